@@ -0,0 +1,174 @@
+package main
+
+import (
+	"math"
+	"math/rand/v2"
+	"time"
+)
+
+// provinces weights buyer addresses towards Java, where most orders ship.
+var provinces = []struct {
+	name   string
+	weight int
+}{
+	{"DKI Jakarta", 30},
+	{"Jawa Barat", 22},
+	{"Jawa Timur", 15},
+	{"Jawa Tengah", 12},
+	{"Banten", 8},
+	{"Sumatera Utara", 5},
+	{"Bali", 4},
+	{"Sulawesi Selatan", 4},
+}
+
+var channels = []string{
+	"BCA_VIRTUAL_ACCOUNT", "BNI_VIRTUAL_ACCOUNT", "MANDIRI_VIRTUAL_ACCOUNT",
+	"QRIS", "GOPAY", "OVO", "DANA", "SHOPEEPAY",
+}
+
+var quantityTypes = []string{"unit", "unit", "unit", "kg", "liter", "sack"}
+
+// generator draws seed data from skewed distributions: a few products and
+// buyers account for most orders, prices are log-normal, and order volume
+// grows towards the present with an evening peak.
+type generator struct {
+	r   *rand.Rand
+	now time.Time
+}
+
+func newGenerator(seed uint64, now time.Time) *generator {
+	return &generator{r: rand.New(rand.NewPCG(seed, seed^0x5eed)), now: now}
+}
+
+// zipf returns a sampler over [0, n) where index 0 is the most popular.
+func (g *generator) zipf(s float64, n int) func() int {
+	z := rand.NewZipf(g.r, s, 1, uint64(n-1))
+	return func() int { return int(z.Uint64()) }
+}
+
+// price returns a log-normal price in rupiah centred on 50.000, rounded to
+// 500.
+func (g *generator) price() int64 {
+	p := math.Exp(math.Log(50000) + 0.9*g.r.NormFloat64())
+	p = math.Max(1000, math.Min(p, 5_000_000))
+	return int64(math.Round(p/500) * 500)
+}
+
+// stock returns 0 for roughly one variant in ten, otherwise 1–500.
+func (g *generator) stock() int {
+	if g.r.IntN(10) == 0 {
+		return 0
+	}
+	return 1 + g.r.IntN(500)
+}
+
+// itemsPerOrder is geometric: most orders hold one or two lines.
+func (g *generator) itemsPerOrder() int {
+	n := 1
+	for n < 6 && g.r.Float64() < 0.45 {
+		n++
+	}
+	return n
+}
+
+func (g *generator) quantity() int {
+	switch x := g.r.IntN(10); {
+	case x < 6:
+		return 1
+	case x < 9:
+		return 2
+	default:
+		return 3 + g.r.IntN(3)
+	}
+}
+
+// orderTime returns a time within the last span. Volume grows linearly
+// towards now and peaks in the evening (Asia/Jakarta is UTC+7).
+func (g *generator) orderTime(span time.Duration) time.Time {
+	age := time.Duration((1 - math.Sqrt(g.r.Float64())) * float64(span))
+	day := g.now.Add(-age).Truncate(24 * time.Hour)
+
+	hour := 12 + int(math.Round(3*g.r.NormFloat64())) // local noon ± 3h
+	if g.r.IntN(3) == 0 {
+		hour = 19 + g.r.IntN(4) // evening peak
+	}
+	hour = (hour - 7 + 24) % 24
+
+	t := day.Add(time.Duration(hour)*time.Hour + time.Duration(g.r.IntN(3600))*time.Second)
+	if t.After(g.now) {
+		t = g.now.Add(-time.Duration(g.r.IntN(3600)) * time.Second)
+	}
+	return t
+}
+
+// status picks an order status plausible for an order placed at placed.
+// Anything older than two weeks has settled.
+func (g *generator) status(placed time.Time) string {
+	x := g.r.IntN(100)
+	if g.now.Sub(placed) > 14*24*time.Hour {
+		switch {
+		case x < 82:
+			return "COMPLETED"
+		case x < 92:
+			return "CANCELLED"
+		default:
+			return "FAILED"
+		}
+	}
+	switch {
+	case x < 15:
+		return "PENDING_PAYMENT"
+	case x < 30:
+		return "PAID"
+	case x < 45:
+		return "ACCEPTED"
+	case x < 65:
+		return "SHIPPED"
+	case x < 85:
+		return "COMPLETED"
+	case x < 93:
+		return "CANCELLED"
+	default:
+		return "FAILED"
+	}
+}
+
+func (g *generator) province() string {
+	total := 0
+	for _, p := range provinces {
+		total += p.weight
+	}
+	x := g.r.IntN(total)
+	for _, p := range provinces {
+		if x < p.weight {
+			return p.name
+		}
+		x -= p.weight
+	}
+	return provinces[0].name
+}
+
+func (g *generator) channel() string {
+	return channels[g.r.IntN(len(channels))]
+}
+
+func (g *generator) quantityType() string {
+	return quantityTypes[g.r.IntN(len(quantityTypes))]
+}
+
+// shippingFee is waived on larger baskets, as the default checkout rule does.
+func (g *generator) shippingFee(subtotal int64) int64 {
+	if subtotal >= 250000 {
+		return 0
+	}
+	return []int64{9000, 12000, 15000, 22000}[g.r.IntN(4)]
+}
+
+// paid reports whether an order in status has a settled gateway payment.
+func paid(status string) bool {
+	switch status {
+	case "PAID", "ACCEPTED", "SHIPPED", "COMPLETED":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+)
+
+// maxParams is Postgres' limit on bind parameters per statement.
+const maxParams = 65535
+
+// inserter writes rows with multi-row INSERTs of up to batch rows.
+type inserter struct {
+	tx    *sql.Tx
+	batch int
+}
+
+// insert writes rows into table and, when returning is set, returns that
+// column for every row in input order.
+func (in *inserter) insert(
+	ctx context.Context,
+	table string,
+	cols []string,
+	rows [][]any,
+	returning string,
+) ([]string, error) {
+	size := in.batch
+	if limit := maxParams / len(cols); size > limit {
+		size = limit
+	}
+
+	var out []string
+	for start := 0; start < len(rows); start += size {
+		end := min(start+size, len(rows))
+
+		query, args := insertQuery(table, cols, rows[start:end], returning)
+		if returning == "" {
+			if _, err := in.tx.ExecContext(ctx, query, args...); err != nil {
+				return nil, fmt.Errorf("insert %s: %w", table, err)
+			}
+			continue
+		}
+
+		ids, err := in.queryIDs(ctx, query, args)
+		if err != nil {
+			return nil, fmt.Errorf("insert %s: %w", table, err)
+		}
+		out = append(out, ids...)
+	}
+	return out, nil
+}
+
+func (in *inserter) queryIDs(ctx context.Context, query string, args []any) ([]string, error) {
+	rows, err := in.tx.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, rows.Err()
+}
+
+// insertQuery renders one INSERT for rows. Postgres returns the rows of a
+// multi-row VALUES insert in the order they were listed.
+func insertQuery(table string, cols []string, rows [][]any, returning string) (string, []any) {
+	var sb strings.Builder
+	args := make([]any, 0, len(rows)*len(cols))
+
+	fmt.Fprintf(&sb, "INSERT INTO %s (%s) VALUES ", table, strings.Join(cols, ", "))
+	for i, row := range rows {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteByte('(')
+		for j, v := range row {
+			if j > 0 {
+				sb.WriteString(", ")
+			}
+			args = append(args, v)
+			fmt.Fprintf(&sb, "$%d", len(args))
+		}
+		sb.WriteByte(')')
+	}
+	if returning != "" {
+		sb.WriteString(" RETURNING " + returning)
+	}
+	return sb.String(), args
+}
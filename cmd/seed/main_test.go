@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testNow = time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func TestGenerator_Deterministic(t *testing.T) {
+	draw := func() []any {
+		g := newGenerator(42, testNow)
+		pick := g.zipf(1.1, 100)
+		var out []any
+		for i := 0; i < 50; i++ {
+			out = append(out, pick(), g.price(), g.orderTime(365*24*time.Hour), g.province())
+		}
+		return out
+	}
+
+	assert.Equal(t, draw(), draw())
+}
+
+func TestGenerator_Ranges(t *testing.T) {
+	g := newGenerator(7, testNow)
+	span := 90 * 24 * time.Hour
+	pick := g.zipf(1.3, 10)
+
+	for i := 0; i < 1000; i++ {
+		placed := g.orderTime(span)
+		assert.False(t, placed.After(testNow), "order placed in the future: %s", placed)
+		assert.True(t, placed.After(testNow.Add(-span-24*time.Hour)), "order placed before span: %s", placed)
+
+		if testNow.Sub(placed) > 14*24*time.Hour {
+			assert.Contains(t, []string{"COMPLETED", "CANCELLED", "FAILED"}, g.status(placed))
+		}
+
+		p := g.price()
+		assert.GreaterOrEqual(t, p, int64(1000))
+		assert.Zero(t, p%500)
+
+		n := pick()
+		assert.True(t, n >= 0 && n < 10)
+	}
+}
+
+func TestShippingFee_FreeAboveThreshold(t *testing.T) {
+	g := newGenerator(1, testNow)
+
+	assert.Zero(t, g.shippingFee(250000))
+	assert.Positive(t, g.shippingFee(100000))
+}
+
+func TestInsertQuery(t *testing.T) {
+	query, args := insertQuery("sellers", []string{"user_id", "name"}, [][]any{
+		{1, "a"},
+		{2, "b"},
+	}, "id")
+
+	assert.Equal(t, "INSERT INTO sellers (user_id, name) VALUES ($1, $2), ($3, $4) RETURNING id", query)
+	assert.Equal(t, []any{1, "a", 2, "b"}, args)
+}
+
+func TestInserter_Batches(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO users (email) VALUES ($1), ($2) RETURNING id")).
+		WithArgs("a", "b").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("1").AddRow("2"))
+	mock.ExpectQuery(regexp.QuoteMeta("INSERT INTO users (email) VALUES ($1) RETURNING id")).
+		WithArgs("c").
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("3"))
+
+	tx, err := db.Begin()
+	require.NoError(t, err)
+
+	in := &inserter{tx: tx, batch: 2}
+	ids, err := in.insert(context.Background(), "users", []string{"email"},
+		[][]any{{"a"}, {"b"}, {"c"}}, "id")
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1", "2", "3"}, ids)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestOptions_Validate(t *testing.T) {
+	valid := options{users: 1, sellers: 1, products: 1, maxVariants: 1, months: 1, batch: 1}
+	assert.NoError(t, valid.validate())
+
+	noSellers := valid
+	noSellers.sellers = 0
+	assert.Error(t, noSellers.validate())
+
+	negativeOrders := valid
+	negativeOrders.orders = -1
+	assert.Error(t, negativeOrders.validate())
+}
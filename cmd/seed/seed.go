@@ -0,0 +1,281 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"warimas-be/internal/user"
+
+	"github.com/google/uuid"
+)
+
+var categoryNames = []string{
+	"Sembako", "Minuman", "Makanan Ringan", "Bumbu Dapur",
+	"Perawatan Diri", "Kebersihan Rumah", "Ibu dan Bayi", "Frozen Food",
+}
+
+const subcategoriesPerCategory = 4
+
+type seedVariant struct {
+	id          string
+	name        string
+	productName string
+	price       int64
+}
+
+type seeder struct {
+	opts options
+	g    *generator
+	in   *inserter
+	tag  string
+
+	buyers   []string
+	sellers  []string
+	subcats  [][2]string // category ID, subcategory ID
+	products [][]seedVariant
+	address  map[string]string // buyer ID → address ID
+}
+
+func (s *seeder) seed(ctx context.Context) error {
+	stages := []struct {
+		name string
+		fn   func(context.Context) error
+	}{
+		{"users", s.seedUsers},
+		{"categories", s.seedCategories},
+		{"products", s.seedProducts},
+		{"addresses", s.seedAddresses},
+		{"orders", s.seedOrders},
+	}
+	for _, st := range stages {
+		start := time.Now()
+		if err := st.fn(ctx); err != nil {
+			return fmt.Errorf("seed %s: %w", st.name, err)
+		}
+		fmt.Printf("🌱 %s done in %s\n", st.name, time.Since(start).Round(time.Millisecond))
+	}
+	return nil
+}
+
+func (s *seeder) seedUsers(ctx context.Context) error {
+	hash, err := user.HashPassword(s.opts.password)
+	if err != nil {
+		return err
+	}
+
+	span := time.Duration(s.opts.months) * 30 * 24 * time.Hour
+	total := s.opts.users + s.opts.sellers
+	rows := make([][]any, 0, total)
+	for i := 0; i < total; i++ {
+		rows = append(rows, []any{
+			fmt.Sprintf("%s-%d@seed.warimas.test", s.tag, i),
+			hash,
+			s.g.orderTime(span),
+		})
+	}
+	ids, err := s.in.insert(ctx, "users", []string{"email", "password", "created_at"}, rows, "id")
+	if err != nil {
+		return err
+	}
+	s.buyers, ids = ids[:s.opts.users], ids[s.opts.users:]
+
+	sellerRows := make([][]any, 0, len(ids))
+	for i, id := range ids {
+		sellerRows = append(sellerRows, []any{id, fmt.Sprintf("Toko %s %d", s.tag, i)})
+	}
+	s.sellers, err = s.in.insert(ctx, "sellers", []string{"user_id", "name"}, sellerRows, "id")
+	return err
+}
+
+func (s *seeder) seedCategories(ctx context.Context) error {
+	rows := make([][]any, 0, len(categoryNames))
+	for _, name := range categoryNames {
+		rows = append(rows, []any{fmt.Sprintf("%s %s", name, s.tag)})
+	}
+	categoryIDs, err := s.in.insert(ctx, "category", []string{"name"}, rows, "id")
+	if err != nil {
+		return err
+	}
+
+	rows = rows[:0]
+	for i, id := range categoryIDs {
+		for j := 0; j < subcategoriesPerCategory; j++ {
+			rows = append(rows, []any{id, fmt.Sprintf("%s %d", categoryNames[i], j+1)})
+		}
+	}
+	subIDs, err := s.in.insert(ctx, "subcategories", []string{"category_id", "name"}, rows, "id")
+	if err != nil {
+		return err
+	}
+	for i, id := range subIDs {
+		s.subcats = append(s.subcats, [2]string{categoryIDs[i/subcategoriesPerCategory], id})
+	}
+	return nil
+}
+
+func (s *seeder) seedProducts(ctx context.Context) error {
+	pickSeller := s.g.zipf(1.2, len(s.sellers)) // a few large stores
+
+	rows := make([][]any, 0, s.opts.products)
+	names := make([]string, 0, s.opts.products)
+	for i := 0; i < s.opts.products; i++ {
+		sub := s.subcats[s.g.r.IntN(len(s.subcats))]
+		name := fmt.Sprintf("Produk %d", i+1)
+		names = append(names, name)
+		rows = append(rows, []any{
+			sub[0], sub[1], s.sellers[pickSeller()],
+			name, fmt.Sprintf("%s-produk-%d", s.tag, i+1),
+			"Seeded product for load testing",
+		})
+	}
+	productIDs, err := s.in.insert(ctx, "products",
+		[]string{"category_id", "subcategory_id", "seller_id", "name", "slug", "description"},
+		rows, "id")
+	if err != nil {
+		return err
+	}
+
+	rows = rows[:0]
+	var variants []seedVariant
+	owner := make([]int, 0, len(productIDs)*s.opts.maxVariants)
+	for i, id := range productIDs {
+		n := 1 + s.g.r.IntN(s.opts.maxVariants)
+		for j := 0; j < n; j++ {
+			v := seedVariant{
+				name:        fmt.Sprintf("Varian %d", j+1),
+				productName: names[i],
+				price:       s.g.price(),
+			}
+			rows = append(rows, []any{id, v.name, s.g.quantityType(), v.price, s.g.stock()})
+			variants = append(variants, v)
+			owner = append(owner, i)
+		}
+	}
+	variantIDs, err := s.in.insert(ctx, "variants",
+		[]string{"product_id", "name", "quantity_type", "price", "stock"},
+		rows, "id")
+	if err != nil {
+		return err
+	}
+
+	s.products = make([][]seedVariant, len(productIDs))
+	for i, id := range variantIDs {
+		variants[i].id = id
+		s.products[owner[i]] = append(s.products[owner[i]], variants[i])
+	}
+	return nil
+}
+
+func (s *seeder) seedAddresses(ctx context.Context) error {
+	s.address = make(map[string]string, len(s.buyers))
+	rows := make([][]any, 0, len(s.buyers))
+	for i, buyer := range s.buyers {
+		id := uuid.NewString()
+		s.address[buyer] = id
+		rows = append(rows, []any{
+			id, buyer, "Rumah", fmt.Sprintf("Pembeli %d", i+1), fmt.Sprintf("0812%08d", i),
+			fmt.Sprintf("Jl. Benchmark No. %d", i+1), "Kota", s.g.province(), "10110", true,
+		})
+	}
+	_, err := s.in.insert(ctx, "addresses",
+		[]string{"id", "user_id", "name", "receiver_name", "phone", "address_line1", "city", "province", "postal_code", "is_default"},
+		rows, "")
+	return err
+}
+
+// seedOrders writes each order with the checkout session it came from, its
+// items and, once paid, its gateway payment.
+func (s *seeder) seedOrders(ctx context.Context) error {
+	if s.opts.orders == 0 {
+		return nil
+	}
+
+	pickBuyer := s.g.zipf(1.3, len(s.buyers))     // repeat customers
+	pickProduct := s.g.zipf(1.1, len(s.products)) // best sellers
+	span := time.Duration(s.opts.months) * 30 * 24 * time.Hour
+
+	type line struct {
+		v   seedVariant
+		qty int
+	}
+	var (
+		sessions, orders, payments [][]any
+		lines                      [][]line
+		statuses                   []string
+	)
+
+	for i := 0; i < s.opts.orders; i++ {
+		buyer := s.buyers[pickBuyer()]
+		placed := s.g.orderTime(span)
+		status := s.g.status(placed)
+
+		var items []line
+		var subtotal int64
+		for n := s.g.itemsPerOrder(); n > 0; n-- {
+			variants := s.products[pickProduct()]
+			l := line{v: variants[s.g.r.IntN(len(variants))], qty: s.g.quantity()}
+			items = append(items, l)
+			subtotal += l.v.price * int64(l.qty)
+		}
+		tax := subtotal * 10 / 100
+		shipping := s.g.shippingFee(subtotal)
+		total := subtotal + tax + shipping
+
+		sessionID := uuid.NewString()
+		sessions = append(sessions, []any{
+			sessionID, buyer, "PAID", subtotal, tax, shipping, total,
+			s.address[buyer], placed.Add(time.Hour), placed, placed,
+			fmt.Sprintf("CK_%s_%d", s.tag, i),
+		})
+		orders = append(orders, []any{
+			buyer, sessionID, status, total, "IDR", fmt.Sprintf("ORD-%s-%d", s.tag, i),
+			subtotal, tax, shipping, s.address[buyer], placed, placed,
+		})
+		lines = append(lines, items)
+		statuses = append(statuses, status)
+	}
+
+	if _, err := s.in.insert(ctx, "checkout_sessions",
+		[]string{"id", "user_id", "status", "subtotal", "tax", "shipping_fee", "total_amount",
+			"address_id", "expires_at", "confirmed_at", "created_at", "external_id"},
+		sessions, ""); err != nil {
+		return err
+	}
+
+	orderIDs, err := s.in.insert(ctx, "orders",
+		[]string{"user_id", "checkout_session_id", "status", "total_amount", "currency", "external_id",
+			"subtotal", "tax", "shipping_fee", "address_id", "created_at", "updated_at"},
+		orders, "id")
+	if err != nil {
+		return err
+	}
+
+	var items [][]any
+	for i, id := range orderIDs {
+		for _, l := range lines[i] {
+			items = append(items, []any{
+				id, l.qty, l.v.price, l.v.id, l.v.name, l.v.productName, l.v.price * int64(l.qty),
+			})
+		}
+		if paid(statuses[i]) {
+			placed := orders[i][10].(time.Time)
+			payments = append(payments, []any{
+				id, fmt.Sprintf("pr-%s-%d", s.tag, i), "", orders[i][3], "PAID",
+				s.g.channel(), "", "XENDIT", "IDR", placed.Add(10 * time.Minute), placed,
+			})
+		}
+	}
+
+	if _, err := s.in.insert(ctx, "order_items",
+		[]string{"order_id", "quantity", "unit_price", "variant_id", "variant_name", "product_name", "subtotal"},
+		items, ""); err != nil {
+		return err
+	}
+
+	_, err = s.in.insert(ctx, "payments",
+		[]string{"order_id", "external_reference", "invoice_url", "amount", "status",
+			"channel_code", "payment_code", "provider", "currency", "paid_at", "created_at"},
+		payments, "")
+	return err
+}
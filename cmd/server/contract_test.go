@@ -0,0 +1,313 @@
+//go:build integration
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"warimas-be/internal/config"
+	"warimas-be/internal/payment"
+	"warimas-be/internal/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeGateway stands in for Xendit: invoices succeed with a predictable
+// payment request ID and every webhook signature is accepted.
+type fakeGateway struct {
+	payment.Gateway
+}
+
+func (fakeGateway) CreateInvoice(
+	ctx context.Context,
+	externalID string,
+	buyer payment.BuyerInfo,
+	amount int64,
+	items []payment.XenditItem,
+	channelCode payment.ChannelCode,
+) (*payment.PaymentResponse, error) {
+	return &payment.PaymentResponse{
+		ProviderPaymentID: "pr-" + externalID,
+		ReferenceID:       externalID,
+		Amount:            amount,
+		Status:            "PENDING",
+		PaymentMethod:     channelCode,
+		ChannelCode:       string(channelCode),
+		PaymentCode:       "8808000000",
+		ExpirationTime:    time.Now().Add(24 * time.Hour),
+	}, nil
+}
+
+func (fakeGateway) VerifySignature(r *http.Request) error {
+	return nil
+}
+
+// contractClient posts GraphQL operations to a server booted with newServer.
+type contractClient struct {
+	t     *testing.T
+	db    *sql.DB
+	url   string
+	token string
+}
+
+func newContractClient(t *testing.T) *contractClient {
+	t.Helper()
+
+	t.Setenv("JWT_SECRET", "contract-test-secret")
+
+	orig := newPaymentGateway
+	newPaymentGateway = func(*config.Config) (payment.Gateway, error) { return fakeGateway{}, nil }
+	t.Cleanup(func() { newPaymentGateway = orig })
+
+	db := testutil.NewDB(t)
+	router, err := newServer(&config.Config{AppEnv: "test"}, db)
+	require.NoError(t, err)
+	srv := httptest.NewServer(router)
+	t.Cleanup(srv.Close)
+
+	return &contractClient{t: t, db: db, url: srv.URL}
+}
+
+// do runs query and decodes its data into out, failing the test on any
+// GraphQL error.
+func (c *contractClient) do(query string, vars map[string]any, out any) {
+	c.t.Helper()
+
+	body, err := json.Marshal(map[string]any{"query": query, "variables": vars})
+	require.NoError(c.t, err)
+
+	req, err := http.NewRequest(http.MethodPost, c.url+"/query", bytes.NewReader(body))
+	require.NoError(c.t, err)
+	req.Header.Set("Content-Type", "application/json")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(c.t, err)
+	defer resp.Body.Close()
+
+	var envelope struct {
+		Data   json.RawMessage `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+			Path    []any  `json:"path"`
+		} `json:"errors"`
+	}
+	require.NoError(c.t, json.NewDecoder(resp.Body).Decode(&envelope))
+	require.Empty(c.t, envelope.Errors, "graphql errors for %s", query)
+	require.NoError(c.t, json.Unmarshal(envelope.Data, out))
+}
+
+func (c *contractClient) webhook(payload any) int {
+	c.t.Helper()
+
+	body, err := json.Marshal(payload)
+	require.NoError(c.t, err)
+
+	resp, err := http.Post(c.url+"/webhook/payment", "application/json", bytes.NewReader(body))
+	require.NoError(c.t, err)
+	resp.Body.Close()
+	return resp.StatusCode
+}
+
+func TestContract_RegisterToPaidOrder(t *testing.T) {
+	c := newContractClient(t)
+
+	// Catalog data is seeded directly; seller flows are out of scope here.
+	variant := c.seedVariant()
+
+	// 1. Register
+	var reg struct {
+		Register struct {
+			Token string `json:"token"`
+			User  struct {
+				ID   string `json:"id"`
+				Role string `json:"role"`
+			} `json:"user"`
+		} `json:"register"`
+	}
+	c.do(`mutation($input: RegisterInput!) {
+		register(input: $input) { token user { id role } }
+	}`, map[string]any{"input": map[string]any{
+		"email":    "contract@example.com",
+		"password": "Sup3r-secret!",
+	}}, &reg)
+	require.NotEmpty(t, reg.Register.Token)
+	assert.Equal(t, "USER", reg.Register.User.Role)
+	c.token = reg.Register.Token
+
+	// 2. Add to cart
+	var added struct {
+		AddToCart struct {
+			Success bool `json:"success"`
+		} `json:"addToCart"`
+	}
+	c.do(`mutation($input: AddToCartInput!) {
+		addToCart(input: $input) { success }
+	}`, map[string]any{"input": map[string]any{"variantId": variant, "quantity": 2}}, &added)
+	require.True(t, added.AddToCart.Success)
+
+	var cart struct {
+		MyCart struct {
+			Items []struct {
+				Quantity int `json:"quantity"`
+				Product  struct {
+					Variant struct {
+						ID string `json:"id"`
+					} `json:"variant"`
+				} `json:"product"`
+			} `json:"items"`
+		} `json:"myCart"`
+	}
+	c.do(`{ myCart { items { quantity product { variant { id } } } } }`, nil, &cart)
+	require.Len(t, cart.MyCart.Items, 1)
+	assert.Equal(t, variant, cart.MyCart.Items[0].Product.Variant.ID)
+
+	// 3. Checkout: session, address, payment method, confirm
+	var session struct {
+		CreateCheckoutSession struct {
+			ExternalID string `json:"externalId"`
+			Status     string `json:"status"`
+		} `json:"createCheckoutSession"`
+	}
+	c.do(`mutation($input: CreateCheckoutSessionInput!) {
+		createCheckoutSession(input: $input) { externalId status }
+	}`, map[string]any{"input": map[string]any{
+		"items": []map[string]any{{"variantId": variant, "quantity": cart.MyCart.Items[0].Quantity}},
+	}}, &session)
+	sessionID := session.CreateCheckoutSession.ExternalID
+	require.NotEmpty(t, sessionID)
+
+	var addr struct {
+		CreateAddress struct {
+			Address struct {
+				ID string `json:"id"`
+			} `json:"address"`
+		} `json:"createAddress"`
+	}
+	c.do(`mutation($input: CreateAddressInput!) {
+		createAddress(input: $input) { address { id } }
+	}`, map[string]any{"input": map[string]any{
+		"setAsDefault": true,
+		"address": map[string]any{
+			"name":         "Home",
+			"receiverName": "Contract Buyer",
+			"phone":        "081200000000",
+			"addressLine1": "Jl. Kontrak 1",
+			"city":         "Jakarta",
+			"province":     "DKI Jakarta",
+			"postalCode":   "10110",
+			"country":      "ID",
+		},
+	}}, &addr)
+
+	var ok struct {
+		UpdateSessionAddress struct {
+			Success bool `json:"success"`
+		} `json:"updateSessionAddress"`
+		UpdateSessionPaymentMethod struct {
+			Success bool `json:"success"`
+		} `json:"updateSessionPaymentMethod"`
+	}
+	c.do(`mutation($addr: UpdateSessionAddressInput!, $pm: UpdateSessionPaymentMethodInput!) {
+		updateSessionAddress(input: $addr) { success }
+		updateSessionPaymentMethod(input: $pm) { success }
+	}`, map[string]any{
+		"addr": map[string]any{"externalId": sessionID, "addressId": addr.CreateAddress.Address.ID},
+		"pm":   map[string]any{"externalId": sessionID, "paymentMethod": string(payment.MethodBCAVA)},
+	}, &ok)
+	require.True(t, ok.UpdateSessionAddress.Success)
+	require.True(t, ok.UpdateSessionPaymentMethod.Success)
+
+	var confirmed struct {
+		ConfirmCheckoutSession struct {
+			Success         bool   `json:"success"`
+			OrderExternalID string `json:"order_external_id"`
+		} `json:"confirmCheckoutSession"`
+	}
+	c.do(`mutation($input: ConfirmCheckoutSessionInput!) {
+		confirmCheckoutSession(input: $input) { success order_external_id }
+	}`, map[string]any{"input": map[string]any{"externalId": sessionID}}, &confirmed)
+	orderID := confirmed.ConfirmCheckoutSession.OrderExternalID
+	require.NotEmpty(t, orderID)
+
+	pending := c.orderDetail(orderID)
+	assert.Equal(t, "PENDING_PAYMENT", pending.Status)
+	require.Len(t, pending.Items, 1)
+	assert.Equal(t, variant, pending.Items[0].Variant.ID)
+	assert.Equal(t, 2, pending.Items[0].Quantity)
+
+	// 4. Gateway reports the payment captured
+	status := c.webhook(map[string]any{
+		"event": "payment.capture",
+		"data": map[string]any{
+			"status":             "SUCCEEDED",
+			"currency":           pending.Pricing.Currency,
+			"payment_id":         "py-contract-1",
+			"reference_id":       orderID,
+			"request_amount":     pending.Pricing.Total - pending.Pricing.WalletAmount,
+			"payment_request_id": "pr-" + orderID,
+			"created":            time.Now().UTC().Format(time.RFC3339),
+		},
+	})
+	require.Equal(t, http.StatusOK, status)
+
+	// 5. Order detail reflects the payment
+	paid := c.orderDetail(orderID)
+	assert.Equal(t, "PAID", paid.Status)
+	assert.Equal(t, reg.Register.User.ID, strconv.Itoa(paid.User.ID))
+}
+
+type contractOrder struct {
+	Status string `json:"status"`
+	User   struct {
+		ID int `json:"id"`
+	} `json:"user"`
+	Pricing struct {
+		Currency     string `json:"currency"`
+		Total        int    `json:"total"`
+		WalletAmount int    `json:"walletAmount"`
+	} `json:"pricing"`
+	Items []struct {
+		Quantity int `json:"quantity"`
+		Variant  struct {
+			ID string `json:"id"`
+		} `json:"variant"`
+	} `json:"items"`
+}
+
+func (c *contractClient) orderDetail(externalID string) contractOrder {
+	c.t.Helper()
+
+	var out struct {
+		OrderDetailByExternalID contractOrder `json:"orderDetailByExternalId"`
+	}
+	c.do(`query($id: ID!) {
+		orderDetailByExternalId(externalId: $id) {
+			status
+			user { id }
+			pricing { currency total walletAmount }
+			items { quantity variant { id } }
+		}
+	}`, map[string]any{"id": externalID}, &out)
+	return out.OrderDetailByExternalID
+}
+
+// seedVariant creates a seller with one in-stock variant and returns the
+// variant ID.
+func (c *contractClient) seedVariant() string {
+	c.t.Helper()
+
+	sellerID := testutil.CreateSeller(c.t, c.db, testutil.CreateUser(c.t, c.db))
+	return testutil.CreateVariant(c.t, c.db, sellerID, 50000, 10).VariantID
+}
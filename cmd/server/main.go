@@ -1,36 +1,88 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
+	"time"
 
+	"warimas-be/internal/accounting"
 	"warimas-be/internal/address"
+	"warimas-be/internal/analytics"
+	"warimas-be/internal/apikey"
 	"warimas-be/internal/cart"
 	"warimas-be/internal/category"
+	"warimas-be/internal/changelog"
+	"warimas-be/internal/commission"
 	"warimas-be/internal/config"
+	"warimas-be/internal/consent"
 	"warimas-be/internal/db"
+	"warimas-be/internal/dbhealth"
+	"warimas-be/internal/dispute"
+	"warimas-be/internal/errreport"
+	"warimas-be/internal/experiment"
+	"warimas-be/internal/export"
+	"warimas-be/internal/fulfillment"
+	"warimas-be/internal/geo"
 	"warimas-be/internal/graph"
+	"warimas-be/internal/guest"
+	"warimas-be/internal/inventory"
 	"warimas-be/internal/logger"
+	"warimas-be/internal/logsettings"
+	"warimas-be/internal/loyalty"
+	"warimas-be/internal/maintenance"
 	"warimas-be/internal/middleware"
+	"warimas-be/internal/moderation"
+	"warimas-be/internal/money"
+	"warimas-be/internal/ops"
 	"warimas-be/internal/order"
+	"warimas-be/internal/order/internalapi"
+	"warimas-be/internal/orderchat"
+	"warimas-be/internal/outbound"
+	"warimas-be/internal/outbox"
 	"warimas-be/internal/packages"
 	"warimas-be/internal/payment"
 	"warimas-be/internal/payment/webhook"
+	"warimas-be/internal/pii"
+	"warimas-be/internal/preference"
+	"warimas-be/internal/pricechange"
 	"warimas-be/internal/product"
+	"warimas-be/internal/quota"
+	"warimas-be/internal/receipt"
+	"warimas-be/internal/referral"
+	"warimas-be/internal/refund"
+	"warimas-be/internal/retention"
+	"warimas-be/internal/scheduler"
+	"warimas-be/internal/shipment"
+	courierwebhook "warimas-be/internal/shipment/webhook"
+	"warimas-be/internal/sla"
+	"warimas-be/internal/stockalert"
+	"warimas-be/internal/store"
+	"warimas-be/internal/synthetic"
 	"warimas-be/internal/transport"
+	"warimas-be/internal/uploads"
 	"warimas-be/internal/user"
+	"warimas-be/internal/voucher"
+	"warimas-be/internal/wallet"
+	"warimas-be/internal/wishlist"
 
+	"github.com/99designs/gqlgen/graphql"
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	gqltransport "github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
+	"github.com/vektah/gqlparser/v2/ast"
 	"go.uber.org/zap"
 )
 
 var (
-	initDBFunc      = db.InitDB
-	startServerFunc = http.ListenAndServe
+	initDBFunc        = db.InitDB
+	startServerFunc   = http.ListenAndServe
+	newPaymentGateway = newGateway
 )
 
 func main() {
@@ -45,6 +97,55 @@ func run() error {
 	defer logger.Sync()
 
 	cfg := config.LoadConfig()
+	middleware.SlowRequestThreshold = time.Duration(cfg.SlowRequestMs) * time.Millisecond
+
+	if cfg.PIIKeys != "" {
+		if err := pii.Init(cfg.PIIKeys, cfg.PIIActiveKey); err != nil {
+			return fmt.Errorf("init pii keyring: %w", err)
+		}
+	} else if cfg.AppEnv == "production" {
+		return fmt.Errorf("PII_KEYS is required in production")
+	}
+	if cfg.PIILookupKey != "" {
+		if err := pii.InitLookup(cfg.PIILookupKey); err != nil {
+			return fmt.Errorf("init pii lookup key: %w", err)
+		}
+	} else if cfg.AppEnv == "production" {
+		return fmt.Errorf("PII_LOOKUP_KEY is required in production")
+	}
+
+	if err := money.Configure(cfg.RoundingRules); err != nil {
+		return fmt.Errorf("configure rounding: %w", err)
+	}
+
+	if cfg.SentryDSN != "" {
+		reporter, err := errreport.NewSentryReporter(cfg.SentryDSN, cfg.AppEnv)
+		if err != nil {
+			return fmt.Errorf("init sentry: %w", err)
+		}
+		errreport.SetReporter(reporter)
+		errreport.SetSampleRate(cfg.ErrorReportSampleRate)
+		defer reporter.Flush(2 * time.Second)
+	}
+
+	switch cfg.AnalyticsSink {
+	case "":
+	case "log":
+		defer analytics.Start(analytics.LogSink{}).Close(2 * time.Second)
+	case "kafka":
+		if cfg.KafkaBrokers == "" {
+			return fmt.Errorf("ANALYTICS_SINK=kafka needs KAFKA_BROKERS")
+		}
+		sink := analytics.NewKafkaSink(cfg.KafkaBrokers, outbox.Topic(cfg.EventTopicPrefix, "analytics"))
+		defer analytics.Start(sink).Close(2 * time.Second)
+	case "http":
+		if cfg.AnalyticsURL == "" {
+			return fmt.Errorf("ANALYTICS_SINK=http needs ANALYTICS_URL")
+		}
+		defer analytics.Start(analytics.NewHTTPSink(cfg.AnalyticsURL)).Close(2 * time.Second)
+	default:
+		return fmt.Errorf("unknown ANALYTICS_SINK %q", cfg.AnalyticsSink)
+	}
 
 	logger.L().Info("Connecting to database...")
 
@@ -52,7 +153,10 @@ func run() error {
 	database := initDBFunc(cfg)
 	defer database.Close()
 
-	router := newServer(cfg, database)
+	router, err := newServer(cfg, database)
+	if err != nil {
+		return err
+	}
 
 	logger.L().Info("🚀 Warimas Backend Started",
 		zap.String("env", cfg.AppEnv),
@@ -62,7 +166,7 @@ func run() error {
 	return startServerFunc(":"+cfg.AppPort, router)
 }
 
-func newServer(cfg *config.Config, database *sql.DB) *http.ServeMux {
+func newServer(cfg *config.Config, database *sql.DB) (*http.ServeMux, error) {
 	// -------------------------------------------------------------------------
 	// Init Repositories
 	// -------------------------------------------------------------------------
@@ -74,62 +178,462 @@ func newServer(cfg *config.Config, database *sql.DB) *http.ServeMux {
 	categoryRepo := category.NewRepository(database)
 	addressRepo := address.NewRepository(database)
 	packagesRepo := packages.NewRepository(database)
+	walletRepo := wallet.NewRepository(database)
+	refundRepo := refund.NewRepository(database)
+	voucherRepo := voucher.NewRepository(database)
+	referralRepo := referral.NewRepository(database)
+	loyaltyRepo := loyalty.NewRepository(database)
+	consentRepo := consent.NewRepository(database)
+	preferenceRepo := preference.NewRepository(database)
+	retentionRepo := retention.NewRepository(database)
+	opsRepo := ops.NewRepository(database)
+	slaRepo := sla.NewRepository(database)
+	dbHealthRepo := dbhealth.NewRepository(database)
+	syntheticRepo := synthetic.NewRepository(database)
+	disputeRepo := dispute.NewRepository(database)
+	outboxRepo := outbox.NewRepository(database)
+	inventoryRepo := inventory.NewRepository(database)
+	fulfillmentRepo := fulfillment.NewRepository(database)
+	shipmentRepo := shipment.NewRepository(database)
+	maintenanceRepo := maintenance.NewRepository(database)
+	logSettingsRepo := logsettings.NewRepository(database)
+	quotaRepo := quota.NewRepository(database)
+	apiKeyRepo := apikey.NewRepository(database)
+	changeLogRepo := changelog.NewRepository(database)
+	uploadsRepo := uploads.NewRepository(database)
+	wishlistRepo := wishlist.NewRepository(database)
+	stockAlertRepo := stockalert.NewRepository(database)
+	receiptRepo := receipt.NewRepository(database)
+	priceChangeRepo := pricechange.NewRepository(database)
+	exportRepo := export.NewRepository(database)
+	storeRepo := store.NewRepository(database)
+	orderChatRepo := orderchat.NewRepository(database)
+	commissionRepo := commission.NewRepository(database)
+	accountingRepo := accounting.NewRepository(database)
+	experimentRepo := experiment.NewRepository(database)
+	moderationRepo := moderation.NewRepository(database)
 
 	// -------------------------------------------------------------------------
 	// Init Services
 	// -------------------------------------------------------------------------
 	productSvc := product.NewService(productRepo)
-	userSvc := user.NewService(userRepo)
+	moderationSvc := moderation.NewService(moderationRepo, map[moderation.ContentType]moderation.Source{
+		moderation.ContentStoreName:        storeRepo,
+		moderation.ContentStoreDescription: storeRepo,
+		moderation.ContentStoreLogo:        storeRepo,
+		moderation.ContentDisplayName:      userRepo,
+		moderation.ContentAvatar:           userRepo,
+		moderation.ContentProduct:          productRepo,
+	}, userRepo, int32(cfg.ModerationHideReports))
+	userSvc := user.NewService(userRepo, user.LogOTPSender{}, moderationSvc)
 	cartSvc := cart.NewService(cartRepo, productRepo)
 	categorySvc := category.NewService(categoryRepo)
 	addressSvc := address.NewService(addressRepo)
 	packagesSvc := packages.NewService(packagesRepo)
+	walletSvc := wallet.NewService(walletRepo)
+	voucherSvc := voucher.NewService(voucherRepo)
+	referralSvc := referral.NewService(referralRepo)
+	loyaltySvc := loyalty.NewService(loyaltyRepo)
+	consentSvc := consent.NewService(consentRepo)
+	preferenceSvc := preference.NewService(preferenceRepo, consentSvc)
+	retentionSvc := retention.NewService(retentionRepo, retention.Policies{
+		CheckoutSessions:      days(cfg.RetentionCheckoutSessionDays),
+		GuestCheckoutSessions: days(cfg.RetentionGuestSessionDays),
+		WebhookPayloads:       days(cfg.RetentionWebhookPayloadDays),
+		StaleCarts:            days(cfg.RetentionStaleCartDays),
+		OrderAnonymization:    days(365 * cfg.RetentionOrderAnonymizeYears),
+		DryRun:                cfg.RetentionDryRun,
+	})
+	opsSvc := ops.NewService(opsRepo)
+	slaSvc := sla.NewService(slaRepo, sla.DefaultRules(
+		hours(cfg.SLAPaidToAcceptedHours),
+		hours(cfg.SLAAcceptedToShippedHours),
+	), sla.LogNotifier{})
+	dbHealthSvc := dbhealth.NewService(dbHealthRepo, dbhealth.DefaultThresholds(
+		cfg.DBHealthDeadTuplePercent,
+		cfg.DBHealthSlowStatementMs,
+		cfg.DBHealthLongQuerySeconds,
+	))
+	disputeSvc := dispute.NewService(disputeRepo, dispute.LogNotifier{})
+	var eventPublisher outbox.Publisher = outbox.LogPublisher{}
+	if cfg.KafkaBrokers != "" {
+		eventPublisher = outbox.NewKafkaPublisher(cfg.KafkaBrokers)
+	}
+	outboxSvc := outbox.NewService(outboxRepo, eventPublisher, cfg.EventTopicPrefix)
+	inventorySvc := inventory.NewService(inventoryRepo, int32(cfg.StockApprovalThreshold))
+	fulfillmentSvc := fulfillment.NewService(fulfillmentRepo, addressSvc)
+	maintenanceSvc := maintenance.NewService(maintenanceRepo, cfg.MaintenanceMode)
+	logSettingsSvc := logsettings.NewService(logSettingsRepo)
+	apiKeySvc := apikey.NewService(apiKeyRepo)
+	changeLogSvc := changelog.NewService(changeLogRepo)
+	uploadStorage := uploads.NewLocalStorage(cfg.UploadsDir, cfg.UploadsBaseURL)
+	uploadsSvc := uploads.NewService(uploadsRepo, uploadStorage, productSvc, userSvc)
+	quotaSvc := quota.NewService(quotaRepo, quota.DefaultThresholds(cfg.AbuseDailyOps, cfg.AbuseSpikeFactor))
+	wishlistSvc := wishlist.NewService(wishlistRepo, consentSvc, preferenceSvc, wishlist.LogNotifier{})
+	stockAlertSvc := stockalert.NewService(stockAlertRepo, preferenceSvc, stockalert.LogNotifier{})
+	receiptSvc := receipt.NewService(receiptRepo, addressSvc, receipt.LogNotifier{})
+	priceChangeSvc := pricechange.NewService(priceChangeRepo)
+	experimentSvc := experiment.NewService(experimentRepo)
+	guestWrites := guest.NewWriteLimiter(guest.DefaultWriteLimits())
+	storeSvc := store.NewService(storeRepo, moderationSvc)
+	exportSvc := export.NewService(exportRepo, uploadStorage)
+	orderChatSvc := orderchat.NewService(orderChatRepo, uploadStorage, preferenceSvc, orderchat.LogNotifier{})
+	commissionSvc := commission.NewService(commissionRepo)
+	accountingSvc := accounting.NewService(accountingRepo, accounting.FeeSchedule{
+		RateBps: int64(cfg.PaymentFeeBps),
+		Fixed:   int64(cfg.PaymentFeeFixed),
+	})
 
-	paymentGateway := payment.NewXenditGateway(cfg.XenditSecretKey)
-	orderSvc := order.NewService(orderRepo, paymentRepo, paymentGateway, addressRepo, userRepo)
-	webhookHandler := webhook.NewWebhookHandler(orderSvc, paymentGateway, paymentRepo)
+	paymentGateway, err := newPaymentGateway(cfg)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.SyntheticCheckoutVariantID != "" {
+		// Only the canary's own checkouts are routed to the simulator
+		paymentGateway, err = payment.NewSyntheticRouter(paymentGateway, payment.SimulatedOptions{
+			WebhookURL:    simulatedWebhookURL(cfg),
+			CallbackToken: os.Getenv("XENDIT_WEBHOOK_TOKEN"),
+			Outcome:       payment.SimulatePaid,
+			Delay:         time.Second,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	orderSvc := order.NewService(orderRepo, paymentRepo, paymentGateway, addressSvc, userRepo, walletSvc, loyaltySvc, preferenceSvc)
+	refundSvc := refund.NewService(refundRepo, paymentGateway)
+	webhookHandler := webhook.NewWebhookHandler(orderSvc, paymentGateway, paymentRepo, disputeSvc, maintenanceSvc)
+	shipmentSvc := shipment.NewService(shipmentRepo, orderSvc)
+	syntheticSvc := synthetic.NewService(syntheticRepo, orderSvc, addressSvc, synthetic.Config{
+		UserID:         uint(cfg.SyntheticCheckoutUserID),
+		VariantID:      cfg.SyntheticCheckoutVariantID,
+		PaymentTimeout: time.Duration(cfg.SyntheticCheckoutTimeoutSeconds) * time.Second,
+	})
+	courierWebhookHandler := courierwebhook.NewCourierWebhookHandler(shipmentSvc, shipmentRepo)
 
 	// -------------------------------------------------------------------------
 	// GraphQL Resolver & Server
 	// -------------------------------------------------------------------------
 	resolver := &graph.Resolver{
-		DB:          database,
-		ProductSvc:  productSvc,
-		UserSvc:     userSvc,
-		CartSvc:     cartSvc,
-		OrderSvc:    orderSvc,
-		CategorySvc: categorySvc,
-		AddressSvc:  addressSvc,
-		PackageSvc:  packagesSvc,
+		DB:             database,
+		ProductSvc:     productSvc,
+		UserSvc:        userSvc,
+		CartSvc:        cartSvc,
+		OrderSvc:       orderSvc,
+		CategorySvc:    categorySvc,
+		AddressSvc:     addressSvc,
+		PackageSvc:     packagesSvc,
+		WalletSvc:      walletSvc,
+		RefundSvc:      refundSvc,
+		VoucherSvc:     voucherSvc,
+		ReferralSvc:    referralSvc,
+		LoyaltySvc:     loyaltySvc,
+		ConsentSvc:     consentSvc,
+		PreferenceSvc:  preferenceSvc,
+		ModerationSvc:  moderationSvc,
+		RetentionSvc:   retentionSvc,
+		OpsSvc:         opsSvc,
+		SLASvc:         slaSvc,
+		DisputeSvc:     disputeSvc,
+		InventorySvc:   inventorySvc,
+		FulfillmentSvc: fulfillmentSvc,
+		ShipmentSvc:    shipmentSvc,
+		MaintenanceSvc: maintenanceSvc,
+		LogSettingsSvc: logSettingsSvc,
+		QuotaSvc:       quotaSvc,
+		APIKeySvc:      apiKeySvc,
+		ChangeLogSvc:   changeLogSvc,
+		UploadsSvc:     uploadsSvc,
+		WishlistSvc:    wishlistSvc,
+		StockAlertSvc:  stockAlertSvc,
+		PriceChangeSvc: priceChangeSvc,
+		ExportSvc:      exportSvc,
+		StoreSvc:       storeSvc,
+		OrderChatSvc:   orderChatSvc,
+		CommissionSvc:  commissionSvc,
+		AccountingSvc:  accountingSvc,
+		ReceiptSvc:     receiptSvc,
+		ExperimentSvc:  experimentSvc,
+		DBHealthSvc:    dbHealthSvc,
+		SyntheticSvc:   syntheticSvc,
+		GuestWrites:    guestWrites,
+	}
+
+	// -------------------------------------------------------------------------
+	// Background Jobs
+	// -------------------------------------------------------------------------
+	bg := context.Background()
+	go scheduler.Every(bg, "segment_refresh", voucher.SegmentRefreshInterval, func(ctx context.Context) error {
+		_, err := voucherSvc.RefreshSegments(ctx)
+		return err
+	})
+	go scheduler.Every(bg, "referral_rewards", referral.RewardInterval, func(ctx context.Context) error {
+		_, err := referralSvc.RewardQualified(ctx)
+		return err
+	})
+	go scheduler.Every(bg, "loyalty_accrual", loyalty.AccrualInterval, func(ctx context.Context) error {
+		_, err := loyaltySvc.AccrueCompletedOrders(ctx)
+		return err
+	})
+	go scheduler.Every(bg, "loyalty_expiry", loyalty.ExpiryInterval, func(ctx context.Context) error {
+		_, err := loyaltySvc.ExpirePoints(ctx)
+		return err
+	})
+	go scheduler.Every(bg, "retention", retention.RunInterval, func(ctx context.Context) error {
+		_, err := retentionSvc.Run(ctx)
+		return err
+	})
+	go scheduler.Every(bg, "sla_check", sla.CheckInterval, func(ctx context.Context) error {
+		_, err := slaSvc.Check(ctx)
+		return err
+	})
+	go scheduler.Every(bg, "db_health_report", dbhealth.ReportInterval, func(ctx context.Context) error {
+		_, err := dbHealthSvc.Run(ctx)
+		return err
+	})
+	if cfg.SyntheticCheckoutIntervalMinutes > 0 {
+		interval := time.Duration(cfg.SyntheticCheckoutIntervalMinutes) * time.Minute
+		go scheduler.Every(bg, "synthetic_checkout", interval, func(ctx context.Context) error {
+			_, err := syntheticSvc.Check(ctx)
+			return err
+		})
+	}
+	go scheduler.Every(bg, "wishlist_alerts", wishlist.AlertInterval, func(ctx context.Context) error {
+		_, err := wishlistSvc.CheckAlerts(ctx)
+		return err
+	})
+	go scheduler.Every(bg, "back_in_stock", stockalert.NotifyInterval, func(ctx context.Context) error {
+		_, err := stockAlertSvc.NotifyRestocked(ctx)
+		return err
+	})
+	go scheduler.Every(bg, "order_receipts", receipt.SendInterval, func(ctx context.Context) error {
+		_, err := receiptSvc.SendPending(ctx)
+		return err
+	})
+	go scheduler.Every(bg, "price_changes", pricechange.ApplyInterval, func(ctx context.Context) error {
+		_, err := priceChangeSvc.ApplyDue(ctx)
+		return err
+	})
+	go scheduler.Every(bg, "exports", export.RunInterval, func(ctx context.Context) error {
+		_, err := exportSvc.Run(ctx)
+		return err
+	})
+	go scheduler.Every(bg, "courier_webhook_retry", shipment.RetryInterval, func(ctx context.Context) error {
+		_, err := shipmentSvc.RetryWebhooks(ctx)
+		return err
+	})
+	go scheduler.Every(bg, "gateway_refund_reconcile", refund.ReconcileInterval, func(ctx context.Context) error {
+		_, err := refundSvc.ReconcileGatewayRefunds(ctx)
+		return err
+	})
+	go scheduler.Every(bg, "payment_webhook_drain", webhook.DrainInterval, func(ctx context.Context) error {
+		_, err := webhookHandler.DrainQueued(ctx)
+		return err
+	})
+	go scheduler.Every(bg, "payment_expiry", order.PaymentExpiryInterval, func(ctx context.Context) error {
+		_, err := orderSvc.ExpireUnpaidOrders(ctx)
+		return err
+	})
+	go scheduler.Every(bg, "session_expiry", order.SessionExpiryInterval, func(ctx context.Context) error {
+		_, err := orderSvc.ExpireSessions(ctx)
+		return err
+	})
+	go scheduler.Every(bg, "log_settings_sync", logsettings.SyncInterval, logSettingsSvc.Sync)
+	go scheduler.Every(bg, "usage_flush", quota.FlushInterval, func(ctx context.Context) error {
+		_, err := quotaSvc.Flush(ctx)
+		return err
+	})
+	go scheduler.Every(bg, "usage_anomaly_check", quota.CheckInterval, func(ctx context.Context) error {
+		_, err := quotaSvc.DetectAnomalies(ctx)
+		return err
+	})
+	go scheduler.Every(bg, "guest_write_prune", guest.PruneInterval, func(ctx context.Context) error {
+		guestWrites.Prune()
+		return nil
+	})
+	go scheduler.Every(bg, "outbox_relay", outbox.RelayInterval, func(ctx context.Context) error {
+		_, err := outboxSvc.Relay(ctx)
+		return err
+	})
+	go scheduler.Every(bg, "outbox_purge", outbox.PurgeInterval, func(ctx context.Context) error {
+		_, err := outboxSvc.Purge(ctx)
+		return err
+	})
+	go scheduler.Every(bg, "pii_rotation", pii.RotateInterval, func(ctx context.Context) error {
+		for _, t := range []pii.Table{address.EncryptedTable, user.EncryptedProfileTable, user.EncryptedPhoneVerificationTable} {
+			if _, err := pii.Rotate(ctx, database, t, pii.RotateBatchSize); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+
+	srv := newGraphQLServer(graph.NewSchema(resolver), graph.StorefrontComplexityLimit, maintenanceSvc, quotaSvc)
+	adminSrv := newGraphQLServer(graph.NewAdminSchema(resolver), graph.AdminComplexityLimit, maintenanceSvc, quotaSvc)
+
+	internalAPI := internalapi.NewHandler(orderSvc).Routes()
+
+	files := uploads.FileServer(cfg.UploadsDir)
+
+	guestSecret := cfg.GuestTokenSecret
+	if guestSecret == "" {
+		guestSecret = os.Getenv("JWT_SECRET")
+	}
+	guests := guest.NewSigner(guestSecret)
+
+	detector := &geo.Detector{
+		CountryHeader: cfg.GeoCountryHeader,
+		RegionHeader:  cfg.GeoRegionHeader,
+	}
+	if cfg.MaxMindAccountID != "" {
+		detector.IP = geo.NewMaxMindLocator(cfg.MaxMindAccountID, cfg.MaxMindLicenseKey)
+	}
+
+	return setupRouter(srv, adminSrv, apiKeySvc, guests, detector, internalAPI, files, webhookHandler.PaymentWebhookHandler, courierWebhookHandler.CourierWebhookHandler), nil
+}
+
+// newGateway builds the payment gateway PAYMENT_GATEWAY selects. The
+// simulated one is refused in production.
+func newGateway(cfg *config.Config) (payment.Gateway, error) {
+	switch cfg.PaymentGateway {
+	case "", "xendit":
+		return newXenditGateway(cfg)
+	case "simulated":
+		if cfg.AppEnv == "production" {
+			return nil, fmt.Errorf("the simulated payment gateway is not allowed in production")
+		}
+		return payment.NewSimulatedGateway(payment.SimulatedOptions{
+			WebhookURL:    simulatedWebhookURL(cfg),
+			CallbackToken: os.Getenv("XENDIT_WEBHOOK_TOKEN"),
+			Outcome:       cfg.SimulatedPaymentOutcome,
+			Delay:         time.Duration(cfg.SimulatedPaymentDelaySeconds) * time.Second,
+			SuccessURL:    os.Getenv("SUCCESS_URL"),
+		})
+	default:
+		return nil, fmt.Errorf("unknown PAYMENT_GATEWAY %q", cfg.PaymentGateway)
 	}
+}
+
+// simulatedWebhookURL is where simulated payments post their webhooks,
+// by default this server's own payment webhook.
+func simulatedWebhookURL(cfg *config.Config) string {
+	if cfg.SimulatedWebhookURL != "" {
+		return cfg.SimulatedWebhookURL
+	}
+	return "http://localhost:" + cfg.AppPort + "/webhook/payment"
+}
+
+// newXenditGateway builds the payment gateway on a client with the
+// outbound proxy and CA settings.
+func newXenditGateway(cfg *config.Config) (payment.Gateway, error) {
+	client, err := outbound.NewClient(outbound.Options{
+		ProxyURL: cfg.GatewayProxyURL,
+		CABundle: cfg.GatewayCABundle,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("init payment gateway client: %w", err)
+	}
+	timeout := time.Duration(cfg.XenditTimeoutSeconds) * time.Second
+	return payment.NewXenditGateway(cfg.XenditSecretKey, client, timeout), nil
+}
+
+// newGraphQLServer sets up the transports, error handling and extensions
+// the storefront and admin endpoints share. It follows
+// handler.NewDefaultServer, except that multipart requests are capped at
+// the largest file the uploads module accepts.
+func newGraphQLServer(schema graphql.ExecutableSchema, complexityLimit int, maintenanceSvc maintenance.Service, quotaSvc quota.Service) *handler.Server {
+	srv := handler.New(schema)
+	srv.AddTransport(gqltransport.Websocket{KeepAlivePingInterval: 10 * time.Second})
+	srv.AddTransport(gqltransport.Options{})
+	srv.AddTransport(gqltransport.GET{})
+	srv.AddTransport(gqltransport.POST{})
+	srv.AddTransport(gqltransport.MultipartForm{
+		// Room for the operations and map fields next to the file.
+		MaxUploadSize: uploads.MaxFileSize + 1<<20,
+		MaxMemory:     8 << 20,
+	})
+	srv.SetQueryCache(lru.New[*ast.QueryDocument](1000))
+	srv.Use(extension.Introspection{})
+	srv.Use(extension.AutomaticPersistedQuery{Cache: lru.New[string](100)})
+	srv.SetRecoverFunc(graph.Recover)
+	srv.SetErrorPresenter(graph.PresentError)
+	srv.Use(extension.FixedComplexityLimit(complexityLimit))
+	srv.Use(graph.QueryTagger{})
+	srv.Use(graph.MaintenanceGuard{Svc: maintenanceSvc})
+	srv.Use(graph.UsageTracker{Svc: quotaSvc})
+	return srv
+}
 
-	srv := handler.NewDefaultServer(graph.NewSchema(resolver))
+// days converts a retention window in days from config; 0 stays 0 and
+// disables the policy.
+func days(n int) time.Duration {
+	return time.Duration(n) * 24 * time.Hour
+}
 
-	return setupRouter(srv, webhookHandler.PaymentWebhookHandler)
+// hours converts an SLA window in hours from config; 0 disables the rule.
+func hours(n int) time.Duration {
+	return time.Duration(n) * time.Hour
 }
 
-func setupRouter(srv *handler.Server, paymentWebhookHandler http.HandlerFunc) *http.ServeMux {
+func setupRouter(srv, adminSrv *handler.Server, apiKeys middleware.APIKeyAuthenticator, guests *guest.Signer, detector *geo.Detector, internalAPI, files http.Handler, paymentWebhookHandler, courierWebhookHandler http.HandlerFunc) *http.ServeMux {
 	mux := http.NewServeMux()
 
 	mux.Handle("/", playground.Handler("GraphQL Playground", "/query"))
 
-	graphqlHandler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		ctx := transport.WithHTTP(r.Context(), r, w)
-		srv.ServeHTTP(w, r.WithContext(ctx))
-	})
+	graphqlHandler := withHTTP(srv)
+	adminHandler := withHTTP(adminSrv)
 
 	mux.Handle("/query",
 		middleware.CORS(
 			middleware.LoggingMiddleware(
-				middleware.AuthMiddleware(
-					middleware.RateLimitMiddleware(graphqlHandler),
+				middleware.Recovery(
+					middleware.APIKeyMiddleware(apiKeys)(
+						middleware.GuestMiddleware(guests)(
+							middleware.GeoMiddleware(detector)(
+								middleware.AuthMiddleware(
+									middleware.RateLimitMiddleware(graphqlHandler),
+								),
+							),
+						),
+					),
+				),
+			),
+		),
+	)
+
+	// Admin schema: user tokens only, and only admins get as far as
+	// GraphQL.
+	mux.Handle("/admin/query",
+		middleware.CORS(
+			middleware.LoggingMiddleware(
+				middleware.Recovery(
+					middleware.AuthMiddleware(
+						middleware.RequireAdmin(
+							middleware.RateLimitMiddleware(adminHandler),
+						),
+					),
 				),
 			),
 		),
 	)
 
+	// Service-to-service order API; every route needs an API key.
+	mux.Handle("/internal/",
+		middleware.LoggingMiddleware(
+			middleware.Recovery(
+				middleware.APIKeyMiddleware(apiKeys)(
+					middleware.RateLimitMiddleware(internalAPI),
+				),
+			),
+		),
+	)
+
+	// Uploaded files, fetched by the URLs the upload mutations return.
+	mux.Handle("/uploads/", http.StripPrefix("/uploads", files))
+
 	// Apply RateLimitMiddleware to webhook (will use "strict" tier based on path)
-	mux.Handle("/webhook/payment", middleware.RateLimitMiddleware(paymentWebhookHandler))
+	mux.Handle("/webhook/payment", middleware.Recovery(middleware.RateLimitMiddleware(paymentWebhookHandler)))
+	mux.Handle("/webhook/courier", middleware.Recovery(middleware.RateLimitMiddleware(courierWebhookHandler)))
 
 	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.WriteHeader(http.StatusOK)
@@ -138,3 +642,11 @@ func setupRouter(srv *handler.Server, paymentWebhookHandler http.HandlerFunc) *h
 
 	return mux
 }
+
+// withHTTP gives resolvers access to the request and response, for cookies.
+func withHTTP(srv http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := transport.WithHTTP(r.Context(), r, w)
+		srv.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -1,14 +1,24 @@
 package main
 
 import (
+	"context"
 	"database/sql"
 	"database/sql/driver"
+	"errors"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"warimas-be/internal/config"
+	"warimas-be/internal/geo"
 	"warimas-be/internal/graph"
+	"warimas-be/internal/guest"
+	"warimas-be/internal/middleware"
+	"warimas-be/internal/uploads"
+	"warimas-be/internal/utils"
 
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/stretchr/testify/assert"
@@ -22,6 +32,7 @@ func TestSetupRouter(t *testing.T) {
 	// Assuming graph.NewSchema exists as per your main.go.
 	// If it's NewExecutableSchema in generated code, adjust accordingly.
 	srv := handler.NewDefaultServer(graph.NewSchema(resolver))
+	adminSrv := handler.NewDefaultServer(graph.NewAdminSchema(resolver))
 
 	// Mock webhook handler
 	mockWebhookHandler := func(w http.ResponseWriter, r *http.Request) {
@@ -29,8 +40,22 @@ func TestSetupRouter(t *testing.T) {
 		w.Write([]byte("webhook received"))
 	}
 
+	mockCourierHandler := func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("courier webhook received"))
+	}
+
+	mockInternalAPI := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("internal api"))
+	})
+
+	uploadsDir := t.TempDir()
+	assert.NoError(t, os.MkdirAll(filepath.Join(uploadsDir, "product_image"), 0o755))
+	assert.NoError(t, os.WriteFile(filepath.Join(uploadsDir, "product_image", "a.png"), []byte("png"), 0o644))
+
 	// 2. Create Router
-	router := setupRouter(srv, mockWebhookHandler)
+	router := setupRouter(srv, adminSrv, stubAPIKeys{}, guest.NewSigner("test-secret"), &geo.Detector{}, mockInternalAPI, uploads.FileServer(uploadsDir), mockWebhookHandler, mockCourierHandler)
 
 	// 3. Test /health
 	t.Run("Health Check", func(t *testing.T) {
@@ -64,6 +89,93 @@ func TestSetupRouter(t *testing.T) {
 		assert.Equal(t, http.StatusOK, rr.Code)
 		assert.Equal(t, "webhook received", rr.Body.String())
 	})
+
+	t.Run("Courier Webhook", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/webhook/courier", nil)
+		rr := httptest.NewRecorder()
+
+		router.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "courier webhook received", rr.Body.String())
+	})
+
+	t.Run("Invalid API Key", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/query", strings.NewReader(`{"query":"{ __typename }"}`))
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(middleware.APIKeyHeader, "wmk_000000000000_wrong")
+		rr := httptest.NewRecorder()
+
+		router.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("Admin Query Requires Admin", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/admin/query", strings.NewReader(`{"query":"{ __typename }"}`))
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+
+		router.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("Admin Fields Hidden From Storefront", func(t *testing.T) {
+		req, _ := http.NewRequest("POST", "/query", strings.NewReader(`{"query":"{ logSettings { level } }"}`))
+		req.Header.Set("Content-Type", "application/json")
+		rr := httptest.NewRecorder()
+
+		router.ServeHTTP(rr, req)
+
+		assert.Contains(t, rr.Body.String(), "Cannot query field")
+	})
+
+	t.Run("Internal API", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/internal/v1/orders/ord-1", nil)
+		rr := httptest.NewRecorder()
+
+		router.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "internal api", rr.Body.String())
+	})
+
+	t.Run("Internal API Invalid Key", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/internal/v1/orders/ord-1", nil)
+		req.Header.Set(middleware.APIKeyHeader, "wmk_000000000000_wrong")
+		rr := httptest.NewRecorder()
+
+		router.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+	})
+
+	t.Run("Uploaded File", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/uploads/product_image/a.png", nil)
+		rr := httptest.NewRecorder()
+
+		router.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		assert.Equal(t, "png", rr.Body.String())
+	})
+
+	t.Run("Uploads Not Listed", func(t *testing.T) {
+		req, _ := http.NewRequest("GET", "/uploads/product_image/", nil)
+		rr := httptest.NewRecorder()
+
+		router.ServeHTTP(rr, req)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+}
+
+// stubAPIKeys rejects every key.
+type stubAPIKeys struct{}
+
+func (stubAPIKeys) Authenticate(context.Context, string) (*utils.ServicePrincipal, error) {
+	return nil, errors.New("invalid api key")
 }
 
 func TestNewServer(t *testing.T) {
@@ -80,7 +192,8 @@ func TestNewServer(t *testing.T) {
 	}
 
 	// 3. Call newServer (The function we want to cover)
-	router := newServer(cfg, db)
+	router, err := newServer(cfg, db)
+	assert.NoError(t, err)
 
 	// 4. Assertions
 	assert.NotNil(t, router)
@@ -91,6 +204,34 @@ func TestNewServer(t *testing.T) {
 	assert.Equal(t, http.StatusOK, rr.Code)
 }
 
+func TestNewServer_BadGatewayProxy(t *testing.T) {
+	db, err := sql.Open("mock_driver_main", "")
+	assert.NoError(t, err)
+
+	cfg := &config.Config{AppEnv: "test", GatewayProxyURL: "proxy.internal:3128"}
+
+	_, err = newServer(cfg, db)
+	assert.ErrorContains(t, err, "init payment gateway client")
+}
+
+func TestNewGateway(t *testing.T) {
+	t.Run("simulated refused in production", func(t *testing.T) {
+		_, err := newGateway(&config.Config{AppEnv: "production", PaymentGateway: "simulated"})
+		assert.ErrorContains(t, err, "not allowed in production")
+	})
+
+	t.Run("simulated outside production", func(t *testing.T) {
+		gw, err := newGateway(&config.Config{AppEnv: "development", AppPort: "8080", PaymentGateway: "simulated", SimulatedPaymentOutcome: "PAID"})
+		assert.NoError(t, err)
+		assert.NotNil(t, gw)
+	})
+
+	t.Run("unknown gateway", func(t *testing.T) {
+		_, err := newGateway(&config.Config{PaymentGateway: "stripe"})
+		assert.ErrorContains(t, err, "unknown PAYMENT_GATEWAY")
+	})
+}
+
 // --- Mock Driver for Testing ---
 type mockDriver struct{}
 
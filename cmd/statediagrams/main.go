@@ -0,0 +1,58 @@
+// Command statediagrams writes docs/state-machines.md from the status
+// machines declared in the order and refund packages, so the diagrams
+// cannot drift from the rules the services enforce.
+//
+//	go generate ./cmd/statediagrams
+package main
+
+//go:generate go run . -o ../../docs/state-machines.md
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"warimas-be/internal/order"
+	"warimas-be/internal/refund"
+)
+
+// diagram is what every statemachine.Machine offers, whatever its types.
+type diagram interface {
+	Name() string
+	Mermaid() string
+}
+
+var machines = []diagram{
+	order.OrderStatuses,
+	order.SessionStatuses,
+	refund.Statuses,
+}
+
+func main() {
+	out := flag.String("o", "docs/state-machines.md", "file to write")
+	flag.Parse()
+
+	if err := os.WriteFile(*out, render(machines), 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func render(ms []diagram) []byte {
+	var b bytes.Buffer
+	b.WriteString("# Status State Machines\n\n")
+	b.WriteString("Generated by `go generate ./cmd/statediagrams` from the machines in\n")
+	b.WriteString("`internal/order/states.go` and `internal/refund/model.go`. Do not edit.\n")
+	for _, m := range ms {
+		fmt.Fprintf(&b, "\n## %s\n\n", title(m.Name()))
+		b.WriteString("```mermaid\n")
+		b.WriteString(m.Mermaid())
+		b.WriteString("```\n")
+	}
+	return b.Bytes()
+}
+
+func title(name string) string {
+	return strings.ToUpper(name[:1]) + name[1:]
+}
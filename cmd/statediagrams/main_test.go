@@ -0,0 +1,17 @@
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDocsUpToDate(t *testing.T) {
+	current, err := os.ReadFile("../../docs/state-machines.md")
+	require.NoError(t, err)
+
+	assert.Equal(t, string(render(machines)), string(current),
+		"docs/state-machines.md is out of date; run go generate ./cmd/statediagrams")
+}
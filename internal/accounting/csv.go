@@ -0,0 +1,43 @@
+package accounting
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+)
+
+var csvHeader = []string{"Date", "Entry", "Journal", "Reference", "Account Code", "Account Name", "Debit", "Credit", "Memo"}
+
+// WriteCSV writes entries one line per row, numbered in order so the
+// importing software can group the rows of an entry. Dates are Jakarta
+// calendar days in loc and amounts are whole rupiah; the unused side of a
+// row is 0.
+func WriteCSV(w io.Writer, entries []*Entry, loc *time.Location) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+
+	for i, e := range entries {
+		date := e.Date.In(loc).Format(time.DateOnly)
+		for _, l := range e.Lines {
+			if err := cw.Write([]string{
+				date,
+				strconv.Itoa(i + 1),
+				string(e.Journal),
+				e.Reference,
+				l.Account.Code,
+				l.Account.Name,
+				strconv.FormatInt(l.Debit, 10),
+				strconv.FormatInt(l.Credit, 10),
+				e.Memo,
+			}); err != nil {
+				return err
+			}
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,14 @@
+package accounting
+
+import (
+	"errors"
+	"warimas-be/internal/apperr"
+)
+
+var (
+	ErrUnauthenticated = apperr.Unauthenticated("unauthenticated")
+	ErrForbidden       = apperr.Forbidden("forbidden")
+	ErrInvalidPeriod   = errors.New("period must be two YYYY-MM-DD dates, from not after to, at most 366 days apart")
+	ErrInvalidFees     = errors.New("settlement fees need a payment request id and a fee of at least 0, at most 5000 rows at a time")
+	ErrDB              = errors.New("database error")
+)
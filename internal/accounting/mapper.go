@@ -0,0 +1,14 @@
+package accounting
+
+import "warimas-be/internal/graph/model"
+
+func MapExportToGraphQL(e *Export) *model.JournalExport {
+	return &model.JournalExport{
+		From:        e.From,
+		To:          e.To,
+		EntryCount:  int32(len(e.Entries)),
+		TotalDebit:  int32(e.TotalDebit),
+		TotalCredit: int32(e.TotalCredit),
+		CSV:         e.CSV,
+	}
+}
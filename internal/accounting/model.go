@@ -0,0 +1,110 @@
+package accounting
+
+import "time"
+
+// Account is a ledger account in the finance team's chart of accounts.
+type Account struct {
+	Code string
+	Name string
+}
+
+var (
+	AccountPaymentClearing   = Account{"1100", "Payment clearing"}
+	AccountCustomerWallets   = Account{"2100", "Customer wallets"}
+	AccountSellerPayables    = Account{"2200", "Seller payables"}
+	AccountTaxPayable        = Account{"2300", "Tax payable"}
+	AccountCommissionRevenue = Account{"4100", "Commission revenue"}
+	AccountShippingCollected = Account{"4200", "Shipping collected"}
+	AccountSalesRefunds      = Account{"4900", "Sales refunds"}
+	AccountPaymentFees       = Account{"6100", "Payment fees"}
+)
+
+type Journal string
+
+const (
+	JournalSales       Journal = "SALES"
+	JournalPaymentFees Journal = "PAYMENT_FEES"
+	JournalRefunds     Journal = "REFUNDS"
+	JournalCommissions Journal = "COMMISSIONS"
+)
+
+// MaxPeriodDays bounds one export so it stays a single response.
+const MaxPeriodDays = 366
+
+// MaxSettlementFees bounds the rows of one settlement report upload.
+const MaxSettlementFees = 5000
+
+// Line is one side of a journal entry; exactly one of Debit and Credit is
+// set.
+type Line struct {
+	Account Account
+	Debit   int64
+	Credit  int64
+}
+
+// Entry is a balanced journal entry: its debits equal its credits.
+type Entry struct {
+	Date      time.Time
+	Journal   Journal
+	Reference string
+	Memo      string
+	Lines     []Line
+}
+
+// Export is the journal of one period, Jakarta calendar days inclusive.
+type Export struct {
+	From        string
+	To          string
+	Entries     []*Entry
+	TotalDebit  int64
+	TotalCredit int64
+	CSV         string
+}
+
+// FeeSchedule estimates what the payment gateway keeps of a captured
+// payment whose fee it has not reported.
+type FeeSchedule struct {
+	RateBps int64
+	Fixed   int64
+}
+
+// Sale is an order that became PAID in the period, with the amounts its
+// journal entry splits.
+type Sale struct {
+	OrderID    int32
+	ExternalID string
+	PaidAt     time.Time
+	Tax        int64
+	// ShippingFee includes the insurance fee; both are passed on to the
+	// courier.
+	ShippingFee  int64
+	TotalAmount  int64
+	WalletAmount int64
+	Commission   int64
+}
+
+// GatewayPayment is a payment the gateway captured in the period. Fee is
+// nil until the gateway reports it.
+type GatewayPayment struct {
+	ExternalReference string
+	OrderExternalID   string
+	Amount            int64
+	Fee               *int64
+	PaidAt            time.Time
+}
+
+// SettlementFee is one row of the gateway's settlement report: the fee it
+// kept of the payment request.
+type SettlementFee struct {
+	PaymentRequestID string
+	Fee              int64
+}
+
+// Refund is a refund completed in the period.
+type Refund struct {
+	ID              int64
+	OrderExternalID string
+	Method          string
+	Amount          int64
+	CompletedAt     time.Time
+}
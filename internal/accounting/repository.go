@@ -0,0 +1,192 @@
+package accounting
+
+import (
+	"context"
+	"database/sql"
+	"time"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/order"
+	"warimas-be/internal/payment"
+	"warimas-be/internal/refund"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+// Repository reads the source rows of a journal export. Every method takes
+// the half-open window [from, to).
+type Repository interface {
+	// Sales returns the orders that first became PAID in the window,
+	// oldest first.
+	Sales(ctx context.Context, from, to time.Time) ([]*Sale, error)
+	// GatewayPayments returns the gateway payments captured in the window.
+	// Wallet and offline payments carry no gateway fee and are left out.
+	GatewayPayments(ctx context.Context, from, to time.Time) ([]*GatewayPayment, error)
+	// Refunds returns the refunds completed in the window.
+	Refunds(ctx context.Context, from, to time.Time) ([]*Refund, error)
+	// RecordFees writes settlement report fees onto their gateway
+	// payments, replacing any fee the capture webhook reported, and
+	// returns how many payments matched.
+	RecordFees(ctx context.Context, fees []*SettlementFee) (int64, error)
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) Sales(ctx context.Context, from, to time.Time) ([]*Sale, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "Sales"),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT o.id, o.external_id, h.paid_at,
+		       o.tax, o.shipping_fee + o.insurance_fee, o.total_amount, o.wallet_amount,
+		       COALESCE((SELECT SUM(oi.commission_amount) FROM order_items oi WHERE oi.order_id = o.id), 0)
+		FROM orders o
+		JOIN (
+			SELECT order_id, MIN(changed_at) AS paid_at
+			FROM order_status_history
+			WHERE to_status = $1
+			GROUP BY order_id
+		) h ON h.order_id = o.id
+		WHERE h.paid_at >= $2
+		  AND h.paid_at < $3
+		ORDER BY h.paid_at, o.id
+	`, string(order.OrderStatusPaid), from, to)
+	if err != nil {
+		log.Error("failed to query sales", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	list := []*Sale{}
+	for rows.Next() {
+		var s Sale
+		if err := rows.Scan(
+			&s.OrderID, &s.ExternalID, &s.PaidAt,
+			&s.Tax, &s.ShippingFee, &s.TotalAmount, &s.WalletAmount,
+			&s.Commission,
+		); err != nil {
+			log.Error("failed to scan sale", zap.Error(err))
+			return nil, ErrDB
+		}
+		list = append(list, &s)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error("sale iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return list, nil
+}
+
+func (r *repository) GatewayPayments(ctx context.Context, from, to time.Time) ([]*GatewayPayment, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "GatewayPayments"),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT p.external_reference, o.external_id,
+		       COALESCE(p.captured_amount, p.amount), p.fee_amount, p.paid_at
+		FROM payments p
+		JOIN orders o ON o.id = p.order_id
+		WHERE p.provider = $1
+		  AND p.status = $2
+		  AND p.paid_at >= $3
+		  AND p.paid_at < $4
+		ORDER BY p.paid_at, p.id
+	`, payment.ProviderXendit, string(order.PaymentStatusPaid), from, to)
+	if err != nil {
+		log.Error("failed to query gateway payments", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	list := []*GatewayPayment{}
+	for rows.Next() {
+		var p GatewayPayment
+		if err := rows.Scan(&p.ExternalReference, &p.OrderExternalID, &p.Amount, &p.Fee, &p.PaidAt); err != nil {
+			log.Error("failed to scan gateway payment", zap.Error(err))
+			return nil, ErrDB
+		}
+		list = append(list, &p)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error("gateway payment iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return list, nil
+}
+
+func (r *repository) Refunds(ctx context.Context, from, to time.Time) ([]*Refund, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "Refunds"),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT r.id, o.external_id, r.method, r.amount, r.completed_at
+		FROM refunds r
+		JOIN orders o ON o.id = r.order_id
+		WHERE r.status = $1
+		  AND r.completed_at >= $2
+		  AND r.completed_at < $3
+		ORDER BY r.completed_at, r.id
+	`, string(refund.StatusCompleted), from, to)
+	if err != nil {
+		log.Error("failed to query refunds", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	list := []*Refund{}
+	for rows.Next() {
+		var rf Refund
+		if err := rows.Scan(&rf.ID, &rf.OrderExternalID, &rf.Method, &rf.Amount, &rf.CompletedAt); err != nil {
+			log.Error("failed to scan refund", zap.Error(err))
+			return nil, ErrDB
+		}
+		list = append(list, &rf)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error("refund iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return list, nil
+}
+
+func (r *repository) RecordFees(ctx context.Context, fees []*SettlementFee) (int64, error) {
+	refs := make([]string, 0, len(fees))
+	amounts := make([]int64, 0, len(fees))
+	for _, f := range fees {
+		refs = append(refs, f.PaymentRequestID)
+		amounts = append(amounts, f.Fee)
+	}
+
+	res, err := r.db.ExecContext(ctx, `
+		UPDATE payments p
+		SET fee_amount = f.fee
+		FROM unnest($1::text[], $2::bigint[]) AS f(external_reference, fee)
+		WHERE p.external_reference = f.external_reference
+		  AND p.provider = $3
+	`, pq.Array(refs), pq.Array(amounts), payment.ProviderXendit)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to record settlement fees", zap.Int("rows", len(fees)), zap.Error(err))
+		return 0, ErrDB
+	}
+
+	n, _ := res.RowsAffected()
+	return n, nil
+}
@@ -0,0 +1,105 @@
+package accounting
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Sales(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	mock.ExpectQuery(`FROM order_status_history WHERE to_status = \$1 GROUP BY order_id`).
+		WithArgs("PAID", from, to).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "external_id", "paid_at", "tax", "shipping_fee", "total_amount", "wallet_amount", "commission",
+		}).AddRow(7, "ORD-7", from.Add(time.Hour), 11000, 9000, 120000, 20000, 5000))
+
+	list, err := repo.Sales(context.Background(), from, to)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, int64(5000), list[0].Commission)
+
+	mock.ExpectQuery(`FROM order_status_history`).WillReturnError(assert.AnError)
+	_, err = repo.Sales(context.Background(), from, to)
+	assert.ErrorIs(t, err, ErrDB)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_GatewayPayments(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	mock.ExpectQuery(`COALESCE\(p.captured_amount, p.amount\)`).
+		WithArgs("XENDIT", "PAID", from, to).
+		WillReturnRows(sqlmock.NewRows([]string{"external_reference", "external_id", "amount", "fee_amount", "paid_at"}).
+			AddRow("ORD-7-PAY", "ORD-7", 100000, 4440, from).
+			AddRow("ORD-8-PAY", "ORD-8", 50000, nil, from))
+
+	list, err := repo.GatewayPayments(context.Background(), from, to)
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.Equal(t, "ORD-7", list[0].OrderExternalID)
+	assert.Equal(t, int64(4440), *list[0].Fee)
+	assert.Nil(t, list[1].Fee)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_Refunds(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	from := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	mock.ExpectQuery(`FROM refunds r JOIN orders o ON o.id = r.order_id WHERE r.status = \$1`).
+		WithArgs("COMPLETED", from, to).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "external_id", "method", "amount", "completed_at"}).
+			AddRow(3, "ORD-2", "GATEWAY", 15000, from))
+
+	list, err := repo.Refunds(context.Background(), from, to)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, "GATEWAY", list[0].Method)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_RecordFees(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	fees := []*SettlementFee{{PaymentRequestID: "pr-1", Fee: 4440}, {PaymentRequestID: "pr-2", Fee: 2220}}
+
+	mock.ExpectExec(`UPDATE payments p SET fee_amount = f.fee FROM unnest\(\$1::text\[\], \$2::bigint\[\]\)`).
+		WithArgs(pq.Array([]string{"pr-1", "pr-2"}), pq.Array([]int64{4440, 2220}), "XENDIT").
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	n, err := repo.RecordFees(context.Background(), fees)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), n)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
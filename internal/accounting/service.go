@@ -0,0 +1,239 @@
+package accounting
+
+import (
+	"bytes"
+	"context"
+	"sort"
+	"time"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/refund"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+// Service builds the double-entry journal finance imports each period.
+type Service interface {
+	// Export builds the journal of the Jakarta calendar days from to to,
+	// both inclusive and given as YYYY-MM-DD. Admin only.
+	Export(ctx context.Context, from, to string) (*Export, error)
+	// RecordSettlementFees stores the fees of a gateway settlement report
+	// and returns how many payments they matched. Admin only.
+	RecordSettlementFees(ctx context.Context, fees []*SettlementFee) (int64, error)
+}
+
+type service struct {
+	repo Repository
+	fees FeeSchedule
+	loc  *time.Location
+}
+
+func NewService(repo Repository, fees FeeSchedule) Service {
+	loc, err := time.LoadLocation("Asia/Jakarta")
+	if err != nil {
+		logger.L().Error("failed to load Jakarta location, defaulting to UTC", zap.Error(err))
+		loc = time.UTC
+	}
+	return &service{repo: repo, fees: fees, loc: loc}
+}
+
+func (s *service) Export(ctx context.Context, from, to string) (*Export, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "Export"),
+		zap.String("from", from),
+		zap.String("to", to),
+	)
+
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	start, err := time.ParseInLocation(time.DateOnly, from, s.loc)
+	if err != nil {
+		return nil, ErrInvalidPeriod
+	}
+	last, err := time.ParseInLocation(time.DateOnly, to, s.loc)
+	if err != nil || last.Before(start) || last.Sub(start) >= MaxPeriodDays*24*time.Hour {
+		return nil, ErrInvalidPeriod
+	}
+	end := last.AddDate(0, 0, 1)
+
+	sales, err := s.repo.Sales(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+	payments, err := s.repo.GatewayPayments(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+	refunds, err := s.repo.Refunds(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*Entry
+	for _, sale := range sales {
+		entries = append(entries, saleEntries(sale)...)
+	}
+	for _, p := range payments {
+		if e := s.feeEntry(p); e != nil {
+			entries = append(entries, e)
+		}
+	}
+	for _, rf := range refunds {
+		entries = append(entries, refundEntry(rf))
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Date.Before(entries[j].Date)
+	})
+
+	out := &Export{From: from, To: to, Entries: entries}
+	for _, e := range entries {
+		for _, l := range e.Lines {
+			out.TotalDebit += l.Debit
+			out.TotalCredit += l.Credit
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, entries, s.loc); err != nil {
+		log.Error("failed to write journal csv", zap.Error(err))
+		return nil, err
+	}
+	out.CSV = buf.String()
+
+	log.Info("journal exported",
+		zap.Int("entries", len(entries)),
+		zap.Int64("total_debit", out.TotalDebit),
+	)
+	return out, nil
+}
+
+// saleEntries books an order's collection against what is owed to its
+// sellers, the tax office and the shipping account, then moves the
+// commission charged on its lines from the sellers to revenue.
+func saleEntries(sale *Sale) []*Entry {
+	if sale.TotalAmount <= 0 {
+		return nil
+	}
+
+	e := &Entry{
+		Date:      sale.PaidAt,
+		Journal:   JournalSales,
+		Reference: sale.ExternalID,
+		Memo:      "Order paid",
+	}
+	e.Lines = appendDebit(e.Lines, AccountPaymentClearing, sale.TotalAmount-sale.WalletAmount)
+	e.Lines = appendDebit(e.Lines, AccountCustomerWallets, sale.WalletAmount)
+	e.Lines = appendCredit(e.Lines, AccountSellerPayables, sale.TotalAmount-sale.Tax-sale.ShippingFee)
+	e.Lines = appendCredit(e.Lines, AccountTaxPayable, sale.Tax)
+	e.Lines = appendCredit(e.Lines, AccountShippingCollected, sale.ShippingFee)
+	entries := []*Entry{e}
+
+	if sale.Commission > 0 {
+		entries = append(entries, &Entry{
+			Date:      sale.PaidAt,
+			Journal:   JournalCommissions,
+			Reference: sale.ExternalID,
+			Memo:      "Marketplace commission",
+			Lines: []Line{
+				{Account: AccountSellerPayables, Debit: sale.Commission},
+				{Account: AccountCommissionRevenue, Credit: sale.Commission},
+			},
+		})
+	}
+	return entries
+}
+
+func (s *service) RecordSettlementFees(ctx context.Context, fees []*SettlementFee) (int64, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "RecordSettlementFees"),
+	)
+
+	if err := requireAdmin(ctx); err != nil {
+		return 0, err
+	}
+
+	if len(fees) == 0 || len(fees) > MaxSettlementFees {
+		return 0, ErrInvalidFees
+	}
+	for _, f := range fees {
+		if f.PaymentRequestID == "" || f.Fee < 0 {
+			return 0, ErrInvalidFees
+		}
+	}
+
+	n, err := s.repo.RecordFees(ctx, fees)
+	if err != nil {
+		return 0, err
+	}
+
+	log.Info("settlement fees recorded", zap.Int("rows", len(fees)), zap.Int64("matched", n))
+	return n, nil
+}
+
+// feeEntry books what the gateway kept of a captured payment, or what the
+// schedule estimates while it has not said; nil when that is nothing.
+func (s *service) feeEntry(p *GatewayPayment) *Entry {
+	fee := (p.Amount*s.fees.RateBps+5000)/10000 + s.fees.Fixed
+	if p.Fee != nil {
+		fee = *p.Fee
+	}
+	if fee <= 0 {
+		return nil
+	}
+	return &Entry{
+		Date:      p.PaidAt,
+		Journal:   JournalPaymentFees,
+		Reference: p.ExternalReference,
+		Memo:      "Gateway fee for " + p.OrderExternalID,
+		Lines: []Line{
+			{Account: AccountPaymentFees, Debit: fee},
+			{Account: AccountPaymentClearing, Credit: fee},
+		},
+	}
+}
+
+// refundEntry books a refund paid back through the channel it went out on.
+func refundEntry(rf *Refund) *Entry {
+	paidFrom := AccountPaymentClearing
+	if rf.Method == string(refund.MethodWallet) {
+		paidFrom = AccountCustomerWallets
+	}
+	return &Entry{
+		Date:      rf.CompletedAt,
+		Journal:   JournalRefunds,
+		Reference: rf.OrderExternalID,
+		Memo:      "Refund to " + rf.Method,
+		Lines: []Line{
+			{Account: AccountSalesRefunds, Debit: rf.Amount},
+			{Account: paidFrom, Credit: rf.Amount},
+		},
+	}
+}
+
+func appendDebit(lines []Line, a Account, amount int64) []Line {
+	if amount <= 0 {
+		return lines
+	}
+	return append(lines, Line{Account: a, Debit: amount})
+}
+
+func appendCredit(lines []Line, a Account, amount int64) []Line {
+	if amount <= 0 {
+		return lines
+	}
+	return append(lines, Line{Account: a, Credit: amount})
+}
+
+func requireAdmin(ctx context.Context) error {
+	if _, ok := utils.GetUserIDFromContext(ctx); !ok {
+		return ErrUnauthenticated
+	}
+	if !utils.IsAdmin(ctx) {
+		return ErrForbidden
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+package accounting
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+	"warimas-be/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Mocks ---
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) Sales(ctx context.Context, from, to time.Time) ([]*Sale, error) {
+	args := m.Called(ctx, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Sale), args.Error(1)
+}
+
+func (m *MockRepository) GatewayPayments(ctx context.Context, from, to time.Time) ([]*GatewayPayment, error) {
+	args := m.Called(ctx, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*GatewayPayment), args.Error(1)
+}
+
+func (m *MockRepository) Refunds(ctx context.Context, from, to time.Time) ([]*Refund, error) {
+	args := m.Called(ctx, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Refund), args.Error(1)
+}
+
+func (m *MockRepository) RecordFees(ctx context.Context, fees []*SettlementFee) (int64, error) {
+	args := m.Called(ctx, fees)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// --- Tests ---
+
+var (
+	adminCtx = utils.SetUserContext(context.Background(), 1, "admin@example.com", "ADMIN")
+	jakarta  = time.FixedZone("WIB", 7*60*60)
+)
+
+func newTestService(repo Repository, fees FeeSchedule) *service {
+	return &service{repo: repo, fees: fees, loc: jakarta}
+}
+
+func TestService_Export(t *testing.T) {
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, jakarta)
+	end := time.Date(2026, 4, 1, 0, 0, 0, 0, jakarta)
+	paidAt := time.Date(2026, 3, 4, 23, 30, 0, 0, time.UTC) // 5 March in Jakarta
+
+	t.Run("BalancedEntries", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := newTestService(mockRepo, FeeSchedule{RateBps: 290, Fixed: 1000})
+
+		mockRepo.On("Sales", adminCtx, start, end).Return([]*Sale{{
+			OrderID:      7,
+			ExternalID:   "ORD-7",
+			PaidAt:       paidAt,
+			Tax:          11000,
+			ShippingFee:  9000,
+			TotalAmount:  120000,
+			WalletAmount: 20000,
+			Commission:   5000,
+		}}, nil)
+		mockRepo.On("GatewayPayments", adminCtx, start, end).Return([]*GatewayPayment{{
+			ExternalReference: "ORD-7-PAY",
+			OrderExternalID:   "ORD-7",
+			Amount:            100000,
+			PaidAt:            paidAt,
+		}}, nil)
+		mockRepo.On("Refunds", adminCtx, start, end).Return([]*Refund{{
+			ID:              3,
+			OrderExternalID: "ORD-2",
+			Method:          "WALLET",
+			Amount:          15000,
+			CompletedAt:     paidAt.Add(-time.Hour),
+		}}, nil)
+
+		out, err := svc.Export(adminCtx, "2026-03-01", "2026-03-31")
+		require.NoError(t, err)
+
+		require.Len(t, out.Entries, 4)
+		assert.Equal(t, JournalRefunds, out.Entries[0].Journal)
+		assert.Equal(t, JournalSales, out.Entries[1].Journal)
+		assert.Equal(t, JournalCommissions, out.Entries[2].Journal)
+		assert.Equal(t, JournalPaymentFees, out.Entries[3].Journal)
+
+		for _, e := range out.Entries {
+			var debit, credit int64
+			for _, l := range e.Lines {
+				debit += l.Debit
+				credit += l.Credit
+			}
+			assert.Equal(t, debit, credit, "entry %s %s", e.Journal, e.Reference)
+		}
+		assert.Equal(t, out.TotalDebit, out.TotalCredit)
+
+		sale := out.Entries[1]
+		assert.Equal(t, []Line{
+			{Account: AccountPaymentClearing, Debit: 100000},
+			{Account: AccountCustomerWallets, Debit: 20000},
+			{Account: AccountSellerPayables, Credit: 100000},
+			{Account: AccountTaxPayable, Credit: 11000},
+			{Account: AccountShippingCollected, Credit: 9000},
+		}, sale.Lines)
+
+		// 2.9% of 100000 plus the flat fee
+		assert.Equal(t, int64(3900), out.Entries[3].Lines[0].Debit)
+
+		rows := strings.Split(strings.TrimSpace(out.CSV), "\n")
+		assert.Equal(t, "Date,Entry,Journal,Reference,Account Code,Account Name,Debit,Credit,Memo", rows[0])
+		assert.Equal(t, "2026-03-05,1,REFUNDS,ORD-2,4900,Sales refunds,15000,0,Refund to WALLET", rows[1])
+		assert.Equal(t, "2026-03-05,1,REFUNDS,ORD-2,2100,Customer wallets,0,15000,Refund to WALLET", rows[2])
+		assert.Len(t, rows, 1+2+5+2+2)
+	})
+
+	t.Run("RecordedFeeWins", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := newTestService(mockRepo, FeeSchedule{RateBps: 290})
+
+		fee := int64(4440)
+		mockRepo.On("Sales", adminCtx, start, end).Return([]*Sale{}, nil)
+		mockRepo.On("GatewayPayments", adminCtx, start, end).Return([]*GatewayPayment{{Amount: 100000, Fee: &fee, PaidAt: paidAt}}, nil)
+		mockRepo.On("Refunds", adminCtx, start, end).Return([]*Refund{}, nil)
+
+		out, err := svc.Export(adminCtx, "2026-03-01", "2026-03-31")
+		require.NoError(t, err)
+		require.Len(t, out.Entries, 1)
+		assert.Equal(t, fee, out.Entries[0].Lines[0].Debit)
+	})
+
+	t.Run("NoFeeSchedule", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := newTestService(mockRepo, FeeSchedule{})
+
+		mockRepo.On("Sales", adminCtx, start, end).Return([]*Sale{}, nil)
+		mockRepo.On("GatewayPayments", adminCtx, start, end).Return([]*GatewayPayment{{Amount: 50000, PaidAt: paidAt}}, nil)
+		mockRepo.On("Refunds", adminCtx, start, end).Return([]*Refund{}, nil)
+
+		out, err := svc.Export(adminCtx, "2026-03-01", "2026-03-31")
+		require.NoError(t, err)
+		assert.Empty(t, out.Entries)
+	})
+
+	t.Run("InvalidPeriod", func(t *testing.T) {
+		svc := newTestService(new(MockRepository), FeeSchedule{})
+
+		for _, p := range [][2]string{
+			{"2026-03", "2026-03-31"},
+			{"2026-03-31", "2026-03-01"},
+			{"2026-01-01", "2027-01-02"},
+		} {
+			_, err := svc.Export(adminCtx, p[0], p[1])
+			assert.ErrorIs(t, err, ErrInvalidPeriod, "%v", p)
+		}
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		svc := newTestService(new(MockRepository), FeeSchedule{})
+		userCtx := utils.SetUserContext(context.Background(), 2, "user@example.com", "USER")
+
+		_, err := svc.Export(userCtx, "2026-03-01", "2026-03-31")
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+}
+
+func TestService_RecordSettlementFees(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := newTestService(mockRepo, FeeSchedule{})
+
+		fees := []*SettlementFee{{PaymentRequestID: "pr-1", Fee: 4440}, {PaymentRequestID: "pr-2", Fee: 0}}
+		mockRepo.On("RecordFees", adminCtx, fees).Return(int64(1), nil)
+
+		n, err := svc.RecordSettlementFees(adminCtx, fees)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), n)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		svc := newTestService(new(MockRepository), FeeSchedule{})
+
+		for _, fees := range [][]*SettlementFee{
+			nil,
+			{{PaymentRequestID: "", Fee: 100}},
+			{{PaymentRequestID: "pr-1", Fee: -1}},
+		} {
+			_, err := svc.RecordSettlementFees(adminCtx, fees)
+			assert.ErrorIs(t, err, ErrInvalidFees)
+		}
+	})
+}
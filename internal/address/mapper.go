@@ -1,6 +1,9 @@
 package address
 
-import "warimas-be/internal/graph/model"
+import (
+	"warimas-be/internal/geo"
+	"warimas-be/internal/graph/model"
+)
 
 func MapAddressToGraphQL(a *Address) *model.Address {
 	return &model.Address{
@@ -14,6 +17,7 @@ func MapAddressToGraphQL(a *Address) *model.Address {
 		Province:     a.Province,
 		PostalCode:   a.Postal,
 		Country:      a.Country,
+		Location:     geo.MapPointToGraphQL(a.Location),
 		IsDefault:    a.IsDefault,
 	}
 }
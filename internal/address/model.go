@@ -1,6 +1,8 @@
 package address
 
 import (
+	"warimas-be/internal/geo"
+
 	"github.com/google/uuid"
 )
 
@@ -20,6 +22,10 @@ type Address struct {
 	Postal   string
 	Country  string
 
+	// Location is where the customer pinned the address, nil when they
+	// did not. Instant delivery needs it.
+	Location *geo.Point
+
 	IsDefault bool
 	IsActive  bool
 }
@@ -34,6 +40,7 @@ type CreateAddressInput struct {
 	Province     string
 	PostalCode   string
 	Country      string
+	Location     *geo.Point
 	SetAsDefault bool
 }
 
@@ -48,5 +55,6 @@ type UpdateAddressInput struct {
 	Province     string
 	PostalCode   string
 	Country      string
+	Location     *geo.Point
 	SetAsDefault bool
 }
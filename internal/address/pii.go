@@ -0,0 +1,70 @@
+package address
+
+import (
+	"warimas-be/internal/geo"
+	"warimas-be/internal/pii"
+)
+
+// EncryptedTable lists the address columns stored through pii.Encrypt.
+var EncryptedTable = pii.Table{
+	Name:    "addresses",
+	Key:     "id",
+	Columns: []string{"receiver_name", "phone", "address_line1", "address_line2", "location"},
+}
+
+// sealed returns a copy of a with its sensitive fields encrypted for
+// storage.
+func sealed(a *Address) (*Address, error) {
+	out := *a
+
+	var err error
+	if out.ReceiverName, err = pii.Encrypt(a.ReceiverName); err != nil {
+		return nil, err
+	}
+	if out.Phone, err = pii.Encrypt(a.Phone); err != nil {
+		return nil, err
+	}
+	if out.Address1, err = pii.Encrypt(a.Address1); err != nil {
+		return nil, err
+	}
+	if out.Address2, err = pii.EncryptPtr(a.Address2); err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// sealedLocation encrypts a's location for the location column.
+func sealedLocation(a *Address) (*string, error) {
+	if a.Location == nil {
+		return nil, nil
+	}
+	loc := a.Location.String()
+	return pii.EncryptPtr(&loc)
+}
+
+// open decrypts the sensitive fields of a freshly scanned address in
+// place, setting its location from the scanned location column.
+func open(a *Address, location *string) error {
+	var err error
+	if a.ReceiverName, err = pii.Decrypt(a.ReceiverName); err != nil {
+		return err
+	}
+	if a.Phone, err = pii.Decrypt(a.Phone); err != nil {
+		return err
+	}
+	if a.Address1, err = pii.Decrypt(a.Address1); err != nil {
+		return err
+	}
+	if a.Address2, err = pii.DecryptPtr(a.Address2); err != nil {
+		return err
+	}
+	if location, err = pii.DecryptPtr(location); err != nil || location == nil {
+		return err
+	}
+	p, err := geo.Parse(*location)
+	if err != nil {
+		return err
+	}
+	a.Location = &p
+	return nil
+}
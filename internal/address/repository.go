@@ -48,7 +48,7 @@ func (r *repository) GetByUserID(
 			name, phone,
 			address_line1, address_line2,
 			city, province, postal_code, country,
-			is_default, is_active, receiver_name
+			is_default, is_active, receiver_name, location
 		FROM addresses
 		WHERE user_id = $1
 		  AND is_active = true
@@ -66,16 +66,21 @@ func (r *repository) GetByUserID(
 
 	for rows.Next() {
 		var a Address
+		var location *string
 		if err := rows.Scan(
 			&a.ID, &a.UserID,
 			&a.Name, &a.Phone,
 			&a.Address1, &a.Address2,
 			&a.City, &a.Province, &a.Postal, &a.Country,
-			&a.IsDefault, &a.IsActive, &a.ReceiverName,
+			&a.IsDefault, &a.IsActive, &a.ReceiverName, &location,
 		); err != nil {
 			log.Error("scan failed", zap.Error(err))
 			return nil, err
 		}
+		if err := open(&a, location); err != nil {
+			log.Error("decrypt failed", zap.String("address_id", a.ID.String()), zap.Error(err))
+			return nil, err
+		}
 		res = append(res, &a)
 	}
 
@@ -99,19 +104,20 @@ func (r *repository) GetByID(
 			name, phone,
 			address_line1, address_line2,
 			city, province, postal_code, country,
-			is_default, is_active, receiver_name
+			is_default, is_active, receiver_name, location
 		FROM addresses
 		WHERE id = $1
 		LIMIT 1
 	`
 
 	var a Address
+	var location *string
 	err := r.db.QueryRowContext(ctx, q, id).Scan(
 		&a.ID, &a.UserID,
 		&a.Name, &a.Phone,
 		&a.Address1, &a.Address2,
 		&a.City, &a.Province, &a.Postal, &a.Country,
-		&a.IsDefault, &a.IsActive, &a.ReceiverName,
+		&a.IsDefault, &a.IsActive, &a.ReceiverName, &location,
 	)
 
 	if err == sql.ErrNoRows {
@@ -122,6 +128,11 @@ func (r *repository) GetByID(
 		return nil, err
 	}
 
+	if err := open(&a, location); err != nil {
+		log.Error("decrypt failed", zap.Error(err))
+		return nil, err
+	}
+
 	return &a, nil
 }
 
@@ -142,23 +153,34 @@ func (r *repository) Create(
 			name, phone,
 			address_line1, address_line2,
 			city, province, postal_code, country,
-			is_default, is_active, receiver_name
+			is_default, is_active, receiver_name, location
 		) VALUES (
 			$1, $2,
 			$3, $4,
 			$5, $6,
 			$7, $8, $9, $10,
-			$11, $12, $13
+			$11, $12, $13, $14
 		)
 	`
 
-	_, err := r.db.ExecContext(
+	stored, err := sealed(addr)
+	if err != nil {
+		log.Error("encrypt failed", zap.Error(err))
+		return err
+	}
+	location, err := sealedLocation(addr)
+	if err != nil {
+		log.Error("encrypt failed", zap.Error(err))
+		return err
+	}
+
+	_, err = r.db.ExecContext(
 		ctx, q,
-		addr.ID, addr.UserID,
-		addr.Name, addr.Phone,
-		addr.Address1, addr.Address2,
-		addr.City, addr.Province, addr.Postal, addr.Country,
-		addr.IsDefault, addr.IsActive, addr.ReceiverName,
+		stored.ID, stored.UserID,
+		stored.Name, stored.Phone,
+		stored.Address1, stored.Address2,
+		stored.City, stored.Province, stored.Postal, stored.Country,
+		stored.IsDefault, stored.IsActive, stored.ReceiverName, location,
 	)
 
 	if err != nil {
@@ -302,7 +324,7 @@ func (r *repository) GetByIDs(
 			name, phone,
 			address_line1, address_line2,
 			city, province, postal_code, country,
-			is_default, is_active, receiver_name
+			is_default, is_active, receiver_name, location
 		FROM addresses
 		WHERE id = ANY($1)
 	`
@@ -318,16 +340,21 @@ func (r *repository) GetByIDs(
 
 	for rows.Next() {
 		var a Address
+		var location *string
 		if err := rows.Scan(
 			&a.ID, &a.UserID,
 			&a.Name, &a.Phone,
 			&a.Address1, &a.Address2,
 			&a.City, &a.Province, &a.Postal, &a.Country,
-			&a.IsDefault, &a.IsActive, &a.ReceiverName,
+			&a.IsDefault, &a.IsActive, &a.ReceiverName, &location,
 		); err != nil {
 			log.Error("scan failed", zap.Error(err))
 			return nil, err
 		}
+		if err := open(&a, location); err != nil {
+			log.Error("decrypt failed", zap.String("address_id", a.ID.String()), zap.Error(err))
+			return nil, err
+		}
 		addresses = append(addresses, a)
 	}
 
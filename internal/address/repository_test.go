@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"errors"
 	"testing"
+	"warimas-be/internal/geo"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
@@ -23,10 +24,10 @@ func TestRepository_GetByUserID(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		rows := sqlmock.NewRows([]string{
 			"id", "user_id", "name", "phone", "address_line1", "address_line2",
-			"city", "province", "postal_code", "country", "is_default", "is_active", "receiver_name",
+			"city", "province", "postal_code", "country", "is_default", "is_active", "receiver_name", "location",
 		}).AddRow(
 			uuid.New(), userID, "Home", "123", "Street 1", nil,
-			"City", "Prov", "12345", "ID", true, true, "John",
+			"City", "Prov", "12345", "ID", true, true, "John", nil,
 		)
 
 		mock.ExpectQuery("SELECT .* FROM addresses WHERE user_id = \\$1").
@@ -61,10 +62,10 @@ func TestRepository_GetByID(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		rows := sqlmock.NewRows([]string{
 			"id", "user_id", "name", "phone", "address_line1", "address_line2",
-			"city", "province", "postal_code", "country", "is_default", "is_active", "receiver_name",
+			"city", "province", "postal_code", "country", "is_default", "is_active", "receiver_name", "location",
 		}).AddRow(
 			id, 1, "Home", "123", "Street 1", nil,
-			"City", "Prov", "12345", "ID", true, true, "John",
+			"City", "Prov", "12345", "ID", true, true, "John", "-6.2088,106.8456",
 		)
 
 		mock.ExpectQuery("SELECT .* FROM addresses WHERE id = \\$1").
@@ -74,6 +75,7 @@ func TestRepository_GetByID(t *testing.T) {
 		res, err := repo.GetByID(context.Background(), id)
 		assert.NoError(t, err)
 		assert.Equal(t, id, res.ID)
+		assert.Equal(t, &geo.Point{Lat: -6.2088, Lng: 106.8456}, res.Location)
 	})
 
 	t.Run("NotFound", func(t *testing.T) {
@@ -114,7 +116,7 @@ func TestRepository_Create(t *testing.T) {
 			WithArgs(
 				addr.ID, addr.UserID, addr.Name, addr.Phone,
 				addr.Address1, addr.Address2, addr.City, addr.Province,
-				addr.Postal, addr.Country, addr.IsDefault, addr.IsActive, addr.ReceiverName,
+				addr.Postal, addr.Country, addr.IsDefault, addr.IsActive, addr.ReceiverName, nil,
 			).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 
@@ -270,13 +272,13 @@ func TestRepository_GetByIDs(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		rows := sqlmock.NewRows([]string{
 			"id", "user_id", "name", "phone", "address_line1", "address_line2",
-			"city", "province", "postal_code", "country", "is_default", "is_active", "receiver_name",
+			"city", "province", "postal_code", "country", "is_default", "is_active", "receiver_name", "location",
 		}).AddRow(
 			ids[0], 1, "Home", "123", "Street 1", nil,
-			"City", "Prov", "12345", "ID", true, true, "John",
+			"City", "Prov", "12345", "ID", true, true, "John", nil,
 		).AddRow(
 			ids[1], 1, "Work", "456", "Street 2", nil,
-			"City", "Prov", "67890", "ID", false, true, "Doe",
+			"City", "Prov", "67890", "ID", false, true, "Doe", nil,
 		)
 
 		// Expect ANY($1)
@@ -3,6 +3,7 @@ package address
 import (
 	"context"
 	"errors"
+	"warimas-be/internal/geo"
 	"warimas-be/internal/logger"
 	"warimas-be/internal/utils"
 
@@ -20,8 +21,22 @@ type Service interface {
 	Delete(ctx context.Context, addressID uuid.UUID) error
 
 	SetDefaultAddress(ctx context.Context, addressID uuid.UUID) error
+
+	// GetOwned loads addressID for userID, who need not be the caller, as
+	// when an admin places an order for a customer. It fails with
+	// ErrNotFound unless the address is active and belongs to userID.
+	GetOwned(ctx context.Context, addressID uuid.UUID, userID uint) (*Address, error)
+	// GetByID and GetByIDs load addresses without an ownership check, for
+	// modules that already authorized access to whatever references them,
+	// such as an order. Deactivated addresses are returned too, since old
+	// orders keep pointing at them.
+	GetByID(ctx context.Context, addressID uuid.UUID) (*Address, error)
+	GetByIDs(ctx context.Context, addressIDs []uuid.UUID) (map[uuid.UUID]*Address, error)
 }
 
+// ErrNotFound hides whether a missing address exists for someone else.
+var ErrNotFound = errors.New("address not found")
+
 // service implements the Service interface
 type service struct {
 	repo Repository
@@ -76,12 +91,82 @@ func (s *service) Get(
 
 	if addr.UserID != userID || !addr.IsActive {
 		log.Warn("unauthorized address access")
-		return nil, errors.New("address not found")
+		return nil, ErrNotFound
 	}
 
 	return addr, nil
 }
 
+func (s *service) GetOwned(
+	ctx context.Context,
+	addressID uuid.UUID,
+	userID uint,
+) (*Address, error) {
+
+	log := logger.FromCtx(ctx).With(
+		zap.String("service", "Address"),
+		zap.String("method", "GetOwned"),
+		zap.String("address_id", addressID.String()),
+		zap.Uint("user_id", userID),
+	)
+
+	addr, err := s.repo.GetByID(ctx, addressID)
+	if err != nil {
+		log.Warn("address not found", zap.Error(err))
+		return nil, ErrNotFound
+	}
+
+	if addr.UserID != userID || !addr.IsActive {
+		log.Warn("address not found or not owned by user")
+		return nil, ErrNotFound
+	}
+
+	return addr, nil
+}
+
+func (s *service) GetByID(
+	ctx context.Context,
+	addressID uuid.UUID,
+) (*Address, error) {
+	return s.repo.GetByID(ctx, addressID)
+}
+
+func (s *service) GetByIDs(
+	ctx context.Context,
+	addressIDs []uuid.UUID,
+) (map[uuid.UUID]*Address, error) {
+
+	byID := make(map[uuid.UUID]*Address, len(addressIDs))
+
+	ids := make([]uuid.UUID, 0, len(addressIDs))
+	seen := make(map[uuid.UUID]bool, len(addressIDs))
+	for _, id := range addressIDs {
+		if id != uuid.Nil && !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return byID, nil
+	}
+
+	addresses, err := s.repo.GetByIDs(ctx, ids)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to load addresses",
+			zap.String("service", "Address"),
+			zap.String("method", "GetByIDs"),
+			zap.Int("ids_count", len(ids)),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	for i := range addresses {
+		byID[addresses[i].ID] = &addresses[i]
+	}
+	return byID, nil
+}
+
 func (s *service) Create(
 	ctx context.Context,
 	input CreateAddressInput,
@@ -96,6 +181,9 @@ func (s *service) Create(
 	if !ok {
 		return nil, errors.New("unauthenticated")
 	}
+	if input.Location != nil && !input.Location.Valid() {
+		return nil, geo.ErrInvalidPoint
+	}
 
 	addr := &Address{
 		ID:           uuid.New(),
@@ -109,6 +197,7 @@ func (s *service) Create(
 		Province:     input.Province,
 		Postal:       input.PostalCode,
 		Country:      input.Country,
+		Location:     input.Location,
 		IsActive:     true,
 		IsDefault:    input.SetAsDefault,
 	}
@@ -146,6 +235,9 @@ func (s *service) Update(
 	if err != nil {
 		return nil, errors.New("invalid address id")
 	}
+	if input.Location != nil && !input.Location.Valid() {
+		return nil, geo.ErrInvalidPoint
+	}
 
 	oldAddr, err := s.repo.GetByID(ctx, oldID)
 	if err != nil || oldAddr.UserID != userID {
@@ -166,6 +258,7 @@ func (s *service) Update(
 		Province:  input.Province,
 		Postal:    input.PostalCode,
 		Country:   input.Country,
+		Location:  input.Location,
 		IsActive:  true,
 		IsDefault: input.SetAsDefault,
 	}
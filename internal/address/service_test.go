@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"warimas-be/internal/geo"
 	"warimas-be/internal/utils"
 
 	"github.com/google/uuid"
@@ -187,6 +188,82 @@ func TestService_Get(t *testing.T) {
 	})
 }
 
+func TestService_GetOwned(t *testing.T) {
+	ctx := context.Background()
+	addrID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+		expected := &Address{ID: addrID, UserID: 7, IsActive: true}
+		mockRepo.On("GetByID", ctx, addrID).Return(expected, nil)
+
+		result, err := svc.GetOwned(ctx, addrID, 7)
+
+		assert.NoError(t, err)
+		assert.Equal(t, expected, result)
+	})
+
+	t.Run("WrongUser", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+		mockRepo.On("GetByID", ctx, addrID).Return(&Address{ID: addrID, UserID: 8, IsActive: true}, nil)
+
+		_, err := svc.GetOwned(ctx, addrID, 7)
+
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("Inactive", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+		mockRepo.On("GetByID", ctx, addrID).Return(&Address{ID: addrID, UserID: 7}, nil)
+
+		_, err := svc.GetOwned(ctx, addrID, 7)
+
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+
+	t.Run("RepoError", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+		mockRepo.On("GetByID", ctx, addrID).Return(nil, errors.New("db error"))
+
+		_, err := svc.GetOwned(ctx, addrID, 7)
+
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestService_GetByIDs(t *testing.T) {
+	ctx := context.Background()
+	a, b := uuid.New(), uuid.New()
+
+	t.Run("DedupesAndSkipsNil", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+		mockRepo.On("GetByIDs", ctx, []uuid.UUID{a, b}).
+			Return([]Address{{ID: a, City: "Bandung"}, {ID: b, City: "Depok"}}, nil)
+
+		result, err := svc.GetByIDs(ctx, []uuid.UUID{a, uuid.Nil, b, a})
+
+		assert.NoError(t, err)
+		assert.Len(t, result, 2)
+		assert.Equal(t, "Depok", result[b].City)
+	})
+
+	t.Run("NothingToLoad", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+
+		result, err := svc.GetByIDs(ctx, []uuid.UUID{uuid.Nil})
+
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+		mockRepo.AssertNotCalled(t, "GetByIDs", mock.Anything, mock.Anything)
+	})
+}
+
 func TestService_Create(t *testing.T) {
 	userID := uint(1)
 	ctx := mockContextWithUser(userID)
@@ -231,6 +308,18 @@ func TestService_Create(t *testing.T) {
 		assert.NotNil(t, res)
 	})
 
+	t.Run("InvalidLocation", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+		inputFarOff := input
+		inputFarOff.Location = &geo.Point{Lat: 106.8, Lng: -6.2}
+
+		_, err := svc.Create(ctx, inputFarOff)
+
+		assert.ErrorIs(t, err, geo.ErrInvalidPoint)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
 	t.Run("RepoError", func(t *testing.T) {
 		mockRepo := new(MockRepository)
 		svc := NewService(mockRepo)
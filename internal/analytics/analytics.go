@@ -0,0 +1,183 @@
+// Package analytics records storefront funnel events (product views, add
+// to cart, checkout and purchase) for marketing. Events are sent in the
+// background to a Sink; tracking never fails or slows a request, and
+// events are dropped when the sink falls behind.
+package analytics
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"warimas-be/internal/logger"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+// Event types, in funnel order.
+const (
+	EventProductView     = "product_view"
+	EventAddToCart       = "add_to_cart"
+	EventCheckoutStarted = "checkout_started"
+	EventPurchase        = "purchase"
+)
+
+const (
+	bufferSize    = 4096
+	batchSize     = 100
+	flushInterval = 2 * time.Second
+)
+
+// Event is one funnel step. A signed-in visitor is attributed by UserID
+// and an anonymous one by the guest token's GuestID; no email or other
+// contact details are sent.
+type Event struct {
+	Type       string    `json:"type"`
+	OccurredAt time.Time `json:"occurredAt"`
+	UserID     *int32    `json:"userId,omitempty"`
+	GuestID    *string   `json:"guestId,omitempty"`
+
+	ProductID  string `json:"productId,omitempty"`
+	VariantID  string `json:"variantId,omitempty"`
+	Quantity   int    `json:"quantity,omitempty"`
+	CheckoutID string `json:"checkoutId,omitempty"`
+	OrderID    string `json:"orderId,omitempty"`
+	// Value is in whole units of Currency.
+	Value    int64  `json:"value,omitempty"`
+	Currency string `json:"currency,omitempty"`
+}
+
+// visitor is the key events of one visitor share, so a partitioned sink
+// keeps their order.
+func (e *Event) visitor() string {
+	switch {
+	case e.UserID != nil:
+		return "user:" + itoa(*e.UserID)
+	case e.GuestID != nil:
+		return "guest:" + *e.GuestID
+	default:
+		return ""
+	}
+}
+
+// Tracker batches events to a sink from a background goroutine.
+type Tracker struct {
+	sink   Sink
+	events chan Event
+	done   chan struct{}
+}
+
+var (
+	mu      sync.RWMutex
+	tracker *Tracker
+)
+
+// Start installs a process-wide tracker sending to sink. Until it is
+// called Track does nothing, which is what tests and local development
+// run with.
+func Start(sink Sink) *Tracker {
+	t := &Tracker{
+		sink:   sink,
+		events: make(chan Event, bufferSize),
+		done:   make(chan struct{}),
+	}
+	go t.run()
+
+	mu.Lock()
+	tracker = t
+	mu.Unlock()
+	return t
+}
+
+// Track records e, attributing it to the request's user or guest unless
+// e already names one.
+func Track(ctx context.Context, e Event) {
+	// Held until the event is queued, so Close cannot close the channel
+	// under a send.
+	mu.RLock()
+	defer mu.RUnlock()
+	t := tracker
+	if t == nil {
+		return
+	}
+
+	if e.OccurredAt.IsZero() {
+		e.OccurredAt = time.Now()
+	}
+	if e.UserID == nil && e.GuestID == nil {
+		if userID, ok := utils.GetUserIDFromContext(ctx); ok {
+			id := int32(userID)
+			e.UserID = &id
+		} else if guestID, ok := utils.GetGuestIDFromContext(ctx); ok {
+			e.GuestID = &guestID
+		}
+	}
+
+	select {
+	case t.events <- e:
+	default:
+		logger.FromCtx(ctx).Debug("analytics buffer full, event dropped",
+			zap.String("type", e.Type),
+		)
+	}
+}
+
+// Close stops the tracker after sending what is buffered, waiting at most
+// timeout.
+func (t *Tracker) Close(timeout time.Duration) {
+	mu.Lock()
+	if tracker == t {
+		tracker = nil
+	}
+	mu.Unlock()
+
+	close(t.events)
+	select {
+	case <-t.done:
+	case <-time.After(timeout):
+	}
+}
+
+func (t *Tracker) run() {
+	defer close(t.done)
+
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]Event, 0, batchSize)
+	for {
+		select {
+		case e, ok := <-t.events:
+			if !ok {
+				t.flush(batch)
+				return
+			}
+			batch = append(batch, e)
+			if len(batch) >= batchSize {
+				t.flush(batch)
+				batch = batch[:0]
+			}
+		case <-ticker.C:
+			t.flush(batch)
+			batch = batch[:0]
+		}
+	}
+}
+
+// flush sends batch; a failed batch is logged and dropped, since
+// analytics tolerate gaps better than a growing backlog.
+func (t *Tracker) flush(batch []Event) {
+	if len(batch) == 0 {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if err := t.sink.Send(ctx, batch); err != nil {
+		logger.L().Warn("failed to send analytics events",
+			zap.Int("count", len(batch)),
+			zap.Error(err),
+		)
+	}
+}
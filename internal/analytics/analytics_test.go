@@ -0,0 +1,89 @@
+package analytics
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"warimas-be/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingSink struct {
+	mu     sync.Mutex
+	events []Event
+}
+
+func (s *recordingSink) Send(_ context.Context, events []Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.events = append(s.events, events...)
+	return nil
+}
+
+func TestTrack(t *testing.T) {
+	t.Run("NotStarted", func(t *testing.T) {
+		// Without a tracker events are dropped quietly.
+		Track(context.Background(), Event{Type: EventProductView})
+	})
+
+	t.Run("Attribution", func(t *testing.T) {
+		sink := &recordingSink{}
+		tracker := Start(sink)
+
+		userCtx := utils.SetUserContext(context.Background(), 7, "buyer@example.com", "USER")
+		guestCtx := utils.WithPrincipal(context.Background(), &utils.Principal{GuestID: "guest-1"})
+		customer := int32(9)
+
+		Track(userCtx, Event{Type: EventProductView, ProductID: "p1"})
+		Track(guestCtx, Event{Type: EventAddToCart, VariantID: "v1", Quantity: 2})
+		Track(guestCtx, Event{Type: EventPurchase, UserID: &customer, OrderID: "pay-1"})
+		tracker.Close(time.Second)
+
+		require.Len(t, sink.events, 3)
+		assert.Equal(t, int32(7), *sink.events[0].UserID)
+		assert.Nil(t, sink.events[0].GuestID)
+		assert.False(t, sink.events[0].OccurredAt.IsZero())
+		assert.Equal(t, "guest-1", *sink.events[1].GuestID)
+		assert.Nil(t, sink.events[1].UserID)
+		assert.Equal(t, int32(9), *sink.events[2].UserID, "an explicit visitor is kept")
+		assert.Nil(t, sink.events[2].GuestID)
+
+		// Closed trackers stop accepting events.
+		Track(userCtx, Event{Type: EventProductView})
+		assert.Len(t, sink.events, 3)
+	})
+}
+
+func TestHTTPSink(t *testing.T) {
+	var got []Event
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&got))
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	err := NewHTTPSink(srv.URL).Send(context.Background(), []Event{{Type: EventCheckoutStarted, CheckoutID: "ck-1", Value: 50000}})
+
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, "ck-1", got[0].CheckoutID)
+}
+
+func TestHTTPSink_CollectorError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	err := NewHTTPSink(srv.URL).Send(context.Background(), []Event{{Type: EventPurchase}})
+
+	assert.Error(t, err)
+}
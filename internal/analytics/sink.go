@@ -0,0 +1,111 @@
+package analytics
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"warimas-be/internal/logger"
+
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+)
+
+// Sink delivers a batch of events. The batch is reused after Send
+// returns.
+type Sink interface {
+	Send(ctx context.Context, events []Event) error
+}
+
+// LogSink writes each event at info level.
+type LogSink struct{}
+
+func (LogSink) Send(ctx context.Context, events []Event) error {
+	log := logger.FromCtx(ctx)
+	for _, e := range events {
+		log.Info("analytics event",
+			zap.String("type", e.Type),
+			zap.String("visitor", e.visitor()),
+			zap.String("product_id", e.ProductID),
+			zap.String("variant_id", e.VariantID),
+			zap.String("checkout_id", e.CheckoutID),
+			zap.String("order_id", e.OrderID),
+			zap.Int64("value", e.Value),
+		)
+	}
+	return nil
+}
+
+// KafkaSink publishes each event as JSON to one topic, keyed by visitor
+// so a visitor's events stay in order.
+type KafkaSink struct {
+	w *kafka.Writer
+}
+
+// NewKafkaSink connects to the comma separated broker addresses. The
+// topic is expected to exist.
+func NewKafkaSink(brokers, topic string) *KafkaSink {
+	return &KafkaSink{w: &kafka.Writer{
+		Addr:         kafka.TCP(strings.Split(brokers, ",")...),
+		Topic:        topic,
+		Balancer:     &kafka.Hash{},
+		RequiredAcks: kafka.RequireOne,
+		BatchSize:    batchSize,
+		BatchTimeout: 10 * time.Millisecond,
+		WriteTimeout: 10 * time.Second,
+	}}
+}
+
+func (s *KafkaSink) Send(ctx context.Context, events []Event) error {
+	msgs := make([]kafka.Message, 0, len(events))
+	for _, e := range events {
+		value, err := json.Marshal(e)
+		if err != nil {
+			return err
+		}
+		msgs = append(msgs, kafka.Message{Key: []byte(e.visitor()), Value: value})
+	}
+	return s.w.WriteMessages(ctx, msgs...)
+}
+
+// HTTPSink posts each batch as a JSON array to a collector endpoint.
+type HTTPSink struct {
+	url    string
+	client *http.Client
+}
+
+func NewHTTPSink(url string) *HTTPSink {
+	return &HTTPSink{url: url, client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+func (s *HTTPSink) Send(ctx context.Context, events []Event) error {
+	body, err := json.Marshal(events)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("collector responded %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func itoa(n int32) string {
+	return strconv.Itoa(int(n))
+}
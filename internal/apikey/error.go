@@ -0,0 +1,15 @@
+package apikey
+
+import (
+	"errors"
+	"warimas-be/internal/apperr"
+)
+
+var (
+	ErrUnauthenticated = apperr.Unauthenticated("unauthenticated")
+	ErrForbidden       = apperr.Forbidden("forbidden")
+	ErrDB              = errors.New("database error")
+	ErrNotFound        = apperr.NotFound("api key not found")
+	ErrInvalidKey      = errors.New("invalid api key")
+	ErrInvalidInput    = errors.New("invalid api key input")
+)
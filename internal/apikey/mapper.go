@@ -0,0 +1,23 @@
+package apikey
+
+import (
+	"strconv"
+	"warimas-be/internal/graph/model"
+)
+
+func MapKeyToGraphQL(k *Key) *model.APIKey {
+	scopes := make([]model.APIKeyScope, len(k.Scopes))
+	for i, s := range k.Scopes {
+		scopes[i] = model.APIKeyScope(s)
+	}
+	return &model.APIKey{
+		ID:         strconv.FormatInt(k.ID, 10),
+		Name:       k.Name,
+		Prefix:     k.Prefix,
+		Scopes:     scopes,
+		CreatedAt:  k.CreatedAt,
+		LastUsedAt: k.LastUsedAt,
+		ExpiresAt:  k.ExpiresAt,
+		RevokedAt:  k.RevokedAt,
+	}
+}
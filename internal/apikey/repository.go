@@ -0,0 +1,176 @@
+package apikey
+
+import (
+	"context"
+	"database/sql"
+
+	"warimas-be/internal/logger"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+type Repository interface {
+	Create(ctx context.Context, k *Key) (*Key, error)
+	GetByPrefix(ctx context.Context, prefix string) (*Key, error)
+	List(ctx context.Context, includeRevoked bool) ([]*Key, error)
+	Revoke(ctx context.Context, id int64, revokedBy int32) (*Key, error)
+	// Touch records that the key was used, at most once per touchInterval.
+	Touch(ctx context.Context, id int64) error
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+const selectKey = `
+	SELECT id, name, prefix, key_hash, scopes, created_by, created_at,
+		last_used_at, expires_at, revoked_at, revoked_by
+	FROM api_keys
+`
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanKey(row scanner) (*Key, error) {
+	var k Key
+	var scopes []string
+	err := row.Scan(&k.ID, &k.Name, &k.Prefix, &k.KeyHash, pq.Array(&scopes), &k.CreatedBy, &k.CreatedAt,
+		&k.LastUsedAt, &k.ExpiresAt, &k.RevokedAt, &k.RevokedBy)
+	if err != nil {
+		return nil, err
+	}
+	k.Scopes = make([]Scope, len(scopes))
+	for i, s := range scopes {
+		k.Scopes[i] = Scope(s)
+	}
+	return &k, nil
+}
+
+func (r *repository) Create(ctx context.Context, k *Key) (*Key, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "Create"),
+		zap.String("prefix", k.Prefix),
+	)
+
+	scopes := make([]string, len(k.Scopes))
+	for i, s := range k.Scopes {
+		scopes[i] = string(s)
+	}
+
+	created, err := scanKey(r.db.QueryRowContext(ctx, `
+		INSERT INTO api_keys (name, prefix, key_hash, scopes, created_by, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id, name, prefix, key_hash, scopes, created_by, created_at,
+			last_used_at, expires_at, revoked_at, revoked_by
+	`, k.Name, k.Prefix, k.KeyHash, pq.Array(scopes), k.CreatedBy, k.ExpiresAt))
+	if err != nil {
+		log.Error("failed to create api key", zap.Error(err))
+		return nil, ErrDB
+	}
+	return created, nil
+}
+
+func (r *repository) GetByPrefix(ctx context.Context, prefix string) (*Key, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "GetByPrefix"),
+		zap.String("prefix", prefix),
+	)
+
+	k, err := scanKey(r.db.QueryRowContext(ctx, selectKey+`WHERE prefix = $1`, prefix))
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		log.Error("failed to get api key", zap.Error(err))
+		return nil, ErrDB
+	}
+	return k, nil
+}
+
+func (r *repository) List(ctx context.Context, includeRevoked bool) ([]*Key, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "List"),
+	)
+
+	rows, err := r.db.QueryContext(ctx, selectKey+`
+		WHERE $1 OR revoked_at IS NULL
+		ORDER BY created_at DESC, id DESC
+	`, includeRevoked)
+	if err != nil {
+		log.Error("failed to query api keys", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	list := []*Key{}
+	for rows.Next() {
+		k, err := scanKey(rows)
+		if err != nil {
+			log.Error("failed to scan api key", zap.Error(err))
+			return nil, ErrDB
+		}
+		list = append(list, k)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error("api key iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return list, nil
+}
+
+func (r *repository) Revoke(ctx context.Context, id int64, revokedBy int32) (*Key, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "Revoke"),
+		zap.Int64("api_key_id", id),
+	)
+
+	// Revoking twice keeps the first revocation.
+	k, err := scanKey(r.db.QueryRowContext(ctx, `
+		UPDATE api_keys
+		SET revoked_at = COALESCE(revoked_at, NOW()),
+			revoked_by = COALESCE(revoked_by, $2)
+		WHERE id = $1
+		RETURNING id, name, prefix, key_hash, scopes, created_by, created_at,
+			last_used_at, expires_at, revoked_at, revoked_by
+	`, id, revokedBy))
+	if err == sql.ErrNoRows {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		log.Error("failed to revoke api key", zap.Error(err))
+		return nil, ErrDB
+	}
+	return k, nil
+}
+
+func (r *repository) Touch(ctx context.Context, id int64) error {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "Touch"),
+		zap.Int64("api_key_id", id),
+	)
+
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE api_keys
+		SET last_used_at = NOW()
+		WHERE id = $1
+			AND (last_used_at IS NULL OR last_used_at < NOW() - $2 * INTERVAL '1 second')
+	`, id, touchInterval.Seconds())
+	if err != nil {
+		log.Error("failed to touch api key", zap.Error(err))
+		return ErrDB
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package apikey
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var keyCols = []string{"id", "name", "prefix", "key_hash", "scopes", "created_by", "created_at",
+	"last_used_at", "expires_at", "revoked_at", "revoked_by"}
+
+func TestRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	createdBy := int32(1)
+
+	mock.ExpectQuery(`INSERT INTO api_keys \(name, prefix, key_hash, scopes, created_by, expires_at\)`).
+		WithArgs("worker", "0123456789ab", "hash", "{\"ORDERS_CREATE_FROM_SESSION\"}", &createdBy, nil).
+		WillReturnRows(sqlmock.NewRows(keyCols).
+			AddRow(4, "worker", "0123456789ab", "hash", "{ORDERS_CREATE_FROM_SESSION}", 1, time.Now(), nil, nil, nil, nil))
+
+	k, err := repo.Create(context.Background(), &Key{
+		Name:      "worker",
+		Prefix:    "0123456789ab",
+		KeyHash:   "hash",
+		Scopes:    []Scope{ScopeOrdersCreateFromSession},
+		CreatedBy: &createdBy,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), k.ID)
+	assert.Equal(t, []Scope{ScopeOrdersCreateFromSession}, k.Scopes)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_GetByPrefix(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+
+	mock.ExpectQuery(`FROM api_keys WHERE prefix = \$1`).
+		WithArgs("0123456789ab").
+		WillReturnRows(sqlmock.NewRows(keyCols).
+			AddRow(4, "worker", "0123456789ab", "hash", "{}", nil, time.Now(), nil, nil, nil, nil))
+
+	k, err := repo.GetByPrefix(ctx, "0123456789ab")
+	require.NoError(t, err)
+	assert.Equal(t, "hash", k.KeyHash)
+
+	mock.ExpectQuery(`FROM api_keys`).WillReturnError(sql.ErrNoRows)
+	_, err = repo.GetByPrefix(ctx, "0123456789ab")
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	mock.ExpectQuery(`FROM api_keys`).WillReturnError(errors.New("boom"))
+	_, err = repo.GetByPrefix(ctx, "0123456789ab")
+	assert.ErrorIs(t, err, ErrDB)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_List(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	mock.ExpectQuery(`FROM api_keys WHERE \$1 OR revoked_at IS NULL ORDER BY created_at DESC`).
+		WithArgs(false).
+		WillReturnRows(sqlmock.NewRows(keyCols).
+			AddRow(4, "worker", "0123456789ab", "hash", "{}", 1, time.Now(), nil, nil, nil, nil).
+			AddRow(5, "reports", "ba9876543210", "hash2", "{}", 1, time.Now(), time.Now(), nil, nil, nil))
+
+	keys, err := repo.List(context.Background(), false)
+	require.NoError(t, err)
+	assert.Len(t, keys, 2)
+	assert.NotNil(t, keys[1].LastUsedAt)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_Revoke(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+
+	mock.ExpectQuery(`UPDATE api_keys SET revoked_at = COALESCE\(revoked_at, NOW\(\)\)`).
+		WithArgs(int64(4), int32(1)).
+		WillReturnRows(sqlmock.NewRows(keyCols).
+			AddRow(4, "worker", "0123456789ab", "hash", "{}", 1, time.Now(), nil, nil, time.Now(), 1))
+
+	k, err := repo.Revoke(ctx, 4, 1)
+	require.NoError(t, err)
+	assert.NotNil(t, k.RevokedAt)
+
+	mock.ExpectQuery(`UPDATE api_keys`).WillReturnError(sql.ErrNoRows)
+	_, err = repo.Revoke(ctx, 4, 1)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_Touch(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	mock.ExpectExec(`UPDATE api_keys SET last_used_at = NOW\(\) WHERE id = \$1`).
+		WithArgs(int64(4), float64(60)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, repo.Touch(context.Background(), 4))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
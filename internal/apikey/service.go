@@ -0,0 +1,199 @@
+package apikey
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"warimas-be/internal/logger"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+type Service interface {
+	// Authenticate resolves a raw X-API-Key value to the service it was
+	// issued to.
+	Authenticate(ctx context.Context, raw string) (*utils.ServicePrincipal, error)
+
+	// Create, List and Revoke are admin only.
+	Create(ctx context.Context, in CreateInput) (*Created, error)
+	List(ctx context.Context, includeRevoked bool) ([]*Key, error)
+	Revoke(ctx context.Context, id int64) (*Key, error)
+}
+
+type service struct {
+	repo Repository
+	now  func() time.Time
+}
+
+func NewService(repo Repository) Service {
+	return &service{repo: repo, now: time.Now}
+}
+
+func (s *service) Authenticate(ctx context.Context, raw string) (*utils.ServicePrincipal, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "Authenticate"),
+	)
+
+	prefix, ok := parseKey(raw)
+	if !ok {
+		return nil, ErrInvalidKey
+	}
+
+	k, err := s.repo.GetByPrefix(ctx, prefix)
+	if err == ErrNotFound {
+		log.Warn("unknown api key", zap.String("prefix", prefix))
+		return nil, ErrInvalidKey
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(hashKey(raw)), []byte(k.KeyHash)) != 1 {
+		log.Warn("api key secret mismatch", zap.String("prefix", prefix))
+		return nil, ErrInvalidKey
+	}
+	if !k.Usable(s.now()) {
+		log.Warn("revoked or expired api key used", zap.Int64("api_key_id", k.ID))
+		return nil, ErrInvalidKey
+	}
+
+	// Usage tracking must not fail the request.
+	if err := s.repo.Touch(ctx, k.ID); err != nil {
+		log.Warn("failed to record api key use", zap.Int64("api_key_id", k.ID), zap.Error(err))
+	}
+
+	scopes := make([]string, len(k.Scopes))
+	for i, sc := range k.Scopes {
+		scopes[i] = string(sc)
+	}
+	return &utils.ServicePrincipal{KeyID: k.ID, Name: k.Name, Scopes: scopes}, nil
+}
+
+func (s *service) Create(ctx context.Context, in CreateInput) (*Created, error) {
+	adminID, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	in.Name = strings.TrimSpace(in.Name)
+	if in.Name == "" || len(in.Name) > maxNameLength || len(in.Scopes) == 0 || in.ExpiresIn < 0 {
+		return nil, ErrInvalidInput
+	}
+	for _, sc := range in.Scopes {
+		if !sc.Valid() {
+			return nil, ErrInvalidInput
+		}
+	}
+
+	raw, prefix, err := generateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	createdBy := int32(adminID)
+	k := &Key{
+		Name:      in.Name,
+		Prefix:    prefix,
+		KeyHash:   hashKey(raw),
+		Scopes:    in.Scopes,
+		CreatedBy: &createdBy,
+	}
+	if in.ExpiresIn > 0 {
+		expires := s.now().Add(in.ExpiresIn)
+		k.ExpiresAt = &expires
+	}
+
+	created, err := s.repo.Create(ctx, k)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.FromCtx(ctx).Info("api key created",
+		zap.Int64("api_key_id", created.ID),
+		zap.String("name", created.Name),
+		zap.String("prefix", created.Prefix),
+		zap.Uint("admin_id", adminID),
+	)
+
+	return &Created{Key: created, Secret: raw}, nil
+}
+
+func (s *service) List(ctx context.Context, includeRevoked bool) ([]*Key, error) {
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return s.repo.List(ctx, includeRevoked)
+}
+
+func (s *service) Revoke(ctx context.Context, id int64) (*Key, error) {
+	adminID, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	k, err := s.repo.Revoke(ctx, id, int32(adminID))
+	if err != nil {
+		return nil, err
+	}
+
+	logger.FromCtx(ctx).Warn("api key revoked",
+		zap.Int64("api_key_id", k.ID),
+		zap.String("name", k.Name),
+		zap.Uint("admin_id", adminID),
+	)
+
+	return k, nil
+}
+
+// generateKey returns a new key "wmk_<prefix>_<secret>" and its prefix.
+func generateKey() (raw, prefix string, err error) {
+	id := make([]byte, 6)
+	secret := make([]byte, 32)
+	if _, err := rand.Read(id); err != nil {
+		return "", "", err
+	}
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", err
+	}
+	prefix = hex.EncodeToString(id)
+	return keyPrefix + prefix + "_" + base64.RawURLEncoding.EncodeToString(secret), prefix, nil
+}
+
+// parseKey returns the lookup prefix of a well-formed key.
+func parseKey(raw string) (string, bool) {
+	rest, ok := strings.CutPrefix(raw, keyPrefix)
+	if !ok {
+		return "", false
+	}
+	prefix, secret, ok := strings.Cut(rest, "_")
+	if !ok || len(prefix) != 12 || secret == "" {
+		return "", false
+	}
+	return prefix, true
+}
+
+// hashKey is what is stored in place of the key. Keys carry 256 bits of
+// randomness, so a plain SHA-256 is enough; no slow password hash needed.
+func hashKey(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
+
+func requireAdmin(ctx context.Context) (uint, error) {
+	userID, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		return 0, ErrUnauthenticated
+	}
+	if !utils.IsAdmin(ctx) {
+		return 0, ErrForbidden
+	}
+	return userID, nil
+}
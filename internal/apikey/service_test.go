@@ -0,0 +1,224 @@
+package apikey
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+	"warimas-be/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Mocks ---
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) Create(ctx context.Context, k *Key) (*Key, error) {
+	args := m.Called(ctx, k)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Key), args.Error(1)
+}
+
+func (m *MockRepository) GetByPrefix(ctx context.Context, prefix string) (*Key, error) {
+	args := m.Called(ctx, prefix)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Key), args.Error(1)
+}
+
+func (m *MockRepository) List(ctx context.Context, includeRevoked bool) ([]*Key, error) {
+	args := m.Called(ctx, includeRevoked)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Key), args.Error(1)
+}
+
+func (m *MockRepository) Revoke(ctx context.Context, id int64, revokedBy int32) (*Key, error) {
+	args := m.Called(ctx, id, revokedBy)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Key), args.Error(1)
+}
+
+func (m *MockRepository) Touch(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+// --- Tests ---
+
+var testNow = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func adminCtx() context.Context {
+	return utils.SetUserContext(context.Background(), 1, "admin@example.com", "ADMIN")
+}
+
+func newTestService(repo Repository) *service {
+	s := NewService(repo).(*service)
+	s.now = func() time.Time { return testNow }
+	return s
+}
+
+func TestGenerateAndParseKey(t *testing.T) {
+	raw, prefix, err := generateKey()
+	require.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(raw, "wmk_"+prefix+"_"))
+	got, ok := parseKey(raw)
+	assert.True(t, ok)
+	assert.Equal(t, prefix, got)
+
+	for _, bad := range []string{"", "wmk_", "wmk_abc_secret", "sk_0123456789ab_secret", "wmk_0123456789ab_"} {
+		_, ok := parseKey(bad)
+		assert.False(t, ok, bad)
+	}
+}
+
+func TestService_Authenticate(t *testing.T) {
+	raw, prefix, err := generateKey()
+	require.NoError(t, err)
+	stored := &Key{ID: 3, Name: "checkout-worker", Prefix: prefix, KeyHash: hashKey(raw), Scopes: []Scope{ScopeOrdersCreateFromSession}}
+
+	t.Run("Valid key", func(t *testing.T) {
+		repo := new(MockRepository)
+		s := newTestService(repo)
+		repo.On("GetByPrefix", mock.Anything, prefix).Return(stored, nil)
+		repo.On("Touch", mock.Anything, int64(3)).Return(ErrDB)
+
+		p, err := s.Authenticate(context.Background(), raw)
+
+		require.NoError(t, err)
+		assert.Equal(t, &utils.ServicePrincipal{
+			KeyID:  3,
+			Name:   "checkout-worker",
+			Scopes: []string{"ORDERS_CREATE_FROM_SESSION"},
+		}, p)
+	})
+
+	t.Run("Malformed key", func(t *testing.T) {
+		s := newTestService(new(MockRepository))
+		_, err := s.Authenticate(context.Background(), "not-a-key")
+		assert.ErrorIs(t, err, ErrInvalidKey)
+	})
+
+	t.Run("Unknown prefix", func(t *testing.T) {
+		repo := new(MockRepository)
+		s := newTestService(repo)
+		repo.On("GetByPrefix", mock.Anything, prefix).Return(nil, ErrNotFound)
+
+		_, err := s.Authenticate(context.Background(), raw)
+		assert.ErrorIs(t, err, ErrInvalidKey)
+	})
+
+	t.Run("Wrong secret", func(t *testing.T) {
+		repo := new(MockRepository)
+		s := newTestService(repo)
+		repo.On("GetByPrefix", mock.Anything, prefix).Return(stored, nil)
+
+		_, err := s.Authenticate(context.Background(), "wmk_"+prefix+"_guessed")
+		assert.ErrorIs(t, err, ErrInvalidKey)
+		repo.AssertNotCalled(t, "Touch", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Revoked or expired", func(t *testing.T) {
+		past := testNow.Add(-time.Minute)
+		for _, k := range []Key{
+			{ID: 3, KeyHash: stored.KeyHash, RevokedAt: &past},
+			{ID: 3, KeyHash: stored.KeyHash, ExpiresAt: &testNow},
+		} {
+			repo := new(MockRepository)
+			s := newTestService(repo)
+			repo.On("GetByPrefix", mock.Anything, prefix).Return(&k, nil)
+
+			_, err := s.Authenticate(context.Background(), raw)
+			assert.ErrorIs(t, err, ErrInvalidKey)
+		}
+	})
+}
+
+func TestService_Create(t *testing.T) {
+	t.Run("Forbidden for non-admin", func(t *testing.T) {
+		s := newTestService(new(MockRepository))
+		ctx := utils.SetUserContext(context.Background(), 2, "user@example.com", "USER")
+		_, err := s.Create(ctx, CreateInput{Name: "worker", Scopes: []Scope{ScopeOrdersCreateFromSession}})
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+
+	t.Run("Validation", func(t *testing.T) {
+		s := newTestService(new(MockRepository))
+		for _, in := range []CreateInput{
+			{Name: " ", Scopes: []Scope{ScopeOrdersCreateFromSession}},
+			{Name: "worker"},
+			{Name: "worker", Scopes: []Scope{"EVERYTHING"}},
+			{Name: "worker", Scopes: []Scope{ScopeOrdersCreateFromSession}, ExpiresIn: -time.Hour},
+		} {
+			_, err := s.Create(adminCtx(), in)
+			assert.ErrorIs(t, err, ErrInvalidInput)
+		}
+	})
+
+	t.Run("Stores only the hash and returns the secret once", func(t *testing.T) {
+		repo := new(MockRepository)
+		s := newTestService(repo)
+		var saved *Key
+		repo.On("Create", mock.Anything, mock.AnythingOfType("*apikey.Key")).
+			Run(func(args mock.Arguments) { saved = args.Get(1).(*Key) }).
+			Return(&Key{ID: 9, Name: "checkout-worker"}, nil)
+
+		created, err := s.Create(adminCtx(), CreateInput{
+			Name:      " checkout-worker ",
+			Scopes:    []Scope{ScopeOrdersCreateFromSession},
+			ExpiresIn: 24 * time.Hour,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, int64(9), created.Key.ID)
+		assert.Equal(t, "checkout-worker", saved.Name)
+		assert.Equal(t, hashKey(created.Secret), saved.KeyHash)
+		assert.NotContains(t, saved.KeyHash, created.Secret)
+		assert.Equal(t, testNow.Add(24*time.Hour), *saved.ExpiresAt)
+		assert.Equal(t, int32(1), *saved.CreatedBy)
+
+		prefix, ok := parseKey(created.Secret)
+		assert.True(t, ok)
+		assert.Equal(t, saved.Prefix, prefix)
+	})
+}
+
+func TestService_Revoke(t *testing.T) {
+	t.Run("Unauthenticated", func(t *testing.T) {
+		s := newTestService(new(MockRepository))
+		_, err := s.Revoke(context.Background(), 9)
+		assert.ErrorIs(t, err, ErrUnauthenticated)
+	})
+
+	t.Run("Admin revokes", func(t *testing.T) {
+		repo := new(MockRepository)
+		s := newTestService(repo)
+		repo.On("Revoke", mock.Anything, int64(9), int32(1)).Return(&Key{ID: 9, RevokedAt: &testNow}, nil)
+
+		k, err := s.Revoke(adminCtx(), 9)
+
+		require.NoError(t, err)
+		assert.NotNil(t, k.RevokedAt)
+	})
+
+	t.Run("Not found", func(t *testing.T) {
+		repo := new(MockRepository)
+		s := newTestService(repo)
+		repo.On("Revoke", mock.Anything, int64(9), int32(1)).Return(nil, ErrNotFound)
+
+		_, err := s.Revoke(adminCtx(), 9)
+		assert.ErrorIs(t, err, ErrNotFound)
+	})
+}
@@ -0,0 +1,116 @@
+// Package apperr is the typed error services return when the caller needs
+// to know what kind of failure happened: a code the GraphQL presenter and
+// HTTP handlers map to an extension code or status, a message that is safe
+// to show the client, and the underlying cause for logs and errors.Is.
+package apperr
+
+import (
+	"errors"
+	"net/http"
+)
+
+// Code classifies an Error. The values double as the GraphQL
+// extensions.code clients switch on.
+type Code string
+
+const (
+	CodeInvalid         Code = "BAD_USER_INPUT"
+	CodeUnauthenticated Code = "UNAUTHENTICATED"
+	CodeForbidden       Code = "FORBIDDEN"
+	CodeNotFound        Code = "NOT_FOUND"
+	CodeConflict        Code = "CONFLICT"
+	// CodeCheckoutBlocked and CodeAccountSuspended refuse a signed-in
+	// user whose account an admin restricted, so clients can tell them
+	// apart from a plain FORBIDDEN.
+	CodeCheckoutBlocked  Code = "CHECKOUT_BLOCKED"
+	CodeAccountSuspended Code = "ACCOUNT_SUSPENDED"
+	// CodeRetryable marks a dependency that is down for now; the same
+	// request may succeed later.
+	CodeRetryable Code = "RETRYABLE"
+	CodeInternal  Code = "INTERNAL_SERVER_ERROR"
+)
+
+// Error is a classified application error. Message is what the client
+// sees; Cause stays server-side.
+type Error struct {
+	Code    Code
+	Message string
+	Cause   error
+}
+
+func (e *Error) Error() string { return e.Message }
+
+func (e *Error) Unwrap() error { return e.Cause }
+
+// Unexpected lets errreport report internal errors and skip the rest.
+func (e *Error) Unexpected() bool { return e.Code == CodeInternal }
+
+// HTTPStatus is the response status for the error's code.
+func (e *Error) HTTPStatus() int {
+	switch e.Code {
+	case CodeInvalid:
+		return http.StatusBadRequest
+	case CodeUnauthenticated:
+		return http.StatusUnauthorized
+	case CodeForbidden, CodeCheckoutBlocked, CodeAccountSuspended:
+		return http.StatusForbidden
+	case CodeNotFound:
+		return http.StatusNotFound
+	case CodeConflict:
+		return http.StatusConflict
+	case CodeRetryable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// Extensions are the GraphQL error extensions for the error.
+func (e *Error) Extensions() map[string]any {
+	return map[string]any{"code": string(e.Code)}
+}
+
+func New(code Code, message string) *Error {
+	return &Error{Code: code, Message: message}
+}
+
+// Wrap classifies cause, keeping it reachable through errors.Is and
+// errors.As while the client only sees message.
+func Wrap(code Code, message string, cause error) *Error {
+	return &Error{Code: code, Message: message, Cause: cause}
+}
+
+func Invalid(message string) *Error         { return New(CodeInvalid, message) }
+func Unauthenticated(message string) *Error { return New(CodeUnauthenticated, message) }
+func Forbidden(message string) *Error       { return New(CodeForbidden, message) }
+func NotFound(message string) *Error        { return New(CodeNotFound, message) }
+func Conflict(message string) *Error        { return New(CodeConflict, message) }
+
+// Internal hides cause behind a generic message.
+func Internal(cause error) *Error {
+	return Wrap(CodeInternal, "internal server error", cause)
+}
+
+// As returns the first *Error in err's chain.
+func As(err error) (*Error, bool) {
+	var e *Error
+	if errors.As(err, &e) {
+		return e, true
+	}
+	return nil, false
+}
+
+// CodeOf returns the code of the first *Error in err's chain, or "" when
+// err is not classified.
+func CodeOf(err error) Code {
+	if e, ok := As(err); ok {
+		return e.Code
+	}
+	return ""
+}
+
+// Is reports whether err carries code. Errors of one code from different
+// packages are distinct values, so use errors.Is to match a specific one.
+func Is(err error, code Code) bool {
+	return CodeOf(err) == code
+}
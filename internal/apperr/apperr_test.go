@@ -0,0 +1,78 @@
+package apperr
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestError(t *testing.T) {
+	t.Run("Helpers set the code", func(t *testing.T) {
+		assert.Equal(t, CodeInvalid, Invalid("bad").Code)
+		assert.Equal(t, CodeUnauthenticated, Unauthenticated("who").Code)
+		assert.Equal(t, CodeForbidden, Forbidden("no").Code)
+		assert.Equal(t, CodeNotFound, NotFound("gone").Code)
+		assert.Equal(t, CodeConflict, Conflict("taken").Code)
+		assert.Equal(t, CodeInternal, Internal(sql.ErrConnDone).Code)
+	})
+
+	t.Run("Wrap keeps the cause but not its message", func(t *testing.T) {
+		err := Wrap(CodeNotFound, "order not found", sql.ErrNoRows)
+
+		assert.Equal(t, "order not found", err.Error())
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("Internal hides the cause", func(t *testing.T) {
+		err := Internal(errors.New("pq: relation does not exist"))
+
+		assert.Equal(t, "internal server error", err.Error())
+		assert.True(t, err.Unexpected())
+		assert.False(t, NotFound("gone").Unexpected())
+	})
+
+	t.Run("HTTPStatus", func(t *testing.T) {
+		assert.Equal(t, http.StatusBadRequest, Invalid("bad").HTTPStatus())
+		assert.Equal(t, http.StatusUnauthorized, Unauthenticated("who").HTTPStatus())
+		assert.Equal(t, http.StatusForbidden, Forbidden("no").HTTPStatus())
+		assert.Equal(t, http.StatusNotFound, NotFound("gone").HTTPStatus())
+		assert.Equal(t, http.StatusConflict, Conflict("taken").HTTPStatus())
+		assert.Equal(t, http.StatusForbidden, New(CodeCheckoutBlocked, "blocked").HTTPStatus())
+		assert.Equal(t, http.StatusServiceUnavailable, New(CodeRetryable, "later").HTTPStatus())
+		assert.Equal(t, http.StatusInternalServerError, Internal(nil).HTTPStatus())
+	})
+
+	t.Run("Extensions", func(t *testing.T) {
+		assert.Equal(t, map[string]any{"code": "FORBIDDEN"}, Forbidden("no").Extensions())
+	})
+}
+
+func TestCodeOf(t *testing.T) {
+	errForbidden := Forbidden("forbidden")
+
+	t.Run("Wrapped", func(t *testing.T) {
+		err := fmt.Errorf("load order: %w", errForbidden)
+
+		assert.Equal(t, CodeForbidden, CodeOf(err))
+		assert.True(t, Is(err, CodeForbidden))
+		assert.ErrorIs(t, err, errForbidden)
+
+		e, ok := As(err)
+		require.True(t, ok)
+		assert.Same(t, errForbidden, e)
+	})
+
+	t.Run("Unclassified", func(t *testing.T) {
+		assert.Equal(t, Code(""), CodeOf(errors.New("boom")))
+		assert.False(t, Is(nil, CodeForbidden))
+	})
+
+	t.Run("Same code is not the same error", func(t *testing.T) {
+		assert.NotErrorIs(t, Forbidden("forbidden"), errForbidden)
+	})
+}
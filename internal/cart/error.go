@@ -1,17 +1,21 @@
 package cart
 
-import "errors"
+import (
+	"errors"
+	"warimas-be/internal/apperr"
+)
 
 var (
 	// -- Authentication/Authorization --
-	ErrUserNotAuthenticated = errors.New("user not authenticated")
+	ErrUserNotAuthenticated = apperr.Unauthenticated("user not authenticated")
+	ErrUnauthorized         = apperr.Unauthenticated("unauthorized")
 
 	// -- Validation & Input --
 	ErrInvalidQuantity        = errors.New("invalid cart quantity")
 	ErrInvalidRemoveCartInput = errors.New("invalid remove cart input")
 
 	// -- Resource State --
-	ErrCartItemNotFound     = errors.New("cart item not found")
+	ErrCartItemNotFound     = apperr.NotFound("cart item not found")
 	ErrCartItemAlreadyExist = errors.New("cart item already exists")
 	ErrCartEmpty            = errors.New("cart is already empty")
 
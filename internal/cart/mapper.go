@@ -1,7 +1,6 @@
 package cart
 
 import (
-	"time"
 	"warimas-be/internal/graph/model"
 )
 
@@ -17,18 +16,16 @@ func MapCartItemToGraphQL(cr []*CartRow) []*model.CartItem {
 
 		status := r.Status
 
-		var updatedAt string
+		updatedAt := r.CreatedAt
 		if r.UpdatedAt != nil {
-			updatedAt = r.UpdatedAt.Format(time.RFC3339)
-		} else {
-			updatedAt = r.CreatedAt.Format(time.RFC3339)
+			updatedAt = *r.UpdatedAt
 		}
 
 		item := &model.CartItem{
 			ID:        r.CartID,
 			UserID:    r.UserID,
 			Quantity:  r.Quantity,
-			CreatedAt: r.CreatedAt.Format(time.RFC3339),
+			CreatedAt: r.CreatedAt,
 			UpdatedAt: updatedAt,
 			Product: &model.ProductCart{
 				ID:            r.ProductID,
@@ -4,9 +4,11 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
+	"warimas-be/internal/db/dbgen"
 	"warimas-be/internal/graph/model"
 	"warimas-be/internal/logger"
 
@@ -48,10 +50,26 @@ type Repository interface {
 
 type repository struct {
 	db *sql.DB
+	q  *dbgen.Queries
 }
 
 func NewRepository(db *sql.DB) Repository {
-	return &repository{db: db}
+	return &repository{db: db, q: dbgen.New(db)}
+}
+
+// cartItemFromRow maps a carts row to a CartItem. The generated row types of
+// the single-item queries share one layout, so callers convert to this one.
+func cartItemFromRow(row dbgen.CreateCartItemRow) *CartItem {
+	return &CartItem{
+		ID:       strconv.Itoa(int(row.ID)),
+		UserID:   row.UserID,
+		Quantity: row.Quantity,
+		Product: &ProductCart{
+			Variant: VariantCart{ID: row.VariantID.String},
+		},
+		CreatedAt: row.CreatedAt,
+		UpdatedAt: &row.UpdatedAt,
+	}
 }
 
 func (r *repository) UpdateCartQuantity(
@@ -73,27 +91,16 @@ func (r *repository) UpdateCartQuantity(
 	}
 
 	// Execute update
-	res, err := r.db.ExecContext(ctx, `
-		UPDATE carts
-		SET quantity = $1, updated_at = NOW()
-		WHERE user_id = $2 AND variant_id = $3
-	`,
-		updateParams.Quantity,
-		updateParams.UserID,
-		updateParams.VariantID,
-	)
+	rowsAffected, err := r.q.UpdateCartQuantity(ctx, dbgen.UpdateCartQuantityParams{
+		Quantity:  int32(updateParams.Quantity),
+		UserID:    int32(updateParams.UserID),
+		VariantID: sql.NullString{String: updateParams.VariantID, Valid: true},
+	})
 	if err != nil {
 		log.Error("failed to execute update cart query", zap.Error(err))
 		return ErrFailedUpdateCart
 	}
 
-	// Check affected rows
-	rowsAffected, err := res.RowsAffected()
-	if err != nil {
-		log.Error("failed to read rows affected", zap.Error(err))
-		return ErrFailedUpdateCart
-	}
-
 	if rowsAffected == 0 {
 		log.Info("no cart item found to update")
 		return ErrCartItemNotFound
@@ -114,24 +121,15 @@ func (r *repository) RemoveFromCart(
 		zap.Strings("variant_id", deleteParams.VariantID),
 	)
 
-	res, err := r.db.ExecContext(ctx, `
-		DELETE FROM carts
-		WHERE user_id = $1 AND variant_id = ANY($2)
-	`,
-		deleteParams.UserID,
-		pq.Array(deleteParams.VariantID),
-	)
+	rowsAffected, err := r.q.RemoveFromCart(ctx, dbgen.RemoveFromCartParams{
+		UserID:     int32(deleteParams.UserID),
+		VariantIds: deleteParams.VariantID,
+	})
 	if err != nil {
 		log.Error("failed to execute delete cart query", zap.Error(err))
 		return ErrFailedRemoveCart
 	}
 
-	rowsAffected, err := res.RowsAffected()
-	if err != nil {
-		log.Error("failed to read rows affected", zap.Error(err))
-		return ErrFailedRemoveCart
-	}
-
 	if rowsAffected == 0 {
 		log.Info("no cart item found to delete")
 		return ErrCartItemNotFound
@@ -151,21 +149,12 @@ func (r *repository) ClearCart(
 		zap.Uint("user_id", userID),
 	)
 
-	res, err := r.db.ExecContext(ctx, `
-		DELETE FROM carts
-		WHERE user_id = $1
-	`, userID)
+	rowsAffected, err := r.q.ClearCart(ctx, int32(userID))
 	if err != nil {
 		log.Error("failed to execute clear cart query", zap.Error(err))
 		return ErrFailedClearCart
 	}
 
-	rowsAffected, err := res.RowsAffected()
-	if err != nil {
-		log.Error("failed to read rows affected", zap.Error(err))
-		return ErrFailedClearCart
-	}
-
 	if rowsAffected == 0 {
 		log.Info("cart already empty")
 		return ErrCartEmpty
@@ -187,33 +176,10 @@ func (r *repository) GetCartItemByUserAndVariant(
 		zap.String("variant_id", variantID),
 	)
 
-	query := `
-		SELECT
-			id,
-			user_id,
-			variant_id,
-			quantity,
-			created_at,
-			updated_at
-		FROM carts
-		WHERE user_id = $1 AND variant_id = $2
-	`
-
-	item := &CartItem{
-		Product: &ProductCart{
-			Variant: VariantCart{},
-		},
-	}
-
-	row := r.db.QueryRowContext(ctx, query, userID, variantID)
-	err := row.Scan(
-		&item.ID,
-		&item.UserID,
-		&item.Product.Variant.ID,
-		&item.Quantity,
-		&item.CreatedAt,
-		&item.UpdatedAt,
-	)
+	row, err := r.q.GetCartItemByUserAndVariant(ctx, dbgen.GetCartItemByUserAndVariantParams{
+		UserID:    int32(userID),
+		VariantID: sql.NullString{String: variantID, Valid: true},
+	})
 
 	if err == sql.ErrNoRows {
 		log.Info("cart item not found")
@@ -226,7 +192,7 @@ func (r *repository) GetCartItemByUserAndVariant(
 	}
 
 	log.Debug("cart item fetched successfully")
-	return item, nil
+	return cartItemFromRow(dbgen.CreateCartItemRow(row)), nil
 }
 func (r *repository) UpdateCartItemQuantity(
 	ctx context.Context,
@@ -246,35 +212,17 @@ func (r *repository) UpdateCartItemQuantity(
 		return nil, ErrInvalidQuantity
 	}
 
-	query := `
-		UPDATE carts
-		SET quantity = $1,
-		    updated_at = NOW()
-		WHERE id = $2
-		RETURNING
-			id,
-			user_id,
-			variant_id,
-			quantity,
-			created_at,
-			updated_at
-	`
-
-	item := &CartItem{
-		Product: &ProductCart{
-			Variant: VariantCart{},
-		},
+	// carts.id is a serial; a non-numeric ID cannot match any row.
+	id, err := strconv.Atoi(cartItemID)
+	if err != nil {
+		log.Info("cart item not found for update")
+		return nil, ErrCartItemNotFound
 	}
 
-	row := r.db.QueryRowContext(ctx, query, quantity, cartItemID)
-	err := row.Scan(
-		&item.ID,
-		&item.UserID,
-		&item.Product.Variant.ID,
-		&item.Quantity,
-		&item.CreatedAt,
-		&item.UpdatedAt,
-	)
+	row, err := r.q.UpdateCartItemQuantity(ctx, dbgen.UpdateCartItemQuantityParams{
+		Quantity: int32(quantity),
+		ID:       int32(id),
+	})
 
 	if err == sql.ErrNoRows {
 		log.Info("cart item not found for update")
@@ -287,7 +235,7 @@ func (r *repository) UpdateCartItemQuantity(
 	}
 
 	log.Info("cart item quantity updated successfully")
-	return item, nil
+	return cartItemFromRow(dbgen.CreateCartItemRow(row)), nil
 }
 
 func (r *repository) CreateCartItem(
@@ -307,44 +255,11 @@ func (r *repository) CreateCartItem(
 		return nil, ErrInvalidQuantity
 	}
 
-	query := `
-		INSERT INTO carts (
-			user_id,
-			variant_id,
-			quantity
-		)
-		VALUES ($1, $2, $3)
-		RETURNING
-			id,
-			user_id,
-			variant_id,
-			quantity,
-			created_at,
-			updated_at
-	`
-
-	item := &CartItem{
-		Product: &ProductCart{
-			Variant: VariantCart{},
-		},
-	}
-
-	row := r.db.QueryRowContext(
-		ctx,
-		query,
-		params.UserID,
-		params.VariantID,
-		params.Quantity,
-	)
-
-	err := row.Scan(
-		&item.ID,
-		&item.UserID,
-		&item.Product.Variant.ID,
-		&item.Quantity,
-		&item.CreatedAt,
-		&item.UpdatedAt,
-	)
+	row, err := r.q.CreateCartItem(ctx, dbgen.CreateCartItemParams{
+		UserID:    int32(params.UserID),
+		VariantID: sql.NullString{String: params.VariantID, Valid: true},
+		Quantity:  int32(params.Quantity),
+	})
 	if err != nil {
 		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code == pq.ErrorCode(PgUniqueViolation) {
 			log.Info("cart item already exists",
@@ -357,6 +272,7 @@ func (r *repository) CreateCartItem(
 		return nil, ErrFailedCreateCartItem
 	}
 
+	item := cartItemFromRow(row)
 	log.Info("cart item created successfully",
 		zap.String("cart_item_id", item.ID),
 	)
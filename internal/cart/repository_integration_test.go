@@ -0,0 +1,65 @@
+//go:build integration
+
+package cart
+
+import (
+	"context"
+	"testing"
+
+	"warimas-be/internal/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepositoryIntegration_CartItemLifecycle(t *testing.T) {
+	db := testutil.NewDB(t)
+	repo := NewRepository(db)
+	ctx := context.Background()
+
+	userID := testutil.CreateUser(t, db)
+	sellerID := testutil.CreateSeller(t, db, testutil.CreateUser(t, db))
+	variant := testutil.CreateVariant(t, db, sellerID, 25000, 10)
+
+	created, err := repo.CreateCartItem(ctx, CreateCartItemParams{
+		UserID:    uint(userID),
+		VariantID: variant.VariantID,
+		Quantity:  2,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, variant.VariantID, created.Product.Variant.ID)
+
+	found, err := repo.GetCartItemByUserAndVariant(ctx, uint(userID), variant.VariantID)
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, created.ID, found.ID)
+
+	updated, err := repo.UpdateCartItemQuantity(ctx, created.ID, 5)
+	require.NoError(t, err)
+	assert.Equal(t, int32(5), updated.Quantity)
+
+	rows, err := repo.GetCartRows(ctx, uint(userID), nil, nil, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, rows, 1)
+	assert.Equal(t, variant.VariantID, rows[0].VariantID)
+
+	count, err := repo.CountCartItems(ctx, uint(userID), nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+
+	require.NoError(t, repo.RemoveFromCart(ctx, DeleteFromCartParams{
+		UserID:    uint32(userID),
+		VariantID: []string{variant.VariantID},
+	}))
+	assert.Equal(t, ErrCartEmpty, repo.ClearCart(ctx, uint(userID)))
+}
+
+func TestRepositoryIntegration_GetCartItemMissing(t *testing.T) {
+	db := testutil.NewDB(t)
+	repo := NewRepository(db)
+
+	userID := testutil.CreateUser(t, db)
+	item, err := repo.GetCartItemByUserAndVariant(context.Background(), uint(userID), "00000000-0000-0000-0000-000000000000")
+	require.NoError(t, err)
+	assert.Nil(t, item)
+}
@@ -27,7 +27,7 @@ func TestRepository_CreateCartItem(t *testing.T) {
 
 	t.Run("Success", func(t *testing.T) {
 		rows := sqlmock.NewRows([]string{"id", "user_id", "variant_id", "quantity", "created_at", "updated_at"}).
-			AddRow("cart-1", 1, "var-1", 2, time.Now(), nil)
+			AddRow(1, 1, "var-1", 2, time.Now(), time.Now())
 
 		mock.ExpectQuery("INSERT INTO carts").
 			WithArgs(params.UserID, params.VariantID, params.Quantity).
@@ -36,7 +36,7 @@ func TestRepository_CreateCartItem(t *testing.T) {
 		res, err := repo.CreateCartItem(context.Background(), params)
 		assert.NoError(t, err)
 		assert.NotNil(t, res)
-		assert.Equal(t, "cart-1", res.ID)
+		assert.Equal(t, "1", res.ID)
 	})
 
 	t.Run("Error", func(t *testing.T) {
@@ -170,7 +170,7 @@ func TestRepository_GetCartItemByUserAndVariant(t *testing.T) {
 
 	t.Run("Success", func(t *testing.T) {
 		rows := sqlmock.NewRows([]string{"id", "user_id", "variant_id", "quantity", "created_at", "updated_at"}).
-			AddRow("cart-1", 1, "var-1", 2, time.Now(), nil)
+			AddRow(1, 1, "var-1", 2, time.Now(), time.Now())
 
 		mock.ExpectQuery("SELECT .* FROM carts").
 			WithArgs(userID, variantID).
@@ -179,7 +179,7 @@ func TestRepository_GetCartItemByUserAndVariant(t *testing.T) {
 		item, err := repo.GetCartItemByUserAndVariant(context.Background(), userID, variantID)
 		assert.NoError(t, err)
 		assert.NotNil(t, item)
-		assert.Equal(t, "cart-1", item.ID)
+		assert.Equal(t, "1", item.ID)
 	})
 
 	t.Run("NotFound", func(t *testing.T) {
@@ -199,15 +199,15 @@ func TestRepository_UpdateCartItemQuantity(t *testing.T) {
 	defer db.Close()
 
 	repo := NewRepository(db)
-	cartItemID := "cart-1"
+	cartItemID := "1"
 	quantity := uint32(5)
 
 	t.Run("Success", func(t *testing.T) {
 		rows := sqlmock.NewRows([]string{"id", "user_id", "variant_id", "quantity", "created_at", "updated_at"}).
-			AddRow("cart-1", 1, "var-1", 5, time.Now(), nil)
+			AddRow(1, 1, "var-1", 5, time.Now(), time.Now())
 
 		mock.ExpectQuery("UPDATE carts").
-			WithArgs(quantity, cartItemID).
+			WithArgs(quantity, 1).
 			WillReturnRows(rows)
 
 		item, err := repo.UpdateCartItemQuantity(context.Background(), cartItemID, quantity)
@@ -221,6 +221,11 @@ func TestRepository_UpdateCartItemQuantity(t *testing.T) {
 		assert.Error(t, err)
 		assert.Equal(t, ErrInvalidQuantity, err)
 	})
+
+	t.Run("NonNumericID", func(t *testing.T) {
+		_, err := repo.UpdateCartItemQuantity(context.Background(), "cart-1", quantity)
+		assert.Equal(t, ErrCartItemNotFound, err)
+	})
 }
 
 func TestRepository_ClearCart(t *testing.T) {
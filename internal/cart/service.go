@@ -3,6 +3,7 @@ package cart
 import (
 	"context"
 	"errors"
+	"warimas-be/internal/apperr"
 	"warimas-be/internal/graph/model"
 	"warimas-be/internal/logger"
 	"warimas-be/internal/product"
@@ -12,7 +13,7 @@ import (
 )
 
 var (
-	ErrProductNotFound   = errors.New("product not found")
+	ErrProductNotFound   = apperr.NotFound("product not found")
 	ErrInsufficientStock = errors.New("insufficient stock")
 )
 
@@ -59,7 +60,7 @@ func (s *service) AddToCart(
 	userID, ok := utils.GetUserIDFromContext(ctx)
 	if !ok {
 		log.Warn("unauthorized user")
-		return nil, errors.New("unauthorized")
+		return nil, ErrUnauthorized
 	}
 	log = log.With(zap.Uint("user_id", userID))
 
@@ -276,7 +277,7 @@ func (s *service) RemoveFromCart(
 	)
 
 	userID, ok := utils.GetUserIDFromContext(ctx)
-	if !ok || userID == 0 {
+	if !ok {
 		log.Warn("user not authenticated")
 		return ErrUserNotAuthenticated
 	}
@@ -308,7 +309,7 @@ func (s *service) ClearCart(ctx context.Context) error {
 	)
 
 	userID, ok := utils.GetUserIDFromContext(ctx)
-	if !ok || userID == 0 {
+	if !ok {
 		log.Warn("user not authenticated")
 		return ErrUserNotAuthenticated
 	}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"testing"
 	"warimas-be/internal/graph/model"
+	"warimas-be/internal/moderation"
 	"warimas-be/internal/product"
 	"warimas-be/internal/utils"
 
@@ -114,14 +115,36 @@ func (m *MockProductRepository) GetProductByID(ctx context.Context, opts product
 	return args.Get(0).(*product.Product), args.Error(1)
 }
 
+func (m *MockProductRepository) GetProductsByIDs(ctx context.Context, ids []string, onlyActive bool) ([]*product.Product, error) {
+	args := m.Called(ctx, ids, onlyActive)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*product.Product), args.Error(1)
+}
+
 func (m *MockProductRepository) Create(ctx context.Context, input product.NewProductInput, sellerID string) (product.Product, error) {
 	args := m.Called(ctx, input, sellerID)
 	return args.Get(0).(product.Product), args.Error(1)
 }
 
-func (m *MockProductRepository) Update(ctx context.Context, input product.UpdateProductInput, sellerID string) (product.Product, error) {
+func (m *MockProductRepository) Update(ctx context.Context, input product.UpdateProductInput, sellerID string) (product.Product, []*product.CartRemoval, error) {
 	args := m.Called(ctx, input, sellerID)
-	return args.Get(0).(product.Product), args.Error(1)
+	var removed []*product.CartRemoval
+	if args.Get(1) != nil {
+		removed = args.Get(1).([]*product.CartRemoval)
+	}
+	return args.Get(0).(product.Product), removed, args.Error(2)
+}
+
+func (m *MockProductRepository) SetStatus(ctx context.Context, productID, sellerID, status string) (product.Product, []*product.CartRemoval, error) {
+	args := m.Called(ctx, productID, sellerID, status)
+	return args.Get(0).(product.Product), nil, args.Error(2)
+}
+
+func (m *MockProductRepository) ArchiveCascade(ctx context.Context, productID, sellerID string) (product.Product, []*product.CartRemoval, error) {
+	args := m.Called(ctx, productID, sellerID)
+	return args.Get(0).(product.Product), nil, args.Error(2)
 }
 
 func (m *MockProductRepository) GetList(ctx context.Context, opts product.ProductQueryOptions) ([]*product.Product, *int, error) {
@@ -145,6 +168,26 @@ func (m *MockProductRepository) GetProductsByGroup(ctx context.Context, opts pro
 	return args.Get(0).([]product.ProductByCategory), args.Error(1)
 }
 
+func (m *MockProductRepository) ModerationContent(ctx context.Context, t moderation.ContentType, id string) (*moderation.Content, error) {
+	args := m.Called(ctx, t, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*moderation.Content), args.Error(1)
+}
+
+func (m *MockProductRepository) RemoveModerationContent(ctx context.Context, t moderation.ContentType, id string) error {
+	return m.Called(ctx, t, id).Error(0)
+}
+
+func (m *MockProductRepository) HideModerationContent(ctx context.Context, t moderation.ContentType, id string) error {
+	return m.Called(ctx, t, id).Error(0)
+}
+
+func (m *MockProductRepository) UnhideModerationContent(ctx context.Context, t moderation.ContentType, id string) error {
+	return m.Called(ctx, t, id).Error(0)
+}
+
 func TestService_GetCartCount(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		// Arrange
@@ -239,7 +282,7 @@ func TestService_AddToCart(t *testing.T) {
 		_, err := svc.AddToCart(context.Background(), params) // Empty context
 
 		assert.Error(t, err)
-		assert.Equal(t, "unauthorized", err.Error())
+		assert.ErrorIs(t, err, ErrUnauthorized)
 	})
 
 	t.Run("Error - Product Not Found", func(t *testing.T) {
@@ -0,0 +1,8 @@
+package changelog
+
+import "errors"
+
+var (
+	ErrDB            = errors.New("database error")
+	ErrInvalidCursor = errors.New("invalid cursor")
+)
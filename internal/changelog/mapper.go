@@ -0,0 +1,28 @@
+package changelog
+
+import (
+	"strconv"
+	"warimas-be/internal/graph/model"
+)
+
+func MapOrderChangeToGraphQL(c *OrderChange) *model.OrderChange {
+	return &model.OrderChange{
+		ID:              strconv.FormatInt(c.ID, 10),
+		OrderID:         strconv.Itoa(int(c.OrderID)),
+		OrderExternalID: c.OrderExternalID,
+		Operation:       model.ChangeOperation(c.Operation),
+		ChangedColumns:  c.ChangedColumns,
+		ChangedAt:       c.ChangedAt,
+	}
+}
+
+func MapProductChangeToGraphQL(c *ProductChange) *model.ProductChange {
+	return &model.ProductChange{
+		ID:             strconv.FormatInt(c.ID, 10),
+		ProductID:      c.ProductID,
+		VariantID:      c.VariantID,
+		Operation:      model.ChangeOperation(c.Operation),
+		ChangedColumns: c.ChangedColumns,
+		ChangedAt:      c.ChangedAt,
+	}
+}
@@ -0,0 +1,44 @@
+package changelog
+
+import "time"
+
+// SettleDelay holds back changes younger than this. Change IDs are taken
+// when a row is written but become visible at commit, so a slow
+// transaction can commit an ID below one already returned; waiting lets
+// it land before a reader's cursor moves past it.
+const SettleDelay = 10 * time.Second
+
+const (
+	defaultLimit = 100
+	maxLimit     = 1000
+)
+
+type Operation string
+
+const (
+	OperationInsert Operation = "INSERT"
+	OperationUpdate Operation = "UPDATE"
+	OperationDelete Operation = "DELETE"
+)
+
+// OrderChange is one row of order_changes. OrderExternalID is empty once
+// the order has been deleted.
+type OrderChange struct {
+	ID              int64
+	OrderID         int32
+	OrderExternalID string
+	Operation       Operation
+	ChangedColumns  []string
+	ChangedAt       time.Time
+}
+
+// ProductChange is one row of product_changes; VariantID is nil for a
+// change to the product row itself.
+type ProductChange struct {
+	ID             int64
+	ProductID      string
+	VariantID      *string
+	Operation      Operation
+	ChangedColumns []string
+	ChangedAt      time.Time
+}
@@ -0,0 +1,102 @@
+package changelog
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"warimas-be/internal/logger"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+type Repository interface {
+	// ListOrderChanges returns changes with an ID above afterID recorded
+	// before until, oldest first.
+	ListOrderChanges(ctx context.Context, afterID int64, until time.Time, limit int32) ([]*OrderChange, error)
+	// ListProductChanges does the same for products and variants.
+	ListProductChanges(ctx context.Context, afterID int64, until time.Time, limit int32) ([]*ProductChange, error)
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) ListOrderChanges(ctx context.Context, afterID int64, until time.Time, limit int32) ([]*OrderChange, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ListOrderChanges"),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT c.id, c.order_id, COALESCE(o.external_id, ''), c.operation,
+		       c.changed_columns, c.changed_at
+		FROM order_changes c
+		LEFT JOIN orders o ON o.id = c.order_id
+		WHERE c.id > $1
+		  AND c.changed_at < $2
+		ORDER BY c.id
+		LIMIT $3
+	`, afterID, until, limit)
+	if err != nil {
+		log.Error("failed to query order changes", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	changes := []*OrderChange{}
+	for rows.Next() {
+		var c OrderChange
+		if err := rows.Scan(&c.ID, &c.OrderID, &c.OrderExternalID, &c.Operation, pq.Array(&c.ChangedColumns), &c.ChangedAt); err != nil {
+			log.Error("failed to scan order change", zap.Error(err))
+			return nil, ErrDB
+		}
+		changes = append(changes, &c)
+	}
+	if err := rows.Err(); err != nil {
+		log.Error("failed to iterate order changes", zap.Error(err))
+		return nil, ErrDB
+	}
+	return changes, nil
+}
+
+func (r *repository) ListProductChanges(ctx context.Context, afterID int64, until time.Time, limit int32) ([]*ProductChange, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ListProductChanges"),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, product_id, variant_id, operation, changed_columns, changed_at
+		FROM product_changes
+		WHERE id > $1
+		  AND changed_at < $2
+		ORDER BY id
+		LIMIT $3
+	`, afterID, until, limit)
+	if err != nil {
+		log.Error("failed to query product changes", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	changes := []*ProductChange{}
+	for rows.Next() {
+		var c ProductChange
+		if err := rows.Scan(&c.ID, &c.ProductID, &c.VariantID, &c.Operation, pq.Array(&c.ChangedColumns), &c.ChangedAt); err != nil {
+			log.Error("failed to scan product change", zap.Error(err))
+			return nil, ErrDB
+		}
+		changes = append(changes, &c)
+	}
+	if err := rows.Err(); err != nil {
+		log.Error("failed to iterate product changes", zap.Error(err))
+		return nil, ErrDB
+	}
+	return changes, nil
+}
@@ -0,0 +1,68 @@
+package changelog
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_ListOrderChanges(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	until := time.Now()
+
+	rows := sqlmock.NewRows([]string{"id", "order_id", "external_id", "operation", "changed_columns", "changed_at"}).
+		AddRow(int64(11), int32(3), "ord-3", "UPDATE", "{status,updated_by}", until.Add(-time.Minute)).
+		AddRow(int64(12), int32(4), "", "DELETE", "{}", until.Add(-time.Minute))
+	mock.ExpectQuery(`SELECT .* FROM order_changes c LEFT JOIN orders o ON o.id = c.order_id WHERE c.id > \$1 AND c.changed_at < \$2 ORDER BY c.id LIMIT \$3`).
+		WithArgs(int64(10), until, int32(100)).
+		WillReturnRows(rows)
+
+	changes, err := repo.ListOrderChanges(context.Background(), 10, until, 100)
+	assert.NoError(t, err)
+	require.Len(t, changes, 2)
+	assert.Equal(t, OperationUpdate, changes[0].Operation)
+	assert.Equal(t, []string{"status", "updated_by"}, changes[0].ChangedColumns)
+	assert.Equal(t, "", changes[1].OrderExternalID)
+	assert.Empty(t, changes[1].ChangedColumns)
+
+	mock.ExpectQuery(`SELECT .* FROM order_changes`).WillReturnError(errors.New("boom"))
+	_, err = repo.ListOrderChanges(context.Background(), 0, until, 100)
+	assert.ErrorIs(t, err, ErrDB)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ListProductChanges(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	until := time.Now()
+	variantID := "6f1c2a52-5b0e-4a47-9a1c-2b4f2f0b8c11"
+
+	rows := sqlmock.NewRows([]string{"id", "product_id", "variant_id", "operation", "changed_columns", "changed_at"}).
+		AddRow(int64(1), "0d7c6a1e-88d5-4f7e-9a52-1f0a7cf0e3b2", nil, "INSERT", "{}", until).
+		AddRow(int64(2), "0d7c6a1e-88d5-4f7e-9a52-1f0a7cf0e3b2", variantID, "UPDATE", "{price}", until)
+	mock.ExpectQuery(`SELECT .* FROM product_changes WHERE id > \$1 AND changed_at < \$2 ORDER BY id LIMIT \$3`).
+		WithArgs(int64(0), until, int32(50)).
+		WillReturnRows(rows)
+
+	changes, err := repo.ListProductChanges(context.Background(), 0, until, 50)
+	assert.NoError(t, err)
+	require.Len(t, changes, 2)
+	assert.Nil(t, changes[0].VariantID)
+	require.NotNil(t, changes[1].VariantID)
+	assert.Equal(t, variantID, *changes[1].VariantID)
+	assert.Equal(t, []string{"price"}, changes[1].ChangedColumns)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,60 @@
+package changelog
+
+import (
+	"context"
+	"strconv"
+	"time"
+)
+
+// Service serves the change logs to sync jobs. Callers are authorized by
+// API key scope in the GraphQL layer.
+type Service interface {
+	// OrderChanges returns the order changes after cursor, the ID of the
+	// last change the caller has seen ("" to start from the beginning).
+	OrderChanges(ctx context.Context, cursor string, limit int32) ([]*OrderChange, error)
+	// ProductChanges does the same for products and variants.
+	ProductChanges(ctx context.Context, cursor string, limit int32) ([]*ProductChange, error)
+}
+
+type service struct {
+	repo Repository
+	now  func() time.Time
+}
+
+func NewService(repo Repository) Service {
+	return &service{repo: repo, now: time.Now}
+}
+
+func (s *service) OrderChanges(ctx context.Context, cursor string, limit int32) ([]*OrderChange, error) {
+	after, err := parseCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListOrderChanges(ctx, after, s.now().Add(-SettleDelay), clampLimit(limit))
+}
+
+func (s *service) ProductChanges(ctx context.Context, cursor string, limit int32) ([]*ProductChange, error) {
+	after, err := parseCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListProductChanges(ctx, after, s.now().Add(-SettleDelay), clampLimit(limit))
+}
+
+func parseCursor(cursor string) (int64, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+	id, err := strconv.ParseInt(cursor, 10, 64)
+	if err != nil || id < 0 {
+		return 0, ErrInvalidCursor
+	}
+	return id, nil
+}
+
+func clampLimit(limit int32) int32 {
+	if limit <= 0 {
+		return defaultLimit
+	}
+	return min(limit, maxLimit)
+}
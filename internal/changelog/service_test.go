@@ -0,0 +1,96 @@
+package changelog
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// --- Mocks ---
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) ListOrderChanges(ctx context.Context, afterID int64, until time.Time, limit int32) ([]*OrderChange, error) {
+	args := m.Called(ctx, afterID, until, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*OrderChange), args.Error(1)
+}
+
+func (m *MockRepository) ListProductChanges(ctx context.Context, afterID int64, until time.Time, limit int32) ([]*ProductChange, error) {
+	args := m.Called(ctx, afterID, until, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ProductChange), args.Error(1)
+}
+
+func newTestService(repo Repository, now time.Time) *service {
+	s := NewService(repo).(*service)
+	s.now = func() time.Time { return now }
+	return s
+}
+
+// --- Tests ---
+
+func TestService_OrderChanges(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	until := now.Add(-SettleDelay)
+
+	t.Run("FromStart", func(t *testing.T) {
+		repo := new(MockRepository)
+		s := newTestService(repo, now)
+		repo.On("ListOrderChanges", ctx, int64(0), until, int32(defaultLimit)).
+			Return([]*OrderChange{{ID: 1}}, nil)
+
+		changes, err := s.OrderChanges(ctx, "", 0)
+		assert.NoError(t, err)
+		assert.Len(t, changes, 1)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("AfterCursorClampsLimit", func(t *testing.T) {
+		repo := new(MockRepository)
+		s := newTestService(repo, now)
+		repo.On("ListOrderChanges", ctx, int64(42), until, int32(maxLimit)).
+			Return([]*OrderChange{}, nil)
+
+		_, err := s.OrderChanges(ctx, "42", 100000)
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("InvalidCursor", func(t *testing.T) {
+		repo := new(MockRepository)
+		s := newTestService(repo, now)
+
+		_, err := s.OrderChanges(ctx, "abc", 10)
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+
+		_, err = s.OrderChanges(ctx, "-1", 10)
+		assert.ErrorIs(t, err, ErrInvalidCursor)
+		repo.AssertNotCalled(t, "ListOrderChanges", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestService_ProductChanges(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	repo := new(MockRepository)
+	s := newTestService(repo, now)
+
+	repo.On("ListProductChanges", ctx, int64(7), now.Add(-SettleDelay), int32(20)).
+		Return([]*ProductChange{{ID: 8}, {ID: 9}}, nil)
+
+	changes, err := s.ProductChanges(ctx, "7", 20)
+	assert.NoError(t, err)
+	assert.Len(t, changes, 2)
+	repo.AssertExpectations(t)
+}
@@ -0,0 +1,20 @@
+package commission
+
+import (
+	"errors"
+	"warimas-be/internal/apperr"
+)
+
+var (
+	ErrUnauthenticated    = apperr.Unauthenticated("unauthenticated")
+	ErrForbidden          = apperr.Forbidden("forbidden")
+	ErrInvalidRate        = errors.New("rate must be between 0 and 10000 basis points and the fee must not be negative")
+	ErrEffectiveInPast    = errors.New("a new rate cannot take effect in the past")
+	ErrRateExists         = errors.New("a rate already takes effect at that time for this category")
+	ErrCategoryNotFound   = apperr.NotFound("category not found")
+	ErrRateNotFound       = apperr.NotFound("commission rate not found")
+	ErrRateInEffect       = errors.New("a rate that has taken effect cannot be deleted")
+	ErrDB                 = errors.New("database error")
+	PgUniqueViolation     = "23505"
+	PgForeignKeyViolation = "23503"
+)
@@ -0,0 +1,17 @@
+package commission
+
+import (
+	"strconv"
+	"warimas-be/internal/graph/model"
+)
+
+func MapRateToGraphQL(r *Rate) *model.CommissionRate {
+	return &model.CommissionRate{
+		ID:            strconv.FormatInt(r.ID, 10),
+		CategoryID:    r.CategoryID,
+		RateBps:       r.RateBps,
+		FixedFee:      int32(r.FixedFee),
+		EffectiveFrom: r.EffectiveFrom,
+		CreatedAt:     r.CreatedAt,
+	}
+}
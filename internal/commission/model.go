@@ -0,0 +1,24 @@
+package commission
+
+import "time"
+
+// Rate is the commission charged on order lines of a category from
+// EffectiveFrom until the next rate of that category takes effect. A nil
+// CategoryID makes it the default for categories without a rate.
+type Rate struct {
+	ID            int64
+	CategoryID    *string
+	RateBps       int32
+	FixedFee      int64
+	EffectiveFrom time.Time
+	CreatedBy     *int32
+	CreatedAt     time.Time
+}
+
+type CreateRateInput struct {
+	CategoryID *string
+	RateBps    int32
+	FixedFee   int64
+	// Nil means the rate takes effect immediately.
+	EffectiveFrom *time.Time
+}
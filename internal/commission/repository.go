@@ -0,0 +1,157 @@
+package commission
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+	"warimas-be/internal/logger"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+type Repository interface {
+	CreateRate(ctx context.Context, in *CreateRateInput, effectiveFrom time.Time, createdBy uint) (*Rate, error)
+	// ListRates returns every version of a category's rates, or of the
+	// default rate when categoryID is nil, newest first.
+	ListRates(ctx context.Context, categoryID *string) ([]*Rate, error)
+	// RateAt returns the rate an order line of the category placed at at
+	// would be charged, falling back to the default rate. It is nil when
+	// neither exists.
+	RateAt(ctx context.Context, categoryID string, at time.Time) (*Rate, error)
+	// DeleteRate removes a rate that has not taken effect by now.
+	DeleteRate(ctx context.Context, id int64, now time.Time) error
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+const rateColumns = `id, category_id, rate_bps, fixed_fee, effective_from, created_by, created_at`
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanRate(s scanner) (*Rate, error) {
+	var r Rate
+	if err := s.Scan(&r.ID, &r.CategoryID, &r.RateBps, &r.FixedFee, &r.EffectiveFrom, &r.CreatedBy, &r.CreatedAt); err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+func pqCode(err error) string {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return string(pqErr.Code)
+	}
+	return ""
+}
+
+func (r *repository) CreateRate(ctx context.Context, in *CreateRateInput, effectiveFrom time.Time, createdBy uint) (*Rate, error) {
+	rate, err := scanRate(r.db.QueryRowContext(ctx, `
+		INSERT INTO category_commissions (category_id, rate_bps, fixed_fee, effective_from, created_by)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING `+rateColumns,
+		in.CategoryID, in.RateBps, in.FixedFee, effectiveFrom, createdBy,
+	))
+	switch pqCode(err) {
+	case PgUniqueViolation:
+		return nil, ErrRateExists
+	case PgForeignKeyViolation:
+		return nil, ErrCategoryNotFound
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to create commission rate", zap.Error(err))
+		return nil, ErrDB
+	}
+	return rate, nil
+}
+
+func (r *repository) ListRates(ctx context.Context, categoryID *string) ([]*Rate, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ListRates"),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+rateColumns+`
+		FROM category_commissions
+		WHERE category_id IS NOT DISTINCT FROM $1
+		ORDER BY effective_from DESC
+	`, categoryID)
+	if err != nil {
+		log.Error("failed to query commission rates", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	list := []*Rate{}
+	for rows.Next() {
+		rate, err := scanRate(rows)
+		if err != nil {
+			log.Error("failed to scan commission rate", zap.Error(err))
+			return nil, ErrDB
+		}
+		list = append(list, rate)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error("commission rate iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return list, nil
+}
+
+func (r *repository) RateAt(ctx context.Context, categoryID string, at time.Time) (*Rate, error) {
+	rate, err := scanRate(r.db.QueryRowContext(ctx, `
+		SELECT `+rateColumns+`
+		FROM category_commissions
+		WHERE (category_id = $1 OR category_id IS NULL)
+		  AND effective_from <= $2
+		ORDER BY category_id IS NULL, effective_from DESC
+		LIMIT 1
+	`, categoryID, at))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to load commission rate", zap.Error(err))
+		return nil, ErrDB
+	}
+	return rate, nil
+}
+
+func (r *repository) DeleteRate(ctx context.Context, id int64, now time.Time) error {
+	var effectiveFrom time.Time
+	err := r.db.QueryRowContext(ctx, `
+		DELETE FROM category_commissions
+		WHERE id = $1
+		  AND effective_from > $2
+		RETURNING effective_from
+	`, id, now).Scan(&effectiveFrom)
+	if errors.Is(err, sql.ErrNoRows) {
+		var exists bool
+		err := r.db.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM category_commissions WHERE id = $1)`, id).Scan(&exists)
+		if err != nil {
+			logger.FromCtx(ctx).Error("failed to load commission rate", zap.Error(err))
+			return ErrDB
+		}
+		if !exists {
+			return ErrRateNotFound
+		}
+		return ErrRateInEffect
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to delete commission rate", zap.Error(err))
+		return ErrDB
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package commission
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var rateCols = []string{"id", "category_id", "rate_bps", "fixed_fee", "effective_from", "created_by", "created_at"}
+
+func TestRepository_CreateRate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+	category := "cat-1"
+	from := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+	in := &CreateRateInput{CategoryID: &category, RateBps: 1250, FixedFee: 1000}
+
+	mock.ExpectQuery(`INSERT INTO category_commissions \(category_id, rate_bps, fixed_fee, effective_from, created_by\)`).
+		WithArgs(&category, int32(1250), int64(1000), from, uint(1)).
+		WillReturnRows(sqlmock.NewRows(rateCols).AddRow(3, category, 1250, 1000, from, 1, time.Now()))
+
+	r, err := repo.CreateRate(ctx, in, from, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, "cat-1", *r.CategoryID)
+
+	mock.ExpectQuery(`INSERT INTO category_commissions`).WillReturnError(&pq.Error{Code: pq.ErrorCode(PgUniqueViolation)})
+	_, err = repo.CreateRate(ctx, in, from, 1)
+	assert.ErrorIs(t, err, ErrRateExists)
+
+	mock.ExpectQuery(`INSERT INTO category_commissions`).WillReturnError(&pq.Error{Code: pq.ErrorCode(PgForeignKeyViolation)})
+	_, err = repo.CreateRate(ctx, in, from, 1)
+	assert.ErrorIs(t, err, ErrCategoryNotFound)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_RateAt(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+	at := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	// Falls back to the default rate, which has no category
+	mock.ExpectQuery(`WHERE \(category_id = \$1 OR category_id IS NULL\) AND effective_from <= \$2 ORDER BY category_id IS NULL, effective_from DESC`).
+		WithArgs("cat-1", at).
+		WillReturnRows(sqlmock.NewRows(rateCols).AddRow(1, nil, 1000, 0, at.Add(-time.Hour), nil, at))
+
+	r, err := repo.RateAt(ctx, "cat-1", at)
+	assert.NoError(t, err)
+	assert.Nil(t, r.CategoryID)
+
+	mock.ExpectQuery(`FROM category_commissions`).WillReturnError(sql.ErrNoRows)
+	r, err = repo.RateAt(ctx, "cat-1", at)
+	assert.NoError(t, err)
+	assert.Nil(t, r)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_DeleteRate(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	t.Run("Scheduled", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectQuery(`DELETE FROM category_commissions WHERE id = \$1 AND effective_from > \$2`).
+			WithArgs(int64(3), now).
+			WillReturnRows(sqlmock.NewRows([]string{"effective_from"}).AddRow(now.Add(time.Hour)))
+
+		assert.NoError(t, repo.DeleteRate(ctx, 3, now))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("InEffect", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectQuery(`DELETE FROM category_commissions`).
+			WillReturnRows(sqlmock.NewRows([]string{"effective_from"}))
+		mock.ExpectQuery(`SELECT EXISTS`).
+			WithArgs(int64(3)).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+		assert.ErrorIs(t, repo.DeleteRate(ctx, 3, now), ErrRateInEffect)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectQuery(`DELETE FROM category_commissions`).
+			WillReturnRows(sqlmock.NewRows([]string{"effective_from"}))
+		mock.ExpectQuery(`SELECT EXISTS`).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+		assert.ErrorIs(t, repo.DeleteRate(ctx, 3, now), ErrRateNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,115 @@
+package commission
+
+import (
+	"context"
+	"time"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+// Service manages the commission rates charged per category. Every method
+// is admin only. Rates are not edited once created; a change is a new
+// version with its own effective date, so orders already placed keep the
+// rate they were charged.
+type Service interface {
+	CreateRate(ctx context.Context, in *CreateRateInput) (*Rate, error)
+	ListRates(ctx context.Context, categoryID *string) ([]*Rate, error)
+	// EffectiveRate returns the rate a category is charged at at, or now
+	// when at is nil.
+	EffectiveRate(ctx context.Context, categoryID string, at *time.Time) (*Rate, error)
+	// DeleteRate withdraws a rate scheduled for the future.
+	DeleteRate(ctx context.Context, id int64) error
+}
+
+type service struct {
+	repo Repository
+	now  func() time.Time
+}
+
+func NewService(repo Repository) Service {
+	return &service{repo: repo, now: time.Now}
+}
+
+func (s *service) CreateRate(ctx context.Context, in *CreateRateInput) (*Rate, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "CreateRate"),
+	)
+
+	adminID, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.RateBps < 0 || in.RateBps > 10000 || in.FixedFee < 0 {
+		log.Warn("invalid commission rate", zap.Int32("rate_bps", in.RateBps), zap.Int64("fixed_fee", in.FixedFee))
+		return nil, ErrInvalidRate
+	}
+
+	now := s.now()
+	effectiveFrom := now
+	if in.EffectiveFrom != nil {
+		if in.EffectiveFrom.Before(now) {
+			return nil, ErrEffectiveInPast
+		}
+		effectiveFrom = *in.EffectiveFrom
+	}
+
+	rate, err := s.repo.CreateRate(ctx, in, effectiveFrom, adminID)
+	if err != nil {
+		log.Warn("failed to create commission rate", zap.Error(err))
+		return nil, err
+	}
+
+	log.Info("commission rate created",
+		zap.Int64("rate_id", rate.ID),
+		zap.Int32("rate_bps", rate.RateBps),
+		zap.Time("effective_from", rate.EffectiveFrom),
+	)
+	return rate, nil
+}
+
+func (s *service) ListRates(ctx context.Context, categoryID *string) ([]*Rate, error) {
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return s.repo.ListRates(ctx, categoryID)
+}
+
+func (s *service) EffectiveRate(ctx context.Context, categoryID string, at *time.Time) (*Rate, error) {
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	when := s.now()
+	if at != nil {
+		when = *at
+	}
+	return s.repo.RateAt(ctx, categoryID, when)
+}
+
+func (s *service) DeleteRate(ctx context.Context, id int64) error {
+	if _, err := requireAdmin(ctx); err != nil {
+		return err
+	}
+
+	if err := s.repo.DeleteRate(ctx, id, s.now()); err != nil {
+		return err
+	}
+
+	logger.FromCtx(ctx).Info("commission rate deleted", zap.Int64("rate_id", id))
+	return nil
+}
+
+func requireAdmin(ctx context.Context) (uint, error) {
+	userID, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		return 0, ErrUnauthenticated
+	}
+	if !utils.IsAdmin(ctx) {
+		return 0, ErrForbidden
+	}
+	return userID, nil
+}
@@ -0,0 +1,141 @@
+package commission
+
+import (
+	"context"
+	"testing"
+	"time"
+	"warimas-be/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// --- Mocks ---
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) CreateRate(ctx context.Context, in *CreateRateInput, effectiveFrom time.Time, createdBy uint) (*Rate, error) {
+	args := m.Called(ctx, in, effectiveFrom, createdBy)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Rate), args.Error(1)
+}
+
+func (m *MockRepository) ListRates(ctx context.Context, categoryID *string) ([]*Rate, error) {
+	args := m.Called(ctx, categoryID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Rate), args.Error(1)
+}
+
+func (m *MockRepository) RateAt(ctx context.Context, categoryID string, at time.Time) (*Rate, error) {
+	args := m.Called(ctx, categoryID, at)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Rate), args.Error(1)
+}
+
+func (m *MockRepository) DeleteRate(ctx context.Context, id int64, now time.Time) error {
+	args := m.Called(ctx, id, now)
+	return args.Error(0)
+}
+
+// --- Tests ---
+
+var (
+	adminCtx = utils.SetUserContext(context.Background(), 1, "admin@example.com", "ADMIN")
+	now      = time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+)
+
+func newTestService(repo Repository) *service {
+	return &service{repo: repo, now: func() time.Time { return now }}
+}
+
+func TestService_CreateRate(t *testing.T) {
+	category := "cat-1"
+
+	t.Run("TakesEffectNow", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := newTestService(mockRepo)
+
+		in := &CreateRateInput{CategoryID: &category, RateBps: 500}
+		mockRepo.On("CreateRate", adminCtx, in, now, uint(1)).Return(&Rate{ID: 3, RateBps: 500, EffectiveFrom: now}, nil)
+
+		r, err := svc.CreateRate(adminCtx, in)
+		assert.NoError(t, err)
+		assert.Equal(t, now, r.EffectiveFrom)
+	})
+
+	t.Run("Scheduled", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := newTestService(mockRepo)
+
+		from := now.Add(30 * 24 * time.Hour)
+		in := &CreateRateInput{CategoryID: &category, RateBps: 700, EffectiveFrom: &from}
+		mockRepo.On("CreateRate", adminCtx, in, from, uint(1)).Return(&Rate{ID: 4, EffectiveFrom: from}, nil)
+
+		_, err := svc.CreateRate(adminCtx, in)
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Backdated", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := newTestService(mockRepo)
+
+		from := now.Add(-time.Hour)
+		_, err := svc.CreateRate(adminCtx, &CreateRateInput{RateBps: 700, EffectiveFrom: &from})
+		assert.ErrorIs(t, err, ErrEffectiveInPast)
+		mockRepo.AssertNotCalled(t, "CreateRate", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("InvalidRate", func(t *testing.T) {
+		svc := newTestService(new(MockRepository))
+
+		_, err := svc.CreateRate(adminCtx, &CreateRateInput{RateBps: 10001})
+		assert.ErrorIs(t, err, ErrInvalidRate)
+
+		_, err = svc.CreateRate(adminCtx, &CreateRateInput{RateBps: 100, FixedFee: -1})
+		assert.ErrorIs(t, err, ErrInvalidRate)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		svc := newTestService(new(MockRepository))
+		ctx := utils.SetUserContext(context.Background(), 2, "user@example.com", "USER")
+
+		_, err := svc.CreateRate(ctx, &CreateRateInput{RateBps: 100})
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+}
+
+func TestService_EffectiveRate(t *testing.T) {
+	mockRepo := new(MockRepository)
+	svc := newTestService(mockRepo)
+
+	mockRepo.On("RateAt", adminCtx, "cat-1", now).Return(&Rate{ID: 3}, nil)
+	past := now.Add(-48 * time.Hour)
+	mockRepo.On("RateAt", adminCtx, "cat-1", past).Return(nil, nil)
+
+	r, err := svc.EffectiveRate(adminCtx, "cat-1", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), r.ID)
+
+	r, err = svc.EffectiveRate(adminCtx, "cat-1", &past)
+	assert.NoError(t, err)
+	assert.Nil(t, r)
+}
+
+func TestService_DeleteRate(t *testing.T) {
+	mockRepo := new(MockRepository)
+	svc := newTestService(mockRepo)
+
+	mockRepo.On("DeleteRate", adminCtx, int64(3), now).Return(ErrRateInEffect)
+
+	err := svc.DeleteRate(adminCtx, 3)
+	assert.ErrorIs(t, err, ErrRateInEffect)
+}
@@ -3,6 +3,7 @@ package config
 import (
 	"log"
 	"os"
+	"strconv"
 
 	"github.com/joho/godotenv"
 )
@@ -16,6 +17,118 @@ type Config struct {
 	AppPort         string
 	XenditSecretKey string
 	AppEnv          string
+	PIIKeys         string
+	PIIActiveKey    string
+	PIILookupKey    string
+	SentryDSN       string
+
+	// Outbound settings of the payment gateway clients. An empty proxy
+	// falls back to HTTPS_PROXY and NO_PROXY; the CA bundle is a PEM file
+	// trusted on top of the system roots.
+	GatewayProxyURL string
+	GatewayCABundle string
+	// Seconds each Xendit call may take; 0 keeps the default.
+	XenditTimeoutSeconds int
+
+	// "xendit" or "simulated". The simulated gateway takes no real
+	// payments: it settles each one as SimulatedPaymentOutcome (PAID,
+	// FAILED or NONE) after SimulatedPaymentDelaySeconds by posting a
+	// webhook to SimulatedWebhookURL, by default this server's own.
+	PaymentGateway               string
+	SimulatedPaymentOutcome      string
+	SimulatedPaymentDelaySeconds int
+	SimulatedWebhookURL          string
+
+	// The synthetic checkout canary buys variant SyntheticCheckoutVariantID
+	// as user SyntheticCheckoutUserID, paying through a simulated gateway,
+	// every SyntheticCheckoutIntervalMinutes (0 runs it only on demand). It
+	// is off without a variant.
+	SyntheticCheckoutUserID          int
+	SyntheticCheckoutVariantID       string
+	SyntheticCheckoutIntervalMinutes int
+	// Seconds the canary's order may take to be marked paid.
+	SyntheticCheckoutTimeoutSeconds int
+
+	// Fraction of unexpected errors sent to the error reporter; panics are
+	// always sent.
+	ErrorReportSampleRate float64
+
+	// Retention windows; 0 disables the policy.
+	RetentionCheckoutSessionDays int
+	RetentionGuestSessionDays    int
+	RetentionWebhookPayloadDays  int
+	RetentionStaleCartDays       int
+	RetentionOrderAnonymizeYears int
+	RetentionDryRun              bool
+
+	// Order fulfilment SLA windows in hours; 0 disables the rule.
+	SLAPaidToAcceptedHours    int
+	SLAAcceptedToShippedHours int
+
+	// What the hourly database health report flags: tables with at
+	// least this share of dead rows, statements averaging this long and
+	// queries running this long. 0 keeps the default.
+	DBHealthDeadTuplePercent int
+	DBHealthSlowStatementMs  int
+	DBHealthLongQuerySeconds int
+
+	// "full" or "read_only" pins maintenance mode on regardless of the
+	// database switch.
+	MaintenanceMode string
+
+	// Requests slower than this are logged as warnings; 0 disables it.
+	SlowRequestMs int
+
+	// Usage flagged as abusive: more calls of one field per user per day
+	// than AbuseDailyOps, or AbuseSpikeFactor times the user's weekly
+	// average. 0 disables the rule.
+	AbuseDailyOps    int
+	AbuseSpikeFactor int
+
+	// Comma separated Kafka brokers for domain events; empty logs the
+	// events instead of publishing them.
+	KafkaBrokers string
+	// Prefix of the event topics, e.g. "warimas" for "warimas.order.v1".
+	EventTopicPrefix string
+
+	// Where funnel analytics go: "log", "kafka" (the <prefix>.analytics.v1
+	// topic on KafkaBrokers) or "http" (posted to AnalyticsURL). Empty
+	// turns analytics off.
+	AnalyticsSink string
+	AnalyticsURL  string
+
+	// Directory uploaded files are kept in, and the URL they are served
+	// from; point UploadsBaseURL at a CDN in front of /uploads/ if any.
+	UploadsDir     string
+	UploadsBaseURL string
+
+	// Manual stock corrections moving more units than this wait for a
+	// second admin's approval; 0 disables the rule.
+	StockApprovalThreshold int
+
+	// Products reported by this many users are hidden from shoppers until
+	// a moderator decides; 0 never hides them.
+	ModerationHideReports int
+
+	// Gateway fee booked against each captured payment in the journal
+	// export: basis points of the amount plus a flat fee in rupiah.
+	PaymentFeeBps   int
+	PaymentFeeFixed int
+
+	// Rounding rules of currencies other than IDR, as
+	// CURRENCY:DECIMALS:MODE entries such as "USD:2:HALF_EVEN".
+	RoundingRules string
+
+	// Signs guest tokens; empty falls back to JWT_SECRET.
+	GuestTokenSecret string
+
+	// Headers the CDN puts the visitor's country and ISO 3166-2
+	// subdivision code in. Requests without them are looked up in
+	// MaxMind's GeoIP2 web service when the account is set.
+	GeoCountryHeader  string
+	GeoRegionHeader   string
+	MaxMindAccountID  string
+	MaxMindLicenseKey string
 }
 
 func LoadConfig() *Config {
@@ -30,6 +143,71 @@ func LoadConfig() *Config {
 		AppPort:         os.Getenv("APP_PORT"),
 		XenditSecretKey: os.Getenv("XENDIT_APIKEY"),
 		AppEnv:          os.Getenv("APP_ENV"),
+		PIIKeys:         os.Getenv("PII_KEYS"),
+		PIIActiveKey:    os.Getenv("PII_ACTIVE_KEY"),
+		PIILookupKey:    os.Getenv("PII_LOOKUP_KEY"),
+		SentryDSN:       os.Getenv("SENTRY_DSN"),
+
+		GatewayProxyURL:      os.Getenv("GATEWAY_PROXY_URL"),
+		GatewayCABundle:      os.Getenv("GATEWAY_CA_BUNDLE"),
+		XenditTimeoutSeconds: envInt("XENDIT_TIMEOUT_SECONDS", 8),
+
+		PaymentGateway:               envString("PAYMENT_GATEWAY", "xendit"),
+		SimulatedPaymentOutcome:      envString("SIMULATED_PAYMENT_OUTCOME", "PAID"),
+		SimulatedPaymentDelaySeconds: envInt("SIMULATED_PAYMENT_DELAY_SECONDS", 10),
+		SimulatedWebhookURL:          os.Getenv("SIMULATED_WEBHOOK_URL"),
+
+		SyntheticCheckoutUserID:          envInt("SYNTHETIC_CHECKOUT_USER_ID", 0),
+		SyntheticCheckoutVariantID:       os.Getenv("SYNTHETIC_CHECKOUT_VARIANT_ID"),
+		SyntheticCheckoutIntervalMinutes: envInt("SYNTHETIC_CHECKOUT_INTERVAL_MINUTES", 0),
+		SyntheticCheckoutTimeoutSeconds:  envInt("SYNTHETIC_CHECKOUT_TIMEOUT_SECONDS", 30),
+
+		ErrorReportSampleRate: envFloat("ERROR_REPORT_SAMPLE_RATE", 1),
+
+		RetentionCheckoutSessionDays: envInt("RETENTION_CHECKOUT_SESSION_DAYS", 30),
+		RetentionGuestSessionDays:    envInt("RETENTION_GUEST_SESSION_DAYS", 1),
+		RetentionWebhookPayloadDays:  envInt("RETENTION_WEBHOOK_PAYLOAD_DAYS", 90),
+		RetentionStaleCartDays:       envInt("RETENTION_STALE_CART_DAYS", 0),
+		RetentionOrderAnonymizeYears: envInt("RETENTION_ORDER_ANONYMIZE_YEARS", 10),
+		RetentionDryRun:              os.Getenv("RETENTION_DRY_RUN") == "true",
+
+		SLAPaidToAcceptedHours:    envInt("SLA_PAID_TO_ACCEPTED_HOURS", 24),
+		SLAAcceptedToShippedHours: envInt("SLA_ACCEPTED_TO_SHIPPED_HOURS", 48),
+
+		DBHealthDeadTuplePercent: envInt("DB_HEALTH_DEAD_TUPLE_PERCENT", 20),
+		DBHealthSlowStatementMs:  envInt("DB_HEALTH_SLOW_STATEMENT_MS", 500),
+		DBHealthLongQuerySeconds: envInt("DB_HEALTH_LONG_QUERY_SECONDS", 60),
+
+		MaintenanceMode: os.Getenv("MAINTENANCE_MODE"),
+		SlowRequestMs:   envInt("SLOW_REQUEST_MS", 1000),
+
+		AbuseDailyOps:    envInt("ABUSE_DAILY_OPS", 20000),
+		AbuseSpikeFactor: envInt("ABUSE_SPIKE_FACTOR", 10),
+
+		KafkaBrokers:     os.Getenv("KAFKA_BROKERS"),
+		EventTopicPrefix: envString("EVENT_TOPIC_PREFIX", "warimas"),
+
+		AnalyticsSink: os.Getenv("ANALYTICS_SINK"),
+		AnalyticsURL:  os.Getenv("ANALYTICS_URL"),
+
+		UploadsDir:     envString("UPLOADS_DIR", "uploads"),
+		UploadsBaseURL: envString("UPLOADS_BASE_URL", "/uploads/"),
+
+		StockApprovalThreshold: envInt("STOCK_APPROVAL_THRESHOLD", 100),
+
+		ModerationHideReports: envInt("MODERATION_HIDE_REPORTS", 3),
+
+		PaymentFeeBps:   envInt("PAYMENT_FEE_BPS", 0),
+		PaymentFeeFixed: envInt("PAYMENT_FEE_FIXED", 0),
+
+		RoundingRules: os.Getenv("ROUNDING_RULES"),
+
+		GuestTokenSecret: os.Getenv("GUEST_TOKEN_SECRET"),
+
+		GeoCountryHeader:  envString("GEO_COUNTRY_HEADER", "CF-IPCountry"),
+		GeoRegionHeader:   os.Getenv("GEO_REGION_HEADER"),
+		MaxMindAccountID:  os.Getenv("MAXMIND_ACCOUNT_ID"),
+		MaxMindLicenseKey: os.Getenv("MAXMIND_LICENSE_KEY"),
 	}
 
 	if cfg.DBHost == "" {
@@ -38,3 +216,41 @@ func LoadConfig() *Config {
 
 	return cfg
 }
+
+// envString reads a variable, falling back to def when it is unset.
+func envString(name, def string) string {
+	if v := os.Getenv(name); v != "" {
+		return v
+	}
+	return def
+}
+
+// envInt reads a non-negative integer variable, falling back to def when it
+// is unset or invalid.
+func envInt(name string, def int) int {
+	v := os.Getenv(name)
+	if v == "" {
+		return def
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil || n < 0 {
+		log.Printf("invalid %s=%q, using %d", name, v, def)
+		return def
+	}
+	return n
+}
+
+// envFloat reads a fraction between 0 and 1, falling back to def when it is
+// unset or invalid.
+func envFloat(name string, def float64) float64 {
+	v := os.Getenv(name)
+	if v == "" {
+		return def
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil || f < 0 || f > 1 {
+		log.Printf("invalid %s=%q, using %g", name, v, def)
+		return def
+	}
+	return f
+}
@@ -18,6 +18,8 @@ func TestLoadConfig(t *testing.T) {
 		t.Setenv("APP_PORT", "8080")
 		t.Setenv("XENDIT_APIKEY", "xendit_secret")
 		t.Setenv("APP_ENV", "test")
+		t.Setenv("PII_KEYS", "k1:c2VjcmV0")
+		t.Setenv("PII_ACTIVE_KEY", "k1")
 
 		cfg := LoadConfig()
 
@@ -30,5 +32,73 @@ func TestLoadConfig(t *testing.T) {
 		assert.Equal(t, "8080", cfg.AppPort)
 		assert.Equal(t, "xendit_secret", cfg.XenditSecretKey)
 		assert.Equal(t, "test", cfg.AppEnv)
+		assert.Equal(t, "k1:c2VjcmV0", cfg.PIIKeys)
+		assert.Equal(t, "k1", cfg.PIIActiveKey)
+	})
+
+	t.Run("Retention defaults and overrides", func(t *testing.T) {
+		t.Setenv("DB_HOST", "localhost")
+		t.Setenv("RETENTION_WEBHOOK_PAYLOAD_DAYS", "30")
+		t.Setenv("RETENTION_STALE_CART_DAYS", "-5")
+		t.Setenv("RETENTION_DRY_RUN", "true")
+
+		cfg := LoadConfig()
+
+		assert.Equal(t, 30, cfg.RetentionCheckoutSessionDays)
+		assert.Equal(t, 30, cfg.RetentionWebhookPayloadDays)
+		assert.Equal(t, 0, cfg.RetentionStaleCartDays)
+		assert.Equal(t, 10, cfg.RetentionOrderAnonymizeYears)
+		assert.True(t, cfg.RetentionDryRun)
+	})
+
+	t.Run("SLA defaults and overrides", func(t *testing.T) {
+		t.Setenv("DB_HOST", "localhost")
+		t.Setenv("SLA_ACCEPTED_TO_SHIPPED_HOURS", "72")
+
+		cfg := LoadConfig()
+
+		assert.Equal(t, 24, cfg.SLAPaidToAcceptedHours)
+		assert.Equal(t, 72, cfg.SLAAcceptedToShippedHours)
+	})
+
+	t.Run("Gateway outbound settings", func(t *testing.T) {
+		t.Setenv("DB_HOST", "localhost")
+
+		cfg := LoadConfig()
+		assert.Empty(t, cfg.GatewayProxyURL)
+		assert.Empty(t, cfg.GatewayCABundle)
+		assert.Equal(t, 8, cfg.XenditTimeoutSeconds)
+
+		t.Setenv("GATEWAY_PROXY_URL", "http://proxy.internal:3128")
+		t.Setenv("GATEWAY_CA_BUNDLE", "/etc/ssl/corp-ca.pem")
+		t.Setenv("XENDIT_TIMEOUT_SECONDS", "5")
+
+		cfg = LoadConfig()
+		assert.Equal(t, "http://proxy.internal:3128", cfg.GatewayProxyURL)
+		assert.Equal(t, "/etc/ssl/corp-ca.pem", cfg.GatewayCABundle)
+		assert.Equal(t, 5, cfg.XenditTimeoutSeconds)
+	})
+
+	t.Run("DB health defaults and overrides", func(t *testing.T) {
+		t.Setenv("DB_HOST", "localhost")
+		t.Setenv("DB_HEALTH_LONG_QUERY_SECONDS", "30")
+
+		cfg := LoadConfig()
+
+		assert.Equal(t, 20, cfg.DBHealthDeadTuplePercent)
+		assert.Equal(t, 500, cfg.DBHealthSlowStatementMs)
+		assert.Equal(t, 30, cfg.DBHealthLongQuerySeconds)
+	})
+
+	t.Run("Error report sample rate", func(t *testing.T) {
+		t.Setenv("DB_HOST", "localhost")
+
+		assert.Equal(t, 1.0, LoadConfig().ErrorReportSampleRate)
+
+		t.Setenv("ERROR_REPORT_SAMPLE_RATE", "0.25")
+		assert.Equal(t, 0.25, LoadConfig().ErrorReportSampleRate)
+
+		t.Setenv("ERROR_REPORT_SAMPLE_RATE", "2")
+		assert.Equal(t, 1.0, LoadConfig().ErrorReportSampleRate)
 	})
 }
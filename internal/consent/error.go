@@ -0,0 +1,12 @@
+package consent
+
+import (
+	"errors"
+	"warimas-be/internal/apperr"
+)
+
+var (
+	ErrUnauthenticated = apperr.Unauthenticated("unauthenticated")
+	ErrInvalidChannel  = errors.New("invalid marketing channel")
+	ErrDB              = errors.New("database error")
+)
@@ -0,0 +1,12 @@
+package consent
+
+import "warimas-be/internal/graph/model"
+
+func MapConsentToGraphQL(c *Consent) *model.MarketingConsent {
+	return &model.MarketingConsent{
+		Channel:        model.MarketingChannel(c.Channel),
+		Status:         model.MarketingConsentStatus(c.Status),
+		SubscribedAt:   c.SubscribedAt,
+		UnsubscribedAt: c.UnsubscribedAt,
+	}
+}
@@ -0,0 +1,48 @@
+package consent
+
+import "time"
+
+type Channel string
+
+const (
+	ChannelEmail    Channel = "EMAIL"
+	ChannelWhatsApp Channel = "WHATSAPP"
+)
+
+type Status string
+
+const (
+	StatusSubscribed   Status = "SUBSCRIBED"
+	StatusUnsubscribed Status = "UNSUBSCRIBED"
+)
+
+// Sources recorded with each consent change.
+const (
+	SourceAccount = "ACCOUNT"
+)
+
+var AllChannels = []Channel{ChannelEmail, ChannelWhatsApp}
+
+func (c Channel) Valid() bool {
+	return c == ChannelEmail || c == ChannelWhatsApp
+}
+
+type Consent struct {
+	UserID         int32
+	Channel        Channel
+	Status         Status
+	Source         string
+	SubscribedAt   *time.Time
+	UnsubscribedAt *time.Time
+	UpdatedAt      time.Time
+}
+
+// Change is one subscribe or unsubscribe, with where it came from.
+type Change struct {
+	UserID    int32
+	Channel   Channel
+	Status    Status
+	Source    string
+	IPAddress *string
+	UserAgent *string
+}
@@ -0,0 +1,203 @@
+package consent
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"warimas-be/internal/logger"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+type Repository interface {
+	ListByUser(ctx context.Context, userID int32) ([]*Consent, error)
+	Get(ctx context.Context, userID int32, channel Channel) (*Consent, error)
+	Apply(ctx context.Context, c *Change) (*Consent, error)
+	FilterSubscribed(ctx context.Context, channel Channel, userIDs []int32) ([]int32, error)
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+const consentColumns = `
+	user_id, channel, status, source, subscribed_at, unsubscribed_at, updated_at
+`
+
+func scanConsent(row interface{ Scan(dest ...any) error }) (*Consent, error) {
+	var c Consent
+	err := row.Scan(
+		&c.UserID, &c.Channel, &c.Status, &c.Source,
+		&c.SubscribedAt, &c.UnsubscribedAt, &c.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+func (r *repository) ListByUser(ctx context.Context, userID int32) ([]*Consent, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ListByUser"),
+		zap.Int32("user_id", userID),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+consentColumns+`
+		FROM marketing_consents
+		WHERE user_id = $1
+		ORDER BY channel
+	`, userID)
+	if err != nil {
+		log.Error("failed to query consents", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	var list []*Consent
+	for rows.Next() {
+		c, err := scanConsent(rows)
+		if err != nil {
+			log.Error("failed to scan consent", zap.Error(err))
+			return nil, ErrDB
+		}
+		list = append(list, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error("consent iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return list, nil
+}
+
+// Get returns the user's consent on channel, or nil when the user never
+// made a choice there.
+func (r *repository) Get(ctx context.Context, userID int32, channel Channel) (*Consent, error) {
+	c, err := scanConsent(r.db.QueryRowContext(ctx, `
+		SELECT `+consentColumns+`
+		FROM marketing_consents
+		WHERE user_id = $1 AND channel = $2
+	`, userID, channel))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to get consent",
+			zap.Int32("user_id", userID),
+			zap.String("channel", string(channel)),
+			zap.Error(err),
+		)
+		return nil, ErrDB
+	}
+	return c, nil
+}
+
+// Apply records the change as the user's current consent and appends it
+// to the consent history in one transaction. The subscribed/unsubscribed
+// timestamps keep the time of the latest change in each direction.
+func (r *repository) Apply(ctx context.Context, ch *Change) (c *Consent, err error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "Apply"),
+		zap.Int32("user_id", ch.UserID),
+		zap.String("channel", string(ch.Channel)),
+		zap.String("status", string(ch.Status)),
+	)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error("failed to begin transaction", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	c, err = scanConsent(tx.QueryRowContext(ctx, `
+		INSERT INTO marketing_consents (
+			user_id, channel, status, source, subscribed_at, unsubscribed_at
+		) VALUES (
+			$1, $2, $3, $4,
+			CASE WHEN $3 = 'SUBSCRIBED' THEN NOW() END,
+			CASE WHEN $3 = 'UNSUBSCRIBED' THEN NOW() END
+		)
+		ON CONFLICT (user_id, channel) DO UPDATE SET
+			status = EXCLUDED.status,
+			source = EXCLUDED.source,
+			subscribed_at = COALESCE(EXCLUDED.subscribed_at, marketing_consents.subscribed_at),
+			unsubscribed_at = COALESCE(EXCLUDED.unsubscribed_at, marketing_consents.unsubscribed_at)
+		RETURNING `+consentColumns,
+		ch.UserID, ch.Channel, ch.Status, ch.Source,
+	))
+	if err != nil {
+		log.Error("failed to upsert consent", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO marketing_consent_events (
+			user_id, channel, status, source, ip_address, user_agent
+		) VALUES ($1,$2,$3,$4,$5,$6)
+	`, ch.UserID, ch.Channel, ch.Status, ch.Source, ch.IPAddress, ch.UserAgent)
+	if err != nil {
+		log.Error("failed to insert consent event", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Error("failed to commit consent change", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return c, nil
+}
+
+// FilterSubscribed returns the subset of userIDs currently subscribed on
+// channel.
+func (r *repository) FilterSubscribed(ctx context.Context, channel Channel, userIDs []int32) ([]int32, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "FilterSubscribed"),
+		zap.String("channel", string(channel)),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT user_id
+		FROM marketing_consents
+		WHERE channel = $1
+		  AND status = 'SUBSCRIBED'
+		  AND user_id = ANY($2)
+	`, channel, pq.Array(userIDs))
+	if err != nil {
+		log.Error("failed to query subscribed users", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	ids := []int32{}
+	for rows.Next() {
+		var id int32
+		if err := rows.Scan(&id); err != nil {
+			log.Error("failed to scan subscribed user", zap.Error(err))
+			return nil, ErrDB
+		}
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error("subscribed user iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return ids, nil
+}
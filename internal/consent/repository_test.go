@@ -0,0 +1,79 @@
+package consent
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var consentRowColumns = []string{
+	"user_id", "channel", "status", "source", "subscribed_at", "unsubscribed_at", "updated_at",
+}
+
+func TestRepository_Apply(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+	now := time.Now()
+	ip := "203.0.113.7"
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO marketing_consents .* ON CONFLICT \(user_id, channel\) DO UPDATE`).
+			WithArgs(int32(1), ChannelEmail, StatusSubscribed, SourceAccount).
+			WillReturnRows(sqlmock.NewRows(consentRowColumns).
+				AddRow(1, "EMAIL", "SUBSCRIBED", SourceAccount, now, nil, now))
+		mock.ExpectExec(`INSERT INTO marketing_consent_events`).
+			WithArgs(int32(1), ChannelEmail, StatusSubscribed, SourceAccount, &ip, nil).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		c, err := repo.Apply(ctx, &Change{
+			UserID: 1, Channel: ChannelEmail, Status: StatusSubscribed,
+			Source: SourceAccount, IPAddress: &ip,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, StatusSubscribed, c.Status)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("EventInsertFails", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO marketing_consents`).
+			WillReturnRows(sqlmock.NewRows(consentRowColumns).
+				AddRow(1, "EMAIL", "UNSUBSCRIBED", SourceAccount, nil, now, now))
+		mock.ExpectExec(`INSERT INTO marketing_consent_events`).WillReturnError(sql.ErrConnDone)
+		mock.ExpectRollback()
+
+		_, err := repo.Apply(ctx, &Change{
+			UserID: 1, Channel: ChannelEmail, Status: StatusUnsubscribed, Source: SourceAccount,
+		})
+		assert.ErrorIs(t, err, ErrDB)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_Get(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+
+	mock.ExpectQuery(`FROM marketing_consents WHERE user_id = \$1 AND channel = \$2`).
+		WithArgs(int32(1), ChannelWhatsApp).
+		WillReturnError(sql.ErrNoRows)
+
+	c, err := repo.Get(ctx, 1, ChannelWhatsApp)
+	assert.NoError(t, err)
+	assert.Nil(t, c)
+}
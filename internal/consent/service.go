@@ -0,0 +1,137 @@
+package consent
+
+import (
+	"context"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/transport"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+type Service interface {
+	// GetMyConsents returns the caller's consent on every channel; channels
+	// the caller never chose on are reported as UNSUBSCRIBED.
+	GetMyConsents(ctx context.Context) ([]*Consent, error)
+	Subscribe(ctx context.Context, channel Channel) (*Consent, error)
+	Unsubscribe(ctx context.Context, channel Channel) (*Consent, error)
+
+	// CanSend and FilterSendable are the suppression check for marketing
+	// sends. Consent is opt-in: users without a SUBSCRIBED record on the
+	// channel are suppressed. Transactional messages (order and payment
+	// updates) do not need consent and must not go through these.
+	CanSend(ctx context.Context, userID uint, channel Channel) (bool, error)
+	FilterSendable(ctx context.Context, channel Channel, userIDs []int32) ([]int32, error)
+}
+
+type service struct {
+	repo Repository
+}
+
+func NewService(repo Repository) Service {
+	return &service{repo: repo}
+}
+
+func (s *service) GetMyConsents(ctx context.Context) ([]*Consent, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "GetMyConsents"),
+	)
+
+	userID, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		log.Warn("user not authenticated")
+		return nil, ErrUnauthenticated
+	}
+
+	stored, err := s.repo.ListByUser(ctx, int32(userID))
+	if err != nil {
+		log.Error("failed to list consents", zap.Error(err))
+		return nil, err
+	}
+
+	byChannel := make(map[Channel]*Consent, len(stored))
+	for _, c := range stored {
+		byChannel[c.Channel] = c
+	}
+
+	list := make([]*Consent, 0, len(AllChannels))
+	for _, ch := range AllChannels {
+		if c, ok := byChannel[ch]; ok {
+			list = append(list, c)
+			continue
+		}
+		list = append(list, &Consent{
+			UserID:  int32(userID),
+			Channel: ch,
+			Status:  StatusUnsubscribed,
+		})
+	}
+
+	return list, nil
+}
+
+func (s *service) Subscribe(ctx context.Context, channel Channel) (*Consent, error) {
+	return s.change(ctx, channel, StatusSubscribed)
+}
+
+func (s *service) Unsubscribe(ctx context.Context, channel Channel) (*Consent, error) {
+	return s.change(ctx, channel, StatusUnsubscribed)
+}
+
+func (s *service) change(ctx context.Context, channel Channel, status Status) (*Consent, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "change"),
+		zap.String("channel", string(channel)),
+		zap.String("status", string(status)),
+	)
+
+	userID, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		log.Warn("user not authenticated")
+		return nil, ErrUnauthenticated
+	}
+
+	if !channel.Valid() {
+		log.Warn("invalid channel")
+		return nil, ErrInvalidChannel
+	}
+
+	ch := &Change{
+		UserID:  int32(userID),
+		Channel: channel,
+		Status:  status,
+		Source:  SourceAccount,
+	}
+	if ip := transport.GetClientIP(ctx); ip != "" {
+		ch.IPAddress = &ip
+	}
+	if ua := transport.GetUserAgent(ctx); ua != "" {
+		ch.UserAgent = &ua
+	}
+
+	c, err := s.repo.Apply(ctx, ch)
+	if err != nil {
+		log.Error("failed to apply consent change", zap.Error(err))
+		return nil, err
+	}
+
+	log.Info("marketing consent updated", zap.Uint("user_id", userID))
+	return c, nil
+}
+
+func (s *service) CanSend(ctx context.Context, userID uint, channel Channel) (bool, error) {
+	c, err := s.repo.Get(ctx, int32(userID), channel)
+	if err != nil {
+		return false, err
+	}
+	return c != nil && c.Status == StatusSubscribed, nil
+}
+
+func (s *service) FilterSendable(ctx context.Context, channel Channel, userIDs []int32) ([]int32, error) {
+	if len(userIDs) == 0 {
+		return []int32{}, nil
+	}
+	return s.repo.FilterSubscribed(ctx, channel, userIDs)
+}
@@ -0,0 +1,163 @@
+package consent
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"warimas-be/internal/transport"
+	"warimas-be/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// --- Mocks ---
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) ListByUser(ctx context.Context, userID int32) ([]*Consent, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Consent), args.Error(1)
+}
+
+func (m *MockRepository) Get(ctx context.Context, userID int32, channel Channel) (*Consent, error) {
+	args := m.Called(ctx, userID, channel)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Consent), args.Error(1)
+}
+
+func (m *MockRepository) Apply(ctx context.Context, c *Change) (*Consent, error) {
+	args := m.Called(ctx, c)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Consent), args.Error(1)
+}
+
+func (m *MockRepository) FilterSubscribed(ctx context.Context, channel Channel, userIDs []int32) ([]int32, error) {
+	args := m.Called(ctx, channel, userIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]int32), args.Error(1)
+}
+
+// --- Tests ---
+
+func TestService_GetMyConsents(t *testing.T) {
+	mockRepo := new(MockRepository)
+	svc := NewService(mockRepo)
+	ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "USER")
+
+	mockRepo.On("ListByUser", ctx, int32(1)).Return([]*Consent{
+		{UserID: 1, Channel: ChannelWhatsApp, Status: StatusSubscribed},
+	}, nil)
+
+	list, err := svc.GetMyConsents(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, list, 2)
+	assert.Equal(t, ChannelEmail, list[0].Channel)
+	assert.Equal(t, StatusUnsubscribed, list[0].Status)
+	assert.Equal(t, StatusSubscribed, list[1].Status)
+}
+
+func TestService_Subscribe(t *testing.T) {
+	t.Run("RecordsRequestOrigin", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+
+		req := httptest.NewRequest(http.MethodPost, "http://example.com", nil)
+		req.Header.Set("X-Forwarded-For", "203.0.113.7")
+		req.Header.Set("User-Agent", "warimas-app/1.0")
+		ctx := transport.WithHTTP(context.Background(), req, httptest.NewRecorder())
+		ctx = utils.SetUserContext(ctx, 1, "test@example.com", "USER")
+
+		mockRepo.On("Apply", ctx, mock.MatchedBy(func(c *Change) bool {
+			return c.UserID == 1 &&
+				c.Channel == ChannelEmail &&
+				c.Status == StatusSubscribed &&
+				c.Source == SourceAccount &&
+				*c.IPAddress == "203.0.113.7" &&
+				*c.UserAgent == "warimas-app/1.0"
+		})).Return(&Consent{UserID: 1, Channel: ChannelEmail, Status: StatusSubscribed}, nil)
+
+		c, err := svc.Subscribe(ctx, ChannelEmail)
+		assert.NoError(t, err)
+		assert.Equal(t, StatusSubscribed, c.Status)
+	})
+
+	t.Run("InvalidChannel", func(t *testing.T) {
+		svc := NewService(new(MockRepository))
+		ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "USER")
+
+		_, err := svc.Subscribe(ctx, Channel("SMS"))
+		assert.ErrorIs(t, err, ErrInvalidChannel)
+	})
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		svc := NewService(new(MockRepository))
+
+		_, err := svc.Unsubscribe(context.Background(), ChannelEmail)
+		assert.ErrorIs(t, err, ErrUnauthenticated)
+	})
+}
+
+func TestService_CanSend(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("NeverOptedIn", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+		mockRepo.On("Get", ctx, int32(1), ChannelEmail).Return(nil, nil)
+
+		ok, err := svc.CanSend(ctx, 1, ChannelEmail)
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("Unsubscribed", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+		mockRepo.On("Get", ctx, int32(1), ChannelEmail).
+			Return(&Consent{Status: StatusUnsubscribed}, nil)
+
+		ok, err := svc.CanSend(ctx, 1, ChannelEmail)
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("Subscribed", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+		mockRepo.On("Get", ctx, int32(1), ChannelWhatsApp).
+			Return(&Consent{Status: StatusSubscribed}, nil)
+
+		ok, err := svc.CanSend(ctx, 1, ChannelWhatsApp)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+}
+
+func TestService_FilterSendable(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockRepository)
+	svc := NewService(mockRepo)
+
+	ids, err := svc.FilterSendable(ctx, ChannelEmail, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, ids)
+	mockRepo.AssertNotCalled(t, "FilterSubscribed", mock.Anything, mock.Anything, mock.Anything)
+
+	mockRepo.On("FilterSubscribed", ctx, ChannelEmail, []int32{1, 2, 3}).Return([]int32{2}, nil)
+	ids, err = svc.FilterSendable(ctx, ChannelEmail, []int32{1, 2, 3})
+	assert.NoError(t, err)
+	assert.Equal(t, []int32{2}, ids)
+}
@@ -0,0 +1,267 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/utils"
+
+	"github.com/lib/pq"
+)
+
+// annotatedDriver is lib/pq with every statement prefixed by a comment
+// naming the package and method that ran it, plus the GraphQL operation,
+// request ID and user behind it when the context has them, e.g.
+//
+//	/* module=order method=GetOrderDetail operation=OrderDetail request_id=3f2a... user_id=42 */ SELECT ...
+//
+// Postgres ignores the comment when grouping statements, so
+// pg_stat_statements keeps one row per query with the comment in its
+// text, and slow statements can be traced back to their repository.
+// pg_stat_activity and the slow statement log show the full comment of
+// each run.
+const annotatedDriver = "postgres+annotated"
+
+const (
+	internalPrefix = "warimas-be/internal/"
+	commentPrefix  = "/* "
+	commentSuffix  = " */ "
+	// maxTagLength cuts request supplied values such as the request ID.
+	maxTagLength = 64
+)
+
+type ctxKey string
+
+const operationKey ctxKey = "sql_operation"
+
+func init() {
+	sql.Register(annotatedDriver, annotatingDriver{pq.Driver{}})
+}
+
+// WithOperation names the GraphQL operation whose statements ctx runs.
+func WithOperation(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, operationKey, name)
+}
+
+func operationFrom(ctx context.Context) string {
+	name, _ := ctx.Value(operationKey).(string)
+	return name
+}
+
+// Annotation is what a statement's comment records. Fields the
+// statement was run without are empty.
+type Annotation struct {
+	Module    string
+	Method    string
+	Operation string
+	RequestID string
+	UserID    string
+}
+
+// ParseAnnotation splits the comment added by the annotating driver off
+// a statement. ok is false when the statement has none.
+func ParseAnnotation(query string) (a Annotation, rest string, ok bool) {
+	if !strings.HasPrefix(query, commentPrefix) {
+		return Annotation{}, query, false
+	}
+	end := strings.Index(query, commentSuffix)
+	if end < 0 {
+		return Annotation{}, query, false
+	}
+	for _, field := range strings.Fields(query[len(commentPrefix):end]) {
+		key, value, _ := strings.Cut(field, "=")
+		switch key {
+		case "module":
+			a.Module, ok = value, true
+		case "method":
+			a.Method, ok = value, true
+		case "operation":
+			a.Operation, ok = value, true
+		case "request_id":
+			a.RequestID, ok = value, true
+		case "user_id":
+			a.UserID, ok = value, true
+		}
+	}
+	if !ok {
+		return Annotation{}, query, false
+	}
+	return a, query[end+len(commentSuffix):], true
+}
+
+// callerTags caches the module and method tags for each program
+// counter, with "" for frames that are not a caller worth naming.
+var callerTags sync.Map
+
+// annotate prefixes query with the first caller outside this package
+// and database/sql, and with the request metadata in ctx. COPY
+// statements are left alone; lib/pq recognizes them by their first word.
+func annotate(ctx context.Context, query string) string {
+	if len(query) >= 4 && strings.EqualFold(query[:4], "COPY") {
+		return query
+	}
+
+	var b strings.Builder
+	var pcs [32]uintptr
+	n := runtime.Callers(3, pcs[:])
+	for _, pc := range pcs[:n] {
+		tags, ok := callerTags.Load(pc)
+		if !ok {
+			tags = tagsFor(pc)
+			callerTags.Store(pc, tags)
+		}
+		if t := tags.(string); t != "" {
+			b.WriteString(t)
+			break
+		}
+	}
+	writeTag(&b, "operation", operationFrom(ctx))
+	writeTag(&b, "request_id", logger.RequestIDFrom(ctx))
+	if id, ok := utils.GetUserIDFromContext(ctx); ok {
+		writeTag(&b, "user_id", strconv.FormatUint(uint64(id), 10))
+	}
+
+	if b.Len() == 0 {
+		return query
+	}
+	return commentPrefix + b.String() + commentSuffix + query
+}
+
+// writeTag appends key=value, dropping every character that could end
+// the comment or split the field. Request IDs come from a header the
+// client controls.
+func writeTag(b *strings.Builder, key, value string) {
+	clean := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9',
+			r == '-', r == '_', r == '.', r == ':':
+			return r
+		}
+		return -1
+	}, value)
+	if len(clean) > maxTagLength {
+		clean = clean[:maxTagLength]
+	}
+	if clean == "" {
+		return
+	}
+	if b.Len() > 0 {
+		b.WriteByte(' ')
+	}
+	b.WriteString(key)
+	b.WriteByte('=')
+	b.WriteString(clean)
+}
+
+func tagsFor(pc uintptr) string {
+	frames := runtime.CallersFrames([]uintptr{pc})
+	for {
+		frame, more := frames.Next()
+		if a, ok := annotationFor(frame.Function); ok {
+			return "module=" + a.Module + " method=" + a.Method
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
+// annotationFor turns a function name such as
+// "warimas-be/internal/order.(*repository).GetOrderDetail.func1" into
+// module "order" and method "GetOrderDetail".
+func annotationFor(function string) (Annotation, bool) {
+	rest, ok := strings.CutPrefix(function, internalPrefix)
+	if !ok {
+		return Annotation{}, false
+	}
+	slash := strings.LastIndex(rest, "/") + 1
+	dot := strings.Index(rest[slash:], ".")
+	if dot < 0 {
+		return Annotation{}, false
+	}
+	module, name := rest[:slash+dot], rest[slash+dot+1:]
+	if module == "db" || module == "db/dbgen" {
+		return Annotation{}, false
+	}
+	if i := strings.Index(name, ")."); i >= 0 {
+		name = name[i+2:]
+	}
+	name, _, _ = strings.Cut(name, ".")
+	return Annotation{Module: module, Method: name}, true
+}
+
+type annotatingDriver struct {
+	driver.Driver
+}
+
+func (d annotatingDriver) Open(name string) (driver.Conn, error) {
+	conn, err := d.Driver.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return annotatingConn{conn}, nil
+}
+
+// annotatingConn passes everything through to the wrapped connection,
+// rewriting only the statement text.
+type annotatingConn struct {
+	driver.Conn
+}
+
+func (c annotatingConn) Prepare(query string) (driver.Stmt, error) {
+	return c.Conn.Prepare(annotate(context.Background(), query))
+}
+
+func (c annotatingConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	if p, ok := c.Conn.(driver.ConnPrepareContext); ok {
+		return p.PrepareContext(ctx, annotate(ctx, query))
+	}
+	return c.Conn.Prepare(annotate(ctx, query))
+}
+
+func (c annotatingConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	if q, ok := c.Conn.(driver.QueryerContext); ok {
+		return q.QueryContext(ctx, annotate(ctx, query), args)
+	}
+	return nil, driver.ErrSkip
+}
+
+func (c annotatingConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	if e, ok := c.Conn.(driver.ExecerContext); ok {
+		return e.ExecContext(ctx, annotate(ctx, query), args)
+	}
+	return nil, driver.ErrSkip
+}
+
+func (c annotatingConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	if b, ok := c.Conn.(driver.ConnBeginTx); ok {
+		return b.BeginTx(ctx, opts)
+	}
+	return c.Conn.Begin()
+}
+
+func (c annotatingConn) Ping(ctx context.Context) error {
+	if p, ok := c.Conn.(driver.Pinger); ok {
+		return p.Ping(ctx)
+	}
+	return nil
+}
+
+func (c annotatingConn) ResetSession(ctx context.Context) error {
+	if r, ok := c.Conn.(driver.SessionResetter); ok {
+		return r.ResetSession(ctx)
+	}
+	return nil
+}
+
+func (c annotatingConn) IsValid() bool {
+	if v, ok := c.Conn.(driver.Validator); ok {
+		return v.IsValid()
+	}
+	return true
+}
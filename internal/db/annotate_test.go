@@ -0,0 +1,77 @@
+package db
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnnotationFor(t *testing.T) {
+	tests := []struct {
+		function string
+		want     Annotation
+		ok       bool
+	}{
+		{"warimas-be/internal/order.(*repository).GetOrderDetail", Annotation{Module: "order", Method: "GetOrderDetail"}, true},
+		{"warimas-be/internal/order.(*repository).ListOrders.func1", Annotation{Module: "order", Method: "ListOrders"}, true},
+		{"warimas-be/internal/payment/webhook.handleInvoice", Annotation{Module: "payment/webhook", Method: "handleInvoice"}, true},
+		{"warimas-be/internal/db/dbgen.(*Queries).GetCart", Annotation{}, false},
+		{"warimas-be/internal/db.annotate", Annotation{}, false},
+		{"database/sql.(*DB).QueryContext", Annotation{}, false},
+	}
+	for _, tt := range tests {
+		got, ok := annotationFor(tt.function)
+		assert.Equal(t, tt.ok, ok, tt.function)
+		assert.Equal(t, tt.want, got, tt.function)
+	}
+}
+
+func TestParseAnnotation(t *testing.T) {
+	a, rest, ok := ParseAnnotation("/* module=cart method=AddToCart */ INSERT INTO carts VALUES ($1)")
+	assert.True(t, ok)
+	assert.Equal(t, Annotation{Module: "cart", Method: "AddToCart"}, a)
+	assert.Equal(t, "INSERT INTO carts VALUES ($1)", rest)
+
+	a, rest, ok = ParseAnnotation("/* module=order method=GetOrderDetail operation=OrderDetail request_id=abc-1 user_id=42 */ SELECT 1")
+	assert.True(t, ok)
+	assert.Equal(t, Annotation{
+		Module: "order", Method: "GetOrderDetail", Operation: "OrderDetail", RequestID: "abc-1", UserID: "42",
+	}, a)
+	assert.Equal(t, "SELECT 1", rest)
+
+	_, rest, ok = ParseAnnotation("/* hand written */ SELECT 1")
+	assert.False(t, ok)
+	assert.Equal(t, "/* hand written */ SELECT 1", rest)
+}
+
+func TestAnnotate(t *testing.T) {
+	ctx := context.Background()
+	assert.Equal(t, "COPY orders FROM STDIN", annotate(ctx, "COPY orders FROM STDIN"))
+	// Callers inside this package are never named.
+	assert.Equal(t, "SELECT 1", annotate(ctx, "SELECT 1"))
+
+	ctx = logger.WithRequestID(ctx, "req-1")
+	ctx = utils.SetUserContext(ctx, 42, "a@example.com", "USER")
+	ctx = WithOperation(ctx, "OrderDetail")
+	assert.Equal(t,
+		"/* operation=OrderDetail request_id=req-1 user_id=42 */ SELECT 1",
+		annotate(ctx, "SELECT 1"))
+}
+
+func TestAnnotate_SanitizesTags(t *testing.T) {
+	ctx := logger.WithRequestID(context.Background(), "x */ DROP TABLE users; --")
+	assert.Equal(t, "/* request_id=xDROPTABLEusers-- */ SELECT 1", annotate(ctx, "SELECT 1"))
+
+	ctx = logger.WithRequestID(context.Background(), strings.Repeat("a", 100))
+	a, _, ok := ParseAnnotation(annotate(ctx, "SELECT 1"))
+	assert.True(t, ok)
+	assert.Len(t, a.RequestID, maxTagLength)
+
+	// Nothing left after cleaning means no tag at all.
+	ctx = logger.WithRequestID(context.Background(), "*/")
+	assert.Equal(t, "SELECT 1", annotate(ctx, "SELECT 1"))
+}
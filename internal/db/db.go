@@ -21,8 +21,9 @@ func InitDB(cfg *config.Config) *sql.DB {
 
 // NewDatabase creates a new database connection.
 // It returns an error instead of exiting, making it testable.
+// Statements are annotated with their caller; see annotatedDriver.
 func NewDatabase(cfg *config.Config) (*sql.DB, error) {
-	return newDatabaseWithDriver(cfg, "postgres")
+	return newDatabaseWithDriver(cfg, annotatedDriver)
 }
 
 func newDatabaseWithDriver(cfg *config.Config, driver string) (*sql.DB, error) {
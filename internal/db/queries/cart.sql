@@ -0,0 +1,29 @@
+-- name: UpdateCartQuantity :execrows
+UPDATE carts
+SET quantity = $1, updated_at = NOW()
+WHERE user_id = $2 AND variant_id = $3;
+
+-- name: RemoveFromCart :execrows
+DELETE FROM carts
+WHERE user_id = $1 AND variant_id = ANY(sqlc.arg(variant_ids)::uuid[]);
+
+-- name: ClearCart :execrows
+DELETE FROM carts
+WHERE user_id = $1;
+
+-- name: GetCartItemByUserAndVariant :one
+SELECT id, user_id, variant_id, quantity, created_at, updated_at
+FROM carts
+WHERE user_id = $1 AND variant_id = $2;
+
+-- name: UpdateCartItemQuantity :one
+UPDATE carts
+SET quantity = $1,
+    updated_at = NOW()
+WHERE id = $2
+RETURNING id, user_id, variant_id, quantity, created_at, updated_at;
+
+-- name: CreateCartItem :one
+INSERT INTO carts (user_id, variant_id, quantity)
+VALUES ($1, $2, $3)
+RETURNING id, user_id, variant_id, quantity, created_at, updated_at;
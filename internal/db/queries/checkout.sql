@@ -0,0 +1,288 @@
+-- name: LockCheckoutSessionStatus :one
+SELECT status FROM checkout_sessions WHERE id = $1 FOR UPDATE;
+
+-- name: LockCheckoutSession :exec
+SELECT 1 FROM checkout_sessions WHERE id = $1 FOR UPDATE;
+
+-- name: SetCheckoutSessionStatus :execrows
+UPDATE checkout_sessions
+SET status = $1
+WHERE id = $2;
+
+-- name: ExpireCheckoutSession :exec
+UPDATE checkout_sessions
+SET status = 'EXPIRED'
+WHERE id = $1
+  AND status = 'PENDING'
+  AND NOT EXISTS (
+    SELECT 1 FROM orders o WHERE o.checkout_session_id = checkout_sessions.id
+  );
+
+-- name: ConfirmCheckoutSession :execrows
+UPDATE checkout_sessions
+SET
+    confirmed_at = NOW()
+WHERE id = $1
+  AND status = 'PENDING';
+
+-- name: GetVariantForCheckout :one
+SELECT
+    v.id,
+    v.name,
+    v.price,
+    v.quantity_type,
+    v.imageurl,
+    v.stock,
+    p.name AS product_name,
+    v.weight_grams,
+    v.length_cm,
+    v.width_cm,
+    v.height_cm,
+    o.id AS origin_id,
+    o.city AS origin_city,
+    o.latitude AS origin_latitude,
+    o.longitude AS origin_longitude
+FROM variants v
+LEFT JOIN products p ON p.id = v.product_id
+LEFT JOIN LATERAL (
+    SELECT so.id, so.city, so.latitude, so.longitude
+    FROM seller_origins so
+    WHERE so.id = p.origin_id
+       OR (p.origin_id IS NULL AND so.seller_id = p.seller_id AND so.is_default)
+    LIMIT 1
+) o ON TRUE
+WHERE v.id = $1;
+
+-- name: HasVariantStock :one
+SELECT EXISTS (
+    SELECT 1
+    FROM variant_stocks s
+    JOIN warehouses w ON w.id = s.warehouse_id
+    WHERE s.variant_id = $2
+      AND s.quantity >= $1
+      AND w.is_active
+);
+
+-- name: ListVariantsOnVacation :many
+SELECT v.id
+FROM variants v
+JOIN products p ON p.id = v.product_id
+WHERE v.id = ANY(sqlc.arg(variant_ids)::uuid[])
+  AND EXISTS (
+    SELECT 1 FROM store_vacations sv
+    WHERE sv.seller_id = p.seller_id
+      AND sv.starts_at <= NOW()
+      AND sv.ends_at > NOW()
+  );
+
+-- name: ListInactiveVariants :many
+SELECT v.id
+FROM variants v
+WHERE v.id = ANY(sqlc.arg(variant_ids)::uuid[])
+  AND NOT v.is_active;
+
+-- name: ListOriginLocations :many
+SELECT id, latitude, longitude
+FROM seller_origins
+WHERE id::text = ANY(sqlc.arg(origin_ids)::text[])
+  AND latitude IS NOT NULL;
+
+-- name: CreateCheckoutSession :exec
+INSERT INTO checkout_sessions (
+    id, user_id, status, subtotal, tax, shipping_fee,
+    discount, total_amount, expires_at, external_id,
+    chargeable_weight_grams, shipping_parcels, guest_id,
+    shipping_method
+) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9, $10, $11, $12, $13, $14);
+
+-- name: CreateCheckoutSessionItem :exec
+INSERT INTO checkout_session_items (
+    id, checkout_session_id, variant_id, variant_name, product_name,
+    quantity, quantity_type, imageurl, unit_price, subtotal
+) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10);
+
+-- name: GetCheckoutSession :many
+SELECT
+    s.id, s.external_id, s.status, s.expires_at, s.created_at,
+    s.user_id, s.guest_id, s.address_id,
+    s.subtotal, s.tax, s.shipping_fee, s.discount,
+    s.total_amount, s.wallet_amount, s.currency, s.confirmed_at,
+    s.payment_method, s.voucher_id, s.points_redeemed,
+    s.chargeable_weight_grams, s.shipping_parcels, s.shipping_method,
+    s.pickup_location_id, s.pickup_slot_start,
+    s.delivery_slot_id, s.delivery_date, s.insured,
+    (
+        SELECT p.expire_at
+        FROM payments p
+        JOIN orders o ON o.id = p.order_id
+        WHERE o.checkout_session_id = s.id
+          AND p.provider = 'XENDIT'
+          AND p.status = 'PENDING'
+        ORDER BY p.id DESC
+        LIMIT 1
+    ) AS payment_expires_at,
+
+    i.id AS item_id, i.variant_id, i.variant_name, i.product_name,
+    i.imageurl, i.quantity, i.quantity_type,
+    i.unit_price, i.subtotal AS item_subtotal
+FROM checkout_sessions s
+LEFT JOIN checkout_session_items i
+    ON i.checkout_session_id = s.id
+WHERE s.external_id = $1;
+
+-- name: UpdateSessionAddressAndPricing :exec
+UPDATE checkout_sessions
+SET
+    address_id = $1,
+    shipping_fee = $2,
+    tax = $3,
+    total_amount = $4
+WHERE id = $5;
+
+-- name: UpdateSessionPaymentMethod :exec
+UPDATE checkout_sessions
+SET payment_method = $1
+WHERE id = $2;
+
+-- name: UpdateSessionShippingMethod :exec
+UPDATE checkout_sessions
+SET
+    shipping_method = $1,
+    shipping_fee = $2,
+    tax = $3,
+    total_amount = $4,
+    pickup_location_id = $5,
+    pickup_slot_start = $6,
+    delivery_slot_id = $7,
+    delivery_date = $8
+WHERE id = $9;
+
+-- name: UpdateSessionInsurance :exec
+UPDATE checkout_sessions
+SET
+    insured = $1,
+    tax = $2,
+    total_amount = $3,
+    wallet_amount = $4
+WHERE id = $5;
+
+-- name: UpdateSessionWalletAmount :exec
+UPDATE checkout_sessions
+SET wallet_amount = $1
+WHERE id = $2;
+
+-- name: GetVoucherByCode :one
+SELECT
+    v.id, v.campaign_id, v.discount_type, v.discount_value,
+    v.max_discount, v.min_subtotal, v.usage_limit,
+    (SELECT COUNT(*) FROM voucher_redemptions vr WHERE vr.voucher_id = v.id) AS redemptions,
+    v.owner_user_id, v.is_active, v.expires_at,
+    c.starts_at, c.ends_at
+FROM vouchers v
+JOIN voucher_campaigns c ON c.id = v.campaign_id
+WHERE v.code = $1;
+
+-- name: GetVoucherByID :one
+SELECT
+    v.id, v.campaign_id, v.discount_type, v.discount_value,
+    v.max_discount, v.min_subtotal, v.usage_limit,
+    (SELECT COUNT(*) FROM voucher_redemptions vr WHERE vr.voucher_id = v.id) AS redemptions,
+    v.owner_user_id, v.is_active, v.expires_at,
+    c.starts_at, c.ends_at
+FROM vouchers v
+JOIN voucher_campaigns c ON c.id = v.campaign_id
+WHERE v.id = $1;
+
+-- name: UpdateSessionDiscounts :exec
+UPDATE checkout_sessions
+SET
+    voucher_id = $1,
+    discount = $2,
+    points_redeemed = $3,
+    tax = $4,
+    total_amount = $5,
+    wallet_amount = $6
+WHERE id = $7;
+
+-- name: GetActiveSessionExternalID :one
+SELECT external_id
+FROM checkout_sessions
+WHERE user_id = $1
+  AND status = 'PENDING'
+  AND confirmed_at IS NULL
+  AND expires_at > NOW()
+ORDER BY created_at DESC
+LIMIT 1;
+
+-- name: CountOpenGuestSessions :one
+SELECT COUNT(*)
+FROM checkout_sessions
+WHERE guest_id = $1
+  AND status = 'PENDING'
+  AND confirmed_at IS NULL
+  AND expires_at > NOW();
+
+-- name: SupersedeOpenSessions :execrows
+UPDATE checkout_sessions
+SET status = $3
+WHERE user_id = $1
+  AND id <> $2
+  AND status = 'PENDING'
+  AND confirmed_at IS NULL;
+
+-- name: CreateSessionEvent :one
+INSERT INTO checkout_session_events (
+    session_id, event_type, actor_user_id, actor_guest_id, actor_role,
+    from_value, to_value, total_before, total_after
+) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)
+RETURNING id, created_at;
+
+-- name: ListSessionEvents :many
+SELECT id, session_id, event_type, actor_user_id, actor_guest_id, actor_role,
+       from_value, to_value, total_before, total_after, created_at
+FROM checkout_session_events
+WHERE session_id = $1
+ORDER BY created_at, id;
+
+-- name: DeleteRemovedSessionItems :exec
+DELETE FROM checkout_session_items
+WHERE checkout_session_id = sqlc.arg(checkout_session_id)
+  AND NOT (id = ANY(sqlc.arg(keep_ids)::uuid[]));
+
+-- name: UpdateSessionItem :exec
+UPDATE checkout_session_items
+SET quantity = $1, unit_price = $2, subtotal = $3
+WHERE id = $4 AND checkout_session_id = $5;
+
+-- name: UpdateSessionPricing :exec
+UPDATE checkout_sessions
+SET
+    subtotal = $1,
+    shipping_fee = $2,
+    voucher_id = $3,
+    discount = $4,
+    points_redeemed = $5,
+    tax = $6,
+    total_amount = $7,
+    wallet_amount = $8,
+    chargeable_weight_grams = $9,
+    shipping_parcels = $10
+WHERE id = $11;
+
+-- name: ListCheckoutRules :many
+SELECT id, region, min_order_amount, free_shipping_min, is_active, updated_at
+FROM checkout_rules
+WHERE is_active OR NOT sqlc.arg(active_only)::boolean
+ORDER BY region NULLS FIRST;
+
+-- name: UpsertCheckoutRule :one
+INSERT INTO checkout_rules (
+    region, min_order_amount, free_shipping_min, is_active, updated_by
+) VALUES ($1,$2,$3,$4,$5)
+ON CONFLICT ((LOWER(COALESCE(region, ''))))
+DO UPDATE SET
+    min_order_amount = EXCLUDED.min_order_amount,
+    free_shipping_min = EXCLUDED.free_shipping_min,
+    is_active = EXCLUDED.is_active,
+    updated_by = EXCLUDED.updated_by
+RETURNING id, updated_at;
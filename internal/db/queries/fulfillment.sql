@@ -0,0 +1,124 @@
+-- name: CreateOrderPickup :exec
+INSERT INTO order_pickups (order_id, location_id, slot_start, slot_end, code)
+VALUES ($1,$2,$3,$4,$5);
+
+-- name: ListPickupLocations :many
+SELECT id, name, address, city, to_char(opens_at, 'HH24:MI') AS opens_at, to_char(closes_at, 'HH24:MI') AS closes_at,
+    slot_minutes, is_active, updated_at
+FROM pickup_locations
+WHERE is_active OR NOT sqlc.arg(active_only)::boolean
+ORDER BY city, name;
+
+-- name: GetPickupLocation :one
+SELECT id, name, address, city, to_char(opens_at, 'HH24:MI') AS opens_at, to_char(closes_at, 'HH24:MI') AS closes_at,
+    slot_minutes, is_active, updated_at
+FROM pickup_locations
+WHERE id = $1;
+
+-- name: CreatePickupLocation :one
+INSERT INTO pickup_locations (
+    name, address, city, opens_at, closes_at, slot_minutes, is_active
+) VALUES ($1,$2,$3,$4,$5,$6,$7)
+RETURNING id, name, address, city, to_char(opens_at, 'HH24:MI') AS opens_at, to_char(closes_at, 'HH24:MI') AS closes_at,
+    slot_minutes, is_active, updated_at;
+
+-- name: UpdatePickupLocation :one
+UPDATE pickup_locations
+SET
+    name = $1,
+    address = $2,
+    city = $3,
+    opens_at = $4,
+    closes_at = $5,
+    slot_minutes = $6,
+    is_active = $7,
+    updated_at = NOW()
+WHERE id = $8
+RETURNING id, name, address, city, to_char(opens_at, 'HH24:MI') AS opens_at, to_char(closes_at, 'HH24:MI') AS closes_at,
+    slot_minutes, is_active, updated_at;
+
+-- name: GetOrderPickup :one
+SELECT
+    p.slot_start, p.slot_end, p.code, p.picked_up_at,
+    l.id, l.name, l.address, l.city, to_char(l.opens_at, 'HH24:MI') AS opens_at,
+    to_char(l.closes_at, 'HH24:MI') AS closes_at, l.slot_minutes, l.is_active, l.updated_at
+FROM order_pickups p
+JOIN pickup_locations l ON l.id = p.location_id
+WHERE p.order_id = $1;
+
+-- name: CompletePickupOrder :execrows
+UPDATE orders
+SET status = sqlc.arg(status), updated_at = NOW()
+WHERE id = sqlc.arg(id) AND status = sqlc.arg(from_status);
+
+-- name: RecordPickupHandover :exec
+UPDATE order_pickups
+SET picked_up_at = NOW(), handed_over_by = $1
+WHERE order_id = $2;
+
+-- name: ListDeliverySlots :many
+SELECT id, region, to_char(starts_at, 'HH24:MI') AS starts_at, to_char(ends_at, 'HH24:MI') AS ends_at,
+    capacity, is_active, updated_at
+FROM delivery_slots
+WHERE (sqlc.narg(region)::text IS NULL OR LOWER(region) = LOWER(sqlc.narg(region)::text))
+  AND (is_active OR NOT sqlc.arg(active_only)::boolean)
+ORDER BY region, starts_at;
+
+-- name: GetDeliverySlot :one
+SELECT id, region, to_char(starts_at, 'HH24:MI') AS starts_at, to_char(ends_at, 'HH24:MI') AS ends_at,
+    capacity, is_active, updated_at
+FROM delivery_slots
+WHERE id = $1;
+
+-- name: CreateDeliverySlot :one
+INSERT INTO delivery_slots (
+    region, starts_at, ends_at, capacity, is_active
+) VALUES ($1,$2,$3,$4,$5)
+RETURNING id, region, to_char(starts_at, 'HH24:MI') AS starts_at, to_char(ends_at, 'HH24:MI') AS ends_at,
+    capacity, is_active, updated_at;
+
+-- name: UpdateDeliverySlot :one
+UPDATE delivery_slots
+SET
+    region = $1,
+    starts_at = $2,
+    ends_at = $3,
+    capacity = $4,
+    is_active = $5,
+    updated_at = NOW()
+WHERE id = $6
+RETURNING id, region, to_char(starts_at, 'HH24:MI') AS starts_at, to_char(ends_at, 'HH24:MI') AS ends_at,
+    capacity, is_active, updated_at;
+
+-- name: CountDeliveryBookings :many
+SELECT b.slot_id, COUNT(*)
+FROM delivery_slot_bookings b
+JOIN orders o ON o.id = b.order_id
+WHERE b.slot_id = ANY(sqlc.arg(slot_ids)::int[])
+  AND b.delivery_date = sqlc.arg(delivery_date)
+  AND o.status NOT IN ('CANCELLED', 'FAILED')
+GROUP BY b.slot_id;
+
+-- name: LockDeliverySlot :one
+SELECT capacity
+FROM delivery_slots
+WHERE id = $1 AND is_active
+FOR UPDATE;
+
+-- name: CountSlotBookings :one
+SELECT COUNT(*)
+FROM delivery_slot_bookings b
+JOIN orders o ON o.id = b.order_id
+WHERE b.slot_id = $1
+  AND b.delivery_date = $2
+  AND o.status NOT IN ('CANCELLED', 'FAILED');
+
+-- name: CreateDeliveryBooking :exec
+INSERT INTO delivery_slot_bookings (
+    order_id, slot_id, delivery_date, window_start, window_end
+) VALUES ($1,$2,$3,$4,$5);
+
+-- name: GetOrderDelivery :one
+SELECT slot_id, delivery_date, window_start, window_end
+FROM delivery_slot_bookings
+WHERE order_id = $1;
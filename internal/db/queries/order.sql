@@ -0,0 +1,488 @@
+-- name: GetOrderBySessionID :one
+SELECT id, status, total_amount, external_id
+FROM orders
+WHERE checkout_session_id = $1;
+
+-- name: GetOrderByExternalID :one
+SELECT id, user_id, status, total_amount, wallet_amount, currency, address_id, external_id
+FROM orders
+WHERE external_id = $1;
+
+-- name: RecordStockIncident :exec
+INSERT INTO stock_incidents (variant_id, checkout_session_id, requested, available)
+SELECT $1, $2, $3, v.stock FROM variants v WHERE v.id = $1;
+
+-- name: AllocateStock :one
+UPDATE variant_stocks vs
+SET quantity = vs.quantity - sqlc.arg(quantity),
+    updated_at = NOW()
+WHERE vs.variant_id = sqlc.arg(variant_id)
+  AND vs.quantity >= sqlc.arg(quantity)
+  AND vs.warehouse_id = (
+    SELECT s.warehouse_id
+    FROM variant_stocks s
+    JOIN warehouses w ON w.id = s.warehouse_id
+    WHERE s.variant_id = sqlc.arg(variant_id)
+      AND s.quantity >= sqlc.arg(quantity)
+      AND w.is_active
+    ORDER BY
+        LOWER(w.region) = (SELECT LOWER(a.province) FROM addresses a WHERE a.id = sqlc.narg(address_id)) DESC NULLS LAST,
+        w.is_default DESC,
+        s.quantity DESC
+    LIMIT 1
+    FOR UPDATE OF s
+  )
+RETURNING vs.warehouse_id;
+
+-- name: CreateOrder :one
+INSERT INTO orders (
+    user_id,
+    checkout_session_id,
+    status,
+    total_amount,
+    currency,
+    external_id,
+    subtotal,
+    tax,
+    shipping_fee,
+    discount,
+    address_id,
+    wallet_amount,
+    placed_by,
+    shipping_method,
+    insurance_fee
+) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15)
+RETURNING id;
+
+-- name: CreateOrderPolicyAcceptance :exec
+INSERT INTO order_policy_acceptances (order_id, policy_id)
+VALUES ($1,$2);
+
+-- name: CreateOrderItem :exec
+INSERT INTO order_items (
+    order_id,
+    quantity,
+    unit_price,
+    variant_id,
+    variant_name,
+    product_name,
+    subtotal,
+    image_url,
+    warehouse_id
+) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9);
+
+-- name: ChargeOrderCommission :exec
+UPDATE order_items oi
+SET commission_rate_id = cr.id,
+    commission_amount = (oi.subtotal * cr.rate_bps + 5000) / 10000 + cr.fixed_fee
+FROM variants v
+JOIN products p ON p.id = v.product_id
+CROSS JOIN LATERAL (
+    SELECT c.id, c.rate_bps, c.fixed_fee
+    FROM category_commissions c
+    WHERE (c.category_id = p.category_id OR c.category_id IS NULL)
+      AND c.effective_from <= NOW()
+    ORDER BY c.category_id IS NULL, c.effective_from DESC
+    LIMIT 1
+) cr
+WHERE oi.order_id = $1
+  AND v.id = oi.variant_id;
+
+-- name: DebitWallet :one
+UPDATE wallets
+SET balance = balance - $1
+WHERE user_id = $2 AND balance >= $1
+RETURNING id, balance;
+
+-- name: CreditWallet :one
+UPDATE wallets
+SET balance = balance + $1
+WHERE user_id = $2
+RETURNING id, balance;
+
+-- name: CreateWalletLedgerEntry :exec
+INSERT INTO wallet_ledger (
+    wallet_id,
+    entry_type,
+    amount,
+    balance_after,
+    reference_type,
+    reference_id
+) VALUES ($1,$2,$3,$4,$5,$6);
+
+-- name: CreateWalletPayment :exec
+INSERT INTO payments (
+    order_id,
+    external_reference,
+    amount,
+    status,
+    payment_method,
+    provider,
+    currency,
+    paid_at
+) VALUES ($1,$2,$3,$4,$5,$6,$7,now());
+
+-- name: VoidWalletPayment :one
+UPDATE payments p
+SET status = sqlc.arg(status)
+FROM orders o
+WHERE o.id = p.order_id
+  AND p.external_reference = sqlc.arg(external_reference)
+  AND p.status = sqlc.arg(from_status)
+  AND o.user_id IS NOT NULL
+RETURNING o.user_id, p.amount;
+
+-- name: LockVoucher :one
+SELECT campaign_id, usage_limit
+FROM vouchers
+WHERE id = $1 AND is_active = true
+FOR UPDATE;
+
+-- name: CountVoucherRedemptions :one
+SELECT COUNT(*) FROM voucher_redemptions WHERE voucher_id = $1;
+
+-- name: CreateVoucherRedemption :exec
+INSERT INTO voucher_redemptions (
+    voucher_id,
+    campaign_id,
+    order_id,
+    user_id,
+    discount_amount
+) VALUES ($1,$2,$3,$4,$5);
+
+-- name: DebitLoyaltyPoints :one
+UPDATE loyalty_accounts
+SET balance = balance - $1
+WHERE user_id = $2 AND balance >= $1
+RETURNING balance;
+
+-- name: ConsumeLoyaltyLots :exec
+UPDATE loyalty_ledger l
+SET remaining = l.remaining - LEAST(l.remaining, sqlc.arg(points)::bigint - (c.running - l.remaining))
+FROM (
+    SELECT id, SUM(remaining) OVER (ORDER BY expires_at, id) AS running
+    FROM loyalty_ledger
+    WHERE user_id = sqlc.arg(user_id) AND entry_type = 'EARN' AND remaining > 0
+) c
+WHERE l.id = c.id
+  AND c.running - l.remaining < sqlc.arg(points)::bigint;
+
+-- name: CreateLoyaltyLedgerEntry :exec
+INSERT INTO loyalty_ledger (
+    user_id,
+    entry_type,
+    points,
+    balance_after,
+    reference_type,
+    reference_id
+) VALUES ($1,$2,$3,$4,$5,$6);
+
+-- name: GetOrderDetail :one
+SELECT id, user_id, total_amount, status, created_at, updated_at, currency,
+address_id, external_id, subtotal, tax, shipping_fee, discount, invoice_number,
+shipping_method, insurance_fee, adjustment_amount, cancel_reason_id, cancel_note
+FROM orders
+WHERE id = $1;
+
+-- name: GetOrderDetailByExternalID :one
+SELECT id, user_id, total_amount, status, created_at, updated_at, currency,
+address_id, external_id, subtotal, tax, shipping_fee, discount, invoice_number,
+shipping_method, insurance_fee, adjustment_amount, cancel_reason_id, cancel_note
+FROM orders
+WHERE external_id = $1;
+
+-- name: ListOrderItems :many
+SELECT id, order_id, quantity, unit_price, variant_id, variant_name, product_name, subtotal, image_url, quantity_type
+FROM order_items
+WHERE order_id = $1;
+
+-- name: ListOrderItemsByOrders :many
+SELECT id, order_id, variant_name, product_name, image_url, quantity, quantity_type, unit_price, variant_id, subtotal
+FROM order_items
+WHERE order_id = ANY(sqlc.arg(order_ids)::int[])
+ORDER BY order_id, id;
+
+-- name: UpdateOrderStatus :execrows
+UPDATE orders SET status = $1, updated_at = NOW() WHERE id = $2;
+
+-- name: UpdateOrderStatusAndInvoice :execrows
+UPDATE orders SET status = $1, invoice_number = $2, updated_at = NOW() WHERE id = $3;
+
+-- name: CancelOrder :execrows
+UPDATE orders
+SET status = $1, cancel_reason_id = $2, cancel_note = $3, updated_at = NOW()
+WHERE id = $4;
+
+-- name: IsOrderPacked :one
+SELECT EXISTS (
+    SELECT 1 FROM order_fulfillments
+    WHERE order_id = $1 AND packed_at IS NOT NULL
+);
+
+-- name: SetOrderStatusByExternalID :one
+UPDATE orders
+SET status = $1
+WHERE external_id = $2
+RETURNING id, checkout_session_id;
+
+-- name: SetPaymentProviderStatus :execrows
+UPDATE payments
+SET status = $1,
+    provider_payment_id = $2
+WHERE external_reference = $3;
+
+-- name: SetPaymentProviderStatusPaid :execrows
+UPDATE payments
+SET status = $1,
+    provider_payment_id = $2, paid_at = now()
+WHERE external_reference = $3;
+
+-- name: CapturePayment :execrows
+UPDATE payments
+SET status = sqlc.arg(status),
+    provider_payment_id = sqlc.arg(provider_payment_id),
+    captured_amount = COALESCE(sqlc.narg(captured_amount), captured_amount),
+    fee_amount = COALESCE(sqlc.narg(fee_amount), fee_amount),
+    channel_code = COALESCE(sqlc.narg(channel_code), channel_code),
+    paid_at = COALESCE(sqlc.narg(paid_at), now())
+WHERE external_reference = sqlc.arg(external_reference);
+
+-- name: RecreateCapturedPayment :exec
+INSERT INTO payments (
+    order_id, external_reference, invoice_url, amount, status,
+    channel_code, payment_code, provider, currency,
+    provider_payment_id, paid_at, captured_amount, fee_amount
+)
+SELECT o.id, sqlc.arg(external_reference), '', sqlc.arg(amount), sqlc.arg(status), sqlc.arg(channel_code), '', sqlc.arg(provider), o.currency, sqlc.arg(provider_payment_id), COALESCE(sqlc.narg(paid_at), now()), sqlc.arg(amount), sqlc.narg(fee_amount)
+FROM orders o
+WHERE o.id = sqlc.arg(order_id);
+
+-- name: ListExpiredPayments :many
+SELECT o.id, o.external_id, p.external_reference, o.user_id, p.expire_at
+FROM payments p
+JOIN orders o ON o.id = p.order_id
+WHERE p.status = sqlc.arg(payment_status)
+  AND p.provider = sqlc.arg(provider)
+  AND p.expire_at < sqlc.arg(expired_before)::timestamptz
+  AND o.status = sqlc.arg(order_status)
+ORDER BY p.expire_at
+LIMIT sqlc.arg(max_rows);
+
+-- name: LockOrderStatus :one
+SELECT status FROM orders WHERE external_id = $1 FOR UPDATE;
+
+-- name: UpdatePaymentStatusFrom :execrows
+UPDATE payments
+SET status = sqlc.arg(status)
+WHERE external_reference = sqlc.arg(external_reference)
+  AND status = sqlc.arg(from_status);
+
+-- name: ListExpiredSessions :many
+SELECT s.id, s.external_id, s.expires_at,
+       o.external_id AS order_external_id, o.id AS order_id, o.user_id,
+       p.external_reference AS payment_request_id
+FROM checkout_sessions s
+LEFT JOIN orders o ON o.checkout_session_id = s.id
+LEFT JOIN payments p ON p.order_id = o.id
+    AND p.status = sqlc.arg(payment_status)
+    AND p.provider = sqlc.arg(provider)
+WHERE s.status = sqlc.arg(session_status)
+  AND s.expires_at < sqlc.arg(expired_before)
+  AND (
+    (o.id IS NULL AND s.expires_at < sqlc.arg(grace_before))
+    OR (
+        o.status = sqlc.arg(order_status)
+        AND p.external_reference IS NOT NULL
+        AND s.expires_at < sqlc.arg(grace_before)
+    )
+    OR (
+        o.status = sqlc.arg(order_status)
+        AND o.created_at < sqlc.arg(grace_before)
+        AND NOT EXISTS (SELECT 1 FROM payments pp WHERE pp.order_id = o.id)
+    )
+  )
+ORDER BY s.expires_at
+LIMIT sqlc.arg(max_rows);
+
+-- name: LockUnpaidOrder :one
+SELECT o.status, EXISTS (SELECT 1 FROM payments p WHERE p.order_id = o.id)
+FROM orders o
+WHERE o.external_id = $1
+FOR UPDATE OF o;
+
+-- name: RestockOrder :exec
+UPDATE variant_stocks vs
+SET quantity = vs.quantity + oi.quantity,
+    updated_at = NOW()
+FROM (
+    SELECT
+        variant_id,
+        COALESCE(warehouse_id, (SELECT id FROM warehouses WHERE is_default)) AS warehouse_id,
+        SUM(quantity) AS quantity
+    FROM order_items
+    WHERE order_id = $1
+    GROUP BY 1, 2
+) oi
+WHERE vs.variant_id = oi.variant_id
+  AND vs.warehouse_id = oi.warehouse_id;
+
+-- name: GetOrderByReferenceID :one
+SELECT
+    id,
+    total_amount,
+    wallet_amount,
+    status,
+    external_id,
+    user_id,
+    currency
+FROM orders
+WHERE external_id = $1
+LIMIT 1;
+
+-- name: CreateOfflinePayment :exec
+INSERT INTO payments (
+    order_id,
+    external_reference,
+    amount,
+    status,
+    payment_method,
+    provider,
+    currency
+) VALUES ($1,$2,$3,$4,$5,$6,$7);
+
+-- name: ListCurrentPolicies :many
+SELECT DISTINCT ON (kind) id, kind, version, title, body, published_by, published_at
+FROM policies
+ORDER BY kind, version DESC;
+
+-- name: ListPolicyVersions :many
+SELECT id, kind, version, title, body, published_by, published_at
+FROM policies
+WHERE kind = $1
+ORDER BY version DESC;
+
+-- name: PublishPolicy :one
+INSERT INTO policies (kind, version, title, body, published_by)
+SELECT $1::varchar, COALESCE(MAX(version), 0) + 1, $2, $3, $4
+FROM policies
+WHERE kind = $1
+RETURNING id, kind, version, title, body, published_by, published_at;
+
+-- name: ListOrderPolicies :many
+SELECT p.id, p.kind, p.version, p.title, p.body, p.published_by, p.published_at
+FROM order_policy_acceptances a
+JOIN policies p ON p.id = a.policy_id
+WHERE a.order_id = $1
+ORDER BY p.kind;
+
+-- name: ListReasons :many
+SELECT id, code, kind, audience, label, position, is_active, updated_at
+FROM order_reasons
+WHERE (sqlc.narg(kind)::varchar IS NULL OR kind = sqlc.narg(kind))
+  AND (NOT sqlc.arg(customer_only)::boolean OR (is_active AND audience = 'CUSTOMER'))
+ORDER BY kind, position, id;
+
+-- name: GetReason :one
+SELECT id, code, kind, audience, label, position, is_active, updated_at
+FROM order_reasons
+WHERE id = $1;
+
+-- name: CreateReason :one
+INSERT INTO order_reasons (
+    code, kind, audience, label, position, is_active
+) VALUES ($1,$2,$3,$4,$5,$6)
+RETURNING id, code, kind, audience, label, position, is_active, updated_at;
+
+-- name: UpdateReason :one
+UPDATE order_reasons
+SET
+    code = $1,
+    kind = $2,
+    audience = $3,
+    label = $4,
+    position = $5,
+    is_active = $6,
+    updated_at = NOW()
+WHERE id = $7
+RETURNING id, code, kind, audience, label, position, is_active, updated_at;
+
+-- name: CancellationReasonStats :many
+SELECT rs.id, rs.code, rs.kind, rs.audience, rs.label, rs.position, rs.is_active, rs.updated_at,
+    COUNT(o.id) AS orders, COALESCE(SUM(o.total_amount), 0)::bigint AS amount
+FROM order_reasons rs
+LEFT JOIN orders o
+    ON o.cancel_reason_id = rs.id
+    AND o.cancelled_at >= sqlc.arg(stopped_from)::timestamptz
+    AND o.cancelled_at < sqlc.arg(stopped_until)::timestamptz
+    AND o.deleted_at IS NULL
+WHERE rs.kind = sqlc.arg(kind)
+GROUP BY rs.id
+ORDER BY COUNT(o.id) DESC, rs.position, rs.id;
+
+-- name: ReturnReasonStats :many
+SELECT rs.id, rs.code, rs.kind, rs.audience, rs.label, rs.position, rs.is_active, rs.updated_at,
+    COUNT(DISTINCT f.order_id) AS orders, COALESCE(SUM(f.amount), 0)::bigint AS amount
+FROM order_reasons rs
+LEFT JOIN refunds f
+    ON f.reason_id = rs.id
+    AND f.created_at >= sqlc.arg(stopped_from)::timestamptz
+    AND f.created_at < sqlc.arg(stopped_until)::timestamptz
+WHERE rs.kind = sqlc.arg(kind)
+GROUP BY rs.id
+ORDER BY COUNT(DISTINCT f.order_id) DESC, rs.position, rs.id;
+
+-- name: GetAdjustableOrder :one
+SELECT o.id, o.external_id, o.user_id, o.status, o.total_amount,
+       o.wallet_amount, o.currency, s.external_id AS session_external_id
+FROM orders o
+LEFT JOIN checkout_sessions s ON s.id = o.checkout_session_id
+WHERE o.id = $1;
+
+-- name: CreateOrderAdjustment :one
+INSERT INTO order_adjustments (order_id, kind, amount, reason, requested_by)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, order_id, kind, amount, reason, status, requested_by, reviewed_by, created_at, reviewed_at;
+
+-- name: GetOrderAdjustment :one
+SELECT id, order_id, kind, amount, reason, status, requested_by, reviewed_by, created_at, reviewed_at
+FROM order_adjustments
+WHERE id = $1;
+
+-- name: ListOrderAdjustments :many
+SELECT id, order_id, kind, amount, reason, status, requested_by, reviewed_by, created_at, reviewed_at
+FROM order_adjustments
+WHERE order_id = $1
+ORDER BY created_at, id;
+
+-- name: LockOrderAdjustment :one
+SELECT id, order_id, kind, amount, reason, status, requested_by, reviewed_by, created_at, reviewed_at
+FROM order_adjustments
+WHERE id = $1
+FOR UPDATE;
+
+-- name: LockOrderForAdjustment :one
+SELECT external_id, user_id, status, total_amount, wallet_amount, currency
+FROM orders
+WHERE id = $1
+FOR UPDATE;
+
+-- name: AdjustOrderTotal :one
+UPDATE orders
+SET total_amount = total_amount + sqlc.arg(delta),
+    adjustment_amount = adjustment_amount + sqlc.arg(delta),
+    updated_at = NOW()
+WHERE id = sqlc.arg(id)
+RETURNING total_amount, adjustment_amount;
+
+-- name: ReviewOrderAdjustment :one
+UPDATE order_adjustments
+SET status = $2, reviewed_by = $3, reviewed_at = NOW()
+WHERE id = $1
+RETURNING id, order_id, kind, amount, reason, status, requested_by, reviewed_by, created_at, reviewed_at;
+
+-- name: ReviewPendingOrderAdjustment :one
+UPDATE order_adjustments
+SET status = sqlc.arg(status), reviewed_by = sqlc.arg(reviewed_by), reviewed_at = NOW()
+WHERE id = sqlc.arg(id)
+  AND status = sqlc.arg(from_status)
+RETURNING id, order_id, kind, amount, reason, status, requested_by, reviewed_by, created_at, reviewed_at;
@@ -0,0 +1,90 @@
+-- name: SavePayment :exec
+INSERT INTO payments (
+    order_id,
+    external_reference,
+    invoice_url,
+    amount,
+    status,
+    payment_method,
+    channel_code,
+    payment_code,
+    provider,
+    currency,
+    expire_at
+)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);
+
+-- name: UpdatePaymentStatus :exec
+UPDATE payments SET status = $1 WHERE external_reference = $2;
+
+-- name: GetPaymentByOrder :one
+SELECT id, order_id, external_reference, invoice_url, amount, status, payment_method, created_at, updated_at, payment_code, expire_at
+FROM payments WHERE order_id = $1;
+
+-- name: GetPaymentsByOrder :many
+SELECT id, order_id, external_reference, amount, status, provider
+FROM payments WHERE order_id = $1
+ORDER BY id;
+
+-- name: MarkPaymentPaid :exec
+UPDATE payments
+SET status = 'PAID',
+    provider_payment_id = sqlc.arg(provider_payment_id),
+    captured_amount = COALESCE(sqlc.narg(captured_amount), captured_amount),
+    fee_amount = COALESCE(sqlc.narg(fee_amount), fee_amount),
+    channel_code = COALESCE(sqlc.narg(channel_code), channel_code),
+    paid_at = COALESCE(sqlc.narg(paid_at), now())
+WHERE external_reference = sqlc.arg(external_reference);
+
+-- name: SavePaymentWebhook :one
+INSERT INTO payment_webhooks (
+    provider,
+    event_type,
+    event_id,
+    external_id,
+    signature_valid,
+    payload
+)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (provider, event_id)
+DO NOTHING
+RETURNING id;
+
+-- name: MarkWebhookProcessed :exec
+UPDATE payment_webhooks SET processed_at = now() WHERE id = $1;
+
+-- name: MarkWebhookFailed :exec
+UPDATE payment_webhooks SET process_error = $2 WHERE id = $1;
+
+-- name: HasAppliedWebhook :one
+SELECT EXISTS (
+    SELECT 1
+    FROM payment_webhooks
+    WHERE provider = sqlc.arg(provider)
+      AND external_id = sqlc.arg(external_id)::text
+      AND event_type = sqlc.arg(event_type)::text
+      AND payload->'data'->>'payment_request_id' = sqlc.arg(payment_request_id)::text
+      AND payload->'data'->>'status' = sqlc.arg(status)::text
+      AND processed_at IS NOT NULL
+      AND process_error IS NULL
+);
+
+-- name: ListQueuedWebhooks :many
+SELECT id, payload
+FROM payment_webhooks
+WHERE provider = sqlc.arg(provider)
+  AND processed_at IS NULL
+  AND process_error IS NULL
+  AND received_at < sqlc.arg(received_before)
+ORDER BY received_at
+LIMIT sqlc.arg(max_rows);
+
+-- name: ListPaymentWebhookEvents :many
+SELECT event_type, payload, received_at
+FROM payment_webhooks
+WHERE provider = sqlc.arg(provider)
+  AND (
+    external_id = sqlc.arg(external_id)::text
+    OR payload->'data'->>'payment_request_id' = sqlc.arg(payment_request_id)::text
+  )
+ORDER BY received_at, id;
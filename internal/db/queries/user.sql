@@ -0,0 +1,9 @@
+-- name: CreateUser :one
+INSERT INTO users (email, password) VALUES ($1, $2) RETURNING id, email, password, role;
+
+-- name: UpdateUserPassword :execrows
+UPDATE users SET password = $1 WHERE email = $2;
+
+-- name: FindUserByEmail :one
+SELECT u.id, u.email, u.password, u.role, s.id AS seller_id
+FROM users u LEFT JOIN sellers s ON u.id = s.user_id WHERE u.email = $1;
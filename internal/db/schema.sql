@@ -0,0 +1,525 @@
+-- Schema snapshot the sqlc queries in internal/db/queries compile against.
+--
+-- sqlc cannot replay migrations/ (they rename columns inside DO blocks), so
+-- this file mirrors the final shape of the tables those queries touch. Update
+-- it in the same change as any migration that alters one of these tables,
+-- then run `sqlc generate`.
+
+CREATE TABLE users (
+    id SERIAL PRIMARY KEY,
+    email TEXT NOT NULL UNIQUE,
+    password TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    role TEXT NOT NULL DEFAULT 'USER',
+    banned_at TIMESTAMPTZ,
+    ban_reason TEXT
+);
+
+CREATE TABLE sellers (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id INTEGER NOT NULL REFERENCES users(id),
+    name VARCHAR(150) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE TABLE carts (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id),
+    quantity INTEGER NOT NULL DEFAULT 1 CHECK (quantity > 0),
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now(),
+    variant_id UUID
+);
+
+CREATE TABLE payments (
+    id SERIAL PRIMARY KEY,
+    order_id INTEGER NOT NULL,
+    external_reference VARCHAR(255) NOT NULL UNIQUE,
+    invoice_url TEXT NOT NULL,
+    amount BIGINT NOT NULL,
+    status VARCHAR(30) NOT NULL DEFAULT 'PENDING',
+    payment_method VARCHAR(100),
+    channel_code VARCHAR(100) NOT NULL,
+    payment_code VARCHAR(2048) NOT NULL,
+    created_at TIMESTAMPTZ DEFAULT now(),
+    updated_at TIMESTAMPTZ DEFAULT now(),
+    provider VARCHAR(50) NOT NULL,
+    currency VARCHAR(3) NOT NULL,
+    expire_at TIMESTAMPTZ,
+    provider_payment_id VARCHAR(150),
+    paid_at TIMESTAMPTZ,
+    failure_reason TEXT,
+    captured_amount BIGINT,
+    fee_amount BIGINT
+);
+
+CREATE TABLE payment_webhooks (
+    id BIGSERIAL PRIMARY KEY,
+    provider VARCHAR(30) NOT NULL,
+    event_type VARCHAR(100),
+    event_id VARCHAR(150),
+    external_id VARCHAR(150),
+    signature_valid BOOLEAN NOT NULL DEFAULT false,
+    payload JSONB NOT NULL,
+    received_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    processed_at TIMESTAMPTZ,
+    process_error TEXT
+);
+
+CREATE UNIQUE INDEX ux_payment_webhooks_event
+ON payment_webhooks (provider, event_id);
+
+CREATE TYPE order_status AS ENUM (
+    'PENDING_PAYMENT',
+    'PAID',
+    'ACCEPTED',
+    'SHIPPED',
+    'READY_FOR_PICKUP',
+    'COMPLETED',
+    'CANCELLED',
+    'FAILED'
+);
+
+CREATE TABLE orders (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER REFERENCES users(id),
+    total_amount BIGINT NOT NULL DEFAULT 0,
+    status order_status NOT NULL DEFAULT 'PENDING_PAYMENT',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    checkout_session_id UUID NOT NULL UNIQUE,
+    currency VARCHAR(3) NOT NULL,
+    external_id VARCHAR(50) NOT NULL,
+    subtotal BIGINT NOT NULL DEFAULT 0,
+    tax BIGINT NOT NULL DEFAULT 0,
+    shipping_fee BIGINT NOT NULL DEFAULT 0,
+    discount BIGINT NOT NULL DEFAULT 0,
+    address_id UUID NOT NULL,
+    invoice_number TEXT,
+    deleted_at TIMESTAMPTZ,
+    wallet_amount BIGINT NOT NULL DEFAULT 0,
+    anonymized_at TIMESTAMPTZ,
+    dispute_status VARCHAR(10),
+    placed_by INT REFERENCES users(id),
+    shipping_method VARCHAR(20) NOT NULL DEFAULT 'STANDARD',
+    insurance_fee INT NOT NULL DEFAULT 0,
+    cancel_reason_id INT,
+    cancel_note TEXT,
+    cancelled_at TIMESTAMPTZ,
+    adjustment_amount BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE TABLE order_items (
+    id SERIAL PRIMARY KEY,
+    order_id INTEGER NOT NULL REFERENCES orders(id),
+    quantity INTEGER NOT NULL,
+    unit_price NUMERIC(10,2) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT now(),
+    updated_at TIMESTAMP NOT NULL DEFAULT now(),
+    variant_id UUID,
+    variant_name VARCHAR(255),
+    product_name VARCHAR(255),
+    subtotal NUMERIC(14,2),
+    image_url TEXT,
+    quantity_type VARCHAR(20) NOT NULL DEFAULT 'UNIT',
+    warehouse_id UUID,
+    commission_rate_id BIGINT,
+    commission_amount BIGINT NOT NULL DEFAULT 0,
+    payout_status VARCHAR(10) NOT NULL DEFAULT 'AVAILABLE'
+);
+
+CREATE TABLE checkout_sessions (
+    id UUID PRIMARY KEY,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING',
+    subtotal BIGINT NOT NULL,
+    tax BIGINT NOT NULL DEFAULT 0,
+    shipping_fee BIGINT NOT NULL DEFAULT 0,
+    discount BIGINT NOT NULL DEFAULT 0,
+    total_amount BIGINT NOT NULL,
+    currency VARCHAR(3) NOT NULL DEFAULT 'IDR',
+    address_id UUID,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    user_id INTEGER,
+    confirmed_at TIMESTAMP,
+    external_id VARCHAR(32) NOT NULL UNIQUE,
+    payment_method VARCHAR(40),
+    wallet_amount BIGINT NOT NULL DEFAULT 0,
+    voucher_id BIGINT,
+    points_redeemed BIGINT NOT NULL DEFAULT 0,
+    chargeable_weight_grams INT NOT NULL DEFAULT 0,
+    shipping_parcels JSONB NOT NULL DEFAULT '[]',
+    guest_id UUID,
+    shipping_method VARCHAR(20) NOT NULL DEFAULT 'STANDARD',
+    pickup_location_id INT,
+    pickup_slot_start TIMESTAMPTZ,
+    delivery_slot_id INT,
+    delivery_date DATE,
+    insured BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE TABLE checkout_session_items (
+    id UUID PRIMARY KEY,
+    checkout_session_id UUID NOT NULL REFERENCES checkout_sessions(id),
+    product_name TEXT NOT NULL,
+    sku VARCHAR(100),
+    unit_price BIGINT NOT NULL,
+    quantity INT NOT NULL,
+    subtotal BIGINT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    variant_id UUID NOT NULL,
+    variant_name TEXT,
+    imageurl TEXT,
+    quantity_type TEXT
+);
+
+CREATE TABLE checkout_session_events (
+    id BIGSERIAL PRIMARY KEY,
+    session_id UUID NOT NULL REFERENCES checkout_sessions(id),
+    event_type VARCHAR(30) NOT NULL,
+    actor_user_id INT,
+    actor_guest_id UUID,
+    actor_role VARCHAR(20),
+    from_value TEXT,
+    to_value TEXT,
+    total_before INT NOT NULL,
+    total_after INT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE checkout_rules (
+    id SERIAL PRIMARY KEY,
+    region VARCHAR(100),
+    min_order_amount INT NOT NULL DEFAULT 0,
+    free_shipping_min INT,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    updated_by INT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX ux_checkout_rules_region
+ON checkout_rules (LOWER(COALESCE(region, '')));
+
+CREATE TABLE products (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    category_id UUID NOT NULL,
+    seller_id UUID NOT NULL REFERENCES sellers(id),
+    name TEXT NOT NULL,
+    slug TEXT NOT NULL,
+    description TEXT,
+    status TEXT NOT NULL DEFAULT 'active',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    imageurl TEXT,
+    subcategory_id UUID,
+    origin_id UUID,
+    moderation_hidden_at TIMESTAMPTZ,
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE TABLE variants (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    product_id UUID NOT NULL REFERENCES products(id),
+    name TEXT NOT NULL,
+    quantity_type TEXT NOT NULL DEFAULT 'unit',
+    price NUMERIC(12,2) NOT NULL,
+    stock INTEGER NOT NULL DEFAULT 0,
+    imageurl TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    description TEXT,
+    weight_grams INT NOT NULL DEFAULT 0,
+    length_cm INT NOT NULL DEFAULT 0,
+    width_cm INT NOT NULL DEFAULT 0,
+    height_cm INT NOT NULL DEFAULT 0,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    compare_at_price NUMERIC(12,2)
+);
+
+CREATE TABLE addresses (
+    id UUID PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    phone TEXT NOT NULL,
+    address_line1 TEXT NOT NULL,
+    address_line2 TEXT,
+    city VARCHAR(100) NOT NULL,
+    province VARCHAR(100) NOT NULL,
+    postal_code VARCHAR(20) NOT NULL,
+    country CHAR(2) NOT NULL DEFAULT 'ID',
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    user_id INTEGER,
+    guest_id UUID,
+    receiver_name TEXT NOT NULL DEFAULT 'USER',
+    location TEXT
+);
+
+CREATE TABLE warehouses (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    code VARCHAR(30) NOT NULL UNIQUE,
+    name VARCHAR(150) NOT NULL,
+    region VARCHAR(100) NOT NULL,
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE variant_stocks (
+    warehouse_id UUID NOT NULL REFERENCES warehouses(id),
+    variant_id UUID NOT NULL REFERENCES variants(id),
+    quantity INT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (warehouse_id, variant_id)
+);
+
+CREATE TABLE stock_incidents (
+    id BIGSERIAL PRIMARY KEY,
+    variant_id UUID NOT NULL,
+    checkout_session_id UUID,
+    requested INT NOT NULL,
+    available INT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE store_vacations (
+    id BIGSERIAL PRIMARY KEY,
+    seller_id UUID NOT NULL REFERENCES sellers(id),
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+    mode VARCHAR(20) NOT NULL,
+    message TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE seller_origins (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    seller_id UUID NOT NULL REFERENCES sellers(id),
+    label VARCHAR(100) NOT NULL,
+    contact_name VARCHAR(100) NOT NULL,
+    phone VARCHAR(30) NOT NULL,
+    address_line1 TEXT NOT NULL,
+    address_line2 TEXT,
+    city VARCHAR(100) NOT NULL,
+    province VARCHAR(100) NOT NULL,
+    postal_code VARCHAR(20) NOT NULL,
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    latitude DOUBLE PRECISION,
+    longitude DOUBLE PRECISION
+);
+
+CREATE TABLE category_commissions (
+    id BIGSERIAL PRIMARY KEY,
+    category_id UUID,
+    rate_bps INT NOT NULL,
+    fixed_fee BIGINT NOT NULL DEFAULT 0,
+    effective_from TIMESTAMPTZ NOT NULL,
+    created_by INT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE wallets (
+    id BIGSERIAL PRIMARY KEY,
+    user_id INT NOT NULL UNIQUE REFERENCES users(id),
+    balance BIGINT NOT NULL DEFAULT 0,
+    currency VARCHAR(3) NOT NULL DEFAULT 'IDR',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE wallet_ledger (
+    id BIGSERIAL PRIMARY KEY,
+    wallet_id BIGINT NOT NULL REFERENCES wallets(id),
+    entry_type VARCHAR(10) NOT NULL,
+    amount BIGINT NOT NULL,
+    balance_after BIGINT NOT NULL,
+    reference_type VARCHAR(30) NOT NULL,
+    reference_id VARCHAR(150) NOT NULL,
+    note TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE voucher_campaigns (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(150) NOT NULL,
+    description TEXT,
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE vouchers (
+    id BIGSERIAL PRIMARY KEY,
+    campaign_id BIGINT NOT NULL REFERENCES voucher_campaigns(id),
+    code VARCHAR(50) NOT NULL UNIQUE,
+    discount_type VARCHAR(10) NOT NULL,
+    discount_value BIGINT NOT NULL,
+    max_discount BIGINT,
+    min_subtotal BIGINT NOT NULL DEFAULT 0,
+    usage_limit INT,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    owner_user_id INT,
+    expires_at TIMESTAMPTZ
+);
+
+CREATE TABLE voucher_redemptions (
+    id BIGSERIAL PRIMARY KEY,
+    voucher_id BIGINT NOT NULL REFERENCES vouchers(id),
+    campaign_id BIGINT NOT NULL REFERENCES voucher_campaigns(id),
+    order_id INT NOT NULL REFERENCES orders(id),
+    user_id INT,
+    discount_amount BIGINT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE loyalty_accounts (
+    user_id INT PRIMARY KEY REFERENCES users(id),
+    balance BIGINT NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE loyalty_ledger (
+    id BIGSERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id),
+    entry_type VARCHAR(10) NOT NULL,
+    points BIGINT NOT NULL,
+    remaining BIGINT NOT NULL DEFAULT 0,
+    balance_after BIGINT NOT NULL,
+    reference_type VARCHAR(30) NOT NULL,
+    reference_id VARCHAR(150) NOT NULL,
+    expires_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE refunds (
+    id BIGSERIAL PRIMARY KEY,
+    order_id INT NOT NULL REFERENCES orders(id),
+    user_id INT,
+    amount BIGINT NOT NULL,
+    currency VARCHAR(3) NOT NULL DEFAULT 'IDR',
+    method VARCHAR(20) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING',
+    reason TEXT,
+    wallet_ledger_id BIGINT,
+    payment_reference VARCHAR(150),
+    provider_refund_id VARCHAR(150),
+    failure_reason TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMPTZ,
+    reason_id INT
+);
+
+CREATE TABLE order_fulfillments (
+    order_id INT PRIMARY KEY REFERENCES orders(id),
+    picker_id INT,
+    assigned_by INT,
+    assigned_at TIMESTAMPTZ,
+    packed_by INT,
+    packed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE pickup_locations (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    address TEXT NOT NULL,
+    city VARCHAR(100) NOT NULL,
+    opens_at TIME NOT NULL,
+    closes_at TIME NOT NULL,
+    slot_minutes INT NOT NULL DEFAULT 60,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE order_pickups (
+    order_id INT PRIMARY KEY REFERENCES orders(id),
+    location_id INT NOT NULL REFERENCES pickup_locations(id),
+    slot_start TIMESTAMPTZ NOT NULL,
+    slot_end TIMESTAMPTZ NOT NULL,
+    code CHAR(6) NOT NULL,
+    picked_up_at TIMESTAMPTZ,
+    handed_over_by INT
+);
+
+CREATE TABLE delivery_slots (
+    id SERIAL PRIMARY KEY,
+    region VARCHAR(100) NOT NULL,
+    starts_at TIME NOT NULL,
+    ends_at TIME NOT NULL,
+    capacity INT NOT NULL,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_delivery_slots_region_start
+ON delivery_slots (LOWER(region), starts_at);
+
+CREATE TABLE delivery_slot_bookings (
+    order_id INT PRIMARY KEY REFERENCES orders(id),
+    slot_id INT NOT NULL REFERENCES delivery_slots(id),
+    delivery_date DATE NOT NULL,
+    window_start TIMESTAMPTZ NOT NULL,
+    window_end TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE policies (
+    id SERIAL PRIMARY KEY,
+    kind VARCHAR(20) NOT NULL,
+    version INT NOT NULL,
+    title VARCHAR(200) NOT NULL,
+    body TEXT NOT NULL,
+    published_by INT,
+    published_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (kind, version)
+);
+
+CREATE TABLE order_policy_acceptances (
+    order_id INT NOT NULL REFERENCES orders(id),
+    policy_id INT NOT NULL REFERENCES policies(id),
+    accepted_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (order_id, policy_id)
+);
+
+CREATE TABLE order_reasons (
+    id SERIAL PRIMARY KEY,
+    code VARCHAR(50) NOT NULL UNIQUE,
+    kind VARCHAR(20) NOT NULL,
+    audience VARCHAR(20) NOT NULL,
+    label VARCHAR(200) NOT NULL,
+    position INT NOT NULL DEFAULT 0,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE order_adjustments (
+    id BIGSERIAL PRIMARY KEY,
+    order_id INT NOT NULL REFERENCES orders(id),
+    kind VARCHAR(20) NOT NULL,
+    amount BIGINT NOT NULL,
+    reason TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING',
+    requested_by INT NOT NULL,
+    reviewed_by INT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    reviewed_at TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX ux_order_adjustments_pending
+ON order_adjustments (order_id) WHERE status = 'PENDING';
@@ -0,0 +1,12 @@
+package dbhealth
+
+import (
+	"errors"
+	"warimas-be/internal/apperr"
+)
+
+var (
+	ErrUnauthenticated = apperr.Unauthenticated("unauthenticated")
+	ErrForbidden       = apperr.Forbidden("forbidden")
+	ErrDB              = errors.New("database error")
+)
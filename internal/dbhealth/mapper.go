@@ -0,0 +1,118 @@
+package dbhealth
+
+import (
+	"math"
+	"strconv"
+	"time"
+	"warimas-be/internal/graph/model"
+)
+
+// capInt32 keeps statistics counters, which outgrow a GraphQL Int on
+// busy tables, from wrapping around.
+func capInt32(n int64) int32 {
+	if n > math.MaxInt32 {
+		return math.MaxInt32
+	}
+	return int32(n)
+}
+
+func millis(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+func MapReportToGraphQL(r *Report) *model.DatabaseHealthReport {
+	out := &model.DatabaseHealthReport{
+		BloatedTables:          make([]*model.BloatedTable, 0, len(r.BloatedTables)),
+		MissingIndexCandidates: make([]*model.SeqScanTable, 0, len(r.SeqScanTables)),
+		SlowStatements:         make([]*model.SlowStatement, 0, len(r.SlowStatements)),
+		StatementsAvailable:    r.StatementsAvailable,
+		UnusedIndexes:          make([]*model.UnusedIndex, 0, len(r.UnusedIndexes)),
+		LongRunningQueries:     make([]*model.LongRunningQuery, 0, len(r.LongQueries)),
+		GeneratedAt:            r.GeneratedAt,
+	}
+	for _, t := range r.BloatedTables {
+		out.BloatedTables = append(out.BloatedTables, &model.BloatedTable{
+			Table:         t.Table,
+			LiveTuples:    capInt32(t.LiveTuples),
+			DeadTuples:    capInt32(t.DeadTuples),
+			DeadPercent:   t.DeadPercent,
+			LastVacuumAt:  t.LastVacuumAt,
+			LastAnalyzeAt: t.LastAnalyzeAt,
+		})
+	}
+	for _, t := range r.SeqScanTables {
+		out.MissingIndexCandidates = append(out.MissingIndexCandidates, &model.SeqScanTable{
+			Table:          t.Table,
+			SeqScans:       capInt32(t.SeqScans),
+			AvgRowsPerScan: capInt32(t.AvgRowsPerScan()),
+			IndexScans:     capInt32(t.IndexScans),
+			LiveTuples:     capInt32(t.LiveTuples),
+		})
+	}
+	for _, st := range r.SlowStatements {
+		out.SlowStatements = append(out.SlowStatements, mapStatement(st))
+	}
+	for _, ix := range r.UnusedIndexes {
+		out.UnusedIndexes = append(out.UnusedIndexes, &model.UnusedIndex{
+			Table:  ix.Table,
+			Index:  ix.Index,
+			SizeKb: capInt32(ix.SizeBytes / 1024),
+		})
+	}
+	for _, q := range r.LongQueries {
+		out.LongRunningQueries = append(out.LongRunningQueries, &model.LongRunningQuery{
+			Pid:             q.PID,
+			State:           q.State,
+			ApplicationName: q.ApplicationName,
+			WaitEvent:       q.WaitEvent,
+			Query:           q.Query,
+			Module:          nonEmpty(q.Tags.Module),
+			Operation:       nonEmpty(q.Tags.Operation),
+			RequestID:       nonEmpty(q.Tags.RequestID),
+			UserID:          nonEmpty(q.Tags.UserID),
+			DurationSeconds: q.Duration.Seconds(),
+		})
+	}
+	return out
+}
+
+func MapStatementReportToGraphQL(r *StatementReport) *model.SlowQueryReport {
+	out := &model.SlowQueryReport{
+		Available:  r.Available,
+		Statements: make([]*model.SlowStatement, 0, len(r.Statements)),
+		Modules:    make([]*model.ModuleQueryStats, 0, len(r.Modules)),
+	}
+	for _, st := range r.Statements {
+		out.Statements = append(out.Statements, mapStatement(st))
+	}
+	for _, m := range r.Modules {
+		out.Modules = append(out.Modules, &model.ModuleQueryStats{
+			Module:      nonEmpty(m.Module),
+			Statements:  capInt32(m.Statements),
+			Calls:       capInt32(m.Calls),
+			TotalTimeMs: millis(m.TotalTime),
+			MeanTimeMs:  millis(m.MeanTime()),
+		})
+	}
+	return out
+}
+
+func mapStatement(st *SlowStatement) *model.SlowStatement {
+	return &model.SlowStatement{
+		QueryID:     strconv.FormatInt(st.QueryID, 10),
+		Module:      nonEmpty(st.Module),
+		Method:      nonEmpty(st.Method),
+		Query:       st.Query,
+		Calls:       capInt32(st.Calls),
+		Rows:        capInt32(st.Rows),
+		MeanTimeMs:  millis(st.MeanTime),
+		TotalTimeMs: millis(st.TotalTime),
+	}
+}
+
+func nonEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
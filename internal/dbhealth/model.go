@@ -0,0 +1,193 @@
+package dbhealth
+
+import (
+	"time"
+	"unicode/utf8"
+	"warimas-be/internal/db"
+)
+
+// ReportInterval is how often the database health report runs.
+const ReportInterval = time.Hour
+
+const (
+	// maxFindings caps each section of a report.
+	maxFindings = 20
+	// minDeadTuples keeps small tables, which vacuum cheaply anyway, out
+	// of the bloat section.
+	minDeadTuples = 10000
+	// minSeqScanRows is how many rows sequential scans of a table must
+	// read on average before the table counts as missing an index.
+	minSeqScanRows = 50000
+	// maxQueryLength trims query texts kept in a report.
+	maxQueryLength = 500
+	// defaultStatementLimit and maxStatementLimit bound the slow query
+	// summary.
+	defaultStatementLimit = 20
+	maxStatementLimit     = 100
+)
+
+// Thresholds decide what a report flags.
+type Thresholds struct {
+	// DeadTuplePercent is the share of dead rows at which a table counts
+	// as bloated.
+	DeadTuplePercent float64
+	// SlowStatement is the mean execution time at which a statement
+	// from pg_stat_statements is reported.
+	SlowStatement time.Duration
+	// LongQuery is how long a query has to be running to be reported.
+	LongQuery time.Duration
+}
+
+// DefaultThresholds builds Thresholds from their configured values,
+// falling back to 20%, 500ms and 60s for zero values.
+func DefaultThresholds(deadTuplePercent, slowStatementMs, longQuerySeconds int) Thresholds {
+	t := Thresholds{
+		DeadTuplePercent: float64(deadTuplePercent),
+		SlowStatement:    time.Duration(slowStatementMs) * time.Millisecond,
+		LongQuery:        time.Duration(longQuerySeconds) * time.Second,
+	}
+	if t.DeadTuplePercent <= 0 {
+		t.DeadTuplePercent = 20
+	}
+	if t.SlowStatement <= 0 {
+		t.SlowStatement = 500 * time.Millisecond
+	}
+	if t.LongQuery <= 0 {
+		t.LongQuery = time.Minute
+	}
+	return t
+}
+
+// BloatedTable is a table whose dead rows passed the threshold. The
+// vacuum and analyze times are the later of the manual and auto runs.
+type BloatedTable struct {
+	Table         string
+	LiveTuples    int64
+	DeadTuples    int64
+	DeadPercent   float64
+	LastVacuumAt  *time.Time
+	LastAnalyzeAt *time.Time
+}
+
+// SeqScanTable is a table read mostly by large sequential scans, a
+// candidate for a missing index.
+type SeqScanTable struct {
+	Table       string
+	SeqScans    int64
+	SeqRowsRead int64
+	IndexScans  int64
+	LiveTuples  int64
+}
+
+// AvgRowsPerScan is how many rows one sequential scan reads on average.
+func (t *SeqScanTable) AvgRowsPerScan() int64 {
+	if t.SeqScans == 0 {
+		return 0
+	}
+	return t.SeqRowsRead / t.SeqScans
+}
+
+// SlowStatement is a normalized statement from pg_stat_statements.
+// Module and Method name the code that ran it, read from the comment the
+// database driver adds; both are empty for statements run without it.
+type SlowStatement struct {
+	QueryID   int64
+	Module    string
+	Method    string
+	Query     string
+	Calls     int64
+	Rows      int64
+	MeanTime  time.Duration
+	TotalTime time.Duration
+}
+
+// UnusedIndex is an index never scanned since statistics were reset.
+// Unique and primary key indexes are left out; they enforce constraints.
+type UnusedIndex struct {
+	Table     string
+	Index     string
+	SizeBytes int64
+}
+
+// LongQuery is a statement that has been running past the threshold.
+// Tags holds what the driver's comment says about who ran it.
+type LongQuery struct {
+	PID             int32
+	State           string
+	ApplicationName string
+	WaitEvent       *string
+	Query           string
+	Tags            db.Annotation
+	Duration        time.Duration
+}
+
+// StatementSort orders the statements of a StatementReport.
+type StatementSort string
+
+const (
+	SortTotalTime StatementSort = "TOTAL_TIME"
+	SortMeanTime  StatementSort = "MEAN_TIME"
+	SortCalls     StatementSort = "CALLS"
+)
+
+// statementOrder maps each sort to its pg_stat_statements column.
+var statementOrder = map[StatementSort]string{
+	SortTotalTime: "s.total_exec_time",
+	SortMeanTime:  "s.mean_exec_time",
+	SortCalls:     "s.calls",
+}
+
+// ModuleStats adds up the statements one module ran. Module is empty for
+// statements without an annotation, such as migrations and psql.
+type ModuleStats struct {
+	Module     string
+	Statements int64
+	Calls      int64
+	TotalTime  time.Duration
+}
+
+// MeanTime is the average time of one call across the module.
+func (m *ModuleStats) MeanTime() time.Duration {
+	if m.Calls == 0 {
+		return 0
+	}
+	return m.TotalTime / time.Duration(m.Calls)
+}
+
+// StatementReport is the slow query summary admins read on demand.
+// Available is false when pg_stat_statements cannot be read.
+type StatementReport struct {
+	Available  bool
+	Statements []*SlowStatement
+	Modules    []*ModuleStats
+}
+
+// Report is one run of the database health check. StatementsAvailable
+// is false when pg_stat_statements is not installed or not loaded.
+type Report struct {
+	BloatedTables       []*BloatedTable
+	SeqScanTables       []*SeqScanTable
+	SlowStatements      []*SlowStatement
+	StatementsAvailable bool
+	UnusedIndexes       []*UnusedIndex
+	LongQueries         []*LongQuery
+	GeneratedAt         time.Time
+}
+
+// Findings counts everything the report flags.
+func (r *Report) Findings() int {
+	return len(r.BloatedTables) + len(r.SeqScanTables) + len(r.SlowStatements) +
+		len(r.UnusedIndexes) + len(r.LongQueries)
+}
+
+// trimQuery shortens a query text for logs and the report.
+func trimQuery(q string) string {
+	if len(q) <= maxQueryLength {
+		return q
+	}
+	cut := maxQueryLength
+	for cut > 0 && !utf8.RuneStart(q[cut]) {
+		cut--
+	}
+	return q[:cut] + "…"
+}
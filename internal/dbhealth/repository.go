@@ -0,0 +1,338 @@
+package dbhealth
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+	"warimas-be/internal/db"
+	"warimas-be/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// Repository reads the statistics views Postgres keeps about itself.
+// Every list returns at most limit rows, worst first.
+type Repository interface {
+	// BloatedTables returns tables whose dead rows are at least
+	// deadPercent of all rows.
+	BloatedTables(ctx context.Context, deadPercent float64, limit int32) ([]*BloatedTable, error)
+	// SeqScanTables returns tables whose sequential scans read many rows
+	// each and outnumber their index scans.
+	SeqScanTables(ctx context.Context, limit int32) ([]*SeqScanTable, error)
+	// SlowStatements returns statements whose mean time is at least
+	// minMean. ok is false when pg_stat_statements cannot be read.
+	SlowStatements(ctx context.Context, minMean time.Duration, limit int32) (stmts []*SlowStatement, ok bool, err error)
+	// TopStatements returns statements ordered by sort. ok is false when
+	// pg_stat_statements cannot be read.
+	TopStatements(ctx context.Context, sort StatementSort, limit int32) (stmts []*SlowStatement, ok bool, err error)
+	// ModuleStats adds up pg_stat_statements per annotated module,
+	// busiest first. Call it only after TopStatements reported ok.
+	ModuleStats(ctx context.Context) ([]*ModuleStats, error)
+	// UnusedIndexes returns non-unique indexes never scanned, largest
+	// first.
+	UnusedIndexes(ctx context.Context, limit int32) ([]*UnusedIndex, error)
+	// LongQueries returns statements of other sessions running for at
+	// least minDuration.
+	LongQueries(ctx context.Context, minDuration time.Duration, limit int32) ([]*LongQuery, error)
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) BloatedTables(ctx context.Context, deadPercent float64, limit int32) ([]*BloatedTable, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "BloatedTables"),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT
+			schemaname || '.' || relname,
+			n_live_tup,
+			n_dead_tup,
+			ROUND(100.0 * n_dead_tup / GREATEST(n_live_tup + n_dead_tup, 1), 1)::float8,
+			GREATEST(last_vacuum, last_autovacuum),
+			GREATEST(last_analyze, last_autoanalyze)
+		FROM pg_stat_user_tables
+		WHERE n_dead_tup >= $1
+		  AND 100.0 * n_dead_tup / GREATEST(n_live_tup + n_dead_tup, 1) >= $2
+		ORDER BY n_dead_tup DESC
+		LIMIT $3
+	`, minDeadTuples, deadPercent, limit)
+	if err != nil {
+		log.Error("failed to query table bloat", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	tables := []*BloatedTable{}
+	for rows.Next() {
+		var t BloatedTable
+		if err := rows.Scan(
+			&t.Table, &t.LiveTuples, &t.DeadTuples, &t.DeadPercent, &t.LastVacuumAt, &t.LastAnalyzeAt,
+		); err != nil {
+			log.Error("failed to scan table bloat", zap.Error(err))
+			return nil, ErrDB
+		}
+		tables = append(tables, &t)
+	}
+	if err := rows.Err(); err != nil {
+		log.Error("table bloat iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+	return tables, nil
+}
+
+func (r *repository) SeqScanTables(ctx context.Context, limit int32) ([]*SeqScanTable, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "SeqScanTables"),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT
+			schemaname || '.' || relname,
+			seq_scan,
+			seq_tup_read,
+			COALESCE(idx_scan, 0),
+			n_live_tup
+		FROM pg_stat_user_tables
+		WHERE seq_scan > 0
+		  AND seq_tup_read / seq_scan >= $1
+		  AND seq_scan > COALESCE(idx_scan, 0)
+		ORDER BY seq_tup_read DESC
+		LIMIT $2
+	`, minSeqScanRows, limit)
+	if err != nil {
+		log.Error("failed to query sequential scans", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	tables := []*SeqScanTable{}
+	for rows.Next() {
+		var t SeqScanTable
+		if err := rows.Scan(&t.Table, &t.SeqScans, &t.SeqRowsRead, &t.IndexScans, &t.LiveTuples); err != nil {
+			log.Error("failed to scan sequential scans", zap.Error(err))
+			return nil, ErrDB
+		}
+		tables = append(tables, &t)
+	}
+	if err := rows.Err(); err != nil {
+		log.Error("sequential scan iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+	return tables, nil
+}
+
+func (r *repository) SlowStatements(ctx context.Context, minMean time.Duration, limit int32) ([]*SlowStatement, bool, error) {
+	return r.statements(ctx, "SlowStatements", minMean, SortTotalTime, limit)
+}
+
+func (r *repository) TopStatements(ctx context.Context, sort StatementSort, limit int32) ([]*SlowStatement, bool, error) {
+	return r.statements(ctx, "TopStatements", 0, sort, limit)
+}
+
+func (r *repository) statements(ctx context.Context, method string, minMean time.Duration, sort StatementSort, limit int32) ([]*SlowStatement, bool, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", method),
+	)
+
+	var installed bool
+	if err := r.db.QueryRowContext(ctx, `
+		SELECT EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'pg_stat_statements')
+	`).Scan(&installed); err != nil {
+		log.Error("failed to check for pg_stat_statements", zap.Error(err))
+		return nil, false, ErrDB
+	}
+	if !installed {
+		return nil, false, nil
+	}
+
+	orderBy, ok := statementOrder[sort]
+	if !ok {
+		orderBy = statementOrder[SortTotalTime]
+	}
+
+	// Times are in milliseconds. Only this database's statements count.
+	rows, err := r.db.QueryContext(ctx, fmt.Sprintf(`
+		SELECT s.queryid, s.query, s.calls, s.rows, s.mean_exec_time, s.total_exec_time
+		FROM pg_stat_statements s
+		JOIN pg_database d ON d.oid = s.dbid
+		WHERE d.datname = current_database()
+		  AND s.mean_exec_time >= $1
+		ORDER BY %s DESC
+		LIMIT $2
+	`, orderBy), float64(minMean)/float64(time.Millisecond), limit)
+	if err != nil {
+		// Installed but not in shared_preload_libraries.
+		log.Warn("pg_stat_statements cannot be read", zap.Error(err))
+		return nil, false, nil
+	}
+	defer rows.Close()
+
+	stmts := []*SlowStatement{}
+	for rows.Next() {
+		var st SlowStatement
+		var meanMs, totalMs float64
+		if err := rows.Scan(&st.QueryID, &st.Query, &st.Calls, &st.Rows, &meanMs, &totalMs); err != nil {
+			log.Error("failed to scan statement", zap.Error(err))
+			return nil, true, ErrDB
+		}
+		if a, query, ok := db.ParseAnnotation(st.Query); ok {
+			st.Module, st.Method, st.Query = a.Module, a.Method, query
+		}
+		st.Query = trimQuery(st.Query)
+		st.MeanTime = time.Duration(meanMs * float64(time.Millisecond))
+		st.TotalTime = time.Duration(totalMs * float64(time.Millisecond))
+		stmts = append(stmts, &st)
+	}
+	if err := rows.Err(); err != nil {
+		log.Error("statement iteration failed", zap.Error(err))
+		return nil, true, ErrDB
+	}
+	return stmts, true, nil
+}
+
+func (r *repository) ModuleStats(ctx context.Context) ([]*ModuleStats, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ModuleStats"),
+	)
+
+	// The module is the first word of the driver's comment; see
+	// db.ParseAnnotation.
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT
+			COALESCE(substring(s.query FROM '^/\* module=([^ ]+) '), ''),
+			COUNT(*),
+			SUM(s.calls),
+			SUM(s.total_exec_time)
+		FROM pg_stat_statements s
+		JOIN pg_database d ON d.oid = s.dbid
+		WHERE d.datname = current_database()
+		GROUP BY 1
+		ORDER BY 4 DESC
+	`)
+	if err != nil {
+		log.Error("failed to query module statistics", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	modules := []*ModuleStats{}
+	for rows.Next() {
+		var m ModuleStats
+		var totalMs float64
+		if err := rows.Scan(&m.Module, &m.Statements, &m.Calls, &totalMs); err != nil {
+			log.Error("failed to scan module statistics", zap.Error(err))
+			return nil, ErrDB
+		}
+		m.TotalTime = time.Duration(totalMs * float64(time.Millisecond))
+		modules = append(modules, &m)
+	}
+	if err := rows.Err(); err != nil {
+		log.Error("module statistics iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+	return modules, nil
+}
+
+func (r *repository) UnusedIndexes(ctx context.Context, limit int32) ([]*UnusedIndex, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "UnusedIndexes"),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT
+			s.schemaname || '.' || s.relname,
+			s.indexrelname,
+			pg_relation_size(s.indexrelid)
+		FROM pg_stat_user_indexes s
+		JOIN pg_index i ON i.indexrelid = s.indexrelid
+		WHERE s.idx_scan = 0
+		  AND NOT i.indisunique
+		  AND NOT i.indisprimary
+		ORDER BY pg_relation_size(s.indexrelid) DESC
+		LIMIT $1
+	`, limit)
+	if err != nil {
+		log.Error("failed to query unused indexes", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	indexes := []*UnusedIndex{}
+	for rows.Next() {
+		var ix UnusedIndex
+		if err := rows.Scan(&ix.Table, &ix.Index, &ix.SizeBytes); err != nil {
+			log.Error("failed to scan unused index", zap.Error(err))
+			return nil, ErrDB
+		}
+		indexes = append(indexes, &ix)
+	}
+	if err := rows.Err(); err != nil {
+		log.Error("unused index iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+	return indexes, nil
+}
+
+func (r *repository) LongQueries(ctx context.Context, minDuration time.Duration, limit int32) ([]*LongQuery, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "LongQueries"),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT
+			pid,
+			state,
+			application_name,
+			wait_event,
+			query,
+			EXTRACT(EPOCH FROM NOW() - query_start)::float8
+		FROM pg_stat_activity
+		WHERE datname = current_database()
+		  AND pid <> pg_backend_pid()
+		  AND state <> 'idle'
+		  AND backend_type = 'client backend'
+		  AND query_start <= NOW() - $1 * INTERVAL '1 second'
+		ORDER BY query_start
+		LIMIT $2
+	`, minDuration.Seconds(), limit)
+	if err != nil {
+		log.Error("failed to query long running queries", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	queries := []*LongQuery{}
+	for rows.Next() {
+		var q LongQuery
+		var seconds float64
+		if err := rows.Scan(&q.PID, &q.State, &q.ApplicationName, &q.WaitEvent, &q.Query, &seconds); err != nil {
+			log.Error("failed to scan long running query", zap.Error(err))
+			return nil, ErrDB
+		}
+		if tags, query, ok := db.ParseAnnotation(q.Query); ok {
+			q.Tags, q.Query = tags, query
+		}
+		q.Query = trimQuery(q.Query)
+		q.Duration = time.Duration(seconds * float64(time.Second))
+		queries = append(queries, &q)
+	}
+	if err := rows.Err(); err != nil {
+		log.Error("long running query iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+	return queries, nil
+}
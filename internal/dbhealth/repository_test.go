@@ -0,0 +1,173 @@
+package dbhealth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_BloatedTables(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	vacuumed := time.Now().Add(-72 * time.Hour)
+
+	mock.ExpectQuery(`FROM pg_stat_user_tables WHERE n_dead_tup >= \$1`).
+		WithArgs(minDeadTuples, float64(20), int32(5)).
+		WillReturnRows(sqlmock.NewRows([]string{"table", "live", "dead", "percent", "vacuum", "analyze"}).
+			AddRow("public.orders", 80000, 40000, 33.3, vacuumed, nil))
+
+	tables, err := repo.BloatedTables(context.Background(), 20, 5)
+	require.NoError(t, err)
+	require.Len(t, tables, 1)
+	assert.Equal(t, "public.orders", tables[0].Table)
+	assert.Equal(t, int64(40000), tables[0].DeadTuples)
+	assert.Equal(t, 33.3, tables[0].DeadPercent)
+	assert.Nil(t, tables[0].LastAnalyzeAt)
+
+	mock.ExpectQuery(`FROM pg_stat_user_tables`).WillReturnError(errors.New("boom"))
+	_, err = repo.BloatedTables(context.Background(), 20, 5)
+	assert.ErrorIs(t, err, ErrDB)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_SlowStatements(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Extension not installed", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`FROM pg_extension WHERE extname = 'pg_stat_statements'`).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+		stmts, ok, err := NewRepository(db).SlowStatements(ctx, 500*time.Millisecond, 5)
+		assert.NoError(t, err)
+		assert.False(t, ok)
+		assert.Empty(t, stmts)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Installed but not preloaded", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`FROM pg_extension`).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+		mock.ExpectQuery(`FROM pg_stat_statements`).
+			WillReturnError(errors.New("pg_stat_statements must be loaded via shared_preload_libraries"))
+
+		_, ok, err := NewRepository(db).SlowStatements(ctx, 500*time.Millisecond, 5)
+		assert.NoError(t, err)
+		assert.False(t, ok)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Reads statements", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectQuery(`FROM pg_extension`).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+		mock.ExpectQuery(`FROM pg_stat_statements s JOIN pg_database d`).
+			WithArgs(float64(500), int32(5)).
+			WillReturnRows(sqlmock.NewRows([]string{"queryid", "query", "calls", "rows", "mean", "total"}).
+				AddRow(42, "SELECT * FROM products WHERE name ILIKE $1", 10, 300, 750.5, 7505.0))
+
+		stmts, ok, err := NewRepository(db).SlowStatements(ctx, 500*time.Millisecond, 5)
+		require.NoError(t, err)
+		assert.True(t, ok)
+		require.Len(t, stmts, 1)
+		assert.Equal(t, int64(42), stmts[0].QueryID)
+		assert.Equal(t, 750500*time.Microsecond, stmts[0].MeanTime)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_LongQueries(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	mock.ExpectQuery(`FROM pg_stat_activity .* AND pid <> pg_backend_pid\(\)`).
+		WithArgs(float64(60), int32(5)).
+		WillReturnRows(sqlmock.NewRows([]string{"pid", "state", "app", "wait", "query", "seconds"}).
+			AddRow(1234, "active", "warimas", nil,
+				"/* module=order method=ListOrders operation=AdminOrders request_id=r-1 user_id=9 */ SELECT pg_sleep(600)", 90.5))
+
+	queries, err := repo.LongQueries(context.Background(), time.Minute, 5)
+	require.NoError(t, err)
+	require.Len(t, queries, 1)
+	assert.Equal(t, int32(1234), queries[0].PID)
+	assert.Nil(t, queries[0].WaitEvent)
+	assert.Equal(t, "SELECT pg_sleep(600)", queries[0].Query)
+	assert.Equal(t, "AdminOrders", queries[0].Tags.Operation)
+	assert.Equal(t, "r-1", queries[0].Tags.RequestID)
+	assert.Equal(t, "9", queries[0].Tags.UserID)
+	assert.Equal(t, 90500*time.Millisecond, queries[0].Duration)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_TopStatements(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectQuery(`FROM pg_extension`).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectQuery(`FROM pg_stat_statements s .* ORDER BY s.calls DESC`).
+		WithArgs(float64(0), int32(10)).
+		WillReturnRows(sqlmock.NewRows([]string{"queryid", "query", "calls", "rows", "mean", "total"}).
+			AddRow(7, "/* module=order method=ListOrders */ SELECT id FROM orders", 900, 900, 1.5, 1350.0).
+			AddRow(8, "SELECT 1", 5, 5, 0.1, 0.5))
+
+	stmts, ok, err := NewRepository(db).TopStatements(context.Background(), SortCalls, 10)
+	require.NoError(t, err)
+	assert.True(t, ok)
+	require.Len(t, stmts, 2)
+	assert.Equal(t, "order", stmts[0].Module)
+	assert.Equal(t, "ListOrders", stmts[0].Method)
+	assert.Equal(t, "SELECT id FROM orders", stmts[0].Query)
+	assert.Empty(t, stmts[1].Module)
+	assert.Equal(t, "SELECT 1", stmts[1].Query)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ModuleStats(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	mock.ExpectQuery(`SELECT COALESCE\(substring\(s.query .* GROUP BY 1`).
+		WillReturnRows(sqlmock.NewRows([]string{"module", "statements", "calls", "total"}).
+			AddRow("order", 12, 4000, 8000.0).
+			AddRow("", 3, 10, 20.0))
+
+	modules, err := repo.ModuleStats(context.Background())
+	require.NoError(t, err)
+	require.Len(t, modules, 2)
+	assert.Equal(t, "order", modules[0].Module)
+	assert.Equal(t, 8*time.Second, modules[0].TotalTime)
+	assert.Equal(t, 2*time.Millisecond, modules[0].MeanTime())
+
+	mock.ExpectQuery(`FROM pg_stat_statements`).WillReturnError(errors.New("boom"))
+	_, err = repo.ModuleStats(context.Background())
+	assert.ErrorIs(t, err, ErrDB)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,185 @@
+package dbhealth
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+type Service interface {
+	// Run gathers a fresh report, logs what it flags and keeps it as the
+	// latest. A section that fails is left empty and its error returned
+	// with the rest of the report.
+	Run(ctx context.Context) (*Report, error)
+	// Latest returns the last report, running one first when there is
+	// none yet. Admin only.
+	Latest(ctx context.Context) (*Report, error)
+	// SlowQueries summarizes pg_stat_statements: the top statements by
+	// sort and the totals of each module. Admin only.
+	SlowQueries(ctx context.Context, sort StatementSort, limit int32) (*StatementReport, error)
+}
+
+type service struct {
+	repo       Repository
+	thresholds Thresholds
+	now        func() time.Time
+
+	mu     sync.Mutex
+	latest *Report
+}
+
+func NewService(repo Repository, thresholds Thresholds) Service {
+	return &service{repo: repo, thresholds: thresholds, now: time.Now}
+}
+
+func (s *service) Run(ctx context.Context) (*Report, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "Run"),
+	)
+
+	report := &Report{GeneratedAt: s.now()}
+	var errs []error
+	var err error
+
+	if report.BloatedTables, err = s.repo.BloatedTables(ctx, s.thresholds.DeadTuplePercent, maxFindings); err != nil {
+		errs = append(errs, err)
+	}
+	for _, t := range report.BloatedTables {
+		log.Warn("table bloat",
+			zap.String("table", t.Table),
+			zap.Int64("dead_tuples", t.DeadTuples),
+			zap.Float64("dead_percent", t.DeadPercent),
+			zap.Timep("last_vacuum_at", t.LastVacuumAt),
+		)
+	}
+
+	if report.SeqScanTables, err = s.repo.SeqScanTables(ctx, maxFindings); err != nil {
+		errs = append(errs, err)
+	}
+	for _, t := range report.SeqScanTables {
+		log.Warn("missing index candidate",
+			zap.String("table", t.Table),
+			zap.Int64("seq_scans", t.SeqScans),
+			zap.Int64("avg_rows_per_scan", t.AvgRowsPerScan()),
+			zap.Int64("index_scans", t.IndexScans),
+		)
+	}
+
+	report.SlowStatements, report.StatementsAvailable, err = s.repo.SlowStatements(ctx, s.thresholds.SlowStatement, maxFindings)
+	if err != nil {
+		errs = append(errs, err)
+	}
+	for _, st := range report.SlowStatements {
+		log.Warn("slow statement",
+			zap.Int64("query_id", st.QueryID),
+			zap.String("module", st.Module),
+			zap.String("query_method", st.Method),
+			zap.Duration("mean_time", st.MeanTime),
+			zap.Int64("calls", st.Calls),
+			zap.String("query", st.Query),
+		)
+	}
+
+	if report.UnusedIndexes, err = s.repo.UnusedIndexes(ctx, maxFindings); err != nil {
+		errs = append(errs, err)
+	}
+	for _, ix := range report.UnusedIndexes {
+		log.Info("unused index",
+			zap.String("table", ix.Table),
+			zap.String("index", ix.Index),
+			zap.Int64("size_bytes", ix.SizeBytes),
+		)
+	}
+
+	if report.LongQueries, err = s.repo.LongQueries(ctx, s.thresholds.LongQuery, maxFindings); err != nil {
+		errs = append(errs, err)
+	}
+	for _, q := range report.LongQueries {
+		log.Warn("long running query",
+			zap.Int32("pid", q.PID),
+			zap.Duration("duration", q.Duration),
+			zap.String("state", q.State),
+			zap.String("module", q.Tags.Module),
+			zap.String("operation", q.Tags.Operation),
+			zap.String("query_request_id", q.Tags.RequestID),
+			zap.String("user_id", q.Tags.UserID),
+			zap.String("query", q.Query),
+		)
+	}
+
+	// One line with every count, for dashboards built on the logs.
+	log.Info("database health report",
+		zap.Int("bloated_tables", len(report.BloatedTables)),
+		zap.Int("missing_index_candidates", len(report.SeqScanTables)),
+		zap.Int("slow_statements", len(report.SlowStatements)),
+		zap.Bool("statements_available", report.StatementsAvailable),
+		zap.Int("unused_indexes", len(report.UnusedIndexes)),
+		zap.Int("long_queries", len(report.LongQueries)),
+	)
+
+	s.mu.Lock()
+	s.latest = report
+	s.mu.Unlock()
+
+	return report, errors.Join(errs...)
+}
+
+func (s *service) Latest(ctx context.Context) (*Report, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	latest := s.latest
+	s.mu.Unlock()
+	if latest != nil {
+		return latest, nil
+	}
+	return s.Run(ctx)
+}
+
+func (s *service) SlowQueries(ctx context.Context, sort StatementSort, limit int32) (*StatementReport, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if _, ok := statementOrder[sort]; !ok {
+		sort = SortTotalTime
+	}
+	if limit <= 0 {
+		limit = defaultStatementLimit
+	}
+	if limit > maxStatementLimit {
+		limit = maxStatementLimit
+	}
+
+	stmts, ok, err := s.repo.TopStatements(ctx, sort, limit)
+	if err != nil {
+		return nil, err
+	}
+	report := &StatementReport{Available: ok, Statements: stmts, Modules: []*ModuleStats{}}
+	if !ok {
+		report.Statements = []*SlowStatement{}
+		return report, nil
+	}
+
+	if report.Modules, err = s.repo.ModuleStats(ctx); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func requireAdmin(ctx context.Context) error {
+	if _, ok := utils.GetUserIDFromContext(ctx); !ok {
+		return ErrUnauthenticated
+	}
+	if !utils.IsAdmin(ctx) {
+		return ErrForbidden
+	}
+	return nil
+}
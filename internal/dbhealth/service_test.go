@@ -0,0 +1,234 @@
+package dbhealth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"warimas-be/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// --- Mocks ---
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) BloatedTables(ctx context.Context, deadPercent float64, limit int32) ([]*BloatedTable, error) {
+	args := m.Called(ctx, deadPercent, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*BloatedTable), args.Error(1)
+}
+
+func (m *MockRepository) SeqScanTables(ctx context.Context, limit int32) ([]*SeqScanTable, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*SeqScanTable), args.Error(1)
+}
+
+func (m *MockRepository) SlowStatements(ctx context.Context, minMean time.Duration, limit int32) ([]*SlowStatement, bool, error) {
+	args := m.Called(ctx, minMean, limit)
+	if args.Get(0) == nil {
+		return nil, args.Bool(1), args.Error(2)
+	}
+	return args.Get(0).([]*SlowStatement), args.Bool(1), args.Error(2)
+}
+
+func (m *MockRepository) TopStatements(ctx context.Context, sort StatementSort, limit int32) ([]*SlowStatement, bool, error) {
+	args := m.Called(ctx, sort, limit)
+	if args.Get(0) == nil {
+		return nil, args.Bool(1), args.Error(2)
+	}
+	return args.Get(0).([]*SlowStatement), args.Bool(1), args.Error(2)
+}
+
+func (m *MockRepository) ModuleStats(ctx context.Context) ([]*ModuleStats, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ModuleStats), args.Error(1)
+}
+
+func (m *MockRepository) UnusedIndexes(ctx context.Context, limit int32) ([]*UnusedIndex, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*UnusedIndex), args.Error(1)
+}
+
+func (m *MockRepository) LongQueries(ctx context.Context, minDuration time.Duration, limit int32) ([]*LongQuery, error) {
+	args := m.Called(ctx, minDuration, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*LongQuery), args.Error(1)
+}
+
+// --- Tests ---
+
+func newTestService(repo Repository, now time.Time) *service {
+	return &service{
+		repo:       repo,
+		thresholds: DefaultThresholds(0, 0, 0),
+		now:        func() time.Time { return now },
+	}
+}
+
+func TestDefaultThresholds(t *testing.T) {
+	th := DefaultThresholds(0, 250, 0)
+	assert.Equal(t, float64(20), th.DeadTuplePercent)
+	assert.Equal(t, 250*time.Millisecond, th.SlowStatement)
+	assert.Equal(t, time.Minute, th.LongQuery)
+}
+
+func TestService_Run(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("Collects every section", func(t *testing.T) {
+		repo := new(MockRepository)
+		s := newTestService(repo, now)
+
+		repo.On("BloatedTables", ctx, float64(20), int32(maxFindings)).
+			Return([]*BloatedTable{{Table: "public.orders", DeadTuples: 50000, DeadPercent: 40}}, nil)
+		repo.On("SeqScanTables", ctx, int32(maxFindings)).
+			Return([]*SeqScanTable{{Table: "public.products", SeqScans: 10, SeqRowsRead: 1000000}}, nil)
+		repo.On("SlowStatements", ctx, 500*time.Millisecond, int32(maxFindings)).
+			Return([]*SlowStatement{{QueryID: 7, MeanTime: time.Second}}, true, nil)
+		repo.On("UnusedIndexes", ctx, int32(maxFindings)).Return([]*UnusedIndex{}, nil)
+		repo.On("LongQueries", ctx, time.Minute, int32(maxFindings)).Return([]*LongQuery{}, nil)
+
+		report, err := s.Run(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, report.Findings())
+		assert.True(t, report.StatementsAvailable)
+		assert.Equal(t, now, report.GeneratedAt)
+		assert.Equal(t, int64(100000), report.SeqScanTables[0].AvgRowsPerScan())
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("A failing section does not stop the rest", func(t *testing.T) {
+		repo := new(MockRepository)
+		s := newTestService(repo, now)
+
+		repo.On("BloatedTables", ctx, float64(20), int32(maxFindings)).Return(nil, ErrDB)
+		repo.On("SeqScanTables", ctx, int32(maxFindings)).Return([]*SeqScanTable{}, nil)
+		repo.On("SlowStatements", ctx, 500*time.Millisecond, int32(maxFindings)).Return(nil, false, nil)
+		repo.On("UnusedIndexes", ctx, int32(maxFindings)).Return([]*UnusedIndex{}, nil)
+		repo.On("LongQueries", ctx, time.Minute, int32(maxFindings)).
+			Return([]*LongQuery{{PID: 1, Duration: 2 * time.Minute}}, nil)
+
+		report, err := s.Run(ctx)
+		assert.ErrorIs(t, err, ErrDB)
+		assert.NotNil(t, report)
+		assert.Len(t, report.LongQueries, 1)
+		assert.False(t, report.StatementsAvailable)
+		repo.AssertExpectations(t)
+	})
+}
+
+func TestService_Latest(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	admin := utils.SetUserContext(context.Background(), 1, "admin@example.com", "ADMIN")
+
+	t.Run("Requires admin", func(t *testing.T) {
+		s := newTestService(new(MockRepository), now)
+
+		_, err := s.Latest(context.Background())
+		assert.ErrorIs(t, err, ErrUnauthenticated)
+
+		user := utils.SetUserContext(context.Background(), 2, "user@example.com", "USER")
+		_, err = s.Latest(user)
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+
+	t.Run("Runs once then reuses the report", func(t *testing.T) {
+		repo := new(MockRepository)
+		s := newTestService(repo, now)
+
+		repo.On("BloatedTables", admin, float64(20), int32(maxFindings)).Return([]*BloatedTable{}, nil).Once()
+		repo.On("SeqScanTables", admin, int32(maxFindings)).Return([]*SeqScanTable{}, nil).Once()
+		repo.On("SlowStatements", admin, 500*time.Millisecond, int32(maxFindings)).Return(nil, false, nil).Once()
+		repo.On("UnusedIndexes", admin, int32(maxFindings)).Return([]*UnusedIndex{}, nil).Once()
+		repo.On("LongQueries", admin, time.Minute, int32(maxFindings)).Return([]*LongQuery{}, nil).Once()
+
+		first, err := s.Latest(admin)
+		assert.NoError(t, err)
+		second, err := s.Latest(admin)
+		assert.NoError(t, err)
+		assert.Same(t, first, second)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Run errors pass through", func(t *testing.T) {
+		repo := new(MockRepository)
+		s := newTestService(repo, now)
+
+		repo.On("BloatedTables", admin, mock.Anything, mock.Anything).Return(nil, errors.New("boom"))
+		repo.On("SeqScanTables", admin, mock.Anything).Return([]*SeqScanTable{}, nil)
+		repo.On("SlowStatements", admin, mock.Anything, mock.Anything).Return(nil, false, nil)
+		repo.On("UnusedIndexes", admin, mock.Anything).Return([]*UnusedIndex{}, nil)
+		repo.On("LongQueries", admin, mock.Anything, mock.Anything).Return([]*LongQuery{}, nil)
+
+		_, err := s.Latest(admin)
+		assert.Error(t, err)
+	})
+}
+
+func TestService_SlowQueries(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	admin := utils.SetUserContext(context.Background(), 1, "admin@example.com", "ADMIN")
+
+	t.Run("Requires admin", func(t *testing.T) {
+		s := newTestService(new(MockRepository), now)
+
+		user := utils.SetUserContext(context.Background(), 2, "user@example.com", "USER")
+		_, err := s.SlowQueries(user, SortCalls, 10)
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+
+	t.Run("Defaults and caps the limit", func(t *testing.T) {
+		repo := new(MockRepository)
+		s := newTestService(repo, now)
+
+		repo.On("TopStatements", admin, SortTotalTime, int32(defaultStatementLimit)).Return(nil, false, nil).Once()
+		repo.On("TopStatements", admin, SortCalls, int32(maxStatementLimit)).Return(nil, false, nil).Once()
+
+		report, err := s.SlowQueries(admin, "BOGUS", 0)
+		assert.NoError(t, err)
+		assert.False(t, report.Available)
+		assert.Empty(t, report.Statements)
+		assert.Empty(t, report.Modules)
+
+		_, err = s.SlowQueries(admin, SortCalls, 1000)
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+		repo.AssertNotCalled(t, "ModuleStats", mock.Anything)
+	})
+
+	t.Run("Adds module totals", func(t *testing.T) {
+		repo := new(MockRepository)
+		s := newTestService(repo, now)
+
+		stmts := []*SlowStatement{{QueryID: 7, Module: "order", Method: "ListOrders", Calls: 40}}
+		modules := []*ModuleStats{{Module: "order", Statements: 3, Calls: 40, TotalTime: 2 * time.Second}}
+		repo.On("TopStatements", admin, SortMeanTime, int32(5)).Return(stmts, true, nil)
+		repo.On("ModuleStats", admin).Return(modules, nil)
+
+		report, err := s.SlowQueries(admin, SortMeanTime, 5)
+		assert.NoError(t, err)
+		assert.True(t, report.Available)
+		assert.Equal(t, stmts, report.Statements)
+		assert.Equal(t, 50*time.Millisecond, report.Modules[0].MeanTime())
+		repo.AssertExpectations(t)
+	})
+}
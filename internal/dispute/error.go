@@ -0,0 +1,17 @@
+package dispute
+
+import (
+	"errors"
+	"warimas-be/internal/apperr"
+)
+
+var (
+	ErrUnauthenticated = apperr.Unauthenticated("unauthenticated")
+	ErrForbidden       = apperr.Forbidden("forbidden")
+	ErrPaymentNotFound = apperr.NotFound("payment not found")
+	ErrDisputeNotFound = apperr.NotFound("dispute not found")
+	ErrAlreadyResolved = errors.New("dispute already resolved")
+	ErrInvalidOutcome  = errors.New("dispute outcome must be WON or LOST")
+	ErrInvalidDispute  = errors.New("dispute id and amount are required")
+	ErrDB              = errors.New("database error")
+)
@@ -0,0 +1,25 @@
+package dispute
+
+import (
+	"strconv"
+	"warimas-be/internal/graph/model"
+)
+
+func MapDisputeToGraphQL(d *Dispute) *model.PaymentDispute {
+	return &model.PaymentDispute{
+		ID:                strconv.FormatInt(d.ID, 10),
+		OrderID:           strconv.Itoa(int(d.OrderID)),
+		OrderExternalID:   d.OrderExternalID,
+		PaymentReference:  d.PaymentReference,
+		ProviderDisputeID: d.ProviderDisputeID,
+		Amount:            int32(d.Amount),
+		Currency:          d.Currency,
+		Reason:            d.Reason,
+		Status:            model.DisputeStatus(d.Status),
+		ResolutionNote:    d.ResolutionNote,
+		PointsClawedBack:  int32(d.PointsClawedBack),
+		PayoutHeld:        int32(d.PayoutHeld),
+		OpenedAt:          d.OpenedAt,
+		ResolvedAt:        d.ResolvedAt,
+	}
+}
@@ -0,0 +1,52 @@
+package dispute
+
+import "time"
+
+type Status string
+
+const (
+	StatusOpen Status = "OPEN"
+	StatusWon  Status = "WON"
+	StatusLost Status = "LOST"
+)
+
+// orders.dispute_status while a dispute is open; once resolved it takes
+// the outcome.
+const orderDisputed = "DISPUTED"
+
+const (
+	defaultLimit = 50
+	maxLimit     = 500
+)
+
+// Dispute is a chargeback raised against a gateway payment.
+type Dispute struct {
+	ID                int64
+	PaymentID         int32
+	OrderID           int32
+	OrderExternalID   string
+	PaymentReference  string
+	Provider          string
+	ProviderDisputeID string
+	Amount            int64
+	Currency          string
+	Reason            *string
+	Status            Status
+	ResolutionNote    *string
+	ResolvedBy        *int32
+	PointsClawedBack  int64
+	PayoutHeld        int64
+	OpenedAt          time.Time
+	ResolvedAt        *time.Time
+}
+
+// OpenInput is a dispute as reported by the gateway. PaymentReference is
+// the payments.external_reference the dispute was raised against.
+type OpenInput struct {
+	Provider          string
+	ProviderDisputeID string
+	PaymentReference  string
+	Amount            int64
+	Currency          string
+	Reason            *string
+}
@@ -0,0 +1,27 @@
+package dispute
+
+import (
+	"context"
+	"warimas-be/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// Notifier tells admins a new dispute needs attention.
+type Notifier interface {
+	NotifyDisputeOpened(ctx context.Context, d *Dispute) error
+}
+
+// LogNotifier writes the alert as a warning for log-based alerting.
+type LogNotifier struct{}
+
+func (LogNotifier) NotifyDisputeOpened(ctx context.Context, d *Dispute) error {
+	logger.FromCtx(ctx).Warn("payment dispute opened",
+		zap.Int64("dispute_id", d.ID),
+		zap.String("order_external_id", d.OrderExternalID),
+		zap.String("payment_reference", d.PaymentReference),
+		zap.Int64("amount", d.Amount),
+		zap.String("currency", d.Currency),
+	)
+	return nil
+}
@@ -0,0 +1,354 @@
+package dispute
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"warimas-be/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+type Repository interface {
+	// Open records a gateway dispute, flags its order as disputed and
+	// holds the seller earnings of the order's lines. created is false
+	// when the provider already reported this dispute.
+	Open(ctx context.Context, in *OpenInput) (id int64, created bool, err error)
+	GetByID(ctx context.Context, id int64) (*Dispute, error)
+	List(ctx context.Context, status *Status, limit int32) ([]*Dispute, error)
+	// Resolve closes an open dispute with outcome. A won dispute releases
+	// the held seller earnings. A lost dispute reverses them, marks the
+	// payment as charged back, so it no longer counts as paid, and claws
+	// back the unspent points the order earned.
+	Resolve(ctx context.Context, id int64, outcome Status, note *string, adminID uint) error
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+const selectDispute = `
+	SELECT d.id, d.payment_id, d.order_id, o.external_id, p.external_reference,
+	       d.provider, d.provider_dispute_id, d.amount, d.currency, d.reason,
+	       d.status, d.resolution_note, d.resolved_by, d.points_clawed_back,
+	       d.payout_held, d.opened_at, d.resolved_at
+	FROM payment_disputes d
+	JOIN orders o ON o.id = d.order_id
+	JOIN payments p ON p.id = d.payment_id
+`
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanDispute(s scanner) (*Dispute, error) {
+	var d Dispute
+	err := s.Scan(
+		&d.ID, &d.PaymentID, &d.OrderID, &d.OrderExternalID, &d.PaymentReference,
+		&d.Provider, &d.ProviderDisputeID, &d.Amount, &d.Currency, &d.Reason,
+		&d.Status, &d.ResolutionNote, &d.ResolvedBy, &d.PointsClawedBack,
+		&d.PayoutHeld, &d.OpenedAt, &d.ResolvedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &d, nil
+}
+
+func (r *repository) Open(ctx context.Context, in *OpenInput) (id int64, created bool, err error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "Open"),
+		zap.String("provider_dispute_id", in.ProviderDisputeID),
+	)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error("failed to begin transaction", zap.Error(err))
+		return 0, false, ErrDB
+	}
+	defer func() {
+		if err != nil || !created {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var paymentID, orderID int32
+	err = tx.QueryRowContext(ctx, `
+		SELECT id, order_id
+		FROM payments
+		WHERE external_reference = $1
+	`, in.PaymentReference).Scan(&paymentID, &orderID)
+	if errors.Is(err, sql.ErrNoRows) {
+		log.Warn("disputed payment not found", zap.String("payment_reference", in.PaymentReference))
+		return 0, false, ErrPaymentNotFound
+	}
+	if err != nil {
+		log.Error("failed to load payment", zap.Error(err))
+		return 0, false, ErrDB
+	}
+
+	// Lines already held by another open dispute of the order add nothing.
+	// A repeated report rolls the hold back with the rest.
+	var held int64
+	err = tx.QueryRowContext(ctx, `
+		WITH held AS (
+			UPDATE order_items
+			SET payout_status = 'HELD', updated_at = NOW()
+			WHERE order_id = $1 AND payout_status = 'AVAILABLE'
+			RETURNING COALESCE(subtotal, 0) - commission_amount AS earning
+		)
+		SELECT COALESCE(SUM(earning), 0)::bigint FROM held
+	`, orderID).Scan(&held)
+	if err != nil {
+		log.Error("failed to hold seller earnings", zap.Error(err))
+		return 0, false, ErrDB
+	}
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO payment_disputes (
+			payment_id, order_id, provider, provider_dispute_id, amount, currency, reason, payout_held
+		) VALUES ($1,$2,$3,$4,$5,$6,$7,$8)
+		ON CONFLICT (provider, provider_dispute_id) DO NOTHING
+		RETURNING id
+	`, paymentID, orderID, in.Provider, in.ProviderDisputeID, in.Amount, in.Currency, in.Reason, held).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		log.Error("failed to insert dispute", zap.Error(err))
+		return 0, false, ErrDB
+	}
+
+	if _, err = tx.ExecContext(ctx, `
+		UPDATE orders SET dispute_status = $2 WHERE id = $1
+	`, orderID, orderDisputed); err != nil {
+		log.Error("failed to flag order as disputed", zap.Error(err))
+		return 0, false, ErrDB
+	}
+
+	created = true
+	if err = tx.Commit(); err != nil {
+		log.Error("failed to commit dispute", zap.Error(err))
+		return 0, false, ErrDB
+	}
+
+	return id, true, nil
+}
+
+func (r *repository) GetByID(ctx context.Context, id int64) (*Dispute, error) {
+	d, err := scanDispute(r.db.QueryRowContext(ctx, selectDispute+` WHERE d.id = $1`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrDisputeNotFound
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to get dispute", zap.Int64("dispute_id", id), zap.Error(err))
+		return nil, ErrDB
+	}
+	return d, nil
+}
+
+func (r *repository) List(ctx context.Context, status *Status, limit int32) ([]*Dispute, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "List"),
+	)
+
+	rows, err := r.db.QueryContext(ctx, selectDispute+`
+		WHERE ($1::text IS NULL OR d.status = $1)
+		ORDER BY d.opened_at DESC, d.id DESC
+		LIMIT $2
+	`, status, limit)
+	if err != nil {
+		log.Error("failed to query disputes", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	list := []*Dispute{}
+	for rows.Next() {
+		d, err := scanDispute(rows)
+		if err != nil {
+			log.Error("failed to scan dispute", zap.Error(err))
+			return nil, ErrDB
+		}
+		list = append(list, d)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error("dispute iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return list, nil
+}
+
+func (r *repository) Resolve(ctx context.Context, id int64, outcome Status, note *string, adminID uint) (err error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "Resolve"),
+		zap.Int64("dispute_id", id),
+		zap.String("outcome", string(outcome)),
+	)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error("failed to begin transaction", zap.Error(err))
+		return ErrDB
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var (
+		status          Status
+		paymentID       int32
+		orderID         int32
+		orderExternalID string
+	)
+	err = tx.QueryRowContext(ctx, `
+		SELECT d.status, d.payment_id, d.order_id, o.external_id
+		FROM payment_disputes d
+		JOIN orders o ON o.id = d.order_id
+		WHERE d.id = $1
+		FOR UPDATE OF d
+	`, id).Scan(&status, &paymentID, &orderID, &orderExternalID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrDisputeNotFound
+	}
+	if err != nil {
+		log.Error("failed to lock dispute", zap.Error(err))
+		return ErrDB
+	}
+	if status != StatusOpen {
+		return ErrAlreadyResolved
+	}
+
+	var clawedBack int64
+	if outcome == StatusWon {
+		// Another open dispute of the order keeps the lines held.
+		if _, err = tx.ExecContext(ctx, `
+			UPDATE order_items
+			SET payout_status = 'AVAILABLE', updated_at = NOW()
+			WHERE order_id = $1
+			  AND payout_status = 'HELD'
+			  AND NOT EXISTS (
+				SELECT 1 FROM payment_disputes
+				WHERE order_id = $1 AND status = 'OPEN' AND id <> $2
+			  )
+		`, orderID, id); err != nil {
+			log.Error("failed to release seller earnings", zap.Error(err))
+			return ErrDB
+		}
+	}
+	if outcome == StatusLost {
+		if _, err = tx.ExecContext(ctx, `
+			UPDATE order_items
+			SET payout_status = 'REVERSED', updated_at = NOW()
+			WHERE order_id = $1 AND payout_status = 'HELD'
+		`, orderID); err != nil {
+			log.Error("failed to reverse seller earnings", zap.Error(err))
+			return ErrDB
+		}
+
+		if _, err = tx.ExecContext(ctx, `
+			UPDATE payments SET status = 'CHARGEBACK', updated_at = NOW() WHERE id = $1
+		`, paymentID); err != nil {
+			log.Error("failed to mark payment charged back", zap.Error(err))
+			return ErrDB
+		}
+
+		clawedBack, err = clawBackPoints(ctx, tx, orderExternalID)
+		if err != nil {
+			log.Error("failed to claw back points", zap.Error(err))
+			return ErrDB
+		}
+	}
+
+	if _, err = tx.ExecContext(ctx, `
+		UPDATE payment_disputes
+		SET status = $2,
+		    resolution_note = $3,
+		    resolved_by = $4,
+		    points_clawed_back = $5,
+		    resolved_at = NOW()
+		WHERE id = $1
+	`, id, outcome, note, adminID, clawedBack); err != nil {
+		log.Error("failed to resolve dispute", zap.Error(err))
+		return ErrDB
+	}
+
+	if _, err = tx.ExecContext(ctx, `
+		UPDATE orders SET dispute_status = $2 WHERE id = $1
+	`, orderID, outcome); err != nil {
+		log.Error("failed to update order dispute status", zap.Error(err))
+		return ErrDB
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Error("failed to commit resolution", zap.Error(err))
+		return ErrDB
+	}
+
+	return nil
+}
+
+// clawBackPoints takes the still unspent part of the order's earn lot off
+// the customer's balance. Points already redeemed are not recovered.
+func clawBackPoints(ctx context.Context, tx *sql.Tx, orderExternalID string) (int64, error) {
+	var (
+		lotID     int64
+		userID    int32
+		remaining int64
+	)
+	err := tx.QueryRowContext(ctx, `
+		SELECT id, user_id, remaining
+		FROM loyalty_ledger
+		WHERE reference_type = 'ORDER'
+		  AND reference_id = $1
+		  AND entry_type = 'EARN'
+		FOR UPDATE
+	`, orderExternalID).Scan(&lotID, &userID, &remaining)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if remaining <= 0 {
+		return 0, nil
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE loyalty_ledger SET remaining = 0 WHERE id = $1
+	`, lotID); err != nil {
+		return 0, err
+	}
+
+	var balance int64
+	if err := tx.QueryRowContext(ctx, `
+		UPDATE loyalty_accounts
+		SET balance = GREATEST(balance - $2, 0)
+		WHERE user_id = $1
+		RETURNING balance
+	`, userID, remaining).Scan(&balance); err != nil {
+		return 0, err
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO loyalty_ledger (
+			user_id, entry_type, points, balance_after, reference_type, reference_id
+		) VALUES ($1, 'CLAWBACK', $2, $3, 'ORDER', $4)
+		ON CONFLICT (reference_type, reference_id, entry_type) DO NOTHING
+	`, userID, remaining, balance, orderExternalID); err != nil {
+		return 0, err
+	}
+
+	return remaining, nil
+}
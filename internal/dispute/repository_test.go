@@ -0,0 +1,174 @@
+package dispute
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Open(t *testing.T) {
+	ctx := context.Background()
+	in := &OpenInput{
+		Provider:          "XENDIT",
+		ProviderDisputeID: "dsp-1",
+		PaymentReference:  "pr-1",
+		Amount:            50000,
+		Currency:          "IDR",
+	}
+
+	t.Run("Created", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT id, order_id FROM payments WHERE external_reference = \$1`).
+			WithArgs("pr-1").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "order_id"}).AddRow(5, 10))
+		mock.ExpectQuery(`UPDATE order_items SET payout_status = 'HELD', .* WHERE order_id = \$1 AND payout_status = 'AVAILABLE'`).
+			WithArgs(int32(10)).
+			WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(42000))
+		mock.ExpectQuery(`INSERT INTO payment_disputes .* ON CONFLICT \(provider, provider_dispute_id\) DO NOTHING RETURNING id`).
+			WithArgs(int32(5), int32(10), "XENDIT", "dsp-1", int64(50000), "IDR", nil, int64(42000)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectExec(`UPDATE orders SET dispute_status = \$2 WHERE id = \$1`).
+			WithArgs(int32(10), "DISPUTED").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		id, created, err := repo.Open(ctx, in)
+		assert.NoError(t, err)
+		assert.True(t, created)
+		assert.Equal(t, int64(1), id)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Duplicate", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT id, order_id FROM payments`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "order_id"}).AddRow(5, 10))
+		mock.ExpectQuery(`UPDATE order_items SET payout_status = 'HELD'`).
+			WillReturnRows(sqlmock.NewRows([]string{"sum"}).AddRow(0))
+		mock.ExpectQuery(`INSERT INTO payment_disputes`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+		mock.ExpectRollback()
+
+		_, created, err := repo.Open(ctx, in)
+		assert.NoError(t, err)
+		assert.False(t, created)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("PaymentNotFound", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT id, order_id FROM payments`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "order_id"}))
+		mock.ExpectRollback()
+
+		_, _, err = repo.Open(ctx, in)
+		assert.ErrorIs(t, err, ErrPaymentNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_Resolve(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("LostReversesPayoutChargesBackAndClawsPoints", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT d.status, d.payment_id, d.order_id, o.external_id FROM payment_disputes d .* FOR UPDATE OF d`).
+			WithArgs(int64(1)).
+			WillReturnRows(sqlmock.NewRows([]string{"status", "payment_id", "order_id", "external_id"}).
+				AddRow("OPEN", 5, 10, "ORD-10"))
+		mock.ExpectExec(`UPDATE order_items SET payout_status = 'REVERSED', .* WHERE order_id = \$1 AND payout_status = 'HELD'`).
+			WithArgs(int32(10)).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(`UPDATE payments SET status = 'CHARGEBACK'`).
+			WithArgs(int32(5)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(`SELECT id, user_id, remaining FROM loyalty_ledger .* FOR UPDATE`).
+			WithArgs("ORD-10").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "remaining"}).AddRow(77, 3, 120))
+		mock.ExpectExec(`UPDATE loyalty_ledger SET remaining = 0 WHERE id = \$1`).
+			WithArgs(int64(77)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(`UPDATE loyalty_accounts SET balance = GREATEST\(balance - \$2, 0\)`).
+			WithArgs(int32(3), int64(120)).
+			WillReturnRows(sqlmock.NewRows([]string{"balance"}).AddRow(30))
+		mock.ExpectExec(`INSERT INTO loyalty_ledger .* 'CLAWBACK'`).
+			WithArgs(int32(3), int64(120), int64(30), "ORD-10").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`UPDATE payment_disputes SET status = \$2`).
+			WithArgs(int64(1), StatusLost, nil, uint(9), int64(120)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE orders SET dispute_status = \$2 WHERE id = \$1`).
+			WithArgs(int32(10), StatusLost).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err = repo.Resolve(ctx, 1, StatusLost, nil, 9)
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("WonReleasesPayoutOnly", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`FROM payment_disputes d`).
+			WillReturnRows(sqlmock.NewRows([]string{"status", "payment_id", "order_id", "external_id"}).
+				AddRow("OPEN", 5, 10, "ORD-10"))
+		mock.ExpectExec(`UPDATE order_items SET payout_status = 'AVAILABLE', .* AND NOT EXISTS \( SELECT 1 FROM payment_disputes WHERE order_id = \$1 AND status = 'OPEN' AND id <> \$2 \)`).
+			WithArgs(int32(10), int64(1)).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(`UPDATE payment_disputes SET status = \$2`).
+			WithArgs(int64(1), StatusWon, nil, uint(9), int64(0)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE orders SET dispute_status`).
+			WithArgs(int32(10), StatusWon).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		assert.NoError(t, repo.Resolve(ctx, 1, StatusWon, nil, 9))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("AlreadyResolved", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`FROM payment_disputes d`).
+			WillReturnRows(sqlmock.NewRows([]string{"status", "payment_id", "order_id", "external_id"}).
+				AddRow("WON", 5, 10, "ORD-10"))
+		mock.ExpectRollback()
+
+		err = repo.Resolve(ctx, 1, StatusLost, nil, 9)
+		assert.ErrorIs(t, err, ErrAlreadyResolved)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,113 @@
+package dispute
+
+import (
+	"context"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+type Service interface {
+	// OpenFromGateway records a dispute reported by the payment gateway
+	// and alerts admins the first time it is seen.
+	OpenFromGateway(ctx context.Context, in *OpenInput) error
+	ListDisputes(ctx context.Context, status *Status, limit int32) ([]*Dispute, error)
+	Resolve(ctx context.Context, id int64, outcome Status, note *string) (*Dispute, error)
+}
+
+type service struct {
+	repo     Repository
+	notifier Notifier
+}
+
+func NewService(repo Repository, notifier Notifier) Service {
+	return &service{repo: repo, notifier: notifier}
+}
+
+func (s *service) OpenFromGateway(ctx context.Context, in *OpenInput) error {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "OpenFromGateway"),
+		zap.String("provider_dispute_id", in.ProviderDisputeID),
+	)
+
+	if in.ProviderDisputeID == "" || in.Amount <= 0 {
+		log.Warn("invalid dispute payload")
+		return ErrInvalidDispute
+	}
+
+	id, created, err := s.repo.Open(ctx, in)
+	if err != nil {
+		return err
+	}
+	if !created {
+		log.Info("dispute already recorded")
+		return nil
+	}
+
+	d, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	// The dispute is already stored; a failed alert must not make the
+	// gateway retry the webhook.
+	if err := s.notifier.NotifyDisputeOpened(ctx, d); err != nil {
+		log.Error("failed to notify admins", zap.Int64("dispute_id", id), zap.Error(err))
+	}
+
+	return nil
+}
+
+func (s *service) ListDisputes(ctx context.Context, status *Status, limit int32) ([]*Dispute, error) {
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+
+	return s.repo.List(ctx, status, limit)
+}
+
+func (s *service) Resolve(ctx context.Context, id int64, outcome Status, note *string) (*Dispute, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "Resolve"),
+		zap.Int64("dispute_id", id),
+		zap.String("outcome", string(outcome)),
+	)
+
+	adminID, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if outcome != StatusWon && outcome != StatusLost {
+		return nil, ErrInvalidOutcome
+	}
+
+	if err := s.repo.Resolve(ctx, id, outcome, note, adminID); err != nil {
+		log.Warn("failed to resolve dispute", zap.Error(err))
+		return nil, err
+	}
+
+	log.Info("dispute resolved")
+	return s.repo.GetByID(ctx, id)
+}
+
+func requireAdmin(ctx context.Context) (uint, error) {
+	userID, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		return 0, ErrUnauthenticated
+	}
+	if !utils.IsAdmin(ctx) {
+		return 0, ErrForbidden
+	}
+	return userID, nil
+}
@@ -0,0 +1,178 @@
+package dispute
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"warimas-be/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// --- Mocks ---
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) Open(ctx context.Context, in *OpenInput) (int64, bool, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(int64), args.Bool(1), args.Error(2)
+}
+
+func (m *MockRepository) GetByID(ctx context.Context, id int64) (*Dispute, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Dispute), args.Error(1)
+}
+
+func (m *MockRepository) List(ctx context.Context, status *Status, limit int32) ([]*Dispute, error) {
+	args := m.Called(ctx, status, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Dispute), args.Error(1)
+}
+
+func (m *MockRepository) Resolve(ctx context.Context, id int64, outcome Status, note *string, adminID uint) error {
+	args := m.Called(ctx, id, outcome, note, adminID)
+	return args.Error(0)
+}
+
+type MockNotifier struct {
+	mock.Mock
+}
+
+func (m *MockNotifier) NotifyDisputeOpened(ctx context.Context, d *Dispute) error {
+	args := m.Called(ctx, d)
+	return args.Error(0)
+}
+
+// --- Tests ---
+
+func TestService_OpenFromGateway(t *testing.T) {
+	ctx := context.Background()
+	in := &OpenInput{
+		Provider:          "XENDIT",
+		ProviderDisputeID: "dsp-1",
+		PaymentReference:  "pr-1",
+		Amount:            50000,
+		Currency:          "IDR",
+	}
+
+	t.Run("NewDisputeNotifiesAdmins", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		notifier := new(MockNotifier)
+		svc := NewService(mockRepo, notifier)
+
+		d := &Dispute{ID: 3, Status: StatusOpen}
+		mockRepo.On("Open", ctx, in).Return(int64(3), true, nil)
+		mockRepo.On("GetByID", ctx, int64(3)).Return(d, nil)
+		notifier.On("NotifyDisputeOpened", ctx, d).Return(nil)
+
+		err := svc.OpenFromGateway(ctx, in)
+		assert.NoError(t, err)
+		notifier.AssertExpectations(t)
+	})
+
+	t.Run("DuplicateIsIgnored", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		notifier := new(MockNotifier)
+		svc := NewService(mockRepo, notifier)
+
+		mockRepo.On("Open", ctx, in).Return(int64(0), false, nil)
+
+		err := svc.OpenFromGateway(ctx, in)
+		assert.NoError(t, err)
+		notifier.AssertNotCalled(t, "NotifyDisputeOpened", mock.Anything, mock.Anything)
+	})
+
+	t.Run("NotifyFailureDoesNotFail", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		notifier := new(MockNotifier)
+		svc := NewService(mockRepo, notifier)
+
+		mockRepo.On("Open", ctx, in).Return(int64(3), true, nil)
+		mockRepo.On("GetByID", ctx, int64(3)).Return(&Dispute{ID: 3}, nil)
+		notifier.On("NotifyDisputeOpened", ctx, mock.Anything).Return(errors.New("down"))
+
+		assert.NoError(t, svc.OpenFromGateway(ctx, in))
+	})
+
+	t.Run("InvalidPayload", func(t *testing.T) {
+		svc := NewService(new(MockRepository), new(MockNotifier))
+
+		err := svc.OpenFromGateway(ctx, &OpenInput{PaymentReference: "pr-1", Amount: 100})
+		assert.ErrorIs(t, err, ErrInvalidDispute)
+	})
+
+	t.Run("UnknownPayment", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, new(MockNotifier))
+
+		mockRepo.On("Open", ctx, in).Return(int64(0), false, ErrPaymentNotFound)
+
+		assert.ErrorIs(t, svc.OpenFromGateway(ctx, in), ErrPaymentNotFound)
+	})
+}
+
+func TestService_Resolve(t *testing.T) {
+	adminCtx := utils.SetUserContext(context.Background(), 9, "admin@example.com", "ADMIN")
+	note := "bank sided with customer"
+
+	t.Run("Lost", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, new(MockNotifier))
+
+		mockRepo.On("Resolve", adminCtx, int64(3), StatusLost, &note, uint(9)).Return(nil)
+		mockRepo.On("GetByID", adminCtx, int64(3)).Return(&Dispute{ID: 3, Status: StatusLost, PointsClawedBack: 120}, nil)
+
+		d, err := svc.Resolve(adminCtx, 3, StatusLost, &note)
+		assert.NoError(t, err)
+		assert.Equal(t, StatusLost, d.Status)
+		assert.Equal(t, int64(120), d.PointsClawedBack)
+	})
+
+	t.Run("InvalidOutcome", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, new(MockNotifier))
+
+		_, err := svc.Resolve(adminCtx, 3, StatusOpen, nil)
+		assert.ErrorIs(t, err, ErrInvalidOutcome)
+		mockRepo.AssertNotCalled(t, "Resolve", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("AlreadyResolved", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, new(MockNotifier))
+
+		mockRepo.On("Resolve", adminCtx, int64(3), StatusWon, (*string)(nil), uint(9)).Return(ErrAlreadyResolved)
+
+		_, err := svc.Resolve(adminCtx, 3, StatusWon, nil)
+		assert.ErrorIs(t, err, ErrAlreadyResolved)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		svc := NewService(new(MockRepository), new(MockNotifier))
+		ctx := utils.SetUserContext(context.Background(), 2, "user@example.com", "USER")
+
+		_, err := svc.Resolve(ctx, 3, StatusWon, nil)
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+}
+
+func TestService_ListDisputes(t *testing.T) {
+	adminCtx := utils.SetUserContext(context.Background(), 9, "admin@example.com", "ADMIN")
+	mockRepo := new(MockRepository)
+	svc := NewService(mockRepo, new(MockNotifier))
+
+	open := StatusOpen
+	mockRepo.On("List", adminCtx, &open, int32(maxLimit)).Return([]*Dispute{{ID: 1}}, nil)
+
+	list, err := svc.ListDisputes(adminCtx, &open, 5000)
+	assert.NoError(t, err)
+	assert.Len(t, list, 1)
+}
@@ -0,0 +1,62 @@
+package errreport
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"net"
+
+	"github.com/lib/pq"
+)
+
+// unexpected is implemented by errors that should be reported even though
+// they are not infrastructure failures, such as a gateway rejecting a
+// well-formed request.
+type unexpected interface {
+	Unexpected() bool
+}
+
+type markedError struct {
+	error
+}
+
+func (markedError) Unexpected() bool { return true }
+
+func (e markedError) Unwrap() error { return e.error }
+
+// Mark flags err as unexpected, so IsUnexpected reports it.
+func Mark(err error) error {
+	if err == nil {
+		return nil
+	}
+	return markedError{err}
+}
+
+// IsUnexpected reports whether err points at a fault in the system rather
+// than at the request: database, driver and network failures, timeouts,
+// and errors flagged with Mark. Domain errors such as "order not found"
+// are expected and are not reported.
+func IsUnexpected(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	var u unexpected
+	if errors.As(err, &u) && u.Unexpected() {
+		return true
+	}
+
+	var pqErr *pq.Error
+	var netErr net.Error
+	switch {
+	case errors.As(err, &pqErr),
+		errors.As(err, &netErr),
+		errors.Is(err, driver.ErrBadConn),
+		errors.Is(err, sql.ErrConnDone),
+		errors.Is(err, sql.ErrTxDone),
+		errors.Is(err, context.DeadlineExceeded):
+		return true
+	}
+	return false
+}
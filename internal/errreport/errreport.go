@@ -0,0 +1,120 @@
+// Package errreport forwards unexpected errors and recovered panics to an
+// error aggregation service, tagged with a reference ID that is also shown
+// to the client and written to the logs.
+package errreport
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"runtime/debug"
+	"sync"
+
+	"warimas-be/internal/logger"
+	"warimas-be/internal/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Event is one reported error.
+type Event struct {
+	Err         error
+	ReferenceID string
+	RequestID   string
+	UserID      uint
+	Stack       []byte
+	Tags        map[string]string
+}
+
+// Reporter delivers events. Report must not block the request for long;
+// implementations send asynchronously or buffer.
+type Reporter interface {
+	Report(ctx context.Context, e *Event)
+}
+
+// NopReporter drops every event; the logs remain the only record.
+type NopReporter struct{}
+
+func (NopReporter) Report(context.Context, *Event) {}
+
+var (
+	mu         sync.RWMutex
+	reporter   Reporter = NopReporter{}
+	sampleRate          = 1.0
+)
+
+// SetReporter installs r as the process-wide reporter.
+func SetReporter(r Reporter) {
+	mu.Lock()
+	reporter = r
+	mu.Unlock()
+}
+
+// SetSampleRate sets the fraction of errors passed to Report that are
+// sent, between 0 and 1. Panics are always sent.
+func SetSampleRate(rate float64) {
+	mu.Lock()
+	sampleRate = min(max(rate, 0), 1)
+	mu.Unlock()
+}
+
+func current() Reporter {
+	mu.RLock()
+	defer mu.RUnlock()
+	return reporter
+}
+
+func sampled() bool {
+	mu.RLock()
+	rate := sampleRate
+	mu.RUnlock()
+	return rate >= 1 || rand.Float64() < rate
+}
+
+func newEvent(ctx context.Context, err error, tags map[string]string) *Event {
+	e := &Event{
+		Err:         err,
+		ReferenceID: uuid.NewString(),
+		RequestID:   logger.RequestIDFrom(ctx),
+		Tags:        tags,
+	}
+	if userID, ok := utils.GetUserIDFromContext(ctx); ok {
+		e.UserID = userID
+	}
+	return e
+}
+
+// Report sends err to the installed reporter, subject to sampling, and
+// returns its reference ID, or "" when the error was sampled out.
+func Report(ctx context.Context, err error, tags map[string]string) string {
+	if !sampled() {
+		return ""
+	}
+	e := newEvent(ctx, err, tags)
+	current().Report(ctx, e)
+	return e.ReferenceID
+}
+
+// Panic handles a value recovered from a panic: it logs it with its stack
+// trace, reports it, and returns the reference ID to show the client. Call
+// it from the deferred function that recovered.
+func Panic(ctx context.Context, recovered any) string {
+	err, ok := recovered.(error)
+	if !ok {
+		err = fmt.Errorf("%v", recovered)
+	}
+	err = fmt.Errorf("panic: %w", err)
+
+	e := newEvent(ctx, err, map[string]string{"kind": "panic"})
+	e.Stack = debug.Stack()
+
+	logger.FromCtx(ctx).Error("recovered from panic",
+		zap.Error(err),
+		zap.String("reference_id", e.ReferenceID),
+		zap.ByteString("stack", e.Stack),
+	)
+	current().Report(ctx, e)
+
+	return e.ReferenceID
+}
@@ -0,0 +1,111 @@
+package errreport
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"testing"
+
+	"warimas-be/internal/logger"
+	"warimas-be/internal/utils"
+
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type recordingReporter struct {
+	events []*Event
+}
+
+func (r *recordingReporter) Report(_ context.Context, e *Event) {
+	r.events = append(r.events, e)
+}
+
+func useRecorder(t *testing.T) *recordingReporter {
+	rec := &recordingReporter{}
+	SetReporter(rec)
+	t.Cleanup(func() { SetReporter(NopReporter{}) })
+	return rec
+}
+
+func TestPanic(t *testing.T) {
+	rec := useRecorder(t)
+	ctx := logger.WithRequestID(context.Background(), "req-1")
+
+	ref := Panic(ctx, "boom")
+
+	require.Len(t, rec.events, 1)
+	e := rec.events[0]
+	assert.Equal(t, ref, e.ReferenceID)
+	assert.Equal(t, "req-1", e.RequestID)
+	assert.EqualError(t, e.Err, "panic: boom")
+	assert.Contains(t, string(e.Stack), "TestPanic")
+	assert.Equal(t, "panic", e.Tags["kind"])
+}
+
+func TestPanic_WrapsError(t *testing.T) {
+	rec := useRecorder(t)
+	cause := errors.New("nil map")
+
+	Panic(context.Background(), cause)
+
+	require.Len(t, rec.events, 1)
+	assert.ErrorIs(t, rec.events[0].Err, cause)
+}
+
+func TestReport(t *testing.T) {
+	rec := useRecorder(t)
+
+	ref := Report(context.Background(), errors.New("db down"), map[string]string{"op": "x"})
+
+	require.Len(t, rec.events, 1)
+	assert.NotEmpty(t, ref)
+	assert.Equal(t, ref, rec.events[0].ReferenceID)
+	assert.Nil(t, rec.events[0].Stack)
+	assert.Equal(t, "x", rec.events[0].Tags["op"])
+}
+
+func TestReport_Sampling(t *testing.T) {
+	rec := useRecorder(t)
+	SetSampleRate(0)
+	t.Cleanup(func() { SetSampleRate(1) })
+
+	ref := Report(context.Background(), errors.New("db down"), nil)
+	Panic(context.Background(), "boom")
+
+	assert.Empty(t, ref)
+	require.Len(t, rec.events, 1, "panics bypass sampling")
+	assert.Equal(t, "panic", rec.events[0].Tags["kind"])
+}
+
+func TestReport_UserFromContext(t *testing.T) {
+	rec := useRecorder(t)
+	ctx := utils.SetUserContext(context.Background(), 42, "", "")
+
+	Report(ctx, errors.New("db down"), nil)
+
+	require.Len(t, rec.events, 1)
+	assert.Equal(t, uint(42), rec.events[0].UserID)
+}
+
+func TestIsUnexpected(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"domain error", errors.New("order not found"), false},
+		{"postgres error", fmt.Errorf("get order: %w", &pq.Error{Code: "42P01"}), true},
+		{"bad connection", fmt.Errorf("query: %w", driver.ErrBadConn), true},
+		{"timeout", fmt.Errorf("query: %w", context.DeadlineExceeded), true},
+		{"marked", fmt.Errorf("create invoice: %w", Mark(errors.New("gateway 502"))), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsUnexpected(tt.err))
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package errreport
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/getsentry/sentry-go"
+)
+
+// SentryReporter sends events to Sentry. The SDK queues and sends them in
+// the background.
+type SentryReporter struct {
+	client *sentry.Client
+}
+
+func NewSentryReporter(dsn, env string) (*SentryReporter, error) {
+	client, err := sentry.NewClient(sentry.ClientOptions{
+		Dsn:              dsn,
+		Environment:      env,
+		AttachStacktrace: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &SentryReporter{client: client}, nil
+}
+
+func (s *SentryReporter) Report(ctx context.Context, e *Event) {
+	scope := sentry.NewScope()
+	scope.SetTag("reference_id", e.ReferenceID)
+	if e.RequestID != "" {
+		scope.SetTag("request_id", e.RequestID)
+	}
+	if e.UserID != 0 {
+		scope.SetUser(sentry.User{ID: strconv.FormatUint(uint64(e.UserID), 10)})
+	}
+	scope.SetTags(e.Tags)
+	if len(e.Stack) > 0 {
+		scope.SetExtra("stack", string(e.Stack))
+	}
+
+	sentry.NewHub(s.client, scope).CaptureException(e.Err)
+}
+
+// Flush waits up to timeout for queued events to be sent; call it before
+// the process exits.
+func (s *SentryReporter) Flush(timeout time.Duration) bool {
+	return s.client.Flush(timeout)
+}
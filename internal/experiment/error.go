@@ -0,0 +1,19 @@
+package experiment
+
+import (
+	"errors"
+	"warimas-be/internal/apperr"
+)
+
+var (
+	ErrUnauthenticated    = apperr.Unauthenticated("unauthenticated")
+	ErrForbidden          = apperr.Forbidden("admin access required")
+	ErrInvalidKey         = apperr.Invalid("experiment key must be 2-64 lowercase letters, digits, dashes or underscores")
+	ErrInvalidVariants    = apperr.Invalid("an experiment needs 2-10 variants with unique names")
+	ErrInvalidVariantName = apperr.Invalid("variant name must be 1-64 characters")
+	ErrInvalidWeight      = apperr.Invalid("variant weight must be between 1 and 1000")
+	ErrExperimentExists   = apperr.Conflict("an experiment with this key already exists")
+	ErrExperimentNotFound = apperr.NotFound("experiment not found")
+	ErrDB                 = errors.New("database error")
+	PgUniqueViolation     = "23505"
+)
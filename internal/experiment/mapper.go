@@ -0,0 +1,66 @@
+package experiment
+
+import "warimas-be/internal/graph/model"
+
+func MapExperimentToGraphQL(e *Experiment) *model.Experiment {
+	variants := make([]*model.ExperimentVariant, 0, len(e.Variants))
+	for _, v := range e.Variants {
+		variants = append(variants, &model.ExperimentVariant{
+			Name:   v.Name,
+			Weight: v.Weight,
+		})
+	}
+	return &model.Experiment{
+		Key:         e.Key,
+		Description: e.Description,
+		Variants:    variants,
+		Active:      e.Active(),
+		StartedAt:   e.StartedAt,
+		EndedAt:     e.EndedAt,
+	}
+}
+
+func MapExperimentsToGraphQL(experiments []*Experiment) []*model.Experiment {
+	out := make([]*model.Experiment, 0, len(experiments))
+	for _, e := range experiments {
+		out = append(out, MapExperimentToGraphQL(e))
+	}
+	return out
+}
+
+func MapAssignmentsToGraphQL(assignments []Assignment) []*model.ExperimentAssignment {
+	out := make([]*model.ExperimentAssignment, 0, len(assignments))
+	for _, a := range assignments {
+		out = append(out, &model.ExperimentAssignment{
+			ExperimentKey: a.ExperimentKey,
+			Variant:       a.Variant,
+		})
+	}
+	return out
+}
+
+func MapResultsToGraphQL(results []*VariantResult) []*model.ExperimentVariantResult {
+	out := make([]*model.ExperimentVariantResult, 0, len(results))
+	for _, res := range results {
+		out = append(out, &model.ExperimentVariantResult{
+			Variant:    res.Variant,
+			Orders:     int32(res.Orders),
+			PaidOrders: int32(res.PaidOrders),
+			Revenue:    int32(res.Revenue),
+		})
+	}
+	return out
+}
+
+// MapInput converts the GraphQL input; the service validates it.
+func MapInput(in model.CreateExperimentInput) CreateInput {
+	variants := make([]Variant, 0, len(in.Variants))
+	for _, v := range in.Variants {
+		variants = append(variants, Variant{Name: v.Name, Weight: v.Weight})
+	}
+	return CreateInput{
+		Key:         in.Key,
+		Description: in.Description,
+		Variants:    variants,
+	}
+}
@@ -0,0 +1,82 @@
+package experiment
+
+import (
+	"hash/fnv"
+	"time"
+)
+
+const (
+	minVariants = 2
+	maxVariants = 10
+	maxWeight   = 1000
+	maxNameLen  = 64
+)
+
+// Variant is one arm of an experiment. Shoppers land in it in proportion
+// to its weight.
+type Variant struct {
+	Name   string `json:"name"`
+	Weight int32  `json:"weight"`
+}
+
+type Experiment struct {
+	Key         string
+	Description *string
+	Variants    []Variant
+	StartedAt   time.Time
+	// EndedAt is set once the experiment is ended; it then assigns no one.
+	EndedAt *time.Time
+}
+
+func (e *Experiment) Active() bool {
+	return e.EndedAt == nil
+}
+
+// Assign picks the variant of e for subject. A subject always lands in
+// the same variant, and each experiment splits shoppers independently of
+// the others.
+func (e *Experiment) Assign(subject string) string {
+	var total uint64
+	for _, v := range e.Variants {
+		total += uint64(v.Weight)
+	}
+	if total == 0 {
+		return ""
+	}
+
+	h := fnv.New64a()
+	h.Write([]byte(e.Key))
+	h.Write([]byte{0})
+	h.Write([]byte(subject))
+	n := h.Sum64() % total
+
+	for _, v := range e.Variants {
+		if n < uint64(v.Weight) {
+			return v.Name
+		}
+		n -= uint64(v.Weight)
+	}
+	return e.Variants[len(e.Variants)-1].Name
+}
+
+// Assignment is the variant a shopper sees in an experiment.
+type Assignment struct {
+	ExperimentKey string
+	Variant       string
+}
+
+// VariantResult counts the orders placed under a variant. Revenue sums the
+// totals of the orders that were paid, including those since shipped or
+// completed.
+type VariantResult struct {
+	Variant    string
+	Orders     int64
+	PaidOrders int64
+	Revenue    int64
+}
+
+type CreateInput struct {
+	Key         string
+	Description *string
+	Variants    []Variant
+}
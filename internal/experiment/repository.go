@@ -0,0 +1,240 @@
+package experiment
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+	"warimas-be/internal/logger"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+type Repository interface {
+	// ListActive returns the experiments that have not ended, oldest
+	// first.
+	ListActive(ctx context.Context) ([]*Experiment, error)
+	// List returns every experiment, newest first.
+	List(ctx context.Context) ([]*Experiment, error)
+	Get(ctx context.Context, key string) (*Experiment, error)
+	// Create fails with ErrExperimentExists when the key is taken, even by
+	// an ended experiment.
+	Create(ctx context.Context, in CreateInput, adminID int32) (*Experiment, error)
+	// End stops the experiment at now; ending it again keeps the first
+	// end time.
+	End(ctx context.Context, key string, now time.Time) (*Experiment, error)
+
+	// TagOrder records the variants the order was placed under.
+	TagOrder(ctx context.Context, orderExternalID string, assignments []Assignment) error
+	// Results counts the experiment's orders per variant. Variants without
+	// orders are left out.
+	Results(ctx context.Context, key string) ([]*VariantResult, error)
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+const experimentColumns = `key, description, variants, started_at, ended_at`
+
+// paidStatuses are the statuses of orders that were paid for.
+const paidStatuses = `('PAID', 'ACCEPTED', 'SHIPPED', 'READY_FOR_PICKUP', 'COMPLETED')`
+
+func scanExperiment(row interface{ Scan(...any) error }) (*Experiment, error) {
+	var (
+		e        Experiment
+		variants []byte
+	)
+	if err := row.Scan(&e.Key, &e.Description, &variants, &e.StartedAt, &e.EndedAt); err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(variants, &e.Variants); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && string(pqErr.Code) == PgUniqueViolation
+}
+
+func (r *repository) ListActive(ctx context.Context) ([]*Experiment, error) {
+	return r.list(ctx, "ListActive", `
+		SELECT `+experimentColumns+`
+		FROM experiments
+		WHERE ended_at IS NULL
+		ORDER BY started_at, key`)
+}
+
+func (r *repository) List(ctx context.Context) ([]*Experiment, error) {
+	return r.list(ctx, "List", `
+		SELECT `+experimentColumns+`
+		FROM experiments
+		ORDER BY started_at DESC, key`)
+}
+
+func (r *repository) list(ctx context.Context, method, query string) ([]*Experiment, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", method),
+	)
+
+	rows, err := r.db.QueryContext(ctx, query)
+	if err != nil {
+		log.Error("failed to list experiments", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	var out []*Experiment
+	for rows.Next() {
+		e, err := scanExperiment(rows)
+		if err != nil {
+			log.Error("failed to scan experiment", zap.Error(err))
+			return nil, ErrDB
+		}
+		out = append(out, e)
+	}
+	if err := rows.Err(); err != nil {
+		log.Error("failed to iterate experiments", zap.Error(err))
+		return nil, ErrDB
+	}
+	return out, nil
+}
+
+func (r *repository) Get(ctx context.Context, key string) (*Experiment, error) {
+	e, err := scanExperiment(r.db.QueryRowContext(ctx, `
+		SELECT `+experimentColumns+`
+		FROM experiments
+		WHERE key = $1`, key))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrExperimentNotFound
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to get experiment",
+			zap.String("key", key),
+			zap.Error(err),
+		)
+		return nil, ErrDB
+	}
+	return e, nil
+}
+
+func (r *repository) Create(ctx context.Context, in CreateInput, adminID int32) (*Experiment, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "Create"),
+		zap.String("key", in.Key),
+	)
+
+	variants, err := json.Marshal(in.Variants)
+	if err != nil {
+		log.Error("failed to encode variants", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	e, err := scanExperiment(r.db.QueryRowContext(ctx, `
+		INSERT INTO experiments (key, description, variants, created_by)
+		VALUES ($1, $2, $3, $4)
+		RETURNING `+experimentColumns,
+		in.Key, in.Description, variants, adminID))
+	if isUniqueViolation(err) {
+		return nil, ErrExperimentExists
+	}
+	if err != nil {
+		log.Error("failed to create experiment", zap.Error(err))
+		return nil, ErrDB
+	}
+	return e, nil
+}
+
+func (r *repository) End(ctx context.Context, key string, now time.Time) (*Experiment, error) {
+	e, err := scanExperiment(r.db.QueryRowContext(ctx, `
+		UPDATE experiments
+		SET ended_at = COALESCE(ended_at, $2)
+		WHERE key = $1
+		RETURNING `+experimentColumns, key, now))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrExperimentNotFound
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to end experiment",
+			zap.String("key", key),
+			zap.Error(err),
+		)
+		return nil, ErrDB
+	}
+	return e, nil
+}
+
+func (r *repository) TagOrder(ctx context.Context, orderExternalID string, assignments []Assignment) error {
+	keys := make([]string, len(assignments))
+	variants := make([]string, len(assignments))
+	for i, a := range assignments {
+		keys[i] = a.ExperimentKey
+		variants[i] = a.Variant
+	}
+
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO order_experiments (order_id, experiment_key, variant)
+		SELECT o.id, a.key, a.variant
+		FROM orders o
+		CROSS JOIN unnest($2::text[], $3::text[]) AS a(key, variant)
+		WHERE o.external_id = $1
+		ON CONFLICT (order_id, experiment_key) DO NOTHING`,
+		orderExternalID, pq.Array(keys), pq.Array(variants))
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to tag order with experiments",
+			zap.String("order_external_id", orderExternalID),
+			zap.Error(err),
+		)
+		return ErrDB
+	}
+	return nil
+}
+
+func (r *repository) Results(ctx context.Context, key string) ([]*VariantResult, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "Results"),
+		zap.String("key", key),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT oe.variant,
+		       COUNT(*),
+		       COUNT(*) FILTER (WHERE o.status IN `+paidStatuses+`),
+		       COALESCE(SUM(o.total_amount) FILTER (WHERE o.status IN `+paidStatuses+`), 0)
+		FROM order_experiments oe
+		JOIN orders o ON o.id = oe.order_id
+		WHERE oe.experiment_key = $1
+		  AND o.deleted_at IS NULL
+		GROUP BY oe.variant`, key)
+	if err != nil {
+		log.Error("failed to count experiment orders", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	var out []*VariantResult
+	for rows.Next() {
+		var res VariantResult
+		if err := rows.Scan(&res.Variant, &res.Orders, &res.PaidOrders, &res.Revenue); err != nil {
+			log.Error("failed to scan experiment result", zap.Error(err))
+			return nil, ErrDB
+		}
+		out = append(out, &res)
+	}
+	if err := rows.Err(); err != nil {
+		log.Error("failed to iterate experiment results", zap.Error(err))
+		return nil, ErrDB
+	}
+	return out, nil
+}
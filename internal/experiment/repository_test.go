@@ -0,0 +1,105 @@
+package experiment
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var experimentRowColumns = []string{"key", "description", "variants", "started_at", "ended_at"}
+
+func TestRepository_ListActive(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := NewRepository(db)
+
+	mock.ExpectQuery(`FROM experiments\s+WHERE ended_at IS NULL`).
+		WillReturnRows(sqlmock.NewRows(experimentRowColumns).
+			AddRow("checkout-button", nil, []byte(`[{"name":"control","weight":50},{"name":"green","weight":50}]`), time.Now(), nil))
+
+	got, err := repo.ListActive(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, got, 1)
+	assert.Equal(t, []Variant{{Name: "control", Weight: 50}, {Name: "green", Weight: 50}}, got[0].Variants)
+	assert.True(t, got[0].Active())
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_Create(t *testing.T) {
+	in := CreateInput{
+		Key:      "checkout-button",
+		Variants: []Variant{{Name: "control", Weight: 50}, {Name: "green", Weight: 50}},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectQuery(`INSERT INTO experiments`).
+			WithArgs("checkout-button", nil, []byte(`[{"name":"control","weight":50},{"name":"green","weight":50}]`), int32(1)).
+			WillReturnRows(sqlmock.NewRows(experimentRowColumns).
+				AddRow("checkout-button", nil, []byte(`[{"name":"control","weight":50},{"name":"green","weight":50}]`), time.Now(), nil))
+
+		e, err := repo.Create(context.Background(), in, 1)
+
+		require.NoError(t, err)
+		assert.Equal(t, "checkout-button", e.Key)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("KeyTaken", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectQuery(`INSERT INTO experiments`).
+			WillReturnError(&pq.Error{Code: pq.ErrorCode(PgUniqueViolation)})
+
+		_, err = repo.Create(context.Background(), in, 1)
+
+		assert.ErrorIs(t, err, ErrExperimentExists)
+	})
+}
+
+func TestRepository_TagOrder(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := NewRepository(db)
+
+	mock.ExpectExec(`INSERT INTO order_experiments .* WHERE o.external_id = \$1\s+ON CONFLICT`).
+		WithArgs("ord-1", pq.Array([]string{"checkout-button", "banner"}), pq.Array([]string{"green", "a"})).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	err = repo.TagOrder(context.Background(), "ord-1", []Assignment{
+		{ExperimentKey: "checkout-button", Variant: "green"},
+		{ExperimentKey: "banner", Variant: "a"},
+	})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_End_NotFound(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := NewRepository(db)
+
+	mock.ExpectQuery(`UPDATE experiments`).
+		WillReturnRows(sqlmock.NewRows(experimentRowColumns))
+
+	_, err = repo.End(context.Background(), "missing", time.Now())
+
+	assert.ErrorIs(t, err, ErrExperimentNotFound)
+}
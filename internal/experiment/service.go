@@ -0,0 +1,204 @@
+// Package experiment splits shoppers between the variants of A/B
+// experiments and records which variants each order was placed under.
+package experiment
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+var keyPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{1,63}$`)
+
+type Service interface {
+	// MyAssignments returns the caller's variant in every running
+	// experiment. Requests with neither a user nor a guest ID get none.
+	MyAssignments(ctx context.Context) ([]Assignment, error)
+	// TagOrder records the caller's variants on an order they just
+	// placed.
+	TagOrder(ctx context.Context, orderExternalID string) error
+
+	// List, Create, End and Results are admin only.
+	List(ctx context.Context) ([]*Experiment, error)
+	Create(ctx context.Context, in CreateInput) (*Experiment, error)
+	End(ctx context.Context, key string) (*Experiment, error)
+	// Results counts the experiment's orders per variant, listing every
+	// variant in the experiment's order.
+	Results(ctx context.Context, key string) ([]*VariantResult, error)
+}
+
+type service struct {
+	repo Repository
+	now  func() time.Time
+}
+
+func NewService(repo Repository) Service {
+	return &service{repo: repo, now: time.Now}
+}
+
+// subject identifies the shopper an assignment is for. A guest who signs
+// up is assigned afresh as a user.
+func subject(ctx context.Context) (string, bool) {
+	if userID, ok := utils.GetUserIDFromContext(ctx); ok {
+		return "user:" + strconv.FormatUint(uint64(userID), 10), true
+	}
+	if guestID, ok := utils.GetGuestIDFromContext(ctx); ok {
+		return "guest:" + guestID, true
+	}
+	return "", false
+}
+
+func (s *service) MyAssignments(ctx context.Context) ([]Assignment, error) {
+	sub, ok := subject(ctx)
+	if !ok {
+		return []Assignment{}, nil
+	}
+	return s.assign(ctx, sub)
+}
+
+func (s *service) assign(ctx context.Context, sub string) ([]Assignment, error) {
+	experiments, err := s.repo.ListActive(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]Assignment, 0, len(experiments))
+	for _, e := range experiments {
+		if variant := e.Assign(sub); variant != "" {
+			out = append(out, Assignment{ExperimentKey: e.Key, Variant: variant})
+		}
+	}
+	return out, nil
+}
+
+func (s *service) TagOrder(ctx context.Context, orderExternalID string) error {
+	sub, ok := subject(ctx)
+	if !ok {
+		return nil
+	}
+	assignments, err := s.assign(ctx, sub)
+	if err != nil || len(assignments) == 0 {
+		return err
+	}
+	return s.repo.TagOrder(ctx, orderExternalID, assignments)
+}
+
+func (s *service) List(ctx context.Context) ([]*Experiment, error) {
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return s.repo.List(ctx)
+}
+
+func (s *service) Create(ctx context.Context, in CreateInput) (*Experiment, error) {
+	adminID, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	in.Key = strings.TrimSpace(in.Key)
+	if !keyPattern.MatchString(in.Key) {
+		return nil, ErrInvalidKey
+	}
+	if len(in.Variants) < minVariants || len(in.Variants) > maxVariants {
+		return nil, ErrInvalidVariants
+	}
+	seen := make(map[string]bool, len(in.Variants))
+	for i, v := range in.Variants {
+		v.Name = strings.TrimSpace(v.Name)
+		if v.Name == "" || utf8.RuneCountInString(v.Name) > maxNameLen {
+			return nil, ErrInvalidVariantName
+		}
+		if seen[v.Name] {
+			return nil, ErrInvalidVariants
+		}
+		seen[v.Name] = true
+		if v.Weight < 1 || v.Weight > maxWeight {
+			return nil, ErrInvalidWeight
+		}
+		in.Variants[i] = v
+	}
+	if in.Description != nil {
+		d := strings.TrimSpace(*in.Description)
+		in.Description = &d
+		if d == "" {
+			in.Description = nil
+		}
+	}
+
+	e, err := s.repo.Create(ctx, in, adminID)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.FromCtx(ctx).Info("experiment created",
+		zap.String("key", e.Key),
+		zap.Int32("admin_id", adminID),
+	)
+	return e, nil
+}
+
+func (s *service) End(ctx context.Context, key string) (*Experiment, error) {
+	adminID, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	e, err := s.repo.End(ctx, key, s.now())
+	if err != nil {
+		return nil, err
+	}
+
+	logger.FromCtx(ctx).Info("experiment ended",
+		zap.String("key", e.Key),
+		zap.Int32("admin_id", adminID),
+	)
+	return e, nil
+}
+
+func (s *service) Results(ctx context.Context, key string) ([]*VariantResult, error) {
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	e, err := s.repo.Get(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	counted, err := s.repo.Results(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	byVariant := make(map[string]*VariantResult, len(counted))
+	for _, res := range counted {
+		byVariant[res.Variant] = res
+	}
+	out := make([]*VariantResult, 0, len(e.Variants))
+	for _, v := range e.Variants {
+		res, ok := byVariant[v.Name]
+		if !ok {
+			res = &VariantResult{Variant: v.Name}
+		}
+		out = append(out, res)
+	}
+	return out, nil
+}
+
+func requireAdmin(ctx context.Context) (int32, error) {
+	userID, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		return 0, ErrUnauthenticated
+	}
+	if !utils.IsAdmin(ctx) {
+		return 0, ErrForbidden
+	}
+	return int32(userID), nil
+}
@@ -0,0 +1,266 @@
+package experiment
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+	"warimas-be/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// --- Mocks ---
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) ListActive(ctx context.Context) ([]*Experiment, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Experiment), args.Error(1)
+}
+
+func (m *MockRepository) List(ctx context.Context) ([]*Experiment, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Experiment), args.Error(1)
+}
+
+func (m *MockRepository) Get(ctx context.Context, key string) (*Experiment, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Experiment), args.Error(1)
+}
+
+func (m *MockRepository) Create(ctx context.Context, in CreateInput, adminID int32) (*Experiment, error) {
+	args := m.Called(ctx, in, adminID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Experiment), args.Error(1)
+}
+
+func (m *MockRepository) End(ctx context.Context, key string, now time.Time) (*Experiment, error) {
+	args := m.Called(ctx, key, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Experiment), args.Error(1)
+}
+
+func (m *MockRepository) TagOrder(ctx context.Context, orderExternalID string, assignments []Assignment) error {
+	args := m.Called(ctx, orderExternalID, assignments)
+	return args.Error(0)
+}
+
+func (m *MockRepository) Results(ctx context.Context, key string) ([]*VariantResult, error) {
+	args := m.Called(ctx, key)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*VariantResult), args.Error(1)
+}
+
+func adminCtx() context.Context {
+	return utils.SetUserContext(context.Background(), 1, "admin@example.com", utils.RoleAdmin)
+}
+
+func checkoutExperiment() *Experiment {
+	return &Experiment{
+		Key: "checkout-button",
+		Variants: []Variant{
+			{Name: "control", Weight: 50},
+			{Name: "green", Weight: 50},
+		},
+	}
+}
+
+// --- Tests ---
+
+func TestExperiment_Assign(t *testing.T) {
+	t.Run("Deterministic", func(t *testing.T) {
+		e := checkoutExperiment()
+		for i := 0; i < 100; i++ {
+			sub := fmt.Sprintf("user:%d", i)
+			assert.Equal(t, e.Assign(sub), e.Assign(sub))
+		}
+	})
+
+	t.Run("FollowsWeights", func(t *testing.T) {
+		e := &Experiment{
+			Key:      "banner",
+			Variants: []Variant{{Name: "a", Weight: 9}, {Name: "b", Weight: 1}},
+		}
+		counts := map[string]int{}
+		for i := 0; i < 10000; i++ {
+			counts[e.Assign(fmt.Sprintf("guest:%d", i))]++
+		}
+		assert.InDelta(t, 9000, counts["a"], 300)
+		assert.InDelta(t, 1000, counts["b"], 300)
+	})
+
+	t.Run("IndependentPerExperiment", func(t *testing.T) {
+		a := checkoutExperiment()
+		b := checkoutExperiment()
+		b.Key = "search-ranking"
+
+		same := 0
+		for i := 0; i < 1000; i++ {
+			sub := fmt.Sprintf("user:%d", i)
+			if a.Assign(sub) == b.Assign(sub) {
+				same++
+			}
+		}
+		assert.InDelta(t, 500, same, 100)
+	})
+}
+
+func TestService_MyAssignments(t *testing.T) {
+	t.Run("User", func(t *testing.T) {
+		repo := new(MockRepository)
+		svc := NewService(repo)
+		ctx := utils.SetUserContext(context.Background(), 7, "a@example.com", "USER")
+		e := checkoutExperiment()
+		repo.On("ListActive", ctx).Return([]*Experiment{e}, nil)
+
+		got, err := svc.MyAssignments(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []Assignment{{ExperimentKey: e.Key, Variant: e.Assign("user:7")}}, got)
+	})
+
+	t.Run("Guest", func(t *testing.T) {
+		repo := new(MockRepository)
+		svc := NewService(repo)
+		ctx := utils.WithPrincipal(context.Background(), &utils.Principal{GuestID: "g-1"})
+		e := checkoutExperiment()
+		repo.On("ListActive", ctx).Return([]*Experiment{e}, nil)
+
+		got, err := svc.MyAssignments(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, e.Assign("guest:g-1"), got[0].Variant)
+	})
+
+	t.Run("Anonymous", func(t *testing.T) {
+		repo := new(MockRepository)
+		svc := NewService(repo)
+
+		got, err := svc.MyAssignments(context.Background())
+
+		assert.NoError(t, err)
+		assert.Empty(t, got)
+		repo.AssertNotCalled(t, "ListActive", mock.Anything)
+	})
+}
+
+func TestService_TagOrder(t *testing.T) {
+	t.Run("RecordsAssignments", func(t *testing.T) {
+		repo := new(MockRepository)
+		svc := NewService(repo)
+		ctx := utils.SetUserContext(context.Background(), 7, "a@example.com", "USER")
+		e := checkoutExperiment()
+		repo.On("ListActive", ctx).Return([]*Experiment{e}, nil)
+		repo.On("TagOrder", ctx, "ord-1", []Assignment{{ExperimentKey: e.Key, Variant: e.Assign("user:7")}}).Return(nil)
+
+		assert.NoError(t, svc.TagOrder(ctx, "ord-1"))
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("NoRunningExperiments", func(t *testing.T) {
+		repo := new(MockRepository)
+		svc := NewService(repo)
+		ctx := utils.SetUserContext(context.Background(), 7, "a@example.com", "USER")
+		repo.On("ListActive", ctx).Return([]*Experiment{}, nil)
+
+		assert.NoError(t, svc.TagOrder(ctx, "ord-1"))
+		repo.AssertNotCalled(t, "TagOrder", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestService_Create(t *testing.T) {
+	valid := func() CreateInput {
+		return CreateInput{
+			Key:      "checkout-button",
+			Variants: []Variant{{Name: "control", Weight: 50}, {Name: "green", Weight: 50}},
+		}
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		repo := new(MockRepository)
+		svc := NewService(repo)
+		ctx := adminCtx()
+		in := valid()
+		in.Variants[1].Name = " green "
+		want := valid()
+		repo.On("Create", ctx, want, int32(1)).Return(&Experiment{Key: want.Key, Variants: want.Variants}, nil)
+
+		e, err := svc.Create(ctx, in)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "checkout-button", e.Key)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		cases := map[string]struct {
+			mutate func(*CreateInput)
+			err    error
+		}{
+			"key":            {func(in *CreateInput) { in.Key = "Checkout Button" }, ErrInvalidKey},
+			"one variant":    {func(in *CreateInput) { in.Variants = in.Variants[:1] }, ErrInvalidVariants},
+			"duplicate name": {func(in *CreateInput) { in.Variants[1].Name = "control" }, ErrInvalidVariants},
+			"empty name":     {func(in *CreateInput) { in.Variants[0].Name = " " }, ErrInvalidVariantName},
+			"zero weight":    {func(in *CreateInput) { in.Variants[0].Weight = 0 }, ErrInvalidWeight},
+		}
+		for name, tc := range cases {
+			t.Run(name, func(t *testing.T) {
+				repo := new(MockRepository)
+				svc := NewService(repo)
+				in := valid()
+				tc.mutate(&in)
+
+				_, err := svc.Create(adminCtx(), in)
+
+				assert.ErrorIs(t, err, tc.err)
+				repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+			})
+		}
+	})
+
+	t.Run("NotAdmin", func(t *testing.T) {
+		svc := NewService(new(MockRepository))
+		ctx := utils.SetUserContext(context.Background(), 7, "a@example.com", "USER")
+
+		_, err := svc.Create(ctx, valid())
+
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+}
+
+func TestService_Results(t *testing.T) {
+	repo := new(MockRepository)
+	svc := NewService(repo)
+	ctx := adminCtx()
+	repo.On("Get", ctx, "checkout-button").Return(checkoutExperiment(), nil)
+	repo.On("Results", ctx, "checkout-button").Return([]*VariantResult{
+		{Variant: "green", Orders: 4, PaidOrders: 3, Revenue: 300000},
+	}, nil)
+
+	got, err := svc.Results(ctx, "checkout-button")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []*VariantResult{
+		{Variant: "control"},
+		{Variant: "green", Orders: 4, PaidOrders: 3, Revenue: 300000},
+	}, got)
+}
@@ -0,0 +1,62 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+)
+
+// catalogHeader names the catalog columns. IDs come first so a re-import
+// can match rows to the products and variants they came from.
+var catalogHeader = []string{
+	"product_id", "product_name", "product_status", "category_id", "subcategory_id", "product_description",
+	"variant_id", "variant_name", "quantity_type", "price", "compare_at_price", "stock",
+	"weight_grams", "length_cm", "width_cm", "height_cm", "variant_active",
+}
+
+// WriteCatalogCSV writes rows under catalogHeader. Prices are plain
+// decimals without separators; the variant columns of a product without
+// variants are left empty.
+func WriteCatalogCSV(w io.Writer, rows []*CatalogRow) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(catalogHeader); err != nil {
+		return err
+	}
+
+	for _, r := range rows {
+		record := []string{
+			r.ProductID, r.ProductName, r.ProductStatus, r.CategoryID, r.SubcategoryID, r.ProductDescription,
+		}
+		if r.VariantID == "" {
+			record = append(record, make([]string, len(catalogHeader)-len(record))...)
+		} else {
+			compareAt := ""
+			if r.CompareAtPrice != nil {
+				compareAt = formatPrice(*r.CompareAtPrice)
+			}
+			record = append(record,
+				r.VariantID,
+				r.VariantName,
+				r.QuantityType,
+				formatPrice(r.Price),
+				compareAt,
+				strconv.Itoa(int(r.Stock)),
+				strconv.Itoa(int(r.WeightGrams)),
+				strconv.Itoa(int(r.LengthCm)),
+				strconv.Itoa(int(r.WidthCm)),
+				strconv.Itoa(int(r.HeightCm)),
+				strconv.FormatBool(r.VariantActive),
+			)
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+func formatPrice(p float64) string {
+	return strconv.FormatFloat(p, 'f', -1, 64)
+}
@@ -0,0 +1,11 @@
+package export
+
+import (
+	"errors"
+	"warimas-be/internal/apperr"
+)
+
+var (
+	ErrNotSeller = apperr.Forbidden("unauthorized")
+	ErrDB        = errors.New("database error")
+)
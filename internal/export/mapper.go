@@ -0,0 +1,18 @@
+package export
+
+import (
+	"strconv"
+	"warimas-be/internal/graph/model"
+)
+
+func MapExportToGraphQL(e *Export) *model.CatalogExport {
+	return &model.CatalogExport{
+		ID:         strconv.FormatInt(e.ID, 10),
+		Status:     model.ExportStatus(e.Status),
+		RowCount:   e.RowCount,
+		URL:        e.URL,
+		Error:      e.Error,
+		CreatedAt:  e.CreatedAt,
+		FinishedAt: e.FinishedAt,
+	}
+}
@@ -0,0 +1,72 @@
+package export
+
+import "time"
+
+// RunInterval is how often the job builds requested exports.
+const RunInterval = 30 * time.Second
+
+const (
+	// maxPerRun caps the exports one job run builds; the rest wait for
+	// the next run.
+	maxPerRun = 5
+	// staleAfter is how long an export may stay RUNNING before it is
+	// taken to be abandoned and claimed again.
+	staleAfter = 10 * time.Minute
+	// maxAttempts is how many times an export is claimed before it fails.
+	maxAttempts = 3
+	listLimit   = int32(20)
+)
+
+type Kind string
+
+// KindCatalog is a seller's products and variants, one row per variant.
+const KindCatalog Kind = "CATALOG"
+
+type Status string
+
+const (
+	StatusPending Status = "PENDING"
+	StatusRunning Status = "RUNNING"
+	StatusDone    Status = "DONE"
+	StatusFailed  Status = "FAILED"
+)
+
+// Export is a file built in the background. StorageKey and RowCount are
+// set once it is DONE; URL is filled in by the service from StorageKey.
+type Export struct {
+	ID          int64
+	Kind        Kind
+	Status      Status
+	SellerID    string
+	RequestedBy int32
+	Attempts    int32
+	StorageKey  *string
+	URL         *string
+	RowCount    *int32
+	Error       *string
+	CreatedAt   time.Time
+	FinishedAt  *time.Time
+}
+
+// CatalogRow is one variant of a seller's product. A product without
+// variants has one row with an empty VariantID and no variant values.
+type CatalogRow struct {
+	ProductID          string
+	ProductName        string
+	ProductStatus      string
+	CategoryID         string
+	SubcategoryID      string
+	ProductDescription string
+
+	VariantID      string
+	VariantName    string
+	QuantityType   string
+	Price          float64
+	CompareAtPrice *float64
+	Stock          int32
+	WeightGrams    int32
+	LengthCm       int32
+	WidthCm        int32
+	HeightCm       int32
+	VariantActive  bool
+}
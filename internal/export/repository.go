@@ -0,0 +1,237 @@
+package export
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+	"warimas-be/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+type Repository interface {
+	// Create queues an export, or returns the seller's unfinished export
+	// of the same kind when there is one.
+	Create(ctx context.Context, kind Kind, sellerID string, userID int32) (*Export, error)
+	ListBySeller(ctx context.Context, sellerID string, kind Kind, limit int32) ([]*Export, error)
+
+	// Claim marks the oldest pending export, or one RUNNING since before
+	// staleBefore, as RUNNING and returns it; nil when there is none.
+	Claim(ctx context.Context, staleBefore time.Time) (*Export, error)
+	Finish(ctx context.Context, id int64, storageKey string, rowCount int32) error
+	Fail(ctx context.Context, id int64, message string) error
+
+	// CatalogRows returns the seller's products with their variants,
+	// archived ones included, ordered by product and variant name.
+	CatalogRows(ctx context.Context, sellerID string) ([]*CatalogRow, error)
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+const exportColumns = `
+	id, kind, status, seller_id, requested_by, attempts, storage_key,
+	row_count, error, created_at, finished_at
+`
+
+func scanExport(row interface{ Scan(...any) error }) (*Export, error) {
+	var e Export
+	err := row.Scan(
+		&e.ID, &e.Kind, &e.Status, &e.SellerID, &e.RequestedBy, &e.Attempts, &e.StorageKey,
+		&e.RowCount, &e.Error, &e.CreatedAt, &e.FinishedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+func (r *repository) Create(ctx context.Context, kind Kind, sellerID string, userID int32) (*Export, error) {
+	// The insert's row is not visible to the second SELECT of the same
+	// statement, so exactly one of the two returns a row.
+	e, err := scanExport(r.db.QueryRowContext(ctx, `
+		WITH inserted AS (
+			INSERT INTO exports (kind, seller_id, requested_by)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (seller_id, kind) WHERE status IN ('PENDING', 'RUNNING') DO NOTHING
+			RETURNING `+exportColumns+`
+		)
+		SELECT `+exportColumns+` FROM inserted
+		UNION ALL
+		SELECT `+exportColumns+`
+		FROM exports
+		WHERE kind = $1
+		  AND seller_id = $2
+		  AND status IN ('PENDING', 'RUNNING')
+		LIMIT 1
+	`, kind, sellerID, userID))
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to create export",
+			zap.String("kind", string(kind)),
+			zap.String("seller_id", sellerID),
+			zap.Error(err),
+		)
+		return nil, ErrDB
+	}
+	return e, nil
+}
+
+func (r *repository) ListBySeller(ctx context.Context, sellerID string, kind Kind, limit int32) ([]*Export, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ListBySeller"),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+exportColumns+`
+		FROM exports
+		WHERE seller_id = $1
+		  AND kind = $2
+		ORDER BY created_at DESC, id DESC
+		LIMIT $3
+	`, sellerID, kind, limit)
+	if err != nil {
+		log.Error("failed to query exports", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	list := []*Export{}
+	for rows.Next() {
+		e, err := scanExport(rows)
+		if err != nil {
+			log.Error("failed to scan export", zap.Error(err))
+			return nil, ErrDB
+		}
+		list = append(list, e)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error("export iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return list, nil
+}
+
+func (r *repository) Claim(ctx context.Context, staleBefore time.Time) (*Export, error) {
+	e, err := scanExport(r.db.QueryRowContext(ctx, `
+		UPDATE exports
+		SET status = 'RUNNING',
+		    started_at = NOW(),
+		    attempts = attempts + 1
+		WHERE id = (
+			SELECT id
+			FROM exports
+			WHERE status = 'PENDING'
+			   OR (status = 'RUNNING' AND started_at < $1)
+			ORDER BY created_at, id
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING `+exportColumns, staleBefore))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to claim export", zap.Error(err))
+		return nil, ErrDB
+	}
+	return e, nil
+}
+
+func (r *repository) Finish(ctx context.Context, id int64, storageKey string, rowCount int32) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE exports
+		SET status = 'DONE',
+		    storage_key = $2,
+		    row_count = $3,
+		    finished_at = NOW()
+		WHERE id = $1
+	`, id, storageKey, rowCount)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to finish export", zap.Int64("export_id", id), zap.Error(err))
+		return ErrDB
+	}
+	return nil
+}
+
+func (r *repository) Fail(ctx context.Context, id int64, message string) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE exports
+		SET status = 'FAILED',
+		    error = $2,
+		    finished_at = NOW()
+		WHERE id = $1
+	`, id, message)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to mark export failed", zap.Int64("export_id", id), zap.Error(err))
+		return ErrDB
+	}
+	return nil
+}
+
+func (r *repository) CatalogRows(ctx context.Context, sellerID string) ([]*CatalogRow, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "CatalogRows"),
+		zap.String("seller_id", sellerID),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT
+			p.id,
+			p.name,
+			p.status,
+			COALESCE(p.category_id::text, ''),
+			COALESCE(p.subcategory_id::text, ''),
+			COALESCE(p.description, ''),
+			COALESCE(v.id::text, ''),
+			COALESCE(v.name, ''),
+			COALESCE(v.quantity_type, ''),
+			COALESCE(v.price, 0),
+			v.compare_at_price,
+			COALESCE(v.stock, 0),
+			COALESCE(v.weight_grams, 0),
+			COALESCE(v.length_cm, 0),
+			COALESCE(v.width_cm, 0),
+			COALESCE(v.height_cm, 0),
+			COALESCE(v.is_active, FALSE)
+		FROM products p
+		LEFT JOIN variants v ON v.product_id = p.id
+		WHERE p.seller_id = $1 AND p.deleted_at IS NULL
+		ORDER BY p.name, p.id, v.name, v.id
+	`, sellerID)
+	if err != nil {
+		log.Error("failed to query catalog", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	list := []*CatalogRow{}
+	for rows.Next() {
+		var c CatalogRow
+		if err := rows.Scan(
+			&c.ProductID, &c.ProductName, &c.ProductStatus, &c.CategoryID, &c.SubcategoryID, &c.ProductDescription,
+			&c.VariantID, &c.VariantName, &c.QuantityType, &c.Price, &c.CompareAtPrice, &c.Stock,
+			&c.WeightGrams, &c.LengthCm, &c.WidthCm, &c.HeightCm, &c.VariantActive,
+		); err != nil {
+			log.Error("failed to scan catalog row", zap.Error(err))
+			return nil, ErrDB
+		}
+		list = append(list, &c)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error("catalog iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return list, nil
+}
@@ -0,0 +1,91 @@
+package export
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var exportCols = []string{
+	"id", "kind", "status", "seller_id", "requested_by", "attempts", "storage_key",
+	"row_count", "error", "created_at", "finished_at",
+}
+
+func TestRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	mock.ExpectQuery(`WITH inserted AS \( INSERT INTO exports .* ON CONFLICT \(seller_id, kind\) WHERE status IN \('PENDING', 'RUNNING'\) DO NOTHING .* UNION ALL SELECT .* FROM exports WHERE kind = \$1 AND seller_id = \$2 AND status IN \('PENDING', 'RUNNING'\) LIMIT 1`).
+		WithArgs(KindCatalog, sellerID, int32(7)).
+		WillReturnRows(sqlmock.NewRows(exportCols).
+			AddRow(1, "CATALOG", "RUNNING", sellerID, 7, 1, nil, nil, nil, now, nil))
+
+	e, err := repo.Create(context.Background(), KindCatalog, sellerID, 7)
+	require.NoError(t, err)
+	assert.Equal(t, StatusRunning, e.Status)
+	assert.Nil(t, e.StorageKey)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_Claim(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+
+	t.Run("Claimed", func(t *testing.T) {
+		mock.ExpectQuery(`UPDATE exports SET status = 'RUNNING', started_at = NOW\(\), attempts = attempts \+ 1 WHERE id = \( SELECT id FROM exports WHERE status = 'PENDING' OR \(status = 'RUNNING' AND started_at < \$1\) .* FOR UPDATE SKIP LOCKED \)`).
+			WithArgs(now).
+			WillReturnRows(sqlmock.NewRows(exportCols).
+				AddRow(1, "CATALOG", "RUNNING", sellerID, 7, 1, nil, nil, nil, now, nil))
+
+		e, err := repo.Claim(ctx, now)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), e.ID)
+	})
+
+	t.Run("NoneQueued", func(t *testing.T) {
+		mock.ExpectQuery(`UPDATE exports SET status = 'RUNNING'`).
+			WithArgs(now).
+			WillReturnRows(sqlmock.NewRows(exportCols))
+
+		e, err := repo.Claim(ctx, now)
+		assert.NoError(t, err)
+		assert.Nil(t, e)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_CatalogRows(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	mock.ExpectQuery(`FROM products p LEFT JOIN variants v ON v.product_id = p.id WHERE p.seller_id = \$1`).
+		WithArgs(sellerID).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "name", "status", "category_id", "subcategory_id", "description",
+			"variant_id", "variant_name", "quantity_type", "price", "compare_at_price", "stock",
+			"weight_grams", "length_cm", "width_cm", "height_cm", "is_active",
+		}).
+			AddRow("p-1", "Beras", "active", "c-1", "s-1", "", "v-1", "5kg", "kg", 65000.0, nil, 12, 5000, 0, 0, 0, true).
+			AddRow("p-2", "Gula", "active", "c-1", "s-1", "", "", "", "", 0.0, nil, 0, 0, 0, 0, 0, false))
+
+	rows, err := repo.CatalogRows(context.Background(), sellerID)
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Nil(t, rows[0].CompareAtPrice)
+	assert.Equal(t, "", rows[1].VariantID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
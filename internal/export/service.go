@@ -0,0 +1,155 @@
+// Package export builds files sellers download in the background, such as
+// their catalog as CSV.
+package export
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"time"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/uploads"
+	"warimas-be/internal/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// failedMessage is what the seller sees on a failed export; the cause is
+// only logged.
+const failedMessage = "export failed, please request it again"
+
+type Service interface {
+	// RequestCatalog queues an export of the caller's catalog. While one
+	// is still queued or running, that one is returned instead.
+	RequestCatalog(ctx context.Context) (*Export, error)
+	// GetMyCatalogExports lists the caller's latest catalog exports,
+	// newest first.
+	GetMyCatalogExports(ctx context.Context) ([]*Export, error)
+
+	// Run builds queued exports and returns how many it finished, failed
+	// ones included.
+	Run(ctx context.Context) (int, error)
+}
+
+type service struct {
+	repo    Repository
+	storage uploads.Storage
+	now     func() time.Time
+}
+
+func NewService(repo Repository, storage uploads.Storage) Service {
+	return &service{repo: repo, storage: storage, now: time.Now}
+}
+
+func (s *service) RequestCatalog(ctx context.Context) (*Export, error) {
+	sellerID, ok := utils.GetSellerIDFromContext(ctx)
+	if !ok {
+		return nil, ErrNotSeller
+	}
+	userID, _ := utils.GetUserIDFromContext(ctx)
+
+	e, err := s.repo.Create(ctx, KindCatalog, sellerID, int32(userID))
+	if err != nil {
+		return nil, err
+	}
+	return s.withURL(e), nil
+}
+
+func (s *service) GetMyCatalogExports(ctx context.Context) ([]*Export, error) {
+	sellerID, ok := utils.GetSellerIDFromContext(ctx)
+	if !ok {
+		return nil, ErrNotSeller
+	}
+
+	list, err := s.repo.ListBySeller(ctx, sellerID, KindCatalog, listLimit)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range list {
+		s.withURL(e)
+	}
+	return list, nil
+}
+
+func (s *service) Run(ctx context.Context) (int, error) {
+	var n int
+	for n < maxPerRun {
+		e, err := s.repo.Claim(ctx, s.now().Add(-staleAfter))
+		if err != nil {
+			return n, err
+		}
+		if e == nil {
+			break
+		}
+		if err := s.build(ctx, e); err != nil {
+			return n, err
+		}
+		n++
+	}
+	return n, nil
+}
+
+// build writes the export's file and records the outcome. Only a failure
+// to record it is returned; the export is then claimed again once stale.
+func (s *service) build(ctx context.Context, e *Export) error {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "build"),
+		zap.Int64("export_id", e.ID),
+		zap.String("kind", string(e.Kind)),
+	)
+
+	if e.Attempts > maxAttempts {
+		log.Error("export abandoned too often, giving up", zap.Int32("attempts", e.Attempts))
+		return s.repo.Fail(ctx, e.ID, failedMessage)
+	}
+
+	var (
+		buf  bytes.Buffer
+		rows int
+		err  error
+	)
+	switch e.Kind {
+	case KindCatalog:
+		rows, err = s.writeCatalog(ctx, &buf, e.SellerID)
+	default:
+		err = fmt.Errorf("unknown export kind %q", e.Kind)
+	}
+	if err != nil {
+		log.Error("failed to build export", zap.Error(err))
+		return s.repo.Fail(ctx, e.ID, failedMessage)
+	}
+
+	key := "exports/" + uuid.NewString() + ".csv"
+	if err := s.storage.Put(ctx, key, &buf); err != nil {
+		log.Error("failed to store export", zap.Error(err))
+		return s.repo.Fail(ctx, e.ID, failedMessage)
+	}
+
+	if err := s.repo.Finish(ctx, e.ID, key, int32(rows)); err != nil {
+		return err
+	}
+
+	log.Info("export finished", zap.Int("rows", rows))
+	return nil
+}
+
+func (s *service) writeCatalog(ctx context.Context, buf *bytes.Buffer, sellerID string) (int, error) {
+	rows, err := s.repo.CatalogRows(ctx, sellerID)
+	if err != nil {
+		return 0, err
+	}
+	if err := WriteCatalogCSV(buf, rows); err != nil {
+		return 0, err
+	}
+	return len(rows), nil
+}
+
+func (s *service) withURL(e *Export) *Export {
+	if e.StorageKey != nil {
+		url := s.storage.URL(*e.StorageKey)
+		e.URL = &url
+	}
+	return e
+}
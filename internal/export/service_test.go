@@ -0,0 +1,201 @@
+package export
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+	"warimas-be/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Mocks ---
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) Create(ctx context.Context, kind Kind, sellerID string, userID int32) (*Export, error) {
+	args := m.Called(ctx, kind, sellerID, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Export), args.Error(1)
+}
+
+func (m *MockRepository) ListBySeller(ctx context.Context, sellerID string, kind Kind, limit int32) ([]*Export, error) {
+	args := m.Called(ctx, sellerID, kind, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Export), args.Error(1)
+}
+
+func (m *MockRepository) Claim(ctx context.Context, staleBefore time.Time) (*Export, error) {
+	args := m.Called(ctx, staleBefore)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Export), args.Error(1)
+}
+
+func (m *MockRepository) Finish(ctx context.Context, id int64, storageKey string, rowCount int32) error {
+	args := m.Called(ctx, id, storageKey, rowCount)
+	return args.Error(0)
+}
+
+func (m *MockRepository) Fail(ctx context.Context, id int64, message string) error {
+	args := m.Called(ctx, id, message)
+	return args.Error(0)
+}
+
+func (m *MockRepository) CatalogRows(ctx context.Context, sellerID string) ([]*CatalogRow, error) {
+	args := m.Called(ctx, sellerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*CatalogRow), args.Error(1)
+}
+
+type memStorage struct {
+	files map[string][]byte
+	err   error
+}
+
+func (s *memStorage) Put(_ context.Context, key string, content io.Reader) error {
+	if s.err != nil {
+		return s.err
+	}
+	b, err := io.ReadAll(content)
+	if err != nil {
+		return err
+	}
+	s.files[key] = b
+	return nil
+}
+
+func (s *memStorage) Delete(_ context.Context, key string) error {
+	delete(s.files, key)
+	return nil
+}
+
+func (s *memStorage) URL(key string) string {
+	return "/uploads/" + key
+}
+
+// --- Tests ---
+
+const sellerID = "0b3e5f7a-1c2d-4e6f-8a9b-0c1d2e3f4a5b"
+
+var now = time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+
+func newTestService(repo Repository, storage *memStorage) *service {
+	return &service{repo: repo, storage: storage, now: func() time.Time { return now }}
+}
+
+func catalogRows() []*CatalogRow {
+	compareAt := 70000.0
+	return []*CatalogRow{
+		{
+			ProductID: "p-1", ProductName: "Beras", ProductStatus: "active", CategoryID: "c-1", SubcategoryID: "s-1",
+			VariantID: "v-1", VariantName: "5kg", QuantityType: "kg", Price: 65000, CompareAtPrice: &compareAt,
+			Stock: 12, WeightGrams: 5000, VariantActive: true,
+		},
+		{ProductID: "p-2", ProductName: "Gula, pasir", ProductStatus: "archived"},
+	}
+}
+
+func TestService_RequestCatalog(t *testing.T) {
+	t.Run("QueuesForSeller", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := newTestService(mockRepo, &memStorage{})
+		ctx := utils.WithPrincipal(context.Background(), &utils.Principal{ID: 7, SellerID: sellerID})
+
+		mockRepo.On("Create", ctx, KindCatalog, sellerID, int32(7)).Return(&Export{ID: 1, Status: StatusPending}, nil)
+
+		e, err := svc.RequestCatalog(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, StatusPending, e.Status)
+		assert.Nil(t, e.URL)
+	})
+
+	t.Run("NotSeller", func(t *testing.T) {
+		svc := newTestService(new(MockRepository), &memStorage{})
+		ctx := utils.SetUserContext(context.Background(), 7, "test@example.com", "ADMIN")
+
+		_, err := svc.RequestCatalog(ctx)
+		assert.ErrorIs(t, err, ErrNotSeller)
+	})
+}
+
+func TestService_Run(t *testing.T) {
+	ctx := context.Background()
+	staleBefore := now.Add(-staleAfter)
+
+	t.Run("BuildsCatalog", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		storage := &memStorage{files: map[string][]byte{}}
+		svc := newTestService(mockRepo, storage)
+
+		mockRepo.On("Claim", ctx, staleBefore).Return(&Export{ID: 1, Kind: KindCatalog, SellerID: sellerID, Attempts: 1}, nil).Once()
+		mockRepo.On("Claim", ctx, staleBefore).Return(nil, nil).Once()
+		mockRepo.On("CatalogRows", ctx, sellerID).Return(catalogRows(), nil)
+		mockRepo.On("Finish", ctx, int64(1), mock.AnythingOfType("string"), int32(2)).Return(nil)
+
+		n, err := svc.Run(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+		require.Len(t, storage.files, 1)
+		for _, b := range storage.files {
+			assert.Contains(t, string(b), "v-1,5kg,kg,65000,70000,12,5000,0,0,0,true\n")
+		}
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("StorageFailureFailsExport", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := newTestService(mockRepo, &memStorage{err: errors.New("disk full")})
+
+		mockRepo.On("Claim", ctx, staleBefore).Return(&Export{ID: 1, Kind: KindCatalog, SellerID: sellerID, Attempts: 1}, nil).Once()
+		mockRepo.On("Claim", ctx, staleBefore).Return(nil, nil).Once()
+		mockRepo.On("CatalogRows", ctx, sellerID).Return(catalogRows(), nil)
+		mockRepo.On("Fail", ctx, int64(1), failedMessage).Return(nil)
+
+		n, err := svc.Run(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+		mockRepo.AssertNotCalled(t, "Finish", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("GivesUpAfterMaxAttempts", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := newTestService(mockRepo, &memStorage{})
+
+		mockRepo.On("Claim", ctx, staleBefore).Return(&Export{ID: 1, Kind: KindCatalog, SellerID: sellerID, Attempts: maxAttempts + 1}, nil).Once()
+		mockRepo.On("Claim", ctx, staleBefore).Return(nil, nil).Once()
+		mockRepo.On("Fail", ctx, int64(1), failedMessage).Return(nil)
+
+		_, err := svc.Run(ctx)
+		assert.NoError(t, err)
+		mockRepo.AssertNotCalled(t, "CatalogRows", mock.Anything, mock.Anything)
+	})
+}
+
+func TestWriteCatalogCSV(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, WriteCatalogCSV(&buf, catalogRows()))
+
+	assert.Equal(t,
+		"product_id,product_name,product_status,category_id,subcategory_id,product_description,"+
+			"variant_id,variant_name,quantity_type,price,compare_at_price,stock,"+
+			"weight_grams,length_cm,width_cm,height_cm,variant_active\n"+
+			"p-1,Beras,active,c-1,s-1,,v-1,5kg,kg,65000,70000,12,5000,0,0,0,true\n"+
+			"p-2,\"Gula, pasir\",archived,,,,,,,,,,,,,,\n",
+		buf.String(),
+	)
+}
@@ -0,0 +1,18 @@
+package fulfillment
+
+import (
+	"errors"
+	"warimas-be/internal/apperr"
+)
+
+var (
+	ErrUnauthenticated    = apperr.Unauthenticated("unauthenticated")
+	ErrForbidden          = apperr.Forbidden("forbidden")
+	ErrOrderNotFound      = apperr.NotFound("order not found")
+	ErrNotFulfillable     = errors.New("order is not awaiting fulfillment")
+	ErrPickerNotFound     = apperr.NotFound("picker not found")
+	ErrNotAssigned        = errors.New("order has no picker assigned")
+	ErrAlreadyPacked      = errors.New("order is already packed")
+	ErrDB                 = errors.New("database error")
+	PgForeignKeyViolation = "23503"
+)
@@ -0,0 +1,28 @@
+package fulfillment
+
+import (
+	"strconv"
+	"warimas-be/internal/graph/model"
+)
+
+func idPtr(id *int32) *string {
+	if id == nil {
+		return nil
+	}
+	s := strconv.Itoa(int(*id))
+	return &s
+}
+
+func MapTaskToGraphQL(t *Task) *model.FulfillmentTask {
+	return &model.FulfillmentTask{
+		OrderID:         strconv.Itoa(int(t.OrderID)),
+		OrderExternalID: t.OrderExternalID,
+		OrderStatus:     model.OrderStatus(t.OrderStatus),
+		ItemCount:       t.ItemCount,
+		PickerID:        idPtr(t.PickerID),
+		AssignedAt:      t.AssignedAt,
+		PackedBy:        idPtr(t.PackedBy),
+		PackedAt:        t.PackedAt,
+		OrderedAt:       t.OrderedAt,
+	}
+}
@@ -0,0 +1,63 @@
+package fulfillment
+
+import (
+	"time"
+	"warimas-be/internal/address"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultLimit = 50
+	maxLimit     = 200
+)
+
+// Task is an order's place in the pick/pack queue. Orders enter the
+// queue once paid; the picker and pack fields stay nil until set.
+type Task struct {
+	OrderID         int32
+	OrderExternalID string
+	OrderStatus     string
+	ItemCount       int32
+	PickerID        *int32
+	AssignedAt      *time.Time
+	PackedBy        *int32
+	PackedAt        *time.Time
+	OrderedAt       time.Time
+}
+
+// PackingSlip is what goes in the box with the order.
+type PackingSlip struct {
+	OrderID         int32
+	OrderExternalID string
+	InvoiceNumber   *string
+	OrderedAt       time.Time
+	AddressID       uuid.UUID
+	Address         *address.Address
+	// Origins are the seller origins the items leave from, in the order
+	// they first appear; items without one ship from the platform.
+	Origins []*SlipOrigin
+	Items   []*SlipItem
+}
+
+type SlipItem struct {
+	ProductName   string
+	VariantName   string
+	Quantity      int32
+	QuantityType  string
+	WarehouseCode *string
+	OriginLabel   *string
+}
+
+// SlipOrigin is a seller origin as printed in the slip's "Ship from".
+type SlipOrigin struct {
+	ID          string
+	Label       string
+	ContactName string
+	Phone       string
+	Address1    string
+	Address2    *string
+	City        string
+	Province    string
+	Postal      string
+}
@@ -0,0 +1,281 @@
+package fulfillment
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"warimas-be/internal/logger"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+type Repository interface {
+	// ListQueue returns paid orders not yet packed, oldest first. With a
+	// picker set only that picker's orders are returned.
+	ListQueue(ctx context.Context, pickerID *uint, limit int32) ([]*Task, error)
+	GetTask(ctx context.Context, orderID uint) (*Task, error)
+	AssignPicker(ctx context.Context, orderID, pickerID, assignedBy uint) error
+	MarkPacked(ctx context.Context, orderID, packedBy uint) error
+	GetPackingSlip(ctx context.Context, orderID uint) (*PackingSlip, error)
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+const selectTask = `
+	SELECT o.id, o.external_id, o.status,
+	       (SELECT COALESCE(SUM(i.quantity), 0) FROM order_items i WHERE i.order_id = o.id),
+	       f.picker_id, f.assigned_at, f.packed_by, f.packed_at, o.created_at
+	FROM orders o
+	LEFT JOIN order_fulfillments f ON f.order_id = o.id
+`
+
+// fulfillableStatuses are the order statuses that may be picked and packed.
+const fulfillableStatuses = `('PAID', 'ACCEPTED')`
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanTask(s scanner) (*Task, error) {
+	var t Task
+	if err := s.Scan(
+		&t.OrderID, &t.OrderExternalID, &t.OrderStatus, &t.ItemCount,
+		&t.PickerID, &t.AssignedAt, &t.PackedBy, &t.PackedAt, &t.OrderedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (r *repository) ListQueue(ctx context.Context, pickerID *uint, limit int32) ([]*Task, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ListQueue"),
+	)
+
+	rows, err := r.db.QueryContext(ctx, selectTask+`
+		WHERE o.status IN `+fulfillableStatuses+`
+		  AND f.packed_at IS NULL
+		  AND ($1::int IS NULL OR f.picker_id = $1)
+		ORDER BY o.created_at, o.id
+		LIMIT $2
+	`, pickerID, limit)
+	if err != nil {
+		log.Error("failed to query fulfillment queue", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	list := []*Task{}
+	for rows.Next() {
+		t, err := scanTask(rows)
+		if err != nil {
+			log.Error("failed to scan task", zap.Error(err))
+			return nil, ErrDB
+		}
+		list = append(list, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error("task iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return list, nil
+}
+
+func (r *repository) GetTask(ctx context.Context, orderID uint) (*Task, error) {
+	t, err := scanTask(r.db.QueryRowContext(ctx, selectTask+` WHERE o.id = $1`, orderID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrOrderNotFound
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to get task", zap.Uint("order_id", orderID), zap.Error(err))
+		return nil, ErrDB
+	}
+	return t, nil
+}
+
+func (r *repository) AssignPicker(ctx context.Context, orderID, pickerID, assignedBy uint) error {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "AssignPicker"),
+		zap.Uint("order_id", orderID),
+	)
+
+	res, err := r.db.ExecContext(ctx, `
+		INSERT INTO order_fulfillments (order_id, picker_id, assigned_by, assigned_at)
+		SELECT o.id, $2, $3, NOW()
+		FROM orders o
+		WHERE o.id = $1
+		  AND o.status IN `+fulfillableStatuses+`
+		ON CONFLICT (order_id) DO UPDATE
+		SET picker_id = EXCLUDED.picker_id,
+		    assigned_by = EXCLUDED.assigned_by,
+		    assigned_at = EXCLUDED.assigned_at
+		WHERE order_fulfillments.packed_at IS NULL
+	`, orderID, pickerID, assignedBy)
+	if err != nil {
+		if pqCode(err) == PgForeignKeyViolation {
+			return ErrPickerNotFound
+		}
+		log.Error("failed to assign picker", zap.Error(err))
+		return ErrDB
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+		return r.whyNotOpen(ctx, orderID)
+	}
+	return nil
+}
+
+func (r *repository) MarkPacked(ctx context.Context, orderID, packedBy uint) error {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "MarkPacked"),
+		zap.Uint("order_id", orderID),
+	)
+
+	res, err := r.db.ExecContext(ctx, `
+		UPDATE order_fulfillments f
+		SET packed_by = $2, packed_at = NOW()
+		FROM orders o
+		WHERE f.order_id = $1
+		  AND o.id = f.order_id
+		  AND o.status IN `+fulfillableStatuses+`
+		  AND f.picker_id IS NOT NULL
+		  AND f.packed_at IS NULL
+	`, orderID, packedBy)
+	if err != nil {
+		log.Error("failed to mark order packed", zap.Error(err))
+		return ErrDB
+	}
+
+	if n, _ := res.RowsAffected(); n == 0 {
+		return r.whyNotOpen(ctx, orderID)
+	}
+	return nil
+}
+
+// whyNotOpen explains why an assign or pack touched no row.
+func (r *repository) whyNotOpen(ctx context.Context, orderID uint) error {
+	t, err := r.GetTask(ctx, orderID)
+	if err != nil {
+		return err
+	}
+	switch {
+	case t.PackedAt != nil:
+		return ErrAlreadyPacked
+	case t.OrderStatus != "PAID" && t.OrderStatus != "ACCEPTED":
+		return ErrNotFulfillable
+	case t.PickerID == nil:
+		return ErrNotAssigned
+	}
+	return ErrNotFulfillable
+}
+
+func (r *repository) GetPackingSlip(ctx context.Context, orderID uint) (*PackingSlip, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "GetPackingSlip"),
+		zap.Uint("order_id", orderID),
+	)
+
+	var slip PackingSlip
+	err := r.db.QueryRowContext(ctx, `
+		SELECT id, external_id, invoice_number, created_at, address_id
+		FROM orders
+		WHERE id = $1
+	`, orderID).Scan(&slip.OrderID, &slip.OrderExternalID, &slip.InvoiceNumber, &slip.OrderedAt, &slip.AddressID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrOrderNotFound
+	}
+	if err != nil {
+		log.Error("failed to load order", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	// Items ship from their product's origin, else the seller's default
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT i.product_name, i.variant_name, i.quantity, i.quantity_type, w.code,
+			o.id, o.label, o.contact_name, o.phone, o.address_line1, o.address_line2,
+			o.city, o.province, o.postal_code
+		FROM order_items i
+		LEFT JOIN warehouses w ON w.id = i.warehouse_id
+		LEFT JOIN variants v ON v.id = i.variant_id
+		LEFT JOIN products p ON p.id = v.product_id
+		LEFT JOIN LATERAL (
+			SELECT so.*
+			FROM seller_origins so
+			WHERE so.id = p.origin_id
+			   OR (p.origin_id IS NULL AND so.seller_id = p.seller_id AND so.is_default)
+			LIMIT 1
+		) o ON TRUE
+		WHERE i.order_id = $1
+		ORDER BY o.label NULLS LAST, w.code NULLS LAST, i.product_name, i.variant_name
+	`, orderID)
+	if err != nil {
+		log.Error("failed to query slip items", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	slip.Items = []*SlipItem{}
+	slip.Origins = []*SlipOrigin{}
+	seen := map[string]bool{}
+	for rows.Next() {
+		var (
+			it SlipItem
+			// every origin column is NULL for items without an origin
+			originID, label, contact, phone, line1, line2 *string
+			city, province, postal                        *string
+		)
+		if err := rows.Scan(
+			&it.ProductName, &it.VariantName, &it.Quantity, &it.QuantityType, &it.WarehouseCode,
+			&originID, &label, &contact, &phone, &line1, &line2, &city, &province, &postal,
+		); err != nil {
+			log.Error("failed to scan slip item", zap.Error(err))
+			return nil, ErrDB
+		}
+		if originID != nil {
+			it.OriginLabel = label
+			if !seen[*originID] {
+				seen[*originID] = true
+				slip.Origins = append(slip.Origins, &SlipOrigin{
+					ID:          *originID,
+					Label:       *label,
+					ContactName: *contact,
+					Phone:       *phone,
+					Address1:    *line1,
+					Address2:    line2,
+					City:        *city,
+					Province:    *province,
+					Postal:      *postal,
+				})
+			}
+		}
+		slip.Items = append(slip.Items, &it)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error("slip item iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return &slip, nil
+}
+
+func pqCode(err error) string {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return string(pqErr.Code)
+	}
+	return ""
+}
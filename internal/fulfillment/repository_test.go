@@ -0,0 +1,130 @@
+package fulfillment
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var taskCols = []string{
+	"id", "external_id", "status", "item_count",
+	"picker_id", "assigned_at", "packed_by", "packed_at", "created_at",
+}
+
+func TestRepository_AssignPicker(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectExec(`INSERT INTO order_fulfillments`).
+			WithArgs(uint(3), uint(9), uint(7)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.AssignPicker(ctx, 3, 9, 7))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("UnknownPicker", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectExec(`INSERT INTO order_fulfillments`).
+			WillReturnError(&pq.Error{Code: pq.ErrorCode(PgForeignKeyViolation)})
+
+		assert.ErrorIs(t, repo.AssignPicker(ctx, 3, 99, 7), ErrPickerNotFound)
+	})
+
+	t.Run("OrderNotPaid", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectExec(`INSERT INTO order_fulfillments`).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(`FROM orders o\s+LEFT JOIN order_fulfillments f .* WHERE o.id = \$1`).
+			WithArgs(uint(3)).
+			WillReturnRows(sqlmock.NewRows(taskCols).
+				AddRow(3, "ORD-3", "PENDING_PAYMENT", 2, nil, nil, nil, nil, time.Now()))
+
+		assert.ErrorIs(t, repo.AssignPicker(ctx, 3, 9, 7), ErrNotFulfillable)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_MarkPacked(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectExec(`UPDATE order_fulfillments f\s+SET packed_by = \$2, packed_at = NOW\(\)`).
+			WithArgs(uint(3), uint(7)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.MarkPacked(ctx, 3, 7))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("NoPicker", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectExec(`UPDATE order_fulfillments`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(`FROM orders o`).
+			WillReturnRows(sqlmock.NewRows(taskCols).
+				AddRow(3, "ORD-3", "PAID", 2, nil, nil, nil, nil, time.Now()))
+
+		assert.ErrorIs(t, repo.MarkPacked(ctx, 3, 7), ErrNotAssigned)
+	})
+
+	t.Run("AlreadyPacked", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		now := time.Now()
+		mock.ExpectExec(`UPDATE order_fulfillments`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(`FROM orders o`).
+			WillReturnRows(sqlmock.NewRows(taskCols).
+				AddRow(3, "ORD-3", "ACCEPTED", 2, 9, now, 7, now, now))
+
+		assert.ErrorIs(t, repo.MarkPacked(ctx, 3, 7), ErrAlreadyPacked)
+	})
+}
+
+func TestRepository_ListQueue(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := NewRepository(db)
+
+	picker := uint(9)
+	mock.ExpectQuery(`WHERE o.status IN \('PAID', 'ACCEPTED'\)\s+AND f.packed_at IS NULL`).
+		WithArgs(&picker, int32(50)).
+		WillReturnRows(sqlmock.NewRows(taskCols).
+			AddRow(3, "ORD-3", "PAID", 2, 9, time.Now(), nil, nil, time.Now()))
+
+	tasks, err := repo.ListQueue(context.Background(), &picker, 50)
+	assert.NoError(t, err)
+	assert.Len(t, tasks, 1)
+	assert.Equal(t, int32(9), *tasks[0].PickerID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
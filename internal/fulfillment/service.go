@@ -0,0 +1,141 @@
+package fulfillment
+
+import (
+	"context"
+	"warimas-be/internal/address"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Service runs the pick/pack queue for paid orders. Every method is admin
+// only; pickers are staff accounts.
+type Service interface {
+	// ListQueue returns orders awaiting packing. With mineOnly set only
+	// orders assigned to the caller are returned.
+	ListQueue(ctx context.Context, mineOnly bool, limit int32) ([]*Task, error)
+	AssignPicker(ctx context.Context, orderID, pickerID uint) (*Task, error)
+	// MarkPacked records the caller as packer. The order can ship after.
+	MarkPacked(ctx context.Context, orderID uint) (*Task, error)
+	// PackingSlip returns the order's packing slip as printable HTML.
+	PackingSlip(ctx context.Context, orderID uint) (string, error)
+}
+
+type AddressGateway interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*address.Address, error)
+}
+
+type service struct {
+	repo        Repository
+	addressRepo AddressGateway
+}
+
+func NewService(repo Repository, addressRepo AddressGateway) Service {
+	return &service{repo: repo, addressRepo: addressRepo}
+}
+
+func (s *service) ListQueue(ctx context.Context, mineOnly bool, limit int32) ([]*Task, error) {
+	adminID, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+
+	var pickerID *uint
+	if mineOnly {
+		pickerID = &adminID
+	}
+	return s.repo.ListQueue(ctx, pickerID, limit)
+}
+
+func (s *service) AssignPicker(ctx context.Context, orderID, pickerID uint) (*Task, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "AssignPicker"),
+		zap.Uint("order_id", orderID),
+		zap.Uint("picker_id", pickerID),
+	)
+
+	adminID, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.AssignPicker(ctx, orderID, pickerID, adminID); err != nil {
+		log.Warn("failed to assign picker", zap.Error(err))
+		return nil, err
+	}
+
+	log.Info("picker assigned")
+	return s.repo.GetTask(ctx, orderID)
+}
+
+func (s *service) MarkPacked(ctx context.Context, orderID uint) (*Task, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "MarkPacked"),
+		zap.Uint("order_id", orderID),
+	)
+
+	adminID, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.repo.MarkPacked(ctx, orderID, adminID); err != nil {
+		log.Warn("failed to mark order packed", zap.Error(err))
+		return nil, err
+	}
+
+	log.Info("order packed", zap.Uint("packed_by", adminID))
+	return s.repo.GetTask(ctx, orderID)
+}
+
+func (s *service) PackingSlip(ctx context.Context, orderID uint) (string, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "PackingSlip"),
+		zap.Uint("order_id", orderID),
+	)
+
+	if _, err := requireAdmin(ctx); err != nil {
+		return "", err
+	}
+
+	slip, err := s.repo.GetPackingSlip(ctx, orderID)
+	if err != nil {
+		return "", err
+	}
+
+	slip.Address, err = s.addressRepo.GetByID(ctx, slip.AddressID)
+	if err != nil {
+		log.Error("failed to load shipping address", zap.Error(err))
+		return "", err
+	}
+
+	html, err := RenderPackingSlip(slip)
+	if err != nil {
+		log.Error("failed to render packing slip", zap.Error(err))
+		return "", err
+	}
+	return html, nil
+}
+
+func requireAdmin(ctx context.Context) (uint, error) {
+	userID, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		return 0, ErrUnauthenticated
+	}
+	if !utils.IsAdmin(ctx) {
+		return 0, ErrForbidden
+	}
+	return userID, nil
+}
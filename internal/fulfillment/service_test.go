@@ -0,0 +1,217 @@
+package fulfillment
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+	"warimas-be/internal/address"
+	"warimas-be/internal/utils"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// --- Mocks ---
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) ListQueue(ctx context.Context, pickerID *uint, limit int32) ([]*Task, error) {
+	args := m.Called(ctx, pickerID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Task), args.Error(1)
+}
+
+func (m *MockRepository) GetTask(ctx context.Context, orderID uint) (*Task, error) {
+	args := m.Called(ctx, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Task), args.Error(1)
+}
+
+func (m *MockRepository) AssignPicker(ctx context.Context, orderID, pickerID, assignedBy uint) error {
+	args := m.Called(ctx, orderID, pickerID, assignedBy)
+	return args.Error(0)
+}
+
+func (m *MockRepository) MarkPacked(ctx context.Context, orderID, packedBy uint) error {
+	args := m.Called(ctx, orderID, packedBy)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetPackingSlip(ctx context.Context, orderID uint) (*PackingSlip, error) {
+	args := m.Called(ctx, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*PackingSlip), args.Error(1)
+}
+
+type MockAddressGateway struct {
+	mock.Mock
+}
+
+func (m *MockAddressGateway) GetByID(ctx context.Context, id uuid.UUID) (*address.Address, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*address.Address), args.Error(1)
+}
+
+// --- Tests ---
+
+var adminCtx = utils.SetUserContext(context.Background(), 7, "admin@example.com", "ADMIN")
+
+func TestService_ListQueue(t *testing.T) {
+	t.Run("MineOnly", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil)
+
+		mockRepo.On("ListQueue", adminCtx, mock.MatchedBy(func(id *uint) bool {
+			return id != nil && *id == 7
+		}), int32(defaultLimit)).Return([]*Task{}, nil)
+
+		_, err := svc.ListQueue(adminCtx, true, 0)
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("AllClampsLimit", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil)
+
+		mockRepo.On("ListQueue", adminCtx, (*uint)(nil), int32(maxLimit)).Return([]*Task{}, nil)
+
+		_, err := svc.ListQueue(adminCtx, false, 10000)
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		svc := NewService(new(MockRepository), nil)
+		ctx := utils.SetUserContext(context.Background(), 2, "user@example.com", "USER")
+
+		_, err := svc.ListQueue(ctx, false, 0)
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+}
+
+func TestService_AssignPicker(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil)
+
+		picker := int32(9)
+		mockRepo.On("AssignPicker", adminCtx, uint(3), uint(9), uint(7)).Return(nil)
+		mockRepo.On("GetTask", adminCtx, uint(3)).Return(&Task{OrderID: 3, PickerID: &picker}, nil)
+
+		task, err := svc.AssignPicker(adminCtx, 3, 9)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(9), *task.PickerID)
+	})
+
+	t.Run("AlreadyPacked", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil)
+
+		mockRepo.On("AssignPicker", adminCtx, uint(3), uint(9), uint(7)).Return(ErrAlreadyPacked)
+
+		_, err := svc.AssignPicker(adminCtx, 3, 9)
+		assert.ErrorIs(t, err, ErrAlreadyPacked)
+		mockRepo.AssertNotCalled(t, "GetTask", mock.Anything, mock.Anything)
+	})
+}
+
+func TestService_MarkPacked(t *testing.T) {
+	mockRepo := new(MockRepository)
+	svc := NewService(mockRepo, nil)
+
+	now := time.Now()
+	packer := int32(7)
+	mockRepo.On("MarkPacked", adminCtx, uint(3), uint(7)).Return(nil)
+	mockRepo.On("GetTask", adminCtx, uint(3)).Return(&Task{OrderID: 3, PackedBy: &packer, PackedAt: &now}, nil)
+
+	task, err := svc.MarkPacked(adminCtx, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(7), *task.PackedBy)
+}
+
+func TestService_PackingSlip(t *testing.T) {
+	addrID := uuid.New()
+	slip := func() *PackingSlip {
+		wh := "MAIN"
+		return &PackingSlip{
+			OrderID:         3,
+			OrderExternalID: "ORD-3",
+			OrderedAt:       time.Date(2026, 1, 2, 10, 30, 0, 0, time.UTC),
+			AddressID:       addrID,
+			Items: []*SlipItem{
+				{ProductName: "Beras <Premium>", VariantName: "5kg", Quantity: 2, QuantityType: "sack", WarehouseCode: &wh},
+			},
+		}
+	}
+
+	t.Run("RendersEscapedHTML", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockAddr := new(MockAddressGateway)
+		svc := NewService(mockRepo, mockAddr)
+
+		mockRepo.On("GetPackingSlip", adminCtx, uint(3)).Return(slip(), nil)
+		mockAddr.On("GetByID", adminCtx, addrID).Return(&address.Address{
+			ReceiverName: "Budi", Phone: "0812", Address1: "Jl. Merdeka 1",
+			City: "Bandung", Province: "Jawa Barat", Postal: "40111", Country: "ID",
+		}, nil)
+
+		html, err := svc.PackingSlip(adminCtx, 3)
+		assert.NoError(t, err)
+		assert.Contains(t, html, "ORD-3")
+		assert.Contains(t, html, "Budi")
+		assert.Contains(t, html, "2026-01-02 10:30")
+		assert.Contains(t, html, "Beras &lt;Premium&gt;")
+		assert.False(t, strings.Contains(html, "<Premium>"))
+	})
+
+	t.Run("ShipFrom", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockAddr := new(MockAddressGateway)
+		svc := NewService(mockRepo, mockAddr)
+
+		label := "Gudang Bekasi"
+		s := slip()
+		s.Origins = []*SlipOrigin{{
+			ID: "o1", Label: label, ContactName: "Sari", Phone: "0813",
+			Address1: "Jl. Industri 5", City: "Bekasi", Province: "Jawa Barat", Postal: "17520",
+		}}
+		s.Items[0].OriginLabel = &label
+		s.Items = append(s.Items, &SlipItem{ProductName: "Gula", VariantName: "1kg", Quantity: 1, QuantityType: "pack"})
+
+		mockRepo.On("GetPackingSlip", adminCtx, uint(3)).Return(s, nil)
+		mockAddr.On("GetByID", adminCtx, addrID).Return(&address.Address{ReceiverName: "Budi"}, nil)
+
+		html, err := svc.PackingSlip(adminCtx, 3)
+		assert.NoError(t, err)
+		assert.Contains(t, html, "Ship from: Gudang Bekasi")
+		assert.Contains(t, html, "Jl. Industri 5")
+		assert.Contains(t, html, "<td>Platform</td>")
+	})
+
+	t.Run("AddressError", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockAddr := new(MockAddressGateway)
+		svc := NewService(mockRepo, mockAddr)
+
+		mockRepo.On("GetPackingSlip", adminCtx, uint(3)).Return(slip(), nil)
+		mockAddr.On("GetByID", adminCtx, addrID).Return(nil, errors.New("address not found"))
+
+		_, err := svc.PackingSlip(adminCtx, 3)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,81 @@
+package fulfillment
+
+import (
+	"bytes"
+	"html/template"
+)
+
+// slipTemplate is a self-contained page meant to be printed from the
+// browser (or saved as PDF from the print dialog).
+var slipTemplate = template.Must(template.New("slip").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Packing slip {{.OrderExternalID}}</title>
+<style>
+body { font-family: sans-serif; font-size: 12px; margin: 24px; }
+h1 { font-size: 18px; margin: 0 0 12px; }
+table { border-collapse: collapse; width: 100%; margin-top: 16px; }
+th, td { border: 1px solid #999; padding: 4px 8px; text-align: left; }
+td.qty { text-align: right; }
+@media print { body { margin: 0; } }
+</style>
+</head>
+<body>
+<h1>Packing slip</h1>
+<p>
+Order: {{.OrderExternalID}}<br>
+{{with .InvoiceNumber}}Invoice: {{.}}<br>{{end}}
+Ordered: {{.OrderedAt.Format "2006-01-02 15:04"}}
+</p>
+{{with .Address}}
+<p>
+<strong>Ship to</strong><br>
+{{.ReceiverName}}<br>
+{{.Phone}}<br>
+{{.Address1}}<br>
+{{with .Address2}}{{.}}<br>{{end}}
+{{.City}}, {{.Province}} {{.Postal}}<br>
+{{.Country}}
+</p>
+{{end}}
+{{range .Origins}}
+<p>
+<strong>Ship from: {{.Label}}</strong><br>
+{{.ContactName}}<br>
+{{.Phone}}<br>
+{{.Address1}}<br>
+{{with .Address2}}{{.}}<br>{{end}}
+{{.City}}, {{.Province}} {{.Postal}}
+</p>
+{{end}}
+<table>
+<thead>
+<tr>{{if .Origins}}<th>Ship from</th>{{end}}<th>Warehouse</th><th>Product</th><th>Variant</th><th>Qty</th><th>Packed</th></tr>
+</thead>
+<tbody>
+{{$origins := .Origins}}
+{{range .Items}}
+<tr>
+{{if $origins}}<td>{{with .OriginLabel}}{{.}}{{else}}Platform{{end}}</td>{{end}}
+<td>{{with .WarehouseCode}}{{.}}{{else}}-{{end}}</td>
+<td>{{.ProductName}}</td>
+<td>{{.VariantName}}</td>
+<td class="qty">{{.Quantity}} {{.QuantityType}}</td>
+<td>&#9744;</td>
+</tr>
+{{end}}
+</tbody>
+</table>
+</body>
+</html>
+`))
+
+// RenderPackingSlip renders slip as printable HTML.
+func RenderPackingSlip(slip *PackingSlip) (string, error) {
+	var buf bytes.Buffer
+	if err := slipTemplate.Execute(&buf, slip); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,127 @@
+package geo
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"warimas-be/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// DefaultCurrency is what the store charges in, and what visitors from
+// countries without an entry in currencies are shown.
+const DefaultCurrency = "IDR"
+
+// LocationSource says how a visitor's location was found.
+type LocationSource string
+
+const (
+	SourceCDN   LocationSource = "CDN"
+	SourceGeoIP LocationSource = "GEOIP"
+	SourceNone  LocationSource = "NONE"
+)
+
+// ClientLocation is where a visitor appears to be. It only preselects
+// defaults, so it is never checked against the shipping address.
+type ClientLocation struct {
+	// ISO 3166-1 alpha-2, "" when unknown.
+	CountryCode string
+	// Province the visitor is in, named as addresses and checkout rules
+	// name it; "" outside Indonesia or when unknown.
+	Region string
+	Source LocationSource
+}
+
+// Currency is the currency to preselect for the visitor.
+func (l ClientLocation) Currency() string {
+	if c, ok := currencies[l.CountryCode]; ok {
+		return c
+	}
+	return DefaultCurrency
+}
+
+// IPLocator looks an address up in a GeoIP database or service.
+type IPLocator interface {
+	Locate(ctx context.Context, ip net.IP) (ClientLocation, error)
+}
+
+// Detector finds a visitor's location, first from the headers a CDN in
+// front of the server adds and then, for requests without them, from the
+// client IP.
+type Detector struct {
+	// CountryHeader carries the visitor's country code, e.g.
+	// "CF-IPCountry" behind Cloudflare. Empty ignores CDN headers.
+	CountryHeader string
+	// RegionHeader carries the ISO 3166-2 subdivision code without the
+	// country, e.g. "JK" in "CloudFront-Viewer-Country-Region". Optional.
+	RegionHeader string
+	// IP is nil when no GeoIP lookup is configured.
+	IP IPLocator
+}
+
+// Detect returns SourceNone when neither the headers nor the IP tell
+// where the visitor is.
+func (d *Detector) Detect(ctx context.Context, r *http.Request, clientIP string) ClientLocation {
+	if d.CountryHeader != "" {
+		if country := countryCode(r.Header.Get(d.CountryHeader)); country != "" {
+			loc := ClientLocation{CountryCode: country, Source: SourceCDN}
+			if d.RegionHeader != "" {
+				loc.Region = RegionName(country, r.Header.Get(d.RegionHeader))
+			}
+			return loc
+		}
+	}
+
+	ip := net.ParseIP(clientIP)
+	if d.IP == nil || ip == nil || !ip.IsGlobalUnicast() || ip.IsPrivate() {
+		return ClientLocation{Source: SourceNone}
+	}
+	loc, err := d.IP.Locate(ctx, ip)
+	if err != nil {
+		logger.FromCtx(ctx).Warn("geoip lookup failed", zap.Error(err))
+		return ClientLocation{Source: SourceNone}
+	}
+	return loc
+}
+
+// countryCode normalizes a country header, "" for the codes CDNs send for
+// unknown and Tor visitors.
+func countryCode(raw string) string {
+	c := strings.ToUpper(strings.TrimSpace(raw))
+	if len(c) != 2 || c == "XX" || c == "T1" {
+		return ""
+	}
+	return c
+}
+
+// RegionName returns the province for an ISO 3166-2 subdivision code of
+// Indonesia, with or without the "ID-" prefix; "" for other countries and
+// unknown codes.
+func RegionName(country, subdivision string) string {
+	if country != "ID" {
+		return ""
+	}
+	code := strings.ToUpper(strings.TrimSpace(subdivision))
+	return provinces[strings.TrimPrefix(code, "ID-")]
+}
+
+type clientLocationKey struct{}
+
+// WithClientLocation puts a lookup of the visitor's location on ctx. It
+// runs at most once, and only when ClientLocation asks for it, so requests
+// that do not need the location never wait on a GeoIP service.
+func WithClientLocation(ctx context.Context, detect func() ClientLocation) context.Context {
+	return context.WithValue(ctx, clientLocationKey{}, sync.OnceValue(detect))
+}
+
+// FromContext returns the visitor's location, SourceNone outside a request
+// that went through the geo middleware.
+func FromContext(ctx context.Context) ClientLocation {
+	if detect, ok := ctx.Value(clientLocationKey{}).(func() ClientLocation); ok {
+		return detect()
+	}
+	return ClientLocation{Source: SourceNone}
+}
@@ -0,0 +1,121 @@
+package geo
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubLocator struct {
+	loc   ClientLocation
+	calls int
+}
+
+func (s *stubLocator) Locate(ctx context.Context, ip net.IP) (ClientLocation, error) {
+	s.calls++
+	return s.loc, nil
+}
+
+func TestDetector_Detect(t *testing.T) {
+	d := &Detector{
+		CountryHeader: "CF-IPCountry",
+		RegionHeader:  "CloudFront-Viewer-Country-Region",
+	}
+
+	t.Run("CDN headers", func(t *testing.T) {
+		r := httptest.NewRequest("POST", "/query", nil)
+		r.Header.Set("CF-IPCountry", "id")
+		r.Header.Set("CloudFront-Viewer-Country-Region", "JB")
+
+		loc := d.Detect(context.Background(), r, "36.68.0.1")
+
+		assert.Equal(t, ClientLocation{CountryCode: "ID", Region: "Jawa Barat", Source: SourceCDN}, loc)
+		assert.Equal(t, "IDR", loc.Currency())
+	})
+
+	t.Run("Foreign visitor has no region", func(t *testing.T) {
+		r := httptest.NewRequest("POST", "/query", nil)
+		r.Header.Set("CF-IPCountry", "SG")
+		r.Header.Set("CloudFront-Viewer-Country-Region", "01")
+
+		loc := d.Detect(context.Background(), r, "")
+
+		assert.Equal(t, "", loc.Region)
+		assert.Equal(t, "SGD", loc.Currency())
+	})
+
+	t.Run("Falls back to GeoIP", func(t *testing.T) {
+		ip := &stubLocator{loc: ClientLocation{CountryCode: "ID", Region: "Bali", Source: SourceGeoIP}}
+		d := &Detector{CountryHeader: "CF-IPCountry", IP: ip}
+		r := httptest.NewRequest("POST", "/query", nil)
+		r.Header.Set("CF-IPCountry", "XX")
+
+		loc := d.Detect(context.Background(), r, "36.68.0.1")
+
+		assert.Equal(t, "Bali", loc.Region)
+		assert.Equal(t, 1, ip.calls)
+	})
+
+	t.Run("Private address is not looked up", func(t *testing.T) {
+		ip := &stubLocator{}
+		d := &Detector{IP: ip}
+		r := httptest.NewRequest("POST", "/query", nil)
+
+		loc := d.Detect(context.Background(), r, "10.0.0.7")
+
+		assert.Equal(t, ClientLocation{Source: SourceNone}, loc)
+		assert.Equal(t, 0, ip.calls)
+	})
+}
+
+func TestMaxMindLocator(t *testing.T) {
+	var calls int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		user, pass, _ := r.BasicAuth()
+		assert.Equal(t, "123", user)
+		assert.Equal(t, "key", pass)
+		switch r.URL.Path {
+		case "/36.68.0.1":
+			w.Write([]byte(`{"country":{"iso_code":"ID"},"subdivisions":[{"iso_code":"JK"}]}`))
+		default:
+			http.Error(w, `{"code":"IP_ADDRESS_NOT_FOUND"}`, http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	m := NewMaxMindLocator("123", "key")
+	m.baseURL = srv.URL + "/"
+
+	loc, err := m.Locate(context.Background(), net.ParseIP("36.68.0.1"))
+	require.NoError(t, err)
+	assert.Equal(t, ClientLocation{CountryCode: "ID", Region: "DKI Jakarta", Source: SourceGeoIP}, loc)
+
+	_, err = m.Locate(context.Background(), net.ParseIP("36.68.0.1"))
+	require.NoError(t, err)
+	assert.Equal(t, 1, calls, "second lookup is served from the cache")
+
+	loc, err = m.Locate(context.Background(), net.ParseIP("203.0.113.9"))
+	require.NoError(t, err)
+	assert.Equal(t, SourceNone, loc.Source)
+}
+
+func TestFromContext(t *testing.T) {
+	assert.Equal(t, SourceNone, FromContext(context.Background()).Source)
+
+	calls := 0
+	ctx := WithClientLocation(context.Background(), func() ClientLocation {
+		calls++
+		return ClientLocation{CountryCode: "ID", Source: SourceCDN}
+	})
+	assert.Equal(t, 0, calls, "lookup waits for the first caller")
+
+	FromContext(ctx)
+	assert.Equal(t, "ID", FromContext(ctx).CountryCode)
+	assert.Equal(t, 1, calls)
+}
@@ -0,0 +1,83 @@
+// Package geo holds the coordinates addresses and shipping origins are
+// pinned at, the distance between them, and where a visitor appears to
+// be.
+package geo
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"warimas-be/internal/apperr"
+)
+
+// earthRadiusKm is the mean radius DistanceKm measures with.
+const earthRadiusKm = 6371.0
+
+var ErrInvalidPoint = apperr.Invalid("latitude must be between -90 and 90 and longitude between -180 and 180")
+
+// Point is a WGS84 latitude/longitude pair in degrees.
+type Point struct {
+	Lat float64 `json:"lat"`
+	Lng float64 `json:"lng"`
+}
+
+// Valid reports whether p is on the globe.
+func (p Point) Valid() bool {
+	return p.Lat >= -90 && p.Lat <= 90 && p.Lng >= -180 && p.Lng <= 180
+}
+
+// String formats p as "lat,lng", the form Parse reads back.
+func (p Point) String() string {
+	return strconv.FormatFloat(p.Lat, 'f', -1, 64) + "," + strconv.FormatFloat(p.Lng, 'f', -1, 64)
+}
+
+// Parse reads a point written by String.
+func Parse(s string) (Point, error) {
+	lat, lng, ok := strings.Cut(s, ",")
+	if !ok {
+		return Point{}, fmt.Errorf("geo: malformed point %q", s)
+	}
+	var p Point
+	var err error
+	if p.Lat, err = strconv.ParseFloat(strings.TrimSpace(lat), 64); err != nil {
+		return Point{}, fmt.Errorf("geo: malformed point %q: %w", s, err)
+	}
+	if p.Lng, err = strconv.ParseFloat(strings.TrimSpace(lng), 64); err != nil {
+		return Point{}, fmt.Errorf("geo: malformed point %q: %w", s, err)
+	}
+	return p, nil
+}
+
+// PointOf returns the point stored in a nullable latitude and longitude
+// column pair, nil unless both are set.
+func PointOf(lat, lng *float64) *Point {
+	if lat == nil || lng == nil {
+		return nil
+	}
+	return &Point{Lat: *lat, Lng: *lng}
+}
+
+// Coords splits p into the nullable column pair PointOf reads.
+func (p *Point) Coords() (lat, lng *float64) {
+	if p == nil {
+		return nil, nil
+	}
+	return &p.Lat, &p.Lng
+}
+
+// DistanceKm is the great-circle distance between a and b. Roads are
+// longer, so callers use it as a lower bound.
+func DistanceKm(a, b Point) float64 {
+	lat1, lat2 := radians(a.Lat), radians(b.Lat)
+	dLat := lat2 - lat1
+	dLng := radians(b.Lng - a.Lng)
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+func radians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
@@ -0,0 +1,56 @@
+package geo
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDistanceKm(t *testing.T) {
+	monas := Point{Lat: -6.1754, Lng: 106.8272}
+	bandung := Point{Lat: -6.9175, Lng: 107.6191}
+
+	assert.InDelta(t, 0, DistanceKm(monas, monas), 1e-9)
+	assert.InDelta(t, 120, DistanceKm(monas, bandung), 2)
+	assert.InDelta(t, DistanceKm(monas, bandung), DistanceKm(bandung, monas), 1e-9)
+}
+
+func TestParse(t *testing.T) {
+	t.Run("Round trip", func(t *testing.T) {
+		p := Point{Lat: -6.1754, Lng: 106.8272}
+
+		got, err := Parse(p.String())
+
+		require.NoError(t, err)
+		assert.Equal(t, p, got)
+	})
+
+	t.Run("Malformed", func(t *testing.T) {
+		_, err := Parse("-6.1754")
+		assert.Error(t, err)
+
+		_, err = Parse("north,106.8")
+		assert.Error(t, err)
+	})
+}
+
+func TestPoint(t *testing.T) {
+	t.Run("Valid", func(t *testing.T) {
+		assert.True(t, Point{Lat: -90, Lng: 180}.Valid())
+		assert.False(t, Point{Lat: 91, Lng: 0}.Valid())
+		assert.False(t, Point{Lat: 0, Lng: -181}.Valid())
+	})
+
+	t.Run("Nullable columns", func(t *testing.T) {
+		lat, lng := 1.5, 2.5
+
+		assert.Nil(t, PointOf(&lat, nil))
+		assert.Equal(t, &Point{Lat: 1.5, Lng: 2.5}, PointOf(&lat, &lng))
+
+		var none *Point
+		gotLat, gotLng := none.Coords()
+		assert.Nil(t, gotLat)
+		assert.Nil(t, gotLng)
+	})
+}
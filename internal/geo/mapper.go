@@ -0,0 +1,31 @@
+package geo
+
+import "warimas-be/internal/graph/model"
+
+func MapPointToGraphQL(p *Point) *model.Location {
+	if p == nil {
+		return nil
+	}
+	return &model.Location{Latitude: p.Lat, Longitude: p.Lng}
+}
+
+func MapPointFromGraphQL(in *model.LocationInput) *Point {
+	if in == nil {
+		return nil
+	}
+	return &Point{Lat: in.Latitude, Lng: in.Longitude}
+}
+
+func MapClientLocationToGraphQL(l ClientLocation) *model.ClientContext {
+	out := &model.ClientContext{
+		Currency: l.Currency(),
+		Source:   model.ClientLocationSource(l.Source),
+	}
+	if l.CountryCode != "" {
+		out.CountryCode = &l.CountryCode
+	}
+	if l.Region != "" {
+		out.Region = &l.Region
+	}
+	return out
+}
@@ -0,0 +1,119 @@
+package geo
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	maxMindURL = "https://geoip.maxmind.com/geoip/v2.1/city/"
+	// Addresses rarely move between countries, so a day-old answer is
+	// still good. The cache is cleared when it fills up.
+	maxMindCacheTTL = 24 * time.Hour
+	maxMindCacheMax = 50000
+)
+
+// MaxMindLocator looks addresses up in MaxMind's GeoIP2 City web service.
+// Answers are cached, and the caller's context bounds each request.
+type MaxMindLocator struct {
+	accountID  string
+	licenseKey string
+	baseURL    string
+	client     *http.Client
+
+	mu      sync.Mutex
+	entries map[string]cachedLocation
+	now     func() time.Time
+}
+
+type cachedLocation struct {
+	loc       ClientLocation
+	expiresAt time.Time
+}
+
+func NewMaxMindLocator(accountID, licenseKey string) *MaxMindLocator {
+	return &MaxMindLocator{
+		accountID:  accountID,
+		licenseKey: licenseKey,
+		baseURL:    maxMindURL,
+		client:     &http.Client{Timeout: 2 * time.Second},
+		entries:    make(map[string]cachedLocation),
+		now:        time.Now,
+	}
+}
+
+type maxMindResponse struct {
+	Country struct {
+		ISOCode string `json:"iso_code"`
+	} `json:"country"`
+	Subdivisions []struct {
+		ISOCode string `json:"iso_code"`
+	} `json:"subdivisions"`
+}
+
+func (m *MaxMindLocator) Locate(ctx context.Context, ip net.IP) (ClientLocation, error) {
+	key := ip.String()
+	if loc, ok := m.cached(key); ok {
+		return loc, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, m.baseURL+key, nil)
+	if err != nil {
+		return ClientLocation{}, err
+	}
+	req.SetBasicAuth(m.accountID, m.licenseKey)
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return ClientLocation{}, err
+	}
+	defer resp.Body.Close()
+
+	// Addresses MaxMind has no data for are not errors; remember them too.
+	loc := ClientLocation{Source: SourceNone}
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+	case resp.StatusCode >= 300:
+		return ClientLocation{}, fmt.Errorf("geo: maxmind returned %s", resp.Status)
+	default:
+		var body maxMindResponse
+		if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+			return ClientLocation{}, fmt.Errorf("geo: decode maxmind response: %w", err)
+		}
+		if country := countryCode(body.Country.ISOCode); country != "" {
+			loc = ClientLocation{CountryCode: country, Source: SourceGeoIP}
+			if len(body.Subdivisions) > 0 {
+				loc.Region = RegionName(country, body.Subdivisions[0].ISOCode)
+			}
+		}
+	}
+
+	m.store(key, loc)
+	return loc, nil
+}
+
+func (m *MaxMindLocator) cached(key string) (ClientLocation, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	e, ok := m.entries[key]
+	if !ok || !m.now().Before(e.expiresAt) {
+		return ClientLocation{}, false
+	}
+	return e.loc, true
+}
+
+func (m *MaxMindLocator) store(key string, loc ClientLocation) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if len(m.entries) >= maxMindCacheMax {
+		m.entries = make(map[string]cachedLocation)
+	}
+	m.entries[key] = cachedLocation{loc: loc, expiresAt: m.now().Add(maxMindCacheTTL)}
+}
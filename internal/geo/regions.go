@@ -0,0 +1,58 @@
+package geo
+
+// provinces maps the ISO 3166-2:ID subdivision codes to province names.
+var provinces = map[string]string{
+	"AC": "Aceh",
+	"SU": "Sumatera Utara",
+	"SB": "Sumatera Barat",
+	"RI": "Riau",
+	"JA": "Jambi",
+	"SS": "Sumatera Selatan",
+	"BE": "Bengkulu",
+	"LA": "Lampung",
+	"BB": "Kepulauan Bangka Belitung",
+	"KR": "Kepulauan Riau",
+	"JK": "DKI Jakarta",
+	"JB": "Jawa Barat",
+	"JT": "Jawa Tengah",
+	"YO": "DI Yogyakarta",
+	"JI": "Jawa Timur",
+	"BT": "Banten",
+	"BA": "Bali",
+	"NB": "Nusa Tenggara Barat",
+	"NT": "Nusa Tenggara Timur",
+	"KB": "Kalimantan Barat",
+	"KT": "Kalimantan Tengah",
+	"KS": "Kalimantan Selatan",
+	"KI": "Kalimantan Timur",
+	"KU": "Kalimantan Utara",
+	"SA": "Sulawesi Utara",
+	"ST": "Sulawesi Tengah",
+	"SN": "Sulawesi Selatan",
+	"SG": "Sulawesi Tenggara",
+	"GO": "Gorontalo",
+	"SR": "Sulawesi Barat",
+	"ML": "Maluku",
+	"MU": "Maluku Utara",
+	"PA": "Papua",
+	"PB": "Papua Barat",
+	"PD": "Papua Barat Daya",
+	"PE": "Papua Pegunungan",
+	"PS": "Papua Selatan",
+	"PT": "Papua Tengah",
+}
+
+// currencies lists the display currency of the countries most visitors
+// come from; the rest are shown DefaultCurrency.
+var currencies = map[string]string{
+	"ID": "IDR",
+	"SG": "SGD",
+	"MY": "MYR",
+	"BN": "BND",
+	"TH": "THB",
+	"PH": "PHP",
+	"VN": "VND",
+	"AU": "AUD",
+	"JP": "JPY",
+	"US": "USD",
+}
@@ -0,0 +1,56 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"warimas-be/internal/accounting"
+	"warimas-be/internal/graph/model"
+	"warimas-be/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// RecordSettlementFees is the resolver for the recordSettlementFees field.
+func (r *mutationResolver) RecordSettlementFees(ctx context.Context, fees []*model.SettlementFeeInput) (int32, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "RecordSettlementFees"),
+	)
+
+	in := make([]*accounting.SettlementFee, 0, len(fees))
+	for _, f := range fees {
+		in = append(in, &accounting.SettlementFee{
+			PaymentRequestID: f.PaymentRequestID,
+			Fee:              int64(f.Fee),
+		})
+	}
+
+	n, err := r.AccountingSvc.RecordSettlementFees(ctx, in)
+	if err != nil {
+		log.Error("failed to record settlement fees", zap.Error(err))
+		return 0, err
+	}
+
+	return int32(n), nil
+}
+
+// JournalExport is the resolver for the journalExport field.
+func (r *queryResolver) JournalExport(ctx context.Context, from string, to string) (*model.JournalExport, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "JournalExport"),
+		zap.String("from", from),
+		zap.String("to", to),
+	)
+
+	export, err := r.AccountingSvc.Export(ctx, from, to)
+	if err != nil {
+		log.Error("failed to export journal", zap.Error(err))
+		return nil, err
+	}
+
+	return accounting.MapExportToGraphQL(export), nil
+}
@@ -39,7 +39,7 @@ func (ec *executionContext) _Address_id(ctx context.Context, field graphql.Colle
 			return obj.ID, nil
 		},
 		nil,
-		ec.marshalNID2string,
+		ec.marshalNUUID2string,
 		true,
 		true,
 	)
@@ -52,7 +52,7 @@ func (ec *executionContext) fieldContext_Address_id(_ context.Context, field gra
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type UUID does not have child fields")
 		},
 	}
 	return fc, nil
@@ -319,6 +319,41 @@ func (ec *executionContext) fieldContext_Address_country(_ context.Context, fiel
 	return fc, nil
 }
 
+func (ec *executionContext) _Address_location(ctx context.Context, field graphql.CollectedField, obj *model.Address) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Address_location,
+		func(ctx context.Context) (any, error) {
+			return obj.Location, nil
+		},
+		nil,
+		ec.marshalOLocation2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐLocation,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Address_location(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Address",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "latitude":
+				return ec.fieldContext_Location_latitude(ctx, field)
+			case "longitude":
+				return ec.fieldContext_Location_longitude(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Location", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Address_isDefault(ctx context.Context, field graphql.CollectedField, obj *model.Address) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -392,6 +427,8 @@ func (ec *executionContext) fieldContext_CreateAddressResponse_address(_ context
 				return ec.fieldContext_Address_postalCode(ctx, field)
 			case "country":
 				return ec.fieldContext_Address_country(ctx, field)
+			case "location":
+				return ec.fieldContext_Address_location(ctx, field)
 			case "isDefault":
 				return ec.fieldContext_Address_isDefault(ctx, field)
 			}
@@ -474,6 +511,8 @@ func (ec *executionContext) fieldContext_UpdateAddressResponse_address(_ context
 				return ec.fieldContext_Address_postalCode(ctx, field)
 			case "country":
 				return ec.fieldContext_Address_country(ctx, field)
+			case "location":
+				return ec.fieldContext_Address_location(ctx, field)
 			case "isDefault":
 				return ec.fieldContext_Address_isDefault(ctx, field)
 			}
@@ -494,7 +533,7 @@ func (ec *executionContext) unmarshalInputAddressInput(ctx context.Context, obj
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"name", "receiverName", "phone", "addressLine1", "addressLine2", "city", "province", "postalCode", "country"}
+	fieldsInOrder := [...]string{"name", "receiverName", "phone", "addressLine1", "addressLine2", "city", "province", "postalCode", "country", "location"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -564,6 +603,13 @@ func (ec *executionContext) unmarshalInputAddressInput(ctx context.Context, obj
 				return it, err
 			}
 			it.Country = data
+		case "location":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("location"))
+			data, err := ec.unmarshalOLocationInput2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐLocationInput(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Location = data
 		}
 	}
 
@@ -624,7 +670,7 @@ func (ec *executionContext) unmarshalInputDeleteAddressInput(ctx context.Context
 		switch k {
 		case "addressId":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("addressId"))
-			data, err := ec.unmarshalNID2string(ctx, v)
+			data, err := ec.unmarshalNUUID2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
@@ -655,7 +701,7 @@ func (ec *executionContext) unmarshalInputUpdateAddressInput(ctx context.Context
 		switch k {
 		case "addressId":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("addressId"))
-			data, err := ec.unmarshalNID2string(ctx, v)
+			data, err := ec.unmarshalNUUID2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
@@ -746,6 +792,8 @@ func (ec *executionContext) _Address(ctx context.Context, sel ast.SelectionSet,
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "location":
+			out.Values[i] = ec._Address_location(ctx, field, obj)
 		case "isDefault":
 			out.Values[i] = ec._Address_isDefault(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"warimas-be/internal/address"
+	"warimas-be/internal/geo"
 	"warimas-be/internal/graph/model"
 	"warimas-be/internal/logger"
 	"warimas-be/internal/utils"
@@ -20,7 +21,7 @@ import (
 func (r *mutationResolver) CreateAddress(ctx context.Context, input model.CreateAddressInput) (*model.CreateAddressResponse, error) {
 	userID, ok := utils.GetUserIDFromContext(ctx)
 	if !ok {
-		return nil, errors.New("unauthorized")
+		return nil, errUnauthorized
 	}
 
 	log := logger.FromCtx(ctx).With(
@@ -49,6 +50,7 @@ func (r *mutationResolver) CreateAddress(ctx context.Context, input model.Create
 		Province:     input.Address.Province,
 		PostalCode:   input.Address.PostalCode,
 		Country:      input.Address.Country,
+		Location:     geo.MapPointFromGraphQL(input.Address.Location),
 		SetAsDefault: setAsDefault,
 	}
 
@@ -69,7 +71,7 @@ func (r *mutationResolver) CreateAddress(ctx context.Context, input model.Create
 func (r *mutationResolver) UpdateAddress(ctx context.Context, input model.UpdateAddressInput) (*model.UpdateAddressResponse, error) {
 	userID, ok := utils.GetUserIDFromContext(ctx)
 	if !ok {
-		return nil, errors.New("unauthorized")
+		return nil, errUnauthorized
 	}
 
 	log := logger.FromCtx(ctx).With(
@@ -98,6 +100,7 @@ func (r *mutationResolver) UpdateAddress(ctx context.Context, input model.Update
 		Province:     input.Address.Province,
 		PostalCode:   input.Address.PostalCode,
 		Country:      input.Address.Country,
+		Location:     geo.MapPointFromGraphQL(input.Address.Location),
 		SetAsDefault: setAsDefault,
 	}
 
@@ -118,7 +121,7 @@ func (r *mutationResolver) UpdateAddress(ctx context.Context, input model.Update
 func (r *mutationResolver) DeleteAddress(ctx context.Context, input model.DeleteAddressInput) (*model.DeleteAddressResponse, error) {
 	userID, ok := utils.GetUserIDFromContext(ctx)
 	if !ok {
-		return nil, errors.New("unauthorized")
+		return nil, errUnauthorized
 	}
 
 	log := logger.FromCtx(ctx).With(
@@ -150,7 +153,7 @@ func (r *mutationResolver) DeleteAddress(ctx context.Context, input model.Delete
 func (r *mutationResolver) SetDefaultAddress(ctx context.Context, addressID string) (bool, error) {
 	userID, ok := utils.GetUserIDFromContext(ctx)
 	if !ok {
-		return false, errors.New("unauthorized")
+		return false, errUnauthorized
 	}
 	log := logger.FromCtx(ctx).With(
 		zap.String("layer", "resolver"),
@@ -178,7 +181,7 @@ func (r *mutationResolver) SetDefaultAddress(ctx context.Context, addressID stri
 func (r *queryResolver) Addresses(ctx context.Context) ([]*model.Address, error) {
 	userID, ok := utils.GetUserIDFromContext(ctx)
 	if !ok {
-		return nil, errors.New("unauthorized")
+		return nil, errUnauthorized
 	}
 
 	log := logger.FromCtx(ctx).With(
@@ -210,7 +213,7 @@ func (r *queryResolver) Addresses(ctx context.Context) ([]*model.Address, error)
 func (r *queryResolver) Address(ctx context.Context, addressID string) (*model.Address, error) {
 	userID, ok := utils.GetUserIDFromContext(ctx)
 	if !ok {
-		return nil, errors.New("unauthorized")
+		return nil, errUnauthorized
 	}
 
 	log := logger.FromCtx(ctx).With(
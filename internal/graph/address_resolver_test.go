@@ -45,6 +45,30 @@ func (m *MockAddressService) SetDefaultAddress(ctx context.Context, id uuid.UUID
 	return args.Error(0)
 }
 
+func (m *MockAddressService) GetOwned(ctx context.Context, id uuid.UUID, userID uint) (*address.Address, error) {
+	args := m.Called(ctx, id, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*address.Address), args.Error(1)
+}
+
+func (m *MockAddressService) GetByID(ctx context.Context, id uuid.UUID) (*address.Address, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*address.Address), args.Error(1)
+}
+
+func (m *MockAddressService) GetByIDs(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]*address.Address, error) {
+	args := m.Called(ctx, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[uuid.UUID]*address.Address), args.Error(1)
+}
+
 func (m *MockAddressService) List(ctx context.Context) ([]*address.Address, error) {
 	args := m.Called(ctx)
 	if args.Get(0) == nil {
@@ -114,7 +138,7 @@ func TestMutationResolver_CreateAddress(t *testing.T) {
 
 		_, err := mr.CreateAddress(context.Background(), model.CreateAddressInput{})
 		assert.Error(t, err)
-		assert.Equal(t, "unauthorized", err.Error())
+		assert.ErrorIs(t, err, errUnauthorized)
 	})
 
 	t.Run("NilInput", func(t *testing.T) {
@@ -179,7 +203,7 @@ func TestMutationResolver_UpdateAddress(t *testing.T) {
 
 		_, err := mr.UpdateAddress(context.Background(), model.UpdateAddressInput{})
 		assert.Error(t, err)
-		assert.Equal(t, "unauthorized", err.Error())
+		assert.ErrorIs(t, err, errUnauthorized)
 	})
 
 	t.Run("NilInput", func(t *testing.T) {
@@ -230,7 +254,7 @@ func TestMutationResolver_DeleteAddress(t *testing.T) {
 
 		_, err := mr.DeleteAddress(context.Background(), model.DeleteAddressInput{})
 		assert.Error(t, err)
-		assert.Equal(t, "unauthorized", err.Error())
+		assert.ErrorIs(t, err, errUnauthorized)
 	})
 
 	t.Run("InvalidID", func(t *testing.T) {
@@ -279,7 +303,7 @@ func TestMutationResolver_SetDefaultAddress(t *testing.T) {
 
 		_, err := mr.SetDefaultAddress(context.Background(), "some-id")
 		assert.Error(t, err)
-		assert.Equal(t, "unauthorized", err.Error())
+		assert.ErrorIs(t, err, errUnauthorized)
 	})
 
 	t.Run("InvalidID", func(t *testing.T) {
@@ -329,7 +353,7 @@ func TestQueryResolver_Addresses(t *testing.T) {
 		qr := &queryResolver{resolver}
 		_, err := qr.Addresses(context.Background())
 		assert.Error(t, err)
-		assert.Equal(t, "unauthorized", err.Error())
+		assert.ErrorIs(t, err, errUnauthorized)
 	})
 
 	t.Run("ServiceError", func(t *testing.T) {
@@ -368,7 +392,7 @@ func TestQueryResolver_Address(t *testing.T) {
 		qr := &queryResolver{resolver}
 		_, err := qr.Address(context.Background(), "some-id")
 		assert.Error(t, err)
-		assert.Equal(t, "unauthorized", err.Error())
+		assert.ErrorIs(t, err, errUnauthorized)
 	})
 
 	t.Run("InvalidID", func(t *testing.T) {
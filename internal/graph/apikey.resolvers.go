@@ -0,0 +1,86 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"time"
+	"warimas-be/internal/apikey"
+	"warimas-be/internal/graph/model"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+// CreateAPIKey is the resolver for the createApiKey field.
+func (r *mutationResolver) CreateAPIKey(ctx context.Context, input model.CreateAPIKeyInput) (*model.CreateAPIKeyResponse, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "CreateAPIKey"),
+	)
+
+	in := apikey.CreateInput{Name: input.Name}
+	for _, s := range input.Scopes {
+		in.Scopes = append(in.Scopes, apikey.Scope(s))
+	}
+	if input.ExpiresInDays != nil {
+		in.ExpiresIn = time.Duration(*input.ExpiresInDays) * 24 * time.Hour
+	}
+
+	created, err := r.APIKeySvc.Create(ctx, in)
+	if err != nil {
+		log.Error("failed to create api key", zap.Error(err))
+		return nil, err
+	}
+
+	return &model.CreateAPIKeyResponse{
+		APIKey: apikey.MapKeyToGraphQL(created.Key),
+		Secret: created.Secret,
+	}, nil
+}
+
+// RevokeAPIKey is the resolver for the revokeApiKey field.
+func (r *mutationResolver) RevokeAPIKey(ctx context.Context, id string) (*model.APIKey, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "RevokeAPIKey"),
+		zap.String("api_key_id", id),
+	)
+
+	keyID, err := utils.ToUint(id)
+	if err != nil {
+		log.Warn("invalid api key id", zap.Error(err))
+		return nil, err
+	}
+
+	k, err := r.APIKeySvc.Revoke(ctx, int64(keyID))
+	if err != nil {
+		log.Error("failed to revoke api key", zap.Error(err))
+		return nil, err
+	}
+
+	return apikey.MapKeyToGraphQL(k), nil
+}
+
+// APIKeys is the resolver for the apiKeys field.
+func (r *queryResolver) APIKeys(ctx context.Context, includeRevoked *bool) ([]*model.APIKey, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "APIKeys"),
+	)
+
+	keys, err := r.APIKeySvc.List(ctx, includeRevoked != nil && *includeRevoked)
+	if err != nil {
+		log.Error("failed to list api keys", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]*model.APIKey, 0, len(keys))
+	for _, k := range keys {
+		out = append(out, apikey.MapKeyToGraphQL(k))
+	}
+	return out, nil
+}
@@ -130,6 +130,94 @@ func (ec *executionContext) fieldContext_AddToCartResponse_cartItem(_ context.Co
 	return fc, nil
 }
 
+func (ec *executionContext) _CartConnection_items(ctx context.Context, field graphql.CollectedField, obj *model.CartConnection) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CartConnection_items,
+		func(ctx context.Context) (any, error) {
+			return obj.Items, nil
+		},
+		nil,
+		ec.marshalNCartItem2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCartItemᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CartConnection_items(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CartConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_CartItem_id(ctx, field)
+			case "userId":
+				return ec.fieldContext_CartItem_userId(ctx, field)
+			case "quantity":
+				return ec.fieldContext_CartItem_quantity(ctx, field)
+			case "product":
+				return ec.fieldContext_CartItem_product(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_CartItem_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_CartItem_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CartItem", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _CartConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.CartConnection) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_CartConnection_pageInfo,
+		func(ctx context.Context) (any, error) {
+			return obj.PageInfo, nil
+		},
+		nil,
+		ec.marshalNPageInfo2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐPageInfo,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_CartConnection_pageInfo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "CartConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "totalItems":
+				return ec.fieldContext_PageInfo_totalItems(ctx, field)
+			case "totalPages":
+				return ec.fieldContext_PageInfo_totalPages(ctx, field)
+			case "page":
+				return ec.fieldContext_PageInfo_page(ctx, field)
+			case "limit":
+				return ec.fieldContext_PageInfo_limit(ctx, field)
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "hasPreviousPage":
+				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _CartItem_id(ctx context.Context, field graphql.CollectedField, obj *model.CartItem) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -288,7 +376,7 @@ func (ec *executionContext) _CartItem_createdAt(ctx context.Context, field graph
 			return obj.CreatedAt, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNTime2timeᚐTime,
 		true,
 		true,
 	)
@@ -301,7 +389,7 @@ func (ec *executionContext) fieldContext_CartItem_createdAt(_ context.Context, f
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
@@ -317,7 +405,7 @@ func (ec *executionContext) _CartItem_updatedAt(ctx context.Context, field graph
 			return obj.UpdatedAt, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNTime2timeᚐTime,
 		true,
 		true,
 	)
@@ -330,93 +418,7 @@ func (ec *executionContext) fieldContext_CartItem_updatedAt(_ context.Context, f
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _CartListResponse_items(ctx context.Context, field graphql.CollectedField, obj *model.CartListResponse) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_CartListResponse_items,
-		func(ctx context.Context) (any, error) {
-			return obj.Items, nil
-		},
-		nil,
-		ec.marshalNCartItem2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCartItem,
-		true,
-		true,
-	)
-}
-
-func (ec *executionContext) fieldContext_CartListResponse_items(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "CartListResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_CartItem_id(ctx, field)
-			case "userId":
-				return ec.fieldContext_CartItem_userId(ctx, field)
-			case "quantity":
-				return ec.fieldContext_CartItem_quantity(ctx, field)
-			case "product":
-				return ec.fieldContext_CartItem_product(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_CartItem_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_CartItem_updatedAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type CartItem", field.Name)
-		},
-	}
-	return fc, nil
-}
-
-func (ec *executionContext) _CartListResponse_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.CartListResponse) (ret graphql.Marshaler) {
-	return graphql.ResolveField(
-		ctx,
-		ec.OperationContext,
-		field,
-		ec.fieldContext_CartListResponse_pageInfo,
-		func(ctx context.Context) (any, error) {
-			return obj.PageInfo, nil
-		},
-		nil,
-		ec.marshalNPageInfo2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐPageInfo,
-		true,
-		true,
-	)
-}
-
-func (ec *executionContext) fieldContext_CartListResponse_pageInfo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
-	fc = &graphql.FieldContext{
-		Object:     "CartListResponse",
-		Field:      field,
-		IsMethod:   false,
-		IsResolver: false,
-		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "totalItems":
-				return ec.fieldContext_PageInfo_totalItems(ctx, field)
-			case "totalPages":
-				return ec.fieldContext_PageInfo_totalPages(ctx, field)
-			case "page":
-				return ec.fieldContext_PageInfo_page(ctx, field)
-			case "limit":
-				return ec.fieldContext_PageInfo_limit(ctx, field)
-			case "hasNextPage":
-				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
-			case "hasPreviousPage":
-				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
@@ -442,7 +444,7 @@ func (ec *executionContext) unmarshalInputAddToCartInput(ctx context.Context, ob
 		switch k {
 		case "variantId":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("variantId"))
-			data, err := ec.unmarshalNID2string(ctx, v)
+			data, err := ec.unmarshalNUUID2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
@@ -548,7 +550,7 @@ func (ec *executionContext) unmarshalInputUpdateCartInput(ctx context.Context, o
 		switch k {
 		case "variantId":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("variantId"))
-			data, err := ec.unmarshalNID2string(ctx, v)
+			data, err := ec.unmarshalNUUID2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
@@ -617,44 +619,24 @@ func (ec *executionContext) _AddToCartResponse(ctx context.Context, sel ast.Sele
 	return out
 }
 
-var cartItemImplementors = []string{"CartItem"}
+var cartConnectionImplementors = []string{"CartConnection"}
 
-func (ec *executionContext) _CartItem(ctx context.Context, sel ast.SelectionSet, obj *model.CartItem) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, cartItemImplementors)
+func (ec *executionContext) _CartConnection(ctx context.Context, sel ast.SelectionSet, obj *model.CartConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, cartConnectionImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("CartItem")
-		case "id":
-			out.Values[i] = ec._CartItem_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "userId":
-			out.Values[i] = ec._CartItem_userId(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "quantity":
-			out.Values[i] = ec._CartItem_quantity(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "product":
-			out.Values[i] = ec._CartItem_product(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "createdAt":
-			out.Values[i] = ec._CartItem_createdAt(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("CartConnection")
+		case "items":
+			out.Values[i] = ec._CartConnection_items(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "updatedAt":
-			out.Values[i] = ec._CartItem_updatedAt(ctx, field, obj)
+		case "pageInfo":
+			out.Values[i] = ec._CartConnection_pageInfo(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -681,24 +663,44 @@ func (ec *executionContext) _CartItem(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var cartListResponseImplementors = []string{"CartListResponse"}
+var cartItemImplementors = []string{"CartItem"}
 
-func (ec *executionContext) _CartListResponse(ctx context.Context, sel ast.SelectionSet, obj *model.CartListResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, cartListResponseImplementors)
+func (ec *executionContext) _CartItem(ctx context.Context, sel ast.SelectionSet, obj *model.CartItem) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, cartItemImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("CartListResponse")
-		case "items":
-			out.Values[i] = ec._CartListResponse_items(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("CartItem")
+		case "id":
+			out.Values[i] = ec._CartItem_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "pageInfo":
-			out.Values[i] = ec._CartListResponse_pageInfo(ctx, field, obj)
+		case "userId":
+			out.Values[i] = ec._CartItem_userId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "quantity":
+			out.Values[i] = ec._CartItem_quantity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "product":
+			out.Values[i] = ec._CartItem_product(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createdAt":
+			out.Values[i] = ec._CartItem_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updatedAt":
+			out.Values[i] = ec._CartItem_updatedAt(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -748,7 +750,7 @@ func (ec *executionContext) marshalNAddToCartResponse2ᚖwarimasᚑbeᚋinternal
 	return ec._AddToCartResponse(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNCartItem2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCartItem(ctx context.Context, sel ast.SelectionSet, v []*model.CartItem) graphql.Marshaler {
+func (ec *executionContext) marshalNCartItem2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCartItemᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.CartItem) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -772,7 +774,7 @@ func (ec *executionContext) marshalNCartItem2ᚕᚖwarimasᚑbeᚋinternalᚋgra
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalOCartItem2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCartItem(ctx, sel, v[i])
+			ret[i] = ec.marshalNCartItem2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCartItem(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -783,9 +785,25 @@ func (ec *executionContext) marshalNCartItem2ᚕᚖwarimasᚑbeᚋinternalᚋgra
 	}
 	wg.Wait()
 
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
 	return ret
 }
 
+func (ec *executionContext) marshalNCartItem2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCartItem(ctx context.Context, sel ast.SelectionSet, v *model.CartItem) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._CartItem(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNCartSortField2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐCartSortField(ctx context.Context, v any) (model.CartSortField, error) {
 	var res model.CartSortField
 	err := res.UnmarshalGQL(v)
@@ -801,6 +819,13 @@ func (ec *executionContext) unmarshalNUpdateCartInput2warimasᚑbeᚋinternalᚋ
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) marshalOCartConnection2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCartConnection(ctx context.Context, sel ast.SelectionSet, v *model.CartConnection) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._CartConnection(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOCartFilterInput2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCartFilterInput(ctx context.Context, v any) (*model.CartFilterInput, error) {
 	if v == nil {
 		return nil, nil
@@ -816,13 +841,6 @@ func (ec *executionContext) marshalOCartItem2ᚖwarimasᚑbeᚋinternalᚋgraph
 	return ec._CartItem(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalOCartListResponse2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCartListResponse(ctx context.Context, sel ast.SelectionSet, v *model.CartListResponse) graphql.Marshaler {
-	if v == nil {
-		return graphql.Null
-	}
-	return ec._CartListResponse(ctx, sel, v)
-}
-
 func (ec *executionContext) unmarshalOCartSortInput2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCartSortInput(ctx context.Context, v any) (*model.CartSortInput, error) {
 	if v == nil {
 		return nil, nil
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"math"
 	"time"
+	"warimas-be/internal/analytics"
 	"warimas-be/internal/cart"
 	"warimas-be/internal/graph/model"
 	"warimas-be/internal/logger"
@@ -70,14 +71,29 @@ func (r *mutationResolver) AddToCart(ctx context.Context, input model.AddToCartI
 		zap.Duration("duration", time.Since(start)),
 	)
 
+	event := analytics.Event{
+		Type:      analytics.EventAddToCart,
+		VariantID: input.VariantID,
+		Quantity:  int(input.Quantity),
+	}
+	if cartItem.Product != nil {
+		event.ProductID = cartItem.Product.ID
+	}
+	analytics.Track(ctx, event)
+
+	updatedAt := cartItem.CreatedAt
+	if cartItem.UpdatedAt != nil {
+		updatedAt = *cartItem.UpdatedAt
+	}
+
 	return &model.AddToCartResponse{
 		Success: true,
 		CartItem: &model.CartItem{
 			ID:        cartItem.ID,
 			UserID:    int32(cartItem.UserID),
 			Quantity:  cartItem.Quantity,
-			CreatedAt: cartItem.CreatedAt.Format(time.RFC3339),
-			UpdatedAt: cartItem.UpdatedAt.Format(time.RFC3339),
+			CreatedAt: cartItem.CreatedAt,
+			UpdatedAt: updatedAt,
 		},
 	}, nil
 }
@@ -176,7 +192,7 @@ func (r *mutationResolver) RemoveFromCart(ctx context.Context, variantIds []stri
 }
 
 // Get all items in my cart
-func (r *queryResolver) MyCart(ctx context.Context, filter *model.CartFilterInput, sort *model.CartSortInput, limit *int32, page *int32) (*model.CartListResponse, error) {
+func (r *queryResolver) MyCart(ctx context.Context, filter *model.CartFilterInput, sort *model.CartSortInput, limit *int32, page *int32, after *string) (*model.CartConnection, error) {
 	log := logger.FromCtx(ctx).With(
 		zap.String("layer", "resolver"),
 		zap.String("method", "MyCart"),
@@ -187,7 +203,7 @@ func (r *queryResolver) MyCart(ctx context.Context, filter *model.CartFilterInpu
 	userID, ok := utils.GetUserIDFromContext(ctx)
 	if !ok {
 		log.Warn("unauthorized access: user id not found in context")
-		return nil, errors.New("unauthorized")
+		return nil, errUnauthorized
 	}
 
 	const (
@@ -223,6 +239,13 @@ func (r *queryResolver) MyCart(ctx context.Context, filter *model.CartFilterInpu
 		p = uint16(*page)
 	}
 
+	np, err := pageAfter(after, int32(p), int32(l))
+	if err != nil || np > int32(math.MaxUint16) {
+		log.Warn("invalid cursor", zap.Error(err))
+		return nil, errInvalidCursor
+	}
+	p = uint16(np)
+
 	log.Debug("resolved cart query params",
 		zap.Uint("user_id", userID),
 		zap.Uint16("limit", l),
@@ -256,18 +279,9 @@ func (r *queryResolver) MyCart(ctx context.Context, filter *model.CartFilterInpu
 
 	cartData := cart.MapCartItemToGraphQL(cartResult)
 
-	totalPages := int32((total + int64(l) - 1) / int64(l))
-
-	return &model.CartListResponse{
-		Items: cartData,
-		PageInfo: &model.PageInfo{
-			TotalItems:      int32(total),
-			TotalPages:      totalPages,
-			Page:            int32(p),
-			Limit:           int32(l),
-			HasNextPage:     int32(p) < totalPages,
-			HasPreviousPage: p > 1,
-		},
+	return &model.CartConnection{
+		Items:    cartData,
+		PageInfo: newPageInfo(total, int32(p), int32(l)),
 	}, nil
 }
 
@@ -281,7 +295,7 @@ func (r *queryResolver) MyCartCount(ctx context.Context) (int32, error) {
 	userID, ok := utils.GetUserIDFromContext(ctx)
 	if !ok {
 		log.Warn("unauthorized access")
-		return 0, errors.New("unauthorized")
+		return 0, errUnauthorized
 	}
 
 	count, err := r.CartSvc.GetCartCount(ctx, userID)
@@ -266,7 +266,7 @@ func TestQueryResolver_MyCart(t *testing.T) {
 		mockSvc.On("GetCart", ctx, uint(1), (*model.CartFilterInput)(nil), (*model.CartSortInput)(nil), mock.Anything, mock.Anything).
 			Return(expectedItems, int64(1), nil)
 
-		res, err := qr.MyCart(ctx, nil, nil, nil, nil)
+		res, err := qr.MyCart(ctx, nil, nil, nil, nil, nil)
 		assert.NoError(t, err)
 		assert.Len(t, res.Items, 1)
 		mockSvc.AssertExpectations(t)
@@ -277,9 +277,9 @@ func TestQueryResolver_MyCart(t *testing.T) {
 		resolver := &Resolver{CartSvc: mockSvc}
 		qr := &queryResolver{resolver}
 
-		_, err := qr.MyCart(context.Background(), nil, nil, nil, nil)
+		_, err := qr.MyCart(context.Background(), nil, nil, nil, nil, nil)
 		assert.Error(t, err)
-		assert.Equal(t, "unauthorized", err.Error())
+		assert.ErrorIs(t, err, errUnauthorized)
 	})
 
 	t.Run("ServiceError", func(t *testing.T) {
@@ -292,7 +292,7 @@ func TestQueryResolver_MyCart(t *testing.T) {
 		mockSvc.On("GetCart", ctx, uint(1), mock.Anything, mock.Anything, mock.Anything, mock.Anything).
 			Return(nil, int64(0), errors.New("db error"))
 
-		_, err := qr.MyCart(ctx, nil, nil, nil, nil)
+		_, err := qr.MyCart(ctx, nil, nil, nil, nil, nil)
 		assert.Error(t, err)
 		assert.Equal(t, "failed to fetch cart items", err.Error())
 	})
@@ -305,7 +305,7 @@ func TestQueryResolver_MyCart(t *testing.T) {
 		ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "user")
 		limit := int32(0)
 
-		_, err := qr.MyCart(ctx, nil, nil, &limit, nil)
+		_, err := qr.MyCart(ctx, nil, nil, &limit, nil, nil)
 		assert.Error(t, err)
 		assert.Equal(t, "limit must be greater than 0", err.Error())
 	})
@@ -318,7 +318,7 @@ func TestQueryResolver_MyCart(t *testing.T) {
 		ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "user")
 		limit := int32(70000) // > 65535 (MaxUint16)
 
-		_, err := qr.MyCart(ctx, nil, nil, &limit, nil)
+		_, err := qr.MyCart(ctx, nil, nil, &limit, nil, nil)
 		assert.Error(t, err)
 		assert.Equal(t, "limit too large", err.Error())
 	})
@@ -347,7 +347,7 @@ func TestQueryResolver_MyCartCount(t *testing.T) {
 
 		_, err := qr.MyCartCount(context.Background())
 		assert.Error(t, err)
-		assert.Equal(t, "unauthorized", err.Error())
+		assert.ErrorIs(t, err, errUnauthorized)
 	})
 
 	t.Run("ServiceError", func(t *testing.T) {
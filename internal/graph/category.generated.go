@@ -39,7 +39,7 @@ func (ec *executionContext) _Category_id(ctx context.Context, field graphql.Coll
 			return obj.ID, nil
 		},
 		nil,
-		ec.marshalNID2string,
+		ec.marshalNUUID2string,
 		true,
 		true,
 	)
@@ -52,7 +52,7 @@ func (ec *executionContext) fieldContext_Category_id(_ context.Context, field gr
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type UUID does not have child fields")
 		},
 	}
 	return fc, nil
@@ -153,12 +153,12 @@ func (ec *executionContext) fieldContext_Category_subcategories(_ context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _CategoryPage_items(ctx context.Context, field graphql.CollectedField, obj *model.CategoryPage) (ret graphql.Marshaler) {
+func (ec *executionContext) _CategoryConnection_items(ctx context.Context, field graphql.CollectedField, obj *model.CategoryConnection) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CategoryPage_items,
+		ec.fieldContext_CategoryConnection_items,
 		func(ctx context.Context) (any, error) {
 			return obj.Items, nil
 		},
@@ -169,9 +169,9 @@ func (ec *executionContext) _CategoryPage_items(ctx context.Context, field graph
 	)
 }
 
-func (ec *executionContext) fieldContext_CategoryPage_items(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CategoryConnection_items(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CategoryPage",
+		Object:     "CategoryConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -192,12 +192,12 @@ func (ec *executionContext) fieldContext_CategoryPage_items(_ context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _CategoryPage_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.CategoryPage) (ret graphql.Marshaler) {
+func (ec *executionContext) _CategoryConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.CategoryConnection) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CategoryPage_pageInfo,
+		ec.fieldContext_CategoryConnection_pageInfo,
 		func(ctx context.Context) (any, error) {
 			return obj.PageInfo, nil
 		},
@@ -208,9 +208,9 @@ func (ec *executionContext) _CategoryPage_pageInfo(ctx context.Context, field gr
 	)
 }
 
-func (ec *executionContext) fieldContext_CategoryPage_pageInfo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CategoryConnection_pageInfo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CategoryPage",
+		Object:     "CategoryConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -228,6 +228,8 @@ func (ec *executionContext) fieldContext_CategoryPage_pageInfo(_ context.Context
 				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
 			case "hasPreviousPage":
 				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
 		},
@@ -245,7 +247,7 @@ func (ec *executionContext) _Subcategory_id(ctx context.Context, field graphql.C
 			return obj.ID, nil
 		},
 		nil,
-		ec.marshalNID2string,
+		ec.marshalNUUID2string,
 		true,
 		true,
 	)
@@ -258,7 +260,7 @@ func (ec *executionContext) fieldContext_Subcategory_id(_ context.Context, field
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type UUID does not have child fields")
 		},
 	}
 	return fc, nil
@@ -274,7 +276,7 @@ func (ec *executionContext) _Subcategory_categoryID(ctx context.Context, field g
 			return obj.CategoryID, nil
 		},
 		nil,
-		ec.marshalNID2string,
+		ec.marshalNUUID2string,
 		true,
 		true,
 	)
@@ -287,7 +289,7 @@ func (ec *executionContext) fieldContext_Subcategory_categoryID(_ context.Contex
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type UUID does not have child fields")
 		},
 	}
 	return fc, nil
@@ -322,12 +324,12 @@ func (ec *executionContext) fieldContext_Subcategory_name(_ context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _SubcategoryPage_items(ctx context.Context, field graphql.CollectedField, obj *model.SubcategoryPage) (ret graphql.Marshaler) {
+func (ec *executionContext) _SubcategoryConnection_items(ctx context.Context, field graphql.CollectedField, obj *model.SubcategoryConnection) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SubcategoryPage_items,
+		ec.fieldContext_SubcategoryConnection_items,
 		func(ctx context.Context) (any, error) {
 			return obj.Items, nil
 		},
@@ -338,9 +340,9 @@ func (ec *executionContext) _SubcategoryPage_items(ctx context.Context, field gr
 	)
 }
 
-func (ec *executionContext) fieldContext_SubcategoryPage_items(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SubcategoryConnection_items(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SubcategoryPage",
+		Object:     "SubcategoryConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -359,12 +361,12 @@ func (ec *executionContext) fieldContext_SubcategoryPage_items(_ context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) _SubcategoryPage_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.SubcategoryPage) (ret graphql.Marshaler) {
+func (ec *executionContext) _SubcategoryConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.SubcategoryConnection) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_SubcategoryPage_pageInfo,
+		ec.fieldContext_SubcategoryConnection_pageInfo,
 		func(ctx context.Context) (any, error) {
 			return obj.PageInfo, nil
 		},
@@ -375,9 +377,9 @@ func (ec *executionContext) _SubcategoryPage_pageInfo(ctx context.Context, field
 	)
 }
 
-func (ec *executionContext) fieldContext_SubcategoryPage_pageInfo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_SubcategoryConnection_pageInfo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "SubcategoryPage",
+		Object:     "SubcategoryConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -395,6 +397,8 @@ func (ec *executionContext) fieldContext_SubcategoryPage_pageInfo(_ context.Cont
 				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
 			case "hasPreviousPage":
 				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
 		},
@@ -468,24 +472,24 @@ func (ec *executionContext) _Category(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
-var categoryPageImplementors = []string{"CategoryPage"}
+var categoryConnectionImplementors = []string{"CategoryConnection"}
 
-func (ec *executionContext) _CategoryPage(ctx context.Context, sel ast.SelectionSet, obj *model.CategoryPage) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, categoryPageImplementors)
+func (ec *executionContext) _CategoryConnection(ctx context.Context, sel ast.SelectionSet, obj *model.CategoryConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, categoryConnectionImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("CategoryPage")
+			out.Values[i] = graphql.MarshalString("CategoryConnection")
 		case "items":
-			out.Values[i] = ec._CategoryPage_items(ctx, field, obj)
+			out.Values[i] = ec._CategoryConnection_items(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
 		case "pageInfo":
-			out.Values[i] = ec._CategoryPage_pageInfo(ctx, field, obj)
+			out.Values[i] = ec._CategoryConnection_pageInfo(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -561,24 +565,24 @@ func (ec *executionContext) _Subcategory(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
-var subcategoryPageImplementors = []string{"SubcategoryPage"}
+var subcategoryConnectionImplementors = []string{"SubcategoryConnection"}
 
-func (ec *executionContext) _SubcategoryPage(ctx context.Context, sel ast.SelectionSet, obj *model.SubcategoryPage) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, subcategoryPageImplementors)
+func (ec *executionContext) _SubcategoryConnection(ctx context.Context, sel ast.SelectionSet, obj *model.SubcategoryConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, subcategoryConnectionImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("SubcategoryPage")
+			out.Values[i] = graphql.MarshalString("SubcategoryConnection")
 		case "items":
-			out.Values[i] = ec._SubcategoryPage_items(ctx, field, obj)
+			out.Values[i] = ec._SubcategoryConnection_items(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
 		case "pageInfo":
-			out.Values[i] = ec._SubcategoryPage_pageInfo(ctx, field, obj)
+			out.Values[i] = ec._SubcategoryConnection_pageInfo(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -663,18 +667,18 @@ func (ec *executionContext) marshalNCategory2ᚖwarimasᚑbeᚋinternalᚋgraph
 	return ec._Category(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNCategoryPage2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐCategoryPage(ctx context.Context, sel ast.SelectionSet, v model.CategoryPage) graphql.Marshaler {
-	return ec._CategoryPage(ctx, sel, &v)
+func (ec *executionContext) marshalNCategoryConnection2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐCategoryConnection(ctx context.Context, sel ast.SelectionSet, v model.CategoryConnection) graphql.Marshaler {
+	return ec._CategoryConnection(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNCategoryPage2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCategoryPage(ctx context.Context, sel ast.SelectionSet, v *model.CategoryPage) graphql.Marshaler {
+func (ec *executionContext) marshalNCategoryConnection2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCategoryConnection(ctx context.Context, sel ast.SelectionSet, v *model.CategoryConnection) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._CategoryPage(ctx, sel, v)
+	return ec._CategoryConnection(ctx, sel, v)
 }
 
 func (ec *executionContext) marshalNSubcategory2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐSubcategoryᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Subcategory) graphql.Marshaler {
@@ -731,18 +735,18 @@ func (ec *executionContext) marshalNSubcategory2ᚖwarimasᚑbeᚋinternalᚋgra
 	return ec._Subcategory(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNSubcategoryPage2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐSubcategoryPage(ctx context.Context, sel ast.SelectionSet, v model.SubcategoryPage) graphql.Marshaler {
-	return ec._SubcategoryPage(ctx, sel, &v)
+func (ec *executionContext) marshalNSubcategoryConnection2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐSubcategoryConnection(ctx context.Context, sel ast.SelectionSet, v model.SubcategoryConnection) graphql.Marshaler {
+	return ec._SubcategoryConnection(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNSubcategoryPage2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐSubcategoryPage(ctx context.Context, sel ast.SelectionSet, v *model.SubcategoryPage) graphql.Marshaler {
+func (ec *executionContext) marshalNSubcategoryConnection2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐSubcategoryConnection(ctx context.Context, sel ast.SelectionSet, v *model.SubcategoryConnection) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._SubcategoryPage(ctx, sel, v)
+	return ec._SubcategoryConnection(ctx, sel, v)
 }
 
 func (ec *executionContext) marshalOCategory2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCategory(ctx context.Context, sel ast.SelectionSet, v *model.Category) graphql.Marshaler {
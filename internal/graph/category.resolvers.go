@@ -53,14 +53,28 @@ func (r *mutationResolver) AddSubcategory(ctx context.Context, categoryID string
 }
 
 // Category is the resolver for the category field.
-func (r *queryResolver) Category(ctx context.Context, filter *string, limit *int32, page *int32) (*model.CategoryPage, error) {
+func (r *queryResolver) Category(ctx context.Context, filter *string, limit *int32, page *int32, after *string) (*model.CategoryConnection, error) {
 	log := logger.FromCtx(ctx).With(
 		zap.String("layer", "resolver"),
 		zap.String("method", "Category"),
 	)
 	log.Info("resolver started")
 
-	categories, total, err := r.CategorySvc.GetCategories(ctx, filter, limit, page)
+	var l int32 = 20
+	if limit != nil && *limit > 0 {
+		l = *limit
+	}
+	var p int32 = 1
+	if page != nil && *page > 0 {
+		p = *page
+	}
+	p, err := pageAfter(after, p, l)
+	if err != nil {
+		log.Warn("invalid cursor", zap.Error(err))
+		return nil, err
+	}
+
+	categories, total, err := r.CategorySvc.GetCategories(ctx, filter, &l, &p)
 	if err != nil {
 		log.Error("failed to get categories", zap.Error(err))
 		return nil, err
@@ -72,33 +86,15 @@ func (r *queryResolver) Category(ctx context.Context, filter *string, limit *int
 		gqlCategories = append(gqlCategories, category.MapCategoryToGraphQL(c))
 	}
 
-	// Pagination logic
-	var l int32 = 20
-	if limit != nil && *limit > 0 {
-		l = *limit
-	}
-	var p int32 = 1
-	if page != nil && *page > 0 {
-		p = *page
-	}
-	totalPages := int32((total + int64(l) - 1) / int64(l))
-
 	log.Info("resolver success", zap.Int("count", len(gqlCategories)))
-	return &model.CategoryPage{
-		Items: gqlCategories,
-		PageInfo: &model.PageInfo{
-			TotalItems:      int32(total),
-			TotalPages:      totalPages,
-			Page:            p,
-			Limit:           l,
-			HasNextPage:     p < totalPages,
-			HasPreviousPage: p > 1,
-		},
+	return &model.CategoryConnection{
+		Items:    gqlCategories,
+		PageInfo: newPageInfo(total, p, l),
 	}, nil
 }
 
 // Subcategory is the resolver for the subcategory field.
-func (r *queryResolver) Subcategory(ctx context.Context, filter *string, categoryID string, limit *int32, page *int32) (*model.SubcategoryPage, error) {
+func (r *queryResolver) Subcategory(ctx context.Context, filter *string, categoryID string, limit *int32, page *int32, after *string) (*model.SubcategoryConnection, error) {
 	log := logger.FromCtx(ctx).With(
 		zap.String("layer", "resolver"),
 		zap.String("method", "Subcategory"),
@@ -106,7 +102,21 @@ func (r *queryResolver) Subcategory(ctx context.Context, filter *string, categor
 	)
 	log.Info("resolver started")
 
-	subcategories, total, err := r.CategorySvc.GetSubcategories(ctx, categoryID, filter, limit, page)
+	var l int32 = 20
+	if limit != nil && *limit > 0 {
+		l = *limit
+	}
+	var p int32 = 1
+	if page != nil && *page > 0 {
+		p = *page
+	}
+	p, err := pageAfter(after, p, l)
+	if err != nil {
+		log.Warn("invalid cursor", zap.Error(err))
+		return nil, err
+	}
+
+	subcategories, total, err := r.CategorySvc.GetSubcategories(ctx, categoryID, filter, &l, &p)
 	if err != nil {
 		log.Error("failed to get subcategories", zap.Error(err))
 		return nil, err
@@ -118,27 +128,9 @@ func (r *queryResolver) Subcategory(ctx context.Context, filter *string, categor
 		gqlSubcategories = append(gqlSubcategories, category.MapSubcategoriesToGraphQL(s))
 	}
 
-	// Pagination logic
-	var l int32 = 20
-	if limit != nil && *limit > 0 {
-		l = *limit
-	}
-	var p int32 = 1
-	if page != nil && *page > 0 {
-		p = *page
-	}
-	totalPages := int32((total + int64(l) - 1) / int64(l))
-
 	log.Info("resolver success", zap.Int("count", len(gqlSubcategories)))
-	return &model.SubcategoryPage{
-		Items: gqlSubcategories,
-		PageInfo: &model.PageInfo{
-			TotalItems:      int32(total),
-			TotalPages:      totalPages,
-			Page:            p,
-			Limit:           l,
-			HasNextPage:     p < totalPages,
-			HasPreviousPage: p > 1,
-		},
+	return &model.SubcategoryConnection{
+		Items:    gqlSubcategories,
+		PageInfo: newPageInfo(total, p, l),
 	}, nil
 }
@@ -130,9 +130,9 @@ func TestQueryResolver_Category(t *testing.T) {
 		expectedList := []*category.Category{{ID: "1", Name: "Cat 1"}}
 		expectedTotal := int64(1)
 
-		mockSvc.On("GetCategories", ctx, (*string)(nil), (*int32)(nil), (*int32)(nil)).Return(expectedList, expectedTotal, nil)
+		mockSvc.On("GetCategories", ctx, (*string)(nil), int32Ptr(20), int32Ptr(1)).Return(expectedList, expectedTotal, nil)
 
-		res, err := qr.Category(ctx, nil, nil, nil)
+		res, err := qr.Category(ctx, nil, nil, nil, nil)
 
 		assert.NoError(t, err)
 		assert.Equal(t, int32(1), res.PageInfo.TotalItems)
@@ -143,8 +143,8 @@ func TestQueryResolver_Category(t *testing.T) {
 		mockSvc := new(MockCategoryService)
 		resolver := &Resolver{CategorySvc: mockSvc}
 		qr := &queryResolver{resolver}
-		mockSvc.On("GetCategories", context.Background(), (*string)(nil), (*int32)(nil), (*int32)(nil)).Return(nil, int64(0), errors.New("db error"))
-		_, err := qr.Category(context.Background(), nil, nil, nil)
+		mockSvc.On("GetCategories", context.Background(), (*string)(nil), int32Ptr(20), int32Ptr(1)).Return(nil, int64(0), errors.New("db error"))
+		_, err := qr.Category(context.Background(), nil, nil, nil, nil)
 		assert.Error(t, err)
 	})
 }
@@ -160,9 +160,9 @@ func TestQueryResolver_Subcategory(t *testing.T) {
 		expectedList := []*category.Subcategory{{ID: "sub1", Name: "Sub 1", CategoryID: catID}}
 		expectedTotal := int64(1)
 
-		mockSvc.On("GetSubcategories", ctx, catID, (*string)(nil), (*int32)(nil), (*int32)(nil)).Return(expectedList, expectedTotal, nil)
+		mockSvc.On("GetSubcategories", ctx, catID, (*string)(nil), int32Ptr(20), int32Ptr(1)).Return(expectedList, expectedTotal, nil)
 
-		res, err := qr.Subcategory(ctx, nil, catID, nil, nil)
+		res, err := qr.Subcategory(ctx, nil, catID, nil, nil, nil)
 
 		assert.NoError(t, err)
 		assert.Equal(t, int32(1), res.PageInfo.TotalItems)
@@ -173,8 +173,12 @@ func TestQueryResolver_Subcategory(t *testing.T) {
 		mockSvc := new(MockCategoryService)
 		resolver := &Resolver{CategorySvc: mockSvc}
 		qr := &queryResolver{resolver}
-		mockSvc.On("GetSubcategories", context.Background(), "cat1", (*string)(nil), (*int32)(nil), (*int32)(nil)).Return(nil, int64(0), errors.New("db error"))
-		_, err := qr.Subcategory(context.Background(), nil, "cat1", nil, nil)
+		mockSvc.On("GetSubcategories", context.Background(), "cat1", (*string)(nil), int32Ptr(20), int32Ptr(1)).Return(nil, int64(0), errors.New("db error"))
+		_, err := qr.Subcategory(context.Background(), nil, "cat1", nil, nil, nil)
 		assert.Error(t, err)
 	})
 }
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
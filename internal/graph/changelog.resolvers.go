@@ -0,0 +1,72 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"warimas-be/internal/changelog"
+	"warimas-be/internal/graph/model"
+	"warimas-be/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// OrderChanges is the resolver for the orderChanges field.
+func (r *queryResolver) OrderChanges(ctx context.Context, after *string, limit *int32) ([]*model.OrderChange, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "OrderChanges"),
+	)
+
+	var cursor string
+	if after != nil {
+		cursor = *after
+	}
+	var l int32
+	if limit != nil {
+		l = *limit
+	}
+
+	changes, err := r.ChangeLogSvc.OrderChanges(ctx, cursor, l)
+	if err != nil {
+		log.Error("failed to list order changes", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]*model.OrderChange, 0, len(changes))
+	for _, c := range changes {
+		out = append(out, changelog.MapOrderChangeToGraphQL(c))
+	}
+	return out, nil
+}
+
+// ProductChanges is the resolver for the productChanges field.
+func (r *queryResolver) ProductChanges(ctx context.Context, after *string, limit *int32) ([]*model.ProductChange, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "ProductChanges"),
+	)
+
+	var cursor string
+	if after != nil {
+		cursor = *after
+	}
+	var l int32
+	if limit != nil {
+		l = *limit
+	}
+
+	changes, err := r.ChangeLogSvc.ProductChanges(ctx, cursor, l)
+	if err != nil {
+		log.Error("failed to list product changes", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]*model.ProductChange, 0, len(changes))
+	for _, c := range changes {
+		out = append(out, changelog.MapProductChangeToGraphQL(c))
+	}
+	return out, nil
+}
@@ -0,0 +1,16 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"warimas-be/internal/geo"
+	"warimas-be/internal/graph/model"
+)
+
+// ClientContext is the resolver for the clientContext field.
+func (r *queryResolver) ClientContext(ctx context.Context) (*model.ClientContext, error) {
+	return geo.MapClientLocationToGraphQL(geo.FromContext(ctx)), nil
+}
@@ -0,0 +1,103 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"time"
+	"warimas-be/internal/commission"
+	"warimas-be/internal/graph/model"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+// CreateCommissionRate is the resolver for the createCommissionRate field.
+func (r *mutationResolver) CreateCommissionRate(ctx context.Context, input model.CreateCommissionRateInput) (*model.CommissionRate, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "CreateCommissionRate"),
+	)
+
+	in := &commission.CreateRateInput{
+		CategoryID:    input.CategoryID,
+		RateBps:       input.RateBps,
+		EffectiveFrom: input.EffectiveFrom,
+	}
+	if input.FixedFee != nil {
+		in.FixedFee = int64(*input.FixedFee)
+	}
+
+	rate, err := r.CommissionSvc.CreateRate(ctx, in)
+	if err != nil {
+		log.Error("failed to create commission rate", zap.Error(err))
+		return nil, err
+	}
+
+	return commission.MapRateToGraphQL(rate), nil
+}
+
+// DeleteCommissionRate is the resolver for the deleteCommissionRate field.
+func (r *mutationResolver) DeleteCommissionRate(ctx context.Context, id string) (bool, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "DeleteCommissionRate"),
+		zap.String("rate_id", id),
+	)
+
+	rateID, err := utils.ToUint(id)
+	if err != nil {
+		log.Warn("invalid commission rate id", zap.Error(err))
+		return false, err
+	}
+
+	if err := r.CommissionSvc.DeleteRate(ctx, int64(rateID)); err != nil {
+		log.Error("failed to delete commission rate", zap.Error(err))
+		return false, err
+	}
+
+	return true, nil
+}
+
+// CommissionRates is the resolver for the commissionRates field.
+func (r *queryResolver) CommissionRates(ctx context.Context, categoryID *string) ([]*model.CommissionRate, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "CommissionRates"),
+	)
+
+	list, err := r.CommissionSvc.ListRates(ctx, categoryID)
+	if err != nil {
+		log.Error("failed to list commission rates", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]*model.CommissionRate, 0, len(list))
+	for _, rate := range list {
+		out = append(out, commission.MapRateToGraphQL(rate))
+	}
+	return out, nil
+}
+
+// EffectiveCommissionRate is the resolver for the effectiveCommissionRate field.
+func (r *queryResolver) EffectiveCommissionRate(ctx context.Context, categoryID string, at *time.Time) (*model.CommissionRate, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "EffectiveCommissionRate"),
+		zap.String("category_id", categoryID),
+	)
+
+	rate, err := r.CommissionSvc.EffectiveRate(ctx, categoryID, at)
+	if err != nil {
+		log.Error("failed to load commission rate", zap.Error(err))
+		return nil, err
+	}
+	if rate == nil {
+		return nil, nil
+	}
+
+	return commission.MapRateToGraphQL(rate), nil
+}
@@ -0,0 +1,289 @@
+// Code generated by github.com/99designs/gqlgen, DO NOT EDIT.
+
+package graph
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"warimas-be/internal/graph/model"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// region    ************************** generated!.gotpl **************************
+
+// endregion ************************** generated!.gotpl **************************
+
+// region    ***************************** args.gotpl *****************************
+
+// endregion ***************************** args.gotpl *****************************
+
+// region    ************************** directives.gotpl **************************
+
+// endregion ************************** directives.gotpl **************************
+
+// region    **************************** field.gotpl *****************************
+
+func (ec *executionContext) _MarketingConsent_channel(ctx context.Context, field graphql.CollectedField, obj *model.MarketingConsent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_MarketingConsent_channel,
+		func(ctx context.Context) (any, error) {
+			return obj.Channel, nil
+		},
+		nil,
+		ec.marshalNMarketingChannel2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐMarketingChannel,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_MarketingConsent_channel(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MarketingConsent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type MarketingChannel does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MarketingConsent_status(ctx context.Context, field graphql.CollectedField, obj *model.MarketingConsent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_MarketingConsent_status,
+		func(ctx context.Context) (any, error) {
+			return obj.Status, nil
+		},
+		nil,
+		ec.marshalNMarketingConsentStatus2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐMarketingConsentStatus,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_MarketingConsent_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MarketingConsent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type MarketingConsentStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MarketingConsent_subscribedAt(ctx context.Context, field graphql.CollectedField, obj *model.MarketingConsent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_MarketingConsent_subscribedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.SubscribedAt, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_MarketingConsent_subscribedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MarketingConsent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _MarketingConsent_unsubscribedAt(ctx context.Context, field graphql.CollectedField, obj *model.MarketingConsent) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_MarketingConsent_unsubscribedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.UnsubscribedAt, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_MarketingConsent_unsubscribedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "MarketingConsent",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var marketingConsentImplementors = []string{"MarketingConsent"}
+
+func (ec *executionContext) _MarketingConsent(ctx context.Context, sel ast.SelectionSet, obj *model.MarketingConsent) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, marketingConsentImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("MarketingConsent")
+		case "channel":
+			out.Values[i] = ec._MarketingConsent_channel(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "status":
+			out.Values[i] = ec._MarketingConsent_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "subscribedAt":
+			out.Values[i] = ec._MarketingConsent_subscribedAt(ctx, field, obj)
+		case "unsubscribedAt":
+			out.Values[i] = ec._MarketingConsent_unsubscribedAt(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+// endregion **************************** object.gotpl ****************************
+
+// region    ***************************** type.gotpl *****************************
+
+func (ec *executionContext) unmarshalNMarketingChannel2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐMarketingChannel(ctx context.Context, v any) (model.MarketingChannel, error) {
+	var res model.MarketingChannel
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNMarketingChannel2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐMarketingChannel(ctx context.Context, sel ast.SelectionSet, v model.MarketingChannel) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNMarketingConsent2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐMarketingConsent(ctx context.Context, sel ast.SelectionSet, v model.MarketingConsent) graphql.Marshaler {
+	return ec._MarketingConsent(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNMarketingConsent2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐMarketingConsentᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.MarketingConsent) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNMarketingConsent2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐMarketingConsent(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNMarketingConsent2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐMarketingConsent(ctx context.Context, sel ast.SelectionSet, v *model.MarketingConsent) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._MarketingConsent(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNMarketingConsentStatus2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐMarketingConsentStatus(ctx context.Context, v any) (model.MarketingConsentStatus, error) {
+	var res model.MarketingConsentStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNMarketingConsentStatus2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐMarketingConsentStatus(ctx context.Context, sel ast.SelectionSet, v model.MarketingConsentStatus) graphql.Marshaler {
+	return v
+}
+
+// endregion ***************************** type.gotpl *****************************
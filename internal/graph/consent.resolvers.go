@@ -0,0 +1,68 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"warimas-be/internal/consent"
+	"warimas-be/internal/graph/model"
+	"warimas-be/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// SubscribeMarketing is the resolver for the subscribeMarketing field.
+func (r *mutationResolver) SubscribeMarketing(ctx context.Context, channel model.MarketingChannel) (*model.MarketingConsent, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "SubscribeMarketing"),
+		zap.String("channel", string(channel)),
+	)
+
+	c, err := r.ConsentSvc.Subscribe(ctx, consent.Channel(channel))
+	if err != nil {
+		log.Error("failed to subscribe", zap.Error(err))
+		return nil, err
+	}
+
+	return consent.MapConsentToGraphQL(c), nil
+}
+
+// UnsubscribeMarketing is the resolver for the unsubscribeMarketing field.
+func (r *mutationResolver) UnsubscribeMarketing(ctx context.Context, channel model.MarketingChannel) (*model.MarketingConsent, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "UnsubscribeMarketing"),
+		zap.String("channel", string(channel)),
+	)
+
+	c, err := r.ConsentSvc.Unsubscribe(ctx, consent.Channel(channel))
+	if err != nil {
+		log.Error("failed to unsubscribe", zap.Error(err))
+		return nil, err
+	}
+
+	return consent.MapConsentToGraphQL(c), nil
+}
+
+// MyMarketingConsents is the resolver for the myMarketingConsents field.
+func (r *queryResolver) MyMarketingConsents(ctx context.Context) ([]*model.MarketingConsent, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "MyMarketingConsents"),
+	)
+
+	list, err := r.ConsentSvc.GetMyConsents(ctx)
+	if err != nil {
+		log.Error("failed to get marketing consents", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]*model.MarketingConsent, 0, len(list))
+	for _, c := range list {
+		out = append(out, consent.MapConsentToGraphQL(c))
+	}
+	return out, nil
+}
@@ -0,0 +1,56 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"warimas-be/internal/dbhealth"
+	"warimas-be/internal/graph/model"
+	"warimas-be/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// DatabaseHealth is the resolver for the databaseHealth field.
+func (r *queryResolver) DatabaseHealth(ctx context.Context) (*model.DatabaseHealthReport, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "DatabaseHealth"),
+	)
+
+	report, err := r.DBHealthSvc.Latest(ctx)
+	if err != nil {
+		log.Error("failed to load database health report", zap.Error(err))
+		return nil, err
+	}
+
+	return dbhealth.MapReportToGraphQL(report), nil
+}
+
+// SlowQueries is the resolver for the slowQueries field.
+func (r *queryResolver) SlowQueries(ctx context.Context, sortBy *model.SlowQuerySortField, limit *int32) (*model.SlowQueryReport, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "SlowQueries"),
+	)
+
+	sort := dbhealth.SortTotalTime
+	if sortBy != nil {
+		sort = dbhealth.StatementSort(*sortBy)
+	}
+
+	var l int32
+	if limit != nil {
+		l = *limit
+	}
+
+	report, err := r.DBHealthSvc.SlowQueries(ctx, sort, l)
+	if err != nil {
+		log.Error("failed to load slow queries", zap.Error(err))
+		return nil, err
+	}
+
+	return dbhealth.MapStatementReportToGraphQL(report), nil
+}
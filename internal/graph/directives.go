@@ -2,7 +2,7 @@ package graph
 
 import (
 	"context"
-	"errors"
+	"warimas-be/internal/apperr"
 	"warimas-be/internal/graph/model"
 	"warimas-be/internal/utils"
 
@@ -11,9 +11,8 @@ import (
 
 func AuthDirective(ctx context.Context, obj interface{}, next graphql.Resolver, role *model.Role) (res interface{}, err error) {
 
-	userRole, ok := ctx.Value(utils.UserRoleKey).(string)
-	if !ok || userRole == "" {
-		return nil, errors.New("unauthorized")
+	if _, ok := utils.GetUserIDFromContext(ctx); !ok {
+		return nil, errUnauthorized
 	}
 
 	// Convert GraphQL enum to string
@@ -23,8 +22,21 @@ func AuthDirective(ctx context.Context, obj interface{}, next graphql.Resolver,
 	}
 
 	// Role-based access control
-	if requiredRole == "ADMIN" && userRole != "ADMIN" {
-		return nil, errors.New("forbidden: admin only")
+	if requiredRole == utils.RoleAdmin && !utils.IsAdmin(ctx) {
+		return nil, errAdminOnly
+	}
+
+	return next(ctx)
+}
+
+// ScopeDirective implements @scope: only a request authenticated with an
+// API key granted the scope may resolve the field.
+func ScopeDirective(ctx context.Context, obj interface{}, next graphql.Resolver, scope model.APIKeyScope) (res interface{}, err error) {
+	if _, ok := utils.GetServicePrincipalFromContext(ctx); !ok {
+		return nil, errUnauthorized
+	}
+	if !utils.HasScope(ctx, string(scope)) {
+		return nil, apperr.Forbidden("forbidden: missing scope " + string(scope))
 	}
 
 	return next(ctx)
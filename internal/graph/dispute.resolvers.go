@@ -0,0 +1,69 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"warimas-be/internal/dispute"
+	"warimas-be/internal/graph/model"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+// ResolvePaymentDispute is the resolver for the resolvePaymentDispute field.
+func (r *mutationResolver) ResolvePaymentDispute(ctx context.Context, id string, outcome model.DisputeOutcome, note *string) (*model.PaymentDispute, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "ResolvePaymentDispute"),
+		zap.String("dispute_id", id),
+	)
+
+	disputeID, err := utils.ToUint(id)
+	if err != nil {
+		log.Warn("invalid dispute id", zap.Error(err))
+		return nil, err
+	}
+
+	d, err := r.DisputeSvc.Resolve(ctx, int64(disputeID), dispute.Status(outcome), note)
+	if err != nil {
+		log.Error("failed to resolve dispute", zap.Error(err))
+		return nil, err
+	}
+
+	return dispute.MapDisputeToGraphQL(d), nil
+}
+
+// PaymentDisputes is the resolver for the paymentDisputes field.
+func (r *queryResolver) PaymentDisputes(ctx context.Context, status *model.DisputeStatus, limit *int32) ([]*model.PaymentDispute, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "PaymentDisputes"),
+	)
+
+	var st *dispute.Status
+	if status != nil {
+		s := dispute.Status(*status)
+		st = &s
+	}
+
+	var l int32
+	if limit != nil {
+		l = *limit
+	}
+
+	disputes, err := r.DisputeSvc.ListDisputes(ctx, st, l)
+	if err != nil {
+		log.Error("failed to list disputes", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]*model.PaymentDispute, 0, len(disputes))
+	for _, d := range disputes {
+		out = append(out, dispute.MapDisputeToGraphQL(d))
+	}
+	return out, nil
+}
@@ -0,0 +1,93 @@
+package graph
+
+import (
+	"context"
+	"errors"
+
+	"warimas-be/internal/apperr"
+	"warimas-be/internal/errreport"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+var (
+	errUnauthorized  = apperr.Unauthenticated("unauthorized")
+	errLoginRequired = apperr.Unauthenticated("unauthorized: please login first")
+	errAdminOnly     = apperr.Forbidden("forbidden: admin only")
+)
+
+// orderArgs are the field arguments that identify the order or checkout
+// session a failing resolver was working on.
+var orderArgs = []string{"externalId", "orderId", "orderExternalId", "sessionId"}
+
+// Recover is the gqlgen recover func. A panicking resolver fails its own
+// field with an internal error carrying a reference ID instead of writing
+// the panic value to stderr.
+func Recover(ctx context.Context, p any) error {
+	ref := errreport.Panic(ctx, p)
+
+	return &gqlerror.Error{
+		Message: "internal server error",
+		Extensions: map[string]any{
+			"code":         "INTERNAL_SERVER_ERROR",
+			"reference_id": ref,
+		},
+	}
+}
+
+// PresentError is the gqlgen error presenter. Unexpected resolver errors
+// are reported with the failing field and any order it names, and the
+// client gets the reference ID to quote to support.
+func PresentError(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+	if appErr, ok := apperr.As(err); ok {
+		if gqlErr.Extensions == nil {
+			gqlErr.Extensions = map[string]any{}
+		}
+		for k, v := range appErr.Extensions() {
+			gqlErr.Extensions[k] = v
+		}
+	}
+
+	var cause *gqlerror.Error
+	if errors.As(err, &cause) && cause.Err == nil {
+		// Built by gqlgen itself (validation, Recover); nothing to report.
+		return gqlErr
+	}
+	if !errreport.IsUnexpected(err) {
+		return gqlErr
+	}
+
+	ref := errreport.Report(ctx, err, errorTags(ctx))
+	if ref == "" {
+		return gqlErr
+	}
+	if gqlErr.Extensions == nil {
+		gqlErr.Extensions = map[string]any{}
+	}
+	gqlErr.Extensions["reference_id"] = ref
+	return gqlErr
+}
+
+func errorTags(ctx context.Context) map[string]string {
+	tags := map[string]string{}
+	if graphql.HasOperationContext(ctx) {
+		if name := graphql.GetOperationContext(ctx).OperationName; name != "" {
+			tags["operation"] = name
+		}
+	}
+
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return tags
+	}
+	tags["field"] = fc.Path().String()
+	for _, name := range orderArgs {
+		if v, ok := fc.Args[name].(string); ok && v != "" {
+			tags["order"] = v
+			break
+		}
+	}
+	return tags
+}
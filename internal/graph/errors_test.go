@@ -0,0 +1,75 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"warimas-be/internal/apperr"
+	"warimas-be/internal/errreport"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+type recordingReporter struct {
+	events []*errreport.Event
+}
+
+func (r *recordingReporter) Report(_ context.Context, e *errreport.Event) {
+	r.events = append(r.events, e)
+}
+
+func TestPresentError(t *testing.T) {
+	rec := &recordingReporter{}
+	errreport.SetReporter(rec)
+	t.Cleanup(func() { errreport.SetReporter(errreport.NopReporter{}) })
+
+	ctx := graphql.WithFieldContext(context.Background(), &graphql.FieldContext{
+		Field: graphql.CollectedField{Field: &ast.Field{Alias: "orderDetailByExternalId"}},
+		Args:  map[string]any{"externalId": "ORD-1"},
+	})
+
+	t.Run("Domain error is not reported", func(t *testing.T) {
+		gqlErr := PresentError(ctx, errors.New("order not found"))
+
+		assert.Equal(t, "order not found", gqlErr.Message)
+		assert.NotContains(t, gqlErr.Extensions, "reference_id")
+		assert.Empty(t, rec.events)
+	})
+
+	t.Run("Classified error carries its code", func(t *testing.T) {
+		gqlErr := PresentError(ctx, fmt.Errorf("load order: %w", apperr.Forbidden("forbidden")))
+
+		assert.Equal(t, "FORBIDDEN", gqlErr.Extensions["code"])
+		assert.NotContains(t, gqlErr.Extensions, "reference_id")
+		assert.Empty(t, rec.events)
+	})
+
+	t.Run("Database error is reported with order context", func(t *testing.T) {
+		err := fmt.Errorf("get order: %w", &pq.Error{Message: "relation does not exist"})
+
+		gqlErr := PresentError(ctx, err)
+
+		require.Len(t, rec.events, 1)
+		e := rec.events[0]
+		assert.Equal(t, e.ReferenceID, gqlErr.Extensions["reference_id"])
+		assert.Equal(t, "ORD-1", e.Tags["order"])
+		assert.Equal(t, "orderDetailByExternalId", e.Tags["field"])
+	})
+}
+
+func TestRecover(t *testing.T) {
+	err := Recover(context.Background(), "boom")
+
+	var gqlErr *gqlerror.Error
+	require.ErrorAs(t, err, &gqlErr)
+	assert.Equal(t, "internal server error", gqlErr.Message)
+	assert.Equal(t, "INTERNAL_SERVER_ERROR", gqlErr.Extensions["code"])
+	assert.NotEmpty(t, gqlErr.Extensions["reference_id"])
+}
@@ -0,0 +1,97 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"warimas-be/internal/experiment"
+	"warimas-be/internal/graph/model"
+	"warimas-be/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// CreateExperiment is the resolver for the createExperiment field.
+func (r *mutationResolver) CreateExperiment(ctx context.Context, input model.CreateExperimentInput) (*model.Experiment, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "CreateExperiment"),
+		zap.String("key", input.Key),
+	)
+
+	e, err := r.ExperimentSvc.Create(ctx, experiment.MapInput(input))
+	if err != nil {
+		log.Error("failed to create experiment", zap.Error(err))
+		return nil, err
+	}
+
+	return experiment.MapExperimentToGraphQL(e), nil
+}
+
+// EndExperiment is the resolver for the endExperiment field.
+func (r *mutationResolver) EndExperiment(ctx context.Context, key string) (*model.Experiment, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "EndExperiment"),
+		zap.String("key", key),
+	)
+
+	e, err := r.ExperimentSvc.End(ctx, key)
+	if err != nil {
+		log.Error("failed to end experiment", zap.Error(err))
+		return nil, err
+	}
+
+	return experiment.MapExperimentToGraphQL(e), nil
+}
+
+// ExperimentAssignments is the resolver for the experimentAssignments field.
+func (r *queryResolver) ExperimentAssignments(ctx context.Context) ([]*model.ExperimentAssignment, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "ExperimentAssignments"),
+	)
+
+	assignments, err := r.ExperimentSvc.MyAssignments(ctx)
+	if err != nil {
+		log.Error("failed to assign experiments", zap.Error(err))
+		return nil, err
+	}
+
+	return experiment.MapAssignmentsToGraphQL(assignments), nil
+}
+
+// Experiments is the resolver for the experiments field.
+func (r *queryResolver) Experiments(ctx context.Context) ([]*model.Experiment, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "Experiments"),
+	)
+
+	experiments, err := r.ExperimentSvc.List(ctx)
+	if err != nil {
+		log.Error("failed to list experiments", zap.Error(err))
+		return nil, err
+	}
+
+	return experiment.MapExperimentsToGraphQL(experiments), nil
+}
+
+// ExperimentResults is the resolver for the experimentResults field.
+func (r *queryResolver) ExperimentResults(ctx context.Context, key string) ([]*model.ExperimentVariantResult, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "ExperimentResults"),
+		zap.String("key", key),
+	)
+
+	results, err := r.ExperimentSvc.Results(ctx, key)
+	if err != nil {
+		log.Error("failed to get experiment results", zap.Error(err))
+		return nil, err
+	}
+
+	return experiment.MapResultsToGraphQL(results), nil
+}
@@ -0,0 +1,40 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"warimas-be/internal/export"
+	"warimas-be/internal/graph/model"
+	"warimas-be/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// RequestCatalogExport is the resolver for the requestCatalogExport field.
+func (r *mutationResolver) RequestCatalogExport(ctx context.Context) (*model.CatalogExport, error) {
+	e, err := r.ExportSvc.RequestCatalog(ctx)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to request catalog export", zap.Error(err))
+		return nil, err
+	}
+
+	return export.MapExportToGraphQL(e), nil
+}
+
+// MyCatalogExports is the resolver for the myCatalogExports field.
+func (r *queryResolver) MyCatalogExports(ctx context.Context) ([]*model.CatalogExport, error) {
+	list, err := r.ExportSvc.GetMyCatalogExports(ctx)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to get catalog exports", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]*model.CatalogExport, 0, len(list))
+	for _, e := range list {
+		out = append(out, export.MapExportToGraphQL(e))
+	}
+	return out, nil
+}
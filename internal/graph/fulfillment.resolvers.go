@@ -0,0 +1,115 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"warimas-be/internal/fulfillment"
+	"warimas-be/internal/graph/model"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+// AssignOrderPicker is the resolver for the assignOrderPicker field.
+func (r *mutationResolver) AssignOrderPicker(ctx context.Context, orderID string, pickerID string) (*model.FulfillmentTask, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "AssignOrderPicker"),
+		zap.String("order_id", orderID),
+		zap.String("picker_id", pickerID),
+	)
+
+	oid, err := utils.ToUint(orderID)
+	if err != nil {
+		log.Warn("invalid order id", zap.Error(err))
+		return nil, err
+	}
+	pid, err := utils.ToUint(pickerID)
+	if err != nil {
+		log.Warn("invalid picker id", zap.Error(err))
+		return nil, err
+	}
+
+	t, err := r.FulfillmentSvc.AssignPicker(ctx, oid, pid)
+	if err != nil {
+		log.Error("failed to assign picker", zap.Error(err))
+		return nil, err
+	}
+
+	return fulfillment.MapTaskToGraphQL(t), nil
+}
+
+// MarkOrderPacked is the resolver for the markOrderPacked field.
+func (r *mutationResolver) MarkOrderPacked(ctx context.Context, orderID string) (*model.FulfillmentTask, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "MarkOrderPacked"),
+		zap.String("order_id", orderID),
+	)
+
+	oid, err := utils.ToUint(orderID)
+	if err != nil {
+		log.Warn("invalid order id", zap.Error(err))
+		return nil, err
+	}
+
+	t, err := r.FulfillmentSvc.MarkPacked(ctx, oid)
+	if err != nil {
+		log.Error("failed to mark order packed", zap.Error(err))
+		return nil, err
+	}
+
+	return fulfillment.MapTaskToGraphQL(t), nil
+}
+
+// FulfillmentQueue is the resolver for the fulfillmentQueue field.
+func (r *queryResolver) FulfillmentQueue(ctx context.Context, mineOnly *bool, limit *int32) ([]*model.FulfillmentTask, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "FulfillmentQueue"),
+	)
+
+	var l int32
+	if limit != nil {
+		l = *limit
+	}
+
+	tasks, err := r.FulfillmentSvc.ListQueue(ctx, mineOnly != nil && *mineOnly, l)
+	if err != nil {
+		log.Error("failed to list fulfillment queue", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]*model.FulfillmentTask, 0, len(tasks))
+	for _, t := range tasks {
+		out = append(out, fulfillment.MapTaskToGraphQL(t))
+	}
+	return out, nil
+}
+
+// PackingSlip is the resolver for the packingSlip field.
+func (r *queryResolver) PackingSlip(ctx context.Context, orderID string) (string, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "PackingSlip"),
+		zap.String("order_id", orderID),
+	)
+
+	oid, err := utils.ToUint(orderID)
+	if err != nil {
+		log.Warn("invalid order id", zap.Error(err))
+		return "", err
+	}
+
+	html, err := r.FulfillmentSvc.PackingSlip(ctx, oid)
+	if err != nil {
+		log.Error("failed to build packing slip", zap.Error(err))
+		return "", err
+	}
+
+	return html, nil
+}
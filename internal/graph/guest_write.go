@@ -0,0 +1,41 @@
+package graph
+
+import (
+	"context"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/utils"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"go.uber.org/zap"
+)
+
+// GuestWriteDirective implements @guestWrite: a guest who writes faster
+// than GuestWrites allows is rejected with RATE_LIMITED. Signed-in users
+// and service calls are left to @quota and the rate limiter.
+func (r *Resolver) GuestWriteDirective(ctx context.Context, obj interface{}, next graphql.Resolver) (res interface{}, err error) {
+	if r.GuestWrites == nil {
+		return next(ctx)
+	}
+	if _, ok := utils.GetUserIDFromContext(ctx); ok {
+		return next(ctx)
+	}
+	guestID, ok := utils.GetGuestIDFromContext(ctx)
+	if !ok {
+		return next(ctx)
+	}
+
+	if !r.GuestWrites.Allow(guestID) {
+		fc := graphql.GetFieldContext(ctx)
+		logger.FromCtx(ctx).Warn("guest write limit reached",
+			zap.String("guest_id", guestID),
+			zap.String("field", fc.Field.Name),
+		)
+		return nil, &gqlerror.Error{
+			Message:    "too many changes, please slow down",
+			Extensions: map[string]any{"code": "RATE_LIMITED"},
+		}
+	}
+
+	return next(ctx)
+}
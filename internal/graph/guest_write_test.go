@@ -0,0 +1,52 @@
+package graph
+
+import (
+	"context"
+	"testing"
+	"warimas-be/internal/guest"
+	"warimas-be/internal/utils"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+func TestGuestWriteDirective(t *testing.T) {
+	r := &Resolver{GuestWrites: guest.NewWriteLimiter(guest.WriteLimits{Daily: 1})}
+	calls := 0
+	next := func(ctx context.Context) (interface{}, error) {
+		calls++
+		return true, nil
+	}
+	withField := func(ctx context.Context) context.Context {
+		return graphql.WithFieldContext(ctx, &graphql.FieldContext{
+			Field: graphql.CollectedField{Field: &ast.Field{Name: "createCheckoutSession"}},
+		})
+	}
+
+	t.Run("Guest over the cap", func(t *testing.T) {
+		ctx := withField(utils.WithPrincipal(context.Background(), &utils.Principal{GuestID: "g-1"}))
+
+		_, err := r.GuestWriteDirective(ctx, nil, next)
+		require.NoError(t, err)
+
+		_, err = r.GuestWriteDirective(ctx, nil, next)
+		var gqlErr *gqlerror.Error
+		require.ErrorAs(t, err, &gqlErr)
+		assert.Equal(t, "RATE_LIMITED", gqlErr.Extensions["code"])
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("Signed-in users are not capped", func(t *testing.T) {
+		calls = 0
+		ctx := withField(utils.WithPrincipal(context.Background(), &utils.Principal{ID: 7, GuestID: "g-1"}))
+
+		for i := 0; i < 3; i++ {
+			_, err := r.GuestWriteDirective(ctx, nil, next)
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 3, calls)
+	})
+}
@@ -0,0 +1,303 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"warimas-be/internal/graph/model"
+	"warimas-be/internal/inventory"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+// CreateWarehouse is the resolver for the createWarehouse field.
+func (r *mutationResolver) CreateWarehouse(ctx context.Context, input model.CreateWarehouseInput) (*model.Warehouse, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "CreateWarehouse"),
+	)
+
+	w, err := r.InventorySvc.CreateWarehouse(ctx, input.Code, input.Name, input.Region)
+	if err != nil {
+		log.Error("failed to create warehouse", zap.Error(err))
+		return nil, err
+	}
+
+	return inventory.MapWarehouseToGraphQL(w), nil
+}
+
+// SetWarehouseActive is the resolver for the setWarehouseActive field.
+func (r *mutationResolver) SetWarehouseActive(ctx context.Context, id string, active bool) (*model.Warehouse, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "SetWarehouseActive"),
+		zap.String("warehouse_id", id),
+	)
+
+	w, err := r.InventorySvc.SetWarehouseActive(ctx, id, active)
+	if err != nil {
+		log.Error("failed to update warehouse", zap.Error(err))
+		return nil, err
+	}
+
+	return inventory.MapWarehouseToGraphQL(w), nil
+}
+
+// SetWarehouseStock is the resolver for the setWarehouseStock field.
+func (r *mutationResolver) SetWarehouseStock(ctx context.Context, warehouseID string, variantID string, quantity int32) ([]*model.WarehouseStockLevel, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "SetWarehouseStock"),
+		zap.String("warehouse_id", warehouseID),
+		zap.String("variant_id", variantID),
+	)
+
+	levels, err := r.InventorySvc.SetStock(ctx, warehouseID, variantID, quantity)
+	if err != nil {
+		log.Error("failed to set warehouse stock", zap.Error(err))
+		return nil, err
+	}
+
+	return inventory.MapStockLevelsToGraphQL(levels), nil
+}
+
+// CreateStockTransfer is the resolver for the createStockTransfer field.
+func (r *mutationResolver) CreateStockTransfer(ctx context.Context, input model.CreateStockTransferInput) (*model.StockTransfer, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "CreateStockTransfer"),
+		zap.String("variant_id", input.VariantID),
+	)
+
+	t, err := r.InventorySvc.CreateTransfer(ctx, &inventory.CreateTransferInput{
+		VariantID:       input.VariantID,
+		FromWarehouseID: input.FromWarehouseID,
+		ToWarehouseID:   input.ToWarehouseID,
+		Quantity:        input.Quantity,
+		Note:            input.Note,
+	})
+	if err != nil {
+		log.Error("failed to create stock transfer", zap.Error(err))
+		return nil, err
+	}
+
+	return inventory.MapTransferToGraphQL(t), nil
+}
+
+// ReceiveStockTransfer is the resolver for the receiveStockTransfer field.
+func (r *mutationResolver) ReceiveStockTransfer(ctx context.Context, id string) (*model.StockTransfer, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "ReceiveStockTransfer"),
+		zap.String("transfer_id", id),
+	)
+
+	transferID, err := utils.ToUint(id)
+	if err != nil {
+		log.Warn("invalid transfer id", zap.Error(err))
+		return nil, err
+	}
+
+	t, err := r.InventorySvc.ReceiveTransfer(ctx, int64(transferID))
+	if err != nil {
+		log.Error("failed to receive stock transfer", zap.Error(err))
+		return nil, err
+	}
+
+	return inventory.MapTransferToGraphQL(t), nil
+}
+
+// CancelStockTransfer is the resolver for the cancelStockTransfer field.
+func (r *mutationResolver) CancelStockTransfer(ctx context.Context, id string) (*model.StockTransfer, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "CancelStockTransfer"),
+		zap.String("transfer_id", id),
+	)
+
+	transferID, err := utils.ToUint(id)
+	if err != nil {
+		log.Warn("invalid transfer id", zap.Error(err))
+		return nil, err
+	}
+
+	t, err := r.InventorySvc.CancelTransfer(ctx, int64(transferID))
+	if err != nil {
+		log.Error("failed to cancel stock transfer", zap.Error(err))
+		return nil, err
+	}
+
+	return inventory.MapTransferToGraphQL(t), nil
+}
+
+// AdjustStock is the resolver for the adjustStock field.
+func (r *mutationResolver) AdjustStock(ctx context.Context, input model.AdjustStockInput) (*model.StockAdjustment, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "AdjustStock"),
+		zap.String("warehouse_id", input.WarehouseID),
+		zap.String("variant_id", input.VariantID),
+	)
+
+	a, err := r.InventorySvc.AdjustStock(ctx, &inventory.AdjustStockInput{
+		WarehouseID: input.WarehouseID,
+		VariantID:   input.VariantID,
+		Delta:       input.Delta,
+		Reason:      input.Reason,
+	})
+	if err != nil {
+		log.Error("failed to adjust stock", zap.Error(err))
+		return nil, err
+	}
+
+	return inventory.MapAdjustmentToGraphQL(a), nil
+}
+
+// ApproveStockAdjustment is the resolver for the approveStockAdjustment field.
+func (r *mutationResolver) ApproveStockAdjustment(ctx context.Context, id string) (*model.StockAdjustment, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "ApproveStockAdjustment"),
+		zap.String("adjustment_id", id),
+	)
+
+	adjustmentID, err := utils.ToUint(id)
+	if err != nil {
+		log.Warn("invalid adjustment id", zap.Error(err))
+		return nil, err
+	}
+
+	a, err := r.InventorySvc.ApproveAdjustment(ctx, int64(adjustmentID))
+	if err != nil {
+		log.Error("failed to approve stock adjustment", zap.Error(err))
+		return nil, err
+	}
+
+	return inventory.MapAdjustmentToGraphQL(a), nil
+}
+
+// RejectStockAdjustment is the resolver for the rejectStockAdjustment field.
+func (r *mutationResolver) RejectStockAdjustment(ctx context.Context, id string) (*model.StockAdjustment, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "RejectStockAdjustment"),
+		zap.String("adjustment_id", id),
+	)
+
+	adjustmentID, err := utils.ToUint(id)
+	if err != nil {
+		log.Warn("invalid adjustment id", zap.Error(err))
+		return nil, err
+	}
+
+	a, err := r.InventorySvc.RejectAdjustment(ctx, int64(adjustmentID))
+	if err != nil {
+		log.Error("failed to reject stock adjustment", zap.Error(err))
+		return nil, err
+	}
+
+	return inventory.MapAdjustmentToGraphQL(a), nil
+}
+
+// Warehouses is the resolver for the warehouses field.
+func (r *queryResolver) Warehouses(ctx context.Context) ([]*model.Warehouse, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "Warehouses"),
+	)
+
+	list, err := r.InventorySvc.ListWarehouses(ctx)
+	if err != nil {
+		log.Error("failed to list warehouses", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]*model.Warehouse, 0, len(list))
+	for _, w := range list {
+		out = append(out, inventory.MapWarehouseToGraphQL(w))
+	}
+	return out, nil
+}
+
+// VariantStockLevels is the resolver for the variantStockLevels field.
+func (r *queryResolver) VariantStockLevels(ctx context.Context, variantID string) ([]*model.WarehouseStockLevel, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "VariantStockLevels"),
+		zap.String("variant_id", variantID),
+	)
+
+	levels, err := r.InventorySvc.GetStockLevels(ctx, variantID)
+	if err != nil {
+		log.Error("failed to get stock levels", zap.Error(err))
+		return nil, err
+	}
+
+	return inventory.MapStockLevelsToGraphQL(levels), nil
+}
+
+// StockTransfers is the resolver for the stockTransfers field.
+func (r *queryResolver) StockTransfers(ctx context.Context, status *model.StockTransferStatus, limit *int32) ([]*model.StockTransfer, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "StockTransfers"),
+	)
+
+	var st *inventory.TransferStatus
+	if status != nil {
+		s := inventory.TransferStatus(*status)
+		st = &s
+	}
+
+	var l int32
+	if limit != nil {
+		l = *limit
+	}
+
+	list, err := r.InventorySvc.ListTransfers(ctx, st, l)
+	if err != nil {
+		log.Error("failed to list stock transfers", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]*model.StockTransfer, 0, len(list))
+	for _, t := range list {
+		out = append(out, inventory.MapTransferToGraphQL(t))
+	}
+	return out, nil
+}
+
+// StockAdjustments is the resolver for the stockAdjustments field.
+func (r *queryResolver) StockAdjustments(ctx context.Context, status *model.StockAdjustmentStatus, limit *int32) ([]*model.StockAdjustment, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "StockAdjustments"),
+	)
+
+	var st *inventory.AdjustmentStatus
+	if status != nil {
+		s := inventory.AdjustmentStatus(*status)
+		st = &s
+	}
+
+	var l int32
+	if limit != nil {
+		l = *limit
+	}
+
+	list, err := r.InventorySvc.ListAdjustments(ctx, st, l)
+	if err != nil {
+		log.Error("failed to list stock adjustments", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]*model.StockAdjustment, 0, len(list))
+	for _, a := range list {
+		out = append(out, inventory.MapAdjustmentToGraphQL(a))
+	}
+	return out, nil
+}
@@ -0,0 +1,57 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"strings"
+	"time"
+	"warimas-be/internal/graph/model"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/logsettings"
+
+	"go.uber.org/zap"
+)
+
+// SetLogSettings is the resolver for the setLogSettings field.
+func (r *mutationResolver) SetLogSettings(ctx context.Context, input model.SetLogSettingsInput) (*model.LogSettings, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "SetLogSettings"),
+	)
+
+	in := logsettings.SetInput{
+		DebugModules: input.DebugModules,
+		Sampling:     input.Sampling,
+		Duration:     time.Duration(input.DurationMinutes) * time.Minute,
+	}
+	if input.Level != nil {
+		in.Level = strings.ToLower(input.Level.String())
+	}
+
+	s, err := r.LogSettingsSvc.Set(ctx, in)
+	if err != nil {
+		log.Error("failed to set log settings", zap.Error(err))
+		return nil, err
+	}
+
+	return logsettings.MapStatusToGraphQL(s), nil
+}
+
+// LogSettings is the resolver for the logSettings field.
+func (r *queryResolver) LogSettings(ctx context.Context) (*model.LogSettings, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "LogSettings"),
+	)
+
+	s, err := r.LogSettingsSvc.Current(ctx)
+	if err != nil {
+		log.Error("failed to get log settings", zap.Error(err))
+		return nil, err
+	}
+
+	return logsettings.MapStatusToGraphQL(s), nil
+}
@@ -0,0 +1,102 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"warimas-be/internal/graph/model"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/loyalty"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+// CreateLoyaltyRule is the resolver for the createLoyaltyRule field.
+func (r *mutationResolver) CreateLoyaltyRule(ctx context.Context, input model.CreateLoyaltyRuleInput) (*model.LoyaltyRule, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "CreateLoyaltyRule"),
+	)
+
+	rule := &loyalty.Rule{
+		Name:          input.Name,
+		PointsPerUnit: input.PointsPerUnit,
+		UnitAmount:    int64(input.UnitAmount),
+		StartsAt:      input.StartsAt,
+		EndsAt:        input.EndsAt,
+		IsActive:      true,
+	}
+	if input.MinOrderAmount != nil {
+		rule.MinOrderAmount = int64(*input.MinOrderAmount)
+	}
+
+	created, err := r.LoyaltySvc.CreateRule(ctx, rule)
+	if err != nil {
+		log.Error("failed to create loyalty rule", zap.Error(err))
+		return nil, err
+	}
+
+	return loyalty.MapRuleToGraphQL(created), nil
+}
+
+// SetLoyaltyRuleActive is the resolver for the setLoyaltyRuleActive field.
+func (r *mutationResolver) SetLoyaltyRuleActive(ctx context.Context, id string, active bool) (*model.LoyaltyRule, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "SetLoyaltyRuleActive"),
+		zap.String("rule_id", id),
+	)
+
+	ruleID, err := utils.ToUint(id)
+	if err != nil {
+		log.Warn("invalid rule id", zap.Error(err))
+		return nil, err
+	}
+
+	rule, err := r.LoyaltySvc.SetRuleActive(ctx, int64(ruleID), active)
+	if err != nil {
+		log.Error("failed to update loyalty rule", zap.Error(err))
+		return nil, err
+	}
+
+	return loyalty.MapRuleToGraphQL(rule), nil
+}
+
+// MyLoyaltyPoints is the resolver for the myLoyaltyPoints field.
+func (r *queryResolver) MyLoyaltyPoints(ctx context.Context) (*model.LoyaltyAccount, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "MyLoyaltyPoints"),
+	)
+
+	a, entries, err := r.LoyaltySvc.GetMyPoints(ctx)
+	if err != nil {
+		log.Error("failed to get loyalty points", zap.Error(err))
+		return nil, err
+	}
+
+	return loyalty.MapAccountToGraphQL(a, entries), nil
+}
+
+// LoyaltyRules is the resolver for the loyaltyRules field.
+func (r *queryResolver) LoyaltyRules(ctx context.Context) ([]*model.LoyaltyRule, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "LoyaltyRules"),
+	)
+
+	rules, err := r.LoyaltySvc.ListRules(ctx)
+	if err != nil {
+		log.Error("failed to list loyalty rules", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]*model.LoyaltyRule, 0, len(rules))
+	for _, rule := range rules {
+		out = append(out, loyalty.MapRuleToGraphQL(rule))
+	}
+	return out, nil
+}
@@ -0,0 +1,89 @@
+package graph
+
+import (
+	"context"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/maintenance"
+	"warimas-be/internal/utils"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"go.uber.org/zap"
+)
+
+const defaultMaintenanceMessage = "service is under maintenance, please try again later"
+
+// maintenanceExempt are the root fields a client may always ask for, so it
+// can learn why it is blocked and keep its schema cache.
+var maintenanceExempt = map[string]bool{
+	"maintenanceMode": true,
+	"__typename":      true,
+	"__schema":        true,
+	"__type":          true,
+}
+
+// MaintenanceGuard rejects whole operations with a MAINTENANCE error while
+// the maintenance switch blocks them. Admins pass, so they can still
+// operate the system and turn the switch off.
+type MaintenanceGuard struct {
+	Svc maintenance.Service
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationInterceptor
+} = MaintenanceGuard{}
+
+func (MaintenanceGuard) ExtensionName() string {
+	return "MaintenanceGuard"
+}
+
+func (MaintenanceGuard) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (g MaintenanceGuard) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	op := graphql.GetOperationContext(ctx).Operation
+	if op == nil || exemptFromMaintenance(op) || utils.IsAdmin(ctx) {
+		return next(ctx)
+	}
+
+	m, err := g.Svc.Current(ctx)
+	if err != nil {
+		// Fail open: if the switch cannot be read, the database behind
+		// every other operation is in trouble too.
+		logger.FromCtx(ctx).Warn("maintenance check failed", zap.Error(err))
+		return next(ctx)
+	}
+	if !m.Blocks(op.Operation == ast.Mutation) {
+		return next(ctx)
+	}
+
+	msg := defaultMaintenanceMessage
+	if m.Message != nil && *m.Message != "" {
+		msg = *m.Message
+	}
+	return graphql.OneShot(&graphql.Response{
+		Errors: gqlerror.List{{
+			Message: msg,
+			Extensions: map[string]any{
+				"code":       "MAINTENANCE",
+				"allowReads": m.AllowReads,
+			},
+		}},
+	})
+}
+
+func exemptFromMaintenance(op *ast.OperationDefinition) bool {
+	if op.Operation != ast.Query {
+		return false
+	}
+	for _, sel := range op.SelectionSet {
+		f, ok := sel.(*ast.Field)
+		if !ok || !maintenanceExempt[f.Name] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,50 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"warimas-be/internal/graph/model"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/maintenance"
+
+	"go.uber.org/zap"
+)
+
+// SetMaintenanceMode is the resolver for the setMaintenanceMode field.
+func (r *mutationResolver) SetMaintenanceMode(ctx context.Context, input model.SetMaintenanceModeInput) (*model.MaintenanceMode, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "SetMaintenanceMode"),
+	)
+
+	m, err := r.MaintenanceSvc.Set(ctx, maintenance.SetInput{
+		Enabled:    input.Enabled,
+		AllowReads: input.AllowReads,
+		Message:    input.Message,
+	})
+	if err != nil {
+		log.Error("failed to set maintenance mode", zap.Error(err))
+		return nil, err
+	}
+
+	return maintenance.MapModeToGraphQL(m), nil
+}
+
+// MaintenanceMode is the resolver for the maintenanceMode field.
+func (r *queryResolver) MaintenanceMode(ctx context.Context) (*model.MaintenanceMode, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "MaintenanceMode"),
+	)
+
+	m, err := r.MaintenanceSvc.Current(ctx)
+	if err != nil {
+		log.Error("failed to get maintenance mode", zap.Error(err))
+		return nil, err
+	}
+
+	return maintenance.MapModeToGraphQL(m), nil
+}
@@ -0,0 +1,96 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"warimas-be/internal/maintenance"
+	"warimas-be/internal/utils"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubMaintenance struct {
+	mode *maintenance.Mode
+}
+
+func (s stubMaintenance) Current(context.Context) (*maintenance.Mode, error) {
+	return s.mode, nil
+}
+
+func (s stubMaintenance) Set(_ context.Context, in maintenance.SetInput) (*maintenance.Mode, error) {
+	return &maintenance.Mode{Enabled: in.Enabled, AllowReads: in.AllowReads, Source: maintenance.SourceDB}, nil
+}
+
+type gqlResponse struct {
+	Data   map[string]any `json:"data"`
+	Errors []struct {
+		Message    string         `json:"message"`
+		Extensions map[string]any `json:"extensions"`
+	} `json:"errors"`
+}
+
+func runGuarded(t *testing.T, mode *maintenance.Mode, role, query string) gqlResponse {
+	t.Helper()
+
+	svc := stubMaintenance{mode: mode}
+	srv := handler.New(NewAdminSchema(&Resolver{MaintenanceSvc: svc}))
+	srv.AddTransport(transport.POST{})
+	srv.Use(MaintenanceGuard{Svc: svc})
+
+	body, err := json.Marshal(map[string]string{"query": query})
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, "/query", strings.NewReader(string(body)))
+	req.Header.Set("Content-Type", "application/json")
+	if role != "" {
+		req = req.WithContext(utils.SetUserContext(req.Context(), 1, "a@example.com", role))
+	}
+
+	w := httptest.NewRecorder()
+	srv.ServeHTTP(w, req)
+
+	var resp gqlResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	return resp
+}
+
+func TestMaintenanceGuard(t *testing.T) {
+	readOnly := &maintenance.Mode{Enabled: true, AllowReads: true}
+	full := &maintenance.Mode{Enabled: true}
+	setMode := `mutation { setMaintenanceMode(input: {enabled: false}) { enabled } }`
+
+	t.Run("Mutation blocked in read-only mode", func(t *testing.T) {
+		resp := runGuarded(t, readOnly, "USER", setMode)
+
+		require.Len(t, resp.Errors, 1)
+		assert.Equal(t, "MAINTENANCE", resp.Errors[0].Extensions["code"])
+		assert.Equal(t, true, resp.Errors[0].Extensions["allowReads"])
+	})
+
+	t.Run("Queries blocked in full mode", func(t *testing.T) {
+		resp := runGuarded(t, full, "", `{ myCart { items { quantity } } }`)
+
+		require.Len(t, resp.Errors, 1)
+		assert.Equal(t, "MAINTENANCE", resp.Errors[0].Extensions["code"])
+	})
+
+	t.Run("Status query always allowed", func(t *testing.T) {
+		resp := runGuarded(t, full, "", `{ maintenanceMode { enabled } }`)
+
+		require.Empty(t, resp.Errors)
+		assert.Equal(t, map[string]any{"enabled": true}, resp.Data["maintenanceMode"])
+	})
+
+	t.Run("Admin passes", func(t *testing.T) {
+		resp := runGuarded(t, full, "ADMIN", setMode)
+
+		require.Empty(t, resp.Errors)
+		assert.Equal(t, map[string]any{"enabled": false}, resp.Data["setMaintenanceMode"])
+	})
+}
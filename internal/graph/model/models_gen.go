@@ -10,6 +10,14 @@ import (
 	"time"
 )
 
+type AccountRestriction struct {
+	UserID    string                  `json:"userId"`
+	Level     AccountRestrictionLevel `json:"level"`
+	Reason    string                  `json:"reason"`
+	ExpiresAt *time.Time              `json:"expiresAt,omitempty"`
+	CreatedAt time.Time               `json:"createdAt"`
+}
+
 type AddPackageInput struct {
 	Name  string                 `json:"name"`
 	Items []*AddPackageItemInput `json:"items"`
@@ -33,17 +41,18 @@ type AddToCartResponse struct {
 }
 
 type Address struct {
-	ID           string  `json:"id"`
-	Name         string  `json:"name"`
-	ReceiverName string  `json:"receiverName"`
-	Phone        string  `json:"phone"`
-	AddressLine1 string  `json:"addressLine1"`
-	AddressLine2 *string `json:"addressLine2,omitempty"`
-	City         string  `json:"city"`
-	Province     string  `json:"province"`
-	PostalCode   string  `json:"postalCode"`
-	Country      string  `json:"country"`
-	IsDefault    bool    `json:"isDefault"`
+	ID           string    `json:"id"`
+	Name         string    `json:"name"`
+	ReceiverName string    `json:"receiverName"`
+	Phone        string    `json:"phone"`
+	AddressLine1 string    `json:"addressLine1"`
+	AddressLine2 *string   `json:"addressLine2,omitempty"`
+	City         string    `json:"city"`
+	Province     string    `json:"province"`
+	PostalCode   string    `json:"postalCode"`
+	Country      string    `json:"country"`
+	Location     *Location `json:"location,omitempty"`
+	IsDefault    bool      `json:"isDefault"`
 }
 
 type AddressInput struct {
@@ -56,6 +65,72 @@ type AddressInput struct {
 	Province     string  `json:"province"`
 	PostalCode   string  `json:"postalCode"`
 	Country      string  `json:"country"`
+	// Where the address is on the map; needed for instant delivery
+	Location *LocationInput `json:"location,omitempty"`
+}
+
+type AdjustStockInput struct {
+	WarehouseID string `json:"warehouseId"`
+	VariantID   string `json:"variantId"`
+	Delta       int32  `json:"delta"`
+	Reason      string `json:"reason"`
+}
+
+// Ops home screen summary, recomputed at most once a minute
+type AdminDashboard struct {
+	// Orders placed since midnight Jakarta time
+	OrdersToday int32 `json:"ordersToday"`
+	// Total of today's orders that have been paid
+	RevenueToday int32 `json:"revenueToday"`
+	// Paid or accepted orders not shipped yet
+	PendingShipments int32 `json:"pendingShipments"`
+	// Payment webhooks whose processing failed
+	FailedWebhooks int32 `json:"failedWebhooks"`
+	// Active variants with 5 or fewer sellable units
+	LowStockVariants int32     `json:"lowStockVariants"`
+	GeneratedAt      time.Time `json:"generatedAt"`
+}
+
+type APIKey struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// Public part of the key, shown in its first characters after wmk_
+	Prefix     string        `json:"prefix"`
+	Scopes     []APIKeyScope `json:"scopes"`
+	CreatedAt  time.Time     `json:"createdAt"`
+	LastUsedAt *time.Time    `json:"lastUsedAt,omitempty"`
+	ExpiresAt  *time.Time    `json:"expiresAt,omitempty"`
+	RevokedAt  *time.Time    `json:"revokedAt,omitempty"`
+}
+
+type ApplyCouponInput struct {
+	ExternalID string `json:"externalId"`
+	Code       string `json:"code"`
+}
+
+type ApplyCouponResponse struct {
+	Success  bool  `json:"success"`
+	Discount int32 `json:"discount"`
+}
+
+type ApplySessionPointsInput struct {
+	ExternalID string `json:"externalId"`
+	Points     int32  `json:"points"`
+}
+
+type ApplySessionPointsResponse struct {
+	Success        bool  `json:"success"`
+	PointsRedeemed int32 `json:"pointsRedeemed"`
+	Discount       int32 `json:"discount"`
+}
+
+type ApplySessionWalletInput struct {
+	ExternalID string `json:"externalId"`
+	Amount     int32  `json:"amount"`
+}
+
+type ApplySessionWalletResponse struct {
+	Success bool `json:"success"`
 }
 
 type AuthResponse struct {
@@ -63,6 +138,52 @@ type AuthResponse struct {
 	Token *string `json:"token,omitempty"`
 }
 
+// A sold-out variant the customer is waiting for
+type BackInStockSubscription struct {
+	ID          string    `json:"id"`
+	VariantID   string    `json:"variantId"`
+	ProductID   string    `json:"productId"`
+	ProductName string    `json:"productName"`
+	VariantName string    `json:"variantName"`
+	ImageURL    *string   `json:"imageUrl,omitempty"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// A table whose dead rows passed the bloat threshold
+type BloatedTable struct {
+	Table       string  `json:"table"`
+	LiveTuples  int32   `json:"liveTuples"`
+	DeadTuples  int32   `json:"deadTuples"`
+	DeadPercent float64 `json:"deadPercent"`
+	// Later of the last manual and automatic vacuum
+	LastVacuumAt  *time.Time `json:"lastVacuumAt,omitempty"`
+	LastAnalyzeAt *time.Time `json:"lastAnalyzeAt,omitempty"`
+}
+
+// A term display names may not contain, stored lowercased with only its letters
+type BlockedDisplayNameTerm struct {
+	Term      string    `json:"term"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type CampaignPerformance struct {
+	CampaignID        string `json:"campaignId"`
+	CampaignName      string `json:"campaignName"`
+	Redemptions       int32  `json:"redemptions"`
+	UniqueCustomers   int32  `json:"uniqueCustomers"`
+	RevenueInfluenced int32  `json:"revenueInfluenced"`
+	// Revenue influenced less the gateway fees recorded on those orders
+	NetRevenueInfluenced int32 `json:"netRevenueInfluenced"`
+	DiscountCost         int32 `json:"discountCost"`
+	NewCustomers         int32 `json:"newCustomers"`
+	ReturningCustomers   int32 `json:"returningCustomers"`
+}
+
+type CartConnection struct {
+	Items    []*CartItem `json:"items"`
+	PageInfo *PageInfo   `json:"pageInfo"`
+}
+
 type CartFilterInput struct {
 	Search  *string `json:"search,omitempty"`
 	InStock *bool   `json:"inStock,omitempty"`
@@ -73,13 +194,8 @@ type CartItem struct {
 	UserID    int32        `json:"userId"`
 	Quantity  int32        `json:"quantity"`
 	Product   *ProductCart `json:"product"`
-	CreatedAt string       `json:"createdAt"`
-	UpdatedAt string       `json:"updatedAt"`
-}
-
-type CartListResponse struct {
-	Items    []*CartItem `json:"items"`
-	PageInfo *PageInfo   `json:"pageInfo"`
+	CreatedAt time.Time    `json:"createdAt"`
+	UpdatedAt time.Time    `json:"updatedAt"`
 }
 
 type CartSortInput struct {
@@ -87,6 +203,20 @@ type CartSortInput struct {
 	Direction SortDirection `json:"direction"`
 }
 
+// A CSV of the store's products and variants, built in the background; poll until it is DONE or FAILED
+type CatalogExport struct {
+	ID     string       `json:"id"`
+	Status ExportStatus `json:"status"`
+	// Rows in the file, one per variant; set once DONE
+	RowCount *int32 `json:"rowCount,omitempty"`
+	// Where to download the file; set once DONE
+	URL *string `json:"url,omitempty"`
+	// Why the export failed
+	Error      *string    `json:"error,omitempty"`
+	CreatedAt  time.Time  `json:"createdAt"`
+	FinishedAt *time.Time `json:"finishedAt,omitempty"`
+}
+
 type Category struct {
 	ID            string         `json:"id"`
 	Name          string         `json:"name"`
@@ -94,25 +224,66 @@ type Category struct {
 	Subcategories []*Subcategory `json:"subcategories"`
 }
 
-type CategoryPage struct {
+type CategoryConnection struct {
 	Items    []*Category `json:"items"`
 	PageInfo *PageInfo   `json:"pageInfo"`
 }
 
+type CheckoutRule struct {
+	ID              string    `json:"id"`
+	Region          *string   `json:"region,omitempty"`
+	MinOrderAmount  int32     `json:"minOrderAmount"`
+	FreeShippingMin *int32    `json:"freeShippingMin,omitempty"`
+	IsActive        bool      `json:"isActive"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+}
+
 type CheckoutSession struct {
-	ID            string                 `json:"id"`
-	ExternalID    string                 `json:"externalId"`
-	Status        CheckoutSessionStatus  `json:"status"`
-	ExpiresAt     time.Time              `json:"expiresAt"`
-	CreatedAt     time.Time              `json:"createdAt"`
-	AddressID     *string                `json:"addressId,omitempty"`
-	Items         []*CheckoutSessionItem `json:"items"`
-	Subtotal      int32                  `json:"subtotal"`
-	Tax           int32                  `json:"tax"`
-	ShippingFee   int32                  `json:"shippingFee"`
-	Discount      int32                  `json:"discount"`
-	TotalPrice    int32                  `json:"totalPrice"`
-	PaymentMethod string                 `json:"paymentMethod"`
+	ID         string                `json:"id"`
+	ExternalID string                `json:"externalId"`
+	Status     CheckoutSessionStatus `json:"status"`
+	ExpiresAt  time.Time             `json:"expiresAt"`
+	// Whole seconds until expiresAt; 0 once passed or when the session is no longer pending
+	SecondsRemaining int32 `json:"secondsRemaining"`
+	// When the virtual account or code of the confirmed order stops working; null while nothing waits for payment
+	PaymentExpiresAt *time.Time `json:"paymentExpiresAt,omitempty"`
+	// Whole seconds until paymentExpiresAt, 0 once passed
+	PaymentSecondsRemaining *int32                 `json:"paymentSecondsRemaining,omitempty"`
+	CreatedAt               time.Time              `json:"createdAt"`
+	AddressID               *string                `json:"addressId,omitempty"`
+	Items                   []*CheckoutSessionItem `json:"items"`
+	ChargeableWeightGrams   int32                  `json:"chargeableWeightGrams"`
+	ShippingMethod          ShippingMethod         `json:"shippingMethod"`
+	PickupLocationID        *string                `json:"pickupLocationId,omitempty"`
+	PickupSlotStart         *time.Time             `json:"pickupSlotStart,omitempty"`
+	DeliverySlotID          *string                `json:"deliverySlotId,omitempty"`
+	// Day the delivery slot was chosen for
+	DeliveryDate *string `json:"deliveryDate,omitempty"`
+	// Whether shipping insurance was chosen
+	Insured     bool  `json:"insured"`
+	Subtotal    int32 `json:"subtotal"`
+	Tax         int32 `json:"tax"`
+	ShippingFee int32 `json:"shippingFee"`
+	// A share of the subtotal set by the courier; 0 when not insured or for self pickup
+	InsuranceFee   int32  `json:"insuranceFee"`
+	Discount       int32  `json:"discount"`
+	TotalPrice     int32  `json:"totalPrice"`
+	WalletAmount   int32  `json:"walletAmount"`
+	PointsRedeemed int32  `json:"pointsRedeemed"`
+	PaymentMethod  string `json:"paymentMethod"`
+}
+
+type CheckoutSessionEvent struct {
+	ID           string    `json:"id"`
+	Type         string    `json:"type"`
+	ActorUserID  *string   `json:"actorUserId,omitempty"`
+	ActorGuestID *string   `json:"actorGuestId,omitempty"`
+	ActorRole    *string   `json:"actorRole,omitempty"`
+	FromValue    *string   `json:"fromValue,omitempty"`
+	ToValue      *string   `json:"toValue,omitempty"`
+	TotalBefore  int32     `json:"totalBefore"`
+	TotalAfter   int32     `json:"totalAfter"`
+	CreatedAt    time.Time `json:"createdAt"`
 }
 
 type CheckoutSessionItem struct {
@@ -138,8 +309,42 @@ type CheckoutSessionResponse struct {
 	ExpiresAt  time.Time             `json:"expiresAt"`
 }
 
+// Defaults to preselect for the visitor, detected from their IP
+type ClientContext struct {
+	// ISO 3166-1 alpha-2 code; null when unknown
+	CountryCode *string `json:"countryCode,omitempty"`
+	// Province to preselect for shipping; null outside Indonesia or when unknown
+	Region *string `json:"region,omitempty"`
+	// Display currency; checkout always charges IDR
+	Currency string               `json:"currency"`
+	Source   ClientLocationSource `json:"source"`
+}
+
+// Commission charged on order lines of a category from effectiveFrom until the category's next rate
+type CommissionRate struct {
+	ID string `json:"id"`
+	// Null for the default rate of categories without their own
+	CategoryID *string `json:"categoryId,omitempty"`
+	// Share of the line subtotal in basis points; 1250 is 12.5%
+	RateBps int32 `json:"rateBps"`
+	// Flat fee per order line
+	FixedFee      int32     `json:"fixedFee"`
+	EffectiveFrom time.Time `json:"effectiveFrom"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+type ComparedProduct struct {
+	Product *Product `json:"product"`
+	// Null when the product has no variants
+	MinPrice *float64 `json:"minPrice,omitempty"`
+	MaxPrice *float64 `json:"maxPrice,omitempty"`
+	InStock  bool     `json:"inStock"`
+}
+
 type ConfirmCheckoutSessionInput struct {
 	ExternalID string `json:"externalId"`
+	// Ids of the current policies the customer accepted; every one must be listed
+	AcceptedPolicyIds []string `json:"acceptedPolicyIds,omitempty"`
 }
 
 type ConfirmCheckoutSessionResponse struct {
@@ -148,6 +353,18 @@ type ConfirmCheckoutSessionResponse struct {
 	OrderExternalID string  `json:"order_external_id"`
 }
 
+type CourierWebhook struct {
+	ID           string     `json:"id"`
+	Courier      string     `json:"courier"`
+	EventID      string     `json:"eventId"`
+	Awb          *string    `json:"awb,omitempty"`
+	Status       *string    `json:"status,omitempty"`
+	Attempts     int32      `json:"attempts"`
+	ProcessError *string    `json:"processError,omitempty"`
+	ReceivedAt   time.Time  `json:"receivedAt"`
+	DeadAt       *time.Time `json:"deadAt,omitempty"`
+}
+
 type CreateAddressInput struct {
 	Address      *AddressInput `json:"address"`
 	SetAsDefault *bool         `json:"setAsDefault,omitempty"`
@@ -157,10 +374,59 @@ type CreateAddressResponse struct {
 	Address *Address `json:"address"`
 }
 
+type CreateAdminOrderInput struct {
+	UserID        string                      `json:"userId"`
+	AddressID     string                      `json:"addressId"`
+	Items         []*CheckoutSessionItemInput `json:"items"`
+	ShippingFee   *int32                      `json:"shippingFee,omitempty"`
+	Payment       AdminOrderPayment           `json:"payment"`
+	PaymentMethod *string                     `json:"paymentMethod,omitempty"`
+}
+
+type CreateAPIKeyInput struct {
+	// Service the key is issued to
+	Name   string        `json:"name"`
+	Scopes []APIKeyScope `json:"scopes"`
+	// Omit for a key that does not expire
+	ExpiresInDays *int32 `json:"expiresInDays,omitempty"`
+}
+
+type CreateAPIKeyResponse struct {
+	APIKey *APIKey `json:"apiKey"`
+	// The key to send as X-API-Key; it cannot be retrieved again
+	Secret string `json:"secret"`
+}
+
 type CreateCheckoutSessionInput struct {
 	Items []*CheckoutSessionItemInput `json:"items"`
 }
 
+type CreateCommissionRateInput struct {
+	// Leave out to set the default rate
+	CategoryID *string `json:"categoryId,omitempty"`
+	RateBps    int32   `json:"rateBps"`
+	FixedFee   *int32  `json:"fixedFee,omitempty"`
+	// Defaults to now; cannot be in the past
+	EffectiveFrom *time.Time `json:"effectiveFrom,omitempty"`
+}
+
+type CreateExperimentInput struct {
+	// 2-64 lowercase letters, digits, dashes or underscores
+	Key         string  `json:"key"`
+	Description *string `json:"description,omitempty"`
+	// 2-10 variants; they cannot be changed once the experiment starts
+	Variants []*ExperimentVariantInput `json:"variants"`
+}
+
+type CreateLoyaltyRuleInput struct {
+	Name           string     `json:"name"`
+	PointsPerUnit  int32      `json:"pointsPerUnit"`
+	UnitAmount     int32      `json:"unitAmount"`
+	MinOrderAmount *int32     `json:"minOrderAmount,omitempty"`
+	StartsAt       *time.Time `json:"startsAt,omitempty"`
+	EndsAt         *time.Time `json:"endsAt,omitempty"`
+}
+
 type CreateOrderFromSessionInput struct {
 	ExternalID string `json:"externalId"`
 }
@@ -173,6 +439,45 @@ type CreateOrderResponse struct {
 	Payment    *Payment `json:"payment,omitempty"`
 }
 
+type CreateStockTransferInput struct {
+	VariantID       string  `json:"variantId"`
+	FromWarehouseID string  `json:"fromWarehouseId"`
+	ToWarehouseID   string  `json:"toWarehouseId"`
+	Quantity        int32   `json:"quantity"`
+	Note            *string `json:"note,omitempty"`
+}
+
+type CreateVoucherCampaignInput struct {
+	Name        string     `json:"name"`
+	Description *string    `json:"description,omitempty"`
+	StartsAt    time.Time  `json:"startsAt"`
+	EndsAt      *time.Time `json:"endsAt,omitempty"`
+}
+
+type CreateWarehouseInput struct {
+	Code   string `json:"code"`
+	Name   string `json:"name"`
+	Region string `json:"region"`
+}
+
+type CustomerSegmentSize struct {
+	Segment CustomerSegment `json:"segment"`
+	Members int32           `json:"members"`
+}
+
+// Latest run of the hourly database health check. Every section lists at
+// most 20 entries, worst first. Counters larger than an Int are capped.
+type DatabaseHealthReport struct {
+	BloatedTables          []*BloatedTable  `json:"bloatedTables"`
+	MissingIndexCandidates []*SeqScanTable  `json:"missingIndexCandidates"`
+	SlowStatements         []*SlowStatement `json:"slowStatements"`
+	// False when pg_stat_statements is not installed or not preloaded
+	StatementsAvailable bool                `json:"statementsAvailable"`
+	UnusedIndexes       []*UnusedIndex      `json:"unusedIndexes"`
+	LongRunningQueries  []*LongRunningQuery `json:"longRunningQueries"`
+	GeneratedAt         time.Time           `json:"generatedAt"`
+}
+
 type DeleteAddressInput struct {
 	AddressID string `json:"addressId"`
 }
@@ -181,6 +486,69 @@ type DeleteAddressResponse struct {
 	Success bool `json:"success"`
 }
 
+type DeliverySlot struct {
+	ID        string    `json:"id"`
+	Region    string    `json:"region"`
+	StartsAt  string    `json:"startsAt"`
+	EndsAt    string    `json:"endsAt"`
+	Capacity  int32     `json:"capacity"`
+	IsActive  bool      `json:"isActive"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type DeliverySlotOption struct {
+	Slot  *DeliverySlot `json:"slot"`
+	Start time.Time     `json:"start"`
+	End   time.Time     `json:"end"`
+	// Orders the slot still takes today
+	Remaining int32 `json:"remaining"`
+	// False once the slot is full or starts too soon
+	Available bool `json:"available"`
+}
+
+type DeliveryWindow struct {
+	SlotID string    `json:"slotId"`
+	Date   string    `json:"date"`
+	Start  time.Time `json:"start"`
+	End    time.Time `json:"end"`
+}
+
+type Experiment struct {
+	Key         string               `json:"key"`
+	Description *string              `json:"description,omitempty"`
+	Variants    []*ExperimentVariant `json:"variants"`
+	Active      bool                 `json:"active"`
+	StartedAt   time.Time            `json:"startedAt"`
+	EndedAt     *time.Time           `json:"endedAt,omitempty"`
+}
+
+// The variant the shopper sees in a running experiment
+type ExperimentAssignment struct {
+	ExperimentKey string `json:"experimentKey"`
+	Variant       string `json:"variant"`
+}
+
+type ExperimentVariant struct {
+	Name string `json:"name"`
+	// Share of shoppers relative to the other variants' weights
+	Weight int32 `json:"weight"`
+}
+
+type ExperimentVariantInput struct {
+	Name   string `json:"name"`
+	Weight int32  `json:"weight"`
+}
+
+// Orders placed under one variant of an experiment
+type ExperimentVariantResult struct {
+	Variant string `json:"variant"`
+	Orders  int32  `json:"orders"`
+	// Orders that were paid, including those since shipped or completed
+	PaidOrders int32 `json:"paidOrders"`
+	// Total of the paid orders
+	Revenue int32 `json:"revenue"`
+}
+
 type ForgotPasswordInput struct {
 	Email string `json:"email"`
 }
@@ -190,14 +558,183 @@ type ForgotPasswordResponse struct {
 	Message *string `json:"message,omitempty"`
 }
 
+type FulfillmentTask struct {
+	OrderID         string      `json:"orderId"`
+	OrderExternalID string      `json:"orderExternalId"`
+	OrderStatus     OrderStatus `json:"orderStatus"`
+	ItemCount       int32       `json:"itemCount"`
+	PickerID        *string     `json:"pickerId,omitempty"`
+	AssignedAt      *time.Time  `json:"assignedAt,omitempty"`
+	PackedBy        *string     `json:"packedBy,omitempty"`
+	PackedAt        *time.Time  `json:"packedAt,omitempty"`
+	OrderedAt       time.Time   `json:"orderedAt"`
+}
+
+type IssueSegmentVouchersInput struct {
+	CampaignID    string              `json:"campaignId"`
+	Segment       CustomerSegment     `json:"segment"`
+	CodePrefix    string              `json:"codePrefix"`
+	DiscountType  VoucherDiscountType `json:"discountType"`
+	DiscountValue int32               `json:"discountValue"`
+	MaxDiscount   *int32              `json:"maxDiscount,omitempty"`
+	MinSubtotal   *int32              `json:"minSubtotal,omitempty"`
+	ExpiresAt     *time.Time          `json:"expiresAt,omitempty"`
+}
+
+// Double-entry journal of a period, ready to import into the finance software
+type JournalExport struct {
+	From       string `json:"from"`
+	To         string `json:"to"`
+	EntryCount int32  `json:"entryCount"`
+	// Debits and credits of every entry; equal when the journal balances
+	TotalDebit  int32 `json:"totalDebit"`
+	TotalCredit int32 `json:"totalCredit"`
+	// One row per entry line: Date, Entry, Journal, Reference, Account Code, Account Name, Debit, Credit, Memo
+	CSV string `json:"csv"`
+}
+
+// A point on the map in WGS84 degrees
+type Location struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+type LocationInput struct {
+	// -90 to 90
+	Latitude float64 `json:"latitude"`
+	// -180 to 180
+	Longitude float64 `json:"longitude"`
+}
+
+type LogSettings struct {
+	Level        LogLevel `json:"level"`
+	DebugModules []string `json:"debugModules"`
+	Sampling     bool     `json:"sampling"`
+	// When the override lapses and the defaults return; null when none is active.
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+	UpdatedAt *time.Time `json:"updatedAt,omitempty"`
+}
+
 type LoginInput struct {
 	Email    string `json:"email"`
 	Password string `json:"password"`
 }
 
+type LongRunningQuery struct {
+	Pid             int32   `json:"pid"`
+	State           string  `json:"state"`
+	ApplicationName string  `json:"applicationName"`
+	WaitEvent       *string `json:"waitEvent,omitempty"`
+	Query           string  `json:"query"`
+	// Package that ran the query, from the comment the database driver adds
+	Module *string `json:"module,omitempty"`
+	// GraphQL operation the query ran for
+	Operation       *string `json:"operation,omitempty"`
+	RequestID       *string `json:"requestId,omitempty"`
+	UserID          *string `json:"userId,omitempty"`
+	DurationSeconds float64 `json:"durationSeconds"`
+}
+
+type LoyaltyAccount struct {
+	Balance int32                 `json:"balance"`
+	Entries []*LoyaltyLedgerEntry `json:"entries"`
+}
+
+type LoyaltyLedgerEntry struct {
+	ID            string           `json:"id"`
+	Type          LoyaltyEntryType `json:"type"`
+	Points        int32            `json:"points"`
+	BalanceAfter  int32            `json:"balanceAfter"`
+	ReferenceType string           `json:"referenceType"`
+	ReferenceID   string           `json:"referenceId"`
+	ExpiresAt     *time.Time       `json:"expiresAt,omitempty"`
+	CreatedAt     time.Time        `json:"createdAt"`
+}
+
+type LoyaltyRule struct {
+	ID             string     `json:"id"`
+	Name           string     `json:"name"`
+	PointsPerUnit  int32      `json:"pointsPerUnit"`
+	UnitAmount     int32      `json:"unitAmount"`
+	MinOrderAmount int32      `json:"minOrderAmount"`
+	StartsAt       *time.Time `json:"startsAt,omitempty"`
+	EndsAt         *time.Time `json:"endsAt,omitempty"`
+	IsActive       bool       `json:"isActive"`
+	CreatedAt      time.Time  `json:"createdAt"`
+}
+
+type MaintenanceMode struct {
+	Enabled    bool              `json:"enabled"`
+	AllowReads bool              `json:"allowReads"`
+	Message    *string           `json:"message,omitempty"`
+	UpdatedAt  *time.Time        `json:"updatedAt,omitempty"`
+	Source     MaintenanceSource `json:"source"`
+}
+
+type MarketingConsent struct {
+	Channel        MarketingChannel       `json:"channel"`
+	Status         MarketingConsentStatus `json:"status"`
+	SubscribedAt   *time.Time             `json:"subscribedAt,omitempty"`
+	UnsubscribedAt *time.Time             `json:"unsubscribedAt,omitempty"`
+}
+
+type ModerationAuditEntry struct {
+	ID           string           `json:"id"`
+	ItemID       string           `json:"itemId"`
+	Action       ModerationAction `json:"action"`
+	ActorID      *string          `json:"actorId,omitempty"`
+	TargetUserID *string          `json:"targetUserId,omitempty"`
+	Note         *string          `json:"note,omitempty"`
+	CreatedAt    time.Time        `json:"createdAt"`
+}
+
+// User-generated content held for a moderator. contentId is the seller ID
+// for store content, the product ID for a product and the user ID for
+// profile content. body is the content as it was queued: the text, the image
+// URL of a logo or avatar, or a product's name and description.
+type ModerationItem struct {
+	ID          string                `json:"id"`
+	ContentType ModerationContentType `json:"contentType"`
+	ContentID   string                `json:"contentId"`
+	AuthorID    *string               `json:"authorId,omitempty"`
+	Body        string                `json:"body"`
+	// Keyword rules the content matched
+	MatchedKeywords []string `json:"matchedKeywords"`
+	ReportCount     int32    `json:"reportCount"`
+	// When reports hid the content from shoppers pending review
+	HiddenAt   *time.Time       `json:"hiddenAt,omitempty"`
+	Status     ModerationStatus `json:"status"`
+	ResolvedBy *string          `json:"resolvedBy,omitempty"`
+	ResolvedAt *time.Time       `json:"resolvedAt,omitempty"`
+	CreatedAt  time.Time        `json:"createdAt"`
+	UpdatedAt  time.Time        `json:"updatedAt"`
+}
+
+// A keyword that flags content for review, stored lowercased with only its letters
+type ModerationKeyword struct {
+	Term      string    `json:"term"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// Totals of the statements one module ran
+type ModuleQueryStats struct {
+	// Null for statements without an annotation, such as migrations
+	Module      *string `json:"module,omitempty"`
+	Statements  int32   `json:"statements"`
+	Calls       int32   `json:"calls"`
+	TotalTimeMs float64 `json:"totalTimeMs"`
+	MeanTimeMs  float64 `json:"meanTimeMs"`
+}
+
 type Mutation struct {
 }
 
+type NegativeStockVariant struct {
+	VariantID string `json:"variantId"`
+	Name      string `json:"name"`
+	Stock     int32  `json:"stock"`
+}
+
 type NewProduct struct {
 	Name          string  `json:"name"`
 	ImageURL      *string `json:"imageUrl,omitempty"`
@@ -207,28 +744,86 @@ type NewProduct struct {
 }
 
 type NewVariant struct {
-	ProductID    string  `json:"productId"`
+	ProductID string `json:"productId"`
+	// One of the quantityTypes codes; case and old spellings like pcs are accepted
 	QuantityType string  `json:"quantityType"`
 	Name         string  `json:"name"`
 	Price        float64 `json:"price"`
-	Stock        int32   `json:"stock"`
-	ImageURL     *string `json:"imageUrl,omitempty"`
-	Description  *string `json:"description,omitempty"`
+	// Original price shown struck through; must not be below price
+	CompareAtPrice *float64 `json:"compareAtPrice,omitempty"`
+	Stock          int32    `json:"stock"`
+	ImageURL       *string  `json:"imageUrl,omitempty"`
+	Description    *string  `json:"description,omitempty"`
+	WeightGrams    *int32   `json:"weightGrams,omitempty"`
+	LengthCm       *int32   `json:"lengthCm,omitempty"`
+	WidthCm        *int32   `json:"widthCm,omitempty"`
+	HeightCm       *int32   `json:"heightCm,omitempty"`
+}
+
+type NotificationSetting struct {
+	Event   NotificationEvent   `json:"event"`
+	Channel NotificationChannel `json:"channel"`
+	Enabled bool                `json:"enabled"`
+}
+
+type NotificationSettingInput struct {
+	Event   NotificationEvent   `json:"event"`
+	Channel NotificationChannel `json:"channel"`
+	Enabled bool                `json:"enabled"`
 }
 
 // ====================
 // Core Types
 // ====================
 type Order struct {
-	ID            int32            `json:"id"`
-	ExternalID    string           `json:"externalId"`
-	InvoiceNumber *string          `json:"invoiceNumber,omitempty"`
-	User          *UserRef         `json:"user"`
-	Pricing       *OrderPricing    `json:"pricing"`
-	Status        OrderStatus      `json:"status"`
-	Shipping      *OrderShipping   `json:"shipping"`
-	Items         []*OrderItem     `json:"items"`
-	Timestamps    *OrderTimestamps `json:"timestamps"`
+	ID            int32          `json:"id"`
+	ExternalID    string         `json:"externalId"`
+	InvoiceNumber *string        `json:"invoiceNumber,omitempty"`
+	User          *UserRef       `json:"user"`
+	Pricing       *OrderPricing  `json:"pricing"`
+	Status        OrderStatus    `json:"status"`
+	Shipping      *OrderShipping `json:"shipping"`
+	// Where and when a self pickup order is collected; only on order detail
+	Pickup *OrderPickup `json:"pickup,omitempty"`
+	// Same-day slot an instant order is delivered in; only on order detail
+	DeliveryWindow *DeliveryWindow `json:"deliveryWindow,omitempty"`
+	// Policy versions accepted when the order was placed; only on order detail
+	AcceptedPolicies []*Policy `json:"acceptedPolicies,omitempty"`
+	// Why a cancelled or failed order stopped; only on order detail. Customers
+	// only see customer reasons; internal ones and the note are for admins.
+	CancelReason *OrderReason     `json:"cancelReason,omitempty"`
+	CancelNote   *string          `json:"cancelNote,omitempty"`
+	Items        []*OrderItem     `json:"items"`
+	Timestamps   *OrderTimestamps `json:"timestamps"`
+}
+
+type OrderAdjustment struct {
+	ID          string                `json:"id"`
+	OrderID     int32                 `json:"orderId"`
+	Kind        OrderAdjustmentKind   `json:"kind"`
+	Amount      int32                 `json:"amount"`
+	Reason      string                `json:"reason"`
+	Status      OrderAdjustmentStatus `json:"status"`
+	RequestedBy string                `json:"requestedBy"`
+	ReviewedBy  *string               `json:"reviewedBy,omitempty"`
+	CreatedAt   time.Time             `json:"createdAt"`
+	ReviewedAt  *time.Time            `json:"reviewedAt,omitempty"`
+}
+
+type OrderChange struct {
+	ID      string `json:"id"`
+	OrderID string `json:"orderId"`
+	// Empty once the order has been deleted
+	OrderExternalID string          `json:"orderExternalId"`
+	Operation       ChangeOperation `json:"operation"`
+	// Columns an update changed; empty for inserts and deletes
+	ChangedColumns []string  `json:"changedColumns"`
+	ChangedAt      time.Time `json:"changedAt"`
+}
+
+type OrderConnection struct {
+	Items    []*Order  `json:"items"`
+	PageInfo *PageInfo `json:"pageInfo"`
 }
 
 type OrderFilterInput struct {
@@ -236,6 +831,12 @@ type OrderFilterInput struct {
 	Status   *OrderStatus `json:"status,omitempty"`
 	DateFrom *time.Time   `json:"dateFrom,omitempty"`
 	DateTo   *time.Time   `json:"dateTo,omitempty"`
+	// Orders with an item whose product and variant name contain every word, e.g. "Beras 5kg"
+	Product *string `json:"product,omitempty"`
+	// Orders with an item of this variant
+	VariantID *string `json:"variantId,omitempty"`
+	// Cannot be combined with dateFrom or dateTo
+	DatePreset *OrderDatePreset `json:"datePreset,omitempty"`
 }
 
 type OrderItem struct {
@@ -251,9 +852,42 @@ type OrderItemPricing struct {
 	Subtotal int32 `json:"subtotal"`
 }
 
-type OrderListResponse struct {
-	Items    []*Order       `json:"items"`
-	PageInfo *PageInfoOrder `json:"pageInfo"`
+type OrderMessage struct {
+	ID      string             `json:"id"`
+	OrderID string             `json:"orderId"`
+	Sender  OrderMessageSender `json:"sender"`
+	// Null once the sender's account is gone
+	SenderID *string `json:"senderId,omitempty"`
+	// Empty when the message is only attachments
+	Body        string          `json:"body"`
+	Attachments []*UploadedFile `json:"attachments"`
+	CreatedAt   time.Time       `json:"createdAt"`
+}
+
+type OrderMessageThread struct {
+	OrderID string `json:"orderId"`
+	// Oldest first
+	Messages []*OrderMessage `json:"messages"`
+	// Messages from the other side the caller's side has not read, in the whole thread
+	UnreadCount int32 `json:"unreadCount"`
+	// Whether there are messages before the first one; page back with before
+	HasMore bool `json:"hasMore"`
+}
+
+type OrderMessageUnread struct {
+	OrderID         string    `json:"orderId"`
+	OrderExternalID string    `json:"orderExternalId"`
+	UnreadCount     int32     `json:"unreadCount"`
+	LastMessageAt   time.Time `json:"lastMessageAt"`
+}
+
+type OrderPickup struct {
+	Location  *PickupLocation `json:"location"`
+	SlotStart time.Time       `json:"slotStart"`
+	SlotEnd   time.Time       `json:"slotEnd"`
+	// Said at the counter to collect the order; only shown to the order's customer
+	Code       *string    `json:"code,omitempty"`
+	PickedUpAt *time.Time `json:"pickedUpAt,omitempty"`
 }
 
 type OrderPricing struct {
@@ -262,11 +896,60 @@ type OrderPricing struct {
 	Tax         int32  `json:"tax"`
 	Discount    int32  `json:"discount"`
 	ShippingFee int32  `json:"shippingFee"`
-	Total       int32  `json:"total"`
+	// Paid to insure the shipment; 0 when not insured
+	InsuranceFee int32 `json:"insuranceFee"`
+	// Net of the approved admin adjustments: charges minus discounts
+	Adjustment   int32 `json:"adjustment"`
+	Total        int32 `json:"total"`
+	WalletAmount int32 `json:"walletAmount"`
+}
+
+type OrderReason struct {
+	ID        string              `json:"id"`
+	Code      string              `json:"code"`
+	Kind      OrderReasonKind     `json:"kind"`
+	Audience  OrderReasonAudience `json:"audience"`
+	Label     string              `json:"label"`
+	Position  int32               `json:"position"`
+	IsActive  bool                `json:"isActive"`
+	UpdatedAt time.Time           `json:"updatedAt"`
+}
+
+type OrderReasonStat struct {
+	Reason *OrderReason `json:"reason"`
+	// Orders cancelled, or refunded, for this reason in the range
+	Orders int32 `json:"orders"`
+	// Order totals cancelled, or amounts refunded, in the range
+	Amount int32 `json:"amount"`
+}
+
+// Where the receipt email of a paid order stands
+type OrderReceipt struct {
+	OrderID  string    `json:"orderId"`
+	QueuedAt time.Time `json:"queuedAt"`
+	// Null while the receipt waits to be sent, including after a resend
+	SentAt    *time.Time `json:"sentAt,omitempty"`
+	SentTo    *string    `json:"sentTo,omitempty"`
+	Attempts  int32      `json:"attempts"`
+	LastError *string    `json:"lastError,omitempty"`
+	ResentAt  *time.Time `json:"resentAt,omitempty"`
 }
 
 type OrderShipping struct {
-	Address *Address `json:"address"`
+	Address *Address       `json:"address"`
+	Method  ShippingMethod `json:"method"`
+}
+
+type OrderSLABreach struct {
+	ID              string      `json:"id"`
+	OrderID         string      `json:"orderId"`
+	OrderExternalID string      `json:"orderExternalId"`
+	OrderStatus     OrderStatus `json:"orderStatus"`
+	Rule            string      `json:"rule"`
+	EnteredAt       time.Time   `json:"enteredAt"`
+	Deadline        time.Time   `json:"deadline"`
+	DetectedAt      time.Time   `json:"detectedAt"`
+	ResolvedAt      *time.Time  `json:"resolvedAt,omitempty"`
 }
 
 type OrderSortInput struct {
@@ -287,8 +970,13 @@ type Package struct {
 	Items     []*PackageItem `json:"items"`
 	Type      string         `json:"type"`
 	IsActive  bool           `json:"isActive"`
-	CreatedAt string         `json:"createdAt"`
-	UpdatedAt string         `json:"updatedAt"`
+	CreatedAt time.Time      `json:"createdAt"`
+	UpdatedAt time.Time      `json:"updatedAt"`
+}
+
+type PackageConnection struct {
+	Items    []*Package `json:"items"`
+	PageInfo *PageInfo  `json:"pageInfo"`
 }
 
 type PackageFilterInput struct {
@@ -298,20 +986,15 @@ type PackageFilterInput struct {
 }
 
 type PackageItem struct {
-	ID        string  `json:"id"`
-	PackageID string  `json:"packageId"`
-	VariantID string  `json:"variantId"`
-	ImageURL  string  `json:"imageUrl"`
-	Name      string  `json:"name"`
-	Price     float64 `json:"price"`
-	Quantity  int32   `json:"quantity"`
-	CreatedAt string  `json:"createdAt"`
-	UpdatedAt string  `json:"updatedAt"`
-}
-
-type PackageListResponse struct {
-	Items    []*Package `json:"items"`
-	PageInfo *PageInfo  `json:"pageInfo"`
+	ID        string    `json:"id"`
+	PackageID string    `json:"packageId"`
+	VariantID string    `json:"variantId"`
+	ImageURL  string    `json:"imageUrl"`
+	Name      string    `json:"name"`
+	Price     float64   `json:"price"`
+	Quantity  int32     `json:"quantity"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
 }
 
 type PackageSortInput struct {
@@ -319,6 +1002,7 @@ type PackageSortInput struct {
 	Direction SortDirection    `json:"direction"`
 }
 
+// Paging of a list query. Every list returns a Connection of items and a PageInfo, and takes page and limit, or after with the endCursor of the page before
 type PageInfo struct {
 	TotalItems      int32 `json:"totalItems"`
 	TotalPages      int32 `json:"totalPages"`
@@ -326,27 +1010,15 @@ type PageInfo struct {
 	Limit           int32 `json:"limit"`
 	HasNextPage     bool  `json:"hasNextPage"`
 	HasPreviousPage bool  `json:"hasPreviousPage"`
-}
-
-type PageInfoCursorStyle struct {
-	HasNextPage     bool    `json:"hasNextPage"`
-	HasPreviousPage bool    `json:"hasPreviousPage"`
-	StartCursor     *string `json:"startCursor,omitempty"`
-	EndCursor       *string `json:"endCursor,omitempty"`
-}
-
-type PageInfoOrder struct {
-	TotalItems      int32 `json:"totalItems"`
-	TotalPages      int32 `json:"totalPages"`
-	Page            int32 `json:"page"`
-	Limit           int32 `json:"limit"`
-	HasNextPage     bool  `json:"hasNextPage"`
-	HasPreviousPage bool  `json:"hasPreviousPage"`
+	// Pass as after, with the same limit, for the next page; null on the last page
+	EndCursor *string `json:"endCursor,omitempty"`
 }
 
 type PaginationInput struct {
 	Page  int32 `json:"page"`
 	Limit int32 `json:"limit"`
+	// endCursor of the page before; takes precedence over page
+	After *string `json:"after,omitempty"`
 }
 
 type Payment struct {
@@ -364,17 +1036,109 @@ type PaymentDetail struct {
 	Instructions []string `json:"instructions"`
 }
 
+type PaymentDispute struct {
+	ID                string        `json:"id"`
+	OrderID           string        `json:"orderId"`
+	OrderExternalID   string        `json:"orderExternalId"`
+	PaymentReference  string        `json:"paymentReference"`
+	ProviderDisputeID string        `json:"providerDisputeId"`
+	Amount            int32         `json:"amount"`
+	Currency          string        `json:"currency"`
+	Reason            *string       `json:"reason,omitempty"`
+	Status            DisputeStatus `json:"status"`
+	ResolutionNote    *string       `json:"resolutionNote,omitempty"`
+	PointsClawedBack  int32         `json:"pointsClawedBack"`
+	// Seller earnings held while the dispute is open; released when won, reversed when lost
+	PayoutHeld int32      `json:"payoutHeld"`
+	OpenedAt   time.Time  `json:"openedAt"`
+	ResolvedAt *time.Time `json:"resolvedAt,omitempty"`
+}
+
 type PaymentOrderInfoResponse struct {
-	OrderExternalID string           `json:"orderExternalID"`
-	Status          PaymentStatus    `json:"status"`
-	ExpiresAt       time.Time        `json:"expiresAt"`
-	TotalAmount     int32            `json:"totalAmount"`
-	Currency        string           `json:"currency"`
-	ShippingAddress *ShippingAddress `json:"shippingAddress"`
-	Payment         *PaymentDetail   `json:"payment"`
+	OrderExternalID string        `json:"orderExternalID"`
+	Status          PaymentStatus `json:"status"`
+	ExpiresAt       time.Time     `json:"expiresAt"`
+	// Whole seconds until expiresAt; 0 once passed or when the payment is no longer pending
+	SecondsRemaining int32            `json:"secondsRemaining"`
+	TotalAmount      int32            `json:"totalAmount"`
+	Currency         string           `json:"currency"`
+	ShippingAddress  *ShippingAddress `json:"shippingAddress"`
+	Payment          *PaymentDetail   `json:"payment"`
+	// What happened to the payment so far, oldest first.
+	Timeline []*PaymentTimelineEntry `json:"timeline"`
+}
+
+type PaymentTimelineEntry struct {
+	Event      PaymentTimelineEvent `json:"event"`
+	OccurredAt time.Time            `json:"occurredAt"`
+	// Amount moved by this step; null for steps that move no money.
+	Amount *int32 `json:"amount,omitempty"`
+}
+
+// Logs in with a verified phone number instead of an email.
+type PhoneLoginInput struct {
+	Phone    string `json:"phone"`
+	Password string `json:"password"`
+}
+
+type PickupLocation struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Address     string    `json:"address"`
+	City        string    `json:"city"`
+	OpensAt     string    `json:"opensAt"`
+	ClosesAt    string    `json:"closesAt"`
+	SlotMinutes int32     `json:"slotMinutes"`
+	IsActive    bool      `json:"isActive"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+type PickupSlot struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+type Policy struct {
+	ID          string     `json:"id"`
+	Kind        PolicyKind `json:"kind"`
+	Version     int32      `json:"version"`
+	Title       string     `json:"title"`
+	Body        string     `json:"body"`
+	PublishedAt time.Time  `json:"publishedAt"`
 }
 
 type Product struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	SellerID   string `json:"sellerId"`
+	SellerName string `json:"sellerName"`
+	// Slug of the seller's store, for linking to storeBySlug
+	StoreSlug *string `json:"storeSlug,omitempty"`
+	// Set while the seller is on vacation; the product can not be checked out until then
+	UnavailableUntil *time.Time `json:"unavailableUntil,omitempty"`
+	// Shipping origin the seller picked for it; null ships from the seller's default origin
+	ShippingOriginID *string    `json:"shippingOriginId,omitempty"`
+	CategoryID       string     `json:"categoryID"`
+	CategoryName     string     `json:"categoryName"`
+	SubcategoryID    string     `json:"subcategoryID"`
+	SubcategoryName  string     `json:"subcategoryName"`
+	Slug             string     `json:"slug"`
+	Variants         []*Variant `json:"variants,omitempty"`
+	ImageURL         *string    `json:"imageUrl,omitempty"`
+	Description      *string    `json:"description,omitempty"`
+	Status           *string    `json:"status,omitempty"`
+	CreatedAt        time.Time  `json:"createdAt"`
+	UpdatedAt        *time.Time `json:"updatedAt,omitempty"`
+}
+
+type ProductByCategory struct {
+	CategoryName  string     `json:"categoryName"`
+	CategorySlug  string     `json:"categorySlug"`
+	TotalProducts int32      `json:"totalProducts"`
+	Products      []*Product `json:"products,omitempty"`
+}
+
+type ProductCart struct {
 	ID              string     `json:"id"`
 	Name            string     `json:"name"`
 	SellerID        string     `json:"sellerId"`
@@ -384,48 +1148,41 @@ type Product struct {
 	SubcategoryID   string     `json:"subcategoryID"`
 	SubcategoryName string     `json:"subcategoryName"`
 	Slug            string     `json:"slug"`
-	Variants        []*Variant `json:"variants,omitempty"`
+	Variant         *Variant   `json:"variant,omitempty"`
 	ImageURL        *string    `json:"imageUrl,omitempty"`
 	Description     *string    `json:"description,omitempty"`
 	Status          *string    `json:"status,omitempty"`
-	CreatedAt       string     `json:"createdAt"`
-	UpdatedAt       *string    `json:"updatedAt,omitempty"`
+	CreatedAt       time.Time  `json:"createdAt"`
+	UpdatedAt       *time.Time `json:"updatedAt,omitempty"`
 }
 
-type ProductByCategory struct {
-	CategoryName  string     `json:"categoryName"`
-	CategorySlug  string     `json:"categorySlug"`
-	TotalProducts int32      `json:"totalProducts"`
-	Products      []*Product `json:"products,omitempty"`
+type ProductChange struct {
+	ID        string `json:"id"`
+	ProductID string `json:"productId"`
+	// Set when the change is to one of the product's variants
+	VariantID      *string         `json:"variantId,omitempty"`
+	Operation      ChangeOperation `json:"operation"`
+	ChangedColumns []string        `json:"changedColumns"`
+	ChangedAt      time.Time       `json:"changedAt"`
 }
 
-type ProductCart struct {
-	ID              string   `json:"id"`
-	Name            string   `json:"name"`
-	SellerID        string   `json:"sellerId"`
-	SellerName      string   `json:"sellerName"`
-	CategoryID      string   `json:"categoryID"`
-	CategoryName    string   `json:"categoryName"`
-	SubcategoryID   string   `json:"subcategoryID"`
-	SubcategoryName string   `json:"subcategoryName"`
-	Slug            string   `json:"slug"`
-	Variant         *Variant `json:"variant,omitempty"`
-	ImageURL        *string  `json:"imageUrl,omitempty"`
-	Description     *string  `json:"description,omitempty"`
-	Status          *string  `json:"status,omitempty"`
-	CreatedAt       string   `json:"createdAt"`
-	UpdatedAt       *string  `json:"updatedAt,omitempty"`
+type ProductComparison struct {
+	Products   []*ComparedProduct            `json:"products"`
+	Attributes []*ProductComparisonAttribute `json:"attributes"`
 }
 
-type ProductConnection struct {
-	Edges      []*ProductEdge `json:"edges"`
-	PageInfo   *PageInfo      `json:"pageInfo"`
-	TotalCount int32          `json:"totalCount"`
+// One row of a comparison, with a value per product in the order of products; null where a product has none
+type ProductComparisonAttribute struct {
+	Key    string    `json:"key"`
+	Label  string    `json:"label"`
+	Values []*string `json:"values"`
+	// The products do not all have the same value
+	Differs bool `json:"differs"`
 }
 
-type ProductEdge struct {
-	Node   *Product `json:"node"`
-	Cursor string   `json:"cursor"`
+type ProductConnection struct {
+	Items    []*Product `json:"items"`
+	PageInfo *PageInfo  `json:"pageInfo"`
 }
 
 type ProductFilterInput struct {
@@ -439,39 +1196,113 @@ type ProductFilterInput struct {
 	SellerName   *string  `json:"sellerName,omitempty"`
 }
 
-type ProductPage struct {
-	Items      []*Product `json:"items"`
-	Page       int32      `json:"page"`
-	Limit      int32      `json:"limit"`
-	TotalCount int32      `json:"totalCount"`
-	TotalPages int32      `json:"totalPages"`
-	HasNext    bool       `json:"hasNext"`
-}
-
 type ProductSortInput struct {
 	Field     ProductSortField `json:"field"`
 	Direction SortDirection    `json:"direction"`
 }
 
 type Profile struct {
-	ID          string  `json:"id"`
-	UserID      string  `json:"userId"`
-	FullName    *string `json:"fullName,omitempty"`
+	ID       string  `json:"id"`
+	UserID   string  `json:"userId"`
+	FullName *string `json:"fullName,omitempty"`
+	// The name shown publicly instead of fullName
+	DisplayName *string `json:"displayName,omitempty"`
 	Email       *string `json:"email,omitempty"`
 	Bio         *string `json:"bio,omitempty"`
 	AvatarURL   *string `json:"avatarUrl,omitempty"`
 	Phone       *string `json:"phone,omitempty"`
-	DateOfBirth *string `json:"dateOfBirth,omitempty"`
-	CreatedAt   *string `json:"createdAt,omitempty"`
-	UpdatedAt   *string `json:"updatedAt,omitempty"`
+	// Whether phone was proven with a one-time code. Cash on delivery needs it.
+	PhoneVerified bool       `json:"phoneVerified"`
+	DateOfBirth   *string    `json:"dateOfBirth,omitempty"`
+	CreatedAt     *time.Time `json:"createdAt,omitempty"`
+	UpdatedAt     *time.Time `json:"updatedAt,omitempty"`
+}
+
+type PromotionReportInput struct {
+	From time.Time `json:"from"`
+	To   time.Time `json:"to"`
+}
+
+type PublishPolicyInput struct {
+	Kind  PolicyKind `json:"kind"`
+	Title string     `json:"title"`
+	Body  string     `json:"body"`
+}
+
+// A quantity type variants can be sold in, with how it converts to its base unit
+type QuantityTypeInfo struct {
+	Code  string `json:"code"`
+	Label string `json:"label"`
+	// g or ml; null for types without a fixed measure
+	BaseUnit *string `json:"baseUnit,omitempty"`
+	// How many baseUnit one quantity holds
+	BasePerUnit *int32 `json:"basePerUnit,omitempty"`
+	// Amount of baseUnit unit prices are quoted for
+	UnitPricePer *int32 `json:"unitPricePer,omitempty"`
 }
 
 type Query struct {
 }
 
+type ReferralStats struct {
+	Code        string `json:"code"`
+	Signups     int32  `json:"signups"`
+	Pending     int32  `json:"pending"`
+	Rewarded    int32  `json:"rewarded"`
+	Rejected    int32  `json:"rejected"`
+	TotalEarned int32  `json:"totalEarned"`
+}
+
+type Refund struct {
+	ID       string       `json:"id"`
+	OrderID  string       `json:"orderId"`
+	Amount   int32        `json:"amount"`
+	Currency string       `json:"currency"`
+	Method   RefundMethod `json:"method"`
+	Status   RefundStatus `json:"status"`
+	// The RETURN reason picked; unset on refunds requested before reasons existed
+	ReasonID *string `json:"reasonId,omitempty"`
+	// Details the customer added
+	Reason        *string    `json:"reason,omitempty"`
+	FailureReason *string    `json:"failureReason,omitempty"`
+	CreatedAt     time.Time  `json:"createdAt"`
+	CompletedAt   *time.Time `json:"completedAt,omitempty"`
+}
+
 type RegisterInput struct {
-	Email    string `json:"email"`
-	Password string `json:"password"`
+	Email        string  `json:"email"`
+	Password     string  `json:"password"`
+	ReferralCode *string `json:"referralCode,omitempty"`
+}
+
+type RemoveSessionItemInput struct {
+	ExternalID string `json:"externalId"`
+	ItemID     string `json:"itemId"`
+}
+
+type RequestOrderAdjustmentInput struct {
+	OrderID string              `json:"orderId"`
+	Kind    OrderAdjustmentKind `json:"kind"`
+	// In rupiah, more than 0
+	Amount int32  `json:"amount"`
+	Reason string `json:"reason"`
+}
+
+type RequestPhoneVerificationInput struct {
+	Phone string `json:"phone"`
+}
+
+type RequestRefundInput struct {
+	OrderID string       `json:"orderId"`
+	Method  RefundMethod `json:"method"`
+	// An active customer RETURN reason from orderReasons
+	ReasonID string `json:"reasonId"`
+	// Details for the reason
+	Reason *string `json:"reason,omitempty"`
+}
+
+type RequestRefundResponse struct {
+	Refunds []*Refund `json:"refunds"`
 }
 
 type ResetPasswordInput struct {
@@ -489,6 +1320,146 @@ type Response struct {
 	Message *string `json:"message,omitempty"`
 }
 
+type RestrictAccountInput struct {
+	UserID string                  `json:"userId"`
+	Level  AccountRestrictionLevel `json:"level"`
+	Reason string                  `json:"reason"`
+	// When the restriction stops applying; it never does when null
+	ExpiresAt *time.Time `json:"expiresAt,omitempty"`
+}
+
+type RetentionPolicyResult struct {
+	Policy   RetentionPolicy `json:"policy"`
+	Cutoff   time.Time       `json:"cutoff"`
+	Affected int32           `json:"affected"`
+	DryRun   bool            `json:"dryRun"`
+}
+
+type SchedulePriceChangeInput struct {
+	VariantID string  `json:"variantId"`
+	Price     float64 `json:"price"`
+	// In the future and at most a year ahead
+	EffectiveAt time.Time `json:"effectiveAt"`
+}
+
+// A price a seller scheduled for one of their variants
+type ScheduledPriceChange struct {
+	ID          string `json:"id"`
+	VariantID   string `json:"variantId"`
+	ProductID   string `json:"productId"`
+	ProductName string `json:"productName"`
+	VariantName string `json:"variantName"`
+	// The variant's price now
+	CurrentPrice float64 `json:"currentPrice"`
+	// The price it changes to
+	Price       float64   `json:"price"`
+	EffectiveAt time.Time `json:"effectiveAt"`
+	// The variant is on sale (has a compareAtPrice); a due change waits until the sale ends
+	HeldBySale bool      `json:"heldBySale"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+// A table read mostly by large sequential scans; it may be missing an index
+type SeqScanTable struct {
+	Table          string `json:"table"`
+	SeqScans       int32  `json:"seqScans"`
+	AvgRowsPerScan int32  `json:"avgRowsPerScan"`
+	IndexScans     int32  `json:"indexScans"`
+	LiveTuples     int32  `json:"liveTuples"`
+}
+
+type SetCheckoutRuleInput struct {
+	// Province the rule applies to; omit for the default rule
+	Region         *string `json:"region,omitempty"`
+	MinOrderAmount int32   `json:"minOrderAmount"`
+	// Subtotal from which shipping is free; omit to never waive shipping
+	FreeShippingMin *int32 `json:"freeShippingMin,omitempty"`
+	IsActive        bool   `json:"isActive"`
+}
+
+type SetDeliverySlotInput struct {
+	// Omit to add a new slot
+	ID *string `json:"id,omitempty"`
+	// Province the slot delivers to
+	Region string `json:"region"`
+	// Local start time as HH:MM
+	StartsAt string `json:"startsAt"`
+	// Local end time as HH:MM
+	EndsAt string `json:"endsAt"`
+	// Orders the slot takes a day
+	Capacity int32 `json:"capacity"`
+	IsActive bool  `json:"isActive"`
+}
+
+// Omitting level, debugModules and sampling clears the override.
+type SetLogSettingsInput struct {
+	Level *LogLevel `json:"level,omitempty"`
+	// Packages under internal/, such as payment, whose debug logs are written at any level.
+	DebugModules    []string `json:"debugModules"`
+	Sampling        *bool    `json:"sampling,omitempty"`
+	DurationMinutes int32    `json:"durationMinutes"`
+}
+
+type SetMaintenanceModeInput struct {
+	Enabled    bool    `json:"enabled"`
+	AllowReads bool    `json:"allowReads"`
+	Message    *string `json:"message,omitempty"`
+}
+
+type SetOrderReasonInput struct {
+	// Omit to add a new reason
+	ID *string `json:"id,omitempty"`
+	// Stable key like CHANGED_MIND: upper case letters, digits and underscores
+	Code     string              `json:"code"`
+	Kind     OrderReasonKind     `json:"kind"`
+	Audience OrderReasonAudience `json:"audience"`
+	Label    string              `json:"label"`
+	// Lower comes first in lists
+	Position int32 `json:"position"`
+	// Inactive reasons stay on past orders but can no longer be picked
+	IsActive bool `json:"isActive"`
+}
+
+type SetPickupLocationInput struct {
+	// Omit to add a new location
+	ID      *string `json:"id,omitempty"`
+	Name    string  `json:"name"`
+	Address string  `json:"address"`
+	City    string  `json:"city"`
+	// Local opening time as HH:MM
+	OpensAt string `json:"opensAt"`
+	// Local closing time as HH:MM
+	ClosesAt    string `json:"closesAt"`
+	SlotMinutes int32  `json:"slotMinutes"`
+	IsActive    bool   `json:"isActive"`
+}
+
+// One row of the gateway's settlement report
+type SettlementFeeInput struct {
+	PaymentRequestID string `json:"paymentRequestId"`
+	// What the gateway kept of the payment, VAT included
+	Fee int32 `json:"fee"`
+}
+
+type Shipment struct {
+	ID          string                   `json:"id"`
+	OrderID     string                   `json:"orderId"`
+	Courier     string                   `json:"courier"`
+	Awb         string                   `json:"awb"`
+	Status      ShipmentStatus           `json:"status"`
+	ShippedAt   time.Time                `json:"shippedAt"`
+	LastEventAt *time.Time               `json:"lastEventAt,omitempty"`
+	DeliveredAt *time.Time               `json:"deliveredAt,omitempty"`
+	Events      []*ShipmentTrackingEvent `json:"events"`
+}
+
+type ShipmentTrackingEvent struct {
+	Status      ShipmentStatus `json:"status"`
+	Description *string        `json:"description,omitempty"`
+	Location    *string        `json:"location,omitempty"`
+	OccurredAt  time.Time      `json:"occurredAt"`
+}
+
 type ShippingAddress struct {
 	Name         string  `json:"name"`
 	ReceiverName string  `json:"receiverName"`
@@ -500,17 +1471,235 @@ type ShippingAddress struct {
 	PostalCode   string  `json:"postalCode"`
 }
 
+type ShippingOption struct {
+	Method ShippingMethod `json:"method"`
+	// What the session would pay for shipping with this method
+	Fee       int32 `json:"fee"`
+	Available bool  `json:"available"`
+	// Straight-line distance from the farthest seller; instant delivery only
+	DistanceKm *float64 `json:"distanceKm,omitempty"`
+	// Why the method cannot be picked, when unavailable
+	UnavailableReason *string `json:"unavailableReason,omitempty"`
+	// What insuring the goods costs with this method's courier; null when it does not insure
+	InsuranceFee *int32 `json:"insuranceFee,omitempty"`
+}
+
+// Summary of pg_stat_statements since statistics were last reset. Modules
+// are listed busiest first.
+type SlowQueryReport struct {
+	// False when pg_stat_statements is not installed or not preloaded
+	Available  bool                `json:"available"`
+	Statements []*SlowStatement    `json:"statements"`
+	Modules    []*ModuleQueryStats `json:"modules"`
+}
+
+type SlowStatement struct {
+	QueryID string `json:"queryId"`
+	// Package that ran the statement, from the comment the database driver adds
+	Module *string `json:"module,omitempty"`
+	// Function that ran the statement
+	Method *string `json:"method,omitempty"`
+	// Normalized text, trimmed to 500 characters
+	Query       string  `json:"query"`
+	Calls       int32   `json:"calls"`
+	Rows        int32   `json:"rows"`
+	MeanTimeMs  float64 `json:"meanTimeMs"`
+	TotalTimeMs float64 `json:"totalTimeMs"`
+}
+
+type StockAdjustment struct {
+	ID          string                `json:"id"`
+	WarehouseID string                `json:"warehouseId"`
+	VariantID   string                `json:"variantId"`
+	Delta       int32                 `json:"delta"`
+	Reason      string                `json:"reason"`
+	Status      StockAdjustmentStatus `json:"status"`
+	RequestedBy string                `json:"requestedBy"`
+	ReviewedBy  *string               `json:"reviewedBy,omitempty"`
+	CreatedAt   time.Time             `json:"createdAt"`
+	ReviewedAt  *time.Time            `json:"reviewedAt,omitempty"`
+}
+
+type StockIncident struct {
+	ID                string    `json:"id"`
+	VariantID         string    `json:"variantId"`
+	VariantName       string    `json:"variantName"`
+	CheckoutSessionID *string   `json:"checkoutSessionId,omitempty"`
+	Requested         int32     `json:"requested"`
+	Available         int32     `json:"available"`
+	CreatedAt         time.Time `json:"createdAt"`
+}
+
+type StockOversellReport struct {
+	Incidents             []*StockIncident        `json:"incidents"`
+	NegativeStockVariants []*NegativeStockVariant `json:"negativeStockVariants"`
+}
+
+type StockTransfer struct {
+	ID              string              `json:"id"`
+	VariantID       string              `json:"variantId"`
+	FromWarehouseID string              `json:"fromWarehouseId"`
+	ToWarehouseID   string              `json:"toWarehouseId"`
+	Quantity        int32               `json:"quantity"`
+	Status          StockTransferStatus `json:"status"`
+	Note            *string             `json:"note,omitempty"`
+	CreatedAt       time.Time           `json:"createdAt"`
+	ReceivedAt      *time.Time          `json:"receivedAt,omitempty"`
+	CancelledAt     *time.Time          `json:"cancelledAt,omitempty"`
+}
+
+// A seller's public storefront
+type Store struct {
+	SellerID    string  `json:"sellerId"`
+	Slug        string  `json:"slug"`
+	Name        string  `json:"name"`
+	LogoURL     *string `json:"logoUrl,omitempty"`
+	Description *string `json:"description,omitempty"`
+	// Active products only
+	ProductCount int32 `json:"productCount"`
+	// Opening hours in Asia/Jakarta time; days not listed are closed
+	OperatingHours []*StoreOperatingHours `json:"operatingHours"`
+	// Null when the store has not set opening hours; false during a vacation
+	OpenNow *bool `json:"openNow,omitempty"`
+	// The vacation running now, if any
+	Vacation  *StoreVacation `json:"vacation,omitempty"`
+	CreatedAt time.Time      `json:"createdAt"`
+	UpdatedAt time.Time      `json:"updatedAt"`
+}
+
+type StoreOperatingHours struct {
+	Weekday Weekday `json:"weekday"`
+	// HH:MM
+	OpensAt string `json:"opensAt"`
+	// HH:MM, after opensAt
+	ClosesAt string `json:"closesAt"`
+}
+
+type StoreOperatingHoursInput struct {
+	Weekday  Weekday `json:"weekday"`
+	OpensAt  string  `json:"opensAt"`
+	ClosesAt string  `json:"closesAt"`
+}
+
+// An address the seller ships from, used for shipping rates and packing slips
+type StoreShippingOrigin struct {
+	ID string `json:"id"`
+	// Seller's own name for it, such as the warehouse name
+	Label        string  `json:"label"`
+	ContactName  string  `json:"contactName"`
+	Phone        string  `json:"phone"`
+	AddressLine1 string  `json:"addressLine1"`
+	AddressLine2 *string `json:"addressLine2,omitempty"`
+	City         string  `json:"city"`
+	Province     string  `json:"province"`
+	PostalCode   string  `json:"postalCode"`
+	// Instant delivery is only sent from origins with a location
+	Location *Location `json:"location,omitempty"`
+	// Used for products without an origin of their own
+	IsDefault bool      `json:"isDefault"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type StoreShippingOriginInput struct {
+	Label        string  `json:"label"`
+	ContactName  string  `json:"contactName"`
+	Phone        string  `json:"phone"`
+	AddressLine1 string  `json:"addressLine1"`
+	AddressLine2 *string `json:"addressLine2,omitempty"`
+	City         string  `json:"city"`
+	Province     string  `json:"province"`
+	PostalCode   string  `json:"postalCode"`
+	// Omit to leave the origin unpinned; instant delivery needs it
+	Location *LocationInput `json:"location,omitempty"`
+	// Makes it the default; a seller's first origin is always the default
+	IsDefault *bool `json:"isDefault,omitempty"`
+}
+
+// A period in which the seller takes no orders; it ends by itself at endsAt
+type StoreVacation struct {
+	ID        string            `json:"id"`
+	StartsAt  time.Time         `json:"startsAt"`
+	EndsAt    time.Time         `json:"endsAt"`
+	Mode      StoreVacationMode `json:"mode"`
+	Message   *string           `json:"message,omitempty"`
+	CreatedAt time.Time         `json:"createdAt"`
+}
+
+type StoreVacationInput struct {
+	// Defaults to now; a time already past also means now
+	StartsAt *time.Time        `json:"startsAt,omitempty"`
+	EndsAt   time.Time         `json:"endsAt"`
+	Mode     StoreVacationMode `json:"mode"`
+	// Shown to shoppers while the vacation runs
+	Message *string `json:"message,omitempty"`
+}
+
+type StuckOrder struct {
+	ID          string    `json:"id"`
+	ExternalID  string    `json:"externalId"`
+	UserID      *string   `json:"userId,omitempty"`
+	TotalAmount int32     `json:"totalAmount"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
 type Subcategory struct {
 	ID         string `json:"id"`
 	CategoryID string `json:"categoryID"`
 	Name       string `json:"name"`
 }
 
-type SubcategoryPage struct {
+type SubcategoryConnection struct {
 	Items    []*Subcategory `json:"items"`
 	PageInfo *PageInfo      `json:"pageInfo"`
 }
 
+// One run of the synthetic checkout canary
+type SyntheticCheckoutRun struct {
+	ID     string `json:"id"`
+	Passed bool   `json:"passed"`
+	// First step that failed
+	FailedStep *string `json:"failedStep,omitempty"`
+	Error      *string `json:"error,omitempty"`
+	// The canary's order, cancelled again after the run
+	OrderExternalID *string                  `json:"orderExternalId,omitempty"`
+	Steps           []*SyntheticCheckoutStep `json:"steps"`
+	StartedAt       time.Time                `json:"startedAt"`
+	DurationMs      int32                    `json:"durationMs"`
+	// Admin who triggered the run; null for scheduled runs
+	TriggeredBy *string `json:"triggeredBy,omitempty"`
+}
+
+type SyntheticCheckoutStep struct {
+	// create_session, set_address, set_payment_method, confirm, payment or cleanup
+	Name       string `json:"name"`
+	DurationMs int32  `json:"durationMs"`
+	// Why the step failed; null when it passed
+	Error *string `json:"error,omitempty"`
+}
+
+// Price per amount of a base unit, e.g. 2500 per 100 g
+type UnitPrice struct {
+	Amount float64 `json:"amount"`
+	Per    int32   `json:"per"`
+	Unit   string  `json:"unit"`
+}
+
+type UnpaidConfirmedSession struct {
+	SessionExternalID string    `json:"sessionExternalId"`
+	UserID            *string   `json:"userId,omitempty"`
+	TotalPrice        int32     `json:"totalPrice"`
+	ConfirmedAt       time.Time `json:"confirmedAt"`
+	OrderExternalID   *string   `json:"orderExternalId,omitempty"`
+}
+
+// A non-unique index never scanned since statistics were reset
+type UnusedIndex struct {
+	Table  string `json:"table"`
+	Index  string `json:"index"`
+	SizeKb int32  `json:"sizeKb"`
+}
+
 type UpdateAddressInput struct {
 	AddressID    string        `json:"addressId"`
 	Address      *AddressInput `json:"address"`
@@ -529,6 +1718,16 @@ type UpdateCartInput struct {
 type UpdateOrderStatusInput struct {
 	OrderID string      `json:"orderId"`
 	Status  OrderStatus `json:"status"`
+	// Required when cancelling; an active CANCELLATION reason
+	ReasonID *string `json:"reasonId,omitempty"`
+	// Details for the cancellation
+	ReasonNote *string `json:"reasonNote,omitempty"`
+}
+
+type UpdatePreferencesInput struct {
+	Locale *string `json:"locale,omitempty"`
+	// Only the settings listed change
+	Notifications []*NotificationSettingInput `json:"notifications,omitempty"`
 }
 
 type UpdateProduct struct {
@@ -542,7 +1741,9 @@ type UpdateProduct struct {
 }
 
 type UpdateProfileInput struct {
-	FullName    *string `json:"fullName,omitempty"`
+	FullName *string `json:"fullName,omitempty"`
+	// 3 to 30 letters, digits, spaces, dots, dashes or underscores, without blocked terms
+	DisplayName *string `json:"displayName,omitempty"`
 	Bio         *string `json:"bio,omitempty"`
 	AvatarURL   *string `json:"avatarUrl,omitempty"`
 	Phone       *string `json:"phone,omitempty"`
@@ -550,23 +1751,53 @@ type UpdateProfileInput struct {
 }
 
 type UpdateSessionAddressInput struct {
-	ExternalID string  `json:"externalId"`
-	AddressID  string  `json:"addressId"`
-	GuestID    *string `json:"guestId,omitempty"`
+	ExternalID string `json:"externalId"`
+	AddressID  string `json:"addressId"`
 }
 
 type UpdateSessionAddressResponse struct {
 	Success bool `json:"success"`
 }
 
-type UpdateSessionPaymentMethodInput struct {
-	ExternalID    string  `json:"externalId"`
-	PaymentMethod string  `json:"paymentMethod"`
-	GuestID       *string `json:"guestId,omitempty"`
+type UpdateSessionInsuranceInput struct {
+	ExternalID string `json:"externalId"`
+	Insured    bool   `json:"insured"`
 }
 
-type UpdateSessionPaymentMethodResponse struct {
-	Success bool `json:"success"`
+type UpdateSessionItemInput struct {
+	ExternalID string `json:"externalId"`
+	ItemID     string `json:"itemId"`
+	Quantity   int32  `json:"quantity"`
+}
+
+type UpdateSessionPaymentMethodInput struct {
+	ExternalID    string `json:"externalId"`
+	PaymentMethod string `json:"paymentMethod"`
+}
+
+type UpdateSessionPaymentMethodResponse struct {
+	Success bool `json:"success"`
+}
+
+type UpdateSessionShippingMethodInput struct {
+	ExternalID     string         `json:"externalId"`
+	ShippingMethod ShippingMethod `json:"shippingMethod"`
+	// Required for SELF_PICKUP
+	PickupLocationID *string `json:"pickupLocationId,omitempty"`
+	// Start of a slot from pickupSlots; required for SELF_PICKUP
+	PickupSlotStart *time.Time `json:"pickupSlotStart,omitempty"`
+	// Slot from deliverySlots; INSTANT only, required where the region has slots
+	DeliverySlotID *string `json:"deliverySlotId,omitempty"`
+}
+
+// Only the fields set are changed; an empty logoUrl or description clears it
+type UpdateStoreInput struct {
+	// 3-60 lowercase letters, digits or single dashes
+	Slug *string `json:"slug,omitempty"`
+	Name *string `json:"name,omitempty"`
+	// An absolute http or https URL
+	LogoURL     *string `json:"logoUrl,omitempty"`
+	Description *string `json:"description,omitempty"`
 }
 
 type UpdateVariant struct {
@@ -575,9 +1806,38 @@ type UpdateVariant struct {
 	QuantityType *string  `json:"quantityType,omitempty"`
 	Name         *string  `json:"name,omitempty"`
 	Price        *float64 `json:"price,omitempty"`
-	Stock        *int32   `json:"stock,omitempty"`
-	ImageURL     *string  `json:"imageUrl,omitempty"`
-	Description  *string  `json:"description,omitempty"`
+	// Must not be below price; 0 ends the promotion
+	CompareAtPrice *float64 `json:"compareAtPrice,omitempty"`
+	Stock          *int32   `json:"stock,omitempty"`
+	ImageURL       *string  `json:"imageUrl,omitempty"`
+	Description    *string  `json:"description,omitempty"`
+	WeightGrams    *int32   `json:"weightGrams,omitempty"`
+	LengthCm       *int32   `json:"lengthCm,omitempty"`
+	WidthCm        *int32   `json:"widthCm,omitempty"`
+	HeightCm       *int32   `json:"heightCm,omitempty"`
+}
+
+type UploadedFile struct {
+	ID      string        `json:"id"`
+	Purpose UploadPurpose `json:"purpose"`
+	URL     string        `json:"url"`
+	// Detected from the file content, not the name the client sent
+	ContentType  string    `json:"contentType"`
+	Size         int32     `json:"size"`
+	OriginalName string    `json:"originalName"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+type UsageFlag struct {
+	ID        string          `json:"id"`
+	UserID    string          `json:"userId"`
+	Day       time.Time       `json:"day"`
+	Operation string          `json:"operation"`
+	Reason    UsageFlagReason `json:"reason"`
+	Count     int32           `json:"count"`
+	// Average daily count over the previous week
+	Baseline  float64   `json:"baseline"`
+	CreatedAt time.Time `json:"createdAt"`
 }
 
 type User struct {
@@ -586,22 +1846,41 @@ type User struct {
 	Role  Role   `json:"role"`
 }
 
+type UserPreferences struct {
+	// Language notices are written in: id or en
+	Locale string `json:"locale"`
+	// One setting per event and channel, defaults included
+	Notifications []*NotificationSetting `json:"notifications"`
+	UpdatedAt     *time.Time             `json:"updatedAt,omitempty"`
+}
+
 type UserRef struct {
 	ID int32 `json:"id"`
 }
 
 type Variant struct {
-	ID           string  `json:"id"`
-	Name         string  `json:"name"`
-	ProductID    string  `json:"productId"`
-	QuantityType string  `json:"quantityType"`
-	Price        float64 `json:"price"`
-	Stock        int32   `json:"stock"`
-	ImageURL     string  `json:"imageUrl"`
-	CategoryID   *string `json:"categoryID,omitempty"`
-	SellerID     string  `json:"sellerId"`
-	CreatedAt    string  `json:"createdAt"`
-	Description  *string `json:"description,omitempty"`
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	ProductID    string `json:"productId"`
+	QuantityType string `json:"quantityType"`
+	// Sale price
+	Price float64 `json:"price"`
+	// Original price to show struck through; null when not on promotion
+	CompareAtPrice *float64 `json:"compareAtPrice,omitempty"`
+	// How far price is below compareAtPrice, in whole percent; null when not on promotion
+	DiscountPercent *int32 `json:"discountPercent,omitempty"`
+	// Price per fixed amount of the quantity type's base unit; null for types without a measure
+	UnitPrice   *UnitPrice `json:"unitPrice,omitempty"`
+	Stock       int32      `json:"stock"`
+	ImageURL    string     `json:"imageUrl"`
+	CategoryID  *string    `json:"categoryID,omitempty"`
+	SellerID    string     `json:"sellerId"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	Description *string    `json:"description,omitempty"`
+	WeightGrams *int32     `json:"weightGrams,omitempty"`
+	LengthCm    *int32     `json:"lengthCm,omitempty"`
+	WidthCm     *int32     `json:"widthCm,omitempty"`
+	HeightCm    *int32     `json:"heightCm,omitempty"`
 }
 
 type VariantRef struct {
@@ -611,50 +1890,149 @@ type VariantRef struct {
 	ImageURL    *string `json:"imageUrl,omitempty"`
 }
 
-type CartSortField string
+type VerifyPhoneInput struct {
+	Code string `json:"code"`
+}
+
+type VerifyPickupCodeInput struct {
+	OrderID string `json:"orderId"`
+	Code    string `json:"code"`
+}
+
+type VoucherCampaign struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Description *string    `json:"description,omitempty"`
+	StartsAt    time.Time  `json:"startsAt"`
+	EndsAt      *time.Time `json:"endsAt,omitempty"`
+	CreatedAt   time.Time  `json:"createdAt"`
+}
+
+type Wallet struct {
+	Balance  int32                `json:"balance"`
+	Currency string               `json:"currency"`
+	Entries  []*WalletLedgerEntry `json:"entries"`
+}
+
+type WalletLedgerEntry struct {
+	ID            string          `json:"id"`
+	Type          WalletEntryType `json:"type"`
+	Amount        int32           `json:"amount"`
+	BalanceAfter  int32           `json:"balanceAfter"`
+	ReferenceType string          `json:"referenceType"`
+	ReferenceID   string          `json:"referenceId"`
+	Note          *string         `json:"note,omitempty"`
+	CreatedAt     time.Time       `json:"createdAt"`
+}
+
+type Warehouse struct {
+	ID        string    `json:"id"`
+	Code      string    `json:"code"`
+	Name      string    `json:"name"`
+	Region    string    `json:"region"`
+	IsDefault bool      `json:"isDefault"`
+	IsActive  bool      `json:"isActive"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+type WarehouseStockLevel struct {
+	WarehouseID   string `json:"warehouseId"`
+	WarehouseCode string `json:"warehouseCode"`
+	VariantID     string `json:"variantId"`
+	Quantity      int32  `json:"quantity"`
+	InTransit     int32  `json:"inTransit"`
+}
+
+type WebhookHealth struct {
+	Pending         int32      `json:"pending"`
+	Failed          int32      `json:"failed"`
+	OldestPendingAt *time.Time `json:"oldestPendingAt,omitempty"`
+}
+
+// A wishlisted variant dropped in price or came back in stock
+type WishlistAlert struct {
+	ID          string            `json:"id"`
+	Kind        WishlistAlertKind `json:"kind"`
+	VariantID   string            `json:"variantId"`
+	ProductID   string            `json:"productId"`
+	ProductName string            `json:"productName"`
+	VariantName string            `json:"variantName"`
+	// The price the drop is measured from; null for BACK_IN_STOCK
+	OldPrice  *float64   `json:"oldPrice,omitempty"`
+	NewPrice  float64    `json:"newPrice"`
+	CreatedAt time.Time  `json:"createdAt"`
+	ReadAt    *time.Time `json:"readAt,omitempty"`
+}
+
+type WishlistAlertConnection struct {
+	Items    []*WishlistAlert `json:"items"`
+	PageInfo *PageInfo        `json:"pageInfo"`
+}
+
+type WishlistConnection struct {
+	Items    []*WishlistItem `json:"items"`
+	PageInfo *PageInfo       `json:"pageInfo"`
+}
+
+type WishlistItem struct {
+	ID          string    `json:"id"`
+	VariantID   string    `json:"variantId"`
+	ProductID   string    `json:"productId"`
+	ProductName string    `json:"productName"`
+	VariantName string    `json:"variantName"`
+	ImageURL    *string   `json:"imageUrl,omitempty"`
+	Price       float64   `json:"price"`
+	InStock     bool      `json:"inStock"`
+	AddedAt     time.Time `json:"addedAt"`
+}
+
+// WARN only notifies the user. BLOCK_CHECKOUT fails checkout with the
+// CHECKOUT_BLOCKED error code. SUSPEND fails checkout and login with
+// ACCOUNT_SUSPENDED.
+type AccountRestrictionLevel string
 
 const (
-	CartSortFieldName      CartSortField = "NAME"
-	CartSortFieldPrice     CartSortField = "PRICE"
-	CartSortFieldCreatedAt CartSortField = "CREATED_AT"
+	AccountRestrictionLevelWarn          AccountRestrictionLevel = "WARN"
+	AccountRestrictionLevelBlockCheckout AccountRestrictionLevel = "BLOCK_CHECKOUT"
+	AccountRestrictionLevelSuspend       AccountRestrictionLevel = "SUSPEND"
 )
 
-var AllCartSortField = []CartSortField{
-	CartSortFieldName,
-	CartSortFieldPrice,
-	CartSortFieldCreatedAt,
+var AllAccountRestrictionLevel = []AccountRestrictionLevel{
+	AccountRestrictionLevelWarn,
+	AccountRestrictionLevelBlockCheckout,
+	AccountRestrictionLevelSuspend,
 }
 
-func (e CartSortField) IsValid() bool {
+func (e AccountRestrictionLevel) IsValid() bool {
 	switch e {
-	case CartSortFieldName, CartSortFieldPrice, CartSortFieldCreatedAt:
+	case AccountRestrictionLevelWarn, AccountRestrictionLevelBlockCheckout, AccountRestrictionLevelSuspend:
 		return true
 	}
 	return false
 }
 
-func (e CartSortField) String() string {
+func (e AccountRestrictionLevel) String() string {
 	return string(e)
 }
 
-func (e *CartSortField) UnmarshalGQL(v any) error {
+func (e *AccountRestrictionLevel) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = CartSortField(str)
+	*e = AccountRestrictionLevel(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid CartSortField", str)
+		return fmt.Errorf("%s is not a valid AccountRestrictionLevel", str)
 	}
 	return nil
 }
 
-func (e CartSortField) MarshalGQL(w io.Writer) {
+func (e AccountRestrictionLevel) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *CartSortField) UnmarshalJSON(b []byte) error {
+func (e *AccountRestrictionLevel) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -662,58 +2040,54 @@ func (e *CartSortField) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e CartSortField) MarshalJSON() ([]byte, error) {
+func (e AccountRestrictionLevel) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-type CheckoutSessionStatus string
+type AdminOrderPayment string
 
 const (
-	CheckoutSessionStatusPending   CheckoutSessionStatus = "PENDING"
-	CheckoutSessionStatusPaid      CheckoutSessionStatus = "PAID"
-	CheckoutSessionStatusExpired   CheckoutSessionStatus = "EXPIRED"
-	CheckoutSessionStatusCancelled CheckoutSessionStatus = "CANCELLED"
+	AdminOrderPaymentOffline     AdminOrderPayment = "OFFLINE"
+	AdminOrderPaymentPaymentLink AdminOrderPayment = "PAYMENT_LINK"
 )
 
-var AllCheckoutSessionStatus = []CheckoutSessionStatus{
-	CheckoutSessionStatusPending,
-	CheckoutSessionStatusPaid,
-	CheckoutSessionStatusExpired,
-	CheckoutSessionStatusCancelled,
+var AllAdminOrderPayment = []AdminOrderPayment{
+	AdminOrderPaymentOffline,
+	AdminOrderPaymentPaymentLink,
 }
 
-func (e CheckoutSessionStatus) IsValid() bool {
+func (e AdminOrderPayment) IsValid() bool {
 	switch e {
-	case CheckoutSessionStatusPending, CheckoutSessionStatusPaid, CheckoutSessionStatusExpired, CheckoutSessionStatusCancelled:
+	case AdminOrderPaymentOffline, AdminOrderPaymentPaymentLink:
 		return true
 	}
 	return false
 }
 
-func (e CheckoutSessionStatus) String() string {
+func (e AdminOrderPayment) String() string {
 	return string(e)
 }
 
-func (e *CheckoutSessionStatus) UnmarshalGQL(v any) error {
+func (e *AdminOrderPayment) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = CheckoutSessionStatus(str)
+	*e = AdminOrderPayment(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid CheckoutSessionStatus", str)
+		return fmt.Errorf("%s is not a valid AdminOrderPayment", str)
 	}
 	return nil
 }
 
-func (e CheckoutSessionStatus) MarshalGQL(w io.Writer) {
+func (e AdminOrderPayment) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *CheckoutSessionStatus) UnmarshalJSON(b []byte) error {
+func (e *AdminOrderPayment) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -721,54 +2095,58 @@ func (e *CheckoutSessionStatus) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e CheckoutSessionStatus) MarshalJSON() ([]byte, error) {
+func (e AdminOrderPayment) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-type OrderSortField string
+type APIKeyScope string
 
 const (
-	OrderSortFieldTotal     OrderSortField = "TOTAL"
-	OrderSortFieldCreatedAt OrderSortField = "CREATED_AT"
+	APIKeyScopeOrdersCreateFromSession APIKeyScope = "ORDERS_CREATE_FROM_SESSION"
+	APIKeyScopeOrdersMarkPaid          APIKeyScope = "ORDERS_MARK_PAID"
+	APIKeyScopeOrdersRead              APIKeyScope = "ORDERS_READ"
+	APIKeyScopeProductsRead            APIKeyScope = "PRODUCTS_READ"
 )
 
-var AllOrderSortField = []OrderSortField{
-	OrderSortFieldTotal,
-	OrderSortFieldCreatedAt,
+var AllAPIKeyScope = []APIKeyScope{
+	APIKeyScopeOrdersCreateFromSession,
+	APIKeyScopeOrdersMarkPaid,
+	APIKeyScopeOrdersRead,
+	APIKeyScopeProductsRead,
 }
 
-func (e OrderSortField) IsValid() bool {
+func (e APIKeyScope) IsValid() bool {
 	switch e {
-	case OrderSortFieldTotal, OrderSortFieldCreatedAt:
+	case APIKeyScopeOrdersCreateFromSession, APIKeyScopeOrdersMarkPaid, APIKeyScopeOrdersRead, APIKeyScopeProductsRead:
 		return true
 	}
 	return false
 }
 
-func (e OrderSortField) String() string {
+func (e APIKeyScope) String() string {
 	return string(e)
 }
 
-func (e *OrderSortField) UnmarshalGQL(v any) error {
+func (e *APIKeyScope) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = OrderSortField(str)
+	*e = APIKeyScope(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid OrderSortField", str)
+		return fmt.Errorf("%s is not a valid ApiKeyScope", str)
 	}
 	return nil
 }
 
-func (e OrderSortField) MarshalGQL(w io.Writer) {
+func (e APIKeyScope) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *OrderSortField) UnmarshalJSON(b []byte) error {
+func (e *APIKeyScope) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -776,64 +2154,56 @@ func (e *OrderSortField) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e OrderSortField) MarshalJSON() ([]byte, error) {
+func (e APIKeyScope) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-type OrderStatus string
+type CartSortField string
 
 const (
-	OrderStatusPendingPayment OrderStatus = "PENDING_PAYMENT"
-	OrderStatusPaid           OrderStatus = "PAID"
-	OrderStatusAccepted       OrderStatus = "ACCEPTED"
-	OrderStatusShipped        OrderStatus = "SHIPPED"
-	OrderStatusCompleted      OrderStatus = "COMPLETED"
-	OrderStatusCancelled      OrderStatus = "CANCELLED"
-	OrderStatusFailed         OrderStatus = "FAILED"
+	CartSortFieldName      CartSortField = "NAME"
+	CartSortFieldPrice     CartSortField = "PRICE"
+	CartSortFieldCreatedAt CartSortField = "CREATED_AT"
 )
 
-var AllOrderStatus = []OrderStatus{
-	OrderStatusPendingPayment,
-	OrderStatusPaid,
-	OrderStatusAccepted,
-	OrderStatusShipped,
-	OrderStatusCompleted,
-	OrderStatusCancelled,
-	OrderStatusFailed,
+var AllCartSortField = []CartSortField{
+	CartSortFieldName,
+	CartSortFieldPrice,
+	CartSortFieldCreatedAt,
 }
 
-func (e OrderStatus) IsValid() bool {
+func (e CartSortField) IsValid() bool {
 	switch e {
-	case OrderStatusPendingPayment, OrderStatusPaid, OrderStatusAccepted, OrderStatusShipped, OrderStatusCompleted, OrderStatusCancelled, OrderStatusFailed:
+	case CartSortFieldName, CartSortFieldPrice, CartSortFieldCreatedAt:
 		return true
 	}
 	return false
 }
 
-func (e OrderStatus) String() string {
+func (e CartSortField) String() string {
 	return string(e)
 }
 
-func (e *OrderStatus) UnmarshalGQL(v any) error {
+func (e *CartSortField) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = OrderStatus(str)
+	*e = CartSortField(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid OrderStatus", str)
+		return fmt.Errorf("%s is not a valid CartSortField", str)
 	}
 	return nil
 }
 
-func (e OrderStatus) MarshalGQL(w io.Writer) {
+func (e CartSortField) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *OrderStatus) UnmarshalJSON(b []byte) error {
+func (e *CartSortField) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -841,54 +2211,56 @@ func (e *OrderStatus) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e OrderStatus) MarshalJSON() ([]byte, error) {
+func (e CartSortField) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-type PackageSortField string
+type ChangeOperation string
 
 const (
-	PackageSortFieldName      PackageSortField = "NAME"
-	PackageSortFieldCreatedAt PackageSortField = "CREATED_AT"
+	ChangeOperationInsert ChangeOperation = "INSERT"
+	ChangeOperationUpdate ChangeOperation = "UPDATE"
+	ChangeOperationDelete ChangeOperation = "DELETE"
 )
 
-var AllPackageSortField = []PackageSortField{
-	PackageSortFieldName,
-	PackageSortFieldCreatedAt,
+var AllChangeOperation = []ChangeOperation{
+	ChangeOperationInsert,
+	ChangeOperationUpdate,
+	ChangeOperationDelete,
 }
 
-func (e PackageSortField) IsValid() bool {
+func (e ChangeOperation) IsValid() bool {
 	switch e {
-	case PackageSortFieldName, PackageSortFieldCreatedAt:
+	case ChangeOperationInsert, ChangeOperationUpdate, ChangeOperationDelete:
 		return true
 	}
 	return false
 }
 
-func (e PackageSortField) String() string {
+func (e ChangeOperation) String() string {
 	return string(e)
 }
 
-func (e *PackageSortField) UnmarshalGQL(v any) error {
+func (e *ChangeOperation) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = PackageSortField(str)
+	*e = ChangeOperation(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid PackageSortField", str)
+		return fmt.Errorf("%s is not a valid ChangeOperation", str)
 	}
 	return nil
 }
 
-func (e PackageSortField) MarshalGQL(w io.Writer) {
+func (e ChangeOperation) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *PackageSortField) UnmarshalJSON(b []byte) error {
+func (e *ChangeOperation) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -896,58 +2268,60 @@ func (e *PackageSortField) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e PackageSortField) MarshalJSON() ([]byte, error) {
+func (e ChangeOperation) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-type PaymentStatus string
+type CheckoutSessionStatus string
 
 const (
-	PaymentStatusPending PaymentStatus = "PENDING"
-	PaymentStatusPaid    PaymentStatus = "PAID"
-	PaymentStatusFailed  PaymentStatus = "FAILED"
-	PaymentStatusExpired PaymentStatus = "EXPIRED"
+	CheckoutSessionStatusPending    CheckoutSessionStatus = "PENDING"
+	CheckoutSessionStatusPaid       CheckoutSessionStatus = "PAID"
+	CheckoutSessionStatusExpired    CheckoutSessionStatus = "EXPIRED"
+	CheckoutSessionStatusCancelled  CheckoutSessionStatus = "CANCELLED"
+	CheckoutSessionStatusSuperseded CheckoutSessionStatus = "SUPERSEDED"
 )
 
-var AllPaymentStatus = []PaymentStatus{
-	PaymentStatusPending,
-	PaymentStatusPaid,
-	PaymentStatusFailed,
-	PaymentStatusExpired,
+var AllCheckoutSessionStatus = []CheckoutSessionStatus{
+	CheckoutSessionStatusPending,
+	CheckoutSessionStatusPaid,
+	CheckoutSessionStatusExpired,
+	CheckoutSessionStatusCancelled,
+	CheckoutSessionStatusSuperseded,
 }
 
-func (e PaymentStatus) IsValid() bool {
+func (e CheckoutSessionStatus) IsValid() bool {
 	switch e {
-	case PaymentStatusPending, PaymentStatusPaid, PaymentStatusFailed, PaymentStatusExpired:
+	case CheckoutSessionStatusPending, CheckoutSessionStatusPaid, CheckoutSessionStatusExpired, CheckoutSessionStatusCancelled, CheckoutSessionStatusSuperseded:
 		return true
 	}
 	return false
 }
 
-func (e PaymentStatus) String() string {
+func (e CheckoutSessionStatus) String() string {
 	return string(e)
 }
 
-func (e *PaymentStatus) UnmarshalGQL(v any) error {
+func (e *CheckoutSessionStatus) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = PaymentStatus(str)
+	*e = CheckoutSessionStatus(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid PaymentStatus", str)
+		return fmt.Errorf("%s is not a valid CheckoutSessionStatus", str)
 	}
 	return nil
 }
 
-func (e PaymentStatus) MarshalGQL(w io.Writer) {
+func (e CheckoutSessionStatus) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *PaymentStatus) UnmarshalJSON(b []byte) error {
+func (e *CheckoutSessionStatus) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -955,56 +2329,59 @@ func (e *PaymentStatus) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e PaymentStatus) MarshalJSON() ([]byte, error) {
+func (e CheckoutSessionStatus) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-type ProductSortField string
+// How clientContext found where the visitor is
+type ClientLocationSource string
 
 const (
-	ProductSortFieldName      ProductSortField = "NAME"
-	ProductSortFieldPrice     ProductSortField = "PRICE"
-	ProductSortFieldCreatedAt ProductSortField = "CREATED_AT"
+	// Headers added by the CDN in front of the server
+	ClientLocationSourceCdn ClientLocationSource = "CDN"
+	// A GeoIP lookup of the client IP
+	ClientLocationSourceGeoip ClientLocationSource = "GEOIP"
+	ClientLocationSourceNone  ClientLocationSource = "NONE"
 )
 
-var AllProductSortField = []ProductSortField{
-	ProductSortFieldName,
-	ProductSortFieldPrice,
-	ProductSortFieldCreatedAt,
+var AllClientLocationSource = []ClientLocationSource{
+	ClientLocationSourceCdn,
+	ClientLocationSourceGeoip,
+	ClientLocationSourceNone,
 }
 
-func (e ProductSortField) IsValid() bool {
+func (e ClientLocationSource) IsValid() bool {
 	switch e {
-	case ProductSortFieldName, ProductSortFieldPrice, ProductSortFieldCreatedAt:
+	case ClientLocationSourceCdn, ClientLocationSourceGeoip, ClientLocationSourceNone:
 		return true
 	}
 	return false
 }
 
-func (e ProductSortField) String() string {
+func (e ClientLocationSource) String() string {
 	return string(e)
 }
 
-func (e *ProductSortField) UnmarshalGQL(v any) error {
+func (e *ClientLocationSource) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = ProductSortField(str)
+	*e = ClientLocationSource(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid ProductSortField", str)
+		return fmt.Errorf("%s is not a valid ClientLocationSource", str)
 	}
 	return nil
 }
 
-func (e ProductSortField) MarshalGQL(w io.Writer) {
+func (e ClientLocationSource) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *ProductSortField) UnmarshalJSON(b []byte) error {
+func (e *ClientLocationSource) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -1012,54 +2389,56 @@ func (e *ProductSortField) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e ProductSortField) MarshalJSON() ([]byte, error) {
+func (e ClientLocationSource) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-type Role string
+type CustomerSegment string
 
 const (
-	RoleUser  Role = "USER"
-	RoleAdmin Role = "ADMIN"
+	CustomerSegmentFirstPurchase CustomerSegment = "FIRST_PURCHASE"
+	CustomerSegmentDormant90d    CustomerSegment = "DORMANT_90D"
+	CustomerSegmentHighLtv       CustomerSegment = "HIGH_LTV"
 )
 
-var AllRole = []Role{
-	RoleUser,
-	RoleAdmin,
+var AllCustomerSegment = []CustomerSegment{
+	CustomerSegmentFirstPurchase,
+	CustomerSegmentDormant90d,
+	CustomerSegmentHighLtv,
 }
 
-func (e Role) IsValid() bool {
+func (e CustomerSegment) IsValid() bool {
 	switch e {
-	case RoleUser, RoleAdmin:
+	case CustomerSegmentFirstPurchase, CustomerSegmentDormant90d, CustomerSegmentHighLtv:
 		return true
 	}
 	return false
 }
 
-func (e Role) String() string {
+func (e CustomerSegment) String() string {
 	return string(e)
 }
 
-func (e *Role) UnmarshalGQL(v any) error {
+func (e *CustomerSegment) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = Role(str)
+	*e = CustomerSegment(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid Role", str)
+		return fmt.Errorf("%s is not a valid CustomerSegment", str)
 	}
 	return nil
 }
 
-func (e Role) MarshalGQL(w io.Writer) {
+func (e CustomerSegment) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *Role) UnmarshalJSON(b []byte) error {
+func (e *CustomerSegment) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -1067,54 +2446,54 @@ func (e *Role) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e Role) MarshalJSON() ([]byte, error) {
+func (e CustomerSegment) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
 }
 
-type SortDirection string
+type DisputeOutcome string
 
 const (
-	SortDirectionAsc  SortDirection = "ASC"
-	SortDirectionDesc SortDirection = "DESC"
+	DisputeOutcomeWon  DisputeOutcome = "WON"
+	DisputeOutcomeLost DisputeOutcome = "LOST"
 )
 
-var AllSortDirection = []SortDirection{
-	SortDirectionAsc,
-	SortDirectionDesc,
+var AllDisputeOutcome = []DisputeOutcome{
+	DisputeOutcomeWon,
+	DisputeOutcomeLost,
 }
 
-func (e SortDirection) IsValid() bool {
+func (e DisputeOutcome) IsValid() bool {
 	switch e {
-	case SortDirectionAsc, SortDirectionDesc:
+	case DisputeOutcomeWon, DisputeOutcomeLost:
 		return true
 	}
 	return false
 }
 
-func (e SortDirection) String() string {
+func (e DisputeOutcome) String() string {
 	return string(e)
 }
 
-func (e *SortDirection) UnmarshalGQL(v any) error {
+func (e *DisputeOutcome) UnmarshalGQL(v any) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = SortDirection(str)
+	*e = DisputeOutcome(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid SortDirection", str)
+		return fmt.Errorf("%s is not a valid DisputeOutcome", str)
 	}
 	return nil
 }
 
-func (e SortDirection) MarshalGQL(w io.Writer) {
+func (e DisputeOutcome) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-func (e *SortDirection) UnmarshalJSON(b []byte) error {
+func (e *DisputeOutcome) UnmarshalJSON(b []byte) error {
 	s, err := strconv.Unquote(string(b))
 	if err != nil {
 		return err
@@ -1122,7 +2501,2446 @@ func (e *SortDirection) UnmarshalJSON(b []byte) error {
 	return e.UnmarshalGQL(s)
 }
 
-func (e SortDirection) MarshalJSON() ([]byte, error) {
+func (e DisputeOutcome) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type DisputeStatus string
+
+const (
+	DisputeStatusOpen DisputeStatus = "OPEN"
+	DisputeStatusWon  DisputeStatus = "WON"
+	DisputeStatusLost DisputeStatus = "LOST"
+)
+
+var AllDisputeStatus = []DisputeStatus{
+	DisputeStatusOpen,
+	DisputeStatusWon,
+	DisputeStatusLost,
+}
+
+func (e DisputeStatus) IsValid() bool {
+	switch e {
+	case DisputeStatusOpen, DisputeStatusWon, DisputeStatusLost:
+		return true
+	}
+	return false
+}
+
+func (e DisputeStatus) String() string {
+	return string(e)
+}
+
+func (e *DisputeStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DisputeStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DisputeStatus", str)
+	}
+	return nil
+}
+
+func (e DisputeStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *DisputeStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e DisputeStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ExportStatus string
+
+const (
+	ExportStatusPending ExportStatus = "PENDING"
+	ExportStatusRunning ExportStatus = "RUNNING"
+	ExportStatusDone    ExportStatus = "DONE"
+	ExportStatusFailed  ExportStatus = "FAILED"
+)
+
+var AllExportStatus = []ExportStatus{
+	ExportStatusPending,
+	ExportStatusRunning,
+	ExportStatusDone,
+	ExportStatusFailed,
+}
+
+func (e ExportStatus) IsValid() bool {
+	switch e {
+	case ExportStatusPending, ExportStatusRunning, ExportStatusDone, ExportStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e ExportStatus) String() string {
+	return string(e)
+}
+
+func (e *ExportStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ExportStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ExportStatus", str)
+	}
+	return nil
+}
+
+func (e ExportStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ExportStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ExportStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type LogLevel string
+
+const (
+	LogLevelDebug LogLevel = "DEBUG"
+	LogLevelInfo  LogLevel = "INFO"
+	LogLevelWarn  LogLevel = "WARN"
+	LogLevelError LogLevel = "ERROR"
+)
+
+var AllLogLevel = []LogLevel{
+	LogLevelDebug,
+	LogLevelInfo,
+	LogLevelWarn,
+	LogLevelError,
+}
+
+func (e LogLevel) IsValid() bool {
+	switch e {
+	case LogLevelDebug, LogLevelInfo, LogLevelWarn, LogLevelError:
+		return true
+	}
+	return false
+}
+
+func (e LogLevel) String() string {
+	return string(e)
+}
+
+func (e *LogLevel) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LogLevel(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LogLevel", str)
+	}
+	return nil
+}
+
+func (e LogLevel) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *LogLevel) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e LogLevel) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type LoyaltyEntryType string
+
+const (
+	LoyaltyEntryTypeEarn     LoyaltyEntryType = "EARN"
+	LoyaltyEntryTypeBurn     LoyaltyEntryType = "BURN"
+	LoyaltyEntryTypeExpire   LoyaltyEntryType = "EXPIRE"
+	LoyaltyEntryTypeClawback LoyaltyEntryType = "CLAWBACK"
+)
+
+var AllLoyaltyEntryType = []LoyaltyEntryType{
+	LoyaltyEntryTypeEarn,
+	LoyaltyEntryTypeBurn,
+	LoyaltyEntryTypeExpire,
+	LoyaltyEntryTypeClawback,
+}
+
+func (e LoyaltyEntryType) IsValid() bool {
+	switch e {
+	case LoyaltyEntryTypeEarn, LoyaltyEntryTypeBurn, LoyaltyEntryTypeExpire, LoyaltyEntryTypeClawback:
+		return true
+	}
+	return false
+}
+
+func (e LoyaltyEntryType) String() string {
+	return string(e)
+}
+
+func (e *LoyaltyEntryType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = LoyaltyEntryType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid LoyaltyEntryType", str)
+	}
+	return nil
+}
+
+func (e LoyaltyEntryType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *LoyaltyEntryType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e LoyaltyEntryType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type MaintenanceSource string
+
+const (
+	MaintenanceSourceDb     MaintenanceSource = "DB"
+	MaintenanceSourceConfig MaintenanceSource = "CONFIG"
+)
+
+var AllMaintenanceSource = []MaintenanceSource{
+	MaintenanceSourceDb,
+	MaintenanceSourceConfig,
+}
+
+func (e MaintenanceSource) IsValid() bool {
+	switch e {
+	case MaintenanceSourceDb, MaintenanceSourceConfig:
+		return true
+	}
+	return false
+}
+
+func (e MaintenanceSource) String() string {
+	return string(e)
+}
+
+func (e *MaintenanceSource) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MaintenanceSource(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MaintenanceSource", str)
+	}
+	return nil
+}
+
+func (e MaintenanceSource) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *MaintenanceSource) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e MaintenanceSource) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type MarketingChannel string
+
+const (
+	MarketingChannelEmail    MarketingChannel = "EMAIL"
+	MarketingChannelWhatsapp MarketingChannel = "WHATSAPP"
+)
+
+var AllMarketingChannel = []MarketingChannel{
+	MarketingChannelEmail,
+	MarketingChannelWhatsapp,
+}
+
+func (e MarketingChannel) IsValid() bool {
+	switch e {
+	case MarketingChannelEmail, MarketingChannelWhatsapp:
+		return true
+	}
+	return false
+}
+
+func (e MarketingChannel) String() string {
+	return string(e)
+}
+
+func (e *MarketingChannel) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MarketingChannel(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MarketingChannel", str)
+	}
+	return nil
+}
+
+func (e MarketingChannel) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *MarketingChannel) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e MarketingChannel) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type MarketingConsentStatus string
+
+const (
+	MarketingConsentStatusSubscribed   MarketingConsentStatus = "SUBSCRIBED"
+	MarketingConsentStatusUnsubscribed MarketingConsentStatus = "UNSUBSCRIBED"
+)
+
+var AllMarketingConsentStatus = []MarketingConsentStatus{
+	MarketingConsentStatusSubscribed,
+	MarketingConsentStatusUnsubscribed,
+}
+
+func (e MarketingConsentStatus) IsValid() bool {
+	switch e {
+	case MarketingConsentStatusSubscribed, MarketingConsentStatusUnsubscribed:
+		return true
+	}
+	return false
+}
+
+func (e MarketingConsentStatus) String() string {
+	return string(e)
+}
+
+func (e *MarketingConsentStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MarketingConsentStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MarketingConsentStatus", str)
+	}
+	return nil
+}
+
+func (e MarketingConsentStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *MarketingConsentStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e MarketingConsentStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ModerationAction string
+
+const (
+	ModerationActionApprove ModerationAction = "APPROVE"
+	ModerationActionRemove  ModerationAction = "REMOVE"
+	ModerationActionBan     ModerationAction = "BAN"
+)
+
+var AllModerationAction = []ModerationAction{
+	ModerationActionApprove,
+	ModerationActionRemove,
+	ModerationActionBan,
+}
+
+func (e ModerationAction) IsValid() bool {
+	switch e {
+	case ModerationActionApprove, ModerationActionRemove, ModerationActionBan:
+		return true
+	}
+	return false
+}
+
+func (e ModerationAction) String() string {
+	return string(e)
+}
+
+func (e *ModerationAction) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ModerationAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ModerationAction", str)
+	}
+	return nil
+}
+
+func (e ModerationAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ModerationAction) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ModerationAction) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ModerationContentType string
+
+const (
+	ModerationContentTypeStoreName        ModerationContentType = "STORE_NAME"
+	ModerationContentTypeStoreDescription ModerationContentType = "STORE_DESCRIPTION"
+	ModerationContentTypeStoreLogo        ModerationContentType = "STORE_LOGO"
+	ModerationContentTypeDisplayName      ModerationContentType = "DISPLAY_NAME"
+	ModerationContentTypeAvatar           ModerationContentType = "AVATAR"
+	ModerationContentTypeProduct          ModerationContentType = "PRODUCT"
+)
+
+var AllModerationContentType = []ModerationContentType{
+	ModerationContentTypeStoreName,
+	ModerationContentTypeStoreDescription,
+	ModerationContentTypeStoreLogo,
+	ModerationContentTypeDisplayName,
+	ModerationContentTypeAvatar,
+	ModerationContentTypeProduct,
+}
+
+func (e ModerationContentType) IsValid() bool {
+	switch e {
+	case ModerationContentTypeStoreName, ModerationContentTypeStoreDescription, ModerationContentTypeStoreLogo, ModerationContentTypeDisplayName, ModerationContentTypeAvatar, ModerationContentTypeProduct:
+		return true
+	}
+	return false
+}
+
+func (e ModerationContentType) String() string {
+	return string(e)
+}
+
+func (e *ModerationContentType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ModerationContentType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ModerationContentType", str)
+	}
+	return nil
+}
+
+func (e ModerationContentType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ModerationContentType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ModerationContentType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ModerationStatus string
+
+const (
+	ModerationStatusPending  ModerationStatus = "PENDING"
+	ModerationStatusApproved ModerationStatus = "APPROVED"
+	ModerationStatusRemoved  ModerationStatus = "REMOVED"
+)
+
+var AllModerationStatus = []ModerationStatus{
+	ModerationStatusPending,
+	ModerationStatusApproved,
+	ModerationStatusRemoved,
+}
+
+func (e ModerationStatus) IsValid() bool {
+	switch e {
+	case ModerationStatusPending, ModerationStatusApproved, ModerationStatusRemoved:
+		return true
+	}
+	return false
+}
+
+func (e ModerationStatus) String() string {
+	return string(e)
+}
+
+func (e *ModerationStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ModerationStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ModerationStatus", str)
+	}
+	return nil
+}
+
+func (e ModerationStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ModerationStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ModerationStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type NotificationChannel string
+
+const (
+	NotificationChannelEmail    NotificationChannel = "EMAIL"
+	NotificationChannelPush     NotificationChannel = "PUSH"
+	NotificationChannelWhatsapp NotificationChannel = "WHATSAPP"
+)
+
+var AllNotificationChannel = []NotificationChannel{
+	NotificationChannelEmail,
+	NotificationChannelPush,
+	NotificationChannelWhatsapp,
+}
+
+func (e NotificationChannel) IsValid() bool {
+	switch e {
+	case NotificationChannelEmail, NotificationChannelPush, NotificationChannelWhatsapp:
+		return true
+	}
+	return false
+}
+
+func (e NotificationChannel) String() string {
+	return string(e)
+}
+
+func (e *NotificationChannel) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = NotificationChannel(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid NotificationChannel", str)
+	}
+	return nil
+}
+
+func (e NotificationChannel) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *NotificationChannel) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e NotificationChannel) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type NotificationEvent string
+
+const (
+	// Expired payments, pickups ready and adjustments to the customer's orders
+	NotificationEventOrderUpdates NotificationEvent = "ORDER_UPDATES"
+	// Staff replies in an order's message thread
+	NotificationEventOrderMessages NotificationEvent = "ORDER_MESSAGES"
+	// Wishlist price drops and back-in-stock notices
+	NotificationEventProductAlerts NotificationEvent = "PRODUCT_ALERTS"
+	// Promotions. Email and WhatsApp here are the marketing consents.
+	NotificationEventMarketing NotificationEvent = "MARKETING"
+)
+
+var AllNotificationEvent = []NotificationEvent{
+	NotificationEventOrderUpdates,
+	NotificationEventOrderMessages,
+	NotificationEventProductAlerts,
+	NotificationEventMarketing,
+}
+
+func (e NotificationEvent) IsValid() bool {
+	switch e {
+	case NotificationEventOrderUpdates, NotificationEventOrderMessages, NotificationEventProductAlerts, NotificationEventMarketing:
+		return true
+	}
+	return false
+}
+
+func (e NotificationEvent) String() string {
+	return string(e)
+}
+
+func (e *NotificationEvent) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = NotificationEvent(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid NotificationEvent", str)
+	}
+	return nil
+}
+
+func (e NotificationEvent) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *NotificationEvent) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e NotificationEvent) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// What an admin adjustment does to an order's total
+type OrderAdjustmentKind string
+
+const (
+	// Lowers the total, e.g. a goodwill discount
+	OrderAdjustmentKindDiscount OrderAdjustmentKind = "DISCOUNT"
+	// Raises the total
+	OrderAdjustmentKindCharge OrderAdjustmentKind = "CHARGE"
+)
+
+var AllOrderAdjustmentKind = []OrderAdjustmentKind{
+	OrderAdjustmentKindDiscount,
+	OrderAdjustmentKindCharge,
+}
+
+func (e OrderAdjustmentKind) IsValid() bool {
+	switch e {
+	case OrderAdjustmentKindDiscount, OrderAdjustmentKindCharge:
+		return true
+	}
+	return false
+}
+
+func (e OrderAdjustmentKind) String() string {
+	return string(e)
+}
+
+func (e *OrderAdjustmentKind) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OrderAdjustmentKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OrderAdjustmentKind", str)
+	}
+	return nil
+}
+
+func (e OrderAdjustmentKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *OrderAdjustmentKind) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e OrderAdjustmentKind) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type OrderAdjustmentStatus string
+
+const (
+	// Waiting for another admin's approval
+	OrderAdjustmentStatusPending  OrderAdjustmentStatus = "PENDING"
+	OrderAdjustmentStatusApplied  OrderAdjustmentStatus = "APPLIED"
+	OrderAdjustmentStatusRejected OrderAdjustmentStatus = "REJECTED"
+)
+
+var AllOrderAdjustmentStatus = []OrderAdjustmentStatus{
+	OrderAdjustmentStatusPending,
+	OrderAdjustmentStatusApplied,
+	OrderAdjustmentStatusRejected,
+}
+
+func (e OrderAdjustmentStatus) IsValid() bool {
+	switch e {
+	case OrderAdjustmentStatusPending, OrderAdjustmentStatusApplied, OrderAdjustmentStatusRejected:
+		return true
+	}
+	return false
+}
+
+func (e OrderAdjustmentStatus) String() string {
+	return string(e)
+}
+
+func (e *OrderAdjustmentStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OrderAdjustmentStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OrderAdjustmentStatus", str)
+	}
+	return nil
+}
+
+func (e OrderAdjustmentStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *OrderAdjustmentStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e OrderAdjustmentStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Date ranges resolved by the server in Asia/Jakarta time, ending now
+type OrderDatePreset string
+
+const (
+	OrderDatePresetLast30Days OrderDatePreset = "LAST_30_DAYS"
+	OrderDatePresetLast90Days OrderDatePreset = "LAST_90_DAYS"
+	OrderDatePresetThisYear   OrderDatePreset = "THIS_YEAR"
+)
+
+var AllOrderDatePreset = []OrderDatePreset{
+	OrderDatePresetLast30Days,
+	OrderDatePresetLast90Days,
+	OrderDatePresetThisYear,
+}
+
+func (e OrderDatePreset) IsValid() bool {
+	switch e {
+	case OrderDatePresetLast30Days, OrderDatePresetLast90Days, OrderDatePresetThisYear:
+		return true
+	}
+	return false
+}
+
+func (e OrderDatePreset) String() string {
+	return string(e)
+}
+
+func (e *OrderDatePreset) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OrderDatePreset(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OrderDatePreset", str)
+	}
+	return nil
+}
+
+func (e OrderDatePreset) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *OrderDatePreset) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e OrderDatePreset) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type OrderMessageSender string
+
+const (
+	OrderMessageSenderCustomer OrderMessageSender = "CUSTOMER"
+	// Any admin or seller
+	OrderMessageSenderStaff OrderMessageSender = "STAFF"
+)
+
+var AllOrderMessageSender = []OrderMessageSender{
+	OrderMessageSenderCustomer,
+	OrderMessageSenderStaff,
+}
+
+func (e OrderMessageSender) IsValid() bool {
+	switch e {
+	case OrderMessageSenderCustomer, OrderMessageSenderStaff:
+		return true
+	}
+	return false
+}
+
+func (e OrderMessageSender) String() string {
+	return string(e)
+}
+
+func (e *OrderMessageSender) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OrderMessageSender(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OrderMessageSender", str)
+	}
+	return nil
+}
+
+func (e OrderMessageSender) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *OrderMessageSender) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e OrderMessageSender) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type OrderReasonAudience string
+
+const (
+	// Offered to customers
+	OrderReasonAudienceCustomer OrderReasonAudience = "CUSTOMER"
+	// Only picked by admins and the system
+	OrderReasonAudienceInternal OrderReasonAudience = "INTERNAL"
+)
+
+var AllOrderReasonAudience = []OrderReasonAudience{
+	OrderReasonAudienceCustomer,
+	OrderReasonAudienceInternal,
+}
+
+func (e OrderReasonAudience) IsValid() bool {
+	switch e {
+	case OrderReasonAudienceCustomer, OrderReasonAudienceInternal:
+		return true
+	}
+	return false
+}
+
+func (e OrderReasonAudience) String() string {
+	return string(e)
+}
+
+func (e *OrderReasonAudience) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OrderReasonAudience(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OrderReasonAudience", str)
+	}
+	return nil
+}
+
+func (e OrderReasonAudience) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *OrderReasonAudience) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e OrderReasonAudience) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Why an order was cancelled or its money returned
+type OrderReasonKind string
+
+const (
+	OrderReasonKindCancellation OrderReasonKind = "CANCELLATION"
+	OrderReasonKindReturn       OrderReasonKind = "RETURN"
+)
+
+var AllOrderReasonKind = []OrderReasonKind{
+	OrderReasonKindCancellation,
+	OrderReasonKindReturn,
+}
+
+func (e OrderReasonKind) IsValid() bool {
+	switch e {
+	case OrderReasonKindCancellation, OrderReasonKindReturn:
+		return true
+	}
+	return false
+}
+
+func (e OrderReasonKind) String() string {
+	return string(e)
+}
+
+func (e *OrderReasonKind) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OrderReasonKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OrderReasonKind", str)
+	}
+	return nil
+}
+
+func (e OrderReasonKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *OrderReasonKind) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e OrderReasonKind) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type OrderSortField string
+
+const (
+	OrderSortFieldTotal     OrderSortField = "TOTAL"
+	OrderSortFieldCreatedAt OrderSortField = "CREATED_AT"
+)
+
+var AllOrderSortField = []OrderSortField{
+	OrderSortFieldTotal,
+	OrderSortFieldCreatedAt,
+}
+
+func (e OrderSortField) IsValid() bool {
+	switch e {
+	case OrderSortFieldTotal, OrderSortFieldCreatedAt:
+		return true
+	}
+	return false
+}
+
+func (e OrderSortField) String() string {
+	return string(e)
+}
+
+func (e *OrderSortField) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OrderSortField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OrderSortField", str)
+	}
+	return nil
+}
+
+func (e OrderSortField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *OrderSortField) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e OrderSortField) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type OrderStatus string
+
+const (
+	OrderStatusPendingPayment OrderStatus = "PENDING_PAYMENT"
+	OrderStatusPaid           OrderStatus = "PAID"
+	OrderStatusAccepted       OrderStatus = "ACCEPTED"
+	OrderStatusShipped        OrderStatus = "SHIPPED"
+	// A self pickup order waiting at its pickup location
+	OrderStatusReadyForPickup OrderStatus = "READY_FOR_PICKUP"
+	OrderStatusCompleted      OrderStatus = "COMPLETED"
+	OrderStatusCancelled      OrderStatus = "CANCELLED"
+	OrderStatusFailed         OrderStatus = "FAILED"
+)
+
+var AllOrderStatus = []OrderStatus{
+	OrderStatusPendingPayment,
+	OrderStatusPaid,
+	OrderStatusAccepted,
+	OrderStatusShipped,
+	OrderStatusReadyForPickup,
+	OrderStatusCompleted,
+	OrderStatusCancelled,
+	OrderStatusFailed,
+}
+
+func (e OrderStatus) IsValid() bool {
+	switch e {
+	case OrderStatusPendingPayment, OrderStatusPaid, OrderStatusAccepted, OrderStatusShipped, OrderStatusReadyForPickup, OrderStatusCompleted, OrderStatusCancelled, OrderStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e OrderStatus) String() string {
+	return string(e)
+}
+
+func (e *OrderStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = OrderStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid OrderStatus", str)
+	}
+	return nil
+}
+
+func (e OrderStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *OrderStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e OrderStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type PackageSortField string
+
+const (
+	PackageSortFieldName      PackageSortField = "NAME"
+	PackageSortFieldCreatedAt PackageSortField = "CREATED_AT"
+)
+
+var AllPackageSortField = []PackageSortField{
+	PackageSortFieldName,
+	PackageSortFieldCreatedAt,
+}
+
+func (e PackageSortField) IsValid() bool {
+	switch e {
+	case PackageSortFieldName, PackageSortFieldCreatedAt:
+		return true
+	}
+	return false
+}
+
+func (e PackageSortField) String() string {
+	return string(e)
+}
+
+func (e *PackageSortField) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PackageSortField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PackageSortField", str)
+	}
+	return nil
+}
+
+func (e PackageSortField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *PackageSortField) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e PackageSortField) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type PaymentStatus string
+
+const (
+	PaymentStatusPending PaymentStatus = "PENDING"
+	PaymentStatusPaid    PaymentStatus = "PAID"
+	PaymentStatusFailed  PaymentStatus = "FAILED"
+	PaymentStatusExpired PaymentStatus = "EXPIRED"
+)
+
+var AllPaymentStatus = []PaymentStatus{
+	PaymentStatusPending,
+	PaymentStatusPaid,
+	PaymentStatusFailed,
+	PaymentStatusExpired,
+}
+
+func (e PaymentStatus) IsValid() bool {
+	switch e {
+	case PaymentStatusPending, PaymentStatusPaid, PaymentStatusFailed, PaymentStatusExpired:
+		return true
+	}
+	return false
+}
+
+func (e PaymentStatus) String() string {
+	return string(e)
+}
+
+func (e *PaymentStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PaymentStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PaymentStatus", str)
+	}
+	return nil
+}
+
+func (e PaymentStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *PaymentStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e PaymentStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type PaymentTimelineEvent string
+
+const (
+	PaymentTimelineEventCreated  PaymentTimelineEvent = "CREATED"
+	PaymentTimelineEventVaIssued PaymentTimelineEvent = "VA_ISSUED"
+	PaymentTimelineEventExpired  PaymentTimelineEvent = "EXPIRED"
+	PaymentTimelineEventCaptured PaymentTimelineEvent = "CAPTURED"
+	PaymentTimelineEventFailed   PaymentTimelineEvent = "FAILED"
+	PaymentTimelineEventRefunded PaymentTimelineEvent = "REFUNDED"
+	PaymentTimelineEventDisputed PaymentTimelineEvent = "DISPUTED"
+)
+
+var AllPaymentTimelineEvent = []PaymentTimelineEvent{
+	PaymentTimelineEventCreated,
+	PaymentTimelineEventVaIssued,
+	PaymentTimelineEventExpired,
+	PaymentTimelineEventCaptured,
+	PaymentTimelineEventFailed,
+	PaymentTimelineEventRefunded,
+	PaymentTimelineEventDisputed,
+}
+
+func (e PaymentTimelineEvent) IsValid() bool {
+	switch e {
+	case PaymentTimelineEventCreated, PaymentTimelineEventVaIssued, PaymentTimelineEventExpired, PaymentTimelineEventCaptured, PaymentTimelineEventFailed, PaymentTimelineEventRefunded, PaymentTimelineEventDisputed:
+		return true
+	}
+	return false
+}
+
+func (e PaymentTimelineEvent) String() string {
+	return string(e)
+}
+
+func (e *PaymentTimelineEvent) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PaymentTimelineEvent(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PaymentTimelineEvent", str)
+	}
+	return nil
+}
+
+func (e PaymentTimelineEvent) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *PaymentTimelineEvent) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e PaymentTimelineEvent) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+// Documents a customer accepts before placing an order
+type PolicyKind string
+
+const (
+	PolicyKindTerms  PolicyKind = "TERMS"
+	PolicyKindRefund PolicyKind = "REFUND"
+)
+
+var AllPolicyKind = []PolicyKind{
+	PolicyKindTerms,
+	PolicyKindRefund,
+}
+
+func (e PolicyKind) IsValid() bool {
+	switch e {
+	case PolicyKindTerms, PolicyKindRefund:
+		return true
+	}
+	return false
+}
+
+func (e PolicyKind) String() string {
+	return string(e)
+}
+
+func (e *PolicyKind) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PolicyKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PolicyKind", str)
+	}
+	return nil
+}
+
+func (e PolicyKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *PolicyKind) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e PolicyKind) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ProductSortField string
+
+const (
+	ProductSortFieldName      ProductSortField = "NAME"
+	ProductSortFieldPrice     ProductSortField = "PRICE"
+	ProductSortFieldCreatedAt ProductSortField = "CREATED_AT"
+)
+
+var AllProductSortField = []ProductSortField{
+	ProductSortFieldName,
+	ProductSortFieldPrice,
+	ProductSortFieldCreatedAt,
+}
+
+func (e ProductSortField) IsValid() bool {
+	switch e {
+	case ProductSortFieldName, ProductSortFieldPrice, ProductSortFieldCreatedAt:
+		return true
+	}
+	return false
+}
+
+func (e ProductSortField) String() string {
+	return string(e)
+}
+
+func (e *ProductSortField) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ProductSortField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ProductSortField", str)
+	}
+	return nil
+}
+
+func (e ProductSortField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ProductSortField) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ProductSortField) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type RefundMethod string
+
+const (
+	RefundMethodWallet  RefundMethod = "WALLET"
+	RefundMethodGateway RefundMethod = "GATEWAY"
+)
+
+var AllRefundMethod = []RefundMethod{
+	RefundMethodWallet,
+	RefundMethodGateway,
+}
+
+func (e RefundMethod) IsValid() bool {
+	switch e {
+	case RefundMethodWallet, RefundMethodGateway:
+		return true
+	}
+	return false
+}
+
+func (e RefundMethod) String() string {
+	return string(e)
+}
+
+func (e *RefundMethod) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RefundMethod(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RefundMethod", str)
+	}
+	return nil
+}
+
+func (e RefundMethod) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *RefundMethod) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e RefundMethod) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type RefundStatus string
+
+const (
+	RefundStatusPending    RefundStatus = "PENDING"
+	RefundStatusProcessing RefundStatus = "PROCESSING"
+	RefundStatusCompleted  RefundStatus = "COMPLETED"
+	RefundStatusFailed     RefundStatus = "FAILED"
+)
+
+var AllRefundStatus = []RefundStatus{
+	RefundStatusPending,
+	RefundStatusProcessing,
+	RefundStatusCompleted,
+	RefundStatusFailed,
+}
+
+func (e RefundStatus) IsValid() bool {
+	switch e {
+	case RefundStatusPending, RefundStatusProcessing, RefundStatusCompleted, RefundStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e RefundStatus) String() string {
+	return string(e)
+}
+
+func (e *RefundStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RefundStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RefundStatus", str)
+	}
+	return nil
+}
+
+func (e RefundStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *RefundStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e RefundStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type RetentionPolicy string
+
+const (
+	RetentionPolicyCheckoutSessions      RetentionPolicy = "CHECKOUT_SESSIONS"
+	RetentionPolicyGuestCheckoutSessions RetentionPolicy = "GUEST_CHECKOUT_SESSIONS"
+	RetentionPolicyWebhookPayloads       RetentionPolicy = "WEBHOOK_PAYLOADS"
+	RetentionPolicyStaleCarts            RetentionPolicy = "STALE_CARTS"
+	RetentionPolicyOrderAnonymization    RetentionPolicy = "ORDER_ANONYMIZATION"
+)
+
+var AllRetentionPolicy = []RetentionPolicy{
+	RetentionPolicyCheckoutSessions,
+	RetentionPolicyGuestCheckoutSessions,
+	RetentionPolicyWebhookPayloads,
+	RetentionPolicyStaleCarts,
+	RetentionPolicyOrderAnonymization,
+}
+
+func (e RetentionPolicy) IsValid() bool {
+	switch e {
+	case RetentionPolicyCheckoutSessions, RetentionPolicyGuestCheckoutSessions, RetentionPolicyWebhookPayloads, RetentionPolicyStaleCarts, RetentionPolicyOrderAnonymization:
+		return true
+	}
+	return false
+}
+
+func (e RetentionPolicy) String() string {
+	return string(e)
+}
+
+func (e *RetentionPolicy) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RetentionPolicy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RetentionPolicy", str)
+	}
+	return nil
+}
+
+func (e RetentionPolicy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *RetentionPolicy) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e RetentionPolicy) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type Role string
+
+const (
+	RoleUser  Role = "USER"
+	RoleAdmin Role = "ADMIN"
+)
+
+var AllRole = []Role{
+	RoleUser,
+	RoleAdmin,
+}
+
+func (e Role) IsValid() bool {
+	switch e {
+	case RoleUser, RoleAdmin:
+		return true
+	}
+	return false
+}
+
+func (e Role) String() string {
+	return string(e)
+}
+
+func (e *Role) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Role(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Role", str)
+	}
+	return nil
+}
+
+func (e Role) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *Role) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e Role) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ShipmentStatus string
+
+const (
+	ShipmentStatusCreated        ShipmentStatus = "CREATED"
+	ShipmentStatusPickedUp       ShipmentStatus = "PICKED_UP"
+	ShipmentStatusInTransit      ShipmentStatus = "IN_TRANSIT"
+	ShipmentStatusOutForDelivery ShipmentStatus = "OUT_FOR_DELIVERY"
+	ShipmentStatusDelivered      ShipmentStatus = "DELIVERED"
+	ShipmentStatusFailed         ShipmentStatus = "FAILED"
+	ShipmentStatusReturned       ShipmentStatus = "RETURNED"
+)
+
+var AllShipmentStatus = []ShipmentStatus{
+	ShipmentStatusCreated,
+	ShipmentStatusPickedUp,
+	ShipmentStatusInTransit,
+	ShipmentStatusOutForDelivery,
+	ShipmentStatusDelivered,
+	ShipmentStatusFailed,
+	ShipmentStatusReturned,
+}
+
+func (e ShipmentStatus) IsValid() bool {
+	switch e {
+	case ShipmentStatusCreated, ShipmentStatusPickedUp, ShipmentStatusInTransit, ShipmentStatusOutForDelivery, ShipmentStatusDelivered, ShipmentStatusFailed, ShipmentStatusReturned:
+		return true
+	}
+	return false
+}
+
+func (e ShipmentStatus) String() string {
+	return string(e)
+}
+
+func (e *ShipmentStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ShipmentStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ShipmentStatus", str)
+	}
+	return nil
+}
+
+func (e ShipmentStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ShipmentStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ShipmentStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type ShippingMethod string
+
+const (
+	ShippingMethodStandard ShippingMethod = "STANDARD"
+	// Same-day courier, only to pinned addresses close to every seller
+	ShippingMethodInstant ShippingMethod = "INSTANT"
+	// Collected by the customer at a pickup location, free of charge
+	ShippingMethodSelfPickup ShippingMethod = "SELF_PICKUP"
+)
+
+var AllShippingMethod = []ShippingMethod{
+	ShippingMethodStandard,
+	ShippingMethodInstant,
+	ShippingMethodSelfPickup,
+}
+
+func (e ShippingMethod) IsValid() bool {
+	switch e {
+	case ShippingMethodStandard, ShippingMethodInstant, ShippingMethodSelfPickup:
+		return true
+	}
+	return false
+}
+
+func (e ShippingMethod) String() string {
+	return string(e)
+}
+
+func (e *ShippingMethod) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ShippingMethod(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ShippingMethod", str)
+	}
+	return nil
+}
+
+func (e ShippingMethod) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ShippingMethod) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ShippingMethod) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type SlowQuerySortField string
+
+const (
+	SlowQuerySortFieldTotalTime SlowQuerySortField = "TOTAL_TIME"
+	SlowQuerySortFieldMeanTime  SlowQuerySortField = "MEAN_TIME"
+	SlowQuerySortFieldCalls     SlowQuerySortField = "CALLS"
+)
+
+var AllSlowQuerySortField = []SlowQuerySortField{
+	SlowQuerySortFieldTotalTime,
+	SlowQuerySortFieldMeanTime,
+	SlowQuerySortFieldCalls,
+}
+
+func (e SlowQuerySortField) IsValid() bool {
+	switch e {
+	case SlowQuerySortFieldTotalTime, SlowQuerySortFieldMeanTime, SlowQuerySortFieldCalls:
+		return true
+	}
+	return false
+}
+
+func (e SlowQuerySortField) String() string {
+	return string(e)
+}
+
+func (e *SlowQuerySortField) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SlowQuerySortField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SlowQuerySortField", str)
+	}
+	return nil
+}
+
+func (e SlowQuerySortField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SlowQuerySortField) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SlowQuerySortField) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type SortDirection string
+
+const (
+	SortDirectionAsc  SortDirection = "ASC"
+	SortDirectionDesc SortDirection = "DESC"
+)
+
+var AllSortDirection = []SortDirection{
+	SortDirectionAsc,
+	SortDirectionDesc,
+}
+
+func (e SortDirection) IsValid() bool {
+	switch e {
+	case SortDirectionAsc, SortDirectionDesc:
+		return true
+	}
+	return false
+}
+
+func (e SortDirection) String() string {
+	return string(e)
+}
+
+func (e *SortDirection) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = SortDirection(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid SortDirection", str)
+	}
+	return nil
+}
+
+func (e SortDirection) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *SortDirection) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e SortDirection) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type StockAdjustmentStatus string
+
+const (
+	StockAdjustmentStatusPending  StockAdjustmentStatus = "PENDING"
+	StockAdjustmentStatusApplied  StockAdjustmentStatus = "APPLIED"
+	StockAdjustmentStatusRejected StockAdjustmentStatus = "REJECTED"
+)
+
+var AllStockAdjustmentStatus = []StockAdjustmentStatus{
+	StockAdjustmentStatusPending,
+	StockAdjustmentStatusApplied,
+	StockAdjustmentStatusRejected,
+}
+
+func (e StockAdjustmentStatus) IsValid() bool {
+	switch e {
+	case StockAdjustmentStatusPending, StockAdjustmentStatusApplied, StockAdjustmentStatusRejected:
+		return true
+	}
+	return false
+}
+
+func (e StockAdjustmentStatus) String() string {
+	return string(e)
+}
+
+func (e *StockAdjustmentStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = StockAdjustmentStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid StockAdjustmentStatus", str)
+	}
+	return nil
+}
+
+func (e StockAdjustmentStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *StockAdjustmentStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e StockAdjustmentStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type StockTransferStatus string
+
+const (
+	StockTransferStatusInTransit StockTransferStatus = "IN_TRANSIT"
+	StockTransferStatusReceived  StockTransferStatus = "RECEIVED"
+	StockTransferStatusCancelled StockTransferStatus = "CANCELLED"
+)
+
+var AllStockTransferStatus = []StockTransferStatus{
+	StockTransferStatusInTransit,
+	StockTransferStatusReceived,
+	StockTransferStatusCancelled,
+}
+
+func (e StockTransferStatus) IsValid() bool {
+	switch e {
+	case StockTransferStatusInTransit, StockTransferStatusReceived, StockTransferStatusCancelled:
+		return true
+	}
+	return false
+}
+
+func (e StockTransferStatus) String() string {
+	return string(e)
+}
+
+func (e *StockTransferStatus) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = StockTransferStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid StockTransferStatus", str)
+	}
+	return nil
+}
+
+func (e StockTransferStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *StockTransferStatus) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e StockTransferStatus) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type StoreVacationMode string
+
+const (
+	// Products leave the catalogue
+	StoreVacationModeHidden StoreVacationMode = "HIDDEN"
+	// Products stay listed but can not be checked out
+	StoreVacationModeUnavailable StoreVacationMode = "UNAVAILABLE"
+)
+
+var AllStoreVacationMode = []StoreVacationMode{
+	StoreVacationModeHidden,
+	StoreVacationModeUnavailable,
+}
+
+func (e StoreVacationMode) IsValid() bool {
+	switch e {
+	case StoreVacationModeHidden, StoreVacationModeUnavailable:
+		return true
+	}
+	return false
+}
+
+func (e StoreVacationMode) String() string {
+	return string(e)
+}
+
+func (e *StoreVacationMode) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = StoreVacationMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid StoreVacationMode", str)
+	}
+	return nil
+}
+
+func (e StoreVacationMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *StoreVacationMode) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e StoreVacationMode) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type UploadPurpose string
+
+const (
+	UploadPurposeProductImage   UploadPurpose = "PRODUCT_IMAGE"
+	UploadPurposeReturnEvidence UploadPurpose = "RETURN_EVIDENCE"
+	UploadPurposeBulkImport     UploadPurpose = "BULK_IMPORT"
+	UploadPurposeOrderMessage   UploadPurpose = "ORDER_MESSAGE"
+	UploadPurposeAvatar         UploadPurpose = "AVATAR"
+)
+
+var AllUploadPurpose = []UploadPurpose{
+	UploadPurposeProductImage,
+	UploadPurposeReturnEvidence,
+	UploadPurposeBulkImport,
+	UploadPurposeOrderMessage,
+	UploadPurposeAvatar,
+}
+
+func (e UploadPurpose) IsValid() bool {
+	switch e {
+	case UploadPurposeProductImage, UploadPurposeReturnEvidence, UploadPurposeBulkImport, UploadPurposeOrderMessage, UploadPurposeAvatar:
+		return true
+	}
+	return false
+}
+
+func (e UploadPurpose) String() string {
+	return string(e)
+}
+
+func (e *UploadPurpose) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = UploadPurpose(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid UploadPurpose", str)
+	}
+	return nil
+}
+
+func (e UploadPurpose) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *UploadPurpose) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e UploadPurpose) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type UsageFlagReason string
+
+const (
+	UsageFlagReasonDailyVolume   UsageFlagReason = "DAILY_VOLUME"
+	UsageFlagReasonSpike         UsageFlagReason = "SPIKE"
+	UsageFlagReasonQuotaExceeded UsageFlagReason = "QUOTA_EXCEEDED"
+)
+
+var AllUsageFlagReason = []UsageFlagReason{
+	UsageFlagReasonDailyVolume,
+	UsageFlagReasonSpike,
+	UsageFlagReasonQuotaExceeded,
+}
+
+func (e UsageFlagReason) IsValid() bool {
+	switch e {
+	case UsageFlagReasonDailyVolume, UsageFlagReasonSpike, UsageFlagReasonQuotaExceeded:
+		return true
+	}
+	return false
+}
+
+func (e UsageFlagReason) String() string {
+	return string(e)
+}
+
+func (e *UsageFlagReason) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = UsageFlagReason(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid UsageFlagReason", str)
+	}
+	return nil
+}
+
+func (e UsageFlagReason) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *UsageFlagReason) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e UsageFlagReason) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type VoucherDiscountType string
+
+const (
+	VoucherDiscountTypePercent VoucherDiscountType = "PERCENT"
+	VoucherDiscountTypeFixed   VoucherDiscountType = "FIXED"
+)
+
+var AllVoucherDiscountType = []VoucherDiscountType{
+	VoucherDiscountTypePercent,
+	VoucherDiscountTypeFixed,
+}
+
+func (e VoucherDiscountType) IsValid() bool {
+	switch e {
+	case VoucherDiscountTypePercent, VoucherDiscountTypeFixed:
+		return true
+	}
+	return false
+}
+
+func (e VoucherDiscountType) String() string {
+	return string(e)
+}
+
+func (e *VoucherDiscountType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = VoucherDiscountType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid VoucherDiscountType", str)
+	}
+	return nil
+}
+
+func (e VoucherDiscountType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *VoucherDiscountType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e VoucherDiscountType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type WalletEntryType string
+
+const (
+	WalletEntryTypeDebit  WalletEntryType = "DEBIT"
+	WalletEntryTypeCredit WalletEntryType = "CREDIT"
+)
+
+var AllWalletEntryType = []WalletEntryType{
+	WalletEntryTypeDebit,
+	WalletEntryTypeCredit,
+}
+
+func (e WalletEntryType) IsValid() bool {
+	switch e {
+	case WalletEntryTypeDebit, WalletEntryTypeCredit:
+		return true
+	}
+	return false
+}
+
+func (e WalletEntryType) String() string {
+	return string(e)
+}
+
+func (e *WalletEntryType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WalletEntryType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WalletEntryType", str)
+	}
+	return nil
+}
+
+func (e WalletEntryType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *WalletEntryType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e WalletEntryType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type Weekday string
+
+const (
+	WeekdayMonday    Weekday = "MONDAY"
+	WeekdayTuesday   Weekday = "TUESDAY"
+	WeekdayWednesday Weekday = "WEDNESDAY"
+	WeekdayThursday  Weekday = "THURSDAY"
+	WeekdayFriday    Weekday = "FRIDAY"
+	WeekdaySaturday  Weekday = "SATURDAY"
+	WeekdaySunday    Weekday = "SUNDAY"
+)
+
+var AllWeekday = []Weekday{
+	WeekdayMonday,
+	WeekdayTuesday,
+	WeekdayWednesday,
+	WeekdayThursday,
+	WeekdayFriday,
+	WeekdaySaturday,
+	WeekdaySunday,
+}
+
+func (e Weekday) IsValid() bool {
+	switch e {
+	case WeekdayMonday, WeekdayTuesday, WeekdayWednesday, WeekdayThursday, WeekdayFriday, WeekdaySaturday, WeekdaySunday:
+		return true
+	}
+	return false
+}
+
+func (e Weekday) String() string {
+	return string(e)
+}
+
+func (e *Weekday) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = Weekday(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid Weekday", str)
+	}
+	return nil
+}
+
+func (e Weekday) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *Weekday) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e Weekday) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
+type WishlistAlertKind string
+
+const (
+	WishlistAlertKindPriceDrop   WishlistAlertKind = "PRICE_DROP"
+	WishlistAlertKindBackInStock WishlistAlertKind = "BACK_IN_STOCK"
+)
+
+var AllWishlistAlertKind = []WishlistAlertKind{
+	WishlistAlertKindPriceDrop,
+	WishlistAlertKindBackInStock,
+}
+
+func (e WishlistAlertKind) IsValid() bool {
+	switch e {
+	case WishlistAlertKindPriceDrop, WishlistAlertKindBackInStock:
+		return true
+	}
+	return false
+}
+
+func (e WishlistAlertKind) String() string {
+	return string(e)
+}
+
+func (e *WishlistAlertKind) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WishlistAlertKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WishlistAlertKind", str)
+	}
+	return nil
+}
+
+func (e WishlistAlertKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *WishlistAlertKind) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e WishlistAlertKind) MarshalJSON() ([]byte, error) {
 	var buf bytes.Buffer
 	e.MarshalGQL(&buf)
 	return buf.Bytes(), nil
@@ -0,0 +1,216 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"warimas-be/internal/graph/model"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/moderation"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+// ReportContent is the resolver for the reportContent field.
+func (r *mutationResolver) ReportContent(ctx context.Context, contentType model.ModerationContentType, contentID string, reason *string) (bool, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "ReportContent"),
+		zap.String("content_type", string(contentType)),
+		zap.String("content_id", contentID),
+	)
+
+	if err := r.ModerationSvc.Report(ctx, moderation.ContentType(contentType), contentID, reason); err != nil {
+		log.Error("failed to report content", zap.Error(err))
+		return false, err
+	}
+	return true, nil
+}
+
+// ApproveModerationItem is the resolver for the approveModerationItem field.
+func (r *mutationResolver) ApproveModerationItem(ctx context.Context, id string, note *string) (*model.ModerationItem, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "ApproveModerationItem"),
+		zap.String("item_id", id),
+	)
+
+	itemID, err := utils.ToUint(id)
+	if err != nil {
+		log.Warn("invalid moderation item id", zap.Error(err))
+		return nil, err
+	}
+
+	it, err := r.ModerationSvc.Approve(ctx, int64(itemID), note)
+	if err != nil {
+		log.Error("failed to approve moderation item", zap.Error(err))
+		return nil, err
+	}
+
+	return moderation.MapItemToGraphQL(it), nil
+}
+
+// RemoveModerationItem is the resolver for the removeModerationItem field.
+func (r *mutationResolver) RemoveModerationItem(ctx context.Context, id string, note *string) (*model.ModerationItem, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "RemoveModerationItem"),
+		zap.String("item_id", id),
+	)
+
+	itemID, err := utils.ToUint(id)
+	if err != nil {
+		log.Warn("invalid moderation item id", zap.Error(err))
+		return nil, err
+	}
+
+	it, err := r.ModerationSvc.Remove(ctx, int64(itemID), note)
+	if err != nil {
+		log.Error("failed to remove moderation item", zap.Error(err))
+		return nil, err
+	}
+
+	return moderation.MapItemToGraphQL(it), nil
+}
+
+// BanModerationAuthor is the resolver for the banModerationAuthor field.
+func (r *mutationResolver) BanModerationAuthor(ctx context.Context, id string, note *string) (*model.ModerationItem, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "BanModerationAuthor"),
+		zap.String("item_id", id),
+	)
+
+	itemID, err := utils.ToUint(id)
+	if err != nil {
+		log.Warn("invalid moderation item id", zap.Error(err))
+		return nil, err
+	}
+
+	it, err := r.ModerationSvc.Ban(ctx, int64(itemID), note)
+	if err != nil {
+		log.Error("failed to ban moderation author", zap.Error(err))
+		return nil, err
+	}
+
+	return moderation.MapItemToGraphQL(it), nil
+}
+
+// AddModerationKeyword is the resolver for the addModerationKeyword field.
+func (r *mutationResolver) AddModerationKeyword(ctx context.Context, term string) (*model.ModerationKeyword, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "AddModerationKeyword"),
+	)
+
+	k, err := r.ModerationSvc.AddKeyword(ctx, term)
+	if err != nil {
+		log.Error("failed to add moderation keyword", zap.Error(err))
+		return nil, err
+	}
+	return moderation.MapKeywordToGraphQL(k), nil
+}
+
+// RemoveModerationKeyword is the resolver for the removeModerationKeyword field.
+func (r *mutationResolver) RemoveModerationKeyword(ctx context.Context, term string) (bool, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "RemoveModerationKeyword"),
+	)
+
+	if err := r.ModerationSvc.RemoveKeyword(ctx, term); err != nil {
+		log.Error("failed to remove moderation keyword", zap.Error(err))
+		return false, err
+	}
+	return true, nil
+}
+
+// ModerationQueue is the resolver for the moderationQueue field.
+func (r *queryResolver) ModerationQueue(ctx context.Context, status *model.ModerationStatus, limit *int32) ([]*model.ModerationItem, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "ModerationQueue"),
+	)
+
+	var st *moderation.Status
+	if status != nil {
+		s := moderation.Status(*status)
+		st = &s
+	}
+
+	var l int32
+	if limit != nil {
+		l = *limit
+	}
+
+	items, err := r.ModerationSvc.Queue(ctx, st, l)
+	if err != nil {
+		log.Error("failed to list moderation queue", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]*model.ModerationItem, 0, len(items))
+	for _, it := range items {
+		out = append(out, moderation.MapItemToGraphQL(it))
+	}
+	return out, nil
+}
+
+// ModerationAudit is the resolver for the moderationAudit field.
+func (r *queryResolver) ModerationAudit(ctx context.Context, itemID *string, limit *int32) ([]*model.ModerationAuditEntry, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "ModerationAudit"),
+	)
+
+	var id *int64
+	if itemID != nil {
+		v, err := utils.ToUint(*itemID)
+		if err != nil {
+			log.Warn("invalid moderation item id", zap.Error(err))
+			return nil, err
+		}
+		i := int64(v)
+		id = &i
+	}
+
+	var l int32
+	if limit != nil {
+		l = *limit
+	}
+
+	entries, err := r.ModerationSvc.Audit(ctx, id, l)
+	if err != nil {
+		log.Error("failed to list moderation audit", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]*model.ModerationAuditEntry, 0, len(entries))
+	for _, e := range entries {
+		out = append(out, moderation.MapAuditEntryToGraphQL(e))
+	}
+	return out, nil
+}
+
+// ModerationKeywords is the resolver for the moderationKeywords field.
+func (r *queryResolver) ModerationKeywords(ctx context.Context) ([]*model.ModerationKeyword, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "ModerationKeywords"),
+	)
+
+	keywords, err := r.ModerationSvc.ListKeywords(ctx)
+	if err != nil {
+		log.Error("failed to list moderation keywords", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]*model.ModerationKeyword, 0, len(keywords))
+	for _, k := range keywords {
+		out = append(out, moderation.MapKeywordToGraphQL(k))
+	}
+	return out, nil
+}
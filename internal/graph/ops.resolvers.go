@@ -0,0 +1,126 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"time"
+	"warimas-be/internal/graph/model"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/ops"
+
+	"go.uber.org/zap"
+)
+
+// AdminDashboard is the resolver for the adminDashboard field.
+func (r *queryResolver) AdminDashboard(ctx context.Context) (*model.AdminDashboard, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "AdminDashboard"),
+	)
+
+	d, err := r.OpsSvc.Dashboard(ctx)
+	if err != nil {
+		log.Error("failed to get admin dashboard", zap.Error(err))
+		return nil, err
+	}
+
+	return ops.MapDashboardToGraphQL(d), nil
+}
+
+// StuckPendingOrders is the resolver for the stuckPendingOrders field.
+func (r *queryResolver) StuckPendingOrders(ctx context.Context, olderThanMinutes *int32, limit *int32) ([]*model.StuckOrder, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "StuckPendingOrders"),
+	)
+
+	var olderThan time.Duration
+	if olderThanMinutes != nil && *olderThanMinutes > 0 {
+		olderThan = time.Duration(*olderThanMinutes) * time.Minute
+	}
+	var l int32
+	if limit != nil {
+		l = *limit
+	}
+
+	orders, err := r.OpsSvc.StuckPendingOrders(ctx, olderThan, l)
+	if err != nil {
+		log.Error("failed to list stuck orders", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]*model.StuckOrder, 0, len(orders))
+	for _, o := range orders {
+		out = append(out, ops.MapStuckOrderToGraphQL(o))
+	}
+	return out, nil
+}
+
+// UnpaidConfirmedSessions is the resolver for the unpaidConfirmedSessions field.
+func (r *queryResolver) UnpaidConfirmedSessions(ctx context.Context, olderThanMinutes *int32, limit *int32) ([]*model.UnpaidConfirmedSession, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "UnpaidConfirmedSessions"),
+	)
+
+	var olderThan time.Duration
+	if olderThanMinutes != nil && *olderThanMinutes > 0 {
+		olderThan = time.Duration(*olderThanMinutes) * time.Minute
+	}
+	var l int32
+	if limit != nil {
+		l = *limit
+	}
+
+	sessions, err := r.OpsSvc.UnpaidConfirmedSessions(ctx, olderThan, l)
+	if err != nil {
+		log.Error("failed to list unpaid sessions", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]*model.UnpaidConfirmedSession, 0, len(sessions))
+	for _, s := range sessions {
+		out = append(out, ops.MapUnpaidSessionToGraphQL(s))
+	}
+	return out, nil
+}
+
+// WebhookHealth is the resolver for the webhookHealth field.
+func (r *queryResolver) WebhookHealth(ctx context.Context) (*model.WebhookHealth, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "WebhookHealth"),
+	)
+
+	h, err := r.OpsSvc.WebhookHealth(ctx)
+	if err != nil {
+		log.Error("failed to get webhook health", zap.Error(err))
+		return nil, err
+	}
+
+	return ops.MapWebhookHealthToGraphQL(h), nil
+}
+
+// StockOversell is the resolver for the stockOversell field.
+func (r *queryResolver) StockOversell(ctx context.Context, since *time.Time, limit *int32) (*model.StockOversellReport, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "StockOversell"),
+	)
+
+	var l int32
+	if limit != nil {
+		l = *limit
+	}
+
+	incidents, negative, err := r.OpsSvc.StockOversell(ctx, since, l)
+	if err != nil {
+		log.Error("failed to get stock oversell report", zap.Error(err))
+		return nil, err
+	}
+
+	return ops.MapStockOversellToGraphQL(incidents, negative), nil
+}
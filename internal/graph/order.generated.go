@@ -29,266 +29,246 @@ import (
 
 // region    **************************** field.gotpl *****************************
 
-func (ec *executionContext) _CheckoutSession_id(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
+func (ec *executionContext) _ApplyCouponResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.ApplyCouponResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CheckoutSession_id,
+		ec.fieldContext_ApplyCouponResponse_success,
 		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
+			return obj.Success, nil
 		},
 		nil,
-		ec.marshalNID2string,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_CheckoutSession_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ApplyCouponResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CheckoutSession",
+		Object:     "ApplyCouponResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CheckoutSession_externalId(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
+func (ec *executionContext) _ApplyCouponResponse_discount(ctx context.Context, field graphql.CollectedField, obj *model.ApplyCouponResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CheckoutSession_externalId,
+		ec.fieldContext_ApplyCouponResponse_discount,
 		func(ctx context.Context) (any, error) {
-			return obj.ExternalID, nil
+			return obj.Discount, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_CheckoutSession_externalId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ApplyCouponResponse_discount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CheckoutSession",
+		Object:     "ApplyCouponResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CheckoutSession_status(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
+func (ec *executionContext) _ApplySessionPointsResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.ApplySessionPointsResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CheckoutSession_status,
+		ec.fieldContext_ApplySessionPointsResponse_success,
 		func(ctx context.Context) (any, error) {
-			return obj.Status, nil
+			return obj.Success, nil
 		},
 		nil,
-		ec.marshalNCheckoutSessionStatus2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutSessionStatus,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_CheckoutSession_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ApplySessionPointsResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CheckoutSession",
+		Object:     "ApplySessionPointsResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type CheckoutSessionStatus does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CheckoutSession_expiresAt(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
+func (ec *executionContext) _ApplySessionPointsResponse_pointsRedeemed(ctx context.Context, field graphql.CollectedField, obj *model.ApplySessionPointsResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CheckoutSession_expiresAt,
+		ec.fieldContext_ApplySessionPointsResponse_pointsRedeemed,
 		func(ctx context.Context) (any, error) {
-			return obj.ExpiresAt, nil
+			return obj.PointsRedeemed, nil
 		},
 		nil,
-		ec.marshalNTime2timeᚐTime,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_CheckoutSession_expiresAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ApplySessionPointsResponse_pointsRedeemed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CheckoutSession",
+		Object:     "ApplySessionPointsResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CheckoutSession_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
+func (ec *executionContext) _ApplySessionPointsResponse_discount(ctx context.Context, field graphql.CollectedField, obj *model.ApplySessionPointsResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CheckoutSession_createdAt,
+		ec.fieldContext_ApplySessionPointsResponse_discount,
 		func(ctx context.Context) (any, error) {
-			return obj.CreatedAt, nil
+			return obj.Discount, nil
 		},
 		nil,
-		ec.marshalNTime2timeᚐTime,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_CheckoutSession_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ApplySessionPointsResponse_discount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CheckoutSession",
+		Object:     "ApplySessionPointsResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CheckoutSession_addressId(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
+func (ec *executionContext) _ApplySessionWalletResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.ApplySessionWalletResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CheckoutSession_addressId,
+		ec.fieldContext_ApplySessionWalletResponse_success,
 		func(ctx context.Context) (any, error) {
-			return obj.AddressID, nil
+			return obj.Success, nil
 		},
 		nil,
-		ec.marshalOID2ᚖstring,
+		ec.marshalNBoolean2bool,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_CheckoutSession_addressId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ApplySessionWalletResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CheckoutSession",
+		Object:     "ApplySessionWalletResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CheckoutSession_items(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutRule_id(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutRule) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CheckoutSession_items,
+		ec.fieldContext_CheckoutRule_id,
 		func(ctx context.Context) (any, error) {
-			return obj.Items, nil
+			return obj.ID, nil
 		},
 		nil,
-		ec.marshalNCheckoutSessionItem2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutSessionItemᚄ,
+		ec.marshalNID2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_CheckoutSession_items(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutRule_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CheckoutSession",
+		Object:     "CheckoutRule",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_CheckoutSessionItem_id(ctx, field)
-			case "variantId":
-				return ec.fieldContext_CheckoutSessionItem_variantId(ctx, field)
-			case "variantName":
-				return ec.fieldContext_CheckoutSessionItem_variantName(ctx, field)
-			case "productName":
-				return ec.fieldContext_CheckoutSessionItem_productName(ctx, field)
-			case "imageUrl":
-				return ec.fieldContext_CheckoutSessionItem_imageUrl(ctx, field)
-			case "quantity":
-				return ec.fieldContext_CheckoutSessionItem_quantity(ctx, field)
-			case "quantityType":
-				return ec.fieldContext_CheckoutSessionItem_quantityType(ctx, field)
-			case "price":
-				return ec.fieldContext_CheckoutSessionItem_price(ctx, field)
-			case "subtotal":
-				return ec.fieldContext_CheckoutSessionItem_subtotal(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type CheckoutSessionItem", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CheckoutSession_subtotal(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutRule_region(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutRule) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CheckoutSession_subtotal,
+		ec.fieldContext_CheckoutRule_region,
 		func(ctx context.Context) (any, error) {
-			return obj.Subtotal, nil
+			return obj.Region, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_CheckoutSession_subtotal(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutRule_region(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CheckoutSession",
+		Object:     "CheckoutRule",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CheckoutSession_tax(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutRule_minOrderAmount(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutRule) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CheckoutSession_tax,
+		ec.fieldContext_CheckoutRule_minOrderAmount,
 		func(ctx context.Context) (any, error) {
-			return obj.Tax, nil
+			return obj.MinOrderAmount, nil
 		},
 		nil,
 		ec.marshalNInt2int32,
@@ -297,9 +277,9 @@ func (ec *executionContext) _CheckoutSession_tax(ctx context.Context, field grap
 	)
 }
 
-func (ec *executionContext) fieldContext_CheckoutSession_tax(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutRule_minOrderAmount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CheckoutSession",
+		Object:     "CheckoutRule",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -310,25 +290,25 @@ func (ec *executionContext) fieldContext_CheckoutSession_tax(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _CheckoutSession_shippingFee(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutRule_freeShippingMin(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutRule) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CheckoutSession_shippingFee,
+		ec.fieldContext_CheckoutRule_freeShippingMin,
 		func(ctx context.Context) (any, error) {
-			return obj.ShippingFee, nil
+			return obj.FreeShippingMin, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
-		true,
+		ec.marshalOInt2ᚖint32,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_CheckoutSession_shippingFee(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutRule_freeShippingMin(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CheckoutSession",
+		Object:     "CheckoutRule",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -339,257 +319,257 @@ func (ec *executionContext) fieldContext_CheckoutSession_shippingFee(_ context.C
 	return fc, nil
 }
 
-func (ec *executionContext) _CheckoutSession_discount(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutRule_isActive(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutRule) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CheckoutSession_discount,
+		ec.fieldContext_CheckoutRule_isActive,
 		func(ctx context.Context) (any, error) {
-			return obj.Discount, nil
+			return obj.IsActive, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_CheckoutSession_discount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutRule_isActive(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CheckoutSession",
+		Object:     "CheckoutRule",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CheckoutSession_totalPrice(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutRule_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutRule) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CheckoutSession_totalPrice,
+		ec.fieldContext_CheckoutRule_updatedAt,
 		func(ctx context.Context) (any, error) {
-			return obj.TotalPrice, nil
+			return obj.UpdatedAt, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
+		ec.marshalNTime2timeᚐTime,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_CheckoutSession_totalPrice(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutRule_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CheckoutSession",
+		Object:     "CheckoutRule",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CheckoutSession_paymentMethod(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSession_id(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CheckoutSession_paymentMethod,
+		ec.fieldContext_CheckoutSession_id,
 		func(ctx context.Context) (any, error) {
-			return obj.PaymentMethod, nil
+			return obj.ID, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNUUID2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_CheckoutSession_paymentMethod(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSession_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "CheckoutSession",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type UUID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CheckoutSessionItem_id(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSessionItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSession_externalId(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CheckoutSessionItem_id,
+		ec.fieldContext_CheckoutSession_externalId,
 		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
+			return obj.ExternalID, nil
 		},
 		nil,
-		ec.marshalNID2string,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_CheckoutSessionItem_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSession_externalId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CheckoutSessionItem",
+		Object:     "CheckoutSession",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CheckoutSessionItem_variantId(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSessionItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSession_status(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CheckoutSessionItem_variantId,
+		ec.fieldContext_CheckoutSession_status,
 		func(ctx context.Context) (any, error) {
-			return obj.VariantID, nil
+			return obj.Status, nil
 		},
 		nil,
-		ec.marshalNID2string,
+		ec.marshalNCheckoutSessionStatus2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutSessionStatus,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_CheckoutSessionItem_variantId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSession_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CheckoutSessionItem",
+		Object:     "CheckoutSession",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type CheckoutSessionStatus does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CheckoutSessionItem_variantName(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSessionItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSession_expiresAt(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CheckoutSessionItem_variantName,
+		ec.fieldContext_CheckoutSession_expiresAt,
 		func(ctx context.Context) (any, error) {
-			return obj.VariantName, nil
+			return obj.ExpiresAt, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNTime2timeᚐTime,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_CheckoutSessionItem_variantName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSession_expiresAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CheckoutSessionItem",
+		Object:     "CheckoutSession",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CheckoutSessionItem_productName(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSessionItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSession_secondsRemaining(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CheckoutSessionItem_productName,
+		ec.fieldContext_CheckoutSession_secondsRemaining,
 		func(ctx context.Context) (any, error) {
-			return obj.ProductName, nil
+			return obj.SecondsRemaining, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_CheckoutSessionItem_productName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSession_secondsRemaining(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CheckoutSessionItem",
+		Object:     "CheckoutSession",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CheckoutSessionItem_imageUrl(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSessionItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSession_paymentExpiresAt(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CheckoutSessionItem_imageUrl,
+		ec.fieldContext_CheckoutSession_paymentExpiresAt,
 		func(ctx context.Context) (any, error) {
-			return obj.ImageURL, nil
+			return obj.PaymentExpiresAt, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalOTime2ᚖtimeᚐTime,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_CheckoutSessionItem_imageUrl(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSession_paymentExpiresAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CheckoutSessionItem",
+		Object:     "CheckoutSession",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CheckoutSessionItem_quantity(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSessionItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSession_paymentSecondsRemaining(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CheckoutSessionItem_quantity,
+		ec.fieldContext_CheckoutSession_paymentSecondsRemaining,
 		func(ctx context.Context) (any, error) {
-			return obj.Quantity, nil
+			return obj.PaymentSecondsRemaining, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
-		true,
+		ec.marshalOInt2ᚖint32,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_CheckoutSessionItem_quantity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSession_paymentSecondsRemaining(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CheckoutSessionItem",
+		Object:     "CheckoutSession",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -600,275 +580,295 @@ func (ec *executionContext) fieldContext_CheckoutSessionItem_quantity(_ context.
 	return fc, nil
 }
 
-func (ec *executionContext) _CheckoutSessionItem_quantityType(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSessionItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSession_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CheckoutSessionItem_quantityType,
+		ec.fieldContext_CheckoutSession_createdAt,
 		func(ctx context.Context) (any, error) {
-			return obj.QuantityType, nil
+			return obj.CreatedAt, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNTime2timeᚐTime,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_CheckoutSessionItem_quantityType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSession_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CheckoutSessionItem",
+		Object:     "CheckoutSession",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CheckoutSessionItem_price(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSessionItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSession_addressId(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CheckoutSessionItem_price,
+		ec.fieldContext_CheckoutSession_addressId,
 		func(ctx context.Context) (any, error) {
-			return obj.Price, nil
+			return obj.AddressID, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
-		true,
+		ec.marshalOUUID2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_CheckoutSessionItem_price(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSession_addressId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CheckoutSessionItem",
+		Object:     "CheckoutSession",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type UUID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CheckoutSessionItem_subtotal(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSessionItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSession_items(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CheckoutSessionItem_subtotal,
+		ec.fieldContext_CheckoutSession_items,
 		func(ctx context.Context) (any, error) {
-			return obj.Subtotal, nil
+			return obj.Items, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
+		ec.marshalNCheckoutSessionItem2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutSessionItemᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_CheckoutSessionItem_subtotal(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSession_items(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CheckoutSessionItem",
+		Object:     "CheckoutSession",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
-		},
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_CheckoutSessionItem_id(ctx, field)
+			case "variantId":
+				return ec.fieldContext_CheckoutSessionItem_variantId(ctx, field)
+			case "variantName":
+				return ec.fieldContext_CheckoutSessionItem_variantName(ctx, field)
+			case "productName":
+				return ec.fieldContext_CheckoutSessionItem_productName(ctx, field)
+			case "imageUrl":
+				return ec.fieldContext_CheckoutSessionItem_imageUrl(ctx, field)
+			case "quantity":
+				return ec.fieldContext_CheckoutSessionItem_quantity(ctx, field)
+			case "quantityType":
+				return ec.fieldContext_CheckoutSessionItem_quantityType(ctx, field)
+			case "price":
+				return ec.fieldContext_CheckoutSessionItem_price(ctx, field)
+			case "subtotal":
+				return ec.fieldContext_CheckoutSessionItem_subtotal(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CheckoutSessionItem", field.Name)
+		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CheckoutSessionResponse_externalId(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSessionResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSession_chargeableWeightGrams(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CheckoutSessionResponse_externalId,
+		ec.fieldContext_CheckoutSession_chargeableWeightGrams,
 		func(ctx context.Context) (any, error) {
-			return obj.ExternalID, nil
+			return obj.ChargeableWeightGrams, nil
 		},
 		nil,
-		ec.marshalNID2string,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_CheckoutSessionResponse_externalId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSession_chargeableWeightGrams(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CheckoutSessionResponse",
+		Object:     "CheckoutSession",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CheckoutSessionResponse_status(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSessionResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSession_shippingMethod(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CheckoutSessionResponse_status,
+		ec.fieldContext_CheckoutSession_shippingMethod,
 		func(ctx context.Context) (any, error) {
-			return obj.Status, nil
+			return obj.ShippingMethod, nil
 		},
 		nil,
-		ec.marshalNCheckoutSessionStatus2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutSessionStatus,
+		ec.marshalNShippingMethod2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐShippingMethod,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_CheckoutSessionResponse_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSession_shippingMethod(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CheckoutSessionResponse",
+		Object:     "CheckoutSession",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type CheckoutSessionStatus does not have child fields")
+			return nil, errors.New("field of type ShippingMethod does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CheckoutSessionResponse_expiresAt(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSessionResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSession_pickupLocationId(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CheckoutSessionResponse_expiresAt,
+		ec.fieldContext_CheckoutSession_pickupLocationId,
 		func(ctx context.Context) (any, error) {
-			return obj.ExpiresAt, nil
+			return obj.PickupLocationID, nil
 		},
 		nil,
-		ec.marshalNTime2timeᚐTime,
-		true,
+		ec.marshalOID2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_CheckoutSessionResponse_expiresAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSession_pickupLocationId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CheckoutSessionResponse",
+		Object:     "CheckoutSession",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ConfirmCheckoutSessionResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.ConfirmCheckoutSessionResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSession_pickupSlotStart(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ConfirmCheckoutSessionResponse_success,
+		ec.fieldContext_CheckoutSession_pickupSlotStart,
 		func(ctx context.Context) (any, error) {
-			return obj.Success, nil
+			return obj.PickupSlotStart, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
-		true,
+		ec.marshalOTime2ᚖtimeᚐTime,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_ConfirmCheckoutSessionResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSession_pickupSlotStart(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ConfirmCheckoutSessionResponse",
+		Object:     "CheckoutSession",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ConfirmCheckoutSessionResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.ConfirmCheckoutSessionResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSession_deliverySlotId(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ConfirmCheckoutSessionResponse_message,
+		ec.fieldContext_CheckoutSession_deliverySlotId,
 		func(ctx context.Context) (any, error) {
-			return obj.Message, nil
+			return obj.DeliverySlotID, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalOID2ᚖstring,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_ConfirmCheckoutSessionResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSession_deliverySlotId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ConfirmCheckoutSessionResponse",
+		Object:     "CheckoutSession",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ConfirmCheckoutSessionResponse_order_external_id(ctx context.Context, field graphql.CollectedField, obj *model.ConfirmCheckoutSessionResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSession_deliveryDate(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ConfirmCheckoutSessionResponse_order_external_id,
+		ec.fieldContext_CheckoutSession_deliveryDate,
 		func(ctx context.Context) (any, error) {
-			return obj.OrderExternalID, nil
+			return obj.DeliveryDate, nil
 		},
 		nil,
-		ec.marshalNString2string,
-		true,
+		ec.marshalODate2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_ConfirmCheckoutSessionResponse_order_external_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSession_deliveryDate(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ConfirmCheckoutSessionResponse",
+		Object:     "CheckoutSession",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Date does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CreateOrderResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.CreateOrderResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSession_insured(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CreateOrderResponse_success,
+		ec.fieldContext_CheckoutSession_insured,
 		func(ctx context.Context) (any, error) {
-			return obj.Success, nil
+			return obj.Insured, nil
 		},
 		nil,
 		ec.marshalNBoolean2bool,
@@ -877,9 +877,9 @@ func (ec *executionContext) _CreateOrderResponse_success(ctx context.Context, fi
 	)
 }
 
-func (ec *executionContext) fieldContext_CreateOrderResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSession_insured(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CreateOrderResponse",
+		Object:     "CheckoutSession",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -890,158 +890,130 @@ func (ec *executionContext) fieldContext_CreateOrderResponse_success(_ context.C
 	return fc, nil
 }
 
-func (ec *executionContext) _CreateOrderResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.CreateOrderResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSession_subtotal(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CreateOrderResponse_message,
+		ec.fieldContext_CheckoutSession_subtotal,
 		func(ctx context.Context) (any, error) {
-			return obj.Message, nil
+			return obj.Subtotal, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNInt2int32,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_CreateOrderResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSession_subtotal(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CreateOrderResponse",
+		Object:     "CheckoutSession",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CreateOrderResponse_order(ctx context.Context, field graphql.CollectedField, obj *model.CreateOrderResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSession_tax(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CreateOrderResponse_order,
+		ec.fieldContext_CheckoutSession_tax,
 		func(ctx context.Context) (any, error) {
-			return obj.Order, nil
+			return obj.Tax, nil
 		},
 		nil,
-		ec.marshalOOrder2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrder,
+		ec.marshalNInt2int32,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_CreateOrderResponse_order(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSession_tax(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CreateOrderResponse",
+		Object:     "CheckoutSession",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Order_id(ctx, field)
-			case "externalId":
-				return ec.fieldContext_Order_externalId(ctx, field)
-			case "invoiceNumber":
-				return ec.fieldContext_Order_invoiceNumber(ctx, field)
-			case "user":
-				return ec.fieldContext_Order_user(ctx, field)
-			case "pricing":
-				return ec.fieldContext_Order_pricing(ctx, field)
-			case "status":
-				return ec.fieldContext_Order_status(ctx, field)
-			case "shipping":
-				return ec.fieldContext_Order_shipping(ctx, field)
-			case "items":
-				return ec.fieldContext_Order_items(ctx, field)
-			case "timestamps":
-				return ec.fieldContext_Order_timestamps(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Order", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CreateOrderResponse_paymentURL(ctx context.Context, field graphql.CollectedField, obj *model.CreateOrderResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSession_shippingFee(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CreateOrderResponse_paymentURL,
+		ec.fieldContext_CheckoutSession_shippingFee,
 		func(ctx context.Context) (any, error) {
-			return obj.PaymentURL, nil
+			return obj.ShippingFee, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNInt2int32,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_CreateOrderResponse_paymentURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSession_shippingFee(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CreateOrderResponse",
+		Object:     "CheckoutSession",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _CreateOrderResponse_payment(ctx context.Context, field graphql.CollectedField, obj *model.CreateOrderResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSession_insuranceFee(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_CreateOrderResponse_payment,
+		ec.fieldContext_CheckoutSession_insuranceFee,
 		func(ctx context.Context) (any, error) {
-			return obj.Payment, nil
+			return obj.InsuranceFee, nil
 		},
 		nil,
-		ec.marshalOPayment2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐPayment,
+		ec.marshalNInt2int32,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_CreateOrderResponse_payment(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSession_insuranceFee(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "CreateOrderResponse",
+		Object:     "CheckoutSession",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "status":
-				return ec.fieldContext_Payment_status(ctx, field)
-			case "url":
-				return ec.fieldContext_Payment_url(ctx, field)
-			case "provider":
-				return ec.fieldContext_Payment_provider(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Payment", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Order_id(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSession_discount(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Order_id,
+		ec.fieldContext_CheckoutSession_discount,
 		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
+			return obj.Discount, nil
 		},
 		nil,
 		ec.marshalNInt2int32,
@@ -1050,9 +1022,9 @@ func (ec *executionContext) _Order_id(ctx context.Context, field graphql.Collect
 	)
 }
 
-func (ec *executionContext) fieldContext_Order_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSession_discount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Order",
+		Object:     "CheckoutSession",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1063,597 +1035,507 @@ func (ec *executionContext) fieldContext_Order_id(_ context.Context, field graph
 	return fc, nil
 }
 
-func (ec *executionContext) _Order_externalId(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSession_totalPrice(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Order_externalId,
+		ec.fieldContext_CheckoutSession_totalPrice,
 		func(ctx context.Context) (any, error) {
-			return obj.ExternalID, nil
+			return obj.TotalPrice, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Order_externalId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSession_totalPrice(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Order",
+		Object:     "CheckoutSession",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Order_invoiceNumber(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSession_walletAmount(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Order_invoiceNumber,
+		ec.fieldContext_CheckoutSession_walletAmount,
 		func(ctx context.Context) (any, error) {
-			return obj.InvoiceNumber, nil
+			return obj.WalletAmount, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNInt2int32,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Order_invoiceNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSession_walletAmount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Order",
+		Object:     "CheckoutSession",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Order_user(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSession_pointsRedeemed(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Order_user,
+		ec.fieldContext_CheckoutSession_pointsRedeemed,
 		func(ctx context.Context) (any, error) {
-			return obj.User, nil
+			return obj.PointsRedeemed, nil
 		},
 		nil,
-		ec.marshalNUserRef2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐUserRef,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Order_user(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSession_pointsRedeemed(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Order",
+		Object:     "CheckoutSession",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_UserRef_id(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type UserRef", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Order_pricing(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSession_paymentMethod(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSession) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Order_pricing,
+		ec.fieldContext_CheckoutSession_paymentMethod,
 		func(ctx context.Context) (any, error) {
-			return obj.Pricing, nil
+			return obj.PaymentMethod, nil
 		},
 		nil,
-		ec.marshalNOrderPricing2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderPricing,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Order_pricing(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSession_paymentMethod(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Order",
+		Object:     "CheckoutSession",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "currency":
-				return ec.fieldContext_OrderPricing_currency(ctx, field)
-			case "subtotal":
-				return ec.fieldContext_OrderPricing_subtotal(ctx, field)
-			case "tax":
-				return ec.fieldContext_OrderPricing_tax(ctx, field)
-			case "discount":
-				return ec.fieldContext_OrderPricing_discount(ctx, field)
-			case "shippingFee":
-				return ec.fieldContext_OrderPricing_shippingFee(ctx, field)
-			case "total":
-				return ec.fieldContext_OrderPricing_total(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type OrderPricing", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Order_status(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSessionEvent_id(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSessionEvent) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Order_status,
+		ec.fieldContext_CheckoutSessionEvent_id,
 		func(ctx context.Context) (any, error) {
-			return obj.Status, nil
+			return obj.ID, nil
 		},
 		nil,
-		ec.marshalNOrderStatus2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderStatus,
+		ec.marshalNID2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Order_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSessionEvent_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Order",
+		Object:     "CheckoutSessionEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type OrderStatus does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Order_shipping(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSessionEvent_type(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSessionEvent) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Order_shipping,
+		ec.fieldContext_CheckoutSessionEvent_type,
 		func(ctx context.Context) (any, error) {
-			return obj.Shipping, nil
+			return obj.Type, nil
 		},
 		nil,
-		ec.marshalNOrderShipping2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderShipping,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Order_shipping(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSessionEvent_type(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Order",
+		Object:     "CheckoutSessionEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "address":
-				return ec.fieldContext_OrderShipping_address(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type OrderShipping", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Order_items(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSessionEvent_actorUserId(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSessionEvent) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Order_items,
+		ec.fieldContext_CheckoutSessionEvent_actorUserId,
 		func(ctx context.Context) (any, error) {
-			return obj.Items, nil
+			return obj.ActorUserID, nil
 		},
 		nil,
-		ec.marshalNOrderItem2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderItemᚄ,
-		true,
+		ec.marshalOID2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Order_items(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSessionEvent_actorUserId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Order",
+		Object:     "CheckoutSessionEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_OrderItem_id(ctx, field)
-			case "variant":
-				return ec.fieldContext_OrderItem_variant(ctx, field)
-			case "quantity":
-				return ec.fieldContext_OrderItem_quantity(ctx, field)
-			case "quantityType":
-				return ec.fieldContext_OrderItem_quantityType(ctx, field)
-			case "pricing":
-				return ec.fieldContext_OrderItem_pricing(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type OrderItem", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Order_timestamps(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSessionEvent_actorGuestId(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSessionEvent) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Order_timestamps,
+		ec.fieldContext_CheckoutSessionEvent_actorGuestId,
 		func(ctx context.Context) (any, error) {
-			return obj.Timestamps, nil
+			return obj.ActorGuestID, nil
 		},
 		nil,
-		ec.marshalNOrderTimestamps2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderTimestamps,
-		true,
+		ec.marshalOUUID2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Order_timestamps(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSessionEvent_actorGuestId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Order",
+		Object:     "CheckoutSessionEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "createdAt":
-				return ec.fieldContext_OrderTimestamps_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_OrderTimestamps_updatedAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type OrderTimestamps", field.Name)
+			return nil, errors.New("field of type UUID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OrderItem_id(ctx context.Context, field graphql.CollectedField, obj *model.OrderItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSessionEvent_actorRole(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSessionEvent) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_OrderItem_id,
+		ec.fieldContext_CheckoutSessionEvent_actorRole,
 		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
+			return obj.ActorRole, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_OrderItem_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSessionEvent_actorRole(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OrderItem",
+		Object:     "CheckoutSessionEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OrderItem_variant(ctx context.Context, field graphql.CollectedField, obj *model.OrderItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSessionEvent_fromValue(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSessionEvent) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_OrderItem_variant,
+		ec.fieldContext_CheckoutSessionEvent_fromValue,
 		func(ctx context.Context) (any, error) {
-			return obj.Variant, nil
+			return obj.FromValue, nil
 		},
 		nil,
-		ec.marshalNVariantRef2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐVariantRef,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_OrderItem_variant(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSessionEvent_fromValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OrderItem",
+		Object:     "CheckoutSessionEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_VariantRef_id(ctx, field)
-			case "name":
-				return ec.fieldContext_VariantRef_name(ctx, field)
-			case "productName":
-				return ec.fieldContext_VariantRef_productName(ctx, field)
-			case "imageUrl":
-				return ec.fieldContext_VariantRef_imageUrl(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type VariantRef", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OrderItem_quantity(ctx context.Context, field graphql.CollectedField, obj *model.OrderItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSessionEvent_toValue(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSessionEvent) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_OrderItem_quantity,
+		ec.fieldContext_CheckoutSessionEvent_toValue,
 		func(ctx context.Context) (any, error) {
-			return obj.Quantity, nil
+			return obj.ToValue, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_OrderItem_quantity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSessionEvent_toValue(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OrderItem",
+		Object:     "CheckoutSessionEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OrderItem_quantityType(ctx context.Context, field graphql.CollectedField, obj *model.OrderItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSessionEvent_totalBefore(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSessionEvent) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_OrderItem_quantityType,
+		ec.fieldContext_CheckoutSessionEvent_totalBefore,
 		func(ctx context.Context) (any, error) {
-			return obj.QuantityType, nil
+			return obj.TotalBefore, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_OrderItem_quantityType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSessionEvent_totalBefore(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OrderItem",
+		Object:     "CheckoutSessionEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OrderItem_pricing(ctx context.Context, field graphql.CollectedField, obj *model.OrderItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSessionEvent_totalAfter(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSessionEvent) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_OrderItem_pricing,
+		ec.fieldContext_CheckoutSessionEvent_totalAfter,
 		func(ctx context.Context) (any, error) {
-			return obj.Pricing, nil
+			return obj.TotalAfter, nil
 		},
 		nil,
-		ec.marshalNOrderItemPricing2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderItemPricing,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_OrderItem_pricing(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSessionEvent_totalAfter(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OrderItem",
+		Object:     "CheckoutSessionEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "price":
-				return ec.fieldContext_OrderItemPricing_price(ctx, field)
-			case "subtotal":
-				return ec.fieldContext_OrderItemPricing_subtotal(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type OrderItemPricing", field.Name)
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OrderItemPricing_price(ctx context.Context, field graphql.CollectedField, obj *model.OrderItemPricing) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSessionEvent_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSessionEvent) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_OrderItemPricing_price,
+		ec.fieldContext_CheckoutSessionEvent_createdAt,
 		func(ctx context.Context) (any, error) {
-			return obj.Price, nil
+			return obj.CreatedAt, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
+		ec.marshalNTime2timeᚐTime,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_OrderItemPricing_price(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSessionEvent_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OrderItemPricing",
+		Object:     "CheckoutSessionEvent",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OrderItemPricing_subtotal(ctx context.Context, field graphql.CollectedField, obj *model.OrderItemPricing) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSessionItem_id(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSessionItem) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_OrderItemPricing_subtotal,
+		ec.fieldContext_CheckoutSessionItem_id,
 		func(ctx context.Context) (any, error) {
-			return obj.Subtotal, nil
+			return obj.ID, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
+		ec.marshalNUUID2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_OrderItemPricing_subtotal(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSessionItem_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OrderItemPricing",
+		Object:     "CheckoutSessionItem",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type UUID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OrderListResponse_items(ctx context.Context, field graphql.CollectedField, obj *model.OrderListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSessionItem_variantId(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSessionItem) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_OrderListResponse_items,
+		ec.fieldContext_CheckoutSessionItem_variantId,
 		func(ctx context.Context) (any, error) {
-			return obj.Items, nil
+			return obj.VariantID, nil
 		},
 		nil,
-		ec.marshalNOrder2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderᚄ,
+		ec.marshalNUUID2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_OrderListResponse_items(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSessionItem_variantId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OrderListResponse",
+		Object:     "CheckoutSessionItem",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Order_id(ctx, field)
-			case "externalId":
-				return ec.fieldContext_Order_externalId(ctx, field)
-			case "invoiceNumber":
-				return ec.fieldContext_Order_invoiceNumber(ctx, field)
-			case "user":
-				return ec.fieldContext_Order_user(ctx, field)
-			case "pricing":
-				return ec.fieldContext_Order_pricing(ctx, field)
-			case "status":
-				return ec.fieldContext_Order_status(ctx, field)
-			case "shipping":
-				return ec.fieldContext_Order_shipping(ctx, field)
-			case "items":
-				return ec.fieldContext_Order_items(ctx, field)
-			case "timestamps":
-				return ec.fieldContext_Order_timestamps(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Order", field.Name)
+			return nil, errors.New("field of type UUID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OrderListResponse_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.OrderListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSessionItem_variantName(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSessionItem) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_OrderListResponse_pageInfo,
+		ec.fieldContext_CheckoutSessionItem_variantName,
 		func(ctx context.Context) (any, error) {
-			return obj.PageInfo, nil
+			return obj.VariantName, nil
 		},
 		nil,
-		ec.marshalNPageInfoOrder2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐPageInfoOrder,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_OrderListResponse_pageInfo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSessionItem_variantName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OrderListResponse",
+		Object:     "CheckoutSessionItem",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "totalItems":
-				return ec.fieldContext_PageInfoOrder_totalItems(ctx, field)
-			case "totalPages":
-				return ec.fieldContext_PageInfoOrder_totalPages(ctx, field)
-			case "page":
-				return ec.fieldContext_PageInfoOrder_page(ctx, field)
-			case "limit":
-				return ec.fieldContext_PageInfoOrder_limit(ctx, field)
-			case "hasNextPage":
-				return ec.fieldContext_PageInfoOrder_hasNextPage(ctx, field)
-			case "hasPreviousPage":
-				return ec.fieldContext_PageInfoOrder_hasPreviousPage(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PageInfoOrder", field.Name)
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OrderPricing_currency(ctx context.Context, field graphql.CollectedField, obj *model.OrderPricing) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSessionItem_productName(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSessionItem) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_OrderPricing_currency,
+		ec.fieldContext_CheckoutSessionItem_productName,
 		func(ctx context.Context) (any, error) {
-			return obj.Currency, nil
+			return obj.ProductName, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -1662,9 +1544,9 @@ func (ec *executionContext) _OrderPricing_currency(ctx context.Context, field gr
 	)
 }
 
-func (ec *executionContext) fieldContext_OrderPricing_currency(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSessionItem_productName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OrderPricing",
+		Object:     "CheckoutSessionItem",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1675,43 +1557,43 @@ func (ec *executionContext) fieldContext_OrderPricing_currency(_ context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) _OrderPricing_subtotal(ctx context.Context, field graphql.CollectedField, obj *model.OrderPricing) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSessionItem_imageUrl(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSessionItem) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_OrderPricing_subtotal,
+		ec.fieldContext_CheckoutSessionItem_imageUrl,
 		func(ctx context.Context) (any, error) {
-			return obj.Subtotal, nil
+			return obj.ImageURL, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_OrderPricing_subtotal(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSessionItem_imageUrl(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OrderPricing",
+		Object:     "CheckoutSessionItem",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OrderPricing_tax(ctx context.Context, field graphql.CollectedField, obj *model.OrderPricing) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSessionItem_quantity(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSessionItem) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_OrderPricing_tax,
+		ec.fieldContext_CheckoutSessionItem_quantity,
 		func(ctx context.Context) (any, error) {
-			return obj.Tax, nil
+			return obj.Quantity, nil
 		},
 		nil,
 		ec.marshalNInt2int32,
@@ -1720,9 +1602,9 @@ func (ec *executionContext) _OrderPricing_tax(ctx context.Context, field graphql
 	)
 }
 
-func (ec *executionContext) fieldContext_OrderPricing_tax(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSessionItem_quantity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OrderPricing",
+		Object:     "CheckoutSessionItem",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1733,43 +1615,43 @@ func (ec *executionContext) fieldContext_OrderPricing_tax(_ context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _OrderPricing_discount(ctx context.Context, field graphql.CollectedField, obj *model.OrderPricing) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSessionItem_quantityType(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSessionItem) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_OrderPricing_discount,
+		ec.fieldContext_CheckoutSessionItem_quantityType,
 		func(ctx context.Context) (any, error) {
-			return obj.Discount, nil
+			return obj.QuantityType, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_OrderPricing_discount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSessionItem_quantityType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OrderPricing",
+		Object:     "CheckoutSessionItem",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OrderPricing_shippingFee(ctx context.Context, field graphql.CollectedField, obj *model.OrderPricing) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSessionItem_price(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSessionItem) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_OrderPricing_shippingFee,
+		ec.fieldContext_CheckoutSessionItem_price,
 		func(ctx context.Context) (any, error) {
-			return obj.ShippingFee, nil
+			return obj.Price, nil
 		},
 		nil,
 		ec.marshalNInt2int32,
@@ -1778,9 +1660,9 @@ func (ec *executionContext) _OrderPricing_shippingFee(ctx context.Context, field
 	)
 }
 
-func (ec *executionContext) fieldContext_OrderPricing_shippingFee(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSessionItem_price(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OrderPricing",
+		Object:     "CheckoutSessionItem",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1791,14 +1673,14 @@ func (ec *executionContext) fieldContext_OrderPricing_shippingFee(_ context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _OrderPricing_total(ctx context.Context, field graphql.CollectedField, obj *model.OrderPricing) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSessionItem_subtotal(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSessionItem) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_OrderPricing_total,
+		ec.fieldContext_CheckoutSessionItem_subtotal,
 		func(ctx context.Context) (any, error) {
-			return obj.Total, nil
+			return obj.Subtotal, nil
 		},
 		nil,
 		ec.marshalNInt2int32,
@@ -1807,9 +1689,9 @@ func (ec *executionContext) _OrderPricing_total(ctx context.Context, field graph
 	)
 }
 
-func (ec *executionContext) fieldContext_OrderPricing_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSessionItem_subtotal(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OrderPricing",
+		Object:     "CheckoutSessionItem",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1820,96 +1702,72 @@ func (ec *executionContext) fieldContext_OrderPricing_total(_ context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _OrderShipping_address(ctx context.Context, field graphql.CollectedField, obj *model.OrderShipping) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSessionResponse_externalId(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSessionResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_OrderShipping_address,
+		ec.fieldContext_CheckoutSessionResponse_externalId,
 		func(ctx context.Context) (any, error) {
-			return obj.Address, nil
+			return obj.ExternalID, nil
 		},
 		nil,
-		ec.marshalNAddress2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐAddress,
+		ec.marshalNID2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_OrderShipping_address(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSessionResponse_externalId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OrderShipping",
+		Object:     "CheckoutSessionResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Address_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Address_name(ctx, field)
-			case "receiverName":
-				return ec.fieldContext_Address_receiverName(ctx, field)
-			case "phone":
-				return ec.fieldContext_Address_phone(ctx, field)
-			case "addressLine1":
-				return ec.fieldContext_Address_addressLine1(ctx, field)
-			case "addressLine2":
-				return ec.fieldContext_Address_addressLine2(ctx, field)
-			case "city":
-				return ec.fieldContext_Address_city(ctx, field)
-			case "province":
-				return ec.fieldContext_Address_province(ctx, field)
-			case "postalCode":
-				return ec.fieldContext_Address_postalCode(ctx, field)
-			case "country":
-				return ec.fieldContext_Address_country(ctx, field)
-			case "isDefault":
-				return ec.fieldContext_Address_isDefault(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Address", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OrderTimestamps_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.OrderTimestamps) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSessionResponse_status(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSessionResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_OrderTimestamps_createdAt,
+		ec.fieldContext_CheckoutSessionResponse_status,
 		func(ctx context.Context) (any, error) {
-			return obj.CreatedAt, nil
+			return obj.Status, nil
 		},
 		nil,
-		ec.marshalNTime2timeᚐTime,
+		ec.marshalNCheckoutSessionStatus2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutSessionStatus,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_OrderTimestamps_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSessionResponse_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OrderTimestamps",
+		Object:     "CheckoutSessionResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type CheckoutSessionStatus does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _OrderTimestamps_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.OrderTimestamps) (ret graphql.Marshaler) {
+func (ec *executionContext) _CheckoutSessionResponse_expiresAt(ctx context.Context, field graphql.CollectedField, obj *model.CheckoutSessionResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_OrderTimestamps_updatedAt,
+		ec.fieldContext_CheckoutSessionResponse_expiresAt,
 		func(ctx context.Context) (any, error) {
-			return obj.UpdatedAt, nil
+			return obj.ExpiresAt, nil
 		},
 		nil,
 		ec.marshalNTime2timeᚐTime,
@@ -1918,9 +1776,9 @@ func (ec *executionContext) _OrderTimestamps_updatedAt(ctx context.Context, fiel
 	)
 }
 
-func (ec *executionContext) fieldContext_OrderTimestamps_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CheckoutSessionResponse_expiresAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "OrderTimestamps",
+		Object:     "CheckoutSessionResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1931,275 +1789,313 @@ func (ec *executionContext) fieldContext_OrderTimestamps_updatedAt(_ context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfoOrder_totalItems(ctx context.Context, field graphql.CollectedField, obj *model.PageInfoOrder) (ret graphql.Marshaler) {
+func (ec *executionContext) _ConfirmCheckoutSessionResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.ConfirmCheckoutSessionResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PageInfoOrder_totalItems,
+		ec.fieldContext_ConfirmCheckoutSessionResponse_success,
 		func(ctx context.Context) (any, error) {
-			return obj.TotalItems, nil
+			return obj.Success, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PageInfoOrder_totalItems(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ConfirmCheckoutSessionResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfoOrder",
+		Object:     "ConfirmCheckoutSessionResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfoOrder_totalPages(ctx context.Context, field graphql.CollectedField, obj *model.PageInfoOrder) (ret graphql.Marshaler) {
+func (ec *executionContext) _ConfirmCheckoutSessionResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.ConfirmCheckoutSessionResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PageInfoOrder_totalPages,
+		ec.fieldContext_ConfirmCheckoutSessionResponse_message,
 		func(ctx context.Context) (any, error) {
-			return obj.TotalPages, nil
+			return obj.Message, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_PageInfoOrder_totalPages(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ConfirmCheckoutSessionResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfoOrder",
+		Object:     "ConfirmCheckoutSessionResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfoOrder_page(ctx context.Context, field graphql.CollectedField, obj *model.PageInfoOrder) (ret graphql.Marshaler) {
+func (ec *executionContext) _ConfirmCheckoutSessionResponse_order_external_id(ctx context.Context, field graphql.CollectedField, obj *model.ConfirmCheckoutSessionResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PageInfoOrder_page,
+		ec.fieldContext_ConfirmCheckoutSessionResponse_order_external_id,
 		func(ctx context.Context) (any, error) {
-			return obj.Page, nil
+			return obj.OrderExternalID, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PageInfoOrder_page(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ConfirmCheckoutSessionResponse_order_external_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfoOrder",
+		Object:     "ConfirmCheckoutSessionResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfoOrder_limit(ctx context.Context, field graphql.CollectedField, obj *model.PageInfoOrder) (ret graphql.Marshaler) {
+func (ec *executionContext) _CreateOrderResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.CreateOrderResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PageInfoOrder_limit,
+		ec.fieldContext_CreateOrderResponse_success,
 		func(ctx context.Context) (any, error) {
-			return obj.Limit, nil
+			return obj.Success, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PageInfoOrder_limit(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CreateOrderResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfoOrder",
+		Object:     "CreateOrderResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfoOrder_hasNextPage(ctx context.Context, field graphql.CollectedField, obj *model.PageInfoOrder) (ret graphql.Marshaler) {
+func (ec *executionContext) _CreateOrderResponse_message(ctx context.Context, field graphql.CollectedField, obj *model.CreateOrderResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PageInfoOrder_hasNextPage,
+		ec.fieldContext_CreateOrderResponse_message,
 		func(ctx context.Context) (any, error) {
-			return obj.HasNextPage, nil
+			return obj.Message, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_PageInfoOrder_hasNextPage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CreateOrderResponse_message(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfoOrder",
+		Object:     "CreateOrderResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PageInfoOrder_hasPreviousPage(ctx context.Context, field graphql.CollectedField, obj *model.PageInfoOrder) (ret graphql.Marshaler) {
+func (ec *executionContext) _CreateOrderResponse_order(ctx context.Context, field graphql.CollectedField, obj *model.CreateOrderResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PageInfoOrder_hasPreviousPage,
+		ec.fieldContext_CreateOrderResponse_order,
 		func(ctx context.Context) (any, error) {
-			return obj.HasPreviousPage, nil
+			return obj.Order, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
-		true,
+		ec.marshalOOrder2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrder,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_PageInfoOrder_hasPreviousPage(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CreateOrderResponse_order(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PageInfoOrder",
+		Object:     "CreateOrderResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Order_id(ctx, field)
+			case "externalId":
+				return ec.fieldContext_Order_externalId(ctx, field)
+			case "invoiceNumber":
+				return ec.fieldContext_Order_invoiceNumber(ctx, field)
+			case "user":
+				return ec.fieldContext_Order_user(ctx, field)
+			case "pricing":
+				return ec.fieldContext_Order_pricing(ctx, field)
+			case "status":
+				return ec.fieldContext_Order_status(ctx, field)
+			case "shipping":
+				return ec.fieldContext_Order_shipping(ctx, field)
+			case "pickup":
+				return ec.fieldContext_Order_pickup(ctx, field)
+			case "deliveryWindow":
+				return ec.fieldContext_Order_deliveryWindow(ctx, field)
+			case "acceptedPolicies":
+				return ec.fieldContext_Order_acceptedPolicies(ctx, field)
+			case "cancelReason":
+				return ec.fieldContext_Order_cancelReason(ctx, field)
+			case "cancelNote":
+				return ec.fieldContext_Order_cancelNote(ctx, field)
+			case "items":
+				return ec.fieldContext_Order_items(ctx, field)
+			case "timestamps":
+				return ec.fieldContext_Order_timestamps(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Order", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Payment_status(ctx context.Context, field graphql.CollectedField, obj *model.Payment) (ret graphql.Marshaler) {
+func (ec *executionContext) _CreateOrderResponse_paymentURL(ctx context.Context, field graphql.CollectedField, obj *model.CreateOrderResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Payment_status,
+		ec.fieldContext_CreateOrderResponse_paymentURL,
 		func(ctx context.Context) (any, error) {
-			return obj.Status, nil
+			return obj.PaymentURL, nil
 		},
 		nil,
-		ec.marshalNPaymentStatus2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐPaymentStatus,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Payment_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CreateOrderResponse_paymentURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Payment",
+		Object:     "CreateOrderResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type PaymentStatus does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Payment_url(ctx context.Context, field graphql.CollectedField, obj *model.Payment) (ret graphql.Marshaler) {
+func (ec *executionContext) _CreateOrderResponse_payment(ctx context.Context, field graphql.CollectedField, obj *model.CreateOrderResponse) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Payment_url,
+		ec.fieldContext_CreateOrderResponse_payment,
 		func(ctx context.Context) (any, error) {
-			return obj.URL, nil
+			return obj.Payment, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalOPayment2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐPayment,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Payment_url(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CreateOrderResponse_payment(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Payment",
+		Object:     "CreateOrderResponse",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "status":
+				return ec.fieldContext_Payment_status(ctx, field)
+			case "url":
+				return ec.fieldContext_Payment_url(ctx, field)
+			case "provider":
+				return ec.fieldContext_Payment_provider(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Payment", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Payment_provider(ctx context.Context, field graphql.CollectedField, obj *model.Payment) (ret graphql.Marshaler) {
+func (ec *executionContext) _DeliverySlot_id(ctx context.Context, field graphql.CollectedField, obj *model.DeliverySlot) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Payment_provider,
+		ec.fieldContext_DeliverySlot_id,
 		func(ctx context.Context) (any, error) {
-			return obj.Provider, nil
+			return obj.ID, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNID2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Payment_provider(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeliverySlot_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Payment",
+		Object:     "DeliverySlot",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PaymentDetail_method(ctx context.Context, field graphql.CollectedField, obj *model.PaymentDetail) (ret graphql.Marshaler) {
+func (ec *executionContext) _DeliverySlot_region(ctx context.Context, field graphql.CollectedField, obj *model.DeliverySlot) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PaymentDetail_method,
+		ec.fieldContext_DeliverySlot_region,
 		func(ctx context.Context) (any, error) {
-			return obj.Method, nil
+			return obj.Region, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -2208,9 +2104,9 @@ func (ec *executionContext) _PaymentDetail_method(ctx context.Context, field gra
 	)
 }
 
-func (ec *executionContext) fieldContext_PaymentDetail_method(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeliverySlot_region(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PaymentDetail",
+		Object:     "DeliverySlot",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2221,25 +2117,25 @@ func (ec *executionContext) fieldContext_PaymentDetail_method(_ context.Context,
 	return fc, nil
 }
 
-func (ec *executionContext) _PaymentDetail_bank(ctx context.Context, field graphql.CollectedField, obj *model.PaymentDetail) (ret graphql.Marshaler) {
+func (ec *executionContext) _DeliverySlot_startsAt(ctx context.Context, field graphql.CollectedField, obj *model.DeliverySlot) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PaymentDetail_bank,
+		ec.fieldContext_DeliverySlot_startsAt,
 		func(ctx context.Context) (any, error) {
-			return obj.Bank, nil
+			return obj.StartsAt, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_PaymentDetail_bank(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeliverySlot_startsAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PaymentDetail",
+		Object:     "DeliverySlot",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2250,25 +2146,25 @@ func (ec *executionContext) fieldContext_PaymentDetail_bank(_ context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _PaymentDetail_paymentCode(ctx context.Context, field graphql.CollectedField, obj *model.PaymentDetail) (ret graphql.Marshaler) {
+func (ec *executionContext) _DeliverySlot_endsAt(ctx context.Context, field graphql.CollectedField, obj *model.DeliverySlot) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PaymentDetail_paymentCode,
+		ec.fieldContext_DeliverySlot_endsAt,
 		func(ctx context.Context) (any, error) {
-			return obj.PaymentCode, nil
+			return obj.EndsAt, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNString2string,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_PaymentDetail_paymentCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeliverySlot_endsAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PaymentDetail",
+		Object:     "DeliverySlot",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2279,159 +2175,175 @@ func (ec *executionContext) fieldContext_PaymentDetail_paymentCode(_ context.Con
 	return fc, nil
 }
 
-func (ec *executionContext) _PaymentDetail_invoiceURL(ctx context.Context, field graphql.CollectedField, obj *model.PaymentDetail) (ret graphql.Marshaler) {
+func (ec *executionContext) _DeliverySlot_capacity(ctx context.Context, field graphql.CollectedField, obj *model.DeliverySlot) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PaymentDetail_invoiceURL,
+		ec.fieldContext_DeliverySlot_capacity,
 		func(ctx context.Context) (any, error) {
-			return obj.InvoiceURL, nil
+			return obj.Capacity, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNInt2int32,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_PaymentDetail_invoiceURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeliverySlot_capacity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PaymentDetail",
+		Object:     "DeliverySlot",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PaymentDetail_referenceId(ctx context.Context, field graphql.CollectedField, obj *model.PaymentDetail) (ret graphql.Marshaler) {
+func (ec *executionContext) _DeliverySlot_isActive(ctx context.Context, field graphql.CollectedField, obj *model.DeliverySlot) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PaymentDetail_referenceId,
+		ec.fieldContext_DeliverySlot_isActive,
 		func(ctx context.Context) (any, error) {
-			return obj.ReferenceID, nil
+			return obj.IsActive, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PaymentDetail_referenceId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeliverySlot_isActive(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PaymentDetail",
+		Object:     "DeliverySlot",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PaymentDetail_instructions(ctx context.Context, field graphql.CollectedField, obj *model.PaymentDetail) (ret graphql.Marshaler) {
+func (ec *executionContext) _DeliverySlot_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.DeliverySlot) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PaymentDetail_instructions,
+		ec.fieldContext_DeliverySlot_updatedAt,
 		func(ctx context.Context) (any, error) {
-			return obj.Instructions, nil
+			return obj.UpdatedAt, nil
 		},
 		nil,
-		ec.marshalNString2ᚕstringᚄ,
+		ec.marshalNTime2timeᚐTime,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PaymentDetail_instructions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeliverySlot_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PaymentDetail",
+		Object:     "DeliverySlot",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PaymentOrderInfoResponse_orderExternalID(ctx context.Context, field graphql.CollectedField, obj *model.PaymentOrderInfoResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _DeliverySlotOption_slot(ctx context.Context, field graphql.CollectedField, obj *model.DeliverySlotOption) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PaymentOrderInfoResponse_orderExternalID,
+		ec.fieldContext_DeliverySlotOption_slot,
 		func(ctx context.Context) (any, error) {
-			return obj.OrderExternalID, nil
+			return obj.Slot, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNDeliverySlot2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐDeliverySlot,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PaymentOrderInfoResponse_orderExternalID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeliverySlotOption_slot(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PaymentOrderInfoResponse",
+		Object:     "DeliverySlotOption",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_DeliverySlot_id(ctx, field)
+			case "region":
+				return ec.fieldContext_DeliverySlot_region(ctx, field)
+			case "startsAt":
+				return ec.fieldContext_DeliverySlot_startsAt(ctx, field)
+			case "endsAt":
+				return ec.fieldContext_DeliverySlot_endsAt(ctx, field)
+			case "capacity":
+				return ec.fieldContext_DeliverySlot_capacity(ctx, field)
+			case "isActive":
+				return ec.fieldContext_DeliverySlot_isActive(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_DeliverySlot_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DeliverySlot", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PaymentOrderInfoResponse_status(ctx context.Context, field graphql.CollectedField, obj *model.PaymentOrderInfoResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _DeliverySlotOption_start(ctx context.Context, field graphql.CollectedField, obj *model.DeliverySlotOption) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PaymentOrderInfoResponse_status,
+		ec.fieldContext_DeliverySlotOption_start,
 		func(ctx context.Context) (any, error) {
-			return obj.Status, nil
+			return obj.Start, nil
 		},
 		nil,
-		ec.marshalNPaymentStatus2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐPaymentStatus,
+		ec.marshalNTime2timeᚐTime,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PaymentOrderInfoResponse_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeliverySlotOption_start(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PaymentOrderInfoResponse",
+		Object:     "DeliverySlotOption",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type PaymentStatus does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PaymentOrderInfoResponse_expiresAt(ctx context.Context, field graphql.CollectedField, obj *model.PaymentOrderInfoResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _DeliverySlotOption_end(ctx context.Context, field graphql.CollectedField, obj *model.DeliverySlotOption) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PaymentOrderInfoResponse_expiresAt,
+		ec.fieldContext_DeliverySlotOption_end,
 		func(ctx context.Context) (any, error) {
-			return obj.ExpiresAt, nil
+			return obj.End, nil
 		},
 		nil,
 		ec.marshalNTime2timeᚐTime,
@@ -2440,9 +2352,9 @@ func (ec *executionContext) _PaymentOrderInfoResponse_expiresAt(ctx context.Cont
 	)
 }
 
-func (ec *executionContext) fieldContext_PaymentOrderInfoResponse_expiresAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeliverySlotOption_end(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PaymentOrderInfoResponse",
+		Object:     "DeliverySlotOption",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2453,14 +2365,14 @@ func (ec *executionContext) fieldContext_PaymentOrderInfoResponse_expiresAt(_ co
 	return fc, nil
 }
 
-func (ec *executionContext) _PaymentOrderInfoResponse_totalAmount(ctx context.Context, field graphql.CollectedField, obj *model.PaymentOrderInfoResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _DeliverySlotOption_remaining(ctx context.Context, field graphql.CollectedField, obj *model.DeliverySlotOption) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PaymentOrderInfoResponse_totalAmount,
+		ec.fieldContext_DeliverySlotOption_remaining,
 		func(ctx context.Context) (any, error) {
-			return obj.TotalAmount, nil
+			return obj.Remaining, nil
 		},
 		nil,
 		ec.marshalNInt2int32,
@@ -2469,9 +2381,9 @@ func (ec *executionContext) _PaymentOrderInfoResponse_totalAmount(ctx context.Co
 	)
 }
 
-func (ec *executionContext) fieldContext_PaymentOrderInfoResponse_totalAmount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeliverySlotOption_remaining(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PaymentOrderInfoResponse",
+		Object:     "DeliverySlotOption",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2482,220 +2394,188 @@ func (ec *executionContext) fieldContext_PaymentOrderInfoResponse_totalAmount(_
 	return fc, nil
 }
 
-func (ec *executionContext) _PaymentOrderInfoResponse_currency(ctx context.Context, field graphql.CollectedField, obj *model.PaymentOrderInfoResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _DeliverySlotOption_available(ctx context.Context, field graphql.CollectedField, obj *model.DeliverySlotOption) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PaymentOrderInfoResponse_currency,
+		ec.fieldContext_DeliverySlotOption_available,
 		func(ctx context.Context) (any, error) {
-			return obj.Currency, nil
+			return obj.Available, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNBoolean2bool,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PaymentOrderInfoResponse_currency(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeliverySlotOption_available(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PaymentOrderInfoResponse",
+		Object:     "DeliverySlotOption",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Boolean does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PaymentOrderInfoResponse_shippingAddress(ctx context.Context, field graphql.CollectedField, obj *model.PaymentOrderInfoResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _DeliveryWindow_slotId(ctx context.Context, field graphql.CollectedField, obj *model.DeliveryWindow) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PaymentOrderInfoResponse_shippingAddress,
+		ec.fieldContext_DeliveryWindow_slotId,
 		func(ctx context.Context) (any, error) {
-			return obj.ShippingAddress, nil
+			return obj.SlotID, nil
 		},
 		nil,
-		ec.marshalNShippingAddress2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐShippingAddress,
+		ec.marshalNID2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PaymentOrderInfoResponse_shippingAddress(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeliveryWindow_slotId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PaymentOrderInfoResponse",
+		Object:     "DeliveryWindow",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext_ShippingAddress_name(ctx, field)
-			case "receiverName":
-				return ec.fieldContext_ShippingAddress_receiverName(ctx, field)
-			case "phone":
-				return ec.fieldContext_ShippingAddress_phone(ctx, field)
-			case "address1":
-				return ec.fieldContext_ShippingAddress_address1(ctx, field)
-			case "address2":
-				return ec.fieldContext_ShippingAddress_address2(ctx, field)
-			case "city":
-				return ec.fieldContext_ShippingAddress_city(ctx, field)
-			case "province":
-				return ec.fieldContext_ShippingAddress_province(ctx, field)
-			case "postalCode":
-				return ec.fieldContext_ShippingAddress_postalCode(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type ShippingAddress", field.Name)
+			return nil, errors.New("field of type ID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PaymentOrderInfoResponse_payment(ctx context.Context, field graphql.CollectedField, obj *model.PaymentOrderInfoResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _DeliveryWindow_date(ctx context.Context, field graphql.CollectedField, obj *model.DeliveryWindow) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PaymentOrderInfoResponse_payment,
+		ec.fieldContext_DeliveryWindow_date,
 		func(ctx context.Context) (any, error) {
-			return obj.Payment, nil
+			return obj.Date, nil
 		},
 		nil,
-		ec.marshalNPaymentDetail2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐPaymentDetail,
+		ec.marshalNDate2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PaymentOrderInfoResponse_payment(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeliveryWindow_date(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PaymentOrderInfoResponse",
+		Object:     "DeliveryWindow",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "method":
-				return ec.fieldContext_PaymentDetail_method(ctx, field)
-			case "bank":
-				return ec.fieldContext_PaymentDetail_bank(ctx, field)
-			case "paymentCode":
-				return ec.fieldContext_PaymentDetail_paymentCode(ctx, field)
-			case "invoiceURL":
-				return ec.fieldContext_PaymentDetail_invoiceURL(ctx, field)
-			case "referenceId":
-				return ec.fieldContext_PaymentDetail_referenceId(ctx, field)
-			case "instructions":
-				return ec.fieldContext_PaymentDetail_instructions(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PaymentDetail", field.Name)
+			return nil, errors.New("field of type Date does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShippingAddress_name(ctx context.Context, field graphql.CollectedField, obj *model.ShippingAddress) (ret graphql.Marshaler) {
+func (ec *executionContext) _DeliveryWindow_start(ctx context.Context, field graphql.CollectedField, obj *model.DeliveryWindow) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ShippingAddress_name,
+		ec.fieldContext_DeliveryWindow_start,
 		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
+			return obj.Start, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNTime2timeᚐTime,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ShippingAddress_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeliveryWindow_start(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShippingAddress",
+		Object:     "DeliveryWindow",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShippingAddress_receiverName(ctx context.Context, field graphql.CollectedField, obj *model.ShippingAddress) (ret graphql.Marshaler) {
+func (ec *executionContext) _DeliveryWindow_end(ctx context.Context, field graphql.CollectedField, obj *model.DeliveryWindow) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ShippingAddress_receiverName,
+		ec.fieldContext_DeliveryWindow_end,
 		func(ctx context.Context) (any, error) {
-			return obj.ReceiverName, nil
+			return obj.End, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNTime2timeᚐTime,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ShippingAddress_receiverName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DeliveryWindow_end(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShippingAddress",
+		Object:     "DeliveryWindow",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShippingAddress_phone(ctx context.Context, field graphql.CollectedField, obj *model.ShippingAddress) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_id(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ShippingAddress_phone,
+		ec.fieldContext_Order_id,
 		func(ctx context.Context) (any, error) {
-			return obj.Phone, nil
+			return obj.ID, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ShippingAddress_phone(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Order_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShippingAddress",
+		Object:     "Order",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShippingAddress_address1(ctx context.Context, field graphql.CollectedField, obj *model.ShippingAddress) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_externalId(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ShippingAddress_address1,
+		ec.fieldContext_Order_externalId,
 		func(ctx context.Context) (any, error) {
-			return obj.Address1, nil
+			return obj.ExternalID, nil
 		},
 		nil,
 		ec.marshalNString2string,
@@ -2704,9 +2584,9 @@ func (ec *executionContext) _ShippingAddress_address1(ctx context.Context, field
 	)
 }
 
-func (ec *executionContext) fieldContext_ShippingAddress_address1(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Order_externalId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShippingAddress",
+		Object:     "Order",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2717,14 +2597,14 @@ func (ec *executionContext) fieldContext_ShippingAddress_address1(_ context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _ShippingAddress_address2(ctx context.Context, field graphql.CollectedField, obj *model.ShippingAddress) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_invoiceNumber(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ShippingAddress_address2,
+		ec.fieldContext_Order_invoiceNumber,
 		func(ctx context.Context) (any, error) {
-			return obj.Address2, nil
+			return obj.InvoiceNumber, nil
 		},
 		nil,
 		ec.marshalOString2ᚖstring,
@@ -2733,9 +2613,9 @@ func (ec *executionContext) _ShippingAddress_address2(ctx context.Context, field
 	)
 }
 
-func (ec *executionContext) fieldContext_ShippingAddress_address2(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Order_invoiceNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShippingAddress",
+		Object:     "Order",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -2746,257 +2626,341 @@ func (ec *executionContext) fieldContext_ShippingAddress_address2(_ context.Cont
 	return fc, nil
 }
 
-func (ec *executionContext) _ShippingAddress_city(ctx context.Context, field graphql.CollectedField, obj *model.ShippingAddress) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_user(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ShippingAddress_city,
+		ec.fieldContext_Order_user,
 		func(ctx context.Context) (any, error) {
-			return obj.City, nil
+			return obj.User, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNUserRef2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐUserRef,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ShippingAddress_city(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Order_user(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShippingAddress",
+		Object:     "Order",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_UserRef_id(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type UserRef", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShippingAddress_province(ctx context.Context, field graphql.CollectedField, obj *model.ShippingAddress) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_pricing(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ShippingAddress_province,
+		ec.fieldContext_Order_pricing,
 		func(ctx context.Context) (any, error) {
-			return obj.Province, nil
+			return obj.Pricing, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNOrderPricing2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderPricing,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ShippingAddress_province(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Order_pricing(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShippingAddress",
+		Object:     "Order",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "currency":
+				return ec.fieldContext_OrderPricing_currency(ctx, field)
+			case "subtotal":
+				return ec.fieldContext_OrderPricing_subtotal(ctx, field)
+			case "tax":
+				return ec.fieldContext_OrderPricing_tax(ctx, field)
+			case "discount":
+				return ec.fieldContext_OrderPricing_discount(ctx, field)
+			case "shippingFee":
+				return ec.fieldContext_OrderPricing_shippingFee(ctx, field)
+			case "insuranceFee":
+				return ec.fieldContext_OrderPricing_insuranceFee(ctx, field)
+			case "adjustment":
+				return ec.fieldContext_OrderPricing_adjustment(ctx, field)
+			case "total":
+				return ec.fieldContext_OrderPricing_total(ctx, field)
+			case "walletAmount":
+				return ec.fieldContext_OrderPricing_walletAmount(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type OrderPricing", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ShippingAddress_postalCode(ctx context.Context, field graphql.CollectedField, obj *model.ShippingAddress) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_status(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ShippingAddress_postalCode,
+		ec.fieldContext_Order_status,
 		func(ctx context.Context) (any, error) {
-			return obj.PostalCode, nil
+			return obj.Status, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNOrderStatus2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderStatus,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ShippingAddress_postalCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Order_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ShippingAddress",
+		Object:     "Order",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type OrderStatus does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UpdateSessionAddressResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.UpdateSessionAddressResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_shipping(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UpdateSessionAddressResponse_success,
+		ec.fieldContext_Order_shipping,
 		func(ctx context.Context) (any, error) {
-			return obj.Success, nil
+			return obj.Shipping, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
+		ec.marshalNOrderShipping2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderShipping,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_UpdateSessionAddressResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Order_shipping(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UpdateSessionAddressResponse",
+		Object:     "Order",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "address":
+				return ec.fieldContext_OrderShipping_address(ctx, field)
+			case "method":
+				return ec.fieldContext_OrderShipping_method(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type OrderShipping", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UpdateSessionPaymentMethodResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.UpdateSessionPaymentMethodResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_pickup(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UpdateSessionPaymentMethodResponse_success,
+		ec.fieldContext_Order_pickup,
 		func(ctx context.Context) (any, error) {
-			return obj.Success, nil
+			return obj.Pickup, nil
 		},
 		nil,
-		ec.marshalNBoolean2bool,
-		true,
+		ec.marshalOOrderPickup2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderPickup,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_UpdateSessionPaymentMethodResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Order_pickup(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UpdateSessionPaymentMethodResponse",
+		Object:     "Order",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Boolean does not have child fields")
+			switch field.Name {
+			case "location":
+				return ec.fieldContext_OrderPickup_location(ctx, field)
+			case "slotStart":
+				return ec.fieldContext_OrderPickup_slotStart(ctx, field)
+			case "slotEnd":
+				return ec.fieldContext_OrderPickup_slotEnd(ctx, field)
+			case "code":
+				return ec.fieldContext_OrderPickup_code(ctx, field)
+			case "pickedUpAt":
+				return ec.fieldContext_OrderPickup_pickedUpAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type OrderPickup", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _UserRef_id(ctx context.Context, field graphql.CollectedField, obj *model.UserRef) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_deliveryWindow(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_UserRef_id,
+		ec.fieldContext_Order_deliveryWindow,
 		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
+			return obj.DeliveryWindow, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
-		true,
+		ec.marshalODeliveryWindow2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐDeliveryWindow,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_UserRef_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Order_deliveryWindow(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "UserRef",
+		Object:     "Order",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "slotId":
+				return ec.fieldContext_DeliveryWindow_slotId(ctx, field)
+			case "date":
+				return ec.fieldContext_DeliveryWindow_date(ctx, field)
+			case "start":
+				return ec.fieldContext_DeliveryWindow_start(ctx, field)
+			case "end":
+				return ec.fieldContext_DeliveryWindow_end(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DeliveryWindow", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _VariantRef_id(ctx context.Context, field graphql.CollectedField, obj *model.VariantRef) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_acceptedPolicies(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_VariantRef_id,
+		ec.fieldContext_Order_acceptedPolicies,
 		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
+			return obj.AcceptedPolicies, nil
 		},
 		nil,
-		ec.marshalNID2string,
-		true,
+		ec.marshalOPolicy2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐPolicyᚄ,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_VariantRef_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Order_acceptedPolicies(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "VariantRef",
+		Object:     "Order",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Policy_id(ctx, field)
+			case "kind":
+				return ec.fieldContext_Policy_kind(ctx, field)
+			case "version":
+				return ec.fieldContext_Policy_version(ctx, field)
+			case "title":
+				return ec.fieldContext_Policy_title(ctx, field)
+			case "body":
+				return ec.fieldContext_Policy_body(ctx, field)
+			case "publishedAt":
+				return ec.fieldContext_Policy_publishedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Policy", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _VariantRef_name(ctx context.Context, field graphql.CollectedField, obj *model.VariantRef) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_cancelReason(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_VariantRef_name,
+		ec.fieldContext_Order_cancelReason,
 		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
+			return obj.CancelReason, nil
 		},
 		nil,
-		ec.marshalNString2string,
-		true,
+		ec.marshalOOrderReason2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderReason,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_VariantRef_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Order_cancelReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "VariantRef",
+		Object:     "Order",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_OrderReason_id(ctx, field)
+			case "code":
+				return ec.fieldContext_OrderReason_code(ctx, field)
+			case "kind":
+				return ec.fieldContext_OrderReason_kind(ctx, field)
+			case "audience":
+				return ec.fieldContext_OrderReason_audience(ctx, field)
+			case "label":
+				return ec.fieldContext_OrderReason_label(ctx, field)
+			case "position":
+				return ec.fieldContext_OrderReason_position(ctx, field)
+			case "isActive":
+				return ec.fieldContext_OrderReason_isActive(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_OrderReason_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type OrderReason", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _VariantRef_productName(ctx context.Context, field graphql.CollectedField, obj *model.VariantRef) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_cancelNote(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_VariantRef_productName,
+		ec.fieldContext_Order_cancelNote,
 		func(ctx context.Context) (any, error) {
-			return obj.ProductName, nil
+			return obj.CancelNote, nil
 		},
 		nil,
-		ec.marshalNString2string,
-		true,
+		ec.marshalOString2ᚖstring,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_VariantRef_productName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Order_cancelNote(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "VariantRef",
+		Object:     "Order",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -3007,478 +2971,6140 @@ func (ec *executionContext) fieldContext_VariantRef_productName(_ context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _VariantRef_imageUrl(ctx context.Context, field graphql.CollectedField, obj *model.VariantRef) (ret graphql.Marshaler) {
+func (ec *executionContext) _Order_items(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_VariantRef_imageUrl,
+		ec.fieldContext_Order_items,
 		func(ctx context.Context) (any, error) {
-			return obj.ImageURL, nil
+			return obj.Items, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNOrderItem2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderItemᚄ,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_VariantRef_imageUrl(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Order_items(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "VariantRef",
+		Object:     "Order",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_OrderItem_id(ctx, field)
+			case "variant":
+				return ec.fieldContext_OrderItem_variant(ctx, field)
+			case "quantity":
+				return ec.fieldContext_OrderItem_quantity(ctx, field)
+			case "quantityType":
+				return ec.fieldContext_OrderItem_quantityType(ctx, field)
+			case "pricing":
+				return ec.fieldContext_OrderItem_pricing(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type OrderItem", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-// endregion **************************** field.gotpl *****************************
-
-// region    **************************** input.gotpl *****************************
-
-func (ec *executionContext) unmarshalInputCheckoutSessionItemInput(ctx context.Context, obj any) (model.CheckoutSessionItemInput, error) {
-	var it model.CheckoutSessionItemInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
-	}
+func (ec *executionContext) _Order_timestamps(ctx context.Context, field graphql.CollectedField, obj *model.Order) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Order_timestamps,
+		func(ctx context.Context) (any, error) {
+			return obj.Timestamps, nil
+		},
+		nil,
+		ec.marshalNOrderTimestamps2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderTimestamps,
+		true,
+		true,
+	)
+}
 
-	fieldsInOrder := [...]string{"variantId", "quantity"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "variantId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("variantId"))
-			data, err := ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.VariantID = data
-		case "quantity":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("quantity"))
-			data, err := ec.unmarshalNInt2int32(ctx, v)
-			if err != nil {
-				return it, err
+func (ec *executionContext) fieldContext_Order_timestamps(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Order",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "createdAt":
+				return ec.fieldContext_OrderTimestamps_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_OrderTimestamps_updatedAt(ctx, field)
 			}
-			it.Quantity = data
-		}
+			return nil, fmt.Errorf("no field named %q was found under type OrderTimestamps", field.Name)
+		},
 	}
-
-	return it, nil
+	return fc, nil
 }
 
-func (ec *executionContext) unmarshalInputConfirmCheckoutSessionInput(ctx context.Context, obj any) (model.ConfirmCheckoutSessionInput, error) {
-	var it model.ConfirmCheckoutSessionInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
-	}
+func (ec *executionContext) _OrderAdjustment_id(ctx context.Context, field graphql.CollectedField, obj *model.OrderAdjustment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderAdjustment_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
 
-	fieldsInOrder := [...]string{"externalId"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "externalId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("externalId"))
-			data, err := ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.ExternalID = data
-		}
+func (ec *executionContext) fieldContext_OrderAdjustment_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderAdjustment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
 	}
-
-	return it, nil
+	return fc, nil
 }
 
-func (ec *executionContext) unmarshalInputCreateCheckoutSessionInput(ctx context.Context, obj any) (model.CreateCheckoutSessionInput, error) {
-	var it model.CreateCheckoutSessionInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
-	}
+func (ec *executionContext) _OrderAdjustment_orderId(ctx context.Context, field graphql.CollectedField, obj *model.OrderAdjustment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderAdjustment_orderId,
+		func(ctx context.Context) (any, error) {
+			return obj.OrderID, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
 
-	fieldsInOrder := [...]string{"items"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "items":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("items"))
-			data, err := ec.unmarshalNCheckoutSessionItemInput2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutSessionItemInputᚄ(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Items = data
-		}
+func (ec *executionContext) fieldContext_OrderAdjustment_orderId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderAdjustment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
 	}
-
-	return it, nil
+	return fc, nil
 }
 
-func (ec *executionContext) unmarshalInputCreateOrderFromSessionInput(ctx context.Context, obj any) (model.CreateOrderFromSessionInput, error) {
-	var it model.CreateOrderFromSessionInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
-	}
+func (ec *executionContext) _OrderAdjustment_kind(ctx context.Context, field graphql.CollectedField, obj *model.OrderAdjustment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderAdjustment_kind,
+		func(ctx context.Context) (any, error) {
+			return obj.Kind, nil
+		},
+		nil,
+		ec.marshalNOrderAdjustmentKind2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderAdjustmentKind,
+		true,
+		true,
+	)
+}
 
-	fieldsInOrder := [...]string{"externalId"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "externalId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("externalId"))
-			data, err := ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.ExternalID = data
-		}
+func (ec *executionContext) fieldContext_OrderAdjustment_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderAdjustment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type OrderAdjustmentKind does not have child fields")
+		},
 	}
-
-	return it, nil
+	return fc, nil
 }
 
-func (ec *executionContext) unmarshalInputOrderFilterInput(ctx context.Context, obj any) (model.OrderFilterInput, error) {
-	var it model.OrderFilterInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
-	}
+func (ec *executionContext) _OrderAdjustment_amount(ctx context.Context, field graphql.CollectedField, obj *model.OrderAdjustment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderAdjustment_amount,
+		func(ctx context.Context) (any, error) {
+			return obj.Amount, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
 
-	fieldsInOrder := [...]string{"search", "status", "dateFrom", "dateTo"}
+func (ec *executionContext) fieldContext_OrderAdjustment_amount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderAdjustment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderAdjustment_reason(ctx context.Context, field graphql.CollectedField, obj *model.OrderAdjustment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderAdjustment_reason,
+		func(ctx context.Context) (any, error) {
+			return obj.Reason, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderAdjustment_reason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderAdjustment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderAdjustment_status(ctx context.Context, field graphql.CollectedField, obj *model.OrderAdjustment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderAdjustment_status,
+		func(ctx context.Context) (any, error) {
+			return obj.Status, nil
+		},
+		nil,
+		ec.marshalNOrderAdjustmentStatus2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderAdjustmentStatus,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderAdjustment_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderAdjustment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type OrderAdjustmentStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderAdjustment_requestedBy(ctx context.Context, field graphql.CollectedField, obj *model.OrderAdjustment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderAdjustment_requestedBy,
+		func(ctx context.Context) (any, error) {
+			return obj.RequestedBy, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderAdjustment_requestedBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderAdjustment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderAdjustment_reviewedBy(ctx context.Context, field graphql.CollectedField, obj *model.OrderAdjustment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderAdjustment_reviewedBy,
+		func(ctx context.Context) (any, error) {
+			return obj.ReviewedBy, nil
+		},
+		nil,
+		ec.marshalOID2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderAdjustment_reviewedBy(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderAdjustment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderAdjustment_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.OrderAdjustment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderAdjustment_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderAdjustment_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderAdjustment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderAdjustment_reviewedAt(ctx context.Context, field graphql.CollectedField, obj *model.OrderAdjustment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderAdjustment_reviewedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.ReviewedAt, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderAdjustment_reviewedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderAdjustment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderConnection_items(ctx context.Context, field graphql.CollectedField, obj *model.OrderConnection) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderConnection_items,
+		func(ctx context.Context) (any, error) {
+			return obj.Items, nil
+		},
+		nil,
+		ec.marshalNOrder2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderConnection_items(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Order_id(ctx, field)
+			case "externalId":
+				return ec.fieldContext_Order_externalId(ctx, field)
+			case "invoiceNumber":
+				return ec.fieldContext_Order_invoiceNumber(ctx, field)
+			case "user":
+				return ec.fieldContext_Order_user(ctx, field)
+			case "pricing":
+				return ec.fieldContext_Order_pricing(ctx, field)
+			case "status":
+				return ec.fieldContext_Order_status(ctx, field)
+			case "shipping":
+				return ec.fieldContext_Order_shipping(ctx, field)
+			case "pickup":
+				return ec.fieldContext_Order_pickup(ctx, field)
+			case "deliveryWindow":
+				return ec.fieldContext_Order_deliveryWindow(ctx, field)
+			case "acceptedPolicies":
+				return ec.fieldContext_Order_acceptedPolicies(ctx, field)
+			case "cancelReason":
+				return ec.fieldContext_Order_cancelReason(ctx, field)
+			case "cancelNote":
+				return ec.fieldContext_Order_cancelNote(ctx, field)
+			case "items":
+				return ec.fieldContext_Order_items(ctx, field)
+			case "timestamps":
+				return ec.fieldContext_Order_timestamps(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Order", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.OrderConnection) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderConnection_pageInfo,
+		func(ctx context.Context) (any, error) {
+			return obj.PageInfo, nil
+		},
+		nil,
+		ec.marshalNPageInfo2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐPageInfo,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderConnection_pageInfo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "totalItems":
+				return ec.fieldContext_PageInfo_totalItems(ctx, field)
+			case "totalPages":
+				return ec.fieldContext_PageInfo_totalPages(ctx, field)
+			case "page":
+				return ec.fieldContext_PageInfo_page(ctx, field)
+			case "limit":
+				return ec.fieldContext_PageInfo_limit(ctx, field)
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "hasPreviousPage":
+				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderItem_id(ctx context.Context, field graphql.CollectedField, obj *model.OrderItem) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderItem_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderItem_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderItem",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderItem_variant(ctx context.Context, field graphql.CollectedField, obj *model.OrderItem) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderItem_variant,
+		func(ctx context.Context) (any, error) {
+			return obj.Variant, nil
+		},
+		nil,
+		ec.marshalNVariantRef2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐVariantRef,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderItem_variant(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderItem",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_VariantRef_id(ctx, field)
+			case "name":
+				return ec.fieldContext_VariantRef_name(ctx, field)
+			case "productName":
+				return ec.fieldContext_VariantRef_productName(ctx, field)
+			case "imageUrl":
+				return ec.fieldContext_VariantRef_imageUrl(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type VariantRef", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderItem_quantity(ctx context.Context, field graphql.CollectedField, obj *model.OrderItem) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderItem_quantity,
+		func(ctx context.Context) (any, error) {
+			return obj.Quantity, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderItem_quantity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderItem",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderItem_quantityType(ctx context.Context, field graphql.CollectedField, obj *model.OrderItem) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderItem_quantityType,
+		func(ctx context.Context) (any, error) {
+			return obj.QuantityType, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderItem_quantityType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderItem",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderItem_pricing(ctx context.Context, field graphql.CollectedField, obj *model.OrderItem) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderItem_pricing,
+		func(ctx context.Context) (any, error) {
+			return obj.Pricing, nil
+		},
+		nil,
+		ec.marshalNOrderItemPricing2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderItemPricing,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderItem_pricing(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderItem",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "price":
+				return ec.fieldContext_OrderItemPricing_price(ctx, field)
+			case "subtotal":
+				return ec.fieldContext_OrderItemPricing_subtotal(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type OrderItemPricing", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderItemPricing_price(ctx context.Context, field graphql.CollectedField, obj *model.OrderItemPricing) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderItemPricing_price,
+		func(ctx context.Context) (any, error) {
+			return obj.Price, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderItemPricing_price(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderItemPricing",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderItemPricing_subtotal(ctx context.Context, field graphql.CollectedField, obj *model.OrderItemPricing) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderItemPricing_subtotal,
+		func(ctx context.Context) (any, error) {
+			return obj.Subtotal, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderItemPricing_subtotal(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderItemPricing",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderPickup_location(ctx context.Context, field graphql.CollectedField, obj *model.OrderPickup) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderPickup_location,
+		func(ctx context.Context) (any, error) {
+			return obj.Location, nil
+		},
+		nil,
+		ec.marshalNPickupLocation2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐPickupLocation,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderPickup_location(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderPickup",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_PickupLocation_id(ctx, field)
+			case "name":
+				return ec.fieldContext_PickupLocation_name(ctx, field)
+			case "address":
+				return ec.fieldContext_PickupLocation_address(ctx, field)
+			case "city":
+				return ec.fieldContext_PickupLocation_city(ctx, field)
+			case "opensAt":
+				return ec.fieldContext_PickupLocation_opensAt(ctx, field)
+			case "closesAt":
+				return ec.fieldContext_PickupLocation_closesAt(ctx, field)
+			case "slotMinutes":
+				return ec.fieldContext_PickupLocation_slotMinutes(ctx, field)
+			case "isActive":
+				return ec.fieldContext_PickupLocation_isActive(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_PickupLocation_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PickupLocation", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderPickup_slotStart(ctx context.Context, field graphql.CollectedField, obj *model.OrderPickup) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderPickup_slotStart,
+		func(ctx context.Context) (any, error) {
+			return obj.SlotStart, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderPickup_slotStart(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderPickup",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderPickup_slotEnd(ctx context.Context, field graphql.CollectedField, obj *model.OrderPickup) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderPickup_slotEnd,
+		func(ctx context.Context) (any, error) {
+			return obj.SlotEnd, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderPickup_slotEnd(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderPickup",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderPickup_code(ctx context.Context, field graphql.CollectedField, obj *model.OrderPickup) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderPickup_code,
+		func(ctx context.Context) (any, error) {
+			return obj.Code, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderPickup_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderPickup",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderPickup_pickedUpAt(ctx context.Context, field graphql.CollectedField, obj *model.OrderPickup) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderPickup_pickedUpAt,
+		func(ctx context.Context) (any, error) {
+			return obj.PickedUpAt, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderPickup_pickedUpAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderPickup",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderPricing_currency(ctx context.Context, field graphql.CollectedField, obj *model.OrderPricing) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderPricing_currency,
+		func(ctx context.Context) (any, error) {
+			return obj.Currency, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderPricing_currency(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderPricing",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderPricing_subtotal(ctx context.Context, field graphql.CollectedField, obj *model.OrderPricing) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderPricing_subtotal,
+		func(ctx context.Context) (any, error) {
+			return obj.Subtotal, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderPricing_subtotal(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderPricing",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderPricing_tax(ctx context.Context, field graphql.CollectedField, obj *model.OrderPricing) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderPricing_tax,
+		func(ctx context.Context) (any, error) {
+			return obj.Tax, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderPricing_tax(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderPricing",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderPricing_discount(ctx context.Context, field graphql.CollectedField, obj *model.OrderPricing) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderPricing_discount,
+		func(ctx context.Context) (any, error) {
+			return obj.Discount, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderPricing_discount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderPricing",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderPricing_shippingFee(ctx context.Context, field graphql.CollectedField, obj *model.OrderPricing) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderPricing_shippingFee,
+		func(ctx context.Context) (any, error) {
+			return obj.ShippingFee, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderPricing_shippingFee(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderPricing",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderPricing_insuranceFee(ctx context.Context, field graphql.CollectedField, obj *model.OrderPricing) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderPricing_insuranceFee,
+		func(ctx context.Context) (any, error) {
+			return obj.InsuranceFee, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderPricing_insuranceFee(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderPricing",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderPricing_adjustment(ctx context.Context, field graphql.CollectedField, obj *model.OrderPricing) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderPricing_adjustment,
+		func(ctx context.Context) (any, error) {
+			return obj.Adjustment, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderPricing_adjustment(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderPricing",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderPricing_total(ctx context.Context, field graphql.CollectedField, obj *model.OrderPricing) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderPricing_total,
+		func(ctx context.Context) (any, error) {
+			return obj.Total, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderPricing_total(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderPricing",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderPricing_walletAmount(ctx context.Context, field graphql.CollectedField, obj *model.OrderPricing) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderPricing_walletAmount,
+		func(ctx context.Context) (any, error) {
+			return obj.WalletAmount, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderPricing_walletAmount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderPricing",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderReason_id(ctx context.Context, field graphql.CollectedField, obj *model.OrderReason) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderReason_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderReason_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderReason",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderReason_code(ctx context.Context, field graphql.CollectedField, obj *model.OrderReason) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderReason_code,
+		func(ctx context.Context) (any, error) {
+			return obj.Code, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderReason_code(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderReason",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderReason_kind(ctx context.Context, field graphql.CollectedField, obj *model.OrderReason) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderReason_kind,
+		func(ctx context.Context) (any, error) {
+			return obj.Kind, nil
+		},
+		nil,
+		ec.marshalNOrderReasonKind2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderReasonKind,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderReason_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderReason",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type OrderReasonKind does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderReason_audience(ctx context.Context, field graphql.CollectedField, obj *model.OrderReason) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderReason_audience,
+		func(ctx context.Context) (any, error) {
+			return obj.Audience, nil
+		},
+		nil,
+		ec.marshalNOrderReasonAudience2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderReasonAudience,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderReason_audience(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderReason",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type OrderReasonAudience does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderReason_label(ctx context.Context, field graphql.CollectedField, obj *model.OrderReason) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderReason_label,
+		func(ctx context.Context) (any, error) {
+			return obj.Label, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderReason_label(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderReason",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderReason_position(ctx context.Context, field graphql.CollectedField, obj *model.OrderReason) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderReason_position,
+		func(ctx context.Context) (any, error) {
+			return obj.Position, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderReason_position(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderReason",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderReason_isActive(ctx context.Context, field graphql.CollectedField, obj *model.OrderReason) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderReason_isActive,
+		func(ctx context.Context) (any, error) {
+			return obj.IsActive, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderReason_isActive(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderReason",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderReason_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.OrderReason) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderReason_updatedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.UpdatedAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderReason_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderReason",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderReasonStat_reason(ctx context.Context, field graphql.CollectedField, obj *model.OrderReasonStat) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderReasonStat_reason,
+		func(ctx context.Context) (any, error) {
+			return obj.Reason, nil
+		},
+		nil,
+		ec.marshalNOrderReason2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderReason,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderReasonStat_reason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderReasonStat",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_OrderReason_id(ctx, field)
+			case "code":
+				return ec.fieldContext_OrderReason_code(ctx, field)
+			case "kind":
+				return ec.fieldContext_OrderReason_kind(ctx, field)
+			case "audience":
+				return ec.fieldContext_OrderReason_audience(ctx, field)
+			case "label":
+				return ec.fieldContext_OrderReason_label(ctx, field)
+			case "position":
+				return ec.fieldContext_OrderReason_position(ctx, field)
+			case "isActive":
+				return ec.fieldContext_OrderReason_isActive(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_OrderReason_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type OrderReason", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderReasonStat_orders(ctx context.Context, field graphql.CollectedField, obj *model.OrderReasonStat) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderReasonStat_orders,
+		func(ctx context.Context) (any, error) {
+			return obj.Orders, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderReasonStat_orders(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderReasonStat",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderReasonStat_amount(ctx context.Context, field graphql.CollectedField, obj *model.OrderReasonStat) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderReasonStat_amount,
+		func(ctx context.Context) (any, error) {
+			return obj.Amount, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderReasonStat_amount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderReasonStat",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderShipping_address(ctx context.Context, field graphql.CollectedField, obj *model.OrderShipping) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderShipping_address,
+		func(ctx context.Context) (any, error) {
+			return obj.Address, nil
+		},
+		nil,
+		ec.marshalNAddress2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐAddress,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderShipping_address(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderShipping",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Address_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Address_name(ctx, field)
+			case "receiverName":
+				return ec.fieldContext_Address_receiverName(ctx, field)
+			case "phone":
+				return ec.fieldContext_Address_phone(ctx, field)
+			case "addressLine1":
+				return ec.fieldContext_Address_addressLine1(ctx, field)
+			case "addressLine2":
+				return ec.fieldContext_Address_addressLine2(ctx, field)
+			case "city":
+				return ec.fieldContext_Address_city(ctx, field)
+			case "province":
+				return ec.fieldContext_Address_province(ctx, field)
+			case "postalCode":
+				return ec.fieldContext_Address_postalCode(ctx, field)
+			case "country":
+				return ec.fieldContext_Address_country(ctx, field)
+			case "location":
+				return ec.fieldContext_Address_location(ctx, field)
+			case "isDefault":
+				return ec.fieldContext_Address_isDefault(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Address", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderShipping_method(ctx context.Context, field graphql.CollectedField, obj *model.OrderShipping) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderShipping_method,
+		func(ctx context.Context) (any, error) {
+			return obj.Method, nil
+		},
+		nil,
+		ec.marshalNShippingMethod2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐShippingMethod,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderShipping_method(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderShipping",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ShippingMethod does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderTimestamps_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.OrderTimestamps) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderTimestamps_createdAt,
+		func(ctx context.Context) (any, error) {
+			return obj.CreatedAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderTimestamps_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderTimestamps",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _OrderTimestamps_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.OrderTimestamps) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_OrderTimestamps_updatedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.UpdatedAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_OrderTimestamps_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "OrderTimestamps",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Payment_status(ctx context.Context, field graphql.CollectedField, obj *model.Payment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Payment_status,
+		func(ctx context.Context) (any, error) {
+			return obj.Status, nil
+		},
+		nil,
+		ec.marshalNPaymentStatus2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐPaymentStatus,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Payment_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Payment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type PaymentStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Payment_url(ctx context.Context, field graphql.CollectedField, obj *model.Payment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Payment_url,
+		func(ctx context.Context) (any, error) {
+			return obj.URL, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Payment_url(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Payment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Payment_provider(ctx context.Context, field graphql.CollectedField, obj *model.Payment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Payment_provider,
+		func(ctx context.Context) (any, error) {
+			return obj.Provider, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Payment_provider(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Payment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PaymentDetail_method(ctx context.Context, field graphql.CollectedField, obj *model.PaymentDetail) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PaymentDetail_method,
+		func(ctx context.Context) (any, error) {
+			return obj.Method, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PaymentDetail_method(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PaymentDetail",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PaymentDetail_bank(ctx context.Context, field graphql.CollectedField, obj *model.PaymentDetail) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PaymentDetail_bank,
+		func(ctx context.Context) (any, error) {
+			return obj.Bank, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PaymentDetail_bank(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PaymentDetail",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PaymentDetail_paymentCode(ctx context.Context, field graphql.CollectedField, obj *model.PaymentDetail) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PaymentDetail_paymentCode,
+		func(ctx context.Context) (any, error) {
+			return obj.PaymentCode, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PaymentDetail_paymentCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PaymentDetail",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PaymentDetail_invoiceURL(ctx context.Context, field graphql.CollectedField, obj *model.PaymentDetail) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PaymentDetail_invoiceURL,
+		func(ctx context.Context) (any, error) {
+			return obj.InvoiceURL, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PaymentDetail_invoiceURL(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PaymentDetail",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PaymentDetail_referenceId(ctx context.Context, field graphql.CollectedField, obj *model.PaymentDetail) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PaymentDetail_referenceId,
+		func(ctx context.Context) (any, error) {
+			return obj.ReferenceID, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PaymentDetail_referenceId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PaymentDetail",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PaymentDetail_instructions(ctx context.Context, field graphql.CollectedField, obj *model.PaymentDetail) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PaymentDetail_instructions,
+		func(ctx context.Context) (any, error) {
+			return obj.Instructions, nil
+		},
+		nil,
+		ec.marshalNString2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PaymentDetail_instructions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PaymentDetail",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PaymentOrderInfoResponse_orderExternalID(ctx context.Context, field graphql.CollectedField, obj *model.PaymentOrderInfoResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PaymentOrderInfoResponse_orderExternalID,
+		func(ctx context.Context) (any, error) {
+			return obj.OrderExternalID, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PaymentOrderInfoResponse_orderExternalID(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PaymentOrderInfoResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PaymentOrderInfoResponse_status(ctx context.Context, field graphql.CollectedField, obj *model.PaymentOrderInfoResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PaymentOrderInfoResponse_status,
+		func(ctx context.Context) (any, error) {
+			return obj.Status, nil
+		},
+		nil,
+		ec.marshalNPaymentStatus2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐPaymentStatus,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PaymentOrderInfoResponse_status(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PaymentOrderInfoResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type PaymentStatus does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PaymentOrderInfoResponse_expiresAt(ctx context.Context, field graphql.CollectedField, obj *model.PaymentOrderInfoResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PaymentOrderInfoResponse_expiresAt,
+		func(ctx context.Context) (any, error) {
+			return obj.ExpiresAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PaymentOrderInfoResponse_expiresAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PaymentOrderInfoResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PaymentOrderInfoResponse_secondsRemaining(ctx context.Context, field graphql.CollectedField, obj *model.PaymentOrderInfoResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PaymentOrderInfoResponse_secondsRemaining,
+		func(ctx context.Context) (any, error) {
+			return obj.SecondsRemaining, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PaymentOrderInfoResponse_secondsRemaining(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PaymentOrderInfoResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PaymentOrderInfoResponse_totalAmount(ctx context.Context, field graphql.CollectedField, obj *model.PaymentOrderInfoResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PaymentOrderInfoResponse_totalAmount,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalAmount, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PaymentOrderInfoResponse_totalAmount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PaymentOrderInfoResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PaymentOrderInfoResponse_currency(ctx context.Context, field graphql.CollectedField, obj *model.PaymentOrderInfoResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PaymentOrderInfoResponse_currency,
+		func(ctx context.Context) (any, error) {
+			return obj.Currency, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PaymentOrderInfoResponse_currency(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PaymentOrderInfoResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PaymentOrderInfoResponse_shippingAddress(ctx context.Context, field graphql.CollectedField, obj *model.PaymentOrderInfoResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PaymentOrderInfoResponse_shippingAddress,
+		func(ctx context.Context) (any, error) {
+			return obj.ShippingAddress, nil
+		},
+		nil,
+		ec.marshalNShippingAddress2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐShippingAddress,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PaymentOrderInfoResponse_shippingAddress(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PaymentOrderInfoResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_ShippingAddress_name(ctx, field)
+			case "receiverName":
+				return ec.fieldContext_ShippingAddress_receiverName(ctx, field)
+			case "phone":
+				return ec.fieldContext_ShippingAddress_phone(ctx, field)
+			case "address1":
+				return ec.fieldContext_ShippingAddress_address1(ctx, field)
+			case "address2":
+				return ec.fieldContext_ShippingAddress_address2(ctx, field)
+			case "city":
+				return ec.fieldContext_ShippingAddress_city(ctx, field)
+			case "province":
+				return ec.fieldContext_ShippingAddress_province(ctx, field)
+			case "postalCode":
+				return ec.fieldContext_ShippingAddress_postalCode(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ShippingAddress", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PaymentOrderInfoResponse_payment(ctx context.Context, field graphql.CollectedField, obj *model.PaymentOrderInfoResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PaymentOrderInfoResponse_payment,
+		func(ctx context.Context) (any, error) {
+			return obj.Payment, nil
+		},
+		nil,
+		ec.marshalNPaymentDetail2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐPaymentDetail,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PaymentOrderInfoResponse_payment(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PaymentOrderInfoResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "method":
+				return ec.fieldContext_PaymentDetail_method(ctx, field)
+			case "bank":
+				return ec.fieldContext_PaymentDetail_bank(ctx, field)
+			case "paymentCode":
+				return ec.fieldContext_PaymentDetail_paymentCode(ctx, field)
+			case "invoiceURL":
+				return ec.fieldContext_PaymentDetail_invoiceURL(ctx, field)
+			case "referenceId":
+				return ec.fieldContext_PaymentDetail_referenceId(ctx, field)
+			case "instructions":
+				return ec.fieldContext_PaymentDetail_instructions(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PaymentDetail", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PaymentOrderInfoResponse_timeline(ctx context.Context, field graphql.CollectedField, obj *model.PaymentOrderInfoResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PaymentOrderInfoResponse_timeline,
+		func(ctx context.Context) (any, error) {
+			return obj.Timeline, nil
+		},
+		nil,
+		ec.marshalNPaymentTimelineEntry2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐPaymentTimelineEntryᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PaymentOrderInfoResponse_timeline(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PaymentOrderInfoResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "event":
+				return ec.fieldContext_PaymentTimelineEntry_event(ctx, field)
+			case "occurredAt":
+				return ec.fieldContext_PaymentTimelineEntry_occurredAt(ctx, field)
+			case "amount":
+				return ec.fieldContext_PaymentTimelineEntry_amount(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PaymentTimelineEntry", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PaymentTimelineEntry_event(ctx context.Context, field graphql.CollectedField, obj *model.PaymentTimelineEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PaymentTimelineEntry_event,
+		func(ctx context.Context) (any, error) {
+			return obj.Event, nil
+		},
+		nil,
+		ec.marshalNPaymentTimelineEvent2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐPaymentTimelineEvent,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PaymentTimelineEntry_event(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PaymentTimelineEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type PaymentTimelineEvent does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PaymentTimelineEntry_occurredAt(ctx context.Context, field graphql.CollectedField, obj *model.PaymentTimelineEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PaymentTimelineEntry_occurredAt,
+		func(ctx context.Context) (any, error) {
+			return obj.OccurredAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PaymentTimelineEntry_occurredAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PaymentTimelineEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PaymentTimelineEntry_amount(ctx context.Context, field graphql.CollectedField, obj *model.PaymentTimelineEntry) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PaymentTimelineEntry_amount,
+		func(ctx context.Context) (any, error) {
+			return obj.Amount, nil
+		},
+		nil,
+		ec.marshalOInt2ᚖint32,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_PaymentTimelineEntry_amount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PaymentTimelineEntry",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PickupLocation_id(ctx context.Context, field graphql.CollectedField, obj *model.PickupLocation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PickupLocation_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PickupLocation_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PickupLocation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PickupLocation_name(ctx context.Context, field graphql.CollectedField, obj *model.PickupLocation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PickupLocation_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PickupLocation_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PickupLocation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PickupLocation_address(ctx context.Context, field graphql.CollectedField, obj *model.PickupLocation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PickupLocation_address,
+		func(ctx context.Context) (any, error) {
+			return obj.Address, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PickupLocation_address(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PickupLocation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PickupLocation_city(ctx context.Context, field graphql.CollectedField, obj *model.PickupLocation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PickupLocation_city,
+		func(ctx context.Context) (any, error) {
+			return obj.City, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PickupLocation_city(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PickupLocation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PickupLocation_opensAt(ctx context.Context, field graphql.CollectedField, obj *model.PickupLocation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PickupLocation_opensAt,
+		func(ctx context.Context) (any, error) {
+			return obj.OpensAt, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PickupLocation_opensAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PickupLocation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PickupLocation_closesAt(ctx context.Context, field graphql.CollectedField, obj *model.PickupLocation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PickupLocation_closesAt,
+		func(ctx context.Context) (any, error) {
+			return obj.ClosesAt, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PickupLocation_closesAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PickupLocation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PickupLocation_slotMinutes(ctx context.Context, field graphql.CollectedField, obj *model.PickupLocation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PickupLocation_slotMinutes,
+		func(ctx context.Context) (any, error) {
+			return obj.SlotMinutes, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PickupLocation_slotMinutes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PickupLocation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PickupLocation_isActive(ctx context.Context, field graphql.CollectedField, obj *model.PickupLocation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PickupLocation_isActive,
+		func(ctx context.Context) (any, error) {
+			return obj.IsActive, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PickupLocation_isActive(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PickupLocation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PickupLocation_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.PickupLocation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PickupLocation_updatedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.UpdatedAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PickupLocation_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PickupLocation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PickupSlot_start(ctx context.Context, field graphql.CollectedField, obj *model.PickupSlot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PickupSlot_start,
+		func(ctx context.Context) (any, error) {
+			return obj.Start, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PickupSlot_start(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PickupSlot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _PickupSlot_end(ctx context.Context, field graphql.CollectedField, obj *model.PickupSlot) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_PickupSlot_end,
+		func(ctx context.Context) (any, error) {
+			return obj.End, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_PickupSlot_end(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "PickupSlot",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Policy_id(ctx context.Context, field graphql.CollectedField, obj *model.Policy) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Policy_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Policy_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Policy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Policy_kind(ctx context.Context, field graphql.CollectedField, obj *model.Policy) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Policy_kind,
+		func(ctx context.Context) (any, error) {
+			return obj.Kind, nil
+		},
+		nil,
+		ec.marshalNPolicyKind2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐPolicyKind,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Policy_kind(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Policy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type PolicyKind does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Policy_version(ctx context.Context, field graphql.CollectedField, obj *model.Policy) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Policy_version,
+		func(ctx context.Context) (any, error) {
+			return obj.Version, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Policy_version(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Policy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Policy_title(ctx context.Context, field graphql.CollectedField, obj *model.Policy) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Policy_title,
+		func(ctx context.Context) (any, error) {
+			return obj.Title, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Policy_title(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Policy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Policy_body(ctx context.Context, field graphql.CollectedField, obj *model.Policy) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Policy_body,
+		func(ctx context.Context) (any, error) {
+			return obj.Body, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Policy_body(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Policy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Policy_publishedAt(ctx context.Context, field graphql.CollectedField, obj *model.Policy) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Policy_publishedAt,
+		func(ctx context.Context) (any, error) {
+			return obj.PublishedAt, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Policy_publishedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Policy",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShippingAddress_name(ctx context.Context, field graphql.CollectedField, obj *model.ShippingAddress) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ShippingAddress_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ShippingAddress_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShippingAddress",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShippingAddress_receiverName(ctx context.Context, field graphql.CollectedField, obj *model.ShippingAddress) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ShippingAddress_receiverName,
+		func(ctx context.Context) (any, error) {
+			return obj.ReceiverName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ShippingAddress_receiverName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShippingAddress",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShippingAddress_phone(ctx context.Context, field graphql.CollectedField, obj *model.ShippingAddress) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ShippingAddress_phone,
+		func(ctx context.Context) (any, error) {
+			return obj.Phone, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ShippingAddress_phone(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShippingAddress",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShippingAddress_address1(ctx context.Context, field graphql.CollectedField, obj *model.ShippingAddress) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ShippingAddress_address1,
+		func(ctx context.Context) (any, error) {
+			return obj.Address1, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ShippingAddress_address1(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShippingAddress",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShippingAddress_address2(ctx context.Context, field graphql.CollectedField, obj *model.ShippingAddress) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ShippingAddress_address2,
+		func(ctx context.Context) (any, error) {
+			return obj.Address2, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ShippingAddress_address2(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShippingAddress",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShippingAddress_city(ctx context.Context, field graphql.CollectedField, obj *model.ShippingAddress) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ShippingAddress_city,
+		func(ctx context.Context) (any, error) {
+			return obj.City, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ShippingAddress_city(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShippingAddress",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShippingAddress_province(ctx context.Context, field graphql.CollectedField, obj *model.ShippingAddress) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ShippingAddress_province,
+		func(ctx context.Context) (any, error) {
+			return obj.Province, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ShippingAddress_province(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShippingAddress",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShippingAddress_postalCode(ctx context.Context, field graphql.CollectedField, obj *model.ShippingAddress) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ShippingAddress_postalCode,
+		func(ctx context.Context) (any, error) {
+			return obj.PostalCode, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ShippingAddress_postalCode(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShippingAddress",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShippingOption_method(ctx context.Context, field graphql.CollectedField, obj *model.ShippingOption) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ShippingOption_method,
+		func(ctx context.Context) (any, error) {
+			return obj.Method, nil
+		},
+		nil,
+		ec.marshalNShippingMethod2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐShippingMethod,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ShippingOption_method(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShippingOption",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ShippingMethod does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShippingOption_fee(ctx context.Context, field graphql.CollectedField, obj *model.ShippingOption) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ShippingOption_fee,
+		func(ctx context.Context) (any, error) {
+			return obj.Fee, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ShippingOption_fee(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShippingOption",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShippingOption_available(ctx context.Context, field graphql.CollectedField, obj *model.ShippingOption) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ShippingOption_available,
+		func(ctx context.Context) (any, error) {
+			return obj.Available, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ShippingOption_available(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShippingOption",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShippingOption_distanceKm(ctx context.Context, field graphql.CollectedField, obj *model.ShippingOption) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ShippingOption_distanceKm,
+		func(ctx context.Context) (any, error) {
+			return obj.DistanceKm, nil
+		},
+		nil,
+		ec.marshalOFloat2ᚖfloat64,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ShippingOption_distanceKm(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShippingOption",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShippingOption_unavailableReason(ctx context.Context, field graphql.CollectedField, obj *model.ShippingOption) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ShippingOption_unavailableReason,
+		func(ctx context.Context) (any, error) {
+			return obj.UnavailableReason, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ShippingOption_unavailableReason(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShippingOption",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ShippingOption_insuranceFee(ctx context.Context, field graphql.CollectedField, obj *model.ShippingOption) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ShippingOption_insuranceFee,
+		func(ctx context.Context) (any, error) {
+			return obj.InsuranceFee, nil
+		},
+		nil,
+		ec.marshalOInt2ᚖint32,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ShippingOption_insuranceFee(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ShippingOption",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UpdateSessionAddressResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.UpdateSessionAddressResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UpdateSessionAddressResponse_success,
+		func(ctx context.Context) (any, error) {
+			return obj.Success, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UpdateSessionAddressResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UpdateSessionAddressResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UpdateSessionPaymentMethodResponse_success(ctx context.Context, field graphql.CollectedField, obj *model.UpdateSessionPaymentMethodResponse) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UpdateSessionPaymentMethodResponse_success,
+		func(ctx context.Context) (any, error) {
+			return obj.Success, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UpdateSessionPaymentMethodResponse_success(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UpdateSessionPaymentMethodResponse",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _UserRef_id(ctx context.Context, field graphql.CollectedField, obj *model.UserRef) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_UserRef_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNInt2int32,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_UserRef_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "UserRef",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VariantRef_id(ctx context.Context, field graphql.CollectedField, obj *model.VariantRef) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VariantRef_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNUUID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VariantRef_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VariantRef",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type UUID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VariantRef_name(ctx context.Context, field graphql.CollectedField, obj *model.VariantRef) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VariantRef_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VariantRef_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VariantRef",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VariantRef_productName(ctx context.Context, field graphql.CollectedField, obj *model.VariantRef) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VariantRef_productName,
+		func(ctx context.Context) (any, error) {
+			return obj.ProductName, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_VariantRef_productName(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VariantRef",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _VariantRef_imageUrl(ctx context.Context, field graphql.CollectedField, obj *model.VariantRef) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_VariantRef_imageUrl,
+		func(ctx context.Context) (any, error) {
+			return obj.ImageURL, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_VariantRef_imageUrl(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "VariantRef",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+// endregion **************************** field.gotpl *****************************
+
+// region    **************************** input.gotpl *****************************
+
+func (ec *executionContext) unmarshalInputApplyCouponInput(ctx context.Context, obj any) (model.ApplyCouponInput, error) {
+	var it model.ApplyCouponInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"externalId", "code"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "externalId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("externalId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExternalID = data
+		case "code":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("code"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Code = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputApplySessionPointsInput(ctx context.Context, obj any) (model.ApplySessionPointsInput, error) {
+	var it model.ApplySessionPointsInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"externalId", "points"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "externalId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("externalId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExternalID = data
+		case "points":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("points"))
+			data, err := ec.unmarshalNInt2int32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Points = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputApplySessionWalletInput(ctx context.Context, obj any) (model.ApplySessionWalletInput, error) {
+	var it model.ApplySessionWalletInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"externalId", "amount"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "externalId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("externalId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExternalID = data
+		case "amount":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("amount"))
+			data, err := ec.unmarshalNInt2int32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Amount = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCheckoutSessionItemInput(ctx context.Context, obj any) (model.CheckoutSessionItemInput, error) {
+	var it model.CheckoutSessionItemInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"variantId", "quantity"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "variantId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("variantId"))
+			data, err := ec.unmarshalNUUID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.VariantID = data
+		case "quantity":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("quantity"))
+			data, err := ec.unmarshalNInt2int32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Quantity = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputConfirmCheckoutSessionInput(ctx context.Context, obj any) (model.ConfirmCheckoutSessionInput, error) {
+	var it model.ConfirmCheckoutSessionInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"externalId", "acceptedPolicyIds"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "externalId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("externalId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExternalID = data
+		case "acceptedPolicyIds":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("acceptedPolicyIds"))
+			data, err := ec.unmarshalOID2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AcceptedPolicyIds = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCreateAdminOrderInput(ctx context.Context, obj any) (model.CreateAdminOrderInput, error) {
+	var it model.CreateAdminOrderInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"userId", "addressId", "items", "shippingFee", "payment", "paymentMethod"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "userId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("userId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.UserID = data
+		case "addressId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("addressId"))
+			data, err := ec.unmarshalNUUID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AddressID = data
+		case "items":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("items"))
+			data, err := ec.unmarshalNCheckoutSessionItemInput2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutSessionItemInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Items = data
+		case "shippingFee":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("shippingFee"))
+			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ShippingFee = data
+		case "payment":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("payment"))
+			data, err := ec.unmarshalNAdminOrderPayment2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐAdminOrderPayment(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Payment = data
+		case "paymentMethod":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("paymentMethod"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PaymentMethod = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCreateCheckoutSessionInput(ctx context.Context, obj any) (model.CreateCheckoutSessionInput, error) {
+	var it model.CreateCheckoutSessionInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"items"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "items":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("items"))
+			data, err := ec.unmarshalNCheckoutSessionItemInput2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutSessionItemInputᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Items = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputCreateOrderFromSessionInput(ctx context.Context, obj any) (model.CreateOrderFromSessionInput, error) {
+	var it model.CreateOrderFromSessionInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"externalId"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "externalId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("externalId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExternalID = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputOrderFilterInput(ctx context.Context, obj any) (model.OrderFilterInput, error) {
+	var it model.OrderFilterInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"search", "status", "dateFrom", "dateTo", "product", "variantId", "datePreset"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "search":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("search"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Search = data
+		case "status":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("status"))
+			data, err := ec.unmarshalOOrderStatus2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderStatus(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Status = data
+		case "dateFrom":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dateFrom"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DateFrom = data
+		case "dateTo":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dateTo"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DateTo = data
+		case "product":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("product"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Product = data
+		case "variantId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("variantId"))
+			data, err := ec.unmarshalOUUID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.VariantID = data
+		case "datePreset":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("datePreset"))
+			data, err := ec.unmarshalOOrderDatePreset2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderDatePreset(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DatePreset = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputOrderSortInput(ctx context.Context, obj any) (model.OrderSortInput, error) {
+	var it model.OrderSortInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	if _, present := asMap["direction"]; !present {
+		asMap["direction"] = "ASC"
+	}
+
+	fieldsInOrder := [...]string{"field", "direction"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "field":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("field"))
+			data, err := ec.unmarshalNOrderSortField2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderSortField(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Field = data
+		case "direction":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("direction"))
+			data, err := ec.unmarshalNSortDirection2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐSortDirection(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Direction = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputPaginationInput(ctx context.Context, obj any) (model.PaginationInput, error) {
+	var it model.PaginationInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	if _, present := asMap["page"]; !present {
+		asMap["page"] = 1
+	}
+	if _, present := asMap["limit"]; !present {
+		asMap["limit"] = 20
+	}
+
+	fieldsInOrder := [...]string{"page", "limit", "after"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "page":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("page"))
+			data, err := ec.unmarshalNInt2int32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Page = data
+		case "limit":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
+			data, err := ec.unmarshalNInt2int32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Limit = data
+		case "after":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("after"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.After = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputPublishPolicyInput(ctx context.Context, obj any) (model.PublishPolicyInput, error) {
+	var it model.PublishPolicyInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"kind", "title", "body"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "kind":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("kind"))
+			data, err := ec.unmarshalNPolicyKind2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐPolicyKind(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Kind = data
+		case "title":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("title"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Title = data
+		case "body":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("body"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Body = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRemoveSessionItemInput(ctx context.Context, obj any) (model.RemoveSessionItemInput, error) {
+	var it model.RemoveSessionItemInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"externalId", "itemId"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "externalId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("externalId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExternalID = data
+		case "itemId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("itemId"))
+			data, err := ec.unmarshalNUUID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ItemID = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputRequestOrderAdjustmentInput(ctx context.Context, obj any) (model.RequestOrderAdjustmentInput, error) {
+	var it model.RequestOrderAdjustmentInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"orderId", "kind", "amount", "reason"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "orderId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("orderId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.OrderID = data
+		case "kind":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("kind"))
+			data, err := ec.unmarshalNOrderAdjustmentKind2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderAdjustmentKind(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Kind = data
+		case "amount":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("amount"))
+			data, err := ec.unmarshalNInt2int32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Amount = data
+		case "reason":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reason"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Reason = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputSetCheckoutRuleInput(ctx context.Context, obj any) (model.SetCheckoutRuleInput, error) {
+	var it model.SetCheckoutRuleInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	if _, present := asMap["isActive"]; !present {
+		asMap["isActive"] = true
+	}
+
+	fieldsInOrder := [...]string{"region", "minOrderAmount", "freeShippingMin", "isActive"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "region":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("region"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Region = data
+		case "minOrderAmount":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("minOrderAmount"))
+			data, err := ec.unmarshalNInt2int32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MinOrderAmount = data
+		case "freeShippingMin":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("freeShippingMin"))
+			data, err := ec.unmarshalOInt2ᚖint32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FreeShippingMin = data
+		case "isActive":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("isActive"))
+			data, err := ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.IsActive = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputSetDeliverySlotInput(ctx context.Context, obj any) (model.SetDeliverySlotInput, error) {
+	var it model.SetDeliverySlotInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	if _, present := asMap["isActive"]; !present {
+		asMap["isActive"] = true
+	}
+
+	fieldsInOrder := [...]string{"id", "region", "startsAt", "endsAt", "capacity", "isActive"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "id":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+			data, err := ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ID = data
+		case "region":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("region"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Region = data
+		case "startsAt":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("startsAt"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.StartsAt = data
+		case "endsAt":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("endsAt"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.EndsAt = data
+		case "capacity":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("capacity"))
+			data, err := ec.unmarshalNInt2int32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Capacity = data
+		case "isActive":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("isActive"))
+			data, err := ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.IsActive = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputSetOrderReasonInput(ctx context.Context, obj any) (model.SetOrderReasonInput, error) {
+	var it model.SetOrderReasonInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	if _, present := asMap["position"]; !present {
+		asMap["position"] = 0
+	}
+	if _, present := asMap["isActive"]; !present {
+		asMap["isActive"] = true
+	}
+
+	fieldsInOrder := [...]string{"id", "code", "kind", "audience", "label", "position", "isActive"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "id":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+			data, err := ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ID = data
+		case "code":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("code"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Code = data
+		case "kind":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("kind"))
+			data, err := ec.unmarshalNOrderReasonKind2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderReasonKind(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Kind = data
+		case "audience":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("audience"))
+			data, err := ec.unmarshalNOrderReasonAudience2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderReasonAudience(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Audience = data
+		case "label":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("label"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Label = data
+		case "position":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("position"))
+			data, err := ec.unmarshalNInt2int32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Position = data
+		case "isActive":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("isActive"))
+			data, err := ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.IsActive = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputSetPickupLocationInput(ctx context.Context, obj any) (model.SetPickupLocationInput, error) {
+	var it model.SetPickupLocationInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	if _, present := asMap["slotMinutes"]; !present {
+		asMap["slotMinutes"] = 60
+	}
+	if _, present := asMap["isActive"]; !present {
+		asMap["isActive"] = true
+	}
+
+	fieldsInOrder := [...]string{"id", "name", "address", "city", "opensAt", "closesAt", "slotMinutes", "isActive"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "id":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
+			data, err := ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ID = data
+		case "name":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("name"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Name = data
+		case "address":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("address"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Address = data
+		case "city":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("city"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.City = data
+		case "opensAt":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("opensAt"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.OpensAt = data
+		case "closesAt":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("closesAt"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ClosesAt = data
+		case "slotMinutes":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("slotMinutes"))
+			data, err := ec.unmarshalNInt2int32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.SlotMinutes = data
+		case "isActive":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("isActive"))
+			data, err := ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.IsActive = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputUpdateOrderStatusInput(ctx context.Context, obj any) (model.UpdateOrderStatusInput, error) {
+	var it model.UpdateOrderStatusInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"orderId", "status", "reasonId", "reasonNote"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "orderId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("orderId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.OrderID = data
+		case "status":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("status"))
+			data, err := ec.unmarshalNOrderStatus2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderStatus(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Status = data
+		case "reasonId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reasonId"))
+			data, err := ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ReasonID = data
+		case "reasonNote":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("reasonNote"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ReasonNote = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputUpdateSessionAddressInput(ctx context.Context, obj any) (model.UpdateSessionAddressInput, error) {
+	var it model.UpdateSessionAddressInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"externalId", "addressId"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "externalId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("externalId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExternalID = data
+		case "addressId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("addressId"))
+			data, err := ec.unmarshalNUUID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.AddressID = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputUpdateSessionInsuranceInput(ctx context.Context, obj any) (model.UpdateSessionInsuranceInput, error) {
+	var it model.UpdateSessionInsuranceInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"externalId", "insured"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "externalId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("externalId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExternalID = data
+		case "insured":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("insured"))
+			data, err := ec.unmarshalNBoolean2bool(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Insured = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputUpdateSessionItemInput(ctx context.Context, obj any) (model.UpdateSessionItemInput, error) {
+	var it model.UpdateSessionItemInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"externalId", "itemId", "quantity"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "externalId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("externalId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExternalID = data
+		case "itemId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("itemId"))
+			data, err := ec.unmarshalNUUID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ItemID = data
+		case "quantity":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("quantity"))
+			data, err := ec.unmarshalNInt2int32(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Quantity = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputUpdateSessionPaymentMethodInput(ctx context.Context, obj any) (model.UpdateSessionPaymentMethodInput, error) {
+	var it model.UpdateSessionPaymentMethodInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"externalId", "paymentMethod"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "externalId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("externalId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExternalID = data
+		case "paymentMethod":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("paymentMethod"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PaymentMethod = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputUpdateSessionShippingMethodInput(ctx context.Context, obj any) (model.UpdateSessionShippingMethodInput, error) {
+	var it model.UpdateSessionShippingMethodInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"externalId", "shippingMethod", "pickupLocationId", "pickupSlotStart", "deliverySlotId"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "externalId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("externalId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ExternalID = data
+		case "shippingMethod":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("shippingMethod"))
+			data, err := ec.unmarshalNShippingMethod2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐShippingMethod(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ShippingMethod = data
+		case "pickupLocationId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pickupLocationId"))
+			data, err := ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PickupLocationID = data
+		case "pickupSlotStart":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("pickupSlotStart"))
+			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.PickupSlotStart = data
+		case "deliverySlotId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("deliverySlotId"))
+			data, err := ec.unmarshalOID2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.DeliverySlotID = data
+		}
+	}
+
+	return it, nil
+}
+
+func (ec *executionContext) unmarshalInputVerifyPickupCodeInput(ctx context.Context, obj any) (model.VerifyPickupCodeInput, error) {
+	var it model.VerifyPickupCodeInput
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"orderId", "code"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
 			continue
 		}
-		switch k {
-		case "search":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("search"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+		switch k {
+		case "orderId":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("orderId"))
+			data, err := ec.unmarshalNID2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.OrderID = data
+		case "code":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("code"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Code = data
+		}
+	}
+
+	return it, nil
+}
+
+// endregion **************************** input.gotpl *****************************
+
+// region    ************************** interface.gotpl ***************************
+
+// endregion ************************** interface.gotpl ***************************
+
+// region    **************************** object.gotpl ****************************
+
+var applyCouponResponseImplementors = []string{"ApplyCouponResponse"}
+
+func (ec *executionContext) _ApplyCouponResponse(ctx context.Context, sel ast.SelectionSet, obj *model.ApplyCouponResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, applyCouponResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ApplyCouponResponse")
+		case "success":
+			out.Values[i] = ec._ApplyCouponResponse_success(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "discount":
+			out.Values[i] = ec._ApplyCouponResponse_discount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var applySessionPointsResponseImplementors = []string{"ApplySessionPointsResponse"}
+
+func (ec *executionContext) _ApplySessionPointsResponse(ctx context.Context, sel ast.SelectionSet, obj *model.ApplySessionPointsResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, applySessionPointsResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ApplySessionPointsResponse")
+		case "success":
+			out.Values[i] = ec._ApplySessionPointsResponse_success(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "pointsRedeemed":
+			out.Values[i] = ec._ApplySessionPointsResponse_pointsRedeemed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "discount":
+			out.Values[i] = ec._ApplySessionPointsResponse_discount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var applySessionWalletResponseImplementors = []string{"ApplySessionWalletResponse"}
+
+func (ec *executionContext) _ApplySessionWalletResponse(ctx context.Context, sel ast.SelectionSet, obj *model.ApplySessionWalletResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, applySessionWalletResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ApplySessionWalletResponse")
+		case "success":
+			out.Values[i] = ec._ApplySessionWalletResponse_success(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var checkoutRuleImplementors = []string{"CheckoutRule"}
+
+func (ec *executionContext) _CheckoutRule(ctx context.Context, sel ast.SelectionSet, obj *model.CheckoutRule) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, checkoutRuleImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CheckoutRule")
+		case "id":
+			out.Values[i] = ec._CheckoutRule_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "region":
+			out.Values[i] = ec._CheckoutRule_region(ctx, field, obj)
+		case "minOrderAmount":
+			out.Values[i] = ec._CheckoutRule_minOrderAmount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "freeShippingMin":
+			out.Values[i] = ec._CheckoutRule_freeShippingMin(ctx, field, obj)
+		case "isActive":
+			out.Values[i] = ec._CheckoutRule_isActive(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updatedAt":
+			out.Values[i] = ec._CheckoutRule_updatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var checkoutSessionImplementors = []string{"CheckoutSession"}
+
+func (ec *executionContext) _CheckoutSession(ctx context.Context, sel ast.SelectionSet, obj *model.CheckoutSession) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, checkoutSessionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CheckoutSession")
+		case "id":
+			out.Values[i] = ec._CheckoutSession_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "externalId":
+			out.Values[i] = ec._CheckoutSession_externalId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "status":
+			out.Values[i] = ec._CheckoutSession_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "expiresAt":
+			out.Values[i] = ec._CheckoutSession_expiresAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "secondsRemaining":
+			out.Values[i] = ec._CheckoutSession_secondsRemaining(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "paymentExpiresAt":
+			out.Values[i] = ec._CheckoutSession_paymentExpiresAt(ctx, field, obj)
+		case "paymentSecondsRemaining":
+			out.Values[i] = ec._CheckoutSession_paymentSecondsRemaining(ctx, field, obj)
+		case "createdAt":
+			out.Values[i] = ec._CheckoutSession_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "addressId":
+			out.Values[i] = ec._CheckoutSession_addressId(ctx, field, obj)
+		case "items":
+			out.Values[i] = ec._CheckoutSession_items(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "chargeableWeightGrams":
+			out.Values[i] = ec._CheckoutSession_chargeableWeightGrams(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "shippingMethod":
+			out.Values[i] = ec._CheckoutSession_shippingMethod(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "pickupLocationId":
+			out.Values[i] = ec._CheckoutSession_pickupLocationId(ctx, field, obj)
+		case "pickupSlotStart":
+			out.Values[i] = ec._CheckoutSession_pickupSlotStart(ctx, field, obj)
+		case "deliverySlotId":
+			out.Values[i] = ec._CheckoutSession_deliverySlotId(ctx, field, obj)
+		case "deliveryDate":
+			out.Values[i] = ec._CheckoutSession_deliveryDate(ctx, field, obj)
+		case "insured":
+			out.Values[i] = ec._CheckoutSession_insured(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "subtotal":
+			out.Values[i] = ec._CheckoutSession_subtotal(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "tax":
+			out.Values[i] = ec._CheckoutSession_tax(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "shippingFee":
+			out.Values[i] = ec._CheckoutSession_shippingFee(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "insuranceFee":
+			out.Values[i] = ec._CheckoutSession_insuranceFee(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "discount":
+			out.Values[i] = ec._CheckoutSession_discount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalPrice":
+			out.Values[i] = ec._CheckoutSession_totalPrice(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "walletAmount":
+			out.Values[i] = ec._CheckoutSession_walletAmount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "pointsRedeemed":
+			out.Values[i] = ec._CheckoutSession_pointsRedeemed(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "paymentMethod":
+			out.Values[i] = ec._CheckoutSession_paymentMethod(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var checkoutSessionEventImplementors = []string{"CheckoutSessionEvent"}
+
+func (ec *executionContext) _CheckoutSessionEvent(ctx context.Context, sel ast.SelectionSet, obj *model.CheckoutSessionEvent) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, checkoutSessionEventImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CheckoutSessionEvent")
+		case "id":
+			out.Values[i] = ec._CheckoutSessionEvent_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "type":
+			out.Values[i] = ec._CheckoutSessionEvent_type(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "actorUserId":
+			out.Values[i] = ec._CheckoutSessionEvent_actorUserId(ctx, field, obj)
+		case "actorGuestId":
+			out.Values[i] = ec._CheckoutSessionEvent_actorGuestId(ctx, field, obj)
+		case "actorRole":
+			out.Values[i] = ec._CheckoutSessionEvent_actorRole(ctx, field, obj)
+		case "fromValue":
+			out.Values[i] = ec._CheckoutSessionEvent_fromValue(ctx, field, obj)
+		case "toValue":
+			out.Values[i] = ec._CheckoutSessionEvent_toValue(ctx, field, obj)
+		case "totalBefore":
+			out.Values[i] = ec._CheckoutSessionEvent_totalBefore(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalAfter":
+			out.Values[i] = ec._CheckoutSessionEvent_totalAfter(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "createdAt":
+			out.Values[i] = ec._CheckoutSessionEvent_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var checkoutSessionItemImplementors = []string{"CheckoutSessionItem"}
+
+func (ec *executionContext) _CheckoutSessionItem(ctx context.Context, sel ast.SelectionSet, obj *model.CheckoutSessionItem) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, checkoutSessionItemImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CheckoutSessionItem")
+		case "id":
+			out.Values[i] = ec._CheckoutSessionItem_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "variantId":
+			out.Values[i] = ec._CheckoutSessionItem_variantId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "variantName":
+			out.Values[i] = ec._CheckoutSessionItem_variantName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "productName":
+			out.Values[i] = ec._CheckoutSessionItem_productName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "imageUrl":
+			out.Values[i] = ec._CheckoutSessionItem_imageUrl(ctx, field, obj)
+		case "quantity":
+			out.Values[i] = ec._CheckoutSessionItem_quantity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "quantityType":
+			out.Values[i] = ec._CheckoutSessionItem_quantityType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "price":
+			out.Values[i] = ec._CheckoutSessionItem_price(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "subtotal":
+			out.Values[i] = ec._CheckoutSessionItem_subtotal(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var checkoutSessionResponseImplementors = []string{"CheckoutSessionResponse"}
+
+func (ec *executionContext) _CheckoutSessionResponse(ctx context.Context, sel ast.SelectionSet, obj *model.CheckoutSessionResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, checkoutSessionResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CheckoutSessionResponse")
+		case "externalId":
+			out.Values[i] = ec._CheckoutSessionResponse_externalId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "status":
+			out.Values[i] = ec._CheckoutSessionResponse_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "expiresAt":
+			out.Values[i] = ec._CheckoutSessionResponse_expiresAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var confirmCheckoutSessionResponseImplementors = []string{"ConfirmCheckoutSessionResponse"}
+
+func (ec *executionContext) _ConfirmCheckoutSessionResponse(ctx context.Context, sel ast.SelectionSet, obj *model.ConfirmCheckoutSessionResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, confirmCheckoutSessionResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ConfirmCheckoutSessionResponse")
+		case "success":
+			out.Values[i] = ec._ConfirmCheckoutSessionResponse_success(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "message":
+			out.Values[i] = ec._ConfirmCheckoutSessionResponse_message(ctx, field, obj)
+		case "order_external_id":
+			out.Values[i] = ec._ConfirmCheckoutSessionResponse_order_external_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var createOrderResponseImplementors = []string{"CreateOrderResponse"}
+
+func (ec *executionContext) _CreateOrderResponse(ctx context.Context, sel ast.SelectionSet, obj *model.CreateOrderResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, createOrderResponseImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CreateOrderResponse")
+		case "success":
+			out.Values[i] = ec._CreateOrderResponse_success(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "message":
+			out.Values[i] = ec._CreateOrderResponse_message(ctx, field, obj)
+		case "order":
+			out.Values[i] = ec._CreateOrderResponse_order(ctx, field, obj)
+		case "paymentURL":
+			out.Values[i] = ec._CreateOrderResponse_paymentURL(ctx, field, obj)
+		case "payment":
+			out.Values[i] = ec._CreateOrderResponse_payment(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var deliverySlotImplementors = []string{"DeliverySlot"}
+
+func (ec *executionContext) _DeliverySlot(ctx context.Context, sel ast.SelectionSet, obj *model.DeliverySlot) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, deliverySlotImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DeliverySlot")
+		case "id":
+			out.Values[i] = ec._DeliverySlot_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "region":
+			out.Values[i] = ec._DeliverySlot_region(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "startsAt":
+			out.Values[i] = ec._DeliverySlot_startsAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "endsAt":
+			out.Values[i] = ec._DeliverySlot_endsAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "capacity":
+			out.Values[i] = ec._DeliverySlot_capacity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "isActive":
+			out.Values[i] = ec._DeliverySlot_isActive(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updatedAt":
+			out.Values[i] = ec._DeliverySlot_updatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var deliverySlotOptionImplementors = []string{"DeliverySlotOption"}
+
+func (ec *executionContext) _DeliverySlotOption(ctx context.Context, sel ast.SelectionSet, obj *model.DeliverySlotOption) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, deliverySlotOptionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DeliverySlotOption")
+		case "slot":
+			out.Values[i] = ec._DeliverySlotOption_slot(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "start":
+			out.Values[i] = ec._DeliverySlotOption_start(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "end":
+			out.Values[i] = ec._DeliverySlotOption_end(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "remaining":
+			out.Values[i] = ec._DeliverySlotOption_remaining(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "available":
+			out.Values[i] = ec._DeliverySlotOption_available(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var deliveryWindowImplementors = []string{"DeliveryWindow"}
+
+func (ec *executionContext) _DeliveryWindow(ctx context.Context, sel ast.SelectionSet, obj *model.DeliveryWindow) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, deliveryWindowImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DeliveryWindow")
+		case "slotId":
+			out.Values[i] = ec._DeliveryWindow_slotId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "date":
+			out.Values[i] = ec._DeliveryWindow_date(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "start":
+			out.Values[i] = ec._DeliveryWindow_start(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "end":
+			out.Values[i] = ec._DeliveryWindow_end(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var orderImplementors = []string{"Order"}
+
+func (ec *executionContext) _Order(ctx context.Context, sel ast.SelectionSet, obj *model.Order) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, orderImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Order")
+		case "id":
+			out.Values[i] = ec._Order_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "externalId":
+			out.Values[i] = ec._Order_externalId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "invoiceNumber":
+			out.Values[i] = ec._Order_invoiceNumber(ctx, field, obj)
+		case "user":
+			out.Values[i] = ec._Order_user(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "pricing":
+			out.Values[i] = ec._Order_pricing(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "status":
+			out.Values[i] = ec._Order_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "shipping":
+			out.Values[i] = ec._Order_shipping(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "pickup":
+			out.Values[i] = ec._Order_pickup(ctx, field, obj)
+		case "deliveryWindow":
+			out.Values[i] = ec._Order_deliveryWindow(ctx, field, obj)
+		case "acceptedPolicies":
+			out.Values[i] = ec._Order_acceptedPolicies(ctx, field, obj)
+		case "cancelReason":
+			out.Values[i] = ec._Order_cancelReason(ctx, field, obj)
+		case "cancelNote":
+			out.Values[i] = ec._Order_cancelNote(ctx, field, obj)
+		case "items":
+			out.Values[i] = ec._Order_items(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "timestamps":
+			out.Values[i] = ec._Order_timestamps(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var orderAdjustmentImplementors = []string{"OrderAdjustment"}
+
+func (ec *executionContext) _OrderAdjustment(ctx context.Context, sel ast.SelectionSet, obj *model.OrderAdjustment) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, orderAdjustmentImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OrderAdjustment")
+		case "id":
+			out.Values[i] = ec._OrderAdjustment_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "orderId":
+			out.Values[i] = ec._OrderAdjustment_orderId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "kind":
+			out.Values[i] = ec._OrderAdjustment_kind(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "amount":
+			out.Values[i] = ec._OrderAdjustment_amount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "reason":
+			out.Values[i] = ec._OrderAdjustment_reason(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "status":
+			out.Values[i] = ec._OrderAdjustment_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "requestedBy":
+			out.Values[i] = ec._OrderAdjustment_requestedBy(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "reviewedBy":
+			out.Values[i] = ec._OrderAdjustment_reviewedBy(ctx, field, obj)
+		case "createdAt":
+			out.Values[i] = ec._OrderAdjustment_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "reviewedAt":
+			out.Values[i] = ec._OrderAdjustment_reviewedAt(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var orderConnectionImplementors = []string{"OrderConnection"}
+
+func (ec *executionContext) _OrderConnection(ctx context.Context, sel ast.SelectionSet, obj *model.OrderConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, orderConnectionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OrderConnection")
+		case "items":
+			out.Values[i] = ec._OrderConnection_items(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "pageInfo":
+			out.Values[i] = ec._OrderConnection_pageInfo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var orderItemImplementors = []string{"OrderItem"}
+
+func (ec *executionContext) _OrderItem(ctx context.Context, sel ast.SelectionSet, obj *model.OrderItem) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, orderItemImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OrderItem")
+		case "id":
+			out.Values[i] = ec._OrderItem_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "variant":
+			out.Values[i] = ec._OrderItem_variant(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "quantity":
+			out.Values[i] = ec._OrderItem_quantity(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "quantityType":
+			out.Values[i] = ec._OrderItem_quantityType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "pricing":
+			out.Values[i] = ec._OrderItem_pricing(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var orderItemPricingImplementors = []string{"OrderItemPricing"}
+
+func (ec *executionContext) _OrderItemPricing(ctx context.Context, sel ast.SelectionSet, obj *model.OrderItemPricing) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, orderItemPricingImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OrderItemPricing")
+		case "price":
+			out.Values[i] = ec._OrderItemPricing_price(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "subtotal":
+			out.Values[i] = ec._OrderItemPricing_subtotal(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var orderPickupImplementors = []string{"OrderPickup"}
+
+func (ec *executionContext) _OrderPickup(ctx context.Context, sel ast.SelectionSet, obj *model.OrderPickup) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, orderPickupImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OrderPickup")
+		case "location":
+			out.Values[i] = ec._OrderPickup_location(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "slotStart":
+			out.Values[i] = ec._OrderPickup_slotStart(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "slotEnd":
+			out.Values[i] = ec._OrderPickup_slotEnd(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "code":
+			out.Values[i] = ec._OrderPickup_code(ctx, field, obj)
+		case "pickedUpAt":
+			out.Values[i] = ec._OrderPickup_pickedUpAt(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var orderPricingImplementors = []string{"OrderPricing"}
+
+func (ec *executionContext) _OrderPricing(ctx context.Context, sel ast.SelectionSet, obj *model.OrderPricing) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, orderPricingImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OrderPricing")
+		case "currency":
+			out.Values[i] = ec._OrderPricing_currency(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Search = data
-		case "status":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("status"))
-			data, err := ec.unmarshalOOrderStatus2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderStatus(ctx, v)
-			if err != nil {
-				return it, err
+		case "subtotal":
+			out.Values[i] = ec._OrderPricing_subtotal(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Status = data
-		case "dateFrom":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dateFrom"))
-			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
-			if err != nil {
-				return it, err
+		case "tax":
+			out.Values[i] = ec._OrderPricing_tax(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.DateFrom = data
-		case "dateTo":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("dateTo"))
-			data, err := ec.unmarshalOTime2ᚖtimeᚐTime(ctx, v)
-			if err != nil {
-				return it, err
+		case "discount":
+			out.Values[i] = ec._OrderPricing_discount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.DateTo = data
+		case "shippingFee":
+			out.Values[i] = ec._OrderPricing_shippingFee(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "insuranceFee":
+			out.Values[i] = ec._OrderPricing_insuranceFee(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "adjustment":
+			out.Values[i] = ec._OrderPricing_adjustment(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "total":
+			out.Values[i] = ec._OrderPricing_total(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "walletAmount":
+			out.Values[i] = ec._OrderPricing_walletAmount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var orderReasonImplementors = []string{"OrderReason"}
+
+func (ec *executionContext) _OrderReason(ctx context.Context, sel ast.SelectionSet, obj *model.OrderReason) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, orderReasonImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OrderReason")
+		case "id":
+			out.Values[i] = ec._OrderReason_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "code":
+			out.Values[i] = ec._OrderReason_code(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "kind":
+			out.Values[i] = ec._OrderReason_kind(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "audience":
+			out.Values[i] = ec._OrderReason_audience(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "label":
+			out.Values[i] = ec._OrderReason_label(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "position":
+			out.Values[i] = ec._OrderReason_position(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "isActive":
+			out.Values[i] = ec._OrderReason_isActive(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updatedAt":
+			out.Values[i] = ec._OrderReason_updatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var orderReasonStatImplementors = []string{"OrderReasonStat"}
+
+func (ec *executionContext) _OrderReasonStat(ctx context.Context, sel ast.SelectionSet, obj *model.OrderReasonStat) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, orderReasonStatImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OrderReasonStat")
+		case "reason":
+			out.Values[i] = ec._OrderReasonStat_reason(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "orders":
+			out.Values[i] = ec._OrderReasonStat_orders(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "amount":
+			out.Values[i] = ec._OrderReasonStat_amount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var orderShippingImplementors = []string{"OrderShipping"}
+
+func (ec *executionContext) _OrderShipping(ctx context.Context, sel ast.SelectionSet, obj *model.OrderShipping) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, orderShippingImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OrderShipping")
+		case "address":
+			out.Values[i] = ec._OrderShipping_address(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "method":
+			out.Values[i] = ec._OrderShipping_method(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
-
-	return it, nil
-}
-
-func (ec *executionContext) unmarshalInputOrderSortInput(ctx context.Context, obj any) (model.OrderSortInput, error) {
-	var it model.OrderSortInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
 
-	if _, present := asMap["direction"]; !present {
-		asMap["direction"] = "ASC"
-	}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-	fieldsInOrder := [...]string{"field", "direction"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "field":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("field"))
-			data, err := ec.unmarshalNOrderSortField2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderSortField(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Field = data
-		case "direction":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("direction"))
-			data, err := ec.unmarshalNSortDirection2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐSortDirection(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.Direction = data
-		}
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	return it, nil
+	return out
 }
 
-func (ec *executionContext) unmarshalInputPaginationInput(ctx context.Context, obj any) (model.PaginationInput, error) {
-	var it model.PaginationInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
-	}
+var orderTimestampsImplementors = []string{"OrderTimestamps"}
 
-	if _, present := asMap["page"]; !present {
-		asMap["page"] = 1
-	}
-	if _, present := asMap["limit"]; !present {
-		asMap["limit"] = 20
-	}
+func (ec *executionContext) _OrderTimestamps(ctx context.Context, sel ast.SelectionSet, obj *model.OrderTimestamps) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, orderTimestampsImplementors)
 
-	fieldsInOrder := [...]string{"page", "limit"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "page":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("page"))
-			data, err := ec.unmarshalNInt2int32(ctx, v)
-			if err != nil {
-				return it, err
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("OrderTimestamps")
+		case "createdAt":
+			out.Values[i] = ec._OrderTimestamps_createdAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Page = data
-		case "limit":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("limit"))
-			data, err := ec.unmarshalNInt2int32(ctx, v)
-			if err != nil {
-				return it, err
+		case "updatedAt":
+			out.Values[i] = ec._OrderTimestamps_updatedAt(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Limit = data
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-func (ec *executionContext) unmarshalInputUpdateOrderStatusInput(ctx context.Context, obj any) (model.UpdateOrderStatusInput, error) {
-	var it model.UpdateOrderStatusInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	fieldsInOrder := [...]string{"orderId", "status"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "orderId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("orderId"))
-			data, err := ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.OrderID = data
+	return out
+}
+
+var paymentImplementors = []string{"Payment"}
+
+func (ec *executionContext) _Payment(ctx context.Context, sel ast.SelectionSet, obj *model.Payment) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, paymentImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("Payment")
 		case "status":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("status"))
-			data, err := ec.unmarshalNOrderStatus2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderStatus(ctx, v)
-			if err != nil {
-				return it, err
+			out.Values[i] = ec._Payment_status(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.Status = data
+		case "url":
+			out.Values[i] = ec._Payment_url(ctx, field, obj)
+		case "provider":
+			out.Values[i] = ec._Payment_provider(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
-
-	return it, nil
-}
-
-func (ec *executionContext) unmarshalInputUpdateSessionAddressInput(ctx context.Context, obj any) (model.UpdateSessionAddressInput, error) {
-	var it model.UpdateSessionAddressInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
 	}
 
-	fieldsInOrder := [...]string{"externalId", "addressId", "guestId"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "externalId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("externalId"))
-			data, err := ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.ExternalID = data
-		case "addressId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("addressId"))
-			data, err := ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.AddressID = data
-		case "guestId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("guestId"))
-			data, err := ec.unmarshalOID2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
-			}
-			it.GuestID = data
-		}
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
 	}
 
-	return it, nil
+	return out
 }
 
-func (ec *executionContext) unmarshalInputUpdateSessionPaymentMethodInput(ctx context.Context, obj any) (model.UpdateSessionPaymentMethodInput, error) {
-	var it model.UpdateSessionPaymentMethodInput
-	asMap := map[string]any{}
-	for k, v := range obj.(map[string]any) {
-		asMap[k] = v
-	}
+var paymentDetailImplementors = []string{"PaymentDetail"}
 
-	fieldsInOrder := [...]string{"externalId", "paymentMethod", "guestId"}
-	for _, k := range fieldsInOrder {
-		v, ok := asMap[k]
-		if !ok {
-			continue
-		}
-		switch k {
-		case "externalId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("externalId"))
-			data, err := ec.unmarshalNID2string(ctx, v)
-			if err != nil {
-				return it, err
+func (ec *executionContext) _PaymentDetail(ctx context.Context, sel ast.SelectionSet, obj *model.PaymentDetail) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, paymentDetailImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PaymentDetail")
+		case "method":
+			out.Values[i] = ec._PaymentDetail_method(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.ExternalID = data
-		case "paymentMethod":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("paymentMethod"))
-			data, err := ec.unmarshalNString2string(ctx, v)
-			if err != nil {
-				return it, err
+		case "bank":
+			out.Values[i] = ec._PaymentDetail_bank(ctx, field, obj)
+		case "paymentCode":
+			out.Values[i] = ec._PaymentDetail_paymentCode(ctx, field, obj)
+		case "invoiceURL":
+			out.Values[i] = ec._PaymentDetail_invoiceURL(ctx, field, obj)
+		case "referenceId":
+			out.Values[i] = ec._PaymentDetail_referenceId(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.PaymentMethod = data
-		case "guestId":
-			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("guestId"))
-			data, err := ec.unmarshalOID2ᚖstring(ctx, v)
-			if err != nil {
-				return it, err
+		case "instructions":
+			out.Values[i] = ec._PaymentDetail_instructions(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
 			}
-			it.GuestID = data
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
 		}
 	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
 
-	return it, nil
-}
-
-// endregion **************************** input.gotpl *****************************
-
-// region    ************************** interface.gotpl ***************************
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
 
-// endregion ************************** interface.gotpl ***************************
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
 
-// region    **************************** object.gotpl ****************************
+	return out
+}
 
-var checkoutSessionImplementors = []string{"CheckoutSession"}
+var paymentOrderInfoResponseImplementors = []string{"PaymentOrderInfoResponse"}
 
-func (ec *executionContext) _CheckoutSession(ctx context.Context, sel ast.SelectionSet, obj *model.CheckoutSession) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, checkoutSessionImplementors)
+func (ec *executionContext) _PaymentOrderInfoResponse(ctx context.Context, sel ast.SelectionSet, obj *model.PaymentOrderInfoResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, paymentOrderInfoResponseImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("CheckoutSession")
-		case "id":
-			out.Values[i] = ec._CheckoutSession_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "externalId":
-			out.Values[i] = ec._CheckoutSession_externalId(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("PaymentOrderInfoResponse")
+		case "orderExternalID":
+			out.Values[i] = ec._PaymentOrderInfoResponse_orderExternalID(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
 		case "status":
-			out.Values[i] = ec._CheckoutSession_status(ctx, field, obj)
+			out.Values[i] = ec._PaymentOrderInfoResponse_status(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
 		case "expiresAt":
-			out.Values[i] = ec._CheckoutSession_expiresAt(ctx, field, obj)
+			out.Values[i] = ec._PaymentOrderInfoResponse_expiresAt(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "createdAt":
-			out.Values[i] = ec._CheckoutSession_createdAt(ctx, field, obj)
+		case "secondsRemaining":
+			out.Values[i] = ec._PaymentOrderInfoResponse_secondsRemaining(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "addressId":
-			out.Values[i] = ec._CheckoutSession_addressId(ctx, field, obj)
-		case "items":
-			out.Values[i] = ec._CheckoutSession_items(ctx, field, obj)
+		case "totalAmount":
+			out.Values[i] = ec._PaymentOrderInfoResponse_totalAmount(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "subtotal":
-			out.Values[i] = ec._CheckoutSession_subtotal(ctx, field, obj)
+		case "currency":
+			out.Values[i] = ec._PaymentOrderInfoResponse_currency(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "tax":
-			out.Values[i] = ec._CheckoutSession_tax(ctx, field, obj)
+		case "shippingAddress":
+			out.Values[i] = ec._PaymentOrderInfoResponse_shippingAddress(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "shippingFee":
-			out.Values[i] = ec._CheckoutSession_shippingFee(ctx, field, obj)
+		case "payment":
+			out.Values[i] = ec._PaymentOrderInfoResponse_payment(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "discount":
-			out.Values[i] = ec._CheckoutSession_discount(ctx, field, obj)
+		case "timeline":
+			out.Values[i] = ec._PaymentOrderInfoResponse_timeline(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "totalPrice":
-			out.Values[i] = ec._CheckoutSession_totalPrice(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var paymentTimelineEntryImplementors = []string{"PaymentTimelineEntry"}
+
+func (ec *executionContext) _PaymentTimelineEntry(ctx context.Context, sel ast.SelectionSet, obj *model.PaymentTimelineEntry) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, paymentTimelineEntryImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PaymentTimelineEntry")
+		case "event":
+			out.Values[i] = ec._PaymentTimelineEntry_event(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "paymentMethod":
-			out.Values[i] = ec._CheckoutSession_paymentMethod(ctx, field, obj)
+		case "occurredAt":
+			out.Values[i] = ec._PaymentTimelineEntry_occurredAt(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "amount":
+			out.Values[i] = ec._PaymentTimelineEntry_amount(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -3502,56 +9128,59 @@ func (ec *executionContext) _CheckoutSession(ctx context.Context, sel ast.Select
 	return out
 }
 
-var checkoutSessionItemImplementors = []string{"CheckoutSessionItem"}
+var pickupLocationImplementors = []string{"PickupLocation"}
 
-func (ec *executionContext) _CheckoutSessionItem(ctx context.Context, sel ast.SelectionSet, obj *model.CheckoutSessionItem) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, checkoutSessionItemImplementors)
+func (ec *executionContext) _PickupLocation(ctx context.Context, sel ast.SelectionSet, obj *model.PickupLocation) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, pickupLocationImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("CheckoutSessionItem")
+			out.Values[i] = graphql.MarshalString("PickupLocation")
 		case "id":
-			out.Values[i] = ec._CheckoutSessionItem_id(ctx, field, obj)
+			out.Values[i] = ec._PickupLocation_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "variantId":
-			out.Values[i] = ec._CheckoutSessionItem_variantId(ctx, field, obj)
+		case "name":
+			out.Values[i] = ec._PickupLocation_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "variantName":
-			out.Values[i] = ec._CheckoutSessionItem_variantName(ctx, field, obj)
+		case "address":
+			out.Values[i] = ec._PickupLocation_address(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "productName":
-			out.Values[i] = ec._CheckoutSessionItem_productName(ctx, field, obj)
+		case "city":
+			out.Values[i] = ec._PickupLocation_city(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "imageUrl":
-			out.Values[i] = ec._CheckoutSessionItem_imageUrl(ctx, field, obj)
-		case "quantity":
-			out.Values[i] = ec._CheckoutSessionItem_quantity(ctx, field, obj)
+		case "opensAt":
+			out.Values[i] = ec._PickupLocation_opensAt(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "quantityType":
-			out.Values[i] = ec._CheckoutSessionItem_quantityType(ctx, field, obj)
+		case "closesAt":
+			out.Values[i] = ec._PickupLocation_closesAt(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "price":
-			out.Values[i] = ec._CheckoutSessionItem_price(ctx, field, obj)
+		case "slotMinutes":
+			out.Values[i] = ec._PickupLocation_slotMinutes(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "subtotal":
-			out.Values[i] = ec._CheckoutSessionItem_subtotal(ctx, field, obj)
+		case "isActive":
+			out.Values[i] = ec._PickupLocation_isActive(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "updatedAt":
+			out.Values[i] = ec._PickupLocation_updatedAt(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -3578,29 +9207,24 @@ func (ec *executionContext) _CheckoutSessionItem(ctx context.Context, sel ast.Se
 	return out
 }
 
-var checkoutSessionResponseImplementors = []string{"CheckoutSessionResponse"}
+var pickupSlotImplementors = []string{"PickupSlot"}
 
-func (ec *executionContext) _CheckoutSessionResponse(ctx context.Context, sel ast.SelectionSet, obj *model.CheckoutSessionResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, checkoutSessionResponseImplementors)
+func (ec *executionContext) _PickupSlot(ctx context.Context, sel ast.SelectionSet, obj *model.PickupSlot) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, pickupSlotImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("CheckoutSessionResponse")
-		case "externalId":
-			out.Values[i] = ec._CheckoutSessionResponse_externalId(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "status":
-			out.Values[i] = ec._CheckoutSessionResponse_status(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("PickupSlot")
+		case "start":
+			out.Values[i] = ec._PickupSlot_start(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "expiresAt":
-			out.Values[i] = ec._CheckoutSessionResponse_expiresAt(ctx, field, obj)
+		case "end":
+			out.Values[i] = ec._PickupSlot_end(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -3627,26 +9251,44 @@ func (ec *executionContext) _CheckoutSessionResponse(ctx context.Context, sel as
 	return out
 }
 
-var confirmCheckoutSessionResponseImplementors = []string{"ConfirmCheckoutSessionResponse"}
+var policyImplementors = []string{"Policy"}
 
-func (ec *executionContext) _ConfirmCheckoutSessionResponse(ctx context.Context, sel ast.SelectionSet, obj *model.ConfirmCheckoutSessionResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, confirmCheckoutSessionResponseImplementors)
+func (ec *executionContext) _Policy(ctx context.Context, sel ast.SelectionSet, obj *model.Policy) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, policyImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ConfirmCheckoutSessionResponse")
-		case "success":
-			out.Values[i] = ec._ConfirmCheckoutSessionResponse_success(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("Policy")
+		case "id":
+			out.Values[i] = ec._Policy_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "message":
-			out.Values[i] = ec._ConfirmCheckoutSessionResponse_message(ctx, field, obj)
-		case "order_external_id":
-			out.Values[i] = ec._ConfirmCheckoutSessionResponse_order_external_id(ctx, field, obj)
+		case "kind":
+			out.Values[i] = ec._Policy_kind(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "version":
+			out.Values[i] = ec._Policy_version(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "title":
+			out.Values[i] = ec._Policy_title(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "body":
+			out.Values[i] = ec._Policy_body(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "publishedAt":
+			out.Values[i] = ec._Policy_publishedAt(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -3673,30 +9315,54 @@ func (ec *executionContext) _ConfirmCheckoutSessionResponse(ctx context.Context,
 	return out
 }
 
-var createOrderResponseImplementors = []string{"CreateOrderResponse"}
+var shippingAddressImplementors = []string{"ShippingAddress"}
 
-func (ec *executionContext) _CreateOrderResponse(ctx context.Context, sel ast.SelectionSet, obj *model.CreateOrderResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, createOrderResponseImplementors)
+func (ec *executionContext) _ShippingAddress(ctx context.Context, sel ast.SelectionSet, obj *model.ShippingAddress) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, shippingAddressImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("CreateOrderResponse")
-		case "success":
-			out.Values[i] = ec._CreateOrderResponse_success(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("ShippingAddress")
+		case "name":
+			out.Values[i] = ec._ShippingAddress_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "receiverName":
+			out.Values[i] = ec._ShippingAddress_receiverName(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "phone":
+			out.Values[i] = ec._ShippingAddress_phone(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "address1":
+			out.Values[i] = ec._ShippingAddress_address1(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "address2":
+			out.Values[i] = ec._ShippingAddress_address2(ctx, field, obj)
+		case "city":
+			out.Values[i] = ec._ShippingAddress_city(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "province":
+			out.Values[i] = ec._ShippingAddress_province(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "postalCode":
+			out.Values[i] = ec._ShippingAddress_postalCode(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "message":
-			out.Values[i] = ec._CreateOrderResponse_message(ctx, field, obj)
-		case "order":
-			out.Values[i] = ec._CreateOrderResponse_order(ctx, field, obj)
-		case "paymentURL":
-			out.Values[i] = ec._CreateOrderResponse_paymentURL(ctx, field, obj)
-		case "payment":
-			out.Values[i] = ec._CreateOrderResponse_payment(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -3720,59 +9386,38 @@ func (ec *executionContext) _CreateOrderResponse(ctx context.Context, sel ast.Se
 	return out
 }
 
-var orderImplementors = []string{"Order"}
+var shippingOptionImplementors = []string{"ShippingOption"}
 
-func (ec *executionContext) _Order(ctx context.Context, sel ast.SelectionSet, obj *model.Order) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, orderImplementors)
+func (ec *executionContext) _ShippingOption(ctx context.Context, sel ast.SelectionSet, obj *model.ShippingOption) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, shippingOptionImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Order")
-		case "id":
-			out.Values[i] = ec._Order_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "externalId":
-			out.Values[i] = ec._Order_externalId(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "invoiceNumber":
-			out.Values[i] = ec._Order_invoiceNumber(ctx, field, obj)
-		case "user":
-			out.Values[i] = ec._Order_user(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "pricing":
-			out.Values[i] = ec._Order_pricing(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "status":
-			out.Values[i] = ec._Order_status(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "shipping":
-			out.Values[i] = ec._Order_shipping(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("ShippingOption")
+		case "method":
+			out.Values[i] = ec._ShippingOption_method(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "items":
-			out.Values[i] = ec._Order_items(ctx, field, obj)
+		case "fee":
+			out.Values[i] = ec._ShippingOption_fee(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "timestamps":
-			out.Values[i] = ec._Order_timestamps(ctx, field, obj)
+		case "available":
+			out.Values[i] = ec._ShippingOption_available(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "distanceKm":
+			out.Values[i] = ec._ShippingOption_distanceKm(ctx, field, obj)
+		case "unavailableReason":
+			out.Values[i] = ec._ShippingOption_unavailableReason(ctx, field, obj)
+		case "insuranceFee":
+			out.Values[i] = ec._ShippingOption_insuranceFee(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -3796,39 +9441,19 @@ func (ec *executionContext) _Order(ctx context.Context, sel ast.SelectionSet, ob
 	return out
 }
 
-var orderItemImplementors = []string{"OrderItem"}
+var updateSessionAddressResponseImplementors = []string{"UpdateSessionAddressResponse"}
 
-func (ec *executionContext) _OrderItem(ctx context.Context, sel ast.SelectionSet, obj *model.OrderItem) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, orderItemImplementors)
+func (ec *executionContext) _UpdateSessionAddressResponse(ctx context.Context, sel ast.SelectionSet, obj *model.UpdateSessionAddressResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, updateSessionAddressResponseImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("OrderItem")
-		case "id":
-			out.Values[i] = ec._OrderItem_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "variant":
-			out.Values[i] = ec._OrderItem_variant(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "quantity":
-			out.Values[i] = ec._OrderItem_quantity(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "quantityType":
-			out.Values[i] = ec._OrderItem_quantityType(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "pricing":
-			out.Values[i] = ec._OrderItem_pricing(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("UpdateSessionAddressResponse")
+		case "success":
+			out.Values[i] = ec._UpdateSessionAddressResponse_success(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -3855,24 +9480,19 @@ func (ec *executionContext) _OrderItem(ctx context.Context, sel ast.SelectionSet
 	return out
 }
 
-var orderItemPricingImplementors = []string{"OrderItemPricing"}
+var updateSessionPaymentMethodResponseImplementors = []string{"UpdateSessionPaymentMethodResponse"}
 
-func (ec *executionContext) _OrderItemPricing(ctx context.Context, sel ast.SelectionSet, obj *model.OrderItemPricing) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, orderItemPricingImplementors)
+func (ec *executionContext) _UpdateSessionPaymentMethodResponse(ctx context.Context, sel ast.SelectionSet, obj *model.UpdateSessionPaymentMethodResponse) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, updateSessionPaymentMethodResponseImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("OrderItemPricing")
-		case "price":
-			out.Values[i] = ec._OrderItemPricing_price(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "subtotal":
-			out.Values[i] = ec._OrderItemPricing_subtotal(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("UpdateSessionPaymentMethodResponse")
+		case "success":
+			out.Values[i] = ec._UpdateSessionPaymentMethodResponse_success(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -3899,24 +9519,19 @@ func (ec *executionContext) _OrderItemPricing(ctx context.Context, sel ast.Selec
 	return out
 }
 
-var orderListResponseImplementors = []string{"OrderListResponse"}
+var userRefImplementors = []string{"UserRef"}
 
-func (ec *executionContext) _OrderListResponse(ctx context.Context, sel ast.SelectionSet, obj *model.OrderListResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, orderListResponseImplementors)
+func (ec *executionContext) _UserRef(ctx context.Context, sel ast.SelectionSet, obj *model.UserRef) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, userRefImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("OrderListResponse")
-		case "items":
-			out.Values[i] = ec._OrderListResponse_items(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "pageInfo":
-			out.Values[i] = ec._OrderListResponse_pageInfo(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("UserRef")
+		case "id":
+			out.Values[i] = ec._UserRef_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -3943,628 +9558,815 @@ func (ec *executionContext) _OrderListResponse(ctx context.Context, sel ast.Sele
 	return out
 }
 
-var orderPricingImplementors = []string{"OrderPricing"}
+var variantRefImplementors = []string{"VariantRef"}
 
-func (ec *executionContext) _OrderPricing(ctx context.Context, sel ast.SelectionSet, obj *model.OrderPricing) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, orderPricingImplementors)
+func (ec *executionContext) _VariantRef(ctx context.Context, sel ast.SelectionSet, obj *model.VariantRef) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, variantRefImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("OrderPricing")
-		case "currency":
-			out.Values[i] = ec._OrderPricing_currency(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "subtotal":
-			out.Values[i] = ec._OrderPricing_subtotal(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("VariantRef")
+		case "id":
+			out.Values[i] = ec._VariantRef_id(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "tax":
-			out.Values[i] = ec._OrderPricing_tax(ctx, field, obj)
+		case "name":
+			out.Values[i] = ec._VariantRef_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "discount":
-			out.Values[i] = ec._OrderPricing_discount(ctx, field, obj)
+		case "productName":
+			out.Values[i] = ec._VariantRef_productName(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "shippingFee":
-			out.Values[i] = ec._OrderPricing_shippingFee(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+		case "imageUrl":
+			out.Values[i] = ec._VariantRef_imageUrl(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+// endregion **************************** object.gotpl ****************************
+
+// region    ***************************** type.gotpl *****************************
+
+func (ec *executionContext) unmarshalNAdminOrderPayment2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐAdminOrderPayment(ctx context.Context, v any) (model.AdminOrderPayment, error) {
+	var res model.AdminOrderPayment
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNAdminOrderPayment2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐAdminOrderPayment(ctx context.Context, sel ast.SelectionSet, v model.AdminOrderPayment) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) unmarshalNApplyCouponInput2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐApplyCouponInput(ctx context.Context, v any) (model.ApplyCouponInput, error) {
+	res, err := ec.unmarshalInputApplyCouponInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNApplyCouponResponse2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐApplyCouponResponse(ctx context.Context, sel ast.SelectionSet, v model.ApplyCouponResponse) graphql.Marshaler {
+	return ec._ApplyCouponResponse(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNApplyCouponResponse2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐApplyCouponResponse(ctx context.Context, sel ast.SelectionSet, v *model.ApplyCouponResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ApplyCouponResponse(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNApplySessionPointsInput2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐApplySessionPointsInput(ctx context.Context, v any) (model.ApplySessionPointsInput, error) {
+	res, err := ec.unmarshalInputApplySessionPointsInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNApplySessionPointsResponse2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐApplySessionPointsResponse(ctx context.Context, sel ast.SelectionSet, v model.ApplySessionPointsResponse) graphql.Marshaler {
+	return ec._ApplySessionPointsResponse(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNApplySessionPointsResponse2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐApplySessionPointsResponse(ctx context.Context, sel ast.SelectionSet, v *model.ApplySessionPointsResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ApplySessionPointsResponse(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNApplySessionWalletInput2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐApplySessionWalletInput(ctx context.Context, v any) (model.ApplySessionWalletInput, error) {
+	res, err := ec.unmarshalInputApplySessionWalletInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNApplySessionWalletResponse2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐApplySessionWalletResponse(ctx context.Context, sel ast.SelectionSet, v model.ApplySessionWalletResponse) graphql.Marshaler {
+	return ec._ApplySessionWalletResponse(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNApplySessionWalletResponse2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐApplySessionWalletResponse(ctx context.Context, sel ast.SelectionSet, v *model.ApplySessionWalletResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ApplySessionWalletResponse(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNCheckoutRule2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutRule(ctx context.Context, sel ast.SelectionSet, v model.CheckoutRule) graphql.Marshaler {
+	return ec._CheckoutRule(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCheckoutRule2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutRuleᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.CheckoutRule) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "total":
-			out.Values[i] = ec._OrderPricing_total(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+			ret[i] = ec.marshalNCheckoutRule2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutRule(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNCheckoutRule2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutRule(ctx context.Context, sel ast.SelectionSet, v *model.CheckoutRule) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._CheckoutRule(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNCheckoutSession2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutSession(ctx context.Context, sel ast.SelectionSet, v model.CheckoutSession) graphql.Marshaler {
+	return ec._CheckoutSession(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNCheckoutSession2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutSession(ctx context.Context, sel ast.SelectionSet, v *model.CheckoutSession) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._CheckoutSession(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNCheckoutSessionEvent2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutSessionEventᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.CheckoutSessionEvent) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNCheckoutSessionEvent2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutSessionEvent(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	}
+	wg.Wait()
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	return out
+	return ret
 }
 
-var orderShippingImplementors = []string{"OrderShipping"}
-
-func (ec *executionContext) _OrderShipping(ctx context.Context, sel ast.SelectionSet, obj *model.OrderShipping) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, orderShippingImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("OrderShipping")
-		case "address":
-			out.Values[i] = ec._OrderShipping_address(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNCheckoutSessionEvent2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutSessionEvent(ctx context.Context, sel ast.SelectionSet, v *model.CheckoutSessionEvent) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
-
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
-
-	return out
+	return ec._CheckoutSessionEvent(ctx, sel, v)
 }
 
-var orderTimestampsImplementors = []string{"OrderTimestamps"}
-
-func (ec *executionContext) _OrderTimestamps(ctx context.Context, sel ast.SelectionSet, obj *model.OrderTimestamps) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, orderTimestampsImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("OrderTimestamps")
-		case "createdAt":
-			out.Values[i] = ec._OrderTimestamps_createdAt(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "updatedAt":
-			out.Values[i] = ec._OrderTimestamps_updatedAt(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+func (ec *executionContext) marshalNCheckoutSessionItem2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutSessionItemᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.CheckoutSessionItem) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNCheckoutSessionItem2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutSessionItem(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	}
+	wg.Wait()
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	return out
+	return ret
 }
 
-var pageInfoOrderImplementors = []string{"PageInfoOrder"}
-
-func (ec *executionContext) _PageInfoOrder(ctx context.Context, sel ast.SelectionSet, obj *model.PageInfoOrder) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, pageInfoOrderImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("PageInfoOrder")
-		case "totalItems":
-			out.Values[i] = ec._PageInfoOrder_totalItems(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "totalPages":
-			out.Values[i] = ec._PageInfoOrder_totalPages(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "page":
-			out.Values[i] = ec._PageInfoOrder_page(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "limit":
-			out.Values[i] = ec._PageInfoOrder_limit(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "hasNextPage":
-			out.Values[i] = ec._PageInfoOrder_hasNextPage(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "hasPreviousPage":
-			out.Values[i] = ec._PageInfoOrder_hasPreviousPage(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNCheckoutSessionItem2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutSessionItem(ctx context.Context, sel ast.SelectionSet, v *model.CheckoutSessionItem) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
+	return ec._CheckoutSessionItem(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) unmarshalNCheckoutSessionItemInput2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutSessionItemInputᚄ(ctx context.Context, v any) ([]*model.CheckoutSessionItemInput, error) {
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]*model.CheckoutSessionItemInput, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNCheckoutSessionItemInput2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutSessionItemInput(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
 	}
-
-	return out
+	return res, nil
 }
 
-var paymentImplementors = []string{"Payment"}
+func (ec *executionContext) unmarshalNCheckoutSessionItemInput2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutSessionItemInput(ctx context.Context, v any) (*model.CheckoutSessionItemInput, error) {
+	res, err := ec.unmarshalInputCheckoutSessionItemInput(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) _Payment(ctx context.Context, sel ast.SelectionSet, obj *model.Payment) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, paymentImplementors)
+func (ec *executionContext) marshalNCheckoutSessionResponse2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutSessionResponse(ctx context.Context, sel ast.SelectionSet, v model.CheckoutSessionResponse) graphql.Marshaler {
+	return ec._CheckoutSessionResponse(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("Payment")
-		case "status":
-			out.Values[i] = ec._Payment_status(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "url":
-			out.Values[i] = ec._Payment_url(ctx, field, obj)
-		case "provider":
-			out.Values[i] = ec._Payment_provider(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNCheckoutSessionResponse2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutSessionResponse(ctx context.Context, sel ast.SelectionSet, v *model.CheckoutSessionResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
+	return ec._CheckoutSessionResponse(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
+func (ec *executionContext) unmarshalNCheckoutSessionStatus2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutSessionStatus(ctx context.Context, v any) (model.CheckoutSessionStatus, error) {
+	var res model.CheckoutSessionStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	return out
+func (ec *executionContext) marshalNCheckoutSessionStatus2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutSessionStatus(ctx context.Context, sel ast.SelectionSet, v model.CheckoutSessionStatus) graphql.Marshaler {
+	return v
 }
 
-var paymentDetailImplementors = []string{"PaymentDetail"}
+func (ec *executionContext) unmarshalNConfirmCheckoutSessionInput2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐConfirmCheckoutSessionInput(ctx context.Context, v any) (model.ConfirmCheckoutSessionInput, error) {
+	res, err := ec.unmarshalInputConfirmCheckoutSessionInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) _PaymentDetail(ctx context.Context, sel ast.SelectionSet, obj *model.PaymentDetail) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, paymentDetailImplementors)
+func (ec *executionContext) marshalNConfirmCheckoutSessionResponse2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐConfirmCheckoutSessionResponse(ctx context.Context, sel ast.SelectionSet, v model.ConfirmCheckoutSessionResponse) graphql.Marshaler {
+	return ec._ConfirmCheckoutSessionResponse(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("PaymentDetail")
-		case "method":
-			out.Values[i] = ec._PaymentDetail_method(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "bank":
-			out.Values[i] = ec._PaymentDetail_bank(ctx, field, obj)
-		case "paymentCode":
-			out.Values[i] = ec._PaymentDetail_paymentCode(ctx, field, obj)
-		case "invoiceURL":
-			out.Values[i] = ec._PaymentDetail_invoiceURL(ctx, field, obj)
-		case "referenceId":
-			out.Values[i] = ec._PaymentDetail_referenceId(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "instructions":
-			out.Values[i] = ec._PaymentDetail_instructions(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNConfirmCheckoutSessionResponse2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐConfirmCheckoutSessionResponse(ctx context.Context, sel ast.SelectionSet, v *model.ConfirmCheckoutSessionResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
+	return ec._ConfirmCheckoutSessionResponse(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) unmarshalNCreateAdminOrderInput2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐCreateAdminOrderInput(ctx context.Context, v any) (model.CreateAdminOrderInput, error) {
+	res, err := ec.unmarshalInputCreateAdminOrderInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
+func (ec *executionContext) unmarshalNCreateCheckoutSessionInput2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐCreateCheckoutSessionInput(ctx context.Context, v any) (model.CreateCheckoutSessionInput, error) {
+	res, err := ec.unmarshalInputCreateCheckoutSessionInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	return out
+func (ec *executionContext) unmarshalNCreateOrderFromSessionInput2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐCreateOrderFromSessionInput(ctx context.Context, v any) (model.CreateOrderFromSessionInput, error) {
+	res, err := ec.unmarshalInputCreateOrderFromSessionInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-var paymentOrderInfoResponseImplementors = []string{"PaymentOrderInfoResponse"}
+func (ec *executionContext) marshalNCreateOrderResponse2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐCreateOrderResponse(ctx context.Context, sel ast.SelectionSet, v model.CreateOrderResponse) graphql.Marshaler {
+	return ec._CreateOrderResponse(ctx, sel, &v)
+}
 
-func (ec *executionContext) _PaymentOrderInfoResponse(ctx context.Context, sel ast.SelectionSet, obj *model.PaymentOrderInfoResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, paymentOrderInfoResponseImplementors)
+func (ec *executionContext) marshalNCreateOrderResponse2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCreateOrderResponse(ctx context.Context, sel ast.SelectionSet, v *model.CreateOrderResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._CreateOrderResponse(ctx, sel, v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("PaymentOrderInfoResponse")
-		case "orderExternalID":
-			out.Values[i] = ec._PaymentOrderInfoResponse_orderExternalID(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "status":
-			out.Values[i] = ec._PaymentOrderInfoResponse_status(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "expiresAt":
-			out.Values[i] = ec._PaymentOrderInfoResponse_expiresAt(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "totalAmount":
-			out.Values[i] = ec._PaymentOrderInfoResponse_totalAmount(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "currency":
-			out.Values[i] = ec._PaymentOrderInfoResponse_currency(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "shippingAddress":
-			out.Values[i] = ec._PaymentOrderInfoResponse_shippingAddress(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "payment":
-			out.Values[i] = ec._PaymentOrderInfoResponse_payment(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+func (ec *executionContext) marshalNDeliverySlot2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐDeliverySlot(ctx context.Context, sel ast.SelectionSet, v model.DeliverySlot) graphql.Marshaler {
+	return ec._DeliverySlot(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNDeliverySlot2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐDeliverySlotᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.DeliverySlot) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNDeliverySlot2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐDeliverySlot(ctx, sel, v[i])
 		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	return ret
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNDeliverySlot2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐDeliverySlot(ctx context.Context, sel ast.SelectionSet, v *model.DeliverySlot) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
-
-	return out
+	return ec._DeliverySlot(ctx, sel, v)
 }
 
-var shippingAddressImplementors = []string{"ShippingAddress"}
+func (ec *executionContext) marshalNDeliverySlotOption2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐDeliverySlotOptionᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.DeliverySlotOption) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNDeliverySlotOption2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐDeliverySlotOption(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-func (ec *executionContext) _ShippingAddress(ctx context.Context, sel ast.SelectionSet, obj *model.ShippingAddress) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, shippingAddressImplementors)
+	}
+	wg.Wait()
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("ShippingAddress")
-		case "name":
-			out.Values[i] = ec._ShippingAddress_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "receiverName":
-			out.Values[i] = ec._ShippingAddress_receiverName(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "phone":
-			out.Values[i] = ec._ShippingAddress_phone(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "address1":
-			out.Values[i] = ec._ShippingAddress_address1(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "address2":
-			out.Values[i] = ec._ShippingAddress_address2(ctx, field, obj)
-		case "city":
-			out.Values[i] = ec._ShippingAddress_city(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "province":
-			out.Values[i] = ec._ShippingAddress_province(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "postalCode":
-			out.Values[i] = ec._ShippingAddress_postalCode(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
+
+	return ret
+}
+
+func (ec *executionContext) marshalNDeliverySlotOption2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐDeliverySlotOption(ctx context.Context, sel ast.SelectionSet, v *model.DeliverySlotOption) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
 		return graphql.Null
 	}
+	return ec._DeliverySlotOption(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) marshalNOrder2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrder(ctx context.Context, sel ast.SelectionSet, v model.Order) graphql.Marshaler {
+	return ec._Order(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNOrder2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Order) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNOrder2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrder(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
 	}
+	wg.Wait()
 
-	return out
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
 }
 
-var updateSessionAddressResponseImplementors = []string{"UpdateSessionAddressResponse"}
+func (ec *executionContext) marshalNOrder2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrder(ctx context.Context, sel ast.SelectionSet, v *model.Order) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._Order(ctx, sel, v)
+}
 
-func (ec *executionContext) _UpdateSessionAddressResponse(ctx context.Context, sel ast.SelectionSet, obj *model.UpdateSessionAddressResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, updateSessionAddressResponseImplementors)
+func (ec *executionContext) marshalNOrderAdjustment2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderAdjustment(ctx context.Context, sel ast.SelectionSet, v model.OrderAdjustment) graphql.Marshaler {
+	return ec._OrderAdjustment(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("UpdateSessionAddressResponse")
-		case "success":
-			out.Values[i] = ec._UpdateSessionAddressResponse_success(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+func (ec *executionContext) marshalNOrderAdjustment2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderAdjustmentᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.OrderAdjustment) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNOrderAdjustment2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderAdjustment(ctx, sel, v[i])
 		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	return ret
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNOrderAdjustment2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderAdjustment(ctx context.Context, sel ast.SelectionSet, v *model.OrderAdjustment) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
+	return ec._OrderAdjustment(ctx, sel, v)
+}
 
-	return out
+func (ec *executionContext) unmarshalNOrderAdjustmentKind2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderAdjustmentKind(ctx context.Context, v any) (model.OrderAdjustmentKind, error) {
+	var res model.OrderAdjustmentKind
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-var updateSessionPaymentMethodResponseImplementors = []string{"UpdateSessionPaymentMethodResponse"}
+func (ec *executionContext) marshalNOrderAdjustmentKind2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderAdjustmentKind(ctx context.Context, sel ast.SelectionSet, v model.OrderAdjustmentKind) graphql.Marshaler {
+	return v
+}
 
-func (ec *executionContext) _UpdateSessionPaymentMethodResponse(ctx context.Context, sel ast.SelectionSet, obj *model.UpdateSessionPaymentMethodResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, updateSessionPaymentMethodResponseImplementors)
+func (ec *executionContext) unmarshalNOrderAdjustmentStatus2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderAdjustmentStatus(ctx context.Context, v any) (model.OrderAdjustmentStatus, error) {
+	var res model.OrderAdjustmentStatus
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("UpdateSessionPaymentMethodResponse")
-		case "success":
-			out.Values[i] = ec._UpdateSessionPaymentMethodResponse_success(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNOrderAdjustmentStatus2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderAdjustmentStatus(ctx context.Context, sel ast.SelectionSet, v model.OrderAdjustmentStatus) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNOrderConnection2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderConnection(ctx context.Context, sel ast.SelectionSet, v model.OrderConnection) graphql.Marshaler {
+	return ec._OrderConnection(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNOrderConnection2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderConnection(ctx context.Context, sel ast.SelectionSet, v *model.OrderConnection) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
+	return ec._OrderConnection(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+func (ec *executionContext) marshalNOrderItem2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderItemᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.OrderItem) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNOrderItem2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderItem(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
 	}
+	wg.Wait()
 
-	return out
-}
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
 
-var userRefImplementors = []string{"UserRef"}
+	return ret
+}
 
-func (ec *executionContext) _UserRef(ctx context.Context, sel ast.SelectionSet, obj *model.UserRef) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, userRefImplementors)
+func (ec *executionContext) marshalNOrderItem2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderItem(ctx context.Context, sel ast.SelectionSet, v *model.OrderItem) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._OrderItem(ctx, sel, v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("UserRef")
-		case "id":
-			out.Values[i] = ec._UserRef_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+func (ec *executionContext) marshalNOrderItemPricing2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderItemPricing(ctx context.Context, sel ast.SelectionSet, v *model.OrderItemPricing) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
 		return graphql.Null
 	}
+	return ec._OrderItemPricing(ctx, sel, v)
+}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNOrderPricing2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderPricing(ctx context.Context, sel ast.SelectionSet, v *model.OrderPricing) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
-
-	return out
+	return ec._OrderPricing(ctx, sel, v)
 }
 
-var variantRefImplementors = []string{"VariantRef"}
-
-func (ec *executionContext) _VariantRef(ctx context.Context, sel ast.SelectionSet, obj *model.VariantRef) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, variantRefImplementors)
+func (ec *executionContext) marshalNOrderReason2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderReason(ctx context.Context, sel ast.SelectionSet, v model.OrderReason) graphql.Marshaler {
+	return ec._OrderReason(ctx, sel, &v)
+}
 
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("VariantRef")
-		case "id":
-			out.Values[i] = ec._VariantRef_id(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "name":
-			out.Values[i] = ec._VariantRef_name(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "productName":
-			out.Values[i] = ec._VariantRef_productName(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
+func (ec *executionContext) marshalNOrderReason2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderReasonᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.OrderReason) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
 			}
-		case "imageUrl":
-			out.Values[i] = ec._VariantRef_imageUrl(ctx, field, obj)
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
+			ret[i] = ec.marshalNOrderReason2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderReason(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
 		}
+
 	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
 	}
 
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+	return ret
+}
 
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
+func (ec *executionContext) marshalNOrderReason2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderReason(ctx context.Context, sel ast.SelectionSet, v *model.OrderReason) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
 	}
+	return ec._OrderReason(ctx, sel, v)
+}
 
-	return out
+func (ec *executionContext) unmarshalNOrderReasonAudience2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderReasonAudience(ctx context.Context, v any) (model.OrderReasonAudience, error) {
+	var res model.OrderReasonAudience
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-// endregion **************************** object.gotpl ****************************
+func (ec *executionContext) marshalNOrderReasonAudience2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderReasonAudience(ctx context.Context, sel ast.SelectionSet, v model.OrderReasonAudience) graphql.Marshaler {
+	return v
+}
 
-// region    ***************************** type.gotpl *****************************
+func (ec *executionContext) unmarshalNOrderReasonKind2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderReasonKind(ctx context.Context, v any) (model.OrderReasonKind, error) {
+	var res model.OrderReasonKind
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
 
-func (ec *executionContext) marshalNCheckoutSessionItem2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutSessionItemᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.CheckoutSessionItem) graphql.Marshaler {
+func (ec *executionContext) marshalNOrderReasonKind2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderReasonKind(ctx context.Context, sel ast.SelectionSet, v model.OrderReasonKind) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNOrderReasonStat2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderReasonStatᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.OrderReasonStat) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -4588,7 +10390,7 @@ func (ec *executionContext) marshalNCheckoutSessionItem2ᚕᚖwarimasᚑbeᚋint
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNCheckoutSessionItem2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutSessionItem(ctx, sel, v[i])
+			ret[i] = ec.marshalNOrderReasonStat2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderReasonStat(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -4608,108 +10410,159 @@ func (ec *executionContext) marshalNCheckoutSessionItem2ᚕᚖwarimasᚑbeᚋint
 	return ret
 }
 
-func (ec *executionContext) marshalNCheckoutSessionItem2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutSessionItem(ctx context.Context, sel ast.SelectionSet, v *model.CheckoutSessionItem) graphql.Marshaler {
+func (ec *executionContext) marshalNOrderReasonStat2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderReasonStat(ctx context.Context, sel ast.SelectionSet, v *model.OrderReasonStat) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._CheckoutSessionItem(ctx, sel, v)
-}
-
-func (ec *executionContext) unmarshalNCheckoutSessionItemInput2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutSessionItemInputᚄ(ctx context.Context, v any) ([]*model.CheckoutSessionItemInput, error) {
-	var vSlice []any
-	vSlice = graphql.CoerceList(v)
-	var err error
-	res := make([]*model.CheckoutSessionItemInput, len(vSlice))
-	for i := range vSlice {
-		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
-		res[i], err = ec.unmarshalNCheckoutSessionItemInput2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutSessionItemInput(ctx, vSlice[i])
-		if err != nil {
-			return nil, err
-		}
-	}
-	return res, nil
-}
-
-func (ec *executionContext) unmarshalNCheckoutSessionItemInput2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutSessionItemInput(ctx context.Context, v any) (*model.CheckoutSessionItemInput, error) {
-	res, err := ec.unmarshalInputCheckoutSessionItemInput(ctx, v)
-	return &res, graphql.ErrorOnPath(ctx, err)
-}
-
-func (ec *executionContext) marshalNCheckoutSessionResponse2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutSessionResponse(ctx context.Context, sel ast.SelectionSet, v model.CheckoutSessionResponse) graphql.Marshaler {
-	return ec._CheckoutSessionResponse(ctx, sel, &v)
+	return ec._OrderReasonStat(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNCheckoutSessionResponse2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutSessionResponse(ctx context.Context, sel ast.SelectionSet, v *model.CheckoutSessionResponse) graphql.Marshaler {
+func (ec *executionContext) marshalNOrderShipping2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderShipping(ctx context.Context, sel ast.SelectionSet, v *model.OrderShipping) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._CheckoutSessionResponse(ctx, sel, v)
+	return ec._OrderShipping(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNCheckoutSessionStatus2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutSessionStatus(ctx context.Context, v any) (model.CheckoutSessionStatus, error) {
-	var res model.CheckoutSessionStatus
+func (ec *executionContext) unmarshalNOrderSortField2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderSortField(ctx context.Context, v any) (model.OrderSortField, error) {
+	var res model.OrderSortField
 	err := res.UnmarshalGQL(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNCheckoutSessionStatus2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutSessionStatus(ctx context.Context, sel ast.SelectionSet, v model.CheckoutSessionStatus) graphql.Marshaler {
+func (ec *executionContext) marshalNOrderSortField2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderSortField(ctx context.Context, sel ast.SelectionSet, v model.OrderSortField) graphql.Marshaler {
 	return v
 }
 
-func (ec *executionContext) unmarshalNConfirmCheckoutSessionInput2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐConfirmCheckoutSessionInput(ctx context.Context, v any) (model.ConfirmCheckoutSessionInput, error) {
-	res, err := ec.unmarshalInputConfirmCheckoutSessionInput(ctx, v)
+func (ec *executionContext) unmarshalNOrderStatus2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderStatus(ctx context.Context, v any) (model.OrderStatus, error) {
+	var res model.OrderStatus
+	err := res.UnmarshalGQL(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNConfirmCheckoutSessionResponse2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐConfirmCheckoutSessionResponse(ctx context.Context, sel ast.SelectionSet, v model.ConfirmCheckoutSessionResponse) graphql.Marshaler {
-	return ec._ConfirmCheckoutSessionResponse(ctx, sel, &v)
+func (ec *executionContext) marshalNOrderStatus2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderStatus(ctx context.Context, sel ast.SelectionSet, v model.OrderStatus) graphql.Marshaler {
+	return v
 }
 
-func (ec *executionContext) marshalNConfirmCheckoutSessionResponse2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐConfirmCheckoutSessionResponse(ctx context.Context, sel ast.SelectionSet, v *model.ConfirmCheckoutSessionResponse) graphql.Marshaler {
+func (ec *executionContext) marshalNOrderTimestamps2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderTimestamps(ctx context.Context, sel ast.SelectionSet, v *model.OrderTimestamps) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._ConfirmCheckoutSessionResponse(ctx, sel, v)
+	return ec._OrderTimestamps(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNCreateCheckoutSessionInput2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐCreateCheckoutSessionInput(ctx context.Context, v any) (model.CreateCheckoutSessionInput, error) {
-	res, err := ec.unmarshalInputCreateCheckoutSessionInput(ctx, v)
-	return res, graphql.ErrorOnPath(ctx, err)
+func (ec *executionContext) marshalNPaymentDetail2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐPaymentDetail(ctx context.Context, sel ast.SelectionSet, v *model.PaymentDetail) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._PaymentDetail(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNCreateOrderFromSessionInput2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐCreateOrderFromSessionInput(ctx context.Context, v any) (model.CreateOrderFromSessionInput, error) {
-	res, err := ec.unmarshalInputCreateOrderFromSessionInput(ctx, v)
+func (ec *executionContext) marshalNPaymentOrderInfoResponse2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐPaymentOrderInfoResponse(ctx context.Context, sel ast.SelectionSet, v model.PaymentOrderInfoResponse) graphql.Marshaler {
+	return ec._PaymentOrderInfoResponse(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNPaymentOrderInfoResponse2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐPaymentOrderInfoResponse(ctx context.Context, sel ast.SelectionSet, v *model.PaymentOrderInfoResponse) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._PaymentOrderInfoResponse(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalNPaymentStatus2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐPaymentStatus(ctx context.Context, v any) (model.PaymentStatus, error) {
+	var res model.PaymentStatus
+	err := res.UnmarshalGQL(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNCreateOrderResponse2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐCreateOrderResponse(ctx context.Context, sel ast.SelectionSet, v model.CreateOrderResponse) graphql.Marshaler {
-	return ec._CreateOrderResponse(ctx, sel, &v)
+func (ec *executionContext) marshalNPaymentStatus2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐPaymentStatus(ctx context.Context, sel ast.SelectionSet, v model.PaymentStatus) graphql.Marshaler {
+	return v
 }
 
-func (ec *executionContext) marshalNCreateOrderResponse2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCreateOrderResponse(ctx context.Context, sel ast.SelectionSet, v *model.CreateOrderResponse) graphql.Marshaler {
+func (ec *executionContext) marshalNPaymentTimelineEntry2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐPaymentTimelineEntryᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.PaymentTimelineEntry) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPaymentTimelineEntry2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐPaymentTimelineEntry(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNPaymentTimelineEntry2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐPaymentTimelineEntry(ctx context.Context, sel ast.SelectionSet, v *model.PaymentTimelineEntry) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._CreateOrderResponse(ctx, sel, v)
+	return ec._PaymentTimelineEntry(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNOrder2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrder(ctx context.Context, sel ast.SelectionSet, v model.Order) graphql.Marshaler {
-	return ec._Order(ctx, sel, &v)
+func (ec *executionContext) unmarshalNPaymentTimelineEvent2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐPaymentTimelineEvent(ctx context.Context, v any) (model.PaymentTimelineEvent, error) {
+	var res model.PaymentTimelineEvent
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNPaymentTimelineEvent2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐPaymentTimelineEvent(ctx context.Context, sel ast.SelectionSet, v model.PaymentTimelineEvent) graphql.Marshaler {
+	return v
+}
+
+func (ec *executionContext) marshalNPickupLocation2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐPickupLocation(ctx context.Context, sel ast.SelectionSet, v model.PickupLocation) graphql.Marshaler {
+	return ec._PickupLocation(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNOrder2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Order) graphql.Marshaler {
+func (ec *executionContext) marshalNPickupLocation2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐPickupLocationᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.PickupLocation) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -4733,7 +10586,7 @@ func (ec *executionContext) marshalNOrder2ᚕᚖwarimasᚑbeᚋinternalᚋgraph
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNOrder2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrder(ctx, sel, v[i])
+			ret[i] = ec.marshalNPickupLocation2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐPickupLocation(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -4753,17 +10606,17 @@ func (ec *executionContext) marshalNOrder2ᚕᚖwarimasᚑbeᚋinternalᚋgraph
 	return ret
 }
 
-func (ec *executionContext) marshalNOrder2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrder(ctx context.Context, sel ast.SelectionSet, v *model.Order) graphql.Marshaler {
+func (ec *executionContext) marshalNPickupLocation2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐPickupLocation(ctx context.Context, sel ast.SelectionSet, v *model.PickupLocation) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._Order(ctx, sel, v)
+	return ec._PickupLocation(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNOrderItem2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderItemᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.OrderItem) graphql.Marshaler {
+func (ec *executionContext) marshalNPickupSlot2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐPickupSlotᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.PickupSlot) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
 	isLen1 := len(v) == 1
@@ -4787,7 +10640,7 @@ func (ec *executionContext) marshalNOrderItem2ᚕᚖwarimasᚑbeᚋinternalᚋgr
 			if !isLen1 {
 				defer wg.Done()
 			}
-			ret[i] = ec.marshalNOrderItem2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderItem(ctx, sel, v[i])
+			ret[i] = ec.marshalNPickupSlot2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐPickupSlot(ctx, sel, v[i])
 		}
 		if isLen1 {
 			f(i)
@@ -4807,142 +10660,191 @@ func (ec *executionContext) marshalNOrderItem2ᚕᚖwarimasᚑbeᚋinternalᚋgr
 	return ret
 }
 
-func (ec *executionContext) marshalNOrderItem2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderItem(ctx context.Context, sel ast.SelectionSet, v *model.OrderItem) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
-	}
-	return ec._OrderItem(ctx, sel, v)
-}
-
-func (ec *executionContext) marshalNOrderItemPricing2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderItemPricing(ctx context.Context, sel ast.SelectionSet, v *model.OrderItemPricing) graphql.Marshaler {
+func (ec *executionContext) marshalNPickupSlot2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐPickupSlot(ctx context.Context, sel ast.SelectionSet, v *model.PickupSlot) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._OrderItemPricing(ctx, sel, v)
+	return ec._PickupSlot(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNOrderListResponse2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderListResponse(ctx context.Context, sel ast.SelectionSet, v model.OrderListResponse) graphql.Marshaler {
-	return ec._OrderListResponse(ctx, sel, &v)
+func (ec *executionContext) marshalNPolicy2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐPolicy(ctx context.Context, sel ast.SelectionSet, v model.Policy) graphql.Marshaler {
+	return ec._Policy(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNOrderListResponse2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderListResponse(ctx context.Context, sel ast.SelectionSet, v *model.OrderListResponse) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+func (ec *executionContext) marshalNPolicy2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐPolicyᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Policy) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
 		}
-		return graphql.Null
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPolicy2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐPolicy(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
 	}
-	return ec._OrderListResponse(ctx, sel, v)
-}
+	wg.Wait()
 
-func (ec *executionContext) marshalNOrderPricing2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderPricing(ctx context.Context, sel ast.SelectionSet, v *model.OrderPricing) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
 		}
-		return graphql.Null
 	}
-	return ec._OrderPricing(ctx, sel, v)
+
+	return ret
 }
 
-func (ec *executionContext) marshalNOrderShipping2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderShipping(ctx context.Context, sel ast.SelectionSet, v *model.OrderShipping) graphql.Marshaler {
+func (ec *executionContext) marshalNPolicy2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐPolicy(ctx context.Context, sel ast.SelectionSet, v *model.Policy) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._OrderShipping(ctx, sel, v)
+	return ec._Policy(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNOrderSortField2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderSortField(ctx context.Context, v any) (model.OrderSortField, error) {
-	var res model.OrderSortField
+func (ec *executionContext) unmarshalNPolicyKind2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐPolicyKind(ctx context.Context, v any) (model.PolicyKind, error) {
+	var res model.PolicyKind
 	err := res.UnmarshalGQL(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNOrderSortField2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderSortField(ctx context.Context, sel ast.SelectionSet, v model.OrderSortField) graphql.Marshaler {
+func (ec *executionContext) marshalNPolicyKind2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐPolicyKind(ctx context.Context, sel ast.SelectionSet, v model.PolicyKind) graphql.Marshaler {
 	return v
 }
 
-func (ec *executionContext) unmarshalNOrderStatus2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderStatus(ctx context.Context, v any) (model.OrderStatus, error) {
-	var res model.OrderStatus
-	err := res.UnmarshalGQL(v)
+func (ec *executionContext) unmarshalNPublishPolicyInput2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐPublishPolicyInput(ctx context.Context, v any) (model.PublishPolicyInput, error) {
+	res, err := ec.unmarshalInputPublishPolicyInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNOrderStatus2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderStatus(ctx context.Context, sel ast.SelectionSet, v model.OrderStatus) graphql.Marshaler {
-	return v
+func (ec *executionContext) unmarshalNRemoveSessionItemInput2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐRemoveSessionItemInput(ctx context.Context, v any) (model.RemoveSessionItemInput, error) {
+	res, err := ec.unmarshalInputRemoveSessionItemInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNOrderTimestamps2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderTimestamps(ctx context.Context, sel ast.SelectionSet, v *model.OrderTimestamps) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
-	}
-	return ec._OrderTimestamps(ctx, sel, v)
+func (ec *executionContext) unmarshalNRequestOrderAdjustmentInput2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐRequestOrderAdjustmentInput(ctx context.Context, v any) (model.RequestOrderAdjustmentInput, error) {
+	res, err := ec.unmarshalInputRequestOrderAdjustmentInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNPageInfoOrder2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐPageInfoOrder(ctx context.Context, sel ast.SelectionSet, v *model.PageInfoOrder) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
-	}
-	return ec._PageInfoOrder(ctx, sel, v)
+func (ec *executionContext) unmarshalNSetCheckoutRuleInput2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐSetCheckoutRuleInput(ctx context.Context, v any) (model.SetCheckoutRuleInput, error) {
+	res, err := ec.unmarshalInputSetCheckoutRuleInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNPaymentDetail2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐPaymentDetail(ctx context.Context, sel ast.SelectionSet, v *model.PaymentDetail) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
-	}
-	return ec._PaymentDetail(ctx, sel, v)
+func (ec *executionContext) unmarshalNSetDeliverySlotInput2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐSetDeliverySlotInput(ctx context.Context, v any) (model.SetDeliverySlotInput, error) {
+	res, err := ec.unmarshalInputSetDeliverySlotInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNPaymentOrderInfoResponse2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐPaymentOrderInfoResponse(ctx context.Context, sel ast.SelectionSet, v model.PaymentOrderInfoResponse) graphql.Marshaler {
-	return ec._PaymentOrderInfoResponse(ctx, sel, &v)
+func (ec *executionContext) unmarshalNSetOrderReasonInput2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐSetOrderReasonInput(ctx context.Context, v any) (model.SetOrderReasonInput, error) {
+	res, err := ec.unmarshalInputSetOrderReasonInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNPaymentOrderInfoResponse2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐPaymentOrderInfoResponse(ctx context.Context, sel ast.SelectionSet, v *model.PaymentOrderInfoResponse) graphql.Marshaler {
+func (ec *executionContext) unmarshalNSetPickupLocationInput2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐSetPickupLocationInput(ctx context.Context, v any) (model.SetPickupLocationInput, error) {
+	res, err := ec.unmarshalInputSetPickupLocationInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNShippingAddress2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐShippingAddress(ctx context.Context, sel ast.SelectionSet, v *model.ShippingAddress) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._PaymentOrderInfoResponse(ctx, sel, v)
+	return ec._ShippingAddress(ctx, sel, v)
 }
 
-func (ec *executionContext) unmarshalNPaymentStatus2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐPaymentStatus(ctx context.Context, v any) (model.PaymentStatus, error) {
-	var res model.PaymentStatus
+func (ec *executionContext) unmarshalNShippingMethod2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐShippingMethod(ctx context.Context, v any) (model.ShippingMethod, error) {
+	var res model.ShippingMethod
 	err := res.UnmarshalGQL(v)
 	return res, graphql.ErrorOnPath(ctx, err)
 }
 
-func (ec *executionContext) marshalNPaymentStatus2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐPaymentStatus(ctx context.Context, sel ast.SelectionSet, v model.PaymentStatus) graphql.Marshaler {
+func (ec *executionContext) marshalNShippingMethod2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐShippingMethod(ctx context.Context, sel ast.SelectionSet, v model.ShippingMethod) graphql.Marshaler {
 	return v
 }
 
-func (ec *executionContext) marshalNShippingAddress2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐShippingAddress(ctx context.Context, sel ast.SelectionSet, v *model.ShippingAddress) graphql.Marshaler {
+func (ec *executionContext) marshalNShippingOption2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐShippingOptionᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ShippingOption) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNShippingOption2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐShippingOption(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNShippingOption2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐShippingOption(ctx context.Context, sel ast.SelectionSet, v *model.ShippingOption) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._ShippingAddress(ctx, sel, v)
+	return ec._ShippingOption(ctx, sel, v)
 }
 
 func (ec *executionContext) unmarshalNUpdateOrderStatusInput2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐUpdateOrderStatusInput(ctx context.Context, v any) (model.UpdateOrderStatusInput, error) {
@@ -4969,6 +10871,16 @@ func (ec *executionContext) marshalNUpdateSessionAddressResponse2ᚖwarimasᚑbe
 	return ec._UpdateSessionAddressResponse(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNUpdateSessionInsuranceInput2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐUpdateSessionInsuranceInput(ctx context.Context, v any) (model.UpdateSessionInsuranceInput, error) {
+	res, err := ec.unmarshalInputUpdateSessionInsuranceInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalNUpdateSessionItemInput2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐUpdateSessionItemInput(ctx context.Context, v any) (model.UpdateSessionItemInput, error) {
+	res, err := ec.unmarshalInputUpdateSessionItemInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) unmarshalNUpdateSessionPaymentMethodInput2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐUpdateSessionPaymentMethodInput(ctx context.Context, v any) (model.UpdateSessionPaymentMethodInput, error) {
 	res, err := ec.unmarshalInputUpdateSessionPaymentMethodInput(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -4988,6 +10900,11 @@ func (ec *executionContext) marshalNUpdateSessionPaymentMethodResponse2ᚖwarima
 	return ec._UpdateSessionPaymentMethodResponse(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNUpdateSessionShippingMethodInput2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐUpdateSessionShippingMethodInput(ctx context.Context, v any) (model.UpdateSessionShippingMethodInput, error) {
+	res, err := ec.unmarshalInputUpdateSessionShippingMethodInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalNUserRef2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐUserRef(ctx context.Context, sel ast.SelectionSet, v *model.UserRef) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
@@ -5008,6 +10925,11 @@ func (ec *executionContext) marshalNVariantRef2ᚖwarimasᚑbeᚋinternalᚋgrap
 	return ec._VariantRef(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNVerifyPickupCodeInput2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐVerifyPickupCodeInput(ctx context.Context, v any) (model.VerifyPickupCodeInput, error) {
+	res, err := ec.unmarshalInputVerifyPickupCodeInput(ctx, v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
 func (ec *executionContext) marshalOCheckoutSession2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐCheckoutSession(ctx context.Context, sel ast.SelectionSet, v *model.CheckoutSession) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -5015,6 +10937,13 @@ func (ec *executionContext) marshalOCheckoutSession2ᚖwarimasᚑbeᚋinternal
 	return ec._CheckoutSession(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalODeliveryWindow2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐDeliveryWindow(ctx context.Context, sel ast.SelectionSet, v *model.DeliveryWindow) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._DeliveryWindow(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalOOrder2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrder(ctx context.Context, sel ast.SelectionSet, v *model.Order) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -5022,6 +10951,22 @@ func (ec *executionContext) marshalOOrder2ᚖwarimasᚑbeᚋinternalᚋgraphᚋm
 	return ec._Order(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalOOrderDatePreset2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderDatePreset(ctx context.Context, v any) (*model.OrderDatePreset, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.OrderDatePreset)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOOrderDatePreset2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderDatePreset(ctx context.Context, sel ast.SelectionSet, v *model.OrderDatePreset) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
 func (ec *executionContext) unmarshalOOrderFilterInput2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderFilterInput(ctx context.Context, v any) (*model.OrderFilterInput, error) {
 	if v == nil {
 		return nil, nil
@@ -5030,6 +10975,36 @@ func (ec *executionContext) unmarshalOOrderFilterInput2ᚖwarimasᚑbeᚋinterna
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) marshalOOrderPickup2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderPickup(ctx context.Context, sel ast.SelectionSet, v *model.OrderPickup) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._OrderPickup(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalOOrderReason2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderReason(ctx context.Context, sel ast.SelectionSet, v *model.OrderReason) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._OrderReason(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalOOrderReasonKind2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderReasonKind(ctx context.Context, v any) (*model.OrderReasonKind, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.OrderReasonKind)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOOrderReasonKind2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderReasonKind(ctx context.Context, sel ast.SelectionSet, v *model.OrderReasonKind) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
 func (ec *executionContext) unmarshalOOrderSortInput2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐOrderSortInput(ctx context.Context, v any) (*model.OrderSortInput, error) {
 	if v == nil {
 		return nil, nil
@@ -5069,4 +11044,51 @@ func (ec *executionContext) marshalOPayment2ᚖwarimasᚑbeᚋinternalᚋgraph
 	return ec._Payment(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalOPolicy2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐPolicyᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Policy) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNPolicy2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐPolicy(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 // endregion ***************************** type.gotpl *****************************
@@ -8,9 +8,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"warimas-be/internal/address"
+	"time"
+	"warimas-be/internal/analytics"
 	"warimas-be/internal/graph/model"
 	"warimas-be/internal/logger"
+	"warimas-be/internal/loyalty"
 	"warimas-be/internal/order"
 	"warimas-be/internal/payment"
 	"warimas-be/internal/utils"
@@ -20,12 +22,7 @@ import (
 
 // CreateOrderFromSession is the resolver for the createOrderFromSession field.
 func (r *mutationResolver) CreateOrderFromSession(ctx context.Context, input model.CreateOrderFromSessionInput) (*model.CreateOrderResponse, error) {
-	// STRONGLY RECOMMENDED:
-	// protect this with ADMIN or INTERNAL auth
-	if !utils.IsInternalRequest(ctx) {
-		return nil, errors.New("forbidden")
-	}
-
+	// Only internal services reach this: see @scope on the field.
 	log := logger.FromCtx(ctx).With(
 		zap.String("layer", "resolver"),
 		zap.String("method", "CreateOrderFromSession"),
@@ -72,7 +69,21 @@ func (r *mutationResolver) UpdateOrderStatus(ctx context.Context, input model.Up
 
 	status := order.OrderStatus(input.Status.String())
 
-	err = r.OrderSvc.UpdateOrderStatus(ctx, orderID, status)
+	if status == order.OrderStatusCancelled {
+		var reasonID int32
+		if input.ReasonID != nil {
+			if reasonID, err = order.ParseReasonID(*input.ReasonID); err != nil {
+				log.Warn("invalid reason id", zap.Error(err))
+				return &model.CreateOrderResponse{
+					Success: false,
+					Message: utils.StrPtr(err.Error()),
+				}, nil
+			}
+		}
+		err = r.OrderSvc.CancelOrder(ctx, orderID, reasonID, input.ReasonNote)
+	} else {
+		err = r.OrderSvc.UpdateOrderStatus(ctx, orderID, status)
+	}
 	if err != nil {
 		log.Error("failed to update order status", zap.Error(err))
 		return &model.CreateOrderResponse{
@@ -89,6 +100,209 @@ func (r *mutationResolver) UpdateOrderStatus(ctx context.Context, input model.Up
 	}, nil
 }
 
+// CreateAdminOrder is the resolver for the createAdminOrder field.
+func (r *mutationResolver) CreateAdminOrder(ctx context.Context, input model.CreateAdminOrderInput) (*model.CreateOrderResponse, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "CreateAdminOrder"),
+		zap.String("customer_id", input.UserID),
+		zap.Int("item_count", len(input.Items)),
+	)
+
+	log.Info("create admin order request received")
+
+	orderCreated, payResp, err := r.OrderSvc.CreateAdminOrder(ctx, input)
+	if err != nil {
+		log.Error("failed to create admin order", zap.Error(err))
+		return nil, err
+	}
+
+	log.Info("admin order created", zap.Int32("order_id", orderCreated.ID))
+
+	var paymentURL *string
+	if payResp.InvoiceURL != "" {
+		paymentURL = &payResp.InvoiceURL
+	}
+
+	return &model.CreateOrderResponse{
+		Success:    true,
+		Order:      order.ToGraphQLOrder(orderCreated, nil),
+		PaymentURL: paymentURL,
+		Payment: &model.Payment{
+			Status: model.PaymentStatus(payResp.Status),
+			URL:    paymentURL,
+		},
+	}, nil
+}
+
+// SetCheckoutRule is the resolver for the setCheckoutRule field.
+func (r *mutationResolver) SetCheckoutRule(ctx context.Context, input model.SetCheckoutRuleInput) (*model.CheckoutRule, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "SetCheckoutRule"),
+	)
+
+	rule, err := r.OrderSvc.SetCheckoutRule(ctx, input)
+	if err != nil {
+		log.Error("failed to set checkout rule", zap.Error(err))
+		return nil, err
+	}
+
+	return order.MapCheckoutRuleToGraphQL(rule), nil
+}
+
+// SetPickupLocation is the resolver for the setPickupLocation field.
+func (r *mutationResolver) SetPickupLocation(ctx context.Context, input model.SetPickupLocationInput) (*model.PickupLocation, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "SetPickupLocation"),
+	)
+
+	location, err := r.OrderSvc.SetPickupLocation(ctx, input)
+	if err != nil {
+		log.Error("failed to set pickup location", zap.Error(err))
+		return nil, err
+	}
+
+	return order.MapPickupLocationToGraphQL(location), nil
+}
+
+// SetDeliverySlot is the resolver for the setDeliverySlot field.
+func (r *mutationResolver) SetDeliverySlot(ctx context.Context, input model.SetDeliverySlotInput) (*model.DeliverySlot, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "SetDeliverySlot"),
+	)
+
+	slot, err := r.OrderSvc.SetDeliverySlot(ctx, input)
+	if err != nil {
+		log.Error("failed to set delivery slot", zap.Error(err))
+		return nil, err
+	}
+
+	return order.MapDeliverySlotToGraphQL(slot), nil
+}
+
+// SetOrderReason is the resolver for the setOrderReason field.
+func (r *mutationResolver) SetOrderReason(ctx context.Context, input model.SetOrderReasonInput) (*model.OrderReason, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "SetOrderReason"),
+	)
+
+	reason, err := r.OrderSvc.SetOrderReason(ctx, input)
+	if err != nil {
+		log.Error("failed to set order reason", zap.Error(err))
+		return nil, err
+	}
+
+	return order.MapReasonToGraphQL(reason), nil
+}
+
+// PublishPolicy is the resolver for the publishPolicy field.
+func (r *mutationResolver) PublishPolicy(ctx context.Context, input model.PublishPolicyInput) (*model.Policy, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "PublishPolicy"),
+	)
+
+	policy, err := r.OrderSvc.PublishPolicy(ctx, input)
+	if err != nil {
+		log.Error("failed to publish policy", zap.Error(err))
+		return nil, err
+	}
+
+	return order.MapPolicyToGraphQL(policy), nil
+}
+
+// RequestOrderAdjustment is the resolver for the requestOrderAdjustment field.
+func (r *mutationResolver) RequestOrderAdjustment(ctx context.Context, input model.RequestOrderAdjustmentInput) (*model.OrderAdjustment, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "RequestOrderAdjustment"),
+	)
+
+	a, err := r.OrderSvc.RequestOrderAdjustment(ctx, input)
+	if err != nil {
+		log.Error("failed to request order adjustment", zap.Error(err))
+		return nil, err
+	}
+
+	return order.MapOrderAdjustmentToGraphQL(a), nil
+}
+
+// ApproveOrderAdjustment is the resolver for the approveOrderAdjustment field.
+func (r *mutationResolver) ApproveOrderAdjustment(ctx context.Context, id string) (*model.OrderAdjustment, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "ApproveOrderAdjustment"),
+		zap.String("adjustment_id", id),
+	)
+
+	adjustmentID, err := order.ParseAdjustmentID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := r.OrderSvc.ApproveOrderAdjustment(ctx, adjustmentID)
+	if err != nil {
+		log.Error("failed to approve order adjustment", zap.Error(err))
+		return nil, err
+	}
+
+	return order.MapOrderAdjustmentToGraphQL(a), nil
+}
+
+// RejectOrderAdjustment is the resolver for the rejectOrderAdjustment field.
+func (r *mutationResolver) RejectOrderAdjustment(ctx context.Context, id string) (*model.OrderAdjustment, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "RejectOrderAdjustment"),
+		zap.String("adjustment_id", id),
+	)
+
+	adjustmentID, err := order.ParseAdjustmentID(id)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := r.OrderSvc.RejectOrderAdjustment(ctx, adjustmentID)
+	if err != nil {
+		log.Error("failed to reject order adjustment", zap.Error(err))
+		return nil, err
+	}
+
+	return order.MapOrderAdjustmentToGraphQL(a), nil
+}
+
+// VerifyPickupCode is the resolver for the verifyPickupCode field.
+func (r *mutationResolver) VerifyPickupCode(ctx context.Context, input model.VerifyPickupCodeInput) (*model.Order, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "VerifyPickupCode"),
+		zap.String("order_id", input.OrderID),
+	)
+
+	orderID, err := utils.ToUint(input.OrderID)
+	if err != nil {
+		log.Warn("invalid order id", zap.Error(err))
+		return nil, err
+	}
+
+	if err := r.OrderSvc.VerifyPickupCode(ctx, orderID, input.Code); err != nil {
+		log.Warn("failed to verify pickup code", zap.Error(err))
+		return nil, err
+	}
+
+	orderDetail, address, err := r.OrderSvc.GetOrderDetail(ctx, orderID)
+	if err != nil {
+		log.Error("failed to get order detail", zap.Error(err))
+		return nil, err
+	}
+
+	return order.ToGraphQLOrder(orderDetail, address), nil
+}
+
 // CreateCheckoutSession is the resolver for the CreateCheckoutSession field.
 func (r *mutationResolver) CreateCheckoutSession(ctx context.Context, input model.CreateCheckoutSessionInput) (*model.CheckoutSessionResponse, error) {
 	log := logger.FromCtx(ctx).With(
@@ -120,6 +334,18 @@ func (r *mutationResolver) CreateCheckoutSession(ctx context.Context, input mode
 		zap.Time("expires_at", session.ExpiresAt),
 	)
 
+	var units int
+	for _, item := range session.Items {
+		units += item.Quantity
+	}
+	analytics.Track(ctx, analytics.Event{
+		Type:       analytics.EventCheckoutStarted,
+		CheckoutID: session.ExternalID,
+		Quantity:   units,
+		Value:      int64(session.Subtotal),
+		Currency:   session.Currency,
+	})
+
 	return &model.CheckoutSessionResponse{
 		ExternalID: session.ExternalID,
 		Status:     model.CheckoutSessionStatus(session.Status),
@@ -129,24 +355,17 @@ func (r *mutationResolver) CreateCheckoutSession(ctx context.Context, input mode
 
 // UpdateSessionAddress is the resolver for the updateSessionAddress field.
 func (r *mutationResolver) UpdateSessionAddress(ctx context.Context, input model.UpdateSessionAddressInput) (*model.UpdateSessionAddressResponse, error) {
-	logFields := []zap.Field{
+	log := logger.FromCtx(ctx).With(
 		zap.String("layer", "resolver"),
 		zap.String("method", "UpdateSessionAddress"),
 		zap.String("session_id", input.ExternalID),
 		zap.String("address_id", input.AddressID),
-	}
-
-	if input.GuestID != nil {
-		logFields = append(logFields, zap.String("guest_id", *input.GuestID))
-	}
-
-	log := logger.FromCtx(ctx).With(logFields...)
+	)
 
 	err := r.OrderSvc.UpdateSessionAddress(
 		ctx,
 		input.ExternalID,
 		input.AddressID,
-		input.GuestID,
 	)
 	if err != nil {
 		log.Error("failed to update session address", zap.Error(err))
@@ -162,24 +381,17 @@ func (r *mutationResolver) UpdateSessionAddress(ctx context.Context, input model
 
 // UpdateSessionPaymentMethod is the resolver for the updateSessionPaymentMethod field.
 func (r *mutationResolver) UpdateSessionPaymentMethod(ctx context.Context, input model.UpdateSessionPaymentMethodInput) (*model.UpdateSessionPaymentMethodResponse, error) {
-	logFields := []zap.Field{
+	log := logger.FromCtx(ctx).With(
 		zap.String("layer", "resolver"),
 		zap.String("method", "UpdateSessionPaymentMethod"),
 		zap.String("session_id", input.ExternalID),
 		zap.String("payment_method", input.PaymentMethod),
-	}
-
-	if input.GuestID != nil {
-		logFields = append(logFields, zap.String("guest_id", *input.GuestID))
-	}
-
-	log := logger.FromCtx(ctx).With(logFields...)
+	)
 
 	err := r.OrderSvc.UpdateSessionPaymentMethod(
 		ctx,
 		input.ExternalID,
 		payment.ChannelCode(input.PaymentMethod),
-		input.GuestID,
 	)
 	if err != nil {
 		log.Error("failed to update session payment method", zap.Error(err))
@@ -193,6 +405,179 @@ func (r *mutationResolver) UpdateSessionPaymentMethod(ctx context.Context, input
 	}, nil
 }
 
+// UpdateSessionShippingMethod is the resolver for the updateSessionShippingMethod field.
+func (r *mutationResolver) UpdateSessionShippingMethod(ctx context.Context, input model.UpdateSessionShippingMethodInput) (*model.CheckoutSession, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "UpdateSessionShippingMethod"),
+		zap.String("session_id", input.ExternalID),
+		zap.String("shipping_method", string(input.ShippingMethod)),
+	)
+
+	var pickup *order.PickupChoice
+	if input.PickupLocationID != nil && input.PickupSlotStart != nil {
+		locationID, err := order.ParsePickupLocationID(*input.PickupLocationID)
+		if err != nil {
+			return nil, err
+		}
+		pickup = &order.PickupChoice{LocationID: locationID, SlotStart: *input.PickupSlotStart}
+	}
+
+	var deliverySlotID *int32
+	if input.DeliverySlotID != nil {
+		id, err := order.ParseDeliverySlotID(*input.DeliverySlotID)
+		if err != nil {
+			return nil, err
+		}
+		deliverySlotID = &id
+	}
+
+	session, err := r.OrderSvc.UpdateSessionShippingMethod(
+		ctx,
+		input.ExternalID,
+		order.ShippingMethod(input.ShippingMethod),
+		pickup,
+		deliverySlotID,
+	)
+	if err != nil {
+		log.Error("failed to update session shipping method", zap.Error(err))
+		return nil, err
+	}
+
+	return order.MapCheckoutSessionToGraphQL(session), nil
+}
+
+// UpdateSessionInsurance is the resolver for the updateSessionInsurance field.
+func (r *mutationResolver) UpdateSessionInsurance(ctx context.Context, input model.UpdateSessionInsuranceInput) (*model.CheckoutSession, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "UpdateSessionInsurance"),
+		zap.String("session_id", input.ExternalID),
+		zap.Bool("insured", input.Insured),
+	)
+
+	session, err := r.OrderSvc.UpdateSessionInsurance(ctx, input.ExternalID, input.Insured)
+	if err != nil {
+		log.Error("failed to update session insurance", zap.Error(err))
+		return nil, err
+	}
+
+	return order.MapCheckoutSessionToGraphQL(session), nil
+}
+
+// UpdateSessionItem is the resolver for the updateSessionItem field.
+func (r *mutationResolver) UpdateSessionItem(ctx context.Context, input model.UpdateSessionItemInput) (*model.CheckoutSession, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "UpdateSessionItem"),
+		zap.String("session_id", input.ExternalID),
+		zap.String("item_id", input.ItemID),
+		zap.Int32("quantity", input.Quantity),
+	)
+
+	session, err := r.OrderSvc.UpdateSessionItemQuantity(
+		ctx,
+		input.ExternalID,
+		input.ItemID,
+		int(input.Quantity),
+	)
+	if err != nil {
+		log.Error("failed to update session item", zap.Error(err))
+		return nil, err
+	}
+
+	return order.MapCheckoutSessionToGraphQL(session), nil
+}
+
+// RemoveSessionItem is the resolver for the removeSessionItem field.
+func (r *mutationResolver) RemoveSessionItem(ctx context.Context, input model.RemoveSessionItemInput) (*model.CheckoutSession, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "RemoveSessionItem"),
+		zap.String("session_id", input.ExternalID),
+		zap.String("item_id", input.ItemID),
+	)
+
+	session, err := r.OrderSvc.RemoveSessionItem(
+		ctx,
+		input.ExternalID,
+		input.ItemID,
+	)
+	if err != nil {
+		log.Error("failed to remove session item", zap.Error(err))
+		return nil, err
+	}
+
+	return order.MapCheckoutSessionToGraphQL(session), nil
+}
+
+// ApplySessionWallet is the resolver for the applySessionWallet field.
+func (r *mutationResolver) ApplySessionWallet(ctx context.Context, input model.ApplySessionWalletInput) (*model.ApplySessionWalletResponse, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "ApplySessionWallet"),
+		zap.String("session_id", input.ExternalID),
+		zap.Int32("amount", input.Amount),
+	)
+
+	err := r.OrderSvc.ApplySessionWallet(ctx, input.ExternalID, int(input.Amount))
+	if err != nil {
+		log.Error("failed to apply wallet to session", zap.Error(err))
+		return nil, err
+	}
+
+	log.Info("wallet applied to session successfully")
+
+	return &model.ApplySessionWalletResponse{
+		Success: true,
+	}, nil
+}
+
+// ApplyCoupon is the resolver for the applyCoupon field.
+func (r *mutationResolver) ApplyCoupon(ctx context.Context, input model.ApplyCouponInput) (*model.ApplyCouponResponse, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "ApplyCoupon"),
+		zap.String("session_id", input.ExternalID),
+	)
+
+	discount, err := r.OrderSvc.ApplySessionCoupon(ctx, input.ExternalID, input.Code)
+	if err != nil {
+		log.Error("failed to apply coupon to session", zap.Error(err))
+		return nil, err
+	}
+
+	log.Info("coupon applied to session successfully")
+
+	return &model.ApplyCouponResponse{
+		Success:  true,
+		Discount: int32(discount),
+	}, nil
+}
+
+// ApplySessionPoints is the resolver for the applySessionPoints field.
+func (r *mutationResolver) ApplySessionPoints(ctx context.Context, input model.ApplySessionPointsInput) (*model.ApplySessionPointsResponse, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "ApplySessionPoints"),
+		zap.String("session_id", input.ExternalID),
+	)
+
+	discount, err := r.OrderSvc.ApplySessionPoints(ctx, input.ExternalID, int(input.Points))
+	if err != nil {
+		log.Error("failed to apply points to session", zap.Error(err))
+		return nil, err
+	}
+
+	log.Info("points applied to session successfully")
+
+	return &model.ApplySessionPointsResponse{
+		Success:        true,
+		PointsRedeemed: int32(discount / loyalty.PointValue),
+		Discount:       int32(discount),
+	}, nil
+}
+
 // ConfirmCheckoutSession is the resolver for the confirmCheckoutSession field.
 func (r *mutationResolver) ConfirmCheckoutSession(ctx context.Context, input model.ConfirmCheckoutSessionInput) (*model.ConfirmCheckoutSessionResponse, error) {
 	log := logger.FromCtx(ctx).With(
@@ -201,15 +586,31 @@ func (r *mutationResolver) ConfirmCheckoutSession(ctx context.Context, input mod
 		zap.String("external_id", input.ExternalID),
 	)
 
+	acceptedPolicyIDs := make([]int32, 0, len(input.AcceptedPolicyIds))
+	for _, id := range input.AcceptedPolicyIds {
+		policyID, err := order.ParsePolicyID(id)
+		if err != nil {
+			return nil, err
+		}
+		acceptedPolicyIDs = append(acceptedPolicyIDs, policyID)
+	}
+
 	orderExternalID, err := r.OrderSvc.ConfirmSession(
 		ctx,
 		input.ExternalID,
+		acceptedPolicyIDs,
 	)
 	if err != nil {
 		log.Error("failed to confirm checkout session", zap.Error(err))
 		return nil, err
 	}
 
+	// The order is placed either way; an untagged order only drops out of
+	// the experiment results.
+	if err := r.ExperimentSvc.TagOrder(ctx, *orderExternalID); err != nil {
+		log.Warn("failed to tag order with experiments", zap.Error(err))
+	}
+
 	msg := "checkout session confirmed"
 
 	return &model.ConfirmCheckoutSessionResponse{
@@ -220,7 +621,7 @@ func (r *mutationResolver) ConfirmCheckoutSession(ctx context.Context, input mod
 }
 
 // OrderList is the resolver for the orderList field.
-func (r *queryResolver) OrderList(ctx context.Context, filter *model.OrderFilterInput, sort *model.OrderSortInput, pagination *model.PaginationInput) (*model.OrderListResponse, error) {
+func (r *queryResolver) OrderList(ctx context.Context, filter *model.OrderFilterInput, sort *model.OrderSortInput, pagination *model.PaginationInput) (*model.OrderConnection, error) {
 	log := logger.FromCtx(ctx).With(
 		zap.String("layer", "resolver"),
 		zap.String("method", "OrderList"),
@@ -237,6 +638,13 @@ func (r *queryResolver) OrderList(ctx context.Context, filter *model.OrderFilter
 		if pagination.Page > 0 {
 			page = pagination.Page
 		}
+
+		var err error
+		page, err = pageAfter(pagination.After, page, limit)
+		if err != nil {
+			log.Warn("invalid cursor", zap.Error(err))
+			return nil, err
+		}
 	}
 
 	log.Info("order list request started",
@@ -250,11 +658,18 @@ func (r *queryResolver) OrderList(ctx context.Context, filter *model.OrderFilter
 		filterOrder.Search = filter.Search
 		filterOrder.DateFrom = filter.DateFrom
 		filterOrder.DateTo = filter.DateTo
+		filterOrder.Product = filter.Product
+		filterOrder.VariantID = filter.VariantID
 
 		if filter.Status != nil {
 			status := order.OrderStatus(*filter.Status)
 			filterOrder.Status = &status
 		}
+
+		if filter.DatePreset != nil {
+			preset := order.OrderDatePreset(*filter.DatePreset)
+			filterOrder.DatePreset = &preset
+		}
 	}
 
 	// Map sort
@@ -290,30 +705,12 @@ func (r *queryResolver) OrderList(ctx context.Context, filter *model.OrderFilter
 	items := make([]*model.Order, 0, len(orders))
 
 	for _, o := range orders {
-		var addr *address.Address
-
-		if list, ok := addressMap[o.AddressID]; ok && len(list) > 0 {
-			addr = &list[0] // usually 1 address per order
-		}
-
-		items = append(items, order.ToGraphQLOrder(o, addr))
-	}
-
-	// Page info
-	totalPages := int32((total + int64(limit) - 1) / int64(limit))
-
-	pageInfo := &model.PageInfoOrder{
-		TotalItems:      int32(total),
-		TotalPages:      totalPages,
-		Page:            page,
-		Limit:           limit,
-		HasNextPage:     page < totalPages,
-		HasPreviousPage: page > 1,
+		items = append(items, order.ToGraphQLOrder(o, addressMap[o.AddressID]))
 	}
 
-	return &model.OrderListResponse{
+	return &model.OrderConnection{
 		Items:    items,
-		PageInfo: pageInfo,
+		PageInfo: newPageInfo(total, page, limit),
 	}, nil
 }
 
@@ -385,6 +782,88 @@ func (r *queryResolver) CheckoutSession(ctx context.Context, externalID string)
 	return order.MapCheckoutSessionToGraphQL(session), nil
 }
 
+// MyActiveCheckoutSession is the resolver for the myActiveCheckoutSession field.
+func (r *queryResolver) MyActiveCheckoutSession(ctx context.Context) (*model.CheckoutSession, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "MyActiveCheckoutSession"),
+	)
+
+	session, err := r.OrderSvc.GetActiveSession(ctx)
+	if err != nil {
+		log.Error("failed to get active checkout session", zap.Error(err))
+		return nil, err
+	}
+	if session == nil {
+		return nil, nil
+	}
+
+	return order.MapCheckoutSessionToGraphQL(session), nil
+}
+
+// ShippingOptions is the resolver for the shippingOptions field.
+func (r *queryResolver) ShippingOptions(ctx context.Context, externalID string) ([]*model.ShippingOption, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "ShippingOptions"),
+		zap.String("session_id", externalID),
+	)
+
+	options, err := r.OrderSvc.ShippingOptions(ctx, externalID)
+	if err != nil {
+		log.Error("failed to list shipping options", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]*model.ShippingOption, 0, len(options))
+	for _, o := range options {
+		out = append(out, order.MapShippingOptionToGraphQL(o))
+	}
+	return out, nil
+}
+
+// DeliverySlots is the resolver for the deliverySlots field.
+func (r *queryResolver) DeliverySlots(ctx context.Context, externalID string) ([]*model.DeliverySlotOption, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "DeliverySlots"),
+		zap.String("session_id", externalID),
+	)
+
+	options, err := r.OrderSvc.DeliverySlots(ctx, externalID)
+	if err != nil {
+		log.Error("failed to list delivery slots", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]*model.DeliverySlotOption, 0, len(options))
+	for _, o := range options {
+		out = append(out, order.MapDeliverySlotOptionToGraphQL(o))
+	}
+	return out, nil
+}
+
+// CheckoutSessionEvents is the resolver for the checkoutSessionEvents field.
+func (r *queryResolver) CheckoutSessionEvents(ctx context.Context, externalID string) ([]*model.CheckoutSessionEvent, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "CheckoutSessionEvents"),
+		zap.String("external_id", externalID),
+	)
+
+	events, err := r.OrderSvc.ListSessionEvents(ctx, externalID)
+	if err != nil {
+		log.Error("failed to list checkout session events", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]*model.CheckoutSessionEvent, 0, len(events))
+	for _, e := range events {
+		out = append(out, order.MapSessionEventToGraphQL(e))
+	}
+	return out, nil
+}
+
 // PaymentOrderInfo is the resolver for the paymentOrderInfo field.
 func (r *queryResolver) PaymentOrderInfo(ctx context.Context, externalID string) (*model.PaymentOrderInfoResponse, error) {
 	log := logger.FromCtx(ctx).With(
@@ -424,7 +903,252 @@ func (r *queryResolver) PaymentOrderInfo(ctx context.Context, externalID string)
 			ReferenceID:  paymentInfo.Payment.ReferenceID,
 			InvoiceURL:   paymentInfo.Payment.InvoiceURL,
 			Instructions: paymentInfo.Payment.Instructions,
-		}}
+		},
+		Timeline:         make([]*model.PaymentTimelineEntry, 0, len(paymentInfo.Timeline)),
+		SecondsRemaining: int32(paymentInfo.SecondsRemaining),
+	}
+
+	for _, e := range paymentInfo.Timeline {
+		entry := &model.PaymentTimelineEntry{
+			Event:      model.PaymentTimelineEvent(e.Event),
+			OccurredAt: e.At,
+		}
+		if e.Amount != 0 {
+			amount := int32(e.Amount)
+			entry.Amount = &amount
+		}
+		paymentInfoMap.Timeline = append(paymentInfoMap.Timeline, entry)
+	}
 
 	return paymentInfoMap, nil
 }
+
+// CheckoutRules is the resolver for the checkoutRules field.
+func (r *queryResolver) CheckoutRules(ctx context.Context) ([]*model.CheckoutRule, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "CheckoutRules"),
+	)
+
+	rules, err := r.OrderSvc.CheckoutRules(ctx, false)
+	if err != nil {
+		log.Error("failed to list checkout rules", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]*model.CheckoutRule, 0, len(rules))
+	for _, rule := range rules {
+		out = append(out, order.MapCheckoutRuleToGraphQL(rule))
+	}
+	return out, nil
+}
+
+// AdminCheckoutRules is the resolver for the adminCheckoutRules field.
+func (r *queryResolver) AdminCheckoutRules(ctx context.Context) ([]*model.CheckoutRule, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "AdminCheckoutRules"),
+	)
+
+	rules, err := r.OrderSvc.CheckoutRules(ctx, true)
+	if err != nil {
+		log.Error("failed to list checkout rules", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]*model.CheckoutRule, 0, len(rules))
+	for _, rule := range rules {
+		out = append(out, order.MapCheckoutRuleToGraphQL(rule))
+	}
+	return out, nil
+}
+
+// PickupLocations is the resolver for the pickupLocations field.
+func (r *queryResolver) PickupLocations(ctx context.Context) ([]*model.PickupLocation, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "PickupLocations"),
+	)
+
+	locations, err := r.OrderSvc.PickupLocations(ctx, false)
+	if err != nil {
+		log.Error("failed to list pickup locations", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]*model.PickupLocation, 0, len(locations))
+	for _, location := range locations {
+		out = append(out, order.MapPickupLocationToGraphQL(location))
+	}
+	return out, nil
+}
+
+// AdminPickupLocations is the resolver for the adminPickupLocations field.
+func (r *queryResolver) AdminPickupLocations(ctx context.Context) ([]*model.PickupLocation, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "AdminPickupLocations"),
+	)
+
+	locations, err := r.OrderSvc.PickupLocations(ctx, true)
+	if err != nil {
+		log.Error("failed to list pickup locations", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]*model.PickupLocation, 0, len(locations))
+	for _, location := range locations {
+		out = append(out, order.MapPickupLocationToGraphQL(location))
+	}
+	return out, nil
+}
+
+// PickupSlots is the resolver for the pickupSlots field.
+func (r *queryResolver) PickupSlots(ctx context.Context, locationID string) ([]*model.PickupSlot, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "PickupSlots"),
+		zap.String("pickup_location_id", locationID),
+	)
+
+	id, err := order.ParsePickupLocationID(locationID)
+	if err != nil {
+		return nil, err
+	}
+
+	slots, err := r.OrderSvc.PickupSlots(ctx, id)
+	if err != nil {
+		log.Error("failed to list pickup slots", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]*model.PickupSlot, 0, len(slots))
+	for _, slot := range slots {
+		out = append(out, &model.PickupSlot{Start: slot.Start, End: slot.End})
+	}
+	return out, nil
+}
+
+// AdminDeliverySlots is the resolver for the adminDeliverySlots field.
+func (r *queryResolver) AdminDeliverySlots(ctx context.Context, region *string) ([]*model.DeliverySlot, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "AdminDeliverySlots"),
+	)
+
+	slots, err := r.OrderSvc.AdminDeliverySlots(ctx, region)
+	if err != nil {
+		log.Error("failed to list delivery slots", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]*model.DeliverySlot, 0, len(slots))
+	for _, slot := range slots {
+		out = append(out, order.MapDeliverySlotToGraphQL(slot))
+	}
+	return out, nil
+}
+
+// CurrentPolicies is the resolver for the currentPolicies field.
+func (r *queryResolver) CurrentPolicies(ctx context.Context) ([]*model.Policy, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "CurrentPolicies"),
+	)
+
+	policies, err := r.OrderSvc.CurrentPolicies(ctx)
+	if err != nil {
+		log.Error("failed to list current policies", zap.Error(err))
+		return nil, err
+	}
+
+	return order.MapPoliciesToGraphQL(policies), nil
+}
+
+// PolicyHistory is the resolver for the policyHistory field.
+func (r *queryResolver) PolicyHistory(ctx context.Context, kind model.PolicyKind) ([]*model.Policy, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "PolicyHistory"),
+	)
+
+	policies, err := r.OrderSvc.PolicyHistory(ctx, order.PolicyKind(kind))
+	if err != nil {
+		log.Error("failed to list policy versions", zap.Error(err))
+		return nil, err
+	}
+
+	return order.MapPoliciesToGraphQL(policies), nil
+}
+
+// OrderReasons is the resolver for the orderReasons field.
+func (r *queryResolver) OrderReasons(ctx context.Context, kind *model.OrderReasonKind) ([]*model.OrderReason, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "OrderReasons"),
+	)
+
+	reasons, err := r.OrderSvc.OrderReasons(ctx, (*order.ReasonKind)(kind), false)
+	if err != nil {
+		log.Error("failed to list order reasons", zap.Error(err))
+		return nil, err
+	}
+
+	return order.MapReasonsToGraphQL(reasons), nil
+}
+
+// AdminOrderReasons is the resolver for the adminOrderReasons field.
+func (r *queryResolver) AdminOrderReasons(ctx context.Context, kind *model.OrderReasonKind) ([]*model.OrderReason, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "AdminOrderReasons"),
+	)
+
+	reasons, err := r.OrderSvc.OrderReasons(ctx, (*order.ReasonKind)(kind), true)
+	if err != nil {
+		log.Error("failed to list order reasons", zap.Error(err))
+		return nil, err
+	}
+
+	return order.MapReasonsToGraphQL(reasons), nil
+}
+
+// OrderReasonStats is the resolver for the orderReasonStats field.
+func (r *queryResolver) OrderReasonStats(ctx context.Context, kind model.OrderReasonKind, from time.Time, to time.Time) ([]*model.OrderReasonStat, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "OrderReasonStats"),
+		zap.String("kind", kind.String()),
+	)
+
+	stats, err := r.OrderSvc.OrderReasonStats(ctx, order.ReasonKind(kind), from, to)
+	if err != nil {
+		log.Error("failed to load order reason stats", zap.Error(err))
+		return nil, err
+	}
+
+	return order.MapReasonStatsToGraphQL(stats), nil
+}
+
+// OrderAdjustments is the resolver for the orderAdjustments field.
+func (r *queryResolver) OrderAdjustments(ctx context.Context, orderID string) ([]*model.OrderAdjustment, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "OrderAdjustments"),
+		zap.String("order_id", orderID),
+	)
+
+	oid, err := utils.ToUint(orderID)
+	if err != nil {
+		log.Warn("invalid order id", zap.Error(err))
+		return nil, err
+	}
+
+	adjustments, err := r.OrderSvc.OrderAdjustments(ctx, oid)
+	if err != nil {
+		log.Error("failed to list order adjustments", zap.Error(err))
+		return nil, err
+	}
+
+	return order.MapOrderAdjustmentsToGraphQL(adjustments), nil
+}
@@ -6,6 +6,8 @@ import (
 	"testing"
 	"time"
 	"warimas-be/internal/address"
+	"warimas-be/internal/apperr"
+	"warimas-be/internal/experiment"
 	"warimas-be/internal/graph/model"
 	"warimas-be/internal/order"
 	"warimas-be/internal/payment"
@@ -14,6 +16,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 // --- Mocks ---
@@ -43,8 +46,8 @@ func (m *MockOrderService) UpdateOrderStatus(ctx context.Context, orderID uint,
 	return args.Error(0)
 }
 
-func (m *MockOrderService) MarkAsPaid(ctx context.Context, referenceID, paymentRequestID, paymentProviderID string) error {
-	args := m.Called(ctx, referenceID, paymentRequestID, paymentProviderID)
+func (m *MockOrderService) MarkAsPaid(ctx context.Context, referenceID string, capture payment.Capture) error {
+	args := m.Called(ctx, referenceID, capture)
 	return args.Error(0)
 }
 
@@ -52,6 +55,15 @@ func (m *MockOrderService) MarkAsFailed(ctx context.Context, referenceID, paymen
 	args := m.Called(ctx, referenceID, paymentRequestID, paymentProviderID)
 	return args.Error(0)
 }
+func (m *MockOrderService) ExpireUnpaidOrders(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockOrderService) ExpireSessions(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
 
 func (m *MockOrderService) CreateSession(ctx context.Context, input model.CreateCheckoutSessionInput) (*order.CheckoutSession, error) {
 	args := m.Called(ctx, input)
@@ -61,30 +73,69 @@ func (m *MockOrderService) CreateSession(ctx context.Context, input model.Create
 	return args.Get(0).(*order.CheckoutSession), args.Error(1)
 }
 
-func (m *MockOrderService) UpdateSessionAddress(ctx context.Context, externalID string, addressID string, guestID *string) error {
-	args := m.Called(ctx, externalID, addressID, guestID)
+func (m *MockOrderService) UpdateSessionAddress(ctx context.Context, externalID string, addressID string) error {
+	args := m.Called(ctx, externalID, addressID)
 	return args.Error(0)
 }
 
-func (m *MockOrderService) UpdateSessionPaymentMethod(ctx context.Context, externalID string, paymentMethod payment.ChannelCode, guestID *string) error {
-	args := m.Called(ctx, externalID, paymentMethod, guestID)
+func (m *MockOrderService) UpdateSessionPaymentMethod(ctx context.Context, externalID string, paymentMethod payment.ChannelCode) error {
+	args := m.Called(ctx, externalID, paymentMethod)
 	return args.Error(0)
 }
 
-func (m *MockOrderService) ConfirmSession(ctx context.Context, externalID string) (*string, error) {
+func (m *MockOrderService) ShippingOptions(ctx context.Context, externalID string) ([]*order.ShippingOption, error) {
 	args := m.Called(ctx, externalID)
 	if args.Get(0) == nil {
 		return nil, args.Error(1)
 	}
+	return args.Get(0).([]*order.ShippingOption), args.Error(1)
+}
+
+func (m *MockOrderService) UpdateSessionShippingMethod(ctx context.Context, externalID string, method order.ShippingMethod, pickup *order.PickupChoice, deliverySlotID *int32) (*order.CheckoutSession, error) {
+	args := m.Called(ctx, externalID, method, pickup, deliverySlotID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.CheckoutSession), args.Error(1)
+}
+
+func (m *MockOrderService) UpdateSessionInsurance(ctx context.Context, externalID string, insured bool) (*order.CheckoutSession, error) {
+	args := m.Called(ctx, externalID, insured)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.CheckoutSession), args.Error(1)
+}
+
+func (m *MockOrderService) ApplySessionWallet(ctx context.Context, externalID string, amount int) error {
+	args := m.Called(ctx, externalID, amount)
+	return args.Error(0)
+}
+
+func (m *MockOrderService) ApplySessionCoupon(ctx context.Context, externalID string, code string) (int, error) {
+	args := m.Called(ctx, externalID, code)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockOrderService) ApplySessionPoints(ctx context.Context, externalID string, points int) (int, error) {
+	args := m.Called(ctx, externalID, points)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockOrderService) ConfirmSession(ctx context.Context, externalID string, acceptedPolicyIDs []int32) (*string, error) {
+	args := m.Called(ctx, externalID, acceptedPolicyIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
 	return args.Get(0).(*string), args.Error(1)
 }
 
-func (m *MockOrderService) GetOrders(ctx context.Context, filter *order.OrderFilterInput, sort *order.OrderSortInput, limit, page int32) ([]*order.Order, int64, map[uuid.UUID][]address.Address, error) {
+func (m *MockOrderService) GetOrders(ctx context.Context, filter *order.OrderFilterInput, sort *order.OrderSortInput, limit, page int32) ([]*order.Order, int64, map[uuid.UUID]*address.Address, error) {
 	args := m.Called(ctx, filter, sort, limit, page)
 	if args.Get(0) == nil {
 		return nil, 0, nil, args.Error(3)
 	}
-	return args.Get(0).([]*order.Order), args.Get(1).(int64), args.Get(2).(map[uuid.UUID][]address.Address), args.Error(3)
+	return args.Get(0).([]*order.Order), args.Get(1).(int64), args.Get(2).(map[uuid.UUID]*address.Address), args.Error(3)
 }
 
 func (m *MockOrderService) GetOrderDetail(ctx context.Context, orderID uint) (*order.Order, *address.Address, error) {
@@ -127,8 +178,236 @@ func (m *MockOrderService) GetOrderForWebhook(ctx context.Context, externalID st
 	return args.Get(0).(*order.Order), args.Error(1)
 }
 
+func (m *MockOrderService) CreateAdminOrder(ctx context.Context, input model.CreateAdminOrderInput) (*order.Order, *payment.PaymentResponse, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*order.Order), args.Get(1).(*payment.PaymentResponse), args.Error(2)
+}
+
+func (m *MockOrderService) GetActiveSession(ctx context.Context) (*order.CheckoutSession, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.CheckoutSession), args.Error(1)
+}
+
+func (m *MockOrderService) ListSessionEvents(ctx context.Context, externalID string) ([]*order.SessionEvent, error) {
+	args := m.Called(ctx, externalID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*order.SessionEvent), args.Error(1)
+}
+
+func (m *MockOrderService) UpdateSessionItemQuantity(ctx context.Context, externalID, itemID string, quantity int) (*order.CheckoutSession, error) {
+	args := m.Called(ctx, externalID, itemID, quantity)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.CheckoutSession), args.Error(1)
+}
+
+func (m *MockOrderService) RemoveSessionItem(ctx context.Context, externalID, itemID string) (*order.CheckoutSession, error) {
+	args := m.Called(ctx, externalID, itemID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.CheckoutSession), args.Error(1)
+}
+
+func (m *MockOrderService) CheckoutRules(ctx context.Context, all bool) ([]*order.CheckoutRule, error) {
+	args := m.Called(ctx, all)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*order.CheckoutRule), args.Error(1)
+}
+
+func (m *MockOrderService) SetCheckoutRule(ctx context.Context, input model.SetCheckoutRuleInput) (*order.CheckoutRule, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.CheckoutRule), args.Error(1)
+}
+
+func (m *MockOrderService) PickupLocations(ctx context.Context, all bool) ([]*order.PickupLocation, error) {
+	args := m.Called(ctx, all)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*order.PickupLocation), args.Error(1)
+}
+
+func (m *MockOrderService) SetPickupLocation(ctx context.Context, input model.SetPickupLocationInput) (*order.PickupLocation, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.PickupLocation), args.Error(1)
+}
+
+func (m *MockOrderService) PickupSlots(ctx context.Context, locationID int32) ([]order.PickupSlot, error) {
+	args := m.Called(ctx, locationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]order.PickupSlot), args.Error(1)
+}
+
+func (m *MockOrderService) VerifyPickupCode(ctx context.Context, orderID uint, code string) error {
+	args := m.Called(ctx, orderID, code)
+	return args.Error(0)
+}
+
+func (m *MockOrderService) DeliverySlots(ctx context.Context, externalID string) ([]*order.DeliverySlotOption, error) {
+	args := m.Called(ctx, externalID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*order.DeliverySlotOption), args.Error(1)
+}
+
+func (m *MockOrderService) AdminDeliverySlots(ctx context.Context, region *string) ([]*order.DeliverySlot, error) {
+	args := m.Called(ctx, region)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*order.DeliverySlot), args.Error(1)
+}
+
+func (m *MockOrderService) SetDeliverySlot(ctx context.Context, input model.SetDeliverySlotInput) (*order.DeliverySlot, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.DeliverySlot), args.Error(1)
+}
+
+func (m *MockOrderService) CurrentPolicies(ctx context.Context) ([]*order.Policy, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*order.Policy), args.Error(1)
+}
+
+func (m *MockOrderService) PolicyHistory(ctx context.Context, kind order.PolicyKind) ([]*order.Policy, error) {
+	args := m.Called(ctx, kind)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*order.Policy), args.Error(1)
+}
+
+func (m *MockOrderService) PublishPolicy(ctx context.Context, input model.PublishPolicyInput) (*order.Policy, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.Policy), args.Error(1)
+}
+
+func (m *MockOrderService) CancelOrder(ctx context.Context, orderID uint, reasonID int32, note *string) error {
+	args := m.Called(ctx, orderID, reasonID, note)
+	return args.Error(0)
+}
+
+func (m *MockOrderService) OrderReasons(ctx context.Context, kind *order.ReasonKind, all bool) ([]*order.Reason, error) {
+	args := m.Called(ctx, kind, all)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*order.Reason), args.Error(1)
+}
+
+func (m *MockOrderService) SetOrderReason(ctx context.Context, input model.SetOrderReasonInput) (*order.Reason, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.Reason), args.Error(1)
+}
+
+func (m *MockOrderService) OrderReasonStats(ctx context.Context, kind order.ReasonKind, from, to time.Time) ([]*order.ReasonStat, error) {
+	args := m.Called(ctx, kind, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*order.ReasonStat), args.Error(1)
+}
+
+func (m *MockOrderService) RequestOrderAdjustment(ctx context.Context, input model.RequestOrderAdjustmentInput) (*order.OrderAdjustment, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.OrderAdjustment), args.Error(1)
+}
+
+func (m *MockOrderService) ApproveOrderAdjustment(ctx context.Context, id int64) (*order.OrderAdjustment, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.OrderAdjustment), args.Error(1)
+}
+
+func (m *MockOrderService) RejectOrderAdjustment(ctx context.Context, id int64) (*order.OrderAdjustment, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.OrderAdjustment), args.Error(1)
+}
+
+func (m *MockOrderService) OrderAdjustments(ctx context.Context, orderID uint) ([]*order.OrderAdjustment, error) {
+	args := m.Called(ctx, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*order.OrderAdjustment), args.Error(1)
+}
+
 // --- Tests ---
 
+type MockExperimentService struct {
+	mock.Mock
+}
+
+func (m *MockExperimentService) MyAssignments(ctx context.Context) ([]experiment.Assignment, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]experiment.Assignment), args.Error(1)
+}
+
+func (m *MockExperimentService) TagOrder(ctx context.Context, orderExternalID string) error {
+	args := m.Called(ctx, orderExternalID)
+	return args.Error(0)
+}
+
+func (m *MockExperimentService) List(ctx context.Context) ([]*experiment.Experiment, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*experiment.Experiment), args.Error(1)
+}
+
+func (m *MockExperimentService) Create(ctx context.Context, in experiment.CreateInput) (*experiment.Experiment, error) {
+	args := m.Called(ctx, in)
+	return args.Get(0).(*experiment.Experiment), args.Error(1)
+}
+
+func (m *MockExperimentService) End(ctx context.Context, key string) (*experiment.Experiment, error) {
+	args := m.Called(ctx, key)
+	return args.Get(0).(*experiment.Experiment), args.Error(1)
+}
+
+func (m *MockExperimentService) Results(ctx context.Context, key string) ([]*experiment.VariantResult, error) {
+	args := m.Called(ctx, key)
+	return args.Get(0).([]*experiment.VariantResult), args.Error(1)
+}
+
 func TestMutationResolver_CreateCheckoutSession(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		mockSvc := new(MockOrderService)
@@ -180,14 +459,16 @@ func TestMutationResolver_CreateCheckoutSession(t *testing.T) {
 func TestMutationResolver_ConfirmCheckoutSession(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		mockSvc := new(MockOrderService)
-		resolver := &Resolver{OrderSvc: mockSvc}
+		mockExperiments := new(MockExperimentService)
+		resolver := &Resolver{OrderSvc: mockSvc, ExperimentSvc: mockExperiments}
 		mr := &mutationResolver{resolver}
 
 		ctx := context.Background()
 		input := model.ConfirmCheckoutSessionInput{ExternalID: "sess_123"}
 		orderExtID := "ord_123"
 
-		mockSvc.On("ConfirmSession", ctx, "sess_123").Return(&orderExtID, nil)
+		mockSvc.On("ConfirmSession", ctx, "sess_123", []int32{}).Return(&orderExtID, nil)
+		mockExperiments.On("TagOrder", ctx, "ord_123").Return(nil)
 
 		res, err := mr.ConfirmCheckoutSession(ctx, input)
 
@@ -195,6 +476,26 @@ func TestMutationResolver_ConfirmCheckoutSession(t *testing.T) {
 		assert.True(t, res.Success)
 		assert.Equal(t, "ord_123", res.OrderExternalID)
 		mockSvc.AssertExpectations(t)
+		mockExperiments.AssertExpectations(t)
+	})
+
+	t.Run("TaggingFailureKeepsOrder", func(t *testing.T) {
+		mockSvc := new(MockOrderService)
+		mockExperiments := new(MockExperimentService)
+		resolver := &Resolver{OrderSvc: mockSvc, ExperimentSvc: mockExperiments}
+		mr := &mutationResolver{resolver}
+
+		ctx := context.Background()
+		input := model.ConfirmCheckoutSessionInput{ExternalID: "sess_123"}
+		orderExtID := "ord_123"
+
+		mockSvc.On("ConfirmSession", ctx, "sess_123", []int32{}).Return(&orderExtID, nil)
+		mockExperiments.On("TagOrder", ctx, "ord_123").Return(errors.New("db error"))
+
+		res, err := mr.ConfirmCheckoutSession(ctx, input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "ord_123", res.OrderExternalID)
 	})
 
 	t.Run("ServiceError", func(t *testing.T) {
@@ -204,7 +505,7 @@ func TestMutationResolver_ConfirmCheckoutSession(t *testing.T) {
 
 		ctx := context.Background()
 		input := model.ConfirmCheckoutSessionInput{ExternalID: "sess_123"}
-		mockSvc.On("ConfirmSession", ctx, "sess_123").Return(nil, errors.New("db error"))
+		mockSvc.On("ConfirmSession", ctx, "sess_123", []int32{}).Return(nil, errors.New("db error"))
 		_, err := mr.ConfirmCheckoutSession(ctx, input)
 		assert.Error(t, err)
 	})
@@ -219,7 +520,7 @@ func TestMutationResolver_UpdateSessionAddress(t *testing.T) {
 		ctx := context.Background()
 		input := model.UpdateSessionAddressInput{ExternalID: "sess_123", AddressID: "addr_1"}
 
-		mockSvc.On("UpdateSessionAddress", ctx, "sess_123", "addr_1", (*string)(nil)).Return(nil)
+		mockSvc.On("UpdateSessionAddress", ctx, "sess_123", "addr_1").Return(nil)
 
 		res, err := mr.UpdateSessionAddress(ctx, input)
 
@@ -234,7 +535,7 @@ func TestMutationResolver_UpdateSessionAddress(t *testing.T) {
 
 		ctx := context.Background()
 		input := model.UpdateSessionAddressInput{ExternalID: "sess_123", AddressID: "addr_1"}
-		mockSvc.On("UpdateSessionAddress", ctx, "sess_123", "addr_1", (*string)(nil)).Return(errors.New("db error"))
+		mockSvc.On("UpdateSessionAddress", ctx, "sess_123", "addr_1").Return(errors.New("db error"))
 		_, err := mr.UpdateSessionAddress(ctx, input)
 		assert.Error(t, err)
 	})
@@ -249,7 +550,7 @@ func TestMutationResolver_UpdateSessionPaymentMethod(t *testing.T) {
 		ctx := context.Background()
 		input := model.UpdateSessionPaymentMethodInput{ExternalID: "sess_123", PaymentMethod: "BCA_VIRTUAL_ACCOUNT"}
 
-		mockSvc.On("UpdateSessionPaymentMethod", ctx, "sess_123", payment.ChannelCode("BCA_VIRTUAL_ACCOUNT"), (*string)(nil)).Return(nil)
+		mockSvc.On("UpdateSessionPaymentMethod", ctx, "sess_123", payment.ChannelCode("BCA_VIRTUAL_ACCOUNT")).Return(nil)
 
 		res, err := mr.UpdateSessionPaymentMethod(ctx, input)
 
@@ -279,6 +580,29 @@ func TestMutationResolver_UpdateOrderStatus(t *testing.T) {
 		mockSvc.AssertExpectations(t)
 	})
 
+	t.Run("CancelGoesThroughReason", func(t *testing.T) {
+		mockSvc := new(MockOrderService)
+		resolver := &Resolver{OrderSvc: mockSvc}
+		mr := &mutationResolver{resolver}
+
+		ctx := context.Background()
+		note := "customer asked by phone"
+		input := model.UpdateOrderStatusInput{
+			OrderID:    "10",
+			Status:     model.OrderStatusCancelled,
+			ReasonID:   utils.StrPtr("2"),
+			ReasonNote: &note,
+		}
+
+		mockSvc.On("CancelOrder", ctx, uint(10), int32(2), &note).Return(nil)
+
+		res, err := mr.UpdateOrderStatus(ctx, input)
+
+		assert.NoError(t, err)
+		assert.True(t, res.Success)
+		mockSvc.AssertNotCalled(t, "UpdateOrderStatus", mock.Anything, mock.Anything, mock.Anything)
+	})
+
 	t.Run("InvalidID", func(t *testing.T) {
 		mockSvc := new(MockOrderService)
 		resolver := &Resolver{OrderSvc: mockSvc}
@@ -304,29 +628,47 @@ func TestMutationResolver_UpdateOrderStatus(t *testing.T) {
 	})
 }
 
-func TestMutationResolver_CreateOrderFromSession(t *testing.T) {
-	t.Run("Forbidden_ExternalRequest", func(t *testing.T) {
-		mockSvc := new(MockOrderService)
-		resolver := &Resolver{OrderSvc: mockSvc}
-		mr := &mutationResolver{resolver}
+// internalCtx is a request from an internal service allowed to create
+// orders from sessions.
+func internalCtx() context.Context {
+	return utils.WithServicePrincipal(context.Background(), &utils.ServicePrincipal{
+		KeyID:  1,
+		Name:   "checkout-worker",
+		Scopes: []string{string(model.APIKeyScopeOrdersCreateFromSession)},
+	})
+}
 
-		// Context without internal flag
-		ctx := context.Background()
-		input := model.CreateOrderFromSessionInput{ExternalID: "sess_123"}
+func TestScopeDirective(t *testing.T) {
+	next := func(ctx context.Context) (any, error) { return "ok", nil }
+	scope := model.APIKeyScopeOrdersCreateFromSession
 
-		_, err := mr.CreateOrderFromSession(ctx, input)
+	t.Run("Rejects users", func(t *testing.T) {
+		ctx := utils.SetUserContext(context.Background(), 1, "admin@example.com", "ADMIN")
+		_, err := ScopeDirective(ctx, nil, next, scope)
+		assert.True(t, apperr.Is(err, apperr.CodeUnauthenticated))
+	})
 
-		assert.Error(t, err)
-		assert.Equal(t, "forbidden", err.Error())
+	t.Run("Rejects keys without the scope", func(t *testing.T) {
+		ctx := utils.WithServicePrincipal(context.Background(), &utils.ServicePrincipal{KeyID: 2, Name: "reporting"})
+		_, err := ScopeDirective(ctx, nil, next, scope)
+		assert.EqualError(t, err, "forbidden: missing scope ORDERS_CREATE_FROM_SESSION")
 	})
 
+	t.Run("Allows keys with the scope", func(t *testing.T) {
+		res, err := ScopeDirective(internalCtx(), nil, next, scope)
+		assert.NoError(t, err)
+		assert.Equal(t, "ok", res)
+	})
+}
+
+func TestMutationResolver_CreateOrderFromSession(t *testing.T) {
+
 	t.Run("Success", func(t *testing.T) {
 		mockSvc := new(MockOrderService)
 		resolver := &Resolver{OrderSvc: mockSvc}
 		mr := &mutationResolver{resolver}
 
-		// Mock internal request (ensure utils.SetInternalContext exists or implement it)
-		ctx := utils.SetInternalContext(context.Background())
+		ctx := internalCtx()
 		input := model.CreateOrderFromSessionInput{ExternalID: "sess_123"}
 
 		userID := int32(1)
@@ -353,7 +695,7 @@ func TestMutationResolver_CreateOrderFromSession(t *testing.T) {
 		resolver := &Resolver{OrderSvc: mockSvc}
 		mr := &mutationResolver{resolver}
 
-		ctx := utils.SetInternalContext(context.Background())
+		ctx := internalCtx()
 		input := model.CreateOrderFromSessionInput{ExternalID: "sess_123"}
 
 		mockSvc.On("CreateFromSession", ctx, "sess_123").Return(nil, errors.New("db error"))
@@ -380,8 +722,8 @@ func TestQueryResolver_OrderList(t *testing.T) {
 			UpdatedAt: now,
 		}}
 		expectedTotal := int64(1)
-		addrMap := map[uuid.UUID][]address.Address{
-			addrID: {{ID: addrID, Address1: "Street 1"}},
+		addrMap := map[uuid.UUID]*address.Address{
+			addrID: {ID: addrID, Address1: "Street 1"},
 		}
 
 		mockSvc.On("GetOrders", ctx, mock.Anything, mock.Anything, int32(20), int32(1)).
@@ -410,7 +752,7 @@ func TestQueryResolver_OrderList(t *testing.T) {
 		mockSvc.On("GetOrders", ctx, mock.MatchedBy(func(f *order.OrderFilterInput) bool {
 			return *f.Status == order.OrderStatusPaid && *f.Search == "ORD-123"
 		}), mock.Anything, int32(20), int32(1)).
-			Return([]*order.Order{}, int64(0), map[uuid.UUID][]address.Address{}, nil)
+			Return([]*order.Order{}, int64(0), map[uuid.UUID]*address.Address{}, nil)
 
 		res, err := qr.OrderList(ctx, filter, nil, nil)
 
@@ -433,7 +775,7 @@ func TestQueryResolver_OrderList(t *testing.T) {
 		mockSvc.On("GetOrders", ctx, mock.Anything, mock.MatchedBy(func(s *order.OrderSortInput) bool {
 			return s.Field == order.OrderSortFieldTotal && s.Direction == order.SortDirectionAsc
 		}), int32(20), int32(1)).
-			Return([]*order.Order{}, int64(0), map[uuid.UUID][]address.Address{}, nil)
+			Return([]*order.Order{}, int64(0), map[uuid.UUID]*address.Address{}, nil)
 
 		res, err := qr.OrderList(ctx, nil, sortInput, nil)
 
@@ -458,7 +800,7 @@ func TestQueryResolver_OrderList(t *testing.T) {
 			UpdatedAt: now,
 		}}
 		// Empty address map simulating missing address data
-		addrMap := map[uuid.UUID][]address.Address{}
+		addrMap := map[uuid.UUID]*address.Address{}
 
 		mockSvc.On("GetOrders", ctx, mock.Anything, mock.Anything, int32(20), int32(1)).
 			Return(expectedOrders, int64(1), addrMap, nil)
@@ -609,6 +951,10 @@ func TestQueryResolver_PaymentOrderInfo(t *testing.T) {
 			Payment: order.PaymentDetail{
 				Method: "BCA",
 			},
+			Timeline: []payment.TimelineEntry{
+				{Event: payment.TimelineCreated, At: time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC), Amount: 10000},
+				{Event: payment.TimelineVAIssued, At: time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)},
+			},
 		}
 
 		mockSvc.On("GetPaymentOrderInfo", ctx, extID).Return(expectedInfo, nil)
@@ -618,6 +964,11 @@ func TestQueryResolver_PaymentOrderInfo(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, extID, res.OrderExternalID)
 		assert.Equal(t, int32(10000), res.TotalAmount)
+		require.Len(t, res.Timeline, 2)
+		assert.Equal(t, model.PaymentTimelineEventCreated, res.Timeline[0].Event)
+		assert.Equal(t, int32(10000), *res.Timeline[0].Amount)
+		assert.Equal(t, model.PaymentTimelineEventVaIssued, res.Timeline[1].Event)
+		assert.Nil(t, res.Timeline[1].Amount)
 	})
 
 	t.Run("ServiceError", func(t *testing.T) {
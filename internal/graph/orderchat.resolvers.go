@@ -0,0 +1,119 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"strconv"
+	"warimas-be/internal/graph/model"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/orderchat"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+// SendOrderMessage is the resolver for the sendOrderMessage field.
+func (r *mutationResolver) SendOrderMessage(ctx context.Context, orderID string, body string, attachmentIds []string) (*model.OrderMessage, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "SendOrderMessage"),
+		zap.String("order_id", orderID),
+	)
+
+	oid, err := utils.ToUint(orderID)
+	if err != nil {
+		log.Warn("invalid order id", zap.Error(err))
+		return nil, err
+	}
+
+	m, err := r.OrderChatSvc.Send(ctx, int32(oid), body, attachmentIds)
+	if err != nil {
+		log.Error("failed to send order message", zap.Error(err))
+		return nil, err
+	}
+
+	return orderchat.MapMessageToGraphQL(m), nil
+}
+
+// MarkOrderMessagesRead is the resolver for the markOrderMessagesRead field.
+func (r *mutationResolver) MarkOrderMessagesRead(ctx context.Context, orderID string) (bool, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "MarkOrderMessagesRead"),
+		zap.String("order_id", orderID),
+	)
+
+	oid, err := utils.ToUint(orderID)
+	if err != nil {
+		log.Warn("invalid order id", zap.Error(err))
+		return false, err
+	}
+
+	if err := r.OrderChatSvc.MarkRead(ctx, int32(oid)); err != nil {
+		log.Error("failed to mark order messages read", zap.Error(err))
+		return false, err
+	}
+
+	return true, nil
+}
+
+// OrderMessages is the resolver for the orderMessages field.
+func (r *queryResolver) OrderMessages(ctx context.Context, orderID string, before *string, limit *int32) (*model.OrderMessageThread, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "OrderMessages"),
+		zap.String("order_id", orderID),
+	)
+
+	oid, err := utils.ToUint(orderID)
+	if err != nil {
+		log.Warn("invalid order id", zap.Error(err))
+		return nil, err
+	}
+
+	var beforeID *int64
+	if before != nil {
+		id, err := strconv.ParseInt(*before, 10, 64)
+		if err != nil {
+			log.Warn("invalid before cursor", zap.Error(err))
+			return nil, err
+		}
+		beforeID = &id
+	}
+
+	var l int32
+	if limit != nil {
+		l = *limit
+	}
+
+	t, err := r.OrderChatSvc.Thread(ctx, int32(oid), beforeID, l)
+	if err != nil {
+		log.Error("failed to get order messages", zap.Error(err))
+		return nil, err
+	}
+
+	return orderchat.MapThreadToGraphQL(t), nil
+}
+
+// UnreadOrderMessages is the resolver for the unreadOrderMessages field.
+func (r *queryResolver) UnreadOrderMessages(ctx context.Context, limit *int32) ([]*model.OrderMessageUnread, error) {
+	var l int32
+	if limit != nil {
+		l = *limit
+	}
+
+	threads, err := r.OrderChatSvc.Unread(ctx, l)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to list unread order messages", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]*model.OrderMessageUnread, 0, len(threads))
+	for _, t := range threads {
+		out = append(out, orderchat.MapUnreadToGraphQL(t))
+	}
+	return out, nil
+}
@@ -39,7 +39,7 @@ func (ec *executionContext) _Package_id(ctx context.Context, field graphql.Colle
 			return obj.ID, nil
 		},
 		nil,
-		ec.marshalNID2string,
+		ec.marshalNUUID2string,
 		true,
 		true,
 	)
@@ -52,7 +52,7 @@ func (ec *executionContext) fieldContext_Package_id(_ context.Context, field gra
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type UUID does not have child fields")
 		},
 	}
 	return fc, nil
@@ -262,7 +262,7 @@ func (ec *executionContext) _Package_createdAt(ctx context.Context, field graphq
 			return obj.CreatedAt, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNTime2timeᚐTime,
 		true,
 		true,
 	)
@@ -275,7 +275,7 @@ func (ec *executionContext) fieldContext_Package_createdAt(_ context.Context, fi
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
@@ -291,7 +291,7 @@ func (ec *executionContext) _Package_updatedAt(ctx context.Context, field graphq
 			return obj.UpdatedAt, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNTime2timeᚐTime,
 		true,
 		true,
 	)
@@ -304,360 +304,362 @@ func (ec *executionContext) fieldContext_Package_updatedAt(_ context.Context, fi
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PackageItem_id(ctx context.Context, field graphql.CollectedField, obj *model.PackageItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _PackageConnection_items(ctx context.Context, field graphql.CollectedField, obj *model.PackageConnection) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PackageItem_id,
+		ec.fieldContext_PackageConnection_items,
 		func(ctx context.Context) (any, error) {
-			return obj.ID, nil
+			return obj.Items, nil
 		},
 		nil,
-		ec.marshalNID2string,
+		ec.marshalNPackage2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐPackageᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PackageItem_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PackageConnection_items(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PackageItem",
+		Object:     "PackageConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Package_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Package_name(ctx, field)
+			case "imageUrl":
+				return ec.fieldContext_Package_imageUrl(ctx, field)
+			case "userId":
+				return ec.fieldContext_Package_userId(ctx, field)
+			case "items":
+				return ec.fieldContext_Package_items(ctx, field)
+			case "type":
+				return ec.fieldContext_Package_type(ctx, field)
+			case "isActive":
+				return ec.fieldContext_Package_isActive(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Package_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Package_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Package", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PackageItem_packageId(ctx context.Context, field graphql.CollectedField, obj *model.PackageItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _PackageConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.PackageConnection) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PackageItem_packageId,
+		ec.fieldContext_PackageConnection_pageInfo,
 		func(ctx context.Context) (any, error) {
-			return obj.PackageID, nil
+			return obj.PageInfo, nil
 		},
 		nil,
-		ec.marshalNID2string,
+		ec.marshalNPageInfo2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐPageInfo,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PackageItem_packageId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PackageConnection_pageInfo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PackageItem",
+		Object:     "PackageConnection",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			switch field.Name {
+			case "totalItems":
+				return ec.fieldContext_PageInfo_totalItems(ctx, field)
+			case "totalPages":
+				return ec.fieldContext_PageInfo_totalPages(ctx, field)
+			case "page":
+				return ec.fieldContext_PageInfo_page(ctx, field)
+			case "limit":
+				return ec.fieldContext_PageInfo_limit(ctx, field)
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "hasPreviousPage":
+				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PackageItem_variantId(ctx context.Context, field graphql.CollectedField, obj *model.PackageItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _PackageItem_id(ctx context.Context, field graphql.CollectedField, obj *model.PackageItem) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PackageItem_variantId,
+		ec.fieldContext_PackageItem_id,
 		func(ctx context.Context) (any, error) {
-			return obj.VariantID, nil
+			return obj.ID, nil
 		},
 		nil,
-		ec.marshalNID2string,
+		ec.marshalNUUID2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PackageItem_variantId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PackageItem_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "PackageItem",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type UUID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PackageItem_imageUrl(ctx context.Context, field graphql.CollectedField, obj *model.PackageItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _PackageItem_packageId(ctx context.Context, field graphql.CollectedField, obj *model.PackageItem) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PackageItem_imageUrl,
+		ec.fieldContext_PackageItem_packageId,
 		func(ctx context.Context) (any, error) {
-			return obj.ImageURL, nil
+			return obj.PackageID, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNUUID2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PackageItem_imageUrl(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PackageItem_packageId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "PackageItem",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type UUID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PackageItem_name(ctx context.Context, field graphql.CollectedField, obj *model.PackageItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _PackageItem_variantId(ctx context.Context, field graphql.CollectedField, obj *model.PackageItem) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PackageItem_name,
+		ec.fieldContext_PackageItem_variantId,
 		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
+			return obj.VariantID, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNUUID2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PackageItem_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PackageItem_variantId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "PackageItem",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type UUID does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PackageItem_price(ctx context.Context, field graphql.CollectedField, obj *model.PackageItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _PackageItem_imageUrl(ctx context.Context, field graphql.CollectedField, obj *model.PackageItem) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PackageItem_price,
+		ec.fieldContext_PackageItem_imageUrl,
 		func(ctx context.Context) (any, error) {
-			return obj.Price, nil
+			return obj.ImageURL, nil
 		},
 		nil,
-		ec.marshalNFloat2float64,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PackageItem_price(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PackageItem_imageUrl(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "PackageItem",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PackageItem_quantity(ctx context.Context, field graphql.CollectedField, obj *model.PackageItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _PackageItem_name(ctx context.Context, field graphql.CollectedField, obj *model.PackageItem) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PackageItem_quantity,
+		ec.fieldContext_PackageItem_name,
 		func(ctx context.Context) (any, error) {
-			return obj.Quantity, nil
+			return obj.Name, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PackageItem_quantity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PackageItem_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "PackageItem",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PackageItem_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.PackageItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _PackageItem_price(ctx context.Context, field graphql.CollectedField, obj *model.PackageItem) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PackageItem_createdAt,
+		ec.fieldContext_PackageItem_price,
 		func(ctx context.Context) (any, error) {
-			return obj.CreatedAt, nil
+			return obj.Price, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNDecimal2float64,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PackageItem_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PackageItem_price(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "PackageItem",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Decimal does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PackageItem_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.PackageItem) (ret graphql.Marshaler) {
+func (ec *executionContext) _PackageItem_quantity(ctx context.Context, field graphql.CollectedField, obj *model.PackageItem) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PackageItem_updatedAt,
+		ec.fieldContext_PackageItem_quantity,
 		func(ctx context.Context) (any, error) {
-			return obj.UpdatedAt, nil
+			return obj.Quantity, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNInt2int32,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PackageItem_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PackageItem_quantity(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "PackageItem",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PackageListResponse_items(ctx context.Context, field graphql.CollectedField, obj *model.PackageListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _PackageItem_createdAt(ctx context.Context, field graphql.CollectedField, obj *model.PackageItem) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PackageListResponse_items,
+		ec.fieldContext_PackageItem_createdAt,
 		func(ctx context.Context) (any, error) {
-			return obj.Items, nil
+			return obj.CreatedAt, nil
 		},
 		nil,
-		ec.marshalNPackage2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐPackageᚄ,
+		ec.marshalNTime2timeᚐTime,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PackageListResponse_items(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PackageItem_createdAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PackageListResponse",
+		Object:     "PackageItem",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "id":
-				return ec.fieldContext_Package_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Package_name(ctx, field)
-			case "imageUrl":
-				return ec.fieldContext_Package_imageUrl(ctx, field)
-			case "userId":
-				return ec.fieldContext_Package_userId(ctx, field)
-			case "items":
-				return ec.fieldContext_Package_items(ctx, field)
-			case "type":
-				return ec.fieldContext_Package_type(ctx, field)
-			case "isActive":
-				return ec.fieldContext_Package_isActive(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Package_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Package_updatedAt(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type Package", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _PackageListResponse_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.PackageListResponse) (ret graphql.Marshaler) {
+func (ec *executionContext) _PackageItem_updatedAt(ctx context.Context, field graphql.CollectedField, obj *model.PackageItem) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_PackageListResponse_pageInfo,
+		ec.fieldContext_PackageItem_updatedAt,
 		func(ctx context.Context) (any, error) {
-			return obj.PageInfo, nil
+			return obj.UpdatedAt, nil
 		},
 		nil,
-		ec.marshalNPageInfo2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐPageInfo,
+		ec.marshalNTime2timeᚐTime,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_PackageListResponse_pageInfo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_PackageItem_updatedAt(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "PackageListResponse",
+		Object:     "PackageItem",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "totalItems":
-				return ec.fieldContext_PageInfo_totalItems(ctx, field)
-			case "totalPages":
-				return ec.fieldContext_PageInfo_totalPages(ctx, field)
-			case "page":
-				return ec.fieldContext_PageInfo_page(ctx, field)
-			case "limit":
-				return ec.fieldContext_PageInfo_limit(ctx, field)
-			case "hasNextPage":
-				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
-			case "hasPreviousPage":
-				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
@@ -724,7 +726,7 @@ func (ec *executionContext) unmarshalInputAddPackageItemInput(ctx context.Contex
 		switch k {
 		case "variantId":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("variantId"))
-			data, err := ec.unmarshalNID2string(ctx, v)
+			data, err := ec.unmarshalNUUID2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
@@ -758,7 +760,7 @@ func (ec *executionContext) unmarshalInputPackageFilterInput(ctx context.Context
 		switch k {
 		case "id":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-			data, err := ec.unmarshalOID2ᚖstring(ctx, v)
+			data, err := ec.unmarshalOUUID2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
@@ -902,6 +904,50 @@ func (ec *executionContext) _Package(ctx context.Context, sel ast.SelectionSet,
 	return out
 }
 
+var packageConnectionImplementors = []string{"PackageConnection"}
+
+func (ec *executionContext) _PackageConnection(ctx context.Context, sel ast.SelectionSet, obj *model.PackageConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, packageConnectionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("PackageConnection")
+		case "items":
+			out.Values[i] = ec._PackageConnection_items(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "pageInfo":
+			out.Values[i] = ec._PackageConnection_pageInfo(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var packageItemImplementors = []string{"PackageItem"}
 
 func (ec *executionContext) _PackageItem(ctx context.Context, sel ast.SelectionSet, obj *model.PackageItem) graphql.Marshaler {
@@ -981,50 +1027,6 @@ func (ec *executionContext) _PackageItem(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
-var packageListResponseImplementors = []string{"PackageListResponse"}
-
-func (ec *executionContext) _PackageListResponse(ctx context.Context, sel ast.SelectionSet, obj *model.PackageListResponse) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, packageListResponseImplementors)
-
-	out := graphql.NewFieldSet(fields)
-	deferred := make(map[string]*graphql.FieldSet)
-	for i, field := range fields {
-		switch field.Name {
-		case "__typename":
-			out.Values[i] = graphql.MarshalString("PackageListResponse")
-		case "items":
-			out.Values[i] = ec._PackageListResponse_items(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "pageInfo":
-			out.Values[i] = ec._PackageListResponse_pageInfo(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		default:
-			panic("unknown field " + strconv.Quote(field.Name))
-		}
-	}
-	out.Dispatch(ctx)
-	if out.Invalids > 0 {
-		return graphql.Null
-	}
-
-	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
-
-	for label, dfs := range deferred {
-		ec.processDeferredGroup(graphql.DeferredGroup{
-			Label:    label,
-			Path:     graphql.GetPath(ctx),
-			FieldSet: dfs,
-			Context:  ctx,
-		})
-	}
-
-	return out
-}
-
 // endregion **************************** object.gotpl ****************************
 
 // region    ***************************** type.gotpl *****************************
@@ -1112,6 +1114,20 @@ func (ec *executionContext) marshalNPackage2ᚖwarimasᚑbeᚋinternalᚋgraph
 	return ec._Package(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNPackageConnection2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐPackageConnection(ctx context.Context, sel ast.SelectionSet, v model.PackageConnection) graphql.Marshaler {
+	return ec._PackageConnection(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNPackageConnection2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐPackageConnection(ctx context.Context, sel ast.SelectionSet, v *model.PackageConnection) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._PackageConnection(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNPackageItem2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐPackageItemᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.PackageItem) graphql.Marshaler {
 	ret := make(graphql.Array, len(v))
 	var wg sync.WaitGroup
@@ -1166,20 +1182,6 @@ func (ec *executionContext) marshalNPackageItem2ᚖwarimasᚑbeᚋinternalᚋgra
 	return ec._PackageItem(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNPackageListResponse2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐPackageListResponse(ctx context.Context, sel ast.SelectionSet, v model.PackageListResponse) graphql.Marshaler {
-	return ec._PackageListResponse(ctx, sel, &v)
-}
-
-func (ec *executionContext) marshalNPackageListResponse2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐPackageListResponse(ctx context.Context, sel ast.SelectionSet, v *model.PackageListResponse) graphql.Marshaler {
-	if v == nil {
-		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
-			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
-		}
-		return graphql.Null
-	}
-	return ec._PackageListResponse(ctx, sel, v)
-}
-
 func (ec *executionContext) unmarshalNPackageSortField2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐPackageSortField(ctx context.Context, v any) (model.PackageSortField, error) {
 	var res model.PackageSortField
 	err := res.UnmarshalGQL(v)
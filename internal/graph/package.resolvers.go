@@ -53,7 +53,7 @@ func (r *mutationResolver) AddPackage(ctx context.Context, input model.AddPackag
 }
 
 // Packages is the resolver for the packages field.
-func (r *queryResolver) Packages(ctx context.Context, filter *model.PackageFilterInput, sort *model.PackageSortInput, limit *int32, page *int32) (*model.PackageListResponse, error) {
+func (r *queryResolver) Packages(ctx context.Context, filter *model.PackageFilterInput, sort *model.PackageSortInput, limit *int32, page *int32, after *string) (*model.PackageConnection, error) {
 	log := logger.FromCtx(ctx).With(
 		zap.String("layer", "resolver"),
 		zap.String("method", "Packages"),
@@ -86,6 +86,11 @@ func (r *queryResolver) Packages(ctx context.Context, filter *model.PackageFilte
 	if page != nil {
 		p = int32(*page)
 	}
+	p, err := pageAfter(after, p, l)
+	if err != nil {
+		log.Warn("invalid cursor", zap.Error(err))
+		return nil, err
+	}
 
 	log.Info("resolver started", zap.Int32("limit", l), zap.Int32("page", p))
 
@@ -100,19 +105,10 @@ func (r *queryResolver) Packages(ctx context.Context, filter *model.PackageFilte
 		items[i] = packages.MapPackageToGraphQL(pkg)
 	}
 
-	totalPages := int32((total + int64(l) - 1) / int64(l))
-
 	log.Info("resolver success", zap.Int("count", len(items)), zap.Int64("total", total))
 
-	return &model.PackageListResponse{
-		Items: items,
-		PageInfo: &model.PageInfo{
-			TotalItems:      int32(total),
-			TotalPages:      totalPages,
-			Page:            p,
-			Limit:           l,
-			HasNextPage:     p < totalPages,
-			HasPreviousPage: p > 1,
-		},
+	return &model.PackageConnection{
+		Items:    items,
+		PageInfo: newPageInfo(total, p, l),
 	}, nil
 }
@@ -0,0 +1,89 @@
+package graph
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+
+	"warimas-be/internal/graph/model"
+)
+
+// List queries page by offset. endCursor encodes the offset of the next
+// page, so after is another way of asking for page; it is opaque so lists
+// can move to keyset cursors without changing the schema.
+
+var errInvalidCursor = errors.New("invalid cursor")
+
+const cursorPrefix = "offset:"
+
+func encodeCursor(offset int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(cursorPrefix + strconv.FormatInt(offset, 10)))
+}
+
+func decodeCursor(cursor string) (int64, error) {
+	b, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, errInvalidCursor
+	}
+	n, ok := strings.CutPrefix(string(b), cursorPrefix)
+	if !ok {
+		return 0, errInvalidCursor
+	}
+	offset, err := strconv.ParseInt(n, 10, 64)
+	if err != nil || offset < 0 {
+		return 0, errInvalidCursor
+	}
+	return offset, nil
+}
+
+// pageAfter returns the page after points at for pages of limit items, or
+// page when after is empty. A cursor from a different limit is rejected.
+func pageAfter(after *string, page, limit int32) (int32, error) {
+	if after == nil || *after == "" {
+		return page, nil
+	}
+	offset, err := decodeCursor(*after)
+	if err != nil || limit <= 0 || offset%int64(limit) != 0 || offset/int64(limit) >= 1<<31-1 {
+		return 0, errInvalidCursor
+	}
+	return int32(offset/int64(limit)) + 1, nil
+}
+
+func newPageInfo(total int64, page, limit int32) *model.PageInfo {
+	var totalPages int32
+	if limit > 0 {
+		totalPages = int32((total + int64(limit) - 1) / int64(limit))
+	}
+
+	info := &model.PageInfo{
+		TotalItems:      int32(total),
+		TotalPages:      totalPages,
+		Page:            page,
+		Limit:           limit,
+		HasNextPage:     page < totalPages,
+		HasPreviousPage: page > 1,
+	}
+	if info.HasNextPage {
+		c := encodeCursor(int64(page) * int64(limit))
+		info.EndCursor = &c
+	}
+	return info
+}
+
+// paginationArgs reads a PaginationInput into page and limit, defaulting
+// to the first page of 20 and resolving after.
+func paginationArgs(pagination *model.PaginationInput) (page, limit int32, err error) {
+	page, limit = 1, 20
+	if pagination == nil {
+		return page, limit, nil
+	}
+	if pagination.Limit > 0 {
+		limit = pagination.Limit
+	}
+	if pagination.Page > 0 {
+		page = pagination.Page
+	}
+	page, err = pageAfter(pagination.After, page, limit)
+	return page, limit, err
+}
@@ -0,0 +1,72 @@
+package graph
+
+import (
+	"testing"
+	"warimas-be/internal/graph/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPageInfo(t *testing.T) {
+	info := newPageInfo(45, 2, 20)
+	assert.Equal(t, int32(45), info.TotalItems)
+	assert.Equal(t, int32(3), info.TotalPages)
+	assert.True(t, info.HasNextPage)
+	assert.True(t, info.HasPreviousPage)
+	require.NotNil(t, info.EndCursor)
+
+	// The cursor leads to the page after.
+	page, err := pageAfter(info.EndCursor, 1, 20)
+	require.NoError(t, err)
+	assert.Equal(t, int32(3), page)
+
+	last := newPageInfo(45, 3, 20)
+	assert.False(t, last.HasNextPage)
+	assert.Nil(t, last.EndCursor)
+
+	empty := newPageInfo(0, 1, 20)
+	assert.Equal(t, int32(0), empty.TotalPages)
+	assert.False(t, empty.HasNextPage)
+	assert.False(t, empty.HasPreviousPage)
+}
+
+func TestPageAfter(t *testing.T) {
+	page, err := pageAfter(nil, 4, 20)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(4), page)
+
+	empty := ""
+	page, err = pageAfter(&empty, 4, 20)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(4), page)
+
+	cursor := encodeCursor(40)
+	page, err = pageAfter(&cursor, 1, 20)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), page, "after takes precedence over page")
+
+	_, err = pageAfter(&cursor, 1, 30)
+	assert.ErrorIs(t, err, errInvalidCursor, "cursor from another limit")
+
+	for _, bad := range []string{"abc", "!!!", encodeCursor(-20), "b2Zmc2V0Onh5eg"} {
+		_, err := pageAfter(&bad, 1, 20)
+		assert.ErrorIs(t, err, errInvalidCursor, bad)
+	}
+}
+
+func TestPaginationArgs(t *testing.T) {
+	page, limit, err := paginationArgs(nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), page)
+	assert.Equal(t, int32(20), limit)
+
+	cursor := encodeCursor(20)
+	page, limit, err = paginationArgs(&model.PaginationInput{Page: 5, Limit: 10, After: &cursor})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(3), page)
+	assert.Equal(t, int32(10), limit)
+
+	_, _, err = paginationArgs(&model.PaginationInput{Page: 1, Limit: 30, After: &cursor})
+	assert.ErrorIs(t, err, errInvalidCursor)
+}
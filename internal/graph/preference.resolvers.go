@@ -0,0 +1,46 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"warimas-be/internal/graph/model"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/preference"
+
+	"go.uber.org/zap"
+)
+
+// UpdateMyPreferences is the resolver for the updateMyPreferences field.
+func (r *mutationResolver) UpdateMyPreferences(ctx context.Context, input model.UpdatePreferencesInput) (*model.UserPreferences, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "UpdateMyPreferences"),
+	)
+
+	p, err := r.PreferenceSvc.UpdateMyPreferences(ctx, preference.MapGraphQLUpdateInput(input))
+	if err != nil {
+		log.Error("failed to update preferences", zap.Error(err))
+		return nil, err
+	}
+
+	return preference.MapPreferencesToGraphQL(p), nil
+}
+
+// MyPreferences is the resolver for the myPreferences field.
+func (r *queryResolver) MyPreferences(ctx context.Context) (*model.UserPreferences, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "MyPreferences"),
+	)
+
+	p, err := r.PreferenceSvc.GetMyPreferences(ctx)
+	if err != nil {
+		log.Error("failed to get preferences", zap.Error(err))
+		return nil, err
+	}
+
+	return preference.MapPreferencesToGraphQL(p), nil
+}
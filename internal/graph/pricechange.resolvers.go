@@ -0,0 +1,77 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"strconv"
+	"warimas-be/internal/graph/model"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/pricechange"
+
+	"go.uber.org/zap"
+)
+
+// SchedulePriceChange is the resolver for the schedulePriceChange field.
+func (r *mutationResolver) SchedulePriceChange(ctx context.Context, input model.SchedulePriceChangeInput) (*model.ScheduledPriceChange, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "SchedulePriceChange"),
+		zap.String("variant_id", input.VariantID),
+	)
+
+	c, err := r.PriceChangeSvc.Schedule(ctx, pricechange.ScheduleInput{
+		VariantID:   input.VariantID,
+		Price:       input.Price,
+		EffectiveAt: input.EffectiveAt,
+	})
+	if err != nil {
+		log.Error("failed to schedule price change", zap.Error(err))
+		return nil, err
+	}
+
+	return pricechange.MapChangeToGraphQL(c), nil
+}
+
+// CancelScheduledPriceChange is the resolver for the cancelScheduledPriceChange field.
+func (r *mutationResolver) CancelScheduledPriceChange(ctx context.Context, id string) (bool, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "CancelScheduledPriceChange"),
+		zap.String("change_id", id),
+	)
+
+	changeID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return false, pricechange.ErrChangeNotFound
+	}
+
+	if err := r.PriceChangeSvc.Cancel(ctx, changeID); err != nil {
+		log.Error("failed to cancel scheduled price change", zap.Error(err))
+		return false, err
+	}
+
+	return true, nil
+}
+
+// MyScheduledPriceChanges is the resolver for the myScheduledPriceChanges field.
+func (r *queryResolver) MyScheduledPriceChanges(ctx context.Context, variantID *string) ([]*model.ScheduledPriceChange, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "MyScheduledPriceChanges"),
+	)
+
+	changes, err := r.PriceChangeSvc.GetMine(ctx, variantID)
+	if err != nil {
+		log.Error("failed to get scheduled price changes", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]*model.ScheduledPriceChange, 0, len(changes))
+	for _, c := range changes {
+		out = append(out, pricechange.MapChangeToGraphQL(c))
+	}
+	return out, nil
+}
@@ -29,6 +29,160 @@ import (
 
 // region    **************************** field.gotpl *****************************
 
+func (ec *executionContext) _ComparedProduct_product(ctx context.Context, field graphql.CollectedField, obj *model.ComparedProduct) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ComparedProduct_product,
+		func(ctx context.Context) (any, error) {
+			return obj.Product, nil
+		},
+		nil,
+		ec.marshalNProduct2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐProduct,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ComparedProduct_product(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ComparedProduct",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Product_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Product_name(ctx, field)
+			case "sellerId":
+				return ec.fieldContext_Product_sellerId(ctx, field)
+			case "sellerName":
+				return ec.fieldContext_Product_sellerName(ctx, field)
+			case "storeSlug":
+				return ec.fieldContext_Product_storeSlug(ctx, field)
+			case "unavailableUntil":
+				return ec.fieldContext_Product_unavailableUntil(ctx, field)
+			case "shippingOriginId":
+				return ec.fieldContext_Product_shippingOriginId(ctx, field)
+			case "categoryID":
+				return ec.fieldContext_Product_categoryID(ctx, field)
+			case "categoryName":
+				return ec.fieldContext_Product_categoryName(ctx, field)
+			case "subcategoryID":
+				return ec.fieldContext_Product_subcategoryID(ctx, field)
+			case "subcategoryName":
+				return ec.fieldContext_Product_subcategoryName(ctx, field)
+			case "slug":
+				return ec.fieldContext_Product_slug(ctx, field)
+			case "variants":
+				return ec.fieldContext_Product_variants(ctx, field)
+			case "imageUrl":
+				return ec.fieldContext_Product_imageUrl(ctx, field)
+			case "description":
+				return ec.fieldContext_Product_description(ctx, field)
+			case "status":
+				return ec.fieldContext_Product_status(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Product_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Product_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Product", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ComparedProduct_minPrice(ctx context.Context, field graphql.CollectedField, obj *model.ComparedProduct) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ComparedProduct_minPrice,
+		func(ctx context.Context) (any, error) {
+			return obj.MinPrice, nil
+		},
+		nil,
+		ec.marshalODecimal2ᚖfloat64,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ComparedProduct_minPrice(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ComparedProduct",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ComparedProduct_maxPrice(ctx context.Context, field graphql.CollectedField, obj *model.ComparedProduct) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ComparedProduct_maxPrice,
+		func(ctx context.Context) (any, error) {
+			return obj.MaxPrice, nil
+		},
+		nil,
+		ec.marshalODecimal2ᚖfloat64,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_ComparedProduct_maxPrice(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ComparedProduct",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Decimal does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ComparedProduct_inStock(ctx context.Context, field graphql.CollectedField, obj *model.ComparedProduct) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ComparedProduct_inStock,
+		func(ctx context.Context) (any, error) {
+			return obj.InStock, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ComparedProduct_inStock(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ComparedProduct",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Product_id(ctx context.Context, field graphql.CollectedField, obj *model.Product) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -39,7 +193,7 @@ func (ec *executionContext) _Product_id(ctx context.Context, field graphql.Colle
 			return obj.ID, nil
 		},
 		nil,
-		ec.marshalNID2string,
+		ec.marshalNUUID2string,
 		true,
 		true,
 	)
@@ -52,7 +206,7 @@ func (ec *executionContext) fieldContext_Product_id(_ context.Context, field gra
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type UUID does not have child fields")
 		},
 	}
 	return fc, nil
@@ -97,7 +251,7 @@ func (ec *executionContext) _Product_sellerId(ctx context.Context, field graphql
 			return obj.SellerID, nil
 		},
 		nil,
-		ec.marshalNID2string,
+		ec.marshalNUUID2string,
 		true,
 		true,
 	)
@@ -110,7 +264,7 @@ func (ec *executionContext) fieldContext_Product_sellerId(_ context.Context, fie
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type UUID does not have child fields")
 		},
 	}
 	return fc, nil
@@ -145,6 +299,93 @@ func (ec *executionContext) fieldContext_Product_sellerName(_ context.Context, f
 	return fc, nil
 }
 
+func (ec *executionContext) _Product_storeSlug(ctx context.Context, field graphql.CollectedField, obj *model.Product) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Product_storeSlug,
+		func(ctx context.Context) (any, error) {
+			return obj.StoreSlug, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Product_storeSlug(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Product",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Product_unavailableUntil(ctx context.Context, field graphql.CollectedField, obj *model.Product) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Product_unavailableUntil,
+		func(ctx context.Context) (any, error) {
+			return obj.UnavailableUntil, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Product_unavailableUntil(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Product",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Product_shippingOriginId(ctx context.Context, field graphql.CollectedField, obj *model.Product) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Product_shippingOriginId,
+		func(ctx context.Context) (any, error) {
+			return obj.ShippingOriginID, nil
+		},
+		nil,
+		ec.marshalOUUID2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Product_shippingOriginId(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Product",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type UUID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Product_categoryID(ctx context.Context, field graphql.CollectedField, obj *model.Product) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -155,7 +396,7 @@ func (ec *executionContext) _Product_categoryID(ctx context.Context, field graph
 			return obj.CategoryID, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNUUID2string,
 		true,
 		true,
 	)
@@ -168,7 +409,7 @@ func (ec *executionContext) fieldContext_Product_categoryID(_ context.Context, f
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type UUID does not have child fields")
 		},
 	}
 	return fc, nil
@@ -213,7 +454,7 @@ func (ec *executionContext) _Product_subcategoryID(ctx context.Context, field gr
 			return obj.SubcategoryID, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNUUID2string,
 		true,
 		true,
 	)
@@ -226,7 +467,7 @@ func (ec *executionContext) fieldContext_Product_subcategoryID(_ context.Context
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type UUID does not have child fields")
 		},
 	}
 	return fc, nil
@@ -324,6 +565,12 @@ func (ec *executionContext) fieldContext_Product_variants(_ context.Context, fie
 				return ec.fieldContext_Variant_quantityType(ctx, field)
 			case "price":
 				return ec.fieldContext_Variant_price(ctx, field)
+			case "compareAtPrice":
+				return ec.fieldContext_Variant_compareAtPrice(ctx, field)
+			case "discountPercent":
+				return ec.fieldContext_Variant_discountPercent(ctx, field)
+			case "unitPrice":
+				return ec.fieldContext_Variant_unitPrice(ctx, field)
 			case "stock":
 				return ec.fieldContext_Variant_stock(ctx, field)
 			case "imageUrl":
@@ -336,6 +583,14 @@ func (ec *executionContext) fieldContext_Product_variants(_ context.Context, fie
 				return ec.fieldContext_Variant_createdAt(ctx, field)
 			case "description":
 				return ec.fieldContext_Variant_description(ctx, field)
+			case "weightGrams":
+				return ec.fieldContext_Variant_weightGrams(ctx, field)
+			case "lengthCm":
+				return ec.fieldContext_Variant_lengthCm(ctx, field)
+			case "widthCm":
+				return ec.fieldContext_Variant_widthCm(ctx, field)
+			case "heightCm":
+				return ec.fieldContext_Variant_heightCm(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Variant", field.Name)
 		},
@@ -440,7 +695,7 @@ func (ec *executionContext) _Product_createdAt(ctx context.Context, field graphq
 			return obj.CreatedAt, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNTime2timeᚐTime,
 		true,
 		true,
 	)
@@ -453,7 +708,7 @@ func (ec *executionContext) fieldContext_Product_createdAt(_ context.Context, fi
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
@@ -469,7 +724,7 @@ func (ec *executionContext) _Product_updatedAt(ctx context.Context, field graphq
 			return obj.UpdatedAt, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalOTime2ᚖtimeᚐTime,
 		true,
 		false,
 	)
@@ -482,7 +737,7 @@ func (ec *executionContext) fieldContext_Product_updatedAt(_ context.Context, fi
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
@@ -607,6 +862,12 @@ func (ec *executionContext) fieldContext_ProductByCategory_products(_ context.Co
 				return ec.fieldContext_Product_sellerId(ctx, field)
 			case "sellerName":
 				return ec.fieldContext_Product_sellerName(ctx, field)
+			case "storeSlug":
+				return ec.fieldContext_Product_storeSlug(ctx, field)
+			case "unavailableUntil":
+				return ec.fieldContext_Product_unavailableUntil(ctx, field)
+			case "shippingOriginId":
+				return ec.fieldContext_Product_shippingOriginId(ctx, field)
 			case "categoryID":
 				return ec.fieldContext_Product_categoryID(ctx, field)
 			case "categoryName":
@@ -646,7 +907,7 @@ func (ec *executionContext) _ProductCart_id(ctx context.Context, field graphql.C
 			return obj.ID, nil
 		},
 		nil,
-		ec.marshalNID2string,
+		ec.marshalNUUID2string,
 		true,
 		true,
 	)
@@ -659,7 +920,7 @@ func (ec *executionContext) fieldContext_ProductCart_id(_ context.Context, field
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type UUID does not have child fields")
 		},
 	}
 	return fc, nil
@@ -704,7 +965,7 @@ func (ec *executionContext) _ProductCart_sellerId(ctx context.Context, field gra
 			return obj.SellerID, nil
 		},
 		nil,
-		ec.marshalNID2string,
+		ec.marshalNUUID2string,
 		true,
 		true,
 	)
@@ -717,7 +978,7 @@ func (ec *executionContext) fieldContext_ProductCart_sellerId(_ context.Context,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type ID does not have child fields")
+			return nil, errors.New("field of type UUID does not have child fields")
 		},
 	}
 	return fc, nil
@@ -762,7 +1023,7 @@ func (ec *executionContext) _ProductCart_categoryID(ctx context.Context, field g
 			return obj.CategoryID, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNUUID2string,
 		true,
 		true,
 	)
@@ -775,7 +1036,7 @@ func (ec *executionContext) fieldContext_ProductCart_categoryID(_ context.Contex
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type UUID does not have child fields")
 		},
 	}
 	return fc, nil
@@ -820,7 +1081,7 @@ func (ec *executionContext) _ProductCart_subcategoryID(ctx context.Context, fiel
 			return obj.SubcategoryID, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNUUID2string,
 		true,
 		true,
 	)
@@ -833,7 +1094,7 @@ func (ec *executionContext) fieldContext_ProductCart_subcategoryID(_ context.Con
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type UUID does not have child fields")
 		},
 	}
 	return fc, nil
@@ -931,6 +1192,12 @@ func (ec *executionContext) fieldContext_ProductCart_variant(_ context.Context,
 				return ec.fieldContext_Variant_quantityType(ctx, field)
 			case "price":
 				return ec.fieldContext_Variant_price(ctx, field)
+			case "compareAtPrice":
+				return ec.fieldContext_Variant_compareAtPrice(ctx, field)
+			case "discountPercent":
+				return ec.fieldContext_Variant_discountPercent(ctx, field)
+			case "unitPrice":
+				return ec.fieldContext_Variant_unitPrice(ctx, field)
 			case "stock":
 				return ec.fieldContext_Variant_stock(ctx, field)
 			case "imageUrl":
@@ -943,6 +1210,14 @@ func (ec *executionContext) fieldContext_ProductCart_variant(_ context.Context,
 				return ec.fieldContext_Variant_createdAt(ctx, field)
 			case "description":
 				return ec.fieldContext_Variant_description(ctx, field)
+			case "weightGrams":
+				return ec.fieldContext_Variant_weightGrams(ctx, field)
+			case "lengthCm":
+				return ec.fieldContext_Variant_lengthCm(ctx, field)
+			case "widthCm":
+				return ec.fieldContext_Variant_widthCm(ctx, field)
+			case "heightCm":
+				return ec.fieldContext_Variant_heightCm(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Variant", field.Name)
 		},
@@ -1047,7 +1322,7 @@ func (ec *executionContext) _ProductCart_createdAt(ctx context.Context, field gr
 			return obj.CreatedAt, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNTime2timeᚐTime,
 		true,
 		true,
 	)
@@ -1060,7 +1335,7 @@ func (ec *executionContext) fieldContext_ProductCart_createdAt(_ context.Context
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
@@ -1076,7 +1351,7 @@ func (ec *executionContext) _ProductCart_updatedAt(ctx context.Context, field gr
 			return obj.UpdatedAt, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalOTime2ᚖtimeᚐTime,
 		true,
 		false,
 	)
@@ -1089,197 +1364,185 @@ func (ec *executionContext) fieldContext_ProductCart_updatedAt(_ context.Context
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ProductPage_items(ctx context.Context, field graphql.CollectedField, obj *model.ProductPage) (ret graphql.Marshaler) {
+func (ec *executionContext) _ProductComparison_products(ctx context.Context, field graphql.CollectedField, obj *model.ProductComparison) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ProductPage_items,
+		ec.fieldContext_ProductComparison_products,
 		func(ctx context.Context) (any, error) {
-			return obj.Items, nil
+			return obj.Products, nil
 		},
 		nil,
-		ec.marshalNProduct2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐProductᚄ,
+		ec.marshalNComparedProduct2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐComparedProductᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ProductPage_items(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ProductComparison_products(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ProductPage",
+		Object:     "ProductComparison",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "id":
-				return ec.fieldContext_Product_id(ctx, field)
-			case "name":
-				return ec.fieldContext_Product_name(ctx, field)
-			case "sellerId":
-				return ec.fieldContext_Product_sellerId(ctx, field)
-			case "sellerName":
-				return ec.fieldContext_Product_sellerName(ctx, field)
-			case "categoryID":
-				return ec.fieldContext_Product_categoryID(ctx, field)
-			case "categoryName":
-				return ec.fieldContext_Product_categoryName(ctx, field)
-			case "subcategoryID":
-				return ec.fieldContext_Product_subcategoryID(ctx, field)
-			case "subcategoryName":
-				return ec.fieldContext_Product_subcategoryName(ctx, field)
-			case "slug":
-				return ec.fieldContext_Product_slug(ctx, field)
-			case "variants":
-				return ec.fieldContext_Product_variants(ctx, field)
-			case "imageUrl":
-				return ec.fieldContext_Product_imageUrl(ctx, field)
-			case "description":
-				return ec.fieldContext_Product_description(ctx, field)
-			case "status":
-				return ec.fieldContext_Product_status(ctx, field)
-			case "createdAt":
-				return ec.fieldContext_Product_createdAt(ctx, field)
-			case "updatedAt":
-				return ec.fieldContext_Product_updatedAt(ctx, field)
+			case "product":
+				return ec.fieldContext_ComparedProduct_product(ctx, field)
+			case "minPrice":
+				return ec.fieldContext_ComparedProduct_minPrice(ctx, field)
+			case "maxPrice":
+				return ec.fieldContext_ComparedProduct_maxPrice(ctx, field)
+			case "inStock":
+				return ec.fieldContext_ComparedProduct_inStock(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Product", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type ComparedProduct", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ProductPage_page(ctx context.Context, field graphql.CollectedField, obj *model.ProductPage) (ret graphql.Marshaler) {
+func (ec *executionContext) _ProductComparison_attributes(ctx context.Context, field graphql.CollectedField, obj *model.ProductComparison) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ProductPage_page,
+		ec.fieldContext_ProductComparison_attributes,
 		func(ctx context.Context) (any, error) {
-			return obj.Page, nil
+			return obj.Attributes, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
+		ec.marshalNProductComparisonAttribute2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐProductComparisonAttributeᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ProductPage_page(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ProductComparison_attributes(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ProductPage",
+		Object:     "ProductComparison",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "key":
+				return ec.fieldContext_ProductComparisonAttribute_key(ctx, field)
+			case "label":
+				return ec.fieldContext_ProductComparisonAttribute_label(ctx, field)
+			case "values":
+				return ec.fieldContext_ProductComparisonAttribute_values(ctx, field)
+			case "differs":
+				return ec.fieldContext_ProductComparisonAttribute_differs(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ProductComparisonAttribute", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ProductPage_limit(ctx context.Context, field graphql.CollectedField, obj *model.ProductPage) (ret graphql.Marshaler) {
+func (ec *executionContext) _ProductComparisonAttribute_key(ctx context.Context, field graphql.CollectedField, obj *model.ProductComparisonAttribute) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ProductPage_limit,
+		ec.fieldContext_ProductComparisonAttribute_key,
 		func(ctx context.Context) (any, error) {
-			return obj.Limit, nil
+			return obj.Key, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ProductPage_limit(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ProductComparisonAttribute_key(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ProductPage",
+		Object:     "ProductComparisonAttribute",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ProductPage_totalCount(ctx context.Context, field graphql.CollectedField, obj *model.ProductPage) (ret graphql.Marshaler) {
+func (ec *executionContext) _ProductComparisonAttribute_label(ctx context.Context, field graphql.CollectedField, obj *model.ProductComparisonAttribute) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ProductPage_totalCount,
+		ec.fieldContext_ProductComparisonAttribute_label,
 		func(ctx context.Context) (any, error) {
-			return obj.TotalCount, nil
+			return obj.Label, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
+		ec.marshalNString2string,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ProductPage_totalCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ProductComparisonAttribute_label(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ProductPage",
+		Object:     "ProductComparisonAttribute",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ProductPage_totalPages(ctx context.Context, field graphql.CollectedField, obj *model.ProductPage) (ret graphql.Marshaler) {
+func (ec *executionContext) _ProductComparisonAttribute_values(ctx context.Context, field graphql.CollectedField, obj *model.ProductComparisonAttribute) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ProductPage_totalPages,
+		ec.fieldContext_ProductComparisonAttribute_values,
 		func(ctx context.Context) (any, error) {
-			return obj.TotalPages, nil
+			return obj.Values, nil
 		},
 		nil,
-		ec.marshalNInt2int32,
+		ec.marshalNString2ᚕᚖstring,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_ProductPage_totalPages(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ProductComparisonAttribute_values(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ProductPage",
+		Object:     "ProductComparisonAttribute",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type String does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _ProductPage_hasNext(ctx context.Context, field graphql.CollectedField, obj *model.ProductPage) (ret graphql.Marshaler) {
+func (ec *executionContext) _ProductComparisonAttribute_differs(ctx context.Context, field graphql.CollectedField, obj *model.ProductComparisonAttribute) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_ProductPage_hasNext,
+		ec.fieldContext_ProductComparisonAttribute_differs,
 		func(ctx context.Context) (any, error) {
-			return obj.HasNext, nil
+			return obj.Differs, nil
 		},
 		nil,
 		ec.marshalNBoolean2bool,
@@ -1288,9 +1551,9 @@ func (ec *executionContext) _ProductPage_hasNext(ctx context.Context, field grap
 	)
 }
 
-func (ec *executionContext) fieldContext_ProductPage_hasNext(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ProductComparisonAttribute_differs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "ProductPage",
+		Object:     "ProductComparisonAttribute",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -1301,6 +1564,118 @@ func (ec *executionContext) fieldContext_ProductPage_hasNext(_ context.Context,
 	return fc, nil
 }
 
+func (ec *executionContext) _ProductConnection_items(ctx context.Context, field graphql.CollectedField, obj *model.ProductConnection) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ProductConnection_items,
+		func(ctx context.Context) (any, error) {
+			return obj.Items, nil
+		},
+		nil,
+		ec.marshalNProduct2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐProductᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ProductConnection_items(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProductConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Product_id(ctx, field)
+			case "name":
+				return ec.fieldContext_Product_name(ctx, field)
+			case "sellerId":
+				return ec.fieldContext_Product_sellerId(ctx, field)
+			case "sellerName":
+				return ec.fieldContext_Product_sellerName(ctx, field)
+			case "storeSlug":
+				return ec.fieldContext_Product_storeSlug(ctx, field)
+			case "unavailableUntil":
+				return ec.fieldContext_Product_unavailableUntil(ctx, field)
+			case "shippingOriginId":
+				return ec.fieldContext_Product_shippingOriginId(ctx, field)
+			case "categoryID":
+				return ec.fieldContext_Product_categoryID(ctx, field)
+			case "categoryName":
+				return ec.fieldContext_Product_categoryName(ctx, field)
+			case "subcategoryID":
+				return ec.fieldContext_Product_subcategoryID(ctx, field)
+			case "subcategoryName":
+				return ec.fieldContext_Product_subcategoryName(ctx, field)
+			case "slug":
+				return ec.fieldContext_Product_slug(ctx, field)
+			case "variants":
+				return ec.fieldContext_Product_variants(ctx, field)
+			case "imageUrl":
+				return ec.fieldContext_Product_imageUrl(ctx, field)
+			case "description":
+				return ec.fieldContext_Product_description(ctx, field)
+			case "status":
+				return ec.fieldContext_Product_status(ctx, field)
+			case "createdAt":
+				return ec.fieldContext_Product_createdAt(ctx, field)
+			case "updatedAt":
+				return ec.fieldContext_Product_updatedAt(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Product", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _ProductConnection_pageInfo(ctx context.Context, field graphql.CollectedField, obj *model.ProductConnection) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_ProductConnection_pageInfo,
+		func(ctx context.Context) (any, error) {
+			return obj.PageInfo, nil
+		},
+		nil,
+		ec.marshalNPageInfo2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐPageInfo,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_ProductConnection_pageInfo(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "ProductConnection",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "totalItems":
+				return ec.fieldContext_PageInfo_totalItems(ctx, field)
+			case "totalPages":
+				return ec.fieldContext_PageInfo_totalPages(ctx, field)
+			case "page":
+				return ec.fieldContext_PageInfo_page(ctx, field)
+			case "limit":
+				return ec.fieldContext_PageInfo_limit(ctx, field)
+			case "hasNextPage":
+				return ec.fieldContext_PageInfo_hasNextPage(ctx, field)
+			case "hasPreviousPage":
+				return ec.fieldContext_PageInfo_hasPreviousPage(ctx, field)
+			case "endCursor":
+				return ec.fieldContext_PageInfo_endCursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type PageInfo", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 // endregion **************************** field.gotpl *****************************
 
 // region    **************************** input.gotpl *****************************
@@ -1342,14 +1717,14 @@ func (ec *executionContext) unmarshalInputNewProduct(ctx context.Context, obj an
 			it.Description = data
 		case "categoryId":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("categoryId"))
-			data, err := ec.unmarshalNID2string(ctx, v)
+			data, err := ec.unmarshalNUUID2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
 			it.CategoryID = data
 		case "subcategoryId":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("subcategoryId"))
-			data, err := ec.unmarshalNID2string(ctx, v)
+			data, err := ec.unmarshalNUUID2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
@@ -1376,7 +1751,7 @@ func (ec *executionContext) unmarshalInputProductFilterInput(ctx context.Context
 		switch k {
 		case "categoryId":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("categoryId"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			data, err := ec.unmarshalOUUID2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
@@ -1390,14 +1765,14 @@ func (ec *executionContext) unmarshalInputProductFilterInput(ctx context.Context
 			it.CategorySlug = data
 		case "minPrice":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("minPrice"))
-			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			data, err := ec.unmarshalODecimal2ᚖfloat64(ctx, v)
 			if err != nil {
 				return it, err
 			}
 			it.MinPrice = data
 		case "maxPrice":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maxPrice"))
-			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			data, err := ec.unmarshalODecimal2ᚖfloat64(ctx, v)
 			if err != nil {
 				return it, err
 			}
@@ -1490,7 +1865,7 @@ func (ec *executionContext) unmarshalInputUpdateProduct(ctx context.Context, obj
 		switch k {
 		case "id":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("id"))
-			data, err := ec.unmarshalNID2string(ctx, v)
+			data, err := ec.unmarshalNUUID2string(ctx, v)
 			if err != nil {
 				return it, err
 			}
@@ -1518,14 +1893,14 @@ func (ec *executionContext) unmarshalInputUpdateProduct(ctx context.Context, obj
 			it.Description = data
 		case "categoryId":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("categoryId"))
-			data, err := ec.unmarshalOID2ᚖstring(ctx, v)
+			data, err := ec.unmarshalOUUID2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
 			it.CategoryID = data
 		case "subcategoryID":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("subcategoryID"))
-			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			data, err := ec.unmarshalOUUID2ᚖstring(ctx, v)
 			if err != nil {
 				return it, err
 			}
@@ -1551,6 +1926,54 @@ func (ec *executionContext) unmarshalInputUpdateProduct(ctx context.Context, obj
 
 // region    **************************** object.gotpl ****************************
 
+var comparedProductImplementors = []string{"ComparedProduct"}
+
+func (ec *executionContext) _ComparedProduct(ctx context.Context, sel ast.SelectionSet, obj *model.ComparedProduct) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, comparedProductImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ComparedProduct")
+		case "product":
+			out.Values[i] = ec._ComparedProduct_product(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "minPrice":
+			out.Values[i] = ec._ComparedProduct_minPrice(ctx, field, obj)
+		case "maxPrice":
+			out.Values[i] = ec._ComparedProduct_maxPrice(ctx, field, obj)
+		case "inStock":
+			out.Values[i] = ec._ComparedProduct_inStock(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var productImplementors = []string{"Product"}
 
 func (ec *executionContext) _Product(ctx context.Context, sel ast.SelectionSet, obj *model.Product) graphql.Marshaler {
@@ -1582,6 +2005,12 @@ func (ec *executionContext) _Product(ctx context.Context, sel ast.SelectionSet,
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "storeSlug":
+			out.Values[i] = ec._Product_storeSlug(ctx, field, obj)
+		case "unavailableUntil":
+			out.Values[i] = ec._Product_unavailableUntil(ctx, field, obj)
+		case "shippingOriginId":
+			out.Values[i] = ec._Product_shippingOriginId(ctx, field, obj)
 		case "categoryID":
 			out.Values[i] = ec._Product_categoryID(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -1790,44 +2219,122 @@ func (ec *executionContext) _ProductCart(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
-var productPageImplementors = []string{"ProductPage"}
+var productComparisonImplementors = []string{"ProductComparison"}
 
-func (ec *executionContext) _ProductPage(ctx context.Context, sel ast.SelectionSet, obj *model.ProductPage) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, productPageImplementors)
+func (ec *executionContext) _ProductComparison(ctx context.Context, sel ast.SelectionSet, obj *model.ProductComparison) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, productComparisonImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("ProductPage")
-		case "items":
-			out.Values[i] = ec._ProductPage_items(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("ProductComparison")
+		case "products":
+			out.Values[i] = ec._ProductComparison_products(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "attributes":
+			out.Values[i] = ec._ProductComparison_attributes(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var productComparisonAttributeImplementors = []string{"ProductComparisonAttribute"}
+
+func (ec *executionContext) _ProductComparisonAttribute(ctx context.Context, sel ast.SelectionSet, obj *model.ProductComparisonAttribute) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, productComparisonAttributeImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ProductComparisonAttribute")
+		case "key":
+			out.Values[i] = ec._ProductComparisonAttribute_key(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "page":
-			out.Values[i] = ec._ProductPage_page(ctx, field, obj)
+		case "label":
+			out.Values[i] = ec._ProductComparisonAttribute_label(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "limit":
-			out.Values[i] = ec._ProductPage_limit(ctx, field, obj)
+		case "values":
+			out.Values[i] = ec._ProductComparisonAttribute_values(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "totalCount":
-			out.Values[i] = ec._ProductPage_totalCount(ctx, field, obj)
+		case "differs":
+			out.Values[i] = ec._ProductComparisonAttribute_differs(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "totalPages":
-			out.Values[i] = ec._ProductPage_totalPages(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.processDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var productConnectionImplementors = []string{"ProductConnection"}
+
+func (ec *executionContext) _ProductConnection(ctx context.Context, sel ast.SelectionSet, obj *model.ProductConnection) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, productConnectionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ProductConnection")
+		case "items":
+			out.Values[i] = ec._ProductConnection_items(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "hasNext":
-			out.Values[i] = ec._ProductPage_hasNext(ctx, field, obj)
+		case "pageInfo":
+			out.Values[i] = ec._ProductConnection_pageInfo(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -1858,6 +2365,60 @@ func (ec *executionContext) _ProductPage(ctx context.Context, sel ast.SelectionS
 
 // region    ***************************** type.gotpl *****************************
 
+func (ec *executionContext) marshalNComparedProduct2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐComparedProductᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ComparedProduct) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNComparedProduct2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐComparedProduct(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNComparedProduct2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐComparedProduct(ctx context.Context, sel ast.SelectionSet, v *model.ComparedProduct) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ComparedProduct(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNNewProduct2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐNewProduct(ctx context.Context, v any) (model.NewProduct, error) {
 	res, err := ec.unmarshalInputNewProduct(ctx, v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -1985,18 +2546,86 @@ func (ec *executionContext) marshalNProductCart2ᚖwarimasᚑbeᚋinternalᚋgra
 	return ec._ProductCart(ctx, sel, v)
 }
 
-func (ec *executionContext) marshalNProductPage2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐProductPage(ctx context.Context, sel ast.SelectionSet, v model.ProductPage) graphql.Marshaler {
-	return ec._ProductPage(ctx, sel, &v)
+func (ec *executionContext) marshalNProductComparison2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐProductComparison(ctx context.Context, sel ast.SelectionSet, v model.ProductComparison) graphql.Marshaler {
+	return ec._ProductComparison(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNProductComparison2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐProductComparison(ctx context.Context, sel ast.SelectionSet, v *model.ProductComparison) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ProductComparison(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNProductComparisonAttribute2ᚕᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐProductComparisonAttributeᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.ProductComparisonAttribute) graphql.Marshaler {
+	ret := make(graphql.Array, len(v))
+	var wg sync.WaitGroup
+	isLen1 := len(v) == 1
+	if !isLen1 {
+		wg.Add(len(v))
+	}
+	for i := range v {
+		i := i
+		fc := &graphql.FieldContext{
+			Index:  &i,
+			Result: &v[i],
+		}
+		ctx := graphql.WithFieldContext(ctx, fc)
+		f := func(i int) {
+			defer func() {
+				if r := recover(); r != nil {
+					ec.Error(ctx, ec.Recover(ctx, r))
+					ret = nil
+				}
+			}()
+			if !isLen1 {
+				defer wg.Done()
+			}
+			ret[i] = ec.marshalNProductComparisonAttribute2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐProductComparisonAttribute(ctx, sel, v[i])
+		}
+		if isLen1 {
+			f(i)
+		} else {
+			go f(i)
+		}
+
+	}
+	wg.Wait()
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNProductComparisonAttribute2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐProductComparisonAttribute(ctx context.Context, sel ast.SelectionSet, v *model.ProductComparisonAttribute) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._ProductComparisonAttribute(ctx, sel, v)
+}
+
+func (ec *executionContext) marshalNProductConnection2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐProductConnection(ctx context.Context, sel ast.SelectionSet, v model.ProductConnection) graphql.Marshaler {
+	return ec._ProductConnection(ctx, sel, &v)
 }
 
-func (ec *executionContext) marshalNProductPage2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐProductPage(ctx context.Context, sel ast.SelectionSet, v *model.ProductPage) graphql.Marshaler {
+func (ec *executionContext) marshalNProductConnection2ᚖwarimasᚑbeᚋinternalᚋgraphᚋmodelᚐProductConnection(ctx context.Context, sel ast.SelectionSet, v *model.ProductConnection) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
 			ec.Errorf(ctx, "the requested element is null which the schema does not allow")
 		}
 		return graphql.Null
 	}
-	return ec._ProductPage(ctx, sel, v)
+	return ec._ProductConnection(ctx, sel, v)
 }
 
 func (ec *executionContext) unmarshalNProductSortField2warimasᚑbeᚋinternalᚋgraphᚋmodelᚐProductSortField(ctx context.Context, v any) (model.ProductSortField, error) {
@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"warimas-be/internal/analytics"
 	"warimas-be/internal/graph/model"
 	"warimas-be/internal/logger"
 	prodInternal "warimas-be/internal/product"
@@ -20,7 +21,7 @@ import (
 func (r *mutationResolver) CreateProduct(ctx context.Context, input model.NewProduct) (*model.Product, error) {
 	_, ok := utils.GetUserIDFromContext(ctx)
 	if !ok {
-		return nil, errors.New("unauthorized: please login first")
+		return nil, errLoginRequired
 	}
 
 	p, err := r.ProductSvc.Create(ctx, MapNewProductInput(input))
@@ -38,7 +39,7 @@ func (r *mutationResolver) CreateProduct(ctx context.Context, input model.NewPro
 func (r *mutationResolver) UpdateProduct(ctx context.Context, input model.UpdateProduct) (*model.Product, error) {
 	_, ok := utils.GetUserIDFromContext(ctx)
 	if !ok {
-		return nil, errors.New("unauthorized: please login first")
+		return nil, errLoginRequired
 	}
 
 	p, err := r.ProductSvc.Update(ctx, MapUpdateProductInput(input))
@@ -52,8 +53,40 @@ func (r *mutationResolver) UpdateProduct(ctx context.Context, input model.Update
 	}, nil
 }
 
+// ArchiveProduct is the resolver for the archiveProduct field.
+func (r *mutationResolver) ArchiveProduct(ctx context.Context, id string) (*model.Product, error) {
+	_, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, errLoginRequired
+	}
+
+	p, err := r.ProductSvc.Archive(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.Product{
+		ID:     fmt.Sprint(p.ID),
+		Name:   p.Name,
+		Status: &p.Status,
+	}, nil
+}
+
+// DeleteProduct is the resolver for the deleteProduct field.
+func (r *mutationResolver) DeleteProduct(ctx context.Context, id string) (bool, error) {
+	_, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		return false, errLoginRequired
+	}
+
+	if err := r.ProductSvc.Delete(ctx, id); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 // ProductList is the resolver for the productList field.
-func (r *queryResolver) ProductList(ctx context.Context, filter *model.ProductFilterInput, sort *model.ProductSortInput, page *int32, limit *int32) (*model.ProductPage, error) {
+func (r *queryResolver) ProductList(ctx context.Context, filter *model.ProductFilterInput, sort *model.ProductSortInput, page *int32, limit *int32, after *string) (*model.ProductConnection, error) {
 	log := logger.FromCtx(ctx).With(
 		zap.String("layer", "resolver"),
 		zap.String("method", "ProductList"),
@@ -74,17 +107,19 @@ func (r *queryResolver) ProductList(ctx context.Context, filter *model.ProductFi
 		l = 100
 	}
 
+	p, err := pageAfter(after, p, l)
+	if err != nil {
+		log.Warn("invalid cursor", zap.Error(err))
+		return nil, err
+	}
+
 	log.Info("resolver started",
 		zap.Int32("page", p),
 		zap.Int32("limit", l),
 	)
 
 	// 2. Optimization: Check requested fields to avoid unnecessary count query
-	includeCount := utils.HasAnyField(ctx,
-		"totalCount",
-		"totalPages",
-		"hasNext",
-	)
+	includeCount := utils.HasAnyField(ctx, "pageInfo")
 
 	var sortField *model.ProductSortField
 	var sortDirection *model.SortDirection
@@ -129,30 +164,19 @@ func (r *queryResolver) ProductList(ctx context.Context, filter *model.ProductFi
 		items = append(items, MapProductToGraphQL(p))
 	}
 
-	var totalCount int32 = 0
-	var totalPages int32 = 0
-	var hasNext bool = false
-
+	var totalCount int64
 	if includeCount && result.TotalCount != nil {
-		totalCount = int32(*result.TotalCount)
-		if l > 0 {
-			totalPages = (totalCount + l - 1) / l
-		}
-		hasNext = p < totalPages
+		totalCount = int64(*result.TotalCount)
 	}
 
 	log.Info("resolver success",
 		zap.Int("items_count", len(items)),
-		zap.Int32("total_count", totalCount),
+		zap.Int64("total_count", totalCount),
 	)
 
-	return &model.ProductPage{
-		Items:      items,
-		Page:       p,
-		Limit:      l,
-		TotalCount: totalCount,
-		TotalPages: totalPages,
-		HasNext:    hasNext,
+	return &model.ProductConnection{
+		Items:    items,
+		PageInfo: newPageInfo(totalCount, p, l),
 	}, nil
 }
 
@@ -277,6 +301,27 @@ func (r *queryResolver) ProductDetail(ctx context.Context, productID string) (*m
 	}
 	productGraph := MapProductToGraphQL(product)
 
+	analytics.Track(ctx, analytics.Event{
+		Type:      analytics.EventProductView,
+		ProductID: productID,
+	})
+
 	log.Debug("product found")
 	return productGraph, nil
 }
+
+// CompareProducts is the resolver for the compareProducts field.
+func (r *queryResolver) CompareProducts(ctx context.Context, ids []string) (*model.ProductComparison, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("resolver", "CompareProducts"),
+		zap.Int("count", len(ids)),
+	)
+
+	cmp, err := r.ProductSvc.Compare(ctx, ids)
+	if err != nil {
+		log.Error("failed to compare products", zap.Error(err))
+		return nil, err
+	}
+
+	return MapComparisonToGraphQL(cmp), nil
+}
@@ -1,10 +1,8 @@
 package graph
 
 import (
-	"time"
 	"warimas-be/internal/graph/model"
 	"warimas-be/internal/product"
-	"warimas-be/internal/utils"
 )
 
 func MapSortField(f *model.ProductSortField) product.ProductSortField {
@@ -60,21 +58,24 @@ func MapProductToGraphQL(p *product.Product) *model.Product {
 	}
 
 	return &model.Product{
-		ID:              p.ID,
-		Name:            p.Name,
-		SellerID:        p.SellerID,
-		SellerName:      p.SellerName,
-		CategoryID:      p.CategoryID,
-		CategoryName:    p.CategoryName,
-		SubcategoryID:   p.SubcategoryID,
-		SubcategoryName: p.SubcategoryName,
-		Slug:            p.Slug,
-		ImageURL:        p.ImageURL,
-		Description:     p.Description,
-		Status:          &status,
-		CreatedAt:       p.CreatedAt.Format(time.RFC3339),
-		UpdatedAt:       utils.FormatTimePtr(p.UpdatedAt),
-		Variants:        variants,
+		ID:               p.ID,
+		Name:             p.Name,
+		SellerID:         p.SellerID,
+		SellerName:       p.SellerName,
+		StoreSlug:        p.StoreSlug,
+		UnavailableUntil: p.UnavailableUntil,
+		ShippingOriginID: p.OriginID,
+		CategoryID:       p.CategoryID,
+		CategoryName:     p.CategoryName,
+		SubcategoryID:    p.SubcategoryID,
+		SubcategoryName:  p.SubcategoryName,
+		Slug:             p.Slug,
+		ImageURL:         p.ImageURL,
+		Description:      p.Description,
+		Status:           &status,
+		CreatedAt:        p.CreatedAt,
+		UpdatedAt:        p.UpdatedAt,
+		Variants:         variants,
 	}
 }
 
@@ -88,20 +89,57 @@ func MapVariantToGraphQL(v *product.Variant) *model.Variant {
 		imageURL = v.ImageURL
 	}
 
-	return &model.Variant{
-		ID:           v.ID,
-		Name:         v.Name,
-		ProductID:    v.ProductID,
-		QuantityType: v.QuantityType,
-		Price:        v.Price,
-		Stock:        int32(v.Stock),
-		ImageURL:     imageURL,
-		Description:  v.Description,
-		CategoryID:   nil,
-		CreatedAt:    v.CreatedAt,
+	out := &model.Variant{
+		ID:              v.ID,
+		Name:            v.Name,
+		ProductID:       v.ProductID,
+		QuantityType:    v.QuantityType,
+		Price:           v.Price,
+		CompareAtPrice:  v.CompareAtPrice,
+		DiscountPercent: v.DiscountPercent(),
+		UnitPrice:       MapUnitPriceToGraphQL(v.UnitPrice()),
+		Stock:           int32(v.Stock),
+		ImageURL:        imageURL,
+		Description:     v.Description,
+		CategoryID:      nil,
+		CreatedAt:       v.CreatedAt,
+	}
+
+	if v.Shipping != nil {
+		out.WeightGrams = &v.Shipping.WeightGrams
+		out.LengthCm = &v.Shipping.LengthCm
+		out.WidthCm = &v.Shipping.WidthCm
+		out.HeightCm = &v.Shipping.HeightCm
+	}
+
+	return out
+}
+
+func MapUnitPriceToGraphQL(p *product.UnitPrice) *model.UnitPrice {
+	if p == nil {
+		return nil
+	}
+	return &model.UnitPrice{
+		Amount: p.Amount,
+		Per:    p.Per,
+		Unit:   p.Unit,
 	}
 }
 
+func MapQuantityTypeInfoToGraphQL(info product.QuantityTypeInfo) *model.QuantityTypeInfo {
+	out := &model.QuantityTypeInfo{
+		Code:  string(info.Type),
+		Label: info.Label,
+	}
+	if info.BaseUnit != "" {
+		basePerUnit := int32(info.BasePerUnit)
+		out.BaseUnit = &info.BaseUnit
+		out.BasePerUnit = &basePerUnit
+		out.UnitPricePer = &info.UnitPricePer
+	}
+	return out
+}
+
 func MapNewProductInput(input model.NewProduct) product.NewProductInput {
 	return product.NewProductInput{
 		Name:          input.Name,
@@ -123,3 +161,30 @@ func MapUpdateProductInput(input model.UpdateProduct) product.UpdateProductInput
 		Status:        input.Status,
 	}
 }
+
+func MapComparisonToGraphQL(c *product.Comparison) *model.ProductComparison {
+	products := make([]*model.ComparedProduct, 0, len(c.Products))
+	for _, p := range c.Products {
+		products = append(products, &model.ComparedProduct{
+			Product:  MapProductToGraphQL(p.Product),
+			MinPrice: p.MinPrice,
+			MaxPrice: p.MaxPrice,
+			InStock:  p.InStock,
+		})
+	}
+
+	attrs := make([]*model.ProductComparisonAttribute, 0, len(c.Attributes))
+	for _, a := range c.Attributes {
+		attrs = append(attrs, &model.ProductComparisonAttribute{
+			Key:     a.Key,
+			Label:   a.Label,
+			Values:  a.Values,
+			Differs: a.Differs,
+		})
+	}
+
+	return &model.ProductComparison{
+		Products:   products,
+		Attributes: attrs,
+	}
+}
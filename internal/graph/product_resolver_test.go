@@ -30,6 +30,16 @@ func (m *MockProductService) Update(ctx context.Context, input product.UpdatePro
 	return args.Get(0).(product.Product), args.Error(1)
 }
 
+func (m *MockProductService) Archive(ctx context.Context, productID string) (product.Product, error) {
+	args := m.Called(ctx, productID)
+	return args.Get(0).(product.Product), args.Error(1)
+}
+
+func (m *MockProductService) Delete(ctx context.Context, productID string) error {
+	args := m.Called(ctx, productID)
+	return args.Error(0)
+}
+
 func (m *MockProductService) GetList(ctx context.Context, opts product.ProductQueryOptions) (*product.ProductListResult, error) {
 	args := m.Called(ctx, opts)
 	if args.Get(0) == nil {
@@ -54,6 +64,14 @@ func (m *MockProductService) GetProductByID(ctx context.Context, id string) (*pr
 	return args.Get(0).(*product.Product), args.Error(1)
 }
 
+func (m *MockProductService) Compare(ctx context.Context, ids []string) (*product.Comparison, error) {
+	args := m.Called(ctx, ids)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*product.Comparison), args.Error(1)
+}
+
 // Stubs for interface satisfaction (if needed by your specific Service interface definition)
 func (m *MockProductService) CreateVariants(ctx context.Context, input []*product.NewVariantInput) ([]*product.Variant, error) {
 	args := m.Called(ctx, input)
@@ -105,7 +123,7 @@ func TestMutationResolver_CreateProduct(t *testing.T) {
 		_, err := mr.CreateProduct(ctx, input)
 
 		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "unauthorized")
+		assert.ErrorIs(t, err, errLoginRequired)
 	})
 
 	t.Run("ServiceError", func(t *testing.T) {
@@ -156,7 +174,7 @@ func TestMutationResolver_UpdateProduct(t *testing.T) {
 		_, err := mr.UpdateProduct(ctx, input)
 
 		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "unauthorized")
+		assert.ErrorIs(t, err, errLoginRequired)
 	})
 
 	t.Run("ServiceError", func(t *testing.T) {
@@ -173,6 +191,40 @@ func TestMutationResolver_UpdateProduct(t *testing.T) {
 	})
 }
 
+func TestMutationResolver_ArchiveAndDeleteProduct(t *testing.T) {
+	ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "seller")
+
+	t.Run("Archive", func(t *testing.T) {
+		mockSvc := new(MockProductService)
+		mr := &mutationResolver{&Resolver{ProductSvc: mockSvc}}
+		mockSvc.On("Archive", ctx, "100").Return(product.Product{ID: "100", Status: "archived"}, nil)
+
+		res, err := mr.ArchiveProduct(ctx, "100")
+		assert.NoError(t, err)
+		assert.Equal(t, "100", res.ID)
+		assert.Equal(t, "archived", *res.Status)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		mockSvc := new(MockProductService)
+		mr := &mutationResolver{&Resolver{ProductSvc: mockSvc}}
+		mockSvc.On("Delete", ctx, "100").Return(nil)
+
+		ok, err := mr.DeleteProduct(ctx, "100")
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		mockSvc := new(MockProductService)
+		mr := &mutationResolver{&Resolver{ProductSvc: mockSvc}}
+
+		_, err := mr.DeleteProduct(context.Background(), "100")
+		assert.ErrorIs(t, err, errLoginRequired)
+		mockSvc.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+}
+
 func TestQueryResolver_ProductList(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		mockSvc := new(MockProductService)
@@ -211,7 +263,7 @@ func TestQueryResolver_ProductList(t *testing.T) {
 			return opts.Limit == 10 && opts.Page == 1
 		})).Return(mockRes, nil)
 
-		res, err := qr.ProductList(ctx, nil, nil, &page, &limit)
+		res, err := qr.ProductList(ctx, nil, nil, &page, &limit, nil)
 
 		assert.NoError(t, err)
 		assert.Len(t, res.Items, 1)
@@ -240,7 +292,7 @@ func TestQueryResolver_ProductList(t *testing.T) {
 			return *opts.Search == "Phone"
 		})).Return(mockRes, nil)
 
-		res, err := qr.ProductList(ctx, filter, nil, nil, nil)
+		res, err := qr.ProductList(ctx, filter, nil, nil, nil, nil)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, res)
@@ -264,7 +316,7 @@ func TestQueryResolver_ProductList(t *testing.T) {
 		// Expect GetList to be called (we assume mapping logic works, just verifying flow)
 		mockSvc.On("GetList", ctx, mock.Anything).Return(&product.ProductListResult{}, nil)
 
-		_, err := qr.ProductList(ctx, nil, sortInput, nil, nil)
+		_, err := qr.ProductList(ctx, nil, sortInput, nil, nil, nil)
 		assert.NoError(t, err)
 	})
 
@@ -283,7 +335,7 @@ func TestQueryResolver_ProductList(t *testing.T) {
 			return opts.Page == 1 && opts.Limit == 20
 		})).Return(&product.ProductListResult{}, nil)
 
-		_, err := qr.ProductList(ctx, nil, nil, nil, nil)
+		_, err := qr.ProductList(ctx, nil, nil, nil, nil, nil)
 		assert.NoError(t, err)
 	})
 
@@ -303,7 +355,7 @@ func TestQueryResolver_ProductList(t *testing.T) {
 			return opts.Limit == 100
 		})).Return(&product.ProductListResult{}, nil)
 
-		_, err := qr.ProductList(ctx, nil, nil, nil, &limit)
+		_, err := qr.ProductList(ctx, nil, nil, nil, &limit, nil)
 		assert.NoError(t, err)
 	})
 
@@ -317,7 +369,7 @@ func TestQueryResolver_ProductList(t *testing.T) {
 
 		mockSvc.On("GetList", ctx, mock.Anything).Return(nil, errors.New("db error"))
 
-		_, err := qr.ProductList(ctx, nil, nil, nil, nil)
+		_, err := qr.ProductList(ctx, nil, nil, nil, nil, nil)
 		assert.Error(t, err)
 	})
 }
@@ -361,6 +413,49 @@ func TestQueryResolver_ProductDetail(t *testing.T) {
 	})
 }
 
+func TestQueryResolver_CompareProducts(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockSvc := new(MockProductService)
+		resolver := &Resolver{ProductSvc: mockSvc}
+		qr := &queryResolver{resolver}
+
+		ctx := context.Background()
+		ids := []string{"p1", "p2"}
+		minPrice := 15000.0
+		cat := "Sembako"
+		cmp := &product.Comparison{
+			Products: []*product.ComparedProduct{
+				{Product: &product.Product{ID: "p1"}, MinPrice: &minPrice, MaxPrice: &minPrice, InStock: true},
+				{Product: &product.Product{ID: "p2"}},
+			},
+			Attributes: []*product.ComparisonAttribute{
+				{Key: "category", Label: "Category", Values: []*string{&cat, &cat}},
+			},
+		}
+		mockSvc.On("Compare", ctx, ids).Return(cmp, nil)
+
+		res, err := qr.CompareProducts(ctx, ids)
+
+		assert.NoError(t, err)
+		assert.Len(t, res.Products, 2)
+		assert.Equal(t, &minPrice, res.Products[0].MinPrice)
+		assert.Nil(t, res.Products[1].MinPrice)
+		assert.Equal(t, "category", res.Attributes[0].Key)
+		assert.False(t, res.Attributes[0].Differs)
+	})
+
+	t.Run("TooMany", func(t *testing.T) {
+		mockSvc := new(MockProductService)
+		resolver := &Resolver{ProductSvc: mockSvc}
+		qr := &queryResolver{resolver}
+
+		mockSvc.On("Compare", context.Background(), mock.Anything).Return(nil, product.ErrInvalidComparison)
+
+		_, err := qr.CompareProducts(context.Background(), []string{"a", "b", "c", "d", "e"})
+		assert.ErrorIs(t, err, product.ErrInvalidComparison)
+	})
+}
+
 func TestQueryResolver_ProductsHome(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		mockSvc := new(MockProductService)
@@ -0,0 +1,42 @@
+package graph
+
+import (
+	"context"
+	"warimas-be/internal/db"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// QueryTagger names the GraphQL operation in the comment the database
+// driver adds to each statement, so a slow statement in the Postgres
+// logs or pg_stat_activity leads back to the operation that ran it.
+// Anonymous operations are tagged with their type.
+type QueryTagger struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationInterceptor
+} = QueryTagger{}
+
+func (QueryTagger) ExtensionName() string {
+	return "QueryTagger"
+}
+
+func (QueryTagger) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (QueryTagger) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	oc := graphql.GetOperationContext(ctx)
+	name := oc.OperationName
+	if name == "" && oc.Operation != nil {
+		name = oc.Operation.Name
+		if name == "" {
+			name = string(oc.Operation.Operation)
+		}
+	}
+	if name == "" {
+		return next(ctx)
+	}
+	return next(db.WithOperation(ctx, name))
+}
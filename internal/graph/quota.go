@@ -0,0 +1,93 @@
+package graph
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"warimas-be/internal/quota"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// QuotaDirective implements @quota: it counts the call against the user's
+// daily allowance for the field and rejects it with QUOTA_EXCEEDED once
+// that is used up.
+func (r *Resolver) QuotaDirective(ctx context.Context, obj interface{}, next graphql.Resolver, daily int32) (res interface{}, err error) {
+	if r.QuotaSvc == nil {
+		return next(ctx)
+	}
+
+	fc := graphql.GetFieldContext(ctx)
+	err = r.QuotaSvc.Consume(ctx, fc.Object+"."+fc.Field.Name, int(daily))
+	if errors.Is(err, quota.ErrQuotaExceeded) {
+		return nil, &gqlerror.Error{
+			Message: "daily quota exceeded for " + fc.Field.Name,
+			Extensions: map[string]any{
+				"code":  "QUOTA_EXCEEDED",
+				"daily": daily,
+			},
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return next(ctx)
+}
+
+// UsageTracker counts every root field an authenticated user asks for, so
+// abusive patterns show up even on fields without a quota. Fields with a
+// @quota are counted by the directive instead. Aliases count separately,
+// so batching one field many times in an operation is not a way around it.
+type UsageTracker struct {
+	Svc quota.Service
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.OperationInterceptor
+} = UsageTracker{}
+
+func (UsageTracker) ExtensionName() string {
+	return "UsageTracker"
+}
+
+func (UsageTracker) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (t UsageTracker) InterceptOperation(ctx context.Context, next graphql.OperationHandler) graphql.ResponseHandler {
+	op := graphql.GetOperationContext(ctx).Operation
+	if op == nil {
+		return next(ctx)
+	}
+
+	object := operationObject(op.Operation)
+	for _, sel := range op.SelectionSet {
+		f, ok := sel.(*ast.Field)
+		if !ok || strings.HasPrefix(f.Name, "__") {
+			continue
+		}
+		if f.Definition != nil && f.Definition.Directives.ForName("quota") != nil {
+			continue
+		}
+		t.Svc.Record(ctx, object+"."+f.Name)
+	}
+
+	return next(ctx)
+}
+
+// operationObject is the root type name for an operation type, matching
+// graphql.FieldContext.Object.
+func operationObject(op ast.Operation) string {
+	switch op {
+	case ast.Mutation:
+		return "Mutation"
+	case ast.Subscription:
+		return "Subscription"
+	default:
+		return "Query"
+	}
+}
@@ -0,0 +1,40 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"time"
+	"warimas-be/internal/graph/model"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/quota"
+
+	"go.uber.org/zap"
+)
+
+// UsageFlags is the resolver for the usageFlags field.
+func (r *queryResolver) UsageFlags(ctx context.Context, since *time.Time, limit *int32) ([]*model.UsageFlag, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "UsageFlags"),
+	)
+
+	var l int32
+	if limit != nil {
+		l = *limit
+	}
+
+	flags, err := r.QuotaSvc.ListFlags(ctx, since, l)
+	if err != nil {
+		log.Error("failed to list usage flags", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]*model.UsageFlag, 0, len(flags))
+	for _, f := range flags {
+		out = append(out, quota.MapFlagToGraphQL(f))
+	}
+	return out, nil
+}
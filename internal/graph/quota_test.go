@@ -0,0 +1,90 @@
+package graph
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+	"warimas-be/internal/maintenance"
+	"warimas-be/internal/quota"
+	"warimas-be/internal/utils"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubQuota struct {
+	consumed []string
+	recorded []string
+	exceeded bool
+}
+
+func (s *stubQuota) Consume(_ context.Context, operation string, _ int) error {
+	s.consumed = append(s.consumed, operation)
+	if s.exceeded {
+		return quota.ErrQuotaExceeded
+	}
+	return nil
+}
+
+func (s *stubQuota) Record(_ context.Context, operation string) {
+	s.recorded = append(s.recorded, operation)
+}
+
+func (s *stubQuota) Flush(context.Context) (int, error)           { return 0, nil }
+func (s *stubQuota) DetectAnomalies(context.Context) (int, error) { return 0, nil }
+
+func (s *stubQuota) ListFlags(context.Context, *time.Time, int32) ([]*quota.Flag, error) {
+	return nil, nil
+}
+
+func runTracked(t *testing.T, svc *stubQuota, query string) gqlResponse {
+	t.Helper()
+
+	srv := handler.New(NewAdminSchema(&Resolver{
+		MaintenanceSvc: stubMaintenance{mode: &maintenance.Mode{}},
+		QuotaSvc:       svc,
+	}))
+	srv.AddTransport(transport.POST{})
+	srv.Use(UsageTracker{Svc: svc})
+
+	body, err := json.Marshal(map[string]string{"query": query})
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, "/query", strings.NewReader(string(body)))
+	req.Header.Set("Content-Type", "application/json")
+	req = req.WithContext(utils.SetUserContext(req.Context(), 1, "a@example.com", "ADMIN"))
+
+	w := httptest.NewRecorder()
+	srv.ServeHTTP(w, req)
+
+	var resp gqlResponse
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+	return resp
+}
+
+func TestQuotaDirective_Exceeded(t *testing.T) {
+	svc := &stubQuota{exceeded: true}
+
+	resp := runTracked(t, svc, `{ retentionPreview { affected } }`)
+
+	require.Len(t, resp.Errors, 1)
+	assert.Equal(t, "QUOTA_EXCEEDED", resp.Errors[0].Extensions["code"])
+	assert.Equal(t, []string{"Query.retentionPreview"}, svc.consumed)
+	// Fields with a quota are counted by the directive only.
+	assert.Empty(t, svc.recorded)
+}
+
+func TestUsageTracker(t *testing.T) {
+	svc := &stubQuota{}
+
+	resp := runTracked(t, svc, `{ a: maintenanceMode { enabled } b: maintenanceMode { enabled } __typename }`)
+
+	require.Empty(t, resp.Errors)
+	assert.Equal(t, []string{"Query.maintenanceMode", "Query.maintenanceMode"}, svc.recorded)
+	assert.Empty(t, svc.consumed)
+}
@@ -0,0 +1,58 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"warimas-be/internal/graph/model"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/receipt"
+
+	"go.uber.org/zap"
+)
+
+// ResendOrderReceipt is the resolver for the resendOrderReceipt field.
+func (r *mutationResolver) ResendOrderReceipt(ctx context.Context, orderID string) (*model.OrderReceipt, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "ResendOrderReceipt"),
+		zap.String("order_id", orderID),
+	)
+
+	id, err := receipt.ParseOrderID(orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := r.ReceiptSvc.Resend(ctx, id)
+	if err != nil {
+		log.Error("failed to resend order receipt", zap.Error(err))
+		return nil, err
+	}
+
+	return receipt.MapStatusToGraphQL(status), nil
+}
+
+// OrderReceipt is the resolver for the orderReceipt field.
+func (r *queryResolver) OrderReceipt(ctx context.Context, orderID string) (*model.OrderReceipt, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "OrderReceipt"),
+		zap.String("order_id", orderID),
+	)
+
+	id, err := receipt.ParseOrderID(orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := r.ReceiptSvc.Status(ctx, id)
+	if err != nil {
+		log.Error("failed to get order receipt", zap.Error(err))
+		return nil, err
+	}
+
+	return receipt.MapStatusToGraphQL(status), nil
+}
@@ -0,0 +1,30 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"warimas-be/internal/graph/model"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/referral"
+
+	"go.uber.org/zap"
+)
+
+// MyReferral is the resolver for the myReferral field.
+func (r *queryResolver) MyReferral(ctx context.Context) (*model.ReferralStats, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "MyReferral"),
+	)
+
+	stats, err := r.ReferralSvc.GetMyReferral(ctx)
+	if err != nil {
+		log.Error("failed to get referral stats", zap.Error(err))
+		return nil, err
+	}
+
+	return referral.MapStatsToGraphQL(stats), nil
+}
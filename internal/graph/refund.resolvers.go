@@ -0,0 +1,91 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"warimas-be/internal/graph/model"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/order"
+	"warimas-be/internal/refund"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+// RequestRefund is the resolver for the requestRefund field.
+func (r *mutationResolver) RequestRefund(ctx context.Context, input model.RequestRefundInput) (*model.RequestRefundResponse, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "RequestRefund"),
+		zap.String("order_id", input.OrderID),
+	)
+
+	orderID, err := utils.ToUint(input.OrderID)
+	if err != nil {
+		log.Warn("invalid order id", zap.Error(err))
+		return nil, err
+	}
+
+	reasonID, err := order.ParseReasonID(input.ReasonID)
+	if err != nil {
+		log.Warn("invalid reason id", zap.Error(err))
+		return nil, refund.ErrInvalidReason
+	}
+
+	refunds, err := r.RefundSvc.RequestRefund(ctx, orderID, refund.Method(input.Method), reasonID, input.Reason)
+	if err != nil {
+		log.Error("failed to request refund", zap.Error(err))
+		return nil, err
+	}
+
+	return &model.RequestRefundResponse{
+		Refunds: refund.MapRefundsToGraphQL(refunds),
+	}, nil
+}
+
+// ProcessPendingRefunds is the resolver for the processPendingRefunds field.
+func (r *mutationResolver) ProcessPendingRefunds(ctx context.Context, limit *int32) (int32, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "ProcessPendingRefunds"),
+	)
+
+	var l int32
+	if limit != nil {
+		l = *limit
+	}
+
+	processed, err := r.RefundSvc.ProcessPendingGatewayRefunds(ctx, l)
+	if err != nil {
+		log.Error("failed to process pending refunds", zap.Error(err))
+		return 0, err
+	}
+
+	return int32(processed), nil
+}
+
+// OrderRefunds is the resolver for the orderRefunds field.
+func (r *queryResolver) OrderRefunds(ctx context.Context, orderID string) ([]*model.Refund, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "OrderRefunds"),
+		zap.String("order_id", orderID),
+	)
+
+	oid, err := utils.ToUint(orderID)
+	if err != nil {
+		log.Warn("invalid order id", zap.Error(err))
+		return nil, err
+	}
+
+	refunds, err := r.RefundSvc.GetOrderRefunds(ctx, oid)
+	if err != nil {
+		log.Error("failed to get order refunds", zap.Error(err))
+		return nil, err
+	}
+
+	return refund.MapRefundsToGraphQL(refunds), nil
+}
@@ -2,33 +2,120 @@ package graph
 
 import (
 	"database/sql"
+	"warimas-be/internal/accounting"
 	"warimas-be/internal/address"
+	"warimas-be/internal/apikey"
 	"warimas-be/internal/cart"
 	"warimas-be/internal/category"
+	"warimas-be/internal/changelog"
+	"warimas-be/internal/commission"
+	"warimas-be/internal/consent"
+	"warimas-be/internal/dbhealth"
+	"warimas-be/internal/dispute"
+	"warimas-be/internal/experiment"
+	"warimas-be/internal/export"
+	"warimas-be/internal/fulfillment"
+	"warimas-be/internal/guest"
+	"warimas-be/internal/inventory"
+	"warimas-be/internal/logsettings"
+	"warimas-be/internal/loyalty"
+	"warimas-be/internal/maintenance"
+	"warimas-be/internal/moderation"
+	"warimas-be/internal/ops"
 	"warimas-be/internal/order"
+	"warimas-be/internal/orderchat"
 	"warimas-be/internal/packages"
+	"warimas-be/internal/preference"
+	"warimas-be/internal/pricechange"
 	"warimas-be/internal/product"
+	"warimas-be/internal/quota"
+	"warimas-be/internal/receipt"
+	"warimas-be/internal/referral"
+	"warimas-be/internal/refund"
+	"warimas-be/internal/retention"
+	"warimas-be/internal/shipment"
+	"warimas-be/internal/sla"
+	"warimas-be/internal/stockalert"
+	"warimas-be/internal/store"
+	"warimas-be/internal/synthetic"
+	"warimas-be/internal/uploads"
 	"warimas-be/internal/user"
+	"warimas-be/internal/voucher"
+	"warimas-be/internal/wallet"
+	"warimas-be/internal/wishlist"
 
 	"github.com/99designs/gqlgen/graphql"
 )
 
 type Resolver struct {
-	DB          *sql.DB
-	ProductSvc  product.Service
-	UserSvc     user.Service
-	CartSvc     cart.Service
-	OrderSvc    order.Service
-	CategorySvc category.Service
-	AddressSvc  address.Service
-	PackageSvc  packages.Service
+	DB             *sql.DB
+	ProductSvc     product.Service
+	UserSvc        user.Service
+	CartSvc        cart.Service
+	OrderSvc       order.Service
+	CategorySvc    category.Service
+	AddressSvc     address.Service
+	PackageSvc     packages.Service
+	WalletSvc      wallet.Service
+	RefundSvc      refund.Service
+	VoucherSvc     voucher.Service
+	ReferralSvc    referral.Service
+	LoyaltySvc     loyalty.Service
+	ConsentSvc     consent.Service
+	PreferenceSvc  preference.Service
+	ModerationSvc  moderation.Service
+	RetentionSvc   retention.Service
+	OpsSvc         ops.Service
+	SLASvc         sla.Service
+	DisputeSvc     dispute.Service
+	InventorySvc   inventory.Service
+	FulfillmentSvc fulfillment.Service
+	ShipmentSvc    shipment.Service
+	MaintenanceSvc maintenance.Service
+	LogSettingsSvc logsettings.Service
+	QuotaSvc       quota.Service
+	APIKeySvc      apikey.Service
+	ChangeLogSvc   changelog.Service
+	UploadsSvc     uploads.Service
+	WishlistSvc    wishlist.Service
+	StockAlertSvc  stockalert.Service
+	PriceChangeSvc pricechange.Service
+	ExportSvc      export.Service
+	StoreSvc       store.Service
+	OrderChatSvc   orderchat.Service
+	CommissionSvc  commission.Service
+	AccountingSvc  accounting.Service
+	ReceiptSvc     receipt.Service
+	ExperimentSvc  experiment.Service
+	DBHealthSvc    dbhealth.Service
+	SyntheticSvc   synthetic.Service
+	// GuestWrites caps the @guestWrite fields for guests; nil leaves them
+	// uncapped.
+	GuestWrites *guest.WriteLimiter
 }
 
+// NewSchema is the storefront schema served on /query; admin-only fields
+// are left out.
 func NewSchema(r *Resolver) graphql.ExecutableSchema {
-	return NewExecutableSchema(Config{
+	cfg := schemaConfig(r)
+	cfg.Schema = storefront()
+	return NewExecutableSchema(cfg)
+}
+
+// NewAdminSchema is the full schema, admin fields included, served on
+// /admin/query.
+func NewAdminSchema(r *Resolver) graphql.ExecutableSchema {
+	return NewExecutableSchema(schemaConfig(r))
+}
+
+func schemaConfig(r *Resolver) Config {
+	return Config{
 		Resolvers: r,
 		Directives: DirectiveRoot{
-			Auth: AuthDirective,
+			Auth:       AuthDirective,
+			Quota:      r.QuotaDirective,
+			GuestWrite: r.GuestWriteDirective,
+			Scope:      ScopeDirective,
 		},
-	})
+	}
 }
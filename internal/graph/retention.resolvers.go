@@ -0,0 +1,34 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"warimas-be/internal/graph/model"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/retention"
+
+	"go.uber.org/zap"
+)
+
+// RetentionPreview is the resolver for the retentionPreview field.
+func (r *queryResolver) RetentionPreview(ctx context.Context) ([]*model.RetentionPolicyResult, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "RetentionPreview"),
+	)
+
+	results, err := r.RetentionSvc.Preview(ctx)
+	if err != nil {
+		log.Error("failed to preview retention", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]*model.RetentionPolicyResult, 0, len(results))
+	for _, res := range results {
+		out = append(out, retention.MapResultToGraphQL(res))
+	}
+	return out, nil
+}
@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"sync/atomic"
+	"time"
 	"warimas-be/internal/graph/model"
 
 	"github.com/99designs/gqlgen/graphql"
@@ -40,10 +41,21 @@ type ResolverRoot interface {
 }
 
 type DirectiveRoot struct {
-	Auth func(ctx context.Context, obj any, next graphql.Resolver, role *model.Role) (res any, err error)
+	Auth       func(ctx context.Context, obj any, next graphql.Resolver, role *model.Role) (res any, err error)
+	GuestWrite func(ctx context.Context, obj any, next graphql.Resolver) (res any, err error)
+	Quota      func(ctx context.Context, obj any, next graphql.Resolver, daily int32) (res any, err error)
+	Scope      func(ctx context.Context, obj any, next graphql.Resolver, scope model.APIKeyScope) (res any, err error)
 }
 
 type ComplexityRoot struct {
+	AccountRestriction struct {
+		CreatedAt func(childComplexity int) int
+		ExpiresAt func(childComplexity int) int
+		Level     func(childComplexity int) int
+		Reason    func(childComplexity int) int
+		UserID    func(childComplexity int) int
+	}
+
 	AddToCartResponse struct {
 		CartItem func(childComplexity int) int
 		Message  func(childComplexity int) int
@@ -57,6 +69,7 @@ type ComplexityRoot struct {
 		Country      func(childComplexity int) int
 		ID           func(childComplexity int) int
 		IsDefault    func(childComplexity int) int
+		Location     func(childComplexity int) int
 		Name         func(childComplexity int) int
 		Phone        func(childComplexity int) int
 		PostalCode   func(childComplexity int) int
@@ -64,11 +77,87 @@ type ComplexityRoot struct {
 		ReceiverName func(childComplexity int) int
 	}
 
+	AdminDashboard struct {
+		FailedWebhooks   func(childComplexity int) int
+		GeneratedAt      func(childComplexity int) int
+		LowStockVariants func(childComplexity int) int
+		OrdersToday      func(childComplexity int) int
+		PendingShipments func(childComplexity int) int
+		RevenueToday     func(childComplexity int) int
+	}
+
+	ApiKey struct {
+		CreatedAt  func(childComplexity int) int
+		ExpiresAt  func(childComplexity int) int
+		ID         func(childComplexity int) int
+		LastUsedAt func(childComplexity int) int
+		Name       func(childComplexity int) int
+		Prefix     func(childComplexity int) int
+		RevokedAt  func(childComplexity int) int
+		Scopes     func(childComplexity int) int
+	}
+
+	ApplyCouponResponse struct {
+		Discount func(childComplexity int) int
+		Success  func(childComplexity int) int
+	}
+
+	ApplySessionPointsResponse struct {
+		Discount       func(childComplexity int) int
+		PointsRedeemed func(childComplexity int) int
+		Success        func(childComplexity int) int
+	}
+
+	ApplySessionWalletResponse struct {
+		Success func(childComplexity int) int
+	}
+
 	AuthResponse struct {
 		Token func(childComplexity int) int
 		User  func(childComplexity int) int
 	}
 
+	BackInStockSubscription struct {
+		CreatedAt   func(childComplexity int) int
+		ID          func(childComplexity int) int
+		ImageURL    func(childComplexity int) int
+		ProductID   func(childComplexity int) int
+		ProductName func(childComplexity int) int
+		VariantID   func(childComplexity int) int
+		VariantName func(childComplexity int) int
+	}
+
+	BloatedTable struct {
+		DeadPercent   func(childComplexity int) int
+		DeadTuples    func(childComplexity int) int
+		LastAnalyzeAt func(childComplexity int) int
+		LastVacuumAt  func(childComplexity int) int
+		LiveTuples    func(childComplexity int) int
+		Table         func(childComplexity int) int
+	}
+
+	BlockedDisplayNameTerm struct {
+		CreatedAt func(childComplexity int) int
+		Term      func(childComplexity int) int
+	}
+
+	CampaignPerformance struct {
+		CampaignID           func(childComplexity int) int
+		CampaignName         func(childComplexity int) int
+		DiscountCost         func(childComplexity int) int
+		NetRevenueInfluenced func(childComplexity int) int
+		NewCustomers         func(childComplexity int) int
+		Redemptions          func(childComplexity int) int
+		ReturningCustomers   func(childComplexity int) int
+		RevenueInfluenced    func(childComplexity int) int
+		UniqueCustomers      func(childComplexity int) int
+	}
+
+	CartConnection struct {
+		Items    func(childComplexity int) int
+		PageInfo func(childComplexity int) int
+	}
+
 	CartItem struct {
 		CreatedAt func(childComplexity int) int
 		ID        func(childComplexity int) int
@@ -78,9 +167,14 @@ type ComplexityRoot struct {
 		UserID    func(childComplexity int) int
 	}
 
-	CartListResponse struct {
-		Items    func(childComplexity int) int
-		PageInfo func(childComplexity int) int
+	CatalogExport struct {
+		CreatedAt  func(childComplexity int) int
+		Error      func(childComplexity int) int
+		FinishedAt func(childComplexity int) int
+		ID         func(childComplexity int) int
+		RowCount   func(childComplexity int) int
+		Status     func(childComplexity int) int
+		URL        func(childComplexity int) int
 	}
 
 	Category struct {
@@ -90,25 +184,60 @@ type ComplexityRoot struct {
 		Subcategories func(childComplexity int) int
 	}
 
-	CategoryPage struct {
+	CategoryConnection struct {
 		Items    func(childComplexity int) int
 		PageInfo func(childComplexity int) int
 	}
 
+	CheckoutRule struct {
+		FreeShippingMin func(childComplexity int) int
+		ID              func(childComplexity int) int
+		IsActive        func(childComplexity int) int
+		MinOrderAmount  func(childComplexity int) int
+		Region          func(childComplexity int) int
+		UpdatedAt       func(childComplexity int) int
+	}
+
 	CheckoutSession struct {
-		AddressID     func(childComplexity int) int
-		CreatedAt     func(childComplexity int) int
-		Discount      func(childComplexity int) int
-		ExpiresAt     func(childComplexity int) int
-		ExternalID    func(childComplexity int) int
-		ID            func(childComplexity int) int
-		Items         func(childComplexity int) int
-		PaymentMethod func(childComplexity int) int
-		ShippingFee   func(childComplexity int) int
-		Status        func(childComplexity int) int
-		Subtotal      func(childComplexity int) int
-		Tax           func(childComplexity int) int
-		TotalPrice    func(childComplexity int) int
+		AddressID               func(childComplexity int) int
+		ChargeableWeightGrams   func(childComplexity int) int
+		CreatedAt               func(childComplexity int) int
+		DeliveryDate            func(childComplexity int) int
+		DeliverySlotID          func(childComplexity int) int
+		Discount                func(childComplexity int) int
+		ExpiresAt               func(childComplexity int) int
+		ExternalID              func(childComplexity int) int
+		ID                      func(childComplexity int) int
+		InsuranceFee            func(childComplexity int) int
+		Insured                 func(childComplexity int) int
+		Items                   func(childComplexity int) int
+		PaymentExpiresAt        func(childComplexity int) int
+		PaymentMethod           func(childComplexity int) int
+		PaymentSecondsRemaining func(childComplexity int) int
+		PickupLocationID        func(childComplexity int) int
+		PickupSlotStart         func(childComplexity int) int
+		PointsRedeemed          func(childComplexity int) int
+		SecondsRemaining        func(childComplexity int) int
+		ShippingFee             func(childComplexity int) int
+		ShippingMethod          func(childComplexity int) int
+		Status                  func(childComplexity int) int
+		Subtotal                func(childComplexity int) int
+		Tax                     func(childComplexity int) int
+		TotalPrice              func(childComplexity int) int
+		WalletAmount            func(childComplexity int) int
+	}
+
+	CheckoutSessionEvent struct {
+		ActorGuestID func(childComplexity int) int
+		ActorRole    func(childComplexity int) int
+		ActorUserID  func(childComplexity int) int
+		CreatedAt    func(childComplexity int) int
+		FromValue    func(childComplexity int) int
+		ID           func(childComplexity int) int
+		ToValue      func(childComplexity int) int
+		TotalAfter   func(childComplexity int) int
+		TotalBefore  func(childComplexity int) int
+		Type         func(childComplexity int) int
 	}
 
 	CheckoutSessionItem struct {
@@ -129,16 +258,56 @@ type ComplexityRoot struct {
 		Status     func(childComplexity int) int
 	}
 
+	ClientContext struct {
+		CountryCode func(childComplexity int) int
+		Currency    func(childComplexity int) int
+		Region      func(childComplexity int) int
+		Source      func(childComplexity int) int
+	}
+
+	CommissionRate struct {
+		CategoryID    func(childComplexity int) int
+		CreatedAt     func(childComplexity int) int
+		EffectiveFrom func(childComplexity int) int
+		FixedFee      func(childComplexity int) int
+		ID            func(childComplexity int) int
+		RateBps       func(childComplexity int) int
+	}
+
+	ComparedProduct struct {
+		InStock  func(childComplexity int) int
+		MaxPrice func(childComplexity int) int
+		MinPrice func(childComplexity int) int
+		Product  func(childComplexity int) int
+	}
+
 	ConfirmCheckoutSessionResponse struct {
 		Message         func(childComplexity int) int
 		OrderExternalID func(childComplexity int) int
 		Success         func(childComplexity int) int
 	}
 
+	CourierWebhook struct {
+		Attempts     func(childComplexity int) int
+		Awb          func(childComplexity int) int
+		Courier      func(childComplexity int) int
+		DeadAt       func(childComplexity int) int
+		EventID      func(childComplexity int) int
+		ID           func(childComplexity int) int
+		ProcessError func(childComplexity int) int
+		ReceivedAt   func(childComplexity int) int
+		Status       func(childComplexity int) int
+	}
+
 	CreateAddressResponse struct {
 		Address func(childComplexity int) int
 	}
 
+	CreateApiKeyResponse struct {
+		APIKey func(childComplexity int) int
+		Secret func(childComplexity int) int
+	}
+
 	CreateOrderResponse struct {
 		Message    func(childComplexity int) int
 		Order      func(childComplexity int) int
@@ -147,54 +316,386 @@ type ComplexityRoot struct {
 		Success    func(childComplexity int) int
 	}
 
+	CustomerSegmentSize struct {
+		Members func(childComplexity int) int
+		Segment func(childComplexity int) int
+	}
+
+	DatabaseHealthReport struct {
+		BloatedTables          func(childComplexity int) int
+		GeneratedAt            func(childComplexity int) int
+		LongRunningQueries     func(childComplexity int) int
+		MissingIndexCandidates func(childComplexity int) int
+		SlowStatements         func(childComplexity int) int
+		StatementsAvailable    func(childComplexity int) int
+		UnusedIndexes          func(childComplexity int) int
+	}
+
 	DeleteAddressResponse struct {
 		Success func(childComplexity int) int
 	}
 
+	DeliverySlot struct {
+		Capacity  func(childComplexity int) int
+		EndsAt    func(childComplexity int) int
+		ID        func(childComplexity int) int
+		IsActive  func(childComplexity int) int
+		Region    func(childComplexity int) int
+		StartsAt  func(childComplexity int) int
+		UpdatedAt func(childComplexity int) int
+	}
+
+	DeliverySlotOption struct {
+		Available func(childComplexity int) int
+		End       func(childComplexity int) int
+		Remaining func(childComplexity int) int
+		Slot      func(childComplexity int) int
+		Start     func(childComplexity int) int
+	}
+
+	DeliveryWindow struct {
+		Date   func(childComplexity int) int
+		End    func(childComplexity int) int
+		SlotID func(childComplexity int) int
+		Start  func(childComplexity int) int
+	}
+
+	Experiment struct {
+		Active      func(childComplexity int) int
+		Description func(childComplexity int) int
+		EndedAt     func(childComplexity int) int
+		Key         func(childComplexity int) int
+		StartedAt   func(childComplexity int) int
+		Variants    func(childComplexity int) int
+	}
+
+	ExperimentAssignment struct {
+		ExperimentKey func(childComplexity int) int
+		Variant       func(childComplexity int) int
+	}
+
+	ExperimentVariant struct {
+		Name   func(childComplexity int) int
+		Weight func(childComplexity int) int
+	}
+
+	ExperimentVariantResult struct {
+		Orders     func(childComplexity int) int
+		PaidOrders func(childComplexity int) int
+		Revenue    func(childComplexity int) int
+		Variant    func(childComplexity int) int
+	}
+
 	ForgotPasswordResponse struct {
 		Message func(childComplexity int) int
 		Success func(childComplexity int) int
 	}
 
+	FulfillmentTask struct {
+		AssignedAt      func(childComplexity int) int
+		ItemCount       func(childComplexity int) int
+		OrderExternalID func(childComplexity int) int
+		OrderID         func(childComplexity int) int
+		OrderStatus     func(childComplexity int) int
+		OrderedAt       func(childComplexity int) int
+		PackedAt        func(childComplexity int) int
+		PackedBy        func(childComplexity int) int
+		PickerID        func(childComplexity int) int
+	}
+
+	JournalExport struct {
+		CSV         func(childComplexity int) int
+		EntryCount  func(childComplexity int) int
+		From        func(childComplexity int) int
+		To          func(childComplexity int) int
+		TotalCredit func(childComplexity int) int
+		TotalDebit  func(childComplexity int) int
+	}
+
+	Location struct {
+		Latitude  func(childComplexity int) int
+		Longitude func(childComplexity int) int
+	}
+
+	LogSettings struct {
+		DebugModules func(childComplexity int) int
+		ExpiresAt    func(childComplexity int) int
+		Level        func(childComplexity int) int
+		Sampling     func(childComplexity int) int
+		UpdatedAt    func(childComplexity int) int
+	}
+
+	LongRunningQuery struct {
+		ApplicationName func(childComplexity int) int
+		DurationSeconds func(childComplexity int) int
+		Module          func(childComplexity int) int
+		Operation       func(childComplexity int) int
+		Pid             func(childComplexity int) int
+		Query           func(childComplexity int) int
+		RequestID       func(childComplexity int) int
+		State           func(childComplexity int) int
+		UserID          func(childComplexity int) int
+		WaitEvent       func(childComplexity int) int
+	}
+
+	LoyaltyAccount struct {
+		Balance func(childComplexity int) int
+		Entries func(childComplexity int) int
+	}
+
+	LoyaltyLedgerEntry struct {
+		BalanceAfter  func(childComplexity int) int
+		CreatedAt     func(childComplexity int) int
+		ExpiresAt     func(childComplexity int) int
+		ID            func(childComplexity int) int
+		Points        func(childComplexity int) int
+		ReferenceID   func(childComplexity int) int
+		ReferenceType func(childComplexity int) int
+		Type          func(childComplexity int) int
+	}
+
+	LoyaltyRule struct {
+		CreatedAt      func(childComplexity int) int
+		EndsAt         func(childComplexity int) int
+		ID             func(childComplexity int) int
+		IsActive       func(childComplexity int) int
+		MinOrderAmount func(childComplexity int) int
+		Name           func(childComplexity int) int
+		PointsPerUnit  func(childComplexity int) int
+		StartsAt       func(childComplexity int) int
+		UnitAmount     func(childComplexity int) int
+	}
+
+	MaintenanceMode struct {
+		AllowReads func(childComplexity int) int
+		Enabled    func(childComplexity int) int
+		Message    func(childComplexity int) int
+		Source     func(childComplexity int) int
+		UpdatedAt  func(childComplexity int) int
+	}
+
+	MarketingConsent struct {
+		Channel        func(childComplexity int) int
+		Status         func(childComplexity int) int
+		SubscribedAt   func(childComplexity int) int
+		UnsubscribedAt func(childComplexity int) int
+	}
+
+	ModerationAuditEntry struct {
+		Action       func(childComplexity int) int
+		ActorID      func(childComplexity int) int
+		CreatedAt    func(childComplexity int) int
+		ID           func(childComplexity int) int
+		ItemID       func(childComplexity int) int
+		Note         func(childComplexity int) int
+		TargetUserID func(childComplexity int) int
+	}
+
+	ModerationItem struct {
+		AuthorID        func(childComplexity int) int
+		Body            func(childComplexity int) int
+		ContentID       func(childComplexity int) int
+		ContentType     func(childComplexity int) int
+		CreatedAt       func(childComplexity int) int
+		HiddenAt        func(childComplexity int) int
+		ID              func(childComplexity int) int
+		MatchedKeywords func(childComplexity int) int
+		ReportCount     func(childComplexity int) int
+		ResolvedAt      func(childComplexity int) int
+		ResolvedBy      func(childComplexity int) int
+		Status          func(childComplexity int) int
+		UpdatedAt       func(childComplexity int) int
+	}
+
+	ModerationKeyword struct {
+		CreatedAt func(childComplexity int) int
+		Term      func(childComplexity int) int
+	}
+
+	ModuleQueryStats struct {
+		Calls       func(childComplexity int) int
+		MeanTimeMs  func(childComplexity int) int
+		Module      func(childComplexity int) int
+		Statements  func(childComplexity int) int
+		TotalTimeMs func(childComplexity int) int
+	}
+
 	Mutation struct {
-		AddCategory                func(childComplexity int, name string) int
-		AddPackage                 func(childComplexity int, input model.AddPackageInput) int
-		AddSubcategory             func(childComplexity int, categoryID string, name string) int
-		AddToCart                  func(childComplexity int, input model.AddToCartInput) int
-		ConfirmCheckoutSession     func(childComplexity int, input model.ConfirmCheckoutSessionInput) int
-		CreateAddress              func(childComplexity int, input model.CreateAddressInput) int
-		CreateCheckoutSession      func(childComplexity int, input model.CreateCheckoutSessionInput) int
-		CreateOrderFromSession     func(childComplexity int, input model.CreateOrderFromSessionInput) int
-		CreateProduct              func(childComplexity int, input model.NewProduct) int
-		CreateVariants             func(childComplexity int, input []*model.NewVariant) int
-		DeleteAddress              func(childComplexity int, input model.DeleteAddressInput) int
-		ForgotPassword             func(childComplexity int, input model.ForgotPasswordInput) int
-		Login                      func(childComplexity int, input model.LoginInput) int
-		Logout                     func(childComplexity int) int
-		Register                   func(childComplexity int, input model.RegisterInput) int
-		RemoveFromCart             func(childComplexity int, variantIds []string) int
-		ResetPassword              func(childComplexity int, input model.ResetPasswordInput) int
-		SetDefaultAddress          func(childComplexity int, addressID string) int
-		UpdateAddress              func(childComplexity int, input model.UpdateAddressInput) int
-		UpdateCart                 func(childComplexity int, input model.UpdateCartInput) int
-		UpdateOrderStatus          func(childComplexity int, input model.UpdateOrderStatusInput) int
-		UpdateProduct              func(childComplexity int, input model.UpdateProduct) int
-		UpdateProfile              func(childComplexity int, input model.UpdateProfileInput) int
-		UpdateSessionAddress       func(childComplexity int, input model.UpdateSessionAddressInput) int
-		UpdateSessionPaymentMethod func(childComplexity int, input model.UpdateSessionPaymentMethodInput) int
-		UpdateVariants             func(childComplexity int, input []*model.UpdateVariant) int
+		AddCategory                     func(childComplexity int, name string) int
+		AddModerationKeyword            func(childComplexity int, term string) int
+		AddPackage                      func(childComplexity int, input model.AddPackageInput) int
+		AddSubcategory                  func(childComplexity int, categoryID string, name string) int
+		AddToCart                       func(childComplexity int, input model.AddToCartInput) int
+		AddToWishlist                   func(childComplexity int, variantID string) int
+		AdjustStock                     func(childComplexity int, input model.AdjustStockInput) int
+		ApplyCoupon                     func(childComplexity int, input model.ApplyCouponInput) int
+		ApplySessionPoints              func(childComplexity int, input model.ApplySessionPointsInput) int
+		ApplySessionWallet              func(childComplexity int, input model.ApplySessionWalletInput) int
+		ApproveModerationItem           func(childComplexity int, id string, note *string) int
+		ApproveOrderAdjustment          func(childComplexity int, id string) int
+		ApproveStockAdjustment          func(childComplexity int, id string) int
+		ArchiveProduct                  func(childComplexity int, id string) int
+		AssignOrderPicker               func(childComplexity int, orderID string, pickerID string) int
+		BanModerationAuthor             func(childComplexity int, id string, note *string) int
+		BlockDisplayNameTerm            func(childComplexity int, term string) int
+		CancelMyStoreVacation           func(childComplexity int, id string) int
+		CancelScheduledPriceChange      func(childComplexity int, id string) int
+		CancelStockTransfer             func(childComplexity int, id string) int
+		ConfirmCheckoutSession          func(childComplexity int, input model.ConfirmCheckoutSessionInput) int
+		CreateAPIKey                    func(childComplexity int, input model.CreateAPIKeyInput) int
+		CreateAddress                   func(childComplexity int, input model.CreateAddressInput) int
+		CreateAdminOrder                func(childComplexity int, input model.CreateAdminOrderInput) int
+		CreateCheckoutSession           func(childComplexity int, input model.CreateCheckoutSessionInput) int
+		CreateCommissionRate            func(childComplexity int, input model.CreateCommissionRateInput) int
+		CreateExperiment                func(childComplexity int, input model.CreateExperimentInput) int
+		CreateLoyaltyRule               func(childComplexity int, input model.CreateLoyaltyRuleInput) int
+		CreateMyStoreShippingOrigin     func(childComplexity int, input model.StoreShippingOriginInput) int
+		CreateOrderFromSession          func(childComplexity int, input model.CreateOrderFromSessionInput) int
+		CreateProduct                   func(childComplexity int, input model.NewProduct) int
+		CreateStockTransfer             func(childComplexity int, input model.CreateStockTransferInput) int
+		CreateVariants                  func(childComplexity int, input []*model.NewVariant) int
+		CreateVoucherCampaign           func(childComplexity int, input model.CreateVoucherCampaignInput) int
+		CreateWarehouse                 func(childComplexity int, input model.CreateWarehouseInput) int
+		DeleteAddress                   func(childComplexity int, input model.DeleteAddressInput) int
+		DeleteCommissionRate            func(childComplexity int, id string) int
+		DeleteMyStoreShippingOrigin     func(childComplexity int, id string) int
+		DeleteProduct                   func(childComplexity int, id string) int
+		EndExperiment                   func(childComplexity int, key string) int
+		ForgotPassword                  func(childComplexity int, input model.ForgotPasswordInput) int
+		IssueSegmentVouchers            func(childComplexity int, input model.IssueSegmentVouchersInput) int
+		LiftAccountRestriction          func(childComplexity int, userID string) int
+		Login                           func(childComplexity int, input model.LoginInput) int
+		LoginWithOtp                    func(childComplexity int, phone string, code string) int
+		LoginWithPhone                  func(childComplexity int, input model.PhoneLoginInput) int
+		Logout                          func(childComplexity int) int
+		MarkOrderMessagesRead           func(childComplexity int, orderID string) int
+		MarkOrderPacked                 func(childComplexity int, orderID string) int
+		MarkWishlistAlertsRead          func(childComplexity int) int
+		ProcessPendingRefunds           func(childComplexity int, limit *int32) int
+		PublishPolicy                   func(childComplexity int, input model.PublishPolicyInput) int
+		ReceiveStockTransfer            func(childComplexity int, id string) int
+		RecordSettlementFees            func(childComplexity int, fees []*model.SettlementFeeInput) int
+		RefreshCustomerSegments         func(childComplexity int) int
+		Register                        func(childComplexity int, input model.RegisterInput) int
+		RejectOrderAdjustment           func(childComplexity int, id string) int
+		RejectStockAdjustment           func(childComplexity int, id string) int
+		RemoveFromCart                  func(childComplexity int, variantIds []string) int
+		RemoveFromWishlist              func(childComplexity int, variantID string) int
+		RemoveModerationItem            func(childComplexity int, id string, note *string) int
+		RemoveModerationKeyword         func(childComplexity int, term string) int
+		RemoveSessionItem               func(childComplexity int, input model.RemoveSessionItemInput) int
+		ReportContent                   func(childComplexity int, contentType model.ModerationContentType, contentID string, reason *string) int
+		RequestCatalogExport            func(childComplexity int) int
+		RequestLoginOtp                 func(childComplexity int, phone string) int
+		RequestOrderAdjustment          func(childComplexity int, input model.RequestOrderAdjustmentInput) int
+		RequestPhoneVerification        func(childComplexity int, input model.RequestPhoneVerificationInput) int
+		RequestRefund                   func(childComplexity int, input model.RequestRefundInput) int
+		RequeueCourierWebhook           func(childComplexity int, id string) int
+		ResendOrderReceipt              func(childComplexity int, orderID string) int
+		ResetPassword                   func(childComplexity int, input model.ResetPasswordInput) int
+		ResolvePaymentDispute           func(childComplexity int, id string, outcome model.DisputeOutcome, note *string) int
+		RestrictAccount                 func(childComplexity int, input model.RestrictAccountInput) int
+		RevokeAPIKey                    func(childComplexity int, id string) int
+		RunSyntheticCheckout            func(childComplexity int) int
+		ScheduleMyStoreVacation         func(childComplexity int, input model.StoreVacationInput) int
+		SchedulePriceChange             func(childComplexity int, input model.SchedulePriceChangeInput) int
+		SendOrderMessage                func(childComplexity int, orderID string, body string, attachmentIds []string) int
+		SetCheckoutRule                 func(childComplexity int, input model.SetCheckoutRuleInput) int
+		SetDefaultAddress               func(childComplexity int, addressID string) int
+		SetDeliverySlot                 func(childComplexity int, input model.SetDeliverySlotInput) int
+		SetLogSettings                  func(childComplexity int, input model.SetLogSettingsInput) int
+		SetLoyaltyRuleActive            func(childComplexity int, id string, active bool) int
+		SetMaintenanceMode              func(childComplexity int, input model.SetMaintenanceModeInput) int
+		SetMyProductShippingOrigin      func(childComplexity int, productID string, originID *string) int
+		SetMyStoreDefaultShippingOrigin func(childComplexity int, id string) int
+		SetMyStoreOperatingHours        func(childComplexity int, hours []*model.StoreOperatingHoursInput) int
+		SetOrderReason                  func(childComplexity int, input model.SetOrderReasonInput) int
+		SetPickupLocation               func(childComplexity int, input model.SetPickupLocationInput) int
+		SetWarehouseActive              func(childComplexity int, id string, active bool) int
+		SetWarehouseStock               func(childComplexity int, warehouseID string, variantID string, quantity int32) int
+		ShipOrder                       func(childComplexity int, orderID string, courier string, awb string) int
+		SubscribeBackInStock            func(childComplexity int, variantID string) int
+		SubscribeMarketing              func(childComplexity int, channel model.MarketingChannel) int
+		UnblockDisplayNameTerm          func(childComplexity int, term string) int
+		UnsubscribeBackInStock          func(childComplexity int, variantID string) int
+		UnsubscribeMarketing            func(childComplexity int, channel model.MarketingChannel) int
+		UpdateAddress                   func(childComplexity int, input model.UpdateAddressInput) int
+		UpdateCart                      func(childComplexity int, input model.UpdateCartInput) int
+		UpdateMyPreferences             func(childComplexity int, input model.UpdatePreferencesInput) int
+		UpdateMyStore                   func(childComplexity int, input model.UpdateStoreInput) int
+		UpdateMyStoreShippingOrigin     func(childComplexity int, id string, input model.StoreShippingOriginInput) int
+		UpdateOrderStatus               func(childComplexity int, input model.UpdateOrderStatusInput) int
+		UpdateProduct                   func(childComplexity int, input model.UpdateProduct) int
+		UpdateProfile                   func(childComplexity int, input model.UpdateProfileInput) int
+		UpdateSessionAddress            func(childComplexity int, input model.UpdateSessionAddressInput) int
+		UpdateSessionInsurance          func(childComplexity int, input model.UpdateSessionInsuranceInput) int
+		UpdateSessionItem               func(childComplexity int, input model.UpdateSessionItemInput) int
+		UpdateSessionPaymentMethod      func(childComplexity int, input model.UpdateSessionPaymentMethodInput) int
+		UpdateSessionShippingMethod     func(childComplexity int, input model.UpdateSessionShippingMethodInput) int
+		UpdateVariants                  func(childComplexity int, input []*model.UpdateVariant) int
+		UploadAvatar                    func(childComplexity int, file graphql.Upload) int
+		UploadImportFile                func(childComplexity int, file graphql.Upload) int
+		UploadOrderMessageAttachment    func(childComplexity int, orderID string, file graphql.Upload) int
+		UploadProductImage              func(childComplexity int, productID string, file graphql.Upload) int
+		UploadReturnEvidence            func(childComplexity int, orderID string, file graphql.Upload) int
+		VerifyPhone                     func(childComplexity int, input model.VerifyPhoneInput) int
+		VerifyPickupCode                func(childComplexity int, input model.VerifyPickupCodeInput) int
+	}
+
+	NegativeStockVariant struct {
+		Name      func(childComplexity int) int
+		Stock     func(childComplexity int) int
+		VariantID func(childComplexity int) int
+	}
+
+	NotificationSetting struct {
+		Channel func(childComplexity int) int
+		Enabled func(childComplexity int) int
+		Event   func(childComplexity int) int
 	}
 
 	Order struct {
-		ExternalID    func(childComplexity int) int
-		ID            func(childComplexity int) int
-		InvoiceNumber func(childComplexity int) int
-		Items         func(childComplexity int) int
-		Pricing       func(childComplexity int) int
-		Shipping      func(childComplexity int) int
-		Status        func(childComplexity int) int
-		Timestamps    func(childComplexity int) int
-		User          func(childComplexity int) int
+		AcceptedPolicies func(childComplexity int) int
+		CancelNote       func(childComplexity int) int
+		CancelReason     func(childComplexity int) int
+		DeliveryWindow   func(childComplexity int) int
+		ExternalID       func(childComplexity int) int
+		ID               func(childComplexity int) int
+		InvoiceNumber    func(childComplexity int) int
+		Items            func(childComplexity int) int
+		Pickup           func(childComplexity int) int
+		Pricing          func(childComplexity int) int
+		Shipping         func(childComplexity int) int
+		Status           func(childComplexity int) int
+		Timestamps       func(childComplexity int) int
+		User             func(childComplexity int) int
+	}
+
+	OrderAdjustment struct {
+		Amount      func(childComplexity int) int
+		CreatedAt   func(childComplexity int) int
+		ID          func(childComplexity int) int
+		Kind        func(childComplexity int) int
+		OrderID     func(childComplexity int) int
+		Reason      func(childComplexity int) int
+		RequestedBy func(childComplexity int) int
+		ReviewedAt  func(childComplexity int) int
+		ReviewedBy  func(childComplexity int) int
+		Status      func(childComplexity int) int
+	}
+
+	OrderChange struct {
+		ChangedAt       func(childComplexity int) int
+		ChangedColumns  func(childComplexity int) int
+		ID              func(childComplexity int) int
+		Operation       func(childComplexity int) int
+		OrderExternalID func(childComplexity int) int
+		OrderID         func(childComplexity int) int
+	}
+
+	OrderConnection struct {
+		Items    func(childComplexity int) int
+		PageInfo func(childComplexity int) int
 	}
 
 	OrderItem struct {
@@ -210,22 +711,92 @@ type ComplexityRoot struct {
 		Subtotal func(childComplexity int) int
 	}
 
-	OrderListResponse struct {
-		Items    func(childComplexity int) int
-		PageInfo func(childComplexity int) int
+	OrderMessage struct {
+		Attachments func(childComplexity int) int
+		Body        func(childComplexity int) int
+		CreatedAt   func(childComplexity int) int
+		ID          func(childComplexity int) int
+		OrderID     func(childComplexity int) int
+		Sender      func(childComplexity int) int
+		SenderID    func(childComplexity int) int
+	}
+
+	OrderMessageThread struct {
+		HasMore     func(childComplexity int) int
+		Messages    func(childComplexity int) int
+		OrderID     func(childComplexity int) int
+		UnreadCount func(childComplexity int) int
+	}
+
+	OrderMessageUnread struct {
+		LastMessageAt   func(childComplexity int) int
+		OrderExternalID func(childComplexity int) int
+		OrderID         func(childComplexity int) int
+		UnreadCount     func(childComplexity int) int
+	}
+
+	OrderPickup struct {
+		Code       func(childComplexity int) int
+		Location   func(childComplexity int) int
+		PickedUpAt func(childComplexity int) int
+		SlotEnd    func(childComplexity int) int
+		SlotStart  func(childComplexity int) int
 	}
 
 	OrderPricing struct {
-		Currency    func(childComplexity int) int
-		Discount    func(childComplexity int) int
-		ShippingFee func(childComplexity int) int
-		Subtotal    func(childComplexity int) int
-		Tax         func(childComplexity int) int
-		Total       func(childComplexity int) int
+		Adjustment   func(childComplexity int) int
+		Currency     func(childComplexity int) int
+		Discount     func(childComplexity int) int
+		InsuranceFee func(childComplexity int) int
+		ShippingFee  func(childComplexity int) int
+		Subtotal     func(childComplexity int) int
+		Tax          func(childComplexity int) int
+		Total        func(childComplexity int) int
+		WalletAmount func(childComplexity int) int
+	}
+
+	OrderReason struct {
+		Audience  func(childComplexity int) int
+		Code      func(childComplexity int) int
+		ID        func(childComplexity int) int
+		IsActive  func(childComplexity int) int
+		Kind      func(childComplexity int) int
+		Label     func(childComplexity int) int
+		Position  func(childComplexity int) int
+		UpdatedAt func(childComplexity int) int
+	}
+
+	OrderReasonStat struct {
+		Amount func(childComplexity int) int
+		Orders func(childComplexity int) int
+		Reason func(childComplexity int) int
+	}
+
+	OrderReceipt struct {
+		Attempts  func(childComplexity int) int
+		LastError func(childComplexity int) int
+		OrderID   func(childComplexity int) int
+		QueuedAt  func(childComplexity int) int
+		ResentAt  func(childComplexity int) int
+		SentAt    func(childComplexity int) int
+		SentTo    func(childComplexity int) int
 	}
 
 	OrderShipping struct {
 		Address func(childComplexity int) int
+		Method  func(childComplexity int) int
+	}
+
+	OrderSlaBreach struct {
+		Deadline        func(childComplexity int) int
+		DetectedAt      func(childComplexity int) int
+		EnteredAt       func(childComplexity int) int
+		ID              func(childComplexity int) int
+		OrderExternalID func(childComplexity int) int
+		OrderID         func(childComplexity int) int
+		OrderStatus     func(childComplexity int) int
+		ResolvedAt      func(childComplexity int) int
+		Rule            func(childComplexity int) int
 	}
 
 	OrderTimestamps struct {
@@ -245,6 +816,11 @@ type ComplexityRoot struct {
 		UserID    func(childComplexity int) int
 	}
 
+	PackageConnection struct {
+		Items    func(childComplexity int) int
+		PageInfo func(childComplexity int) int
+	}
+
 	PackageItem struct {
 		CreatedAt func(childComplexity int) int
 		ID        func(childComplexity int) int
@@ -257,28 +833,8 @@ type ComplexityRoot struct {
 		VariantID func(childComplexity int) int
 	}
 
-	PackageListResponse struct {
-		Items    func(childComplexity int) int
-		PageInfo func(childComplexity int) int
-	}
-
 	PageInfo struct {
-		HasNextPage     func(childComplexity int) int
-		HasPreviousPage func(childComplexity int) int
-		Limit           func(childComplexity int) int
-		Page            func(childComplexity int) int
-		TotalItems      func(childComplexity int) int
-		TotalPages      func(childComplexity int) int
-	}
-
-	PageInfoCursorStyle struct {
 		EndCursor       func(childComplexity int) int
-		HasNextPage     func(childComplexity int) int
-		HasPreviousPage func(childComplexity int) int
-		StartCursor     func(childComplexity int) int
-	}
-
-	PageInfoOrder struct {
 		HasNextPage     func(childComplexity int) int
 		HasPreviousPage func(childComplexity int) int
 		Limit           func(childComplexity int) int
@@ -302,32 +858,86 @@ type ComplexityRoot struct {
 		ReferenceID  func(childComplexity int) int
 	}
 
+	PaymentDispute struct {
+		Amount            func(childComplexity int) int
+		Currency          func(childComplexity int) int
+		ID                func(childComplexity int) int
+		OpenedAt          func(childComplexity int) int
+		OrderExternalID   func(childComplexity int) int
+		OrderID           func(childComplexity int) int
+		PaymentReference  func(childComplexity int) int
+		PayoutHeld        func(childComplexity int) int
+		PointsClawedBack  func(childComplexity int) int
+		ProviderDisputeID func(childComplexity int) int
+		Reason            func(childComplexity int) int
+		ResolutionNote    func(childComplexity int) int
+		ResolvedAt        func(childComplexity int) int
+		Status            func(childComplexity int) int
+	}
+
 	PaymentOrderInfoResponse struct {
-		Currency        func(childComplexity int) int
-		ExpiresAt       func(childComplexity int) int
-		OrderExternalID func(childComplexity int) int
-		Payment         func(childComplexity int) int
-		ShippingAddress func(childComplexity int) int
-		Status          func(childComplexity int) int
-		TotalAmount     func(childComplexity int) int
+		Currency         func(childComplexity int) int
+		ExpiresAt        func(childComplexity int) int
+		OrderExternalID  func(childComplexity int) int
+		Payment          func(childComplexity int) int
+		SecondsRemaining func(childComplexity int) int
+		ShippingAddress  func(childComplexity int) int
+		Status           func(childComplexity int) int
+		Timeline         func(childComplexity int) int
+		TotalAmount      func(childComplexity int) int
+	}
+
+	PaymentTimelineEntry struct {
+		Amount     func(childComplexity int) int
+		Event      func(childComplexity int) int
+		OccurredAt func(childComplexity int) int
+	}
+
+	PickupLocation struct {
+		Address     func(childComplexity int) int
+		City        func(childComplexity int) int
+		ClosesAt    func(childComplexity int) int
+		ID          func(childComplexity int) int
+		IsActive    func(childComplexity int) int
+		Name        func(childComplexity int) int
+		OpensAt     func(childComplexity int) int
+		SlotMinutes func(childComplexity int) int
+		UpdatedAt   func(childComplexity int) int
+	}
+
+	PickupSlot struct {
+		End   func(childComplexity int) int
+		Start func(childComplexity int) int
+	}
+
+	Policy struct {
+		Body        func(childComplexity int) int
+		ID          func(childComplexity int) int
+		Kind        func(childComplexity int) int
+		PublishedAt func(childComplexity int) int
+		Title       func(childComplexity int) int
+		Version     func(childComplexity int) int
 	}
 
 	Product struct {
-		CategoryID      func(childComplexity int) int
-		CategoryName    func(childComplexity int) int
-		CreatedAt       func(childComplexity int) int
-		Description     func(childComplexity int) int
-		ID              func(childComplexity int) int
-		ImageURL        func(childComplexity int) int
-		Name            func(childComplexity int) int
-		SellerID        func(childComplexity int) int
-		SellerName      func(childComplexity int) int
-		Slug            func(childComplexity int) int
-		Status          func(childComplexity int) int
-		SubcategoryID   func(childComplexity int) int
-		SubcategoryName func(childComplexity int) int
-		UpdatedAt       func(childComplexity int) int
-		Variants        func(childComplexity int) int
+		CategoryID       func(childComplexity int) int
+		CategoryName     func(childComplexity int) int
+		CreatedAt        func(childComplexity int) int
+		Description      func(childComplexity int) int
+		ID               func(childComplexity int) int
+		ImageURL         func(childComplexity int) int
+		Name             func(childComplexity int) int
+		SellerID         func(childComplexity int) int
+		SellerName       func(childComplexity int) int
+		ShippingOriginID func(childComplexity int) int
+		Slug             func(childComplexity int) int
+		Status           func(childComplexity int) int
+		StoreSlug        func(childComplexity int) int
+		SubcategoryID    func(childComplexity int) int
+		SubcategoryName  func(childComplexity int) int
+		UnavailableUntil func(childComplexity int) int
+		UpdatedAt        func(childComplexity int) int
+		Variants         func(childComplexity int) int
 	}
 
 	ProductByCategory struct {
@@ -355,56 +965,178 @@ type ComplexityRoot struct {
 		Variant         func(childComplexity int) int
 	}
 
-	ProductConnection struct {
-		Edges      func(childComplexity int) int
-		PageInfo   func(childComplexity int) int
-		TotalCount func(childComplexity int) int
+	ProductChange struct {
+		ChangedAt      func(childComplexity int) int
+		ChangedColumns func(childComplexity int) int
+		ID             func(childComplexity int) int
+		Operation      func(childComplexity int) int
+		ProductID      func(childComplexity int) int
+		VariantID      func(childComplexity int) int
 	}
 
-	ProductEdge struct {
-		Cursor func(childComplexity int) int
-		Node   func(childComplexity int) int
+	ProductComparison struct {
+		Attributes func(childComplexity int) int
+		Products   func(childComplexity int) int
 	}
 
-	ProductPage struct {
-		HasNext    func(childComplexity int) int
-		Items      func(childComplexity int) int
-		Limit      func(childComplexity int) int
-		Page       func(childComplexity int) int
-		TotalCount func(childComplexity int) int
-		TotalPages func(childComplexity int) int
+	ProductComparisonAttribute struct {
+		Differs func(childComplexity int) int
+		Key     func(childComplexity int) int
+		Label   func(childComplexity int) int
+		Values  func(childComplexity int) int
+	}
+
+	ProductConnection struct {
+		Items    func(childComplexity int) int
+		PageInfo func(childComplexity int) int
 	}
 
 	Profile struct {
-		AvatarURL   func(childComplexity int) int
-		Bio         func(childComplexity int) int
-		CreatedAt   func(childComplexity int) int
-		DateOfBirth func(childComplexity int) int
-		Email       func(childComplexity int) int
-		FullName    func(childComplexity int) int
-		ID          func(childComplexity int) int
-		Phone       func(childComplexity int) int
-		UpdatedAt   func(childComplexity int) int
-		UserID      func(childComplexity int) int
+		AvatarURL     func(childComplexity int) int
+		Bio           func(childComplexity int) int
+		CreatedAt     func(childComplexity int) int
+		DateOfBirth   func(childComplexity int) int
+		DisplayName   func(childComplexity int) int
+		Email         func(childComplexity int) int
+		FullName      func(childComplexity int) int
+		ID            func(childComplexity int) int
+		Phone         func(childComplexity int) int
+		PhoneVerified func(childComplexity int) int
+		UpdatedAt     func(childComplexity int) int
+		UserID        func(childComplexity int) int
+	}
+
+	QuantityTypeInfo struct {
+		BasePerUnit  func(childComplexity int) int
+		BaseUnit     func(childComplexity int) int
+		Code         func(childComplexity int) int
+		Label        func(childComplexity int) int
+		UnitPricePer func(childComplexity int) int
 	}
 
 	Query struct {
-		Address                 func(childComplexity int, addressID string) int
-		Addresses               func(childComplexity int) int
-		Category                func(childComplexity int, filter *string, limit *int32, page *int32) int
-		CheckoutSession         func(childComplexity int, externalID string) int
-		MyCart                  func(childComplexity int, filter *model.CartFilterInput, sort *model.CartSortInput, limit *int32, page *int32) int
-		MyCartCount             func(childComplexity int) int
-		MyProfile               func(childComplexity int) int
-		OrderDetail             func(childComplexity int, orderID string) int
-		OrderDetailByExternalID func(childComplexity int, externalID string) int
-		OrderList               func(childComplexity int, filter *model.OrderFilterInput, sort *model.OrderSortInput, pagination *model.PaginationInput) int
-		Packages                func(childComplexity int, filter *model.PackageFilterInput, sort *model.PackageSortInput, limit *int32, page *int32) int
-		PaymentOrderInfo        func(childComplexity int, externalID string) int
-		ProductDetail           func(childComplexity int, productID string) int
-		ProductList             func(childComplexity int, filter *model.ProductFilterInput, sort *model.ProductSortInput, page *int32, limit *int32) int
-		ProductsHome            func(childComplexity int, filter *model.ProductFilterInput, sort *model.ProductSortInput, page *int32, limit *int32) int
-		Subcategory             func(childComplexity int, filter *string, categoryID string, limit *int32, page *int32) int
+		APIKeys                    func(childComplexity int, includeRevoked *bool) int
+		AccountRestrictions        func(childComplexity int, limit *int32) int
+		Address                    func(childComplexity int, addressID string) int
+		Addresses                  func(childComplexity int) int
+		AdminCheckoutRules         func(childComplexity int) int
+		AdminDashboard             func(childComplexity int) int
+		AdminDeliverySlots         func(childComplexity int, region *string) int
+		AdminOrderReasons          func(childComplexity int, kind *model.OrderReasonKind) int
+		AdminPickupLocations       func(childComplexity int) int
+		Category                   func(childComplexity int, filter *string, limit *int32, page *int32, after *string) int
+		CheckoutRules              func(childComplexity int) int
+		CheckoutSession            func(childComplexity int, externalID string) int
+		CheckoutSessionEvents      func(childComplexity int, externalID string) int
+		ClientContext              func(childComplexity int) int
+		CommissionRates            func(childComplexity int, categoryID *string) int
+		CompareProducts            func(childComplexity int, ids []string) int
+		CourierManifest            func(childComplexity int, date *string) int
+		CourierWebhookDeadLetters  func(childComplexity int, limit *int32) int
+		CurrentPolicies            func(childComplexity int) int
+		DatabaseHealth             func(childComplexity int) int
+		DeliverySlots              func(childComplexity int, externalID string) int
+		DisplayNameBlocklist       func(childComplexity int) int
+		EffectiveCommissionRate    func(childComplexity int, categoryID string, at *time.Time) int
+		ExperimentAssignments      func(childComplexity int) int
+		ExperimentResults          func(childComplexity int, key string) int
+		Experiments                func(childComplexity int) int
+		FulfillmentQueue           func(childComplexity int, mineOnly *bool, limit *int32) int
+		JournalExport              func(childComplexity int, from string, to string) int
+		LogSettings                func(childComplexity int) int
+		LoyaltyRules               func(childComplexity int) int
+		MaintenanceMode            func(childComplexity int) int
+		ModerationAudit            func(childComplexity int, itemID *string, limit *int32) int
+		ModerationKeywords         func(childComplexity int) int
+		ModerationQueue            func(childComplexity int, status *model.ModerationStatus, limit *int32) int
+		MyAccountRestriction       func(childComplexity int) int
+		MyActiveCheckoutSession    func(childComplexity int) int
+		MyBackInStockSubscriptions func(childComplexity int) int
+		MyCart                     func(childComplexity int, filter *model.CartFilterInput, sort *model.CartSortInput, limit *int32, page *int32, after *string) int
+		MyCartCount                func(childComplexity int) int
+		MyCatalogExports           func(childComplexity int) int
+		MyLoyaltyPoints            func(childComplexity int) int
+		MyMarketingConsents        func(childComplexity int) int
+		MyPreferences              func(childComplexity int) int
+		MyProfile                  func(childComplexity int) int
+		MyReferral                 func(childComplexity int) int
+		MyScheduledPriceChanges    func(childComplexity int, variantID *string) int
+		MyStore                    func(childComplexity int) int
+		MyStoreShippingOrigins     func(childComplexity int) int
+		MyStoreVacations           func(childComplexity int) int
+		MyWallet                   func(childComplexity int) int
+		MyWishlist                 func(childComplexity int, pagination *model.PaginationInput) int
+		MyWishlistAlerts           func(childComplexity int, unreadOnly *bool, pagination *model.PaginationInput) int
+		OrderAdjustments           func(childComplexity int, orderID string) int
+		OrderChanges               func(childComplexity int, after *string, limit *int32) int
+		OrderDetail                func(childComplexity int, orderID string) int
+		OrderDetailByExternalID    func(childComplexity int, externalID string) int
+		OrderList                  func(childComplexity int, filter *model.OrderFilterInput, sort *model.OrderSortInput, pagination *model.PaginationInput) int
+		OrderMessages              func(childComplexity int, orderID string, before *string, limit *int32) int
+		OrderReasonStats           func(childComplexity int, kind model.OrderReasonKind, from time.Time, to time.Time) int
+		OrderReasons               func(childComplexity int, kind *model.OrderReasonKind) int
+		OrderReceipt               func(childComplexity int, orderID string) int
+		OrderRefunds               func(childComplexity int, orderID string) int
+		OrderSLABreaches           func(childComplexity int, openOnly *bool, limit *int32) int
+		OrderShipment              func(childComplexity int, orderID string) int
+		Packages                   func(childComplexity int, filter *model.PackageFilterInput, sort *model.PackageSortInput, limit *int32, page *int32, after *string) int
+		PackingSlip                func(childComplexity int, orderID string) int
+		PaymentDisputes            func(childComplexity int, status *model.DisputeStatus, limit *int32) int
+		PaymentOrderInfo           func(childComplexity int, externalID string) int
+		PickupLocations            func(childComplexity int) int
+		PickupSlots                func(childComplexity int, locationID string) int
+		PolicyHistory              func(childComplexity int, kind model.PolicyKind) int
+		ProductChanges             func(childComplexity int, after *string, limit *int32) int
+		ProductDetail              func(childComplexity int, productID string) int
+		ProductList                func(childComplexity int, filter *model.ProductFilterInput, sort *model.ProductSortInput, page *int32, limit *int32, after *string) int
+		ProductsHome               func(childComplexity int, filter *model.ProductFilterInput, sort *model.ProductSortInput, page *int32, limit *int32) int
+		PromotionReport            func(childComplexity int, input model.PromotionReportInput) int
+		QuantityTypes              func(childComplexity int) int
+		RetentionPreview           func(childComplexity int) int
+		ReturnEvidence             func(childComplexity int, orderID string) int
+		ShippingOptions            func(childComplexity int, externalID string) int
+		SlowQueries                func(childComplexity int, sortBy *model.SlowQuerySortField, limit *int32) int
+		StockAdjustments           func(childComplexity int, status *model.StockAdjustmentStatus, limit *int32) int
+		StockOversell              func(childComplexity int, since *time.Time, limit *int32) int
+		StockTransfers             func(childComplexity int, status *model.StockTransferStatus, limit *int32) int
+		StoreBySlug                func(childComplexity int, slug string) int
+		StoreProducts              func(childComplexity int, slug string, sort *model.ProductSortInput, page *int32, limit *int32, after *string) int
+		StuckPendingOrders         func(childComplexity int, olderThanMinutes *int32, limit *int32) int
+		Subcategory                func(childComplexity int, filter *string, categoryID string, limit *int32, page *int32, after *string) int
+		SyntheticCheckoutRuns      func(childComplexity int, limit *int32) int
+		UnpaidConfirmedSessions    func(childComplexity int, olderThanMinutes *int32, limit *int32) int
+		UnreadOrderMessages        func(childComplexity int, limit *int32) int
+		UsageFlags                 func(childComplexity int, since *time.Time, limit *int32) int
+		VariantStockLevels         func(childComplexity int, variantID string) int
+		Warehouses                 func(childComplexity int) int
+		WebhookHealth              func(childComplexity int) int
+	}
+
+	ReferralStats struct {
+		Code        func(childComplexity int) int
+		Pending     func(childComplexity int) int
+		Rejected    func(childComplexity int) int
+		Rewarded    func(childComplexity int) int
+		Signups     func(childComplexity int) int
+		TotalEarned func(childComplexity int) int
+	}
+
+	Refund struct {
+		Amount        func(childComplexity int) int
+		CompletedAt   func(childComplexity int) int
+		CreatedAt     func(childComplexity int) int
+		Currency      func(childComplexity int) int
+		FailureReason func(childComplexity int) int
+		ID            func(childComplexity int) int
+		Method        func(childComplexity int) int
+		OrderID       func(childComplexity int) int
+		Reason        func(childComplexity int) int
+		ReasonID      func(childComplexity int) int
+		Status        func(childComplexity int) int
+	}
+
+	RequestRefundResponse struct {
+		Refunds func(childComplexity int) int
 	}
 
 	ResetPasswordResponse struct {
@@ -417,28 +1149,233 @@ type ComplexityRoot struct {
 		Success func(childComplexity int) int
 	}
 
-	ShippingAddress struct {
-		Address1     func(childComplexity int) int
-		Address2     func(childComplexity int) int
-		City         func(childComplexity int) int
-		Name         func(childComplexity int) int
-		Phone        func(childComplexity int) int
-		PostalCode   func(childComplexity int) int
-		Province     func(childComplexity int) int
-		ReceiverName func(childComplexity int) int
+	RetentionPolicyResult struct {
+		Affected func(childComplexity int) int
+		Cutoff   func(childComplexity int) int
+		DryRun   func(childComplexity int) int
+		Policy   func(childComplexity int) int
 	}
 
-	Subcategory struct {
+	ScheduledPriceChange struct {
+		CreatedAt    func(childComplexity int) int
+		CurrentPrice func(childComplexity int) int
+		EffectiveAt  func(childComplexity int) int
+		HeldBySale   func(childComplexity int) int
+		ID           func(childComplexity int) int
+		Price        func(childComplexity int) int
+		ProductID    func(childComplexity int) int
+		ProductName  func(childComplexity int) int
+		VariantID    func(childComplexity int) int
+		VariantName  func(childComplexity int) int
+	}
+
+	SeqScanTable struct {
+		AvgRowsPerScan func(childComplexity int) int
+		IndexScans     func(childComplexity int) int
+		LiveTuples     func(childComplexity int) int
+		SeqScans       func(childComplexity int) int
+		Table          func(childComplexity int) int
+	}
+
+	Shipment struct {
+		Awb         func(childComplexity int) int
+		Courier     func(childComplexity int) int
+		DeliveredAt func(childComplexity int) int
+		Events      func(childComplexity int) int
+		ID          func(childComplexity int) int
+		LastEventAt func(childComplexity int) int
+		OrderID     func(childComplexity int) int
+		ShippedAt   func(childComplexity int) int
+		Status      func(childComplexity int) int
+	}
+
+	ShipmentTrackingEvent struct {
+		Description func(childComplexity int) int
+		Location    func(childComplexity int) int
+		OccurredAt  func(childComplexity int) int
+		Status      func(childComplexity int) int
+	}
+
+	ShippingAddress struct {
+		Address1     func(childComplexity int) int
+		Address2     func(childComplexity int) int
+		City         func(childComplexity int) int
+		Name         func(childComplexity int) int
+		Phone        func(childComplexity int) int
+		PostalCode   func(childComplexity int) int
+		Province     func(childComplexity int) int
+		ReceiverName func(childComplexity int) int
+	}
+
+	ShippingOption struct {
+		Available         func(childComplexity int) int
+		DistanceKm        func(childComplexity int) int
+		Fee               func(childComplexity int) int
+		InsuranceFee      func(childComplexity int) int
+		Method            func(childComplexity int) int
+		UnavailableReason func(childComplexity int) int
+	}
+
+	SlowQueryReport struct {
+		Available  func(childComplexity int) int
+		Modules    func(childComplexity int) int
+		Statements func(childComplexity int) int
+	}
+
+	SlowStatement struct {
+		Calls       func(childComplexity int) int
+		MeanTimeMs  func(childComplexity int) int
+		Method      func(childComplexity int) int
+		Module      func(childComplexity int) int
+		Query       func(childComplexity int) int
+		QueryID     func(childComplexity int) int
+		Rows        func(childComplexity int) int
+		TotalTimeMs func(childComplexity int) int
+	}
+
+	StockAdjustment struct {
+		CreatedAt   func(childComplexity int) int
+		Delta       func(childComplexity int) int
+		ID          func(childComplexity int) int
+		Reason      func(childComplexity int) int
+		RequestedBy func(childComplexity int) int
+		ReviewedAt  func(childComplexity int) int
+		ReviewedBy  func(childComplexity int) int
+		Status      func(childComplexity int) int
+		VariantID   func(childComplexity int) int
+		WarehouseID func(childComplexity int) int
+	}
+
+	StockIncident struct {
+		Available         func(childComplexity int) int
+		CheckoutSessionID func(childComplexity int) int
+		CreatedAt         func(childComplexity int) int
+		ID                func(childComplexity int) int
+		Requested         func(childComplexity int) int
+		VariantID         func(childComplexity int) int
+		VariantName       func(childComplexity int) int
+	}
+
+	StockOversellReport struct {
+		Incidents             func(childComplexity int) int
+		NegativeStockVariants func(childComplexity int) int
+	}
+
+	StockTransfer struct {
+		CancelledAt     func(childComplexity int) int
+		CreatedAt       func(childComplexity int) int
+		FromWarehouseID func(childComplexity int) int
+		ID              func(childComplexity int) int
+		Note            func(childComplexity int) int
+		Quantity        func(childComplexity int) int
+		ReceivedAt      func(childComplexity int) int
+		Status          func(childComplexity int) int
+		ToWarehouseID   func(childComplexity int) int
+		VariantID       func(childComplexity int) int
+	}
+
+	Store struct {
+		CreatedAt      func(childComplexity int) int
+		Description    func(childComplexity int) int
+		LogoURL        func(childComplexity int) int
+		Name           func(childComplexity int) int
+		OpenNow        func(childComplexity int) int
+		OperatingHours func(childComplexity int) int
+		ProductCount   func(childComplexity int) int
+		SellerID       func(childComplexity int) int
+		Slug           func(childComplexity int) int
+		UpdatedAt      func(childComplexity int) int
+		Vacation       func(childComplexity int) int
+	}
+
+	StoreOperatingHours struct {
+		ClosesAt func(childComplexity int) int
+		OpensAt  func(childComplexity int) int
+		Weekday  func(childComplexity int) int
+	}
+
+	StoreShippingOrigin struct {
+		AddressLine1 func(childComplexity int) int
+		AddressLine2 func(childComplexity int) int
+		City         func(childComplexity int) int
+		ContactName  func(childComplexity int) int
+		CreatedAt    func(childComplexity int) int
+		ID           func(childComplexity int) int
+		IsDefault    func(childComplexity int) int
+		Label        func(childComplexity int) int
+		Location     func(childComplexity int) int
+		Phone        func(childComplexity int) int
+		PostalCode   func(childComplexity int) int
+		Province     func(childComplexity int) int
+		UpdatedAt    func(childComplexity int) int
+	}
+
+	StoreVacation struct {
+		CreatedAt func(childComplexity int) int
+		EndsAt    func(childComplexity int) int
+		ID        func(childComplexity int) int
+		Message   func(childComplexity int) int
+		Mode      func(childComplexity int) int
+		StartsAt  func(childComplexity int) int
+	}
+
+	StuckOrder struct {
+		CreatedAt   func(childComplexity int) int
+		ExternalID  func(childComplexity int) int
+		ID          func(childComplexity int) int
+		TotalAmount func(childComplexity int) int
+		UserID      func(childComplexity int) int
+	}
+
+	Subcategory struct {
 		CategoryID func(childComplexity int) int
 		ID         func(childComplexity int) int
 		Name       func(childComplexity int) int
 	}
 
-	SubcategoryPage struct {
+	SubcategoryConnection struct {
 		Items    func(childComplexity int) int
 		PageInfo func(childComplexity int) int
 	}
 
+	SyntheticCheckoutRun struct {
+		DurationMs      func(childComplexity int) int
+		Error           func(childComplexity int) int
+		FailedStep      func(childComplexity int) int
+		ID              func(childComplexity int) int
+		OrderExternalID func(childComplexity int) int
+		Passed          func(childComplexity int) int
+		StartedAt       func(childComplexity int) int
+		Steps           func(childComplexity int) int
+		TriggeredBy     func(childComplexity int) int
+	}
+
+	SyntheticCheckoutStep struct {
+		DurationMs func(childComplexity int) int
+		Error      func(childComplexity int) int
+		Name       func(childComplexity int) int
+	}
+
+	UnitPrice struct {
+		Amount func(childComplexity int) int
+		Per    func(childComplexity int) int
+		Unit   func(childComplexity int) int
+	}
+
+	UnpaidConfirmedSession struct {
+		ConfirmedAt       func(childComplexity int) int
+		OrderExternalID   func(childComplexity int) int
+		SessionExternalID func(childComplexity int) int
+		TotalPrice        func(childComplexity int) int
+		UserID            func(childComplexity int) int
+	}
+
+	UnusedIndex struct {
+		Index  func(childComplexity int) int
+		SizeKb func(childComplexity int) int
+		Table  func(childComplexity int) int
+	}
+
 	UpdateAddressResponse struct {
 		Address func(childComplexity int) int
 	}
@@ -451,28 +1388,62 @@ type ComplexityRoot struct {
 		Success func(childComplexity int) int
 	}
 
+	UploadedFile struct {
+		ContentType  func(childComplexity int) int
+		CreatedAt    func(childComplexity int) int
+		ID           func(childComplexity int) int
+		OriginalName func(childComplexity int) int
+		Purpose      func(childComplexity int) int
+		Size         func(childComplexity int) int
+		URL          func(childComplexity int) int
+	}
+
+	UsageFlag struct {
+		Baseline  func(childComplexity int) int
+		Count     func(childComplexity int) int
+		CreatedAt func(childComplexity int) int
+		Day       func(childComplexity int) int
+		ID        func(childComplexity int) int
+		Operation func(childComplexity int) int
+		Reason    func(childComplexity int) int
+		UserID    func(childComplexity int) int
+	}
+
 	User struct {
 		Email func(childComplexity int) int
 		ID    func(childComplexity int) int
 		Role  func(childComplexity int) int
 	}
 
+	UserPreferences struct {
+		Locale        func(childComplexity int) int
+		Notifications func(childComplexity int) int
+		UpdatedAt     func(childComplexity int) int
+	}
+
 	UserRef struct {
 		ID func(childComplexity int) int
 	}
 
 	Variant struct {
-		CategoryID   func(childComplexity int) int
-		CreatedAt    func(childComplexity int) int
-		Description  func(childComplexity int) int
-		ID           func(childComplexity int) int
-		ImageURL     func(childComplexity int) int
-		Name         func(childComplexity int) int
-		Price        func(childComplexity int) int
-		ProductID    func(childComplexity int) int
-		QuantityType func(childComplexity int) int
-		SellerID     func(childComplexity int) int
-		Stock        func(childComplexity int) int
+		CategoryID      func(childComplexity int) int
+		CompareAtPrice  func(childComplexity int) int
+		CreatedAt       func(childComplexity int) int
+		Description     func(childComplexity int) int
+		DiscountPercent func(childComplexity int) int
+		HeightCm        func(childComplexity int) int
+		ID              func(childComplexity int) int
+		ImageURL        func(childComplexity int) int
+		LengthCm        func(childComplexity int) int
+		Name            func(childComplexity int) int
+		Price           func(childComplexity int) int
+		ProductID       func(childComplexity int) int
+		QuantityType    func(childComplexity int) int
+		SellerID        func(childComplexity int) int
+		Stock           func(childComplexity int) int
+		UnitPrice       func(childComplexity int) int
+		WeightGrams     func(childComplexity int) int
+		WidthCm         func(childComplexity int) int
 	}
 
 	VariantRef struct {
@@ -481,6 +1452,91 @@ type ComplexityRoot struct {
 		Name        func(childComplexity int) int
 		ProductName func(childComplexity int) int
 	}
+
+	VoucherCampaign struct {
+		CreatedAt   func(childComplexity int) int
+		Description func(childComplexity int) int
+		EndsAt      func(childComplexity int) int
+		ID          func(childComplexity int) int
+		Name        func(childComplexity int) int
+		StartsAt    func(childComplexity int) int
+	}
+
+	Wallet struct {
+		Balance  func(childComplexity int) int
+		Currency func(childComplexity int) int
+		Entries  func(childComplexity int) int
+	}
+
+	WalletLedgerEntry struct {
+		Amount        func(childComplexity int) int
+		BalanceAfter  func(childComplexity int) int
+		CreatedAt     func(childComplexity int) int
+		ID            func(childComplexity int) int
+		Note          func(childComplexity int) int
+		ReferenceID   func(childComplexity int) int
+		ReferenceType func(childComplexity int) int
+		Type          func(childComplexity int) int
+	}
+
+	Warehouse struct {
+		Code      func(childComplexity int) int
+		CreatedAt func(childComplexity int) int
+		ID        func(childComplexity int) int
+		IsActive  func(childComplexity int) int
+		IsDefault func(childComplexity int) int
+		Name      func(childComplexity int) int
+		Region    func(childComplexity int) int
+	}
+
+	WarehouseStockLevel struct {
+		InTransit     func(childComplexity int) int
+		Quantity      func(childComplexity int) int
+		VariantID     func(childComplexity int) int
+		WarehouseCode func(childComplexity int) int
+		WarehouseID   func(childComplexity int) int
+	}
+
+	WebhookHealth struct {
+		Failed          func(childComplexity int) int
+		OldestPendingAt func(childComplexity int) int
+		Pending         func(childComplexity int) int
+	}
+
+	WishlistAlert struct {
+		CreatedAt   func(childComplexity int) int
+		ID          func(childComplexity int) int
+		Kind        func(childComplexity int) int
+		NewPrice    func(childComplexity int) int
+		OldPrice    func(childComplexity int) int
+		ProductID   func(childComplexity int) int
+		ProductName func(childComplexity int) int
+		ReadAt      func(childComplexity int) int
+		VariantID   func(childComplexity int) int
+		VariantName func(childComplexity int) int
+	}
+
+	WishlistAlertConnection struct {
+		Items    func(childComplexity int) int
+		PageInfo func(childComplexity int) int
+	}
+
+	WishlistConnection struct {
+		Items    func(childComplexity int) int
+		PageInfo func(childComplexity int) int
+	}
+
+	WishlistItem struct {
+		AddedAt     func(childComplexity int) int
+		ID          func(childComplexity int) int
+		ImageURL    func(childComplexity int) int
+		InStock     func(childComplexity int) int
+		Price       func(childComplexity int) int
+		ProductID   func(childComplexity int) int
+		ProductName func(childComplexity int) int
+		VariantID   func(childComplexity int) int
+		VariantName func(childComplexity int) int
+	}
 }
 
 type executableSchema struct {
@@ -502,6 +1558,41 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 	_ = ec
 	switch typeName + "." + field {
 
+	case "AccountRestriction.createdAt":
+		if e.complexity.AccountRestriction.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.AccountRestriction.CreatedAt(childComplexity), true
+
+	case "AccountRestriction.expiresAt":
+		if e.complexity.AccountRestriction.ExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.AccountRestriction.ExpiresAt(childComplexity), true
+
+	case "AccountRestriction.level":
+		if e.complexity.AccountRestriction.Level == nil {
+			break
+		}
+
+		return e.complexity.AccountRestriction.Level(childComplexity), true
+
+	case "AccountRestriction.reason":
+		if e.complexity.AccountRestriction.Reason == nil {
+			break
+		}
+
+		return e.complexity.AccountRestriction.Reason(childComplexity), true
+
+	case "AccountRestriction.userId":
+		if e.complexity.AccountRestriction.UserID == nil {
+			break
+		}
+
+		return e.complexity.AccountRestriction.UserID(childComplexity), true
+
 	case "AddToCartResponse.cartItem":
 		if e.complexity.AddToCartResponse.CartItem == nil {
 			break
@@ -565,6 +1656,13 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Address.IsDefault(childComplexity), true
 
+	case "Address.location":
+		if e.complexity.Address.Location == nil {
+			break
+		}
+
+		return e.complexity.Address.Location(childComplexity), true
+
 	case "Address.name":
 		if e.complexity.Address.Name == nil {
 			break
@@ -600,2071 +1698,8062 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.complexity.Address.ReceiverName(childComplexity), true
 
-	case "AuthResponse.token":
-		if e.complexity.AuthResponse.Token == nil {
+	case "AdminDashboard.failedWebhooks":
+		if e.complexity.AdminDashboard.FailedWebhooks == nil {
 			break
 		}
 
-		return e.complexity.AuthResponse.Token(childComplexity), true
+		return e.complexity.AdminDashboard.FailedWebhooks(childComplexity), true
 
-	case "AuthResponse.user":
-		if e.complexity.AuthResponse.User == nil {
+	case "AdminDashboard.generatedAt":
+		if e.complexity.AdminDashboard.GeneratedAt == nil {
 			break
 		}
 
-		return e.complexity.AuthResponse.User(childComplexity), true
+		return e.complexity.AdminDashboard.GeneratedAt(childComplexity), true
 
-	case "CartItem.createdAt":
-		if e.complexity.CartItem.CreatedAt == nil {
+	case "AdminDashboard.lowStockVariants":
+		if e.complexity.AdminDashboard.LowStockVariants == nil {
 			break
 		}
 
-		return e.complexity.CartItem.CreatedAt(childComplexity), true
+		return e.complexity.AdminDashboard.LowStockVariants(childComplexity), true
 
-	case "CartItem.id":
-		if e.complexity.CartItem.ID == nil {
+	case "AdminDashboard.ordersToday":
+		if e.complexity.AdminDashboard.OrdersToday == nil {
 			break
 		}
 
-		return e.complexity.CartItem.ID(childComplexity), true
+		return e.complexity.AdminDashboard.OrdersToday(childComplexity), true
 
-	case "CartItem.product":
-		if e.complexity.CartItem.Product == nil {
+	case "AdminDashboard.pendingShipments":
+		if e.complexity.AdminDashboard.PendingShipments == nil {
 			break
 		}
 
-		return e.complexity.CartItem.Product(childComplexity), true
+		return e.complexity.AdminDashboard.PendingShipments(childComplexity), true
 
-	case "CartItem.quantity":
-		if e.complexity.CartItem.Quantity == nil {
+	case "AdminDashboard.revenueToday":
+		if e.complexity.AdminDashboard.RevenueToday == nil {
 			break
 		}
 
-		return e.complexity.CartItem.Quantity(childComplexity), true
+		return e.complexity.AdminDashboard.RevenueToday(childComplexity), true
 
-	case "CartItem.updatedAt":
-		if e.complexity.CartItem.UpdatedAt == nil {
+	case "ApiKey.createdAt":
+		if e.complexity.ApiKey.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.CartItem.UpdatedAt(childComplexity), true
+		return e.complexity.ApiKey.CreatedAt(childComplexity), true
 
-	case "CartItem.userId":
-		if e.complexity.CartItem.UserID == nil {
+	case "ApiKey.expiresAt":
+		if e.complexity.ApiKey.ExpiresAt == nil {
 			break
 		}
 
-		return e.complexity.CartItem.UserID(childComplexity), true
+		return e.complexity.ApiKey.ExpiresAt(childComplexity), true
 
-	case "CartListResponse.items":
-		if e.complexity.CartListResponse.Items == nil {
+	case "ApiKey.id":
+		if e.complexity.ApiKey.ID == nil {
 			break
 		}
 
-		return e.complexity.CartListResponse.Items(childComplexity), true
+		return e.complexity.ApiKey.ID(childComplexity), true
 
-	case "CartListResponse.pageInfo":
-		if e.complexity.CartListResponse.PageInfo == nil {
+	case "ApiKey.lastUsedAt":
+		if e.complexity.ApiKey.LastUsedAt == nil {
 			break
 		}
 
-		return e.complexity.CartListResponse.PageInfo(childComplexity), true
+		return e.complexity.ApiKey.LastUsedAt(childComplexity), true
 
-	case "Category.id":
-		if e.complexity.Category.ID == nil {
+	case "ApiKey.name":
+		if e.complexity.ApiKey.Name == nil {
 			break
 		}
 
-		return e.complexity.Category.ID(childComplexity), true
+		return e.complexity.ApiKey.Name(childComplexity), true
 
-	case "Category.name":
-		if e.complexity.Category.Name == nil {
+	case "ApiKey.prefix":
+		if e.complexity.ApiKey.Prefix == nil {
 			break
 		}
 
-		return e.complexity.Category.Name(childComplexity), true
+		return e.complexity.ApiKey.Prefix(childComplexity), true
 
-	case "Category.slug":
-		if e.complexity.Category.Slug == nil {
+	case "ApiKey.revokedAt":
+		if e.complexity.ApiKey.RevokedAt == nil {
 			break
 		}
 
-		return e.complexity.Category.Slug(childComplexity), true
+		return e.complexity.ApiKey.RevokedAt(childComplexity), true
 
-	case "Category.subcategories":
-		if e.complexity.Category.Subcategories == nil {
+	case "ApiKey.scopes":
+		if e.complexity.ApiKey.Scopes == nil {
 			break
 		}
 
-		return e.complexity.Category.Subcategories(childComplexity), true
+		return e.complexity.ApiKey.Scopes(childComplexity), true
 
-	case "CategoryPage.items":
-		if e.complexity.CategoryPage.Items == nil {
+	case "ApplyCouponResponse.discount":
+		if e.complexity.ApplyCouponResponse.Discount == nil {
 			break
 		}
 
-		return e.complexity.CategoryPage.Items(childComplexity), true
+		return e.complexity.ApplyCouponResponse.Discount(childComplexity), true
 
-	case "CategoryPage.pageInfo":
-		if e.complexity.CategoryPage.PageInfo == nil {
+	case "ApplyCouponResponse.success":
+		if e.complexity.ApplyCouponResponse.Success == nil {
 			break
 		}
 
-		return e.complexity.CategoryPage.PageInfo(childComplexity), true
+		return e.complexity.ApplyCouponResponse.Success(childComplexity), true
 
-	case "CheckoutSession.addressId":
-		if e.complexity.CheckoutSession.AddressID == nil {
+	case "ApplySessionPointsResponse.discount":
+		if e.complexity.ApplySessionPointsResponse.Discount == nil {
 			break
 		}
 
-		return e.complexity.CheckoutSession.AddressID(childComplexity), true
+		return e.complexity.ApplySessionPointsResponse.Discount(childComplexity), true
 
-	case "CheckoutSession.createdAt":
-		if e.complexity.CheckoutSession.CreatedAt == nil {
+	case "ApplySessionPointsResponse.pointsRedeemed":
+		if e.complexity.ApplySessionPointsResponse.PointsRedeemed == nil {
 			break
 		}
 
-		return e.complexity.CheckoutSession.CreatedAt(childComplexity), true
+		return e.complexity.ApplySessionPointsResponse.PointsRedeemed(childComplexity), true
 
-	case "CheckoutSession.discount":
-		if e.complexity.CheckoutSession.Discount == nil {
+	case "ApplySessionPointsResponse.success":
+		if e.complexity.ApplySessionPointsResponse.Success == nil {
 			break
 		}
 
-		return e.complexity.CheckoutSession.Discount(childComplexity), true
+		return e.complexity.ApplySessionPointsResponse.Success(childComplexity), true
 
-	case "CheckoutSession.expiresAt":
-		if e.complexity.CheckoutSession.ExpiresAt == nil {
+	case "ApplySessionWalletResponse.success":
+		if e.complexity.ApplySessionWalletResponse.Success == nil {
 			break
 		}
 
-		return e.complexity.CheckoutSession.ExpiresAt(childComplexity), true
+		return e.complexity.ApplySessionWalletResponse.Success(childComplexity), true
 
-	case "CheckoutSession.externalId":
-		if e.complexity.CheckoutSession.ExternalID == nil {
+	case "AuthResponse.token":
+		if e.complexity.AuthResponse.Token == nil {
 			break
 		}
 
-		return e.complexity.CheckoutSession.ExternalID(childComplexity), true
+		return e.complexity.AuthResponse.Token(childComplexity), true
 
-	case "CheckoutSession.id":
-		if e.complexity.CheckoutSession.ID == nil {
+	case "AuthResponse.user":
+		if e.complexity.AuthResponse.User == nil {
 			break
 		}
 
-		return e.complexity.CheckoutSession.ID(childComplexity), true
+		return e.complexity.AuthResponse.User(childComplexity), true
 
-	case "CheckoutSession.items":
-		if e.complexity.CheckoutSession.Items == nil {
+	case "BackInStockSubscription.createdAt":
+		if e.complexity.BackInStockSubscription.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.CheckoutSession.Items(childComplexity), true
+		return e.complexity.BackInStockSubscription.CreatedAt(childComplexity), true
 
-	case "CheckoutSession.paymentMethod":
-		if e.complexity.CheckoutSession.PaymentMethod == nil {
+	case "BackInStockSubscription.id":
+		if e.complexity.BackInStockSubscription.ID == nil {
 			break
 		}
 
-		return e.complexity.CheckoutSession.PaymentMethod(childComplexity), true
+		return e.complexity.BackInStockSubscription.ID(childComplexity), true
 
-	case "CheckoutSession.shippingFee":
-		if e.complexity.CheckoutSession.ShippingFee == nil {
+	case "BackInStockSubscription.imageUrl":
+		if e.complexity.BackInStockSubscription.ImageURL == nil {
 			break
 		}
 
-		return e.complexity.CheckoutSession.ShippingFee(childComplexity), true
+		return e.complexity.BackInStockSubscription.ImageURL(childComplexity), true
 
-	case "CheckoutSession.status":
-		if e.complexity.CheckoutSession.Status == nil {
+	case "BackInStockSubscription.productId":
+		if e.complexity.BackInStockSubscription.ProductID == nil {
 			break
 		}
 
-		return e.complexity.CheckoutSession.Status(childComplexity), true
+		return e.complexity.BackInStockSubscription.ProductID(childComplexity), true
 
-	case "CheckoutSession.subtotal":
-		if e.complexity.CheckoutSession.Subtotal == nil {
+	case "BackInStockSubscription.productName":
+		if e.complexity.BackInStockSubscription.ProductName == nil {
 			break
 		}
 
-		return e.complexity.CheckoutSession.Subtotal(childComplexity), true
+		return e.complexity.BackInStockSubscription.ProductName(childComplexity), true
 
-	case "CheckoutSession.tax":
-		if e.complexity.CheckoutSession.Tax == nil {
+	case "BackInStockSubscription.variantId":
+		if e.complexity.BackInStockSubscription.VariantID == nil {
 			break
 		}
 
-		return e.complexity.CheckoutSession.Tax(childComplexity), true
+		return e.complexity.BackInStockSubscription.VariantID(childComplexity), true
 
-	case "CheckoutSession.totalPrice":
-		if e.complexity.CheckoutSession.TotalPrice == nil {
+	case "BackInStockSubscription.variantName":
+		if e.complexity.BackInStockSubscription.VariantName == nil {
 			break
 		}
 
-		return e.complexity.CheckoutSession.TotalPrice(childComplexity), true
+		return e.complexity.BackInStockSubscription.VariantName(childComplexity), true
 
-	case "CheckoutSessionItem.id":
-		if e.complexity.CheckoutSessionItem.ID == nil {
+	case "BloatedTable.deadPercent":
+		if e.complexity.BloatedTable.DeadPercent == nil {
 			break
 		}
 
-		return e.complexity.CheckoutSessionItem.ID(childComplexity), true
+		return e.complexity.BloatedTable.DeadPercent(childComplexity), true
+
+	case "BloatedTable.deadTuples":
+		if e.complexity.BloatedTable.DeadTuples == nil {
+			break
+		}
+
+		return e.complexity.BloatedTable.DeadTuples(childComplexity), true
+
+	case "BloatedTable.lastAnalyzeAt":
+		if e.complexity.BloatedTable.LastAnalyzeAt == nil {
+			break
+		}
+
+		return e.complexity.BloatedTable.LastAnalyzeAt(childComplexity), true
+
+	case "BloatedTable.lastVacuumAt":
+		if e.complexity.BloatedTable.LastVacuumAt == nil {
+			break
+		}
+
+		return e.complexity.BloatedTable.LastVacuumAt(childComplexity), true
+
+	case "BloatedTable.liveTuples":
+		if e.complexity.BloatedTable.LiveTuples == nil {
+			break
+		}
+
+		return e.complexity.BloatedTable.LiveTuples(childComplexity), true
+
+	case "BloatedTable.table":
+		if e.complexity.BloatedTable.Table == nil {
+			break
+		}
+
+		return e.complexity.BloatedTable.Table(childComplexity), true
+
+	case "BlockedDisplayNameTerm.createdAt":
+		if e.complexity.BlockedDisplayNameTerm.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.BlockedDisplayNameTerm.CreatedAt(childComplexity), true
+
+	case "BlockedDisplayNameTerm.term":
+		if e.complexity.BlockedDisplayNameTerm.Term == nil {
+			break
+		}
+
+		return e.complexity.BlockedDisplayNameTerm.Term(childComplexity), true
+
+	case "CampaignPerformance.campaignId":
+		if e.complexity.CampaignPerformance.CampaignID == nil {
+			break
+		}
+
+		return e.complexity.CampaignPerformance.CampaignID(childComplexity), true
+
+	case "CampaignPerformance.campaignName":
+		if e.complexity.CampaignPerformance.CampaignName == nil {
+			break
+		}
+
+		return e.complexity.CampaignPerformance.CampaignName(childComplexity), true
+
+	case "CampaignPerformance.discountCost":
+		if e.complexity.CampaignPerformance.DiscountCost == nil {
+			break
+		}
+
+		return e.complexity.CampaignPerformance.DiscountCost(childComplexity), true
+
+	case "CampaignPerformance.netRevenueInfluenced":
+		if e.complexity.CampaignPerformance.NetRevenueInfluenced == nil {
+			break
+		}
+
+		return e.complexity.CampaignPerformance.NetRevenueInfluenced(childComplexity), true
+
+	case "CampaignPerformance.newCustomers":
+		if e.complexity.CampaignPerformance.NewCustomers == nil {
+			break
+		}
+
+		return e.complexity.CampaignPerformance.NewCustomers(childComplexity), true
+
+	case "CampaignPerformance.redemptions":
+		if e.complexity.CampaignPerformance.Redemptions == nil {
+			break
+		}
+
+		return e.complexity.CampaignPerformance.Redemptions(childComplexity), true
+
+	case "CampaignPerformance.returningCustomers":
+		if e.complexity.CampaignPerformance.ReturningCustomers == nil {
+			break
+		}
+
+		return e.complexity.CampaignPerformance.ReturningCustomers(childComplexity), true
+
+	case "CampaignPerformance.revenueInfluenced":
+		if e.complexity.CampaignPerformance.RevenueInfluenced == nil {
+			break
+		}
+
+		return e.complexity.CampaignPerformance.RevenueInfluenced(childComplexity), true
+
+	case "CampaignPerformance.uniqueCustomers":
+		if e.complexity.CampaignPerformance.UniqueCustomers == nil {
+			break
+		}
+
+		return e.complexity.CampaignPerformance.UniqueCustomers(childComplexity), true
+
+	case "CartConnection.items":
+		if e.complexity.CartConnection.Items == nil {
+			break
+		}
+
+		return e.complexity.CartConnection.Items(childComplexity), true
+
+	case "CartConnection.pageInfo":
+		if e.complexity.CartConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.CartConnection.PageInfo(childComplexity), true
+
+	case "CartItem.createdAt":
+		if e.complexity.CartItem.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.CartItem.CreatedAt(childComplexity), true
+
+	case "CartItem.id":
+		if e.complexity.CartItem.ID == nil {
+			break
+		}
+
+		return e.complexity.CartItem.ID(childComplexity), true
+
+	case "CartItem.product":
+		if e.complexity.CartItem.Product == nil {
+			break
+		}
+
+		return e.complexity.CartItem.Product(childComplexity), true
+
+	case "CartItem.quantity":
+		if e.complexity.CartItem.Quantity == nil {
+			break
+		}
+
+		return e.complexity.CartItem.Quantity(childComplexity), true
+
+	case "CartItem.updatedAt":
+		if e.complexity.CartItem.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.CartItem.UpdatedAt(childComplexity), true
+
+	case "CartItem.userId":
+		if e.complexity.CartItem.UserID == nil {
+			break
+		}
+
+		return e.complexity.CartItem.UserID(childComplexity), true
+
+	case "CatalogExport.createdAt":
+		if e.complexity.CatalogExport.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.CatalogExport.CreatedAt(childComplexity), true
+
+	case "CatalogExport.error":
+		if e.complexity.CatalogExport.Error == nil {
+			break
+		}
+
+		return e.complexity.CatalogExport.Error(childComplexity), true
+
+	case "CatalogExport.finishedAt":
+		if e.complexity.CatalogExport.FinishedAt == nil {
+			break
+		}
+
+		return e.complexity.CatalogExport.FinishedAt(childComplexity), true
+
+	case "CatalogExport.id":
+		if e.complexity.CatalogExport.ID == nil {
+			break
+		}
+
+		return e.complexity.CatalogExport.ID(childComplexity), true
+
+	case "CatalogExport.rowCount":
+		if e.complexity.CatalogExport.RowCount == nil {
+			break
+		}
+
+		return e.complexity.CatalogExport.RowCount(childComplexity), true
+
+	case "CatalogExport.status":
+		if e.complexity.CatalogExport.Status == nil {
+			break
+		}
+
+		return e.complexity.CatalogExport.Status(childComplexity), true
+
+	case "CatalogExport.url":
+		if e.complexity.CatalogExport.URL == nil {
+			break
+		}
+
+		return e.complexity.CatalogExport.URL(childComplexity), true
+
+	case "Category.id":
+		if e.complexity.Category.ID == nil {
+			break
+		}
+
+		return e.complexity.Category.ID(childComplexity), true
+
+	case "Category.name":
+		if e.complexity.Category.Name == nil {
+			break
+		}
+
+		return e.complexity.Category.Name(childComplexity), true
+
+	case "Category.slug":
+		if e.complexity.Category.Slug == nil {
+			break
+		}
+
+		return e.complexity.Category.Slug(childComplexity), true
+
+	case "Category.subcategories":
+		if e.complexity.Category.Subcategories == nil {
+			break
+		}
+
+		return e.complexity.Category.Subcategories(childComplexity), true
+
+	case "CategoryConnection.items":
+		if e.complexity.CategoryConnection.Items == nil {
+			break
+		}
+
+		return e.complexity.CategoryConnection.Items(childComplexity), true
+
+	case "CategoryConnection.pageInfo":
+		if e.complexity.CategoryConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.CategoryConnection.PageInfo(childComplexity), true
+
+	case "CheckoutRule.freeShippingMin":
+		if e.complexity.CheckoutRule.FreeShippingMin == nil {
+			break
+		}
+
+		return e.complexity.CheckoutRule.FreeShippingMin(childComplexity), true
+
+	case "CheckoutRule.id":
+		if e.complexity.CheckoutRule.ID == nil {
+			break
+		}
+
+		return e.complexity.CheckoutRule.ID(childComplexity), true
+
+	case "CheckoutRule.isActive":
+		if e.complexity.CheckoutRule.IsActive == nil {
+			break
+		}
+
+		return e.complexity.CheckoutRule.IsActive(childComplexity), true
+
+	case "CheckoutRule.minOrderAmount":
+		if e.complexity.CheckoutRule.MinOrderAmount == nil {
+			break
+		}
+
+		return e.complexity.CheckoutRule.MinOrderAmount(childComplexity), true
+
+	case "CheckoutRule.region":
+		if e.complexity.CheckoutRule.Region == nil {
+			break
+		}
+
+		return e.complexity.CheckoutRule.Region(childComplexity), true
+
+	case "CheckoutRule.updatedAt":
+		if e.complexity.CheckoutRule.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.CheckoutRule.UpdatedAt(childComplexity), true
+
+	case "CheckoutSession.addressId":
+		if e.complexity.CheckoutSession.AddressID == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSession.AddressID(childComplexity), true
+
+	case "CheckoutSession.chargeableWeightGrams":
+		if e.complexity.CheckoutSession.ChargeableWeightGrams == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSession.ChargeableWeightGrams(childComplexity), true
+
+	case "CheckoutSession.createdAt":
+		if e.complexity.CheckoutSession.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSession.CreatedAt(childComplexity), true
+
+	case "CheckoutSession.deliveryDate":
+		if e.complexity.CheckoutSession.DeliveryDate == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSession.DeliveryDate(childComplexity), true
+
+	case "CheckoutSession.deliverySlotId":
+		if e.complexity.CheckoutSession.DeliverySlotID == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSession.DeliverySlotID(childComplexity), true
+
+	case "CheckoutSession.discount":
+		if e.complexity.CheckoutSession.Discount == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSession.Discount(childComplexity), true
+
+	case "CheckoutSession.expiresAt":
+		if e.complexity.CheckoutSession.ExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSession.ExpiresAt(childComplexity), true
+
+	case "CheckoutSession.externalId":
+		if e.complexity.CheckoutSession.ExternalID == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSession.ExternalID(childComplexity), true
+
+	case "CheckoutSession.id":
+		if e.complexity.CheckoutSession.ID == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSession.ID(childComplexity), true
+
+	case "CheckoutSession.insuranceFee":
+		if e.complexity.CheckoutSession.InsuranceFee == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSession.InsuranceFee(childComplexity), true
+
+	case "CheckoutSession.insured":
+		if e.complexity.CheckoutSession.Insured == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSession.Insured(childComplexity), true
+
+	case "CheckoutSession.items":
+		if e.complexity.CheckoutSession.Items == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSession.Items(childComplexity), true
+
+	case "CheckoutSession.paymentExpiresAt":
+		if e.complexity.CheckoutSession.PaymentExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSession.PaymentExpiresAt(childComplexity), true
+
+	case "CheckoutSession.paymentMethod":
+		if e.complexity.CheckoutSession.PaymentMethod == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSession.PaymentMethod(childComplexity), true
+
+	case "CheckoutSession.paymentSecondsRemaining":
+		if e.complexity.CheckoutSession.PaymentSecondsRemaining == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSession.PaymentSecondsRemaining(childComplexity), true
+
+	case "CheckoutSession.pickupLocationId":
+		if e.complexity.CheckoutSession.PickupLocationID == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSession.PickupLocationID(childComplexity), true
+
+	case "CheckoutSession.pickupSlotStart":
+		if e.complexity.CheckoutSession.PickupSlotStart == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSession.PickupSlotStart(childComplexity), true
+
+	case "CheckoutSession.pointsRedeemed":
+		if e.complexity.CheckoutSession.PointsRedeemed == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSession.PointsRedeemed(childComplexity), true
+
+	case "CheckoutSession.secondsRemaining":
+		if e.complexity.CheckoutSession.SecondsRemaining == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSession.SecondsRemaining(childComplexity), true
+
+	case "CheckoutSession.shippingFee":
+		if e.complexity.CheckoutSession.ShippingFee == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSession.ShippingFee(childComplexity), true
+
+	case "CheckoutSession.shippingMethod":
+		if e.complexity.CheckoutSession.ShippingMethod == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSession.ShippingMethod(childComplexity), true
+
+	case "CheckoutSession.status":
+		if e.complexity.CheckoutSession.Status == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSession.Status(childComplexity), true
+
+	case "CheckoutSession.subtotal":
+		if e.complexity.CheckoutSession.Subtotal == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSession.Subtotal(childComplexity), true
+
+	case "CheckoutSession.tax":
+		if e.complexity.CheckoutSession.Tax == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSession.Tax(childComplexity), true
+
+	case "CheckoutSession.totalPrice":
+		if e.complexity.CheckoutSession.TotalPrice == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSession.TotalPrice(childComplexity), true
+
+	case "CheckoutSession.walletAmount":
+		if e.complexity.CheckoutSession.WalletAmount == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSession.WalletAmount(childComplexity), true
+
+	case "CheckoutSessionEvent.actorGuestId":
+		if e.complexity.CheckoutSessionEvent.ActorGuestID == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSessionEvent.ActorGuestID(childComplexity), true
+
+	case "CheckoutSessionEvent.actorRole":
+		if e.complexity.CheckoutSessionEvent.ActorRole == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSessionEvent.ActorRole(childComplexity), true
+
+	case "CheckoutSessionEvent.actorUserId":
+		if e.complexity.CheckoutSessionEvent.ActorUserID == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSessionEvent.ActorUserID(childComplexity), true
+
+	case "CheckoutSessionEvent.createdAt":
+		if e.complexity.CheckoutSessionEvent.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSessionEvent.CreatedAt(childComplexity), true
+
+	case "CheckoutSessionEvent.fromValue":
+		if e.complexity.CheckoutSessionEvent.FromValue == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSessionEvent.FromValue(childComplexity), true
+
+	case "CheckoutSessionEvent.id":
+		if e.complexity.CheckoutSessionEvent.ID == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSessionEvent.ID(childComplexity), true
+
+	case "CheckoutSessionEvent.toValue":
+		if e.complexity.CheckoutSessionEvent.ToValue == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSessionEvent.ToValue(childComplexity), true
+
+	case "CheckoutSessionEvent.totalAfter":
+		if e.complexity.CheckoutSessionEvent.TotalAfter == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSessionEvent.TotalAfter(childComplexity), true
+
+	case "CheckoutSessionEvent.totalBefore":
+		if e.complexity.CheckoutSessionEvent.TotalBefore == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSessionEvent.TotalBefore(childComplexity), true
+
+	case "CheckoutSessionEvent.type":
+		if e.complexity.CheckoutSessionEvent.Type == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSessionEvent.Type(childComplexity), true
+
+	case "CheckoutSessionItem.id":
+		if e.complexity.CheckoutSessionItem.ID == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSessionItem.ID(childComplexity), true
 
 	case "CheckoutSessionItem.imageUrl":
 		if e.complexity.CheckoutSessionItem.ImageURL == nil {
 			break
 		}
 
-		return e.complexity.CheckoutSessionItem.ImageURL(childComplexity), true
+		return e.complexity.CheckoutSessionItem.ImageURL(childComplexity), true
+
+	case "CheckoutSessionItem.price":
+		if e.complexity.CheckoutSessionItem.Price == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSessionItem.Price(childComplexity), true
+
+	case "CheckoutSessionItem.productName":
+		if e.complexity.CheckoutSessionItem.ProductName == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSessionItem.ProductName(childComplexity), true
+
+	case "CheckoutSessionItem.quantity":
+		if e.complexity.CheckoutSessionItem.Quantity == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSessionItem.Quantity(childComplexity), true
+
+	case "CheckoutSessionItem.quantityType":
+		if e.complexity.CheckoutSessionItem.QuantityType == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSessionItem.QuantityType(childComplexity), true
+
+	case "CheckoutSessionItem.subtotal":
+		if e.complexity.CheckoutSessionItem.Subtotal == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSessionItem.Subtotal(childComplexity), true
+
+	case "CheckoutSessionItem.variantId":
+		if e.complexity.CheckoutSessionItem.VariantID == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSessionItem.VariantID(childComplexity), true
+
+	case "CheckoutSessionItem.variantName":
+		if e.complexity.CheckoutSessionItem.VariantName == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSessionItem.VariantName(childComplexity), true
+
+	case "CheckoutSessionResponse.expiresAt":
+		if e.complexity.CheckoutSessionResponse.ExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSessionResponse.ExpiresAt(childComplexity), true
+
+	case "CheckoutSessionResponse.externalId":
+		if e.complexity.CheckoutSessionResponse.ExternalID == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSessionResponse.ExternalID(childComplexity), true
+
+	case "CheckoutSessionResponse.status":
+		if e.complexity.CheckoutSessionResponse.Status == nil {
+			break
+		}
+
+		return e.complexity.CheckoutSessionResponse.Status(childComplexity), true
+
+	case "ClientContext.countryCode":
+		if e.complexity.ClientContext.CountryCode == nil {
+			break
+		}
+
+		return e.complexity.ClientContext.CountryCode(childComplexity), true
+
+	case "ClientContext.currency":
+		if e.complexity.ClientContext.Currency == nil {
+			break
+		}
+
+		return e.complexity.ClientContext.Currency(childComplexity), true
+
+	case "ClientContext.region":
+		if e.complexity.ClientContext.Region == nil {
+			break
+		}
+
+		return e.complexity.ClientContext.Region(childComplexity), true
+
+	case "ClientContext.source":
+		if e.complexity.ClientContext.Source == nil {
+			break
+		}
+
+		return e.complexity.ClientContext.Source(childComplexity), true
+
+	case "CommissionRate.categoryId":
+		if e.complexity.CommissionRate.CategoryID == nil {
+			break
+		}
+
+		return e.complexity.CommissionRate.CategoryID(childComplexity), true
+
+	case "CommissionRate.createdAt":
+		if e.complexity.CommissionRate.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.CommissionRate.CreatedAt(childComplexity), true
+
+	case "CommissionRate.effectiveFrom":
+		if e.complexity.CommissionRate.EffectiveFrom == nil {
+			break
+		}
+
+		return e.complexity.CommissionRate.EffectiveFrom(childComplexity), true
+
+	case "CommissionRate.fixedFee":
+		if e.complexity.CommissionRate.FixedFee == nil {
+			break
+		}
+
+		return e.complexity.CommissionRate.FixedFee(childComplexity), true
+
+	case "CommissionRate.id":
+		if e.complexity.CommissionRate.ID == nil {
+			break
+		}
+
+		return e.complexity.CommissionRate.ID(childComplexity), true
+
+	case "CommissionRate.rateBps":
+		if e.complexity.CommissionRate.RateBps == nil {
+			break
+		}
+
+		return e.complexity.CommissionRate.RateBps(childComplexity), true
+
+	case "ComparedProduct.inStock":
+		if e.complexity.ComparedProduct.InStock == nil {
+			break
+		}
+
+		return e.complexity.ComparedProduct.InStock(childComplexity), true
+
+	case "ComparedProduct.maxPrice":
+		if e.complexity.ComparedProduct.MaxPrice == nil {
+			break
+		}
+
+		return e.complexity.ComparedProduct.MaxPrice(childComplexity), true
+
+	case "ComparedProduct.minPrice":
+		if e.complexity.ComparedProduct.MinPrice == nil {
+			break
+		}
+
+		return e.complexity.ComparedProduct.MinPrice(childComplexity), true
+
+	case "ComparedProduct.product":
+		if e.complexity.ComparedProduct.Product == nil {
+			break
+		}
+
+		return e.complexity.ComparedProduct.Product(childComplexity), true
+
+	case "ConfirmCheckoutSessionResponse.message":
+		if e.complexity.ConfirmCheckoutSessionResponse.Message == nil {
+			break
+		}
+
+		return e.complexity.ConfirmCheckoutSessionResponse.Message(childComplexity), true
+
+	case "ConfirmCheckoutSessionResponse.order_external_id":
+		if e.complexity.ConfirmCheckoutSessionResponse.OrderExternalID == nil {
+			break
+		}
+
+		return e.complexity.ConfirmCheckoutSessionResponse.OrderExternalID(childComplexity), true
+
+	case "ConfirmCheckoutSessionResponse.success":
+		if e.complexity.ConfirmCheckoutSessionResponse.Success == nil {
+			break
+		}
+
+		return e.complexity.ConfirmCheckoutSessionResponse.Success(childComplexity), true
+
+	case "CourierWebhook.attempts":
+		if e.complexity.CourierWebhook.Attempts == nil {
+			break
+		}
+
+		return e.complexity.CourierWebhook.Attempts(childComplexity), true
+
+	case "CourierWebhook.awb":
+		if e.complexity.CourierWebhook.Awb == nil {
+			break
+		}
+
+		return e.complexity.CourierWebhook.Awb(childComplexity), true
+
+	case "CourierWebhook.courier":
+		if e.complexity.CourierWebhook.Courier == nil {
+			break
+		}
+
+		return e.complexity.CourierWebhook.Courier(childComplexity), true
+
+	case "CourierWebhook.deadAt":
+		if e.complexity.CourierWebhook.DeadAt == nil {
+			break
+		}
+
+		return e.complexity.CourierWebhook.DeadAt(childComplexity), true
+
+	case "CourierWebhook.eventId":
+		if e.complexity.CourierWebhook.EventID == nil {
+			break
+		}
+
+		return e.complexity.CourierWebhook.EventID(childComplexity), true
+
+	case "CourierWebhook.id":
+		if e.complexity.CourierWebhook.ID == nil {
+			break
+		}
+
+		return e.complexity.CourierWebhook.ID(childComplexity), true
+
+	case "CourierWebhook.processError":
+		if e.complexity.CourierWebhook.ProcessError == nil {
+			break
+		}
+
+		return e.complexity.CourierWebhook.ProcessError(childComplexity), true
+
+	case "CourierWebhook.receivedAt":
+		if e.complexity.CourierWebhook.ReceivedAt == nil {
+			break
+		}
+
+		return e.complexity.CourierWebhook.ReceivedAt(childComplexity), true
+
+	case "CourierWebhook.status":
+		if e.complexity.CourierWebhook.Status == nil {
+			break
+		}
+
+		return e.complexity.CourierWebhook.Status(childComplexity), true
+
+	case "CreateAddressResponse.address":
+		if e.complexity.CreateAddressResponse.Address == nil {
+			break
+		}
+
+		return e.complexity.CreateAddressResponse.Address(childComplexity), true
+
+	case "CreateApiKeyResponse.apiKey":
+		if e.complexity.CreateApiKeyResponse.APIKey == nil {
+			break
+		}
+
+		return e.complexity.CreateApiKeyResponse.APIKey(childComplexity), true
+
+	case "CreateApiKeyResponse.secret":
+		if e.complexity.CreateApiKeyResponse.Secret == nil {
+			break
+		}
+
+		return e.complexity.CreateApiKeyResponse.Secret(childComplexity), true
+
+	case "CreateOrderResponse.message":
+		if e.complexity.CreateOrderResponse.Message == nil {
+			break
+		}
+
+		return e.complexity.CreateOrderResponse.Message(childComplexity), true
+
+	case "CreateOrderResponse.order":
+		if e.complexity.CreateOrderResponse.Order == nil {
+			break
+		}
+
+		return e.complexity.CreateOrderResponse.Order(childComplexity), true
+
+	case "CreateOrderResponse.payment":
+		if e.complexity.CreateOrderResponse.Payment == nil {
+			break
+		}
+
+		return e.complexity.CreateOrderResponse.Payment(childComplexity), true
+
+	case "CreateOrderResponse.paymentURL":
+		if e.complexity.CreateOrderResponse.PaymentURL == nil {
+			break
+		}
+
+		return e.complexity.CreateOrderResponse.PaymentURL(childComplexity), true
+
+	case "CreateOrderResponse.success":
+		if e.complexity.CreateOrderResponse.Success == nil {
+			break
+		}
+
+		return e.complexity.CreateOrderResponse.Success(childComplexity), true
+
+	case "CustomerSegmentSize.members":
+		if e.complexity.CustomerSegmentSize.Members == nil {
+			break
+		}
+
+		return e.complexity.CustomerSegmentSize.Members(childComplexity), true
+
+	case "CustomerSegmentSize.segment":
+		if e.complexity.CustomerSegmentSize.Segment == nil {
+			break
+		}
+
+		return e.complexity.CustomerSegmentSize.Segment(childComplexity), true
+
+	case "DatabaseHealthReport.bloatedTables":
+		if e.complexity.DatabaseHealthReport.BloatedTables == nil {
+			break
+		}
+
+		return e.complexity.DatabaseHealthReport.BloatedTables(childComplexity), true
+
+	case "DatabaseHealthReport.generatedAt":
+		if e.complexity.DatabaseHealthReport.GeneratedAt == nil {
+			break
+		}
+
+		return e.complexity.DatabaseHealthReport.GeneratedAt(childComplexity), true
+
+	case "DatabaseHealthReport.longRunningQueries":
+		if e.complexity.DatabaseHealthReport.LongRunningQueries == nil {
+			break
+		}
+
+		return e.complexity.DatabaseHealthReport.LongRunningQueries(childComplexity), true
+
+	case "DatabaseHealthReport.missingIndexCandidates":
+		if e.complexity.DatabaseHealthReport.MissingIndexCandidates == nil {
+			break
+		}
+
+		return e.complexity.DatabaseHealthReport.MissingIndexCandidates(childComplexity), true
+
+	case "DatabaseHealthReport.slowStatements":
+		if e.complexity.DatabaseHealthReport.SlowStatements == nil {
+			break
+		}
+
+		return e.complexity.DatabaseHealthReport.SlowStatements(childComplexity), true
+
+	case "DatabaseHealthReport.statementsAvailable":
+		if e.complexity.DatabaseHealthReport.StatementsAvailable == nil {
+			break
+		}
+
+		return e.complexity.DatabaseHealthReport.StatementsAvailable(childComplexity), true
+
+	case "DatabaseHealthReport.unusedIndexes":
+		if e.complexity.DatabaseHealthReport.UnusedIndexes == nil {
+			break
+		}
+
+		return e.complexity.DatabaseHealthReport.UnusedIndexes(childComplexity), true
+
+	case "DeleteAddressResponse.success":
+		if e.complexity.DeleteAddressResponse.Success == nil {
+			break
+		}
+
+		return e.complexity.DeleteAddressResponse.Success(childComplexity), true
+
+	case "DeliverySlot.capacity":
+		if e.complexity.DeliverySlot.Capacity == nil {
+			break
+		}
+
+		return e.complexity.DeliverySlot.Capacity(childComplexity), true
+
+	case "DeliverySlot.endsAt":
+		if e.complexity.DeliverySlot.EndsAt == nil {
+			break
+		}
+
+		return e.complexity.DeliverySlot.EndsAt(childComplexity), true
+
+	case "DeliverySlot.id":
+		if e.complexity.DeliverySlot.ID == nil {
+			break
+		}
+
+		return e.complexity.DeliverySlot.ID(childComplexity), true
+
+	case "DeliverySlot.isActive":
+		if e.complexity.DeliverySlot.IsActive == nil {
+			break
+		}
+
+		return e.complexity.DeliverySlot.IsActive(childComplexity), true
+
+	case "DeliverySlot.region":
+		if e.complexity.DeliverySlot.Region == nil {
+			break
+		}
+
+		return e.complexity.DeliverySlot.Region(childComplexity), true
+
+	case "DeliverySlot.startsAt":
+		if e.complexity.DeliverySlot.StartsAt == nil {
+			break
+		}
+
+		return e.complexity.DeliverySlot.StartsAt(childComplexity), true
+
+	case "DeliverySlot.updatedAt":
+		if e.complexity.DeliverySlot.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.DeliverySlot.UpdatedAt(childComplexity), true
+
+	case "DeliverySlotOption.available":
+		if e.complexity.DeliverySlotOption.Available == nil {
+			break
+		}
+
+		return e.complexity.DeliverySlotOption.Available(childComplexity), true
+
+	case "DeliverySlotOption.end":
+		if e.complexity.DeliverySlotOption.End == nil {
+			break
+		}
+
+		return e.complexity.DeliverySlotOption.End(childComplexity), true
+
+	case "DeliverySlotOption.remaining":
+		if e.complexity.DeliverySlotOption.Remaining == nil {
+			break
+		}
+
+		return e.complexity.DeliverySlotOption.Remaining(childComplexity), true
+
+	case "DeliverySlotOption.slot":
+		if e.complexity.DeliverySlotOption.Slot == nil {
+			break
+		}
+
+		return e.complexity.DeliverySlotOption.Slot(childComplexity), true
+
+	case "DeliverySlotOption.start":
+		if e.complexity.DeliverySlotOption.Start == nil {
+			break
+		}
+
+		return e.complexity.DeliverySlotOption.Start(childComplexity), true
+
+	case "DeliveryWindow.date":
+		if e.complexity.DeliveryWindow.Date == nil {
+			break
+		}
+
+		return e.complexity.DeliveryWindow.Date(childComplexity), true
+
+	case "DeliveryWindow.end":
+		if e.complexity.DeliveryWindow.End == nil {
+			break
+		}
+
+		return e.complexity.DeliveryWindow.End(childComplexity), true
+
+	case "DeliveryWindow.slotId":
+		if e.complexity.DeliveryWindow.SlotID == nil {
+			break
+		}
+
+		return e.complexity.DeliveryWindow.SlotID(childComplexity), true
+
+	case "DeliveryWindow.start":
+		if e.complexity.DeliveryWindow.Start == nil {
+			break
+		}
+
+		return e.complexity.DeliveryWindow.Start(childComplexity), true
+
+	case "Experiment.active":
+		if e.complexity.Experiment.Active == nil {
+			break
+		}
+
+		return e.complexity.Experiment.Active(childComplexity), true
+
+	case "Experiment.description":
+		if e.complexity.Experiment.Description == nil {
+			break
+		}
+
+		return e.complexity.Experiment.Description(childComplexity), true
+
+	case "Experiment.endedAt":
+		if e.complexity.Experiment.EndedAt == nil {
+			break
+		}
+
+		return e.complexity.Experiment.EndedAt(childComplexity), true
+
+	case "Experiment.key":
+		if e.complexity.Experiment.Key == nil {
+			break
+		}
+
+		return e.complexity.Experiment.Key(childComplexity), true
+
+	case "Experiment.startedAt":
+		if e.complexity.Experiment.StartedAt == nil {
+			break
+		}
+
+		return e.complexity.Experiment.StartedAt(childComplexity), true
+
+	case "Experiment.variants":
+		if e.complexity.Experiment.Variants == nil {
+			break
+		}
+
+		return e.complexity.Experiment.Variants(childComplexity), true
+
+	case "ExperimentAssignment.experimentKey":
+		if e.complexity.ExperimentAssignment.ExperimentKey == nil {
+			break
+		}
+
+		return e.complexity.ExperimentAssignment.ExperimentKey(childComplexity), true
+
+	case "ExperimentAssignment.variant":
+		if e.complexity.ExperimentAssignment.Variant == nil {
+			break
+		}
+
+		return e.complexity.ExperimentAssignment.Variant(childComplexity), true
+
+	case "ExperimentVariant.name":
+		if e.complexity.ExperimentVariant.Name == nil {
+			break
+		}
+
+		return e.complexity.ExperimentVariant.Name(childComplexity), true
+
+	case "ExperimentVariant.weight":
+		if e.complexity.ExperimentVariant.Weight == nil {
+			break
+		}
+
+		return e.complexity.ExperimentVariant.Weight(childComplexity), true
+
+	case "ExperimentVariantResult.orders":
+		if e.complexity.ExperimentVariantResult.Orders == nil {
+			break
+		}
+
+		return e.complexity.ExperimentVariantResult.Orders(childComplexity), true
+
+	case "ExperimentVariantResult.paidOrders":
+		if e.complexity.ExperimentVariantResult.PaidOrders == nil {
+			break
+		}
+
+		return e.complexity.ExperimentVariantResult.PaidOrders(childComplexity), true
+
+	case "ExperimentVariantResult.revenue":
+		if e.complexity.ExperimentVariantResult.Revenue == nil {
+			break
+		}
+
+		return e.complexity.ExperimentVariantResult.Revenue(childComplexity), true
+
+	case "ExperimentVariantResult.variant":
+		if e.complexity.ExperimentVariantResult.Variant == nil {
+			break
+		}
+
+		return e.complexity.ExperimentVariantResult.Variant(childComplexity), true
+
+	case "ForgotPasswordResponse.message":
+		if e.complexity.ForgotPasswordResponse.Message == nil {
+			break
+		}
+
+		return e.complexity.ForgotPasswordResponse.Message(childComplexity), true
+
+	case "ForgotPasswordResponse.success":
+		if e.complexity.ForgotPasswordResponse.Success == nil {
+			break
+		}
+
+		return e.complexity.ForgotPasswordResponse.Success(childComplexity), true
+
+	case "FulfillmentTask.assignedAt":
+		if e.complexity.FulfillmentTask.AssignedAt == nil {
+			break
+		}
+
+		return e.complexity.FulfillmentTask.AssignedAt(childComplexity), true
+
+	case "FulfillmentTask.itemCount":
+		if e.complexity.FulfillmentTask.ItemCount == nil {
+			break
+		}
+
+		return e.complexity.FulfillmentTask.ItemCount(childComplexity), true
+
+	case "FulfillmentTask.orderExternalId":
+		if e.complexity.FulfillmentTask.OrderExternalID == nil {
+			break
+		}
+
+		return e.complexity.FulfillmentTask.OrderExternalID(childComplexity), true
+
+	case "FulfillmentTask.orderId":
+		if e.complexity.FulfillmentTask.OrderID == nil {
+			break
+		}
+
+		return e.complexity.FulfillmentTask.OrderID(childComplexity), true
+
+	case "FulfillmentTask.orderStatus":
+		if e.complexity.FulfillmentTask.OrderStatus == nil {
+			break
+		}
+
+		return e.complexity.FulfillmentTask.OrderStatus(childComplexity), true
+
+	case "FulfillmentTask.orderedAt":
+		if e.complexity.FulfillmentTask.OrderedAt == nil {
+			break
+		}
+
+		return e.complexity.FulfillmentTask.OrderedAt(childComplexity), true
+
+	case "FulfillmentTask.packedAt":
+		if e.complexity.FulfillmentTask.PackedAt == nil {
+			break
+		}
+
+		return e.complexity.FulfillmentTask.PackedAt(childComplexity), true
+
+	case "FulfillmentTask.packedBy":
+		if e.complexity.FulfillmentTask.PackedBy == nil {
+			break
+		}
+
+		return e.complexity.FulfillmentTask.PackedBy(childComplexity), true
+
+	case "FulfillmentTask.pickerId":
+		if e.complexity.FulfillmentTask.PickerID == nil {
+			break
+		}
+
+		return e.complexity.FulfillmentTask.PickerID(childComplexity), true
+
+	case "JournalExport.csv":
+		if e.complexity.JournalExport.CSV == nil {
+			break
+		}
+
+		return e.complexity.JournalExport.CSV(childComplexity), true
+
+	case "JournalExport.entryCount":
+		if e.complexity.JournalExport.EntryCount == nil {
+			break
+		}
+
+		return e.complexity.JournalExport.EntryCount(childComplexity), true
+
+	case "JournalExport.from":
+		if e.complexity.JournalExport.From == nil {
+			break
+		}
+
+		return e.complexity.JournalExport.From(childComplexity), true
+
+	case "JournalExport.to":
+		if e.complexity.JournalExport.To == nil {
+			break
+		}
+
+		return e.complexity.JournalExport.To(childComplexity), true
+
+	case "JournalExport.totalCredit":
+		if e.complexity.JournalExport.TotalCredit == nil {
+			break
+		}
+
+		return e.complexity.JournalExport.TotalCredit(childComplexity), true
+
+	case "JournalExport.totalDebit":
+		if e.complexity.JournalExport.TotalDebit == nil {
+			break
+		}
+
+		return e.complexity.JournalExport.TotalDebit(childComplexity), true
+
+	case "Location.latitude":
+		if e.complexity.Location.Latitude == nil {
+			break
+		}
+
+		return e.complexity.Location.Latitude(childComplexity), true
+
+	case "Location.longitude":
+		if e.complexity.Location.Longitude == nil {
+			break
+		}
+
+		return e.complexity.Location.Longitude(childComplexity), true
+
+	case "LogSettings.debugModules":
+		if e.complexity.LogSettings.DebugModules == nil {
+			break
+		}
+
+		return e.complexity.LogSettings.DebugModules(childComplexity), true
+
+	case "LogSettings.expiresAt":
+		if e.complexity.LogSettings.ExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.LogSettings.ExpiresAt(childComplexity), true
+
+	case "LogSettings.level":
+		if e.complexity.LogSettings.Level == nil {
+			break
+		}
+
+		return e.complexity.LogSettings.Level(childComplexity), true
+
+	case "LogSettings.sampling":
+		if e.complexity.LogSettings.Sampling == nil {
+			break
+		}
+
+		return e.complexity.LogSettings.Sampling(childComplexity), true
+
+	case "LogSettings.updatedAt":
+		if e.complexity.LogSettings.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.LogSettings.UpdatedAt(childComplexity), true
+
+	case "LongRunningQuery.applicationName":
+		if e.complexity.LongRunningQuery.ApplicationName == nil {
+			break
+		}
+
+		return e.complexity.LongRunningQuery.ApplicationName(childComplexity), true
+
+	case "LongRunningQuery.durationSeconds":
+		if e.complexity.LongRunningQuery.DurationSeconds == nil {
+			break
+		}
+
+		return e.complexity.LongRunningQuery.DurationSeconds(childComplexity), true
+
+	case "LongRunningQuery.module":
+		if e.complexity.LongRunningQuery.Module == nil {
+			break
+		}
+
+		return e.complexity.LongRunningQuery.Module(childComplexity), true
+
+	case "LongRunningQuery.operation":
+		if e.complexity.LongRunningQuery.Operation == nil {
+			break
+		}
+
+		return e.complexity.LongRunningQuery.Operation(childComplexity), true
+
+	case "LongRunningQuery.pid":
+		if e.complexity.LongRunningQuery.Pid == nil {
+			break
+		}
+
+		return e.complexity.LongRunningQuery.Pid(childComplexity), true
+
+	case "LongRunningQuery.query":
+		if e.complexity.LongRunningQuery.Query == nil {
+			break
+		}
+
+		return e.complexity.LongRunningQuery.Query(childComplexity), true
+
+	case "LongRunningQuery.requestId":
+		if e.complexity.LongRunningQuery.RequestID == nil {
+			break
+		}
+
+		return e.complexity.LongRunningQuery.RequestID(childComplexity), true
+
+	case "LongRunningQuery.state":
+		if e.complexity.LongRunningQuery.State == nil {
+			break
+		}
+
+		return e.complexity.LongRunningQuery.State(childComplexity), true
+
+	case "LongRunningQuery.userId":
+		if e.complexity.LongRunningQuery.UserID == nil {
+			break
+		}
+
+		return e.complexity.LongRunningQuery.UserID(childComplexity), true
+
+	case "LongRunningQuery.waitEvent":
+		if e.complexity.LongRunningQuery.WaitEvent == nil {
+			break
+		}
+
+		return e.complexity.LongRunningQuery.WaitEvent(childComplexity), true
+
+	case "LoyaltyAccount.balance":
+		if e.complexity.LoyaltyAccount.Balance == nil {
+			break
+		}
+
+		return e.complexity.LoyaltyAccount.Balance(childComplexity), true
+
+	case "LoyaltyAccount.entries":
+		if e.complexity.LoyaltyAccount.Entries == nil {
+			break
+		}
+
+		return e.complexity.LoyaltyAccount.Entries(childComplexity), true
+
+	case "LoyaltyLedgerEntry.balanceAfter":
+		if e.complexity.LoyaltyLedgerEntry.BalanceAfter == nil {
+			break
+		}
+
+		return e.complexity.LoyaltyLedgerEntry.BalanceAfter(childComplexity), true
+
+	case "LoyaltyLedgerEntry.createdAt":
+		if e.complexity.LoyaltyLedgerEntry.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.LoyaltyLedgerEntry.CreatedAt(childComplexity), true
+
+	case "LoyaltyLedgerEntry.expiresAt":
+		if e.complexity.LoyaltyLedgerEntry.ExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.LoyaltyLedgerEntry.ExpiresAt(childComplexity), true
+
+	case "LoyaltyLedgerEntry.id":
+		if e.complexity.LoyaltyLedgerEntry.ID == nil {
+			break
+		}
+
+		return e.complexity.LoyaltyLedgerEntry.ID(childComplexity), true
+
+	case "LoyaltyLedgerEntry.points":
+		if e.complexity.LoyaltyLedgerEntry.Points == nil {
+			break
+		}
+
+		return e.complexity.LoyaltyLedgerEntry.Points(childComplexity), true
+
+	case "LoyaltyLedgerEntry.referenceId":
+		if e.complexity.LoyaltyLedgerEntry.ReferenceID == nil {
+			break
+		}
+
+		return e.complexity.LoyaltyLedgerEntry.ReferenceID(childComplexity), true
+
+	case "LoyaltyLedgerEntry.referenceType":
+		if e.complexity.LoyaltyLedgerEntry.ReferenceType == nil {
+			break
+		}
+
+		return e.complexity.LoyaltyLedgerEntry.ReferenceType(childComplexity), true
+
+	case "LoyaltyLedgerEntry.type":
+		if e.complexity.LoyaltyLedgerEntry.Type == nil {
+			break
+		}
+
+		return e.complexity.LoyaltyLedgerEntry.Type(childComplexity), true
+
+	case "LoyaltyRule.createdAt":
+		if e.complexity.LoyaltyRule.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.LoyaltyRule.CreatedAt(childComplexity), true
+
+	case "LoyaltyRule.endsAt":
+		if e.complexity.LoyaltyRule.EndsAt == nil {
+			break
+		}
+
+		return e.complexity.LoyaltyRule.EndsAt(childComplexity), true
+
+	case "LoyaltyRule.id":
+		if e.complexity.LoyaltyRule.ID == nil {
+			break
+		}
+
+		return e.complexity.LoyaltyRule.ID(childComplexity), true
+
+	case "LoyaltyRule.isActive":
+		if e.complexity.LoyaltyRule.IsActive == nil {
+			break
+		}
+
+		return e.complexity.LoyaltyRule.IsActive(childComplexity), true
+
+	case "LoyaltyRule.minOrderAmount":
+		if e.complexity.LoyaltyRule.MinOrderAmount == nil {
+			break
+		}
+
+		return e.complexity.LoyaltyRule.MinOrderAmount(childComplexity), true
+
+	case "LoyaltyRule.name":
+		if e.complexity.LoyaltyRule.Name == nil {
+			break
+		}
+
+		return e.complexity.LoyaltyRule.Name(childComplexity), true
+
+	case "LoyaltyRule.pointsPerUnit":
+		if e.complexity.LoyaltyRule.PointsPerUnit == nil {
+			break
+		}
+
+		return e.complexity.LoyaltyRule.PointsPerUnit(childComplexity), true
+
+	case "LoyaltyRule.startsAt":
+		if e.complexity.LoyaltyRule.StartsAt == nil {
+			break
+		}
+
+		return e.complexity.LoyaltyRule.StartsAt(childComplexity), true
+
+	case "LoyaltyRule.unitAmount":
+		if e.complexity.LoyaltyRule.UnitAmount == nil {
+			break
+		}
+
+		return e.complexity.LoyaltyRule.UnitAmount(childComplexity), true
+
+	case "MaintenanceMode.allowReads":
+		if e.complexity.MaintenanceMode.AllowReads == nil {
+			break
+		}
+
+		return e.complexity.MaintenanceMode.AllowReads(childComplexity), true
+
+	case "MaintenanceMode.enabled":
+		if e.complexity.MaintenanceMode.Enabled == nil {
+			break
+		}
+
+		return e.complexity.MaintenanceMode.Enabled(childComplexity), true
+
+	case "MaintenanceMode.message":
+		if e.complexity.MaintenanceMode.Message == nil {
+			break
+		}
+
+		return e.complexity.MaintenanceMode.Message(childComplexity), true
+
+	case "MaintenanceMode.source":
+		if e.complexity.MaintenanceMode.Source == nil {
+			break
+		}
+
+		return e.complexity.MaintenanceMode.Source(childComplexity), true
+
+	case "MaintenanceMode.updatedAt":
+		if e.complexity.MaintenanceMode.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.MaintenanceMode.UpdatedAt(childComplexity), true
+
+	case "MarketingConsent.channel":
+		if e.complexity.MarketingConsent.Channel == nil {
+			break
+		}
+
+		return e.complexity.MarketingConsent.Channel(childComplexity), true
+
+	case "MarketingConsent.status":
+		if e.complexity.MarketingConsent.Status == nil {
+			break
+		}
+
+		return e.complexity.MarketingConsent.Status(childComplexity), true
+
+	case "MarketingConsent.subscribedAt":
+		if e.complexity.MarketingConsent.SubscribedAt == nil {
+			break
+		}
+
+		return e.complexity.MarketingConsent.SubscribedAt(childComplexity), true
+
+	case "MarketingConsent.unsubscribedAt":
+		if e.complexity.MarketingConsent.UnsubscribedAt == nil {
+			break
+		}
+
+		return e.complexity.MarketingConsent.UnsubscribedAt(childComplexity), true
+
+	case "ModerationAuditEntry.action":
+		if e.complexity.ModerationAuditEntry.Action == nil {
+			break
+		}
+
+		return e.complexity.ModerationAuditEntry.Action(childComplexity), true
+
+	case "ModerationAuditEntry.actorId":
+		if e.complexity.ModerationAuditEntry.ActorID == nil {
+			break
+		}
+
+		return e.complexity.ModerationAuditEntry.ActorID(childComplexity), true
+
+	case "ModerationAuditEntry.createdAt":
+		if e.complexity.ModerationAuditEntry.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.ModerationAuditEntry.CreatedAt(childComplexity), true
+
+	case "ModerationAuditEntry.id":
+		if e.complexity.ModerationAuditEntry.ID == nil {
+			break
+		}
+
+		return e.complexity.ModerationAuditEntry.ID(childComplexity), true
+
+	case "ModerationAuditEntry.itemId":
+		if e.complexity.ModerationAuditEntry.ItemID == nil {
+			break
+		}
+
+		return e.complexity.ModerationAuditEntry.ItemID(childComplexity), true
+
+	case "ModerationAuditEntry.note":
+		if e.complexity.ModerationAuditEntry.Note == nil {
+			break
+		}
+
+		return e.complexity.ModerationAuditEntry.Note(childComplexity), true
+
+	case "ModerationAuditEntry.targetUserId":
+		if e.complexity.ModerationAuditEntry.TargetUserID == nil {
+			break
+		}
+
+		return e.complexity.ModerationAuditEntry.TargetUserID(childComplexity), true
+
+	case "ModerationItem.authorId":
+		if e.complexity.ModerationItem.AuthorID == nil {
+			break
+		}
+
+		return e.complexity.ModerationItem.AuthorID(childComplexity), true
+
+	case "ModerationItem.body":
+		if e.complexity.ModerationItem.Body == nil {
+			break
+		}
+
+		return e.complexity.ModerationItem.Body(childComplexity), true
+
+	case "ModerationItem.contentId":
+		if e.complexity.ModerationItem.ContentID == nil {
+			break
+		}
+
+		return e.complexity.ModerationItem.ContentID(childComplexity), true
+
+	case "ModerationItem.contentType":
+		if e.complexity.ModerationItem.ContentType == nil {
+			break
+		}
+
+		return e.complexity.ModerationItem.ContentType(childComplexity), true
+
+	case "ModerationItem.createdAt":
+		if e.complexity.ModerationItem.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.ModerationItem.CreatedAt(childComplexity), true
+
+	case "ModerationItem.hiddenAt":
+		if e.complexity.ModerationItem.HiddenAt == nil {
+			break
+		}
+
+		return e.complexity.ModerationItem.HiddenAt(childComplexity), true
+
+	case "ModerationItem.id":
+		if e.complexity.ModerationItem.ID == nil {
+			break
+		}
+
+		return e.complexity.ModerationItem.ID(childComplexity), true
+
+	case "ModerationItem.matchedKeywords":
+		if e.complexity.ModerationItem.MatchedKeywords == nil {
+			break
+		}
+
+		return e.complexity.ModerationItem.MatchedKeywords(childComplexity), true
+
+	case "ModerationItem.reportCount":
+		if e.complexity.ModerationItem.ReportCount == nil {
+			break
+		}
+
+		return e.complexity.ModerationItem.ReportCount(childComplexity), true
+
+	case "ModerationItem.resolvedAt":
+		if e.complexity.ModerationItem.ResolvedAt == nil {
+			break
+		}
+
+		return e.complexity.ModerationItem.ResolvedAt(childComplexity), true
+
+	case "ModerationItem.resolvedBy":
+		if e.complexity.ModerationItem.ResolvedBy == nil {
+			break
+		}
+
+		return e.complexity.ModerationItem.ResolvedBy(childComplexity), true
+
+	case "ModerationItem.status":
+		if e.complexity.ModerationItem.Status == nil {
+			break
+		}
+
+		return e.complexity.ModerationItem.Status(childComplexity), true
+
+	case "ModerationItem.updatedAt":
+		if e.complexity.ModerationItem.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.ModerationItem.UpdatedAt(childComplexity), true
+
+	case "ModerationKeyword.createdAt":
+		if e.complexity.ModerationKeyword.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.ModerationKeyword.CreatedAt(childComplexity), true
+
+	case "ModerationKeyword.term":
+		if e.complexity.ModerationKeyword.Term == nil {
+			break
+		}
+
+		return e.complexity.ModerationKeyword.Term(childComplexity), true
+
+	case "ModuleQueryStats.calls":
+		if e.complexity.ModuleQueryStats.Calls == nil {
+			break
+		}
+
+		return e.complexity.ModuleQueryStats.Calls(childComplexity), true
+
+	case "ModuleQueryStats.meanTimeMs":
+		if e.complexity.ModuleQueryStats.MeanTimeMs == nil {
+			break
+		}
+
+		return e.complexity.ModuleQueryStats.MeanTimeMs(childComplexity), true
+
+	case "ModuleQueryStats.module":
+		if e.complexity.ModuleQueryStats.Module == nil {
+			break
+		}
+
+		return e.complexity.ModuleQueryStats.Module(childComplexity), true
+
+	case "ModuleQueryStats.statements":
+		if e.complexity.ModuleQueryStats.Statements == nil {
+			break
+		}
+
+		return e.complexity.ModuleQueryStats.Statements(childComplexity), true
+
+	case "ModuleQueryStats.totalTimeMs":
+		if e.complexity.ModuleQueryStats.TotalTimeMs == nil {
+			break
+		}
+
+		return e.complexity.ModuleQueryStats.TotalTimeMs(childComplexity), true
+
+	case "Mutation.addCategory":
+		if e.complexity.Mutation.AddCategory == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addCategory_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddCategory(childComplexity, args["name"].(string)), true
+
+	case "Mutation.addModerationKeyword":
+		if e.complexity.Mutation.AddModerationKeyword == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addModerationKeyword_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddModerationKeyword(childComplexity, args["term"].(string)), true
+
+	case "Mutation.addPackage":
+		if e.complexity.Mutation.AddPackage == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addPackage_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddPackage(childComplexity, args["input"].(model.AddPackageInput)), true
+
+	case "Mutation.addSubcategory":
+		if e.complexity.Mutation.AddSubcategory == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addSubcategory_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddSubcategory(childComplexity, args["categoryID"].(string), args["name"].(string)), true
+
+	case "Mutation.addToCart":
+		if e.complexity.Mutation.AddToCart == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addToCart_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddToCart(childComplexity, args["input"].(model.AddToCartInput)), true
+
+	case "Mutation.addToWishlist":
+		if e.complexity.Mutation.AddToWishlist == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_addToWishlist_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AddToWishlist(childComplexity, args["variantId"].(string)), true
+
+	case "Mutation.adjustStock":
+		if e.complexity.Mutation.AdjustStock == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_adjustStock_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AdjustStock(childComplexity, args["input"].(model.AdjustStockInput)), true
+
+	case "Mutation.applyCoupon":
+		if e.complexity.Mutation.ApplyCoupon == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_applyCoupon_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ApplyCoupon(childComplexity, args["input"].(model.ApplyCouponInput)), true
+
+	case "Mutation.applySessionPoints":
+		if e.complexity.Mutation.ApplySessionPoints == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_applySessionPoints_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ApplySessionPoints(childComplexity, args["input"].(model.ApplySessionPointsInput)), true
+
+	case "Mutation.applySessionWallet":
+		if e.complexity.Mutation.ApplySessionWallet == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_applySessionWallet_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ApplySessionWallet(childComplexity, args["input"].(model.ApplySessionWalletInput)), true
+
+	case "Mutation.approveModerationItem":
+		if e.complexity.Mutation.ApproveModerationItem == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_approveModerationItem_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ApproveModerationItem(childComplexity, args["id"].(string), args["note"].(*string)), true
+
+	case "Mutation.approveOrderAdjustment":
+		if e.complexity.Mutation.ApproveOrderAdjustment == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_approveOrderAdjustment_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ApproveOrderAdjustment(childComplexity, args["id"].(string)), true
+
+	case "Mutation.approveStockAdjustment":
+		if e.complexity.Mutation.ApproveStockAdjustment == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_approveStockAdjustment_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ApproveStockAdjustment(childComplexity, args["id"].(string)), true
+
+	case "Mutation.archiveProduct":
+		if e.complexity.Mutation.ArchiveProduct == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_archiveProduct_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ArchiveProduct(childComplexity, args["id"].(string)), true
+
+	case "Mutation.assignOrderPicker":
+		if e.complexity.Mutation.AssignOrderPicker == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_assignOrderPicker_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.AssignOrderPicker(childComplexity, args["orderId"].(string), args["pickerId"].(string)), true
+
+	case "Mutation.banModerationAuthor":
+		if e.complexity.Mutation.BanModerationAuthor == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_banModerationAuthor_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.BanModerationAuthor(childComplexity, args["id"].(string), args["note"].(*string)), true
+
+	case "Mutation.blockDisplayNameTerm":
+		if e.complexity.Mutation.BlockDisplayNameTerm == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_blockDisplayNameTerm_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.BlockDisplayNameTerm(childComplexity, args["term"].(string)), true
+
+	case "Mutation.cancelMyStoreVacation":
+		if e.complexity.Mutation.CancelMyStoreVacation == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_cancelMyStoreVacation_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CancelMyStoreVacation(childComplexity, args["id"].(string)), true
+
+	case "Mutation.cancelScheduledPriceChange":
+		if e.complexity.Mutation.CancelScheduledPriceChange == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_cancelScheduledPriceChange_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CancelScheduledPriceChange(childComplexity, args["id"].(string)), true
+
+	case "Mutation.cancelStockTransfer":
+		if e.complexity.Mutation.CancelStockTransfer == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_cancelStockTransfer_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CancelStockTransfer(childComplexity, args["id"].(string)), true
+
+	case "Mutation.confirmCheckoutSession":
+		if e.complexity.Mutation.ConfirmCheckoutSession == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_confirmCheckoutSession_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ConfirmCheckoutSession(childComplexity, args["input"].(model.ConfirmCheckoutSessionInput)), true
+
+	case "Mutation.createApiKey":
+		if e.complexity.Mutation.CreateAPIKey == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createApiKey_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateAPIKey(childComplexity, args["input"].(model.CreateAPIKeyInput)), true
+
+	case "Mutation.createAddress":
+		if e.complexity.Mutation.CreateAddress == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createAddress_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateAddress(childComplexity, args["input"].(model.CreateAddressInput)), true
+
+	case "Mutation.createAdminOrder":
+		if e.complexity.Mutation.CreateAdminOrder == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createAdminOrder_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateAdminOrder(childComplexity, args["input"].(model.CreateAdminOrderInput)), true
+
+	case "Mutation.createCheckoutSession":
+		if e.complexity.Mutation.CreateCheckoutSession == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createCheckoutSession_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateCheckoutSession(childComplexity, args["input"].(model.CreateCheckoutSessionInput)), true
+
+	case "Mutation.createCommissionRate":
+		if e.complexity.Mutation.CreateCommissionRate == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createCommissionRate_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateCommissionRate(childComplexity, args["input"].(model.CreateCommissionRateInput)), true
+
+	case "Mutation.createExperiment":
+		if e.complexity.Mutation.CreateExperiment == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createExperiment_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateExperiment(childComplexity, args["input"].(model.CreateExperimentInput)), true
+
+	case "Mutation.createLoyaltyRule":
+		if e.complexity.Mutation.CreateLoyaltyRule == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createLoyaltyRule_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateLoyaltyRule(childComplexity, args["input"].(model.CreateLoyaltyRuleInput)), true
+
+	case "Mutation.createMyStoreShippingOrigin":
+		if e.complexity.Mutation.CreateMyStoreShippingOrigin == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createMyStoreShippingOrigin_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateMyStoreShippingOrigin(childComplexity, args["input"].(model.StoreShippingOriginInput)), true
+
+	case "Mutation.createOrderFromSession":
+		if e.complexity.Mutation.CreateOrderFromSession == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createOrderFromSession_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateOrderFromSession(childComplexity, args["input"].(model.CreateOrderFromSessionInput)), true
+
+	case "Mutation.createProduct":
+		if e.complexity.Mutation.CreateProduct == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createProduct_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateProduct(childComplexity, args["input"].(model.NewProduct)), true
+
+	case "Mutation.createStockTransfer":
+		if e.complexity.Mutation.CreateStockTransfer == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createStockTransfer_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateStockTransfer(childComplexity, args["input"].(model.CreateStockTransferInput)), true
+
+	case "Mutation.createVariants":
+		if e.complexity.Mutation.CreateVariants == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createVariants_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateVariants(childComplexity, args["input"].([]*model.NewVariant)), true
+
+	case "Mutation.createVoucherCampaign":
+		if e.complexity.Mutation.CreateVoucherCampaign == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createVoucherCampaign_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateVoucherCampaign(childComplexity, args["input"].(model.CreateVoucherCampaignInput)), true
+
+	case "Mutation.createWarehouse":
+		if e.complexity.Mutation.CreateWarehouse == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_createWarehouse_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.CreateWarehouse(childComplexity, args["input"].(model.CreateWarehouseInput)), true
+
+	case "Mutation.deleteAddress":
+		if e.complexity.Mutation.DeleteAddress == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteAddress_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteAddress(childComplexity, args["input"].(model.DeleteAddressInput)), true
+
+	case "Mutation.deleteCommissionRate":
+		if e.complexity.Mutation.DeleteCommissionRate == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteCommissionRate_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteCommissionRate(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteMyStoreShippingOrigin":
+		if e.complexity.Mutation.DeleteMyStoreShippingOrigin == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteMyStoreShippingOrigin_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteMyStoreShippingOrigin(childComplexity, args["id"].(string)), true
+
+	case "Mutation.deleteProduct":
+		if e.complexity.Mutation.DeleteProduct == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_deleteProduct_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.DeleteProduct(childComplexity, args["id"].(string)), true
+
+	case "Mutation.endExperiment":
+		if e.complexity.Mutation.EndExperiment == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_endExperiment_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.EndExperiment(childComplexity, args["key"].(string)), true
+
+	case "Mutation.forgotPassword":
+		if e.complexity.Mutation.ForgotPassword == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_forgotPassword_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ForgotPassword(childComplexity, args["input"].(model.ForgotPasswordInput)), true
+
+	case "Mutation.issueSegmentVouchers":
+		if e.complexity.Mutation.IssueSegmentVouchers == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_issueSegmentVouchers_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.IssueSegmentVouchers(childComplexity, args["input"].(model.IssueSegmentVouchersInput)), true
+
+	case "Mutation.liftAccountRestriction":
+		if e.complexity.Mutation.LiftAccountRestriction == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_liftAccountRestriction_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.LiftAccountRestriction(childComplexity, args["userId"].(string)), true
+
+	case "Mutation.login":
+		if e.complexity.Mutation.Login == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_login_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.Login(childComplexity, args["input"].(model.LoginInput)), true
+
+	case "Mutation.loginWithOtp":
+		if e.complexity.Mutation.LoginWithOtp == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_loginWithOtp_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.LoginWithOtp(childComplexity, args["phone"].(string), args["code"].(string)), true
+
+	case "Mutation.loginWithPhone":
+		if e.complexity.Mutation.LoginWithPhone == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_loginWithPhone_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.LoginWithPhone(childComplexity, args["input"].(model.PhoneLoginInput)), true
+
+	case "Mutation.logout":
+		if e.complexity.Mutation.Logout == nil {
+			break
+		}
+
+		return e.complexity.Mutation.Logout(childComplexity), true
+
+	case "Mutation.markOrderMessagesRead":
+		if e.complexity.Mutation.MarkOrderMessagesRead == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_markOrderMessagesRead_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.MarkOrderMessagesRead(childComplexity, args["orderId"].(string)), true
+
+	case "Mutation.markOrderPacked":
+		if e.complexity.Mutation.MarkOrderPacked == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_markOrderPacked_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.MarkOrderPacked(childComplexity, args["orderId"].(string)), true
+
+	case "Mutation.markWishlistAlertsRead":
+		if e.complexity.Mutation.MarkWishlistAlertsRead == nil {
+			break
+		}
+
+		return e.complexity.Mutation.MarkWishlistAlertsRead(childComplexity), true
+
+	case "Mutation.processPendingRefunds":
+		if e.complexity.Mutation.ProcessPendingRefunds == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_processPendingRefunds_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ProcessPendingRefunds(childComplexity, args["limit"].(*int32)), true
+
+	case "Mutation.publishPolicy":
+		if e.complexity.Mutation.PublishPolicy == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_publishPolicy_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.PublishPolicy(childComplexity, args["input"].(model.PublishPolicyInput)), true
+
+	case "Mutation.receiveStockTransfer":
+		if e.complexity.Mutation.ReceiveStockTransfer == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_receiveStockTransfer_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ReceiveStockTransfer(childComplexity, args["id"].(string)), true
+
+	case "Mutation.recordSettlementFees":
+		if e.complexity.Mutation.RecordSettlementFees == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_recordSettlementFees_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RecordSettlementFees(childComplexity, args["fees"].([]*model.SettlementFeeInput)), true
+
+	case "Mutation.refreshCustomerSegments":
+		if e.complexity.Mutation.RefreshCustomerSegments == nil {
+			break
+		}
+
+		return e.complexity.Mutation.RefreshCustomerSegments(childComplexity), true
+
+	case "Mutation.register":
+		if e.complexity.Mutation.Register == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_register_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.Register(childComplexity, args["input"].(model.RegisterInput)), true
+
+	case "Mutation.rejectOrderAdjustment":
+		if e.complexity.Mutation.RejectOrderAdjustment == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_rejectOrderAdjustment_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RejectOrderAdjustment(childComplexity, args["id"].(string)), true
+
+	case "Mutation.rejectStockAdjustment":
+		if e.complexity.Mutation.RejectStockAdjustment == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_rejectStockAdjustment_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RejectStockAdjustment(childComplexity, args["id"].(string)), true
+
+	case "Mutation.removeFromCart":
+		if e.complexity.Mutation.RemoveFromCart == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_removeFromCart_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RemoveFromCart(childComplexity, args["variantIds"].([]string)), true
+
+	case "Mutation.removeFromWishlist":
+		if e.complexity.Mutation.RemoveFromWishlist == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_removeFromWishlist_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RemoveFromWishlist(childComplexity, args["variantId"].(string)), true
+
+	case "Mutation.removeModerationItem":
+		if e.complexity.Mutation.RemoveModerationItem == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_removeModerationItem_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RemoveModerationItem(childComplexity, args["id"].(string), args["note"].(*string)), true
+
+	case "Mutation.removeModerationKeyword":
+		if e.complexity.Mutation.RemoveModerationKeyword == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_removeModerationKeyword_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RemoveModerationKeyword(childComplexity, args["term"].(string)), true
+
+	case "Mutation.removeSessionItem":
+		if e.complexity.Mutation.RemoveSessionItem == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_removeSessionItem_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RemoveSessionItem(childComplexity, args["input"].(model.RemoveSessionItemInput)), true
+
+	case "Mutation.reportContent":
+		if e.complexity.Mutation.ReportContent == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_reportContent_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ReportContent(childComplexity, args["contentType"].(model.ModerationContentType), args["contentId"].(string), args["reason"].(*string)), true
+
+	case "Mutation.requestCatalogExport":
+		if e.complexity.Mutation.RequestCatalogExport == nil {
+			break
+		}
+
+		return e.complexity.Mutation.RequestCatalogExport(childComplexity), true
+
+	case "Mutation.requestLoginOtp":
+		if e.complexity.Mutation.RequestLoginOtp == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_requestLoginOtp_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RequestLoginOtp(childComplexity, args["phone"].(string)), true
+
+	case "Mutation.requestOrderAdjustment":
+		if e.complexity.Mutation.RequestOrderAdjustment == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_requestOrderAdjustment_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RequestOrderAdjustment(childComplexity, args["input"].(model.RequestOrderAdjustmentInput)), true
+
+	case "Mutation.requestPhoneVerification":
+		if e.complexity.Mutation.RequestPhoneVerification == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_requestPhoneVerification_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RequestPhoneVerification(childComplexity, args["input"].(model.RequestPhoneVerificationInput)), true
+
+	case "Mutation.requestRefund":
+		if e.complexity.Mutation.RequestRefund == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_requestRefund_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RequestRefund(childComplexity, args["input"].(model.RequestRefundInput)), true
+
+	case "Mutation.requeueCourierWebhook":
+		if e.complexity.Mutation.RequeueCourierWebhook == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_requeueCourierWebhook_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RequeueCourierWebhook(childComplexity, args["id"].(string)), true
+
+	case "Mutation.resendOrderReceipt":
+		if e.complexity.Mutation.ResendOrderReceipt == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_resendOrderReceipt_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ResendOrderReceipt(childComplexity, args["orderId"].(string)), true
+
+	case "Mutation.resetPassword":
+		if e.complexity.Mutation.ResetPassword == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_resetPassword_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ResetPassword(childComplexity, args["input"].(model.ResetPasswordInput)), true
+
+	case "Mutation.resolvePaymentDispute":
+		if e.complexity.Mutation.ResolvePaymentDispute == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_resolvePaymentDispute_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ResolvePaymentDispute(childComplexity, args["id"].(string), args["outcome"].(model.DisputeOutcome), args["note"].(*string)), true
+
+	case "Mutation.restrictAccount":
+		if e.complexity.Mutation.RestrictAccount == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_restrictAccount_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RestrictAccount(childComplexity, args["input"].(model.RestrictAccountInput)), true
+
+	case "Mutation.revokeApiKey":
+		if e.complexity.Mutation.RevokeAPIKey == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_revokeApiKey_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.RevokeAPIKey(childComplexity, args["id"].(string)), true
+
+	case "Mutation.runSyntheticCheckout":
+		if e.complexity.Mutation.RunSyntheticCheckout == nil {
+			break
+		}
+
+		return e.complexity.Mutation.RunSyntheticCheckout(childComplexity), true
+
+	case "Mutation.scheduleMyStoreVacation":
+		if e.complexity.Mutation.ScheduleMyStoreVacation == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_scheduleMyStoreVacation_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ScheduleMyStoreVacation(childComplexity, args["input"].(model.StoreVacationInput)), true
+
+	case "Mutation.schedulePriceChange":
+		if e.complexity.Mutation.SchedulePriceChange == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_schedulePriceChange_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SchedulePriceChange(childComplexity, args["input"].(model.SchedulePriceChangeInput)), true
+
+	case "Mutation.sendOrderMessage":
+		if e.complexity.Mutation.SendOrderMessage == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_sendOrderMessage_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SendOrderMessage(childComplexity, args["orderId"].(string), args["body"].(string), args["attachmentIds"].([]string)), true
+
+	case "Mutation.setCheckoutRule":
+		if e.complexity.Mutation.SetCheckoutRule == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setCheckoutRule_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetCheckoutRule(childComplexity, args["input"].(model.SetCheckoutRuleInput)), true
+
+	case "Mutation.setDefaultAddress":
+		if e.complexity.Mutation.SetDefaultAddress == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setDefaultAddress_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetDefaultAddress(childComplexity, args["addressId"].(string)), true
+
+	case "Mutation.setDeliverySlot":
+		if e.complexity.Mutation.SetDeliverySlot == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setDeliverySlot_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetDeliverySlot(childComplexity, args["input"].(model.SetDeliverySlotInput)), true
+
+	case "Mutation.setLogSettings":
+		if e.complexity.Mutation.SetLogSettings == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setLogSettings_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetLogSettings(childComplexity, args["input"].(model.SetLogSettingsInput)), true
+
+	case "Mutation.setLoyaltyRuleActive":
+		if e.complexity.Mutation.SetLoyaltyRuleActive == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setLoyaltyRuleActive_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetLoyaltyRuleActive(childComplexity, args["id"].(string), args["active"].(bool)), true
+
+	case "Mutation.setMaintenanceMode":
+		if e.complexity.Mutation.SetMaintenanceMode == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setMaintenanceMode_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetMaintenanceMode(childComplexity, args["input"].(model.SetMaintenanceModeInput)), true
+
+	case "Mutation.setMyProductShippingOrigin":
+		if e.complexity.Mutation.SetMyProductShippingOrigin == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setMyProductShippingOrigin_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetMyProductShippingOrigin(childComplexity, args["productId"].(string), args["originId"].(*string)), true
+
+	case "Mutation.setMyStoreDefaultShippingOrigin":
+		if e.complexity.Mutation.SetMyStoreDefaultShippingOrigin == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setMyStoreDefaultShippingOrigin_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetMyStoreDefaultShippingOrigin(childComplexity, args["id"].(string)), true
+
+	case "Mutation.setMyStoreOperatingHours":
+		if e.complexity.Mutation.SetMyStoreOperatingHours == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setMyStoreOperatingHours_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetMyStoreOperatingHours(childComplexity, args["hours"].([]*model.StoreOperatingHoursInput)), true
+
+	case "Mutation.setOrderReason":
+		if e.complexity.Mutation.SetOrderReason == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setOrderReason_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetOrderReason(childComplexity, args["input"].(model.SetOrderReasonInput)), true
+
+	case "Mutation.setPickupLocation":
+		if e.complexity.Mutation.SetPickupLocation == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setPickupLocation_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetPickupLocation(childComplexity, args["input"].(model.SetPickupLocationInput)), true
+
+	case "Mutation.setWarehouseActive":
+		if e.complexity.Mutation.SetWarehouseActive == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setWarehouseActive_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetWarehouseActive(childComplexity, args["id"].(string), args["active"].(bool)), true
+
+	case "Mutation.setWarehouseStock":
+		if e.complexity.Mutation.SetWarehouseStock == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_setWarehouseStock_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SetWarehouseStock(childComplexity, args["warehouseId"].(string), args["variantId"].(string), args["quantity"].(int32)), true
+
+	case "Mutation.shipOrder":
+		if e.complexity.Mutation.ShipOrder == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_shipOrder_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.ShipOrder(childComplexity, args["orderId"].(string), args["courier"].(string), args["awb"].(string)), true
+
+	case "Mutation.subscribeBackInStock":
+		if e.complexity.Mutation.SubscribeBackInStock == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_subscribeBackInStock_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SubscribeBackInStock(childComplexity, args["variantId"].(string)), true
+
+	case "Mutation.subscribeMarketing":
+		if e.complexity.Mutation.SubscribeMarketing == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_subscribeMarketing_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.SubscribeMarketing(childComplexity, args["channel"].(model.MarketingChannel)), true
+
+	case "Mutation.unblockDisplayNameTerm":
+		if e.complexity.Mutation.UnblockDisplayNameTerm == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_unblockDisplayNameTerm_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UnblockDisplayNameTerm(childComplexity, args["term"].(string)), true
+
+	case "Mutation.unsubscribeBackInStock":
+		if e.complexity.Mutation.UnsubscribeBackInStock == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_unsubscribeBackInStock_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UnsubscribeBackInStock(childComplexity, args["variantId"].(string)), true
+
+	case "Mutation.unsubscribeMarketing":
+		if e.complexity.Mutation.UnsubscribeMarketing == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_unsubscribeMarketing_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UnsubscribeMarketing(childComplexity, args["channel"].(model.MarketingChannel)), true
+
+	case "Mutation.updateAddress":
+		if e.complexity.Mutation.UpdateAddress == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateAddress_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateAddress(childComplexity, args["input"].(model.UpdateAddressInput)), true
+
+	case "Mutation.updateCart":
+		if e.complexity.Mutation.UpdateCart == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateCart_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateCart(childComplexity, args["input"].(model.UpdateCartInput)), true
+
+	case "Mutation.updateMyPreferences":
+		if e.complexity.Mutation.UpdateMyPreferences == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateMyPreferences_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateMyPreferences(childComplexity, args["input"].(model.UpdatePreferencesInput)), true
+
+	case "Mutation.updateMyStore":
+		if e.complexity.Mutation.UpdateMyStore == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateMyStore_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateMyStore(childComplexity, args["input"].(model.UpdateStoreInput)), true
+
+	case "Mutation.updateMyStoreShippingOrigin":
+		if e.complexity.Mutation.UpdateMyStoreShippingOrigin == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateMyStoreShippingOrigin_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateMyStoreShippingOrigin(childComplexity, args["id"].(string), args["input"].(model.StoreShippingOriginInput)), true
+
+	case "Mutation.updateOrderStatus":
+		if e.complexity.Mutation.UpdateOrderStatus == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateOrderStatus_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateOrderStatus(childComplexity, args["input"].(model.UpdateOrderStatusInput)), true
+
+	case "Mutation.updateProduct":
+		if e.complexity.Mutation.UpdateProduct == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateProduct_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateProduct(childComplexity, args["input"].(model.UpdateProduct)), true
+
+	case "Mutation.updateProfile":
+		if e.complexity.Mutation.UpdateProfile == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateProfile_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateProfile(childComplexity, args["input"].(model.UpdateProfileInput)), true
+
+	case "Mutation.updateSessionAddress":
+		if e.complexity.Mutation.UpdateSessionAddress == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateSessionAddress_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateSessionAddress(childComplexity, args["input"].(model.UpdateSessionAddressInput)), true
+
+	case "Mutation.updateSessionInsurance":
+		if e.complexity.Mutation.UpdateSessionInsurance == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateSessionInsurance_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateSessionInsurance(childComplexity, args["input"].(model.UpdateSessionInsuranceInput)), true
+
+	case "Mutation.updateSessionItem":
+		if e.complexity.Mutation.UpdateSessionItem == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateSessionItem_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateSessionItem(childComplexity, args["input"].(model.UpdateSessionItemInput)), true
+
+	case "Mutation.updateSessionPaymentMethod":
+		if e.complexity.Mutation.UpdateSessionPaymentMethod == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateSessionPaymentMethod_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateSessionPaymentMethod(childComplexity, args["input"].(model.UpdateSessionPaymentMethodInput)), true
+
+	case "Mutation.updateSessionShippingMethod":
+		if e.complexity.Mutation.UpdateSessionShippingMethod == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateSessionShippingMethod_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateSessionShippingMethod(childComplexity, args["input"].(model.UpdateSessionShippingMethodInput)), true
+
+	case "Mutation.updateVariants":
+		if e.complexity.Mutation.UpdateVariants == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_updateVariants_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UpdateVariants(childComplexity, args["input"].([]*model.UpdateVariant)), true
+
+	case "Mutation.uploadAvatar":
+		if e.complexity.Mutation.UploadAvatar == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_uploadAvatar_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UploadAvatar(childComplexity, args["file"].(graphql.Upload)), true
+
+	case "Mutation.uploadImportFile":
+		if e.complexity.Mutation.UploadImportFile == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_uploadImportFile_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UploadImportFile(childComplexity, args["file"].(graphql.Upload)), true
+
+	case "Mutation.uploadOrderMessageAttachment":
+		if e.complexity.Mutation.UploadOrderMessageAttachment == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_uploadOrderMessageAttachment_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UploadOrderMessageAttachment(childComplexity, args["orderId"].(string), args["file"].(graphql.Upload)), true
+
+	case "Mutation.uploadProductImage":
+		if e.complexity.Mutation.UploadProductImage == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_uploadProductImage_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UploadProductImage(childComplexity, args["productId"].(string), args["file"].(graphql.Upload)), true
+
+	case "Mutation.uploadReturnEvidence":
+		if e.complexity.Mutation.UploadReturnEvidence == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_uploadReturnEvidence_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.UploadReturnEvidence(childComplexity, args["orderId"].(string), args["file"].(graphql.Upload)), true
+
+	case "Mutation.verifyPhone":
+		if e.complexity.Mutation.VerifyPhone == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_verifyPhone_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.VerifyPhone(childComplexity, args["input"].(model.VerifyPhoneInput)), true
+
+	case "Mutation.verifyPickupCode":
+		if e.complexity.Mutation.VerifyPickupCode == nil {
+			break
+		}
+
+		args, err := ec.field_Mutation_verifyPickupCode_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Mutation.VerifyPickupCode(childComplexity, args["input"].(model.VerifyPickupCodeInput)), true
+
+	case "NegativeStockVariant.name":
+		if e.complexity.NegativeStockVariant.Name == nil {
+			break
+		}
+
+		return e.complexity.NegativeStockVariant.Name(childComplexity), true
+
+	case "NegativeStockVariant.stock":
+		if e.complexity.NegativeStockVariant.Stock == nil {
+			break
+		}
+
+		return e.complexity.NegativeStockVariant.Stock(childComplexity), true
+
+	case "NegativeStockVariant.variantId":
+		if e.complexity.NegativeStockVariant.VariantID == nil {
+			break
+		}
+
+		return e.complexity.NegativeStockVariant.VariantID(childComplexity), true
+
+	case "NotificationSetting.channel":
+		if e.complexity.NotificationSetting.Channel == nil {
+			break
+		}
+
+		return e.complexity.NotificationSetting.Channel(childComplexity), true
+
+	case "NotificationSetting.enabled":
+		if e.complexity.NotificationSetting.Enabled == nil {
+			break
+		}
+
+		return e.complexity.NotificationSetting.Enabled(childComplexity), true
+
+	case "NotificationSetting.event":
+		if e.complexity.NotificationSetting.Event == nil {
+			break
+		}
+
+		return e.complexity.NotificationSetting.Event(childComplexity), true
+
+	case "Order.acceptedPolicies":
+		if e.complexity.Order.AcceptedPolicies == nil {
+			break
+		}
+
+		return e.complexity.Order.AcceptedPolicies(childComplexity), true
+
+	case "Order.cancelNote":
+		if e.complexity.Order.CancelNote == nil {
+			break
+		}
+
+		return e.complexity.Order.CancelNote(childComplexity), true
+
+	case "Order.cancelReason":
+		if e.complexity.Order.CancelReason == nil {
+			break
+		}
+
+		return e.complexity.Order.CancelReason(childComplexity), true
+
+	case "Order.deliveryWindow":
+		if e.complexity.Order.DeliveryWindow == nil {
+			break
+		}
+
+		return e.complexity.Order.DeliveryWindow(childComplexity), true
+
+	case "Order.externalId":
+		if e.complexity.Order.ExternalID == nil {
+			break
+		}
+
+		return e.complexity.Order.ExternalID(childComplexity), true
+
+	case "Order.id":
+		if e.complexity.Order.ID == nil {
+			break
+		}
+
+		return e.complexity.Order.ID(childComplexity), true
+
+	case "Order.invoiceNumber":
+		if e.complexity.Order.InvoiceNumber == nil {
+			break
+		}
+
+		return e.complexity.Order.InvoiceNumber(childComplexity), true
+
+	case "Order.items":
+		if e.complexity.Order.Items == nil {
+			break
+		}
+
+		return e.complexity.Order.Items(childComplexity), true
+
+	case "Order.pickup":
+		if e.complexity.Order.Pickup == nil {
+			break
+		}
+
+		return e.complexity.Order.Pickup(childComplexity), true
+
+	case "Order.pricing":
+		if e.complexity.Order.Pricing == nil {
+			break
+		}
+
+		return e.complexity.Order.Pricing(childComplexity), true
+
+	case "Order.shipping":
+		if e.complexity.Order.Shipping == nil {
+			break
+		}
+
+		return e.complexity.Order.Shipping(childComplexity), true
+
+	case "Order.status":
+		if e.complexity.Order.Status == nil {
+			break
+		}
+
+		return e.complexity.Order.Status(childComplexity), true
+
+	case "Order.timestamps":
+		if e.complexity.Order.Timestamps == nil {
+			break
+		}
+
+		return e.complexity.Order.Timestamps(childComplexity), true
+
+	case "Order.user":
+		if e.complexity.Order.User == nil {
+			break
+		}
+
+		return e.complexity.Order.User(childComplexity), true
+
+	case "OrderAdjustment.amount":
+		if e.complexity.OrderAdjustment.Amount == nil {
+			break
+		}
+
+		return e.complexity.OrderAdjustment.Amount(childComplexity), true
+
+	case "OrderAdjustment.createdAt":
+		if e.complexity.OrderAdjustment.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.OrderAdjustment.CreatedAt(childComplexity), true
+
+	case "OrderAdjustment.id":
+		if e.complexity.OrderAdjustment.ID == nil {
+			break
+		}
+
+		return e.complexity.OrderAdjustment.ID(childComplexity), true
+
+	case "OrderAdjustment.kind":
+		if e.complexity.OrderAdjustment.Kind == nil {
+			break
+		}
+
+		return e.complexity.OrderAdjustment.Kind(childComplexity), true
+
+	case "OrderAdjustment.orderId":
+		if e.complexity.OrderAdjustment.OrderID == nil {
+			break
+		}
+
+		return e.complexity.OrderAdjustment.OrderID(childComplexity), true
+
+	case "OrderAdjustment.reason":
+		if e.complexity.OrderAdjustment.Reason == nil {
+			break
+		}
+
+		return e.complexity.OrderAdjustment.Reason(childComplexity), true
+
+	case "OrderAdjustment.requestedBy":
+		if e.complexity.OrderAdjustment.RequestedBy == nil {
+			break
+		}
+
+		return e.complexity.OrderAdjustment.RequestedBy(childComplexity), true
+
+	case "OrderAdjustment.reviewedAt":
+		if e.complexity.OrderAdjustment.ReviewedAt == nil {
+			break
+		}
+
+		return e.complexity.OrderAdjustment.ReviewedAt(childComplexity), true
+
+	case "OrderAdjustment.reviewedBy":
+		if e.complexity.OrderAdjustment.ReviewedBy == nil {
+			break
+		}
+
+		return e.complexity.OrderAdjustment.ReviewedBy(childComplexity), true
+
+	case "OrderAdjustment.status":
+		if e.complexity.OrderAdjustment.Status == nil {
+			break
+		}
+
+		return e.complexity.OrderAdjustment.Status(childComplexity), true
+
+	case "OrderChange.changedAt":
+		if e.complexity.OrderChange.ChangedAt == nil {
+			break
+		}
+
+		return e.complexity.OrderChange.ChangedAt(childComplexity), true
+
+	case "OrderChange.changedColumns":
+		if e.complexity.OrderChange.ChangedColumns == nil {
+			break
+		}
+
+		return e.complexity.OrderChange.ChangedColumns(childComplexity), true
+
+	case "OrderChange.id":
+		if e.complexity.OrderChange.ID == nil {
+			break
+		}
+
+		return e.complexity.OrderChange.ID(childComplexity), true
+
+	case "OrderChange.operation":
+		if e.complexity.OrderChange.Operation == nil {
+			break
+		}
+
+		return e.complexity.OrderChange.Operation(childComplexity), true
+
+	case "OrderChange.orderExternalId":
+		if e.complexity.OrderChange.OrderExternalID == nil {
+			break
+		}
+
+		return e.complexity.OrderChange.OrderExternalID(childComplexity), true
+
+	case "OrderChange.orderId":
+		if e.complexity.OrderChange.OrderID == nil {
+			break
+		}
+
+		return e.complexity.OrderChange.OrderID(childComplexity), true
+
+	case "OrderConnection.items":
+		if e.complexity.OrderConnection.Items == nil {
+			break
+		}
+
+		return e.complexity.OrderConnection.Items(childComplexity), true
+
+	case "OrderConnection.pageInfo":
+		if e.complexity.OrderConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.OrderConnection.PageInfo(childComplexity), true
+
+	case "OrderItem.id":
+		if e.complexity.OrderItem.ID == nil {
+			break
+		}
+
+		return e.complexity.OrderItem.ID(childComplexity), true
+
+	case "OrderItem.pricing":
+		if e.complexity.OrderItem.Pricing == nil {
+			break
+		}
+
+		return e.complexity.OrderItem.Pricing(childComplexity), true
+
+	case "OrderItem.quantity":
+		if e.complexity.OrderItem.Quantity == nil {
+			break
+		}
+
+		return e.complexity.OrderItem.Quantity(childComplexity), true
+
+	case "OrderItem.quantityType":
+		if e.complexity.OrderItem.QuantityType == nil {
+			break
+		}
+
+		return e.complexity.OrderItem.QuantityType(childComplexity), true
+
+	case "OrderItem.variant":
+		if e.complexity.OrderItem.Variant == nil {
+			break
+		}
+
+		return e.complexity.OrderItem.Variant(childComplexity), true
+
+	case "OrderItemPricing.price":
+		if e.complexity.OrderItemPricing.Price == nil {
+			break
+		}
+
+		return e.complexity.OrderItemPricing.Price(childComplexity), true
+
+	case "OrderItemPricing.subtotal":
+		if e.complexity.OrderItemPricing.Subtotal == nil {
+			break
+		}
+
+		return e.complexity.OrderItemPricing.Subtotal(childComplexity), true
+
+	case "OrderMessage.attachments":
+		if e.complexity.OrderMessage.Attachments == nil {
+			break
+		}
+
+		return e.complexity.OrderMessage.Attachments(childComplexity), true
+
+	case "OrderMessage.body":
+		if e.complexity.OrderMessage.Body == nil {
+			break
+		}
+
+		return e.complexity.OrderMessage.Body(childComplexity), true
+
+	case "OrderMessage.createdAt":
+		if e.complexity.OrderMessage.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.OrderMessage.CreatedAt(childComplexity), true
+
+	case "OrderMessage.id":
+		if e.complexity.OrderMessage.ID == nil {
+			break
+		}
+
+		return e.complexity.OrderMessage.ID(childComplexity), true
+
+	case "OrderMessage.orderId":
+		if e.complexity.OrderMessage.OrderID == nil {
+			break
+		}
+
+		return e.complexity.OrderMessage.OrderID(childComplexity), true
+
+	case "OrderMessage.sender":
+		if e.complexity.OrderMessage.Sender == nil {
+			break
+		}
+
+		return e.complexity.OrderMessage.Sender(childComplexity), true
+
+	case "OrderMessage.senderId":
+		if e.complexity.OrderMessage.SenderID == nil {
+			break
+		}
+
+		return e.complexity.OrderMessage.SenderID(childComplexity), true
+
+	case "OrderMessageThread.hasMore":
+		if e.complexity.OrderMessageThread.HasMore == nil {
+			break
+		}
+
+		return e.complexity.OrderMessageThread.HasMore(childComplexity), true
+
+	case "OrderMessageThread.messages":
+		if e.complexity.OrderMessageThread.Messages == nil {
+			break
+		}
+
+		return e.complexity.OrderMessageThread.Messages(childComplexity), true
+
+	case "OrderMessageThread.orderId":
+		if e.complexity.OrderMessageThread.OrderID == nil {
+			break
+		}
+
+		return e.complexity.OrderMessageThread.OrderID(childComplexity), true
+
+	case "OrderMessageThread.unreadCount":
+		if e.complexity.OrderMessageThread.UnreadCount == nil {
+			break
+		}
+
+		return e.complexity.OrderMessageThread.UnreadCount(childComplexity), true
+
+	case "OrderMessageUnread.lastMessageAt":
+		if e.complexity.OrderMessageUnread.LastMessageAt == nil {
+			break
+		}
+
+		return e.complexity.OrderMessageUnread.LastMessageAt(childComplexity), true
+
+	case "OrderMessageUnread.orderExternalId":
+		if e.complexity.OrderMessageUnread.OrderExternalID == nil {
+			break
+		}
+
+		return e.complexity.OrderMessageUnread.OrderExternalID(childComplexity), true
+
+	case "OrderMessageUnread.orderId":
+		if e.complexity.OrderMessageUnread.OrderID == nil {
+			break
+		}
+
+		return e.complexity.OrderMessageUnread.OrderID(childComplexity), true
+
+	case "OrderMessageUnread.unreadCount":
+		if e.complexity.OrderMessageUnread.UnreadCount == nil {
+			break
+		}
+
+		return e.complexity.OrderMessageUnread.UnreadCount(childComplexity), true
+
+	case "OrderPickup.code":
+		if e.complexity.OrderPickup.Code == nil {
+			break
+		}
+
+		return e.complexity.OrderPickup.Code(childComplexity), true
+
+	case "OrderPickup.location":
+		if e.complexity.OrderPickup.Location == nil {
+			break
+		}
+
+		return e.complexity.OrderPickup.Location(childComplexity), true
+
+	case "OrderPickup.pickedUpAt":
+		if e.complexity.OrderPickup.PickedUpAt == nil {
+			break
+		}
+
+		return e.complexity.OrderPickup.PickedUpAt(childComplexity), true
+
+	case "OrderPickup.slotEnd":
+		if e.complexity.OrderPickup.SlotEnd == nil {
+			break
+		}
+
+		return e.complexity.OrderPickup.SlotEnd(childComplexity), true
+
+	case "OrderPickup.slotStart":
+		if e.complexity.OrderPickup.SlotStart == nil {
+			break
+		}
+
+		return e.complexity.OrderPickup.SlotStart(childComplexity), true
+
+	case "OrderPricing.adjustment":
+		if e.complexity.OrderPricing.Adjustment == nil {
+			break
+		}
+
+		return e.complexity.OrderPricing.Adjustment(childComplexity), true
+
+	case "OrderPricing.currency":
+		if e.complexity.OrderPricing.Currency == nil {
+			break
+		}
+
+		return e.complexity.OrderPricing.Currency(childComplexity), true
+
+	case "OrderPricing.discount":
+		if e.complexity.OrderPricing.Discount == nil {
+			break
+		}
+
+		return e.complexity.OrderPricing.Discount(childComplexity), true
+
+	case "OrderPricing.insuranceFee":
+		if e.complexity.OrderPricing.InsuranceFee == nil {
+			break
+		}
+
+		return e.complexity.OrderPricing.InsuranceFee(childComplexity), true
+
+	case "OrderPricing.shippingFee":
+		if e.complexity.OrderPricing.ShippingFee == nil {
+			break
+		}
+
+		return e.complexity.OrderPricing.ShippingFee(childComplexity), true
+
+	case "OrderPricing.subtotal":
+		if e.complexity.OrderPricing.Subtotal == nil {
+			break
+		}
+
+		return e.complexity.OrderPricing.Subtotal(childComplexity), true
+
+	case "OrderPricing.tax":
+		if e.complexity.OrderPricing.Tax == nil {
+			break
+		}
+
+		return e.complexity.OrderPricing.Tax(childComplexity), true
+
+	case "OrderPricing.total":
+		if e.complexity.OrderPricing.Total == nil {
+			break
+		}
+
+		return e.complexity.OrderPricing.Total(childComplexity), true
+
+	case "OrderPricing.walletAmount":
+		if e.complexity.OrderPricing.WalletAmount == nil {
+			break
+		}
+
+		return e.complexity.OrderPricing.WalletAmount(childComplexity), true
+
+	case "OrderReason.audience":
+		if e.complexity.OrderReason.Audience == nil {
+			break
+		}
+
+		return e.complexity.OrderReason.Audience(childComplexity), true
+
+	case "OrderReason.code":
+		if e.complexity.OrderReason.Code == nil {
+			break
+		}
+
+		return e.complexity.OrderReason.Code(childComplexity), true
+
+	case "OrderReason.id":
+		if e.complexity.OrderReason.ID == nil {
+			break
+		}
+
+		return e.complexity.OrderReason.ID(childComplexity), true
+
+	case "OrderReason.isActive":
+		if e.complexity.OrderReason.IsActive == nil {
+			break
+		}
+
+		return e.complexity.OrderReason.IsActive(childComplexity), true
+
+	case "OrderReason.kind":
+		if e.complexity.OrderReason.Kind == nil {
+			break
+		}
+
+		return e.complexity.OrderReason.Kind(childComplexity), true
+
+	case "OrderReason.label":
+		if e.complexity.OrderReason.Label == nil {
+			break
+		}
+
+		return e.complexity.OrderReason.Label(childComplexity), true
+
+	case "OrderReason.position":
+		if e.complexity.OrderReason.Position == nil {
+			break
+		}
+
+		return e.complexity.OrderReason.Position(childComplexity), true
+
+	case "OrderReason.updatedAt":
+		if e.complexity.OrderReason.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.OrderReason.UpdatedAt(childComplexity), true
+
+	case "OrderReasonStat.amount":
+		if e.complexity.OrderReasonStat.Amount == nil {
+			break
+		}
+
+		return e.complexity.OrderReasonStat.Amount(childComplexity), true
+
+	case "OrderReasonStat.orders":
+		if e.complexity.OrderReasonStat.Orders == nil {
+			break
+		}
+
+		return e.complexity.OrderReasonStat.Orders(childComplexity), true
+
+	case "OrderReasonStat.reason":
+		if e.complexity.OrderReasonStat.Reason == nil {
+			break
+		}
+
+		return e.complexity.OrderReasonStat.Reason(childComplexity), true
+
+	case "OrderReceipt.attempts":
+		if e.complexity.OrderReceipt.Attempts == nil {
+			break
+		}
+
+		return e.complexity.OrderReceipt.Attempts(childComplexity), true
+
+	case "OrderReceipt.lastError":
+		if e.complexity.OrderReceipt.LastError == nil {
+			break
+		}
+
+		return e.complexity.OrderReceipt.LastError(childComplexity), true
+
+	case "OrderReceipt.orderId":
+		if e.complexity.OrderReceipt.OrderID == nil {
+			break
+		}
+
+		return e.complexity.OrderReceipt.OrderID(childComplexity), true
+
+	case "OrderReceipt.queuedAt":
+		if e.complexity.OrderReceipt.QueuedAt == nil {
+			break
+		}
+
+		return e.complexity.OrderReceipt.QueuedAt(childComplexity), true
+
+	case "OrderReceipt.resentAt":
+		if e.complexity.OrderReceipt.ResentAt == nil {
+			break
+		}
+
+		return e.complexity.OrderReceipt.ResentAt(childComplexity), true
+
+	case "OrderReceipt.sentAt":
+		if e.complexity.OrderReceipt.SentAt == nil {
+			break
+		}
+
+		return e.complexity.OrderReceipt.SentAt(childComplexity), true
+
+	case "OrderReceipt.sentTo":
+		if e.complexity.OrderReceipt.SentTo == nil {
+			break
+		}
+
+		return e.complexity.OrderReceipt.SentTo(childComplexity), true
+
+	case "OrderShipping.address":
+		if e.complexity.OrderShipping.Address == nil {
+			break
+		}
+
+		return e.complexity.OrderShipping.Address(childComplexity), true
+
+	case "OrderShipping.method":
+		if e.complexity.OrderShipping.Method == nil {
+			break
+		}
+
+		return e.complexity.OrderShipping.Method(childComplexity), true
+
+	case "OrderSlaBreach.deadline":
+		if e.complexity.OrderSlaBreach.Deadline == nil {
+			break
+		}
+
+		return e.complexity.OrderSlaBreach.Deadline(childComplexity), true
+
+	case "OrderSlaBreach.detectedAt":
+		if e.complexity.OrderSlaBreach.DetectedAt == nil {
+			break
+		}
+
+		return e.complexity.OrderSlaBreach.DetectedAt(childComplexity), true
+
+	case "OrderSlaBreach.enteredAt":
+		if e.complexity.OrderSlaBreach.EnteredAt == nil {
+			break
+		}
+
+		return e.complexity.OrderSlaBreach.EnteredAt(childComplexity), true
+
+	case "OrderSlaBreach.id":
+		if e.complexity.OrderSlaBreach.ID == nil {
+			break
+		}
+
+		return e.complexity.OrderSlaBreach.ID(childComplexity), true
+
+	case "OrderSlaBreach.orderExternalId":
+		if e.complexity.OrderSlaBreach.OrderExternalID == nil {
+			break
+		}
+
+		return e.complexity.OrderSlaBreach.OrderExternalID(childComplexity), true
+
+	case "OrderSlaBreach.orderId":
+		if e.complexity.OrderSlaBreach.OrderID == nil {
+			break
+		}
+
+		return e.complexity.OrderSlaBreach.OrderID(childComplexity), true
+
+	case "OrderSlaBreach.orderStatus":
+		if e.complexity.OrderSlaBreach.OrderStatus == nil {
+			break
+		}
+
+		return e.complexity.OrderSlaBreach.OrderStatus(childComplexity), true
+
+	case "OrderSlaBreach.resolvedAt":
+		if e.complexity.OrderSlaBreach.ResolvedAt == nil {
+			break
+		}
+
+		return e.complexity.OrderSlaBreach.ResolvedAt(childComplexity), true
+
+	case "OrderSlaBreach.rule":
+		if e.complexity.OrderSlaBreach.Rule == nil {
+			break
+		}
+
+		return e.complexity.OrderSlaBreach.Rule(childComplexity), true
+
+	case "OrderTimestamps.createdAt":
+		if e.complexity.OrderTimestamps.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.OrderTimestamps.CreatedAt(childComplexity), true
+
+	case "OrderTimestamps.updatedAt":
+		if e.complexity.OrderTimestamps.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.OrderTimestamps.UpdatedAt(childComplexity), true
+
+	case "Package.createdAt":
+		if e.complexity.Package.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Package.CreatedAt(childComplexity), true
+
+	case "Package.id":
+		if e.complexity.Package.ID == nil {
+			break
+		}
+
+		return e.complexity.Package.ID(childComplexity), true
+
+	case "Package.imageUrl":
+		if e.complexity.Package.ImageURL == nil {
+			break
+		}
+
+		return e.complexity.Package.ImageURL(childComplexity), true
+
+	case "Package.isActive":
+		if e.complexity.Package.IsActive == nil {
+			break
+		}
+
+		return e.complexity.Package.IsActive(childComplexity), true
+
+	case "Package.items":
+		if e.complexity.Package.Items == nil {
+			break
+		}
+
+		return e.complexity.Package.Items(childComplexity), true
+
+	case "Package.name":
+		if e.complexity.Package.Name == nil {
+			break
+		}
+
+		return e.complexity.Package.Name(childComplexity), true
+
+	case "Package.type":
+		if e.complexity.Package.Type == nil {
+			break
+		}
+
+		return e.complexity.Package.Type(childComplexity), true
+
+	case "Package.updatedAt":
+		if e.complexity.Package.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.Package.UpdatedAt(childComplexity), true
+
+	case "Package.userId":
+		if e.complexity.Package.UserID == nil {
+			break
+		}
+
+		return e.complexity.Package.UserID(childComplexity), true
+
+	case "PackageConnection.items":
+		if e.complexity.PackageConnection.Items == nil {
+			break
+		}
+
+		return e.complexity.PackageConnection.Items(childComplexity), true
+
+	case "PackageConnection.pageInfo":
+		if e.complexity.PackageConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.PackageConnection.PageInfo(childComplexity), true
+
+	case "PackageItem.createdAt":
+		if e.complexity.PackageItem.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.PackageItem.CreatedAt(childComplexity), true
+
+	case "PackageItem.id":
+		if e.complexity.PackageItem.ID == nil {
+			break
+		}
+
+		return e.complexity.PackageItem.ID(childComplexity), true
+
+	case "PackageItem.imageUrl":
+		if e.complexity.PackageItem.ImageURL == nil {
+			break
+		}
+
+		return e.complexity.PackageItem.ImageURL(childComplexity), true
+
+	case "PackageItem.name":
+		if e.complexity.PackageItem.Name == nil {
+			break
+		}
+
+		return e.complexity.PackageItem.Name(childComplexity), true
+
+	case "PackageItem.packageId":
+		if e.complexity.PackageItem.PackageID == nil {
+			break
+		}
+
+		return e.complexity.PackageItem.PackageID(childComplexity), true
+
+	case "PackageItem.price":
+		if e.complexity.PackageItem.Price == nil {
+			break
+		}
+
+		return e.complexity.PackageItem.Price(childComplexity), true
+
+	case "PackageItem.quantity":
+		if e.complexity.PackageItem.Quantity == nil {
+			break
+		}
+
+		return e.complexity.PackageItem.Quantity(childComplexity), true
+
+	case "PackageItem.updatedAt":
+		if e.complexity.PackageItem.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.PackageItem.UpdatedAt(childComplexity), true
+
+	case "PackageItem.variantId":
+		if e.complexity.PackageItem.VariantID == nil {
+			break
+		}
+
+		return e.complexity.PackageItem.VariantID(childComplexity), true
+
+	case "PageInfo.endCursor":
+		if e.complexity.PageInfo.EndCursor == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.EndCursor(childComplexity), true
+
+	case "PageInfo.hasNextPage":
+		if e.complexity.PageInfo.HasNextPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasNextPage(childComplexity), true
+
+	case "PageInfo.hasPreviousPage":
+		if e.complexity.PageInfo.HasPreviousPage == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.HasPreviousPage(childComplexity), true
+
+	case "PageInfo.limit":
+		if e.complexity.PageInfo.Limit == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.Limit(childComplexity), true
+
+	case "PageInfo.page":
+		if e.complexity.PageInfo.Page == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.Page(childComplexity), true
+
+	case "PageInfo.totalItems":
+		if e.complexity.PageInfo.TotalItems == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.TotalItems(childComplexity), true
+
+	case "PageInfo.totalPages":
+		if e.complexity.PageInfo.TotalPages == nil {
+			break
+		}
+
+		return e.complexity.PageInfo.TotalPages(childComplexity), true
+
+	case "Payment.provider":
+		if e.complexity.Payment.Provider == nil {
+			break
+		}
+
+		return e.complexity.Payment.Provider(childComplexity), true
+
+	case "Payment.status":
+		if e.complexity.Payment.Status == nil {
+			break
+		}
+
+		return e.complexity.Payment.Status(childComplexity), true
+
+	case "Payment.url":
+		if e.complexity.Payment.URL == nil {
+			break
+		}
+
+		return e.complexity.Payment.URL(childComplexity), true
+
+	case "PaymentDetail.bank":
+		if e.complexity.PaymentDetail.Bank == nil {
+			break
+		}
+
+		return e.complexity.PaymentDetail.Bank(childComplexity), true
+
+	case "PaymentDetail.instructions":
+		if e.complexity.PaymentDetail.Instructions == nil {
+			break
+		}
+
+		return e.complexity.PaymentDetail.Instructions(childComplexity), true
+
+	case "PaymentDetail.invoiceURL":
+		if e.complexity.PaymentDetail.InvoiceURL == nil {
+			break
+		}
+
+		return e.complexity.PaymentDetail.InvoiceURL(childComplexity), true
+
+	case "PaymentDetail.method":
+		if e.complexity.PaymentDetail.Method == nil {
+			break
+		}
+
+		return e.complexity.PaymentDetail.Method(childComplexity), true
+
+	case "PaymentDetail.paymentCode":
+		if e.complexity.PaymentDetail.PaymentCode == nil {
+			break
+		}
+
+		return e.complexity.PaymentDetail.PaymentCode(childComplexity), true
+
+	case "PaymentDetail.referenceId":
+		if e.complexity.PaymentDetail.ReferenceID == nil {
+			break
+		}
+
+		return e.complexity.PaymentDetail.ReferenceID(childComplexity), true
+
+	case "PaymentDispute.amount":
+		if e.complexity.PaymentDispute.Amount == nil {
+			break
+		}
+
+		return e.complexity.PaymentDispute.Amount(childComplexity), true
+
+	case "PaymentDispute.currency":
+		if e.complexity.PaymentDispute.Currency == nil {
+			break
+		}
+
+		return e.complexity.PaymentDispute.Currency(childComplexity), true
+
+	case "PaymentDispute.id":
+		if e.complexity.PaymentDispute.ID == nil {
+			break
+		}
+
+		return e.complexity.PaymentDispute.ID(childComplexity), true
+
+	case "PaymentDispute.openedAt":
+		if e.complexity.PaymentDispute.OpenedAt == nil {
+			break
+		}
+
+		return e.complexity.PaymentDispute.OpenedAt(childComplexity), true
+
+	case "PaymentDispute.orderExternalId":
+		if e.complexity.PaymentDispute.OrderExternalID == nil {
+			break
+		}
+
+		return e.complexity.PaymentDispute.OrderExternalID(childComplexity), true
+
+	case "PaymentDispute.orderId":
+		if e.complexity.PaymentDispute.OrderID == nil {
+			break
+		}
+
+		return e.complexity.PaymentDispute.OrderID(childComplexity), true
+
+	case "PaymentDispute.paymentReference":
+		if e.complexity.PaymentDispute.PaymentReference == nil {
+			break
+		}
+
+		return e.complexity.PaymentDispute.PaymentReference(childComplexity), true
+
+	case "PaymentDispute.payoutHeld":
+		if e.complexity.PaymentDispute.PayoutHeld == nil {
+			break
+		}
+
+		return e.complexity.PaymentDispute.PayoutHeld(childComplexity), true
+
+	case "PaymentDispute.pointsClawedBack":
+		if e.complexity.PaymentDispute.PointsClawedBack == nil {
+			break
+		}
+
+		return e.complexity.PaymentDispute.PointsClawedBack(childComplexity), true
+
+	case "PaymentDispute.providerDisputeId":
+		if e.complexity.PaymentDispute.ProviderDisputeID == nil {
+			break
+		}
+
+		return e.complexity.PaymentDispute.ProviderDisputeID(childComplexity), true
+
+	case "PaymentDispute.reason":
+		if e.complexity.PaymentDispute.Reason == nil {
+			break
+		}
+
+		return e.complexity.PaymentDispute.Reason(childComplexity), true
+
+	case "PaymentDispute.resolutionNote":
+		if e.complexity.PaymentDispute.ResolutionNote == nil {
+			break
+		}
+
+		return e.complexity.PaymentDispute.ResolutionNote(childComplexity), true
+
+	case "PaymentDispute.resolvedAt":
+		if e.complexity.PaymentDispute.ResolvedAt == nil {
+			break
+		}
+
+		return e.complexity.PaymentDispute.ResolvedAt(childComplexity), true
+
+	case "PaymentDispute.status":
+		if e.complexity.PaymentDispute.Status == nil {
+			break
+		}
+
+		return e.complexity.PaymentDispute.Status(childComplexity), true
+
+	case "PaymentOrderInfoResponse.currency":
+		if e.complexity.PaymentOrderInfoResponse.Currency == nil {
+			break
+		}
+
+		return e.complexity.PaymentOrderInfoResponse.Currency(childComplexity), true
+
+	case "PaymentOrderInfoResponse.expiresAt":
+		if e.complexity.PaymentOrderInfoResponse.ExpiresAt == nil {
+			break
+		}
+
+		return e.complexity.PaymentOrderInfoResponse.ExpiresAt(childComplexity), true
+
+	case "PaymentOrderInfoResponse.orderExternalID":
+		if e.complexity.PaymentOrderInfoResponse.OrderExternalID == nil {
+			break
+		}
+
+		return e.complexity.PaymentOrderInfoResponse.OrderExternalID(childComplexity), true
+
+	case "PaymentOrderInfoResponse.payment":
+		if e.complexity.PaymentOrderInfoResponse.Payment == nil {
+			break
+		}
+
+		return e.complexity.PaymentOrderInfoResponse.Payment(childComplexity), true
+
+	case "PaymentOrderInfoResponse.secondsRemaining":
+		if e.complexity.PaymentOrderInfoResponse.SecondsRemaining == nil {
+			break
+		}
+
+		return e.complexity.PaymentOrderInfoResponse.SecondsRemaining(childComplexity), true
+
+	case "PaymentOrderInfoResponse.shippingAddress":
+		if e.complexity.PaymentOrderInfoResponse.ShippingAddress == nil {
+			break
+		}
+
+		return e.complexity.PaymentOrderInfoResponse.ShippingAddress(childComplexity), true
+
+	case "PaymentOrderInfoResponse.status":
+		if e.complexity.PaymentOrderInfoResponse.Status == nil {
+			break
+		}
+
+		return e.complexity.PaymentOrderInfoResponse.Status(childComplexity), true
+
+	case "PaymentOrderInfoResponse.timeline":
+		if e.complexity.PaymentOrderInfoResponse.Timeline == nil {
+			break
+		}
+
+		return e.complexity.PaymentOrderInfoResponse.Timeline(childComplexity), true
+
+	case "PaymentOrderInfoResponse.totalAmount":
+		if e.complexity.PaymentOrderInfoResponse.TotalAmount == nil {
+			break
+		}
+
+		return e.complexity.PaymentOrderInfoResponse.TotalAmount(childComplexity), true
+
+	case "PaymentTimelineEntry.amount":
+		if e.complexity.PaymentTimelineEntry.Amount == nil {
+			break
+		}
+
+		return e.complexity.PaymentTimelineEntry.Amount(childComplexity), true
+
+	case "PaymentTimelineEntry.event":
+		if e.complexity.PaymentTimelineEntry.Event == nil {
+			break
+		}
+
+		return e.complexity.PaymentTimelineEntry.Event(childComplexity), true
+
+	case "PaymentTimelineEntry.occurredAt":
+		if e.complexity.PaymentTimelineEntry.OccurredAt == nil {
+			break
+		}
+
+		return e.complexity.PaymentTimelineEntry.OccurredAt(childComplexity), true
+
+	case "PickupLocation.address":
+		if e.complexity.PickupLocation.Address == nil {
+			break
+		}
+
+		return e.complexity.PickupLocation.Address(childComplexity), true
+
+	case "PickupLocation.city":
+		if e.complexity.PickupLocation.City == nil {
+			break
+		}
+
+		return e.complexity.PickupLocation.City(childComplexity), true
+
+	case "PickupLocation.closesAt":
+		if e.complexity.PickupLocation.ClosesAt == nil {
+			break
+		}
+
+		return e.complexity.PickupLocation.ClosesAt(childComplexity), true
+
+	case "PickupLocation.id":
+		if e.complexity.PickupLocation.ID == nil {
+			break
+		}
+
+		return e.complexity.PickupLocation.ID(childComplexity), true
+
+	case "PickupLocation.isActive":
+		if e.complexity.PickupLocation.IsActive == nil {
+			break
+		}
+
+		return e.complexity.PickupLocation.IsActive(childComplexity), true
+
+	case "PickupLocation.name":
+		if e.complexity.PickupLocation.Name == nil {
+			break
+		}
+
+		return e.complexity.PickupLocation.Name(childComplexity), true
+
+	case "PickupLocation.opensAt":
+		if e.complexity.PickupLocation.OpensAt == nil {
+			break
+		}
+
+		return e.complexity.PickupLocation.OpensAt(childComplexity), true
+
+	case "PickupLocation.slotMinutes":
+		if e.complexity.PickupLocation.SlotMinutes == nil {
+			break
+		}
+
+		return e.complexity.PickupLocation.SlotMinutes(childComplexity), true
+
+	case "PickupLocation.updatedAt":
+		if e.complexity.PickupLocation.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.PickupLocation.UpdatedAt(childComplexity), true
+
+	case "PickupSlot.end":
+		if e.complexity.PickupSlot.End == nil {
+			break
+		}
+
+		return e.complexity.PickupSlot.End(childComplexity), true
+
+	case "PickupSlot.start":
+		if e.complexity.PickupSlot.Start == nil {
+			break
+		}
+
+		return e.complexity.PickupSlot.Start(childComplexity), true
+
+	case "Policy.body":
+		if e.complexity.Policy.Body == nil {
+			break
+		}
+
+		return e.complexity.Policy.Body(childComplexity), true
+
+	case "Policy.id":
+		if e.complexity.Policy.ID == nil {
+			break
+		}
+
+		return e.complexity.Policy.ID(childComplexity), true
+
+	case "Policy.kind":
+		if e.complexity.Policy.Kind == nil {
+			break
+		}
+
+		return e.complexity.Policy.Kind(childComplexity), true
+
+	case "Policy.publishedAt":
+		if e.complexity.Policy.PublishedAt == nil {
+			break
+		}
+
+		return e.complexity.Policy.PublishedAt(childComplexity), true
+
+	case "Policy.title":
+		if e.complexity.Policy.Title == nil {
+			break
+		}
+
+		return e.complexity.Policy.Title(childComplexity), true
+
+	case "Policy.version":
+		if e.complexity.Policy.Version == nil {
+			break
+		}
+
+		return e.complexity.Policy.Version(childComplexity), true
+
+	case "Product.categoryID":
+		if e.complexity.Product.CategoryID == nil {
+			break
+		}
+
+		return e.complexity.Product.CategoryID(childComplexity), true
+
+	case "Product.categoryName":
+		if e.complexity.Product.CategoryName == nil {
+			break
+		}
+
+		return e.complexity.Product.CategoryName(childComplexity), true
+
+	case "Product.createdAt":
+		if e.complexity.Product.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Product.CreatedAt(childComplexity), true
+
+	case "Product.description":
+		if e.complexity.Product.Description == nil {
+			break
+		}
+
+		return e.complexity.Product.Description(childComplexity), true
+
+	case "Product.id":
+		if e.complexity.Product.ID == nil {
+			break
+		}
+
+		return e.complexity.Product.ID(childComplexity), true
+
+	case "Product.imageUrl":
+		if e.complexity.Product.ImageURL == nil {
+			break
+		}
+
+		return e.complexity.Product.ImageURL(childComplexity), true
+
+	case "Product.name":
+		if e.complexity.Product.Name == nil {
+			break
+		}
+
+		return e.complexity.Product.Name(childComplexity), true
+
+	case "Product.sellerId":
+		if e.complexity.Product.SellerID == nil {
+			break
+		}
+
+		return e.complexity.Product.SellerID(childComplexity), true
+
+	case "Product.sellerName":
+		if e.complexity.Product.SellerName == nil {
+			break
+		}
+
+		return e.complexity.Product.SellerName(childComplexity), true
+
+	case "Product.shippingOriginId":
+		if e.complexity.Product.ShippingOriginID == nil {
+			break
+		}
+
+		return e.complexity.Product.ShippingOriginID(childComplexity), true
+
+	case "Product.slug":
+		if e.complexity.Product.Slug == nil {
+			break
+		}
+
+		return e.complexity.Product.Slug(childComplexity), true
+
+	case "Product.status":
+		if e.complexity.Product.Status == nil {
+			break
+		}
+
+		return e.complexity.Product.Status(childComplexity), true
+
+	case "Product.storeSlug":
+		if e.complexity.Product.StoreSlug == nil {
+			break
+		}
+
+		return e.complexity.Product.StoreSlug(childComplexity), true
+
+	case "Product.subcategoryID":
+		if e.complexity.Product.SubcategoryID == nil {
+			break
+		}
+
+		return e.complexity.Product.SubcategoryID(childComplexity), true
+
+	case "Product.subcategoryName":
+		if e.complexity.Product.SubcategoryName == nil {
+			break
+		}
+
+		return e.complexity.Product.SubcategoryName(childComplexity), true
+
+	case "Product.unavailableUntil":
+		if e.complexity.Product.UnavailableUntil == nil {
+			break
+		}
+
+		return e.complexity.Product.UnavailableUntil(childComplexity), true
+
+	case "Product.updatedAt":
+		if e.complexity.Product.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.Product.UpdatedAt(childComplexity), true
+
+	case "Product.variants":
+		if e.complexity.Product.Variants == nil {
+			break
+		}
+
+		return e.complexity.Product.Variants(childComplexity), true
+
+	case "ProductByCategory.categoryName":
+		if e.complexity.ProductByCategory.CategoryName == nil {
+			break
+		}
+
+		return e.complexity.ProductByCategory.CategoryName(childComplexity), true
+
+	case "ProductByCategory.categorySlug":
+		if e.complexity.ProductByCategory.CategorySlug == nil {
+			break
+		}
+
+		return e.complexity.ProductByCategory.CategorySlug(childComplexity), true
+
+	case "ProductByCategory.products":
+		if e.complexity.ProductByCategory.Products == nil {
+			break
+		}
+
+		return e.complexity.ProductByCategory.Products(childComplexity), true
+
+	case "ProductByCategory.totalProducts":
+		if e.complexity.ProductByCategory.TotalProducts == nil {
+			break
+		}
+
+		return e.complexity.ProductByCategory.TotalProducts(childComplexity), true
+
+	case "ProductCart.categoryID":
+		if e.complexity.ProductCart.CategoryID == nil {
+			break
+		}
+
+		return e.complexity.ProductCart.CategoryID(childComplexity), true
+
+	case "ProductCart.categoryName":
+		if e.complexity.ProductCart.CategoryName == nil {
+			break
+		}
+
+		return e.complexity.ProductCart.CategoryName(childComplexity), true
+
+	case "ProductCart.createdAt":
+		if e.complexity.ProductCart.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.ProductCart.CreatedAt(childComplexity), true
+
+	case "ProductCart.description":
+		if e.complexity.ProductCart.Description == nil {
+			break
+		}
+
+		return e.complexity.ProductCart.Description(childComplexity), true
+
+	case "ProductCart.id":
+		if e.complexity.ProductCart.ID == nil {
+			break
+		}
+
+		return e.complexity.ProductCart.ID(childComplexity), true
+
+	case "ProductCart.imageUrl":
+		if e.complexity.ProductCart.ImageURL == nil {
+			break
+		}
+
+		return e.complexity.ProductCart.ImageURL(childComplexity), true
+
+	case "ProductCart.name":
+		if e.complexity.ProductCart.Name == nil {
+			break
+		}
+
+		return e.complexity.ProductCart.Name(childComplexity), true
+
+	case "ProductCart.sellerId":
+		if e.complexity.ProductCart.SellerID == nil {
+			break
+		}
+
+		return e.complexity.ProductCart.SellerID(childComplexity), true
+
+	case "ProductCart.sellerName":
+		if e.complexity.ProductCart.SellerName == nil {
+			break
+		}
+
+		return e.complexity.ProductCart.SellerName(childComplexity), true
+
+	case "ProductCart.slug":
+		if e.complexity.ProductCart.Slug == nil {
+			break
+		}
+
+		return e.complexity.ProductCart.Slug(childComplexity), true
+
+	case "ProductCart.status":
+		if e.complexity.ProductCart.Status == nil {
+			break
+		}
+
+		return e.complexity.ProductCart.Status(childComplexity), true
+
+	case "ProductCart.subcategoryID":
+		if e.complexity.ProductCart.SubcategoryID == nil {
+			break
+		}
+
+		return e.complexity.ProductCart.SubcategoryID(childComplexity), true
+
+	case "ProductCart.subcategoryName":
+		if e.complexity.ProductCart.SubcategoryName == nil {
+			break
+		}
+
+		return e.complexity.ProductCart.SubcategoryName(childComplexity), true
+
+	case "ProductCart.updatedAt":
+		if e.complexity.ProductCart.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.ProductCart.UpdatedAt(childComplexity), true
+
+	case "ProductCart.variant":
+		if e.complexity.ProductCart.Variant == nil {
+			break
+		}
+
+		return e.complexity.ProductCart.Variant(childComplexity), true
+
+	case "ProductChange.changedAt":
+		if e.complexity.ProductChange.ChangedAt == nil {
+			break
+		}
+
+		return e.complexity.ProductChange.ChangedAt(childComplexity), true
+
+	case "ProductChange.changedColumns":
+		if e.complexity.ProductChange.ChangedColumns == nil {
+			break
+		}
+
+		return e.complexity.ProductChange.ChangedColumns(childComplexity), true
+
+	case "ProductChange.id":
+		if e.complexity.ProductChange.ID == nil {
+			break
+		}
+
+		return e.complexity.ProductChange.ID(childComplexity), true
+
+	case "ProductChange.operation":
+		if e.complexity.ProductChange.Operation == nil {
+			break
+		}
+
+		return e.complexity.ProductChange.Operation(childComplexity), true
+
+	case "ProductChange.productId":
+		if e.complexity.ProductChange.ProductID == nil {
+			break
+		}
+
+		return e.complexity.ProductChange.ProductID(childComplexity), true
+
+	case "ProductChange.variantId":
+		if e.complexity.ProductChange.VariantID == nil {
+			break
+		}
+
+		return e.complexity.ProductChange.VariantID(childComplexity), true
+
+	case "ProductComparison.attributes":
+		if e.complexity.ProductComparison.Attributes == nil {
+			break
+		}
+
+		return e.complexity.ProductComparison.Attributes(childComplexity), true
+
+	case "ProductComparison.products":
+		if e.complexity.ProductComparison.Products == nil {
+			break
+		}
+
+		return e.complexity.ProductComparison.Products(childComplexity), true
+
+	case "ProductComparisonAttribute.differs":
+		if e.complexity.ProductComparisonAttribute.Differs == nil {
+			break
+		}
+
+		return e.complexity.ProductComparisonAttribute.Differs(childComplexity), true
+
+	case "ProductComparisonAttribute.key":
+		if e.complexity.ProductComparisonAttribute.Key == nil {
+			break
+		}
+
+		return e.complexity.ProductComparisonAttribute.Key(childComplexity), true
+
+	case "ProductComparisonAttribute.label":
+		if e.complexity.ProductComparisonAttribute.Label == nil {
+			break
+		}
+
+		return e.complexity.ProductComparisonAttribute.Label(childComplexity), true
+
+	case "ProductComparisonAttribute.values":
+		if e.complexity.ProductComparisonAttribute.Values == nil {
+			break
+		}
+
+		return e.complexity.ProductComparisonAttribute.Values(childComplexity), true
+
+	case "ProductConnection.items":
+		if e.complexity.ProductConnection.Items == nil {
+			break
+		}
+
+		return e.complexity.ProductConnection.Items(childComplexity), true
+
+	case "ProductConnection.pageInfo":
+		if e.complexity.ProductConnection.PageInfo == nil {
+			break
+		}
+
+		return e.complexity.ProductConnection.PageInfo(childComplexity), true
+
+	case "Profile.avatarUrl":
+		if e.complexity.Profile.AvatarURL == nil {
+			break
+		}
+
+		return e.complexity.Profile.AvatarURL(childComplexity), true
+
+	case "Profile.bio":
+		if e.complexity.Profile.Bio == nil {
+			break
+		}
+
+		return e.complexity.Profile.Bio(childComplexity), true
+
+	case "Profile.createdAt":
+		if e.complexity.Profile.CreatedAt == nil {
+			break
+		}
+
+		return e.complexity.Profile.CreatedAt(childComplexity), true
+
+	case "Profile.dateOfBirth":
+		if e.complexity.Profile.DateOfBirth == nil {
+			break
+		}
+
+		return e.complexity.Profile.DateOfBirth(childComplexity), true
+
+	case "Profile.displayName":
+		if e.complexity.Profile.DisplayName == nil {
+			break
+		}
+
+		return e.complexity.Profile.DisplayName(childComplexity), true
+
+	case "Profile.email":
+		if e.complexity.Profile.Email == nil {
+			break
+		}
+
+		return e.complexity.Profile.Email(childComplexity), true
+
+	case "Profile.fullName":
+		if e.complexity.Profile.FullName == nil {
+			break
+		}
+
+		return e.complexity.Profile.FullName(childComplexity), true
+
+	case "Profile.id":
+		if e.complexity.Profile.ID == nil {
+			break
+		}
+
+		return e.complexity.Profile.ID(childComplexity), true
+
+	case "Profile.phone":
+		if e.complexity.Profile.Phone == nil {
+			break
+		}
+
+		return e.complexity.Profile.Phone(childComplexity), true
+
+	case "Profile.phoneVerified":
+		if e.complexity.Profile.PhoneVerified == nil {
+			break
+		}
+
+		return e.complexity.Profile.PhoneVerified(childComplexity), true
+
+	case "Profile.updatedAt":
+		if e.complexity.Profile.UpdatedAt == nil {
+			break
+		}
+
+		return e.complexity.Profile.UpdatedAt(childComplexity), true
+
+	case "Profile.userId":
+		if e.complexity.Profile.UserID == nil {
+			break
+		}
+
+		return e.complexity.Profile.UserID(childComplexity), true
+
+	case "QuantityTypeInfo.basePerUnit":
+		if e.complexity.QuantityTypeInfo.BasePerUnit == nil {
+			break
+		}
+
+		return e.complexity.QuantityTypeInfo.BasePerUnit(childComplexity), true
+
+	case "QuantityTypeInfo.baseUnit":
+		if e.complexity.QuantityTypeInfo.BaseUnit == nil {
+			break
+		}
+
+		return e.complexity.QuantityTypeInfo.BaseUnit(childComplexity), true
+
+	case "QuantityTypeInfo.code":
+		if e.complexity.QuantityTypeInfo.Code == nil {
+			break
+		}
+
+		return e.complexity.QuantityTypeInfo.Code(childComplexity), true
+
+	case "QuantityTypeInfo.label":
+		if e.complexity.QuantityTypeInfo.Label == nil {
+			break
+		}
+
+		return e.complexity.QuantityTypeInfo.Label(childComplexity), true
+
+	case "QuantityTypeInfo.unitPricePer":
+		if e.complexity.QuantityTypeInfo.UnitPricePer == nil {
+			break
+		}
+
+		return e.complexity.QuantityTypeInfo.UnitPricePer(childComplexity), true
+
+	case "Query.apiKeys":
+		if e.complexity.Query.APIKeys == nil {
+			break
+		}
+
+		args, err := ec.field_Query_apiKeys_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.APIKeys(childComplexity, args["includeRevoked"].(*bool)), true
+
+	case "Query.accountRestrictions":
+		if e.complexity.Query.AccountRestrictions == nil {
+			break
+		}
+
+		args, err := ec.field_Query_accountRestrictions_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AccountRestrictions(childComplexity, args["limit"].(*int32)), true
+
+	case "Query.address":
+		if e.complexity.Query.Address == nil {
+			break
+		}
+
+		args, err := ec.field_Query_address_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Address(childComplexity, args["addressId"].(string)), true
+
+	case "Query.addresses":
+		if e.complexity.Query.Addresses == nil {
+			break
+		}
+
+		return e.complexity.Query.Addresses(childComplexity), true
+
+	case "Query.adminCheckoutRules":
+		if e.complexity.Query.AdminCheckoutRules == nil {
+			break
+		}
+
+		return e.complexity.Query.AdminCheckoutRules(childComplexity), true
+
+	case "Query.adminDashboard":
+		if e.complexity.Query.AdminDashboard == nil {
+			break
+		}
+
+		return e.complexity.Query.AdminDashboard(childComplexity), true
+
+	case "Query.adminDeliverySlots":
+		if e.complexity.Query.AdminDeliverySlots == nil {
+			break
+		}
+
+		args, err := ec.field_Query_adminDeliverySlots_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AdminDeliverySlots(childComplexity, args["region"].(*string)), true
+
+	case "Query.adminOrderReasons":
+		if e.complexity.Query.AdminOrderReasons == nil {
+			break
+		}
+
+		args, err := ec.field_Query_adminOrderReasons_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.AdminOrderReasons(childComplexity, args["kind"].(*model.OrderReasonKind)), true
+
+	case "Query.adminPickupLocations":
+		if e.complexity.Query.AdminPickupLocations == nil {
+			break
+		}
+
+		return e.complexity.Query.AdminPickupLocations(childComplexity), true
+
+	case "Query.category":
+		if e.complexity.Query.Category == nil {
+			break
+		}
+
+		args, err := ec.field_Query_category_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Category(childComplexity, args["filter"].(*string), args["limit"].(*int32), args["page"].(*int32), args["after"].(*string)), true
+
+	case "Query.checkoutRules":
+		if e.complexity.Query.CheckoutRules == nil {
+			break
+		}
+
+		return e.complexity.Query.CheckoutRules(childComplexity), true
+
+	case "Query.checkoutSession":
+		if e.complexity.Query.CheckoutSession == nil {
+			break
+		}
+
+		args, err := ec.field_Query_checkoutSession_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CheckoutSession(childComplexity, args["externalId"].(string)), true
+
+	case "Query.checkoutSessionEvents":
+		if e.complexity.Query.CheckoutSessionEvents == nil {
+			break
+		}
+
+		args, err := ec.field_Query_checkoutSessionEvents_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CheckoutSessionEvents(childComplexity, args["externalId"].(string)), true
+
+	case "Query.clientContext":
+		if e.complexity.Query.ClientContext == nil {
+			break
+		}
+
+		return e.complexity.Query.ClientContext(childComplexity), true
+
+	case "Query.commissionRates":
+		if e.complexity.Query.CommissionRates == nil {
+			break
+		}
+
+		args, err := ec.field_Query_commissionRates_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CommissionRates(childComplexity, args["categoryId"].(*string)), true
+
+	case "Query.compareProducts":
+		if e.complexity.Query.CompareProducts == nil {
+			break
+		}
+
+		args, err := ec.field_Query_compareProducts_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CompareProducts(childComplexity, args["ids"].([]string)), true
+
+	case "Query.courierManifest":
+		if e.complexity.Query.CourierManifest == nil {
+			break
+		}
+
+		args, err := ec.field_Query_courierManifest_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CourierManifest(childComplexity, args["date"].(*string)), true
+
+	case "Query.courierWebhookDeadLetters":
+		if e.complexity.Query.CourierWebhookDeadLetters == nil {
+			break
+		}
+
+		args, err := ec.field_Query_courierWebhookDeadLetters_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.CourierWebhookDeadLetters(childComplexity, args["limit"].(*int32)), true
+
+	case "Query.currentPolicies":
+		if e.complexity.Query.CurrentPolicies == nil {
+			break
+		}
+
+		return e.complexity.Query.CurrentPolicies(childComplexity), true
+
+	case "Query.databaseHealth":
+		if e.complexity.Query.DatabaseHealth == nil {
+			break
+		}
+
+		return e.complexity.Query.DatabaseHealth(childComplexity), true
+
+	case "Query.deliverySlots":
+		if e.complexity.Query.DeliverySlots == nil {
+			break
+		}
+
+		args, err := ec.field_Query_deliverySlots_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.DeliverySlots(childComplexity, args["externalId"].(string)), true
+
+	case "Query.displayNameBlocklist":
+		if e.complexity.Query.DisplayNameBlocklist == nil {
+			break
+		}
+
+		return e.complexity.Query.DisplayNameBlocklist(childComplexity), true
+
+	case "Query.effectiveCommissionRate":
+		if e.complexity.Query.EffectiveCommissionRate == nil {
+			break
+		}
+
+		args, err := ec.field_Query_effectiveCommissionRate_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.EffectiveCommissionRate(childComplexity, args["categoryId"].(string), args["at"].(*time.Time)), true
+
+	case "Query.experimentAssignments":
+		if e.complexity.Query.ExperimentAssignments == nil {
+			break
+		}
+
+		return e.complexity.Query.ExperimentAssignments(childComplexity), true
+
+	case "Query.experimentResults":
+		if e.complexity.Query.ExperimentResults == nil {
+			break
+		}
+
+		args, err := ec.field_Query_experimentResults_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ExperimentResults(childComplexity, args["key"].(string)), true
+
+	case "Query.experiments":
+		if e.complexity.Query.Experiments == nil {
+			break
+		}
+
+		return e.complexity.Query.Experiments(childComplexity), true
+
+	case "Query.fulfillmentQueue":
+		if e.complexity.Query.FulfillmentQueue == nil {
+			break
+		}
+
+		args, err := ec.field_Query_fulfillmentQueue_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.FulfillmentQueue(childComplexity, args["mineOnly"].(*bool), args["limit"].(*int32)), true
+
+	case "Query.journalExport":
+		if e.complexity.Query.JournalExport == nil {
+			break
+		}
+
+		args, err := ec.field_Query_journalExport_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.JournalExport(childComplexity, args["from"].(string), args["to"].(string)), true
+
+	case "Query.logSettings":
+		if e.complexity.Query.LogSettings == nil {
+			break
+		}
+
+		return e.complexity.Query.LogSettings(childComplexity), true
+
+	case "Query.loyaltyRules":
+		if e.complexity.Query.LoyaltyRules == nil {
+			break
+		}
+
+		return e.complexity.Query.LoyaltyRules(childComplexity), true
+
+	case "Query.maintenanceMode":
+		if e.complexity.Query.MaintenanceMode == nil {
+			break
+		}
+
+		return e.complexity.Query.MaintenanceMode(childComplexity), true
+
+	case "Query.moderationAudit":
+		if e.complexity.Query.ModerationAudit == nil {
+			break
+		}
+
+		args, err := ec.field_Query_moderationAudit_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ModerationAudit(childComplexity, args["itemId"].(*string), args["limit"].(*int32)), true
+
+	case "Query.moderationKeywords":
+		if e.complexity.Query.ModerationKeywords == nil {
+			break
+		}
+
+		return e.complexity.Query.ModerationKeywords(childComplexity), true
+
+	case "Query.moderationQueue":
+		if e.complexity.Query.ModerationQueue == nil {
+			break
+		}
+
+		args, err := ec.field_Query_moderationQueue_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ModerationQueue(childComplexity, args["status"].(*model.ModerationStatus), args["limit"].(*int32)), true
+
+	case "Query.myAccountRestriction":
+		if e.complexity.Query.MyAccountRestriction == nil {
+			break
+		}
+
+		return e.complexity.Query.MyAccountRestriction(childComplexity), true
+
+	case "Query.myActiveCheckoutSession":
+		if e.complexity.Query.MyActiveCheckoutSession == nil {
+			break
+		}
+
+		return e.complexity.Query.MyActiveCheckoutSession(childComplexity), true
+
+	case "Query.myBackInStockSubscriptions":
+		if e.complexity.Query.MyBackInStockSubscriptions == nil {
+			break
+		}
+
+		return e.complexity.Query.MyBackInStockSubscriptions(childComplexity), true
+
+	case "Query.myCart":
+		if e.complexity.Query.MyCart == nil {
+			break
+		}
+
+		args, err := ec.field_Query_myCart_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.MyCart(childComplexity, args["filter"].(*model.CartFilterInput), args["sort"].(*model.CartSortInput), args["limit"].(*int32), args["page"].(*int32), args["after"].(*string)), true
+
+	case "Query.myCartCount":
+		if e.complexity.Query.MyCartCount == nil {
+			break
+		}
+
+		return e.complexity.Query.MyCartCount(childComplexity), true
+
+	case "Query.myCatalogExports":
+		if e.complexity.Query.MyCatalogExports == nil {
+			break
+		}
+
+		return e.complexity.Query.MyCatalogExports(childComplexity), true
+
+	case "Query.myLoyaltyPoints":
+		if e.complexity.Query.MyLoyaltyPoints == nil {
+			break
+		}
+
+		return e.complexity.Query.MyLoyaltyPoints(childComplexity), true
+
+	case "Query.myMarketingConsents":
+		if e.complexity.Query.MyMarketingConsents == nil {
+			break
+		}
+
+		return e.complexity.Query.MyMarketingConsents(childComplexity), true
+
+	case "Query.myPreferences":
+		if e.complexity.Query.MyPreferences == nil {
+			break
+		}
+
+		return e.complexity.Query.MyPreferences(childComplexity), true
+
+	case "Query.myProfile":
+		if e.complexity.Query.MyProfile == nil {
+			break
+		}
+
+		return e.complexity.Query.MyProfile(childComplexity), true
+
+	case "Query.myReferral":
+		if e.complexity.Query.MyReferral == nil {
+			break
+		}
+
+		return e.complexity.Query.MyReferral(childComplexity), true
+
+	case "Query.myScheduledPriceChanges":
+		if e.complexity.Query.MyScheduledPriceChanges == nil {
+			break
+		}
+
+		args, err := ec.field_Query_myScheduledPriceChanges_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.MyScheduledPriceChanges(childComplexity, args["variantId"].(*string)), true
+
+	case "Query.myStore":
+		if e.complexity.Query.MyStore == nil {
+			break
+		}
+
+		return e.complexity.Query.MyStore(childComplexity), true
+
+	case "Query.myStoreShippingOrigins":
+		if e.complexity.Query.MyStoreShippingOrigins == nil {
+			break
+		}
+
+		return e.complexity.Query.MyStoreShippingOrigins(childComplexity), true
+
+	case "Query.myStoreVacations":
+		if e.complexity.Query.MyStoreVacations == nil {
+			break
+		}
+
+		return e.complexity.Query.MyStoreVacations(childComplexity), true
+
+	case "Query.myWallet":
+		if e.complexity.Query.MyWallet == nil {
+			break
+		}
+
+		return e.complexity.Query.MyWallet(childComplexity), true
+
+	case "Query.myWishlist":
+		if e.complexity.Query.MyWishlist == nil {
+			break
+		}
+
+		args, err := ec.field_Query_myWishlist_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.MyWishlist(childComplexity, args["pagination"].(*model.PaginationInput)), true
+
+	case "Query.myWishlistAlerts":
+		if e.complexity.Query.MyWishlistAlerts == nil {
+			break
+		}
+
+		args, err := ec.field_Query_myWishlistAlerts_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.MyWishlistAlerts(childComplexity, args["unreadOnly"].(*bool), args["pagination"].(*model.PaginationInput)), true
+
+	case "Query.orderAdjustments":
+		if e.complexity.Query.OrderAdjustments == nil {
+			break
+		}
+
+		args, err := ec.field_Query_orderAdjustments_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.OrderAdjustments(childComplexity, args["orderId"].(string)), true
+
+	case "Query.orderChanges":
+		if e.complexity.Query.OrderChanges == nil {
+			break
+		}
+
+		args, err := ec.field_Query_orderChanges_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.OrderChanges(childComplexity, args["after"].(*string), args["limit"].(*int32)), true
+
+	case "Query.orderDetail":
+		if e.complexity.Query.OrderDetail == nil {
+			break
+		}
+
+		args, err := ec.field_Query_orderDetail_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.OrderDetail(childComplexity, args["orderId"].(string)), true
+
+	case "Query.orderDetailByExternalId":
+		if e.complexity.Query.OrderDetailByExternalID == nil {
+			break
+		}
+
+		args, err := ec.field_Query_orderDetailByExternalId_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.OrderDetailByExternalID(childComplexity, args["externalId"].(string)), true
+
+	case "Query.orderList":
+		if e.complexity.Query.OrderList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_orderList_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.OrderList(childComplexity, args["filter"].(*model.OrderFilterInput), args["sort"].(*model.OrderSortInput), args["pagination"].(*model.PaginationInput)), true
+
+	case "Query.orderMessages":
+		if e.complexity.Query.OrderMessages == nil {
+			break
+		}
+
+		args, err := ec.field_Query_orderMessages_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.OrderMessages(childComplexity, args["orderId"].(string), args["before"].(*string), args["limit"].(*int32)), true
+
+	case "Query.orderReasonStats":
+		if e.complexity.Query.OrderReasonStats == nil {
+			break
+		}
+
+		args, err := ec.field_Query_orderReasonStats_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.OrderReasonStats(childComplexity, args["kind"].(model.OrderReasonKind), args["from"].(time.Time), args["to"].(time.Time)), true
+
+	case "Query.orderReasons":
+		if e.complexity.Query.OrderReasons == nil {
+			break
+		}
+
+		args, err := ec.field_Query_orderReasons_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.OrderReasons(childComplexity, args["kind"].(*model.OrderReasonKind)), true
+
+	case "Query.orderReceipt":
+		if e.complexity.Query.OrderReceipt == nil {
+			break
+		}
+
+		args, err := ec.field_Query_orderReceipt_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.OrderReceipt(childComplexity, args["orderId"].(string)), true
+
+	case "Query.orderRefunds":
+		if e.complexity.Query.OrderRefunds == nil {
+			break
+		}
+
+		args, err := ec.field_Query_orderRefunds_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.OrderRefunds(childComplexity, args["orderId"].(string)), true
+
+	case "Query.orderSlaBreaches":
+		if e.complexity.Query.OrderSLABreaches == nil {
+			break
+		}
+
+		args, err := ec.field_Query_orderSlaBreaches_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.OrderSLABreaches(childComplexity, args["openOnly"].(*bool), args["limit"].(*int32)), true
+
+	case "Query.orderShipment":
+		if e.complexity.Query.OrderShipment == nil {
+			break
+		}
+
+		args, err := ec.field_Query_orderShipment_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.OrderShipment(childComplexity, args["orderId"].(string)), true
+
+	case "Query.packages":
+		if e.complexity.Query.Packages == nil {
+			break
+		}
+
+		args, err := ec.field_Query_packages_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.Packages(childComplexity, args["filter"].(*model.PackageFilterInput), args["sort"].(*model.PackageSortInput), args["limit"].(*int32), args["page"].(*int32), args["after"].(*string)), true
+
+	case "Query.packingSlip":
+		if e.complexity.Query.PackingSlip == nil {
+			break
+		}
+
+		args, err := ec.field_Query_packingSlip_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PackingSlip(childComplexity, args["orderId"].(string)), true
+
+	case "Query.paymentDisputes":
+		if e.complexity.Query.PaymentDisputes == nil {
+			break
+		}
+
+		args, err := ec.field_Query_paymentDisputes_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PaymentDisputes(childComplexity, args["status"].(*model.DisputeStatus), args["limit"].(*int32)), true
+
+	case "Query.paymentOrderInfo":
+		if e.complexity.Query.PaymentOrderInfo == nil {
+			break
+		}
+
+		args, err := ec.field_Query_paymentOrderInfo_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PaymentOrderInfo(childComplexity, args["externalId"].(string)), true
+
+	case "Query.pickupLocations":
+		if e.complexity.Query.PickupLocations == nil {
+			break
+		}
+
+		return e.complexity.Query.PickupLocations(childComplexity), true
+
+	case "Query.pickupSlots":
+		if e.complexity.Query.PickupSlots == nil {
+			break
+		}
+
+		args, err := ec.field_Query_pickupSlots_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PickupSlots(childComplexity, args["locationId"].(string)), true
+
+	case "Query.policyHistory":
+		if e.complexity.Query.PolicyHistory == nil {
+			break
+		}
+
+		args, err := ec.field_Query_policyHistory_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PolicyHistory(childComplexity, args["kind"].(model.PolicyKind)), true
+
+	case "Query.productChanges":
+		if e.complexity.Query.ProductChanges == nil {
+			break
+		}
+
+		args, err := ec.field_Query_productChanges_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ProductChanges(childComplexity, args["after"].(*string), args["limit"].(*int32)), true
+
+	case "Query.productDetail":
+		if e.complexity.Query.ProductDetail == nil {
+			break
+		}
+
+		args, err := ec.field_Query_productDetail_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ProductDetail(childComplexity, args["productId"].(string)), true
+
+	case "Query.productList":
+		if e.complexity.Query.ProductList == nil {
+			break
+		}
+
+		args, err := ec.field_Query_productList_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ProductList(childComplexity, args["filter"].(*model.ProductFilterInput), args["sort"].(*model.ProductSortInput), args["page"].(*int32), args["limit"].(*int32), args["after"].(*string)), true
+
+	case "Query.productsHome":
+		if e.complexity.Query.ProductsHome == nil {
+			break
+		}
+
+		args, err := ec.field_Query_productsHome_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ProductsHome(childComplexity, args["filter"].(*model.ProductFilterInput), args["sort"].(*model.ProductSortInput), args["page"].(*int32), args["limit"].(*int32)), true
+
+	case "Query.promotionReport":
+		if e.complexity.Query.PromotionReport == nil {
+			break
+		}
+
+		args, err := ec.field_Query_promotionReport_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.PromotionReport(childComplexity, args["input"].(model.PromotionReportInput)), true
+
+	case "Query.quantityTypes":
+		if e.complexity.Query.QuantityTypes == nil {
+			break
+		}
+
+		return e.complexity.Query.QuantityTypes(childComplexity), true
+
+	case "Query.retentionPreview":
+		if e.complexity.Query.RetentionPreview == nil {
+			break
+		}
+
+		return e.complexity.Query.RetentionPreview(childComplexity), true
+
+	case "Query.returnEvidence":
+		if e.complexity.Query.ReturnEvidence == nil {
+			break
+		}
+
+		args, err := ec.field_Query_returnEvidence_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ReturnEvidence(childComplexity, args["orderId"].(string)), true
+
+	case "Query.shippingOptions":
+		if e.complexity.Query.ShippingOptions == nil {
+			break
+		}
+
+		args, err := ec.field_Query_shippingOptions_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.ShippingOptions(childComplexity, args["externalId"].(string)), true
+
+	case "Query.slowQueries":
+		if e.complexity.Query.SlowQueries == nil {
+			break
+		}
+
+		args, err := ec.field_Query_slowQueries_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.SlowQueries(childComplexity, args["sortBy"].(*model.SlowQuerySortField), args["limit"].(*int32)), true
+
+	case "Query.stockAdjustments":
+		if e.complexity.Query.StockAdjustments == nil {
+			break
+		}
+
+		args, err := ec.field_Query_stockAdjustments_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.StockAdjustments(childComplexity, args["status"].(*model.StockAdjustmentStatus), args["limit"].(*int32)), true
+
+	case "Query.stockOversell":
+		if e.complexity.Query.StockOversell == nil {
+			break
+		}
+
+		args, err := ec.field_Query_stockOversell_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.complexity.Query.StockOversell(childComplexity, args["since"].(*time.Time), args["limit"].(*int32)), true
+
+	case "Query.stockTransfers":
+		if e.complexity.Query.StockTransfers == nil {
+			break
+		}
+
+		args, err := ec.field_Query_stockTransfers_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "CheckoutSessionItem.price":
-		if e.complexity.CheckoutSessionItem.Price == nil {
+		return e.complexity.Query.StockTransfers(childComplexity, args["status"].(*model.StockTransferStatus), args["limit"].(*int32)), true
+
+	case "Query.storeBySlug":
+		if e.complexity.Query.StoreBySlug == nil {
 			break
 		}
 
-		return e.complexity.CheckoutSessionItem.Price(childComplexity), true
+		args, err := ec.field_Query_storeBySlug_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "CheckoutSessionItem.productName":
-		if e.complexity.CheckoutSessionItem.ProductName == nil {
+		return e.complexity.Query.StoreBySlug(childComplexity, args["slug"].(string)), true
+
+	case "Query.storeProducts":
+		if e.complexity.Query.StoreProducts == nil {
 			break
 		}
 
-		return e.complexity.CheckoutSessionItem.ProductName(childComplexity), true
+		args, err := ec.field_Query_storeProducts_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "CheckoutSessionItem.quantity":
-		if e.complexity.CheckoutSessionItem.Quantity == nil {
+		return e.complexity.Query.StoreProducts(childComplexity, args["slug"].(string), args["sort"].(*model.ProductSortInput), args["page"].(*int32), args["limit"].(*int32), args["after"].(*string)), true
+
+	case "Query.stuckPendingOrders":
+		if e.complexity.Query.StuckPendingOrders == nil {
 			break
 		}
 
-		return e.complexity.CheckoutSessionItem.Quantity(childComplexity), true
+		args, err := ec.field_Query_stuckPendingOrders_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "CheckoutSessionItem.quantityType":
-		if e.complexity.CheckoutSessionItem.QuantityType == nil {
+		return e.complexity.Query.StuckPendingOrders(childComplexity, args["olderThanMinutes"].(*int32), args["limit"].(*int32)), true
+
+	case "Query.subcategory":
+		if e.complexity.Query.Subcategory == nil {
 			break
 		}
 
-		return e.complexity.CheckoutSessionItem.QuantityType(childComplexity), true
+		args, err := ec.field_Query_subcategory_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "CheckoutSessionItem.subtotal":
-		if e.complexity.CheckoutSessionItem.Subtotal == nil {
+		return e.complexity.Query.Subcategory(childComplexity, args["filter"].(*string), args["categoryID"].(string), args["limit"].(*int32), args["page"].(*int32), args["after"].(*string)), true
+
+	case "Query.syntheticCheckoutRuns":
+		if e.complexity.Query.SyntheticCheckoutRuns == nil {
 			break
 		}
 
-		return e.complexity.CheckoutSessionItem.Subtotal(childComplexity), true
+		args, err := ec.field_Query_syntheticCheckoutRuns_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "CheckoutSessionItem.variantId":
-		if e.complexity.CheckoutSessionItem.VariantID == nil {
+		return e.complexity.Query.SyntheticCheckoutRuns(childComplexity, args["limit"].(*int32)), true
+
+	case "Query.unpaidConfirmedSessions":
+		if e.complexity.Query.UnpaidConfirmedSessions == nil {
 			break
 		}
 
-		return e.complexity.CheckoutSessionItem.VariantID(childComplexity), true
+		args, err := ec.field_Query_unpaidConfirmedSessions_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "CheckoutSessionItem.variantName":
-		if e.complexity.CheckoutSessionItem.VariantName == nil {
+		return e.complexity.Query.UnpaidConfirmedSessions(childComplexity, args["olderThanMinutes"].(*int32), args["limit"].(*int32)), true
+
+	case "Query.unreadOrderMessages":
+		if e.complexity.Query.UnreadOrderMessages == nil {
 			break
 		}
 
-		return e.complexity.CheckoutSessionItem.VariantName(childComplexity), true
+		args, err := ec.field_Query_unreadOrderMessages_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "CheckoutSessionResponse.expiresAt":
-		if e.complexity.CheckoutSessionResponse.ExpiresAt == nil {
+		return e.complexity.Query.UnreadOrderMessages(childComplexity, args["limit"].(*int32)), true
+
+	case "Query.usageFlags":
+		if e.complexity.Query.UsageFlags == nil {
 			break
 		}
 
-		return e.complexity.CheckoutSessionResponse.ExpiresAt(childComplexity), true
+		args, err := ec.field_Query_usageFlags_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "CheckoutSessionResponse.externalId":
-		if e.complexity.CheckoutSessionResponse.ExternalID == nil {
+		return e.complexity.Query.UsageFlags(childComplexity, args["since"].(*time.Time), args["limit"].(*int32)), true
+
+	case "Query.variantStockLevels":
+		if e.complexity.Query.VariantStockLevels == nil {
 			break
 		}
 
-		return e.complexity.CheckoutSessionResponse.ExternalID(childComplexity), true
+		args, err := ec.field_Query_variantStockLevels_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
-	case "CheckoutSessionResponse.status":
-		if e.complexity.CheckoutSessionResponse.Status == nil {
+		return e.complexity.Query.VariantStockLevels(childComplexity, args["variantId"].(string)), true
+
+	case "Query.warehouses":
+		if e.complexity.Query.Warehouses == nil {
 			break
 		}
 
-		return e.complexity.CheckoutSessionResponse.Status(childComplexity), true
+		return e.complexity.Query.Warehouses(childComplexity), true
 
-	case "ConfirmCheckoutSessionResponse.message":
-		if e.complexity.ConfirmCheckoutSessionResponse.Message == nil {
+	case "Query.webhookHealth":
+		if e.complexity.Query.WebhookHealth == nil {
 			break
 		}
 
-		return e.complexity.ConfirmCheckoutSessionResponse.Message(childComplexity), true
+		return e.complexity.Query.WebhookHealth(childComplexity), true
 
-	case "ConfirmCheckoutSessionResponse.order_external_id":
-		if e.complexity.ConfirmCheckoutSessionResponse.OrderExternalID == nil {
+	case "ReferralStats.code":
+		if e.complexity.ReferralStats.Code == nil {
 			break
 		}
 
-		return e.complexity.ConfirmCheckoutSessionResponse.OrderExternalID(childComplexity), true
+		return e.complexity.ReferralStats.Code(childComplexity), true
 
-	case "ConfirmCheckoutSessionResponse.success":
-		if e.complexity.ConfirmCheckoutSessionResponse.Success == nil {
+	case "ReferralStats.pending":
+		if e.complexity.ReferralStats.Pending == nil {
 			break
 		}
 
-		return e.complexity.ConfirmCheckoutSessionResponse.Success(childComplexity), true
+		return e.complexity.ReferralStats.Pending(childComplexity), true
 
-	case "CreateAddressResponse.address":
-		if e.complexity.CreateAddressResponse.Address == nil {
+	case "ReferralStats.rejected":
+		if e.complexity.ReferralStats.Rejected == nil {
 			break
 		}
 
-		return e.complexity.CreateAddressResponse.Address(childComplexity), true
+		return e.complexity.ReferralStats.Rejected(childComplexity), true
 
-	case "CreateOrderResponse.message":
-		if e.complexity.CreateOrderResponse.Message == nil {
+	case "ReferralStats.rewarded":
+		if e.complexity.ReferralStats.Rewarded == nil {
 			break
 		}
 
-		return e.complexity.CreateOrderResponse.Message(childComplexity), true
+		return e.complexity.ReferralStats.Rewarded(childComplexity), true
 
-	case "CreateOrderResponse.order":
-		if e.complexity.CreateOrderResponse.Order == nil {
+	case "ReferralStats.signups":
+		if e.complexity.ReferralStats.Signups == nil {
 			break
 		}
 
-		return e.complexity.CreateOrderResponse.Order(childComplexity), true
+		return e.complexity.ReferralStats.Signups(childComplexity), true
 
-	case "CreateOrderResponse.payment":
-		if e.complexity.CreateOrderResponse.Payment == nil {
+	case "ReferralStats.totalEarned":
+		if e.complexity.ReferralStats.TotalEarned == nil {
 			break
 		}
 
-		return e.complexity.CreateOrderResponse.Payment(childComplexity), true
+		return e.complexity.ReferralStats.TotalEarned(childComplexity), true
 
-	case "CreateOrderResponse.paymentURL":
-		if e.complexity.CreateOrderResponse.PaymentURL == nil {
+	case "Refund.amount":
+		if e.complexity.Refund.Amount == nil {
 			break
 		}
 
-		return e.complexity.CreateOrderResponse.PaymentURL(childComplexity), true
+		return e.complexity.Refund.Amount(childComplexity), true
 
-	case "CreateOrderResponse.success":
-		if e.complexity.CreateOrderResponse.Success == nil {
+	case "Refund.completedAt":
+		if e.complexity.Refund.CompletedAt == nil {
 			break
 		}
 
-		return e.complexity.CreateOrderResponse.Success(childComplexity), true
+		return e.complexity.Refund.CompletedAt(childComplexity), true
 
-	case "DeleteAddressResponse.success":
-		if e.complexity.DeleteAddressResponse.Success == nil {
+	case "Refund.createdAt":
+		if e.complexity.Refund.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.DeleteAddressResponse.Success(childComplexity), true
+		return e.complexity.Refund.CreatedAt(childComplexity), true
 
-	case "ForgotPasswordResponse.message":
-		if e.complexity.ForgotPasswordResponse.Message == nil {
+	case "Refund.currency":
+		if e.complexity.Refund.Currency == nil {
 			break
 		}
 
-		return e.complexity.ForgotPasswordResponse.Message(childComplexity), true
+		return e.complexity.Refund.Currency(childComplexity), true
 
-	case "ForgotPasswordResponse.success":
-		if e.complexity.ForgotPasswordResponse.Success == nil {
+	case "Refund.failureReason":
+		if e.complexity.Refund.FailureReason == nil {
 			break
 		}
 
-		return e.complexity.ForgotPasswordResponse.Success(childComplexity), true
+		return e.complexity.Refund.FailureReason(childComplexity), true
 
-	case "Mutation.addCategory":
-		if e.complexity.Mutation.AddCategory == nil {
+	case "Refund.id":
+		if e.complexity.Refund.ID == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_addCategory_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Refund.ID(childComplexity), true
+
+	case "Refund.method":
+		if e.complexity.Refund.Method == nil {
+			break
 		}
 
-		return e.complexity.Mutation.AddCategory(childComplexity, args["name"].(string)), true
+		return e.complexity.Refund.Method(childComplexity), true
 
-	case "Mutation.addPackage":
-		if e.complexity.Mutation.AddPackage == nil {
+	case "Refund.orderId":
+		if e.complexity.Refund.OrderID == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_addPackage_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Refund.OrderID(childComplexity), true
+
+	case "Refund.reason":
+		if e.complexity.Refund.Reason == nil {
+			break
 		}
 
-		return e.complexity.Mutation.AddPackage(childComplexity, args["input"].(model.AddPackageInput)), true
+		return e.complexity.Refund.Reason(childComplexity), true
 
-	case "Mutation.addSubcategory":
-		if e.complexity.Mutation.AddSubcategory == nil {
+	case "Refund.reasonId":
+		if e.complexity.Refund.ReasonID == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_addSubcategory_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Refund.ReasonID(childComplexity), true
+
+	case "Refund.status":
+		if e.complexity.Refund.Status == nil {
+			break
 		}
 
-		return e.complexity.Mutation.AddSubcategory(childComplexity, args["categoryID"].(string), args["name"].(string)), true
+		return e.complexity.Refund.Status(childComplexity), true
 
-	case "Mutation.addToCart":
-		if e.complexity.Mutation.AddToCart == nil {
+	case "RequestRefundResponse.refunds":
+		if e.complexity.RequestRefundResponse.Refunds == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_addToCart_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.RequestRefundResponse.Refunds(childComplexity), true
+
+	case "ResetPasswordResponse.message":
+		if e.complexity.ResetPasswordResponse.Message == nil {
+			break
 		}
 
-		return e.complexity.Mutation.AddToCart(childComplexity, args["input"].(model.AddToCartInput)), true
+		return e.complexity.ResetPasswordResponse.Message(childComplexity), true
 
-	case "Mutation.confirmCheckoutSession":
-		if e.complexity.Mutation.ConfirmCheckoutSession == nil {
+	case "ResetPasswordResponse.success":
+		if e.complexity.ResetPasswordResponse.Success == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_confirmCheckoutSession_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.ResetPasswordResponse.Success(childComplexity), true
+
+	case "Response.message":
+		if e.complexity.Response.Message == nil {
+			break
 		}
 
-		return e.complexity.Mutation.ConfirmCheckoutSession(childComplexity, args["input"].(model.ConfirmCheckoutSessionInput)), true
+		return e.complexity.Response.Message(childComplexity), true
 
-	case "Mutation.createAddress":
-		if e.complexity.Mutation.CreateAddress == nil {
+	case "Response.success":
+		if e.complexity.Response.Success == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_createAddress_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Response.Success(childComplexity), true
+
+	case "RetentionPolicyResult.affected":
+		if e.complexity.RetentionPolicyResult.Affected == nil {
+			break
 		}
 
-		return e.complexity.Mutation.CreateAddress(childComplexity, args["input"].(model.CreateAddressInput)), true
+		return e.complexity.RetentionPolicyResult.Affected(childComplexity), true
 
-	case "Mutation.createCheckoutSession":
-		if e.complexity.Mutation.CreateCheckoutSession == nil {
+	case "RetentionPolicyResult.cutoff":
+		if e.complexity.RetentionPolicyResult.Cutoff == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_createCheckoutSession_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.RetentionPolicyResult.Cutoff(childComplexity), true
+
+	case "RetentionPolicyResult.dryRun":
+		if e.complexity.RetentionPolicyResult.DryRun == nil {
+			break
 		}
 
-		return e.complexity.Mutation.CreateCheckoutSession(childComplexity, args["input"].(model.CreateCheckoutSessionInput)), true
+		return e.complexity.RetentionPolicyResult.DryRun(childComplexity), true
 
-	case "Mutation.createOrderFromSession":
-		if e.complexity.Mutation.CreateOrderFromSession == nil {
+	case "RetentionPolicyResult.policy":
+		if e.complexity.RetentionPolicyResult.Policy == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_createOrderFromSession_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.RetentionPolicyResult.Policy(childComplexity), true
+
+	case "ScheduledPriceChange.createdAt":
+		if e.complexity.ScheduledPriceChange.CreatedAt == nil {
+			break
 		}
 
-		return e.complexity.Mutation.CreateOrderFromSession(childComplexity, args["input"].(model.CreateOrderFromSessionInput)), true
+		return e.complexity.ScheduledPriceChange.CreatedAt(childComplexity), true
 
-	case "Mutation.createProduct":
-		if e.complexity.Mutation.CreateProduct == nil {
+	case "ScheduledPriceChange.currentPrice":
+		if e.complexity.ScheduledPriceChange.CurrentPrice == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_createProduct_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.ScheduledPriceChange.CurrentPrice(childComplexity), true
+
+	case "ScheduledPriceChange.effectiveAt":
+		if e.complexity.ScheduledPriceChange.EffectiveAt == nil {
+			break
 		}
 
-		return e.complexity.Mutation.CreateProduct(childComplexity, args["input"].(model.NewProduct)), true
+		return e.complexity.ScheduledPriceChange.EffectiveAt(childComplexity), true
 
-	case "Mutation.createVariants":
-		if e.complexity.Mutation.CreateVariants == nil {
+	case "ScheduledPriceChange.heldBySale":
+		if e.complexity.ScheduledPriceChange.HeldBySale == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_createVariants_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.ScheduledPriceChange.HeldBySale(childComplexity), true
+
+	case "ScheduledPriceChange.id":
+		if e.complexity.ScheduledPriceChange.ID == nil {
+			break
 		}
 
-		return e.complexity.Mutation.CreateVariants(childComplexity, args["input"].([]*model.NewVariant)), true
+		return e.complexity.ScheduledPriceChange.ID(childComplexity), true
 
-	case "Mutation.deleteAddress":
-		if e.complexity.Mutation.DeleteAddress == nil {
+	case "ScheduledPriceChange.price":
+		if e.complexity.ScheduledPriceChange.Price == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_deleteAddress_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.ScheduledPriceChange.Price(childComplexity), true
+
+	case "ScheduledPriceChange.productId":
+		if e.complexity.ScheduledPriceChange.ProductID == nil {
+			break
+		}
+
+		return e.complexity.ScheduledPriceChange.ProductID(childComplexity), true
+
+	case "ScheduledPriceChange.productName":
+		if e.complexity.ScheduledPriceChange.ProductName == nil {
+			break
+		}
+
+		return e.complexity.ScheduledPriceChange.ProductName(childComplexity), true
+
+	case "ScheduledPriceChange.variantId":
+		if e.complexity.ScheduledPriceChange.VariantID == nil {
+			break
+		}
+
+		return e.complexity.ScheduledPriceChange.VariantID(childComplexity), true
+
+	case "ScheduledPriceChange.variantName":
+		if e.complexity.ScheduledPriceChange.VariantName == nil {
+			break
+		}
+
+		return e.complexity.ScheduledPriceChange.VariantName(childComplexity), true
+
+	case "SeqScanTable.avgRowsPerScan":
+		if e.complexity.SeqScanTable.AvgRowsPerScan == nil {
+			break
+		}
+
+		return e.complexity.SeqScanTable.AvgRowsPerScan(childComplexity), true
+
+	case "SeqScanTable.indexScans":
+		if e.complexity.SeqScanTable.IndexScans == nil {
+			break
+		}
+
+		return e.complexity.SeqScanTable.IndexScans(childComplexity), true
+
+	case "SeqScanTable.liveTuples":
+		if e.complexity.SeqScanTable.LiveTuples == nil {
+			break
+		}
+
+		return e.complexity.SeqScanTable.LiveTuples(childComplexity), true
+
+	case "SeqScanTable.seqScans":
+		if e.complexity.SeqScanTable.SeqScans == nil {
+			break
+		}
+
+		return e.complexity.SeqScanTable.SeqScans(childComplexity), true
+
+	case "SeqScanTable.table":
+		if e.complexity.SeqScanTable.Table == nil {
+			break
+		}
+
+		return e.complexity.SeqScanTable.Table(childComplexity), true
+
+	case "Shipment.awb":
+		if e.complexity.Shipment.Awb == nil {
+			break
+		}
+
+		return e.complexity.Shipment.Awb(childComplexity), true
+
+	case "Shipment.courier":
+		if e.complexity.Shipment.Courier == nil {
+			break
+		}
+
+		return e.complexity.Shipment.Courier(childComplexity), true
+
+	case "Shipment.deliveredAt":
+		if e.complexity.Shipment.DeliveredAt == nil {
+			break
 		}
 
-		return e.complexity.Mutation.DeleteAddress(childComplexity, args["input"].(model.DeleteAddressInput)), true
+		return e.complexity.Shipment.DeliveredAt(childComplexity), true
 
-	case "Mutation.forgotPassword":
-		if e.complexity.Mutation.ForgotPassword == nil {
+	case "Shipment.events":
+		if e.complexity.Shipment.Events == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_forgotPassword_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Shipment.Events(childComplexity), true
+
+	case "Shipment.id":
+		if e.complexity.Shipment.ID == nil {
+			break
 		}
 
-		return e.complexity.Mutation.ForgotPassword(childComplexity, args["input"].(model.ForgotPasswordInput)), true
+		return e.complexity.Shipment.ID(childComplexity), true
 
-	case "Mutation.login":
-		if e.complexity.Mutation.Login == nil {
+	case "Shipment.lastEventAt":
+		if e.complexity.Shipment.LastEventAt == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_login_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Shipment.LastEventAt(childComplexity), true
+
+	case "Shipment.orderId":
+		if e.complexity.Shipment.OrderID == nil {
+			break
 		}
 
-		return e.complexity.Mutation.Login(childComplexity, args["input"].(model.LoginInput)), true
+		return e.complexity.Shipment.OrderID(childComplexity), true
 
-	case "Mutation.logout":
-		if e.complexity.Mutation.Logout == nil {
+	case "Shipment.shippedAt":
+		if e.complexity.Shipment.ShippedAt == nil {
 			break
 		}
 
-		return e.complexity.Mutation.Logout(childComplexity), true
+		return e.complexity.Shipment.ShippedAt(childComplexity), true
 
-	case "Mutation.register":
-		if e.complexity.Mutation.Register == nil {
+	case "Shipment.status":
+		if e.complexity.Shipment.Status == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_register_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Shipment.Status(childComplexity), true
+
+	case "ShipmentTrackingEvent.description":
+		if e.complexity.ShipmentTrackingEvent.Description == nil {
+			break
 		}
 
-		return e.complexity.Mutation.Register(childComplexity, args["input"].(model.RegisterInput)), true
+		return e.complexity.ShipmentTrackingEvent.Description(childComplexity), true
 
-	case "Mutation.removeFromCart":
-		if e.complexity.Mutation.RemoveFromCart == nil {
+	case "ShipmentTrackingEvent.location":
+		if e.complexity.ShipmentTrackingEvent.Location == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_removeFromCart_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.ShipmentTrackingEvent.Location(childComplexity), true
+
+	case "ShipmentTrackingEvent.occurredAt":
+		if e.complexity.ShipmentTrackingEvent.OccurredAt == nil {
+			break
 		}
 
-		return e.complexity.Mutation.RemoveFromCart(childComplexity, args["variantIds"].([]string)), true
+		return e.complexity.ShipmentTrackingEvent.OccurredAt(childComplexity), true
 
-	case "Mutation.resetPassword":
-		if e.complexity.Mutation.ResetPassword == nil {
+	case "ShipmentTrackingEvent.status":
+		if e.complexity.ShipmentTrackingEvent.Status == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_resetPassword_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.ShipmentTrackingEvent.Status(childComplexity), true
+
+	case "ShippingAddress.address1":
+		if e.complexity.ShippingAddress.Address1 == nil {
+			break
 		}
 
-		return e.complexity.Mutation.ResetPassword(childComplexity, args["input"].(model.ResetPasswordInput)), true
+		return e.complexity.ShippingAddress.Address1(childComplexity), true
 
-	case "Mutation.setDefaultAddress":
-		if e.complexity.Mutation.SetDefaultAddress == nil {
+	case "ShippingAddress.address2":
+		if e.complexity.ShippingAddress.Address2 == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_setDefaultAddress_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.ShippingAddress.Address2(childComplexity), true
+
+	case "ShippingAddress.city":
+		if e.complexity.ShippingAddress.City == nil {
+			break
 		}
 
-		return e.complexity.Mutation.SetDefaultAddress(childComplexity, args["addressId"].(string)), true
+		return e.complexity.ShippingAddress.City(childComplexity), true
 
-	case "Mutation.updateAddress":
-		if e.complexity.Mutation.UpdateAddress == nil {
+	case "ShippingAddress.name":
+		if e.complexity.ShippingAddress.Name == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_updateAddress_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.ShippingAddress.Name(childComplexity), true
+
+	case "ShippingAddress.phone":
+		if e.complexity.ShippingAddress.Phone == nil {
+			break
 		}
 
-		return e.complexity.Mutation.UpdateAddress(childComplexity, args["input"].(model.UpdateAddressInput)), true
+		return e.complexity.ShippingAddress.Phone(childComplexity), true
 
-	case "Mutation.updateCart":
-		if e.complexity.Mutation.UpdateCart == nil {
+	case "ShippingAddress.postalCode":
+		if e.complexity.ShippingAddress.PostalCode == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_updateCart_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.ShippingAddress.PostalCode(childComplexity), true
+
+	case "ShippingAddress.province":
+		if e.complexity.ShippingAddress.Province == nil {
+			break
 		}
 
-		return e.complexity.Mutation.UpdateCart(childComplexity, args["input"].(model.UpdateCartInput)), true
+		return e.complexity.ShippingAddress.Province(childComplexity), true
 
-	case "Mutation.updateOrderStatus":
-		if e.complexity.Mutation.UpdateOrderStatus == nil {
+	case "ShippingAddress.receiverName":
+		if e.complexity.ShippingAddress.ReceiverName == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_updateOrderStatus_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.ShippingAddress.ReceiverName(childComplexity), true
+
+	case "ShippingOption.available":
+		if e.complexity.ShippingOption.Available == nil {
+			break
 		}
 
-		return e.complexity.Mutation.UpdateOrderStatus(childComplexity, args["input"].(model.UpdateOrderStatusInput)), true
+		return e.complexity.ShippingOption.Available(childComplexity), true
 
-	case "Mutation.updateProduct":
-		if e.complexity.Mutation.UpdateProduct == nil {
+	case "ShippingOption.distanceKm":
+		if e.complexity.ShippingOption.DistanceKm == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_updateProduct_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.ShippingOption.DistanceKm(childComplexity), true
+
+	case "ShippingOption.fee":
+		if e.complexity.ShippingOption.Fee == nil {
+			break
 		}
 
-		return e.complexity.Mutation.UpdateProduct(childComplexity, args["input"].(model.UpdateProduct)), true
+		return e.complexity.ShippingOption.Fee(childComplexity), true
 
-	case "Mutation.updateProfile":
-		if e.complexity.Mutation.UpdateProfile == nil {
+	case "ShippingOption.insuranceFee":
+		if e.complexity.ShippingOption.InsuranceFee == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_updateProfile_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.ShippingOption.InsuranceFee(childComplexity), true
+
+	case "ShippingOption.method":
+		if e.complexity.ShippingOption.Method == nil {
+			break
 		}
 
-		return e.complexity.Mutation.UpdateProfile(childComplexity, args["input"].(model.UpdateProfileInput)), true
+		return e.complexity.ShippingOption.Method(childComplexity), true
 
-	case "Mutation.updateSessionAddress":
-		if e.complexity.Mutation.UpdateSessionAddress == nil {
+	case "ShippingOption.unavailableReason":
+		if e.complexity.ShippingOption.UnavailableReason == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_updateSessionAddress_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.ShippingOption.UnavailableReason(childComplexity), true
+
+	case "SlowQueryReport.available":
+		if e.complexity.SlowQueryReport.Available == nil {
+			break
 		}
 
-		return e.complexity.Mutation.UpdateSessionAddress(childComplexity, args["input"].(model.UpdateSessionAddressInput)), true
+		return e.complexity.SlowQueryReport.Available(childComplexity), true
 
-	case "Mutation.updateSessionPaymentMethod":
-		if e.complexity.Mutation.UpdateSessionPaymentMethod == nil {
+	case "SlowQueryReport.modules":
+		if e.complexity.SlowQueryReport.Modules == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_updateSessionPaymentMethod_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.SlowQueryReport.Modules(childComplexity), true
+
+	case "SlowQueryReport.statements":
+		if e.complexity.SlowQueryReport.Statements == nil {
+			break
 		}
 
-		return e.complexity.Mutation.UpdateSessionPaymentMethod(childComplexity, args["input"].(model.UpdateSessionPaymentMethodInput)), true
+		return e.complexity.SlowQueryReport.Statements(childComplexity), true
 
-	case "Mutation.updateVariants":
-		if e.complexity.Mutation.UpdateVariants == nil {
+	case "SlowStatement.calls":
+		if e.complexity.SlowStatement.Calls == nil {
 			break
 		}
 
-		args, err := ec.field_Mutation_updateVariants_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.SlowStatement.Calls(childComplexity), true
+
+	case "SlowStatement.meanTimeMs":
+		if e.complexity.SlowStatement.MeanTimeMs == nil {
+			break
 		}
 
-		return e.complexity.Mutation.UpdateVariants(childComplexity, args["input"].([]*model.UpdateVariant)), true
+		return e.complexity.SlowStatement.MeanTimeMs(childComplexity), true
 
-	case "Order.externalId":
-		if e.complexity.Order.ExternalID == nil {
+	case "SlowStatement.method":
+		if e.complexity.SlowStatement.Method == nil {
 			break
 		}
 
-		return e.complexity.Order.ExternalID(childComplexity), true
+		return e.complexity.SlowStatement.Method(childComplexity), true
 
-	case "Order.id":
-		if e.complexity.Order.ID == nil {
+	case "SlowStatement.module":
+		if e.complexity.SlowStatement.Module == nil {
 			break
 		}
 
-		return e.complexity.Order.ID(childComplexity), true
+		return e.complexity.SlowStatement.Module(childComplexity), true
 
-	case "Order.invoiceNumber":
-		if e.complexity.Order.InvoiceNumber == nil {
+	case "SlowStatement.query":
+		if e.complexity.SlowStatement.Query == nil {
 			break
 		}
 
-		return e.complexity.Order.InvoiceNumber(childComplexity), true
+		return e.complexity.SlowStatement.Query(childComplexity), true
 
-	case "Order.items":
-		if e.complexity.Order.Items == nil {
+	case "SlowStatement.queryId":
+		if e.complexity.SlowStatement.QueryID == nil {
 			break
 		}
 
-		return e.complexity.Order.Items(childComplexity), true
+		return e.complexity.SlowStatement.QueryID(childComplexity), true
 
-	case "Order.pricing":
-		if e.complexity.Order.Pricing == nil {
+	case "SlowStatement.rows":
+		if e.complexity.SlowStatement.Rows == nil {
 			break
 		}
 
-		return e.complexity.Order.Pricing(childComplexity), true
+		return e.complexity.SlowStatement.Rows(childComplexity), true
 
-	case "Order.shipping":
-		if e.complexity.Order.Shipping == nil {
+	case "SlowStatement.totalTimeMs":
+		if e.complexity.SlowStatement.TotalTimeMs == nil {
 			break
 		}
 
-		return e.complexity.Order.Shipping(childComplexity), true
+		return e.complexity.SlowStatement.TotalTimeMs(childComplexity), true
 
-	case "Order.status":
-		if e.complexity.Order.Status == nil {
+	case "StockAdjustment.createdAt":
+		if e.complexity.StockAdjustment.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.Order.Status(childComplexity), true
+		return e.complexity.StockAdjustment.CreatedAt(childComplexity), true
 
-	case "Order.timestamps":
-		if e.complexity.Order.Timestamps == nil {
+	case "StockAdjustment.delta":
+		if e.complexity.StockAdjustment.Delta == nil {
 			break
 		}
 
-		return e.complexity.Order.Timestamps(childComplexity), true
+		return e.complexity.StockAdjustment.Delta(childComplexity), true
 
-	case "Order.user":
-		if e.complexity.Order.User == nil {
+	case "StockAdjustment.id":
+		if e.complexity.StockAdjustment.ID == nil {
 			break
 		}
 
-		return e.complexity.Order.User(childComplexity), true
+		return e.complexity.StockAdjustment.ID(childComplexity), true
 
-	case "OrderItem.id":
-		if e.complexity.OrderItem.ID == nil {
+	case "StockAdjustment.reason":
+		if e.complexity.StockAdjustment.Reason == nil {
 			break
 		}
 
-		return e.complexity.OrderItem.ID(childComplexity), true
+		return e.complexity.StockAdjustment.Reason(childComplexity), true
 
-	case "OrderItem.pricing":
-		if e.complexity.OrderItem.Pricing == nil {
+	case "StockAdjustment.requestedBy":
+		if e.complexity.StockAdjustment.RequestedBy == nil {
 			break
 		}
 
-		return e.complexity.OrderItem.Pricing(childComplexity), true
+		return e.complexity.StockAdjustment.RequestedBy(childComplexity), true
 
-	case "OrderItem.quantity":
-		if e.complexity.OrderItem.Quantity == nil {
+	case "StockAdjustment.reviewedAt":
+		if e.complexity.StockAdjustment.ReviewedAt == nil {
 			break
 		}
 
-		return e.complexity.OrderItem.Quantity(childComplexity), true
+		return e.complexity.StockAdjustment.ReviewedAt(childComplexity), true
 
-	case "OrderItem.quantityType":
-		if e.complexity.OrderItem.QuantityType == nil {
+	case "StockAdjustment.reviewedBy":
+		if e.complexity.StockAdjustment.ReviewedBy == nil {
 			break
 		}
 
-		return e.complexity.OrderItem.QuantityType(childComplexity), true
+		return e.complexity.StockAdjustment.ReviewedBy(childComplexity), true
 
-	case "OrderItem.variant":
-		if e.complexity.OrderItem.Variant == nil {
+	case "StockAdjustment.status":
+		if e.complexity.StockAdjustment.Status == nil {
 			break
 		}
 
-		return e.complexity.OrderItem.Variant(childComplexity), true
+		return e.complexity.StockAdjustment.Status(childComplexity), true
 
-	case "OrderItemPricing.price":
-		if e.complexity.OrderItemPricing.Price == nil {
+	case "StockAdjustment.variantId":
+		if e.complexity.StockAdjustment.VariantID == nil {
 			break
 		}
 
-		return e.complexity.OrderItemPricing.Price(childComplexity), true
+		return e.complexity.StockAdjustment.VariantID(childComplexity), true
 
-	case "OrderItemPricing.subtotal":
-		if e.complexity.OrderItemPricing.Subtotal == nil {
+	case "StockAdjustment.warehouseId":
+		if e.complexity.StockAdjustment.WarehouseID == nil {
 			break
 		}
 
-		return e.complexity.OrderItemPricing.Subtotal(childComplexity), true
+		return e.complexity.StockAdjustment.WarehouseID(childComplexity), true
 
-	case "OrderListResponse.items":
-		if e.complexity.OrderListResponse.Items == nil {
+	case "StockIncident.available":
+		if e.complexity.StockIncident.Available == nil {
 			break
 		}
 
-		return e.complexity.OrderListResponse.Items(childComplexity), true
+		return e.complexity.StockIncident.Available(childComplexity), true
 
-	case "OrderListResponse.pageInfo":
-		if e.complexity.OrderListResponse.PageInfo == nil {
+	case "StockIncident.checkoutSessionId":
+		if e.complexity.StockIncident.CheckoutSessionID == nil {
 			break
 		}
 
-		return e.complexity.OrderListResponse.PageInfo(childComplexity), true
+		return e.complexity.StockIncident.CheckoutSessionID(childComplexity), true
 
-	case "OrderPricing.currency":
-		if e.complexity.OrderPricing.Currency == nil {
+	case "StockIncident.createdAt":
+		if e.complexity.StockIncident.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.OrderPricing.Currency(childComplexity), true
+		return e.complexity.StockIncident.CreatedAt(childComplexity), true
 
-	case "OrderPricing.discount":
-		if e.complexity.OrderPricing.Discount == nil {
+	case "StockIncident.id":
+		if e.complexity.StockIncident.ID == nil {
 			break
 		}
 
-		return e.complexity.OrderPricing.Discount(childComplexity), true
+		return e.complexity.StockIncident.ID(childComplexity), true
 
-	case "OrderPricing.shippingFee":
-		if e.complexity.OrderPricing.ShippingFee == nil {
+	case "StockIncident.requested":
+		if e.complexity.StockIncident.Requested == nil {
 			break
 		}
 
-		return e.complexity.OrderPricing.ShippingFee(childComplexity), true
+		return e.complexity.StockIncident.Requested(childComplexity), true
 
-	case "OrderPricing.subtotal":
-		if e.complexity.OrderPricing.Subtotal == nil {
+	case "StockIncident.variantId":
+		if e.complexity.StockIncident.VariantID == nil {
 			break
 		}
 
-		return e.complexity.OrderPricing.Subtotal(childComplexity), true
+		return e.complexity.StockIncident.VariantID(childComplexity), true
 
-	case "OrderPricing.tax":
-		if e.complexity.OrderPricing.Tax == nil {
+	case "StockIncident.variantName":
+		if e.complexity.StockIncident.VariantName == nil {
 			break
 		}
 
-		return e.complexity.OrderPricing.Tax(childComplexity), true
+		return e.complexity.StockIncident.VariantName(childComplexity), true
 
-	case "OrderPricing.total":
-		if e.complexity.OrderPricing.Total == nil {
+	case "StockOversellReport.incidents":
+		if e.complexity.StockOversellReport.Incidents == nil {
 			break
 		}
 
-		return e.complexity.OrderPricing.Total(childComplexity), true
+		return e.complexity.StockOversellReport.Incidents(childComplexity), true
 
-	case "OrderShipping.address":
-		if e.complexity.OrderShipping.Address == nil {
+	case "StockOversellReport.negativeStockVariants":
+		if e.complexity.StockOversellReport.NegativeStockVariants == nil {
 			break
 		}
 
-		return e.complexity.OrderShipping.Address(childComplexity), true
+		return e.complexity.StockOversellReport.NegativeStockVariants(childComplexity), true
 
-	case "OrderTimestamps.createdAt":
-		if e.complexity.OrderTimestamps.CreatedAt == nil {
+	case "StockTransfer.cancelledAt":
+		if e.complexity.StockTransfer.CancelledAt == nil {
 			break
 		}
 
-		return e.complexity.OrderTimestamps.CreatedAt(childComplexity), true
+		return e.complexity.StockTransfer.CancelledAt(childComplexity), true
 
-	case "OrderTimestamps.updatedAt":
-		if e.complexity.OrderTimestamps.UpdatedAt == nil {
+	case "StockTransfer.createdAt":
+		if e.complexity.StockTransfer.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.OrderTimestamps.UpdatedAt(childComplexity), true
+		return e.complexity.StockTransfer.CreatedAt(childComplexity), true
 
-	case "Package.createdAt":
-		if e.complexity.Package.CreatedAt == nil {
+	case "StockTransfer.fromWarehouseId":
+		if e.complexity.StockTransfer.FromWarehouseID == nil {
 			break
 		}
 
-		return e.complexity.Package.CreatedAt(childComplexity), true
+		return e.complexity.StockTransfer.FromWarehouseID(childComplexity), true
 
-	case "Package.id":
-		if e.complexity.Package.ID == nil {
+	case "StockTransfer.id":
+		if e.complexity.StockTransfer.ID == nil {
 			break
 		}
 
-		return e.complexity.Package.ID(childComplexity), true
+		return e.complexity.StockTransfer.ID(childComplexity), true
 
-	case "Package.imageUrl":
-		if e.complexity.Package.ImageURL == nil {
+	case "StockTransfer.note":
+		if e.complexity.StockTransfer.Note == nil {
 			break
 		}
 
-		return e.complexity.Package.ImageURL(childComplexity), true
+		return e.complexity.StockTransfer.Note(childComplexity), true
 
-	case "Package.isActive":
-		if e.complexity.Package.IsActive == nil {
+	case "StockTransfer.quantity":
+		if e.complexity.StockTransfer.Quantity == nil {
 			break
 		}
 
-		return e.complexity.Package.IsActive(childComplexity), true
+		return e.complexity.StockTransfer.Quantity(childComplexity), true
 
-	case "Package.items":
-		if e.complexity.Package.Items == nil {
+	case "StockTransfer.receivedAt":
+		if e.complexity.StockTransfer.ReceivedAt == nil {
 			break
 		}
 
-		return e.complexity.Package.Items(childComplexity), true
+		return e.complexity.StockTransfer.ReceivedAt(childComplexity), true
 
-	case "Package.name":
-		if e.complexity.Package.Name == nil {
+	case "StockTransfer.status":
+		if e.complexity.StockTransfer.Status == nil {
 			break
 		}
 
-		return e.complexity.Package.Name(childComplexity), true
+		return e.complexity.StockTransfer.Status(childComplexity), true
 
-	case "Package.type":
-		if e.complexity.Package.Type == nil {
+	case "StockTransfer.toWarehouseId":
+		if e.complexity.StockTransfer.ToWarehouseID == nil {
 			break
 		}
 
-		return e.complexity.Package.Type(childComplexity), true
+		return e.complexity.StockTransfer.ToWarehouseID(childComplexity), true
 
-	case "Package.updatedAt":
-		if e.complexity.Package.UpdatedAt == nil {
+	case "StockTransfer.variantId":
+		if e.complexity.StockTransfer.VariantID == nil {
 			break
 		}
 
-		return e.complexity.Package.UpdatedAt(childComplexity), true
+		return e.complexity.StockTransfer.VariantID(childComplexity), true
 
-	case "Package.userId":
-		if e.complexity.Package.UserID == nil {
+	case "Store.createdAt":
+		if e.complexity.Store.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.Package.UserID(childComplexity), true
+		return e.complexity.Store.CreatedAt(childComplexity), true
 
-	case "PackageItem.createdAt":
-		if e.complexity.PackageItem.CreatedAt == nil {
+	case "Store.description":
+		if e.complexity.Store.Description == nil {
 			break
 		}
 
-		return e.complexity.PackageItem.CreatedAt(childComplexity), true
+		return e.complexity.Store.Description(childComplexity), true
 
-	case "PackageItem.id":
-		if e.complexity.PackageItem.ID == nil {
+	case "Store.logoUrl":
+		if e.complexity.Store.LogoURL == nil {
 			break
 		}
 
-		return e.complexity.PackageItem.ID(childComplexity), true
+		return e.complexity.Store.LogoURL(childComplexity), true
 
-	case "PackageItem.imageUrl":
-		if e.complexity.PackageItem.ImageURL == nil {
+	case "Store.name":
+		if e.complexity.Store.Name == nil {
 			break
 		}
 
-		return e.complexity.PackageItem.ImageURL(childComplexity), true
+		return e.complexity.Store.Name(childComplexity), true
 
-	case "PackageItem.name":
-		if e.complexity.PackageItem.Name == nil {
+	case "Store.openNow":
+		if e.complexity.Store.OpenNow == nil {
+			break
+		}
+
+		return e.complexity.Store.OpenNow(childComplexity), true
+
+	case "Store.operatingHours":
+		if e.complexity.Store.OperatingHours == nil {
 			break
 		}
 
-		return e.complexity.PackageItem.Name(childComplexity), true
+		return e.complexity.Store.OperatingHours(childComplexity), true
 
-	case "PackageItem.packageId":
-		if e.complexity.PackageItem.PackageID == nil {
+	case "Store.productCount":
+		if e.complexity.Store.ProductCount == nil {
 			break
 		}
 
-		return e.complexity.PackageItem.PackageID(childComplexity), true
+		return e.complexity.Store.ProductCount(childComplexity), true
 
-	case "PackageItem.price":
-		if e.complexity.PackageItem.Price == nil {
+	case "Store.sellerId":
+		if e.complexity.Store.SellerID == nil {
 			break
 		}
 
-		return e.complexity.PackageItem.Price(childComplexity), true
+		return e.complexity.Store.SellerID(childComplexity), true
 
-	case "PackageItem.quantity":
-		if e.complexity.PackageItem.Quantity == nil {
+	case "Store.slug":
+		if e.complexity.Store.Slug == nil {
 			break
 		}
 
-		return e.complexity.PackageItem.Quantity(childComplexity), true
+		return e.complexity.Store.Slug(childComplexity), true
 
-	case "PackageItem.updatedAt":
-		if e.complexity.PackageItem.UpdatedAt == nil {
+	case "Store.updatedAt":
+		if e.complexity.Store.UpdatedAt == nil {
 			break
 		}
 
-		return e.complexity.PackageItem.UpdatedAt(childComplexity), true
+		return e.complexity.Store.UpdatedAt(childComplexity), true
 
-	case "PackageItem.variantId":
-		if e.complexity.PackageItem.VariantID == nil {
+	case "Store.vacation":
+		if e.complexity.Store.Vacation == nil {
 			break
 		}
 
-		return e.complexity.PackageItem.VariantID(childComplexity), true
+		return e.complexity.Store.Vacation(childComplexity), true
 
-	case "PackageListResponse.items":
-		if e.complexity.PackageListResponse.Items == nil {
+	case "StoreOperatingHours.closesAt":
+		if e.complexity.StoreOperatingHours.ClosesAt == nil {
 			break
 		}
 
-		return e.complexity.PackageListResponse.Items(childComplexity), true
+		return e.complexity.StoreOperatingHours.ClosesAt(childComplexity), true
 
-	case "PackageListResponse.pageInfo":
-		if e.complexity.PackageListResponse.PageInfo == nil {
+	case "StoreOperatingHours.opensAt":
+		if e.complexity.StoreOperatingHours.OpensAt == nil {
 			break
 		}
 
-		return e.complexity.PackageListResponse.PageInfo(childComplexity), true
+		return e.complexity.StoreOperatingHours.OpensAt(childComplexity), true
 
-	case "PageInfo.hasNextPage":
-		if e.complexity.PageInfo.HasNextPage == nil {
+	case "StoreOperatingHours.weekday":
+		if e.complexity.StoreOperatingHours.Weekday == nil {
 			break
 		}
 
-		return e.complexity.PageInfo.HasNextPage(childComplexity), true
+		return e.complexity.StoreOperatingHours.Weekday(childComplexity), true
 
-	case "PageInfo.hasPreviousPage":
-		if e.complexity.PageInfo.HasPreviousPage == nil {
+	case "StoreShippingOrigin.addressLine1":
+		if e.complexity.StoreShippingOrigin.AddressLine1 == nil {
 			break
 		}
 
-		return e.complexity.PageInfo.HasPreviousPage(childComplexity), true
+		return e.complexity.StoreShippingOrigin.AddressLine1(childComplexity), true
 
-	case "PageInfo.limit":
-		if e.complexity.PageInfo.Limit == nil {
+	case "StoreShippingOrigin.addressLine2":
+		if e.complexity.StoreShippingOrigin.AddressLine2 == nil {
 			break
 		}
 
-		return e.complexity.PageInfo.Limit(childComplexity), true
+		return e.complexity.StoreShippingOrigin.AddressLine2(childComplexity), true
 
-	case "PageInfo.page":
-		if e.complexity.PageInfo.Page == nil {
+	case "StoreShippingOrigin.city":
+		if e.complexity.StoreShippingOrigin.City == nil {
 			break
 		}
 
-		return e.complexity.PageInfo.Page(childComplexity), true
+		return e.complexity.StoreShippingOrigin.City(childComplexity), true
 
-	case "PageInfo.totalItems":
-		if e.complexity.PageInfo.TotalItems == nil {
+	case "StoreShippingOrigin.contactName":
+		if e.complexity.StoreShippingOrigin.ContactName == nil {
 			break
 		}
 
-		return e.complexity.PageInfo.TotalItems(childComplexity), true
+		return e.complexity.StoreShippingOrigin.ContactName(childComplexity), true
 
-	case "PageInfo.totalPages":
-		if e.complexity.PageInfo.TotalPages == nil {
+	case "StoreShippingOrigin.createdAt":
+		if e.complexity.StoreShippingOrigin.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.PageInfo.TotalPages(childComplexity), true
+		return e.complexity.StoreShippingOrigin.CreatedAt(childComplexity), true
 
-	case "PageInfoCursorStyle.endCursor":
-		if e.complexity.PageInfoCursorStyle.EndCursor == nil {
+	case "StoreShippingOrigin.id":
+		if e.complexity.StoreShippingOrigin.ID == nil {
 			break
 		}
 
-		return e.complexity.PageInfoCursorStyle.EndCursor(childComplexity), true
+		return e.complexity.StoreShippingOrigin.ID(childComplexity), true
 
-	case "PageInfoCursorStyle.hasNextPage":
-		if e.complexity.PageInfoCursorStyle.HasNextPage == nil {
+	case "StoreShippingOrigin.isDefault":
+		if e.complexity.StoreShippingOrigin.IsDefault == nil {
 			break
 		}
 
-		return e.complexity.PageInfoCursorStyle.HasNextPage(childComplexity), true
+		return e.complexity.StoreShippingOrigin.IsDefault(childComplexity), true
 
-	case "PageInfoCursorStyle.hasPreviousPage":
-		if e.complexity.PageInfoCursorStyle.HasPreviousPage == nil {
+	case "StoreShippingOrigin.label":
+		if e.complexity.StoreShippingOrigin.Label == nil {
 			break
 		}
 
-		return e.complexity.PageInfoCursorStyle.HasPreviousPage(childComplexity), true
+		return e.complexity.StoreShippingOrigin.Label(childComplexity), true
 
-	case "PageInfoCursorStyle.startCursor":
-		if e.complexity.PageInfoCursorStyle.StartCursor == nil {
+	case "StoreShippingOrigin.location":
+		if e.complexity.StoreShippingOrigin.Location == nil {
 			break
 		}
 
-		return e.complexity.PageInfoCursorStyle.StartCursor(childComplexity), true
+		return e.complexity.StoreShippingOrigin.Location(childComplexity), true
 
-	case "PageInfoOrder.hasNextPage":
-		if e.complexity.PageInfoOrder.HasNextPage == nil {
+	case "StoreShippingOrigin.phone":
+		if e.complexity.StoreShippingOrigin.Phone == nil {
 			break
 		}
 
-		return e.complexity.PageInfoOrder.HasNextPage(childComplexity), true
+		return e.complexity.StoreShippingOrigin.Phone(childComplexity), true
 
-	case "PageInfoOrder.hasPreviousPage":
-		if e.complexity.PageInfoOrder.HasPreviousPage == nil {
+	case "StoreShippingOrigin.postalCode":
+		if e.complexity.StoreShippingOrigin.PostalCode == nil {
 			break
 		}
 
-		return e.complexity.PageInfoOrder.HasPreviousPage(childComplexity), true
+		return e.complexity.StoreShippingOrigin.PostalCode(childComplexity), true
 
-	case "PageInfoOrder.limit":
-		if e.complexity.PageInfoOrder.Limit == nil {
+	case "StoreShippingOrigin.province":
+		if e.complexity.StoreShippingOrigin.Province == nil {
 			break
 		}
 
-		return e.complexity.PageInfoOrder.Limit(childComplexity), true
+		return e.complexity.StoreShippingOrigin.Province(childComplexity), true
 
-	case "PageInfoOrder.page":
-		if e.complexity.PageInfoOrder.Page == nil {
+	case "StoreShippingOrigin.updatedAt":
+		if e.complexity.StoreShippingOrigin.UpdatedAt == nil {
 			break
 		}
 
-		return e.complexity.PageInfoOrder.Page(childComplexity), true
+		return e.complexity.StoreShippingOrigin.UpdatedAt(childComplexity), true
 
-	case "PageInfoOrder.totalItems":
-		if e.complexity.PageInfoOrder.TotalItems == nil {
+	case "StoreVacation.createdAt":
+		if e.complexity.StoreVacation.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.PageInfoOrder.TotalItems(childComplexity), true
+		return e.complexity.StoreVacation.CreatedAt(childComplexity), true
 
-	case "PageInfoOrder.totalPages":
-		if e.complexity.PageInfoOrder.TotalPages == nil {
+	case "StoreVacation.endsAt":
+		if e.complexity.StoreVacation.EndsAt == nil {
 			break
 		}
 
-		return e.complexity.PageInfoOrder.TotalPages(childComplexity), true
+		return e.complexity.StoreVacation.EndsAt(childComplexity), true
 
-	case "Payment.provider":
-		if e.complexity.Payment.Provider == nil {
+	case "StoreVacation.id":
+		if e.complexity.StoreVacation.ID == nil {
 			break
 		}
 
-		return e.complexity.Payment.Provider(childComplexity), true
+		return e.complexity.StoreVacation.ID(childComplexity), true
 
-	case "Payment.status":
-		if e.complexity.Payment.Status == nil {
+	case "StoreVacation.message":
+		if e.complexity.StoreVacation.Message == nil {
 			break
 		}
 
-		return e.complexity.Payment.Status(childComplexity), true
+		return e.complexity.StoreVacation.Message(childComplexity), true
 
-	case "Payment.url":
-		if e.complexity.Payment.URL == nil {
+	case "StoreVacation.mode":
+		if e.complexity.StoreVacation.Mode == nil {
 			break
 		}
 
-		return e.complexity.Payment.URL(childComplexity), true
+		return e.complexity.StoreVacation.Mode(childComplexity), true
 
-	case "PaymentDetail.bank":
-		if e.complexity.PaymentDetail.Bank == nil {
+	case "StoreVacation.startsAt":
+		if e.complexity.StoreVacation.StartsAt == nil {
 			break
 		}
 
-		return e.complexity.PaymentDetail.Bank(childComplexity), true
+		return e.complexity.StoreVacation.StartsAt(childComplexity), true
 
-	case "PaymentDetail.instructions":
-		if e.complexity.PaymentDetail.Instructions == nil {
+	case "StuckOrder.createdAt":
+		if e.complexity.StuckOrder.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.PaymentDetail.Instructions(childComplexity), true
+		return e.complexity.StuckOrder.CreatedAt(childComplexity), true
 
-	case "PaymentDetail.invoiceURL":
-		if e.complexity.PaymentDetail.InvoiceURL == nil {
+	case "StuckOrder.externalId":
+		if e.complexity.StuckOrder.ExternalID == nil {
 			break
 		}
 
-		return e.complexity.PaymentDetail.InvoiceURL(childComplexity), true
+		return e.complexity.StuckOrder.ExternalID(childComplexity), true
 
-	case "PaymentDetail.method":
-		if e.complexity.PaymentDetail.Method == nil {
+	case "StuckOrder.id":
+		if e.complexity.StuckOrder.ID == nil {
 			break
 		}
 
-		return e.complexity.PaymentDetail.Method(childComplexity), true
+		return e.complexity.StuckOrder.ID(childComplexity), true
 
-	case "PaymentDetail.paymentCode":
-		if e.complexity.PaymentDetail.PaymentCode == nil {
+	case "StuckOrder.totalAmount":
+		if e.complexity.StuckOrder.TotalAmount == nil {
 			break
 		}
 
-		return e.complexity.PaymentDetail.PaymentCode(childComplexity), true
+		return e.complexity.StuckOrder.TotalAmount(childComplexity), true
 
-	case "PaymentDetail.referenceId":
-		if e.complexity.PaymentDetail.ReferenceID == nil {
+	case "StuckOrder.userId":
+		if e.complexity.StuckOrder.UserID == nil {
 			break
 		}
 
-		return e.complexity.PaymentDetail.ReferenceID(childComplexity), true
+		return e.complexity.StuckOrder.UserID(childComplexity), true
 
-	case "PaymentOrderInfoResponse.currency":
-		if e.complexity.PaymentOrderInfoResponse.Currency == nil {
+	case "Subcategory.categoryID":
+		if e.complexity.Subcategory.CategoryID == nil {
 			break
 		}
 
-		return e.complexity.PaymentOrderInfoResponse.Currency(childComplexity), true
+		return e.complexity.Subcategory.CategoryID(childComplexity), true
 
-	case "PaymentOrderInfoResponse.expiresAt":
-		if e.complexity.PaymentOrderInfoResponse.ExpiresAt == nil {
+	case "Subcategory.id":
+		if e.complexity.Subcategory.ID == nil {
 			break
 		}
 
-		return e.complexity.PaymentOrderInfoResponse.ExpiresAt(childComplexity), true
+		return e.complexity.Subcategory.ID(childComplexity), true
 
-	case "PaymentOrderInfoResponse.orderExternalID":
-		if e.complexity.PaymentOrderInfoResponse.OrderExternalID == nil {
+	case "Subcategory.name":
+		if e.complexity.Subcategory.Name == nil {
 			break
 		}
 
-		return e.complexity.PaymentOrderInfoResponse.OrderExternalID(childComplexity), true
+		return e.complexity.Subcategory.Name(childComplexity), true
 
-	case "PaymentOrderInfoResponse.payment":
-		if e.complexity.PaymentOrderInfoResponse.Payment == nil {
+	case "SubcategoryConnection.items":
+		if e.complexity.SubcategoryConnection.Items == nil {
 			break
 		}
 
-		return e.complexity.PaymentOrderInfoResponse.Payment(childComplexity), true
+		return e.complexity.SubcategoryConnection.Items(childComplexity), true
 
-	case "PaymentOrderInfoResponse.shippingAddress":
-		if e.complexity.PaymentOrderInfoResponse.ShippingAddress == nil {
+	case "SubcategoryConnection.pageInfo":
+		if e.complexity.SubcategoryConnection.PageInfo == nil {
 			break
 		}
 
-		return e.complexity.PaymentOrderInfoResponse.ShippingAddress(childComplexity), true
+		return e.complexity.SubcategoryConnection.PageInfo(childComplexity), true
 
-	case "PaymentOrderInfoResponse.status":
-		if e.complexity.PaymentOrderInfoResponse.Status == nil {
+	case "SyntheticCheckoutRun.durationMs":
+		if e.complexity.SyntheticCheckoutRun.DurationMs == nil {
 			break
 		}
 
-		return e.complexity.PaymentOrderInfoResponse.Status(childComplexity), true
+		return e.complexity.SyntheticCheckoutRun.DurationMs(childComplexity), true
 
-	case "PaymentOrderInfoResponse.totalAmount":
-		if e.complexity.PaymentOrderInfoResponse.TotalAmount == nil {
+	case "SyntheticCheckoutRun.error":
+		if e.complexity.SyntheticCheckoutRun.Error == nil {
 			break
 		}
 
-		return e.complexity.PaymentOrderInfoResponse.TotalAmount(childComplexity), true
+		return e.complexity.SyntheticCheckoutRun.Error(childComplexity), true
 
-	case "Product.categoryID":
-		if e.complexity.Product.CategoryID == nil {
+	case "SyntheticCheckoutRun.failedStep":
+		if e.complexity.SyntheticCheckoutRun.FailedStep == nil {
 			break
 		}
 
-		return e.complexity.Product.CategoryID(childComplexity), true
+		return e.complexity.SyntheticCheckoutRun.FailedStep(childComplexity), true
 
-	case "Product.categoryName":
-		if e.complexity.Product.CategoryName == nil {
+	case "SyntheticCheckoutRun.id":
+		if e.complexity.SyntheticCheckoutRun.ID == nil {
 			break
 		}
 
-		return e.complexity.Product.CategoryName(childComplexity), true
+		return e.complexity.SyntheticCheckoutRun.ID(childComplexity), true
 
-	case "Product.createdAt":
-		if e.complexity.Product.CreatedAt == nil {
+	case "SyntheticCheckoutRun.orderExternalId":
+		if e.complexity.SyntheticCheckoutRun.OrderExternalID == nil {
 			break
 		}
 
-		return e.complexity.Product.CreatedAt(childComplexity), true
+		return e.complexity.SyntheticCheckoutRun.OrderExternalID(childComplexity), true
 
-	case "Product.description":
-		if e.complexity.Product.Description == nil {
+	case "SyntheticCheckoutRun.passed":
+		if e.complexity.SyntheticCheckoutRun.Passed == nil {
 			break
 		}
 
-		return e.complexity.Product.Description(childComplexity), true
+		return e.complexity.SyntheticCheckoutRun.Passed(childComplexity), true
 
-	case "Product.id":
-		if e.complexity.Product.ID == nil {
+	case "SyntheticCheckoutRun.startedAt":
+		if e.complexity.SyntheticCheckoutRun.StartedAt == nil {
 			break
 		}
 
-		return e.complexity.Product.ID(childComplexity), true
+		return e.complexity.SyntheticCheckoutRun.StartedAt(childComplexity), true
 
-	case "Product.imageUrl":
-		if e.complexity.Product.ImageURL == nil {
+	case "SyntheticCheckoutRun.steps":
+		if e.complexity.SyntheticCheckoutRun.Steps == nil {
 			break
 		}
 
-		return e.complexity.Product.ImageURL(childComplexity), true
+		return e.complexity.SyntheticCheckoutRun.Steps(childComplexity), true
 
-	case "Product.name":
-		if e.complexity.Product.Name == nil {
+	case "SyntheticCheckoutRun.triggeredBy":
+		if e.complexity.SyntheticCheckoutRun.TriggeredBy == nil {
 			break
 		}
 
-		return e.complexity.Product.Name(childComplexity), true
+		return e.complexity.SyntheticCheckoutRun.TriggeredBy(childComplexity), true
 
-	case "Product.sellerId":
-		if e.complexity.Product.SellerID == nil {
+	case "SyntheticCheckoutStep.durationMs":
+		if e.complexity.SyntheticCheckoutStep.DurationMs == nil {
 			break
 		}
 
-		return e.complexity.Product.SellerID(childComplexity), true
+		return e.complexity.SyntheticCheckoutStep.DurationMs(childComplexity), true
 
-	case "Product.sellerName":
-		if e.complexity.Product.SellerName == nil {
+	case "SyntheticCheckoutStep.error":
+		if e.complexity.SyntheticCheckoutStep.Error == nil {
 			break
 		}
 
-		return e.complexity.Product.SellerName(childComplexity), true
+		return e.complexity.SyntheticCheckoutStep.Error(childComplexity), true
 
-	case "Product.slug":
-		if e.complexity.Product.Slug == nil {
+	case "SyntheticCheckoutStep.name":
+		if e.complexity.SyntheticCheckoutStep.Name == nil {
 			break
 		}
 
-		return e.complexity.Product.Slug(childComplexity), true
+		return e.complexity.SyntheticCheckoutStep.Name(childComplexity), true
 
-	case "Product.status":
-		if e.complexity.Product.Status == nil {
+	case "UnitPrice.amount":
+		if e.complexity.UnitPrice.Amount == nil {
 			break
 		}
 
-		return e.complexity.Product.Status(childComplexity), true
+		return e.complexity.UnitPrice.Amount(childComplexity), true
 
-	case "Product.subcategoryID":
-		if e.complexity.Product.SubcategoryID == nil {
+	case "UnitPrice.per":
+		if e.complexity.UnitPrice.Per == nil {
 			break
 		}
 
-		return e.complexity.Product.SubcategoryID(childComplexity), true
+		return e.complexity.UnitPrice.Per(childComplexity), true
 
-	case "Product.subcategoryName":
-		if e.complexity.Product.SubcategoryName == nil {
+	case "UnitPrice.unit":
+		if e.complexity.UnitPrice.Unit == nil {
 			break
 		}
 
-		return e.complexity.Product.SubcategoryName(childComplexity), true
+		return e.complexity.UnitPrice.Unit(childComplexity), true
 
-	case "Product.updatedAt":
-		if e.complexity.Product.UpdatedAt == nil {
+	case "UnpaidConfirmedSession.confirmedAt":
+		if e.complexity.UnpaidConfirmedSession.ConfirmedAt == nil {
 			break
 		}
 
-		return e.complexity.Product.UpdatedAt(childComplexity), true
+		return e.complexity.UnpaidConfirmedSession.ConfirmedAt(childComplexity), true
 
-	case "Product.variants":
-		if e.complexity.Product.Variants == nil {
+	case "UnpaidConfirmedSession.orderExternalId":
+		if e.complexity.UnpaidConfirmedSession.OrderExternalID == nil {
 			break
 		}
 
-		return e.complexity.Product.Variants(childComplexity), true
+		return e.complexity.UnpaidConfirmedSession.OrderExternalID(childComplexity), true
 
-	case "ProductByCategory.categoryName":
-		if e.complexity.ProductByCategory.CategoryName == nil {
+	case "UnpaidConfirmedSession.sessionExternalId":
+		if e.complexity.UnpaidConfirmedSession.SessionExternalID == nil {
 			break
 		}
 
-		return e.complexity.ProductByCategory.CategoryName(childComplexity), true
+		return e.complexity.UnpaidConfirmedSession.SessionExternalID(childComplexity), true
 
-	case "ProductByCategory.categorySlug":
-		if e.complexity.ProductByCategory.CategorySlug == nil {
+	case "UnpaidConfirmedSession.totalPrice":
+		if e.complexity.UnpaidConfirmedSession.TotalPrice == nil {
 			break
 		}
 
-		return e.complexity.ProductByCategory.CategorySlug(childComplexity), true
+		return e.complexity.UnpaidConfirmedSession.TotalPrice(childComplexity), true
 
-	case "ProductByCategory.products":
-		if e.complexity.ProductByCategory.Products == nil {
+	case "UnpaidConfirmedSession.userId":
+		if e.complexity.UnpaidConfirmedSession.UserID == nil {
 			break
 		}
 
-		return e.complexity.ProductByCategory.Products(childComplexity), true
+		return e.complexity.UnpaidConfirmedSession.UserID(childComplexity), true
 
-	case "ProductByCategory.totalProducts":
-		if e.complexity.ProductByCategory.TotalProducts == nil {
+	case "UnusedIndex.index":
+		if e.complexity.UnusedIndex.Index == nil {
 			break
 		}
 
-		return e.complexity.ProductByCategory.TotalProducts(childComplexity), true
+		return e.complexity.UnusedIndex.Index(childComplexity), true
 
-	case "ProductCart.categoryID":
-		if e.complexity.ProductCart.CategoryID == nil {
+	case "UnusedIndex.sizeKb":
+		if e.complexity.UnusedIndex.SizeKb == nil {
 			break
 		}
 
-		return e.complexity.ProductCart.CategoryID(childComplexity), true
+		return e.complexity.UnusedIndex.SizeKb(childComplexity), true
 
-	case "ProductCart.categoryName":
-		if e.complexity.ProductCart.CategoryName == nil {
+	case "UnusedIndex.table":
+		if e.complexity.UnusedIndex.Table == nil {
 			break
 		}
 
-		return e.complexity.ProductCart.CategoryName(childComplexity), true
+		return e.complexity.UnusedIndex.Table(childComplexity), true
 
-	case "ProductCart.createdAt":
-		if e.complexity.ProductCart.CreatedAt == nil {
+	case "UpdateAddressResponse.address":
+		if e.complexity.UpdateAddressResponse.Address == nil {
 			break
 		}
 
-		return e.complexity.ProductCart.CreatedAt(childComplexity), true
+		return e.complexity.UpdateAddressResponse.Address(childComplexity), true
 
-	case "ProductCart.description":
-		if e.complexity.ProductCart.Description == nil {
+	case "UpdateSessionAddressResponse.success":
+		if e.complexity.UpdateSessionAddressResponse.Success == nil {
 			break
 		}
 
-		return e.complexity.ProductCart.Description(childComplexity), true
+		return e.complexity.UpdateSessionAddressResponse.Success(childComplexity), true
 
-	case "ProductCart.id":
-		if e.complexity.ProductCart.ID == nil {
+	case "UpdateSessionPaymentMethodResponse.success":
+		if e.complexity.UpdateSessionPaymentMethodResponse.Success == nil {
 			break
 		}
 
-		return e.complexity.ProductCart.ID(childComplexity), true
+		return e.complexity.UpdateSessionPaymentMethodResponse.Success(childComplexity), true
 
-	case "ProductCart.imageUrl":
-		if e.complexity.ProductCart.ImageURL == nil {
+	case "UploadedFile.contentType":
+		if e.complexity.UploadedFile.ContentType == nil {
 			break
 		}
 
-		return e.complexity.ProductCart.ImageURL(childComplexity), true
+		return e.complexity.UploadedFile.ContentType(childComplexity), true
 
-	case "ProductCart.name":
-		if e.complexity.ProductCart.Name == nil {
+	case "UploadedFile.createdAt":
+		if e.complexity.UploadedFile.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.ProductCart.Name(childComplexity), true
+		return e.complexity.UploadedFile.CreatedAt(childComplexity), true
 
-	case "ProductCart.sellerId":
-		if e.complexity.ProductCart.SellerID == nil {
+	case "UploadedFile.id":
+		if e.complexity.UploadedFile.ID == nil {
 			break
 		}
 
-		return e.complexity.ProductCart.SellerID(childComplexity), true
+		return e.complexity.UploadedFile.ID(childComplexity), true
 
-	case "ProductCart.sellerName":
-		if e.complexity.ProductCart.SellerName == nil {
+	case "UploadedFile.originalName":
+		if e.complexity.UploadedFile.OriginalName == nil {
 			break
 		}
 
-		return e.complexity.ProductCart.SellerName(childComplexity), true
+		return e.complexity.UploadedFile.OriginalName(childComplexity), true
 
-	case "ProductCart.slug":
-		if e.complexity.ProductCart.Slug == nil {
+	case "UploadedFile.purpose":
+		if e.complexity.UploadedFile.Purpose == nil {
 			break
 		}
 
-		return e.complexity.ProductCart.Slug(childComplexity), true
+		return e.complexity.UploadedFile.Purpose(childComplexity), true
 
-	case "ProductCart.status":
-		if e.complexity.ProductCart.Status == nil {
+	case "UploadedFile.size":
+		if e.complexity.UploadedFile.Size == nil {
 			break
 		}
 
-		return e.complexity.ProductCart.Status(childComplexity), true
+		return e.complexity.UploadedFile.Size(childComplexity), true
 
-	case "ProductCart.subcategoryID":
-		if e.complexity.ProductCart.SubcategoryID == nil {
+	case "UploadedFile.url":
+		if e.complexity.UploadedFile.URL == nil {
 			break
 		}
 
-		return e.complexity.ProductCart.SubcategoryID(childComplexity), true
+		return e.complexity.UploadedFile.URL(childComplexity), true
 
-	case "ProductCart.subcategoryName":
-		if e.complexity.ProductCart.SubcategoryName == nil {
+	case "UsageFlag.baseline":
+		if e.complexity.UsageFlag.Baseline == nil {
 			break
 		}
 
-		return e.complexity.ProductCart.SubcategoryName(childComplexity), true
+		return e.complexity.UsageFlag.Baseline(childComplexity), true
 
-	case "ProductCart.updatedAt":
-		if e.complexity.ProductCart.UpdatedAt == nil {
+	case "UsageFlag.count":
+		if e.complexity.UsageFlag.Count == nil {
 			break
 		}
 
-		return e.complexity.ProductCart.UpdatedAt(childComplexity), true
+		return e.complexity.UsageFlag.Count(childComplexity), true
 
-	case "ProductCart.variant":
-		if e.complexity.ProductCart.Variant == nil {
+	case "UsageFlag.createdAt":
+		if e.complexity.UsageFlag.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.ProductCart.Variant(childComplexity), true
+		return e.complexity.UsageFlag.CreatedAt(childComplexity), true
 
-	case "ProductConnection.edges":
-		if e.complexity.ProductConnection.Edges == nil {
+	case "UsageFlag.day":
+		if e.complexity.UsageFlag.Day == nil {
 			break
 		}
 
-		return e.complexity.ProductConnection.Edges(childComplexity), true
+		return e.complexity.UsageFlag.Day(childComplexity), true
 
-	case "ProductConnection.pageInfo":
-		if e.complexity.ProductConnection.PageInfo == nil {
+	case "UsageFlag.id":
+		if e.complexity.UsageFlag.ID == nil {
 			break
 		}
 
-		return e.complexity.ProductConnection.PageInfo(childComplexity), true
+		return e.complexity.UsageFlag.ID(childComplexity), true
 
-	case "ProductConnection.totalCount":
-		if e.complexity.ProductConnection.TotalCount == nil {
+	case "UsageFlag.operation":
+		if e.complexity.UsageFlag.Operation == nil {
 			break
 		}
 
-		return e.complexity.ProductConnection.TotalCount(childComplexity), true
+		return e.complexity.UsageFlag.Operation(childComplexity), true
 
-	case "ProductEdge.cursor":
-		if e.complexity.ProductEdge.Cursor == nil {
+	case "UsageFlag.reason":
+		if e.complexity.UsageFlag.Reason == nil {
 			break
 		}
 
-		return e.complexity.ProductEdge.Cursor(childComplexity), true
+		return e.complexity.UsageFlag.Reason(childComplexity), true
 
-	case "ProductEdge.node":
-		if e.complexity.ProductEdge.Node == nil {
+	case "UsageFlag.userId":
+		if e.complexity.UsageFlag.UserID == nil {
 			break
 		}
 
-		return e.complexity.ProductEdge.Node(childComplexity), true
+		return e.complexity.UsageFlag.UserID(childComplexity), true
 
-	case "ProductPage.hasNext":
-		if e.complexity.ProductPage.HasNext == nil {
+	case "User.email":
+		if e.complexity.User.Email == nil {
 			break
 		}
 
-		return e.complexity.ProductPage.HasNext(childComplexity), true
+		return e.complexity.User.Email(childComplexity), true
 
-	case "ProductPage.items":
-		if e.complexity.ProductPage.Items == nil {
+	case "User.id":
+		if e.complexity.User.ID == nil {
 			break
 		}
 
-		return e.complexity.ProductPage.Items(childComplexity), true
+		return e.complexity.User.ID(childComplexity), true
 
-	case "ProductPage.limit":
-		if e.complexity.ProductPage.Limit == nil {
+	case "User.role":
+		if e.complexity.User.Role == nil {
 			break
 		}
 
-		return e.complexity.ProductPage.Limit(childComplexity), true
+		return e.complexity.User.Role(childComplexity), true
 
-	case "ProductPage.page":
-		if e.complexity.ProductPage.Page == nil {
+	case "UserPreferences.locale":
+		if e.complexity.UserPreferences.Locale == nil {
 			break
 		}
 
-		return e.complexity.ProductPage.Page(childComplexity), true
+		return e.complexity.UserPreferences.Locale(childComplexity), true
 
-	case "ProductPage.totalCount":
-		if e.complexity.ProductPage.TotalCount == nil {
+	case "UserPreferences.notifications":
+		if e.complexity.UserPreferences.Notifications == nil {
 			break
 		}
 
-		return e.complexity.ProductPage.TotalCount(childComplexity), true
+		return e.complexity.UserPreferences.Notifications(childComplexity), true
 
-	case "ProductPage.totalPages":
-		if e.complexity.ProductPage.TotalPages == nil {
+	case "UserPreferences.updatedAt":
+		if e.complexity.UserPreferences.UpdatedAt == nil {
 			break
 		}
 
-		return e.complexity.ProductPage.TotalPages(childComplexity), true
+		return e.complexity.UserPreferences.UpdatedAt(childComplexity), true
 
-	case "Profile.avatarUrl":
-		if e.complexity.Profile.AvatarURL == nil {
+	case "UserRef.id":
+		if e.complexity.UserRef.ID == nil {
 			break
 		}
 
-		return e.complexity.Profile.AvatarURL(childComplexity), true
+		return e.complexity.UserRef.ID(childComplexity), true
 
-	case "Profile.bio":
-		if e.complexity.Profile.Bio == nil {
+	case "Variant.categoryID":
+		if e.complexity.Variant.CategoryID == nil {
 			break
 		}
 
-		return e.complexity.Profile.Bio(childComplexity), true
+		return e.complexity.Variant.CategoryID(childComplexity), true
 
-	case "Profile.createdAt":
-		if e.complexity.Profile.CreatedAt == nil {
+	case "Variant.compareAtPrice":
+		if e.complexity.Variant.CompareAtPrice == nil {
 			break
 		}
 
-		return e.complexity.Profile.CreatedAt(childComplexity), true
+		return e.complexity.Variant.CompareAtPrice(childComplexity), true
 
-	case "Profile.dateOfBirth":
-		if e.complexity.Profile.DateOfBirth == nil {
+	case "Variant.createdAt":
+		if e.complexity.Variant.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.Profile.DateOfBirth(childComplexity), true
+		return e.complexity.Variant.CreatedAt(childComplexity), true
 
-	case "Profile.email":
-		if e.complexity.Profile.Email == nil {
+	case "Variant.description":
+		if e.complexity.Variant.Description == nil {
 			break
 		}
 
-		return e.complexity.Profile.Email(childComplexity), true
+		return e.complexity.Variant.Description(childComplexity), true
 
-	case "Profile.fullName":
-		if e.complexity.Profile.FullName == nil {
+	case "Variant.discountPercent":
+		if e.complexity.Variant.DiscountPercent == nil {
 			break
 		}
 
-		return e.complexity.Profile.FullName(childComplexity), true
+		return e.complexity.Variant.DiscountPercent(childComplexity), true
 
-	case "Profile.id":
-		if e.complexity.Profile.ID == nil {
+	case "Variant.heightCm":
+		if e.complexity.Variant.HeightCm == nil {
 			break
 		}
 
-		return e.complexity.Profile.ID(childComplexity), true
+		return e.complexity.Variant.HeightCm(childComplexity), true
 
-	case "Profile.phone":
-		if e.complexity.Profile.Phone == nil {
+	case "Variant.id":
+		if e.complexity.Variant.ID == nil {
 			break
 		}
 
-		return e.complexity.Profile.Phone(childComplexity), true
+		return e.complexity.Variant.ID(childComplexity), true
 
-	case "Profile.updatedAt":
-		if e.complexity.Profile.UpdatedAt == nil {
+	case "Variant.imageUrl":
+		if e.complexity.Variant.ImageURL == nil {
 			break
 		}
 
-		return e.complexity.Profile.UpdatedAt(childComplexity), true
+		return e.complexity.Variant.ImageURL(childComplexity), true
 
-	case "Profile.userId":
-		if e.complexity.Profile.UserID == nil {
+	case "Variant.lengthCm":
+		if e.complexity.Variant.LengthCm == nil {
 			break
 		}
 
-		return e.complexity.Profile.UserID(childComplexity), true
+		return e.complexity.Variant.LengthCm(childComplexity), true
 
-	case "Query.address":
-		if e.complexity.Query.Address == nil {
+	case "Variant.name":
+		if e.complexity.Variant.Name == nil {
 			break
 		}
 
-		args, err := ec.field_Query_address_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Variant.Name(childComplexity), true
+
+	case "Variant.price":
+		if e.complexity.Variant.Price == nil {
+			break
 		}
 
-		return e.complexity.Query.Address(childComplexity, args["addressId"].(string)), true
+		return e.complexity.Variant.Price(childComplexity), true
 
-	case "Query.addresses":
-		if e.complexity.Query.Addresses == nil {
+	case "Variant.productId":
+		if e.complexity.Variant.ProductID == nil {
 			break
 		}
 
-		return e.complexity.Query.Addresses(childComplexity), true
+		return e.complexity.Variant.ProductID(childComplexity), true
 
-	case "Query.category":
-		if e.complexity.Query.Category == nil {
+	case "Variant.quantityType":
+		if e.complexity.Variant.QuantityType == nil {
 			break
 		}
 
-		args, err := ec.field_Query_category_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Variant.QuantityType(childComplexity), true
+
+	case "Variant.sellerId":
+		if e.complexity.Variant.SellerID == nil {
+			break
 		}
 
-		return e.complexity.Query.Category(childComplexity, args["filter"].(*string), args["limit"].(*int32), args["page"].(*int32)), true
+		return e.complexity.Variant.SellerID(childComplexity), true
 
-	case "Query.checkoutSession":
-		if e.complexity.Query.CheckoutSession == nil {
+	case "Variant.stock":
+		if e.complexity.Variant.Stock == nil {
 			break
 		}
 
-		args, err := ec.field_Query_checkoutSession_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Variant.Stock(childComplexity), true
+
+	case "Variant.unitPrice":
+		if e.complexity.Variant.UnitPrice == nil {
+			break
 		}
 
-		return e.complexity.Query.CheckoutSession(childComplexity, args["externalId"].(string)), true
+		return e.complexity.Variant.UnitPrice(childComplexity), true
 
-	case "Query.myCart":
-		if e.complexity.Query.MyCart == nil {
+	case "Variant.weightGrams":
+		if e.complexity.Variant.WeightGrams == nil {
 			break
 		}
 
-		args, err := ec.field_Query_myCart_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Variant.WeightGrams(childComplexity), true
+
+	case "Variant.widthCm":
+		if e.complexity.Variant.WidthCm == nil {
+			break
 		}
 
-		return e.complexity.Query.MyCart(childComplexity, args["filter"].(*model.CartFilterInput), args["sort"].(*model.CartSortInput), args["limit"].(*int32), args["page"].(*int32)), true
+		return e.complexity.Variant.WidthCm(childComplexity), true
 
-	case "Query.myCartCount":
-		if e.complexity.Query.MyCartCount == nil {
+	case "VariantRef.id":
+		if e.complexity.VariantRef.ID == nil {
 			break
 		}
 
-		return e.complexity.Query.MyCartCount(childComplexity), true
+		return e.complexity.VariantRef.ID(childComplexity), true
 
-	case "Query.myProfile":
-		if e.complexity.Query.MyProfile == nil {
+	case "VariantRef.imageUrl":
+		if e.complexity.VariantRef.ImageURL == nil {
 			break
 		}
 
-		return e.complexity.Query.MyProfile(childComplexity), true
+		return e.complexity.VariantRef.ImageURL(childComplexity), true
 
-	case "Query.orderDetail":
-		if e.complexity.Query.OrderDetail == nil {
+	case "VariantRef.name":
+		if e.complexity.VariantRef.Name == nil {
 			break
 		}
 
-		args, err := ec.field_Query_orderDetail_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.VariantRef.Name(childComplexity), true
+
+	case "VariantRef.productName":
+		if e.complexity.VariantRef.ProductName == nil {
+			break
 		}
 
-		return e.complexity.Query.OrderDetail(childComplexity, args["orderId"].(string)), true
+		return e.complexity.VariantRef.ProductName(childComplexity), true
 
-	case "Query.orderDetailByExternalId":
-		if e.complexity.Query.OrderDetailByExternalID == nil {
+	case "VoucherCampaign.createdAt":
+		if e.complexity.VoucherCampaign.CreatedAt == nil {
 			break
 		}
 
-		args, err := ec.field_Query_orderDetailByExternalId_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.VoucherCampaign.CreatedAt(childComplexity), true
+
+	case "VoucherCampaign.description":
+		if e.complexity.VoucherCampaign.Description == nil {
+			break
 		}
 
-		return e.complexity.Query.OrderDetailByExternalID(childComplexity, args["externalId"].(string)), true
+		return e.complexity.VoucherCampaign.Description(childComplexity), true
 
-	case "Query.orderList":
-		if e.complexity.Query.OrderList == nil {
+	case "VoucherCampaign.endsAt":
+		if e.complexity.VoucherCampaign.EndsAt == nil {
 			break
 		}
 
-		args, err := ec.field_Query_orderList_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.VoucherCampaign.EndsAt(childComplexity), true
+
+	case "VoucherCampaign.id":
+		if e.complexity.VoucherCampaign.ID == nil {
+			break
 		}
 
-		return e.complexity.Query.OrderList(childComplexity, args["filter"].(*model.OrderFilterInput), args["sort"].(*model.OrderSortInput), args["pagination"].(*model.PaginationInput)), true
+		return e.complexity.VoucherCampaign.ID(childComplexity), true
 
-	case "Query.packages":
-		if e.complexity.Query.Packages == nil {
+	case "VoucherCampaign.name":
+		if e.complexity.VoucherCampaign.Name == nil {
 			break
 		}
 
-		args, err := ec.field_Query_packages_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.VoucherCampaign.Name(childComplexity), true
+
+	case "VoucherCampaign.startsAt":
+		if e.complexity.VoucherCampaign.StartsAt == nil {
+			break
 		}
 
-		return e.complexity.Query.Packages(childComplexity, args["filter"].(*model.PackageFilterInput), args["sort"].(*model.PackageSortInput), args["limit"].(*int32), args["page"].(*int32)), true
+		return e.complexity.VoucherCampaign.StartsAt(childComplexity), true
 
-	case "Query.paymentOrderInfo":
-		if e.complexity.Query.PaymentOrderInfo == nil {
+	case "Wallet.balance":
+		if e.complexity.Wallet.Balance == nil {
 			break
 		}
 
-		args, err := ec.field_Query_paymentOrderInfo_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Wallet.Balance(childComplexity), true
+
+	case "Wallet.currency":
+		if e.complexity.Wallet.Currency == nil {
+			break
 		}
 
-		return e.complexity.Query.PaymentOrderInfo(childComplexity, args["externalId"].(string)), true
+		return e.complexity.Wallet.Currency(childComplexity), true
 
-	case "Query.productDetail":
-		if e.complexity.Query.ProductDetail == nil {
+	case "Wallet.entries":
+		if e.complexity.Wallet.Entries == nil {
 			break
 		}
 
-		args, err := ec.field_Query_productDetail_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.Wallet.Entries(childComplexity), true
+
+	case "WalletLedgerEntry.amount":
+		if e.complexity.WalletLedgerEntry.Amount == nil {
+			break
 		}
 
-		return e.complexity.Query.ProductDetail(childComplexity, args["productId"].(string)), true
+		return e.complexity.WalletLedgerEntry.Amount(childComplexity), true
 
-	case "Query.productList":
-		if e.complexity.Query.ProductList == nil {
+	case "WalletLedgerEntry.balanceAfter":
+		if e.complexity.WalletLedgerEntry.BalanceAfter == nil {
 			break
 		}
 
-		args, err := ec.field_Query_productList_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.WalletLedgerEntry.BalanceAfter(childComplexity), true
+
+	case "WalletLedgerEntry.createdAt":
+		if e.complexity.WalletLedgerEntry.CreatedAt == nil {
+			break
 		}
 
-		return e.complexity.Query.ProductList(childComplexity, args["filter"].(*model.ProductFilterInput), args["sort"].(*model.ProductSortInput), args["page"].(*int32), args["limit"].(*int32)), true
+		return e.complexity.WalletLedgerEntry.CreatedAt(childComplexity), true
 
-	case "Query.productsHome":
-		if e.complexity.Query.ProductsHome == nil {
+	case "WalletLedgerEntry.id":
+		if e.complexity.WalletLedgerEntry.ID == nil {
 			break
 		}
 
-		args, err := ec.field_Query_productsHome_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.WalletLedgerEntry.ID(childComplexity), true
+
+	case "WalletLedgerEntry.note":
+		if e.complexity.WalletLedgerEntry.Note == nil {
+			break
 		}
 
-		return e.complexity.Query.ProductsHome(childComplexity, args["filter"].(*model.ProductFilterInput), args["sort"].(*model.ProductSortInput), args["page"].(*int32), args["limit"].(*int32)), true
+		return e.complexity.WalletLedgerEntry.Note(childComplexity), true
 
-	case "Query.subcategory":
-		if e.complexity.Query.Subcategory == nil {
+	case "WalletLedgerEntry.referenceId":
+		if e.complexity.WalletLedgerEntry.ReferenceID == nil {
 			break
 		}
 
-		args, err := ec.field_Query_subcategory_args(ctx, rawArgs)
-		if err != nil {
-			return 0, false
+		return e.complexity.WalletLedgerEntry.ReferenceID(childComplexity), true
+
+	case "WalletLedgerEntry.referenceType":
+		if e.complexity.WalletLedgerEntry.ReferenceType == nil {
+			break
 		}
 
-		return e.complexity.Query.Subcategory(childComplexity, args["filter"].(*string), args["categoryID"].(string), args["limit"].(*int32), args["page"].(*int32)), true
+		return e.complexity.WalletLedgerEntry.ReferenceType(childComplexity), true
 
-	case "ResetPasswordResponse.message":
-		if e.complexity.ResetPasswordResponse.Message == nil {
+	case "WalletLedgerEntry.type":
+		if e.complexity.WalletLedgerEntry.Type == nil {
 			break
 		}
 
-		return e.complexity.ResetPasswordResponse.Message(childComplexity), true
+		return e.complexity.WalletLedgerEntry.Type(childComplexity), true
 
-	case "ResetPasswordResponse.success":
-		if e.complexity.ResetPasswordResponse.Success == nil {
+	case "Warehouse.code":
+		if e.complexity.Warehouse.Code == nil {
 			break
 		}
 
-		return e.complexity.ResetPasswordResponse.Success(childComplexity), true
+		return e.complexity.Warehouse.Code(childComplexity), true
 
-	case "Response.message":
-		if e.complexity.Response.Message == nil {
+	case "Warehouse.createdAt":
+		if e.complexity.Warehouse.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.Response.Message(childComplexity), true
+		return e.complexity.Warehouse.CreatedAt(childComplexity), true
 
-	case "Response.success":
-		if e.complexity.Response.Success == nil {
+	case "Warehouse.id":
+		if e.complexity.Warehouse.ID == nil {
 			break
 		}
 
-		return e.complexity.Response.Success(childComplexity), true
+		return e.complexity.Warehouse.ID(childComplexity), true
 
-	case "ShippingAddress.address1":
-		if e.complexity.ShippingAddress.Address1 == nil {
+	case "Warehouse.isActive":
+		if e.complexity.Warehouse.IsActive == nil {
 			break
 		}
 
-		return e.complexity.ShippingAddress.Address1(childComplexity), true
+		return e.complexity.Warehouse.IsActive(childComplexity), true
 
-	case "ShippingAddress.address2":
-		if e.complexity.ShippingAddress.Address2 == nil {
+	case "Warehouse.isDefault":
+		if e.complexity.Warehouse.IsDefault == nil {
 			break
 		}
 
-		return e.complexity.ShippingAddress.Address2(childComplexity), true
+		return e.complexity.Warehouse.IsDefault(childComplexity), true
 
-	case "ShippingAddress.city":
-		if e.complexity.ShippingAddress.City == nil {
+	case "Warehouse.name":
+		if e.complexity.Warehouse.Name == nil {
 			break
 		}
 
-		return e.complexity.ShippingAddress.City(childComplexity), true
+		return e.complexity.Warehouse.Name(childComplexity), true
 
-	case "ShippingAddress.name":
-		if e.complexity.ShippingAddress.Name == nil {
+	case "Warehouse.region":
+		if e.complexity.Warehouse.Region == nil {
 			break
 		}
 
-		return e.complexity.ShippingAddress.Name(childComplexity), true
+		return e.complexity.Warehouse.Region(childComplexity), true
 
-	case "ShippingAddress.phone":
-		if e.complexity.ShippingAddress.Phone == nil {
+	case "WarehouseStockLevel.inTransit":
+		if e.complexity.WarehouseStockLevel.InTransit == nil {
 			break
 		}
 
-		return e.complexity.ShippingAddress.Phone(childComplexity), true
+		return e.complexity.WarehouseStockLevel.InTransit(childComplexity), true
 
-	case "ShippingAddress.postalCode":
-		if e.complexity.ShippingAddress.PostalCode == nil {
+	case "WarehouseStockLevel.quantity":
+		if e.complexity.WarehouseStockLevel.Quantity == nil {
 			break
 		}
 
-		return e.complexity.ShippingAddress.PostalCode(childComplexity), true
+		return e.complexity.WarehouseStockLevel.Quantity(childComplexity), true
 
-	case "ShippingAddress.province":
-		if e.complexity.ShippingAddress.Province == nil {
+	case "WarehouseStockLevel.variantId":
+		if e.complexity.WarehouseStockLevel.VariantID == nil {
 			break
 		}
 
-		return e.complexity.ShippingAddress.Province(childComplexity), true
+		return e.complexity.WarehouseStockLevel.VariantID(childComplexity), true
 
-	case "ShippingAddress.receiverName":
-		if e.complexity.ShippingAddress.ReceiverName == nil {
+	case "WarehouseStockLevel.warehouseCode":
+		if e.complexity.WarehouseStockLevel.WarehouseCode == nil {
 			break
 		}
 
-		return e.complexity.ShippingAddress.ReceiverName(childComplexity), true
+		return e.complexity.WarehouseStockLevel.WarehouseCode(childComplexity), true
 
-	case "Subcategory.categoryID":
-		if e.complexity.Subcategory.CategoryID == nil {
+	case "WarehouseStockLevel.warehouseId":
+		if e.complexity.WarehouseStockLevel.WarehouseID == nil {
 			break
 		}
 
-		return e.complexity.Subcategory.CategoryID(childComplexity), true
+		return e.complexity.WarehouseStockLevel.WarehouseID(childComplexity), true
 
-	case "Subcategory.id":
-		if e.complexity.Subcategory.ID == nil {
+	case "WebhookHealth.failed":
+		if e.complexity.WebhookHealth.Failed == nil {
 			break
 		}
 
-		return e.complexity.Subcategory.ID(childComplexity), true
+		return e.complexity.WebhookHealth.Failed(childComplexity), true
 
-	case "Subcategory.name":
-		if e.complexity.Subcategory.Name == nil {
+	case "WebhookHealth.oldestPendingAt":
+		if e.complexity.WebhookHealth.OldestPendingAt == nil {
 			break
 		}
 
-		return e.complexity.Subcategory.Name(childComplexity), true
+		return e.complexity.WebhookHealth.OldestPendingAt(childComplexity), true
 
-	case "SubcategoryPage.items":
-		if e.complexity.SubcategoryPage.Items == nil {
+	case "WebhookHealth.pending":
+		if e.complexity.WebhookHealth.Pending == nil {
 			break
 		}
 
-		return e.complexity.SubcategoryPage.Items(childComplexity), true
+		return e.complexity.WebhookHealth.Pending(childComplexity), true
 
-	case "SubcategoryPage.pageInfo":
-		if e.complexity.SubcategoryPage.PageInfo == nil {
+	case "WishlistAlert.createdAt":
+		if e.complexity.WishlistAlert.CreatedAt == nil {
 			break
 		}
 
-		return e.complexity.SubcategoryPage.PageInfo(childComplexity), true
+		return e.complexity.WishlistAlert.CreatedAt(childComplexity), true
 
-	case "UpdateAddressResponse.address":
-		if e.complexity.UpdateAddressResponse.Address == nil {
+	case "WishlistAlert.id":
+		if e.complexity.WishlistAlert.ID == nil {
 			break
 		}
 
-		return e.complexity.UpdateAddressResponse.Address(childComplexity), true
+		return e.complexity.WishlistAlert.ID(childComplexity), true
 
-	case "UpdateSessionAddressResponse.success":
-		if e.complexity.UpdateSessionAddressResponse.Success == nil {
+	case "WishlistAlert.kind":
+		if e.complexity.WishlistAlert.Kind == nil {
 			break
 		}
 
-		return e.complexity.UpdateSessionAddressResponse.Success(childComplexity), true
+		return e.complexity.WishlistAlert.Kind(childComplexity), true
 
-	case "UpdateSessionPaymentMethodResponse.success":
-		if e.complexity.UpdateSessionPaymentMethodResponse.Success == nil {
+	case "WishlistAlert.newPrice":
+		if e.complexity.WishlistAlert.NewPrice == nil {
 			break
 		}
 
-		return e.complexity.UpdateSessionPaymentMethodResponse.Success(childComplexity), true
+		return e.complexity.WishlistAlert.NewPrice(childComplexity), true
 
-	case "User.email":
-		if e.complexity.User.Email == nil {
+	case "WishlistAlert.oldPrice":
+		if e.complexity.WishlistAlert.OldPrice == nil {
 			break
 		}
 
-		return e.complexity.User.Email(childComplexity), true
+		return e.complexity.WishlistAlert.OldPrice(childComplexity), true
 
-	case "User.id":
-		if e.complexity.User.ID == nil {
+	case "WishlistAlert.productId":
+		if e.complexity.WishlistAlert.ProductID == nil {
 			break
 		}
 
-		return e.complexity.User.ID(childComplexity), true
+		return e.complexity.WishlistAlert.ProductID(childComplexity), true
 
-	case "User.role":
-		if e.complexity.User.Role == nil {
+	case "WishlistAlert.productName":
+		if e.complexity.WishlistAlert.ProductName == nil {
 			break
 		}
 
-		return e.complexity.User.Role(childComplexity), true
+		return e.complexity.WishlistAlert.ProductName(childComplexity), true
 
-	case "UserRef.id":
-		if e.complexity.UserRef.ID == nil {
+	case "WishlistAlert.readAt":
+		if e.complexity.WishlistAlert.ReadAt == nil {
 			break
 		}
 
-		return e.complexity.UserRef.ID(childComplexity), true
+		return e.complexity.WishlistAlert.ReadAt(childComplexity), true
 
-	case "Variant.categoryID":
-		if e.complexity.Variant.CategoryID == nil {
+	case "WishlistAlert.variantId":
+		if e.complexity.WishlistAlert.VariantID == nil {
 			break
 		}
 
-		return e.complexity.Variant.CategoryID(childComplexity), true
+		return e.complexity.WishlistAlert.VariantID(childComplexity), true
 
-	case "Variant.createdAt":
-		if e.complexity.Variant.CreatedAt == nil {
+	case "WishlistAlert.variantName":
+		if e.complexity.WishlistAlert.VariantName == nil {
 			break
 		}
 
-		return e.complexity.Variant.CreatedAt(childComplexity), true
+		return e.complexity.WishlistAlert.VariantName(childComplexity), true
 
-	case "Variant.description":
-		if e.complexity.Variant.Description == nil {
+	case "WishlistAlertConnection.items":
+		if e.complexity.WishlistAlertConnection.Items == nil {
 			break
 		}
 
-		return e.complexity.Variant.Description(childComplexity), true
+		return e.complexity.WishlistAlertConnection.Items(childComplexity), true
 
-	case "Variant.id":
-		if e.complexity.Variant.ID == nil {
+	case "WishlistAlertConnection.pageInfo":
+		if e.complexity.WishlistAlertConnection.PageInfo == nil {
 			break
 		}
 
-		return e.complexity.Variant.ID(childComplexity), true
+		return e.complexity.WishlistAlertConnection.PageInfo(childComplexity), true
 
-	case "Variant.imageUrl":
-		if e.complexity.Variant.ImageURL == nil {
+	case "WishlistConnection.items":
+		if e.complexity.WishlistConnection.Items == nil {
 			break
 		}
 
-		return e.complexity.Variant.ImageURL(childComplexity), true
+		return e.complexity.WishlistConnection.Items(childComplexity), true
 
-	case "Variant.name":
-		if e.complexity.Variant.Name == nil {
+	case "WishlistConnection.pageInfo":
+		if e.complexity.WishlistConnection.PageInfo == nil {
 			break
 		}
 
-		return e.complexity.Variant.Name(childComplexity), true
+		return e.complexity.WishlistConnection.PageInfo(childComplexity), true
 
-	case "Variant.price":
-		if e.complexity.Variant.Price == nil {
+	case "WishlistItem.addedAt":
+		if e.complexity.WishlistItem.AddedAt == nil {
 			break
 		}
 
-		return e.complexity.Variant.Price(childComplexity), true
+		return e.complexity.WishlistItem.AddedAt(childComplexity), true
 
-	case "Variant.productId":
-		if e.complexity.Variant.ProductID == nil {
+	case "WishlistItem.id":
+		if e.complexity.WishlistItem.ID == nil {
 			break
 		}
 
-		return e.complexity.Variant.ProductID(childComplexity), true
+		return e.complexity.WishlistItem.ID(childComplexity), true
 
-	case "Variant.quantityType":
-		if e.complexity.Variant.QuantityType == nil {
+	case "WishlistItem.imageUrl":
+		if e.complexity.WishlistItem.ImageURL == nil {
 			break
 		}
 
-		return e.complexity.Variant.QuantityType(childComplexity), true
+		return e.complexity.WishlistItem.ImageURL(childComplexity), true
 
-	case "Variant.sellerId":
-		if e.complexity.Variant.SellerID == nil {
+	case "WishlistItem.inStock":
+		if e.complexity.WishlistItem.InStock == nil {
 			break
 		}
 
-		return e.complexity.Variant.SellerID(childComplexity), true
+		return e.complexity.WishlistItem.InStock(childComplexity), true
 
-	case "Variant.stock":
-		if e.complexity.Variant.Stock == nil {
+	case "WishlistItem.price":
+		if e.complexity.WishlistItem.Price == nil {
 			break
 		}
 
-		return e.complexity.Variant.Stock(childComplexity), true
+		return e.complexity.WishlistItem.Price(childComplexity), true
 
-	case "VariantRef.id":
-		if e.complexity.VariantRef.ID == nil {
+	case "WishlistItem.productId":
+		if e.complexity.WishlistItem.ProductID == nil {
 			break
 		}
 
-		return e.complexity.VariantRef.ID(childComplexity), true
+		return e.complexity.WishlistItem.ProductID(childComplexity), true
 
-	case "VariantRef.imageUrl":
-		if e.complexity.VariantRef.ImageURL == nil {
+	case "WishlistItem.productName":
+		if e.complexity.WishlistItem.ProductName == nil {
 			break
 		}
 
-		return e.complexity.VariantRef.ImageURL(childComplexity), true
+		return e.complexity.WishlistItem.ProductName(childComplexity), true
 
-	case "VariantRef.name":
-		if e.complexity.VariantRef.Name == nil {
+	case "WishlistItem.variantId":
+		if e.complexity.WishlistItem.VariantID == nil {
 			break
 		}
 
-		return e.complexity.VariantRef.Name(childComplexity), true
+		return e.complexity.WishlistItem.VariantID(childComplexity), true
 
-	case "VariantRef.productName":
-		if e.complexity.VariantRef.ProductName == nil {
+	case "WishlistItem.variantName":
+		if e.complexity.WishlistItem.VariantName == nil {
 			break
 		}
 
-		return e.complexity.VariantRef.ProductName(childComplexity), true
+		return e.complexity.WishlistItem.VariantName(childComplexity), true
 
 	}
 	return 0, false
@@ -2678,35 +9767,77 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 		ec.unmarshalInputAddPackageItemInput,
 		ec.unmarshalInputAddToCartInput,
 		ec.unmarshalInputAddressInput,
+		ec.unmarshalInputAdjustStockInput,
+		ec.unmarshalInputApplyCouponInput,
+		ec.unmarshalInputApplySessionPointsInput,
+		ec.unmarshalInputApplySessionWalletInput,
 		ec.unmarshalInputCartFilterInput,
 		ec.unmarshalInputCartSortInput,
 		ec.unmarshalInputCheckoutSessionItemInput,
 		ec.unmarshalInputConfirmCheckoutSessionInput,
 		ec.unmarshalInputCreateAddressInput,
+		ec.unmarshalInputCreateAdminOrderInput,
+		ec.unmarshalInputCreateApiKeyInput,
 		ec.unmarshalInputCreateCheckoutSessionInput,
+		ec.unmarshalInputCreateCommissionRateInput,
+		ec.unmarshalInputCreateExperimentInput,
+		ec.unmarshalInputCreateLoyaltyRuleInput,
 		ec.unmarshalInputCreateOrderFromSessionInput,
+		ec.unmarshalInputCreateStockTransferInput,
+		ec.unmarshalInputCreateVoucherCampaignInput,
+		ec.unmarshalInputCreateWarehouseInput,
 		ec.unmarshalInputDeleteAddressInput,
+		ec.unmarshalInputExperimentVariantInput,
 		ec.unmarshalInputForgotPasswordInput,
+		ec.unmarshalInputIssueSegmentVouchersInput,
+		ec.unmarshalInputLocationInput,
 		ec.unmarshalInputLoginInput,
 		ec.unmarshalInputNewProduct,
 		ec.unmarshalInputNewVariant,
+		ec.unmarshalInputNotificationSettingInput,
 		ec.unmarshalInputOrderFilterInput,
 		ec.unmarshalInputOrderSortInput,
 		ec.unmarshalInputPackageFilterInput,
 		ec.unmarshalInputPackageSortInput,
 		ec.unmarshalInputPaginationInput,
+		ec.unmarshalInputPhoneLoginInput,
 		ec.unmarshalInputProductFilterInput,
 		ec.unmarshalInputProductSortInput,
+		ec.unmarshalInputPromotionReportInput,
+		ec.unmarshalInputPublishPolicyInput,
 		ec.unmarshalInputRegisterInput,
+		ec.unmarshalInputRemoveSessionItemInput,
+		ec.unmarshalInputRequestOrderAdjustmentInput,
+		ec.unmarshalInputRequestPhoneVerificationInput,
+		ec.unmarshalInputRequestRefundInput,
 		ec.unmarshalInputResetPasswordInput,
+		ec.unmarshalInputRestrictAccountInput,
+		ec.unmarshalInputSchedulePriceChangeInput,
+		ec.unmarshalInputSetCheckoutRuleInput,
+		ec.unmarshalInputSetDeliverySlotInput,
+		ec.unmarshalInputSetLogSettingsInput,
+		ec.unmarshalInputSetMaintenanceModeInput,
+		ec.unmarshalInputSetOrderReasonInput,
+		ec.unmarshalInputSetPickupLocationInput,
+		ec.unmarshalInputSettlementFeeInput,
+		ec.unmarshalInputStoreOperatingHoursInput,
+		ec.unmarshalInputStoreShippingOriginInput,
+		ec.unmarshalInputStoreVacationInput,
 		ec.unmarshalInputUpdateAddressInput,
 		ec.unmarshalInputUpdateCartInput,
 		ec.unmarshalInputUpdateOrderStatusInput,
+		ec.unmarshalInputUpdatePreferencesInput,
 		ec.unmarshalInputUpdateProduct,
 		ec.unmarshalInputUpdateProfileInput,
 		ec.unmarshalInputUpdateSessionAddressInput,
+		ec.unmarshalInputUpdateSessionInsuranceInput,
+		ec.unmarshalInputUpdateSessionItemInput,
 		ec.unmarshalInputUpdateSessionPaymentMethodInput,
+		ec.unmarshalInputUpdateSessionShippingMethodInput,
+		ec.unmarshalInputUpdateStoreInput,
 		ec.unmarshalInputUpdateVariant,
+		ec.unmarshalInputVerifyPhoneInput,
+		ec.unmarshalInputVerifyPickupCodeInput,
 	)
 	first := true
 
@@ -2803,7 +9934,7 @@ func (ec *executionContext) introspectType(name string) (*introspection.Type, er
 	return introspection.WrapTypeFromDef(ec.Schema(), ec.Schema().Types[name]), nil
 }
 
-//go:embed "schema/address.graphqls" "schema/cart.graphqls" "schema/category.graphqls" "schema/common.graphqls" "schema/order.graphqls" "schema/package.graphqls" "schema/pagination.graphqls" "schema/product.graphqls" "schema/schema.graphqls" "schema/user.graphqls" "schema/variant.graphqls"
+//go:embed "schema/accounting.graphqls" "schema/address.graphqls" "schema/apikey.graphqls" "schema/cart.graphqls" "schema/category.graphqls" "schema/changelog.graphqls" "schema/client.graphqls" "schema/commission.graphqls" "schema/common.graphqls" "schema/consent.graphqls" "schema/dbhealth.graphqls" "schema/dispute.graphqls" "schema/experiment.graphqls" "schema/export.graphqls" "schema/fulfillment.graphqls" "schema/inventory.graphqls" "schema/logsettings.graphqls" "schema/loyalty.graphqls" "schema/maintenance.graphqls" "schema/moderation.graphqls" "schema/ops.graphqls" "schema/order.graphqls" "schema/orderchat.graphqls" "schema/package.graphqls" "schema/pagination.graphqls" "schema/preference.graphqls" "schema/pricechange.graphqls" "schema/product.graphqls" "schema/quota.graphqls" "schema/receipt.graphqls" "schema/referral.graphqls" "schema/refund.graphqls" "schema/retention.graphqls" "schema/schema.graphqls" "schema/shipment.graphqls" "schema/sla.graphqls" "schema/stockalert.graphqls" "schema/store.graphqls" "schema/synthetic.graphqls" "schema/uploads.graphqls" "schema/user.graphqls" "schema/variant.graphqls" "schema/voucher.graphqls" "schema/wallet.graphqls" "schema/wishlist.graphqls"
 var sourcesFS embed.FS
 
 func sourceData(filename string) string {
@@ -2815,16 +9946,50 @@ func sourceData(filename string) string {
 }
 
 var sources = []*ast.Source{
+	{Name: "schema/accounting.graphqls", Input: sourceData("schema/accounting.graphqls"), BuiltIn: false},
 	{Name: "schema/address.graphqls", Input: sourceData("schema/address.graphqls"), BuiltIn: false},
+	{Name: "schema/apikey.graphqls", Input: sourceData("schema/apikey.graphqls"), BuiltIn: false},
 	{Name: "schema/cart.graphqls", Input: sourceData("schema/cart.graphqls"), BuiltIn: false},
 	{Name: "schema/category.graphqls", Input: sourceData("schema/category.graphqls"), BuiltIn: false},
+	{Name: "schema/changelog.graphqls", Input: sourceData("schema/changelog.graphqls"), BuiltIn: false},
+	{Name: "schema/client.graphqls", Input: sourceData("schema/client.graphqls"), BuiltIn: false},
+	{Name: "schema/commission.graphqls", Input: sourceData("schema/commission.graphqls"), BuiltIn: false},
 	{Name: "schema/common.graphqls", Input: sourceData("schema/common.graphqls"), BuiltIn: false},
+	{Name: "schema/consent.graphqls", Input: sourceData("schema/consent.graphqls"), BuiltIn: false},
+	{Name: "schema/dbhealth.graphqls", Input: sourceData("schema/dbhealth.graphqls"), BuiltIn: false},
+	{Name: "schema/dispute.graphqls", Input: sourceData("schema/dispute.graphqls"), BuiltIn: false},
+	{Name: "schema/experiment.graphqls", Input: sourceData("schema/experiment.graphqls"), BuiltIn: false},
+	{Name: "schema/export.graphqls", Input: sourceData("schema/export.graphqls"), BuiltIn: false},
+	{Name: "schema/fulfillment.graphqls", Input: sourceData("schema/fulfillment.graphqls"), BuiltIn: false},
+	{Name: "schema/inventory.graphqls", Input: sourceData("schema/inventory.graphqls"), BuiltIn: false},
+	{Name: "schema/logsettings.graphqls", Input: sourceData("schema/logsettings.graphqls"), BuiltIn: false},
+	{Name: "schema/loyalty.graphqls", Input: sourceData("schema/loyalty.graphqls"), BuiltIn: false},
+	{Name: "schema/maintenance.graphqls", Input: sourceData("schema/maintenance.graphqls"), BuiltIn: false},
+	{Name: "schema/moderation.graphqls", Input: sourceData("schema/moderation.graphqls"), BuiltIn: false},
+	{Name: "schema/ops.graphqls", Input: sourceData("schema/ops.graphqls"), BuiltIn: false},
 	{Name: "schema/order.graphqls", Input: sourceData("schema/order.graphqls"), BuiltIn: false},
+	{Name: "schema/orderchat.graphqls", Input: sourceData("schema/orderchat.graphqls"), BuiltIn: false},
 	{Name: "schema/package.graphqls", Input: sourceData("schema/package.graphqls"), BuiltIn: false},
 	{Name: "schema/pagination.graphqls", Input: sourceData("schema/pagination.graphqls"), BuiltIn: false},
+	{Name: "schema/preference.graphqls", Input: sourceData("schema/preference.graphqls"), BuiltIn: false},
+	{Name: "schema/pricechange.graphqls", Input: sourceData("schema/pricechange.graphqls"), BuiltIn: false},
 	{Name: "schema/product.graphqls", Input: sourceData("schema/product.graphqls"), BuiltIn: false},
+	{Name: "schema/quota.graphqls", Input: sourceData("schema/quota.graphqls"), BuiltIn: false},
+	{Name: "schema/receipt.graphqls", Input: sourceData("schema/receipt.graphqls"), BuiltIn: false},
+	{Name: "schema/referral.graphqls", Input: sourceData("schema/referral.graphqls"), BuiltIn: false},
+	{Name: "schema/refund.graphqls", Input: sourceData("schema/refund.graphqls"), BuiltIn: false},
+	{Name: "schema/retention.graphqls", Input: sourceData("schema/retention.graphqls"), BuiltIn: false},
 	{Name: "schema/schema.graphqls", Input: sourceData("schema/schema.graphqls"), BuiltIn: false},
+	{Name: "schema/shipment.graphqls", Input: sourceData("schema/shipment.graphqls"), BuiltIn: false},
+	{Name: "schema/sla.graphqls", Input: sourceData("schema/sla.graphqls"), BuiltIn: false},
+	{Name: "schema/stockalert.graphqls", Input: sourceData("schema/stockalert.graphqls"), BuiltIn: false},
+	{Name: "schema/store.graphqls", Input: sourceData("schema/store.graphqls"), BuiltIn: false},
+	{Name: "schema/synthetic.graphqls", Input: sourceData("schema/synthetic.graphqls"), BuiltIn: false},
+	{Name: "schema/uploads.graphqls", Input: sourceData("schema/uploads.graphqls"), BuiltIn: false},
 	{Name: "schema/user.graphqls", Input: sourceData("schema/user.graphqls"), BuiltIn: false},
 	{Name: "schema/variant.graphqls", Input: sourceData("schema/variant.graphqls"), BuiltIn: false},
+	{Name: "schema/voucher.graphqls", Input: sourceData("schema/voucher.graphqls"), BuiltIn: false},
+	{Name: "schema/wallet.graphqls", Input: sourceData("schema/wallet.graphqls"), BuiltIn: false},
+	{Name: "schema/wishlist.graphqls", Input: sourceData("schema/wishlist.graphqls"), BuiltIn: false},
 }
 var parsedSchema = gqlparser.MustLoadSchema(sources...)
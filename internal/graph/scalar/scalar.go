@@ -0,0 +1,119 @@
+// Package scalar holds the custom GraphQL scalars. Each one validates its
+// input at the transport layer, so a malformed value fails the request
+// with a message naming the expected format instead of reaching a service
+// and surfacing as a repository error.
+package scalar
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+// MarshalUUID writes an ID stored as a UUID.
+func MarshalUUID(id string) graphql.Marshaler {
+	return graphql.MarshalString(id)
+}
+
+// UnmarshalUUID accepts only the canonical 36 character form and returns
+// it lower-cased.
+func UnmarshalUUID(v any) (string, error) {
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("%T is not a UUID string", v)
+	}
+	if !uuidPattern.MatchString(s) {
+		return "", fmt.Errorf("invalid UUID %q", s)
+	}
+	return strings.ToLower(s), nil
+}
+
+// MarshalTime writes an RFC3339 timestamp; the zero time is null.
+func MarshalTime(t time.Time) graphql.Marshaler {
+	return graphql.MarshalTime(t)
+}
+
+// UnmarshalTime accepts an RFC3339 timestamp with a time zone, e.g.
+// 2024-01-31T09:00:00+07:00.
+func UnmarshalTime(v any) (time.Time, error) {
+	s, ok := v.(string)
+	if !ok {
+		return time.Time{}, fmt.Errorf("%T is not an RFC3339 time string", v)
+	}
+	t, err := time.Parse(time.RFC3339Nano, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid Time %q, want RFC3339 such as 2024-01-31T09:00:00+07:00", s)
+	}
+	return t, nil
+}
+
+// MarshalDate writes a calendar date as YYYY-MM-DD.
+func MarshalDate(d string) graphql.Marshaler {
+	return graphql.MarshalString(d)
+}
+
+// UnmarshalDate accepts an RFC3339 full-date, YYYY-MM-DD. It stays a
+// string because what day it is depends on a time zone only the service
+// knows.
+func UnmarshalDate(v any) (string, error) {
+	s, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("%T is not a date string", v)
+	}
+	if _, err := time.Parse(time.DateOnly, s); err != nil {
+		return "", fmt.Errorf("invalid Date %q, want YYYY-MM-DD", s)
+	}
+	return s, nil
+}
+
+var decimalPattern = regexp.MustCompile(`^-?[0-9]+(\.[0-9]+)?$`)
+
+// MarshalDecimal writes an amount as a string, so clients parse it with
+// their own decimal type rather than a float.
+func MarshalDecimal(d float64) graphql.Marshaler {
+	return graphql.WriterFunc(func(w io.Writer) {
+		io.WriteString(w, strconv.Quote(strconv.FormatFloat(d, 'f', -1, 64)))
+	})
+}
+
+// UnmarshalDecimal accepts a plain decimal string such as "12500.50", or
+// a number for clients that send one. Exponents, NaN and infinities are
+// rejected.
+func UnmarshalDecimal(v any) (float64, error) {
+	var s string
+	switch v := v.(type) {
+	case string:
+		s = v
+	case json.Number:
+		s = v.String()
+	case int64:
+		return float64(v), nil
+	case int:
+		return float64(v), nil
+	case float64:
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			return 0, fmt.Errorf("invalid Decimal %v", v)
+		}
+		return v, nil
+	default:
+		return 0, fmt.Errorf("%T is not a decimal", v)
+	}
+
+	if !decimalPattern.MatchString(s) {
+		return 0, fmt.Errorf("invalid Decimal %q", s)
+	}
+	d, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsInf(d, 0) {
+		return 0, fmt.Errorf("invalid Decimal %q", s)
+	}
+	return d, nil
+}
@@ -0,0 +1,75 @@
+package scalar
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnmarshalUUID(t *testing.T) {
+	id, err := UnmarshalUUID("7F8E9A1B-2C3D-4E5F-8A9B-0C1D2E3F4A5B")
+	require.NoError(t, err)
+	assert.Equal(t, "7f8e9a1b-2c3d-4e5f-8a9b-0c1d2e3f4a5b", id)
+
+	for _, v := range []any{
+		"abc",
+		"",
+		"7f8e9a1b2c3d4e5f8a9b0c1d2e3f4a5b",
+		"{7f8e9a1b-2c3d-4e5f-8a9b-0c1d2e3f4a5b}",
+		"urn:uuid:7f8e9a1b-2c3d-4e5f-8a9b-0c1d2e3f4a5b",
+		"7f8e9a1b-2c3d-4e5f-8a9b-0c1d2e3f4a5g",
+		int64(12),
+	} {
+		_, err := UnmarshalUUID(v)
+		assert.Error(t, err, "%v", v)
+	}
+}
+
+func TestUnmarshalTime(t *testing.T) {
+	got, err := UnmarshalTime("2024-01-31T09:00:00+07:00")
+	require.NoError(t, err)
+	assert.True(t, got.Equal(time.Date(2024, 1, 31, 2, 0, 0, 0, time.UTC)))
+
+	_, err = UnmarshalTime("2024-01-31T09:00:00.123Z")
+	assert.NoError(t, err)
+
+	for _, v := range []any{"2024-01-31", "2024-01-31 09:00:00", "2024-01-31T09:00:00", "2024-02-30T09:00:00Z", "yesterday", int64(1706662800)} {
+		_, err := UnmarshalTime(v)
+		assert.Error(t, err, "%v", v)
+	}
+}
+
+func TestUnmarshalDate(t *testing.T) {
+	got, err := UnmarshalDate("2024-02-29")
+	require.NoError(t, err)
+	assert.Equal(t, "2024-02-29", got)
+
+	for _, v := range []any{"2023-02-29", "2024-1-5", "31/01/2024", "2024-01-31T00:00:00Z", int64(20240131)} {
+		_, err := UnmarshalDate(v)
+		assert.Error(t, err, "%v", v)
+	}
+}
+
+func TestDecimal(t *testing.T) {
+	for _, v := range []any{"12500.50", json.Number("12500.50"), 12500.5} {
+		got, err := UnmarshalDecimal(v)
+		require.NoError(t, err, "%v", v)
+		assert.Equal(t, 12500.5, got)
+	}
+	got, err := UnmarshalDecimal(int64(100))
+	require.NoError(t, err)
+	assert.Equal(t, 100.0, got)
+
+	for _, v := range []any{"1e3", "12,50", "", " 1", "NaN", "Infinity", ".5", json.Number("1e400"), true} {
+		_, err := UnmarshalDecimal(v)
+		assert.Error(t, err, "%v", v)
+	}
+
+	var buf bytes.Buffer
+	MarshalDecimal(12500.5).MarshalGQL(&buf)
+	assert.Equal(t, `"12500.5"`, buf.String())
+}
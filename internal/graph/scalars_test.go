@@ -0,0 +1,60 @@
+package graph
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScalarsRejectedBeforeResolver(t *testing.T) {
+	productSvc := new(MockProductService)
+	srv := handler.New(NewSchema(&Resolver{ProductSvc: productSvc}))
+	srv.AddTransport(transport.POST{})
+
+	run := func(body map[string]any) gqlResponse {
+		b, err := json.Marshal(body)
+		require.NoError(t, err)
+		req := httptest.NewRequest(http.MethodPost, "/query", strings.NewReader(string(b)))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		srv.ServeHTTP(w, req)
+
+		var resp gqlResponse
+		require.NoError(t, json.NewDecoder(w.Body).Decode(&resp))
+		return resp
+	}
+
+	t.Run("Literal", func(t *testing.T) {
+		resp := run(map[string]any{"query": `{ productDetail(productId: "p-1") { id } }`})
+		require.NotEmpty(t, resp.Errors)
+		assert.Contains(t, resp.Errors[0].Message, `invalid UUID "p-1"`)
+	})
+
+	t.Run("Variable", func(t *testing.T) {
+		resp := run(map[string]any{
+			"query":     `query($id: UUID!) { productDetail(productId: $id) { id } }`,
+			"variables": map[string]any{"id": "p-1"},
+		})
+		require.NotEmpty(t, resp.Errors)
+		assert.Contains(t, resp.Errors[0].Message, `invalid UUID "p-1"`)
+	})
+
+	t.Run("Decimal filter", func(t *testing.T) {
+		resp := run(map[string]any{
+			"query": `{ productList(filter: { minPrice: "10k" }) { pageInfo { page } } }`,
+		})
+		require.NotEmpty(t, resp.Errors)
+		assert.Contains(t, resp.Errors[0].Message, `invalid Decimal "10k"`)
+	})
+
+	productSvc.AssertNotCalled(t, "GetProductByID", mock.Anything, mock.Anything)
+	productSvc.AssertNotCalled(t, "GetList", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,124 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"warimas-be/internal/graph/model"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/shipment"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+// ShipOrder is the resolver for the shipOrder field.
+func (r *mutationResolver) ShipOrder(ctx context.Context, orderID string, courier string, awb string) (*model.Shipment, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "ShipOrder"),
+		zap.String("order_id", orderID),
+	)
+
+	oid, err := utils.ToUint(orderID)
+	if err != nil {
+		log.Warn("invalid order id", zap.Error(err))
+		return nil, err
+	}
+
+	s, err := r.ShipmentSvc.ShipOrder(ctx, oid, courier, awb)
+	if err != nil {
+		log.Error("failed to ship order", zap.Error(err))
+		return nil, err
+	}
+
+	return shipment.MapShipmentToGraphQL(s), nil
+}
+
+// RequeueCourierWebhook is the resolver for the requeueCourierWebhook field.
+func (r *mutationResolver) RequeueCourierWebhook(ctx context.Context, id string) (bool, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "RequeueCourierWebhook"),
+		zap.String("webhook_id", id),
+	)
+
+	webhookID, err := utils.ToUint(id)
+	if err != nil {
+		log.Warn("invalid webhook id", zap.Error(err))
+		return false, err
+	}
+
+	if err := r.ShipmentSvc.RequeueWebhook(ctx, int64(webhookID)); err != nil {
+		log.Error("failed to requeue courier webhook", zap.Error(err))
+		return false, err
+	}
+
+	return true, nil
+}
+
+// OrderShipment is the resolver for the orderShipment field.
+func (r *queryResolver) OrderShipment(ctx context.Context, orderID string) (*model.Shipment, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "OrderShipment"),
+		zap.String("order_id", orderID),
+	)
+
+	oid, err := utils.ToUint(orderID)
+	if err != nil {
+		log.Warn("invalid order id", zap.Error(err))
+		return nil, err
+	}
+
+	s, err := r.ShipmentSvc.GetShipment(ctx, oid)
+	if err != nil {
+		log.Error("failed to get shipment", zap.Error(err))
+		return nil, err
+	}
+
+	return shipment.MapShipmentToGraphQL(s), nil
+}
+
+// CourierWebhookDeadLetters is the resolver for the courierWebhookDeadLetters field.
+func (r *queryResolver) CourierWebhookDeadLetters(ctx context.Context, limit *int32) ([]*model.CourierWebhook, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "CourierWebhookDeadLetters"),
+	)
+
+	var l int32
+	if limit != nil {
+		l = *limit
+	}
+
+	list, err := r.ShipmentSvc.ListDeadLetters(ctx, l)
+	if err != nil {
+		log.Error("failed to list dead-lettered courier webhooks", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]*model.CourierWebhook, 0, len(list))
+	for _, w := range list {
+		out = append(out, shipment.MapWebhookToGraphQL(w))
+	}
+	return out, nil
+}
+
+// CourierManifest is the resolver for the courierManifest field.
+func (r *queryResolver) CourierManifest(ctx context.Context, date *string) (string, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "CourierManifest"),
+	)
+
+	html, err := r.ShipmentSvc.Manifest(ctx, date)
+	if err != nil {
+		log.Error("failed to build courier manifest", zap.Error(err))
+		return "", err
+	}
+
+	return html, nil
+}
@@ -0,0 +1,39 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"warimas-be/internal/graph/model"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/sla"
+
+	"go.uber.org/zap"
+)
+
+// OrderSLABreaches is the resolver for the orderSlaBreaches field.
+func (r *queryResolver) OrderSLABreaches(ctx context.Context, openOnly *bool, limit *int32) ([]*model.OrderSLABreach, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "OrderSLABreaches"),
+	)
+
+	var l int32
+	if limit != nil {
+		l = *limit
+	}
+
+	breaches, err := r.SLASvc.ListBreaches(ctx, openOnly != nil && *openOnly, l)
+	if err != nil {
+		log.Error("failed to list SLA breaches", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]*model.OrderSLABreach, 0, len(breaches))
+	for _, b := range breaches {
+		out = append(out, sla.MapBreachToGraphQL(b))
+	}
+	return out, nil
+}
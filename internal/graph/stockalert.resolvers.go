@@ -0,0 +1,68 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"warimas-be/internal/graph/model"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/stockalert"
+
+	"go.uber.org/zap"
+)
+
+// SubscribeBackInStock is the resolver for the subscribeBackInStock field.
+func (r *mutationResolver) SubscribeBackInStock(ctx context.Context, variantID string) (*model.BackInStockSubscription, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "SubscribeBackInStock"),
+		zap.String("variant_id", variantID),
+	)
+
+	sub, err := r.StockAlertSvc.Subscribe(ctx, variantID)
+	if err != nil {
+		log.Error("failed to subscribe to back in stock", zap.Error(err))
+		return nil, err
+	}
+
+	return stockalert.MapSubscriptionToGraphQL(sub), nil
+}
+
+// UnsubscribeBackInStock is the resolver for the unsubscribeBackInStock field.
+func (r *mutationResolver) UnsubscribeBackInStock(ctx context.Context, variantID string) (bool, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "UnsubscribeBackInStock"),
+		zap.String("variant_id", variantID),
+	)
+
+	removed, err := r.StockAlertSvc.Unsubscribe(ctx, variantID)
+	if err != nil {
+		log.Error("failed to unsubscribe from back in stock", zap.Error(err))
+		return false, err
+	}
+
+	return removed, nil
+}
+
+// MyBackInStockSubscriptions is the resolver for the myBackInStockSubscriptions field.
+func (r *queryResolver) MyBackInStockSubscriptions(ctx context.Context) ([]*model.BackInStockSubscription, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "MyBackInStockSubscriptions"),
+	)
+
+	subs, err := r.StockAlertSvc.GetMySubscriptions(ctx)
+	if err != nil {
+		log.Error("failed to get back in stock subscriptions", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]*model.BackInStockSubscription, 0, len(subs))
+	for _, s := range subs {
+		out = append(out, stockalert.MapSubscriptionToGraphQL(s))
+	}
+	return out, nil
+}
@@ -0,0 +1,285 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"warimas-be/internal/graph/model"
+	"warimas-be/internal/logger"
+	prodInternal "warimas-be/internal/product"
+	"warimas-be/internal/store"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+// UpdateMyStore is the resolver for the updateMyStore field.
+func (r *mutationResolver) UpdateMyStore(ctx context.Context, input model.UpdateStoreInput) (*model.Store, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "UpdateMyStore"),
+	)
+
+	s, err := r.StoreSvc.UpdateMine(ctx, store.UpdateInput{
+		Slug:        input.Slug,
+		Name:        input.Name,
+		LogoURL:     input.LogoURL,
+		Description: input.Description,
+	})
+	if err != nil {
+		log.Error("failed to update store", zap.Error(err))
+		return nil, err
+	}
+
+	return store.MapStoreToGraphQL(s), nil
+}
+
+// SetMyStoreOperatingHours is the resolver for the setMyStoreOperatingHours field.
+func (r *mutationResolver) SetMyStoreOperatingHours(ctx context.Context, hours []*model.StoreOperatingHoursInput) (*model.Store, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "SetMyStoreOperatingHours"),
+	)
+
+	week := make([]*store.OperatingHours, 0, len(hours))
+	for _, h := range hours {
+		week = append(week, &store.OperatingHours{
+			Weekday: store.WeekdayFromGraphQL(h.Weekday),
+			Opens:   h.OpensAt,
+			Closes:  h.ClosesAt,
+		})
+	}
+
+	s, err := r.StoreSvc.SetMyHours(ctx, week)
+	if err != nil {
+		log.Error("failed to set store hours", zap.Error(err))
+		return nil, err
+	}
+
+	return store.MapStoreToGraphQL(s), nil
+}
+
+// ScheduleMyStoreVacation is the resolver for the scheduleMyStoreVacation field.
+func (r *mutationResolver) ScheduleMyStoreVacation(ctx context.Context, input model.StoreVacationInput) (*model.StoreVacation, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "ScheduleMyStoreVacation"),
+	)
+
+	in := store.VacationInput{
+		EndsAt:  input.EndsAt,
+		Mode:    store.VacationMode(input.Mode),
+		Message: input.Message,
+	}
+	if input.StartsAt != nil {
+		in.StartsAt = *input.StartsAt
+	}
+
+	v, err := r.StoreSvc.ScheduleMyVacation(ctx, in)
+	if err != nil {
+		log.Error("failed to schedule vacation", zap.Error(err))
+		return nil, err
+	}
+
+	return store.MapVacationToGraphQL(v), nil
+}
+
+// CancelMyStoreVacation is the resolver for the cancelMyStoreVacation field.
+func (r *mutationResolver) CancelMyStoreVacation(ctx context.Context, id string) (bool, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "CancelMyStoreVacation"),
+		zap.String("vacation_id", id),
+	)
+
+	vacationID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return false, store.ErrVacationNotFound
+	}
+
+	if err := r.StoreSvc.CancelMyVacation(ctx, vacationID); err != nil {
+		log.Error("failed to cancel vacation", zap.Error(err))
+		return false, err
+	}
+
+	return true, nil
+}
+
+// CreateMyStoreShippingOrigin is the resolver for the createMyStoreShippingOrigin field.
+func (r *mutationResolver) CreateMyStoreShippingOrigin(ctx context.Context, input model.StoreShippingOriginInput) (*model.StoreShippingOrigin, error) {
+	o, err := r.StoreSvc.CreateMyOrigin(ctx, store.MapOriginInputFromGraphQL(input))
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to create shipping origin", zap.Error(err))
+		return nil, err
+	}
+	return store.MapOriginToGraphQL(o), nil
+}
+
+// UpdateMyStoreShippingOrigin is the resolver for the updateMyStoreShippingOrigin field.
+func (r *mutationResolver) UpdateMyStoreShippingOrigin(ctx context.Context, id string, input model.StoreShippingOriginInput) (*model.StoreShippingOrigin, error) {
+	o, err := r.StoreSvc.UpdateMyOrigin(ctx, id, store.MapOriginInputFromGraphQL(input))
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to update shipping origin", zap.String("origin_id", id), zap.Error(err))
+		return nil, err
+	}
+	return store.MapOriginToGraphQL(o), nil
+}
+
+// DeleteMyStoreShippingOrigin is the resolver for the deleteMyStoreShippingOrigin field.
+func (r *mutationResolver) DeleteMyStoreShippingOrigin(ctx context.Context, id string) (bool, error) {
+	if err := r.StoreSvc.DeleteMyOrigin(ctx, id); err != nil {
+		logger.FromCtx(ctx).Error("failed to delete shipping origin", zap.String("origin_id", id), zap.Error(err))
+		return false, err
+	}
+	return true, nil
+}
+
+// SetMyStoreDefaultShippingOrigin is the resolver for the setMyStoreDefaultShippingOrigin field.
+func (r *mutationResolver) SetMyStoreDefaultShippingOrigin(ctx context.Context, id string) (bool, error) {
+	if err := r.StoreSvc.SetMyDefaultOrigin(ctx, id); err != nil {
+		logger.FromCtx(ctx).Error("failed to set default shipping origin", zap.String("origin_id", id), zap.Error(err))
+		return false, err
+	}
+	return true, nil
+}
+
+// SetMyProductShippingOrigin is the resolver for the setMyProductShippingOrigin field.
+func (r *mutationResolver) SetMyProductShippingOrigin(ctx context.Context, productID string, originID *string) (bool, error) {
+	if err := r.StoreSvc.SetMyProductOrigin(ctx, productID, originID); err != nil {
+		logger.FromCtx(ctx).Error("failed to set product shipping origin", zap.String("product_id", productID), zap.Error(err))
+		return false, err
+	}
+	return true, nil
+}
+
+// StoreBySlug is the resolver for the storeBySlug field.
+func (r *queryResolver) StoreBySlug(ctx context.Context, slug string) (*model.Store, error) {
+	s, err := r.StoreSvc.GetBySlug(ctx, slug)
+	if errors.Is(err, store.ErrStoreNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to get store", zap.String("slug", slug), zap.Error(err))
+		return nil, err
+	}
+
+	return store.MapStoreToGraphQL(s), nil
+}
+
+// StoreProducts is the resolver for the storeProducts field.
+func (r *queryResolver) StoreProducts(ctx context.Context, slug string, sort *model.ProductSortInput, page *int32, limit *int32, after *string) (*model.ProductConnection, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "StoreProducts"),
+		zap.String("slug", slug),
+	)
+
+	p := int32(1)
+	if page != nil && *page > 0 {
+		p = *page
+	}
+	l := int32(20)
+	if limit != nil && *limit > 0 {
+		l = *limit
+	}
+	if l > 100 {
+		l = 100
+	}
+
+	p, err := pageAfter(after, p, l)
+	if err != nil {
+		log.Warn("invalid cursor", zap.Error(err))
+		return nil, err
+	}
+
+	s, err := r.StoreSvc.GetBySlug(ctx, slug)
+	if err != nil {
+		log.Warn("store lookup failed", zap.Error(err))
+		return nil, err
+	}
+
+	var sortField *model.ProductSortField
+	var sortDirection *model.SortDirection
+	if sort != nil {
+		sortField = &sort.Field
+		sortDirection = &sort.Direction
+	}
+
+	// The storefront shows what shoppers can buy, whoever is asking.
+	active := utils.ProductStatusActive
+	includeCount := utils.HasAnyField(ctx, "pageInfo")
+
+	result, err := r.ProductSvc.GetList(ctx, prodInternal.ProductQueryOptions{
+		SellerID:      &s.SellerID,
+		Status:        &active,
+		SortField:     MapSortField(sortField),
+		SortDirection: MapSortDirection(sortDirection),
+		Page:          p,
+		Limit:         l,
+		IncludeCount:  includeCount,
+	})
+	if err != nil {
+		log.Error("failed to fetch store products", zap.Error(err))
+		return nil, err
+	}
+
+	items := make([]*model.Product, 0, len(result.Items))
+	for _, prod := range result.Items {
+		items = append(items, MapProductToGraphQL(prod))
+	}
+
+	var totalCount int64
+	if includeCount && result.TotalCount != nil {
+		totalCount = int64(*result.TotalCount)
+	}
+
+	return &model.ProductConnection{
+		Items:    items,
+		PageInfo: newPageInfo(totalCount, p, l),
+	}, nil
+}
+
+// MyStore is the resolver for the myStore field.
+func (r *queryResolver) MyStore(ctx context.Context) (*model.Store, error) {
+	s, err := r.StoreSvc.GetMine(ctx)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to get own store", zap.Error(err))
+		return nil, err
+	}
+
+	return store.MapStoreToGraphQL(s), nil
+}
+
+// MyStoreVacations is the resolver for the myStoreVacations field.
+func (r *queryResolver) MyStoreVacations(ctx context.Context) ([]*model.StoreVacation, error) {
+	vacations, err := r.StoreSvc.GetMyVacations(ctx)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to list own vacations", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]*model.StoreVacation, 0, len(vacations))
+	for _, v := range vacations {
+		out = append(out, store.MapVacationToGraphQL(v))
+	}
+	return out, nil
+}
+
+// MyStoreShippingOrigins is the resolver for the myStoreShippingOrigins field.
+func (r *queryResolver) MyStoreShippingOrigins(ctx context.Context) ([]*model.StoreShippingOrigin, error) {
+	origins, err := r.StoreSvc.GetMyOrigins(ctx)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to list own shipping origins", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]*model.StoreShippingOrigin, 0, len(origins))
+	for _, o := range origins {
+		out = append(out, store.MapOriginToGraphQL(o))
+	}
+	return out, nil
+}
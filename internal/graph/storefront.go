@@ -0,0 +1,138 @@
+package graph
+
+import (
+	"sync"
+
+	"github.com/vektah/gqlparser/v2/ast"
+)
+
+// The storefront (/query) and admin (/admin/query) endpoints share the
+// generated resolvers but not the schema: the storefront is served a copy
+// without the fields marked @auth(role: ADMIN) and the types only those
+// fields use, so they can be neither queried nor introspected there.
+
+// Complexity limits per endpoint. Admin tooling is trusted with fewer
+// fields per request: its types are heavier and an admin token that leaks
+// should not be able to pull reports in bulk.
+const (
+	StorefrontComplexityLimit = 1000
+	AdminComplexityLimit      = 200
+)
+
+var storefront = sync.OnceValue(func() *ast.Schema {
+	return withoutAdminFields(parsedSchema)
+})
+
+// adminOnly reports whether a field is restricted to admins.
+func adminOnly(f *ast.FieldDefinition) bool {
+	auth := f.Directives.ForName("auth")
+	if auth == nil {
+		return false
+	}
+	role := auth.Arguments.ForName("role")
+	return role != nil && role.Value != nil && role.Value.Raw == "ADMIN"
+}
+
+// withoutAdminFields returns a copy of full without the admin-only root
+// fields and without the types no longer reachable from the roots or the
+// directives. full is not modified.
+func withoutAdminFields(full *ast.Schema) *ast.Schema {
+	out := &ast.Schema{
+		SchemaDirectives: full.SchemaDirectives,
+		Types:            map[string]*ast.Definition{},
+		Directives:       full.Directives,
+		PossibleTypes:    map[string][]*ast.Definition{},
+		Implements:       map[string][]*ast.Definition{},
+		Description:      full.Description,
+		Comment:          full.Comment,
+	}
+
+	root := func(def *ast.Definition) *ast.Definition {
+		if def == nil {
+			return nil
+		}
+		cp := *def
+		cp.Fields = nil
+		for _, f := range def.Fields {
+			if !adminOnly(f) {
+				cp.Fields = append(cp.Fields, f)
+			}
+		}
+		return &cp
+	}
+	out.Query = root(full.Query)
+	out.Mutation = root(full.Mutation)
+	out.Subscription = root(full.Subscription)
+
+	var visit func(def *ast.Definition)
+	visitType := func(name string) {
+		if def, ok := full.Types[name]; ok {
+			visit(def)
+		}
+	}
+	visitFields := func(fields ast.FieldList) {
+		for _, f := range fields {
+			visitType(f.Type.Name())
+			for _, arg := range f.Arguments {
+				visitType(arg.Type.Name())
+			}
+		}
+	}
+	visit = func(def *ast.Definition) {
+		if _, seen := out.Types[def.Name]; seen {
+			return
+		}
+		out.Types[def.Name] = def
+		visitFields(def.Fields)
+		for _, name := range def.Interfaces {
+			visitType(name)
+		}
+		for _, name := range def.Types {
+			visitType(name)
+		}
+		for _, possible := range full.PossibleTypes[def.Name] {
+			visit(possible)
+		}
+	}
+
+	for _, def := range []*ast.Definition{out.Query, out.Mutation, out.Subscription} {
+		if def == nil {
+			continue
+		}
+		out.Types[def.Name] = def
+		visitFields(def.Fields)
+	}
+	for _, dir := range full.Directives {
+		for _, arg := range dir.Arguments {
+			visitType(arg.Type.Name())
+		}
+	}
+	for name, def := range full.Types {
+		if def.BuiltIn {
+			visitType(name)
+		}
+	}
+
+	for name, types := range full.PossibleTypes {
+		if _, ok := out.Types[name]; !ok {
+			continue
+		}
+		for _, t := range types {
+			if _, ok := out.Types[t.Name]; ok {
+				out.AddPossibleType(name, out.Types[t.Name])
+			}
+		}
+	}
+	for name, ifaces := range full.Implements {
+		if _, ok := out.Types[name]; !ok {
+			continue
+		}
+		for _, iface := range ifaces {
+			if _, ok := out.Types[iface.Name]; ok {
+				out.AddImplements(name, iface)
+			}
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,56 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vektah/gqlparser/v2"
+)
+
+func TestStorefrontSchema(t *testing.T) {
+	s := storefront()
+
+	t.Run("Admin fields removed", func(t *testing.T) {
+		assert.Nil(t, s.Query.Fields.ForName("logSettings"))
+		assert.Nil(t, s.Mutation.Fields.ForName("setMaintenanceMode"))
+		assert.NotNil(t, s.Query.Fields.ForName("maintenanceMode"))
+		assert.NotNil(t, s.Query.Fields.ForName("__schema"))
+
+		// The full schema is untouched
+		assert.NotNil(t, parsedSchema.Query.Fields.ForName("logSettings"))
+	})
+
+	t.Run("Admin-only types removed", func(t *testing.T) {
+		for _, name := range []string{"LogSettings", "SetLogSettingsInput", "ApiKey", "UsageFlag", "OrderSlaBreach"} {
+			assert.NotContains(t, s.Types, name)
+			assert.Contains(t, parsedSchema.Types, name)
+		}
+	})
+
+	t.Run("Shared and directive types kept", func(t *testing.T) {
+		for _, name := range []string{"Order", "Product", "Role", "ApiKeyScope", "String", "__Schema"} {
+			assert.Contains(t, s.Types, name)
+		}
+	})
+
+	t.Run("Every reference resolves", func(t *testing.T) {
+		for _, def := range s.Types {
+			for _, f := range def.Fields {
+				assert.Contains(t, s.Types, f.Type.Name(), "%s.%s", def.Name, f.Name)
+				for _, arg := range f.Arguments {
+					assert.Contains(t, s.Types, arg.Type.Name(), "%s.%s(%s)", def.Name, f.Name, arg.Name)
+				}
+			}
+		}
+	})
+
+	t.Run("Queries validate against it", func(t *testing.T) {
+		_, errs := gqlparser.LoadQuery(s, `{ maintenanceMode { enabled } }`)
+		assert.Empty(t, errs)
+
+		_, errs = gqlparser.LoadQuery(s, `{ logSettings { level } }`)
+		require.Len(t, errs, 1)
+		assert.Contains(t, errs[0].Message, `Cannot query field "logSettings"`)
+	})
+}
@@ -0,0 +1,51 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"warimas-be/internal/graph/model"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/synthetic"
+
+	"go.uber.org/zap"
+)
+
+// RunSyntheticCheckout is the resolver for the runSyntheticCheckout field.
+func (r *mutationResolver) RunSyntheticCheckout(ctx context.Context) (*model.SyntheticCheckoutRun, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "RunSyntheticCheckout"),
+	)
+
+	run, err := r.SyntheticSvc.Trigger(ctx)
+	if err != nil {
+		log.Error("failed to run synthetic checkout", zap.Error(err))
+		return nil, err
+	}
+
+	return synthetic.MapRunToGraphQL(run), nil
+}
+
+// SyntheticCheckoutRuns is the resolver for the syntheticCheckoutRuns field.
+func (r *queryResolver) SyntheticCheckoutRuns(ctx context.Context, limit *int32) ([]*model.SyntheticCheckoutRun, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "SyntheticCheckoutRuns"),
+	)
+
+	var l int32
+	if limit != nil {
+		l = *limit
+	}
+
+	runs, err := r.SyntheticSvc.Runs(ctx, l)
+	if err != nil {
+		log.Error("failed to list synthetic checkout runs", zap.Error(err))
+		return nil, err
+	}
+
+	return synthetic.MapRunsToGraphQL(runs), nil
+}
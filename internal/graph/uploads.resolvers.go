@@ -0,0 +1,136 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"warimas-be/internal/graph/model"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/uploads"
+	"warimas-be/internal/utils"
+
+	"github.com/99designs/gqlgen/graphql"
+	"go.uber.org/zap"
+)
+
+// UploadProductImage is the resolver for the uploadProductImage field.
+func (r *mutationResolver) UploadProductImage(ctx context.Context, productID string, file graphql.Upload) (*model.UploadedFile, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "UploadProductImage"),
+		zap.String("product_id", productID),
+	)
+
+	u, err := r.UploadsSvc.UploadProductImage(ctx, productID, uploads.MapGraphQLUpload(file))
+	if err != nil {
+		log.Error("failed to upload product image", zap.Error(err))
+		return nil, err
+	}
+
+	return uploads.MapUploadToGraphQL(u), nil
+}
+
+// UploadImportFile is the resolver for the uploadImportFile field.
+func (r *mutationResolver) UploadImportFile(ctx context.Context, file graphql.Upload) (*model.UploadedFile, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "UploadImportFile"),
+	)
+
+	u, err := r.UploadsSvc.UploadImportFile(ctx, uploads.MapGraphQLUpload(file))
+	if err != nil {
+		log.Error("failed to upload import file", zap.Error(err))
+		return nil, err
+	}
+
+	return uploads.MapUploadToGraphQL(u), nil
+}
+
+// UploadReturnEvidence is the resolver for the uploadReturnEvidence field.
+func (r *mutationResolver) UploadReturnEvidence(ctx context.Context, orderID string, file graphql.Upload) (*model.UploadedFile, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "UploadReturnEvidence"),
+		zap.String("order_id", orderID),
+	)
+
+	oid, err := utils.ToUint(orderID)
+	if err != nil {
+		log.Warn("invalid order id", zap.Error(err))
+		return nil, err
+	}
+
+	u, err := r.UploadsSvc.UploadReturnEvidence(ctx, int32(oid), uploads.MapGraphQLUpload(file))
+	if err != nil {
+		log.Error("failed to upload return evidence", zap.Error(err))
+		return nil, err
+	}
+
+	return uploads.MapUploadToGraphQL(u), nil
+}
+
+// UploadOrderMessageAttachment is the resolver for the uploadOrderMessageAttachment field.
+func (r *mutationResolver) UploadOrderMessageAttachment(ctx context.Context, orderID string, file graphql.Upload) (*model.UploadedFile, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "UploadOrderMessageAttachment"),
+		zap.String("order_id", orderID),
+	)
+
+	oid, err := utils.ToUint(orderID)
+	if err != nil {
+		log.Warn("invalid order id", zap.Error(err))
+		return nil, err
+	}
+
+	u, err := r.UploadsSvc.UploadOrderMessageAttachment(ctx, int32(oid), uploads.MapGraphQLUpload(file))
+	if err != nil {
+		log.Error("failed to upload order message attachment", zap.Error(err))
+		return nil, err
+	}
+
+	return uploads.MapUploadToGraphQL(u), nil
+}
+
+// UploadAvatar is the resolver for the uploadAvatar field.
+func (r *mutationResolver) UploadAvatar(ctx context.Context, file graphql.Upload) (*model.UploadedFile, error) {
+	u, err := r.UploadsSvc.UploadAvatar(ctx, uploads.MapGraphQLUpload(file))
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to upload avatar",
+			zap.String("layer", "resolver"),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return uploads.MapUploadToGraphQL(u), nil
+}
+
+// ReturnEvidence is the resolver for the returnEvidence field.
+func (r *queryResolver) ReturnEvidence(ctx context.Context, orderID string) ([]*model.UploadedFile, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "ReturnEvidence"),
+		zap.String("order_id", orderID),
+	)
+
+	oid, err := utils.ToUint(orderID)
+	if err != nil {
+		log.Warn("invalid order id", zap.Error(err))
+		return nil, err
+	}
+
+	list, err := r.UploadsSvc.ReturnEvidence(ctx, int32(oid))
+	if err != nil {
+		log.Error("failed to list return evidence", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]*model.UploadedFile, 0, len(list))
+	for _, u := range list {
+		out = append(out, uploads.MapUploadToGraphQL(u))
+	}
+	return out, nil
+}
@@ -34,6 +34,13 @@ func (r *mutationResolver) Register(ctx context.Context, input model.RegisterInp
 		return nil, err
 	}
 
+	// A bad referral code must not block signup
+	if input.ReferralCode != nil && *input.ReferralCode != "" && r.ReferralSvc != nil {
+		if _, err := r.ReferralSvc.AttachReferral(ctx, uint(u.ID), *input.ReferralCode); err != nil {
+			log.Warn("failed to attach referral", zap.Error(err))
+		}
+	}
+
 	w := transport.GetResponseWriter(ctx) // <-- your helper
 	if w != nil {
 		http.SetCookie(w, &http.Cookie{
@@ -107,6 +114,107 @@ func (r *mutationResolver) Login(ctx context.Context, input model.LoginInput) (*
 	}, nil
 }
 
+// LoginWithPhone is the resolver for the loginWithPhone field.
+func (r *mutationResolver) LoginWithPhone(ctx context.Context, input model.PhoneLoginInput) (*model.AuthResponse, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "LoginWithPhone"),
+	)
+
+	log.Info("phone login request received")
+
+	token, u, err := r.UserSvc.LoginWithPhone(ctx, input.Phone, input.Password)
+	if err != nil {
+		log.Warn("phone login failed", zap.Error(err))
+		return nil, err
+	}
+
+	w := transport.GetResponseWriter(ctx)
+	if w != nil {
+		http.SetCookie(w, &http.Cookie{
+			Name:     "access_token",
+			Value:    token,
+			Path:     "/",
+			HttpOnly: true,
+			Secure:   true, // HTTPS only
+			SameSite: http.SameSiteNoneMode,
+			MaxAge:   60 * 60 * 24, // 24 hours
+		})
+	}
+
+	log.Info("phone login successful",
+		zap.String("user_id", fmt.Sprint(u.ID)),
+		zap.String("role", string(u.Role)),
+	)
+
+	return &model.AuthResponse{
+		Token: &token,
+		User: &model.User{
+			ID:    fmt.Sprint(u.ID),
+			Email: u.Email,
+			Role:  model.Role(u.Role),
+		},
+	}, nil
+}
+
+// RequestLoginOtp is the resolver for the requestLoginOtp field.
+func (r *mutationResolver) RequestLoginOtp(ctx context.Context, phone string) (bool, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "RequestLoginOtp"),
+	)
+
+	if err := r.UserSvc.RequestLoginOTP(ctx, phone); err != nil {
+		log.Warn("failed to request login code", zap.Error(err))
+		return false, err
+	}
+
+	return true, nil
+}
+
+// LoginWithOtp is the resolver for the loginWithOtp field.
+func (r *mutationResolver) LoginWithOtp(ctx context.Context, phone string, code string) (*model.AuthResponse, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "LoginWithOtp"),
+	)
+
+	log.Info("otp login request received")
+
+	token, u, err := r.UserSvc.LoginWithOTP(ctx, phone, code)
+	if err != nil {
+		log.Warn("otp login failed", zap.Error(err))
+		return nil, err
+	}
+
+	w := transport.GetResponseWriter(ctx)
+	if w != nil {
+		http.SetCookie(w, &http.Cookie{
+			Name:     "access_token",
+			Value:    token,
+			Path:     "/",
+			HttpOnly: true,
+			Secure:   true, // HTTPS only
+			SameSite: http.SameSiteNoneMode,
+			MaxAge:   60 * 60 * 24, // 24 hours
+		})
+	}
+
+	log.Info("otp login successful",
+		zap.String("user_id", fmt.Sprint(u.ID)),
+		zap.String("role", string(u.Role)),
+	)
+
+	return &model.AuthResponse{
+		Token: &token,
+		User: &model.User{
+			ID:    fmt.Sprint(u.ID),
+			Email: u.Email,
+			Role:  model.Role(u.Role),
+		},
+	}, nil
+}
+
 // ForgotPassword is the resolver for the forgotPassword field.
 func (r *mutationResolver) ForgotPassword(ctx context.Context, input model.ForgotPasswordInput) (*model.ForgotPasswordResponse, error) {
 	log := logger.FromCtx(ctx).With(
@@ -189,7 +297,7 @@ func (r *mutationResolver) UpdateProfile(ctx context.Context, input model.Update
 	userID, ok := utils.GetUserIDFromContext(ctx)
 	if !ok {
 		log.Warn("unauthorized update profile attempt")
-		return nil, fmt.Errorf("unauthorized")
+		return nil, errUnauthorized
 	}
 
 	log = log.With(zap.Uint("user_id", userID))
@@ -208,6 +316,7 @@ func (r *mutationResolver) UpdateProfile(ctx context.Context, input model.Update
 	params := user.UpdateProfileParams{
 		UserID:      userID,
 		FullName:    input.FullName,
+		DisplayName: input.DisplayName,
 		Bio:         input.Bio,
 		AvatarURL:   input.AvatarURL,
 		Phone:       input.Phone,
@@ -225,6 +334,124 @@ func (r *mutationResolver) UpdateProfile(ctx context.Context, input model.Update
 	return mapProfileToGraphQL(updated), nil
 }
 
+// RequestPhoneVerification is the resolver for the requestPhoneVerification field.
+func (r *mutationResolver) RequestPhoneVerification(ctx context.Context, input model.RequestPhoneVerificationInput) (bool, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "RequestPhoneVerification"),
+	)
+
+	userID, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		log.Warn("unauthorized phone verification attempt")
+		return false, errUnauthorized
+	}
+
+	if err := r.UserSvc.RequestPhoneVerification(ctx, userID, input.Phone); err != nil {
+		log.Warn("failed to request phone verification", zap.Uint("user_id", userID), zap.Error(err))
+		return false, err
+	}
+
+	return true, nil
+}
+
+// VerifyPhone is the resolver for the verifyPhone field.
+func (r *mutationResolver) VerifyPhone(ctx context.Context, input model.VerifyPhoneInput) (*model.Profile, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "VerifyPhone"),
+	)
+
+	userID, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		log.Warn("unauthorized verify phone attempt")
+		return nil, errUnauthorized
+	}
+
+	profile, err := r.UserSvc.VerifyPhone(ctx, userID, input.Code)
+	if err != nil {
+		log.Warn("failed to verify phone", zap.Uint("user_id", userID), zap.Error(err))
+		return nil, err
+	}
+
+	return mapProfileToGraphQL(profile), nil
+}
+
+// BlockDisplayNameTerm is the resolver for the blockDisplayNameTerm field.
+func (r *mutationResolver) BlockDisplayNameTerm(ctx context.Context, term string) (*model.BlockedDisplayNameTerm, error) {
+	t, err := r.UserSvc.BlockTerm(ctx, term)
+	if err != nil {
+		logger.FromCtx(ctx).Warn("failed to block display name term",
+			zap.String("layer", "resolver"),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	return mapBlockedTermToGraphQL(t), nil
+}
+
+// UnblockDisplayNameTerm is the resolver for the unblockDisplayNameTerm field.
+func (r *mutationResolver) UnblockDisplayNameTerm(ctx context.Context, term string) (bool, error) {
+	if err := r.UserSvc.UnblockTerm(ctx, term); err != nil {
+		logger.FromCtx(ctx).Warn("failed to unblock display name term",
+			zap.String("layer", "resolver"),
+			zap.Error(err),
+		)
+		return false, err
+	}
+
+	return true, nil
+}
+
+// RestrictAccount is the resolver for the restrictAccount field.
+func (r *mutationResolver) RestrictAccount(ctx context.Context, input model.RestrictAccountInput) (*model.AccountRestriction, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "RestrictAccount"),
+	)
+
+	userID, err := utils.ToUint(input.UserID)
+	if err != nil {
+		log.Warn("invalid user id", zap.Error(err))
+		return nil, err
+	}
+
+	res, err := r.UserSvc.RestrictAccount(ctx, user.RestrictParams{
+		UserID:    userID,
+		Level:     user.RestrictionLevel(input.Level),
+		Reason:    input.Reason,
+		ExpiresAt: input.ExpiresAt,
+	})
+	if err != nil {
+		log.Warn("failed to restrict account", zap.Error(err))
+		return nil, err
+	}
+
+	return mapRestrictionToGraphQL(res), nil
+}
+
+// LiftAccountRestriction is the resolver for the liftAccountRestriction field.
+func (r *mutationResolver) LiftAccountRestriction(ctx context.Context, userID string) (bool, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "LiftAccountRestriction"),
+	)
+
+	id, err := utils.ToUint(userID)
+	if err != nil {
+		log.Warn("invalid user id", zap.Error(err))
+		return false, err
+	}
+
+	if err := r.UserSvc.LiftRestriction(ctx, id); err != nil {
+		log.Warn("failed to lift account restriction", zap.Error(err))
+		return false, err
+	}
+
+	return true, nil
+}
+
 // MyProfile is the resolver for the myProfile field.
 func (r *queryResolver) MyProfile(ctx context.Context) (*model.Profile, error) {
 	log := logger.FromCtx(ctx).With(
@@ -235,7 +462,7 @@ func (r *queryResolver) MyProfile(ctx context.Context) (*model.Profile, error) {
 	userID, ok := utils.GetUserIDFromContext(ctx)
 	if !ok {
 		log.Warn("unauthorized my profile attempt")
-		return nil, fmt.Errorf("unauthorized")
+		return nil, errUnauthorized
 	}
 
 	log = log.With(zap.Uint("user_id", userID))
@@ -251,3 +478,61 @@ func (r *queryResolver) MyProfile(ctx context.Context) (*model.Profile, error) {
 
 	return mapProfileToGraphQL(profile), nil
 }
+
+// DisplayNameBlocklist is the resolver for the displayNameBlocklist field.
+func (r *queryResolver) DisplayNameBlocklist(ctx context.Context) ([]*model.BlockedDisplayNameTerm, error) {
+	terms, err := r.UserSvc.ListBlockedTerms(ctx)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to list display name blocklist",
+			zap.String("layer", "resolver"),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	out := make([]*model.BlockedDisplayNameTerm, len(terms))
+	for i, t := range terms {
+		out[i] = mapBlockedTermToGraphQL(t)
+	}
+	return out, nil
+}
+
+// AccountRestrictions is the resolver for the accountRestrictions field.
+func (r *queryResolver) AccountRestrictions(ctx context.Context, limit *int32) ([]*model.AccountRestriction, error) {
+	var l int32
+	if limit != nil {
+		l = *limit
+	}
+
+	restrictions, err := r.UserSvc.ListRestrictions(ctx, l)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to list account restrictions",
+			zap.String("layer", "resolver"),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	out := make([]*model.AccountRestriction, len(restrictions))
+	for i, res := range restrictions {
+		out[i] = mapRestrictionToGraphQL(res)
+	}
+	return out, nil
+}
+
+// MyAccountRestriction is the resolver for the myAccountRestriction field.
+func (r *queryResolver) MyAccountRestriction(ctx context.Context) (*model.AccountRestriction, error) {
+	res, err := r.UserSvc.MyRestriction(ctx)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to load account restriction",
+			zap.String("layer", "resolver"),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+
+	return mapRestrictionToGraphQL(res), nil
+}
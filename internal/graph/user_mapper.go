@@ -2,10 +2,8 @@ package graph
 
 import (
 	"fmt"
-	"time"
 	"warimas-be/internal/graph/model"
 	"warimas-be/internal/user"
-	"warimas-be/internal/utils"
 )
 
 func mapProfileToGraphQL(profile *user.Profile) *model.Profile {
@@ -16,15 +14,34 @@ func mapProfileToGraphQL(profile *user.Profile) *model.Profile {
 	}
 
 	return &model.Profile{
-		ID:          profile.ID.String(),
-		UserID:      fmt.Sprint(profile.UserID),
-		FullName:    profile.FullName,
-		Bio:         profile.Bio,
-		AvatarURL:   profile.AvatarURL,
-		Phone:       profile.Phone,
-		Email:       profile.Email,
-		DateOfBirth: dob,
-		CreatedAt:   utils.StrPtr(profile.CreatedAt.Format(time.RFC3339)),
-		UpdatedAt:   utils.StrPtr(profile.UpdatedAt.Format(time.RFC3339)),
+		ID:            profile.ID.String(),
+		UserID:        fmt.Sprint(profile.UserID),
+		FullName:      profile.FullName,
+		DisplayName:   profile.DisplayName,
+		Bio:           profile.Bio,
+		AvatarURL:     profile.AvatarURL,
+		Phone:         profile.Phone,
+		PhoneVerified: profile.PhoneVerifiedAt != nil,
+		Email:         profile.Email,
+		DateOfBirth:   dob,
+		CreatedAt:     &profile.CreatedAt,
+		UpdatedAt:     &profile.UpdatedAt,
+	}
+}
+
+func mapBlockedTermToGraphQL(t *user.BlockedTerm) *model.BlockedDisplayNameTerm {
+	return &model.BlockedDisplayNameTerm{
+		Term:      t.Term,
+		CreatedAt: t.CreatedAt,
+	}
+}
+
+func mapRestrictionToGraphQL(r *user.Restriction) *model.AccountRestriction {
+	return &model.AccountRestriction{
+		UserID:    fmt.Sprint(r.UserID),
+		Level:     model.AccountRestrictionLevel(r.Level),
+		Reason:    r.Reason,
+		ExpiresAt: r.ExpiresAt,
+		CreatedAt: r.CreatedAt,
 	}
 }
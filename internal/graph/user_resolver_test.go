@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 	"warimas-be/internal/graph/model"
 	"warimas-be/internal/user"
 	"warimas-be/internal/utils"
@@ -71,6 +72,95 @@ func (m *MockUserService) UpdateProfile(ctx context.Context, params user.UpdateP
 
 // --- Tests ---
 
+func (m *MockUserService) RequestPhoneVerification(ctx context.Context, userID uint, phone string) error {
+	args := m.Called(ctx, userID, phone)
+	return args.Error(0)
+}
+
+func (m *MockUserService) VerifyPhone(ctx context.Context, userID uint, code string) (*user.Profile, error) {
+	args := m.Called(ctx, userID, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*user.Profile), args.Error(1)
+}
+
+func (m *MockUserService) LoginWithPhone(ctx context.Context, phone, password string) (string, *user.User, error) {
+	args := m.Called(ctx, phone, password)
+	if args.Get(1) == nil {
+		return args.String(0), nil, args.Error(2)
+	}
+	return args.String(0), args.Get(1).(*user.User), args.Error(2)
+}
+
+func (m *MockUserService) RequestLoginOTP(ctx context.Context, phone string) error {
+	args := m.Called(ctx, phone)
+	return args.Error(0)
+}
+
+func (m *MockUserService) LoginWithOTP(ctx context.Context, phone, code string) (string, *user.User, error) {
+	args := m.Called(ctx, phone, code)
+	if args.Get(1) == nil {
+		return args.String(0), nil, args.Error(2)
+	}
+	return args.String(0), args.Get(1).(*user.User), args.Error(2)
+}
+
+func (m *MockUserService) SetAvatar(ctx context.Context, userID uint, url string) error {
+	args := m.Called(ctx, userID, url)
+	return args.Error(0)
+}
+
+func (m *MockUserService) ListBlockedTerms(ctx context.Context) ([]*user.BlockedTerm, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*user.BlockedTerm), args.Error(1)
+}
+
+func (m *MockUserService) BlockTerm(ctx context.Context, term string) (*user.BlockedTerm, error) {
+	args := m.Called(ctx, term)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*user.BlockedTerm), args.Error(1)
+}
+
+func (m *MockUserService) UnblockTerm(ctx context.Context, term string) error {
+	args := m.Called(ctx, term)
+	return args.Error(0)
+}
+
+func (m *MockUserService) RestrictAccount(ctx context.Context, params user.RestrictParams) (*user.Restriction, error) {
+	args := m.Called(ctx, params)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*user.Restriction), args.Error(1)
+}
+
+func (m *MockUserService) LiftRestriction(ctx context.Context, userID uint) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockUserService) ListRestrictions(ctx context.Context, limit int32) ([]*user.Restriction, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*user.Restriction), args.Error(1)
+}
+
+func (m *MockUserService) MyRestriction(ctx context.Context) (*user.Restriction, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*user.Restriction), args.Error(1)
+}
+
 func TestMutationResolver_Register(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		mockSvc := new(MockUserService)
@@ -147,6 +237,35 @@ func TestMutationResolver_Login(t *testing.T) {
 	})
 }
 
+func TestMutationResolver_LoginWithOtp(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockSvc := new(MockUserService)
+		mr := &mutationResolver{&Resolver{UserSvc: mockSvc}}
+		ctx := context.Background()
+
+		mockSvc.On("LoginWithOTP", ctx, "+628123456789", "123456").
+			Return("token", &user.User{ID: 3, Email: "otp@example.com", Role: user.RoleUser}, nil)
+
+		res, err := mr.LoginWithOtp(ctx, "+628123456789", "123456")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "token", *res.Token)
+		assert.Equal(t, "3", res.User.ID)
+	})
+
+	t.Run("WrongCode", func(t *testing.T) {
+		mockSvc := new(MockUserService)
+		mr := &mutationResolver{&Resolver{UserSvc: mockSvc}}
+		ctx := context.Background()
+
+		mockSvc.On("LoginWithOTP", ctx, "+628123456789", "000000").Return("", nil, user.ErrOTPInvalid)
+
+		_, err := mr.LoginWithOtp(ctx, "+628123456789", "000000")
+
+		assert.ErrorIs(t, err, user.ErrOTPInvalid)
+	})
+}
+
 func TestMutationResolver_ForgotPassword(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		mockSvc := new(MockUserService)
@@ -233,22 +352,25 @@ func TestMutationResolver_UpdateProfile(t *testing.T) {
 
 		ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "user")
 		name := "John Doe"
-		input := model.UpdateProfileInput{FullName: &name}
+		displayName := "johnd"
+		input := model.UpdateProfileInput{FullName: &name, DisplayName: &displayName}
 
 		expectedProfile := &user.Profile{
-			ID:       uuid.New(),
-			UserID:   1,
-			FullName: &name,
+			ID:          uuid.New(),
+			UserID:      1,
+			FullName:    &name,
+			DisplayName: &displayName,
 		}
 
 		mockSvc.On("UpdateProfile", ctx, mock.MatchedBy(func(p user.UpdateProfileParams) bool {
-			return p.UserID == 1 && *p.FullName == "John Doe"
+			return p.UserID == 1 && *p.FullName == "John Doe" && *p.DisplayName == "johnd"
 		})).Return(expectedProfile, nil)
 
 		res, err := mr.UpdateProfile(ctx, input)
 
 		assert.NoError(t, err)
 		assert.Equal(t, "John Doe", *res.FullName)
+		assert.Equal(t, "johnd", *res.DisplayName)
 		mockSvc.AssertExpectations(t)
 	})
 
@@ -259,7 +381,63 @@ func TestMutationResolver_UpdateProfile(t *testing.T) {
 
 		_, err := mr.UpdateProfile(context.Background(), model.UpdateProfileInput{})
 		assert.Error(t, err)
-		assert.Equal(t, "unauthorized", err.Error())
+		assert.ErrorIs(t, err, errUnauthorized)
+	})
+}
+
+func TestMutationResolver_VerifyPhone(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockSvc := new(MockUserService)
+		mr := &mutationResolver{&Resolver{UserSvc: mockSvc}}
+
+		ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "user")
+		phone := "+628123456789"
+		verifiedAt := time.Now()
+		mockSvc.On("VerifyPhone", ctx, uint(1), "123456").
+			Return(&user.Profile{ID: uuid.New(), UserID: 1, Phone: &phone, PhoneVerifiedAt: &verifiedAt}, nil)
+
+		res, err := mr.VerifyPhone(ctx, model.VerifyPhoneInput{Code: "123456"})
+
+		assert.NoError(t, err)
+		assert.True(t, res.PhoneVerified)
+		assert.Equal(t, phone, *res.Phone)
+	})
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		mr := &mutationResolver{&Resolver{UserSvc: new(MockUserService)}}
+
+		_, err := mr.VerifyPhone(context.Background(), model.VerifyPhoneInput{Code: "123456"})
+		assert.ErrorIs(t, err, errUnauthorized)
+	})
+}
+
+func TestMutationResolver_RestrictAccount(t *testing.T) {
+	ctx := utils.SetUserContext(context.Background(), 9, "admin@example.com", "ADMIN")
+
+	t.Run("Success", func(t *testing.T) {
+		mockSvc := new(MockUserService)
+		mr := &mutationResolver{&Resolver{UserSvc: mockSvc}}
+		expires := time.Now().Add(24 * time.Hour)
+		params := user.RestrictParams{UserID: 4, Level: user.RestrictionBlockCheckout, Reason: "chargebacks", ExpiresAt: &expires}
+		mockSvc.On("RestrictAccount", ctx, params).
+			Return(&user.Restriction{UserID: 4, Level: user.RestrictionBlockCheckout, Reason: "chargebacks", ExpiresAt: &expires}, nil)
+
+		res, err := mr.RestrictAccount(ctx, model.RestrictAccountInput{
+			UserID: "4", Level: model.AccountRestrictionLevelBlockCheckout, Reason: "chargebacks", ExpiresAt: &expires,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "4", res.UserID)
+		assert.Equal(t, model.AccountRestrictionLevelBlockCheckout, res.Level)
+	})
+
+	t.Run("InvalidUserID", func(t *testing.T) {
+		mockSvc := new(MockUserService)
+		mr := &mutationResolver{&Resolver{UserSvc: mockSvc}}
+
+		_, err := mr.LiftAccountRestriction(ctx, "abc")
+		assert.Error(t, err)
+		mockSvc.AssertNotCalled(t, "LiftRestriction", mock.Anything, mock.Anything)
 	})
 }
 
@@ -293,7 +471,7 @@ func TestQueryResolver_MyProfile(t *testing.T) {
 
 		_, err := qr.MyProfile(context.Background())
 		assert.Error(t, err)
-		assert.Equal(t, "unauthorized", err.Error())
+		assert.ErrorIs(t, err, errUnauthorized)
 	})
 
 	t.Run("ServiceError", func(t *testing.T) {
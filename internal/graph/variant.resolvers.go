@@ -6,7 +6,6 @@ package graph
 
 import (
 	"context"
-	"errors"
 	"warimas-be/internal/graph/model"
 	"warimas-be/internal/product"
 	"warimas-be/internal/utils"
@@ -16,19 +15,32 @@ import (
 func (r *mutationResolver) CreateVariants(ctx context.Context, input []*model.NewVariant) ([]*model.Variant, error) {
 	_, ok := utils.GetUserIDFromContext(ctx)
 	if !ok {
-		return nil, errors.New("unauthorized: please login first")
+		return nil, errLoginRequired
 	}
 
 	svcInput := make([]*product.NewVariantInput, len(input))
 	for i, v := range input {
 		svcInput[i] = &product.NewVariantInput{
-			ProductID:    v.ProductID,
-			QuantityType: v.QuantityType,
-			Name:         v.Name,
-			Price:        v.Price,
-			Stock:        int32(v.Stock),
-			ImageURL:     v.ImageURL,
-			Description:  v.Description,
+			ProductID:      v.ProductID,
+			QuantityType:   v.QuantityType,
+			Name:           v.Name,
+			Price:          v.Price,
+			CompareAtPrice: v.CompareAtPrice,
+			Stock:          int32(v.Stock),
+			ImageURL:       v.ImageURL,
+			Description:    v.Description,
+		}
+		if v.WeightGrams != nil {
+			svcInput[i].WeightGrams = *v.WeightGrams
+		}
+		if v.LengthCm != nil {
+			svcInput[i].LengthCm = *v.LengthCm
+		}
+		if v.WidthCm != nil {
+			svcInput[i].WidthCm = *v.WidthCm
+		}
+		if v.HeightCm != nil {
+			svcInput[i].HeightCm = *v.HeightCm
 		}
 	}
 
@@ -49,7 +61,7 @@ func (r *mutationResolver) CreateVariants(ctx context.Context, input []*model.Ne
 func (r *mutationResolver) UpdateVariants(ctx context.Context, input []*model.UpdateVariant) ([]*model.Variant, error) {
 	_, ok := utils.GetUserIDFromContext(ctx)
 	if !ok {
-		return nil, errors.New("unauthorized: please login first")
+		return nil, errLoginRequired
 	}
 
 	svcInput := make([]*product.UpdateVariantInput, len(input))
@@ -60,14 +72,19 @@ func (r *mutationResolver) UpdateVariants(ctx context.Context, input []*model.Up
 			stock = &s
 		}
 		svcInput[i] = &product.UpdateVariantInput{
-			ID:           v.ID,
-			ProductID:    v.ProductID,
-			QuantityType: v.QuantityType,
-			Name:         v.Name,
-			Price:        v.Price,
-			Stock:        stock,
-			ImageURL:     v.ImageURL,
-			Description:  v.Description,
+			ID:             v.ID,
+			ProductID:      v.ProductID,
+			QuantityType:   v.QuantityType,
+			Name:           v.Name,
+			Price:          v.Price,
+			CompareAtPrice: v.CompareAtPrice,
+			Stock:          stock,
+			ImageURL:       v.ImageURL,
+			Description:    v.Description,
+			WeightGrams:    v.WeightGrams,
+			LengthCm:       v.LengthCm,
+			WidthCm:        v.WidthCm,
+			HeightCm:       v.HeightCm,
 		}
 	}
 
@@ -83,3 +100,12 @@ func (r *mutationResolver) UpdateVariants(ctx context.Context, input []*model.Up
 
 	return res, nil
 }
+
+// QuantityTypes is the resolver for the quantityTypes field.
+func (r *queryResolver) QuantityTypes(ctx context.Context) ([]*model.QuantityTypeInfo, error) {
+	out := make([]*model.QuantityTypeInfo, 0, len(product.QuantityTypes))
+	for _, info := range product.QuantityTypes {
+		out = append(out, MapQuantityTypeInfoToGraphQL(info))
+	}
+	return out, nil
+}
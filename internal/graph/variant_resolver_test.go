@@ -45,7 +45,7 @@ func TestMutationResolver_CreateVariants(t *testing.T) {
 
 		_, err := mr.CreateVariants(context.Background(), []*model.NewVariant{})
 		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "unauthorized")
+		assert.ErrorIs(t, err, errLoginRequired)
 	})
 
 	t.Run("ServiceError", func(t *testing.T) {
@@ -92,7 +92,7 @@ func TestMutationResolver_UpdateVariants(t *testing.T) {
 
 		_, err := mr.UpdateVariants(context.Background(), []*model.UpdateVariant{})
 		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "unauthorized")
+		assert.ErrorIs(t, err, errLoginRequired)
 	})
 
 	t.Run("ServiceError", func(t *testing.T) {
@@ -107,3 +107,21 @@ func TestMutationResolver_UpdateVariants(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestQueryResolver_QuantityTypes(t *testing.T) {
+	qr := &queryResolver{&Resolver{}}
+
+	res, err := qr.QuantityTypes(context.Background())
+
+	assert.NoError(t, err)
+	assert.Len(t, res, len(product.QuantityTypes))
+	for _, info := range res {
+		if info.Code == "kg" {
+			assert.Equal(t, "g", *info.BaseUnit)
+			assert.Equal(t, int32(1000), *info.BasePerUnit)
+		}
+		if info.Code == "unit" {
+			assert.Nil(t, info.BaseUnit)
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"warimas-be/internal/graph/model"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/utils"
+	"warimas-be/internal/voucher"
+
+	"go.uber.org/zap"
+)
+
+// CreateVoucherCampaign is the resolver for the createVoucherCampaign field.
+func (r *mutationResolver) CreateVoucherCampaign(ctx context.Context, input model.CreateVoucherCampaignInput) (*model.VoucherCampaign, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "CreateVoucherCampaign"),
+	)
+
+	c := &voucher.Campaign{
+		Name:        input.Name,
+		Description: input.Description,
+		StartsAt:    input.StartsAt,
+		EndsAt:      input.EndsAt,
+	}
+
+	if err := r.VoucherSvc.CreateCampaign(ctx, c); err != nil {
+		log.Error("failed to create voucher campaign", zap.Error(err))
+		return nil, err
+	}
+
+	return voucher.MapCampaignToGraphQL(c), nil
+}
+
+// RefreshCustomerSegments is the resolver for the refreshCustomerSegments field.
+func (r *mutationResolver) RefreshCustomerSegments(ctx context.Context) ([]*model.CustomerSegmentSize, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "RefreshCustomerSegments"),
+	)
+
+	sizes, err := r.VoucherSvc.RefreshSegments(ctx)
+	if err != nil {
+		log.Error("failed to refresh customer segments", zap.Error(err))
+		return nil, err
+	}
+
+	return voucher.MapSegmentSizesToGraphQL(sizes), nil
+}
+
+// IssueSegmentVouchers is the resolver for the issueSegmentVouchers field.
+func (r *mutationResolver) IssueSegmentVouchers(ctx context.Context, input model.IssueSegmentVouchersInput) (int32, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "IssueSegmentVouchers"),
+		zap.String("campaign_id", input.CampaignID),
+	)
+
+	campaignID, err := utils.ToUint(input.CampaignID)
+	if err != nil {
+		log.Warn("invalid campaign id", zap.Error(err))
+		return 0, err
+	}
+
+	in := voucher.IssueSegmentVouchersInput{
+		CampaignID:    int64(campaignID),
+		Segment:       voucher.Segment(input.Segment),
+		CodePrefix:    input.CodePrefix,
+		DiscountType:  voucher.DiscountType(input.DiscountType),
+		DiscountValue: int64(input.DiscountValue),
+		ExpiresAt:     input.ExpiresAt,
+	}
+	if input.MaxDiscount != nil {
+		maxDiscount := int64(*input.MaxDiscount)
+		in.MaxDiscount = &maxDiscount
+	}
+	if input.MinSubtotal != nil {
+		in.MinSubtotal = int64(*input.MinSubtotal)
+	}
+
+	issued, err := r.VoucherSvc.IssueSegmentVouchers(ctx, in)
+	if err != nil {
+		log.Error("failed to issue segment vouchers", zap.Error(err))
+		return 0, err
+	}
+
+	return int32(issued), nil
+}
+
+// PromotionReport is the resolver for the promotionReport field.
+func (r *queryResolver) PromotionReport(ctx context.Context, input model.PromotionReportInput) ([]*model.CampaignPerformance, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "PromotionReport"),
+	)
+
+	report, err := r.VoucherSvc.GetPromotionReport(ctx, input.From, input.To)
+	if err != nil {
+		log.Error("failed to get promotion report", zap.Error(err))
+		return nil, err
+	}
+
+	return voucher.MapCampaignPerformanceToGraphQL(report), nil
+}
@@ -0,0 +1,30 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"warimas-be/internal/graph/model"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/wallet"
+
+	"go.uber.org/zap"
+)
+
+// MyWallet is the resolver for the myWallet field.
+func (r *queryResolver) MyWallet(ctx context.Context) (*model.Wallet, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "MyWallet"),
+	)
+
+	w, entries, err := r.WalletSvc.GetMyWallet(ctx)
+	if err != nil {
+		log.Error("failed to get wallet", zap.Error(err))
+		return nil, err
+	}
+
+	return wallet.MapWalletToGraphQL(w, entries), nil
+}
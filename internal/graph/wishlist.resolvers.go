@@ -0,0 +1,124 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"warimas-be/internal/graph/model"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/wishlist"
+
+	"go.uber.org/zap"
+)
+
+// AddToWishlist is the resolver for the addToWishlist field.
+func (r *mutationResolver) AddToWishlist(ctx context.Context, variantID string) (*model.WishlistItem, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "AddToWishlist"),
+		zap.String("variant_id", variantID),
+	)
+
+	it, err := r.WishlistSvc.AddItem(ctx, variantID)
+	if err != nil {
+		log.Error("failed to add to wishlist", zap.Error(err))
+		return nil, err
+	}
+
+	return wishlist.MapItemToGraphQL(it), nil
+}
+
+// RemoveFromWishlist is the resolver for the removeFromWishlist field.
+func (r *mutationResolver) RemoveFromWishlist(ctx context.Context, variantID string) (bool, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "RemoveFromWishlist"),
+		zap.String("variant_id", variantID),
+	)
+
+	removed, err := r.WishlistSvc.RemoveItem(ctx, variantID)
+	if err != nil {
+		log.Error("failed to remove from wishlist", zap.Error(err))
+		return false, err
+	}
+
+	return removed, nil
+}
+
+// MarkWishlistAlertsRead is the resolver for the markWishlistAlertsRead field.
+func (r *mutationResolver) MarkWishlistAlertsRead(ctx context.Context) (int32, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "MarkWishlistAlertsRead"),
+	)
+
+	n, err := r.WishlistSvc.MarkAlertsRead(ctx)
+	if err != nil {
+		log.Error("failed to mark wishlist alerts read", zap.Error(err))
+		return 0, err
+	}
+
+	return int32(n), nil
+}
+
+// MyWishlist is the resolver for the myWishlist field.
+func (r *queryResolver) MyWishlist(ctx context.Context, pagination *model.PaginationInput) (*model.WishlistConnection, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "MyWishlist"),
+	)
+
+	page, limit, err := paginationArgs(pagination)
+	if err != nil {
+		log.Warn("invalid cursor", zap.Error(err))
+		return nil, err
+	}
+
+	items, total, err := r.WishlistSvc.GetMyWishlist(ctx, limit, page)
+	if err != nil {
+		log.Error("failed to get wishlist", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]*model.WishlistItem, 0, len(items))
+	for _, it := range items {
+		out = append(out, wishlist.MapItemToGraphQL(it))
+	}
+
+	return &model.WishlistConnection{
+		Items:    out,
+		PageInfo: newPageInfo(total, page, limit),
+	}, nil
+}
+
+// MyWishlistAlerts is the resolver for the myWishlistAlerts field.
+func (r *queryResolver) MyWishlistAlerts(ctx context.Context, unreadOnly *bool, pagination *model.PaginationInput) (*model.WishlistAlertConnection, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "resolver"),
+		zap.String("method", "MyWishlistAlerts"),
+	)
+
+	page, limit, err := paginationArgs(pagination)
+	if err != nil {
+		log.Warn("invalid cursor", zap.Error(err))
+		return nil, err
+	}
+
+	alerts, total, err := r.WishlistSvc.GetMyAlerts(ctx, unreadOnly != nil && *unreadOnly, limit, page)
+	if err != nil {
+		log.Error("failed to get wishlist alerts", zap.Error(err))
+		return nil, err
+	}
+
+	out := make([]*model.WishlistAlert, 0, len(alerts))
+	for _, a := range alerts {
+		out = append(out, wishlist.MapAlertToGraphQL(a))
+	}
+
+	return &model.WishlistAlertConnection{
+		Items:    out,
+		PageInfo: newPageInfo(total, page, limit),
+	}, nil
+}
@@ -0,0 +1,107 @@
+package guest
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	// PruneInterval is how often idle guests are dropped from the write
+	// limiter.
+	PruneInterval = 10 * time.Minute
+	// writerIdle is how long a guest keeps its counters after its last
+	// write. It outlasts the daily window, so going quiet for a while is
+	// not a way to reset the daily cap.
+	writerIdle = 24 * time.Hour
+)
+
+// WriteLimits caps the writes one guest can make. A zero field disables
+// its cap.
+type WriteLimits struct {
+	// PerMinute is the sustained rate; Burst is how many writes may come
+	// at once before it applies.
+	PerMinute int
+	Burst     int
+	// Daily caps the writes in any 24 hours after the guest's first.
+	Daily int
+}
+
+func DefaultWriteLimits() WriteLimits {
+	return WriteLimits{PerMinute: 30, Burst: 30, Daily: 1000}
+}
+
+// WriteLimiter counts the writes of each guest in memory. The caps are
+// soft: each server instance counts on its own, and a restart forgets the
+// counts. They are there to stop scripts, not to meter customers.
+type WriteLimiter struct {
+	limits WriteLimits
+	now    func() time.Time
+
+	mu      sync.Mutex
+	writers map[string]*writer
+}
+
+type writer struct {
+	limiter     *rate.Limiter
+	windowStart time.Time
+	count       int
+	lastSeen    time.Time
+}
+
+func NewWriteLimiter(limits WriteLimits) *WriteLimiter {
+	return &WriteLimiter{
+		limits:  limits,
+		now:     time.Now,
+		writers: make(map[string]*writer),
+	}
+}
+
+// Allow counts a write by guestID and reports whether it is within the
+// guest's caps. Refused writes are not counted.
+func (l *WriteLimiter) Allow(guestID string) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	w, ok := l.writers[guestID]
+	if !ok {
+		w = &writer{windowStart: now}
+		if l.limits.PerMinute > 0 {
+			w.limiter = rate.NewLimiter(rate.Limit(float64(l.limits.PerMinute)/60), max(l.limits.Burst, 1))
+		}
+		l.writers[guestID] = w
+	}
+	w.lastSeen = now
+
+	if now.Sub(w.windowStart) >= 24*time.Hour {
+		w.windowStart = now
+		w.count = 0
+	}
+	if l.limits.Daily > 0 && w.count >= l.limits.Daily {
+		return false
+	}
+	if w.limiter != nil && !w.limiter.AllowN(now, 1) {
+		return false
+	}
+	w.count++
+	return true
+}
+
+// Prune drops guests that have not written for a day and returns how many
+// were dropped.
+func (l *WriteLimiter) Prune() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	dropped := 0
+	for id, w := range l.writers {
+		if now.Sub(w.lastSeen) > writerIdle {
+			delete(l.writers, id)
+			dropped++
+		}
+	}
+	return dropped
+}
@@ -0,0 +1,53 @@
+package guest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteLimiter(t *testing.T) {
+	now := time.Date(2026, 1, 1, 9, 0, 0, 0, time.UTC)
+	newLimiter := func(limits WriteLimits) *WriteLimiter {
+		l := NewWriteLimiter(limits)
+		l.now = func() time.Time { return now }
+		return l
+	}
+
+	t.Run("Burst then rate", func(t *testing.T) {
+		l := newLimiter(WriteLimits{PerMinute: 60, Burst: 3})
+
+		for i := 0; i < 3; i++ {
+			assert.True(t, l.Allow("g-1"))
+		}
+		assert.False(t, l.Allow("g-1"))
+		assert.True(t, l.Allow("g-2"), "guests are counted separately")
+
+		now = now.Add(time.Second)
+		assert.True(t, l.Allow("g-1"))
+	})
+
+	t.Run("Daily cap", func(t *testing.T) {
+		l := newLimiter(WriteLimits{Daily: 2})
+
+		assert.True(t, l.Allow("g-1"))
+		assert.True(t, l.Allow("g-1"))
+		assert.False(t, l.Allow("g-1"))
+
+		now = now.Add(24 * time.Hour)
+		assert.True(t, l.Allow("g-1"))
+	})
+
+	t.Run("Prune drops idle guests", func(t *testing.T) {
+		l := newLimiter(WriteLimits{Daily: 1})
+		l.Allow("g-1")
+
+		now = now.Add(time.Hour)
+		assert.Equal(t, 0, l.Prune())
+
+		now = now.Add(writerIdle)
+		assert.Equal(t, 1, l.Prune())
+		assert.True(t, l.Allow("g-1"))
+	})
+}
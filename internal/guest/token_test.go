@@ -0,0 +1,44 @@
+package guest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSigner(t *testing.T) {
+	s := NewSigner("secret")
+
+	t.Run("Round trip", func(t *testing.T) {
+		id, token := s.Issue()
+
+		got, err := s.Verify(token)
+		require.NoError(t, err)
+		assert.Equal(t, id, got)
+	})
+
+	t.Run("Other secret", func(t *testing.T) {
+		_, token := NewSigner("other").Issue()
+
+		_, err := s.Verify(token)
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("Swapped ID", func(t *testing.T) {
+		_, token := s.Issue()
+		_, sig, _ := strings.Cut(token, ".")
+
+		_, err := s.Verify(uuid.NewString() + "." + sig)
+		assert.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("Malformed", func(t *testing.T) {
+		for _, token := range []string{"", "abc", uuid.NewString(), uuid.NewString() + ".!!"} {
+			_, err := s.Verify(token)
+			assert.ErrorIs(t, err, ErrInvalidToken, token)
+		}
+	})
+}
@@ -0,0 +1,31 @@
+package inventory
+
+import (
+	"errors"
+	"warimas-be/internal/apperr"
+)
+
+var (
+	ErrUnauthenticated      = apperr.Unauthenticated("unauthenticated")
+	ErrForbidden            = apperr.Forbidden("forbidden")
+	ErrInvalidWarehouse     = errors.New("warehouse code, name and region are required")
+	ErrWarehouseExists      = errors.New("warehouse code already exists")
+	ErrWarehouseNotFound    = apperr.NotFound("warehouse not found")
+	ErrDefaultWarehouse     = errors.New("the default warehouse cannot be deactivated")
+	ErrInvalidQuantity      = errors.New("quantity must not be negative")
+	ErrInvalidTransfer      = errors.New("transfer needs two different warehouses and a positive quantity")
+	ErrInsufficientStock    = errors.New("insufficient stock in source warehouse")
+	ErrTransferNotFound     = apperr.NotFound("transfer not found")
+	ErrTransferNotInTransit = errors.New("transfer is not in transit")
+	ErrDB                   = errors.New("database error")
+	PgUniqueViolation       = "23505"
+	PgForeignKeyViolation   = "23503"
+	PgCheckViolation        = "23514"
+
+	ErrInvalidAdjustment    = errors.New("adjustment needs a non-zero quantity change and a reason")
+	ErrApprovalRequired     = errors.New("change is over the approval threshold; request it as a stock adjustment")
+	ErrAdjustmentNotFound   = apperr.NotFound("stock adjustment not found")
+	ErrAdjustmentNotPending = errors.New("stock adjustment is not pending")
+	ErrSelfApproval         = errors.New("a stock adjustment must be approved by a different admin")
+	ErrNegativeStock        = errors.New("adjustment would take stock below zero")
+)
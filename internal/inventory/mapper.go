@@ -0,0 +1,68 @@
+package inventory
+
+import (
+	"strconv"
+	"warimas-be/internal/graph/model"
+)
+
+func MapWarehouseToGraphQL(w *Warehouse) *model.Warehouse {
+	return &model.Warehouse{
+		ID:        w.ID,
+		Code:      w.Code,
+		Name:      w.Name,
+		Region:    w.Region,
+		IsDefault: w.IsDefault,
+		IsActive:  w.IsActive,
+		CreatedAt: w.CreatedAt,
+	}
+}
+
+func MapStockLevelsToGraphQL(levels []*StockLevel) []*model.WarehouseStockLevel {
+	out := make([]*model.WarehouseStockLevel, 0, len(levels))
+	for _, l := range levels {
+		out = append(out, &model.WarehouseStockLevel{
+			WarehouseID:   l.WarehouseID,
+			WarehouseCode: l.WarehouseCode,
+			VariantID:     l.VariantID,
+			Quantity:      l.Quantity,
+			InTransit:     l.InTransit,
+		})
+	}
+	return out
+}
+
+func MapTransferToGraphQL(t *Transfer) *model.StockTransfer {
+	return &model.StockTransfer{
+		ID:              strconv.FormatInt(t.ID, 10),
+		VariantID:       t.VariantID,
+		FromWarehouseID: t.FromWarehouseID,
+		ToWarehouseID:   t.ToWarehouseID,
+		Quantity:        t.Quantity,
+		Status:          model.StockTransferStatus(t.Status),
+		Note:            t.Note,
+		CreatedAt:       t.CreatedAt,
+		ReceivedAt:      t.ReceivedAt,
+		CancelledAt:     t.CancelledAt,
+	}
+}
+
+func MapAdjustmentToGraphQL(a *Adjustment) *model.StockAdjustment {
+	var reviewedBy *string
+	if a.ReviewedBy != nil {
+		s := strconv.Itoa(int(*a.ReviewedBy))
+		reviewedBy = &s
+	}
+
+	return &model.StockAdjustment{
+		ID:          strconv.FormatInt(a.ID, 10),
+		WarehouseID: a.WarehouseID,
+		VariantID:   a.VariantID,
+		Delta:       a.Delta,
+		Reason:      a.Reason,
+		Status:      model.StockAdjustmentStatus(a.Status),
+		RequestedBy: strconv.Itoa(int(a.RequestedBy)),
+		ReviewedBy:  reviewedBy,
+		CreatedAt:   a.CreatedAt,
+		ReviewedAt:  a.ReviewedAt,
+	}
+}
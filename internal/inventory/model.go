@@ -0,0 +1,92 @@
+package inventory
+
+import "time"
+
+type Warehouse struct {
+	ID        string
+	Code      string
+	Name      string
+	Region    string
+	IsDefault bool
+	IsActive  bool
+	CreatedAt time.Time
+}
+
+// StockLevel is a variant's stock in one warehouse. InTransit is stock on
+// its way in from transfers not yet received; it is not sellable.
+type StockLevel struct {
+	WarehouseID   string
+	WarehouseCode string
+	VariantID     string
+	Quantity      int32
+	InTransit     int32
+}
+
+type TransferStatus string
+
+const (
+	TransferInTransit TransferStatus = "IN_TRANSIT"
+	TransferReceived  TransferStatus = "RECEIVED"
+	TransferCancelled TransferStatus = "CANCELLED"
+)
+
+type Transfer struct {
+	ID              int64
+	VariantID       string
+	FromWarehouseID string
+	ToWarehouseID   string
+	Quantity        int32
+	Status          TransferStatus
+	Note            *string
+	CreatedBy       *int32
+	CreatedAt       time.Time
+	ReceivedAt      *time.Time
+	CancelledAt     *time.Time
+}
+
+type CreateTransferInput struct {
+	VariantID       string
+	FromWarehouseID string
+	ToWarehouseID   string
+	Quantity        int32
+	Note            *string
+}
+
+const (
+	defaultLimit = 50
+	maxLimit     = 500
+)
+
+type AdjustmentStatus string
+
+const (
+	AdjustmentPending  AdjustmentStatus = "PENDING"
+	AdjustmentApplied  AdjustmentStatus = "APPLIED"
+	AdjustmentRejected AdjustmentStatus = "REJECTED"
+)
+
+// Adjustment is a manual correction of one warehouse's stock by Delta
+// units. Corrections over the approval threshold stay pending until a
+// second admin reviews them.
+type Adjustment struct {
+	ID          int64
+	WarehouseID string
+	VariantID   string
+	Delta       int32
+	Reason      string
+	Status      AdjustmentStatus
+	RequestedBy int32
+	ReviewedBy  *int32
+	CreatedAt   time.Time
+	ReviewedAt  *time.Time
+}
+
+// stock_movement type of applied manual adjustments.
+const movementAdjustment = "ADJUSTMENT"
+
+type AdjustStockInput struct {
+	WarehouseID string
+	VariantID   string
+	Delta       int32
+	Reason      string
+}
@@ -0,0 +1,601 @@
+package inventory
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"warimas-be/internal/logger"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+type Repository interface {
+	ListWarehouses(ctx context.Context) ([]*Warehouse, error)
+	CreateWarehouse(ctx context.Context, w *Warehouse) (*Warehouse, error)
+	SetWarehouseActive(ctx context.Context, id string, active bool) (*Warehouse, error)
+
+	ListStockLevels(ctx context.Context, variantID string) ([]*StockLevel, error)
+	// SetStock overwrites a variant's on-hand quantity in one warehouse,
+	// e.g. after a stock count.
+	SetStock(ctx context.Context, warehouseID, variantID string, quantity int32) error
+
+	CreateTransfer(ctx context.Context, in *CreateTransferInput, createdBy uint) (*Transfer, error)
+	ReceiveTransfer(ctx context.Context, id int64) (*Transfer, error)
+	CancelTransfer(ctx context.Context, id int64) (*Transfer, error)
+	ListTransfers(ctx context.Context, status *TransferStatus, limit int32) ([]*Transfer, error)
+
+	// CreateAdjustment records a stock correction with the given status,
+	// changing the stock in the same transaction when it is APPLIED.
+	CreateAdjustment(ctx context.Context, in *AdjustStockInput, requestedBy uint, status AdjustmentStatus) (*Adjustment, error)
+	// ReviewAdjustment approves or rejects a pending correction; approving
+	// applies it.
+	ReviewAdjustment(ctx context.Context, id int64, reviewedBy uint, approve bool) (*Adjustment, error)
+	ListAdjustments(ctx context.Context, status *AdjustmentStatus, limit int32) ([]*Adjustment, error)
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+const warehouseColumns = `id, code, name, region, is_default, is_active, created_at`
+
+const transferColumns = `
+	id, variant_id, from_warehouse_id, to_warehouse_id, quantity, status,
+	note, created_by, created_at, received_at, cancelled_at
+`
+
+const adjustmentColumns = `
+	id, warehouse_id, variant_id, delta, reason, status,
+	requested_by, reviewed_by, created_at, reviewed_at
+`
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanWarehouse(s scanner) (*Warehouse, error) {
+	var w Warehouse
+	if err := s.Scan(&w.ID, &w.Code, &w.Name, &w.Region, &w.IsDefault, &w.IsActive, &w.CreatedAt); err != nil {
+		return nil, err
+	}
+	return &w, nil
+}
+
+func scanTransfer(s scanner) (*Transfer, error) {
+	var t Transfer
+	if err := s.Scan(
+		&t.ID, &t.VariantID, &t.FromWarehouseID, &t.ToWarehouseID, &t.Quantity, &t.Status,
+		&t.Note, &t.CreatedBy, &t.CreatedAt, &t.ReceivedAt, &t.CancelledAt,
+	); err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func scanAdjustment(s scanner) (*Adjustment, error) {
+	var a Adjustment
+	if err := s.Scan(
+		&a.ID, &a.WarehouseID, &a.VariantID, &a.Delta, &a.Reason, &a.Status,
+		&a.RequestedBy, &a.ReviewedBy, &a.CreatedAt, &a.ReviewedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+func pqCode(err error) string {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return string(pqErr.Code)
+	}
+	return ""
+}
+
+func (r *repository) ListWarehouses(ctx context.Context) ([]*Warehouse, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ListWarehouses"),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+warehouseColumns+`
+		FROM warehouses
+		ORDER BY is_default DESC, code
+	`)
+	if err != nil {
+		log.Error("failed to query warehouses", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	list := []*Warehouse{}
+	for rows.Next() {
+		w, err := scanWarehouse(rows)
+		if err != nil {
+			log.Error("failed to scan warehouse", zap.Error(err))
+			return nil, ErrDB
+		}
+		list = append(list, w)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error("warehouse iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return list, nil
+}
+
+func (r *repository) CreateWarehouse(ctx context.Context, w *Warehouse) (*Warehouse, error) {
+	created, err := scanWarehouse(r.db.QueryRowContext(ctx, `
+		INSERT INTO warehouses (code, name, region)
+		VALUES ($1, $2, $3)
+		RETURNING `+warehouseColumns,
+		w.Code, w.Name, w.Region,
+	))
+	if pqCode(err) == PgUniqueViolation {
+		return nil, ErrWarehouseExists
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to create warehouse", zap.Error(err))
+		return nil, ErrDB
+	}
+	return created, nil
+}
+
+// SetWarehouseActive toggles a warehouse. Stock in an inactive warehouse
+// stops counting towards the variant total and is never allocated.
+func (r *repository) SetWarehouseActive(ctx context.Context, id string, active bool) (*Warehouse, error) {
+	w, err := scanWarehouse(r.db.QueryRowContext(ctx, `
+		UPDATE warehouses
+		SET is_active = $2
+		WHERE id = $1
+		  AND (is_active = $2 OR NOT is_default)
+		RETURNING `+warehouseColumns,
+		id, active,
+	))
+	if errors.Is(err, sql.ErrNoRows) {
+		var isDefault bool
+		err := r.db.QueryRowContext(ctx, `SELECT is_default FROM warehouses WHERE id = $1`, id).Scan(&isDefault)
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrWarehouseNotFound
+		}
+		if err != nil {
+			logger.FromCtx(ctx).Error("failed to load warehouse", zap.Error(err))
+			return nil, ErrDB
+		}
+		return nil, ErrDefaultWarehouse
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to update warehouse", zap.Error(err))
+		return nil, ErrDB
+	}
+	return w, nil
+}
+
+func (r *repository) ListStockLevels(ctx context.Context, variantID string) ([]*StockLevel, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ListStockLevels"),
+		zap.String("variant_id", variantID),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT w.id, w.code, $1::uuid,
+		       COALESCE(s.quantity, 0),
+		       COALESCE((
+				SELECT SUM(t.quantity)
+				FROM stock_transfers t
+				WHERE t.to_warehouse_id = w.id
+				  AND t.variant_id = $1
+				  AND t.status = 'IN_TRANSIT'
+		       ), 0)
+		FROM warehouses w
+		LEFT JOIN variant_stocks s ON s.warehouse_id = w.id AND s.variant_id = $1
+		ORDER BY w.is_default DESC, w.code
+	`, variantID)
+	if err != nil {
+		log.Error("failed to query stock levels", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	list := []*StockLevel{}
+	for rows.Next() {
+		var l StockLevel
+		if err := rows.Scan(&l.WarehouseID, &l.WarehouseCode, &l.VariantID, &l.Quantity, &l.InTransit); err != nil {
+			log.Error("failed to scan stock level", zap.Error(err))
+			return nil, ErrDB
+		}
+		list = append(list, &l)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error("stock level iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return list, nil
+}
+
+func (r *repository) SetStock(ctx context.Context, warehouseID, variantID string, quantity int32) error {
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO variant_stocks (warehouse_id, variant_id, quantity)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (warehouse_id, variant_id)
+		DO UPDATE SET quantity = EXCLUDED.quantity, updated_at = NOW()
+	`, warehouseID, variantID, quantity)
+	if pqCode(err) == PgForeignKeyViolation {
+		return ErrWarehouseNotFound
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to set stock", zap.Error(err))
+		return ErrDB
+	}
+	return nil
+}
+
+// CreateTransfer takes the quantity out of the source warehouse and
+// records it as in transit.
+func (r *repository) CreateTransfer(ctx context.Context, in *CreateTransferInput, createdBy uint) (t *Transfer, err error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "CreateTransfer"),
+		zap.String("variant_id", in.VariantID),
+	)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error("failed to begin transaction", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	res, err := tx.ExecContext(ctx, `
+		UPDATE variant_stocks
+		SET quantity = quantity - $3, updated_at = NOW()
+		WHERE warehouse_id = $1
+		  AND variant_id = $2
+		  AND quantity >= $3
+	`, in.FromWarehouseID, in.VariantID, in.Quantity)
+	if err != nil {
+		log.Error("failed to take stock from source", zap.Error(err))
+		return nil, ErrDB
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return nil, ErrInsufficientStock
+	}
+
+	t, err = scanTransfer(tx.QueryRowContext(ctx, `
+		INSERT INTO stock_transfers (
+			variant_id, from_warehouse_id, to_warehouse_id, quantity, note, created_by
+		) VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING `+transferColumns,
+		in.VariantID, in.FromWarehouseID, in.ToWarehouseID, in.Quantity, in.Note, createdBy,
+	))
+	if pqCode(err) == PgForeignKeyViolation {
+		return nil, ErrWarehouseNotFound
+	}
+	if err != nil {
+		log.Error("failed to insert transfer", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Error("failed to commit transfer", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return t, nil
+}
+
+// ReceiveTransfer books an in-transit transfer into its destination.
+func (r *repository) ReceiveTransfer(ctx context.Context, id int64) (*Transfer, error) {
+	return r.closeTransfer(ctx, id, TransferReceived)
+}
+
+// CancelTransfer returns an in-transit transfer to its source.
+func (r *repository) CancelTransfer(ctx context.Context, id int64) (*Transfer, error) {
+	return r.closeTransfer(ctx, id, TransferCancelled)
+}
+
+func (r *repository) closeTransfer(ctx context.Context, id int64, status TransferStatus) (t *Transfer, err error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "closeTransfer"),
+		zap.Int64("transfer_id", id),
+		zap.String("status", string(status)),
+	)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error("failed to begin transaction", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	t, err = scanTransfer(tx.QueryRowContext(ctx, `
+		SELECT `+transferColumns+`
+		FROM stock_transfers
+		WHERE id = $1
+		FOR UPDATE
+	`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrTransferNotFound
+	}
+	if err != nil {
+		log.Error("failed to lock transfer", zap.Error(err))
+		return nil, ErrDB
+	}
+	if t.Status != TransferInTransit {
+		return nil, ErrTransferNotInTransit
+	}
+
+	target := t.ToWarehouseID
+	stamp := "received_at"
+	if status == TransferCancelled {
+		target = t.FromWarehouseID
+		stamp = "cancelled_at"
+	}
+
+	if _, err = tx.ExecContext(ctx, `
+		INSERT INTO variant_stocks (warehouse_id, variant_id, quantity)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (warehouse_id, variant_id)
+		DO UPDATE SET quantity = variant_stocks.quantity + EXCLUDED.quantity, updated_at = NOW()
+	`, target, t.VariantID, t.Quantity); err != nil {
+		log.Error("failed to book stock", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	t, err = scanTransfer(tx.QueryRowContext(ctx, `
+		UPDATE stock_transfers
+		SET status = $2, `+stamp+` = NOW()
+		WHERE id = $1
+		RETURNING `+transferColumns,
+		id, status,
+	))
+	if err != nil {
+		log.Error("failed to update transfer", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Error("failed to commit transfer", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return t, nil
+}
+
+func (r *repository) ListTransfers(ctx context.Context, status *TransferStatus, limit int32) ([]*Transfer, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ListTransfers"),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+transferColumns+`
+		FROM stock_transfers
+		WHERE ($1::text IS NULL OR status = $1)
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2
+	`, status, limit)
+	if err != nil {
+		log.Error("failed to query transfers", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	list := []*Transfer{}
+	for rows.Next() {
+		t, err := scanTransfer(rows)
+		if err != nil {
+			log.Error("failed to scan transfer", zap.Error(err))
+			return nil, ErrDB
+		}
+		list = append(list, t)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error("transfer iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return list, nil
+}
+
+func (r *repository) CreateAdjustment(ctx context.Context, in *AdjustStockInput, requestedBy uint, status AdjustmentStatus) (a *Adjustment, err error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "CreateAdjustment"),
+		zap.String("warehouse_id", in.WarehouseID),
+		zap.String("variant_id", in.VariantID),
+	)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error("failed to begin transaction", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	a, err = scanAdjustment(tx.QueryRowContext(ctx, `
+		INSERT INTO stock_adjustments (
+			warehouse_id, variant_id, delta, reason, status, requested_by
+		) VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING `+adjustmentColumns,
+		in.WarehouseID, in.VariantID, in.Delta, in.Reason, status, requestedBy,
+	))
+	if pqCode(err) == PgForeignKeyViolation {
+		return nil, ErrWarehouseNotFound
+	}
+	if err != nil {
+		log.Error("failed to insert adjustment", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	if status == AdjustmentApplied {
+		if err = applyAdjustment(ctx, tx, a); err != nil {
+			return nil, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Error("failed to commit adjustment", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return a, nil
+}
+
+func (r *repository) ReviewAdjustment(ctx context.Context, id int64, reviewedBy uint, approve bool) (a *Adjustment, err error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ReviewAdjustment"),
+		zap.Int64("adjustment_id", id),
+		zap.Bool("approve", approve),
+	)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error("failed to begin transaction", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	a, err = scanAdjustment(tx.QueryRowContext(ctx, `
+		SELECT `+adjustmentColumns+`
+		FROM stock_adjustments
+		WHERE id = $1
+		FOR UPDATE
+	`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrAdjustmentNotFound
+	}
+	if err != nil {
+		log.Error("failed to lock adjustment", zap.Error(err))
+		return nil, ErrDB
+	}
+	if a.Status != AdjustmentPending {
+		return nil, ErrAdjustmentNotPending
+	}
+	if approve && uint(a.RequestedBy) == reviewedBy {
+		return nil, ErrSelfApproval
+	}
+
+	status := AdjustmentRejected
+	if approve {
+		status = AdjustmentApplied
+		if err = applyAdjustment(ctx, tx, a); err != nil {
+			return nil, err
+		}
+	}
+
+	a, err = scanAdjustment(tx.QueryRowContext(ctx, `
+		UPDATE stock_adjustments
+		SET status = $2, reviewed_by = $3, reviewed_at = NOW()
+		WHERE id = $1
+		RETURNING `+adjustmentColumns,
+		id, status, reviewedBy,
+	))
+	if err != nil {
+		log.Error("failed to update adjustment", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Error("failed to commit adjustment", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return a, nil
+}
+
+// applyAdjustment moves the warehouse's stock by the adjustment's delta and
+// books it in the stock_movement ledger. The quantity check on
+// variant_stocks refuses to go below zero.
+func applyAdjustment(ctx context.Context, tx *sql.Tx, a *Adjustment) error {
+	log := logger.FromCtx(ctx).With(zap.Int64("adjustment_id", a.ID))
+
+	_, err := tx.ExecContext(ctx, `
+		INSERT INTO variant_stocks (warehouse_id, variant_id, quantity)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (warehouse_id, variant_id)
+		DO UPDATE SET quantity = variant_stocks.quantity + EXCLUDED.quantity, updated_at = NOW()
+	`, a.WarehouseID, a.VariantID, a.Delta)
+	if pqCode(err) == PgCheckViolation {
+		return ErrNegativeStock
+	}
+	if err != nil {
+		log.Error("failed to apply adjustment", zap.Error(err))
+		return ErrDB
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO stock_movement (variant_id, type, quantity, note)
+		VALUES ($1, $2, $3, $4)
+	`, a.VariantID, movementAdjustment, a.Delta, a.Reason); err != nil {
+		log.Error("failed to record stock movement", zap.Error(err))
+		return ErrDB
+	}
+	return nil
+}
+
+func (r *repository) ListAdjustments(ctx context.Context, status *AdjustmentStatus, limit int32) ([]*Adjustment, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ListAdjustments"),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+adjustmentColumns+`
+		FROM stock_adjustments
+		WHERE ($1::text IS NULL OR status = $1)
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2
+	`, status, limit)
+	if err != nil {
+		log.Error("failed to query adjustments", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	list := []*Adjustment{}
+	for rows.Next() {
+		a, err := scanAdjustment(rows)
+		if err != nil {
+			log.Error("failed to scan adjustment", zap.Error(err))
+			return nil, ErrDB
+		}
+		list = append(list, a)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error("adjustment iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return list, nil
+}
@@ -0,0 +1,342 @@
+package inventory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var transferCols = []string{
+	"id", "variant_id", "from_warehouse_id", "to_warehouse_id", "quantity", "status",
+	"note", "created_by", "created_at", "received_at", "cancelled_at",
+}
+
+var adjustmentCols = []string{
+	"id", "warehouse_id", "variant_id", "delta", "reason", "status",
+	"requested_by", "reviewed_by", "created_at", "reviewed_at",
+}
+
+func TestRepository_CreateWarehouse(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+	w := &Warehouse{Code: "SBY-1", Name: "Surabaya", Region: "Jawa Timur"}
+
+	mock.ExpectQuery(`INSERT INTO warehouses \(code, name, region\)`).
+		WithArgs("SBY-1", "Surabaya", "Jawa Timur").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "code", "name", "region", "is_default", "is_active", "created_at"}).
+			AddRow("wh-2", "SBY-1", "Surabaya", "Jawa Timur", false, true, time.Now()))
+
+	created, err := repo.CreateWarehouse(ctx, w)
+	assert.NoError(t, err)
+	assert.Equal(t, "wh-2", created.ID)
+
+	mock.ExpectQuery(`INSERT INTO warehouses`).WillReturnError(&pq.Error{Code: pq.ErrorCode(PgUniqueViolation)})
+	_, err = repo.CreateWarehouse(ctx, w)
+	assert.ErrorIs(t, err, ErrWarehouseExists)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_SetWarehouseActive_Default(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	mock.ExpectQuery(`UPDATE warehouses SET is_active = \$2`).
+		WithArgs("wh-1", false).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectQuery(`SELECT is_default FROM warehouses WHERE id = \$1`).
+		WithArgs("wh-1").
+		WillReturnRows(sqlmock.NewRows([]string{"is_default"}).AddRow(true))
+
+	_, err = repo.SetWarehouseActive(context.Background(), "wh-1", false)
+	assert.ErrorIs(t, err, ErrDefaultWarehouse)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_CreateTransfer(t *testing.T) {
+	ctx := context.Background()
+	in := &CreateTransferInput{VariantID: "v-1", FromWarehouseID: "wh-1", ToWarehouseID: "wh-2", Quantity: 5}
+
+	t.Run("Success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`UPDATE variant_stocks SET quantity = quantity - \$3`).
+			WithArgs("wh-1", "v-1", int32(5)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(`INSERT INTO stock_transfers`).
+			WithArgs("v-1", "wh-1", "wh-2", int32(5), nil, uint(1)).
+			WillReturnRows(sqlmock.NewRows(transferCols).
+				AddRow(8, "v-1", "wh-1", "wh-2", 5, "IN_TRANSIT", nil, 1, time.Now(), nil, nil))
+		mock.ExpectCommit()
+
+		tr, err := repo.CreateTransfer(ctx, in, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(8), tr.ID)
+		assert.Equal(t, TransferInTransit, tr.Status)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("InsufficientStock", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`UPDATE variant_stocks`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		_, err = repo.CreateTransfer(ctx, in, 1)
+		assert.ErrorIs(t, err, ErrInsufficientStock)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_ReceiveTransfer(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("BooksIntoDestination", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		now := time.Now()
+		mock.ExpectBegin()
+		mock.ExpectQuery(`FROM stock_transfers WHERE id = \$1 FOR UPDATE`).
+			WithArgs(int64(8)).
+			WillReturnRows(sqlmock.NewRows(transferCols).
+				AddRow(8, "v-1", "wh-1", "wh-2", 5, "IN_TRANSIT", nil, 1, now, nil, nil))
+		mock.ExpectExec(`INSERT INTO variant_stocks .* quantity = variant_stocks.quantity \+ EXCLUDED.quantity`).
+			WithArgs("wh-2", "v-1", int32(5)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(`UPDATE stock_transfers SET status = \$2, received_at = NOW\(\)`).
+			WithArgs(int64(8), TransferReceived).
+			WillReturnRows(sqlmock.NewRows(transferCols).
+				AddRow(8, "v-1", "wh-1", "wh-2", 5, "RECEIVED", nil, 1, now, now, nil))
+		mock.ExpectCommit()
+
+		tr, err := repo.ReceiveTransfer(ctx, 8)
+		assert.NoError(t, err)
+		assert.Equal(t, TransferReceived, tr.Status)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("AlreadyClosed", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`FROM stock_transfers`).
+			WillReturnRows(sqlmock.NewRows(transferCols).
+				AddRow(8, "v-1", "wh-1", "wh-2", 5, "CANCELLED", nil, 1, time.Now(), nil, time.Now()))
+		mock.ExpectRollback()
+
+		_, err = repo.ReceiveTransfer(ctx, 8)
+		assert.ErrorIs(t, err, ErrTransferNotInTransit)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_CancelTransfer_ReturnsToSource(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := NewRepository(db)
+
+	now := time.Now()
+	mock.ExpectBegin()
+	mock.ExpectQuery(`FROM stock_transfers`).
+		WillReturnRows(sqlmock.NewRows(transferCols).
+			AddRow(8, "v-1", "wh-1", "wh-2", 5, "IN_TRANSIT", nil, 1, now, nil, nil))
+	mock.ExpectExec(`INSERT INTO variant_stocks`).
+		WithArgs("wh-1", "v-1", int32(5)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`UPDATE stock_transfers SET status = \$2, cancelled_at = NOW\(\)`).
+		WillReturnRows(sqlmock.NewRows(transferCols).
+			AddRow(8, "v-1", "wh-1", "wh-2", 5, "CANCELLED", nil, 1, now, nil, now))
+	mock.ExpectCommit()
+
+	tr, err := repo.CancelTransfer(context.Background(), 8)
+	assert.NoError(t, err)
+	assert.Equal(t, TransferCancelled, tr.Status)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_CreateAdjustment(t *testing.T) {
+	ctx := context.Background()
+	in := &AdjustStockInput{WarehouseID: "wh-1", VariantID: "v-1", Delta: -3, Reason: "damaged"}
+
+	t.Run("Applied", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO stock_adjustments`).
+			WithArgs("wh-1", "v-1", int32(-3), "damaged", AdjustmentApplied, uint(1)).
+			WillReturnRows(sqlmock.NewRows(adjustmentCols).
+				AddRow(3, "wh-1", "v-1", -3, "damaged", "APPLIED", 1, nil, time.Now(), nil))
+		mock.ExpectExec(`INSERT INTO variant_stocks .* quantity = variant_stocks.quantity \+ EXCLUDED.quantity`).
+			WithArgs("wh-1", "v-1", int32(-3)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`INSERT INTO stock_movement \(variant_id, type, quantity, note\)`).
+			WithArgs("v-1", "ADJUSTMENT", int32(-3), "damaged").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		a, err := repo.CreateAdjustment(ctx, in, 1, AdjustmentApplied)
+		assert.NoError(t, err)
+		assert.Equal(t, AdjustmentApplied, a.Status)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("PendingLeavesStock", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO stock_adjustments`).
+			WithArgs("wh-1", "v-1", int32(-3), "damaged", AdjustmentPending, uint(1)).
+			WillReturnRows(sqlmock.NewRows(adjustmentCols).
+				AddRow(3, "wh-1", "v-1", -3, "damaged", "PENDING", 1, nil, time.Now(), nil))
+		mock.ExpectCommit()
+
+		a, err := repo.CreateAdjustment(ctx, in, 1, AdjustmentPending)
+		assert.NoError(t, err)
+		assert.Equal(t, AdjustmentPending, a.Status)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("BelowZero", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO stock_adjustments`).
+			WillReturnRows(sqlmock.NewRows(adjustmentCols).
+				AddRow(3, "wh-1", "v-1", -3, "damaged", "APPLIED", 1, nil, time.Now(), nil))
+		mock.ExpectExec(`INSERT INTO variant_stocks`).
+			WillReturnError(&pq.Error{Code: pq.ErrorCode(PgCheckViolation)})
+		mock.ExpectRollback()
+
+		_, err = repo.CreateAdjustment(ctx, in, 1, AdjustmentApplied)
+		assert.ErrorIs(t, err, ErrNegativeStock)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_ReviewAdjustment(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("ApproveApplies", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		now := time.Now()
+		mock.ExpectBegin()
+		mock.ExpectQuery(`FROM stock_adjustments WHERE id = \$1 FOR UPDATE`).
+			WithArgs(int64(4)).
+			WillReturnRows(sqlmock.NewRows(adjustmentCols).
+				AddRow(4, "wh-1", "v-1", 500, "supplier delivery", "PENDING", 1, nil, now, nil))
+		mock.ExpectExec(`INSERT INTO variant_stocks`).
+			WithArgs("wh-1", "v-1", int32(500)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`INSERT INTO stock_movement`).
+			WithArgs("v-1", "ADJUSTMENT", int32(500), "supplier delivery").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(`UPDATE stock_adjustments SET status = \$2, reviewed_by = \$3, reviewed_at = NOW\(\)`).
+			WithArgs(int64(4), AdjustmentApplied, uint(2)).
+			WillReturnRows(sqlmock.NewRows(adjustmentCols).
+				AddRow(4, "wh-1", "v-1", 500, "supplier delivery", "APPLIED", 1, 2, now, now))
+		mock.ExpectCommit()
+
+		a, err := repo.ReviewAdjustment(ctx, 4, 2, true)
+		assert.NoError(t, err)
+		assert.Equal(t, AdjustmentApplied, a.Status)
+		assert.Equal(t, int32(2), *a.ReviewedBy)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("RejectLeavesStock", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		now := time.Now()
+		mock.ExpectBegin()
+		mock.ExpectQuery(`FROM stock_adjustments`).
+			WillReturnRows(sqlmock.NewRows(adjustmentCols).
+				AddRow(4, "wh-1", "v-1", 500, "supplier delivery", "PENDING", 1, nil, now, nil))
+		mock.ExpectQuery(`UPDATE stock_adjustments`).
+			WithArgs(int64(4), AdjustmentRejected, uint(1)).
+			WillReturnRows(sqlmock.NewRows(adjustmentCols).
+				AddRow(4, "wh-1", "v-1", 500, "supplier delivery", "REJECTED", 1, 1, now, now))
+		mock.ExpectCommit()
+
+		a, err := repo.ReviewAdjustment(ctx, 4, 1, false)
+		assert.NoError(t, err)
+		assert.Equal(t, AdjustmentRejected, a.Status)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("SelfApproval", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`FROM stock_adjustments`).
+			WillReturnRows(sqlmock.NewRows(adjustmentCols).
+				AddRow(4, "wh-1", "v-1", 500, "supplier delivery", "PENDING", 1, nil, time.Now(), nil))
+		mock.ExpectRollback()
+
+		_, err = repo.ReviewAdjustment(ctx, 4, 1, true)
+		assert.ErrorIs(t, err, ErrSelfApproval)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("AlreadyReviewed", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`FROM stock_adjustments`).
+			WillReturnRows(sqlmock.NewRows(adjustmentCols).
+				AddRow(4, "wh-1", "v-1", 500, "supplier delivery", "APPLIED", 1, 2, time.Now(), time.Now()))
+		mock.ExpectRollback()
+
+		_, err = repo.ReviewAdjustment(ctx, 4, 3, true)
+		assert.ErrorIs(t, err, ErrAdjustmentNotPending)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,291 @@
+package inventory
+
+import (
+	"context"
+	"strings"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+// Service manages warehouses and per-warehouse stock. Every method is
+// admin only.
+type Service interface {
+	ListWarehouses(ctx context.Context) ([]*Warehouse, error)
+	CreateWarehouse(ctx context.Context, code, name, region string) (*Warehouse, error)
+	SetWarehouseActive(ctx context.Context, id string, active bool) (*Warehouse, error)
+
+	GetStockLevels(ctx context.Context, variantID string) ([]*StockLevel, error)
+	SetStock(ctx context.Context, warehouseID, variantID string, quantity int32) ([]*StockLevel, error)
+
+	CreateTransfer(ctx context.Context, in *CreateTransferInput) (*Transfer, error)
+	ReceiveTransfer(ctx context.Context, id int64) (*Transfer, error)
+	CancelTransfer(ctx context.Context, id int64) (*Transfer, error)
+	ListTransfers(ctx context.Context, status *TransferStatus, limit int32) ([]*Transfer, error)
+
+	// AdjustStock corrects a warehouse's stock by a signed quantity.
+	// Corrections over the approval threshold are kept pending for a
+	// second admin instead of applied.
+	AdjustStock(ctx context.Context, in *AdjustStockInput) (*Adjustment, error)
+	ApproveAdjustment(ctx context.Context, id int64) (*Adjustment, error)
+	RejectAdjustment(ctx context.Context, id int64) (*Adjustment, error)
+	ListAdjustments(ctx context.Context, status *AdjustmentStatus, limit int32) ([]*Adjustment, error)
+}
+
+type service struct {
+	repo Repository
+	// Largest stock change, in units, an admin may make alone; 0 lets
+	// every change through without approval.
+	approvalThreshold int32
+}
+
+func NewService(repo Repository, approvalThreshold int32) Service {
+	return &service{repo: repo, approvalThreshold: approvalThreshold}
+}
+
+func (s *service) ListWarehouses(ctx context.Context) ([]*Warehouse, error) {
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return s.repo.ListWarehouses(ctx)
+}
+
+func (s *service) CreateWarehouse(ctx context.Context, code, name, region string) (*Warehouse, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "CreateWarehouse"),
+	)
+
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	w := &Warehouse{
+		Code:   strings.ToUpper(strings.TrimSpace(code)),
+		Name:   strings.TrimSpace(name),
+		Region: strings.TrimSpace(region),
+	}
+	if w.Code == "" || w.Name == "" || w.Region == "" {
+		log.Warn("invalid warehouse input")
+		return nil, ErrInvalidWarehouse
+	}
+
+	return s.repo.CreateWarehouse(ctx, w)
+}
+
+func (s *service) SetWarehouseActive(ctx context.Context, id string, active bool) (*Warehouse, error) {
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return s.repo.SetWarehouseActive(ctx, id, active)
+}
+
+func (s *service) GetStockLevels(ctx context.Context, variantID string) ([]*StockLevel, error) {
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return s.repo.ListStockLevels(ctx, variantID)
+}
+
+func (s *service) SetStock(ctx context.Context, warehouseID, variantID string, quantity int32) ([]*StockLevel, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "SetStock"),
+		zap.String("warehouse_id", warehouseID),
+		zap.String("variant_id", variantID),
+	)
+
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if quantity < 0 {
+		return nil, ErrInvalidQuantity
+	}
+
+	// Overwriting the count must not get round the approval step
+	if s.approvalThreshold > 0 {
+		levels, err := s.repo.ListStockLevels(ctx, variantID)
+		if err != nil {
+			return nil, err
+		}
+		current, found := int32(0), false
+		for _, l := range levels {
+			if l.WarehouseID == warehouseID {
+				current, found = l.Quantity, true
+			}
+		}
+		if !found {
+			return nil, ErrWarehouseNotFound
+		}
+		if s.needsApproval(quantity - current) {
+			log.Warn("stock change needs approval", zap.Int32("current", current), zap.Int32("quantity", quantity))
+			return nil, ErrApprovalRequired
+		}
+	}
+
+	if err := s.repo.SetStock(ctx, warehouseID, variantID, quantity); err != nil {
+		return nil, err
+	}
+
+	log.Info("warehouse stock set", zap.Int32("quantity", quantity))
+	return s.repo.ListStockLevels(ctx, variantID)
+}
+
+func (s *service) CreateTransfer(ctx context.Context, in *CreateTransferInput) (*Transfer, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "CreateTransfer"),
+		zap.String("variant_id", in.VariantID),
+	)
+
+	adminID, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.Quantity <= 0 || in.FromWarehouseID == "" || in.FromWarehouseID == in.ToWarehouseID {
+		log.Warn("invalid transfer input")
+		return nil, ErrInvalidTransfer
+	}
+
+	t, err := s.repo.CreateTransfer(ctx, in, adminID)
+	if err != nil {
+		log.Warn("failed to create transfer", zap.Error(err))
+		return nil, err
+	}
+
+	log.Info("stock transfer created", zap.Int64("transfer_id", t.ID))
+	return t, nil
+}
+
+func (s *service) ReceiveTransfer(ctx context.Context, id int64) (*Transfer, error) {
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return s.repo.ReceiveTransfer(ctx, id)
+}
+
+func (s *service) CancelTransfer(ctx context.Context, id int64) (*Transfer, error) {
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return s.repo.CancelTransfer(ctx, id)
+}
+
+func (s *service) ListTransfers(ctx context.Context, status *TransferStatus, limit int32) ([]*Transfer, error) {
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+
+	return s.repo.ListTransfers(ctx, status, limit)
+}
+
+func (s *service) AdjustStock(ctx context.Context, in *AdjustStockInput) (*Adjustment, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "AdjustStock"),
+		zap.String("warehouse_id", in.WarehouseID),
+		zap.String("variant_id", in.VariantID),
+	)
+
+	adminID, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	in.Reason = strings.TrimSpace(in.Reason)
+	if in.Delta == 0 || in.Reason == "" {
+		log.Warn("invalid adjustment input")
+		return nil, ErrInvalidAdjustment
+	}
+
+	status := AdjustmentApplied
+	if s.needsApproval(in.Delta) {
+		status = AdjustmentPending
+	}
+
+	a, err := s.repo.CreateAdjustment(ctx, in, adminID, status)
+	if err != nil {
+		log.Warn("failed to create adjustment", zap.Error(err))
+		return nil, err
+	}
+
+	log.Info("stock adjustment created",
+		zap.Int64("adjustment_id", a.ID),
+		zap.Int32("delta", a.Delta),
+		zap.String("status", string(a.Status)),
+	)
+	return a, nil
+}
+
+func (s *service) ApproveAdjustment(ctx context.Context, id int64) (*Adjustment, error) {
+	return s.reviewAdjustment(ctx, id, true)
+}
+
+func (s *service) RejectAdjustment(ctx context.Context, id int64) (*Adjustment, error) {
+	return s.reviewAdjustment(ctx, id, false)
+}
+
+func (s *service) reviewAdjustment(ctx context.Context, id int64, approve bool) (*Adjustment, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "reviewAdjustment"),
+		zap.Int64("adjustment_id", id),
+		zap.Bool("approve", approve),
+	)
+
+	adminID, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := s.repo.ReviewAdjustment(ctx, id, adminID, approve)
+	if err != nil {
+		log.Warn("failed to review adjustment", zap.Error(err))
+		return nil, err
+	}
+
+	log.Info("stock adjustment reviewed", zap.String("status", string(a.Status)))
+	return a, nil
+}
+
+func (s *service) ListAdjustments(ctx context.Context, status *AdjustmentStatus, limit int32) ([]*Adjustment, error) {
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+
+	return s.repo.ListAdjustments(ctx, status, limit)
+}
+
+func (s *service) needsApproval(delta int32) bool {
+	if delta < 0 {
+		delta = -delta
+	}
+	return s.approvalThreshold > 0 && delta > s.approvalThreshold
+}
+
+func requireAdmin(ctx context.Context) (uint, error) {
+	userID, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		return 0, ErrUnauthenticated
+	}
+	if !utils.IsAdmin(ctx) {
+		return 0, ErrForbidden
+	}
+	return userID, nil
+}
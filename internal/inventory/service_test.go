@@ -0,0 +1,331 @@
+package inventory
+
+import (
+	"context"
+	"testing"
+	"warimas-be/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// --- Mocks ---
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) ListWarehouses(ctx context.Context) ([]*Warehouse, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Warehouse), args.Error(1)
+}
+
+func (m *MockRepository) CreateWarehouse(ctx context.Context, w *Warehouse) (*Warehouse, error) {
+	args := m.Called(ctx, w)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Warehouse), args.Error(1)
+}
+
+func (m *MockRepository) SetWarehouseActive(ctx context.Context, id string, active bool) (*Warehouse, error) {
+	args := m.Called(ctx, id, active)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Warehouse), args.Error(1)
+}
+
+func (m *MockRepository) ListStockLevels(ctx context.Context, variantID string) ([]*StockLevel, error) {
+	args := m.Called(ctx, variantID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*StockLevel), args.Error(1)
+}
+
+func (m *MockRepository) SetStock(ctx context.Context, warehouseID, variantID string, quantity int32) error {
+	args := m.Called(ctx, warehouseID, variantID, quantity)
+	return args.Error(0)
+}
+
+func (m *MockRepository) CreateTransfer(ctx context.Context, in *CreateTransferInput, createdBy uint) (*Transfer, error) {
+	args := m.Called(ctx, in, createdBy)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Transfer), args.Error(1)
+}
+
+func (m *MockRepository) ReceiveTransfer(ctx context.Context, id int64) (*Transfer, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Transfer), args.Error(1)
+}
+
+func (m *MockRepository) CancelTransfer(ctx context.Context, id int64) (*Transfer, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Transfer), args.Error(1)
+}
+
+func (m *MockRepository) ListTransfers(ctx context.Context, status *TransferStatus, limit int32) ([]*Transfer, error) {
+	args := m.Called(ctx, status, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Transfer), args.Error(1)
+}
+
+func (m *MockRepository) CreateAdjustment(ctx context.Context, in *AdjustStockInput, requestedBy uint, status AdjustmentStatus) (*Adjustment, error) {
+	args := m.Called(ctx, in, requestedBy, status)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Adjustment), args.Error(1)
+}
+
+func (m *MockRepository) ReviewAdjustment(ctx context.Context, id int64, reviewedBy uint, approve bool) (*Adjustment, error) {
+	args := m.Called(ctx, id, reviewedBy, approve)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Adjustment), args.Error(1)
+}
+
+func (m *MockRepository) ListAdjustments(ctx context.Context, status *AdjustmentStatus, limit int32) ([]*Adjustment, error) {
+	args := m.Called(ctx, status, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Adjustment), args.Error(1)
+}
+
+// --- Tests ---
+
+var adminCtx = utils.SetUserContext(context.Background(), 1, "admin@example.com", "ADMIN")
+
+func TestService_CreateWarehouse(t *testing.T) {
+	t.Run("NormalisesInput", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, 0)
+
+		want := &Warehouse{Code: "SBY-1", Name: "Surabaya", Region: "Jawa Timur"}
+		mockRepo.On("CreateWarehouse", adminCtx, want).Return(&Warehouse{ID: "wh-2", Code: "SBY-1"}, nil)
+
+		w, err := svc.CreateWarehouse(adminCtx, " sby-1 ", "Surabaya ", "Jawa Timur")
+		assert.NoError(t, err)
+		assert.Equal(t, "wh-2", w.ID)
+	})
+
+	t.Run("MissingRegion", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, 0)
+
+		_, err := svc.CreateWarehouse(adminCtx, "SBY-1", "Surabaya", " ")
+		assert.ErrorIs(t, err, ErrInvalidWarehouse)
+		mockRepo.AssertNotCalled(t, "CreateWarehouse", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		svc := NewService(new(MockRepository), 0)
+		ctx := utils.SetUserContext(context.Background(), 2, "user@example.com", "USER")
+
+		_, err := svc.CreateWarehouse(ctx, "SBY-1", "Surabaya", "Jawa Timur")
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+}
+
+func TestService_SetStock(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, 0)
+
+		mockRepo.On("SetStock", adminCtx, "wh-1", "v-1", int32(12)).Return(nil)
+		mockRepo.On("ListStockLevels", adminCtx, "v-1").Return([]*StockLevel{{WarehouseID: "wh-1", Quantity: 12}}, nil)
+
+		levels, err := svc.SetStock(adminCtx, "wh-1", "v-1", 12)
+		assert.NoError(t, err)
+		assert.Equal(t, int32(12), levels[0].Quantity)
+	})
+
+	t.Run("Negative", func(t *testing.T) {
+		svc := NewService(new(MockRepository), 0)
+
+		_, err := svc.SetStock(adminCtx, "wh-1", "v-1", -1)
+		assert.ErrorIs(t, err, ErrInvalidQuantity)
+	})
+
+	t.Run("WithinThreshold", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, 10)
+
+		mockRepo.On("ListStockLevels", adminCtx, "v-1").Return([]*StockLevel{{WarehouseID: "wh-1", Quantity: 20}}, nil)
+		mockRepo.On("SetStock", adminCtx, "wh-1", "v-1", int32(12)).Return(nil)
+
+		_, err := svc.SetStock(adminCtx, "wh-1", "v-1", 12)
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("OverThreshold", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, 10)
+
+		mockRepo.On("ListStockLevels", adminCtx, "v-1").Return([]*StockLevel{{WarehouseID: "wh-1", Quantity: 20}}, nil)
+
+		_, err := svc.SetStock(adminCtx, "wh-1", "v-1", 5)
+		assert.ErrorIs(t, err, ErrApprovalRequired)
+		mockRepo.AssertNotCalled(t, "SetStock", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("UnknownWarehouse", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, 10)
+
+		mockRepo.On("ListStockLevels", adminCtx, "v-1").Return([]*StockLevel{{WarehouseID: "wh-1", Quantity: 20}}, nil)
+
+		_, err := svc.SetStock(adminCtx, "wh-9", "v-1", 5)
+		assert.ErrorIs(t, err, ErrWarehouseNotFound)
+	})
+}
+
+func TestService_AdjustStock(t *testing.T) {
+	t.Run("AppliesSmallChange", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, 10)
+
+		in := &AdjustStockInput{WarehouseID: "wh-1", VariantID: "v-1", Delta: -10, Reason: " damaged "}
+		mockRepo.On("CreateAdjustment", adminCtx, in, uint(1), AdjustmentApplied).
+			Return(&Adjustment{ID: 3, Delta: -10, Status: AdjustmentApplied}, nil)
+
+		a, err := svc.AdjustStock(adminCtx, in)
+		assert.NoError(t, err)
+		assert.Equal(t, AdjustmentApplied, a.Status)
+		assert.Equal(t, "damaged", in.Reason)
+	})
+
+	t.Run("LargeChangeWaitsForApproval", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, 10)
+
+		in := &AdjustStockInput{WarehouseID: "wh-1", VariantID: "v-1", Delta: -11, Reason: "stock count"}
+		mockRepo.On("CreateAdjustment", adminCtx, in, uint(1), AdjustmentPending).
+			Return(&Adjustment{ID: 4, Delta: -11, Status: AdjustmentPending}, nil)
+
+		a, err := svc.AdjustStock(adminCtx, in)
+		assert.NoError(t, err)
+		assert.Equal(t, AdjustmentPending, a.Status)
+	})
+
+	t.Run("NoThreshold", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, 0)
+
+		in := &AdjustStockInput{WarehouseID: "wh-1", VariantID: "v-1", Delta: 5000, Reason: "supplier delivery"}
+		mockRepo.On("CreateAdjustment", adminCtx, in, uint(1), AdjustmentApplied).
+			Return(&Adjustment{ID: 5, Status: AdjustmentApplied}, nil)
+
+		_, err := svc.AdjustStock(adminCtx, in)
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("MissingReason", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, 10)
+
+		_, err := svc.AdjustStock(adminCtx, &AdjustStockInput{WarehouseID: "wh-1", VariantID: "v-1", Delta: 2, Reason: " "})
+		assert.ErrorIs(t, err, ErrInvalidAdjustment)
+		mockRepo.AssertNotCalled(t, "CreateAdjustment", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestService_ReviewAdjustment(t *testing.T) {
+	t.Run("Approve", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, 10)
+
+		mockRepo.On("ReviewAdjustment", adminCtx, int64(4), uint(1), true).
+			Return(&Adjustment{ID: 4, Status: AdjustmentApplied}, nil)
+
+		a, err := svc.ApproveAdjustment(adminCtx, 4)
+		assert.NoError(t, err)
+		assert.Equal(t, AdjustmentApplied, a.Status)
+	})
+
+	t.Run("Reject", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, 10)
+
+		mockRepo.On("ReviewAdjustment", adminCtx, int64(4), uint(1), false).
+			Return(&Adjustment{ID: 4, Status: AdjustmentRejected}, nil)
+
+		a, err := svc.RejectAdjustment(adminCtx, 4)
+		assert.NoError(t, err)
+		assert.Equal(t, AdjustmentRejected, a.Status)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		svc := NewService(new(MockRepository), 10)
+		ctx := utils.SetUserContext(context.Background(), 2, "user@example.com", "USER")
+
+		_, err := svc.ApproveAdjustment(ctx, 4)
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+}
+
+func TestService_CreateTransfer(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, 0)
+
+		in := &CreateTransferInput{VariantID: "v-1", FromWarehouseID: "wh-1", ToWarehouseID: "wh-2", Quantity: 5}
+		mockRepo.On("CreateTransfer", adminCtx, in, uint(1)).Return(&Transfer{ID: 8, Status: TransferInTransit}, nil)
+
+		tr, err := svc.CreateTransfer(adminCtx, in)
+		assert.NoError(t, err)
+		assert.Equal(t, TransferInTransit, tr.Status)
+	})
+
+	t.Run("SameWarehouse", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, 0)
+
+		_, err := svc.CreateTransfer(adminCtx, &CreateTransferInput{
+			VariantID: "v-1", FromWarehouseID: "wh-1", ToWarehouseID: "wh-1", Quantity: 5,
+		})
+		assert.ErrorIs(t, err, ErrInvalidTransfer)
+		mockRepo.AssertNotCalled(t, "CreateTransfer", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("InsufficientStock", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, 0)
+
+		in := &CreateTransferInput{VariantID: "v-1", FromWarehouseID: "wh-1", ToWarehouseID: "wh-2", Quantity: 500}
+		mockRepo.On("CreateTransfer", adminCtx, in, uint(1)).Return(nil, ErrInsufficientStock)
+
+		_, err := svc.CreateTransfer(adminCtx, in)
+		assert.ErrorIs(t, err, ErrInsufficientStock)
+	})
+}
+
+func TestService_ListTransfers(t *testing.T) {
+	mockRepo := new(MockRepository)
+	svc := NewService(mockRepo, 0)
+
+	status := TransferInTransit
+	mockRepo.On("ListTransfers", adminCtx, &status, int32(defaultLimit)).Return([]*Transfer{}, nil)
+
+	_, err := svc.ListTransfers(adminCtx, &status, 0)
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
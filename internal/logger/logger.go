@@ -28,9 +28,20 @@ func Init(env string) {
 		cfg.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
 	}
 
+	// Level and sampling are applied by dynamicCore so they can be changed
+	// at runtime with Apply.
+	sample := cfg.Sampling != nil
+	cfg.Sampling = nil
+	def := Settings{Level: cfg.Level.Level(), Sampling: sample}
+	defaults.Store(&def)
+	Apply(def)
+	cfg.Level = level
+
 	// Build logger
 	var err error
-	log, err = cfg.Build(zap.AddCaller(), zap.AddCallerSkip(1))
+	log, err = cfg.Build(zap.AddCaller(), zap.AddCallerSkip(1),
+		zap.WrapCore(func(c zapcore.Core) zapcore.Core { return newDynamicCore(c, sample) }),
+	)
 	if err != nil {
 		panic(err)
 	}
@@ -175,3 +175,57 @@ func TestLoggingMiddleware(t *testing.T) {
 	assert.Equal(t, "incoming request", logs[0].Message)
 	assert.Equal(t, "/test", logs[0].ContextMap()["path"])
 }
+
+func TestModuleOf(t *testing.T) {
+	assert.Equal(t, "payment", moduleOf(internalPrefix+"payment/webhook.(*WebhookHandler).apply"))
+	assert.Equal(t, "order", moduleOf(internalPrefix+"order.(*service).CreateOrder"))
+	assert.Equal(t, "graph", moduleOf(internalPrefix+"graph.(*mutationResolver).SetLogSettings.func1"))
+	assert.Equal(t, "", moduleOf("go.uber.org/zap/internal/exit.With"))
+	assert.Equal(t, "", moduleOf("main.main"))
+}
+
+func TestApply(t *testing.T) {
+	original := Current()
+	defer Apply(original)
+
+	core, observed := observer.New(level)
+	l := zap.New(newDynamicCore(core, true))
+
+	Apply(Settings{Level: zapcore.InfoLevel})
+	l.Debug("hidden")
+	l.Info("shown")
+	assert.Equal(t, 1, observed.Len())
+
+	Apply(Settings{Level: zapcore.DebugLevel})
+	l.Debug("shown too")
+	assert.Equal(t, 2, observed.Len())
+
+	// Entries from this package never match a debug module.
+	Apply(Settings{Level: zapcore.WarnLevel, DebugModules: []string{"logger"}})
+	l.Debug("still hidden")
+	l.Info("hidden as well")
+	assert.Equal(t, 2, observed.Len())
+
+	assert.Equal(t, Settings{Level: zapcore.WarnLevel, DebugModules: []string{"logger"}}, Current())
+}
+
+func TestSampling(t *testing.T) {
+	original := Current()
+	defer Apply(original)
+
+	core, observed := observer.New(level)
+	l := zap.New(newDynamicCore(core, true))
+
+	Apply(Settings{Level: zapcore.InfoLevel, Sampling: true})
+	for range 150 {
+		l.Info("repeated")
+	}
+	assert.Equal(t, 100, observed.Len())
+
+	observed.TakeAll()
+	Apply(Settings{Level: zapcore.InfoLevel, Sampling: false})
+	for range 150 {
+		l.Info("repeated")
+	}
+	assert.Equal(t, 150, observed.Len())
+}
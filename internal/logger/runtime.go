@@ -0,0 +1,159 @@
+package logger
+
+import (
+	"reflect"
+	"runtime"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// Settings is the part of the logger configuration that can change while
+// the process runs.
+type Settings struct {
+	Level zapcore.Level
+	// DebugModules lists packages under internal/ (for example "payment")
+	// whose debug entries are written whatever Level is.
+	DebugModules []string
+	// Sampling drops repeated entries within a second after the first 100.
+	// It only has an effect in production.
+	Sampling bool
+}
+
+var (
+	level        = zap.NewAtomicLevel()
+	defaults     atomic.Pointer[Settings]
+	debugModules atomic.Pointer[[]string]
+	sampling     atomic.Bool
+)
+
+// internalPrefix is the import path prefix of the packages a debug module
+// can name, e.g. "warimas-be/internal/".
+var internalPrefix = strings.TrimSuffix(reflect.TypeOf(Settings{}).PkgPath(), "logger")
+
+// Defaults returns the settings the logger was initialised with.
+func Defaults() Settings {
+	if d := defaults.Load(); d != nil {
+		return *d
+	}
+	return Settings{Level: zapcore.InfoLevel}
+}
+
+// Current returns the settings in effect.
+func Current() Settings {
+	s := Settings{Level: level.Level(), Sampling: sampling.Load()}
+	if mods := debugModules.Load(); mods != nil {
+		s.DebugModules = slices.Clone(*mods)
+	}
+	return s
+}
+
+// Apply changes the level, debug modules and sampling of the global logger
+// and every logger derived from it.
+func Apply(s Settings) {
+	level.SetLevel(s.Level)
+	mods := slices.Clone(s.DebugModules)
+	debugModules.Store(&mods)
+	sampling.Store(s.Sampling)
+}
+
+func activeDebugModules() []string {
+	if mods := debugModules.Load(); mods != nil {
+		return *mods
+	}
+	return nil
+}
+
+// dynamicCore applies the runtime Settings on top of the core built from
+// the zap config: it picks the sampled or unsampled core per entry and lets
+// debug entries from the selected modules through below the global level.
+type dynamicCore struct {
+	raw     zapcore.Core
+	sampled zapcore.Core // nil when sampling is not configured
+}
+
+func newDynamicCore(raw zapcore.Core, sample bool) zapcore.Core {
+	c := &dynamicCore{raw: raw}
+	if sample {
+		c.sampled = zapcore.NewSamplerWithOptions(raw, time.Second, 100, 100)
+	}
+	return c
+}
+
+func (c *dynamicCore) active() zapcore.Core {
+	if c.sampled != nil && sampling.Load() {
+		return c.sampled
+	}
+	return c.raw
+}
+
+func (c *dynamicCore) Enabled(lvl zapcore.Level) bool {
+	return c.raw.Enabled(lvl) || (lvl >= zapcore.DebugLevel && len(activeDebugModules()) > 0)
+}
+
+func (c *dynamicCore) With(fields []zapcore.Field) zapcore.Core {
+	out := &dynamicCore{raw: c.raw.With(fields)}
+	if c.sampled != nil {
+		out.sampled = c.sampled.With(fields)
+	}
+	return out
+}
+
+func (c *dynamicCore) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.raw.Enabled(ent.Level) {
+		return c.active().Check(ent, ce)
+	}
+	if ent.Level < zapcore.DebugLevel {
+		return ce
+	}
+	if mods := activeDebugModules(); len(mods) > 0 && slices.Contains(mods, callerModule()) {
+		return ce.AddCore(ent, c)
+	}
+	return ce
+}
+
+// Write is only reached for entries Check accepted, so it writes to the
+// unsampled core unconditionally; module debug entries are never sampled.
+func (c *dynamicCore) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	return c.raw.Write(ent, fields)
+}
+
+func (c *dynamicCore) Sync() error {
+	return c.raw.Sync()
+}
+
+// callerModule returns the module of the innermost caller under internal/
+// outside this package. The logger's caller skip makes ent.Caller point one
+// frame too far out, so the stack is walked instead; this only runs while
+// debug modules are enabled.
+func callerModule() string {
+	pcs := make([]uintptr, 32)
+	frames := runtime.CallersFrames(pcs[:runtime.Callers(3, pcs)])
+	for {
+		f, more := frames.Next()
+		if m := moduleOf(f.Function); m != "" && m != "logger" {
+			return m
+		}
+		if !more {
+			return ""
+		}
+	}
+}
+
+// moduleOf maps a fully qualified function name to its module, the first
+// path element under internal/: "warimas-be/internal/payment/webhook.(*H).F"
+// is "payment".
+func moduleOf(function string) string {
+	rest, ok := strings.CutPrefix(function, internalPrefix)
+	if !ok {
+		return ""
+	}
+	if i := strings.IndexAny(rest, "/."); i >= 0 {
+		rest = rest[:i]
+	}
+	return rest
+}
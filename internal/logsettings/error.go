@@ -0,0 +1,15 @@
+package logsettings
+
+import (
+	"errors"
+	"warimas-be/internal/apperr"
+)
+
+var (
+	ErrUnauthenticated = apperr.Unauthenticated("unauthenticated")
+	ErrForbidden       = apperr.Forbidden("forbidden")
+	ErrDB              = errors.New("database error")
+	ErrInvalidLevel    = errors.New("invalid log level")
+	ErrInvalidModule   = errors.New("invalid debug module")
+	ErrInvalidDuration = errors.New("invalid duration")
+)
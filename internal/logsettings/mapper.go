@@ -0,0 +1,23 @@
+package logsettings
+
+import (
+	"strings"
+
+	"warimas-be/internal/graph/model"
+)
+
+func MapStatusToGraphQL(s *Status) *model.LogSettings {
+	out := &model.LogSettings{
+		Level:        model.LogLevel(strings.ToUpper(s.Settings.Level.String())),
+		DebugModules: s.Settings.DebugModules,
+		Sampling:     s.Settings.Sampling,
+		ExpiresAt:    s.ExpiresAt,
+	}
+	if out.DebugModules == nil {
+		out.DebugModules = []string{}
+	}
+	if !s.UpdatedAt.IsZero() {
+		out.UpdatedAt = &s.UpdatedAt
+	}
+	return out
+}
@@ -0,0 +1,77 @@
+package logsettings
+
+import (
+	"time"
+
+	"warimas-be/internal/logger"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// SyncInterval is how often each instance applies the stored override, and
+// so bounds how long a change takes to reach every pod.
+const SyncInterval = 15 * time.Second
+
+const (
+	// DefaultDuration is how long an override lasts when none is given.
+	DefaultDuration = 30 * time.Minute
+	// MaxDuration caps an override so verbose logging left on after an
+	// incident switches itself off.
+	MaxDuration = 24 * time.Hour
+)
+
+// Override is the stored runtime change to the logger. Empty or nil fields
+// keep the environment default; the whole override lapses at ExpiresAt.
+type Override struct {
+	Level        string
+	DebugModules []string
+	Sampling     *bool
+	ExpiresAt    *time.Time
+	UpdatedBy    *int32
+	UpdatedAt    time.Time
+}
+
+// Active reports whether o is in force at now.
+func (o *Override) Active(now time.Time) bool {
+	return o != nil && o.ExpiresAt != nil && now.Before(*o.ExpiresAt)
+}
+
+// Resolve returns the logger settings that apply at now, o over def.
+func (o *Override) Resolve(def logger.Settings, now time.Time) logger.Settings {
+	if !o.Active(now) {
+		return def
+	}
+	s := def
+	if lvl, err := zapcore.ParseLevel(o.Level); o.Level != "" && err == nil {
+		s.Level = lvl
+	}
+	if len(o.DebugModules) > 0 {
+		s.DebugModules = o.DebugModules
+	}
+	if o.Sampling != nil {
+		s.Sampling = *o.Sampling
+	}
+	return s
+}
+
+// Status is what admins see: the settings every instance converges on and
+// the override behind them.
+type Status struct {
+	Settings  logger.Settings
+	ExpiresAt *time.Time
+	UpdatedBy *int32
+	UpdatedAt time.Time
+}
+
+// SetInput is an admin change. A zero Level, no DebugModules and a nil
+// Sampling clear the override.
+type SetInput struct {
+	Level        string
+	DebugModules []string
+	Sampling     *bool
+	Duration     time.Duration
+}
+
+func (in SetInput) clears() bool {
+	return in.Level == "" && len(in.DebugModules) == 0 && in.Sampling == nil
+}
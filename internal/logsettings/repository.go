@@ -0,0 +1,73 @@
+package logsettings
+
+import (
+	"context"
+	"database/sql"
+
+	"warimas-be/internal/logger"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+type Repository interface {
+	Get(ctx context.Context) (*Override, error)
+	Set(ctx context.Context, o Override, updatedBy int32) (*Override, error)
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) Get(ctx context.Context) (*Override, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "Get"),
+	)
+
+	var o Override
+	err := r.db.QueryRowContext(ctx, `
+		SELECT level, debug_modules, sampling, expires_at, updated_by, updated_at
+		FROM log_settings
+		WHERE id
+	`).Scan(&o.Level, pq.Array(&o.DebugModules), &o.Sampling, &o.ExpiresAt, &o.UpdatedBy, &o.UpdatedAt)
+	if err == sql.ErrNoRows {
+		// The migration seeds the row; without it there is no override.
+		return &o, nil
+	}
+	if err != nil {
+		log.Error("failed to get log settings", zap.Error(err))
+		return nil, ErrDB
+	}
+	return &o, nil
+}
+
+func (r *repository) Set(ctx context.Context, o Override, updatedBy int32) (*Override, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "Set"),
+	)
+
+	var out Override
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO log_settings (id, level, debug_modules, sampling, expires_at, updated_by)
+		VALUES (TRUE, $1, $2, $3, $4, $5)
+		ON CONFLICT (id) DO UPDATE SET
+			level = EXCLUDED.level,
+			debug_modules = EXCLUDED.debug_modules,
+			sampling = EXCLUDED.sampling,
+			expires_at = EXCLUDED.expires_at,
+			updated_by = EXCLUDED.updated_by
+		RETURNING level, debug_modules, sampling, expires_at, updated_by, updated_at
+	`, o.Level, pq.Array(o.DebugModules), o.Sampling, o.ExpiresAt, updatedBy).
+		Scan(&out.Level, pq.Array(&out.DebugModules), &out.Sampling, &out.ExpiresAt, &out.UpdatedBy, &out.UpdatedAt)
+	if err != nil {
+		log.Error("failed to set log settings", zap.Error(err))
+		return nil, ErrDB
+	}
+	return &out, nil
+}
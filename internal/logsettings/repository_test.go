@@ -0,0 +1,71 @@
+package logsettings
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var overrideCols = []string{"level", "debug_modules", "sampling", "expires_at", "updated_by", "updated_at"}
+
+func TestRepository_Get(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+	expires := time.Now().Add(time.Hour)
+
+	mock.ExpectQuery(`SELECT level, debug_modules, sampling, expires_at, updated_by, updated_at FROM log_settings`).
+		WillReturnRows(sqlmock.NewRows(overrideCols).AddRow("debug", "{payment,order}", false, expires, 1, time.Now()))
+
+	o, err := repo.Get(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "debug", o.Level)
+	assert.Equal(t, []string{"payment", "order"}, o.DebugModules)
+	assert.False(t, *o.Sampling)
+	assert.Equal(t, expires, *o.ExpiresAt)
+
+	mock.ExpectQuery(`FROM log_settings`).WillReturnError(sql.ErrNoRows)
+	o, err = repo.Get(ctx)
+	require.NoError(t, err)
+	assert.Nil(t, o.ExpiresAt)
+
+	mock.ExpectQuery(`FROM log_settings`).WillReturnError(errors.New("boom"))
+	_, err = repo.Get(ctx)
+	assert.ErrorIs(t, err, ErrDB)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_Set(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	expires := time.Now().Add(time.Hour)
+
+	mock.ExpectQuery(`INSERT INTO log_settings .* ON CONFLICT \(id\) DO UPDATE`).
+		WithArgs("", "{\"payment\"}", nil, expires, int32(1)).
+		WillReturnRows(sqlmock.NewRows(overrideCols).AddRow("", "{payment}", nil, expires, 1, time.Now()))
+
+	o, err := repo.Set(context.Background(), Override{DebugModules: []string{"payment"}, ExpiresAt: &expires}, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"payment"}, o.DebugModules)
+	assert.Nil(t, o.Sampling)
+	assert.Equal(t, int32(1), *o.UpdatedBy)
+
+	mock.ExpectQuery(`INSERT INTO log_settings`).WillReturnError(errors.New("boom"))
+	_, err = repo.Set(context.Background(), Override{DebugModules: []string{}}, 1)
+	assert.ErrorIs(t, err, ErrDB)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
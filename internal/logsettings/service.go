@@ -0,0 +1,165 @@
+package logsettings
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"time"
+
+	"warimas-be/internal/logger"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+// modulePattern matches a package directly under internal/, which is what
+// a debug module names.
+var modulePattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+
+// Service stores the runtime logger override and applies it to this
+// instance. Other instances pick a change up on their next Sync.
+type Service interface {
+	Current(ctx context.Context) (*Status, error)
+	// Set is admin only.
+	Set(ctx context.Context, in SetInput) (*Status, error)
+	// Sync applies the stored override, or the defaults once it has
+	// expired, to the process logger.
+	Sync(ctx context.Context) error
+}
+
+type service struct {
+	repo     Repository
+	defaults func() logger.Settings
+	apply    func(logger.Settings)
+	current  func() logger.Settings
+	now      func() time.Time
+}
+
+func NewService(repo Repository) Service {
+	return &service{
+		repo:     repo,
+		defaults: logger.Defaults,
+		apply:    logger.Apply,
+		current:  logger.Current,
+		now:      time.Now,
+	}
+}
+
+func (s *service) Current(ctx context.Context) (*Status, error) {
+	o, err := s.repo.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.status(o), nil
+}
+
+func (s *service) Set(ctx context.Context, in SetInput) (*Status, error) {
+	userID, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+	if !utils.IsAdmin(ctx) {
+		return nil, ErrForbidden
+	}
+
+	o, err := s.override(in)
+	if err != nil {
+		return nil, err
+	}
+
+	saved, err := s.repo.Set(ctx, *o, int32(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	st := s.status(saved)
+	s.apply(st.Settings)
+
+	logger.FromCtx(ctx).Warn("log settings changed",
+		zap.Stringer("level", st.Settings.Level),
+		zap.Strings("debug_modules", st.Settings.DebugModules),
+		zap.Bool("sampling", st.Settings.Sampling),
+		zap.Timep("expires_at", st.ExpiresAt),
+		zap.Uint("admin_id", userID),
+	)
+
+	return st, nil
+}
+
+func (s *service) Sync(ctx context.Context) error {
+	o, err := s.repo.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	want := o.Resolve(s.defaults(), s.now())
+	if sameSettings(want, s.current()) {
+		return nil
+	}
+	s.apply(want)
+
+	logger.FromCtx(ctx).Info("log settings applied",
+		zap.Stringer("level", want.Level),
+		zap.Strings("debug_modules", want.DebugModules),
+		zap.Bool("sampling", want.Sampling),
+	)
+	return nil
+}
+
+// override validates in and turns it into the row to store.
+func (s *service) override(in SetInput) (*Override, error) {
+	if in.clears() {
+		return &Override{DebugModules: []string{}}, nil
+	}
+
+	if in.Level != "" {
+		lvl, err := zapcore.ParseLevel(in.Level)
+		if err != nil || lvl < zapcore.DebugLevel || lvl > zapcore.ErrorLevel {
+			return nil, ErrInvalidLevel
+		}
+		in.Level = lvl.String()
+	}
+
+	modules := []string{}
+	for _, m := range in.DebugModules {
+		if !modulePattern.MatchString(m) {
+			return nil, ErrInvalidModule
+		}
+		if !slices.Contains(modules, m) {
+			modules = append(modules, m)
+		}
+	}
+
+	d := in.Duration
+	if d == 0 {
+		d = DefaultDuration
+	}
+	if d < 0 || d > MaxDuration {
+		return nil, ErrInvalidDuration
+	}
+	expires := s.now().Add(d)
+
+	return &Override{
+		Level:        in.Level,
+		DebugModules: modules,
+		Sampling:     in.Sampling,
+		ExpiresAt:    &expires,
+	}, nil
+}
+
+func (s *service) status(o *Override) *Status {
+	st := &Status{
+		Settings:  o.Resolve(s.defaults(), s.now()),
+		UpdatedBy: o.UpdatedBy,
+		UpdatedAt: o.UpdatedAt,
+	}
+	if o.Active(s.now()) {
+		st.ExpiresAt = o.ExpiresAt
+	}
+	return st
+}
+
+func sameSettings(a, b logger.Settings) bool {
+	return a.Level == b.Level && a.Sampling == b.Sampling && slices.Equal(a.DebugModules, b.DebugModules)
+}
@@ -0,0 +1,171 @@
+package logsettings
+
+import (
+	"context"
+	"testing"
+	"time"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+// --- Mocks ---
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) Get(ctx context.Context) (*Override, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Override), args.Error(1)
+}
+
+func (m *MockRepository) Set(ctx context.Context, o Override, updatedBy int32) (*Override, error) {
+	args := m.Called(ctx, o, updatedBy)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Override), args.Error(1)
+}
+
+// --- Tests ---
+
+var testDefaults = logger.Settings{Level: zapcore.InfoLevel, Sampling: true}
+
+func adminCtx() context.Context {
+	return utils.SetUserContext(context.Background(), 1, "admin@example.com", "ADMIN")
+}
+
+// newTestService returns a service whose logger is a plain variable, so
+// tests can see what was applied without touching the global logger.
+func newTestService(repo Repository) (*service, *time.Time, *logger.Settings) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	applied := testDefaults
+	s := NewService(repo).(*service)
+	s.now = func() time.Time { return now }
+	s.defaults = func() logger.Settings { return testDefaults }
+	s.current = func() logger.Settings { return applied }
+	s.apply = func(ls logger.Settings) { applied = ls }
+	return s, &now, &applied
+}
+
+func TestOverride_Resolve(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	later := now.Add(time.Minute)
+	off := false
+
+	var none *Override
+	assert.Equal(t, testDefaults, none.Resolve(testDefaults, now))
+
+	o := &Override{Level: "warn", DebugModules: []string{"payment"}, Sampling: &off, ExpiresAt: &later}
+	assert.Equal(t, logger.Settings{
+		Level:        zapcore.WarnLevel,
+		DebugModules: []string{"payment"},
+		Sampling:     false,
+	}, o.Resolve(testDefaults, now))
+
+	// Unset fields keep the defaults.
+	o = &Override{DebugModules: []string{"payment"}, ExpiresAt: &later}
+	assert.Equal(t, logger.Settings{
+		Level:        zapcore.InfoLevel,
+		DebugModules: []string{"payment"},
+		Sampling:     true,
+	}, o.Resolve(testDefaults, now))
+
+	assert.Equal(t, testDefaults, o.Resolve(testDefaults, later))
+}
+
+func TestService_Set(t *testing.T) {
+	t.Run("Unauthenticated", func(t *testing.T) {
+		s, _, _ := newTestService(new(MockRepository))
+		_, err := s.Set(context.Background(), SetInput{Level: "debug"})
+		assert.ErrorIs(t, err, ErrUnauthenticated)
+	})
+
+	t.Run("Forbidden for non-admin", func(t *testing.T) {
+		s, _, _ := newTestService(new(MockRepository))
+		ctx := utils.SetUserContext(context.Background(), 2, "user@example.com", "USER")
+		_, err := s.Set(ctx, SetInput{Level: "debug"})
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+
+	t.Run("Validation", func(t *testing.T) {
+		s, _, _ := newTestService(new(MockRepository))
+
+		_, err := s.Set(adminCtx(), SetInput{Level: "fatal"})
+		assert.ErrorIs(t, err, ErrInvalidLevel)
+
+		_, err = s.Set(adminCtx(), SetInput{DebugModules: []string{"../payment"}})
+		assert.ErrorIs(t, err, ErrInvalidModule)
+
+		_, err = s.Set(adminCtx(), SetInput{Level: "debug", Duration: 25 * time.Hour})
+		assert.ErrorIs(t, err, ErrInvalidDuration)
+	})
+
+	t.Run("Enables payment debug logs for the default duration", func(t *testing.T) {
+		repo := new(MockRepository)
+		s, now, applied := newTestService(repo)
+		expires := now.Add(DefaultDuration)
+		want := Override{DebugModules: []string{"payment"}, ExpiresAt: &expires}
+		repo.On("Set", mock.Anything, want, int32(1)).Return(&want, nil)
+
+		st, err := s.Set(adminCtx(), SetInput{DebugModules: []string{"payment", "payment"}})
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"payment"}, st.Settings.DebugModules)
+		assert.Equal(t, &expires, st.ExpiresAt)
+		assert.Equal(t, []string{"payment"}, applied.DebugModules)
+		assert.Equal(t, zapcore.InfoLevel, applied.Level)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Empty input clears the override", func(t *testing.T) {
+		repo := new(MockRepository)
+		s, _, applied := newTestService(repo)
+		*applied = logger.Settings{Level: zapcore.DebugLevel}
+		repo.On("Set", mock.Anything, Override{DebugModules: []string{}}, int32(1)).
+			Return(&Override{DebugModules: []string{}}, nil)
+
+		st, err := s.Set(adminCtx(), SetInput{Duration: time.Hour})
+
+		require.NoError(t, err)
+		assert.Nil(t, st.ExpiresAt)
+		assert.Equal(t, testDefaults, *applied)
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		repo := new(MockRepository)
+		s, _, _ := newTestService(repo)
+		repo.On("Set", mock.Anything, mock.Anything, int32(1)).Return(nil, ErrDB)
+
+		_, err := s.Set(adminCtx(), SetInput{Level: "debug"})
+		assert.ErrorIs(t, err, ErrDB)
+	})
+}
+
+func TestService_Sync(t *testing.T) {
+	repo := new(MockRepository)
+	s, now, applied := newTestService(repo)
+	expires := now.Add(time.Minute)
+	repo.On("Get", mock.Anything).Return(&Override{Level: "debug", ExpiresAt: &expires}, nil)
+
+	require.NoError(t, s.Sync(context.Background()))
+	assert.Equal(t, zapcore.DebugLevel, applied.Level)
+
+	// Reverts to the defaults once the override expires.
+	*now = expires
+	require.NoError(t, s.Sync(context.Background()))
+	assert.Equal(t, testDefaults, *applied)
+
+	repo.ExpectedCalls = nil
+	repo.On("Get", mock.Anything).Return(nil, ErrDB)
+	assert.ErrorIs(t, s.Sync(context.Background()), ErrDB)
+	assert.Equal(t, testDefaults, *applied)
+}
@@ -0,0 +1,14 @@
+package loyalty
+
+import (
+	"errors"
+	"warimas-be/internal/apperr"
+)
+
+var (
+	ErrUnauthenticated = apperr.Unauthenticated("unauthenticated")
+	ErrForbidden       = apperr.Forbidden("forbidden")
+	ErrInvalidRule     = errors.New("invalid loyalty rule")
+	ErrRuleNotFound    = apperr.NotFound("loyalty rule not found")
+	ErrDB              = errors.New("database error")
+)
@@ -0,0 +1,49 @@
+package loyalty
+
+import (
+	"strconv"
+	"warimas-be/internal/graph/model"
+)
+
+func MapAccountToGraphQL(a *Account, entries []*LedgerEntry) *model.LoyaltyAccount {
+	if a == nil {
+		return nil
+	}
+
+	items := make([]*model.LoyaltyLedgerEntry, 0, len(entries))
+	for _, e := range entries {
+		items = append(items, MapLedgerEntryToGraphQL(e))
+	}
+
+	return &model.LoyaltyAccount{
+		Balance: int32(a.Balance),
+		Entries: items,
+	}
+}
+
+func MapLedgerEntryToGraphQL(e *LedgerEntry) *model.LoyaltyLedgerEntry {
+	return &model.LoyaltyLedgerEntry{
+		ID:            strconv.FormatInt(e.ID, 10),
+		Type:          model.LoyaltyEntryType(e.Type),
+		Points:        int32(e.Points),
+		BalanceAfter:  int32(e.BalanceAfter),
+		ReferenceType: e.ReferenceType,
+		ReferenceID:   e.ReferenceID,
+		ExpiresAt:     e.ExpiresAt,
+		CreatedAt:     e.CreatedAt,
+	}
+}
+
+func MapRuleToGraphQL(r *Rule) *model.LoyaltyRule {
+	return &model.LoyaltyRule{
+		ID:             strconv.FormatInt(r.ID, 10),
+		Name:           r.Name,
+		PointsPerUnit:  r.PointsPerUnit,
+		UnitAmount:     int32(r.UnitAmount),
+		MinOrderAmount: int32(r.MinOrderAmount),
+		StartsAt:       r.StartsAt,
+		EndsAt:         r.EndsAt,
+		IsActive:       r.IsActive,
+		CreatedAt:      r.CreatedAt,
+	}
+}
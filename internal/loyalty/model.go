@@ -0,0 +1,97 @@
+package loyalty
+
+import "time"
+
+type EntryType string
+
+const (
+	EntryEarn   EntryType = "EARN"
+	EntryBurn   EntryType = "BURN"
+	EntryExpire EntryType = "EXPIRE"
+	// EntryClawback takes back the points of an order whose payment was
+	// charged back.
+	EntryClawback EntryType = "CLAWBACK"
+)
+
+// Reference types recorded on ledger entries.
+const (
+	ReferenceOrder = "ORDER"
+	ReferenceLot   = "EARN_LOT"
+)
+
+// PointValue is the rupiah value of one point when redeemed at checkout.
+const PointValue = 1
+
+// pointsLifetime is how long earned points stay redeemable.
+const pointsLifetime = 365 * 24 * time.Hour
+
+// Scheduling of the background loyalty jobs.
+const (
+	AccrualInterval = 10 * time.Minute
+	ExpiryInterval  = time.Hour
+	jobBatchSize    = 200
+)
+
+const defaultLedgerLimit = 20
+
+type Rule struct {
+	ID             int64
+	Name           string
+	PointsPerUnit  int32
+	UnitAmount     int64
+	MinOrderAmount int64
+	StartsAt       *time.Time
+	EndsAt         *time.Time
+	IsActive       bool
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+}
+
+// PointsFor returns what the rule awards on amount of eligible spend.
+func (r *Rule) PointsFor(amount int64) int64 {
+	if amount <= 0 || amount < r.MinOrderAmount {
+		return 0
+	}
+	return amount / r.UnitAmount * int64(r.PointsPerUnit)
+}
+
+func (r *Rule) ActiveAt(t time.Time) bool {
+	if !r.IsActive {
+		return false
+	}
+	if r.StartsAt != nil && t.Before(*r.StartsAt) {
+		return false
+	}
+	if r.EndsAt != nil && !t.Before(*r.EndsAt) {
+		return false
+	}
+	return true
+}
+
+type Account struct {
+	UserID  uint
+	Balance int64
+}
+
+type LedgerEntry struct {
+	ID            int64
+	UserID        int32
+	Type          EntryType
+	Points        int64
+	BalanceAfter  int64
+	ReferenceType string
+	ReferenceID   string
+	ExpiresAt     *time.Time
+	CreatedAt     time.Time
+}
+
+// CompletedOrder is a completed order that has not earned points yet.
+// EligibleAmount is what the customer paid for goods: the total less tax
+// and shipping, so voucher and points discounts earn nothing.
+type CompletedOrder struct {
+	ID             int32
+	ExternalID     string
+	UserID         int32
+	EligibleAmount int64
+	CompletedAt    time.Time
+}
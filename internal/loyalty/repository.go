@@ -0,0 +1,403 @@
+package loyalty
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"time"
+	"warimas-be/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+type Repository interface {
+	GetAccount(ctx context.Context, userID uint) (*Account, error)
+	ListLedger(ctx context.Context, userID uint, limit int32) ([]*LedgerEntry, error)
+	ListRules(ctx context.Context) ([]*Rule, error)
+	CreateRule(ctx context.Context, rule *Rule) error
+	SetRuleActive(ctx context.Context, id int64, active bool) (*Rule, error)
+	ListUnaccruedCompletedOrders(ctx context.Context, since time.Time, afterID int32, limit int32) ([]*CompletedOrder, error)
+	Earn(ctx context.Context, o *CompletedOrder, points int64, expiresAt time.Time) (bool, error)
+	ExpireLots(ctx context.Context, limit int32) (int64, error)
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+const ruleColumns = `
+	id, name, points_per_unit, unit_amount, min_order_amount,
+	starts_at, ends_at, is_active, created_at, updated_at
+`
+
+func scanRule(row interface{ Scan(dest ...any) error }) (*Rule, error) {
+	var r Rule
+	err := row.Scan(
+		&r.ID, &r.Name, &r.PointsPerUnit, &r.UnitAmount, &r.MinOrderAmount,
+		&r.StartsAt, &r.EndsAt, &r.IsActive, &r.CreatedAt, &r.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// GetAccount returns the user's points account, or an empty one when the
+// user has never earned points.
+func (r *repository) GetAccount(ctx context.Context, userID uint) (*Account, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "GetAccount"),
+		zap.Uint("user_id", userID),
+	)
+
+	a := Account{UserID: userID}
+	err := r.db.QueryRowContext(ctx, `
+		SELECT balance FROM loyalty_accounts WHERE user_id = $1
+	`, userID).Scan(&a.Balance)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &a, nil
+	}
+	if err != nil {
+		log.Error("failed to get loyalty account", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return &a, nil
+}
+
+func (r *repository) ListLedger(ctx context.Context, userID uint, limit int32) ([]*LedgerEntry, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ListLedger"),
+		zap.Uint("user_id", userID),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, user_id, entry_type, points, balance_after,
+		       reference_type, reference_id, expires_at, created_at
+		FROM loyalty_ledger
+		WHERE user_id = $1
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2
+	`, userID, limit)
+	if err != nil {
+		log.Error("failed to query loyalty ledger", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	entries := []*LedgerEntry{}
+	for rows.Next() {
+		var e LedgerEntry
+		if err := rows.Scan(
+			&e.ID, &e.UserID, &e.Type, &e.Points, &e.BalanceAfter,
+			&e.ReferenceType, &e.ReferenceID, &e.ExpiresAt, &e.CreatedAt,
+		); err != nil {
+			log.Error("failed to scan loyalty ledger row", zap.Error(err))
+			return nil, ErrDB
+		}
+		entries = append(entries, &e)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error("loyalty ledger iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return entries, nil
+}
+
+func (r *repository) ListRules(ctx context.Context) ([]*Rule, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ListRules"),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+ruleColumns+`
+		FROM loyalty_rules
+		ORDER BY id
+	`)
+	if err != nil {
+		log.Error("failed to query loyalty rules", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	rules := []*Rule{}
+	for rows.Next() {
+		rule, err := scanRule(rows)
+		if err != nil {
+			log.Error("failed to scan loyalty rule", zap.Error(err))
+			return nil, ErrDB
+		}
+		rules = append(rules, rule)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error("loyalty rule iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return rules, nil
+}
+
+func (r *repository) CreateRule(ctx context.Context, rule *Rule) error {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "CreateRule"),
+	)
+
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO loyalty_rules (
+			name, points_per_unit, unit_amount, min_order_amount, starts_at, ends_at, is_active
+		) VALUES ($1,$2,$3,$4,$5,$6,$7)
+		RETURNING id, created_at, updated_at
+	`,
+		rule.Name, rule.PointsPerUnit, rule.UnitAmount, rule.MinOrderAmount,
+		rule.StartsAt, rule.EndsAt, rule.IsActive,
+	).Scan(&rule.ID, &rule.CreatedAt, &rule.UpdatedAt)
+	if err != nil {
+		log.Error("failed to insert loyalty rule", zap.Error(err))
+		return ErrDB
+	}
+
+	return nil
+}
+
+func (r *repository) SetRuleActive(ctx context.Context, id int64, active bool) (*Rule, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "SetRuleActive"),
+		zap.Int64("rule_id", id),
+	)
+
+	rule, err := scanRule(r.db.QueryRowContext(ctx, `
+		UPDATE loyalty_rules SET is_active = $1
+		WHERE id = $2
+		RETURNING `+ruleColumns,
+		active, id,
+	))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrRuleNotFound
+	}
+	if err != nil {
+		log.Error("failed to update loyalty rule", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return rule, nil
+}
+
+// ListUnaccruedCompletedOrders returns a page of orders completed after
+// since that have no EARN entry yet, keyed by order id after afterID.
+func (r *repository) ListUnaccruedCompletedOrders(
+	ctx context.Context,
+	since time.Time,
+	afterID int32,
+	limit int32,
+) ([]*CompletedOrder, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ListUnaccruedCompletedOrders"),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT o.id, o.external_id, o.user_id,
+		       GREATEST(o.total_amount - COALESCE(o.tax, 0) - COALESCE(o.shipping_fee, 0) - o.insurance_fee, 0),
+		       o.updated_at
+		FROM orders o
+		WHERE o.status = 'COMPLETED'
+		  AND o.user_id IS NOT NULL
+		  AND COALESCE(o.dispute_status, '') NOT IN ('DISPUTED', 'LOST')
+		  AND o.updated_at > $1
+		  AND o.id > $2
+		  AND NOT EXISTS (
+			SELECT 1 FROM loyalty_ledger l
+			WHERE l.reference_type = 'ORDER'
+			  AND l.reference_id = o.external_id
+			  AND l.entry_type = 'EARN'
+		  )
+		ORDER BY o.id
+		LIMIT $3
+	`, since, afterID, limit)
+	if err != nil {
+		log.Error("failed to query completed orders", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	var orders []*CompletedOrder
+	for rows.Next() {
+		var o CompletedOrder
+		if err := rows.Scan(&o.ID, &o.ExternalID, &o.UserID, &o.EligibleAmount, &o.CompletedAt); err != nil {
+			log.Error("failed to scan completed order", zap.Error(err))
+			return nil, ErrDB
+		}
+		orders = append(orders, &o)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error("completed order iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return orders, nil
+}
+
+// Earn credits points for a completed order as a new lot expiring at
+// expiresAt. Returns false when the order has already earned.
+func (r *repository) Earn(ctx context.Context, o *CompletedOrder, points int64, expiresAt time.Time) (ok bool, err error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "Earn"),
+		zap.String("order_external_id", o.ExternalID),
+	)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error("failed to begin transaction", zap.Error(err))
+		return false, ErrDB
+	}
+	defer func() {
+		if err != nil || !ok {
+			_ = tx.Rollback()
+		}
+	}()
+
+	var balance int64
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO loyalty_accounts (user_id, balance)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id)
+		DO UPDATE SET balance = loyalty_accounts.balance + EXCLUDED.balance
+		RETURNING balance
+	`, o.UserID, points).Scan(&balance)
+	if err != nil {
+		log.Error("failed to credit loyalty account", zap.Error(err))
+		return false, ErrDB
+	}
+
+	res, err := tx.ExecContext(ctx, `
+		INSERT INTO loyalty_ledger (
+			user_id, entry_type, points, remaining, balance_after,
+			reference_type, reference_id, expires_at
+		) VALUES ($1,$2,$3,$3,$4,$5,$6,$7)
+		ON CONFLICT (reference_type, reference_id, entry_type) DO NOTHING
+	`, o.UserID, EntryEarn, points, balance, ReferenceOrder, o.ExternalID, expiresAt)
+	if err != nil {
+		log.Error("failed to insert earn entry", zap.Error(err))
+		return false, ErrDB
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return false, nil
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Error("failed to commit earn", zap.Error(err))
+		return false, ErrDB
+	}
+
+	return true, nil
+}
+
+// ExpireLots zeroes up to limit EARN lots past their expiry, takes what
+// was left of each off the account balance and records an EXPIRE entry
+// per lot. Returns the number of points expired.
+func (r *repository) ExpireLots(ctx context.Context, limit int32) (expired int64, err error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ExpireLots"),
+	)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error("failed to begin transaction", zap.Error(err))
+		return 0, ErrDB
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT id, user_id, remaining
+		FROM loyalty_ledger
+		WHERE entry_type = 'EARN'
+		  AND remaining > 0
+		  AND expires_at <= NOW()
+		ORDER BY expires_at
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	`, limit)
+	if err != nil {
+		log.Error("failed to query expired lots", zap.Error(err))
+		return 0, ErrDB
+	}
+
+	type lot struct {
+		id        int64
+		userID    int32
+		remaining int64
+	}
+	var lots []lot
+	for rows.Next() {
+		var l lot
+		if err = rows.Scan(&l.id, &l.userID, &l.remaining); err != nil {
+			rows.Close()
+			log.Error("failed to scan expired lot", zap.Error(err))
+			return 0, ErrDB
+		}
+		lots = append(lots, l)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		log.Error("expired lot iteration failed", zap.Error(err))
+		return 0, ErrDB
+	}
+
+	for _, l := range lots {
+		if _, err = tx.ExecContext(ctx, `
+			UPDATE loyalty_ledger SET remaining = 0 WHERE id = $1
+		`, l.id); err != nil {
+			log.Error("failed to close lot", zap.Int64("lot_id", l.id), zap.Error(err))
+			return 0, ErrDB
+		}
+
+		var balance int64
+		if err = tx.QueryRowContext(ctx, `
+			UPDATE loyalty_accounts
+			SET balance = GREATEST(balance - $1, 0)
+			WHERE user_id = $2
+			RETURNING balance
+		`, l.remaining, l.userID).Scan(&balance); err != nil {
+			log.Error("failed to debit loyalty account", zap.Int64("lot_id", l.id), zap.Error(err))
+			return 0, ErrDB
+		}
+
+		if _, err = tx.ExecContext(ctx, `
+			INSERT INTO loyalty_ledger (
+				user_id, entry_type, points, balance_after, reference_type, reference_id
+			) VALUES ($1,$2,$3,$4,$5,$6)
+		`, l.userID, EntryExpire, l.remaining, balance, ReferenceLot, strconv.FormatInt(l.id, 10)); err != nil {
+			log.Error("failed to insert expire entry", zap.Int64("lot_id", l.id), zap.Error(err))
+			return 0, ErrDB
+		}
+
+		expired += l.remaining
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Error("failed to commit expiry", zap.Error(err))
+		return 0, ErrDB
+	}
+
+	return expired, nil
+}
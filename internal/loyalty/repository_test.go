@@ -0,0 +1,109 @@
+package loyalty
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_GetAccount(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+
+	t.Run("Found", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT balance FROM loyalty_accounts WHERE user_id = \$1`).
+			WithArgs(uint(1)).
+			WillReturnRows(sqlmock.NewRows([]string{"balance"}).AddRow(700))
+
+		a, err := repo.GetAccount(ctx, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(700), a.Balance)
+	})
+
+	t.Run("NoAccount", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT balance FROM loyalty_accounts`).WillReturnError(sql.ErrNoRows)
+
+		a, err := repo.GetAccount(ctx, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), a.Balance)
+	})
+}
+
+func TestRepository_Earn(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+
+	o := &CompletedOrder{ID: 7, ExternalID: "ord-7", UserID: 3}
+	expiresAt := time.Now().Add(pointsLifetime)
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO loyalty_accounts .* ON CONFLICT \(user_id\)`).
+			WithArgs(int32(3), int64(250)).
+			WillReturnRows(sqlmock.NewRows([]string{"balance"}).AddRow(1250))
+		mock.ExpectExec(`INSERT INTO loyalty_ledger .* ON CONFLICT .* DO NOTHING`).
+			WithArgs(int32(3), EntryEarn, int64(250), int64(1250), ReferenceOrder, "ord-7", expiresAt).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		ok, err := repo.Earn(ctx, o, 250, expiresAt)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("AlreadyEarned", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO loyalty_accounts`).
+			WillReturnRows(sqlmock.NewRows([]string{"balance"}).AddRow(1500))
+		mock.ExpectExec(`INSERT INTO loyalty_ledger`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		ok, err := repo.Earn(ctx, o, 250, expiresAt)
+		assert.NoError(t, err)
+		assert.False(t, ok)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_ExpireLots(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+
+	mock.ExpectBegin()
+	mock.ExpectQuery(`SELECT id, user_id, remaining FROM loyalty_ledger .* FOR UPDATE SKIP LOCKED`).
+		WithArgs(int32(200)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "remaining"}).AddRow(11, 3, 40))
+	mock.ExpectExec(`UPDATE loyalty_ledger SET remaining = 0 WHERE id = \$1`).
+		WithArgs(int64(11)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`UPDATE loyalty_accounts SET balance = GREATEST\(balance - \$1, 0\)`).
+		WithArgs(int64(40), int32(3)).
+		WillReturnRows(sqlmock.NewRows([]string{"balance"}).AddRow(960))
+	mock.ExpectExec(`INSERT INTO loyalty_ledger`).
+		WithArgs(int32(3), EntryExpire, int64(40), int64(960), ReferenceLot, "11").
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	n, err := repo.ExpireLots(ctx, 200)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(40), n)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,217 @@
+package loyalty
+
+import (
+	"context"
+	"strings"
+	"time"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+type Service interface {
+	GetMyPoints(ctx context.Context) (*Account, []*LedgerEntry, error)
+	ListRules(ctx context.Context) ([]*Rule, error)
+	CreateRule(ctx context.Context, rule *Rule) (*Rule, error)
+	SetRuleActive(ctx context.Context, id int64, active bool) (*Rule, error)
+
+	// AccrueCompletedOrders and ExpirePoints run on a schedule
+	// (AccrualInterval, ExpiryInterval) and carry no caller check.
+	AccrueCompletedOrders(ctx context.Context) (int, error)
+	ExpirePoints(ctx context.Context) (int64, error)
+
+	// Balance is for other modules (checkout) and carries no caller check.
+	Balance(ctx context.Context, userID uint) (int64, error)
+}
+
+type service struct {
+	repo Repository
+}
+
+func NewService(repo Repository) Service {
+	return &service{repo: repo}
+}
+
+// GetMyPoints returns the caller's points balance with its most recent
+// ledger entries.
+func (s *service) GetMyPoints(ctx context.Context) (*Account, []*LedgerEntry, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "GetMyPoints"),
+	)
+
+	userID, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		log.Warn("user not authenticated")
+		return nil, nil, ErrUnauthenticated
+	}
+
+	log = log.With(zap.Uint("user_id", userID))
+
+	a, err := s.repo.GetAccount(ctx, userID)
+	if err != nil {
+		log.Error("failed to get loyalty account", zap.Error(err))
+		return nil, nil, err
+	}
+
+	entries, err := s.repo.ListLedger(ctx, userID, defaultLedgerLimit)
+	if err != nil {
+		log.Error("failed to list loyalty ledger", zap.Error(err))
+		return nil, nil, err
+	}
+
+	return a, entries, nil
+}
+
+// Balance returns the user's points balance, zero when the user has never
+// earned any.
+func (s *service) Balance(ctx context.Context, userID uint) (int64, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "Balance"),
+		zap.Uint("user_id", userID),
+	)
+
+	a, err := s.repo.GetAccount(ctx, userID)
+	if err != nil {
+		log.Error("failed to get loyalty account", zap.Error(err))
+		return 0, err
+	}
+
+	return a.Balance, nil
+}
+
+func (s *service) ListRules(ctx context.Context) ([]*Rule, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return s.repo.ListRules(ctx)
+}
+
+func (s *service) CreateRule(ctx context.Context, rule *Rule) (*Rule, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "CreateRule"),
+	)
+
+	if err := requireAdmin(ctx); err != nil {
+		log.Warn("caller is not an admin", zap.Error(err))
+		return nil, err
+	}
+
+	rule.Name = strings.TrimSpace(rule.Name)
+	if rule.Name == "" || rule.PointsPerUnit <= 0 || rule.UnitAmount <= 0 || rule.MinOrderAmount < 0 {
+		return nil, ErrInvalidRule
+	}
+	if rule.StartsAt != nil && rule.EndsAt != nil && !rule.EndsAt.After(*rule.StartsAt) {
+		return nil, ErrInvalidRule
+	}
+
+	if err := s.repo.CreateRule(ctx, rule); err != nil {
+		log.Error("failed to create loyalty rule", zap.Error(err))
+		return nil, err
+	}
+
+	log.Info("loyalty rule created", zap.Int64("rule_id", rule.ID))
+	return rule, nil
+}
+
+func (s *service) SetRuleActive(ctx context.Context, id int64, active bool) (*Rule, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return s.repo.SetRuleActive(ctx, id, active)
+}
+
+// AccrueCompletedOrders awards points for completed orders that have not
+// earned yet. Each active rule is applied to the order's eligible amount
+// and the results are summed. Orders that earn nothing stay unaccrued, so
+// only orders completed within pointsLifetime are considered.
+func (s *service) AccrueCompletedOrders(ctx context.Context) (int, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "AccrueCompletedOrders"),
+	)
+
+	rules, err := s.repo.ListRules(ctx)
+	if err != nil {
+		log.Error("failed to list loyalty rules", zap.Error(err))
+		return 0, err
+	}
+
+	since := time.Now().Add(-pointsLifetime)
+	accrued := 0
+	var afterID int32
+	for {
+		orders, err := s.repo.ListUnaccruedCompletedOrders(ctx, since, afterID, jobBatchSize)
+		if err != nil {
+			log.Error("failed to list completed orders", zap.Error(err))
+			return accrued, err
+		}
+
+		for _, o := range orders {
+			afterID = o.ID
+
+			var points int64
+			for _, rule := range rules {
+				if rule.ActiveAt(o.CompletedAt) {
+					points += rule.PointsFor(o.EligibleAmount)
+				}
+			}
+			if points <= 0 {
+				continue
+			}
+
+			ok, err := s.repo.Earn(ctx, o, points, o.CompletedAt.Add(pointsLifetime))
+			if err != nil {
+				log.Error("failed to earn points",
+					zap.String("order_external_id", o.ExternalID),
+					zap.Error(err),
+				)
+				continue
+			}
+			if ok {
+				accrued++
+			}
+		}
+
+		if len(orders) < jobBatchSize {
+			break
+		}
+	}
+
+	if accrued > 0 {
+		log.Info("loyalty points accrued", zap.Int("accrued", accrued))
+	}
+	return accrued, nil
+}
+
+// ExpirePoints expires lots that outlived pointsLifetime.
+func (s *service) ExpirePoints(ctx context.Context) (int64, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "ExpirePoints"),
+	)
+
+	expired, err := s.repo.ExpireLots(ctx, jobBatchSize)
+	if err != nil {
+		log.Error("failed to expire loyalty points", zap.Error(err))
+		return 0, err
+	}
+
+	if expired > 0 {
+		log.Info("loyalty points expired", zap.Int64("points", expired))
+	}
+	return expired, nil
+}
+
+func requireAdmin(ctx context.Context) error {
+	if _, ok := utils.GetUserIDFromContext(ctx); !ok {
+		return ErrUnauthenticated
+	}
+	if !utils.IsAdmin(ctx) {
+		return ErrForbidden
+	}
+	return nil
+}
@@ -0,0 +1,249 @@
+package loyalty
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"warimas-be/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// --- Mocks ---
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) GetAccount(ctx context.Context, userID uint) (*Account, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Account), args.Error(1)
+}
+
+func (m *MockRepository) ListLedger(ctx context.Context, userID uint, limit int32) ([]*LedgerEntry, error) {
+	args := m.Called(ctx, userID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*LedgerEntry), args.Error(1)
+}
+
+func (m *MockRepository) ListRules(ctx context.Context) ([]*Rule, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Rule), args.Error(1)
+}
+
+func (m *MockRepository) CreateRule(ctx context.Context, rule *Rule) error {
+	args := m.Called(ctx, rule)
+	return args.Error(0)
+}
+
+func (m *MockRepository) SetRuleActive(ctx context.Context, id int64, active bool) (*Rule, error) {
+	args := m.Called(ctx, id, active)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Rule), args.Error(1)
+}
+
+func (m *MockRepository) ListUnaccruedCompletedOrders(ctx context.Context, since time.Time, afterID int32, limit int32) ([]*CompletedOrder, error) {
+	args := m.Called(ctx, since, afterID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*CompletedOrder), args.Error(1)
+}
+
+func (m *MockRepository) Earn(ctx context.Context, o *CompletedOrder, points int64, expiresAt time.Time) (bool, error) {
+	args := m.Called(ctx, o, points, expiresAt)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRepository) ExpireLots(ctx context.Context, limit int32) (int64, error) {
+	args := m.Called(ctx, limit)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// --- Tests ---
+
+func TestRule_PointsFor(t *testing.T) {
+	r := &Rule{PointsPerUnit: 2, UnitAmount: 1000, MinOrderAmount: 50000}
+
+	assert.Equal(t, int64(0), r.PointsFor(49999))
+	assert.Equal(t, int64(100), r.PointsFor(50000))
+	assert.Equal(t, int64(100), r.PointsFor(50999))
+}
+
+func TestRule_ActiveAt(t *testing.T) {
+	now := time.Now()
+	start := now.Add(-time.Hour)
+	end := now.Add(time.Hour)
+
+	assert.True(t, (&Rule{IsActive: true, StartsAt: &start, EndsAt: &end}).ActiveAt(now))
+	assert.False(t, (&Rule{IsActive: false}).ActiveAt(now))
+	assert.False(t, (&Rule{IsActive: true, StartsAt: &end}).ActiveAt(now))
+	assert.False(t, (&Rule{IsActive: true, EndsAt: &start}).ActiveAt(now))
+}
+
+func TestService_GetMyPoints(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+		ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "USER")
+
+		mockRepo.On("GetAccount", ctx, uint(1)).Return(&Account{UserID: 1, Balance: 1200}, nil)
+		mockRepo.On("ListLedger", ctx, uint(1), int32(defaultLedgerLimit)).
+			Return([]*LedgerEntry{{ID: 1, Type: EntryEarn, Points: 1200}}, nil)
+
+		a, entries, err := svc.GetMyPoints(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1200), a.Balance)
+		assert.Len(t, entries, 1)
+	})
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		svc := NewService(new(MockRepository))
+
+		_, _, err := svc.GetMyPoints(context.Background())
+		assert.ErrorIs(t, err, ErrUnauthenticated)
+	})
+}
+
+func TestService_Balance(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+		ctx := context.Background()
+
+		mockRepo.On("GetAccount", ctx, uint(1)).Return(&Account{UserID: 1, Balance: 1200}, nil)
+
+		balance, err := svc.Balance(ctx, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1200), balance)
+	})
+
+	t.Run("RepoError", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+		ctx := context.Background()
+
+		mockRepo.On("GetAccount", ctx, uint(1)).Return(nil, ErrDB)
+
+		_, err := svc.Balance(ctx, 1)
+		assert.ErrorIs(t, err, ErrDB)
+	})
+}
+
+func TestService_CreateRule(t *testing.T) {
+	admin := utils.SetUserContext(context.Background(), 1, "admin@example.com", "ADMIN")
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+
+		rule := &Rule{Name: " Double points ", PointsPerUnit: 2, UnitAmount: 100, IsActive: true}
+		mockRepo.On("CreateRule", admin, rule).Return(nil)
+
+		created, err := svc.CreateRule(admin, rule)
+		assert.NoError(t, err)
+		assert.Equal(t, "Double points", created.Name)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		svc := NewService(new(MockRepository))
+		ctx := utils.SetUserContext(context.Background(), 2, "user@example.com", "USER")
+
+		_, err := svc.CreateRule(ctx, &Rule{Name: "x", PointsPerUnit: 1, UnitAmount: 100})
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		svc := NewService(new(MockRepository))
+		start := time.Now()
+		end := start.Add(-time.Hour)
+
+		_, err := svc.CreateRule(admin, &Rule{Name: "x", PointsPerUnit: 0, UnitAmount: 100})
+		assert.ErrorIs(t, err, ErrInvalidRule)
+
+		_, err = svc.CreateRule(admin, &Rule{Name: "x", PointsPerUnit: 1, UnitAmount: 100, StartsAt: &start, EndsAt: &end})
+		assert.ErrorIs(t, err, ErrInvalidRule)
+	})
+}
+
+func TestService_AccrueCompletedOrders(t *testing.T) {
+	ctx := context.Background()
+	completedAt := time.Now().Add(-time.Hour)
+	promoEnd := completedAt.Add(-time.Minute)
+
+	rules := []*Rule{
+		{ID: 1, IsActive: true, PointsPerUnit: 1, UnitAmount: 100},
+		{ID: 2, IsActive: true, PointsPerUnit: 5, UnitAmount: 100, EndsAt: &promoEnd},
+		{ID: 3, IsActive: false, PointsPerUnit: 10, UnitAmount: 100},
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+
+		earning := &CompletedOrder{ID: 7, ExternalID: "ord-7", UserID: 3, EligibleAmount: 25050, CompletedAt: completedAt}
+		tiny := &CompletedOrder{ID: 8, ExternalID: "ord-8", UserID: 3, EligibleAmount: 50, CompletedAt: completedAt}
+
+		mockRepo.On("ListRules", ctx).Return(rules, nil)
+		mockRepo.On("ListUnaccruedCompletedOrders", ctx, mock.Anything, int32(0), int32(jobBatchSize)).
+			Return([]*CompletedOrder{earning, tiny}, nil)
+		mockRepo.On("Earn", ctx, earning, int64(250), completedAt.Add(pointsLifetime)).Return(true, nil)
+
+		n, err := svc.AccrueCompletedOrders(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+		mockRepo.AssertNotCalled(t, "Earn", ctx, tiny, mock.Anything, mock.Anything)
+	})
+
+	t.Run("EarnFailureContinues", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+
+		o1 := &CompletedOrder{ID: 1, ExternalID: "ord-1", UserID: 3, EligibleAmount: 1000, CompletedAt: completedAt}
+		o2 := &CompletedOrder{ID: 2, ExternalID: "ord-2", UserID: 4, EligibleAmount: 2000, CompletedAt: completedAt}
+
+		mockRepo.On("ListRules", ctx).Return(rules[:1], nil)
+		mockRepo.On("ListUnaccruedCompletedOrders", ctx, mock.Anything, int32(0), int32(jobBatchSize)).
+			Return([]*CompletedOrder{o1, o2}, nil)
+		mockRepo.On("Earn", ctx, o1, int64(10), mock.Anything).Return(false, ErrDB)
+		mockRepo.On("Earn", ctx, o2, int64(20), mock.Anything).Return(true, nil)
+
+		n, err := svc.AccrueCompletedOrders(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("ListError", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+
+		mockRepo.On("ListRules", ctx).Return(nil, errors.New("boom"))
+
+		_, err := svc.AccrueCompletedOrders(ctx)
+		assert.Error(t, err)
+	})
+}
+
+func TestService_ExpirePoints(t *testing.T) {
+	mockRepo := new(MockRepository)
+	svc := NewService(mockRepo)
+	ctx := context.Background()
+
+	mockRepo.On("ExpireLots", ctx, int32(jobBatchSize)).Return(int64(300), nil)
+
+	n, err := svc.ExpirePoints(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(300), n)
+}
@@ -0,0 +1,16 @@
+package maintenance
+
+import "warimas-be/internal/graph/model"
+
+func MapModeToGraphQL(m *Mode) *model.MaintenanceMode {
+	out := &model.MaintenanceMode{
+		Enabled:    m.Enabled,
+		AllowReads: m.AllowReads,
+		Message:    m.Message,
+		Source:     model.MaintenanceSource(m.Source),
+	}
+	if !m.UpdatedAt.IsZero() {
+		out.UpdatedAt = &m.UpdatedAt
+	}
+	return out
+}
@@ -0,0 +1,49 @@
+package maintenance
+
+import "time"
+
+// cacheTTL bounds how long a change made on one instance takes to reach
+// the others.
+const cacheTTL = 5 * time.Second
+
+// Source says where the effective mode comes from. The config override
+// wins over the database so a deployment can pin maintenance on even if
+// the database is unreachable.
+type Source string
+
+const (
+	SourceDB     Source = "DB"
+	SourceConfig Source = "CONFIG"
+)
+
+// Override values for the MAINTENANCE_MODE setting.
+const (
+	OverrideFull     = "full"
+	OverrideReadOnly = "read_only"
+)
+
+// Mode is the maintenance switch. While Enabled, mutations are rejected;
+// queries are rejected too unless AllowReads. Admins are never blocked.
+type Mode struct {
+	Enabled    bool
+	AllowReads bool
+	Message    *string
+	UpdatedBy  *int32
+	UpdatedAt  time.Time
+	Source     Source
+}
+
+// Blocks reports whether an operation is rejected under m.
+func (m *Mode) Blocks(mutation bool) bool {
+	if m == nil || !m.Enabled {
+		return false
+	}
+	return mutation || !m.AllowReads
+}
+
+// SetInput is an admin change to the switch.
+type SetInput struct {
+	Enabled    bool
+	AllowReads bool
+	Message    *string
+}
@@ -0,0 +1,70 @@
+package maintenance
+
+import (
+	"context"
+	"database/sql"
+	"warimas-be/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+type Repository interface {
+	Get(ctx context.Context) (*Mode, error)
+	Set(ctx context.Context, in SetInput, updatedBy int32) (*Mode, error)
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) Get(ctx context.Context) (*Mode, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "Get"),
+	)
+
+	m := Mode{Source: SourceDB}
+	err := r.db.QueryRowContext(ctx, `
+		SELECT enabled, allow_reads, message, updated_by, updated_at
+		FROM maintenance_mode
+		WHERE id
+	`).Scan(&m.Enabled, &m.AllowReads, &m.Message, &m.UpdatedBy, &m.UpdatedAt)
+	if err == sql.ErrNoRows {
+		// The migration seeds the row; without it the switch is off.
+		return &m, nil
+	}
+	if err != nil {
+		log.Error("failed to get maintenance mode", zap.Error(err))
+		return nil, ErrDB
+	}
+	return &m, nil
+}
+
+func (r *repository) Set(ctx context.Context, in SetInput, updatedBy int32) (*Mode, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "Set"),
+	)
+
+	m := Mode{Source: SourceDB}
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO maintenance_mode (id, enabled, allow_reads, message, updated_by)
+		VALUES (TRUE, $1, $2, $3, $4)
+		ON CONFLICT (id) DO UPDATE SET
+			enabled = EXCLUDED.enabled,
+			allow_reads = EXCLUDED.allow_reads,
+			message = EXCLUDED.message,
+			updated_by = EXCLUDED.updated_by
+		RETURNING enabled, allow_reads, message, updated_by, updated_at
+	`, in.Enabled, in.AllowReads, in.Message, updatedBy).
+		Scan(&m.Enabled, &m.AllowReads, &m.Message, &m.UpdatedBy, &m.UpdatedAt)
+	if err != nil {
+		log.Error("failed to set maintenance mode", zap.Error(err))
+		return nil, ErrDB
+	}
+	return &m, nil
+}
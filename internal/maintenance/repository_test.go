@@ -0,0 +1,65 @@
+package maintenance
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Get(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+	updated := time.Now()
+	cols := []string{"enabled", "allow_reads", "message", "updated_by", "updated_at"}
+
+	mock.ExpectQuery(`SELECT enabled, allow_reads, message, updated_by, updated_at FROM maintenance_mode`).
+		WillReturnRows(sqlmock.NewRows(cols).AddRow(true, false, "back soon", 1, updated))
+
+	m, err := repo.Get(ctx)
+	require.NoError(t, err)
+	assert.True(t, m.Enabled)
+	assert.False(t, m.AllowReads)
+	assert.Equal(t, "back soon", *m.Message)
+	assert.Equal(t, SourceDB, m.Source)
+
+	mock.ExpectQuery(`FROM maintenance_mode`).WillReturnError(sql.ErrNoRows)
+	m, err = repo.Get(ctx)
+	require.NoError(t, err)
+	assert.False(t, m.Enabled)
+
+	mock.ExpectQuery(`FROM maintenance_mode`).WillReturnError(errors.New("boom"))
+	_, err = repo.Get(ctx)
+	assert.ErrorIs(t, err, ErrDB)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_Set(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	cols := []string{"enabled", "allow_reads", "message", "updated_by", "updated_at"}
+
+	mock.ExpectQuery(`INSERT INTO maintenance_mode .* ON CONFLICT \(id\) DO UPDATE`).
+		WithArgs(true, true, nil, int32(1)).
+		WillReturnRows(sqlmock.NewRows(cols).AddRow(true, true, nil, 1, time.Now()))
+
+	m, err := repo.Set(context.Background(), SetInput{Enabled: true, AllowReads: true}, 1)
+	require.NoError(t, err)
+	assert.True(t, m.Enabled)
+	assert.Equal(t, int32(1), *m.UpdatedBy)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
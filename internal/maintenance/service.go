@@ -0,0 +1,91 @@
+package maintenance
+
+import (
+	"context"
+	"sync"
+	"time"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+// Service reads and flips the maintenance switch. Current is on the path
+// of every GraphQL operation and webhook, so it is cached for cacheTTL.
+type Service interface {
+	Current(ctx context.Context) (*Mode, error)
+	// Set is admin only.
+	Set(ctx context.Context, in SetInput) (*Mode, error)
+}
+
+type service struct {
+	repo     Repository
+	override string
+	now      func() time.Time
+
+	mu       sync.Mutex
+	cached   *Mode
+	cachedAt time.Time
+}
+
+// NewService builds the service. override is the MAINTENANCE_MODE setting:
+// OverrideFull or OverrideReadOnly pin maintenance on, anything else
+// defers to the database.
+func NewService(repo Repository, override string) Service {
+	return &service{repo: repo, override: override, now: time.Now}
+}
+
+func (s *service) Current(ctx context.Context) (*Mode, error) {
+	switch s.override {
+	case OverrideFull:
+		return &Mode{Enabled: true, Source: SourceConfig}, nil
+	case OverrideReadOnly:
+		return &Mode{Enabled: true, AllowReads: true, Source: SourceConfig}, nil
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.cached != nil && s.now().Sub(s.cachedAt) < cacheTTL {
+		return s.cached, nil
+	}
+
+	m, err := s.repo.Get(ctx)
+	if err != nil {
+		if s.cached != nil {
+			// Keep serving the last known state rather than failing every
+			// request while the database blips.
+			return s.cached, nil
+		}
+		return nil, err
+	}
+	s.cached, s.cachedAt = m, s.now()
+	return m, nil
+}
+
+func (s *service) Set(ctx context.Context, in SetInput) (*Mode, error) {
+	userID, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+	if !utils.IsAdmin(ctx) {
+		return nil, ErrForbidden
+	}
+
+	m, err := s.repo.Set(ctx, in, int32(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	logger.FromCtx(ctx).Warn("maintenance mode changed",
+		zap.Bool("enabled", m.Enabled),
+		zap.Bool("allow_reads", m.AllowReads),
+		zap.Uint("admin_id", userID),
+	)
+
+	s.mu.Lock()
+	s.cached, s.cachedAt = m, s.now()
+	s.mu.Unlock()
+
+	return s.Current(ctx)
+}
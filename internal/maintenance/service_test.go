@@ -0,0 +1,163 @@
+package maintenance
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"warimas-be/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Mocks ---
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) Get(ctx context.Context) (*Mode, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Mode), args.Error(1)
+}
+
+func (m *MockRepository) Set(ctx context.Context, in SetInput, updatedBy int32) (*Mode, error) {
+	args := m.Called(ctx, in, updatedBy)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Mode), args.Error(1)
+}
+
+// --- Tests ---
+
+func adminCtx() context.Context {
+	return utils.SetUserContext(context.Background(), 1, "admin@example.com", "ADMIN")
+}
+
+func newTestService(repo Repository, override string) (*service, *time.Time) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	s := NewService(repo, override).(*service)
+	s.now = func() time.Time { return now }
+	return s, &now
+}
+
+func TestMode_Blocks(t *testing.T) {
+	var off *Mode
+	assert.False(t, off.Blocks(true))
+
+	readOnly := &Mode{Enabled: true, AllowReads: true}
+	assert.True(t, readOnly.Blocks(true))
+	assert.False(t, readOnly.Blocks(false))
+
+	full := &Mode{Enabled: true}
+	assert.True(t, full.Blocks(false))
+}
+
+func TestService_Current(t *testing.T) {
+	t.Run("Config override wins", func(t *testing.T) {
+		repo := new(MockRepository)
+		s, _ := newTestService(repo, OverrideReadOnly)
+
+		m, err := s.Current(context.Background())
+
+		require.NoError(t, err)
+		assert.Equal(t, &Mode{Enabled: true, AllowReads: true, Source: SourceConfig}, m)
+		repo.AssertNotCalled(t, "Get", mock.Anything)
+	})
+
+	t.Run("Cached within TTL", func(t *testing.T) {
+		repo := new(MockRepository)
+		s, now := newTestService(repo, "")
+		repo.On("Get", mock.Anything).Return(&Mode{Enabled: true, Source: SourceDB}, nil).Twice()
+
+		_, err := s.Current(context.Background())
+		require.NoError(t, err)
+		_, err = s.Current(context.Background())
+		require.NoError(t, err)
+		repo.AssertNumberOfCalls(t, "Get", 1)
+
+		*now = now.Add(cacheTTL)
+		_, err = s.Current(context.Background())
+		require.NoError(t, err)
+		repo.AssertNumberOfCalls(t, "Get", 2)
+	})
+
+	t.Run("Serves last known state on error", func(t *testing.T) {
+		repo := new(MockRepository)
+		s, now := newTestService(repo, "")
+		repo.On("Get", mock.Anything).Return(&Mode{Enabled: true}, nil).Once()
+		repo.On("Get", mock.Anything).Return(nil, ErrDB).Once()
+
+		_, err := s.Current(context.Background())
+		require.NoError(t, err)
+
+		*now = now.Add(cacheTTL)
+		m, err := s.Current(context.Background())
+		require.NoError(t, err)
+		assert.True(t, m.Enabled)
+	})
+
+	t.Run("Error without cache", func(t *testing.T) {
+		repo := new(MockRepository)
+		s, _ := newTestService(repo, "")
+		repo.On("Get", mock.Anything).Return(nil, ErrDB)
+
+		_, err := s.Current(context.Background())
+
+		assert.ErrorIs(t, err, ErrDB)
+	})
+}
+
+func TestService_Set(t *testing.T) {
+	msg := "upgrading payments"
+	in := SetInput{Enabled: true, AllowReads: true, Message: &msg}
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		s, _ := newTestService(new(MockRepository), "")
+		_, err := s.Set(context.Background(), in)
+		assert.ErrorIs(t, err, ErrUnauthenticated)
+	})
+
+	t.Run("Forbidden for non-admin", func(t *testing.T) {
+		s, _ := newTestService(new(MockRepository), "")
+		ctx := utils.SetUserContext(context.Background(), 2, "user@example.com", "USER")
+
+		_, err := s.Set(ctx, in)
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+
+	t.Run("Admin change is visible immediately", func(t *testing.T) {
+		repo := new(MockRepository)
+		s, _ := newTestService(repo, "")
+		repo.On("Get", mock.Anything).Return(&Mode{Source: SourceDB}, nil).Once()
+		repo.On("Set", mock.Anything, in, int32(1)).
+			Return(&Mode{Enabled: true, AllowReads: true, Message: &msg, Source: SourceDB}, nil)
+
+		_, err := s.Current(context.Background())
+		require.NoError(t, err)
+
+		m, err := s.Set(adminCtx(), in)
+		require.NoError(t, err)
+		assert.True(t, m.Enabled)
+
+		m, err = s.Current(context.Background())
+		require.NoError(t, err)
+		assert.True(t, m.Enabled)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Repository error", func(t *testing.T) {
+		repo := new(MockRepository)
+		s, _ := newTestService(repo, "")
+		repo.On("Set", mock.Anything, in, int32(1)).Return(nil, errors.New("boom"))
+
+		_, err := s.Set(adminCtx(), in)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"warimas-be/internal/logger"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+// APIKeyHeader carries the key of an internal service calling the API.
+const APIKeyHeader = "X-API-Key"
+
+// APIKeyAuthenticator resolves an API key to the service it belongs to.
+type APIKeyAuthenticator interface {
+	Authenticate(ctx context.Context, raw string) (*utils.ServicePrincipal, error)
+}
+
+// APIKeyMiddleware authenticates X-API-Key into a service principal in the
+// request context. Requests without the header pass through untouched; a
+// bad key is rejected rather than downgraded to anonymous, so a
+// misconfigured service fails loudly.
+func APIKeyMiddleware(keys APIKeyAuthenticator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			raw := r.Header.Get(APIKeyHeader)
+			if raw == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			p, err := keys.Authenticate(r.Context(), raw)
+			if err != nil {
+				logger.FromCtx(r.Context()).Warn("auth failed: invalid api key", zap.Error(err))
+				utils.WriteJSONError(w, "invalid api key", http.StatusUnauthorized)
+				return
+			}
+
+			ctx := utils.WithServicePrincipal(r.Context(), p)
+			ctx = utils.WithPrincipal(ctx, &utils.Principal{IsInternal: true})
+			setRequestService(ctx, p.Name)
+
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
@@ -23,6 +23,16 @@ const (
 	TokenClaimsKey contextKey = "jwtClaims"
 )
 
+// principalFor returns a copy of the request principal to extend, or a
+// new one.
+func principalFor(ctx context.Context) *utils.Principal {
+	if p, ok := utils.GetPrincipalFromContext(ctx); ok {
+		cp := *p
+		return &cp
+	}
+	return &utils.Principal{}
+}
+
 // JWT secret
 
 func AuthMiddleware(next http.Handler) http.Handler {
@@ -59,22 +69,41 @@ func AuthMiddleware(next http.Handler) http.Handler {
 			return
 		}
 
-		// 4️⃣ Inject user data into context
+		// 4️⃣ Inject user data into context, keeping what APIKeyMiddleware
+		// already established
 		ctx := r.Context()
-		ctx = context.WithValue(ctx, utils.UserIDKey, claims.UserID)
-
+		p := principalFor(ctx)
+		p.ID = claims.UserID
+		p.Email = claims.Email
+		p.Roles = []string{claims.Role}
 		if claims.SellerID != nil {
-			ctx = context.WithValue(ctx, utils.SellerIDKey, *claims.SellerID)
+			p.SellerID = *claims.SellerID
 		}
-		ctx = context.WithValue(ctx, utils.UserEmailKey, claims.Email)
-		ctx = context.WithValue(ctx, utils.UserRoleKey, claims.Role)
+		ctx = utils.WithPrincipal(ctx, p)
 		ctx = context.WithValue(ctx, TokenClaimsKey, claims)
+		setRequestUser(ctx, claims.UserID)
 
 		// 5️⃣ Continue request
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
 
+// RequireAdmin rejects requests without an admin user, before any GraphQL
+// parsing. Place it inside AuthMiddleware.
+func RequireAdmin(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := utils.GetUserIDFromContext(r.Context()); !ok {
+			utils.WriteJSONError(w, "authentication required", http.StatusUnauthorized)
+			return
+		}
+		if !utils.IsAdmin(r.Context()) {
+			utils.WriteJSONError(w, "admin only", http.StatusForbidden)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
 // 🔐 Token extractor (cookie → header)
 func extractAccessToken(r *http.Request) string {
 	// Cookie (preferred)
@@ -0,0 +1,23 @@
+package middleware
+
+import (
+	"net/http"
+
+	"warimas-be/internal/geo"
+	"warimas-be/internal/transport"
+)
+
+// GeoMiddleware lets resolvers ask where the visitor is with
+// geo.FromContext. The lookup runs on first use, so only requests that
+// ask pay for a GeoIP call.
+func GeoMiddleware(detector *geo.Detector) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ctx := r.Context()
+			ctx = geo.WithClientLocation(ctx, func() geo.ClientLocation {
+				return detector.Detect(ctx, r, transport.ClientIP(r))
+			})
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
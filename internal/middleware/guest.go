@@ -0,0 +1,62 @@
+package middleware
+
+import (
+	"net/http"
+
+	"warimas-be/internal/guest"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// GuestTokenCookie and GuestTokenHeader carry the signed guest token;
+	// the cookie wins when both are sent.
+	GuestTokenCookie = "guest_token"
+	GuestTokenHeader = "X-Guest-Token"
+
+	guestTokenMaxAge = 60 * 60 * 24 * 30 // 30 days
+)
+
+// GuestMiddleware puts the caller's guest ID on the request principal. A
+// missing or forged token gets a fresh guest ID, returned in both the
+// cookie and the response header so browsers and apps can keep it. Service
+// calls carry no guest. Place it outside AuthMiddleware.
+func GuestMiddleware(signer *guest.Signer) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if utils.IsInternal(r.Context()) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			token := r.Header.Get(GuestTokenHeader)
+			if cookie, err := r.Cookie(GuestTokenCookie); err == nil && cookie.Value != "" {
+				token = cookie.Value
+			}
+
+			id, err := signer.Verify(token)
+			if err != nil {
+				if token != "" {
+					logger.FromCtx(r.Context()).Warn("guest token rejected, issuing a new one", zap.Error(err))
+				}
+				id, token = signer.Issue()
+				http.SetCookie(w, &http.Cookie{
+					Name:     GuestTokenCookie,
+					Value:    token,
+					Path:     "/",
+					HttpOnly: true,
+					Secure:   true,
+					SameSite: http.SameSiteNoneMode,
+					MaxAge:   guestTokenMaxAge,
+				})
+				w.Header().Set(GuestTokenHeader, token)
+			}
+
+			p := principalFor(r.Context())
+			p.GuestID = id.String()
+			next.ServeHTTP(w, r.WithContext(utils.WithPrincipal(r.Context(), p)))
+		})
+	}
+}
@@ -87,8 +87,10 @@ func RateLimitMiddleware(next http.Handler) http.Handler {
 		// 2. Determine Identity Key
 		var identity string
 
-		// Prefer User ID if authenticated
-		if userID, ok := utils.GetUserIDFromContext(r.Context()); ok {
+		// Prefer the API key or User ID if authenticated
+		if p, ok := utils.GetServicePrincipalFromContext(r.Context()); ok {
+			identity = fmt.Sprintf("service:%d", p.KeyID)
+		} else if userID, ok := utils.GetUserIDFromContext(r.Context()); ok {
 			identity = fmt.Sprintf("user:%d", userID)
 		} else if deviceID := r.Header.Get("X-Device-ID"); deviceID != "" {
 			// Use Device ID if provided by the client
@@ -118,15 +120,18 @@ func RateLimitMiddleware(next http.Handler) http.Handler {
 
 // resolveRateTier determines which rate limit policy applies to the request.
 func resolveRateTier(r *http.Request) (rate.Limit, int, string) {
-	// 1. Internal / Trusted Services (Check for a secret header)
+	// 1. Internal / Trusted Services (API key or a secret header)
+	if _, ok := utils.GetServicePrincipalFromContext(r.Context()); ok {
+		return limitInternal, burstInternal, "internal"
+	}
 	internalKey := os.Getenv("INTERNAL_SECRET_KEY")
 	if internalKey != "" && r.Header.Get("X-Service-Auth") == internalKey {
 		return limitInternal, burstInternal, "internal"
 	}
 
 	// 2. Auth / Payment (Strict)
-	// Apply to payment webhooks OR if the client explicitly signals an auth action
-	if r.URL.Path == "/webhook/payment" || r.Header.Get("X-Action") == "strict" {
+	// Apply to payment/courier webhooks OR if the client explicitly signals an auth action
+	if r.URL.Path == "/webhook/payment" || r.URL.Path == "/webhook/courier" || r.Header.Get("X-Action") == "strict" {
 		return limitStrict, burstStrict, "strict"
 	}
 
@@ -1,8 +1,13 @@
 package middleware
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"io"
 	"net/http"
+	"regexp"
+	"strings"
 	"time"
 
 	"warimas-be/internal/logger"
@@ -11,7 +16,42 @@ import (
 	"go.uber.org/zap"
 )
 
-const loggerKey contextKey = "requestLogger"
+const (
+	loggerKey      contextKey = "requestLogger"
+	requestInfoKey contextKey = "requestInfo"
+)
+
+// SlowRequestThreshold is the duration above which a completed request is
+// logged as a warning instead of info. Zero disables the warning.
+var SlowRequestThreshold = time.Second
+
+// maxLoggedBody caps how much of a GraphQL request body is buffered for
+// operation extraction; larger bodies are passed through unparsed.
+const maxLoggedBody = 1 << 20
+
+// maxLoggedVariables caps the encoded size of the variables written to the
+// log; larger ones are logged by size only.
+const maxLoggedVariables = 2 << 10
+
+// sensitiveVariable matches variable names whose values never reach the
+// logs, at any depth.
+var sensitiveVariable = regexp.MustCompile(`(?i)password|token|secret|^otp|^pin$|cvv|card_?number`)
+
+var operationPattern = regexp.MustCompile(`^\s*(query|mutation|subscription)\b\s*([_A-Za-z][_0-9A-Za-z]*)?`)
+
+// requestInfo collects what inner middleware learns about a request, such
+// as the authenticated user or service, for the completion log line.
+type requestInfo struct {
+	userID  uint
+	service string
+}
+
+// graphQLRequest is the subset of a GraphQL POST body that is logged.
+type graphQLRequest struct {
+	OperationName string          `json:"operationName"`
+	Query         string          `json:"query"`
+	Variables     json.RawMessage `json:"variables"`
+}
 
 // L extracts logger from context
 func L(ctx context.Context) *zap.Logger {
@@ -21,6 +61,20 @@ func L(ctx context.Context) *zap.Logger {
 	return logger.L()
 }
 
+// setRequestUser records the authenticated user for the request log.
+func setRequestUser(ctx context.Context, userID uint) {
+	if info, ok := ctx.Value(requestInfoKey).(*requestInfo); ok {
+		info.userID = userID
+	}
+}
+
+// setRequestService records the API key caller for the request log.
+func setRequestService(ctx context.Context, name string) {
+	if info, ok := ctx.Value(requestInfoKey).(*requestInfo); ok {
+		info.service = name
+	}
+}
+
 func LoggingMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 
@@ -31,25 +85,152 @@ func LoggingMiddleware(next http.Handler) http.Handler {
 			reqID = uuid.NewString()
 		}
 
-		// Create logger bound to this request
-		reqLogger := logger.L().With(
+		fields := []zap.Field{
 			zap.String("request_id", reqID),
 			zap.String("method", r.Method),
 			zap.String("path", r.URL.Path),
-		)
+		}
+		if r.Method == http.MethodPost {
+			fields = append(fields, graphQLFields(r)...)
+		}
+
+		// Create logger bound to this request
+		reqLogger := logger.L().With(fields...)
 
 		// Put logger into request context
+		info := &requestInfo{}
 		ctx := context.WithValue(r.Context(), loggerKey, reqLogger)
+		ctx = context.WithValue(ctx, requestInfoKey, info)
 		ctx = logger.WithRequestID(ctx, reqID)
 		r = r.WithContext(ctx)
 
 		// Continue
-		next.ServeHTTP(w, r)
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
 
 		// Log request summary
-		reqLogger.Info("request completed",
-			zap.Duration("duration", time.Since(start)),
+		duration := time.Since(start)
+		summary := []zap.Field{
+			zap.Int("status", rec.status),
+			zap.Duration("duration", duration),
 			zap.String("ip", r.RemoteAddr),
-		)
+		}
+		if info.userID != 0 {
+			summary = append(summary, zap.Uint("user_id", info.userID))
+		}
+		if info.service != "" {
+			summary = append(summary, zap.String("service", info.service))
+		}
+
+		if SlowRequestThreshold > 0 && duration > SlowRequestThreshold {
+			reqLogger.Warn("slow request", append(summary, zap.Duration("threshold", SlowRequestThreshold))...)
+			return
+		}
+		reqLogger.Info("request completed", summary...)
 	})
 }
+
+// graphQLFields reads the operation name, type and variables from a GraphQL
+// POST body and restores the body for the next handler.
+func graphQLFields(r *http.Request) []zap.Field {
+	if r.Body == nil || !strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+		return nil
+	}
+
+	buf, err := io.ReadAll(io.LimitReader(r.Body, maxLoggedBody+1))
+	r.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(buf), r.Body), r.Body}
+	if err != nil || len(buf) > maxLoggedBody {
+		return nil
+	}
+
+	var req graphQLRequest
+	if err := json.Unmarshal(buf, &req); err != nil {
+		return nil
+	}
+
+	opType, opName := parseOperation(req.Query)
+	if req.OperationName != "" {
+		opName = req.OperationName
+	}
+
+	fields := []zap.Field{
+		zap.String("operation_type", opType),
+		zap.String("operation", opName),
+		zap.Int("variables_size", len(req.Variables)),
+	}
+	if vars := redactVariables(req.Variables); vars != nil {
+		fields = append(fields, zap.Any("variables", vars))
+	}
+	return fields
+}
+
+// parseOperation returns the type and name of the first operation in query.
+// Shorthand queries ("{ ... }") are anonymous queries.
+func parseOperation(query string) (opType, name string) {
+	if m := operationPattern.FindStringSubmatch(query); m != nil {
+		return m[1], m[2]
+	}
+	if strings.HasPrefix(strings.TrimSpace(query), "{") {
+		return "query", ""
+	}
+	return "", ""
+}
+
+// redactVariables decodes raw GraphQL variables and masks sensitive values.
+// It returns nil when there is nothing to log or the variables are too
+// large to log in full.
+func redactVariables(raw json.RawMessage) any {
+	if len(raw) == 0 || len(raw) > maxLoggedVariables {
+		return nil
+	}
+
+	var v any
+	if err := json.Unmarshal(raw, &v); err != nil || v == nil {
+		return nil
+	}
+	return redact(v)
+}
+
+func redact(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, val := range v {
+			if sensitiveVariable.MatchString(k) {
+				v[k] = "[REDACTED]"
+				continue
+			}
+			v[k] = redact(val)
+		}
+	case []any:
+		for i, val := range v {
+			v[i] = redact(val)
+		}
+	}
+	return v
+}
+
+// statusRecorder captures the status code written by the next handler.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (s *statusRecorder) WriteHeader(code int) {
+	s.status = code
+	s.ResponseWriter.WriteHeader(code)
+}
+
+// Flush keeps streaming transports working behind the recorder.
+func (s *statusRecorder) Flush() {
+	if f, ok := s.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap lets http.ResponseController reach the underlying writer.
+func (s *statusRecorder) Unwrap() http.ResponseWriter {
+	return s.ResponseWriter
+}
@@ -1,16 +1,25 @@
 package middleware
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 	"time"
+	"warimas-be/internal/geo"
+	"warimas-be/internal/guest"
 	"warimas-be/internal/logger"
 	"warimas-be/internal/utils"
 
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestRequestID(t *testing.T) {
@@ -163,6 +172,36 @@ func TestAuth(t *testing.T) {
 		assert.Equal(t, http.StatusUnauthorized, w.Code)
 	})
 
+	t.Run("Seller Token", func(t *testing.T) {
+		os.Setenv("JWT_SECRET", "test-secret")
+		defer os.Unsetenv("JWT_SECRET")
+
+		token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"user_id":   float64(1),
+			"role":      "ADMIN",
+			"seller_id": "seller-1",
+			"exp":       time.Now().Add(time.Hour).Unix(),
+		})
+		tokenString, err := token.SignedString([]byte("test-secret"))
+		assert.NoError(t, err)
+
+		req := httptest.NewRequest("GET", "/protected", nil)
+		req.Header.Set("Authorization", "Bearer "+tokenString)
+		w := httptest.NewRecorder()
+
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			sellerID, ok := utils.GetSellerIDFromContext(r.Context())
+			assert.True(t, ok)
+			assert.Equal(t, "seller-1", sellerID)
+			assert.True(t, utils.IsAdmin(r.Context()))
+			w.WriteHeader(http.StatusOK)
+		})
+
+		AuthMiddleware(next).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
 	t.Run("Malformed Header", func(t *testing.T) {
 		req := httptest.NewRequest("GET", "/protected", nil)
 		req.Header.Set("Authorization", "Basic user:pass") // Wrong scheme
@@ -180,3 +219,266 @@ func TestAuth(t *testing.T) {
 		assert.Equal(t, http.StatusOK, w.Code)
 	})
 }
+
+func TestGuestMiddleware(t *testing.T) {
+	signer := guest.NewSigner("guest-secret")
+
+	var gotGuest string
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotGuest, _ = utils.GetGuestIDFromContext(r.Context())
+		w.WriteHeader(http.StatusOK)
+	})
+
+	t.Run("First visit gets a token", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/query", nil)
+		w := httptest.NewRecorder()
+
+		GuestMiddleware(signer)(next).ServeHTTP(w, req)
+
+		token := w.Header().Get(GuestTokenHeader)
+		require.NotEmpty(t, token)
+		id, err := signer.Verify(token)
+		require.NoError(t, err)
+		assert.Equal(t, id.String(), gotGuest)
+		assert.Contains(t, w.Header().Get("Set-Cookie"), GuestTokenCookie+"="+token)
+	})
+
+	t.Run("Valid token keeps its guest", func(t *testing.T) {
+		id, token := signer.Issue()
+		req := httptest.NewRequest("POST", "/query", nil)
+		req.AddCookie(&http.Cookie{Name: GuestTokenCookie, Value: token})
+		w := httptest.NewRecorder()
+
+		GuestMiddleware(signer)(next).ServeHTTP(w, req)
+
+		assert.Equal(t, id.String(), gotGuest)
+		assert.Empty(t, w.Header().Get(GuestTokenHeader))
+	})
+
+	t.Run("Forged token is replaced", func(t *testing.T) {
+		forged := uuid.NewString() + ".c2lnbmF0dXJl"
+		req := httptest.NewRequest("POST", "/query", nil)
+		req.Header.Set(GuestTokenHeader, forged)
+		w := httptest.NewRecorder()
+
+		GuestMiddleware(signer)(next).ServeHTTP(w, req)
+
+		assert.NotEqual(t, strings.SplitN(forged, ".", 2)[0], gotGuest)
+		assert.NotEmpty(t, w.Header().Get(GuestTokenHeader))
+	})
+
+	t.Run("Service calls carry no guest", func(t *testing.T) {
+		gotGuest = ""
+		req := httptest.NewRequest("POST", "/query", nil)
+		req = req.WithContext(utils.WithPrincipal(req.Context(), &utils.Principal{IsInternal: true}))
+		w := httptest.NewRecorder()
+
+		GuestMiddleware(signer)(next).ServeHTTP(w, req)
+
+		assert.Empty(t, gotGuest)
+		assert.Empty(t, w.Header().Get(GuestTokenHeader))
+	})
+}
+
+func TestGeoMiddleware(t *testing.T) {
+	var got geo.ClientLocation
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = geo.FromContext(r.Context())
+	})
+	req := httptest.NewRequest("POST", "/query", nil)
+	req.Header.Set("CF-IPCountry", "ID")
+	req.Header.Set("X-Region", "ID-JT")
+
+	GeoMiddleware(&geo.Detector{CountryHeader: "CF-IPCountry", RegionHeader: "X-Region"})(next).
+		ServeHTTP(httptest.NewRecorder(), req)
+
+	assert.Equal(t, geo.ClientLocation{CountryCode: "ID", Region: "Jawa Tengah", Source: geo.SourceCDN}, got)
+}
+
+func TestRequireAdmin(t *testing.T) {
+	ok := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	tests := []struct {
+		name string
+		role string
+		want int
+	}{
+		{"Anonymous", "", http.StatusUnauthorized},
+		{"User", "USER", http.StatusForbidden},
+		{"Admin", "ADMIN", http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/admin/query", nil)
+			if tt.role != "" {
+				req = req.WithContext(utils.SetUserContext(req.Context(), 1, "a@example.com", tt.role))
+			}
+			w := httptest.NewRecorder()
+
+			RequireAdmin(ok).ServeHTTP(w, req)
+
+			assert.Equal(t, tt.want, w.Code)
+		})
+	}
+}
+
+type stubAPIKeys map[string]*utils.ServicePrincipal
+
+func (s stubAPIKeys) Authenticate(_ context.Context, raw string) (*utils.ServicePrincipal, error) {
+	if p, ok := s[raw]; ok {
+		return p, nil
+	}
+	return nil, errors.New("invalid api key")
+}
+
+func TestAPIKeyMiddleware(t *testing.T) {
+	worker := &utils.ServicePrincipal{KeyID: 1, Name: "checkout-worker", Scopes: []string{"ORDERS_CREATE_FROM_SESSION"}}
+	mw := APIKeyMiddleware(stubAPIKeys{"wmk_good": worker})
+
+	t.Run("No Header", func(t *testing.T) {
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, ok := utils.GetServicePrincipalFromContext(r.Context())
+			assert.False(t, ok)
+			w.WriteHeader(http.StatusOK)
+		})
+
+		w := httptest.NewRecorder()
+		mw(next).ServeHTTP(w, httptest.NewRequest("POST", "/query", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("Valid Key", func(t *testing.T) {
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			p, ok := utils.GetServicePrincipalFromContext(r.Context())
+			assert.True(t, ok)
+			assert.Equal(t, worker, p)
+			assert.True(t, utils.IsInternal(r.Context()))
+			w.WriteHeader(http.StatusOK)
+		})
+
+		req := httptest.NewRequest("POST", "/query", nil)
+		req.Header.Set(APIKeyHeader, "wmk_good")
+		w := httptest.NewRecorder()
+		mw(next).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("Invalid Key", func(t *testing.T) {
+		req := httptest.NewRequest("POST", "/query", nil)
+		req.Header.Set(APIKeyHeader, "wmk_bad")
+		w := httptest.NewRecorder()
+		mw(http.NotFoundHandler()).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+	})
+}
+
+func TestLoggingMiddleware_GraphQL(t *testing.T) {
+	body := `{"operationName":"Login","query":"mutation Login($input: LoginInput!) { login(input: $input) { token } }","variables":{"input":{"email":"a@b.c","password":"hunter2"}}}`
+
+	t.Run("Body stays readable downstream", func(t *testing.T) {
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.Equal(t, body, string(got))
+			w.WriteHeader(http.StatusTeapot)
+		})
+
+		req := httptest.NewRequest("POST", "/query", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+
+		LoggingMiddleware(next).ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusTeapot, w.Code)
+	})
+
+	t.Run("Records authenticated user", func(t *testing.T) {
+		var info *requestInfo
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			setRequestUser(r.Context(), 7)
+			info = r.Context().Value(requestInfoKey).(*requestInfo)
+		})
+
+		LoggingMiddleware(next).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/test", nil))
+
+		assert.Equal(t, uint(7), info.userID)
+	})
+}
+
+func TestParseOperation(t *testing.T) {
+	tests := []struct {
+		query, opType, name string
+	}{
+		{"mutation Login($input: LoginInput!) { login }", "mutation", "Login"},
+		{"  query   { me { id } }", "query", ""},
+		{"{ me { id } }", "query", ""},
+		{"subscription OnOrder { order }", "subscription", "OnOrder"},
+		{"fragment F on User { id }", "", ""},
+	}
+	for _, tt := range tests {
+		opType, name := parseOperation(tt.query)
+		assert.Equal(t, tt.opType, opType, tt.query)
+		assert.Equal(t, tt.name, name, tt.query)
+	}
+}
+
+func TestRedactVariables(t *testing.T) {
+	got := redactVariables(json.RawMessage(`{
+		"input": {"email": "a@b.c", "password": "x", "newPassword": "y"},
+		"items": [{"refreshToken": "t", "quantity": 2}]
+	}`))
+
+	assert.Equal(t, map[string]any{
+		"input": map[string]any{"email": "a@b.c", "password": "[REDACTED]", "newPassword": "[REDACTED]"},
+		"items": []any{map[string]any{"refreshToken": "[REDACTED]", "quantity": float64(2)}},
+	}, got)
+
+	assert.Nil(t, redactVariables(nil))
+	assert.Nil(t, redactVariables(json.RawMessage(`null`)))
+	assert.Nil(t, redactVariables(json.RawMessage(`{"blob":"`+strings.Repeat("a", maxLoggedVariables)+`"}`)))
+}
+
+func TestRecovery(t *testing.T) {
+	t.Run("Panic becomes 500 with reference", func(t *testing.T) {
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var m map[string]int
+			m["boom"]++ // nil map write panics
+		})
+
+		w := httptest.NewRecorder()
+		Recovery(next).ServeHTTP(w, httptest.NewRequest("POST", "/query", nil))
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+		var body map[string]string
+		assert.NoError(t, json.NewDecoder(w.Body).Decode(&body))
+		assert.Equal(t, "internal server error", body["error"])
+		assert.NotEmpty(t, body["reference_id"])
+	})
+
+	t.Run("ErrAbortHandler is re-raised", func(t *testing.T) {
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			panic(http.ErrAbortHandler)
+		})
+
+		assert.PanicsWithValue(t, http.ErrAbortHandler, func() {
+			Recovery(next).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil))
+		})
+	})
+
+	t.Run("No panic passes through", func(t *testing.T) {
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusAccepted)
+		})
+
+		w := httptest.NewRecorder()
+		Recovery(next).ServeHTTP(w, httptest.NewRequest("GET", "/", nil))
+
+		assert.Equal(t, http.StatusAccepted, w.Code)
+	})
+}
@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"warimas-be/internal/errreport"
+)
+
+// Recovery turns a panic in next into a 500 response carrying a reference
+// ID, so one bad request cannot take the process down. Place it inside
+// LoggingMiddleware so the report carries the request ID.
+func Recovery(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer func() {
+			p := recover()
+			if p == nil {
+				return
+			}
+			// The server uses ErrAbortHandler to abort a response on
+			// purpose; it is not a bug.
+			if p == http.ErrAbortHandler {
+				panic(p)
+			}
+
+			ref := errreport.Panic(r.Context(), p)
+
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusInternalServerError)
+			json.NewEncoder(w).Encode(map[string]string{
+				"error":        "internal server error",
+				"reference_id": ref,
+			})
+		}()
+
+		next.ServeHTTP(w, r)
+	})
+}
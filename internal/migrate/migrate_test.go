@@ -1,4 +1,4 @@
-package main
+package migrate
 
 import (
 	"database/sql"
@@ -286,7 +286,7 @@ func TestRun(t *testing.T) {
 		WithArgs(fileName).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
-	err = run(db, "up", tmpDir)
+	err = Run(db, "up", tmpDir)
 	assert.NoError(t, err)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
@@ -299,14 +299,14 @@ func TestRun_Errors(t *testing.T) {
 	t.Run("TableCreationFail", func(t *testing.T) {
 		mock.ExpectExec("CREATE TABLE IF NOT EXISTS schema_migrations").
 			WillReturnError(errors.New("create table error"))
-		err := run(db, "up", ".")
+		err := Run(db, "up", ".")
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "failed to ensure schema_migrations table")
 	})
 
 	t.Run("UnknownMode", func(t *testing.T) {
 		mock.ExpectExec("CREATE TABLE IF NOT EXISTS schema_migrations").WillReturnResult(sqlmock.NewResult(0, 0))
-		err := run(db, "invalid", ".")
+		err := Run(db, "invalid", ".")
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "unknown mode")
 	})
@@ -314,7 +314,7 @@ func TestRun_Errors(t *testing.T) {
 	t.Run("GlobError", func(t *testing.T) {
 		mock.ExpectExec("CREATE TABLE IF NOT EXISTS schema_migrations").WillReturnResult(sqlmock.NewResult(0, 0))
 		// "[" is a syntax error in glob patterns if not closed
-		err := run(db, "up", "[")
+		err := Run(db, "up", "[")
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "failed to read migrations")
 	})
@@ -0,0 +1,23 @@
+package moderation
+
+import (
+	"errors"
+	"warimas-be/internal/apperr"
+)
+
+var (
+	ErrUnauthenticated    = apperr.Unauthenticated("unauthenticated")
+	ErrForbidden          = apperr.Forbidden("forbidden")
+	ErrInvalidContentType = apperr.Invalid("content type cannot be moderated")
+	ErrContentNotFound    = apperr.NotFound("content not found")
+	ErrOwnContent         = apperr.Invalid("you cannot report your own content")
+	ErrInvalidReason      = apperr.Invalid("report reason must be at most 500 characters")
+	ErrReasonRequired     = apperr.Invalid("a reason is required to report a product")
+	ErrItemNotFound       = apperr.NotFound("moderation item not found")
+	ErrAlreadyResolved    = apperr.Conflict("moderation item is already resolved")
+	ErrNoAuthor           = apperr.Invalid("content has no author to ban")
+	ErrInvalidKeyword     = apperr.Invalid("keyword must have 2 to 50 letters")
+	ErrKeywordExists      = apperr.Conflict("keyword already exists")
+	ErrKeywordNotFound    = apperr.NotFound("keyword not found")
+	ErrDB                 = errors.New("database error")
+)
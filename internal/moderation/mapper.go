@@ -0,0 +1,55 @@
+package moderation
+
+import (
+	"strconv"
+	"warimas-be/internal/graph/model"
+)
+
+func idPtr(id *int32) *string {
+	if id == nil {
+		return nil
+	}
+	s := strconv.Itoa(int(*id))
+	return &s
+}
+
+func MapItemToGraphQL(it *Item) *model.ModerationItem {
+	keywords := it.MatchedKeywords
+	if keywords == nil {
+		keywords = []string{}
+	}
+	return &model.ModerationItem{
+		ID:              strconv.FormatInt(it.ID, 10),
+		ContentType:     model.ModerationContentType(it.ContentType),
+		ContentID:       it.ContentID,
+		AuthorID:        idPtr(it.AuthorID),
+		Body:            it.Body,
+		MatchedKeywords: keywords,
+		ReportCount:     it.ReportCount,
+		HiddenAt:        it.HiddenAt,
+		Status:          model.ModerationStatus(it.Status),
+		ResolvedBy:      idPtr(it.ResolvedBy),
+		ResolvedAt:      it.ResolvedAt,
+		CreatedAt:       it.CreatedAt,
+		UpdatedAt:       it.UpdatedAt,
+	}
+}
+
+func MapAuditEntryToGraphQL(e *AuditEntry) *model.ModerationAuditEntry {
+	return &model.ModerationAuditEntry{
+		ID:           strconv.FormatInt(e.ID, 10),
+		ItemID:       strconv.FormatInt(e.ItemID, 10),
+		Action:       model.ModerationAction(e.Action),
+		ActorID:      idPtr(e.ActorID),
+		TargetUserID: idPtr(e.TargetUserID),
+		Note:         e.Note,
+		CreatedAt:    e.CreatedAt,
+	}
+}
+
+func MapKeywordToGraphQL(k *Keyword) *model.ModerationKeyword {
+	return &model.ModerationKeyword{
+		Term:      k.Term,
+		CreatedAt: k.CreatedAt,
+	}
+}
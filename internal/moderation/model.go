@@ -0,0 +1,156 @@
+package moderation
+
+import (
+	"context"
+	"strings"
+	"time"
+	"unicode"
+)
+
+const (
+	defaultLimit = 50
+	maxLimit     = 500
+
+	maxReasonLen  = 500
+	minKeywordLen = 2
+	maxKeywordLen = 50
+)
+
+// ContentType is a kind of user-generated content the queue covers.
+type ContentType string
+
+const (
+	ContentStoreName        ContentType = "STORE_NAME"
+	ContentStoreDescription ContentType = "STORE_DESCRIPTION"
+	ContentStoreLogo        ContentType = "STORE_LOGO"
+	ContentDisplayName      ContentType = "DISPLAY_NAME"
+	ContentAvatar           ContentType = "AVATAR"
+	ContentProduct          ContentType = "PRODUCT"
+)
+
+type Status string
+
+const (
+	StatusPending  Status = "PENDING"
+	StatusApproved Status = "APPROVED"
+	StatusRemoved  Status = "REMOVED"
+)
+
+// Action is a moderator decision recorded in the audit log.
+type Action string
+
+const (
+	ActionApprove Action = "APPROVE"
+	ActionRemove  Action = "REMOVE"
+	// ActionBan removes the content and bans its author.
+	ActionBan Action = "BAN"
+)
+
+// Content is one piece of user-generated content as it is now. ID is the
+// seller ID for store content, the product ID for a product and the user
+// ID for profile content. Body is the text, or the image URL of a logo or
+// avatar; a product's is its name and description.
+type Content struct {
+	Type     ContentType
+	ID       string
+	AuthorID *int32
+	Body     string
+}
+
+// Source reads and takes down the content of the types it is registered
+// for. ModerationContent returns nil when the content is unset or gone.
+type Source interface {
+	ModerationContent(ctx context.Context, t ContentType, id string) (*Content, error)
+	RemoveModerationContent(ctx context.Context, t ContentType, id string) error
+}
+
+// Hider is implemented by sources whose content can be hidden from
+// shoppers while reports on it are reviewed.
+type Hider interface {
+	HideModerationContent(ctx context.Context, t ContentType, id string) error
+	UnhideModerationContent(ctx context.Context, t ContentType, id string) error
+}
+
+// Banner bans a user from logging in. Tokens already issued stay valid
+// until they expire.
+type Banner interface {
+	BanUser(ctx context.Context, userID uint, reason string) error
+}
+
+// Item is content held for a moderator. Body is the content when it was
+// last flagged or reported; MatchedKeywords lists the keyword rules it
+// hit, and ReportCount how many users reported it. HiddenAt is when
+// reports hid the content pending review.
+type Item struct {
+	ID              int64
+	ContentType     ContentType
+	ContentID       string
+	AuthorID        *int32
+	Body            string
+	MatchedKeywords []string
+	ReportCount     int32
+	HiddenAt        *time.Time
+	Status          Status
+	ResolvedBy      *int32
+	ResolvedAt      *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
+}
+
+// AuditEntry records one moderator decision on an item.
+type AuditEntry struct {
+	ID           int64
+	ItemID       int64
+	Action       Action
+	ActorID      *int32
+	TargetUserID *int32
+	Note         *string
+	CreatedAt    time.Time
+}
+
+// Keyword is a rule that flags content containing it, stored normalized
+// by foldText.
+type Keyword struct {
+	Term      string
+	CreatedBy *int32
+	CreatedAt time.Time
+}
+
+// leet maps the digits and symbols commonly swapped for letters to dodge
+// a keyword rule.
+var leet = map[rune]rune{
+	'0': 'o', '1': 'i', '3': 'e', '4': 'a', '5': 's', '7': 't', '@': 'a', '$': 's',
+}
+
+// foldText lowercases s, undoes leetspeak and drops everything but
+// letters, so "J.u-d1" and "judi" compare equal.
+func foldText(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if m, ok := leet[r]; ok {
+			r = m
+		}
+		if unicode.IsLetter(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// matchKeywords returns the keywords body contains.
+func matchKeywords(body string, keywords []*Keyword) []string {
+	folded := foldText(body)
+	var matched []string
+	for _, k := range keywords {
+		if k.Term != "" && strings.Contains(folded, k.Term) {
+			matched = append(matched, k.Term)
+		}
+	}
+	return matched
+}
+
+// isText reports whether keyword rules apply to content of type t; logos
+// and avatars are images and only reach the queue by report.
+func (t ContentType) isText() bool {
+	return t == ContentStoreName || t == ContentStoreDescription || t == ContentDisplayName
+}
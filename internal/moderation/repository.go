@@ -0,0 +1,342 @@
+package moderation
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"warimas-be/internal/logger"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+const pgUniqueViolation = "23505"
+
+type Repository interface {
+	ListKeywords(ctx context.Context) ([]*Keyword, error)
+	AddKeyword(ctx context.Context, k *Keyword) error
+	RemoveKeyword(ctx context.Context, term string) error
+
+	// Flag queues c for review with the keywords it matched, or refreshes
+	// the pending item already held for it.
+	Flag(ctx context.Context, c *Content, keywords []string) (*Item, error)
+	// Report queues c for review like Flag and counts reporterID's report
+	// on it; a user's repeat reports on a pending item count once.
+	Report(ctx context.Context, c *Content, reporterID uint, reason *string) (*Item, error)
+	// MarkHidden records that reports hid the item's content.
+	MarkHidden(ctx context.Context, id int64) error
+	GetItem(ctx context.Context, id int64) (*Item, error)
+	ListItems(ctx context.Context, status *Status, limit int32) ([]*Item, error)
+	// Resolve closes a pending item with status and records the decision
+	// in the audit log. It fails with ErrAlreadyResolved when the item is
+	// no longer pending.
+	Resolve(ctx context.Context, item *Item, status Status, e *AuditEntry) error
+	ListAudit(ctx context.Context, itemID *int64, limit int32) ([]*AuditEntry, error)
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+const itemColumns = `
+	id, content_type, content_id, author_id, body, matched_keywords,
+	report_count, hidden_at, status, resolved_by, resolved_at, created_at, updated_at
+`
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanItem(s scanner) (*Item, error) {
+	var it Item
+	err := s.Scan(
+		&it.ID, &it.ContentType, &it.ContentID, &it.AuthorID, &it.Body, pq.Array(&it.MatchedKeywords),
+		&it.ReportCount, &it.HiddenAt, &it.Status, &it.ResolvedBy, &it.ResolvedAt, &it.CreatedAt, &it.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &it, nil
+}
+
+func (r *repository) ListKeywords(ctx context.Context) ([]*Keyword, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT term, created_by, created_at
+		FROM moderation_keywords
+		ORDER BY term
+	`)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to list moderation keywords", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	keywords := []*Keyword{}
+	for rows.Next() {
+		var k Keyword
+		if err := rows.Scan(&k.Term, &k.CreatedBy, &k.CreatedAt); err != nil {
+			logger.FromCtx(ctx).Error("failed to scan moderation keyword", zap.Error(err))
+			return nil, ErrDB
+		}
+		keywords = append(keywords, &k)
+	}
+	if err := rows.Err(); err != nil {
+		logger.FromCtx(ctx).Error("failed to iterate moderation keywords", zap.Error(err))
+		return nil, ErrDB
+	}
+	return keywords, nil
+}
+
+func (r *repository) AddKeyword(ctx context.Context, k *Keyword) error {
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO moderation_keywords (term, created_by)
+		VALUES ($1, $2)
+		RETURNING created_at
+	`, k.Term, k.CreatedBy).Scan(&k.CreatedAt)
+	if err != nil {
+		var pqErr *pq.Error
+		if errors.As(err, &pqErr) && string(pqErr.Code) == pgUniqueViolation {
+			return ErrKeywordExists
+		}
+		logger.FromCtx(ctx).Error("failed to add moderation keyword", zap.String("term", k.Term), zap.Error(err))
+		return ErrDB
+	}
+	return nil
+}
+
+func (r *repository) RemoveKeyword(ctx context.Context, term string) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM moderation_keywords WHERE term = $1`, term)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to remove moderation keyword", zap.String("term", term), zap.Error(err))
+		return ErrDB
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return ErrKeywordNotFound
+	}
+	return nil
+}
+
+func (r *repository) Flag(ctx context.Context, c *Content, keywords []string) (*Item, error) {
+	it, err := scanItem(r.db.QueryRowContext(ctx, `
+		INSERT INTO moderation_items (content_type, content_id, author_id, body, matched_keywords)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (content_type, content_id) WHERE status = 'PENDING' DO UPDATE
+		SET author_id = EXCLUDED.author_id,
+			body = EXCLUDED.body,
+			matched_keywords = EXCLUDED.matched_keywords,
+			updated_at = NOW()
+		RETURNING `+itemColumns,
+		c.Type, c.ID, c.AuthorID, c.Body, pq.Array(keywords),
+	))
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to flag content",
+			zap.String("layer", "repository"),
+			zap.String("content_type", string(c.Type)),
+			zap.String("content_id", c.ID),
+			zap.Error(err),
+		)
+		return nil, ErrDB
+	}
+	return it, nil
+}
+
+func (r *repository) Report(ctx context.Context, c *Content, reporterID uint, reason *string) (*Item, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "Report"),
+		zap.String("content_type", string(c.Type)),
+		zap.String("content_id", c.ID),
+		zap.Uint("reporter_id", reporterID),
+	)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error("failed to begin transaction", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer tx.Rollback()
+
+	var itemID int64
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO moderation_items (content_type, content_id, author_id, body)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (content_type, content_id) WHERE status = 'PENDING' DO UPDATE
+		SET author_id = EXCLUDED.author_id,
+			body = EXCLUDED.body,
+			updated_at = NOW()
+		RETURNING id
+	`, c.Type, c.ID, c.AuthorID, c.Body).Scan(&itemID)
+	if err != nil {
+		log.Error("failed to queue reported content", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	res, err := tx.ExecContext(ctx, `
+		INSERT INTO moderation_reports (item_id, reporter_id, reason)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (item_id, reporter_id) DO NOTHING
+	`, itemID, reporterID, reason)
+	if err != nil {
+		log.Error("failed to insert report", zap.Error(err))
+		return nil, ErrDB
+	}
+	if n, err := res.RowsAffected(); err == nil && n > 0 {
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE moderation_items SET report_count = report_count + 1 WHERE id = $1
+		`, itemID); err != nil {
+			log.Error("failed to count report", zap.Error(err))
+			return nil, ErrDB
+		}
+	}
+
+	it, err := scanItem(tx.QueryRowContext(ctx, `SELECT `+itemColumns+` FROM moderation_items WHERE id = $1`, itemID))
+	if err != nil {
+		log.Error("failed to load reported item", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Error("failed to commit transaction", zap.Error(err))
+		return nil, ErrDB
+	}
+	return it, nil
+}
+
+func (r *repository) MarkHidden(ctx context.Context, id int64) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE moderation_items SET hidden_at = NOW(), updated_at = NOW() WHERE id = $1
+	`, id)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to mark item hidden", zap.Int64("item_id", id), zap.Error(err))
+		return ErrDB
+	}
+	return nil
+}
+
+func (r *repository) GetItem(ctx context.Context, id int64) (*Item, error) {
+	it, err := scanItem(r.db.QueryRowContext(ctx, `SELECT `+itemColumns+` FROM moderation_items WHERE id = $1`, id))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrItemNotFound
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to get moderation item", zap.Int64("item_id", id), zap.Error(err))
+		return nil, ErrDB
+	}
+	return it, nil
+}
+
+// ListItems returns pending items most reported first, and resolved ones
+// most recently resolved first.
+func (r *repository) ListItems(ctx context.Context, status *Status, limit int32) ([]*Item, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+itemColumns+`
+		FROM moderation_items
+		WHERE ($1::text IS NULL OR status = $1)
+		ORDER BY status <> 'PENDING', report_count DESC, COALESCE(resolved_at, created_at) DESC, id
+		LIMIT $2
+	`, status, limit)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to list moderation items", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	items := []*Item{}
+	for rows.Next() {
+		it, err := scanItem(rows)
+		if err != nil {
+			logger.FromCtx(ctx).Error("failed to scan moderation item", zap.Error(err))
+			return nil, ErrDB
+		}
+		items = append(items, it)
+	}
+	if err := rows.Err(); err != nil {
+		logger.FromCtx(ctx).Error("failed to iterate moderation items", zap.Error(err))
+		return nil, ErrDB
+	}
+	return items, nil
+}
+
+func (r *repository) Resolve(ctx context.Context, item *Item, status Status, e *AuditEntry) error {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "Resolve"),
+		zap.Int64("item_id", item.ID),
+		zap.String("action", string(e.Action)),
+	)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error("failed to begin transaction", zap.Error(err))
+		return ErrDB
+	}
+	defer tx.Rollback()
+
+	err = tx.QueryRowContext(ctx, `
+		UPDATE moderation_items
+		SET status = $2, resolved_by = $3, resolved_at = NOW(), updated_at = NOW()
+		WHERE id = $1 AND status = 'PENDING'
+		RETURNING resolved_at
+	`, item.ID, status, e.ActorID).Scan(&item.ResolvedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrAlreadyResolved
+	}
+	if err != nil {
+		log.Error("failed to resolve moderation item", zap.Error(err))
+		return ErrDB
+	}
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO moderation_audit (item_id, action, actor_id, target_user_id, note)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING id, created_at
+	`, item.ID, e.Action, e.ActorID, e.TargetUserID, e.Note).Scan(&e.ID, &e.CreatedAt)
+	if err != nil {
+		log.Error("failed to write audit entry", zap.Error(err))
+		return ErrDB
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Error("failed to commit transaction", zap.Error(err))
+		return ErrDB
+	}
+
+	item.Status = status
+	item.ResolvedBy = e.ActorID
+	e.ItemID = item.ID
+	return nil
+}
+
+func (r *repository) ListAudit(ctx context.Context, itemID *int64, limit int32) ([]*AuditEntry, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, item_id, action, actor_id, target_user_id, note, created_at
+		FROM moderation_audit
+		WHERE ($1::bigint IS NULL OR item_id = $1)
+		ORDER BY id DESC
+		LIMIT $2
+	`, itemID, limit)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to list moderation audit", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	entries := []*AuditEntry{}
+	for rows.Next() {
+		var e AuditEntry
+		if err := rows.Scan(&e.ID, &e.ItemID, &e.Action, &e.ActorID, &e.TargetUserID, &e.Note, &e.CreatedAt); err != nil {
+			logger.FromCtx(ctx).Error("failed to scan audit entry", zap.Error(err))
+			return nil, ErrDB
+		}
+		entries = append(entries, &e)
+	}
+	if err := rows.Err(); err != nil {
+		logger.FromCtx(ctx).Error("failed to iterate moderation audit", zap.Error(err))
+		return nil, ErrDB
+	}
+	return entries, nil
+}
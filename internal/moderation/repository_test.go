@@ -0,0 +1,149 @@
+package moderation
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var itemRowColumns = []string{
+	"id", "content_type", "content_id", "author_id", "body", "matched_keywords",
+	"report_count", "hidden_at", "status", "resolved_by", "resolved_at", "created_at", "updated_at",
+}
+
+func TestRepository_Flag(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := NewRepository(db)
+
+	now := time.Now()
+	author := int32(7)
+	c := &Content{Type: ContentStoreName, ID: "s-1", AuthorID: &author, Body: "Toko Judi"}
+	mock.ExpectQuery(`INSERT INTO moderation_items .* ON CONFLICT \(content_type, content_id\) WHERE status = 'PENDING' DO UPDATE`).
+		WithArgs(ContentStoreName, "s-1", &author, "Toko Judi", pq.Array([]string{"judi"})).
+		WillReturnRows(sqlmock.NewRows(itemRowColumns).
+			AddRow(1, "STORE_NAME", "s-1", 7, "Toko Judi", "{judi}", 0, nil, "PENDING", nil, nil, now, now))
+
+	it, err := repo.Flag(context.Background(), c, []string{"judi"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"judi"}, it.MatchedKeywords)
+	assert.Equal(t, StatusPending, it.Status)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_Report(t *testing.T) {
+	now := time.Now()
+	c := &Content{Type: ContentAvatar, ID: "8", Body: "/uploads/avatar/a.png"}
+
+	t.Run("FirstReportCounted", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO moderation_items`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+		mock.ExpectExec(`INSERT INTO moderation_reports .* ON CONFLICT \(item_id, reporter_id\) DO NOTHING`).
+			WithArgs(int64(4), uint(5), nil).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE moderation_items SET report_count = report_count \+ 1 WHERE id = \$1`).
+			WithArgs(int64(4)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(`SELECT .* FROM moderation_items WHERE id = \$1`).
+			WillReturnRows(sqlmock.NewRows(itemRowColumns).
+				AddRow(4, "AVATAR", "8", nil, c.Body, "{}", 1, nil, "PENDING", nil, nil, now, now))
+		mock.ExpectCommit()
+
+		it, err := repo.Report(context.Background(), c, 5, nil)
+		require.NoError(t, err)
+		assert.Equal(t, int32(1), it.ReportCount)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("RepeatNotCounted", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO moderation_items`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(4))
+		mock.ExpectExec(`INSERT INTO moderation_reports`).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(`SELECT .* FROM moderation_items WHERE id = \$1`).
+			WillReturnRows(sqlmock.NewRows(itemRowColumns).
+				AddRow(4, "AVATAR", "8", nil, c.Body, "{}", 1, nil, "PENDING", nil, nil, now, now))
+		mock.ExpectCommit()
+
+		_, err = repo.Report(context.Background(), c, 5, nil)
+		require.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_Resolve(t *testing.T) {
+	actor := int32(9)
+
+	t.Run("Audited", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		now := time.Now()
+		mock.ExpectBegin()
+		mock.ExpectQuery(`UPDATE moderation_items SET status = \$2, .* WHERE id = \$1 AND status = 'PENDING'`).
+			WithArgs(int64(3), StatusRemoved, &actor).
+			WillReturnRows(sqlmock.NewRows([]string{"resolved_at"}).AddRow(now))
+		mock.ExpectQuery(`INSERT INTO moderation_audit`).
+			WithArgs(int64(3), ActionRemove, &actor, nil, nil).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(11, now))
+		mock.ExpectCommit()
+
+		it := &Item{ID: 3, Status: StatusPending}
+		e := &AuditEntry{Action: ActionRemove, ActorID: &actor}
+		require.NoError(t, repo.Resolve(context.Background(), it, StatusRemoved, e))
+		assert.Equal(t, StatusRemoved, it.Status)
+		assert.Equal(t, int64(11), e.ID)
+		assert.Equal(t, int64(3), e.ItemID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("AlreadyResolved", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`UPDATE moderation_items`).
+			WillReturnRows(sqlmock.NewRows([]string{"resolved_at"}))
+		mock.ExpectRollback()
+
+		err = repo.Resolve(context.Background(), &Item{ID: 3}, StatusApproved, &AuditEntry{Action: ActionApprove, ActorID: &actor})
+		assert.ErrorIs(t, err, ErrAlreadyResolved)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_AddKeyword_Exists(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := NewRepository(db)
+
+	mock.ExpectQuery(`INSERT INTO moderation_keywords`).
+		WithArgs("judi", nil).
+		WillReturnError(&pq.Error{Code: pgUniqueViolation})
+
+	err = repo.AddKeyword(context.Background(), &Keyword{Term: "judi"})
+	assert.ErrorIs(t, err, ErrKeywordExists)
+}
@@ -0,0 +1,321 @@
+package moderation
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+type Service interface {
+	// Screen queues text content matching a keyword rule for review. It
+	// never fails the edit that triggered it; errors are only logged.
+	Screen(ctx context.Context, c *Content)
+	// Report queues content for review on behalf of the user in ctx.
+	// Content that can be hidden is hidden from shoppers once enough users
+	// have reported it, until a moderator decides.
+	Report(ctx context.Context, t ContentType, id string, reason *string) error
+
+	// The queue and its decisions are admin only. Remove and Ban take the
+	// content down; Ban also bans its author. Each decision is written to
+	// the audit log.
+	Queue(ctx context.Context, status *Status, limit int32) ([]*Item, error)
+	Approve(ctx context.Context, id int64, note *string) (*Item, error)
+	Remove(ctx context.Context, id int64, note *string) (*Item, error)
+	Ban(ctx context.Context, id int64, note *string) (*Item, error)
+	Audit(ctx context.Context, itemID *int64, limit int32) ([]*AuditEntry, error)
+
+	ListKeywords(ctx context.Context) ([]*Keyword, error)
+	AddKeyword(ctx context.Context, term string) (*Keyword, error)
+	RemoveKeyword(ctx context.Context, term string) error
+}
+
+type service struct {
+	repo      Repository
+	sources   map[ContentType]Source
+	banner    Banner
+	hideAfter int32
+}
+
+// NewService creates the moderation service; sources serves each content
+// type the queue covers. Content is hidden once hideAfter users reported
+// it; 0 never hides it.
+func NewService(repo Repository, sources map[ContentType]Source, banner Banner, hideAfter int32) Service {
+	return &service{repo: repo, sources: sources, banner: banner, hideAfter: hideAfter}
+}
+
+func (s *service) Screen(ctx context.Context, c *Content) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "Screen"),
+		zap.String("content_type", string(c.Type)),
+		zap.String("content_id", c.ID),
+	)
+
+	if !c.Type.isText() || strings.TrimSpace(c.Body) == "" {
+		return
+	}
+
+	keywords, err := s.repo.ListKeywords(ctx)
+	if err != nil {
+		log.Error("failed to load keywords", zap.Error(err))
+		return
+	}
+	matched := matchKeywords(c.Body, keywords)
+	if len(matched) == 0 {
+		return
+	}
+
+	if _, err := s.repo.Flag(ctx, c, matched); err != nil {
+		log.Error("failed to flag content", zap.Error(err))
+		return
+	}
+	log.Info("content flagged for review", zap.Strings("keywords", matched))
+}
+
+func (s *service) Report(ctx context.Context, t ContentType, id string, reason *string) error {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "Report"),
+		zap.String("content_type", string(t)),
+		zap.String("content_id", id),
+	)
+
+	userID, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		return ErrUnauthenticated
+	}
+
+	src, ok := s.sources[t]
+	if !ok {
+		return ErrInvalidContentType
+	}
+	if reason != nil {
+		r := strings.TrimSpace(*reason)
+		if utf8.RuneCountInString(r) > maxReasonLen {
+			return ErrInvalidReason
+		}
+		reason = &r
+		if r == "" {
+			reason = nil
+		}
+	}
+	if t == ContentProduct && reason == nil {
+		return ErrReasonRequired
+	}
+
+	c, err := src.ModerationContent(ctx, t, id)
+	if err != nil {
+		return err
+	}
+	if c == nil {
+		return ErrContentNotFound
+	}
+	if c.AuthorID != nil && uint(*c.AuthorID) == userID {
+		return ErrOwnContent
+	}
+
+	it, err := s.repo.Report(ctx, c, userID, reason)
+	if err != nil {
+		return err
+	}
+
+	log.Info("content reported", zap.Int64("item_id", it.ID), zap.Int32("report_count", it.ReportCount))
+
+	hider, ok := src.(Hider)
+	if !ok || s.hideAfter <= 0 || it.ReportCount < s.hideAfter || it.HiddenAt != nil {
+		return nil
+	}
+	// The report is recorded; failing to hide must not fail it.
+	if err := hider.HideModerationContent(ctx, t, id); err != nil {
+		log.Error("failed to hide reported content", zap.Error(err))
+		return nil
+	}
+	if err := s.repo.MarkHidden(ctx, it.ID); err != nil {
+		log.Error("failed to mark item hidden", zap.Error(err))
+		return nil
+	}
+	log.Info("reported content hidden pending review", zap.Int64("item_id", it.ID))
+	return nil
+}
+
+func (s *service) Queue(ctx context.Context, status *Status, limit int32) ([]*Item, error) {
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return s.repo.ListItems(ctx, status, clampLimit(limit))
+}
+
+func (s *service) Approve(ctx context.Context, id int64, note *string) (*Item, error) {
+	return s.resolve(ctx, id, ActionApprove, note)
+}
+
+func (s *service) Remove(ctx context.Context, id int64, note *string) (*Item, error) {
+	return s.resolve(ctx, id, ActionRemove, note)
+}
+
+func (s *service) Ban(ctx context.Context, id int64, note *string) (*Item, error) {
+	return s.resolve(ctx, id, ActionBan, note)
+}
+
+// resolve applies action to a pending item. Approving shows content that
+// reports hid again. Content is only taken down while it still reads as
+// it did when queued, so a fix its author made since is left alone;
+// hidden content is taken down regardless, since the author's edits did
+// not show it again. The take-down and ban run before the item is
+// resolved, so a failure leaves it pending to retry; both are safe to
+// repeat.
+func (s *service) resolve(ctx context.Context, id int64, action Action, note *string) (*Item, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "resolve"),
+		zap.Int64("item_id", id),
+		zap.String("action", string(action)),
+	)
+
+	adminID, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	it, err := s.repo.GetItem(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if it.Status != StatusPending {
+		return nil, ErrAlreadyResolved
+	}
+	if action == ActionBan && it.AuthorID == nil {
+		return nil, ErrNoAuthor
+	}
+
+	actor := int32(adminID)
+	entry := &AuditEntry{Action: action, ActorID: &actor, TargetUserID: it.AuthorID, Note: note}
+	status := StatusApproved
+	if action == ActionApprove && it.HiddenAt != nil {
+		if err := s.unhide(ctx, it); err != nil {
+			return nil, err
+		}
+	}
+	if action != ActionApprove {
+		status = StatusRemoved
+		if err := s.takeDown(ctx, it); err != nil {
+			return nil, err
+		}
+	}
+	if action == ActionBan {
+		reason := "content removed by moderation"
+		if note != nil && *note != "" {
+			reason = *note
+		}
+		if err := s.banner.BanUser(ctx, uint(*it.AuthorID), reason); err != nil {
+			log.Error("failed to ban author", zap.Error(err))
+			return nil, err
+		}
+	}
+
+	if err := s.repo.Resolve(ctx, it, status, entry); err != nil {
+		log.Warn("failed to resolve item", zap.Error(err))
+		return nil, err
+	}
+
+	log.Info("moderation item resolved")
+	return it, nil
+}
+
+func (s *service) takeDown(ctx context.Context, it *Item) error {
+	src, ok := s.sources[it.ContentType]
+	if !ok {
+		return ErrInvalidContentType
+	}
+	c, err := src.ModerationContent(ctx, it.ContentType, it.ContentID)
+	if err != nil {
+		return err
+	}
+	if c == nil || (it.HiddenAt == nil && c.Body != it.Body) {
+		logger.FromCtx(ctx).Info("content changed since it was queued, leaving it",
+			zap.Int64("item_id", it.ID),
+		)
+		return nil
+	}
+	return src.RemoveModerationContent(ctx, it.ContentType, it.ContentID)
+}
+
+func (s *service) unhide(ctx context.Context, it *Item) error {
+	hider, ok := s.sources[it.ContentType].(Hider)
+	if !ok {
+		return nil
+	}
+	return hider.UnhideModerationContent(ctx, it.ContentType, it.ContentID)
+}
+
+func (s *service) Audit(ctx context.Context, itemID *int64, limit int32) ([]*AuditEntry, error) {
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return s.repo.ListAudit(ctx, itemID, clampLimit(limit))
+}
+
+func (s *service) ListKeywords(ctx context.Context) ([]*Keyword, error) {
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return s.repo.ListKeywords(ctx)
+}
+
+func (s *service) AddKeyword(ctx context.Context, term string) (*Keyword, error) {
+	adminID, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	folded := foldText(term)
+	if n := utf8.RuneCountInString(folded); n < minKeywordLen || n > maxKeywordLen {
+		return nil, ErrInvalidKeyword
+	}
+
+	createdBy := int32(adminID)
+	k := &Keyword{Term: folded, CreatedBy: &createdBy}
+	if err := s.repo.AddKeyword(ctx, k); err != nil {
+		return nil, err
+	}
+
+	logger.FromCtx(ctx).Info("moderation keyword added", zap.String("term", folded))
+	return k, nil
+}
+
+func (s *service) RemoveKeyword(ctx context.Context, term string) error {
+	if _, err := requireAdmin(ctx); err != nil {
+		return err
+	}
+	if err := s.repo.RemoveKeyword(ctx, foldText(term)); err != nil {
+		return err
+	}
+
+	logger.FromCtx(ctx).Info("moderation keyword removed", zap.String("term", foldText(term)))
+	return nil
+}
+
+func clampLimit(limit int32) int32 {
+	if limit <= 0 {
+		return defaultLimit
+	}
+	if limit > maxLimit {
+		return maxLimit
+	}
+	return limit
+}
+
+func requireAdmin(ctx context.Context) (uint, error) {
+	userID, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		return 0, ErrUnauthenticated
+	}
+	if !utils.IsAdmin(ctx) {
+		return 0, ErrForbidden
+	}
+	return userID, nil
+}
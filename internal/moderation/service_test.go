@@ -0,0 +1,393 @@
+package moderation
+
+import (
+	"context"
+	"testing"
+	"time"
+	"warimas-be/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) ListKeywords(ctx context.Context) ([]*Keyword, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Keyword), args.Error(1)
+}
+
+func (m *MockRepository) AddKeyword(ctx context.Context, k *Keyword) error {
+	return m.Called(ctx, k).Error(0)
+}
+
+func (m *MockRepository) RemoveKeyword(ctx context.Context, term string) error {
+	return m.Called(ctx, term).Error(0)
+}
+
+func (m *MockRepository) Flag(ctx context.Context, c *Content, keywords []string) (*Item, error) {
+	args := m.Called(ctx, c, keywords)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Item), args.Error(1)
+}
+
+func (m *MockRepository) Report(ctx context.Context, c *Content, reporterID uint, reason *string) (*Item, error) {
+	args := m.Called(ctx, c, reporterID, reason)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Item), args.Error(1)
+}
+
+func (m *MockRepository) MarkHidden(ctx context.Context, id int64) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+func (m *MockRepository) GetItem(ctx context.Context, id int64) (*Item, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Item), args.Error(1)
+}
+
+func (m *MockRepository) ListItems(ctx context.Context, status *Status, limit int32) ([]*Item, error) {
+	args := m.Called(ctx, status, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Item), args.Error(1)
+}
+
+func (m *MockRepository) Resolve(ctx context.Context, item *Item, status Status, e *AuditEntry) error {
+	return m.Called(ctx, item, status, e).Error(0)
+}
+
+func (m *MockRepository) ListAudit(ctx context.Context, itemID *int64, limit int32) ([]*AuditEntry, error) {
+	args := m.Called(ctx, itemID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*AuditEntry), args.Error(1)
+}
+
+type MockSource struct {
+	mock.Mock
+}
+
+func (m *MockSource) ModerationContent(ctx context.Context, t ContentType, id string) (*Content, error) {
+	args := m.Called(ctx, t, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Content), args.Error(1)
+}
+
+func (m *MockSource) RemoveModerationContent(ctx context.Context, t ContentType, id string) error {
+	return m.Called(ctx, t, id).Error(0)
+}
+
+// MockHidingSource is a source whose content can be hidden, like
+// products.
+type MockHidingSource struct {
+	MockSource
+}
+
+func (m *MockHidingSource) HideModerationContent(ctx context.Context, t ContentType, id string) error {
+	return m.Called(ctx, t, id).Error(0)
+}
+
+func (m *MockHidingSource) UnhideModerationContent(ctx context.Context, t ContentType, id string) error {
+	return m.Called(ctx, t, id).Error(0)
+}
+
+type MockBanner struct {
+	mock.Mock
+}
+
+func (m *MockBanner) BanUser(ctx context.Context, userID uint, reason string) error {
+	return m.Called(ctx, userID, reason).Error(0)
+}
+
+const testHideAfter = 3
+
+func newTestService() (*service, *MockRepository, *MockSource, *MockBanner) {
+	svc, repo, src, _, banner := newHidingTestService()
+	return svc, repo, src, banner
+}
+
+// newHidingTestService also returns the hiding source products are
+// served by.
+func newHidingTestService() (*service, *MockRepository, *MockSource, *MockHidingSource, *MockBanner) {
+	repo, src, products, banner := new(MockRepository), new(MockSource), new(MockHidingSource), new(MockBanner)
+	svc := NewService(repo, map[ContentType]Source{
+		ContentStoreName:   src,
+		ContentDisplayName: src,
+		ContentAvatar:      src,
+		ContentProduct:     products,
+	}, banner, testHideAfter).(*service)
+	return svc, repo, src, products, banner
+}
+
+func int32Ptr(v int32) *int32 { return &v }
+
+func adminCtx() context.Context {
+	return utils.SetUserContext(context.Background(), 9, "admin@example.com", "ADMIN")
+}
+
+func TestService_Screen(t *testing.T) {
+	ctx := context.Background()
+	keywords := []*Keyword{{Term: "judi"}, {Term: "togel"}}
+
+	t.Run("FlagsMatch", func(t *testing.T) {
+		svc, repo, _, _ := newTestService()
+		c := &Content{Type: ContentStoreName, ID: "s-1", Body: "Toko J.u-d1 & T0gel"}
+		repo.On("ListKeywords", ctx).Return(keywords, nil)
+		repo.On("Flag", ctx, c, []string{"judi", "togel"}).Return(&Item{ID: 1}, nil)
+
+		svc.Screen(ctx, c)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("CleanTextNotFlagged", func(t *testing.T) {
+		svc, repo, _, _ := newTestService()
+		repo.On("ListKeywords", ctx).Return(keywords, nil)
+
+		svc.Screen(ctx, &Content{Type: ContentDisplayName, ID: "1", Body: "Budi Santoso"})
+		repo.AssertNotCalled(t, "Flag", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("ImagesSkipped", func(t *testing.T) {
+		svc, repo, _, _ := newTestService()
+
+		svc.Screen(ctx, &Content{Type: ContentAvatar, ID: "1", Body: "/uploads/avatar/judi.png"})
+		repo.AssertNotCalled(t, "ListKeywords", mock.Anything)
+	})
+}
+
+func TestService_Report(t *testing.T) {
+	ctx := utils.SetUserContext(context.Background(), 5, "user@example.com", "USER")
+	content := &Content{Type: ContentStoreName, ID: "s-1", AuthorID: int32Ptr(7), Body: "Toko"}
+
+	t.Run("Queued", func(t *testing.T) {
+		svc, repo, src, _ := newTestService()
+		reason := "spam"
+		src.On("ModerationContent", ctx, ContentStoreName, "s-1").Return(content, nil)
+		repo.On("Report", ctx, content, uint(5), &reason).Return(&Item{ID: 1, ReportCount: 1}, nil)
+
+		assert.NoError(t, svc.Report(ctx, ContentStoreName, "s-1", &reason))
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("OwnContent", func(t *testing.T) {
+		svc, _, src, _ := newTestService()
+		own := &Content{Type: ContentDisplayName, ID: "5", AuthorID: int32Ptr(5), Body: "Budi"}
+		src.On("ModerationContent", ctx, ContentDisplayName, "5").Return(own, nil)
+
+		assert.ErrorIs(t, svc.Report(ctx, ContentDisplayName, "5", nil), ErrOwnContent)
+	})
+
+	t.Run("Gone", func(t *testing.T) {
+		svc, _, src, _ := newTestService()
+		src.On("ModerationContent", ctx, ContentAvatar, "8").Return(nil, nil)
+
+		assert.ErrorIs(t, svc.Report(ctx, ContentAvatar, "8", nil), ErrContentNotFound)
+	})
+
+	t.Run("HidesProductAtThreshold", func(t *testing.T) {
+		svc, repo, _, products, _ := newHidingTestService()
+		p := &Content{Type: ContentProduct, ID: "p-1", AuthorID: int32Ptr(7), Body: "Beras"}
+		reason := "counterfeit"
+		products.On("ModerationContent", ctx, ContentProduct, "p-1").Return(p, nil)
+		repo.On("Report", ctx, p, uint(5), &reason).Return(&Item{ID: 2, ReportCount: testHideAfter}, nil)
+		products.On("HideModerationContent", ctx, ContentProduct, "p-1").Return(nil)
+		repo.On("MarkHidden", ctx, int64(2)).Return(nil)
+
+		assert.NoError(t, svc.Report(ctx, ContentProduct, "p-1", &reason))
+		products.AssertExpectations(t)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("BelowThresholdStaysVisible", func(t *testing.T) {
+		svc, repo, _, products, _ := newHidingTestService()
+		p := &Content{Type: ContentProduct, ID: "p-1", AuthorID: int32Ptr(7), Body: "Beras"}
+		reason := "counterfeit"
+		products.On("ModerationContent", ctx, ContentProduct, "p-1").Return(p, nil)
+		repo.On("Report", ctx, p, uint(5), &reason).Return(&Item{ID: 2, ReportCount: testHideAfter - 1}, nil)
+
+		assert.NoError(t, svc.Report(ctx, ContentProduct, "p-1", &reason))
+		products.AssertNotCalled(t, "HideModerationContent", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("ProductNeedsReason", func(t *testing.T) {
+		svc, repo, _, products, _ := newHidingTestService()
+		blank := "   "
+
+		assert.ErrorIs(t, svc.Report(ctx, ContentProduct, "p-1", nil), ErrReasonRequired)
+		assert.ErrorIs(t, svc.Report(ctx, ContentProduct, "p-1", &blank), ErrReasonRequired)
+		products.AssertNotCalled(t, "ModerationContent", mock.Anything, mock.Anything, mock.Anything)
+		repo.AssertNotCalled(t, "Report", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("UnknownType", func(t *testing.T) {
+		svc, _, _, _ := newTestService()
+		assert.ErrorIs(t, svc.Report(ctx, "REVIEW", "1", nil), ErrInvalidContentType)
+	})
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		svc, _, _, _ := newTestService()
+		assert.ErrorIs(t, svc.Report(context.Background(), ContentStoreName, "s-1", nil), ErrUnauthenticated)
+	})
+}
+
+func TestService_Resolve(t *testing.T) {
+	ctx := adminCtx()
+	pending := func() *Item {
+		return &Item{ID: 3, ContentType: ContentStoreName, ContentID: "s-1", AuthorID: int32Ptr(7), Body: "Toko Judi", Status: StatusPending}
+	}
+	current := &Content{Type: ContentStoreName, ID: "s-1", AuthorID: int32Ptr(7), Body: "Toko Judi"}
+
+	t.Run("Approve", func(t *testing.T) {
+		svc, repo, src, _ := newTestService()
+		repo.On("GetItem", ctx, int64(3)).Return(pending(), nil)
+		repo.On("Resolve", ctx, mock.Anything, StatusApproved, mock.MatchedBy(func(e *AuditEntry) bool {
+			return e.Action == ActionApprove && *e.ActorID == 9 && *e.TargetUserID == 7
+		})).Return(nil)
+
+		_, err := svc.Approve(ctx, 3, nil)
+		assert.NoError(t, err)
+		src.AssertNotCalled(t, "RemoveModerationContent", mock.Anything, mock.Anything, mock.Anything)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Remove", func(t *testing.T) {
+		svc, repo, src, _ := newTestService()
+		repo.On("GetItem", ctx, int64(3)).Return(pending(), nil)
+		src.On("ModerationContent", ctx, ContentStoreName, "s-1").Return(current, nil)
+		src.On("RemoveModerationContent", ctx, ContentStoreName, "s-1").Return(nil)
+		repo.On("Resolve", ctx, mock.Anything, StatusRemoved, mock.MatchedBy(func(e *AuditEntry) bool {
+			return e.Action == ActionRemove
+		})).Return(nil)
+
+		_, err := svc.Remove(ctx, 3, nil)
+		assert.NoError(t, err)
+		src.AssertExpectations(t)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("RemoveLeavesChangedContent", func(t *testing.T) {
+		svc, repo, src, _ := newTestService()
+		repo.On("GetItem", ctx, int64(3)).Return(pending(), nil)
+		src.On("ModerationContent", ctx, ContentStoreName, "s-1").
+			Return(&Content{Type: ContentStoreName, ID: "s-1", Body: "Toko Beras"}, nil)
+		repo.On("Resolve", ctx, mock.Anything, StatusRemoved, mock.Anything).Return(nil)
+
+		_, err := svc.Remove(ctx, 3, nil)
+		assert.NoError(t, err)
+		src.AssertNotCalled(t, "RemoveModerationContent", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Ban", func(t *testing.T) {
+		svc, repo, src, banner := newTestService()
+		note := "gambling ads"
+		repo.On("GetItem", ctx, int64(3)).Return(pending(), nil)
+		src.On("ModerationContent", ctx, ContentStoreName, "s-1").Return(current, nil)
+		src.On("RemoveModerationContent", ctx, ContentStoreName, "s-1").Return(nil)
+		banner.On("BanUser", ctx, uint(7), note).Return(nil)
+		repo.On("Resolve", ctx, mock.Anything, StatusRemoved, mock.MatchedBy(func(e *AuditEntry) bool {
+			return e.Action == ActionBan && *e.Note == note
+		})).Return(nil)
+
+		_, err := svc.Ban(ctx, 3, &note)
+		assert.NoError(t, err)
+		banner.AssertExpectations(t)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("ApproveShowsHiddenProduct", func(t *testing.T) {
+		svc, repo, _, products, _ := newHidingTestService()
+		hiddenAt := time.Now()
+		repo.On("GetItem", ctx, int64(2)).
+			Return(&Item{ID: 2, ContentType: ContentProduct, ContentID: "p-1", HiddenAt: &hiddenAt, Status: StatusPending}, nil)
+		products.On("UnhideModerationContent", ctx, ContentProduct, "p-1").Return(nil)
+		repo.On("Resolve", ctx, mock.Anything, StatusApproved, mock.Anything).Return(nil)
+
+		_, err := svc.Approve(ctx, 2, nil)
+		assert.NoError(t, err)
+		products.AssertExpectations(t)
+	})
+
+	t.Run("RemoveKeepsEditedHiddenProductDown", func(t *testing.T) {
+		svc, repo, _, products, _ := newHidingTestService()
+		hiddenAt := time.Now()
+		repo.On("GetItem", ctx, int64(2)).
+			Return(&Item{ID: 2, ContentType: ContentProduct, ContentID: "p-1", Body: "Beras", HiddenAt: &hiddenAt, Status: StatusPending}, nil)
+		products.On("ModerationContent", ctx, ContentProduct, "p-1").
+			Return(&Content{Type: ContentProduct, ID: "p-1", Body: "Beras Premium"}, nil)
+		products.On("RemoveModerationContent", ctx, ContentProduct, "p-1").Return(nil)
+		repo.On("Resolve", ctx, mock.Anything, StatusRemoved, mock.Anything).Return(nil)
+
+		_, err := svc.Remove(ctx, 2, nil)
+		assert.NoError(t, err)
+		products.AssertExpectations(t)
+	})
+
+	t.Run("BanWithoutAuthor", func(t *testing.T) {
+		svc, repo, _, banner := newTestService()
+		it := pending()
+		it.AuthorID = nil
+		repo.On("GetItem", ctx, int64(3)).Return(it, nil)
+
+		_, err := svc.Ban(ctx, 3, nil)
+		assert.ErrorIs(t, err, ErrNoAuthor)
+		banner.AssertNotCalled(t, "BanUser", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("AlreadyResolved", func(t *testing.T) {
+		svc, repo, _, _ := newTestService()
+		it := pending()
+		it.Status = StatusApproved
+		repo.On("GetItem", ctx, int64(3)).Return(it, nil)
+
+		_, err := svc.Remove(ctx, 3, nil)
+		assert.ErrorIs(t, err, ErrAlreadyResolved)
+	})
+
+	t.Run("NotAdmin", func(t *testing.T) {
+		svc, _, _, _ := newTestService()
+		user := utils.SetUserContext(context.Background(), 5, "user@example.com", "USER")
+
+		_, err := svc.Approve(user, 3, nil)
+		assert.ErrorIs(t, err, ErrForbidden)
+		_, err = svc.Queue(user, nil, 0)
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+}
+
+func TestService_AddKeyword(t *testing.T) {
+	ctx := adminCtx()
+
+	t.Run("Folded", func(t *testing.T) {
+		svc, repo, _, _ := newTestService()
+		repo.On("AddKeyword", ctx, mock.MatchedBy(func(k *Keyword) bool {
+			return k.Term == "togel" && *k.CreatedBy == 9
+		})).Return(nil)
+
+		k, err := svc.AddKeyword(ctx, " T0-GEL ")
+		assert.NoError(t, err)
+		assert.Equal(t, "togel", k.Term)
+	})
+
+	t.Run("TooShort", func(t *testing.T) {
+		svc, _, _, _ := newTestService()
+		_, err := svc.AddKeyword(ctx, "-x-")
+		assert.ErrorIs(t, err, ErrInvalidKeyword)
+	})
+}
@@ -0,0 +1,157 @@
+// Package money rounds amounts the same way wherever they are computed.
+//
+// Amounts are kept as integers in a currency's minor units: rupiah for
+// IDR, which has no decimals, and cents for a currency with two. Every
+// fraction that pricing produces, from a variant's price, a percentage
+// tax or discount, or a provider's decimal amount, goes through Round
+// with the currency's rule, so the session, the order and the payment
+// webhook agree to the last unit.
+package money
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultCurrency is what amounts without a currency are in.
+const DefaultCurrency = "IDR"
+
+// Mode is how an amount between two whole minor units is rounded.
+type Mode string
+
+const (
+	// HalfUp rounds to the nearest unit, halves away from zero.
+	HalfUp Mode = "HALF_UP"
+	// HalfEven rounds to the nearest unit, halves to the even one.
+	HalfEven Mode = "HALF_EVEN"
+	// Up rounds away from zero.
+	Up Mode = "UP"
+	// Down rounds toward zero.
+	Down Mode = "DOWN"
+)
+
+// Rule is how one currency is rounded.
+type Rule struct {
+	// Decimals is the number of minor units digits, 0 for IDR.
+	Decimals int
+	Mode     Mode
+}
+
+// maxDecimals keeps minor unit amounts of a sensible size.
+const maxDecimals = 4
+
+var (
+	defaultRules = map[string]Rule{
+		"IDR": {Decimals: 0, Mode: HalfUp},
+	}
+	// fallback rounds currencies without a rule.
+	fallback = Rule{Decimals: 2, Mode: HalfUp}
+
+	mu    sync.RWMutex
+	rules = copyRules(defaultRules)
+)
+
+func copyRules(in map[string]Rule) map[string]Rule {
+	out := make(map[string]Rule, len(in))
+	for k, v := range in {
+		out[k] = v
+	}
+	return out
+}
+
+// Configure sets the rules from spec, a comma separated list of
+// CURRENCY:DECIMALS:MODE such as "USD:2:HALF_EVEN,IDR:0:HALF_UP".
+// Currencies left out keep their default. IDR has no decimals, so only
+// its mode can change.
+func Configure(spec string) error {
+	next := copyRules(defaultRules)
+
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		parts := strings.Split(entry, ":")
+		if len(parts) != 3 {
+			return fmt.Errorf("rounding rule %q is not CURRENCY:DECIMALS:MODE", entry)
+		}
+		currency := strings.ToUpper(strings.TrimSpace(parts[0]))
+		if len(currency) != 3 {
+			return fmt.Errorf("rounding rule %q: currency must be a 3 letter code", entry)
+		}
+		decimals, err := strconv.Atoi(strings.TrimSpace(parts[1]))
+		if err != nil || decimals < 0 || decimals > maxDecimals {
+			return fmt.Errorf("rounding rule %q: decimals must be 0-%d", entry, maxDecimals)
+		}
+		if currency == "IDR" && decimals != 0 {
+			return fmt.Errorf("rounding rule %q: IDR amounts have no decimals", entry)
+		}
+		mode := Mode(strings.ToUpper(strings.TrimSpace(parts[2])))
+		switch mode {
+		case HalfUp, HalfEven, Up, Down:
+		default:
+			return fmt.Errorf("rounding rule %q: unknown mode %q", entry, mode)
+		}
+		next[currency] = Rule{Decimals: decimals, Mode: mode}
+	}
+
+	mu.Lock()
+	rules = next
+	mu.Unlock()
+	return nil
+}
+
+// RuleFor returns the rule of currency; "" is DefaultCurrency.
+func RuleFor(currency string) Rule {
+	if currency == "" {
+		currency = DefaultCurrency
+	}
+	mu.RLock()
+	defer mu.RUnlock()
+	if r, ok := rules[strings.ToUpper(currency)]; ok {
+		return r
+	}
+	return fallback
+}
+
+// Round rounds an amount in minor units to a whole one with the rule of
+// currency.
+func Round(currency string, minor float64) int64 {
+	return round(minor, RuleFor(currency).Mode)
+}
+
+// FromMajor turns an amount in major units, as prices are stored and
+// providers report them, into whole minor units.
+func FromMajor(currency string, major float64) int64 {
+	r := RuleFor(currency)
+	return round(major*math.Pow10(r.Decimals), r.Mode)
+}
+
+// Share is basisPoints hundredths of a percent of amount, rounded with
+// the rule of currency; 1000 basis points is 10%.
+func Share(currency string, amount int64, basisPoints int64) int64 {
+	return Round(currency, float64(amount)*float64(basisPoints)/10000)
+}
+
+func round(x float64, mode Mode) int64 {
+	// Drop float noise such as 149999.99999999997 before rounding, so
+	// the mode only decides amounts that really fall between two units.
+	x = math.Round(x*1e6) / 1e6
+
+	switch mode {
+	case Up:
+		if x < 0 {
+			return int64(math.Floor(x))
+		}
+		return int64(math.Ceil(x))
+	case Down:
+		return int64(math.Trunc(x))
+	case HalfEven:
+		return int64(math.RoundToEven(x))
+	default:
+		return int64(math.Round(x))
+	}
+}
@@ -0,0 +1,60 @@
+package money
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRound_Modes(t *testing.T) {
+	tests := []struct {
+		mode Mode
+		in   float64
+		want int64
+	}{
+		{HalfUp, 2.5, 3},
+		{HalfUp, 2.4, 2},
+		{HalfUp, -2.5, -3},
+		{HalfEven, 2.5, 2},
+		{HalfEven, 3.5, 4},
+		{Up, 2.1, 3},
+		{Up, -2.1, -3},
+		{Down, 2.9, 2},
+		{Down, -2.9, -2},
+		// float noise is not a fraction
+		{Up, 149999.99999999997, 150000},
+		{Down, 150000.00000000003, 150000},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, round(tt.in, tt.mode), "%s %v", tt.mode, tt.in)
+	}
+}
+
+func TestFromMajorAndShare(t *testing.T) {
+	t.Cleanup(func() { require.NoError(t, Configure("")) })
+
+	assert.Equal(t, int64(150000), FromMajor("IDR", 149999.5))
+	assert.Equal(t, int64(150000), FromMajor("", 150000))
+	assert.Equal(t, int64(1999), FromMajor("USD", 19.99))
+	assert.Equal(t, int64(1235), Share("IDR", 12345, 1000))
+
+	require.NoError(t, Configure("idr:0:down, USD:2:HALF_EVEN"))
+	assert.Equal(t, int64(1234), Share("IDR", 12345, 1000))
+	assert.Equal(t, Rule{Decimals: 2, Mode: HalfEven}, RuleFor("usd"))
+	assert.Equal(t, fallback, RuleFor("SGD"))
+}
+
+func TestConfigure_Invalid(t *testing.T) {
+	t.Cleanup(func() { require.NoError(t, Configure("")) })
+
+	for spec, want := range map[string]string{
+		"USD:2":         "is not CURRENCY:DECIMALS:MODE",
+		"DOLLAR:2:UP":   "3 letter code",
+		"USD:9:UP":      "decimals must be 0-4",
+		"IDR:2:HALF_UP": "IDR amounts have no decimals",
+		"USD:2:CEILING": "unknown mode",
+	} {
+		assert.ErrorContains(t, Configure(spec), want, spec)
+	}
+}
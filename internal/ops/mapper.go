@@ -0,0 +1,79 @@
+package ops
+
+import (
+	"strconv"
+	"warimas-be/internal/graph/model"
+)
+
+func idPtr(id *int32) *string {
+	if id == nil {
+		return nil
+	}
+	s := strconv.Itoa(int(*id))
+	return &s
+}
+
+func MapStuckOrderToGraphQL(o *StuckOrder) *model.StuckOrder {
+	return &model.StuckOrder{
+		ID:          strconv.Itoa(int(o.ID)),
+		ExternalID:  o.ExternalID,
+		UserID:      idPtr(o.UserID),
+		TotalAmount: int32(o.TotalAmount),
+		CreatedAt:   o.CreatedAt,
+	}
+}
+
+func MapUnpaidSessionToGraphQL(s *UnpaidSession) *model.UnpaidConfirmedSession {
+	return &model.UnpaidConfirmedSession{
+		SessionExternalID: s.SessionExternalID,
+		UserID:            idPtr(s.UserID),
+		TotalPrice:        int32(s.TotalPrice),
+		ConfirmedAt:       s.ConfirmedAt,
+		OrderExternalID:   s.OrderExternalID,
+	}
+}
+
+func MapDashboardToGraphQL(d *Dashboard) *model.AdminDashboard {
+	return &model.AdminDashboard{
+		OrdersToday:      int32(d.OrdersToday),
+		RevenueToday:     int32(d.RevenueToday),
+		PendingShipments: int32(d.PendingShipments),
+		FailedWebhooks:   int32(d.FailedWebhooks),
+		LowStockVariants: int32(d.LowStockVariants),
+		GeneratedAt:      d.GeneratedAt,
+	}
+}
+
+func MapWebhookHealthToGraphQL(h *WebhookHealth) *model.WebhookHealth {
+	return &model.WebhookHealth{
+		Pending:         int32(h.Pending),
+		Failed:          int32(h.Failed),
+		OldestPendingAt: h.OldestPendingAt,
+	}
+}
+
+func MapStockOversellToGraphQL(incidents []*StockIncident, negative []*NegativeStockVariant) *model.StockOversellReport {
+	report := &model.StockOversellReport{
+		Incidents:             make([]*model.StockIncident, 0, len(incidents)),
+		NegativeStockVariants: make([]*model.NegativeStockVariant, 0, len(negative)),
+	}
+	for _, i := range incidents {
+		report.Incidents = append(report.Incidents, &model.StockIncident{
+			ID:                strconv.FormatInt(i.ID, 10),
+			VariantID:         i.VariantID,
+			VariantName:       i.VariantName,
+			CheckoutSessionID: i.CheckoutSessionID,
+			Requested:         i.Requested,
+			Available:         i.Available,
+			CreatedAt:         i.CreatedAt,
+		})
+	}
+	for _, v := range negative {
+		report.NegativeStockVariants = append(report.NegativeStockVariants, &model.NegativeStockVariant{
+			VariantID: v.VariantID,
+			Name:      v.Name,
+			Stock:     v.Stock,
+		})
+	}
+	return report
+}
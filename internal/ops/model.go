@@ -0,0 +1,73 @@
+package ops
+
+import "time"
+
+// Default look-back and page size for the dashboard queries.
+const (
+	defaultStuckAfter = 30 * time.Minute
+	defaultLimit      = 50
+	maxLimit          = 500
+)
+
+// StuckOrder is an order still waiting for payment past the threshold.
+type StuckOrder struct {
+	ID          int32
+	ExternalID  string
+	UserID      *int32
+	TotalAmount int64
+	CreatedAt   time.Time
+}
+
+// UnpaidSession is a confirmed checkout session whose order has no
+// payment row. OrderExternalID is nil when not even the order exists.
+type UnpaidSession struct {
+	SessionExternalID string
+	UserID            *int32
+	TotalPrice        int64
+	ConfirmedAt       time.Time
+	OrderExternalID   *string
+}
+
+// Dashboard is the ops home screen summary. The day counts run from
+// Jakarta midnight.
+type Dashboard struct {
+	OrdersToday      int64
+	RevenueToday     int64
+	PendingShipments int64
+	FailedWebhooks   int64
+	LowStockVariants int64
+	GeneratedAt      time.Time
+}
+
+// DashboardTTL is how long one computed Dashboard is served to every
+// admin before it is recomputed.
+const DashboardTTL = 60 * time.Second
+
+// LowStockThreshold is the sellable stock at or below which an active
+// variant counts as low on the dashboard.
+const LowStockThreshold = 5
+
+type WebhookHealth struct {
+	Pending         int64
+	Failed          int64
+	OldestPendingAt *time.Time
+}
+
+// StockIncident is a checkout that lost its stock to a concurrent order.
+type StockIncident struct {
+	ID                int64
+	VariantID         string
+	VariantName       string
+	CheckoutSessionID *string
+	Requested         int32
+	Available         int32
+	CreatedAt         time.Time
+}
+
+// NegativeStockVariant is a variant whose stock went below zero, which
+// means more was sold than was on hand.
+type NegativeStockVariant struct {
+	VariantID string
+	Name      string
+	Stock     int32
+}
@@ -0,0 +1,226 @@
+package ops
+
+import (
+	"context"
+	"database/sql"
+	"time"
+	"warimas-be/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+type Repository interface {
+	ListStuckPendingOrders(ctx context.Context, before time.Time, limit int32) ([]*StuckOrder, error)
+	ListUnpaidConfirmedSessions(ctx context.Context, before time.Time, limit int32) ([]*UnpaidSession, error)
+	GetWebhookHealth(ctx context.Context) (*WebhookHealth, error)
+	ListStockIncidents(ctx context.Context, since time.Time, limit int32) ([]*StockIncident, error)
+	ListNegativeStockVariants(ctx context.Context, limit int32) ([]*NegativeStockVariant, error)
+	// GetDashboard counts the dashboard figures in one round trip; orders
+	// and revenue are those placed since dayStart.
+	GetDashboard(ctx context.Context, dayStart time.Time, lowStock int32) (*Dashboard, error)
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) ListStuckPendingOrders(ctx context.Context, before time.Time, limit int32) ([]*StuckOrder, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ListStuckPendingOrders"),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, external_id, user_id, total_amount, created_at
+		FROM orders
+		WHERE status = 'PENDING_PAYMENT'
+		  AND created_at < $1
+		ORDER BY created_at
+		LIMIT $2
+	`, before, limit)
+	if err != nil {
+		log.Error("failed to query stuck orders", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	list := []*StuckOrder{}
+	for rows.Next() {
+		var o StuckOrder
+		if err := rows.Scan(&o.ID, &o.ExternalID, &o.UserID, &o.TotalAmount, &o.CreatedAt); err != nil {
+			log.Error("failed to scan stuck order", zap.Error(err))
+			return nil, ErrDB
+		}
+		list = append(list, &o)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error("stuck order iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return list, nil
+}
+
+func (r *repository) ListUnpaidConfirmedSessions(ctx context.Context, before time.Time, limit int32) ([]*UnpaidSession, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ListUnpaidConfirmedSessions"),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT s.external_id, s.user_id, s.total_amount, s.confirmed_at, o.external_id
+		FROM checkout_sessions s
+		LEFT JOIN orders o ON o.checkout_session_id = s.id
+		WHERE s.confirmed_at IS NOT NULL
+		  AND s.confirmed_at < $1
+		  AND NOT EXISTS (
+			SELECT 1 FROM payments p WHERE p.order_id = o.id
+		  )
+		ORDER BY s.confirmed_at
+		LIMIT $2
+	`, before, limit)
+	if err != nil {
+		log.Error("failed to query unpaid sessions", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	list := []*UnpaidSession{}
+	for rows.Next() {
+		var s UnpaidSession
+		if err := rows.Scan(&s.SessionExternalID, &s.UserID, &s.TotalPrice, &s.ConfirmedAt, &s.OrderExternalID); err != nil {
+			log.Error("failed to scan unpaid session", zap.Error(err))
+			return nil, ErrDB
+		}
+		list = append(list, &s)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error("unpaid session iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return list, nil
+}
+
+// GetWebhookHealth counts webhooks not yet processed and those whose
+// processing failed.
+func (r *repository) GetWebhookHealth(ctx context.Context) (*WebhookHealth, error) {
+	var h WebhookHealth
+	err := r.db.QueryRowContext(ctx, `
+		SELECT
+			COUNT(*) FILTER (WHERE processed_at IS NULL AND process_error IS NULL),
+			COUNT(*) FILTER (WHERE processed_at IS NULL AND process_error IS NOT NULL),
+			MIN(received_at) FILTER (WHERE processed_at IS NULL AND process_error IS NULL)
+		FROM payment_webhooks
+		WHERE processed_at IS NULL
+	`).Scan(&h.Pending, &h.Failed, &h.OldestPendingAt)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to get webhook health", zap.Error(err))
+		return nil, ErrDB
+	}
+	return &h, nil
+}
+
+func (r *repository) GetDashboard(ctx context.Context, dayStart time.Time, lowStock int32) (*Dashboard, error) {
+	var d Dashboard
+	err := r.db.QueryRowContext(ctx, `
+		SELECT
+			(SELECT COUNT(*) FROM orders WHERE created_at >= $1 AND deleted_at IS NULL),
+			(SELECT COALESCE(SUM(total_amount), 0) FROM orders
+			 WHERE created_at >= $1 AND deleted_at IS NULL
+			   AND status IN ('PAID', 'ACCEPTED', 'SHIPPED', 'READY_FOR_PICKUP', 'COMPLETED')),
+			(SELECT COUNT(*) FROM orders WHERE status IN ('PAID', 'ACCEPTED') AND deleted_at IS NULL),
+			(SELECT COUNT(*) FROM payment_webhooks WHERE processed_at IS NULL AND process_error IS NOT NULL),
+			(SELECT COUNT(*) FROM variants WHERE is_active AND stock <= $2)
+	`, dayStart, lowStock).Scan(&d.OrdersToday, &d.RevenueToday, &d.PendingShipments, &d.FailedWebhooks, &d.LowStockVariants)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to get dashboard", zap.Error(err))
+		return nil, ErrDB
+	}
+	return &d, nil
+}
+
+func (r *repository) ListStockIncidents(ctx context.Context, since time.Time, limit int32) ([]*StockIncident, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ListStockIncidents"),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT i.id, i.variant_id, v.name, i.checkout_session_id,
+		       i.requested, i.available, i.created_at
+		FROM stock_incidents i
+		JOIN variants v ON v.id = i.variant_id
+		WHERE i.created_at >= $1
+		ORDER BY i.created_at DESC
+		LIMIT $2
+	`, since, limit)
+	if err != nil {
+		log.Error("failed to query stock incidents", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	list := []*StockIncident{}
+	for rows.Next() {
+		var i StockIncident
+		if err := rows.Scan(
+			&i.ID, &i.VariantID, &i.VariantName, &i.CheckoutSessionID,
+			&i.Requested, &i.Available, &i.CreatedAt,
+		); err != nil {
+			log.Error("failed to scan stock incident", zap.Error(err))
+			return nil, ErrDB
+		}
+		list = append(list, &i)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error("stock incident iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return list, nil
+}
+
+func (r *repository) ListNegativeStockVariants(ctx context.Context, limit int32) ([]*NegativeStockVariant, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ListNegativeStockVariants"),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, name, stock
+		FROM variants
+		WHERE stock < 0
+		ORDER BY stock
+		LIMIT $1
+	`, limit)
+	if err != nil {
+		log.Error("failed to query negative stock variants", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	list := []*NegativeStockVariant{}
+	for rows.Next() {
+		var v NegativeStockVariant
+		if err := rows.Scan(&v.VariantID, &v.Name, &v.Stock); err != nil {
+			log.Error("failed to scan variant", zap.Error(err))
+			return nil, ErrDB
+		}
+		list = append(list, &v)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error("variant iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return list, nil
+}
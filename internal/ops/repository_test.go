@@ -0,0 +1,132 @@
+package ops
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_ListStuckPendingOrders(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+	before := time.Now()
+	userID := int32(3)
+
+	mock.ExpectQuery(`SELECT id, external_id, user_id, total_amount, created_at FROM orders WHERE status = 'PENDING_PAYMENT'`).
+		WithArgs(before, int32(10)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "external_id", "user_id", "total_amount", "created_at"}).
+			AddRow(1, "ORD-1", userID, 50000, before.Add(-time.Hour)))
+
+	list, err := repo.ListStuckPendingOrders(ctx, before, 10)
+	assert.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, "ORD-1", list[0].ExternalID)
+	assert.Equal(t, userID, *list[0].UserID)
+
+	mock.ExpectQuery(`FROM orders`).WillReturnError(errors.New("boom"))
+	_, err = repo.ListStuckPendingOrders(ctx, before, 10)
+	assert.ErrorIs(t, err, ErrDB)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ListUnpaidConfirmedSessions(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+	before := time.Now()
+	confirmedAt := before.Add(-time.Hour)
+
+	mock.ExpectQuery(`SELECT s.external_id, s.user_id, s.total_amount, s.confirmed_at, o.external_id FROM checkout_sessions s LEFT JOIN orders o`).
+		WithArgs(before, int32(10)).
+		WillReturnRows(sqlmock.NewRows([]string{"external_id", "user_id", "total_amount", "confirmed_at", "external_id"}).
+			AddRow("sess-1", 3, 50000, confirmedAt, "ORD-1"))
+
+	list, err := repo.ListUnpaidConfirmedSessions(ctx, before, 10)
+	assert.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, "sess-1", list[0].SessionExternalID)
+	assert.Equal(t, confirmedAt, list[0].ConfirmedAt)
+
+	mock.ExpectQuery(`FROM checkout_sessions s`).WillReturnError(errors.New("boom"))
+	_, err = repo.ListUnpaidConfirmedSessions(ctx, before, 10)
+	assert.ErrorIs(t, err, ErrDB)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_GetWebhookHealth(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	oldest := time.Now().Add(-time.Hour)
+
+	mock.ExpectQuery(`FROM payment_webhooks WHERE processed_at IS NULL`).
+		WillReturnRows(sqlmock.NewRows([]string{"pending", "failed", "oldest"}).AddRow(4, 2, oldest))
+
+	h, err := repo.GetWebhookHealth(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(4), h.Pending)
+	assert.Equal(t, int64(2), h.Failed)
+	assert.Equal(t, oldest, *h.OldestPendingAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ListStockIncidents(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	since := time.Now().Add(-24 * time.Hour)
+
+	mock.ExpectQuery(`FROM stock_incidents i JOIN variants v ON v.id = i.variant_id WHERE i.created_at >= \$1`).
+		WithArgs(since, int32(50)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "variant_id", "name", "checkout_session_id", "requested", "available", "created_at"}).
+			AddRow(1, "v1", "Red / M", nil, 3, 1, time.Now()))
+
+	list, err := repo.ListStockIncidents(context.Background(), since, 50)
+	assert.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Nil(t, list[0].CheckoutSessionID)
+	assert.Equal(t, int32(3), list[0].Requested)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_GetDashboard(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	dayStart := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(`SELECT \(SELECT COUNT\(\*\) FROM orders WHERE created_at >= \$1 AND deleted_at IS NULL\)`).
+		WithArgs(dayStart, int32(5)).
+		WillReturnRows(sqlmock.NewRows([]string{"orders", "revenue", "pending", "failed", "low"}).
+			AddRow(12, 1500000, 4, 1, 7))
+
+	d, err := repo.GetDashboard(context.Background(), dayStart, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, &Dashboard{OrdersToday: 12, RevenueToday: 1500000, PendingShipments: 4, FailedWebhooks: 1, LowStockVariants: 7}, d)
+
+	mock.ExpectQuery(`FROM payment_webhooks`).WillReturnError(errors.New("db error"))
+	_, err = repo.GetDashboard(context.Background(), dayStart, 5)
+	assert.ErrorIs(t, err, ErrDB)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,156 @@
+package ops
+
+import (
+	"context"
+	"sync"
+	"time"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+// defaultIncidentWindow is how far back StockOversell looks when the
+// caller gives no start.
+const defaultIncidentWindow = 7 * 24 * time.Hour
+
+// Service backs the admin operations dashboard. Every method is admin
+// only; olderThan and limit fall back to defaults when zero.
+type Service interface {
+	StuckPendingOrders(ctx context.Context, olderThan time.Duration, limit int32) ([]*StuckOrder, error)
+	UnpaidConfirmedSessions(ctx context.Context, olderThan time.Duration, limit int32) ([]*UnpaidSession, error)
+	WebhookHealth(ctx context.Context) (*WebhookHealth, error)
+	StockOversell(ctx context.Context, since *time.Time, limit int32) ([]*StockIncident, []*NegativeStockVariant, error)
+	// Dashboard returns the ops home screen summary, computed at most once
+	// per DashboardTTL.
+	Dashboard(ctx context.Context) (*Dashboard, error)
+}
+
+type service struct {
+	repo Repository
+	now  func() time.Time
+	loc  *time.Location
+
+	mu        sync.Mutex
+	dashboard *Dashboard
+}
+
+func NewService(repo Repository) Service {
+	loc, err := time.LoadLocation("Asia/Jakarta")
+	if err != nil {
+		logger.L().Error("failed to load Jakarta location, defaulting to UTC", zap.Error(err))
+		loc = time.UTC
+	}
+	return &service{repo: repo, now: time.Now, loc: loc}
+}
+
+func (s *service) StuckPendingOrders(ctx context.Context, olderThan time.Duration, limit int32) ([]*StuckOrder, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return s.repo.ListStuckPendingOrders(ctx, s.before(olderThan), clampLimit(limit))
+}
+
+func (s *service) UnpaidConfirmedSessions(ctx context.Context, olderThan time.Duration, limit int32) ([]*UnpaidSession, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return s.repo.ListUnpaidConfirmedSessions(ctx, s.before(olderThan), clampLimit(limit))
+}
+
+func (s *service) WebhookHealth(ctx context.Context) (*WebhookHealth, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return s.repo.GetWebhookHealth(ctx)
+}
+
+func (s *service) StockOversell(
+	ctx context.Context,
+	since *time.Time,
+	limit int32,
+) ([]*StockIncident, []*NegativeStockVariant, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "StockOversell"),
+	)
+
+	if err := requireAdmin(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	from := s.now().Add(-defaultIncidentWindow)
+	if since != nil {
+		from = *since
+	}
+	limit = clampLimit(limit)
+
+	incidents, err := s.repo.ListStockIncidents(ctx, from, limit)
+	if err != nil {
+		log.Error("failed to list stock incidents", zap.Error(err))
+		return nil, nil, err
+	}
+
+	negative, err := s.repo.ListNegativeStockVariants(ctx, limit)
+	if err != nil {
+		log.Error("failed to list negative stock variants", zap.Error(err))
+		return nil, nil, err
+	}
+
+	return incidents, negative, nil
+}
+
+func (s *service) Dashboard(ctx context.Context) (*Dashboard, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	// Held across the query so a burst of admins opening the screen at
+	// expiry computes it once.
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if s.dashboard != nil && now.Sub(s.dashboard.GeneratedAt) < DashboardTTL {
+		return s.dashboard, nil
+	}
+
+	local := now.In(s.loc)
+	dayStart := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, s.loc)
+
+	d, err := s.repo.GetDashboard(ctx, dayStart, LowStockThreshold)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to compute dashboard", zap.Error(err))
+		return nil, err
+	}
+	d.GeneratedAt = now
+	s.dashboard = d
+	return d, nil
+}
+
+func (s *service) before(olderThan time.Duration) time.Time {
+	if olderThan <= 0 {
+		olderThan = defaultStuckAfter
+	}
+	return s.now().Add(-olderThan)
+}
+
+func clampLimit(limit int32) int32 {
+	if limit <= 0 {
+		return defaultLimit
+	}
+	if limit > maxLimit {
+		return maxLimit
+	}
+	return limit
+}
+
+func requireAdmin(ctx context.Context) error {
+	if _, ok := utils.GetUserIDFromContext(ctx); !ok {
+		return ErrUnauthenticated
+	}
+	if !utils.IsAdmin(ctx) {
+		return ErrForbidden
+	}
+	return nil
+}
@@ -0,0 +1,211 @@
+package ops
+
+import (
+	"context"
+	"testing"
+	"time"
+	"warimas-be/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// --- Mocks ---
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) ListStuckPendingOrders(ctx context.Context, before time.Time, limit int32) ([]*StuckOrder, error) {
+	args := m.Called(ctx, before, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*StuckOrder), args.Error(1)
+}
+
+func (m *MockRepository) ListUnpaidConfirmedSessions(ctx context.Context, before time.Time, limit int32) ([]*UnpaidSession, error) {
+	args := m.Called(ctx, before, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*UnpaidSession), args.Error(1)
+}
+
+func (m *MockRepository) GetWebhookHealth(ctx context.Context) (*WebhookHealth, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*WebhookHealth), args.Error(1)
+}
+
+func (m *MockRepository) ListStockIncidents(ctx context.Context, since time.Time, limit int32) ([]*StockIncident, error) {
+	args := m.Called(ctx, since, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*StockIncident), args.Error(1)
+}
+
+func (m *MockRepository) ListNegativeStockVariants(ctx context.Context, limit int32) ([]*NegativeStockVariant, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*NegativeStockVariant), args.Error(1)
+}
+
+func (m *MockRepository) GetDashboard(ctx context.Context, dayStart time.Time, lowStock int32) (*Dashboard, error) {
+	args := m.Called(ctx, dayStart, lowStock)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Dashboard), args.Error(1)
+}
+
+// --- Tests ---
+
+func newTestService(repo Repository, now time.Time) *service {
+	return &service{repo: repo, now: func() time.Time { return now }, loc: time.UTC}
+}
+
+func TestService_StuckPendingOrders(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	adminCtx := utils.SetUserContext(context.Background(), 1, "admin@example.com", "ADMIN")
+
+	t.Run("DefaultsThresholdAndLimit", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := newTestService(mockRepo, now)
+
+		mockRepo.On("ListStuckPendingOrders", adminCtx, now.Add(-defaultStuckAfter), int32(defaultLimit)).
+			Return([]*StuckOrder{{ID: 7}}, nil)
+
+		list, err := svc.StuckPendingOrders(adminCtx, 0, 0)
+		assert.NoError(t, err)
+		assert.Len(t, list, 1)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("ClampsLimit", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := newTestService(mockRepo, now)
+
+		mockRepo.On("ListStuckPendingOrders", adminCtx, now.Add(-2*time.Hour), int32(maxLimit)).
+			Return([]*StuckOrder{}, nil)
+
+		_, err := svc.StuckPendingOrders(adminCtx, 2*time.Hour, 10000)
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := newTestService(mockRepo, now)
+		ctx := utils.SetUserContext(context.Background(), 2, "user@example.com", "USER")
+
+		_, err := svc.StuckPendingOrders(ctx, 0, 0)
+		assert.ErrorIs(t, err, ErrForbidden)
+		mockRepo.AssertNotCalled(t, "ListStuckPendingOrders", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		svc := newTestService(new(MockRepository), now)
+
+		_, err := svc.StuckPendingOrders(context.Background(), 0, 0)
+		assert.ErrorIs(t, err, ErrUnauthenticated)
+	})
+}
+
+func TestService_StockOversell(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	adminCtx := utils.SetUserContext(context.Background(), 1, "admin@example.com", "ADMIN")
+
+	t.Run("DefaultWindow", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := newTestService(mockRepo, now)
+
+		mockRepo.On("ListStockIncidents", adminCtx, now.Add(-defaultIncidentWindow), int32(defaultLimit)).
+			Return([]*StockIncident{{ID: 1, VariantID: "v1"}}, nil)
+		mockRepo.On("ListNegativeStockVariants", adminCtx, int32(defaultLimit)).
+			Return([]*NegativeStockVariant{{VariantID: "v2", Stock: -3}}, nil)
+
+		incidents, negative, err := svc.StockOversell(adminCtx, nil, 0)
+		assert.NoError(t, err)
+		assert.Len(t, incidents, 1)
+		assert.Equal(t, int32(-3), negative[0].Stock)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("ExplicitSince", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := newTestService(mockRepo, now)
+		since := now.Add(-time.Hour)
+
+		mockRepo.On("ListStockIncidents", adminCtx, since, int32(20)).Return([]*StockIncident{}, nil)
+		mockRepo.On("ListNegativeStockVariants", adminCtx, int32(20)).Return([]*NegativeStockVariant{}, nil)
+
+		_, _, err := svc.StockOversell(adminCtx, &since, 20)
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("RepoError", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := newTestService(mockRepo, now)
+
+		mockRepo.On("ListStockIncidents", adminCtx, mock.Anything, mock.Anything).Return(nil, ErrDB)
+
+		_, _, err := svc.StockOversell(adminCtx, nil, 0)
+		assert.ErrorIs(t, err, ErrDB)
+		mockRepo.AssertNotCalled(t, "ListNegativeStockVariants", mock.Anything, mock.Anything)
+	})
+}
+
+func TestService_Dashboard(t *testing.T) {
+	ctx := utils.SetUserContext(context.Background(), 1, "admin@example.com", "ADMIN")
+
+	t.Run("CachedForTTL", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		now := time.Date(2026, 3, 2, 9, 15, 0, 0, time.UTC)
+		svc := newTestService(mockRepo, now)
+
+		dayStart := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+		mockRepo.On("GetDashboard", ctx, dayStart, int32(LowStockThreshold)).
+			Return(&Dashboard{OrdersToday: 12, RevenueToday: 1500000}, nil).Twice()
+
+		d, err := svc.Dashboard(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(12), d.OrdersToday)
+		assert.Equal(t, now, d.GeneratedAt)
+
+		svc.now = func() time.Time { return now.Add(DashboardTTL - time.Second) }
+		_, err = svc.Dashboard(ctx)
+		assert.NoError(t, err)
+		mockRepo.AssertNumberOfCalls(t, "GetDashboard", 1)
+
+		svc.now = func() time.Time { return now.Add(DashboardTTL) }
+		d, err = svc.Dashboard(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, now.Add(DashboardTTL), d.GeneratedAt)
+		mockRepo.AssertNumberOfCalls(t, "GetDashboard", 2)
+	})
+
+	t.Run("ErrorNotCached", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := newTestService(mockRepo, time.Now())
+
+		mockRepo.On("GetDashboard", ctx, mock.Anything, mock.Anything).Return(nil, ErrDB)
+
+		_, err := svc.Dashboard(ctx)
+		assert.ErrorIs(t, err, ErrDB)
+		assert.Nil(t, svc.dashboard)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		svc := newTestService(new(MockRepository), time.Now())
+
+		_, err := svc.Dashboard(context.Background())
+		assert.ErrorIs(t, err, ErrUnauthenticated)
+	})
+}
@@ -0,0 +1,64 @@
+package order
+
+import (
+	"strconv"
+	"time"
+)
+
+// AdjustmentKind is whether an adjustment lowers the order's total or
+// raises it.
+type AdjustmentKind string
+
+const (
+	AdjustmentKindDiscount AdjustmentKind = "DISCOUNT"
+	AdjustmentKindCharge   AdjustmentKind = "CHARGE"
+)
+
+func validAdjustmentKind(k AdjustmentKind) bool {
+	return k == AdjustmentKindDiscount || k == AdjustmentKindCharge
+}
+
+type AdjustmentStatus string
+
+const (
+	AdjustmentPending  AdjustmentStatus = "PENDING"
+	AdjustmentApplied  AdjustmentStatus = "APPLIED"
+	AdjustmentRejected AdjustmentStatus = "REJECTED"
+)
+
+// maxAdjustmentReason bounds the reason an admin gives.
+const maxAdjustmentReason = 500
+
+// OrderAdjustment is a discount or extra charge an admin puts on an order
+// waiting for payment, such as a goodwill discount. It stays pending until
+// a second admin approves it; approving it changes the order's total and
+// replaces the payment invoice.
+type OrderAdjustment struct {
+	ID          int64
+	OrderID     int32
+	Kind        AdjustmentKind
+	Amount      int64
+	Reason      string
+	Status      AdjustmentStatus
+	RequestedBy int32
+	ReviewedBy  *int32
+	CreatedAt   time.Time
+	ReviewedAt  *time.Time
+}
+
+// Delta is what the adjustment does to the order's total.
+func (a *OrderAdjustment) Delta() int64 {
+	if a.Kind == AdjustmentKindDiscount {
+		return -a.Amount
+	}
+	return a.Amount
+}
+
+// ParseAdjustmentID reads an order adjustment id from the API.
+func ParseAdjustmentID(id string) (int64, error) {
+	n, err := strconv.ParseInt(id, 10, 64)
+	if err != nil || n <= 0 {
+		return 0, ErrAdjustmentNotFound
+	}
+	return n, nil
+}
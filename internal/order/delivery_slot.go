@@ -0,0 +1,71 @@
+package order
+
+import (
+	"strconv"
+	"time"
+)
+
+// sameDayLeadTime is how long before a delivery slot starts it stops
+// taking orders, so the courier can still be booked.
+const sameDayLeadTime = time.Hour
+
+// DeliverySlot is a same-day delivery window in a region. It takes at
+// most Capacity instant orders a day.
+type DeliverySlot struct {
+	ID     int32
+	Region string
+	// StartsAt and EndsAt are local times of day as "15:04".
+	StartsAt  string
+	EndsAt    string
+	Capacity  int
+	IsActive  bool
+	UpdatedAt time.Time
+}
+
+// DeliverySlotOption is a slot offered to a checkout for today.
+type DeliverySlotOption struct {
+	Slot  *DeliverySlot
+	Start time.Time
+	End   time.Time
+	// Remaining is how many more orders the slot takes today.
+	Remaining int
+	// Available is false once the slot is full or starts too soon.
+	Available bool
+}
+
+// DeliveryBooking is the slot an instant order holds.
+type DeliveryBooking struct {
+	SlotID int32
+	// Date is the local day of the delivery, at midnight.
+	Date        time.Time
+	WindowStart time.Time
+	WindowEnd   time.Time
+}
+
+// ParseDeliverySlotID reads a delivery slot id from the API.
+func ParseDeliverySlotID(id string) (int32, error) {
+	n, err := strconv.ParseInt(id, 10, 32)
+	if err != nil || n <= 0 {
+		return 0, ErrDeliverySlotNotFound
+	}
+	return int32(n), nil
+}
+
+// window is when the slot runs on day, a local midnight.
+func (d *DeliverySlot) window(day time.Time) (time.Time, time.Time, error) {
+	starts, err := parseClock(d.StartsAt)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	ends, err := parseClock(d.EndsAt)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return day.Add(starts), day.Add(ends), nil
+}
+
+// localMidnight is the start of the local day of t.
+func localMidnight(t time.Time, loc *time.Location) time.Time {
+	local := t.In(loc)
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+}
@@ -1,9 +1,88 @@
 package order
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"warimas-be/internal/apperr"
+)
 
 var (
-	ErrAddressNotFound = errors.New("address not found")
-	ErrOrderNotFound   = errors.New("order not found")
-	ErrUnauthorized    = errors.New("unauthorized")
+	ErrAddressNotFound      = apperr.NotFound("address not found")
+	ErrOrderNotFound        = apperr.NotFound("order not found")
+	ErrUnauthorized         = apperr.Unauthenticated("unauthorized")
+	ErrForbidden            = apperr.Forbidden("forbidden")
+	ErrSessionForbidden     = apperr.Forbidden("forbidden: cannot update others' sessions")
+	ErrGuestMismatch        = apperr.Forbidden("forbidden: guest ID mismatch")
+	ErrTooManyGuestSessions = apperr.Conflict(fmt.Sprintf("at most %d checkouts can be open at once; finish one or sign in", MaxOpenGuestSessions))
+	ErrWalletRequiresUser   = errors.New("wallet payment requires a signed-in user")
+	ErrInsufficientWallet   = errors.New("insufficient wallet balance")
+	ErrInvalidWalletUse     = errors.New("invalid wallet amount")
+	ErrSessionNotEditable   = errors.New("checkout session is not editable")
+	ErrSessionExpired       = errors.New("checkout session expired")
+	ErrVoucherNotFound      = apperr.NotFound("voucher not found")
+	ErrVoucherNotOwned      = errors.New("voucher belongs to another customer")
+	ErrVoucherInactive      = errors.New("voucher is not active")
+	ErrVoucherExhausted     = errors.New("voucher usage limit reached")
+	ErrVoucherMinSubtotal   = errors.New("order subtotal below voucher minimum")
+	ErrInvalidPointsUse     = errors.New("invalid loyalty points amount")
+	ErrInsufficientPoints   = errors.New("insufficient loyalty points")
+	ErrInsufficientStock    = errors.New("insufficient stock")
+	ErrOrderNotPacked       = errors.New("order must be packed before it ships")
+	ErrInvalidAdminOrder    = errors.New("invalid admin order input")
+	ErrSessionItemMissing   = errors.New("checkout session item not found")
+	ErrSessionEmpty         = errors.New("checkout session has no items")
+	ErrBelowMinimumOrder    = errors.New("order subtotal below the minimum order amount")
+	ErrInvalidRule          = errors.New("invalid checkout rule")
+	ErrDatePresetConflict   = errors.New("datePreset cannot be combined with dateFrom or dateTo")
+	ErrSellerOnVacation     = errors.New("a seller in this checkout is on vacation")
+	ErrPaymentNotPending    = errors.New("order is no longer waiting for this payment")
+	ErrVariantUnavailable   = errors.New("an item in this checkout is no longer sold")
+
+	ErrCODPhoneUnverified = apperr.Invalid("cash on delivery needs a verified phone number")
+
+	ErrShippingAddressNotSet = apperr.Invalid("shipping address not set")
+	ErrInvalidShippingMethod = apperr.Invalid("unknown shipping method")
+	ErrAddressNotPinned      = apperr.Invalid("instant delivery needs the address pinned on the map")
+	ErrNoInstantFromOrigin   = apperr.Invalid("a seller in this checkout does not send instant deliveries")
+	ErrOutsideInstantRadius  = apperr.Invalid(fmt.Sprintf("instant delivery only reaches addresses within %g km of every seller", InstantRadiusKm))
+	ErrTooHeavyForInstant    = apperr.Invalid(fmt.Sprintf("instant delivery takes parcels of at most %d kg", maxInstantParcelGrams/1000))
+
+	ErrPickupLocationNotFound = apperr.NotFound("pickup location not found")
+	ErrInvalidPickupLocation  = apperr.Invalid("invalid pickup location")
+	ErrPickupNotChosen        = apperr.Invalid("self pickup needs a pickup location and slot")
+	ErrPickupSlotUnavailable  = apperr.Invalid("pickup slot is not available")
+	ErrNotPickupOrder         = apperr.Invalid("order is not a self pickup order")
+	ErrNotReadyForPickup      = apperr.Invalid("order is not ready for pickup")
+	ErrPickupCodeMismatch     = apperr.Invalid("pickup code does not match")
+	ErrPickupCodeRequired     = apperr.Invalid("an order waiting for pickup is completed by verifying its pickup code")
+	ErrPickupOrderShipped     = apperr.Invalid("self pickup orders are collected, not shipped")
+
+	ErrDeliverySlotNotFound     = apperr.NotFound("delivery slot not found")
+	ErrInvalidDeliverySlot      = apperr.Invalid("invalid delivery slot")
+	ErrDeliverySlotNeedsInstant = apperr.Invalid("delivery slots are only for instant delivery")
+	ErrDeliverySlotRequired     = apperr.Invalid("choose a delivery slot for instant delivery")
+	ErrDeliverySlotUnavailable  = apperr.Invalid("delivery slot is not available")
+	ErrDeliverySlotFull         = apperr.Conflict("delivery slot is full")
+
+	ErrInsuranceUnavailable = apperr.Invalid("shipping insurance is not offered for this shipping method")
+
+	ErrPolicyNotFound        = apperr.NotFound("policy not found")
+	ErrInvalidPolicy         = apperr.Invalid("invalid policy")
+	ErrPolicyNotAccepted     = apperr.Invalid("accept the current terms and refund policy to place the order")
+	ErrPolicyVersionConflict = apperr.Conflict("another version of this policy was just published")
+
+	ErrReasonNotFound       = apperr.NotFound("order reason not found")
+	ErrInvalidReason        = apperr.Invalid("invalid order reason")
+	ErrReasonCodeTaken      = apperr.Conflict("another order reason already uses this code")
+	ErrCancelReasonRequired = apperr.Invalid("cancelling an order needs an active cancellation reason")
+
+	ErrAdjustmentNotFound     = apperr.NotFound("order adjustment not found")
+	ErrInvalidAdjustment      = apperr.Invalid("an adjustment needs a known kind, a positive amount and a reason")
+	ErrOrderNotAdjustable     = apperr.Invalid("only orders waiting for payment can be adjusted")
+	ErrAdjustmentTooLarge     = apperr.Invalid("a discount must leave an amount to pay")
+	ErrAdjustmentPending      = apperr.Conflict("the order already has an adjustment waiting for approval")
+	ErrAdjustmentNotPending   = apperr.Conflict("order adjustment was already reviewed")
+	ErrAdjustmentSelfApproval = apperr.Forbidden("an adjustment is approved by another admin than the one who requested it")
+
+	PgUniqueViolation = "23505"
 )
@@ -0,0 +1,83 @@
+package order
+
+import (
+	"math"
+	"warimas-be/internal/address"
+	"warimas-be/internal/geo"
+)
+
+// ShippingMethod is how a checkout's parcels travel to the customer.
+type ShippingMethod string
+
+const (
+	ShippingMethodStandard ShippingMethod = "STANDARD"
+	// ShippingMethodInstant is a same-day courier ride from every origin,
+	// offered only to pinned addresses within InstantRadiusKm of them.
+	ShippingMethodInstant ShippingMethod = "INSTANT"
+	// ShippingMethodSelfPickup skips the courier: the customer collects
+	// the order at a pickup location in a slot they chose.
+	ShippingMethodSelfPickup ShippingMethod = "SELF_PICKUP"
+)
+
+// InstantRadiusKm is the farthest an instant courier rides from an
+// origin, measured in a straight line.
+const InstantRadiusKm = 15.0
+
+// maxInstantParcelGrams is what fits on an instant courier's motorbike.
+const maxInstantParcelGrams = 20000
+
+// An instant courier charges per ride plus per started kilometre.
+const (
+	instantBaseFee  = 10000
+	instantPerKmFee = 2500
+)
+
+// ShippingOption is one way a session's parcels can reach its address.
+type ShippingOption struct {
+	Method    ShippingMethod
+	Fee       int
+	Available bool
+	// DistanceKm is how far the farthest origin is from the address; only
+	// set for instant delivery once every location is known.
+	DistanceKm *float64
+	// Reason says why an unavailable option cannot be picked.
+	Reason *string
+	// InsuranceFee is what insuring the session's goods costs with this
+	// method's courier; nil when it does not insure.
+	InsuranceFee *int
+}
+
+func validShippingMethod(m ShippingMethod) bool {
+	return m == ShippingMethodStandard || m == ShippingMethodInstant || m == ShippingMethodSelfPickup
+}
+
+// instantQuote is the instant courier fee for sending every parcel to
+// addr and the distance from the farthest origin, nil while a location is
+// missing. The error says why instant delivery cannot take the parcels.
+func instantQuote(parcels []ShippingParcel, addr *address.Address) (int, *float64, error) {
+	if addr.Location == nil {
+		return 0, nil, ErrAddressNotPinned
+	}
+
+	farthest := 0.0
+	distances := make([]float64, len(parcels))
+	for i, p := range parcels {
+		if p.OriginLocation == nil {
+			return 0, nil, ErrNoInstantFromOrigin
+		}
+		distances[i] = geo.DistanceKm(*p.OriginLocation, *addr.Location)
+		farthest = math.Max(farthest, distances[i])
+	}
+	if farthest > InstantRadiusKm {
+		return 0, &farthest, ErrOutsideInstantRadius
+	}
+
+	fee := 0
+	for i, p := range parcels {
+		if p.WeightGrams > maxInstantParcelGrams {
+			return 0, &farthest, ErrTooHeavyForInstant
+		}
+		fee += instantBaseFee + int(math.Ceil(distances[i]))*instantPerKmFee
+	}
+	return fee, &farthest, nil
+}
@@ -0,0 +1,33 @@
+package order
+
+// insuranceBasisPoints is what each courier charges to insure a parcel,
+// in hundredths of a percent of the goods' value. Self pickup never
+// leaves the platform, so it is not insured.
+var insuranceBasisPoints = map[ShippingMethod]int{
+	ShippingMethodStandard: 20,
+	ShippingMethodInstant:  50,
+}
+
+// insuranceQuote is the fee to insure goods worth value with method's
+// courier, rounded up to the rupiah. ok is false when the courier does
+// not insure.
+func insuranceQuote(method ShippingMethod, value int) (fee int, ok bool) {
+	bp, ok := insuranceBasisPoints[method]
+	if !ok {
+		return 0, false
+	}
+	if value <= 0 {
+		return 0, true
+	}
+	return (value*bp + 9999) / 10000, true
+}
+
+// InsuranceFee is what the session pays to insure its goods, 0 unless
+// insurance was chosen and its shipping method offers it.
+func (s *CheckoutSession) InsuranceFee() int {
+	if !s.Insured {
+		return 0
+	}
+	fee, _ := insuranceQuote(s.ShippingMethod, s.Subtotal)
+	return fee
+}
@@ -0,0 +1,238 @@
+// Package internalapi serves order operations to sibling services (the
+// fulfillment worker, the reconciliation tool) as plain JSON over HTTP, so
+// they need neither GraphQL nor a user token. Callers authenticate with an
+// API key; each route needs its own scope.
+package internalapi
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"warimas-be/internal/apikey"
+	"warimas-be/internal/apperr"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/order"
+	"warimas-be/internal/payment"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+// maxBody caps request bodies; every request here is a few IDs.
+const maxBody = 64 << 10
+
+type Handler struct {
+	OrderSvc order.Service
+}
+
+func NewHandler(orderSvc order.Service) *Handler {
+	return &Handler{OrderSvc: orderSvc}
+}
+
+// Routes returns the API, mounted under /internal/v1/. It expects
+// middleware.APIKeyMiddleware in front of it.
+func (h *Handler) Routes() http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle("POST /internal/v1/orders/from-session",
+		requireScope(apikey.ScopeOrdersCreateFromSession, h.createFromSession))
+	mux.Handle("POST /internal/v1/orders/{externalId}/mark-paid",
+		requireScope(apikey.ScopeOrdersMarkPaid, h.markAsPaid))
+	mux.Handle("GET /internal/v1/orders/{externalId}",
+		requireScope(apikey.ScopeOrdersRead, h.getOrder))
+	return mux
+}
+
+type createFromSessionRequest struct {
+	SessionExternalID string `json:"sessionExternalId"`
+}
+
+type markAsPaidRequest struct {
+	PaymentRequestID  string `json:"paymentRequestId"`
+	PaymentProviderID string `json:"paymentProviderId"`
+	// What the provider captured; optional, the payment row keeps its
+	// values when left out.
+	Amount      int64      `json:"amount,omitempty"`
+	ChannelCode string     `json:"channelCode,omitempty"`
+	PaidAt      *time.Time `json:"paidAt,omitempty"`
+}
+
+type orderResponse struct {
+	ID            int32          `json:"id"`
+	ExternalID    string         `json:"externalId"`
+	UserID        *int32         `json:"userId,omitempty"`
+	Status        string         `json:"status"`
+	TotalAmount   uint           `json:"totalAmount"`
+	WalletAmount  uint           `json:"walletAmount"`
+	Currency      string         `json:"currency"`
+	InvoiceNumber *string        `json:"invoiceNumber,omitempty"`
+	Items         []itemResponse `json:"items"`
+	CreatedAt     time.Time      `json:"createdAt"`
+	UpdatedAt     time.Time      `json:"updatedAt"`
+}
+
+type itemResponse struct {
+	VariantID   string  `json:"variantId"`
+	ProductName string  `json:"productName"`
+	VariantName string  `json:"variantName"`
+	Quantity    int     `json:"quantity"`
+	Price       float64 `json:"price"`
+	Subtotal    float64 `json:"subtotal"`
+}
+
+func (h *Handler) createFromSession(w http.ResponseWriter, r *http.Request) {
+	log := logger.FromCtx(r.Context()).With(
+		zap.String("layer", "internalapi"),
+		zap.String("method", "CreateFromSession"),
+	)
+
+	var req createFromSessionRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.SessionExternalID == "" {
+		utils.WriteJSONError(w, "sessionExternalId is required", http.StatusBadRequest)
+		return
+	}
+
+	o, err := h.OrderSvc.CreateFromSession(r.Context(), req.SessionExternalID)
+	if err != nil {
+		log.Warn("failed to create order from session", zap.String("session_id", req.SessionExternalID), zap.Error(err))
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toResponse(o))
+}
+
+func (h *Handler) markAsPaid(w http.ResponseWriter, r *http.Request) {
+	externalID := r.PathValue("externalId")
+	log := logger.FromCtx(r.Context()).With(
+		zap.String("layer", "internalapi"),
+		zap.String("method", "MarkAsPaid"),
+		zap.String("external_id", externalID),
+	)
+
+	var req markAsPaidRequest
+	if !decode(w, r, &req) {
+		return
+	}
+	if req.PaymentRequestID == "" {
+		utils.WriteJSONError(w, "paymentRequestId is required", http.StatusBadRequest)
+		return
+	}
+
+	capture := payment.Capture{
+		ExternalReference: req.PaymentRequestID,
+		ProviderPaymentID: req.PaymentProviderID,
+		Amount:            req.Amount,
+		ChannelCode:       req.ChannelCode,
+	}
+	if req.PaidAt != nil {
+		capture.PaidAt = *req.PaidAt
+	}
+
+	err := h.OrderSvc.MarkAsPaid(r.Context(), externalID, capture)
+	if err != nil {
+		log.Warn("failed to mark order as paid", zap.Error(err))
+		writeError(w, err)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) getOrder(w http.ResponseWriter, r *http.Request) {
+	o, err := h.OrderSvc.GetOrderForWebhook(r.Context(), r.PathValue("externalId"))
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, toResponse(o))
+}
+
+// requireScope lets the request through only if it carries an API key with
+// scope.
+func requireScope(scope apikey.Scope, next http.HandlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := utils.GetServicePrincipalFromContext(r.Context()); !ok {
+			utils.WriteJSONError(w, "api key required", http.StatusUnauthorized)
+			return
+		}
+		if !utils.HasScope(r.Context(), string(scope)) {
+			utils.WriteJSONError(w, "missing scope "+string(scope), http.StatusForbidden)
+			return
+		}
+		next(w, r)
+	})
+}
+
+func decode(w http.ResponseWriter, r *http.Request, v any) bool {
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBody))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(v); err != nil {
+		utils.WriteJSONError(w, "invalid request body", http.StatusBadRequest)
+		return false
+	}
+	return true
+}
+
+// writeError maps order errors to statuses. Classified errors carry their
+// own status. The order repository still reports some failures as plain
+// errors (a missing reference ID, a failed session query), so those are
+// matched by message; anything else the service returned is a rejected
+// request.
+func writeError(w http.ResponseWriter, err error) {
+	if appErr, ok := apperr.As(err); ok && appErr.Code != apperr.CodeInternal {
+		utils.WriteJSONError(w, appErr.Message, appErr.HTTPStatus())
+		return
+	}
+
+	msg := err.Error()
+	switch {
+	case errors.Is(err, sql.ErrNoRows), strings.HasPrefix(msg, "order not found"):
+		utils.WriteJSONError(w, "not found", http.StatusNotFound)
+	case errors.Is(err, order.ErrDB),
+		msg == "failed to get order", msg == "failed to load checkout session":
+		utils.WriteJSONError(w, "internal error", http.StatusInternalServerError)
+	default:
+		utils.WriteJSONError(w, msg, http.StatusUnprocessableEntity)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func toResponse(o *order.Order) orderResponse {
+	res := orderResponse{
+		ID:            o.ID,
+		ExternalID:    o.ExternalID,
+		UserID:        o.UserID,
+		Status:        string(o.Status),
+		TotalAmount:   o.TotalAmount,
+		WalletAmount:  o.WalletAmount,
+		Currency:      o.Currency,
+		InvoiceNumber: o.InvoiceNumber,
+		Items:         make([]itemResponse, 0, len(o.Items)),
+		CreatedAt:     o.CreatedAt,
+		UpdatedAt:     o.UpdatedAt,
+	}
+	for _, it := range o.Items {
+		res.Items = append(res.Items, itemResponse{
+			VariantID:   it.VariantID,
+			ProductName: it.ProductName,
+			VariantName: it.VariantName,
+			Quantity:    it.Quantity,
+			Price:       it.Price,
+			Subtotal:    it.Subtotal,
+		})
+	}
+	return res
+}
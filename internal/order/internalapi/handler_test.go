@@ -0,0 +1,222 @@
+package internalapi
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"warimas-be/internal/apikey"
+	"warimas-be/internal/order"
+	"warimas-be/internal/payment"
+	"warimas-be/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockOrderService struct {
+	order.Service
+	mock.Mock
+}
+
+func (m *MockOrderService) CreateFromSession(ctx context.Context, externalID string) (*order.Order, error) {
+	args := m.Called(ctx, externalID)
+	o, _ := args.Get(0).(*order.Order)
+	return o, args.Error(1)
+}
+
+func (m *MockOrderService) MarkAsPaid(ctx context.Context, referenceID string, capture payment.Capture) error {
+	args := m.Called(ctx, referenceID, capture)
+	return args.Error(0)
+}
+
+func (m *MockOrderService) GetOrderForWebhook(ctx context.Context, externalID string) (*order.Order, error) {
+	args := m.Called(ctx, externalID)
+	o, _ := args.Get(0).(*order.Order)
+	return o, args.Error(1)
+}
+
+// serve sends the request through the routes as a service holding scopes;
+// nil scopes means no API key.
+func serve(svc order.Service, scopes []string, method, path, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if scopes != nil {
+		req = req.WithContext(utils.WithServicePrincipal(req.Context(), &utils.ServicePrincipal{
+			KeyID:  1,
+			Name:   "fulfillment-worker",
+			Scopes: scopes,
+		}))
+	}
+	rr := httptest.NewRecorder()
+	NewHandler(svc).Routes().ServeHTTP(rr, req)
+	return rr
+}
+
+func TestHandler_CreateFromSession(t *testing.T) {
+	scopes := []string{string(apikey.ScopeOrdersCreateFromSession)}
+
+	t.Run("Success", func(t *testing.T) {
+		svc := new(MockOrderService)
+		svc.On("CreateFromSession", mock.Anything, "sess-1").
+			Return(&order.Order{ID: 7, ExternalID: "ord-7", Status: order.OrderStatusPendingPayment, TotalAmount: 50000}, nil)
+
+		rr := serve(svc, scopes, http.MethodPost, "/internal/v1/orders/from-session", `{"sessionExternalId":"sess-1"}`)
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		var res orderResponse
+		assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &res))
+		assert.Equal(t, int32(7), res.ID)
+		assert.Equal(t, "ord-7", res.ExternalID)
+		assert.Equal(t, uint(50000), res.TotalAmount)
+		svc.AssertExpectations(t)
+	})
+
+	t.Run("NoAPIKey", func(t *testing.T) {
+		svc := new(MockOrderService)
+
+		rr := serve(svc, nil, http.MethodPost, "/internal/v1/orders/from-session", `{"sessionExternalId":"sess-1"}`)
+
+		assert.Equal(t, http.StatusUnauthorized, rr.Code)
+		svc.AssertNotCalled(t, "CreateFromSession", mock.Anything, mock.Anything)
+	})
+
+	t.Run("MissingScope", func(t *testing.T) {
+		svc := new(MockOrderService)
+
+		rr := serve(svc, []string{string(apikey.ScopeOrdersRead)}, http.MethodPost, "/internal/v1/orders/from-session", `{"sessionExternalId":"sess-1"}`)
+
+		assert.Equal(t, http.StatusForbidden, rr.Code)
+		svc.AssertNotCalled(t, "CreateFromSession", mock.Anything, mock.Anything)
+	})
+
+	t.Run("InvalidBody", func(t *testing.T) {
+		svc := new(MockOrderService)
+
+		rr := serve(svc, scopes, http.MethodPost, "/internal/v1/orders/from-session", `{"session":"sess-1"}`)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("SessionNotFound", func(t *testing.T) {
+		svc := new(MockOrderService)
+		svc.On("CreateFromSession", mock.Anything, "sess-x").Return(nil, sql.ErrNoRows)
+
+		rr := serve(svc, scopes, http.MethodPost, "/internal/v1/orders/from-session", `{"sessionExternalId":"sess-x"}`)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("Rejected", func(t *testing.T) {
+		svc := new(MockOrderService)
+		svc.On("CreateFromSession", mock.Anything, "sess-1").Return(nil, errors.New("payment not completed"))
+
+		rr := serve(svc, scopes, http.MethodPost, "/internal/v1/orders/from-session", `{"sessionExternalId":"sess-1"}`)
+
+		assert.Equal(t, http.StatusUnprocessableEntity, rr.Code)
+		assert.Contains(t, rr.Body.String(), "payment not completed")
+	})
+}
+
+func TestHandler_MarkAsPaid(t *testing.T) {
+	scopes := []string{string(apikey.ScopeOrdersMarkPaid)}
+
+	t.Run("Success", func(t *testing.T) {
+		svc := new(MockOrderService)
+		svc.On("MarkAsPaid", mock.Anything, "ord-7", payment.Capture{ExternalReference: "pr-1", ProviderPaymentID: "py-1"}).Return(nil)
+
+		rr := serve(svc, scopes, http.MethodPost, "/internal/v1/orders/ord-7/mark-paid", `{"paymentRequestId":"pr-1","paymentProviderId":"py-1"}`)
+
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+		svc.AssertExpectations(t)
+	})
+
+	t.Run("WithCapture", func(t *testing.T) {
+		svc := new(MockOrderService)
+		svc.On("MarkAsPaid", mock.Anything, "ord-7", payment.Capture{
+			ExternalReference: "pr-1",
+			ProviderPaymentID: "py-1",
+			Amount:            150000,
+			ChannelCode:       "BCA_VIRTUAL_ACCOUNT",
+			PaidAt:            time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC),
+		}).Return(nil)
+
+		rr := serve(svc, scopes, http.MethodPost, "/internal/v1/orders/ord-7/mark-paid",
+			`{"paymentRequestId":"pr-1","paymentProviderId":"py-1","amount":150000,"channelCode":"BCA_VIRTUAL_ACCOUNT","paidAt":"2026-03-01T09:30:00Z"}`)
+
+		assert.Equal(t, http.StatusNoContent, rr.Code)
+		svc.AssertExpectations(t)
+	})
+
+	t.Run("MissingPaymentRequestID", func(t *testing.T) {
+		svc := new(MockOrderService)
+
+		rr := serve(svc, scopes, http.MethodPost, "/internal/v1/orders/ord-7/mark-paid", `{}`)
+
+		assert.Equal(t, http.StatusBadRequest, rr.Code)
+	})
+
+	t.Run("OrderNotFound", func(t *testing.T) {
+		svc := new(MockOrderService)
+		svc.On("MarkAsPaid", mock.Anything, "ord-x", payment.Capture{ExternalReference: "pr-1"}).
+			Return(errors.New("order not found with reference_id: ord-x"))
+
+		rr := serve(svc, scopes, http.MethodPost, "/internal/v1/orders/ord-x/mark-paid", `{"paymentRequestId":"pr-1"}`)
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("DBError", func(t *testing.T) {
+		svc := new(MockOrderService)
+		svc.On("MarkAsPaid", mock.Anything, "ord-7", payment.Capture{ExternalReference: "pr-1"}).Return(order.ErrDB)
+
+		rr := serve(svc, scopes, http.MethodPost, "/internal/v1/orders/ord-7/mark-paid", `{"paymentRequestId":"pr-1"}`)
+
+		assert.Equal(t, http.StatusInternalServerError, rr.Code)
+	})
+}
+
+func TestHandler_GetOrder(t *testing.T) {
+	scopes := []string{string(apikey.ScopeOrdersRead)}
+
+	t.Run("Success", func(t *testing.T) {
+		svc := new(MockOrderService)
+		svc.On("GetOrderForWebhook", mock.Anything, "ord-7").Return(&order.Order{
+			ID:         7,
+			ExternalID: "ord-7",
+			Status:     order.OrderStatusPaid,
+			Items:      []*order.OrderItem{{VariantID: "v-1", Quantity: 2, Price: 10000, Subtotal: 20000}},
+		}, nil)
+
+		rr := serve(svc, scopes, http.MethodGet, "/internal/v1/orders/ord-7", "")
+
+		assert.Equal(t, http.StatusOK, rr.Code)
+		var res orderResponse
+		assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &res))
+		assert.Equal(t, "PAID", res.Status)
+		assert.Len(t, res.Items, 1)
+		assert.Equal(t, 2, res.Items[0].Quantity)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		svc := new(MockOrderService)
+		svc.On("GetOrderForWebhook", mock.Anything, "ord-x").Return(nil, order.ErrOrderNotFound)
+
+		rr := serve(svc, scopes, http.MethodGet, "/internal/v1/orders/ord-x", "")
+
+		assert.Equal(t, http.StatusNotFound, rr.Code)
+	})
+
+	t.Run("WrongMethod", func(t *testing.T) {
+		svc := new(MockOrderService)
+
+		rr := serve(svc, scopes, http.MethodDelete, "/internal/v1/orders/ord-7", "")
+
+		assert.Equal(t, http.StatusMethodNotAllowed, rr.Code)
+	})
+}
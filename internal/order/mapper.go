@@ -1,7 +1,10 @@
 package order
 
 import (
+	"strconv"
+	"time"
 	"warimas-be/internal/address"
+	"warimas-be/internal/geo"
 	"warimas-be/internal/graph/model"
 )
 
@@ -35,18 +38,22 @@ func ToGraphQLOrder(o *Order, addr *address.Address) *model.Order {
 
 	var shipping *model.OrderShipping
 	if addr != nil {
-		shipping = &model.OrderShipping{Address: &model.Address{
-			ID:           addr.ID.String(),
-			Name:         addr.Name,
-			ReceiverName: addr.ReceiverName,
-			Phone:        addr.Phone,
-			AddressLine1: addr.Address1,
-			AddressLine2: addr.Address2,
-			City:         addr.City,
-			Province:     addr.Province,
-			Country:      addr.Country,
-			PostalCode:   addr.Postal,
-		}}
+		shipping = &model.OrderShipping{
+			Method: model.ShippingMethod(o.ShippingMethod),
+			Address: &model.Address{
+				ID:           addr.ID.String(),
+				Name:         addr.Name,
+				ReceiverName: addr.ReceiverName,
+				Phone:        addr.Phone,
+				AddressLine1: addr.Address1,
+				AddressLine2: addr.Address2,
+				City:         addr.City,
+				Province:     addr.Province,
+				Country:      addr.Country,
+				PostalCode:   addr.Postal,
+				Location:     geo.MapPointToGraphQL(addr.Location),
+			},
+		}
 	}
 
 	return &model.Order{
@@ -57,15 +64,23 @@ func ToGraphQLOrder(o *Order, addr *address.Address) *model.Order {
 			CreatedAt: o.CreatedAt,
 			UpdatedAt: o.UpdatedAt,
 		},
-		Shipping:      shipping,
-		InvoiceNumber: o.InvoiceNumber,
+		Shipping:         shipping,
+		Pickup:           MapOrderPickupToGraphQL(o.Pickup),
+		DeliveryWindow:   MapDeliveryWindowToGraphQL(o.Delivery),
+		AcceptedPolicies: MapPoliciesToGraphQL(o.AcceptedPolicies),
+		CancelReason:     MapReasonToGraphQL(o.CancelReason),
+		CancelNote:       o.CancelNote,
+		InvoiceNumber:    o.InvoiceNumber,
 		Pricing: &model.OrderPricing{
-			Currency:    o.Currency,
-			Subtotal:    int32(o.Subtotal),
-			Tax:         int32(o.Tax),
-			Discount:    int32(o.Discount),
-			ShippingFee: int32(o.ShippingFee),
-			Total:       int32(o.TotalAmount),
+			Currency:     o.Currency,
+			Subtotal:     int32(o.Subtotal),
+			Tax:          int32(o.Tax),
+			Discount:     int32(o.Discount),
+			ShippingFee:  int32(o.ShippingFee),
+			InsuranceFee: int32(o.InsuranceFee),
+			Adjustment:   int32(o.Adjustment),
+			Total:        int32(o.TotalAmount),
+			WalletAmount: int32(o.WalletAmount),
 		},
 		Status: model.OrderStatus(o.Status),
 		Items:  items,
@@ -101,24 +116,270 @@ func MapCheckoutSessionToGraphQL(
 		addressID = &id
 	}
 
+	var pickupLocationID *string
+	if s.PickupLocationID != nil {
+		id := strconv.Itoa(int(*s.PickupLocationID))
+		pickupLocationID = &id
+	}
+
+	var deliverySlotID, deliveryDate *string
+	if s.DeliverySlotID != nil {
+		id := strconv.Itoa(int(*s.DeliverySlotID))
+		deliverySlotID = &id
+	}
+	if s.DeliveryDate != nil {
+		d := s.DeliveryDate.Format(time.DateOnly)
+		deliveryDate = &d
+	}
+
 	var paymentMethod string
 	if s.PaymentMethod != nil {
 		method := string(*s.PaymentMethod)
 		paymentMethod = method
 	}
+
+	now := time.Now()
+	var secondsRemaining int32
+	if s.Status == CheckoutSessionStatusPending {
+		secondsRemaining = int32(secondsUntil(s.ExpiresAt, now))
+	}
+	var paymentSecondsRemaining *int32
+	if s.PaymentExpiresAt != nil {
+		v := int32(secondsUntil(*s.PaymentExpiresAt, now))
+		paymentSecondsRemaining = &v
+	}
 	return &model.CheckoutSession{
-		ID:            s.ID.String(),
-		ExternalID:    s.ExternalID,
-		Status:        model.CheckoutSessionStatus(s.Status),
-		ExpiresAt:     s.ExpiresAt,
-		CreatedAt:     s.CreatedAt,
-		AddressID:     addressID, //field AddressID *string `json:"addressId,omitempty"`
-		Items:         items,
-		Subtotal:      int32(s.Subtotal),
-		Tax:           int32(s.Tax),
-		ShippingFee:   int32(s.ShippingFee),
-		Discount:      int32(s.Discount),
-		TotalPrice:    int32(s.TotalPrice),
-		PaymentMethod: paymentMethod,
+		ID:             s.ID.String(),
+		ExternalID:     s.ExternalID,
+		Status:         model.CheckoutSessionStatus(s.Status),
+		ExpiresAt:      s.ExpiresAt,
+		CreatedAt:      s.CreatedAt,
+		AddressID:      addressID, //field AddressID *string `json:"addressId,omitempty"`
+		Items:          items,
+		Subtotal:       int32(s.Subtotal),
+		Tax:            int32(s.Tax),
+		ShippingFee:    int32(s.ShippingFee),
+		InsuranceFee:   int32(s.InsuranceFee()),
+		Discount:       int32(s.Discount),
+		TotalPrice:     int32(s.TotalPrice),
+		WalletAmount:   int32(s.WalletAmount),
+		PointsRedeemed: int32(s.PointsRedeemed),
+		PaymentMethod:  paymentMethod,
+
+		ChargeableWeightGrams: int32(s.ChargeableWeightGrams),
+		ShippingMethod:        model.ShippingMethod(s.ShippingMethod),
+		PickupLocationID:      pickupLocationID,
+		PickupSlotStart:       s.PickupSlotStart,
+		DeliverySlotID:        deliverySlotID,
+		DeliveryDate:          deliveryDate,
+		Insured:               s.Insured,
+
+		SecondsRemaining:        secondsRemaining,
+		PaymentExpiresAt:        s.PaymentExpiresAt,
+		PaymentSecondsRemaining: paymentSecondsRemaining,
+	}
+}
+
+func MapShippingOptionToGraphQL(o *ShippingOption) *model.ShippingOption {
+	out := &model.ShippingOption{
+		Method:            model.ShippingMethod(o.Method),
+		Fee:               int32(o.Fee),
+		Available:         o.Available,
+		DistanceKm:        o.DistanceKm,
+		UnavailableReason: o.Reason,
+	}
+	if o.InsuranceFee != nil {
+		fee := int32(*o.InsuranceFee)
+		out.InsuranceFee = &fee
+	}
+	return out
+}
+
+func MapSessionEventToGraphQL(e *SessionEvent) *model.CheckoutSessionEvent {
+	out := &model.CheckoutSessionEvent{
+		ID:          strconv.FormatInt(e.ID, 10),
+		Type:        string(e.Type),
+		ActorRole:   e.ActorRole,
+		FromValue:   e.FromValue,
+		ToValue:     e.ToValue,
+		TotalBefore: int32(e.TotalBefore),
+		TotalAfter:  int32(e.TotalAfter),
+		CreatedAt:   e.CreatedAt,
+	}
+	if e.ActorUserID != nil {
+		id := strconv.Itoa(int(*e.ActorUserID))
+		out.ActorUserID = &id
+	}
+	if e.ActorGuestID != nil {
+		id := e.ActorGuestID.String()
+		out.ActorGuestID = &id
+	}
+	return out
+}
+
+func MapCheckoutRuleToGraphQL(r *CheckoutRule) *model.CheckoutRule {
+	out := &model.CheckoutRule{
+		ID:             strconv.Itoa(int(r.ID)),
+		Region:         r.Region,
+		MinOrderAmount: int32(r.MinOrderAmount),
+		IsActive:       r.IsActive,
+		UpdatedAt:      r.UpdatedAt,
+	}
+	if r.FreeShippingMin != nil {
+		v := int32(*r.FreeShippingMin)
+		out.FreeShippingMin = &v
+	}
+	return out
+}
+
+func MapPickupLocationToGraphQL(l *PickupLocation) *model.PickupLocation {
+	return &model.PickupLocation{
+		ID:          strconv.Itoa(int(l.ID)),
+		Name:        l.Name,
+		Address:     l.Address,
+		City:        l.City,
+		OpensAt:     l.OpensAt,
+		ClosesAt:    l.ClosesAt,
+		SlotMinutes: int32(l.SlotMinutes),
+		IsActive:    l.IsActive,
+		UpdatedAt:   l.UpdatedAt,
+	}
+}
+
+func MapOrderPickupToGraphQL(p *OrderPickup) *model.OrderPickup {
+	if p == nil {
+		return nil
+	}
+	out := &model.OrderPickup{
+		Location:   MapPickupLocationToGraphQL(p.Location),
+		SlotStart:  p.SlotStart,
+		SlotEnd:    p.SlotEnd,
+		PickedUpAt: p.PickedUpAt,
+	}
+	if p.Code != "" {
+		code := p.Code
+		out.Code = &code
+	}
+	return out
+}
+
+func MapDeliverySlotToGraphQL(d *DeliverySlot) *model.DeliverySlot {
+	return &model.DeliverySlot{
+		ID:        strconv.Itoa(int(d.ID)),
+		Region:    d.Region,
+		StartsAt:  d.StartsAt,
+		EndsAt:    d.EndsAt,
+		Capacity:  int32(d.Capacity),
+		IsActive:  d.IsActive,
+		UpdatedAt: d.UpdatedAt,
+	}
+}
+
+func MapDeliverySlotOptionToGraphQL(o *DeliverySlotOption) *model.DeliverySlotOption {
+	return &model.DeliverySlotOption{
+		Slot:      MapDeliverySlotToGraphQL(o.Slot),
+		Start:     o.Start,
+		End:       o.End,
+		Remaining: int32(o.Remaining),
+		Available: o.Available,
+	}
+}
+
+func MapDeliveryWindowToGraphQL(d *DeliveryBooking) *model.DeliveryWindow {
+	if d == nil {
+		return nil
+	}
+	return &model.DeliveryWindow{
+		SlotID: strconv.Itoa(int(d.SlotID)),
+		Date:   d.Date.Format(time.DateOnly),
+		Start:  d.WindowStart,
+		End:    d.WindowEnd,
+	}
+}
+
+func MapPolicyToGraphQL(p *Policy) *model.Policy {
+	return &model.Policy{
+		ID:          strconv.Itoa(int(p.ID)),
+		Kind:        model.PolicyKind(p.Kind),
+		Version:     int32(p.Version),
+		Title:       p.Title,
+		Body:        p.Body,
+		PublishedAt: p.PublishedAt,
+	}
+}
+
+// MapPoliciesToGraphQL keeps nil as nil, so order lists, which do not
+// load acceptances, leave the field null.
+func MapPoliciesToGraphQL(policies []*Policy) []*model.Policy {
+	if policies == nil {
+		return nil
+	}
+	out := make([]*model.Policy, 0, len(policies))
+	for _, p := range policies {
+		out = append(out, MapPolicyToGraphQL(p))
+	}
+	return out
+}
+
+func MapReasonToGraphQL(r *Reason) *model.OrderReason {
+	if r == nil {
+		return nil
+	}
+	return &model.OrderReason{
+		ID:        strconv.Itoa(int(r.ID)),
+		Code:      r.Code,
+		Kind:      model.OrderReasonKind(r.Kind),
+		Audience:  model.OrderReasonAudience(r.Audience),
+		Label:     r.Label,
+		Position:  int32(r.Position),
+		IsActive:  r.IsActive,
+		UpdatedAt: r.UpdatedAt,
+	}
+}
+
+func MapReasonsToGraphQL(reasons []*Reason) []*model.OrderReason {
+	out := make([]*model.OrderReason, 0, len(reasons))
+	for _, r := range reasons {
+		out = append(out, MapReasonToGraphQL(r))
+	}
+	return out
+}
+
+func MapReasonStatsToGraphQL(stats []*ReasonStat) []*model.OrderReasonStat {
+	out := make([]*model.OrderReasonStat, 0, len(stats))
+	for _, st := range stats {
+		out = append(out, &model.OrderReasonStat{
+			Reason: MapReasonToGraphQL(st.Reason),
+			Orders: int32(st.Orders),
+			Amount: int32(st.Amount),
+		})
+	}
+	return out
+}
+
+func MapOrderAdjustmentToGraphQL(a *OrderAdjustment) *model.OrderAdjustment {
+	out := &model.OrderAdjustment{
+		ID:          strconv.FormatInt(a.ID, 10),
+		OrderID:     a.OrderID,
+		Kind:        model.OrderAdjustmentKind(a.Kind),
+		Amount:      int32(a.Amount),
+		Reason:      a.Reason,
+		Status:      model.OrderAdjustmentStatus(a.Status),
+		RequestedBy: strconv.Itoa(int(a.RequestedBy)),
+		CreatedAt:   a.CreatedAt,
+		ReviewedAt:  a.ReviewedAt,
+	}
+	if a.ReviewedBy != nil {
+		reviewedBy := strconv.Itoa(int(*a.ReviewedBy))
+		out.ReviewedBy = &reviewedBy
+	}
+	return out
+}
+
+func MapOrderAdjustmentsToGraphQL(adjustments []*OrderAdjustment) []*model.OrderAdjustment {
+	out := make([]*model.OrderAdjustment, 0, len(adjustments))
+	for _, a := range adjustments {
+		out = append(out, MapOrderAdjustmentToGraphQL(a))
 	}
+	return out
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestMapOrderItemToGraphQL(t *testing.T) {
@@ -154,5 +155,40 @@ func TestMapCheckoutSessionToGraphQL(t *testing.T) {
 		}
 		res := MapCheckoutSessionToGraphQL(session)
 		assert.Nil(t, res.AddressID)
+		assert.Nil(t, res.PaymentExpiresAt)
+		assert.Nil(t, res.PaymentSecondsRemaining)
 	})
+
+	t.Run("Countdowns", func(t *testing.T) {
+		paymentExpiresAt := time.Now().Add(24 * time.Hour)
+
+		pending := MapCheckoutSessionToGraphQL(&CheckoutSession{
+			ID:        uuid.New(),
+			Status:    CheckoutSessionStatusPending,
+			ExpiresAt: time.Now().Add(10 * time.Minute),
+		})
+		assert.InDelta(t, 600, pending.SecondsRemaining, 2)
+
+		paid := MapCheckoutSessionToGraphQL(&CheckoutSession{
+			ID:               uuid.New(),
+			Status:           CheckoutSessionStatusPaid,
+			ExpiresAt:        time.Now().Add(10 * time.Minute),
+			PaymentExpiresAt: &paymentExpiresAt,
+		})
+		assert.Zero(t, paid.SecondsRemaining)
+		assert.Equal(t, &paymentExpiresAt, paid.PaymentExpiresAt)
+		require.NotNil(t, paid.PaymentSecondsRemaining)
+		assert.InDelta(t, 24*3600, *paid.PaymentSecondsRemaining, 2)
+	})
+}
+
+func TestSecondsUntil(t *testing.T) {
+	now := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, 90, secondsUntil(now.Add(90*time.Second), now))
+	// A part-second left still shows 1, so the countdown reaches 0 only
+	// once the payment really expired
+	assert.Equal(t, 1, secondsUntil(now.Add(300*time.Millisecond), now))
+	assert.Equal(t, 0, secondsUntil(now, now))
+	assert.Equal(t, 0, secondsUntil(now.Add(-time.Hour), now))
 }
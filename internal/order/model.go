@@ -15,6 +15,9 @@ const (
 	OrderStatusPaid           OrderStatus = "PAID"
 	OrderStatusAccepted       OrderStatus = "ACCEPTED"
 	OrderStatusShipped        OrderStatus = "SHIPPED"
+	// OrderStatusReadyForPickup is a SELF_PICKUP order waiting at its
+	// pickup location.
+	OrderStatusReadyForPickup OrderStatus = "READY_FOR_PICKUP"
 	OrderStatusCompleted      OrderStatus = "COMPLETED"
 	OrderStatusCancelled      OrderStatus = "CANCELLED"
 	OrderStatusFailed         OrderStatus = "FAILED"
@@ -27,6 +30,16 @@ const (
 	OrderSortFieldCreatedAt OrderSortField = "CREATED_AT"
 )
 
+// OrderDatePreset names a created_at range the service resolves relative to
+// the current time.
+type OrderDatePreset string
+
+const (
+	OrderDatePresetLast30Days OrderDatePreset = "LAST_30_DAYS"
+	OrderDatePresetLast90Days OrderDatePreset = "LAST_90_DAYS"
+	OrderDatePresetThisYear   OrderDatePreset = "THIS_YEAR"
+)
+
 type SortDirection string
 
 const (
@@ -43,21 +56,47 @@ const (
 // --- Primary Model ---
 
 type Order struct {
-	ID            int32
-	UserID        *int32
-	AddressID     uuid.UUID
-	TotalAmount   uint
-	Status        OrderStatus
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
-	Items         []*OrderItem
-	Subtotal      uint
-	Tax           uint
-	ShippingFee   uint
-	Discount      uint
+	ID          int32
+	UserID      *int32
+	AddressID   uuid.UUID
+	TotalAmount uint
+	Status      OrderStatus
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+	Items       []*OrderItem
+	Subtotal    uint
+	Tax         uint
+	ShippingFee uint
+	// InsuranceFee is what the customer paid to insure the shipment.
+	InsuranceFee uint
+	Discount     uint
+	// Adjustment is the net of the admin adjustments applied, already in
+	// TotalAmount: charges add to it and discounts take from it.
+	Adjustment    int
 	ExternalID    string
 	InvoiceNumber *string
 	Currency      string
+	WalletAmount  uint
+	// PlacedBy is the admin who created the order for the customer.
+	PlacedBy       *int32
+	ShippingMethod ShippingMethod
+	// Pickup is set on SELF_PICKUP orders by the order detail lookups.
+	Pickup *OrderPickup
+	// Delivery is the same-day slot an INSTANT order holds, if any.
+	Delivery *DeliveryBooking
+	// AcceptedPolicies are the policy versions the customer accepted when
+	// placing the order. Admin-created orders have none.
+	AcceptedPolicies []*Policy
+	// CancelReasonID is why a cancelled or failed order stopped; the
+	// order detail lookups resolve it into CancelReason.
+	CancelReasonID *int32
+	CancelReason   *Reason
+	CancelNote     *string
+}
+
+// GatewayAmount is the part of the total expected from the payment gateway.
+func (o *Order) GatewayAmount() uint {
+	return o.TotalAmount - o.WalletAmount
 }
 
 // --- Supporting Order Entities ---
@@ -89,6 +128,11 @@ type OrderFilterInput struct {
 	Status   *OrderStatus `json:"status,omitempty"`
 	DateFrom *time.Time   `json:"dateFrom,omitempty"`
 	DateTo   *time.Time   `json:"dateTo,omitempty"`
+	// Product matches items whose "product variant" name contains every
+	// word, so "Beras 5kg" finds product Beras with variant 5kg.
+	Product    *string          `json:"product,omitempty"`
+	VariantID  *string          `json:"variantId,omitempty"`
+	DatePreset *OrderDatePreset `json:"datePreset,omitempty"`
 }
 
 type OrderSortInput struct {
@@ -0,0 +1,84 @@
+package order
+
+import (
+	"context"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/preference"
+
+	"go.uber.org/zap"
+)
+
+// Notifier tells customers about changes to their orders they did not make
+// themselves, on the channels and in the language of d. A failed notice is
+// logged and not retried; the order change it reports is already saved.
+type Notifier interface {
+	NotifyPaymentExpired(ctx context.Context, d *preference.Delivery, p *ExpiredPayment) error
+	// NotifyReadyForPickup tells the customer their order waits at its
+	// pickup location, with the code to collect it.
+	NotifyReadyForPickup(ctx context.Context, d *preference.Delivery, userID *int32, orderExternalID string, p *OrderPickup) error
+	// NotifyOrderAdjusted tells the customer an admin changed what their
+	// unpaid order costs, and that it now has a new invoice for total.
+	NotifyOrderAdjusted(ctx context.Context, d *preference.Delivery, userID *int32, orderExternalID string, a *OrderAdjustment, total uint) error
+}
+
+// LogNotifier writes each notice at info level until a push or email
+// provider is wired in.
+type LogNotifier struct{}
+
+func (LogNotifier) NotifyPaymentExpired(ctx context.Context, d *preference.Delivery, p *ExpiredPayment) error {
+	fields := []zap.Field{
+		zap.String("order_external_id", p.OrderExternalID),
+		zap.String("message", p.Message()),
+		zap.Any("channels", d.Channels),
+		zap.String("locale", d.Locale),
+	}
+	if p.UserID != nil {
+		fields = append(fields, zap.Int32("user_id", *p.UserID))
+	}
+	logger.FromCtx(ctx).Info("payment expired notice", fields...)
+	return nil
+}
+
+// NotifyReadyForPickup leaves the pickup code out of the log; only the
+// customer may see it.
+func (LogNotifier) NotifyReadyForPickup(ctx context.Context, d *preference.Delivery, userID *int32, orderExternalID string, p *OrderPickup) error {
+	fields := []zap.Field{
+		zap.String("order_external_id", orderExternalID),
+		zap.Int32("pickup_location_id", p.Location.ID),
+		zap.Time("slot_start", p.SlotStart),
+		zap.Any("channels", d.Channels),
+		zap.String("locale", d.Locale),
+	}
+	if userID != nil {
+		fields = append(fields, zap.Int32("user_id", *userID))
+	}
+	logger.FromCtx(ctx).Info("ready for pickup notice", fields...)
+	return nil
+}
+
+// NotifyOrderAdjusted leaves the adjustment's reason out; it is written
+// for admins, not the customer.
+func (LogNotifier) NotifyOrderAdjusted(ctx context.Context, d *preference.Delivery, userID *int32, orderExternalID string, a *OrderAdjustment, total uint) error {
+	fields := []zap.Field{
+		zap.String("order_external_id", orderExternalID),
+		zap.String("kind", string(a.Kind)),
+		zap.Int64("amount", a.Amount),
+		zap.Uint("total_amount", total),
+		zap.Any("channels", d.Channels),
+		zap.String("locale", d.Locale),
+	}
+	if userID != nil {
+		fields = append(fields, zap.Int32("user_id", *userID))
+	}
+	logger.FromCtx(ctx).Info("order adjusted notice", fields...)
+	return nil
+}
+
+// orderUpdateDelivery is how the customer wants order updates sent. Guest
+// orders have no preferences and get the defaults.
+func (s *service) orderUpdateDelivery(ctx context.Context, userID *int32) *preference.Delivery {
+	if userID == nil {
+		return preference.DefaultDelivery(preference.EventOrderUpdates)
+	}
+	return s.prefs.DeliveryFor(ctx, *userID, preference.EventOrderUpdates)
+}
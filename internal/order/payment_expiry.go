@@ -0,0 +1,91 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"warimas-be/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// PaymentExpiryInterval is how often orders are checked for a payment
+// request that expired unpaid.
+const PaymentExpiryInterval = time.Minute
+
+// paymentExpiryBatchSize bounds the orders cancelled per run; the rest are
+// picked up on the next one.
+const paymentExpiryBatchSize = 50
+
+// ExpiredPayment is an order still waiting for a gateway payment whose
+// request expired.
+type ExpiredPayment struct {
+	OrderID          int32
+	OrderExternalID  string
+	PaymentRequestID string
+	// UserID is nil for guest orders.
+	UserID    *int32
+	ExpiredAt time.Time
+}
+
+// Message is what the customer is told about the expired payment.
+func (p *ExpiredPayment) Message() string {
+	return fmt.Sprintf(
+		"The payment for order %s expired before it was paid, so the order was cancelled. You can order the items again from your order history.",
+		p.OrderExternalID,
+	)
+}
+
+// ExpireUnpaidOrders cancels orders whose payment request expired unpaid:
+// the payment becomes EXPIRED, the order CANCELLED and its session EXPIRED,
+// the wallet portion of a split payment is credited back and the stock is
+// returned to the warehouses it came from. The customer is then notified.
+// Returns how many orders were cancelled.
+func (s *service) ExpireUnpaidOrders(ctx context.Context) (int, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "ExpireUnpaidOrders"),
+	)
+
+	expired, err := s.repo.ListExpiredPayments(ctx, s.now(), paymentExpiryBatchSize)
+	if err != nil {
+		log.Error("failed to list expired payments", zap.Error(err))
+		return 0, err
+	}
+
+	cancelled := 0
+	for _, p := range expired {
+		plog := log.With(
+			zap.String("order_external_id", p.OrderExternalID),
+			zap.String("payment_request_id", p.PaymentRequestID),
+		)
+
+		change := orderChange{svc: s, orderID: uint(p.OrderID), externalID: p.OrderExternalID, byPayment: true}
+		err := OrderStatuses.Fire(ctx, change, OrderStatusPendingPayment, OrderStatusCancelled, func(ctx context.Context) error {
+			return s.repo.ExpireOrderPayment(ctx, p.OrderExternalID, p.PaymentRequestID)
+		})
+		if errors.Is(err, ErrPaymentNotPending) {
+			// Paid or cancelled since it was listed
+			plog.Info("order no longer waiting for the expired payment")
+			continue
+		}
+		if err != nil {
+			plog.Error("failed to cancel order with expired payment", zap.Error(err))
+			continue
+		}
+		cancelled++
+
+		if d := s.orderUpdateDelivery(ctx, p.UserID); !d.Muted() {
+			if err := s.notifier.NotifyPaymentExpired(ctx, d, p); err != nil {
+				plog.Error("failed to notify about expired payment", zap.Error(err))
+			}
+		}
+	}
+
+	log.Info("expired payments processed",
+		zap.Int("expired", len(expired)),
+		zap.Int("cancelled", cancelled),
+	)
+	return cancelled, nil
+}
@@ -0,0 +1,129 @@
+package order
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+)
+
+// pickupSlotDays is how many days ahead, today included, a pickup slot
+// can be booked.
+const pickupSlotDays = 3
+
+// pickupLeadTime is how long the counter needs to have an order ready;
+// slots starting sooner are not offered.
+const pickupLeadTime = 2 * time.Hour
+
+// PickupLocation is a counter where customers collect SELF_PICKUP orders.
+type PickupLocation struct {
+	ID      int32
+	Name    string
+	Address string
+	City    string
+	// OpensAt and ClosesAt are local times of day as "15:04". The day
+	// between them is cut into slots of SlotMinutes.
+	OpensAt     string
+	ClosesAt    string
+	SlotMinutes int
+	IsActive    bool
+	UpdatedAt   time.Time
+}
+
+// PickupSlot is a window in which a customer collects their order.
+type PickupSlot struct {
+	Start time.Time
+	End   time.Time
+}
+
+// PickupChoice is the pickup location and slot a customer picked for a
+// checkout session.
+type PickupChoice struct {
+	LocationID int32
+	SlotStart  time.Time
+}
+
+// OrderPickup is where and when a SELF_PICKUP order is collected.
+type OrderPickup struct {
+	Location  *PickupLocation
+	SlotStart time.Time
+	SlotEnd   time.Time
+	// Code is said at the counter to collect the order. Only the order's
+	// customer is shown it.
+	Code       string
+	PickedUpAt *time.Time
+}
+
+// ParsePickupLocationID reads a pickup location id from the API.
+func ParsePickupLocationID(id string) (int32, error) {
+	n, err := strconv.ParseInt(id, 10, 32)
+	if err != nil || n <= 0 {
+		return 0, ErrPickupLocationNotFound
+	}
+	return int32(n), nil
+}
+
+// parseClock reads a "15:04" time of day.
+func parseClock(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// slots lists the location's bookable slots from now on, in loc's
+// calendar. Slots that would run past closing are left out.
+func (l *PickupLocation) slots(now time.Time, loc *time.Location) []PickupSlot {
+	opens, err := parseClock(l.OpensAt)
+	if err != nil {
+		return nil
+	}
+	closes, err := parseClock(l.ClosesAt)
+	if err != nil || l.SlotMinutes <= 0 {
+		return nil
+	}
+	length := time.Duration(l.SlotMinutes) * time.Minute
+	earliest := now.Add(pickupLeadTime)
+
+	midnight := localMidnight(now, loc)
+
+	var slots []PickupSlot
+	for d := 0; d < pickupSlotDays; d++ {
+		day := midnight.AddDate(0, 0, d)
+		for at := opens; at+length <= closes; at += length {
+			start := day.Add(at)
+			if start.Before(earliest) {
+				continue
+			}
+			slots = append(slots, PickupSlot{Start: start, End: start.Add(length)})
+		}
+	}
+	return slots
+}
+
+// slotAt is the bookable slot starting at start, if there is one.
+func (l *PickupLocation) slotAt(start, now time.Time, loc *time.Location) (PickupSlot, bool) {
+	for _, slot := range l.slots(now, loc) {
+		if slot.Start.Equal(start) {
+			return slot, true
+		}
+	}
+	return PickupSlot{}, false
+}
+
+// newPickupCode is a random six-digit code.
+func newPickupCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+// pickupCodeMatches compares codes in constant time.
+func pickupCodeMatches(want, got string) bool {
+	return subtle.ConstantTimeCompare([]byte(want), []byte(got)) == 1
+}
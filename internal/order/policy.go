@@ -0,0 +1,52 @@
+package order
+
+import (
+	"slices"
+	"strconv"
+	"time"
+)
+
+// PolicyKind is a document customers accept before placing an order.
+type PolicyKind string
+
+const (
+	PolicyKindTerms  PolicyKind = "TERMS"
+	PolicyKindRefund PolicyKind = "REFUND"
+)
+
+func validPolicyKind(k PolicyKind) bool {
+	return k == PolicyKindTerms || k == PolicyKindRefund
+}
+
+// Policy is one published version of a policy. The latest version of
+// each kind is the current one.
+type Policy struct {
+	ID          int32
+	Kind        PolicyKind
+	Version     int
+	Title       string
+	Body        string
+	PublishedBy *int32
+	PublishedAt time.Time
+}
+
+// ParsePolicyID reads a policy id from the API.
+func ParsePolicyID(id string) (int32, error) {
+	n, err := strconv.ParseInt(id, 10, 32)
+	if err != nil || n <= 0 {
+		return 0, ErrPolicyNotFound
+	}
+	return int32(n), nil
+}
+
+// unacceptedPolicies returns those of current that accepted does not
+// name.
+func unacceptedPolicies(current []*Policy, accepted []int32) []*Policy {
+	var missing []*Policy
+	for _, p := range current {
+		if !slices.Contains(accepted, p.ID) {
+			missing = append(missing, p)
+		}
+	}
+	return missing
+}
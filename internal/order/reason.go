@@ -0,0 +1,77 @@
+package order
+
+import (
+	"strconv"
+	"time"
+)
+
+// ReasonKind is what a reason explains: a cancelled order or a returned
+// one.
+type ReasonKind string
+
+const (
+	ReasonKindCancellation ReasonKind = "CANCELLATION"
+	ReasonKindReturn       ReasonKind = "RETURN"
+)
+
+func validReasonKind(k ReasonKind) bool {
+	return k == ReasonKindCancellation || k == ReasonKindReturn
+}
+
+// ReasonAudience is who may pick a reason. Internal reasons are kept
+// for admins and the system.
+type ReasonAudience string
+
+const (
+	ReasonAudienceCustomer ReasonAudience = "CUSTOMER"
+	ReasonAudienceInternal ReasonAudience = "INTERNAL"
+)
+
+func validReasonAudience(a ReasonAudience) bool {
+	return a == ReasonAudienceCustomer || a == ReasonAudienceInternal
+}
+
+// Reason is one entry of the managed list of cancellation and return
+// reasons. Reasons are deactivated rather than deleted so past orders
+// keep theirs.
+type Reason struct {
+	ID        int32
+	Code      string
+	Kind      ReasonKind
+	Audience  ReasonAudience
+	Label     string
+	Position  int
+	IsActive  bool
+	UpdatedAt time.Time
+}
+
+// ReasonStat is how many orders stopped for a reason and the money
+// involved.
+type ReasonStat struct {
+	Reason *Reason
+	Orders int
+	Amount int64
+}
+
+// validReasonCode accepts codes like CHANGED_MIND: upper case letters,
+// digits and underscores, starting with a letter.
+func validReasonCode(code string) bool {
+	if code == "" || len(code) > 50 || code[0] < 'A' || code[0] > 'Z' {
+		return false
+	}
+	for _, c := range code {
+		if (c < 'A' || c > 'Z') && (c < '0' || c > '9') && c != '_' {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseReasonID reads a reason id from the API.
+func ParseReasonID(id string) (int32, error) {
+	n, err := strconv.ParseInt(id, 10, 32)
+	if err != nil || n <= 0 {
+		return 0, ErrReasonNotFound
+	}
+	return int32(n), nil
+}
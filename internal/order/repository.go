@@ -3,15 +3,20 @@ package order
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strings"
 	"time"
-	"warimas-be/internal/address"
+	"warimas-be/internal/db/dbgen"
+	"warimas-be/internal/geo"
 	"warimas-be/internal/logger"
+	"warimas-be/internal/loyalty"
 	"warimas-be/internal/payment"
 	"warimas-be/internal/product"
+	"warimas-be/internal/sqlbuilder"
 	"warimas-be/internal/utils"
+	"warimas-be/internal/wallet"
 
 	"github.com/google/uuid"
 	"github.com/lib/pq"
@@ -20,8 +25,25 @@ import (
 
 var ErrDB = errors.New("database error")
 
+// Repository is everything the order service persists. Consumers that
+// only need part of it should depend on the focused interfaces instead.
+// Addresses, wallet balances and loyalty points are read through their
+// own modules, not from here.
 type Repository interface {
-	// CreateOrder(userID uint) (*Order, error)
+	OrderRepo
+	SessionRepo
+	StockRepo
+	CheckoutRuleRepo
+	PickupRepo
+	DeliverySlotRepo
+	PolicyRepo
+	ReasonRepo
+	AdjustmentRepo
+}
+
+// OrderRepo reads and moves placed orders and the payments that settle
+// them.
+type OrderRepo interface {
 	FetchOrders(
 		ctx context.Context,
 		filter *OrderFilterInput,
@@ -40,7 +62,35 @@ type Repository interface {
 	GetOrderDetail(ctx context.Context, orderID uint) (*Order, error)
 	GetOrderDetailByExternalID(ctx context.Context, external string) (*Order, error)
 	UpdateOrderStatus(ctx context.Context, orderID uint, status OrderStatus, invoiceNumber *string) error
+	// CancelOrder cancels the order and records why.
+	CancelOrder(ctx context.Context, orderID uint, reasonID int32, note *string) error
+	// IsOrderPacked reports whether the order finished pick/pack.
+	IsOrderPacked(ctx context.Context, orderID uint) (bool, error)
+	// UpdateStatusByReferenceID moves the order, its session and payment
+	// to status. Moving to FAILED also returns the wallet portion.
 	UpdateStatusByReferenceID(ctx context.Context, referenceID, ExternalReference, paymentProviderID, status string) error
+	// MarkPaidByReferenceID marks the order and its session PAID and writes
+	// capture onto the payment it settles, recreating that payment from
+	// capture if its row is missing.
+	MarkPaidByReferenceID(ctx context.Context, referenceID string, capture payment.Capture) error
+	// ListExpiredPayments returns orders waiting for a gateway payment
+	// whose request expired before now, earliest expiry first.
+	ListExpiredPayments(ctx context.Context, now time.Time, limit int32) ([]*ExpiredPayment, error)
+	// ExpireOrderPayment marks the payment request EXPIRED, cancels its
+	// order, expires the session, returns the wallet portion and restocks
+	// the items. ErrPaymentNotPending when the order or payment stopped
+	// waiting in the meantime.
+	ExpireOrderPayment(ctx context.Context, orderExternalID, paymentRequestID string) error
+	// ListExpiredSessions returns PENDING sessions that expired before now,
+	// earliest first: those without an order or with an order waiting on a
+	// payment request that expired before graceBefore, and those whose
+	// order was placed before graceBefore and never got a payment request.
+	ListExpiredSessions(ctx context.Context, now, graceBefore time.Time, limit int32) ([]*ExpiredSession, error)
+	// CancelUnpaidSessionOrder cancels an order that never got a payment
+	// request, expires its session, returns the wallet portion and
+	// restocks the items. ErrPaymentNotPending when the order stopped
+	// waiting or got a payment in the meantime.
+	CancelUnpaidSessionOrder(ctx context.Context, orderExternalID string) error
 	GetByReferenceID(ctx context.Context, referenceID string) (*Order, error)
 	GetOrderBySessionID(
 		ctx context.Context,
@@ -58,11 +108,17 @@ type Repository interface {
 		session *CheckoutSession,
 	) error
 
-	GetVariantForCheckout(
+	// SaveOfflinePayment records a pending payment for money an admin
+	// collects outside the platform; MarkAsPaid settles it.
+	SaveOfflinePayment(
 		ctx context.Context,
-		variantID string,
-	) (*product.Variant, *product.Product, error)
+		order *Order,
+	) error
+}
 
+// SessionRepo keeps checkout sessions, their change log and the vouchers
+// applied to them.
+type SessionRepo interface {
 	CreateCheckoutSession(
 		ctx context.Context,
 		session *CheckoutSession,
@@ -75,12 +131,6 @@ type Repository interface {
 
 	) (*CheckoutSession, error)
 
-	GetUserAddress(
-		ctx context.Context,
-		addressID string,
-		userID uint,
-	) (*address.Address, error)
-
 	UpdateSessionAddressAndPricing(
 		ctx context.Context,
 		session *CheckoutSession,
@@ -92,29 +142,314 @@ type Repository interface {
 		paymentMethod payment.ChannelCode,
 	) error
 
+	// UpdateSessionShippingMethod saves the session's shipping method and
+	// the pricing it produces.
+	UpdateSessionShippingMethod(
+		ctx context.Context,
+		session *CheckoutSession,
+	) error
+
+	// UpdateSessionInsurance saves whether the session is insured and the
+	// pricing that produces.
+	UpdateSessionInsurance(
+		ctx context.Context,
+		session *CheckoutSession,
+	) error
+
+	UpdateSessionWalletAmount(
+		ctx context.Context,
+		sessionID uuid.UUID,
+		amount int,
+	) error
+
+	GetVoucherByCode(
+		ctx context.Context,
+		code string,
+	) (*SessionVoucher, error)
+
+	GetVoucherByID(
+		ctx context.Context,
+		id int64,
+	) (*SessionVoucher, error)
+
+	UpdateSessionDiscounts(
+		ctx context.Context,
+		session *CheckoutSession,
+	) error
+
 	ConfirmCheckoutSession(
 		ctx context.Context,
 		session *CheckoutSession,
 	) error
 
+	// MarkSessionExpired expires a PENDING session unless an order was
+	// placed from it. It waits for an order being placed to commit first.
+	MarkSessionExpired(
+		ctx context.Context,
+		sessionID uuid.UUID,
+	) error
+
+	// GetActiveSessionExternalID returns the user's latest open checkout
+	// session, or "" when there is none.
+	GetActiveSessionExternalID(
+		ctx context.Context,
+		userID uint,
+	) (string, error)
+
+	// CountOpenGuestSessions counts the guest's checkout sessions that are
+	// still open.
+	CountOpenGuestSessions(
+		ctx context.Context,
+		guestID uuid.UUID,
+	) (int, error)
+
+	// SupersedeOpenSessions marks the user's other open checkout sessions
+	// as superseded by keepID.
+	SupersedeOpenSessions(
+		ctx context.Context,
+		userID uint,
+		keepID uuid.UUID,
+	) (int64, error)
+
+	InsertSessionEvent(
+		ctx context.Context,
+		event *SessionEvent,
+	) error
+
+	// ListSessionEvents returns the session's change log, oldest first.
+	ListSessionEvents(
+		ctx context.Context,
+		sessionID uuid.UUID,
+	) ([]*SessionEvent, error)
+
+	// UpdateSessionItems replaces the session's items with session.Items,
+	// keeping item IDs, and saves the pricing they produce.
+	UpdateSessionItems(
+		ctx context.Context,
+		session *CheckoutSession,
+	) error
+}
+
+// StockRepo answers whether variants can be sold right now.
+type StockRepo interface {
+	GetVariantForCheckout(
+		ctx context.Context,
+		variantID string,
+	) (*product.Variant, *product.Product, error)
+
 	ValidateVariantStock(
 		ctx context.Context,
 		variantID string,
 		qty int,
 	) (bool, error)
 
-	MarkSessionExpired(
+	// VariantsOnVacation returns those of variantIDs whose seller is on
+	// vacation right now.
+	VariantsOnVacation(
 		ctx context.Context,
-		sessionID uuid.UUID,
+		variantIDs []string,
+	) ([]string, error)
+
+	// InactiveVariants returns those of variantIDs that are no longer
+	// sold because their product was archived or disabled.
+	InactiveVariants(
+		ctx context.Context,
+		variantIDs []string,
+	) ([]string, error)
+
+	// OriginLocations returns where each of originIDs sits now, leaving
+	// out origins that are gone or not pinned.
+	OriginLocations(
+		ctx context.Context,
+		originIDs []string,
+	) (map[string]geo.Point, error)
+}
+
+// CheckoutRuleRepo stores the per-region checkout rules.
+type CheckoutRuleRepo interface {
+	ListCheckoutRules(
+		ctx context.Context,
+		activeOnly bool,
+	) ([]*CheckoutRule, error)
+
+	// UpsertCheckoutRule creates or replaces the rule for rule.Region.
+	UpsertCheckoutRule(
+		ctx context.Context,
+		rule *CheckoutRule,
+		adminID uint,
+	) (*CheckoutRule, error)
+}
+
+// PickupRepo keeps the pickup locations and the pickups booked at them.
+type PickupRepo interface {
+	ListPickupLocations(
+		ctx context.Context,
+		activeOnly bool,
+	) ([]*PickupLocation, error)
+
+	// GetPickupLocation returns ErrPickupLocationNotFound for an unknown
+	// id.
+	GetPickupLocation(
+		ctx context.Context,
+		id int32,
+	) (*PickupLocation, error)
+
+	// SavePickupLocation adds location, or replaces the one with its ID.
+	SavePickupLocation(
+		ctx context.Context,
+		location *PickupLocation,
+	) (*PickupLocation, error)
+
+	// GetOrderPickup returns the order's pickup with its location, or nil
+	// when the order is not collected.
+	GetOrderPickup(
+		ctx context.Context,
+		orderID uint,
+	) (*OrderPickup, error)
+
+	// CompletePickup marks a READY_FOR_PICKUP order COMPLETED and records
+	// who handed it over. ErrNotReadyForPickup when it moved meanwhile.
+	CompletePickup(
+		ctx context.Context,
+		orderID uint,
+		adminID uint,
 	) error
 }
 
+// DeliverySlotRepo keeps the same-day delivery slots and what is booked
+// in them. Instant orders book their slot in CreateOrderTx.
+type DeliverySlotRepo interface {
+	// ListDeliverySlots lists the slots of region, or of every region
+	// when region is nil, earliest first.
+	ListDeliverySlots(
+		ctx context.Context,
+		region *string,
+		activeOnly bool,
+	) ([]*DeliverySlot, error)
+
+	// GetDeliverySlot returns ErrDeliverySlotNotFound for an unknown id.
+	GetDeliverySlot(
+		ctx context.Context,
+		id int32,
+	) (*DeliverySlot, error)
+
+	// SaveDeliverySlot adds slot, or replaces the one with its ID.
+	SaveDeliverySlot(
+		ctx context.Context,
+		slot *DeliverySlot,
+	) (*DeliverySlot, error)
+
+	// CountDeliveryBookings counts the orders holding each of slotIDs on
+	// date. Cancelled and failed orders no longer hold their slot.
+	CountDeliveryBookings(
+		ctx context.Context,
+		slotIDs []int32,
+		date time.Time,
+	) (map[int32]int, error)
+
+	// GetOrderDelivery returns the slot the order holds, or nil.
+	GetOrderDelivery(
+		ctx context.Context,
+		orderID uint,
+	) (*DeliveryBooking, error)
+}
+
+// PolicyRepo keeps the published policy versions and which ones each
+// order was placed under. Orders record theirs in CreateOrderTx.
+type PolicyRepo interface {
+	// ListCurrentPolicies returns the latest version of each policy kind.
+	ListCurrentPolicies(ctx context.Context) ([]*Policy, error)
+
+	// ListPolicyVersions returns every version of kind, newest first.
+	ListPolicyVersions(
+		ctx context.Context,
+		kind PolicyKind,
+	) ([]*Policy, error)
+
+	// PublishPolicy adds the next version of policy.Kind.
+	PublishPolicy(
+		ctx context.Context,
+		policy *Policy,
+	) (*Policy, error)
+
+	// ListOrderPolicies returns the versions the order was placed under.
+	ListOrderPolicies(
+		ctx context.Context,
+		orderID uint,
+	) ([]*Policy, error)
+}
+
+// ReasonRepo keeps the managed cancellation and return reasons and
+// reports on them.
+type ReasonRepo interface {
+	// ListReasons returns the reasons of kind, or of every kind when
+	// nil, in display order. customerOnly keeps the active customer ones.
+	ListReasons(
+		ctx context.Context,
+		kind *ReasonKind,
+		customerOnly bool,
+	) ([]*Reason, error)
+
+	// GetReason returns ErrReasonNotFound for an unknown id.
+	GetReason(ctx context.Context, id int32) (*Reason, error)
+
+	// SaveReason adds reason, or replaces the one with its ID.
+	// ErrReasonCodeTaken when another reason has its code.
+	SaveReason(ctx context.Context, reason *Reason) (*Reason, error)
+
+	// ReasonStats counts what stopped for each reason of kind in
+	// [from, to): cancelled orders and their totals, or refunded orders
+	// and the amounts returned. Reasons nothing stopped for are included.
+	ReasonStats(
+		ctx context.Context,
+		kind ReasonKind,
+		from, to time.Time,
+	) ([]*ReasonStat, error)
+}
+
+// AdjustmentRepo keeps the discounts and charges admins put on orders
+// waiting for payment.
+type AdjustmentRepo interface {
+	// GetAdjustableOrder returns the order with its amounts and the
+	// external id of the checkout session it was placed from, "" when it
+	// has none.
+	GetAdjustableOrder(ctx context.Context, orderID uint) (*Order, string, error)
+
+	// CreateOrderAdjustment saves a pending adjustment.
+	// ErrAdjustmentPending when the order already has one.
+	CreateOrderAdjustment(ctx context.Context, a *OrderAdjustment) (*OrderAdjustment, error)
+
+	// GetOrderAdjustment returns ErrAdjustmentNotFound for an unknown id.
+	GetOrderAdjustment(ctx context.Context, id int64) (*OrderAdjustment, error)
+
+	// ListOrderAdjustments returns the adjustments of an order, oldest
+	// first.
+	ListOrderAdjustments(ctx context.Context, orderID uint) ([]*OrderAdjustment, error)
+
+	// ApplyOrderAdjustment approves the pending adjustment id in one
+	// transaction: it moves the order's total, voids the pending payment
+	// replaced and records invoice as the order's payment. It fails with
+	// ErrOrderNotAdjustable once the order is no longer waiting for
+	// payment, and returns the order's new amounts.
+	ApplyOrderAdjustment(
+		ctx context.Context,
+		id int64,
+		reviewedBy uint,
+		replaced string,
+		invoice *payment.Payment,
+	) (*OrderAdjustment, *Order, error)
+
+	// RejectOrderAdjustment closes the pending adjustment id unapplied.
+	RejectOrderAdjustment(ctx context.Context, id int64, reviewedBy uint) (*OrderAdjustment, error)
+}
+
 type repository struct {
 	db *sql.DB
+	q  *dbgen.Queries
 }
 
 func NewRepository(db *sql.DB) Repository {
-	return &repository{db: db}
+	return &repository{db: db, q: dbgen.New(db)}
 }
 
 func (r *repository) GetOrderBySessionID(
@@ -127,15 +462,7 @@ func (r *repository) GetOrderBySessionID(
 		zap.String("method", "GetOrderBySessionID"),
 	)
 
-	query := `
-		SELECT id, status, total_amount, external_id
-		FROM orders
-		WHERE checkout_session_id = $1
-	`
-
-	var o Order
-	err := r.db.QueryRowContext(ctx, query, sessionID).
-		Scan(&o.ID, &o.Status, &o.TotalAmount, &o.ExternalID)
+	row, err := r.q.GetOrderBySessionID(ctx, sessionID.String())
 
 	if err == sql.ErrNoRows {
 		return nil, nil
@@ -145,7 +472,12 @@ func (r *repository) GetOrderBySessionID(
 		return nil, ErrDB
 	}
 
-	return &o, nil
+	return &Order{
+		ID:          row.ID,
+		Status:      OrderStatus(row.Status),
+		TotalAmount: uint(row.TotalAmount),
+		ExternalID:  row.ExternalID,
+	}, nil
 }
 
 func (r *repository) GetOrderByExternalID(
@@ -159,23 +491,7 @@ func (r *repository) GetOrderByExternalID(
 		zap.String("external_id", externalID),
 	)
 
-	query := `
-		SELECT id, user_id, status, total_amount, currency, address_id, external_id
-		FROM orders
-		WHERE external_id = $1
-	`
-
-	var o Order
-	err := r.db.QueryRowContext(ctx, query, externalID).
-		Scan(
-			&o.ID,
-			&o.UserID,
-			&o.Status,
-			&o.TotalAmount,
-			&o.Currency,
-			&o.AddressID,
-			&o.ExternalID,
-		)
+	row, err := r.q.GetOrderByExternalID(ctx, externalID)
 
 	if err == sql.ErrNoRows {
 		log.Info("order not found",
@@ -192,6 +508,25 @@ func (r *repository) GetOrderByExternalID(
 		return nil, ErrDB
 	}
 
+	addressID, err := uuid.Parse(row.AddressID)
+	if err != nil {
+		log.Error("failed to parse order address id",
+			zap.String("external_id", externalID),
+			zap.Error(err),
+		)
+		return nil, ErrDB
+	}
+	o := Order{
+		ID:           row.ID,
+		UserID:       int32Ptr(row.UserID),
+		Status:       OrderStatus(row.Status),
+		TotalAmount:  uint(row.TotalAmount),
+		WalletAmount: uint(row.WalletAmount),
+		Currency:     row.Currency,
+		AddressID:    addressID,
+		ExternalID:   row.ExternalID,
+	}
+
 	log.Info("order fetched successfully",
 		zap.String("external_id", externalID),
 		zap.Uint("order_id", uint(o.ID)),
@@ -200,6 +535,53 @@ func (r *repository) GetOrderByExternalID(
 	return &o, nil
 }
 
+// recordStockIncident logs a checkout that passed stock validation but
+// lost the stock to a concurrent order. It writes outside the order
+// transaction, which is about to roll back, and only logs on failure.
+func (r *repository) recordStockIncident(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	variantID string,
+	requested int,
+) {
+	err := r.q.RecordStockIncident(ctx, dbgen.RecordStockIncidentParams{
+		VariantID:         variantID,
+		CheckoutSessionID: sql.NullString{String: sessionID.String(), Valid: true},
+		Requested:         int32(requested),
+	})
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to record stock incident",
+			zap.String("variant_id", variantID),
+			zap.Error(err),
+		)
+	}
+}
+
+// allocateStock takes qty of a variant from a single active warehouse,
+// preferring one in the shipping address's province, then the default
+// warehouse, then whichever holds the most. ok is false when no warehouse
+// can cover the whole quantity.
+func (r *repository) allocateStock(
+	ctx context.Context,
+	q *dbgen.Queries,
+	variantID string,
+	qty int,
+	addressID *uuid.UUID,
+) (warehouseID string, ok bool, err error) {
+	warehouseID, err = q.AllocateStock(ctx, dbgen.AllocateStockParams{
+		Quantity:  int32(qty),
+		VariantID: variantID,
+		AddressID: nullUUID(addressID),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, err
+	}
+	return warehouseID, true, nil
+}
+
 func (r *repository) CreateOrderTx(
 	ctx context.Context,
 	order *Order,
@@ -220,36 +602,47 @@ func (r *repository) CreateOrderTx(
 		return ErrDB
 	}
 	defer tx.Rollback()
+	qtx := r.q.WithTx(tx)
+
+	// Lock the session so it cannot expire while the order is placed; one
+	// that expired since it was read gets no order.
+	lockedStatus, err := qtx.LockCheckoutSessionStatus(ctx, session.ID.String())
+	if err != nil {
+		log.Error("failed to lock checkout session", zap.Error(err))
+		return ErrDB
+	}
+	switch status := CheckoutSessionStatus(lockedStatus); status {
+	case CheckoutSessionStatusPending:
+	case CheckoutSessionStatusExpired:
+		log.Warn("checkout session expired before the order was placed")
+		return ErrSessionExpired
+	default:
+		log.Warn("checkout session no longer open", zap.String("status", string(status)))
+		return ErrSessionNotEditable
+	}
 
 	// 1. Insert order (RETURNING id)
-	err = tx.QueryRowContext(ctx, `
-		INSERT INTO orders (
-			user_id,
-			checkout_session_id,
-			status,
-			total_amount,
-			currency,
-			external_id,
-			subtotal,
-			tax,
-			shipping_fee,
-			discount,
-			address_id
-		) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11)
-		RETURNING id
-	`,
-		order.UserID,
-		session.ID,
-		order.Status,
-		order.TotalAmount,
-		order.Currency,
-		order.ExternalID,
-		session.Subtotal,
-		session.Tax,
-		session.ShippingFee,
-		session.Discount,
-		session.AddressID,
-	).Scan(&order.ID)
+	var addressID string
+	if session.AddressID != nil {
+		addressID = session.AddressID.String()
+	}
+	order.ID, err = qtx.CreateOrder(ctx, dbgen.CreateOrderParams{
+		UserID:            nullInt32(order.UserID),
+		CheckoutSessionID: session.ID.String(),
+		Status:            dbgen.OrderStatus(order.Status),
+		TotalAmount:       int64(order.TotalAmount),
+		Currency:          order.Currency,
+		ExternalID:        order.ExternalID,
+		Subtotal:          int64(session.Subtotal),
+		Tax:               int64(session.Tax),
+		ShippingFee:       int64(session.ShippingFee),
+		Discount:          int64(session.Discount),
+		AddressID:         addressID,
+		WalletAmount:      int64(order.WalletAmount),
+		PlacedBy:          nullInt32(order.PlacedBy),
+		ShippingMethod:    string(order.ShippingMethod),
+		InsuranceFee:      int32(session.InsuranceFee()),
+	})
 	if err != nil {
 		log.Error("failed to insert order", zap.Error(err))
 		return ErrDB
@@ -260,68 +653,125 @@ func (r *repository) CreateOrderTx(
 		zap.Int("items_count", len(session.Items)),
 	)
 
-	// 2. Insert order items + deduct stock
-	for _, item := range session.Items {
+	if p := order.Pickup; p != nil {
+		err = qtx.CreateOrderPickup(ctx, dbgen.CreateOrderPickupParams{
+			OrderID:    order.ID,
+			LocationID: p.Location.ID,
+			SlotStart:  p.SlotStart,
+			SlotEnd:    p.SlotEnd,
+			Code:       p.Code,
+		})
+		if err != nil {
+			log.Error("failed to insert order pickup", zap.Error(err))
+			return ErrDB
+		}
+	}
 
-		_, err = tx.ExecContext(ctx, `
-			INSERT INTO order_items (
-				order_id,
-				quantity,
-				unit_price,
-				variant_id,
-				variant_name,
-				product_name,
-				subtotal,
-				image_url
-			) VALUES ($1,$2,$3,$4,$5,$6,$7,$8)
-		`,
-			order.ID,
-			item.Quantity,
-			item.Price,
-			item.VariantID,
-			item.VariantName,
-			item.ProductName,
-			item.Subtotal,
-			item.ImageURL,
-		)
+	for _, p := range order.AcceptedPolicies {
+		err = qtx.CreateOrderPolicyAcceptance(ctx, dbgen.CreateOrderPolicyAcceptanceParams{
+			OrderID:  order.ID,
+			PolicyID: p.ID,
+		})
 		if err != nil {
-			log.Error("failed to insert order item",
-				zap.String("variant_id", item.VariantID),
+			log.Error("failed to record policy acceptance",
+				zap.Int32("policy_id", p.ID),
 				zap.Error(err),
 			)
 			return ErrDB
 		}
+	}
 
-		// Deduct stock (safe)
-		res, err := tx.ExecContext(ctx, `
-			UPDATE variants
-			SET stock = stock - $1
-			WHERE id = $2 AND stock >= $1
-		`,
-			item.Quantity,
-			item.VariantID,
-		)
+	if d := order.Delivery; d != nil {
+		if err := r.bookDeliverySlot(ctx, qtx, order.ID, d); err != nil {
+			log.Warn("failed to book delivery slot",
+				zap.Int32("slot_id", d.SlotID),
+				zap.Error(err),
+			)
+			return err
+		}
+	}
+
+	// 2. Allocate stock from a warehouse + insert order items
+	for _, item := range session.Items {
+
+		warehouseID, ok, err := r.allocateStock(ctx, qtx, item.VariantID, item.Quantity, session.AddressID)
 		if err != nil {
-			log.Error("failed to deduct stock",
+			log.Error("failed to allocate stock",
 				zap.String("variant_id", item.VariantID),
 				zap.Error(err),
 			)
 			return ErrDB
 		}
-
-		rows, _ := res.RowsAffected()
-		if rows == 0 {
+		if !ok {
 			log.Warn("insufficient stock during order creation",
 				zap.String("variant_id", item.VariantID),
 				zap.Int("quantity", item.Quantity),
 			)
-			return errors.New("insufficient stock")
+			r.recordStockIncident(ctx, session.ID, item.VariantID, item.Quantity)
+			return ErrInsufficientStock
+		}
+
+		err = qtx.CreateOrderItem(ctx, dbgen.CreateOrderItemParams{
+			OrderID:     order.ID,
+			Quantity:    int32(item.Quantity),
+			UnitPrice:   float64(item.Price),
+			VariantID:   sql.NullString{String: item.VariantID, Valid: true},
+			VariantName: sql.NullString{String: item.VariantName, Valid: true},
+			ProductName: sql.NullString{String: item.ProductName, Valid: true},
+			Subtotal:    sql.NullFloat64{Float64: float64(item.Subtotal), Valid: true},
+			ImageUrl:    nullString(item.ImageURL),
+			WarehouseID: sql.NullString{String: warehouseID, Valid: true},
+		})
+		if err != nil {
+			log.Error("failed to insert order item",
+				zap.String("variant_id", item.VariantID),
+				zap.Error(err),
+			)
+			return ErrDB
 		}
 	}
 
 	log.Info("all order items inserted and stock deducted")
 
-	// 4. Commit
+	if err := r.chargeCommission(ctx, qtx, order.ID); err != nil {
+		return err
+	}
+
+	// 3. Settle wallet portion of a split payment
+	if order.WalletAmount > 0 {
+		if err := r.debitWalletForOrder(ctx, qtx, order); err != nil {
+			return err
+		}
+
+		log.Info("wallet portion settled",
+			zap.Uint("wallet_amount", order.WalletAmount),
+		)
+	}
+
+	// 4. Redeem applied voucher
+	if session.VoucherID != nil {
+		if err := r.redeemVoucherForOrder(ctx, qtx, order, session); err != nil {
+			return err
+		}
+
+		log.Info("voucher redeemed",
+			zap.Int64("voucher_id", *session.VoucherID),
+			zap.Int("discount", session.Discount),
+		)
+	}
+
+	// 5. Burn redeemed loyalty points
+	if session.PointsRedeemed > 0 {
+		if err := r.burnPointsForOrder(ctx, qtx, order, session.PointsRedeemed); err != nil {
+			return err
+		}
+
+		log.Info("loyalty points burned",
+			zap.Int("points", session.PointsRedeemed),
+		)
+	}
+
+	// 6. Commit
 	if err := tx.Commit(); err != nil {
 		log.Error("failed to commit order transaction", zap.Error(err))
 		return ErrDB
@@ -334,45 +784,300 @@ func (r *repository) CreateOrderTx(
 	return nil
 }
 
-// ✅ Create new order from user’s cart
-// func (r *repository) CreateOrder(userID uint) (*Order, error) {
-// 	tx, err := r.db.Begin()
-// 	if err != nil {
-// 		return nil, err
-// 	}
-// 	defer tx.Rollback()
+// chargeCommission fixes each order line's marketplace commission at the
+// rate its category is charged now, falling back to the default rate. Lines
+// with no rate at all are left at zero. The percentage is rounded to the
+// nearest rupiah before the flat fee is added. The rate is stored with the
+// line so later rate changes do not touch placed orders.
+func (r *repository) chargeCommission(
+	ctx context.Context,
+	q *dbgen.Queries,
+	orderID int32,
+) error {
+	err := q.ChargeOrderCommission(ctx, orderID)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to charge commission",
+			zap.Int32("order_id", orderID),
+			zap.Error(err),
+		)
+		return ErrDB
+	}
+	return nil
+}
 
-// 	// 1️⃣ Get cart items
-// 	rows, err := tx.Query(`
-// 		SELECT c.product_id, c.quantity, p.price
-// 		FROM carts c
-// 		JOIN products p ON p.id = c.product_id
-// 		WHERE c.user_id = $1
-// 	`, userID)
-// 	if err != nil {
-// 		return nil, err
-// 	}
-// 	defer rows.Close()
+// debitWalletForOrder takes the wallet portion of a split payment inside the
+// order transaction and records it as an already PAID payment row, so the
+// gateway only has to collect the remainder.
+func (r *repository) debitWalletForOrder(
+	ctx context.Context,
+	q *dbgen.Queries,
+	order *Order,
+) error {
 
-// 	var items []*OrderItem
-// 	var total int
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "debitWalletForOrder"),
+		zap.Int32("order_id", order.ID),
+	)
 
-// 	for rows.Next() {
-// 		var item OrderItem
-// 		err := rows.Scan(&item.ProductID, &item.Quantity, &item.Pricing.Price)
-// 		if err != nil {
-// 			return nil, err
-// 		}
-// 		items = append(items, &item)
-// 		total += item.Quantity * int(item.Price)
-// 	}
+	if order.UserID == nil {
+		log.Warn("wallet payment requires a user")
+		return ErrWalletRequiresUser
+	}
 
-// 	if len(items) == 0 {
-// 		return nil, errors.New("cart is empty")
-// 	}
+	debited, err := q.DebitWallet(ctx, dbgen.DebitWalletParams{
+		Balance: int64(order.WalletAmount),
+		UserID:  *order.UserID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		log.Warn("insufficient wallet balance",
+			zap.Uint("wallet_amount", order.WalletAmount),
+		)
+		return ErrInsufficientWallet
+	}
+	if err != nil {
+		log.Error("failed to debit wallet", zap.Error(err))
+		return ErrDB
+	}
 
-// 	// 2️⃣ Create order
-// 	var orderID uint
+	err = q.CreateWalletLedgerEntry(ctx, dbgen.CreateWalletLedgerEntryParams{
+		WalletID:      debited.ID,
+		EntryType:     string(wallet.EntryDebit),
+		Amount:        int64(order.WalletAmount),
+		BalanceAfter:  debited.Balance,
+		ReferenceType: wallet.ReferenceOrderPayment,
+		ReferenceID:   order.ExternalID,
+	})
+	if err != nil {
+		log.Error("failed to insert wallet ledger entry", zap.Error(err))
+		return ErrDB
+	}
+
+	err = q.CreateWalletPayment(ctx, dbgen.CreateWalletPaymentParams{
+		OrderID:           order.ID,
+		ExternalReference: payment.WalletReference(order.ExternalID),
+		Amount:            int64(order.WalletAmount),
+		Status:            string(PaymentStatusPaid),
+		PaymentMethod:     sql.NullString{String: string(payment.MethodWallet), Valid: true},
+		Provider:          payment.ProviderWallet,
+		Currency:          order.Currency,
+	})
+	if err != nil {
+		log.Error("failed to insert wallet payment", zap.Error(err))
+		return ErrDB
+	}
+
+	return nil
+}
+
+// returnWalletForOrder credits the wallet portion of the order with
+// externalID back through the transaction q runs in and voids its wallet
+// payment. Only a PAID wallet payment is voided, so the portion goes back
+// at most once.
+func returnWalletForOrder(
+	ctx context.Context,
+	q *dbgen.Queries,
+	externalID string,
+) error {
+
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "returnWalletForOrder"),
+		zap.String("order_external_id", externalID),
+	)
+
+	voided, err := q.VoidWalletPayment(ctx, dbgen.VoidWalletPaymentParams{
+		Status:            string(PaymentStatusVoided),
+		ExternalReference: payment.WalletReference(externalID),
+		FromStatus:        string(PaymentStatusPaid),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		// Paid in full at the gateway, or already returned
+		return nil
+	}
+	if err != nil {
+		log.Error("failed to void wallet payment", zap.Error(err))
+		return ErrDB
+	}
+
+	credited, err := q.CreditWallet(ctx, dbgen.CreditWalletParams{
+		Balance: voided.Amount,
+		UserID:  voided.UserID.Int32,
+	})
+	if err != nil {
+		log.Error("failed to credit wallet", zap.Error(err))
+		return ErrDB
+	}
+
+	err = q.CreateWalletLedgerEntry(ctx, dbgen.CreateWalletLedgerEntryParams{
+		WalletID:      credited.ID,
+		EntryType:     string(wallet.EntryCredit),
+		Amount:        voided.Amount,
+		BalanceAfter:  credited.Balance,
+		ReferenceType: wallet.ReferenceOrderPayment,
+		ReferenceID:   externalID,
+	})
+	if err != nil {
+		log.Error("failed to insert wallet ledger entry", zap.Error(err))
+		return ErrDB
+	}
+
+	log.Info("wallet portion returned", zap.Int64("amount", voided.Amount))
+	return nil
+}
+
+// redeemVoucherForOrder records the voucher redemption inside the order
+// transaction. The voucher row is locked so concurrent checkouts cannot
+// exceed its usage limit.
+func (r *repository) redeemVoucherForOrder(
+	ctx context.Context,
+	q *dbgen.Queries,
+	order *Order,
+	session *CheckoutSession,
+) error {
+
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "redeemVoucherForOrder"),
+		zap.Int32("order_id", order.ID),
+		zap.Int64("voucher_id", *session.VoucherID),
+	)
+
+	voucher, err := q.LockVoucher(ctx, *session.VoucherID)
+	if errors.Is(err, sql.ErrNoRows) {
+		log.Warn("voucher no longer active")
+		return ErrVoucherInactive
+	}
+	if err != nil {
+		log.Error("failed to lock voucher", zap.Error(err))
+		return ErrDB
+	}
+
+	if voucher.UsageLimit.Valid {
+		used, err := q.CountVoucherRedemptions(ctx, *session.VoucherID)
+		if err != nil {
+			log.Error("failed to count voucher redemptions", zap.Error(err))
+			return ErrDB
+		}
+		if used >= int64(voucher.UsageLimit.Int32) {
+			log.Warn("voucher usage limit reached", zap.Int64("used", used))
+			return ErrVoucherExhausted
+		}
+	}
+
+	err = q.CreateVoucherRedemption(ctx, dbgen.CreateVoucherRedemptionParams{
+		VoucherID:      *session.VoucherID,
+		CampaignID:     voucher.CampaignID,
+		OrderID:        order.ID,
+		UserID:         nullInt32(order.UserID),
+		DiscountAmount: int64(session.Discount),
+	})
+	if err != nil {
+		log.Error("failed to insert voucher redemption", zap.Error(err))
+		return ErrDB
+	}
+
+	return nil
+}
+
+// burnPointsForOrder deducts redeemed loyalty points inside the order
+// transaction, consuming the oldest-expiring earn lots first.
+func (r *repository) burnPointsForOrder(
+	ctx context.Context,
+	q *dbgen.Queries,
+	order *Order,
+	points int,
+) error {
+
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "burnPointsForOrder"),
+		zap.Int32("order_id", order.ID),
+	)
+
+	if order.UserID == nil {
+		log.Warn("loyalty points require a user")
+		return ErrUnauthorized
+	}
+
+	// Locks the account row, serializing burns and expiry per user
+	balanceAfter, err := q.DebitLoyaltyPoints(ctx, dbgen.DebitLoyaltyPointsParams{
+		Balance: int64(points),
+		UserID:  *order.UserID,
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		log.Warn("insufficient loyalty points", zap.Int("points", points))
+		return ErrInsufficientPoints
+	}
+	if err != nil {
+		log.Error("failed to deduct loyalty points", zap.Error(err))
+		return ErrDB
+	}
+
+	err = q.ConsumeLoyaltyLots(ctx, dbgen.ConsumeLoyaltyLotsParams{
+		Points: int64(points),
+		UserID: *order.UserID,
+	})
+	if err != nil {
+		log.Error("failed to consume loyalty lots", zap.Error(err))
+		return ErrDB
+	}
+
+	err = q.CreateLoyaltyLedgerEntry(ctx, dbgen.CreateLoyaltyLedgerEntryParams{
+		UserID:        *order.UserID,
+		EntryType:     string(loyalty.EntryBurn),
+		Points:        int64(points),
+		BalanceAfter:  balanceAfter,
+		ReferenceType: loyalty.ReferenceOrder,
+		ReferenceID:   order.ExternalID,
+	})
+	if err != nil {
+		log.Error("failed to insert loyalty ledger entry", zap.Error(err))
+		return ErrDB
+	}
+
+	return nil
+}
+
+// ✅ Create new order from user’s cart
+// func (r *repository) CreateOrder(userID uint) (*Order, error) {
+// 	tx, err := r.db.Begin()
+// 	if err != nil {
+// 		return nil, err
+// 	}
+// 	defer tx.Rollback()
+
+// 	// 1️⃣ Get cart items
+// 	rows, err := tx.Query(`
+// 		SELECT c.product_id, c.quantity, p.price
+// 		FROM carts c
+// 		JOIN products p ON p.id = c.product_id
+// 		WHERE c.user_id = $1
+// 	`, userID)
+// 	if err != nil {
+// 		return nil, err
+// 	}
+// 	defer rows.Close()
+
+// 	var items []*OrderItem
+// 	var total int
+
+// 	for rows.Next() {
+// 		var item OrderItem
+// 		err := rows.Scan(&item.ProductID, &item.Quantity, &item.Pricing.Price)
+// 		if err != nil {
+// 			return nil, err
+// 		}
+// 		items = append(items, &item)
+// 		total += item.Quantity * int(item.Price)
+// 	}
+
+// 	if len(items) == 0 {
+// 		return nil, errors.New("cart is empty")
+// 	}
+
+// 	// 2️⃣ Create order
+// 	var orderID uint
 // 	err = tx.QueryRow(`
 // 		INSERT INTO orders (user_id, total, status)
 // 		VALUES ($1, $2, 'PENDING')
@@ -414,6 +1119,36 @@ func (r *repository) CreateOrderTx(
 // 	}, nil
 // }
 
+// orderFromDetailRow maps an order detail row. Both detail lookups select
+// the same columns, so callers convert to this row type.
+func orderFromDetailRow(row dbgen.GetOrderDetailRow) (*Order, error) {
+	addressID, err := uuid.Parse(row.AddressID)
+	if err != nil {
+		return nil, err
+	}
+	return &Order{
+		ID:             row.ID,
+		UserID:         int32Ptr(row.UserID),
+		TotalAmount:    uint(row.TotalAmount),
+		Status:         OrderStatus(row.Status),
+		CreatedAt:      row.CreatedAt,
+		UpdatedAt:      row.UpdatedAt,
+		Currency:       row.Currency,
+		AddressID:      addressID,
+		ExternalID:     row.ExternalID,
+		Subtotal:       uint(row.Subtotal),
+		Tax:            uint(row.Tax),
+		ShippingFee:    uint(row.ShippingFee),
+		Discount:       uint(row.Discount),
+		InvoiceNumber:  stringPtr(row.InvoiceNumber),
+		ShippingMethod: ShippingMethod(row.ShippingMethod),
+		InsuranceFee:   uint(row.InsuranceFee),
+		Adjustment:     int(row.AdjustmentAmount),
+		CancelReasonID: int32Ptr(row.CancelReasonID),
+		CancelNote:     stringPtr(row.CancelNote),
+	}, nil
+}
+
 // ✅ Get detailed order with items
 func (r *repository) GetOrderDetail(
 	ctx context.Context,
@@ -428,30 +1163,8 @@ func (r *repository) GetOrderDetail(
 
 	log.Debug("fetching order")
 
-	var o Order
-
 	// Fetch order
-	err := r.db.QueryRowContext(ctx, `
-		SELECT id, user_id, total_amount, status, created_at, updated_at, currency, 
-		address_id, external_id, subtotal, tax, shipping_fee, discount, invoice_number
-		FROM orders
-		WHERE id = $1
-	`, orderID).Scan(
-		&o.ID,
-		&o.UserID,
-		&o.TotalAmount,
-		&o.Status,
-		&o.CreatedAt,
-		&o.UpdatedAt,
-		&o.Currency,
-		&o.AddressID,
-		&o.ExternalID,
-		&o.Subtotal,
-		&o.Tax,
-		&o.ShippingFee,
-		&o.Discount,
-		&o.InvoiceNumber,
-	)
+	row, err := r.q.GetOrderDetail(ctx, int32(orderID))
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -462,52 +1175,40 @@ func (r *repository) GetOrderDetail(
 		log.Error("failed to query order", zap.Error(err))
 		return nil, ErrDB
 	}
+	o, err := orderFromDetailRow(row)
+	if err != nil {
+		log.Error("failed to parse order", zap.Error(err))
+		return nil, ErrDB
+	}
 
 	// Fetch order items
-	rows, err := r.db.QueryContext(ctx, `
-		SELECT id, order_id, quantity, unit_price, variant_id, variant_name, product_name, subtotal, image_url, quantity_type
-		FROM order_items
-		WHERE order_id = $1
-	`, orderID)
+	items, err := r.q.ListOrderItems(ctx, o.ID)
 	if err != nil {
 		log.Error("failed to query order items", zap.Error(err))
 		return nil, ErrDB
 	}
-	defer rows.Close()
-
-	o.Items = make([]*OrderItem, 0)
-
-	for rows.Next() {
-		var item OrderItem
-		if err := rows.Scan(
-			&item.ID,
-			&item.OrderID,
-			&item.Quantity,
-			&item.Price,
-			&item.VariantID,
-			&item.VariantName,
-			&item.ProductName,
-			&item.Subtotal,
-			&item.ImageURL,
-			&item.QuantityType,
-		); err != nil {
-			log.Error("failed to scan order item", zap.Error(err))
-			return nil, ErrDB
-		}
-
-		o.Items = append(o.Items, &item)
-	}
 
-	if err := rows.Err(); err != nil {
-		log.Error("row iteration error", zap.Error(err))
-		return nil, ErrDB
+	o.Items = make([]*OrderItem, 0, len(items))
+	for _, item := range items {
+		o.Items = append(o.Items, &OrderItem{
+			ID:           uint(item.ID),
+			OrderID:      uint(item.OrderID),
+			Quantity:     int(item.Quantity),
+			Price:        item.UnitPrice,
+			VariantID:    item.VariantID.String,
+			VariantName:  item.VariantName.String,
+			ProductName:  item.ProductName.String,
+			Subtotal:     item.Subtotal.Float64,
+			ImageURL:     stringPtr(item.ImageUrl),
+			QuantityType: item.QuantityType,
+		})
 	}
 
 	log.Debug("order fetched successfully",
 		zap.Int("items_count", len(o.Items)),
 	)
 
-	return &o, nil
+	return o, nil
 }
 
 func (r *repository) GetOrderDetailByExternalID(
@@ -523,30 +1224,8 @@ func (r *repository) GetOrderDetailByExternalID(
 
 	log.Debug("fetching order")
 
-	var o Order
-
 	// Fetch order
-	err := r.db.QueryRowContext(ctx, `
-		SELECT id, user_id, total_amount, status, created_at, updated_at, currency, 
-		address_id, external_id, subtotal, tax, shipping_fee, discount, invoice_number
-		FROM orders
-		WHERE external_id = $1
-	`, externalID).Scan(
-		&o.ID,
-		&o.UserID,
-		&o.TotalAmount,
-		&o.Status,
-		&o.CreatedAt,
-		&o.UpdatedAt,
-		&o.Currency,
-		&o.AddressID,
-		&o.ExternalID,
-		&o.Subtotal,
-		&o.Tax,
-		&o.ShippingFee,
-		&o.Discount,
-		&o.InvoiceNumber,
-	)
+	row, err := r.q.GetOrderDetailByExternalID(ctx, externalID)
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -557,52 +1236,40 @@ func (r *repository) GetOrderDetailByExternalID(
 		log.Error("failed to query order", zap.Error(err))
 		return nil, ErrDB
 	}
+	o, err := orderFromDetailRow(dbgen.GetOrderDetailRow(row))
+	if err != nil {
+		log.Error("failed to parse order", zap.Error(err))
+		return nil, ErrDB
+	}
 
 	// Fetch order items
-	rows, err := r.db.QueryContext(ctx, `
-		SELECT id, order_id, quantity, unit_price, variant_id, variant_name, product_name, subtotal, image_url, quantity_type
-		FROM order_items
-		WHERE order_id = $1
-	`, o.ID)
+	items, err := r.q.ListOrderItems(ctx, o.ID)
 	if err != nil {
 		log.Error("failed to query order items", zap.Error(err))
 		return nil, ErrDB
 	}
-	defer rows.Close()
-
-	o.Items = make([]*OrderItem, 0)
-
-	for rows.Next() {
-		var item OrderItem
-		if err := rows.Scan(
-			&item.ID,
-			&item.OrderID,
-			&item.Quantity,
-			&item.Price,
-			&item.VariantID,
-			&item.VariantName,
-			&item.ProductName,
-			&item.Subtotal,
-			&item.ImageURL,
-			&item.QuantityType,
-		); err != nil {
-			log.Error("failed to scan order item", zap.Error(err))
-			return nil, ErrDB
-		}
-
-		o.Items = append(o.Items, &item)
-	}
 
-	if err := rows.Err(); err != nil {
-		log.Error("row iteration error", zap.Error(err))
-		return nil, ErrDB
+	o.Items = make([]*OrderItem, 0, len(items))
+	for _, item := range items {
+		o.Items = append(o.Items, &OrderItem{
+			ID:           uint(item.ID),
+			OrderID:      uint(item.OrderID),
+			Quantity:     int(item.Quantity),
+			Price:        item.UnitPrice,
+			VariantID:    item.VariantID.String,
+			VariantName:  item.VariantName.String,
+			ProductName:  item.ProductName.String,
+			Subtotal:     item.Subtotal.Float64,
+			ImageURL:     stringPtr(item.ImageUrl),
+			QuantityType: item.QuantityType,
+		})
 	}
 
 	log.Debug("order fetched successfully",
 		zap.Int("items_count", len(o.Items)),
 	)
 
-	return &o, nil
+	return o, nil
 }
 
 // ✅ Admin: Update order status
@@ -614,22 +1281,28 @@ func (r *repository) UpdateOrderStatus(ctx context.Context, orderID uint, status
 		zap.String("status", string(status)),
 	)
 
-	var res sql.Result
+	var rowsAffected int64
 	var err error
 
 	if invoiceNumber != nil {
 		log.Debug("updating status with invoice number", zap.String("invoice_number", *invoiceNumber))
-		res, err = r.db.ExecContext(ctx, `UPDATE orders SET status = $1, invoice_number = $2, updated_at = NOW() WHERE id = $3`, status, *invoiceNumber, orderID)
+		rowsAffected, err = r.q.UpdateOrderStatusAndInvoice(ctx, dbgen.UpdateOrderStatusAndInvoiceParams{
+			Status:        dbgen.OrderStatus(status),
+			InvoiceNumber: sql.NullString{String: *invoiceNumber, Valid: true},
+			ID:            int32(orderID),
+		})
 	} else {
 		log.Debug("updating status without invoice number")
-		res, err = r.db.ExecContext(ctx, `UPDATE orders SET status = $1, updated_at = NOW() WHERE id = $2`, status, orderID)
+		rowsAffected, err = r.q.UpdateOrderStatus(ctx, dbgen.UpdateOrderStatusParams{
+			Status: dbgen.OrderStatus(status),
+			ID:     int32(orderID),
+		})
 	}
 
 	if err != nil {
 		log.Error("failed to execute update query", zap.Error(err))
 		return ErrDB
 	}
-	rowsAffected, _ := res.RowsAffected()
 	if rowsAffected == 0 {
 		log.Warn("order not found or no change")
 		return fmt.Errorf("order not found")
@@ -639,6 +1312,44 @@ func (r *repository) UpdateOrderStatus(ctx context.Context, orderID uint, status
 	return nil
 }
 
+// CancelOrder cancels the order with its reason in the same update, so
+// the trigger stamping system reasons leaves it alone.
+func (r *repository) CancelOrder(ctx context.Context, orderID uint, reasonID int32, note *string) error {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "CancelOrder"),
+		zap.Uint("order_id", orderID),
+		zap.Int32("reason_id", reasonID),
+	)
+
+	n, err := r.q.CancelOrder(ctx, dbgen.CancelOrderParams{
+		Status:         dbgen.OrderStatusCANCELLED,
+		CancelReasonID: sql.NullInt32{Int32: reasonID, Valid: true},
+		CancelNote:     nullString(note),
+		ID:             int32(orderID),
+	})
+	if err != nil {
+		log.Error("failed to cancel order", zap.Error(err))
+		return ErrDB
+	}
+	if n == 0 {
+		log.Warn("order not found")
+		return ErrOrderNotFound
+	}
+
+	log.Info("order cancelled in db")
+	return nil
+}
+
+func (r *repository) IsOrderPacked(ctx context.Context, orderID uint) (bool, error) {
+	packed, err := r.q.IsOrderPacked(ctx, int32(orderID))
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to check pack status", zap.Uint("order_id", orderID), zap.Error(err))
+		return false, ErrDB
+	}
+	return packed, nil
+}
+
 func (r *repository) UpdateStatusByReferenceID(
 	ctx context.Context,
 	referenceID string,
@@ -670,80 +1381,42 @@ func (r *repository) UpdateStatusByReferenceID(
 		}
 	}()
 
-	// --------------------------------------------------
-	// 1. Update order (LOCK ROW) & get checkout_session_id
-	// --------------------------------------------------
-	var sessionID string
-	queryOrder := `
-		UPDATE orders
-		SET status = $1
-		WHERE external_id = $2
-		RETURNING checkout_session_id
-	`
-
-	err = tx.QueryRowContext(ctx, queryOrder, status, referenceID).
-		Scan(&sessionID)
-	if err != nil {
-		log.Error("failed to update order status", zap.Error(err))
-		return ErrDB
-	}
-
-	log.Info("order status updated",
-		zap.String("checkout_session_id", sessionID),
-	)
-
-	// --------------------------------------------------
-	// 2. Update checkout session
-	// --------------------------------------------------
-	querySession := `
-		UPDATE checkout_sessions
-		SET status = $1
-		WHERE id = $2
-	`
-
-	res, err := tx.ExecContext(ctx, querySession, status, sessionID)
-	if err != nil {
-		log.Error("failed to update checkout session", zap.Error(err))
-		return ErrDB
-	}
+	qtx := r.q.WithTx(tx)
 
-	rows, _ := res.RowsAffected()
-	if rows == 0 {
-		err = fmt.Errorf("checkout session not found: %s", sessionID)
-		log.Warn("checkout session not found", zap.String("session_id", sessionID))
+	if _, err = moveOrderAndSession(ctx, qtx, log, referenceID, status); err != nil {
 		return err
 	}
 
-	log.Info("checkout session status updated")
+	// A failed gateway payment leaves nothing to settle the order, so the
+	// wallet portion goes back with it
+	if status == string(OrderStatusFailed) {
+		if err = returnWalletForOrder(ctx, qtx, referenceID); err != nil {
+			return err
+		}
+	}
 
 	// --------------------------------------------------
 	// 3. Update payment
 	// --------------------------------------------------
-	queryPayment := `
-		UPDATE payments
-		SET status = $1,
-		    provider_payment_id = $2
-	`
-
-	args := []any{status, paymentProviderID}
-
+	var rows int64
 	if status == string(OrderStatusPaid) {
-		queryPayment += `, paid_at = now()`
+		rows, err = qtx.SetPaymentProviderStatusPaid(ctx, dbgen.SetPaymentProviderStatusPaidParams{
+			Status:            status,
+			ProviderPaymentID: sql.NullString{String: paymentProviderID, Valid: true},
+			ExternalReference: paymentRequestID,
+		})
+	} else {
+		rows, err = qtx.SetPaymentProviderStatus(ctx, dbgen.SetPaymentProviderStatusParams{
+			Status:            status,
+			ProviderPaymentID: sql.NullString{String: paymentProviderID, Valid: true},
+			ExternalReference: paymentRequestID,
+		})
 	}
-
-	queryPayment += `
-		WHERE external_reference = $3
-	`
-
-	args = append(args, paymentRequestID)
-
-	res, err = tx.ExecContext(ctx, queryPayment, args...)
 	if err != nil {
 		log.Error("failed to update payment status", zap.Error(err))
 		return ErrDB
 	}
 
-	rows, _ = res.RowsAffected()
 	if rows == 0 {
 		log.Warn("payment not found",
 			zap.String("external_reference", paymentRequestID),
@@ -764,47 +1437,386 @@ func (r *repository) UpdateStatusByReferenceID(
 	return nil
 }
 
-func (r *repository) GetByReferenceID(
+func (r *repository) MarkPaidByReferenceID(
 	ctx context.Context,
 	referenceID string,
-) (*Order, error) {
+	capture payment.Capture,
+) (err error) {
 
 	log := logger.FromCtx(ctx).With(
 		zap.String("layer", "repository"),
-		zap.String("method", "GetByReferenceID"),
+		zap.String("method", "MarkPaidByReferenceID"),
 		zap.String("reference_id", referenceID),
+		zap.String("payment_request_id", capture.ExternalReference),
 	)
 
-	log.Debug("fetching order by reference id")
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error("failed to start transaction", zap.Error(err))
+		return ErrDB
+	}
 
-	query := `
-		SELECT
-			id,
-			total_amount,
-			status
-		FROM orders
-		WHERE external_id = $1
-		LIMIT 1
-	`
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+			log.Warn("transaction rolled back due to error", zap.Error(err))
+		}
+	}()
 
-	var o Order
-	err := r.db.QueryRowContext(ctx, query, referenceID).
-		Scan(&o.ID, &o.TotalAmount, &o.Status)
+	qtx := r.q.WithTx(tx)
 
+	orderID, err := moveOrderAndSession(ctx, qtx, log, referenceID, string(OrderStatusPaid))
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			log.Warn("order not found")
-			return nil, fmt.Errorf("order not found with reference_id: %s", referenceID)
-		}
+		return err
+	}
 
-		log.Error("failed to query order", zap.Error(err))
-		return nil, ErrDB
+	capturedAmount := sql.NullInt64{Int64: capture.Amount, Valid: capture.Amount > 0}
+	feeAmount := sql.NullInt64{Int64: capture.Fee, Valid: capture.Fee > 0}
+	channelCode := sql.NullString{String: capture.ChannelCode, Valid: capture.ChannelCode != ""}
+	paidAt := sql.NullTime{Time: capture.PaidAt, Valid: !capture.PaidAt.IsZero()}
+
+	rows, err := qtx.CapturePayment(ctx, dbgen.CapturePaymentParams{
+		Status:            string(PaymentStatusPaid),
+		ProviderPaymentID: sql.NullString{String: capture.ProviderPaymentID, Valid: true},
+		CapturedAmount:    capturedAmount,
+		FeeAmount:         feeAmount,
+		ChannelCode:       channelCode,
+		PaidAt:            paidAt,
+		ExternalReference: capture.ExternalReference,
+	})
+	if err != nil {
+		log.Error("failed to update payment", zap.Error(err))
+		return ErrDB
 	}
 
-	log.Debug("order fetched successfully",
-		zap.Int32("order_id", o.ID),
-		zap.String("status", string(o.Status)),
-	)
+	if rows == 0 {
+		// Without a captured amount there is nothing to rebuild the row from
+		if !capturedAmount.Valid {
+			log.Warn("payment not found")
+		} else {
+			// The payment row was lost between creating the invoice and
+			// saving it; rebuild it from the provider's report so the
+			// payments table agrees with the order.
+			log.Warn("payment not found, recreating it from the capture")
+			if err = qtx.RecreateCapturedPayment(ctx, dbgen.RecreateCapturedPaymentParams{
+				ExternalReference: capture.ExternalReference,
+				Amount:            capture.Amount,
+				Status:            string(PaymentStatusPaid),
+				ChannelCode:       capture.ChannelCode,
+				Provider:          payment.ProviderXendit,
+				ProviderPaymentID: sql.NullString{String: capture.ProviderPaymentID, Valid: true},
+				PaidAt:            paidAt,
+				FeeAmount:         feeAmount,
+				OrderID:           orderID,
+			}); err != nil {
+				log.Error("failed to recreate payment", zap.Error(err))
+				return ErrDB
+			}
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Error("failed to commit transaction", zap.Error(err))
+		return ErrDB
+	}
+
+	log.Info("order, session and payment marked paid")
+	return nil
+}
+
+func (r *repository) ListExpiredPayments(
+	ctx context.Context,
+	now time.Time,
+	limit int32,
+) ([]*ExpiredPayment, error) {
+	rows, err := r.q.ListExpiredPayments(ctx, dbgen.ListExpiredPaymentsParams{
+		PaymentStatus: string(PaymentStatusPending),
+		Provider:      payment.ProviderXendit,
+		ExpiredBefore: now,
+		OrderStatus:   dbgen.OrderStatusPENDINGPAYMENT,
+		MaxRows:       limit,
+	})
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to list expired payments", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	var out []*ExpiredPayment
+	for _, row := range rows {
+		out = append(out, &ExpiredPayment{
+			OrderID:          row.ID,
+			OrderExternalID:  row.ExternalID,
+			PaymentRequestID: row.ExternalReference,
+			UserID:           int32Ptr(row.UserID),
+			ExpiredAt:        row.ExpireAt.Time,
+		})
+	}
+	return out, nil
+}
+
+func (r *repository) ExpireOrderPayment(
+	ctx context.Context,
+	orderExternalID string,
+	paymentRequestID string,
+) (err error) {
+
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ExpireOrderPayment"),
+		zap.String("reference_id", orderExternalID),
+		zap.String("payment_request_id", paymentRequestID),
+	)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error("failed to start transaction", zap.Error(err))
+		return ErrDB
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	// Lock the order first, as the payment webhooks do, so a capture
+	// arriving now either lands before this check or waits for the cancel
+	qtx := r.q.WithTx(tx)
+	status, err := qtx.LockOrderStatus(ctx, orderExternalID)
+	if err != nil {
+		log.Error("failed to lock order", zap.Error(err))
+		return ErrDB
+	}
+	if OrderStatus(status) != OrderStatusPendingPayment {
+		return ErrPaymentNotPending
+	}
+
+	rows, err := qtx.UpdatePaymentStatusFrom(ctx, dbgen.UpdatePaymentStatusFromParams{
+		Status:            string(PaymentStatusExpired),
+		ExternalReference: paymentRequestID,
+		FromStatus:        string(PaymentStatusPending),
+	})
+	if err != nil {
+		log.Error("failed to expire payment", zap.Error(err))
+		return ErrDB
+	}
+	if rows == 0 {
+		return ErrPaymentNotPending
+	}
+
+	orderID, err := moveOrderAndSession(ctx, qtx, log, orderExternalID, string(OrderStatusCancelled))
+	if err != nil {
+		return err
+	}
+
+	if err = returnWalletForOrder(ctx, qtx, orderExternalID); err != nil {
+		return err
+	}
+
+	if err = qtx.RestockOrder(ctx, orderID); err != nil {
+		log.Error("failed to restock order items", zap.Error(err))
+		return ErrDB
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Error("failed to commit transaction", zap.Error(err))
+		return ErrDB
+	}
+
+	log.Info("order cancelled after its payment expired")
+	return nil
+}
+
+func (r *repository) ListExpiredSessions(
+	ctx context.Context,
+	now time.Time,
+	graceBefore time.Time,
+	limit int32,
+) ([]*ExpiredSession, error) {
+	rows, err := r.q.ListExpiredSessions(ctx, dbgen.ListExpiredSessionsParams{
+		PaymentStatus: string(PaymentStatusPending),
+		Provider:      payment.ProviderXendit,
+		SessionStatus: string(CheckoutSessionStatusPending),
+		ExpiredBefore: now,
+		GraceBefore:   graceBefore,
+		OrderStatus:   dbgen.OrderStatusPENDINGPAYMENT,
+		MaxRows:       limit,
+	})
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to list expired sessions", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	var out []*ExpiredSession
+	for _, row := range rows {
+		id, err := uuid.Parse(row.ID)
+		if err != nil {
+			logger.FromCtx(ctx).Error("failed to parse expired session id", zap.Error(err))
+			return nil, ErrDB
+		}
+		out = append(out, &ExpiredSession{
+			ID:               id,
+			ExternalID:       row.ExternalID,
+			ExpiresAt:        row.ExpiresAt,
+			OrderExternalID:  stringPtr(row.OrderExternalID),
+			OrderID:          int32Ptr(row.OrderID),
+			UserID:           int32Ptr(row.UserID),
+			PaymentRequestID: stringPtr(row.PaymentRequestID),
+		})
+	}
+	return out, nil
+}
+
+func (r *repository) CancelUnpaidSessionOrder(
+	ctx context.Context,
+	orderExternalID string,
+) (err error) {
+
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "CancelUnpaidSessionOrder"),
+		zap.String("reference_id", orderExternalID),
+	)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error("failed to start transaction", zap.Error(err))
+		return ErrDB
+	}
+
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	// Lock the order as ExpireOrderPayment does, so a payment step retried
+	// now either lands first or waits for the cancel
+	qtx := r.q.WithTx(tx)
+	locked, err := qtx.LockUnpaidOrder(ctx, orderExternalID)
+	if err != nil {
+		log.Error("failed to lock order", zap.Error(err))
+		return ErrDB
+	}
+	if OrderStatus(locked.Status) != OrderStatusPendingPayment || locked.Exists {
+		return ErrPaymentNotPending
+	}
+
+	orderID, err := moveOrderAndSession(ctx, qtx, log, orderExternalID, string(OrderStatusCancelled))
+	if err != nil {
+		return err
+	}
+
+	if err = returnWalletForOrder(ctx, qtx, orderExternalID); err != nil {
+		return err
+	}
+
+	if err = qtx.RestockOrder(ctx, orderID); err != nil {
+		log.Error("failed to restock order items", zap.Error(err))
+		return ErrDB
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Error("failed to commit transaction", zap.Error(err))
+		return ErrDB
+	}
+
+	log.Info("order of expired checkout session cancelled")
+	return nil
+}
+
+// moveOrderAndSession sets the status of the order with referenceID through
+// the transaction q runs in and moves its checkout session to match. It
+// returns the order id.
+func moveOrderAndSession(
+	ctx context.Context,
+	q *dbgen.Queries,
+	log *zap.Logger,
+	referenceID string,
+	status string,
+) (int32, error) {
+	sessionStatus, ok := sessionStatusAfterPayment[OrderStatus(status)]
+	if !ok {
+		err := fmt.Errorf("no checkout session status for payment status %s", status)
+		log.Error("unknown payment status", zap.Error(err))
+		return 0, err
+	}
+
+	// --------------------------------------------------
+	// 1. Update order (LOCK ROW) & get checkout_session_id
+	// --------------------------------------------------
+	moved, err := q.SetOrderStatusByExternalID(ctx, dbgen.SetOrderStatusByExternalIDParams{
+		Status:     dbgen.OrderStatus(status),
+		ExternalID: referenceID,
+	})
+	if err != nil {
+		log.Error("failed to update order status", zap.Error(err))
+		return 0, ErrDB
+	}
+
+	log.Info("order status updated",
+		zap.String("checkout_session_id", moved.CheckoutSessionID),
+	)
+
+	// --------------------------------------------------
+	// 2. Update checkout session
+	// --------------------------------------------------
+	rows, err := q.SetCheckoutSessionStatus(ctx, dbgen.SetCheckoutSessionStatusParams{
+		Status: string(sessionStatus),
+		ID:     moved.CheckoutSessionID,
+	})
+	if err != nil {
+		log.Error("failed to update checkout session", zap.Error(err))
+		return 0, ErrDB
+	}
+
+	if rows == 0 {
+		log.Warn("checkout session not found", zap.String("session_id", moved.CheckoutSessionID))
+		return 0, fmt.Errorf("checkout session not found: %s", moved.CheckoutSessionID)
+	}
+
+	log.Info("checkout session status updated")
+	return moved.ID, nil
+}
+
+func (r *repository) GetByReferenceID(
+	ctx context.Context,
+	referenceID string,
+) (*Order, error) {
+
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "GetByReferenceID"),
+		zap.String("reference_id", referenceID),
+	)
+
+	log.Debug("fetching order by reference id")
+
+	row, err := r.q.GetOrderByReferenceID(ctx, referenceID)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("order not found")
+			return nil, fmt.Errorf("order not found with reference_id: %s", referenceID)
+		}
+
+		log.Error("failed to query order", zap.Error(err))
+		return nil, ErrDB
+	}
+	o := Order{
+		ID:           row.ID,
+		TotalAmount:  uint(row.TotalAmount),
+		WalletAmount: uint(row.WalletAmount),
+		Status:       OrderStatus(row.Status),
+		ExternalID:   row.ExternalID,
+		UserID:       int32Ptr(row.UserID),
+		Currency:     row.Currency,
+	}
+
+	log.Debug("order fetched successfully",
+		zap.Int32("order_id", o.ID),
+		zap.String("status", string(o.Status)),
+	)
 
 	return &o, nil
 }
@@ -822,25 +1834,8 @@ func (r *repository) GetVariantForCheckout(
 
 	log.Debug("fetching variant for checkout")
 
-	query := `
-		SELECT
-			v.id,
-			v.name,
-			v.price,
-			v.quantity_type,
-			v.imageurl,
-			v.stock,
-			p.name
-		FROM variants v
-		LEFT JOIN products p ON p.id = v.product_id
-		WHERE v.id = $1
-	`
-
-	var v product.Variant
-	var p product.Product
-
-	err := r.db.QueryRowContext(ctx, query, variantID).
-		Scan(&v.ID, &v.Name, &v.Price, &v.QuantityType, &v.ImageURL, &v.Stock, &p.Name)
+	// The origin is the product's own, else the seller's default
+	row, err := r.q.GetVariantForCheckout(ctx, variantID)
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -855,6 +1850,29 @@ func (r *repository) GetVariantForCheckout(
 		return nil, nil, ErrDB
 	}
 
+	v := product.Variant{
+		ID:           row.ID,
+		Name:         row.Name,
+		Price:        row.Price,
+		QuantityType: row.QuantityType,
+		ImageURL:     row.Imageurl.String,
+		Stock:        row.Stock,
+		Shipping: &product.ShippingSpec{
+			WeightGrams: row.WeightGrams,
+			LengthCm:    row.LengthCm,
+			WidthCm:     row.WidthCm,
+			HeightCm:    row.HeightCm,
+		},
+	}
+	p := product.Product{Name: row.ProductName.String}
+	if row.OriginID.Valid {
+		p.ShipsFrom = &product.ShipsFrom{
+			OriginID: row.OriginID.String,
+			City:     row.OriginCity.String,
+			Location: geo.PointOf(float64Ptr(row.OriginLatitude), float64Ptr(row.OriginLongitude)),
+		}
+	}
+
 	log.Debug(
 		"variant fetched successfully",
 		zap.String("variant_name", v.Name),
@@ -902,24 +1920,25 @@ func (r *repository) CreateCheckoutSession(
 		}
 	}()
 
+	qtx := r.q.WithTx(tx)
+
 	// Insert checkout session
-	_, err = tx.ExecContext(ctx, `
-		INSERT INTO checkout_sessions (
-			id, user_id, status, subtotal, tax, shipping_fee,
-			discount, total_amount, expires_at, external_id
-		) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9, $10)
-	`,
-		session.ID,
-		session.UserID,
-		session.Status,
-		session.Subtotal,
-		session.Tax,
-		session.ShippingFee,
-		session.Discount,
-		session.TotalPrice,
-		session.ExpiresAt,
-		session.ExternalID,
-	)
+	err = qtx.CreateCheckoutSession(ctx, dbgen.CreateCheckoutSessionParams{
+		ID:                    session.ID.String(),
+		UserID:                nullInt32(session.UserID),
+		Status:                string(session.Status),
+		Subtotal:              int64(session.Subtotal),
+		Tax:                   int64(session.Tax),
+		ShippingFee:           int64(session.ShippingFee),
+		Discount:              int64(session.Discount),
+		TotalAmount:           int64(session.TotalPrice),
+		ExpiresAt:             session.ExpiresAt,
+		ExternalID:            session.ExternalID,
+		ChargeableWeightGrams: int32(session.ChargeableWeightGrams),
+		ShippingParcels:       parcelsJSON(session.ShippingParcels),
+		GuestID:               nullUUID(session.GuestID),
+		ShippingMethod:        string(session.ShippingMethod),
+	})
 	if err != nil {
 		log.Error(
 			"failed to insert checkout session",
@@ -932,23 +1951,18 @@ func (r *repository) CreateCheckoutSession(
 
 	// Insert session items
 	for i, item := range items {
-		_, err = tx.ExecContext(ctx, `
-			INSERT INTO checkout_session_items (
-				id, checkout_session_id, variant_id, variant_name, product_name,
-				quantity, quantity_type, imageurl, unit_price, subtotal
-			) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10)
-		`,
-			item.ID,
-			session.ID,
-			item.VariantID,
-			item.VariantName,
-			item.ProductName,
-			item.Quantity,
-			item.QuantityType,
-			item.ImageURL,
-			item.Price,
-			item.Subtotal,
-		)
+		err = qtx.CreateCheckoutSessionItem(ctx, dbgen.CreateCheckoutSessionItemParams{
+			ID:                item.ID.String(),
+			CheckoutSessionID: session.ID.String(),
+			VariantID:         item.VariantID,
+			VariantName:       sql.NullString{String: item.VariantName, Valid: true},
+			ProductName:       item.ProductName,
+			Quantity:          int32(item.Quantity),
+			QuantityType:      sql.NullString{String: item.QuantityType, Valid: true},
+			Imageurl:          nullString(item.ImageURL),
+			UnitPrice:         int64(item.Price),
+			Subtotal:          int64(item.Subtotal),
+		})
 		if err != nil {
 			log.Error(
 				"failed to insert checkout session item",
@@ -977,6 +1991,58 @@ func (r *repository) CreateCheckoutSession(
 	return nil
 }
 
+// sessionFromRow maps the session columns of a GetCheckoutSession row.
+func sessionFromRow(row dbgen.GetCheckoutSessionRow) (*CheckoutSession, error) {
+	id, err := uuid.Parse(row.ID)
+	if err != nil {
+		return nil, err
+	}
+	guestID, err := uuidPtr(row.GuestID)
+	if err != nil {
+		return nil, err
+	}
+	addressID, err := uuidPtr(row.AddressID)
+	if err != nil {
+		return nil, err
+	}
+	s := &CheckoutSession{
+		ID:                    id,
+		ExternalID:            row.ExternalID,
+		Status:                CheckoutSessionStatus(row.Status),
+		ExpiresAt:             row.ExpiresAt,
+		CreatedAt:             row.CreatedAt,
+		ConfirmedAt:           timePtr(row.ConfirmedAt),
+		PaymentExpiresAt:      timePtr(row.PaymentExpiresAt),
+		UserID:                int32Ptr(row.UserID),
+		GuestID:               guestID,
+		AddressID:             addressID,
+		Subtotal:              int(row.Subtotal),
+		Tax:                   int(row.Tax),
+		ShippingFee:           int(row.ShippingFee),
+		Discount:              int(row.Discount),
+		TotalPrice:            int(row.TotalAmount),
+		WalletAmount:          int(row.WalletAmount),
+		Currency:              row.Currency,
+		VoucherID:             int64Ptr(row.VoucherID),
+		PointsRedeemed:        int(row.PointsRedeemed),
+		ChargeableWeightGrams: int(row.ChargeableWeightGrams),
+		ShippingMethod:        ShippingMethod(row.ShippingMethod),
+		PickupLocationID:      int32Ptr(row.PickupLocationID),
+		PickupSlotStart:       timePtr(row.PickupSlotStart),
+		DeliverySlotID:        int32Ptr(row.DeliverySlotID),
+		DeliveryDate:          timePtr(row.DeliveryDate),
+		Insured:               row.Insured,
+	}
+	if row.PaymentMethod.Valid {
+		method := payment.ChannelCode(row.PaymentMethod.String)
+		s.PaymentMethod = &method
+	}
+	if err := json.Unmarshal(row.ShippingParcels, &s.ShippingParcels); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
 func (r *repository) GetCheckoutSession(
 	ctx context.Context,
 	externalID string,
@@ -990,91 +2056,49 @@ func (r *repository) GetCheckoutSession(
 		zap.String("external_id", externalID),
 	)
 
-	query := `
-		SELECT
-			s.id, s.external_id, s.status, s.expires_at, s.created_at,
-			s.user_id, s.address_id,
-			s.subtotal, s.tax, s.shipping_fee, s.discount,
-			s.total_amount, s.currency, s.confirmed_at,
-			s.payment_method,
-
-			i.id, i.variant_id, i.variant_name, i.product_name,
-			i.imageurl, i.quantity, i.quantity_type,
-			i.unit_price, i.subtotal
-		FROM checkout_sessions s
-		LEFT JOIN checkout_session_items i
-			ON i.checkout_session_id = s.id
-		WHERE s.external_id = $1
-	`
-
-	rows, err := r.db.QueryContext(ctx, query, externalID)
+	rows, err := r.q.GetCheckoutSession(ctx, externalID)
 	if err != nil {
 		log.Error("failed to query checkout session", zap.Error(err))
 		return nil, errors.New("failed to load checkout session")
 	}
-	defer rows.Close()
 
 	var session *CheckoutSession
 	itemCount := 0
 
-	for rows.Next() {
-		var (
-			s      CheckoutSession
-			item   CheckoutSessionItem
-			itemID *uuid.UUID
-		)
-
-		err := rows.Scan(
-			&s.ID,
-			&s.ExternalID,
-			&s.Status,
-			&s.ExpiresAt,
-			&s.CreatedAt,
-			&s.UserID,
-			&s.AddressID,
-			&s.Subtotal,
-			&s.Tax,
-			&s.ShippingFee,
-			&s.Discount,
-			&s.TotalPrice,
-			&s.Currency,
-			&s.ConfirmedAt,
-			&s.PaymentMethod,
-
-			&itemID,
-			&item.VariantID,
-			&item.VariantName,
-			&item.ProductName,
-			&item.ImageURL,
-			&item.Quantity,
-			&item.QuantityType,
-			&item.Price,
-			&item.Subtotal,
-		)
-		if err != nil {
-			log.Error("failed to scan checkout session row", zap.Error(err))
-			return nil, errors.New("failed to load checkout session")
-		}
-
+	for _, row := range rows {
 		// Initialize session once
 		if session == nil {
+			s, err := sessionFromRow(row)
+			if err != nil {
+				log.Error("failed to decode checkout session row", zap.Error(err))
+				return nil, errors.New("failed to load checkout session")
+			}
 			s.Items = make([]CheckoutSessionItem, 0, 4)
-			session = &s
+			session = s
 		}
 
 		// LEFT JOIN: item may be NULL
-		if itemID != nil {
-			item.ID = *itemID
-			session.Items = append(session.Items, item)
+		if row.ItemID.Valid {
+			itemID, err := uuid.Parse(row.ItemID.String)
+			if err != nil {
+				log.Error("failed to parse checkout session item id", zap.Error(err))
+				return nil, errors.New("failed to load checkout session")
+			}
+			session.Items = append(session.Items, CheckoutSessionItem{
+				ID:           itemID,
+				VariantID:    row.VariantID.String,
+				VariantName:  row.VariantName.String,
+				ProductName:  row.ProductName.String,
+				ImageURL:     stringPtr(row.Imageurl),
+				Quantity:     int(row.Quantity.Int32),
+				QuantityType: row.QuantityType.String,
+				Price:        int(row.UnitPrice.Int64),
+				Subtotal:     int(row.ItemSubtotal.Int64),
+			})
 			itemCount++
 		}
 	}
 
-	if err := rows.Err(); err != nil {
-		log.Error("row iteration failed", zap.Error(err))
-		return nil, errors.New("failed to load checkout session")
-	}
-
 	if session == nil {
 		log.Warn("checkout session not found")
 		return nil, sql.ErrNoRows // or domain error
@@ -1089,46 +2113,6 @@ func (r *repository) GetCheckoutSession(
 	return session, nil
 }
 
-func (r *repository) GetUserAddress(
-	ctx context.Context,
-	addressID string,
-	userID uint,
-) (*address.Address, error) {
-
-	log := logger.FromCtx(ctx).With(
-		zap.String("layer", "repository"),
-		zap.String("method", "GetUserAddress"),
-		zap.String("address_id", addressID),
-		zap.Uint("user_id", userID),
-	)
-
-	const query = `
-		SELECT id, city
-		FROM addresses
-		WHERE id = $1
-		  AND user_id = $2
-		  AND is_active = true
-	`
-
-	var a address.Address
-	err := r.db.QueryRowContext(ctx, query, addressID, userID).
-		Scan(&a.ID, &a.City)
-
-	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			log.Warn("address not found or not owned by user")
-			return nil, ErrAddressNotFound
-		}
-
-		log.Error("failed to query user address", zap.Error(err))
-		return nil, ErrAddressNotFound
-	}
-
-	log.Debug("user address fetched successfully")
-
-	return &a, nil
-}
-
 func (r *repository) UpdateSessionAddressAndPricing(
 	ctx context.Context,
 	session *CheckoutSession,
@@ -1139,24 +2123,13 @@ func (r *repository) UpdateSessionAddressAndPricing(
 		zap.String("method", "UpdateSessionAddressAndPricing"),
 	)
 
-	query := `
-		UPDATE checkout_sessions
-		SET
-			address_id = $1,
-			shipping_fee = $2,
-			tax = $3,
-			total_amount = $4
-		WHERE id = $5
-	`
-
-	_, err := r.db.ExecContext(ctx, query,
-		session.AddressID,
-		session.ShippingFee,
-		session.Tax,
-		session.TotalPrice,
-		session.ID,
-	)
-
+	err := r.q.UpdateSessionAddressAndPricing(ctx, dbgen.UpdateSessionAddressAndPricingParams{
+		AddressID:   nullUUID(session.AddressID),
+		ShippingFee: int64(session.ShippingFee),
+		Tax:         int64(session.Tax),
+		TotalAmount: int64(session.TotalPrice),
+		ID:          session.ID.String(),
+	})
 	if err != nil {
 		log.Error("failed to update session address and pricing", zap.Error(err))
 		return ErrDB
@@ -1173,12 +2146,10 @@ func (r *repository) UpdateSessionPaymentMethod(
 		zap.String("layer", "repository"),
 		zap.String("method", "UpdateSessionPaymentMethod"),
 	)
-	query := `
-		UPDATE checkout_sessions
-		SET payment_method = $1
-		WHERE id = $2
-	`
-	_, err := r.db.ExecContext(ctx, query, paymentMethod, sessionID)
+	err := r.q.UpdateSessionPaymentMethod(ctx, dbgen.UpdateSessionPaymentMethodParams{
+		PaymentMethod: sql.NullString{String: string(paymentMethod), Valid: true},
+		ID:            sessionID.String(),
+	})
 	if err != nil {
 		log.Error("failed to update session payment method", zap.Error(err))
 		return ErrDB
@@ -1186,26 +2157,168 @@ func (r *repository) UpdateSessionPaymentMethod(
 	return nil
 }
 
-func (r *repository) ValidateVariantStock(
+func (r *repository) UpdateSessionShippingMethod(
 	ctx context.Context,
-	variantID string,
-	qty int,
-) (bool, error) {
+	session *CheckoutSession,
+) error {
+	err := r.q.UpdateSessionShippingMethod(ctx, dbgen.UpdateSessionShippingMethodParams{
+		ShippingMethod:   string(session.ShippingMethod),
+		ShippingFee:      int64(session.ShippingFee),
+		Tax:              int64(session.Tax),
+		TotalAmount:      int64(session.TotalPrice),
+		PickupLocationID: nullInt32(session.PickupLocationID),
+		PickupSlotStart:  nullTime(session.PickupSlotStart),
+		DeliverySlotID:   nullInt32(session.DeliverySlotID),
+		DeliveryDate:     nullTime(session.DeliveryDate),
+		ID:               session.ID.String(),
+	})
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to update session shipping method", zap.Error(err))
+		return ErrDB
+	}
+	return nil
+}
 
-	log := logger.FromCtx(ctx).With(
-		zap.String("layer", "repository"),
-		zap.String("method", "ValidateVariantStock"),
+func (r *repository) UpdateSessionInsurance(
+	ctx context.Context,
+	session *CheckoutSession,
+) error {
+	err := r.q.UpdateSessionInsurance(ctx, dbgen.UpdateSessionInsuranceParams{
+		Insured:      session.Insured,
+		Tax:          int64(session.Tax),
+		TotalAmount:  int64(session.TotalPrice),
+		WalletAmount: int64(session.WalletAmount),
+		ID:           session.ID.String(),
+	})
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to update session insurance", zap.Error(err))
+		return ErrDB
+	}
+	return nil
+}
+
+func (r *repository) UpdateSessionWalletAmount(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	amount int,
+) error {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "UpdateSessionWalletAmount"),
 	)
+	err := r.q.UpdateSessionWalletAmount(ctx, dbgen.UpdateSessionWalletAmountParams{
+		WalletAmount: int64(amount),
+		ID:           sessionID.String(),
+	})
+	if err != nil {
+		log.Error("failed to update session wallet amount", zap.Error(err))
+		return ErrDB
+	}
+	return nil
+}
 
-	query := `
-		SELECT stock >= $1
-		FROM variants
-		WHERE id = $2
-	`
+// voucherFromRow maps a voucher row. Both voucher lookups select the same
+// columns, so callers convert to this row type.
+func voucherFromRow(row dbgen.GetVoucherByCodeRow) *SessionVoucher {
+	return &SessionVoucher{
+		ID:            row.ID,
+		CampaignID:    row.CampaignID,
+		DiscountType:  row.DiscountType,
+		DiscountValue: row.DiscountValue,
+		MaxDiscount:   int64Ptr(row.MaxDiscount),
+		MinSubtotal:   row.MinSubtotal,
+		UsageLimit:    int32Ptr(row.UsageLimit),
+		Redemptions:   row.Redemptions,
+		OwnerUserID:   int32Ptr(row.OwnerUserID),
+		IsActive:      row.IsActive,
+		ExpiresAt:     timePtr(row.ExpiresAt),
+		StartsAt:      row.StartsAt,
+		EndsAt:        timePtr(row.EndsAt),
+	}
+}
+
+// GetVoucherByCode loads a voucher with its campaign window and how many
+// times it has been redeemed.
+func (r *repository) GetVoucherByCode(
+	ctx context.Context,
+	code string,
+) (*SessionVoucher, error) {
+	row, err := r.q.GetVoucherByCode(ctx, code)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrVoucherNotFound
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to get voucher",
+			zap.String("layer", "repository"),
+			zap.String("method", "GetVoucherByCode"),
+			zap.Error(err),
+		)
+		return nil, ErrDB
+	}
+	return voucherFromRow(row), nil
+}
+
+func (r *repository) GetVoucherByID(
+	ctx context.Context,
+	id int64,
+) (*SessionVoucher, error) {
+	row, err := r.q.GetVoucherByID(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrVoucherNotFound
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to get voucher",
+			zap.String("layer", "repository"),
+			zap.String("method", "GetVoucherByID"),
+			zap.Error(err),
+		)
+		return nil, ErrDB
+	}
+	return voucherFromRow(dbgen.GetVoucherByCodeRow(row)), nil
+}
+
+// UpdateSessionDiscounts persists the voucher and loyalty points applied to
+// a session together with the pricing they produce.
+func (r *repository) UpdateSessionDiscounts(
+	ctx context.Context,
+	session *CheckoutSession,
+) error {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "UpdateSessionDiscounts"),
+	)
+	err := r.q.UpdateSessionDiscounts(ctx, dbgen.UpdateSessionDiscountsParams{
+		VoucherID:      nullInt64(session.VoucherID),
+		Discount:       int64(session.Discount),
+		PointsRedeemed: int64(session.PointsRedeemed),
+		Tax:            int64(session.Tax),
+		TotalAmount:    int64(session.TotalPrice),
+		WalletAmount:   int64(session.WalletAmount),
+		ID:             session.ID.String(),
+	})
+	if err != nil {
+		log.Error("failed to update session discounts", zap.Error(err))
+		return ErrDB
+	}
+	return nil
+}
+
+func (r *repository) ValidateVariantStock(
+	ctx context.Context,
+	variantID string,
+	qty int,
+) (bool, error) {
+
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ValidateVariantStock"),
+	)
 
-	var ok bool
-	err := r.db.QueryRowContext(ctx, query, qty, variantID).
-		Scan(&ok)
+	// Orders take each line from a single warehouse
+	ok, err := r.q.HasVariantStock(ctx, dbgen.HasVariantStockParams{
+		Quantity:  int32(qty),
+		VariantID: variantID,
+	})
 
 	if err != nil {
 		log.Error("failed to validate variant stock", zap.Error(err))
@@ -1215,6 +2328,68 @@ func (r *repository) ValidateVariantStock(
 	return ok, nil
 }
 
+func (r *repository) VariantsOnVacation(
+	ctx context.Context,
+	variantIDs []string,
+) ([]string, error) {
+
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "VariantsOnVacation"),
+	)
+
+	away, err := r.q.ListVariantsOnVacation(ctx, variantIDs)
+	if err != nil {
+		log.Error("failed to check seller vacations", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return away, nil
+}
+
+func (r *repository) InactiveVariants(
+	ctx context.Context,
+	variantIDs []string,
+) ([]string, error) {
+
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "InactiveVariants"),
+	)
+
+	inactive, err := r.q.ListInactiveVariants(ctx, variantIDs)
+	if err != nil {
+		log.Error("failed to check inactive variants", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return inactive, nil
+}
+
+func (r *repository) OriginLocations(
+	ctx context.Context,
+	originIDs []string,
+) (map[string]geo.Point, error) {
+
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "OriginLocations"),
+	)
+
+	rows, err := r.q.ListOriginLocations(ctx, originIDs)
+	if err != nil {
+		log.Error("failed to query origin locations", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	locations := make(map[string]geo.Point, len(originIDs))
+	for _, row := range rows {
+		locations[row.ID] = geo.Point{Lat: row.Latitude.Float64, Lng: row.Longitude.Float64}
+	}
+
+	return locations, nil
+}
+
 func (r *repository) ConfirmCheckoutSession(
 	ctx context.Context,
 	session *CheckoutSession,
@@ -1224,25 +2399,12 @@ func (r *repository) ConfirmCheckoutSession(
 		zap.String("method", "ConfirmCheckoutSession"),
 	)
 
-	query := `
-		UPDATE checkout_sessions
-		SET
-			confirmed_at = NOW()
-		WHERE id = $1
-		  AND status = 'PENDING'
-	`
-
-	res, err := r.db.ExecContext(
-		ctx,
-		query,
-		session.ID,
-	)
+	affected, err := r.q.ConfirmCheckoutSession(ctx, session.ID.String())
 	if err != nil {
 		log.Error("failed to confirm checkout session", zap.Error(err))
 		return ErrDB
 	}
 
-	affected, _ := res.RowsAffected()
 	if affected == 0 {
 		return errors.New("checkout session already confirmed")
 	}
@@ -1260,20 +2422,92 @@ func (r *repository) MarkSessionExpired(
 		zap.String("method", "MarkSessionExpired"),
 	)
 
-	_, err := r.db.ExecContext(ctx, `
-		UPDATE checkout_sessions
-		SET status = 'EXPIRED'
-		WHERE id = $1
-		  AND status = 'PENDING'
-	`, sessionID)
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error("failed to begin transaction", zap.Error(err))
+		return ErrDB
+	}
+	defer tx.Rollback()
+
+	qtx := r.q.WithTx(tx)
+
+	// CreateOrderTx holds this lock while it inserts the order. Taking it
+	// first means the UPDATE below, a new statement, sees that order.
+	err = qtx.LockCheckoutSession(ctx, sessionID.String())
+	if err != nil {
+		log.Error("failed to lock checkout session", zap.Error(err))
+		return ErrDB
+	}
 
+	err = qtx.ExpireCheckoutSession(ctx, sessionID.String())
 	if err != nil {
 		log.Error("failed to mark session expired", zap.Error(err))
 		return ErrDB
 	}
+
+	if err := tx.Commit(); err != nil {
+		log.Error("failed to commit transaction", zap.Error(err))
+		return ErrDB
+	}
 	return nil
 }
 
+// orderListFilter builds the conditions shared by FetchOrders and
+// CountOrders, so a page and its total always agree. Customers only see
+// their own orders.
+func orderListFilter(ctx context.Context, filter *OrderFilterInput) *sqlbuilder.Builder {
+	qb := sqlbuilder.New()
+
+	// Default condition
+	// qb.Where("o.deleted_at IS NULL")
+	if !utils.IsAdmin(ctx) {
+		userId, _ := utils.GetUserIDFromContext(ctx)
+		qb.Where("o.user_id = ?", userId)
+	}
+
+	if filter == nil {
+		return qb
+	}
+
+	if filter.Search != nil && *filter.Search != "" {
+		qb.Where("(o.id::text ILIKE ? OR o.external_id ILIKE ?)", "%"+*filter.Search+"%")
+	}
+
+	if filter.Status != nil {
+		qb.Where("o.status = ?", *filter.Status)
+	}
+
+	if filter.DateFrom != nil {
+		qb.Where("o.created_at >= ?", *filter.DateFrom)
+	}
+
+	if filter.DateTo != nil {
+		qb.Where("o.created_at <= ?", *filter.DateTo)
+	}
+
+	// Item filters share one EXISTS, so they must all hold for the same item.
+	var itemConds []string
+	var itemArgs []any
+	if filter.Product != nil {
+		for _, word := range strings.Fields(*filter.Product) {
+			itemConds = append(itemConds, "(oi.product_name || ' ' || oi.variant_name) ILIKE ?")
+			itemArgs = append(itemArgs, "%"+word+"%")
+		}
+	}
+	if filter.VariantID != nil && *filter.VariantID != "" {
+		itemConds = append(itemConds, "oi.variant_id = ?")
+		itemArgs = append(itemArgs, *filter.VariantID)
+	}
+	if len(itemConds) > 0 {
+		qb.Where(
+			"EXISTS (SELECT 1 FROM order_items oi WHERE oi.order_id = o.id AND "+strings.Join(itemConds, " AND ")+")",
+			itemArgs...,
+		)
+	}
+
+	return qb
+}
+
 func (r *repository) CountOrders(
 	ctx context.Context,
 	filter *OrderFilterInput,
@@ -1284,67 +2518,17 @@ func (r *repository) CountOrders(
 		zap.String("method", "countOrders"),
 	)
 
-	var (
-		total int64
-		args  []any
-		where []string
-	)
+	var total int64
 
 	baseQuery := `
 		SELECT COUNT(1)
-		FROM orders
+		FROM orders o
 	`
 
-	// Default condition
-	// where = append(where, "deleted_at IS NULL")
-
-	// -------------------------
-	// Dynamic filters
-	// -------------------------
-
-	if filter != nil {
-
-		// Search (example: order_id or external_id)
-		if filter.Search != nil && *filter.Search != "" {
-			args = append(args, "%"+*filter.Search+"%")
-			where = append(where,
-				fmt.Sprintf("(id::text ILIKE $%d OR external_id ILIKE $%d)", len(args), len(args)),
-			)
-		}
-
-		// Status
-		if filter.Status != nil {
-			args = append(args, *filter.Status)
-			where = append(where,
-				fmt.Sprintf("status = $%d", len(args)),
-			)
-		}
-
-		// Date From
-		if filter.DateFrom != nil {
-			args = append(args, *filter.DateFrom)
-			where = append(where,
-				fmt.Sprintf("created_at >= $%d", len(args)),
-			)
-		}
-
-		// Date To
-		if filter.DateTo != nil {
-			args = append(args, *filter.DateTo)
-			where = append(where,
-				fmt.Sprintf("created_at <= $%d", len(args)),
-			)
-		}
-	}
-
-	// -------------------------
-	// Final query
-	// -------------------------
+	qb := orderListFilter(ctx, filter)
 
-	query := baseQuery
-	if len(where) > 0 {
-		query += " WHERE " + strings.Join(where, " AND ")
-	}
+	query := baseQuery + qb.WhereClause()
+	args := qb.Args()
 
 	log.Debug("count orders query built",
 		zap.String("query", query),
@@ -1373,96 +2557,35 @@ func (r *repository) FetchOrders(
 	limit int32,
 	offset int32,
 ) ([]*Order, error) {
-	userId, _ := utils.GetUserIDFromContext(ctx)
-	userRole := utils.GetUserRoleFromContext(ctx)
-	isAdmin := userRole == "ADMIN"
-
 	log := logger.FromCtx(ctx).With(
 		zap.String("layer", "repository"),
 		zap.String("method", "fetchOrders"),
 	)
 
-	var (
-		args  []any
-		where []string
-	)
-
 	baseQuery := `
 		SELECT 
 		o.id, o.external_id, o.invoice_number, 
 		o.user_id, o.currency, o.subtotal, o.tax, o.discount, 
 		o.shipping_fee, o.total_amount, o.status,
-		o.address_id, o.created_at, o.updated_at
+		o.address_id, o.created_at, o.updated_at, o.shipping_method,
+		o.insurance_fee, o.adjustment_amount
 		FROM orders o
 	`
 
-	// Default condition
-	// where = append(where, "o.deleted_at IS NULL")
-	if !isAdmin {
-		args = append(args, userId)
-		where = append(where, fmt.Sprintf("o.user_id = $%d", len(args)))
-
-	}
-
-	if filter != nil {
-
-		if filter.Search != nil && *filter.Search != "" {
-			args = append(args, "%"+*filter.Search+"%")
-			where = append(where,
-				fmt.Sprintf("(o.id::text ILIKE $%d OR o.external_id ILIKE $%d)", len(args), len(args)),
-			)
-		}
-
-		if filter.Status != nil {
-			args = append(args, *filter.Status)
-			where = append(where,
-				fmt.Sprintf("o.status = $%d", len(args)),
-			)
-		}
-
-		if filter.DateFrom != nil {
-			args = append(args, *filter.DateFrom)
-			where = append(where,
-				fmt.Sprintf("o.created_at >= $%d", len(args)),
-			)
-		}
-
-		if filter.DateTo != nil {
-			args = append(args, *filter.DateTo)
-			where = append(where,
-				fmt.Sprintf("o.created_at <= $%d", len(args)),
-			)
-		}
-	}
+	qb := orderListFilter(ctx, filter)
 
-	orderBy := "o.created_at DESC"
+	orderBy := sqlbuilder.OrderBy("o.created_at", string(SortDirectionDesc))
 	if sort != nil {
 		switch sort.Field {
 		case OrderSortFieldCreatedAt:
-			if sort.Direction == SortDirectionAsc {
-				orderBy = "o.created_at ASC"
-			}
+			orderBy = sqlbuilder.OrderBy("o.created_at", string(sort.Direction))
 		case OrderSortFieldTotal:
-			if sort.Direction == SortDirectionAsc {
-				orderBy = "o.total_amount ASC"
-			} else {
-				orderBy = "o.total_amount DESC"
-			}
+			orderBy = sqlbuilder.OrderBy("o.total_amount", string(sort.Direction))
 		}
 	}
 
-	query := baseQuery
-	if len(where) > 0 {
-		query += " WHERE " + strings.Join(where, " AND ")
-	}
-
-	args = append(args, limit, offset)
-	query += fmt.Sprintf(
-		" ORDER BY %s LIMIT $%d OFFSET $%d",
-		orderBy,
-		len(args)-1,
-		len(args),
-	)
+	query := baseQuery + qb.WhereClause() + orderBy + qb.Page(limit, offset)
+	args := qb.Args()
 
 	log.Debug("fetch orders query built",
 		zap.String("query", query),
@@ -1494,6 +2617,9 @@ func (r *repository) FetchOrders(
 			&o.AddressID,
 			&o.CreatedAt,
 			&o.UpdatedAt,
+			&o.ShippingMethod,
+			&o.InsuranceFee,
+			&o.Adjustment,
 		); err != nil {
 			log.Error("failed to scan order row", zap.Error(err))
 			return nil, err
@@ -1522,45 +2648,1232 @@ func (r *repository) FetchOrderItems(
 		return map[int32][]*OrderItem{}, nil
 	}
 
-	query := `
-		SELECT id, order_id,  variant_name, product_name, image_url, quantity, quantity_type, unit_price, variant_id, subtotal
-		FROM order_items
-		WHERE order_id = ANY($1)
-	`
-
-	rows, err := r.db.QueryContext(ctx, query, pq.Array(orderIDs))
+	rows, err := r.q.ListOrderItemsByOrders(ctx, orderIDs)
 	if err != nil {
 		log.Error("failed to query order items", zap.Error(err))
 		return nil, ErrDB
 	}
-	defer rows.Close()
 
 	itemsMap := make(map[int32][]*OrderItem)
 
-	for rows.Next() {
-		var item OrderItem
-		if err := rows.Scan(
-			&item.ID,
-			&item.OrderID,
-			&item.VariantName,
-			&item.ProductName,
-			&item.ImageURL,
-			&item.Quantity,
-			&item.QuantityType,
-			&item.Price,
-			&item.VariantID,
-			&item.Subtotal,
-		); err != nil {
-			log.Error("failed to scan order item", zap.Error(err))
-			return nil, ErrDB
-		}
-		itemsMap[int32(item.OrderID)] = append(itemsMap[int32(item.OrderID)], &item)
+	for _, row := range rows {
+		itemsMap[row.OrderID] = append(itemsMap[row.OrderID], &OrderItem{
+			ID:           uint(row.ID),
+			OrderID:      uint(row.OrderID),
+			VariantName:  row.VariantName.String,
+			ProductName:  row.ProductName.String,
+			ImageURL:     stringPtr(row.ImageUrl),
+			Quantity:     int(row.Quantity),
+			QuantityType: row.QuantityType,
+			Price:        row.UnitPrice,
+			VariantID:    row.VariantID.String,
+			Subtotal:     row.Subtotal.Float64,
+		})
 	}
 
-	if err := rows.Err(); err != nil {
-		log.Error("rows iteration error", zap.Error(err))
+	return itemsMap, nil
+}
+
+func (r *repository) SaveOfflinePayment(
+	ctx context.Context,
+	order *Order,
+) error {
+	err := r.q.CreateOfflinePayment(ctx, dbgen.CreateOfflinePaymentParams{
+		OrderID:           order.ID,
+		ExternalReference: payment.OfflineReference(order.ExternalID),
+		Amount:            int64(order.TotalAmount),
+		Status:            string(PaymentStatusPending),
+		PaymentMethod:     sql.NullString{String: string(payment.MethodOffline), Valid: true},
+		Provider:          payment.ProviderOffline,
+		Currency:          order.Currency,
+	})
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to save offline payment",
+			zap.Int32("order_id", order.ID),
+			zap.Error(err),
+		)
+		return ErrDB
+	}
+	return nil
+}
+
+func (r *repository) GetActiveSessionExternalID(
+	ctx context.Context,
+	userID uint,
+) (string, error) {
+	externalID, err := r.q.GetActiveSessionExternalID(ctx, sql.NullInt32{Int32: int32(userID), Valid: true})
+	if errors.Is(err, sql.ErrNoRows) {
+		return "", nil
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to get active checkout session",
+			zap.Uint("user_id", userID),
+			zap.Error(err),
+		)
+		return "", ErrDB
+	}
+	return externalID, nil
+}
+
+func (r *repository) CountOpenGuestSessions(
+	ctx context.Context,
+	guestID uuid.UUID,
+) (int, error) {
+	n, err := r.q.CountOpenGuestSessions(ctx, sql.NullString{String: guestID.String(), Valid: true})
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to count guest checkout sessions",
+			zap.String("guest_id", guestID.String()),
+			zap.Error(err),
+		)
+		return 0, ErrDB
+	}
+	return int(n), nil
+}
+
+func (r *repository) SupersedeOpenSessions(
+	ctx context.Context,
+	userID uint,
+	keepID uuid.UUID,
+) (int64, error) {
+	n, err := r.q.SupersedeOpenSessions(ctx, dbgen.SupersedeOpenSessionsParams{
+		UserID: sql.NullInt32{Int32: int32(userID), Valid: true},
+		ID:     keepID.String(),
+		Status: string(CheckoutSessionStatusSuperseded),
+	})
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to supersede checkout sessions",
+			zap.Uint("user_id", userID),
+			zap.Error(err),
+		)
+		return 0, ErrDB
+	}
+	return n, nil
+}
+
+func (r *repository) InsertSessionEvent(
+	ctx context.Context,
+	event *SessionEvent,
+) error {
+	row, err := r.q.CreateSessionEvent(ctx, dbgen.CreateSessionEventParams{
+		SessionID:    event.SessionID.String(),
+		EventType:    string(event.Type),
+		ActorUserID:  nullInt32(event.ActorUserID),
+		ActorGuestID: nullUUID(event.ActorGuestID),
+		ActorRole:    nullString(event.ActorRole),
+		FromValue:    nullString(event.FromValue),
+		ToValue:      nullString(event.ToValue),
+		TotalBefore:  int32(event.TotalBefore),
+		TotalAfter:   int32(event.TotalAfter),
+	})
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to insert session event",
+			zap.String("session_id", event.SessionID.String()),
+			zap.String("event_type", string(event.Type)),
+			zap.Error(err),
+		)
+		return ErrDB
+	}
+	event.ID = row.ID
+	event.CreatedAt = row.CreatedAt
+	return nil
+}
+
+func (r *repository) ListSessionEvents(
+	ctx context.Context,
+	sessionID uuid.UUID,
+) ([]*SessionEvent, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ListSessionEvents"),
+		zap.String("session_id", sessionID.String()),
+	)
+
+	rows, err := r.q.ListSessionEvents(ctx, sessionID.String())
+	if err != nil {
+		log.Error("failed to query session events", zap.Error(err))
 		return nil, ErrDB
 	}
 
-	return itemsMap, nil
+	events := []*SessionEvent{}
+	for _, row := range rows {
+		actorGuestID, err := uuidPtr(row.ActorGuestID)
+		if err != nil {
+			log.Error("failed to parse session event guest id", zap.Error(err))
+			return nil, ErrDB
+		}
+		events = append(events, &SessionEvent{
+			ID:           row.ID,
+			SessionID:    sessionID,
+			Type:         SessionEventType(row.EventType),
+			ActorUserID:  int32Ptr(row.ActorUserID),
+			ActorGuestID: actorGuestID,
+			ActorRole:    stringPtr(row.ActorRole),
+			FromValue:    stringPtr(row.FromValue),
+			ToValue:      stringPtr(row.ToValue),
+			TotalBefore:  int(row.TotalBefore),
+			TotalAfter:   int(row.TotalAfter),
+			CreatedAt:    row.CreatedAt,
+		})
+	}
+
+	return events, nil
+}
+
+func (r *repository) UpdateSessionItems(
+	ctx context.Context,
+	session *CheckoutSession,
+) (err error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "UpdateSessionItems"),
+		zap.String("session_id", session.ID.String()),
+		zap.Int("item_count", len(session.Items)),
+	)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error("failed to begin transaction", zap.Error(err))
+		return ErrDB
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	qtx := r.q.WithTx(tx)
+
+	keep := make([]string, 0, len(session.Items))
+	for _, item := range session.Items {
+		keep = append(keep, item.ID.String())
+	}
+
+	if err = qtx.DeleteRemovedSessionItems(ctx, dbgen.DeleteRemovedSessionItemsParams{
+		CheckoutSessionID: session.ID.String(),
+		KeepIds:           keep,
+	}); err != nil {
+		log.Error("failed to delete removed session items", zap.Error(err))
+		return ErrDB
+	}
+
+	for _, item := range session.Items {
+		if err = qtx.UpdateSessionItem(ctx, dbgen.UpdateSessionItemParams{
+			Quantity:          int32(item.Quantity),
+			UnitPrice:         int64(item.Price),
+			Subtotal:          int64(item.Subtotal),
+			ID:                item.ID.String(),
+			CheckoutSessionID: session.ID.String(),
+		}); err != nil {
+			log.Error("failed to update session item",
+				zap.String("item_id", item.ID.String()),
+				zap.Error(err),
+			)
+			return ErrDB
+		}
+	}
+
+	if err = qtx.UpdateSessionPricing(ctx, dbgen.UpdateSessionPricingParams{
+		Subtotal:              int64(session.Subtotal),
+		ShippingFee:           int64(session.ShippingFee),
+		VoucherID:             nullInt64(session.VoucherID),
+		Discount:              int64(session.Discount),
+		PointsRedeemed:        int64(session.PointsRedeemed),
+		Tax:                   int64(session.Tax),
+		TotalAmount:           int64(session.TotalPrice),
+		WalletAmount:          int64(session.WalletAmount),
+		ChargeableWeightGrams: int32(session.ChargeableWeightGrams),
+		ShippingParcels:       parcelsJSON(session.ShippingParcels),
+		ID:                    session.ID.String(),
+	}); err != nil {
+		log.Error("failed to update session pricing", zap.Error(err))
+		return ErrDB
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Error("failed to commit session items", zap.Error(err))
+		return ErrDB
+	}
+
+	return nil
+}
+
+func (r *repository) ListCheckoutRules(
+	ctx context.Context,
+	activeOnly bool,
+) ([]*CheckoutRule, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ListCheckoutRules"),
+	)
+
+	rows, err := r.q.ListCheckoutRules(ctx, activeOnly)
+	if err != nil {
+		log.Error("failed to query checkout rules", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	rules := []*CheckoutRule{}
+	for _, row := range rows {
+		rule := &CheckoutRule{
+			ID:             row.ID,
+			Region:         stringPtr(row.Region),
+			MinOrderAmount: int(row.MinOrderAmount),
+			IsActive:       row.IsActive,
+			UpdatedAt:      row.UpdatedAt,
+		}
+		if row.FreeShippingMin.Valid {
+			min := int(row.FreeShippingMin.Int32)
+			rule.FreeShippingMin = &min
+		}
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+func (r *repository) UpsertCheckoutRule(
+	ctx context.Context,
+	rule *CheckoutRule,
+	adminID uint,
+) (*CheckoutRule, error) {
+	out := *rule
+	params := dbgen.UpsertCheckoutRuleParams{
+		Region:         nullString(rule.Region),
+		MinOrderAmount: int32(rule.MinOrderAmount),
+		IsActive:       rule.IsActive,
+		UpdatedBy:      sql.NullInt32{Int32: int32(adminID), Valid: true},
+	}
+	if rule.FreeShippingMin != nil {
+		params.FreeShippingMin = sql.NullInt32{Int32: int32(*rule.FreeShippingMin), Valid: true}
+	}
+	row, err := r.q.UpsertCheckoutRule(ctx, params)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to upsert checkout rule", zap.Error(err))
+		return nil, ErrDB
+	}
+	out.ID = row.ID
+	out.UpdatedAt = row.UpdatedAt
+	return &out, nil
+}
+
+// pickupLocationFromRow maps a pickup location row. The pickup location
+// queries share one layout, so callers convert to this row type.
+func pickupLocationFromRow(row dbgen.GetPickupLocationRow) *PickupLocation {
+	return &PickupLocation{
+		ID:          row.ID,
+		Name:        row.Name,
+		Address:     row.Address,
+		City:        row.City,
+		OpensAt:     row.OpensAt,
+		ClosesAt:    row.ClosesAt,
+		SlotMinutes: int(row.SlotMinutes),
+		IsActive:    row.IsActive,
+		UpdatedAt:   row.UpdatedAt,
+	}
+}
+
+func (r *repository) ListPickupLocations(
+	ctx context.Context,
+	activeOnly bool,
+) ([]*PickupLocation, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ListPickupLocations"),
+	)
+
+	rows, err := r.q.ListPickupLocations(ctx, activeOnly)
+	if err != nil {
+		log.Error("failed to query pickup locations", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	locations := []*PickupLocation{}
+	for _, row := range rows {
+		locations = append(locations, pickupLocationFromRow(dbgen.GetPickupLocationRow(row)))
+	}
+
+	return locations, nil
+}
+
+func (r *repository) GetPickupLocation(
+	ctx context.Context,
+	id int32,
+) (*PickupLocation, error) {
+	row, err := r.q.GetPickupLocation(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrPickupLocationNotFound
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to get pickup location", zap.Error(err))
+		return nil, ErrDB
+	}
+	return pickupLocationFromRow(row), nil
+}
+
+func (r *repository) SavePickupLocation(
+	ctx context.Context,
+	location *PickupLocation,
+) (*PickupLocation, error) {
+	var row dbgen.GetPickupLocationRow
+	var err error
+	if location.ID == 0 {
+		var created dbgen.CreatePickupLocationRow
+		created, err = r.q.CreatePickupLocation(ctx, dbgen.CreatePickupLocationParams{
+			Name:        location.Name,
+			Address:     location.Address,
+			City:        location.City,
+			OpensAt:     location.OpensAt,
+			ClosesAt:    location.ClosesAt,
+			SlotMinutes: int32(location.SlotMinutes),
+			IsActive:    location.IsActive,
+		})
+		row = dbgen.GetPickupLocationRow(created)
+	} else {
+		var updated dbgen.UpdatePickupLocationRow
+		updated, err = r.q.UpdatePickupLocation(ctx, dbgen.UpdatePickupLocationParams{
+			Name:        location.Name,
+			Address:     location.Address,
+			City:        location.City,
+			OpensAt:     location.OpensAt,
+			ClosesAt:    location.ClosesAt,
+			SlotMinutes: int32(location.SlotMinutes),
+			IsActive:    location.IsActive,
+			ID:          location.ID,
+		})
+		row = dbgen.GetPickupLocationRow(updated)
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrPickupLocationNotFound
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to save pickup location", zap.Error(err))
+		return nil, ErrDB
+	}
+	return pickupLocationFromRow(row), nil
+}
+
+func (r *repository) GetOrderPickup(
+	ctx context.Context,
+	orderID uint,
+) (*OrderPickup, error) {
+	row, err := r.q.GetOrderPickup(ctx, int32(orderID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to get order pickup", zap.Error(err))
+		return nil, ErrDB
+	}
+	return &OrderPickup{
+		Location: &PickupLocation{
+			ID:          row.ID,
+			Name:        row.Name,
+			Address:     row.Address,
+			City:        row.City,
+			OpensAt:     row.OpensAt,
+			ClosesAt:    row.ClosesAt,
+			SlotMinutes: int(row.SlotMinutes),
+			IsActive:    row.IsActive,
+			UpdatedAt:   row.UpdatedAt,
+		},
+		SlotStart:  row.SlotStart,
+		SlotEnd:    row.SlotEnd,
+		Code:       row.Code,
+		PickedUpAt: timePtr(row.PickedUpAt),
+	}, nil
+}
+
+func (r *repository) CompletePickup(
+	ctx context.Context,
+	orderID uint,
+	adminID uint,
+) error {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "CompletePickup"),
+		zap.Uint("order_id", orderID),
+	)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error("failed to begin transaction", zap.Error(err))
+		return ErrDB
+	}
+	defer tx.Rollback()
+
+	qtx := r.q.WithTx(tx)
+
+	n, err := qtx.CompletePickupOrder(ctx, dbgen.CompletePickupOrderParams{
+		Status:     dbgen.OrderStatusCOMPLETED,
+		ID:         int32(orderID),
+		FromStatus: dbgen.OrderStatusREADYFORPICKUP,
+	})
+	if err != nil {
+		log.Error("failed to complete order", zap.Error(err))
+		return ErrDB
+	}
+	if n == 0 {
+		return ErrNotReadyForPickup
+	}
+
+	if err := qtx.RecordPickupHandover(ctx, dbgen.RecordPickupHandoverParams{
+		HandedOverBy: sql.NullInt32{Int32: int32(adminID), Valid: true},
+		OrderID:      int32(orderID),
+	}); err != nil {
+		log.Error("failed to record pickup", zap.Error(err))
+		return ErrDB
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Error("failed to commit pickup", zap.Error(err))
+		return ErrDB
+	}
+	return nil
+}
+
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && string(pqErr.Code) == PgUniqueViolation
+}
+
+// The helpers below move optional values between the domain's pointers
+// and the sql.Null types of the generated queries.
+
+func nullString(s *string) sql.NullString {
+	if s == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: *s, Valid: true}
+}
+
+func stringPtr(s sql.NullString) *string {
+	if !s.Valid {
+		return nil
+	}
+	return &s.String
+}
+
+func nullInt32(n *int32) sql.NullInt32 {
+	if n == nil {
+		return sql.NullInt32{}
+	}
+	return sql.NullInt32{Int32: *n, Valid: true}
+}
+
+func int32Ptr(n sql.NullInt32) *int32 {
+	if !n.Valid {
+		return nil
+	}
+	return &n.Int32
+}
+
+func nullInt64(n *int64) sql.NullInt64 {
+	if n == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: *n, Valid: true}
+}
+
+func int64Ptr(n sql.NullInt64) *int64 {
+	if !n.Valid {
+		return nil
+	}
+	return &n.Int64
+}
+
+func float64Ptr(f sql.NullFloat64) *float64 {
+	if !f.Valid {
+		return nil
+	}
+	return &f.Float64
+}
+
+func nullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
+
+func timePtr(t sql.NullTime) *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+func nullUUID(id *uuid.UUID) sql.NullString {
+	if id == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: id.String(), Valid: true}
+}
+
+func uuidPtr(s sql.NullString) (*uuid.UUID, error) {
+	if !s.Valid {
+		return nil, nil
+	}
+	id, err := uuid.Parse(s.String)
+	if err != nil {
+		return nil, err
+	}
+	return &id, nil
+}
+
+// deliverySlotFromRow maps a delivery slot row. The delivery slot queries
+// share one layout, so callers convert to this row type.
+func deliverySlotFromRow(row dbgen.GetDeliverySlotRow) *DeliverySlot {
+	return &DeliverySlot{
+		ID:        row.ID,
+		Region:    row.Region,
+		StartsAt:  row.StartsAt,
+		EndsAt:    row.EndsAt,
+		Capacity:  int(row.Capacity),
+		IsActive:  row.IsActive,
+		UpdatedAt: row.UpdatedAt,
+	}
+}
+
+func (r *repository) ListDeliverySlots(
+	ctx context.Context,
+	region *string,
+	activeOnly bool,
+) ([]*DeliverySlot, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ListDeliverySlots"),
+	)
+
+	rows, err := r.q.ListDeliverySlots(ctx, dbgen.ListDeliverySlotsParams{
+		Region:     nullString(region),
+		ActiveOnly: activeOnly,
+	})
+	if err != nil {
+		log.Error("failed to query delivery slots", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	slots := []*DeliverySlot{}
+	for _, row := range rows {
+		slots = append(slots, deliverySlotFromRow(dbgen.GetDeliverySlotRow(row)))
+	}
+
+	return slots, nil
+}
+
+func (r *repository) GetDeliverySlot(
+	ctx context.Context,
+	id int32,
+) (*DeliverySlot, error) {
+	row, err := r.q.GetDeliverySlot(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrDeliverySlotNotFound
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to get delivery slot", zap.Error(err))
+		return nil, ErrDB
+	}
+	return deliverySlotFromRow(row), nil
+}
+
+func (r *repository) SaveDeliverySlot(
+	ctx context.Context,
+	slot *DeliverySlot,
+) (*DeliverySlot, error) {
+	var row dbgen.GetDeliverySlotRow
+	var err error
+	if slot.ID == 0 {
+		var created dbgen.CreateDeliverySlotRow
+		created, err = r.q.CreateDeliverySlot(ctx, dbgen.CreateDeliverySlotParams{
+			Region:   slot.Region,
+			StartsAt: slot.StartsAt,
+			EndsAt:   slot.EndsAt,
+			Capacity: int32(slot.Capacity),
+			IsActive: slot.IsActive,
+		})
+		row = dbgen.GetDeliverySlotRow(created)
+	} else {
+		var updated dbgen.UpdateDeliverySlotRow
+		updated, err = r.q.UpdateDeliverySlot(ctx, dbgen.UpdateDeliverySlotParams{
+			Region:   slot.Region,
+			StartsAt: slot.StartsAt,
+			EndsAt:   slot.EndsAt,
+			Capacity: int32(slot.Capacity),
+			IsActive: slot.IsActive,
+			ID:       slot.ID,
+		})
+		row = dbgen.GetDeliverySlotRow(updated)
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrDeliverySlotNotFound
+	}
+	if isUniqueViolation(err) {
+		// Another slot of the region already starts then.
+		return nil, ErrInvalidDeliverySlot
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to save delivery slot", zap.Error(err))
+		return nil, ErrDB
+	}
+	return deliverySlotFromRow(row), nil
+}
+
+func (r *repository) CountDeliveryBookings(
+	ctx context.Context,
+	slotIDs []int32,
+	date time.Time,
+) (map[int32]int, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "CountDeliveryBookings"),
+	)
+
+	rows, err := r.q.CountDeliveryBookings(ctx, dbgen.CountDeliveryBookingsParams{
+		SlotIds:      slotIDs,
+		DeliveryDate: date,
+	})
+	if err != nil {
+		log.Error("failed to count delivery bookings", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	counts := make(map[int32]int, len(slotIDs))
+	for _, row := range rows {
+		counts[row.SlotID] = int(row.Count)
+	}
+
+	return counts, nil
+}
+
+// bookDeliverySlot takes a place in the slot for the order. The slot row
+// is locked so concurrent checkouts cannot overbook it.
+func (r *repository) bookDeliverySlot(
+	ctx context.Context,
+	q *dbgen.Queries,
+	orderID int32,
+	d *DeliveryBooking,
+) error {
+	capacity, err := q.LockDeliverySlot(ctx, d.SlotID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrDeliverySlotUnavailable
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to lock delivery slot", zap.Error(err))
+		return ErrDB
+	}
+
+	booked, err := q.CountSlotBookings(ctx, dbgen.CountSlotBookingsParams{
+		SlotID:       d.SlotID,
+		DeliveryDate: d.Date,
+	})
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to count delivery bookings", zap.Error(err))
+		return ErrDB
+	}
+	if booked >= int64(capacity) {
+		return ErrDeliverySlotFull
+	}
+
+	if err := q.CreateDeliveryBooking(ctx, dbgen.CreateDeliveryBookingParams{
+		OrderID:      orderID,
+		SlotID:       d.SlotID,
+		DeliveryDate: d.Date,
+		WindowStart:  d.WindowStart,
+		WindowEnd:    d.WindowEnd,
+	}); err != nil {
+		logger.FromCtx(ctx).Error("failed to insert delivery booking", zap.Error(err))
+		return ErrDB
+	}
+	return nil
+}
+
+func (r *repository) GetOrderDelivery(
+	ctx context.Context,
+	orderID uint,
+) (*DeliveryBooking, error) {
+	row, err := r.q.GetOrderDelivery(ctx, int32(orderID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to get order delivery", zap.Error(err))
+		return nil, ErrDB
+	}
+	return &DeliveryBooking{
+		SlotID:      row.SlotID,
+		Date:        row.DeliveryDate,
+		WindowStart: row.WindowStart,
+		WindowEnd:   row.WindowEnd,
+	}, nil
+}
+
+func policyFromModel(p dbgen.Policy) *Policy {
+	return &Policy{
+		ID:          p.ID,
+		Kind:        PolicyKind(p.Kind),
+		Version:     int(p.Version),
+		Title:       p.Title,
+		Body:        p.Body,
+		PublishedBy: int32Ptr(p.PublishedBy),
+		PublishedAt: p.PublishedAt,
+	}
+}
+
+func policiesFromModels(rows []dbgen.Policy) []*Policy {
+	policies := make([]*Policy, 0, len(rows))
+	for _, row := range rows {
+		policies = append(policies, policyFromModel(row))
+	}
+	return policies
+}
+
+func (r *repository) ListCurrentPolicies(ctx context.Context) ([]*Policy, error) {
+	rows, err := r.q.ListCurrentPolicies(ctx)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to query current policies", zap.Error(err))
+		return nil, ErrDB
+	}
+	return policiesFromModels(rows), nil
+}
+
+func (r *repository) ListPolicyVersions(
+	ctx context.Context,
+	kind PolicyKind,
+) ([]*Policy, error) {
+	rows, err := r.q.ListPolicyVersions(ctx, string(kind))
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to query policy versions", zap.Error(err))
+		return nil, ErrDB
+	}
+	return policiesFromModels(rows), nil
+}
+
+func (r *repository) PublishPolicy(
+	ctx context.Context,
+	policy *Policy,
+) (*Policy, error) {
+	saved, err := r.q.PublishPolicy(ctx, dbgen.PublishPolicyParams{
+		Kind:        string(policy.Kind),
+		Title:       policy.Title,
+		Body:        policy.Body,
+		PublishedBy: nullInt32(policy.PublishedBy),
+	})
+	if isUniqueViolation(err) {
+		// Another admin published the same kind at the same time.
+		return nil, ErrPolicyVersionConflict
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to publish policy", zap.Error(err))
+		return nil, ErrDB
+	}
+	return policyFromModel(saved), nil
+}
+
+func (r *repository) ListOrderPolicies(
+	ctx context.Context,
+	orderID uint,
+) ([]*Policy, error) {
+	rows, err := r.q.ListOrderPolicies(ctx, int32(orderID))
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to query order policies", zap.Error(err))
+		return nil, ErrDB
+	}
+	return policiesFromModels(rows), nil
+}
+
+func reasonFromModel(rs dbgen.OrderReason) *Reason {
+	return &Reason{
+		ID:        rs.ID,
+		Code:      rs.Code,
+		Kind:      ReasonKind(rs.Kind),
+		Audience:  ReasonAudience(rs.Audience),
+		Label:     rs.Label,
+		Position:  int(rs.Position),
+		IsActive:  rs.IsActive,
+		UpdatedAt: rs.UpdatedAt,
+	}
+}
+
+func (r *repository) ListReasons(
+	ctx context.Context,
+	kind *ReasonKind,
+	customerOnly bool,
+) ([]*Reason, error) {
+	params := dbgen.ListReasonsParams{CustomerOnly: customerOnly}
+	if kind != nil {
+		params.Kind = sql.NullString{String: string(*kind), Valid: true}
+	}
+
+	rows, err := r.q.ListReasons(ctx, params)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to query order reasons", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	reasons := make([]*Reason, 0, len(rows))
+	for _, row := range rows {
+		reasons = append(reasons, reasonFromModel(row))
+	}
+
+	return reasons, nil
+}
+
+func (r *repository) GetReason(ctx context.Context, id int32) (*Reason, error) {
+	rs, err := r.q.GetReason(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrReasonNotFound
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to get order reason", zap.Error(err))
+		return nil, ErrDB
+	}
+	return reasonFromModel(rs), nil
+}
+
+func (r *repository) SaveReason(ctx context.Context, reason *Reason) (*Reason, error) {
+	var saved dbgen.OrderReason
+	var err error
+	if reason.ID == 0 {
+		saved, err = r.q.CreateReason(ctx, dbgen.CreateReasonParams{
+			Code:     reason.Code,
+			Kind:     string(reason.Kind),
+			Audience: string(reason.Audience),
+			Label:    reason.Label,
+			Position: int32(reason.Position),
+			IsActive: reason.IsActive,
+		})
+	} else {
+		saved, err = r.q.UpdateReason(ctx, dbgen.UpdateReasonParams{
+			Code:     reason.Code,
+			Kind:     string(reason.Kind),
+			Audience: string(reason.Audience),
+			Label:    reason.Label,
+			Position: int32(reason.Position),
+			IsActive: reason.IsActive,
+			ID:       reason.ID,
+		})
+	}
+
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrReasonNotFound
+	}
+	if isUniqueViolation(err) {
+		return nil, ErrReasonCodeTaken
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to save order reason", zap.Error(err))
+		return nil, ErrDB
+	}
+	return reasonFromModel(saved), nil
+}
+
+// ReasonStats counts per reason what stopped in [from, to). Cancellations
+// are counted when the order stopped, returns when the refund was
+// requested; an order refunded in two parts counts once.
+func (r *repository) ReasonStats(
+	ctx context.Context,
+	kind ReasonKind,
+	from, to time.Time,
+) ([]*ReasonStat, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ReasonStats"),
+		zap.String("kind", string(kind)),
+	)
+
+	var rows []dbgen.CancellationReasonStatsRow
+	var err error
+	switch kind {
+	case ReasonKindCancellation:
+		rows, err = r.q.CancellationReasonStats(ctx, dbgen.CancellationReasonStatsParams{
+			StoppedFrom:  from,
+			StoppedUntil: to,
+			Kind:         string(kind),
+		})
+	case ReasonKindReturn:
+		var returns []dbgen.ReturnReasonStatsRow
+		returns, err = r.q.ReturnReasonStats(ctx, dbgen.ReturnReasonStatsParams{
+			StoppedFrom:  from,
+			StoppedUntil: to,
+			Kind:         string(kind),
+		})
+		for _, row := range returns {
+			rows = append(rows, dbgen.CancellationReasonStatsRow(row))
+		}
+	default:
+		return nil, ErrInvalidReason
+	}
+	if err != nil {
+		log.Error("failed to query order reason stats", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	stats := make([]*ReasonStat, 0, len(rows))
+	for _, row := range rows {
+		stats = append(stats, &ReasonStat{
+			Reason: reasonFromModel(dbgen.OrderReason{
+				ID:        row.ID,
+				Code:      row.Code,
+				Kind:      row.Kind,
+				Audience:  row.Audience,
+				Label:     row.Label,
+				Position:  row.Position,
+				IsActive:  row.IsActive,
+				UpdatedAt: row.UpdatedAt,
+			}),
+			Orders: int(row.Orders),
+			Amount: row.Amount,
+		})
+	}
+
+	return stats, nil
+}
+
+func orderAdjustmentFromModel(a dbgen.OrderAdjustment) *OrderAdjustment {
+	return &OrderAdjustment{
+		ID:          a.ID,
+		OrderID:     a.OrderID,
+		Kind:        AdjustmentKind(a.Kind),
+		Amount:      a.Amount,
+		Reason:      a.Reason,
+		Status:      AdjustmentStatus(a.Status),
+		RequestedBy: a.RequestedBy,
+		ReviewedBy:  int32Ptr(a.ReviewedBy),
+		CreatedAt:   a.CreatedAt,
+		ReviewedAt:  timePtr(a.ReviewedAt),
+	}
+}
+
+func (r *repository) GetAdjustableOrder(
+	ctx context.Context,
+	orderID uint,
+) (*Order, string, error) {
+	row, err := r.q.GetAdjustableOrder(ctx, int32(orderID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, "", ErrOrderNotFound
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to get order to adjust",
+			zap.Uint("order_id", orderID),
+			zap.Error(err),
+		)
+		return nil, "", ErrDB
+	}
+	return &Order{
+		ID:           row.ID,
+		ExternalID:   row.ExternalID,
+		UserID:       int32Ptr(row.UserID),
+		Status:       OrderStatus(row.Status),
+		TotalAmount:  uint(row.TotalAmount),
+		WalletAmount: uint(row.WalletAmount),
+		Currency:     row.Currency,
+	}, row.SessionExternalID.String, nil
+}
+
+func (r *repository) CreateOrderAdjustment(
+	ctx context.Context,
+	a *OrderAdjustment,
+) (*OrderAdjustment, error) {
+	saved, err := r.q.CreateOrderAdjustment(ctx, dbgen.CreateOrderAdjustmentParams{
+		OrderID:     a.OrderID,
+		Kind:        string(a.Kind),
+		Amount:      a.Amount,
+		Reason:      a.Reason,
+		RequestedBy: a.RequestedBy,
+	})
+	if isUniqueViolation(err) {
+		return nil, ErrAdjustmentPending
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to create order adjustment",
+			zap.Int32("order_id", a.OrderID),
+			zap.Error(err),
+		)
+		return nil, ErrDB
+	}
+	return orderAdjustmentFromModel(saved), nil
+}
+
+func (r *repository) GetOrderAdjustment(
+	ctx context.Context,
+	id int64,
+) (*OrderAdjustment, error) {
+	a, err := r.q.GetOrderAdjustment(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrAdjustmentNotFound
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to get order adjustment",
+			zap.Int64("adjustment_id", id),
+			zap.Error(err),
+		)
+		return nil, ErrDB
+	}
+	return orderAdjustmentFromModel(a), nil
+}
+
+func (r *repository) ListOrderAdjustments(
+	ctx context.Context,
+	orderID uint,
+) ([]*OrderAdjustment, error) {
+	rows, err := r.q.ListOrderAdjustments(ctx, int32(orderID))
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to query order adjustments",
+			zap.Uint("order_id", orderID),
+			zap.Error(err),
+		)
+		return nil, ErrDB
+	}
+
+	adjustments := make([]*OrderAdjustment, 0, len(rows))
+	for _, row := range rows {
+		adjustments = append(adjustments, orderAdjustmentFromModel(row))
+	}
+
+	return adjustments, nil
+}
+
+func (r *repository) ApplyOrderAdjustment(
+	ctx context.Context,
+	id int64,
+	reviewedBy uint,
+	replaced string,
+	invoice *payment.Payment,
+) (a *OrderAdjustment, o *Order, err error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ApplyOrderAdjustment"),
+		zap.Int64("adjustment_id", id),
+	)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error("failed to begin transaction", zap.Error(err))
+		return nil, nil, ErrDB
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	qtx := r.q.WithTx(tx)
+
+	locked, err := qtx.LockOrderAdjustment(ctx, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil, ErrAdjustmentNotFound
+	}
+	if err != nil {
+		log.Error("failed to lock order adjustment", zap.Error(err))
+		return nil, nil, ErrDB
+	}
+	a = orderAdjustmentFromModel(locked)
+	if a.Status != AdjustmentPending {
+		return nil, nil, ErrAdjustmentNotPending
+	}
+
+	// The order is checked again under its lock: it may have been paid
+	// with the old invoice while the new one was being made.
+	row, err := qtx.LockOrderForAdjustment(ctx, a.OrderID)
+	if err != nil {
+		log.Error("failed to lock order", zap.Error(err))
+		return nil, nil, ErrDB
+	}
+	o = &Order{
+		ID:           a.OrderID,
+		ExternalID:   row.ExternalID,
+		UserID:       int32Ptr(row.UserID),
+		Status:       OrderStatus(row.Status),
+		TotalAmount:  uint(row.TotalAmount),
+		WalletAmount: uint(row.WalletAmount),
+		Currency:     row.Currency,
+	}
+	if o.Status != OrderStatusPendingPayment {
+		return nil, nil, ErrOrderNotAdjustable
+	}
+	if int64(o.GatewayAmount())+a.Delta() <= 0 {
+		return nil, nil, ErrAdjustmentTooLarge
+	}
+
+	totals, err := qtx.AdjustOrderTotal(ctx, dbgen.AdjustOrderTotalParams{
+		Delta: a.Delta(),
+		ID:    a.OrderID,
+	})
+	if err != nil {
+		log.Error("failed to adjust order total", zap.Error(err))
+		return nil, nil, ErrDB
+	}
+	o.TotalAmount = uint(totals.TotalAmount)
+	o.Adjustment = int(totals.AdjustmentAmount)
+
+	if replaced != "" {
+		if _, err = qtx.UpdatePaymentStatusFrom(ctx, dbgen.UpdatePaymentStatusFromParams{
+			Status:            string(PaymentStatusVoided),
+			ExternalReference: replaced,
+			FromStatus:        string(PaymentStatusPending),
+		}); err != nil {
+			log.Error("failed to void replaced payment", zap.Error(err))
+			return nil, nil, ErrDB
+		}
+	}
+
+	if err = qtx.SavePayment(ctx, dbgen.SavePaymentParams{
+		OrderID:           a.OrderID,
+		ExternalReference: invoice.ExternalReference,
+		InvoiceUrl:        invoice.InvoiceURL,
+		Amount:            invoice.Amount,
+		Status:            invoice.Status,
+		PaymentMethod:     sql.NullString{String: string(invoice.PaymentMethod), Valid: true},
+		ChannelCode:       invoice.ChannelCode,
+		PaymentCode:       invoice.PaymentCode,
+		Provider:          payment.ProviderXendit,
+		Currency:          o.Currency,
+		ExpireAt:          sql.NullTime{Time: invoice.ExpireAt, Valid: !invoice.ExpireAt.IsZero()},
+	}); err != nil {
+		log.Error("failed to save replacement payment", zap.Error(err))
+		return nil, nil, ErrDB
+	}
+
+	reviewed, err := qtx.ReviewOrderAdjustment(ctx, dbgen.ReviewOrderAdjustmentParams{
+		ID:         id,
+		Status:     string(AdjustmentApplied),
+		ReviewedBy: sql.NullInt32{Int32: int32(reviewedBy), Valid: true},
+	})
+	if err != nil {
+		log.Error("failed to update order adjustment", zap.Error(err))
+		return nil, nil, ErrDB
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Error("failed to commit order adjustment", zap.Error(err))
+		return nil, nil, ErrDB
+	}
+
+	return orderAdjustmentFromModel(reviewed), o, nil
+}
+
+func (r *repository) RejectOrderAdjustment(
+	ctx context.Context,
+	id int64,
+	reviewedBy uint,
+) (*OrderAdjustment, error) {
+	a, err := r.q.ReviewPendingOrderAdjustment(ctx, dbgen.ReviewPendingOrderAdjustmentParams{
+		Status:     string(AdjustmentRejected),
+		ReviewedBy: sql.NullInt32{Int32: int32(reviewedBy), Valid: true},
+		ID:         id,
+		FromStatus: string(AdjustmentPending),
+	})
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrAdjustmentNotPending
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to reject order adjustment",
+			zap.Int64("adjustment_id", id),
+			zap.Error(err),
+		)
+		return nil, ErrDB
+	}
+	return orderAdjustmentFromModel(a), nil
 }
@@ -6,7 +6,10 @@ import (
 	"errors"
 	"testing"
 	"time"
+	"warimas-be/internal/geo"
+	"warimas-be/internal/payment"
 	"warimas-be/internal/utils"
+	"warimas-be/internal/wallet"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
@@ -31,11 +34,13 @@ func TestRepository_FetchOrders(t *testing.T) {
 		rows := sqlmock.NewRows([]string{
 			"id", "external_id", "invoice_number", "user_id", "currency",
 			"subtotal", "tax", "discount", "shipping_fee", "total_amount",
-			"status", "address_id", "created_at", "updated_at",
+			"status", "address_id", "created_at", "updated_at", "shipping_method",
+			"insurance_fee", "adjustment_amount",
 		}).AddRow(
 			1, "ext-1", "INV-1", 1, "IDR",
 			10000, 1000, 0, 5000, 16000,
-			"PENDING", uuid.New(), time.Now(), time.Now(),
+			"PENDING", uuid.New(), time.Now(), time.Now(), "STANDARD",
+			0, 0,
 		)
 
 		// Regex for the query
@@ -73,8 +78,8 @@ func TestRepository_FetchOrders_Filters(t *testing.T) {
 
 	// Helper to create full rows for FetchOrders
 	newFullRows := func() *sqlmock.Rows {
-		return sqlmock.NewRows([]string{"id", "external_id", "invoice_number", "user_id", "currency", "subtotal", "tax", "discount", "shipping_fee", "total_amount", "status", "address_id", "created_at", "updated_at"}).
-			AddRow(1, "ext-1", "INV-1", userID, "IDR", 10000, 1000, 0, 5000, 16000, "PAID", uuid.New(), time.Now(), time.Now())
+		return sqlmock.NewRows([]string{"id", "external_id", "invoice_number", "user_id", "currency", "subtotal", "tax", "discount", "shipping_fee", "total_amount", "status", "address_id", "created_at", "updated_at", "shipping_method", "insurance_fee", "adjustment_amount"}).
+			AddRow(1, "ext-1", "INV-1", userID, "IDR", 10000, 1000, 0, 5000, 16000, "PAID", uuid.New(), time.Now(), time.Now(), "STANDARD", 0, 0)
 	}
 
 	t.Run("SearchAndStatus", func(t *testing.T) {
@@ -120,6 +125,33 @@ func TestRepository_FetchOrders_Filters(t *testing.T) {
 		_, err := repo.FetchOrders(ctx, filter, nil, limit, offset)
 		assert.NoError(t, err)
 	})
+
+	t.Run("ProductWordsAndVariantMatchSameItem", func(t *testing.T) {
+		product := "  beras   5kg "
+		variantID := "6f1c2a8e-3b0d-4c55-9a7e-2d1f0b8c4e11"
+		filter := &OrderFilterInput{Product: &product, VariantID: &variantID}
+
+		mock.ExpectQuery(`SELECT .* FROM orders o WHERE o.user_id = \$1 AND EXISTS \(SELECT 1 FROM order_items oi WHERE oi.order_id = o.id AND \(oi.product_name \|\| ' ' \|\| oi.variant_name\) ILIKE \$2 AND \(oi.product_name \|\| ' ' \|\| oi.variant_name\) ILIKE \$3 AND oi.variant_id = \$4\) ORDER BY`).
+			WithArgs(userID, "%beras%", "%5kg%", variantID, limit, offset).
+			WillReturnRows(newFullRows())
+
+		_, err := repo.FetchOrders(ctx, filter, nil, limit, offset)
+		assert.NoError(t, err)
+	})
+
+	t.Run("BlankProductIgnored", func(t *testing.T) {
+		product := "   "
+		filter := &OrderFilterInput{Product: &product}
+
+		mock.ExpectQuery(`SELECT .* FROM orders o WHERE o.user_id = \$1 ORDER BY`).
+			WithArgs(userID, limit, offset).
+			WillReturnRows(newFullRows())
+
+		_, err := repo.FetchOrders(ctx, filter, nil, limit, offset)
+		assert.NoError(t, err)
+	})
+
+	require.NoError(t, mock.ExpectationsWereMet())
 }
 
 func TestRepository_GetOrderDetail(t *testing.T) {
@@ -135,10 +167,12 @@ func TestRepository_GetOrderDetail(t *testing.T) {
 		rows := sqlmock.NewRows([]string{
 			"id", "user_id", "total_amount", "status", "created_at", "updated_at",
 			"currency", "address_id", "external_id", "subtotal", "tax",
-			"shipping_fee", "discount", "invoice_number",
+			"shipping_fee", "discount", "invoice_number", "shipping_method", "insurance_fee",
+			"adjustment_amount", "cancel_reason_id", "cancel_note",
 		}).AddRow(
 			orderID, 1, 15000, "PAID", time.Now(), time.Now(),
-			"IDR", uuid.New(), "ext-123", 10000, 1000, 4000, 0, "INV-123",
+			"IDR", uuid.New(), "ext-123", 10000, 1000, 4000, 0, "INV-123", "INSTANT", 0,
+			0, nil, nil,
 		)
 
 		itemRows := sqlmock.NewRows([]string{
@@ -188,10 +222,12 @@ func TestRepository_GetOrderDetailByExternalID(t *testing.T) {
 		rows := sqlmock.NewRows([]string{
 			"id", "user_id", "total_amount", "status", "created_at", "updated_at",
 			"currency", "address_id", "external_id", "subtotal", "tax",
-			"shipping_fee", "discount", "invoice_number",
+			"shipping_fee", "discount", "invoice_number", "shipping_method", "insurance_fee",
+			"adjustment_amount", "cancel_reason_id", "cancel_note",
 		}).AddRow(
 			orderID, 1, 15000, "PAID", time.Now(), time.Now(),
-			"IDR", uuid.New(), extID, 10000, 1000, 4000, 0, "INV-123",
+			"IDR", uuid.New(), extID, 10000, 1000, 4000, 0, "INV-123", "INSTANT", 0,
+			0, nil, nil,
 		)
 
 		itemRows := sqlmock.NewRows([]string{
@@ -266,6 +302,8 @@ func TestRepository_CreateCheckoutSession(t *testing.T) {
 				session.ID, session.UserID, session.Status, session.Subtotal,
 				session.Tax, session.ShippingFee, session.Discount,
 				session.TotalPrice, session.ExpiresAt, session.ExternalID,
+				session.ChargeableWeightGrams, []byte(`[]`), session.GuestID,
+				session.ShippingMethod,
 			).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 
@@ -306,17 +344,23 @@ func TestRepository_GetCheckoutSession(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		sessionID := uuid.New()
 		itemID := uuid.New()
+		paymentExpiresAt := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
 
 		rows := sqlmock.NewRows([]string{
 			"id", "external_id", "status", "expires_at", "created_at",
-			"user_id", "address_id", "subtotal", "tax", "shipping_fee", "discount",
-			"total_amount", "currency", "confirmed_at", "payment_method",
+			"user_id", "guest_id", "address_id", "subtotal", "tax", "shipping_fee", "discount",
+			"total_amount", "wallet_amount", "currency", "confirmed_at", "payment_method", "voucher_id", "points_redeemed",
+			"chargeable_weight_grams", "shipping_parcels", "shipping_method",
+			"pickup_location_id", "pickup_slot_start", "delivery_slot_id", "delivery_date",
+			"insured", "payment_expires_at",
 			"item_id", "variant_id", "variant_name", "product_name",
 			"imageurl", "quantity", "quantity_type", "unit_price", "item_subtotal",
 		}).AddRow(
-			sessionID, extID, "PENDING", time.Now(), time.Now(),
-			1, nil, 10000, 0, 0, 0, 10000, "IDR", nil, nil,
-			itemID, "var-1", "V1", "P1", "img", 1, "pcs", 10000, 10000,
+			sessionID.String(), extID, "PENDING", time.Now(), time.Now(),
+			1, nil, nil, 10000, 0, 0, 0, 10000, 0, "IDR", nil, nil, nil, 0,
+			1500, []byte(`[{"originId":"o1","originCity":"Bekasi","originLocation":{"lat":-6.24,"lng":106.99},"weightGrams":1500}]`), "INSTANT",
+			nil, nil, nil, nil, false, paymentExpiresAt,
+			itemID.String(), "var-1", "V1", "P1", "img", 1, "pcs", 10000, 10000,
 		)
 
 		mock.ExpectQuery(`SELECT .* FROM checkout_sessions s LEFT JOIN checkout_session_items i`).
@@ -327,10 +371,23 @@ func TestRepository_GetCheckoutSession(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NotNil(t, sess)
 		assert.Equal(t, sessionID, sess.ID)
+		assert.Equal(t, 1500, sess.ChargeableWeightGrams)
+		require.Len(t, sess.ShippingParcels, 1)
+		assert.Equal(t, "Bekasi", sess.ShippingParcels[0].OriginCity)
+		assert.Equal(t, &geo.Point{Lat: -6.24, Lng: 106.99}, sess.ShippingParcels[0].OriginLocation)
+		assert.Equal(t, ShippingMethodInstant, sess.ShippingMethod)
 		assert.Len(t, sess.Items, 1)
+		require.NotNil(t, sess.PaymentExpiresAt)
+		assert.Equal(t, paymentExpiresAt, *sess.PaymentExpiresAt)
 	})
 }
 
+// expectSessionLock expects CreateOrderTx to lock a session in status.
+func expectSessionLock(mock sqlmock.Sqlmock, status CheckoutSessionStatus) {
+	mock.ExpectQuery(`SELECT status FROM checkout_sessions WHERE id = \$1 FOR UPDATE`).
+		WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow(status))
+}
+
 func TestRepository_CreateOrderTx(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -376,27 +433,35 @@ func TestRepository_CreateOrderTx(t *testing.T) {
 		mock.ExpectBegin()
 
 		// 1. Insert Order
+		expectSessionLock(mock, CheckoutSessionStatusPending)
 		mock.ExpectQuery(`INSERT INTO orders`).
 			WithArgs(
 				order.UserID, session.ID, order.Status, order.TotalAmount,
 				order.Currency, order.ExternalID, session.Subtotal, session.Tax,
-				session.ShippingFee, session.Discount, session.AddressID,
+				session.ShippingFee, session.Discount, session.AddressID, order.WalletAmount,
+				order.PlacedBy, order.ShippingMethod, 0,
 			).
 			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(100))
 
-		// 2. Insert Order Item
+		// 2. Allocate stock from a warehouse
+		mock.ExpectQuery(`UPDATE variant_stocks vs SET quantity = vs.quantity - \$1`).
+			WithArgs(session.Items[0].Quantity, session.Items[0].VariantID, session.AddressID).
+			WillReturnRows(sqlmock.NewRows([]string{"warehouse_id"}).AddRow("wh-1"))
+
+		// 3. Insert Order Item
 		mock.ExpectExec(`INSERT INTO order_items`).
 			WithArgs(
-				100, session.Items[0].Quantity, session.Items[0].Price,
+				100, session.Items[0].Quantity, float64(session.Items[0].Price),
 				session.Items[0].VariantID, session.Items[0].VariantName,
-				session.Items[0].ProductName, session.Items[0].Subtotal, session.Items[0].ImageURL,
+				session.Items[0].ProductName, float64(session.Items[0].Subtotal), session.Items[0].ImageURL,
+				"wh-1",
 			).
 			WillReturnResult(sqlmock.NewResult(1, 1))
 
-		// 3. Deduct Stock
-		mock.ExpectExec(`UPDATE variants SET stock = stock - \$1`).
-			WithArgs(session.Items[0].Quantity, session.Items[0].VariantID).
-			WillReturnResult(sqlmock.NewResult(0, 1)) // 1 row affected
+		// 4. Charge commission at the category's current rate
+		mock.ExpectExec(`UPDATE order_items oi SET commission_rate_id = cr.id`).
+			WithArgs(int32(100)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
 
 		mock.ExpectCommit()
 
@@ -407,22 +472,38 @@ func TestRepository_CreateOrderTx(t *testing.T) {
 
 	t.Run("InsufficientStock", func(t *testing.T) {
 		mock.ExpectBegin()
+		expectSessionLock(mock, CheckoutSessionStatusPending)
 		mock.ExpectQuery(`INSERT INTO orders`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(100))
-		mock.ExpectExec(`INSERT INTO order_items`).WillReturnResult(sqlmock.NewResult(1, 1))
 
-		// 0 rows affected implies stock condition failed
-		mock.ExpectExec(`UPDATE variants SET stock`).
-			WillReturnResult(sqlmock.NewResult(0, 0))
+		// No warehouse row returned implies no warehouse can cover the quantity
+		mock.ExpectQuery(`UPDATE variant_stocks`).
+			WillReturnRows(sqlmock.NewRows([]string{"warehouse_id"}))
+		mock.ExpectExec(`INSERT INTO stock_incidents`).
+			WithArgs(session.Items[0].VariantID, session.ID, session.Items[0].Quantity).
+			WillReturnResult(sqlmock.NewResult(1, 1))
 
 		mock.ExpectRollback() // Implicitly handled by db.BeginTx defer rollback if panic/error, but here function returns error
 
 		err := repo.CreateOrderTx(ctx, order, session)
 		assert.Error(t, err)
 		assert.Equal(t, "insufficient stock", err.Error())
+		assert.ErrorIs(t, err, ErrInsufficientStock)
+	})
+
+	t.Run("SessionExpired", func(t *testing.T) {
+		mock.ExpectBegin()
+		expectSessionLock(mock, CheckoutSessionStatusExpired)
+		mock.ExpectRollback()
+
+		err := repo.CreateOrderTx(ctx, order, session)
+
+		assert.ErrorIs(t, err, ErrSessionExpired)
+		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
 	t.Run("InsertOrderError", func(t *testing.T) {
 		mock.ExpectBegin()
+		expectSessionLock(mock, CheckoutSessionStatusPending)
 		mock.ExpectQuery(`INSERT INTO orders`).WillReturnError(errors.New("insert order error"))
 		mock.ExpectRollback()
 		err := repo.CreateOrderTx(ctx, order, session)
@@ -431,7 +512,9 @@ func TestRepository_CreateOrderTx(t *testing.T) {
 
 	t.Run("InsertItemError", func(t *testing.T) {
 		mock.ExpectBegin()
+		expectSessionLock(mock, CheckoutSessionStatusPending)
 		mock.ExpectQuery(`INSERT INTO orders`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(100))
+		mock.ExpectQuery(`UPDATE variant_stocks`).WillReturnRows(sqlmock.NewRows([]string{"warehouse_id"}).AddRow("wh-1"))
 		mock.ExpectExec(`INSERT INTO order_items`).WillReturnError(errors.New("insert item error"))
 		mock.ExpectRollback()
 		err := repo.CreateOrderTx(ctx, order, session)
@@ -457,13 +540,13 @@ func TestRepository_UpdateStatusByReferenceID(t *testing.T) {
 		mock.ExpectBegin()
 
 		// 1. Update Order
-		mock.ExpectQuery(`UPDATE orders SET status = \$1 WHERE external_id = \$2 RETURNING checkout_session_id`).
+		mock.ExpectQuery(`UPDATE orders SET status = \$1 WHERE external_id = \$2 RETURNING id, checkout_session_id`).
 			WithArgs(status, refID).
-			WillReturnRows(sqlmock.NewRows([]string{"checkout_session_id"}).AddRow(sessionID))
+			WillReturnRows(sqlmock.NewRows([]string{"id", "checkout_session_id"}).AddRow(1, sessionID))
 
 		// 2. Update Session
 		mock.ExpectExec(`UPDATE checkout_sessions SET status = \$1 WHERE id = \$2`).
-			WithArgs(status, sessionID).
+			WithArgs(CheckoutSessionStatusPaid, sessionID).
 			WillReturnResult(sqlmock.NewResult(0, 1))
 
 		// 3. Update Payment (PAID includes paid_at)
@@ -477,6 +560,53 @@ func TestRepository_UpdateStatusByReferenceID(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("Success_FailedReturnsWallet", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`UPDATE orders SET status = \$1 WHERE external_id = \$2 RETURNING id, checkout_session_id`).
+			WithArgs("FAILED", refID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "checkout_session_id"}).AddRow(1, sessionID))
+		// Sessions have no FAILED status; a failed payment cancels it
+		mock.ExpectExec(`UPDATE checkout_sessions SET status = \$1 WHERE id = \$2`).
+			WithArgs(CheckoutSessionStatusCanceled, sessionID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		mock.ExpectQuery(`UPDATE payments p SET status = \$1 FROM orders o`).
+			WithArgs(PaymentStatusVoided, "wallet-"+refID, PaymentStatusPaid).
+			WillReturnRows(sqlmock.NewRows([]string{"user_id", "amount"}).AddRow(7, 5000))
+		mock.ExpectQuery(`UPDATE wallets SET balance = balance \+ \$1`).
+			WithArgs(int64(5000), int32(7)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "balance"}).AddRow(3, 12000))
+		mock.ExpectExec(`INSERT INTO wallet_ledger`).
+			WithArgs(int64(3), wallet.EntryCredit, int64(5000), int64(12000), wallet.ReferenceOrderPayment, refID).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+
+		mock.ExpectExec(`UPDATE payments SET status = \$1, provider_payment_id = \$2\s*WHERE external_reference = \$3`).
+			WithArgs("FAILED", provID, payReqID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := repo.UpdateStatusByReferenceID(ctx, refID, payReqID, provID, "FAILED")
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Success_FailedWalletAlreadyReturned", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`UPDATE orders`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "checkout_session_id"}).AddRow(1, sessionID))
+		mock.ExpectExec(`UPDATE checkout_sessions`).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(`UPDATE payments p SET status = \$1 FROM orders o`).
+			WillReturnError(sql.ErrNoRows)
+		mock.ExpectExec(`UPDATE payments SET status = \$1, provider_payment_id = \$2`).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := repo.UpdateStatusByReferenceID(ctx, refID, payReqID, provID, "FAILED")
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
 	t.Run("UpdateOrderError", func(t *testing.T) {
 		mock.ExpectBegin()
 		mock.ExpectQuery(`UPDATE orders`).WillReturnError(errors.New("update order error"))
@@ -487,7 +617,7 @@ func TestRepository_UpdateStatusByReferenceID(t *testing.T) {
 
 	t.Run("UpdateSessionError", func(t *testing.T) {
 		mock.ExpectBegin()
-		mock.ExpectQuery(`UPDATE orders`).WillReturnRows(sqlmock.NewRows([]string{"checkout_session_id"}).AddRow(sessionID))
+		mock.ExpectQuery(`UPDATE orders`).WillReturnRows(sqlmock.NewRows([]string{"id", "checkout_session_id"}).AddRow(1, sessionID))
 		mock.ExpectExec(`UPDATE checkout_sessions`).WillReturnError(errors.New("update session error"))
 		mock.ExpectRollback()
 		err := repo.UpdateStatusByReferenceID(ctx, refID, payReqID, provID, status)
@@ -495,6 +625,251 @@ func TestRepository_UpdateStatusByReferenceID(t *testing.T) {
 	})
 }
 
+func TestRepository_MarkPaidByReferenceID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+
+	refID := "ord-ext-1"
+	sessionID := uuid.New().String()
+	paidAt := time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+	capture := payment.Capture{
+		ExternalReference: "pay-req-1",
+		ProviderPaymentID: "prov-1",
+		Amount:            150000,
+		Fee:               4440,
+		ChannelCode:       "BCA_VIRTUAL_ACCOUNT",
+		PaidAt:            paidAt,
+	}
+
+	expectOrderAndSession := func() {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`UPDATE orders SET status = \$1 WHERE external_id = \$2 RETURNING id, checkout_session_id`).
+			WithArgs("PAID", refID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "checkout_session_id"}).AddRow(9, sessionID))
+		mock.ExpectExec(`UPDATE checkout_sessions SET status = \$1 WHERE id = \$2`).
+			WithArgs(CheckoutSessionStatusPaid, sessionID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+
+	t.Run("WritesCapture", func(t *testing.T) {
+		expectOrderAndSession()
+		mock.ExpectExec(`UPDATE payments SET status = \$1, provider_payment_id = \$2, captured_amount = COALESCE\(\$3, captured_amount\)`).
+			WithArgs(PaymentStatusPaid, "prov-1",
+				sql.NullInt64{Int64: 150000, Valid: true},
+				sql.NullInt64{Int64: 4440, Valid: true},
+				sql.NullString{String: "BCA_VIRTUAL_ACCOUNT", Valid: true},
+				sql.NullTime{Time: paidAt, Valid: true},
+				"pay-req-1").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		assert.NoError(t, repo.MarkPaidByReferenceID(ctx, refID, capture))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("RecreatesMissingPayment", func(t *testing.T) {
+		expectOrderAndSession()
+		mock.ExpectExec(`UPDATE payments`).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`INSERT INTO payments .* SELECT o.id, \$1, '', \$2, \$3, \$4, '', \$5, o.currency, \$6, COALESCE\(\$7, now\(\)\), \$2, \$8 FROM orders o WHERE o.id = \$9`).
+			WithArgs("pay-req-1", int64(150000), PaymentStatusPaid, "BCA_VIRTUAL_ACCOUNT",
+				payment.ProviderXendit, "prov-1", sql.NullTime{Time: paidAt, Valid: true},
+				sql.NullInt64{Int64: 4440, Valid: true}, int32(9)).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		assert.NoError(t, repo.MarkPaidByReferenceID(ctx, refID, capture))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("MissingPaymentWithoutAmount", func(t *testing.T) {
+		expectOrderAndSession()
+		mock.ExpectExec(`UPDATE payments`).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectCommit()
+
+		err := repo.MarkPaidByReferenceID(ctx, refID, payment.Capture{ExternalReference: "pay-req-1", ProviderPaymentID: "prov-1"})
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("UpdatePaymentError", func(t *testing.T) {
+		expectOrderAndSession()
+		mock.ExpectExec(`UPDATE payments`).WillReturnError(errors.New("update payment error"))
+		mock.ExpectRollback()
+
+		assert.ErrorIs(t, repo.MarkPaidByReferenceID(ctx, refID, capture), ErrDB)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_ListExpiredPayments(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT o.id, o.external_id, p.external_reference, o.user_id, p.expire_at FROM payments p JOIN orders o`).
+			WithArgs(PaymentStatusPending, payment.ProviderXendit, now, OrderStatusPendingPayment, int32(50)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "external_id", "external_reference", "user_id", "expire_at"}).
+				AddRow(1, "ord-1", "pr-1", 7, now.Add(-time.Minute)).
+				AddRow(2, "ord-2", "pr-2", nil, now.Add(-time.Second)))
+
+		out, err := repo.ListExpiredPayments(ctx, now, 50)
+		assert.NoError(t, err)
+		require.Len(t, out, 2)
+		assert.Equal(t, "pr-1", out[0].PaymentRequestID)
+		assert.Equal(t, int32(7), *out[0].UserID)
+		assert.Nil(t, out[1].UserID)
+	})
+
+	t.Run("DBError", func(t *testing.T) {
+		mock.ExpectQuery(`FROM payments p JOIN orders o`).WillReturnError(errors.New("db error"))
+
+		_, err := repo.ListExpiredPayments(ctx, now, 50)
+		assert.ErrorIs(t, err, ErrDB)
+	})
+}
+
+func TestRepository_ExpireOrderPayment(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+	refID := "ord-ext-1"
+	sessionID := uuid.New().String()
+
+	expectLock := func(status OrderStatus) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT status FROM orders WHERE external_id = \$1 FOR UPDATE`).
+			WithArgs(refID).
+			WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow(status))
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		expectLock(OrderStatusPendingPayment)
+		mock.ExpectExec(`UPDATE payments SET status = \$1 WHERE external_reference = \$2 AND status = \$3`).
+			WithArgs(PaymentStatusExpired, "pr-1", PaymentStatusPending).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(`UPDATE orders SET status = \$1 WHERE external_id = \$2 RETURNING id, checkout_session_id`).
+			WithArgs("CANCELLED", refID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "checkout_session_id"}).AddRow(9, sessionID))
+		mock.ExpectExec(`UPDATE checkout_sessions SET status = \$1 WHERE id = \$2`).
+			WithArgs(CheckoutSessionStatusExpired, sessionID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		// Paid in full at the gateway: no wallet portion to return
+		mock.ExpectQuery(`UPDATE payments p SET status = \$1 FROM orders o`).
+			WithArgs(PaymentStatusVoided, "wallet-"+refID, PaymentStatusPaid).
+			WillReturnError(sql.ErrNoRows)
+		mock.ExpectExec(`UPDATE variant_stocks vs SET quantity = vs.quantity \+ oi.quantity`).
+			WithArgs(int32(9)).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		assert.NoError(t, repo.ExpireOrderPayment(ctx, refID, "pr-1"))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("OrderNoLongerPending", func(t *testing.T) {
+		expectLock(OrderStatusPaid)
+		mock.ExpectRollback()
+
+		assert.ErrorIs(t, repo.ExpireOrderPayment(ctx, refID, "pr-1"), ErrPaymentNotPending)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("PaymentNoLongerPending", func(t *testing.T) {
+		expectLock(OrderStatusPendingPayment)
+		mock.ExpectExec(`UPDATE payments SET status = \$1`).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		assert.ErrorIs(t, repo.ExpireOrderPayment(ctx, refID, "pr-1"), ErrPaymentNotPending)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("RestockError", func(t *testing.T) {
+		expectLock(OrderStatusPendingPayment)
+		mock.ExpectExec(`UPDATE payments SET status = \$1`).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(`UPDATE orders`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "checkout_session_id"}).AddRow(9, sessionID))
+		mock.ExpectExec(`UPDATE checkout_sessions`).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(`UPDATE payments p SET status = \$1 FROM orders o`).
+			WillReturnError(sql.ErrNoRows)
+		mock.ExpectExec(`UPDATE variant_stocks`).
+			WillReturnError(errors.New("deadlock"))
+		mock.ExpectRollback()
+
+		assert.ErrorIs(t, repo.ExpireOrderPayment(ctx, refID, "pr-1"), ErrDB)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_CancelUnpaidSessionOrder(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+	refID := "ord-ext-1"
+	sessionID := uuid.New().String()
+
+	expectLock := func(status OrderStatus, hasPayment bool) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT o.status, EXISTS \(SELECT 1 FROM payments p WHERE p.order_id = o.id\) FROM orders o WHERE o.external_id = \$1 FOR UPDATE OF o`).
+			WithArgs(refID).
+			WillReturnRows(sqlmock.NewRows([]string{"status", "exists"}).AddRow(status, hasPayment))
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		expectLock(OrderStatusPendingPayment, false)
+		mock.ExpectQuery(`UPDATE orders SET status = \$1 WHERE external_id = \$2 RETURNING id, checkout_session_id`).
+			WithArgs("CANCELLED", refID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "checkout_session_id"}).AddRow(9, sessionID))
+		mock.ExpectExec(`UPDATE checkout_sessions SET status = \$1 WHERE id = \$2`).
+			WithArgs(CheckoutSessionStatusExpired, sessionID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(`UPDATE payments p SET status = \$1 FROM orders o`).
+			WillReturnError(sql.ErrNoRows)
+		mock.ExpectExec(`UPDATE variant_stocks vs SET quantity = vs.quantity \+ oi.quantity`).
+			WithArgs(int32(9)).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		assert.NoError(t, repo.CancelUnpaidSessionOrder(ctx, refID))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("PaymentArrived", func(t *testing.T) {
+		expectLock(OrderStatusPendingPayment, true)
+		mock.ExpectRollback()
+
+		assert.ErrorIs(t, repo.CancelUnpaidSessionOrder(ctx, refID), ErrPaymentNotPending)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("OrderNoLongerPending", func(t *testing.T) {
+		expectLock(OrderStatusPaid, true)
+		mock.ExpectRollback()
+
+		assert.ErrorIs(t, repo.CancelUnpaidSessionOrder(ctx, refID), ErrPaymentNotPending)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
 func TestRepository_GetOrderByExternalID(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -506,10 +881,10 @@ func TestRepository_GetOrderByExternalID(t *testing.T) {
 
 	t.Run("Success", func(t *testing.T) {
 		rows := sqlmock.NewRows([]string{
-			"id", "user_id", "status", "total_amount", "currency", "address_id", "external_id",
-		}).AddRow(1, 1, "PENDING", 10000, "IDR", uuid.New(), extID)
+			"id", "user_id", "status", "total_amount", "wallet_amount", "currency", "address_id", "external_id",
+		}).AddRow(1, 1, "PENDING", 10000, 0, "IDR", uuid.New(), extID)
 
-		mock.ExpectQuery(`SELECT id, user_id, status, total_amount, currency, address_id, external_id FROM orders WHERE external_id = \$1`).
+		mock.ExpectQuery(`SELECT id, user_id, status, total_amount, wallet_amount, currency, address_id, external_id FROM orders WHERE external_id = \$1`).
 			WithArgs(extID).
 			WillReturnRows(rows)
 
@@ -529,10 +904,13 @@ func TestRepository_GetVariantForCheckout(t *testing.T) {
 	variantID := "var-1"
 
 	t.Run("Success", func(t *testing.T) {
-		rows := sqlmock.NewRows([]string{"id", "name", "price", "quantity_type", "imageurl", "stock", "product_name"}).
-			AddRow(variantID, "Variant 1", 10000, "pcs", "img", 10, "Product 1")
+		rows := sqlmock.NewRows([]string{
+			"id", "name", "price", "quantity_type", "imageurl", "stock", "product_name",
+			"weight_grams", "length_cm", "width_cm", "height_cm", "origin_id", "origin_city",
+			"origin_latitude", "origin_longitude",
+		}).AddRow(variantID, "Variant 1", 10000, "pcs", "img", 10, "Product 1", 5000, 40, 30, 10, "o1", "Bekasi", -6.24, 106.99)
 
-		mock.ExpectQuery(`SELECT v.id, v.name, v.price, .* FROM variants v`).
+		mock.ExpectQuery(`SELECT v.id, v.name, v.price, .* FROM variants v .* FROM seller_origins so WHERE so.id = p.origin_id OR \(p.origin_id IS NULL AND so.seller_id = p.seller_id AND so.is_default\)`).
 			WithArgs(variantID).
 			WillReturnRows(rows)
 
@@ -540,30 +918,26 @@ func TestRepository_GetVariantForCheckout(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, variantID, v.ID)
 		assert.Equal(t, "Product 1", p.Name)
+		assert.Equal(t, int32(5000), v.Shipping.WeightGrams)
+		require.NotNil(t, p.ShipsFrom)
+		assert.Equal(t, "Bekasi", p.ShipsFrom.City)
+		assert.Equal(t, &geo.Point{Lat: -6.24, Lng: 106.99}, p.ShipsFrom.Location)
 	})
-}
-
-func TestRepository_GetUserAddress(t *testing.T) {
-	db, mock, err := sqlmock.New()
-	require.NoError(t, err)
-	defer db.Close()
 
-	repo := NewRepository(db)
-	ctx := context.Background()
-	addrID := uuid.New().String()
-	userID := uint(1)
-
-	t.Run("Success", func(t *testing.T) {
-		rows := sqlmock.NewRows([]string{"id", "city"}).
-			AddRow(addrID, "Jakarta")
+	t.Run("NoOrigin", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{
+			"id", "name", "price", "quantity_type", "imageurl", "stock", "product_name",
+			"weight_grams", "length_cm", "width_cm", "height_cm", "origin_id", "origin_city",
+			"origin_latitude", "origin_longitude",
+		}).AddRow(variantID, "Variant 1", 10000, "pcs", "img", 10, "Product 1", 5000, 40, 30, 10, nil, nil, nil, nil)
 
-		mock.ExpectQuery(`SELECT id, city FROM addresses`).
-			WithArgs(addrID, userID).
+		mock.ExpectQuery(`FROM variants v`).
+			WithArgs(variantID).
 			WillReturnRows(rows)
 
-		addr, err := repo.GetUserAddress(ctx, addrID, userID)
+		_, p, err := repo.GetVariantForCheckout(ctx, variantID)
 		assert.NoError(t, err)
-		assert.Equal(t, "Jakarta", addr.City)
+		assert.Nil(t, p.ShipsFrom)
 	})
 }
 
@@ -602,7 +976,7 @@ func TestRepository_ValidateVariantStock(t *testing.T) {
 	ctx := context.Background()
 
 	t.Run("InStock", func(t *testing.T) {
-		mock.ExpectQuery(`SELECT stock >= \$1 FROM variants`).
+		mock.ExpectQuery(`SELECT EXISTS \( SELECT 1 FROM variant_stocks s JOIN warehouses w`).
 			WithArgs(5, "var-1").
 			WillReturnRows(sqlmock.NewRows([]string{"ok"}).AddRow(true))
 
@@ -612,6 +986,30 @@ func TestRepository_ValidateVariantStock(t *testing.T) {
 	})
 }
 
+func TestRepository_VariantsOnVacation(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+	ids := []string{"var-1", "var-2"}
+
+	mock.ExpectQuery(`SELECT v.id FROM variants v JOIN products p .* WHERE v.id = ANY\(\$1::uuid\[\]\) AND EXISTS \( SELECT 1 FROM store_vacations sv WHERE sv.seller_id = p.seller_id AND sv.starts_at <= NOW\(\) AND sv.ends_at > NOW\(\)`).
+		WithArgs(pq.Array(ids)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("var-2"))
+
+	away, err := repo.VariantsOnVacation(ctx, ids)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"var-2"}, away)
+
+	mock.ExpectQuery(`FROM variants v`).WillReturnError(errors.New("boom"))
+	_, err = repo.VariantsOnVacation(ctx, ids)
+	assert.ErrorIs(t, err, ErrDB)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestRepository_ConfirmCheckoutSession(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -659,19 +1057,36 @@ func TestRepository_CountOrders(t *testing.T) {
 	})
 
 	t.Run("WithFilters", func(t *testing.T) {
+		adminCtx := utils.SetUserContext(ctx, 1, "admin@example.com", "ADMIN")
 		search := "test"
 		filter := &OrderFilterInput{Search: &search}
 
 		// Query builder uses dynamic args.
 		// Search is the first filter added.
-		mock.ExpectQuery(`SELECT COUNT\(1\) FROM orders WHERE \(id::text ILIKE \$1 OR external_id ILIKE \$1\)`).
+		mock.ExpectQuery(`SELECT COUNT\(1\) FROM orders o WHERE \(o.id::text ILIKE \$1 OR o.external_id ILIKE \$1\)`).
 			WithArgs("%" + search + "%").
 			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(5))
 
-		count, err := repo.CountOrders(ctx, filter)
+		count, err := repo.CountOrders(adminCtx, filter)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(5), count)
 	})
+
+	t.Run("CustomerCountsOwnOrders", func(t *testing.T) {
+		userCtx := utils.SetUserContext(ctx, 7, "test@example.com", "USER")
+		product := "Beras"
+		filter := &OrderFilterInput{Product: &product}
+
+		mock.ExpectQuery(`SELECT COUNT\(1\) FROM orders o WHERE o.user_id = \$1 AND EXISTS \(SELECT 1 FROM order_items oi WHERE oi.order_id = o.id AND \(oi.product_name \|\| ' ' \|\| oi.variant_name\) ILIKE \$2\)`).
+			WithArgs(uint(7), "%Beras%").
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+		count, err := repo.CountOrders(userCtx, filter)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+	})
+
+	require.NoError(t, mock.ExpectationsWereMet())
 }
 
 func TestRepository_FetchOrderItems(t *testing.T) {
@@ -690,7 +1105,7 @@ func TestRepository_FetchOrderItems(t *testing.T) {
 		}).AddRow(10, 1, "V1", "P1", "img", 1, "pcs", 1000, "var-1", 1000)
 
 		// pq.Array can be tricky with sqlmock, usually matching the query string is enough
-		mock.ExpectQuery(`SELECT .* FROM order_items WHERE order_id = ANY\(\$1\)`).
+		mock.ExpectQuery(`SELECT .* FROM order_items WHERE order_id = ANY\(\$1::int\[\]\)`).
 			WithArgs(pq.Array(orderIDs)).
 			WillReturnRows(rows)
 
@@ -739,16 +1154,17 @@ func TestRepository_GetByReferenceID(t *testing.T) {
 	refID := "ref-1"
 
 	t.Run("Success", func(t *testing.T) {
-		rows := sqlmock.NewRows([]string{"id", "total_amount", "status"}).
-			AddRow(1, 10000, "PENDING")
+		rows := sqlmock.NewRows([]string{"id", "total_amount", "wallet_amount", "status", "external_id", "user_id", "currency"}).
+			AddRow(1, 10000, 0, "PENDING", refID, 7, "IDR")
 
-		mock.ExpectQuery(`SELECT id, total_amount, status FROM orders WHERE external_id = \$1`).
+		mock.ExpectQuery(`SELECT id, total_amount, wallet_amount, status, external_id, user_id, currency FROM orders WHERE external_id = \$1`).
 			WithArgs(refID).
 			WillReturnRows(rows)
 
 		o, err := repo.GetByReferenceID(ctx, refID)
 		assert.NoError(t, err)
 		assert.Equal(t, int32(1), o.ID)
+		assert.Equal(t, int32(7), *o.UserID)
 	})
 }
 
@@ -785,11 +1201,583 @@ func TestRepository_MarkSessionExpired(t *testing.T) {
 	sessID := uuid.New()
 
 	t.Run("Success", func(t *testing.T) {
-		mock.ExpectExec(`UPDATE checkout_sessions SET status = 'EXPIRED'`).
+		mock.ExpectBegin()
+		mock.ExpectExec(`SELECT 1 FROM checkout_sessions WHERE id = \$1 FOR UPDATE`).
+			WithArgs(sessID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE checkout_sessions SET status = 'EXPIRED' WHERE id = \$1 AND status = 'PENDING' AND NOT EXISTS \( SELECT 1 FROM orders o WHERE o.checkout_session_id = checkout_sessions.id \)`).
 			WithArgs(sessID).
 			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
 
 		err := repo.MarkSessionExpired(ctx, sessID)
 		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_CreateOrderTx_WalletSplit(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+
+	userID := int32(1)
+	session := &CheckoutSession{
+		ID: uuid.New(),
+		Items: []CheckoutSessionItem{
+			{VariantID: "var-1", Quantity: 1, Price: 10000, Subtotal: 10000},
+		},
+	}
+
+	newOrder := func() *Order {
+		return &Order{
+			UserID:       &userID,
+			Status:       OrderStatusPendingPayment,
+			TotalAmount:  16000,
+			WalletAmount: 6000,
+			Currency:     "IDR",
+			ExternalID:   "ord-ext-1",
+		}
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		order := newOrder()
+
+		mock.ExpectBegin()
+		expectSessionLock(mock, CheckoutSessionStatusPending)
+		mock.ExpectQuery(`INSERT INTO orders`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(100))
+		mock.ExpectQuery(`UPDATE variant_stocks`).WillReturnRows(sqlmock.NewRows([]string{"warehouse_id"}).AddRow("wh-1"))
+		mock.ExpectExec(`INSERT INTO order_items`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`UPDATE order_items oi SET commission_rate_id`).WillReturnResult(sqlmock.NewResult(0, 1))
+
+		mock.ExpectQuery(`UPDATE wallets SET balance = balance - \$1`).
+			WithArgs(order.WalletAmount, userID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "balance"}).AddRow(7, 4000))
+		mock.ExpectExec(`INSERT INTO wallet_ledger`).
+			WithArgs(int64(7), "DEBIT", order.WalletAmount, int64(4000), "ORDER_PAYMENT", order.ExternalID).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`INSERT INTO payments`).
+			WithArgs(int32(100), "wallet-ord-ext-1", order.WalletAmount, "PAID", payment.MethodWallet, "WALLET", "IDR").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		err := repo.CreateOrderTx(ctx, order, session)
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("InsufficientWallet", func(t *testing.T) {
+		mock.ExpectBegin()
+		expectSessionLock(mock, CheckoutSessionStatusPending)
+		mock.ExpectQuery(`INSERT INTO orders`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(100))
+		mock.ExpectQuery(`UPDATE variant_stocks`).WillReturnRows(sqlmock.NewRows([]string{"warehouse_id"}).AddRow("wh-1"))
+		mock.ExpectExec(`INSERT INTO order_items`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`UPDATE order_items oi SET commission_rate_id`).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(`UPDATE wallets`).WillReturnError(sql.ErrNoRows)
+		mock.ExpectRollback()
+
+		err := repo.CreateOrderTx(ctx, newOrder(), session)
+		assert.ErrorIs(t, err, ErrInsufficientWallet)
+	})
+}
+
+func TestRepository_CreateOrderTx_Voucher(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+
+	userID := int32(1)
+	voucherID := int64(9)
+	limit := int32(1)
+	session := &CheckoutSession{
+		ID:        uuid.New(),
+		Discount:  2000,
+		VoucherID: &voucherID,
+		Items: []CheckoutSessionItem{
+			{VariantID: "var-1", Quantity: 1, Price: 10000, Subtotal: 10000},
+		},
+	}
+
+	newOrder := func() *Order {
+		return &Order{
+			UserID:      &userID,
+			Status:      OrderStatusPendingPayment,
+			TotalAmount: 8000,
+			Currency:    "IDR",
+			ExternalID:  "ord-ext-1",
+		}
+	}
+
+	expectOrderInsert := func() {
+		mock.ExpectBegin()
+		expectSessionLock(mock, CheckoutSessionStatusPending)
+		mock.ExpectQuery(`INSERT INTO orders`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(100))
+		mock.ExpectQuery(`UPDATE variant_stocks`).WillReturnRows(sqlmock.NewRows([]string{"warehouse_id"}).AddRow("wh-1"))
+		mock.ExpectExec(`INSERT INTO order_items`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`UPDATE order_items oi SET commission_rate_id`).WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		expectOrderInsert()
+		mock.ExpectQuery(`SELECT campaign_id, usage_limit FROM vouchers .* FOR UPDATE`).
+			WithArgs(voucherID).
+			WillReturnRows(sqlmock.NewRows([]string{"campaign_id", "usage_limit"}).AddRow(3, limit))
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM voucher_redemptions`).
+			WithArgs(voucherID).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectExec(`INSERT INTO voucher_redemptions`).
+			WithArgs(voucherID, int64(3), int32(100), &userID, 2000).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		err := repo.CreateOrderTx(ctx, newOrder(), session)
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Exhausted", func(t *testing.T) {
+		expectOrderInsert()
+		mock.ExpectQuery(`SELECT campaign_id, usage_limit FROM vouchers`).
+			WillReturnRows(sqlmock.NewRows([]string{"campaign_id", "usage_limit"}).AddRow(3, limit))
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM voucher_redemptions`).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
+		mock.ExpectRollback()
+
+		err := repo.CreateOrderTx(ctx, newOrder(), session)
+		assert.ErrorIs(t, err, ErrVoucherExhausted)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_CreateOrderTx_LoyaltyPoints(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+
+	userID := int32(1)
+	session := &CheckoutSession{
+		ID:             uuid.New(),
+		PointsRedeemed: 500,
+		Items: []CheckoutSessionItem{
+			{VariantID: "var-1", Quantity: 1, Price: 10000, Subtotal: 10000},
+		},
+	}
+
+	newOrder := func() *Order {
+		return &Order{
+			UserID:      &userID,
+			Status:      OrderStatusPendingPayment,
+			TotalAmount: 9500,
+			Currency:    "IDR",
+			ExternalID:  "ord-ext-1",
+		}
+	}
+
+	expectOrderInsert := func() {
+		mock.ExpectBegin()
+		expectSessionLock(mock, CheckoutSessionStatusPending)
+		mock.ExpectQuery(`INSERT INTO orders`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(100))
+		mock.ExpectQuery(`UPDATE variant_stocks`).WillReturnRows(sqlmock.NewRows([]string{"warehouse_id"}).AddRow("wh-1"))
+		mock.ExpectExec(`INSERT INTO order_items`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`UPDATE order_items oi SET commission_rate_id`).WillReturnResult(sqlmock.NewResult(0, 1))
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		expectOrderInsert()
+		mock.ExpectQuery(`UPDATE loyalty_accounts SET balance = balance - \$1`).
+			WithArgs(500, userID).
+			WillReturnRows(sqlmock.NewRows([]string{"balance"}).AddRow(1500))
+		mock.ExpectExec(`UPDATE loyalty_ledger l SET remaining`).
+			WithArgs(500, userID).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(`INSERT INTO loyalty_ledger`).
+			WithArgs(userID, "BURN", 500, int64(1500), "ORDER", "ord-ext-1").
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		err := repo.CreateOrderTx(ctx, newOrder(), session)
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("InsufficientPoints", func(t *testing.T) {
+		expectOrderInsert()
+		mock.ExpectQuery(`UPDATE loyalty_accounts`).WillReturnError(sql.ErrNoRows)
+		mock.ExpectRollback()
+
+		err := repo.CreateOrderTx(ctx, newOrder(), session)
+		assert.ErrorIs(t, err, ErrInsufficientPoints)
+		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 }
+
+func TestRepository_SupersedeOpenSessions(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := NewRepository(db)
+
+	keep := uuid.New()
+	mock.ExpectExec(`UPDATE checkout_sessions`).
+		WithArgs(uint(1), keep, CheckoutSessionStatusSuperseded).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	n, err := repo.SupersedeOpenSessions(context.Background(), 1, keep)
+
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), n)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_GetActiveSessionExternalID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := NewRepository(db)
+
+	mock.ExpectQuery(`SELECT external_id`).
+		WithArgs(uint(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"external_id"}))
+
+	externalID, err := repo.GetActiveSessionExternalID(context.Background(), 1)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "", externalID)
+}
+
+func TestRepository_UpdateSessionItems(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := NewRepository(db)
+
+	itemID := uuid.New()
+	session := &CheckoutSession{
+		ID:    uuid.New(),
+		Items: []CheckoutSessionItem{{ID: itemID, Quantity: 3, Price: 1000, Subtotal: 3000}},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`DELETE FROM checkout_session_items`).
+		WithArgs(session.ID, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE checkout_session_items`).
+		WithArgs(3, 1000, 3000, itemID, session.ID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE checkout_sessions`).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err = repo.UpdateSessionItems(context.Background(), session)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_OriginLocations(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	mock.ExpectQuery(`SELECT id, latitude, longitude FROM seller_origins WHERE id::text = ANY\(\$1::text\[\]\) AND latitude IS NOT NULL`).
+		WithArgs(pq.Array([]string{"o1", "o2"})).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "latitude", "longitude"}).AddRow("o1", -6.24, 106.99))
+
+	got, err := repo.OriginLocations(context.Background(), []string{"o1", "o2"})
+
+	require.NoError(t, err)
+	assert.Equal(t, map[string]geo.Point{"o1": {Lat: -6.24, Lng: 106.99}}, got)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_CompletePickup(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`UPDATE orders SET status = \$1, updated_at = NOW\(\) WHERE id = \$2 AND status = \$3`).
+			WithArgs(OrderStatusCompleted, uint(100), OrderStatusReadyForPickup).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE order_pickups SET picked_up_at = NOW\(\), handed_over_by = \$1 WHERE order_id = \$2`).
+			WithArgs(uint(9), uint(100)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		assert.NoError(t, repo.CompletePickup(ctx, 100, 9))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("NoLongerReady", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectExec(`UPDATE orders`).
+			WithArgs(OrderStatusCompleted, uint(100), OrderStatusReadyForPickup).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		assert.ErrorIs(t, repo.CompletePickup(ctx, 100, 9), ErrNotReadyForPickup)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_GetOrderPickup(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := NewRepository(db)
+
+	slotStart := time.Date(2026, 3, 3, 3, 0, 0, 0, time.UTC)
+	mock.ExpectQuery(`SELECT .* FROM order_pickups p JOIN pickup_locations l ON l.id = p.location_id WHERE p.order_id = \$1`).
+		WithArgs(uint(100)).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"slot_start", "slot_end", "code", "picked_up_at",
+			"id", "name", "address", "city", "opens_at", "closes_at", "slot_minutes", "is_active", "updated_at",
+		}).AddRow(
+			slotStart, slotStart.Add(time.Hour), "042917", nil,
+			4, "Counter Kemang", "Jl. Kemang Raya 1", "Jakarta", "09:00", "17:00", 60, true, time.Now(),
+		))
+
+	got, err := repo.GetOrderPickup(context.Background(), 100)
+
+	require.NoError(t, err)
+	assert.Equal(t, "042917", got.Code)
+	assert.Equal(t, int32(4), got.Location.ID)
+	assert.Equal(t, "09:00", got.Location.OpensAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_CreateOrderTx_DeliverySlot(t *testing.T) {
+	ctx := context.Background()
+	userID := int32(1)
+	addrID := uuid.New()
+	session := &CheckoutSession{
+		ID:        uuid.New(),
+		UserID:    &userID,
+		AddressID: &addrID,
+		Items:     []CheckoutSessionItem{{VariantID: "var-1", Quantity: 1, Price: 10000, Subtotal: 10000}},
+	}
+	windowStart := time.Date(2026, 3, 3, 3, 0, 0, 0, time.UTC)
+	newOrder := func() *Order {
+		return &Order{
+			UserID:         &userID,
+			Status:         OrderStatusPendingPayment,
+			ShippingMethod: ShippingMethodInstant,
+			Delivery: &DeliveryBooking{
+				SlotID:      7,
+				Date:        time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC),
+				WindowStart: windowStart,
+				WindowEnd:   windowStart.Add(2 * time.Hour),
+			},
+		}
+	}
+
+	t.Run("Books", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectBegin()
+		expectSessionLock(mock, CheckoutSessionStatusPending)
+		mock.ExpectQuery(`INSERT INTO orders`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(100))
+		mock.ExpectQuery(`SELECT capacity FROM delivery_slots WHERE id = \$1 AND is_active FOR UPDATE`).
+			WithArgs(int32(7)).
+			WillReturnRows(sqlmock.NewRows([]string{"capacity"}).AddRow(3))
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM delivery_slot_bookings b`).
+			WithArgs(int32(7), newOrder().Delivery.Date).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+		mock.ExpectExec(`INSERT INTO delivery_slot_bookings`).
+			WithArgs(int32(100), int32(7), newOrder().Delivery.Date, windowStart, windowStart.Add(2*time.Hour)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(`UPDATE variant_stocks`).WillReturnRows(sqlmock.NewRows([]string{"warehouse_id"}).AddRow("wh-1"))
+		mock.ExpectExec(`INSERT INTO order_items`).WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`UPDATE order_items oi SET commission_rate_id = cr.id`).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		require.NoError(t, repo.CreateOrderTx(ctx, newOrder(), session))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("Full", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectBegin()
+		expectSessionLock(mock, CheckoutSessionStatusPending)
+		mock.ExpectQuery(`INSERT INTO orders`).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(100))
+		mock.ExpectQuery(`SELECT capacity FROM delivery_slots`).
+			WillReturnRows(sqlmock.NewRows([]string{"capacity"}).AddRow(3))
+		mock.ExpectQuery(`SELECT COUNT\(\*\) FROM delivery_slot_bookings b`).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+		mock.ExpectRollback()
+
+		err = repo.CreateOrderTx(ctx, newOrder(), session)
+
+		assert.ErrorIs(t, err, ErrDeliverySlotFull)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_SaveDeliverySlot_Duplicate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := NewRepository(db)
+
+	mock.ExpectQuery(`INSERT INTO delivery_slots`).
+		WithArgs("DKI Jakarta", "10:00", "12:00", 5, true).
+		WillReturnError(&pq.Error{Code: "23505"})
+
+	_, err = repo.SaveDeliverySlot(context.Background(), &DeliverySlot{
+		Region: "DKI Jakarta", StartsAt: "10:00", EndsAt: "12:00", Capacity: 5, IsActive: true,
+	})
+
+	assert.ErrorIs(t, err, ErrInvalidDeliverySlot)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_PublishPolicy(t *testing.T) {
+	adminID := int32(9)
+	policy := func() *Policy {
+		return &Policy{Kind: PolicyKindTerms, Title: "Terms", Body: "...", PublishedBy: &adminID}
+	}
+
+	t.Run("NextVersion", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectQuery(`INSERT INTO policies`).
+			WithArgs(PolicyKindTerms, "Terms", "...", &adminID).
+			WillReturnRows(sqlmock.NewRows([]string{
+				"id", "kind", "version", "title", "body", "published_by", "published_at",
+			}).AddRow(7, "TERMS", 3, "Terms", "...", 9, time.Now()))
+
+		saved, err := repo.PublishPolicy(context.Background(), policy())
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(7), saved.ID)
+		assert.Equal(t, 3, saved.Version)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ConcurrentPublish", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectQuery(`INSERT INTO policies`).
+			WillReturnError(&pq.Error{Code: "23505"})
+
+		_, err = repo.PublishPolicy(context.Background(), policy())
+
+		assert.ErrorIs(t, err, ErrPolicyVersionConflict)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_CancelOrder(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := NewRepository(db)
+	note := "out of stock at the warehouse"
+
+	mock.ExpectExec(`UPDATE orders SET status = \$1, cancel_reason_id = \$2, cancel_note = \$3, updated_at = NOW\(\) WHERE id = \$4`).
+		WithArgs(OrderStatusCancelled, int32(5), &note, uint(100)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.CancelOrder(context.Background(), 100, 5, &note)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ListReasons(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := NewRepository(db)
+	kind := ReasonKindReturn
+
+	mock.ExpectQuery(`SELECT .* FROM order_reasons`).
+		WithArgs(&kind, true).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "code", "kind", "audience", "label", "position", "is_active", "updated_at",
+		}).AddRow(10, "DAMAGED", "RETURN", "CUSTOMER", "Item arrived damaged", 10, true, time.Now()))
+
+	reasons, err := repo.ListReasons(context.Background(), &kind, true)
+
+	assert.NoError(t, err)
+	assert.Len(t, reasons, 1)
+	assert.Equal(t, ReasonAudienceCustomer, reasons[0].Audience)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_SaveReason(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := NewRepository(db)
+
+	mock.ExpectQuery(`INSERT INTO order_reasons`).
+		WillReturnError(&pq.Error{Code: "23505"})
+
+	_, err = repo.SaveReason(context.Background(), &Reason{
+		Code: "DAMAGED", Kind: ReasonKindReturn, Audience: ReasonAudienceCustomer, Label: "Damaged",
+	})
+
+	assert.ErrorIs(t, err, ErrReasonCodeTaken)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ReasonStats(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := NewRepository(db)
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	mock.ExpectQuery(`FROM order_reasons rs LEFT JOIN orders o ON o.cancel_reason_id = rs.id`).
+		WithArgs(from, to, ReasonKindCancellation).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "code", "kind", "audience", "label", "position", "is_active", "updated_at", "count", "sum",
+		}).
+			AddRow(8, "PAYMENT_EXPIRED", "CANCELLATION", "INTERNAL", "Payment not completed in time", 900, true, time.Now(), 12, 1500000).
+			AddRow(1, "CHANGED_MIND", "CANCELLATION", "CUSTOMER", "Changed my mind", 10, true, time.Now(), 0, 0))
+
+	stats, err := repo.ReasonStats(context.Background(), ReasonKindCancellation, from, to)
+
+	assert.NoError(t, err)
+	require.Len(t, stats, 2)
+	assert.Equal(t, "PAYMENT_EXPIRED", stats[0].Reason.Code)
+	assert.Equal(t, 12, stats[0].Orders)
+	assert.Equal(t, int64(1500000), stats[0].Amount)
+	assert.Zero(t, stats[1].Orders)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
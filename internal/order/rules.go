@@ -0,0 +1,45 @@
+package order
+
+import (
+	"strings"
+	"time"
+)
+
+// CheckoutRule holds the order minimum and free-shipping threshold for a
+// region. A rule without a region is the default for every other region.
+type CheckoutRule struct {
+	ID     int32
+	Region *string
+	// Smallest subtotal that can be confirmed; 0 means no minimum.
+	MinOrderAmount int
+	// Subtotal from which shipping is free; nil means never.
+	FreeShippingMin *int
+	IsActive        bool
+	UpdatedAt       time.Time
+}
+
+// FreeShipping reports whether subtotal qualifies for free shipping.
+func (r *CheckoutRule) FreeShipping(subtotal int) bool {
+	return r != nil && r.FreeShippingMin != nil && subtotal >= *r.FreeShippingMin
+}
+
+// BelowMinimum reports whether subtotal is under the order minimum.
+func (r *CheckoutRule) BelowMinimum(subtotal int) bool {
+	return r != nil && subtotal < r.MinOrderAmount
+}
+
+// ruleFor picks the rule for province from rules, falling back to the
+// default rule. nil when neither exists.
+func ruleFor(rules []*CheckoutRule, province string) *CheckoutRule {
+	var def *CheckoutRule
+	for _, r := range rules {
+		if r.Region == nil {
+			def = r
+			continue
+		}
+		if strings.EqualFold(strings.TrimSpace(*r.Region), strings.TrimSpace(province)) {
+			return r
+		}
+	}
+	return def
+}
@@ -5,11 +5,18 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 	"warimas-be/internal/address"
+	"warimas-be/internal/analytics"
 	"warimas-be/internal/graph/model"
 	"warimas-be/internal/logger"
+	"warimas-be/internal/loyalty"
+	"warimas-be/internal/money"
 	"warimas-be/internal/payment"
+	"warimas-be/internal/preference"
 	"warimas-be/internal/user"
 	"warimas-be/internal/utils"
 
@@ -29,12 +36,26 @@ type Service interface {
 		sort *OrderSortInput,
 		limit int32,
 		page int32,
-	) ([]*Order, int64, map[uuid.UUID][]address.Address, error)
+	) ([]*Order, int64, map[uuid.UUID]*address.Address, error)
 	GetOrderDetail(ctx context.Context, orderID uint) (*Order, *address.Address, error)
 	GetOrderDetailByExternalID(ctx context.Context, externalId string) (*Order, *address.Address, error)
+	// UpdateOrderStatus moves the order to status. Cancelling goes
+	// through CancelOrder, which needs a reason.
 	UpdateOrderStatus(ctx context.Context, orderID uint, status OrderStatus) error
-	MarkAsPaid(ctx context.Context, referenceID, paymentRequestID, paymentProviderID string) error
+	// CancelOrder cancels the order for an active CANCELLATION reason,
+	// with an optional note.
+	CancelOrder(ctx context.Context, orderID uint, reasonID int32, note *string) error
+	// MarkAsPaid settles the order with referenceID once its payments cover
+	// it, writing capture onto the payment row. Paying a PAID order again
+	// does nothing.
+	MarkAsPaid(ctx context.Context, referenceID string, capture payment.Capture) error
 	MarkAsFailed(ctx context.Context, referenceID, paymentRequestID, paymentProviderID string) error
+	// ExpireUnpaidOrders cancels orders whose payment request expired
+	// unpaid and returns how many it cancelled.
+	ExpireUnpaidOrders(ctx context.Context) (int, error)
+	// ExpireSessions closes checkout sessions past their expiry and
+	// returns how many it closed.
+	ExpireSessions(ctx context.Context) (int, error)
 	CreateSession(
 		ctx context.Context,
 		input model.CreateCheckoutSessionInput,
@@ -44,22 +65,85 @@ type Service interface {
 		ctx context.Context,
 		externalID string,
 		addressID string,
-		guestID *string,
 	) error
 	UpdateSessionPaymentMethod(
 		ctx context.Context,
 		externalID string,
 		paymentMethod payment.ChannelCode,
-		guestID *string,
 	) error
+	// ShippingOptions lists how the session can ship to its address with
+	// what each costs. Instant delivery is listed with the reason when it
+	// cannot reach the address.
+	ShippingOptions(
+		ctx context.Context,
+		externalID string,
+	) ([]*ShippingOption, error)
+	// UpdateSessionShippingMethod switches the session's shipping method
+	// and reprices it; instant delivery fails unless it reaches the
+	// address. Self pickup needs pickup, a slot that can still be booked.
+	// Instant delivery takes deliverySlotID, one of today's DeliverySlots.
+	UpdateSessionShippingMethod(
+		ctx context.Context,
+		externalID string,
+		method ShippingMethod,
+		pickup *PickupChoice,
+		deliverySlotID *int32,
+	) (*CheckoutSession, error)
+	// UpdateSessionInsurance turns shipping insurance on or off and
+	// reprices the session. Self pickup cannot be insured.
+	UpdateSessionInsurance(
+		ctx context.Context,
+		externalID string,
+		insured bool,
+	) (*CheckoutSession, error)
+	ApplySessionWallet(
+		ctx context.Context,
+		externalID string,
+		amount int,
+	) error
+	ApplySessionCoupon(
+		ctx context.Context,
+		externalID string,
+		code string,
+	) (int, error)
+	ApplySessionPoints(
+		ctx context.Context,
+		externalID string,
+		points int,
+	) (int, error)
+	// UpdateSessionItemQuantity and RemoveSessionItem edit the items of a
+	// pending session and reprice it at current variant prices.
+	UpdateSessionItemQuantity(
+		ctx context.Context,
+		externalID string,
+		itemID string,
+		quantity int,
+	) (*CheckoutSession, error)
+	RemoveSessionItem(
+		ctx context.Context,
+		externalID string,
+		itemID string,
+	) (*CheckoutSession, error)
+	// ConfirmSession places the order. acceptedPolicyIDs must name the
+	// current version of every policy.
 	ConfirmSession(
 		ctx context.Context,
 		sessionID string,
+		acceptedPolicyIDs []int32,
 	) (*string, error)
 	GetSession(
 		ctx context.Context,
 		externalID string,
 	) (*CheckoutSession, error)
+	// GetActiveSession returns the caller's latest open checkout session,
+	// so checkout can resume on any device. nil when there is none.
+	GetActiveSession(ctx context.Context) (*CheckoutSession, error)
+	// ListSessionEvents returns the change log of a checkout session for
+	// support. Admin only.
+	ListSessionEvents(
+		ctx context.Context,
+		externalID string,
+	) ([]*SessionEvent, error)
 	GetPaymentOrderInfo(
 		ctx context.Context,
 		externalID string,
@@ -68,27 +152,141 @@ type Service interface {
 		ctx context.Context,
 		externalID string,
 	) (*Order, error)
+	// CreateAdminOrder places an order on behalf of a customer (e.g. a
+	// phone order). Offline orders are recorded as paid; otherwise the
+	// returned payment carries the link to send to the customer.
+	CreateAdminOrder(
+		ctx context.Context,
+		input model.CreateAdminOrderInput,
+	) (*Order, *payment.PaymentResponse, error)
+
+	// CheckoutRules lists the active order minimum and free-shipping
+	// rules; all is admin only and includes inactive ones.
+	CheckoutRules(ctx context.Context, all bool) ([]*CheckoutRule, error)
+	SetCheckoutRule(
+		ctx context.Context,
+		input model.SetCheckoutRuleInput,
+	) (*CheckoutRule, error)
+
+	// PickupLocations lists the active pickup locations; all is admin
+	// only and includes inactive ones.
+	PickupLocations(ctx context.Context, all bool) ([]*PickupLocation, error)
+	SetPickupLocation(
+		ctx context.Context,
+		input model.SetPickupLocationInput,
+	) (*PickupLocation, error)
+	// PickupSlots lists the slots of an active pickup location that can
+	// still be booked.
+	PickupSlots(ctx context.Context, locationID int32) ([]PickupSlot, error)
+	// VerifyPickupCode checks the code the customer gave at the counter
+	// and completes their READY_FOR_PICKUP order. Admin only.
+	VerifyPickupCode(ctx context.Context, orderID uint, code string) error
+
+	// DeliverySlots lists today's same-day slots for the region of the
+	// session's address with how many orders each still takes.
+	DeliverySlots(ctx context.Context, externalID string) ([]*DeliverySlotOption, error)
+	// AdminDeliverySlots lists the slots of region, or of every region
+	// when nil, inactive ones included. Admin only.
+	AdminDeliverySlots(ctx context.Context, region *string) ([]*DeliverySlot, error)
+	SetDeliverySlot(
+		ctx context.Context,
+		input model.SetDeliverySlotInput,
+	) (*DeliverySlot, error)
+
+	// CurrentPolicies returns the latest version of each policy, the ones
+	// a checkout has to accept.
+	CurrentPolicies(ctx context.Context) ([]*Policy, error)
+	// PolicyHistory lists every version of kind. Admin only.
+	PolicyHistory(ctx context.Context, kind PolicyKind) ([]*Policy, error)
+	PublishPolicy(
+		ctx context.Context,
+		input model.PublishPolicyInput,
+	) (*Policy, error)
+
+	// OrderReasons lists the active customer reasons of kind, or of every
+	// kind when nil; all is admin only and includes internal and inactive
+	// ones.
+	OrderReasons(ctx context.Context, kind *ReasonKind, all bool) ([]*Reason, error)
+	SetOrderReason(
+		ctx context.Context,
+		input model.SetOrderReasonInput,
+	) (*Reason, error)
+	// OrderReasonStats counts the orders cancelled or refunded per reason
+	// of kind in [from, to). Admin only.
+	OrderReasonStats(ctx context.Context, kind ReasonKind, from, to time.Time) ([]*ReasonStat, error)
+	// RequestOrderAdjustment puts a discount or extra charge on an order
+	// waiting for payment. It is applied once another admin approves it.
+	RequestOrderAdjustment(
+		ctx context.Context,
+		input model.RequestOrderAdjustmentInput,
+	) (*OrderAdjustment, error)
+	// ApproveOrderAdjustment applies an adjustment another admin
+	// requested: the order's total changes and its payment invoice is
+	// replaced by one for the new amount. When the new invoice cannot be
+	// made the adjustment stays pending, so approving it again retries.
+	ApproveOrderAdjustment(ctx context.Context, id int64) (*OrderAdjustment, error)
+	RejectOrderAdjustment(ctx context.Context, id int64) (*OrderAdjustment, error)
+	// OrderAdjustments lists the adjustments of an order. Admin only.
+	OrderAdjustments(ctx context.Context, orderID uint) ([]*OrderAdjustment, error)
 }
 
 type UserGateway interface {
 	GetProfile(ctx context.Context, userID uint) (*user.Profile, error)
+	// GetRestriction is the admin restriction in force on the user's
+	// account, nil when there is none.
+	GetRestriction(ctx context.Context, userID uint) (*user.Restriction, error)
+}
+
+// BalanceGateway reads a user's spendable balance from the module that
+// owns it: the wallet for wallet funds, loyalty for points.
+type BalanceGateway interface {
+	Balance(ctx context.Context, userID uint) (int64, error)
+}
+
+// PreferenceGateway says how a customer wants to be told about an event.
+type PreferenceGateway interface {
+	DeliveryFor(ctx context.Context, userID int32, event preference.Event) *preference.Delivery
 }
 
 type service struct {
 	repo        Repository
 	paymentRepo payment.Repository
 	paymentGate payment.Gateway
-	addressRepo address.Repository
+	addresses   address.Service
 	userRepo    UserGateway
+	wallets     BalanceGateway
+	points      BalanceGateway
+	prefs       PreferenceGateway
+
+	webhookOrders *orderCache
+	notifier      Notifier
+
+	now func() time.Time
+	loc *time.Location
 }
 
-func NewService(repo Repository, payRepo payment.Repository, payGate payment.Gateway, addressRepo address.Repository, userRepo UserGateway) Service {
+func NewService(repo Repository, payRepo payment.Repository, payGate payment.Gateway, addresses address.Service, userRepo UserGateway, wallets BalanceGateway, points BalanceGateway, prefs PreferenceGateway) Service {
+	// Order date presets follow the customer's calendar.
+	loc, err := time.LoadLocation("Asia/Jakarta")
+	if err != nil {
+		logger.L().Error("failed to load Jakarta location, defaulting to UTC", zap.Error(err))
+		loc = time.UTC
+	}
 	return &service{
 		repo:        repo,
 		paymentRepo: payRepo,
 		paymentGate: payGate,
-		addressRepo: addressRepo,
+		addresses:   addresses,
 		userRepo:    userRepo,
+		wallets:     wallets,
+		points:      points,
+		prefs:       prefs,
+
+		webhookOrders: newOrderCache(webhookOrderTTL),
+		notifier:      LogNotifier{},
+
+		now: time.Now,
+		loc: loc,
 	}
 }
 
@@ -143,10 +341,8 @@ func (s *service) CreateFromSession(
 	return order, nil
 }
 
-// ✅ Create new order from cart
-func (s *service) OrderToPaymentProcess(ctx context.Context, session *CheckoutSession, externalID string, orderId uint) (*payment.PaymentResponse, error) {
-	userEmail := utils.GetUserEmailFromContext(ctx)
-
+// invoiceItems lists the session's items for the payment invoice.
+func invoiceItems(session *CheckoutSession) []payment.XenditItem {
 	var items []payment.XenditItem
 	for _, s := range session.Items {
 		items = append(items, payment.XenditItem{
@@ -155,6 +351,23 @@ func (s *service) OrderToPaymentProcess(ctx context.Context, session *CheckoutSe
 			Price:    int64(s.Price),
 		})
 	}
+	return items
+}
+
+// invoiceChannel is the session's payment method, GoPay when none was
+// chosen.
+func invoiceChannel(session *CheckoutSession) payment.ChannelCode {
+	if session.PaymentMethod != nil {
+		return payment.ChannelCode(*session.PaymentMethod)
+	}
+	return payment.MethodGOPAY
+}
+
+// invoiceBuyer is who the payment invoice is addressed to: the session's
+// customer, falling back to the shipping address's receiver.
+func (s *service) invoiceBuyer(ctx context.Context, session *CheckoutSession) payment.BuyerInfo {
+	userEmail := utils.GetUserEmailFromContext(ctx)
+	callerID, _ := utils.GetUserIDFromContext(ctx)
 
 	var userName string
 	var userPhone string
@@ -167,23 +380,25 @@ func (s *service) OrderToPaymentProcess(ctx context.Context, session *CheckoutSe
 			if userProfile.Phone != nil {
 				userPhone = *userProfile.Phone
 			}
+			// An admin placing the order is not the buyer
+			if *session.UserID != int32(callerID) && userProfile.Email != nil {
+				userEmail = *userProfile.Email
+			}
 		} else {
 			logger.FromCtx(ctx).Warn("failed to fetch user profile for invoice", zap.Error(err))
 		}
 	}
 
-	// Fallback to address receiver name if profile name is missing
-	if userName == "" && session.AddressID != nil {
-		addr, err := s.addressRepo.GetByID(ctx, *session.AddressID)
-		if err == nil && addr != nil {
-			userName = addr.ReceiverName
-		}
-	}
-
-	if userPhone == "" && session.AddressID != nil {
-		addr, err := s.addressRepo.GetByID(ctx, *session.AddressID)
+	// Fallback to the address receiver if the profile is incomplete
+	if (userName == "" || userPhone == "") && session.AddressID != nil {
+		addr, err := s.addresses.GetByID(ctx, *session.AddressID)
 		if err == nil && addr != nil {
-			userPhone = addr.Phone
+			if userName == "" {
+				userName = addr.ReceiverName
+			}
+			if userPhone == "" {
+				userPhone = addr.Phone
+			}
 		}
 	}
 
@@ -191,24 +406,40 @@ func (s *service) OrderToPaymentProcess(ctx context.Context, session *CheckoutSe
 		userName = "Guest"
 	}
 
-	paymentMethod := payment.ChannelCode(payment.MethodGOPAY)
-	if session.PaymentMethod != nil {
-		paymentMethod = payment.ChannelCode(*session.PaymentMethod)
-
-	}
-
-	buyer := &payment.BuyerInfo{
+	return payment.BuyerInfo{
 		Name:  userName,
 		Email: &userEmail,
 		Phone: userPhone,
 	}
+}
+
+// ✅ Create new order from cart
+func (s *service) OrderToPaymentProcess(ctx context.Context, session *CheckoutSession, externalID string, orderId uint) (*payment.PaymentResponse, error) {
+	items := invoiceItems(session)
+	buyer := s.invoiceBuyer(ctx, session)
+
+	// Fully covered by the wallet: nothing left for the gateway.
+	if session.GatewayAmount() <= 0 {
+		walletRef := payment.WalletReference(externalID)
+		capture := payment.Capture{ExternalReference: walletRef, ProviderPaymentID: walletRef}
+		if err := s.MarkAsPaid(ctx, externalID, capture); err != nil {
+			return nil, fmt.Errorf("failed to settle wallet payment: %w", err)
+		}
+
+		return &payment.PaymentResponse{
+			ReferenceID:   externalID,
+			Amount:        int64(session.WalletAmount),
+			Status:        string(PaymentStatusPaid),
+			PaymentMethod: payment.MethodWallet,
+		}, nil
+	}
 
 	payResp, err := s.paymentGate.CreateInvoice(ctx,
 		externalID,
-		*buyer,
-		int64(session.TotalPrice),
+		buyer,
+		int64(session.GatewayAmount()),
 		items,
-		paymentMethod)
+		invoiceChannel(session))
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to create payment invoice: %w", err)
@@ -235,13 +466,28 @@ func (s *service) OrderToPaymentProcess(ctx context.Context, session *CheckoutSe
 }
 
 // ✅ Get list of orders (user or admin)
+// datePresetStart returns the start of a preset's range, at local midnight.
+// The range runs to now, so no end date is needed.
+func (s *service) datePresetStart(preset OrderDatePreset) time.Time {
+	now := s.now().In(s.loc)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, s.loc)
+	switch preset {
+	case OrderDatePresetLast90Days:
+		return today.AddDate(0, 0, -89)
+	case OrderDatePresetThisYear:
+		return time.Date(now.Year(), time.January, 1, 0, 0, 0, 0, s.loc)
+	default:
+		return today.AddDate(0, 0, -29)
+	}
+}
+
 func (s *service) GetOrders(
 	ctx context.Context,
 	filter *OrderFilterInput,
 	sort *OrderSortInput,
 	limit int32,
 	page int32,
-) ([]*Order, int64, map[uuid.UUID][]address.Address, error) {
+) ([]*Order, int64, map[uuid.UUID]*address.Address, error) {
 
 	log := logger.FromCtx(ctx).With(
 		zap.String("layer", "service"),
@@ -264,6 +510,16 @@ func (s *service) GetOrders(
 
 	offset := (p - 1) * l
 
+	if filter != nil && filter.DatePreset != nil {
+		if filter.DateFrom != nil || filter.DateTo != nil {
+			return nil, 0, nil, ErrDatePresetConflict
+		}
+		resolved := *filter
+		from := s.datePresetStart(*filter.DatePreset)
+		resolved.DateFrom = &from
+		filter = &resolved
+	}
+
 	log.Info("fetching orders",
 		zap.Int32("limit", l),
 		zap.Int32("page", p),
@@ -284,11 +540,31 @@ func (s *service) GetOrders(
 		return nil, 0, nil, err
 	}
 
+	addressMap, err := s.hydrateOrders(ctx, orders)
+	if err != nil {
+		log.Error("failed to hydrate orders", zap.Error(err))
+		return nil, 0, nil, err
+	}
+
+	log.Info("orders fetched",
+		zap.Int("orders_count", len(orders)),
+		zap.Int64("total", total),
+	)
+
+	return orders, total, addressMap, nil
+}
+
+// hydrateOrders attaches items to orders and resolves their addresses with
+// one batched query each, so listing a page never costs a query per order.
+func (s *service) hydrateOrders(
+	ctx context.Context,
+	orders []*Order,
+) (map[uuid.UUID]*address.Address, error) {
+	addressMap := make(map[uuid.UUID]*address.Address)
 	if len(orders) == 0 {
-		return orders, total, map[uuid.UUID][]address.Address{}, nil
+		return addressMap, nil
 	}
 
-	// Collect order IDs & address IDs
 	orderIDs := make([]int32, 0, len(orders))
 	addressIDs := make([]uuid.UUID, 0, len(orders))
 
@@ -297,37 +573,20 @@ func (s *service) GetOrders(
 		addressIDs = append(addressIDs, o.AddressID)
 	}
 
-	// Fetch addresses in batch (IMPORTANT)
-	addresses, err := s.addressRepo.GetByIDs(ctx, addressIDs)
+	addressMap, err := s.addresses.GetByIDs(ctx, addressIDs)
 	if err != nil {
-		log.Error("failed to fetch addresses", zap.Error(err))
-		return nil, 0, nil, err
-	}
-
-	// Map addressID -> []address.Address
-	addressMap := make(map[uuid.UUID][]address.Address, len(addresses))
-	for _, addr := range addresses {
-		addressMap[addr.ID] = append(addressMap[addr.ID], addr)
+		return nil, err
 	}
 
-	// Fetch order items
 	itemsMap, err := s.repo.FetchOrderItems(ctx, orderIDs)
 	if err != nil {
-		log.Error("failed to fetch order items", zap.Error(err))
-		return nil, 0, nil, err
+		return nil, err
 	}
-
-	// Attach items
 	for _, o := range orders {
 		o.Items = itemsMap[o.ID]
 	}
 
-	log.Info("orders fetched",
-		zap.Int("orders_count", len(orders)),
-		zap.Int64("total", total),
-	)
-
-	return orders, total, addressMap, nil
+	return addressMap, nil
 }
 
 // ✅ Get order detail (user only sees their own order), but admin could see everything
@@ -369,7 +628,7 @@ func (s *service) GetOrderDetail(
 	}
 
 	userRole := utils.GetUserRoleFromContext(ctx)
-	isAdmin := userRole == "ADMIN"
+	isAdmin := utils.IsAdmin(ctx)
 
 	// Authorization check
 	if !isAdmin {
@@ -388,8 +647,25 @@ func (s *service) GetOrderDetail(
 		}
 	}
 
+	if err := s.attachPickup(ctx, order, userID); err != nil {
+		log.Error("failed to fetch order pickup", zap.Error(err))
+		return nil, nil, err
+	}
+	if err := s.attachDelivery(ctx, order); err != nil {
+		log.Error("failed to fetch order delivery slot", zap.Error(err))
+		return nil, nil, err
+	}
+	if order.AcceptedPolicies, err = s.repo.ListOrderPolicies(ctx, uint(order.ID)); err != nil {
+		log.Error("failed to fetch accepted policies", zap.Error(err))
+		return nil, nil, err
+	}
+	if err := s.attachCancelReason(ctx, order, isAdmin); err != nil {
+		log.Error("failed to fetch cancel reason", zap.Error(err))
+		return nil, nil, err
+	}
+
 	// Fetch address
-	addr, err := s.addressRepo.GetByID(ctx, order.AddressID)
+	addr, err := s.addresses.GetByID(ctx, order.AddressID)
 	if err != nil {
 		log.Error("failed to fetch address",
 			zap.String("address_id", order.AddressID.String()),
@@ -438,7 +714,7 @@ func (s *service) GetOrderDetailByExternalID(
 	}
 
 	userRole := utils.GetUserRoleFromContext(ctx)
-	isAdmin := userRole == "ADMIN"
+	isAdmin := utils.IsAdmin(ctx)
 
 	// Authorization check
 	if !isAdmin {
@@ -457,8 +733,25 @@ func (s *service) GetOrderDetailByExternalID(
 		}
 	}
 
+	if err := s.attachPickup(ctx, order, userID); err != nil {
+		log.Error("failed to fetch order pickup", zap.Error(err))
+		return nil, nil, err
+	}
+	if err := s.attachDelivery(ctx, order); err != nil {
+		log.Error("failed to fetch order delivery slot", zap.Error(err))
+		return nil, nil, err
+	}
+	if order.AcceptedPolicies, err = s.repo.ListOrderPolicies(ctx, uint(order.ID)); err != nil {
+		log.Error("failed to fetch accepted policies", zap.Error(err))
+		return nil, nil, err
+	}
+	if err := s.attachCancelReason(ctx, order, isAdmin); err != nil {
+		log.Error("failed to fetch cancel reason", zap.Error(err))
+		return nil, nil, err
+	}
+
 	// Fetch address
-	addr, err := s.addressRepo.GetByID(ctx, order.AddressID)
+	addr, err := s.addresses.GetByID(ctx, order.AddressID)
 	if err != nil {
 		log.Error("failed to fetch address",
 			zap.String("address_id", order.AddressID.String()),
@@ -472,6 +765,57 @@ func (s *service) GetOrderDetailByExternalID(
 	return order, addr, nil
 }
 
+// attachPickup loads the pickup of a self pickup order. The code stays
+// hidden from anyone but the order's customer, so staff have to ask for
+// it at the counter.
+func (s *service) attachPickup(ctx context.Context, order *Order, viewerID uint) error {
+	if order.ShippingMethod != ShippingMethodSelfPickup {
+		return nil
+	}
+	pickup, err := s.repo.GetOrderPickup(ctx, uint(order.ID))
+	if err != nil {
+		return err
+	}
+	if pickup != nil && (order.UserID == nil || int32(viewerID) != *order.UserID) {
+		pickup.Code = ""
+	}
+	order.Pickup = pickup
+	return nil
+}
+
+// attachDelivery loads the same-day slot of an instant order.
+func (s *service) attachDelivery(ctx context.Context, order *Order) error {
+	if order.ShippingMethod != ShippingMethodInstant {
+		return nil
+	}
+	delivery, err := s.repo.GetOrderDelivery(ctx, uint(order.ID))
+	if err != nil {
+		return err
+	}
+	order.Delivery = delivery
+	return nil
+}
+
+// attachCancelReason loads why a stopped order stopped. Customers only
+// see customer reasons; internal ones and the note stay with admins.
+func (s *service) attachCancelReason(ctx context.Context, order *Order, isAdmin bool) error {
+	if order.CancelReasonID == nil {
+		return nil
+	}
+	reason, err := s.repo.GetReason(ctx, *order.CancelReasonID)
+	if err != nil {
+		return err
+	}
+	if !isAdmin {
+		order.CancelNote = nil
+		if reason.Audience != ReasonAudienceCustomer {
+			return nil
+		}
+	}
+	order.CancelReason = reason
+	return nil
+}
+
 // ✅ Update order status (admin only)
 func (s *service) UpdateOrderStatus(ctx context.Context, orderID uint, status OrderStatus) error {
 	log := logger.FromCtx(ctx).With(
@@ -483,6 +827,11 @@ func (s *service) UpdateOrderStatus(ctx context.Context, orderID uint, status Or
 
 	log.Info("update order status started")
 
+	if status == OrderStatusCancelled {
+		log.Warn("cancel without a reason")
+		return ErrCancelReasonRequired
+	}
+
 	// 1. Fetch current order
 	order, err := s.repo.GetOrderDetail(ctx, orderID)
 	if err != nil {
@@ -497,75 +846,97 @@ func (s *service) UpdateOrderStatus(ctx context.Context, orderID uint, status Or
 	current := order.Status
 	log = log.With(zap.String("current_status", string(current)))
 
-	// Rule 4 & Terminal check: Cannot change status if already completed, cancelled or failed
-	if current == OrderStatusCompleted || current == OrderStatusCancelled || current == OrderStatusFailed {
-		log.Warn("cannot update order with terminal status")
-		return fmt.Errorf("cannot update order with terminal status: %s", current)
-	}
-
-	// Rule 6: FAILED is free (can transition TO failed from any non-terminal state)
-	if status == OrderStatusFailed {
-		log.Info("transitioning to FAILED status")
-		if err := s.repo.UpdateOrderStatus(ctx, orderID, status, nil); err != nil {
-			log.Error("failed to update order status to FAILED", zap.Error(err))
-			return err
+	change := orderChange{
+		svc:        s,
+		orderID:    orderID,
+		externalID: order.ExternalID,
+		userID:     order.UserID,
+		method:     order.ShippingMethod,
+	}
+	err = OrderStatuses.Fire(ctx, change, current, status, func(ctx context.Context) error {
+		var invoiceNumber *string
+		if status == OrderStatusAccepted {
+			inv := utils.GenerateInvoiceNumber()
+			invoiceNumber = &inv
+			log.Info("generated invoice number", zap.String("invoice_number", inv))
 		}
-		log.Info("order status updated to FAILED successfully")
-		return nil
+		return s.repo.UpdateOrderStatus(ctx, orderID, status, invoiceNumber)
+	})
+	if err != nil {
+		log.Warn("failed to update order status", zap.Error(err))
+		return err
 	}
 
-	// Allowed transitions map (Rule 1, 5)
-	allowed := map[OrderStatus]map[OrderStatus]bool{
-		OrderStatusPendingPayment: {
-			OrderStatusPaid:      true,
-			OrderStatusCancelled: true,
-		},
-		OrderStatusPaid: {
-			OrderStatusAccepted:  true,
-			OrderStatusCancelled: true,
-		},
-		OrderStatusAccepted: {
-			OrderStatusShipped:   true,
-			OrderStatusCancelled: true,
-		},
-		OrderStatusShipped: {
-			OrderStatusCompleted: true,
-		},
-	}
+	log.Info("order status updated successfully")
+	return nil
+}
+
+func (s *service) CancelOrder(ctx context.Context, orderID uint, reasonID int32, note *string) error {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "CancelOrder"),
+		zap.Uint("order_id", orderID),
+		zap.Int32("reason_id", reasonID),
+	)
 
-	if targets, ok := allowed[current]; !ok || !targets[status] {
-		log.Warn("invalid status transition")
-		return fmt.Errorf("invalid status transition from %s to %s", current, status)
+	if reasonID == 0 {
+		log.Warn("cancel without a reason")
+		return ErrCancelReasonRequired
+	}
+	reason, err := s.repo.GetReason(ctx, reasonID)
+	if err != nil {
+		return err
+	}
+	if !reason.IsActive || reason.Kind != ReasonKindCancellation {
+		log.Warn("reason cannot cancel orders",
+			zap.String("kind", string(reason.Kind)),
+			zap.Bool("is_active", reason.IsActive),
+		)
+		return ErrCancelReasonRequired
+	}
+	if note != nil {
+		trimmed := strings.TrimSpace(*note)
+		note = &trimmed
+		if trimmed == "" {
+			note = nil
+		}
 	}
 
-	var invoiceNumber *string
-	if status == OrderStatusAccepted {
-		inv := utils.GenerateInvoiceNumber()
-		invoiceNumber = &inv
-		log.Info("generated invoice number", zap.String("invoice_number", inv))
+	order, err := s.repo.GetOrderDetail(ctx, orderID)
+	if err != nil {
+		return err
 	}
 
-	if err := s.repo.UpdateOrderStatus(ctx, orderID, status, invoiceNumber); err != nil {
-		log.Error("failed to update order status", zap.Error(err))
+	change := orderChange{
+		svc:        s,
+		orderID:    orderID,
+		externalID: order.ExternalID,
+		userID:     order.UserID,
+		method:     order.ShippingMethod,
+	}
+	err = OrderStatuses.Fire(ctx, change, order.Status, OrderStatusCancelled, func(ctx context.Context) error {
+		return s.repo.CancelOrder(ctx, orderID, reasonID, note)
+	})
+	if err != nil {
+		log.Warn("failed to cancel order", zap.Error(err))
 		return err
 	}
 
-	log.Info("order status updated successfully")
+	log.Info("order cancelled", zap.String("reason", reason.Code))
 	return nil
 }
 
 func (s *service) MarkAsPaid(
 	ctx context.Context,
 	referenceID string,
-	paymentRequestID string,
-	paymentProviderID string,
+	capture payment.Capture,
 ) error {
 
 	log := logger.FromCtx(ctx).With(
 		zap.String("layer", "service"),
 		zap.String("method", "MarkAsPaid"),
 		zap.String("reference_id", referenceID),
-		zap.String("payment_request_id", paymentRequestID),
+		zap.String("payment_request_id", capture.ExternalReference),
 	)
 
 	log.Info("mark as paid started")
@@ -577,33 +948,82 @@ func (s *service) MarkAsPaid(
 	}
 
 	// Idempotency guard
-	if order.Status == "PAID" {
+	if order.Status == OrderStatusPaid {
 		log.Info("order already marked as PAID")
 		return nil
 	}
 
-	// Optional safety check
-	if order.Status == "FAILED" {
-		log.Warn("cannot mark FAILED order as PAID")
-		return fmt.Errorf("invalid status transition: FAILED -> PAID")
+	if err := OrderStatuses.Check(order.Status, OrderStatusPaid); err != nil {
+		log.Warn("cannot mark order as PAID", zap.String("current_status", string(order.Status)))
+		return err
 	}
 
-	err = s.repo.UpdateStatusByReferenceID(
-		ctx,
-		referenceID,
-		paymentRequestID,
-		paymentProviderID,
-		"PAID",
-	)
+	// Split payment: the order only becomes PAID once every funding
+	// source together covers the total.
+	if order.WalletAmount > 0 {
+		covered, err := s.coveredAmount(ctx, uint(order.ID), capture.ExternalReference)
+		if err != nil {
+			log.Error("failed to sum order payments", zap.Error(err))
+			return err
+		}
+
+		if covered < int64(order.TotalAmount) {
+			log.Warn("order not fully covered yet, recording partial payment",
+				zap.Int64("covered", covered),
+				zap.Uint("total_amount", order.TotalAmount),
+			)
+			if err := s.paymentRepo.MarkPaymentPaid(ctx, capture); err != nil {
+				log.Error("failed to mark partial payment as paid", zap.Error(err))
+				return err
+			}
+			return nil
+		}
+	}
+
+	change := orderChange{svc: s, orderID: uint(order.ID), externalID: referenceID, byPayment: true}
+	err = OrderStatuses.Fire(ctx, change, order.Status, OrderStatusPaid, func(ctx context.Context) error {
+		return s.repo.MarkPaidByReferenceID(ctx, referenceID, capture)
+	})
 	if err != nil {
 		log.Error("failed to update order status to PAID", zap.Error(err))
 		return err
 	}
 
+	// The webhook carries no visitor, so the purchase is attributed to
+	// the order's customer.
+	analytics.Track(ctx, analytics.Event{
+		Type:     analytics.EventPurchase,
+		UserID:   order.UserID,
+		OrderID:  order.ExternalID,
+		Value:    int64(order.TotalAmount),
+		Currency: order.Currency,
+	})
+
 	log.Info("order successfully marked as PAID")
 	return nil
 }
 
+// coveredAmount sums the order's PAID payments, counting the payment
+// identified by paymentRequestID as paid.
+func (s *service) coveredAmount(
+	ctx context.Context,
+	orderID uint,
+	paymentRequestID string,
+) (int64, error) {
+	payments, err := s.paymentRepo.GetPaymentsByOrder(ctx, orderID)
+	if err != nil {
+		return 0, err
+	}
+
+	var covered int64
+	for _, p := range payments {
+		if p.Status == string(PaymentStatusPaid) || p.ExternalReference == paymentRequestID {
+			covered += p.Amount
+		}
+	}
+	return covered, nil
+}
+
 func (s *service) MarkAsFailed(
 	ctx context.Context,
 	referenceID string,
@@ -627,24 +1047,21 @@ func (s *service) MarkAsFailed(
 	}
 
 	// Idempotency guard
-	if order.Status == "FAILED" {
+	if order.Status == OrderStatusFailed {
 		log.Info("order already marked as FAILED")
 		return nil
 	}
 
-	// Optional safety check
-	if order.Status == "PAID" {
-		log.Warn("cannot mark PAID order as FAILED")
-		return fmt.Errorf("invalid status transition: PAID -> FAILED")
-	}
-
-	err = s.repo.UpdateStatusByReferenceID(
-		ctx,
-		referenceID,
-		paymentRequestID,
-		paymentProviderID,
-		"FAILED",
-	)
+	change := orderChange{svc: s, orderID: uint(order.ID), externalID: referenceID, byPayment: true}
+	err = OrderStatuses.Fire(ctx, change, order.Status, OrderStatusFailed, func(ctx context.Context) error {
+		return s.repo.UpdateStatusByReferenceID(
+			ctx,
+			referenceID,
+			paymentRequestID,
+			paymentProviderID,
+			string(OrderStatusFailed),
+		)
+	})
 	if err != nil {
 		log.Error("failed to update order status to FAILED", zap.Error(err))
 		return err
@@ -667,65 +1084,49 @@ func (s *service) CreateSession(
 
 	log.Info("create checkout session started")
 
-	userId, _ := utils.GetUserIDFromContext(ctx)
-
-	// 1. Validate variants & calculate price
-	items := make([]CheckoutSessionItem, 0, len(input.Items))
-	subtotal := 0
-
-	for i, item := range input.Items {
-		logItem := log.With(
-			zap.Int("index", i),
-			zap.String("variant_id", item.VariantID),
-			zap.Int32("quantity", item.Quantity),
-		)
-
-		if item.Quantity <= 0 {
-			logItem.Warn("invalid quantity")
-			return nil, errors.New("quantity must be greater than zero")
+	// Signed-in users own their sessions; anonymous shoppers own theirs
+	// through the guest token GuestMiddleware issued.
+	userId, isUser := utils.GetUserIDFromContext(ctx)
+	var guestID *uuid.UUID
+	if !isUser {
+		raw, ok := utils.GetGuestIDFromContext(ctx)
+		if !ok {
+			log.Warn("checkout session requested without user or guest")
+			return nil, ErrUnauthorized
+		}
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			log.Warn("invalid guest id", zap.Error(err))
+			return nil, ErrUnauthorized
 		}
+		guestID = &id
 
-		variant, product, err := s.repo.GetVariantForCheckout(ctx, item.VariantID)
+		// Users' older sessions are superseded; a guest's pile up until
+		// they expire, so cap how many a script can open.
+		open, err := s.repo.CountOpenGuestSessions(ctx, id)
 		if err != nil {
-			if errors.Is(err, sql.ErrNoRows) {
-				return nil, fmt.Errorf("variant not found: %s", item.VariantID)
-			}
-			logItem.Error(
-				"failed to get variant for checkout",
-				zap.Error(err),
-			)
-			return nil, errors.New("failed to get variant")
+			return nil, err
+		}
+		if open >= MaxOpenGuestSessions {
+			log.Warn("guest has too many open checkout sessions", zap.Int("open", open))
+			return nil, ErrTooManyGuestSessions
 		}
+	} else if err := s.checkCanCheckout(ctx, userId); err != nil {
+		return nil, err
+	}
 
-		itemSubtotal := int32(variant.Price) * item.Quantity
-		subtotal += int(itemSubtotal)
+	// 1. Validate variants & calculate price
+	items, subtotal, parcels, err := s.buildSessionItems(ctx, log, money.DefaultCurrency, input.Items)
+	if err != nil {
+		return nil, err
+	}
+	chargeableGrams := parcelsWeight(parcels)
 
-		logItem.Debug(
-			"item calculated",
-			zap.String("variant_name", variant.Name),
-			zap.String("product_name", product.Name),
-			zap.Int("price", int(variant.Price)),
-			zap.Int32("item_subtotal", itemSubtotal),
-		)
-
-		items = append(items, CheckoutSessionItem{
-			ID:           uuid.New(),
-			VariantID:    variant.ID,
-			VariantName:  variant.Name,
-			ProductName:  product.Name,
-			Quantity:     int(item.Quantity),
-			QuantityType: variant.QuantityType,
-			ImageURL:     &variant.ImageURL,
-			Price:        int(variant.Price),
-			Subtotal:     int(itemSubtotal),
-		})
-	}
-
-	// 2. Calculate fees
-	tax := subtotal * 10 / 100
-	shippingFee := 0
-	discount := 0
-	totalPrice := subtotal + tax + shippingFee - discount
+	// 2. Calculate fees
+	tax := s.calculateTax(nil, money.DefaultCurrency, subtotal)
+	shippingFee := 0
+	discount := 0
+	totalPrice := subtotal + tax + shippingFee - discount
 
 	log.Info(
 		"price calculated",
@@ -734,17 +1135,17 @@ func (s *service) CreateSession(
 		zap.Int("shipping_fee", shippingFee),
 		zap.Int("discount", discount),
 		zap.Int("total_price", totalPrice),
+		zap.Int("chargeable_weight_grams", chargeableGrams),
 	)
 
 	sessionID := uuid.New()
 	sessionExternalID := utils.ExternalIDFromSession("ck", sessionID.String())
-	uid := int32(userId)
 
 	// 3. Create session model
 	session := &CheckoutSession{
 		ID:          sessionID,
 		ExternalID:  sessionExternalID,
-		UserID:      &uid,
+		GuestID:     guestID,
 		Status:      CheckoutSessionStatusPending,
 		Subtotal:    subtotal,
 		Tax:         tax,
@@ -752,6 +1153,14 @@ func (s *service) CreateSession(
 		Discount:    discount,
 		TotalPrice:  totalPrice,
 		ExpiresAt:   time.Now().Add(30 * time.Minute),
+
+		ChargeableWeightGrams: chargeableGrams,
+		ShippingParcels:       parcels,
+		ShippingMethod:        ShippingMethodStandard,
+	}
+	if isUser {
+		uid := int32(userId)
+		session.UserID = &uid
 	}
 
 	log = log.With(
@@ -768,16 +1177,92 @@ func (s *service) CreateSession(
 		return nil, err
 	}
 
+	// Only the newest session stays open, so every device resumes the same one
+	if isUser {
+		n, err := s.repo.SupersedeOpenSessions(ctx, userId, session.ID)
+		if err != nil {
+			log.Warn("failed to supersede older checkout sessions", zap.Error(err))
+		} else if n > 0 {
+			log.Info("older checkout sessions superseded", zap.Int64("count", n))
+		}
+	}
+
 	log.Info("checkout session created successfully")
 
 	return session, nil
 }
 
+// buildSessionItems prices the requested variants for a checkout session
+// and sums their subtotal and, per shipping origin, their chargeable
+// weight. Unit prices are rounded to whole units of currency first, so
+// each line is its unit price times its quantity.
+func (s *service) buildSessionItems(
+	ctx context.Context,
+	log *zap.Logger,
+	currency string,
+	input []*model.CheckoutSessionItemInput,
+) ([]CheckoutSessionItem, int, []ShippingParcel, error) {
+	items := make([]CheckoutSessionItem, 0, len(input))
+	subtotal := 0
+	var parcels []ShippingParcel
+
+	for i, item := range input {
+		logItem := log.With(
+			zap.Int("index", i),
+			zap.String("variant_id", item.VariantID),
+			zap.Int32("quantity", item.Quantity),
+		)
+
+		if item.Quantity <= 0 {
+			logItem.Warn("invalid quantity")
+			return nil, 0, nil, errors.New("quantity must be greater than zero")
+		}
+
+		variant, product, err := s.repo.GetVariantForCheckout(ctx, item.VariantID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, 0, nil, fmt.Errorf("variant not found: %s", item.VariantID)
+			}
+			logItem.Error(
+				"failed to get variant for checkout",
+				zap.Error(err),
+			)
+			return nil, 0, nil, errors.New("failed to get variant")
+		}
+
+		price := int(money.FromMajor(currency, variant.Price))
+		itemSubtotal := price * int(item.Quantity)
+		subtotal += itemSubtotal
+		parcels = addToParcel(parcels, product, chargeableWeightGrams(variant.Shipping, int(item.Quantity)))
+
+		logItem.Debug(
+			"item calculated",
+			zap.String("variant_name", variant.Name),
+			zap.String("product_name", product.Name),
+			zap.Int("price", price),
+			zap.Int("item_subtotal", itemSubtotal),
+		)
+
+		items = append(items, CheckoutSessionItem{
+			ID:           uuid.New(),
+			VariantID:    variant.ID,
+			VariantName:  variant.Name,
+			ProductName:  product.Name,
+			Quantity:     int(item.Quantity),
+			QuantityType: variant.QuantityType,
+			ImageURL:     &variant.ImageURL,
+			Price:        price,
+			Subtotal:     itemSubtotal,
+		})
+	}
+
+	return items, subtotal, parcels, nil
+}
+
 func (s *service) UpdateSessionAddress(
 	ctx context.Context,
 	externalID string,
 	addressID string,
-	guestID *string,
 ) error {
 	log := logger.FromCtx(ctx).With(
 		zap.String("layer", "service"),
@@ -796,24 +1281,8 @@ func (s *service) UpdateSessionAddress(
 
 	userID, _ := utils.GetUserIDFromContext(ctx)
 
-	if guestID != nil {
-		guestUUID, err := uuid.Parse(*guestID)
-		if err != nil {
-			log.Warn("invalid guest id format", zap.String("guest_id", *guestID), zap.Error(err))
-			return errors.New("invalid guest id")
-		}
-		if session.GuestID == nil || *session.GuestID != guestUUID {
-			log.Warn("forbidden: guest ID mismatch")
-			return errors.New("forbidden: guest ID mismatch")
-		}
-	} else {
-		if session.UserID == nil || *session.UserID != int32(userID) {
-			log.Warn("forbidden: cannot update others' sessions",
-				zap.Int32("session_user_id", *session.UserID),
-				zap.Uint("request_user_id", userID),
-			)
-			return errors.New("forbidden: cannot update others' sessions")
-		}
+	if err := checkSessionOwner(ctx, log, session); err != nil {
+		return err
 	}
 
 	if session.Status != CheckoutSessionStatusPending {
@@ -826,20 +1295,21 @@ func (s *service) UpdateSessionAddress(
 		return errors.New("checkout session expired")
 	}
 
-	address, err := s.repo.GetUserAddress(ctx, addressID, userID)
+	address, err := s.userAddress(ctx, addressID, userID)
 	if err != nil {
 		log.Error("failed to get user address", zap.Error(err))
 		return err
 	}
 
 	// 4. Recalculate pricing
-	shippingFee := s.calculateShippingFee(address, session.Items)
-	tax := s.calculateTax(address, session.Subtotal)
-
+	fromAddress := session.AddressID
+	totalBefore := session.TotalPrice
 	session.AddressID = &address.ID
-	session.ShippingFee = shippingFee
-	session.Tax = tax
-	session.TotalPrice = session.Subtotal + tax + shippingFee - session.Discount
+	session.ShippingFee, err = s.sessionShippingFee(ctx, session, address)
+	if err != nil {
+		return err
+	}
+	s.applyPricing(session)
 
 	// 5. Persist changes
 	if err := s.repo.UpdateSessionAddressAndPricing(ctx, session); err != nil {
@@ -847,6 +1317,9 @@ func (s *service) UpdateSessionAddress(
 		return err
 	}
 
+	s.recordSessionEvent(ctx, session, SessionEventAddressChanged,
+		uuidText(fromAddress), uuidText(session.AddressID), totalBefore)
+
 	log.Info("session address updated successfully")
 	return nil
 }
@@ -855,7 +1328,6 @@ func (s *service) UpdateSessionPaymentMethod(
 	ctx context.Context,
 	externalID string,
 	paymentMethod payment.ChannelCode,
-	guestID *string,
 ) error {
 	log := logger.FromCtx(ctx).With(
 		zap.String("layer", "service"),
@@ -866,22 +1338,7 @@ func (s *service) UpdateSessionPaymentMethod(
 
 	log.Info("update session payment method started")
 
-	switch paymentMethod {
-	case payment.MethodBCAVA,
-		payment.MethodBNIVA,
-		payment.MethodMandiriVA,
-		payment.MethodQRIS,
-		payment.MethodCOD,
-		payment.MethodOVO,
-		payment.MethodDANA,
-		payment.MethodLINKAJA,
-		payment.MethodSHOPEE,
-		payment.MethodGOPAY,
-		payment.MethodAlfamart,
-		payment.MethodIndomaret,
-		payment.MethodCreditCard:
-		// valid
-	default:
+	if !gatewayMethod(paymentMethod) {
 		log.Warn("invalid payment method", zap.String("payment_method", string(paymentMethod)))
 		return fmt.Errorf("invalid payment method: %s", paymentMethod)
 	}
@@ -892,26 +1349,8 @@ func (s *service) UpdateSessionPaymentMethod(
 		return err
 	}
 
-	userID, _ := utils.GetUserIDFromContext(ctx)
-
-	if guestID != nil {
-		guestUUID, err := uuid.Parse(*guestID)
-		if err != nil {
-			log.Warn("invalid guest id format", zap.String("guest_id", *guestID), zap.Error(err))
-			return errors.New("invalid guest id")
-		}
-		if session.GuestID == nil || *session.GuestID != guestUUID {
-			log.Warn("forbidden: guest ID mismatch")
-			return errors.New("forbidden: guest ID mismatch")
-		}
-	} else {
-		if session.UserID == nil || *session.UserID != int32(userID) {
-			log.Warn("forbidden: cannot update others' sessions",
-				zap.Int32("session_user_id", *session.UserID),
-				zap.Uint("request_user_id", userID),
-			)
-			return errors.New("forbidden: cannot update others' sessions")
-		}
+	if err := checkSessionOwner(ctx, log, session); err != nil {
+		return err
 	}
 
 	if session.Status != CheckoutSessionStatusPending {
@@ -924,346 +1363,2452 @@ func (s *service) UpdateSessionPaymentMethod(
 		return errors.New("checkout session expired")
 	}
 
+	if paymentMethod == payment.MethodCOD {
+		if err := s.requireVerifiedPhone(ctx, session.UserID); err != nil {
+			log.Warn("cash on delivery not allowed", zap.Error(err))
+			return err
+		}
+	}
+
 	// Persist changes
 	if err := s.repo.UpdateSessionPaymentMethod(ctx, session.ID, paymentMethod); err != nil {
 		log.Error("failed to update session payment method", zap.Error(err))
 		return err
 	}
 
+	var fromMethod *string
+	if session.PaymentMethod != nil {
+		m := string(*session.PaymentMethod)
+		fromMethod = &m
+	}
+	toMethod := string(paymentMethod)
+	s.recordSessionEvent(ctx, session, SessionEventPaymentMethodChanged,
+		fromMethod, &toMethod, session.TotalPrice)
+
 	log.Info("session payment method updated successfully")
 	return nil
 }
 
-func (s *service) calculateShippingFee(
-	address *address.Address,
-	items []CheckoutSessionItem,
-) int {
-	// stub logic
-	if address.City == "Jakarta" {
-		return 10000
+func (s *service) ShippingOptions(
+	ctx context.Context,
+	externalID string,
+) ([]*ShippingOption, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "ShippingOptions"),
+		zap.String("external_id", externalID),
+	)
+
+	session, err := s.repo.GetCheckoutSession(ctx, externalID)
+	if err != nil {
+		log.Error("failed to get checkout session", zap.Error(err))
+		return nil, err
 	}
-	return 20000
-}
 
-func (s *service) calculateTax(
-	address *address.Address,
-	subtotal int,
-) int {
-	return subtotal * 10 / 100
+	if err := checkSessionOwner(ctx, log, session); err != nil {
+		return nil, err
+	}
+
+	if session.AddressID == nil {
+		return nil, ErrShippingAddressNotSet
+	}
+
+	userID, _ := utils.GetUserIDFromContext(ctx)
+	address, err := s.userAddress(ctx, session.AddressID.String(), userID)
+	if err != nil {
+		log.Error("failed to get user address", zap.Error(err))
+		return nil, err
+	}
+
+	standardFee, err := s.standardShippingFee(ctx, session, address)
+	if err != nil {
+		return nil, err
+	}
+
+	instant := &ShippingOption{Method: ShippingMethodInstant, Available: true}
+	instant.Fee, instant.DistanceKm, err = instantQuote(session.parcels(), address)
+	if err != nil {
+		reason := err.Error()
+		instant.Available = false
+		instant.Reason = &reason
+	}
+
+	locations, err := s.repo.ListPickupLocations(ctx, true)
+	if err != nil {
+		return nil, err
+	}
+	pickup := &ShippingOption{Method: ShippingMethodSelfPickup, Available: len(locations) > 0}
+	if !pickup.Available {
+		reason := "no pickup location is open"
+		pickup.Reason = &reason
+	}
+
+	options := []*ShippingOption{
+		{Method: ShippingMethodStandard, Fee: standardFee, Available: true},
+		instant,
+		pickup,
+	}
+	for _, o := range options {
+		if fee, ok := insuranceQuote(o.Method, session.Subtotal); ok {
+			o.InsuranceFee = &fee
+		}
+	}
+	return options, nil
 }
 
-func (s *service) ConfirmSession(
+func (s *service) UpdateSessionShippingMethod(
 	ctx context.Context,
 	externalID string,
-) (*string, error) {
-
+	method ShippingMethod,
+	pickup *PickupChoice,
+	deliverySlotID *int32,
+) (*CheckoutSession, error) {
 	log := logger.FromCtx(ctx).With(
 		zap.String("layer", "service"),
-		zap.String("method", "ConfirmSession"),
-		zap.String("externa_idD", externalID),
+		zap.String("method", "UpdateSessionShippingMethod"),
+		zap.String("external_id", externalID),
+		zap.String("shipping_method", string(method)),
 	)
 
-	userID, _ := utils.GetUserIDFromContext(ctx)
-
-	log.Info("confirm checkout session started")
+	if !validShippingMethod(method) {
+		return nil, ErrInvalidShippingMethod
+	}
 
-	// 1. Load session (with items)
 	session, err := s.repo.GetCheckoutSession(ctx, externalID)
 	if err != nil {
-		log.Error("failed to load checkout session", zap.Error(err))
+		log.Error("failed to get checkout session", zap.Error(err))
 		return nil, err
 	}
 
-	log.Debug("checkout session loaded",
-		zap.String("status", string(session.Status)),
-		zap.Int("items_count", len(session.Items)),
-	)
-
-	// 2. Ownership check (if not guest)
-	if session.UserID != nil && *session.UserID != int32(userID) {
-		log.Warn("ownership check failed",
-			zap.Int32("session_user_id", *session.UserID),
-			zap.Uint("request_user_id", userID),
-		)
-		return nil, errors.New("forbidden")
+	if err := checkSessionOwner(ctx, log, session); err != nil {
+		return nil, err
 	}
 
-	// 3. Validate state
 	if session.Status != CheckoutSessionStatusPending {
-		log.Warn("invalid session status",
-			zap.String("status", string(session.Status)),
-		)
-		return nil, errors.New("checkout session already confirmed")
+		log.Warn("checkout session is not editable", zap.String("status", string(session.Status)))
+		return nil, ErrSessionNotEditable
 	}
 
 	if time.Now().After(session.ExpiresAt) {
-		log.Warn("checkout session expired",
-			zap.Time("expires_at", session.ExpiresAt),
-		)
-		return nil, errors.New("checkout session expired")
+		log.Warn("checkout session expired", zap.Time("expires_at", session.ExpiresAt))
+		return nil, ErrSessionExpired
 	}
 
 	if session.AddressID == nil {
-		log.Warn("shipping address not set")
-		return nil, errors.New("shipping address not set")
+		return nil, ErrShippingAddressNotSet
 	}
 
-	if len(session.Items) == 0 {
-		log.Warn("checkout session has no items")
-		return nil, errors.New("checkout session has no items")
+	userID, _ := utils.GetUserIDFromContext(ctx)
+	address, err := s.userAddress(ctx, session.AddressID.String(), userID)
+	if err != nil {
+		log.Error("failed to get user address", zap.Error(err))
+		return nil, err
 	}
 
-	// 4. Re-validate stock & price
-	for _, item := range session.Items {
-		ok, err := s.repo.ValidateVariantStock(
-			ctx,
-			item.VariantID,
-			item.Quantity,
-		)
+	session.PickupLocationID, session.PickupSlotStart = nil, nil
+	if method == ShippingMethodSelfPickup {
+		if pickup == nil {
+			return nil, ErrPickupNotChosen
+		}
+		location, slot, err := s.pickupSlot(ctx, pickup.LocationID, pickup.SlotStart)
 		if err != nil {
-			log.Error("failed to validate variant stock",
-				zap.String("variant_id", item.VariantID),
-				zap.Int("quantity", item.Quantity),
-				zap.Error(err),
-			)
+			log.Warn("pickup slot not available", zap.Error(err))
 			return nil, err
 		}
-		if !ok {
-			log.Warn("product out of stock",
-				zap.String("variant_id", item.VariantID),
-				zap.Int("quantity", item.Quantity),
-			)
-			return nil, errors.New("product out of stock")
-		}
-	}
-
-	log.Info("stock validation passed")
-
-	// Idempotency check: see if an order already exists for this session.
-	// This handles retries if the payment gateway call fails after order creation.
-	order, err := s.repo.GetOrderBySessionID(ctx, session.ID)
-	if err != nil {
-		// This is an actual DB error, not a "not found" case.
-		log.Error("failed to check for existing order by session ID", zap.Error(err))
-		return nil, err
+		session.PickupLocationID = &location.ID
+		session.PickupSlotStart = &slot.Start
 	}
 
-	var externalOrderID string
-
-	if order == nil {
-		// Order does not exist, this is the first attempt.
-		log.Info("creating new order for session")
-		externalOrderID = utils.ExternalIDFromSession("pay", session.ID.String())
-
-		order = &Order{
-			UserID:      session.UserID,
-			TotalAmount: uint(session.TotalPrice),
-			Currency:    session.Currency,
-			Status:      OrderStatus(model.OrderStatusPendingPayment),
-			ExternalID:  externalOrderID,
+	session.DeliverySlotID, session.DeliveryDate = nil, nil
+	if deliverySlotID != nil {
+		if method != ShippingMethodInstant {
+			return nil, ErrDeliverySlotNeedsInstant
 		}
-
-		if err := s.repo.CreateOrderTx(ctx, order, session); err != nil {
-			log.Error("failed to create order in transaction", zap.Error(err))
+		options, err := s.deliveryOptions(ctx, address.Province)
+		if err != nil {
 			return nil, err
 		}
-
-		if err := s.repo.ConfirmCheckoutSession(ctx, session); err != nil {
-			log.Error("failed to confirm checkout session", zap.Error(err))
-			// Note: At this point, an order exists but the session isn't marked as confirmed.
-			// The idempotency check at the start of this function will handle retries correctly.
+		booking, err := deliveryBooking(options, *deliverySlotID)
+		if err != nil {
+			log.Warn("delivery slot not available", zap.Error(err))
 			return nil, err
 		}
-	} else {
-		// Order already exists, this is a retry.
-		log.Info("order already exists for this session, retrying payment process", zap.Int32("order_id", order.ID))
-		externalOrderID = order.ExternalID
+		session.DeliverySlotID = &booking.SlotID
+		session.DeliveryDate = &booking.Date
 	}
 
-	// 7. Process payment
-	_, err = s.OrderToPaymentProcess(ctx, session, externalOrderID, uint(order.ID))
+	fromMethod := string(session.ShippingMethod)
+	totalBefore := session.TotalPrice
+	session.ShippingMethod = method
+	session.ShippingFee, err = s.sessionShippingFee(ctx, session, address)
 	if err != nil {
-		log.Error("failed to process order to payment", zap.Error(err))
+		log.Warn("shipping method not available", zap.Error(err))
 		return nil, err
 	}
+	s.applyPricing(session)
 
-	log.Info("checkout session confirmed successfully",
-		zap.String("final_status", string(session.Status)),
-	)
+	if err := s.repo.UpdateSessionShippingMethod(ctx, session); err != nil {
+		log.Error("failed to update session shipping method", zap.Error(err))
+		return nil, err
+	}
 
-	return &externalOrderID, nil
+	toMethod := string(method)
+	s.recordSessionEvent(ctx, session, SessionEventShippingMethodChanged,
+		&fromMethod, &toMethod, totalBefore)
+
+	log.Info("session shipping method updated successfully",
+		zap.Int("shipping_fee", session.ShippingFee),
+	)
+	return session, nil
 }
 
-func (s *service) GetSession(
+func (s *service) UpdateSessionInsurance(
 	ctx context.Context,
 	externalID string,
+	insured bool,
 ) (*CheckoutSession, error) {
-
 	log := logger.FromCtx(ctx).With(
 		zap.String("layer", "service"),
-		zap.String("method", "GetSession"),
+		zap.String("method", "UpdateSessionInsurance"),
 		zap.String("external_id", externalID),
+		zap.Bool("insured", insured),
 	)
 
-	log.Info("get checkout session started")
-
-	userID, ok := utils.GetUserIDFromContext(ctx)
 	session, err := s.repo.GetCheckoutSession(ctx, externalID)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			log.Warn("checkout session not found")
-			return nil, errors.New("checkout session not found")
-		}
 		log.Error("failed to get checkout session", zap.Error(err))
-		return nil, errors.New("failed to get checkout session")
+		return nil, err
 	}
 
-	// Ownership check (if session is tied to a user)
-	if session.UserID != nil && ok {
-		if *session.UserID != int32(userID) {
-			log.Warn("forbidden access to checkout session",
-				zap.Int32("session_user_id", *session.UserID),
-				zap.Uint("request_user_id", userID),
+	if err := checkSessionOwner(ctx, log, session); err != nil {
+		return nil, err
+	}
+
+	if session.Status != CheckoutSessionStatusPending {
+		log.Warn("checkout session is not editable", zap.String("status", string(session.Status)))
+		return nil, ErrSessionNotEditable
+	}
+
+	if time.Now().After(session.ExpiresAt) {
+		log.Warn("checkout session expired", zap.Time("expires_at", session.ExpiresAt))
+		return nil, ErrSessionExpired
+	}
+
+	if _, ok := insuranceQuote(session.ShippingMethod, session.Subtotal); insured && !ok {
+		log.Warn("shipping method cannot be insured", zap.String("shipping_method", string(session.ShippingMethod)))
+		return nil, ErrInsuranceUnavailable
+	}
+
+	from := strconv.FormatBool(session.Insured)
+	totalBefore := session.TotalPrice
+	session.Insured = insured
+	s.applyPricing(session)
+
+	if err := s.repo.UpdateSessionInsurance(ctx, session); err != nil {
+		log.Error("failed to update session insurance", zap.Error(err))
+		return nil, err
+	}
+
+	to := strconv.FormatBool(insured)
+	s.recordSessionEvent(ctx, session, SessionEventInsuranceChanged, &from, &to, totalBefore)
+
+	log.Info("session insurance updated successfully",
+		zap.Int("insurance_fee", session.InsuranceFee()),
+	)
+	return session, nil
+}
+
+// pickupSlot is the slot starting at start that can still be booked at
+// an active pickup location.
+func (s *service) pickupSlot(
+	ctx context.Context,
+	locationID int32,
+	start time.Time,
+) (*PickupLocation, PickupSlot, error) {
+	location, err := s.repo.GetPickupLocation(ctx, locationID)
+	if err != nil {
+		return nil, PickupSlot{}, err
+	}
+	if !location.IsActive {
+		return nil, PickupSlot{}, ErrPickupLocationNotFound
+	}
+	slot, ok := location.slotAt(start, s.now(), s.loc)
+	if !ok {
+		return nil, PickupSlot{}, ErrPickupSlotUnavailable
+	}
+	return location, slot, nil
+}
+
+// bookedPickup checks the session's pickup slot can still be booked and
+// returns the pickup for its order, with a new code.
+func (s *service) bookedPickup(
+	ctx context.Context,
+	session *CheckoutSession,
+) (*OrderPickup, error) {
+	if session.PickupLocationID == nil || session.PickupSlotStart == nil {
+		return nil, ErrPickupNotChosen
+	}
+	location, slot, err := s.pickupSlot(ctx, *session.PickupLocationID, *session.PickupSlotStart)
+	if errors.Is(err, ErrPickupLocationNotFound) {
+		return nil, ErrPickupSlotUnavailable
+	}
+	if err != nil {
+		return nil, err
+	}
+	code, err := newPickupCode()
+	if err != nil {
+		return nil, err
+	}
+	return &OrderPickup{
+		Location:  location,
+		SlotStart: slot.Start,
+		SlotEnd:   slot.End,
+		Code:      code,
+	}, nil
+}
+
+// deliveryOptions lists today's active slots for province, with what is
+// left of each.
+func (s *service) deliveryOptions(
+	ctx context.Context,
+	province string,
+) ([]*DeliverySlotOption, error) {
+	slots, err := s.repo.ListDeliverySlots(ctx, &province, true)
+	if err != nil {
+		return nil, err
+	}
+	if len(slots) == 0 {
+		return []*DeliverySlotOption{}, nil
+	}
+
+	now := s.now()
+	today := localMidnight(now, s.loc)
+	ids := make([]int32, len(slots))
+	for i, slot := range slots {
+		ids[i] = slot.ID
+	}
+	booked, err := s.repo.CountDeliveryBookings(ctx, ids, today)
+	if err != nil {
+		return nil, err
+	}
+
+	options := make([]*DeliverySlotOption, 0, len(slots))
+	for _, slot := range slots {
+		start, end, err := slot.window(today)
+		if err != nil {
+			logger.FromCtx(ctx).Error("delivery slot has invalid times",
+				zap.Int32("delivery_slot_id", slot.ID),
+				zap.Error(err),
 			)
-			return nil, errors.New("forbidden")
+			continue
 		}
+		remaining := max(slot.Capacity-booked[slot.ID], 0)
+		options = append(options, &DeliverySlotOption{
+			Slot:      slot,
+			Start:     start,
+			End:       end,
+			Remaining: remaining,
+			Available: remaining > 0 && !start.Before(now.Add(sameDayLeadTime)),
+		})
 	}
+	return options, nil
+}
 
-	// Expiration handling (soft)
-	if time.Now().After(session.ExpiresAt) &&
-		session.Status == CheckoutSessionStatusPending {
+// deliveryBooking books slotID out of today's options. A slot of another
+// region is not among them.
+func deliveryBooking(options []*DeliverySlotOption, slotID int32) (*DeliveryBooking, error) {
+	for _, o := range options {
+		if o.Slot.ID != slotID {
+			continue
+		}
+		if o.Remaining == 0 {
+			return nil, ErrDeliverySlotFull
+		}
+		if !o.Available {
+			return nil, ErrDeliverySlotUnavailable
+		}
+		return &DeliveryBooking{
+			SlotID:      slotID,
+			Date:        localMidnight(o.Start, o.Start.Location()),
+			WindowStart: o.Start,
+			WindowEnd:   o.End,
+		}, nil
+	}
+	return nil, ErrDeliverySlotUnavailable
+}
 
-		log.Info("marking session as expired", zap.String("session_id", session.ID.String()))
-		// Optional: mark expired lazily
-		if err := s.repo.MarkSessionExpired(ctx, session.ID); err != nil {
-			log.Error("failed to mark session expired", zap.Error(err))
+// confirmedDelivery rechecks the session's delivery slot on confirmation.
+// Regions without slots deliver instant orders without one.
+func (s *service) confirmedDelivery(
+	ctx context.Context,
+	session *CheckoutSession,
+	province string,
+) (*DeliveryBooking, error) {
+	options, err := s.deliveryOptions(ctx, province)
+	if err != nil {
+		return nil, err
+	}
+	if session.DeliverySlotID == nil {
+		if len(options) > 0 {
+			return nil, ErrDeliverySlotRequired
 		}
-		session.Status = CheckoutSessionStatusExpired
+		return nil, nil
 	}
+	// A slot chosen on an earlier day is gone.
+	today := localMidnight(s.now(), s.loc)
+	if session.DeliveryDate == nil || session.DeliveryDate.Format(time.DateOnly) != today.Format(time.DateOnly) {
+		return nil, ErrDeliverySlotUnavailable
+	}
+	return deliveryBooking(options, *session.DeliverySlotID)
+}
 
-	log.Info("checkout session retrieved successfully")
+// checkInstantReach checks instant delivery still reaches address from
+// where the session's origins sit now, since a seller may have moved or
+// unpinned one after the session was priced.
+func (s *service) checkInstantReach(
+	ctx context.Context,
+	session *CheckoutSession,
+	address *address.Address,
+) error {
+	parcels := slices.Clone(session.parcels())
+	originIDs := make([]string, 0, len(parcels))
+	for _, p := range parcels {
+		if p.OriginID != nil {
+			originIDs = append(originIDs, *p.OriginID)
+		}
+	}
 
-	return session, nil
+	locations, err := s.repo.OriginLocations(ctx, originIDs)
+	if err != nil {
+		return err
+	}
+	for i, p := range parcels {
+		parcels[i].OriginLocation = nil
+		if p.OriginID == nil {
+			continue
+		}
+		if loc, ok := locations[*p.OriginID]; ok {
+			parcels[i].OriginLocation = &loc
+		}
+	}
+
+	_, _, err = instantQuote(parcels, address)
+	return err
 }
 
-func (s *service) GetPaymentOrderInfo(
+// ApplySessionWallet sets how much of the session total is paid from the
+// caller's wallet. The remainder is collected by the gateway on confirm.
+// An amount of 0 removes the wallet portion.
+func (s *service) ApplySessionWallet(
 	ctx context.Context,
 	externalID string,
-) (*PaymentOrderInfoResponse, error) {
-
+	amount int,
+) error {
 	log := logger.FromCtx(ctx).With(
 		zap.String("layer", "service"),
-		zap.String("method", "GetPaymentOrderInfo"),
+		zap.String("method", "ApplySessionWallet"),
 		zap.String("external_id", externalID),
+		zap.Int("amount", amount),
 	)
 
-	log.Info("get payment order info started")
+	log.Info("apply session wallet started")
 
 	userID, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		log.Warn("wallet payment requires a user")
+		return ErrWalletRequiresUser
+	}
 
-	order, err := s.repo.GetOrderByExternalID(ctx, externalID)
+	if amount < 0 {
+		log.Warn("negative wallet amount")
+		return ErrInvalidWalletUse
+	}
+
+	session, err := s.repo.GetCheckoutSession(ctx, externalID)
 	if err != nil {
-		log.Error("failed to get order", zap.Error(err))
-		return nil, errors.New("failed to get order")
+		log.Error("failed to get checkout session", zap.Error(err))
+		return err
 	}
 
-	if order == nil {
-		log.Warn("order not found")
-		return nil, ErrOrderNotFound
+	if session.UserID == nil || *session.UserID != int32(userID) {
+		log.Warn("forbidden: cannot update others' sessions")
+		return ErrSessionForbidden
 	}
 
-	// Ownership check
-	if order.UserID != nil {
-		if !ok {
-			log.Warn("unauthorized access to payment info")
-			return nil, errors.New("forbidden")
+	if session.Status != CheckoutSessionStatusPending {
+		log.Warn("checkout session is not editable", zap.String("status", string(session.Status)))
+		return ErrSessionNotEditable
+	}
+
+	if time.Now().After(session.ExpiresAt) {
+		log.Warn("checkout session expired", zap.Time("expires_at", session.ExpiresAt))
+		return ErrSessionExpired
+	}
+
+	if amount > session.TotalPrice {
+		log.Warn("wallet amount exceeds session total", zap.Int("total_price", session.TotalPrice))
+		return ErrInvalidWalletUse
+	}
+
+	if amount > 0 {
+		balance, err := s.wallets.Balance(ctx, userID)
+		if err != nil {
+			log.Error("failed to get wallet balance", zap.Error(err))
+			return err
 		}
-		if *order.UserID != int32(userID) {
-			log.Warn("forbidden access to payment info",
-				zap.Int32("order_user_id", *order.UserID),
-				zap.Uint("request_user_id", userID),
-			)
-			return nil, errors.New("forbidden")
+		if balance < int64(amount) {
+			log.Warn("insufficient wallet balance", zap.Int64("balance", balance))
+			return ErrInsufficientWallet
 		}
 	}
 
-	paymentData, err := s.paymentRepo.GetPaymentByOrder(ctx, uint(order.ID))
+	if err := s.repo.UpdateSessionWalletAmount(ctx, session.ID, amount); err != nil {
+		log.Error("failed to update session wallet amount", zap.Error(err))
+		return err
+	}
+
+	s.recordSessionEvent(ctx, session, SessionEventWalletChanged,
+		intText(session.WalletAmount), intText(amount), session.TotalPrice)
+
+	log.Info("session wallet amount updated successfully")
+	return nil
+}
+
+// ApplySessionCoupon validates a voucher code against the caller's session
+// and applies its discount. Personal vouchers only apply to their owner.
+// Returns the discount applied.
+func (s *service) ApplySessionCoupon(
+	ctx context.Context,
+	externalID string,
+	code string,
+) (int, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "ApplySessionCoupon"),
+		zap.String("external_id", externalID),
+	)
+
+	log.Info("apply session coupon started")
+
+	userID, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		log.Warn("user not authenticated")
+		return 0, ErrUnauthorized
+	}
+
+	session, err := s.repo.GetCheckoutSession(ctx, externalID)
 	if err != nil {
-		log.Error("failed to get payment data", zap.Error(err))
-		return nil, errors.New("failed to get payment data")
+		log.Error("failed to get checkout session", zap.Error(err))
+		return 0, err
+	}
+
+	if session.UserID == nil || *session.UserID != int32(userID) {
+		log.Warn("forbidden: cannot update others' sessions")
+		return 0, ErrSessionForbidden
+	}
+
+	if session.Status != CheckoutSessionStatusPending {
+		log.Warn("checkout session is not editable", zap.String("status", string(session.Status)))
+		return 0, errors.New("checkout session is not editable")
+	}
+
+	now := time.Now()
+	if now.After(session.ExpiresAt) {
+		log.Warn("checkout session expired", zap.Time("expires_at", session.ExpiresAt))
+		return 0, errors.New("checkout session expired")
 	}
 
-	address, err := s.addressRepo.GetByID(ctx, order.AddressID)
+	v, err := s.repo.GetVoucherByCode(ctx, strings.ToUpper(strings.TrimSpace(code)))
 	if err != nil {
-		log.Error("failed to get address", zap.Error(err))
-		return nil, errors.New("failed to get address")
+		log.Warn("failed to get voucher", zap.Error(err))
+		return 0, err
 	}
 
-	instructions := payment.GetInstructions(paymentData.PaymentMethod)
-	instructions = payment.InjectVariables(
-		instructions,
-		payment.InstructionVars{
-			"amount":       utils.FormatIDR(int64(order.TotalAmount)),
-			"payment_code": paymentData.PaymentCode,
-		},
-	)
+	log = log.With(zap.Int64("voucher_id", v.ID))
 
-	var paymentCode, invoiceURL *string
-	if paymentData.PaymentCode != "" {
-		paymentCode = &paymentData.PaymentCode
+	if v.OwnerUserID != nil && *v.OwnerUserID != int32(userID) {
+		log.Warn("voucher owned by another user")
+		return 0, ErrVoucherNotOwned
 	}
-	if paymentData.InvoiceURL != "" {
-		invoiceURL = &paymentData.InvoiceURL
+
+	if !v.IsActive ||
+		now.Before(v.StartsAt) ||
+		(v.EndsAt != nil && now.After(*v.EndsAt)) ||
+		(v.ExpiresAt != nil && now.After(*v.ExpiresAt)) {
+		log.Warn("voucher not active")
+		return 0, ErrVoucherInactive
 	}
 
-	paymentInfo := &PaymentOrderInfoResponse{
-		OrderExternalID: externalID,
-		Status:          PaymentStatus(paymentData.Status),
-		TotalAmount:     int(order.TotalAmount),
-		Currency:        order.Currency,
-		ExpiresAt:       paymentData.ExpireAt,
-		ShippingAddress: ShippingAddress{
-			Name:         address.Name,
-			ReceiverName: address.ReceiverName,
-			Phone:        address.Phone,
-			Address1:     address.Address1,
-			Address2:     address.Address2,
-			City:         address.City,
-			Province:     address.Province,
-			PostalCode:   address.Postal,
-		},
-		Payment: PaymentDetail{
-			Method:       paymentData.PaymentMethod,
-			PaymentCode:  paymentCode,
-			InvoiceURL:   invoiceURL,
-			ReferenceID:  paymentData.ExternalReference,
-			Instructions: instructions,
-		},
+	if v.UsageLimit != nil && v.Redemptions >= int64(*v.UsageLimit) {
+		log.Warn("voucher usage limit reached")
+		return 0, ErrVoucherExhausted
 	}
 
-	log.Info("payment order info retrieved successfully")
+	if int64(session.Subtotal) < v.MinSubtotal {
+		log.Warn("subtotal below voucher minimum",
+			zap.Int("subtotal", session.Subtotal),
+			zap.Int64("min_subtotal", v.MinSubtotal),
+		)
+		return 0, ErrVoucherMinSubtotal
+	}
 
-	return paymentInfo, nil
+	discount := v.DiscountFor(session.Currency, session.Subtotal)
+
+	fromVoucher := session.VoucherID
+	totalBefore := session.TotalPrice
+	session.VoucherID = &v.ID
+	session.Discount = discount
+	s.applyPricing(session)
+
+	if err := s.repo.UpdateSessionDiscounts(ctx, session); err != nil {
+		log.Error("failed to update session voucher", zap.Error(err))
+		return 0, err
+	}
+
+	var fromCode *string
+	if fromVoucher != nil {
+		fromCode = intText(int(*fromVoucher))
+	}
+	s.recordSessionEvent(ctx, session, SessionEventCouponApplied,
+		fromCode, intText(int(v.ID)), totalBefore)
+
+	log.Info("coupon applied successfully", zap.Int("discount", discount))
+	return discount, nil
 }
 
-func (s *service) GetOrderForWebhook(
+// ApplySessionPoints redeems loyalty points against the caller's session.
+// Points apply after any voucher and before tax, and are capped at what is
+// left of the subtotal. Returns the discount applied; 0 points clears it.
+func (s *service) ApplySessionPoints(
 	ctx context.Context,
 	externalID string,
-) (*Order, error) {
+	points int,
+) (int, error) {
 	log := logger.FromCtx(ctx).With(
 		zap.String("layer", "service"),
-		zap.String("method", "GetOrderForWebhook"),
+		zap.String("method", "ApplySessionPoints"),
 		zap.String("external_id", externalID),
+		zap.Int("points", points),
 	)
 
-	order, err := s.repo.GetOrderByExternalID(ctx, externalID)
+	log.Info("apply session points started")
+
+	userID, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		log.Warn("user not authenticated")
+		return 0, ErrUnauthorized
+	}
+
+	if points < 0 {
+		log.Warn("negative points amount")
+		return 0, ErrInvalidPointsUse
+	}
+
+	session, err := s.repo.GetCheckoutSession(ctx, externalID)
 	if err != nil {
-		log.Error("failed to get order for webhook", zap.Error(err))
-		return nil, errors.New("failed to get order")
+		log.Error("failed to get checkout session", zap.Error(err))
+		return 0, err
 	}
-	if order == nil {
-		log.Warn("order not found for webhook")
-		return nil, ErrOrderNotFound
+
+	if session.UserID == nil || *session.UserID != int32(userID) {
+		log.Warn("forbidden: cannot update others' sessions")
+		return 0, ErrSessionForbidden
 	}
-	return order, nil
+
+	if session.Status != CheckoutSessionStatusPending {
+		log.Warn("checkout session is not editable", zap.String("status", string(session.Status)))
+		return 0, errors.New("checkout session is not editable")
+	}
+
+	if time.Now().After(session.ExpiresAt) {
+		log.Warn("checkout session expired", zap.Time("expires_at", session.ExpiresAt))
+		return 0, errors.New("checkout session expired")
+	}
+
+	if points > 0 {
+		balance, err := s.points.Balance(ctx, userID)
+		if err != nil {
+			log.Error("failed to get loyalty balance", zap.Error(err))
+			return 0, err
+		}
+		if balance < int64(points) {
+			log.Warn("insufficient loyalty points", zap.Int64("balance", balance))
+			return 0, ErrInsufficientPoints
+		}
+	}
+
+	fromPoints := session.PointsRedeemed
+	totalBefore := session.TotalPrice
+	session.PointsRedeemed = points
+	s.applyPricing(session)
+
+	if err := s.repo.UpdateSessionDiscounts(ctx, session); err != nil {
+		log.Error("failed to update session points", zap.Error(err))
+		return 0, err
+	}
+
+	s.recordSessionEvent(ctx, session, SessionEventPointsChanged,
+		intText(fromPoints), intText(session.PointsRedeemed), totalBefore)
+
+	log.Info("session points applied successfully",
+		zap.Int("points_redeemed", session.PointsRedeemed),
+	)
+	return session.PointsDiscount(), nil
+}
+
+// applyPricing recomputes tax and total after discounts. The voucher comes
+// off the subtotal first, then loyalty points (capped at what is left), and
+// tax is charged on the discounted amount. The wallet portion is clamped to
+// the new total.
+func (s *service) applyPricing(session *CheckoutSession) {
+	remaining := session.Subtotal - session.Discount
+	if remaining < 0 {
+		remaining = 0
+	}
+
+	if session.PointsDiscount() > remaining {
+		session.PointsRedeemed = remaining / loyalty.PointValue
+	}
+
+	taxable := remaining - session.PointsDiscount()
+	session.Tax = s.calculateTax(nil, session.Currency, taxable)
+	session.TotalPrice = taxable + session.Tax + session.ShippingFee + session.InsuranceFee()
+
+	if session.WalletAmount > session.TotalPrice {
+		session.WalletAmount = session.TotalPrice
+	}
+}
+
+// calculateShippingFee is the courier fee for sending every parcel to
+// address, each from its own origin.
+func (s *service) calculateShippingFee(
+	address *address.Address,
+	parcels []ShippingParcel,
+) int {
+	fee := 0
+	for _, p := range parcels {
+		rate := rateFor(p.OriginCity, address)
+		fee += rate.FirstKg + (billableKg(p.WeightGrams)-1)*rate.NextKg
+	}
+	return fee
+}
+
+// checkSessionOwner rejects edits from anyone but the session's owner: the
+// guest whose token created it, or else its signed-in user.
+func checkSessionOwner(ctx context.Context, log *zap.Logger, session *CheckoutSession) error {
+	if session.GuestID != nil {
+		if !isSessionGuest(ctx, session) {
+			log.Warn("forbidden: guest ID mismatch")
+			return ErrGuestMismatch
+		}
+		return nil
+	}
+
+	userID, _ := utils.GetUserIDFromContext(ctx)
+	if session.UserID == nil || *session.UserID != int32(userID) {
+		log.Warn("forbidden: cannot update others' sessions",
+			zap.Uint("request_user_id", userID),
+		)
+		return ErrSessionForbidden
+	}
+	return nil
+}
+
+// isSessionGuest reports whether the request carries the guest token the
+// session was created with.
+func isSessionGuest(ctx context.Context, session *CheckoutSession) bool {
+	guestID, ok := utils.GetGuestIDFromContext(ctx)
+	return ok && session.GuestID != nil && session.GuestID.String() == guestID
+}
+
+// userAddress loads addressID through the address service, which checks
+// that it is still active and belongs to userID. Any miss is ErrAddressNotFound so
+// callers cannot probe other users' addresses.
+func (s *service) userAddress(
+	ctx context.Context,
+	addressID string,
+	userID uint,
+) (*address.Address, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "userAddress"),
+		zap.String("address_id", addressID),
+		zap.Uint("user_id", userID),
+	)
+
+	id, err := uuid.Parse(addressID)
+	if err != nil {
+		log.Warn("invalid address id")
+		return nil, ErrAddressNotFound
+	}
+
+	a, err := s.addresses.GetOwned(ctx, id, userID)
+	if err != nil {
+		log.Warn("failed to get address", zap.Error(err))
+		return nil, ErrAddressNotFound
+	}
+
+	return a, nil
+}
+
+// sessionShippingFee is the fee for the session's parcels to address by
+// its shipping method. Instant delivery fails when it cannot reach the
+// address, so a session cannot keep it after moving out of reach. Self
+// pickup is free.
+func (s *service) sessionShippingFee(
+	ctx context.Context,
+	session *CheckoutSession,
+	address *address.Address,
+) (int, error) {
+	switch session.ShippingMethod {
+	case ShippingMethodInstant:
+		fee, _, err := instantQuote(session.parcels(), address)
+		return fee, err
+	case ShippingMethodSelfPickup:
+		return 0, nil
+	}
+	return s.standardShippingFee(ctx, session, address)
+}
+
+// standardShippingFee is the courier fee for the session's parcels to
+// address, waived when the subtotal reaches the free-shipping threshold of
+// the address's region.
+func (s *service) standardShippingFee(
+	ctx context.Context,
+	session *CheckoutSession,
+	address *address.Address,
+) (int, error) {
+	rule, err := s.checkoutRule(ctx, address.Province)
+	if err != nil {
+		return 0, err
+	}
+	if rule.FreeShipping(session.Subtotal) {
+		return 0, nil
+	}
+	return s.calculateShippingFee(address, session.parcels()), nil
+}
+
+// checkoutRule returns the active rule for province, or nil when no rule
+// applies.
+func (s *service) checkoutRule(ctx context.Context, province string) (*CheckoutRule, error) {
+	rules, err := s.repo.ListCheckoutRules(ctx, true)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to load checkout rules", zap.Error(err))
+		return nil, err
+	}
+	return ruleFor(rules, province), nil
+}
+
+// calculateTax is the VAT on subtotal, rounded by the currency's rule.
+func (s *service) calculateTax(
+	address *address.Address,
+	currency string,
+	subtotal int,
+) int {
+	return int(money.Share(currency, int64(subtotal), taxBasisPoints))
+}
+
+func (s *service) UpdateSessionItemQuantity(
+	ctx context.Context,
+	externalID string,
+	itemID string,
+	quantity int,
+) (*CheckoutSession, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "UpdateSessionItemQuantity"),
+		zap.String("external_id", externalID),
+		zap.String("item_id", itemID),
+		zap.Int("quantity", quantity),
+	)
+
+	log.Info("update session item quantity started")
+
+	if quantity <= 0 {
+		log.Warn("invalid quantity")
+		return nil, errors.New("quantity must be greater than zero")
+	}
+
+	return s.editSessionItems(ctx, log, externalID, itemID, quantity)
+}
+
+func (s *service) RemoveSessionItem(
+	ctx context.Context,
+	externalID string,
+	itemID string,
+) (*CheckoutSession, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "RemoveSessionItem"),
+		zap.String("external_id", externalID),
+		zap.String("item_id", itemID),
+	)
+
+	log.Info("remove session item started")
+
+	return s.editSessionItems(ctx, log, externalID, itemID, 0)
+}
+
+// editSessionItems sets the quantity of one session item, removing it when
+// quantity is 0, then reprices the session: items at current variant
+// prices, shipping for the new weight, and the voucher against the new
+// subtotal (dropped if the subtotal falls below its minimum).
+func (s *service) editSessionItems(
+	ctx context.Context,
+	log *zap.Logger,
+	externalID string,
+	itemID string,
+	quantity int,
+) (*CheckoutSession, error) {
+	session, err := s.repo.GetCheckoutSession(ctx, externalID)
+	if err != nil {
+		log.Error("failed to get checkout session", zap.Error(err))
+		return nil, err
+	}
+
+	if err := checkSessionOwner(ctx, log, session); err != nil {
+		return nil, err
+	}
+
+	if session.Status != CheckoutSessionStatusPending {
+		log.Warn("checkout session is not editable", zap.String("status", string(session.Status)))
+		return nil, errors.New("checkout session is not editable")
+	}
+
+	if time.Now().After(session.ExpiresAt) {
+		log.Warn("checkout session expired", zap.Time("expires_at", session.ExpiresAt))
+		return nil, errors.New("checkout session expired")
+	}
+
+	var (
+		kept    []CheckoutSessionItem
+		input   []*model.CheckoutSessionItemInput
+		changed *CheckoutSessionItem
+	)
+	for i := range session.Items {
+		item := session.Items[i]
+		if item.ID.String() == itemID {
+			changed = &session.Items[i]
+			if quantity == 0 {
+				continue
+			}
+			item.Quantity = quantity
+		}
+		kept = append(kept, item)
+		input = append(input, &model.CheckoutSessionItemInput{
+			VariantID: item.VariantID,
+			Quantity:  int32(item.Quantity),
+		})
+	}
+	if changed == nil {
+		log.Warn("item not in checkout session")
+		return nil, ErrSessionItemMissing
+	}
+
+	items, subtotal, parcels, err := s.buildSessionItems(ctx, log, session.Currency, input)
+	if err != nil {
+		return nil, err
+	}
+	for i := range items {
+		items[i].ID = kept[i].ID
+		items[i].SessionID = session.ID
+	}
+
+	if err := s.validateSessionItems(ctx, log, items); err != nil {
+		return nil, err
+	}
+
+	totalBefore := session.TotalPrice
+	session.Items = items
+	session.Subtotal = subtotal
+	session.ChargeableWeightGrams = parcelsWeight(parcels)
+	session.ShippingParcels = parcels
+
+	if session.AddressID != nil {
+		userID, _ := utils.GetUserIDFromContext(ctx)
+		address, err := s.userAddress(ctx, session.AddressID.String(), userID)
+		if err != nil {
+			log.Error("failed to get user address", zap.Error(err))
+			return nil, err
+		}
+		session.ShippingFee, err = s.sessionShippingFee(ctx, session, address)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if session.VoucherID != nil {
+		v, err := s.repo.GetVoucherByID(ctx, *session.VoucherID)
+		if err != nil {
+			log.Error("failed to get session voucher", zap.Error(err))
+			return nil, err
+		}
+		if int64(subtotal) < v.MinSubtotal {
+			log.Info("voucher dropped, subtotal below minimum",
+				zap.Int64("voucher_id", v.ID),
+				zap.Int64("min_subtotal", v.MinSubtotal),
+			)
+			session.VoucherID = nil
+			session.Discount = 0
+		} else {
+			session.Discount = v.DiscountFor(session.Currency, subtotal)
+		}
+	}
+
+	s.applyPricing(session)
+
+	if err := s.repo.UpdateSessionItems(ctx, session); err != nil {
+		log.Error("failed to update session items", zap.Error(err))
+		return nil, err
+	}
+
+	eventType, to := SessionEventItemQuantityChanged, itemText(changed.VariantID, quantity)
+	if quantity == 0 {
+		eventType, to = SessionEventItemRemoved, nil
+	}
+	s.recordSessionEvent(ctx, session, eventType,
+		itemText(changed.VariantID, changed.Quantity), to, totalBefore)
+
+	log.Info("session items updated successfully",
+		zap.Int("subtotal", session.Subtotal),
+		zap.Int("total_price", session.TotalPrice),
+	)
+	return session, nil
+}
+
+func (s *service) ConfirmSession(
+	ctx context.Context,
+	externalID string,
+	acceptedPolicyIDs []int32,
+) (*string, error) {
+
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "ConfirmSession"),
+		zap.String("externa_idD", externalID),
+	)
+
+	userID, _ := utils.GetUserIDFromContext(ctx)
+
+	log.Info("confirm checkout session started")
+
+	// 1. Load session (with items)
+	session, err := s.repo.GetCheckoutSession(ctx, externalID)
+	if err != nil {
+		log.Error("failed to load checkout session", zap.Error(err))
+		return nil, err
+	}
+
+	log.Debug("checkout session loaded",
+		zap.String("status", string(session.Status)),
+		zap.Int("items_count", len(session.Items)),
+	)
+
+	// 2. Ownership check
+	if session.GuestID != nil && !isSessionGuest(ctx, session) {
+		log.Warn("ownership check failed: guest ID mismatch")
+		return nil, ErrGuestMismatch
+	}
+	if session.UserID != nil && *session.UserID != int32(userID) {
+		log.Warn("ownership check failed",
+			zap.Int32("session_user_id", *session.UserID),
+			zap.Uint("request_user_id", userID),
+		)
+		return nil, ErrForbidden
+	}
+	if session.UserID != nil {
+		if err := s.checkCanCheckout(ctx, uint(*session.UserID)); err != nil {
+			return nil, err
+		}
+	}
+
+	// 3. Validate state
+	if session.Status != CheckoutSessionStatusPending {
+		log.Warn("invalid session status",
+			zap.String("status", string(session.Status)),
+		)
+		return nil, errors.New("checkout session already confirmed")
+	}
+
+	if time.Now().After(session.ExpiresAt) {
+		log.Warn("checkout session expired",
+			zap.Time("expires_at", session.ExpiresAt),
+		)
+		return nil, errors.New("checkout session expired")
+	}
+
+	policies, err := s.repo.ListCurrentPolicies(ctx)
+	if err != nil {
+		log.Error("failed to load current policies", zap.Error(err))
+		return nil, err
+	}
+	if missing := unacceptedPolicies(policies, acceptedPolicyIDs); len(missing) > 0 {
+		log.Warn("current policies not accepted",
+			zap.String("kind", string(missing[0].Kind)),
+			zap.Int("version", missing[0].Version),
+		)
+		return nil, ErrPolicyNotAccepted
+	}
+
+	// The phone may have changed since cash on delivery was chosen
+	if session.PaymentMethod != nil && *session.PaymentMethod == payment.MethodCOD {
+		if err := s.requireVerifiedPhone(ctx, session.UserID); err != nil {
+			log.Warn("cash on delivery not allowed", zap.Error(err))
+			return nil, err
+		}
+	}
+
+	if session.AddressID == nil {
+		log.Warn("shipping address not set")
+		return nil, errors.New("shipping address not set")
+	}
+
+	address, err := s.userAddress(ctx, session.AddressID.String(), userID)
+	if err != nil {
+		log.Error("failed to get shipping address", zap.Error(err))
+		return nil, err
+	}
+	rule, err := s.checkoutRule(ctx, address.Province)
+	if err != nil {
+		return nil, err
+	}
+	if rule.BelowMinimum(session.Subtotal) {
+		log.Warn("subtotal below minimum order amount",
+			zap.Int("subtotal", session.Subtotal),
+			zap.Int("min_order_amount", rule.MinOrderAmount),
+		)
+		return nil, ErrBelowMinimumOrder
+	}
+
+	var delivery *DeliveryBooking
+	if session.ShippingMethod == ShippingMethodInstant {
+		if err := s.checkInstantReach(ctx, session, address); err != nil {
+			log.Warn("instant delivery no longer reaches the address", zap.Error(err))
+			return nil, err
+		}
+		delivery, err = s.confirmedDelivery(ctx, session, address.Province)
+		if err != nil {
+			log.Warn("delivery slot can no longer be booked", zap.Error(err))
+			return nil, err
+		}
+	}
+
+	var pickup *OrderPickup
+	if session.ShippingMethod == ShippingMethodSelfPickup {
+		pickup, err = s.bookedPickup(ctx, session)
+		if err != nil {
+			log.Warn("pickup slot can no longer be booked", zap.Error(err))
+			return nil, err
+		}
+	}
+
+	// Pricing may have changed since the wallet was applied (e.g. address)
+	if session.WalletAmount > 0 && (session.UserID == nil || session.WalletAmount > session.TotalPrice) {
+		log.Warn("wallet amount no longer valid for session",
+			zap.Int("wallet_amount", session.WalletAmount),
+			zap.Int("total_price", session.TotalPrice),
+		)
+		return nil, ErrInvalidWalletUse
+	}
+
+	// 4. Re-validate stock & price
+	if err := s.validateSessionItems(ctx, log, session.Items); err != nil {
+		return nil, err
+	}
+
+	log.Info("stock validation passed")
+
+	order, err := s.placeOrder(ctx, log, session, nil, pickup, delivery, policies)
+	if err != nil {
+		return nil, err
+	}
+	externalOrderID := order.ExternalID
+
+	// 7. Process payment
+	_, err = s.OrderToPaymentProcess(ctx, session, externalOrderID, uint(order.ID))
+	if err != nil {
+		log.Error("failed to process order to payment", zap.Error(err))
+		return nil, err
+	}
+
+	log.Info("checkout session confirmed successfully",
+		zap.String("final_status", string(session.Status)),
+	)
+
+	return &externalOrderID, nil
+}
+
+// validateSessionItems checks a session can still be fulfilled: it has
+// items, every one is still sold and in stock, and no seller is on
+// vacation. Run on confirmation and on item edits.
+func (s *service) validateSessionItems(
+	ctx context.Context,
+	log *zap.Logger,
+	items []CheckoutSessionItem,
+) error {
+	if len(items) == 0 {
+		log.Warn("checkout session has no items")
+		return ErrSessionEmpty
+	}
+
+	variantIDs := make([]string, 0, len(items))
+	for _, item := range items {
+		variantIDs = append(variantIDs, item.VariantID)
+	}
+	inactive, err := s.repo.InactiveVariants(ctx, variantIDs)
+	if err != nil {
+		log.Error("failed to check inactive variants", zap.Error(err))
+		return err
+	}
+	if len(inactive) > 0 {
+		log.Warn("variant no longer sold", zap.Strings("variant_ids", inactive))
+		return ErrVariantUnavailable
+	}
+
+	away, err := s.repo.VariantsOnVacation(ctx, variantIDs)
+	if err != nil {
+		log.Error("failed to check seller vacations", zap.Error(err))
+		return err
+	}
+	if len(away) > 0 {
+		log.Warn("seller on vacation", zap.Strings("variant_ids", away))
+		return ErrSellerOnVacation
+	}
+
+	for _, item := range items {
+		ok, err := s.repo.ValidateVariantStock(
+			ctx,
+			item.VariantID,
+			item.Quantity,
+		)
+		if err != nil {
+			log.Error("failed to validate variant stock",
+				zap.String("variant_id", item.VariantID),
+				zap.Int("quantity", item.Quantity),
+				zap.Error(err),
+			)
+			return err
+		}
+		if !ok {
+			log.Warn("product out of stock",
+				zap.String("variant_id", item.VariantID),
+				zap.Int("quantity", item.Quantity),
+			)
+			return errors.New("product out of stock")
+		}
+	}
+
+	return nil
+}
+
+// placeOrder creates the order for a confirmed-to-be session, allocating
+// stock, booking pickup or the delivery slot when set and recording the
+// accepted policies, and marks the session confirmed. An order that already exists for the session is
+// returned as is, so a failed payment step can be retried.
+func (s *service) placeOrder(
+	ctx context.Context,
+	log *zap.Logger,
+	session *CheckoutSession,
+	placedBy *int32,
+	pickup *OrderPickup,
+	delivery *DeliveryBooking,
+	policies []*Policy,
+) (*Order, error) {
+	// Idempotency check: see if an order already exists for this session.
+	// This handles retries if the payment gateway call fails after order creation.
+	order, err := s.repo.GetOrderBySessionID(ctx, session.ID)
+	if err != nil {
+		// This is an actual DB error, not a "not found" case.
+		log.Error("failed to check for existing order by session ID", zap.Error(err))
+		return nil, err
+	}
+
+	if order != nil {
+		// Order already exists, this is a retry.
+		log.Info("order already exists for this session, retrying payment process", zap.Int32("order_id", order.ID))
+		return order, nil
+	}
+
+	// Order does not exist, this is the first attempt.
+	log.Info("creating new order for session")
+
+	order = &Order{
+		UserID:       session.UserID,
+		TotalAmount:  uint(session.TotalPrice),
+		WalletAmount: uint(session.WalletAmount),
+		Currency:     session.Currency,
+		Status:       OrderStatus(model.OrderStatusPendingPayment),
+		ExternalID:   utils.ExternalIDFromSession("pay", session.ID.String()),
+		PlacedBy:     placedBy,
+
+		ShippingMethod: session.ShippingMethod,
+		InsuranceFee:   uint(session.InsuranceFee()),
+		Pickup:         pickup,
+		Delivery:       delivery,
+
+		AcceptedPolicies: policies,
+	}
+
+	if err := s.repo.CreateOrderTx(ctx, order, session); err != nil {
+		log.Error("failed to create order in transaction", zap.Error(err))
+		return nil, err
+	}
+
+	if err := s.repo.ConfirmCheckoutSession(ctx, session); err != nil {
+		log.Error("failed to confirm checkout session", zap.Error(err))
+		// Note: At this point, an order exists but the session isn't marked as confirmed.
+		// The idempotency check above will handle retries correctly.
+		return nil, err
+	}
+
+	return order, nil
+}
+
+func (s *service) GetSession(
+	ctx context.Context,
+	externalID string,
+) (*CheckoutSession, error) {
+
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "GetSession"),
+		zap.String("external_id", externalID),
+	)
+
+	log.Info("get checkout session started")
+
+	userID, ok := utils.GetUserIDFromContext(ctx)
+	session, err := s.repo.GetCheckoutSession(ctx, externalID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("checkout session not found")
+			return nil, errors.New("checkout session not found")
+		}
+		log.Error("failed to get checkout session", zap.Error(err))
+		return nil, errors.New("failed to get checkout session")
+	}
+
+	// Ownership check: guest sessions only open with their guest token
+	if session.GuestID != nil && !isSessionGuest(ctx, session) {
+		log.Warn("forbidden access to guest checkout session")
+		return nil, ErrGuestMismatch
+	}
+	if session.UserID != nil && ok {
+		if *session.UserID != int32(userID) {
+			log.Warn("forbidden access to checkout session",
+				zap.Int32("session_user_id", *session.UserID),
+				zap.Uint("request_user_id", userID),
+			)
+			return nil, ErrForbidden
+		}
+	}
+
+	// Expiration handling (soft)
+	if time.Now().After(session.ExpiresAt) &&
+		SessionStatuses.Can(session.Status, CheckoutSessionStatusExpired) {
+
+		log.Info("marking session as expired", zap.String("session_id", session.ID.String()))
+		// Optional: mark expired lazily
+		if err := SessionStatuses.Fire(ctx, session, session.Status, CheckoutSessionStatusExpired, func(ctx context.Context) error {
+			return s.repo.MarkSessionExpired(ctx, session.ID)
+		}); err != nil {
+			log.Error("failed to mark session expired", zap.Error(err))
+		}
+		session.Status = CheckoutSessionStatusExpired
+	}
+
+	log.Info("checkout session retrieved successfully")
+
+	return session, nil
+}
+
+func (s *service) GetActiveSession(ctx context.Context) (*CheckoutSession, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "GetActiveSession"),
+	)
+
+	userID, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthorized
+	}
+
+	externalID, err := s.repo.GetActiveSessionExternalID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if externalID == "" {
+		return nil, nil
+	}
+
+	session, err := s.repo.GetCheckoutSession(ctx, externalID)
+	if err != nil {
+		log.Error("failed to load active checkout session", zap.Error(err))
+		return nil, err
+	}
+
+	return session, nil
+}
+
+func (s *service) ListSessionEvents(
+	ctx context.Context,
+	externalID string,
+) ([]*SessionEvent, error) {
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	session, err := s.repo.GetCheckoutSession(ctx, externalID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.repo.ListSessionEvents(ctx, session.ID)
+}
+
+// recordSessionEvent adds a change to the session's log. The change itself
+// is already saved, so a failure here is logged rather than returned.
+func (s *service) recordSessionEvent(
+	ctx context.Context,
+	session *CheckoutSession,
+	eventType SessionEventType,
+	from, to *string,
+	totalBefore int,
+) {
+	event := &SessionEvent{
+		SessionID:   session.ID,
+		Type:        eventType,
+		FromValue:   from,
+		ToValue:     to,
+		TotalBefore: totalBefore,
+		TotalAfter:  session.TotalPrice,
+	}
+
+	if userID, ok := utils.GetUserIDFromContext(ctx); ok && userID != 0 {
+		id := int32(userID)
+		event.ActorUserID = &id
+		if role := utils.GetUserRoleFromContext(ctx); role != "" {
+			event.ActorRole = &role
+		}
+	} else {
+		event.ActorGuestID = session.GuestID
+	}
+
+	if err := s.repo.InsertSessionEvent(ctx, event); err != nil {
+		logger.FromCtx(ctx).Warn("failed to record session event",
+			zap.String("session_id", session.ID.String()),
+			zap.String("event_type", string(eventType)),
+			zap.Error(err),
+		)
+	}
+}
+
+func uuidText(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+	v := id.String()
+	return &v
+}
+
+func itemText(variantID string, quantity int) *string {
+	v := fmt.Sprintf("%s x%d", variantID, quantity)
+	return &v
+}
+
+func intText(n int) *string {
+	v := strconv.Itoa(n)
+	return &v
+}
+
+func (s *service) GetPaymentOrderInfo(
+	ctx context.Context,
+	externalID string,
+) (*PaymentOrderInfoResponse, error) {
+
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "GetPaymentOrderInfo"),
+		zap.String("external_id", externalID),
+	)
+
+	log.Info("get payment order info started")
+
+	userID, ok := utils.GetUserIDFromContext(ctx)
+
+	order, err := s.repo.GetOrderByExternalID(ctx, externalID)
+	if err != nil {
+		log.Error("failed to get order", zap.Error(err))
+		return nil, errors.New("failed to get order")
+	}
+
+	if order == nil {
+		log.Warn("order not found")
+		return nil, ErrOrderNotFound
+	}
+
+	// Ownership check
+	if order.UserID != nil {
+		if !ok {
+			log.Warn("unauthorized access to payment info")
+			return nil, ErrForbidden
+		}
+		if *order.UserID != int32(userID) {
+			log.Warn("forbidden access to payment info",
+				zap.Int32("order_user_id", *order.UserID),
+				zap.Uint("request_user_id", userID),
+			)
+			return nil, ErrForbidden
+		}
+	}
+
+	paymentData, err := s.paymentRepo.GetPaymentByOrder(ctx, uint(order.ID))
+	if err != nil {
+		log.Error("failed to get payment data", zap.Error(err))
+		return nil, errors.New("failed to get payment data")
+	}
+
+	address, err := s.addresses.GetByID(ctx, order.AddressID)
+	if err != nil {
+		log.Error("failed to get address", zap.Error(err))
+		return nil, errors.New("failed to get address")
+	}
+
+	// The timeline is secondary to the payment itself; without the
+	// webhooks it still shows when the payment was created.
+	events, err := s.paymentRepo.ListWebhookEvents(ctx, payment.ProviderXendit, externalID, paymentData.ExternalReference)
+	if err != nil {
+		log.Warn("failed to get payment webhooks", zap.Error(err))
+	}
+
+	instructions := payment.GetInstructions(paymentData.PaymentMethod)
+	instructions = payment.InjectVariables(
+		instructions,
+		payment.InstructionVars{
+			"amount":       utils.FormatIDR(int64(order.TotalAmount)),
+			"payment_code": paymentData.PaymentCode,
+		},
+	)
+
+	var paymentCode, invoiceURL *string
+	if paymentData.PaymentCode != "" {
+		paymentCode = &paymentData.PaymentCode
+	}
+	if paymentData.InvoiceURL != "" {
+		invoiceURL = &paymentData.InvoiceURL
+	}
+
+	paymentInfo := &PaymentOrderInfoResponse{
+		OrderExternalID: externalID,
+		Status:          PaymentStatus(paymentData.Status),
+		TotalAmount:     int(order.TotalAmount),
+		Currency:        order.Currency,
+		ExpiresAt:       paymentData.ExpireAt,
+		ShippingAddress: ShippingAddress{
+			Name:         address.Name,
+			ReceiverName: address.ReceiverName,
+			Phone:        address.Phone,
+			Address1:     address.Address1,
+			Address2:     address.Address2,
+			City:         address.City,
+			Province:     address.Province,
+			PostalCode:   address.Postal,
+		},
+		Payment: PaymentDetail{
+			Method:       paymentData.PaymentMethod,
+			PaymentCode:  paymentCode,
+			InvoiceURL:   invoiceURL,
+			ReferenceID:  paymentData.ExternalReference,
+			Instructions: instructions,
+		},
+		Timeline: payment.Timeline(paymentData, events),
+	}
+	if paymentInfo.Status == PaymentStatusPending {
+		paymentInfo.SecondsRemaining = secondsUntil(paymentData.ExpireAt, time.Now())
+	}
+
+	log.Info("payment order info retrieved successfully")
+
+	return paymentInfo, nil
+}
+
+func (s *service) GetOrderForWebhook(
+	ctx context.Context,
+	externalID string,
+) (*Order, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "GetOrderForWebhook"),
+		zap.String("external_id", externalID),
+	)
+
+	// Gateway retries come in bursts; serve them from the short-lived cache
+	if order, ok := s.webhookOrders.get(externalID); ok {
+		log.Debug("order for webhook served from cache")
+		return order, nil
+	}
+
+	order, err := s.repo.GetOrderByExternalID(ctx, externalID)
+	if err != nil {
+		log.Error("failed to get order for webhook", zap.Error(err))
+		return nil, errors.New("failed to get order")
+	}
+	if order == nil {
+		log.Warn("order not found for webhook")
+		return nil, ErrOrderNotFound
+	}
+
+	s.webhookOrders.set(externalID, order)
+	return order, nil
+}
+
+func (s *service) CreateAdminOrder(
+	ctx context.Context,
+	input model.CreateAdminOrderInput,
+) (*Order, *payment.PaymentResponse, error) {
+
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "CreateAdminOrder"),
+		zap.String("customer_id", input.UserID),
+		zap.String("payment", input.Payment.String()),
+	)
+
+	log.Info("create admin order started")
+
+	adminID, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	customerID, err := utils.ToUint(input.UserID)
+	if err != nil || customerID == 0 {
+		log.Warn("invalid customer id")
+		return nil, nil, ErrInvalidAdminOrder
+	}
+
+	if len(input.Items) == 0 {
+		log.Warn("admin order has no items")
+		return nil, nil, ErrInvalidAdminOrder
+	}
+
+	if input.ShippingFee != nil && *input.ShippingFee < 0 {
+		log.Warn("negative shipping fee override")
+		return nil, nil, ErrInvalidAdminOrder
+	}
+
+	var paymentMethod *payment.ChannelCode
+	if input.Payment == model.AdminOrderPaymentPaymentLink && input.PaymentMethod != nil {
+		m := payment.ChannelCode(*input.PaymentMethod)
+		if !gatewayMethod(m) {
+			log.Warn("invalid payment method", zap.String("payment_method", *input.PaymentMethod))
+			return nil, nil, fmt.Errorf("invalid payment method: %s", m)
+		}
+		if m == payment.MethodCOD {
+			uid := int32(customerID)
+			if err := s.requireVerifiedPhone(ctx, &uid); err != nil {
+				log.Warn("cash on delivery not allowed", zap.Error(err))
+				return nil, nil, err
+			}
+		}
+		paymentMethod = &m
+	}
+
+	// The address must belong to the customer, not the admin
+	address, err := s.userAddress(ctx, input.AddressID, customerID)
+	if err != nil {
+		log.Warn("failed to get customer address", zap.Error(err))
+		return nil, nil, err
+	}
+
+	items, subtotal, parcels, err := s.buildSessionItems(ctx, log, money.DefaultCurrency, input.Items)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	sessionID := uuid.New()
+	uid := int32(customerID)
+
+	session := &CheckoutSession{
+		ID:         sessionID,
+		ExternalID: utils.ExternalIDFromSession("ck", sessionID.String()),
+		UserID:     &uid,
+		Status:     CheckoutSessionStatusPending,
+		Subtotal:   subtotal,
+		AddressID:  &address.ID,
+		ExpiresAt:  time.Now().Add(30 * time.Minute),
+
+		ChargeableWeightGrams: parcelsWeight(parcels),
+		ShippingParcels:       parcels,
+		ShippingMethod:        ShippingMethodStandard,
+	}
+
+	session.ShippingFee = s.calculateShippingFee(address, parcels)
+	if input.ShippingFee != nil {
+		session.ShippingFee = int(*input.ShippingFee)
+	}
+	s.applyPricing(session)
+
+	log = log.With(zap.String("session_id", session.ID.String()))
+
+	// Same session records as a storefront checkout, so the order is
+	// traceable and placed through the same path
+	if err := s.repo.CreateCheckoutSession(ctx, session, items); err != nil {
+		log.Error("failed to create checkout session", zap.Error(err))
+		return nil, nil, err
+	}
+	if err := s.repo.UpdateSessionAddressAndPricing(ctx, session); err != nil {
+		log.Error("failed to set session address and pricing", zap.Error(err))
+		return nil, nil, err
+	}
+	if paymentMethod != nil {
+		if err := s.repo.UpdateSessionPaymentMethod(ctx, session.ID, *paymentMethod); err != nil {
+			log.Error("failed to set session payment method", zap.Error(err))
+			return nil, nil, err
+		}
+	}
+
+	// Reload so the session carries its stored items and defaults
+	session, err = s.repo.GetCheckoutSession(ctx, session.ExternalID)
+	if err != nil {
+		log.Error("failed to reload checkout session", zap.Error(err))
+		return nil, nil, err
+	}
+
+	placedBy := int32(adminID)
+	order, err := s.placeOrder(ctx, log, session, &placedBy, nil, nil, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	log = log.With(zap.Int32("order_id", order.ID))
+
+	if input.Payment == model.AdminOrderPaymentOffline {
+		if err := s.repo.SaveOfflinePayment(ctx, order); err != nil {
+			return nil, nil, err
+		}
+		ref := payment.OfflineReference(order.ExternalID)
+		capture := payment.Capture{ExternalReference: ref, ProviderPaymentID: ref}
+		if err := s.MarkAsPaid(ctx, order.ExternalID, capture); err != nil {
+			log.Error("failed to settle offline payment", zap.Error(err))
+			return nil, nil, err
+		}
+		order.Status = OrderStatusPaid
+
+		log.Info("admin order created and paid offline")
+		return order, &payment.PaymentResponse{
+			ReferenceID:   order.ExternalID,
+			Amount:        int64(order.TotalAmount),
+			Status:        string(PaymentStatusPaid),
+			PaymentMethod: payment.MethodOffline,
+		}, nil
+	}
+
+	payResp, err := s.OrderToPaymentProcess(ctx, session, order.ExternalID, uint(order.ID))
+	if err != nil {
+		log.Error("failed to create payment link", zap.Error(err))
+		return nil, nil, err
+	}
+
+	log.Info("admin order created with payment link")
+	return order, payResp, nil
+}
+
+// gatewayMethod reports whether m can be collected through the gateway.
+func gatewayMethod(m payment.ChannelCode) bool {
+	switch m {
+	case payment.MethodBCAVA,
+		payment.MethodBNIVA,
+		payment.MethodMandiriVA,
+		payment.MethodQRIS,
+		payment.MethodCOD,
+		payment.MethodOVO,
+		payment.MethodDANA,
+		payment.MethodLINKAJA,
+		payment.MethodSHOPEE,
+		payment.MethodGOPAY,
+		payment.MethodAlfamart,
+		payment.MethodIndomaret,
+		payment.MethodCreditCard:
+		return true
+	}
+	return false
+}
+
+// requireVerifiedPhone fails with ErrCODPhoneUnverified unless userID has
+// a verified phone, which cash on delivery needs so the courier can reach
+// the customer. Guests have none.
+func (s *service) requireVerifiedPhone(ctx context.Context, userID *int32) error {
+	if userID == nil {
+		return ErrCODPhoneUnverified
+	}
+	profile, err := s.userRepo.GetProfile(ctx, uint(*userID))
+	if err != nil {
+		if errors.Is(err, user.ErrProfileNotFound) {
+			return ErrCODPhoneUnverified
+		}
+		return err
+	}
+	if profile.Phone == nil || profile.PhoneVerifiedAt == nil {
+		return ErrCODPhoneUnverified
+	}
+	return nil
+}
+
+func requireAdmin(ctx context.Context) (uint, error) {
+	userID, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		return 0, ErrUnauthorized
+	}
+	if !utils.IsAdmin(ctx) {
+		return 0, ErrForbidden
+	}
+	return userID, nil
+}
+
+func (s *service) CheckoutRules(ctx context.Context, all bool) ([]*CheckoutRule, error) {
+	if all {
+		if _, err := requireAdmin(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return s.repo.ListCheckoutRules(ctx, !all)
+}
+
+func (s *service) SetCheckoutRule(
+	ctx context.Context,
+	input model.SetCheckoutRuleInput,
+) (*CheckoutRule, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "SetCheckoutRule"),
+	)
+
+	adminID, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rule := &CheckoutRule{
+		MinOrderAmount: int(input.MinOrderAmount),
+		IsActive:       input.IsActive,
+	}
+	if input.Region != nil {
+		region := strings.TrimSpace(*input.Region)
+		if region == "" {
+			log.Warn("blank rule region")
+			return nil, ErrInvalidRule
+		}
+		rule.Region = &region
+	}
+	if input.FreeShippingMin != nil {
+		threshold := int(*input.FreeShippingMin)
+		rule.FreeShippingMin = &threshold
+	}
+	if rule.MinOrderAmount < 0 || (rule.FreeShippingMin != nil && *rule.FreeShippingMin < 0) {
+		log.Warn("negative rule amount")
+		return nil, ErrInvalidRule
+	}
+
+	saved, err := s.repo.UpsertCheckoutRule(ctx, rule, adminID)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("checkout rule saved",
+		zap.Int32("rule_id", saved.ID),
+		zap.Int("min_order_amount", saved.MinOrderAmount),
+	)
+	return saved, nil
+}
+
+func (s *service) PickupLocations(ctx context.Context, all bool) ([]*PickupLocation, error) {
+	if all {
+		if _, err := requireAdmin(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return s.repo.ListPickupLocations(ctx, !all)
+}
+
+func (s *service) SetPickupLocation(
+	ctx context.Context,
+	input model.SetPickupLocationInput,
+) (*PickupLocation, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "SetPickupLocation"),
+	)
+
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	location := &PickupLocation{
+		Name:        strings.TrimSpace(input.Name),
+		Address:     strings.TrimSpace(input.Address),
+		City:        strings.TrimSpace(input.City),
+		OpensAt:     strings.TrimSpace(input.OpensAt),
+		ClosesAt:    strings.TrimSpace(input.ClosesAt),
+		SlotMinutes: int(input.SlotMinutes),
+		IsActive:    input.IsActive,
+	}
+	if input.ID != nil {
+		id, err := ParsePickupLocationID(*input.ID)
+		if err != nil {
+			return nil, err
+		}
+		location.ID = id
+	}
+
+	opens, err := parseClock(location.OpensAt)
+	if err != nil {
+		log.Warn("invalid opening time", zap.String("opens_at", location.OpensAt))
+		return nil, ErrInvalidPickupLocation
+	}
+	closes, err := parseClock(location.ClosesAt)
+	if err != nil {
+		log.Warn("invalid closing time", zap.String("closes_at", location.ClosesAt))
+		return nil, ErrInvalidPickupLocation
+	}
+	slot := time.Duration(location.SlotMinutes) * time.Minute
+	if location.Name == "" || location.Address == "" || location.City == "" ||
+		location.SlotMinutes < 15 || location.SlotMinutes > 240 || opens+slot > closes {
+		log.Warn("invalid pickup location")
+		return nil, ErrInvalidPickupLocation
+	}
+
+	saved, err := s.repo.SavePickupLocation(ctx, location)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("pickup location saved",
+		zap.Int32("pickup_location_id", saved.ID),
+		zap.Bool("is_active", saved.IsActive),
+	)
+	return saved, nil
+}
+
+func (s *service) PickupSlots(ctx context.Context, locationID int32) ([]PickupSlot, error) {
+	location, err := s.repo.GetPickupLocation(ctx, locationID)
+	if err != nil {
+		return nil, err
+	}
+	if !location.IsActive {
+		return nil, ErrPickupLocationNotFound
+	}
+	return location.slots(s.now(), s.loc), nil
+}
+
+func (s *service) VerifyPickupCode(ctx context.Context, orderID uint, code string) error {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "VerifyPickupCode"),
+		zap.Uint("order_id", orderID),
+	)
+
+	adminID, err := requireAdmin(ctx)
+	if err != nil {
+		return err
+	}
+
+	order, err := s.repo.GetOrderDetail(ctx, orderID)
+	if err != nil {
+		return err
+	}
+	if order.ShippingMethod != ShippingMethodSelfPickup {
+		return ErrNotPickupOrder
+	}
+	if order.Status != OrderStatusReadyForPickup {
+		log.Warn("order not ready for pickup", zap.String("status", string(order.Status)))
+		return ErrNotReadyForPickup
+	}
+
+	pickup, err := s.repo.GetOrderPickup(ctx, orderID)
+	if err != nil {
+		return err
+	}
+	if pickup == nil {
+		log.Error("self pickup order has no pickup")
+		return ErrNotPickupOrder
+	}
+	if !pickupCodeMatches(pickup.Code, strings.TrimSpace(code)) {
+		log.Warn("pickup code mismatch")
+		return ErrPickupCodeMismatch
+	}
+
+	change := orderChange{
+		svc:            s,
+		orderID:        orderID,
+		externalID:     order.ExternalID,
+		userID:         order.UserID,
+		method:         order.ShippingMethod,
+		pickupVerified: true,
+	}
+	err = OrderStatuses.Fire(ctx, change, order.Status, OrderStatusCompleted, func(ctx context.Context) error {
+		return s.repo.CompletePickup(ctx, orderID, adminID)
+	})
+	if err != nil {
+		log.Warn("failed to complete pickup", zap.Error(err))
+		return err
+	}
+
+	log.Info("order handed over at pickup", zap.Uint("admin_id", adminID))
+	return nil
+}
+
+func (s *service) DeliverySlots(ctx context.Context, externalID string) ([]*DeliverySlotOption, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "DeliverySlots"),
+		zap.String("external_id", externalID),
+	)
+
+	session, err := s.repo.GetCheckoutSession(ctx, externalID)
+	if err != nil {
+		log.Error("failed to get checkout session", zap.Error(err))
+		return nil, err
+	}
+
+	if err := checkSessionOwner(ctx, log, session); err != nil {
+		return nil, err
+	}
+
+	if session.AddressID == nil {
+		return nil, ErrShippingAddressNotSet
+	}
+
+	userID, _ := utils.GetUserIDFromContext(ctx)
+	address, err := s.userAddress(ctx, session.AddressID.String(), userID)
+	if err != nil {
+		log.Error("failed to get user address", zap.Error(err))
+		return nil, err
+	}
+
+	return s.deliveryOptions(ctx, address.Province)
+}
+
+func (s *service) AdminDeliverySlots(ctx context.Context, region *string) ([]*DeliverySlot, error) {
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return s.repo.ListDeliverySlots(ctx, region, false)
+}
+
+func (s *service) SetDeliverySlot(
+	ctx context.Context,
+	input model.SetDeliverySlotInput,
+) (*DeliverySlot, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "SetDeliverySlot"),
+	)
+
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	slot := &DeliverySlot{
+		Region:   strings.TrimSpace(input.Region),
+		StartsAt: strings.TrimSpace(input.StartsAt),
+		EndsAt:   strings.TrimSpace(input.EndsAt),
+		Capacity: int(input.Capacity),
+		IsActive: input.IsActive,
+	}
+	if input.ID != nil {
+		id, err := ParseDeliverySlotID(*input.ID)
+		if err != nil {
+			return nil, err
+		}
+		slot.ID = id
+	}
+
+	starts, err := parseClock(slot.StartsAt)
+	if err != nil {
+		log.Warn("invalid slot start", zap.String("starts_at", slot.StartsAt))
+		return nil, ErrInvalidDeliverySlot
+	}
+	ends, err := parseClock(slot.EndsAt)
+	if err != nil {
+		log.Warn("invalid slot end", zap.String("ends_at", slot.EndsAt))
+		return nil, ErrInvalidDeliverySlot
+	}
+	if slot.Region == "" || slot.Capacity < 0 || starts >= ends {
+		log.Warn("invalid delivery slot")
+		return nil, ErrInvalidDeliverySlot
+	}
+
+	saved, err := s.repo.SaveDeliverySlot(ctx, slot)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("delivery slot saved",
+		zap.Int32("delivery_slot_id", saved.ID),
+		zap.String("region", saved.Region),
+		zap.Int("capacity", saved.Capacity),
+	)
+	return saved, nil
+}
+
+func (s *service) CurrentPolicies(ctx context.Context) ([]*Policy, error) {
+	return s.repo.ListCurrentPolicies(ctx)
+}
+
+func (s *service) PolicyHistory(ctx context.Context, kind PolicyKind) ([]*Policy, error) {
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if !validPolicyKind(kind) {
+		return nil, ErrInvalidPolicy
+	}
+	return s.repo.ListPolicyVersions(ctx, kind)
+}
+
+// PublishPolicy publishes the next version of a policy. Checkouts must
+// accept it from then on; orders already placed keep the version they
+// were placed under.
+func (s *service) PublishPolicy(
+	ctx context.Context,
+	input model.PublishPolicyInput,
+) (*Policy, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "PublishPolicy"),
+	)
+
+	adminID, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	publishedBy := int32(adminID)
+	policy := &Policy{
+		Kind:        PolicyKind(input.Kind),
+		Title:       strings.TrimSpace(input.Title),
+		Body:        strings.TrimSpace(input.Body),
+		PublishedBy: &publishedBy,
+	}
+	if !validPolicyKind(policy.Kind) || policy.Title == "" || policy.Body == "" {
+		log.Warn("invalid policy", zap.String("kind", string(policy.Kind)))
+		return nil, ErrInvalidPolicy
+	}
+
+	saved, err := s.repo.PublishPolicy(ctx, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("policy published",
+		zap.Int32("policy_id", saved.ID),
+		zap.String("kind", string(saved.Kind)),
+		zap.Int("version", saved.Version),
+	)
+	return saved, nil
+}
+
+func (s *service) OrderReasons(ctx context.Context, kind *ReasonKind, all bool) ([]*Reason, error) {
+	if all {
+		if _, err := requireAdmin(ctx); err != nil {
+			return nil, err
+		}
+	}
+	if kind != nil && !validReasonKind(*kind) {
+		return nil, ErrInvalidReason
+	}
+	return s.repo.ListReasons(ctx, kind, !all)
+}
+
+// SetOrderReason adds a reason or edits one. Orders keep pointing at
+// the reason they were given, so relabelling one relabels them too.
+func (s *service) SetOrderReason(
+	ctx context.Context,
+	input model.SetOrderReasonInput,
+) (*Reason, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "SetOrderReason"),
+	)
+
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	reason := &Reason{
+		Code:     strings.ToUpper(strings.TrimSpace(input.Code)),
+		Kind:     ReasonKind(input.Kind),
+		Audience: ReasonAudience(input.Audience),
+		Label:    strings.TrimSpace(input.Label),
+		Position: int(input.Position),
+		IsActive: input.IsActive,
+	}
+	if input.ID != nil {
+		id, err := ParseReasonID(*input.ID)
+		if err != nil {
+			return nil, err
+		}
+		reason.ID = id
+	}
+	if !validReasonCode(reason.Code) || reason.Label == "" || len(reason.Label) > 200 ||
+		!validReasonKind(reason.Kind) || !validReasonAudience(reason.Audience) {
+		log.Warn("invalid order reason", zap.String("code", reason.Code))
+		return nil, ErrInvalidReason
+	}
+
+	saved, err := s.repo.SaveReason(ctx, reason)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("order reason saved",
+		zap.Int32("reason_id", saved.ID),
+		zap.String("code", saved.Code),
+		zap.Bool("is_active", saved.IsActive),
+	)
+	return saved, nil
+}
+
+func (s *service) OrderReasonStats(ctx context.Context, kind ReasonKind, from, to time.Time) ([]*ReasonStat, error) {
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if !validReasonKind(kind) || !from.Before(to) {
+		return nil, ErrInvalidReason
+	}
+	return s.repo.ReasonStats(ctx, kind, from, to)
+}
+
+func (s *service) RequestOrderAdjustment(
+	ctx context.Context,
+	input model.RequestOrderAdjustmentInput,
+) (*OrderAdjustment, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "RequestOrderAdjustment"),
+		zap.String("order_id", input.OrderID),
+	)
+
+	adminID, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	orderID, err := utils.ToUint(input.OrderID)
+	if err != nil {
+		return nil, ErrOrderNotFound
+	}
+
+	a := &OrderAdjustment{
+		OrderID:     int32(orderID),
+		Kind:        AdjustmentKind(input.Kind),
+		Amount:      int64(input.Amount),
+		Reason:      strings.TrimSpace(input.Reason),
+		RequestedBy: int32(adminID),
+	}
+	if !validAdjustmentKind(a.Kind) || a.Amount <= 0 || a.Reason == "" || len(a.Reason) > maxAdjustmentReason {
+		log.Warn("invalid order adjustment", zap.String("kind", string(a.Kind)), zap.Int64("amount", a.Amount))
+		return nil, ErrInvalidAdjustment
+	}
+
+	o, _, err := s.repo.GetAdjustableOrder(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if err := canAdjust(o, a); err != nil {
+		log.Warn("order cannot take the adjustment", zap.String("status", string(o.Status)), zap.Error(err))
+		return nil, err
+	}
+
+	saved, err := s.repo.CreateOrderAdjustment(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+
+	log.Info("order adjustment requested",
+		zap.Int64("adjustment_id", saved.ID),
+		zap.String("kind", string(saved.Kind)),
+		zap.Int64("amount", saved.Amount),
+	)
+	return saved, nil
+}
+
+func (s *service) ApproveOrderAdjustment(ctx context.Context, id int64) (*OrderAdjustment, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "ApproveOrderAdjustment"),
+		zap.Int64("adjustment_id", id),
+	)
+
+	adminID, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := s.repo.GetOrderAdjustment(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if a.Status != AdjustmentPending {
+		return nil, ErrAdjustmentNotPending
+	}
+	if uint(a.RequestedBy) == adminID {
+		return nil, ErrAdjustmentSelfApproval
+	}
+
+	o, sessionID, err := s.repo.GetAdjustableOrder(ctx, uint(a.OrderID))
+	if err != nil {
+		return nil, err
+	}
+	if err := canAdjust(o, a); err != nil {
+		log.Warn("order cannot take the adjustment", zap.String("status", string(o.Status)), zap.Error(err))
+		return nil, err
+	}
+	if sessionID == "" {
+		log.Warn("order has no checkout session to invoice from")
+		return nil, ErrOrderNotAdjustable
+	}
+	log = log.With(zap.String("order_external_id", o.ExternalID))
+
+	session, err := s.repo.GetCheckoutSession(ctx, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	replaced, err := s.pendingGatewayPayment(ctx, uint(o.ID))
+	if err != nil {
+		log.Error("failed to get order payments", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	// The old invoice goes first, so the customer cannot pay it once the
+	// new amount is due.
+	if replaced != "" {
+		if err := s.paymentGate.CancelPayment(ctx, replaced); err != nil {
+			log.Error("failed to cancel replaced payment", zap.String("payment_request_id", replaced), zap.Error(err))
+			return nil, fmt.Errorf("failed to cancel payment invoice: %w", err)
+		}
+	}
+
+	amount := int64(o.GatewayAmount()) + a.Delta()
+	payResp, err := s.paymentGate.CreateInvoice(ctx,
+		o.ExternalID,
+		s.invoiceBuyer(ctx, session),
+		amount,
+		invoiceItems(session),
+		invoiceChannel(session))
+	if err != nil {
+		log.Error("failed to create replacement invoice", zap.Error(err))
+		return nil, fmt.Errorf("failed to create payment invoice: %w", err)
+	}
+
+	applied, updated, err := s.repo.ApplyOrderAdjustment(ctx, id, adminID, replaced, &payment.Payment{
+		ExternalReference: payResp.ProviderPaymentID,
+		InvoiceURL:        payResp.InvoiceURL,
+		Amount:            payResp.Amount,
+		Status:            payResp.Status,
+		PaymentMethod:     payResp.PaymentMethod,
+		ChannelCode:       payResp.ChannelCode,
+		PaymentCode:       payResp.PaymentCode,
+		ExpireAt:          payResp.ExpirationTime,
+	})
+	if err != nil {
+		// Most likely the order was paid meanwhile; the new invoice must
+		// not be paid as well.
+		if cerr := s.paymentGate.CancelPayment(ctx, payResp.ProviderPaymentID); cerr != nil {
+			log.Error("failed to cancel unused invoice",
+				zap.String("payment_request_id", payResp.ProviderPaymentID),
+				zap.Error(cerr),
+			)
+		}
+		return nil, err
+	}
+	s.webhookOrders.invalidate(o.ExternalID)
+
+	log.Info("order adjustment applied",
+		zap.String("kind", string(applied.Kind)),
+		zap.Int64("amount", applied.Amount),
+		zap.Uint("total_amount", updated.TotalAmount),
+		zap.String("payment_request_id", payResp.ProviderPaymentID),
+	)
+
+	if d := s.orderUpdateDelivery(ctx, updated.UserID); !d.Muted() {
+		if err := s.notifier.NotifyOrderAdjusted(ctx, d, updated.UserID, updated.ExternalID, applied, updated.TotalAmount); err != nil {
+			log.Warn("failed to send order adjusted notice", zap.Error(err))
+		}
+	}
+	return applied, nil
+}
+
+func (s *service) RejectOrderAdjustment(ctx context.Context, id int64) (*OrderAdjustment, error) {
+	adminID, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.repo.GetOrderAdjustment(ctx, id); err != nil {
+		return nil, err
+	}
+	a, err := s.repo.RejectOrderAdjustment(ctx, id, adminID)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.FromCtx(ctx).Info("order adjustment rejected",
+		zap.Int64("adjustment_id", id),
+		zap.Int32("order_id", a.OrderID),
+	)
+	return a, nil
+}
+
+func (s *service) OrderAdjustments(ctx context.Context, orderID uint) ([]*OrderAdjustment, error) {
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return s.repo.ListOrderAdjustments(ctx, orderID)
+}
+
+// canAdjust reports whether a can be put on o: the order must still be
+// waiting for payment, and a discount must leave something for the
+// gateway to collect.
+func canAdjust(o *Order, a *OrderAdjustment) error {
+	if o.Status != OrderStatusPendingPayment {
+		return ErrOrderNotAdjustable
+	}
+	if int64(o.GatewayAmount())+a.Delta() <= 0 {
+		return ErrAdjustmentTooLarge
+	}
+	return nil
+}
+
+// pendingGatewayPayment returns the payment request the order is waiting
+// on, "" when there is none.
+func (s *service) pendingGatewayPayment(ctx context.Context, orderID uint) (string, error) {
+	payments, err := s.paymentRepo.GetPaymentsByOrder(ctx, orderID)
+	if err != nil {
+		return "", err
+	}
+	for _, p := range payments {
+		if p.Provider == payment.ProviderXendit && p.Status == string(PaymentStatusPending) {
+			return p.ExternalReference, nil
+		}
+	}
+	return "", nil
+}
+
+// checkCanCheckout fails with the user's restriction error while an admin
+// has blocked their checkouts or suspended them.
+func (s *service) checkCanCheckout(ctx context.Context, userID uint) error {
+	res, err := s.userRepo.GetRestriction(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if err := res.CheckoutErr(); err != nil {
+		logger.FromCtx(ctx).Warn("restricted user tried to check out",
+			zap.String("layer", "service"),
+			zap.Uint("user_id", userID),
+			zap.String("level", string(res.Level)),
+		)
+		return err
+	}
+	return nil
 }
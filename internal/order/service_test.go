@@ -5,18 +5,25 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 	"warimas-be/internal/address"
+	"warimas-be/internal/apperr"
+	"warimas-be/internal/geo"
 	"warimas-be/internal/graph/model"
 	"warimas-be/internal/payment"
+	"warimas-be/internal/preference"
 	"warimas-be/internal/product"
+	"warimas-be/internal/statemachine"
 	"warimas-be/internal/user"
 	"warimas-be/internal/utils"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 // --- Mocks ---
@@ -85,6 +92,14 @@ func (m *MockRepository) UpdateOrderStatus(ctx context.Context, orderID uint, st
 	args := m.Called(ctx, orderID, status, invoiceNumber)
 	return args.Error(0)
 }
+func (m *MockRepository) CancelOrder(ctx context.Context, orderID uint, reasonID int32, note *string) error {
+	args := m.Called(ctx, orderID, reasonID, note)
+	return args.Error(0)
+}
+func (m *MockRepository) IsOrderPacked(ctx context.Context, orderID uint) (bool, error) {
+	args := m.Called(ctx, orderID)
+	return args.Bool(0), args.Error(1)
+}
 func (m *MockRepository) GetByReferenceID(ctx context.Context, refID string) (*Order, error) {
 	args := m.Called(ctx, refID)
 	if args.Get(0) == nil {
@@ -96,6 +111,34 @@ func (m *MockRepository) UpdateStatusByReferenceID(ctx context.Context, refID, p
 	args := m.Called(ctx, refID, payReqID, payProvID, status)
 	return args.Error(0)
 }
+func (m *MockRepository) MarkPaidByReferenceID(ctx context.Context, refID string, capture payment.Capture) error {
+	args := m.Called(ctx, refID, capture)
+	return args.Error(0)
+}
+func (m *MockRepository) ListExpiredPayments(ctx context.Context, now time.Time, limit int32) ([]*ExpiredPayment, error) {
+	args := m.Called(ctx, now, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ExpiredPayment), args.Error(1)
+}
+func (m *MockRepository) ExpireOrderPayment(ctx context.Context, orderExternalID, paymentRequestID string) error {
+	args := m.Called(ctx, orderExternalID, paymentRequestID)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ListExpiredSessions(ctx context.Context, now, graceBefore time.Time, limit int32) ([]*ExpiredSession, error) {
+	args := m.Called(ctx, now, graceBefore, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ExpiredSession), args.Error(1)
+}
+
+func (m *MockRepository) CancelUnpaidSessionOrder(ctx context.Context, orderExternalID string) error {
+	args := m.Called(ctx, orderExternalID)
+	return args.Error(0)
+}
 func (m *MockRepository) GetVariantForCheckout(ctx context.Context, variantID string) (*product.Variant, *product.Product, error) {
 	args := m.Called(ctx, variantID)
 	if args.Get(0) == nil {
@@ -107,13 +150,6 @@ func (m *MockRepository) CreateCheckoutSession(ctx context.Context, session *Che
 	args := m.Called(ctx, session, items)
 	return args.Error(0)
 }
-func (m *MockRepository) GetUserAddress(ctx context.Context, addressID string, userID uint) (*address.Address, error) {
-	args := m.Called(ctx, addressID, userID)
-	if args.Get(0) == nil {
-		return nil, args.Error(1)
-	}
-	return args.Get(0).(*address.Address), args.Error(1)
-}
 func (m *MockRepository) UpdateSessionAddressAndPricing(ctx context.Context, session *CheckoutSession) error {
 	args := m.Called(ctx, session)
 	return args.Error(0)
@@ -124,10 +160,60 @@ func (m *MockRepository) UpdateSessionPaymentMethod(ctx context.Context, session
 	return args.Error(0)
 }
 
+func (m *MockRepository) UpdateSessionShippingMethod(ctx context.Context, session *CheckoutSession) error {
+	args := m.Called(ctx, session)
+	return args.Error(0)
+}
+
+func (m *MockRepository) UpdateSessionInsurance(ctx context.Context, session *CheckoutSession) error {
+	args := m.Called(ctx, session)
+	return args.Error(0)
+}
+
+func (m *MockRepository) UpdateSessionWalletAmount(ctx context.Context, sessionID uuid.UUID, amount int) error {
+	args := m.Called(ctx, sessionID, amount)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetVoucherByCode(ctx context.Context, code string) (*SessionVoucher, error) {
+	args := m.Called(ctx, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*SessionVoucher), args.Error(1)
+}
+
+func (m *MockRepository) UpdateSessionDiscounts(ctx context.Context, session *CheckoutSession) error {
+	args := m.Called(ctx, session)
+	return args.Error(0)
+}
+
 func (m *MockRepository) ValidateVariantStock(ctx context.Context, variantID string, qty int) (bool, error) {
 	args := m.Called(ctx, variantID, qty)
 	return args.Bool(0), args.Error(1)
 }
+func (m *MockRepository) VariantsOnVacation(ctx context.Context, variantIDs []string) ([]string, error) {
+	args := m.Called(ctx, variantIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+func (m *MockRepository) InactiveVariants(ctx context.Context, variantIDs []string) ([]string, error) {
+	args := m.Called(ctx, variantIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+func (m *MockRepository) OriginLocations(ctx context.Context, originIDs []string) (map[string]geo.Point, error) {
+	args := m.Called(ctx, originIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[string]geo.Point), args.Error(1)
+}
 func (m *MockRepository) ConfirmCheckoutSession(ctx context.Context, session *CheckoutSession) error {
 	args := m.Called(ctx, session)
 	return args.Error(0)
@@ -136,6 +222,254 @@ func (m *MockRepository) MarkSessionExpired(ctx context.Context, id uuid.UUID) e
 	args := m.Called(ctx, id)
 	return args.Error(0)
 }
+func (m *MockRepository) GetActiveSessionExternalID(ctx context.Context, userID uint) (string, error) {
+	args := m.Called(ctx, userID)
+	return args.String(0), args.Error(1)
+}
+func (m *MockRepository) CountOpenGuestSessions(ctx context.Context, guestID uuid.UUID) (int, error) {
+	args := m.Called(ctx, guestID)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockRepository) SupersedeOpenSessions(ctx context.Context, userID uint, keepID uuid.UUID) (int64, error) {
+	args := m.Called(ctx, userID, keepID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) InsertSessionEvent(ctx context.Context, event *SessionEvent) error {
+	args := m.Called(ctx, event)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ListSessionEvents(ctx context.Context, sessionID uuid.UUID) ([]*SessionEvent, error) {
+	args := m.Called(ctx, sessionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*SessionEvent), args.Error(1)
+}
+
+func (m *MockRepository) GetVoucherByID(ctx context.Context, id int64) (*SessionVoucher, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*SessionVoucher), args.Error(1)
+}
+
+func (m *MockRepository) UpdateSessionItems(ctx context.Context, session *CheckoutSession) error {
+	args := m.Called(ctx, session)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ListCheckoutRules(ctx context.Context, activeOnly bool) ([]*CheckoutRule, error) {
+	args := m.Called(ctx, activeOnly)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*CheckoutRule), args.Error(1)
+}
+
+func (m *MockRepository) UpsertCheckoutRule(ctx context.Context, rule *CheckoutRule, adminID uint) (*CheckoutRule, error) {
+	args := m.Called(ctx, rule, adminID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*CheckoutRule), args.Error(1)
+}
+
+func (m *MockRepository) ListPickupLocations(ctx context.Context, activeOnly bool) ([]*PickupLocation, error) {
+	args := m.Called(ctx, activeOnly)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*PickupLocation), args.Error(1)
+}
+
+func (m *MockRepository) GetPickupLocation(ctx context.Context, id int32) (*PickupLocation, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*PickupLocation), args.Error(1)
+}
+
+func (m *MockRepository) SavePickupLocation(ctx context.Context, location *PickupLocation) (*PickupLocation, error) {
+	args := m.Called(ctx, location)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*PickupLocation), args.Error(1)
+}
+
+func (m *MockRepository) GetOrderPickup(ctx context.Context, orderID uint) (*OrderPickup, error) {
+	args := m.Called(ctx, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*OrderPickup), args.Error(1)
+}
+
+func (m *MockRepository) CompletePickup(ctx context.Context, orderID uint, adminID uint) error {
+	args := m.Called(ctx, orderID, adminID)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ListDeliverySlots(ctx context.Context, region *string, activeOnly bool) ([]*DeliverySlot, error) {
+	args := m.Called(ctx, region, activeOnly)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*DeliverySlot), args.Error(1)
+}
+
+func (m *MockRepository) GetDeliverySlot(ctx context.Context, id int32) (*DeliverySlot, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*DeliverySlot), args.Error(1)
+}
+
+func (m *MockRepository) SaveDeliverySlot(ctx context.Context, slot *DeliverySlot) (*DeliverySlot, error) {
+	args := m.Called(ctx, slot)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*DeliverySlot), args.Error(1)
+}
+
+func (m *MockRepository) CountDeliveryBookings(ctx context.Context, slotIDs []int32, date time.Time) (map[int32]int, error) {
+	args := m.Called(ctx, slotIDs, date)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[int32]int), args.Error(1)
+}
+
+func (m *MockRepository) GetOrderDelivery(ctx context.Context, orderID uint) (*DeliveryBooking, error) {
+	args := m.Called(ctx, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*DeliveryBooking), args.Error(1)
+}
+
+func (m *MockRepository) ListCurrentPolicies(ctx context.Context) ([]*Policy, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Policy), args.Error(1)
+}
+
+func (m *MockRepository) ListPolicyVersions(ctx context.Context, kind PolicyKind) ([]*Policy, error) {
+	args := m.Called(ctx, kind)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Policy), args.Error(1)
+}
+
+func (m *MockRepository) PublishPolicy(ctx context.Context, policy *Policy) (*Policy, error) {
+	args := m.Called(ctx, policy)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Policy), args.Error(1)
+}
+
+func (m *MockRepository) ListOrderPolicies(ctx context.Context, orderID uint) ([]*Policy, error) {
+	args := m.Called(ctx, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Policy), args.Error(1)
+}
+
+func (m *MockRepository) ListReasons(ctx context.Context, kind *ReasonKind, customerOnly bool) ([]*Reason, error) {
+	args := m.Called(ctx, kind, customerOnly)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Reason), args.Error(1)
+}
+
+func (m *MockRepository) GetReason(ctx context.Context, id int32) (*Reason, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Reason), args.Error(1)
+}
+
+func (m *MockRepository) SaveReason(ctx context.Context, reason *Reason) (*Reason, error) {
+	args := m.Called(ctx, reason)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Reason), args.Error(1)
+}
+
+func (m *MockRepository) ReasonStats(ctx context.Context, kind ReasonKind, from, to time.Time) ([]*ReasonStat, error) {
+	args := m.Called(ctx, kind, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ReasonStat), args.Error(1)
+}
+
+func (m *MockRepository) GetAdjustableOrder(ctx context.Context, orderID uint) (*Order, string, error) {
+	args := m.Called(ctx, orderID)
+	if args.Get(0) == nil {
+		return nil, "", args.Error(2)
+	}
+	return args.Get(0).(*Order), args.String(1), args.Error(2)
+}
+
+func (m *MockRepository) CreateOrderAdjustment(ctx context.Context, a *OrderAdjustment) (*OrderAdjustment, error) {
+	args := m.Called(ctx, a)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*OrderAdjustment), args.Error(1)
+}
+
+func (m *MockRepository) GetOrderAdjustment(ctx context.Context, id int64) (*OrderAdjustment, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*OrderAdjustment), args.Error(1)
+}
+
+func (m *MockRepository) ListOrderAdjustments(ctx context.Context, orderID uint) ([]*OrderAdjustment, error) {
+	args := m.Called(ctx, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*OrderAdjustment), args.Error(1)
+}
+
+func (m *MockRepository) ApplyOrderAdjustment(ctx context.Context, id int64, reviewedBy uint, replaced string, invoice *payment.Payment) (*OrderAdjustment, *Order, error) {
+	args := m.Called(ctx, id, reviewedBy, replaced, invoice)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*OrderAdjustment), args.Get(1).(*Order), args.Error(2)
+}
+
+func (m *MockRepository) RejectOrderAdjustment(ctx context.Context, id int64, reviewedBy uint) (*OrderAdjustment, error) {
+	args := m.Called(ctx, id, reviewedBy)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*OrderAdjustment), args.Error(1)
+}
+func (m *MockRepository) SaveOfflinePayment(ctx context.Context, o *Order) error {
+	args := m.Called(ctx, o)
+	return args.Error(0)
+}
 func (m *MockRepository) GetOrderByExternalID(ctx context.Context, externalID string) (*Order, error) {
 	args := m.Called(ctx, externalID)
 	if args.Get(0) == nil {
@@ -188,6 +522,23 @@ func (m *MockAddressRepository) SetDefault(ctx context.Context, userID uint, add
 	return args.Error(0)
 }
 
+// ownedAddress marks a as an active address of userID, the only kind
+// userAddress accepts.
+func ownedAddress(a *address.Address, userID uint) *address.Address {
+	a.UserID = userID
+	a.IsActive = true
+	return a
+}
+
+type MockBalanceGateway struct {
+	mock.Mock
+}
+
+func (m *MockBalanceGateway) Balance(ctx context.Context, userID uint) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 type MockPaymentRepository struct {
 	mock.Mock
 }
@@ -208,6 +559,19 @@ func (m *MockPaymentRepository) GetPaymentByOrder(ctx context.Context, orderID u
 	return args.Get(0).(*payment.Payment), args.Error(1)
 }
 
+func (m *MockPaymentRepository) GetPaymentsByOrder(ctx context.Context, orderID uint) ([]*payment.Payment, error) {
+	args := m.Called(ctx, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*payment.Payment), args.Error(1)
+}
+
+func (m *MockPaymentRepository) MarkPaymentPaid(ctx context.Context, c payment.Capture) error {
+	args := m.Called(ctx, c)
+	return args.Error(0)
+}
+
 func (m *MockPaymentRepository) MarkWebhookFailed(ctx context.Context, id int64, reason string) error {
 	args := m.Called(ctx, id, reason)
 	return args.Error(0)
@@ -218,6 +582,27 @@ func (m *MockPaymentRepository) MarkWebhookProcessed(ctx context.Context, id int
 	return args.Error(0)
 }
 
+func (m *MockPaymentRepository) HasAppliedWebhook(ctx context.Context, provider, externalID, eventType, paymentRequestID, status string) (bool, error) {
+	args := m.Called(ctx, provider, externalID, eventType, paymentRequestID, status)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockPaymentRepository) ListQueuedWebhooks(ctx context.Context, provider string, receivedBefore time.Time, limit int32) ([]*payment.QueuedWebhook, error) {
+	args := m.Called(ctx, provider, receivedBefore, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*payment.QueuedWebhook), args.Error(1)
+}
+
+func (m *MockPaymentRepository) ListWebhookEvents(ctx context.Context, provider, orderExternalID, paymentRequestID string) ([]*payment.WebhookEvent, error) {
+	args := m.Called(ctx, provider, orderExternalID, paymentRequestID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*payment.WebhookEvent), args.Error(1)
+}
+
 func (m *MockPaymentRepository) SavePaymentWebhook(
 	ctx context.Context,
 	provider string,
@@ -263,9 +648,24 @@ func (m *MockUserRepository) GetProfile(ctx context.Context, userID uint) (*user
 	return args.Get(0).(*user.Profile), args.Error(1)
 }
 
-func (m *MockUserRepository) Create(ctx context.Context, u *user.User) error {
-	args := m.Called(ctx, u)
-	return args.Error(0)
+func (m *MockUserRepository) GetRestriction(ctx context.Context, userID uint) (*user.Restriction, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*user.Restriction), args.Error(1)
+}
+
+// unrestricted is a user gateway whose users check out freely.
+func unrestricted() *MockUserRepository {
+	m := new(MockUserRepository)
+	m.On("GetRestriction", mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+	return m
+}
+
+func (m *MockUserRepository) Create(ctx context.Context, u *user.User) error {
+	args := m.Called(ctx, u)
+	return args.Error(0)
 }
 
 func (m *MockPaymentGateway) CancelPayment(ctx context.Context, externalID string) error {
@@ -273,6 +673,22 @@ func (m *MockPaymentGateway) CancelPayment(ctx context.Context, externalID strin
 	return args.Error(0)
 }
 
+func (m *MockPaymentGateway) Refund(ctx context.Context, paymentRequestID, referenceID string, amount int64, reason string) (*payment.RefundResponse, error) {
+	args := m.Called(ctx, paymentRequestID, referenceID, amount, reason)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*payment.RefundResponse), args.Error(1)
+}
+
+func (m *MockPaymentGateway) GetRefund(ctx context.Context, providerRefundID string) (*payment.RefundResponse, error) {
+	args := m.Called(ctx, providerRefundID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*payment.RefundResponse), args.Error(1)
+}
+
 func (m *MockPaymentGateway) VerifySignature(r *http.Request) error {
 	args := m.Called(r)
 	return args.Error(0)
@@ -289,7 +705,7 @@ func TestService_GetOrderDetail(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		mockRepo := new(MockRepository)
 		mockAddrRepo := new(MockAddressRepository)
-		svc := NewService(mockRepo, nil, nil, mockAddrRepo, nil)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), nil, nil, nil, nil)
 
 		ctx := utils.SetUserContext(context.Background(), userID, "test@example.com", "user")
 
@@ -301,6 +717,7 @@ func TestService_GetOrderDetail(t *testing.T) {
 		mockAddr := &address.Address{ID: addrID, Name: "Home"}
 
 		mockRepo.On("GetOrderDetail", ctx, orderID).Return(mockOrder, nil)
+		mockRepo.On("ListOrderPolicies", ctx, orderID).Return([]*Policy{}, nil)
 		mockAddrRepo.On("GetByID", ctx, addrID).Return(mockAddr, nil)
 
 		resOrder, resAddr, err := svc.GetOrderDetail(ctx, orderID)
@@ -311,10 +728,42 @@ func TestService_GetOrderDetail(t *testing.T) {
 		mockRepo.AssertExpectations(t)
 	})
 
+	t.Run("HidesInternalCancelReasonFromCustomer", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockAddrRepo := new(MockAddressRepository)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), nil, nil, nil, nil)
+
+		ctx := utils.SetUserContext(context.Background(), userID, "test@example.com", "user")
+
+		reasonID := int32(6)
+		note := "chargeback on a previous order"
+		mockOrder := &Order{
+			ID:             int32(orderID),
+			UserID:         &userInt32,
+			AddressID:      addrID,
+			Status:         OrderStatusCancelled,
+			CancelReasonID: &reasonID,
+			CancelNote:     &note,
+		}
+
+		mockRepo.On("GetOrderDetail", ctx, orderID).Return(mockOrder, nil)
+		mockRepo.On("ListOrderPolicies", ctx, orderID).Return([]*Policy{}, nil)
+		mockRepo.On("GetReason", ctx, reasonID).Return(&Reason{
+			ID: reasonID, Code: "SUSPECTED_FRAUD", Kind: ReasonKindCancellation, Audience: ReasonAudienceInternal,
+		}, nil)
+		mockAddrRepo.On("GetByID", ctx, addrID).Return(&address.Address{ID: addrID}, nil)
+
+		resOrder, _, err := svc.GetOrderDetail(ctx, orderID)
+
+		assert.NoError(t, err)
+		assert.Nil(t, resOrder.CancelReason)
+		assert.Nil(t, resOrder.CancelNote)
+	})
+
 	t.Run("Unauthenticated", func(t *testing.T) {
 		mockRepo := new(MockRepository)
 		mockAddrRepo := new(MockAddressRepository)
-		svc := NewService(mockRepo, nil, nil, mockAddrRepo, nil)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), nil, nil, nil, nil)
 
 		// Context without user
 		ctx := context.Background()
@@ -332,7 +781,7 @@ func TestService_GetOrderDetail(t *testing.T) {
 	t.Run("Unauthorized_WrongUser", func(t *testing.T) {
 		mockRepo := new(MockRepository)
 		mockAddrRepo := new(MockAddressRepository)
-		svc := NewService(mockRepo, nil, nil, mockAddrRepo, nil)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), nil, nil, nil, nil)
 
 		ctx := utils.SetUserContext(context.Background(), userID, "test@example.com", "user")
 
@@ -353,7 +802,7 @@ func TestService_GetOrderDetail(t *testing.T) {
 	t.Run("NotFound", func(t *testing.T) {
 		mockRepo := new(MockRepository)
 		mockAddrRepo := new(MockAddressRepository)
-		svc := NewService(mockRepo, nil, nil, mockAddrRepo, nil)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), nil, nil, nil, nil)
 
 		ctx := utils.SetUserContext(context.Background(), userID, "test@example.com", "user")
 
@@ -368,11 +817,12 @@ func TestService_GetOrderDetail(t *testing.T) {
 	t.Run("AddressRepoError", func(t *testing.T) {
 		mockRepo := new(MockRepository)
 		mockAddrRepo := new(MockAddressRepository)
-		svc := NewService(mockRepo, nil, nil, mockAddrRepo, nil)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), nil, nil, nil, nil)
 		ctx := utils.SetUserContext(context.Background(), userID, "test@example.com", "user")
 
 		mockOrder := &Order{ID: int32(orderID), UserID: &userInt32, AddressID: addrID}
 		mockRepo.On("GetOrderDetail", ctx, orderID).Return(mockOrder, nil)
+		mockRepo.On("ListOrderPolicies", ctx, mock.Anything).Return([]*Policy{}, nil)
 		mockAddrRepo.On("GetByID", ctx, addrID).Return(nil, errors.New("addr error"))
 
 		_, _, err := svc.GetOrderDetail(ctx, orderID)
@@ -381,7 +831,7 @@ func TestService_GetOrderDetail(t *testing.T) {
 
 	t.Run("InvalidData_NilUserID", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
 		ctx := utils.SetUserContext(context.Background(), userID, "test@example.com", "user")
 
 		mockOrder := &Order{ID: int32(orderID), UserID: nil} // Invalid
@@ -395,7 +845,7 @@ func TestService_GetOrderDetail(t *testing.T) {
 	t.Run("Success_Admin", func(t *testing.T) {
 		mockRepo := new(MockRepository)
 		mockAddrRepo := new(MockAddressRepository)
-		svc := NewService(mockRepo, nil, nil, mockAddrRepo, nil)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), nil, nil, nil, nil)
 
 		// Context with ADMIN role
 		ctx := utils.SetUserContext(context.Background(), userID, "admin@example.com", "ADMIN")
@@ -405,6 +855,7 @@ func TestService_GetOrderDetail(t *testing.T) {
 		mockAddr := &address.Address{ID: addrID}
 
 		mockRepo.On("GetOrderDetail", ctx, orderID).Return(mockOrder, nil)
+		mockRepo.On("ListOrderPolicies", ctx, mock.Anything).Return([]*Policy{}, nil)
 		mockAddrRepo.On("GetByID", ctx, addrID).Return(mockAddr, nil)
 
 		res, _, err := svc.GetOrderDetail(ctx, orderID)
@@ -422,7 +873,7 @@ func TestService_CreateFromSession(t *testing.T) {
 
 	t.Run("Success", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
 
 		mockSession := &CheckoutSession{
 			ID:          sessionID,
@@ -450,7 +901,7 @@ func TestService_CreateFromSession(t *testing.T) {
 
 	t.Run("SessionNotPaid", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
 
 		mockSession := &CheckoutSession{
 			ID:          sessionID,
@@ -469,7 +920,7 @@ func TestService_CreateFromSession(t *testing.T) {
 
 	t.Run("Idempotency_OrderExists", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
 
 		mockSession := &CheckoutSession{
 			ID:          sessionID,
@@ -492,7 +943,7 @@ func TestService_CreateFromSession(t *testing.T) {
 
 	t.Run("SessionNotConfirmed", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
 
 		mockSession := &CheckoutSession{ID: sessionID, ConfirmedAt: nil}
 		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(mockSession, nil)
@@ -506,7 +957,7 @@ func TestService_CreateFromSession(t *testing.T) {
 func TestService_GetOrders(t *testing.T) {
 	mockRepo := new(MockRepository)
 	mockAddrRepo := new(MockAddressRepository)
-	svc := NewService(mockRepo, nil, nil, mockAddrRepo, nil)
+	svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), nil, nil, nil, nil)
 
 	userID := uint(1)
 	ctx := utils.SetUserContext(context.Background(), userID, "test@example.com", "user")
@@ -552,9 +1003,41 @@ func TestService_GetOrders(t *testing.T) {
 		mockAddrRepo.AssertExpectations(t)
 	})
 
+	t.Run("BatchesSharedAddresses", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockAddrRepo := new(MockAddressRepository)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), nil, nil, nil, nil)
+		filter := &OrderFilterInput{}
+		sort := &OrderSortInput{Field: OrderSortFieldCreatedAt, Direction: SortDirectionDesc}
+		addrID := uuid.New()
+
+		mockOrders := []*Order{
+			{ID: 1, AddressID: addrID},
+			{ID: 2, AddressID: addrID},
+		}
+		mockItems := map[int32][]*OrderItem{
+			1: {{ID: 10}},
+			2: {{ID: 20}, {ID: 21}},
+		}
+
+		mockRepo.On("FetchOrders", ctx, filter, sort, int32(10), int32(0)).Return(mockOrders, nil)
+		mockRepo.On("CountOrders", ctx, filter).Return(int64(2), nil)
+		mockAddrRepo.On("GetByIDs", ctx, []uuid.UUID{addrID}).Return([]address.Address{{ID: addrID, Name: "Home"}}, nil).Once()
+		mockRepo.On("FetchOrderItems", ctx, []int32{1, 2}).Return(mockItems, nil).Once()
+
+		orders, _, addrMap, err := svc.GetOrders(ctx, filter, sort, 10, 1)
+
+		assert.NoError(t, err)
+		assert.Len(t, orders[0].Items, 1)
+		assert.Len(t, orders[1].Items, 2)
+		assert.Equal(t, "Home", addrMap[addrID].Name)
+		mockRepo.AssertExpectations(t)
+		mockAddrRepo.AssertExpectations(t)
+	})
+
 	t.Run("RepoError", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
 
 		filter := &OrderFilterInput{}
 		sort := &OrderSortInput{Field: OrderSortFieldCreatedAt, Direction: SortDirectionDesc}
@@ -567,7 +1050,7 @@ func TestService_GetOrders(t *testing.T) {
 
 	t.Run("CountError", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
 		filter := &OrderFilterInput{}
 		sort := &OrderSortInput{Field: OrderSortFieldCreatedAt, Direction: SortDirectionDesc}
 
@@ -582,7 +1065,7 @@ func TestService_GetOrders(t *testing.T) {
 	t.Run("AddressRepoError", func(t *testing.T) {
 		mockRepo := new(MockRepository)
 		mockAddrRepo := new(MockAddressRepository)
-		svc := NewService(mockRepo, nil, nil, mockAddrRepo, nil)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), nil, nil, nil, nil)
 		filter := &OrderFilterInput{}
 		sort := &OrderSortInput{Field: OrderSortFieldCreatedAt, Direction: SortDirectionDesc}
 		addrID := uuid.New()
@@ -599,7 +1082,7 @@ func TestService_GetOrders(t *testing.T) {
 	t.Run("FetchItemsError", func(t *testing.T) {
 		mockRepo := new(MockRepository)
 		mockAddrRepo := new(MockAddressRepository)
-		svc := NewService(mockRepo, nil, nil, mockAddrRepo, nil)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), nil, nil, nil, nil)
 		filter := &OrderFilterInput{}
 		sort := &OrderSortInput{Field: OrderSortFieldCreatedAt, Direction: SortDirectionDesc}
 		addrID := uuid.New()
@@ -612,6 +1095,57 @@ func TestService_GetOrders(t *testing.T) {
 		_, _, _, err := svc.GetOrders(ctx, filter, sort, 10, 1)
 		assert.Error(t, err)
 	})
+
+	t.Run("DatePresetResolvedInJakarta", func(t *testing.T) {
+		jakarta := time.FixedZone("WIB", 7*3600)
+		// 2024-03-01 01:30 in Jakarta is still February 29th in UTC.
+		now := time.Date(2024, 2, 29, 18, 30, 0, 0, time.UTC)
+
+		tests := []struct {
+			preset OrderDatePreset
+			from   time.Time
+		}{
+			{OrderDatePresetLast30Days, time.Date(2024, 2, 1, 0, 0, 0, 0, jakarta)},
+			{OrderDatePresetLast90Days, time.Date(2023, 12, 3, 0, 0, 0, 0, jakarta)},
+			{OrderDatePresetThisYear, time.Date(2024, 1, 1, 0, 0, 0, 0, jakarta)},
+		}
+
+		for _, tt := range tests {
+			mockRepo := new(MockRepository)
+			svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+			svc.(*service).now = func() time.Time { return now }
+			svc.(*service).loc = jakarta
+
+			preset := tt.preset
+			product := "Beras 5kg"
+			filter := &OrderFilterInput{Product: &product, DatePreset: &preset}
+			sort := &OrderSortInput{Field: OrderSortFieldCreatedAt, Direction: SortDirectionDesc}
+
+			resolved := mock.MatchedBy(func(f *OrderFilterInput) bool {
+				return f.DateFrom != nil && f.DateFrom.Equal(tt.from) && f.DateTo == nil &&
+					f.Product == &product
+			})
+			mockRepo.On("FetchOrders", ctx, resolved, sort, int32(10), int32(0)).Return([]*Order{}, nil)
+			mockRepo.On("CountOrders", ctx, resolved).Return(int64(0), nil)
+
+			_, _, _, err := svc.GetOrders(ctx, filter, sort, 10, 1)
+			assert.NoError(t, err, tt.preset)
+			assert.Nil(t, filter.DateFrom, "caller's filter must not be modified")
+			mockRepo.AssertExpectations(t)
+		}
+	})
+
+	t.Run("DatePresetWithExplicitDates", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+		preset := OrderDatePresetThisYear
+		from := time.Now()
+		filter := &OrderFilterInput{DatePreset: &preset, DateFrom: &from}
+
+		_, _, _, err := svc.GetOrders(ctx, filter, nil, 10, 1)
+		assert.ErrorIs(t, err, ErrDatePresetConflict)
+		mockRepo.AssertNotCalled(t, "FetchOrders", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
 }
 
 func TestService_UpdateOrderStatus(t *testing.T) {
@@ -674,12 +1208,23 @@ func TestService_UpdateOrderStatus(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockRepo := new(MockRepository)
-			svc := NewService(mockRepo, nil, nil, nil, nil)
+			svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
 
 			mockOrder := &Order{Status: tt.currentStatus}
 			mockRepo.On("GetOrderDetail", ctx, orderID).Return(mockOrder, nil)
 
-			if !tt.expectError {
+			// Cancelling needs a reason, so it goes through CancelOrder.
+			if tt.newStatus == OrderStatusCancelled {
+				mockRepo.On("GetReason", ctx, int32(7)).Return(&Reason{
+					ID: 7, Kind: ReasonKindCancellation, IsActive: true,
+				}, nil)
+				if !tt.expectError {
+					mockRepo.On("CancelOrder", ctx, orderID, int32(7), (*string)(nil)).Return(nil)
+				}
+			} else if !tt.expectError {
+				if tt.newStatus == OrderStatusShipped {
+					mockRepo.On("IsOrderPacked", ctx, orderID).Return(true, nil)
+				}
 				var invMatcher interface{}
 				if tt.newStatus == OrderStatusAccepted {
 					invMatcher = mock.AnythingOfType("*string")
@@ -689,7 +1234,12 @@ func TestService_UpdateOrderStatus(t *testing.T) {
 				mockRepo.On("UpdateOrderStatus", ctx, orderID, tt.newStatus, invMatcher).Return(nil)
 			}
 
-			err := svc.UpdateOrderStatus(ctx, orderID, tt.newStatus)
+			var err error
+			if tt.newStatus == OrderStatusCancelled {
+				err = svc.CancelOrder(ctx, orderID, 7, nil)
+			} else {
+				err = svc.UpdateOrderStatus(ctx, orderID, tt.newStatus)
+			}
 
 			if tt.expectError {
 				assert.Error(t, err)
@@ -701,9 +1251,74 @@ func TestService_UpdateOrderStatus(t *testing.T) {
 		})
 	}
 
+	t.Run("ShipBeforePacked", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+		mockRepo.On("GetOrderDetail", ctx, orderID).Return(&Order{Status: OrderStatusAccepted}, nil)
+		mockRepo.On("IsOrderPacked", ctx, orderID).Return(false, nil)
+
+		err := svc.UpdateOrderStatus(ctx, orderID, OrderStatusShipped)
+		assert.ErrorIs(t, err, ErrOrderNotPacked)
+		mockRepo.AssertNotCalled(t, "UpdateOrderStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("ReadyForPickup", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		notifier, prefs := new(MockNotifier), new(MockPreferences)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, prefs).(*service)
+		svc.notifier = notifier
+		userID := int32(7)
+		pickup := &OrderPickup{Location: &PickupLocation{ID: 1}, Code: "123456"}
+		push := &preference.Delivery{Locale: "en", Channels: []preference.Channel{preference.ChannelPush}}
+		prefs.On("DeliveryFor", ctx, userID, preference.EventOrderUpdates).Return(push)
+		mockRepo.On("GetOrderDetail", ctx, orderID).Return(&Order{
+			Status: OrderStatusAccepted, ExternalID: "ord-1", UserID: &userID, ShippingMethod: ShippingMethodSelfPickup,
+		}, nil)
+		mockRepo.On("IsOrderPacked", ctx, orderID).Return(true, nil)
+		mockRepo.On("UpdateOrderStatus", ctx, orderID, OrderStatusReadyForPickup, (*string)(nil)).Return(nil)
+		mockRepo.On("GetOrderPickup", ctx, orderID).Return(pickup, nil)
+		notifier.On("NotifyReadyForPickup", ctx, push, &userID, "ord-1", pickup).Return(nil)
+
+		err := svc.UpdateOrderStatus(ctx, orderID, OrderStatusReadyForPickup)
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+		notifier.AssertExpectations(t)
+	})
+
+	t.Run("ReadyForPickupNeedsSelfPickup", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+		mockRepo.On("GetOrderDetail", ctx, orderID).Return(&Order{Status: OrderStatusAccepted, ShippingMethod: ShippingMethodStandard}, nil)
+		mockRepo.On("IsOrderPacked", ctx, orderID).Return(true, nil)
+
+		err := svc.UpdateOrderStatus(ctx, orderID, OrderStatusReadyForPickup)
+		assert.ErrorIs(t, err, ErrNotPickupOrder)
+		mockRepo.AssertNotCalled(t, "UpdateOrderStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("SelfPickupDoesNotShip", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+		mockRepo.On("GetOrderDetail", ctx, orderID).Return(&Order{Status: OrderStatusAccepted, ShippingMethod: ShippingMethodSelfPickup}, nil)
+		mockRepo.On("IsOrderPacked", ctx, orderID).Return(true, nil)
+
+		err := svc.UpdateOrderStatus(ctx, orderID, OrderStatusShipped)
+		assert.ErrorIs(t, err, ErrPickupOrderShipped)
+	})
+
+	t.Run("PickupCompletesOnlyWithCode", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+		mockRepo.On("GetOrderDetail", ctx, orderID).Return(&Order{Status: OrderStatusReadyForPickup, ShippingMethod: ShippingMethodSelfPickup}, nil)
+
+		err := svc.UpdateOrderStatus(ctx, orderID, OrderStatusCompleted)
+		assert.ErrorIs(t, err, ErrPickupCodeRequired)
+		mockRepo.AssertNotCalled(t, "UpdateOrderStatus", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
 	t.Run("OrderNotFound", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
 		mockRepo.On("GetOrderDetail", ctx, orderID).Return(nil, nil) // nil order
 		err := svc.UpdateOrderStatus(ctx, orderID, OrderStatusPaid)
 		assert.Error(t, err)
@@ -712,7 +1327,7 @@ func TestService_UpdateOrderStatus(t *testing.T) {
 
 	t.Run("RepoError_GetOrder", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
 		mockRepo.On("GetOrderDetail", ctx, orderID).Return(nil, errors.New("db error"))
 		err := svc.UpdateOrderStatus(ctx, orderID, OrderStatusPaid)
 		assert.Error(t, err)
@@ -720,7 +1335,7 @@ func TestService_UpdateOrderStatus(t *testing.T) {
 
 	t.Run("RepoError_Update", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
 		mockOrder := &Order{Status: OrderStatusPendingPayment}
 		mockRepo.On("GetOrderDetail", ctx, orderID).Return(mockOrder, nil)
 		mockRepo.On("UpdateOrderStatus", ctx, orderID, OrderStatusPaid, (*string)(nil)).Return(errors.New("update error"))
@@ -742,9 +1357,9 @@ func TestService_ConfirmSession(t *testing.T) {
 		mockRepo := new(MockRepository)
 		mockPayRepo := new(MockPaymentRepository)
 		mockPayGate := new(MockPaymentGateway)
-		mockUserRepo := new(MockUserRepository)
+		mockUserRepo := unrestricted()
 		mockAddrRepo := new(MockAddressRepository)
-		svc := NewService(mockRepo, mockPayRepo, mockPayGate, mockAddrRepo, mockUserRepo)
+		svc := NewService(mockRepo, mockPayRepo, mockPayGate, address.NewService(mockAddrRepo), mockUserRepo, nil, nil, nil)
 
 		pm := payment.MethodBCAVA
 
@@ -764,8 +1379,13 @@ func TestService_ConfirmSession(t *testing.T) {
 
 		// 1. Get Session
 		mockRepo.On("GetCheckoutSession", mock.Anything, externalID).Return(mockSession, nil).Times(1)
+		mockRepo.On("ListCurrentPolicies", ctx).Return([]*Policy{}, nil)
+		mockAddrRepo.On("GetByID", ctx, mock.Anything).Return(ownedAddress(&address.Address{}, userID), nil)
+		mockRepo.On("ListCheckoutRules", ctx, true).Return([]*CheckoutRule{}, nil)
 
 		// 2. Validate Stock
+		mockRepo.On("InactiveVariants", ctx, mock.Anything).Return([]string(nil), nil)
+		mockRepo.On("VariantsOnVacation", ctx, mock.Anything).Return([]string(nil), nil)
 		mockRepo.On("ValidateVariantStock", ctx, "v1", 1).Return(true, nil)
 
 		// 3. Idempotency Check (No existing order)
@@ -794,7 +1414,7 @@ func TestService_ConfirmSession(t *testing.T) {
 		// 9. Get Address (fallback for phone)
 		mockAddrRepo.On("GetByID", ctx, addrID).Return(&address.Address{Phone: "08123456789"}, nil)
 
-		res, err := svc.ConfirmSession(ctx, externalID)
+		res, err := svc.ConfirmSession(ctx, externalID, nil)
 
 		assert.NoError(t, err)
 		assert.NotNil(t, res)
@@ -807,7 +1427,8 @@ func TestService_ConfirmSession(t *testing.T) {
 
 	t.Run("OutOfStock", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
+		mockAddrRepo := new(MockAddressRepository)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), unrestricted(), nil, nil, nil)
 
 		mockSession := &CheckoutSession{
 			ID:         sessionID,
@@ -822,14 +1443,78 @@ func TestService_ConfirmSession(t *testing.T) {
 		}
 
 		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(mockSession, nil)
+		mockRepo.On("ListCurrentPolicies", ctx).Return([]*Policy{}, nil)
+		mockAddrRepo.On("GetByID", ctx, mock.Anything).Return(ownedAddress(&address.Address{}, userID), nil)
+		mockRepo.On("ListCheckoutRules", ctx, true).Return([]*CheckoutRule{}, nil)
+		mockRepo.On("InactiveVariants", ctx, mock.Anything).Return([]string(nil), nil)
+		mockRepo.On("VariantsOnVacation", ctx, mock.Anything).Return([]string(nil), nil)
 		mockRepo.On("ValidateVariantStock", ctx, "v1", 1).Return(false, nil)
 
-		_, err := svc.ConfirmSession(ctx, externalID)
+		_, err := svc.ConfirmSession(ctx, externalID, nil)
 
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "product out of stock")
 		mockRepo.AssertExpectations(t)
 	})
+
+	t.Run("SellerOnVacation", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockAddrRepo := new(MockAddressRepository)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), unrestricted(), nil, nil, nil)
+
+		mockSession := &CheckoutSession{
+			ID:         sessionID,
+			ExternalID: externalID,
+			UserID:     &userInt32,
+			Status:     CheckoutSessionStatusPending,
+			ExpiresAt:  now,
+			AddressID:  &addrID,
+			Items: []CheckoutSessionItem{
+				{VariantID: "v1", Quantity: 1},
+				{VariantID: "v2", Quantity: 2},
+			},
+		}
+
+		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(mockSession, nil)
+		mockRepo.On("ListCurrentPolicies", ctx).Return([]*Policy{}, nil)
+		mockAddrRepo.On("GetByID", ctx, mock.Anything).Return(ownedAddress(&address.Address{}, userID), nil)
+		mockRepo.On("ListCheckoutRules", ctx, true).Return([]*CheckoutRule{}, nil)
+		mockRepo.On("InactiveVariants", ctx, []string{"v1", "v2"}).Return([]string(nil), nil)
+		mockRepo.On("VariantsOnVacation", ctx, []string{"v1", "v2"}).Return([]string{"v2"}, nil)
+
+		_, err := svc.ConfirmSession(ctx, externalID, nil)
+
+		assert.ErrorIs(t, err, ErrSellerOnVacation)
+		mockRepo.AssertNotCalled(t, "ValidateVariantStock", mock.Anything, mock.Anything, mock.Anything)
+		mockRepo.AssertNotCalled(t, "CreateOrderTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("VariantNoLongerSold", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockAddrRepo := new(MockAddressRepository)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), unrestricted(), nil, nil, nil)
+
+		mockSession := &CheckoutSession{
+			ID:         sessionID,
+			ExternalID: externalID,
+			UserID:     &userInt32,
+			Status:     CheckoutSessionStatusPending,
+			ExpiresAt:  now,
+			AddressID:  &addrID,
+			Items:      []CheckoutSessionItem{{VariantID: "v1", Quantity: 1}},
+		}
+
+		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(mockSession, nil)
+		mockRepo.On("ListCurrentPolicies", ctx).Return([]*Policy{}, nil)
+		mockAddrRepo.On("GetByID", ctx, mock.Anything).Return(ownedAddress(&address.Address{}, userID), nil)
+		mockRepo.On("ListCheckoutRules", ctx, true).Return([]*CheckoutRule{}, nil)
+		mockRepo.On("InactiveVariants", ctx, []string{"v1"}).Return([]string{"v1"}, nil)
+
+		_, err := svc.ConfirmSession(ctx, externalID, nil)
+
+		assert.ErrorIs(t, err, ErrVariantUnavailable)
+		mockRepo.AssertNotCalled(t, "CreateOrderTx", mock.Anything, mock.Anything, mock.Anything)
+	})
 }
 
 func TestService_UpdateSessionAddress(t *testing.T) {
@@ -842,7 +1527,8 @@ func TestService_UpdateSessionAddress(t *testing.T) {
 
 	t.Run("Success", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
+		mockAddrRepo := new(MockAddressRepository)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), nil, nil, nil, nil)
 
 		mockSession := &CheckoutSession{
 			UserID:    &userInt32,
@@ -857,10 +1543,19 @@ func TestService_UpdateSessionAddress(t *testing.T) {
 		}
 
 		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(mockSession, nil)
-		mockRepo.On("GetUserAddress", ctx, addrIDStr, userID).Return(mockAddr, nil)
+		mockAddrRepo.On("GetByID", ctx, mock.Anything).Return(ownedAddress(mockAddr, userID), nil)
+		mockRepo.On("ListCheckoutRules", ctx, true).Return([]*CheckoutRule{}, nil)
 		mockRepo.On("UpdateSessionAddressAndPricing", ctx, mockSession).Return(nil)
+		mockRepo.On("InsertSessionEvent", ctx, mock.MatchedBy(func(e *SessionEvent) bool {
+			return e.Type == SessionEventAddressChanged &&
+				e.FromValue == nil &&
+				*e.ToValue == addrIDStr &&
+				*e.ActorUserID == userInt32 &&
+				e.TotalBefore == 0 &&
+				e.TotalAfter == mockSession.TotalPrice
+		})).Return(nil)
 
-		err := svc.UpdateSessionAddress(ctx, externalID, addrIDStr, nil)
+		err := svc.UpdateSessionAddress(ctx, externalID, addrIDStr)
 
 		assert.NoError(t, err)
 		mockRepo.AssertExpectations(t)
@@ -868,7 +1563,7 @@ func TestService_UpdateSessionAddress(t *testing.T) {
 
 	t.Run("Expired", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
 
 		mockSession := &CheckoutSession{
 			UserID:    &userInt32,
@@ -878,14 +1573,14 @@ func TestService_UpdateSessionAddress(t *testing.T) {
 
 		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(mockSession, nil)
 
-		err := svc.UpdateSessionAddress(ctx, externalID, addrIDStr, nil)
+		err := svc.UpdateSessionAddress(ctx, externalID, addrIDStr)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "checkout session expired")
 	})
 
 	t.Run("NotEditable", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
 
 		mockSession := &CheckoutSession{
 			UserID: &userInt32,
@@ -894,18 +1589,18 @@ func TestService_UpdateSessionAddress(t *testing.T) {
 
 		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(mockSession, nil)
 
-		err := svc.UpdateSessionAddress(ctx, externalID, addrIDStr, nil)
+		err := svc.UpdateSessionAddress(ctx, externalID, addrIDStr)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "checkout session is not editable")
 	})
 
 	t.Run("Guest_Success", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
+		mockAddrRepo := new(MockAddressRepository)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), nil, nil, nil, nil)
 
-		ctxGuest := context.Background()
 		guestID := uuid.New()
-		guestIDStr := guestID.String()
+		ctxGuest := utils.WithPrincipal(context.Background(), &utils.Principal{GuestID: guestID.String()})
 
 		mockSession := &CheckoutSession{
 			GuestID:   &guestID,
@@ -917,701 +1612,3189 @@ func TestService_UpdateSessionAddress(t *testing.T) {
 		mockAddr := &address.Address{ID: uuid.MustParse(addrIDStr), City: "Jakarta"}
 
 		mockRepo.On("GetCheckoutSession", ctxGuest, externalID).Return(mockSession, nil)
-		mockRepo.On("GetUserAddress", ctxGuest, addrIDStr, uint(0)).Return(mockAddr, nil)
+		mockAddrRepo.On("GetByID", ctxGuest, mock.Anything).Return(ownedAddress(mockAddr, uint(0)), nil)
+		mockRepo.On("ListCheckoutRules", ctxGuest, true).Return([]*CheckoutRule{}, nil)
 		mockRepo.On("UpdateSessionAddressAndPricing", ctxGuest, mockSession).Return(nil)
+		mockRepo.On("InsertSessionEvent", ctxGuest, mock.AnythingOfType("*order.SessionEvent")).Return(nil)
 
-		err := svc.UpdateSessionAddress(ctxGuest, externalID, addrIDStr, &guestIDStr)
+		err := svc.UpdateSessionAddress(ctxGuest, externalID, addrIDStr)
 		assert.NoError(t, err)
 	})
 
 	t.Run("Guest_Forbidden_Mismatch", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
 
-		ctxGuest := context.Background()
 		guestID := uuid.New()
-		otherGuestIDStr := uuid.New().String()
+		ctxGuest := utils.WithPrincipal(context.Background(), &utils.Principal{GuestID: uuid.New().String()})
 
 		mockSession := &CheckoutSession{GuestID: &guestID}
 		mockRepo.On("GetCheckoutSession", ctxGuest, externalID).Return(mockSession, nil)
 
-		err := svc.UpdateSessionAddress(ctxGuest, externalID, addrIDStr, &otherGuestIDStr)
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "forbidden")
+		err := svc.UpdateSessionAddress(ctxGuest, externalID, addrIDStr)
+		assert.ErrorIs(t, err, ErrGuestMismatch)
 	})
 
 	t.Run("RepoError_GetSession", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
 		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(nil, errors.New("db error"))
-		err := svc.UpdateSessionAddress(ctx, externalID, addrIDStr, nil)
+		err := svc.UpdateSessionAddress(ctx, externalID, addrIDStr)
 		assert.Error(t, err)
 	})
 
 	t.Run("RepoError_GetAddress", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
+		mockAddrRepo := new(MockAddressRepository)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), nil, nil, nil, nil)
 		mockSession := &CheckoutSession{UserID: &userInt32, Status: CheckoutSessionStatusPending, ExpiresAt: now}
 		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(mockSession, nil)
-		mockRepo.On("GetUserAddress", ctx, addrIDStr, userID).Return(nil, errors.New("addr error"))
-		err := svc.UpdateSessionAddress(ctx, externalID, addrIDStr, nil)
+		mockAddrRepo.On("GetByID", ctx, mock.Anything).Return(nil, errors.New("addr error"))
+		err := svc.UpdateSessionAddress(ctx, externalID, addrIDStr)
 		assert.Error(t, err)
 	})
 
 	t.Run("RepoError_Update", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
+		mockAddrRepo := new(MockAddressRepository)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), nil, nil, nil, nil)
 		mockSession := &CheckoutSession{UserID: &userInt32, Status: CheckoutSessionStatusPending, ExpiresAt: now}
 		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(mockSession, nil)
-		mockRepo.On("GetUserAddress", ctx, addrIDStr, userID).Return(&address.Address{ID: uuid.MustParse(addrIDStr)}, nil)
+		mockAddrRepo.On("GetByID", ctx, mock.Anything).Return(ownedAddress(&address.Address{ID: uuid.MustParse(addrIDStr)}, userID), nil)
+		mockRepo.On("ListCheckoutRules", ctx, true).Return([]*CheckoutRule{}, nil)
 		mockRepo.On("UpdateSessionAddressAndPricing", ctx, mockSession).Return(errors.New("update error"))
-		err := svc.UpdateSessionAddress(ctx, externalID, addrIDStr, nil)
+		err := svc.UpdateSessionAddress(ctx, externalID, addrIDStr)
 		assert.Error(t, err)
 	})
 
 	t.Run("ShippingFee_Jakarta", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
+		mockAddrRepo := new(MockAddressRepository)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), nil, nil, nil, nil)
 		mockSession := &CheckoutSession{UserID: &userInt32, Status: CheckoutSessionStatusPending, ExpiresAt: now}
 		mockAddr := &address.Address{ID: uuid.MustParse(addrIDStr), City: "Jakarta"}
 
 		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(mockSession, nil)
-		mockRepo.On("GetUserAddress", ctx, addrIDStr, userID).Return(mockAddr, nil)
+		mockAddrRepo.On("GetByID", ctx, mock.Anything).Return(ownedAddress(mockAddr, userID), nil)
+		mockRepo.On("ListCheckoutRules", ctx, true).Return([]*CheckoutRule{}, nil)
 		// Expect shipping fee 10000 for Jakarta
 		mockRepo.On("UpdateSessionAddressAndPricing", ctx, mock.MatchedBy(func(s *CheckoutSession) bool {
 			return s.ShippingFee == 10000
 		})).Return(nil)
+		mockRepo.On("InsertSessionEvent", ctx, mock.AnythingOfType("*order.SessionEvent")).Return(nil)
 
-		err := svc.UpdateSessionAddress(ctx, externalID, addrIDStr, nil)
+		err := svc.UpdateSessionAddress(ctx, externalID, addrIDStr)
 		assert.NoError(t, err)
 	})
 
 	t.Run("ShippingFee_Other", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
+		mockAddrRepo := new(MockAddressRepository)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), nil, nil, nil, nil)
 		mockSession := &CheckoutSession{UserID: &userInt32, Status: CheckoutSessionStatusPending, ExpiresAt: now}
 		mockAddr := &address.Address{ID: uuid.MustParse(addrIDStr), City: "Bandung"}
 
 		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(mockSession, nil)
-		mockRepo.On("GetUserAddress", ctx, addrIDStr, userID).Return(mockAddr, nil)
+		mockAddrRepo.On("GetByID", ctx, mock.Anything).Return(ownedAddress(mockAddr, userID), nil)
+		mockRepo.On("ListCheckoutRules", ctx, true).Return([]*CheckoutRule{}, nil)
 		// Expect shipping fee 20000 for non-Jakarta
 		mockRepo.On("UpdateSessionAddressAndPricing", ctx, mock.MatchedBy(func(s *CheckoutSession) bool {
 			return s.ShippingFee == 20000
 		})).Return(nil)
+		mockRepo.On("InsertSessionEvent", ctx, mock.AnythingOfType("*order.SessionEvent")).Return(nil)
 
-		err := svc.UpdateSessionAddress(ctx, externalID, addrIDStr, nil)
+		err := svc.UpdateSessionAddress(ctx, externalID, addrIDStr)
 		assert.NoError(t, err)
 	})
-}
 
-func TestService_MarkAsPaid(t *testing.T) {
-	ctx := context.Background()
-	refID := "ord-ref-1"
-	payReqID := "pay-req-1"
-	provID := "prov-1"
-
-	t.Run("Success", func(t *testing.T) {
+	t.Run("ShippingFee_ByWeight", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
-
-		mockOrder := &Order{
-			Status: OrderStatusPendingPayment,
+		mockAddrRepo := new(MockAddressRepository)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), nil, nil, nil, nil)
+		mockSession := &CheckoutSession{
+			UserID: &userInt32, Status: CheckoutSessionStatusPending, ExpiresAt: now,
+			ChargeableWeightGrams: 3200,
 		}
+		mockAddr := &address.Address{ID: uuid.MustParse(addrIDStr), City: "Bandung"}
 
-		mockRepo.On("GetByReferenceID", ctx, refID).Return(mockOrder, nil)
-		mockRepo.On("UpdateStatusByReferenceID", ctx, refID, payReqID, provID, "PAID").Return(nil)
+		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(mockSession, nil)
+		mockAddrRepo.On("GetByID", ctx, mock.Anything).Return(ownedAddress(mockAddr, userID), nil)
+		mockRepo.On("ListCheckoutRules", ctx, true).Return([]*CheckoutRule{}, nil)
+		// 3.2kg bills as 4kg: first kg plus three more
+		mockRepo.On("UpdateSessionAddressAndPricing", ctx, mock.MatchedBy(func(s *CheckoutSession) bool {
+			return s.ShippingFee == 20000+3*8000
+		})).Return(nil)
+		mockRepo.On("InsertSessionEvent", ctx, mock.AnythingOfType("*order.SessionEvent")).Return(nil)
 
-		err := svc.MarkAsPaid(ctx, refID, payReqID, provID)
+		err := svc.UpdateSessionAddress(ctx, externalID, addrIDStr)
 		assert.NoError(t, err)
-		mockRepo.AssertExpectations(t)
 	})
+}
 
-	t.Run("AlreadyPaid", func(t *testing.T) {
-		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
+func TestChargeableWeightGrams(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     *product.ShippingSpec
+		quantity int
+		want     int
+	}{
+		{"NoSpec", nil, 3, 0},
+		{"ActualHeavier", &product.ShippingSpec{WeightGrams: 5000, LengthCm: 30, WidthCm: 20, HeightCm: 10}, 2, 10000},
+		// 60x40x30 cm is 12kg volumetric, more than the 2kg it weighs
+		{"VolumetricHeavier", &product.ShippingSpec{WeightGrams: 2000, LengthCm: 60, WidthCm: 40, HeightCm: 30}, 1, 12000},
+		{"ZeroQuantity", &product.ShippingSpec{WeightGrams: 500}, 0, 0},
+	}
 
-		mockOrder := &Order{Status: OrderStatusPaid}
-		mockRepo.On("GetByReferenceID", ctx, refID).Return(mockOrder, nil)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, chargeableWeightGrams(tt.spec, tt.quantity))
+		})
+	}
+}
 
-		err := svc.MarkAsPaid(ctx, refID, payReqID, provID)
-		assert.NoError(t, err) // Should return nil (idempotent)
+func TestAddToParcel(t *testing.T) {
+	bekasi := &product.Product{ShipsFrom: &product.ShipsFrom{OriginID: "o1", City: "Bekasi"}}
+	platform := &product.Product{}
+
+	var parcels []ShippingParcel
+	parcels = addToParcel(parcels, bekasi, 1000)
+	parcels = addToParcel(parcels, platform, 500)
+	parcels = addToParcel(parcels, bekasi, 2500)
+
+	require.Len(t, parcels, 2)
+	assert.Equal(t, "Bekasi", parcels[0].OriginCity)
+	assert.Equal(t, 3500, parcels[0].WeightGrams)
+	assert.Nil(t, parcels[1].OriginID)
+	assert.Equal(t, platformOriginCity, parcels[1].OriginCity)
+	assert.Equal(t, 4000, parcelsWeight(parcels))
+}
+
+func TestService_CalculateShippingFee_PerOrigin(t *testing.T) {
+	svc := NewService(new(MockRepository), nil, nil, nil, nil, nil, nil, nil).(*service)
+	originID := "o1"
+	dest := &address.Address{City: "bandung"}
+
+	fee := svc.calculateShippingFee(dest, []ShippingParcel{
+		// same city: 1.2kg bills as 2kg
+		{OriginID: &originID, OriginCity: "Bandung", WeightGrams: 1200},
+		{OriginCity: platformOriginCity, WeightGrams: 500},
 	})
+	assert.Equal(t, (10000+5000)+20000, fee)
 
-	t.Run("InvalidTransition_FailedToPaid", func(t *testing.T) {
-		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
+	// A session from before origins ships everything from the platform
+	legacy := &CheckoutSession{ChargeableWeightGrams: 3200}
+	assert.Equal(t, 20000+3*8000, svc.calculateShippingFee(dest, legacy.parcels()))
+}
 
-		mockOrder := &Order{Status: OrderStatusFailed}
-		mockRepo.On("GetByReferenceID", ctx, refID).Return(mockOrder, nil)
+var (
+	monas   = geo.Point{Lat: -6.1754, Lng: 106.8272}
+	senayan = geo.Point{Lat: -6.2183, Lng: 106.8022}
+	bandung = geo.Point{Lat: -6.9175, Lng: 107.6191}
+)
 
-		err := svc.MarkAsPaid(ctx, refID, payReqID, provID)
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "invalid status transition")
-	})
+func TestInstantQuote(t *testing.T) {
+	originID := "o1"
+	parcels := []ShippingParcel{{OriginID: &originID, OriginCity: "Jakarta", OriginLocation: &monas, WeightGrams: 3000}}
 
-	t.Run("RepoError_GetOrder", func(t *testing.T) {
-		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
-		mockRepo.On("GetByReferenceID", ctx, refID).Return(nil, errors.New("db error"))
-		err := svc.MarkAsPaid(ctx, refID, payReqID, provID)
-		assert.Error(t, err)
-	})
+	t.Run("WithinRadius", func(t *testing.T) {
+		fee, km, err := instantQuote(parcels, &address.Address{Location: &senayan})
 
-	t.Run("RepoError_UpdateStatus", func(t *testing.T) {
-		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
-		mockOrder := &Order{Status: OrderStatusPendingPayment}
-		mockRepo.On("GetByReferenceID", ctx, refID).Return(mockOrder, nil)
-		mockRepo.On("UpdateStatusByReferenceID", ctx, refID, payReqID, provID, "PAID").Return(errors.New("update error"))
-		err := svc.MarkAsPaid(ctx, refID, payReqID, provID)
-		assert.Error(t, err)
+		require.NoError(t, err)
+		// about 5.5 km, billed as 6
+		assert.InDelta(t, 5.5, *km, 0.2)
+		assert.Equal(t, instantBaseFee+6*instantPerKmFee, fee)
 	})
-}
-
-func TestService_MarkAsFailed(t *testing.T) {
-	ctx := context.Background()
-	refID := "ord-ref-1"
-	payReqID := "pay-req-1"
-	provID := "prov-1"
 
-	t.Run("Success", func(t *testing.T) {
-		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
+	t.Run("OutsideRadius", func(t *testing.T) {
+		_, km, err := instantQuote(parcels, &address.Address{Location: &bandung})
 
-		mockOrder := &Order{
-			Status: OrderStatusPendingPayment,
-		}
+		assert.ErrorIs(t, err, ErrOutsideInstantRadius)
+		assert.Greater(t, *km, InstantRadiusKm)
+	})
 
-		mockRepo.On("GetByReferenceID", ctx, refID).Return(mockOrder, nil)
-		mockRepo.On("UpdateStatusByReferenceID", ctx, refID, payReqID, provID, "FAILED").Return(nil)
+	t.Run("AddressNotPinned", func(t *testing.T) {
+		_, km, err := instantQuote(parcels, &address.Address{})
 
-		err := svc.MarkAsFailed(ctx, refID, payReqID, provID)
-		assert.NoError(t, err)
-		mockRepo.AssertExpectations(t)
+		assert.ErrorIs(t, err, ErrAddressNotPinned)
+		assert.Nil(t, km)
 	})
 
-	t.Run("AlreadyFailed", func(t *testing.T) {
-		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
+	t.Run("OriginNotPinned", func(t *testing.T) {
+		platform := append(slices.Clone(parcels), ShippingParcel{OriginCity: platformOriginCity, WeightGrams: 500})
 
-		mockOrder := &Order{Status: OrderStatusFailed}
-		mockRepo.On("GetByReferenceID", ctx, refID).Return(mockOrder, nil)
+		_, _, err := instantQuote(platform, &address.Address{Location: &senayan})
 
-		err := svc.MarkAsFailed(ctx, refID, payReqID, provID)
-		assert.NoError(t, err)
+		assert.ErrorIs(t, err, ErrNoInstantFromOrigin)
 	})
 
-	t.Run("InvalidTransition_PaidToFailed", func(t *testing.T) {
-		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
+	t.Run("TooHeavy", func(t *testing.T) {
+		heavy := []ShippingParcel{{OriginID: &originID, OriginLocation: &monas, WeightGrams: 25000}}
 
-		mockOrder := &Order{Status: OrderStatusPaid}
-		mockRepo.On("GetByReferenceID", ctx, refID).Return(mockOrder, nil)
+		_, _, err := instantQuote(heavy, &address.Address{Location: &senayan})
 
-		err := svc.MarkAsFailed(ctx, refID, payReqID, provID)
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "invalid status transition")
+		assert.ErrorIs(t, err, ErrTooHeavyForInstant)
 	})
 }
 
-func TestService_GetOrderDetailByExternalID(t *testing.T) {
+func TestService_ShippingOptions(t *testing.T) {
 	userID := uint(1)
 	userInt32 := int32(userID)
 	ctx := utils.SetUserContext(context.Background(), userID, "test@example.com", "user")
-	extID := "ord-ext-1"
+	externalID := "sess-ext-1"
 	addrID := uuid.New()
+	originID := "o1"
+
+	newSession := func() *CheckoutSession {
+		return &CheckoutSession{
+			UserID:         &userInt32,
+			Status:         CheckoutSessionStatusPending,
+			ExpiresAt:      time.Now().Add(time.Hour),
+			AddressID:      &addrID,
+			Subtotal:       50000,
+			ShippingMethod: ShippingMethodStandard,
+			ShippingParcels: []ShippingParcel{
+				{OriginID: &originID, OriginCity: "Jakarta", OriginLocation: &monas, WeightGrams: 1500},
+			},
+		}
+	}
 
-	t.Run("Success", func(t *testing.T) {
+	t.Run("InstantAvailable", func(t *testing.T) {
 		mockRepo := new(MockRepository)
 		mockAddrRepo := new(MockAddressRepository)
-		svc := NewService(mockRepo, nil, nil, mockAddrRepo, nil)
-
-		mockOrder := &Order{ID: 1, ExternalID: extID, UserID: &userInt32, AddressID: addrID}
-		mockAddr := &address.Address{ID: addrID}
-
-		mockRepo.On("GetOrderDetailByExternalID", ctx, extID).Return(mockOrder, nil)
-		mockAddrRepo.On("GetByID", ctx, addrID).Return(mockAddr, nil)
-
-		res, _, err := svc.GetOrderDetailByExternalID(ctx, extID)
-		assert.NoError(t, err)
-		assert.Equal(t, extID, res.ExternalID)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), nil, nil, nil, nil)
+
+		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(newSession(), nil)
+		mockAddrRepo.On("GetByID", ctx, addrID).Return(ownedAddress(&address.Address{City: "Jakarta", Location: &senayan}, userID), nil)
+		mockRepo.On("ListCheckoutRules", ctx, true).Return([]*CheckoutRule{}, nil)
+		mockRepo.On("ListPickupLocations", ctx, true).Return([]*PickupLocation{{ID: 1, IsActive: true}}, nil)
+
+		options, err := svc.ShippingOptions(ctx, externalID)
+
+		require.NoError(t, err)
+		require.Len(t, options, 3)
+		assert.Equal(t, ShippingMethodStandard, options[0].Method)
+		assert.Equal(t, 10000+5000, options[0].Fee)
+		assert.True(t, options[1].Available)
+		assert.Equal(t, instantBaseFee+6*instantPerKmFee, options[1].Fee)
+		assert.NotNil(t, options[1].DistanceKm)
+		assert.Nil(t, options[1].Reason)
+		assert.Equal(t, ShippingMethodSelfPickup, options[2].Method)
+		assert.True(t, options[2].Available)
+		assert.Zero(t, options[2].Fee)
+		// 0.2% and 0.5% of the subtotal; pickup is not insured.
+		assert.Equal(t, 100, *options[0].InsuranceFee)
+		assert.Equal(t, 250, *options[1].InsuranceFee)
+		assert.Nil(t, options[2].InsuranceFee)
 	})
 
-	t.Run("NotFound", func(t *testing.T) {
+	t.Run("InstantUnavailable", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
+		mockAddrRepo := new(MockAddressRepository)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), nil, nil, nil, nil)
 
-		mockRepo.On("GetOrderDetailByExternalID", ctx, extID).Return(nil, nil)
+		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(newSession(), nil)
+		mockAddrRepo.On("GetByID", ctx, addrID).Return(ownedAddress(&address.Address{City: "Bandung", Location: &bandung}, userID), nil)
+		mockRepo.On("ListCheckoutRules", ctx, true).Return([]*CheckoutRule{}, nil)
+		mockRepo.On("ListPickupLocations", ctx, true).Return([]*PickupLocation{}, nil)
 
-		_, _, err := svc.GetOrderDetailByExternalID(ctx, extID)
-		assert.Error(t, err)
-		assert.Equal(t, ErrOrderNotFound, err)
+		options, err := svc.ShippingOptions(ctx, externalID)
+
+		require.NoError(t, err)
+		assert.True(t, options[0].Available)
+		assert.False(t, options[1].Available)
+		require.NotNil(t, options[1].Reason)
+		assert.Equal(t, ErrOutsideInstantRadius.Error(), *options[1].Reason)
+		assert.False(t, options[2].Available)
 	})
 
-	t.Run("Unauthenticated", func(t *testing.T) {
+	t.Run("NoAddress", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
-		ctx := context.Background()
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
 
-		mockOrder := &Order{ID: 1, ExternalID: extID}
-		mockRepo.On("GetOrderDetailByExternalID", ctx, extID).Return(mockOrder, nil)
+		session := newSession()
+		session.AddressID = nil
+		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(session, nil)
 
-		_, _, err := svc.GetOrderDetailByExternalID(ctx, extID)
-		assert.Error(t, err)
-		assert.Equal(t, ErrUnauthorized, err)
+		_, err := svc.ShippingOptions(ctx, externalID)
+
+		assert.ErrorIs(t, err, ErrShippingAddressNotSet)
 	})
+}
 
-	t.Run("Unauthorized_WrongUser", func(t *testing.T) {
-		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
+func TestService_UpdateSessionShippingMethod(t *testing.T) {
+	userID := uint(1)
+	userInt32 := int32(userID)
+	ctx := utils.SetUserContext(context.Background(), userID, "test@example.com", "user")
+	externalID := "sess-ext-1"
+	addrID := uuid.New()
+	originID := "o1"
+
+	newSession := func() *CheckoutSession {
+		return &CheckoutSession{
+			UserID:         &userInt32,
+			Status:         CheckoutSessionStatusPending,
+			ExpiresAt:      time.Now().Add(time.Hour),
+			AddressID:      &addrID,
+			Subtotal:       50000,
+			ShippingFee:    15000,
+			TotalPrice:     65000,
+			ShippingMethod: ShippingMethodStandard,
+			ShippingParcels: []ShippingParcel{
+				{OriginID: &originID, OriginCity: "Jakarta", OriginLocation: &monas, WeightGrams: 1500},
+			},
+		}
+	}
+
+	t.Run("Instant", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockAddrRepo := new(MockAddressRepository)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), nil, nil, nil, nil)
+
+		session := newSession()
+		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(session, nil)
+		mockAddrRepo.On("GetByID", ctx, addrID).Return(ownedAddress(&address.Address{Location: &senayan}, userID), nil)
+		mockRepo.On("UpdateSessionShippingMethod", ctx, session).Return(nil)
+		mockRepo.On("InsertSessionEvent", ctx, mock.MatchedBy(func(e *SessionEvent) bool {
+			return e.Type == SessionEventShippingMethodChanged &&
+				*e.FromValue == "STANDARD" &&
+				*e.ToValue == "INSTANT" &&
+				e.TotalBefore == 65000
+		})).Return(nil)
+
+		got, err := svc.UpdateSessionShippingMethod(ctx, externalID, ShippingMethodInstant, nil, nil)
+
+		require.NoError(t, err)
+		assert.Equal(t, ShippingMethodInstant, got.ShippingMethod)
+		assert.Equal(t, instantBaseFee+6*instantPerKmFee, got.ShippingFee)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("OutOfReach", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockAddrRepo := new(MockAddressRepository)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), nil, nil, nil, nil)
+
+		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(newSession(), nil)
+		mockAddrRepo.On("GetByID", ctx, addrID).Return(ownedAddress(&address.Address{Location: &bandung}, userID), nil)
+
+		_, err := svc.UpdateSessionShippingMethod(ctx, externalID, ShippingMethodInstant, nil, nil)
+
+		assert.ErrorIs(t, err, ErrOutsideInstantRadius)
+		mockRepo.AssertNotCalled(t, "UpdateSessionShippingMethod", mock.Anything, mock.Anything)
+	})
+
+	t.Run("InstantWithSlot", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockAddrRepo := new(MockAddressRepository)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), nil, nil, nil, nil).(*service)
+		svc.now = func() time.Time { return pickupNow }
+
+		session := newSession()
+		region := "DKI Jakarta"
+		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(session, nil)
+		mockAddrRepo.On("GetByID", ctx, addrID).Return(ownedAddress(&address.Address{Province: region, Location: &senayan}, userID), nil)
+		mockRepo.On("ListDeliverySlots", ctx, &region, true).Return(jakartaSlots(), nil)
+		mockRepo.On("CountDeliveryBookings", ctx, []int32{1, 2, 3}, mock.Anything).Return(map[int32]int{3: 1}, nil)
+		mockRepo.On("UpdateSessionShippingMethod", ctx, session).Return(nil)
+		mockRepo.On("InsertSessionEvent", ctx, mock.Anything).Return(nil)
+
+		slotID := int32(3)
+		got, err := svc.UpdateSessionShippingMethod(ctx, externalID, ShippingMethodInstant, nil, &slotID)
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), *got.DeliverySlotID)
+		assert.Equal(t, "2026-03-03", got.DeliveryDate.Format(time.DateOnly))
+	})
+
+	t.Run("InstantSlotFull", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockAddrRepo := new(MockAddressRepository)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), nil, nil, nil, nil).(*service)
+		svc.now = func() time.Time { return pickupNow }
+
+		region := "DKI Jakarta"
+		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(newSession(), nil)
+		mockAddrRepo.On("GetByID", ctx, addrID).Return(ownedAddress(&address.Address{Province: region, Location: &senayan}, userID), nil)
+		mockRepo.On("ListDeliverySlots", ctx, &region, true).Return(jakartaSlots(), nil)
+		mockRepo.On("CountDeliveryBookings", ctx, []int32{1, 2, 3}, mock.Anything).Return(map[int32]int{2: 2}, nil)
+
+		slotID := int32(2)
+		_, err := svc.UpdateSessionShippingMethod(ctx, externalID, ShippingMethodInstant, nil, &slotID)
+
+		assert.ErrorIs(t, err, ErrDeliverySlotFull)
+		mockRepo.AssertNotCalled(t, "UpdateSessionShippingMethod", mock.Anything, mock.Anything)
+	})
+
+	t.Run("DeliverySlotNeedsInstant", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockAddrRepo := new(MockAddressRepository)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), nil, nil, nil, nil)
+
+		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(newSession(), nil)
+		mockAddrRepo.On("GetByID", ctx, addrID).Return(ownedAddress(&address.Address{}, userID), nil)
+
+		slotID := int32(3)
+		_, err := svc.UpdateSessionShippingMethod(ctx, externalID, ShippingMethodStandard, nil, &slotID)
+
+		assert.ErrorIs(t, err, ErrDeliverySlotNeedsInstant)
+	})
+
+	t.Run("SelfPickup", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockAddrRepo := new(MockAddressRepository)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), nil, nil, nil, nil).(*service)
+		svc.now = func() time.Time { return pickupNow }
+
+		session := newSession()
+		slotStart := time.Date(2026, 3, 3, 10, 0, 0, 0, svc.loc)
+		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(session, nil)
+		mockAddrRepo.On("GetByID", ctx, addrID).Return(ownedAddress(&address.Address{}, userID), nil)
+		mockRepo.On("GetPickupLocation", ctx, int32(4)).Return(pickupCounter(), nil)
+		mockRepo.On("UpdateSessionShippingMethod", ctx, session).Return(nil)
+		mockRepo.On("InsertSessionEvent", ctx, mock.Anything).Return(nil)
+
+		got, err := svc.UpdateSessionShippingMethod(ctx, externalID, ShippingMethodSelfPickup,
+			&PickupChoice{LocationID: 4, SlotStart: slotStart}, nil)
+
+		require.NoError(t, err)
+		assert.Zero(t, got.ShippingFee)
+		assert.Equal(t, got.Subtotal+got.Tax, got.TotalPrice)
+		assert.Equal(t, int32(4), *got.PickupLocationID)
+		assert.True(t, slotStart.Equal(*got.PickupSlotStart))
+	})
+
+	t.Run("SelfPickupSlotTaken", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockAddrRepo := new(MockAddressRepository)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), nil, nil, nil, nil).(*service)
+		svc.now = func() time.Time { return pickupNow }
+
+		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(newSession(), nil)
+		mockAddrRepo.On("GetByID", ctx, addrID).Return(ownedAddress(&address.Address{}, userID), nil)
+		mockRepo.On("GetPickupLocation", ctx, int32(4)).Return(pickupCounter(), nil)
+
+		// Outside opening hours.
+		_, err := svc.UpdateSessionShippingMethod(ctx, externalID, ShippingMethodSelfPickup,
+			&PickupChoice{LocationID: 4, SlotStart: time.Date(2026, 3, 3, 20, 0, 0, 0, svc.loc)}, nil)
+
+		assert.ErrorIs(t, err, ErrPickupSlotUnavailable)
+		mockRepo.AssertNotCalled(t, "UpdateSessionShippingMethod", mock.Anything, mock.Anything)
+	})
+
+	t.Run("SelfPickupWithoutChoice", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockAddrRepo := new(MockAddressRepository)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), nil, nil, nil, nil)
+
+		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(newSession(), nil)
+		mockAddrRepo.On("GetByID", ctx, addrID).Return(ownedAddress(&address.Address{}, userID), nil)
+
+		_, err := svc.UpdateSessionShippingMethod(ctx, externalID, ShippingMethodSelfPickup, nil, nil)
+
+		assert.ErrorIs(t, err, ErrPickupNotChosen)
+	})
+
+	t.Run("UnknownMethod", func(t *testing.T) {
+		svc := NewService(new(MockRepository), nil, nil, nil, nil, nil, nil, nil)
+
+		_, err := svc.UpdateSessionShippingMethod(ctx, externalID, "DRONE", nil, nil)
+
+		assert.ErrorIs(t, err, ErrInvalidShippingMethod)
+	})
+}
+
+func TestInsuranceQuote(t *testing.T) {
+	fee, ok := insuranceQuote(ShippingMethodStandard, 123456)
+	assert.True(t, ok)
+	assert.Equal(t, 247, fee, "rounded up from 246.912")
+
+	fee, ok = insuranceQuote(ShippingMethodInstant, 100000)
+	assert.True(t, ok)
+	assert.Equal(t, 500, fee)
+
+	_, ok = insuranceQuote(ShippingMethodSelfPickup, 100000)
+	assert.False(t, ok)
+}
+
+func TestService_UpdateSessionInsurance(t *testing.T) {
+	userID := uint(1)
+	userInt32 := int32(userID)
+	ctx := utils.SetUserContext(context.Background(), userID, "test@example.com", "user")
+	externalID := "sess-ext-1"
+
+	newSession := func(method ShippingMethod) *CheckoutSession {
+		return &CheckoutSession{
+			UserID:         &userInt32,
+			Status:         CheckoutSessionStatusPending,
+			ExpiresAt:      time.Now().Add(time.Hour),
+			Subtotal:       50000,
+			Tax:            5000,
+			ShippingFee:    15000,
+			TotalPrice:     70000,
+			ShippingMethod: method,
+		}
+	}
+
+	t.Run("Insures", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+
+		session := newSession(ShippingMethodStandard)
+		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(session, nil)
+		mockRepo.On("UpdateSessionInsurance", ctx, session).Return(nil)
+		mockRepo.On("InsertSessionEvent", ctx, mock.MatchedBy(func(e *SessionEvent) bool {
+			return e.Type == SessionEventInsuranceChanged &&
+				*e.FromValue == "false" &&
+				*e.ToValue == "true" &&
+				e.TotalBefore == 70000 &&
+				e.TotalAfter == 70100
+		})).Return(nil)
+
+		got, err := svc.UpdateSessionInsurance(ctx, externalID, true)
+
+		require.NoError(t, err)
+		assert.True(t, got.Insured)
+		assert.Equal(t, 100, got.InsuranceFee())
+		assert.Equal(t, 70100, got.TotalPrice)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("SelfPickup", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+
+		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(newSession(ShippingMethodSelfPickup), nil)
+
+		_, err := svc.UpdateSessionInsurance(ctx, externalID, true)
+
+		assert.ErrorIs(t, err, ErrInsuranceUnavailable)
+		mockRepo.AssertNotCalled(t, "UpdateSessionInsurance", mock.Anything, mock.Anything)
+	})
+
+	t.Run("NotOwner", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+
+		session := newSession(ShippingMethodStandard)
+		other := int32(2)
+		session.UserID = &other
+		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(session, nil)
+
+		_, err := svc.UpdateSessionInsurance(ctx, externalID, true)
+
+		assert.ErrorIs(t, err, ErrSessionForbidden)
+	})
+}
+
+// pickupNow is 08:00 in Jakarta.
+var pickupNow = time.Date(2026, 3, 3, 1, 0, 0, 0, time.UTC)
+
+func pickupCounter() *PickupLocation {
+	return &PickupLocation{ID: 4, Name: "Counter Kemang", OpensAt: "09:00", ClosesAt: "17:00", SlotMinutes: 60, IsActive: true}
+}
+
+func TestPickupLocation_Slots(t *testing.T) {
+	jakarta, err := time.LoadLocation("Asia/Jakarta")
+	require.NoError(t, err)
+
+	slots := pickupCounter().slots(pickupNow, jakarta)
+
+	// 10:00 is the first slot two hours out; today has 10:00-16:00, the
+	// next two days 09:00-16:00.
+	require.Len(t, slots, 7+8+8)
+	assert.True(t, slots[0].Start.Equal(time.Date(2026, 3, 3, 10, 0, 0, 0, jakarta)))
+	assert.True(t, slots[0].End.Equal(time.Date(2026, 3, 3, 11, 0, 0, 0, jakarta)))
+	assert.True(t, slots[len(slots)-1].End.Equal(time.Date(2026, 3, 5, 17, 0, 0, 0, jakarta)))
+
+	_, ok := pickupCounter().slotAt(time.Date(2026, 3, 3, 9, 0, 0, 0, jakarta), pickupNow, jakarta)
+	assert.False(t, ok, "slot inside the lead time")
+	_, ok = pickupCounter().slotAt(time.Date(2026, 3, 4, 9, 30, 0, 0, jakarta), pickupNow, jakarta)
+	assert.False(t, ok, "not a slot boundary")
+	_, ok = pickupCounter().slotAt(time.Date(2026, 3, 6, 9, 0, 0, 0, jakarta), pickupNow, jakarta)
+	assert.False(t, ok, "too far ahead")
+}
+
+// jakartaSlots are a slot too close to pickupNow, a full one and an
+// open one.
+func jakartaSlots() []*DeliverySlot {
+	return []*DeliverySlot{
+		{ID: 1, Region: "DKI Jakarta", StartsAt: "08:30", EndsAt: "10:00", Capacity: 5, IsActive: true},
+		{ID: 2, Region: "DKI Jakarta", StartsAt: "10:00", EndsAt: "12:00", Capacity: 2, IsActive: true},
+		{ID: 3, Region: "DKI Jakarta", StartsAt: "13:00", EndsAt: "15:00", Capacity: 5, IsActive: true},
+	}
+}
+
+func TestService_DeliveryOptions(t *testing.T) {
+	ctx := context.Background()
+	region := "DKI Jakarta"
+	mockRepo := new(MockRepository)
+	svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil).(*service)
+	svc.now = func() time.Time { return pickupNow }
+	today := time.Date(2026, 3, 3, 0, 0, 0, 0, svc.loc)
+
+	mockRepo.On("ListDeliverySlots", ctx, &region, true).Return(jakartaSlots(), nil)
+	mockRepo.On("CountDeliveryBookings", ctx, []int32{1, 2, 3}, today).Return(map[int32]int{2: 2, 3: 1}, nil)
+
+	options, err := svc.deliveryOptions(ctx, region)
+
+	require.NoError(t, err)
+	require.Len(t, options, 3)
+	assert.False(t, options[0].Available, "starts within the lead time")
+	assert.Equal(t, 5, options[0].Remaining)
+	assert.False(t, options[1].Available, "full")
+	assert.Zero(t, options[1].Remaining)
+	assert.True(t, options[2].Available)
+	assert.Equal(t, 4, options[2].Remaining)
+	assert.True(t, options[2].Start.Equal(time.Date(2026, 3, 3, 13, 0, 0, 0, svc.loc)))
+}
+
+func TestService_ConfirmedDelivery(t *testing.T) {
+	ctx := context.Background()
+	region := "DKI Jakarta"
+	newService := func(slots []*DeliverySlot) *service {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil).(*service)
+		svc.now = func() time.Time { return pickupNow }
+		mockRepo.On("ListDeliverySlots", ctx, &region, true).Return(slots, nil)
+		mockRepo.On("CountDeliveryBookings", ctx, mock.Anything, mock.Anything).Return(map[int32]int{}, nil)
+		return svc
+	}
+	slotID := int32(3)
+
+	t.Run("Books", func(t *testing.T) {
+		svc := newService(jakartaSlots())
+		today := time.Date(2026, 3, 3, 0, 0, 0, 0, time.UTC)
+
+		got, err := svc.confirmedDelivery(ctx, &CheckoutSession{DeliverySlotID: &slotID, DeliveryDate: &today}, region)
+
+		require.NoError(t, err)
+		assert.Equal(t, int32(3), got.SlotID)
+		assert.True(t, got.WindowEnd.Equal(time.Date(2026, 3, 3, 15, 0, 0, 0, svc.loc)))
+	})
+
+	t.Run("Required", func(t *testing.T) {
+		_, err := newService(jakartaSlots()).confirmedDelivery(ctx, &CheckoutSession{}, region)
+		assert.ErrorIs(t, err, ErrDeliverySlotRequired)
+	})
+
+	t.Run("RegionWithoutSlots", func(t *testing.T) {
+		got, err := newService([]*DeliverySlot{}).confirmedDelivery(ctx, &CheckoutSession{}, region)
+		assert.NoError(t, err)
+		assert.Nil(t, got)
+	})
+
+	t.Run("ChosenYesterday", func(t *testing.T) {
+		yesterday := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+		_, err := newService(jakartaSlots()).confirmedDelivery(ctx,
+			&CheckoutSession{DeliverySlotID: &slotID, DeliveryDate: &yesterday}, region)
+		assert.ErrorIs(t, err, ErrDeliverySlotUnavailable)
+	})
+}
+
+func TestService_VerifyPickupCode(t *testing.T) {
+	orderID := uint(100)
+	adminCtx := utils.SetUserContext(context.Background(), 9, "admin@example.com", "ADMIN")
+	readyOrder := func() *Order {
+		return &Order{ID: 100, Status: OrderStatusReadyForPickup, ShippingMethod: ShippingMethodSelfPickup}
+	}
+	pickup := &OrderPickup{Location: pickupCounter(), Code: "042917"}
+
+	t.Run("HandsOver", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+		mockRepo.On("GetOrderDetail", adminCtx, orderID).Return(readyOrder(), nil)
+		mockRepo.On("GetOrderPickup", adminCtx, orderID).Return(pickup, nil)
+		mockRepo.On("CompletePickup", adminCtx, orderID, uint(9)).Return(nil)
+
+		err := svc.VerifyPickupCode(adminCtx, orderID, " 042917 ")
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("WrongCode", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+		mockRepo.On("GetOrderDetail", adminCtx, orderID).Return(readyOrder(), nil)
+		mockRepo.On("GetOrderPickup", adminCtx, orderID).Return(pickup, nil)
+
+		err := svc.VerifyPickupCode(adminCtx, orderID, "000000")
+
+		assert.ErrorIs(t, err, ErrPickupCodeMismatch)
+		mockRepo.AssertNotCalled(t, "CompletePickup", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("NotReady", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+		o := readyOrder()
+		o.Status = OrderStatusAccepted
+		mockRepo.On("GetOrderDetail", adminCtx, orderID).Return(o, nil)
+
+		err := svc.VerifyPickupCode(adminCtx, orderID, "042917")
+
+		assert.ErrorIs(t, err, ErrNotReadyForPickup)
+	})
+
+	t.Run("AdminOnly", func(t *testing.T) {
+		svc := NewService(new(MockRepository), nil, nil, nil, nil, nil, nil, nil)
+		userCtx := utils.SetUserContext(context.Background(), 7, "user@example.com", "USER")
+
+		err := svc.VerifyPickupCode(userCtx, orderID, "042917")
+
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+}
+
+func TestService_SetPickupLocation(t *testing.T) {
+	adminCtx := utils.SetUserContext(context.Background(), 9, "admin@example.com", "ADMIN")
+	input := func() model.SetPickupLocationInput {
+		return model.SetPickupLocationInput{
+			Name: " Counter Kemang ", Address: "Jl. Kemang Raya 1", City: "Jakarta",
+			OpensAt: "09:00", ClosesAt: "17:00", SlotMinutes: 60, IsActive: true,
+		}
+	}
+
+	t.Run("Saves", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+		mockRepo.On("SavePickupLocation", adminCtx, mock.MatchedBy(func(l *PickupLocation) bool {
+			return l.ID == 0 && l.Name == "Counter Kemang" && l.SlotMinutes == 60
+		})).Return(pickupCounter(), nil)
+
+		saved, err := svc.SetPickupLocation(adminCtx, input())
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(4), saved.ID)
+	})
+
+	t.Run("SlotLongerThanDay", func(t *testing.T) {
+		svc := NewService(new(MockRepository), nil, nil, nil, nil, nil, nil, nil)
+		in := input()
+		in.ClosesAt = "09:30"
+
+		_, err := svc.SetPickupLocation(adminCtx, in)
+
+		assert.ErrorIs(t, err, ErrInvalidPickupLocation)
+	})
+
+	t.Run("BadClock", func(t *testing.T) {
+		svc := NewService(new(MockRepository), nil, nil, nil, nil, nil, nil, nil)
+		in := input()
+		in.OpensAt = "9am"
+
+		_, err := svc.SetPickupLocation(adminCtx, in)
+
+		assert.ErrorIs(t, err, ErrInvalidPickupLocation)
+	})
+}
+
+func TestService_ConfirmSession_Policies(t *testing.T) {
+	userID := uint(1)
+	userInt32 := int32(userID)
+	ctx := utils.SetUserContext(context.Background(), userID, "test@example.com", "user")
+	externalID := "sess-ext-1"
+	current := []*Policy{
+		{ID: 3, Kind: PolicyKindTerms, Version: 2},
+		{ID: 4, Kind: PolicyKindRefund, Version: 1},
+	}
+	session := func() *CheckoutSession {
+		return &CheckoutSession{
+			ExternalID: externalID,
+			UserID:     &userInt32,
+			Status:     CheckoutSessionStatusPending,
+			ExpiresAt:  time.Now().Add(time.Hour),
+		}
+	}
+
+	t.Run("NoneAccepted", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, unrestricted(), nil, nil, nil)
+		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(session(), nil)
+		mockRepo.On("ListCurrentPolicies", ctx).Return(current, nil)
+
+		_, err := svc.ConfirmSession(ctx, externalID, nil)
+
+		assert.ErrorIs(t, err, ErrPolicyNotAccepted)
+		mockRepo.AssertNotCalled(t, "CreateOrderTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("OutdatedVersion", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, unrestricted(), nil, nil, nil)
+		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(session(), nil)
+		mockRepo.On("ListCurrentPolicies", ctx).Return(current, nil)
+
+		// Terms version 1 was accepted before version 2 was published.
+		_, err := svc.ConfirmSession(ctx, externalID, []int32{1, 4})
+
+		assert.ErrorIs(t, err, ErrPolicyNotAccepted)
+	})
+}
+
+func TestService_PublishPolicy(t *testing.T) {
+	adminCtx := utils.SetUserContext(context.Background(), 9, "admin@example.com", "ADMIN")
+	input := model.PublishPolicyInput{
+		Kind: model.PolicyKindRefund, Title: " Refund Policy ", Body: "Refunds within 7 days.",
+	}
+
+	t.Run("Publishes", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+		mockRepo.On("PublishPolicy", adminCtx, mock.MatchedBy(func(p *Policy) bool {
+			return p.Kind == PolicyKindRefund && p.Title == "Refund Policy" &&
+				p.PublishedBy != nil && *p.PublishedBy == 9
+		})).Return(&Policy{ID: 5, Kind: PolicyKindRefund, Version: 2}, nil)
+
+		saved, err := svc.PublishPolicy(adminCtx, input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 2, saved.Version)
+	})
+
+	t.Run("EmptyBody", func(t *testing.T) {
+		svc := NewService(new(MockRepository), nil, nil, nil, nil, nil, nil, nil)
+		in := input
+		in.Body = "  "
+
+		_, err := svc.PublishPolicy(adminCtx, in)
+
+		assert.ErrorIs(t, err, ErrInvalidPolicy)
+	})
+
+	t.Run("NotAdmin", func(t *testing.T) {
+		svc := NewService(new(MockRepository), nil, nil, nil, nil, nil, nil, nil)
+		ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "user")
+
+		_, err := svc.PublishPolicy(ctx, input)
+
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+}
+
+func TestService_CancelOrder(t *testing.T) {
+	orderID := uint(100)
+	ctx := context.Background()
+	changedMind := func() *Reason {
+		return &Reason{ID: 1, Code: "CHANGED_MIND", Kind: ReasonKindCancellation, IsActive: true}
+	}
+
+	t.Run("CancelsWithReasonAndNote", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+		mockRepo.On("GetReason", ctx, int32(1)).Return(changedMind(), nil)
+		mockRepo.On("GetOrderDetail", ctx, orderID).Return(&Order{Status: OrderStatusPaid}, nil)
+		mockRepo.On("CancelOrder", ctx, orderID, int32(1), mock.MatchedBy(func(note *string) bool {
+			return note != nil && *note == "customer called"
+		})).Return(nil)
+
+		note := "  customer called "
+		err := svc.CancelOrder(ctx, orderID, 1, &note)
+
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("StatusUpdateCannotCancel", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+
+		err := svc.UpdateOrderStatus(ctx, orderID, OrderStatusCancelled)
+
+		assert.ErrorIs(t, err, ErrCancelReasonRequired)
+		mockRepo.AssertNotCalled(t, "GetOrderDetail", mock.Anything, mock.Anything)
+	})
+
+	t.Run("MissingReason", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+
+		err := svc.CancelOrder(ctx, orderID, 0, nil)
+
+		assert.ErrorIs(t, err, ErrCancelReasonRequired)
+		mockRepo.AssertNotCalled(t, "GetReason", mock.Anything, mock.Anything)
+	})
+
+	t.Run("ReturnReasonCannotCancel", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+		mockRepo.On("GetReason", ctx, int32(10)).Return(&Reason{
+			ID: 10, Code: "DAMAGED", Kind: ReasonKindReturn, IsActive: true,
+		}, nil)
+
+		err := svc.CancelOrder(ctx, orderID, 10, nil)
+
+		assert.ErrorIs(t, err, ErrCancelReasonRequired)
+		mockRepo.AssertNotCalled(t, "CancelOrder", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("InactiveReason", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+		reason := changedMind()
+		reason.IsActive = false
+		mockRepo.On("GetReason", ctx, int32(1)).Return(reason, nil)
+
+		err := svc.CancelOrder(ctx, orderID, 1, nil)
+
+		assert.ErrorIs(t, err, ErrCancelReasonRequired)
+	})
+}
+
+func TestService_SetOrderReason(t *testing.T) {
+	adminCtx := utils.SetUserContext(context.Background(), 9, "admin@example.com", "ADMIN")
+	input := model.SetOrderReasonInput{
+		Code: " wrong_size ", Kind: model.OrderReasonKindReturn, Audience: model.OrderReasonAudienceCustomer,
+		Label: "Wrong size", Position: 60, IsActive: true,
+	}
+
+	t.Run("Saves", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+		mockRepo.On("SaveReason", adminCtx, mock.MatchedBy(func(r *Reason) bool {
+			return r.ID == 0 && r.Code == "WRONG_SIZE" && r.Kind == ReasonKindReturn
+		})).Return(&Reason{ID: 16, Code: "WRONG_SIZE"}, nil)
+
+		saved, err := svc.SetOrderReason(adminCtx, input)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int32(16), saved.ID)
+	})
+
+	t.Run("InvalidCode", func(t *testing.T) {
+		svc := NewService(new(MockRepository), nil, nil, nil, nil, nil, nil, nil)
+		in := input
+		in.Code = "wrong size"
+
+		_, err := svc.SetOrderReason(adminCtx, in)
+
+		assert.ErrorIs(t, err, ErrInvalidReason)
+	})
+
+	t.Run("NotAdmin", func(t *testing.T) {
+		svc := NewService(new(MockRepository), nil, nil, nil, nil, nil, nil, nil)
+		ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "user")
+
+		_, err := svc.SetOrderReason(ctx, input)
+
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+}
+
+func TestService_OrderReasonStats(t *testing.T) {
+	adminCtx := utils.SetUserContext(context.Background(), 9, "admin@example.com", "ADMIN")
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	t.Run("Loads", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+		mockRepo.On("ReasonStats", adminCtx, ReasonKindCancellation, from, to).Return([]*ReasonStat{
+			{Reason: &Reason{ID: 8, Code: "PAYMENT_EXPIRED"}, Orders: 12, Amount: 1500000},
+		}, nil)
+
+		stats, err := svc.OrderReasonStats(adminCtx, ReasonKindCancellation, from, to)
+
+		assert.NoError(t, err)
+		assert.Len(t, stats, 1)
+	})
+
+	t.Run("EmptyRange", func(t *testing.T) {
+		svc := NewService(new(MockRepository), nil, nil, nil, nil, nil, nil, nil)
+
+		_, err := svc.OrderReasonStats(adminCtx, ReasonKindReturn, to, from)
+
+		assert.ErrorIs(t, err, ErrInvalidReason)
+	})
+}
+
+func TestService_MarkAsPaid(t *testing.T) {
+	ctx := context.Background()
+	refID := "ord-ref-1"
+	capture := payment.Capture{ExternalReference: "pay-req-1", ProviderPaymentID: "prov-1", Amount: 30000}
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+
+		mockOrder := &Order{
+			Status: OrderStatusPendingPayment,
+		}
+
+		mockRepo.On("GetByReferenceID", ctx, refID).Return(mockOrder, nil)
+		mockRepo.On("MarkPaidByReferenceID", ctx, refID, capture).Return(nil)
+
+		err := svc.MarkAsPaid(ctx, refID, capture)
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("AlreadyPaid", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+
+		mockOrder := &Order{Status: OrderStatusPaid}
+		mockRepo.On("GetByReferenceID", ctx, refID).Return(mockOrder, nil)
+
+		err := svc.MarkAsPaid(ctx, refID, capture)
+		assert.NoError(t, err) // Should return nil (idempotent)
+	})
+
+	t.Run("InvalidTransition_FailedToPaid", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+
+		mockOrder := &Order{Status: OrderStatusFailed}
+		mockRepo.On("GetByReferenceID", ctx, refID).Return(mockOrder, nil)
+
+		err := svc.MarkAsPaid(ctx, refID, capture)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid status transition")
+	})
+
+	t.Run("InvalidTransition_CancelledToPaid", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+
+		mockRepo.On("GetByReferenceID", ctx, refID).Return(&Order{Status: OrderStatusCancelled}, nil)
+
+		err := svc.MarkAsPaid(ctx, refID, capture)
+		assert.ErrorIs(t, err, statemachine.ErrInvalidTransition)
+		mockRepo.AssertNotCalled(t, "MarkPaidByReferenceID", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("RepoError_GetOrder", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+		mockRepo.On("GetByReferenceID", ctx, refID).Return(nil, errors.New("db error"))
+		err := svc.MarkAsPaid(ctx, refID, capture)
+		assert.Error(t, err)
+	})
+
+	t.Run("RepoError_UpdateStatus", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+		mockOrder := &Order{Status: OrderStatusPendingPayment}
+		mockRepo.On("GetByReferenceID", ctx, refID).Return(mockOrder, nil)
+		mockRepo.On("MarkPaidByReferenceID", ctx, refID, capture).Return(errors.New("update error"))
+		err := svc.MarkAsPaid(ctx, refID, capture)
+		assert.Error(t, err)
+	})
+}
+
+func TestService_MarkAsFailed(t *testing.T) {
+	ctx := context.Background()
+	refID := "ord-ref-1"
+	payReqID := "pay-req-1"
+	provID := "prov-1"
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+
+		mockOrder := &Order{
+			Status: OrderStatusPendingPayment,
+		}
+
+		mockRepo.On("GetByReferenceID", ctx, refID).Return(mockOrder, nil)
+		mockRepo.On("UpdateStatusByReferenceID", ctx, refID, payReqID, provID, "FAILED").Return(nil)
+
+		err := svc.MarkAsFailed(ctx, refID, payReqID, provID)
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("AlreadyFailed", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+
+		mockOrder := &Order{Status: OrderStatusFailed}
+		mockRepo.On("GetByReferenceID", ctx, refID).Return(mockOrder, nil)
+
+		err := svc.MarkAsFailed(ctx, refID, payReqID, provID)
+		assert.NoError(t, err)
+	})
+
+	t.Run("InvalidTransition_AcceptedToFailed", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+
+		mockRepo.On("GetByReferenceID", ctx, refID).Return(&Order{Status: OrderStatusAccepted}, nil)
+
+		err := svc.MarkAsFailed(ctx, refID, payReqID, provID)
+		assert.ErrorIs(t, err, statemachine.ErrInvalidTransition)
+	})
+
+	t.Run("InvalidTransition_PaidToFailed", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+
+		mockOrder := &Order{Status: OrderStatusPaid}
+		mockRepo.On("GetByReferenceID", ctx, refID).Return(mockOrder, nil)
+
+		err := svc.MarkAsFailed(ctx, refID, payReqID, provID)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid status transition")
+	})
+}
+
+type MockNotifier struct {
+	mock.Mock
+}
+
+func (m *MockNotifier) NotifyPaymentExpired(ctx context.Context, d *preference.Delivery, p *ExpiredPayment) error {
+	args := m.Called(ctx, d, p)
+	return args.Error(0)
+}
+
+func (m *MockNotifier) NotifyReadyForPickup(ctx context.Context, d *preference.Delivery, userID *int32, orderExternalID string, p *OrderPickup) error {
+	args := m.Called(ctx, d, userID, orderExternalID, p)
+	return args.Error(0)
+}
+
+func (m *MockNotifier) NotifyOrderAdjusted(ctx context.Context, d *preference.Delivery, userID *int32, orderExternalID string, a *OrderAdjustment, total uint) error {
+	args := m.Called(ctx, d, userID, orderExternalID, a, total)
+	return args.Error(0)
+}
+
+type MockPreferences struct {
+	mock.Mock
+}
+
+func (m *MockPreferences) DeliveryFor(ctx context.Context, userID int32, event preference.Event) *preference.Delivery {
+	return m.Called(ctx, userID, event).Get(0).(*preference.Delivery)
+}
+
+func TestService_ExpireUnpaidOrders(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	userID := int32(7)
+
+	newService := func() (*service, *MockRepository, *MockNotifier) {
+		mockRepo := new(MockRepository)
+		notifier := new(MockNotifier)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil).(*service)
+		svc.notifier = notifier
+		svc.now = func() time.Time { return now }
+		return svc, mockRepo, notifier
+	}
+	email := &preference.Delivery{Locale: "id", Channels: []preference.Channel{preference.ChannelEmail}}
+
+	t.Run("CancelsAndNotifies", func(t *testing.T) {
+		svc, mockRepo, notifier := newService()
+		prefs := new(MockPreferences)
+		svc.prefs = prefs
+
+		expired := &ExpiredPayment{OrderID: 1, OrderExternalID: "ord-1", PaymentRequestID: "pr-1", UserID: &userID}
+		mockRepo.On("ListExpiredPayments", ctx, now, int32(paymentExpiryBatchSize)).
+			Return([]*ExpiredPayment{expired}, nil)
+		mockRepo.On("ExpireOrderPayment", ctx, "ord-1", "pr-1").Return(nil)
+		prefs.On("DeliveryFor", ctx, userID, preference.EventOrderUpdates).Return(email)
+		notifier.On("NotifyPaymentExpired", ctx, email, expired).Return(nil)
+
+		n, err := svc.ExpireUnpaidOrders(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+		mockRepo.AssertExpectations(t)
+		notifier.AssertExpectations(t)
+	})
+
+	t.Run("MutedSkipsNotice", func(t *testing.T) {
+		svc, mockRepo, notifier := newService()
+		prefs := new(MockPreferences)
+		svc.prefs = prefs
+
+		mockRepo.On("ListExpiredPayments", ctx, now, int32(paymentExpiryBatchSize)).Return([]*ExpiredPayment{
+			{OrderID: 1, OrderExternalID: "ord-1", PaymentRequestID: "pr-1", UserID: &userID},
+		}, nil)
+		mockRepo.On("ExpireOrderPayment", ctx, "ord-1", "pr-1").Return(nil)
+		prefs.On("DeliveryFor", ctx, userID, preference.EventOrderUpdates).Return(&preference.Delivery{Locale: "id"})
+
+		n, err := svc.ExpireUnpaidOrders(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+		notifier.AssertNotCalled(t, "NotifyPaymentExpired", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("SkipsPaidMeanwhile", func(t *testing.T) {
+		svc, mockRepo, notifier := newService()
+
+		mockRepo.On("ListExpiredPayments", ctx, now, int32(paymentExpiryBatchSize)).Return([]*ExpiredPayment{
+			{OrderID: 1, OrderExternalID: "ord-1", PaymentRequestID: "pr-1"},
+			{OrderID: 2, OrderExternalID: "ord-2", PaymentRequestID: "pr-2"},
+		}, nil)
+		mockRepo.On("ExpireOrderPayment", ctx, "ord-1", "pr-1").Return(ErrPaymentNotPending)
+		mockRepo.On("ExpireOrderPayment", ctx, "ord-2", "pr-2").Return(nil)
+		notifier.On("NotifyPaymentExpired", ctx, preference.DefaultDelivery(preference.EventOrderUpdates), mock.MatchedBy(func(p *ExpiredPayment) bool {
+			return p.OrderExternalID == "ord-2"
+		})).Return(nil)
+
+		n, err := svc.ExpireUnpaidOrders(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+		notifier.AssertNumberOfCalls(t, "NotifyPaymentExpired", 1)
+	})
+
+	t.Run("ContinuesAfterError", func(t *testing.T) {
+		svc, mockRepo, notifier := newService()
+
+		mockRepo.On("ListExpiredPayments", ctx, now, int32(paymentExpiryBatchSize)).Return([]*ExpiredPayment{
+			{OrderID: 1, OrderExternalID: "ord-1", PaymentRequestID: "pr-1"},
+			{OrderID: 2, OrderExternalID: "ord-2", PaymentRequestID: "pr-2"},
+		}, nil)
+		mockRepo.On("ExpireOrderPayment", ctx, "ord-1", "pr-1").Return(ErrDB)
+		mockRepo.On("ExpireOrderPayment", ctx, "ord-2", "pr-2").Return(nil)
+		// A failed notice does not undo the cancel
+		notifier.On("NotifyPaymentExpired", ctx, mock.Anything, mock.Anything).Return(errors.New("push down"))
+
+		n, err := svc.ExpireUnpaidOrders(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+	})
+
+	t.Run("ListError", func(t *testing.T) {
+		svc, mockRepo, _ := newService()
+
+		mockRepo.On("ListExpiredPayments", ctx, now, int32(paymentExpiryBatchSize)).Return(nil, ErrDB)
+
+		_, err := svc.ExpireUnpaidOrders(ctx)
+		assert.ErrorIs(t, err, ErrDB)
+	})
+}
+
+func TestService_ExpireSessions(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	graceBefore := now.Add(-sessionGrace)
+	userID := int32(7)
+	email := &preference.Delivery{Locale: "id", Channels: []preference.Channel{preference.ChannelEmail}}
+
+	newService := func() (*service, *MockRepository, *MockPaymentGateway, *MockNotifier) {
+		mockRepo := new(MockRepository)
+		gateway := new(MockPaymentGateway)
+		notifier := new(MockNotifier)
+		prefs := new(MockPreferences)
+		svc := NewService(mockRepo, nil, gateway, nil, nil, nil, nil, prefs).(*service)
+		svc.notifier = notifier
+		svc.now = func() time.Time { return now }
+		prefs.On("DeliveryFor", ctx, userID, preference.EventOrderUpdates).Return(email)
+		return svc, mockRepo, gateway, notifier
+	}
+	invoiced := func() *ExpiredSession {
+		orderExtID, orderID, requestID := "ord-2", int32(6), "pr-2"
+		return &ExpiredSession{
+			ID: uuid.New(), ExternalID: "sess-3",
+			OrderExternalID: &orderExtID, OrderID: &orderID, UserID: &userID, PaymentRequestID: &requestID,
+		}
+	}
+
+	t.Run("ExpiresSessionsAndStuckOrders", func(t *testing.T) {
+		svc, mockRepo, gateway, _ := newService()
+		open := uuid.New()
+		orderExtID := "ord-1"
+		orderID := int32(5)
+
+		mockRepo.On("ListExpiredSessions", ctx, now, graceBefore, int32(sessionExpiryBatchSize)).Return([]*ExpiredSession{
+			{ID: open, ExternalID: "sess-1"},
+			{ID: uuid.New(), ExternalID: "sess-2", OrderExternalID: &orderExtID, OrderID: &orderID},
+		}, nil)
+		mockRepo.On("MarkSessionExpired", ctx, open).Return(nil)
+		mockRepo.On("CancelUnpaidSessionOrder", ctx, orderExtID).Return(nil)
+
+		n, err := svc.ExpireSessions(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, n)
+		mockRepo.AssertExpectations(t)
+		gateway.AssertNotCalled(t, "CancelPayment", mock.Anything, mock.Anything)
+	})
+
+	t.Run("CancelsInvoiceThenOrder", func(t *testing.T) {
+		svc, mockRepo, gateway, notifier := newService()
+		es := invoiced()
+
+		mockRepo.On("ListExpiredSessions", ctx, now, graceBefore, int32(sessionExpiryBatchSize)).Return([]*ExpiredSession{es}, nil)
+		gateway.On("CancelPayment", ctx, "pr-2").Return(nil)
+		mockRepo.On("ExpireOrderPayment", ctx, "ord-2", "pr-2").Return(nil)
+		notifier.On("NotifyPaymentExpired", ctx, email, mock.MatchedBy(func(p *ExpiredPayment) bool {
+			return p.OrderExternalID == "ord-2" && p.PaymentRequestID == "pr-2"
+		})).Return(nil)
+
+		n, err := svc.ExpireSessions(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+		mockRepo.AssertExpectations(t)
+		gateway.AssertExpectations(t)
+		notifier.AssertExpectations(t)
+	})
+
+	t.Run("InvoiceAlreadyExpired", func(t *testing.T) {
+		svc, mockRepo, gateway, notifier := newService()
+
+		mockRepo.On("ListExpiredSessions", ctx, now, graceBefore, int32(sessionExpiryBatchSize)).Return([]*ExpiredSession{invoiced()}, nil)
+		gateway.On("CancelPayment", ctx, "pr-2").Return(payment.ErrPaymentAlreadyExpired)
+		mockRepo.On("ExpireOrderPayment", ctx, "ord-2", "pr-2").Return(nil)
+		notifier.On("NotifyPaymentExpired", ctx, email, mock.Anything).Return(nil)
+
+		n, err := svc.ExpireSessions(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("InvoiceAlreadyPaid", func(t *testing.T) {
+		svc, mockRepo, gateway, notifier := newService()
+
+		mockRepo.On("ListExpiredSessions", ctx, now, graceBefore, int32(sessionExpiryBatchSize)).Return([]*ExpiredSession{invoiced()}, nil)
+		gateway.On("CancelPayment", ctx, "pr-2").Return(payment.ErrPaymentAlreadyPaid)
+
+		n, err := svc.ExpireSessions(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, n)
+		mockRepo.AssertNotCalled(t, "ExpireOrderPayment", mock.Anything, mock.Anything, mock.Anything)
+		notifier.AssertNotCalled(t, "NotifyPaymentExpired", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("GatewayDown", func(t *testing.T) {
+		svc, mockRepo, gateway, _ := newService()
+
+		mockRepo.On("ListExpiredSessions", ctx, now, graceBefore, int32(sessionExpiryBatchSize)).Return([]*ExpiredSession{invoiced()}, nil)
+		gateway.On("CancelPayment", ctx, "pr-2").Return(payment.ErrGatewayUnavailable)
+
+		n, err := svc.ExpireSessions(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, n)
+		mockRepo.AssertNotCalled(t, "ExpireOrderPayment", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("OrderPaidMeanwhile", func(t *testing.T) {
+		svc, mockRepo, _, _ := newService()
+		orderExtID := "ord-1"
+		orderID := int32(5)
+
+		mockRepo.On("ListExpiredSessions", ctx, now, graceBefore, int32(sessionExpiryBatchSize)).Return([]*ExpiredSession{
+			{ID: uuid.New(), ExternalID: "sess-2", OrderExternalID: &orderExtID, OrderID: &orderID},
+		}, nil)
+		mockRepo.On("CancelUnpaidSessionOrder", ctx, orderExtID).Return(ErrPaymentNotPending)
+
+		n, err := svc.ExpireSessions(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, n)
+	})
+
+	t.Run("ListError", func(t *testing.T) {
+		svc, mockRepo, _, _ := newService()
+		mockRepo.On("ListExpiredSessions", ctx, now, graceBefore, int32(sessionExpiryBatchSize)).Return(nil, ErrDB)
+
+		_, err := svc.ExpireSessions(ctx)
+		assert.ErrorIs(t, err, ErrDB)
+	})
+}
+
+func TestExpiredPayment_Message(t *testing.T) {
+	p := &ExpiredPayment{OrderExternalID: "ord-1"}
+	assert.Contains(t, p.Message(), "ord-1")
+	assert.Contains(t, p.Message(), "expired")
+}
+
+func TestService_GetOrderDetailByExternalID(t *testing.T) {
+	userID := uint(1)
+	userInt32 := int32(userID)
+	ctx := utils.SetUserContext(context.Background(), userID, "test@example.com", "user")
+	extID := "ord-ext-1"
+	addrID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockAddrRepo := new(MockAddressRepository)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), nil, nil, nil, nil)
+
+		mockOrder := &Order{ID: 1, ExternalID: extID, UserID: &userInt32, AddressID: addrID}
+		mockAddr := &address.Address{ID: addrID}
+
+		mockRepo.On("GetOrderDetailByExternalID", ctx, extID).Return(mockOrder, nil)
+		mockRepo.On("ListOrderPolicies", ctx, mock.Anything).Return([]*Policy{}, nil)
+		mockAddrRepo.On("GetByID", ctx, addrID).Return(mockAddr, nil)
+
+		res, _, err := svc.GetOrderDetailByExternalID(ctx, extID)
+		assert.NoError(t, err)
+		assert.Equal(t, extID, res.ExternalID)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+
+		mockRepo.On("GetOrderDetailByExternalID", ctx, extID).Return(nil, nil)
+
+		_, _, err := svc.GetOrderDetailByExternalID(ctx, extID)
+		assert.Error(t, err)
+		assert.Equal(t, ErrOrderNotFound, err)
+	})
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+		ctx := context.Background()
+
+		mockOrder := &Order{ID: 1, ExternalID: extID}
+		mockRepo.On("GetOrderDetailByExternalID", ctx, extID).Return(mockOrder, nil)
+
+		_, _, err := svc.GetOrderDetailByExternalID(ctx, extID)
+		assert.Error(t, err)
+		assert.Equal(t, ErrUnauthorized, err)
+	})
+
+	t.Run("Unauthorized_WrongUser", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+
+		otherUser := int32(999)
+		mockOrder := &Order{ID: 1, ExternalID: extID, UserID: &otherUser}
+		mockRepo.On("GetOrderDetailByExternalID", ctx, extID).Return(mockOrder, nil)
+
+		_, _, err := svc.GetOrderDetailByExternalID(ctx, extID)
+		assert.Error(t, err)
+		assert.Equal(t, ErrUnauthorized, err)
+	})
+}
+
+func TestService_CreateSession(t *testing.T) {
+	userID := uint(1)
+	ctx := utils.SetUserContext(context.Background(), userID, "test@example.com", "user")
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, unrestricted(), nil, nil, nil)
+
+		input := model.CreateCheckoutSessionInput{
+			Items: []*model.CheckoutSessionItemInput{
+				{VariantID: "var-1", Quantity: 2},
+			},
+		}
+
+		mockVariant := &product.Variant{
+			ID:       "var-1",
+			Price:    5000,
+			Shipping: &product.ShippingSpec{WeightGrams: 750},
+		}
+		mockProduct := &product.Product{
+			ID:   "1",
+			Name: "Product 1",
+		}
+
+		// 2. Get Variant Info
+		mockRepo.On("GetVariantForCheckout", ctx, "var-1").Return(mockVariant, mockProduct, nil)
+		// 3. Create Session
+		mockRepo.On("CreateCheckoutSession", ctx, mock.AnythingOfType("*order.CheckoutSession"), mock.Anything).Return(nil)
+		// 4. Older open sessions are superseded
+		mockRepo.On("SupersedeOpenSessions", ctx, userID, mock.AnythingOfType("uuid.UUID")).Return(int64(1), nil)
+
+		res, err := svc.CreateSession(ctx, input)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, res)
+		assert.Equal(t, 11000, res.TotalPrice)
+		assert.Equal(t, 1500, res.ChargeableWeightGrams)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("InvalidQuantity", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, unrestricted(), nil, nil, nil)
+
+		input := model.CreateCheckoutSessionInput{
+			Items: []*model.CheckoutSessionItemInput{
+				{VariantID: "var-1", Quantity: 0},
+			},
+		}
+		_, err := svc.CreateSession(ctx, input)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "quantity must be greater than zero")
+	})
+
+	t.Run("RepoError_CreateSession", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, unrestricted(), nil, nil, nil)
+
+		input := model.CreateCheckoutSessionInput{
+			Items: []*model.CheckoutSessionItemInput{{VariantID: "var-1", Quantity: 1}},
+		}
+		mockRepo.On("GetVariantForCheckout", ctx, "var-1").Return(&product.Variant{Price: 1000}, &product.Product{}, nil)
+		mockRepo.On("CreateCheckoutSession", ctx, mock.Anything, mock.Anything).Return(errors.New("db error"))
+
+		_, err := svc.CreateSession(ctx, input)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "db error")
+	})
+
+	t.Run("GetVariantError", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, unrestricted(), nil, nil, nil)
+		input := model.CreateCheckoutSessionInput{
+			Items: []*model.CheckoutSessionItemInput{{VariantID: "var-1", Quantity: 1}},
+		}
+		mockRepo.On("GetVariantForCheckout", ctx, "var-1").Return(nil, nil, errors.New("var error"))
+
+		_, err := svc.CreateSession(ctx, input)
+		assert.Error(t, err)
+	})
+
+	t.Run("Guest", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, unrestricted(), nil, nil, nil)
+
+		guestID := uuid.New()
+		ctxGuest := utils.WithPrincipal(context.Background(), &utils.Principal{GuestID: guestID.String()})
+		input := model.CreateCheckoutSessionInput{
+			Items: []*model.CheckoutSessionItemInput{{VariantID: "var-1", Quantity: 1}},
+		}
+		mockRepo.On("CountOpenGuestSessions", ctxGuest, guestID).Return(2, nil)
+		mockRepo.On("GetVariantForCheckout", ctxGuest, "var-1").Return(&product.Variant{Price: 1000}, &product.Product{}, nil)
+		mockRepo.On("CreateCheckoutSession", ctxGuest, mock.MatchedBy(func(s *CheckoutSession) bool {
+			return s.UserID == nil && s.GuestID != nil && *s.GuestID == guestID
+		}), mock.Anything).Return(nil)
+
+		res, err := svc.CreateSession(ctxGuest, input)
+
+		require.NoError(t, err)
+		assert.Equal(t, guestID, *res.GuestID)
+		// Guests have no other sessions to supersede
+		mockRepo.AssertNotCalled(t, "SupersedeOpenSessions", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("GuestTooManyOpen", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, unrestricted(), nil, nil, nil)
+
+		guestID := uuid.New()
+		ctxGuest := utils.WithPrincipal(context.Background(), &utils.Principal{GuestID: guestID.String()})
+		input := model.CreateCheckoutSessionInput{
+			Items: []*model.CheckoutSessionItemInput{{VariantID: "var-1", Quantity: 1}},
+		}
+		mockRepo.On("CountOpenGuestSessions", ctxGuest, guestID).Return(MaxOpenGuestSessions, nil)
+
+		_, err := svc.CreateSession(ctxGuest, input)
+
+		assert.ErrorIs(t, err, ErrTooManyGuestSessions)
+		mockRepo.AssertNotCalled(t, "CreateCheckoutSession", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Anonymous", func(t *testing.T) {
+		svc := NewService(new(MockRepository), nil, nil, nil, unrestricted(), nil, nil, nil)
+		input := model.CreateCheckoutSessionInput{
+			Items: []*model.CheckoutSessionItemInput{{VariantID: "var-1", Quantity: 1}},
+		}
+
+		_, err := svc.CreateSession(context.Background(), input)
+		assert.ErrorIs(t, err, ErrUnauthorized)
+	})
+}
+
+func TestService_GetSession(t *testing.T) {
+	userID := uint(1)
+	userInt32 := int32(userID)
+	ctx := utils.SetUserContext(context.Background(), userID, "test@example.com", "user")
+	externalID := "sess-ext-1"
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+
+		mockSession := &CheckoutSession{
+			UserID:    &userInt32,
+			Status:    CheckoutSessionStatusPending,
+			ExpiresAt: time.Now().Add(1 * time.Hour),
+		}
+
+		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(mockSession, nil)
+
+		res, err := svc.GetSession(ctx, externalID)
+		assert.NoError(t, err)
+		assert.Equal(t, mockSession, res)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+
+		sessionID := uuid.New()
+		mockSession := &CheckoutSession{
+			ID:        sessionID,
+			UserID:    &userInt32,
+			Status:    CheckoutSessionStatusPending,
+			ExpiresAt: time.Now().Add(-1 * time.Hour), // Expired
+		}
+
+		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(mockSession, nil)
+		mockRepo.On("MarkSessionExpired", ctx, sessionID).Return(nil)
+
+		res, err := svc.GetSession(ctx, externalID)
+		assert.NoError(t, err)
+		assert.Equal(t, CheckoutSessionStatusExpired, res.Status)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+
+		otherUser := int32(999)
+		mockSession := &CheckoutSession{UserID: &otherUser}
+
+		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(mockSession, nil)
+
+		_, err := svc.GetSession(ctx, externalID)
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+
+	t.Run("Guest", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+
+		guestID := uuid.New()
+		mockSession := &CheckoutSession{
+			GuestID:   &guestID,
+			Status:    CheckoutSessionStatusPending,
+			ExpiresAt: time.Now().Add(1 * time.Hour),
+		}
+		mockRepo.On("GetCheckoutSession", mock.Anything, externalID).Return(mockSession, nil)
+
+		ctxGuest := utils.WithPrincipal(context.Background(), &utils.Principal{GuestID: guestID.String()})
+		res, err := svc.GetSession(ctxGuest, externalID)
+		require.NoError(t, err)
+		assert.Equal(t, mockSession, res)
+
+		ctxOther := utils.WithPrincipal(context.Background(), &utils.Principal{GuestID: uuid.New().String()})
+		_, err = svc.GetSession(ctxOther, externalID)
+		assert.ErrorIs(t, err, ErrGuestMismatch)
+
+		_, err = svc.GetSession(ctx, externalID)
+		assert.ErrorIs(t, err, ErrGuestMismatch)
+	})
+
+	t.Run("RepoError", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(nil, errors.New("db error"))
+
+		_, err := svc.GetSession(ctx, externalID)
+		assert.Error(t, err)
+	})
+}
+
+func TestService_GetPaymentOrderInfo(t *testing.T) {
+	userID := uint(1)
+	userInt32 := int32(userID)
+	ctx := utils.SetUserContext(context.Background(), userID, "test@example.com", "user")
+	externalID := "ord-ext-1"
+	addrID := uuid.New()
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockPayRepo := new(MockPaymentRepository)
+		mockAddrRepo := new(MockAddressRepository)
+		svc := NewService(mockRepo, mockPayRepo, nil, address.NewService(mockAddrRepo), nil, nil, nil, nil)
+
+		mockOrder := &Order{
+			ID:          1,
+			UserID:      &userInt32,
+			AddressID:   addrID,
+			TotalAmount: 50000,
+			Currency:    "IDR",
+		}
+		createdAt := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+		mockPayment := &payment.Payment{
+			ExternalReference: "pr-1",
+			Status:            "PAID",
+			PaymentMethod:     payment.MethodBCAVA,
+			PaymentCode:       "123456",
+			Amount:            50000,
+			CreatedAt:         createdAt,
+		}
+		mockAddr := &address.Address{
+			ID:   addrID,
+			Name: "Home",
+		}
+
+		mockRepo.On("GetOrderByExternalID", ctx, externalID).Return(mockOrder, nil)
+		mockPayRepo.On("GetPaymentByOrder", ctx, uint(1)).Return(mockPayment, nil)
+		mockAddrRepo.On("GetByID", ctx, addrID).Return(mockAddr, nil)
+		mockPayRepo.On("ListWebhookEvents", ctx, payment.ProviderXendit, externalID, "pr-1").Return([]*payment.WebhookEvent{{
+			EventType:  "payment.capture",
+			Payload:    json.RawMessage(`{"event":"payment.capture","data":{"status":"SUCCEEDED","request_amount":50000,"updated":"2026-03-01T09:30:00Z"}}`),
+			ReceivedAt: createdAt.Add(time.Hour),
+		}}, nil)
+
+		res, err := svc.GetPaymentOrderInfo(ctx, externalID)
+		assert.NoError(t, err)
+		assert.Equal(t, 50000, res.TotalAmount)
+		assert.NotNil(t, res.Payment.PaymentCode)
+		assert.Equal(t, "123456", *res.Payment.PaymentCode)
+		assert.Nil(t, res.Payment.InvoiceURL) // Should be nil because it's an empty string
+		assert.Equal(t, []payment.TimelineEntry{
+			{Event: payment.TimelineCreated, At: createdAt, Amount: 50000},
+			{Event: payment.TimelineVAIssued, At: createdAt},
+			{Event: payment.TimelineCaptured, At: createdAt.Add(30 * time.Minute), Amount: 50000},
+		}, res.Timeline)
+	})
+
+	t.Run("TimelineWithoutWebhooks", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockPayRepo := new(MockPaymentRepository)
+		mockAddrRepo := new(MockAddressRepository)
+		svc := NewService(mockRepo, mockPayRepo, nil, address.NewService(mockAddrRepo), nil, nil, nil, nil)
+
+		mockRepo.On("GetOrderByExternalID", ctx, externalID).
+			Return(&Order{ID: 1, UserID: &userInt32, AddressID: addrID}, nil)
+		mockPayRepo.On("GetPaymentByOrder", ctx, uint(1)).
+			Return(&payment.Payment{ExternalReference: "pr-1", Status: "PENDING", Amount: 50000}, nil)
+		mockAddrRepo.On("GetByID", ctx, addrID).Return(&address.Address{ID: addrID}, nil)
+		mockPayRepo.On("ListWebhookEvents", ctx, payment.ProviderXendit, externalID, "pr-1").
+			Return(nil, errors.New("db error"))
+
+		res, err := svc.GetPaymentOrderInfo(ctx, externalID)
+		assert.NoError(t, err)
+		assert.Len(t, res.Timeline, 1)
+		assert.Equal(t, payment.TimelineCreated, res.Timeline[0].Event)
+	})
+
+	t.Run("SecondsRemaining", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockPayRepo := new(MockPaymentRepository)
+		mockAddrRepo := new(MockAddressRepository)
+		svc := NewService(mockRepo, mockPayRepo, nil, address.NewService(mockAddrRepo), nil, nil, nil, nil)
+
+		expireAt := time.Now().Add(time.Hour)
+		mockRepo.On("GetOrderByExternalID", ctx, externalID).
+			Return(&Order{ID: 1, UserID: &userInt32, AddressID: addrID}, nil)
+		mockPayRepo.On("GetPaymentByOrder", ctx, uint(1)).
+			Return(&payment.Payment{ExternalReference: "pr-1", Status: "PENDING", ExpireAt: expireAt}, nil)
+		mockAddrRepo.On("GetByID", ctx, addrID).Return(&address.Address{ID: addrID}, nil)
+		mockPayRepo.On("ListWebhookEvents", ctx, payment.ProviderXendit, externalID, "pr-1").
+			Return([]*payment.WebhookEvent{}, nil)
+
+		res, err := svc.GetPaymentOrderInfo(ctx, externalID)
+		assert.NoError(t, err)
+		assert.Equal(t, expireAt, res.ExpiresAt)
+		assert.InDelta(t, 3600, res.SecondsRemaining, 2)
+	})
+
+	t.Run("PaymentNotFound", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockPayRepo := new(MockPaymentRepository)
+		svc := NewService(mockRepo, mockPayRepo, nil, nil, nil, nil, nil, nil)
+
+		mockOrder := &Order{
+			ID:     1,
+			UserID: &userInt32,
+		}
+		mockRepo.On("GetOrderByExternalID", ctx, externalID).Return(mockOrder, nil)
+		mockPayRepo.On("GetPaymentByOrder", ctx, uint(1)).Return(nil, errors.New("payment not found"))
+
+		_, err := svc.GetPaymentOrderInfo(ctx, externalID)
+		assert.Error(t, err)
+	})
+}
+
+func TestService_UpdateSessionAddress_Forbidden(t *testing.T) {
+	userID := uint(1)
+	ctx := utils.SetUserContext(context.Background(), userID, "test@example.com", "user")
+	externalID := "sess-ext-1"
+	addrIDStr := uuid.New().String()
+
+	mockRepo := new(MockRepository)
+	svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+
+	otherUser := int32(999)
+	mockSession := &CheckoutSession{
+		UserID: &otherUser,
+		Status: CheckoutSessionStatusPending,
+	}
+
+	mockRepo.On("GetCheckoutSession", ctx, externalID).Return(mockSession, nil)
+
+	err := svc.UpdateSessionAddress(ctx, externalID, addrIDStr)
+	assert.Error(t, err)
+	assert.True(t, apperr.Is(err, apperr.CodeForbidden))
+}
+
+func TestService_CheckoutRestrictions(t *testing.T) {
+	userID := uint(1)
+	userInt32 := int32(userID)
+	ctx := utils.SetUserContext(context.Background(), userID, "test@example.com", "user")
+	input := model.CreateCheckoutSessionInput{
+		Items: []*model.CheckoutSessionItemInput{{VariantID: "var-1", Quantity: 1}},
+	}
+
+	t.Run("CreateBlocked", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockUserRepo := new(MockUserRepository)
+		svc := NewService(mockRepo, nil, nil, nil, mockUserRepo, nil, nil, nil)
+		mockUserRepo.On("GetRestriction", ctx, userID).
+			Return(&user.Restriction{UserID: userID, Level: user.RestrictionBlockCheckout}, nil)
+
+		_, err := svc.CreateSession(ctx, input)
+		assert.ErrorIs(t, err, user.ErrCheckoutBlocked)
+		assert.Equal(t, apperr.CodeCheckoutBlocked, apperr.CodeOf(err))
+		mockRepo.AssertNotCalled(t, "GetVariantForCheckout", mock.Anything, mock.Anything)
+	})
+
+	t.Run("ConfirmSuspended", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockUserRepo := new(MockUserRepository)
+		svc := NewService(mockRepo, nil, nil, nil, mockUserRepo, nil, nil, nil)
+		mockRepo.On("GetCheckoutSession", ctx, "sess-ext-1").Return(&CheckoutSession{
+			UserID:    &userInt32,
+			Status:    CheckoutSessionStatusPending,
+			ExpiresAt: time.Now().Add(time.Hour),
+		}, nil)
+		mockUserRepo.On("GetRestriction", ctx, userID).
+			Return(&user.Restriction{UserID: userID, Level: user.RestrictionSuspend}, nil)
+
+		_, err := svc.ConfirmSession(ctx, "sess-ext-1", nil)
+		assert.ErrorIs(t, err, user.ErrAccountSuspended)
+		mockRepo.AssertNotCalled(t, "ListCurrentPolicies", mock.Anything)
+	})
+
+	t.Run("WarnOnlyProceeds", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockUserRepo := new(MockUserRepository)
+		svc := NewService(mockRepo, nil, nil, nil, mockUserRepo, nil, nil, nil)
+		mockUserRepo.On("GetRestriction", ctx, userID).
+			Return(&user.Restriction{UserID: userID, Level: user.RestrictionWarn}, nil)
+		mockRepo.On("GetVariantForCheckout", ctx, "var-1").Return(nil, nil, errors.New("variant not found"))
+
+		_, err := svc.CreateSession(ctx, input)
+		assert.EqualError(t, err, "failed to get variant")
+	})
+}
+
+func TestService_ConfirmSession_EdgeCases(t *testing.T) {
+	userID := uint(1)
+	userInt32 := int32(userID)
+	ctx := utils.SetUserContext(context.Background(), userID, "test@example.com", "user")
+	externalID := "sess-ext-1"
+	now := time.Now().Add(1 * time.Hour)
+
+	t.Run("AddressNotSet", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, unrestricted(), nil, nil, nil)
+
+		mockSession := &CheckoutSession{
+			UserID:    &userInt32,
+			Status:    CheckoutSessionStatusPending,
+			ExpiresAt: now,
+			AddressID: nil, // Missing address
+		}
+
+		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(mockSession, nil)
+		mockRepo.On("ListCurrentPolicies", ctx).Return([]*Policy{}, nil)
+
+		_, err := svc.ConfirmSession(ctx, externalID, nil)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "shipping address not set")
+	})
+
+	t.Run("InstantOriginMoved", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockAddrRepo := new(MockAddressRepository)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), unrestricted(), nil, nil, nil)
+
+		addrID := uuid.New()
+		originID := "o1"
+		mockSession := &CheckoutSession{
+			UserID:         &userInt32,
+			Status:         CheckoutSessionStatusPending,
+			ExpiresAt:      now,
+			AddressID:      &addrID,
+			ShippingMethod: ShippingMethodInstant,
+			// priced while the origin was still at Monas
+			ShippingParcels: []ShippingParcel{{OriginID: &originID, OriginLocation: &monas, WeightGrams: 1000}},
+		}
+
+		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(mockSession, nil)
+		mockRepo.On("ListCurrentPolicies", ctx).Return([]*Policy{}, nil)
+		mockAddrRepo.On("GetByID", ctx, addrID).Return(ownedAddress(&address.Address{Location: &senayan}, userID), nil)
+		mockRepo.On("ListCheckoutRules", ctx, true).Return([]*CheckoutRule{}, nil)
+		mockRepo.On("OriginLocations", ctx, []string{originID}).Return(map[string]geo.Point{originID: bandung}, nil)
+
+		_, err := svc.ConfirmSession(ctx, externalID, nil)
+
+		assert.ErrorIs(t, err, ErrOutsideInstantRadius)
+		mockRepo.AssertNotCalled(t, "CreateOrderTx", mock.Anything, mock.Anything, mock.Anything)
+		// the stored parcels are left as they were
+		assert.Equal(t, &monas, mockSession.ShippingParcels[0].OriginLocation)
+	})
+
+	t.Run("PickupSlotPassed", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockAddrRepo := new(MockAddressRepository)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), unrestricted(), nil, nil, nil).(*service)
+		svc.now = func() time.Time { return pickupNow }
+
+		addrID := uuid.New()
+		locationID := int32(4)
+		// booked yesterday; it is no longer two hours away
+		slotStart := time.Date(2026, 3, 3, 9, 0, 0, 0, svc.loc)
+		mockSession := &CheckoutSession{
+			UserID:           &userInt32,
+			Status:           CheckoutSessionStatusPending,
+			ExpiresAt:        now,
+			AddressID:        &addrID,
+			ShippingMethod:   ShippingMethodSelfPickup,
+			PickupLocationID: &locationID,
+			PickupSlotStart:  &slotStart,
+		}
+
+		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(mockSession, nil)
+		mockRepo.On("ListCurrentPolicies", ctx).Return([]*Policy{}, nil)
+		mockAddrRepo.On("GetByID", ctx, addrID).Return(ownedAddress(&address.Address{}, userID), nil)
+		mockRepo.On("ListCheckoutRules", ctx, true).Return([]*CheckoutRule{}, nil)
+		mockRepo.On("GetPickupLocation", ctx, locationID).Return(pickupCounter(), nil)
+
+		_, err := svc.ConfirmSession(ctx, externalID, nil)
+
+		assert.ErrorIs(t, err, ErrPickupSlotUnavailable)
+		mockRepo.AssertNotCalled(t, "CreateOrderTx", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("AlreadyConfirmed", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, unrestricted(), nil, nil, nil)
+
+		mockSession := &CheckoutSession{
+			UserID: &userInt32,
+			Status: CheckoutSessionStatusPaid, // Not pending
+		}
+
+		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(mockSession, nil)
+
+		_, err := svc.ConfirmSession(ctx, externalID, nil)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "already confirmed")
+	})
+
+	t.Run("Forbidden_Ownership", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, unrestricted(), nil, nil, nil)
+
+		otherUser := int32(999)
+		mockSession := &CheckoutSession{UserID: &otherUser}
+
+		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(mockSession, nil)
+
+		_, err := svc.ConfirmSession(ctx, externalID, nil)
+		assert.Error(t, err)
+		assert.True(t, apperr.Is(err, apperr.CodeForbidden))
+	})
+
+	t.Run("NoItems", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockAddrRepo := new(MockAddressRepository)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), unrestricted(), nil, nil, nil)
+
+		mockSession := &CheckoutSession{
+			UserID:    &userInt32,
+			Status:    CheckoutSessionStatusPending,
+			ExpiresAt: now,
+			AddressID: &uuid.UUID{},
+			Items:     []CheckoutSessionItem{},
+		}
+
+		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(mockSession, nil)
+		mockRepo.On("ListCurrentPolicies", ctx).Return([]*Policy{}, nil)
+		mockAddrRepo.On("GetByID", ctx, mock.Anything).Return(ownedAddress(&address.Address{}, userID), nil)
+		mockRepo.On("ListCheckoutRules", ctx, true).Return([]*CheckoutRule{}, nil)
+
+		_, err := svc.ConfirmSession(ctx, externalID, nil)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "checkout session has no items")
+	})
+
+	t.Run("RepoError_Confirm", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockAddrRepo := new(MockAddressRepository)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), unrestricted(), nil, nil, nil)
+		sessID := uuid.New()
+		addrID := uuid.New()
+		mockSession := &CheckoutSession{ID: sessID, UserID: &userInt32, Status: CheckoutSessionStatusPending, ExpiresAt: now, AddressID: &addrID, Items: []CheckoutSessionItem{{VariantID: "v1", Quantity: 1}}}
+
+		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(mockSession, nil)
+		mockRepo.On("ListCurrentPolicies", ctx).Return([]*Policy{}, nil)
+		mockAddrRepo.On("GetByID", ctx, mock.Anything).Return(ownedAddress(&address.Address{}, userID), nil)
+		mockRepo.On("ListCheckoutRules", ctx, true).Return([]*CheckoutRule{}, nil)
+		mockRepo.On("InactiveVariants", ctx, mock.Anything).Return([]string(nil), nil)
+		mockRepo.On("VariantsOnVacation", ctx, mock.Anything).Return([]string(nil), nil)
+		mockRepo.On("ValidateVariantStock", ctx, "v1", 1).Return(true, nil)
+		mockRepo.On("GetOrderBySessionID", ctx, sessID).Return(nil, nil)
+		mockRepo.On("CreateOrderTx", ctx, mock.Anything, mock.Anything).Return(errors.New("tx error"))
+
+		_, err := svc.ConfirmSession(ctx, externalID, nil)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "tx error")
+	})
+
+	t.Run("RepoError_GetSession", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, unrestricted(), nil, nil, nil)
+		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(nil, errors.New("db error"))
+		_, err := svc.ConfirmSession(ctx, externalID, nil)
+		assert.Error(t, err)
+	})
+
+	t.Run("RepoError_ValidateStock", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockAddrRepo := new(MockAddressRepository)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), unrestricted(), nil, nil, nil)
+		addrID := uuid.New()
+		mockSession := &CheckoutSession{UserID: &userInt32, Status: CheckoutSessionStatusPending, ExpiresAt: now, AddressID: &addrID, Items: []CheckoutSessionItem{{VariantID: "v1", Quantity: 1}}}
+
+		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(mockSession, nil)
+		mockRepo.On("ListCurrentPolicies", ctx).Return([]*Policy{}, nil)
+		mockAddrRepo.On("GetByID", ctx, mock.Anything).Return(ownedAddress(&address.Address{}, userID), nil)
+		mockRepo.On("ListCheckoutRules", ctx, true).Return([]*CheckoutRule{}, nil)
+		mockRepo.On("InactiveVariants", ctx, mock.Anything).Return([]string(nil), nil)
+		mockRepo.On("VariantsOnVacation", ctx, mock.Anything).Return([]string(nil), nil)
+		mockRepo.On("ValidateVariantStock", ctx, "v1", 1).Return(false, errors.New("stock error"))
+
+		_, err := svc.ConfirmSession(ctx, externalID, nil)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "stock error")
+	})
+
+	t.Run("RepoError_ConfirmSession", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockPayGate := new(MockPaymentGateway)
+		mockPayRepo := new(MockPaymentRepository)
+		mockAddrRepo := new(MockAddressRepository)
+		svc := NewService(mockRepo, mockPayRepo, mockPayGate, address.NewService(mockAddrRepo), unrestricted(), nil, nil, nil)
+		sessID := uuid.New()
+		addrID := uuid.New()
+		mockSession := &CheckoutSession{ID: sessID, UserID: &userInt32, Status: CheckoutSessionStatusPending, ExpiresAt: now, AddressID: &addrID, Items: []CheckoutSessionItem{{VariantID: "v1", Quantity: 1}}}
+
+		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(mockSession, nil)
+		mockRepo.On("ListCurrentPolicies", ctx).Return([]*Policy{}, nil)
+		mockAddrRepo.On("GetByID", ctx, mock.Anything).Return(ownedAddress(&address.Address{}, userID), nil)
+		mockRepo.On("ListCheckoutRules", ctx, true).Return([]*CheckoutRule{}, nil)
+		mockRepo.On("InactiveVariants", ctx, mock.Anything).Return([]string(nil), nil)
+		mockRepo.On("VariantsOnVacation", ctx, mock.Anything).Return([]string(nil), nil)
+		mockRepo.On("ValidateVariantStock", ctx, "v1", 1).Return(true, nil)
+		mockRepo.On("GetOrderBySessionID", ctx, sessID).Return(nil, nil)
+		mockRepo.On("CreateOrderTx", ctx, mock.Anything, mock.Anything).Return(nil)
+		mockRepo.On("ConfirmCheckoutSession", ctx, mockSession).Return(errors.New("confirm error"))
+
+		_, err := svc.ConfirmSession(ctx, externalID, nil)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "confirm error")
+	})
+}
+
+func TestService_OrderToPaymentProcess_GatewayError(t *testing.T) {
+	mockRepo := new(MockRepository)
+	mockPayGate := new(MockPaymentGateway)
+	svc := NewService(mockRepo, nil, mockPayGate, nil, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	orderExtID := "ord-ext-1"
+	orderID := uint(1)
+
+	pm := payment.MethodBCAVA
+	mockSession := &CheckoutSession{
+		TotalPrice:    10000,
+		Items:         []CheckoutSessionItem{{ProductName: "P1", VariantName: "V1", Quantity: 1, Price: 10000}},
+		PaymentMethod: &pm,
+	}
+
+	mockPayGate.On("CreateInvoice", ctx, orderExtID, mock.AnythingOfType("payment.BuyerInfo"), int64(10000), mock.Anything, payment.ChannelCode(payment.MethodBCAVA)).Return(nil, errors.New("gateway error"))
+
+	_, err := svc.OrderToPaymentProcess(ctx, mockSession, orderExtID, orderID)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to create payment invoice")
+}
+
+func TestService_OrderToPaymentProcess_SavePaymentError(t *testing.T) {
+	mockRepo := new(MockRepository)
+	mockPayRepo := new(MockPaymentRepository)
+	mockPayGate := new(MockPaymentGateway)
+	svc := NewService(mockRepo, mockPayRepo, mockPayGate, nil, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	orderExtID := "ord-ext-1"
+	orderID := uint(1)
+
+	pm := payment.MethodBCAVA
+	mockSession := &CheckoutSession{
+		TotalPrice:    10000,
+		Items:         []CheckoutSessionItem{{ProductName: "P1", VariantName: "V1", Quantity: 1, Price: 10000}},
+		PaymentMethod: &pm,
+	}
+	mockPayResp := &payment.PaymentResponse{ProviderPaymentID: "pay-1", Status: "PENDING"}
+
+	mockPayGate.On("CreateInvoice", ctx, orderExtID, mock.AnythingOfType("payment.BuyerInfo"), int64(10000), mock.Anything, payment.ChannelCode(payment.MethodBCAVA)).Return(mockPayResp, nil)
+	mockPayRepo.On("SavePayment", ctx, mock.Anything).Return(errors.New("db error"))
+
+	_, err := svc.OrderToPaymentProcess(ctx, mockSession, orderExtID, orderID)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "failed to save payment")
+}
+
+func TestService_GetPaymentOrderInfo_Forbidden(t *testing.T) {
+	mockRepo := new(MockRepository)
+	svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+	ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "user")
+
+	otherUser := int32(999)
+	mockOrder := &Order{UserID: &otherUser}
+	mockRepo.On("GetOrderByExternalID", ctx, "ext-id").Return(mockOrder, nil)
+
+	_, err := svc.GetPaymentOrderInfo(ctx, "ext-id")
+	assert.Error(t, err)
+	assert.True(t, apperr.Is(err, apperr.CodeForbidden))
+}
+
+func TestService_GetPaymentOrderInfo_AddressError(t *testing.T) {
+	mockRepo := new(MockRepository)
+	mockPayRepo := new(MockPaymentRepository)
+	mockAddrRepo := new(MockAddressRepository)
+	svc := NewService(mockRepo, mockPayRepo, nil, address.NewService(mockAddrRepo), nil, nil, nil, nil)
+	ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "user")
+
+	userID := int32(1)
+	mockOrder := &Order{ID: 1, UserID: &userID, AddressID: uuid.New()}
+	mockRepo.On("GetOrderByExternalID", ctx, "ext-id").Return(mockOrder, nil)
+	mockPayRepo.On("GetPaymentByOrder", ctx, uint(1)).Return(&payment.Payment{}, nil)
+	mockAddrRepo.On("GetByID", ctx, mockOrder.AddressID).Return(nil, errors.New("addr error"))
+
+	_, err := svc.GetPaymentOrderInfo(ctx, "ext-id")
+	assert.Error(t, err)
+	assert.Equal(t, "failed to get address", err.Error())
+}
+
+func TestService_ApplySessionWallet(t *testing.T) {
+	userID := int32(1)
+	extID := "sess-ext-1"
+
+	newSession := func() *CheckoutSession {
+		return &CheckoutSession{
+			ID:         uuid.New(),
+			ExternalID: extID,
+			UserID:     &userID,
+			Status:     CheckoutSessionStatusPending,
+			ExpiresAt:  time.Now().Add(time.Hour),
+			TotalPrice: 50000,
+		}
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		wallets := new(MockBalanceGateway)
+		svc := NewService(mockRepo, nil, nil, nil, nil, wallets, nil, nil)
+		ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "user")
+
+		session := newSession()
+		mockRepo.On("GetCheckoutSession", ctx, extID).Return(session, nil)
+		wallets.On("Balance", ctx, uint(1)).Return(int64(30000), nil)
+		mockRepo.On("UpdateSessionWalletAmount", ctx, session.ID, 20000).Return(nil)
+		mockRepo.On("InsertSessionEvent", ctx, mock.AnythingOfType("*order.SessionEvent")).Return(nil)
+
+		err := svc.ApplySessionWallet(ctx, extID, 20000)
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Guest", func(t *testing.T) {
+		svc := NewService(new(MockRepository), nil, nil, nil, nil, nil, nil, nil)
+
+		err := svc.ApplySessionWallet(context.Background(), extID, 20000)
+		assert.ErrorIs(t, err, ErrWalletRequiresUser)
+	})
+
+	t.Run("ExceedsTotal", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+		ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "user")
+
+		mockRepo.On("GetCheckoutSession", ctx, extID).Return(newSession(), nil)
 
-		otherUser := int32(999)
-		mockOrder := &Order{ID: 1, ExternalID: extID, UserID: &otherUser}
-		mockRepo.On("GetOrderDetailByExternalID", ctx, extID).Return(mockOrder, nil)
+		err := svc.ApplySessionWallet(ctx, extID, 60000)
+		assert.ErrorIs(t, err, ErrInvalidWalletUse)
+	})
 
-		_, _, err := svc.GetOrderDetailByExternalID(ctx, extID)
-		assert.Error(t, err)
-		assert.Equal(t, ErrUnauthorized, err)
+	t.Run("InsufficientBalance", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		wallets := new(MockBalanceGateway)
+		svc := NewService(mockRepo, nil, nil, nil, nil, wallets, nil, nil)
+		ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "user")
+
+		mockRepo.On("GetCheckoutSession", ctx, extID).Return(newSession(), nil)
+		wallets.On("Balance", ctx, uint(1)).Return(int64(1000), nil)
+
+		err := svc.ApplySessionWallet(ctx, extID, 20000)
+		assert.ErrorIs(t, err, ErrInsufficientWallet)
+		mockRepo.AssertNotCalled(t, "UpdateSessionWalletAmount", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("OtherUsersSession", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+		ctx := utils.SetUserContext(context.Background(), 2, "other@example.com", "user")
+
+		mockRepo.On("GetCheckoutSession", ctx, extID).Return(newSession(), nil)
+
+		err := svc.ApplySessionWallet(ctx, extID, 20000)
+		assert.ErrorIs(t, err, ErrSessionForbidden)
 	})
 }
 
-func TestService_CreateSession(t *testing.T) {
-	userID := uint(1)
-	ctx := utils.SetUserContext(context.Background(), userID, "test@example.com", "user")
+func TestService_GetOrderForWebhook(t *testing.T) {
+	ctx := context.Background()
+	refID := "ord-ref-1"
 
-	t.Run("Success", func(t *testing.T) {
+	t.Run("CachesByExternalID", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
 
-		input := model.CreateCheckoutSessionInput{
-			Items: []*model.CheckoutSessionItemInput{
-				{VariantID: "var-1", Quantity: 1},
-			},
-		}
+		mockRepo.On("GetOrderByExternalID", ctx, refID).
+			Return(&Order{ExternalID: refID, Status: OrderStatusPendingPayment}, nil).Once()
 
-		mockVariant := &product.Variant{
-			ID:    "var-1",
-			Price: 10000,
+		first, err := svc.GetOrderForWebhook(ctx, refID)
+		assert.NoError(t, err)
+		second, err := svc.GetOrderForWebhook(ctx, refID)
+		assert.NoError(t, err)
+
+		assert.Equal(t, first.Status, second.Status)
+		mockRepo.AssertNumberOfCalls(t, "GetOrderByExternalID", 1)
+	})
+
+	t.Run("MarkAsPaidInvalidates", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+
+		mockRepo.On("GetOrderByExternalID", ctx, refID).
+			Return(&Order{ExternalID: refID, Status: OrderStatusPendingPayment}, nil).Once()
+		mockRepo.On("GetByReferenceID", ctx, refID).
+			Return(&Order{Status: OrderStatusPendingPayment}, nil)
+		mockRepo.On("MarkPaidByReferenceID", ctx, refID, payment.Capture{ExternalReference: "pay-req-1", ProviderPaymentID: "prov-1"}).Return(nil)
+		mockRepo.On("GetOrderByExternalID", ctx, refID).
+			Return(&Order{ExternalID: refID, Status: OrderStatusPaid}, nil).Once()
+
+		_, err := svc.GetOrderForWebhook(ctx, refID)
+		assert.NoError(t, err)
+		assert.NoError(t, svc.MarkAsPaid(ctx, refID, payment.Capture{ExternalReference: "pay-req-1", ProviderPaymentID: "prov-1"}))
+
+		o, err := svc.GetOrderForWebhook(ctx, refID)
+		assert.NoError(t, err)
+		assert.Equal(t, OrderStatusPaid, o.Status)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("NotFoundIsNotCached", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+
+		mockRepo.On("GetOrderByExternalID", ctx, refID).Return(nil, nil)
+
+		_, err := svc.GetOrderForWebhook(ctx, refID)
+		assert.ErrorIs(t, err, ErrOrderNotFound)
+		_, err = svc.GetOrderForWebhook(ctx, refID)
+		assert.ErrorIs(t, err, ErrOrderNotFound)
+		mockRepo.AssertNumberOfCalls(t, "GetOrderByExternalID", 2)
+	})
+}
+
+func TestOrderCache_Expiry(t *testing.T) {
+	now := time.Now()
+	c := newOrderCache(time.Second)
+	c.now = func() time.Time { return now }
+
+	c.set("ord-ref-1", &Order{Status: OrderStatusPaid})
+	_, ok := c.get("ord-ref-1")
+	assert.True(t, ok)
+
+	now = now.Add(time.Second)
+	_, ok = c.get("ord-ref-1")
+	assert.False(t, ok)
+}
+
+func TestService_MarkAsPaid_SplitPayment(t *testing.T) {
+	ctx := context.Background()
+	refID := "ord-ref-1"
+	capture := payment.Capture{ExternalReference: "pay-req-1", ProviderPaymentID: "prov-1", Amount: 30000}
+
+	t.Run("FullyCovered", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockPayRepo := new(MockPaymentRepository)
+		svc := NewService(mockRepo, mockPayRepo, nil, nil, nil, nil, nil, nil)
+
+		mockOrder := &Order{ID: 1, Status: OrderStatusPendingPayment, TotalAmount: 50000, WalletAmount: 20000}
+		mockRepo.On("GetByReferenceID", ctx, refID).Return(mockOrder, nil)
+		mockPayRepo.On("GetPaymentsByOrder", ctx, uint(1)).Return([]*payment.Payment{
+			{ExternalReference: payment.WalletReference(refID), Amount: 20000, Status: "PAID"},
+			{ExternalReference: capture.ExternalReference, Amount: 30000, Status: "PENDING"},
+		}, nil)
+		mockRepo.On("MarkPaidByReferenceID", ctx, refID, capture).Return(nil)
+
+		err := svc.MarkAsPaid(ctx, refID, capture)
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("PartiallyCovered", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockPayRepo := new(MockPaymentRepository)
+		svc := NewService(mockRepo, mockPayRepo, nil, nil, nil, nil, nil, nil)
+
+		mockOrder := &Order{ID: 1, Status: OrderStatusPendingPayment, TotalAmount: 50000, WalletAmount: 20000}
+		mockRepo.On("GetByReferenceID", ctx, refID).Return(mockOrder, nil)
+		mockPayRepo.On("GetPaymentsByOrder", ctx, uint(1)).Return([]*payment.Payment{
+			{ExternalReference: capture.ExternalReference, Amount: 30000, Status: "PENDING"},
+		}, nil)
+		mockPayRepo.On("MarkPaymentPaid", ctx, capture).Return(nil)
+
+		err := svc.MarkAsPaid(ctx, refID, capture)
+		assert.NoError(t, err)
+		mockRepo.AssertNotCalled(t, "MarkPaidByReferenceID", mock.Anything, mock.Anything, mock.Anything)
+		mockPayRepo.AssertExpectations(t)
+	})
+}
+
+func TestService_ApplySessionCoupon(t *testing.T) {
+	userID := int32(1)
+	extID := "sess-ext-1"
+
+	newSession := func() *CheckoutSession {
+		return &CheckoutSession{
+			ID:           uuid.New(),
+			ExternalID:   extID,
+			UserID:       &userID,
+			Status:       CheckoutSessionStatusPending,
+			ExpiresAt:    time.Now().Add(time.Hour),
+			Subtotal:     100000,
+			Tax:          10000,
+			TotalPrice:   110000,
+			WalletAmount: 105000,
 		}
-		mockProduct := &product.Product{
-			ID:   "1",
-			Name: "Product 1",
+	}
+	newVoucher := func() *SessionVoucher {
+		maxDiscount := int64(15000)
+		return &SessionVoucher{
+			ID:            9,
+			DiscountType:  VoucherDiscountPercent,
+			DiscountValue: 20,
+			MaxDiscount:   &maxDiscount,
+			IsActive:      true,
+			StartsAt:      time.Now().Add(-time.Hour),
 		}
+	}
 
-		// 2. Get Variant Info
-		mockRepo.On("GetVariantForCheckout", ctx, "var-1").Return(mockVariant, mockProduct, nil)
-		// 3. Create Session
-		mockRepo.On("CreateCheckoutSession", ctx, mock.AnythingOfType("*order.CheckoutSession"), mock.Anything).Return(nil)
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+		ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "user")
+
+		mockRepo.On("GetCheckoutSession", ctx, extID).Return(newSession(), nil)
+		mockRepo.On("GetVoucherByCode", ctx, "VIP-AB12").Return(newVoucher(), nil)
+		mockRepo.On("UpdateSessionDiscounts", ctx, mock.MatchedBy(func(s *CheckoutSession) bool {
+			// 20% of 100000 capped at 15000, tax on the remaining 85000;
+			// wallet clamped to the new total
+			return *s.VoucherID == 9 && s.Discount == 15000 && s.Tax == 8500 &&
+				s.TotalPrice == 93500 && s.WalletAmount == 93500
+		})).Return(nil)
+		mockRepo.On("InsertSessionEvent", ctx, mock.AnythingOfType("*order.SessionEvent")).Return(nil)
 
-		res, err := svc.CreateSession(ctx, input)
+		discount, err := svc.ApplySessionCoupon(ctx, extID, " vip-ab12 ")
+		assert.NoError(t, err)
+		assert.Equal(t, 15000, discount)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("PersonalVoucherOfAnotherUser", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+		ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "user")
+
+		other := int32(2)
+		v := newVoucher()
+		v.OwnerUserID = &other
+		mockRepo.On("GetCheckoutSession", ctx, extID).Return(newSession(), nil)
+		mockRepo.On("GetVoucherByCode", ctx, "VIP-AB12").Return(v, nil)
+
+		_, err := svc.ApplySessionCoupon(ctx, extID, "VIP-AB12")
+		assert.ErrorIs(t, err, ErrVoucherNotOwned)
+		mockRepo.AssertNotCalled(t, "UpdateSessionDiscounts", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Exhausted", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+		ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "user")
+
+		limit := int32(1)
+		v := newVoucher()
+		v.OwnerUserID = &userID
+		v.UsageLimit = &limit
+		v.Redemptions = 1
+		mockRepo.On("GetCheckoutSession", ctx, extID).Return(newSession(), nil)
+		mockRepo.On("GetVoucherByCode", ctx, "VIP-AB12").Return(v, nil)
+
+		_, err := svc.ApplySessionCoupon(ctx, extID, "VIP-AB12")
+		assert.ErrorIs(t, err, ErrVoucherExhausted)
+	})
 
+	t.Run("Expired", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+		ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "user")
+
+		expired := time.Now().Add(-time.Minute)
+		v := newVoucher()
+		v.ExpiresAt = &expired
+		mockRepo.On("GetCheckoutSession", ctx, extID).Return(newSession(), nil)
+		mockRepo.On("GetVoucherByCode", ctx, "VIP-AB12").Return(v, nil)
+
+		_, err := svc.ApplySessionCoupon(ctx, extID, "VIP-AB12")
+		assert.ErrorIs(t, err, ErrVoucherInactive)
+	})
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		svc := NewService(new(MockRepository), nil, nil, nil, nil, nil, nil, nil)
+
+		_, err := svc.ApplySessionCoupon(context.Background(), extID, "VIP-AB12")
+		assert.ErrorIs(t, err, ErrUnauthorized)
+	})
+}
+
+func TestService_ApplySessionPoints(t *testing.T) {
+	userID := int32(1)
+	extID := "sess-ext-1"
+
+	newSession := func() *CheckoutSession {
+		voucherID := int64(9)
+		return &CheckoutSession{
+			ID:          uuid.New(),
+			ExternalID:  extID,
+			UserID:      &userID,
+			Status:      CheckoutSessionStatusPending,
+			ExpiresAt:   time.Now().Add(time.Hour),
+			Subtotal:    100000,
+			ShippingFee: 10000,
+			Discount:    30000,
+			VoucherID:   &voucherID,
+		}
+	}
+
+	t.Run("AppliesAfterVoucherBeforeTax", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		points := new(MockBalanceGateway)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, points, nil)
+		ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "user")
+
+		mockRepo.On("GetCheckoutSession", ctx, extID).Return(newSession(), nil)
+		points.On("Balance", ctx, uint(1)).Return(int64(50000), nil)
+		mockRepo.On("UpdateSessionDiscounts", ctx, mock.MatchedBy(func(s *CheckoutSession) bool {
+			// 100000 - 30000 voucher - 20000 points = 50000 taxable
+			return s.PointsRedeemed == 20000 && s.Tax == 5000 && s.TotalPrice == 65000
+		})).Return(nil)
+		mockRepo.On("InsertSessionEvent", ctx, mock.AnythingOfType("*order.SessionEvent")).Return(nil)
+
+		discount, err := svc.ApplySessionPoints(ctx, extID, 20000)
 		assert.NoError(t, err)
-		assert.NotNil(t, res)
-		assert.Equal(t, 11000, res.TotalPrice)
+		assert.Equal(t, 20000, discount)
 		mockRepo.AssertExpectations(t)
 	})
 
-	t.Run("InvalidQuantity", func(t *testing.T) {
+	t.Run("CappedAtRemainingSubtotal", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		points := new(MockBalanceGateway)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, points, nil)
+		ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "user")
+
+		mockRepo.On("GetCheckoutSession", ctx, extID).Return(newSession(), nil)
+		points.On("Balance", ctx, uint(1)).Return(int64(90000), nil)
+		mockRepo.On("UpdateSessionDiscounts", ctx, mock.MatchedBy(func(s *CheckoutSession) bool {
+			return s.PointsRedeemed == 70000 && s.Tax == 0 && s.TotalPrice == 10000
+		})).Return(nil)
+		mockRepo.On("InsertSessionEvent", ctx, mock.AnythingOfType("*order.SessionEvent")).Return(nil)
+
+		discount, err := svc.ApplySessionPoints(ctx, extID, 90000)
+		assert.NoError(t, err)
+		assert.Equal(t, 70000, discount)
+	})
+
+	t.Run("InsufficientPoints", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
+		points := new(MockBalanceGateway)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, points, nil)
+		ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "user")
 
-		input := model.CreateCheckoutSessionInput{
+		mockRepo.On("GetCheckoutSession", ctx, extID).Return(newSession(), nil)
+		points.On("Balance", ctx, uint(1)).Return(int64(100), nil)
+
+		_, err := svc.ApplySessionPoints(ctx, extID, 20000)
+		assert.ErrorIs(t, err, ErrInsufficientPoints)
+		mockRepo.AssertNotCalled(t, "UpdateSessionDiscounts", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Negative", func(t *testing.T) {
+		svc := NewService(new(MockRepository), nil, nil, nil, nil, nil, nil, nil)
+		ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "user")
+
+		_, err := svc.ApplySessionPoints(ctx, extID, -1)
+		assert.ErrorIs(t, err, ErrInvalidPointsUse)
+	})
+}
+
+func TestService_CreateAdminOrder(t *testing.T) {
+	adminID := uint(9)
+	ctx := utils.SetUserContext(context.Background(), adminID, "admin@example.com", "ADMIN")
+	customerID := uint(4)
+	customer := int32(customerID)
+	addrID := uuid.New()
+	sessionID := uuid.New()
+
+	input := func(mode model.AdminOrderPayment) model.CreateAdminOrderInput {
+		return model.CreateAdminOrderInput{
+			UserID:    "4",
+			AddressID: addrID.String(),
 			Items: []*model.CheckoutSessionItemInput{
-				{VariantID: "var-1", Quantity: 0},
+				{VariantID: "var-1", Quantity: 2},
 			},
+			Payment: mode,
 		}
-		_, err := svc.CreateSession(ctx, input)
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "quantity must be greater than zero")
+	}
+
+	storedSession := func() *CheckoutSession {
+		return &CheckoutSession{
+			ID:         sessionID,
+			ExternalID: "ck-1",
+			UserID:     &customer,
+			Status:     CheckoutSessionStatusPending,
+			AddressID:  &addrID,
+			TotalPrice: 31000,
+			Currency:   "IDR",
+			Items: []CheckoutSessionItem{
+				{VariantID: "var-1", Quantity: 2, Price: 5000},
+			},
+		}
+	}
+
+	expectSession := func(mockRepo *MockRepository, mockAddrRepo *MockAddressRepository) *CheckoutSession {
+		mockAddrRepo.On("GetByID", ctx, addrID).
+			Return(ownedAddress(&address.Address{ID: addrID, Province: "DKI Jakarta"}, customerID), nil)
+		mockRepo.On("GetVariantForCheckout", ctx, "var-1").
+			Return(&product.Variant{ID: "var-1", Price: 5000}, &product.Product{Name: "P1"}, nil)
+		mockRepo.On("CreateCheckoutSession", ctx, mock.MatchedBy(func(s *CheckoutSession) bool {
+			return *s.UserID == customer && s.ShippingFee == 20000 && s.TotalPrice == 31000
+		}), mock.Anything).Return(nil)
+		mockRepo.On("UpdateSessionAddressAndPricing", ctx, mock.AnythingOfType("*order.CheckoutSession")).Return(nil)
+
+		session := storedSession()
+		mockRepo.On("GetCheckoutSession", ctx, mock.AnythingOfType("string")).Return(session, nil)
+		mockRepo.On("GetOrderBySessionID", ctx, sessionID).Return(nil, nil)
+		mockRepo.On("CreateOrderTx", ctx, mock.MatchedBy(func(o *Order) bool {
+			return o.PlacedBy != nil && *o.PlacedBy == int32(adminID) && *o.UserID == customer
+		}), session).Return(nil)
+		mockRepo.On("ConfirmCheckoutSession", ctx, session).Return(nil)
+		return session
+	}
+
+	t.Run("Offline", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockAddrRepo := new(MockAddressRepository)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), nil, nil, nil, nil)
+
+		in := input(model.AdminOrderPaymentOffline)
+		fee := int32(20000)
+		in.ShippingFee = &fee
+
+		expectSession(mockRepo, mockAddrRepo)
+		mockRepo.On("SaveOfflinePayment", ctx, mock.AnythingOfType("*order.Order")).Return(nil)
+		mockRepo.On("GetByReferenceID", ctx, mock.AnythingOfType("string")).
+			Return(&Order{Status: OrderStatusPendingPayment}, nil)
+		mockRepo.On("MarkPaidByReferenceID", ctx, mock.AnythingOfType("string"),
+			mock.MatchedBy(func(c payment.Capture) bool { return strings.HasPrefix(c.ExternalReference, "offline-") })).Return(nil)
+
+		o, payResp, err := svc.CreateAdminOrder(ctx, in)
+
+		assert.NoError(t, err)
+		assert.Equal(t, OrderStatusPaid, o.Status)
+		assert.Equal(t, payment.MethodOffline, payResp.PaymentMethod)
+		mockRepo.AssertExpectations(t)
 	})
 
-	t.Run("RepoError_CreateSession", func(t *testing.T) {
+	t.Run("PaymentLink", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
+		mockPayRepo := new(MockPaymentRepository)
+		mockPayGate := new(MockPaymentGateway)
+		mockUserRepo := new(MockUserRepository)
+		mockAddrRepo := new(MockAddressRepository)
+		svc := NewService(mockRepo, mockPayRepo, mockPayGate, address.NewService(mockAddrRepo), mockUserRepo, nil, nil, nil)
+
+		in := input(model.AdminOrderPaymentPaymentLink)
+		in.PaymentMethod = utils.StrPtr(string(payment.MethodQRIS))
+
+		session := expectSession(mockRepo, mockAddrRepo)
+		mockRepo.On("UpdateSessionPaymentMethod", ctx, mock.Anything, payment.MethodQRIS).Return(nil)
+		mockUserRepo.On("GetProfile", ctx, customerID).Return(&user.Profile{
+			FullName: utils.StrPtr("Customer"),
+			Phone:    utils.StrPtr("0812"),
+			Email:    utils.StrPtr("customer@example.com"),
+		}, nil)
+		mockPayGate.On("CreateInvoice", ctx, mock.AnythingOfType("string"),
+			mock.MatchedBy(func(b payment.BuyerInfo) bool { return *b.Email == "customer@example.com" }),
+			int64(session.TotalPrice), mock.Anything, mock.Anything).
+			Return(&payment.PaymentResponse{InvoiceURL: "http://invoice", Status: "PENDING"}, nil)
+		mockPayRepo.On("SavePayment", ctx, mock.AnythingOfType("*payment.Payment")).Return(nil)
 
-		input := model.CreateCheckoutSessionInput{
-			Items: []*model.CheckoutSessionItemInput{{VariantID: "var-1", Quantity: 1}},
-		}
-		mockRepo.On("GetVariantForCheckout", ctx, "var-1").Return(&product.Variant{Price: 1000}, &product.Product{}, nil)
-		mockRepo.On("CreateCheckoutSession", ctx, mock.Anything, mock.Anything).Return(errors.New("db error"))
+		_, payResp, err := svc.CreateAdminOrder(ctx, in)
 
-		_, err := svc.CreateSession(ctx, input)
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "db error")
+		assert.NoError(t, err)
+		assert.Equal(t, "http://invoice", payResp.InvoiceURL)
+		mockRepo.AssertExpectations(t)
+		mockPayGate.AssertExpectations(t)
 	})
 
-	t.Run("GetVariantError", func(t *testing.T) {
+	t.Run("Forbidden", func(t *testing.T) {
+		svc := NewService(new(MockRepository), nil, nil, nil, nil, nil, nil, nil)
+		userCtx := utils.SetUserContext(context.Background(), 1, "test@example.com", "USER")
+
+		_, _, err := svc.CreateAdminOrder(userCtx, input(model.AdminOrderPaymentOffline))
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+
+	t.Run("AddressNotOwnedByCustomer", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
-		input := model.CreateCheckoutSessionInput{
-			Items: []*model.CheckoutSessionItemInput{{VariantID: "var-1", Quantity: 1}},
-		}
-		mockRepo.On("GetVariantForCheckout", ctx, "var-1").Return(nil, nil, errors.New("var error"))
+		mockAddrRepo := new(MockAddressRepository)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), nil, nil, nil, nil)
 
-		_, err := svc.CreateSession(ctx, input)
+		mockAddrRepo.On("GetByID", ctx, addrID).
+			Return(ownedAddress(&address.Address{ID: addrID}, adminID), nil)
+
+		_, _, err := svc.CreateAdminOrder(ctx, input(model.AdminOrderPaymentOffline))
+		assert.ErrorIs(t, err, ErrAddressNotFound)
+		mockRepo.AssertNotCalled(t, "CreateCheckoutSession", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("InvalidPaymentMethod", func(t *testing.T) {
+		svc := NewService(new(MockRepository), nil, nil, nil, nil, nil, nil, nil)
+
+		in := input(model.AdminOrderPaymentPaymentLink)
+		in.PaymentMethod = utils.StrPtr("BITCOIN")
+
+		_, _, err := svc.CreateAdminOrder(ctx, in)
 		assert.Error(t, err)
 	})
 }
 
-func TestService_GetSession(t *testing.T) {
+func TestService_GetActiveSession(t *testing.T) {
 	userID := uint(1)
-	userInt32 := int32(userID)
 	ctx := utils.SetUserContext(context.Background(), userID, "test@example.com", "user")
-	externalID := "sess-ext-1"
 
-	t.Run("Success", func(t *testing.T) {
+	t.Run("Found", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
 
-		mockSession := &CheckoutSession{
-			UserID:    &userInt32,
-			Status:    CheckoutSessionStatusPending,
-			ExpiresAt: time.Now().Add(1 * time.Hour),
-		}
+		mockRepo.On("GetActiveSessionExternalID", ctx, userID).Return("ck-1", nil)
+		mockRepo.On("GetCheckoutSession", ctx, "ck-1").Return(&CheckoutSession{ExternalID: "ck-1"}, nil)
 
-		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(mockSession, nil)
+		session, err := svc.GetActiveSession(ctx)
 
-		res, err := svc.GetSession(ctx, externalID)
 		assert.NoError(t, err)
-		assert.Equal(t, mockSession, res)
+		assert.Equal(t, "ck-1", session.ExternalID)
+		mockRepo.AssertExpectations(t)
 	})
 
-	t.Run("Expired", func(t *testing.T) {
+	t.Run("None", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
 
-		sessionID := uuid.New()
-		mockSession := &CheckoutSession{
-			ID:        sessionID,
-			UserID:    &userInt32,
-			Status:    CheckoutSessionStatusPending,
-			ExpiresAt: time.Now().Add(-1 * time.Hour), // Expired
+		mockRepo.On("GetActiveSessionExternalID", ctx, userID).Return("", nil)
+
+		session, err := svc.GetActiveSession(ctx)
+
+		assert.NoError(t, err)
+		assert.Nil(t, session)
+	})
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		svc := NewService(new(MockRepository), nil, nil, nil, nil, nil, nil, nil)
+
+		_, err := svc.GetActiveSession(context.Background())
+		assert.ErrorIs(t, err, ErrUnauthorized)
+	})
+}
+
+func TestService_ListSessionEvents(t *testing.T) {
+	adminCtx := utils.SetUserContext(context.Background(), 9, "admin@example.com", "ADMIN")
+	session := &CheckoutSession{ID: uuid.New(), ExternalID: "ck-1"}
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+
+		events := []*SessionEvent{{ID: 1, SessionID: session.ID, Type: SessionEventPaymentMethodChanged}}
+		mockRepo.On("GetCheckoutSession", adminCtx, "ck-1").Return(session, nil)
+		mockRepo.On("ListSessionEvents", adminCtx, session.ID).Return(events, nil)
+
+		res, err := svc.ListSessionEvents(adminCtx, "ck-1")
+
+		assert.NoError(t, err)
+		assert.Equal(t, events, res)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("NotAdmin", func(t *testing.T) {
+		svc := NewService(new(MockRepository), nil, nil, nil, nil, nil, nil, nil)
+		ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "USER")
+
+		_, err := svc.ListSessionEvents(ctx, "ck-1")
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+}
+
+func TestService_UpdateSessionItemQuantity(t *testing.T) {
+	userID := uint(1)
+	userInt32 := int32(userID)
+	ctx := utils.SetUserContext(context.Background(), userID, "test@example.com", "user")
+	itemA, itemB := uuid.New(), uuid.New()
+	voucherID := int64(7)
+
+	newSession := func() *CheckoutSession {
+		return &CheckoutSession{
+			ID:         uuid.New(),
+			ExternalID: "ck-1",
+			UserID:     &userInt32,
+			Status:     CheckoutSessionStatusPending,
+			ExpiresAt:  time.Now().Add(time.Hour),
+			Items: []CheckoutSessionItem{
+				{ID: itemA, VariantID: "var-a", Quantity: 1, Price: 10000, Subtotal: 10000},
+				{ID: itemB, VariantID: "var-b", Quantity: 2, Price: 5000, Subtotal: 10000},
+			},
+			Subtotal:   20000,
+			VoucherID:  &voucherID,
+			Discount:   2000,
+			TotalPrice: 19800,
 		}
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+		session := newSession()
+
+		mockRepo.On("GetCheckoutSession", ctx, "ck-1").Return(session, nil)
+		mockRepo.On("GetVariantForCheckout", ctx, "var-a").Return(&product.Variant{ID: "var-a", Price: 10000}, &product.Product{}, nil)
+		mockRepo.On("GetVariantForCheckout", ctx, "var-b").Return(&product.Variant{ID: "var-b", Price: 5000}, &product.Product{}, nil)
+		mockRepo.On("InactiveVariants", ctx, mock.Anything).Return([]string(nil), nil)
+		mockRepo.On("VariantsOnVacation", ctx, mock.Anything).Return([]string(nil), nil)
+		mockRepo.On("ValidateVariantStock", ctx, "var-a", 3).Return(true, nil)
+		mockRepo.On("ValidateVariantStock", ctx, "var-b", 2).Return(true, nil)
+		mockRepo.On("GetVoucherByID", ctx, voucherID).Return(&SessionVoucher{
+			ID: voucherID, DiscountType: VoucherDiscountPercent, DiscountValue: 10,
+		}, nil)
+		mockRepo.On("UpdateSessionItems", ctx, session).Return(nil)
+		mockRepo.On("InsertSessionEvent", ctx, mock.MatchedBy(func(e *SessionEvent) bool {
+			return e.Type == SessionEventItemQuantityChanged &&
+				*e.FromValue == "var-a x1" && *e.ToValue == "var-a x3" &&
+				e.TotalBefore == 19800 && e.TotalAfter == 39600
+		})).Return(nil)
+
+		res, err := svc.UpdateSessionItemQuantity(ctx, "ck-1", itemA.String(), 3)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 40000, res.Subtotal)
+		assert.Equal(t, 4000, res.Discount)
+		assert.Equal(t, 3600, res.Tax)
+		assert.Equal(t, 39600, res.TotalPrice)
+		assert.Equal(t, itemA, res.Items[0].ID)
+		mockRepo.AssertExpectations(t)
+	})
 
-		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(mockSession, nil)
-		mockRepo.On("MarkSessionExpired", ctx, sessionID).Return(nil)
+	t.Run("RemoveDropsVoucherBelowMinimum", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+		session := newSession()
+
+		mockRepo.On("GetCheckoutSession", ctx, "ck-1").Return(session, nil)
+		mockRepo.On("GetVariantForCheckout", ctx, "var-b").Return(&product.Variant{ID: "var-b", Price: 5000}, &product.Product{}, nil)
+		mockRepo.On("InactiveVariants", ctx, mock.Anything).Return([]string(nil), nil)
+		mockRepo.On("VariantsOnVacation", ctx, mock.Anything).Return([]string(nil), nil)
+		mockRepo.On("ValidateVariantStock", ctx, "var-b", 2).Return(true, nil)
+		mockRepo.On("GetVoucherByID", ctx, voucherID).Return(&SessionVoucher{
+			ID: voucherID, DiscountType: VoucherDiscountFixed, DiscountValue: 2000, MinSubtotal: 15000,
+		}, nil)
+		mockRepo.On("UpdateSessionItems", ctx, session).Return(nil)
+		mockRepo.On("InsertSessionEvent", ctx, mock.MatchedBy(func(e *SessionEvent) bool {
+			return e.Type == SessionEventItemRemoved && e.ToValue == nil
+		})).Return(nil)
+
+		res, err := svc.RemoveSessionItem(ctx, "ck-1", itemA.String())
 
-		res, err := svc.GetSession(ctx, externalID)
 		assert.NoError(t, err)
-		assert.Equal(t, CheckoutSessionStatusExpired, res.Status)
+		assert.Len(t, res.Items, 1)
+		assert.Nil(t, res.VoucherID)
+		assert.Equal(t, 0, res.Discount)
+		assert.Equal(t, 11000, res.TotalPrice)
 	})
 
-	t.Run("Forbidden", func(t *testing.T) {
+	t.Run("RemoveLastItem", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+		session := newSession()
+		session.Items = session.Items[:1]
 
-		otherUser := int32(999)
-		mockSession := &CheckoutSession{UserID: &otherUser}
+		mockRepo.On("GetCheckoutSession", ctx, "ck-1").Return(session, nil)
 
-		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(mockSession, nil)
+		_, err := svc.RemoveSessionItem(ctx, "ck-1", itemA.String())
 
-		_, err := svc.GetSession(ctx, externalID)
-		assert.Error(t, err)
-		assert.Equal(t, "forbidden", err.Error())
+		assert.ErrorIs(t, err, ErrSessionEmpty)
+		mockRepo.AssertNotCalled(t, "UpdateSessionItems", mock.Anything, mock.Anything)
 	})
 
-	t.Run("RepoError", func(t *testing.T) {
+	t.Run("ItemNotInSession", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
-		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(nil, errors.New("db error"))
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
 
-		_, err := svc.GetSession(ctx, externalID)
-		assert.Error(t, err)
-	})
-}
+		mockRepo.On("GetCheckoutSession", ctx, "ck-1").Return(newSession(), nil)
 
-func TestService_GetPaymentOrderInfo(t *testing.T) {
-	userID := uint(1)
-	userInt32 := int32(userID)
-	ctx := utils.SetUserContext(context.Background(), userID, "test@example.com", "user")
-	externalID := "ord-ext-1"
-	addrID := uuid.New()
+		_, err := svc.UpdateSessionItemQuantity(ctx, "ck-1", uuid.New().String(), 2)
+		assert.ErrorIs(t, err, ErrSessionItemMissing)
+	})
 
-	t.Run("Success", func(t *testing.T) {
+	t.Run("OutOfStock", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		mockPayRepo := new(MockPaymentRepository)
-		mockAddrRepo := new(MockAddressRepository)
-		svc := NewService(mockRepo, mockPayRepo, nil, mockAddrRepo, nil)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
 
-		mockOrder := &Order{
-			ID:          1,
-			UserID:      &userInt32,
-			AddressID:   addrID,
-			TotalAmount: 50000,
-			Currency:    "IDR",
-		}
-		mockPayment := &payment.Payment{
-			Status:        "PENDING",
-			PaymentMethod: "BCA",
-			PaymentCode:   "123456",
-		}
-		mockAddr := &address.Address{
-			ID:   addrID,
-			Name: "Home",
-		}
+		mockRepo.On("GetCheckoutSession", ctx, "ck-1").Return(newSession(), nil)
+		mockRepo.On("GetVariantForCheckout", ctx, "var-a").Return(&product.Variant{ID: "var-a", Price: 10000}, &product.Product{}, nil)
+		mockRepo.On("GetVariantForCheckout", ctx, "var-b").Return(&product.Variant{ID: "var-b", Price: 5000}, &product.Product{}, nil)
+		mockRepo.On("InactiveVariants", ctx, mock.Anything).Return([]string(nil), nil)
+		mockRepo.On("VariantsOnVacation", ctx, mock.Anything).Return([]string(nil), nil)
+		mockRepo.On("ValidateVariantStock", ctx, "var-a", 50).Return(false, nil)
 
-		mockRepo.On("GetOrderByExternalID", ctx, externalID).Return(mockOrder, nil)
-		mockPayRepo.On("GetPaymentByOrder", ctx, uint(1)).Return(mockPayment, nil)
-		mockAddrRepo.On("GetByID", ctx, addrID).Return(mockAddr, nil)
+		_, err := svc.UpdateSessionItemQuantity(ctx, "ck-1", itemA.String(), 50)
 
-		res, err := svc.GetPaymentOrderInfo(ctx, externalID)
-		assert.NoError(t, err)
-		assert.Equal(t, 50000, res.TotalAmount)
-		assert.NotNil(t, res.Payment.PaymentCode)
-		assert.Equal(t, "123456", *res.Payment.PaymentCode)
-		assert.Nil(t, res.Payment.InvoiceURL) // Should be nil because it's an empty string
+		assert.EqualError(t, err, "product out of stock")
+		mockRepo.AssertNotCalled(t, "UpdateSessionItems", mock.Anything, mock.Anything)
 	})
 
-	t.Run("PaymentNotFound", func(t *testing.T) {
-		mockRepo := new(MockRepository)
-		mockPayRepo := new(MockPaymentRepository)
-		svc := NewService(mockRepo, mockPayRepo, nil, nil, nil)
-
-		mockOrder := &Order{
-			ID:     1,
-			UserID: &userInt32,
-		}
-		mockRepo.On("GetOrderByExternalID", ctx, externalID).Return(mockOrder, nil)
-		mockPayRepo.On("GetPaymentByOrder", ctx, uint(1)).Return(nil, errors.New("payment not found"))
+	t.Run("InvalidQuantity", func(t *testing.T) {
+		svc := NewService(new(MockRepository), nil, nil, nil, nil, nil, nil, nil)
 
-		_, err := svc.GetPaymentOrderInfo(ctx, externalID)
+		_, err := svc.UpdateSessionItemQuantity(ctx, "ck-1", itemA.String(), 0)
 		assert.Error(t, err)
 	})
 }
 
-func TestService_UpdateSessionAddress_Forbidden(t *testing.T) {
-	userID := uint(1)
-	ctx := utils.SetUserContext(context.Background(), userID, "test@example.com", "user")
-	externalID := "sess-ext-1"
-	addrIDStr := uuid.New().String()
-
-	mockRepo := new(MockRepository)
-	svc := NewService(mockRepo, nil, nil, nil, nil)
-
-	otherUser := int32(999)
-	mockSession := &CheckoutSession{
-		UserID: &otherUser,
-		Status: CheckoutSessionStatusPending,
-	}
+func TestRuleFor(t *testing.T) {
+	jakarta := "DKI Jakarta"
+	threshold := 150000
+	def := &CheckoutRule{ID: 1}
+	regional := &CheckoutRule{ID: 2, Region: &jakarta, FreeShippingMin: &threshold}
+	rules := []*CheckoutRule{def, regional}
 
-	mockRepo.On("GetCheckoutSession", ctx, externalID).Return(mockSession, nil)
+	assert.Equal(t, regional, ruleFor(rules, "dki jakarta "))
+	assert.Equal(t, def, ruleFor(rules, "Jawa Barat"))
+	assert.Nil(t, ruleFor(nil, "Jawa Barat"))
 
-	err := svc.UpdateSessionAddress(ctx, externalID, addrIDStr, nil)
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "forbidden")
+	assert.True(t, regional.FreeShipping(150000))
+	assert.False(t, regional.FreeShipping(149999))
+	assert.False(t, def.FreeShipping(1000000))
 }
 
-func TestService_ConfirmSession_EdgeCases(t *testing.T) {
+func TestService_CheckoutRules(t *testing.T) {
 	userID := uint(1)
 	userInt32 := int32(userID)
 	ctx := utils.SetUserContext(context.Background(), userID, "test@example.com", "user")
-	externalID := "sess-ext-1"
-	now := time.Now().Add(1 * time.Hour)
+	addrID := uuid.New()
+	threshold := 100000
 
-	t.Run("AddressNotSet", func(t *testing.T) {
+	t.Run("FreeShippingAboveThreshold", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
+		mockAddrRepo := new(MockAddressRepository)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), unrestricted(), nil, nil, nil)
 
-		mockSession := &CheckoutSession{
+		session := &CheckoutSession{
 			UserID:    &userInt32,
 			Status:    CheckoutSessionStatusPending,
-			ExpiresAt: now,
-			AddressID: nil, // Missing address
+			ExpiresAt: time.Now().Add(time.Hour),
+			Subtotal:  120000,
 		}
+		mockRepo.On("GetCheckoutSession", ctx, "ck-1").Return(session, nil)
+		mockAddrRepo.On("GetByID", ctx, mock.Anything).Return(ownedAddress(&address.Address{ID: addrID, City: "Jakarta", Province: "DKI Jakarta"}, userID), nil)
+		mockRepo.On("ListCheckoutRules", ctx, true).
+			Return([]*CheckoutRule{{MinOrderAmount: 0, FreeShippingMin: &threshold}}, nil)
+		mockRepo.On("UpdateSessionAddressAndPricing", ctx, mock.MatchedBy(func(s *CheckoutSession) bool {
+			return s.ShippingFee == 0 && s.TotalPrice == 132000
+		})).Return(nil)
+		mockRepo.On("InsertSessionEvent", ctx, mock.AnythingOfType("*order.SessionEvent")).Return(nil)
 
-		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(mockSession, nil)
+		err := svc.UpdateSessionAddress(ctx, "ck-1", addrID.String())
 
-		_, err := svc.ConfirmSession(ctx, externalID)
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "shipping address not set")
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
 	})
 
-	t.Run("AlreadyConfirmed", func(t *testing.T) {
+	t.Run("ConfirmBelowMinimum", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
+		mockAddrRepo := new(MockAddressRepository)
+		svc := NewService(mockRepo, nil, nil, address.NewService(mockAddrRepo), unrestricted(), nil, nil, nil)
 
-		mockSession := &CheckoutSession{
-			UserID: &userInt32,
-			Status: CheckoutSessionStatusPaid, // Not pending
+		session := &CheckoutSession{
+			UserID:    &userInt32,
+			Status:    CheckoutSessionStatusPending,
+			ExpiresAt: time.Now().Add(time.Hour),
+			AddressID: &addrID,
+			Subtotal:  20000,
+			Items:     []CheckoutSessionItem{{VariantID: "v1", Quantity: 1}},
 		}
+		mockRepo.On("GetCheckoutSession", ctx, "ck-1").Return(session, nil)
+		mockRepo.On("ListCurrentPolicies", ctx).Return([]*Policy{}, nil)
+		mockAddrRepo.On("GetByID", ctx, mock.Anything).Return(ownedAddress(&address.Address{ID: addrID, Province: "Bali"}, userID), nil)
+		mockRepo.On("ListCheckoutRules", ctx, true).
+			Return([]*CheckoutRule{{MinOrderAmount: 50000}}, nil)
 
-		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(mockSession, nil)
+		_, err := svc.ConfirmSession(ctx, "ck-1", nil)
 
-		_, err := svc.ConfirmSession(ctx, externalID)
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "already confirmed")
+		assert.ErrorIs(t, err, ErrBelowMinimumOrder)
+		mockRepo.AssertNotCalled(t, "ValidateVariantStock", mock.Anything, mock.Anything, mock.Anything)
 	})
 
-	t.Run("Forbidden_Ownership", func(t *testing.T) {
+	t.Run("SetRule", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+		adminCtx := utils.SetUserContext(context.Background(), 9, "admin@example.com", "ADMIN")
+		region := " Bali "
+		free := int32(150000)
 
-		otherUser := int32(999)
-		mockSession := &CheckoutSession{UserID: &otherUser}
+		mockRepo.On("UpsertCheckoutRule", adminCtx, mock.MatchedBy(func(r *CheckoutRule) bool {
+			return *r.Region == "Bali" && r.MinOrderAmount == 25000 && *r.FreeShippingMin == 150000
+		}), uint(9)).Return(&CheckoutRule{ID: 3}, nil)
 
-		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(mockSession, nil)
+		rule, err := svc.SetCheckoutRule(adminCtx, model.SetCheckoutRuleInput{
+			Region: &region, MinOrderAmount: 25000, FreeShippingMin: &free, IsActive: true,
+		})
 
-		_, err := svc.ConfirmSession(ctx, externalID)
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "forbidden")
+		assert.NoError(t, err)
+		assert.Equal(t, int32(3), rule.ID)
 	})
 
-	t.Run("NoItems", func(t *testing.T) {
-		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
+	t.Run("SetRuleNegative", func(t *testing.T) {
+		svc := NewService(new(MockRepository), nil, nil, nil, nil, nil, nil, nil)
+		adminCtx := utils.SetUserContext(context.Background(), 9, "admin@example.com", "ADMIN")
 
-		mockSession := &CheckoutSession{
-			UserID:    &userInt32,
-			Status:    CheckoutSessionStatusPending,
-			ExpiresAt: now,
-			AddressID: &uuid.UUID{},
-			Items:     []CheckoutSessionItem{},
-		}
+		_, err := svc.SetCheckoutRule(adminCtx, model.SetCheckoutRuleInput{MinOrderAmount: -1, IsActive: true})
+		assert.ErrorIs(t, err, ErrInvalidRule)
+	})
 
-		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(mockSession, nil)
+	t.Run("AdminListRequiresAdmin", func(t *testing.T) {
+		svc := NewService(new(MockRepository), nil, nil, nil, nil, nil, nil, nil)
 
-		_, err := svc.ConfirmSession(ctx, externalID)
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "checkout session has no items")
+		_, err := svc.CheckoutRules(ctx, true)
+		assert.ErrorIs(t, err, ErrForbidden)
 	})
+}
 
-	t.Run("RepoError_Confirm", func(t *testing.T) {
+func TestService_RequestOrderAdjustment(t *testing.T) {
+	adminCtx := utils.SetUserContext(context.Background(), 9, "admin@example.com", "ADMIN")
+	input := model.RequestOrderAdjustmentInput{
+		OrderID: "100", Kind: model.OrderAdjustmentKindDiscount, Amount: 5000, Reason: " late delivery ",
+	}
+	pending := &Order{ID: 100, Status: OrderStatusPendingPayment, TotalAmount: 50000, WalletAmount: 10000}
+
+	t.Run("Saves", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
-		sessID := uuid.New()
-		addrID := uuid.New()
-		mockSession := &CheckoutSession{ID: sessID, UserID: &userInt32, Status: CheckoutSessionStatusPending, ExpiresAt: now, AddressID: &addrID, Items: []CheckoutSessionItem{{VariantID: "v1", Quantity: 1}}}
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+		mockRepo.On("GetAdjustableOrder", adminCtx, uint(100)).Return(pending, "ck-1", nil)
+		mockRepo.On("CreateOrderAdjustment", adminCtx, mock.MatchedBy(func(a *OrderAdjustment) bool {
+			return a.OrderID == 100 && a.Kind == AdjustmentKindDiscount && a.Amount == 5000 &&
+				a.Reason == "late delivery" && a.RequestedBy == 9
+		})).Return(&OrderAdjustment{ID: 5, Status: AdjustmentPending}, nil)
 
-		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(mockSession, nil)
-		mockRepo.On("ValidateVariantStock", ctx, "v1", 1).Return(true, nil)
-		mockRepo.On("GetOrderBySessionID", ctx, sessID).Return(nil, nil)
-		mockRepo.On("CreateOrderTx", ctx, mock.Anything, mock.Anything).Return(errors.New("tx error"))
+		a, err := svc.RequestOrderAdjustment(adminCtx, input)
 
-		_, err := svc.ConfirmSession(ctx, externalID)
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "tx error")
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), a.ID)
 	})
 
-	t.Run("RepoError_GetSession", func(t *testing.T) {
-		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
-		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(nil, errors.New("db error"))
-		_, err := svc.ConfirmSession(ctx, externalID)
-		assert.Error(t, err)
+	t.Run("Invalid", func(t *testing.T) {
+		svc := NewService(new(MockRepository), nil, nil, nil, nil, nil, nil, nil)
+		in := input
+		in.Amount = 0
+
+		_, err := svc.RequestOrderAdjustment(adminCtx, in)
+
+		assert.ErrorIs(t, err, ErrInvalidAdjustment)
 	})
 
-	t.Run("RepoError_ValidateStock", func(t *testing.T) {
+	t.Run("DiscountTooLarge", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo, nil, nil, nil, nil)
-		addrID := uuid.New()
-		mockSession := &CheckoutSession{UserID: &userInt32, Status: CheckoutSessionStatusPending, ExpiresAt: now, AddressID: &addrID, Items: []CheckoutSessionItem{{VariantID: "v1", Quantity: 1}}}
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+		mockRepo.On("GetAdjustableOrder", adminCtx, uint(100)).Return(pending, "ck-1", nil)
+		in := input
+		in.Amount = 40000
 
-		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(mockSession, nil)
-		mockRepo.On("ValidateVariantStock", ctx, "v1", 1).Return(false, errors.New("stock error"))
+		_, err := svc.RequestOrderAdjustment(adminCtx, in)
 
-		_, err := svc.ConfirmSession(ctx, externalID)
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "stock error")
+		assert.ErrorIs(t, err, ErrAdjustmentTooLarge)
+		mockRepo.AssertNotCalled(t, "CreateOrderAdjustment", mock.Anything, mock.Anything)
 	})
 
-	t.Run("RepoError_ConfirmSession", func(t *testing.T) {
+	t.Run("OrderPaid", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		mockPayGate := new(MockPaymentGateway)
-		mockPayRepo := new(MockPaymentRepository)
-		svc := NewService(mockRepo, mockPayRepo, mockPayGate, nil, nil)
-		sessID := uuid.New()
-		addrID := uuid.New()
-		mockSession := &CheckoutSession{ID: sessID, UserID: &userInt32, Status: CheckoutSessionStatusPending, ExpiresAt: now, AddressID: &addrID, Items: []CheckoutSessionItem{{VariantID: "v1", Quantity: 1}}}
+		svc := NewService(mockRepo, nil, nil, nil, nil, nil, nil, nil)
+		mockRepo.On("GetAdjustableOrder", adminCtx, uint(100)).
+			Return(&Order{ID: 100, Status: OrderStatusPaid, TotalAmount: 50000}, "ck-1", nil)
 
-		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(mockSession, nil)
-		mockRepo.On("ValidateVariantStock", ctx, "v1", 1).Return(true, nil)
-		mockRepo.On("GetOrderBySessionID", ctx, sessID).Return(nil, nil)
-		mockRepo.On("CreateOrderTx", ctx, mock.Anything, mock.Anything).Return(nil)
-		mockRepo.On("ConfirmCheckoutSession", ctx, mockSession).Return(errors.New("confirm error"))
+		_, err := svc.RequestOrderAdjustment(adminCtx, input)
 
-		_, err := svc.ConfirmSession(ctx, externalID)
-		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "confirm error")
+		assert.ErrorIs(t, err, ErrOrderNotAdjustable)
 	})
-}
 
-func TestService_OrderToPaymentProcess_GatewayError(t *testing.T) {
-	mockRepo := new(MockRepository)
-	mockPayGate := new(MockPaymentGateway)
-	svc := NewService(mockRepo, nil, mockPayGate, nil, nil)
+	t.Run("NotAdmin", func(t *testing.T) {
+		svc := NewService(new(MockRepository), nil, nil, nil, nil, nil, nil, nil)
+		ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "user")
 
-	ctx := context.Background()
-	orderExtID := "ord-ext-1"
-	orderID := uint(1)
+		_, err := svc.RequestOrderAdjustment(ctx, input)
 
-	pm := payment.MethodBCAVA
-	mockSession := &CheckoutSession{
-		TotalPrice:    10000,
-		Items:         []CheckoutSessionItem{{ProductName: "P1", VariantName: "V1", Quantity: 1, Price: 10000}},
-		PaymentMethod: &pm,
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+}
+
+func TestService_ApproveOrderAdjustment(t *testing.T) {
+	adminCtx := utils.SetUserContext(context.Background(), 9, "admin@example.com", "ADMIN")
+	method := payment.MethodBCAVA
+
+	setup := func(requestedBy int32) (*service, *MockRepository, *MockPaymentRepository, *MockPaymentGateway, *MockNotifier) {
+		mockRepo := new(MockRepository)
+		payRepo := new(MockPaymentRepository)
+		gateway := new(MockPaymentGateway)
+		notifier := new(MockNotifier)
+		svc := NewService(mockRepo, payRepo, gateway, nil, nil, nil, nil, nil).(*service)
+		svc.notifier = notifier
+
+		mockRepo.On("GetOrderAdjustment", adminCtx, int64(5)).Return(&OrderAdjustment{
+			ID: 5, OrderID: 100, Kind: AdjustmentKindDiscount, Amount: 5000,
+			Status: AdjustmentPending, RequestedBy: requestedBy,
+		}, nil)
+		mockRepo.On("GetAdjustableOrder", adminCtx, uint(100)).Return(&Order{
+			ID: 100, ExternalID: "ord-1", Status: OrderStatusPendingPayment, TotalAmount: 50000,
+		}, "ck-1", nil).Maybe()
+		mockRepo.On("GetCheckoutSession", adminCtx, "ck-1").Return(&CheckoutSession{
+			ExternalID:    "ck-1",
+			PaymentMethod: &method,
+			Items:         []CheckoutSessionItem{{ProductName: "Beras", VariantName: "5kg", Quantity: 1, Price: 45000}},
+		}, nil).Maybe()
+		payRepo.On("GetPaymentsByOrder", adminCtx, uint(100)).Return([]*payment.Payment{
+			{ExternalReference: "pr-old", Status: "PENDING", Provider: payment.ProviderXendit},
+		}, nil).Maybe()
+		return svc, mockRepo, payRepo, gateway, notifier
 	}
 
-	mockPayGate.On("CreateInvoice", ctx, orderExtID, mock.AnythingOfType("payment.BuyerInfo"), int64(10000), mock.Anything, payment.ChannelCode(payment.MethodBCAVA)).Return(nil, errors.New("gateway error"))
+	t.Run("ReplacesInvoice", func(t *testing.T) {
+		svc, mockRepo, _, gateway, notifier := setup(8)
+		gateway.On("CancelPayment", adminCtx, "pr-old").Return(nil).Once()
+		gateway.On("CreateInvoice", adminCtx, "ord-1", mock.Anything, int64(45000), mock.Anything, payment.MethodBCAVA).
+			Return(&payment.PaymentResponse{ProviderPaymentID: "pr-new", Amount: 45000, Status: "PENDING"}, nil)
+		applied := &OrderAdjustment{ID: 5, OrderID: 100, Kind: AdjustmentKindDiscount, Amount: 5000, Status: AdjustmentApplied}
+		updated := &Order{ID: 100, ExternalID: "ord-1", TotalAmount: 45000}
+		mockRepo.On("ApplyOrderAdjustment", adminCtx, int64(5), uint(9), "pr-old", mock.MatchedBy(func(p *payment.Payment) bool {
+			return p.ExternalReference == "pr-new" && p.Amount == 45000
+		})).Return(applied, updated, nil)
+		notifier.On("NotifyOrderAdjusted", adminCtx, preference.DefaultDelivery(preference.EventOrderUpdates), (*int32)(nil), "ord-1", applied, uint(45000)).Return(nil)
 
-	_, err := svc.OrderToPaymentProcess(ctx, mockSession, orderExtID, orderID)
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "failed to create payment invoice")
-}
+		a, err := svc.ApproveOrderAdjustment(adminCtx, 5)
 
-func TestService_OrderToPaymentProcess_SavePaymentError(t *testing.T) {
-	mockRepo := new(MockRepository)
-	mockPayRepo := new(MockPaymentRepository)
-	mockPayGate := new(MockPaymentGateway)
-	svc := NewService(mockRepo, mockPayRepo, mockPayGate, nil, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, AdjustmentApplied, a.Status)
+		gateway.AssertExpectations(t)
+		notifier.AssertExpectations(t)
+	})
 
-	ctx := context.Background()
-	orderExtID := "ord-ext-1"
-	orderID := uint(1)
+	t.Run("SelfApproval", func(t *testing.T) {
+		svc, _, _, gateway, _ := setup(9)
 
-	pm := payment.MethodBCAVA
-	mockSession := &CheckoutSession{
-		TotalPrice:    10000,
-		Items:         []CheckoutSessionItem{{ProductName: "P1", VariantName: "V1", Quantity: 1, Price: 10000}},
-		PaymentMethod: &pm,
-	}
-	mockPayResp := &payment.PaymentResponse{ProviderPaymentID: "pay-1", Status: "PENDING"}
+		_, err := svc.ApproveOrderAdjustment(adminCtx, 5)
 
-	mockPayGate.On("CreateInvoice", ctx, orderExtID, mock.AnythingOfType("payment.BuyerInfo"), int64(10000), mock.Anything, payment.ChannelCode(payment.MethodBCAVA)).Return(mockPayResp, nil)
-	mockPayRepo.On("SavePayment", ctx, mock.Anything).Return(errors.New("db error"))
+		assert.ErrorIs(t, err, ErrAdjustmentSelfApproval)
+		gateway.AssertNotCalled(t, "CancelPayment", mock.Anything, mock.Anything)
+	})
 
-	_, err := svc.OrderToPaymentProcess(ctx, mockSession, orderExtID, orderID)
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "failed to save payment")
-}
+	t.Run("InvoiceFailsStaysPending", func(t *testing.T) {
+		svc, mockRepo, _, gateway, _ := setup(8)
+		gateway.On("CancelPayment", adminCtx, "pr-old").Return(nil)
+		gateway.On("CreateInvoice", adminCtx, "ord-1", mock.Anything, int64(45000), mock.Anything, payment.MethodBCAVA).
+			Return(nil, errors.New("gateway down"))
 
-func TestService_GetPaymentOrderInfo_Forbidden(t *testing.T) {
-	mockRepo := new(MockRepository)
-	svc := NewService(mockRepo, nil, nil, nil, nil)
-	ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "user")
+		_, err := svc.ApproveOrderAdjustment(adminCtx, 5)
 
-	otherUser := int32(999)
-	mockOrder := &Order{UserID: &otherUser}
-	mockRepo.On("GetOrderByExternalID", ctx, "ext-id").Return(mockOrder, nil)
+		assert.ErrorContains(t, err, "gateway down")
+		mockRepo.AssertNotCalled(t, "ApplyOrderAdjustment", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
 
-	_, err := svc.GetPaymentOrderInfo(ctx, "ext-id")
-	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "forbidden")
+	t.Run("PaidMeanwhileCancelsNewInvoice", func(t *testing.T) {
+		svc, mockRepo, _, gateway, _ := setup(8)
+		gateway.On("CancelPayment", adminCtx, "pr-old").Return(nil).Once()
+		gateway.On("CreateInvoice", adminCtx, "ord-1", mock.Anything, int64(45000), mock.Anything, payment.MethodBCAVA).
+			Return(&payment.PaymentResponse{ProviderPaymentID: "pr-new", Amount: 45000}, nil)
+		mockRepo.On("ApplyOrderAdjustment", adminCtx, int64(5), uint(9), "pr-old", mock.Anything).
+			Return(nil, nil, ErrOrderNotAdjustable)
+		gateway.On("CancelPayment", adminCtx, "pr-new").Return(nil).Once()
+
+		_, err := svc.ApproveOrderAdjustment(adminCtx, 5)
+
+		assert.ErrorIs(t, err, ErrOrderNotAdjustable)
+		gateway.AssertExpectations(t)
+	})
 }
 
-func TestService_GetPaymentOrderInfo_AddressError(t *testing.T) {
-	mockRepo := new(MockRepository)
-	mockPayRepo := new(MockPaymentRepository)
-	mockAddrRepo := new(MockAddressRepository)
-	svc := NewService(mockRepo, mockPayRepo, nil, mockAddrRepo, nil)
-	ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "user")
+func TestService_UpdateSessionPaymentMethod_COD(t *testing.T) {
+	userID := uint(1)
+	userInt32 := int32(userID)
+	ctx := utils.SetUserContext(context.Background(), userID, "test@example.com", "user")
+	externalID := "sess-ext-1"
+	phone := "+628123456789"
 
-	userID := int32(1)
-	mockOrder := &Order{ID: 1, UserID: &userID, AddressID: uuid.New()}
-	mockRepo.On("GetOrderByExternalID", ctx, "ext-id").Return(mockOrder, nil)
-	mockPayRepo.On("GetPaymentByOrder", ctx, uint(1)).Return(&payment.Payment{}, nil)
-	mockAddrRepo.On("GetByID", ctx, mockOrder.AddressID).Return(nil, errors.New("addr error"))
+	setup := func(session *CheckoutSession) (Service, *MockRepository, *MockUserRepository) {
+		mockRepo := new(MockRepository)
+		mockUserRepo := new(MockUserRepository)
+		svc := NewService(mockRepo, nil, nil, nil, mockUserRepo, nil, nil, nil)
+		mockRepo.On("GetCheckoutSession", ctx, externalID).Return(session, nil)
+		return svc, mockRepo, mockUserRepo
+	}
+	pending := func(userID *int32) *CheckoutSession {
+		return &CheckoutSession{UserID: userID, Status: CheckoutSessionStatusPending, ExpiresAt: time.Now().Add(time.Hour)}
+	}
 
-	_, err := svc.GetPaymentOrderInfo(ctx, "ext-id")
-	assert.Error(t, err)
-	assert.Equal(t, "failed to get address", err.Error())
+	t.Run("VerifiedPhone", func(t *testing.T) {
+		svc, mockRepo, mockUserRepo := setup(pending(&userInt32))
+		verifiedAt := time.Now()
+		mockUserRepo.On("GetProfile", ctx, userID).Return(&user.Profile{Phone: &phone, PhoneVerifiedAt: &verifiedAt}, nil)
+		mockRepo.On("UpdateSessionPaymentMethod", ctx, mock.Anything, payment.MethodCOD).Return(nil)
+		mockRepo.On("InsertSessionEvent", ctx, mock.Anything).Return(nil).Maybe()
+
+		err := svc.UpdateSessionPaymentMethod(ctx, externalID, payment.MethodCOD)
+
+		assert.NoError(t, err)
+		mockRepo.AssertCalled(t, "UpdateSessionPaymentMethod", ctx, mock.Anything, payment.MethodCOD)
+	})
+
+	t.Run("UnverifiedPhone", func(t *testing.T) {
+		svc, mockRepo, mockUserRepo := setup(pending(&userInt32))
+		mockUserRepo.On("GetProfile", ctx, userID).Return(&user.Profile{Phone: &phone}, nil)
+
+		err := svc.UpdateSessionPaymentMethod(ctx, externalID, payment.MethodCOD)
+
+		assert.ErrorIs(t, err, ErrCODPhoneUnverified)
+		mockRepo.AssertNotCalled(t, "UpdateSessionPaymentMethod", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("NoProfile", func(t *testing.T) {
+		svc, _, mockUserRepo := setup(pending(&userInt32))
+		mockUserRepo.On("GetProfile", ctx, userID).Return(nil, user.ErrProfileNotFound)
+
+		err := svc.UpdateSessionPaymentMethod(ctx, externalID, payment.MethodCOD)
+
+		assert.ErrorIs(t, err, ErrCODPhoneUnverified)
+	})
 }
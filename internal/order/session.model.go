@@ -2,6 +2,8 @@ package order
 
 import (
 	"time"
+	"warimas-be/internal/loyalty"
+	"warimas-be/internal/money"
 	"warimas-be/internal/payment"
 
 	"github.com/google/uuid"
@@ -14,6 +16,10 @@ const (
 	PaymentStatusPaid    PaymentStatus = "PAID"
 	PaymentStatusFailed  PaymentStatus = "FAILED"
 	PaymentStatusExpired PaymentStatus = "EXPIRED"
+	// PaymentStatusVoided no longer counts toward the order: a wallet
+	// portion returned after the order failed, or an invoice replaced
+	// after an adjustment changed the order's total.
+	PaymentStatusVoided PaymentStatus = "VOIDED"
 )
 
 type CheckoutSessionStatus string
@@ -23,6 +29,21 @@ const (
 	CheckoutSessionStatusPaid     CheckoutSessionStatus = "PAID"
 	CheckoutSessionStatusExpired  CheckoutSessionStatus = "EXPIRED"
 	CheckoutSessionStatusCanceled CheckoutSessionStatus = "CANCELLED"
+	// Replaced by a newer session of the same user
+	CheckoutSessionStatusSuperseded CheckoutSessionStatus = "SUPERSEDED"
+)
+
+// MaxOpenGuestSessions caps the checkout sessions one guest may have open
+// at once. Sessions expire after half an hour, so a shopper never gets
+// near it.
+const MaxOpenGuestSessions = 10
+
+// taxBasisPoints is the VAT charged on the discounted subtotal, 10%.
+const taxBasisPoints = 1000
+
+const (
+	VoucherDiscountPercent = "PERCENT"
+	VoucherDiscountFixed   = "FIXED"
 )
 
 type CheckoutSession struct {
@@ -32,6 +53,9 @@ type CheckoutSession struct {
 	ExpiresAt   time.Time
 	CreatedAt   time.Time
 	ConfirmedAt *time.Time
+	// PaymentExpiresAt is when the pending gateway payment of the session's
+	// order expires; nil before confirming and once nothing is waiting.
+	PaymentExpiresAt *time.Time
 
 	// Optional / lifecycle-dependent
 	UserID    *int32
@@ -46,8 +70,89 @@ type CheckoutSession struct {
 	ShippingFee   int
 	Discount      int
 	TotalPrice    int
+	WalletAmount  int
 	Currency      string
 	PaymentMethod *payment.ChannelCode
+
+	// Voucher applied through applyCoupon; Discount holds its amount.
+	VoucherID *int64
+	// Loyalty points burned on this checkout, see PointsDiscount.
+	PointsRedeemed int
+	// Weight the courier bills for, see chargeableWeightGrams.
+	ChargeableWeightGrams int
+	// ShippingParcels splits that weight by the origin it ships from.
+	ShippingParcels []ShippingParcel
+	ShippingMethod  ShippingMethod
+	// PickupLocationID and PickupSlotStart are the customer's choice for
+	// SELF_PICKUP; nil for other methods.
+	PickupLocationID *int32
+	PickupSlotStart  *time.Time
+	// DeliverySlotID and DeliveryDate are the same-day slot picked for
+	// INSTANT, and the local day it was picked for.
+	DeliverySlotID *int32
+	DeliveryDate   *time.Time
+	// Insured asks the courier to insure the goods, see InsuranceFee.
+	Insured bool
+}
+
+// parcels returns the session's parcels. Sessions opened before sellers
+// had origins have none and ship their whole weight from the platform
+// origin.
+func (s *CheckoutSession) parcels() []ShippingParcel {
+	if len(s.ShippingParcels) > 0 {
+		return s.ShippingParcels
+	}
+	return []ShippingParcel{{OriginCity: platformOriginCity, WeightGrams: s.ChargeableWeightGrams}}
+}
+
+// PointsDiscount is the rupiah value of the redeemed loyalty points.
+func (s *CheckoutSession) PointsDiscount() int {
+	return s.PointsRedeemed * loyalty.PointValue
+}
+
+// GatewayAmount is the part of the total left for the payment gateway
+// after the wallet portion has been applied.
+func (s *CheckoutSession) GatewayAmount() int {
+	return s.TotalPrice - s.WalletAmount
+}
+
+// SessionVoucher is the voucher data needed to validate a coupon against
+// a checkout session.
+type SessionVoucher struct {
+	ID            int64
+	CampaignID    int64
+	DiscountType  string
+	DiscountValue int64
+	MaxDiscount   *int64
+	MinSubtotal   int64
+	UsageLimit    *int32
+	Redemptions   int64
+	OwnerUserID   *int32
+	IsActive      bool
+	ExpiresAt     *time.Time
+	StartsAt      time.Time
+	EndsAt        *time.Time
+}
+
+// DiscountFor returns the discount the voucher gives on subtotal, capped by
+// MaxDiscount and never more than the subtotal itself. Percentages are
+// rounded by the rule of currency.
+func (v *SessionVoucher) DiscountFor(currency string, subtotal int) int {
+	var discount int64
+	switch v.DiscountType {
+	case VoucherDiscountPercent:
+		discount = money.Share(currency, int64(subtotal), v.DiscountValue*100)
+	default:
+		discount = v.DiscountValue
+	}
+
+	if v.MaxDiscount != nil && discount > *v.MaxDiscount {
+		discount = *v.MaxDiscount
+	}
+	if discount > int64(subtotal) {
+		discount = int64(subtotal)
+	}
+	return int(discount)
 }
 
 type CheckoutSessionItem struct {
@@ -66,6 +171,37 @@ type CheckoutSessionItem struct {
 	Subtotal int
 }
 
+type SessionEventType string
+
+const (
+	SessionEventAddressChanged        SessionEventType = "ADDRESS_CHANGED"
+	SessionEventPaymentMethodChanged  SessionEventType = "PAYMENT_METHOD_CHANGED"
+	SessionEventShippingMethodChanged SessionEventType = "SHIPPING_METHOD_CHANGED"
+	SessionEventInsuranceChanged      SessionEventType = "INSURANCE_CHANGED"
+	SessionEventWalletChanged         SessionEventType = "WALLET_CHANGED"
+	SessionEventCouponApplied         SessionEventType = "COUPON_APPLIED"
+	SessionEventPointsChanged         SessionEventType = "POINTS_CHANGED"
+	SessionEventItemQuantityChanged   SessionEventType = "ITEM_QUANTITY_CHANGED"
+	SessionEventItemRemoved           SessionEventType = "ITEM_REMOVED"
+)
+
+// SessionEvent is one change made to a checkout session after creation.
+// FromValue and ToValue hold the changed field as text; the totals show
+// what the change did to the amount due.
+type SessionEvent struct {
+	ID           int64
+	SessionID    uuid.UUID
+	Type         SessionEventType
+	ActorUserID  *int32
+	ActorGuestID *uuid.UUID
+	ActorRole    *string
+	FromValue    *string
+	ToValue      *string
+	TotalBefore  int
+	TotalAfter   int
+	CreatedAt    time.Time
+}
+
 type PaymentOrderInfoResponse struct {
 	OrderExternalID string          `json:"orderExternalId"`
 	Status          PaymentStatus   `json:"status"`
@@ -74,6 +210,10 @@ type PaymentOrderInfoResponse struct {
 	Currency        string          `json:"currency"`
 	ShippingAddress ShippingAddress `json:"shippingAddress"`
 	Payment         PaymentDetail   `json:"payment"`
+	// Timeline is what happened to the payment so far, oldest first.
+	Timeline []payment.TimelineEntry `json:"timeline"`
+	// SecondsRemaining counts down to ExpiresAt while the payment is pending.
+	SecondsRemaining int `json:"secondsRemaining"`
 }
 
 type ShippingAddress struct {
@@ -95,3 +235,13 @@ type PaymentDetail struct {
 	ReferenceID  string              `json:"referenceId"`
 	Instructions []string            `json:"instructions"`
 }
+
+// secondsUntil is the countdown a client shows for expiresAt: whole seconds
+// left, rounded up so it reaches 0 only once expired, and never negative.
+func secondsUntil(expiresAt, now time.Time) int {
+	d := expiresAt.Sub(now)
+	if d <= 0 {
+		return 0
+	}
+	return int((d + time.Second - 1) / time.Second)
+}
@@ -0,0 +1,143 @@
+package order
+
+import (
+	"context"
+	"errors"
+	"time"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/payment"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// SessionExpiryInterval is how often checkout sessions past their expiry
+// are closed.
+const SessionExpiryInterval = time.Minute
+
+// sessionExpiryBatchSize bounds the sessions closed per run; the rest are
+// picked up on the next one.
+const sessionExpiryBatchSize = 100
+
+// sessionGrace is how long an expired session is left alone before it
+// is closed, so a confirmation that passed its expiry check just in time
+// can place its order and get its payment request.
+const sessionGrace = 10 * time.Minute
+
+// ExpiredSession is a PENDING checkout session past its expiry.
+type ExpiredSession struct {
+	ID         uuid.UUID
+	ExternalID string
+	ExpiresAt  time.Time
+	// OrderExternalID is set when an order was placed from the session.
+	// Confirming again fails once the session expired, so an order
+	// without a payment request could never be paid.
+	OrderExternalID *string
+	OrderID         *int32
+	UserID          *int32
+	// PaymentRequestID is the order's outstanding gateway payment request,
+	// if it got one.
+	PaymentRequestID *string
+}
+
+// ExpireSessions closes checkout sessions past their expiry, so they stop
+// counting as open without waiting for someone to read them. A session
+// without an order becomes EXPIRED. An order still waiting for payment is
+// cancelled like one whose payment expired: its payment request is
+// cancelled with the gateway first, then the session expires, the wallet
+// portion is returned and the stock goes back. Returns how many sessions
+// were closed.
+func (s *service) ExpireSessions(ctx context.Context) (int, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "ExpireSessions"),
+	)
+
+	now := s.now()
+	expired, err := s.repo.ListExpiredSessions(ctx, now, now.Add(-sessionGrace), sessionExpiryBatchSize)
+	if err != nil {
+		log.Error("failed to list expired sessions", zap.Error(err))
+		return 0, err
+	}
+
+	closed := 0
+	for _, es := range expired {
+		sesslog := log.With(zap.String("session_external_id", es.ExternalID))
+
+		var err error
+		switch {
+		case es.OrderExternalID == nil:
+			err = s.expireOpenSession(ctx, es)
+		case es.PaymentRequestID != nil:
+			err = s.cancelSessionPayment(ctx, sesslog, es)
+		default:
+			change := orderChange{svc: s, orderID: uint(*es.OrderID), externalID: *es.OrderExternalID, userID: es.UserID, byPayment: true}
+			err = OrderStatuses.Fire(ctx, change, OrderStatusPendingPayment, OrderStatusCancelled, func(ctx context.Context) error {
+				return s.repo.CancelUnpaidSessionOrder(ctx, *es.OrderExternalID)
+			})
+		}
+		if errors.Is(err, ErrPaymentNotPending) || errors.Is(err, payment.ErrPaymentAlreadyPaid) {
+			// Paid for or cancelled since it was listed
+			sesslog.Info("order of expired session no longer waiting for payment")
+			continue
+		}
+		if err != nil {
+			sesslog.Error("failed to close expired checkout session", zap.Error(err))
+			continue
+		}
+		closed++
+	}
+
+	log.Info("expired checkout sessions processed",
+		zap.Int("expired", len(expired)),
+		zap.Int("closed", closed),
+	)
+	return closed, nil
+}
+
+// expireOpenSession expires a session no order was placed from.
+func (s *service) expireOpenSession(ctx context.Context, es *ExpiredSession) error {
+	session := &CheckoutSession{ID: es.ID, ExternalID: es.ExternalID, Status: CheckoutSessionStatusPending}
+	return SessionStatuses.Fire(ctx, session, session.Status, CheckoutSessionStatusExpired, func(ctx context.Context) error {
+		return s.repo.MarkSessionExpired(ctx, es.ID)
+	})
+}
+
+// cancelSessionPayment cancels the outstanding payment request of an
+// expired session's order and then the order, and tells the customer.
+// A request that already expired is cancelled all the same; one that was
+// paid returns payment.ErrPaymentAlreadyPaid and is left to its capture
+// webhook.
+func (s *service) cancelSessionPayment(ctx context.Context, log *zap.Logger, es *ExpiredSession) error {
+	log = log.With(
+		zap.String("order_external_id", *es.OrderExternalID),
+		zap.String("payment_request_id", *es.PaymentRequestID),
+	)
+
+	err := s.paymentGate.CancelPayment(ctx, *es.PaymentRequestID)
+	if err != nil && !errors.Is(err, payment.ErrPaymentAlreadyExpired) {
+		return err
+	}
+
+	change := orderChange{svc: s, orderID: uint(*es.OrderID), externalID: *es.OrderExternalID, userID: es.UserID, byPayment: true}
+	err = OrderStatuses.Fire(ctx, change, OrderStatusPendingPayment, OrderStatusCancelled, func(ctx context.Context) error {
+		return s.repo.ExpireOrderPayment(ctx, *es.OrderExternalID, *es.PaymentRequestID)
+	})
+	if err != nil {
+		return err
+	}
+
+	if d := s.orderUpdateDelivery(ctx, es.UserID); !d.Muted() {
+		p := &ExpiredPayment{
+			OrderID:          *es.OrderID,
+			OrderExternalID:  *es.OrderExternalID,
+			PaymentRequestID: *es.PaymentRequestID,
+			UserID:           es.UserID,
+			ExpiredAt:        s.now(),
+		}
+		if err := s.notifier.NotifyPaymentExpired(ctx, d, p); err != nil {
+			log.Error("failed to notify about expired payment", zap.Error(err))
+		}
+	}
+	return nil
+}
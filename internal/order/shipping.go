@@ -0,0 +1,127 @@
+package order
+
+import (
+	"encoding/json"
+	"strings"
+	"warimas-be/internal/address"
+	"warimas-be/internal/geo"
+	"warimas-be/internal/product"
+)
+
+// volumetricDivisor is the courier convention for volumetric weight:
+// length x width x height in cm divided by 6000 gives kilograms.
+const volumetricDivisor = 6000
+
+// shippingRate is what a courier charges for the first kilogram and for
+// every kilogram after it.
+type shippingRate struct {
+	FirstKg int
+	NextKg  int
+}
+
+var (
+	sameCityRate = shippingRate{FirstKg: 10000, NextKg: 5000}
+	defaultRate  = shippingRate{FirstKg: 20000, NextKg: 8000}
+)
+
+// platformOriginCity is where items ship from when their seller has not
+// set up a shipping origin.
+const platformOriginCity = "Jakarta"
+
+// ShippingParcel is what goes out from one origin: each is weighed and
+// charged on its own. OriginID is nil for the platform origin, and
+// OriginLocation for origins the seller has not pinned.
+type ShippingParcel struct {
+	OriginID       *string    `json:"originId,omitempty"`
+	OriginCity     string     `json:"originCity"`
+	OriginLocation *geo.Point `json:"originLocation,omitempty"`
+	WeightGrams    int        `json:"weightGrams"`
+}
+
+// addToParcel adds grams to the parcel leaving from p's origin, starting
+// a new parcel for an origin not seen yet.
+func addToParcel(parcels []ShippingParcel, p *product.Product, grams int) []ShippingParcel {
+	var originID *string
+	var location *geo.Point
+	city := platformOriginCity
+	if p.ShipsFrom != nil {
+		originID = &p.ShipsFrom.OriginID
+		city = p.ShipsFrom.City
+		location = p.ShipsFrom.Location
+	}
+
+	for i := range parcels {
+		if sameOrigin(parcels[i].OriginID, originID) {
+			parcels[i].WeightGrams += grams
+			return parcels
+		}
+	}
+	return append(parcels, ShippingParcel{
+		OriginID:       originID,
+		OriginCity:     city,
+		OriginLocation: location,
+		WeightGrams:    grams,
+	})
+}
+
+func sameOrigin(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
+
+// parcelsWeight is the total chargeable weight of parcels.
+func parcelsWeight(parcels []ShippingParcel) int {
+	total := 0
+	for _, p := range parcels {
+		total += p.WeightGrams
+	}
+	return total
+}
+
+// chargeableWeightGrams is the weight a courier bills for quantity units
+// of a variant: the greater of the actual and the volumetric weight.
+// Variants without a shipping spec weigh nothing.
+func chargeableWeightGrams(spec *product.ShippingSpec, quantity int) int {
+	if spec == nil || quantity <= 0 {
+		return 0
+	}
+
+	actual := int(spec.WeightGrams)
+	// cm³ / 6000 kg = cm³ * 1000 / 6000 g
+	volumetric := int(spec.LengthCm) * int(spec.WidthCm) * int(spec.HeightCm) * 1000 / volumetricDivisor
+
+	if volumetric > actual {
+		return volumetric * quantity
+	}
+	return actual * quantity
+}
+
+// billableKg rounds a weight up to whole kilograms, with one kilogram as
+// the minimum couriers charge.
+func billableKg(grams int) int {
+	kg := (grams + 999) / 1000
+	if kg < 1 {
+		return 1
+	}
+	return kg
+}
+
+// parcelsJSON encodes parcels for the shipping_parcels column.
+func parcelsJSON(parcels []ShippingParcel) []byte {
+	if parcels == nil {
+		parcels = []ShippingParcel{}
+	}
+	b, _ := json.Marshal(parcels)
+	return b
+}
+
+// rateFor is the cheaper same-city rate when the parcel does not leave
+// its origin city, and the default rate otherwise.
+func rateFor(originCity string, addr *address.Address) shippingRate {
+	if strings.EqualFold(strings.TrimSpace(originCity), strings.TrimSpace(addr.City)) {
+		return sameCityRate
+	}
+	return defaultRate
+}
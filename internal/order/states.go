@@ -0,0 +1,156 @@
+package order
+
+import (
+	"context"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/statemachine"
+
+	"go.uber.org/zap"
+)
+
+// orderChange is what the order status hooks are handed.
+type orderChange struct {
+	svc        *service
+	orderID    uint
+	externalID string
+	userID     *int32
+	method     ShippingMethod
+	// byPayment is set when a payment outcome drives the change rather
+	// than an admin.
+	byPayment bool
+	// pickupVerified is set when the customer's pickup code was checked
+	// at the counter.
+	pickupVerified bool
+}
+
+// OrderStatuses is the order lifecycle. An admin may fail any order that
+// is still open; a failed payment only fails an order waiting for payment.
+// Self pickup orders wait READY_FOR_PICKUP instead of shipping and are
+// completed by checking their pickup code.
+var OrderStatuses = statemachine.New[OrderStatus, orderChange]("order",
+	OrderStatusPendingPayment,
+	OrderStatusPaid,
+	OrderStatusAccepted,
+	OrderStatusShipped,
+	OrderStatusReadyForPickup,
+	OrderStatusCompleted,
+	OrderStatusCancelled,
+	OrderStatusFailed,
+).
+	Initial(OrderStatusPendingPayment).
+	Permit(OrderStatusPendingPayment, OrderStatusPaid, OrderStatusCancelled).
+	Permit(OrderStatusPaid, OrderStatusAccepted, OrderStatusCancelled).
+	Permit(OrderStatusAccepted, OrderStatusShipped, OrderStatusReadyForPickup, OrderStatusCancelled).
+	Permit(OrderStatusShipped, OrderStatusCompleted).
+	Permit(OrderStatusReadyForPickup, OrderStatusCompleted, OrderStatusCancelled).
+	PermitFromAny(OrderStatusFailed).
+	Terminal(OrderStatusCompleted, OrderStatusCancelled, OrderStatusFailed).
+	Guard(OrderStatusShipped, requirePacked).
+	Guard(OrderStatusShipped, requireCourier).
+	Guard(OrderStatusReadyForPickup, requirePacked).
+	Guard(OrderStatusReadyForPickup, requireSelfPickup).
+	Guard(OrderStatusCompleted, requirePickupCode).
+	Guard(OrderStatusFailed, paymentFailsPendingOnly).
+	OnEnter(OrderStatusReadyForPickup, notifyReadyForPickup).
+	OnChange(invalidateWebhookOrder)
+
+// requirePacked lets an order ship only after it was packed.
+func requirePacked(ctx context.Context, c orderChange, _, _ OrderStatus) error {
+	packed, err := c.svc.repo.IsOrderPacked(ctx, c.orderID)
+	if err != nil {
+		return err
+	}
+	if !packed {
+		logger.FromCtx(ctx).Warn("order not packed yet", zap.Uint("order_id", c.orderID))
+		return ErrOrderNotPacked
+	}
+	return nil
+}
+
+// requireCourier keeps self pickup orders from shipping.
+func requireCourier(_ context.Context, c orderChange, _, _ OrderStatus) error {
+	if c.method == ShippingMethodSelfPickup {
+		return ErrPickupOrderShipped
+	}
+	return nil
+}
+
+// requireSelfPickup lets only self pickup orders wait for pickup.
+func requireSelfPickup(_ context.Context, c orderChange, _, _ OrderStatus) error {
+	if c.method != ShippingMethodSelfPickup {
+		return ErrNotPickupOrder
+	}
+	return nil
+}
+
+// requirePickupCode completes an order waiting for pickup only through
+// VerifyPickupCode.
+func requirePickupCode(_ context.Context, c orderChange, from, _ OrderStatus) error {
+	if from == OrderStatusReadyForPickup && !c.pickupVerified {
+		return ErrPickupCodeRequired
+	}
+	return nil
+}
+
+// paymentFailsPendingOnly ignores a late payment failure for an order that
+// was already paid.
+func paymentFailsPendingOnly(_ context.Context, c orderChange, from, to OrderStatus) error {
+	if c.byPayment && from != OrderStatusPendingPayment {
+		return &statemachine.TransitionError[OrderStatus]{From: from, To: to}
+	}
+	return nil
+}
+
+// notifyReadyForPickup tells the customer where and when to collect the
+// order.
+func notifyReadyForPickup(ctx context.Context, c orderChange, _, _ OrderStatus) {
+	log := logger.FromCtx(ctx).With(zap.Uint("order_id", c.orderID))
+	pickup, err := c.svc.repo.GetOrderPickup(ctx, c.orderID)
+	if err != nil || pickup == nil {
+		log.Error("failed to load pickup for notice", zap.Error(err))
+		return
+	}
+	d := c.svc.orderUpdateDelivery(ctx, c.userID)
+	if d.Muted() {
+		return
+	}
+	if err := c.svc.notifier.NotifyReadyForPickup(ctx, d, c.userID, c.externalID, pickup); err != nil {
+		log.Warn("failed to send ready for pickup notice", zap.Error(err))
+	}
+}
+
+func invalidateWebhookOrder(_ context.Context, c orderChange, _, _ OrderStatus) {
+	c.svc.webhookOrders.invalidate(c.externalID)
+}
+
+// SessionStatuses is the checkout session lifecycle. Every outcome is
+// final; only a PENDING session can still change.
+var SessionStatuses = statemachine.New[CheckoutSessionStatus, *CheckoutSession]("checkout session",
+	CheckoutSessionStatusPending,
+	CheckoutSessionStatusPaid,
+	CheckoutSessionStatusExpired,
+	CheckoutSessionStatusCanceled,
+	CheckoutSessionStatusSuperseded,
+).
+	Initial(CheckoutSessionStatusPending).
+	Permit(CheckoutSessionStatusPending,
+		CheckoutSessionStatusPaid,
+		CheckoutSessionStatusExpired,
+		CheckoutSessionStatusCanceled,
+		CheckoutSessionStatusSuperseded,
+	).
+	Terminal(
+		CheckoutSessionStatusPaid,
+		CheckoutSessionStatusExpired,
+		CheckoutSessionStatusCanceled,
+		CheckoutSessionStatusSuperseded,
+	)
+
+// sessionStatusAfterPayment is where a payment outcome moves the order's
+// session. A failed payment cancels it; sessions have no FAILED status. An
+// order cancelled because its payment expired expires the session.
+var sessionStatusAfterPayment = map[OrderStatus]CheckoutSessionStatus{
+	OrderStatusPaid:      CheckoutSessionStatusPaid,
+	OrderStatusFailed:    CheckoutSessionStatusCanceled,
+	OrderStatusCancelled: CheckoutSessionStatusExpired,
+}
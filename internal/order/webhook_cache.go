@@ -0,0 +1,90 @@
+package order
+
+import (
+	"sync"
+	"time"
+)
+
+// webhookOrderTTL bounds how long GetOrderForWebhook serves an order from
+// memory. Gateway retries arrive in bursts of a few seconds; anything the
+// cache gets wrong is caught again by MarkAsPaid/MarkAsFailed, which always
+// read the order from the database.
+const webhookOrderTTL = 30 * time.Second
+
+// webhookOrderCacheMax is the size at which expired entries are swept.
+const webhookOrderCacheMax = 1024
+
+type cachedOrder struct {
+	order     Order
+	expiresAt time.Time
+}
+
+// orderCache is a short-lived per-external-ID cache of orders. A nil
+// *orderCache is valid and caches nothing.
+type orderCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]cachedOrder
+	now     func() time.Time
+}
+
+func newOrderCache(ttl time.Duration) *orderCache {
+	return &orderCache{
+		ttl:     ttl,
+		entries: make(map[string]cachedOrder),
+		now:     time.Now,
+	}
+}
+
+// get returns a copy of the cached order, so callers cannot mutate the entry.
+func (c *orderCache) get(externalID string) (*Order, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[externalID]
+	if !ok {
+		return nil, false
+	}
+	if !c.now().Before(e.expiresAt) {
+		delete(c.entries, externalID)
+		return nil, false
+	}
+
+	o := e.order
+	return &o, true
+}
+
+func (c *orderCache) set(externalID string, order *Order) {
+	if c == nil || order == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := c.now()
+	if len(c.entries) >= webhookOrderCacheMax {
+		for id, e := range c.entries {
+			if !now.Before(e.expiresAt) {
+				delete(c.entries, id)
+			}
+		}
+	}
+
+	c.entries[externalID] = cachedOrder{order: *order, expiresAt: now.Add(c.ttl)}
+}
+
+func (c *orderCache) invalidate(externalID string) {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.entries, externalID)
+}
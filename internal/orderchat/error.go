@@ -0,0 +1,17 @@
+package orderchat
+
+import (
+	"errors"
+	"fmt"
+	"warimas-be/internal/apperr"
+)
+
+var (
+	ErrUnauthenticated    = apperr.Unauthenticated("unauthenticated")
+	ErrOrderNotFound      = apperr.NotFound("order not found")
+	ErrEmptyMessage       = errors.New("message needs a body or an attachment")
+	ErrBodyTooLong        = fmt.Errorf("message must be at most %d characters", maxBodyLen)
+	ErrTooManyAttachments = fmt.Errorf("a message can carry at most %d attachments", MaxAttachments)
+	ErrAttachmentNotFound = apperr.NotFound("attachment not found or already sent")
+	ErrDB                 = errors.New("database error")
+)
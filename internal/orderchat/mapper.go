@@ -0,0 +1,53 @@
+package orderchat
+
+import (
+	"strconv"
+	"warimas-be/internal/graph/model"
+	"warimas-be/internal/uploads"
+)
+
+func MapMessageToGraphQL(m *Message) *model.OrderMessage {
+	var senderID *string
+	if m.SenderID != nil {
+		id := strconv.Itoa(int(*m.SenderID))
+		senderID = &id
+	}
+
+	attachments := make([]*model.UploadedFile, 0, len(m.Attachments))
+	for _, u := range m.Attachments {
+		attachments = append(attachments, uploads.MapUploadToGraphQL(u))
+	}
+
+	return &model.OrderMessage{
+		ID:          strconv.FormatInt(m.ID, 10),
+		OrderID:     strconv.Itoa(int(m.OrderID)),
+		Sender:      model.OrderMessageSender(m.Sender),
+		SenderID:    senderID,
+		Body:        m.Body,
+		Attachments: attachments,
+		CreatedAt:   m.CreatedAt,
+	}
+}
+
+func MapThreadToGraphQL(t *Thread) *model.OrderMessageThread {
+	messages := make([]*model.OrderMessage, 0, len(t.Messages))
+	for _, m := range t.Messages {
+		messages = append(messages, MapMessageToGraphQL(m))
+	}
+
+	return &model.OrderMessageThread{
+		OrderID:     strconv.Itoa(int(t.OrderID)),
+		Messages:    messages,
+		UnreadCount: int32(t.UnreadCount),
+		HasMore:     t.HasMore,
+	}
+}
+
+func MapUnreadToGraphQL(t *UnreadThread) *model.OrderMessageUnread {
+	return &model.OrderMessageUnread{
+		OrderID:         strconv.Itoa(int(t.OrderID)),
+		OrderExternalID: t.OrderExternalID,
+		UnreadCount:     int32(t.UnreadCount),
+		LastMessageAt:   t.LastMessageAt,
+	}
+}
@@ -0,0 +1,53 @@
+package orderchat
+
+import (
+	"time"
+	"warimas-be/internal/uploads"
+)
+
+const (
+	defaultLimit = 50
+	maxLimit     = 100
+	maxBodyLen   = 2000
+
+	// MaxAttachments is how many files one message can carry.
+	MaxAttachments = 5
+)
+
+// Side is who wrote a message: the order's customer, or the staff, which
+// is any admin or seller.
+type Side string
+
+const (
+	SideCustomer Side = "CUSTOMER"
+	SideStaff    Side = "STAFF"
+)
+
+// Message is one message in an order's thread. SenderID is nil once the
+// sender's account is gone.
+type Message struct {
+	ID          int64
+	OrderID     int32
+	SenderID    *int32
+	Sender      Side
+	Body        string
+	Attachments []*uploads.Upload
+	CreatedAt   time.Time
+}
+
+// Thread is a page of an order's messages, oldest first. UnreadCount is
+// for the whole thread, not just the page.
+type Thread struct {
+	OrderID     int32
+	Messages    []*Message
+	UnreadCount int
+	HasMore     bool
+}
+
+// UnreadThread is an order with messages its reader has not read yet.
+type UnreadThread struct {
+	OrderID         int32
+	OrderExternalID string
+	UnreadCount     int
+	LastMessageAt   time.Time
+}
@@ -0,0 +1,38 @@
+package orderchat
+
+import (
+	"context"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/preference"
+
+	"go.uber.org/zap"
+)
+
+// Notifier tells the other side about a new message: the customer when
+// staff wrote, the staff inbox when the customer did. d is how the
+// customer wants to be told, and nil for the staff inbox. customerID is
+// nil for guest orders.
+type Notifier interface {
+	NotifyOrderMessage(ctx context.Context, d *preference.Delivery, m *Message, customerID *int32) error
+}
+
+// LogNotifier writes each message at info level until a push or email
+// provider is wired in.
+type LogNotifier struct{}
+
+func (LogNotifier) NotifyOrderMessage(ctx context.Context, d *preference.Delivery, m *Message, customerID *int32) error {
+	fields := []zap.Field{
+		zap.Int64("message_id", m.ID),
+		zap.Int32("order_id", m.OrderID),
+		zap.String("sender", string(m.Sender)),
+		zap.Int("attachments", len(m.Attachments)),
+	}
+	if d != nil {
+		fields = append(fields, zap.Any("channels", d.Channels), zap.String("locale", d.Locale))
+	}
+	if customerID != nil {
+		fields = append(fields, zap.Int32("customer_id", *customerID))
+	}
+	logger.FromCtx(ctx).Info("order message", fields...)
+	return nil
+}
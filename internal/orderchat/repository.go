@@ -0,0 +1,313 @@
+package orderchat
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/uploads"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+type Repository interface {
+	// OrderOwner returns the customer an order belongs to, nil for a guest
+	// order, or ErrOrderNotFound.
+	OrderOwner(ctx context.Context, orderID int32) (*int32, error)
+	// Create stores m with the given uploads and moves the sender's read
+	// marker up to it. The uploads must be order message uploads of the
+	// same order by the same sender, not sent before; otherwise nothing is
+	// stored and ErrAttachmentNotFound is returned.
+	Create(ctx context.Context, m *Message, attachmentIDs []string) (*Message, error)
+	// List returns up to limit messages before the message before, or the
+	// latest ones when before is nil, oldest first.
+	List(ctx context.Context, orderID int32, before *int64, limit int32) ([]*Message, error)
+	// MarkRead moves the reader's marker to the order's latest message.
+	MarkRead(ctx context.Context, orderID int32, reader Side) error
+	// UnreadCount counts the messages from the other side past the
+	// reader's marker.
+	UnreadCount(ctx context.Context, orderID int32, reader Side) (int, error)
+	// ListUnread returns the orders with unread messages for reader, the
+	// latest message first. customerID limits it to one customer's orders.
+	ListUnread(ctx context.Context, reader Side, customerID *int32, limit int32) ([]*UnreadThread, error)
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+func (s Side) other() Side {
+	if s == SideCustomer {
+		return SideStaff
+	}
+	return SideCustomer
+}
+
+func (r *repository) OrderOwner(ctx context.Context, orderID int32) (*int32, error) {
+	var userID *int32
+	err := r.db.QueryRowContext(ctx, `SELECT user_id FROM orders WHERE id = $1`, orderID).Scan(&userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrOrderNotFound
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to get order owner", zap.Error(err))
+		return nil, ErrDB
+	}
+	return userID, nil
+}
+
+func (r *repository) Create(ctx context.Context, m *Message, attachmentIDs []string) (*Message, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "Create"),
+		zap.Int32("order_id", m.OrderID),
+	)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error("failed to begin transaction", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer tx.Rollback()
+
+	out := *m
+	if err := tx.QueryRowContext(ctx, `
+		INSERT INTO order_messages (order_id, sender_id, sender_role, body)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at
+	`, m.OrderID, m.SenderID, m.Sender, m.Body).Scan(&out.ID, &out.CreatedAt); err != nil {
+		log.Error("failed to insert message", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	out.Attachments = []*uploads.Upload{}
+	if len(attachmentIDs) > 0 {
+		res, err := tx.ExecContext(ctx, `
+			INSERT INTO order_message_attachments (message_id, upload_id)
+			SELECT $1, u.id
+			FROM uploads u
+			WHERE u.id = ANY($2::uuid[])
+			  AND u.purpose = $3
+			  AND u.order_id = $4
+			  AND u.uploaded_by = $5
+			  AND NOT EXISTS (
+				SELECT 1 FROM order_message_attachments a WHERE a.upload_id = u.id
+			  )
+		`, out.ID, pq.Array(attachmentIDs), uploads.PurposeOrderMessage, m.OrderID, m.SenderID)
+		if err != nil {
+			log.Error("failed to attach uploads", zap.Error(err))
+			return nil, ErrDB
+		}
+		if n, _ := res.RowsAffected(); int(n) != len(attachmentIDs) {
+			return nil, ErrAttachmentNotFound
+		}
+
+		byMessage, err := listAttachments(ctx, tx, []int64{out.ID})
+		if err != nil {
+			log.Error("failed to load attachments", zap.Error(err))
+			return nil, ErrDB
+		}
+		out.Attachments = byMessage[out.ID]
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO order_message_reads (order_id, reader_role, last_read_id)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (order_id, reader_role) DO UPDATE
+		SET last_read_id = GREATEST(order_message_reads.last_read_id, EXCLUDED.last_read_id),
+		    updated_at = NOW()
+	`, m.OrderID, m.Sender, out.ID); err != nil {
+		log.Error("failed to move read marker", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Error("failed to commit message", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	log.Info("order message sent", zap.Int64("message_id", out.ID))
+	return &out, nil
+}
+
+func (r *repository) List(ctx context.Context, orderID int32, before *int64, limit int32) ([]*Message, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "List"),
+		zap.Int32("order_id", orderID),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, order_id, sender_id, sender_role, body, created_at
+		FROM order_messages
+		WHERE order_id = $1
+		  AND ($2::bigint IS NULL OR id < $2)
+		ORDER BY id DESC
+		LIMIT $3
+	`, orderID, before, limit)
+	if err != nil {
+		log.Error("failed to query messages", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	messages := []*Message{}
+	ids := []int64{}
+	for rows.Next() {
+		var m Message
+		if err := rows.Scan(&m.ID, &m.OrderID, &m.SenderID, &m.Sender, &m.Body, &m.CreatedAt); err != nil {
+			log.Error("failed to scan message", zap.Error(err))
+			return nil, ErrDB
+		}
+		messages = append(messages, &m)
+		ids = append(ids, m.ID)
+	}
+	if err := rows.Err(); err != nil {
+		log.Error("failed to iterate messages", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	byMessage, err := listAttachments(ctx, r.db, ids)
+	if err != nil {
+		log.Error("failed to load attachments", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	// Newest were read first; the thread reads oldest first.
+	out := make([]*Message, 0, len(messages))
+	for i := len(messages) - 1; i >= 0; i-- {
+		m := messages[i]
+		m.Attachments = byMessage[m.ID]
+		if m.Attachments == nil {
+			m.Attachments = []*uploads.Upload{}
+		}
+		out = append(out, m)
+	}
+	return out, nil
+}
+
+type querier interface {
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+}
+
+// listAttachments returns the uploads of the messages, by message id.
+func listAttachments(ctx context.Context, q querier, messageIDs []int64) (map[int64][]*uploads.Upload, error) {
+	out := map[int64][]*uploads.Upload{}
+	if len(messageIDs) == 0 {
+		return out, nil
+	}
+
+	rows, err := q.QueryContext(ctx, `
+		SELECT a.message_id, u.id, u.purpose, u.storage_key, u.content_type,
+		       u.size_bytes, u.original_name, u.uploaded_by, u.order_id, u.created_at
+		FROM order_message_attachments a
+		JOIN uploads u ON u.id = a.upload_id
+		WHERE a.message_id = ANY($1)
+		ORDER BY u.created_at
+	`, pq.Array(messageIDs))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var (
+			messageID int64
+			u         uploads.Upload
+		)
+		if err := rows.Scan(&messageID, &u.ID, &u.Purpose, &u.StorageKey, &u.ContentType,
+			&u.Size, &u.OriginalName, &u.UploadedBy, &u.OrderID, &u.CreatedAt); err != nil {
+			return nil, err
+		}
+		out[messageID] = append(out[messageID], &u)
+	}
+	return out, rows.Err()
+}
+
+func (r *repository) MarkRead(ctx context.Context, orderID int32, reader Side) error {
+	if _, err := r.db.ExecContext(ctx, `
+		INSERT INTO order_message_reads (order_id, reader_role, last_read_id)
+		SELECT $1, $2, MAX(id)
+		FROM order_messages
+		WHERE order_id = $1
+		HAVING MAX(id) IS NOT NULL
+		ON CONFLICT (order_id, reader_role) DO UPDATE
+		SET last_read_id = GREATEST(order_message_reads.last_read_id, EXCLUDED.last_read_id),
+		    updated_at = NOW()
+	`, orderID, reader); err != nil {
+		logger.FromCtx(ctx).Error("failed to mark messages read",
+			zap.Int32("order_id", orderID),
+			zap.Error(err),
+		)
+		return ErrDB
+	}
+	return nil
+}
+
+func (r *repository) UnreadCount(ctx context.Context, orderID int32, reader Side) (int, error) {
+	var n int
+	err := r.db.QueryRowContext(ctx, `
+		SELECT COUNT(*)
+		FROM order_messages m
+		LEFT JOIN order_message_reads rd
+			ON rd.order_id = m.order_id AND rd.reader_role = $2
+		WHERE m.order_id = $1
+		  AND m.sender_role = $3
+		  AND m.id > COALESCE(rd.last_read_id, 0)
+	`, orderID, reader, reader.other()).Scan(&n)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to count unread messages",
+			zap.Int32("order_id", orderID),
+			zap.Error(err),
+		)
+		return 0, ErrDB
+	}
+	return n, nil
+}
+
+func (r *repository) ListUnread(ctx context.Context, reader Side, customerID *int32, limit int32) ([]*UnreadThread, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ListUnread"),
+		zap.String("reader", string(reader)),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT o.id, o.external_id, COUNT(*), MAX(m.created_at)
+		FROM order_messages m
+		JOIN orders o ON o.id = m.order_id
+		LEFT JOIN order_message_reads rd
+			ON rd.order_id = m.order_id AND rd.reader_role = $1
+		WHERE m.sender_role = $2
+		  AND m.id > COALESCE(rd.last_read_id, 0)
+		  AND ($3::int IS NULL OR o.user_id = $3)
+		GROUP BY o.id, o.external_id
+		ORDER BY MAX(m.created_at) DESC
+		LIMIT $4
+	`, reader, reader.other(), customerID, limit)
+	if err != nil {
+		log.Error("failed to query unread threads", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	threads := []*UnreadThread{}
+	for rows.Next() {
+		var t UnreadThread
+		if err := rows.Scan(&t.OrderID, &t.OrderExternalID, &t.UnreadCount, &t.LastMessageAt); err != nil {
+			log.Error("failed to scan unread thread", zap.Error(err))
+			return nil, ErrDB
+		}
+		threads = append(threads, &t)
+	}
+	if err := rows.Err(); err != nil {
+		log.Error("failed to iterate unread threads", zap.Error(err))
+		return nil, ErrDB
+	}
+	return threads, nil
+}
@@ -0,0 +1,166 @@
+package orderchat
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_OrderOwner(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+
+	mock.ExpectQuery(`SELECT user_id FROM orders WHERE id = \$1`).
+		WithArgs(int32(10)).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(7))
+
+	owner, err := repo.OrderOwner(ctx, 10)
+	assert.NoError(t, err)
+	assert.Equal(t, int32(7), *owner)
+
+	mock.ExpectQuery(`SELECT user_id FROM orders`).
+		WithArgs(int32(11)).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}))
+
+	_, err = repo.OrderOwner(ctx, 11)
+	assert.ErrorIs(t, err, ErrOrderNotFound)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+	now := time.Now()
+	sender := int32(7)
+
+	t.Run("WithAttachment", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO order_messages`).
+			WithArgs(int32(10), &sender, SideCustomer, "Halo").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(int64(5), now))
+		mock.ExpectExec(`INSERT INTO order_message_attachments`).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(`FROM order_message_attachments a JOIN uploads u`).
+			WillReturnRows(sqlmock.NewRows([]string{
+				"message_id", "id", "purpose", "storage_key", "content_type",
+				"size_bytes", "original_name", "uploaded_by", "order_id", "created_at",
+			}).AddRow(int64(5), attachmentID, "ORDER_MESSAGE", "order_message/a.png", "image/png",
+				int64(10), "a.png", 7, 10, now))
+		mock.ExpectExec(`INSERT INTO order_message_reads`).
+			WithArgs(int32(10), SideCustomer, int64(5)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		m, err := repo.Create(ctx, &Message{OrderID: 10, SenderID: &sender, Sender: SideCustomer, Body: "Halo"}, []string{attachmentID})
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), m.ID)
+		assert.Len(t, m.Attachments, 1)
+	})
+
+	t.Run("AttachmentNotUsable", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO order_messages`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(int64(6), now))
+		mock.ExpectExec(`INSERT INTO order_message_attachments`).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		_, err := repo.Create(ctx, &Message{OrderID: 10, SenderID: &sender, Sender: SideCustomer}, []string{attachmentID})
+		assert.ErrorIs(t, err, ErrAttachmentNotFound)
+	})
+
+	t.Run("DBError", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO order_messages`).WillReturnError(errors.New("boom"))
+		mock.ExpectRollback()
+
+		_, err := repo.Create(ctx, &Message{OrderID: 10, SenderID: &sender, Sender: SideCustomer, Body: "Halo"}, nil)
+		assert.ErrorIs(t, err, ErrDB)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_List(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+	now := time.Now()
+
+	mock.ExpectQuery(`FROM order_messages WHERE order_id = \$1 .* ORDER BY id DESC LIMIT \$3`).
+		WithArgs(int32(10), nil, int32(20)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "order_id", "sender_id", "sender_role", "body", "created_at"}).
+			AddRow(int64(9), 10, 1, "STAFF", "Besok", now).
+			AddRow(int64(8), 10, 7, "CUSTOMER", "Kapan?", now))
+	mock.ExpectQuery(`FROM order_message_attachments a`).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"message_id", "id", "purpose", "storage_key", "content_type",
+			"size_bytes", "original_name", "uploaded_by", "order_id", "created_at",
+		}))
+
+	messages, err := repo.List(ctx, 10, nil, 20)
+	assert.NoError(t, err)
+	require.Len(t, messages, 2)
+	assert.Equal(t, int64(8), messages[0].ID)
+	assert.Equal(t, SideStaff, messages[1].Sender)
+	assert.NotNil(t, messages[0].Attachments)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_UnreadCount(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	mock.ExpectQuery(`SELECT COUNT\(\*\) FROM order_messages m`).
+		WithArgs(int32(10), SideCustomer, SideStaff).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+	n, err := repo.UnreadCount(context.Background(), 10, SideCustomer)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, n)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ListUnread(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	now := time.Now()
+
+	mock.ExpectQuery(`GROUP BY o.id, o.external_id`).
+		WithArgs(SideStaff, SideCustomer, nil, int32(50)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "external_id", "count", "max"}).
+			AddRow(10, "ORD-10", 2, now))
+
+	threads, err := repo.ListUnread(context.Background(), SideStaff, nil, 50)
+	assert.NoError(t, err)
+	require.Len(t, threads, 1)
+	assert.Equal(t, "ORD-10", threads[0].OrderExternalID)
+	assert.Equal(t, 2, threads[0].UnreadCount)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
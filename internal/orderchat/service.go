@@ -0,0 +1,219 @@
+package orderchat
+
+import (
+	"context"
+	"strings"
+	"unicode/utf8"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/preference"
+	"warimas-be/internal/uploads"
+	"warimas-be/internal/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Service runs the message thread of each order. The order's customer
+// writes as CUSTOMER and any admin or seller as STAFF; anyone else is told
+// the order does not exist.
+type Service interface {
+	// Send posts a message with attachments uploaded beforehand through
+	// uploadOrderMessageAttachment, and notifies the other side.
+	Send(ctx context.Context, orderID int32, body string, attachmentIDs []string) (*Message, error)
+	// Thread returns a page of the order's messages. It does not mark
+	// anything read.
+	Thread(ctx context.Context, orderID int32, before *int64, limit int32) (*Thread, error)
+	// MarkRead marks every message of the order read for the caller's side.
+	MarkRead(ctx context.Context, orderID int32) error
+	// Unread lists the orders with messages the caller's side has not read.
+	Unread(ctx context.Context, limit int32) ([]*UnreadThread, error)
+}
+
+// PreferenceGateway says how a customer wants staff replies sent.
+type PreferenceGateway interface {
+	DeliveryFor(ctx context.Context, userID int32, event preference.Event) *preference.Delivery
+}
+
+type service struct {
+	repo     Repository
+	storage  uploads.Storage
+	prefs    PreferenceGateway
+	notifier Notifier
+}
+
+func NewService(repo Repository, storage uploads.Storage, prefs PreferenceGateway, notifier Notifier) Service {
+	return &service{repo: repo, storage: storage, prefs: prefs, notifier: notifier}
+}
+
+func (s *service) Send(ctx context.Context, orderID int32, body string, attachmentIDs []string) (*Message, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "Send"),
+		zap.Int32("order_id", orderID),
+	)
+
+	userID, side, customerID, err := s.participant(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	body = strings.TrimSpace(body)
+	ids, err := uniqueIDs(attachmentIDs)
+	if err != nil {
+		return nil, err
+	}
+	if body == "" && len(ids) == 0 {
+		return nil, ErrEmptyMessage
+	}
+	if utf8.RuneCountInString(body) > maxBodyLen {
+		return nil, ErrBodyTooLong
+	}
+	if len(ids) > MaxAttachments {
+		return nil, ErrTooManyAttachments
+	}
+
+	m, err := s.repo.Create(ctx, &Message{
+		OrderID:  orderID,
+		SenderID: &userID,
+		Sender:   side,
+		Body:     body,
+	}, ids)
+	if err != nil {
+		return nil, err
+	}
+	s.withURLs(m)
+
+	// Staff replies reach the customer as their preferences say; guest
+	// orders get the defaults. The staff inbox is always told.
+	var d *preference.Delivery
+	if side == SideStaff {
+		d = preference.DefaultDelivery(preference.EventOrderMessages)
+		if customerID != nil {
+			d = s.prefs.DeliveryFor(ctx, *customerID, preference.EventOrderMessages)
+		}
+	}
+
+	// The message is stored; a failed notification must not fail the send.
+	if d == nil || !d.Muted() {
+		if err := s.notifier.NotifyOrderMessage(ctx, d, m, customerID); err != nil {
+			log.Error("failed to notify about order message", zap.Int64("message_id", m.ID), zap.Error(err))
+		}
+	}
+
+	return m, nil
+}
+
+func (s *service) Thread(ctx context.Context, orderID int32, before *int64, limit int32) (*Thread, error) {
+	_, side, _, err := s.participant(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+
+	// One extra message tells whether there is an earlier page.
+	messages, err := s.repo.List(ctx, orderID, before, limit+1)
+	if err != nil {
+		return nil, err
+	}
+	hasMore := len(messages) > int(limit)
+	if hasMore {
+		messages = messages[1:]
+	}
+	for _, m := range messages {
+		s.withURLs(m)
+	}
+
+	unread, err := s.repo.UnreadCount(ctx, orderID, side)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Thread{
+		OrderID:     orderID,
+		Messages:    messages,
+		UnreadCount: unread,
+		HasMore:     hasMore,
+	}, nil
+}
+
+func (s *service) MarkRead(ctx context.Context, orderID int32) error {
+	_, side, _, err := s.participant(ctx, orderID)
+	if err != nil {
+		return err
+	}
+	return s.repo.MarkRead(ctx, orderID, side)
+}
+
+func (s *service) Unread(ctx context.Context, limit int32) ([]*UnreadThread, error) {
+	id, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+
+	if utils.IsAdmin(ctx) {
+		return s.repo.ListUnread(ctx, SideStaff, nil, limit)
+	}
+	userID := int32(id)
+	return s.repo.ListUnread(ctx, SideCustomer, &userID, limit)
+}
+
+// participant returns the caller and the side they write on, with the
+// order's customer. Staff may use any thread, a customer only their own;
+// someone else's order looks the same as a missing one.
+func (s *service) participant(ctx context.Context, orderID int32) (int32, Side, *int32, error) {
+	id, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		return 0, "", nil, ErrUnauthenticated
+	}
+	userID := int32(id)
+
+	owner, err := s.repo.OrderOwner(ctx, orderID)
+	if err != nil {
+		return 0, "", nil, err
+	}
+
+	if utils.IsAdmin(ctx) {
+		return userID, SideStaff, owner, nil
+	}
+	if owner == nil || *owner != userID {
+		return 0, "", nil, ErrOrderNotFound
+	}
+	return userID, SideCustomer, owner, nil
+}
+
+func (s *service) withURLs(m *Message) {
+	for _, u := range m.Attachments {
+		u.URL = s.storage.URL(u.StorageKey)
+	}
+}
+
+// uniqueIDs drops repeated attachment ids. An id that is not a UUID can
+// not name an upload.
+func uniqueIDs(ids []string) ([]string, error) {
+	out := make([]string, 0, len(ids))
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		u, err := uuid.Parse(id)
+		if err != nil {
+			return nil, ErrAttachmentNotFound
+		}
+		if key := u.String(); !seen[key] {
+			seen[key] = true
+			out = append(out, key)
+		}
+	}
+	return out, nil
+}
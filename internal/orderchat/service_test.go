@@ -0,0 +1,298 @@
+package orderchat
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"warimas-be/internal/preference"
+	"warimas-be/internal/uploads"
+	"warimas-be/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// --- Mocks ---
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) OrderOwner(ctx context.Context, orderID int32) (*int32, error) {
+	args := m.Called(ctx, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*int32), args.Error(1)
+}
+
+func (m *MockRepository) Create(ctx context.Context, msg *Message, attachmentIDs []string) (*Message, error) {
+	args := m.Called(ctx, msg, attachmentIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Message), args.Error(1)
+}
+
+func (m *MockRepository) List(ctx context.Context, orderID int32, before *int64, limit int32) ([]*Message, error) {
+	args := m.Called(ctx, orderID, before, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Message), args.Error(1)
+}
+
+func (m *MockRepository) MarkRead(ctx context.Context, orderID int32, reader Side) error {
+	args := m.Called(ctx, orderID, reader)
+	return args.Error(0)
+}
+
+func (m *MockRepository) UnreadCount(ctx context.Context, orderID int32, reader Side) (int, error) {
+	args := m.Called(ctx, orderID, reader)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockRepository) ListUnread(ctx context.Context, reader Side, customerID *int32, limit int32) ([]*UnreadThread, error) {
+	args := m.Called(ctx, reader, customerID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*UnreadThread), args.Error(1)
+}
+
+type MockStorage struct {
+	mock.Mock
+}
+
+func (m *MockStorage) Put(ctx context.Context, key string, content io.Reader) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
+
+func (m *MockStorage) Delete(ctx context.Context, key string) error {
+	args := m.Called(ctx, key)
+	return args.Error(0)
+}
+
+func (m *MockStorage) URL(key string) string {
+	return "https://cdn.test/" + key
+}
+
+type MockNotifier struct {
+	mock.Mock
+}
+
+func (m *MockNotifier) NotifyOrderMessage(ctx context.Context, d *preference.Delivery, msg *Message, customerID *int32) error {
+	args := m.Called(ctx, d, msg, customerID)
+	return args.Error(0)
+}
+
+type MockPreferences struct {
+	mock.Mock
+}
+
+func (m *MockPreferences) DeliveryFor(ctx context.Context, userID int32, event preference.Event) *preference.Delivery {
+	return m.Called(ctx, userID, event).Get(0).(*preference.Delivery)
+}
+
+const attachmentID = "3f1c2d4e-5a6b-4c7d-8e9f-0a1b2c3d4e5f"
+
+func int32Ptr(v int32) *int32 { return &v }
+
+func TestService_Send(t *testing.T) {
+	customerCtx := utils.SetUserContext(context.Background(), 7, "buyer@test.com", "USER")
+	adminCtx := utils.SetUserContext(context.Background(), 1, "admin@test.com", "ADMIN")
+
+	t.Run("CustomerSends", func(t *testing.T) {
+		repo, notifier := new(MockRepository), new(MockNotifier)
+		s := NewService(repo, new(MockStorage), new(MockPreferences), notifier)
+
+		repo.On("OrderOwner", customerCtx, int32(10)).Return(int32Ptr(7), nil)
+		repo.On("Create", customerCtx, mock.MatchedBy(func(m *Message) bool {
+			return m.Sender == SideCustomer && *m.SenderID == 7 && m.Body == "Kapan dikirim?"
+		}), []string{attachmentID}).Return(&Message{
+			ID: 1, OrderID: 10, Sender: SideCustomer, Body: "Kapan dikirim?",
+			Attachments: []*uploads.Upload{{ID: attachmentID, StorageKey: "order_message/a.png"}},
+		}, nil)
+		notifier.On("NotifyOrderMessage", customerCtx, (*preference.Delivery)(nil), mock.Anything, int32Ptr(7)).Return(nil)
+
+		m, err := s.Send(customerCtx, 10, "  Kapan dikirim? ", []string{attachmentID, strings.ToUpper(attachmentID)})
+		assert.NoError(t, err)
+		assert.Equal(t, "https://cdn.test/order_message/a.png", m.Attachments[0].URL)
+		repo.AssertExpectations(t)
+		notifier.AssertExpectations(t)
+	})
+
+	t.Run("StaffSends", func(t *testing.T) {
+		repo, prefs, notifier := new(MockRepository), new(MockPreferences), new(MockNotifier)
+		s := NewService(repo, new(MockStorage), prefs, notifier)
+
+		push := &preference.Delivery{Locale: "id", Channels: []preference.Channel{preference.ChannelPush}}
+		repo.On("OrderOwner", adminCtx, int32(10)).Return(int32Ptr(7), nil)
+		repo.On("Create", adminCtx, mock.MatchedBy(func(m *Message) bool {
+			return m.Sender == SideStaff
+		}), []string{}).Return(&Message{ID: 2, OrderID: 10, Sender: SideStaff, Body: "Besok"}, nil)
+		prefs.On("DeliveryFor", adminCtx, int32(7), preference.EventOrderMessages).Return(push)
+		notifier.On("NotifyOrderMessage", adminCtx, push, mock.Anything, int32Ptr(7)).Return(nil)
+
+		_, err := s.Send(adminCtx, 10, "Besok", nil)
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+		notifier.AssertExpectations(t)
+	})
+
+	t.Run("StaffReplyMuted", func(t *testing.T) {
+		repo, prefs, notifier := new(MockRepository), new(MockPreferences), new(MockNotifier)
+		s := NewService(repo, new(MockStorage), prefs, notifier)
+
+		repo.On("OrderOwner", adminCtx, int32(10)).Return(int32Ptr(7), nil)
+		repo.On("Create", adminCtx, mock.Anything, []string{}).Return(&Message{ID: 2, OrderID: 10, Sender: SideStaff}, nil)
+		prefs.On("DeliveryFor", adminCtx, int32(7), preference.EventOrderMessages).Return(&preference.Delivery{Locale: "id"})
+
+		_, err := s.Send(adminCtx, 10, "Besok", nil)
+		assert.NoError(t, err)
+		notifier.AssertNotCalled(t, "NotifyOrderMessage", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("NotifierFailureDoesNotFailSend", func(t *testing.T) {
+		repo, notifier := new(MockRepository), new(MockNotifier)
+		s := NewService(repo, new(MockStorage), new(MockPreferences), notifier)
+
+		repo.On("OrderOwner", customerCtx, int32(10)).Return(int32Ptr(7), nil)
+		repo.On("Create", customerCtx, mock.Anything, []string{}).Return(&Message{ID: 3}, nil)
+		notifier.On("NotifyOrderMessage", customerCtx, mock.Anything, mock.Anything, int32Ptr(7)).Return(errors.New("down"))
+
+		_, err := s.Send(customerCtx, 10, "Halo", nil)
+		assert.NoError(t, err)
+	})
+
+	t.Run("OtherCustomersOrder", func(t *testing.T) {
+		repo := new(MockRepository)
+		s := NewService(repo, new(MockStorage), new(MockPreferences), new(MockNotifier))
+
+		repo.On("OrderOwner", customerCtx, int32(10)).Return(int32Ptr(8), nil)
+
+		_, err := s.Send(customerCtx, 10, "Halo", nil)
+		assert.ErrorIs(t, err, ErrOrderNotFound)
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		s := NewService(new(MockRepository), new(MockStorage), new(MockPreferences), new(MockNotifier))
+
+		_, err := s.Send(context.Background(), 10, "Halo", nil)
+		assert.ErrorIs(t, err, ErrUnauthenticated)
+	})
+
+	t.Run("Validation", func(t *testing.T) {
+		repo := new(MockRepository)
+		s := NewService(repo, new(MockStorage), new(MockPreferences), new(MockNotifier))
+		repo.On("OrderOwner", customerCtx, int32(10)).Return(int32Ptr(7), nil)
+
+		_, err := s.Send(customerCtx, 10, "   ", nil)
+		assert.ErrorIs(t, err, ErrEmptyMessage)
+
+		_, err = s.Send(customerCtx, 10, strings.Repeat("a", maxBodyLen+1), nil)
+		assert.ErrorIs(t, err, ErrBodyTooLong)
+
+		_, err = s.Send(customerCtx, 10, "Halo", []string{"not-a-uuid"})
+		assert.ErrorIs(t, err, ErrAttachmentNotFound)
+
+		ids := []string{
+			"00000000-0000-4000-8000-000000000001", "00000000-0000-4000-8000-000000000002",
+			"00000000-0000-4000-8000-000000000003", "00000000-0000-4000-8000-000000000004",
+			"00000000-0000-4000-8000-000000000005", "00000000-0000-4000-8000-000000000006",
+		}
+		_, err = s.Send(customerCtx, 10, "Halo", ids)
+		assert.ErrorIs(t, err, ErrTooManyAttachments)
+
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestService_Thread(t *testing.T) {
+	ctx := utils.SetUserContext(context.Background(), 7, "buyer@test.com", "USER")
+
+	t.Run("HasMore", func(t *testing.T) {
+		repo := new(MockRepository)
+		s := NewService(repo, new(MockStorage), new(MockPreferences), new(MockNotifier))
+
+		repo.On("OrderOwner", ctx, int32(10)).Return(int32Ptr(7), nil)
+		repo.On("List", ctx, int32(10), (*int64)(nil), int32(3)).
+			Return([]*Message{{ID: 1}, {ID: 2}, {ID: 3}}, nil)
+		repo.On("UnreadCount", ctx, int32(10), SideCustomer).Return(2, nil)
+
+		thread, err := s.Thread(ctx, 10, nil, 2)
+		assert.NoError(t, err)
+		assert.True(t, thread.HasMore)
+		assert.Len(t, thread.Messages, 2)
+		assert.Equal(t, int64(2), thread.Messages[0].ID)
+		assert.Equal(t, 2, thread.UnreadCount)
+	})
+
+	t.Run("DefaultLimit", func(t *testing.T) {
+		repo := new(MockRepository)
+		s := NewService(repo, new(MockStorage), new(MockPreferences), new(MockNotifier))
+
+		repo.On("OrderOwner", ctx, int32(10)).Return(int32Ptr(7), nil)
+		repo.On("List", ctx, int32(10), (*int64)(nil), int32(defaultLimit+1)).Return([]*Message{}, nil)
+		repo.On("UnreadCount", ctx, int32(10), SideCustomer).Return(0, nil)
+
+		thread, err := s.Thread(ctx, 10, nil, 0)
+		assert.NoError(t, err)
+		assert.False(t, thread.HasMore)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("OrderNotFound", func(t *testing.T) {
+		repo := new(MockRepository)
+		s := NewService(repo, new(MockStorage), new(MockPreferences), new(MockNotifier))
+
+		repo.On("OrderOwner", ctx, int32(99)).Return(nil, ErrOrderNotFound)
+
+		_, err := s.Thread(ctx, 99, nil, 10)
+		assert.ErrorIs(t, err, ErrOrderNotFound)
+	})
+}
+
+func TestService_MarkRead(t *testing.T) {
+	ctx := utils.SetUserContext(context.Background(), 1, "admin@test.com", "ADMIN")
+	repo := new(MockRepository)
+	s := NewService(repo, new(MockStorage), new(MockPreferences), new(MockNotifier))
+
+	repo.On("OrderOwner", ctx, int32(10)).Return(int32Ptr(7), nil)
+	repo.On("MarkRead", ctx, int32(10), SideStaff).Return(nil)
+
+	assert.NoError(t, s.MarkRead(ctx, 10))
+	repo.AssertExpectations(t)
+}
+
+func TestService_Unread(t *testing.T) {
+	t.Run("Staff", func(t *testing.T) {
+		ctx := utils.SetUserContext(context.Background(), 1, "admin@test.com", "ADMIN")
+		repo := new(MockRepository)
+		s := NewService(repo, new(MockStorage), new(MockPreferences), new(MockNotifier))
+
+		repo.On("ListUnread", ctx, SideStaff, (*int32)(nil), int32(maxLimit)).
+			Return([]*UnreadThread{{OrderID: 10, UnreadCount: 1}}, nil)
+
+		threads, err := s.Unread(ctx, 500)
+		assert.NoError(t, err)
+		assert.Len(t, threads, 1)
+	})
+
+	t.Run("Customer", func(t *testing.T) {
+		ctx := utils.SetUserContext(context.Background(), 7, "buyer@test.com", "USER")
+		repo := new(MockRepository)
+		s := NewService(repo, new(MockStorage), new(MockPreferences), new(MockNotifier))
+
+		repo.On("ListUnread", ctx, SideCustomer, int32Ptr(7), int32(defaultLimit)).
+			Return([]*UnreadThread{}, nil)
+
+		_, err := s.Unread(ctx, 0)
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+}
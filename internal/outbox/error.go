@@ -0,0 +1,5 @@
+package outbox
+
+import "errors"
+
+var ErrDB = errors.New("database error")
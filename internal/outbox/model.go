@@ -0,0 +1,70 @@
+package outbox
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// RelayInterval is how often pending events are published.
+const RelayInterval = 5 * time.Second
+
+// PurgeInterval is how often published events past PublishedRetention are
+// deleted.
+const PurgeInterval = time.Hour
+
+// PublishedRetention keeps published events around for replaying to a
+// consumer that missed them.
+const PublishedRetention = 7 * 24 * time.Hour
+
+// SchemaVersion is the version of the envelope and payloads. It is part of
+// the topic name, so a breaking change goes to new topics and consumers
+// move over when ready.
+const SchemaVersion = 1
+
+const relayBatchSize = 500
+
+// Aggregate types; each has its own topic.
+const (
+	AggregateOrder     = "order"
+	AggregatePayment   = "payment"
+	AggregateInventory = "inventory"
+)
+
+// Event is a row of outbox_events, written by the triggers on orders,
+// payments and variant_stocks.
+type Event struct {
+	ID            int64
+	AggregateType string
+	AggregateID   string
+	EventType     string
+	Payload       json.RawMessage
+	OccurredAt    time.Time
+	Attempts      int
+}
+
+// Message is an event ready for the broker. Key is the aggregate ID, so
+// the events of one order, payment or variant keep their order.
+type Message struct {
+	Topic string
+	Key   []byte
+	Value []byte
+}
+
+// Envelope is the JSON published for every event. ID is unique and
+// increasing; delivery is at least once, so consumers drop IDs they have
+// already seen.
+type Envelope struct {
+	ID            int64           `json:"id"`
+	Type          string          `json:"type"`
+	Version       int             `json:"version"`
+	AggregateType string          `json:"aggregateType"`
+	AggregateID   string          `json:"aggregateId"`
+	OccurredAt    time.Time       `json:"occurredAt"`
+	Data          json.RawMessage `json:"data"`
+}
+
+// Topic names the topic of an aggregate type, e.g. "warimas.order.v1".
+func Topic(prefix, aggregateType string) string {
+	return fmt.Sprintf("%s.%s.v%d", prefix, aggregateType, SchemaVersion)
+}
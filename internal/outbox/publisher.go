@@ -0,0 +1,62 @@
+package outbox
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"warimas-be/internal/logger"
+
+	"github.com/segmentio/kafka-go"
+	"go.uber.org/zap"
+)
+
+// Publisher delivers messages to the broker. The relay marks events as
+// published only after Publish succeeds, so a failed batch is sent again
+// on the next run.
+type Publisher interface {
+	Publish(ctx context.Context, msgs []Message) error
+}
+
+// LogPublisher writes each message as a debug entry instead of sending it;
+// it stands in when no broker is configured.
+type LogPublisher struct{}
+
+func (LogPublisher) Publish(ctx context.Context, msgs []Message) error {
+	log := logger.FromCtx(ctx)
+	for _, m := range msgs {
+		log.Debug("domain event",
+			zap.String("topic", m.Topic),
+			zap.ByteString("key", m.Key),
+			zap.ByteString("value", m.Value),
+		)
+	}
+	return nil
+}
+
+// KafkaPublisher sends messages to Kafka. Messages are partitioned by key
+// and a batch is acknowledged only once all in-sync replicas have it.
+type KafkaPublisher struct {
+	w *kafka.Writer
+}
+
+// NewKafkaPublisher connects to the comma separated broker addresses.
+// Topics are expected to exist; they are not created on first use.
+func NewKafkaPublisher(brokers string) *KafkaPublisher {
+	return &KafkaPublisher{w: &kafka.Writer{
+		Addr:         kafka.TCP(strings.Split(brokers, ",")...),
+		Balancer:     &kafka.Hash{},
+		RequiredAcks: kafka.RequireAll,
+		BatchSize:    relayBatchSize,
+		BatchTimeout: 10 * time.Millisecond,
+		WriteTimeout: 10 * time.Second,
+	}}
+}
+
+func (p *KafkaPublisher) Publish(ctx context.Context, msgs []Message) error {
+	out := make([]kafka.Message, 0, len(msgs))
+	for _, m := range msgs {
+		out = append(out, kafka.Message{Topic: m.Topic, Key: m.Key, Value: m.Value})
+	}
+	return p.w.WriteMessages(ctx, out...)
+}
@@ -0,0 +1,103 @@
+package outbox
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"warimas-be/internal/logger"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+type Repository interface {
+	// ListPending returns unpublished events, oldest first.
+	ListPending(ctx context.Context, limit int32) ([]*Event, error)
+	MarkPublished(ctx context.Context, ids []int64, now time.Time) error
+	// MarkFailed records a failed attempt; the events stay pending.
+	MarkFailed(ctx context.Context, ids []int64, reason string) error
+	DeletePublished(ctx context.Context, before time.Time) (int64, error)
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) ListPending(ctx context.Context, limit int32) ([]*Event, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ListPending"),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, aggregate_type, aggregate_id, event_type, payload, occurred_at, attempts
+		FROM outbox_events
+		WHERE published_at IS NULL
+		ORDER BY id
+		LIMIT $1
+	`, limit)
+	if err != nil {
+		log.Error("failed to query pending events", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	events := []*Event{}
+	for rows.Next() {
+		var e Event
+		if err := rows.Scan(&e.ID, &e.AggregateType, &e.AggregateID, &e.EventType, &e.Payload, &e.OccurredAt, &e.Attempts); err != nil {
+			log.Error("failed to scan event", zap.Error(err))
+			return nil, ErrDB
+		}
+		events = append(events, &e)
+	}
+	if err := rows.Err(); err != nil {
+		log.Error("failed to iterate events", zap.Error(err))
+		return nil, ErrDB
+	}
+	return events, nil
+}
+
+func (r *repository) MarkPublished(ctx context.Context, ids []int64, now time.Time) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE outbox_events
+		SET published_at = $2, attempts = attempts + 1, last_error = NULL
+		WHERE id = ANY($1)
+	`, pq.Array(ids), now)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to mark events published", zap.Error(err))
+		return ErrDB
+	}
+	return nil
+}
+
+func (r *repository) MarkFailed(ctx context.Context, ids []int64, reason string) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE outbox_events
+		SET attempts = attempts + 1, last_error = $2
+		WHERE id = ANY($1)
+	`, pq.Array(ids), reason)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to mark events failed", zap.Error(err))
+		return ErrDB
+	}
+	return nil
+}
+
+func (r *repository) DeletePublished(ctx context.Context, before time.Time) (int64, error) {
+	res, err := r.db.ExecContext(ctx, `
+		DELETE FROM outbox_events
+		WHERE published_at < $1
+	`, before)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to delete published events", zap.Error(err))
+		return 0, ErrDB
+	}
+	n, _ := res.RowsAffected()
+	return n, nil
+}
@@ -0,0 +1,92 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_ListPending(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	now := time.Now()
+
+	rows := sqlmock.NewRows([]string{"id", "aggregate_type", "aggregate_id", "event_type", "payload", "occurred_at", "attempts"}).
+		AddRow(int64(1), AggregateOrder, "ord-1", "order.created", []byte(`{"status":"PENDING_PAYMENT"}`), now, 0).
+		AddRow(int64(2), AggregatePayment, "pay-1", "payment.created", []byte(`{"status":"PENDING"}`), now, 1)
+	mock.ExpectQuery(`SELECT .* FROM outbox_events WHERE published_at IS NULL ORDER BY id LIMIT \$1`).
+		WithArgs(int32(500)).
+		WillReturnRows(rows)
+
+	events, err := repo.ListPending(context.Background(), 500)
+	assert.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, "ord-1", events[0].AggregateID)
+	assert.JSONEq(t, `{"status":"PENDING"}`, string(events[1].Payload))
+	assert.Equal(t, 1, events[1].Attempts)
+
+	mock.ExpectQuery(`SELECT .* FROM outbox_events`).WillReturnError(errors.New("boom"))
+	_, err = repo.ListPending(context.Background(), 500)
+	assert.ErrorIs(t, err, ErrDB)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_MarkPublished(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	now := time.Now()
+
+	mock.ExpectExec(`UPDATE outbox_events SET published_at = \$2, attempts = attempts \+ 1, last_error = NULL WHERE id = ANY\(\$1\)`).
+		WithArgs(pq.Array([]int64{1, 2}), now).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	assert.NoError(t, repo.MarkPublished(context.Background(), []int64{1, 2}, now))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_MarkFailed(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	mock.ExpectExec(`UPDATE outbox_events SET attempts = attempts \+ 1, last_error = \$2 WHERE id = ANY\(\$1\)`).
+		WithArgs(pq.Array([]int64{3}), "broker down").
+		WillReturnError(errors.New("boom"))
+
+	err = repo.MarkFailed(context.Background(), []int64{3}, "broker down")
+	assert.ErrorIs(t, err, ErrDB)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_DeletePublished(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	before := time.Now().Add(-PublishedRetention)
+
+	mock.ExpectExec(`DELETE FROM outbox_events WHERE published_at < \$1`).
+		WithArgs(before).
+		WillReturnResult(sqlmock.NewResult(0, 42))
+
+	n, err := repo.DeletePublished(context.Background(), before)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(42), n)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,117 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"warimas-be/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// Service relays domain events from the outbox table to the broker.
+type Service interface {
+	// Relay publishes pending events in batches until none are left and
+	// returns how many were published.
+	Relay(ctx context.Context) (int, error)
+	// Purge deletes events published longer than PublishedRetention ago.
+	Purge(ctx context.Context) (int64, error)
+}
+
+type service struct {
+	repo        Repository
+	publisher   Publisher
+	topicPrefix string
+	now         func() time.Time
+}
+
+func NewService(repo Repository, publisher Publisher, topicPrefix string) Service {
+	return &service{
+		repo:        repo,
+		publisher:   publisher,
+		topicPrefix: topicPrefix,
+		now:         time.Now,
+	}
+}
+
+func (s *service) Relay(ctx context.Context) (int, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "Relay"),
+	)
+
+	var published int
+	for {
+		events, err := s.repo.ListPending(ctx, relayBatchSize)
+		if err != nil {
+			return published, err
+		}
+		if len(events) == 0 {
+			return published, nil
+		}
+
+		msgs := make([]Message, 0, len(events))
+		ids := make([]int64, 0, len(events))
+		for _, e := range events {
+			msg, err := s.message(e)
+			if err != nil {
+				return published, err
+			}
+			msgs = append(msgs, msg)
+			ids = append(ids, e.ID)
+		}
+
+		if err := s.publisher.Publish(ctx, msgs); err != nil {
+			log.Error("failed to publish events",
+				zap.Int64("first_id", ids[0]),
+				zap.Int("count", len(ids)),
+				zap.Error(err),
+			)
+			if markErr := s.repo.MarkFailed(ctx, ids, err.Error()); markErr != nil {
+				return published, markErr
+			}
+			return published, err
+		}
+
+		if err := s.repo.MarkPublished(ctx, ids, s.now()); err != nil {
+			return published, err
+		}
+		published += len(events)
+
+		if len(events) < relayBatchSize {
+			return published, nil
+		}
+	}
+}
+
+func (s *service) message(e *Event) (Message, error) {
+	value, err := json.Marshal(Envelope{
+		ID:            e.ID,
+		Type:          e.EventType,
+		Version:       SchemaVersion,
+		AggregateType: e.AggregateType,
+		AggregateID:   e.AggregateID,
+		OccurredAt:    e.OccurredAt.UTC(),
+		Data:          e.Payload,
+	})
+	if err != nil {
+		return Message{}, err
+	}
+	return Message{
+		Topic: Topic(s.topicPrefix, e.AggregateType),
+		Key:   []byte(e.AggregateID),
+		Value: value,
+	}, nil
+}
+
+func (s *service) Purge(ctx context.Context) (int64, error) {
+	n, err := s.repo.DeletePublished(ctx, s.now().Add(-PublishedRetention))
+	if err != nil {
+		return 0, err
+	}
+	if n > 0 {
+		logger.FromCtx(ctx).Info("purged published events", zap.Int64("count", n))
+	}
+	return n, nil
+}
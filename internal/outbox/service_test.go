@@ -0,0 +1,171 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Mocks ---
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) ListPending(ctx context.Context, limit int32) ([]*Event, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Event), args.Error(1)
+}
+
+func (m *MockRepository) MarkPublished(ctx context.Context, ids []int64, now time.Time) error {
+	args := m.Called(ctx, ids, now)
+	return args.Error(0)
+}
+
+func (m *MockRepository) MarkFailed(ctx context.Context, ids []int64, reason string) error {
+	args := m.Called(ctx, ids, reason)
+	return args.Error(0)
+}
+
+func (m *MockRepository) DeletePublished(ctx context.Context, before time.Time) (int64, error) {
+	args := m.Called(ctx, before)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+type MockPublisher struct {
+	mock.Mock
+}
+
+func (m *MockPublisher) Publish(ctx context.Context, msgs []Message) error {
+	args := m.Called(ctx, msgs)
+	return args.Error(0)
+}
+
+func newTestService(repo Repository, pub Publisher, now time.Time) *service {
+	s := NewService(repo, pub, "warimas").(*service)
+	s.now = func() time.Time { return now }
+	return s
+}
+
+// --- Tests ---
+
+func TestTopic(t *testing.T) {
+	assert.Equal(t, "warimas.order.v1", Topic("warimas", AggregateOrder))
+	assert.Equal(t, "staging.inventory.v1", Topic("staging", AggregateInventory))
+}
+
+func TestService_Relay(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	occurred := time.Date(2026, 3, 1, 16, 59, 0, 0, time.FixedZone("WIB", 7*3600))
+
+	t.Run("PublishesAndMarks", func(t *testing.T) {
+		repo := new(MockRepository)
+		pub := new(MockPublisher)
+		s := newTestService(repo, pub, now)
+
+		repo.On("ListPending", ctx, int32(relayBatchSize)).Return([]*Event{
+			{ID: 1, AggregateType: AggregateOrder, AggregateID: "ord-1", EventType: "order.created", Payload: json.RawMessage(`{"status":"PENDING_PAYMENT"}`), OccurredAt: occurred},
+			{ID: 2, AggregateType: AggregateInventory, AggregateID: "var-1", EventType: "inventory.stock_changed", Payload: json.RawMessage(`{"quantity":4}`), OccurredAt: occurred},
+		}, nil).Once()
+
+		var sent []Message
+		pub.On("Publish", ctx, mock.Anything).Run(func(args mock.Arguments) {
+			sent = args.Get(1).([]Message)
+		}).Return(nil)
+		repo.On("MarkPublished", ctx, []int64{1, 2}, now).Return(nil)
+
+		n, err := s.Relay(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, 2, n)
+
+		require.Len(t, sent, 2)
+		assert.Equal(t, "warimas.order.v1", sent[0].Topic)
+		assert.Equal(t, []byte("ord-1"), sent[0].Key)
+		assert.JSONEq(t, `{
+			"id": 1,
+			"type": "order.created",
+			"version": 1,
+			"aggregateType": "order",
+			"aggregateId": "ord-1",
+			"occurredAt": "2026-03-01T09:59:00Z",
+			"data": {"status": "PENDING_PAYMENT"}
+		}`, string(sent[0].Value))
+		assert.Equal(t, "warimas.inventory.v1", sent[1].Topic)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("FullBatchFetchesAgain", func(t *testing.T) {
+		repo := new(MockRepository)
+		pub := new(MockPublisher)
+		s := newTestService(repo, pub, now)
+
+		full := make([]*Event, relayBatchSize)
+		for i := range full {
+			full[i] = &Event{ID: int64(i + 1), AggregateType: AggregatePayment, AggregateID: fmt.Sprintf("pay-%d", i), Payload: json.RawMessage(`{}`)}
+		}
+		repo.On("ListPending", ctx, int32(relayBatchSize)).Return(full, nil).Once()
+		repo.On("ListPending", ctx, int32(relayBatchSize)).Return([]*Event{}, nil).Once()
+		pub.On("Publish", ctx, mock.Anything).Return(nil).Once()
+		repo.On("MarkPublished", ctx, mock.Anything, now).Return(nil).Once()
+
+		n, err := s.Relay(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, relayBatchSize, n)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("PublishFailureKeepsEventsPending", func(t *testing.T) {
+		repo := new(MockRepository)
+		pub := new(MockPublisher)
+		s := newTestService(repo, pub, now)
+
+		repo.On("ListPending", ctx, int32(relayBatchSize)).Return([]*Event{
+			{ID: 5, AggregateType: AggregateOrder, AggregateID: "ord-5", Payload: json.RawMessage(`{}`)},
+		}, nil)
+		pub.On("Publish", ctx, mock.Anything).Return(errors.New("broker down"))
+		repo.On("MarkFailed", ctx, []int64{5}, "broker down").Return(nil)
+
+		n, err := s.Relay(ctx)
+		assert.EqualError(t, err, "broker down")
+		assert.Zero(t, n)
+		repo.AssertNotCalled(t, "MarkPublished", mock.Anything, mock.Anything, mock.Anything)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		repo := new(MockRepository)
+		pub := new(MockPublisher)
+		s := newTestService(repo, pub, now)
+
+		repo.On("ListPending", ctx, int32(relayBatchSize)).Return([]*Event{}, nil)
+
+		n, err := s.Relay(ctx)
+		assert.NoError(t, err)
+		assert.Zero(t, n)
+		pub.AssertNotCalled(t, "Publish", mock.Anything, mock.Anything)
+	})
+}
+
+func TestService_Purge(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	repo := new(MockRepository)
+	s := newTestService(repo, LogPublisher{}, now)
+
+	repo.On("DeletePublished", ctx, now.Add(-PublishedRetention)).Return(int64(12), nil)
+
+	n, err := s.Purge(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(12), n)
+}
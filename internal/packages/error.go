@@ -1,10 +1,10 @@
 package packages
 
-import "errors"
+import "warimas-be/internal/apperr"
 
 var (
-	ErrPackagesNotFound = errors.New("order not found")
-	ErrUnauthorized     = errors.New("unauthorized")
-	ErrUnauthenticated  = errors.New("unauthenticated")
-	ErrForbidden        = errors.New("unauthorized")
+	ErrPackagesNotFound = apperr.NotFound("order not found")
+	ErrUnauthorized     = apperr.Unauthenticated("unauthorized")
+	ErrUnauthenticated  = apperr.Unauthenticated("unauthenticated")
+	ErrForbidden        = apperr.Forbidden("unauthorized")
 )
@@ -1,5 +1,7 @@
 package packages
 
+import "time"
+
 type SortDirection string
 
 const (
@@ -32,8 +34,8 @@ type Package struct {
 	ImageURL  *string
 	UserID    *uint
 	Items     []*PackageItem
-	CreatedAt string
-	UpdatedAt string
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
 type PackageItem struct {
@@ -44,8 +46,8 @@ type PackageItem struct {
 	Name      string
 	Price     float64
 	Quantity  int32
-	CreatedAt string
-	UpdatedAt string
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
 type CreatePackageInput struct {
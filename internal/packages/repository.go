@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 	"warimas-be/internal/logger"
+	"warimas-be/internal/sqlbuilder"
 
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -62,63 +63,52 @@ func (r *repository) GetPackages(
 	log.Debug("start get packages")
 
 	// Base conditions
-	whereClause := " WHERE p.deleted_at IS NULL" // Always hide soft-deleted items
-	args := []any{}
-	argIndex := 1
+	qb := sqlbuilder.New()
+	qb.Where("p.deleted_at IS NULL") // Always hide soft-deleted items
 
 	// ---------- ENABLE / DISABLE ----------
 	if !includeDisabled {
-		whereClause += " AND p.is_active = TRUE"
+		qb.Where("p.is_active = TRUE")
 
 		// Visibility: Users can only see non-personal packages OR their own personal packages
 		if viewerID != nil {
-			whereClause += fmt.Sprintf(" AND (p.type != 'personal' OR p.user_id = $%d)", argIndex)
-			args = append(args, *viewerID)
-			argIndex++
+			qb.Where("(p.type != 'personal' OR p.user_id = ?)", *viewerID)
 		} else {
-			whereClause += " AND p.type != 'personal'"
+			qb.Where("p.type != 'personal'")
 		}
 	}
 
 	// ---------- FILTERING ----------
 	if filter != nil {
 		if filter.ID != nil {
-			whereClause += fmt.Sprintf(" AND p.id = $%d", argIndex)
-			args = append(args, *filter.ID)
-			argIndex++
+			qb.Where("p.id = ?", *filter.ID)
 		}
 
 		if filter.Name != nil && *filter.Name != "" {
-			whereClause += fmt.Sprintf(" AND p.name ILIKE $%d", argIndex)
-			args = append(args, "%"+*filter.Name+"%")
-			argIndex++
+			qb.Where("p.name ILIKE ?", "%"+*filter.Name+"%")
 		}
 
 		if filter.Type != nil && *filter.Type != "" {
-			whereClause += fmt.Sprintf(" AND p.type = $%d", argIndex)
-			args = append(args, *filter.Type)
-			argIndex++
+			qb.Where("p.type = ?", *filter.Type)
 		}
 	}
 
 	// ---------- COUNT ----------
 	var total int64
-	countQuery := "SELECT COUNT(DISTINCT p.id) FROM packages p" + whereClause
-	if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+	countQuery := "SELECT COUNT(DISTINCT p.id) FROM packages p" + qb.WhereClause()
+	if err := r.db.QueryRowContext(ctx, countQuery, qb.Args()...).Scan(&total); err != nil {
 		log.Error("failed to count packages", zap.Error(err))
 		return nil, 0, err
 	}
 
 	// ---------- SORTING ----------
-	orderBy := "p.created_at DESC"
+	orderBy := sqlbuilder.OrderBy("p.created_at", string(SortDirectionDesc))
 	if sort != nil {
-		dir := sort.Direction
-
 		switch sort.Field {
 		case PackageSortFieldName:
-			orderBy = fmt.Sprintf("p.name %s", dir)
+			orderBy = sqlbuilder.OrderBy("p.name", string(sort.Direction))
 		case PackageSortFieldCreatedAt:
-			orderBy = fmt.Sprintf("p.created_at %s", dir)
+			orderBy = sqlbuilder.OrderBy("p.created_at", string(sort.Direction))
 		}
 	}
 
@@ -143,9 +133,9 @@ func (r *repository) GetPackages(
 		FROM packages p
 		LEFT JOIN package_items pi ON p.id = pi.package_id
 		LEFT JOIN variants v ON pi.variant_id = v.id
-	` + whereClause + " ORDER BY " + orderBy + fmt.Sprintf(" LIMIT $%d OFFSET $%d", argIndex, argIndex+1)
+	` + qb.WhereClause() + orderBy + qb.Page(limit, offset)
 
-	args = append(args, limit, offset)
+	args := qb.Args()
 
 	log.Debug("executing query",
 		zap.String("query", query),
@@ -222,8 +212,8 @@ func (r *repository) GetPackages(
 				ImageURL:  img,
 				UserID:    uid,
 				Items:     []*PackageItem{},
-				CreatedAt: pCreatedAt.Format(time.RFC3339),
-				UpdatedAt: pUpdatedAt.Format(time.RFC3339),
+				CreatedAt: pCreatedAt,
+				UpdatedAt: pUpdatedAt,
 			}
 			packagesMap[pID] = pkg
 			result = append(result, pkg)
@@ -240,10 +230,10 @@ func (r *repository) GetPackages(
 				Quantity:  itemQuantity.Int32,
 			}
 			if itemCreatedAt.Valid {
-				item.CreatedAt = itemCreatedAt.Time.Format(time.RFC3339)
+				item.CreatedAt = itemCreatedAt.Time
 			}
 			if itemUpdatedAt.Valid {
-				item.UpdatedAt = itemUpdatedAt.Time.Format(time.RFC3339)
+				item.UpdatedAt = itemUpdatedAt.Time
 			}
 			pkg.Items = append(pkg.Items, item)
 		}
@@ -321,8 +311,8 @@ func (r *repository) CreatePackage(ctx context.Context, input CreatePackageInput
 			ImageURL:  vImage,
 			Price:     vPrice,
 			Quantity:  item.Quantity,
-			CreatedAt: now.Format(time.RFC3339),
-			UpdatedAt: now.Format(time.RFC3339),
+			CreatedAt: now,
+			UpdatedAt: now,
 		})
 	}
 
@@ -339,7 +329,7 @@ func (r *repository) CreatePackage(ctx context.Context, input CreatePackageInput
 		Type:      input.Type,
 		UserID:    &userID,
 		Items:     items,
-		CreatedAt: now.Format(time.RFC3339),
-		UpdatedAt: now.Format(time.RFC3339),
+		CreatedAt: now,
+		UpdatedAt: now,
 	}, nil
 }
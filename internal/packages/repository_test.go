@@ -49,6 +49,51 @@ func TestRepository_GetPackages(t *testing.T) {
 		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
+	t.Run("FiltersAndSort_PlaceholdersInOrder", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+		ctx := context.Background()
+		viewerID := uint(1)
+		name := "snack"
+		pkgType := "promotion"
+		filter := &PackageFilterInput{Name: &name, Type: &pkgType}
+		sort := &PackageSortInput{Field: PackageSortFieldName, Direction: SortDirectionAsc}
+
+		where := "WHERE p.deleted_at IS NULL AND p.is_active = TRUE AND (p.type != 'personal' OR p.user_id = $1) AND p.name ILIKE $2 AND p.type = $3"
+
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(DISTINCT p.id) FROM packages p "+where)).
+			WithArgs(viewerID, "%snack%", pkgType).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectQuery(`(?s)SELECT .* FROM packages p .*`+regexp.QuoteMeta(where+" ORDER BY p.name ASC LIMIT $4 OFFSET $5")).
+			WithArgs(viewerID, "%snack%", pkgType, int32(10), int32(10)).
+			WillReturnRows(sqlmock.NewRows([]string{"p.id"}))
+
+		_, _, err = repo.GetPackages(ctx, filter, sort, 10, 2, false, &viewerID)
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("InvalidSortDirection_FallsBackToDesc", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+		ctx := context.Background()
+		sort := &PackageSortInput{Field: PackageSortFieldCreatedAt, Direction: "ASC; DROP TABLE packages"}
+
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(DISTINCT p.id) FROM packages p")).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+		mock.ExpectQuery(regexp.QuoteMeta("ORDER BY p.created_at DESC LIMIT $1 OFFSET $2")).
+			WithArgs(int32(20), int32(0)).
+			WillReturnRows(sqlmock.NewRows([]string{"p.id"}))
+
+		_, _, err = repo.GetPackages(ctx, nil, sort, 0, 0, true, nil)
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
 	t.Run("NoItems", func(t *testing.T) {
 		db, mock, err := sqlmock.New()
 		require.NoError(t, err)
@@ -49,8 +49,7 @@ func (s *service) GetPackages(
 	}
 
 	// ---------- AUTH ----------
-	role := utils.GetUserRoleFromContext(ctx)
-	includeDisabled := role == "ADMIN"
+	includeDisabled := utils.IsAdmin(ctx)
 
 	var viewerID *uint
 	if uid, ok := utils.GetUserIDFromContext(ctx); ok {
@@ -89,8 +88,7 @@ func (s *service) AddPackage(ctx context.Context, input CreatePackageInput) (*Pa
 		log.Warn("unauthenticated")
 		return nil, ErrUnauthenticated
 	}
-	userRole := utils.GetUserRoleFromContext(ctx)
-	if userRole != "ADMIN" && input.Type == "promotion" {
+	if !utils.IsAdmin(ctx) && input.Type == "promotion" {
 		log.Warn("unauthorized: promotion type requires admin")
 		return nil, ErrUnauthorized
 	}
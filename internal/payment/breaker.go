@@ -0,0 +1,77 @@
+package payment
+
+import (
+	"sync"
+	"time"
+)
+
+// breaker is a consecutive failure circuit breaker. After threshold
+// failures in a row it opens and rejects calls for cooldown. Then it
+// lets a single probe through: success closes it, failure opens it for
+// another cooldown.
+type breaker struct {
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+
+	mu       sync.Mutex
+	failures int
+	openedAt time.Time // zero while closed
+	probing  bool
+}
+
+func newBreaker(threshold int, cooldown time.Duration) *breaker {
+	return &breaker{threshold: threshold, cooldown: cooldown, now: time.Now}
+}
+
+// allow reports whether a call may go out now. A true answer must be
+// followed by success, failure or release.
+func (b *breaker) allow() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.openedAt.IsZero() {
+		return true
+	}
+	if b.probing || b.now().Sub(b.openedAt) < b.cooldown {
+		return false
+	}
+	b.probing = true
+	return true
+}
+
+// success records a call that reached the provider, and reports whether
+// it closed the breaker.
+func (b *breaker) success() (closed bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	closed = !b.openedAt.IsZero()
+	b.failures = 0
+	b.openedAt = time.Time{}
+	b.probing = false
+	return closed
+}
+
+// failure records a call the provider failed, and reports whether it
+// opened the breaker.
+func (b *breaker) failure() (opened bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.failures++
+	wasOpen := !b.openedAt.IsZero()
+	b.probing = false
+	if wasOpen || b.failures >= b.threshold {
+		b.openedAt = b.now()
+	}
+	return !wasOpen && !b.openedAt.IsZero()
+}
+
+// release gives back a call that ended without telling anything about
+// the provider, such as one the caller cancelled.
+func (b *breaker) release() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+}
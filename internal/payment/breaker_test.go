@@ -0,0 +1,47 @@
+package payment
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBreaker(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	b := newBreaker(3, 30*time.Second)
+	b.now = func() time.Time { return now }
+
+	// Failures below the threshold, or broken by a success, keep it closed.
+	assert.False(t, b.failure())
+	assert.False(t, b.failure())
+	assert.False(t, b.success())
+	assert.False(t, b.failure())
+	assert.False(t, b.failure())
+	assert.True(t, b.allow())
+
+	assert.True(t, b.failure())
+	assert.False(t, b.allow())
+
+	// After the cooldown one probe goes out at a time.
+	now = now.Add(30 * time.Second)
+	assert.True(t, b.allow())
+	assert.False(t, b.allow())
+
+	// A failed probe reopens it for another cooldown.
+	assert.False(t, b.failure())
+	assert.False(t, b.allow())
+	now = now.Add(29 * time.Second)
+	assert.False(t, b.allow())
+
+	// A released probe lets the next call probe instead.
+	now = now.Add(time.Second)
+	assert.True(t, b.allow())
+	b.release()
+	assert.True(t, b.allow())
+
+	// A successful probe closes it.
+	assert.True(t, b.success())
+	assert.True(t, b.allow())
+	assert.True(t, b.allow())
+}
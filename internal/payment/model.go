@@ -3,6 +3,7 @@ package payment
 import (
 	"encoding/json"
 	"time"
+	"warimas-be/internal/money"
 )
 
 type Payment struct {
@@ -25,6 +26,20 @@ type Payment struct {
 	ExpireAt          time.Time
 }
 
+// Capture is what the provider reported for a settled payment. Amount, Fee,
+// ChannelCode and PaidAt are zero when the settlement carries none, as for
+// wallet and offline payments; the payment row keeps its values then.
+type Capture struct {
+	// ExternalReference is the payment request the capture settles.
+	ExternalReference string
+	ProviderPaymentID string
+	Amount            int64
+	// Fee is what the gateway kept of Amount, VAT on the fee included.
+	Fee         int64
+	ChannelCode string
+	PaidAt      time.Time
+}
+
 type BuyerInfo struct {
 	Name  string
 	Email *string
@@ -55,6 +70,21 @@ type PaymentResponse struct {
 	RawResponse       *json.RawMessage `json:"raw_response,omitempty"`
 }
 
+type RefundResponse struct {
+	ProviderRefundID string `json:"id"`
+	ReferenceID      string `json:"reference_id"`
+	Amount           int64  `json:"amount"`
+	Status           string `json:"status"`
+	FailureCode      string `json:"failure_code,omitempty"`
+}
+
+// Final refund statuses reported by the provider; anything else is still
+// in progress.
+const (
+	RefundStatusSucceeded = "SUCCEEDED"
+	RefundStatusFailed    = "FAILED"
+)
+
 type PaymentStatus struct {
 	Status string
 	PaidAt *time.Time
@@ -88,6 +118,37 @@ const (
 
 	// Credit Card
 	MethodCreditCard ChannelCode = "CARDS"
+
+	// Store wallet (settled internally, never sent to the gateway)
+	MethodWallet ChannelCode = "WALLET"
+
+	// Collected outside the platform (cash, manual transfer) and recorded
+	// by an admin
+	MethodOffline ChannelCode = "OFFLINE"
+)
+
+const (
+	ProviderXendit  = "XENDIT"
+	ProviderWallet  = "WALLET"
+	ProviderOffline = "OFFLINE"
+)
+
+// WalletReference is the payments.external_reference used for the wallet
+// portion of an order.
+func WalletReference(orderExternalID string) string {
+	return "wallet-" + orderExternalID
+}
+
+// OfflineReference is the payments.external_reference used for a payment
+// an admin collected outside the platform.
+func OfflineReference(orderExternalID string) string {
+	return "offline-" + orderExternalID
+}
+
+// Gateway events that open a dispute against a captured payment.
+const (
+	EventPaymentDispute    = "payment.dispute"
+	EventPaymentChargeback = "payment.chargeback"
 )
 
 const (
@@ -102,11 +163,11 @@ type XenditPaymentResponse struct {
 	BusinessID       string `json:"business_id"`
 	ReferenceID      string `json:"reference_id"`
 
-	RequestAmount int64  `json:"request_amount"`
-	Status        string `json:"status"`
-	Type          string `json:"type"`
-	ChannelCode   string `json:"channel_code"`
-	CustomerID    string `json:"customer_id,omitempty"`
+	RequestAmount float64 `json:"request_amount"`
+	Status        string  `json:"status"`
+	Type          string  `json:"type"`
+	ChannelCode   string  `json:"channel_code"`
+	CustomerID    string  `json:"customer_id,omitempty"`
 
 	Created time.Time `json:"created"`
 	Updated time.Time `json:"updated"`
@@ -145,17 +206,88 @@ type WebhookPayload struct {
 		ChannelCode      string    `json:"channel_code"`
 		ReferenceID      string    `json:"reference_id"`
 		CaptureMethod    string    `json:"capture_method"`
-		RequestAmount    int64     `json:"request_amount"`
+		RequestAmount    float64   `json:"request_amount"`
 		PaymentRequestID string    `json:"payment_request_id"`
 
+		// Set on dispute events only
+		DisputeID     string  `json:"dispute_id"`
+		DisputeAmount float64 `json:"dispute_amount"`
+		Reason        string  `json:"reason"`
+
+		// Set on refund events only
+		Amount float64 `json:"amount"`
+
 		Captures []struct {
-			CaptureID        string `json:"capture_id"`
-			CaptureAmount    int64  `json:"capture_amount"`
-			CaptureTimestamp string `json:"capture_timestamp"`
+			CaptureID        string  `json:"capture_id"`
+			CaptureAmount    float64 `json:"capture_amount"`
+			CaptureTimestamp string  `json:"capture_timestamp"`
 		} `json:"captures"`
 
+		// Set on captures of accounts with fee reporting enabled
+		Fee struct {
+			XenditFee     float64 `json:"xendit_fee"`
+			ValueAddedTax float64 `json:"value_added_tax"`
+		} `json:"fee"`
+
 		Metadata struct {
 			Items []XenditItem `json:"items"`
 		} `json:"metadata"`
 	} `json:"data"`
 }
+
+// Webhook amounts are in major units and may carry decimals, so they are
+// read through money.FromMajor with the webhook's currency, the same
+// rounding the order's amounts went through.
+
+// RequestedAmount is what the payment request asked for, in minor units.
+func (p WebhookPayload) RequestedAmount() int64 {
+	return money.FromMajor(p.Data.Currency, p.Data.RequestAmount)
+}
+
+// DisputedAmount is what a dispute contests, the whole payment when the
+// webhook does not say.
+func (p WebhookPayload) DisputedAmount() int64 {
+	if p.Data.DisputeAmount == 0 {
+		return p.RequestedAmount()
+	}
+	return money.FromMajor(p.Data.Currency, p.Data.DisputeAmount)
+}
+
+// RefundedAmount is what a refund event paid back.
+func (p WebhookPayload) RefundedAmount() int64 {
+	return money.FromMajor(p.Data.Currency, p.Data.Amount)
+}
+
+// Capture reads what a capture webhook says was taken. The amount is the
+// sum of the captures, or the requested amount when the webhook lists none;
+// the time is that of the last capture, or the webhook's update time. The
+// fee is zero when the webhook does not report one.
+func (p WebhookPayload) Capture() Capture {
+	c := Capture{
+		ExternalReference: p.Data.PaymentRequestID,
+		ProviderPaymentID: p.Data.PaymentID,
+		Fee:               money.FromMajor(p.Data.Currency, p.Data.Fee.XenditFee+p.Data.Fee.ValueAddedTax),
+		ChannelCode:       p.Data.ChannelCode,
+		PaidAt:            p.Data.Updated,
+	}
+
+	var captured float64
+	for _, cp := range p.Data.Captures {
+		captured += cp.CaptureAmount
+		if t, err := time.Parse(time.RFC3339, cp.CaptureTimestamp); err == nil && t.After(c.PaidAt) {
+			c.PaidAt = t
+		}
+	}
+	c.Amount = money.FromMajor(p.Data.Currency, captured)
+	if c.Amount == 0 {
+		c.Amount = p.RequestedAmount()
+	}
+	return c
+}
+
+// QueuedWebhook is a stored webhook that was never applied, typically one
+// received during maintenance mode.
+type QueuedWebhook struct {
+	ID      int64
+	Payload json.RawMessage
+}
@@ -3,9 +3,21 @@ package payment
 
 import (
 	"context"
+	"errors"
 	"net/http"
 )
 
+// CancelPayment fails with these when the payment request is already
+// settled and so cannot be cancelled.
+var (
+	// ErrPaymentAlreadyPaid means the request was paid; its capture
+	// webhook settles the order.
+	ErrPaymentAlreadyPaid = errors.New("payment request is already paid")
+	// ErrPaymentAlreadyExpired means the request expired, failed or was
+	// cancelled, so it can no longer be paid either.
+	ErrPaymentAlreadyExpired = errors.New("payment request has already expired")
+)
+
 type Gateway interface {
 	CreateInvoice(ctx context.Context,
 		externalID string,
@@ -15,6 +27,13 @@ type Gateway interface {
 		channelCode ChannelCode,
 	) (*PaymentResponse, error)
 	GetPaymentStatus(ctx context.Context, externalID string) (*PaymentStatus, error)
-	CancelPayment(ctx context.Context, externalID string) error
+	// CancelPayment stops a pending payment request, by its id, from
+	// being paid. ErrPaymentAlreadyPaid or ErrPaymentAlreadyExpired when
+	// it was no longer pending.
+	CancelPayment(ctx context.Context, paymentRequestID string) error
+	Refund(ctx context.Context, paymentRequestID, referenceID string, amount int64, reason string) (*RefundResponse, error)
+	// GetRefund returns the provider's current view of a refund it
+	// accepted, see RefundStatusSucceeded and RefundStatusFailed.
+	GetRefund(ctx context.Context, providerRefundID string) (*RefundResponse, error)
 	VerifySignature(r *http.Request) error
 }
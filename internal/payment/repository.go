@@ -5,12 +5,19 @@ import (
 	"database/sql"
 	"encoding/json"
 	"errors"
+	"time"
+
+	"warimas-be/internal/db/dbgen"
 )
 
 type Repository interface {
 	SavePayment(ctx context.Context, p *Payment) error
 	UpdatePaymentStatus(ctx context.Context, externalID, status string) error
 	GetPaymentByOrder(ctx context.Context, orderID uint) (*Payment, error)
+	GetPaymentsByOrder(ctx context.Context, orderID uint) ([]*Payment, error)
+	// MarkPaymentPaid marks the payment c settles PAID and writes the
+	// captured amount, channel and time onto it.
+	MarkPaymentPaid(ctx context.Context, c Capture) error
 	SavePaymentWebhook(
 		ctx context.Context,
 		provider string,
@@ -23,59 +30,107 @@ type Repository interface {
 
 	MarkWebhookProcessed(ctx context.Context, webhookID int64) error
 	MarkWebhookFailed(ctx context.Context, webhookID int64, reason string) error
+	// HasAppliedWebhook reports whether an earlier webhook moving the same
+	// payment request to status was already processed without error.
+	HasAppliedWebhook(
+		ctx context.Context,
+		provider string,
+		externalID string,
+		eventType string,
+		paymentRequestID string,
+		status string,
+	) (bool, error)
+	// ListQueuedWebhooks returns webhooks received before receivedBefore
+	// that were stored but neither processed nor failed, oldest first.
+	ListQueuedWebhooks(ctx context.Context, provider string, receivedBefore time.Time, limit int32) ([]*QueuedWebhook, error)
+	// ListWebhookEvents returns the webhooks stored for an order or its
+	// payment request, oldest first.
+	ListWebhookEvents(ctx context.Context, provider, orderExternalID, paymentRequestID string) ([]*WebhookEvent, error)
 }
 
 type repository struct {
-	db *sql.DB
+	q *dbgen.Queries
 }
 
 func NewRepository(db *sql.DB) Repository {
-	return &repository{db: db}
+	return &repository{q: dbgen.New(db)}
 }
 
 func (r *repository) SavePayment(ctx context.Context, p *Payment) error {
-	_, err := r.db.ExecContext(ctx, `
-		INSERT INTO payments (order_id, 
-		external_reference, 
-		invoice_url, 
-		amount, 
-		status, 
-		payment_method, 
-		channel_code, 
-		payment_code,
-		provider,
-		currency,
-		expire_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
-	`,
-		p.OrderID, p.ExternalReference, p.InvoiceURL, p.Amount, p.Status, p.PaymentMethod, p.ChannelCode, p.PaymentCode,
-		"XENDIT", "IDR", p.ExpireAt,
-	)
-	return err
+	return r.q.SavePayment(ctx, dbgen.SavePaymentParams{
+		OrderID:           int32(p.OrderID),
+		ExternalReference: p.ExternalReference,
+		InvoiceUrl:        p.InvoiceURL,
+		Amount:            p.Amount,
+		Status:            p.Status,
+		PaymentMethod:     sql.NullString{String: string(p.PaymentMethod), Valid: true},
+		ChannelCode:       p.ChannelCode,
+		PaymentCode:       p.PaymentCode,
+		Provider:          ProviderXendit,
+		Currency:          "IDR",
+		ExpireAt:          sql.NullTime{Time: p.ExpireAt, Valid: !p.ExpireAt.IsZero()},
+	})
 }
 
 func (r *repository) UpdatePaymentStatus(ctx context.Context, externalID, status string) error {
-	_, err := r.db.ExecContext(ctx, `
-		UPDATE payments SET status = $1 WHERE external_id = $2
-	`, status, externalID)
-	return err
+	return r.q.UpdatePaymentStatus(ctx, dbgen.UpdatePaymentStatusParams{
+		Status:            status,
+		ExternalReference: externalID,
+	})
 }
 
 func (r *repository) GetPaymentByOrder(ctx context.Context, orderID uint) (*Payment, error) {
-	row := r.db.QueryRowContext(ctx, `
-		SELECT id, order_id, external_reference, invoice_url, amount, status, payment_method, created_at, updated_at, payment_code, expire_at
-		FROM payments WHERE order_id = $1
-	`, orderID)
-
-	var p Payment
-	err := row.Scan(
-		&p.ID, &p.OrderID, &p.ExternalReference, &p.InvoiceURL,
-		&p.Amount, &p.Status, &p.PaymentMethod, &p.CreatedAt, &p.UpdatedAt, &p.PaymentCode, &p.ExpireAt,
-	)
+	row, err := r.q.GetPaymentByOrder(ctx, int32(orderID))
+	if err != nil {
+		return nil, err
+	}
+
+	return &Payment{
+		ID:                uint(row.ID),
+		OrderID:           uint(row.OrderID),
+		ExternalReference: row.ExternalReference,
+		InvoiceURL:        row.InvoiceUrl,
+		Amount:            row.Amount,
+		Status:            row.Status,
+		PaymentMethod:     ChannelCode(row.PaymentMethod.String),
+		CreatedAt:         row.CreatedAt.Time,
+		UpdatedAt:         row.UpdatedAt.Time,
+		PaymentCode:       row.PaymentCode,
+		ExpireAt:          row.ExpireAt.Time,
+	}, nil
+}
+
+// GetPaymentsByOrder returns every payment row of an order. Split payments
+// have one row per funding source.
+func (r *repository) GetPaymentsByOrder(ctx context.Context, orderID uint) ([]*Payment, error) {
+	rows, err := r.q.GetPaymentsByOrder(ctx, int32(orderID))
 	if err != nil {
 		return nil, err
 	}
-	return &p, nil
+
+	var payments []*Payment
+	for _, row := range rows {
+		payments = append(payments, &Payment{
+			ID:                uint(row.ID),
+			OrderID:           uint(row.OrderID),
+			ExternalReference: row.ExternalReference,
+			Amount:            row.Amount,
+			Status:            row.Status,
+			Provider:          row.Provider,
+		})
+	}
+	return payments, nil
+}
+
+func (r *repository) MarkPaymentPaid(ctx context.Context, c Capture) error {
+	return r.q.MarkPaymentPaid(ctx, dbgen.MarkPaymentPaidParams{
+		ProviderPaymentID: sql.NullString{String: c.ProviderPaymentID, Valid: true},
+		CapturedAmount:    sql.NullInt64{Int64: c.Amount, Valid: c.Amount > 0},
+		FeeAmount:         sql.NullInt64{Int64: c.Fee, Valid: c.Fee > 0},
+		ChannelCode:       sql.NullString{String: c.ChannelCode, Valid: c.ChannelCode != ""},
+		PaidAt:            sql.NullTime{Time: c.PaidAt, Valid: !c.PaidAt.IsZero()},
+		ExternalReference: c.ExternalReference,
+	})
 }
 
 func (r *repository) SavePaymentWebhook(
@@ -88,32 +143,14 @@ func (r *repository) SavePaymentWebhook(
 	signatureValid bool,
 ) (int64, bool, error) {
 
-	const q = `
-	INSERT INTO payment_webhooks (
-		provider,
-		event_type,
-		event_id,
-		external_id,
-		signature_valid,
-		payload
-	)
-	VALUES ($1, $2, $3, $4, $5, $6)
-	ON CONFLICT (provider, event_id)
-	DO NOTHING
-	RETURNING id;
-	`
-
-	var id int64
-	err := r.db.QueryRowContext(
-		ctx,
-		q,
-		provider,
-		eventType,
-		eventID,
-		externalID,
-		signatureValid,
-		payload,
-	).Scan(&id)
+	id, err := r.q.SavePaymentWebhook(ctx, dbgen.SavePaymentWebhookParams{
+		Provider:       provider,
+		EventType:      sql.NullString{String: eventType, Valid: true},
+		EventID:        sql.NullString{String: eventID, Valid: true},
+		ExternalID:     sql.NullString{String: externalID, Valid: true},
+		SignatureValid: signatureValid,
+		Payload:        payload,
+	})
 
 	if err != nil {
 		// Duplicate webhook → idempotent success
@@ -130,15 +167,7 @@ func (r *repository) MarkWebhookProcessed(
 	ctx context.Context,
 	webhookID int64,
 ) error {
-
-	const q = `
-	UPDATE payment_webhooks
-	SET processed_at = now()
-	WHERE id = $1;
-	`
-
-	_, err := r.db.ExecContext(ctx, q, webhookID)
-	return err
+	return r.q.MarkWebhookProcessed(ctx, webhookID)
 }
 
 func (r *repository) MarkWebhookFailed(
@@ -146,13 +175,73 @@ func (r *repository) MarkWebhookFailed(
 	webhookID int64,
 	reason string,
 ) error {
+	return r.q.MarkWebhookFailed(ctx, dbgen.MarkWebhookFailedParams{
+		ID:           webhookID,
+		ProcessError: sql.NullString{String: reason, Valid: true},
+	})
+}
 
-	const q = `
-	UPDATE payment_webhooks
-	SET process_error = $2
-	WHERE id = $1;
-	`
+func (r *repository) HasAppliedWebhook(
+	ctx context.Context,
+	provider string,
+	externalID string,
+	eventType string,
+	paymentRequestID string,
+	status string,
+) (bool, error) {
+	return r.q.HasAppliedWebhook(ctx, dbgen.HasAppliedWebhookParams{
+		Provider:         provider,
+		ExternalID:       externalID,
+		EventType:        eventType,
+		PaymentRequestID: paymentRequestID,
+		Status:           status,
+	})
+}
+
+func (r *repository) ListQueuedWebhooks(
+	ctx context.Context,
+	provider string,
+	receivedBefore time.Time,
+	limit int32,
+) ([]*QueuedWebhook, error) {
+	rows, err := r.q.ListQueuedWebhooks(ctx, dbgen.ListQueuedWebhooksParams{
+		Provider:       provider,
+		ReceivedBefore: receivedBefore,
+		MaxRows:        limit,
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	_, err := r.db.ExecContext(ctx, q, webhookID, reason)
-	return err
+	out := make([]*QueuedWebhook, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &QueuedWebhook{ID: row.ID, Payload: row.Payload})
+	}
+	return out, nil
+}
+
+func (r *repository) ListWebhookEvents(
+	ctx context.Context,
+	provider string,
+	orderExternalID string,
+	paymentRequestID string,
+) ([]*WebhookEvent, error) {
+	rows, err := r.q.ListPaymentWebhookEvents(ctx, dbgen.ListPaymentWebhookEventsParams{
+		Provider:         provider,
+		ExternalID:       orderExternalID,
+		PaymentRequestID: paymentRequestID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*WebhookEvent, 0, len(rows))
+	for _, row := range rows {
+		out = append(out, &WebhookEvent{
+			EventType:  row.EventType.String,
+			Payload:    row.Payload,
+			ReceivedAt: row.ReceivedAt,
+		})
+	}
+	return out, nil
 }
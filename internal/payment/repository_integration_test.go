@@ -0,0 +1,104 @@
+//go:build integration
+
+package payment
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"warimas-be/internal/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepositoryIntegration_PaymentLifecycle(t *testing.T) {
+	db := testutil.NewDB(t)
+	repo := NewRepository(db)
+	ctx := context.Background()
+
+	userID := testutil.CreateUser(t, db)
+	order := testutil.CreateOrder(t, db, userID, 150000)
+
+	p := &Payment{
+		OrderID:           uint(order.ID),
+		ExternalReference: "pr-" + order.ExternalID,
+		InvoiceURL:        "https://pay.example.com/inv",
+		Amount:            150000,
+		Status:            "PENDING",
+		PaymentMethod:     ChannelCode("BCA_VIRTUAL_ACCOUNT"),
+		ChannelCode:       "BCA_VIRTUAL_ACCOUNT",
+		PaymentCode:       "8808123456",
+	}
+	require.NoError(t, repo.SavePayment(ctx, p))
+
+	// expire_at is NULL when ExpireAt is zero
+	got, err := repo.GetPaymentByOrder(ctx, uint(order.ID))
+	require.NoError(t, err)
+	assert.Equal(t, p.ExternalReference, got.ExternalReference)
+	assert.Equal(t, int64(150000), got.Amount)
+	assert.True(t, got.ExpireAt.IsZero())
+
+	require.NoError(t, repo.UpdatePaymentStatus(ctx, p.ExternalReference, "EXPIRED"))
+	got, err = repo.GetPaymentByOrder(ctx, uint(order.ID))
+	require.NoError(t, err)
+	assert.Equal(t, "EXPIRED", got.Status)
+
+	require.NoError(t, repo.MarkPaymentPaid(ctx, Capture{ExternalReference: p.ExternalReference, ProviderPaymentID: "py-1", Amount: 150000}))
+	payments, err := repo.GetPaymentsByOrder(ctx, uint(order.ID))
+	require.NoError(t, err)
+	require.Len(t, payments, 1)
+	assert.Equal(t, "PAID", payments[0].Status)
+	assert.Equal(t, ProviderXendit, payments[0].Provider)
+}
+
+func TestRepositoryIntegration_SavePaymentWebhook(t *testing.T) {
+	db := testutil.NewDB(t)
+	repo := NewRepository(db)
+	ctx := context.Background()
+
+	payload := json.RawMessage(`{"data":{"payment_request_id":"pr-1","status":"SUCCEEDED"}}`)
+
+	id, dup, err := repo.SavePaymentWebhook(ctx, ProviderXendit, "evt-1", "payment.succeeded", "ORD-1", payload, true)
+	require.NoError(t, err)
+	assert.False(t, dup)
+	assert.NotZero(t, id)
+
+	_, dup, err = repo.SavePaymentWebhook(ctx, ProviderXendit, "evt-1", "payment.succeeded", "ORD-1", payload, true)
+	require.NoError(t, err)
+	assert.True(t, dup)
+
+	applied, err := repo.HasAppliedWebhook(ctx, ProviderXendit, "ORD-1", "payment.succeeded", "pr-1", "SUCCEEDED")
+	require.NoError(t, err)
+	assert.False(t, applied, "unprocessed webhook must not count as applied")
+
+	require.NoError(t, repo.MarkWebhookProcessed(ctx, id))
+	applied, err = repo.HasAppliedWebhook(ctx, ProviderXendit, "ORD-1", "payment.succeeded", "pr-1", "SUCCEEDED")
+	require.NoError(t, err)
+	assert.True(t, applied)
+}
+
+func TestRepositoryIntegration_SavePaymentStoresExpiry(t *testing.T) {
+	db := testutil.NewDB(t)
+	repo := NewRepository(db)
+	ctx := context.Background()
+
+	userID := testutil.CreateUser(t, db)
+	order := testutil.CreateOrder(t, db, userID, 50000)
+	expireAt := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+
+	require.NoError(t, repo.SavePayment(ctx, &Payment{
+		OrderID:           uint(order.ID),
+		ExternalReference: "pr-exp-" + order.ExternalID,
+		Amount:            50000,
+		Status:            "PENDING",
+		ChannelCode:       "QRIS",
+		ExpireAt:          expireAt,
+	}))
+
+	got, err := repo.GetPaymentByOrder(ctx, uint(order.ID))
+	require.NoError(t, err)
+	assert.True(t, expireAt.Equal(got.ExpireAt))
+}
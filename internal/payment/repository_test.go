@@ -62,7 +62,7 @@ func TestRepository_UpdatePaymentStatus(t *testing.T) {
 	status := "PAID"
 
 	t.Run("Success", func(t *testing.T) {
-		mock.ExpectExec(`UPDATE payments SET status = \$1 WHERE external_id = \$2`).
+		mock.ExpectExec(`UPDATE payments SET status = \$1 WHERE external_reference = \$2`).
 			WithArgs(status, extID).
 			WillReturnResult(sqlmock.NewResult(0, 1))
 
@@ -71,7 +71,7 @@ func TestRepository_UpdatePaymentStatus(t *testing.T) {
 	})
 
 	t.Run("DBError", func(t *testing.T) {
-		mock.ExpectExec(`UPDATE payments SET status = \$1 WHERE external_id = \$2`).
+		mock.ExpectExec(`UPDATE payments SET status = \$1 WHERE external_reference = \$2`).
 			WithArgs(status, extID).
 			WillReturnError(errors.New("db error"))
 
@@ -175,6 +175,66 @@ func TestRepository_WebhookUpdates(t *testing.T) {
 	})
 }
 
+func TestRepository_HasAppliedWebhook(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+
+	t.Run("Applied", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT EXISTS \(.*FROM payment_webhooks`).
+			WithArgs("XENDIT", "ord-ref-1", "payment.capture", "pay-req-1", "SUCCEEDED").
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+
+		applied, err := repo.HasAppliedWebhook(ctx, "XENDIT", "ord-ref-1", "payment.capture", "pay-req-1", "SUCCEEDED")
+		assert.NoError(t, err)
+		assert.True(t, applied)
+	})
+
+	t.Run("DBError", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT EXISTS \(.*FROM payment_webhooks`).
+			WillReturnError(errors.New("db error"))
+
+		_, err := repo.HasAppliedWebhook(ctx, "XENDIT", "ord-ref-1", "payment.capture", "pay-req-1", "SUCCEEDED")
+		assert.Error(t, err)
+	})
+}
+
+func TestRepository_ListWebhookEvents(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		receivedAt := time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+		mock.ExpectQuery(`SELECT event_type, payload, received_at FROM payment_webhooks`).
+			WithArgs("XENDIT", "ord-ref-1", "pay-req-1").
+			WillReturnRows(sqlmock.NewRows([]string{"event_type", "payload", "received_at"}).
+				AddRow("payment.capture", []byte(`{"event":"payment.capture"}`), receivedAt).
+				AddRow(nil, []byte(`{}`), receivedAt.Add(time.Minute)))
+
+		events, err := repo.ListWebhookEvents(ctx, "XENDIT", "ord-ref-1", "pay-req-1")
+		assert.NoError(t, err)
+		require.Len(t, events, 2)
+		assert.Equal(t, "payment.capture", events[0].EventType)
+		assert.Equal(t, receivedAt, events[0].ReceivedAt)
+		assert.Empty(t, events[1].EventType)
+	})
+
+	t.Run("DBError", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT event_type, payload, received_at FROM payment_webhooks`).
+			WillReturnError(errors.New("db error"))
+
+		_, err := repo.ListWebhookEvents(ctx, "XENDIT", "ord-ref-1", "pay-req-1")
+		assert.Error(t, err)
+	})
+}
+
 func TestRepository_GetPaymentByOrder(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -0,0 +1,414 @@
+package payment
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"warimas-be/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// Outcomes the simulated gateway reports for every payment it creates.
+const (
+	SimulatePaid   = "PAID"
+	SimulateFailed = "FAILED"
+	// SimulateNone leaves payments pending until they expire.
+	SimulateNone = "NONE"
+)
+
+// Prefixes of the IDs the simulated gateway hands out, which tell its
+// payments and refunds apart from Xendit's.
+const (
+	simulatedPaymentPrefix = "sim-pr-"
+	simulatedRefundPrefix  = "sim-rfd-"
+)
+
+const (
+	simulatedWebhookAttempts = 3
+	simulatedWebhookBackoff  = 2 * time.Second
+	simulatedWebhookTimeout  = 5 * time.Second
+)
+
+// SimulatedOptions configures the simulated gateway.
+type SimulatedOptions struct {
+	// WebhookURL receives the payment webhooks, normally this server's
+	// /webhook/payment.
+	WebhookURL string
+	// CallbackToken is sent as x-callback-token, the token the webhook
+	// handler expects from Xendit.
+	CallbackToken string
+	// Outcome is SimulatePaid, SimulateFailed or SimulateNone.
+	Outcome string
+	// Delay is how long after creation a payment is settled.
+	Delay time.Duration
+	// SuccessURL is returned as the invoice URL of redirect channels,
+	// since there is no provider page to send the customer to.
+	SuccessURL string
+	// Client sends the webhooks; nil uses a plain client.
+	Client *http.Client
+}
+
+type simulatedPayment struct {
+	referenceID string
+	paymentID   string
+	amount      int64
+	channel     ChannelCode
+	status      string
+	paidAt      *time.Time
+	timer       *time.Timer
+}
+
+// simulatedGateway stands in for Xendit outside production. Payments get
+// fake virtual account numbers, QR strings and payment codes, and after
+// a delay the gateway posts the same capture or failure webhook Xendit
+// would, so checkouts go through the real webhook handler.
+type simulatedGateway struct {
+	opts SimulatedOptions
+
+	mu       sync.Mutex
+	payments map[string]*simulatedPayment // by payment request ID
+	refunds  map[string]*RefundResponse
+}
+
+// ----------------- Constructor -----------------
+
+func NewSimulatedGateway(opts SimulatedOptions) (Gateway, error) {
+	gw, err := newSimulatedGateway(opts)
+	if err != nil {
+		return nil, err
+	}
+	logger.L().Warn("Using simulated payment gateway, no real payments are taken",
+		zap.String("outcome", gw.opts.Outcome),
+		zap.Duration("delay", gw.opts.Delay),
+	)
+	return gw, nil
+}
+
+func newSimulatedGateway(opts SimulatedOptions) (*simulatedGateway, error) {
+	opts.Outcome = strings.ToUpper(opts.Outcome)
+	switch opts.Outcome {
+	case "":
+		opts.Outcome = SimulatePaid
+	case SimulatePaid, SimulateFailed, SimulateNone:
+	default:
+		return nil, fmt.Errorf("unknown simulated payment outcome %q", opts.Outcome)
+	}
+	if opts.Outcome != SimulateNone && opts.WebhookURL == "" {
+		return nil, errors.New("simulated payments need a webhook url")
+	}
+	if opts.Client == nil {
+		opts.Client = &http.Client{Timeout: simulatedWebhookTimeout}
+	}
+
+	return &simulatedGateway{
+		opts:     opts,
+		payments: make(map[string]*simulatedPayment),
+		refunds:  make(map[string]*RefundResponse),
+	}, nil
+}
+
+// ----------------- CreateInvoice -----------------
+
+func (s *simulatedGateway) CreateInvoice(
+	ctx context.Context,
+	externalID string,
+	buyer BuyerInfo,
+	amount int64,
+	items []XenditItem,
+	channelCode ChannelCode,
+) (*PaymentResponse, error) {
+	p := &simulatedPayment{
+		referenceID: externalID,
+		paymentID:   "sim-py-" + randomHex(12),
+		amount:      amount,
+		channel:     channelCode,
+		status:      "PENDING",
+	}
+	requestID := simulatedPaymentPrefix + randomHex(12)
+
+	res := &PaymentResponse{
+		ProviderPaymentID: requestID,
+		ReferenceID:       externalID,
+		Amount:            amount,
+		Status:            p.status,
+		PaymentMethod:     channelCode,
+		ChannelCode:       string(channelCode),
+		ExpirationTime:    time.Now().Add(PaymentTTL),
+	}
+	switch channelCode {
+	case MethodBCAVA, MethodBNIVA, MethodMandiriVA:
+		res.PaymentCode = simulatedVANumber(channelCode)
+	case MethodQRIS:
+		res.PaymentCode = "SIMULATED-QRIS-" + requestID
+	case MethodAlfamart, MethodIndomaret:
+		res.PaymentCode = "SIM" + randomDigits(9)
+	default:
+		res.InvoiceURL = s.opts.SuccessURL
+	}
+	raw, err := json.Marshal(res)
+	if err != nil {
+		return nil, err
+	}
+	rawMsg := json.RawMessage(raw)
+	res.RawResponse = &rawMsg
+
+	s.mu.Lock()
+	s.payments[requestID] = p
+	if s.opts.Outcome != SimulateNone {
+		p.timer = time.AfterFunc(s.opts.Delay, func() { s.settle(requestID) })
+	}
+	s.mu.Unlock()
+
+	logger.FromCtx(ctx).Info("Simulated payment created",
+		zap.String("order_id", externalID),
+		zap.String("payment_id", requestID),
+		zap.String("channel", string(channelCode)),
+		zap.String("outcome", s.opts.Outcome),
+	)
+	return res, nil
+}
+
+// settle moves a pending payment to the configured outcome and posts its
+// webhook.
+func (s *simulatedGateway) settle(requestID string) {
+	s.mu.Lock()
+	p, ok := s.payments[requestID]
+	if !ok || p.status != "PENDING" {
+		s.mu.Unlock()
+		return
+	}
+	now := time.Now().UTC()
+	event, status := "payment.capture", "SUCCEEDED"
+	if s.opts.Outcome == SimulateFailed {
+		event, status = "payment.failure", "FAILED"
+		p.status = "FAILED"
+	} else {
+		p.status = "PAID"
+		p.paidAt = &now
+	}
+	body, err := json.Marshal(simulatedWebhook(event, status, requestID, p, now))
+	s.mu.Unlock()
+
+	log := logger.L().With(
+		zap.String("order_id", p.referenceID),
+		zap.String("payment_id", requestID),
+		zap.String("event", event),
+	)
+	if err != nil {
+		log.Error("Failed to marshal simulated webhook", zap.Error(err))
+		return
+	}
+
+	// The server may still be starting, or the order transaction still
+	// open, so a refused delivery is tried again.
+	for attempt := 1; attempt <= simulatedWebhookAttempts; attempt++ {
+		err = s.deliver(body)
+		if err == nil {
+			log.Info("Simulated webhook delivered")
+			return
+		}
+		if attempt < simulatedWebhookAttempts {
+			time.Sleep(simulatedWebhookBackoff)
+		}
+	}
+	log.Error("Simulated webhook not delivered", zap.Error(err))
+}
+
+func (s *simulatedGateway) deliver(body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, s.opts.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("x-callback-token", s.opts.CallbackToken)
+
+	resp, err := s.opts.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// simulatedWebhook builds the body of a Xendit v3 payment webhook.
+func simulatedWebhook(event, status, requestID string, p *simulatedPayment, now time.Time) map[string]interface{} {
+	data := map[string]interface{}{
+		"type":               "PAY",
+		"status":             status,
+		"country":            "ID",
+		"created":            now.Format(time.RFC3339Nano),
+		"updated":            now,
+		"currency":           "IDR",
+		"payment_id":         p.paymentID,
+		"business_id":        "simulated",
+		"channel_code":       string(p.channel),
+		"reference_id":       p.referenceID,
+		"capture_method":     "AUTOMATIC",
+		"request_amount":     p.amount,
+		"payment_request_id": requestID,
+	}
+	if status == "SUCCEEDED" {
+		data["captures"] = []map[string]interface{}{{
+			"capture_id":        "sim-cp-" + randomHex(12),
+			"capture_amount":    p.amount,
+			"capture_timestamp": now.Format(time.RFC3339),
+		}}
+	}
+	return map[string]interface{}{
+		"created":     now,
+		"business_id": "simulated",
+		"event":       event,
+		"api_version": "v3",
+		"data":        data,
+	}
+}
+
+// knows reports whether the gateway created a payment for externalID.
+func (s *simulatedGateway) knows(externalID string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, p := range s.payments {
+		if p.referenceID == externalID {
+			return true
+		}
+	}
+	return false
+}
+
+// ----------------- GetPaymentStatus -----------------
+
+func (s *simulatedGateway) GetPaymentStatus(ctx context.Context, externalID string) (*PaymentStatus, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, p := range s.payments {
+		if p.referenceID == externalID {
+			return &PaymentStatus{Status: p.status, PaidAt: p.paidAt}, nil
+		}
+	}
+	return nil, errors.New("invoice not found")
+}
+
+// ----------------- Cancel Payment -----------------
+
+// CancelPayment takes a payment request id, or an order reference to
+// cancel all of its pending payments. A payment request id that is no
+// longer pending fails as it would with Xendit.
+func (s *simulatedGateway) CancelPayment(ctx context.Context, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for requestID, p := range s.payments {
+		if requestID != id && p.referenceID != id {
+			continue
+		}
+		if p.status == "PENDING" {
+			if p.timer != nil {
+				p.timer.Stop()
+			}
+			p.status = "EXPIRED"
+			continue
+		}
+		if requestID == id {
+			if p.status == "PAID" {
+				return ErrPaymentAlreadyPaid
+			}
+			return ErrPaymentAlreadyExpired
+		}
+	}
+	return nil
+}
+
+// ----------------- Refund -----------------
+
+// Refund accepts every refund; GetRefund then reports it succeeded.
+func (s *simulatedGateway) Refund(
+	ctx context.Context,
+	paymentRequestID string,
+	referenceID string,
+	amount int64,
+	reason string,
+) (*RefundResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// The reference is the idempotency key, as with Xendit
+	for _, rf := range s.refunds {
+		if rf.ReferenceID == referenceID {
+			res := *rf
+			return &res, nil
+		}
+	}
+	rf := &RefundResponse{
+		ProviderRefundID: simulatedRefundPrefix + randomHex(12),
+		ReferenceID:      referenceID,
+		Amount:           amount,
+		Status:           "PENDING",
+	}
+	s.refunds[rf.ProviderRefundID] = rf
+	res := *rf
+	return &res, nil
+}
+
+func (s *simulatedGateway) GetRefund(ctx context.Context, providerRefundID string) (*RefundResponse, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rf, ok := s.refunds[providerRefundID]
+	if !ok {
+		return nil, errors.New("refund not found")
+	}
+	rf.Status = RefundStatusSucceeded
+	res := *rf
+	return &res, nil
+}
+
+// ----------------- Webhook Verification -----------------
+
+func (s *simulatedGateway) VerifySignature(r *http.Request) error {
+	if r.Header.Get("x-callback-token") != s.opts.CallbackToken {
+		return errors.New("invalid webhook signature")
+	}
+	return nil
+}
+
+// simulatedVANumber returns a virtual account number with the bank's
+// usual prefix.
+func simulatedVANumber(channel ChannelCode) string {
+	prefix := map[ChannelCode]string{
+		MethodBCAVA:     "39108",
+		MethodBNIVA:     "8808",
+		MethodMandiriVA: "88908",
+	}[channel]
+	return prefix + randomDigits(16-len(prefix))
+}
+
+func randomHex(n int) string {
+	b := make([]byte, n/2)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func randomDigits(n int) string {
+	var b strings.Builder
+	for range n {
+		d, _ := rand.Int(rand.Reader, big.NewInt(10))
+		b.WriteString(d.String())
+	}
+	return b.String()
+}
@@ -0,0 +1,187 @@
+package payment
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// webhookReceiver records the webhooks posted to it.
+func webhookReceiver(t *testing.T) (*httptest.Server, <-chan *http.Request, <-chan []byte) {
+	reqs := make(chan *http.Request, 1)
+	bodies := make(chan []byte, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		reqs <- r
+		bodies <- body
+	}))
+	t.Cleanup(srv.Close)
+	return srv, reqs, bodies
+}
+
+func TestSimulatedGateway_PaidWebhook(t *testing.T) {
+	srv, reqs, bodies := webhookReceiver(t)
+	gw, err := NewSimulatedGateway(SimulatedOptions{
+		WebhookURL:    srv.URL,
+		CallbackToken: "tok",
+		Outcome:       "paid",
+		Delay:         10 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	res, err := gw.CreateInvoice(context.Background(), "ORD-1", BuyerInfo{Name: "Budi"}, 150000, nil, MethodBCAVA)
+	require.NoError(t, err)
+	assert.Equal(t, "PENDING", res.Status)
+	assert.Len(t, res.PaymentCode, 16)
+	assert.True(t, strings.HasPrefix(res.PaymentCode, "39108"))
+	assert.Empty(t, res.InvoiceURL)
+
+	select {
+	case r := <-reqs:
+		assert.Equal(t, "tok", r.Header.Get("x-callback-token"))
+	case <-time.After(2 * time.Second):
+		t.Fatal("webhook not posted")
+	}
+
+	var payload WebhookPayload
+	require.NoError(t, json.Unmarshal(<-bodies, &payload))
+	assert.Equal(t, "payment.capture", payload.Event)
+	assert.Equal(t, "SUCCEEDED", payload.Data.Status)
+	assert.Equal(t, "ORD-1", payload.Data.ReferenceID)
+	assert.Equal(t, res.ProviderPaymentID, payload.Data.PaymentRequestID)
+	assert.Equal(t, "IDR", payload.Data.Currency)
+	assert.Equal(t, int64(150000), payload.Capture().Amount)
+
+	status, err := gw.GetPaymentStatus(context.Background(), "ORD-1")
+	require.NoError(t, err)
+	assert.Equal(t, "PAID", status.Status)
+	assert.NotNil(t, status.PaidAt)
+}
+
+func TestSimulatedGateway_FailedWebhook(t *testing.T) {
+	srv, _, bodies := webhookReceiver(t)
+	gw, err := NewSimulatedGateway(SimulatedOptions{WebhookURL: srv.URL, Outcome: SimulateFailed})
+	require.NoError(t, err)
+
+	res, err := gw.CreateInvoice(context.Background(), "ORD-2", BuyerInfo{}, 5000, nil, MethodQRIS)
+	require.NoError(t, err)
+	assert.Contains(t, res.PaymentCode, "QRIS")
+
+	var payload WebhookPayload
+	select {
+	case body := <-bodies:
+		require.NoError(t, json.Unmarshal(body, &payload))
+	case <-time.After(2 * time.Second):
+		t.Fatal("webhook not posted")
+	}
+	assert.Equal(t, "payment.failure", payload.Event)
+	assert.Equal(t, "FAILED", payload.Data.Status)
+	assert.Empty(t, payload.Data.Captures)
+}
+
+func TestSimulatedGateway_CancelStopsWebhook(t *testing.T) {
+	srv, _, bodies := webhookReceiver(t)
+	gw, err := NewSimulatedGateway(SimulatedOptions{WebhookURL: srv.URL, Delay: 50 * time.Millisecond})
+	require.NoError(t, err)
+
+	_, err = gw.CreateInvoice(context.Background(), "ORD-3", BuyerInfo{}, 5000, nil, MethodGOPAY)
+	require.NoError(t, err)
+	require.NoError(t, gw.CancelPayment(context.Background(), "ORD-3"))
+
+	select {
+	case <-bodies:
+		t.Fatal("webhook posted for a cancelled payment")
+	case <-time.After(200 * time.Millisecond):
+	}
+	status, err := gw.GetPaymentStatus(context.Background(), "ORD-3")
+	require.NoError(t, err)
+	assert.Equal(t, "EXPIRED", status.Status)
+}
+
+func TestSimulatedGateway_CancelSettled(t *testing.T) {
+	gw, err := NewSimulatedGateway(SimulatedOptions{Outcome: SimulateNone})
+	require.NoError(t, err)
+
+	res, err := gw.CreateInvoice(context.Background(), "ORD-4", BuyerInfo{}, 5000, nil, MethodGOPAY)
+	require.NoError(t, err)
+	require.NoError(t, gw.CancelPayment(context.Background(), res.ProviderPaymentID))
+
+	err = gw.CancelPayment(context.Background(), res.ProviderPaymentID)
+	assert.ErrorIs(t, err, ErrPaymentAlreadyExpired)
+}
+
+func TestSimulatedGateway_Refund(t *testing.T) {
+	gw, err := NewSimulatedGateway(SimulatedOptions{Outcome: SimulateNone})
+	require.NoError(t, err)
+
+	first, err := gw.Refund(context.Background(), "sim-pr-1", "rf-7", 5000, "REQUESTED_BY_CUSTOMER")
+	require.NoError(t, err)
+	assert.Equal(t, "PENDING", first.Status)
+
+	again, err := gw.Refund(context.Background(), "sim-pr-1", "rf-7", 5000, "REQUESTED_BY_CUSTOMER")
+	require.NoError(t, err)
+	assert.Equal(t, first.ProviderRefundID, again.ProviderRefundID)
+
+	got, err := gw.GetRefund(context.Background(), first.ProviderRefundID)
+	require.NoError(t, err)
+	assert.Equal(t, RefundStatusSucceeded, got.Status)
+}
+
+func TestNewSimulatedGateway_Invalid(t *testing.T) {
+	_, err := NewSimulatedGateway(SimulatedOptions{WebhookURL: "http://localhost", Outcome: "MAYBE"})
+	assert.ErrorContains(t, err, "unknown simulated payment outcome")
+
+	_, err = NewSimulatedGateway(SimulatedOptions{Outcome: SimulatePaid})
+	assert.ErrorContains(t, err, "webhook url")
+}
+
+// liveStub is a live gateway that records which calls reached it.
+type liveStub struct {
+	Gateway
+	calls []string
+}
+
+func (l *liveStub) CreateInvoice(ctx context.Context, externalID string, buyer BuyerInfo, amount int64, items []XenditItem, channelCode ChannelCode) (*PaymentResponse, error) {
+	l.calls = append(l.calls, "CreateInvoice "+externalID)
+	return &PaymentResponse{ProviderPaymentID: "pr-live"}, nil
+}
+
+func (l *liveStub) CancelPayment(ctx context.Context, externalID string) error {
+	l.calls = append(l.calls, "CancelPayment "+externalID)
+	return nil
+}
+
+func (l *liveStub) Refund(ctx context.Context, paymentRequestID, referenceID string, amount int64, reason string) (*RefundResponse, error) {
+	l.calls = append(l.calls, "Refund "+paymentRequestID)
+	return &RefundResponse{}, nil
+}
+
+func TestSyntheticRouter(t *testing.T) {
+	live := &liveStub{}
+	gw, err := NewSyntheticRouter(live, SimulatedOptions{Outcome: SimulateNone})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	res, err := gw.CreateInvoice(WithSynthetic(ctx), "ORD-SYN", BuyerInfo{}, 1000, nil, MethodBCAVA)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(res.ProviderPaymentID, simulatedPaymentPrefix))
+	require.NoError(t, gw.CancelPayment(ctx, "ORD-SYN"))
+	_, err = gw.Refund(ctx, res.ProviderPaymentID, "rf-1", 1000, "")
+	require.NoError(t, err)
+	assert.Empty(t, live.calls)
+
+	_, err = gw.CreateInvoice(ctx, "ORD-LIVE", BuyerInfo{}, 1000, nil, MethodBCAVA)
+	require.NoError(t, err)
+	require.NoError(t, gw.CancelPayment(ctx, "ORD-LIVE"))
+	_, err = gw.Refund(ctx, "pr-live", "rf-2", 1000, "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"CreateInvoice ORD-LIVE", "CancelPayment ORD-LIVE", "Refund pr-live"}, live.calls)
+}
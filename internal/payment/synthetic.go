@@ -0,0 +1,86 @@
+package payment
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+type syntheticKey struct{}
+
+// WithSynthetic marks ctx as a synthetic checkout, whose payments the
+// gateway from NewSyntheticRouter sends to its simulated gateway.
+func WithSynthetic(ctx context.Context) context.Context {
+	return context.WithValue(ctx, syntheticKey{}, true)
+}
+
+// IsSynthetic reports whether ctx belongs to a synthetic checkout.
+func IsSynthetic(ctx context.Context) bool {
+	v, _ := ctx.Value(syntheticKey{}).(bool)
+	return v
+}
+
+// syntheticRouter sends synthetic checkouts to a simulated gateway and
+// everything else to the live one. Later calls about a synthetic payment
+// or refund follow it by its order reference or ID prefix, so cancelling
+// or refunding a synthetic order never reaches the provider.
+type syntheticRouter struct {
+	live Gateway
+	sim  *simulatedGateway
+}
+
+// NewSyntheticRouter wraps live so synthetic checkouts, see WithSynthetic,
+// are paid through a simulated gateway built from opts.
+func NewSyntheticRouter(live Gateway, opts SimulatedOptions) (Gateway, error) {
+	sim, err := newSimulatedGateway(opts)
+	if err != nil {
+		return nil, err
+	}
+	return &syntheticRouter{live: live, sim: sim}, nil
+}
+
+func (r *syntheticRouter) CreateInvoice(
+	ctx context.Context,
+	externalID string,
+	buyer BuyerInfo,
+	amount int64,
+	items []XenditItem,
+	channelCode ChannelCode,
+) (*PaymentResponse, error) {
+	if IsSynthetic(ctx) {
+		return r.sim.CreateInvoice(ctx, externalID, buyer, amount, items, channelCode)
+	}
+	return r.live.CreateInvoice(ctx, externalID, buyer, amount, items, channelCode)
+}
+
+func (r *syntheticRouter) GetPaymentStatus(ctx context.Context, externalID string) (*PaymentStatus, error) {
+	if r.sim.knows(externalID) {
+		return r.sim.GetPaymentStatus(ctx, externalID)
+	}
+	return r.live.GetPaymentStatus(ctx, externalID)
+}
+
+func (r *syntheticRouter) CancelPayment(ctx context.Context, paymentRequestID string) error {
+	if strings.HasPrefix(paymentRequestID, simulatedPaymentPrefix) || r.sim.knows(paymentRequestID) {
+		return r.sim.CancelPayment(ctx, paymentRequestID)
+	}
+	return r.live.CancelPayment(ctx, paymentRequestID)
+}
+
+func (r *syntheticRouter) Refund(ctx context.Context, paymentRequestID, referenceID string, amount int64, reason string) (*RefundResponse, error) {
+	if strings.HasPrefix(paymentRequestID, simulatedPaymentPrefix) {
+		return r.sim.Refund(ctx, paymentRequestID, referenceID, amount, reason)
+	}
+	return r.live.Refund(ctx, paymentRequestID, referenceID, amount, reason)
+}
+
+func (r *syntheticRouter) GetRefund(ctx context.Context, providerRefundID string) (*RefundResponse, error) {
+	if strings.HasPrefix(providerRefundID, simulatedRefundPrefix) {
+		return r.sim.GetRefund(ctx, providerRefundID)
+	}
+	return r.live.GetRefund(ctx, providerRefundID)
+}
+
+func (r *syntheticRouter) VerifySignature(req *http.Request) error {
+	return r.live.VerifySignature(req)
+}
@@ -0,0 +1,95 @@
+package payment
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"time"
+)
+
+// TimelineEvent is one step in a payment's history.
+type TimelineEvent string
+
+const (
+	TimelineCreated  TimelineEvent = "CREATED"
+	TimelineVAIssued TimelineEvent = "VA_ISSUED"
+	TimelineExpired  TimelineEvent = "EXPIRED"
+	TimelineCaptured TimelineEvent = "CAPTURED"
+	TimelineFailed   TimelineEvent = "FAILED"
+	TimelineRefunded TimelineEvent = "REFUNDED"
+	TimelineDisputed TimelineEvent = "DISPUTED"
+)
+
+// Gateway events that end up on the timeline besides captures, failures
+// and disputes.
+const (
+	EventPaymentRequestExpiry = "payment_request.expiry"
+	EventRefundSucceeded      = "refund.succeeded"
+)
+
+// TimelineEntry is one step of the timeline. Amount is zero for steps that
+// move no money.
+type TimelineEntry struct {
+	Event  TimelineEvent
+	At     time.Time
+	Amount int64
+}
+
+// WebhookEvent is a stored webhook as the timeline reads it.
+type WebhookEvent struct {
+	EventType  string
+	Payload    json.RawMessage
+	ReceivedAt time.Time
+}
+
+// Timeline builds the history of p, oldest first. Creating the payment and
+// issuing its virtual account come from the payment row; everything after
+// comes from the webhooks the gateway sent. Webhooks that do not change
+// what the customer sees, and ones that cannot be read, are left out.
+func Timeline(p *Payment, events []*WebhookEvent) []TimelineEntry {
+	out := []TimelineEntry{{Event: TimelineCreated, At: p.CreatedAt, Amount: p.Amount}}
+	if p.PaymentCode != "" && isVirtualAccount(p.PaymentMethod) {
+		out = append(out, TimelineEntry{Event: TimelineVAIssued, At: p.CreatedAt})
+	}
+
+	for _, e := range events {
+		var payload WebhookPayload
+		if err := json.Unmarshal(e.Payload, &payload); err != nil {
+			continue
+		}
+
+		at := payload.Data.Updated
+		if at.IsZero() {
+			at = e.ReceivedAt
+		}
+
+		switch e.EventType {
+		case "payment.capture":
+			if payload.Data.Status != "SUCCEEDED" {
+				continue
+			}
+			c := payload.Capture()
+			if !c.PaidAt.IsZero() {
+				at = c.PaidAt
+			}
+			out = append(out, TimelineEntry{Event: TimelineCaptured, At: at, Amount: c.Amount})
+		case "payment.failed", "payment.failure":
+			out = append(out, TimelineEntry{Event: TimelineFailed, At: at})
+		case EventPaymentRequestExpiry:
+			out = append(out, TimelineEntry{Event: TimelineExpired, At: at})
+		case EventRefundSucceeded:
+			out = append(out, TimelineEntry{Event: TimelineRefunded, At: at, Amount: payload.RefundedAmount()})
+		case EventPaymentDispute, EventPaymentChargeback:
+			out = append(out, TimelineEntry{Event: TimelineDisputed, At: at, Amount: payload.DisputedAmount()})
+		}
+	}
+
+	slices.SortStableFunc(out, func(a, b TimelineEntry) int {
+		return a.At.Compare(b.At)
+	})
+	return out
+}
+
+func isVirtualAccount(method ChannelCode) bool {
+	return strings.HasSuffix(string(method), "_VIRTUAL_ACCOUNT")
+}
@@ -0,0 +1,78 @@
+package payment
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTimeline(t *testing.T) {
+	created := time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+	webhook := func(eventType, payload string, receivedAt time.Time) *WebhookEvent {
+		return &WebhookEvent{EventType: eventType, Payload: json.RawMessage(payload), ReceivedAt: receivedAt}
+	}
+
+	t.Run("VirtualAccountPaidAndRefunded", func(t *testing.T) {
+		p := &Payment{Amount: 150000, PaymentMethod: MethodBCAVA, PaymentCode: "8808123", CreatedAt: created}
+		events := []*WebhookEvent{
+			// Received out of order; the timeline follows the provider's times
+			webhook(EventRefundSucceeded,
+				`{"data":{"status":"SUCCEEDED","amount":50000,"updated":"2026-03-02T10:00:00Z"}}`,
+				created.Add(25*time.Hour)),
+			webhook("payment.capture",
+				`{"data":{"status":"SUCCEEDED","request_amount":150000,"updated":"2026-03-01T09:40:00Z",
+				"captures":[{"capture_amount":150000,"capture_timestamp":"2026-03-01T09:35:00Z"}]}}`,
+				created.Add(time.Hour)),
+		}
+
+		assert.Equal(t, []TimelineEntry{
+			{Event: TimelineCreated, At: created, Amount: 150000},
+			{Event: TimelineVAIssued, At: created},
+			{Event: TimelineCaptured, At: created.Add(40 * time.Minute), Amount: 150000},
+			{Event: TimelineRefunded, At: created.Add(25 * time.Hour), Amount: 50000},
+		}, Timeline(p, events))
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		p := &Payment{Amount: 150000, PaymentMethod: MethodQRIS, CreatedAt: created}
+		events := []*WebhookEvent{
+			// No provider time; falls back to when the webhook arrived
+			webhook(EventPaymentRequestExpiry, `{"data":{"status":"EXPIRED"}}`, created.Add(24*time.Hour)),
+		}
+
+		assert.Equal(t, []TimelineEntry{
+			{Event: TimelineCreated, At: created, Amount: 150000},
+			{Event: TimelineExpired, At: created.Add(24 * time.Hour)},
+		}, Timeline(p, events))
+	})
+
+	t.Run("SkipsUnknownAndUnreadable", func(t *testing.T) {
+		p := &Payment{Amount: 150000, CreatedAt: created}
+		events := []*WebhookEvent{
+			webhook("payment.capture", `{"data":{"status":"PENDING"}}`, created),
+			webhook("payment.authorization", `{"data":{"status":"SUCCEEDED"}}`, created),
+			webhook("payment.failure", `not json`, created),
+		}
+
+		assert.Equal(t, []TimelineEntry{
+			{Event: TimelineCreated, At: created, Amount: 150000},
+		}, Timeline(p, events))
+	})
+
+	t.Run("FailedAndDisputed", func(t *testing.T) {
+		p := &Payment{Amount: 150000, CreatedAt: created}
+		events := []*WebhookEvent{
+			webhook("payment.failure", `{"data":{"status":"FAILED","updated":"2026-03-01T09:10:00Z"}}`, created),
+			webhook(EventPaymentChargeback,
+				`{"data":{"request_amount":150000,"updated":"2026-03-05T00:00:00Z"}}`, created),
+		}
+
+		assert.Equal(t, []TimelineEntry{
+			{Event: TimelineCreated, At: created, Amount: 150000},
+			{Event: TimelineFailed, At: created.Add(10 * time.Minute)},
+			{Event: TimelineDisputed, At: time.Date(2026, 3, 5, 0, 0, 0, 0, time.UTC), Amount: 150000},
+		}, Timeline(p, events))
+	})
+}
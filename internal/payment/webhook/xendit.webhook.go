@@ -7,25 +7,48 @@ import (
 	"io"
 	"net/http"
 	"os"
+	"time"
 
+	"warimas-be/internal/dispute"
+	"warimas-be/internal/errreport"
 	"warimas-be/internal/logger"
+	"warimas-be/internal/maintenance"
 	"warimas-be/internal/order"
 	"warimas-be/internal/payment"
 
 	"go.uber.org/zap"
 )
 
+// DrainInterval is how often webhooks queued during maintenance are
+// applied.
+const DrainInterval = time.Minute
+
+const (
+	drainBatchSize = 100
+	drainGrace     = time.Minute
+)
+
 type Handler struct {
 	OrderSvc    order.Service
 	Gateway     payment.Gateway
 	PaymentRepo payment.Repository
+	DisputeSvc  dispute.Service
+	Maintenance maintenance.Service
 }
 
-func NewWebhookHandler(orderSvc order.Service, gateway payment.Gateway, paymentRepo payment.Repository) *Handler {
+func NewWebhookHandler(
+	orderSvc order.Service,
+	gateway payment.Gateway,
+	paymentRepo payment.Repository,
+	disputeSvc dispute.Service,
+	maintenanceSvc maintenance.Service,
+) *Handler {
 	return &Handler{
 		OrderSvc:    orderSvc,
 		Gateway:     gateway,
 		PaymentRepo: paymentRepo,
+		DisputeSvc:  disputeSvc,
+		Maintenance: maintenanceSvc,
 	}
 }
 
@@ -79,6 +102,7 @@ func (h *Handler) PaymentWebhookHandler(w http.ResponseWriter, r *http.Request)
 	)
 	if err != nil {
 		log.Error("Failed saving webhook", zap.Error(err))
+		errreport.Report(ctx, err, webhookTags(payload))
 		http.Error(w, "internal error", http.StatusInternalServerError)
 		return
 	}
@@ -88,24 +112,134 @@ func (h *Handler) PaymentWebhookHandler(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	// 6. Process webhook safely
+	// 6. During maintenance the webhook stays stored and unapplied;
+	// DrainQueued applies it once maintenance ends.
+	if h.paused(ctx) {
+		log.Info("Maintenance mode, webhook queued",
+			zap.Int64("webhook_id", webhookID),
+			zap.String("reference_id", payload.Data.ReferenceID),
+		)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	// 7. Apply
+	if err := h.apply(ctx, webhookID, payload); err != nil {
+		http.Error(w, "processing failed", http.StatusBadRequest)
+		return
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+// apply processes a stored webhook and records the outcome on it.
+func (h *Handler) apply(ctx context.Context, webhookID int64, payload payment.WebhookPayload) error {
+	log := logger.FromCtx(ctx)
+
+	// Fast path: gateway retry of a payment state already applied
+	if h.alreadyApplied(ctx, payload) {
+		log.Info("Payment state already applied, skipping",
+			zap.String("event", payload.Event),
+			zap.String("reference_id", payload.Data.ReferenceID),
+		)
+		_ = h.PaymentRepo.MarkWebhookProcessed(ctx, webhookID)
+		return nil
+	}
+
+	// Process webhook safely
 	if err := h.processPaymentEvent(ctx, payload); err != nil {
 		log.Error("Webhook processing failed", zap.Error(err))
+		// A payment the gateway took but we could not apply needs a human,
+		// whatever the cause.
+		errreport.Report(ctx, err, webhookTags(payload))
 
 		_ = h.PaymentRepo.MarkWebhookFailed(ctx, webhookID, err.Error())
-		http.Error(w, "processing failed", http.StatusBadRequest)
-		return
+		return err
 	}
 
-	// 7. Mark webhook processed
+	// Mark webhook processed
 	_ = h.PaymentRepo.MarkWebhookProcessed(ctx, webhookID)
 
 	log.Info("Webhook processed successfully",
 		zap.String("event", payload.Event),
 		zap.String("reference_id", payload.Data.ReferenceID),
 	)
+	return nil
+}
 
-	w.WriteHeader(http.StatusOK)
+// paused reports whether maintenance mode holds webhooks back. Webhooks
+// are applied when the switch cannot be read.
+func (h *Handler) paused(ctx context.Context) bool {
+	if h.Maintenance == nil {
+		return false
+	}
+	m, err := h.Maintenance.Current(ctx)
+	return err == nil && m.Enabled
+}
+
+// DrainQueued applies webhooks stored during maintenance, oldest first. It
+// skips anything received in the last drainGrace, which a live request may
+// still be applying, and does nothing while maintenance is on.
+func (h *Handler) DrainQueued(ctx context.Context) (int, error) {
+	if h.paused(ctx) {
+		return 0, nil
+	}
+
+	queued, err := h.PaymentRepo.ListQueuedWebhooks(ctx, "XENDIT", time.Now().Add(-drainGrace), drainBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	applied := 0
+	for _, q := range queued {
+		var payload payment.WebhookPayload
+		if err := json.Unmarshal(q.Payload, &payload); err != nil {
+			_ = h.PaymentRepo.MarkWebhookFailed(ctx, q.ID, "invalid payload: "+err.Error())
+			continue
+		}
+		if err := h.apply(ctx, q.ID, payload); err == nil {
+			applied++
+		}
+	}
+	return applied, nil
+}
+
+// webhookTags identifies a payment webhook in error reports.
+func webhookTags(payload payment.WebhookPayload) map[string]string {
+	return map[string]string{
+		"provider": "XENDIT",
+		"event":    payload.Event,
+		"order":    payload.Data.ReferenceID,
+	}
+}
+
+// alreadyApplied reports whether an earlier webhook already moved the same
+// payment request to the terminal state this one carries. Lookup errors fall
+// through to normal processing, which is idempotent on its own.
+func (h *Handler) alreadyApplied(ctx context.Context, payload payment.WebhookPayload) bool {
+	switch {
+	case payload.Event == "payment.capture" && payload.Data.Status == "SUCCEEDED":
+	case payload.Event == "payment.failed" || payload.Event == "payment.failure":
+	default:
+		return false
+	}
+
+	applied, err := h.PaymentRepo.HasAppliedWebhook(
+		ctx,
+		payment.ProviderXendit,
+		payload.Data.ReferenceID,
+		payload.Event,
+		payload.Data.PaymentRequestID,
+		payload.Data.Status,
+	)
+	if err != nil {
+		logger.FromCtx(ctx).Warn("failed to check applied webhooks",
+			zap.String("reference_id", payload.Data.ReferenceID),
+			zap.Error(err),
+		)
+		return false
+	}
+	return applied
 }
 
 func (h *Handler) processPaymentEvent(
@@ -121,11 +255,16 @@ func (h *Handler) processPaymentEvent(
 		zap.String("event", payload.Event),
 		zap.String("reference_id", ref),
 		zap.String("payment_request_id", payload.Data.PaymentRequestID),
-		zap.Int64("amount", payload.Data.RequestAmount),
+		zap.Float64("amount", payload.Data.RequestAmount),
 		zap.String("currency", payload.Data.Currency),
 		zap.String("status", payload.Data.Status),
 	)
 
+	// Disputes may cover part of the payment, so they skip the amount check
+	if payload.Event == payment.EventPaymentDispute || payload.Event == payment.EventPaymentChargeback {
+		return h.processDisputeEvent(ctx, payload)
+	}
+
 	// Lock payment/order row
 	order, err := h.OrderSvc.GetOrderForWebhook(ctx, ref)
 	if err != nil {
@@ -136,20 +275,9 @@ func (h *Handler) processPaymentEvent(
 		return err
 	}
 
-	// Validate money
-	if payload.Data.RequestAmount != int64(order.TotalAmount) {
-		log.Error("payment amount mismatch",
-			zap.String("reference_id", ref),
-			zap.Int64("webhook_amount", payload.Data.RequestAmount),
-			zap.Uint("db_amount", order.TotalAmount),
-		)
-		return fmt.Errorf(
-			"amount mismatch: webhook=%d db=%d",
-			payload.Data.RequestAmount,
-			order.TotalAmount,
-		)
-	}
-
+	// Validate money. The currency comes first, as the webhook amount is
+	// rounded by its rule (split payments only send the remainder to the
+	// gateway)
 	if payload.Data.Currency != order.Currency {
 		log.Error("payment currency mismatch",
 			zap.String("reference_id", ref),
@@ -159,6 +287,20 @@ func (h *Handler) processPaymentEvent(
 		return fmt.Errorf("currency mismatch")
 	}
 
+	if amount := payload.RequestedAmount(); amount != int64(order.GatewayAmount()) {
+		log.Error("payment amount mismatch",
+			zap.String("reference_id", ref),
+			zap.Float64("webhook_amount", payload.Data.RequestAmount),
+			zap.Int64("webhook_amount_rounded", amount),
+			zap.Uint("db_amount", order.GatewayAmount()),
+		)
+		return fmt.Errorf(
+			"amount mismatch: webhook=%d db=%d",
+			amount,
+			order.GatewayAmount(),
+		)
+	}
+
 	switch payload.Event {
 
 	case "payment.capture":
@@ -183,14 +325,17 @@ func (h *Handler) processPaymentEvent(
 			zap.String("order_id", order.ExternalID),
 		)
 
-		return h.OrderSvc.MarkAsPaid(
-			ctx,
-			ref,
-			payload.Data.PaymentRequestID,
-			payload.Data.PaymentID,
-		)
+		return h.OrderSvc.MarkAsPaid(ctx, ref, payload.Capture())
 
 	case "payment.failed", "payment.failure":
+		if order.Status == "FAILED" {
+			log.Info("order already failed, skipping",
+				zap.String("reference_id", ref),
+				zap.String("OrderExternalID", order.ExternalID),
+			)
+			return nil
+		}
+
 		if order.Status == "PAID" {
 			log.Error("invalid payment state transition PAID -> FAILED",
 				zap.String("reference_id", ref),
@@ -220,3 +365,33 @@ func (h *Handler) processPaymentEvent(
 
 	return nil
 }
+
+func (h *Handler) processDisputeEvent(
+	ctx context.Context,
+	payload payment.WebhookPayload,
+) error {
+	log := logger.FromCtx(ctx)
+
+	amount := payload.DisputedAmount()
+
+	var reason *string
+	if payload.Data.Reason != "" {
+		reason = &payload.Data.Reason
+	}
+
+	log.Info("recording payment dispute",
+		zap.String("event", payload.Event),
+		zap.String("dispute_id", payload.Data.DisputeID),
+		zap.String("payment_request_id", payload.Data.PaymentRequestID),
+		zap.Int64("amount", amount),
+	)
+
+	return h.DisputeSvc.OpenFromGateway(ctx, &dispute.OpenInput{
+		Provider:          payment.ProviderXendit,
+		ProviderDisputeID: payload.Data.DisputeID,
+		PaymentReference:  payload.Data.PaymentRequestID,
+		Amount:            amount,
+		Currency:          payload.Data.Currency,
+		Reason:            reason,
+	})
+}
@@ -8,8 +8,11 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 	"warimas-be/internal/address"
+	"warimas-be/internal/dispute"
 	"warimas-be/internal/graph/model"
+	"warimas-be/internal/maintenance"
 	"warimas-be/internal/order"
 	"warimas-be/internal/payment"
 
@@ -27,7 +30,7 @@ func TestHandler_PaymentWebhookHandler(t *testing.T) {
 		mockOrderSvc := new(MockOrderService)
 		mockPayRepo := new(MockPaymentRepository)
 		mockGateway := new(MockGateway)
-		h := NewWebhookHandler(mockOrderSvc, mockGateway, mockPayRepo)
+		h := NewWebhookHandler(mockOrderSvc, mockGateway, mockPayRepo, nil, nil)
 
 		payload := map[string]interface{}{
 			"event": "payment.capture",
@@ -38,7 +41,13 @@ func TestHandler_PaymentWebhookHandler(t *testing.T) {
 				"status":             "SUCCEEDED",
 				"request_amount":     100000,
 				"currency":           "IDR",
+				"channel_code":       "BCA_VIRTUAL_ACCOUNT",
 				"created":            "2024-01-01T10:00:00Z",
+				"updated":            "2024-01-01T10:05:00Z",
+				"captures": []map[string]interface{}{
+					{"capture_id": "cap-1", "capture_amount": 100000, "capture_timestamp": "2024-01-01T10:04:00Z"},
+				},
+				"fee": map[string]interface{}{"xendit_fee": 4000, "value_added_tax": 440},
 			},
 		}
 		body, _ := json.Marshal(payload)
@@ -49,6 +58,7 @@ func TestHandler_PaymentWebhookHandler(t *testing.T) {
 		// 1. Save Webhook (Not Duplicate)
 		mockPayRepo.On("SavePaymentWebhook", mock.Anything, "XENDIT", mock.Anything, "payment.capture", "ord-ref-1", mock.Anything, true).
 			Return(int64(1), false, nil)
+		mockPayRepo.On("HasAppliedWebhook", mock.Anything, "XENDIT", "ord-ref-1", mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Maybe()
 
 		// 2. Get Order Info for Validation
 		mockOrderInfo := &order.Order{
@@ -59,7 +69,14 @@ func TestHandler_PaymentWebhookHandler(t *testing.T) {
 		mockOrderSvc.On("GetOrderForWebhook", mock.Anything, "ord-ref-1").Return(mockOrderInfo, nil)
 
 		// 3. Mark as Paid
-		mockOrderSvc.On("MarkAsPaid", mock.Anything, "ord-ref-1", "pay-req-1", "pay-id-1").Return(nil)
+		mockOrderSvc.On("MarkAsPaid", mock.Anything, "ord-ref-1", payment.Capture{
+			ExternalReference: "pay-req-1",
+			ProviderPaymentID: "pay-id-1",
+			Amount:            100000,
+			Fee:               4440,
+			ChannelCode:       "BCA_VIRTUAL_ACCOUNT",
+			PaidAt:            time.Date(2024, 1, 1, 10, 5, 0, 0, time.UTC),
+		}).Return(nil)
 
 		// 4. Mark Processed
 		mockPayRepo.On("MarkWebhookProcessed", mock.Anything, int64(1)).Return(nil)
@@ -75,7 +92,7 @@ func TestHandler_PaymentWebhookHandler(t *testing.T) {
 		mockOrderSvc := new(MockOrderService)
 		mockPayRepo := new(MockPaymentRepository)
 		mockGateway := new(MockGateway)
-		h := NewWebhookHandler(mockOrderSvc, mockGateway, mockPayRepo)
+		h := NewWebhookHandler(mockOrderSvc, mockGateway, mockPayRepo, nil, nil)
 
 		payload := map[string]interface{}{
 			"event": "payment.failed",
@@ -96,6 +113,7 @@ func TestHandler_PaymentWebhookHandler(t *testing.T) {
 
 		mockPayRepo.On("SavePaymentWebhook", mock.Anything, "XENDIT", mock.Anything, "payment.failed", "ord-ref-1", mock.Anything, true).
 			Return(int64(2), false, nil)
+		mockPayRepo.On("HasAppliedWebhook", mock.Anything, "XENDIT", "ord-ref-1", mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Maybe()
 
 		mockOrderInfo := &order.Order{
 			TotalAmount: 100000,
@@ -116,7 +134,7 @@ func TestHandler_PaymentWebhookHandler(t *testing.T) {
 		mockOrderSvc := new(MockOrderService)
 		mockPayRepo := new(MockPaymentRepository)
 		mockGateway := new(MockGateway)
-		h := NewWebhookHandler(mockOrderSvc, mockGateway, mockPayRepo)
+		h := NewWebhookHandler(mockOrderSvc, mockGateway, mockPayRepo, nil, nil)
 
 		payload := map[string]interface{}{
 			"event": "payment.capture",
@@ -144,7 +162,7 @@ func TestHandler_PaymentWebhookHandler(t *testing.T) {
 		mockOrderSvc := new(MockOrderService)
 		mockPayRepo := new(MockPaymentRepository)
 		mockGateway := new(MockGateway)
-		h := NewWebhookHandler(mockOrderSvc, mockGateway, mockPayRepo)
+		h := NewWebhookHandler(mockOrderSvc, mockGateway, mockPayRepo, nil, nil)
 
 		req := httptest.NewRequest("POST", "/webhook/xendit", nil)
 		req.Header.Set("x-callback-token", "invalid-token")
@@ -159,7 +177,7 @@ func TestHandler_PaymentWebhookHandler(t *testing.T) {
 		mockOrderSvc := new(MockOrderService)
 		mockPayRepo := new(MockPaymentRepository)
 		mockGateway := new(MockGateway)
-		h := NewWebhookHandler(mockOrderSvc, mockGateway, mockPayRepo)
+		h := NewWebhookHandler(mockOrderSvc, mockGateway, mockPayRepo, nil, nil)
 
 		payload := map[string]interface{}{
 			"event": "payment.capture",
@@ -177,6 +195,7 @@ func TestHandler_PaymentWebhookHandler(t *testing.T) {
 
 		mockPayRepo.On("SavePaymentWebhook", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, true).
 			Return(int64(3), false, nil)
+		mockPayRepo.On("HasAppliedWebhook", mock.Anything, "XENDIT", "ord-ref-1", mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Maybe()
 
 		mockOrderInfo := &order.Order{
 			TotalAmount: 100000, // Expected
@@ -193,11 +212,58 @@ func TestHandler_PaymentWebhookHandler(t *testing.T) {
 		assert.Equal(t, http.StatusBadRequest, w.Code)
 	})
 
+	t.Run("Decimal_Amount_Rounded", func(t *testing.T) {
+		mockOrderSvc := new(MockOrderService)
+		mockPayRepo := new(MockPaymentRepository)
+		mockGateway := new(MockGateway)
+		h := NewWebhookHandler(mockOrderSvc, mockGateway, mockPayRepo, nil, nil)
+
+		payload := map[string]interface{}{
+			"event": "payment.capture",
+			"data": map[string]interface{}{
+				"payment_id":         "pay-id-1",
+				"payment_request_id": "pay-req-1",
+				"reference_id":       "ord-ref-1",
+				"status":             "SUCCEEDED",
+				"request_amount":     100000.5,
+				"currency":           "IDR",
+				"updated":            "2024-01-01T10:05:00Z",
+				"captures": []map[string]interface{}{
+					{"capture_id": "cap-1", "capture_amount": 100000.5},
+				},
+			},
+		}
+		body, _ := json.Marshal(payload)
+		req := httptest.NewRequest("POST", "/webhook/xendit", bytes.NewBuffer(body))
+		req.Header.Set("x-callback-token", validHeader)
+		w := httptest.NewRecorder()
+
+		mockPayRepo.On("SavePaymentWebhook", mock.Anything, "XENDIT", mock.Anything, "payment.capture", "ord-ref-1", mock.Anything, true).
+			Return(int64(6), false, nil)
+		mockPayRepo.On("HasAppliedWebhook", mock.Anything, "XENDIT", "ord-ref-1", mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Maybe()
+
+		mockOrderInfo := &order.Order{
+			TotalAmount: 100001,
+			Currency:    "IDR",
+			Status:      "PENDING",
+		}
+		mockOrderSvc.On("GetOrderForWebhook", mock.Anything, "ord-ref-1").Return(mockOrderInfo, nil)
+		mockOrderSvc.On("MarkAsPaid", mock.Anything, "ord-ref-1", mock.MatchedBy(func(c payment.Capture) bool {
+			return c.Amount == 100001
+		})).Return(nil)
+		mockPayRepo.On("MarkWebhookProcessed", mock.Anything, int64(6)).Return(nil)
+
+		h.PaymentWebhookHandler(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockOrderSvc.AssertExpectations(t)
+	})
+
 	t.Run("Invalid_Transition_PaidToFailed", func(t *testing.T) {
 		mockOrderSvc := new(MockOrderService)
 		mockPayRepo := new(MockPaymentRepository)
 		mockGateway := new(MockGateway)
-		h := NewWebhookHandler(mockOrderSvc, mockGateway, mockPayRepo)
+		h := NewWebhookHandler(mockOrderSvc, mockGateway, mockPayRepo, nil, nil)
 
 		payload := map[string]interface{}{
 			"event": "payment.failed",
@@ -215,6 +281,7 @@ func TestHandler_PaymentWebhookHandler(t *testing.T) {
 
 		mockPayRepo.On("SavePaymentWebhook", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, true).
 			Return(int64(4), false, nil)
+		mockPayRepo.On("HasAppliedWebhook", mock.Anything, "XENDIT", "ord-ref-1", mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Maybe()
 
 		mockOrderInfo := &order.Order{
 			TotalAmount: 100000,
@@ -234,7 +301,7 @@ func TestHandler_PaymentWebhookHandler(t *testing.T) {
 		mockOrderSvc := new(MockOrderService)
 		mockPayRepo := new(MockPaymentRepository)
 		mockGateway := new(MockGateway)
-		h := NewWebhookHandler(mockOrderSvc, mockGateway, mockPayRepo)
+		h := NewWebhookHandler(mockOrderSvc, mockGateway, mockPayRepo, nil, nil)
 
 		payload := map[string]interface{}{
 			"event": "payment.capture",
@@ -255,6 +322,7 @@ func TestHandler_PaymentWebhookHandler(t *testing.T) {
 
 		mockPayRepo.On("SavePaymentWebhook", mock.Anything, "XENDIT", mock.Anything, "payment.capture", "ord-ref-1", mock.Anything, true).
 			Return(int64(3), false, nil)
+		mockPayRepo.On("HasAppliedWebhook", mock.Anything, "XENDIT", "ord-ref-1", mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Maybe()
 
 		mockOrderInfo := &order.Order{
 			TotalAmount: 100000,
@@ -263,7 +331,7 @@ func TestHandler_PaymentWebhookHandler(t *testing.T) {
 		}
 		mockOrderSvc.On("GetOrderForWebhook", mock.Anything, "ord-ref-1").Return(mockOrderInfo, nil)
 
-		mockOrderSvc.On("MarkAsPaid", mock.Anything, "ord-ref-1", "pay-req-1", "pay-id-1").Return(errors.New("db error"))
+		mockOrderSvc.On("MarkAsPaid", mock.Anything, "ord-ref-1", basicCapture).Return(errors.New("db error"))
 
 		mockPayRepo.On("MarkWebhookFailed", mock.Anything, int64(3), "db error").Return(nil)
 
@@ -276,7 +344,7 @@ func TestHandler_PaymentWebhookHandler(t *testing.T) {
 		mockOrderSvc := new(MockOrderService)
 		mockPayRepo := new(MockPaymentRepository)
 		mockGateway := new(MockGateway)
-		h := NewWebhookHandler(mockOrderSvc, mockGateway, mockPayRepo)
+		h := NewWebhookHandler(mockOrderSvc, mockGateway, mockPayRepo, nil, nil)
 
 		payload := map[string]interface{}{
 			"event": "payment.created",
@@ -314,7 +382,7 @@ func TestHandler_PaymentWebhookHandler(t *testing.T) {
 		mockOrderSvc := new(MockOrderService)
 		mockPayRepo := new(MockPaymentRepository)
 		mockGateway := new(MockGateway)
-		h := NewWebhookHandler(mockOrderSvc, mockGateway, mockPayRepo)
+		h := NewWebhookHandler(mockOrderSvc, mockGateway, mockPayRepo, nil, nil)
 
 		req := httptest.NewRequest("POST", "/webhook/xendit", bytes.NewBufferString("{invalid-json"))
 		req.Header.Set("x-callback-token", validHeader)
@@ -329,7 +397,7 @@ func TestHandler_PaymentWebhookHandler(t *testing.T) {
 		mockOrderSvc := new(MockOrderService)
 		mockPayRepo := new(MockPaymentRepository)
 		mockGateway := new(MockGateway)
-		h := NewWebhookHandler(mockOrderSvc, mockGateway, mockPayRepo)
+		h := NewWebhookHandler(mockOrderSvc, mockGateway, mockPayRepo, nil, nil)
 
 		payload := map[string]interface{}{
 			"event": "payment.capture",
@@ -356,7 +424,7 @@ func TestHandler_PaymentWebhookHandler(t *testing.T) {
 		mockOrderSvc := new(MockOrderService)
 		mockPayRepo := new(MockPaymentRepository)
 		mockGateway := new(MockGateway)
-		h := NewWebhookHandler(mockOrderSvc, mockGateway, mockPayRepo)
+		h := NewWebhookHandler(mockOrderSvc, mockGateway, mockPayRepo, nil, nil)
 
 		payload := map[string]interface{}{
 			"event": "payment.capture",
@@ -373,6 +441,7 @@ func TestHandler_PaymentWebhookHandler(t *testing.T) {
 
 		mockPayRepo.On("SavePaymentWebhook", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, true).
 			Return(int64(10), false, nil)
+		mockPayRepo.On("HasAppliedWebhook", mock.Anything, "XENDIT", "ord-ref-1", mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Maybe()
 
 		mockOrderSvc.On("GetOrderForWebhook", mock.Anything, "ord-ref-1").Return(nil, errors.New("order not found"))
 
@@ -387,7 +456,7 @@ func TestHandler_PaymentWebhookHandler(t *testing.T) {
 		mockOrderSvc := new(MockOrderService)
 		mockPayRepo := new(MockPaymentRepository)
 		mockGateway := new(MockGateway)
-		h := NewWebhookHandler(mockOrderSvc, mockGateway, mockPayRepo)
+		h := NewWebhookHandler(mockOrderSvc, mockGateway, mockPayRepo, nil, nil)
 
 		payload := map[string]interface{}{
 			"event": "payment.capture",
@@ -406,6 +475,7 @@ func TestHandler_PaymentWebhookHandler(t *testing.T) {
 
 		mockPayRepo.On("SavePaymentWebhook", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, true).
 			Return(int64(11), false, nil)
+		mockPayRepo.On("HasAppliedWebhook", mock.Anything, "XENDIT", "ord-ref-1", mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Maybe()
 
 		mockOrderInfo := &order.Order{
 			TotalAmount: 100000,
@@ -424,7 +494,7 @@ func TestHandler_PaymentWebhookHandler(t *testing.T) {
 		mockOrderSvc := new(MockOrderService)
 		mockPayRepo := new(MockPaymentRepository)
 		mockGateway := new(MockGateway)
-		h := NewWebhookHandler(mockOrderSvc, mockGateway, mockPayRepo)
+		h := NewWebhookHandler(mockOrderSvc, mockGateway, mockPayRepo, nil, nil)
 
 		payload := map[string]interface{}{
 			"event": "payment.capture",
@@ -444,6 +514,7 @@ func TestHandler_PaymentWebhookHandler(t *testing.T) {
 
 		mockPayRepo.On("SavePaymentWebhook", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, true).
 			Return(int64(12), false, nil)
+		mockPayRepo.On("HasAppliedWebhook", mock.Anything, "XENDIT", "ord-ref-1", mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Maybe()
 
 		mockOrderInfo := &order.Order{
 			TotalAmount: 100000,
@@ -465,7 +536,7 @@ func TestHandler_PaymentWebhookHandler(t *testing.T) {
 		mockOrderSvc := new(MockOrderService)
 		mockPayRepo := new(MockPaymentRepository)
 		mockGateway := new(MockGateway)
-		h := NewWebhookHandler(mockOrderSvc, mockGateway, mockPayRepo)
+		h := NewWebhookHandler(mockOrderSvc, mockGateway, mockPayRepo, nil, nil)
 
 		payload := map[string]interface{}{
 			"event": "payment.capture",
@@ -485,6 +556,7 @@ func TestHandler_PaymentWebhookHandler(t *testing.T) {
 
 		mockPayRepo.On("SavePaymentWebhook", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, true).
 			Return(int64(13), false, nil)
+		mockPayRepo.On("HasAppliedWebhook", mock.Anything, "XENDIT", "ord-ref-1", mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Maybe()
 
 		mockOrderInfo := &order.Order{
 			TotalAmount: 100000,
@@ -501,6 +573,212 @@ func TestHandler_PaymentWebhookHandler(t *testing.T) {
 		assert.Equal(t, http.StatusOK, w.Code)
 		mockOrderSvc.AssertNotCalled(t, "MarkAsPaid")
 	})
+	t.Run("Already_Applied_FastPath", func(t *testing.T) {
+		mockOrderSvc := new(MockOrderService)
+		mockPayRepo := new(MockPaymentRepository)
+		mockGateway := new(MockGateway)
+		h := NewWebhookHandler(mockOrderSvc, mockGateway, mockPayRepo, nil, nil)
+
+		payload := map[string]interface{}{
+			"event": "payment.capture",
+			"data": map[string]interface{}{
+				"payment_id":         "pay-id-1",
+				"payment_request_id": "pay-req-1",
+				"reference_id":       "ord-ref-1",
+				"status":             "SUCCEEDED",
+				"request_amount":     100000,
+				"currency":           "IDR",
+				"created":            "2024-01-01T10:05:00Z",
+			},
+		}
+		body, _ := json.Marshal(payload)
+		req := httptest.NewRequest("POST", "/webhook/xendit", bytes.NewBuffer(body))
+		req.Header.Set("x-callback-token", validHeader)
+		w := httptest.NewRecorder()
+
+		mockPayRepo.On("SavePaymentWebhook", mock.Anything, "XENDIT", mock.Anything, "payment.capture", "ord-ref-1", mock.Anything, true).
+			Return(int64(9), false, nil)
+		mockPayRepo.On("HasAppliedWebhook", mock.Anything, "XENDIT", "ord-ref-1", "payment.capture", "pay-req-1", "SUCCEEDED").
+			Return(true, nil)
+		mockPayRepo.On("MarkWebhookProcessed", mock.Anything, int64(9)).Return(nil)
+
+		h.PaymentWebhookHandler(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockOrderSvc.AssertNotCalled(t, "GetOrderForWebhook", mock.Anything, mock.Anything)
+		mockOrderSvc.AssertNotCalled(t, "MarkAsPaid", mock.Anything, mock.Anything, mock.Anything)
+		mockPayRepo.AssertExpectations(t)
+	})
+
+	t.Run("Order_Already_Failed", func(t *testing.T) {
+		mockOrderSvc := new(MockOrderService)
+		mockPayRepo := new(MockPaymentRepository)
+		mockGateway := new(MockGateway)
+		h := NewWebhookHandler(mockOrderSvc, mockGateway, mockPayRepo, nil, nil)
+
+		payload := map[string]interface{}{
+			"event": "payment.failed",
+			"data": map[string]interface{}{
+				"payment_id":         "pay-id-1",
+				"payment_request_id": "pay-req-1",
+				"reference_id":       "ord-ref-1",
+				"status":             "FAILED",
+				"request_amount":     100000,
+				"currency":           "IDR",
+				"created":            "2024-01-01T10:00:00Z",
+			},
+		}
+		body, _ := json.Marshal(payload)
+		req := httptest.NewRequest("POST", "/webhook/xendit", bytes.NewBuffer(body))
+		req.Header.Set("x-callback-token", validHeader)
+		w := httptest.NewRecorder()
+
+		mockPayRepo.On("SavePaymentWebhook", mock.Anything, "XENDIT", mock.Anything, "payment.failed", "ord-ref-1", mock.Anything, true).
+			Return(int64(10), false, nil)
+		mockPayRepo.On("HasAppliedWebhook", mock.Anything, "XENDIT", "ord-ref-1", "payment.failed", "pay-req-1", "FAILED").
+			Return(false, errors.New("db error"))
+		mockOrderSvc.On("GetOrderForWebhook", mock.Anything, "ord-ref-1").Return(&order.Order{
+			TotalAmount: 100000,
+			Currency:    "IDR",
+			Status:      "FAILED",
+		}, nil)
+		mockPayRepo.On("MarkWebhookProcessed", mock.Anything, int64(10)).Return(nil)
+
+		h.PaymentWebhookHandler(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockOrderSvc.AssertNotCalled(t, "MarkAsFailed", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Dispute_Recorded", func(t *testing.T) {
+		mockOrderSvc := new(MockOrderService)
+		mockPayRepo := new(MockPaymentRepository)
+		mockDisputeSvc := new(MockDisputeService)
+		h := NewWebhookHandler(mockOrderSvc, new(MockGateway), mockPayRepo, mockDisputeSvc, nil)
+
+		payload := map[string]interface{}{
+			"event": "payment.chargeback",
+			"data": map[string]interface{}{
+				"payment_id":         "pay-id-1",
+				"payment_request_id": "pay-req-1",
+				"reference_id":       "ord-ref-1",
+				"dispute_id":         "dsp-1",
+				"dispute_amount":     40000,
+				"request_amount":     100000,
+				"currency":           "IDR",
+				"reason":             "FRAUDULENT",
+				"created":            "2024-01-05T10:00:00Z",
+			},
+		}
+		body, _ := json.Marshal(payload)
+		req := httptest.NewRequest("POST", "/webhook/xendit", bytes.NewBuffer(body))
+		req.Header.Set("x-callback-token", validHeader)
+		w := httptest.NewRecorder()
+
+		mockPayRepo.On("SavePaymentWebhook", mock.Anything, "XENDIT", mock.Anything, "payment.chargeback", "ord-ref-1", mock.Anything, true).
+			Return(int64(20), false, nil)
+		mockDisputeSvc.On("OpenFromGateway", mock.Anything, mock.MatchedBy(func(in *dispute.OpenInput) bool {
+			return in.ProviderDisputeID == "dsp-1" &&
+				in.PaymentReference == "pay-req-1" &&
+				in.Amount == 40000 &&
+				in.Reason != nil && *in.Reason == "FRAUDULENT"
+		})).Return(nil)
+		mockPayRepo.On("MarkWebhookProcessed", mock.Anything, int64(20)).Return(nil)
+
+		h.PaymentWebhookHandler(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockDisputeSvc.AssertExpectations(t)
+		// Partial disputes must not hit the full-amount validation
+		mockOrderSvc.AssertNotCalled(t, "GetOrderForWebhook", mock.Anything, mock.Anything)
+	})
+}
+
+func TestHandler_Maintenance(t *testing.T) {
+	t.Setenv("XENDIT_WEBHOOK_TOKEN", "secret-token")
+	on := stubMaintenance{mode: &maintenance.Mode{Enabled: true}}
+
+	payload := map[string]interface{}{
+		"event": "payment.capture",
+		"data": map[string]interface{}{
+			"payment_id":         "pay-id-1",
+			"payment_request_id": "pay-req-1",
+			"reference_id":       "ord-ref-1",
+			"status":             "SUCCEEDED",
+			"request_amount":     100000,
+			"currency":           "IDR",
+			"created":            "2024-01-01T10:00:00Z",
+		},
+	}
+	body, _ := json.Marshal(payload)
+
+	t.Run("Webhook_Queued", func(t *testing.T) {
+		mockOrderSvc := new(MockOrderService)
+		mockPayRepo := new(MockPaymentRepository)
+		h := NewWebhookHandler(mockOrderSvc, new(MockGateway), mockPayRepo, nil, on)
+
+		mockPayRepo.On("SavePaymentWebhook", mock.Anything, "XENDIT", mock.Anything, "payment.capture", "ord-ref-1", mock.Anything, true).
+			Return(int64(1), false, nil)
+
+		req := httptest.NewRequest("POST", "/webhook/xendit", bytes.NewBuffer(body))
+		req.Header.Set("x-callback-token", "secret-token")
+		w := httptest.NewRecorder()
+
+		h.PaymentWebhookHandler(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockPayRepo.AssertExpectations(t)
+		mockPayRepo.AssertNotCalled(t, "MarkWebhookProcessed", mock.Anything, mock.Anything)
+		mockOrderSvc.AssertNotCalled(t, "MarkAsPaid", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Drain_SkippedWhilePaused", func(t *testing.T) {
+		mockPayRepo := new(MockPaymentRepository)
+		h := NewWebhookHandler(new(MockOrderService), new(MockGateway), mockPayRepo, nil, on)
+
+		n, err := h.DrainQueued(context.Background())
+
+		assert.NoError(t, err)
+		assert.Zero(t, n)
+		mockPayRepo.AssertNotCalled(t, "ListQueuedWebhooks", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Drain_AppliesQueued", func(t *testing.T) {
+		mockOrderSvc := new(MockOrderService)
+		mockPayRepo := new(MockPaymentRepository)
+		h := NewWebhookHandler(mockOrderSvc, new(MockGateway), mockPayRepo, nil, stubMaintenance{mode: &maintenance.Mode{}})
+
+		mockPayRepo.On("ListQueuedWebhooks", mock.Anything, "XENDIT", mock.Anything, int32(drainBatchSize)).
+			Return([]*payment.QueuedWebhook{
+				{ID: 1, Payload: body},
+				{ID: 2, Payload: json.RawMessage(`{`)},
+			}, nil)
+		mockPayRepo.On("HasAppliedWebhook", mock.Anything, "XENDIT", "ord-ref-1", mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Maybe()
+		mockOrderSvc.On("GetOrderForWebhook", mock.Anything, "ord-ref-1").
+			Return(&order.Order{TotalAmount: 100000, Currency: "IDR", Status: "PENDING"}, nil)
+		mockOrderSvc.On("MarkAsPaid", mock.Anything, "ord-ref-1", basicCapture).Return(nil)
+		mockPayRepo.On("MarkWebhookProcessed", mock.Anything, int64(1)).Return(nil)
+		mockPayRepo.On("MarkWebhookFailed", mock.Anything, int64(2), mock.Anything).Return(nil)
+
+		n, err := h.DrainQueued(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+		mockOrderSvc.AssertExpectations(t)
+		mockPayRepo.AssertExpectations(t)
+	})
+}
+
+type stubMaintenance struct {
+	mode *maintenance.Mode
+}
+
+func (s stubMaintenance) Current(context.Context) (*maintenance.Mode, error) {
+	return s.mode, nil
+}
+
+func (s stubMaintenance) Set(context.Context, maintenance.SetInput) (*maintenance.Mode, error) {
+	return s.mode, nil
 }
 
 // --- Mocks ---
@@ -509,8 +787,16 @@ type MockOrderService struct {
 	mock.Mock
 }
 
-func (m *MockOrderService) MarkAsPaid(ctx context.Context, refID, payReqID, provID string) error {
-	args := m.Called(ctx, refID, payReqID, provID)
+// basicCapture is what a capture webhook without captures or a channel
+// settles.
+var basicCapture = payment.Capture{
+	ExternalReference: "pay-req-1",
+	ProviderPaymentID: "pay-id-1",
+	Amount:            100000,
+}
+
+func (m *MockOrderService) MarkAsPaid(ctx context.Context, refID string, capture payment.Capture) error {
+	args := m.Called(ctx, refID, capture)
 	return args.Error(0)
 }
 
@@ -518,6 +804,15 @@ func (m *MockOrderService) MarkAsFailed(ctx context.Context, refID, payReqID, pr
 	args := m.Called(ctx, refID, payReqID, provID)
 	return args.Error(0)
 }
+func (m *MockOrderService) ExpireUnpaidOrders(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockOrderService) ExpireSessions(ctx context.Context) (int, error) {
+	args := m.Called(ctx)
+	return args.Int(0), args.Error(1)
+}
 
 // Stubs to satisfy order.Service interface
 func (m *MockOrderService) CreateFromSession(ctx context.Context, externalID string) (*order.Order, error) {
@@ -526,7 +821,7 @@ func (m *MockOrderService) CreateFromSession(ctx context.Context, externalID str
 func (m *MockOrderService) OrderToPaymentProcess(ctx context.Context, session *order.CheckoutSession, externalID string, orderId uint) (*payment.PaymentResponse, error) {
 	return nil, nil
 }
-func (m *MockOrderService) GetOrders(ctx context.Context, filter *order.OrderFilterInput, sort *order.OrderSortInput, limit int32, page int32) ([]*order.Order, int64, map[uuid.UUID][]address.Address, error) {
+func (m *MockOrderService) GetOrders(ctx context.Context, filter *order.OrderFilterInput, sort *order.OrderSortInput, limit int32, page int32) ([]*order.Order, int64, map[uuid.UUID]*address.Address, error) {
 	return nil, 0, nil, nil
 }
 func (m *MockOrderService) GetOrderDetail(ctx context.Context, orderID uint) (*order.Order, *address.Address, error) {
@@ -541,13 +836,31 @@ func (m *MockOrderService) UpdateOrderStatus(ctx context.Context, orderID uint,
 func (m *MockOrderService) CreateSession(ctx context.Context, input model.CreateCheckoutSessionInput) (*order.CheckoutSession, error) {
 	return nil, nil
 }
-func (m *MockOrderService) UpdateSessionAddress(ctx context.Context, externalID string, addressID string, guestID *string) error {
+func (m *MockOrderService) UpdateSessionAddress(ctx context.Context, externalID string, addressID string) error {
 	return nil
 }
-func (m *MockOrderService) UpdateSessionPaymentMethod(ctx context.Context, externalID string, paymentMethod payment.ChannelCode, guestID *string) error {
+func (m *MockOrderService) UpdateSessionPaymentMethod(ctx context.Context, externalID string, paymentMethod payment.ChannelCode) error {
 	return nil
 }
-func (m *MockOrderService) ConfirmSession(ctx context.Context, sessionID string) (*string, error) {
+func (m *MockOrderService) ShippingOptions(ctx context.Context, externalID string) ([]*order.ShippingOption, error) {
+	return nil, nil
+}
+func (m *MockOrderService) UpdateSessionShippingMethod(ctx context.Context, externalID string, method order.ShippingMethod, pickup *order.PickupChoice, deliverySlotID *int32) (*order.CheckoutSession, error) {
+	return nil, nil
+}
+func (m *MockOrderService) UpdateSessionInsurance(ctx context.Context, externalID string, insured bool) (*order.CheckoutSession, error) {
+	return nil, nil
+}
+func (m *MockOrderService) ApplySessionWallet(ctx context.Context, externalID string, amount int) error {
+	return nil
+}
+func (m *MockOrderService) ApplySessionCoupon(ctx context.Context, externalID string, code string) (int, error) {
+	return 0, nil
+}
+func (m *MockOrderService) ApplySessionPoints(ctx context.Context, externalID string, points int) (int, error) {
+	return 0, nil
+}
+func (m *MockOrderService) ConfirmSession(ctx context.Context, sessionID string, acceptedPolicyIDs []int32) (*string, error) {
 	return nil, nil
 }
 func (m *MockOrderService) GetSession(ctx context.Context, externalID string) (*order.CheckoutSession, error) {
@@ -568,6 +881,200 @@ func (m *MockOrderService) GetOrderForWebhook(ctx context.Context, externalID st
 	return args.Get(0).(*order.Order), args.Error(1)
 }
 
+func (m *MockOrderService) CreateAdminOrder(ctx context.Context, input model.CreateAdminOrderInput) (*order.Order, *payment.PaymentResponse, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*order.Order), args.Get(1).(*payment.PaymentResponse), args.Error(2)
+}
+
+func (m *MockOrderService) GetActiveSession(ctx context.Context) (*order.CheckoutSession, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.CheckoutSession), args.Error(1)
+}
+
+func (m *MockOrderService) ListSessionEvents(ctx context.Context, externalID string) ([]*order.SessionEvent, error) {
+	args := m.Called(ctx, externalID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*order.SessionEvent), args.Error(1)
+}
+
+func (m *MockOrderService) UpdateSessionItemQuantity(ctx context.Context, externalID, itemID string, quantity int) (*order.CheckoutSession, error) {
+	args := m.Called(ctx, externalID, itemID, quantity)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.CheckoutSession), args.Error(1)
+}
+
+func (m *MockOrderService) RemoveSessionItem(ctx context.Context, externalID, itemID string) (*order.CheckoutSession, error) {
+	args := m.Called(ctx, externalID, itemID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.CheckoutSession), args.Error(1)
+}
+
+func (m *MockOrderService) CheckoutRules(ctx context.Context, all bool) ([]*order.CheckoutRule, error) {
+	args := m.Called(ctx, all)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*order.CheckoutRule), args.Error(1)
+}
+
+func (m *MockOrderService) SetCheckoutRule(ctx context.Context, input model.SetCheckoutRuleInput) (*order.CheckoutRule, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.CheckoutRule), args.Error(1)
+}
+
+func (m *MockOrderService) PickupLocations(ctx context.Context, all bool) ([]*order.PickupLocation, error) {
+	args := m.Called(ctx, all)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*order.PickupLocation), args.Error(1)
+}
+
+func (m *MockOrderService) SetPickupLocation(ctx context.Context, input model.SetPickupLocationInput) (*order.PickupLocation, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.PickupLocation), args.Error(1)
+}
+
+func (m *MockOrderService) PickupSlots(ctx context.Context, locationID int32) ([]order.PickupSlot, error) {
+	args := m.Called(ctx, locationID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]order.PickupSlot), args.Error(1)
+}
+
+func (m *MockOrderService) VerifyPickupCode(ctx context.Context, orderID uint, code string) error {
+	args := m.Called(ctx, orderID, code)
+	return args.Error(0)
+}
+
+func (m *MockOrderService) DeliverySlots(ctx context.Context, externalID string) ([]*order.DeliverySlotOption, error) {
+	args := m.Called(ctx, externalID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*order.DeliverySlotOption), args.Error(1)
+}
+
+func (m *MockOrderService) AdminDeliverySlots(ctx context.Context, region *string) ([]*order.DeliverySlot, error) {
+	args := m.Called(ctx, region)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*order.DeliverySlot), args.Error(1)
+}
+
+func (m *MockOrderService) SetDeliverySlot(ctx context.Context, input model.SetDeliverySlotInput) (*order.DeliverySlot, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.DeliverySlot), args.Error(1)
+}
+
+func (m *MockOrderService) CurrentPolicies(ctx context.Context) ([]*order.Policy, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*order.Policy), args.Error(1)
+}
+
+func (m *MockOrderService) PolicyHistory(ctx context.Context, kind order.PolicyKind) ([]*order.Policy, error) {
+	args := m.Called(ctx, kind)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*order.Policy), args.Error(1)
+}
+
+func (m *MockOrderService) PublishPolicy(ctx context.Context, input model.PublishPolicyInput) (*order.Policy, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.Policy), args.Error(1)
+}
+
+func (m *MockOrderService) CancelOrder(ctx context.Context, orderID uint, reasonID int32, note *string) error {
+	args := m.Called(ctx, orderID, reasonID, note)
+	return args.Error(0)
+}
+
+func (m *MockOrderService) OrderReasons(ctx context.Context, kind *order.ReasonKind, all bool) ([]*order.Reason, error) {
+	args := m.Called(ctx, kind, all)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*order.Reason), args.Error(1)
+}
+
+func (m *MockOrderService) SetOrderReason(ctx context.Context, input model.SetOrderReasonInput) (*order.Reason, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.Reason), args.Error(1)
+}
+
+func (m *MockOrderService) OrderReasonStats(ctx context.Context, kind order.ReasonKind, from, to time.Time) ([]*order.ReasonStat, error) {
+	args := m.Called(ctx, kind, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*order.ReasonStat), args.Error(1)
+}
+
+func (m *MockOrderService) RequestOrderAdjustment(ctx context.Context, input model.RequestOrderAdjustmentInput) (*order.OrderAdjustment, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.OrderAdjustment), args.Error(1)
+}
+
+func (m *MockOrderService) ApproveOrderAdjustment(ctx context.Context, id int64) (*order.OrderAdjustment, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.OrderAdjustment), args.Error(1)
+}
+
+func (m *MockOrderService) RejectOrderAdjustment(ctx context.Context, id int64) (*order.OrderAdjustment, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.OrderAdjustment), args.Error(1)
+}
+
+func (m *MockOrderService) OrderAdjustments(ctx context.Context, orderID uint) ([]*order.OrderAdjustment, error) {
+	args := m.Called(ctx, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*order.OrderAdjustment), args.Error(1)
+}
+
 type MockPaymentRepository struct {
 	mock.Mock
 }
@@ -587,6 +1094,27 @@ func (m *MockPaymentRepository) MarkWebhookFailed(ctx context.Context, id int64,
 	return args.Error(0)
 }
 
+func (m *MockPaymentRepository) HasAppliedWebhook(ctx context.Context, provider, externalID, eventType, paymentRequestID, status string) (bool, error) {
+	args := m.Called(ctx, provider, externalID, eventType, paymentRequestID, status)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockPaymentRepository) ListQueuedWebhooks(ctx context.Context, provider string, receivedBefore time.Time, limit int32) ([]*payment.QueuedWebhook, error) {
+	args := m.Called(ctx, provider, receivedBefore, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*payment.QueuedWebhook), args.Error(1)
+}
+
+func (m *MockPaymentRepository) ListWebhookEvents(ctx context.Context, provider, orderExternalID, paymentRequestID string) ([]*payment.WebhookEvent, error) {
+	args := m.Called(ctx, provider, orderExternalID, paymentRequestID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*payment.WebhookEvent), args.Error(1)
+}
+
 // Stubs
 func (m *MockPaymentRepository) SavePayment(ctx context.Context, p *payment.Payment) error {
 	return nil
@@ -597,6 +1125,12 @@ func (m *MockPaymentRepository) UpdatePaymentStatus(ctx context.Context, eid, st
 func (m *MockPaymentRepository) GetPaymentByOrder(ctx context.Context, oid uint) (*payment.Payment, error) {
 	return nil, nil
 }
+func (m *MockPaymentRepository) GetPaymentsByOrder(ctx context.Context, oid uint) ([]*payment.Payment, error) {
+	return nil, nil
+}
+func (m *MockPaymentRepository) MarkPaymentPaid(ctx context.Context, c payment.Capture) error {
+	return nil
+}
 
 type MockGateway struct {
 	mock.Mock
@@ -618,3 +1152,24 @@ func (m *MockGateway) CancelPayment(ctx context.Context, extID string) error {
 	args := m.Called(extID)
 	return args.Error(0)
 }
+func (m *MockGateway) Refund(ctx context.Context, prID, refID string, amt int64, reason string) (*payment.RefundResponse, error) {
+	return nil, nil
+}
+func (m *MockGateway) GetRefund(ctx context.Context, providerRefundID string) (*payment.RefundResponse, error) {
+	return nil, nil
+}
+
+type MockDisputeService struct {
+	mock.Mock
+}
+
+func (m *MockDisputeService) OpenFromGateway(ctx context.Context, in *dispute.OpenInput) error {
+	args := m.Called(ctx, in)
+	return args.Error(0)
+}
+func (m *MockDisputeService) ListDisputes(ctx context.Context, status *dispute.Status, limit int32) ([]*dispute.Dispute, error) {
+	return nil, nil
+}
+func (m *MockDisputeService) Resolve(ctx context.Context, id int64, outcome dispute.Status, note *string) (*dispute.Dispute, error) {
+	return nil, nil
+}
@@ -6,12 +6,15 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math/rand/v2"
 	"net/http"
 	"os"
 	"time"
 
 	"context"
+	"warimas-be/internal/apperr"
 	"warimas-be/internal/logger"
+	"warimas-be/internal/money"
 	"warimas-be/internal/utils"
 
 	"go.uber.org/zap"
@@ -22,9 +25,40 @@ const (
 	apiVersion    = "2024-11-11"
 )
 
+// PaymentTTL is how long a customer has to pay a payment request before
+// its virtual account or code stops working.
+const PaymentTTL = 24 * time.Hour
+
+// Every call to Xendit goes through send, which bounds each attempt,
+// retries calls that are safe to repeat and trips a circuit breaker when
+// Xendit keeps failing, so checkouts fail fast instead of waiting out
+// timeouts one after another.
+const (
+	// defaultTimeout bounds a single call unless the gateway is given
+	// its own timeout.
+	defaultTimeout = 8 * time.Second
+	// maxAttempts is how often a call safe to repeat is tried.
+	maxAttempts = 3
+	// retryDelay is the backoff before the first retry. It doubles for
+	// each further retry and is jittered down to half.
+	retryDelay = 200 * time.Millisecond
+	// breakerThreshold failed calls in a row open the breaker for
+	// breakerCooldown.
+	breakerThreshold = 5
+	breakerCooldown  = 30 * time.Second
+)
+
+// ErrGatewayUnavailable is returned while Xendit is failing: without
+// calling it when the breaker is open, or after the last attempt of a
+// call that timed out or got a 5xx. Clients may retry later.
+var ErrGatewayUnavailable = apperr.New(apperr.CodeRetryable, "payment provider is unavailable, please try again shortly")
+
 type xenditGateway struct {
 	apiKey        string
 	httpClient    *http.Client
+	timeout       time.Duration
+	breaker       *breaker
+	retryDelay    time.Duration
 	jakartaLoc    *time.Location
 	failureURL    string
 	successURL    string
@@ -34,7 +68,10 @@ type xenditGateway struct {
 
 // ----------------- Constructor -----------------
 
-func NewXenditGateway(apiKey string) Gateway {
+// NewXenditGateway builds the gateway on client, which carries the
+// outbound proxy and TLS settings; nil uses a plain client. timeout
+// bounds each call to Xendit; 0 keeps the 8 second default.
+func NewXenditGateway(apiKey string, client *http.Client, timeout time.Duration) Gateway {
 	if apiKey == "" {
 		logger.L().Warn("Xendit API key is empty")
 	}
@@ -45,11 +82,19 @@ func NewXenditGateway(apiKey string) Gateway {
 		loc = time.UTC
 	}
 
+	if client == nil {
+		client = &http.Client{}
+	}
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
 	return &xenditGateway{
-		apiKey: apiKey,
-		httpClient: &http.Client{
-			Timeout: 15 * time.Second,
-		},
+		apiKey:        apiKey,
+		httpClient:    client,
+		timeout:       timeout,
+		breaker:       newBreaker(breakerThreshold, breakerCooldown),
+		retryDelay:    retryDelay,
 		jakartaLoc:    loc,
 		failureURL:    os.Getenv("FAILURE_URL"),
 		successURL:    os.Getenv("SUCCESS_URL"),
@@ -79,7 +124,7 @@ func (x *xenditGateway) CreateInvoice(
 
 	phone := utils.NormalizePhoneID(buyer.Phone)
 
-	expiry := time.Now().In(x.jakartaLoc).Add(24 * time.Hour).Format(time.RFC3339)
+	expiresAt := time.Now().In(x.jakartaLoc).Add(PaymentTTL)
 
 	body := map[string]interface{}{
 		"reference_id":   externalID,
@@ -103,7 +148,7 @@ func (x *xenditGateway) CreateInvoice(
 			"failure_return_url":    x.failureURL,
 			"success_return_url":    x.successURL,
 			"cancel_return_url":     x.cancelURL,
-			"expires_at":            expiry,
+			"expires_at":            expiresAt.Format(time.RFC3339),
 			"payer_name":            buyer.Name,
 			"display_name":          buyer.Name,
 			"account_mobile_number": phone,
@@ -115,36 +160,25 @@ func (x *xenditGateway) CreateInvoice(
 		return nil, err
 	}
 
-	req, err := http.NewRequestWithContext(ctx, "POST", xenditBaseURL+"/v3/payment_requests", bytes.NewBuffer(jsonBody))
-	if err != nil {
-		log.Error("Failed creating request", zap.Error(err))
-		return nil, err
-	}
-
-	req.SetBasicAuth(x.apiKey, "")
-	req.Header.Add("Content-Type", "application/json")
-	req.Header.Add("api-version", apiVersion)
+	header := http.Header{}
+	header.Set("Content-Type", "application/json")
+	header.Set("api-version", apiVersion)
 
 	log.Info("Sending payment request to Xendit")
 
-	resp, err := x.httpClient.Do(req)
+	// Not retried: payment requests carry no idempotency key, so a retry
+	// after a lost response could charge twice.
+	status, bodyBytes, err := x.send(ctx, http.MethodPost, xenditBaseURL+"/v3/payment_requests", jsonBody, header, false)
 	if err != nil {
 		log.Error("Xendit request failed", zap.Error(err))
 		return nil, err
 	}
-	defer resp.Body.Close()
-
-	bodyBytes, err := io.ReadAll(resp.Body)
-	if err != nil {
-		log.Error("Failed to read response body", zap.Error(err))
-		return nil, fmt.Errorf("failed to read xendit response: %w", err)
-	}
 
 	raw := json.RawMessage(bodyBytes)
 
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+	if status != http.StatusOK && status != http.StatusCreated {
 		log.Error("Xendit returned non-success status",
-			zap.Int("status", resp.StatusCode),
+			zap.Int("status", status),
 			zap.ByteString("response", bodyBytes),
 		)
 		return nil, fmt.Errorf("xendit error: %s", string(bodyBytes))
@@ -181,19 +215,16 @@ func (x *xenditGateway) CreateInvoice(
 		}
 	}
 
-	// Use the expiration time returned by Xendit, or default if nil
-	var expirationTime time.Time
+	// Use the expiration time returned by Xendit, or the one we asked for
+	expirationTime := expiresAt
 	if res.ChannelProperties.ExpiresAt != nil {
 		expirationTime = *res.ChannelProperties.ExpiresAt
-	} else {
-		// Fallback if Xendit doesn't return it (unlikely for created invoice)
-		expirationTime = time.Now().Add(24 * time.Hour)
 	}
 
 	return &PaymentResponse{
 		ProviderPaymentID: res.PaymentRequestID,
 		ReferenceID:       res.ReferenceID,
-		Amount:            res.RequestAmount,
+		Amount:            money.FromMajor(res.Currency, res.RequestAmount),
 		Status:            res.Status,
 		PaymentMethod:     ChannelCode(res.ChannelCode),
 		PaymentCode:       paymentCode,
@@ -211,30 +242,15 @@ func (x *xenditGateway) GetPaymentStatus(ctx context.Context, externalID string)
 
 	url := fmt.Sprintf("%s/v2/invoices?external_id=%s", xenditBaseURL, externalID)
 
-	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
-	if err != nil {
-		log.Error("Failed building request", zap.Error(err))
-		return nil, err
-	}
-
-	req.SetBasicAuth(x.apiKey, "")
-
-	resp, err := x.httpClient.Do(req)
+	status, bodyBytes, err := x.send(ctx, http.MethodGet, url, nil, nil, true)
 	if err != nil {
 		log.Error("Request to Xendit failed", zap.Error(err))
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	bodyBytes, err := io.ReadAll(resp.Body)
-	if err != nil {
-		log.Error("Failed to read response body", zap.Error(err))
-		return nil, fmt.Errorf("failed to read xendit response: %w", err)
-	}
-
-	if resp.StatusCode != http.StatusOK {
+	if status != http.StatusOK {
 		log.Error("Xendit returned error",
-			zap.Int("http_status", resp.StatusCode),
+			zap.Int("http_status", status),
 			zap.ByteString("response", bodyBytes),
 		)
 		return nil, fmt.Errorf("xendit error: %s", string(bodyBytes))
@@ -262,42 +278,280 @@ func (x *xenditGateway) GetPaymentStatus(ctx context.Context, externalID string)
 
 // ----------------- Cancel Payment -----------------
 
-func (x *xenditGateway) CancelPayment(ctx context.Context, externalID string) error {
-	log := logger.L().With(zap.String("external_id", externalID))
+func (x *xenditGateway) CancelPayment(ctx context.Context, paymentRequestID string) error {
+	log := logger.L().With(zap.String("payment_request_id", paymentRequestID))
 
-	url := fmt.Sprintf("%s/invoices/%s/expire!", xenditBaseURL, externalID)
+	url := fmt.Sprintf("%s/v3/payment_requests/%s/cancel", xenditBaseURL, paymentRequestID)
 
-	req, err := http.NewRequestWithContext(ctx, "POST", url, nil)
+	header := http.Header{}
+	header.Set("api-version", apiVersion)
+
+	status, bodyBytes, err := x.send(ctx, http.MethodPost, url, nil, header, false)
 	if err != nil {
-		log.Error("Failed creating request", zap.Error(err))
+		log.Error("Xendit request failed", zap.Error(err))
 		return err
 	}
 
-	req.SetBasicAuth(x.apiKey, "")
+	if status != http.StatusOK {
+		// A request that is no longer pending is refused; its own status
+		// tells whether it was paid or expired
+		if status == http.StatusBadRequest || status == http.StatusConflict {
+			if settled := x.settledPaymentErr(ctx, paymentRequestID); settled != nil {
+				log.Info("Payment request already settled", zap.Error(settled))
+				return settled
+			}
+		}
+		log.Error("Failed to cancel payment",
+			zap.Int("http_status", status),
+			zap.ByteString("response", bodyBytes),
+		)
+		return fmt.Errorf("xendit cancel error: %s", string(bodyBytes))
+	}
 
-	resp, err := x.httpClient.Do(req)
+	log.Info("Payment cancelled successfully")
+	return nil
+}
+
+// settledPaymentErr looks up a payment request and returns
+// ErrPaymentAlreadyPaid or ErrPaymentAlreadyExpired when it reached a
+// final status, or nil when it did not or could not be read.
+func (x *xenditGateway) settledPaymentErr(ctx context.Context, paymentRequestID string) error {
+	url := fmt.Sprintf("%s/v3/payment_requests/%s", xenditBaseURL, paymentRequestID)
+
+	header := http.Header{}
+	header.Set("api-version", apiVersion)
+
+	status, bodyBytes, err := x.send(ctx, http.MethodGet, url, nil, header, true)
+	if err != nil || status != http.StatusOK {
+		return nil
+	}
+
+	var pr struct {
+		Status string `json:"status"`
+	}
+	if err := json.Unmarshal(bodyBytes, &pr); err != nil {
+		return nil
+	}
+
+	switch pr.Status {
+	case "SUCCEEDED":
+		return ErrPaymentAlreadyPaid
+	case "EXPIRED", "CANCELED", "FAILED":
+		return ErrPaymentAlreadyExpired
+	}
+	return nil
+}
+
+// ----------------- Refund -----------------
+
+func (x *xenditGateway) Refund(
+	ctx context.Context,
+	paymentRequestID string,
+	referenceID string,
+	amount int64,
+	reason string,
+) (*RefundResponse, error) {
+	log := logger.L().With(
+		zap.String("payment_request_id", paymentRequestID),
+		zap.String("reference_id", referenceID),
+		zap.Int64("amount", amount),
+	)
+
+	body := map[string]interface{}{
+		"payment_request_id": paymentRequestID,
+		"reference_id":       referenceID,
+		"amount":             amount,
+		"currency":           "IDR",
+		"reason":             reason,
+	}
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		log.Error("Failed to marshal refund request", zap.Error(err))
+		return nil, err
+	}
+
+	header := http.Header{}
+	header.Set("Content-Type", "application/json")
+	header.Set("Idempotency-key", referenceID)
+
+	// The idempotency key makes a retry return the first refund.
+	status, bodyBytes, err := x.send(ctx, http.MethodPost, xenditBaseURL+"/refunds", jsonBody, header, true)
 	if err != nil {
 		log.Error("Xendit request failed", zap.Error(err))
-		return err
+		return nil, err
 	}
-	defer resp.Body.Close()
 
-	bodyBytes, err := io.ReadAll(resp.Body)
+	if status != http.StatusOK && status != http.StatusCreated {
+		log.Error("Failed to create refund",
+			zap.Int("http_status", status),
+			zap.ByteString("response", bodyBytes),
+		)
+		return nil, fmt.Errorf("xendit refund error: %s", string(bodyBytes))
+	}
+
+	var res RefundResponse
+	if err := json.Unmarshal(bodyBytes, &res); err != nil {
+		log.Error("Failed decoding refund response", zap.Error(err))
+		return nil, err
+	}
+
+	log.Info("Refund submitted to Xendit",
+		zap.String("refund_id", res.ProviderRefundID),
+		zap.String("status", res.Status),
+	)
+	return &res, nil
+}
+
+// ----------------- Get Refund -----------------
+
+func (x *xenditGateway) GetRefund(ctx context.Context, providerRefundID string) (*RefundResponse, error) {
+	log := logger.L().With(zap.String("refund_id", providerRefundID))
+
+	url := fmt.Sprintf("%s/refunds/%s", xenditBaseURL, providerRefundID)
+
+	status, bodyBytes, err := x.send(ctx, http.MethodGet, url, nil, nil, true)
 	if err != nil {
-		log.Error("Failed to read response body", zap.Error(err))
-		return fmt.Errorf("failed to read xendit response: %w", err)
+		log.Error("Request to Xendit failed", zap.Error(err))
+		return nil, err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		log.Error("Failed to cancel payment",
-			zap.Int("http_status", resp.StatusCode),
+	if status != http.StatusOK {
+		log.Error("Failed to get refund",
+			zap.Int("http_status", status),
 			zap.ByteString("response", bodyBytes),
 		)
-		return fmt.Errorf("xendit cancel error: %s", string(bodyBytes))
+		return nil, fmt.Errorf("xendit refund error: %s", string(bodyBytes))
 	}
 
-	log.Info("Payment cancelled successfully")
-	return nil
+	var res RefundResponse
+	if err := json.Unmarshal(bodyBytes, &res); err != nil {
+		log.Error("Failed decoding refund response", zap.Error(err))
+		return nil, err
+	}
+
+	return &res, nil
+}
+
+// ----------------- Send -----------------
+
+// send calls Xendit and returns the response status and body. With retry
+// set, network errors, 429 and 5xx responses are retried with jittered
+// backoff; only reads and writes carrying an idempotency key may set it.
+// Network errors and 5xx responses count toward the breaker, and a call
+// that still fails after its last attempt returns a RETRYABLE error.
+func (x *xenditGateway) send(
+	ctx context.Context,
+	method, url string,
+	body []byte,
+	header http.Header,
+	retry bool,
+) (int, []byte, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("http_method", method),
+		zap.String("url", url),
+	)
+
+	attempts := 1
+	if retry {
+		attempts = maxAttempts
+	}
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		if !x.breaker.allow() {
+			log.Warn("Xendit circuit open, failing fast")
+			return 0, nil, ErrGatewayUnavailable
+		}
+
+		var status int
+		var respBody []byte
+		status, respBody, err = x.do(ctx, method, url, body, header)
+		switch {
+		case err != nil && ctx.Err() != nil:
+			// The caller gave up; that says nothing about Xendit.
+			x.breaker.release()
+			return 0, nil, ctx.Err()
+		case err == nil && status < http.StatusInternalServerError:
+			if x.breaker.success() {
+				log.Info("Xendit circuit closed")
+			}
+			if status != http.StatusTooManyRequests || attempt >= attempts {
+				return status, respBody, nil
+			}
+			err = fmt.Errorf("xendit rate limited: %s", string(respBody))
+		default:
+			if err == nil {
+				err = fmt.Errorf("xendit status %d: %s", status, string(respBody))
+			}
+			if x.breaker.failure() {
+				log.Warn("Xendit circuit opened",
+					zap.Duration("cooldown", x.breaker.cooldown),
+					zap.Error(err),
+				)
+			}
+		}
+
+		if attempt >= attempts {
+			log.Warn("Xendit call failed", zap.Int("attempts", attempt), zap.Error(err))
+			break
+		}
+		log.Warn("Xendit call failed, retrying", zap.Int("attempt", attempt), zap.Error(err))
+
+		timer := time.NewTimer(x.backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return 0, nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+	return 0, nil, apperr.Wrap(apperr.CodeRetryable, ErrGatewayUnavailable.Message, err)
+}
+
+// do makes one attempt, bounded by the gateway's timeout.
+func (x *xenditGateway) do(
+	ctx context.Context,
+	method, url string,
+	body []byte,
+	header http.Header,
+) (int, []byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, x.timeout)
+	defer cancel()
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, url, reader)
+	if err != nil {
+		return 0, nil, err
+	}
+	for k, v := range header {
+		req.Header[k] = v
+	}
+	req.SetBasicAuth(x.apiKey, "")
+
+	resp, err := x.httpClient.Do(req)
+	if err != nil {
+		return 0, nil, err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return 0, nil, fmt.Errorf("failed to read xendit response: %w", err)
+	}
+	return resp.StatusCode, respBody, nil
+}
+
+// backoff is the wait before retry number attempt, between half and all
+// of retryDelay doubled for each earlier retry.
+func (x *xenditGateway) backoff(attempt int) time.Duration {
+	d := x.retryDelay << (attempt - 1)
+	if d <= 1 {
+		return d
+	}
+	return d/2 + rand.N(d/2)
 }
 
 // ----------------- Verify Signature -----------------
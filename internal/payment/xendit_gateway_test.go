@@ -6,7 +6,10 @@ import (
 	"errors"
 	"io"
 	"net/http"
+	"sync/atomic"
 	"testing"
+	"time"
+	"warimas-be/internal/apperr"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -26,7 +29,7 @@ func (f MockRoundTripperWithError) RoundTrip(req *http.Request) (*http.Response,
 
 func TestXenditGateway_CreateInvoice(t *testing.T) {
 	apiKey := "test-secret"
-	gw := NewXenditGateway(apiKey).(*xenditGateway)
+	gw := NewXenditGateway(apiKey, nil, 0).(*xenditGateway)
 
 	externalID := "ord-123"
 	amount := int64(100000)
@@ -130,7 +133,8 @@ func TestXenditGateway_CreateInvoice(t *testing.T) {
 
 		_, err := gw.CreateInvoice(context.Background(), externalID, buyer, amount, items, channel)
 		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "connection refused")
+		assert.True(t, apperr.Is(err, apperr.CodeRetryable))
+		assert.ErrorContains(t, errors.Unwrap(err), "connection refused")
 	})
 
 	t.Run("InvalidJSONResponse", func(t *testing.T) {
@@ -204,7 +208,7 @@ func TestXenditGateway_CreateInvoice(t *testing.T) {
 
 func TestXenditGateway_GetPaymentStatus(t *testing.T) {
 	apiKey := "test-secret"
-	gw := NewXenditGateway(apiKey).(*xenditGateway)
+	gw := NewXenditGateway(apiKey, nil, 0).(*xenditGateway)
 	externalID := "ord-123"
 
 	t.Run("Success", func(t *testing.T) {
@@ -273,7 +277,7 @@ func TestXenditGateway_VerifySignature(t *testing.T) {
 	t.Run("SkipInDev", func(t *testing.T) {
 		// Explicitly set to empty to ensure dev mode is tested
 		t.Setenv("XENDIT_CALLBACK_TOKEN", "")
-		gw := NewXenditGateway("secret").(*xenditGateway)
+		gw := NewXenditGateway("secret", nil, 0).(*xenditGateway)
 		req, _ := http.NewRequest("POST", "/", nil)
 		err := gw.VerifySignature(req)
 		assert.NoError(t, err)
@@ -281,7 +285,7 @@ func TestXenditGateway_VerifySignature(t *testing.T) {
 
 	t.Run("ValidSignature", func(t *testing.T) {
 		t.Setenv("XENDIT_CALLBACK_TOKEN", "valid-token")
-		gw := NewXenditGateway("secret").(*xenditGateway)
+		gw := NewXenditGateway("secret", nil, 0).(*xenditGateway)
 		req, _ := http.NewRequest("POST", "/", nil)
 		req.Header.Set("x-callback-token", "valid-token")
 
@@ -291,7 +295,7 @@ func TestXenditGateway_VerifySignature(t *testing.T) {
 
 	t.Run("InvalidSignature", func(t *testing.T) {
 		t.Setenv("XENDIT_CALLBACK_TOKEN", "valid-token")
-		gw := NewXenditGateway("secret").(*xenditGateway)
+		gw := NewXenditGateway("secret", nil, 0).(*xenditGateway)
 		req, _ := http.NewRequest("POST", "/", nil)
 		req.Header.Set("x-callback-token", "invalid-token")
 
@@ -303,13 +307,13 @@ func TestXenditGateway_VerifySignature(t *testing.T) {
 
 func TestXenditGateway_CancelPayment(t *testing.T) {
 	apiKey := "test-secret"
-	gw := NewXenditGateway(apiKey).(*xenditGateway)
-	externalID := "ord-123"
+	gw := NewXenditGateway(apiKey, nil, 0).(*xenditGateway)
+	externalID := "pr-123"
 
 	t.Run("Success", func(t *testing.T) {
 		gw.httpClient.Transport = MockRoundTripper(func(req *http.Request) *http.Response {
 			assert.Equal(t, "POST", req.Method)
-			assert.Contains(t, req.URL.String(), "/expire!")
+			assert.Contains(t, req.URL.String(), "/v3/payment_requests/pr-123/cancel")
 			return &http.Response{
 				StatusCode: http.StatusOK,
 				Body:       io.NopCloser(bytes.NewBufferString(`{}`)),
@@ -343,11 +347,225 @@ func TestXenditGateway_CancelPayment(t *testing.T) {
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "xendit cancel error")
 	})
+
+	for status, want := range map[string]error{
+		"SUCCEEDED": ErrPaymentAlreadyPaid,
+		"EXPIRED":   ErrPaymentAlreadyExpired,
+	} {
+		t.Run("AlreadySettled "+status, func(t *testing.T) {
+			gw.httpClient.Transport = MockRoundTripper(func(req *http.Request) *http.Response {
+				if req.Method == http.MethodPost {
+					return &http.Response{
+						StatusCode: http.StatusConflict,
+						Body:       io.NopCloser(bytes.NewBufferString(`{"error_code": "INVALID_STATUS"}`)),
+						Header:     make(http.Header),
+					}
+				}
+				assert.Contains(t, req.URL.String(), "/v3/payment_requests/pr-123")
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(bytes.NewBufferString(`{"status": "` + status + `"}`)),
+					Header:     make(http.Header),
+				}
+			})
+
+			err := gw.CancelPayment(context.Background(), externalID)
+			assert.ErrorIs(t, err, want)
+		})
+	}
+}
+
+func TestXenditGateway_Refund(t *testing.T) {
+	gw := NewXenditGateway("test-secret", nil, 0).(*xenditGateway)
+
+	t.Run("Success", func(t *testing.T) {
+		gw.httpClient.Transport = MockRoundTripper(func(req *http.Request) *http.Response {
+			assert.Equal(t, "POST", req.Method)
+			assert.Contains(t, req.URL.String(), "/refunds")
+			assert.Equal(t, "rf-1", req.Header.Get("Idempotency-key"))
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewBufferString(`{"id": "xr-1", "reference_id": "rf-1", "amount": 5000, "status": "PENDING"}`)),
+				Header:     make(http.Header),
+			}
+		})
+
+		res, err := gw.Refund(context.Background(), "pr-1", "rf-1", 5000, "REQUESTED_BY_CUSTOMER")
+		assert.NoError(t, err)
+		assert.Equal(t, "xr-1", res.ProviderRefundID)
+		assert.Equal(t, "PENDING", res.Status)
+	})
+
+	t.Run("APIError", func(t *testing.T) {
+		gw.httpClient.Transport = MockRoundTripper(func(req *http.Request) *http.Response {
+			return &http.Response{
+				StatusCode: http.StatusBadRequest,
+				Body:       io.NopCloser(bytes.NewBufferString(`{"error": "bad request"}`)),
+				Header:     make(http.Header),
+			}
+		})
+
+		_, err := gw.Refund(context.Background(), "pr-1", "rf-1", 5000, "REQUESTED_BY_CUSTOMER")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "xendit refund error")
+	})
+}
+
+func TestXenditGateway_GetRefund(t *testing.T) {
+	gw := NewXenditGateway("test-secret", nil, 0).(*xenditGateway)
+
+	t.Run("Success", func(t *testing.T) {
+		gw.httpClient.Transport = MockRoundTripper(func(req *http.Request) *http.Response {
+			assert.Equal(t, "GET", req.Method)
+			assert.Contains(t, req.URL.String(), "/refunds/xr-1")
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(bytes.NewBufferString(`{"id": "xr-1", "reference_id": "rf-1", "amount": 5000, "status": "FAILED", "failure_code": "INSUFFICIENT_BALANCE"}`)),
+				Header:     make(http.Header),
+			}
+		})
+
+		res, err := gw.GetRefund(context.Background(), "xr-1")
+		assert.NoError(t, err)
+		assert.Equal(t, RefundStatusFailed, res.Status)
+		assert.Equal(t, "INSUFFICIENT_BALANCE", res.FailureCode)
+	})
+
+	t.Run("APIError", func(t *testing.T) {
+		gw.httpClient.Transport = MockRoundTripper(func(req *http.Request) *http.Response {
+			return &http.Response{
+				StatusCode: http.StatusNotFound,
+				Body:       io.NopCloser(bytes.NewBufferString(`{"error_code": "DATA_NOT_FOUND"}`)),
+				Header:     make(http.Header),
+			}
+		})
+
+		_, err := gw.GetRefund(context.Background(), "xr-1")
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "xendit refund error")
+	})
 }
 
 func TestNewXenditGateway(t *testing.T) {
 	t.Run("EmptyKey", func(t *testing.T) {
-		gw := NewXenditGateway("")
+		gw := NewXenditGateway("", nil, 0)
 		assert.NotNil(t, gw)
 	})
+
+	t.Run("Client and timeout", func(t *testing.T) {
+		client := &http.Client{}
+		gw := NewXenditGateway("test-secret", client, 2*time.Second).(*xenditGateway)
+		assert.Same(t, client, gw.httpClient)
+		assert.Equal(t, 2*time.Second, gw.timeout)
+
+		gw = NewXenditGateway("test-secret", nil, 0).(*xenditGateway)
+		assert.NotNil(t, gw.httpClient)
+		assert.Equal(t, defaultTimeout, gw.timeout)
+	})
+}
+
+func TestXenditGateway_Send(t *testing.T) {
+	newGateway := func(rt http.RoundTripper) *xenditGateway {
+		gw := NewXenditGateway("test-secret", nil, 0).(*xenditGateway)
+		gw.retryDelay = time.Millisecond
+		gw.httpClient.Transport = rt
+		return gw
+	}
+	reply := func(status int, body string) *http.Response {
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(bytes.NewBufferString(body)),
+			Header:     make(http.Header),
+		}
+	}
+
+	t.Run("Reads are retried until they succeed", func(t *testing.T) {
+		var calls atomic.Int32
+		gw := newGateway(MockRoundTripper(func(req *http.Request) *http.Response {
+			if calls.Add(1) < 3 {
+				return reply(http.StatusBadGateway, `{}`)
+			}
+			return reply(http.StatusOK, `{"id": "xr-1", "status": "SUCCEEDED"}`)
+		}))
+
+		res, err := gw.GetRefund(context.Background(), "xr-1")
+		assert.NoError(t, err)
+		assert.Equal(t, "SUCCEEDED", res.Status)
+		assert.Equal(t, int32(3), calls.Load())
+	})
+
+	t.Run("Refund retries resend the body and idempotency key", func(t *testing.T) {
+		var calls atomic.Int32
+		gw := newGateway(MockRoundTripperWithError(func(req *http.Request) (*http.Response, error) {
+			body, _ := io.ReadAll(req.Body)
+			assert.Contains(t, string(body), `"reference_id":"rf-1"`)
+			assert.Equal(t, "rf-1", req.Header.Get("Idempotency-key"))
+			if calls.Add(1) == 1 {
+				return nil, errors.New("connection reset")
+			}
+			return reply(http.StatusOK, `{"id": "xr-1", "status": "PENDING"}`), nil
+		}))
+
+		_, err := gw.Refund(context.Background(), "pr-1", "rf-1", 5000, "REQUESTED_BY_CUSTOMER")
+		assert.NoError(t, err)
+		assert.Equal(t, int32(2), calls.Load())
+	})
+
+	t.Run("Payment requests are not retried", func(t *testing.T) {
+		var calls atomic.Int32
+		gw := newGateway(MockRoundTripper(func(req *http.Request) *http.Response {
+			calls.Add(1)
+			return reply(http.StatusServiceUnavailable, `{}`)
+		}))
+
+		_, err := gw.CreateInvoice(context.Background(), "ord-1", BuyerInfo{Name: "Buyer"}, 1000, nil, ChannelCode(MethodBCAVA))
+		assert.True(t, apperr.Is(err, apperr.CodeRetryable))
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("Client errors are returned without retrying", func(t *testing.T) {
+		var calls atomic.Int32
+		gw := newGateway(MockRoundTripper(func(req *http.Request) *http.Response {
+			calls.Add(1)
+			return reply(http.StatusNotFound, `{"error_code": "DATA_NOT_FOUND"}`)
+		}))
+
+		_, err := gw.GetRefund(context.Background(), "xr-1")
+		assert.ErrorContains(t, err, "xendit refund error")
+		assert.False(t, apperr.Is(err, apperr.CodeRetryable))
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("Open breaker fails fast", func(t *testing.T) {
+		var calls atomic.Int32
+		gw := newGateway(MockRoundTripper(func(req *http.Request) *http.Response {
+			calls.Add(1)
+			return reply(http.StatusInternalServerError, `{}`)
+		}))
+
+		for i := 0; i < breakerThreshold; i++ {
+			_ = gw.CancelPayment(context.Background(), "ord-1")
+		}
+		assert.Equal(t, int32(breakerThreshold), calls.Load())
+
+		err := gw.CancelPayment(context.Background(), "ord-1")
+		assert.ErrorIs(t, err, ErrGatewayUnavailable)
+		assert.Equal(t, int32(breakerThreshold), calls.Load())
+	})
+
+	t.Run("Cancelled calls do not count", func(t *testing.T) {
+		var calls atomic.Int32
+		gw := newGateway(MockRoundTripperWithError(func(req *http.Request) (*http.Response, error) {
+			calls.Add(1)
+			return nil, req.Context().Err()
+		}))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		for i := 0; i < breakerThreshold+1; i++ {
+			_, err := gw.GetRefund(ctx, "xr-1")
+			assert.ErrorIs(t, err, context.Canceled)
+		}
+		assert.True(t, gw.breaker.allow())
+	})
 }
@@ -0,0 +1,10 @@
+package pii
+
+import "errors"
+
+var (
+	ErrInvalidKeyring = errors.New("invalid pii keyring")
+	ErrKeyNotFound    = errors.New("pii key not found")
+	ErrMalformed      = errors.New("malformed encrypted value")
+	ErrDecrypt        = errors.New("failed to decrypt value")
+)
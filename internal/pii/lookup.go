@@ -0,0 +1,39 @@
+package pii
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+)
+
+var lookupKey []byte
+
+// InitLookup loads the key Lookup digests with, a base64-encoded 32-byte
+// key. Unlike the keyring it cannot rotate: a new key orphans every digest
+// stored under the old one.
+func InitLookup(encoded string) error {
+	key, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(key) != keySize {
+		return fmt.Errorf("%w: lookup key must be %d base64-encoded bytes", ErrInvalidKeyring, keySize)
+	}
+
+	mu.Lock()
+	lookupKey = key
+	mu.Unlock()
+	return nil
+}
+
+// Lookup is a keyed digest of value for finding rows by an encrypted
+// column, whose stored values differ on every Encrypt. Without a lookup
+// key, as in tests and local development, it digests with an empty one.
+func Lookup(value string) string {
+	mu.RLock()
+	key := lookupKey
+	mu.RUnlock()
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
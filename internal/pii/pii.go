@@ -0,0 +1,273 @@
+// Package pii encrypts sensitive column values at the application layer.
+//
+// Each value is sealed with its own random data key (AES-256-GCM), and the
+// data key is wrapped with a key-encryption key from the keyring. Stored
+// values look like
+//
+//	enc:v1:<kid>:<wrapped data key>:<sealed value>
+//
+// Rotating keys only re-wraps the data key; the sealed value is untouched.
+// Values without the enc: prefix are legacy plaintext and decrypt as-is,
+// so columns can be migrated gradually by Rotate.
+package pii
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+const (
+	prefix     = "enc:"
+	version    = "v1"
+	keySize    = 32
+	partsCount = 5
+)
+
+type keyring struct {
+	active string
+	keys   map[string][]byte
+}
+
+var (
+	mu   sync.RWMutex
+	ring *keyring
+)
+
+// Init loads the keyring. keys is a comma-separated list of kid:base64key
+// pairs holding 32-byte keys; active names the key new values are wrapped
+// with. Old keys stay in the list until Rotate has moved every value off
+// them.
+func Init(keys string, active string) error {
+	kr := &keyring{active: active, keys: map[string][]byte{}}
+
+	for _, pair := range strings.Split(keys, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		kid, encoded, ok := strings.Cut(pair, ":")
+		if !ok || kid == "" || strings.Contains(kid, ":") {
+			return fmt.Errorf("%w: malformed entry", ErrInvalidKeyring)
+		}
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil || len(key) != keySize {
+			return fmt.Errorf("%w: key %q must be %d base64-encoded bytes", ErrInvalidKeyring, kid, keySize)
+		}
+		kr.keys[kid] = key
+	}
+
+	if _, ok := kr.keys[active]; !ok {
+		return fmt.Errorf("%w: active key %q not in keyring", ErrInvalidKeyring, active)
+	}
+
+	mu.Lock()
+	ring = kr
+	mu.Unlock()
+	return nil
+}
+
+// Enabled reports whether a keyring has been loaded. Without one Encrypt
+// is a no-op, which is what tests and local development run with.
+func Enabled() bool {
+	return current() != nil
+}
+
+func current() *keyring {
+	mu.RLock()
+	defer mu.RUnlock()
+	return ring
+}
+
+// ActivePrefix is the stored-value prefix of values wrapped with the
+// active key; anything else still needs rotating.
+func ActivePrefix() string {
+	kr := current()
+	if kr == nil {
+		return ""
+	}
+	return prefix + version + ":" + kr.active + ":"
+}
+
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, prefix)
+}
+
+// Encrypt seals plaintext under a fresh data key wrapped with the active
+// key. Input that already looks encrypted is sealed like any other; only
+// Rewrap trusts the prefix.
+func Encrypt(plaintext string) (string, error) {
+	kr := current()
+	if kr == nil || plaintext == "" {
+		return plaintext, nil
+	}
+
+	dek := make([]byte, keySize)
+	if _, err := rand.Read(dek); err != nil {
+		return "", err
+	}
+
+	sealed, err := seal(dek, []byte(plaintext))
+	if err != nil {
+		return "", err
+	}
+	wrapped, err := seal(kr.keys[kr.active], dek)
+	if err != nil {
+		return "", err
+	}
+
+	return format(kr.active, wrapped, sealed), nil
+}
+
+// Decrypt opens a value produced by Encrypt. Plaintext passes through.
+func Decrypt(value string) (string, error) {
+	if !IsEncrypted(value) {
+		return value, nil
+	}
+
+	kid, wrapped, sealed, err := parse(value)
+	if err != nil {
+		return "", err
+	}
+
+	dek, err := unwrap(kid, wrapped)
+	if err != nil {
+		return "", err
+	}
+
+	plaintext, err := open(dek, sealed)
+	if err != nil {
+		return "", ErrDecrypt
+	}
+	return string(plaintext), nil
+}
+
+// Rewrap moves value onto the active key. Plaintext is encrypted; values
+// already on the active key are returned unchanged.
+func Rewrap(value string) (string, error) {
+	kr := current()
+	if kr == nil || value == "" {
+		return value, nil
+	}
+	if !IsEncrypted(value) {
+		return Encrypt(value)
+	}
+
+	kid, wrapped, sealed, err := parse(value)
+	if err != nil {
+		return "", err
+	}
+	if kid == kr.active {
+		return value, nil
+	}
+
+	dek, err := unwrap(kid, wrapped)
+	if err != nil {
+		return "", err
+	}
+	rewrapped, err := seal(kr.keys[kr.active], dek)
+	if err != nil {
+		return "", err
+	}
+
+	return format(kr.active, rewrapped, sealed), nil
+}
+
+// EncryptPtr and DecryptPtr are the nullable-column variants; nil stays
+// nil.
+func EncryptPtr(value *string) (*string, error) {
+	if value == nil {
+		return nil, nil
+	}
+	out, err := Encrypt(*value)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func DecryptPtr(value *string) (*string, error) {
+	if value == nil {
+		return nil, nil
+	}
+	out, err := Decrypt(*value)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func unwrap(kid string, wrapped []byte) ([]byte, error) {
+	kr := current()
+	if kr == nil {
+		return nil, ErrKeyNotFound
+	}
+	kek, ok := kr.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrKeyNotFound, kid)
+	}
+	dek, err := open(kek, wrapped)
+	if err != nil {
+		return nil, ErrDecrypt
+	}
+	return dek, nil
+}
+
+func format(kid string, wrapped, sealed []byte) string {
+	return prefix + version + ":" + kid + ":" +
+		base64.RawStdEncoding.EncodeToString(wrapped) + ":" +
+		base64.RawStdEncoding.EncodeToString(sealed)
+}
+
+func parse(value string) (kid string, wrapped, sealed []byte, err error) {
+	parts := strings.Split(value, ":")
+	if len(parts) != partsCount || parts[1] != version {
+		return "", nil, nil, ErrMalformed
+	}
+	wrapped, err = base64.RawStdEncoding.DecodeString(parts[3])
+	if err != nil {
+		return "", nil, nil, ErrMalformed
+	}
+	sealed, err = base64.RawStdEncoding.DecodeString(parts[4])
+	if err != nil {
+		return "", nil, nil, ErrMalformed
+	}
+	return parts[2], wrapped, sealed, nil
+}
+
+// seal returns nonce || AES-GCM(key, plaintext).
+func seal(key, plaintext []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func open(key, data []byte) ([]byte, error) {
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < gcm.NonceSize() {
+		return nil, ErrMalformed
+	}
+	nonce, ct := data[:gcm.NonceSize()], data[gcm.NonceSize():]
+	return gcm.Open(nil, nonce, ct, nil)
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,168 @@
+package pii
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testKey(b byte) string {
+	return base64.StdEncoding.EncodeToString([]byte(strings.Repeat(string(b), keySize)))
+}
+
+func useKeyring(t *testing.T, keys, active string) {
+	t.Helper()
+	require.NoError(t, Init(keys, active))
+	t.Cleanup(func() {
+		mu.Lock()
+		ring = nil
+		mu.Unlock()
+	})
+}
+
+func TestInit(t *testing.T) {
+	t.Run("ActiveKeyMissing", func(t *testing.T) {
+		err := Init("k1:"+testKey('a'), "k2")
+		assert.ErrorIs(t, err, ErrInvalidKeyring)
+	})
+
+	t.Run("WrongKeySize", func(t *testing.T) {
+		err := Init("k1:"+base64.StdEncoding.EncodeToString([]byte("short")), "k1")
+		assert.ErrorIs(t, err, ErrInvalidKeyring)
+	})
+
+	t.Run("Malformed", func(t *testing.T) {
+		err := Init("k1", "k1")
+		assert.ErrorIs(t, err, ErrInvalidKeyring)
+	})
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	t.Run("Disabled", func(t *testing.T) {
+		out, err := Encrypt("08123456789")
+		assert.NoError(t, err)
+		assert.Equal(t, "08123456789", out)
+	})
+
+	t.Run("RoundTrip", func(t *testing.T) {
+		useKeyring(t, "k1:"+testKey('a'), "k1")
+
+		enc, err := Encrypt("08123456789")
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(enc, "enc:v1:k1:"))
+		assert.NotContains(t, enc, "08123456789")
+
+		again, err := Encrypt("08123456789")
+		require.NoError(t, err)
+		assert.NotEqual(t, enc, again, "each value gets its own data key and nonce")
+
+		dec, err := Decrypt(enc)
+		assert.NoError(t, err)
+		assert.Equal(t, "08123456789", dec)
+	})
+
+	t.Run("PrefixedInputIsSealed", func(t *testing.T) {
+		useKeyring(t, "k1:"+testKey('a'), "k1")
+
+		enc, err := Encrypt("enc:v1:k1:not-really")
+		require.NoError(t, err)
+		assert.NotEqual(t, "enc:v1:k1:not-really", enc)
+
+		dec, err := Decrypt(enc)
+		assert.NoError(t, err)
+		assert.Equal(t, "enc:v1:k1:not-really", dec)
+	})
+
+	t.Run("PlaintextPassesThrough", func(t *testing.T) {
+		useKeyring(t, "k1:"+testKey('a'), "k1")
+
+		dec, err := Decrypt("Jl. Sudirman 1")
+		assert.NoError(t, err)
+		assert.Equal(t, "Jl. Sudirman 1", dec)
+	})
+
+	t.Run("EmptyAndNil", func(t *testing.T) {
+		useKeyring(t, "k1:"+testKey('a'), "k1")
+
+		enc, err := Encrypt("")
+		assert.NoError(t, err)
+		assert.Equal(t, "", enc)
+
+		p, err := EncryptPtr(nil)
+		assert.NoError(t, err)
+		assert.Nil(t, p)
+	})
+
+	t.Run("UnknownKey", func(t *testing.T) {
+		useKeyring(t, "k1:"+testKey('a'), "k1")
+		enc, err := Encrypt("secret")
+		require.NoError(t, err)
+
+		useKeyring(t, "k2:"+testKey('b'), "k2")
+		_, err = Decrypt(enc)
+		assert.ErrorIs(t, err, ErrKeyNotFound)
+	})
+
+	t.Run("Tampered", func(t *testing.T) {
+		useKeyring(t, "k1:"+testKey('a'), "k1")
+		enc, err := Encrypt("secret")
+		require.NoError(t, err)
+
+		tampered := enc[:len(enc)-2] + "AA"
+		_, err = Decrypt(tampered)
+		assert.Error(t, err)
+
+		_, err = Decrypt("enc:v1:k1:garbage")
+		assert.ErrorIs(t, err, ErrMalformed)
+	})
+}
+
+func TestRewrap(t *testing.T) {
+	useKeyring(t, "k1:"+testKey('a'), "k1")
+	old, err := Encrypt("08123456789")
+	require.NoError(t, err)
+
+	useKeyring(t, "k1:"+testKey('a')+",k2:"+testKey('b'), "k2")
+
+	rewrapped, err := Rewrap(old)
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(rewrapped, ActivePrefix()))
+	assert.Equal(t, old[strings.LastIndex(old, ":"):], rewrapped[strings.LastIndex(rewrapped, ":"):],
+		"the sealed value is kept; only the data key is re-wrapped")
+
+	same, err := Rewrap(rewrapped)
+	require.NoError(t, err)
+	assert.Equal(t, rewrapped, same)
+
+	fromPlain, err := Rewrap("08123456789")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(fromPlain, ActivePrefix()))
+
+	// Once k1 is retired, the rewrapped value still opens.
+	useKeyring(t, "k2:"+testKey('b'), "k2")
+	dec, err := Decrypt(rewrapped)
+	assert.NoError(t, err)
+	assert.Equal(t, "08123456789", dec)
+}
+
+func TestLookup(t *testing.T) {
+	t.Cleanup(func() {
+		mu.Lock()
+		lookupKey = nil
+		mu.Unlock()
+	})
+
+	unkeyed := Lookup("+628123456789")
+	assert.Equal(t, unkeyed, Lookup("+628123456789"))
+
+	require.NoError(t, InitLookup(testKey('l')))
+	keyed := Lookup("+628123456789")
+	assert.Equal(t, keyed, Lookup("+628123456789"))
+	assert.NotEqual(t, unkeyed, keyed)
+	assert.NotEqual(t, keyed, Lookup("+628123456780"))
+
+	assert.ErrorIs(t, InitLookup("short"), ErrInvalidKeyring)
+}
@@ -0,0 +1,139 @@
+package pii
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+	"warimas-be/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// RotateInterval is how often encrypted columns are swept for values that
+// are still plaintext or wrapped with a retired key.
+const RotateInterval = 30 * time.Minute
+
+// RotateBatchSize bounds the rows re-wrapped per table per sweep.
+const RotateBatchSize = 500
+
+// Table names an encrypted table: its primary key column and the columns
+// holding Encrypt output. Owning packages declare these next to their
+// repositories.
+type Table struct {
+	Name    string
+	Key     string
+	Columns []string
+}
+
+// Rotate re-wraps up to limit rows of t onto the active key and returns
+// how many rows changed. It is a no-op without a keyring.
+func Rotate(ctx context.Context, db *sql.DB, t Table, limit int32) (n int, err error) {
+	if !Enabled() {
+		return 0, nil
+	}
+
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "Rotate"),
+		zap.String("table", t.Name),
+	)
+
+	stale := make([]string, 0, len(t.Columns))
+	for _, c := range t.Columns {
+		stale = append(stale, fmt.Sprintf("(%s <> '' AND %s NOT LIKE $1)", c, c))
+	}
+
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error("failed to begin transaction", zap.Error(err))
+		return 0, err
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	rows, err := tx.QueryContext(ctx, `
+		SELECT `+t.Key+`, `+strings.Join(t.Columns, ", ")+`
+		FROM `+t.Name+`
+		WHERE `+strings.Join(stale, " OR ")+`
+		LIMIT $2
+		FOR UPDATE SKIP LOCKED
+	`, escapeLike(ActivePrefix())+"%", limit)
+	if err != nil {
+		log.Error("failed to query stale rows", zap.Error(err))
+		return 0, err
+	}
+
+	type row struct {
+		key    string
+		values []sql.NullString
+	}
+	var pending []row
+	for rows.Next() {
+		r := row{values: make([]sql.NullString, len(t.Columns))}
+		dest := []any{&r.key}
+		for i := range r.values {
+			dest = append(dest, &r.values[i])
+		}
+		if err = rows.Scan(dest...); err != nil {
+			rows.Close()
+			log.Error("failed to scan stale row", zap.Error(err))
+			return 0, err
+		}
+		pending = append(pending, r)
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		log.Error("stale row iteration failed", zap.Error(err))
+		return 0, err
+	}
+
+	sets := make([]string, 0, len(t.Columns))
+	for i, c := range t.Columns {
+		sets = append(sets, fmt.Sprintf("%s = $%d", c, i+1))
+	}
+	update := `UPDATE ` + t.Name + ` SET ` + strings.Join(sets, ", ") +
+		fmt.Sprintf(` WHERE %s = $%d`, t.Key, len(t.Columns)+1)
+
+	for _, r := range pending {
+		args := make([]any, 0, len(r.values)+1)
+		for _, v := range r.values {
+			if !v.Valid {
+				args = append(args, nil)
+				continue
+			}
+			var out string
+			if out, err = Rewrap(v.String); err != nil {
+				log.Error("failed to rewrap value", zap.String("key", r.key), zap.Error(err))
+				return 0, err
+			}
+			args = append(args, out)
+		}
+		args = append(args, r.key)
+
+		if _, err = tx.ExecContext(ctx, update, args...); err != nil {
+			log.Error("failed to update row", zap.String("key", r.key), zap.Error(err))
+			return 0, err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Error("failed to commit rotation", zap.Error(err))
+		return 0, err
+	}
+
+	if len(pending) > 0 {
+		log.Info("pii rows rotated", zap.Int("rows", len(pending)))
+	}
+	return len(pending), nil
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+func escapeLike(s string) string {
+	return likeEscaper.Replace(s)
+}
@@ -0,0 +1,49 @@
+package pii
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRotate(t *testing.T) {
+	table := Table{Name: "addresses", Key: "id", Columns: []string{"phone", "address_line2"}}
+	ctx := context.Background()
+
+	t.Run("Disabled", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		n, err := Rotate(ctx, db, table, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, n)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("RewrapsStaleRows", func(t *testing.T) {
+		useKeyring(t, "key_1:"+testKey('a'), "key_1")
+
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT id, phone, address_line2 FROM addresses WHERE .* FOR UPDATE SKIP LOCKED`).
+			WithArgs(`enc:v1:key\_1:%`, int32(10)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "phone", "address_line2"}).
+				AddRow("a-1", "08123456789", nil))
+		mock.ExpectExec(`UPDATE addresses SET phone = \$1, address_line2 = \$2 WHERE id = \$3`).
+			WithArgs(sqlmock.AnyArg(), nil, "a-1").
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		n, err := Rotate(ctx, db, table, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,13 @@
+package preference
+
+import (
+	"errors"
+	"warimas-be/internal/apperr"
+)
+
+var (
+	ErrUnauthenticated = apperr.Unauthenticated("unauthenticated")
+	ErrInvalidLocale   = apperr.Invalid("locale must be one of: id, en")
+	ErrInvalidSetting  = apperr.Invalid("unknown notification event or channel")
+	ErrDB              = errors.New("database error")
+)
@@ -0,0 +1,31 @@
+package preference
+
+import "warimas-be/internal/graph/model"
+
+func MapPreferencesToGraphQL(p *Preferences) *model.UserPreferences {
+	out := &model.UserPreferences{
+		Locale:        p.Locale,
+		Notifications: make([]*model.NotificationSetting, 0, len(p.Settings)),
+		UpdatedAt:     p.UpdatedAt,
+	}
+	for _, s := range p.Settings {
+		out.Notifications = append(out.Notifications, &model.NotificationSetting{
+			Event:   model.NotificationEvent(s.Event),
+			Channel: model.NotificationChannel(s.Channel),
+			Enabled: s.Enabled,
+		})
+	}
+	return out
+}
+
+func MapGraphQLUpdateInput(in model.UpdatePreferencesInput) UpdateInput {
+	out := UpdateInput{Locale: in.Locale}
+	for _, s := range in.Notifications {
+		out.Settings = append(out.Settings, &Setting{
+			Event:   Event(s.Event),
+			Channel: Channel(s.Channel),
+			Enabled: s.Enabled,
+		})
+	}
+	return out
+}
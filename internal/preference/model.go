@@ -0,0 +1,115 @@
+package preference
+
+import (
+	"slices"
+	"time"
+)
+
+// Event is a kind of notice a user can turn on or off per channel.
+type Event string
+
+const (
+	// EventOrderUpdates covers changes to an order the customer did not
+	// make: expired payments, pickups ready, admin adjustments.
+	EventOrderUpdates Event = "ORDER_UPDATES"
+	// EventOrderMessages covers staff replies in an order's thread.
+	EventOrderMessages Event = "ORDER_MESSAGES"
+	// EventProductAlerts covers wishlist price drops and back-in-stock
+	// notices.
+	EventProductAlerts Event = "PRODUCT_ALERTS"
+	// EventMarketing covers promotions. Its email and WhatsApp settings
+	// are the marketing consents.
+	EventMarketing Event = "MARKETING"
+)
+
+var AllEvents = []Event{EventOrderUpdates, EventOrderMessages, EventProductAlerts, EventMarketing}
+
+func (e Event) Valid() bool {
+	return slices.Contains(AllEvents, e)
+}
+
+type Channel string
+
+const (
+	ChannelEmail    Channel = "EMAIL"
+	ChannelPush     Channel = "PUSH"
+	ChannelWhatsApp Channel = "WHATSAPP"
+)
+
+var AllChannels = []Channel{ChannelEmail, ChannelPush, ChannelWhatsApp}
+
+func (c Channel) Valid() bool {
+	return slices.Contains(AllChannels, c)
+}
+
+// Locales are the languages notices can be written in.
+var Locales = []string{"id", "en"}
+
+const DefaultLocale = "id"
+
+// defaultEnabled is the setting of users who never changed it. Service
+// notices go everywhere; product alerts skip WhatsApp; marketing is opt-in.
+func defaultEnabled(e Event, c Channel) bool {
+	switch e {
+	case EventOrderUpdates, EventOrderMessages:
+		return true
+	case EventProductAlerts:
+		return c != ChannelWhatsApp
+	}
+	return false
+}
+
+// Setting turns one event on or off on one channel.
+type Setting struct {
+	Event   Event
+	Channel Channel
+	Enabled bool
+}
+
+// Preferences are a user's locale and a setting for every event and
+// channel, defaults included.
+type Preferences struct {
+	UserID    int32
+	Locale    string
+	Settings  []*Setting
+	UpdatedAt *time.Time
+}
+
+// Stored is what the user changed; anything missing is the default.
+type Stored struct {
+	Locale    *string
+	Settings  []*Setting
+	UpdatedAt *time.Time
+}
+
+type UpdateInput struct {
+	Locale   *string
+	Settings []*Setting
+}
+
+// Delivery is how one user is to be sent one event: in which language and
+// on which channels. No channels means the user muted the event.
+type Delivery struct {
+	Locale   string
+	Channels []Channel
+}
+
+func (d *Delivery) Muted() bool {
+	return len(d.Channels) == 0
+}
+
+func (d *Delivery) Has(c Channel) bool {
+	return slices.Contains(d.Channels, c)
+}
+
+// DefaultDelivery is the delivery of event for users without preferences,
+// guests included.
+func DefaultDelivery(e Event) *Delivery {
+	d := &Delivery{Locale: DefaultLocale}
+	for _, c := range AllChannels {
+		if defaultEnabled(e, c) {
+			d.Channels = append(d.Channels, c)
+		}
+	}
+	return d
+}
@@ -0,0 +1,170 @@
+package preference
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"warimas-be/internal/logger"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+type Repository interface {
+	Get(ctx context.Context, userID int32) (*Stored, error)
+	// ListByUsers returns the stored preferences of the given users for
+	// event only. Users who changed nothing are left out.
+	ListByUsers(ctx context.Context, event Event, userIDs []int32) (map[int32]*Stored, error)
+	Save(ctx context.Context, userID int32, in UpdateInput) error
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) Get(ctx context.Context, userID int32) (*Stored, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "Get"),
+		zap.Int32("user_id", userID),
+	)
+
+	var st Stored
+	err := r.db.QueryRowContext(ctx, `
+		SELECT locale, updated_at FROM user_preferences WHERE user_id = $1
+	`, userID).Scan(&st.Locale, &st.UpdatedAt)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		log.Error("failed to get preferences", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT event, channel, enabled
+		FROM notification_preferences
+		WHERE user_id = $1
+	`, userID)
+	if err != nil {
+		log.Error("failed to query notification preferences", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var s Setting
+		if err := rows.Scan(&s.Event, &s.Channel, &s.Enabled); err != nil {
+			log.Error("failed to scan notification preference", zap.Error(err))
+			return nil, ErrDB
+		}
+		st.Settings = append(st.Settings, &s)
+	}
+	if err := rows.Err(); err != nil {
+		log.Error("notification preference iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return &st, nil
+}
+
+func (r *repository) ListByUsers(ctx context.Context, event Event, userIDs []int32) (map[int32]*Stored, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ListByUsers"),
+		zap.String("event", string(event)),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT u.user_id, p.locale, n.channel, n.enabled
+		FROM UNNEST($1::int[]) AS u(user_id)
+		LEFT JOIN user_preferences p ON p.user_id = u.user_id
+		LEFT JOIN notification_preferences n ON n.user_id = u.user_id AND n.event = $2
+		WHERE p.user_id IS NOT NULL OR n.user_id IS NOT NULL
+	`, pq.Array(userIDs), event)
+	if err != nil {
+		log.Error("failed to query preferences", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	out := make(map[int32]*Stored)
+	for rows.Next() {
+		var (
+			userID  int32
+			locale  *string
+			channel *Channel
+			enabled *bool
+		)
+		if err := rows.Scan(&userID, &locale, &channel, &enabled); err != nil {
+			log.Error("failed to scan preference", zap.Error(err))
+			return nil, ErrDB
+		}
+		st, ok := out[userID]
+		if !ok {
+			st = &Stored{Locale: locale}
+			out[userID] = st
+		}
+		if channel != nil {
+			st.Settings = append(st.Settings, &Setting{Event: event, Channel: *channel, Enabled: *enabled})
+		}
+	}
+	if err := rows.Err(); err != nil {
+		log.Error("preference iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return out, nil
+}
+
+// Save applies in on top of what the user stored before, in one
+// transaction.
+func (r *repository) Save(ctx context.Context, userID int32, in UpdateInput) (err error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "Save"),
+		zap.Int32("user_id", userID),
+	)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error("failed to begin transaction", zap.Error(err))
+		return ErrDB
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if _, err = tx.ExecContext(ctx, `
+		INSERT INTO user_preferences (user_id, locale)
+		VALUES ($1, COALESCE($2, 'id'))
+		ON CONFLICT (user_id) DO UPDATE
+		SET locale = COALESCE($2, user_preferences.locale),
+			updated_at = NOW()
+	`, userID, in.Locale); err != nil {
+		log.Error("failed to save locale", zap.Error(err))
+		return ErrDB
+	}
+
+	for _, s := range in.Settings {
+		if _, err = tx.ExecContext(ctx, `
+			INSERT INTO notification_preferences (user_id, event, channel, enabled)
+			VALUES ($1, $2, $3, $4)
+			ON CONFLICT (user_id, event, channel) DO UPDATE
+			SET enabled = EXCLUDED.enabled,
+				updated_at = NOW()
+		`, userID, s.Event, s.Channel, s.Enabled); err != nil {
+			log.Error("failed to save notification preference", zap.Error(err))
+			return ErrDB
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Error("failed to commit transaction", zap.Error(err))
+		return ErrDB
+	}
+	return nil
+}
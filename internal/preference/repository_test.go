@@ -0,0 +1,76 @@
+package preference
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_ListByUsers(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+
+	mock.ExpectQuery(`SELECT u.user_id, p.locale, n.channel, n.enabled FROM UNNEST`).
+		WithArgs(pq.Array([]int32{1, 2}), EventOrderUpdates).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "locale", "channel", "enabled"}).
+			AddRow(1, "en", "EMAIL", false).
+			AddRow(1, "en", "PUSH", true).
+			AddRow(2, nil, "WHATSAPP", false))
+
+	got, err := repo.ListByUsers(ctx, EventOrderUpdates, []int32{1, 2})
+	require.NoError(t, err)
+	assert.Equal(t, "en", *got[1].Locale)
+	assert.Len(t, got[1].Settings, 2)
+	assert.Nil(t, got[2].Locale)
+	assert.Equal(t, &Setting{Event: EventOrderUpdates, Channel: ChannelWhatsApp, Enabled: false}, got[2].Settings[0])
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_Save(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+	locale := "en"
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(`INSERT INTO user_preferences`).
+			WithArgs(int32(1), &locale).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`INSERT INTO notification_preferences .* ON CONFLICT \(user_id, event, channel\) DO UPDATE`).
+			WithArgs(int32(1), EventMarketing, ChannelPush, false).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := repo.Save(ctx, 1, UpdateInput{
+			Locale:   &locale,
+			Settings: []*Setting{{Event: EventMarketing, Channel: ChannelPush, Enabled: false}},
+		})
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("SettingFailsRollsBack", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(`INSERT INTO user_preferences`).WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`INSERT INTO notification_preferences`).WillReturnError(assert.AnError)
+		mock.ExpectRollback()
+
+		err := repo.Save(ctx, 1, UpdateInput{
+			Settings: []*Setting{{Event: EventMarketing, Channel: ChannelPush, Enabled: false}},
+		})
+		assert.ErrorIs(t, err, ErrDB)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,205 @@
+package preference
+
+import (
+	"context"
+	"slices"
+	"warimas-be/internal/consent"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+type Service interface {
+	// GetMyPreferences returns the caller's locale and a setting for every
+	// event and channel, defaults included.
+	GetMyPreferences(ctx context.Context) (*Preferences, error)
+	// UpdateMyPreferences changes the given settings and leaves the rest.
+	UpdateMyPreferences(ctx context.Context, in UpdateInput) (*Preferences, error)
+
+	// DeliveryFor and DeliveriesFor tell notifiers how to send event to
+	// users. They do not fail: when preferences cannot be read, the
+	// defaults apply, which leaves marketing off.
+	DeliveryFor(ctx context.Context, userID int32, event Event) *Delivery
+	DeliveriesFor(ctx context.Context, event Event, userIDs []int32) map[int32]*Delivery
+}
+
+type service struct {
+	repo    Repository
+	consent consent.Service
+}
+
+// NewService creates the preference service. Marketing by email and
+// WhatsApp is read from and written to consent, so the consent history
+// stays the record of those choices.
+func NewService(repo Repository, consent consent.Service) Service {
+	return &service{repo: repo, consent: consent}
+}
+
+// viaConsent reports whether the setting is a marketing consent.
+func viaConsent(e Event, c Channel) bool {
+	return e == EventMarketing && (c == ChannelEmail || c == ChannelWhatsApp)
+}
+
+// enabled is the user's setting for e on c, or the default.
+func (st *Stored) enabled(e Event, c Channel) bool {
+	if st != nil {
+		for _, s := range st.Settings {
+			if s.Event == e && s.Channel == c {
+				return s.Enabled
+			}
+		}
+	}
+	return defaultEnabled(e, c)
+}
+
+func (st *Stored) locale() string {
+	if st != nil && st.Locale != nil {
+		return *st.Locale
+	}
+	return DefaultLocale
+}
+
+func (s *service) GetMyPreferences(ctx context.Context) (*Preferences, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "GetMyPreferences"),
+	)
+
+	userID, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		log.Warn("user not authenticated")
+		return nil, ErrUnauthenticated
+	}
+
+	st, err := s.repo.Get(ctx, int32(userID))
+	if err != nil {
+		log.Error("failed to get preferences", zap.Error(err))
+		return nil, err
+	}
+
+	consents, err := s.consent.GetMyConsents(ctx)
+	if err != nil {
+		log.Error("failed to get marketing consents", zap.Error(err))
+		return nil, err
+	}
+	subscribed := make(map[Channel]bool, len(consents))
+	for _, c := range consents {
+		subscribed[Channel(c.Channel)] = c.Status == consent.StatusSubscribed
+	}
+
+	p := &Preferences{UserID: int32(userID), Locale: st.locale(), UpdatedAt: st.UpdatedAt}
+	for _, e := range AllEvents {
+		for _, c := range AllChannels {
+			on := st.enabled(e, c)
+			if viaConsent(e, c) {
+				on = subscribed[c]
+			}
+			p.Settings = append(p.Settings, &Setting{Event: e, Channel: c, Enabled: on})
+		}
+	}
+	return p, nil
+}
+
+func (s *service) UpdateMyPreferences(ctx context.Context, in UpdateInput) (*Preferences, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "UpdateMyPreferences"),
+	)
+
+	userID, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		log.Warn("user not authenticated")
+		return nil, ErrUnauthenticated
+	}
+
+	if in.Locale != nil && !slices.Contains(Locales, *in.Locale) {
+		return nil, ErrInvalidLocale
+	}
+
+	stored := UpdateInput{Locale: in.Locale}
+	var consents []*Setting
+	for _, set := range in.Settings {
+		if !set.Event.Valid() || !set.Channel.Valid() {
+			return nil, ErrInvalidSetting
+		}
+		if viaConsent(set.Event, set.Channel) {
+			consents = append(consents, set)
+		} else {
+			stored.Settings = append(stored.Settings, set)
+		}
+	}
+
+	if err := s.repo.Save(ctx, int32(userID), stored); err != nil {
+		log.Error("failed to save preferences", zap.Error(err))
+		return nil, err
+	}
+
+	for _, set := range consents {
+		ch := consent.Channel(set.Channel)
+		var err error
+		if set.Enabled {
+			_, err = s.consent.Subscribe(ctx, ch)
+		} else {
+			_, err = s.consent.Unsubscribe(ctx, ch)
+		}
+		if err != nil {
+			log.Error("failed to change marketing consent", zap.String("channel", string(ch)), zap.Error(err))
+			return nil, err
+		}
+	}
+
+	log.Info("preferences updated", zap.Uint("user_id", userID))
+	return s.GetMyPreferences(ctx)
+}
+
+func (s *service) DeliveryFor(ctx context.Context, userID int32, event Event) *Delivery {
+	return s.DeliveriesFor(ctx, event, []int32{userID})[userID]
+}
+
+func (s *service) DeliveriesFor(ctx context.Context, event Event, userIDs []int32) map[int32]*Delivery {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "DeliveriesFor"),
+		zap.String("event", string(event)),
+	)
+
+	stored, err := s.repo.ListByUsers(ctx, event, userIDs)
+	if err != nil {
+		log.Warn("failed to load preferences, using defaults", zap.Error(err))
+		stored = nil
+	}
+
+	// Marketing consent is opt-in; a user counts as subscribed only when
+	// consent says so.
+	consented := make(map[Channel]map[int32]bool)
+	if event == EventMarketing {
+		for _, c := range []Channel{ChannelEmail, ChannelWhatsApp} {
+			ids, err := s.consent.FilterSendable(ctx, consent.Channel(c), userIDs)
+			if err != nil {
+				log.Warn("failed to check marketing consent", zap.String("channel", string(c)), zap.Error(err))
+			}
+			consented[c] = make(map[int32]bool, len(ids))
+			for _, id := range ids {
+				consented[c][id] = true
+			}
+		}
+	}
+
+	out := make(map[int32]*Delivery, len(userIDs))
+	for _, id := range userIDs {
+		st := stored[id]
+		d := &Delivery{Locale: st.locale()}
+		for _, c := range AllChannels {
+			on := st.enabled(event, c)
+			if viaConsent(event, c) {
+				on = consented[c][id]
+			}
+			if on {
+				d.Channels = append(d.Channels, c)
+			}
+		}
+		out[id] = d
+	}
+	return out
+}
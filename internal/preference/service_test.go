@@ -0,0 +1,208 @@
+package preference
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"warimas-be/internal/consent"
+	"warimas-be/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Mocks ---
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) Get(ctx context.Context, userID int32) (*Stored, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Stored), args.Error(1)
+}
+
+func (m *MockRepository) ListByUsers(ctx context.Context, event Event, userIDs []int32) (map[int32]*Stored, error) {
+	args := m.Called(ctx, event, userIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(map[int32]*Stored), args.Error(1)
+}
+
+func (m *MockRepository) Save(ctx context.Context, userID int32, in UpdateInput) error {
+	args := m.Called(ctx, userID, in)
+	return args.Error(0)
+}
+
+// MockConsent implements only what the preference service calls.
+type MockConsent struct {
+	consent.Service
+	mock.Mock
+}
+
+func (m *MockConsent) GetMyConsents(ctx context.Context) ([]*consent.Consent, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*consent.Consent), args.Error(1)
+}
+
+func (m *MockConsent) Subscribe(ctx context.Context, channel consent.Channel) (*consent.Consent, error) {
+	args := m.Called(ctx, channel)
+	return nil, args.Error(0)
+}
+
+func (m *MockConsent) Unsubscribe(ctx context.Context, channel consent.Channel) (*consent.Consent, error) {
+	args := m.Called(ctx, channel)
+	return nil, args.Error(0)
+}
+
+func (m *MockConsent) FilterSendable(ctx context.Context, channel consent.Channel, userIDs []int32) ([]int32, error) {
+	args := m.Called(ctx, channel, userIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]int32), args.Error(1)
+}
+
+func setting(p *Preferences, e Event, c Channel) bool {
+	for _, s := range p.Settings {
+		if s.Event == e && s.Channel == c {
+			return s.Enabled
+		}
+	}
+	panic("missing setting")
+}
+
+// --- Tests ---
+
+func TestService_GetMyPreferences(t *testing.T) {
+	ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "USER")
+
+	t.Run("DefaultsStoredAndConsent", func(t *testing.T) {
+		repo, consents := new(MockRepository), new(MockConsent)
+		svc := NewService(repo, consents)
+
+		locale := "en"
+		repo.On("Get", ctx, int32(1)).Return(&Stored{
+			Locale:   &locale,
+			Settings: []*Setting{{Event: EventOrderMessages, Channel: ChannelEmail, Enabled: false}},
+		}, nil)
+		consents.On("GetMyConsents", ctx).Return([]*consent.Consent{
+			{Channel: consent.ChannelEmail, Status: consent.StatusSubscribed},
+			{Channel: consent.ChannelWhatsApp, Status: consent.StatusUnsubscribed},
+		}, nil)
+
+		p, err := svc.GetMyPreferences(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "en", p.Locale)
+		assert.Len(t, p.Settings, len(AllEvents)*len(AllChannels))
+		assert.False(t, setting(p, EventOrderMessages, ChannelEmail))
+		assert.True(t, setting(p, EventOrderMessages, ChannelPush))
+		assert.False(t, setting(p, EventProductAlerts, ChannelWhatsApp))
+		assert.True(t, setting(p, EventMarketing, ChannelEmail))
+		assert.False(t, setting(p, EventMarketing, ChannelWhatsApp))
+		assert.False(t, setting(p, EventMarketing, ChannelPush))
+	})
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		svc := NewService(new(MockRepository), new(MockConsent))
+		_, err := svc.GetMyPreferences(context.Background())
+		assert.ErrorIs(t, err, ErrUnauthenticated)
+	})
+}
+
+func TestService_UpdateMyPreferences(t *testing.T) {
+	ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "USER")
+
+	t.Run("MarketingEmailGoesToConsent", func(t *testing.T) {
+		repo, consents := new(MockRepository), new(MockConsent)
+		svc := NewService(repo, consents)
+
+		locale := "en"
+		push := &Setting{Event: EventMarketing, Channel: ChannelPush, Enabled: false}
+		email := &Setting{Event: EventMarketing, Channel: ChannelEmail, Enabled: false}
+		orders := &Setting{Event: EventOrderUpdates, Channel: ChannelEmail, Enabled: true}
+
+		repo.On("Save", ctx, int32(1), UpdateInput{Locale: &locale, Settings: []*Setting{push, orders}}).Return(nil)
+		consents.On("Unsubscribe", ctx, consent.ChannelEmail).Return(nil)
+		repo.On("Get", ctx, int32(1)).Return(&Stored{Locale: &locale}, nil)
+		consents.On("GetMyConsents", ctx).Return([]*consent.Consent{}, nil)
+
+		p, err := svc.UpdateMyPreferences(ctx, UpdateInput{Locale: &locale, Settings: []*Setting{push, email, orders}})
+		require.NoError(t, err)
+		assert.Equal(t, "en", p.Locale)
+		repo.AssertExpectations(t)
+		consents.AssertExpectations(t)
+	})
+
+	t.Run("InvalidLocale", func(t *testing.T) {
+		repo := new(MockRepository)
+		svc := NewService(repo, new(MockConsent))
+
+		locale := "fr"
+		_, err := svc.UpdateMyPreferences(ctx, UpdateInput{Locale: &locale})
+		assert.ErrorIs(t, err, ErrInvalidLocale)
+		repo.AssertNotCalled(t, "Save", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("InvalidSetting", func(t *testing.T) {
+		svc := NewService(new(MockRepository), new(MockConsent))
+
+		_, err := svc.UpdateMyPreferences(ctx, UpdateInput{Settings: []*Setting{{Event: "REVIEWS", Channel: ChannelPush}}})
+		assert.ErrorIs(t, err, ErrInvalidSetting)
+	})
+}
+
+func TestService_DeliveriesFor(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("StoredSettingsAndDefaults", func(t *testing.T) {
+		repo := new(MockRepository)
+		svc := NewService(repo, new(MockConsent))
+
+		locale := "en"
+		repo.On("ListByUsers", ctx, EventOrderUpdates, []int32{1, 2}).Return(map[int32]*Stored{
+			1: {Locale: &locale, Settings: []*Setting{
+				{Event: EventOrderUpdates, Channel: ChannelEmail, Enabled: false},
+				{Event: EventOrderUpdates, Channel: ChannelWhatsApp, Enabled: false},
+			}},
+		}, nil)
+
+		got := svc.DeliveriesFor(ctx, EventOrderUpdates, []int32{1, 2})
+		assert.Equal(t, &Delivery{Locale: "en", Channels: []Channel{ChannelPush}}, got[1])
+		assert.Equal(t, DefaultDelivery(EventOrderUpdates), got[2])
+	})
+
+	t.Run("MarketingNeedsConsent", func(t *testing.T) {
+		repo, consents := new(MockRepository), new(MockConsent)
+		svc := NewService(repo, consents)
+
+		repo.On("ListByUsers", ctx, EventMarketing, []int32{1, 2}).Return(map[int32]*Stored{
+			2: {Settings: []*Setting{{Event: EventMarketing, Channel: ChannelPush, Enabled: true}}},
+		}, nil)
+		consents.On("FilterSendable", ctx, consent.ChannelEmail, []int32{1, 2}).Return([]int32{1}, nil)
+		consents.On("FilterSendable", ctx, consent.ChannelWhatsApp, []int32{1, 2}).Return([]int32{}, nil)
+
+		got := svc.DeliveriesFor(ctx, EventMarketing, []int32{1, 2})
+		assert.Equal(t, []Channel{ChannelEmail}, got[1].Channels)
+		assert.Equal(t, []Channel{ChannelPush}, got[2].Channels)
+	})
+
+	t.Run("FallsBackToDefaults", func(t *testing.T) {
+		repo := new(MockRepository)
+		svc := NewService(repo, new(MockConsent))
+
+		repo.On("ListByUsers", ctx, EventOrderMessages, []int32{1}).Return(nil, errors.New("db down"))
+
+		d := svc.DeliveryFor(ctx, 1, EventOrderMessages)
+		assert.Equal(t, DefaultDelivery(EventOrderMessages), d)
+		assert.False(t, d.Muted())
+	})
+}
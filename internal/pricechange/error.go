@@ -0,0 +1,18 @@
+package pricechange
+
+import (
+	"errors"
+	"warimas-be/internal/apperr"
+)
+
+var (
+	ErrNotSeller          = apperr.Forbidden("unauthorized")
+	ErrVariantNotFound    = apperr.NotFound("variant not found")
+	ErrInvalidVariantID   = apperr.Invalid("invalid variant id")
+	ErrInvalidPrice       = apperr.Invalid("price must be positive")
+	ErrInvalidEffectiveAt = apperr.Invalid("effective time must be in the future and at most a year ahead")
+	ErrAlreadyScheduled   = apperr.Conflict("variant already has a scheduled price change")
+	ErrChangeNotFound     = apperr.NotFound("scheduled price change not found or already applied")
+	ErrDB                 = errors.New("database error")
+	PgUniqueViolation     = "23505"
+)
@@ -0,0 +1,21 @@
+package pricechange
+
+import (
+	"strconv"
+	"warimas-be/internal/graph/model"
+)
+
+func MapChangeToGraphQL(c *Change) *model.ScheduledPriceChange {
+	return &model.ScheduledPriceChange{
+		ID:           strconv.FormatInt(c.ID, 10),
+		VariantID:    c.VariantID,
+		ProductID:    c.ProductID,
+		ProductName:  c.ProductName,
+		VariantName:  c.VariantName,
+		CurrentPrice: c.CurrentPrice,
+		Price:        c.Price,
+		EffectiveAt:  c.EffectiveAt,
+		HeldBySale:   c.HeldBySale,
+		CreatedAt:    c.CreatedAt,
+	}
+}
@@ -0,0 +1,46 @@
+package pricechange
+
+import "time"
+
+// ApplyInterval is how often the job applies due price changes.
+const ApplyInterval = time.Minute
+
+const (
+	// maxLeadTime is how far ahead a change can be scheduled.
+	maxLeadTime = 365 * 24 * time.Hour
+	// maxApplyPerRun caps the changes one job run applies; the rest are
+	// applied on the next run.
+	maxApplyPerRun = 1000
+	applyBatchSize = int32(100)
+)
+
+type Status string
+
+const (
+	StatusPending   Status = "PENDING"
+	StatusApplied   Status = "APPLIED"
+	StatusCancelled Status = "CANCELLED"
+)
+
+// Change is a price a seller scheduled for one of their variants.
+// HeldBySale is true while the variant has a compare-at price; a due
+// change is not applied until the sale ends.
+type Change struct {
+	ID           int64
+	VariantID    string
+	ProductID    string
+	ProductName  string
+	VariantName  string
+	CurrentPrice float64
+	Price        float64
+	EffectiveAt  time.Time
+	Status       Status
+	HeldBySale   bool
+	CreatedAt    time.Time
+}
+
+type ScheduleInput struct {
+	VariantID   string
+	Price       float64
+	EffectiveAt time.Time
+}
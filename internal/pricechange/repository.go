@@ -0,0 +1,198 @@
+package pricechange
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"warimas-be/internal/logger"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+type Repository interface {
+	// Add schedules a change for a variant of the seller. It fails with
+	// ErrVariantNotFound when the seller has no such variant and with
+	// ErrAlreadyScheduled when the variant has a pending change.
+	Add(ctx context.Context, sellerID string, userID int32, in ScheduleInput) (*Change, error)
+	// ListPending returns the seller's pending changes, soonest first,
+	// only variantID's when it is set.
+	ListPending(ctx context.Context, sellerID string, variantID *string) ([]*Change, error)
+	// Cancel cancels a pending change of the seller; false when there was
+	// none.
+	Cancel(ctx context.Context, sellerID string, id int64) (bool, error)
+
+	// ApplyDue applies up to limit due changes of variants not on sale and
+	// returns how many it applied.
+	ApplyDue(ctx context.Context, limit int32) (int64, error)
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+const changeColumns = `
+	c.id, c.variant_id, p.id, p.name, v.name, v.price, c.price,
+	c.effective_at, c.status, v.compare_at_price IS NOT NULL, c.created_at
+`
+
+const changeJoins = `
+	FROM scheduled_price_changes c
+	JOIN variants v ON v.id = c.variant_id
+	JOIN products p ON p.id = v.product_id
+`
+
+func scanChange(row interface{ Scan(...any) error }) (*Change, error) {
+	var c Change
+	err := row.Scan(
+		&c.ID, &c.VariantID, &c.ProductID, &c.ProductName, &c.VariantName,
+		&c.CurrentPrice, &c.Price, &c.EffectiveAt, &c.Status, &c.HeldBySale,
+		&c.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &c, nil
+}
+
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && string(pqErr.Code) == PgUniqueViolation
+}
+
+func (r *repository) Add(ctx context.Context, sellerID string, userID int32, in ScheduleInput) (*Change, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "Add"),
+		zap.String("seller_id", sellerID),
+		zap.String("variant_id", in.VariantID),
+	)
+
+	var id int64
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO scheduled_price_changes (variant_id, price, effective_at, created_by)
+		SELECT v.id, $3, $4, $5
+		FROM variants v
+		JOIN products p ON p.id = v.product_id
+		WHERE v.id = $1
+		  AND p.seller_id = $2
+		RETURNING id
+	`, in.VariantID, sellerID, in.Price, in.EffectiveAt, userID).Scan(&id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrVariantNotFound
+	}
+	if isUniqueViolation(err) {
+		return nil, ErrAlreadyScheduled
+	}
+	if err != nil {
+		log.Error("failed to insert scheduled price change", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	c, err := scanChange(r.db.QueryRowContext(ctx, `
+		SELECT `+changeColumns+changeJoins+`
+		WHERE c.id = $1
+	`, id))
+	if err != nil {
+		log.Error("failed to load scheduled price change", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return c, nil
+}
+
+func (r *repository) ListPending(ctx context.Context, sellerID string, variantID *string) ([]*Change, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ListPending"),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+changeColumns+changeJoins+`
+		WHERE p.seller_id = $1
+		  AND c.status = 'PENDING'
+		  AND ($2::uuid IS NULL OR c.variant_id = $2)
+		ORDER BY c.effective_at, c.id
+	`, sellerID, variantID)
+	if err != nil {
+		log.Error("failed to query scheduled price changes", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	list := []*Change{}
+	for rows.Next() {
+		c, err := scanChange(rows)
+		if err != nil {
+			log.Error("failed to scan scheduled price change", zap.Error(err))
+			return nil, ErrDB
+		}
+		list = append(list, c)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error("scheduled price change iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return list, nil
+}
+
+func (r *repository) Cancel(ctx context.Context, sellerID string, id int64) (bool, error) {
+	res, err := r.db.ExecContext(ctx, `
+		UPDATE scheduled_price_changes c
+		SET status = 'CANCELLED'
+		FROM variants v
+		JOIN products p ON p.id = v.product_id
+		WHERE c.id = $1
+		  AND c.status = 'PENDING'
+		  AND v.id = c.variant_id
+		  AND p.seller_id = $2
+	`, id, sellerID)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to cancel scheduled price change", zap.Error(err))
+		return false, ErrDB
+	}
+
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
+
+func (r *repository) ApplyDue(ctx context.Context, limit int32) (int64, error) {
+	// Locking the variant too keeps a compare-at price set meanwhile from
+	// being missed; the variants update fires the change-log trigger, so
+	// wishlist price alerts see the new price.
+	res, err := r.db.ExecContext(ctx, `
+		WITH due AS (
+			SELECT c.id, c.variant_id, c.price
+			FROM scheduled_price_changes c
+			JOIN variants v ON v.id = c.variant_id
+			WHERE c.status = 'PENDING'
+			  AND c.effective_at <= NOW()
+			  AND v.compare_at_price IS NULL
+			ORDER BY c.effective_at, c.id
+			LIMIT $1
+			FOR UPDATE OF c, v SKIP LOCKED
+		), priced AS (
+			UPDATE variants v
+			SET price = due.price
+			FROM due
+			WHERE v.id = due.variant_id
+		)
+		UPDATE scheduled_price_changes c
+		SET status = 'APPLIED', applied_at = NOW()
+		FROM due
+		WHERE c.id = due.id
+	`, limit)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to apply scheduled price changes", zap.Error(err))
+		return 0, ErrDB
+	}
+
+	n, _ := res.RowsAffected()
+	return n, nil
+}
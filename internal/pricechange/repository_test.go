@@ -0,0 +1,99 @@
+package pricechange
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var changeCols = []string{
+	"id", "variant_id", "product_id", "product_name", "variant_name", "current_price",
+	"price", "effective_at", "status", "held_by_sale", "created_at",
+}
+
+func TestRepository_Add(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+	in := ScheduleInput{VariantID: variantID, Price: 55000, EffectiveAt: now}
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectQuery(`INSERT INTO scheduled_price_changes .* WHERE v.id = \$1 AND p.seller_id = \$2 RETURNING id`).
+			WithArgs(variantID, sellerID, 55000.0, now, int32(7)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectQuery(`SELECT .* FROM scheduled_price_changes c .* WHERE c.id = \$1`).
+			WithArgs(int64(1)).
+			WillReturnRows(sqlmock.NewRows(changeCols).
+				AddRow(1, variantID, "p-1", "Beras", "5kg", 60000.0, 55000.0, now, "PENDING", true, now))
+
+		c, err := repo.Add(ctx, sellerID, 7, in)
+		require.NoError(t, err)
+		assert.Equal(t, 60000.0, c.CurrentPrice)
+		assert.Equal(t, StatusPending, c.Status)
+		assert.True(t, c.HeldBySale)
+	})
+
+	t.Run("NotOwned", func(t *testing.T) {
+		mock.ExpectQuery(`INSERT INTO scheduled_price_changes`).
+			WillReturnError(sql.ErrNoRows)
+
+		_, err := repo.Add(ctx, sellerID, 7, in)
+		assert.ErrorIs(t, err, ErrVariantNotFound)
+	})
+
+	t.Run("AlreadyScheduled", func(t *testing.T) {
+		mock.ExpectQuery(`INSERT INTO scheduled_price_changes`).
+			WillReturnError(&pq.Error{Code: pq.ErrorCode(PgUniqueViolation)})
+
+		_, err := repo.Add(ctx, sellerID, 7, in)
+		assert.ErrorIs(t, err, ErrAlreadyScheduled)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ApplyDue(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	mock.ExpectExec(`WITH due AS \( SELECT .* WHERE c.status = 'PENDING' AND c.effective_at <= NOW\(\) AND v.compare_at_price IS NULL .* FOR UPDATE OF c, v SKIP LOCKED \), priced AS \( UPDATE variants v SET price = due.price .* SET status = 'APPLIED', applied_at = NOW\(\)`).
+		WithArgs(int32(100)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+
+	n, err := repo.ApplyDue(context.Background(), 100)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), n)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ListPending(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	due := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+
+	mock.ExpectQuery(`WHERE p.seller_id = \$1 AND c.status = 'PENDING' AND \(\$2::uuid IS NULL OR c.variant_id = \$2\) ORDER BY c.effective_at, c.id`).
+		WithArgs(sellerID, nil).
+		WillReturnRows(sqlmock.NewRows(changeCols).
+			AddRow(1, variantID, "p-1", "Beras", "5kg", 60000.0, 55000.0, due, "PENDING", false, now))
+
+	list, err := repo.ListPending(context.Background(), sellerID, nil)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, due, list[0].EffectiveAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,117 @@
+// Package pricechange lets sellers schedule a variant's price ahead of
+// time and applies it when it falls due.
+package pricechange
+
+import (
+	"context"
+	"time"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type Service interface {
+	// Schedule sets the price a variant of the caller's store changes to
+	// at EffectiveAt. A variant has at most one pending change.
+	Schedule(ctx context.Context, in ScheduleInput) (*Change, error)
+	Cancel(ctx context.Context, id int64) error
+	// GetMine lists the store's pending changes, soonest first.
+	GetMine(ctx context.Context, variantID *string) ([]*Change, error)
+
+	// ApplyDue applies the changes that fell due, leaving those of
+	// variants on sale for when the sale ends. It returns how many were
+	// applied.
+	ApplyDue(ctx context.Context) (int64, error)
+}
+
+type service struct {
+	repo Repository
+	now  func() time.Time
+}
+
+func NewService(repo Repository) Service {
+	return &service{repo: repo, now: time.Now}
+}
+
+func (s *service) Schedule(ctx context.Context, in ScheduleInput) (*Change, error) {
+	sellerID, err := currentSeller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	userID, _ := utils.GetUserIDFromContext(ctx)
+
+	if _, err := uuid.Parse(in.VariantID); err != nil {
+		return nil, ErrInvalidVariantID
+	}
+	if in.Price <= 0 {
+		return nil, ErrInvalidPrice
+	}
+	now := s.now()
+	if !in.EffectiveAt.After(now) || in.EffectiveAt.After(now.Add(maxLeadTime)) {
+		return nil, ErrInvalidEffectiveAt
+	}
+
+	return s.repo.Add(ctx, sellerID, int32(userID), in)
+}
+
+func (s *service) Cancel(ctx context.Context, id int64) error {
+	sellerID, err := currentSeller(ctx)
+	if err != nil {
+		return err
+	}
+
+	ok, err := s.repo.Cancel(ctx, sellerID, id)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrChangeNotFound
+	}
+	return nil
+}
+
+func (s *service) GetMine(ctx context.Context, variantID *string) ([]*Change, error) {
+	sellerID, err := currentSeller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if variantID != nil {
+		if _, err := uuid.Parse(*variantID); err != nil {
+			return nil, ErrInvalidVariantID
+		}
+	}
+	return s.repo.ListPending(ctx, sellerID, variantID)
+}
+
+func (s *service) ApplyDue(ctx context.Context) (int64, error) {
+	var applied int64
+	for applied < maxApplyPerRun {
+		n, err := s.repo.ApplyDue(ctx, applyBatchSize)
+		if err != nil {
+			return applied, err
+		}
+		applied += n
+		if n < int64(applyBatchSize) {
+			break
+		}
+	}
+
+	if applied > 0 {
+		logger.FromCtx(ctx).Info("scheduled price changes applied",
+			zap.String("layer", "service"),
+			zap.String("method", "ApplyDue"),
+			zap.Int64("count", applied),
+		)
+	}
+	return applied, nil
+}
+
+func currentSeller(ctx context.Context) (string, error) {
+	sellerID, ok := utils.GetSellerIDFromContext(ctx)
+	if !ok {
+		return "", ErrNotSeller
+	}
+	return sellerID, nil
+}
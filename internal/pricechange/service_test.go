@@ -0,0 +1,152 @@
+package pricechange
+
+import (
+	"context"
+	"testing"
+	"time"
+	"warimas-be/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// --- Mocks ---
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) Add(ctx context.Context, sellerID string, userID int32, in ScheduleInput) (*Change, error) {
+	args := m.Called(ctx, sellerID, userID, in)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Change), args.Error(1)
+}
+
+func (m *MockRepository) ListPending(ctx context.Context, sellerID string, variantID *string) ([]*Change, error) {
+	args := m.Called(ctx, sellerID, variantID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Change), args.Error(1)
+}
+
+func (m *MockRepository) Cancel(ctx context.Context, sellerID string, id int64) (bool, error) {
+	args := m.Called(ctx, sellerID, id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRepository) ApplyDue(ctx context.Context, limit int32) (int64, error) {
+	args := m.Called(ctx, limit)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// --- Tests ---
+
+const (
+	sellerID  = "0b3e5f7a-1c2d-4e6f-8a9b-0c1d2e3f4a5b"
+	variantID = "6f1c2a8e-3b0d-4c55-9a7e-2d1f0b8c4e11"
+)
+
+var now = time.Date(2026, 3, 1, 9, 0, 0, 0, time.UTC)
+
+func sellerContext() context.Context {
+	return utils.WithPrincipal(context.Background(), &utils.Principal{
+		ID:       7,
+		Roles:    []string{utils.RoleAdmin},
+		SellerID: sellerID,
+	})
+}
+
+func newTestService(repo Repository) *service {
+	return &service{repo: repo, now: func() time.Time { return now }}
+}
+
+func TestService_Schedule(t *testing.T) {
+	ctx := sellerContext()
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := newTestService(mockRepo)
+
+		in := ScheduleInput{VariantID: variantID, Price: 55000, EffectiveAt: now.Add(24 * time.Hour)}
+		mockRepo.On("Add", ctx, sellerID, int32(7), in).Return(&Change{ID: 1, Price: 55000}, nil)
+
+		c, err := svc.Schedule(ctx, in)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), c.ID)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		svc := newTestService(new(MockRepository))
+
+		tests := []struct {
+			name string
+			in   ScheduleInput
+			err  error
+		}{
+			{"BadVariantID", ScheduleInput{VariantID: "x", Price: 1, EffectiveAt: now.Add(time.Hour)}, ErrInvalidVariantID},
+			{"ZeroPrice", ScheduleInput{VariantID: variantID, EffectiveAt: now.Add(time.Hour)}, ErrInvalidPrice},
+			{"InThePast", ScheduleInput{VariantID: variantID, Price: 1, EffectiveAt: now}, ErrInvalidEffectiveAt},
+			{"TooFarAhead", ScheduleInput{VariantID: variantID, Price: 1, EffectiveAt: now.Add(maxLeadTime + time.Hour)}, ErrInvalidEffectiveAt},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				_, err := svc.Schedule(ctx, tt.in)
+				assert.ErrorIs(t, err, tt.err)
+			})
+		}
+	})
+
+	t.Run("NotSeller", func(t *testing.T) {
+		svc := newTestService(new(MockRepository))
+		userCtx := utils.SetUserContext(context.Background(), 7, "test@example.com", "USER")
+
+		_, err := svc.Schedule(userCtx, ScheduleInput{VariantID: variantID, Price: 1, EffectiveAt: now.Add(time.Hour)})
+		assert.ErrorIs(t, err, ErrNotSeller)
+	})
+}
+
+func TestService_Cancel(t *testing.T) {
+	ctx := sellerContext()
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("Cancel", ctx, sellerID, int64(3)).Return(true, nil)
+
+		assert.NoError(t, newTestService(mockRepo).Cancel(ctx, 3))
+	})
+
+	t.Run("NotPending", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("Cancel", ctx, sellerID, int64(3)).Return(false, nil)
+
+		assert.ErrorIs(t, newTestService(mockRepo).Cancel(ctx, 3), ErrChangeNotFound)
+	})
+}
+
+func TestService_ApplyDue(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("StopsAtPartialBatch", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("ApplyDue", ctx, applyBatchSize).Return(int64(applyBatchSize), nil).Once()
+		mockRepo.On("ApplyDue", ctx, applyBatchSize).Return(int64(3), nil).Once()
+
+		n, err := newTestService(mockRepo).ApplyDue(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(applyBatchSize)+3, n)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("CappedPerRun", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("ApplyDue", ctx, applyBatchSize).Return(int64(applyBatchSize), nil)
+
+		n, err := newTestService(mockRepo).ApplyDue(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(maxApplyPerRun), n)
+	})
+}
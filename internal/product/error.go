@@ -1,5 +1,24 @@
 package product
 
-import "errors"
+import (
+	"errors"
+	"fmt"
+	"warimas-be/internal/apperr"
+)
 
 var ErrRepositoryFailure = errors.New("internal data access error")
+
+var ErrInvalidComparison = fmt.Errorf("compare between 1 and %d products", MaxCompareProducts)
+
+var ErrInvalidProductStatus = errors.New("status must be active, disable or archived")
+
+// ErrNotSeller rejects product writes from callers without a seller
+// account.
+var ErrNotSeller = apperr.Forbidden("unauthorized")
+
+// ErrCompareAtBelowPrice rejects a compare-at price lower than the price
+// it is shown against.
+var ErrCompareAtBelowPrice = apperr.Invalid("compare-at price cannot be lower than the price")
+
+// ErrInvalidQuantityType rejects a quantity type outside QuantityTypes.
+var ErrInvalidQuantityType = apperr.Invalid("quantity type must be one of unit, kg, liter, sack")
@@ -1,6 +1,10 @@
 package product
 
-import "time"
+import (
+	"math"
+	"time"
+	"warimas-be/internal/geo"
+)
 
 type ProductSortField int
 
@@ -23,19 +27,54 @@ type Variant struct {
 	ProductID    string
 	QuantityType string
 	Price        float64
-	Stock        int32
-	ImageURL     string
-	CategoryID   *string
-	SellerID     string
-	CreatedAt    string
-	Description  *string
+	// CompareAtPrice is the original price shown struck through next to
+	// Price while the variant is on promotion; nil otherwise.
+	CompareAtPrice *float64
+	Stock          int32
+	ImageURL       string
+	CategoryID     *string
+	SellerID       string
+	CreatedAt      time.Time
+	Description    *string
+	// Shipping is nil when the query did not load it.
+	Shipping *ShippingSpec
+}
+
+// DiscountPercent is how much Price is below CompareAtPrice, rounded to a
+// whole percent. It is nil when the variant has no promotion.
+func (v *Variant) DiscountPercent() *int32 {
+	if v.CompareAtPrice == nil || *v.CompareAtPrice <= 0 || *v.CompareAtPrice <= v.Price {
+		return nil
+	}
+	pct := int32(math.Round((*v.CompareAtPrice - v.Price) / *v.CompareAtPrice * 100))
+	return &pct
+}
+
+// ShippingSpec is a variant's packed weight and box size, used to work
+// out the chargeable weight of a shipment.
+type ShippingSpec struct {
+	WeightGrams int32
+	LengthCm    int32
+	WidthCm     int32
+	HeightCm    int32
 }
 
 type Product struct {
-	ID              string
-	Name            string
-	SellerID        string
-	SellerName      string
+	ID         string
+	Name       string
+	SellerID   string
+	SellerName string
+	StoreSlug  *string
+	// UnavailableUntil is set while the seller is on vacation: the
+	// product can not be checked out until then.
+	UnavailableUntil *time.Time
+	// OriginID is the seller shipping origin the product ships from; nil
+	// means the seller's default origin. Not read by GetProductsByGroup.
+	OriginID *string
+	// ShipsFrom is the origin the product actually leaves from, its own or
+	// the seller's default. Only checkout fills it in; nil means the
+	// platform origin.
+	ShipsFrom       *ShipsFrom
 	CategoryID      string
 	CategoryName    string
 	SubcategoryID   string
@@ -48,6 +87,42 @@ type Product struct {
 	CreatedAt       time.Time
 	UpdatedAt       *time.Time
 }
+
+type ShipsFrom struct {
+	OriginID string
+	City     string
+	// Location is nil when the seller has not pinned the origin.
+	Location *geo.Point
+}
+
+// MaxCompareProducts is how many products one comparison takes.
+const MaxCompareProducts = 4
+
+// Comparison lines products up for a compare page. Each attribute has one
+// value per product, in the order of Products.
+type Comparison struct {
+	Products   []*ComparedProduct
+	Attributes []*ComparisonAttribute
+}
+
+// ComparedProduct is a product with its variants summarised. The price
+// range is nil for a product without variants.
+type ComparedProduct struct {
+	Product  *Product
+	MinPrice *float64
+	MaxPrice *float64
+	InStock  bool
+}
+
+// ComparisonAttribute is one row of a comparison. A value is nil where the
+// product has none; Differs is set when the products do not all agree.
+type ComparisonAttribute struct {
+	Key     string
+	Label   string
+	Values  []*string
+	Differs bool
+}
+
 type ProductByCategory struct {
 	CategoryName  string
 	CategorySlug  string
@@ -112,14 +187,29 @@ type UpdateProductInput struct {
 	Status        *string
 }
 
+// CartRemoval is a cart line dropped because its product stopped being
+// active.
+type CartRemoval struct {
+	UserID      int32
+	VariantID   string
+	VariantName string
+	ProductName string
+	Quantity    int32
+}
+
 type NewVariantInput struct {
-	ProductID    string
-	QuantityType string
-	Name         string
-	Price        float64
-	Stock        int32
-	ImageURL     *string
-	Description  *string
+	ProductID      string
+	QuantityType   string
+	Name           string
+	Price          float64
+	CompareAtPrice *float64
+	Stock          int32
+	ImageURL       *string
+	Description    *string
+	WeightGrams    int32
+	LengthCm       int32
+	WidthCm        int32
+	HeightCm       int32
 }
 
 type UpdateVariantInput struct {
@@ -128,7 +218,13 @@ type UpdateVariantInput struct {
 	QuantityType *string
 	Name         *string
 	Price        *float64
-	Stock        *int32
-	ImageURL     *string
-	Description  *string
+	// CompareAtPrice of 0 ends the variant's promotion.
+	CompareAtPrice *float64
+	Stock          *int32
+	ImageURL       *string
+	Description    *string
+	WeightGrams    *int32
+	LengthCm       *int32
+	WidthCm        *int32
+	HeightCm       *int32
 }
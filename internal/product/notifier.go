@@ -0,0 +1,30 @@
+package product
+
+import (
+	"context"
+	"warimas-be/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// Notifier tells customers about items taken out of their carts. A failed
+// notice is logged and not retried; the items are already gone.
+type Notifier interface {
+	NotifyCartItemsRemoved(ctx context.Context, userID int32, items []*CartRemoval) error
+}
+
+// LogNotifier writes each notice at info level until a push or email
+// provider is wired in.
+type LogNotifier struct{}
+
+func (LogNotifier) NotifyCartItemsRemoved(ctx context.Context, userID int32, items []*CartRemoval) error {
+	variantIDs := make([]string, 0, len(items))
+	for _, it := range items {
+		variantIDs = append(variantIDs, it.VariantID)
+	}
+	logger.FromCtx(ctx).Info("cart items removed notice",
+		zap.Int32("user_id", userID),
+		zap.Strings("variant_ids", variantIDs),
+	)
+	return nil
+}
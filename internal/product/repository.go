@@ -9,8 +9,11 @@ import (
 	"strings"
 	"time"
 	"warimas-be/internal/logger"
+	"warimas-be/internal/moderation"
+	"warimas-be/internal/sqlbuilder"
 	"warimas-be/internal/utils"
 
+	"github.com/lib/pq"
 	"go.uber.org/zap"
 )
 
@@ -18,7 +21,16 @@ type Repository interface {
 	GetProductsByGroup(ctx context.Context, opts ProductQueryOptions) ([]ProductByCategory, error)
 	GetList(ctx context.Context, opts ProductQueryOptions) ([]*Product, *int, error)
 	Create(ctx context.Context, input NewProductInput, sellerID string) (Product, error)
-	Update(ctx context.Context, input UpdateProductInput, sellerID string) (Product, error)
+	// Update changes the given fields of a seller's product. A status change
+	// is applied to the product's variants in the same transaction, and
+	// leaving 'active' also takes them out of every cart; those cart lines
+	// are returned.
+	Update(ctx context.Context, input UpdateProductInput, sellerID string) (Product, []*CartRemoval, error)
+	// SetStatus and ArchiveCascade change only a product's status, with the
+	// same cascade as Update. ArchiveCascade also marks the product deleted,
+	// after which no read or write finds it.
+	SetStatus(ctx context.Context, productID, sellerID, status string) (Product, []*CartRemoval, error)
+	ArchiveCascade(ctx context.Context, productID, sellerID string) (Product, []*CartRemoval, error)
 	BulkCreateVariants(
 		ctx context.Context,
 		input []*NewVariantInput,
@@ -31,6 +43,15 @@ type Repository interface {
 	) ([]*Variant, error)
 	GetProductByID(ctx context.Context, productParams GetProductOptions) (*Product, error)
 	GetProductVariantByID(ctx context.Context, productParams GetVariantOptions) (*Variant, error)
+	// GetProductsByIDs loads the given products with their variants and
+	// shipping specs in one query. Missing products are left out, and the
+	// order of the result is not the order of ids.
+	GetProductsByIDs(ctx context.Context, ids []string, onlyActive bool) ([]*Product, error)
+
+	ModerationContent(ctx context.Context, t moderation.ContentType, id string) (*moderation.Content, error)
+	RemoveModerationContent(ctx context.Context, t moderation.ContentType, id string) error
+	HideModerationContent(ctx context.Context, t moderation.ContentType, id string) error
+	UnhideModerationContent(ctx context.Context, t moderation.ContentType, id string) error
 }
 
 type repository struct {
@@ -41,6 +62,34 @@ func NewRepository(db *sql.DB) Repository {
 	return &repository{db: db}
 }
 
+// A seller is on vacation while one of their store_vacations covers
+// NOW(). Their products can not be checked out until it ends, and a
+// HIDDEN vacation also takes them out of what shoppers see, as does
+// moderation hiding a reported product.
+const (
+	unavailableUntilColumn = `(
+		SELECT sv.ends_at FROM store_vacations sv
+		WHERE sv.seller_id = p.seller_id
+		  AND sv.starts_at <= NOW() AND sv.ends_at > NOW()
+		LIMIT 1
+	) AS unavailable_until`
+
+	notHiddenFromShoppers = `NOT EXISTS (
+		SELECT 1 FROM store_vacations sv
+		WHERE sv.seller_id = p.seller_id
+		  AND sv.mode = 'HIDDEN'
+		  AND sv.starts_at <= NOW() AND sv.ends_at > NOW()
+	) AND p.moderation_hidden_at IS NULL`
+)
+
+// Archived products stay out of catalogue lists unless asked for by
+// status, and deleted ones are gone from every read; both are kept for
+// the orders that reference them.
+const (
+	notArchived = `p.status <> 'archived'`
+	notDeleted  = `p.deleted_at IS NULL`
+)
+
 func (r *repository) GetProductsByGroup(
 	ctx context.Context,
 	opts ProductQueryOptions,
@@ -77,7 +126,13 @@ func (r *repository) GetProductsByGroup(
 		prodConditions = append(prodConditions, fmt.Sprintf("p.status = $%d", argCounter))
 		args = append(args, "active")
 		argCounter++
+	} else {
+		prodConditions = append(prodConditions, notArchived)
+	}
+	if opts.OnlyActive {
+		prodConditions = append(prodConditions, notHiddenFromShoppers)
 	}
+	prodConditions = append(prodConditions, notDeleted)
 
 	// Search Filter (Product Name)
 	if opts.Search != nil {
@@ -194,8 +249,11 @@ func (r *repository) GetProductsByGroup(
 	    v.stock,
 	    v.imageurl,
 		v.quantity_type,
+		v.compare_at_price,
 
-		sellers.name
+		sellers.name,
+		st.slug AS store_slug,
+		`+unavailableUntilColumn+`
 
 	FROM (
 		SELECT * FROM category c
@@ -221,6 +279,7 @@ func (r *repository) GetProductsByGroup(
 	) AS p ON true
 
 	LEFT JOIN sellers ON sellers.id = p.seller_id
+	LEFT JOIN stores st ON st.seller_id = p.seller_id
 
 	-- Join variants
 	LEFT JOIN variants v ON v.product_id = p.id
@@ -270,8 +329,11 @@ func (r *repository) GetProductsByGroup(
 			vStock        sql.NullInt32
 			vImageURL     sql.NullString
 			vQuantityType sql.NullString
+			vCompareAt    *float64
 
 			SellerName sql.NullString
+			StoreSlug  *string
+			Until      *time.Time
 		)
 
 		if err := rows.Scan(
@@ -282,8 +344,10 @@ func (r *repository) GetProductsByGroup(
 			&subcategoryName,
 			&totalProducts,
 			&pID, &pName, &pSellerID, &pSlug, &pStatus,
-			&vID, &vProdID, &vName, &vPrice, &vStock, &vImageURL, &vQuantityType,
+			&vID, &vProdID, &vName, &vPrice, &vStock, &vImageURL, &vQuantityType, &vCompareAt,
 			&SellerName,
+			&StoreSlug,
+			&Until,
 		); err != nil {
 			log.Error("failed to scan grouped product row", zap.Error(err))
 			return nil, err
@@ -316,17 +380,19 @@ func (r *repository) GetProductsByGroup(
 
 			if _, ok := productMap[productKey]; !ok {
 				product := &Product{
-					ID:              pID.String,
-					Name:            pName.String,
-					SellerID:        pSellerID.String,
-					SellerName:      SellerName.String,
-					Status:          pStatus.String,
-					CategoryID:      catID,
-					CategoryName:    categoryName.String,
-					SubcategoryID:   subcategoryID.String,
-					SubcategoryName: subcategoryName.String,
-					Slug:            pSlug.String,
-					Variants:        make([]*Variant, 0, 4),
+					ID:               pID.String,
+					Name:             pName.String,
+					SellerID:         pSellerID.String,
+					SellerName:       SellerName.String,
+					StoreSlug:        StoreSlug,
+					UnavailableUntil: Until,
+					Status:           pStatus.String,
+					CategoryID:       catID,
+					CategoryName:     categoryName.String,
+					SubcategoryID:    subcategoryID.String,
+					SubcategoryName:  subcategoryName.String,
+					Slug:             pSlug.String,
+					Variants:         make([]*Variant, 0, 4),
 				}
 
 				productMap[productKey] = product
@@ -340,13 +406,14 @@ func (r *repository) GetProductsByGroup(
 				productMap[productKey].Variants = append(
 					productMap[productKey].Variants,
 					&Variant{
-						ID:           vID.String,
-						ProductID:    vProdID.String,
-						Name:         vName.String,
-						Price:        vPrice.Float64,
-						QuantityType: vQuantityType.String,
-						Stock:        vStock.Int32,
-						ImageURL:     vImageURL.String,
+						ID:             vID.String,
+						ProductID:      vProdID.String,
+						Name:           vName.String,
+						Price:          vPrice.Float64,
+						CompareAtPrice: vCompareAt,
+						QuantityType:   vQuantityType.String,
+						Stock:          vStock.Int32,
+						ImageURL:       vImageURL.String,
 					},
 				)
 			}
@@ -387,21 +454,12 @@ func (r *repository) GetList(
 	start := time.Now()
 
 	// 1. Build Dynamic Query Parts
-	var (
-		joinClauses []string
-		where       []string
-		having      []string
-		args        []any
-	)
-
-	// Helper to add arg and return placeholder
-	addArg := func(v any) string {
-		args = append(args, v)
-		return fmt.Sprintf("$%d", len(args))
-	}
+	var joinClauses []string
+	qb := sqlbuilder.New()
 
 	// Always need these joins for the main selection
 	joinClauses = append(joinClauses, "LEFT JOIN sellers ON sellers.id = p.seller_id")
+	joinClauses = append(joinClauses, "LEFT JOIN stores st ON st.seller_id = p.seller_id")
 	joinClauses = append(joinClauses, "LEFT JOIN category c ON c.id = p.category_id")
 	joinClauses = append(joinClauses, "LEFT JOIN subcategories s ON s.id = p.subcategory_id")
 	joinClauses = append(joinClauses, "LEFT JOIN variants v ON v.product_id = p.id")
@@ -409,27 +467,27 @@ func (r *repository) GetList(
 	/* ---------- FILTERS ---------- */
 
 	if opts.SellerID != nil {
-		where = append(where, fmt.Sprintf("p.seller_id = %s", addArg(*opts.SellerID)))
+		qb.Where("p.seller_id = ?", *opts.SellerID)
 	}
 
 	if opts.CategoryID != nil {
-		where = append(where, fmt.Sprintf("p.category_id = %s", addArg(*opts.CategoryID)))
+		qb.Where("p.category_id = ?", *opts.CategoryID)
 	}
 
 	if opts.CategorySlug != nil {
-		where = append(where, fmt.Sprintf("c.slug = %s", addArg(*opts.CategorySlug)))
+		qb.Where("c.slug = ?", *opts.CategorySlug)
 	}
 
 	if opts.SellerName != nil {
-		where = append(where, fmt.Sprintf("sellers.name ILIKE %s", addArg("%"+*opts.SellerName+"%")))
+		qb.Where("sellers.name ILIKE ?", "%"+*opts.SellerName+"%")
 	}
 
 	if opts.Search != nil {
-		where = append(where, fmt.Sprintf("p.name ILIKE %s", addArg("%"+*opts.Search+"%")))
+		qb.Where("p.name ILIKE ?", "%"+*opts.Search+"%")
 	}
 
 	if opts.InStock != nil && *opts.InStock {
-		where = append(where, `
+		qb.Where(`
 			EXISTS (
 				SELECT 1 FROM variants v2
 				WHERE v2.product_id = p.id
@@ -440,26 +498,30 @@ func (r *repository) GetList(
 
 	// ---- STATUS & VISIBILITY (single source of truth) ----
 	if opts.Status != nil {
-		where = append(where, fmt.Sprintf("p.status = %s", addArg(*opts.Status)))
+		qb.Where("p.status = ?", *opts.Status)
 	} else if opts.OnlyActive {
-		where = append(where, "p.status = 'active'")
+		qb.Where("p.status = 'active'")
+	} else {
+		qb.Where(notArchived)
 	}
+	if opts.OnlyActive {
+		qb.Where(notHiddenFromShoppers)
+	}
+	qb.Where(notDeleted)
 
 	/* ---------- PRICE FILTERS (HAVING) ---------- */
 
 	if opts.MinPrice != nil {
-		having = append(having, fmt.Sprintf("MIN(v.price) >= %s", addArg(*opts.MinPrice)))
+		qb.Having("MIN(v.price) >= ?", *opts.MinPrice)
 	}
 
 	if opts.MaxPrice != nil {
-		having = append(having, fmt.Sprintf("MIN(v.price) <= %s", addArg(*opts.MaxPrice)))
+		qb.Having("MIN(v.price) <= ?", *opts.MaxPrice)
 	}
 
 	// Construct Base FROM + JOIN + WHERE
-	baseQuery := "FROM products p " + strings.Join(joinClauses, " ")
-	if len(where) > 0 {
-		baseQuery += " WHERE " + strings.Join(where, " AND ")
-	}
+	baseQuery := "FROM products p " + strings.Join(joinClauses, " ") + qb.WhereClause()
+	having := qb.HavingClause()
 
 	/* ---------- PAGINATION NORMALIZATION ---------- */
 
@@ -480,8 +542,8 @@ func (r *repository) GetList(
 	log.Debug("get product list started",
 		zap.Int32("page", page),
 		zap.Int32("limit", limit),
-		zap.Int("where_conditions", len(where)),
-		zap.Int("having_conditions", len(having)),
+		zap.String("where", qb.WhereClause()),
+		zap.String("having", having),
 		zap.Bool("include_count", opts.IncludeCount),
 	)
 
@@ -492,7 +554,7 @@ func (r *repository) GetList(
 	if opts.IncludeCount {
 		var countQuery string
 		// Optimization: If no HAVING clause, we can use COUNT(DISTINCT p.id)
-		if len(having) == 0 {
+		if having == "" {
 			countQuery = "SELECT COUNT(DISTINCT p.id) " + baseQuery
 		} else {
 			// With HAVING, we must group first
@@ -501,12 +563,12 @@ func (r *repository) GetList(
 					SELECT p.id
 					%s
 					GROUP BY p.id
-					HAVING %s
-				) AS sub`, baseQuery, strings.Join(having, " AND "))
+					%s
+				) AS sub`, baseQuery, having)
 		}
 
 		var total int
-		if err := r.db.QueryRowContext(ctx, countQuery, args...).Scan(&total); err != nil {
+		if err := r.db.QueryRowContext(ctx, countQuery, qb.Args()...).Scan(&total); err != nil {
 			log.Error("count query failed", zap.Error(err))
 			return nil, nil, fmt.Errorf("failed to count products: %w", err)
 		}
@@ -524,21 +586,15 @@ func (r *repository) GetList(
 		orderBy = "p.name"
 	}
 
-	dir := "DESC"
-	if opts.SortDirection == SortDirectionAsc {
-		dir = "ASC"
-	}
-
-	// Add limit/offset to args
-	limitPlaceholder := addArg(limit)
-	offsetPlaceholder := addArg(offset)
-
 	selectQuery := fmt.Sprintf(`
 SELECT
 	p.id,
 	p.name,
 	p.seller_id,
 	COALESCE(sellers.name, 'Unknown') AS seller_name,
+	st.slug AS store_slug,
+	`+unavailableUntilColumn+`,
+	p.origin_id,
 	p.status,
 	p.category_id,
 	p.subcategory_id,
@@ -556,6 +612,7 @@ SELECT
 				'productId', v.product_id,
 				'name', v.name,
 				'price', v.price,
+				'compareAtPrice', v.compare_at_price,
 				'stock', v.stock,
 				'imageUrl', v.imageurl,
 				'quantityType', v.quantity_type
@@ -565,18 +622,14 @@ SELECT
 	) AS variants
 %s
 GROUP BY
-	p.id, sellers.name, c.name, s.name
+	p.id, sellers.name, st.slug, c.name, s.name
 `, baseQuery)
 
-	if len(having) > 0 {
-		selectQuery += " HAVING " + strings.Join(having, " AND ")
-	}
-
-	selectQuery += fmt.Sprintf(" ORDER BY %s %s LIMIT %s OFFSET %s", orderBy, dir, limitPlaceholder, offsetPlaceholder)
+	selectQuery += having + sqlbuilder.OrderBy(orderBy, string(opts.SortDirection)) + qb.Page(limit, offset)
 
 	/* ---------- EXEC ---------- */
 
-	rows, err := r.db.QueryContext(ctx, selectQuery, args...)
+	rows, err := r.db.QueryContext(ctx, selectQuery, qb.Args()...)
 	if err != nil {
 		log.Error("data query failed", zap.Error(err))
 		return nil, totalProduct, fmt.Errorf("failed to fetch product list: %w", err)
@@ -596,6 +649,9 @@ GROUP BY
 			&p.Name,
 			&p.SellerID,
 			&p.SellerName,
+			&p.StoreSlug,
+			&p.UnavailableUntil,
+			&p.OriginID,
 			&p.Status,
 			&p.CategoryID,
 			&p.SubcategoryID,
@@ -734,7 +790,7 @@ func (r *repository) Update(
 	ctx context.Context,
 	input UpdateProductInput,
 	sellerID string,
-) (Product, error) {
+) (Product, []*CartRemoval, error) {
 
 	log := logger.FromCtx(ctx).With(
 		zap.String("layer", "repository"),
@@ -749,7 +805,7 @@ func (r *repository) Update(
 	queryTpl := `
 		UPDATE products
 		SET %s
-		WHERE id = $%d AND seller_id = $%d
+		WHERE id = $%d AND seller_id = $%d AND deleted_at IS NULL
 		RETURNING id, name, imageurl, description, category_id, seller_id, subcategory_id, status
 	`
 
@@ -805,7 +861,7 @@ func (r *repository) Update(
 	// 🚨 No fields to update
 	if len(setClauses) == 0 {
 		log.Warn("update product skipped: no fields to update")
-		return Product{}, errors.New("no fields to update")
+		return Product{}, nil, errors.New("no fields to update")
 	}
 
 	// WHERE clause args
@@ -818,8 +874,15 @@ func (r *repository) Update(
 		argPos+1,
 	)
 
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error("failed to begin transaction", zap.Error(err))
+		return Product{}, nil, err
+	}
+	defer tx.Rollback()
+
 	var product Product
-	err := r.db.QueryRowContext(ctx, finalQuery, args...).Scan(
+	err = tx.QueryRowContext(ctx, finalQuery, args...).Scan(
 		&product.ID,
 		&product.Name,
 		&product.ImageURL,
@@ -833,19 +896,81 @@ func (r *repository) Update(
 	if err != nil {
 		if err == sql.ErrNoRows {
 			log.Warn("product not found or not owned by seller")
-			return Product{}, errors.New("product not found or not owned by seller")
+			return Product{}, nil, errors.New("product not found or not owned by seller")
 		}
 
 		log.Error("failed to update product", zap.Error(err))
-		return Product{}, err
+		return Product{}, nil, err
+	}
+
+	var removed []*CartRemoval
+	if input.Status != nil {
+		removed, err = cascadeProductStatus(ctx, tx, product.ID, product.Status)
+		if err != nil {
+			log.Error("failed to cascade product status", zap.Error(err))
+			return Product{}, nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Error("failed to commit product update", zap.Error(err))
+		return Product{}, nil, err
 	}
 
 	log.Info("success update product",
 		zap.Strings("updated_fields", updatedFields),
+		zap.Int("cart_items_removed", len(removed)),
 		zap.Duration("duration", time.Since(start)),
 	)
 
-	return product, nil
+	return product, removed, nil
+}
+
+// cascadeProductStatus makes the product's variants follow its status. A
+// product that is no longer active also loses its variants from carts.
+func cascadeProductStatus(
+	ctx context.Context,
+	tx *sql.Tx,
+	productID string,
+	status string,
+) ([]*CartRemoval, error) {
+	active := status == utils.ProductStatusActive
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE variants
+		SET is_active = $2, updated_at = NOW()
+		WHERE product_id = $1
+		  AND is_active <> $2
+	`, productID, active); err != nil {
+		return nil, err
+	}
+
+	if active {
+		return nil, nil
+	}
+
+	rows, err := tx.QueryContext(ctx, `
+		DELETE FROM carts c
+		USING variants v
+		JOIN products p ON p.id = v.product_id
+		WHERE c.variant_id = v.id
+		  AND v.product_id = $1
+		RETURNING c.user_id, c.variant_id, v.name, p.name, c.quantity
+	`, productID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var removed []*CartRemoval
+	for rows.Next() {
+		var c CartRemoval
+		if err := rows.Scan(&c.UserID, &c.VariantID, &c.VariantName, &c.ProductName, &c.Quantity); err != nil {
+			return nil, err
+		}
+		removed = append(removed, &c)
+	}
+	return removed, rows.Err()
 }
 
 func (r *repository) BulkCreateVariants(
@@ -878,22 +1003,28 @@ func (r *repository) BulkCreateVariants(
 			name,
 			quantity_type,
 			price,
+			compare_at_price,
 			stock,
 			imageurl,
-			description
+			description,
+			weight_grams,
+			length_cm,
+			width_cm,
+			height_cm
 		) VALUES
 	`
 
-	args := make([]any, 0, len(input)*7)
+	args := make([]any, 0, len(input)*12)
 	valueStrings := make([]string, 0, len(input))
 
 	for i, v := range input {
-		idx := i * 7
+		idx := i * 12
 
 		valueStrings = append(valueStrings,
-			fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d)",
+			fmt.Sprintf("($%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d,$%d)",
 				idx+1, idx+2, idx+3,
 				idx+4, idx+5, idx+6, idx+7,
+				idx+8, idx+9, idx+10, idx+11, idx+12,
 			),
 		)
 
@@ -902,9 +1033,14 @@ func (r *repository) BulkCreateVariants(
 			v.Name,
 			v.QuantityType,
 			v.Price,
+			v.CompareAtPrice,
 			v.Stock,
 			v.ImageURL,
 			v.Description,
+			v.WeightGrams,
+			v.LengthCm,
+			v.WidthCm,
+			v.HeightCm,
 		)
 	}
 
@@ -916,13 +1052,21 @@ func (r *repository) BulkCreateVariants(
 			name,
 			quantity_type,
 			price,
+			compare_at_price,
 			stock,
 			imageurl,
-			created_at
+			created_at,
+			weight_grams,
+			length_cm,
+			width_cm,
+			height_cm
 	`
 
 	rows, err := r.db.QueryContext(ctx, query, args...)
 	if err != nil {
+		if isCompareAtViolation(err) {
+			return nil, ErrCompareAtBelowPrice
+		}
 		log.Error("failed to execute bulk insert variants", zap.Error(err))
 		return nil, err
 	}
@@ -932,19 +1076,26 @@ func (r *repository) BulkCreateVariants(
 
 	for rows.Next() {
 		var v Variant
+		var spec ShippingSpec
 		if err := rows.Scan(
 			&v.ID,
 			&v.ProductID,
 			&v.Name,
 			&v.QuantityType,
 			&v.Price,
+			&v.CompareAtPrice,
 			&v.Stock,
 			&v.ImageURL,
 			&v.CreatedAt,
+			&spec.WeightGrams,
+			&spec.LengthCm,
+			&spec.WidthCm,
+			&spec.HeightCm,
 		); err != nil {
 			log.Error("failed to scan created variant", zap.Error(err))
 			return nil, err
 		}
+		v.Shipping = &spec
 
 		variants = append(variants, &v)
 	}
@@ -962,6 +1113,14 @@ func (r *repository) BulkCreateVariants(
 	return variants, nil
 }
 
+// isCompareAtViolation reports whether err is chk_variants_compare_at_price
+// failing, which an update of only the price or only the compare-at price
+// can hit without the service seeing both.
+func isCompareAtViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Constraint == "chk_variants_compare_at_price"
+}
+
 func (r *repository) BulkUpdateVariants(
 	ctx context.Context,
 	input []*UpdateVariantInput,
@@ -1010,6 +1169,11 @@ func (r *repository) BulkUpdateVariants(
 			args = append(args, *v.Price)
 			argPos++
 		}
+		if v.CompareAtPrice != nil {
+			setClauses = append(setClauses, fmt.Sprintf("compare_at_price = NULLIF($%d::numeric, 0)", argPos))
+			args = append(args, *v.CompareAtPrice)
+			argPos++
+		}
 		if v.Stock != nil {
 			setClauses = append(setClauses, fmt.Sprintf("stock = $%d", argPos))
 			args = append(args, *v.Stock)
@@ -1025,6 +1189,26 @@ func (r *repository) BulkUpdateVariants(
 			args = append(args, *v.Description)
 			argPos++
 		}
+		if v.WeightGrams != nil {
+			setClauses = append(setClauses, fmt.Sprintf("weight_grams = $%d", argPos))
+			args = append(args, *v.WeightGrams)
+			argPos++
+		}
+		if v.LengthCm != nil {
+			setClauses = append(setClauses, fmt.Sprintf("length_cm = $%d", argPos))
+			args = append(args, *v.LengthCm)
+			argPos++
+		}
+		if v.WidthCm != nil {
+			setClauses = append(setClauses, fmt.Sprintf("width_cm = $%d", argPos))
+			args = append(args, *v.WidthCm)
+			argPos++
+		}
+		if v.HeightCm != nil {
+			setClauses = append(setClauses, fmt.Sprintf("height_cm = $%d", argPos))
+			args = append(args, *v.HeightCm)
+			argPos++
+		}
 
 		// ✅ Safety guard
 		if len(setClauses) == 0 {
@@ -1045,7 +1229,8 @@ func (r *repository) BulkUpdateVariants(
 			  AND product_id IN (
 			    SELECT id FROM products WHERE seller_id = $%d
 			  )
-			RETURNING id, product_id, name, price, stock, imageurl, description
+			RETURNING id, product_id, name, price, compare_at_price, stock, imageurl, description,
+			          weight_grams, length_cm, width_cm, height_cm
 		`,
 			strings.Join(setClauses, ", "),
 			argPos,
@@ -1054,15 +1239,24 @@ func (r *repository) BulkUpdateVariants(
 		)
 
 		var variant Variant
+		var spec ShippingSpec
 		if err := tx.QueryRowContext(ctx, query, args...).Scan(
 			&variant.ID,
 			&variant.ProductID,
 			&variant.Name,
 			&variant.Price,
+			&variant.CompareAtPrice,
 			&variant.Stock,
 			&variant.ImageURL,
 			&variant.Description,
+			&spec.WeightGrams,
+			&spec.LengthCm,
+			&spec.WidthCm,
+			&spec.HeightCm,
 		); err != nil {
+			if isCompareAtViolation(err) {
+				return nil, ErrCompareAtBelowPrice
+			}
 
 			log.Error("failed to update variant",
 				zap.String("variant_id", v.ID),
@@ -1071,6 +1265,7 @@ func (r *repository) BulkUpdateVariants(
 			)
 			return nil, err
 		}
+		variant.Shipping = &spec
 
 		updatedVariants = append(updatedVariants, &variant)
 	}
@@ -1116,6 +1311,9 @@ func (r *repository) GetProductByID(
 		c.name AS category_name,
 		s.name AS subcategory_name,
 		COALESCE(sel.name, 'UNKNOWN') as seller_name,
+		st.slug AS store_slug,
+		` + unavailableUntilColumn + `,
+		p.origin_id,
  
 		COALESCE(
 			json_agg(
@@ -1124,6 +1322,7 @@ func (r *repository) GetProductByID(
 					'productId', v.product_id,
 					'name', v.name,
 					'price', v.price,
+					'compareAtPrice', v.compare_at_price,
 					'stock', v.stock,
 					'imageUrl', v.imageurl,
 					'description', v.description
@@ -1137,6 +1336,7 @@ func (r *repository) GetProductByID(
 	LEFT JOIN subcategories s ON s.id = p.subcategory_id
 	LEFT JOIN variants v ON v.product_id = p.id
 	LEFT JOIN sellers sel on sel.id = p.seller_id
+	LEFT JOIN stores st ON st.seller_id = p.seller_id
 	WHERE p.id = $1
 	`
 
@@ -1148,9 +1348,10 @@ func (r *repository) GetProductByID(
 	args := []any{productParams.ProductID}
 
 	if productParams.OnlyActive {
-		query += " AND p.status = $2"
+		query += " AND p.status = $2 AND " + notHiddenFromShoppers
 		args = append(args, utils.ProductStatusActive)
 	}
+	query += " AND " + notDeleted
 
 	query += `
 		GROUP BY
@@ -1165,7 +1366,8 @@ func (r *repository) GetProductByID(
 		p.created_at,
 		c.name,
 		s.name,
-		sel.name
+		sel.name,
+		st.slug
  	`
 
 	err := r.db.QueryRowContext(ctx, query, args...).Scan(
@@ -1181,6 +1383,9 @@ func (r *repository) GetProductByID(
 		&product.CategoryName,
 		&product.SubcategoryName,
 		&product.SellerName,
+		&product.StoreSlug,
+		&product.UnavailableUntil,
+		&product.OriginID,
 		&variantsJSON,
 	)
 
@@ -1210,6 +1415,122 @@ func (r *repository) GetProductByID(
 	return &product, nil
 }
 
+func (r *repository) GetProductsByIDs(
+	ctx context.Context,
+	ids []string,
+	onlyActive bool,
+) ([]*Product, error) {
+
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "GetProductsByIDs"),
+		zap.Int("count", len(ids)),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+	SELECT
+		p.id,
+		p.name,
+		p.seller_id,
+		p.category_id,
+		p.subcategory_id,
+		p.slug,
+		p.imageurl,
+		p.description,
+		p.status,
+		p.created_at,
+
+		c.name AS category_name,
+		s.name AS subcategory_name,
+		COALESCE(sel.name, 'UNKNOWN') as seller_name,
+		st.slug AS store_slug,
+		`+unavailableUntilColumn+`,
+		p.origin_id,
+
+		COALESCE(
+			json_agg(
+				json_build_object(
+					'id', v.id,
+					'productId', v.product_id,
+					'name', v.name,
+					'price', v.price,
+					'compareAtPrice', v.compare_at_price,
+					'stock', v.stock,
+					'imageUrl', v.imageurl,
+					'description', v.description,
+					'shipping', json_build_object(
+						'weightGrams', v.weight_grams,
+						'lengthCm', v.length_cm,
+						'widthCm', v.width_cm,
+						'heightCm', v.height_cm
+					)
+				)
+				ORDER BY v.created_at NULLS LAST
+			) FILTER (WHERE v.id IS NOT NULL),
+			'[]'::json
+		) AS variants
+	FROM products p
+	LEFT JOIN category c ON c.id = p.category_id
+	LEFT JOIN subcategories s ON s.id = p.subcategory_id
+	LEFT JOIN variants v ON v.product_id = p.id
+	LEFT JOIN sellers sel on sel.id = p.seller_id
+	LEFT JOIN stores st ON st.seller_id = p.seller_id
+	WHERE p.id = ANY($1)
+	  AND (NOT $2 OR (p.status = $3 AND `+notHiddenFromShoppers+`))
+	  AND `+notDeleted+`
+	GROUP BY p.id, c.name, s.name, sel.name, st.slug
+	`, pq.Array(ids), onlyActive, utils.ProductStatusActive)
+	if err != nil {
+		log.Error("failed to query products", zap.Error(err))
+		return nil, ErrRepositoryFailure
+	}
+	defer rows.Close()
+
+	products := []*Product{}
+	for rows.Next() {
+		var (
+			p            Product
+			variantsJSON []byte
+		)
+		if err := rows.Scan(
+			&p.ID,
+			&p.Name,
+			&p.SellerID,
+			&p.CategoryID,
+			&p.SubcategoryID,
+			&p.Slug,
+			&p.ImageURL,
+			&p.Description,
+			&p.Status,
+			&p.CreatedAt,
+			&p.CategoryName,
+			&p.SubcategoryName,
+			&p.SellerName,
+			&p.StoreSlug,
+			&p.UnavailableUntil,
+			&p.OriginID,
+			&variantsJSON,
+		); err != nil {
+			log.Error("failed to scan product", zap.Error(err))
+			return nil, ErrRepositoryFailure
+		}
+
+		if err := json.Unmarshal(variantsJSON, &p.Variants); err != nil {
+			log.Error("failed to unmarshal variants", zap.Error(err))
+			return nil, ErrRepositoryFailure
+		}
+
+		products = append(products, &p)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error("product iteration failed", zap.Error(err))
+		return nil, ErrRepositoryFailure
+	}
+
+	return products, nil
+}
+
 func (r *repository) GetProductVariantByID(
 	ctx context.Context,
 	opts GetVariantOptions,
@@ -1231,12 +1552,17 @@ func (r *repository) GetProductVariantByID(
 		v.product_id,
 		v.quantity_type,
 		v.price,
+		v.compare_at_price,
 		v.stock,
 		v.imageurl,
 		p.category_id,
 		p.seller_id,
 		v.created_at,
-		v.description
+		v.description,
+		v.weight_grams,
+		v.length_cm,
+		v.width_cm,
+		v.height_cm
 	FROM variants v
 	JOIN products p ON v.product_id = p.id
 	WHERE v.id = $1
@@ -1245,11 +1571,12 @@ func (r *repository) GetProductVariantByID(
 	args := []any{opts.VariantID}
 
 	if opts.OnlyActive {
-		query += " AND p.status = $2"
+		query += " AND p.status = $2 AND v.is_active"
 		args = append(args, utils.ProductStatusActive)
 	}
 
 	var variant Variant
+	var spec ShippingSpec
 
 	row := r.db.QueryRowContext(ctx, query, args...)
 	err := row.Scan(
@@ -1258,12 +1585,17 @@ func (r *repository) GetProductVariantByID(
 		&variant.ProductID,
 		&variant.QuantityType,
 		&variant.Price,
+		&variant.CompareAtPrice,
 		&variant.Stock,
 		&variant.ImageURL,
 		&variant.CategoryID,
 		&variant.SellerID,
 		&variant.CreatedAt,
 		&variant.Description,
+		&spec.WeightGrams,
+		&spec.LengthCm,
+		&spec.WidthCm,
+		&spec.HeightCm,
 	)
 
 	if err == sql.ErrNoRows {
@@ -1275,6 +1607,8 @@ func (r *repository) GetProductVariantByID(
 		return nil, err
 	}
 
+	variant.Shipping = &spec
+
 	log.Info("success get variant by id")
 
 	return &variant, nil
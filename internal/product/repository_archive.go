@@ -0,0 +1,105 @@
+package product
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+// SetStatus moves a seller's product to status and applies it to the
+// product's variants in the same transaction, like a status change
+// through Update.
+func (r *repository) SetStatus(
+	ctx context.Context,
+	productID string,
+	sellerID string,
+	status string,
+) (Product, []*CartRemoval, error) {
+	return r.setStatus(ctx, "SetStatus", productID, sellerID, status, false)
+}
+
+// ArchiveCascade soft-deletes a seller's product: it is archived, marked
+// deleted and its variants are deactivated and taken out of every cart.
+func (r *repository) ArchiveCascade(
+	ctx context.Context,
+	productID string,
+	sellerID string,
+) (Product, []*CartRemoval, error) {
+	return r.setStatus(ctx, "ArchiveCascade", productID, sellerID, utils.ProductStatusArchived, true)
+}
+
+func (r *repository) setStatus(
+	ctx context.Context,
+	method string,
+	productID string,
+	sellerID string,
+	status string,
+	deleted bool,
+) (Product, []*CartRemoval, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", method),
+		zap.String("product_id", productID),
+		zap.String("seller_id", sellerID),
+		zap.String("status", status),
+	)
+
+	start := time.Now()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error("failed to begin transaction", zap.Error(err))
+		return Product{}, nil, err
+	}
+	defer tx.Rollback()
+
+	var product Product
+	err = tx.QueryRowContext(ctx, `
+		UPDATE products
+		SET status = $3,
+			deleted_at = CASE WHEN $4 THEN NOW() END
+		WHERE id = $1 AND seller_id = $2 AND deleted_at IS NULL
+		RETURNING id, name, imageurl, description, category_id, seller_id, subcategory_id, status
+	`, productID, sellerID, status, deleted).Scan(
+		&product.ID,
+		&product.Name,
+		&product.ImageURL,
+		&product.Description,
+		&product.CategoryID,
+		&product.SellerID,
+		&product.SubcategoryID,
+		&product.Status,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		log.Warn("product not found or not owned by seller")
+		return Product{}, nil, ErrProductNotFound
+	}
+	if err != nil {
+		log.Error("failed to set product status", zap.Error(err))
+		return Product{}, nil, err
+	}
+
+	removed, err := cascadeProductStatus(ctx, tx, product.ID, product.Status)
+	if err != nil {
+		log.Error("failed to cascade product status", zap.Error(err))
+		return Product{}, nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Error("failed to commit product status", zap.Error(err))
+		return Product{}, nil, err
+	}
+
+	log.Info("success set product status",
+		zap.Bool("deleted", deleted),
+		zap.Int("cart_items_removed", len(removed)),
+		zap.Duration("duration", time.Since(start)),
+	)
+
+	return product, removed, nil
+}
@@ -0,0 +1,89 @@
+package product
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/moderation"
+
+	"go.uber.org/zap"
+)
+
+// ModerationContent serves a product listing to the moderation queue; id
+// is the product ID and the author is the user who owns the seller
+// (sellers.user_id).
+func (r *repository) ModerationContent(ctx context.Context, t moderation.ContentType, id string) (*moderation.Content, error) {
+	if t != moderation.ContentProduct {
+		return nil, moderation.ErrInvalidContentType
+	}
+
+	var name string
+	var description sql.NullString
+	var author sql.NullInt32
+	err := r.db.QueryRowContext(ctx, `
+		SELECT p.name, p.description,
+			(SELECT s.user_id FROM sellers s WHERE s.id = p.seller_id)
+		FROM products p
+		WHERE p.id::text = $1
+	`, id).Scan(&name, &description, &author)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to load product content",
+			zap.String("layer", "repository"),
+			zap.String("product_id", id),
+			zap.Error(err),
+		)
+		return nil, ErrRepositoryFailure
+	}
+
+	body := name
+	if description.Valid && description.String != "" {
+		body += "\n\n" + description.String
+	}
+	c := &moderation.Content{Type: t, ID: id, Body: body}
+	if author.Valid {
+		c.AuthorID = &author.Int32
+	}
+	return c, nil
+}
+
+// HideModerationContent takes a reported product out of what shoppers see
+// while it is reviewed. The seller still sees and edits it.
+func (r *repository) HideModerationContent(ctx context.Context, t moderation.ContentType, id string) error {
+	return r.setModerationHidden(ctx, t, id, true)
+}
+
+func (r *repository) UnhideModerationContent(ctx context.Context, t moderation.ContentType, id string) error {
+	return r.setModerationHidden(ctx, t, id, false)
+}
+
+// RemoveModerationContent keeps a removed product hidden for good; only
+// the moderation queue can show it again.
+func (r *repository) RemoveModerationContent(ctx context.Context, t moderation.ContentType, id string) error {
+	return r.setModerationHidden(ctx, t, id, true)
+}
+
+func (r *repository) setModerationHidden(ctx context.Context, t moderation.ContentType, id string, hidden bool) error {
+	if t != moderation.ContentProduct {
+		return moderation.ErrInvalidContentType
+	}
+
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE products
+		SET moderation_hidden_at = CASE WHEN $2 THEN COALESCE(moderation_hidden_at, NOW()) END
+		WHERE id::text = $1
+	`, id, hidden)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to set product moderation visibility",
+			zap.String("layer", "repository"),
+			zap.String("product_id", id),
+			zap.Bool("hidden", hidden),
+			zap.Error(err),
+		)
+		return ErrRepositoryFailure
+	}
+	return nil
+}
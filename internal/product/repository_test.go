@@ -6,9 +6,11 @@ import (
 	"errors"
 	"testing"
 	"time"
+	"warimas-be/internal/moderation"
 	"warimas-be/internal/utils"
 
 	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -26,13 +28,13 @@ func TestRepository_GetProductsByGroup(t *testing.T) {
 		rows := sqlmock.NewRows([]string{
 			"category_id", "category_name", "category_slug", "subcategory_id", "subcategory_name", "total_products",
 			"product_id", "product_name", "seller_id", "slug", "status",
-			"variant_id", "variant_product_id", "variant_name", "variant_price", "stock", "imageurl", "quantity_type",
-			"seller_name",
+			"variant_id", "variant_product_id", "variant_name", "variant_price", "stock", "imageurl", "quantity_type", "compare_at_price",
+			"seller_name", "store_slug", "unavailable_until",
 		}).AddRow(
 			"cat1", "Category 1", "cat-slug-1", "sub1", "Sub 1", 5,
 			"p1", "Product 1", "s1", "slug-1", "active",
-			"v1", "p1", "Var 1", 100.0, 10, "img.jpg", "pcs",
-			"Seller A",
+			"v1", "p1", "Var 1", 100.0, 10, "img.jpg", "pcs", 125.0,
+			"Seller A", "s1-seller-a", nil,
 		)
 
 		// The query is complex, matching via regex
@@ -116,11 +118,11 @@ func TestRepository_GetList(t *testing.T) {
 
 		// Data Query
 		rows := sqlmock.NewRows([]string{
-			"id", "name", "seller_id", "seller_name", "status", "category_id", "subcategory_id",
+			"id", "name", "seller_id", "seller_name", "store_slug", "unavailable_until", "origin_id", "status", "category_id", "subcategory_id",
 			"slug", "imageurl", "description", "created_at", "updated_at",
 			"category_name", "subcategory_name", "variants",
 		}).AddRow(
-			"p1", "Product 1", "s1", "Seller A", "active", "c1", "sub1",
+			"p1", "Product 1", "s1", "Seller A", "s1-seller-a", nil, nil, "active", "c1", "sub1",
 			"slug-1", "img", "desc", time.Now(), nil,
 			"Cat 1", "Sub 1", `[{"id":"v1", "price": 100}]`,
 		)
@@ -152,15 +154,58 @@ func TestRepository_GetList(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("FiltersHavingAndCount_PlaceholdersInOrder", func(t *testing.T) {
+		sellerID := "s1"
+		search := "tea"
+		minP, maxP := 10.0, 50.0
+		opts := ProductQueryOptions{
+			SellerID:      &sellerID,
+			Search:        &search,
+			MinPrice:      &minP,
+			MaxPrice:      &maxP,
+			SortField:     ProductSortFieldName,
+			SortDirection: SortDirectionAsc,
+			Limit:         5,
+			Page:          3,
+			IncludeCount:  true,
+		}
+
+		// Count binds only the filter args
+		mock.ExpectQuery(`(?s)SELECT COUNT\(\*\) FROM .*WHERE p.seller_id = \$1 AND p.name ILIKE \$2.*HAVING MIN\(v.price\) >= \$3 AND MIN\(v.price\) <= \$4`).
+			WithArgs(sellerID, "%tea%", minP, maxP).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+
+		mock.ExpectQuery(`(?s)SELECT .* HAVING MIN\(v.price\) >= \$3 AND MIN\(v.price\) <= \$4 ORDER BY p.name ASC LIMIT \$5 OFFSET \$6`).
+			WithArgs(sellerID, "%tea%", minP, maxP, int32(5), int32(10)).
+			WillReturnRows(sqlmock.NewRows([]string{}))
+
+		_, total, err := repo.GetList(ctx, opts)
+		assert.NoError(t, err)
+		assert.Equal(t, 0, *total)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("OnlyActive_HidesVacationingSellers", func(t *testing.T) {
+		opts := ProductQueryOptions{Limit: 10, Page: 1, OnlyActive: true}
+
+		mock.ExpectQuery(`(?s)SELECT .*AS unavailable_until.* WHERE p.status = 'active' AND NOT EXISTS \(\s*SELECT 1 FROM store_vacations sv\s*WHERE sv.seller_id = p.seller_id\s*AND sv.mode = 'HIDDEN'`).
+			WithArgs(int32(10), int32(0)).
+			WillReturnRows(sqlmock.NewRows([]string{}))
+
+		_, _, err := repo.GetList(ctx, opts)
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
 	t.Run("JSONUnmarshalError", func(t *testing.T) {
 		// Test the branch where variants JSON is invalid
 		opts := ProductQueryOptions{Limit: 10, Page: 1}
 		rows := sqlmock.NewRows([]string{
-			"id", "name", "seller_id", "seller_name", "status", "category_id", "subcategory_id",
+			"id", "name", "seller_id", "seller_name", "store_slug", "unavailable_until", "origin_id", "status", "category_id", "subcategory_id",
 			"slug", "imageurl", "description", "created_at", "updated_at",
 			"category_name", "subcategory_name", "variants",
 		}).AddRow(
-			"p1", "Product 1", "s1", "Seller A", "active", "c1", "sub1",
+			"p1", "Product 1", "s1", "Seller A", "s1-seller-a", nil, nil, "active", "c1", "sub1",
 			"slug-1", "img", "desc", time.Now(), nil,
 			"Cat 1", "Sub 1", `invalid-json`, // <--- Invalid JSON
 		)
@@ -234,43 +279,169 @@ func TestRepository_Update(t *testing.T) {
 	sellerID := "s1"
 	name := "New Name"
 	input := UpdateProductInput{ID: "p1", Name: &name}
+	productCols := []string{
+		"id", "name", "imageurl", "description", "category_id", "seller_id", "subcategory_id", "status",
+	}
 
 	t.Run("Success", func(t *testing.T) {
-		mock.ExpectQuery(`UPDATE products SET name = \$1, slug = \$2 WHERE id = \$3 AND seller_id = \$4 RETURNING`).
+		mock.ExpectBegin()
+		mock.ExpectQuery(`UPDATE products SET name = \$1, slug = \$2 WHERE id = \$3 AND seller_id = \$4 AND deleted_at IS NULL RETURNING`).
 			WithArgs(name, sqlmock.AnyArg(), input.ID, sellerID).
-			WillReturnRows(sqlmock.NewRows([]string{
-				"id", "name", "imageurl", "description", "category_id", "seller_id", "subcategory_id", "status",
-			}).AddRow("p1", name, "img", "desc", "c1", "s1", "sub1", "active"))
+			WillReturnRows(sqlmock.NewRows(productCols).AddRow("p1", name, "img", "desc", "c1", "s1", "sub1", "active"))
+		mock.ExpectCommit()
 
-		p, err := repo.Update(ctx, input, sellerID)
+		p, removed, err := repo.Update(ctx, input, sellerID)
 		assert.NoError(t, err)
 		assert.Equal(t, name, p.Name)
+		assert.Empty(t, removed)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ArchiveCascades", func(t *testing.T) {
+		status := "archived"
+		mock.ExpectBegin()
+		mock.ExpectQuery(`UPDATE products SET status = \$1 WHERE id = \$2 AND seller_id = \$3 AND deleted_at IS NULL RETURNING`).
+			WithArgs(status, "p1", sellerID).
+			WillReturnRows(sqlmock.NewRows(productCols).AddRow("p1", name, "img", "desc", "c1", "s1", "sub1", status))
+		mock.ExpectExec(`UPDATE variants SET is_active = \$2, updated_at = NOW\(\) WHERE product_id = \$1 AND is_active <> \$2`).
+			WithArgs("p1", false).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectQuery(`DELETE FROM carts c USING variants v`).
+			WithArgs("p1").
+			WillReturnRows(sqlmock.NewRows([]string{"user_id", "variant_id", "name", "name", "quantity"}).
+				AddRow(7, "v1", "Red", "Shirt", 2).
+				AddRow(8, "v2", "Blue", "Shirt", 1))
+		mock.ExpectCommit()
+
+		_, removed, err := repo.Update(ctx, UpdateProductInput{ID: "p1", Status: &status}, sellerID)
+		assert.NoError(t, err)
+		require.Len(t, removed, 2)
+		assert.Equal(t, int32(7), removed[0].UserID)
+		assert.Equal(t, "Red", removed[0].VariantName)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("ReactivateRestoresVariants", func(t *testing.T) {
+		status := "active"
+		mock.ExpectBegin()
+		mock.ExpectQuery(`UPDATE products SET status = \$1`).
+			WillReturnRows(sqlmock.NewRows(productCols).AddRow("p1", name, "img", "desc", "c1", "s1", "sub1", status))
+		mock.ExpectExec(`UPDATE variants SET is_active = \$2`).
+			WithArgs("p1", true).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+
+		_, removed, err := repo.Update(ctx, UpdateProductInput{ID: "p1", Status: &status}, sellerID)
+		assert.NoError(t, err)
+		assert.Empty(t, removed)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("CascadeErrorRollsBack", func(t *testing.T) {
+		status := "disable"
+		mock.ExpectBegin()
+		mock.ExpectQuery(`UPDATE products SET status = \$1`).
+			WillReturnRows(sqlmock.NewRows(productCols).AddRow("p1", name, "img", "desc", "c1", "s1", "sub1", status))
+		mock.ExpectExec(`UPDATE variants SET is_active = \$2`).
+			WillReturnError(errors.New("db error"))
+		mock.ExpectRollback()
+
+		_, _, err := repo.Update(ctx, UpdateProductInput{ID: "p1", Status: &status}, sellerID)
+		assert.Error(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
 	})
 
 	t.Run("NoFields", func(t *testing.T) {
-		_, err := repo.Update(ctx, UpdateProductInput{ID: "p1"}, sellerID)
+		_, _, err := repo.Update(ctx, UpdateProductInput{ID: "p1"}, sellerID)
 		assert.Error(t, err)
 	})
 
 	t.Run("NotFound", func(t *testing.T) {
+		mock.ExpectBegin()
 		mock.ExpectQuery(`UPDATE products`).
 			WillReturnError(sql.ErrNoRows)
+		mock.ExpectRollback()
 
-		_, err := repo.Update(ctx, input, sellerID)
+		_, _, err := repo.Update(ctx, input, sellerID)
 		assert.Error(t, err)
 		assert.Equal(t, "product not found or not owned by seller", err.Error())
 	})
 
 	t.Run("DBError", func(t *testing.T) {
+		mock.ExpectBegin()
 		mock.ExpectQuery(`UPDATE products`).
 			WillReturnError(errors.New("db error"))
+		mock.ExpectRollback()
 
-		_, err := repo.Update(ctx, input, sellerID)
+		_, _, err := repo.Update(ctx, input, sellerID)
 		assert.Error(t, err)
 		assert.NotEqual(t, "product not found or not owned by seller", err.Error())
 	})
 }
 
+func TestRepository_ArchiveCascade(t *testing.T) {
+	ctx := context.Background()
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := NewRepository(db)
+
+	productCols := []string{
+		"id", "name", "imageurl", "description", "category_id", "seller_id", "subcategory_id", "status",
+	}
+
+	t.Run("SoftDeletes", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`UPDATE products SET status = \$3, deleted_at = CASE WHEN \$4 THEN NOW\(\) END WHERE id = \$1 AND seller_id = \$2 AND deleted_at IS NULL`).
+			WithArgs("p1", "s1", "archived", true).
+			WillReturnRows(sqlmock.NewRows(productCols).AddRow("p1", "Shirt", "img", "desc", "c1", "s1", "sub1", "archived"))
+		mock.ExpectExec(`UPDATE variants SET is_active = \$2`).
+			WithArgs("p1", false).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(`DELETE FROM carts c USING variants v`).
+			WithArgs("p1").
+			WillReturnRows(sqlmock.NewRows([]string{"user_id", "variant_id", "name", "name", "quantity"}).
+				AddRow(7, "v1", "Red", "Shirt", 2))
+		mock.ExpectCommit()
+
+		p, removed, err := repo.ArchiveCascade(ctx, "p1", "s1")
+		assert.NoError(t, err)
+		assert.Equal(t, "archived", p.Status)
+		require.Len(t, removed, 1)
+		assert.Equal(t, int32(7), removed[0].UserID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("SetStatusKeepsRow", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`UPDATE products SET status = \$3`).
+			WithArgs("p1", "s1", "archived", false).
+			WillReturnRows(sqlmock.NewRows(productCols).AddRow("p1", "Shirt", "img", "desc", "c1", "s1", "sub1", "archived"))
+		mock.ExpectExec(`UPDATE variants SET is_active = \$2`).
+			WithArgs("p1", false).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(`DELETE FROM carts c USING variants v`).
+			WillReturnRows(sqlmock.NewRows([]string{"user_id", "variant_id", "name", "name", "quantity"}))
+		mock.ExpectCommit()
+
+		_, removed, err := repo.SetStatus(ctx, "p1", "s1", "archived")
+		assert.NoError(t, err)
+		assert.Empty(t, removed)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("AlreadyDeleted", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`UPDATE products`).
+			WillReturnError(sql.ErrNoRows)
+		mock.ExpectRollback()
+
+		_, _, err := repo.ArchiveCascade(ctx, "p1", "s1")
+		assert.ErrorIs(t, err, ErrProductNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
 func TestRepository_BulkCreateVariants(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -285,9 +456,12 @@ func TestRepository_BulkCreateVariants(t *testing.T) {
 
 	t.Run("Success", func(t *testing.T) {
 		mock.ExpectQuery(`INSERT INTO variants`).
-			WithArgs(input[0].ProductID, input[0].Name, input[0].QuantityType, input[0].Price, input[0].Stock, input[0].ImageURL, input[0].Description).
-			WillReturnRows(sqlmock.NewRows([]string{"id", "product_id", "name", "quantity_type", "price", "stock", "imageurl", "created_at"}).
-				AddRow("v1", "p1", "V1", "pcs", 100.0, 10, "img", time.Now()))
+			WithArgs(input[0].ProductID, input[0].Name, input[0].QuantityType, input[0].Price, input[0].CompareAtPrice, input[0].Stock, input[0].ImageURL, input[0].Description,
+				input[0].WeightGrams, input[0].LengthCm, input[0].WidthCm, input[0].HeightCm).
+			WillReturnRows(sqlmock.NewRows([]string{
+				"id", "product_id", "name", "quantity_type", "price", "compare_at_price", "stock", "imageurl", "created_at",
+				"weight_grams", "length_cm", "width_cm", "height_cm",
+			}).AddRow("v1", "p1", "V1", "pcs", 100.0, nil, 10, "img", time.Now(), 500, 10, 10, 10))
 
 		vars, err := repo.BulkCreateVariants(ctx, input, sellerID)
 		assert.NoError(t, err)
@@ -318,8 +492,10 @@ func TestRepository_BulkUpdateVariants(t *testing.T) {
 		mock.ExpectBegin()
 		mock.ExpectQuery(`UPDATE variants SET name = \$1 WHERE id = \$2 AND product_id = \$3 AND product_id IN`).
 			WithArgs(name, input[0].ID, input[0].ProductID, sellerID).
-			WillReturnRows(sqlmock.NewRows([]string{"id", "product_id", "name", "price", "stock", "imageurl", "description"}).
-				AddRow("v1", "p1", name, 100.0, 10, "img", "desc"))
+			WillReturnRows(sqlmock.NewRows([]string{
+				"id", "product_id", "name", "price", "compare_at_price", "stock", "imageurl", "description",
+				"weight_grams", "length_cm", "width_cm", "height_cm",
+			}).AddRow("v1", "p1", name, 100.0, nil, 10, "img", "desc", 500, 10, 10, 10))
 		mock.ExpectCommit()
 
 		vars, err := repo.BulkUpdateVariants(ctx, input, sellerID)
@@ -333,6 +509,19 @@ func TestRepository_BulkUpdateVariants(t *testing.T) {
 		assert.Error(t, err)
 	})
 
+	t.Run("PriceAboveCompareAt", func(t *testing.T) {
+		price := 150.0
+		priceInput := []*UpdateVariantInput{{ID: "v1", ProductID: "p1", Price: &price}}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`UPDATE variants SET price = \$1`).
+			WillReturnError(&pq.Error{Code: "23514", Constraint: "chk_variants_compare_at_price"})
+		mock.ExpectRollback()
+
+		_, err := repo.BulkUpdateVariants(ctx, priceInput, sellerID)
+		assert.ErrorIs(t, err, ErrCompareAtBelowPrice)
+	})
+
 	t.Run("SkipEmptyUpdate", func(t *testing.T) {
 		// Input with no fields to update
 		emptyInput := []*UpdateVariantInput{{ID: "v1", ProductID: "p1"}}
@@ -358,10 +547,10 @@ func TestRepository_GetProductByID(t *testing.T) {
 	t.Run("Success", func(t *testing.T) {
 		rows := sqlmock.NewRows([]string{
 			"id", "name", "seller_id", "category_id", "subcategory_id", "slug", "imageurl", "description", "created_at",
-			"category_name", "subcategory_name", "seller_name", "variants",
+			"category_name", "subcategory_name", "seller_name", "store_slug", "unavailable_until", "origin_id", "variants",
 		}).AddRow(
 			pID, "Prod 1", "s1", "c1", "sub1", "slug", "img", "desc", time.Now(),
-			"Cat 1", "Sub 1", "Seller A", `[]`,
+			"Cat 1", "Sub 1", "Seller A", "s1-seller-a", nil, "o1", `[]`,
 		)
 
 		mock.ExpectQuery(`(?s)SELECT .* FROM products p .* WHERE p.id = \$1`).
@@ -371,6 +560,12 @@ func TestRepository_GetProductByID(t *testing.T) {
 		p, err := repo.GetProductByID(ctx, GetProductOptions{ProductID: pID})
 		assert.NoError(t, err)
 		assert.Equal(t, pID, p.ID)
+		if assert.NotNil(t, p.StoreSlug) {
+			assert.Equal(t, "s1-seller-a", *p.StoreSlug)
+		}
+		if assert.NotNil(t, p.OriginID) {
+			assert.Equal(t, "o1", *p.OriginID)
+		}
 	})
 
 	t.Run("NotFound", func(t *testing.T) {
@@ -384,6 +579,42 @@ func TestRepository_GetProductByID(t *testing.T) {
 	})
 }
 
+func TestRepository_GetProductsByIDs(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+	ids := []string{"p1", "p2"}
+
+	rows := sqlmock.NewRows([]string{
+		"id", "name", "seller_id", "category_id", "subcategory_id", "slug", "imageurl", "description", "status", "created_at",
+		"category_name", "subcategory_name", "seller_name", "store_slug", "unavailable_until", "origin_id", "variants",
+	}).AddRow(
+		"p1", "Prod 1", "s1", "c1", "sub1", "slug", "img", "desc", "active", time.Now(),
+		"Cat 1", "Sub 1", "Seller A", nil, nil, nil,
+		`[{"id":"v1","name":"5kg","price":65000,"stock":3,"shipping":{"weightGrams":5000,"lengthCm":30,"widthCm":20,"heightCm":10}}]`,
+	)
+
+	mock.ExpectQuery(`(?s)SELECT .* FROM products p .* WHERE p.id = ANY\(\$1\) AND \(NOT \$2 OR \(p.status = \$3 AND NOT EXISTS \(.*sv.mode = 'HIDDEN'`).
+		WithArgs(pq.Array(ids), true, "active").
+		WillReturnRows(rows)
+
+	products, err := repo.GetProductsByIDs(ctx, ids, true)
+	assert.NoError(t, err)
+	require.Len(t, products, 1)
+	require.Len(t, products[0].Variants, 1)
+	assert.Equal(t, 65000.0, products[0].Variants[0].Price)
+	assert.Equal(t, int32(5000), products[0].Variants[0].Shipping.WeightGrams)
+
+	mock.ExpectQuery(`FROM products p`).WillReturnError(errors.New("boom"))
+	_, err = repo.GetProductsByIDs(ctx, ids, true)
+	assert.ErrorIs(t, err, ErrRepositoryFailure)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestRepository_GetProductVariantByID(t *testing.T) {
 	db, mock, err := sqlmock.New()
 	require.NoError(t, err)
@@ -395,9 +626,11 @@ func TestRepository_GetProductVariantByID(t *testing.T) {
 
 	t.Run("Success", func(t *testing.T) {
 		rows := sqlmock.NewRows([]string{
-			"id", "name", "product_id", "quantity_type", "price", "stock", "imageurl", "category_id", "seller_id", "created_at", "description",
+			"id", "name", "product_id", "quantity_type", "price", "compare_at_price", "stock", "imageurl", "category_id", "seller_id", "created_at", "description",
+			"weight_grams", "length_cm", "width_cm", "height_cm",
 		}).AddRow(
-			vID, "V1", "p1", "pcs", 100.0, 10, "img", "c1", "s1", time.Now(), "desc",
+			vID, "V1", "p1", "pcs", 100.0, 125.0, 10, "img", "c1", "s1", time.Now(), "desc",
+			1200, 20, 15, 10,
 		)
 
 		mock.ExpectQuery(`(?s)SELECT .* FROM variants v .* WHERE v.id = \$1`).
@@ -407,6 +640,10 @@ func TestRepository_GetProductVariantByID(t *testing.T) {
 		v, err := repo.GetProductVariantByID(ctx, GetVariantOptions{VariantID: vID})
 		assert.NoError(t, err)
 		assert.Equal(t, vID, v.ID)
+		assert.Equal(t, int32(1200), v.Shipping.WeightGrams)
+		require.NotNil(t, v.CompareAtPrice)
+		assert.Equal(t, 125.0, *v.CompareAtPrice)
+		assert.Equal(t, int32(20), *v.DiscountPercent())
 	})
 
 	t.Run("NotFound", func(t *testing.T) {
@@ -428,3 +665,23 @@ func TestRepository_GetProductVariantByID(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestRepository_ModerationVisibility(t *testing.T) {
+	ctx := context.Background()
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := NewRepository(db)
+
+	mock.ExpectExec(`UPDATE products SET moderation_hidden_at = CASE WHEN \$2 THEN COALESCE\(moderation_hidden_at, NOW\(\)\) END WHERE id::text = \$1`).
+		WithArgs("p-1", true).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(`UPDATE products SET moderation_hidden_at`).
+		WithArgs("p-1", false).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.NoError(t, repo.HideModerationContent(ctx, moderation.ContentProduct, "p-1"))
+	assert.NoError(t, repo.UnhideModerationContent(ctx, moderation.ContentProduct, "p-1"))
+	assert.ErrorIs(t, repo.HideModerationContent(ctx, moderation.ContentStoreName, "p-1"), moderation.ErrInvalidContentType)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strings"
 	"time"
+	"warimas-be/internal/apperr"
 	"warimas-be/internal/logger"
 	"warimas-be/internal/user"
 	"warimas-be/internal/utils"
@@ -19,26 +20,39 @@ type Service interface {
 	GetList(ctx context.Context, opts ProductQueryOptions) (*ProductListResult, error)
 	Create(ctx context.Context, input NewProductInput) (Product, error)
 	Update(ctx context.Context, input UpdateProductInput) (Product, error)
+	// Archive takes a product off the catalogue but keeps it for the seller
+	// to restore with Update. Delete soft-deletes it for good. Both take
+	// the product's variants out of every cart.
+	Archive(ctx context.Context, productID string) (Product, error)
+	Delete(ctx context.Context, productID string) error
 	CreateVariants(ctx context.Context, input []*NewVariantInput) ([]*Variant, error)
 	UpdateVariants(ctx context.Context, input []*UpdateVariantInput) ([]*Variant, error)
 	GetProductByID(ctx context.Context, productID string) (*Product, error)
+	// Compare loads up to MaxCompareProducts products in one go and lines
+	// up their attributes. Repeated IDs count once; products that are
+	// missing or hidden from the caller are left out.
+	Compare(ctx context.Context, productIDs []string) (*Comparison, error)
 }
 
 type service struct {
-	repo Repository
+	repo     Repository
+	notifier Notifier
 }
 
 func NewService(repo Repository) Service {
-	return &service{repo: repo}
+	return &service{repo: repo, notifier: LogNotifier{}}
 }
 
-var ErrProductNotFound = errors.New("product not found")
+var ErrProductNotFound = apperr.NotFound("product not found")
 
 func (s *service) GetProductsByGroup(
 	ctx context.Context,
 	opts ProductQueryOptions,
 ) ([]ProductByCategory, error) {
 
+	// Default to active only, unless Admin
+	opts.OnlyActive = utils.GetUserRoleFromContext(ctx) != string(user.RoleAdmin)
+
 	log := logger.FromCtx(ctx)
 	log.Debug("Service: GetProductsByGroup called")
 
@@ -144,9 +158,9 @@ func (s *service) Create(ctx context.Context, input NewProductInput) (Product, e
 		return Product{}, errors.New("name cannot be empty")
 	}
 
-	sellerID, ok := ctx.Value(utils.SellerIDKey).(string)
-	if !ok || sellerID == "" {
-		return Product{}, errors.New("unauthorized: seller ID not found in context")
+	sellerID, ok := utils.GetSellerIDFromContext(ctx)
+	if !ok {
+		return Product{}, ErrNotSeller
 	}
 
 	return s.repo.Create(ctx, input, sellerID)
@@ -166,9 +180,9 @@ func (s *service) Update(
 		return Product{}, errors.New("name cannot be empty")
 	}
 
-	sellerID, ok := ctx.Value(utils.SellerIDKey).(string)
-	if !ok || sellerID == "" {
-		return Product{}, errors.New("unauthorized")
+	sellerID, ok := utils.GetSellerIDFromContext(ctx)
+	if !ok {
+		return Product{}, ErrNotSeller
 	}
 
 	// Ensure at least one field is updated
@@ -176,7 +190,81 @@ func (s *service) Update(
 		return Product{}, errors.New("no fields to update")
 	}
 
-	return s.repo.Update(ctx, input, sellerID)
+	if input.Status != nil {
+		switch *input.Status {
+		case utils.ProductStatusActive, utils.ProductStatusDisable, utils.ProductStatusArchived:
+		default:
+			return Product{}, ErrInvalidProductStatus
+		}
+	}
+
+	p, removed, err := s.repo.Update(ctx, input, sellerID)
+	if err != nil {
+		return Product{}, err
+	}
+
+	s.notifyCartRemovals(ctx, removed)
+	return p, nil
+}
+
+func (s *service) Archive(ctx context.Context, productID string) (Product, error) {
+	if productID == "" {
+		return Product{}, errors.New("product id is required")
+	}
+
+	sellerID, ok := utils.GetSellerIDFromContext(ctx)
+	if !ok {
+		return Product{}, ErrNotSeller
+	}
+
+	p, removed, err := s.repo.SetStatus(ctx, productID, sellerID, utils.ProductStatusArchived)
+	if err != nil {
+		return Product{}, err
+	}
+
+	s.notifyCartRemovals(ctx, removed)
+	return p, nil
+}
+
+func (s *service) Delete(ctx context.Context, productID string) error {
+	if productID == "" {
+		return errors.New("product id is required")
+	}
+
+	sellerID, ok := utils.GetSellerIDFromContext(ctx)
+	if !ok {
+		return ErrNotSeller
+	}
+
+	_, removed, err := s.repo.ArchiveCascade(ctx, productID, sellerID)
+	if err != nil {
+		return err
+	}
+
+	s.notifyCartRemovals(ctx, removed)
+	return nil
+}
+
+// notifyCartRemovals sends each customer one notice for the lines taken
+// out of their cart.
+func (s *service) notifyCartRemovals(ctx context.Context, removed []*CartRemoval) {
+	byUser := map[int32][]*CartRemoval{}
+	var users []int32
+	for _, c := range removed {
+		if _, ok := byUser[c.UserID]; !ok {
+			users = append(users, c.UserID)
+		}
+		byUser[c.UserID] = append(byUser[c.UserID], c)
+	}
+
+	for _, userID := range users {
+		if err := s.notifier.NotifyCartItemsRemoved(ctx, userID, byUser[userID]); err != nil {
+			logger.FromCtx(ctx).Warn("failed to notify removed cart items",
+				zap.Int32("user_id", userID),
+				zap.Error(err),
+			)
+		}
+	}
 }
 
 func (s *service) CreateVariants(
@@ -188,9 +276,25 @@ func (s *service) CreateVariants(
 		return nil, errors.New("variant input cannot be empty")
 	}
 
-	sellerID, ok := ctx.Value(utils.SellerIDKey).(string)
-	if !ok || sellerID == "" {
-		return nil, errors.New("unauthorized: seller ID not found in context")
+	sellerID, ok := utils.GetSellerIDFromContext(ctx)
+	if !ok {
+		return nil, ErrNotSeller
+	}
+
+	for i, v := range input {
+		if v != nil && (v.WeightGrams < 0 || v.LengthCm < 0 || v.WidthCm < 0 || v.HeightCm < 0) {
+			return nil, fmt.Errorf("weight and dimensions cannot be negative at index %d", i)
+		}
+		if v != nil && v.CompareAtPrice != nil && *v.CompareAtPrice < v.Price {
+			return nil, fmt.Errorf("%w at index %d", ErrCompareAtBelowPrice, i)
+		}
+		if v != nil {
+			t, err := ParseQuantityType(v.QuantityType)
+			if err != nil {
+				return nil, fmt.Errorf("%w at index %d", err, i)
+			}
+			v.QuantityType = string(t)
+		}
 	}
 
 	return s.repo.BulkCreateVariants(ctx, input, sellerID)
@@ -205,9 +309,9 @@ func (s *service) UpdateVariants(
 		return nil, errors.New("variant input cannot be empty")
 	}
 
-	sellerID, ok := ctx.Value(utils.SellerIDKey).(string)
-	if !ok || sellerID == "" {
-		return nil, errors.New("unauthorized")
+	sellerID, ok := utils.GetSellerIDFromContext(ctx)
+	if !ok {
+		return nil, ErrNotSeller
 	}
 
 	for i, v := range input {
@@ -236,7 +340,34 @@ func (s *service) UpdateVariants(
 			return nil, fmt.Errorf("stock cannot be negative at index %d", i)
 		}
 
-		if v.Name == nil && v.Price == nil && v.Stock == nil && v.ImageURL == nil && v.Description == nil && v.QuantityType == nil {
+		if v.QuantityType != nil {
+			t, err := ParseQuantityType(*v.QuantityType)
+			if err != nil {
+				return nil, fmt.Errorf("%w at index %d", err, i)
+			}
+			qt := string(t)
+			v.QuantityType = &qt
+		}
+
+		// 0 clears the compare-at price; a partial update is checked
+		// against the stored price by the database.
+		if v.CompareAtPrice != nil && *v.CompareAtPrice != 0 {
+			if *v.CompareAtPrice < 0 {
+				return nil, fmt.Errorf("compare-at price cannot be negative at index %d", i)
+			}
+			if v.Price != nil && *v.CompareAtPrice < *v.Price {
+				return nil, fmt.Errorf("%w at index %d", ErrCompareAtBelowPrice, i)
+			}
+		}
+
+		for _, d := range []*int32{v.WeightGrams, v.LengthCm, v.WidthCm, v.HeightCm} {
+			if d != nil && *d < 0 {
+				return nil, fmt.Errorf("weight and dimensions cannot be negative at index %d", i)
+			}
+		}
+
+		if v.Name == nil && v.Price == nil && v.CompareAtPrice == nil && v.Stock == nil && v.ImageURL == nil && v.Description == nil && v.QuantityType == nil &&
+			v.WeightGrams == nil && v.LengthCm == nil && v.WidthCm == nil && v.HeightCm == nil {
 			return nil, fmt.Errorf("no fields to update at index %d", i)
 		}
 	}
@@ -273,3 +404,148 @@ func (s *service) GetProductByID(ctx context.Context, productID string) (*Produc
 
 	return product, nil
 }
+
+func (s *service) Compare(ctx context.Context, productIDs []string) (*Comparison, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "Compare"),
+	)
+
+	ids := make([]string, 0, len(productIDs))
+	seen := make(map[string]bool, len(productIDs))
+	for _, id := range productIDs {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 || len(ids) > MaxCompareProducts {
+		return nil, ErrInvalidComparison
+	}
+
+	onlyActive := utils.GetUserRoleFromContext(ctx) != string(user.RoleAdmin)
+	found, err := s.repo.GetProductsByIDs(ctx, ids, onlyActive)
+	if err != nil {
+		log.Error("failed to load products", zap.Error(err))
+		return nil, err
+	}
+
+	byID := make(map[string]*Product, len(found))
+	for _, p := range found {
+		byID[p.ID] = p
+	}
+
+	products := make([]*Product, 0, len(ids))
+	for _, id := range ids {
+		if p, ok := byID[id]; ok {
+			products = append(products, p)
+		}
+	}
+
+	cmp := &Comparison{
+		Products:   make([]*ComparedProduct, 0, len(products)),
+		Attributes: compareAttributes(products),
+	}
+	for _, p := range products {
+		cmp.Products = append(cmp.Products, summarise(p))
+	}
+	return cmp, nil
+}
+
+func summarise(p *Product) *ComparedProduct {
+	out := &ComparedProduct{Product: p}
+	for _, v := range p.Variants {
+		if out.MinPrice == nil || v.Price < *out.MinPrice {
+			price := v.Price
+			out.MinPrice = &price
+		}
+		if out.MaxPrice == nil || v.Price > *out.MaxPrice {
+			price := v.Price
+			out.MaxPrice = &price
+		}
+		if v.Stock > 0 {
+			out.InStock = true
+		}
+	}
+	return out
+}
+
+// compareAttributes builds the comparison rows from what every product
+// carries: its catalogue placement, seller, variants and packed weight.
+func compareAttributes(products []*Product) []*ComparisonAttribute {
+	rows := []struct {
+		key, label string
+		value      func(p *Product) *string
+	}{
+		{"category", "Category", func(p *Product) *string { return nonEmpty(p.CategoryName) }},
+		{"subcategory", "Subcategory", func(p *Product) *string { return nonEmpty(p.SubcategoryName) }},
+		{"seller", "Seller", func(p *Product) *string { return nonEmpty(p.SellerName) }},
+		{"variants", "Variants", variantNames},
+		{"weight", "Weight", weightRange},
+	}
+
+	attrs := make([]*ComparisonAttribute, 0, len(rows))
+	for _, row := range rows {
+		attr := &ComparisonAttribute{
+			Key:    row.key,
+			Label:  row.label,
+			Values: make([]*string, 0, len(products)),
+		}
+		for i, p := range products {
+			v := row.value(p)
+			attr.Values = append(attr.Values, v)
+			if i > 0 && !sameValue(v, attr.Values[0]) {
+				attr.Differs = true
+			}
+		}
+		attrs = append(attrs, attr)
+	}
+	return attrs
+}
+
+func variantNames(p *Product) *string {
+	names := make([]string, 0, len(p.Variants))
+	for _, v := range p.Variants {
+		names = append(names, v.Name)
+	}
+	return nonEmpty(strings.Join(names, ", "))
+}
+
+// weightRange is the packed weight of the measured variants, e.g.
+// "1000 g" or "1000-5000 g".
+func weightRange(p *Product) *string {
+	var lo, hi int32
+	for _, v := range p.Variants {
+		if v.Shipping == nil || v.Shipping.WeightGrams <= 0 {
+			continue
+		}
+		w := v.Shipping.WeightGrams
+		if lo == 0 || w < lo {
+			lo = w
+		}
+		if w > hi {
+			hi = w
+		}
+	}
+	if lo == 0 {
+		return nil
+	}
+	if lo == hi {
+		return nonEmpty(fmt.Sprintf("%d g", lo))
+	}
+	return nonEmpty(fmt.Sprintf("%d-%d g", lo, hi))
+}
+
+func nonEmpty(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
+
+func sameValue(a, b *string) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -5,11 +5,13 @@ import (
 	"database/sql"
 	"errors"
 	"testing"
+	"warimas-be/internal/moderation"
 	"warimas-be/internal/user"
 	"warimas-be/internal/utils"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 // --- Mocks ---
@@ -39,9 +41,60 @@ func (m *MockRepository) Create(ctx context.Context, input NewProductInput, sell
 	return args.Get(0).(Product), args.Error(1)
 }
 
-func (m *MockRepository) Update(ctx context.Context, input UpdateProductInput, sellerID string) (Product, error) {
+func (m *MockRepository) Update(ctx context.Context, input UpdateProductInput, sellerID string) (Product, []*CartRemoval, error) {
 	args := m.Called(ctx, input, sellerID)
-	return args.Get(0).(Product), args.Error(1)
+	var removed []*CartRemoval
+	if args.Get(1) != nil {
+		removed = args.Get(1).([]*CartRemoval)
+	}
+	return args.Get(0).(Product), removed, args.Error(2)
+}
+
+func (m *MockRepository) SetStatus(ctx context.Context, productID, sellerID, status string) (Product, []*CartRemoval, error) {
+	args := m.Called(ctx, productID, sellerID, status)
+	var removed []*CartRemoval
+	if args.Get(1) != nil {
+		removed = args.Get(1).([]*CartRemoval)
+	}
+	return args.Get(0).(Product), removed, args.Error(2)
+}
+
+func (m *MockRepository) ArchiveCascade(ctx context.Context, productID, sellerID string) (Product, []*CartRemoval, error) {
+	args := m.Called(ctx, productID, sellerID)
+	var removed []*CartRemoval
+	if args.Get(1) != nil {
+		removed = args.Get(1).([]*CartRemoval)
+	}
+	return args.Get(0).(Product), removed, args.Error(2)
+}
+
+func (m *MockRepository) ModerationContent(ctx context.Context, t moderation.ContentType, id string) (*moderation.Content, error) {
+	args := m.Called(ctx, t, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*moderation.Content), args.Error(1)
+}
+
+func (m *MockRepository) RemoveModerationContent(ctx context.Context, t moderation.ContentType, id string) error {
+	return m.Called(ctx, t, id).Error(0)
+}
+
+func (m *MockRepository) HideModerationContent(ctx context.Context, t moderation.ContentType, id string) error {
+	return m.Called(ctx, t, id).Error(0)
+}
+
+func (m *MockRepository) UnhideModerationContent(ctx context.Context, t moderation.ContentType, id string) error {
+	return m.Called(ctx, t, id).Error(0)
+}
+
+type MockNotifier struct {
+	mock.Mock
+}
+
+func (m *MockNotifier) NotifyCartItemsRemoved(ctx context.Context, userID int32, items []*CartRemoval) error {
+	args := m.Called(ctx, userID, items)
+	return args.Error(0)
 }
 
 func (m *MockRepository) BulkCreateVariants(ctx context.Context, input []*NewVariantInput, sellerID string) ([]*Variant, error) {
@@ -68,6 +121,14 @@ func (m *MockRepository) GetProductByID(ctx context.Context, opts GetProductOpti
 	return args.Get(0).(*Product), args.Error(1)
 }
 
+func (m *MockRepository) GetProductsByIDs(ctx context.Context, ids []string, onlyActive bool) ([]*Product, error) {
+	args := m.Called(ctx, ids, onlyActive)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Product), args.Error(1)
+}
+
 func (m *MockRepository) GetProductVariantByID(ctx context.Context, opts GetVariantOptions) (*Variant, error) {
 	args := m.Called(ctx, opts)
 	if args.Get(0) == nil {
@@ -79,7 +140,7 @@ func (m *MockRepository) GetProductVariantByID(ctx context.Context, opts GetVari
 // --- Helpers ---
 
 func mockContextWithSeller(sellerID string) context.Context {
-	return context.WithValue(context.Background(), utils.SellerIDKey, sellerID)
+	return utils.WithPrincipal(context.Background(), &utils.Principal{SellerID: sellerID})
 }
 
 func mockContextWithRole(role string) context.Context {
@@ -96,17 +157,28 @@ func TestService_GetProductsByGroup(t *testing.T) {
 		mockRepo := new(MockRepository)
 		svc := NewService(mockRepo)
 		expected := []ProductByCategory{{CategoryName: "Cat1"}}
-		mockRepo.On("GetProductsByGroup", ctx, opts).Return(expected, nil)
+		mockRepo.On("GetProductsByGroup", ctx, ProductQueryOptions{OnlyActive: true}).Return(expected, nil)
 
 		res, err := svc.GetProductsByGroup(ctx, opts)
 		assert.NoError(t, err)
 		assert.Equal(t, expected, res)
 	})
 
+	t.Run("Admin_SeesEverything", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+		adminCtx := mockContextWithRole(string(user.RoleAdmin))
+		mockRepo.On("GetProductsByGroup", adminCtx, opts).Return([]ProductByCategory{}, nil)
+
+		_, err := svc.GetProductsByGroup(adminCtx, opts)
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+
 	t.Run("Error", func(t *testing.T) {
 		mockRepo := new(MockRepository)
 		svc := NewService(mockRepo)
-		mockRepo.On("GetProductsByGroup", ctx, opts).Return(nil, errors.New("db error"))
+		mockRepo.On("GetProductsByGroup", ctx, ProductQueryOptions{OnlyActive: true}).Return(nil, errors.New("db error"))
 		_, err := svc.GetProductsByGroup(ctx, opts)
 		assert.Error(t, err)
 	})
@@ -223,7 +295,7 @@ func TestService_Create(t *testing.T) {
 		svc := NewService(mockRepo)
 		_, err := svc.Create(context.Background(), input)
 		assert.Error(t, err)
-		assert.Contains(t, err.Error(), "unauthorized")
+		assert.ErrorIs(t, err, ErrNotSeller)
 	})
 }
 
@@ -238,13 +310,44 @@ func TestService_Update(t *testing.T) {
 		mockRepo := new(MockRepository)
 		svc := NewService(mockRepo)
 		expected := Product{ID: "p1", Name: name}
-		mockRepo.On("Update", ctx, input, sellerID).Return(expected, nil)
+		mockRepo.On("Update", ctx, input, sellerID).Return(expected, nil, nil)
 
 		res, err := svc.Update(ctx, input)
 		assert.NoError(t, err)
 		assert.Equal(t, name, res.Name)
 	})
 
+	t.Run("ArchiveNotifiesCartOwners", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		notifier := new(MockNotifier)
+		svc := &service{repo: mockRepo, notifier: notifier}
+
+		status := utils.ProductStatusArchived
+		in := UpdateProductInput{ID: "p1", Status: &status}
+		removed := []*CartRemoval{
+			{UserID: 7, VariantID: "v1"},
+			{UserID: 8, VariantID: "v1"},
+			{UserID: 7, VariantID: "v2"},
+		}
+		mockRepo.On("Update", ctx, in, sellerID).Return(Product{ID: "p1", Status: status}, removed, nil)
+		notifier.On("NotifyCartItemsRemoved", ctx, int32(7), []*CartRemoval{removed[0], removed[2]}).Return(nil)
+		notifier.On("NotifyCartItemsRemoved", ctx, int32(8), []*CartRemoval{removed[1]}).Return(errors.New("push down"))
+
+		res, err := svc.Update(ctx, in)
+		assert.NoError(t, err)
+		assert.Equal(t, status, res.Status)
+		notifier.AssertExpectations(t)
+	})
+
+	t.Run("InvalidStatus", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+		status := "deleted"
+		_, err := svc.Update(ctx, UpdateProductInput{ID: "p1", Status: &status})
+		assert.ErrorIs(t, err, ErrInvalidProductStatus)
+		mockRepo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+	})
+
 	t.Run("MissingID", func(t *testing.T) {
 		mockRepo := new(MockRepository)
 		svc := NewService(mockRepo)
@@ -275,7 +378,55 @@ func TestService_Update(t *testing.T) {
 		svc := NewService(mockRepo)
 		_, err := svc.Update(context.Background(), input)
 		assert.Error(t, err)
-		assert.Equal(t, "unauthorized", err.Error())
+		assert.ErrorIs(t, err, ErrNotSeller)
+	})
+}
+
+func TestService_ArchiveAndDelete(t *testing.T) {
+	sellerID := "seller-1"
+	ctx := mockContextWithSeller(sellerID)
+
+	t.Run("ArchiveNotifiesCartOwners", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		notifier := new(MockNotifier)
+		svc := &service{repo: mockRepo, notifier: notifier}
+
+		removed := []*CartRemoval{{UserID: 7, VariantID: "v1"}}
+		mockRepo.On("SetStatus", ctx, "p1", sellerID, utils.ProductStatusArchived).
+			Return(Product{ID: "p1", Status: utils.ProductStatusArchived}, removed, nil)
+		notifier.On("NotifyCartItemsRemoved", ctx, int32(7), removed).Return(nil)
+
+		res, err := svc.Archive(ctx, "p1")
+		assert.NoError(t, err)
+		assert.Equal(t, utils.ProductStatusArchived, res.Status)
+		notifier.AssertExpectations(t)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+		mockRepo.On("ArchiveCascade", ctx, "p1", sellerID).Return(Product{ID: "p1"}, nil, nil)
+
+		assert.NoError(t, svc.Delete(ctx, "p1"))
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("DeleteNotFound", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+		mockRepo.On("ArchiveCascade", ctx, "p1", sellerID).Return(Product{}, nil, ErrProductNotFound)
+
+		assert.ErrorIs(t, svc.Delete(ctx, "p1"), ErrProductNotFound)
+	})
+
+	t.Run("Unauthorized", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+
+		_, err := svc.Archive(context.Background(), "p1")
+		assert.ErrorIs(t, err, ErrNotSeller)
+		assert.ErrorIs(t, svc.Delete(context.Background(), "p1"), ErrNotSeller)
+		mockRepo.AssertNotCalled(t, "ArchiveCascade", mock.Anything, mock.Anything, mock.Anything)
 	})
 }
 
@@ -309,6 +460,27 @@ func TestService_CreateVariants(t *testing.T) {
 		_, err := svc.CreateVariants(context.Background(), input)
 		assert.Error(t, err)
 	})
+
+	t.Run("InvalidQuantityType", func(t *testing.T) {
+		svc := NewService(new(MockRepository))
+		_, err := svc.CreateVariants(ctx, []*NewVariantInput{{Name: "V1", Price: 100, QuantityType: "dozen"}})
+		assert.ErrorIs(t, err, ErrInvalidQuantityType)
+	})
+
+	t.Run("CompareAtBelowPrice", func(t *testing.T) {
+		svc := NewService(new(MockRepository))
+		compareAt := 90.0
+		_, err := svc.CreateVariants(ctx, []*NewVariantInput{{Name: "V1", Price: 100, CompareAtPrice: &compareAt}})
+		assert.ErrorIs(t, err, ErrCompareAtBelowPrice)
+	})
+}
+
+func TestVariant_DiscountPercent(t *testing.T) {
+	price := func(f float64) *float64 { return &f }
+
+	assert.Nil(t, (&Variant{Price: 100}).DiscountPercent())
+	assert.Nil(t, (&Variant{Price: 100, CompareAtPrice: price(100)}).DiscountPercent())
+	assert.Equal(t, int32(33), *(&Variant{Price: 20000, CompareAtPrice: price(29900)}).DiscountPercent())
 }
 
 func TestService_UpdateVariants(t *testing.T) {
@@ -359,6 +531,11 @@ func TestService_UpdateVariants(t *testing.T) {
 		_, err = svc.UpdateVariants(ctx, []*UpdateVariantInput{{ID: "v1", ProductID: "p1", Stock: &negStock}})
 		assert.Error(t, err)
 
+		// Compare-at below the new price
+		price, compareAt := 100.0, 90.0
+		_, err = svc.UpdateVariants(ctx, []*UpdateVariantInput{{ID: "v1", ProductID: "p1", Price: &price, CompareAtPrice: &compareAt}})
+		assert.ErrorIs(t, err, ErrCompareAtBelowPrice)
+
 		// No fields
 		_, err = svc.UpdateVariants(ctx, []*UpdateVariantInput{{ID: "v1", ProductID: "p1"}})
 		assert.Error(t, err)
@@ -409,3 +586,77 @@ func TestService_GetProductByID(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestService_Compare(t *testing.T) {
+	ctx := mockContextWithRole("USER")
+
+	rice := &Product{
+		ID: "p1", CategoryName: "Sembako", SubcategoryName: "Beras", SellerName: "Toko A",
+		Variants: []*Variant{
+			{Name: "1kg", Price: 15000, Stock: 0, Shipping: &ShippingSpec{WeightGrams: 1000}},
+			{Name: "5kg", Price: 65000, Stock: 3, Shipping: &ShippingSpec{WeightGrams: 5000}},
+		},
+	}
+	oil := &Product{
+		ID: "p2", CategoryName: "Sembako", SubcategoryName: "Minyak", SellerName: "Toko A",
+		Variants: []*Variant{{Name: "2L", Price: 38000, Stock: 0}},
+	}
+
+	t.Run("AlignsInRequestedOrder", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+
+		// p3 is missing or inactive and the repeated p2 counts once.
+		mockRepo.On("GetProductsByIDs", ctx, []string{"p2", "p1", "p3"}, true).
+			Return([]*Product{rice, oil}, nil)
+
+		cmp, err := svc.Compare(ctx, []string{"p2", "p1", "p2", "p3"})
+		assert.NoError(t, err)
+		require.Len(t, cmp.Products, 2)
+
+		assert.Equal(t, "p2", cmp.Products[0].Product.ID)
+		assert.Equal(t, 38000.0, *cmp.Products[0].MinPrice)
+		assert.False(t, cmp.Products[0].InStock)
+
+		assert.Equal(t, "p1", cmp.Products[1].Product.ID)
+		assert.Equal(t, 15000.0, *cmp.Products[1].MinPrice)
+		assert.Equal(t, 65000.0, *cmp.Products[1].MaxPrice)
+		assert.True(t, cmp.Products[1].InStock)
+
+		attrs := map[string]*ComparisonAttribute{}
+		for _, a := range cmp.Attributes {
+			attrs[a.Key] = a
+		}
+		assert.False(t, attrs["category"].Differs)
+		assert.True(t, attrs["subcategory"].Differs)
+		assert.Equal(t, "1kg, 5kg", *attrs["variants"].Values[1])
+		assert.Nil(t, attrs["weight"].Values[0], "oil has no measured weight")
+		assert.Equal(t, "1000-5000 g", *attrs["weight"].Values[1])
+		assert.True(t, attrs["weight"].Differs)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("AdminSeesInactive", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+		adminCtx := mockContextWithRole("ADMIN")
+
+		mockRepo.On("GetProductsByIDs", adminCtx, []string{"p1"}, false).Return([]*Product{rice}, nil)
+
+		cmp, err := svc.Compare(adminCtx, []string{"p1"})
+		assert.NoError(t, err)
+		assert.Len(t, cmp.Products, 1)
+	})
+
+	t.Run("TooManyOrNone", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+
+		_, err := svc.Compare(ctx, nil)
+		assert.ErrorIs(t, err, ErrInvalidComparison)
+
+		_, err = svc.Compare(ctx, []string{"a", "b", "c", "d", "e"})
+		assert.ErrorIs(t, err, ErrInvalidComparison)
+		mockRepo.AssertNotCalled(t, "GetProductsByIDs", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,99 @@
+package product
+
+import "strings"
+
+// QuantityType is the unit a variant is sold in. The values are the ones
+// variants_quantity_type_check allows.
+type QuantityType string
+
+const (
+	QuantityTypeUnit  QuantityType = "unit"
+	QuantityTypeKg    QuantityType = "kg"
+	QuantityTypeLiter QuantityType = "liter"
+	QuantityTypeSack  QuantityType = "sack"
+)
+
+// QuantityTypeInfo describes a quantity type and how it converts to the
+// base unit its unit price is quoted in.
+type QuantityTypeInfo struct {
+	Type  QuantityType
+	Label string
+	// BaseUnit is "g" or "ml", empty for types without a fixed measure.
+	BaseUnit string
+	// BasePerUnit is how many BaseUnit one quantity holds, 0 when BaseUnit
+	// is empty.
+	BasePerUnit float64
+	// UnitPricePer is the amount of BaseUnit unit prices are quoted for,
+	// e.g. 100 for Rp/100g.
+	UnitPricePer int32
+}
+
+// QuantityTypes lists the quantity types in display order.
+var QuantityTypes = []QuantityTypeInfo{
+	{Type: QuantityTypeUnit, Label: "Unit"},
+	{Type: QuantityTypeKg, Label: "Kilogram", BaseUnit: "g", BasePerUnit: 1000, UnitPricePer: 100},
+	{Type: QuantityTypeLiter, Label: "Liter", BaseUnit: "ml", BasePerUnit: 1000, UnitPricePer: 100},
+	{Type: QuantityTypeSack, Label: "Sack"},
+}
+
+// quantityTypeAliases maps spellings sellers used before the type was
+// controlled to the type they meant.
+var quantityTypeAliases = map[string]QuantityType{
+	"pcs":   QuantityTypeUnit,
+	"pc":    QuantityTypeUnit,
+	"kilo":  QuantityTypeKg,
+	"l":     QuantityTypeLiter,
+	"litre": QuantityTypeLiter,
+}
+
+// ParseQuantityType normalizes s to a QuantityType, accepting any case and
+// the legacy aliases. An empty s is a unit, the column default.
+func ParseQuantityType(s string) (QuantityType, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" {
+		return QuantityTypeUnit, nil
+	}
+	if t, ok := quantityTypeAliases[s]; ok {
+		return t, nil
+	}
+	if _, ok := QuantityTypeInfoOf(QuantityType(s)); ok {
+		return QuantityType(s), nil
+	}
+	return "", ErrInvalidQuantityType
+}
+
+// QuantityTypeInfoOf returns the metadata of t.
+func QuantityTypeInfoOf(t QuantityType) (QuantityTypeInfo, bool) {
+	for _, info := range QuantityTypes {
+		if info.Type == t {
+			return info, true
+		}
+	}
+	return QuantityTypeInfo{}, false
+}
+
+// UnitPrice is a variant's price per fixed amount of its base unit, such as
+// Rp 2,500 per 100 g, for comparing pack sizes.
+type UnitPrice struct {
+	Amount float64
+	Per    int32
+	Unit   string
+}
+
+// UnitPrice returns the variant's price per UnitPricePer of its base unit,
+// or nil when its quantity type has no fixed measure.
+func (v *Variant) UnitPrice() *UnitPrice {
+	t, err := ParseQuantityType(v.QuantityType)
+	if err != nil {
+		return nil
+	}
+	info, _ := QuantityTypeInfoOf(t)
+	if info.BasePerUnit == 0 {
+		return nil
+	}
+	return &UnitPrice{
+		Amount: v.Price / info.BasePerUnit * float64(info.UnitPricePer),
+		Per:    info.UnitPricePer,
+		Unit:   info.BaseUnit,
+	}
+}
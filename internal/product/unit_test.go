@@ -0,0 +1,49 @@
+package product
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseQuantityType(t *testing.T) {
+	for in, want := range map[string]QuantityType{
+		"kg":     QuantityTypeKg,
+		" Liter": QuantityTypeLiter,
+		"PCS":    QuantityTypeUnit,
+		"":       QuantityTypeUnit,
+		"sack":   QuantityTypeSack,
+	} {
+		got, err := ParseQuantityType(in)
+		require.NoError(t, err, in)
+		assert.Equal(t, want, got, in)
+	}
+
+	_, err := ParseQuantityType("dozen")
+	assert.ErrorIs(t, err, ErrInvalidQuantityType)
+}
+
+func TestVariant_UnitPrice(t *testing.T) {
+	t.Run("Per100g", func(t *testing.T) {
+		p := (&Variant{QuantityType: "kg", Price: 25000}).UnitPrice()
+
+		require.NotNil(t, p)
+		assert.Equal(t, 2500.0, p.Amount)
+		assert.Equal(t, int32(100), p.Per)
+		assert.Equal(t, "g", p.Unit)
+	})
+
+	t.Run("Per100ml", func(t *testing.T) {
+		p := (&Variant{QuantityType: "liter", Price: 18000}).UnitPrice()
+
+		require.NotNil(t, p)
+		assert.Equal(t, 1800.0, p.Amount)
+		assert.Equal(t, "ml", p.Unit)
+	})
+
+	t.Run("NoMeasure", func(t *testing.T) {
+		assert.Nil(t, (&Variant{QuantityType: "unit", Price: 5000}).UnitPrice())
+		assert.Nil(t, (&Variant{QuantityType: "sack", Price: 5000}).UnitPrice())
+	})
+}
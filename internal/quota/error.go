@@ -0,0 +1,13 @@
+package quota
+
+import (
+	"errors"
+	"warimas-be/internal/apperr"
+)
+
+var (
+	ErrUnauthenticated = apperr.Unauthenticated("unauthenticated")
+	ErrForbidden       = apperr.Forbidden("forbidden")
+	ErrDB              = errors.New("database error")
+	ErrQuotaExceeded   = errors.New("daily quota exceeded")
+)
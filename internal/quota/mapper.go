@@ -0,0 +1,19 @@
+package quota
+
+import (
+	"strconv"
+	"warimas-be/internal/graph/model"
+)
+
+func MapFlagToGraphQL(f *Flag) *model.UsageFlag {
+	return &model.UsageFlag{
+		ID:        strconv.FormatInt(f.ID, 10),
+		UserID:    strconv.FormatUint(uint64(f.UserID), 10),
+		Day:       f.Day,
+		Operation: f.Operation,
+		Reason:    model.UsageFlagReason(f.Reason),
+		Count:     int32(f.Count),
+		Baseline:  f.Baseline,
+		CreatedAt: f.CreatedAt,
+	}
+}
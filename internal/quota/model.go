@@ -0,0 +1,62 @@
+package quota
+
+import "time"
+
+const (
+	// FlushInterval is how often the counts of fields without a quota are
+	// written to the database.
+	FlushInterval = time.Minute
+	// CheckInterval is how often today's counts are scanned for anomalies.
+	CheckInterval = 15 * time.Minute
+	// UsageRetention is how long daily counts are kept; anomaly detection
+	// only looks back a week.
+	UsageRetention = 30 * 24 * time.Hour
+
+	defaultLimit = 50
+	maxLimit     = 500
+)
+
+type FlagReason string
+
+const (
+	// FlagDailyVolume: a single field was called more than
+	// Thresholds.DailyVolume times today.
+	FlagDailyVolume FlagReason = "DAILY_VOLUME"
+	// FlagSpike: today's count is far above the user's own weekly average.
+	FlagSpike FlagReason = "SPIKE"
+	// FlagQuotaExceeded: the user kept calling a field past its quota.
+	FlagQuotaExceeded FlagReason = "QUOTA_EXCEEDED"
+)
+
+// Thresholds decide when usage is flagged. A zero field disables its rule.
+type Thresholds struct {
+	DailyVolume int
+	// SpikeFactor flags a count above SpikeFactor times the weekly average,
+	// once it reaches SpikeMinimum so light users are not flagged for
+	// going from 2 calls to 30.
+	SpikeFactor  int
+	SpikeMinimum int
+}
+
+func DefaultThresholds(dailyVolume, spikeFactor int) Thresholds {
+	return Thresholds{DailyVolume: dailyVolume, SpikeFactor: spikeFactor, SpikeMinimum: 500}
+}
+
+// usageKey identifies one counter in operation_usage.
+type usageKey struct {
+	UserID    uint
+	Day       time.Time
+	Operation string
+}
+
+// Flag is usage that looked abusive.
+type Flag struct {
+	ID        int64
+	UserID    uint
+	Day       time.Time
+	Operation string
+	Reason    FlagReason
+	Count     int
+	Baseline  float64
+	CreatedAt time.Time
+}
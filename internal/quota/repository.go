@@ -0,0 +1,218 @@
+package quota
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"warimas-be/internal/logger"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+type Repository interface {
+	// Consume counts one call of operation and reports whether it was
+	// within limit. Calls past the limit are not counted.
+	Consume(ctx context.Context, userID uint, day time.Time, operation string, limit int) (bool, error)
+	AddUsage(ctx context.Context, counts map[usageKey]int) error
+	// Flag records f unless the user is already flagged for the operation
+	// that day, and reports whether it was new.
+	Flag(ctx context.Context, f *Flag) (bool, error)
+	// FlagAnomalies flags usage on day that crosses th and returns the new
+	// flags.
+	FlagAnomalies(ctx context.Context, day time.Time, th Thresholds) ([]*Flag, error)
+	ListFlags(ctx context.Context, since *time.Time, limit int32) ([]*Flag, error)
+	PruneUsage(ctx context.Context, before time.Time) (int64, error)
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) Consume(ctx context.Context, userID uint, day time.Time, operation string, limit int) (bool, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "Consume"),
+		zap.Uint("user_id", userID),
+		zap.String("operation", operation),
+	)
+
+	// The conditional update makes the check and the increment one step,
+	// so concurrent calls on several instances cannot overshoot the limit.
+	// Days are sent as dates throughout so the session time zone cannot
+	// shift them.
+	var count int
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO operation_usage (user_id, day, operation, count)
+		VALUES ($1, $2, $3, 1)
+		ON CONFLICT (user_id, day, operation) DO UPDATE
+			SET count = operation_usage.count + 1
+			WHERE operation_usage.count < $4
+		RETURNING count
+	`, userID, day.Format(time.DateOnly), operation, limit).Scan(&count)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		log.Error("failed to consume quota", zap.Error(err))
+		return false, ErrDB
+	}
+	return true, nil
+}
+
+func (r *repository) AddUsage(ctx context.Context, counts map[usageKey]int) error {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "AddUsage"),
+	)
+
+	if len(counts) == 0 {
+		return nil
+	}
+
+	users := make([]int64, 0, len(counts))
+	days := make([]string, 0, len(counts))
+	ops := make([]string, 0, len(counts))
+	ns := make([]int64, 0, len(counts))
+	for k, n := range counts {
+		users = append(users, int64(k.UserID))
+		days = append(days, k.Day.Format(time.DateOnly))
+		ops = append(ops, k.Operation)
+		ns = append(ns, int64(n))
+	}
+
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO operation_usage (user_id, day, operation, count)
+		SELECT u.user_id, u.day, u.operation, u.count
+		FROM unnest($1::int[], $2::date[], $3::text[], $4::int[])
+			AS u(user_id, day, operation, count)
+		-- Skip users deleted since the calls were counted.
+		JOIN users ON users.id = u.user_id
+		ON CONFLICT (user_id, day, operation) DO UPDATE
+			SET count = operation_usage.count + EXCLUDED.count
+	`, pq.Array(users), pq.Array(days), pq.Array(ops), pq.Array(ns))
+	if err != nil {
+		log.Error("failed to add usage", zap.Int("counters", len(counts)), zap.Error(err))
+		return ErrDB
+	}
+	return nil
+}
+
+func (r *repository) Flag(ctx context.Context, f *Flag) (bool, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "Flag"),
+		zap.Uint("user_id", f.UserID),
+		zap.String("operation", f.Operation),
+	)
+
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO usage_flags (user_id, day, operation, reason, count, baseline)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (user_id, day, operation) DO NOTHING
+		RETURNING id, created_at
+	`, f.UserID, f.Day.Format(time.DateOnly), f.Operation, f.Reason, f.Count, f.Baseline).Scan(&f.ID, &f.CreatedAt)
+	if err == sql.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		log.Error("failed to flag usage", zap.Error(err))
+		return false, ErrDB
+	}
+	return true, nil
+}
+
+func (r *repository) FlagAnomalies(ctx context.Context, day time.Time, th Thresholds) ([]*Flag, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "FlagAnomalies"),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		WITH baseline AS (
+			SELECT user_id, operation, SUM(count)::numeric / 7 AS avg
+			FROM operation_usage
+			WHERE day >= $1::date - 7 AND day < $1::date
+			GROUP BY user_id, operation
+		)
+		INSERT INTO usage_flags (user_id, day, operation, reason, count, baseline)
+		SELECT t.user_id, t.day, t.operation,
+			CASE WHEN $2::int > 0 AND t.count >= $2 THEN 'DAILY_VOLUME' ELSE 'SPIKE' END,
+			t.count, COALESCE(b.avg, 0)
+		FROM operation_usage t
+		LEFT JOIN baseline b USING (user_id, operation)
+		WHERE t.day = $1::date
+			AND (
+				($2 > 0 AND t.count >= $2)
+				OR ($3::int > 0 AND t.count >= $4 AND t.count > $3 * COALESCE(b.avg, 0))
+			)
+		ON CONFLICT (user_id, day, operation) DO NOTHING
+		RETURNING id, user_id, day, operation, reason, count, baseline, created_at
+	`, day.Format(time.DateOnly), th.DailyVolume, th.SpikeFactor, th.SpikeMinimum)
+	if err != nil {
+		log.Error("failed to flag anomalies", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	return scanFlags(rows, log)
+}
+
+func (r *repository) ListFlags(ctx context.Context, since *time.Time, limit int32) ([]*Flag, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ListFlags"),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, user_id, day, operation, reason, count, baseline, created_at
+		FROM usage_flags
+		WHERE ($1::timestamptz IS NULL OR created_at >= $1)
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2
+	`, since, limit)
+	if err != nil {
+		log.Error("failed to query usage flags", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	return scanFlags(rows, log)
+}
+
+func (r *repository) PruneUsage(ctx context.Context, before time.Time) (int64, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "PruneUsage"),
+	)
+
+	res, err := r.db.ExecContext(ctx, `DELETE FROM operation_usage WHERE day < $1`, before.Format(time.DateOnly))
+	if err != nil {
+		log.Error("failed to prune usage", zap.Error(err))
+		return 0, ErrDB
+	}
+	n, _ := res.RowsAffected()
+	return n, nil
+}
+
+func scanFlags(rows *sql.Rows, log *zap.Logger) ([]*Flag, error) {
+	list := []*Flag{}
+	for rows.Next() {
+		var f Flag
+		if err := rows.Scan(&f.ID, &f.UserID, &f.Day, &f.Operation, &f.Reason, &f.Count, &f.Baseline, &f.CreatedAt); err != nil {
+			log.Error("failed to scan usage flag", zap.Error(err))
+			return nil, ErrDB
+		}
+		list = append(list, &f)
+	}
+	if err := rows.Err(); err != nil {
+		log.Error("usage flag iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+	return list, nil
+}
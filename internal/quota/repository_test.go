@@ -0,0 +1,155 @@
+package quota
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var flagCols = []string{"id", "user_id", "day", "operation", "reason", "count", "baseline", "created_at"}
+
+func TestRepository_Consume(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+
+	mock.ExpectQuery(`INSERT INTO operation_usage .* WHERE operation_usage.count < \$4 RETURNING count`).
+		WithArgs(uint(7), "2026-01-02", "Query.stockOversell", 10).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+	ok, err := repo.Consume(ctx, 7, testToday, "Query.stockOversell", 10)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	// No row comes back once the limit is reached.
+	mock.ExpectQuery(`INSERT INTO operation_usage`).WillReturnError(sql.ErrNoRows)
+	ok, err = repo.Consume(ctx, 7, testToday, "Query.stockOversell", 10)
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	mock.ExpectQuery(`INSERT INTO operation_usage`).WillReturnError(errors.New("boom"))
+	_, err = repo.Consume(ctx, 7, testToday, "Query.stockOversell", 10)
+	assert.ErrorIs(t, err, ErrDB)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_AddUsage(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	// Nothing to write.
+	require.NoError(t, repo.AddUsage(context.Background(), nil))
+
+	mock.ExpectExec(`INSERT INTO operation_usage .* FROM unnest\(.*\) .* SET count = operation_usage.count \+ EXCLUDED.count`).
+		WithArgs("{7}", "{\"2026-01-02\"}", "{\"Query.orderList\"}", "{4}").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = repo.AddUsage(context.Background(), map[usageKey]int{
+		{UserID: 7, Day: testToday, Operation: "Query.orderList"}: 4,
+	})
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_Flag(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	f := &Flag{UserID: 7, Day: testToday, Operation: "Query.stockOversell", Reason: FlagQuotaExceeded, Count: 10}
+
+	mock.ExpectQuery(`INSERT INTO usage_flags .* ON CONFLICT \(user_id, day, operation\) DO NOTHING`).
+		WithArgs(uint(7), "2026-01-02", "Query.stockOversell", FlagQuotaExceeded, 10, float64(0)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "created_at"}).AddRow(5, time.Now()))
+
+	created, err := repo.Flag(context.Background(), f)
+	require.NoError(t, err)
+	assert.True(t, created)
+	assert.Equal(t, int64(5), f.ID)
+
+	mock.ExpectQuery(`INSERT INTO usage_flags`).WillReturnError(sql.ErrNoRows)
+	created, err = repo.Flag(context.Background(), f)
+	require.NoError(t, err)
+	assert.False(t, created)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_FlagAnomalies(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	th := DefaultThresholds(1000, 10)
+
+	mock.ExpectQuery(`WITH baseline AS .* INSERT INTO usage_flags .* RETURNING`).
+		WithArgs("2026-01-02", 1000, 10, 500).
+		WillReturnRows(sqlmock.NewRows(flagCols).
+			AddRow(1, 7, testToday, "Query.orderList", "SPIKE", 900, 40.5, time.Now()))
+
+	flags, err := repo.FlagAnomalies(context.Background(), testToday, th)
+	require.NoError(t, err)
+	require.Len(t, flags, 1)
+	assert.Equal(t, FlagSpike, flags[0].Reason)
+	assert.Equal(t, 40.5, flags[0].Baseline)
+
+	mock.ExpectQuery(`WITH baseline AS`).WillReturnError(errors.New("boom"))
+	_, err = repo.FlagAnomalies(context.Background(), testToday, th)
+	assert.ErrorIs(t, err, ErrDB)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ListFlags(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	since := time.Now().Add(-24 * time.Hour)
+
+	mock.ExpectQuery(`SELECT .* FROM usage_flags WHERE .* ORDER BY created_at DESC, id DESC LIMIT \$2`).
+		WithArgs(&since, int32(50)).
+		WillReturnRows(sqlmock.NewRows(flagCols).
+			AddRow(2, 7, testToday, "Query.stockOversell", "QUOTA_EXCEEDED", 200, 0, time.Now()))
+
+	flags, err := repo.ListFlags(context.Background(), &since, 50)
+	require.NoError(t, err)
+	require.Len(t, flags, 1)
+	assert.Equal(t, uint(7), flags[0].UserID)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_PruneUsage(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	mock.ExpectExec(`DELETE FROM operation_usage WHERE day < \$1`).
+		WithArgs("2025-12-03").
+		WillReturnResult(sqlmock.NewResult(0, 12))
+
+	n, err := repo.PruneUsage(context.Background(), testToday.Add(-UsageRetention))
+	require.NoError(t, err)
+	assert.Equal(t, int64(12), n)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,187 @@
+package quota
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"warimas-be/internal/logger"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+// Service counts GraphQL root fields per user, caps the expensive ones per
+// day and flags unusual usage for admins. Anonymous calls are left to the
+// rate limiter.
+type Service interface {
+	// Consume counts a call of a field with a daily quota and returns
+	// ErrQuotaExceeded once the user has used it up.
+	Consume(ctx context.Context, operation string, daily int) error
+	// Record counts a call of a field without a quota; counts are kept in
+	// memory until the next Flush.
+	Record(ctx context.Context, operation string)
+	Flush(ctx context.Context) (int, error)
+	// DetectAnomalies flags today's unusual usage and prunes old counts.
+	DetectAnomalies(ctx context.Context) (int, error)
+	ListFlags(ctx context.Context, since *time.Time, limit int32) ([]*Flag, error)
+}
+
+type service struct {
+	repo Repository
+	th   Thresholds
+	now  func() time.Time
+	loc  *time.Location
+
+	mu      sync.Mutex
+	pending map[usageKey]int
+}
+
+func NewService(repo Repository, th Thresholds) Service {
+	// Quotas reset at local midnight.
+	loc, err := time.LoadLocation("Asia/Jakarta")
+	if err != nil {
+		logger.L().Error("failed to load Jakarta location, defaulting to UTC", zap.Error(err))
+		loc = time.UTC
+	}
+	return &service{
+		repo:    repo,
+		th:      th,
+		now:     time.Now,
+		loc:     loc,
+		pending: map[usageKey]int{},
+	}
+}
+
+func (s *service) Consume(ctx context.Context, operation string, daily int) error {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "Consume"),
+		zap.String("operation", operation),
+	)
+
+	userID, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	if daily <= 0 {
+		return ErrQuotaExceeded
+	}
+
+	day := s.today()
+	allowed, err := s.repo.Consume(ctx, userID, day, operation, daily)
+	if err != nil {
+		// Fail open: the quota guards against abuse, it must not take the
+		// field down with the database hiccup.
+		log.Warn("quota check failed", zap.Error(err))
+		return nil
+	}
+	if allowed {
+		return nil
+	}
+
+	flagged, err := s.repo.Flag(ctx, &Flag{
+		UserID:    userID,
+		Day:       day,
+		Operation: operation,
+		Reason:    FlagQuotaExceeded,
+		Count:     daily,
+	})
+	if err != nil {
+		log.Warn("failed to flag quota overrun", zap.Error(err))
+	}
+	if flagged {
+		log.Warn("user exceeded daily quota", zap.Uint("user_id", userID), zap.Int("daily", daily))
+	}
+	return ErrQuotaExceeded
+}
+
+func (s *service) Record(ctx context.Context, operation string) {
+	userID, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		return
+	}
+
+	k := usageKey{UserID: userID, Day: s.today(), Operation: operation}
+	s.mu.Lock()
+	s.pending[k]++
+	s.mu.Unlock()
+}
+
+func (s *service) Flush(ctx context.Context) (int, error) {
+	s.mu.Lock()
+	counts := s.pending
+	s.pending = map[usageKey]int{}
+	s.mu.Unlock()
+
+	if err := s.repo.AddUsage(ctx, counts); err != nil {
+		// Put the counts back for the next flush.
+		s.mu.Lock()
+		for k, n := range counts {
+			s.pending[k] += n
+		}
+		s.mu.Unlock()
+		return 0, err
+	}
+	return len(counts), nil
+}
+
+func (s *service) DetectAnomalies(ctx context.Context) (int, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "DetectAnomalies"),
+	)
+
+	flags, err := s.repo.FlagAnomalies(ctx, s.today(), s.th)
+	if err != nil {
+		return 0, err
+	}
+	for _, f := range flags {
+		log.Warn("unusual usage flagged",
+			zap.Int64("flag_id", f.ID),
+			zap.Uint("user_id", f.UserID),
+			zap.String("operation", f.Operation),
+			zap.String("reason", string(f.Reason)),
+			zap.Int("count", f.Count),
+			zap.Float64("baseline", f.Baseline),
+		)
+	}
+
+	if _, err := s.repo.PruneUsage(ctx, s.today().Add(-UsageRetention)); err != nil {
+		return len(flags), err
+	}
+	return len(flags), nil
+}
+
+func (s *service) ListFlags(ctx context.Context, since *time.Time, limit int32) ([]*Flag, error) {
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+
+	return s.repo.ListFlags(ctx, since, limit)
+}
+
+// today returns the current local date as midnight UTC, the form DATE
+// columns scan into.
+func (s *service) today() time.Time {
+	y, m, d := s.now().In(s.loc).Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func requireAdmin(ctx context.Context) (uint, error) {
+	userID, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		return 0, ErrUnauthenticated
+	}
+	if !utils.IsAdmin(ctx) {
+		return 0, ErrForbidden
+	}
+	return userID, nil
+}
@@ -0,0 +1,190 @@
+package quota
+
+import (
+	"context"
+	"testing"
+	"time"
+	"warimas-be/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Mocks ---
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) Consume(ctx context.Context, userID uint, day time.Time, operation string, limit int) (bool, error) {
+	args := m.Called(ctx, userID, day, operation, limit)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRepository) AddUsage(ctx context.Context, counts map[usageKey]int) error {
+	args := m.Called(ctx, counts)
+	return args.Error(0)
+}
+
+func (m *MockRepository) Flag(ctx context.Context, f *Flag) (bool, error) {
+	args := m.Called(ctx, f)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRepository) FlagAnomalies(ctx context.Context, day time.Time, th Thresholds) ([]*Flag, error) {
+	args := m.Called(ctx, day, th)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Flag), args.Error(1)
+}
+
+func (m *MockRepository) ListFlags(ctx context.Context, since *time.Time, limit int32) ([]*Flag, error) {
+	args := m.Called(ctx, since, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Flag), args.Error(1)
+}
+
+func (m *MockRepository) PruneUsage(ctx context.Context, before time.Time) (int64, error) {
+	args := m.Called(ctx, before)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// --- Tests ---
+
+var testThresholds = DefaultThresholds(1000, 10)
+
+// 2026-01-01 20:00 UTC is already 2 January in Jakarta.
+var (
+	testNow   = time.Date(2026, 1, 1, 20, 0, 0, 0, time.UTC)
+	testToday = time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)
+)
+
+func userCtx() context.Context {
+	return utils.SetUserContext(context.Background(), 7, "user@example.com", "USER")
+}
+
+func adminCtx() context.Context {
+	return utils.SetUserContext(context.Background(), 1, "admin@example.com", "ADMIN")
+}
+
+func newTestService(repo Repository) *service {
+	s := NewService(repo, testThresholds).(*service)
+	s.now = func() time.Time { return testNow }
+	return s
+}
+
+func TestService_Consume(t *testing.T) {
+	t.Run("Anonymous calls are not counted", func(t *testing.T) {
+		repo := new(MockRepository)
+		s := newTestService(repo)
+
+		assert.NoError(t, s.Consume(context.Background(), "Query.stockOversell", 10))
+		repo.AssertNotCalled(t, "Consume", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Within quota", func(t *testing.T) {
+		repo := new(MockRepository)
+		s := newTestService(repo)
+		repo.On("Consume", mock.Anything, uint(7), testToday, "Query.stockOversell", 10).Return(true, nil)
+
+		assert.NoError(t, s.Consume(userCtx(), "Query.stockOversell", 10))
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Exceeded quota is flagged", func(t *testing.T) {
+		repo := new(MockRepository)
+		s := newTestService(repo)
+		repo.On("Consume", mock.Anything, uint(7), testToday, "Query.stockOversell", 10).Return(false, nil)
+		repo.On("Flag", mock.Anything, mock.MatchedBy(func(f *Flag) bool {
+			return f.UserID == 7 && f.Reason == FlagQuotaExceeded && f.Day.Equal(testToday)
+		})).Return(true, nil)
+
+		err := s.Consume(userCtx(), "Query.stockOversell", 10)
+
+		assert.ErrorIs(t, err, ErrQuotaExceeded)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("Fails open on database error", func(t *testing.T) {
+		repo := new(MockRepository)
+		s := newTestService(repo)
+		repo.On("Consume", mock.Anything, uint(7), testToday, "Query.stockOversell", 10).Return(false, ErrDB)
+
+		assert.NoError(t, s.Consume(userCtx(), "Query.stockOversell", 10))
+	})
+}
+
+func TestService_RecordAndFlush(t *testing.T) {
+	repo := new(MockRepository)
+	s := newTestService(repo)
+
+	s.Record(userCtx(), "Query.orderList")
+	s.Record(userCtx(), "Query.orderList")
+	s.Record(context.Background(), "Query.orderList")
+	s.Record(adminCtx(), "Mutation.updateOrderStatus")
+
+	want := map[usageKey]int{
+		{UserID: 7, Day: testToday, Operation: "Query.orderList"}:            2,
+		{UserID: 1, Day: testToday, Operation: "Mutation.updateOrderStatus"}: 1,
+	}
+
+	// A failed flush keeps the counts for the next one.
+	repo.On("AddUsage", mock.Anything, want).Return(ErrDB).Once()
+	_, err := s.Flush(context.Background())
+	assert.ErrorIs(t, err, ErrDB)
+
+	repo.On("AddUsage", mock.Anything, want).Return(nil).Once()
+	n, err := s.Flush(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	repo.On("AddUsage", mock.Anything, map[usageKey]int{}).Return(nil).Once()
+	n, err = s.Flush(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, n)
+
+	repo.AssertExpectations(t)
+}
+
+func TestService_DetectAnomalies(t *testing.T) {
+	repo := new(MockRepository)
+	s := newTestService(repo)
+	repo.On("FlagAnomalies", mock.Anything, testToday, testThresholds).
+		Return([]*Flag{{ID: 1, UserID: 7, Operation: "Query.orderList", Reason: FlagSpike, Count: 900, Baseline: 40}}, nil)
+	repo.On("PruneUsage", mock.Anything, testToday.Add(-UsageRetention)).Return(int64(12), nil)
+
+	n, err := s.DetectAnomalies(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, n)
+	repo.AssertExpectations(t)
+}
+
+func TestService_ListFlags(t *testing.T) {
+	t.Run("Forbidden for non-admin", func(t *testing.T) {
+		s := newTestService(new(MockRepository))
+		_, err := s.ListFlags(userCtx(), nil, 0)
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		s := newTestService(new(MockRepository))
+		_, err := s.ListFlags(context.Background(), nil, 0)
+		assert.ErrorIs(t, err, ErrUnauthenticated)
+	})
+
+	t.Run("Clamps the limit", func(t *testing.T) {
+		repo := new(MockRepository)
+		s := newTestService(repo)
+		repo.On("ListFlags", mock.Anything, (*time.Time)(nil), int32(maxLimit)).Return([]*Flag{}, nil)
+
+		_, err := s.ListFlags(adminCtx(), nil, 10000)
+
+		require.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+}
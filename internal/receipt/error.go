@@ -0,0 +1,14 @@
+package receipt
+
+import (
+	"errors"
+	"warimas-be/internal/apperr"
+)
+
+var (
+	ErrUnauthenticated = apperr.Unauthenticated("unauthenticated")
+	ErrForbidden       = apperr.Forbidden("admin access required")
+	ErrInvalidOrderID  = apperr.Invalid("invalid order id")
+	ErrNotPaid         = apperr.Invalid("only paid orders of registered customers have a receipt")
+	ErrDB              = errors.New("database error")
+)
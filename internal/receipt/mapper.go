@@ -0,0 +1,30 @@
+package receipt
+
+import (
+	"strconv"
+	"warimas-be/internal/graph/model"
+)
+
+func MapStatusToGraphQL(s *Status) *model.OrderReceipt {
+	if s == nil {
+		return nil
+	}
+	return &model.OrderReceipt{
+		OrderID:   strconv.Itoa(int(s.OrderID)),
+		QueuedAt:  s.QueuedAt,
+		SentAt:    s.SentAt,
+		SentTo:    s.SentTo,
+		Attempts:  int32(s.Attempts),
+		LastError: s.LastError,
+		ResentAt:  s.ResentAt,
+	}
+}
+
+// ParseOrderID reads an order id from the API.
+func ParseOrderID(id string) (int32, error) {
+	n, err := strconv.ParseInt(id, 10, 32)
+	if err != nil || n <= 0 {
+		return 0, ErrInvalidOrderID
+	}
+	return int32(n), nil
+}
@@ -0,0 +1,97 @@
+package receipt
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SendInterval is how often the job sends queued receipts.
+const SendInterval = time.Minute
+
+const (
+	// maxSendPerRun caps the receipts one job run sends; the rest go out
+	// on the next run.
+	maxSendPerRun = 500
+	sendBatchSize = int32(50)
+)
+
+// Status is where an order's receipt email stands.
+type Status struct {
+	OrderID  int32
+	QueuedAt time.Time
+	// SentAt is nil while the receipt waits to be sent, including after
+	// a resend was requested.
+	SentAt    *time.Time
+	SentTo    *string
+	Attempts  int
+	LastError *string
+	ResentBy  *int32
+	ResentAt  *time.Time
+}
+
+// Receipt is what the receipt email shows for a paid order.
+type Receipt struct {
+	OrderID       int32
+	ExternalID    string
+	InvoiceNumber *string
+	Email         string
+	Currency      string
+
+	Items []Item
+
+	Subtotal     int64
+	Discount     int64
+	Tax          int64
+	ShippingFee  int64
+	InsuranceFee int64
+	Total        int64
+	// WalletAmount is the part of the total paid from the wallet.
+	WalletAmount int64
+
+	ShippingMethod string
+	AddressID      uuid.UUID
+	// Address is filled in by the service; it is stored encrypted.
+	Address *Address
+
+	// Payment is the gateway payment that settled the order, nil when
+	// the wallet covered all of it.
+	Payment *Payment
+	PaidAt  *time.Time
+}
+
+// Item is an order line on the receipt.
+type Item struct {
+	ProductName string
+	VariantName string
+	Quantity    int
+	UnitPrice   float64
+	Subtotal    float64
+}
+
+// Payment is how the order was paid, e.g. channel BCA with the virtual
+// account number as Code.
+type Payment struct {
+	Provider string
+	Channel  string
+	Code     string
+}
+
+// Address is the shipping address printed on the receipt.
+type Address struct {
+	ReceiverName string
+	Phone        string
+	Line1        string
+	Line2        *string
+	City         string
+	Province     string
+	Postal       string
+}
+
+// Email is a rendered receipt ready for delivery.
+type Email struct {
+	OrderID int32
+	To      string
+	Subject string
+	HTML    string
+}
@@ -0,0 +1,28 @@
+package receipt
+
+import (
+	"context"
+	"warimas-be/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// Notifier delivers receipt emails. The job marks a receipt sent only
+// after SendReceipt succeeds, so a failed delivery is retried on the next
+// run.
+type Notifier interface {
+	SendReceipt(ctx context.Context, email *Email) error
+}
+
+// LogNotifier writes each receipt at info level until an email provider
+// is wired in.
+type LogNotifier struct{}
+
+func (LogNotifier) SendReceipt(ctx context.Context, email *Email) error {
+	logger.FromCtx(ctx).Info("receipt email",
+		zap.Int32("order_id", email.OrderID),
+		zap.String("subject", email.Subject),
+		zap.Int("html_bytes", len(email.HTML)),
+	)
+	return nil
+}
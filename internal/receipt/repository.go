@@ -0,0 +1,221 @@
+package receipt
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+	"warimas-be/internal/logger"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+type Repository interface {
+	// ListPending returns receipts waiting to be sent, with their items,
+	// oldest first and those that already failed last. Address is left
+	// for the caller to load.
+	ListPending(ctx context.Context, limit int32) ([]*Receipt, error)
+	MarkSent(ctx context.Context, orderID int32, to string, now time.Time) error
+	// MarkFailed records a failed attempt; the receipt stays pending.
+	MarkFailed(ctx context.Context, orderID int32, reason string) error
+
+	// GetStatus returns nil when the order has no receipt.
+	GetStatus(ctx context.Context, orderID int32) (*Status, error)
+	// Requeue queues the receipt of a paid order to be sent again. It
+	// fails with ErrNotPaid when the order never had one to send.
+	Requeue(ctx context.Context, orderID int32, adminID int32) (*Status, error)
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) ListPending(ctx context.Context, limit int32) ([]*Receipt, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ListPending"),
+	)
+
+	// The latest gateway payment is the one that completed the order; a
+	// wallet share shows as WalletAmount instead.
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT o.id, o.external_id, o.invoice_number, u.email, o.currency,
+		       o.subtotal, o.discount, o.tax, o.shipping_fee, o.insurance_fee,
+		       o.total_amount, o.wallet_amount, o.shipping_method, o.address_id,
+		       p.provider, p.channel_code, p.payment_code, p.paid_at
+		FROM order_receipts rc
+		JOIN orders o ON o.id = rc.order_id
+		JOIN users u ON u.id = o.user_id
+		LEFT JOIN LATERAL (
+			SELECT provider, channel_code, payment_code, paid_at
+			FROM payments
+			WHERE order_id = o.id
+			  AND status = 'PAID'
+			  AND provider <> 'WALLET'
+			ORDER BY paid_at DESC NULLS LAST, id DESC
+			LIMIT 1
+		) p ON TRUE
+		WHERE rc.sent_at IS NULL
+		ORDER BY rc.attempts, rc.queued_at, rc.order_id
+		LIMIT $1
+	`, limit)
+	if err != nil {
+		log.Error("failed to query pending receipts", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	receipts := []*Receipt{}
+	byOrder := map[int32]*Receipt{}
+	for rows.Next() {
+		var rc Receipt
+		var provider, channel, code sql.NullString
+		if err := rows.Scan(
+			&rc.OrderID, &rc.ExternalID, &rc.InvoiceNumber, &rc.Email, &rc.Currency,
+			&rc.Subtotal, &rc.Discount, &rc.Tax, &rc.ShippingFee, &rc.InsuranceFee,
+			&rc.Total, &rc.WalletAmount, &rc.ShippingMethod, &rc.AddressID,
+			&provider, &channel, &code, &rc.PaidAt,
+		); err != nil {
+			log.Error("failed to scan receipt", zap.Error(err))
+			return nil, ErrDB
+		}
+		if provider.Valid {
+			rc.Payment = &Payment{Provider: provider.String, Channel: channel.String, Code: code.String}
+		}
+		receipts = append(receipts, &rc)
+		byOrder[rc.OrderID] = &rc
+	}
+	if err := rows.Err(); err != nil {
+		log.Error("receipt iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+	if len(receipts) == 0 {
+		return receipts, nil
+	}
+
+	ids := make([]int32, 0, len(receipts))
+	for _, rc := range receipts {
+		ids = append(ids, rc.OrderID)
+	}
+
+	itemRows, err := r.db.QueryContext(ctx, `
+		SELECT order_id, product_name, variant_name, quantity, unit_price, subtotal
+		FROM order_items
+		WHERE order_id = ANY($1)
+		ORDER BY order_id, id
+	`, pq.Array(ids))
+	if err != nil {
+		log.Error("failed to query receipt items", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer itemRows.Close()
+
+	for itemRows.Next() {
+		var orderID int32
+		var it Item
+		if err := itemRows.Scan(&orderID, &it.ProductName, &it.VariantName, &it.Quantity, &it.UnitPrice, &it.Subtotal); err != nil {
+			log.Error("failed to scan receipt item", zap.Error(err))
+			return nil, ErrDB
+		}
+		if rc := byOrder[orderID]; rc != nil {
+			rc.Items = append(rc.Items, it)
+		}
+	}
+	if err := itemRows.Err(); err != nil {
+		log.Error("receipt item iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return receipts, nil
+}
+
+func (r *repository) MarkSent(ctx context.Context, orderID int32, to string, now time.Time) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE order_receipts
+		SET sent_at = $2, sent_to = $3, attempts = attempts + 1, last_error = NULL
+		WHERE order_id = $1
+	`, orderID, now, to)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to mark receipt sent", zap.Error(err))
+		return ErrDB
+	}
+	return nil
+}
+
+func (r *repository) MarkFailed(ctx context.Context, orderID int32, reason string) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE order_receipts
+		SET attempts = attempts + 1, last_error = $2
+		WHERE order_id = $1
+	`, orderID, reason)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to mark receipt failed", zap.Error(err))
+		return ErrDB
+	}
+	return nil
+}
+
+const statusColumns = `
+	order_id, queued_at, sent_at, sent_to, attempts, last_error, resent_by, resent_at
+`
+
+func scanStatus(row *sql.Row) (*Status, error) {
+	var s Status
+	err := row.Scan(
+		&s.OrderID, &s.QueuedAt, &s.SentAt, &s.SentTo,
+		&s.Attempts, &s.LastError, &s.ResentBy, &s.ResentAt,
+	)
+	return &s, err
+}
+
+func (r *repository) GetStatus(ctx context.Context, orderID int32) (*Status, error) {
+	s, err := scanStatus(r.db.QueryRowContext(ctx, `
+		SELECT `+statusColumns+`
+		FROM order_receipts
+		WHERE order_id = $1
+	`, orderID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to get receipt status", zap.Error(err))
+		return nil, ErrDB
+	}
+	return s, nil
+}
+
+func (r *repository) Requeue(ctx context.Context, orderID int32, adminID int32) (*Status, error) {
+	// Orders paid before receipts existed have no row yet; any order
+	// that got past payment can have one.
+	s, err := scanStatus(r.db.QueryRowContext(ctx, `
+		INSERT INTO order_receipts (order_id, resent_by, resent_at)
+		SELECT o.id, $2, NOW()
+		FROM orders o
+		WHERE o.id = $1
+		  AND o.user_id IS NOT NULL
+		  AND (
+		      o.status IN ('PAID', 'ACCEPTED', 'SHIPPED', 'READY_FOR_PICKUP', 'COMPLETED')
+		      OR EXISTS (SELECT 1 FROM order_receipts WHERE order_id = o.id)
+		  )
+		ON CONFLICT (order_id) DO UPDATE
+		SET sent_at = NULL,
+		    last_error = NULL,
+		    resent_by = EXCLUDED.resent_by,
+		    resent_at = EXCLUDED.resent_at
+		RETURNING `+statusColumns,
+		orderID, adminID,
+	))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotPaid
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to requeue receipt", zap.Error(err))
+		return nil, ErrDB
+	}
+	return s, nil
+}
@@ -0,0 +1,64 @@
+package receipt
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_ListPending(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := NewRepository(db)
+
+	mock.ExpectQuery(`FROM order_receipts rc .* WHERE rc.sent_at IS NULL`).
+		WithArgs(int32(10)).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "external_id", "invoice_number", "email", "currency",
+			"subtotal", "discount", "tax", "shipping_fee", "insurance_fee",
+			"total_amount", "wallet_amount", "shipping_method", "address_id",
+			"provider", "channel_code", "payment_code", "paid_at",
+		}).
+			AddRow(1, "pay-a", nil, "a@example.com", "IDR", 100000, 0, 0, 10000, 0, 110000, 0, "STANDARD", addrID, "XENDIT", "BCA", "8808", time.Now()).
+			AddRow(2, "pay-b", nil, "b@example.com", "IDR", 50000, 0, 0, 0, 0, 50000, 50000, "SELF_PICKUP", addrID, nil, nil, nil, nil))
+	mock.ExpectQuery(`FROM order_items`).
+		WithArgs(pq.Array([]int32{1, 2})).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"order_id", "product_name", "variant_name", "quantity", "unit_price", "subtotal",
+		}).
+			AddRow(1, "Beras", "5kg", 1, 100000.0, 100000.0).
+			AddRow(2, "Gula", "1kg", 2, 25000.0, 50000.0))
+
+	receipts, err := repo.ListPending(context.Background(), 10)
+
+	require.NoError(t, err)
+	require.Len(t, receipts, 2)
+	assert.Equal(t, "BCA", receipts[0].Payment.Channel)
+	assert.Nil(t, receipts[1].Payment, "wallet-only order has no gateway payment")
+	assert.Len(t, receipts[1].Items, 1)
+	assert.Equal(t, "Gula", receipts[1].Items[0].ProductName)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_Requeue_NotPaid(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := NewRepository(db)
+
+	mock.ExpectQuery(`INSERT INTO order_receipts`).
+		WithArgs(int32(5), int32(9)).
+		WillReturnError(sql.ErrNoRows)
+
+	_, err = repo.Requeue(context.Background(), 5, 9)
+
+	assert.ErrorIs(t, err, ErrNotPaid)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,150 @@
+// Package receipt emails customers an itemized receipt once their order
+// is paid.
+package receipt
+
+import (
+	"context"
+	"time"
+	"warimas-be/internal/address"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// AddressReader loads the shipping address of an order, decrypted.
+type AddressReader interface {
+	GetByID(ctx context.Context, id uuid.UUID) (*address.Address, error)
+}
+
+type Service interface {
+	// SendPending sends the queued receipts and returns how many were
+	// sent. A receipt that fails stays queued for the next run.
+	SendPending(ctx context.Context) (int64, error)
+
+	// Status returns where the order's receipt stands, nil when it has
+	// none. Admin only.
+	Status(ctx context.Context, orderID int32) (*Status, error)
+	// Resend queues the order's receipt to be sent again. Admin only.
+	Resend(ctx context.Context, orderID int32) (*Status, error)
+}
+
+type service struct {
+	repo      Repository
+	addresses AddressReader
+	notifier  Notifier
+	now       func() time.Time
+}
+
+func NewService(repo Repository, addresses AddressReader, notifier Notifier) Service {
+	return &service{
+		repo:      repo,
+		addresses: addresses,
+		notifier:  notifier,
+		now:       time.Now,
+	}
+}
+
+func (s *service) SendPending(ctx context.Context) (int64, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "SendPending"),
+	)
+
+	var sent int64
+	for sent < maxSendPerRun {
+		receipts, err := s.repo.ListPending(ctx, sendBatchSize)
+		if err != nil {
+			return sent, err
+		}
+
+		var batchSent int64
+		for _, rc := range receipts {
+			if err := s.send(ctx, rc); err != nil {
+				log.Warn("failed to send receipt",
+					zap.Int32("order_id", rc.OrderID),
+					zap.Error(err),
+				)
+				if err := s.repo.MarkFailed(ctx, rc.OrderID, err.Error()); err != nil {
+					return sent, err
+				}
+				continue
+			}
+			batchSent++
+		}
+		sent += batchSent
+
+		// Failed receipts sort last, so a batch with nothing sent holds
+		// only receipts that already failed; leave them for the next run.
+		if len(receipts) < int(sendBatchSize) || batchSent == 0 {
+			break
+		}
+	}
+
+	if sent > 0 {
+		log.Info("receipts sent", zap.Int64("count", sent))
+	}
+	return sent, nil
+}
+
+func (s *service) send(ctx context.Context, rc *Receipt) error {
+	addr, err := s.addresses.GetByID(ctx, rc.AddressID)
+	if err != nil {
+		return err
+	}
+	rc.Address = &Address{
+		ReceiverName: addr.ReceiverName,
+		Phone:        addr.Phone,
+		Line1:        addr.Address1,
+		Line2:        addr.Address2,
+		City:         addr.City,
+		Province:     addr.Province,
+		Postal:       addr.Postal,
+	}
+
+	email, err := Render(rc)
+	if err != nil {
+		return err
+	}
+	if err := s.notifier.SendReceipt(ctx, email); err != nil {
+		return err
+	}
+	return s.repo.MarkSent(ctx, rc.OrderID, email.To, s.now())
+}
+
+func (s *service) Status(ctx context.Context, orderID int32) (*Status, error) {
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return s.repo.GetStatus(ctx, orderID)
+}
+
+func (s *service) Resend(ctx context.Context, orderID int32) (*Status, error) {
+	adminID, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := s.repo.Requeue(ctx, orderID, adminID)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.FromCtx(ctx).Info("receipt queued for resend",
+		zap.Int32("order_id", orderID),
+		zap.Int32("admin_id", adminID),
+	)
+	return status, nil
+}
+
+func requireAdmin(ctx context.Context) (int32, error) {
+	userID, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		return 0, ErrUnauthenticated
+	}
+	if !utils.IsAdmin(ctx) {
+		return 0, ErrForbidden
+	}
+	return int32(userID), nil
+}
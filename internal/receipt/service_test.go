@@ -0,0 +1,191 @@
+package receipt
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"warimas-be/internal/address"
+	"warimas-be/internal/utils"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Mocks ---
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) ListPending(ctx context.Context, limit int32) ([]*Receipt, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Receipt), args.Error(1)
+}
+
+func (m *MockRepository) MarkSent(ctx context.Context, orderID int32, to string, now time.Time) error {
+	args := m.Called(ctx, orderID, to, now)
+	return args.Error(0)
+}
+
+func (m *MockRepository) MarkFailed(ctx context.Context, orderID int32, reason string) error {
+	args := m.Called(ctx, orderID, reason)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetStatus(ctx context.Context, orderID int32) (*Status, error) {
+	args := m.Called(ctx, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Status), args.Error(1)
+}
+
+func (m *MockRepository) Requeue(ctx context.Context, orderID int32, adminID int32) (*Status, error) {
+	args := m.Called(ctx, orderID, adminID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Status), args.Error(1)
+}
+
+type MockAddressReader struct {
+	mock.Mock
+}
+
+func (m *MockAddressReader) GetByID(ctx context.Context, id uuid.UUID) (*address.Address, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*address.Address), args.Error(1)
+}
+
+type MockNotifier struct {
+	mock.Mock
+}
+
+func (m *MockNotifier) SendReceipt(ctx context.Context, email *Email) error {
+	args := m.Called(ctx, email)
+	return args.Error(0)
+}
+
+// --- Tests ---
+
+var addrID = uuid.MustParse("3d0f6a2b-9c4e-4a8f-8d2b-5e7c1a9f0b32")
+
+func paidReceipt(orderID int32) *Receipt {
+	invoice := "INV-20260301-0001"
+	paidAt := time.Date(2026, 3, 1, 3, 0, 0, 0, time.UTC)
+	return &Receipt{
+		OrderID:       orderID,
+		ExternalID:    "pay-abc",
+		InvoiceNumber: &invoice,
+		Email:         "buyer@example.com",
+		Currency:      "IDR",
+		Items: []Item{
+			{ProductName: "Beras Pandan Wangi", VariantName: "5kg", Quantity: 2, UnitPrice: 75000, Subtotal: 150000},
+		},
+		Subtotal:       150000,
+		ShippingFee:    12000,
+		InsuranceFee:   300,
+		Total:          162300,
+		ShippingMethod: "STANDARD",
+		AddressID:      addrID,
+		Payment:        &Payment{Provider: "XENDIT", Channel: "BCA", Code: "8808123456789"},
+		PaidAt:         &paidAt,
+	}
+}
+
+func TestRender(t *testing.T) {
+	rc := paidReceipt(1)
+	rc.Address = &Address{ReceiverName: "Sari", Phone: "0812", Line1: "Jl. Melati 3", City: "Bandung", Province: "Jawa Barat", Postal: "40111"}
+
+	email, err := Render(rc)
+
+	require.NoError(t, err)
+	assert.Equal(t, "buyer@example.com", email.To)
+	assert.Contains(t, email.Subject, "INV-20260301-0001")
+	for _, want := range []string{
+		"Beras Pandan Wangi", "Rp 75.000", "Rp 150.000", "BCA 8808123456789",
+		"Shipping insurance", "Rp 162.300", "Jl. Melati 3", "INV-20260301-0001",
+	} {
+		assert.Contains(t, email.HTML, want)
+	}
+	assert.NotContains(t, email.HTML, "Paid from wallet")
+}
+
+func TestService_SendPending(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 4, 0, 0, 0, time.UTC)
+	addr := &address.Address{ID: addrID, ReceiverName: "Sari", Address1: "Jl. Melati 3", City: "Bandung"}
+
+	t.Run("SendsAndMarks", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockAddr := new(MockAddressReader)
+		mockNotifier := new(MockNotifier)
+		svc := NewService(mockRepo, mockAddr, mockNotifier).(*service)
+		svc.now = func() time.Time { return now }
+
+		mockRepo.On("ListPending", ctx, sendBatchSize).Return([]*Receipt{paidReceipt(1)}, nil)
+		mockAddr.On("GetByID", ctx, addrID).Return(addr, nil)
+		mockNotifier.On("SendReceipt", ctx, mock.MatchedBy(func(e *Email) bool {
+			return e.OrderID == 1 && e.To == "buyer@example.com"
+		})).Return(nil)
+		mockRepo.On("MarkSent", ctx, int32(1), "buyer@example.com", now).Return(nil)
+
+		sent, err := svc.SendPending(ctx)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), sent)
+		mockRepo.AssertExpectations(t)
+		mockNotifier.AssertExpectations(t)
+	})
+
+	t.Run("FailureStaysQueued", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockAddr := new(MockAddressReader)
+		mockNotifier := new(MockNotifier)
+		svc := NewService(mockRepo, mockAddr, mockNotifier)
+
+		mockRepo.On("ListPending", ctx, sendBatchSize).Return([]*Receipt{paidReceipt(1)}, nil)
+		mockAddr.On("GetByID", ctx, addrID).Return(addr, nil)
+		mockNotifier.On("SendReceipt", ctx, mock.Anything).Return(errors.New("smtp down"))
+		mockRepo.On("MarkFailed", ctx, int32(1), "smtp down").Return(nil)
+
+		sent, err := svc.SendPending(ctx)
+
+		assert.NoError(t, err)
+		assert.Zero(t, sent)
+		mockRepo.AssertNotCalled(t, "MarkSent", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestService_Resend(t *testing.T) {
+	t.Run("QueuesAgain", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil)
+		ctx := utils.SetUserContext(context.Background(), 9, "admin@example.com", "ADMIN")
+
+		mockRepo.On("Requeue", ctx, int32(5), int32(9)).Return(&Status{OrderID: 5, Attempts: 1}, nil)
+
+		status, err := svc.Resend(ctx, 5)
+
+		assert.NoError(t, err)
+		assert.Nil(t, status.SentAt)
+	})
+
+	t.Run("NotAdmin", func(t *testing.T) {
+		svc := NewService(new(MockRepository), nil, nil)
+		ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "USER")
+
+		_, err := svc.Resend(ctx, 5)
+
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+}
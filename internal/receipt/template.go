@@ -0,0 +1,102 @@
+package receipt
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"math"
+	"warimas-be/internal/utils"
+)
+
+var receiptTemplate = template.Must(template.New("receipt").Funcs(template.FuncMap{
+	"idr": idr,
+}).Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Receipt {{.ExternalID}}</title>
+</head>
+<body style="margin:0;background:#f4f4f4;font-family:Helvetica,Arial,sans-serif;color:#222;">
+<table width="100%" cellpadding="0" cellspacing="0" style="background:#f4f4f4;padding:24px 0;">
+<tr><td align="center">
+<table width="600" cellpadding="0" cellspacing="0" style="background:#fff;border-radius:8px;overflow:hidden;">
+<tr><td style="background:#0b7a3e;color:#fff;padding:20px 24px;font-size:22px;font-weight:bold;">Warimas</td></tr>
+<tr><td style="padding:24px;">
+<h1 style="font-size:18px;margin:0 0 8px;">Thank you, your payment is received</h1>
+<p style="margin:0 0 16px;font-size:14px;">
+Order: {{.ExternalID}}<br>
+{{with .InvoiceNumber}}Invoice: {{.}}<br>{{end}}
+{{with .PaidAt}}Paid: {{.Format "02 Jan 2006 15:04 MST"}}<br>{{end}}
+Payment: {{with .Payment}}{{.Channel}}{{with .Code}} {{.}}{{end}}{{else}}Wallet{{end}}
+</p>
+<table width="100%" cellpadding="6" cellspacing="0" style="border-collapse:collapse;font-size:14px;">
+<tr style="border-bottom:1px solid #ddd;"><th align="left">Item</th><th align="right">Qty</th><th align="right">Price</th><th align="right">Subtotal</th></tr>
+{{range .Items}}
+<tr style="border-bottom:1px solid #eee;">
+<td>{{.ProductName}}{{with .VariantName}}<br><span style="color:#777;">{{.}}</span>{{end}}</td>
+<td align="right">{{.Quantity}}</td>
+<td align="right">{{idr .UnitPrice}}</td>
+<td align="right">{{idr .Subtotal}}</td>
+</tr>
+{{end}}
+</table>
+<table width="100%" cellpadding="4" cellspacing="0" style="font-size:14px;margin-top:12px;">
+<tr><td>Subtotal</td><td align="right">{{idr .Subtotal}}</td></tr>
+{{if .Discount}}<tr><td>Discount</td><td align="right">-{{idr .Discount}}</td></tr>{{end}}
+{{if .Tax}}<tr><td>Tax</td><td align="right">{{idr .Tax}}</td></tr>{{end}}
+<tr><td>Shipping ({{.ShippingMethod}})</td><td align="right">{{idr .ShippingFee}}</td></tr>
+{{if .InsuranceFee}}<tr><td>Shipping insurance</td><td align="right">{{idr .InsuranceFee}}</td></tr>{{end}}
+<tr style="font-weight:bold;"><td>Total</td><td align="right">{{idr .Total}}</td></tr>
+{{if .WalletAmount}}<tr><td>Paid from wallet</td><td align="right">{{idr .WalletAmount}}</td></tr>{{end}}
+</table>
+{{with .Address}}
+<h2 style="font-size:15px;margin:24px 0 4px;">Shipping address</h2>
+<p style="margin:0;font-size:14px;">
+{{.ReceiverName}} ({{.Phone}})<br>
+{{.Line1}}<br>
+{{with .Line2}}{{.}}<br>{{end}}
+{{.City}}, {{.Province}} {{.Postal}}
+</p>
+{{end}}
+</td></tr>
+<tr><td style="background:#fafafa;color:#777;font-size:12px;padding:16px 24px;">
+This receipt was sent to {{.Email}} for order {{.ExternalID}}. Keep it for returns and warranty claims.
+</td></tr>
+</table>
+</td></tr>
+</table>
+</body>
+</html>
+`))
+
+// idr formats whole rupiah amounts; item prices are stored as numeric
+// and come in as float64.
+func idr(v any) string {
+	switch n := v.(type) {
+	case int64:
+		return utils.FormatIDR(n)
+	case float64:
+		return utils.FormatIDR(int64(math.Round(n)))
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// Render builds the receipt email for rc.
+func Render(rc *Receipt) (*Email, error) {
+	var buf bytes.Buffer
+	if err := receiptTemplate.Execute(&buf, rc); err != nil {
+		return nil, err
+	}
+
+	subject := "Your Warimas receipt for order " + rc.ExternalID
+	if rc.InvoiceNumber != nil {
+		subject += " (" + *rc.InvoiceNumber + ")"
+	}
+	return &Email{
+		OrderID: rc.OrderID,
+		To:      rc.Email,
+		Subject: subject,
+		HTML:    buf.String(),
+	}, nil
+}
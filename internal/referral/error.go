@@ -0,0 +1,16 @@
+package referral
+
+import (
+	"errors"
+	"warimas-be/internal/apperr"
+)
+
+var (
+	ErrUnauthenticated = apperr.Unauthenticated("unauthenticated")
+	ErrCodeNotFound    = apperr.NotFound("referral code not found")
+	ErrCodeCollision   = errors.New("referral code collision")
+	ErrAlreadyReferred = errors.New("user already referred")
+	ErrDB              = errors.New("database error")
+
+	PgUniqueViolation = "23505"
+)
@@ -0,0 +1,14 @@
+package referral
+
+import "warimas-be/internal/graph/model"
+
+func MapStatsToGraphQL(s *Stats) *model.ReferralStats {
+	return &model.ReferralStats{
+		Code:        s.Code,
+		Signups:     int32(s.Signups),
+		Pending:     int32(s.Pending),
+		Rewarded:    int32(s.Rewarded),
+		Rejected:    int32(s.Rejected),
+		TotalEarned: int32(s.TotalEarned),
+	}
+}
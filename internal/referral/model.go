@@ -0,0 +1,67 @@
+package referral
+
+import "time"
+
+type Status string
+
+const (
+	StatusPending  Status = "PENDING"
+	StatusRewarded Status = "REWARDED"
+	StatusRejected Status = "REJECTED"
+)
+
+// Reasons recorded on rejected referrals.
+const (
+	RejectSelfReferral = "SELF_REFERRAL"
+	RejectSameDevice   = "SAME_DEVICE"
+)
+
+// Wallet credit granted to each side once the referee completes their
+// first order.
+const (
+	referrerReward int64 = 25000
+	refereeReward  int64 = 25000
+	rewardCurrency       = "IDR"
+)
+
+// RewardInterval is how often qualified referrals are rewarded.
+const RewardInterval = 15 * time.Minute
+
+const rewardBatchSize = 100
+
+type Code struct {
+	UserID    int32
+	Code      string
+	DeviceID  *string
+	CreatedAt time.Time
+}
+
+type Referral struct {
+	ID              int64
+	ReferrerUserID  int32
+	RefereeUserID   int32
+	Code            string
+	SignupDeviceID  *string
+	Status          Status
+	RejectionReason *string
+	FirstOrderID    *int32
+	CreatedAt       time.Time
+	RewardedAt      *time.Time
+}
+
+// Qualified is a pending referral whose referee has completed an order.
+type Qualified struct {
+	ID             int64
+	ReferrerUserID int32
+	RefereeUserID  int32
+	FirstOrderID   int32
+}
+
+type Stats struct {
+	Code        string
+	Signups     int64
+	Pending     int64
+	Rewarded    int64
+	Rejected    int64
+	TotalEarned int64
+}
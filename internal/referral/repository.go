@@ -0,0 +1,313 @@
+package referral
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/wallet"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+type Repository interface {
+	GetCodeByUserID(ctx context.Context, userID int32) (*Code, error)
+	GetCode(ctx context.Context, code string) (*Code, error)
+	CreateCode(ctx context.Context, c *Code) error
+	SetCodeDevice(ctx context.Context, userID int32, deviceID string) error
+	DeviceSeen(ctx context.Context, referrerUserID int32, deviceID string) (bool, error)
+	Create(ctx context.Context, r *Referral) error
+	ListQualified(ctx context.Context, limit int32) ([]*Qualified, error)
+	Reward(ctx context.Context, q *Qualified) (bool, error)
+	GetStats(ctx context.Context, userID int32) (*Stats, error)
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && string(pqErr.Code) == PgUniqueViolation
+}
+
+// GetCodeByUserID returns the user's referral code, or nil when none has
+// been issued yet.
+func (r *repository) GetCodeByUserID(ctx context.Context, userID int32) (*Code, error) {
+	var c Code
+	err := r.db.QueryRowContext(ctx, `
+		SELECT user_id, code, device_id, created_at
+		FROM referral_codes
+		WHERE user_id = $1
+	`, userID).Scan(&c.UserID, &c.Code, &c.DeviceID, &c.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to get referral code",
+			zap.Int32("user_id", userID),
+			zap.Error(err),
+		)
+		return nil, ErrDB
+	}
+	return &c, nil
+}
+
+func (r *repository) GetCode(ctx context.Context, code string) (*Code, error) {
+	var c Code
+	err := r.db.QueryRowContext(ctx, `
+		SELECT user_id, code, device_id, created_at
+		FROM referral_codes
+		WHERE code = $1
+	`, code).Scan(&c.UserID, &c.Code, &c.DeviceID, &c.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrCodeNotFound
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to look up referral code", zap.Error(err))
+		return nil, ErrDB
+	}
+	return &c, nil
+}
+
+// CreateCode issues a code for the user. When the user already has one
+// (concurrent request), the existing code is loaded into c instead.
+func (r *repository) CreateCode(ctx context.Context, c *Code) error {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "CreateCode"),
+		zap.Int32("user_id", c.UserID),
+	)
+
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO referral_codes (user_id, code, device_id)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id) DO NOTHING
+		RETURNING created_at
+	`, c.UserID, c.Code, c.DeviceID).Scan(&c.CreatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		existing, err := r.GetCodeByUserID(ctx, c.UserID)
+		if err != nil {
+			return err
+		}
+		*c = *existing
+		return nil
+	}
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrCodeCollision
+		}
+		log.Error("failed to insert referral code", zap.Error(err))
+		return ErrDB
+	}
+	return nil
+}
+
+func (r *repository) SetCodeDevice(ctx context.Context, userID int32, deviceID string) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE referral_codes
+		SET device_id = $1
+		WHERE user_id = $2 AND device_id IS NULL
+	`, deviceID, userID)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to set referral code device",
+			zap.Int32("user_id", userID),
+			zap.Error(err),
+		)
+		return ErrDB
+	}
+	return nil
+}
+
+// DeviceSeen reports whether the device already belongs to the referrer or
+// was used by another account they referred.
+func (r *repository) DeviceSeen(ctx context.Context, referrerUserID int32, deviceID string) (bool, error) {
+	var seen bool
+	err := r.db.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM referral_codes
+			WHERE user_id = $1 AND device_id = $2
+		) OR EXISTS (
+			SELECT 1 FROM referrals
+			WHERE referrer_user_id = $1 AND signup_device_id = $2
+		)
+	`, referrerUserID, deviceID).Scan(&seen)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to check referral device",
+			zap.Int32("referrer_user_id", referrerUserID),
+			zap.Error(err),
+		)
+		return false, ErrDB
+	}
+	return seen, nil
+}
+
+func (r *repository) Create(ctx context.Context, ref *Referral) error {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "Create"),
+		zap.Int32("referee_user_id", ref.RefereeUserID),
+	)
+
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO referrals (
+			referrer_user_id, referee_user_id, code, signup_device_id, status, rejection_reason
+		) VALUES ($1,$2,$3,$4,$5,$6)
+		RETURNING id, created_at
+	`,
+		ref.ReferrerUserID, ref.RefereeUserID, ref.Code, ref.SignupDeviceID, ref.Status, ref.RejectionReason,
+	).Scan(&ref.ID, &ref.CreatedAt)
+	if err != nil {
+		if isUniqueViolation(err) {
+			log.Warn("referee already referred")
+			return ErrAlreadyReferred
+		}
+		log.Error("failed to insert referral", zap.Error(err))
+		return ErrDB
+	}
+	return nil
+}
+
+// ListQualified returns pending referrals whose referee has a COMPLETED
+// order, with the earliest such order. Waiting for completion rather than
+// payment keeps cancelled-and-refunded orders from earning rewards.
+func (r *repository) ListQualified(ctx context.Context, limit int32) ([]*Qualified, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ListQualified"),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT r.id, r.referrer_user_id, r.referee_user_id, fo.id
+		FROM referrals r
+		JOIN LATERAL (
+			SELECT o.id
+			FROM orders o
+			WHERE o.user_id = r.referee_user_id
+			  AND o.status = 'COMPLETED'
+			ORDER BY o.created_at
+			LIMIT 1
+		) fo ON true
+		WHERE r.status = 'PENDING'
+		ORDER BY r.created_at
+		LIMIT $1
+	`, limit)
+	if err != nil {
+		log.Error("failed to query qualified referrals", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	var list []*Qualified
+	for rows.Next() {
+		var q Qualified
+		if err := rows.Scan(&q.ID, &q.ReferrerUserID, &q.RefereeUserID, &q.FirstOrderID); err != nil {
+			log.Error("failed to scan qualified referral", zap.Error(err))
+			return nil, ErrDB
+		}
+		list = append(list, &q)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error("qualified referral iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return list, nil
+}
+
+// Reward marks the referral REWARDED and credits both wallets in one
+// transaction. Returns false when another worker already rewarded it.
+func (r *repository) Reward(ctx context.Context, q *Qualified) (ok bool, err error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "Reward"),
+		zap.Int64("referral_id", q.ID),
+	)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error("failed to begin transaction", zap.Error(err))
+		return false, ErrDB
+	}
+	defer func() {
+		if err != nil || !ok {
+			_ = tx.Rollback()
+		}
+	}()
+
+	res, err := tx.ExecContext(ctx, `
+		UPDATE referrals
+		SET status = 'REWARDED', first_order_id = $1, rewarded_at = NOW()
+		WHERE id = $2 AND status = 'PENDING'
+	`, q.FirstOrderID, q.ID)
+	if err != nil {
+		log.Error("failed to mark referral rewarded", zap.Error(err))
+		return false, ErrDB
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return false, nil
+	}
+
+	ref := fmt.Sprintf("referral-%d", q.ID)
+	if _, err = wallet.CreditTx(ctx, tx,
+		q.ReferrerUserID, referrerReward, rewardCurrency,
+		wallet.ReferenceReferral, ref+"-referrer", nil,
+	); err != nil {
+		return false, err
+	}
+	if _, err = wallet.CreditTx(ctx, tx,
+		q.RefereeUserID, refereeReward, rewardCurrency,
+		wallet.ReferenceReferral, ref+"-referee", nil,
+	); err != nil {
+		return false, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Error("failed to commit referral reward", zap.Error(err))
+		return false, ErrDB
+	}
+
+	return true, nil
+}
+
+func (r *repository) GetStats(ctx context.Context, userID int32) (*Stats, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "GetStats"),
+		zap.Int32("user_id", userID),
+	)
+
+	var s Stats
+	err := r.db.QueryRowContext(ctx, `
+		SELECT
+			COUNT(*),
+			COUNT(*) FILTER (WHERE status = 'PENDING'),
+			COUNT(*) FILTER (WHERE status = 'REWARDED'),
+			COUNT(*) FILTER (WHERE status = 'REJECTED'),
+			COALESCE((
+				SELECT SUM(l.amount)
+				FROM wallet_ledger l
+				JOIN wallets w ON w.id = l.wallet_id
+				WHERE w.user_id = $1
+				  AND l.reference_type = $2
+				  AND l.entry_type = 'CREDIT'
+			), 0)
+		FROM referrals
+		WHERE referrer_user_id = $1
+	`, userID, wallet.ReferenceReferral).Scan(
+		&s.Signups, &s.Pending, &s.Rewarded, &s.Rejected, &s.TotalEarned,
+	)
+	if err != nil {
+		log.Error("failed to get referral stats", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return &s, nil
+}
@@ -0,0 +1,98 @@
+package referral
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_CreateCode(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectQuery(`INSERT INTO referral_codes .* ON CONFLICT \(user_id\) DO NOTHING`).
+			WithArgs(int32(1), "ABCD2345", nil).
+			WillReturnRows(sqlmock.NewRows([]string{"created_at"}).AddRow(time.Now()))
+
+		err := repo.CreateCode(ctx, &Code{UserID: 1, Code: "ABCD2345"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("AlreadyIssued", func(t *testing.T) {
+		mock.ExpectQuery(`INSERT INTO referral_codes`).WillReturnError(sql.ErrNoRows)
+		mock.ExpectQuery(`SELECT user_id, code, device_id, created_at FROM referral_codes WHERE user_id = \$1`).
+			WithArgs(int32(1)).
+			WillReturnRows(sqlmock.NewRows([]string{"user_id", "code", "device_id", "created_at"}).
+				AddRow(1, "EXISTING", nil, time.Now()))
+
+		c := &Code{UserID: 1, Code: "ABCD2345"}
+		err := repo.CreateCode(ctx, c)
+		assert.NoError(t, err)
+		assert.Equal(t, "EXISTING", c.Code)
+	})
+
+	t.Run("Collision", func(t *testing.T) {
+		mock.ExpectQuery(`INSERT INTO referral_codes`).
+			WillReturnError(&pq.Error{Code: pq.ErrorCode(PgUniqueViolation)})
+
+		err := repo.CreateCode(ctx, &Code{UserID: 1, Code: "ABCD2345"})
+		assert.ErrorIs(t, err, ErrCodeCollision)
+	})
+}
+
+func TestRepository_Reward(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+	q := &Qualified{ID: 5, ReferrerUserID: 1, RefereeUserID: 2, FirstOrderID: 10}
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(`UPDATE referrals SET status = 'REWARDED'`).
+			WithArgs(int32(10), int64(5)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(`INSERT INTO wallets`).
+			WithArgs(int32(1), referrerReward, "IDR").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "balance"}).AddRow(7, referrerReward))
+		mock.ExpectQuery(`INSERT INTO wallet_ledger`).
+			WithArgs(int64(7), "CREDIT", referrerReward, referrerReward, "REFERRAL", "referral-5-referrer", nil).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+		mock.ExpectQuery(`INSERT INTO wallets`).
+			WithArgs(int32(2), refereeReward, "IDR").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "balance"}).AddRow(8, refereeReward))
+		mock.ExpectQuery(`INSERT INTO wallet_ledger`).
+			WithArgs(int64(8), "CREDIT", refereeReward, refereeReward, "REFERRAL", "referral-5-referee", nil).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(2))
+		mock.ExpectCommit()
+
+		ok, err := repo.Reward(ctx, q)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("AlreadyRewarded", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(`UPDATE referrals`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectRollback()
+
+		ok, err := repo.Reward(ctx, q)
+		assert.NoError(t, err)
+		assert.False(t, ok)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
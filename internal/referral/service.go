@@ -0,0 +1,208 @@
+package referral
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"strings"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/transport"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+const (
+	// Unambiguous characters only: no 0/O or 1/I/L.
+	codeAlphabet   = "23456789ABCDEFGHJKMNPQRSTUVWXYZ"
+	codeLength     = 8
+	codeMaxAttempt = 3
+)
+
+type Service interface {
+	// GetMyReferral returns the caller's referral code, issuing one on first
+	// use, together with their referral stats.
+	GetMyReferral(ctx context.Context) (*Stats, error)
+	// AttachReferral links a newly registered user to the owner of code.
+	// Self-referrals and same-device signups are recorded as REJECTED.
+	AttachReferral(ctx context.Context, refereeUserID uint, code string) (*Referral, error)
+	// RewardQualified credits both parties of every referral whose referee
+	// has completed a first order. Returns how many were rewarded.
+	RewardQualified(ctx context.Context) (int, error)
+}
+
+type service struct {
+	repo Repository
+}
+
+func NewService(repo Repository) Service {
+	return &service{repo: repo}
+}
+
+func (s *service) GetMyReferral(ctx context.Context) (*Stats, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "GetMyReferral"),
+	)
+
+	userID, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		log.Warn("user not authenticated")
+		return nil, ErrUnauthenticated
+	}
+	uid := int32(userID)
+	deviceID := transport.GetDeviceID(ctx)
+
+	code, err := s.repo.GetCodeByUserID(ctx, uid)
+	if err != nil {
+		log.Error("failed to get referral code", zap.Error(err))
+		return nil, err
+	}
+
+	if code == nil {
+		code, err = s.issueCode(ctx, uid, deviceID)
+		if err != nil {
+			log.Error("failed to issue referral code", zap.Error(err))
+			return nil, err
+		}
+	} else if code.DeviceID == nil && deviceID != "" {
+		if err := s.repo.SetCodeDevice(ctx, uid, deviceID); err != nil {
+			log.Warn("failed to record referral code device", zap.Error(err))
+		}
+	}
+
+	stats, err := s.repo.GetStats(ctx, uid)
+	if err != nil {
+		log.Error("failed to get referral stats", zap.Error(err))
+		return nil, err
+	}
+	stats.Code = code.Code
+
+	return stats, nil
+}
+
+func (s *service) issueCode(ctx context.Context, userID int32, deviceID string) (*Code, error) {
+	c := &Code{UserID: userID}
+	if deviceID != "" {
+		c.DeviceID = &deviceID
+	}
+
+	for attempt := 0; attempt < codeMaxAttempt; attempt++ {
+		code, err := generateCode()
+		if err != nil {
+			return nil, err
+		}
+		c.Code = code
+
+		err = s.repo.CreateCode(ctx, c)
+		if errors.Is(err, ErrCodeCollision) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return c, nil
+	}
+
+	return nil, ErrCodeCollision
+}
+
+func generateCode() (string, error) {
+	var b strings.Builder
+	max := big.NewInt(int64(len(codeAlphabet)))
+	for i := 0; i < codeLength; i++ {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b.WriteByte(codeAlphabet[n.Int64()])
+	}
+	return b.String(), nil
+}
+
+func (s *service) AttachReferral(ctx context.Context, refereeUserID uint, code string) (*Referral, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "AttachReferral"),
+		zap.Uint("referee_user_id", refereeUserID),
+	)
+
+	owner, err := s.repo.GetCode(ctx, strings.ToUpper(strings.TrimSpace(code)))
+	if err != nil {
+		log.Warn("referral code lookup failed", zap.Error(err))
+		return nil, err
+	}
+
+	ref := &Referral{
+		ReferrerUserID: owner.UserID,
+		RefereeUserID:  int32(refereeUserID),
+		Code:           owner.Code,
+		Status:         StatusPending,
+	}
+
+	deviceID := transport.GetDeviceID(ctx)
+	if deviceID != "" {
+		ref.SignupDeviceID = &deviceID
+	}
+
+	switch {
+	case owner.UserID == int32(refereeUserID):
+		ref.Status = StatusRejected
+		ref.RejectionReason = utils.StrPtr(RejectSelfReferral)
+	case deviceID != "":
+		seen, err := s.repo.DeviceSeen(ctx, owner.UserID, deviceID)
+		if err != nil {
+			log.Error("failed to check referral device", zap.Error(err))
+			return nil, err
+		}
+		if seen {
+			ref.Status = StatusRejected
+			ref.RejectionReason = utils.StrPtr(RejectSameDevice)
+		}
+	}
+
+	if err := s.repo.Create(ctx, ref); err != nil {
+		log.Error("failed to create referral", zap.Error(err))
+		return nil, err
+	}
+
+	log.Info("referral attached",
+		zap.Int32("referrer_user_id", ref.ReferrerUserID),
+		zap.String("status", string(ref.Status)),
+	)
+	return ref, nil
+}
+
+func (s *service) RewardQualified(ctx context.Context) (int, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "RewardQualified"),
+	)
+
+	qualified, err := s.repo.ListQualified(ctx, rewardBatchSize)
+	if err != nil {
+		log.Error("failed to list qualified referrals", zap.Error(err))
+		return 0, err
+	}
+
+	rewarded := 0
+	for _, q := range qualified {
+		ok, err := s.repo.Reward(ctx, q)
+		if err != nil {
+			log.Error("failed to reward referral",
+				zap.Int64("referral_id", q.ID),
+				zap.Error(err),
+			)
+			continue
+		}
+		if ok {
+			rewarded++
+		}
+	}
+
+	if rewarded > 0 {
+		log.Info("referrals rewarded", zap.Int("count", rewarded))
+	}
+	return rewarded, nil
+}
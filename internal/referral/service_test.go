@@ -0,0 +1,201 @@
+package referral
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"warimas-be/internal/transport"
+	"warimas-be/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// --- Mocks ---
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) GetCodeByUserID(ctx context.Context, userID int32) (*Code, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Code), args.Error(1)
+}
+
+func (m *MockRepository) GetCode(ctx context.Context, code string) (*Code, error) {
+	args := m.Called(ctx, code)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Code), args.Error(1)
+}
+
+func (m *MockRepository) CreateCode(ctx context.Context, c *Code) error {
+	args := m.Called(ctx, c)
+	return args.Error(0)
+}
+
+func (m *MockRepository) SetCodeDevice(ctx context.Context, userID int32, deviceID string) error {
+	args := m.Called(ctx, userID, deviceID)
+	return args.Error(0)
+}
+
+func (m *MockRepository) DeviceSeen(ctx context.Context, referrerUserID int32, deviceID string) (bool, error) {
+	args := m.Called(ctx, referrerUserID, deviceID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRepository) Create(ctx context.Context, r *Referral) error {
+	args := m.Called(ctx, r)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ListQualified(ctx context.Context, limit int32) ([]*Qualified, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Qualified), args.Error(1)
+}
+
+func (m *MockRepository) Reward(ctx context.Context, q *Qualified) (bool, error) {
+	args := m.Called(ctx, q)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRepository) GetStats(ctx context.Context, userID int32) (*Stats, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Stats), args.Error(1)
+}
+
+// --- Tests ---
+
+func withDevice(ctx context.Context, deviceID string) context.Context {
+	req := httptest.NewRequest(http.MethodPost, "/query", nil)
+	req.Header.Set("X-Device-ID", deviceID)
+	return transport.WithHTTP(ctx, req, httptest.NewRecorder())
+}
+
+func TestService_GetMyReferral(t *testing.T) {
+	t.Run("IssuesCodeOnFirstUse", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+		ctx := withDevice(utils.SetUserContext(context.Background(), 1, "test@example.com", "user"), "dev-1")
+
+		mockRepo.On("GetCodeByUserID", ctx, int32(1)).Return(nil, nil)
+		mockRepo.On("CreateCode", ctx, mock.MatchedBy(func(c *Code) bool {
+			return len(c.Code) == codeLength && *c.DeviceID == "dev-1"
+		})).Return(nil)
+		mockRepo.On("GetStats", ctx, int32(1)).Return(&Stats{Signups: 2}, nil)
+
+		stats, err := svc.GetMyReferral(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, stats.Code, codeLength)
+		assert.Equal(t, int64(2), stats.Signups)
+	})
+
+	t.Run("RetriesOnCollision", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+		ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "user")
+
+		mockRepo.On("GetCodeByUserID", ctx, int32(1)).Return(nil, nil)
+		mockRepo.On("CreateCode", ctx, mock.Anything).Return(ErrCodeCollision).Once()
+		mockRepo.On("CreateCode", ctx, mock.Anything).Return(nil).Once()
+		mockRepo.On("GetStats", ctx, int32(1)).Return(&Stats{}, nil)
+
+		_, err := svc.GetMyReferral(ctx)
+		assert.NoError(t, err)
+		mockRepo.AssertNumberOfCalls(t, "CreateCode", 2)
+	})
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		svc := NewService(new(MockRepository))
+
+		_, err := svc.GetMyReferral(context.Background())
+		assert.ErrorIs(t, err, ErrUnauthenticated)
+	})
+}
+
+func TestService_AttachReferral(t *testing.T) {
+	owner := &Code{UserID: 1, Code: "ABCD2345"}
+
+	t.Run("Pending", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+		ctx := withDevice(context.Background(), "dev-new")
+
+		mockRepo.On("GetCode", ctx, "ABCD2345").Return(owner, nil)
+		mockRepo.On("DeviceSeen", ctx, int32(1), "dev-new").Return(false, nil)
+		mockRepo.On("Create", ctx, mock.Anything).Return(nil)
+
+		ref, err := svc.AttachReferral(ctx, 2, " abcd2345 ")
+		assert.NoError(t, err)
+		assert.Equal(t, StatusPending, ref.Status)
+		assert.Equal(t, int32(1), ref.ReferrerUserID)
+	})
+
+	t.Run("SameDeviceRejected", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+		ctx := withDevice(context.Background(), "dev-1")
+
+		mockRepo.On("GetCode", ctx, "ABCD2345").Return(owner, nil)
+		mockRepo.On("DeviceSeen", ctx, int32(1), "dev-1").Return(true, nil)
+		mockRepo.On("Create", ctx, mock.Anything).Return(nil)
+
+		ref, err := svc.AttachReferral(ctx, 2, "ABCD2345")
+		assert.NoError(t, err)
+		assert.Equal(t, StatusRejected, ref.Status)
+		assert.Equal(t, RejectSameDevice, *ref.RejectionReason)
+	})
+
+	t.Run("SelfReferralRejected", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+		ctx := context.Background()
+
+		mockRepo.On("GetCode", ctx, "ABCD2345").Return(owner, nil)
+		mockRepo.On("Create", ctx, mock.Anything).Return(nil)
+
+		ref, err := svc.AttachReferral(ctx, 1, "ABCD2345")
+		assert.NoError(t, err)
+		assert.Equal(t, StatusRejected, ref.Status)
+		assert.Equal(t, RejectSelfReferral, *ref.RejectionReason)
+	})
+
+	t.Run("UnknownCode", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+		ctx := context.Background()
+
+		mockRepo.On("GetCode", ctx, "NOPE").Return(nil, ErrCodeNotFound)
+
+		_, err := svc.AttachReferral(ctx, 2, "nope")
+		assert.ErrorIs(t, err, ErrCodeNotFound)
+		mockRepo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+}
+
+func TestService_RewardQualified(t *testing.T) {
+	mockRepo := new(MockRepository)
+	svc := NewService(mockRepo)
+	ctx := context.Background()
+
+	q1 := &Qualified{ID: 1, ReferrerUserID: 1, RefereeUserID: 2, FirstOrderID: 10}
+	q2 := &Qualified{ID: 2, ReferrerUserID: 1, RefereeUserID: 3, FirstOrderID: 11}
+	mockRepo.On("ListQualified", ctx, int32(rewardBatchSize)).Return([]*Qualified{q1, q2}, nil)
+	mockRepo.On("Reward", ctx, q1).Return(true, nil)
+	mockRepo.On("Reward", ctx, q2).Return(false, nil)
+
+	n, err := svc.RewardQualified(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, n)
+}
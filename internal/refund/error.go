@@ -0,0 +1,19 @@
+package refund
+
+import (
+	"errors"
+	"warimas-be/internal/apperr"
+)
+
+var (
+	ErrUnauthenticated = apperr.Unauthenticated("unauthenticated")
+	ErrForbidden       = apperr.Forbidden("forbidden")
+	ErrOrderNotFound   = apperr.NotFound("order not found")
+	ErrNotRefundable   = errors.New("order is not refundable")
+	ErrNothingToRefund = errors.New("order has no paid amount to refund")
+	ErrInvalidMethod   = errors.New("invalid refund method")
+	ErrInvalidReason   = apperr.Invalid("choose a return reason from the list")
+	ErrRefundExists    = errors.New("refund already requested for this order")
+	ErrDB              = errors.New("database error")
+	PgUniqueViolation  = "23505"
+)
@@ -0,0 +1,35 @@
+package refund
+
+import (
+	"strconv"
+	"warimas-be/internal/graph/model"
+)
+
+func MapRefundToGraphQL(r *Refund) *model.Refund {
+	var reasonID *string
+	if r.ReasonID != nil {
+		id := strconv.Itoa(int(*r.ReasonID))
+		reasonID = &id
+	}
+	return &model.Refund{
+		ID:            strconv.FormatInt(r.ID, 10),
+		OrderID:       strconv.FormatInt(int64(r.OrderID), 10),
+		Amount:        int32(r.Amount),
+		Currency:      r.Currency,
+		Method:        model.RefundMethod(r.Method),
+		Status:        model.RefundStatus(r.Status),
+		ReasonID:      reasonID,
+		Reason:        r.Reason,
+		FailureReason: r.FailureReason,
+		CreatedAt:     r.CreatedAt,
+		CompletedAt:   r.CompletedAt,
+	}
+}
+
+func MapRefundsToGraphQL(refunds []*Refund) []*model.Refund {
+	items := make([]*model.Refund, 0, len(refunds))
+	for _, r := range refunds {
+		items = append(items, MapRefundToGraphQL(r))
+	}
+	return items
+}
@@ -0,0 +1,75 @@
+package refund
+
+import (
+	"time"
+	"warimas-be/internal/statemachine"
+)
+
+type Method string
+
+const (
+	MethodWallet  Method = "WALLET"
+	MethodGateway Method = "GATEWAY"
+)
+
+type Status string
+
+const (
+	StatusPending    Status = "PENDING"
+	StatusProcessing Status = "PROCESSING"
+	StatusCompleted  Status = "COMPLETED"
+	StatusFailed     Status = "FAILED"
+)
+
+// Statuses is the refund lifecycle. Wallet refunds are paid out at once and
+// start COMPLETED; gateway refunds wait for the provider.
+var Statuses = statemachine.New[Status, *Refund]("refund",
+	StatusPending,
+	StatusProcessing,
+	StatusCompleted,
+	StatusFailed,
+).
+	Initial(StatusPending, StatusCompleted).
+	Permit(StatusPending, StatusProcessing, StatusFailed).
+	Permit(StatusProcessing, StatusCompleted, StatusFailed).
+	Terminal(StatusCompleted, StatusFailed)
+
+// ReconcileInterval is how often PROCESSING gateway refunds are checked
+// with the provider.
+const ReconcileInterval = 10 * time.Minute
+
+// gatewayRefundReason is sent to the payment provider for customer refunds.
+const gatewayRefundReason = "REQUESTED_BY_CUSTOMER"
+
+type Refund struct {
+	ID       int64
+	OrderID  int32
+	UserID   *int32
+	Amount   int64
+	Currency string
+	Method   Method
+	Status   Status
+	// ReasonID is the RETURN reason the customer picked; Reason holds
+	// the details they added.
+	ReasonID         *int32
+	Reason           *string
+	WalletLedgerID   *int64
+	PaymentReference *string
+	ProviderRefundID *string
+	FailureReason    *string
+	CreatedAt        time.Time
+	UpdatedAt        time.Time
+	CompletedAt      *time.Time
+}
+
+// RefundableOrder is an order with the amounts paid per funding source.
+type RefundableOrder struct {
+	ID               int32
+	UserID           *int32
+	Status           string
+	ExternalID       string
+	Currency         string
+	WalletPaid       int64
+	GatewayPaid      int64
+	PaymentReference *string
+}
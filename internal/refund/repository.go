@@ -0,0 +1,397 @@
+package refund
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/wallet"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+type Repository interface {
+	GetRefundableOrder(ctx context.Context, orderID uint) (*RefundableOrder, error)
+	// IsCustomerReturnReason reports whether id is an active RETURN
+	// reason customers may pick.
+	IsCustomerReturnReason(ctx context.Context, id int32) (bool, error)
+	ListByOrderID(ctx context.Context, orderID uint) ([]*Refund, error)
+	CreateWalletRefund(ctx context.Context, r *Refund) error
+	// CreateGatewayRefund records r and, when set, the wallet portion
+	// walletPart in one transaction.
+	CreateGatewayRefund(ctx context.Context, r *Refund, walletPart *Refund) error
+	ListPendingGatewayRefunds(ctx context.Context, limit int32) ([]*Refund, error)
+	// ListProcessingGatewayRefunds returns refunds the provider accepted
+	// but has not settled yet, the ones waiting longest first.
+	ListProcessingGatewayRefunds(ctx context.Context, limit int32) ([]*Refund, error)
+	MarkProcessing(ctx context.Context, id int64, providerRefundID string) error
+	MarkCompleted(ctx context.Context, id int64) error
+	MarkFailed(ctx context.Context, id int64, reason string) error
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+const refundColumns = `
+	id, order_id, user_id, amount, currency, method, status, reason_id, reason,
+	wallet_ledger_id, payment_reference, provider_refund_id, failure_reason,
+	created_at, updated_at, completed_at
+`
+
+func scanRefund(row interface{ Scan(dest ...any) error }) (*Refund, error) {
+	var r Refund
+	err := row.Scan(
+		&r.ID, &r.OrderID, &r.UserID, &r.Amount, &r.Currency, &r.Method, &r.Status, &r.ReasonID, &r.Reason,
+		&r.WalletLedgerID, &r.PaymentReference, &r.ProviderRefundID, &r.FailureReason,
+		&r.CreatedAt, &r.UpdatedAt, &r.CompletedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && string(pqErr.Code) == PgUniqueViolation
+}
+
+// GetRefundableOrder loads an order together with what was actually paid,
+// split into the wallet portion and the gateway portion.
+func (r *repository) GetRefundableOrder(ctx context.Context, orderID uint) (*RefundableOrder, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "GetRefundableOrder"),
+		zap.Uint("order_id", orderID),
+	)
+
+	const q = `
+		SELECT
+			o.id, o.user_id, o.status, o.external_id, o.currency,
+			COALESCE(SUM(p.amount) FILTER (WHERE p.status = 'PAID' AND p.provider = 'WALLET'), 0),
+			COALESCE(SUM(p.amount) FILTER (WHERE p.status = 'PAID' AND p.provider <> 'WALLET'), 0),
+			MAX(p.external_reference) FILTER (WHERE p.status = 'PAID' AND p.provider <> 'WALLET')
+		FROM orders o
+		LEFT JOIN payments p ON p.order_id = o.id
+		WHERE o.id = $1
+		GROUP BY o.id
+	`
+
+	var o RefundableOrder
+	err := r.db.QueryRowContext(ctx, q, orderID).Scan(
+		&o.ID, &o.UserID, &o.Status, &o.ExternalID, &o.Currency,
+		&o.WalletPaid, &o.GatewayPaid, &o.PaymentReference,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		log.Warn("order not found")
+		return nil, ErrOrderNotFound
+	}
+	if err != nil {
+		log.Error("failed to load refundable order", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return &o, nil
+}
+
+func (r *repository) IsCustomerReturnReason(ctx context.Context, id int32) (bool, error) {
+	var ok bool
+	err := r.db.QueryRowContext(ctx, `
+		SELECT EXISTS (
+			SELECT 1 FROM order_reasons
+			WHERE id = $1 AND kind = 'RETURN' AND audience = 'CUSTOMER' AND is_active
+		)
+	`, id).Scan(&ok)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to check return reason", zap.Error(err))
+		return false, ErrDB
+	}
+	return ok, nil
+}
+
+func (r *repository) ListByOrderID(ctx context.Context, orderID uint) ([]*Refund, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ListByOrderID"),
+		zap.Uint("order_id", orderID),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+refundColumns+`
+		FROM refunds
+		WHERE order_id = $1
+		ORDER BY id
+	`, orderID)
+	if err != nil {
+		log.Error("failed to query refunds", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	refunds := []*Refund{}
+	for rows.Next() {
+		rf, err := scanRefund(rows)
+		if err != nil {
+			log.Error("failed to scan refund", zap.Error(err))
+			return nil, ErrDB
+		}
+		refunds = append(refunds, rf)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error("refund iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return refunds, nil
+}
+
+// CreateWalletRefund records a completed refund and credits the user's
+// wallet in the same transaction, creating the wallet on first credit.
+func (r *repository) CreateWalletRefund(ctx context.Context, rf *Refund) (err error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "CreateWalletRefund"),
+		zap.Int32("order_id", rf.OrderID),
+		zap.Int64("amount", rf.Amount),
+	)
+
+	if rf.UserID == nil {
+		return ErrUnauthenticated
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error("failed to begin transaction", zap.Error(err))
+		return ErrDB
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if err = insertWalletRefund(ctx, tx, rf); err != nil {
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Error("failed to commit wallet refund", zap.Error(err))
+		return ErrDB
+	}
+
+	log.Info("wallet refund completed", zap.Int64("refund_id", rf.ID))
+	return nil
+}
+
+// insertWalletRefund records rf as completed inside tx and credits the
+// user's wallet with it.
+func insertWalletRefund(ctx context.Context, tx *sql.Tx, rf *Refund) error {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "insertWalletRefund"),
+		zap.Int32("order_id", rf.OrderID),
+	)
+
+	// 1. Refund record
+	err := tx.QueryRowContext(ctx, `
+		INSERT INTO refunds (
+			order_id, user_id, amount, currency, method, status, reason, reason_id, completed_at
+		) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,NOW())
+		RETURNING id, created_at, updated_at, completed_at
+	`,
+		rf.OrderID, rf.UserID, rf.Amount, rf.Currency, MethodWallet, StatusCompleted, rf.Reason, rf.ReasonID,
+	).Scan(&rf.ID, &rf.CreatedAt, &rf.UpdatedAt, &rf.CompletedAt)
+	if err != nil {
+		if isUniqueViolation(err) {
+			log.Warn("wallet refund already exists")
+			return ErrRefundExists
+		}
+		log.Error("failed to insert refund", zap.Error(err))
+		return ErrDB
+	}
+
+	// 2. Credit wallet + ledger entry
+	ledgerID, err := wallet.CreditTx(ctx, tx,
+		*rf.UserID, rf.Amount, rf.Currency,
+		wallet.ReferenceRefund, strconv.FormatInt(rf.ID, 10), rf.Reason,
+	)
+	if err != nil {
+		return ErrDB
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE refunds SET wallet_ledger_id = $1 WHERE id = $2
+	`, ledgerID, rf.ID)
+	if err != nil {
+		log.Error("failed to link refund to ledger entry", zap.Error(err))
+		return ErrDB
+	}
+
+	rf.Method = MethodWallet
+	rf.Status = StatusCompleted
+	rf.WalletLedgerID = &ledgerID
+	return nil
+}
+
+// CreateGatewayRefund records a PENDING refund to be submitted to the
+// payment provider by ProcessPendingGatewayRefunds. walletPart, when set,
+// is the wallet portion of the same order; it is refunded to the wallet
+// in the same transaction, so the order is never left half refunded.
+func (r *repository) CreateGatewayRefund(ctx context.Context, rf *Refund, walletPart *Refund) (err error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "CreateGatewayRefund"),
+		zap.Int32("order_id", rf.OrderID),
+		zap.Int64("amount", rf.Amount),
+	)
+
+	if walletPart != nil && walletPart.UserID == nil {
+		return ErrUnauthenticated
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error("failed to begin transaction", zap.Error(err))
+		return ErrDB
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO refunds (
+			order_id, user_id, amount, currency, method, status, reason, payment_reference, reason_id
+		) VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)
+		RETURNING id, created_at, updated_at
+	`,
+		rf.OrderID, rf.UserID, rf.Amount, rf.Currency, MethodGateway, StatusPending, rf.Reason, rf.PaymentReference, rf.ReasonID,
+	).Scan(&rf.ID, &rf.CreatedAt, &rf.UpdatedAt)
+	if err != nil {
+		if isUniqueViolation(err) {
+			log.Warn("gateway refund already exists")
+			return ErrRefundExists
+		}
+		log.Error("failed to insert refund", zap.Error(err))
+		return ErrDB
+	}
+
+	if walletPart != nil {
+		if err = insertWalletRefund(ctx, tx, walletPart); err != nil {
+			return err
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Error("failed to commit gateway refund", zap.Error(err))
+		return ErrDB
+	}
+
+	rf.Method = MethodGateway
+	rf.Status = StatusPending
+	return nil
+}
+
+func (r *repository) ListPendingGatewayRefunds(ctx context.Context, limit int32) ([]*Refund, error) {
+	return r.listGatewayRefunds(ctx, "ListPendingGatewayRefunds", StatusPending, limit)
+}
+
+func (r *repository) ListProcessingGatewayRefunds(ctx context.Context, limit int32) ([]*Refund, error) {
+	return r.listGatewayRefunds(ctx, "ListProcessingGatewayRefunds", StatusProcessing, limit)
+}
+
+// listGatewayRefunds returns up to limit gateway refunds in status, the
+// ones waiting longest first.
+func (r *repository) listGatewayRefunds(ctx context.Context, method string, status Status, limit int32) ([]*Refund, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", method),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+refundColumns+`
+		FROM refunds
+		WHERE method = 'GATEWAY' AND status = $1
+		ORDER BY updated_at
+		LIMIT $2
+	`, status, limit)
+	if err != nil {
+		log.Error("failed to query gateway refunds", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	var refunds []*Refund
+	for rows.Next() {
+		rf, err := scanRefund(rows)
+		if err != nil {
+			log.Error("failed to scan refund", zap.Error(err))
+			return nil, ErrDB
+		}
+		refunds = append(refunds, rf)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error("refund iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return refunds, nil
+}
+
+func (r *repository) MarkProcessing(ctx context.Context, id int64, providerRefundID string) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE refunds
+		SET status = $1, provider_refund_id = $2
+		WHERE id = $3 AND status = ANY($4)
+	`, StatusProcessing, providerRefundID, id, pq.Array(Statuses.Sources(StatusProcessing)))
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to mark refund processing",
+			zap.Int64("refund_id", id),
+			zap.Error(err),
+		)
+		return ErrDB
+	}
+	return nil
+}
+
+func (r *repository) MarkCompleted(ctx context.Context, id int64) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE refunds
+		SET status = $1, completed_at = NOW()
+		WHERE id = $2 AND status = ANY($3)
+	`, StatusCompleted, id, pq.Array(Statuses.Sources(StatusCompleted)))
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to mark refund completed",
+			zap.Int64("refund_id", id),
+			zap.Error(err),
+		)
+		return ErrDB
+	}
+	return nil
+}
+
+func (r *repository) MarkFailed(ctx context.Context, id int64, reason string) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE refunds
+		SET status = $1, failure_reason = $2
+		WHERE id = $3 AND status = ANY($4)
+	`, StatusFailed, reason, id, pq.Array(Statuses.Sources(StatusFailed)))
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to mark refund failed",
+			zap.Int64("refund_id", id),
+			zap.Error(err),
+		)
+		return ErrDB
+	}
+	return nil
+}
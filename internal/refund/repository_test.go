@@ -0,0 +1,216 @@
+package refund
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_GetRefundableOrder(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{
+			"id", "user_id", "status", "external_id", "currency", "wallet_paid", "gateway_paid", "reference",
+		}).AddRow(10, 1, "CANCELLED", "ext-10", "IDR", 20000, 80000, "pr-123")
+
+		mock.ExpectQuery(`SELECT .* FROM orders o LEFT JOIN payments p`).
+			WithArgs(uint(10)).
+			WillReturnRows(rows)
+
+		o, err := repo.GetRefundableOrder(ctx, 10)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(20000), o.WalletPaid)
+		assert.Equal(t, int64(80000), o.GatewayPaid)
+		assert.Equal(t, "pr-123", *o.PaymentReference)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT .* FROM orders o`).
+			WithArgs(uint(10)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		_, err := repo.GetRefundableOrder(ctx, 10)
+		assert.ErrorIs(t, err, ErrOrderNotFound)
+	})
+}
+
+func TestRepository_CreateWalletRefund(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+	uid := int32(1)
+	now := time.Now()
+
+	t.Run("Success", func(t *testing.T) {
+		reasonID := int32(3)
+		rf := &Refund{OrderID: 10, UserID: &uid, Amount: 50000, Currency: "IDR", ReasonID: &reasonID}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO refunds`).
+			WithArgs(int32(10), &uid, int64(50000), "IDR", MethodWallet, StatusCompleted, nil, &reasonID).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at", "completed_at"}).
+				AddRow(7, now, now, now))
+		mock.ExpectQuery(`INSERT INTO wallets .* ON CONFLICT \(user_id\)`).
+			WithArgs(int32(1), int64(50000), "IDR").
+			WillReturnRows(sqlmock.NewRows([]string{"id", "balance"}).AddRow(3, 65000))
+		mock.ExpectQuery(`INSERT INTO wallet_ledger`).
+			WithArgs(int64(3), "CREDIT", int64(50000), int64(65000), "REFUND", "7", nil).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(42))
+		mock.ExpectExec(`UPDATE refunds SET wallet_ledger_id`).
+			WithArgs(int64(42), int64(7)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := repo.CreateWalletRefund(ctx, rf)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(7), rf.ID)
+		assert.Equal(t, StatusCompleted, rf.Status)
+		assert.Equal(t, int64(42), *rf.WalletLedgerID)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("AlreadyRefunded", func(t *testing.T) {
+		rf := &Refund{OrderID: 10, UserID: &uid, Amount: 50000, Currency: "IDR"}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO refunds`).
+			WillReturnError(&pq.Error{Code: pq.ErrorCode(PgUniqueViolation)})
+		mock.ExpectRollback()
+
+		err := repo.CreateWalletRefund(ctx, rf)
+		assert.ErrorIs(t, err, ErrRefundExists)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("LedgerError", func(t *testing.T) {
+		rf := &Refund{OrderID: 10, UserID: &uid, Amount: 50000, Currency: "IDR"}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO refunds`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at", "completed_at"}).
+				AddRow(8, now, now, now))
+		mock.ExpectQuery(`INSERT INTO wallets`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "balance"}).AddRow(3, 65000))
+		mock.ExpectQuery(`INSERT INTO wallet_ledger`).
+			WillReturnError(errors.New("db error"))
+		mock.ExpectRollback()
+
+		err := repo.CreateWalletRefund(ctx, rf)
+		assert.ErrorIs(t, err, ErrDB)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_CreateGatewayRefund(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	uid := int32(1)
+	ref := "pr-123"
+	now := time.Now()
+
+	t.Run("GatewayOnly", func(t *testing.T) {
+		rf := &Refund{OrderID: 10, UserID: &uid, Amount: 80000, Currency: "IDR", PaymentReference: &ref}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO refunds`).
+			WithArgs(int32(10), &uid, int64(80000), "IDR", MethodGateway, StatusPending, nil, &ref, nil).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).AddRow(9, now, now))
+		mock.ExpectCommit()
+
+		err := repo.CreateGatewayRefund(context.Background(), rf, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(9), rf.ID)
+		assert.Equal(t, StatusPending, rf.Status)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("WithWalletPart", func(t *testing.T) {
+		rf := &Refund{OrderID: 10, UserID: &uid, Amount: 80000, Currency: "IDR", PaymentReference: &ref}
+		walletPart := &Refund{OrderID: 10, UserID: &uid, Amount: 20000, Currency: "IDR"}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO refunds`).
+			WithArgs(int32(10), &uid, int64(80000), "IDR", MethodGateway, StatusPending, nil, &ref, nil).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).AddRow(9, now, now))
+		mock.ExpectQuery(`INSERT INTO refunds`).
+			WithArgs(int32(10), &uid, int64(20000), "IDR", MethodWallet, StatusCompleted, nil, nil).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at", "completed_at"}).
+				AddRow(10, now, now, now))
+		mock.ExpectQuery(`INSERT INTO wallets`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "balance"}).AddRow(3, 20000))
+		mock.ExpectQuery(`INSERT INTO wallet_ledger`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(77))
+		mock.ExpectExec(`UPDATE refunds SET wallet_ledger_id`).
+			WithArgs(int64(77), int64(10)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		err := repo.CreateGatewayRefund(context.Background(), rf, walletPart)
+		assert.NoError(t, err)
+		assert.Equal(t, StatusCompleted, walletPart.Status)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("WalletPartFailsRollsBackGatewayRefund", func(t *testing.T) {
+		rf := &Refund{OrderID: 10, UserID: &uid, Amount: 80000, Currency: "IDR", PaymentReference: &ref}
+		walletPart := &Refund{OrderID: 10, UserID: &uid, Amount: 20000, Currency: "IDR"}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`INSERT INTO refunds`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).AddRow(9, now, now))
+		mock.ExpectQuery(`INSERT INTO refunds`).
+			WillReturnError(errors.New("db error"))
+		mock.ExpectRollback()
+
+		err := repo.CreateGatewayRefund(context.Background(), rf, walletPart)
+		assert.ErrorIs(t, err, ErrDB)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_MarkCompleted(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectExec(`UPDATE refunds\s+SET status = \$1, completed_at = NOW\(\)\s+WHERE id = \$2 AND status = ANY\(\$3\)`).
+		WithArgs(StatusCompleted, int64(9), pq.Array([]Status{StatusProcessing})).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = NewRepository(db).MarkCompleted(context.Background(), 9)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_MarkFailed(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	// A completed refund is never turned into a failed one
+	mock.ExpectExec(`UPDATE refunds\s+SET status = \$1, failure_reason = \$2\s+WHERE id = \$3 AND status = ANY\(\$4\)`).
+		WithArgs(StatusFailed, "declined", int64(9), pq.Array([]Status{StatusPending, StatusProcessing})).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err = NewRepository(db).MarkFailed(context.Background(), 9, "declined")
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
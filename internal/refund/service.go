@@ -0,0 +1,282 @@
+package refund
+
+import (
+	"context"
+	"fmt"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/payment"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+const defaultProcessLimit = 50
+
+type Service interface {
+	// RequestRefund refunds a cancelled, paid order. WALLET refunds the
+	// whole paid amount to the store wallet immediately. GATEWAY returns the
+	// gateway portion through the provider asynchronously; any wallet
+	// portion still goes straight back to the wallet. reasonID is the
+	// customer RETURN reason picked; note adds details to it.
+	RequestRefund(ctx context.Context, orderID uint, method Method, reasonID int32, note *string) ([]*Refund, error)
+	GetOrderRefunds(ctx context.Context, orderID uint) ([]*Refund, error)
+	ProcessPendingGatewayRefunds(ctx context.Context, limit int32) (int, error)
+	// ReconcileGatewayRefunds asks the provider how PROCESSING refunds
+	// ended and completes or fails them. Returns how many were settled.
+	ReconcileGatewayRefunds(ctx context.Context) (int, error)
+}
+
+type service struct {
+	repo        Repository
+	paymentGate payment.Gateway
+}
+
+func NewService(repo Repository, paymentGate payment.Gateway) Service {
+	return &service{repo: repo, paymentGate: paymentGate}
+}
+
+func (s *service) RequestRefund(
+	ctx context.Context,
+	orderID uint,
+	method Method,
+	reasonID int32,
+	note *string,
+) ([]*Refund, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "RequestRefund"),
+		zap.Uint("order_id", orderID),
+		zap.String("refund_method", string(method)),
+		zap.Int32("reason_id", reasonID),
+	)
+
+	log.Info("request refund started")
+
+	userID, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		log.Warn("user not authenticated")
+		return nil, ErrUnauthenticated
+	}
+
+	if method != MethodWallet && method != MethodGateway {
+		log.Warn("invalid refund method")
+		return nil, ErrInvalidMethod
+	}
+
+	if reasonID <= 0 {
+		log.Warn("refund without a reason")
+		return nil, ErrInvalidReason
+	}
+	usable, err := s.repo.IsCustomerReturnReason(ctx, reasonID)
+	if err != nil {
+		return nil, err
+	}
+	if !usable {
+		log.Warn("reason is not an active customer return reason")
+		return nil, ErrInvalidReason
+	}
+
+	o, err := s.repo.GetRefundableOrder(ctx, orderID)
+	if err != nil {
+		log.Error("failed to load order", zap.Error(err))
+		return nil, err
+	}
+
+	if o.UserID == nil || *o.UserID != int32(userID) {
+		log.Warn("forbidden: order belongs to another user")
+		return nil, ErrForbidden
+	}
+
+	if o.Status != "CANCELLED" {
+		log.Warn("order is not refundable", zap.String("status", o.Status))
+		return nil, ErrNotRefundable
+	}
+
+	if o.WalletPaid+o.GatewayPaid <= 0 {
+		log.Warn("nothing to refund")
+		return nil, ErrNothingToRefund
+	}
+
+	uid := int32(userID)
+	var refunds []*Refund
+
+	walletAmount := o.WalletPaid
+	if method == MethodWallet {
+		walletAmount += o.GatewayPaid
+	}
+
+	var walletPart *Refund
+	if walletAmount > 0 {
+		walletPart = &Refund{
+			OrderID:  o.ID,
+			UserID:   &uid,
+			Amount:   walletAmount,
+			Currency: o.Currency,
+			ReasonID: &reasonID,
+			Reason:   note,
+		}
+	}
+
+	if method == MethodGateway && o.GatewayPaid > 0 {
+		rf := &Refund{
+			OrderID:          o.ID,
+			UserID:           &uid,
+			Amount:           o.GatewayPaid,
+			Currency:         o.Currency,
+			ReasonID:         &reasonID,
+			Reason:           note,
+			PaymentReference: o.PaymentReference,
+		}
+		if err := s.repo.CreateGatewayRefund(ctx, rf, walletPart); err != nil {
+			log.Error("failed to create gateway refund", zap.Error(err))
+			return nil, err
+		}
+		refunds = append(refunds, rf)
+	} else if walletPart != nil {
+		if err := s.repo.CreateWalletRefund(ctx, walletPart); err != nil {
+			log.Error("failed to create wallet refund", zap.Error(err))
+			return nil, err
+		}
+	}
+	if walletPart != nil {
+		refunds = append(refunds, walletPart)
+	}
+
+	log.Info("refund requested successfully", zap.Int("refund_count", len(refunds)))
+	return refunds, nil
+}
+
+func (s *service) GetOrderRefunds(ctx context.Context, orderID uint) ([]*Refund, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "GetOrderRefunds"),
+		zap.Uint("order_id", orderID),
+	)
+
+	userID, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		log.Warn("user not authenticated")
+		return nil, ErrUnauthenticated
+	}
+
+	if !utils.IsAdmin(ctx) {
+		o, err := s.repo.GetRefundableOrder(ctx, orderID)
+		if err != nil {
+			log.Error("failed to load order", zap.Error(err))
+			return nil, err
+		}
+		if o.UserID == nil || *o.UserID != int32(userID) {
+			log.Warn("forbidden: order belongs to another user")
+			return nil, ErrForbidden
+		}
+	}
+
+	return s.repo.ListByOrderID(ctx, orderID)
+}
+
+// ProcessPendingGatewayRefunds submits PENDING gateway refunds to the
+// payment provider. Accepted refunds move to PROCESSING; rejected ones are
+// marked FAILED with the provider error. Returns how many were accepted.
+func (s *service) ProcessPendingGatewayRefunds(ctx context.Context, limit int32) (int, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "ProcessPendingGatewayRefunds"),
+	)
+
+	if limit <= 0 {
+		limit = defaultProcessLimit
+	}
+
+	refunds, err := s.repo.ListPendingGatewayRefunds(ctx, limit)
+	if err != nil {
+		log.Error("failed to list pending refunds", zap.Error(err))
+		return 0, err
+	}
+
+	processed := 0
+	for _, rf := range refunds {
+		rlog := log.With(zap.Int64("refund_id", rf.ID))
+
+		if rf.PaymentReference == nil {
+			rlog.Warn("refund has no gateway payment reference")
+			_ = s.repo.MarkFailed(ctx, rf.ID, "missing payment reference")
+			continue
+		}
+
+		// The refund id is the idempotency key, so a refund the provider
+		// accepted but that stayed PENDING is not paid out twice
+		res, err := s.paymentGate.Refund(
+			ctx,
+			*rf.PaymentReference,
+			fmt.Sprintf("rf-%d", rf.ID),
+			rf.Amount,
+			gatewayRefundReason,
+		)
+		if err != nil {
+			rlog.Error("gateway refund failed", zap.Error(err))
+			_ = s.repo.MarkFailed(ctx, rf.ID, err.Error())
+			continue
+		}
+
+		if err := s.repo.MarkProcessing(ctx, rf.ID, res.ProviderRefundID); err != nil {
+			rlog.Error("failed to mark refund processing", zap.Error(err))
+			continue
+		}
+		processed++
+	}
+
+	log.Info("pending gateway refunds processed",
+		zap.Int("pending", len(refunds)),
+		zap.Int("processed", processed),
+	)
+	return processed, nil
+}
+
+func (s *service) ReconcileGatewayRefunds(ctx context.Context) (int, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "ReconcileGatewayRefunds"),
+	)
+
+	refunds, err := s.repo.ListProcessingGatewayRefunds(ctx, defaultProcessLimit)
+	if err != nil {
+		log.Error("failed to list processing refunds", zap.Error(err))
+		return 0, err
+	}
+
+	settled := 0
+	for _, rf := range refunds {
+		rlog := log.With(zap.Int64("refund_id", rf.ID))
+
+		if rf.ProviderRefundID == nil {
+			rlog.Warn("processing refund has no provider refund id")
+			continue
+		}
+
+		res, err := s.paymentGate.GetRefund(ctx, *rf.ProviderRefundID)
+		if err != nil {
+			rlog.Warn("failed to get refund status", zap.Error(err))
+			continue
+		}
+
+		switch res.Status {
+		case payment.RefundStatusSucceeded:
+			err = s.repo.MarkCompleted(ctx, rf.ID)
+		case payment.RefundStatusFailed:
+			err = s.repo.MarkFailed(ctx, rf.ID, "provider refund failed: "+res.FailureCode)
+		default:
+			continue
+		}
+		if err != nil {
+			rlog.Error("failed to settle refund", zap.String("provider_status", res.Status), zap.Error(err))
+			continue
+		}
+		settled++
+	}
+
+	log.Info("processing gateway refunds reconciled",
+		zap.Int("processing", len(refunds)),
+		zap.Int("settled", settled),
+	)
+	return settled, nil
+}
@@ -0,0 +1,311 @@
+package refund
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"warimas-be/internal/payment"
+	"warimas-be/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// --- Mocks ---
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) GetRefundableOrder(ctx context.Context, orderID uint) (*RefundableOrder, error) {
+	args := m.Called(ctx, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*RefundableOrder), args.Error(1)
+}
+
+func (m *MockRepository) ListByOrderID(ctx context.Context, orderID uint) ([]*Refund, error) {
+	args := m.Called(ctx, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Refund), args.Error(1)
+}
+
+func (m *MockRepository) CreateWalletRefund(ctx context.Context, r *Refund) error {
+	args := m.Called(ctx, r)
+	return args.Error(0)
+}
+
+func (m *MockRepository) CreateGatewayRefund(ctx context.Context, r *Refund, walletPart *Refund) error {
+	args := m.Called(ctx, r, walletPart)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ListPendingGatewayRefunds(ctx context.Context, limit int32) ([]*Refund, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Refund), args.Error(1)
+}
+
+func (m *MockRepository) ListProcessingGatewayRefunds(ctx context.Context, limit int32) ([]*Refund, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Refund), args.Error(1)
+}
+
+func (m *MockRepository) MarkCompleted(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockRepository) MarkProcessing(ctx context.Context, id int64, providerRefundID string) error {
+	args := m.Called(ctx, id, providerRefundID)
+	return args.Error(0)
+}
+
+func (m *MockRepository) IsCustomerReturnReason(ctx context.Context, id int32) (bool, error) {
+	args := m.Called(ctx, id)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRepository) MarkFailed(ctx context.Context, id int64, reason string) error {
+	args := m.Called(ctx, id, reason)
+	return args.Error(0)
+}
+
+type MockGateway struct {
+	mock.Mock
+}
+
+func (m *MockGateway) CreateInvoice(ctx context.Context, externalID string, buyer payment.BuyerInfo, amount int64, items []payment.XenditItem, channelCode payment.ChannelCode) (*payment.PaymentResponse, error) {
+	return nil, nil
+}
+
+func (m *MockGateway) GetPaymentStatus(ctx context.Context, externalID string) (*payment.PaymentStatus, error) {
+	return nil, nil
+}
+
+func (m *MockGateway) CancelPayment(ctx context.Context, externalID string) error {
+	return nil
+}
+
+func (m *MockGateway) Refund(ctx context.Context, paymentRequestID, referenceID string, amount int64, reason string) (*payment.RefundResponse, error) {
+	args := m.Called(ctx, paymentRequestID, referenceID, amount, reason)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*payment.RefundResponse), args.Error(1)
+}
+
+func (m *MockGateway) GetRefund(ctx context.Context, providerRefundID string) (*payment.RefundResponse, error) {
+	args := m.Called(ctx, providerRefundID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*payment.RefundResponse), args.Error(1)
+}
+
+func (m *MockGateway) VerifySignature(r *http.Request) error {
+	return nil
+}
+
+// --- Tests ---
+
+func int32Ptr(v int32) *int32 { return &v }
+
+func TestService_RequestRefund(t *testing.T) {
+	ref := "pr-123"
+	paidOrder := func() *RefundableOrder {
+		return &RefundableOrder{
+			ID:               10,
+			UserID:           int32Ptr(1),
+			Status:           "CANCELLED",
+			Currency:         "IDR",
+			WalletPaid:       20000,
+			GatewayPaid:      80000,
+			PaymentReference: &ref,
+		}
+	}
+
+	t.Run("WalletRefundsEverythingToWallet", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, new(MockGateway))
+		ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "user")
+
+		mockRepo.On("IsCustomerReturnReason", ctx, int32(3)).Return(true, nil)
+		mockRepo.On("GetRefundableOrder", ctx, uint(10)).Return(paidOrder(), nil)
+		mockRepo.On("CreateWalletRefund", ctx, mock.MatchedBy(func(r *Refund) bool {
+			return r.Amount == 100000 && r.OrderID == 10 && *r.ReasonID == 3
+		})).Return(nil)
+
+		refunds, err := svc.RequestRefund(ctx, 10, MethodWallet, 3, nil)
+		assert.NoError(t, err)
+		assert.Len(t, refunds, 1)
+		mockRepo.AssertNotCalled(t, "CreateGatewayRefund", mock.Anything, mock.Anything)
+	})
+
+	t.Run("GatewaySplitsWalletPortion", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, new(MockGateway))
+		ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "user")
+
+		mockRepo.On("IsCustomerReturnReason", ctx, int32(3)).Return(true, nil)
+		mockRepo.On("GetRefundableOrder", ctx, uint(10)).Return(paidOrder(), nil)
+		mockRepo.On("CreateGatewayRefund", ctx, mock.MatchedBy(func(r *Refund) bool {
+			return r.Amount == 80000 && *r.PaymentReference == ref
+		}), mock.MatchedBy(func(r *Refund) bool {
+			return r.Amount == 20000
+		})).Return(nil)
+
+		refunds, err := svc.RequestRefund(ctx, 10, MethodGateway, 3, nil)
+		assert.NoError(t, err)
+		assert.Len(t, refunds, 2)
+		mockRepo.AssertNotCalled(t, "CreateWalletRefund", mock.Anything, mock.Anything)
+	})
+
+	t.Run("NotCancelled", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, new(MockGateway))
+		ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "user")
+
+		o := paidOrder()
+		o.Status = "PAID"
+		mockRepo.On("IsCustomerReturnReason", ctx, int32(3)).Return(true, nil)
+		mockRepo.On("GetRefundableOrder", ctx, uint(10)).Return(o, nil)
+
+		_, err := svc.RequestRefund(ctx, 10, MethodWallet, 3, nil)
+		assert.ErrorIs(t, err, ErrNotRefundable)
+	})
+
+	t.Run("NothingPaid", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, new(MockGateway))
+		ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "user")
+
+		o := paidOrder()
+		o.WalletPaid, o.GatewayPaid = 0, 0
+		mockRepo.On("IsCustomerReturnReason", ctx, int32(3)).Return(true, nil)
+		mockRepo.On("GetRefundableOrder", ctx, uint(10)).Return(o, nil)
+
+		_, err := svc.RequestRefund(ctx, 10, MethodWallet, 3, nil)
+		assert.ErrorIs(t, err, ErrNothingToRefund)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, new(MockGateway))
+		ctx := utils.SetUserContext(context.Background(), 2, "other@example.com", "user")
+
+		mockRepo.On("IsCustomerReturnReason", ctx, int32(3)).Return(true, nil)
+		mockRepo.On("GetRefundableOrder", ctx, uint(10)).Return(paidOrder(), nil)
+
+		_, err := svc.RequestRefund(ctx, 10, MethodWallet, 3, nil)
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+
+	t.Run("MissingReason", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, new(MockGateway))
+		ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "user")
+
+		_, err := svc.RequestRefund(ctx, 10, MethodWallet, 0, nil)
+		assert.ErrorIs(t, err, ErrInvalidReason)
+		mockRepo.AssertNotCalled(t, "GetRefundableOrder", mock.Anything, mock.Anything)
+	})
+
+	t.Run("ReasonNotForCustomers", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, new(MockGateway))
+		ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "user")
+
+		mockRepo.On("IsCustomerReturnReason", ctx, int32(9)).Return(false, nil)
+
+		_, err := svc.RequestRefund(ctx, 10, MethodWallet, 9, nil)
+		assert.ErrorIs(t, err, ErrInvalidReason)
+		mockRepo.AssertNotCalled(t, "GetRefundableOrder", mock.Anything, mock.Anything)
+	})
+
+	t.Run("InvalidMethod", func(t *testing.T) {
+		svc := NewService(new(MockRepository), new(MockGateway))
+		ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "user")
+
+		_, err := svc.RequestRefund(ctx, 10, Method("CASH"), 3, nil)
+		assert.ErrorIs(t, err, ErrInvalidMethod)
+	})
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		svc := NewService(new(MockRepository), new(MockGateway))
+
+		_, err := svc.RequestRefund(context.Background(), 10, MethodWallet, 3, nil)
+		assert.ErrorIs(t, err, ErrUnauthenticated)
+	})
+}
+
+func TestService_ProcessPendingGatewayRefunds(t *testing.T) {
+	ctx := context.Background()
+	ref := "pr-123"
+
+	mockRepo := new(MockRepository)
+	mockGateway := new(MockGateway)
+	svc := NewService(mockRepo, mockGateway)
+
+	mockRepo.On("ListPendingGatewayRefunds", ctx, int32(defaultProcessLimit)).Return([]*Refund{
+		{ID: 1, Amount: 5000, PaymentReference: &ref},
+		{ID: 2, Amount: 7000, PaymentReference: &ref},
+		{ID: 3, Amount: 9000},
+	}, nil)
+
+	mockGateway.On("Refund", ctx, ref, "rf-1", int64(5000), gatewayRefundReason).
+		Return(&payment.RefundResponse{ProviderRefundID: "xr-1"}, nil)
+	mockGateway.On("Refund", ctx, ref, "rf-2", int64(7000), gatewayRefundReason).
+		Return(nil, errors.New("insufficient balance"))
+
+	mockRepo.On("MarkProcessing", ctx, int64(1), "xr-1").Return(nil)
+	mockRepo.On("MarkFailed", ctx, int64(2), "insufficient balance").Return(nil)
+	mockRepo.On("MarkFailed", ctx, int64(3), "missing payment reference").Return(nil)
+
+	processed, err := svc.ProcessPendingGatewayRefunds(ctx, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, processed)
+	mockRepo.AssertExpectations(t)
+	mockGateway.AssertExpectations(t)
+}
+
+func TestService_ReconcileGatewayRefunds(t *testing.T) {
+	ctx := context.Background()
+	xr1, xr2, xr3 := "xr-1", "xr-2", "xr-3"
+
+	mockRepo := new(MockRepository)
+	mockGateway := new(MockGateway)
+	svc := NewService(mockRepo, mockGateway)
+
+	mockRepo.On("ListProcessingGatewayRefunds", ctx, int32(defaultProcessLimit)).Return([]*Refund{
+		{ID: 1, ProviderRefundID: &xr1},
+		{ID: 2, ProviderRefundID: &xr2},
+		{ID: 3, ProviderRefundID: &xr3},
+	}, nil)
+
+	mockGateway.On("GetRefund", ctx, xr1).
+		Return(&payment.RefundResponse{Status: payment.RefundStatusSucceeded}, nil)
+	mockGateway.On("GetRefund", ctx, xr2).
+		Return(&payment.RefundResponse{Status: payment.RefundStatusFailed, FailureCode: "INSUFFICIENT_BALANCE"}, nil)
+	mockGateway.On("GetRefund", ctx, xr3).
+		Return(&payment.RefundResponse{Status: "PENDING"}, nil)
+
+	mockRepo.On("MarkCompleted", ctx, int64(1)).Return(nil)
+	mockRepo.On("MarkFailed", ctx, int64(2), "provider refund failed: INSUFFICIENT_BALANCE").Return(nil)
+
+	settled, err := svc.ReconcileGatewayRefunds(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, settled)
+	mockRepo.AssertExpectations(t)
+	mockGateway.AssertExpectations(t)
+	mockRepo.AssertNotCalled(t, "MarkCompleted", ctx, int64(3))
+}
@@ -0,0 +1,13 @@
+package retention
+
+import (
+	"errors"
+	"warimas-be/internal/apperr"
+)
+
+var (
+	ErrUnauthenticated = apperr.Unauthenticated("unauthenticated")
+	ErrForbidden       = apperr.Forbidden("forbidden")
+	ErrUnknownPolicy   = errors.New("unknown retention policy")
+	ErrDB              = errors.New("database error")
+)
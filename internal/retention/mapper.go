@@ -0,0 +1,12 @@
+package retention
+
+import "warimas-be/internal/graph/model"
+
+func MapResultToGraphQL(r *Result) *model.RetentionPolicyResult {
+	return &model.RetentionPolicyResult{
+		Policy:   model.RetentionPolicy(r.Policy),
+		Cutoff:   r.Cutoff,
+		Affected: int32(r.Affected),
+		DryRun:   r.DryRun,
+	}
+}
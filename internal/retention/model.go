@@ -0,0 +1,67 @@
+package retention
+
+import "time"
+
+type Policy string
+
+const (
+	// PolicyCheckoutSessions deletes checkout sessions that expired without
+	// becoming an order.
+	PolicyCheckoutSessions Policy = "CHECKOUT_SESSIONS"
+	// PolicyGuestCheckoutSessions does the same for guests on a shorter
+	// window, since nobody can come back to a guest's abandoned session
+	// once the guest token is gone.
+	PolicyGuestCheckoutSessions Policy = "GUEST_CHECKOUT_SESSIONS"
+	// PolicyWebhookPayloads empties the stored body of old payment
+	// webhooks. The rows stay so event-id idempotency keeps working.
+	PolicyWebhookPayloads Policy = "WEBHOOK_PAYLOADS"
+	// PolicyStaleCarts deletes cart lines untouched for the window. Carts
+	// always belong to an account, so this is off unless configured.
+	PolicyStaleCarts Policy = "STALE_CARTS"
+	// PolicyOrderAnonymization detaches old closed orders from their
+	// customer and scrubs delivery addresses no live order still uses.
+	// Amounts and items are kept for bookkeeping.
+	PolicyOrderAnonymization Policy = "ORDER_ANONYMIZATION"
+)
+
+// RunInterval is how often the retention job runs.
+const RunInterval = 6 * time.Hour
+
+// purgeBatchSize bounds each purge statement so a large backlog does not
+// hold locks for long.
+const purgeBatchSize = 1000
+
+// Policies holds the retention window of each policy; a zero window
+// disables it.
+type Policies struct {
+	CheckoutSessions      time.Duration
+	GuestCheckoutSessions time.Duration
+	WebhookPayloads       time.Duration
+	StaleCarts            time.Duration
+	OrderAnonymization    time.Duration
+	DryRun                bool
+}
+
+type window struct {
+	policy Policy
+	age    time.Duration
+}
+
+func (p Policies) windows() []window {
+	return []window{
+		{PolicyCheckoutSessions, p.CheckoutSessions},
+		{PolicyGuestCheckoutSessions, p.GuestCheckoutSessions},
+		{PolicyWebhookPayloads, p.WebhookPayloads},
+		{PolicyStaleCarts, p.StaleCarts},
+		{PolicyOrderAnonymization, p.OrderAnonymization},
+	}
+}
+
+// Result reports one policy run. In a dry run Affected is how many rows
+// would have been purged.
+type Result struct {
+	Policy   Policy
+	Cutoff   time.Time
+	Affected int64
+	DryRun   bool
+}
@@ -0,0 +1,223 @@
+package retention
+
+import (
+	"context"
+	"database/sql"
+	"time"
+	"warimas-be/internal/logger"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+type Repository interface {
+	// Count returns how many rows policy would touch at cutoff.
+	Count(ctx context.Context, policy Policy, cutoff time.Time) (int64, error)
+	// Purge applies policy to at most limit rows older than cutoff and
+	// returns how many it touched.
+	Purge(ctx context.Context, policy Policy, cutoff time.Time, limit int32) (int64, error)
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+// Row selection per policy. Count and Purge share these so a dry run
+// reports exactly what a real run would touch.
+const (
+	expiredSessions = `
+		FROM checkout_sessions s
+		WHERE s.expires_at < $1
+		  AND s.status <> 'PAID'
+		  AND NOT EXISTS (
+			SELECT 1 FROM orders o WHERE o.checkout_session_id = s.id
+		  )
+	`
+	expiredGuestSessions = `
+		FROM checkout_sessions s
+		WHERE s.guest_id IS NOT NULL
+		  AND s.expires_at < $1
+		  AND s.status <> 'PAID'
+		  AND NOT EXISTS (
+			SELECT 1 FROM orders o WHERE o.checkout_session_id = s.id
+		  )
+	`
+	oldWebhookPayloads = `
+		FROM payment_webhooks w
+		WHERE w.received_at < $1
+		  AND w.payload <> '{}'::jsonb
+	`
+	staleCarts = `
+		FROM carts c
+		WHERE c.updated_at < $1
+	`
+	ordersToAnonymize = `
+		FROM orders o
+		WHERE o.created_at < $1
+		  AND o.anonymized_at IS NULL
+		  AND o.status IN ('COMPLETED', 'CANCELLED', 'FAILED')
+	`
+)
+
+func selection(policy Policy) (string, error) {
+	switch policy {
+	case PolicyCheckoutSessions:
+		return expiredSessions, nil
+	case PolicyGuestCheckoutSessions:
+		return expiredGuestSessions, nil
+	case PolicyWebhookPayloads:
+		return oldWebhookPayloads, nil
+	case PolicyStaleCarts:
+		return staleCarts, nil
+	case PolicyOrderAnonymization:
+		return ordersToAnonymize, nil
+	}
+	return "", ErrUnknownPolicy
+}
+
+func (r *repository) Count(ctx context.Context, policy Policy, cutoff time.Time) (int64, error) {
+	from, err := selection(policy)
+	if err != nil {
+		return 0, err
+	}
+
+	var n int64
+	if err := r.db.QueryRowContext(ctx, `SELECT COUNT(*) `+from, cutoff).Scan(&n); err != nil {
+		logger.FromCtx(ctx).Error("failed to count retention candidates",
+			zap.String("policy", string(policy)),
+			zap.Error(err),
+		)
+		return 0, ErrDB
+	}
+	return n, nil
+}
+
+func (r *repository) Purge(ctx context.Context, policy Policy, cutoff time.Time, limit int32) (int64, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "Purge"),
+		zap.String("policy", string(policy)),
+	)
+
+	var (
+		res sql.Result
+		err error
+	)
+	switch policy {
+	case PolicyCheckoutSessions:
+		res, err = r.db.ExecContext(ctx, `
+			DELETE FROM checkout_sessions
+			WHERE id IN (SELECT s.id `+expiredSessions+` LIMIT $2)
+		`, cutoff, limit)
+	case PolicyGuestCheckoutSessions:
+		res, err = r.db.ExecContext(ctx, `
+			DELETE FROM checkout_sessions
+			WHERE id IN (SELECT s.id `+expiredGuestSessions+` LIMIT $2)
+		`, cutoff, limit)
+	case PolicyWebhookPayloads:
+		res, err = r.db.ExecContext(ctx, `
+			UPDATE payment_webhooks
+			SET payload = '{}'::jsonb
+			WHERE id IN (SELECT w.id `+oldWebhookPayloads+` LIMIT $2)
+		`, cutoff, limit)
+	case PolicyStaleCarts:
+		res, err = r.db.ExecContext(ctx, `
+			DELETE FROM carts
+			WHERE id IN (SELECT c.id `+staleCarts+` LIMIT $2)
+		`, cutoff, limit)
+	case PolicyOrderAnonymization:
+		return r.anonymizeOrders(ctx, cutoff, limit)
+	default:
+		return 0, ErrUnknownPolicy
+	}
+	if err != nil {
+		log.Error("failed to purge", zap.Error(err))
+		return 0, ErrDB
+	}
+
+	n, _ := res.RowsAffected()
+	return n, nil
+}
+
+// anonymizeOrders detaches a batch of old orders from their customer, then
+// scrubs the delivery addresses of that batch the customer has already
+// deleted and no other live order still points at.
+func (r *repository) anonymizeOrders(ctx context.Context, cutoff time.Time, limit int32) (n int64, err error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "anonymizeOrders"),
+	)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error("failed to begin transaction", zap.Error(err))
+		return 0, ErrDB
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	rows, err := tx.QueryContext(ctx, `
+		UPDATE orders
+		SET user_id = NULL, anonymized_at = NOW()
+		WHERE id IN (
+			SELECT o.id `+ordersToAnonymize+`
+			LIMIT $2
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING address_id
+	`, cutoff, limit)
+	if err != nil {
+		log.Error("failed to anonymize orders", zap.Error(err))
+		return 0, ErrDB
+	}
+
+	var addressIDs []string
+	for rows.Next() {
+		var id sql.NullString
+		if err = rows.Scan(&id); err != nil {
+			rows.Close()
+			log.Error("failed to scan anonymized order", zap.Error(err))
+			return 0, ErrDB
+		}
+		n++
+		if id.Valid {
+			addressIDs = append(addressIDs, id.String)
+		}
+	}
+	rows.Close()
+	if err = rows.Err(); err != nil {
+		log.Error("anonymized order iteration failed", zap.Error(err))
+		return 0, ErrDB
+	}
+
+	if len(addressIDs) > 0 {
+		_, err = tx.ExecContext(ctx, `
+			UPDATE addresses a
+			SET receiver_name = '', phone = '', address_line1 = '', address_line2 = NULL, location = NULL
+			WHERE a.id::text = ANY($1)
+			  AND a.is_active = false
+			  AND NOT EXISTS (
+				SELECT 1 FROM orders o
+				WHERE o.address_id = a.id AND o.anonymized_at IS NULL
+			  )
+		`, pq.Array(addressIDs))
+		if err != nil {
+			log.Error("failed to scrub addresses", zap.Error(err))
+			return 0, ErrDB
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Error("failed to commit anonymization", zap.Error(err))
+		return 0, ErrDB
+	}
+
+	return n, nil
+}
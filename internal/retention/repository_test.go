@@ -0,0 +1,71 @@
+package retention
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Count(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+	cutoff := time.Now()
+
+	mock.ExpectQuery(`SELECT COUNT\(\*\) FROM checkout_sessions s WHERE s.expires_at < \$1 AND s.status <> 'PAID'`).
+		WithArgs(cutoff).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(7))
+
+	n, err := repo.Count(ctx, PolicyCheckoutSessions, cutoff)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(7), n)
+
+	_, err = repo.Count(ctx, Policy("NOPE"), cutoff)
+	assert.ErrorIs(t, err, ErrUnknownPolicy)
+}
+
+func TestRepository_Purge(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+	cutoff := time.Now()
+
+	t.Run("WebhookPayloads", func(t *testing.T) {
+		mock.ExpectExec(`UPDATE payment_webhooks SET payload = '\{\}'::jsonb WHERE id IN`).
+			WithArgs(cutoff, int32(100)).
+			WillReturnResult(sqlmock.NewResult(0, 5))
+
+		n, err := repo.Purge(ctx, PolicyWebhookPayloads, cutoff, 100)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), n)
+	})
+
+	t.Run("OrderAnonymization", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`UPDATE orders SET user_id = NULL, anonymized_at = NOW\(\) .* RETURNING address_id`).
+			WithArgs(cutoff, int32(100)).
+			WillReturnRows(sqlmock.NewRows([]string{"address_id"}).
+				AddRow("addr-1").
+				AddRow(nil))
+		mock.ExpectExec(`UPDATE addresses a SET receiver_name = ''.* a.is_active = false`).
+			WithArgs(pq.Array([]string{"addr-1"})).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		n, err := repo.Purge(ctx, PolicyOrderAnonymization, cutoff, 100)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), n)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
@@ -0,0 +1,94 @@
+package retention
+
+import (
+	"context"
+	"time"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+type Service interface {
+	// Run applies every enabled policy, or only reports what it would do
+	// when the policies are configured as a dry run. It runs on a schedule
+	// (RunInterval) and carries no caller check.
+	Run(ctx context.Context) ([]*Result, error)
+	// Preview is an on-demand dry run for admins.
+	Preview(ctx context.Context) ([]*Result, error)
+}
+
+type service struct {
+	repo     Repository
+	policies Policies
+	now      func() time.Time
+}
+
+func NewService(repo Repository, policies Policies) Service {
+	return &service{repo: repo, policies: policies, now: time.Now}
+}
+
+func (s *service) Run(ctx context.Context) ([]*Result, error) {
+	return s.apply(ctx, s.policies.DryRun)
+}
+
+func (s *service) Preview(ctx context.Context) ([]*Result, error) {
+	if _, ok := utils.GetUserIDFromContext(ctx); !ok {
+		return nil, ErrUnauthenticated
+	}
+	if !utils.IsAdmin(ctx) {
+		return nil, ErrForbidden
+	}
+	return s.apply(ctx, true)
+}
+
+func (s *service) apply(ctx context.Context, dryRun bool) ([]*Result, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "apply"),
+		zap.Bool("dry_run", dryRun),
+	)
+
+	now := s.now()
+	results := []*Result{}
+
+	for _, w := range s.policies.windows() {
+		if w.age <= 0 {
+			continue
+		}
+
+		res := &Result{Policy: w.policy, Cutoff: now.Add(-w.age), DryRun: dryRun}
+		plog := log.With(
+			zap.String("policy", string(w.policy)),
+			zap.Time("cutoff", res.Cutoff),
+		)
+
+		if dryRun {
+			n, err := s.repo.Count(ctx, w.policy, res.Cutoff)
+			if err != nil {
+				plog.Error("failed to count retention candidates", zap.Error(err))
+				return nil, err
+			}
+			res.Affected = n
+		} else {
+			for {
+				n, err := s.repo.Purge(ctx, w.policy, res.Cutoff, purgeBatchSize)
+				if err != nil {
+					plog.Error("failed to apply retention policy", zap.Error(err))
+					return nil, err
+				}
+				res.Affected += n
+				if n < purgeBatchSize {
+					break
+				}
+			}
+		}
+
+		if res.Affected > 0 {
+			plog.Info("retention policy applied", zap.Int64("affected", res.Affected))
+		}
+		results = append(results, res)
+	}
+
+	return results, nil
+}
@@ -0,0 +1,115 @@
+package retention
+
+import (
+	"context"
+	"testing"
+	"time"
+	"warimas-be/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// --- Mocks ---
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) Count(ctx context.Context, policy Policy, cutoff time.Time) (int64, error) {
+	args := m.Called(ctx, policy, cutoff)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) Purge(ctx context.Context, policy Policy, cutoff time.Time, limit int32) (int64, error) {
+	args := m.Called(ctx, policy, cutoff, limit)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// --- Tests ---
+
+func newTestService(repo Repository, p Policies, now time.Time) *service {
+	return &service{repo: repo, policies: p, now: func() time.Time { return now }}
+}
+
+func TestService_Run(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	t.Run("PurgesInBatchesAndSkipsDisabled", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := newTestService(mockRepo, Policies{
+			CheckoutSessions: 30 * day,
+			WebhookPayloads:  90 * day,
+		}, now)
+
+		sessionCutoff := now.Add(-30 * day)
+		mockRepo.On("Purge", ctx, PolicyCheckoutSessions, sessionCutoff, int32(purgeBatchSize)).
+			Return(int64(purgeBatchSize), nil).Once()
+		mockRepo.On("Purge", ctx, PolicyCheckoutSessions, sessionCutoff, int32(purgeBatchSize)).
+			Return(int64(12), nil).Once()
+		mockRepo.On("Purge", ctx, PolicyWebhookPayloads, now.Add(-90*day), int32(purgeBatchSize)).
+			Return(int64(0), nil).Once()
+
+		results, err := svc.Run(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, results, 2)
+		assert.Equal(t, int64(purgeBatchSize+12), results[0].Affected)
+		assert.False(t, results[0].DryRun)
+		mockRepo.AssertNotCalled(t, "Purge", ctx, PolicyStaleCarts, mock.Anything, mock.Anything)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("DryRunOnlyCounts", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := newTestService(mockRepo, Policies{
+			OrderAnonymization: 3650 * day,
+			DryRun:             true,
+		}, now)
+
+		mockRepo.On("Count", ctx, PolicyOrderAnonymization, now.Add(-3650*day)).Return(int64(42), nil)
+
+		results, err := svc.Run(ctx)
+		assert.NoError(t, err)
+		assert.Len(t, results, 1)
+		assert.Equal(t, int64(42), results[0].Affected)
+		assert.True(t, results[0].DryRun)
+		mockRepo.AssertNotCalled(t, "Purge", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Error", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := newTestService(mockRepo, Policies{StaleCarts: day}, now)
+
+		mockRepo.On("Purge", ctx, PolicyStaleCarts, mock.Anything, mock.Anything).Return(int64(0), ErrDB)
+
+		_, err := svc.Run(ctx)
+		assert.ErrorIs(t, err, ErrDB)
+	})
+}
+
+func TestService_Preview(t *testing.T) {
+	now := time.Now()
+
+	t.Run("AdminGetsDryRun", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := newTestService(mockRepo, Policies{CheckoutSessions: time.Hour}, now)
+		ctx := utils.SetUserContext(context.Background(), 1, "admin@example.com", "ADMIN")
+
+		mockRepo.On("Count", ctx, PolicyCheckoutSessions, now.Add(-time.Hour)).Return(int64(3), nil)
+
+		results, err := svc.Preview(ctx)
+		assert.NoError(t, err)
+		assert.True(t, results[0].DryRun)
+		assert.Equal(t, int64(3), results[0].Affected)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		svc := newTestService(new(MockRepository), Policies{}, now)
+		ctx := utils.SetUserContext(context.Background(), 2, "user@example.com", "USER")
+
+		_, err := svc.Preview(ctx)
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+}
@@ -0,0 +1,39 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+	"warimas-be/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// Job is a unit of periodic background work.
+type Job func(ctx context.Context) error
+
+// Every runs job once per interval until ctx is cancelled. A failing run is
+// logged and retried on the next tick; runs never overlap.
+func Every(ctx context.Context, name string, interval time.Duration, job Job) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "scheduler"),
+		zap.String("job", name),
+	)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("scheduled job stopped")
+			return
+		case <-ticker.C:
+			start := time.Now()
+			if err := job(ctx); err != nil {
+				log.Error("scheduled job failed", zap.Error(err))
+				continue
+			}
+			log.Debug("scheduled job finished", zap.Duration("duration", time.Since(start)))
+		}
+	}
+}
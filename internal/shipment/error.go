@@ -0,0 +1,20 @@
+package shipment
+
+import (
+	"errors"
+	"warimas-be/internal/apperr"
+)
+
+var (
+	ErrUnauthenticated  = apperr.Unauthenticated("unauthenticated")
+	ErrForbidden        = apperr.Forbidden("forbidden")
+	ErrInvalidShipment  = errors.New("courier and AWB are required")
+	ErrNotShippable     = errors.New("order not found or not ready to ship")
+	ErrShipmentExists   = errors.New("order already has a shipment or AWB is in use")
+	ErrShipmentNotFound = apperr.NotFound("shipment not found")
+	ErrUnknownStatus    = errors.New("unknown shipment status")
+	ErrWebhookNotFound  = apperr.NotFound("courier webhook not found or not dead-lettered")
+	ErrInvalidDate      = errors.New("date must be YYYY-MM-DD")
+	ErrDB               = errors.New("database error")
+	PgUniqueViolation   = "23505"
+)
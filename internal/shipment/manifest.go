@@ -0,0 +1,76 @@
+package shipment
+
+import (
+	"bytes"
+	"html/template"
+)
+
+// manifestTemplate prints one page per courier so each driver signs and
+// keeps their own sheet.
+var manifestTemplate = template.Must(template.New("manifest").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Courier manifest {{.Date.Format "2006-01-02"}}</title>
+<style>
+body { font-family: sans-serif; font-size: 12px; margin: 24px; }
+h1 { font-size: 18px; margin: 0 0 4px; }
+section { page-break-after: always; }
+section:last-child { page-break-after: auto; }
+table { border-collapse: collapse; width: 100%; margin-top: 12px; }
+th, td { border: 1px solid #999; padding: 4px 8px; text-align: left; }
+td.num { text-align: right; }
+.signatures { display: flex; gap: 48px; margin-top: 32px; }
+.signatures div { flex: 1; }
+.line { border-bottom: 1px solid #000; height: 48px; }
+@media print { body { margin: 0; } }
+</style>
+</head>
+<body>
+{{$m := .}}
+{{range .Groups}}
+<section>
+<h1>Courier manifest: {{with .Courier}}{{.}}{{else}}No shipment recorded{{end}}</h1>
+<p>
+Date: {{$m.Date.Format "2006-01-02"}}<br>
+Generated: {{$m.GeneratedAt.Format "2006-01-02 15:04"}}<br>
+Parcels: {{len .Entries}}
+</p>
+<table>
+<thead>
+<tr><th>AWB</th><th>Order</th><th>City</th><th>Items</th><th>Insured value</th><th>Shipped</th></tr>
+</thead>
+<tbody>
+{{range $e := .Entries}}
+<tr>
+<td>{{with $e.AWB}}{{.}}{{else}}-{{end}}</td>
+<td>{{$e.OrderExternalID}}</td>
+<td>{{$e.City}}</td>
+<td class="num">{{$e.ItemCount}}</td>
+<td class="num">{{with $e.InsuredValue}}{{.}}{{else}}-{{end}}</td>
+<td>{{$e.ShippedAt.Format "15:04"}}</td>
+</tr>
+{{end}}
+</tbody>
+</table>
+<div class="signatures">
+<div>Handed over by<div class="line"></div>Name / time</div>
+<div>Received by courier<div class="line"></div>Name / time</div>
+</div>
+</section>
+{{else}}
+<h1>Courier manifest</h1>
+<p>No orders were shipped on {{.Date.Format "2006-01-02"}}.</p>
+{{end}}
+</body>
+</html>
+`))
+
+// RenderManifest renders m as printable HTML.
+func RenderManifest(m *Manifest) (string, error) {
+	var buf bytes.Buffer
+	if err := manifestTemplate.Execute(&buf, m); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
@@ -0,0 +1,44 @@
+package shipment
+
+import (
+	"strconv"
+	"warimas-be/internal/graph/model"
+)
+
+func MapShipmentToGraphQL(s *Shipment) *model.Shipment {
+	events := make([]*model.ShipmentTrackingEvent, 0, len(s.Events))
+	for _, e := range s.Events {
+		events = append(events, &model.ShipmentTrackingEvent{
+			Status:      model.ShipmentStatus(e.Status),
+			Description: e.Description,
+			Location:    e.Location,
+			OccurredAt:  e.OccurredAt,
+		})
+	}
+
+	return &model.Shipment{
+		ID:          strconv.FormatInt(s.ID, 10),
+		OrderID:     strconv.Itoa(int(s.OrderID)),
+		Courier:     s.Courier,
+		Awb:         s.AWB,
+		Status:      model.ShipmentStatus(s.Status),
+		ShippedAt:   s.ShippedAt,
+		LastEventAt: s.LastEventAt,
+		DeliveredAt: s.DeliveredAt,
+		Events:      events,
+	}
+}
+
+func MapWebhookToGraphQL(w *Webhook) *model.CourierWebhook {
+	return &model.CourierWebhook{
+		ID:           strconv.FormatInt(w.ID, 10),
+		Courier:      w.Courier,
+		EventID:      w.EventID,
+		Awb:          w.AWB,
+		Status:       w.Status,
+		Attempts:     w.Attempts,
+		ProcessError: w.ProcessError,
+		ReceivedAt:   w.ReceivedAt,
+		DeadAt:       w.DeadAt,
+	}
+}
@@ -0,0 +1,118 @@
+package shipment
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Retry schedule for courier callbacks that failed to apply. The n-th
+// retry waits 2^n minutes; after MaxWebhookAttempts the callback is
+// dead-lettered until an admin requeues it.
+const (
+	RetryInterval      = 5 * time.Minute
+	MaxWebhookAttempts = 6
+	retryBatchSize     = 50
+	defaultLimit       = 50
+	maxLimit           = 200
+)
+
+type Status string
+
+const (
+	StatusCreated        Status = "CREATED"
+	StatusPickedUp       Status = "PICKED_UP"
+	StatusInTransit      Status = "IN_TRANSIT"
+	StatusOutForDelivery Status = "OUT_FOR_DELIVERY"
+	StatusDelivered      Status = "DELIVERED"
+	StatusFailed         Status = "FAILED"
+	StatusReturned       Status = "RETURNED"
+)
+
+func (s Status) Valid() bool {
+	switch s {
+	case StatusCreated, StatusPickedUp, StatusInTransit, StatusOutForDelivery,
+		StatusDelivered, StatusFailed, StatusReturned:
+		return true
+	}
+	return false
+}
+
+// Terminal statuses are final; later callbacks are kept as history only.
+func (s Status) Terminal() bool {
+	return s == StatusDelivered || s == StatusReturned
+}
+
+type Shipment struct {
+	ID          int64
+	OrderID     int32
+	OrderStatus string
+	Courier     string
+	AWB         string
+	Status      Status
+	CreatedBy   *int32
+	ShippedAt   time.Time
+	LastEventAt *time.Time
+	DeliveredAt *time.Time
+	Events      []*TrackingEvent
+}
+
+type TrackingEvent struct {
+	Status      Status
+	Description *string
+	Location    *string
+	OccurredAt  time.Time
+}
+
+// CourierEvent is the tracking callback body couriers post to
+// /webhook/courier.
+type CourierEvent struct {
+	EventID     string    `json:"event_id"`
+	Courier     string    `json:"courier"`
+	AWB         string    `json:"awb"`
+	Status      Status    `json:"status"`
+	Description *string   `json:"description,omitempty"`
+	Location    *string   `json:"location,omitempty"`
+	OccurredAt  time.Time `json:"occurred_at"`
+}
+
+// Webhook is a stored courier callback.
+type Webhook struct {
+	ID            int64
+	Courier       string
+	EventID       string
+	AWB           *string
+	Status        *string
+	Payload       json.RawMessage
+	ReceivedAt    time.Time
+	Attempts      int32
+	ProcessError  *string
+	NextAttemptAt *time.Time
+	DeadAt        *time.Time
+}
+
+// Manifest lists the orders handed to couriers on one day, grouped by
+// courier.
+type Manifest struct {
+	Date        time.Time
+	GeneratedAt time.Time
+	Groups      []*ManifestGroup
+}
+
+// ManifestGroup is one courier's page. Courier is empty for orders that
+// were marked SHIPPED without a recorded shipment.
+type ManifestGroup struct {
+	Courier string
+	Entries []*ManifestEntry
+}
+
+type ManifestEntry struct {
+	Courier         *string
+	AWB             *string
+	OrderExternalID string
+	City            string
+	ItemCount       int32
+	// InsuredValue is the declared goods value the courier insures; nil
+	// when the customer did not buy insurance.
+	InsuredValue *int64
+	ShippedAt    time.Time
+}
@@ -0,0 +1,424 @@
+package shipment
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+	"warimas-be/internal/logger"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+type Repository interface {
+	// Create records the shipment of an ACCEPTED or SHIPPED order. The
+	// returned shipment carries the order's status at that moment.
+	Create(ctx context.Context, orderID uint, courier, awb string, createdBy uint) (*Shipment, error)
+	Delete(ctx context.Context, id int64) error
+	GetByOrder(ctx context.Context, orderID uint) (*Shipment, error)
+	// ApplyEvent stores a tracking event and moves the shipment to its
+	// status unless the shipment is final or already saw a newer event.
+	// changed reports whether the status moved.
+	ApplyEvent(ctx context.Context, ev *CourierEvent) (s *Shipment, changed bool, err error)
+
+	SaveWebhook(ctx context.Context, ev *CourierEvent, payload json.RawMessage, signatureValid bool) (id int64, isDuplicate bool, err error)
+	MarkWebhookProcessed(ctx context.Context, id int64) error
+	// MarkWebhookFailed counts a failed attempt and schedules the next
+	// one, or dead-letters the webhook once maxAttempts is reached.
+	MarkWebhookFailed(ctx context.Context, id int64, reason string, maxAttempts int) (dead bool, err error)
+	ListDueWebhooks(ctx context.Context, now time.Time, limit int32) ([]*Webhook, error)
+	ListDeadWebhooks(ctx context.Context, limit int32) ([]*Webhook, error)
+	RequeueWebhook(ctx context.Context, id int64) error
+
+	// ListManifestEntries returns the orders that entered SHIPPED in
+	// [from, to), ordered by courier with unrecorded shipments last.
+	ListManifestEntries(ctx context.Context, from, to time.Time) ([]*ManifestEntry, error)
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+const shipmentCols = `
+	s.id, s.order_id, o.status, s.courier, s.awb, s.status, s.created_by,
+	s.shipped_at, s.last_event_at, s.delivered_at
+`
+
+const webhookCols = `
+	id, courier, event_id, awb, status, payload, received_at,
+	attempts, process_error, next_attempt_at, dead_at
+`
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanShipment(sc scanner) (*Shipment, error) {
+	var s Shipment
+	if err := sc.Scan(
+		&s.ID, &s.OrderID, &s.OrderStatus, &s.Courier, &s.AWB, &s.Status, &s.CreatedBy,
+		&s.ShippedAt, &s.LastEventAt, &s.DeliveredAt,
+	); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func scanWebhook(sc scanner) (*Webhook, error) {
+	var w Webhook
+	if err := sc.Scan(
+		&w.ID, &w.Courier, &w.EventID, &w.AWB, &w.Status, &w.Payload, &w.ReceivedAt,
+		&w.Attempts, &w.ProcessError, &w.NextAttemptAt, &w.DeadAt,
+	); err != nil {
+		return nil, err
+	}
+	return &w, nil
+}
+
+func pqCode(err error) string {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return string(pqErr.Code)
+	}
+	return ""
+}
+
+func (r *repository) Create(ctx context.Context, orderID uint, courier, awb string, createdBy uint) (*Shipment, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "Create"),
+		zap.Uint("order_id", orderID),
+	)
+
+	s, err := scanShipment(r.db.QueryRowContext(ctx, `
+		WITH o AS (
+			SELECT id, status FROM orders
+			WHERE id = $1 AND status IN ('ACCEPTED', 'SHIPPED')
+		), s AS (
+			INSERT INTO shipments (order_id, courier, awb, created_by)
+			SELECT id, $2, $3, $4 FROM o
+			RETURNING *
+		)
+		SELECT `+shipmentCols+`
+		FROM s JOIN o ON o.id = s.order_id
+	`, orderID, courier, awb, createdBy))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrNotShippable
+	}
+	if err != nil {
+		if pqCode(err) == PgUniqueViolation {
+			return nil, ErrShipmentExists
+		}
+		log.Error("failed to create shipment", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	s.Events = []*TrackingEvent{}
+	return s, nil
+}
+
+func (r *repository) Delete(ctx context.Context, id int64) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM shipments WHERE id = $1`, id); err != nil {
+		logger.FromCtx(ctx).Error("failed to delete shipment", zap.Int64("shipment_id", id), zap.Error(err))
+		return ErrDB
+	}
+	return nil
+}
+
+func (r *repository) GetByOrder(ctx context.Context, orderID uint) (*Shipment, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "GetByOrder"),
+		zap.Uint("order_id", orderID),
+	)
+
+	s, err := scanShipment(r.db.QueryRowContext(ctx, `
+		SELECT `+shipmentCols+`
+		FROM shipments s
+		JOIN orders o ON o.id = s.order_id
+		WHERE s.order_id = $1
+	`, orderID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrShipmentNotFound
+	}
+	if err != nil {
+		log.Error("failed to get shipment", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT status, description, location, occurred_at
+		FROM shipment_tracking_events
+		WHERE shipment_id = $1
+		ORDER BY occurred_at, id
+	`, s.ID)
+	if err != nil {
+		log.Error("failed to query tracking events", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	s.Events = []*TrackingEvent{}
+	for rows.Next() {
+		var e TrackingEvent
+		if err := rows.Scan(&e.Status, &e.Description, &e.Location, &e.OccurredAt); err != nil {
+			log.Error("failed to scan tracking event", zap.Error(err))
+			return nil, ErrDB
+		}
+		s.Events = append(s.Events, &e)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error("tracking event iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return s, nil
+}
+
+func (r *repository) ApplyEvent(ctx context.Context, ev *CourierEvent) (s *Shipment, changed bool, err error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ApplyEvent"),
+		zap.String("courier", ev.Courier),
+		zap.String("awb", ev.AWB),
+	)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error("failed to begin transaction", zap.Error(err))
+		return nil, false, ErrDB
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	s, err = scanShipment(tx.QueryRowContext(ctx, `
+		SELECT `+shipmentCols+`
+		FROM shipments s
+		JOIN orders o ON o.id = s.order_id
+		WHERE s.courier = $1 AND s.awb = $2
+		FOR UPDATE OF s
+	`, ev.Courier, ev.AWB))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, false, ErrShipmentNotFound
+	}
+	if err != nil {
+		log.Error("failed to lock shipment", zap.Error(err))
+		return nil, false, ErrDB
+	}
+
+	if _, err = tx.ExecContext(ctx, `
+		INSERT INTO shipment_tracking_events (shipment_id, status, description, location, occurred_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`, s.ID, ev.Status, ev.Description, ev.Location, ev.OccurredAt); err != nil {
+		log.Error("failed to insert tracking event", zap.Error(err))
+		return nil, false, ErrDB
+	}
+
+	stale := s.LastEventAt != nil && ev.OccurredAt.Before(*s.LastEventAt)
+	if !s.Status.Terminal() && !stale && s.Status != ev.Status {
+		if _, err = tx.ExecContext(ctx, `
+			UPDATE shipments
+			SET status = $2,
+			    last_event_at = $3,
+			    delivered_at = CASE WHEN $2 = 'DELIVERED' THEN $3 ELSE delivered_at END
+			WHERE id = $1
+		`, s.ID, ev.Status, ev.OccurredAt); err != nil {
+			log.Error("failed to update shipment status", zap.Error(err))
+			return nil, false, ErrDB
+		}
+		s.Status = ev.Status
+		s.LastEventAt = &ev.OccurredAt
+		if ev.Status == StatusDelivered {
+			s.DeliveredAt = &ev.OccurredAt
+		}
+		changed = true
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Error("failed to commit tracking event", zap.Error(err))
+		return nil, false, ErrDB
+	}
+
+	return s, changed, nil
+}
+
+func (r *repository) SaveWebhook(ctx context.Context, ev *CourierEvent, payload json.RawMessage, signatureValid bool) (int64, bool, error) {
+	var id int64
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO courier_webhooks (courier, event_id, awb, status, signature_valid, payload)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (courier, event_id) DO NOTHING
+		RETURNING id
+	`, ev.Courier, ev.EventID, ev.AWB, ev.Status, signatureValid, payload).Scan(&id)
+	if err != nil {
+		// Duplicate callback → idempotent success
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, true, nil
+		}
+		logger.FromCtx(ctx).Error("failed to save courier webhook", zap.Error(err))
+		return 0, false, ErrDB
+	}
+	return id, false, nil
+}
+
+func (r *repository) MarkWebhookProcessed(ctx context.Context, id int64) error {
+	if _, err := r.db.ExecContext(ctx, `
+		UPDATE courier_webhooks
+		SET processed_at = NOW(), process_error = NULL, next_attempt_at = NULL
+		WHERE id = $1
+	`, id); err != nil {
+		logger.FromCtx(ctx).Error("failed to mark courier webhook processed", zap.Int64("webhook_id", id), zap.Error(err))
+		return ErrDB
+	}
+	return nil
+}
+
+func (r *repository) MarkWebhookFailed(ctx context.Context, id int64, reason string, maxAttempts int) (bool, error) {
+	var dead bool
+	err := r.db.QueryRowContext(ctx, `
+		UPDATE courier_webhooks
+		SET attempts = attempts + 1,
+		    process_error = $2,
+		    next_attempt_at = CASE
+		        WHEN attempts + 1 >= $3 THEN NULL
+		        ELSE NOW() + make_interval(mins => (2 ^ attempts)::int)
+		    END,
+		    dead_at = CASE WHEN attempts + 1 >= $3 THEN NOW() END
+		WHERE id = $1
+		RETURNING dead_at IS NOT NULL
+	`, id, reason, maxAttempts).Scan(&dead)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to mark courier webhook failed", zap.Int64("webhook_id", id), zap.Error(err))
+		return false, ErrDB
+	}
+	return dead, nil
+}
+
+func (r *repository) ListDueWebhooks(ctx context.Context, now time.Time, limit int32) ([]*Webhook, error) {
+	return r.listWebhooks(ctx, "ListDueWebhooks", `
+		SELECT `+webhookCols+`
+		FROM courier_webhooks
+		WHERE processed_at IS NULL
+		  AND dead_at IS NULL
+		  AND next_attempt_at <= $1
+		ORDER BY next_attempt_at, id
+		LIMIT $2
+	`, now, limit)
+}
+
+func (r *repository) ListDeadWebhooks(ctx context.Context, limit int32) ([]*Webhook, error) {
+	return r.listWebhooks(ctx, "ListDeadWebhooks", `
+		SELECT `+webhookCols+`
+		FROM courier_webhooks
+		WHERE dead_at IS NOT NULL
+		ORDER BY dead_at DESC, id DESC
+		LIMIT $1
+	`, limit)
+}
+
+func (r *repository) listWebhooks(ctx context.Context, method, query string, args ...any) ([]*Webhook, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", method),
+	)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		log.Error("failed to query courier webhooks", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	list := []*Webhook{}
+	for rows.Next() {
+		w, err := scanWebhook(rows)
+		if err != nil {
+			log.Error("failed to scan courier webhook", zap.Error(err))
+			return nil, ErrDB
+		}
+		list = append(list, w)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error("courier webhook iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return list, nil
+}
+
+func (r *repository) RequeueWebhook(ctx context.Context, id int64) error {
+	res, err := r.db.ExecContext(ctx, `
+		UPDATE courier_webhooks
+		SET dead_at = NULL, attempts = 0, next_attempt_at = NOW()
+		WHERE id = $1 AND dead_at IS NOT NULL
+	`, id)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to requeue courier webhook", zap.Int64("webhook_id", id), zap.Error(err))
+		return ErrDB
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrWebhookNotFound
+	}
+	return nil
+}
+
+func (r *repository) ListManifestEntries(ctx context.Context, from, to time.Time) ([]*ManifestEntry, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ListManifestEntries"),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT s.courier, s.awb, o.external_id, COALESCE(a.city, ''),
+		       (SELECT COALESCE(SUM(oi.quantity), 0) FROM order_items oi WHERE oi.order_id = o.id),
+		       CASE WHEN o.insurance_fee > 0 THEN o.subtotal END,
+		       h.shipped_at
+		FROM (
+			SELECT order_id, MAX(changed_at) AS shipped_at
+			FROM order_status_history
+			WHERE to_status = 'SHIPPED'
+			  AND changed_at >= $1
+			  AND changed_at < $2
+			GROUP BY order_id
+		) h
+		JOIN orders o ON o.id = h.order_id
+		LEFT JOIN shipments s ON s.order_id = o.id
+		LEFT JOIN addresses a ON a.id = o.address_id
+		ORDER BY s.courier NULLS LAST, h.shipped_at, o.id
+	`, from, to)
+	if err != nil {
+		log.Error("failed to query manifest", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	list := []*ManifestEntry{}
+	for rows.Next() {
+		var e ManifestEntry
+		if err := rows.Scan(
+			&e.Courier, &e.AWB, &e.OrderExternalID, &e.City, &e.ItemCount, &e.InsuredValue, &e.ShippedAt,
+		); err != nil {
+			log.Error("failed to scan manifest entry", zap.Error(err))
+			return nil, ErrDB
+		}
+		list = append(list, &e)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error("manifest iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return list, nil
+}
@@ -0,0 +1,172 @@
+package shipment
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var shipmentRowCols = []string{
+	"id", "order_id", "status", "courier", "awb", "status", "created_by",
+	"shipped_at", "last_event_at", "delivered_at",
+}
+
+func TestRepository_Create(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run("Success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectQuery(`INSERT INTO shipments`).
+			WithArgs(uint(3), "JNE", "AWB123", uint(7)).
+			WillReturnRows(sqlmock.NewRows(shipmentRowCols).
+				AddRow(11, 3, "ACCEPTED", "JNE", "AWB123", "CREATED", 7, now, nil, nil))
+
+		s, err := repo.Create(ctx, 3, "JNE", "AWB123", 7)
+
+		assert.NoError(t, err)
+		assert.Equal(t, int64(11), s.ID)
+		assert.Equal(t, "ACCEPTED", s.OrderStatus)
+		assert.Equal(t, StatusCreated, s.Status)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("NotShippable", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectQuery(`INSERT INTO shipments`).
+			WillReturnRows(sqlmock.NewRows(shipmentRowCols))
+
+		_, err = repo.Create(ctx, 3, "JNE", "AWB123", 7)
+		assert.ErrorIs(t, err, ErrNotShippable)
+	})
+
+	t.Run("AlreadyShipped", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectQuery(`INSERT INTO shipments`).
+			WillReturnError(&pq.Error{Code: pq.ErrorCode(PgUniqueViolation)})
+
+		_, err = repo.Create(ctx, 3, "JNE", "AWB123", 7)
+		assert.ErrorIs(t, err, ErrShipmentExists)
+	})
+}
+
+func TestRepository_ApplyEvent(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run("MovesStatus", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		ev := &CourierEvent{Courier: "JNE", AWB: "AWB123", Status: StatusDelivered, OccurredAt: now}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`FOR UPDATE OF s`).
+			WithArgs("JNE", "AWB123").
+			WillReturnRows(sqlmock.NewRows(shipmentRowCols).
+				AddRow(11, 3, "SHIPPED", "JNE", "AWB123", "IN_TRANSIT", 7, now, now.Add(-time.Hour), nil))
+		mock.ExpectExec(`INSERT INTO shipment_tracking_events`).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectExec(`UPDATE shipments`).
+			WithArgs(int64(11), StatusDelivered, now).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		s, changed, err := repo.ApplyEvent(ctx, ev)
+
+		assert.NoError(t, err)
+		assert.True(t, changed)
+		assert.Equal(t, StatusDelivered, s.Status)
+		assert.NotNil(t, s.DeliveredAt)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("StaleEventKeepsStatus", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		ev := &CourierEvent{Courier: "JNE", AWB: "AWB123", Status: StatusPickedUp, OccurredAt: now.Add(-2 * time.Hour)}
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`FOR UPDATE OF s`).
+			WillReturnRows(sqlmock.NewRows(shipmentRowCols).
+				AddRow(11, 3, "SHIPPED", "JNE", "AWB123", "IN_TRANSIT", 7, now, now.Add(-time.Hour), nil))
+		mock.ExpectExec(`INSERT INTO shipment_tracking_events`).
+			WillReturnResult(sqlmock.NewResult(1, 1))
+		mock.ExpectCommit()
+
+		s, changed, err := repo.ApplyEvent(ctx, ev)
+
+		assert.NoError(t, err)
+		assert.False(t, changed)
+		assert.Equal(t, StatusInTransit, s.Status)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("UnknownAWB", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectBegin()
+		mock.ExpectQuery(`FOR UPDATE OF s`).
+			WillReturnRows(sqlmock.NewRows(shipmentRowCols))
+		mock.ExpectRollback()
+
+		_, _, err = repo.ApplyEvent(ctx, &CourierEvent{Courier: "JNE", AWB: "NOPE", Status: StatusInTransit})
+		assert.ErrorIs(t, err, ErrShipmentNotFound)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_MarkWebhookFailed(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := NewRepository(db)
+
+	mock.ExpectQuery(`UPDATE courier_webhooks`).
+		WithArgs(int64(4), "boom", MaxWebhookAttempts).
+		WillReturnRows(sqlmock.NewRows([]string{"dead"}).AddRow(true))
+
+	dead, err := repo.MarkWebhookFailed(context.Background(), 4, "boom", MaxWebhookAttempts)
+
+	assert.NoError(t, err)
+	assert.True(t, dead)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_RequeueWebhook(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := NewRepository(db)
+
+	mock.ExpectExec(`UPDATE courier_webhooks`).
+		WithArgs(int64(4)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	assert.ErrorIs(t, repo.RequeueWebhook(context.Background(), 4), ErrWebhookNotFound)
+}
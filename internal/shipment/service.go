@@ -0,0 +1,268 @@
+package shipment
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/order"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+type Service interface {
+	// ShipOrder records the courier and AWB of a packed order and moves
+	// it to SHIPPED.
+	ShipOrder(ctx context.Context, orderID uint, courier, awb string) (*Shipment, error)
+	GetShipment(ctx context.Context, orderID uint) (*Shipment, error)
+	// ApplyEvent applies a courier tracking callback. A delivered
+	// shipment completes its order.
+	ApplyEvent(ctx context.Context, ev *CourierEvent) error
+	// RetryWebhooks re-applies failed callbacks whose backoff elapsed and
+	// returns how many succeeded.
+	RetryWebhooks(ctx context.Context) (int, error)
+	ListDeadLetters(ctx context.Context, limit int32) ([]*Webhook, error)
+	RequeueWebhook(ctx context.Context, id int64) error
+	// Manifest renders the courier handover manifest for date
+	// (YYYY-MM-DD, Jakarta time; today when nil) as printable HTML.
+	Manifest(ctx context.Context, date *string) (string, error)
+}
+
+// OrderUpdater moves an order through its status flow.
+type OrderUpdater interface {
+	UpdateOrderStatus(ctx context.Context, orderID uint, status order.OrderStatus) error
+}
+
+type service struct {
+	repo   Repository
+	orders OrderUpdater
+	now    func() time.Time
+	loc    *time.Location
+}
+
+func NewService(repo Repository, orders OrderUpdater) Service {
+	loc, err := time.LoadLocation("Asia/Jakarta")
+	if err != nil {
+		logger.L().Error("failed to load Jakarta location, defaulting to UTC", zap.Error(err))
+		loc = time.UTC
+	}
+	return &service{repo: repo, orders: orders, now: time.Now, loc: loc}
+}
+
+func (s *service) ShipOrder(ctx context.Context, orderID uint, courier, awb string) (*Shipment, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "ShipOrder"),
+		zap.Uint("order_id", orderID),
+	)
+
+	adminID, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	courier = strings.ToUpper(strings.TrimSpace(courier))
+	awb = strings.TrimSpace(awb)
+	if courier == "" || awb == "" {
+		return nil, ErrInvalidShipment
+	}
+
+	sh, err := s.repo.Create(ctx, orderID, courier, awb, adminID)
+	if err != nil {
+		log.Warn("failed to create shipment", zap.Error(err))
+		return nil, err
+	}
+
+	if sh.OrderStatus != string(order.OrderStatusShipped) {
+		if err := s.orders.UpdateOrderStatus(ctx, orderID, order.OrderStatusShipped); err != nil {
+			log.Warn("order cannot ship, dropping shipment", zap.Error(err))
+			if delErr := s.repo.Delete(ctx, sh.ID); delErr != nil {
+				log.Error("failed to drop shipment", zap.Error(delErr))
+			}
+			return nil, err
+		}
+		sh.OrderStatus = string(order.OrderStatusShipped)
+	}
+
+	log.Info("order shipped", zap.String("courier", courier), zap.String("awb", awb))
+	return sh, nil
+}
+
+func (s *service) GetShipment(ctx context.Context, orderID uint) (*Shipment, error) {
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	return s.repo.GetByOrder(ctx, orderID)
+}
+
+func (s *service) ApplyEvent(ctx context.Context, ev *CourierEvent) error {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "ApplyEvent"),
+		zap.String("courier", ev.Courier),
+		zap.String("awb", ev.AWB),
+		zap.String("status", string(ev.Status)),
+	)
+
+	if !ev.Status.Valid() {
+		return ErrUnknownStatus
+	}
+
+	sh, changed, err := s.repo.ApplyEvent(ctx, ev)
+	if err != nil {
+		return err
+	}
+	if !changed {
+		log.Info("tracking event recorded without status change")
+	}
+
+	// Checked on every callback, not only on the change, so a failed
+	// completion is picked up again when the callback is retried
+	if sh.Status == StatusDelivered && sh.OrderStatus == string(order.OrderStatusShipped) {
+		if err := s.orders.UpdateOrderStatus(ctx, uint(sh.OrderID), order.OrderStatusCompleted); err != nil {
+			log.Error("failed to complete delivered order", zap.Error(err))
+			return err
+		}
+		log.Info("delivered order completed", zap.Int32("order_id", sh.OrderID))
+	}
+
+	return nil
+}
+
+func (s *service) RetryWebhooks(ctx context.Context) (int, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "RetryWebhooks"),
+	)
+
+	due, err := s.repo.ListDueWebhooks(ctx, s.now(), retryBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	done := 0
+	for _, w := range due {
+		var ev CourierEvent
+		err := json.Unmarshal(w.Payload, &ev)
+		if err == nil {
+			ev.EventID = w.EventID
+			ev.Courier = w.Courier
+			err = s.ApplyEvent(ctx, &ev)
+		}
+		if err != nil {
+			dead, markErr := s.repo.MarkWebhookFailed(ctx, w.ID, err.Error(), MaxWebhookAttempts)
+			if markErr != nil {
+				return done, markErr
+			}
+			if dead {
+				log.Error("courier webhook dead-lettered",
+					zap.Int64("webhook_id", w.ID),
+					zap.String("event_id", w.EventID),
+					zap.Error(err),
+				)
+			}
+			continue
+		}
+
+		if err := s.repo.MarkWebhookProcessed(ctx, w.ID); err != nil {
+			return done, err
+		}
+		done++
+	}
+
+	if len(due) > 0 {
+		log.Info("courier webhook retry finished", zap.Int("due", len(due)), zap.Int("succeeded", done))
+	}
+	return done, nil
+}
+
+func (s *service) ListDeadLetters(ctx context.Context, limit int32) ([]*Webhook, error) {
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+
+	return s.repo.ListDeadWebhooks(ctx, limit)
+}
+
+func (s *service) RequeueWebhook(ctx context.Context, id int64) error {
+	if _, err := requireAdmin(ctx); err != nil {
+		return err
+	}
+	return s.repo.RequeueWebhook(ctx, id)
+}
+
+func (s *service) Manifest(ctx context.Context, date *string) (string, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "Manifest"),
+	)
+
+	if _, err := requireAdmin(ctx); err != nil {
+		return "", err
+	}
+
+	now := s.now().In(s.loc)
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, s.loc)
+	if date != nil && *date != "" {
+		d, err := time.ParseInLocation("2006-01-02", *date, s.loc)
+		if err != nil {
+			return "", ErrInvalidDate
+		}
+		day = d
+	}
+
+	entries, err := s.repo.ListManifestEntries(ctx, day, day.AddDate(0, 0, 1))
+	if err != nil {
+		return "", err
+	}
+	for _, e := range entries {
+		e.ShippedAt = e.ShippedAt.In(s.loc)
+	}
+
+	m := &Manifest{Date: day, GeneratedAt: now, Groups: groupByCourier(entries)}
+
+	html, err := RenderManifest(m)
+	if err != nil {
+		log.Error("failed to render manifest", zap.Error(err))
+		return "", err
+	}
+
+	return html, nil
+}
+
+// groupByCourier relies on entries being ordered by courier.
+func groupByCourier(entries []*ManifestEntry) []*ManifestGroup {
+	groups := []*ManifestGroup{}
+	for _, e := range entries {
+		courier := ""
+		if e.Courier != nil {
+			courier = *e.Courier
+		}
+		if len(groups) == 0 || groups[len(groups)-1].Courier != courier {
+			groups = append(groups, &ManifestGroup{Courier: courier})
+		}
+		g := groups[len(groups)-1]
+		g.Entries = append(g.Entries, e)
+	}
+	return groups
+}
+
+func requireAdmin(ctx context.Context) (uint, error) {
+	userID, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		return 0, ErrUnauthenticated
+	}
+	if !utils.IsAdmin(ctx) {
+		return 0, ErrForbidden
+	}
+	return userID, nil
+}
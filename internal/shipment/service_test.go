@@ -0,0 +1,311 @@
+package shipment
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+	"warimas-be/internal/order"
+	"warimas-be/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// --- Mocks ---
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) Create(ctx context.Context, orderID uint, courier, awb string, createdBy uint) (*Shipment, error) {
+	args := m.Called(ctx, orderID, courier, awb, createdBy)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Shipment), args.Error(1)
+}
+
+func (m *MockRepository) Delete(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetByOrder(ctx context.Context, orderID uint) (*Shipment, error) {
+	args := m.Called(ctx, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Shipment), args.Error(1)
+}
+
+func (m *MockRepository) ApplyEvent(ctx context.Context, ev *CourierEvent) (*Shipment, bool, error) {
+	args := m.Called(ctx, ev)
+	if args.Get(0) == nil {
+		return nil, args.Bool(1), args.Error(2)
+	}
+	return args.Get(0).(*Shipment), args.Bool(1), args.Error(2)
+}
+
+func (m *MockRepository) SaveWebhook(ctx context.Context, ev *CourierEvent, payload json.RawMessage, signatureValid bool) (int64, bool, error) {
+	args := m.Called(ctx, ev, payload, signatureValid)
+	return args.Get(0).(int64), args.Bool(1), args.Error(2)
+}
+
+func (m *MockRepository) MarkWebhookProcessed(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockRepository) MarkWebhookFailed(ctx context.Context, id int64, reason string, maxAttempts int) (bool, error) {
+	args := m.Called(ctx, id, reason, maxAttempts)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRepository) ListDueWebhooks(ctx context.Context, now time.Time, limit int32) ([]*Webhook, error) {
+	args := m.Called(ctx, now, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Webhook), args.Error(1)
+}
+
+func (m *MockRepository) ListDeadWebhooks(ctx context.Context, limit int32) ([]*Webhook, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Webhook), args.Error(1)
+}
+
+func (m *MockRepository) RequeueWebhook(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ListManifestEntries(ctx context.Context, from, to time.Time) ([]*ManifestEntry, error) {
+	args := m.Called(ctx, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*ManifestEntry), args.Error(1)
+}
+
+type MockOrderUpdater struct {
+	mock.Mock
+}
+
+func (m *MockOrderUpdater) UpdateOrderStatus(ctx context.Context, orderID uint, status order.OrderStatus) error {
+	args := m.Called(ctx, orderID, status)
+	return args.Error(0)
+}
+
+// --- Tests ---
+
+func adminCtx() context.Context {
+	return utils.SetUserContext(context.Background(), 7, "admin@test.com", "ADMIN")
+}
+
+func TestService_ShipOrder(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockOrders := new(MockOrderUpdater)
+		svc := NewService(mockRepo, mockOrders)
+
+		mockRepo.On("Create", mock.Anything, uint(3), "JNE", "AWB123", uint(7)).
+			Return(&Shipment{ID: 11, OrderID: 3, OrderStatus: "ACCEPTED", Courier: "JNE", AWB: "AWB123", Status: StatusCreated}, nil)
+		mockOrders.On("UpdateOrderStatus", mock.Anything, uint(3), order.OrderStatusShipped).Return(nil)
+
+		s, err := svc.ShipOrder(adminCtx(), 3, " jne ", " AWB123 ")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "SHIPPED", s.OrderStatus)
+		mockRepo.AssertExpectations(t)
+		mockOrders.AssertExpectations(t)
+	})
+
+	t.Run("AlreadyShipped", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockOrders := new(MockOrderUpdater)
+		svc := NewService(mockRepo, mockOrders)
+
+		mockRepo.On("Create", mock.Anything, uint(3), "JNE", "AWB123", uint(7)).
+			Return(&Shipment{ID: 11, OrderID: 3, OrderStatus: "SHIPPED"}, nil)
+
+		_, err := svc.ShipOrder(adminCtx(), 3, "JNE", "AWB123")
+
+		assert.NoError(t, err)
+		mockOrders.AssertNotCalled(t, "UpdateOrderStatus", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("OrderNotPacked_DropsShipment", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockOrders := new(MockOrderUpdater)
+		svc := NewService(mockRepo, mockOrders)
+
+		mockRepo.On("Create", mock.Anything, uint(3), "JNE", "AWB123", uint(7)).
+			Return(&Shipment{ID: 11, OrderID: 3, OrderStatus: "ACCEPTED"}, nil)
+		mockOrders.On("UpdateOrderStatus", mock.Anything, uint(3), order.OrderStatusShipped).Return(order.ErrOrderNotPacked)
+		mockRepo.On("Delete", mock.Anything, int64(11)).Return(nil)
+
+		_, err := svc.ShipOrder(adminCtx(), 3, "JNE", "AWB123")
+
+		assert.ErrorIs(t, err, order.ErrOrderNotPacked)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("InvalidInput", func(t *testing.T) {
+		svc := NewService(new(MockRepository), new(MockOrderUpdater))
+
+		_, err := svc.ShipOrder(adminCtx(), 3, "JNE", "  ")
+		assert.ErrorIs(t, err, ErrInvalidShipment)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		svc := NewService(new(MockRepository), new(MockOrderUpdater))
+		ctx := utils.SetUserContext(context.Background(), 5, "user@test.com", "USER")
+
+		_, err := svc.ShipOrder(ctx, 3, "JNE", "AWB123")
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+}
+
+func TestService_ApplyEvent(t *testing.T) {
+	ev := &CourierEvent{Courier: "JNE", AWB: "AWB123", Status: StatusDelivered, OccurredAt: time.Now()}
+
+	t.Run("DeliveredCompletesOrder", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockOrders := new(MockOrderUpdater)
+		svc := NewService(mockRepo, mockOrders)
+
+		mockRepo.On("ApplyEvent", mock.Anything, ev).
+			Return(&Shipment{OrderID: 3, OrderStatus: "SHIPPED", Status: StatusDelivered}, true, nil)
+		mockOrders.On("UpdateOrderStatus", mock.Anything, uint(3), order.OrderStatusCompleted).Return(nil)
+
+		assert.NoError(t, svc.ApplyEvent(context.Background(), ev))
+		mockOrders.AssertExpectations(t)
+	})
+
+	t.Run("AlreadyCompleted", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockOrders := new(MockOrderUpdater)
+		svc := NewService(mockRepo, mockOrders)
+
+		mockRepo.On("ApplyEvent", mock.Anything, ev).
+			Return(&Shipment{OrderID: 3, OrderStatus: "COMPLETED", Status: StatusDelivered}, false, nil)
+
+		assert.NoError(t, svc.ApplyEvent(context.Background(), ev))
+		mockOrders.AssertNotCalled(t, "UpdateOrderStatus", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("UnknownStatus", func(t *testing.T) {
+		svc := NewService(new(MockRepository), new(MockOrderUpdater))
+
+		err := svc.ApplyEvent(context.Background(), &CourierEvent{Courier: "JNE", AWB: "AWB123", Status: "LOST"})
+		assert.ErrorIs(t, err, ErrUnknownStatus)
+	})
+}
+
+func TestService_RetryWebhooks(t *testing.T) {
+	payload := json.RawMessage(`{"awb":"AWB123","status":"IN_TRANSIT","occurred_at":"2026-01-01T10:00:00Z"}`)
+
+	t.Run("SucceededAndDeadLettered", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, new(MockOrderUpdater))
+
+		mockRepo.On("ListDueWebhooks", mock.Anything, mock.Anything, int32(retryBatchSize)).Return([]*Webhook{
+			{ID: 1, Courier: "JNE", EventID: "e1", Payload: payload},
+			{ID: 2, Courier: "JNE", EventID: "e2", Payload: json.RawMessage(`not json`)},
+		}, nil)
+		mockRepo.On("ApplyEvent", mock.Anything, mock.MatchedBy(func(ev *CourierEvent) bool {
+			return ev.EventID == "e1" && ev.Courier == "JNE" && ev.AWB == "AWB123"
+		})).Return(&Shipment{OrderID: 3, OrderStatus: "SHIPPED", Status: StatusInTransit}, true, nil)
+		mockRepo.On("MarkWebhookProcessed", mock.Anything, int64(1)).Return(nil)
+		mockRepo.On("MarkWebhookFailed", mock.Anything, int64(2), mock.Anything, MaxWebhookAttempts).Return(true, nil)
+
+		n, err := svc.RetryWebhooks(context.Background())
+
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("ListError", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, new(MockOrderUpdater))
+
+		mockRepo.On("ListDueWebhooks", mock.Anything, mock.Anything, mock.Anything).Return(nil, errors.New("db"))
+
+		_, err := svc.RetryWebhooks(context.Background())
+		assert.Error(t, err)
+	})
+}
+
+func TestService_ListDeadLetters(t *testing.T) {
+	mockRepo := new(MockRepository)
+	svc := NewService(mockRepo, new(MockOrderUpdater))
+
+	mockRepo.On("ListDeadWebhooks", mock.Anything, int32(maxLimit)).Return([]*Webhook{}, nil)
+
+	_, err := svc.ListDeadLetters(adminCtx(), 1000)
+	assert.NoError(t, err)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestService_Manifest(t *testing.T) {
+	jne, sicepat := "JNE", "SICEPAT"
+	awb1, awb2 := "JNE001", "SCP001"
+	insured := int64(250000)
+
+	t.Run("GroupsByCourier", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, new(MockOrderUpdater)).(*service)
+		svc.loc = time.UTC
+
+		day := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+		mockRepo.On("ListManifestEntries", mock.Anything, day, day.AddDate(0, 0, 1)).Return([]*ManifestEntry{
+			{Courier: &jne, AWB: &awb1, OrderExternalID: "ORD-1", City: "Jakarta", ItemCount: 2, ShippedAt: day.Add(9 * time.Hour)},
+			{Courier: &sicepat, AWB: &awb2, OrderExternalID: "ORD-2", City: "Bandung", ItemCount: 1, InsuredValue: &insured, ShippedAt: day.Add(10 * time.Hour)},
+			{OrderExternalID: "ORD-3", City: "Bogor", ItemCount: 1, ShippedAt: day.Add(11 * time.Hour)},
+		}, nil)
+
+		date := "2026-03-02"
+		html, err := svc.Manifest(adminCtx(), &date)
+
+		assert.NoError(t, err)
+		assert.Equal(t, 3, strings.Count(html, "<section>"))
+		assert.Contains(t, html, "Courier manifest: JNE")
+		assert.Contains(t, html, "Courier manifest: SICEPAT")
+		assert.Contains(t, html, "No shipment recorded")
+		assert.Contains(t, html, "JNE001")
+		assert.Contains(t, html, `<td class="num">250000</td>`)
+		assert.Contains(t, html, "Received by courier")
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("DefaultsToToday", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, new(MockOrderUpdater)).(*service)
+		svc.loc = time.UTC
+		svc.now = func() time.Time { return time.Date(2026, 3, 2, 15, 30, 0, 0, time.UTC) }
+
+		day := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+		mockRepo.On("ListManifestEntries", mock.Anything, day, day.AddDate(0, 0, 1)).Return([]*ManifestEntry{}, nil)
+
+		html, err := svc.Manifest(adminCtx(), nil)
+
+		assert.NoError(t, err)
+		assert.Contains(t, html, "No orders were shipped on 2026-03-02")
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("InvalidDate", func(t *testing.T) {
+		svc := NewService(new(MockRepository), new(MockOrderUpdater))
+
+		date := "02/03/2026"
+		_, err := svc.Manifest(adminCtx(), &date)
+		assert.ErrorIs(t, err, ErrInvalidDate)
+	})
+}
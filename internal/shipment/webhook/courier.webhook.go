@@ -0,0 +1,115 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/shipment"
+
+	"go.uber.org/zap"
+)
+
+type Handler struct {
+	ShipmentSvc  shipment.Service
+	ShipmentRepo shipment.Repository
+}
+
+func NewCourierWebhookHandler(
+	shipmentSvc shipment.Service,
+	shipmentRepo shipment.Repository,
+) *Handler {
+	return &Handler{
+		ShipmentSvc:  shipmentSvc,
+		ShipmentRepo: shipmentRepo,
+	}
+}
+
+func (h *Handler) CourierWebhookHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	log := logger.FromCtx(ctx)
+
+	// 1. Read body
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Error("Failed reading courier webhook body", zap.Error(err))
+		http.Error(w, "invalid body", http.StatusBadRequest)
+		return
+	}
+	defer r.Body.Close()
+
+	// 2. Verify signature (hex HMAC-SHA256 of the raw body)
+	if !validSignature(os.Getenv("COURIER_WEBHOOK_SECRET"), body, r.Header.Get("x-courier-signature")) {
+		log.Warn("Invalid courier webhook signature")
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	// 3. Parse payload
+	var ev shipment.CourierEvent
+	if err := json.Unmarshal(body, &ev); err != nil {
+		log.Error("Invalid courier webhook JSON", zap.Error(err))
+		http.Error(w, "bad json", http.StatusBadRequest)
+		return
+	}
+	ev.Courier = strings.ToUpper(strings.TrimSpace(ev.Courier))
+	if ev.Courier == "" || ev.AWB == "" || ev.OccurredAt.IsZero() {
+		log.Warn("Incomplete courier webhook", zap.String("courier", ev.Courier), zap.String("awb", ev.AWB))
+		http.Error(w, "missing fields", http.StatusBadRequest)
+		return
+	}
+
+	// 4. Derive event ID when the courier does not send one
+	if ev.EventID == "" {
+		ev.EventID = ev.AWB + ":" + string(ev.Status) + ":" + ev.OccurredAt.UTC().Format("2006-01-02T15:04:05Z")
+	}
+
+	// 5. Save webhook FIRST (idempotency happens here)
+	webhookID, isDuplicate, err := h.ShipmentRepo.SaveWebhook(ctx, &ev, json.RawMessage(body), true)
+	if err != nil {
+		log.Error("Failed saving courier webhook", zap.Error(err))
+		http.Error(w, "internal error", http.StatusInternalServerError)
+		return
+	}
+	if isDuplicate {
+		log.Info("Duplicate courier webhook ignored", zap.String("event_id", ev.EventID))
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+
+	// 6. Apply; failures are retried by the courier_webhook_retry job
+	if err := h.ShipmentSvc.ApplyEvent(ctx, &ev); err != nil {
+		log.Warn("Courier webhook processing failed, queued for retry", zap.Error(err))
+		if _, markErr := h.ShipmentRepo.MarkWebhookFailed(ctx, webhookID, err.Error(), shipment.MaxWebhookAttempts); markErr != nil {
+			log.Error("Failed marking courier webhook failed", zap.Error(markErr))
+		}
+		w.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	// 7. Mark webhook processed
+	_ = h.ShipmentRepo.MarkWebhookProcessed(ctx, webhookID)
+
+	log.Info("Courier webhook processed successfully",
+		zap.String("courier", ev.Courier),
+		zap.String("awb", ev.AWB),
+		zap.String("status", string(ev.Status)),
+	)
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func validSignature(secret string, body []byte, signature string) bool {
+	if secret == "" || signature == "" {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	expected := hex.EncodeToString(mac.Sum(nil))
+	return hmac.Equal([]byte(expected), []byte(strings.ToLower(signature)))
+}
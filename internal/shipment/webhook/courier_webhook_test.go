@@ -0,0 +1,134 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+	"warimas-be/internal/shipment"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type MockShipmentService struct {
+	shipment.Service
+	mock.Mock
+}
+
+func (m *MockShipmentService) ApplyEvent(ctx context.Context, ev *shipment.CourierEvent) error {
+	args := m.Called(ctx, ev)
+	return args.Error(0)
+}
+
+type MockShipmentRepository struct {
+	shipment.Repository
+	mock.Mock
+}
+
+func (m *MockShipmentRepository) SaveWebhook(ctx context.Context, ev *shipment.CourierEvent, payload json.RawMessage, signatureValid bool) (int64, bool, error) {
+	args := m.Called(ctx, ev, payload, signatureValid)
+	return args.Get(0).(int64), args.Bool(1), args.Error(2)
+}
+
+func (m *MockShipmentRepository) MarkWebhookProcessed(ctx context.Context, id int64) error {
+	args := m.Called(ctx, id)
+	return args.Error(0)
+}
+
+func (m *MockShipmentRepository) MarkWebhookFailed(ctx context.Context, id int64, reason string, maxAttempts int) (bool, error) {
+	args := m.Called(ctx, id, reason, maxAttempts)
+	return args.Bool(0), args.Error(1)
+}
+
+func sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestHandler_CourierWebhookHandler(t *testing.T) {
+	t.Setenv("COURIER_WEBHOOK_SECRET", "courier-secret")
+
+	body, _ := json.Marshal(map[string]interface{}{
+		"event_id":    "evt-1",
+		"courier":     "jne",
+		"awb":         "AWB123",
+		"status":      "DELIVERED",
+		"occurred_at": time.Date(2026, 1, 1, 10, 0, 0, 0, time.UTC),
+	})
+
+	newRequest := func(signature string) *http.Request {
+		req := httptest.NewRequest("POST", "/webhook/courier", bytes.NewBuffer(body))
+		req.Header.Set("x-courier-signature", signature)
+		return req
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockSvc := new(MockShipmentService)
+		mockRepo := new(MockShipmentRepository)
+		h := NewCourierWebhookHandler(mockSvc, mockRepo)
+		w := httptest.NewRecorder()
+
+		mockRepo.On("SaveWebhook", mock.Anything, mock.MatchedBy(func(ev *shipment.CourierEvent) bool {
+			return ev.Courier == "JNE" && ev.EventID == "evt-1"
+		}), mock.Anything, true).Return(int64(1), false, nil)
+		mockSvc.On("ApplyEvent", mock.Anything, mock.Anything).Return(nil)
+		mockRepo.On("MarkWebhookProcessed", mock.Anything, int64(1)).Return(nil)
+
+		h.CourierWebhookHandler(w, newRequest(sign("courier-secret", body)))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockSvc.AssertExpectations(t)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("InvalidSignature", func(t *testing.T) {
+		mockSvc := new(MockShipmentService)
+		mockRepo := new(MockShipmentRepository)
+		h := NewCourierWebhookHandler(mockSvc, mockRepo)
+		w := httptest.NewRecorder()
+
+		h.CourierWebhookHandler(w, newRequest(sign("wrong", body)))
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		mockRepo.AssertNotCalled(t, "SaveWebhook", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Duplicate", func(t *testing.T) {
+		mockSvc := new(MockShipmentService)
+		mockRepo := new(MockShipmentRepository)
+		h := NewCourierWebhookHandler(mockSvc, mockRepo)
+		w := httptest.NewRecorder()
+
+		mockRepo.On("SaveWebhook", mock.Anything, mock.Anything, mock.Anything, true).Return(int64(0), true, nil)
+
+		h.CourierWebhookHandler(w, newRequest(sign("courier-secret", body)))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockSvc.AssertNotCalled(t, "ApplyEvent", mock.Anything, mock.Anything)
+	})
+
+	t.Run("ProcessingFailed_QueuedForRetry", func(t *testing.T) {
+		mockSvc := new(MockShipmentService)
+		mockRepo := new(MockShipmentRepository)
+		h := NewCourierWebhookHandler(mockSvc, mockRepo)
+		w := httptest.NewRecorder()
+
+		mockRepo.On("SaveWebhook", mock.Anything, mock.Anything, mock.Anything, true).Return(int64(1), false, nil)
+		mockSvc.On("ApplyEvent", mock.Anything, mock.Anything).Return(errors.New("db down"))
+		mockRepo.On("MarkWebhookFailed", mock.Anything, int64(1), "db down", shipment.MaxWebhookAttempts).Return(false, nil)
+
+		h.CourierWebhookHandler(w, newRequest(sign("courier-secret", body)))
+
+		assert.Equal(t, http.StatusAccepted, w.Code)
+		mockRepo.AssertExpectations(t)
+	})
+}
@@ -0,0 +1,20 @@
+package sla
+
+import (
+	"strconv"
+	"warimas-be/internal/graph/model"
+)
+
+func MapBreachToGraphQL(b *Breach) *model.OrderSLABreach {
+	return &model.OrderSLABreach{
+		ID:              strconv.FormatInt(b.ID, 10),
+		OrderID:         strconv.Itoa(int(b.OrderID)),
+		OrderExternalID: b.OrderExternalID,
+		OrderStatus:     model.OrderStatus(b.OrderStatus),
+		Rule:            b.Rule,
+		EnteredAt:       b.EnteredAt,
+		Deadline:        b.Deadline,
+		DetectedAt:      b.DetectedAt,
+		ResolvedAt:      b.ResolvedAt,
+	}
+}
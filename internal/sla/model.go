@@ -0,0 +1,51 @@
+package sla
+
+import "time"
+
+const (
+	RulePaidToAccepted    = "PAID_TO_ACCEPTED"
+	RuleAcceptedToShipped = "ACCEPTED_TO_SHIPPED"
+)
+
+// CheckInterval is how often the SLA job looks for new breaches.
+const CheckInterval = 10 * time.Minute
+
+const (
+	defaultLimit    = 50
+	maxLimit        = 500
+	notifyBatchSize = 100
+)
+
+// Rule says an order entering Status must leave it within Within.
+type Rule struct {
+	Name   string
+	Status string
+	Within time.Duration
+}
+
+// DefaultRules builds the fulfilment rules from their windows; a zero
+// window leaves that rule out.
+func DefaultRules(paidToAccepted, acceptedToShipped time.Duration) []Rule {
+	var rules []Rule
+	if paidToAccepted > 0 {
+		rules = append(rules, Rule{Name: RulePaidToAccepted, Status: "PAID", Within: paidToAccepted})
+	}
+	if acceptedToShipped > 0 {
+		rules = append(rules, Rule{Name: RuleAcceptedToShipped, Status: "ACCEPTED", Within: acceptedToShipped})
+	}
+	return rules
+}
+
+// Breach is an order that stayed in a status past its rule's deadline.
+// ResolvedAt is set once the order has moved on.
+type Breach struct {
+	ID              int64
+	OrderID         int32
+	OrderExternalID string
+	OrderStatus     string
+	Rule            string
+	EnteredAt       time.Time
+	Deadline        time.Time
+	DetectedAt      time.Time
+	ResolvedAt      *time.Time
+}
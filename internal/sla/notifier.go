@@ -0,0 +1,32 @@
+package sla
+
+import (
+	"context"
+	"warimas-be/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// Notifier delivers breach alerts. The job marks breaches as notified
+// only after NotifyBreaches succeeds, so a failed delivery is retried on
+// the next run.
+type Notifier interface {
+	NotifyBreaches(ctx context.Context, breaches []*Breach) error
+}
+
+// LogNotifier writes each breach as a warning for log-based alerting.
+type LogNotifier struct{}
+
+func (LogNotifier) NotifyBreaches(ctx context.Context, breaches []*Breach) error {
+	log := logger.FromCtx(ctx)
+	for _, b := range breaches {
+		log.Warn("order SLA breached",
+			zap.String("rule", b.Rule),
+			zap.String("order_external_id", b.OrderExternalID),
+			zap.String("order_status", b.OrderStatus),
+			zap.Time("entered_at", b.EnteredAt),
+			zap.Time("deadline", b.Deadline),
+		)
+	}
+	return nil
+}
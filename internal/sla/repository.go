@@ -0,0 +1,157 @@
+package sla
+
+import (
+	"context"
+	"database/sql"
+	"time"
+	"warimas-be/internal/logger"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+type Repository interface {
+	ResolveBreaches(ctx context.Context, rule Rule, now time.Time) (int64, error)
+	DetectBreaches(ctx context.Context, rule Rule, now time.Time) (int64, error)
+	ListUnnotified(ctx context.Context, limit int32) ([]*Breach, error)
+	MarkNotified(ctx context.Context, ids []int64, now time.Time) error
+	ListBreaches(ctx context.Context, openOnly bool, limit int32) ([]*Breach, error)
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+// ResolveBreaches closes the open breaches of rule whose order has left
+// the rule's status.
+func (r *repository) ResolveBreaches(ctx context.Context, rule Rule, now time.Time) (int64, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ResolveBreaches"),
+		zap.String("rule", rule.Name),
+	)
+
+	res, err := r.db.ExecContext(ctx, `
+		UPDATE order_sla_breaches b
+		SET resolved_at = $3
+		FROM orders o
+		WHERE o.id = b.order_id
+		  AND b.rule = $1
+		  AND b.resolved_at IS NULL
+		  AND o.status <> $2
+	`, rule.Name, rule.Status, now)
+	if err != nil {
+		log.Error("failed to resolve breaches", zap.Error(err))
+		return 0, ErrDB
+	}
+
+	n, _ := res.RowsAffected()
+	return n, nil
+}
+
+// DetectBreaches records orders that have sat in the rule's status past
+// its window. The clock starts at the latest time the order entered that
+// status; orders already recorded for the rule are skipped.
+func (r *repository) DetectBreaches(ctx context.Context, rule Rule, now time.Time) (int64, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "DetectBreaches"),
+		zap.String("rule", rule.Name),
+	)
+
+	res, err := r.db.ExecContext(ctx, `
+		INSERT INTO order_sla_breaches (order_id, rule, entered_at, deadline, detected_at)
+		SELECT o.id, $1, h.changed_at, h.changed_at + make_interval(secs => $3), $4
+		FROM orders o
+		JOIN LATERAL (
+			SELECT changed_at
+			FROM order_status_history
+			WHERE order_id = o.id
+			  AND to_status = o.status
+			ORDER BY changed_at DESC
+			LIMIT 1
+		) h ON TRUE
+		WHERE o.status = $2
+		  AND h.changed_at + make_interval(secs => $3) < $4
+		ON CONFLICT (order_id, rule) DO NOTHING
+	`, rule.Name, rule.Status, rule.Within.Seconds(), now)
+	if err != nil {
+		log.Error("failed to detect breaches", zap.Error(err))
+		return 0, ErrDB
+	}
+
+	n, _ := res.RowsAffected()
+	return n, nil
+}
+
+func (r *repository) ListUnnotified(ctx context.Context, limit int32) ([]*Breach, error) {
+	return r.list(ctx, "ListUnnotified", `
+		WHERE b.notified_at IS NULL
+		ORDER BY b.id
+		LIMIT $1
+	`, limit)
+}
+
+func (r *repository) MarkNotified(ctx context.Context, ids []int64, now time.Time) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE order_sla_breaches
+		SET notified_at = $2
+		WHERE id = ANY($1)
+	`, pq.Array(ids), now)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to mark breaches notified", zap.Error(err))
+		return ErrDB
+	}
+	return nil
+}
+
+func (r *repository) ListBreaches(ctx context.Context, openOnly bool, limit int32) ([]*Breach, error) {
+	return r.list(ctx, "ListBreaches", `
+		WHERE (NOT $2 OR b.resolved_at IS NULL)
+		ORDER BY b.detected_at DESC, b.id DESC
+		LIMIT $1
+	`, limit, openOnly)
+}
+
+func (r *repository) list(ctx context.Context, method, where string, args ...any) ([]*Breach, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", method),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT b.id, b.order_id, o.external_id, o.status, b.rule,
+		       b.entered_at, b.deadline, b.detected_at, b.resolved_at
+		FROM order_sla_breaches b
+		JOIN orders o ON o.id = b.order_id
+	`+where, args...)
+	if err != nil {
+		log.Error("failed to query breaches", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	list := []*Breach{}
+	for rows.Next() {
+		var b Breach
+		if err := rows.Scan(
+			&b.ID, &b.OrderID, &b.OrderExternalID, &b.OrderStatus, &b.Rule,
+			&b.EnteredAt, &b.Deadline, &b.DetectedAt, &b.ResolvedAt,
+		); err != nil {
+			log.Error("failed to scan breach", zap.Error(err))
+			return nil, ErrDB
+		}
+		list = append(list, &b)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error("breach iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return list, nil
+}
@@ -0,0 +1,78 @@
+package sla
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_DetectBreaches(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	now := time.Now()
+	rule := Rule{Name: RulePaidToAccepted, Status: "PAID", Within: 24 * time.Hour}
+
+	mock.ExpectExec(`INSERT INTO order_sla_breaches .* FROM orders o JOIN LATERAL .* ON CONFLICT \(order_id, rule\) DO NOTHING`).
+		WithArgs(RulePaidToAccepted, "PAID", float64(86400), now).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	n, err := repo.DetectBreaches(context.Background(), rule, now)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(3), n)
+
+	mock.ExpectExec(`INSERT INTO order_sla_breaches`).WillReturnError(errors.New("boom"))
+	_, err = repo.DetectBreaches(context.Background(), rule, now)
+	assert.ErrorIs(t, err, ErrDB)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ResolveBreaches(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	now := time.Now()
+	rule := Rule{Name: RuleAcceptedToShipped, Status: "ACCEPTED", Within: 48 * time.Hour}
+
+	mock.ExpectExec(`UPDATE order_sla_breaches b SET resolved_at = \$3 FROM orders o`).
+		WithArgs(RuleAcceptedToShipped, "ACCEPTED", now).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	n, err := repo.ResolveBreaches(context.Background(), rule, now)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), n)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ListBreaches(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	entered := time.Now().Add(-30 * time.Hour)
+
+	mock.ExpectQuery(`FROM order_sla_breaches b JOIN orders o ON o.id = b.order_id WHERE \(NOT \$2 OR b.resolved_at IS NULL\)`).
+		WithArgs(int32(50), true).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "order_id", "external_id", "status", "rule",
+			"entered_at", "deadline", "detected_at", "resolved_at",
+		}).AddRow(1, 10, "ORD-10", "PAID", RulePaidToAccepted, entered, entered.Add(24*time.Hour), time.Now(), nil))
+
+	list, err := repo.ListBreaches(context.Background(), true, 50)
+	assert.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, "ORD-10", list[0].OrderExternalID)
+	assert.Nil(t, list[0].ResolvedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
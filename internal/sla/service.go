@@ -0,0 +1,104 @@
+package sla
+
+import (
+	"context"
+	"time"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+type Service interface {
+	// Check closes breaches whose order moved on, records new ones and
+	// sends alerts for any not yet notified. It returns how many new
+	// breaches were found.
+	Check(ctx context.Context) (int64, error)
+	ListBreaches(ctx context.Context, openOnly bool, limit int32) ([]*Breach, error)
+}
+
+type service struct {
+	repo     Repository
+	rules    []Rule
+	notifier Notifier
+	now      func() time.Time
+}
+
+func NewService(repo Repository, rules []Rule, notifier Notifier) Service {
+	return &service{repo: repo, rules: rules, notifier: notifier, now: time.Now}
+}
+
+func (s *service) Check(ctx context.Context) (int64, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "Check"),
+	)
+
+	now := s.now()
+	var found int64
+	for _, rule := range s.rules {
+		if _, err := s.repo.ResolveBreaches(ctx, rule, now); err != nil {
+			return found, err
+		}
+		n, err := s.repo.DetectBreaches(ctx, rule, now)
+		if err != nil {
+			return found, err
+		}
+		if n > 0 {
+			log.Info("new SLA breaches", zap.String("rule", rule.Name), zap.Int64("count", n))
+		}
+		found += n
+	}
+
+	for {
+		pending, err := s.repo.ListUnnotified(ctx, notifyBatchSize)
+		if err != nil {
+			return found, err
+		}
+		if len(pending) == 0 {
+			return found, nil
+		}
+
+		if err := s.notifier.NotifyBreaches(ctx, pending); err != nil {
+			log.Error("failed to send breach alerts", zap.Error(err))
+			return found, err
+		}
+
+		ids := make([]int64, 0, len(pending))
+		for _, b := range pending {
+			ids = append(ids, b.ID)
+		}
+		if err := s.repo.MarkNotified(ctx, ids, now); err != nil {
+			return found, err
+		}
+
+		if len(pending) < notifyBatchSize {
+			return found, nil
+		}
+	}
+}
+
+func (s *service) ListBreaches(ctx context.Context, openOnly bool, limit int32) ([]*Breach, error) {
+	if err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+
+	return s.repo.ListBreaches(ctx, openOnly, limit)
+}
+
+func requireAdmin(ctx context.Context) error {
+	if _, ok := utils.GetUserIDFromContext(ctx); !ok {
+		return ErrUnauthenticated
+	}
+	if !utils.IsAdmin(ctx) {
+		return ErrForbidden
+	}
+	return nil
+}
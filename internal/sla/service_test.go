@@ -0,0 +1,156 @@
+package sla
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"warimas-be/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// --- Mocks ---
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) ResolveBreaches(ctx context.Context, rule Rule, now time.Time) (int64, error) {
+	args := m.Called(ctx, rule, now)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) DetectBreaches(ctx context.Context, rule Rule, now time.Time) (int64, error) {
+	args := m.Called(ctx, rule, now)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) ListUnnotified(ctx context.Context, limit int32) ([]*Breach, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Breach), args.Error(1)
+}
+
+func (m *MockRepository) MarkNotified(ctx context.Context, ids []int64, now time.Time) error {
+	args := m.Called(ctx, ids, now)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ListBreaches(ctx context.Context, openOnly bool, limit int32) ([]*Breach, error) {
+	args := m.Called(ctx, openOnly, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Breach), args.Error(1)
+}
+
+type MockNotifier struct {
+	mock.Mock
+}
+
+func (m *MockNotifier) NotifyBreaches(ctx context.Context, breaches []*Breach) error {
+	args := m.Called(ctx, breaches)
+	return args.Error(0)
+}
+
+// --- Tests ---
+
+func newTestService(repo Repository, notifier Notifier, now time.Time) *service {
+	return &service{
+		repo:     repo,
+		rules:    DefaultRules(24*time.Hour, 48*time.Hour),
+		notifier: notifier,
+		now:      func() time.Time { return now },
+	}
+}
+
+func TestDefaultRules(t *testing.T) {
+	rules := DefaultRules(24*time.Hour, 0)
+	assert.Len(t, rules, 1)
+	assert.Equal(t, RulePaidToAccepted, rules[0].Name)
+	assert.Equal(t, "PAID", rules[0].Status)
+}
+
+func TestService_Check(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("DetectsAndNotifies", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		notifier := new(MockNotifier)
+		svc := newTestService(mockRepo, notifier, now)
+
+		for _, rule := range svc.rules {
+			mockRepo.On("ResolveBreaches", ctx, rule, now).Return(int64(0), nil)
+		}
+		mockRepo.On("DetectBreaches", ctx, svc.rules[0], now).Return(int64(2), nil)
+		mockRepo.On("DetectBreaches", ctx, svc.rules[1], now).Return(int64(0), nil)
+
+		pending := []*Breach{{ID: 4}, {ID: 9}}
+		mockRepo.On("ListUnnotified", ctx, int32(notifyBatchSize)).Return(pending, nil)
+		notifier.On("NotifyBreaches", ctx, pending).Return(nil)
+		mockRepo.On("MarkNotified", ctx, []int64{4, 9}, now).Return(nil)
+
+		n, err := svc.Check(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), n)
+		mockRepo.AssertExpectations(t)
+		notifier.AssertExpectations(t)
+	})
+
+	t.Run("NotifyFailureLeavesBreachesPending", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		notifier := new(MockNotifier)
+		svc := newTestService(mockRepo, notifier, now)
+
+		mockRepo.On("ResolveBreaches", ctx, mock.Anything, now).Return(int64(0), nil)
+		mockRepo.On("DetectBreaches", ctx, mock.Anything, now).Return(int64(0), nil)
+		mockRepo.On("ListUnnotified", ctx, int32(notifyBatchSize)).Return([]*Breach{{ID: 1}}, nil)
+		notifier.On("NotifyBreaches", ctx, mock.Anything).Return(errors.New("smtp down"))
+
+		_, err := svc.Check(ctx)
+		assert.Error(t, err)
+		mockRepo.AssertNotCalled(t, "MarkNotified", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("RepoError", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := newTestService(mockRepo, new(MockNotifier), now)
+
+		mockRepo.On("ResolveBreaches", ctx, svc.rules[0], now).Return(int64(0), ErrDB)
+
+		_, err := svc.Check(ctx)
+		assert.ErrorIs(t, err, ErrDB)
+		mockRepo.AssertNotCalled(t, "DetectBreaches", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestService_ListBreaches(t *testing.T) {
+	now := time.Now()
+	adminCtx := utils.SetUserContext(context.Background(), 1, "admin@example.com", "ADMIN")
+
+	t.Run("DefaultsLimit", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := newTestService(mockRepo, nil, now)
+
+		mockRepo.On("ListBreaches", adminCtx, true, int32(defaultLimit)).Return([]*Breach{{ID: 1}}, nil)
+
+		list, err := svc.ListBreaches(adminCtx, true, 0)
+		assert.NoError(t, err)
+		assert.Len(t, list, 1)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := newTestService(mockRepo, nil, now)
+		ctx := utils.SetUserContext(context.Background(), 2, "user@example.com", "USER")
+
+		_, err := svc.ListBreaches(ctx, false, 10)
+		assert.ErrorIs(t, err, ErrForbidden)
+		mockRepo.AssertNotCalled(t, "ListBreaches", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
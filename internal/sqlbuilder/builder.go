@@ -0,0 +1,101 @@
+// Package sqlbuilder assembles the dynamic parts of Postgres queries —
+// filters, sorting and pagination — and numbers their placeholders, so
+// repositories never track $n indexes by hand.
+package sqlbuilder
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Builder accumulates WHERE and HAVING conditions with their arguments.
+// Conditions are written with ? placeholders, which are rewritten to $n in
+// the order arguments are bound. Use Arg for placeholders outside a
+// condition, and keep literal question marks (e.g. jsonb ?) out of
+// conditions.
+type Builder struct {
+	args   []any
+	where  []string
+	having []string
+}
+
+func New() *Builder {
+	return &Builder{}
+}
+
+// Arg binds v and returns its placeholder.
+func (b *Builder) Arg(v any) string {
+	b.args = append(b.args, v)
+	return fmt.Sprintf("$%d", len(b.args))
+}
+
+// Where adds a condition joined to the others with AND. A condition with a
+// single argument binds it once, however many ? it contains.
+func (b *Builder) Where(cond string, args ...any) *Builder {
+	b.where = append(b.where, b.bind(cond, args))
+	return b
+}
+
+// Having adds a HAVING condition joined to the others with AND.
+func (b *Builder) Having(cond string, args ...any) *Builder {
+	b.having = append(b.having, b.bind(cond, args))
+	return b
+}
+
+// WhereClause renders " WHERE ..." or "" when there are no conditions.
+func (b *Builder) WhereClause() string {
+	if len(b.where) == 0 {
+		return ""
+	}
+	return " WHERE " + strings.Join(b.where, " AND ")
+}
+
+// HavingClause renders " HAVING ..." or "" when there are no conditions.
+func (b *Builder) HavingClause() string {
+	if len(b.having) == 0 {
+		return ""
+	}
+	return " HAVING " + strings.Join(b.having, " AND ")
+}
+
+// Page binds limit and offset and renders " LIMIT $n OFFSET $m".
+func (b *Builder) Page(limit, offset any) string {
+	return fmt.Sprintf(" LIMIT %s OFFSET %s", b.Arg(limit), b.Arg(offset))
+}
+
+// Args returns the arguments bound so far, in placeholder order.
+func (b *Builder) Args() []any {
+	return append([]any(nil), b.args...)
+}
+
+func (b *Builder) bind(cond string, args []any) string {
+	n := strings.Count(cond, "?")
+	if n > 0 && len(args) == 1 {
+		return strings.ReplaceAll(cond, "?", b.Arg(args[0]))
+	}
+	if n != len(args) {
+		panic(fmt.Sprintf("sqlbuilder: %q has %d placeholders but %d args", cond, n, len(args)))
+	}
+
+	var sb strings.Builder
+	i := 0
+	for _, r := range cond {
+		if r == '?' {
+			sb.WriteString(b.Arg(args[i]))
+			i++
+			continue
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
+
+// OrderBy renders " ORDER BY expr ASC|DESC". Only "ASC" (any case) sorts
+// ascending, so user input never reaches the query text.
+func OrderBy(expr string, direction string) string {
+	dir := "DESC"
+	if strings.EqualFold(direction, "ASC") {
+		dir = "ASC"
+	}
+	return " ORDER BY " + expr + " " + dir
+}
@@ -0,0 +1,66 @@
+package sqlbuilder
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuilder_NumbersPlaceholdersInBindOrder(t *testing.T) {
+	b := New()
+	b.Where("o.user_id = ?", 7)
+	b.Where("o.created_at BETWEEN ? AND ?", "2024-01-01", "2024-02-01")
+	b.Having("MIN(v.price) >= ?", 1000)
+	page := b.Page(20, 40)
+
+	assert.Equal(t, " WHERE o.user_id = $1 AND o.created_at BETWEEN $2 AND $3", b.WhereClause())
+	assert.Equal(t, " HAVING MIN(v.price) >= $4", b.HavingClause())
+	assert.Equal(t, " LIMIT $5 OFFSET $6", page)
+	assert.Equal(t, []any{7, "2024-01-01", "2024-02-01", 1000, 20, 40}, b.Args())
+}
+
+func TestBuilder_SingleArgReusedAcrossPlaceholders(t *testing.T) {
+	b := New()
+	b.Where("(o.id::text ILIKE ? OR o.external_id ILIKE ?)", "%a%")
+
+	assert.Equal(t, " WHERE (o.id::text ILIKE $1 OR o.external_id ILIKE $1)", b.WhereClause())
+	assert.Equal(t, []any{"%a%"}, b.Args())
+}
+
+func TestBuilder_EmptyClauses(t *testing.T) {
+	b := New()
+
+	assert.Empty(t, b.WhereClause())
+	assert.Empty(t, b.HavingClause())
+	assert.Empty(t, b.Args())
+}
+
+func TestBuilder_ConditionWithoutArgs(t *testing.T) {
+	b := New()
+	b.Where("p.deleted_at IS NULL")
+	b.Where("p.type = ?", "promotion")
+
+	assert.Equal(t, " WHERE p.deleted_at IS NULL AND p.type = $1", b.WhereClause())
+}
+
+func TestBuilder_ArgsSnapshot(t *testing.T) {
+	b := New()
+	b.Where("a = ?", 1)
+	countArgs := b.Args()
+	b.Page(10, 0)
+
+	assert.Equal(t, []any{1}, countArgs)
+	assert.Len(t, b.Args(), 3)
+}
+
+func TestBuilder_PlaceholderMismatchPanics(t *testing.T) {
+	assert.Panics(t, func() {
+		New().Where("a = ? AND b = ?", 1, 2, 3)
+	})
+}
+
+func TestOrderBy(t *testing.T) {
+	assert.Equal(t, " ORDER BY p.name ASC", OrderBy("p.name", "asc"))
+	assert.Equal(t, " ORDER BY p.name DESC", OrderBy("p.name", "DESC"))
+	assert.Equal(t, " ORDER BY p.name DESC", OrderBy("p.name", "1; DROP TABLE products"))
+}
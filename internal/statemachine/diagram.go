@@ -0,0 +1,27 @@
+package statemachine
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Mermaid renders the machine as a Mermaid state diagram. Changes allowed
+// from any state are drawn from each state that is not terminal.
+func (m *Machine[S, T]) Mermaid() string {
+	var b strings.Builder
+	b.WriteString("stateDiagram-v2\n")
+	for _, s := range m.initial {
+		fmt.Fprintf(&b, "    [*] --> %s\n", s)
+	}
+	for _, from := range m.states {
+		for _, to := range m.Targets(from) {
+			fmt.Fprintf(&b, "    %s --> %s\n", from, to)
+		}
+	}
+	for _, s := range m.states {
+		if m.terminal[s] {
+			fmt.Fprintf(&b, "    %s --> [*]\n", s)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,203 @@
+// Package statemachine declares which status changes a record may go
+// through and runs the checks and side effects around each change. Orders,
+// checkout sessions and refunds each declare one machine; the diagrams in
+// docs/state-machines.md are generated from the same declarations.
+package statemachine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+var ErrInvalidTransition = errors.New("invalid status transition")
+
+// TransitionError is returned for a change the machine does not allow.
+// It matches ErrInvalidTransition with errors.Is.
+type TransitionError[S ~string] struct {
+	From S
+	To   S
+	// Terminal is set when From allows no change at all.
+	Terminal bool
+}
+
+func (e *TransitionError[S]) Error() string {
+	if e.Terminal {
+		return fmt.Sprintf("invalid status transition from %s to %s: %s is a terminal status", e.From, e.To, e.From)
+	}
+	return fmt.Sprintf("invalid status transition from %s to %s", e.From, e.To)
+}
+
+func (e *TransitionError[S]) Unwrap() error {
+	return ErrInvalidTransition
+}
+
+// Guard runs before a change is applied; an error cancels it.
+type Guard[S ~string, T any] func(ctx context.Context, subject T, from, to S) error
+
+// Effect runs after a change was applied. It cannot undo the change, so it
+// has no error to return; effects log their own failures.
+type Effect[S ~string, T any] func(ctx context.Context, subject T, from, to S)
+
+// Machine holds the states of one status field, the changes allowed
+// between them and the hooks around those changes. S is the status type
+// and T what the hooks are handed, usually the record changing status.
+//
+// A machine is declared once, at package level, and is read-only after.
+type Machine[S ~string, T any] struct {
+	name     string
+	states   []S
+	initial  []S
+	next     map[S][]S
+	fromAny  []S
+	terminal map[S]bool
+	guards   map[S][]Guard[S, T]
+	enter    map[S][]Effect[S, T]
+	change   []Effect[S, T]
+}
+
+// New starts a machine over states. name is used in errors and diagrams.
+func New[S ~string, T any](name string, states ...S) *Machine[S, T] {
+	return &Machine[S, T]{
+		name:     name,
+		states:   states,
+		next:     map[S][]S{},
+		terminal: map[S]bool{},
+		guards:   map[S][]Guard[S, T]{},
+		enter:    map[S][]Effect[S, T]{},
+	}
+}
+
+// Initial marks the states a record may be created in.
+func (m *Machine[S, T]) Initial(states ...S) *Machine[S, T] {
+	m.mustKnow(states...)
+	m.initial = append(m.initial, states...)
+	return m
+}
+
+// Permit allows from to move to each of to.
+func (m *Machine[S, T]) Permit(from S, to ...S) *Machine[S, T] {
+	m.mustKnow(from)
+	m.mustKnow(to...)
+	m.next[from] = append(m.next[from], to...)
+	return m
+}
+
+// PermitFromAny allows every state that is not terminal to move to each
+// of to.
+func (m *Machine[S, T]) PermitFromAny(to ...S) *Machine[S, T] {
+	m.mustKnow(to...)
+	m.fromAny = append(m.fromAny, to...)
+	return m
+}
+
+// Terminal marks states that allow no further change.
+func (m *Machine[S, T]) Terminal(states ...S) *Machine[S, T] {
+	m.mustKnow(states...)
+	for _, s := range states {
+		m.terminal[s] = true
+	}
+	return m
+}
+
+// Guard adds a check run before every change to to.
+func (m *Machine[S, T]) Guard(to S, g Guard[S, T]) *Machine[S, T] {
+	m.mustKnow(to)
+	m.guards[to] = append(m.guards[to], g)
+	return m
+}
+
+// OnEnter adds an effect run after every change to to.
+func (m *Machine[S, T]) OnEnter(to S, e Effect[S, T]) *Machine[S, T] {
+	m.mustKnow(to)
+	m.enter[to] = append(m.enter[to], e)
+	return m
+}
+
+// OnChange adds an effect run after every change.
+func (m *Machine[S, T]) OnChange(e Effect[S, T]) *Machine[S, T] {
+	m.change = append(m.change, e)
+	return m
+}
+
+// mustKnow panics on a state the machine was not created with. Machines
+// are declared at package level, so a typo fails at startup and in tests.
+func (m *Machine[S, T]) mustKnow(states ...S) {
+	for _, s := range states {
+		if !slices.Contains(m.states, s) {
+			panic(fmt.Sprintf("statemachine: %s has no state %q", m.name, s))
+		}
+	}
+}
+
+func (m *Machine[S, T]) Name() string { return m.name }
+
+// States returns every state in declaration order.
+func (m *Machine[S, T]) States() []S { return slices.Clone(m.states) }
+
+func (m *Machine[S, T]) IsTerminal(s S) bool { return m.terminal[s] }
+
+// Targets returns the states from may move to.
+func (m *Machine[S, T]) Targets(from S) []S {
+	if m.terminal[from] {
+		return nil
+	}
+	out := slices.Clone(m.next[from])
+	for _, to := range m.fromAny {
+		if to != from && !slices.Contains(out, to) {
+			out = append(out, to)
+		}
+	}
+	return out
+}
+
+// Sources returns the states that may move to to, in declaration order.
+func (m *Machine[S, T]) Sources(to S) []S {
+	var out []S
+	for _, from := range m.states {
+		if m.Can(from, to) {
+			out = append(out, from)
+		}
+	}
+	return out
+}
+
+func (m *Machine[S, T]) Can(from, to S) bool {
+	return slices.Contains(m.Targets(from), to)
+}
+
+// Check returns a *TransitionError unless from may move to to.
+func (m *Machine[S, T]) Check(from, to S) error {
+	if m.terminal[from] {
+		return &TransitionError[S]{From: from, To: to, Terminal: true}
+	}
+	if !m.Can(from, to) {
+		return &TransitionError[S]{From: from, To: to}
+	}
+	return nil
+}
+
+// Fire moves subject from from to to: it checks the change is allowed,
+// runs the guards of to, calls apply to persist the change and then runs
+// the effects. Nothing runs after a failed step.
+func (m *Machine[S, T]) Fire(ctx context.Context, subject T, from, to S, apply func(context.Context) error) error {
+	if err := m.Check(from, to); err != nil {
+		return err
+	}
+	for _, g := range m.guards[to] {
+		if err := g(ctx, subject, from, to); err != nil {
+			return err
+		}
+	}
+	if err := apply(ctx); err != nil {
+		return err
+	}
+	for _, e := range m.enter[to] {
+		e(ctx, subject, from, to)
+	}
+	for _, e := range m.change {
+		e(ctx, subject, from, to)
+	}
+	return nil
+}
@@ -0,0 +1,137 @@
+package statemachine
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type light string
+
+const (
+	red    light = "RED"
+	green  light = "GREEN"
+	yellow light = "YELLOW"
+	off    light = "OFF"
+)
+
+func newLights() *Machine[light, *[]string] {
+	return New[light, *[]string]("light", red, green, yellow, off).
+		Initial(red).
+		Permit(red, green).
+		Permit(green, yellow).
+		Permit(yellow, red).
+		PermitFromAny(off).
+		Terminal(off)
+}
+
+func TestMachine_Check(t *testing.T) {
+	m := newLights()
+
+	assert.NoError(t, m.Check(red, green))
+	assert.NoError(t, m.Check(yellow, off))
+
+	err := m.Check(red, yellow)
+	assert.ErrorIs(t, err, ErrInvalidTransition)
+	assert.EqualError(t, err, "invalid status transition from RED to YELLOW")
+
+	err = m.Check(off, red)
+	assert.ErrorIs(t, err, ErrInvalidTransition)
+	assert.Contains(t, err.Error(), "terminal status")
+
+	// A state never moves to itself unless permitted
+	assert.Error(t, m.Check(red, red))
+}
+
+func TestMachine_TargetsAndSources(t *testing.T) {
+	m := newLights()
+
+	assert.Equal(t, []light{green, off}, m.Targets(red))
+	assert.Nil(t, m.Targets(off))
+	assert.Equal(t, []light{red, green, yellow}, m.Sources(off))
+	assert.Equal(t, []light{yellow}, m.Sources(red))
+}
+
+func TestMachine_Fire(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("RunsHooksInOrder", func(t *testing.T) {
+		var calls []string
+		m := newLights().
+			Guard(green, func(_ context.Context, c *[]string, _, _ light) error {
+				*c = append(*c, "guard")
+				return nil
+			}).
+			OnEnter(green, func(_ context.Context, c *[]string, _, _ light) {
+				*c = append(*c, "enter")
+			}).
+			OnChange(func(_ context.Context, c *[]string, from, to light) {
+				*c = append(*c, string(from)+">"+string(to))
+			})
+
+		err := m.Fire(ctx, &calls, red, green, func(context.Context) error {
+			calls = append(calls, "apply")
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"guard", "apply", "enter", "RED>GREEN"}, calls)
+	})
+
+	t.Run("GuardStops", func(t *testing.T) {
+		var calls []string
+		stop := errors.New("not now")
+		m := newLights().Guard(green, func(context.Context, *[]string, light, light) error {
+			return stop
+		})
+
+		err := m.Fire(ctx, &calls, red, green, func(context.Context) error {
+			calls = append(calls, "apply")
+			return nil
+		})
+		assert.ErrorIs(t, err, stop)
+		assert.Empty(t, calls)
+	})
+
+	t.Run("ApplyErrorSkipsEffects", func(t *testing.T) {
+		var calls []string
+		m := newLights().OnChange(func(_ context.Context, c *[]string, _, _ light) {
+			*c = append(*c, "change")
+		})
+
+		err := m.Fire(ctx, &calls, red, green, func(context.Context) error {
+			return errors.New("db down")
+		})
+		assert.Error(t, err)
+		assert.Empty(t, calls)
+	})
+
+	t.Run("InvalidTransition", func(t *testing.T) {
+		err := newLights().Fire(ctx, nil, green, red, func(context.Context) error {
+			t.Fatal("apply must not run")
+			return nil
+		})
+		assert.ErrorIs(t, err, ErrInvalidTransition)
+	})
+}
+
+func TestMachine_UnknownStatePanics(t *testing.T) {
+	assert.Panics(t, func() {
+		New[light, any]("light", red, green).Permit(red, yellow)
+	})
+}
+
+func TestMachine_Mermaid(t *testing.T) {
+	want := `stateDiagram-v2
+    [*] --> RED
+    RED --> GREEN
+    RED --> OFF
+    GREEN --> YELLOW
+    GREEN --> OFF
+    YELLOW --> RED
+    YELLOW --> OFF
+    OFF --> [*]
+`
+	assert.Equal(t, want, newLights().Mermaid())
+}
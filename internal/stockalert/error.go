@@ -0,0 +1,16 @@
+package stockalert
+
+import (
+	"errors"
+	"fmt"
+	"warimas-be/internal/apperr"
+)
+
+var (
+	ErrUnauthenticated      = apperr.Unauthenticated("unauthenticated")
+	ErrVariantNotFound      = apperr.NotFound("variant not found")
+	ErrInvalidVariantID     = apperr.Invalid("invalid variant id")
+	ErrInStock              = apperr.Invalid("variant is in stock")
+	ErrTooManySubscriptions = apperr.Invalid(fmt.Sprintf("you can wait for at most %d variants", MaxSubscriptions))
+	ErrDB                   = errors.New("database error")
+)
@@ -0,0 +1,18 @@
+package stockalert
+
+import (
+	"strconv"
+	"warimas-be/internal/graph/model"
+)
+
+func MapSubscriptionToGraphQL(s *Subscription) *model.BackInStockSubscription {
+	return &model.BackInStockSubscription{
+		ID:          strconv.FormatInt(s.ID, 10),
+		VariantID:   s.VariantID,
+		ProductID:   s.ProductID,
+		ProductName: s.ProductName,
+		VariantName: s.VariantName,
+		ImageURL:    s.ImageURL,
+		CreatedAt:   s.CreatedAt,
+	}
+}
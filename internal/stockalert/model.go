@@ -0,0 +1,42 @@
+package stockalert
+
+import (
+	"time"
+	"warimas-be/internal/preference"
+)
+
+// NotifyInterval is how often the job looks for restocked variants.
+const NotifyInterval = time.Minute
+
+const (
+	// MaxSubscriptions caps how many variants one customer waits for.
+	MaxSubscriptions = 50
+	// maxNotifyPerRun caps the notices one job run sends; the rest go out
+	// on the next run.
+	maxNotifyPerRun = 1000
+	notifyBatchSize = int32(100)
+)
+
+// Subscription is a customer waiting for a sold-out variant.
+type Subscription struct {
+	ID          int64
+	UserID      int32
+	VariantID   string
+	ProductID   string
+	ProductName string
+	VariantName string
+	ImageURL    *string
+	CreatedAt   time.Time
+}
+
+// Restock is a subscription whose variant is back in stock. Wishlisted is
+// true when the customer also has the variant on their wishlist and so
+// already gets the wishlist's back-in-stock alert.
+type Restock struct {
+	Subscription
+	Price      float64
+	Wishlisted bool
+	// Delivery is filled in before notifying, from the customer's product
+	// alert preferences.
+	Delivery *preference.Delivery
+}
@@ -0,0 +1,34 @@
+package stockalert
+
+import (
+	"context"
+	"warimas-be/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// Notifier tells customers a variant they wait for is back in stock, on
+// the channels of each Restock's Delivery. The job deletes the subscriptions only after NotifyRestocked succeeds, so a
+// failed delivery is retried on the next run.
+type Notifier interface {
+	NotifyRestocked(ctx context.Context, restocks []*Restock) error
+}
+
+// LogNotifier writes each notice at info level until a push or email
+// provider is wired in.
+type LogNotifier struct{}
+
+func (LogNotifier) NotifyRestocked(ctx context.Context, restocks []*Restock) error {
+	log := logger.FromCtx(ctx)
+	for _, r := range restocks {
+		log.Info("back in stock",
+			zap.Int64("subscription_id", r.ID),
+			zap.Int32("user_id", r.UserID),
+			zap.String("variant_id", r.VariantID),
+			zap.Float64("price", r.Price),
+			zap.Any("channels", r.Delivery.Channels),
+			zap.String("locale", r.Delivery.Locale),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,222 @@
+package stockalert
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"warimas-be/internal/logger"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+type Repository interface {
+	// VariantStock returns the stock of a variant that can be bought,
+	// ErrVariantNotFound when it is missing or not on sale.
+	VariantStock(ctx context.Context, variantID string) (int32, error)
+	// Add subscribes the user to the variant; adding it again is a no-op.
+	// It fails with ErrTooManySubscriptions once the user has max.
+	Add(ctx context.Context, userID int32, variantID string, max int) (*Subscription, error)
+	Remove(ctx context.Context, userID int32, variantID string) (bool, error)
+	ListByUser(ctx context.Context, userID int32) ([]*Subscription, error)
+
+	// ListRestocked returns the oldest subscriptions whose variant is in
+	// stock and on sale again.
+	ListRestocked(ctx context.Context, limit int32) ([]*Restock, error)
+	Delete(ctx context.Context, ids []int64) error
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+const subscriptionColumns = `
+	s.id, s.user_id, s.variant_id, p.id, p.name, v.name, v.imageurl, s.created_at
+`
+
+const subscriptionJoins = `
+	FROM stock_subscriptions s
+	JOIN variants v ON v.id = s.variant_id
+	JOIN products p ON p.id = v.product_id
+`
+
+// onSale matches variants that can be bought.
+const onSale = `p.status = 'active' AND v.is_active`
+
+func scanSubscription(row interface{ Scan(...any) error }, extra ...any) (*Subscription, error) {
+	var s Subscription
+	dest := append([]any{
+		&s.ID, &s.UserID, &s.VariantID, &s.ProductID, &s.ProductName,
+		&s.VariantName, &s.ImageURL, &s.CreatedAt,
+	}, extra...)
+	if err := row.Scan(dest...); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (r *repository) VariantStock(ctx context.Context, variantID string) (int32, error) {
+	var stock int32
+	err := r.db.QueryRowContext(ctx, `
+		SELECT v.stock
+		FROM variants v
+		JOIN products p ON p.id = v.product_id
+		WHERE v.id = $1
+		  AND `+onSale, variantID).Scan(&stock)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, ErrVariantNotFound
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to read variant stock", zap.Error(err))
+		return 0, ErrDB
+	}
+	return stock, nil
+}
+
+func (r *repository) Add(ctx context.Context, userID int32, variantID string, max int) (*Subscription, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "Add"),
+		zap.Int32("user_id", userID),
+		zap.String("variant_id", variantID),
+	)
+
+	// The count guard lets a second request for the same variant through
+	// the conflict clause even at the cap.
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO stock_subscriptions (user_id, variant_id)
+		SELECT $1, $2
+		WHERE (SELECT COUNT(1) FROM stock_subscriptions WHERE user_id = $1) < $3
+		ON CONFLICT (user_id, variant_id) DO NOTHING
+	`, userID, variantID, max)
+	if err != nil {
+		log.Error("failed to insert stock subscription", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	s, err := scanSubscription(r.db.QueryRowContext(ctx, `
+		SELECT `+subscriptionColumns+subscriptionJoins+`
+		WHERE s.user_id = $1
+		  AND s.variant_id = $2
+	`, userID, variantID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrTooManySubscriptions
+	}
+	if err != nil {
+		log.Error("failed to load stock subscription", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return s, nil
+}
+
+func (r *repository) Remove(ctx context.Context, userID int32, variantID string) (bool, error) {
+	res, err := r.db.ExecContext(ctx, `
+		DELETE FROM stock_subscriptions
+		WHERE user_id = $1
+		  AND variant_id = $2
+	`, userID, variantID)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to delete stock subscription", zap.Error(err))
+		return false, ErrDB
+	}
+
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
+
+func (r *repository) ListByUser(ctx context.Context, userID int32) ([]*Subscription, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ListByUser"),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+subscriptionColumns+subscriptionJoins+`
+		WHERE s.user_id = $1
+		ORDER BY s.created_at DESC, s.id DESC
+	`, userID)
+	if err != nil {
+		log.Error("failed to query stock subscriptions", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	list := []*Subscription{}
+	for rows.Next() {
+		s, err := scanSubscription(rows)
+		if err != nil {
+			log.Error("failed to scan stock subscription", zap.Error(err))
+			return nil, ErrDB
+		}
+		list = append(list, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error("stock subscription iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return list, nil
+}
+
+func (r *repository) ListRestocked(ctx context.Context, limit int32) ([]*Restock, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ListRestocked"),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+subscriptionColumns+`,
+		       v.price,
+		       EXISTS (
+		           SELECT 1 FROM wishlist_items w
+		           WHERE w.user_id = s.user_id
+		             AND w.variant_id = s.variant_id
+		       )
+		`+subscriptionJoins+`
+		WHERE v.stock > 0
+		  AND `+onSale+`
+		ORDER BY s.id
+		LIMIT $1
+	`, limit)
+	if err != nil {
+		log.Error("failed to query restocked subscriptions", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	list := []*Restock{}
+	for rows.Next() {
+		var rs Restock
+		s, err := scanSubscription(rows, &rs.Price, &rs.Wishlisted)
+		if err != nil {
+			log.Error("failed to scan restocked subscription", zap.Error(err))
+			return nil, ErrDB
+		}
+		rs.Subscription = *s
+		list = append(list, &rs)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error("restocked subscription iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return list, nil
+}
+
+func (r *repository) Delete(ctx context.Context, ids []int64) error {
+	_, err := r.db.ExecContext(ctx, `
+		DELETE FROM stock_subscriptions WHERE id = ANY($1)
+	`, pq.Array(ids))
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to delete stock subscriptions", zap.Error(err))
+		return ErrDB
+	}
+	return nil
+}
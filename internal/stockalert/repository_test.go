@@ -0,0 +1,98 @@
+package stockalert
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var subscriptionCols = []string{
+	"id", "user_id", "variant_id", "product_id", "product_name",
+	"variant_name", "imageurl", "created_at",
+}
+
+func TestRepository_VariantStock(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+
+	mock.ExpectQuery(`SELECT v.stock FROM variants v JOIN products p .* WHERE v.id = \$1 AND p.status = 'active' AND v.is_active`).
+		WithArgs(variantID).
+		WillReturnError(sql.ErrNoRows)
+
+	_, err = repo.VariantStock(ctx, variantID)
+	assert.ErrorIs(t, err, ErrVariantNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_Add(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectExec(`INSERT INTO stock_subscriptions .* WHERE \(SELECT COUNT\(1\) FROM stock_subscriptions WHERE user_id = \$1\) < \$3 ON CONFLICT \(user_id, variant_id\) DO NOTHING`).
+			WithArgs(int32(7), variantID, MaxSubscriptions).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(`SELECT .* FROM stock_subscriptions s JOIN variants v .* WHERE s.user_id = \$1 AND s.variant_id = \$2`).
+			WithArgs(int32(7), variantID).
+			WillReturnRows(sqlmock.NewRows(subscriptionCols).
+				AddRow(1, 7, variantID, "p-1", "Beras", "5kg", nil, time.Now()))
+
+		sub, err := repo.Add(ctx, 7, variantID, MaxSubscriptions)
+		assert.NoError(t, err)
+		assert.Equal(t, "Beras", sub.ProductName)
+	})
+
+	t.Run("AtCap", func(t *testing.T) {
+		mock.ExpectExec(`INSERT INTO stock_subscriptions`).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(`SELECT .* FROM stock_subscriptions s`).
+			WillReturnRows(sqlmock.NewRows(subscriptionCols))
+
+		_, err := repo.Add(ctx, 7, variantID, MaxSubscriptions)
+		assert.ErrorIs(t, err, ErrTooManySubscriptions)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ListRestocked(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+
+	mock.ExpectQuery(`SELECT .* EXISTS \( SELECT 1 FROM wishlist_items w .* WHERE v.stock > 0 AND p.status = 'active' AND v.is_active ORDER BY s.id LIMIT \$1`).
+		WithArgs(int32(100)).
+		WillReturnRows(sqlmock.NewRows(append(subscriptionCols, "price", "wishlisted")).
+			AddRow(1, 7, variantID, "p-1", "Beras", "5kg", nil, time.Now(), 65000.0, true))
+
+	list, err := repo.ListRestocked(ctx, 100)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, int64(1), list[0].ID)
+	assert.Equal(t, 65000.0, list[0].Price)
+	assert.True(t, list[0].Wishlisted)
+
+	mock.ExpectExec(`DELETE FROM stock_subscriptions WHERE id = ANY\(\$1\)`).
+		WithArgs(pq.Array([]int64{1})).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.NoError(t, repo.Delete(ctx, []int64{1}))
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,161 @@
+// Package stockalert lets customers wait for a sold-out variant and tells
+// them once it is back in stock.
+package stockalert
+
+import (
+	"context"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/preference"
+	"warimas-be/internal/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type Service interface {
+	// Subscribe waits for a sold-out variant; subscribing again returns
+	// the existing subscription.
+	Subscribe(ctx context.Context, variantID string) (*Subscription, error)
+	Unsubscribe(ctx context.Context, variantID string) (bool, error)
+	GetMySubscriptions(ctx context.Context) ([]*Subscription, error)
+
+	// NotifyRestocked tells subscribers their variant is back and deletes
+	// their subscriptions. It returns how many notices were sent.
+	NotifyRestocked(ctx context.Context) (int64, error)
+}
+
+// PreferenceGateway says how customers want product alerts sent.
+type PreferenceGateway interface {
+	DeliveriesFor(ctx context.Context, event preference.Event, userIDs []int32) map[int32]*preference.Delivery
+}
+
+type service struct {
+	repo     Repository
+	prefs    PreferenceGateway
+	notifier Notifier
+}
+
+func NewService(repo Repository, prefs PreferenceGateway, notifier Notifier) Service {
+	return &service{repo: repo, prefs: prefs, notifier: notifier}
+}
+
+func (s *service) Subscribe(ctx context.Context, variantID string) (*Subscription, error) {
+	userID, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uuid.Parse(variantID); err != nil {
+		return nil, ErrInvalidVariantID
+	}
+
+	stock, err := s.repo.VariantStock(ctx, variantID)
+	if err != nil {
+		return nil, err
+	}
+	if stock > 0 {
+		return nil, ErrInStock
+	}
+
+	return s.repo.Add(ctx, userID, variantID, MaxSubscriptions)
+}
+
+func (s *service) Unsubscribe(ctx context.Context, variantID string) (bool, error) {
+	userID, err := currentUser(ctx)
+	if err != nil {
+		return false, err
+	}
+	if _, err := uuid.Parse(variantID); err != nil {
+		return false, ErrInvalidVariantID
+	}
+	return s.repo.Remove(ctx, userID, variantID)
+}
+
+func (s *service) GetMySubscriptions(ctx context.Context) ([]*Subscription, error) {
+	userID, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListByUser(ctx, userID)
+}
+
+func (s *service) NotifyRestocked(ctx context.Context) (int64, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "NotifyRestocked"),
+	)
+
+	var sent int64
+	for sent < maxNotifyPerRun {
+		restocks, err := s.repo.ListRestocked(ctx, notifyBatchSize)
+		if err != nil {
+			return sent, err
+		}
+		if len(restocks) == 0 {
+			break
+		}
+
+		// Customers who also wishlisted the variant hear about it from
+		// the wishlist alert, and those who muted product alerts hear
+		// nothing; their subscriptions are just cleaned up.
+		notify := s.withDeliveries(ctx, restocks)
+		ids := make([]int64, 0, len(restocks))
+		for _, r := range restocks {
+			ids = append(ids, r.ID)
+		}
+
+		if len(notify) > 0 {
+			if err := s.notifier.NotifyRestocked(ctx, notify); err != nil {
+				log.Error("failed to send back-in-stock notices", zap.Error(err))
+				return sent, err
+			}
+		}
+		if err := s.repo.Delete(ctx, ids); err != nil {
+			return sent, err
+		}
+		sent += int64(len(notify))
+
+		if len(restocks) < int(notifyBatchSize) {
+			break
+		}
+	}
+
+	if sent > 0 {
+		log.Info("back-in-stock notices sent", zap.Int64("count", sent))
+	}
+	return sent, nil
+}
+
+// withDeliveries returns the restocks to notify, with Delivery set.
+func (s *service) withDeliveries(ctx context.Context, restocks []*Restock) []*Restock {
+	var userIDs []int32
+	seen := make(map[int32]bool, len(restocks))
+	for _, r := range restocks {
+		if !r.Wishlisted && !seen[r.UserID] {
+			seen[r.UserID] = true
+			userIDs = append(userIDs, r.UserID)
+		}
+	}
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	deliveries := s.prefs.DeliveriesFor(ctx, preference.EventProductAlerts, userIDs)
+	notify := make([]*Restock, 0, len(userIDs))
+	for _, r := range restocks {
+		if r.Wishlisted {
+			continue
+		}
+		if r.Delivery = deliveries[r.UserID]; !r.Delivery.Muted() {
+			notify = append(notify, r)
+		}
+	}
+	return notify
+}
+
+func currentUser(ctx context.Context) (int32, error) {
+	userID, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		return 0, ErrUnauthenticated
+	}
+	return int32(userID), nil
+}
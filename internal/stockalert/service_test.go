@@ -0,0 +1,215 @@
+package stockalert
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"warimas-be/internal/preference"
+	"warimas-be/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// --- Mocks ---
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) VariantStock(ctx context.Context, variantID string) (int32, error) {
+	args := m.Called(ctx, variantID)
+	return args.Get(0).(int32), args.Error(1)
+}
+
+func (m *MockRepository) Add(ctx context.Context, userID int32, variantID string, max int) (*Subscription, error) {
+	args := m.Called(ctx, userID, variantID, max)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Subscription), args.Error(1)
+}
+
+func (m *MockRepository) Remove(ctx context.Context, userID int32, variantID string) (bool, error) {
+	args := m.Called(ctx, userID, variantID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRepository) ListByUser(ctx context.Context, userID int32) ([]*Subscription, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Subscription), args.Error(1)
+}
+
+func (m *MockRepository) ListRestocked(ctx context.Context, limit int32) ([]*Restock, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Restock), args.Error(1)
+}
+
+func (m *MockRepository) Delete(ctx context.Context, ids []int64) error {
+	args := m.Called(ctx, ids)
+	return args.Error(0)
+}
+
+type MockNotifier struct {
+	mock.Mock
+}
+
+func (m *MockNotifier) NotifyRestocked(ctx context.Context, restocks []*Restock) error {
+	args := m.Called(ctx, restocks)
+	return args.Error(0)
+}
+
+type MockPreferences struct {
+	mock.Mock
+}
+
+func (m *MockPreferences) DeliveriesFor(ctx context.Context, event preference.Event, userIDs []int32) map[int32]*preference.Delivery {
+	return m.Called(ctx, event, userIDs).Get(0).(map[int32]*preference.Delivery)
+}
+
+// defaults gives each user the default product alert delivery.
+func defaults(userIDs ...int32) map[int32]*preference.Delivery {
+	out := make(map[int32]*preference.Delivery, len(userIDs))
+	for _, id := range userIDs {
+		out[id] = preference.DefaultDelivery(preference.EventProductAlerts)
+	}
+	return out
+}
+
+// --- Tests ---
+
+const variantID = "6f1c2a8e-3b0d-4c55-9a7e-2d1f0b8c4e11"
+
+func restock(id int64, userID int32, wishlisted bool) *Restock {
+	return &Restock{
+		Subscription: Subscription{ID: id, UserID: userID, VariantID: variantID},
+		Price:        65000,
+		Wishlisted:   wishlisted,
+	}
+}
+
+func TestService_Subscribe(t *testing.T) {
+	ctx := utils.SetUserContext(context.Background(), 7, "test@example.com", "USER")
+
+	t.Run("SoldOut", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil)
+
+		mockRepo.On("VariantStock", ctx, variantID).Return(int32(0), nil)
+		mockRepo.On("Add", ctx, int32(7), variantID, MaxSubscriptions).Return(&Subscription{ID: 1, VariantID: variantID}, nil)
+
+		sub, err := svc.Subscribe(ctx, variantID)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), sub.ID)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("InStock", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil)
+
+		mockRepo.On("VariantStock", ctx, variantID).Return(int32(4), nil)
+
+		_, err := svc.Subscribe(ctx, variantID)
+		assert.ErrorIs(t, err, ErrInStock)
+		mockRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("AtCap", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil)
+
+		mockRepo.On("VariantStock", ctx, variantID).Return(int32(0), nil)
+		mockRepo.On("Add", ctx, int32(7), variantID, MaxSubscriptions).Return(nil, ErrTooManySubscriptions)
+
+		_, err := svc.Subscribe(ctx, variantID)
+		assert.ErrorIs(t, err, ErrTooManySubscriptions)
+	})
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		svc := NewService(new(MockRepository), nil, nil)
+
+		_, err := svc.Subscribe(context.Background(), variantID)
+		assert.ErrorIs(t, err, ErrUnauthenticated)
+	})
+
+	t.Run("InvalidVariantID", func(t *testing.T) {
+		svc := NewService(new(MockRepository), nil, nil)
+
+		_, err := svc.Subscribe(ctx, "not-a-uuid")
+		assert.ErrorIs(t, err, ErrInvalidVariantID)
+	})
+}
+
+func TestService_NotifyRestocked(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("NotifiesAndCleansUp", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		prefs := new(MockPreferences)
+		notifier := new(MockNotifier)
+		svc := NewService(mockRepo, prefs, notifier)
+
+		batch := []*Restock{restock(1, 7, false), restock(2, 8, true), restock(3, 9, false), restock(4, 10, false)}
+		mockRepo.On("ListRestocked", ctx, notifyBatchSize).Return(batch, nil)
+		muted := &preference.Delivery{Locale: "id"}
+		prefs.On("DeliveriesFor", ctx, preference.EventProductAlerts, []int32{7, 9, 10}).Return(map[int32]*preference.Delivery{
+			7: preference.DefaultDelivery(preference.EventProductAlerts), 9: preference.DefaultDelivery(preference.EventProductAlerts), 10: muted,
+		})
+		// The wishlisted customer already gets the wishlist alert, and
+		// customer 10 muted product alerts
+		notifier.On("NotifyRestocked", ctx, []*Restock{batch[0], batch[2]}).Return(nil)
+		mockRepo.On("Delete", ctx, []int64{1, 2, 3, 4}).Return(nil)
+
+		n, err := svc.NotifyRestocked(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), n)
+		assert.Equal(t, []preference.Channel{preference.ChannelEmail, preference.ChannelPush}, batch[0].Delivery.Channels)
+		mockRepo.AssertExpectations(t)
+		notifier.AssertExpectations(t)
+	})
+
+	t.Run("NotifyFailureKeepsSubscriptions", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		prefs := new(MockPreferences)
+		notifier := new(MockNotifier)
+		svc := NewService(mockRepo, prefs, notifier)
+
+		mockRepo.On("ListRestocked", ctx, notifyBatchSize).Return([]*Restock{restock(1, 7, false)}, nil)
+		prefs.On("DeliveriesFor", ctx, preference.EventProductAlerts, []int32{7}).Return(defaults(7))
+		notifier.On("NotifyRestocked", ctx, mock.Anything).Return(errors.New("push down"))
+
+		_, err := svc.NotifyRestocked(ctx)
+		assert.Error(t, err)
+		mockRepo.AssertNotCalled(t, "Delete", mock.Anything, mock.Anything)
+	})
+
+	t.Run("CappedPerRun", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		prefs := new(MockPreferences)
+		notifier := new(MockNotifier)
+		svc := NewService(mockRepo, prefs, notifier)
+
+		full := make([]*Restock, notifyBatchSize)
+		userIDs := make([]int32, notifyBatchSize)
+		for i := range full {
+			full[i] = restock(int64(i+1), int32(i+1), false)
+			userIDs[i] = int32(i + 1)
+		}
+		mockRepo.On("ListRestocked", ctx, notifyBatchSize).Return(full, nil)
+		prefs.On("DeliveriesFor", ctx, preference.EventProductAlerts, userIDs).Return(defaults(userIDs...))
+		notifier.On("NotifyRestocked", ctx, full).Return(nil)
+		mockRepo.On("Delete", ctx, mock.Anything).Return(nil)
+
+		n, err := svc.NotifyRestocked(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(maxNotifyPerRun), n)
+		mockRepo.AssertNumberOfCalls(t, "ListRestocked", maxNotifyPerRun/int(notifyBatchSize))
+	})
+}
@@ -0,0 +1,28 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"warimas-be/internal/apperr"
+)
+
+var (
+	ErrNotSeller        = errors.New("unauthorized: seller ID not found in context")
+	ErrStoreNotFound    = apperr.NotFound("store not found")
+	ErrSlugTaken        = errors.New("store slug is already taken")
+	ErrInvalidSlug      = fmt.Errorf("slug must be %d-%d lowercase letters, digits or single dashes", minSlugLen, maxSlugLen)
+	ErrInvalidName      = fmt.Errorf("name must be 1-%d characters", maxNameLen)
+	ErrInvalidLogoURL   = errors.New("logo URL must be an http or https URL")
+	ErrInvalidDesc      = fmt.Errorf("description must be at most %d characters", maxDescriptionLen)
+	ErrInvalidHours     = errors.New("hours need a weekday from 1 to 7, given once, and HH:MM times with closing after opening")
+	ErrInvalidVacation  = errors.New("vacation must end after it starts and after now")
+	ErrInvalidMode      = errors.New("unknown vacation mode")
+	ErrInvalidMessage   = fmt.Errorf("vacation message must be at most %d characters", maxMessageLen)
+	ErrVacationOverlap  = errors.New("vacation overlaps another one")
+	ErrVacationNotFound = apperr.NotFound("vacation not found or already over")
+	ErrInvalidOrigin    = fmt.Errorf("origin needs a label and contact name of at most %d characters, a phone, an address line, city, province and postal code", maxOriginLabelLen)
+	ErrOriginNotFound   = apperr.NotFound("shipping origin not found")
+	ErrProductNotFound  = apperr.NotFound("product not found")
+	ErrDB               = errors.New("database error")
+	PgUniqueViolation   = "23505"
+)
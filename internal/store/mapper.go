@@ -0,0 +1,97 @@
+package store
+
+import (
+	"strconv"
+	"warimas-be/internal/geo"
+	"warimas-be/internal/graph/model"
+)
+
+func MapStoreToGraphQL(s *Store) *model.Store {
+	hours := make([]*model.StoreOperatingHours, 0, len(s.Hours))
+	for _, h := range s.Hours {
+		hours = append(hours, &model.StoreOperatingHours{
+			Weekday:  WeekdayToGraphQL(h.Weekday),
+			OpensAt:  h.Opens,
+			ClosesAt: h.Closes,
+		})
+	}
+
+	var vacation *model.StoreVacation
+	if s.Vacation != nil {
+		vacation = MapVacationToGraphQL(s.Vacation)
+	}
+
+	return &model.Store{
+		SellerID:       s.SellerID,
+		Slug:           s.Slug,
+		Name:           s.Name,
+		LogoURL:        s.LogoURL,
+		Description:    s.Description,
+		ProductCount:   s.ProductCount,
+		OperatingHours: hours,
+		OpenNow:        s.OpenNow,
+		Vacation:       vacation,
+		CreatedAt:      s.CreatedAt,
+		UpdatedAt:      s.UpdatedAt,
+	}
+}
+
+func MapVacationToGraphQL(v *Vacation) *model.StoreVacation {
+	return &model.StoreVacation{
+		ID:        strconv.FormatInt(v.ID, 10),
+		StartsAt:  v.StartsAt,
+		EndsAt:    v.EndsAt,
+		Mode:      model.StoreVacationMode(v.Mode),
+		Message:   v.Message,
+		CreatedAt: v.CreatedAt,
+	}
+}
+
+// WeekdayToGraphQL maps an ISO weekday, 1 for Monday, to the enum.
+func WeekdayToGraphQL(weekday int) model.Weekday {
+	return model.AllWeekday[weekday-1]
+}
+
+// WeekdayFromGraphQL maps the enum to an ISO weekday, 1 for Monday, or 0
+// when it is not a weekday.
+func WeekdayFromGraphQL(w model.Weekday) int {
+	for i, d := range model.AllWeekday {
+		if d == w {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+func MapOriginToGraphQL(o *Origin) *model.StoreShippingOrigin {
+	return &model.StoreShippingOrigin{
+		ID:           o.ID,
+		Label:        o.Label,
+		ContactName:  o.ContactName,
+		Phone:        o.Phone,
+		AddressLine1: o.Address1,
+		AddressLine2: o.Address2,
+		City:         o.City,
+		Province:     o.Province,
+		PostalCode:   o.Postal,
+		Location:     geo.MapPointToGraphQL(o.Location),
+		IsDefault:    o.IsDefault,
+		CreatedAt:    o.CreatedAt,
+		UpdatedAt:    o.UpdatedAt,
+	}
+}
+
+func MapOriginInputFromGraphQL(in model.StoreShippingOriginInput) OriginInput {
+	return OriginInput{
+		Label:       in.Label,
+		ContactName: in.ContactName,
+		Phone:       in.Phone,
+		Address1:    in.AddressLine1,
+		Address2:    in.AddressLine2,
+		City:        in.City,
+		Province:    in.Province,
+		Postal:      in.PostalCode,
+		Location:    geo.MapPointFromGraphQL(in.Location),
+		IsDefault:   in.IsDefault != nil && *in.IsDefault,
+	}
+}
@@ -0,0 +1,123 @@
+package store
+
+import (
+	"time"
+	"warimas-be/internal/geo"
+)
+
+const (
+	minSlugLen        = 3
+	maxSlugLen        = 60
+	maxNameLen        = 150
+	maxDescriptionLen = 2000
+	maxMessageLen     = 500
+	maxOriginLabelLen = 100
+
+	// hoursLayout is how opening and closing times are written.
+	hoursLayout = "15:04"
+)
+
+// Store is a seller's public storefront. Hours, Vacation and OpenNow are
+// filled in by the service: Vacation is the one running now, if any, and
+// OpenNow is nil when the seller has not set opening hours.
+type Store struct {
+	SellerID     string
+	Slug         string
+	Name         string
+	LogoURL      *string
+	Description  *string
+	ProductCount int32
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+
+	Hours    []*OperatingHours
+	Vacation *Vacation
+	OpenNow  *bool
+}
+
+// UpdateInput changes the fields that are set. An empty LogoURL or
+// Description clears it.
+type UpdateInput struct {
+	Slug        *string
+	Name        *string
+	LogoURL     *string
+	Description *string
+}
+
+// OperatingHours is when a store is open on one weekday, in Jakarta time.
+// Weekday is ISO: 1 is Monday, 7 is Sunday. Opens and Closes are "15:04".
+type OperatingHours struct {
+	Weekday int
+	Opens   string
+	Closes  string
+}
+
+// VacationMode decides what shoppers see while a seller is away.
+type VacationMode string
+
+const (
+	// VacationModeHidden takes the seller's products out of the catalogue.
+	VacationModeHidden VacationMode = "HIDDEN"
+	// VacationModeUnavailable keeps them listed but not purchasable.
+	VacationModeUnavailable VacationMode = "UNAVAILABLE"
+)
+
+func (m VacationMode) valid() bool {
+	return m == VacationModeHidden || m == VacationModeUnavailable
+}
+
+// Vacation is a period in which a seller takes no orders. It ends by
+// itself at EndsAt.
+type Vacation struct {
+	ID        int64
+	SellerID  string
+	StartsAt  time.Time
+	EndsAt    time.Time
+	Mode      VacationMode
+	Message   *string
+	CreatedAt time.Time
+}
+
+type VacationInput struct {
+	StartsAt time.Time
+	EndsAt   time.Time
+	Mode     VacationMode
+	Message  *string
+}
+
+// Origin is an address a seller ships from. The default one is used for
+// products without an origin of their own.
+type Origin struct {
+	ID          string
+	SellerID    string
+	Label       string
+	ContactName string
+	Phone       string
+	Address1    string
+	Address2    *string
+	City        string
+	Province    string
+	Postal      string
+	// Location is where the origin sits; instant delivery is only sent
+	// from origins that have one.
+	Location  *geo.Point
+	IsDefault bool
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// OriginInput creates or replaces an origin. IsDefault makes it the
+// default; false leaves the current default alone, since a seller with
+// origins always has one.
+type OriginInput struct {
+	Label       string
+	ContactName string
+	Phone       string
+	Address1    string
+	Address2    *string
+	City        string
+	Province    string
+	Postal      string
+	Location    *geo.Point
+	IsDefault   bool
+}
@@ -0,0 +1,668 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+	"warimas-be/internal/geo"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/moderation"
+	"warimas-be/internal/sqlbuilder"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+type Repository interface {
+	// GetBySlug returns the store of an active seller.
+	GetBySlug(ctx context.Context, slug string) (*Store, error)
+	GetBySellerID(ctx context.Context, sellerID string) (*Store, error)
+	Update(ctx context.Context, sellerID string, input UpdateInput) (*Store, error)
+
+	ListHours(ctx context.Context, sellerID string) ([]*OperatingHours, error)
+	// ReplaceHours swaps the seller's whole week for hours.
+	ReplaceHours(ctx context.Context, sellerID string, hours []*OperatingHours) error
+
+	// CurrentVacation returns the vacation running at at, or nil.
+	CurrentVacation(ctx context.Context, sellerID string, at time.Time) (*Vacation, error)
+	// ListVacations returns the vacations ending after at, soonest first.
+	ListVacations(ctx context.Context, sellerID string, at time.Time) ([]*Vacation, error)
+	// AddVacation fails with ErrVacationOverlap when the seller already
+	// has a vacation overlapping the new one.
+	AddVacation(ctx context.Context, sellerID string, input VacationInput) (*Vacation, error)
+	// EndVacation deletes a vacation that has not started at at and cuts
+	// a running one short at at. It reports false for anything else.
+	EndVacation(ctx context.Context, sellerID string, id int64, at time.Time) (bool, error)
+
+	// ListOrigins returns the seller's origins, the default first.
+	ListOrigins(ctx context.Context, sellerID string) ([]*Origin, error)
+	// CreateOrigin makes the origin the default when asked to or when it
+	// is the seller's first.
+	CreateOrigin(ctx context.Context, sellerID string, input OriginInput) (*Origin, error)
+	UpdateOrigin(ctx context.Context, sellerID, id string, input OriginInput) (*Origin, error)
+	// DeleteOrigin hands the default over to the oldest origin left when
+	// the deleted one was the default.
+	DeleteOrigin(ctx context.Context, sellerID, id string) error
+	SetDefaultOrigin(ctx context.Context, sellerID, id string) error
+	// SetProductOrigin points one of the seller's products at one of their
+	// origins, or back at the default when originID is nil.
+	SetProductOrigin(ctx context.Context, sellerID, productID string, originID *string) error
+
+	ModerationContent(ctx context.Context, t moderation.ContentType, id string) (*moderation.Content, error)
+	RemoveModerationContent(ctx context.Context, t moderation.ContentType, id string) error
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+// storeColumns reads a store aliased st; the product count is of the
+// products shoppers can see.
+const storeColumns = `
+	st.seller_id, st.slug, st.name, st.logo_url, st.description,
+	(SELECT COUNT(*) FROM products p WHERE p.seller_id = st.seller_id AND p.status = 'active'),
+	st.created_at, st.updated_at
+`
+
+func scanStore(row interface{ Scan(...any) error }) (*Store, error) {
+	var s Store
+	if err := row.Scan(
+		&s.SellerID, &s.Slug, &s.Name, &s.LogoURL, &s.Description,
+		&s.ProductCount, &s.CreatedAt, &s.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func pqCode(err error) string {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return string(pqErr.Code)
+	}
+	return ""
+}
+
+func (r *repository) GetBySlug(ctx context.Context, slug string) (*Store, error) {
+	s, err := scanStore(r.db.QueryRowContext(ctx, `
+		SELECT `+storeColumns+`
+		FROM stores st
+		JOIN sellers sel ON sel.id = st.seller_id
+		WHERE st.slug = $1
+		  AND sel.deleted_at IS NULL
+	`, slug))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrStoreNotFound
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to get store by slug",
+			zap.String("slug", slug),
+			zap.Error(err),
+		)
+		return nil, ErrDB
+	}
+	return s, nil
+}
+
+func (r *repository) GetBySellerID(ctx context.Context, sellerID string) (*Store, error) {
+	s, err := scanStore(r.db.QueryRowContext(ctx, `
+		SELECT `+storeColumns+`
+		FROM stores st
+		WHERE st.seller_id = $1
+	`, sellerID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrStoreNotFound
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to get store by seller",
+			zap.String("seller_id", sellerID),
+			zap.Error(err),
+		)
+		return nil, ErrDB
+	}
+	return s, nil
+}
+
+func (r *repository) Update(ctx context.Context, sellerID string, input UpdateInput) (*Store, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "Update"),
+		zap.String("seller_id", sellerID),
+	)
+
+	qb := sqlbuilder.New()
+	sets := make([]string, 0, 4)
+	if input.Slug != nil {
+		sets = append(sets, "slug = "+qb.Arg(*input.Slug))
+	}
+	if input.Name != nil {
+		sets = append(sets, "name = "+qb.Arg(*input.Name))
+	}
+	if input.LogoURL != nil {
+		sets = append(sets, "logo_url = NULLIF("+qb.Arg(*input.LogoURL)+", '')")
+	}
+	if input.Description != nil {
+		sets = append(sets, "description = NULLIF("+qb.Arg(*input.Description)+", '')")
+	}
+	if len(sets) == 0 {
+		return r.GetBySellerID(ctx, sellerID)
+	}
+
+	s, err := scanStore(r.db.QueryRowContext(ctx, `
+		UPDATE stores st
+		SET `+strings.Join(sets, ", ")+`
+		WHERE st.seller_id = `+qb.Arg(sellerID)+`
+		RETURNING `+storeColumns,
+		qb.Args()...))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrStoreNotFound
+	}
+	if pqCode(err) == PgUniqueViolation {
+		return nil, ErrSlugTaken
+	}
+	if err != nil {
+		log.Error("failed to update store", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	log.Info("store updated")
+	return s, nil
+}
+
+func (r *repository) ListHours(ctx context.Context, sellerID string) ([]*OperatingHours, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT weekday, to_char(opens_at, 'HH24:MI'), to_char(closes_at, 'HH24:MI')
+		FROM store_operating_hours
+		WHERE seller_id = $1
+		ORDER BY weekday
+	`, sellerID)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to list store hours", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	hours := []*OperatingHours{}
+	for rows.Next() {
+		var h OperatingHours
+		if err := rows.Scan(&h.Weekday, &h.Opens, &h.Closes); err != nil {
+			logger.FromCtx(ctx).Error("failed to scan store hours", zap.Error(err))
+			return nil, ErrDB
+		}
+		hours = append(hours, &h)
+	}
+	if err := rows.Err(); err != nil {
+		logger.FromCtx(ctx).Error("failed to list store hours", zap.Error(err))
+		return nil, ErrDB
+	}
+	return hours, nil
+}
+
+func (r *repository) ReplaceHours(ctx context.Context, sellerID string, hours []*OperatingHours) error {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ReplaceHours"),
+		zap.String("seller_id", sellerID),
+	)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error("failed to begin transaction", zap.Error(err))
+		return ErrDB
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM store_operating_hours WHERE seller_id = $1
+	`, sellerID); err != nil {
+		log.Error("failed to clear store hours", zap.Error(err))
+		return ErrDB
+	}
+
+	for _, h := range hours {
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO store_operating_hours (seller_id, weekday, opens_at, closes_at)
+			VALUES ($1, $2, $3, $4)
+		`, sellerID, h.Weekday, h.Opens, h.Closes); err != nil {
+			log.Error("failed to insert store hours", zap.Int("weekday", h.Weekday), zap.Error(err))
+			return ErrDB
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Error("failed to commit store hours", zap.Error(err))
+		return ErrDB
+	}
+	return nil
+}
+
+const vacationColumns = `id, seller_id, starts_at, ends_at, mode, message, created_at`
+
+func scanVacation(row interface{ Scan(...any) error }) (*Vacation, error) {
+	var v Vacation
+	if err := row.Scan(
+		&v.ID, &v.SellerID, &v.StartsAt, &v.EndsAt, &v.Mode, &v.Message, &v.CreatedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+func (r *repository) CurrentVacation(ctx context.Context, sellerID string, at time.Time) (*Vacation, error) {
+	v, err := scanVacation(r.db.QueryRowContext(ctx, `
+		SELECT `+vacationColumns+`
+		FROM store_vacations
+		WHERE seller_id = $1
+		  AND starts_at <= $2
+		  AND ends_at > $2
+		LIMIT 1
+	`, sellerID, at))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to get current vacation", zap.Error(err))
+		return nil, ErrDB
+	}
+	return v, nil
+}
+
+func (r *repository) ListVacations(ctx context.Context, sellerID string, at time.Time) ([]*Vacation, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+vacationColumns+`
+		FROM store_vacations
+		WHERE seller_id = $1
+		  AND ends_at > $2
+		ORDER BY starts_at
+	`, sellerID, at)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to list vacations", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	vacations := []*Vacation{}
+	for rows.Next() {
+		v, err := scanVacation(rows)
+		if err != nil {
+			logger.FromCtx(ctx).Error("failed to scan vacation", zap.Error(err))
+			return nil, ErrDB
+		}
+		vacations = append(vacations, v)
+	}
+	if err := rows.Err(); err != nil {
+		logger.FromCtx(ctx).Error("failed to list vacations", zap.Error(err))
+		return nil, ErrDB
+	}
+	return vacations, nil
+}
+
+func (r *repository) AddVacation(ctx context.Context, sellerID string, input VacationInput) (*Vacation, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "AddVacation"),
+		zap.String("seller_id", sellerID),
+	)
+
+	v, err := scanVacation(r.db.QueryRowContext(ctx, `
+		INSERT INTO store_vacations (seller_id, starts_at, ends_at, mode, message)
+		SELECT $1, $2, $3, $4, $5
+		WHERE NOT EXISTS (
+			SELECT 1 FROM store_vacations
+			WHERE seller_id = $1
+			  AND starts_at < $3
+			  AND ends_at > $2
+		)
+		RETURNING `+vacationColumns,
+		sellerID, input.StartsAt, input.EndsAt, input.Mode, input.Message))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrVacationOverlap
+	}
+	if err != nil {
+		log.Error("failed to insert vacation", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	log.Info("vacation scheduled", zap.Int64("vacation_id", v.ID))
+	return v, nil
+}
+
+func (r *repository) EndVacation(ctx context.Context, sellerID string, id int64, at time.Time) (bool, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "EndVacation"),
+		zap.String("seller_id", sellerID),
+		zap.Int64("vacation_id", id),
+	)
+
+	res, err := r.db.ExecContext(ctx, `
+		DELETE FROM store_vacations
+		WHERE id = $1
+		  AND seller_id = $2
+		  AND starts_at > $3
+	`, id, sellerID, at)
+	if err != nil {
+		log.Error("failed to delete vacation", zap.Error(err))
+		return false, ErrDB
+	}
+	if n, _ := res.RowsAffected(); n > 0 {
+		return true, nil
+	}
+
+	res, err = r.db.ExecContext(ctx, `
+		UPDATE store_vacations
+		SET ends_at = $3
+		WHERE id = $1
+		  AND seller_id = $2
+		  AND starts_at <= $3
+		  AND ends_at > $3
+	`, id, sellerID, at)
+	if err != nil {
+		log.Error("failed to end vacation", zap.Error(err))
+		return false, ErrDB
+	}
+
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
+
+const originColumns = `
+	id, seller_id, label, contact_name, phone, address_line1, address_line2,
+	city, province, postal_code, latitude, longitude, is_default, created_at, updated_at
+`
+
+func scanOrigin(row interface{ Scan(...any) error }) (*Origin, error) {
+	var o Origin
+	var lat, lng *float64
+	if err := row.Scan(
+		&o.ID, &o.SellerID, &o.Label, &o.ContactName, &o.Phone, &o.Address1, &o.Address2,
+		&o.City, &o.Province, &o.Postal, &lat, &lng, &o.IsDefault, &o.CreatedAt, &o.UpdatedAt,
+	); err != nil {
+		return nil, err
+	}
+	o.Location = geo.PointOf(lat, lng)
+	return &o, nil
+}
+
+func (r *repository) ListOrigins(ctx context.Context, sellerID string) ([]*Origin, error) {
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+originColumns+`
+		FROM seller_origins
+		WHERE seller_id = $1
+		ORDER BY is_default DESC, created_at
+	`, sellerID)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to list origins", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	origins := []*Origin{}
+	for rows.Next() {
+		o, err := scanOrigin(rows)
+		if err != nil {
+			logger.FromCtx(ctx).Error("failed to scan origin", zap.Error(err))
+			return nil, ErrDB
+		}
+		origins = append(origins, o)
+	}
+	if err := rows.Err(); err != nil {
+		logger.FromCtx(ctx).Error("failed to list origins", zap.Error(err))
+		return nil, ErrDB
+	}
+	return origins, nil
+}
+
+func (r *repository) CreateOrigin(ctx context.Context, sellerID string, input OriginInput) (*Origin, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "CreateOrigin"),
+		zap.String("seller_id", sellerID),
+	)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error("failed to begin transaction", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer tx.Rollback()
+
+	if input.IsDefault {
+		if err := clearDefaultOrigin(ctx, tx, sellerID); err != nil {
+			log.Error("failed to clear default origin", zap.Error(err))
+			return nil, ErrDB
+		}
+	}
+
+	lat, lng := input.Location.Coords()
+	o, err := scanOrigin(tx.QueryRowContext(ctx, `
+		INSERT INTO seller_origins (
+			seller_id, label, contact_name, phone, address_line1, address_line2,
+			city, province, postal_code, is_default, latitude, longitude
+		)
+		SELECT $1, $2, $3, $4, $5, $6, $7, $8, $9,
+			$10 OR NOT EXISTS (SELECT 1 FROM seller_origins WHERE seller_id = $1),
+			$11, $12
+		RETURNING `+originColumns,
+		sellerID, input.Label, input.ContactName, input.Phone, input.Address1, input.Address2,
+		input.City, input.Province, input.Postal, input.IsDefault, lat, lng))
+	if err != nil {
+		log.Error("failed to insert origin", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Error("failed to commit origin", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	log.Info("origin created", zap.String("origin_id", o.ID))
+	return o, nil
+}
+
+func (r *repository) UpdateOrigin(ctx context.Context, sellerID, id string, input OriginInput) (*Origin, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "UpdateOrigin"),
+		zap.String("seller_id", sellerID),
+		zap.String("origin_id", id),
+	)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error("failed to begin transaction", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer tx.Rollback()
+
+	if input.IsDefault {
+		if err := clearDefaultOrigin(ctx, tx, sellerID); err != nil {
+			log.Error("failed to clear default origin", zap.Error(err))
+			return nil, ErrDB
+		}
+	}
+
+	lat, lng := input.Location.Coords()
+	o, err := scanOrigin(tx.QueryRowContext(ctx, `
+		UPDATE seller_origins
+		SET label = $3, contact_name = $4, phone = $5,
+			address_line1 = $6, address_line2 = $7,
+			city = $8, province = $9, postal_code = $10,
+			is_default = is_default OR $11,
+			latitude = $12, longitude = $13
+		WHERE id = $1
+		  AND seller_id = $2
+		RETURNING `+originColumns,
+		id, sellerID, input.Label, input.ContactName, input.Phone, input.Address1, input.Address2,
+		input.City, input.Province, input.Postal, input.IsDefault, lat, lng))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrOriginNotFound
+	}
+	if err != nil {
+		log.Error("failed to update origin", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Error("failed to commit origin", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	log.Info("origin updated")
+	return o, nil
+}
+
+func (r *repository) DeleteOrigin(ctx context.Context, sellerID, id string) error {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "DeleteOrigin"),
+		zap.String("seller_id", sellerID),
+		zap.String("origin_id", id),
+	)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error("failed to begin transaction", zap.Error(err))
+		return ErrDB
+	}
+	defer tx.Rollback()
+
+	var wasDefault bool
+	err = tx.QueryRowContext(ctx, `
+		DELETE FROM seller_origins
+		WHERE id = $1
+		  AND seller_id = $2
+		RETURNING is_default
+	`, id, sellerID).Scan(&wasDefault)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrOriginNotFound
+	}
+	if err != nil {
+		log.Error("failed to delete origin", zap.Error(err))
+		return ErrDB
+	}
+
+	if wasDefault {
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE seller_origins
+			SET is_default = TRUE
+			WHERE id = (
+				SELECT id FROM seller_origins
+				WHERE seller_id = $1
+				ORDER BY created_at
+				LIMIT 1
+			)
+		`, sellerID); err != nil {
+			log.Error("failed to promote default origin", zap.Error(err))
+			return ErrDB
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Error("failed to commit origin delete", zap.Error(err))
+		return ErrDB
+	}
+
+	log.Info("origin deleted")
+	return nil
+}
+
+func (r *repository) SetDefaultOrigin(ctx context.Context, sellerID, id string) error {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "SetDefaultOrigin"),
+		zap.String("seller_id", sellerID),
+		zap.String("origin_id", id),
+	)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error("failed to begin transaction", zap.Error(err))
+		return ErrDB
+	}
+	defer tx.Rollback()
+
+	if err := clearDefaultOrigin(ctx, tx, sellerID); err != nil {
+		log.Error("failed to clear default origin", zap.Error(err))
+		return ErrDB
+	}
+
+	res, err := tx.ExecContext(ctx, `
+		UPDATE seller_origins
+		SET is_default = TRUE
+		WHERE id = $1
+		  AND seller_id = $2
+	`, id, sellerID)
+	if err != nil {
+		log.Error("failed to set default origin", zap.Error(err))
+		return ErrDB
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrOriginNotFound
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Error("failed to commit default origin", zap.Error(err))
+		return ErrDB
+	}
+	return nil
+}
+
+func clearDefaultOrigin(ctx context.Context, tx *sql.Tx, sellerID string) error {
+	_, err := tx.ExecContext(ctx, `
+		UPDATE seller_origins
+		SET is_default = FALSE
+		WHERE seller_id = $1
+		  AND is_default
+	`, sellerID)
+	return err
+}
+
+func (r *repository) SetProductOrigin(ctx context.Context, sellerID, productID string, originID *string) error {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "SetProductOrigin"),
+		zap.String("seller_id", sellerID),
+		zap.String("product_id", productID),
+	)
+
+	if originID != nil {
+		var exists bool
+		if err := r.db.QueryRowContext(ctx, `
+			SELECT EXISTS (
+				SELECT 1 FROM seller_origins
+				WHERE id = $1
+				  AND seller_id = $2
+			)
+		`, *originID, sellerID).Scan(&exists); err != nil {
+			log.Error("failed to check origin", zap.Error(err))
+			return ErrDB
+		}
+		if !exists {
+			return ErrOriginNotFound
+		}
+	}
+
+	res, err := r.db.ExecContext(ctx, `
+		UPDATE products
+		SET origin_id = $1
+		WHERE id = $2
+		  AND seller_id = $3
+	`, originID, productID, sellerID)
+	if err != nil {
+		log.Error("failed to set product origin", zap.Error(err))
+		return ErrDB
+	}
+	if n, _ := res.RowsAffected(); n == 0 {
+		return ErrProductNotFound
+	}
+
+	log.Info("product origin set")
+	return nil
+}
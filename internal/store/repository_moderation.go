@@ -0,0 +1,82 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/moderation"
+
+	"go.uber.org/zap"
+)
+
+// storeColumn is the stores column holding each content type the
+// moderation queue reads from stores.
+var storeColumn = map[moderation.ContentType]string{
+	moderation.ContentStoreName:        "name",
+	moderation.ContentStoreDescription: "description",
+	moderation.ContentStoreLogo:        "logo_url",
+}
+
+// ModerationContent serves a store's name, description or logo to the
+// moderation queue; id is the seller ID. The author is the user who owns
+// the seller (sellers.user_id).
+func (r *repository) ModerationContent(ctx context.Context, t moderation.ContentType, id string) (*moderation.Content, error) {
+	col, ok := storeColumn[t]
+	if !ok {
+		return nil, moderation.ErrInvalidContentType
+	}
+
+	var body sql.NullString
+	var author sql.NullInt32
+	err := r.db.QueryRowContext(ctx, `
+		SELECT st.`+col+`,
+			(SELECT s.user_id FROM sellers s WHERE s.id = st.seller_id)
+		FROM stores st
+		WHERE st.seller_id::text = $1
+	`, id).Scan(&body, &author)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && !body.Valid) {
+		return nil, nil
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to load store content",
+			zap.String("layer", "repository"),
+			zap.String("content_type", string(t)),
+			zap.String("seller_id", id),
+			zap.Error(err),
+		)
+		return nil, ErrDB
+	}
+
+	c := &moderation.Content{Type: t, ID: id, Body: body.String}
+	if author.Valid {
+		c.AuthorID = &author.Int32
+	}
+	return c, nil
+}
+
+// RemoveModerationContent clears a store's description or logo. A name
+// cannot be empty, so a removed name falls back to the store's slug.
+func (r *repository) RemoveModerationContent(ctx context.Context, t moderation.ContentType, id string) error {
+	var set string
+	switch t {
+	case moderation.ContentStoreName:
+		set = "name = slug"
+	case moderation.ContentStoreDescription, moderation.ContentStoreLogo:
+		set = storeColumn[t] + " = NULL"
+	default:
+		return moderation.ErrInvalidContentType
+	}
+
+	_, err := r.db.ExecContext(ctx, `UPDATE stores SET `+set+` WHERE seller_id::text = $1`, id)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to remove store content",
+			zap.String("layer", "repository"),
+			zap.String("content_type", string(t)),
+			zap.String("seller_id", id),
+			zap.Error(err),
+		)
+		return ErrDB
+	}
+	return nil
+}
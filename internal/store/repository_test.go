@@ -0,0 +1,287 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"warimas-be/internal/geo"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var storeRowColumns = []string{
+	"seller_id", "slug", "name", "logo_url", "description",
+	"product_count", "created_at", "updated_at",
+}
+
+func TestRepository_GetBySlug(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT .* p.status = 'active'.* FROM stores st JOIN sellers sel ON sel.id = st.seller_id WHERE st.slug = \$1 AND sel.deleted_at IS NULL`).
+			WithArgs("toko-beras").
+			WillReturnRows(sqlmock.NewRows(storeRowColumns).
+				AddRow(sellerID, "toko-beras", "Toko Beras", nil, "Beras pilihan", 12, now, now))
+
+		s, err := repo.GetBySlug(ctx, "toko-beras")
+		assert.NoError(t, err)
+		assert.Equal(t, "Toko Beras", s.Name)
+		assert.Nil(t, s.LogoURL)
+		assert.Equal(t, int32(12), s.ProductCount)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mock.ExpectQuery(`FROM stores st`).
+			WithArgs("nope").
+			WillReturnRows(sqlmock.NewRows(storeRowColumns))
+
+		_, err := repo.GetBySlug(ctx, "nope")
+		assert.ErrorIs(t, err, ErrStoreNotFound)
+	})
+
+	t.Run("DBError", func(t *testing.T) {
+		mock.ExpectQuery(`FROM stores st`).WillReturnError(errors.New("boom"))
+
+		_, err := repo.GetBySlug(ctx, "toko-beras")
+		assert.ErrorIs(t, err, ErrDB)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_Update(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run("SetsOnlyGivenFields", func(t *testing.T) {
+		mock.ExpectQuery(`UPDATE stores st SET slug = \$1, logo_url = NULLIF\(\$2, ''\) WHERE st.seller_id = \$3 RETURNING`).
+			WithArgs("toko-beras", "", sellerID).
+			WillReturnRows(sqlmock.NewRows(storeRowColumns).
+				AddRow(sellerID, "toko-beras", "Toko Beras", nil, nil, 0, now, now))
+
+		s, err := repo.Update(ctx, sellerID, UpdateInput{Slug: strPtr("toko-beras"), LogoURL: strPtr("")})
+		assert.NoError(t, err)
+		assert.Equal(t, "toko-beras", s.Slug)
+	})
+
+	t.Run("NothingToSet", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT .* FROM stores st WHERE st.seller_id = \$1`).
+			WithArgs(sellerID).
+			WillReturnRows(sqlmock.NewRows(storeRowColumns).
+				AddRow(sellerID, "toko-beras", "Toko Beras", nil, nil, 0, now, now))
+
+		_, err := repo.Update(ctx, sellerID, UpdateInput{})
+		assert.NoError(t, err)
+	})
+
+	t.Run("SlugTaken", func(t *testing.T) {
+		mock.ExpectQuery(`UPDATE stores st`).
+			WillReturnError(&pq.Error{Code: "23505"})
+
+		_, err := repo.Update(ctx, sellerID, UpdateInput{Slug: strPtr("taken")})
+		assert.ErrorIs(t, err, ErrSlugTaken)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_AddVacation(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+	now := time.Now()
+	in := VacationInput{StartsAt: now, EndsAt: now.Add(24 * time.Hour), Mode: VacationModeHidden}
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectQuery(`INSERT INTO store_vacations .* SELECT \$1, \$2, \$3, \$4, \$5 WHERE NOT EXISTS \( SELECT 1 FROM store_vacations WHERE seller_id = \$1 AND starts_at < \$3 AND ends_at > \$2 \) RETURNING`).
+			WithArgs(sellerID, in.StartsAt, in.EndsAt, in.Mode, nil).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "seller_id", "starts_at", "ends_at", "mode", "message", "created_at"}).
+				AddRow(3, sellerID, in.StartsAt, in.EndsAt, "HIDDEN", nil, now))
+
+		v, err := repo.AddVacation(ctx, sellerID, in)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), v.ID)
+		assert.Equal(t, VacationModeHidden, v.Mode)
+	})
+
+	t.Run("Overlap", func(t *testing.T) {
+		mock.ExpectQuery(`INSERT INTO store_vacations`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		_, err := repo.AddVacation(ctx, sellerID, in)
+		assert.ErrorIs(t, err, ErrVacationOverlap)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_EndVacation(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run("Upcoming_Deleted", func(t *testing.T) {
+		mock.ExpectExec(`DELETE FROM store_vacations WHERE id = \$1 AND seller_id = \$2 AND starts_at > \$3`).
+			WithArgs(int64(1), sellerID, now).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		ok, err := repo.EndVacation(ctx, sellerID, 1, now)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("Running_CutShort", func(t *testing.T) {
+		mock.ExpectExec(`DELETE FROM store_vacations`).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`UPDATE store_vacations SET ends_at = \$3 WHERE id = \$1 AND seller_id = \$2 AND starts_at <= \$3 AND ends_at > \$3`).
+			WithArgs(int64(2), sellerID, now).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		ok, err := repo.EndVacation(ctx, sellerID, 2, now)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("Over", func(t *testing.T) {
+		mock.ExpectExec(`DELETE FROM store_vacations`).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`UPDATE store_vacations`).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		ok, err := repo.EndVacation(ctx, sellerID, 3, now)
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+var originRowColumns = []string{
+	"id", "seller_id", "label", "contact_name", "phone", "address_line1", "address_line2",
+	"city", "province", "postal_code", "latitude", "longitude", "is_default", "created_at", "updated_at",
+}
+
+func TestRepository_CreateOrigin(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+	now := time.Now()
+	in := OriginInput{
+		Label: "Gudang", ContactName: "Budi", Phone: "0812", Address1: "Jl. Industri 5",
+		City: "Bekasi", Province: "Jawa Barat", Postal: "17520", IsDefault: true,
+		Location: &geo.Point{Lat: -6.3, Lng: 107.1},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE seller_origins SET is_default = FALSE WHERE seller_id = \$1 AND is_default`).
+		WithArgs(sellerID).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery(`INSERT INTO seller_origins .* \$10 OR NOT EXISTS \(SELECT 1 FROM seller_origins WHERE seller_id = \$1\), \$11, \$12 RETURNING`).
+		WithArgs(sellerID, in.Label, in.ContactName, in.Phone, in.Address1, nil, in.City, in.Province, in.Postal, true, -6.3, 107.1).
+		WillReturnRows(sqlmock.NewRows(originRowColumns).
+			AddRow("o1", sellerID, "Gudang", "Budi", "0812", "Jl. Industri 5", nil, "Bekasi", "Jawa Barat", "17520", -6.3, 107.1, true, now, now))
+	mock.ExpectCommit()
+
+	o, err := repo.CreateOrigin(ctx, sellerID, in)
+	require.NoError(t, err)
+	assert.Equal(t, "o1", o.ID)
+	assert.True(t, o.IsDefault)
+	assert.Equal(t, in.Location, o.Location)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_DeleteOrigin(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+
+	t.Run("DefaultHandedOver", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`DELETE FROM seller_origins WHERE id = \$1 AND seller_id = \$2 RETURNING is_default`).
+			WithArgs("o1", sellerID).
+			WillReturnRows(sqlmock.NewRows([]string{"is_default"}).AddRow(true))
+		mock.ExpectExec(`UPDATE seller_origins SET is_default = TRUE WHERE id = \( SELECT id FROM seller_origins WHERE seller_id = \$1 ORDER BY created_at LIMIT 1 \)`).
+			WithArgs(sellerID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		assert.NoError(t, repo.DeleteOrigin(ctx, sellerID, "o1"))
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`DELETE FROM seller_origins`).
+			WillReturnRows(sqlmock.NewRows([]string{"is_default"}))
+		mock.ExpectRollback()
+
+		assert.ErrorIs(t, repo.DeleteOrigin(ctx, sellerID, "o2"), ErrOriginNotFound)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_SetProductOrigin(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+	originID := "o1"
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT EXISTS \( SELECT 1 FROM seller_origins WHERE id = \$1 AND seller_id = \$2 \)`).
+			WithArgs(originID, sellerID).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+		mock.ExpectExec(`UPDATE products SET origin_id = \$1 WHERE id = \$2 AND seller_id = \$3`).
+			WithArgs(&originID, "p1", sellerID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		assert.NoError(t, repo.SetProductOrigin(ctx, sellerID, "p1", &originID))
+	})
+
+	t.Run("OtherSellersOrigin", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT EXISTS`).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+		assert.ErrorIs(t, repo.SetProductOrigin(ctx, sellerID, "p1", &originID), ErrOriginNotFound)
+	})
+
+	t.Run("BackToDefault_ProductNotFound", func(t *testing.T) {
+		mock.ExpectExec(`UPDATE products SET origin_id = \$1`).
+			WithArgs(nil, "p2", sellerID).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		assert.ErrorIs(t, repo.SetProductOrigin(ctx, sellerID, "p2", nil), ErrProductNotFound)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,403 @@
+package store
+
+import (
+	"context"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+	"warimas-be/internal/geo"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/moderation"
+	"warimas-be/internal/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type Service interface {
+	GetBySlug(ctx context.Context, slug string) (*Store, error)
+
+	// GetMine and UpdateMine act on the store of the seller in ctx.
+	GetMine(ctx context.Context) (*Store, error)
+	UpdateMine(ctx context.Context, input UpdateInput) (*Store, error)
+	// SetMyHours replaces the whole week; days left out are closed.
+	SetMyHours(ctx context.Context, hours []*OperatingHours) (*Store, error)
+	// GetMyVacations returns the running and upcoming vacations.
+	GetMyVacations(ctx context.Context) ([]*Vacation, error)
+	// ScheduleMyVacation starts the vacation now when StartsAt has passed.
+	ScheduleMyVacation(ctx context.Context, input VacationInput) (*Vacation, error)
+	// CancelMyVacation drops an upcoming vacation or ends a running one now.
+	CancelMyVacation(ctx context.Context, id int64) error
+
+	GetMyOrigins(ctx context.Context) ([]*Origin, error)
+	CreateMyOrigin(ctx context.Context, input OriginInput) (*Origin, error)
+	UpdateMyOrigin(ctx context.Context, id string, input OriginInput) (*Origin, error)
+	DeleteMyOrigin(ctx context.Context, id string) error
+	SetMyDefaultOrigin(ctx context.Context, id string) error
+	// SetMyProductOrigin sends a product from one of the seller's origins;
+	// a nil originID sends it from the default again.
+	SetMyProductOrigin(ctx context.Context, productID string, originID *string) error
+}
+
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// Screener queues store text for moderator review.
+type Screener interface {
+	Screen(ctx context.Context, c *moderation.Content)
+}
+
+type service struct {
+	repo     Repository
+	screener Screener
+	now      func() time.Time
+	loc      *time.Location
+}
+
+func NewService(repo Repository, screener Screener) Service {
+	loc, err := time.LoadLocation("Asia/Jakarta")
+	if err != nil {
+		logger.L().Error("failed to load Jakarta location, defaulting to UTC", zap.Error(err))
+		loc = time.UTC
+	}
+	return &service{repo: repo, screener: screener, now: time.Now, loc: loc}
+}
+
+func (s *service) GetBySlug(ctx context.Context, slug string) (*Store, error) {
+	st, err := s.repo.GetBySlug(ctx, strings.ToLower(strings.TrimSpace(slug)))
+	if err != nil {
+		return nil, err
+	}
+	return s.withSchedule(ctx, st)
+}
+
+func (s *service) GetMine(ctx context.Context) (*Store, error) {
+	sellerID, err := currentSeller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	st, err := s.repo.GetBySellerID(ctx, sellerID)
+	if err != nil {
+		return nil, err
+	}
+	return s.withSchedule(ctx, st)
+}
+
+func (s *service) UpdateMine(ctx context.Context, input UpdateInput) (*Store, error) {
+	sellerID, err := currentSeller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	in := UpdateInput{
+		Slug:        trimmed(input.Slug),
+		Name:        trimmed(input.Name),
+		LogoURL:     trimmed(input.LogoURL),
+		Description: trimmed(input.Description),
+	}
+
+	if in.Slug != nil {
+		lower := strings.ToLower(*in.Slug)
+		in.Slug = &lower
+		if len(lower) < minSlugLen || len(lower) > maxSlugLen || !slugPattern.MatchString(lower) {
+			return nil, ErrInvalidSlug
+		}
+	}
+	if in.Name != nil {
+		if n := utf8.RuneCountInString(*in.Name); n == 0 || n > maxNameLen {
+			return nil, ErrInvalidName
+		}
+	}
+	if in.LogoURL != nil && *in.LogoURL != "" && !isWebURL(*in.LogoURL) {
+		return nil, ErrInvalidLogoURL
+	}
+	if in.Description != nil && utf8.RuneCountInString(*in.Description) > maxDescriptionLen {
+		return nil, ErrInvalidDesc
+	}
+
+	st, err := s.repo.Update(ctx, sellerID, in)
+	if err != nil {
+		return nil, err
+	}
+
+	if in.Name != nil {
+		s.screen(ctx, moderation.ContentStoreName, sellerID, &st.Name)
+	}
+	if in.Description != nil {
+		s.screen(ctx, moderation.ContentStoreDescription, sellerID, st.Description)
+	}
+	return s.withSchedule(ctx, st)
+}
+
+// screen hands a store text just saved to moderation, authored by the
+// user who saved it.
+func (s *service) screen(ctx context.Context, t moderation.ContentType, sellerID string, body *string) {
+	if body == nil || *body == "" {
+		return
+	}
+	c := &moderation.Content{Type: t, ID: sellerID, Body: *body}
+	if userID, ok := utils.GetUserIDFromContext(ctx); ok {
+		author := int32(userID)
+		c.AuthorID = &author
+	}
+	s.screener.Screen(ctx, c)
+}
+
+func (s *service) SetMyHours(ctx context.Context, hours []*OperatingHours) (*Store, error) {
+	sellerID, err := currentSeller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	week := make([]*OperatingHours, 0, len(hours))
+	seen := make(map[int]bool, len(hours))
+	for _, h := range hours {
+		if h.Weekday < 1 || h.Weekday > 7 || seen[h.Weekday] {
+			return nil, ErrInvalidHours
+		}
+		seen[h.Weekday] = true
+
+		opens, err1 := time.Parse(hoursLayout, strings.TrimSpace(h.Opens))
+		closes, err2 := time.Parse(hoursLayout, strings.TrimSpace(h.Closes))
+		if err1 != nil || err2 != nil || !closes.After(opens) {
+			return nil, ErrInvalidHours
+		}
+		week = append(week, &OperatingHours{
+			Weekday: h.Weekday,
+			Opens:   opens.Format(hoursLayout),
+			Closes:  closes.Format(hoursLayout),
+		})
+	}
+
+	if err := s.repo.ReplaceHours(ctx, sellerID, week); err != nil {
+		return nil, err
+	}
+	return s.GetMine(ctx)
+}
+
+func (s *service) GetMyVacations(ctx context.Context) ([]*Vacation, error) {
+	sellerID, err := currentSeller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListVacations(ctx, sellerID, s.now())
+}
+
+func (s *service) ScheduleMyVacation(ctx context.Context, input VacationInput) (*Vacation, error) {
+	sellerID, err := currentSeller(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.now()
+	in := input
+	in.Message = trimmed(input.Message)
+	if in.StartsAt.Before(now) {
+		in.StartsAt = now
+	}
+
+	if !in.EndsAt.After(in.StartsAt) {
+		return nil, ErrInvalidVacation
+	}
+	if !in.Mode.valid() {
+		return nil, ErrInvalidMode
+	}
+	if in.Message != nil {
+		if *in.Message == "" {
+			in.Message = nil
+		} else if utf8.RuneCountInString(*in.Message) > maxMessageLen {
+			return nil, ErrInvalidMessage
+		}
+	}
+
+	return s.repo.AddVacation(ctx, sellerID, in)
+}
+
+func (s *service) CancelMyVacation(ctx context.Context, id int64) error {
+	sellerID, err := currentSeller(ctx)
+	if err != nil {
+		return err
+	}
+
+	ok, err := s.repo.EndVacation(ctx, sellerID, id, s.now())
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrVacationNotFound
+	}
+	return nil
+}
+
+func (s *service) GetMyOrigins(ctx context.Context) ([]*Origin, error) {
+	sellerID, err := currentSeller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.ListOrigins(ctx, sellerID)
+}
+
+func (s *service) CreateMyOrigin(ctx context.Context, input OriginInput) (*Origin, error) {
+	sellerID, err := currentSeller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	in, err := normalizeOrigin(input)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.CreateOrigin(ctx, sellerID, in)
+}
+
+func (s *service) UpdateMyOrigin(ctx context.Context, id string, input OriginInput) (*Origin, error) {
+	sellerID, err := currentSeller(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !isUUID(id) {
+		return nil, ErrOriginNotFound
+	}
+	in, err := normalizeOrigin(input)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.UpdateOrigin(ctx, sellerID, id, in)
+}
+
+func (s *service) DeleteMyOrigin(ctx context.Context, id string) error {
+	sellerID, err := currentSeller(ctx)
+	if err != nil {
+		return err
+	}
+	if !isUUID(id) {
+		return ErrOriginNotFound
+	}
+	return s.repo.DeleteOrigin(ctx, sellerID, id)
+}
+
+func (s *service) SetMyDefaultOrigin(ctx context.Context, id string) error {
+	sellerID, err := currentSeller(ctx)
+	if err != nil {
+		return err
+	}
+	if !isUUID(id) {
+		return ErrOriginNotFound
+	}
+	return s.repo.SetDefaultOrigin(ctx, sellerID, id)
+}
+
+func (s *service) SetMyProductOrigin(ctx context.Context, productID string, originID *string) error {
+	sellerID, err := currentSeller(ctx)
+	if err != nil {
+		return err
+	}
+	if !isUUID(productID) {
+		return ErrProductNotFound
+	}
+	if originID != nil && !isUUID(*originID) {
+		return ErrOriginNotFound
+	}
+	return s.repo.SetProductOrigin(ctx, sellerID, productID, originID)
+}
+
+// normalizeOrigin trims the input and checks every required field is
+// there. An empty second address line is dropped.
+func normalizeOrigin(input OriginInput) (OriginInput, error) {
+	in := OriginInput{
+		Label:       strings.TrimSpace(input.Label),
+		ContactName: strings.TrimSpace(input.ContactName),
+		Phone:       strings.TrimSpace(input.Phone),
+		Address1:    strings.TrimSpace(input.Address1),
+		Address2:    trimmed(input.Address2),
+		City:        strings.TrimSpace(input.City),
+		Province:    strings.TrimSpace(input.Province),
+		Postal:      strings.TrimSpace(input.Postal),
+		Location:    input.Location,
+		IsDefault:   input.IsDefault,
+	}
+	if in.Address2 != nil && *in.Address2 == "" {
+		in.Address2 = nil
+	}
+
+	for _, f := range []string{in.Label, in.ContactName, in.Phone, in.Address1, in.City, in.Province, in.Postal} {
+		if f == "" {
+			return in, ErrInvalidOrigin
+		}
+	}
+	if utf8.RuneCountInString(in.Label) > maxOriginLabelLen || utf8.RuneCountInString(in.ContactName) > maxOriginLabelLen {
+		return in, ErrInvalidOrigin
+	}
+	if in.Location != nil && !in.Location.Valid() {
+		return in, geo.ErrInvalidPoint
+	}
+	return in, nil
+}
+
+func isUUID(s string) bool {
+	_, err := uuid.Parse(s)
+	return err == nil
+}
+
+// withSchedule fills in the store's hours, running vacation and whether
+// it is open now.
+func (s *service) withSchedule(ctx context.Context, st *Store) (*Store, error) {
+	now := s.now()
+
+	hours, err := s.repo.ListHours(ctx, st.SellerID)
+	if err != nil {
+		return nil, err
+	}
+	vacation, err := s.repo.CurrentVacation(ctx, st.SellerID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	st.Hours = hours
+	st.Vacation = vacation
+	if len(hours) > 0 {
+		open := vacation == nil && openAt(hours, now.In(s.loc))
+		st.OpenNow = &open
+	}
+	return st, nil
+}
+
+// openAt reports whether t, in store time, falls inside that weekday's
+// hours. Closing time is exclusive.
+func openAt(hours []*OperatingHours, t time.Time) bool {
+	weekday := int(t.Weekday())
+	if weekday == 0 {
+		weekday = 7
+	}
+	clock := t.Format(hoursLayout)
+
+	for _, h := range hours {
+		if h.Weekday == weekday {
+			return clock >= h.Opens && clock < h.Closes
+		}
+	}
+	return false
+}
+
+func currentSeller(ctx context.Context) (string, error) {
+	sellerID, ok := utils.GetSellerIDFromContext(ctx)
+	if !ok {
+		return "", ErrNotSeller
+	}
+	return sellerID, nil
+}
+
+func trimmed(s *string) *string {
+	if s == nil {
+		return nil
+	}
+	t := strings.TrimSpace(*s)
+	return &t
+}
+
+// isWebURL reports whether s is an absolute http(s) URL, so a logo can
+// not smuggle another scheme onto the storefront.
+func isWebURL(s string) bool {
+	u, err := url.Parse(s)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
@@ -0,0 +1,483 @@
+package store
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+	"warimas-be/internal/moderation"
+	"warimas-be/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// --- Mocks ---
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) GetBySlug(ctx context.Context, slug string) (*Store, error) {
+	args := m.Called(ctx, slug)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Store), args.Error(1)
+}
+
+func (m *MockRepository) GetBySellerID(ctx context.Context, sellerID string) (*Store, error) {
+	args := m.Called(ctx, sellerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Store), args.Error(1)
+}
+
+func (m *MockRepository) Update(ctx context.Context, sellerID string, input UpdateInput) (*Store, error) {
+	args := m.Called(ctx, sellerID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Store), args.Error(1)
+}
+
+func (m *MockRepository) ListHours(ctx context.Context, sellerID string) ([]*OperatingHours, error) {
+	args := m.Called(ctx, sellerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*OperatingHours), args.Error(1)
+}
+
+func (m *MockRepository) ReplaceHours(ctx context.Context, sellerID string, hours []*OperatingHours) error {
+	args := m.Called(ctx, sellerID, hours)
+	return args.Error(0)
+}
+
+func (m *MockRepository) CurrentVacation(ctx context.Context, sellerID string, at time.Time) (*Vacation, error) {
+	args := m.Called(ctx, sellerID, at)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Vacation), args.Error(1)
+}
+
+func (m *MockRepository) ListVacations(ctx context.Context, sellerID string, at time.Time) ([]*Vacation, error) {
+	args := m.Called(ctx, sellerID, at)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Vacation), args.Error(1)
+}
+
+func (m *MockRepository) AddVacation(ctx context.Context, sellerID string, input VacationInput) (*Vacation, error) {
+	args := m.Called(ctx, sellerID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Vacation), args.Error(1)
+}
+
+func (m *MockRepository) EndVacation(ctx context.Context, sellerID string, id int64, at time.Time) (bool, error) {
+	args := m.Called(ctx, sellerID, id, at)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRepository) ListOrigins(ctx context.Context, sellerID string) ([]*Origin, error) {
+	args := m.Called(ctx, sellerID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Origin), args.Error(1)
+}
+
+func (m *MockRepository) CreateOrigin(ctx context.Context, sellerID string, input OriginInput) (*Origin, error) {
+	args := m.Called(ctx, sellerID, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Origin), args.Error(1)
+}
+
+func (m *MockRepository) UpdateOrigin(ctx context.Context, sellerID, id string, input OriginInput) (*Origin, error) {
+	args := m.Called(ctx, sellerID, id, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Origin), args.Error(1)
+}
+
+func (m *MockRepository) DeleteOrigin(ctx context.Context, sellerID, id string) error {
+	return m.Called(ctx, sellerID, id).Error(0)
+}
+
+func (m *MockRepository) SetDefaultOrigin(ctx context.Context, sellerID, id string) error {
+	return m.Called(ctx, sellerID, id).Error(0)
+}
+
+func (m *MockRepository) SetProductOrigin(ctx context.Context, sellerID, productID string, originID *string) error {
+	return m.Called(ctx, sellerID, productID, originID).Error(0)
+}
+
+func (m *MockRepository) ModerationContent(ctx context.Context, t moderation.ContentType, id string) (*moderation.Content, error) {
+	args := m.Called(ctx, t, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*moderation.Content), args.Error(1)
+}
+
+func (m *MockRepository) RemoveModerationContent(ctx context.Context, t moderation.ContentType, id string) error {
+	args := m.Called(ctx, t, id)
+	return args.Error(0)
+}
+
+type MockScreener struct {
+	mock.Mock
+}
+
+func (m *MockScreener) Screen(ctx context.Context, c *moderation.Content) {
+	m.Called(ctx, c)
+}
+
+const sellerID = "5f1c0e6a-0000-4000-8000-000000000001"
+
+// testNow is Wednesday 10:00 in Jakarta.
+var testNow = time.Date(2026, 10, 14, 3, 0, 0, 0, time.UTC)
+
+func newTestService(repo Repository) *service {
+	s := NewService(repo, nil).(*service)
+	s.now = func() time.Time { return testNow }
+	return s
+}
+
+func sellerCtx() context.Context {
+	return utils.WithPrincipal(context.Background(), &utils.Principal{SellerID: sellerID})
+}
+
+func strPtr(s string) *string { return &s }
+
+// expectNoSchedule stubs the schedule lookups for a store without hours
+// or a vacation.
+func expectNoSchedule(repo *MockRepository, ctx context.Context) {
+	repo.On("ListHours", ctx, sellerID).Return([]*OperatingHours{}, nil)
+	repo.On("CurrentVacation", ctx, sellerID, testNow).Return(nil, nil)
+}
+
+func TestService_GetBySlug(t *testing.T) {
+	t.Run("Normalises", func(t *testing.T) {
+		repo := new(MockRepository)
+		svc := newTestService(repo)
+		ctx := context.Background()
+
+		repo.On("GetBySlug", ctx, "toko-beras").Return(&Store{SellerID: sellerID, Slug: "toko-beras"}, nil)
+		expectNoSchedule(repo, ctx)
+
+		s, err := svc.GetBySlug(ctx, "  Toko-Beras ")
+		assert.NoError(t, err)
+		assert.Equal(t, "toko-beras", s.Slug)
+		assert.Nil(t, s.OpenNow)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("OpenNow", func(t *testing.T) {
+		repo := new(MockRepository)
+		svc := newTestService(repo)
+		ctx := context.Background()
+
+		repo.On("GetBySlug", ctx, "toko").Return(&Store{SellerID: sellerID}, nil)
+		repo.On("ListHours", ctx, sellerID).Return([]*OperatingHours{
+			{Weekday: 3, Opens: "09:00", Closes: "17:00"},
+		}, nil)
+		repo.On("CurrentVacation", ctx, sellerID, testNow).Return(nil, nil)
+
+		s, err := svc.GetBySlug(ctx, "toko")
+		assert.NoError(t, err)
+		if assert.NotNil(t, s.OpenNow) {
+			assert.True(t, *s.OpenNow)
+		}
+	})
+
+	t.Run("ClosedDuringVacation", func(t *testing.T) {
+		repo := new(MockRepository)
+		svc := newTestService(repo)
+		ctx := context.Background()
+		vacation := &Vacation{ID: 1, Mode: VacationModeUnavailable, EndsAt: testNow.Add(48 * time.Hour)}
+
+		repo.On("GetBySlug", ctx, "toko").Return(&Store{SellerID: sellerID}, nil)
+		repo.On("ListHours", ctx, sellerID).Return([]*OperatingHours{
+			{Weekday: 3, Opens: "09:00", Closes: "17:00"},
+		}, nil)
+		repo.On("CurrentVacation", ctx, sellerID, testNow).Return(vacation, nil)
+
+		s, err := svc.GetBySlug(ctx, "toko")
+		assert.NoError(t, err)
+		assert.Equal(t, vacation, s.Vacation)
+		if assert.NotNil(t, s.OpenNow) {
+			assert.False(t, *s.OpenNow)
+		}
+	})
+}
+
+func TestOpenAt(t *testing.T) {
+	jakarta := time.FixedZone("WIB", 7*60*60)
+	hours := []*OperatingHours{
+		{Weekday: 1, Opens: "08:00", Closes: "12:00"},
+		{Weekday: 7, Opens: "10:00", Closes: "14:00"},
+	}
+
+	cases := []struct {
+		name string
+		at   time.Time
+		want bool
+	}{
+		{"MondayOpen", time.Date(2026, 10, 12, 8, 0, 0, 0, jakarta), true},
+		{"MondayAtClosing", time.Date(2026, 10, 12, 12, 0, 0, 0, jakarta), false},
+		{"SundayIsSeven", time.Date(2026, 10, 18, 13, 59, 0, 0, jakarta), true},
+		{"TuesdayClosed", time.Date(2026, 10, 13, 9, 0, 0, 0, jakarta), false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, openAt(hours, tc.at))
+		})
+	}
+}
+
+func TestService_GetMine(t *testing.T) {
+	t.Run("NotSeller", func(t *testing.T) {
+		svc := newTestService(new(MockRepository))
+		_, err := svc.GetMine(context.Background())
+		assert.ErrorIs(t, err, ErrNotSeller)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		repo := new(MockRepository)
+		svc := newTestService(repo)
+		ctx := sellerCtx()
+		repo.On("GetBySellerID", ctx, sellerID).Return(&Store{SellerID: sellerID}, nil)
+		expectNoSchedule(repo, ctx)
+
+		s, err := svc.GetMine(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, sellerID, s.SellerID)
+	})
+}
+
+func TestService_UpdateMine(t *testing.T) {
+	t.Run("TrimsAndLowercases", func(t *testing.T) {
+		repo := new(MockRepository)
+		screener := new(MockScreener)
+		svc := newTestService(repo)
+		svc.screener = screener
+		ctx := sellerCtx()
+
+		want := UpdateInput{
+			Slug:        strPtr("toko-beras"),
+			Name:        strPtr("Toko Beras"),
+			LogoURL:     strPtr(""),
+			Description: strPtr("Beras pilihan"),
+		}
+		repo.On("Update", ctx, sellerID, want).Return(&Store{
+			SellerID: sellerID, Slug: "toko-beras", Name: "Toko Beras", Description: strPtr("Beras pilihan"),
+		}, nil)
+		expectNoSchedule(repo, ctx)
+		screener.On("Screen", ctx, &moderation.Content{Type: moderation.ContentStoreName, ID: sellerID, Body: "Toko Beras"}).Return()
+		screener.On("Screen", ctx, &moderation.Content{Type: moderation.ContentStoreDescription, ID: sellerID, Body: "Beras pilihan"}).Return()
+
+		_, err := svc.UpdateMine(ctx, UpdateInput{
+			Slug:        strPtr(" Toko-Beras "),
+			Name:        strPtr(" Toko Beras "),
+			LogoURL:     strPtr(" "),
+			Description: strPtr("Beras pilihan\n"),
+		})
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+		screener.AssertExpectations(t)
+	})
+
+	invalid := []struct {
+		name  string
+		input UpdateInput
+		err   error
+	}{
+		{"SlugTooShort", UpdateInput{Slug: strPtr("ab")}, ErrInvalidSlug},
+		{"SlugDoubleDash", UpdateInput{Slug: strPtr("toko--beras")}, ErrInvalidSlug},
+		{"SlugTrailingDash", UpdateInput{Slug: strPtr("toko-")}, ErrInvalidSlug},
+		{"SlugSymbols", UpdateInput{Slug: strPtr("toko_beras")}, ErrInvalidSlug},
+		{"EmptyName", UpdateInput{Name: strPtr("  ")}, ErrInvalidName},
+		{"LogoScheme", UpdateInput{LogoURL: strPtr("javascript:alert(1)")}, ErrInvalidLogoURL},
+		{"LogoRelative", UpdateInput{LogoURL: strPtr("/logo.png")}, ErrInvalidLogoURL},
+		{"LongDescription", UpdateInput{Description: strPtr(strings.Repeat("a", maxDescriptionLen+1))}, ErrInvalidDesc},
+	}
+	for _, tc := range invalid {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := new(MockRepository)
+			svc := newTestService(repo)
+
+			_, err := svc.UpdateMine(sellerCtx(), tc.input)
+			assert.ErrorIs(t, err, tc.err)
+			repo.AssertNotCalled(t, "Update", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+
+	t.Run("NotSeller", func(t *testing.T) {
+		svc := newTestService(new(MockRepository))
+		_, err := svc.UpdateMine(context.Background(), UpdateInput{Name: strPtr("Toko")})
+		assert.ErrorIs(t, err, ErrNotSeller)
+	})
+}
+
+func TestService_SetMyHours(t *testing.T) {
+	t.Run("NormalisesTimes", func(t *testing.T) {
+		repo := new(MockRepository)
+		svc := newTestService(repo)
+		ctx := sellerCtx()
+
+		repo.On("ReplaceHours", ctx, sellerID, []*OperatingHours{
+			{Weekday: 1, Opens: "08:00", Closes: "17:30"},
+		}).Return(nil)
+		repo.On("GetBySellerID", ctx, sellerID).Return(&Store{SellerID: sellerID}, nil)
+		expectNoSchedule(repo, ctx)
+
+		_, err := svc.SetMyHours(ctx, []*OperatingHours{
+			{Weekday: 1, Opens: "8:00", Closes: " 17:30"},
+		})
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	invalid := map[string][]*OperatingHours{
+		"BadWeekday":       {{Weekday: 8, Opens: "08:00", Closes: "17:00"}},
+		"RepeatedWeekday":  {{Weekday: 2, Opens: "08:00", Closes: "12:00"}, {Weekday: 2, Opens: "13:00", Closes: "17:00"}},
+		"ClosesBeforeOpen": {{Weekday: 2, Opens: "17:00", Closes: "08:00"}},
+		"BadTime":          {{Weekday: 2, Opens: "8am", Closes: "17:00"}},
+	}
+	for name, hours := range invalid {
+		t.Run(name, func(t *testing.T) {
+			repo := new(MockRepository)
+			svc := newTestService(repo)
+
+			_, err := svc.SetMyHours(sellerCtx(), hours)
+			assert.ErrorIs(t, err, ErrInvalidHours)
+			repo.AssertNotCalled(t, "ReplaceHours", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestService_ScheduleMyVacation(t *testing.T) {
+	t.Run("PastStartMeansNow", func(t *testing.T) {
+		repo := new(MockRepository)
+		svc := newTestService(repo)
+		ctx := sellerCtx()
+		ends := testNow.Add(72 * time.Hour)
+
+		repo.On("AddVacation", ctx, sellerID, VacationInput{
+			StartsAt: testNow,
+			EndsAt:   ends,
+			Mode:     VacationModeHidden,
+		}).Return(&Vacation{ID: 1}, nil)
+
+		_, err := svc.ScheduleMyVacation(ctx, VacationInput{
+			EndsAt:  ends,
+			Mode:    VacationModeHidden,
+			Message: strPtr("  "),
+		})
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	invalid := []struct {
+		name  string
+		input VacationInput
+		err   error
+	}{
+		{"EndsInPast", VacationInput{EndsAt: testNow.Add(-time.Hour), Mode: VacationModeHidden}, ErrInvalidVacation},
+		{"EndsBeforeStart", VacationInput{StartsAt: testNow.Add(48 * time.Hour), EndsAt: testNow.Add(24 * time.Hour), Mode: VacationModeHidden}, ErrInvalidVacation},
+		{"UnknownMode", VacationInput{EndsAt: testNow.Add(time.Hour), Mode: "CLOSED"}, ErrInvalidMode},
+		{"LongMessage", VacationInput{EndsAt: testNow.Add(time.Hour), Mode: VacationModeUnavailable, Message: strPtr(strings.Repeat("a", maxMessageLen+1))}, ErrInvalidMessage},
+	}
+	for _, tc := range invalid {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := new(MockRepository)
+			svc := newTestService(repo)
+
+			_, err := svc.ScheduleMyVacation(sellerCtx(), tc.input)
+			assert.ErrorIs(t, err, tc.err)
+			repo.AssertNotCalled(t, "AddVacation", mock.Anything, mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestService_CancelMyVacation(t *testing.T) {
+	repo := new(MockRepository)
+	svc := newTestService(repo)
+	ctx := sellerCtx()
+
+	repo.On("EndVacation", ctx, sellerID, int64(1), testNow).Return(true, nil)
+	repo.On("EndVacation", ctx, sellerID, int64(2), testNow).Return(false, nil)
+
+	assert.NoError(t, svc.CancelMyVacation(ctx, 1))
+	assert.ErrorIs(t, svc.CancelMyVacation(ctx, 2), ErrVacationNotFound)
+}
+
+func TestService_CreateMyOrigin(t *testing.T) {
+	valid := OriginInput{
+		Label:       " Gudang Bekasi ",
+		ContactName: "Budi",
+		Phone:       "0812000111",
+		Address1:    "Jl. Industri 5",
+		Address2:    strPtr(" "),
+		City:        "Bekasi",
+		Province:    "Jawa Barat",
+		Postal:      "17520",
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		repo := new(MockRepository)
+		svc := newTestService(repo)
+		ctx := sellerCtx()
+
+		want := valid
+		want.Label = "Gudang Bekasi"
+		want.Address2 = nil
+		repo.On("CreateOrigin", ctx, sellerID, want).Return(&Origin{ID: "o1", IsDefault: true}, nil)
+
+		o, err := svc.CreateMyOrigin(ctx, valid)
+		assert.NoError(t, err)
+		assert.True(t, o.IsDefault)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("MissingCity", func(t *testing.T) {
+		repo := new(MockRepository)
+		svc := newTestService(repo)
+
+		in := valid
+		in.City = "  "
+		_, err := svc.CreateMyOrigin(sellerCtx(), in)
+		assert.ErrorIs(t, err, ErrInvalidOrigin)
+		repo.AssertNotCalled(t, "CreateOrigin", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("NotSeller", func(t *testing.T) {
+		svc := newTestService(new(MockRepository))
+		_, err := svc.CreateMyOrigin(context.Background(), valid)
+		assert.ErrorIs(t, err, ErrNotSeller)
+	})
+}
+
+func TestService_SetMyProductOrigin(t *testing.T) {
+	repo := new(MockRepository)
+	svc := newTestService(repo)
+	ctx := sellerCtx()
+	productID := "7a2d0e6a-0000-4000-8000-000000000002"
+	originID := "8b3e0e6a-0000-4000-8000-000000000003"
+
+	repo.On("SetProductOrigin", ctx, sellerID, productID, &originID).Return(nil)
+
+	assert.NoError(t, svc.SetMyProductOrigin(ctx, productID, &originID))
+	assert.ErrorIs(t, svc.SetMyProductOrigin(ctx, "p1", nil), ErrProductNotFound)
+	assert.ErrorIs(t, svc.SetMyProductOrigin(ctx, productID, strPtr("o1")), ErrOriginNotFound)
+	repo.AssertNumberOfCalls(t, "SetProductOrigin", 1)
+}
@@ -0,0 +1,18 @@
+package synthetic
+
+import (
+	"errors"
+	"warimas-be/internal/apperr"
+)
+
+var (
+	ErrUnauthenticated = apperr.Unauthenticated("unauthenticated")
+	ErrForbidden       = apperr.Forbidden("admin access required")
+	ErrNotConfigured   = apperr.Invalid("synthetic checkout is not configured")
+	ErrRunning         = apperr.Conflict("a synthetic checkout is already running")
+	ErrDB              = errors.New("database error")
+
+	errNoAddress      = errors.New("canary user has no address")
+	errNoCancelReason = errors.New("no active cancellation reason")
+	errPaymentTimeout = errors.New("order was not paid in time")
+)
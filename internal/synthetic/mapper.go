@@ -0,0 +1,40 @@
+package synthetic
+
+import (
+	"strconv"
+	"warimas-be/internal/graph/model"
+)
+
+func MapRunToGraphQL(r *Run) *model.SyntheticCheckoutRun {
+	out := &model.SyntheticCheckoutRun{
+		ID:              strconv.FormatInt(r.ID, 10),
+		Passed:          r.Passed,
+		FailedStep:      r.FailedStep,
+		Error:           r.Error,
+		OrderExternalID: r.OrderExternalID,
+		Steps:           make([]*model.SyntheticCheckoutStep, 0, len(r.Steps)),
+		StartedAt:       r.StartedAt,
+		DurationMs:      int32(r.Duration.Milliseconds()),
+	}
+	for _, st := range r.Steps {
+		step := &model.SyntheticCheckoutStep{Name: st.Name, DurationMs: int32(st.DurationMs)}
+		if st.Error != "" {
+			e := st.Error
+			step.Error = &e
+		}
+		out.Steps = append(out.Steps, step)
+	}
+	if r.TriggeredBy != nil {
+		id := strconv.FormatInt(int64(*r.TriggeredBy), 10)
+		out.TriggeredBy = &id
+	}
+	return out
+}
+
+func MapRunsToGraphQL(runs []*Run) []*model.SyntheticCheckoutRun {
+	out := make([]*model.SyntheticCheckoutRun, 0, len(runs))
+	for _, r := range runs {
+		out = append(out, MapRunToGraphQL(r))
+	}
+	return out
+}
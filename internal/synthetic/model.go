@@ -0,0 +1,58 @@
+package synthetic
+
+import (
+	"time"
+)
+
+// Steps of a synthetic checkout, in the order they run.
+const (
+	StepCreateSession = "create_session"
+	StepSetAddress    = "set_address"
+	StepSetPayment    = "set_payment_method"
+	StepConfirm       = "confirm"
+	StepPayment       = "payment"
+	StepCleanup       = "cleanup"
+)
+
+const (
+	defaultRunsLimit = 20
+	maxRunsLimit     = 100
+	// paymentPoll is how often the order is checked while its simulated
+	// payment webhook is on its way.
+	paymentPoll = 500 * time.Millisecond
+)
+
+// Config names what the canary buys and as whom. It is off while
+// VariantID is empty.
+type Config struct {
+	UserID    uint
+	VariantID string
+	// PaymentTimeout is how long the order may take to be marked paid.
+	PaymentTimeout time.Duration
+}
+
+func (c Config) Enabled() bool {
+	return c.VariantID != "" && c.UserID != 0
+}
+
+// Step is how one step of a run went. Error is empty when it passed.
+type Step struct {
+	Name       string `json:"name"`
+	DurationMs int64  `json:"duration_ms"`
+	Error      string `json:"error,omitempty"`
+}
+
+// Run is one synthetic checkout. A failed run stops at FailedStep; the
+// order is cancelled again whenever one was placed.
+type Run struct {
+	ID              int64
+	Passed          bool
+	FailedStep      *string
+	Error           *string
+	OrderExternalID *string
+	Steps           []Step
+	StartedAt       time.Time
+	Duration        time.Duration
+	// TriggeredBy is the admin who ran it, nil for scheduled runs.
+	TriggeredBy *int32
+}
@@ -0,0 +1,110 @@
+package synthetic
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+	"warimas-be/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+type Repository interface {
+	// SaveRun stores run and sets its ID.
+	SaveRun(ctx context.Context, run *Run) error
+	// ListRuns returns the latest runs, newest first.
+	ListRuns(ctx context.Context, limit int32) ([]*Run, error)
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+func (r *repository) SaveRun(ctx context.Context, run *Run) error {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "SaveRun"),
+	)
+
+	steps, err := json.Marshal(run.Steps)
+	if err != nil {
+		log.Error("failed to encode steps", zap.Error(err))
+		return ErrDB
+	}
+
+	err = r.db.QueryRowContext(ctx, `
+		INSERT INTO synthetic_checkout_runs
+			(passed, failed_step, error, order_external_id, steps, started_at, duration_ms, triggered_by)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING id`,
+		run.Passed,
+		run.FailedStep,
+		run.Error,
+		run.OrderExternalID,
+		steps,
+		run.StartedAt,
+		run.Duration.Milliseconds(),
+		run.TriggeredBy,
+	).Scan(&run.ID)
+	if err != nil {
+		log.Error("failed to save synthetic checkout run", zap.Error(err))
+		return ErrDB
+	}
+	return nil
+}
+
+func (r *repository) ListRuns(ctx context.Context, limit int32) ([]*Run, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ListRuns"),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT id, passed, failed_step, error, order_external_id, steps,
+		       started_at, duration_ms, triggered_by
+		FROM synthetic_checkout_runs
+		ORDER BY started_at DESC
+		LIMIT $1`, limit)
+	if err != nil {
+		log.Error("failed to list synthetic checkout runs", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	var out []*Run
+	for rows.Next() {
+		var run Run
+		var steps []byte
+		var durationMs int64
+		if err := rows.Scan(
+			&run.ID,
+			&run.Passed,
+			&run.FailedStep,
+			&run.Error,
+			&run.OrderExternalID,
+			&steps,
+			&run.StartedAt,
+			&durationMs,
+			&run.TriggeredBy,
+		); err != nil {
+			log.Error("failed to scan synthetic checkout run", zap.Error(err))
+			return nil, ErrDB
+		}
+		if err := json.Unmarshal(steps, &run.Steps); err != nil {
+			log.Error("failed to decode steps", zap.Int64("run_id", run.ID), zap.Error(err))
+			return nil, ErrDB
+		}
+		run.Duration = time.Duration(durationMs) * time.Millisecond
+		out = append(out, &run)
+	}
+	if err := rows.Err(); err != nil {
+		log.Error("failed to iterate synthetic checkout runs", zap.Error(err))
+		return nil, ErrDB
+	}
+	return out, nil
+}
@@ -0,0 +1,79 @@
+package synthetic
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_SaveRun(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+	repo := NewRepository(db)
+
+	started := time.Date(2026, 10, 1, 8, 0, 0, 0, time.UTC)
+	step, msg, ref := StepPayment, "order was not paid in time", "ORD-1"
+	run := &Run{
+		FailedStep:      &step,
+		Error:           &msg,
+		OrderExternalID: &ref,
+		Steps:           []Step{{Name: StepPayment, DurationMs: 30000, Error: msg}},
+		StartedAt:       started,
+		Duration:        31 * time.Second,
+	}
+
+	mock.ExpectQuery(`INSERT INTO synthetic_checkout_runs`).
+		WithArgs(false, &step, &msg, &ref,
+			[]byte(`[{"name":"payment","duration_ms":30000,"error":"order was not paid in time"}]`),
+			started, int64(31000), nil).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(int64(4)))
+
+	require.NoError(t, repo.SaveRun(context.Background(), run))
+	assert.Equal(t, int64(4), run.ID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ListRuns(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectQuery(`FROM synthetic_checkout_runs\s+ORDER BY started_at DESC`).
+			WithArgs(int32(20)).
+			WillReturnRows(sqlmock.NewRows([]string{
+				"id", "passed", "failed_step", "error", "order_external_id", "steps",
+				"started_at", "duration_ms", "triggered_by",
+			}).AddRow(int64(4), true, nil, nil, "ORD-1", []byte(`[{"name":"create_session","duration_ms":12}]`),
+				time.Now(), int64(1500), int64(1)))
+
+		runs, err := repo.ListRuns(context.Background(), 20)
+
+		require.NoError(t, err)
+		require.Len(t, runs, 1)
+		assert.True(t, runs[0].Passed)
+		assert.Equal(t, []Step{{Name: StepCreateSession, DurationMs: 12}}, runs[0].Steps)
+		assert.Equal(t, 1500*time.Millisecond, runs[0].Duration)
+		assert.Equal(t, int32(1), *runs[0].TriggeredBy)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("DBError", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		require.NoError(t, err)
+		defer db.Close()
+		repo := NewRepository(db)
+
+		mock.ExpectQuery(`FROM synthetic_checkout_runs`).WillReturnError(errors.New("boom"))
+
+		_, err = repo.ListRuns(context.Background(), 20)
+		assert.ErrorIs(t, err, ErrDB)
+	})
+}
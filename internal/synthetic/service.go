@@ -0,0 +1,299 @@
+package synthetic
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+	"warimas-be/internal/address"
+	"warimas-be/internal/graph/model"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/order"
+	"warimas-be/internal/payment"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+// Checkout is the part of the order service a synthetic checkout goes
+// through, the same calls the storefront makes.
+type Checkout interface {
+	CreateSession(ctx context.Context, input model.CreateCheckoutSessionInput) (*order.CheckoutSession, error)
+	UpdateSessionAddress(ctx context.Context, externalID string, addressID string) error
+	UpdateSessionPaymentMethod(ctx context.Context, externalID string, paymentMethod payment.ChannelCode) error
+	CurrentPolicies(ctx context.Context) ([]*order.Policy, error)
+	ConfirmSession(ctx context.Context, sessionID string, acceptedPolicyIDs []int32) (*string, error)
+	GetOrderDetailByExternalID(ctx context.Context, externalId string) (*order.Order, *address.Address, error)
+	OrderReasons(ctx context.Context, kind *order.ReasonKind, all bool) ([]*order.Reason, error)
+	CancelOrder(ctx context.Context, orderID uint, reasonID int32, note *string) error
+}
+
+// AddressLister lists the caller's saved addresses.
+type AddressLister interface {
+	List(ctx context.Context) ([]*address.Address, error)
+}
+
+type Service interface {
+	// Check buys the test product as the canary user: it opens a
+	// checkout session, confirms it, waits for the simulated payment
+	// webhook to mark the order paid and cancels the order again. The
+	// result is logged and stored; a failing checkout is a run that did
+	// not pass, not an error.
+	Check(ctx context.Context) (*Run, error)
+	// Trigger runs Check now. Admin only.
+	Trigger(ctx context.Context) (*Run, error)
+	// Runs returns the latest runs, newest first; limit defaults to 20
+	// and is capped at 100. Admin only.
+	Runs(ctx context.Context, limit int32) ([]*Run, error)
+}
+
+type service struct {
+	repo      Repository
+	checkout  Checkout
+	addresses AddressLister
+	cfg       Config
+	now       func() time.Time
+	poll      time.Duration
+
+	running atomic.Bool
+}
+
+func NewService(repo Repository, checkout Checkout, addresses AddressLister, cfg Config) Service {
+	return &service{
+		repo:      repo,
+		checkout:  checkout,
+		addresses: addresses,
+		cfg:       cfg,
+		now:       time.Now,
+		poll:      paymentPoll,
+	}
+}
+
+func (s *service) Trigger(ctx context.Context) (*Run, error) {
+	adminID, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.check(ctx, &adminID)
+}
+
+func (s *service) Check(ctx context.Context) (*Run, error) {
+	return s.check(ctx, nil)
+}
+
+func (s *service) check(ctx context.Context, triggeredBy *int32) (*Run, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "Check"),
+	)
+
+	if !s.cfg.Enabled() {
+		return nil, ErrNotConfigured
+	}
+	if !s.running.CompareAndSwap(false, true) {
+		log.Warn("synthetic checkout already running")
+		return nil, ErrRunning
+	}
+	defer s.running.Store(false)
+
+	run := &Run{StartedAt: s.now(), TriggeredBy: triggeredBy}
+	s.run(s.canaryContext(ctx), run)
+	run.Duration = s.now().Sub(run.StartedAt)
+
+	fields := []zap.Field{
+		zap.Bool("passed", run.Passed),
+		zap.Int64("duration_ms", run.Duration.Milliseconds()),
+		zap.Stringp("order_external_id", run.OrderExternalID),
+	}
+	if run.Passed {
+		log.Info("synthetic checkout passed", fields...)
+	} else {
+		log.Error("synthetic checkout failed", append(fields,
+			zap.Stringp("step", run.FailedStep),
+			zap.Stringp("error", run.Error),
+		)...)
+	}
+
+	if err := s.repo.SaveRun(ctx, run); err != nil {
+		return nil, err
+	}
+	return run, nil
+}
+
+// canaryContext acts as the canary user and marks the checkout as
+// synthetic, so its payment goes to the simulated gateway.
+func (s *service) canaryContext(ctx context.Context) context.Context {
+	ctx = utils.WithPrincipal(ctx, &utils.Principal{ID: s.cfg.UserID, Roles: []string{"USER"}})
+	return payment.WithSynthetic(ctx)
+}
+
+// run goes through the steps until one fails. An order it placed is
+// cancelled whatever happened after.
+func (s *service) run(ctx context.Context, run *Run) {
+	var sessionID string
+	var placed *order.Order
+
+	steps := []struct {
+		name string
+		fn   func() error
+	}{
+		{StepCreateSession, func() error {
+			session, err := s.checkout.CreateSession(ctx, model.CreateCheckoutSessionInput{
+				Items: []*model.CheckoutSessionItemInput{{VariantID: s.cfg.VariantID, Quantity: 1}},
+			})
+			if err != nil {
+				return err
+			}
+			sessionID = session.ExternalID
+			return nil
+		}},
+		{StepSetAddress, func() error {
+			addr, err := s.canaryAddress(ctx)
+			if err != nil {
+				return err
+			}
+			return s.checkout.UpdateSessionAddress(ctx, sessionID, addr.ID.String())
+		}},
+		{StepSetPayment, func() error {
+			return s.checkout.UpdateSessionPaymentMethod(ctx, sessionID, payment.MethodBCAVA)
+		}},
+		{StepConfirm, func() error {
+			policies, err := s.checkout.CurrentPolicies(ctx)
+			if err != nil {
+				return err
+			}
+			accepted := make([]int32, 0, len(policies))
+			for _, p := range policies {
+				accepted = append(accepted, p.ID)
+			}
+			externalID, err := s.checkout.ConfirmSession(ctx, sessionID, accepted)
+			if err != nil {
+				return err
+			}
+			run.OrderExternalID = externalID
+			placed, _, err = s.checkout.GetOrderDetailByExternalID(ctx, *externalID)
+			return err
+		}},
+		{StepPayment, func() error {
+			return s.awaitPaid(ctx, *run.OrderExternalID)
+		}},
+	}
+
+	run.Passed = true
+	for _, step := range steps {
+		if !s.step(run, step.name, step.fn) {
+			break
+		}
+	}
+	if placed != nil {
+		s.step(run, StepCleanup, func() error {
+			return s.cancel(ctx, uint(placed.ID))
+		})
+	}
+}
+
+// step runs fn as the step called name and records how it went. It
+// reports whether the step passed.
+func (s *service) step(run *Run, name string, fn func() error) bool {
+	start := s.now()
+	err := fn()
+	st := Step{Name: name, DurationMs: s.now().Sub(start).Milliseconds()}
+	if err != nil {
+		st.Error = err.Error()
+		if run.Passed {
+			run.Passed = false
+			run.FailedStep = &st.Name
+			run.Error = &st.Error
+		}
+	}
+	run.Steps = append(run.Steps, st)
+	return err == nil
+}
+
+// canaryAddress picks the canary user's default address, or its first.
+func (s *service) canaryAddress(ctx context.Context) (*address.Address, error) {
+	addrs, err := s.addresses.List(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(addrs) == 0 {
+		return nil, errNoAddress
+	}
+	for _, a := range addrs {
+		if a.IsDefault {
+			return a, nil
+		}
+	}
+	return addrs[0], nil
+}
+
+// awaitPaid waits for the simulated payment webhook to mark the order
+// paid.
+func (s *service) awaitPaid(ctx context.Context, externalID string) error {
+	ctx, cancel := context.WithTimeout(ctx, s.cfg.PaymentTimeout)
+	defer cancel()
+
+	ticker := time.NewTicker(s.poll)
+	defer ticker.Stop()
+	for {
+		o, _, err := s.checkout.GetOrderDetailByExternalID(ctx, externalID)
+		if err != nil && !errors.Is(err, context.DeadlineExceeded) {
+			return err
+		}
+		if o != nil {
+			switch o.Status {
+			case order.OrderStatusPaid:
+				return nil
+			case order.OrderStatusPendingPayment:
+			default:
+				return errors.New("order became " + string(o.Status))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return errPaymentTimeout
+		case <-ticker.C:
+		}
+	}
+}
+
+// cancel cancels the canary's order with the first active cancellation
+// reason, returning its stock.
+func (s *service) cancel(ctx context.Context, orderID uint) error {
+	kind := order.ReasonKindCancellation
+	reasons, err := s.checkout.OrderReasons(ctx, &kind, false)
+	if err != nil {
+		return err
+	}
+	if len(reasons) == 0 {
+		return errNoCancelReason
+	}
+	note := "synthetic checkout"
+	return s.checkout.CancelOrder(ctx, orderID, reasons[0].ID, &note)
+}
+
+func (s *service) Runs(ctx context.Context, limit int32) ([]*Run, error) {
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		limit = defaultRunsLimit
+	}
+	if limit > maxRunsLimit {
+		limit = maxRunsLimit
+	}
+	return s.repo.ListRuns(ctx, limit)
+}
+
+func requireAdmin(ctx context.Context) (int32, error) {
+	userID, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		return 0, ErrUnauthenticated
+	}
+	if !utils.IsAdmin(ctx) {
+		return 0, ErrForbidden
+	}
+	return int32(userID), nil
+}
@@ -0,0 +1,275 @@
+package synthetic
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"warimas-be/internal/address"
+	"warimas-be/internal/graph/model"
+	"warimas-be/internal/order"
+	"warimas-be/internal/payment"
+	"warimas-be/internal/utils"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Mocks ---
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) SaveRun(ctx context.Context, run *Run) error {
+	return m.Called(ctx, run).Error(0)
+}
+
+func (m *MockRepository) ListRuns(ctx context.Context, limit int32) ([]*Run, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Run), args.Error(1)
+}
+
+type MockCheckout struct {
+	mock.Mock
+}
+
+func (m *MockCheckout) CreateSession(ctx context.Context, input model.CreateCheckoutSessionInput) (*order.CheckoutSession, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*order.CheckoutSession), args.Error(1)
+}
+
+func (m *MockCheckout) UpdateSessionAddress(ctx context.Context, externalID string, addressID string) error {
+	return m.Called(ctx, externalID, addressID).Error(0)
+}
+
+func (m *MockCheckout) UpdateSessionPaymentMethod(ctx context.Context, externalID string, paymentMethod payment.ChannelCode) error {
+	return m.Called(ctx, externalID, paymentMethod).Error(0)
+}
+
+func (m *MockCheckout) CurrentPolicies(ctx context.Context) ([]*order.Policy, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*order.Policy), args.Error(1)
+}
+
+func (m *MockCheckout) ConfirmSession(ctx context.Context, sessionID string, acceptedPolicyIDs []int32) (*string, error) {
+	args := m.Called(ctx, sessionID, acceptedPolicyIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*string), args.Error(1)
+}
+
+func (m *MockCheckout) GetOrderDetailByExternalID(ctx context.Context, externalId string) (*order.Order, *address.Address, error) {
+	args := m.Called(ctx, externalId)
+	if args.Get(0) == nil {
+		return nil, nil, args.Error(2)
+	}
+	return args.Get(0).(*order.Order), nil, args.Error(2)
+}
+
+func (m *MockCheckout) OrderReasons(ctx context.Context, kind *order.ReasonKind, all bool) ([]*order.Reason, error) {
+	args := m.Called(ctx, kind, all)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*order.Reason), args.Error(1)
+}
+
+func (m *MockCheckout) CancelOrder(ctx context.Context, orderID uint, reasonID int32, note *string) error {
+	return m.Called(ctx, orderID, reasonID, note).Error(0)
+}
+
+type MockAddresses struct {
+	mock.Mock
+}
+
+func (m *MockAddresses) List(ctx context.Context) ([]*address.Address, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*address.Address), args.Error(1)
+}
+
+// --- Helpers ---
+
+var testConfig = Config{UserID: 99, VariantID: "var-canary", PaymentTimeout: time.Second}
+
+func adminCtx() context.Context {
+	return utils.SetUserContext(context.Background(), 1, "admin@example.com", utils.RoleAdmin)
+}
+
+func newTestService(repo *MockRepository, checkout *MockCheckout, addresses *MockAddresses) *service {
+	s := NewService(repo, checkout, addresses, testConfig).(*service)
+	s.poll = time.Millisecond
+	return s
+}
+
+// canaryCtx matches the context the checkout runs in: the canary user
+// with a synthetic payment.
+var canaryCtx = mock.MatchedBy(func(ctx context.Context) bool {
+	id, ok := utils.GetUserIDFromContext(ctx)
+	return ok && id == 99 && payment.IsSynthetic(ctx) && !utils.IsAdmin(ctx)
+})
+
+func expectCheckoutUntilConfirm(checkout *MockCheckout, addresses *MockAddresses, addrID uuid.UUID) {
+	checkout.On("CreateSession", canaryCtx, model.CreateCheckoutSessionInput{
+		Items: []*model.CheckoutSessionItemInput{{VariantID: "var-canary", Quantity: 1}},
+	}).Return(&order.CheckoutSession{ExternalID: "sess-1"}, nil)
+	addresses.On("List", canaryCtx).Return([]*address.Address{{ID: uuid.New()}, {ID: addrID, IsDefault: true}}, nil)
+	checkout.On("UpdateSessionAddress", canaryCtx, "sess-1", addrID.String()).Return(nil)
+	checkout.On("UpdateSessionPaymentMethod", canaryCtx, "sess-1", payment.MethodBCAVA).Return(nil)
+	checkout.On("CurrentPolicies", canaryCtx).Return([]*order.Policy{{ID: 3}, {ID: 4}}, nil)
+	ref := "ORD-1"
+	checkout.On("ConfirmSession", canaryCtx, "sess-1", []int32{3, 4}).Return(&ref, nil)
+}
+
+// --- Tests ---
+
+func TestService_Trigger_Passes(t *testing.T) {
+	repo, checkout, addresses := new(MockRepository), new(MockCheckout), new(MockAddresses)
+	s := newTestService(repo, checkout, addresses)
+	addrID := uuid.New()
+
+	expectCheckoutUntilConfirm(checkout, addresses, addrID)
+	checkout.On("GetOrderDetailByExternalID", canaryCtx, "ORD-1").
+		Return(&order.Order{ID: 7, Status: order.OrderStatusPendingPayment}, nil, nil).Twice()
+	checkout.On("GetOrderDetailByExternalID", canaryCtx, "ORD-1").
+		Return(&order.Order{ID: 7, Status: order.OrderStatusPaid}, nil, nil)
+	checkout.On("OrderReasons", canaryCtx, mock.Anything, false).Return([]*order.Reason{{ID: 11}}, nil)
+	checkout.On("CancelOrder", canaryCtx, uint(7), int32(11), mock.Anything).Return(nil)
+	repo.On("SaveRun", mock.Anything, mock.Anything).Return(nil)
+
+	run, err := s.Trigger(adminCtx())
+
+	require.NoError(t, err)
+	assert.True(t, run.Passed)
+	assert.Nil(t, run.FailedStep)
+	assert.Equal(t, "ORD-1", *run.OrderExternalID)
+	assert.Equal(t, int32(1), *run.TriggeredBy)
+	names := make([]string, len(run.Steps))
+	for i, st := range run.Steps {
+		names[i] = st.Name
+		assert.Empty(t, st.Error)
+	}
+	assert.Equal(t, []string{
+		StepCreateSession, StepSetAddress, StepSetPayment, StepConfirm, StepPayment, StepCleanup,
+	}, names)
+	checkout.AssertExpectations(t)
+	repo.AssertExpectations(t)
+}
+
+func TestService_Check_PaymentFailsStillCancels(t *testing.T) {
+	repo, checkout, addresses := new(MockRepository), new(MockCheckout), new(MockAddresses)
+	s := newTestService(repo, checkout, addresses)
+
+	expectCheckoutUntilConfirm(checkout, addresses, uuid.New())
+	checkout.On("GetOrderDetailByExternalID", canaryCtx, "ORD-1").
+		Return(&order.Order{ID: 7, Status: order.OrderStatusPendingPayment}, nil, nil).Once()
+	checkout.On("GetOrderDetailByExternalID", canaryCtx, "ORD-1").
+		Return(&order.Order{ID: 7, Status: order.OrderStatusFailed}, nil, nil)
+	checkout.On("OrderReasons", canaryCtx, mock.Anything, false).Return([]*order.Reason{{ID: 11}}, nil)
+	checkout.On("CancelOrder", canaryCtx, uint(7), int32(11), mock.Anything).Return(errors.New("cannot cancel"))
+	repo.On("SaveRun", mock.Anything, mock.Anything).Return(nil)
+
+	run, err := s.Check(context.Background())
+
+	require.NoError(t, err)
+	assert.False(t, run.Passed)
+	assert.Equal(t, StepPayment, *run.FailedStep)
+	assert.Equal(t, "order became FAILED", *run.Error)
+	assert.Nil(t, run.TriggeredBy)
+	require.Len(t, run.Steps, 6)
+	assert.Equal(t, "cannot cancel", run.Steps[5].Error)
+	checkout.AssertExpectations(t)
+}
+
+func TestService_Check_StopsAtFirstFailure(t *testing.T) {
+	repo, checkout, addresses := new(MockRepository), new(MockCheckout), new(MockAddresses)
+	s := newTestService(repo, checkout, addresses)
+
+	checkout.On("CreateSession", canaryCtx, mock.Anything).Return(&order.CheckoutSession{ExternalID: "sess-1"}, nil)
+	addresses.On("List", canaryCtx).Return([]*address.Address{}, nil)
+	repo.On("SaveRun", mock.Anything, mock.Anything).Return(nil)
+
+	run, err := s.Check(context.Background())
+
+	require.NoError(t, err)
+	assert.False(t, run.Passed)
+	assert.Equal(t, StepSetAddress, *run.FailedStep)
+	assert.Len(t, run.Steps, 2)
+	assert.Nil(t, run.OrderExternalID)
+	checkout.AssertNotCalled(t, "CancelOrder", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestService_Check_PaymentTimeout(t *testing.T) {
+	repo, checkout, addresses := new(MockRepository), new(MockCheckout), new(MockAddresses)
+	s := newTestService(repo, checkout, addresses)
+	s.cfg.PaymentTimeout = 20 * time.Millisecond
+
+	expectCheckoutUntilConfirm(checkout, addresses, uuid.New())
+	checkout.On("GetOrderDetailByExternalID", canaryCtx, "ORD-1").
+		Return(&order.Order{ID: 7, Status: order.OrderStatusPendingPayment}, nil, nil)
+	checkout.On("OrderReasons", canaryCtx, mock.Anything, false).Return([]*order.Reason{{ID: 11}}, nil)
+	checkout.On("CancelOrder", canaryCtx, uint(7), int32(11), mock.Anything).Return(nil)
+	repo.On("SaveRun", mock.Anything, mock.Anything).Return(nil)
+
+	run, err := s.Check(context.Background())
+
+	require.NoError(t, err)
+	assert.False(t, run.Passed)
+	assert.Equal(t, StepPayment, *run.FailedStep)
+	assert.Equal(t, errPaymentTimeout.Error(), *run.Error)
+}
+
+func TestService_Check_Guards(t *testing.T) {
+	t.Run("NotConfigured", func(t *testing.T) {
+		s := NewService(new(MockRepository), new(MockCheckout), new(MockAddresses), Config{})
+		_, err := s.Check(context.Background())
+		assert.ErrorIs(t, err, ErrNotConfigured)
+	})
+
+	t.Run("AlreadyRunning", func(t *testing.T) {
+		s := newTestService(new(MockRepository), new(MockCheckout), new(MockAddresses))
+		s.running.Store(true)
+		_, err := s.Check(context.Background())
+		assert.ErrorIs(t, err, ErrRunning)
+	})
+
+	t.Run("TriggerNeedsAdmin", func(t *testing.T) {
+		s := newTestService(new(MockRepository), new(MockCheckout), new(MockAddresses))
+		_, err := s.Trigger(context.Background())
+		assert.ErrorIs(t, err, ErrUnauthenticated)
+
+		_, err = s.Trigger(utils.SetUserContext(context.Background(), 5, "u@example.com", "USER"))
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+}
+
+func TestService_Runs(t *testing.T) {
+	repo := new(MockRepository)
+	s := newTestService(repo, new(MockCheckout), new(MockAddresses))
+
+	repo.On("ListRuns", mock.Anything, int32(20)).Return([]*Run{{ID: 1}}, nil).Once()
+	repo.On("ListRuns", mock.Anything, int32(100)).Return([]*Run{}, nil).Once()
+
+	runs, err := s.Runs(adminCtx(), 0)
+	require.NoError(t, err)
+	assert.Len(t, runs, 1)
+
+	_, err = s.Runs(adminCtx(), 500)
+	require.NoError(t, err)
+	repo.AssertExpectations(t)
+}
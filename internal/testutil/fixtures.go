@@ -0,0 +1,161 @@
+package testutil
+
+import (
+	"database/sql"
+	"fmt"
+	"sync/atomic"
+	"testing"
+)
+
+// fixtureSeq keeps names, slugs and emails unique across fixtures.
+var fixtureSeq atomic.Int64
+
+// Variant is a sellable variant with the product it belongs to.
+type Variant struct {
+	ProductID string
+	VariantID string
+}
+
+// Order is a PENDING_PAYMENT order with the rows it references.
+type Order struct {
+	ID         int32
+	ExternalID string
+	AddressID  string
+	SessionID  string
+}
+
+// CreateUser inserts a USER-role user and returns its ID.
+func CreateUser(t testing.TB, db *sql.DB) int32 {
+	t.Helper()
+
+	var id int32
+	email := fmt.Sprintf("user%d@example.com", fixtureSeq.Add(1))
+	err := db.QueryRow(
+		`INSERT INTO users (email, password) VALUES ($1, 'hashed') RETURNING id`,
+		email,
+	).Scan(&id)
+	if err != nil {
+		t.Fatalf("testutil: create user: %v", err)
+	}
+	return id
+}
+
+// CreateSeller inserts a seller owned by userID and returns its ID.
+func CreateSeller(t testing.TB, db *sql.DB, userID int32) string {
+	t.Helper()
+
+	var id string
+	name := fmt.Sprintf("Seller %d", fixtureSeq.Add(1))
+	err := db.QueryRow(
+		`INSERT INTO sellers (user_id, name) VALUES ($1, $2) RETURNING id`,
+		userID, name,
+	).Scan(&id)
+	if err != nil {
+		t.Fatalf("testutil: create seller: %v", err)
+	}
+	return id
+}
+
+// CreateVariant inserts a category, subcategory and product of sellerID with
+// one variant, all in one transaction.
+func CreateVariant(t testing.TB, db *sql.DB, sellerID string, price float64, stock int32) Variant {
+	t.Helper()
+
+	n := fixtureSeq.Add(1)
+	var v Variant
+	withTx(t, db, func(tx *sql.Tx) error {
+		var categoryID, subcategoryID string
+		if err := tx.QueryRow(
+			`INSERT INTO category (name) VALUES ($1) RETURNING id`,
+			fmt.Sprintf("Category %d", n),
+		).Scan(&categoryID); err != nil {
+			return err
+		}
+		if err := tx.QueryRow(
+			`INSERT INTO subcategories (category_id, name) VALUES ($1, $2) RETURNING id`,
+			categoryID, fmt.Sprintf("Subcategory %d", n),
+		).Scan(&subcategoryID); err != nil {
+			return err
+		}
+		if err := tx.QueryRow(`
+			INSERT INTO products (category_id, subcategory_id, seller_id, name, slug)
+			VALUES ($1, $2, $3, $4, $5)
+			RETURNING id`,
+			categoryID, subcategoryID, sellerID,
+			fmt.Sprintf("Product %d", n), fmt.Sprintf("product-%d", n),
+		).Scan(&v.ProductID); err != nil {
+			return err
+		}
+		return tx.QueryRow(`
+			INSERT INTO variants (product_id, name, price, stock)
+			VALUES ($1, $2, $3, $4)
+			RETURNING id`,
+			v.ProductID, fmt.Sprintf("Variant %d", n), price, stock,
+		).Scan(&v.VariantID)
+	})
+	return v
+}
+
+// CreateOrder inserts an address, a checkout session and a PENDING_PAYMENT
+// order of userID for total, all in one transaction.
+func CreateOrder(t testing.TB, db *sql.DB, userID int32, total int64) Order {
+	t.Helper()
+
+	n := fixtureSeq.Add(1)
+	o := Order{ExternalID: fmt.Sprintf("ORD-TEST-%d", n)}
+	withTx(t, db, func(tx *sql.Tx) error {
+		if err := tx.QueryRow(`
+			INSERT INTO addresses (
+				id, user_id, name, phone,
+				address_line1, city, province, postal_code
+			) VALUES (
+				gen_random_uuid(), $1, 'Home', '0812000000',
+				'Jl. Test 1', 'Jakarta', 'DKI Jakarta', '10110'
+			)
+			RETURNING id`,
+			userID,
+		).Scan(&o.AddressID); err != nil {
+			return err
+		}
+		if err := tx.QueryRow(`
+			INSERT INTO checkout_sessions (
+				id, user_id, status, subtotal, total_amount,
+				address_id, expires_at, external_id
+			) VALUES (
+				gen_random_uuid(), $1, 'PAID', $2, $2,
+				$3, NOW() + INTERVAL '1 hour', $4
+			)
+			RETURNING id`,
+			userID, total, o.AddressID, fmt.Sprintf("CK_TEST_%d", n),
+		).Scan(&o.SessionID); err != nil {
+			return err
+		}
+		return tx.QueryRow(`
+			INSERT INTO orders (
+				user_id, checkout_session_id, status, total_amount,
+				currency, external_id, subtotal, address_id
+			) VALUES ($1, $2, 'PENDING_PAYMENT', $3, 'IDR', $4, $3, $5)
+			RETURNING id`,
+			userID, o.SessionID, total, o.ExternalID, o.AddressID,
+		).Scan(&o.ID)
+	})
+	return o
+}
+
+// withTx runs fn in a transaction, so a failing fixture leaves no partial
+// rows behind for the rest of the test.
+func withTx(t testing.TB, db *sql.DB, fn func(tx *sql.Tx) error) {
+	t.Helper()
+
+	tx, err := db.Begin()
+	if err != nil {
+		t.Fatalf("testutil: begin fixture: %v", err)
+	}
+	if err := fn(tx); err != nil {
+		tx.Rollback()
+		t.Fatalf("testutil: fixture: %v", err)
+	}
+	if err := tx.Commit(); err != nil {
+		t.Fatalf("testutil: commit fixture: %v", err)
+	}
+}
@@ -2,7 +2,9 @@ package transport
 
 import (
 	"context"
+	"net"
 	"net/http"
+	"strings"
 )
 
 type ctxKey string
@@ -27,3 +29,45 @@ func GetResponseWriter(ctx context.Context) http.ResponseWriter {
 	w, _ := ctx.Value(responseWriterKey).(http.ResponseWriter)
 	return w
 }
+
+// GetDeviceID returns the client-supplied X-Device-ID header, or "" when the
+// request carries none.
+func GetDeviceID(ctx context.Context) string {
+	r := GetRequest(ctx)
+	if r == nil {
+		return ""
+	}
+	return r.Header.Get("X-Device-ID")
+}
+
+// GetClientIP returns the first address in X-Forwarded-For, falling back to
+// the connection's remote address, or "" outside an HTTP request.
+func GetClientIP(ctx context.Context) string {
+	r := GetRequest(ctx)
+	if r == nil {
+		return ""
+	}
+	return ClientIP(r)
+}
+
+// ClientIP is GetClientIP for middleware that has the request at hand.
+func ClientIP(r *http.Request) string {
+	if fwd := r.Header.Get("X-Forwarded-For"); fwd != "" {
+		ip, _, _ := strings.Cut(fwd, ",")
+		return strings.TrimSpace(ip)
+	}
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		return host
+	}
+	return r.RemoteAddr
+}
+
+// GetUserAgent returns the request's User-Agent header, or "" outside an
+// HTTP request.
+func GetUserAgent(ctx context.Context) string {
+	r := GetRequest(ctx)
+	if r == nil {
+		return ""
+	}
+	return r.UserAgent()
+}
@@ -33,3 +33,34 @@ func TestContextHelpers(t *testing.T) {
 		assert.Nil(t, GetResponseWriter(ctx), "GetResponseWriter should return nil if key is missing")
 	})
 }
+
+func TestGetDeviceID(t *testing.T) {
+	req := httptest.NewRequest(http.MethodPost, "http://example.com", nil)
+	req.Header.Set("X-Device-ID", "dev-123")
+	ctx := WithHTTP(context.Background(), req, httptest.NewRecorder())
+
+	assert.Equal(t, "dev-123", GetDeviceID(ctx))
+	assert.Equal(t, "", GetDeviceID(context.Background()))
+}
+
+func TestGetClientIP(t *testing.T) {
+	t.Run("ForwardedFor", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "http://example.com", nil)
+		req.Header.Set("X-Forwarded-For", "203.0.113.7, 10.0.0.1")
+		ctx := WithHTTP(context.Background(), req, httptest.NewRecorder())
+
+		assert.Equal(t, "203.0.113.7", GetClientIP(ctx))
+	})
+
+	t.Run("RemoteAddr", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "http://example.com", nil)
+		req.RemoteAddr = "198.51.100.2:5555"
+		ctx := WithHTTP(context.Background(), req, httptest.NewRecorder())
+
+		assert.Equal(t, "198.51.100.2", GetClientIP(ctx))
+	})
+
+	t.Run("NoRequest", func(t *testing.T) {
+		assert.Equal(t, "", GetClientIP(context.Background()))
+	})
+}
@@ -0,0 +1,18 @@
+package uploads
+
+import (
+	"errors"
+	"warimas-be/internal/apperr"
+)
+
+var (
+	ErrUnauthenticated = apperr.Unauthenticated("unauthenticated")
+	ErrForbidden       = apperr.Forbidden("forbidden")
+	ErrDB              = errors.New("database error")
+	ErrStorage         = errors.New("failed to store file")
+	ErrEmptyFile       = errors.New("file is empty")
+	ErrTooLarge        = errors.New("file is too large")
+	ErrFileType        = errors.New("file type not allowed")
+	ErrOrderNotFound   = apperr.NotFound("order not found")
+	ErrTooManyFiles    = errors.New("too many files for this order")
+)
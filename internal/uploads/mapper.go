@@ -0,0 +1,25 @@
+package uploads
+
+import (
+	"warimas-be/internal/graph/model"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+func MapUploadToGraphQL(u *Upload) *model.UploadedFile {
+	return &model.UploadedFile{
+		ID:           u.ID,
+		Purpose:      model.UploadPurpose(u.Purpose),
+		URL:          u.URL,
+		ContentType:  u.ContentType,
+		Size:         int32(u.Size),
+		OriginalName: u.OriginalName,
+		CreatedAt:    u.CreatedAt,
+	}
+}
+
+// MapGraphQLUpload takes a file from a multipart request. The client's
+// content type is dropped; the service detects it from the content.
+func MapGraphQLUpload(f graphql.Upload) File {
+	return File{Name: f.Filename, Size: f.Size, Content: f.File}
+}
@@ -0,0 +1,79 @@
+package uploads
+
+import (
+	"io"
+	"time"
+)
+
+type Purpose string
+
+const (
+	PurposeProductImage   Purpose = "PRODUCT_IMAGE"
+	PurposeReturnEvidence Purpose = "RETURN_EVIDENCE"
+	PurposeBulkImport     Purpose = "BULK_IMPORT"
+	PurposeOrderMessage   Purpose = "ORDER_MESSAGE"
+	PurposeAvatar         Purpose = "AVATAR"
+)
+
+// MaxEvidencePerOrder caps the return evidence a customer can attach to
+// one order.
+const MaxEvidencePerOrder = 5
+
+// sniffLen is how much of a file is read to detect its type.
+const sniffLen = 512
+
+// fileType is a type an upload may have. The type is detected from the
+// content, never taken from the client, and decides the stored extension.
+type fileType struct {
+	ContentType string
+	Ext         string
+}
+
+var (
+	typeJPEG = fileType{"image/jpeg", ".jpg"}
+	typePNG  = fileType{"image/png", ".png"}
+	typeWebP = fileType{"image/webp", ".webp"}
+	typePDF  = fileType{"application/pdf", ".pdf"}
+	typeCSV  = fileType{"text/csv", ".csv"}
+)
+
+// Rule limits the files accepted for a purpose.
+type Rule struct {
+	MaxSize int64
+	Types   []fileType
+}
+
+var rules = map[Purpose]Rule{
+	PurposeProductImage:   {MaxSize: 5 << 20, Types: []fileType{typeJPEG, typePNG, typeWebP}},
+	PurposeReturnEvidence: {MaxSize: 10 << 20, Types: []fileType{typeJPEG, typePNG, typeWebP, typePDF}},
+	PurposeBulkImport:     {MaxSize: 20 << 20, Types: []fileType{typeCSV}},
+	PurposeOrderMessage:   {MaxSize: 10 << 20, Types: []fileType{typeJPEG, typePNG, typeWebP, typePDF}},
+	PurposeAvatar:         {MaxSize: 2 << 20, Types: []fileType{typeJPEG, typePNG, typeWebP}},
+}
+
+// MaxFileSize is the largest file any purpose accepts; the GraphQL
+// transport rejects bigger requests before they are read.
+const MaxFileSize = 20 << 20
+
+// File is an uploaded file as received.
+type File struct {
+	Name    string
+	Size    int64
+	Content io.ReadSeeker
+}
+
+// Upload is a stored file. OrderID and ProductID are set for the purposes
+// that belong to one.
+type Upload struct {
+	ID           string
+	Purpose      Purpose
+	StorageKey   string
+	URL          string
+	ContentType  string
+	Size         int64
+	OriginalName string
+	UploadedBy   *int32
+	OrderID      *int32
+	ProductID    *string
+	CreatedAt    time.Time
+}
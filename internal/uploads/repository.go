@@ -0,0 +1,132 @@
+package uploads
+
+import (
+	"context"
+	"database/sql"
+
+	"warimas-be/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+type Repository interface {
+	Create(ctx context.Context, u *Upload) (*Upload, error)
+	Delete(ctx context.Context, id string) error
+	ListByOrder(ctx context.Context, orderID int32, purpose Purpose) ([]*Upload, error)
+	CountByOrder(ctx context.Context, orderID int32, purpose Purpose) (int, error)
+	// OrderOwner returns the customer an order belongs to, nil for a guest
+	// order, or ErrOrderNotFound.
+	OrderOwner(ctx context.Context, orderID int32) (*int32, error)
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+const uploadColumns = `
+	id, purpose, storage_key, content_type, size_bytes, original_name,
+	uploaded_by, order_id, product_id, created_at
+`
+
+func scanUpload(row interface{ Scan(...any) error }) (*Upload, error) {
+	var u Upload
+	err := row.Scan(&u.ID, &u.Purpose, &u.StorageKey, &u.ContentType, &u.Size, &u.OriginalName,
+		&u.UploadedBy, &u.OrderID, &u.ProductID, &u.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &u, nil
+}
+
+func (r *repository) Create(ctx context.Context, u *Upload) (*Upload, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "Create"),
+	)
+
+	out, err := scanUpload(r.db.QueryRowContext(ctx, `
+		INSERT INTO uploads (purpose, storage_key, content_type, size_bytes, original_name,
+		                     uploaded_by, order_id, product_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		RETURNING `+uploadColumns,
+		u.Purpose, u.StorageKey, u.ContentType, u.Size, u.OriginalName,
+		u.UploadedBy, u.OrderID, u.ProductID,
+	))
+	if err != nil {
+		log.Error("failed to insert upload", zap.Error(err))
+		return nil, ErrDB
+	}
+	return out, nil
+}
+
+func (r *repository) Delete(ctx context.Context, id string) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM uploads WHERE id = $1`, id); err != nil {
+		logger.FromCtx(ctx).Error("failed to delete upload", zap.String("upload_id", id), zap.Error(err))
+		return ErrDB
+	}
+	return nil
+}
+
+func (r *repository) ListByOrder(ctx context.Context, orderID int32, purpose Purpose) ([]*Upload, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ListByOrder"),
+		zap.Int32("order_id", orderID),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+uploadColumns+`
+		FROM uploads
+		WHERE order_id = $1 AND purpose = $2
+		ORDER BY created_at
+	`, orderID, purpose)
+	if err != nil {
+		log.Error("failed to query uploads", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	list := []*Upload{}
+	for rows.Next() {
+		u, err := scanUpload(rows)
+		if err != nil {
+			log.Error("failed to scan upload", zap.Error(err))
+			return nil, ErrDB
+		}
+		list = append(list, u)
+	}
+	if err := rows.Err(); err != nil {
+		log.Error("failed to iterate uploads", zap.Error(err))
+		return nil, ErrDB
+	}
+	return list, nil
+}
+
+func (r *repository) CountByOrder(ctx context.Context, orderID int32, purpose Purpose) (int, error) {
+	var n int
+	err := r.db.QueryRowContext(ctx, `
+		SELECT COUNT(*) FROM uploads WHERE order_id = $1 AND purpose = $2
+	`, orderID, purpose).Scan(&n)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to count uploads", zap.Error(err))
+		return 0, ErrDB
+	}
+	return n, nil
+}
+
+func (r *repository) OrderOwner(ctx context.Context, orderID int32) (*int32, error) {
+	var userID *int32
+	err := r.db.QueryRowContext(ctx, `SELECT user_id FROM orders WHERE id = $1`, orderID).Scan(&userID)
+	if err == sql.ErrNoRows {
+		return nil, ErrOrderNotFound
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to get order owner", zap.Error(err))
+		return nil, ErrDB
+	}
+	return userID, nil
+}
@@ -0,0 +1,105 @@
+package uploads
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var uploadRowColumns = []string{
+	"id", "purpose", "storage_key", "content_type", "size_bytes", "original_name",
+	"uploaded_by", "order_id", "product_id", "created_at",
+}
+
+func TestRepository_Create(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	now := time.Now()
+	by, orderID := int32(7), int32(3)
+	in := &Upload{
+		Purpose: PurposeReturnEvidence, StorageKey: "return_evidence/a.pdf", ContentType: "application/pdf",
+		Size: 9, OriginalName: "receipt.pdf", UploadedBy: &by, OrderID: &orderID,
+	}
+
+	mock.ExpectQuery(`INSERT INTO uploads`).
+		WithArgs(PurposeReturnEvidence, "return_evidence/a.pdf", "application/pdf", int64(9), "receipt.pdf", &by, &orderID, nil).
+		WillReturnRows(sqlmock.NewRows(uploadRowColumns).
+			AddRow("u-1", "RETURN_EVIDENCE", "return_evidence/a.pdf", "application/pdf", int64(9), "receipt.pdf", int32(7), int32(3), nil, now))
+
+	u, err := repo.Create(context.Background(), in)
+	require.NoError(t, err)
+	assert.Equal(t, "u-1", u.ID)
+	assert.Equal(t, int32(3), *u.OrderID)
+	assert.Nil(t, u.ProductID)
+
+	mock.ExpectQuery(`INSERT INTO uploads`).WillReturnError(errors.New("boom"))
+	_, err = repo.Create(context.Background(), in)
+	assert.ErrorIs(t, err, ErrDB)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ListByOrder(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	mock.ExpectQuery(`SELECT .* FROM uploads WHERE order_id = \$1 AND purpose = \$2 ORDER BY created_at`).
+		WithArgs(int32(3), PurposeReturnEvidence).
+		WillReturnRows(sqlmock.NewRows(uploadRowColumns).
+			AddRow("u-1", "RETURN_EVIDENCE", "return_evidence/a.jpg", "image/jpeg", int64(100), "a.jpg", int32(7), int32(3), nil, time.Now()))
+
+	list, err := repo.ListByOrder(context.Background(), 3, PurposeReturnEvidence)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, "return_evidence/a.jpg", list[0].StorageKey)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_CountByOrder(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	mock.ExpectQuery(`SELECT COUNT\(\*\) FROM uploads`).
+		WithArgs(int32(3), PurposeReturnEvidence).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+	n, err := repo.CountByOrder(context.Background(), 3, PurposeReturnEvidence)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, n)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_OrderOwner(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	mock.ExpectQuery(`SELECT user_id FROM orders`).WithArgs(int32(3)).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(int32(7)))
+	owner, err := repo.OrderOwner(context.Background(), 3)
+	require.NoError(t, err)
+	assert.Equal(t, int32(7), *owner)
+
+	mock.ExpectQuery(`SELECT user_id FROM orders`).WithArgs(int32(4)).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}))
+	_, err = repo.OrderOwner(context.Background(), 4)
+	assert.ErrorIs(t, err, ErrOrderNotFound)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,263 @@
+package uploads
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"warimas-be/internal/logger"
+	"warimas-be/internal/product"
+	"warimas-be/internal/user"
+	"warimas-be/internal/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// Service accepts files sent with GraphQL multipart requests, an
+// alternative to pre-signed URLs for small files.
+type Service interface {
+	// UploadProductImage stores an image and makes it the product's image.
+	// Admin only; the product must belong to the caller's seller.
+	UploadProductImage(ctx context.Context, productID string, f File) (*Upload, error)
+	// UploadImportFile stores a CSV for a bulk import. Admin only.
+	UploadImportFile(ctx context.Context, f File) (*Upload, error)
+	// UploadReturnEvidence attaches a photo or PDF to one of the caller's
+	// orders, up to MaxEvidencePerOrder.
+	UploadReturnEvidence(ctx context.Context, orderID int32, f File) (*Upload, error)
+	// ReturnEvidence lists the evidence attached to an order. Admin only.
+	ReturnEvidence(ctx context.Context, orderID int32) ([]*Upload, error)
+	// UploadOrderMessageAttachment stores a photo or PDF for the order's
+	// message thread. The customer of the order and admins may upload; the
+	// file is shown once a message is sent with it.
+	UploadOrderMessageAttachment(ctx context.Context, orderID int32, f File) (*Upload, error)
+	// UploadAvatar stores an image and makes it the caller's avatar.
+	UploadAvatar(ctx context.Context, f File) (*Upload, error)
+}
+
+type service struct {
+	repo       Repository
+	storage    Storage
+	productSvc product.Service
+	userSvc    user.Service
+}
+
+func NewService(repo Repository, storage Storage, productSvc product.Service, userSvc user.Service) Service {
+	return &service{repo: repo, storage: storage, productSvc: productSvc, userSvc: userSvc}
+}
+
+func (s *service) UploadProductImage(ctx context.Context, productID string, f File) (*Upload, error) {
+	userID, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fail before storing anything when the product does not exist.
+	if _, err := s.productSvc.GetProductByID(ctx, productID); err != nil {
+		return nil, err
+	}
+
+	u, err := s.store(ctx, PurposeProductImage, f, &Upload{UploadedBy: &userID, ProductID: &productID})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.productSvc.Update(ctx, product.UpdateProductInput{ID: productID, ImageURL: &u.URL}); err != nil {
+		s.discard(ctx, u)
+		return nil, err
+	}
+	return u, nil
+}
+
+func (s *service) UploadImportFile(ctx context.Context, f File) (*Upload, error) {
+	userID, err := requireAdmin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return s.store(ctx, PurposeBulkImport, f, &Upload{UploadedBy: &userID})
+}
+
+func (s *service) UploadReturnEvidence(ctx context.Context, orderID int32, f File) (*Upload, error) {
+	id, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+	userID := int32(id)
+
+	owner, err := s.repo.OrderOwner(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if owner == nil || *owner != userID {
+		// Someone else's order looks the same as a missing one.
+		return nil, ErrOrderNotFound
+	}
+
+	n, err := s.repo.CountByOrder(ctx, orderID, PurposeReturnEvidence)
+	if err != nil {
+		return nil, err
+	}
+	if n >= MaxEvidencePerOrder {
+		return nil, ErrTooManyFiles
+	}
+
+	return s.store(ctx, PurposeReturnEvidence, f, &Upload{UploadedBy: &userID, OrderID: &orderID})
+}
+
+func (s *service) ReturnEvidence(ctx context.Context, orderID int32) ([]*Upload, error) {
+	if _, err := requireAdmin(ctx); err != nil {
+		return nil, err
+	}
+
+	list, err := s.repo.ListByOrder(ctx, orderID, PurposeReturnEvidence)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range list {
+		u.URL = s.storage.URL(u.StorageKey)
+	}
+	return list, nil
+}
+
+func (s *service) UploadOrderMessageAttachment(ctx context.Context, orderID int32, f File) (*Upload, error) {
+	id, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+	userID := int32(id)
+
+	owner, err := s.repo.OrderOwner(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if !utils.IsAdmin(ctx) && (owner == nil || *owner != userID) {
+		return nil, ErrOrderNotFound
+	}
+
+	return s.store(ctx, PurposeOrderMessage, f, &Upload{UploadedBy: &userID, OrderID: &orderID})
+}
+
+func (s *service) UploadAvatar(ctx context.Context, f File) (*Upload, error) {
+	id, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, ErrUnauthenticated
+	}
+	userID := int32(id)
+
+	u, err := s.store(ctx, PurposeAvatar, f, &Upload{UploadedBy: &userID})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.userSvc.SetAvatar(ctx, id, u.URL); err != nil {
+		s.discard(ctx, u)
+		return nil, err
+	}
+	return u, nil
+}
+
+// store checks f against the rule of purpose, writes it to storage and
+// records it with the owner fields of u.
+func (s *service) store(ctx context.Context, purpose Purpose, f File, u *Upload) (*Upload, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "store"),
+		zap.String("purpose", string(purpose)),
+	)
+
+	rule := rules[purpose]
+	if f.Size <= 0 {
+		return nil, ErrEmptyFile
+	}
+	if f.Size > rule.MaxSize {
+		return nil, ErrTooLarge
+	}
+
+	head := make([]byte, sniffLen)
+	n, err := io.ReadFull(f.Content, head)
+	if err != nil && !errors.Is(err, io.ErrUnexpectedEOF) {
+		log.Warn("failed to read upload", zap.Error(err))
+		return nil, ErrEmptyFile
+	}
+	t, ok := detect(head[:n], f.Name)
+	if !ok || !slices.Contains(rule.Types, t) {
+		return nil, ErrFileType
+	}
+	if _, err := f.Content.Seek(0, io.SeekStart); err != nil {
+		log.Error("failed to rewind upload", zap.Error(err))
+		return nil, ErrStorage
+	}
+
+	u.Purpose = purpose
+	u.StorageKey = strings.ToLower(string(purpose)) + "/" + uuid.NewString() + t.Ext
+	u.ContentType = t.ContentType
+	u.Size = f.Size
+	u.OriginalName = filepath.Base(f.Name)
+
+	if err := s.storage.Put(ctx, u.StorageKey, io.LimitReader(f.Content, rule.MaxSize)); err != nil {
+		log.Error("failed to store upload", zap.Error(err))
+		return nil, ErrStorage
+	}
+
+	out, err := s.repo.Create(ctx, u)
+	if err != nil {
+		if err := s.storage.Delete(ctx, u.StorageKey); err != nil {
+			log.Warn("failed to remove orphaned upload", zap.String("key", u.StorageKey), zap.Error(err))
+		}
+		return nil, err
+	}
+	out.URL = s.storage.URL(out.StorageKey)
+
+	log.Info("file uploaded",
+		zap.String("upload_id", out.ID),
+		zap.String("content_type", out.ContentType),
+		zap.Int64("size", out.Size),
+	)
+	return out, nil
+}
+
+// discard removes an upload whose follow-up step failed. Failures are only
+// logged; the caller is already returning the original error.
+func (s *service) discard(ctx context.Context, u *Upload) {
+	log := logger.FromCtx(ctx)
+	if err := s.repo.Delete(ctx, u.ID); err != nil {
+		log.Warn("failed to delete upload record", zap.String("upload_id", u.ID), zap.Error(err))
+	}
+	if err := s.storage.Delete(ctx, u.StorageKey); err != nil {
+		log.Warn("failed to delete upload file", zap.String("key", u.StorageKey), zap.Error(err))
+	}
+}
+
+// detect identifies a file from its first bytes. CSV has no signature, so
+// plain text is taken as CSV only when the name says so.
+func detect(head []byte, name string) (fileType, bool) {
+	sniffed := http.DetectContentType(head)
+	switch {
+	case sniffed == typeJPEG.ContentType:
+		return typeJPEG, true
+	case sniffed == typePNG.ContentType:
+		return typePNG, true
+	case sniffed == typeWebP.ContentType:
+		return typeWebP, true
+	case sniffed == typePDF.ContentType:
+		return typePDF, true
+	case strings.HasPrefix(sniffed, "text/plain") && strings.EqualFold(filepath.Ext(name), ".csv"):
+		return typeCSV, true
+	}
+	return fileType{}, false
+}
+
+func requireAdmin(ctx context.Context) (int32, error) {
+	userID, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		return 0, ErrUnauthenticated
+	}
+	if !utils.IsAdmin(ctx) {
+		return 0, ErrForbidden
+	}
+	return int32(userID), nil
+}
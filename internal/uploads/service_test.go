@@ -0,0 +1,432 @@
+package uploads
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"warimas-be/internal/product"
+	"warimas-be/internal/user"
+	"warimas-be/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Mocks ---
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) Create(ctx context.Context, u *Upload) (*Upload, error) {
+	args := m.Called(ctx, u)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Upload), args.Error(1)
+}
+
+func (m *MockRepository) Delete(ctx context.Context, id string) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+func (m *MockRepository) ListByOrder(ctx context.Context, orderID int32, purpose Purpose) ([]*Upload, error) {
+	args := m.Called(ctx, orderID, purpose)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Upload), args.Error(1)
+}
+
+func (m *MockRepository) CountByOrder(ctx context.Context, orderID int32, purpose Purpose) (int, error) {
+	args := m.Called(ctx, orderID, purpose)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockRepository) OrderOwner(ctx context.Context, orderID int32) (*int32, error) {
+	args := m.Called(ctx, orderID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*int32), args.Error(1)
+}
+
+type MockStorage struct {
+	mock.Mock
+	written []byte
+}
+
+func (m *MockStorage) Put(ctx context.Context, key string, content io.Reader) error {
+	m.written, _ = io.ReadAll(content)
+	return m.Called(ctx, key).Error(0)
+}
+
+func (m *MockStorage) Delete(ctx context.Context, key string) error {
+	return m.Called(ctx, key).Error(0)
+}
+
+func (m *MockStorage) URL(key string) string {
+	return "/uploads/" + key
+}
+
+// MockProductService implements only what the uploads service calls.
+type MockProductService struct {
+	product.Service
+	mock.Mock
+}
+
+func (m *MockProductService) GetProductByID(ctx context.Context, productID string) (*product.Product, error) {
+	args := m.Called(ctx, productID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*product.Product), args.Error(1)
+}
+
+func (m *MockProductService) Compare(ctx context.Context, productIDs []string) (*product.Comparison, error) {
+	args := m.Called(ctx, productIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*product.Comparison), args.Error(1)
+}
+
+func (m *MockProductService) Update(ctx context.Context, input product.UpdateProductInput) (product.Product, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(product.Product), args.Error(1)
+}
+
+// MockUserService implements only what the uploads service calls.
+type MockUserService struct {
+	user.Service
+	mock.Mock
+}
+
+func (m *MockUserService) SetAvatar(ctx context.Context, userID uint, url string) error {
+	return m.Called(ctx, userID, url).Error(0)
+}
+
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func file(name string, content []byte) File {
+	return File{Name: name, Size: int64(len(content)), Content: bytes.NewReader(content)}
+}
+
+func adminCtx() context.Context {
+	return utils.SetUserContext(context.Background(), 1, "admin@example.com", "ADMIN")
+}
+
+func userCtx(id uint) context.Context {
+	return utils.SetUserContext(context.Background(), id, "user@example.com", "USER")
+}
+
+// --- Tests ---
+
+func TestService_UploadProductImage(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		repo, storage, products := new(MockRepository), new(MockStorage), new(MockProductService)
+		s := NewService(repo, storage, products, new(MockUserService))
+		ctx := adminCtx()
+
+		products.On("GetProductByID", ctx, "p-1").Return(&product.Product{ID: "p-1"}, nil)
+		storage.On("Put", ctx, mock.MatchedBy(func(key string) bool {
+			return strings.HasPrefix(key, "product_image/") && strings.HasSuffix(key, ".png")
+		})).Return(nil)
+		repo.On("Create", ctx, mock.MatchedBy(func(u *Upload) bool {
+			return u.Purpose == PurposeProductImage && u.ContentType == "image/png" &&
+				*u.ProductID == "p-1" && *u.UploadedBy == 1 && u.OriginalName == "shoe.png"
+		})).Return(&Upload{ID: "u-1", StorageKey: "product_image/x.png"}, nil)
+		products.On("Update", ctx, mock.MatchedBy(func(in product.UpdateProductInput) bool {
+			return in.ID == "p-1" && *in.ImageURL == "/uploads/product_image/x.png"
+		})).Return(product.Product{ID: "p-1"}, nil)
+
+		u, err := s.UploadProductImage(ctx, "p-1", file("shoe.png", pngHeader))
+		require.NoError(t, err)
+		assert.Equal(t, "/uploads/product_image/x.png", u.URL)
+		assert.Equal(t, pngHeader, storage.written)
+		repo.AssertExpectations(t)
+		products.AssertExpectations(t)
+	})
+
+	t.Run("ProductUpdateFailsRemovesUpload", func(t *testing.T) {
+		repo, storage, products := new(MockRepository), new(MockStorage), new(MockProductService)
+		s := NewService(repo, storage, products, new(MockUserService))
+		ctx := adminCtx()
+
+		products.On("GetProductByID", ctx, "p-1").Return(&product.Product{ID: "p-1"}, nil)
+		storage.On("Put", ctx, mock.Anything).Return(nil)
+		repo.On("Create", ctx, mock.Anything).Return(&Upload{ID: "u-1", StorageKey: "product_image/x.png"}, nil)
+		products.On("Update", ctx, mock.Anything).Return(product.Product{}, errors.New("unauthorized"))
+		repo.On("Delete", ctx, "u-1").Return(nil)
+		storage.On("Delete", ctx, "product_image/x.png").Return(nil)
+
+		_, err := s.UploadProductImage(ctx, "p-1", file("shoe.png", pngHeader))
+		assert.EqualError(t, err, "unauthorized")
+		repo.AssertExpectations(t)
+		storage.AssertExpectations(t)
+	})
+
+	t.Run("WrongType", func(t *testing.T) {
+		repo, storage, products := new(MockRepository), new(MockStorage), new(MockProductService)
+		s := NewService(repo, storage, products, new(MockUserService))
+		ctx := adminCtx()
+		products.On("GetProductByID", ctx, "p-1").Return(&product.Product{ID: "p-1"}, nil)
+
+		// A PDF renamed to .png is still a PDF.
+		_, err := s.UploadProductImage(ctx, "p-1", file("shoe.png", []byte("%PDF-1.7\n")))
+		assert.ErrorIs(t, err, ErrFileType)
+		storage.AssertNotCalled(t, "Put", mock.Anything, mock.Anything)
+	})
+
+	t.Run("TooLarge", func(t *testing.T) {
+		repo, storage, products := new(MockRepository), new(MockStorage), new(MockProductService)
+		s := NewService(repo, storage, products, new(MockUserService))
+		ctx := adminCtx()
+		products.On("GetProductByID", ctx, "p-1").Return(&product.Product{ID: "p-1"}, nil)
+
+		f := file("shoe.png", pngHeader)
+		f.Size = 5<<20 + 1
+		_, err := s.UploadProductImage(ctx, "p-1", f)
+		assert.ErrorIs(t, err, ErrTooLarge)
+	})
+
+	t.Run("Empty", func(t *testing.T) {
+		repo, storage, products := new(MockRepository), new(MockStorage), new(MockProductService)
+		s := NewService(repo, storage, products, new(MockUserService))
+		ctx := adminCtx()
+		products.On("GetProductByID", ctx, "p-1").Return(&product.Product{ID: "p-1"}, nil)
+
+		_, err := s.UploadProductImage(ctx, "p-1", file("shoe.png", nil))
+		assert.ErrorIs(t, err, ErrEmptyFile)
+	})
+
+	t.Run("NotAdmin", func(t *testing.T) {
+		s := NewService(new(MockRepository), new(MockStorage), new(MockProductService), new(MockUserService))
+		_, err := s.UploadProductImage(userCtx(2), "p-1", file("shoe.png", pngHeader))
+		assert.ErrorIs(t, err, ErrForbidden)
+
+		_, err = s.UploadProductImage(context.Background(), "p-1", file("shoe.png", pngHeader))
+		assert.ErrorIs(t, err, ErrUnauthenticated)
+	})
+}
+
+func TestService_UploadImportFile(t *testing.T) {
+	t.Run("CSV", func(t *testing.T) {
+		repo, storage := new(MockRepository), new(MockStorage)
+		s := NewService(repo, storage, new(MockProductService), new(MockUserService))
+		ctx := adminCtx()
+
+		storage.On("Put", ctx, mock.MatchedBy(func(key string) bool {
+			return strings.HasPrefix(key, "bulk_import/") && strings.HasSuffix(key, ".csv")
+		})).Return(nil)
+		repo.On("Create", ctx, mock.MatchedBy(func(u *Upload) bool {
+			return u.ContentType == "text/csv" && u.ProductID == nil && u.OrderID == nil
+		})).Return(&Upload{ID: "u-1", StorageKey: "bulk_import/x.csv"}, nil)
+
+		_, err := s.UploadImportFile(ctx, file("stock.CSV", []byte("sku,qty\nA-1,4\n")))
+		assert.NoError(t, err)
+		repo.AssertExpectations(t)
+	})
+
+	t.Run("TextWithoutCSVName", func(t *testing.T) {
+		s := NewService(new(MockRepository), new(MockStorage), new(MockProductService), new(MockUserService))
+		_, err := s.UploadImportFile(adminCtx(), file("stock.txt", []byte("sku,qty\n")))
+		assert.ErrorIs(t, err, ErrFileType)
+	})
+
+	t.Run("StorageFails", func(t *testing.T) {
+		repo, storage := new(MockRepository), new(MockStorage)
+		s := NewService(repo, storage, new(MockProductService), new(MockUserService))
+		ctx := adminCtx()
+		storage.On("Put", ctx, mock.Anything).Return(errors.New("disk full"))
+
+		_, err := s.UploadImportFile(ctx, file("stock.csv", []byte("sku,qty\n")))
+		assert.ErrorIs(t, err, ErrStorage)
+		repo.AssertNotCalled(t, "Create", mock.Anything, mock.Anything)
+	})
+
+	t.Run("RecordFailsRemovesFile", func(t *testing.T) {
+		repo, storage := new(MockRepository), new(MockStorage)
+		s := NewService(repo, storage, new(MockProductService), new(MockUserService))
+		ctx := adminCtx()
+		storage.On("Put", ctx, mock.Anything).Return(nil)
+		repo.On("Create", ctx, mock.Anything).Return(nil, ErrDB)
+		storage.On("Delete", ctx, mock.Anything).Return(nil)
+
+		_, err := s.UploadImportFile(ctx, file("stock.csv", []byte("sku,qty\n")))
+		assert.ErrorIs(t, err, ErrDB)
+		storage.AssertExpectations(t)
+	})
+}
+
+func TestService_UploadReturnEvidence(t *testing.T) {
+	owner := int32(7)
+	pdf := []byte("%PDF-1.7\n")
+
+	t.Run("Success", func(t *testing.T) {
+		repo, storage := new(MockRepository), new(MockStorage)
+		s := NewService(repo, storage, new(MockProductService), new(MockUserService))
+		ctx := userCtx(7)
+
+		repo.On("OrderOwner", ctx, int32(3)).Return(&owner, nil)
+		repo.On("CountByOrder", ctx, int32(3), PurposeReturnEvidence).Return(4, nil)
+		storage.On("Put", ctx, mock.Anything).Return(nil)
+		repo.On("Create", ctx, mock.MatchedBy(func(u *Upload) bool {
+			return u.ContentType == "application/pdf" && *u.OrderID == 3 && *u.UploadedBy == 7
+		})).Return(&Upload{ID: "u-1", StorageKey: "return_evidence/x.pdf"}, nil)
+
+		u, err := s.UploadReturnEvidence(ctx, 3, file("receipt.pdf", pdf))
+		require.NoError(t, err)
+		assert.Equal(t, "/uploads/return_evidence/x.pdf", u.URL)
+	})
+
+	t.Run("OtherCustomersOrder", func(t *testing.T) {
+		repo := new(MockRepository)
+		s := NewService(repo, new(MockStorage), new(MockProductService), new(MockUserService))
+		ctx := userCtx(8)
+		repo.On("OrderOwner", ctx, int32(3)).Return(&owner, nil)
+
+		_, err := s.UploadReturnEvidence(ctx, 3, file("receipt.pdf", pdf))
+		assert.ErrorIs(t, err, ErrOrderNotFound)
+	})
+
+	t.Run("GuestOrder", func(t *testing.T) {
+		repo := new(MockRepository)
+		s := NewService(repo, new(MockStorage), new(MockProductService), new(MockUserService))
+		ctx := userCtx(7)
+		repo.On("OrderOwner", ctx, int32(3)).Return(nil, nil)
+
+		_, err := s.UploadReturnEvidence(ctx, 3, file("receipt.pdf", pdf))
+		assert.ErrorIs(t, err, ErrOrderNotFound)
+	})
+
+	t.Run("LimitReached", func(t *testing.T) {
+		repo := new(MockRepository)
+		s := NewService(repo, new(MockStorage), new(MockProductService), new(MockUserService))
+		ctx := userCtx(7)
+		repo.On("OrderOwner", ctx, int32(3)).Return(&owner, nil)
+		repo.On("CountByOrder", ctx, int32(3), PurposeReturnEvidence).Return(MaxEvidencePerOrder, nil)
+
+		_, err := s.UploadReturnEvidence(ctx, 3, file("receipt.pdf", pdf))
+		assert.ErrorIs(t, err, ErrTooManyFiles)
+	})
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		s := NewService(new(MockRepository), new(MockStorage), new(MockProductService), new(MockUserService))
+		_, err := s.UploadReturnEvidence(context.Background(), 3, file("receipt.pdf", pdf))
+		assert.ErrorIs(t, err, ErrUnauthenticated)
+	})
+}
+
+func TestService_UploadOrderMessageAttachment(t *testing.T) {
+	owner := int32(7)
+	pdf := []byte("%PDF-1.7\n")
+
+	for _, tc := range []struct {
+		name string
+		ctx  context.Context
+	}{
+		{"Customer", userCtx(7)},
+		{"Admin", adminCtx()},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			repo, storage := new(MockRepository), new(MockStorage)
+			s := NewService(repo, storage, new(MockProductService), new(MockUserService))
+
+			repo.On("OrderOwner", tc.ctx, int32(3)).Return(&owner, nil)
+			storage.On("Put", tc.ctx, mock.MatchedBy(func(key string) bool {
+				return strings.HasPrefix(key, "order_message/")
+			})).Return(nil)
+			repo.On("Create", tc.ctx, mock.MatchedBy(func(u *Upload) bool {
+				return u.Purpose == PurposeOrderMessage && *u.OrderID == 3
+			})).Return(&Upload{ID: "u-1", StorageKey: "order_message/x.pdf"}, nil)
+
+			u, err := s.UploadOrderMessageAttachment(tc.ctx, 3, file("invoice.pdf", pdf))
+			require.NoError(t, err)
+			assert.Equal(t, "/uploads/order_message/x.pdf", u.URL)
+		})
+	}
+
+	t.Run("OtherCustomersOrder", func(t *testing.T) {
+		repo := new(MockRepository)
+		s := NewService(repo, new(MockStorage), new(MockProductService), new(MockUserService))
+		ctx := userCtx(8)
+		repo.On("OrderOwner", ctx, int32(3)).Return(&owner, nil)
+
+		_, err := s.UploadOrderMessageAttachment(ctx, 3, file("invoice.pdf", pdf))
+		assert.ErrorIs(t, err, ErrOrderNotFound)
+	})
+}
+
+func TestService_ReturnEvidence(t *testing.T) {
+	repo := new(MockRepository)
+	s := NewService(repo, new(MockStorage), new(MockProductService), new(MockUserService))
+	ctx := adminCtx()
+	repo.On("ListByOrder", ctx, int32(3), PurposeReturnEvidence).
+		Return([]*Upload{{ID: "u-1", StorageKey: "return_evidence/a.jpg"}}, nil)
+
+	list, err := s.ReturnEvidence(ctx, 3)
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assert.Equal(t, "/uploads/return_evidence/a.jpg", list[0].URL)
+
+	_, err = s.ReturnEvidence(userCtx(7), 3)
+	assert.ErrorIs(t, err, ErrForbidden)
+}
+
+func TestService_UploadAvatar(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		repo, storage, users := new(MockRepository), new(MockStorage), new(MockUserService)
+		s := NewService(repo, storage, new(MockProductService), users)
+		ctx := userCtx(7)
+
+		storage.On("Put", ctx, mock.MatchedBy(func(key string) bool {
+			return strings.HasPrefix(key, "avatar/") && strings.HasSuffix(key, ".png")
+		})).Return(nil)
+		repo.On("Create", ctx, mock.MatchedBy(func(u *Upload) bool {
+			return u.Purpose == PurposeAvatar && *u.UploadedBy == 7
+		})).Return(&Upload{ID: "u-1", StorageKey: "avatar/x.png"}, nil)
+		users.On("SetAvatar", ctx, uint(7), "/uploads/avatar/x.png").Return(nil)
+
+		u, err := s.UploadAvatar(ctx, file("me.png", pngHeader))
+		require.NoError(t, err)
+		assert.Equal(t, "/uploads/avatar/x.png", u.URL)
+		users.AssertExpectations(t)
+	})
+
+	t.Run("SetAvatarFailsRemovesUpload", func(t *testing.T) {
+		repo, storage, users := new(MockRepository), new(MockStorage), new(MockUserService)
+		s := NewService(repo, storage, new(MockProductService), users)
+		ctx := userCtx(7)
+
+		storage.On("Put", ctx, mock.Anything).Return(nil)
+		repo.On("Create", ctx, mock.Anything).Return(&Upload{ID: "u-1", StorageKey: "avatar/x.png"}, nil)
+		users.On("SetAvatar", ctx, uint(7), mock.Anything).Return(errors.New("db error"))
+		repo.On("Delete", ctx, "u-1").Return(nil)
+		storage.On("Delete", ctx, "avatar/x.png").Return(nil)
+
+		_, err := s.UploadAvatar(ctx, file("me.png", pngHeader))
+		assert.EqualError(t, err, "db error")
+		repo.AssertExpectations(t)
+		storage.AssertExpectations(t)
+	})
+
+	t.Run("TooLarge", func(t *testing.T) {
+		s := NewService(new(MockRepository), new(MockStorage), new(MockProductService), new(MockUserService))
+		f := file("me.png", pngHeader)
+		f.Size = 2<<20 + 1
+		_, err := s.UploadAvatar(userCtx(7), f)
+		assert.ErrorIs(t, err, ErrTooLarge)
+	})
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		s := NewService(new(MockRepository), new(MockStorage), new(MockProductService), new(MockUserService))
+		_, err := s.UploadAvatar(context.Background(), file("me.png", pngHeader))
+		assert.ErrorIs(t, err, ErrUnauthenticated)
+	})
+}
@@ -0,0 +1,88 @@
+package uploads
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// Storage keeps the uploaded files. Keys are slash separated paths
+// generated by the service.
+type Storage interface {
+	Put(ctx context.Context, key string, content io.Reader) error
+	Delete(ctx context.Context, key string) error
+	// URL is where clients fetch the file.
+	URL(key string) string
+}
+
+// LocalStorage keeps files in a directory served by FileServer. Keys are
+// random, so a URL is as hard to guess as a pre-signed one, but anyone
+// holding it can fetch the file.
+type LocalStorage struct {
+	dir     string
+	baseURL string
+}
+
+// NewLocalStorage stores files under dir; baseURL is the URL FileServer
+// is mounted at, e.g. "/uploads/" or a CDN in front of it.
+func NewLocalStorage(dir, baseURL string) *LocalStorage {
+	return &LocalStorage{dir: dir, baseURL: strings.TrimSuffix(baseURL, "/") + "/"}
+}
+
+func (s *LocalStorage) Put(_ context.Context, key string, content io.Reader) error {
+	dst := filepath.Join(s.dir, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(dst), 0o755); err != nil {
+		return err
+	}
+
+	// Write to a temporary name first so a reader never sees half a file.
+	tmp, err := os.CreateTemp(filepath.Dir(dst), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := io.Copy(tmp, content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), dst)
+}
+
+func (s *LocalStorage) Delete(_ context.Context, key string) error {
+	err := os.Remove(filepath.Join(s.dir, filepath.FromSlash(key)))
+	if os.IsNotExist(err) {
+		return nil
+	}
+	return err
+}
+
+func (s *LocalStorage) URL(key string) string {
+	return s.baseURL + key
+}
+
+// FileServer serves the files of a LocalStorage. Directories are not
+// listed, so files can only be fetched by their exact key.
+func FileServer(dir string) http.Handler {
+	files := http.FileServer(http.Dir(dir))
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := path.Clean("/" + r.URL.Path)
+		if strings.HasSuffix(r.URL.Path, "/") || strings.HasPrefix(path.Base(name), ".") {
+			http.NotFound(w, r)
+			return
+		}
+		if info, err := os.Stat(filepath.Join(dir, filepath.FromSlash(name))); err != nil || info.IsDir() {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		files.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,49 @@
+package uploads
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStorage(t *testing.T) {
+	dir := t.TempDir()
+	s := NewLocalStorage(dir, "https://cdn.example.com/uploads")
+	ctx := context.Background()
+
+	require.NoError(t, s.Put(ctx, "product_image/a.png", strings.NewReader("png")))
+	got, err := os.ReadFile(filepath.Join(dir, "product_image", "a.png"))
+	require.NoError(t, err)
+	assert.Equal(t, "png", string(got))
+	assert.Equal(t, "https://cdn.example.com/uploads/product_image/a.png", s.URL("product_image/a.png"))
+
+	require.NoError(t, s.Delete(ctx, "product_image/a.png"))
+	assert.NoFileExists(t, filepath.Join(dir, "product_image", "a.png"))
+	assert.NoError(t, s.Delete(ctx, "product_image/a.png"), "deleting a missing file is not an error")
+}
+
+func TestFileServer(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, NewLocalStorage(dir, "/uploads/").Put(context.Background(), "product_image/a.png", strings.NewReader("png")))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "product_image", ".upload-1"), []byte("partial"), 0o644))
+	files := FileServer(dir)
+
+	for path, want := range map[string]int{
+		"/product_image/a.png":     http.StatusOK,
+		"/product_image/":          http.StatusNotFound,
+		"/product_image":           http.StatusNotFound,
+		"/product_image/.upload-1": http.StatusNotFound,
+		"/../etc/passwd":           http.StatusNotFound,
+	} {
+		rr := httptest.NewRecorder()
+		files.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, path, nil))
+		assert.Equal(t, want, rr.Code, path)
+	}
+}
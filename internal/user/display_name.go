@@ -0,0 +1,78 @@
+package user
+
+import (
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+	"warimas-be/internal/apperr"
+)
+
+const (
+	minDisplayName = 3
+	maxDisplayName = 30
+	maxBlockedTerm = 50
+)
+
+var (
+	ErrInvalidDisplayName = apperr.Invalid("display name must be 3 to 30 letters, digits, spaces, dots, dashes or underscores")
+	ErrDisplayNameBlocked = apperr.Invalid("display name is not allowed, choose another one")
+	ErrInvalidBlockedTerm = apperr.Invalid("blocked term must have 2 to 50 letters")
+	ErrBlockedTermExists  = apperr.Conflict("term is already blocked")
+	ErrBlockedTermMissing = apperr.NotFound("term is not blocked")
+	ErrForbidden          = apperr.Forbidden("forbidden")
+)
+
+var displayNameChars = regexp.MustCompile(`^[\p{L}\p{N} ._-]+$`)
+
+// BlockedTerm is a word display names may not contain, stored normalized
+// by foldTerm.
+type BlockedTerm struct {
+	Term      string
+	CreatedBy *int32
+	CreatedAt time.Time
+}
+
+// cleanDisplayName trims name and collapses its inner spaces, then checks
+// the length and characters allowed in a public name.
+func cleanDisplayName(name string) (string, error) {
+	name = strings.Join(strings.Fields(name), " ")
+	n := utf8.RuneCountInString(name)
+	if n < minDisplayName || n > maxDisplayName || !displayNameChars.MatchString(name) {
+		return "", ErrInvalidDisplayName
+	}
+	return name, nil
+}
+
+// leet maps the digits and symbols commonly swapped for letters to dodge
+// a blocklist.
+var leet = map[rune]rune{
+	'0': 'o', '1': 'i', '3': 'e', '4': 'a', '5': 's', '7': 't', '@': 'a', '$': 's',
+}
+
+// foldTerm lowercases s, undoes leetspeak and drops everything but
+// letters, so "A.d-m1n" and "admin" compare equal.
+func foldTerm(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if m, ok := leet[r]; ok {
+			r = m
+		}
+		if unicode.IsLetter(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// blockedBy returns the first blocked term name contains, if any.
+func blockedBy(name string, terms []*BlockedTerm) (string, bool) {
+	folded := foldTerm(name)
+	for _, t := range terms {
+		if t.Term != "" && strings.Contains(folded, t.Term) {
+			return t.Term, true
+		}
+	}
+	return "", false
+}
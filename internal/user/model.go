@@ -22,21 +22,28 @@ type User struct {
 }
 
 type Profile struct {
-	ID          uuid.UUID
-	UserID      uint
-	FullName    *string
+	ID       uuid.UUID
+	UserID   uint
+	FullName *string
+	// DisplayName is the public name, checked against the display name
+	// blocklist when set.
+	DisplayName *string
 	Bio         *string
 	AvatarURL   *string
 	Phone       *string
 	Email       *string
-	DateOfBirth *time.Time
-	CreatedAt   time.Time
-	UpdatedAt   time.Time
+	// PhoneVerifiedAt is set while Phone is the number the user proved
+	// with a one-time code; changing the phone clears it.
+	PhoneVerifiedAt *time.Time
+	DateOfBirth     *time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
 }
 
 type UpdateProfileParams struct {
 	UserID      uint
 	FullName    *string
+	DisplayName *string
 	Bio         *string
 	AvatarURL   *string
 	Phone       *string
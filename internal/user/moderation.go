@@ -0,0 +1,14 @@
+package user
+
+import (
+	"context"
+	"warimas-be/internal/apperr"
+	"warimas-be/internal/moderation"
+)
+
+var ErrAccountBanned = apperr.Forbidden("this account has been banned")
+
+// Screener queues public profile content for moderator review.
+type Screener interface {
+	Screen(ctx context.Context, c *moderation.Content)
+}
@@ -3,7 +3,9 @@ package user
 import (
 	"context"
 	"database/sql"
+	"warimas-be/internal/db/dbgen"
 	"warimas-be/internal/logger"
+	"warimas-be/internal/moderation"
 
 	"go.uber.org/zap"
 )
@@ -15,24 +17,48 @@ type Repository interface {
 	GetProfile(ctx context.Context, userID uint) (*Profile, error)
 	CreateProfile(ctx context.Context, p *Profile) (*Profile, error)
 	UpdateProfile(ctx context.Context, p *Profile) (*Profile, error)
+
+	FindByPhone(ctx context.Context, phone string) (*User, error)
+	SavePhoneVerification(ctx context.Context, v *PhoneVerification) error
+	GetPhoneVerification(ctx context.Context, userID uint, purpose OTPPurpose) (*PhoneVerification, error)
+	ClaimPhoneVerificationAttempt(ctx context.Context, id int64, max int) error
+	ExpirePhoneVerification(ctx context.Context, userID uint, purpose OTPPurpose) error
+	ConfirmPhone(ctx context.Context, userID uint, phone string) error
+
+	ListBlockedTerms(ctx context.Context) ([]*BlockedTerm, error)
+	AddBlockedTerm(ctx context.Context, t *BlockedTerm) error
+	RemoveBlockedTerm(ctx context.Context, term string) error
+
+	BanUser(ctx context.Context, userID uint, reason string) error
+	IsBanned(ctx context.Context, userID uint) (bool, error)
+	ModerationContent(ctx context.Context, t moderation.ContentType, id string) (*moderation.Content, error)
+	RemoveModerationContent(ctx context.Context, t moderation.ContentType, id string) error
+
+	SetRestriction(ctx context.Context, r *Restriction) error
+	GetRestriction(ctx context.Context, userID uint) (*Restriction, error)
+	RemoveRestriction(ctx context.Context, userID uint) error
+	ListRestrictions(ctx context.Context, limit int32) ([]*Restriction, error)
 }
 
 type repository struct {
 	db *sql.DB
+	q  *dbgen.Queries
 }
 
 func NewRepository(db *sql.DB) Repository {
-	return &repository{db: db}
+	return &repository{db: db, q: dbgen.New(db)}
 }
 
 func (r *repository) Create(ctx context.Context, email, password, role string) (*User, error) {
 	log := logger.FromCtx(ctx)
 
-	var u User
-	err := r.db.QueryRowContext(ctx,
-		"INSERT INTO users (email, password) VALUES ($1, $2) RETURNING id, email, password, role",
-		email, password,
-	).Scan(&u.ID, &u.Email, &u.Password, &u.Role)
+	row, err := r.q.CreateUser(ctx, dbgen.CreateUserParams{Email: email, Password: password})
+	u := User{
+		ID:       int(row.ID),
+		Email:    row.Email,
+		Password: row.Password,
+		Role:     Role(row.Role),
+	}
 
 	if err != nil {
 		log.Error("db: failed to insert user",
@@ -47,16 +73,11 @@ func (r *repository) Create(ctx context.Context, email, password, role string) (
 func (r *repository) UpdatePassword(ctx context.Context, email, password string) error {
 	log := logger.FromCtx(ctx).With(zap.String("email", email))
 
-	result, err := r.db.ExecContext(ctx, "UPDATE users SET password = $1 WHERE email = $2", password, email)
+	rows, err := r.q.UpdateUserPassword(ctx, dbgen.UpdateUserPasswordParams{Password: password, Email: email})
 	if err != nil {
 		log.Error("db: failed to update password", zap.Error(err))
 		return err
 	}
-	rows, err := result.RowsAffected()
-	if err != nil {
-		log.Error("db: failed to get rows affected", zap.Error(err))
-		return err
-	}
 	if rows == 0 {
 		log.Warn("db: no user found to update password")
 		return sql.ErrNoRows
@@ -69,11 +90,16 @@ func (r *repository) UpdatePassword(ctx context.Context, email, password string)
 func (r *repository) FindByEmail(ctx context.Context, email string) (*User, error) {
 	log := logger.FromCtx(ctx).With(zap.String("email", email))
 
-	var u User
-	err := r.db.QueryRowContext(ctx,
-		"SELECT u.id, u.email, u.password, u.role, s.id FROM users u LEFT JOIN sellers s ON u.id = s.user_id WHERE u.email=$1",
-		email,
-	).Scan(&u.ID, &u.Email, &u.Password, &u.Role, &u.SellerID)
+	row, err := r.q.FindUserByEmail(ctx, email)
+	u := User{
+		ID:       int(row.ID),
+		Email:    row.Email,
+		Password: row.Password,
+		Role:     Role(row.Role),
+	}
+	if row.SellerID.Valid {
+		u.SellerID = &row.SellerID.String
+	}
 
 	if err != nil {
 		if err == sql.ErrNoRows {
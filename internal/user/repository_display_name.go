@@ -0,0 +1,80 @@
+package user
+
+import (
+	"context"
+	"warimas-be/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// ListBlockedTerms returns the display name blocklist, alphabetically.
+func (r *repository) ListBlockedTerms(ctx context.Context) ([]*BlockedTerm, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ListBlockedTerms"),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT term, created_by, created_at
+		FROM display_name_blocklist
+		ORDER BY term
+	`)
+	if err != nil {
+		log.Error("failed to list blocked terms", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var terms []*BlockedTerm
+	for rows.Next() {
+		var t BlockedTerm
+		if err := rows.Scan(&t.Term, &t.CreatedBy, &t.CreatedAt); err != nil {
+			log.Error("failed to scan blocked term", zap.Error(err))
+			return nil, err
+		}
+		terms = append(terms, &t)
+	}
+	return terms, rows.Err()
+}
+
+// AddBlockedTerm blocks t.Term, filling in CreatedAt. It fails with
+// ErrBlockedTermExists when the term is already blocked.
+func (r *repository) AddBlockedTerm(ctx context.Context, t *BlockedTerm) error {
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO display_name_blocklist (term, created_by)
+		VALUES ($1, $2)
+		RETURNING created_at
+	`, t.Term, t.CreatedBy).Scan(&t.CreatedAt)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return ErrBlockedTermExists
+		}
+		logger.FromCtx(ctx).Error("failed to add blocked term",
+			zap.String("layer", "repository"),
+			zap.String("term", t.Term),
+			zap.Error(err),
+		)
+		return err
+	}
+	return nil
+}
+
+// RemoveBlockedTerm unblocks term, failing with ErrBlockedTermMissing
+// when it was not blocked.
+func (r *repository) RemoveBlockedTerm(ctx context.Context, term string) error {
+	res, err := r.db.ExecContext(ctx, `
+		DELETE FROM display_name_blocklist WHERE term = $1
+	`, term)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to remove blocked term",
+			zap.String("layer", "repository"),
+			zap.String("term", term),
+			zap.Error(err),
+		)
+		return err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return ErrBlockedTermMissing
+	}
+	return nil
+}
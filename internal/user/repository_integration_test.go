@@ -0,0 +1,52 @@
+//go:build integration
+
+package user
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"warimas-be/internal/testutil"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepositoryIntegration_CreateAndFindByEmail(t *testing.T) {
+	db := testutil.NewDB(t)
+	repo := NewRepository(db)
+	ctx := context.Background()
+
+	created, err := repo.Create(ctx, "buyer@example.com", "hashed", string(RoleUser))
+	require.NoError(t, err)
+	assert.Equal(t, RoleUser, created.Role)
+
+	found, err := repo.FindByEmail(ctx, "buyer@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, found.ID)
+	assert.Nil(t, found.SellerID)
+
+	sellerID := testutil.CreateSeller(t, db, int32(created.ID))
+	found, err = repo.FindByEmail(ctx, "buyer@example.com")
+	require.NoError(t, err)
+	require.NotNil(t, found.SellerID)
+	assert.Equal(t, sellerID, *found.SellerID)
+}
+
+func TestRepositoryIntegration_UpdatePassword(t *testing.T) {
+	db := testutil.NewDB(t)
+	repo := NewRepository(db)
+	ctx := context.Background()
+
+	_, err := repo.Create(ctx, "reset@example.com", "old", string(RoleUser))
+	require.NoError(t, err)
+
+	require.NoError(t, repo.UpdatePassword(ctx, "reset@example.com", "new"))
+	u, err := repo.FindByEmail(ctx, "reset@example.com")
+	require.NoError(t, err)
+	assert.Equal(t, "new", u.Password)
+
+	err = repo.UpdatePassword(ctx, "missing@example.com", "new")
+	assert.Equal(t, sql.ErrNoRows, err)
+}
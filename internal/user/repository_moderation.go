@@ -0,0 +1,110 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strconv"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/moderation"
+
+	"go.uber.org/zap"
+)
+
+// BanUser bans the user from logging in. Banning a banned user keeps the
+// first ban's time and reason.
+func (r *repository) BanUser(ctx context.Context, userID uint, reason string) error {
+	res, err := r.db.ExecContext(ctx, `
+		UPDATE users
+		SET banned_at = COALESCE(banned_at, NOW()),
+			ban_reason = COALESCE(ban_reason, $2)
+		WHERE id = $1
+	`, userID, reason)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to ban user",
+			zap.String("layer", "repository"),
+			zap.Uint("user_id", userID),
+			zap.Error(err),
+		)
+		return err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+func (r *repository) IsBanned(ctx context.Context, userID uint) (bool, error) {
+	var banned bool
+	err := r.db.QueryRowContext(ctx, `
+		SELECT banned_at IS NOT NULL FROM users WHERE id = $1
+	`, userID).Scan(&banned)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to check ban",
+			zap.String("layer", "repository"),
+			zap.Uint("user_id", userID),
+			zap.Error(err),
+		)
+		return false, err
+	}
+	return banned, nil
+}
+
+// profileColumn is the profiles column holding each content type the
+// moderation queue reads from profiles.
+var profileColumn = map[moderation.ContentType]string{
+	moderation.ContentDisplayName: "display_name",
+	moderation.ContentAvatar:      "avatar_url",
+}
+
+// ModerationContent serves a user's display name or avatar to the
+// moderation queue; id is the user ID.
+func (r *repository) ModerationContent(ctx context.Context, t moderation.ContentType, id string) (*moderation.Content, error) {
+	col, ok := profileColumn[t]
+	if !ok {
+		return nil, moderation.ErrInvalidContentType
+	}
+	userID, err := strconv.ParseInt(id, 10, 32)
+	if err != nil {
+		return nil, nil
+	}
+
+	var body sql.NullString
+	err = r.db.QueryRowContext(ctx, `SELECT `+col+` FROM profiles WHERE user_id = $1`, userID).Scan(&body)
+	if errors.Is(err, sql.ErrNoRows) || (err == nil && !body.Valid) {
+		return nil, nil
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to load profile content",
+			zap.String("layer", "repository"),
+			zap.String("content_type", string(t)),
+			zap.String("user_id", id),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+
+	author := int32(userID)
+	return &moderation.Content{Type: t, ID: id, AuthorID: &author, Body: body.String}, nil
+}
+
+// RemoveModerationContent clears a user's display name or avatar.
+func (r *repository) RemoveModerationContent(ctx context.Context, t moderation.ContentType, id string) error {
+	col, ok := profileColumn[t]
+	if !ok {
+		return moderation.ErrInvalidContentType
+	}
+
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE profiles SET `+col+` = NULL, updated_at = NOW() WHERE user_id = $1
+	`, id)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to remove profile content",
+			zap.String("layer", "repository"),
+			zap.String("content_type", string(t)),
+			zap.String("user_id", id),
+			zap.Error(err),
+		)
+	}
+	return err
+}
@@ -0,0 +1,229 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/pii"
+
+	"go.uber.org/zap"
+)
+
+// EncryptedPhoneVerificationTable lists the phone_verifications columns
+// stored through pii.Encrypt.
+var EncryptedPhoneVerificationTable = pii.Table{
+	Name:    "phone_verifications",
+	Key:     "id",
+	Columns: []string{"phone"},
+}
+
+// FindByPhone finds the user whose verified phone is phone, which must
+// already be normalized.
+func (r *repository) FindByPhone(ctx context.Context, phone string) (*User, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "FindByPhone"),
+	)
+
+	var u User
+	var role string
+	err := r.db.QueryRowContext(ctx, `
+		SELECT u.id, u.email, u.password, u.role, s.id
+		FROM users u
+		JOIN profiles p ON p.user_id = u.id
+		LEFT JOIN sellers s ON s.user_id = u.id AND s.deleted_at IS NULL
+		WHERE p.phone_lookup = $1
+		  AND p.phone_verified_at IS NOT NULL
+	`, pii.Lookup(phone)).Scan(&u.ID, &u.Email, &u.Password, &role, &u.SellerID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Debug("db: user not found")
+		} else {
+			log.Error("db: failed to find user by phone", zap.Error(err))
+		}
+		return nil, err
+	}
+
+	u.Role = Role(role)
+	return &u, nil
+}
+
+// SavePhoneVerification stores the code just sent to v.Phone, replacing
+// any earlier one of the user for v.Purpose. The send is counted in the
+// current hourly window, or starts a new one.
+func (r *repository) SavePhoneVerification(ctx context.Context, v *PhoneVerification) error {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "SavePhoneVerification"),
+		zap.Uint("user_id", v.UserID),
+		zap.String("purpose", string(v.Purpose)),
+	)
+
+	phone, err := pii.Encrypt(v.Phone)
+	if err != nil {
+		log.Error("failed to encrypt phone", zap.Error(err))
+		return err
+	}
+
+	err = r.db.QueryRowContext(ctx, `
+		INSERT INTO phone_verifications (user_id, purpose, phone, phone_lookup, code_hash, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		ON CONFLICT (user_id, purpose) DO UPDATE
+		SET phone = EXCLUDED.phone,
+			phone_lookup = EXCLUDED.phone_lookup,
+			code_hash = EXCLUDED.code_hash,
+			attempts = 0,
+			sends = CASE
+				WHEN phone_verifications.window_started_at > NOW() - $7 * INTERVAL '1 second'
+				THEN phone_verifications.sends + 1
+				ELSE 1
+			END,
+			window_started_at = CASE
+				WHEN phone_verifications.window_started_at > NOW() - $7 * INTERVAL '1 second'
+				THEN phone_verifications.window_started_at
+				ELSE NOW()
+			END,
+			expires_at = EXCLUDED.expires_at,
+			created_at = NOW()
+		RETURNING sends, window_started_at, created_at
+	`, v.UserID, v.Purpose, phone, pii.Lookup(v.Phone), v.CodeHash, v.ExpiresAt, int(otpSendWindow.Seconds()),
+	).Scan(&v.Sends, &v.WindowStartedAt, &v.CreatedAt)
+	if err != nil {
+		log.Error("failed to save phone verification", zap.Error(err))
+		return err
+	}
+
+	v.Attempts = 0
+	return nil
+}
+
+// GetPhoneVerification loads the user's outstanding code for purpose.
+func (r *repository) GetPhoneVerification(ctx context.Context, userID uint, purpose OTPPurpose) (*PhoneVerification, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "GetPhoneVerification"),
+		zap.Uint("user_id", userID),
+		zap.String("purpose", string(purpose)),
+	)
+
+	v := PhoneVerification{UserID: userID, Purpose: purpose}
+	err := r.db.QueryRowContext(ctx, `
+		SELECT id, phone, code_hash, attempts, sends, window_started_at, expires_at, created_at
+		FROM phone_verifications
+		WHERE user_id = $1 AND purpose = $2
+	`, userID, purpose).Scan(
+		&v.ID, &v.Phone, &v.CodeHash, &v.Attempts, &v.Sends, &v.WindowStartedAt, &v.ExpiresAt, &v.CreatedAt,
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errVerificationMissing
+		}
+		log.Error("failed to get phone verification", zap.Error(err))
+		return nil, err
+	}
+
+	if v.Phone, err = pii.Decrypt(v.Phone); err != nil {
+		log.Error("failed to decrypt phone", zap.Error(err))
+		return nil, err
+	}
+	return &v, nil
+}
+
+// ClaimPhoneVerificationAttempt uses up one of the max attempts at the
+// code id before it is checked. The limit is part of the UPDATE, so it
+// holds under concurrent checks; ErrOTPTooManyAttempts means none are
+// left.
+func (r *repository) ClaimPhoneVerificationAttempt(ctx context.Context, id int64, max int) error {
+	var attempts int
+	err := r.db.QueryRowContext(ctx, `
+		UPDATE phone_verifications
+		SET attempts = attempts + 1
+		WHERE id = $1 AND attempts < $2
+		RETURNING attempts
+	`, id, max).Scan(&attempts)
+	if errors.Is(err, sql.ErrNoRows) {
+		return ErrOTPTooManyAttempts
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to claim phone verification attempt",
+			zap.String("layer", "repository"),
+			zap.Int64("verification_id", id),
+			zap.Error(err),
+		)
+	}
+	return err
+}
+
+// ConfirmPhone makes phone the user's verified phone, creating the profile
+// if needed, and drops the code that proved it. It fails with ErrPhoneTaken
+// when another account verified the same number first.
+func (r *repository) ConfirmPhone(ctx context.Context, userID uint, phone string) error {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ConfirmPhone"),
+		zap.Uint("user_id", userID),
+	)
+
+	encrypted, err := pii.Encrypt(phone)
+	if err != nil {
+		log.Error("failed to encrypt phone", zap.Error(err))
+		return err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error("failed to begin transaction", zap.Error(err))
+		return err
+	}
+	defer tx.Rollback()
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO profiles (user_id, phone, phone_lookup, phone_verified_at)
+		VALUES ($1, $2, $3, NOW())
+		ON CONFLICT (user_id) DO UPDATE
+		SET phone = EXCLUDED.phone,
+			phone_lookup = EXCLUDED.phone_lookup,
+			phone_verified_at = EXCLUDED.phone_verified_at
+	`, userID, encrypted, pii.Lookup(phone))
+	if err != nil {
+		if isUniqueViolation(err) {
+			log.Warn("phone already verified on another account")
+			return ErrPhoneTaken
+		}
+		log.Error("failed to verify phone", zap.Error(err))
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM phone_verifications WHERE user_id = $1 AND purpose = $2
+	`, userID, OTPVerify); err != nil {
+		log.Error("failed to delete phone verification", zap.Error(err))
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		log.Error("failed to commit transaction", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// ExpirePhoneVerification makes the user's code for purpose unusable once
+// it has served, keeping the row so the hourly send count survives.
+func (r *repository) ExpirePhoneVerification(ctx context.Context, userID uint, purpose OTPPurpose) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE phone_verifications
+		SET expires_at = NOW()
+		WHERE user_id = $1 AND purpose = $2
+	`, userID, purpose)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to expire phone verification",
+			zap.String("layer", "repository"),
+			zap.Uint("user_id", userID),
+			zap.String("purpose", string(purpose)),
+			zap.Error(err),
+		)
+	}
+	return err
+}
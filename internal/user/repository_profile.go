@@ -4,12 +4,21 @@ import (
 	"context"
 	"database/sql"
 	"warimas-be/internal/logger"
+	"warimas-be/internal/pii"
 
 	"errors"
 
 	"go.uber.org/zap"
 )
 
+// EncryptedProfileTable lists the profile columns stored through
+// pii.Encrypt.
+var EncryptedProfileTable = pii.Table{
+	Name:    "profiles",
+	Key:     "id",
+	Columns: []string{"phone"},
+}
+
 // GetProfile fetches a user's profile by user ID.
 func (r *repository) GetProfile(ctx context.Context, userID uint) (*Profile, error) {
 	log := logger.FromCtx(ctx).With(
@@ -19,7 +28,7 @@ func (r *repository) GetProfile(ctx context.Context, userID uint) (*Profile, err
 	)
 
 	query := `
-		SELECT p.id, p.user_id, p.full_name, p.bio, p.avatar_url, p.phone, p.date_of_birth, p.created_at, p.updated_at, u.email
+		SELECT p.id, p.user_id, p.full_name, p.display_name, p.bio, p.avatar_url, p.phone, p.phone_verified_at, p.date_of_birth, p.created_at, p.updated_at, u.email
 		FROM profiles p
 		INNER JOIN users u ON p.user_id = u.id
 		WHERE p.user_id = $1
@@ -28,7 +37,7 @@ func (r *repository) GetProfile(ctx context.Context, userID uint) (*Profile, err
 
 	var p Profile
 	err := row.Scan(
-		&p.ID, &p.UserID, &p.FullName, &p.Bio, &p.AvatarURL, &p.Phone, &p.DateOfBirth, &p.CreatedAt, &p.UpdatedAt, &p.Email,
+		&p.ID, &p.UserID, &p.FullName, &p.DisplayName, &p.Bio, &p.AvatarURL, &p.Phone, &p.PhoneVerifiedAt, &p.DateOfBirth, &p.CreatedAt, &p.UpdatedAt, &p.Email,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -39,6 +48,11 @@ func (r *repository) GetProfile(ctx context.Context, userID uint) (*Profile, err
 		return nil, err
 	}
 
+	if p.Phone, err = pii.DecryptPtr(p.Phone); err != nil {
+		log.Error("failed to decrypt profile", zap.Error(err))
+		return nil, err
+	}
+
 	log.Info("profile fetched successfully")
 	return &p, nil
 }
@@ -51,13 +65,19 @@ func (r *repository) CreateProfile(ctx context.Context, p *Profile) (*Profile, e
 		zap.Uint("user_id", p.UserID),
 	)
 
+	phone, err := pii.EncryptPtr(p.Phone)
+	if err != nil {
+		log.Error("failed to encrypt profile", zap.Error(err))
+		return nil, err
+	}
+
 	query := `
 		INSERT INTO profiles (user_id, full_name, bio, avatar_url, phone, date_of_birth)
 		VALUES ($1, $2, $3, $4, $5, $6)
 		RETURNING id, created_at, updated_at
 	`
-	err := r.db.QueryRowContext(ctx, query,
-		p.UserID, p.FullName, p.Bio, p.AvatarURL, p.Phone, p.DateOfBirth,
+	err = r.db.QueryRowContext(ctx, query,
+		p.UserID, p.FullName, p.Bio, p.AvatarURL, phone, p.DateOfBirth,
 	).Scan(&p.ID, &p.CreatedAt, &p.UpdatedAt)
 
 	if err != nil {
@@ -77,7 +97,20 @@ func (r *repository) UpdateProfile(ctx context.Context, p *Profile) (*Profile, e
 		zap.Uint("user_id", p.UserID),
 	)
 
-	// Using COALESCE to keep existing values if input is nil
+	phone, err := pii.EncryptPtr(p.Phone)
+	if err != nil {
+		log.Error("failed to encrypt profile", zap.Error(err))
+		return nil, err
+	}
+
+	var lookup *string
+	if p.Phone != nil {
+		l := pii.Lookup(*p.Phone)
+		lookup = &l
+	}
+
+	// Using COALESCE to keep existing values if input is nil. A different
+	// phone is no longer verified.
 	query := `
 		UPDATE profiles
 		SET full_name = COALESCE($2, full_name),
@@ -85,15 +118,20 @@ func (r *repository) UpdateProfile(ctx context.Context, p *Profile) (*Profile, e
 			avatar_url = COALESCE($4, avatar_url),
 			phone = COALESCE($5, phone),
 			date_of_birth = COALESCE($6, date_of_birth),
+			phone_verified_at = CASE
+				WHEN $7::text IS NULL OR $7 = phone_lookup THEN phone_verified_at
+			END,
+			phone_lookup = COALESCE($7, phone_lookup),
+			display_name = COALESCE($8, display_name),
 			updated_at = NOW()
 		WHERE user_id = $1
-		RETURNING id, full_name, bio, avatar_url, phone, date_of_birth, created_at, updated_at
+		RETURNING id, full_name, display_name, bio, avatar_url, phone, phone_verified_at, date_of_birth, created_at, updated_at
 	`
 
-	err := r.db.QueryRowContext(ctx, query,
-		p.UserID, p.FullName, p.Bio, p.AvatarURL, p.Phone, p.DateOfBirth,
+	err = r.db.QueryRowContext(ctx, query,
+		p.UserID, p.FullName, p.Bio, p.AvatarURL, phone, p.DateOfBirth, lookup, p.DisplayName,
 	).Scan(
-		&p.ID, &p.FullName, &p.Bio, &p.AvatarURL, &p.Phone, &p.DateOfBirth, &p.CreatedAt, &p.UpdatedAt,
+		&p.ID, &p.FullName, &p.DisplayName, &p.Bio, &p.AvatarURL, &p.Phone, &p.PhoneVerifiedAt, &p.DateOfBirth, &p.CreatedAt, &p.UpdatedAt,
 	)
 
 	if err != nil {
@@ -101,6 +139,11 @@ func (r *repository) UpdateProfile(ctx context.Context, p *Profile) (*Profile, e
 		return nil, err
 	}
 
+	if p.Phone, err = pii.DecryptPtr(p.Phone); err != nil {
+		log.Error("failed to decrypt profile", zap.Error(err))
+		return nil, err
+	}
+
 	log.Info("profile updated successfully")
 	return p, nil
 }
@@ -0,0 +1,122 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"warimas-be/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+const restrictionColumns = `user_id, level, reason, expires_at, created_by, created_at`
+
+// activeRestriction matches restrictions that have not expired.
+const activeRestriction = `(expires_at IS NULL OR expires_at > NOW())`
+
+func scanRestriction(row interface{ Scan(...any) error }) (*Restriction, error) {
+	var r Restriction
+	var level string
+	if err := row.Scan(&r.UserID, &level, &r.Reason, &r.ExpiresAt, &r.CreatedBy, &r.CreatedAt); err != nil {
+		return nil, err
+	}
+	r.Level = RestrictionLevel(level)
+	return &r, nil
+}
+
+// SetRestriction restricts r.UserID, replacing any restriction they had,
+// and fills in CreatedAt. It fails with sql.ErrNoRows for an unknown
+// user.
+func (r *repository) SetRestriction(ctx context.Context, res *Restriction) error {
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO user_restrictions (user_id, level, reason, expires_at, created_by)
+		SELECT id, $2, $3, $4, $5 FROM users WHERE id = $1
+		ON CONFLICT (user_id) DO UPDATE
+		SET level = EXCLUDED.level,
+			reason = EXCLUDED.reason,
+			expires_at = EXCLUDED.expires_at,
+			created_by = EXCLUDED.created_by,
+			created_at = NOW()
+		RETURNING created_at
+	`, res.UserID, string(res.Level), res.Reason, res.ExpiresAt, res.CreatedBy).Scan(&res.CreatedAt)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		logger.FromCtx(ctx).Error("failed to set restriction",
+			zap.String("layer", "repository"),
+			zap.Uint("user_id", res.UserID),
+			zap.Error(err),
+		)
+	}
+	return err
+}
+
+// GetRestriction returns userID's restriction while it applies, nil
+// when there is none.
+func (r *repository) GetRestriction(ctx context.Context, userID uint) (*Restriction, error) {
+	res, err := scanRestriction(r.db.QueryRowContext(ctx, `
+		SELECT `+restrictionColumns+`
+		FROM user_restrictions
+		WHERE user_id = $1 AND `+activeRestriction, userID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to get restriction",
+			zap.String("layer", "repository"),
+			zap.Uint("user_id", userID),
+			zap.Error(err),
+		)
+		return nil, err
+	}
+	return res, nil
+}
+
+// RemoveRestriction lifts userID's restriction, failing with
+// ErrRestrictionNotFound when there was none in force.
+func (r *repository) RemoveRestriction(ctx context.Context, userID uint) error {
+	res, err := r.db.ExecContext(ctx, `
+		DELETE FROM user_restrictions WHERE user_id = $1 AND `+activeRestriction, userID)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to remove restriction",
+			zap.String("layer", "repository"),
+			zap.Uint("user_id", userID),
+			zap.Error(err),
+		)
+		return err
+	}
+	if n, err := res.RowsAffected(); err == nil && n == 0 {
+		return ErrRestrictionNotFound
+	}
+	return nil
+}
+
+// ListRestrictions returns the restrictions in force, newest first.
+func (r *repository) ListRestrictions(ctx context.Context, limit int32) ([]*Restriction, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ListRestrictions"),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+restrictionColumns+`
+		FROM user_restrictions
+		WHERE `+activeRestriction+`
+		ORDER BY created_at DESC, user_id
+		LIMIT $1
+	`, limit)
+	if err != nil {
+		log.Error("failed to list restrictions", zap.Error(err))
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []*Restriction
+	for rows.Next() {
+		res, err := scanRestriction(rows)
+		if err != nil {
+			log.Error("failed to scan restriction", zap.Error(err))
+			return nil, err
+		}
+		out = append(out, res)
+	}
+	return out, rows.Err()
+}
@@ -6,9 +6,11 @@ import (
 	"errors"
 	"testing"
 	"time"
+	"warimas-be/internal/pii"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/google/uuid"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -66,11 +68,11 @@ func TestRepository_FindByEmail(t *testing.T) {
 	email := "john@example.com"
 
 	t.Run("Success", func(t *testing.T) {
-		// Matches: SELECT u.id, u.email, u.password, u.role, s.id FROM users u LEFT JOIN sellers s ...
+		// Matches: SELECT u.id, u.email, u.password, u.role, s.id AS seller_id FROM users u LEFT JOIN sellers s ...
 		rows := sqlmock.NewRows([]string{"id", "email", "password", "role", "seller_id"}).
 			AddRow(1, email, "hashed", "USER", nil)
 
-		mock.ExpectQuery(`SELECT u.id, u.email, u.password, u.role, s.id FROM users u LEFT JOIN sellers s ON u.id = s.user_id WHERE u.email=\$1`).
+		mock.ExpectQuery(`SELECT u.id, u.email, u.password, u.role, s.id AS seller_id FROM users u LEFT JOIN sellers s ON u.id = s.user_id WHERE u.email = \$1`).
 			WithArgs(email).
 			WillReturnRows(rows)
 
@@ -162,12 +164,12 @@ func TestRepository_GetProfile(t *testing.T) {
 
 	t.Run("Success", func(t *testing.T) {
 		rows := sqlmock.NewRows([]string{
-			"id", "user_id", "full_name", "bio", "avatar_url", "phone", "date_of_birth", "created_at", "updated_at", "email",
+			"id", "user_id", "full_name", "display_name", "bio", "avatar_url", "phone", "phone_verified_at", "date_of_birth", "created_at", "updated_at", "email",
 		}).AddRow(
-			uuid.New(), userID, "John Doe", "Bio", "http://avatar", "123456", time.Now(), time.Now(), time.Now(), "test@example.com",
+			uuid.New(), userID, "John Doe", "johnd", "Bio", "http://avatar", "123456", nil, time.Now(), time.Now(), time.Now(), "test@example.com",
 		)
 
-		mock.ExpectQuery(`SELECT p.id, p.user_id, p.full_name, p.bio, p.avatar_url, p.phone, p.date_of_birth, p.created_at, p.updated_at, u.email FROM profiles p INNER JOIN users u ON p.user_id = u.id WHERE p.user_id = \$1`).
+		mock.ExpectQuery(`SELECT p.id, p.user_id, p.full_name, p.display_name, p.bio, p.avatar_url, p.phone, p.phone_verified_at, p.date_of_birth, p.created_at, p.updated_at, u.email FROM profiles p INNER JOIN users u ON p.user_id = u.id WHERE p.user_id = \$1`).
 			WithArgs(userID).
 			WillReturnRows(rows)
 
@@ -175,6 +177,7 @@ func TestRepository_GetProfile(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NotNil(t, p)
 		assert.Equal(t, userID, p.UserID)
+		assert.Equal(t, "johnd", *p.DisplayName)
 	})
 
 	t.Run("NotFound", func(t *testing.T) {
@@ -239,12 +242,12 @@ func TestRepository_UpdateProfile(t *testing.T) {
 	profile := &Profile{UserID: userID, FullName: &name}
 
 	t.Run("Success", func(t *testing.T) {
-		mock.ExpectQuery(`UPDATE profiles SET full_name = COALESCE\(\$2, full_name\), .* WHERE user_id = \$1 RETURNING id, full_name, bio, avatar_url, phone, date_of_birth, created_at, updated_at`).
-			WithArgs(userID, &name, nil, nil, nil, nil).
+		mock.ExpectQuery(`UPDATE profiles SET full_name = COALESCE\(\$2, full_name\), .* WHERE user_id = \$1 RETURNING id, full_name, display_name, bio, avatar_url, phone, phone_verified_at, date_of_birth, created_at, updated_at`).
+			WithArgs(userID, &name, nil, nil, nil, nil, nil, nil).
 			WillReturnRows(sqlmock.NewRows([]string{
-				"id", "full_name", "bio", "avatar_url", "phone", "date_of_birth", "created_at", "updated_at",
+				"id", "full_name", "display_name", "bio", "avatar_url", "phone", "phone_verified_at", "date_of_birth", "created_at", "updated_at",
 			}).AddRow(
-				uuid.New(), name, nil, nil, nil, nil, time.Now(), time.Now(),
+				uuid.New(), name, nil, nil, nil, nil, nil, nil, time.Now(), time.Now(),
 			))
 
 		p, err := repo.UpdateProfile(ctx, profile)
@@ -260,3 +263,162 @@ func TestRepository_UpdateProfile(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestRepository_ConfirmPhone(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+	phone := "+628123456789"
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(`INSERT INTO profiles \(user_id, phone, phone_lookup, phone_verified_at\)`).
+			WithArgs(uint(1), phone, pii.Lookup(phone)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`DELETE FROM phone_verifications WHERE user_id = \$1 AND purpose = \$2`).
+			WithArgs(uint(1), OTPVerify).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		assert.NoError(t, repo.ConfirmPhone(ctx, 1, phone))
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("TakenByAnotherAccount", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(`INSERT INTO profiles`).
+			WillReturnError(&pq.Error{Code: pgUniqueViolation})
+		mock.ExpectRollback()
+
+		err := repo.ConfirmPhone(ctx, 1, phone)
+
+		assert.ErrorIs(t, err, ErrPhoneTaken)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_FindByPhone(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	phone := "+628123456789"
+
+	mock.ExpectQuery(`SELECT u.id, u.email, u.password, u.role, s.id FROM users u JOIN profiles p ON p.user_id = u.id LEFT JOIN sellers s ON s.user_id = u.id AND s.deleted_at IS NULL .* p.phone_verified_at IS NOT NULL`).
+		WithArgs(pii.Lookup(phone)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "email", "password", "role", "seller_id"}).
+			AddRow(1, "test@example.com", "hash", "USER", nil))
+
+	u, err := repo.FindByPhone(context.Background(), phone)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, u.ID)
+	assert.Equal(t, RoleUser, u.Role)
+}
+
+func TestRepository_ClaimPhoneVerificationAttempt(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+
+	t.Run("Claimed", func(t *testing.T) {
+		mock.ExpectQuery(`UPDATE phone_verifications SET attempts = attempts \+ 1 WHERE id = \$1 AND attempts < \$2 RETURNING attempts`).
+			WithArgs(int64(7), otpMaxAttempts).
+			WillReturnRows(sqlmock.NewRows([]string{"attempts"}).AddRow(3))
+
+		err := repo.ClaimPhoneVerificationAttempt(ctx, 7, otpMaxAttempts)
+
+		assert.NoError(t, err)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("NoneLeft", func(t *testing.T) {
+		mock.ExpectQuery(`UPDATE phone_verifications SET attempts = attempts \+ 1`).
+			WithArgs(int64(7), otpMaxAttempts).
+			WillReturnRows(sqlmock.NewRows([]string{"attempts"}))
+
+		err := repo.ClaimPhoneVerificationAttempt(ctx, 7, otpMaxAttempts)
+
+		assert.ErrorIs(t, err, ErrOTPTooManyAttempts)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_SavePhoneVerification(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	phone := "+628123456789"
+	expires := time.Now().Add(otpTTL)
+	window := time.Now().Add(-10 * time.Minute)
+	v := &PhoneVerification{UserID: 1, Purpose: OTPLogin, Phone: phone, CodeHash: "hash", ExpiresAt: expires}
+
+	mock.ExpectQuery(`INSERT INTO phone_verifications .* ON CONFLICT \(user_id, purpose\) DO UPDATE .* RETURNING sends, window_started_at, created_at`).
+		WithArgs(uint(1), OTPLogin, phone, pii.Lookup(phone), "hash", expires, 3600).
+		WillReturnRows(sqlmock.NewRows([]string{"sends", "window_started_at", "created_at"}).
+			AddRow(2, window, time.Now()))
+
+	err = repo.SavePhoneVerification(context.Background(), v)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, v.Sends)
+	assert.Equal(t, window, v.WindowStartedAt)
+}
+
+func TestRepository_Restrictions(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+	cols := []string{"user_id", "level", "reason", "expires_at", "created_by", "created_at"}
+
+	t.Run("GetActive", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT user_id, level, reason, expires_at, created_by, created_at FROM user_restrictions WHERE user_id = \$1 AND \(expires_at IS NULL OR expires_at > NOW\(\)\)`).
+			WithArgs(uint(4)).
+			WillReturnRows(sqlmock.NewRows(cols).AddRow(4, "BLOCK_CHECKOUT", "chargebacks", nil, 9, time.Now()))
+
+		res, err := repo.GetRestriction(ctx, 4)
+		require.NoError(t, err)
+		assert.Equal(t, RestrictionBlockCheckout, res.Level)
+		assert.Nil(t, res.ExpiresAt)
+	})
+
+	t.Run("GetNone", func(t *testing.T) {
+		mock.ExpectQuery(`FROM user_restrictions`).
+			WithArgs(uint(5)).
+			WillReturnError(sql.ErrNoRows)
+
+		res, err := repo.GetRestriction(ctx, 5)
+		assert.NoError(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("SetUnknownUser", func(t *testing.T) {
+		mock.ExpectQuery(`INSERT INTO user_restrictions .* SELECT id, \$2, \$3, \$4, \$5 FROM users WHERE id = \$1 ON CONFLICT \(user_id\) DO UPDATE`).
+			WillReturnError(sql.ErrNoRows)
+
+		err := repo.SetRestriction(ctx, &Restriction{UserID: 404, Level: RestrictionWarn, Reason: "x"})
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("RemoveMissing", func(t *testing.T) {
+		mock.ExpectExec(`DELETE FROM user_restrictions WHERE user_id = \$1`).
+			WithArgs(uint(4)).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		assert.ErrorIs(t, repo.RemoveRestriction(ctx, 4), ErrRestrictionNotFound)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,80 @@
+package user
+
+import (
+	"fmt"
+	"time"
+	"unicode/utf8"
+	"warimas-be/internal/apperr"
+)
+
+// RestrictionLevel is how far an admin restricted an account. Each level
+// includes the ones before it.
+type RestrictionLevel string
+
+const (
+	// RestrictionWarn only tells the user their account is on notice.
+	RestrictionWarn RestrictionLevel = "WARN"
+	// RestrictionBlockCheckout stops the user from starting or confirming
+	// a checkout.
+	RestrictionBlockCheckout RestrictionLevel = "BLOCK_CHECKOUT"
+	// RestrictionSuspend also stops the user from logging in.
+	RestrictionSuspend RestrictionLevel = "SUSPEND"
+)
+
+const maxRestrictionReason = 500
+
+var (
+	ErrCheckoutBlocked       = apperr.New(apperr.CodeCheckoutBlocked, "checkout is blocked on this account, contact support")
+	ErrAccountSuspended      = apperr.New(apperr.CodeAccountSuspended, "this account is suspended, contact support")
+	ErrInvalidRestriction    = apperr.Invalid("restriction level must be WARN, BLOCK_CHECKOUT or SUSPEND")
+	ErrInvalidRestrictReason = apperr.Invalid(fmt.Sprintf("restriction reason must have 1 to %d characters", maxRestrictionReason))
+	ErrInvalidRestrictExpiry = apperr.Invalid("restriction expiry must be in the future")
+	ErrRestrictionNotFound   = apperr.NotFound("account is not restricted")
+	ErrRestrictUserNotFound  = apperr.NotFound("user not found")
+)
+
+// Restriction is an admin's restriction on a user's account. An account
+// has at most one; it stops applying at ExpiresAt, or never when nil.
+type Restriction struct {
+	UserID    uint
+	Level     RestrictionLevel
+	Reason    string
+	ExpiresAt *time.Time
+	CreatedBy *int32
+	CreatedAt time.Time
+}
+
+type RestrictParams struct {
+	UserID    uint
+	Level     RestrictionLevel
+	Reason    string
+	ExpiresAt *time.Time
+}
+
+func (l RestrictionLevel) valid() bool {
+	switch l {
+	case RestrictionWarn, RestrictionBlockCheckout, RestrictionSuspend:
+		return true
+	}
+	return false
+}
+
+// CheckoutErr is the error a checkout fails with under r, nil when r is
+// nil or lets the user check out.
+func (r *Restriction) CheckoutErr() error {
+	if r == nil {
+		return nil
+	}
+	switch r.Level {
+	case RestrictionBlockCheckout:
+		return ErrCheckoutBlocked
+	case RestrictionSuspend:
+		return ErrAccountSuspended
+	}
+	return nil
+}
+
+func validReason(reason string) bool {
+	n := utf8.RuneCountInString(reason)
+	return n > 0 && n <= maxRestrictionReason
+}
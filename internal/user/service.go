@@ -2,10 +2,16 @@ package user
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
+	"time"
+	"unicode/utf8"
 	"warimas-be/internal/logger"
+	"warimas-be/internal/moderation"
+	"warimas-be/internal/utils"
 
 	"go.uber.org/zap"
 )
@@ -20,14 +26,48 @@ type Service interface {
 	ResetPassword(ctx context.Context, token, newPassword string) error
 	GetOrCreateProfile(ctx context.Context, userID uint) (*Profile, error)
 	UpdateProfile(ctx context.Context, params UpdateProfileParams) (*Profile, error)
+
+	// RequestPhoneVerification sends a one-time code to phone. Proving it
+	// with VerifyPhone makes phone the user's verified number.
+	RequestPhoneVerification(ctx context.Context, userID uint, phone string) error
+	VerifyPhone(ctx context.Context, userID uint, code string) (*Profile, error)
+	// LoginWithPhone logs in by verified phone instead of email.
+	LoginWithPhone(ctx context.Context, phone, password string) (string, *User, error)
+	// RequestLoginOTP sends a login code to a verified phone, and
+	// LoginWithOTP logs in with it, without a password. Unknown numbers
+	// and rate-limited requests look like successful ones.
+	RequestLoginOTP(ctx context.Context, phone string) error
+	LoginWithOTP(ctx context.Context, phone, code string) (string, *User, error)
+
+	// SetAvatar points the user's avatar at an uploaded image.
+	SetAvatar(ctx context.Context, userID uint, url string) error
+	// The display name blocklist is managed by admins; UpdateProfile
+	// rejects display names containing a blocked term.
+	ListBlockedTerms(ctx context.Context) ([]*BlockedTerm, error)
+	BlockTerm(ctx context.Context, term string) (*BlockedTerm, error)
+	UnblockTerm(ctx context.Context, term string) error
+
+	// RestrictAccount warns a user, blocks their checkouts or suspends
+	// them, replacing any restriction they had. LiftRestriction ends it
+	// early. Both and ListRestrictions are admin only.
+	RestrictAccount(ctx context.Context, params RestrictParams) (*Restriction, error)
+	LiftRestriction(ctx context.Context, userID uint) error
+	ListRestrictions(ctx context.Context, limit int32) ([]*Restriction, error)
+	// MyRestriction is the caller's restriction in force, nil when none.
+	MyRestriction(ctx context.Context) (*Restriction, error)
 }
 
 type service struct {
-	repo Repository
+	repo     Repository
+	otp      OTPSender
+	screener Screener
+	now      func() time.Time
 }
 
-func NewService(repo Repository) Service {
-	return &service{repo: repo}
+// NewService creates the user service; otp delivers phone verification
+// codes and screener queues display names for moderation.
+func NewService(repo Repository, otp OTPSender, screener Screener) Service {
+	return &service{repo: repo, otp: otp, screener: screener, now: time.Now}
 }
 
 func (s *service) Register(ctx context.Context, email, password string) (string, *User, error) {
@@ -93,6 +133,10 @@ func (s *service) Login(ctx context.Context, email, password string) (string, *U
 		return "", nil, errors.New("invalid credentials")
 	}
 
+	if err := s.checkNotBanned(ctx, u.ID); err != nil {
+		return "", nil, err
+	}
+
 	// Generate token
 	token, err := GenerateJWT(u.ID, string(u.Role), email, u.SellerID)
 	if err != nil {
@@ -225,10 +269,29 @@ func (s *service) UpdateProfile(ctx context.Context, params UpdateProfileParams)
 		zap.Uint("user_id", params.UserID),
 	)
 
+	if params.Phone != nil {
+		phone, err := NormalizePhone(*params.Phone)
+		if err != nil {
+			log.Warn("invalid phone")
+			return nil, err
+		}
+		params.Phone = &phone
+	}
+
+	if params.DisplayName != nil {
+		name, err := s.checkDisplayName(ctx, *params.DisplayName)
+		if err != nil {
+			log.Warn("display name rejected", zap.Error(err))
+			return nil, err
+		}
+		params.DisplayName = &name
+	}
+
 	// Construct profile object with fields to update
 	p := &Profile{
 		UserID:      params.UserID,
 		FullName:    params.FullName,
+		DisplayName: params.DisplayName,
 		Bio:         params.Bio,
 		AvatarURL:   params.AvatarURL,
 		Phone:       params.Phone,
@@ -241,6 +304,458 @@ func (s *service) UpdateProfile(ctx context.Context, params UpdateProfileParams)
 		return nil, err
 	}
 
+	if params.DisplayName != nil && updatedProfile.DisplayName != nil {
+		author := int32(params.UserID)
+		s.screener.Screen(ctx, &moderation.Content{
+			Type:     moderation.ContentDisplayName,
+			ID:       strconv.FormatUint(uint64(params.UserID), 10),
+			AuthorID: &author,
+			Body:     *updatedProfile.DisplayName,
+		})
+	}
+
 	log.Info("profile updated successfully")
 	return updatedProfile, nil
 }
+
+func (s *service) RequestPhoneVerification(ctx context.Context, userID uint, phone string) error {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "RequestPhoneVerification"),
+		zap.Uint("user_id", userID),
+	)
+
+	phone, err := NormalizePhone(phone)
+	if err != nil {
+		log.Warn("invalid phone")
+		return err
+	}
+
+	owner, err := s.repo.FindByPhone(ctx, phone)
+	switch {
+	case err == nil && uint(owner.ID) != userID:
+		log.Warn("phone already verified on another account")
+		return ErrPhoneTaken
+	case err != nil && !errors.Is(err, sql.ErrNoRows):
+		return err
+	}
+
+	if err := s.sendOTP(ctx, userID, phone, OTPVerify); err != nil {
+		log.Warn("phone verification code not sent", zap.Error(err))
+		return err
+	}
+
+	log.Info("phone verification code sent")
+	return nil
+}
+
+func (s *service) VerifyPhone(ctx context.Context, userID uint, code string) (*Profile, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "VerifyPhone"),
+		zap.Uint("user_id", userID),
+	)
+
+	v, err := s.checkOTP(ctx, userID, OTPVerify, code)
+	if err != nil {
+		log.Warn("phone not verified", zap.Error(err))
+		return nil, err
+	}
+
+	if err := s.repo.ConfirmPhone(ctx, userID, v.Phone); err != nil {
+		return nil, err
+	}
+
+	log.Info("phone verified")
+	return s.repo.GetProfile(ctx, userID)
+}
+
+func (s *service) RequestLoginOTP(ctx context.Context, phone string) error {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "RequestLoginOTP"),
+	)
+
+	phone, err := NormalizePhone(phone)
+	if err != nil {
+		log.Warn("invalid phone")
+		return err
+	}
+
+	u, err := s.repo.FindByPhone(ctx, phone)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// Like ForgotPassword, unknown numbers look the same as known ones
+			log.Warn("phone not verified on any account")
+			return nil
+		}
+		return err
+	}
+
+	log = log.With(zap.Int("user_id", u.ID))
+	if err := s.sendOTP(ctx, uint(u.ID), phone, OTPLogin); err != nil {
+		if errors.Is(err, ErrOTPTooSoon) || errors.Is(err, ErrOTPSendLimit) {
+			// Answering differently would tell the number has an account
+			log.Warn("login code rate limited", zap.Error(err))
+			return nil
+		}
+		log.Error("failed to send login code", zap.Error(err))
+		return err
+	}
+
+	log.Info("login code sent")
+	return nil
+}
+
+func (s *service) LoginWithOTP(ctx context.Context, phone, code string) (string, *User, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "LoginWithOTP"),
+	)
+
+	normalized, err := NormalizePhone(phone)
+	if err != nil {
+		log.Warn("invalid phone")
+		return "", nil, ErrOTPInvalid
+	}
+
+	u, err := s.repo.FindByPhone(ctx, normalized)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			log.Warn("phone not verified on any account")
+			return "", nil, ErrOTPInvalid
+		}
+		return "", nil, err
+	}
+
+	log = log.With(zap.Int("user_id", u.ID))
+	v, err := s.checkOTP(ctx, uint(u.ID), OTPLogin, code)
+	if err != nil {
+		log.Warn("login code rejected", zap.Error(err))
+		return "", nil, err
+	}
+	// The code was sent to the phone the account had then
+	if v.Phone != normalized {
+		log.Warn("login code was sent to another phone")
+		return "", nil, ErrOTPInvalid
+	}
+
+	if err := s.repo.ExpirePhoneVerification(ctx, uint(u.ID), OTPLogin); err != nil {
+		return "", nil, err
+	}
+
+	if err := s.checkNotBanned(ctx, u.ID); err != nil {
+		return "", nil, err
+	}
+
+	token, err := GenerateJWT(u.ID, string(u.Role), u.Email, u.SellerID)
+	if err != nil {
+		log.Error("failed to generate jwt", zap.Error(err))
+		return "", nil, errors.New("internal error")
+	}
+
+	log.Info("Login successful", zap.String("role", string(u.Role)))
+	return token, u, nil
+}
+
+// sendOTP sends userID a new code for purpose to phone, within the resend
+// interval and hourly cap of that purpose.
+func (s *service) sendOTP(ctx context.Context, userID uint, phone string, purpose OTPPurpose) error {
+	last, err := s.repo.GetPhoneVerification(ctx, userID, purpose)
+	if err != nil && !errors.Is(err, errVerificationMissing) {
+		return err
+	}
+	if last != nil {
+		if err := last.sendLimited(s.now()); err != nil {
+			return err
+		}
+	}
+
+	code, err := generateOTP()
+	if err != nil {
+		return err
+	}
+	hash, err := HashPassword(code)
+	if err != nil {
+		return err
+	}
+
+	v := &PhoneVerification{
+		UserID:    userID,
+		Purpose:   purpose,
+		Phone:     phone,
+		CodeHash:  hash,
+		ExpiresAt: s.now().Add(otpTTL),
+	}
+	if err := s.repo.SavePhoneVerification(ctx, v); err != nil {
+		return err
+	}
+
+	return s.otp.SendOTP(ctx, phone, code)
+}
+
+// checkOTP loads userID's code for purpose and checks code against it.
+// Every check of an unexpired code, right or wrong, uses up an attempt.
+func (s *service) checkOTP(ctx context.Context, userID uint, purpose OTPPurpose, code string) (*PhoneVerification, error) {
+	v, err := s.repo.GetPhoneVerification(ctx, userID, purpose)
+	if err != nil {
+		if errors.Is(err, errVerificationMissing) {
+			return nil, ErrOTPInvalid
+		}
+		return nil, err
+	}
+
+	if s.now().After(v.ExpiresAt) {
+		return nil, ErrOTPExpired
+	}
+	if err := s.repo.ClaimPhoneVerificationAttempt(ctx, v.ID, otpMaxAttempts); err != nil {
+		return nil, err
+	}
+	if !v.matches(code) {
+		return nil, ErrOTPInvalid
+	}
+	return v, nil
+}
+
+func (s *service) LoginWithPhone(ctx context.Context, phone, password string) (string, *User, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "LoginWithPhone"),
+	)
+
+	normalized, err := NormalizePhone(phone)
+	if err != nil {
+		log.Warn("invalid phone")
+		return "", nil, errors.New("invalid credentials")
+	}
+
+	u, err := s.repo.FindByPhone(ctx, normalized)
+	if err != nil {
+		log.Warn("phone not found", zap.Error(err))
+		return "", nil, errors.New("invalid credentials")
+	}
+
+	if !CheckPasswordHash(password, u.Password) {
+		log.Warn("incorrect password")
+		return "", nil, errors.New("invalid credentials")
+	}
+
+	if err := s.checkNotBanned(ctx, u.ID); err != nil {
+		return "", nil, err
+	}
+
+	token, err := GenerateJWT(u.ID, string(u.Role), u.Email, u.SellerID)
+	if err != nil {
+		log.Error("failed to generate jwt", zap.Error(err))
+		return "", nil, errors.New("internal error")
+	}
+
+	log.Info("Login successful",
+		zap.Int("user_id", u.ID),
+		zap.String("role", string(u.Role)),
+	)
+
+	return token, u, nil
+}
+
+// checkDisplayName cleans name and fails unless it follows the display
+// name rules and contains no blocked term.
+func (s *service) checkDisplayName(ctx context.Context, name string) (string, error) {
+	name, err := cleanDisplayName(name)
+	if err != nil {
+		return "", err
+	}
+
+	terms, err := s.repo.ListBlockedTerms(ctx)
+	if err != nil {
+		return "", err
+	}
+	if term, blocked := blockedBy(name, terms); blocked {
+		logger.FromCtx(ctx).Info("display name contains blocked term", zap.String("term", term))
+		return "", ErrDisplayNameBlocked
+	}
+	return name, nil
+}
+
+func (s *service) SetAvatar(ctx context.Context, userID uint, url string) error {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "SetAvatar"),
+		zap.Uint("user_id", userID),
+	)
+
+	if _, err := s.GetOrCreateProfile(ctx, userID); err != nil {
+		return err
+	}
+	if _, err := s.repo.UpdateProfile(ctx, &Profile{UserID: userID, AvatarURL: &url}); err != nil {
+		log.Error("failed to set avatar", zap.Error(err))
+		return err
+	}
+
+	log.Info("avatar updated")
+	return nil
+}
+
+func (s *service) ListBlockedTerms(ctx context.Context) ([]*BlockedTerm, error) {
+	if !utils.IsAdmin(ctx) {
+		return nil, ErrForbidden
+	}
+	return s.repo.ListBlockedTerms(ctx)
+}
+
+// BlockTerm adds term to the display name blocklist in its folded form.
+// Display names already saved are left as they are.
+func (s *service) BlockTerm(ctx context.Context, term string) (*BlockedTerm, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "BlockTerm"),
+	)
+
+	if !utils.IsAdmin(ctx) {
+		return nil, ErrForbidden
+	}
+
+	folded := foldTerm(term)
+	if n := utf8.RuneCountInString(folded); n < 2 || n > maxBlockedTerm {
+		return nil, ErrInvalidBlockedTerm
+	}
+
+	t := &BlockedTerm{Term: folded}
+	if adminID, ok := utils.GetUserIDFromContext(ctx); ok {
+		id := int32(adminID)
+		t.CreatedBy = &id
+	}
+	if err := s.repo.AddBlockedTerm(ctx, t); err != nil {
+		return nil, err
+	}
+
+	log.Info("display name term blocked", zap.String("term", folded))
+	return t, nil
+}
+
+func (s *service) UnblockTerm(ctx context.Context, term string) error {
+	if !utils.IsAdmin(ctx) {
+		return ErrForbidden
+	}
+	if err := s.repo.RemoveBlockedTerm(ctx, foldTerm(term)); err != nil {
+		return err
+	}
+
+	logger.FromCtx(ctx).Info("display name term unblocked",
+		zap.String("layer", "service"),
+		zap.String("term", foldTerm(term)),
+	)
+	return nil
+}
+
+// checkNotBanned fails a login for a banned or suspended account once its
+// credentials have checked out, so the error does not reveal which
+// accounts exist.
+func (s *service) checkNotBanned(ctx context.Context, userID int) error {
+	banned, err := s.repo.IsBanned(ctx, uint(userID))
+	if err != nil {
+		return errors.New("internal error")
+	}
+	if banned {
+		logger.FromCtx(ctx).Warn("banned user tried to log in", zap.Int("user_id", userID))
+		return ErrAccountBanned
+	}
+
+	res, err := s.repo.GetRestriction(ctx, uint(userID))
+	if err != nil {
+		return errors.New("internal error")
+	}
+	if res != nil && res.Level == RestrictionSuspend {
+		logger.FromCtx(ctx).Warn("suspended user tried to log in", zap.Int("user_id", userID))
+		return ErrAccountSuspended
+	}
+	return nil
+}
+
+const (
+	defaultRestrictionLimit = 50
+	maxRestrictionLimit     = 500
+)
+
+func (s *service) RestrictAccount(ctx context.Context, params RestrictParams) (*Restriction, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "RestrictAccount"),
+		zap.Uint("user_id", params.UserID),
+	)
+
+	if !utils.IsAdmin(ctx) {
+		return nil, ErrForbidden
+	}
+
+	reason := strings.TrimSpace(params.Reason)
+	switch {
+	case !params.Level.valid():
+		return nil, ErrInvalidRestriction
+	case !validReason(reason):
+		return nil, ErrInvalidRestrictReason
+	case params.ExpiresAt != nil && !params.ExpiresAt.After(s.now()):
+		return nil, ErrInvalidRestrictExpiry
+	}
+
+	adminID, _ := utils.GetUserIDFromContext(ctx)
+	if params.UserID == adminID {
+		return nil, ErrForbidden
+	}
+
+	res := &Restriction{
+		UserID:    params.UserID,
+		Level:     params.Level,
+		Reason:    reason,
+		ExpiresAt: params.ExpiresAt,
+	}
+	if adminID != 0 {
+		id := int32(adminID)
+		res.CreatedBy = &id
+	}
+	if err := s.repo.SetRestriction(ctx, res); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrRestrictUserNotFound
+		}
+		return nil, err
+	}
+
+	log.Info("account restricted", zap.String("level", string(res.Level)))
+	return res, nil
+}
+
+func (s *service) LiftRestriction(ctx context.Context, userID uint) error {
+	if !utils.IsAdmin(ctx) {
+		return ErrForbidden
+	}
+	if err := s.repo.RemoveRestriction(ctx, userID); err != nil {
+		return err
+	}
+
+	logger.FromCtx(ctx).Info("account restriction lifted",
+		zap.String("layer", "service"),
+		zap.Uint("user_id", userID),
+	)
+	return nil
+}
+
+func (s *service) ListRestrictions(ctx context.Context, limit int32) ([]*Restriction, error) {
+	if !utils.IsAdmin(ctx) {
+		return nil, ErrForbidden
+	}
+	if limit <= 0 {
+		limit = defaultRestrictionLimit
+	}
+	if limit > maxRestrictionLimit {
+		limit = maxRestrictionLimit
+	}
+	return s.repo.ListRestrictions(ctx, limit)
+}
+
+func (s *service) MyRestriction(ctx context.Context) (*Restriction, error) {
+	userID, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		return nil, ErrForbidden
+	}
+	return s.repo.GetRestriction(ctx, userID)
+}
@@ -2,9 +2,12 @@ package user
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 	"testing"
 	"time"
+	"warimas-be/internal/moderation"
+	"warimas-be/internal/utils"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
@@ -61,6 +64,126 @@ func (m *MockRepository) UpdateProfile(ctx context.Context, p *Profile) (*Profil
 	return args.Get(0).(*Profile), args.Error(1)
 }
 
+func (m *MockRepository) FindByPhone(ctx context.Context, phone string) (*User, error) {
+	args := m.Called(ctx, phone)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*User), args.Error(1)
+}
+
+func (m *MockRepository) SavePhoneVerification(ctx context.Context, v *PhoneVerification) error {
+	args := m.Called(ctx, v)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetPhoneVerification(ctx context.Context, userID uint, purpose OTPPurpose) (*PhoneVerification, error) {
+	args := m.Called(ctx, userID, purpose)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*PhoneVerification), args.Error(1)
+}
+
+func (m *MockRepository) ClaimPhoneVerificationAttempt(ctx context.Context, id int64, max int) error {
+	args := m.Called(ctx, id, max)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ExpirePhoneVerification(ctx context.Context, userID uint, purpose OTPPurpose) error {
+	args := m.Called(ctx, userID, purpose)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ConfirmPhone(ctx context.Context, userID uint, phone string) error {
+	args := m.Called(ctx, userID, phone)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ListBlockedTerms(ctx context.Context) ([]*BlockedTerm, error) {
+	args := m.Called(ctx)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*BlockedTerm), args.Error(1)
+}
+
+func (m *MockRepository) AddBlockedTerm(ctx context.Context, t *BlockedTerm) error {
+	args := m.Called(ctx, t)
+	return args.Error(0)
+}
+
+func (m *MockRepository) RemoveBlockedTerm(ctx context.Context, term string) error {
+	args := m.Called(ctx, term)
+	return args.Error(0)
+}
+
+func (m *MockRepository) BanUser(ctx context.Context, userID uint, reason string) error {
+	args := m.Called(ctx, userID, reason)
+	return args.Error(0)
+}
+
+func (m *MockRepository) IsBanned(ctx context.Context, userID uint) (bool, error) {
+	args := m.Called(ctx, userID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRepository) SetRestriction(ctx context.Context, r *Restriction) error {
+	args := m.Called(ctx, r)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetRestriction(ctx context.Context, userID uint) (*Restriction, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Restriction), args.Error(1)
+}
+
+func (m *MockRepository) RemoveRestriction(ctx context.Context, userID uint) error {
+	args := m.Called(ctx, userID)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ListRestrictions(ctx context.Context, limit int32) ([]*Restriction, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Restriction), args.Error(1)
+}
+
+func (m *MockRepository) ModerationContent(ctx context.Context, t moderation.ContentType, id string) (*moderation.Content, error) {
+	args := m.Called(ctx, t, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*moderation.Content), args.Error(1)
+}
+
+func (m *MockRepository) RemoveModerationContent(ctx context.Context, t moderation.ContentType, id string) error {
+	args := m.Called(ctx, t, id)
+	return args.Error(0)
+}
+
+type MockScreener struct {
+	mock.Mock
+}
+
+func (m *MockScreener) Screen(ctx context.Context, c *moderation.Content) {
+	m.Called(ctx, c)
+}
+
+type MockOTPSender struct {
+	mock.Mock
+}
+
+func (m *MockOTPSender) SendOTP(ctx context.Context, phone, code string) error {
+	args := m.Called(ctx, phone, code)
+	return args.Error(0)
+}
+
 func TestService_Register(t *testing.T) {
 	t.Setenv("JWT_SECRET", "testsecret")
 	ctx := context.Background()
@@ -69,7 +192,7 @@ func TestService_Register(t *testing.T) {
 
 	t.Run("Success", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo)
+		svc := NewService(mockRepo, nil, nil)
 
 		expectedUser := &User{
 			ID:       1,
@@ -91,7 +214,7 @@ func TestService_Register(t *testing.T) {
 
 	t.Run("EmailExists", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo)
+		svc := NewService(mockRepo, nil, nil)
 
 		mockRepo.On("Create", ctx, email, mock.Anything, string(RoleUser)).Return(nil, errors.New("duplicate key value violates unique constraint \"users_email_key\""))
 
@@ -103,7 +226,7 @@ func TestService_Register(t *testing.T) {
 
 	t.Run("RepoError", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo)
+		svc := NewService(mockRepo, nil, nil)
 
 		mockRepo.On("Create", ctx, email, mock.Anything, string(RoleUser)).Return(nil, errors.New("db error"))
 
@@ -124,7 +247,7 @@ func TestService_Login(t *testing.T) {
 
 	t.Run("Success", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo)
+		svc := NewService(mockRepo, nil, nil)
 
 		user := &User{
 			ID:       1,
@@ -134,6 +257,8 @@ func TestService_Login(t *testing.T) {
 		}
 
 		mockRepo.On("FindByEmail", ctx, email).Return(user, nil)
+		mockRepo.On("IsBanned", ctx, uint(1)).Return(false, nil)
+		mockRepo.On("GetRestriction", ctx, uint(1)).Return(nil, nil)
 
 		token, u, err := svc.Login(ctx, email, password)
 
@@ -142,9 +267,38 @@ func TestService_Login(t *testing.T) {
 		assert.Equal(t, user, u)
 	})
 
+	t.Run("Banned", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil)
+
+		mockRepo.On("FindByEmail", ctx, email).
+			Return(&User{ID: 1, Email: email, Password: hashedPassword, Role: RoleUser}, nil)
+		mockRepo.On("IsBanned", ctx, uint(1)).Return(true, nil)
+
+		token, _, err := svc.Login(ctx, email, password)
+
+		assert.ErrorIs(t, err, ErrAccountBanned)
+		assert.Empty(t, token)
+	})
+
+	t.Run("Suspended", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil)
+
+		mockRepo.On("FindByEmail", ctx, email).
+			Return(&User{ID: 1, Email: email, Password: hashedPassword, Role: RoleUser}, nil)
+		mockRepo.On("IsBanned", ctx, uint(1)).Return(false, nil)
+		mockRepo.On("GetRestriction", ctx, uint(1)).
+			Return(&Restriction{UserID: 1, Level: RestrictionSuspend}, nil)
+
+		_, _, err := svc.Login(ctx, email, password)
+
+		assert.ErrorIs(t, err, ErrAccountSuspended)
+	})
+
 	t.Run("UserNotFound", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo)
+		svc := NewService(mockRepo, nil, nil)
 
 		mockRepo.On("FindByEmail", ctx, email).Return(nil, errors.New("not found"))
 
@@ -156,7 +310,7 @@ func TestService_Login(t *testing.T) {
 
 	t.Run("InvalidPassword", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo)
+		svc := NewService(mockRepo, nil, nil)
 
 		user := &User{
 			ID:       1,
@@ -180,7 +334,7 @@ func TestService_GetUserByEmail(t *testing.T) {
 
 	t.Run("Success", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo)
+		svc := NewService(mockRepo, nil, nil)
 		expectedUser := &User{ID: 1, Email: email}
 
 		mockRepo.On("FindByEmail", ctx, email).Return(expectedUser, nil)
@@ -192,7 +346,7 @@ func TestService_GetUserByEmail(t *testing.T) {
 
 	t.Run("Error", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo)
+		svc := NewService(mockRepo, nil, nil)
 
 		mockRepo.On("FindByEmail", ctx, email).Return(nil, errors.New("db error"))
 
@@ -208,7 +362,7 @@ func TestService_ForgotPassword(t *testing.T) {
 
 	t.Run("Success", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo)
+		svc := NewService(mockRepo, nil, nil)
 		user := &User{ID: 1, Email: email, Role: RoleUser}
 
 		mockRepo.On("FindByEmail", ctx, email).Return(user, nil)
@@ -219,7 +373,7 @@ func TestService_ForgotPassword(t *testing.T) {
 
 	t.Run("UserNotFound", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo)
+		svc := NewService(mockRepo, nil, nil)
 
 		mockRepo.On("FindByEmail", ctx, email).Return(nil, errors.New("not found"))
 
@@ -239,7 +393,7 @@ func TestService_ResetPassword(t *testing.T) {
 
 	t.Run("Success", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo)
+		svc := NewService(mockRepo, nil, nil)
 
 		mockRepo.On("UpdatePassword", ctx, email, mock.Anything).Return(nil)
 
@@ -249,7 +403,7 @@ func TestService_ResetPassword(t *testing.T) {
 
 	t.Run("InvalidToken", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo)
+		svc := NewService(mockRepo, nil, nil)
 
 		err := svc.ResetPassword(ctx, "invalid-token", newPassword)
 		assert.Error(t, err)
@@ -258,7 +412,7 @@ func TestService_ResetPassword(t *testing.T) {
 
 	t.Run("UpdateError", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo)
+		svc := NewService(mockRepo, nil, nil)
 
 		mockRepo.On("UpdatePassword", ctx, email, mock.Anything).Return(errors.New("db error"))
 
@@ -273,7 +427,7 @@ func TestService_GetOrCreateProfile(t *testing.T) {
 
 	t.Run("ProfileExists", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo)
+		svc := NewService(mockRepo, nil, nil)
 
 		expectedProfile := &Profile{
 			ID:     uuid.New(),
@@ -291,7 +445,7 @@ func TestService_GetOrCreateProfile(t *testing.T) {
 
 	t.Run("ProfileNotFound_CreateNew", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo)
+		svc := NewService(mockRepo, nil, nil)
 
 		// GetProfile returns ErrProfileNotFound
 		mockRepo.On("GetProfile", ctx, userID).Return(nil, ErrProfileNotFound)
@@ -316,7 +470,7 @@ func TestService_GetOrCreateProfile(t *testing.T) {
 
 	t.Run("GetProfile_DBError", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo)
+		svc := NewService(mockRepo, nil, nil)
 
 		mockRepo.On("GetProfile", ctx, userID).Return(nil, errors.New("db error"))
 
@@ -330,7 +484,7 @@ func TestService_GetOrCreateProfile(t *testing.T) {
 
 	t.Run("CreateProfile_DBError", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo)
+		svc := NewService(mockRepo, nil, nil)
 
 		mockRepo.On("GetProfile", ctx, userID).Return(nil, ErrProfileNotFound)
 		mockRepo.On("CreateProfile", ctx, mock.Anything).Return(nil, errors.New("create error"))
@@ -359,7 +513,7 @@ func TestService_UpdateProfile(t *testing.T) {
 
 	t.Run("Success", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo)
+		svc := NewService(mockRepo, nil, nil)
 
 		updatedProfile := &Profile{
 			ID:          uuid.New(),
@@ -385,7 +539,7 @@ func TestService_UpdateProfile(t *testing.T) {
 
 	t.Run("DBError", func(t *testing.T) {
 		mockRepo := new(MockRepository)
-		svc := NewService(mockRepo)
+		svc := NewService(mockRepo, nil, nil)
 
 		mockRepo.On("UpdateProfile", ctx, mock.Anything).Return(nil, errors.New("update error"))
 
@@ -404,7 +558,7 @@ func TestService_Register_JWTError(t *testing.T) {
 	password := "password123"
 
 	mockRepo := new(MockRepository)
-	svc := NewService(mockRepo)
+	svc := NewService(mockRepo, nil, nil)
 
 	expectedUser := &User{ID: 1, Email: email, Role: RoleUser}
 	mockRepo.On("Create", ctx, email, mock.Anything, string(RoleUser)).Return(expectedUser, nil)
@@ -422,7 +576,7 @@ func TestService_Register_HashError(t *testing.T) {
 	longPassword := string(make([]byte, 73))
 
 	mockRepo := new(MockRepository)
-	svc := NewService(mockRepo)
+	svc := NewService(mockRepo, nil, nil)
 
 	_, _, err := svc.Register(ctx, email, longPassword)
 	assert.Error(t, err)
@@ -437,10 +591,12 @@ func TestService_Login_JWTError(t *testing.T) {
 	hashed, _ := HashPassword(password)
 
 	mockRepo := new(MockRepository)
-	svc := NewService(mockRepo)
+	svc := NewService(mockRepo, nil, nil)
 
 	user := &User{ID: 1, Email: email, Password: hashed, Role: RoleUser}
 	mockRepo.On("FindByEmail", ctx, email).Return(user, nil)
+	mockRepo.On("IsBanned", ctx, uint(1)).Return(false, nil)
+	mockRepo.On("GetRestriction", ctx, uint(1)).Return(nil, nil)
 
 	_, _, err := svc.Login(ctx, email, password)
 	assert.Error(t, err)
@@ -453,7 +609,7 @@ func TestService_ForgotPassword_JWTError(t *testing.T) {
 	email := "test@example.com"
 
 	mockRepo := new(MockRepository)
-	svc := NewService(mockRepo)
+	svc := NewService(mockRepo, nil, nil)
 
 	user := &User{ID: 1, Email: email, Role: RoleUser}
 	mockRepo.On("FindByEmail", ctx, email).Return(user, nil)
@@ -472,9 +628,511 @@ func TestService_ResetPassword_HashError(t *testing.T) {
 	longPassword := string(make([]byte, 73))
 
 	mockRepo := new(MockRepository)
-	svc := NewService(mockRepo)
+	svc := NewService(mockRepo, nil, nil)
 
 	err := svc.ResetPassword(ctx, token, longPassword)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "password length exceeds")
 }
+
+func TestNormalizePhone(t *testing.T) {
+	phone, err := NormalizePhone("0812-3456 789")
+	assert.NoError(t, err)
+	assert.Equal(t, "+628123456789", phone)
+
+	phone, err = NormalizePhone("+62 (812) 3456.789")
+	assert.NoError(t, err)
+	assert.Equal(t, "+628123456789", phone)
+
+	_, err = NormalizePhone("12345")
+	assert.ErrorIs(t, err, ErrInvalidPhone)
+}
+
+func TestService_RequestPhoneVerification(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	phone := "+628123456789"
+
+	newService := func() (*service, *MockRepository, *MockOTPSender) {
+		mockRepo := new(MockRepository)
+		sender := new(MockOTPSender)
+		svc := NewService(mockRepo, sender, nil).(*service)
+		svc.now = func() time.Time { return now }
+		return svc, mockRepo, sender
+	}
+
+	t.Run("SendsCode", func(t *testing.T) {
+		svc, mockRepo, sender := newService()
+		mockRepo.On("FindByPhone", ctx, phone).Return(nil, sql.ErrNoRows)
+		mockRepo.On("GetPhoneVerification", ctx, uint(1), OTPVerify).Return(nil, errVerificationMissing)
+
+		var sent string
+		sender.On("SendOTP", ctx, phone, mock.AnythingOfType("string")).
+			Run(func(args mock.Arguments) { sent = args.String(2) }).Return(nil)
+
+		var saved *PhoneVerification
+		mockRepo.On("SavePhoneVerification", ctx, mock.Anything).
+			Run(func(args mock.Arguments) { saved = args.Get(1).(*PhoneVerification) }).Return(nil)
+
+		err := svc.RequestPhoneVerification(ctx, 1, "08123456789")
+
+		assert.NoError(t, err)
+		assert.Len(t, sent, otpDigits)
+		assert.Equal(t, phone, saved.Phone)
+		assert.Equal(t, now.Add(otpTTL), saved.ExpiresAt)
+		assert.True(t, CheckPasswordHash(sent, saved.CodeHash))
+	})
+
+	t.Run("TooSoon", func(t *testing.T) {
+		svc, mockRepo, sender := newService()
+		mockRepo.On("FindByPhone", ctx, phone).Return(nil, sql.ErrNoRows)
+		mockRepo.On("GetPhoneVerification", ctx, uint(1), OTPVerify).
+			Return(&PhoneVerification{CreatedAt: now.Add(-30 * time.Second)}, nil)
+
+		err := svc.RequestPhoneVerification(ctx, 1, phone)
+
+		assert.ErrorIs(t, err, ErrOTPTooSoon)
+		sender.AssertNotCalled(t, "SendOTP", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("HourlyLimit", func(t *testing.T) {
+		svc, mockRepo, sender := newService()
+		mockRepo.On("FindByPhone", ctx, phone).Return(nil, sql.ErrNoRows)
+		mockRepo.On("GetPhoneVerification", ctx, uint(1), OTPVerify).Return(&PhoneVerification{
+			Sends:           otpMaxSends,
+			WindowStartedAt: now.Add(-30 * time.Minute),
+			CreatedAt:       now.Add(-5 * time.Minute),
+		}, nil)
+
+		err := svc.RequestPhoneVerification(ctx, 1, phone)
+
+		assert.ErrorIs(t, err, ErrOTPSendLimit)
+		sender.AssertNotCalled(t, "SendOTP", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("TakenByAnotherUser", func(t *testing.T) {
+		svc, mockRepo, _ := newService()
+		mockRepo.On("FindByPhone", ctx, phone).Return(&User{ID: 2}, nil)
+
+		err := svc.RequestPhoneVerification(ctx, 1, phone)
+
+		assert.ErrorIs(t, err, ErrPhoneTaken)
+	})
+
+	t.Run("InvalidPhone", func(t *testing.T) {
+		svc, _, _ := newService()
+
+		err := svc.RequestPhoneVerification(ctx, 1, "abc")
+
+		assert.ErrorIs(t, err, ErrInvalidPhone)
+	})
+}
+
+func TestService_VerifyPhone(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	phone := "+628123456789"
+	hash, err := HashPassword("123456")
+	assert.NoError(t, err)
+
+	newService := func(v *PhoneVerification) (*service, *MockRepository) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil).(*service)
+		svc.now = func() time.Time { return now }
+		mockRepo.On("GetPhoneVerification", ctx, uint(1), OTPVerify).Return(v, nil)
+		return svc, mockRepo
+	}
+	pending := func() *PhoneVerification {
+		return &PhoneVerification{ID: 7, UserID: 1, Phone: phone, CodeHash: hash, ExpiresAt: now.Add(time.Minute)}
+	}
+
+	t.Run("Verifies", func(t *testing.T) {
+		svc, mockRepo := newService(pending())
+		mockRepo.On("ClaimPhoneVerificationAttempt", ctx, int64(7), otpMaxAttempts).Return(nil)
+		mockRepo.On("ConfirmPhone", ctx, uint(1), phone).Return(nil)
+		verifiedAt := now
+		mockRepo.On("GetProfile", ctx, uint(1)).Return(&Profile{Phone: &phone, PhoneVerifiedAt: &verifiedAt}, nil)
+
+		p, err := svc.VerifyPhone(ctx, 1, " 123456 ")
+
+		assert.NoError(t, err)
+		assert.NotNil(t, p.PhoneVerifiedAt)
+	})
+
+	t.Run("WrongCodeCountsAttempt", func(t *testing.T) {
+		svc, mockRepo := newService(pending())
+		mockRepo.On("ClaimPhoneVerificationAttempt", ctx, int64(7), otpMaxAttempts).Return(nil)
+
+		_, err := svc.VerifyPhone(ctx, 1, "654321")
+
+		assert.ErrorIs(t, err, ErrOTPInvalid)
+		mockRepo.AssertNotCalled(t, "ConfirmPhone", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		v := pending()
+		v.ExpiresAt = now.Add(-time.Second)
+		svc, _ := newService(v)
+
+		_, err := svc.VerifyPhone(ctx, 1, "123456")
+
+		assert.ErrorIs(t, err, ErrOTPExpired)
+	})
+
+	t.Run("TooManyAttempts", func(t *testing.T) {
+		svc, mockRepo := newService(pending())
+		mockRepo.On("ClaimPhoneVerificationAttempt", ctx, int64(7), otpMaxAttempts).Return(ErrOTPTooManyAttempts)
+
+		_, err := svc.VerifyPhone(ctx, 1, "123456")
+
+		assert.ErrorIs(t, err, ErrOTPTooManyAttempts)
+		mockRepo.AssertNotCalled(t, "ConfirmPhone", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("NothingRequested", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil)
+		mockRepo.On("GetPhoneVerification", ctx, uint(1), OTPVerify).Return(nil, errVerificationMissing)
+
+		_, err := svc.VerifyPhone(ctx, 1, "123456")
+
+		assert.ErrorIs(t, err, ErrOTPInvalid)
+	})
+}
+
+func TestService_LoginWithPhone(t *testing.T) {
+	t.Setenv("JWT_SECRET", "testsecret")
+	ctx := context.Background()
+	hashed, _ := HashPassword("password123")
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil)
+		mockRepo.On("FindByPhone", ctx, "+628123456789").
+			Return(&User{ID: 1, Email: "test@example.com", Password: hashed, Role: RoleUser}, nil)
+		mockRepo.On("IsBanned", ctx, uint(1)).Return(false, nil)
+		mockRepo.On("GetRestriction", ctx, uint(1)).Return(nil, nil)
+
+		token, u, err := svc.LoginWithPhone(ctx, "08123456789", "password123")
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, token)
+		assert.Equal(t, "test@example.com", u.Email)
+	})
+
+	t.Run("UnverifiedOrUnknown", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil)
+		mockRepo.On("FindByPhone", ctx, "+628123456789").Return(nil, sql.ErrNoRows)
+
+		_, _, err := svc.LoginWithPhone(ctx, "+628123456789", "password123")
+
+		assert.EqualError(t, err, "invalid credentials")
+	})
+
+	t.Run("WrongPassword", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil)
+		mockRepo.On("FindByPhone", ctx, "+628123456789").
+			Return(&User{ID: 1, Password: hashed}, nil)
+
+		_, _, err := svc.LoginWithPhone(ctx, "+628123456789", "wrong")
+
+		assert.EqualError(t, err, "invalid credentials")
+	})
+}
+
+func TestService_RequestLoginOTP(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	phone := "+628123456789"
+
+	newService := func() (*service, *MockRepository, *MockOTPSender) {
+		mockRepo := new(MockRepository)
+		sender := new(MockOTPSender)
+		svc := NewService(mockRepo, sender, nil).(*service)
+		svc.now = func() time.Time { return now }
+		return svc, mockRepo, sender
+	}
+
+	t.Run("SendsCode", func(t *testing.T) {
+		svc, mockRepo, sender := newService()
+		mockRepo.On("FindByPhone", ctx, phone).Return(&User{ID: 3}, nil)
+		mockRepo.On("GetPhoneVerification", ctx, uint(3), OTPLogin).Return(nil, errVerificationMissing)
+		mockRepo.On("SavePhoneVerification", ctx, mock.MatchedBy(func(v *PhoneVerification) bool {
+			return v.UserID == 3 && v.Purpose == OTPLogin && v.Phone == phone
+		})).Return(nil)
+		sender.On("SendOTP", ctx, phone, mock.AnythingOfType("string")).Return(nil)
+
+		err := svc.RequestLoginOTP(ctx, "08123456789")
+
+		assert.NoError(t, err)
+		sender.AssertExpectations(t)
+	})
+
+	t.Run("UnknownPhoneLooksSent", func(t *testing.T) {
+		svc, mockRepo, sender := newService()
+		mockRepo.On("FindByPhone", ctx, phone).Return(nil, sql.ErrNoRows)
+
+		err := svc.RequestLoginOTP(ctx, phone)
+
+		assert.NoError(t, err)
+		sender.AssertNotCalled(t, "SendOTP", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("RateLimitedLooksSent", func(t *testing.T) {
+		svc, mockRepo, sender := newService()
+		mockRepo.On("FindByPhone", ctx, phone).Return(&User{ID: 3}, nil)
+		mockRepo.On("GetPhoneVerification", ctx, uint(3), OTPLogin).
+			Return(&PhoneVerification{CreatedAt: now.Add(-10 * time.Second)}, nil)
+
+		err := svc.RequestLoginOTP(ctx, phone)
+
+		assert.NoError(t, err)
+		mockRepo.AssertNotCalled(t, "SavePhoneVerification", mock.Anything, mock.Anything)
+		sender.AssertNotCalled(t, "SendOTP", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestService_LoginWithOTP(t *testing.T) {
+	t.Setenv("JWT_SECRET", "testsecret")
+	ctx := context.Background()
+	now := time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	phone := "+628123456789"
+	hash, err := HashPassword("123456")
+	assert.NoError(t, err)
+
+	newService := func(v *PhoneVerification) (*service, *MockRepository) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil).(*service)
+		svc.now = func() time.Time { return now }
+		mockRepo.On("FindByPhone", ctx, phone).Return(&User{ID: 3, Email: "otp@example.com", Role: RoleUser}, nil)
+		mockRepo.On("GetPhoneVerification", ctx, uint(3), OTPLogin).Return(v, nil)
+		return svc, mockRepo
+	}
+	pending := func() *PhoneVerification {
+		return &PhoneVerification{ID: 9, UserID: 3, Purpose: OTPLogin, Phone: phone, CodeHash: hash, ExpiresAt: now.Add(time.Minute)}
+	}
+
+	t.Run("LogsIn", func(t *testing.T) {
+		svc, mockRepo := newService(pending())
+		mockRepo.On("ClaimPhoneVerificationAttempt", ctx, int64(9), otpMaxAttempts).Return(nil)
+		mockRepo.On("ExpirePhoneVerification", ctx, uint(3), OTPLogin).Return(nil)
+		mockRepo.On("IsBanned", ctx, uint(3)).Return(false, nil)
+		mockRepo.On("GetRestriction", ctx, uint(3)).Return(nil, nil)
+
+		token, u, err := svc.LoginWithOTP(ctx, "0812 3456 789", "123456")
+
+		assert.NoError(t, err)
+		assert.NotEmpty(t, token)
+		assert.Equal(t, "otp@example.com", u.Email)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("WrongCode", func(t *testing.T) {
+		svc, mockRepo := newService(pending())
+		mockRepo.On("ClaimPhoneVerificationAttempt", ctx, int64(9), otpMaxAttempts).Return(nil)
+
+		_, _, err := svc.LoginWithOTP(ctx, phone, "000000")
+
+		assert.ErrorIs(t, err, ErrOTPInvalid)
+		mockRepo.AssertNotCalled(t, "ExpirePhoneVerification", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("AttemptsExhausted", func(t *testing.T) {
+		svc, mockRepo := newService(pending())
+		mockRepo.On("ClaimPhoneVerificationAttempt", ctx, int64(9), otpMaxAttempts).Return(ErrOTPTooManyAttempts)
+
+		_, _, err := svc.LoginWithOTP(ctx, phone, "123456")
+
+		assert.ErrorIs(t, err, ErrOTPTooManyAttempts)
+	})
+
+	t.Run("SentToAnotherPhone", func(t *testing.T) {
+		v := pending()
+		v.Phone = "+628999999999"
+		svc, mockRepo := newService(v)
+		mockRepo.On("ClaimPhoneVerificationAttempt", ctx, int64(9), otpMaxAttempts).Return(nil)
+
+		_, _, err := svc.LoginWithOTP(ctx, phone, "123456")
+
+		assert.ErrorIs(t, err, ErrOTPInvalid)
+	})
+
+	t.Run("UnknownPhone", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil)
+		mockRepo.On("FindByPhone", ctx, phone).Return(nil, sql.ErrNoRows)
+
+		_, _, err := svc.LoginWithOTP(ctx, phone, "123456")
+
+		assert.ErrorIs(t, err, ErrOTPInvalid)
+	})
+}
+
+func TestService_UpdateProfile_DisplayName(t *testing.T) {
+	ctx := context.Background()
+	blocked := []*BlockedTerm{{Term: "admin"}, {Term: "warimas"}}
+
+	t.Run("CleanedAndScreened", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		screener := new(MockScreener)
+		svc := NewService(mockRepo, nil, screener)
+		name := "  Budi   Santoso "
+		saved := "Budi Santoso"
+
+		mockRepo.On("ListBlockedTerms", ctx).Return(blocked, nil)
+		mockRepo.On("UpdateProfile", ctx, mock.MatchedBy(func(p *Profile) bool {
+			return *p.DisplayName == saved
+		})).Return(&Profile{UserID: 1, DisplayName: &saved}, nil)
+		screener.On("Screen", ctx, mock.MatchedBy(func(c *moderation.Content) bool {
+			return c.Type == moderation.ContentDisplayName && c.ID == "1" && *c.AuthorID == 1 && c.Body == saved
+		})).Return()
+
+		_, err := svc.UpdateProfile(ctx, UpdateProfileParams{UserID: 1, DisplayName: &name})
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+		screener.AssertExpectations(t)
+	})
+
+	for _, name := range []string{"ab", "this name is far too long to show", "<script>"} {
+		t.Run("Invalid "+name, func(t *testing.T) {
+			mockRepo := new(MockRepository)
+			svc := NewService(mockRepo, nil, nil)
+
+			_, err := svc.UpdateProfile(ctx, UpdateProfileParams{UserID: 1, DisplayName: &name})
+			assert.ErrorIs(t, err, ErrInvalidDisplayName)
+			mockRepo.AssertNotCalled(t, "UpdateProfile", mock.Anything, mock.Anything)
+		})
+	}
+
+	for _, name := range []string{"Admin", "the_4dm1n", "W.a.r.i.m.a.s Store"} {
+		t.Run("Blocked "+name, func(t *testing.T) {
+			mockRepo := new(MockRepository)
+			svc := NewService(mockRepo, nil, nil)
+			mockRepo.On("ListBlockedTerms", ctx).Return(blocked, nil)
+
+			_, err := svc.UpdateProfile(ctx, UpdateProfileParams{UserID: 1, DisplayName: &name})
+			assert.ErrorIs(t, err, ErrDisplayNameBlocked)
+			mockRepo.AssertNotCalled(t, "UpdateProfile", mock.Anything, mock.Anything)
+		})
+	}
+}
+
+func TestService_SetAvatar(t *testing.T) {
+	ctx := context.Background()
+	mockRepo := new(MockRepository)
+	svc := NewService(mockRepo, nil, nil)
+
+	mockRepo.On("GetProfile", ctx, uint(1)).Return(nil, ErrProfileNotFound)
+	mockRepo.On("CreateProfile", ctx, mock.Anything).Return(&Profile{UserID: 1}, nil)
+	mockRepo.On("UpdateProfile", ctx, mock.MatchedBy(func(p *Profile) bool {
+		return p.UserID == 1 && *p.AvatarURL == "/uploads/avatar/x.png"
+	})).Return(&Profile{UserID: 1}, nil)
+
+	assert.NoError(t, svc.SetAvatar(ctx, 1, "/uploads/avatar/x.png"))
+	mockRepo.AssertExpectations(t)
+}
+
+func TestService_BlockTerm(t *testing.T) {
+	admin := utils.SetUserContext(context.Background(), 9, "admin@example.com", string(RoleAdmin))
+
+	t.Run("Folded", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo, nil, nil)
+		mockRepo.On("AddBlockedTerm", admin, mock.MatchedBy(func(bt *BlockedTerm) bool {
+			return bt.Term == "scam" && *bt.CreatedBy == 9
+		})).Return(nil)
+
+		bt, err := svc.BlockTerm(admin, " $C-4M ")
+		assert.NoError(t, err)
+		assert.Equal(t, "scam", bt.Term)
+	})
+
+	t.Run("TooShort", func(t *testing.T) {
+		svc := NewService(new(MockRepository), nil, nil)
+		_, err := svc.BlockTerm(admin, "-x-")
+		assert.ErrorIs(t, err, ErrInvalidBlockedTerm)
+	})
+
+	t.Run("NotAdmin", func(t *testing.T) {
+		svc := NewService(new(MockRepository), nil, nil)
+		ctx := utils.SetUserContext(context.Background(), 2, "user@example.com", string(RoleUser))
+		_, err := svc.BlockTerm(ctx, "scam")
+		assert.ErrorIs(t, err, ErrForbidden)
+		assert.ErrorIs(t, svc.UnblockTerm(ctx, "scam"), ErrForbidden)
+	})
+}
+
+func TestService_RestrictAccount(t *testing.T) {
+	admin := utils.SetUserContext(context.Background(), 9, "admin@example.com", string(RoleAdmin))
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	newSvc := func(repo *MockRepository) *service {
+		return &service{repo: repo, now: func() time.Time { return now }}
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		expires := now.Add(7 * 24 * time.Hour)
+		mockRepo.On("SetRestriction", admin, mock.MatchedBy(func(r *Restriction) bool {
+			return r.UserID == 4 && r.Level == RestrictionBlockCheckout &&
+				r.Reason == "chargebacks" && *r.CreatedBy == 9 && r.ExpiresAt.Equal(expires)
+		})).Return(nil)
+
+		res, err := newSvc(mockRepo).RestrictAccount(admin, RestrictParams{
+			UserID: 4, Level: RestrictionBlockCheckout, Reason: " chargebacks ", ExpiresAt: &expires,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, RestrictionBlockCheckout, res.Level)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		svc := newSvc(new(MockRepository))
+		past := now.Add(-time.Hour)
+
+		_, err := svc.RestrictAccount(admin, RestrictParams{UserID: 4, Level: "BAN", Reason: "x"})
+		assert.ErrorIs(t, err, ErrInvalidRestriction)
+		_, err = svc.RestrictAccount(admin, RestrictParams{UserID: 4, Level: RestrictionWarn, Reason: "  "})
+		assert.ErrorIs(t, err, ErrInvalidRestrictReason)
+		_, err = svc.RestrictAccount(admin, RestrictParams{UserID: 4, Level: RestrictionWarn, Reason: "x", ExpiresAt: &past})
+		assert.ErrorIs(t, err, ErrInvalidRestrictExpiry)
+		_, err = svc.RestrictAccount(admin, RestrictParams{UserID: 9, Level: RestrictionSuspend, Reason: "x"})
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+
+	t.Run("UnknownUser", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("SetRestriction", admin, mock.Anything).Return(sql.ErrNoRows)
+
+		_, err := newSvc(mockRepo).RestrictAccount(admin, RestrictParams{UserID: 404, Level: RestrictionWarn, Reason: "x"})
+		assert.ErrorIs(t, err, ErrRestrictUserNotFound)
+	})
+
+	t.Run("NotAdmin", func(t *testing.T) {
+		svc := newSvc(new(MockRepository))
+		ctx := utils.SetUserContext(context.Background(), 2, "user@example.com", string(RoleUser))
+
+		_, err := svc.RestrictAccount(ctx, RestrictParams{UserID: 4, Level: RestrictionWarn, Reason: "x"})
+		assert.ErrorIs(t, err, ErrForbidden)
+		assert.ErrorIs(t, svc.LiftRestriction(ctx, 4), ErrForbidden)
+		_, err = svc.ListRestrictions(ctx, 10)
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+
+	t.Run("ListClampsLimit", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockRepo.On("ListRestrictions", admin, int32(500)).Return([]*Restriction{}, nil)
+
+		_, err := newSvc(mockRepo).ListRestrictions(admin, 10000)
+		assert.NoError(t, err)
+		mockRepo.AssertExpectations(t)
+	})
+}
+
+func TestRestriction_CheckoutErr(t *testing.T) {
+	var none *Restriction
+	assert.NoError(t, none.CheckoutErr())
+	assert.NoError(t, (&Restriction{Level: RestrictionWarn}).CheckoutErr())
+	assert.ErrorIs(t, (&Restriction{Level: RestrictionBlockCheckout}).CheckoutErr(), ErrCheckoutBlocked)
+	assert.ErrorIs(t, (&Restriction{Level: RestrictionSuspend}).CheckoutErr(), ErrAccountSuspended)
+}
@@ -1,31 +1,109 @@
 package utils
 
-import "context"
+import (
+	"context"
+	"slices"
+)
 
 type contextKey string
 
+const principalKey contextKey = "principal"
+
+// RoleAdmin is the role that unlocks admin-only operations.
+const RoleAdmin = "ADMIN"
+
+// Principal is whoever the request acts for. Middleware builds it once per
+// request; everything else reads it through the accessors below, which
+// treat a missing principal and zero fields the same way.
+type Principal struct {
+	// ID is the signed-in user, 0 for anonymous and guest requests.
+	ID    uint
+	Email string
+	Roles []string
+	// SellerID is set when the user runs a seller account.
+	SellerID string
+	// GuestID identifies an anonymous shopper's checkout.
+	GuestID string
+	// IsInternal marks a request made with a service API key.
+	IsInternal bool
+}
+
+// HasRole reports whether the principal was granted role.
+func (p *Principal) HasRole(role string) bool {
+	return p != nil && slices.Contains(p.Roles, role)
+}
+
+// WithPrincipal stores p as the request principal (called by middleware).
+func WithPrincipal(ctx context.Context, p *Principal) context.Context {
+	return context.WithValue(ctx, principalKey, p)
+}
+
+// GetPrincipalFromContext returns the request principal, if any.
+func GetPrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	p, ok := ctx.Value(principalKey).(*Principal)
+	return p, ok && p != nil
+}
+
+// principal returns the request principal or an empty one, so accessors
+// need no nil checks.
+func principal(ctx context.Context) *Principal {
+	if p, ok := GetPrincipalFromContext(ctx); ok {
+		return p
+	}
+	return &Principal{}
+}
+
 // SetUserContext sets user info into context (called by middleware)
 func SetUserContext(ctx context.Context, id uint, email string, role string) context.Context {
-	ctx = context.WithValue(ctx, UserIDKey, id)
-	ctx = context.WithValue(ctx, UserEmailKey, email)
-	ctx = context.WithValue(ctx, UserRoleKey, role)
-	return ctx
+	p := *principal(ctx)
+	p.ID = id
+	p.Email = email
+	p.Roles = nil
+	if role != "" {
+		p.Roles = []string{role}
+	}
+	return WithPrincipal(ctx, &p)
 }
 
-// GetUserIDFromContext retrieves userID safely
+// GetUserIDFromContext returns the signed-in user. ok is false for
+// anonymous and guest requests, so callers need not check for 0.
 func GetUserIDFromContext(ctx context.Context) (uint, bool) {
-	id, ok := ctx.Value(UserIDKey).(uint)
-	return id, ok
+	id := principal(ctx).ID
+	return id, id != 0
 }
 
 // ✅ GetUserEmailFromContext retrieves userEmail safely
 func GetUserEmailFromContext(ctx context.Context) string {
-	email, _ := ctx.Value(UserEmailKey).(string)
-	return email
+	return principal(ctx).Email
 }
 
-// Optionally: get role (if you implement roles later)
+// GetUserRoleFromContext returns the user's primary role, "" when
+// anonymous.
 func GetUserRoleFromContext(ctx context.Context) string {
-	role, _ := ctx.Value(UserRoleKey).(string)
-	return role
+	if roles := principal(ctx).Roles; len(roles) > 0 {
+		return roles[0]
+	}
+	return ""
+}
+
+// IsAdmin reports whether the request is made by an admin user.
+func IsAdmin(ctx context.Context) bool {
+	return principal(ctx).HasRole(RoleAdmin)
+}
+
+// GetSellerIDFromContext returns the caller's seller account, if any.
+func GetSellerIDFromContext(ctx context.Context) (string, bool) {
+	id := principal(ctx).SellerID
+	return id, id != ""
+}
+
+// GetGuestIDFromContext returns the anonymous shopper's guest ID, if any.
+func GetGuestIDFromContext(ctx context.Context) (string, bool) {
+	id := principal(ctx).GuestID
+	return id, id != ""
+}
+
+// IsInternal reports whether the request was made with a service API key.
+func IsInternal(ctx context.Context) bool {
+	return principal(ctx).IsInternal
 }
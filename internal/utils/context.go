@@ -1,27 +1,43 @@
 package utils
 
-import "context"
-
-const (
-	UserIDKey    contextKey = "user_id"
-	SellerIDKey  contextKey = "seller_id"
-	UserEmailKey contextKey = "email"
-	UserRoleKey  contextKey = "role"
+import (
+	"context"
+	"slices"
 )
 
 const (
-	ProductStatusActive  = "active"
-	ProductStatusDisable = "disable"
+	ProductStatusActive   = "active"
+	ProductStatusDisable  = "disable"
+	ProductStatusArchived = "archived"
 )
 
 type ctxKey string
 
-const internalRequestKey ctxKey = "internal_request"
+const servicePrincipalKey ctxKey = "service_principal"
+
+// ServicePrincipal is a machine caller authenticated with an API key
+// rather than a user token.
+type ServicePrincipal struct {
+	KeyID  int64
+	Name   string
+	Scopes []string
+}
+
+// WithServicePrincipal marks the request as made by p (called by
+// middleware).
+func WithServicePrincipal(ctx context.Context, p *ServicePrincipal) context.Context {
+	return context.WithValue(ctx, servicePrincipalKey, p)
+}
 
-func WithInternalRequest(ctx context.Context) context.Context {
-	return context.WithValue(ctx, internalRequestKey, true)
+// GetServicePrincipalFromContext returns the API key caller, if any.
+func GetServicePrincipalFromContext(ctx context.Context) (*ServicePrincipal, bool) {
+	p, ok := ctx.Value(servicePrincipalKey).(*ServicePrincipal)
+	return p, ok && p != nil
 }
 
-func SetInternalContext(ctx context.Context) context.Context {
-	return WithInternalRequest(ctx)
+// HasScope reports whether the request was made with an API key granted
+// scope.
+func HasScope(ctx context.Context, scope string) bool {
+	p, ok := GetServicePrincipalFromContext(ctx)
+	return ok && slices.Contains(p.Scopes, scope)
 }
@@ -127,16 +127,6 @@ func HasAnyField(ctx context.Context, names ...string) bool {
 	return false
 }
 
-func IsInternalRequest(ctx context.Context) bool {
-	v := ctx.Value(internalRequestKey)
-	if v == nil {
-		return false
-	}
-
-	isInternal, ok := v.(bool)
-	return ok && isInternal
-}
-
 func ExternalIDFromSession(prefix, sessionID string) string {
 	h := sha1.Sum([]byte(sessionID))
 	return fmt.Sprintf(
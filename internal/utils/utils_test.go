@@ -39,20 +39,48 @@ func TestUserContext(t *testing.T) {
 		ctx := context.Background()
 		_, ok := GetUserIDFromContext(ctx)
 		assert.False(t, ok)
+		assert.False(t, IsAdmin(ctx))
+	})
+
+	t.Run("Guest principal has no user", func(t *testing.T) {
+		ctx := WithPrincipal(context.Background(), &Principal{GuestID: "g-1"})
+
+		_, ok := GetUserIDFromContext(ctx)
+		assert.False(t, ok)
+		guestID, ok := GetGuestIDFromContext(ctx)
+		assert.True(t, ok)
+		assert.Equal(t, "g-1", guestID)
+	})
+
+	t.Run("SetUserContext keeps the rest of the principal", func(t *testing.T) {
+		ctx := WithPrincipal(context.Background(), &Principal{SellerID: "s-1", IsInternal: true})
+		ctx = SetUserContext(ctx, 7, "admin@example.com", RoleAdmin)
+
+		sellerID, ok := GetSellerIDFromContext(ctx)
+		assert.True(t, ok)
+		assert.Equal(t, "s-1", sellerID)
+		assert.True(t, IsInternal(ctx))
+		assert.True(t, IsAdmin(ctx))
 	})
 }
 
-func TestIsInternalRequest(t *testing.T) {
+func TestHasScope(t *testing.T) {
 	t.Run("Returns false for empty context", func(t *testing.T) {
-		ctx := context.Background()
-		isInternal := IsInternalRequest(ctx)
-		assert.False(t, isInternal)
+		assert.False(t, HasScope(context.Background(), "ORDERS_CREATE_FROM_SESSION"))
 	})
 
-	t.Run("Returns true for internal request", func(t *testing.T) {
-		ctx := context.Background()
-		ctx = WithInternalRequest(ctx)
-		assert.True(t, IsInternalRequest(ctx))
+	t.Run("Checks the principal's scopes", func(t *testing.T) {
+		ctx := WithServicePrincipal(context.Background(), &ServicePrincipal{
+			KeyID:  1,
+			Name:   "checkout-worker",
+			Scopes: []string{"ORDERS_CREATE_FROM_SESSION"},
+		})
+		assert.True(t, HasScope(ctx, "ORDERS_CREATE_FROM_SESSION"))
+		assert.False(t, HasScope(ctx, "ORDERS_READ"))
+
+		p, ok := GetServicePrincipalFromContext(ctx)
+		assert.True(t, ok)
+		assert.Equal(t, "checkout-worker", p.Name)
 	})
 }
 
@@ -282,9 +310,3 @@ func TestExternalIDFromSession(t *testing.T) {
 	id := ExternalIDFromSession("prefix", "session-id")
 	assert.Contains(t, id, "prefix_")
 }
-
-func TestSetInternalContext(t *testing.T) {
-	ctx := context.Background()
-	ctx = SetInternalContext(ctx)
-	assert.True(t, IsInternalRequest(ctx))
-}
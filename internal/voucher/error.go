@@ -0,0 +1,17 @@
+package voucher
+
+import (
+	"errors"
+	"warimas-be/internal/apperr"
+)
+
+var (
+	ErrUnauthenticated   = apperr.Unauthenticated("unauthenticated")
+	ErrForbidden         = apperr.Forbidden("forbidden")
+	ErrInvalidDateRange  = errors.New("invalid date range")
+	ErrInvalidSegment    = errors.New("invalid customer segment")
+	ErrInvalidDiscount   = errors.New("invalid voucher discount")
+	ErrInvalidCodePrefix = errors.New("invalid voucher code prefix")
+	ErrCampaignNotFound  = apperr.NotFound("campaign not found")
+	ErrDB                = errors.New("database error")
+)
@@ -0,0 +1,46 @@
+package voucher
+
+import (
+	"strconv"
+	"warimas-be/internal/graph/model"
+)
+
+func MapCampaignPerformanceToGraphQL(report []*CampaignPerformance) []*model.CampaignPerformance {
+	items := make([]*model.CampaignPerformance, 0, len(report))
+	for _, p := range report {
+		items = append(items, &model.CampaignPerformance{
+			CampaignID:           strconv.FormatInt(p.CampaignID, 10),
+			CampaignName:         p.CampaignName,
+			Redemptions:          int32(p.Redemptions),
+			UniqueCustomers:      int32(p.UniqueCustomers),
+			RevenueInfluenced:    int32(p.RevenueInfluenced),
+			NetRevenueInfluenced: int32(p.NetRevenue),
+			DiscountCost:         int32(p.DiscountCost),
+			NewCustomers:         int32(p.NewCustomers),
+			ReturningCustomers:   int32(p.ReturningCustomers),
+		})
+	}
+	return items
+}
+
+func MapCampaignToGraphQL(c *Campaign) *model.VoucherCampaign {
+	return &model.VoucherCampaign{
+		ID:          strconv.FormatInt(c.ID, 10),
+		Name:        c.Name,
+		Description: c.Description,
+		StartsAt:    c.StartsAt,
+		EndsAt:      c.EndsAt,
+		CreatedAt:   c.CreatedAt,
+	}
+}
+
+func MapSegmentSizesToGraphQL(sizes []*SegmentSize) []*model.CustomerSegmentSize {
+	items := make([]*model.CustomerSegmentSize, 0, len(sizes))
+	for _, s := range sizes {
+		items = append(items, &model.CustomerSegmentSize{
+			Segment: model.CustomerSegment(s.Segment),
+			Members: int32(s.Members),
+		})
+	}
+	return items
+}
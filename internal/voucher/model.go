@@ -0,0 +1,88 @@
+package voucher
+
+import "time"
+
+// SegmentRefreshInterval is how often customer segments are re-evaluated.
+const SegmentRefreshInterval = 6 * time.Hour
+
+// reportMaxRange bounds the date range of a single promotions report.
+const reportMaxRange = 366 * 24 * time.Hour
+
+type Segment string
+
+const (
+	// SegmentFirstPurchase is customers with exactly one settled order.
+	SegmentFirstPurchase Segment = "FIRST_PURCHASE"
+	// SegmentDormant90D is customers whose last settled order is older
+	// than 90 days.
+	SegmentDormant90D Segment = "DORMANT_90D"
+	// SegmentHighLTV is customers whose settled lifetime spend reaches
+	// highLTVThreshold.
+	SegmentHighLTV Segment = "HIGH_LTV"
+)
+
+var AllSegments = []Segment{SegmentFirstPurchase, SegmentDormant90D, SegmentHighLTV}
+
+func (s Segment) Valid() bool {
+	for _, seg := range AllSegments {
+		if s == seg {
+			return true
+		}
+	}
+	return false
+}
+
+const (
+	highLTVThreshold = 5_000_000
+	dormantAfterDays = 90
+)
+
+type DiscountType string
+
+const (
+	DiscountPercent DiscountType = "PERCENT"
+	DiscountFixed   DiscountType = "FIXED"
+)
+
+type Campaign struct {
+	ID          int64
+	Name        string
+	Description *string
+	StartsAt    time.Time
+	EndsAt      *time.Time
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// IssueSegmentVouchersInput describes the single-use personal voucher
+// issued to every member of a segment.
+type IssueSegmentVouchersInput struct {
+	CampaignID    int64
+	Segment       Segment
+	CodePrefix    string
+	DiscountType  DiscountType
+	DiscountValue int64
+	MaxDiscount   *int64
+	MinSubtotal   int64
+	ExpiresAt     *time.Time
+}
+
+type SegmentSize struct {
+	Segment Segment
+	Members int64
+}
+
+// CampaignPerformance aggregates the redemptions of one campaign over a
+// report window. Only redemptions whose order reached a paid state count.
+type CampaignPerformance struct {
+	CampaignID        int64
+	CampaignName      string
+	Redemptions       int64
+	UniqueCustomers   int64
+	RevenueInfluenced int64
+	// RevenueInfluenced less the gateway fees recorded on the orders
+	NetRevenue         int64
+	DiscountCost       int64
+	NewCustomers       int64
+	ReturningCustomers int64
+}
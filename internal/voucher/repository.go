@@ -0,0 +1,277 @@
+package voucher
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+	"warimas-be/internal/logger"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+// settledOrderStatuses are the order states counted as a successful
+// redemption in reports.
+var settledOrderStatuses = []string{"PAID", "ACCEPTED", "SHIPPED", "READY_FOR_PICKUP", "COMPLETED"}
+
+type Repository interface {
+	GetCampaignPerformance(ctx context.Context, from, to time.Time) ([]*CampaignPerformance, error)
+	CreateCampaign(ctx context.Context, c *Campaign) error
+	GetCampaignByID(ctx context.Context, id int64) (*Campaign, error)
+	RefreshSegment(ctx context.Context, segment Segment) (int64, error)
+	IssueSegmentVouchers(ctx context.Context, input IssueSegmentVouchersInput) (int64, error)
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+// GetCampaignPerformance aggregates redemptions created in [from, to) per
+// campaign. A customer is "returning" when they had a settled order before
+// the redeemed one.
+func (r *repository) GetCampaignPerformance(
+	ctx context.Context,
+	from, to time.Time,
+) ([]*CampaignPerformance, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "GetCampaignPerformance"),
+		zap.Time("from", from),
+		zap.Time("to", to),
+	)
+
+	const q = `
+		WITH redeemed AS (
+			SELECT
+				r.campaign_id,
+				r.discount_amount,
+				o.user_id,
+				o.total_amount,
+				(
+					SELECT COALESCE(SUM(p.fee_amount), 0)
+					FROM payments p
+					WHERE p.order_id = o.id
+				) AS gateway_fees,
+				EXISTS (
+					SELECT 1
+					FROM orders prev
+					WHERE prev.user_id = o.user_id
+					  AND prev.id <> o.id
+					  AND prev.created_at < o.created_at
+					  AND prev.status = ANY($3)
+				) AS is_returning
+			FROM voucher_redemptions r
+			JOIN orders o ON o.id = r.order_id
+			WHERE r.created_at >= $1
+			  AND r.created_at < $2
+			  AND o.status = ANY($3)
+		)
+		SELECT
+			c.id,
+			c.name,
+			COUNT(*),
+			COUNT(DISTINCT d.user_id),
+			COALESCE(SUM(d.total_amount), 0),
+			COALESCE(SUM(d.total_amount - d.gateway_fees), 0),
+			COALESCE(SUM(d.discount_amount), 0),
+			COUNT(DISTINCT d.user_id) FILTER (WHERE NOT d.is_returning),
+			COUNT(DISTINCT d.user_id) FILTER (WHERE d.is_returning)
+		FROM redeemed d
+		JOIN voucher_campaigns c ON c.id = d.campaign_id
+		GROUP BY c.id, c.name
+		ORDER BY SUM(d.total_amount) DESC, c.id
+	`
+
+	rows, err := r.db.QueryContext(ctx, q, from, to, pq.Array(settledOrderStatuses))
+	if err != nil {
+		log.Error("failed to query campaign performance", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	report := []*CampaignPerformance{}
+	for rows.Next() {
+		var p CampaignPerformance
+		if err := rows.Scan(
+			&p.CampaignID, &p.CampaignName,
+			&p.Redemptions, &p.UniqueCustomers,
+			&p.RevenueInfluenced, &p.NetRevenue, &p.DiscountCost,
+			&p.NewCustomers, &p.ReturningCustomers,
+		); err != nil {
+			log.Error("failed to scan campaign performance", zap.Error(err))
+			return nil, ErrDB
+		}
+		report = append(report, &p)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error("campaign performance iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return report, nil
+}
+
+func (r *repository) CreateCampaign(ctx context.Context, c *Campaign) error {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "CreateCampaign"),
+	)
+
+	err := r.db.QueryRowContext(ctx, `
+		INSERT INTO voucher_campaigns (name, description, starts_at, ends_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at, updated_at
+	`, c.Name, c.Description, c.StartsAt, c.EndsAt).Scan(&c.ID, &c.CreatedAt, &c.UpdatedAt)
+	if err != nil {
+		log.Error("failed to insert campaign", zap.Error(err))
+		return ErrDB
+	}
+
+	return nil
+}
+
+func (r *repository) GetCampaignByID(ctx context.Context, id int64) (*Campaign, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "GetCampaignByID"),
+		zap.Int64("campaign_id", id),
+	)
+
+	var c Campaign
+	err := r.db.QueryRowContext(ctx, `
+		SELECT id, name, description, starts_at, ends_at, created_at, updated_at
+		FROM voucher_campaigns
+		WHERE id = $1
+	`, id).Scan(&c.ID, &c.Name, &c.Description, &c.StartsAt, &c.EndsAt, &c.CreatedAt, &c.UpdatedAt)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrCampaignNotFound
+	}
+	if err != nil {
+		log.Error("failed to get campaign", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return &c, nil
+}
+
+// segmentRule returns the HAVING clause that selects a segment's members
+// from their settled orders, and the value bound to $2 in it.
+func segmentRule(segment Segment) (string, any, error) {
+	switch segment {
+	case SegmentFirstPurchase:
+		return "COUNT(*) = $2", 1, nil
+	case SegmentDormant90D:
+		return "MAX(o.created_at) < NOW() - make_interval(days => $2)", dormantAfterDays, nil
+	case SegmentHighLTV:
+		return "SUM(o.total_amount) >= $2", highLTVThreshold, nil
+	}
+	return "", nil, ErrInvalidSegment
+}
+
+// RefreshSegment rebuilds the membership of one segment from current order
+// data and returns the new member count.
+func (r *repository) RefreshSegment(ctx context.Context, segment Segment) (n int64, err error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "RefreshSegment"),
+		zap.String("segment", string(segment)),
+	)
+
+	having, arg, err := segmentRule(segment)
+	if err != nil {
+		return 0, err
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error("failed to begin transaction", zap.Error(err))
+		return 0, ErrDB
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	if _, err = tx.ExecContext(ctx, `
+		DELETE FROM customer_segment_members WHERE segment = $1
+	`, segment); err != nil {
+		log.Error("failed to clear segment", zap.Error(err))
+		return 0, ErrDB
+	}
+
+	q := fmt.Sprintf(`
+		INSERT INTO customer_segment_members (segment, user_id, evaluated_at)
+		SELECT $1, o.user_id, NOW()
+		FROM orders o
+		WHERE o.user_id IS NOT NULL
+		  AND o.status = ANY($3)
+		GROUP BY o.user_id
+		HAVING %s
+	`, having)
+
+	res, err := tx.ExecContext(ctx, q, segment, arg, pq.Array(settledOrderStatuses))
+	if err != nil {
+		log.Error("failed to evaluate segment", zap.Error(err))
+		return 0, ErrDB
+	}
+	n, _ = res.RowsAffected()
+
+	if err = tx.Commit(); err != nil {
+		log.Error("failed to commit segment refresh", zap.Error(err))
+		return 0, ErrDB
+	}
+
+	return n, nil
+}
+
+// IssueSegmentVouchers creates one single-use personal voucher per segment
+// member. Members that already hold a voucher from the campaign are skipped,
+// so issuing twice is safe. Returns how many vouchers were created.
+func (r *repository) IssueSegmentVouchers(ctx context.Context, in IssueSegmentVouchersInput) (int64, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "IssueSegmentVouchers"),
+		zap.Int64("campaign_id", in.CampaignID),
+		zap.String("segment", string(in.Segment)),
+	)
+
+	const q = `
+		INSERT INTO vouchers (
+			campaign_id, code, discount_type, discount_value, max_discount,
+			min_subtotal, usage_limit, owner_user_id, expires_at
+		)
+		SELECT
+			$1,
+			$2 || '-' || UPPER(SUBSTRING(MD5(RANDOM()::TEXT || m.user_id::TEXT) FROM 1 FOR 8)),
+			$3, $4, $5, $6, 1, m.user_id, $7
+		FROM customer_segment_members m
+		WHERE m.segment = $8
+		ON CONFLICT DO NOTHING
+	`
+
+	res, err := r.db.ExecContext(ctx, q,
+		in.CampaignID,
+		in.CodePrefix,
+		in.DiscountType,
+		in.DiscountValue,
+		in.MaxDiscount,
+		in.MinSubtotal,
+		in.ExpiresAt,
+		in.Segment,
+	)
+	if err != nil {
+		log.Error("failed to issue segment vouchers", zap.Error(err))
+		return 0, ErrDB
+	}
+
+	n, _ := res.RowsAffected()
+	return n, nil
+}
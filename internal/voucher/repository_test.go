@@ -0,0 +1,118 @@
+package voucher
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_GetCampaignPerformance(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	t.Run("Success", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{
+			"id", "name", "redemptions", "unique_customers", "revenue", "net_revenue", "discount", "new", "returning",
+		}).
+			AddRow(1, "New Year", 12, 10, 1500000, 1455600, 120000, 4, 6).
+			AddRow(2, "Payday", 3, 3, 300000, 300000, 30000, 0, 3)
+
+		mock.ExpectQuery(`WITH redeemed AS .* FROM voucher_redemptions r JOIN orders o`).
+			WithArgs(from, to, pq.Array(settledOrderStatuses)).
+			WillReturnRows(rows)
+
+		report, err := repo.GetCampaignPerformance(ctx, from, to)
+		assert.NoError(t, err)
+		require.Len(t, report, 2)
+		assert.Equal(t, "New Year", report[0].CampaignName)
+		assert.Equal(t, int64(1500000), report[0].RevenueInfluenced)
+		assert.Equal(t, int64(1455600), report[0].NetRevenue)
+		assert.Equal(t, int64(4), report[0].NewCustomers)
+		assert.Equal(t, int64(6), report[0].ReturningCustomers)
+	})
+
+	t.Run("DBError", func(t *testing.T) {
+		mock.ExpectQuery(`WITH redeemed AS`).WillReturnError(errors.New("db error"))
+
+		_, err := repo.GetCampaignPerformance(ctx, from, to)
+		assert.ErrorIs(t, err, ErrDB)
+	})
+}
+
+func TestRepository_RefreshSegment(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(`DELETE FROM customer_segment_members WHERE segment = \$1`).
+			WithArgs(SegmentHighLTV).
+			WillReturnResult(sqlmock.NewResult(0, 3))
+		mock.ExpectExec(`INSERT INTO customer_segment_members .* HAVING SUM\(o.total_amount\) >= \$2`).
+			WithArgs(SegmentHighLTV, highLTVThreshold, pq.Array(settledOrderStatuses)).
+			WillReturnResult(sqlmock.NewResult(0, 5))
+		mock.ExpectCommit()
+
+		n, err := repo.RefreshSegment(ctx, SegmentHighLTV)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(5), n)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+
+	t.Run("InvalidSegment", func(t *testing.T) {
+		_, err := repo.RefreshSegment(ctx, Segment("VIP"))
+		assert.ErrorIs(t, err, ErrInvalidSegment)
+	})
+
+	t.Run("DBError", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectExec(`DELETE FROM customer_segment_members`).WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectExec(`INSERT INTO customer_segment_members`).WillReturnError(errors.New("db error"))
+		mock.ExpectRollback()
+
+		_, err := repo.RefreshSegment(ctx, SegmentFirstPurchase)
+		assert.ErrorIs(t, err, ErrDB)
+		assert.NoError(t, mock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_IssueSegmentVouchers(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	in := IssueSegmentVouchersInput{
+		CampaignID:    3,
+		Segment:       SegmentDormant90D,
+		CodePrefix:    "COMEBACK",
+		DiscountType:  DiscountFixed,
+		DiscountValue: 25000,
+	}
+
+	mock.ExpectExec(`INSERT INTO vouchers .* FROM customer_segment_members m WHERE m.segment = \$8 ON CONFLICT DO NOTHING`).
+		WithArgs(int64(3), "COMEBACK", DiscountFixed, int64(25000), nil, int64(0), nil, SegmentDormant90D).
+		WillReturnResult(sqlmock.NewResult(0, 12))
+
+	n, err := repo.IssueSegmentVouchers(context.Background(), in)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(12), n)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,190 @@
+package voucher
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+var codePrefixPattern = regexp.MustCompile(`^[A-Z0-9]{2,20}$`)
+
+type Service interface {
+	GetPromotionReport(ctx context.Context, from, to time.Time) ([]*CampaignPerformance, error)
+	CreateCampaign(ctx context.Context, c *Campaign) error
+	// RefreshSegments re-evaluates every customer segment. It runs on a
+	// schedule (SegmentRefreshInterval) and carries no caller check.
+	RefreshSegments(ctx context.Context) ([]*SegmentSize, error)
+	IssueSegmentVouchers(ctx context.Context, input IssueSegmentVouchersInput) (int64, error)
+}
+
+type service struct {
+	repo Repository
+}
+
+func NewService(repo Repository) Service {
+	return &service{repo: repo}
+}
+
+// GetPromotionReport returns per-campaign performance for redemptions made
+// in [from, to). Admin only.
+func (s *service) GetPromotionReport(
+	ctx context.Context,
+	from, to time.Time,
+) ([]*CampaignPerformance, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "GetPromotionReport"),
+	)
+
+	if err := requireAdmin(ctx); err != nil {
+		log.Warn("admin check failed", zap.Error(err))
+		return nil, err
+	}
+
+	if !from.Before(to) || to.Sub(from) > reportMaxRange {
+		log.Warn("invalid report date range", zap.Time("from", from), zap.Time("to", to))
+		return nil, ErrInvalidDateRange
+	}
+
+	report, err := s.repo.GetCampaignPerformance(ctx, from, to)
+	if err != nil {
+		log.Error("failed to get campaign performance", zap.Error(err))
+		return nil, err
+	}
+
+	return report, nil
+}
+
+func (s *service) CreateCampaign(ctx context.Context, c *Campaign) error {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "CreateCampaign"),
+	)
+
+	if err := requireAdmin(ctx); err != nil {
+		log.Warn("admin check failed", zap.Error(err))
+		return err
+	}
+
+	c.Name = strings.TrimSpace(c.Name)
+	if c.Name == "" {
+		return errors.New("campaign name is required")
+	}
+
+	if c.EndsAt != nil && !c.EndsAt.After(c.StartsAt) {
+		log.Warn("campaign ends before it starts")
+		return ErrInvalidDateRange
+	}
+
+	if err := s.repo.CreateCampaign(ctx, c); err != nil {
+		log.Error("failed to create campaign", zap.Error(err))
+		return err
+	}
+
+	log.Info("campaign created", zap.Int64("campaign_id", c.ID))
+	return nil
+}
+
+func (s *service) RefreshSegments(ctx context.Context) ([]*SegmentSize, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "RefreshSegments"),
+	)
+
+	sizes := make([]*SegmentSize, 0, len(AllSegments))
+	for _, seg := range AllSegments {
+		n, err := s.repo.RefreshSegment(ctx, seg)
+		if err != nil {
+			log.Error("failed to refresh segment",
+				zap.String("segment", string(seg)),
+				zap.Error(err),
+			)
+			return nil, err
+		}
+		sizes = append(sizes, &SegmentSize{Segment: seg, Members: n})
+	}
+
+	log.Info("customer segments refreshed")
+	return sizes, nil
+}
+
+// IssueSegmentVouchers issues a single-use personal voucher to every
+// current member of a segment. Admin only.
+func (s *service) IssueSegmentVouchers(ctx context.Context, in IssueSegmentVouchersInput) (int64, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "IssueSegmentVouchers"),
+		zap.Int64("campaign_id", in.CampaignID),
+		zap.String("segment", string(in.Segment)),
+	)
+
+	if err := requireAdmin(ctx); err != nil {
+		log.Warn("admin check failed", zap.Error(err))
+		return 0, err
+	}
+
+	if !in.Segment.Valid() {
+		return 0, ErrInvalidSegment
+	}
+
+	in.CodePrefix = strings.ToUpper(strings.TrimSpace(in.CodePrefix))
+	if !codePrefixPattern.MatchString(in.CodePrefix) {
+		return 0, ErrInvalidCodePrefix
+	}
+
+	switch in.DiscountType {
+	case DiscountPercent:
+		if in.DiscountValue <= 0 || in.DiscountValue > 100 {
+			return 0, ErrInvalidDiscount
+		}
+	case DiscountFixed:
+		if in.DiscountValue <= 0 {
+			return 0, ErrInvalidDiscount
+		}
+	default:
+		return 0, ErrInvalidDiscount
+	}
+
+	if in.MaxDiscount != nil && *in.MaxDiscount <= 0 {
+		return 0, ErrInvalidDiscount
+	}
+	if in.MinSubtotal < 0 {
+		return 0, ErrInvalidDiscount
+	}
+
+	campaign, err := s.repo.GetCampaignByID(ctx, in.CampaignID)
+	if err != nil {
+		log.Warn("failed to get campaign", zap.Error(err))
+		return 0, err
+	}
+
+	if campaign.EndsAt != nil && time.Now().After(*campaign.EndsAt) {
+		log.Warn("campaign already ended")
+		return 0, ErrInvalidDateRange
+	}
+
+	issued, err := s.repo.IssueSegmentVouchers(ctx, in)
+	if err != nil {
+		log.Error("failed to issue vouchers", zap.Error(err))
+		return 0, err
+	}
+
+	log.Info("segment vouchers issued", zap.Int64("issued", issued))
+	return issued, nil
+}
+
+func requireAdmin(ctx context.Context) error {
+	if _, ok := utils.GetUserIDFromContext(ctx); !ok {
+		return ErrUnauthenticated
+	}
+	if !utils.IsAdmin(ctx) {
+		return ErrForbidden
+	}
+	return nil
+}
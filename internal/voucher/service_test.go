@@ -0,0 +1,174 @@
+package voucher
+
+import (
+	"context"
+	"testing"
+	"time"
+	"warimas-be/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// --- Mocks ---
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) GetCampaignPerformance(ctx context.Context, from, to time.Time) ([]*CampaignPerformance, error) {
+	args := m.Called(ctx, from, to)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*CampaignPerformance), args.Error(1)
+}
+
+func (m *MockRepository) CreateCampaign(ctx context.Context, c *Campaign) error {
+	args := m.Called(ctx, c)
+	return args.Error(0)
+}
+
+func (m *MockRepository) GetCampaignByID(ctx context.Context, id int64) (*Campaign, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Campaign), args.Error(1)
+}
+
+func (m *MockRepository) RefreshSegment(ctx context.Context, segment Segment) (int64, error) {
+	args := m.Called(ctx, segment)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) IssueSegmentVouchers(ctx context.Context, input IssueSegmentVouchersInput) (int64, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// --- Tests ---
+
+func TestService_GetPromotionReport(t *testing.T) {
+	from := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 1, 0)
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+		ctx := utils.SetUserContext(context.Background(), 1, "admin@example.com", "ADMIN")
+
+		mockRepo.On("GetCampaignPerformance", ctx, from, to).
+			Return([]*CampaignPerformance{{CampaignID: 1, Redemptions: 5}}, nil)
+
+		report, err := svc.GetPromotionReport(ctx, from, to)
+		assert.NoError(t, err)
+		assert.Len(t, report, 1)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		svc := NewService(new(MockRepository))
+		ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "USER")
+
+		_, err := svc.GetPromotionReport(ctx, from, to)
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+
+	t.Run("InvalidRange", func(t *testing.T) {
+		svc := NewService(new(MockRepository))
+		ctx := utils.SetUserContext(context.Background(), 1, "admin@example.com", "ADMIN")
+
+		_, err := svc.GetPromotionReport(ctx, to, from)
+		assert.ErrorIs(t, err, ErrInvalidDateRange)
+
+		_, err = svc.GetPromotionReport(ctx, from, from.AddDate(2, 0, 0))
+		assert.ErrorIs(t, err, ErrInvalidDateRange)
+	})
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		svc := NewService(new(MockRepository))
+
+		_, err := svc.GetPromotionReport(context.Background(), from, to)
+		assert.ErrorIs(t, err, ErrUnauthenticated)
+	})
+}
+
+func TestService_RefreshSegments(t *testing.T) {
+	mockRepo := new(MockRepository)
+	svc := NewService(mockRepo)
+	ctx := context.Background()
+
+	mockRepo.On("RefreshSegment", ctx, SegmentFirstPurchase).Return(int64(10), nil)
+	mockRepo.On("RefreshSegment", ctx, SegmentDormant90D).Return(int64(4), nil)
+	mockRepo.On("RefreshSegment", ctx, SegmentHighLTV).Return(int64(2), nil)
+
+	sizes, err := svc.RefreshSegments(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, sizes, 3)
+	assert.Equal(t, int64(4), sizes[1].Members)
+}
+
+func TestService_IssueSegmentVouchers(t *testing.T) {
+	adminCtx := utils.SetUserContext(context.Background(), 1, "admin@example.com", "ADMIN")
+	newInput := func() IssueSegmentVouchersInput {
+		return IssueSegmentVouchersInput{
+			CampaignID:    3,
+			Segment:       SegmentDormant90D,
+			CodePrefix:    " comeback ",
+			DiscountType:  DiscountPercent,
+			DiscountValue: 15,
+		}
+	}
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+
+		mockRepo.On("GetCampaignByID", adminCtx, int64(3)).Return(&Campaign{ID: 3}, nil)
+		mockRepo.On("IssueSegmentVouchers", adminCtx, mock.MatchedBy(func(in IssueSegmentVouchersInput) bool {
+			return in.CodePrefix == "COMEBACK"
+		})).Return(int64(4), nil)
+
+		issued, err := svc.IssueSegmentVouchers(adminCtx, newInput())
+		assert.NoError(t, err)
+		assert.Equal(t, int64(4), issued)
+	})
+
+	t.Run("InvalidDiscount", func(t *testing.T) {
+		svc := NewService(new(MockRepository))
+
+		in := newInput()
+		in.DiscountValue = 120
+		_, err := svc.IssueSegmentVouchers(adminCtx, in)
+		assert.ErrorIs(t, err, ErrInvalidDiscount)
+	})
+
+	t.Run("InvalidSegment", func(t *testing.T) {
+		svc := NewService(new(MockRepository))
+
+		in := newInput()
+		in.Segment = Segment("VIP")
+		_, err := svc.IssueSegmentVouchers(adminCtx, in)
+		assert.ErrorIs(t, err, ErrInvalidSegment)
+	})
+
+	t.Run("CampaignEnded", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+
+		ended := time.Now().Add(-time.Hour)
+		mockRepo.On("GetCampaignByID", adminCtx, int64(3)).Return(&Campaign{ID: 3, EndsAt: &ended}, nil)
+
+		_, err := svc.IssueSegmentVouchers(adminCtx, newInput())
+		assert.ErrorIs(t, err, ErrInvalidDateRange)
+		mockRepo.AssertNotCalled(t, "IssueSegmentVouchers", mock.Anything, mock.Anything)
+	})
+
+	t.Run("Forbidden", func(t *testing.T) {
+		svc := NewService(new(MockRepository))
+		ctx := utils.SetUserContext(context.Background(), 2, "test@example.com", "USER")
+
+		_, err := svc.IssueSegmentVouchers(ctx, newInput())
+		assert.ErrorIs(t, err, ErrForbidden)
+	})
+}
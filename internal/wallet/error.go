@@ -0,0 +1,12 @@
+package wallet
+
+import (
+	"errors"
+	"warimas-be/internal/apperr"
+)
+
+var (
+	ErrUnauthenticated     = apperr.Unauthenticated("unauthenticated")
+	ErrInsufficientBalance = errors.New("insufficient wallet balance")
+	ErrDB                  = errors.New("database error")
+)
@@ -0,0 +1,36 @@
+package wallet
+
+import (
+	"strconv"
+	"warimas-be/internal/graph/model"
+)
+
+func MapWalletToGraphQL(w *Wallet, entries []*LedgerEntry) *model.Wallet {
+	if w == nil {
+		return nil
+	}
+
+	items := make([]*model.WalletLedgerEntry, 0, len(entries))
+	for _, e := range entries {
+		items = append(items, MapLedgerEntryToGraphQL(e))
+	}
+
+	return &model.Wallet{
+		Balance:  int32(w.Balance),
+		Currency: w.Currency,
+		Entries:  items,
+	}
+}
+
+func MapLedgerEntryToGraphQL(e *LedgerEntry) *model.WalletLedgerEntry {
+	return &model.WalletLedgerEntry{
+		ID:            strconv.FormatInt(e.ID, 10),
+		Type:          model.WalletEntryType(e.Type),
+		Amount:        int32(e.Amount),
+		BalanceAfter:  int32(e.BalanceAfter),
+		ReferenceType: e.ReferenceType,
+		ReferenceID:   e.ReferenceID,
+		Note:          e.Note,
+		CreatedAt:     e.CreatedAt,
+	}
+}
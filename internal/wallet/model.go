@@ -0,0 +1,38 @@
+package wallet
+
+import "time"
+
+type EntryType string
+
+const (
+	EntryDebit  EntryType = "DEBIT"
+	EntryCredit EntryType = "CREDIT"
+)
+
+// Reference types recorded on ledger entries.
+const (
+	ReferenceOrderPayment = "ORDER_PAYMENT"
+	ReferenceRefund       = "REFUND"
+	ReferenceReferral     = "REFERRAL"
+)
+
+type Wallet struct {
+	ID        int64
+	UserID    uint
+	Balance   int64
+	Currency  string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+type LedgerEntry struct {
+	ID            int64
+	WalletID      int64
+	Type          EntryType
+	Amount        int64
+	BalanceAfter  int64
+	ReferenceType string
+	ReferenceID   string
+	Note          *string
+	CreatedAt     time.Time
+}
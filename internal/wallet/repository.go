@@ -0,0 +1,151 @@
+package wallet
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"warimas-be/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+type Repository interface {
+	GetByUserID(ctx context.Context, userID uint) (*Wallet, error)
+	ListLedger(ctx context.Context, walletID int64, limit int32) ([]*LedgerEntry, error)
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+// GetByUserID returns the user's wallet, or nil when the user has never
+// received a wallet credit.
+func (r *repository) GetByUserID(ctx context.Context, userID uint) (*Wallet, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "GetByUserID"),
+		zap.Uint("user_id", userID),
+	)
+
+	const q = `
+		SELECT id, user_id, balance, currency, created_at, updated_at
+		FROM wallets
+		WHERE user_id = $1
+	`
+
+	var w Wallet
+	err := r.db.QueryRowContext(ctx, q, userID).Scan(
+		&w.ID, &w.UserID, &w.Balance, &w.Currency, &w.CreatedAt, &w.UpdatedAt,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		log.Debug("wallet not found")
+		return nil, nil
+	}
+	if err != nil {
+		log.Error("failed to get wallet", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return &w, nil
+}
+
+func (r *repository) ListLedger(ctx context.Context, walletID int64, limit int32) ([]*LedgerEntry, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ListLedger"),
+		zap.Int64("wallet_id", walletID),
+	)
+
+	const q = `
+		SELECT id, wallet_id, entry_type, amount, balance_after,
+		       reference_type, reference_id, note, created_at
+		FROM wallet_ledger
+		WHERE wallet_id = $1
+		ORDER BY created_at DESC, id DESC
+		LIMIT $2
+	`
+
+	rows, err := r.db.QueryContext(ctx, q, walletID, limit)
+	if err != nil {
+		log.Error("failed to query wallet ledger", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	entries := []*LedgerEntry{}
+	for rows.Next() {
+		var e LedgerEntry
+		if err := rows.Scan(
+			&e.ID, &e.WalletID, &e.Type, &e.Amount, &e.BalanceAfter,
+			&e.ReferenceType, &e.ReferenceID, &e.Note, &e.CreatedAt,
+		); err != nil {
+			log.Error("failed to scan wallet ledger row", zap.Error(err))
+			return nil, ErrDB
+		}
+		entries = append(entries, &e)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error("wallet ledger iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return entries, nil
+}
+
+// CreditTx credits the user's wallet inside tx, creating the wallet on the
+// first credit, and records the matching ledger entry. Returns the ledger
+// entry id.
+func CreditTx(
+	ctx context.Context,
+	tx *sql.Tx,
+	userID int32,
+	amount int64,
+	currency string,
+	referenceType string,
+	referenceID string,
+	note *string,
+) (int64, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "CreditTx"),
+		zap.Int32("user_id", userID),
+		zap.String("reference_type", referenceType),
+	)
+
+	var (
+		walletID     int64
+		balanceAfter int64
+	)
+	err := tx.QueryRowContext(ctx, `
+		INSERT INTO wallets (user_id, balance, currency)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id)
+		DO UPDATE SET balance = wallets.balance + EXCLUDED.balance
+		RETURNING id, balance
+	`, userID, amount, currency).Scan(&walletID, &balanceAfter)
+	if err != nil {
+		log.Error("failed to credit wallet", zap.Error(err))
+		return 0, ErrDB
+	}
+
+	var ledgerID int64
+	err = tx.QueryRowContext(ctx, `
+		INSERT INTO wallet_ledger (
+			wallet_id, entry_type, amount, balance_after, reference_type, reference_id, note
+		) VALUES ($1,$2,$3,$4,$5,$6,$7)
+		RETURNING id
+	`,
+		walletID, EntryCredit, amount, balanceAfter, referenceType, referenceID, note,
+	).Scan(&ledgerID)
+	if err != nil {
+		log.Error("failed to insert wallet ledger entry", zap.Error(err))
+		return 0, ErrDB
+	}
+
+	return ledgerID, nil
+}
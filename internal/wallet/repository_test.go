@@ -0,0 +1,73 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_GetByUserID(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+
+	t.Run("Success", func(t *testing.T) {
+		rows := sqlmock.NewRows([]string{"id", "user_id", "balance", "currency", "created_at", "updated_at"}).
+			AddRow(1, 5, 25000, "IDR", time.Now(), time.Now())
+
+		mock.ExpectQuery(`SELECT id, user_id, balance, currency, created_at, updated_at FROM wallets WHERE user_id = \$1`).
+			WithArgs(uint(5)).
+			WillReturnRows(rows)
+
+		w, err := repo.GetByUserID(ctx, 5)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(25000), w.Balance)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT .* FROM wallets`).
+			WithArgs(uint(5)).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		w, err := repo.GetByUserID(ctx, 5)
+		assert.NoError(t, err)
+		assert.Nil(t, w)
+	})
+
+	t.Run("DBError", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT .* FROM wallets`).WillReturnError(errors.New("db error"))
+
+		_, err := repo.GetByUserID(ctx, 5)
+		assert.ErrorIs(t, err, ErrDB)
+	})
+}
+
+func TestRepository_ListLedger(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+
+	rows := sqlmock.NewRows([]string{
+		"id", "wallet_id", "entry_type", "amount", "balance_after",
+		"reference_type", "reference_id", "note", "created_at",
+	}).AddRow(10, 1, "DEBIT", 5000, 20000, ReferenceOrderPayment, "pay-1", nil, time.Now())
+
+	mock.ExpectQuery(`SELECT .* FROM wallet_ledger WHERE wallet_id = \$1`).
+		WithArgs(int64(1), int32(20)).
+		WillReturnRows(rows)
+
+	entries, err := repo.ListLedger(context.Background(), 1, 20)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+	assert.Equal(t, EntryDebit, entries[0].Type)
+}
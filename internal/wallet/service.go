@@ -0,0 +1,81 @@
+package wallet
+
+import (
+	"context"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/utils"
+
+	"go.uber.org/zap"
+)
+
+const defaultLedgerLimit = 20
+
+type Service interface {
+	GetMyWallet(ctx context.Context) (*Wallet, []*LedgerEntry, error)
+
+	// Balance is for other modules (checkout) and carries no caller check.
+	Balance(ctx context.Context, userID uint) (int64, error)
+}
+
+type service struct {
+	repo Repository
+}
+
+func NewService(repo Repository) Service {
+	return &service{repo: repo}
+}
+
+// GetMyWallet returns the caller's wallet with its most recent ledger
+// entries. Users without a wallet row get an empty IDR wallet.
+func (s *service) GetMyWallet(ctx context.Context) (*Wallet, []*LedgerEntry, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "GetMyWallet"),
+	)
+
+	userID, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		log.Warn("user not authenticated")
+		return nil, nil, ErrUnauthenticated
+	}
+
+	log = log.With(zap.Uint("user_id", userID))
+
+	w, err := s.repo.GetByUserID(ctx, userID)
+	if err != nil {
+		log.Error("failed to get wallet", zap.Error(err))
+		return nil, nil, err
+	}
+
+	if w == nil {
+		return &Wallet{UserID: userID, Currency: "IDR"}, []*LedgerEntry{}, nil
+	}
+
+	entries, err := s.repo.ListLedger(ctx, w.ID, defaultLedgerLimit)
+	if err != nil {
+		log.Error("failed to list wallet ledger", zap.Error(err))
+		return nil, nil, err
+	}
+
+	return w, entries, nil
+}
+
+// Balance returns the user's spendable wallet balance, zero when the user
+// has no wallet yet.
+func (s *service) Balance(ctx context.Context, userID uint) (int64, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "Balance"),
+		zap.Uint("user_id", userID),
+	)
+
+	w, err := s.repo.GetByUserID(ctx, userID)
+	if err != nil {
+		log.Error("failed to get wallet", zap.Error(err))
+		return 0, err
+	}
+	if w == nil {
+		return 0, nil
+	}
+	return w.Balance, nil
+}
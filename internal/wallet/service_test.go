@@ -0,0 +1,98 @@
+package wallet
+
+import (
+	"context"
+	"testing"
+	"warimas-be/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// --- Mocks ---
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) GetByUserID(ctx context.Context, userID uint) (*Wallet, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Wallet), args.Error(1)
+}
+
+func (m *MockRepository) ListLedger(ctx context.Context, walletID int64, limit int32) ([]*LedgerEntry, error) {
+	args := m.Called(ctx, walletID, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*LedgerEntry), args.Error(1)
+}
+
+// --- Tests ---
+
+func TestService_GetMyWallet(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+		ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "user")
+
+		w := &Wallet{ID: 3, UserID: 1, Balance: 15000, Currency: "IDR"}
+		mockRepo.On("GetByUserID", ctx, uint(1)).Return(w, nil)
+		mockRepo.On("ListLedger", ctx, int64(3), int32(defaultLedgerLimit)).Return([]*LedgerEntry{{ID: 1}}, nil)
+
+		res, entries, err := svc.GetMyWallet(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(15000), res.Balance)
+		assert.Len(t, entries, 1)
+	})
+
+	t.Run("NoWalletYet", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+		ctx := utils.SetUserContext(context.Background(), 1, "test@example.com", "user")
+
+		mockRepo.On("GetByUserID", ctx, uint(1)).Return(nil, nil)
+
+		res, entries, err := svc.GetMyWallet(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), res.Balance)
+		assert.Empty(t, entries)
+		mockRepo.AssertNotCalled(t, "ListLedger", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		svc := NewService(new(MockRepository))
+
+		_, _, err := svc.GetMyWallet(context.Background())
+		assert.ErrorIs(t, err, ErrUnauthenticated)
+	})
+}
+
+func TestService_Balance(t *testing.T) {
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+		ctx := context.Background()
+
+		mockRepo.On("GetByUserID", ctx, uint(1)).Return(&Wallet{ID: 3, UserID: 1, Balance: 15000}, nil)
+
+		balance, err := svc.Balance(ctx, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(15000), balance)
+	})
+
+	t.Run("NoWalletYet", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := NewService(mockRepo)
+		ctx := context.Background()
+
+		mockRepo.On("GetByUserID", ctx, uint(1)).Return(nil, nil)
+
+		balance, err := svc.Balance(ctx, 1)
+		assert.NoError(t, err)
+		assert.Zero(t, balance)
+	})
+}
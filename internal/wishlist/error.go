@@ -0,0 +1,13 @@
+package wishlist
+
+import (
+	"errors"
+	"warimas-be/internal/apperr"
+)
+
+var (
+	ErrUnauthenticated  = apperr.Unauthenticated("unauthenticated")
+	ErrVariantNotFound  = apperr.NotFound("variant not found")
+	ErrInvalidVariantID = errors.New("invalid variant id")
+	ErrDB               = errors.New("database error")
+)
@@ -0,0 +1,35 @@
+package wishlist
+
+import (
+	"strconv"
+	"warimas-be/internal/graph/model"
+)
+
+func MapItemToGraphQL(it *Item) *model.WishlistItem {
+	return &model.WishlistItem{
+		ID:          strconv.FormatInt(it.ID, 10),
+		VariantID:   it.VariantID,
+		ProductID:   it.ProductID,
+		ProductName: it.ProductName,
+		VariantName: it.VariantName,
+		ImageURL:    it.ImageURL,
+		Price:       it.Price,
+		InStock:     it.InStock,
+		AddedAt:     it.AddedAt,
+	}
+}
+
+func MapAlertToGraphQL(a *Alert) *model.WishlistAlert {
+	return &model.WishlistAlert{
+		ID:          strconv.FormatInt(a.ID, 10),
+		Kind:        model.WishlistAlertKind(a.Kind),
+		VariantID:   a.VariantID,
+		ProductID:   a.ProductID,
+		ProductName: a.ProductName,
+		VariantName: a.VariantName,
+		OldPrice:    a.OldPrice,
+		NewPrice:    a.NewPrice,
+		CreatedAt:   a.CreatedAt,
+		ReadAt:      a.ReadAt,
+	}
+}
@@ -0,0 +1,54 @@
+package wishlist
+
+import (
+	"time"
+	"warimas-be/internal/preference"
+)
+
+// AlertInterval is how often the alert job reads new variant changes.
+const AlertInterval = time.Minute
+
+const (
+	defaultLimit    = int32(20)
+	maxLimit        = int32(100)
+	notifyBatchSize = 100
+)
+
+type AlertKind string
+
+const (
+	AlertKindPriceDrop   AlertKind = "PRICE_DROP"
+	AlertKindBackInStock AlertKind = "BACK_IN_STOCK"
+)
+
+// Item is a wishlisted variant with its current price and stock.
+type Item struct {
+	ID          int64
+	UserID      int32
+	VariantID   string
+	ProductID   string
+	ProductName string
+	VariantName string
+	ImageURL    *string
+	Price       float64
+	InStock     bool
+	AddedAt     time.Time
+}
+
+// Alert tells a customer a wishlisted variant got cheaper or came back in
+// stock. OldPrice is nil for AlertKindBackInStock. Delivery is filled in
+// before delivery from the customer's preferences.
+type Alert struct {
+	ID          int64
+	UserID      int32
+	VariantID   string
+	ProductID   string
+	ProductName string
+	VariantName string
+	Kind        AlertKind
+	OldPrice    *float64
+	NewPrice    float64
+	CreatedAt   time.Time
+	ReadAt      *time.Time
+	Delivery    *preference.Delivery
+}
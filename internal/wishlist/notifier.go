@@ -0,0 +1,35 @@
+package wishlist
+
+import (
+	"context"
+	"warimas-be/internal/logger"
+
+	"go.uber.org/zap"
+)
+
+// Notifier delivers each alert on the channels of its Delivery. Alerts are
+// listed in the app either way. The job marks alerts notified only after
+// NotifyAlerts succeeds, so a failed delivery is retried on the next run.
+type Notifier interface {
+	NotifyAlerts(ctx context.Context, alerts []*Alert) error
+}
+
+// LogNotifier writes each alert at info level until a push or email
+// provider is wired in.
+type LogNotifier struct{}
+
+func (LogNotifier) NotifyAlerts(ctx context.Context, alerts []*Alert) error {
+	log := logger.FromCtx(ctx)
+	for _, a := range alerts {
+		log.Info("wishlist alert",
+			zap.Int64("alert_id", a.ID),
+			zap.Int32("user_id", a.UserID),
+			zap.String("kind", string(a.Kind)),
+			zap.String("variant_id", a.VariantID),
+			zap.Float64("new_price", a.NewPrice),
+			zap.Any("channels", a.Delivery.Channels),
+			zap.String("locale", a.Delivery.Locale),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,366 @@
+package wishlist
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+	"warimas-be/internal/logger"
+
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+type Repository interface {
+	// Add wishlists an active variant; adding it again is a no-op.
+	Add(ctx context.Context, userID int32, variantID string) (*Item, error)
+	Remove(ctx context.Context, userID int32, variantID string) (bool, error)
+	ListItems(ctx context.Context, userID int32, limit, offset int32) ([]*Item, error)
+	CountItems(ctx context.Context, userID int32) (int64, error)
+
+	// DetectAlerts reads variant changes logged before settledBefore and
+	// past the stored cursor, records alerts for wishlisted variants that
+	// dropped below their alert price or came back in stock, and moves the
+	// cursor on. It returns how many alerts were recorded.
+	DetectAlerts(ctx context.Context, settledBefore time.Time) (int64, error)
+	ListUnnotified(ctx context.Context, limit int32) ([]*Alert, error)
+	MarkNotified(ctx context.Context, ids []int64, now time.Time) error
+
+	ListAlerts(ctx context.Context, userID int32, unreadOnly bool, limit, offset int32) ([]*Alert, error)
+	CountAlerts(ctx context.Context, userID int32, unreadOnly bool) (int64, error)
+	MarkAlertsRead(ctx context.Context, userID int32, now time.Time) (int64, error)
+}
+
+type repository struct {
+	db *sql.DB
+}
+
+func NewRepository(db *sql.DB) Repository {
+	return &repository{db: db}
+}
+
+const itemColumns = `
+	w.id, w.user_id, w.variant_id, p.id, p.name, v.name, v.imageurl,
+	v.price, v.stock > 0, w.created_at
+`
+
+const itemJoins = `
+	FROM wishlist_items w
+	JOIN variants v ON v.id = w.variant_id
+	JOIN products p ON p.id = v.product_id
+`
+
+func scanItem(row interface{ Scan(...any) error }) (*Item, error) {
+	var it Item
+	if err := row.Scan(
+		&it.ID, &it.UserID, &it.VariantID, &it.ProductID, &it.ProductName,
+		&it.VariantName, &it.ImageURL, &it.Price, &it.InStock, &it.AddedAt,
+	); err != nil {
+		return nil, err
+	}
+	return &it, nil
+}
+
+func (r *repository) Add(ctx context.Context, userID int32, variantID string) (*Item, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "Add"),
+		zap.Int32("user_id", userID),
+		zap.String("variant_id", variantID),
+	)
+
+	// The baselines start from the variant as it is now.
+	_, err := r.db.ExecContext(ctx, `
+		INSERT INTO wishlist_items (user_id, variant_id, alert_price, in_stock)
+		SELECT $1, v.id, v.price, v.stock > 0
+		FROM variants v
+		JOIN products p ON p.id = v.product_id
+		WHERE v.id = $2
+		  AND p.status = 'active'
+		ON CONFLICT (user_id, variant_id) DO NOTHING
+	`, userID, variantID)
+	if err != nil {
+		log.Error("failed to insert wishlist item", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	it, err := scanItem(r.db.QueryRowContext(ctx, `
+		SELECT `+itemColumns+itemJoins+`
+		WHERE w.user_id = $1
+		  AND w.variant_id = $2
+	`, userID, variantID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrVariantNotFound
+	}
+	if err != nil {
+		log.Error("failed to load wishlist item", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return it, nil
+}
+
+func (r *repository) Remove(ctx context.Context, userID int32, variantID string) (bool, error) {
+	res, err := r.db.ExecContext(ctx, `
+		DELETE FROM wishlist_items
+		WHERE user_id = $1
+		  AND variant_id = $2
+	`, userID, variantID)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to delete wishlist item", zap.Error(err))
+		return false, ErrDB
+	}
+
+	n, _ := res.RowsAffected()
+	return n > 0, nil
+}
+
+func (r *repository) ListItems(ctx context.Context, userID int32, limit, offset int32) ([]*Item, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "ListItems"),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT `+itemColumns+itemJoins+`
+		WHERE w.user_id = $1
+		ORDER BY w.created_at DESC, w.id DESC
+		LIMIT $2 OFFSET $3
+	`, userID, limit, offset)
+	if err != nil {
+		log.Error("failed to query wishlist", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	items := []*Item{}
+	for rows.Next() {
+		it, err := scanItem(rows)
+		if err != nil {
+			log.Error("failed to scan wishlist item", zap.Error(err))
+			return nil, ErrDB
+		}
+		items = append(items, it)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error("wishlist iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return items, nil
+}
+
+func (r *repository) CountItems(ctx context.Context, userID int32) (int64, error) {
+	var n int64
+	err := r.db.QueryRowContext(ctx, `
+		SELECT COUNT(1) FROM wishlist_items WHERE user_id = $1
+	`, userID).Scan(&n)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to count wishlist", zap.Error(err))
+		return 0, ErrDB
+	}
+	return n, nil
+}
+
+// changedVariants selects the variants whose price or stock changed in
+// the log range ($1, $2].
+const changedVariants = `
+	SELECT DISTINCT variant_id
+	FROM product_changes
+	WHERE id > $1
+	  AND id <= $2
+	  AND variant_id IS NOT NULL
+	  AND operation = 'UPDATE'
+	  AND changed_columns && ARRAY['price', 'stock']
+`
+
+func (r *repository) DetectAlerts(ctx context.Context, settledBefore time.Time) (n int64, err error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", "DetectAlerts"),
+	)
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		log.Error("failed to begin transaction", zap.Error(err))
+		return 0, ErrDB
+	}
+	defer func() {
+		if err != nil {
+			_ = tx.Rollback()
+		}
+	}()
+
+	// Locking the cursor keeps two instances from reading the same range.
+	var from, to int64
+	if err = tx.QueryRowContext(ctx, `
+		SELECT last_change_id FROM wishlist_alert_cursor FOR UPDATE
+	`).Scan(&from); err != nil {
+		log.Error("failed to lock alert cursor", zap.Error(err))
+		return 0, ErrDB
+	}
+
+	if err = tx.QueryRowContext(ctx, `
+		SELECT COALESCE(MAX(id), $1)
+		FROM product_changes
+		WHERE id > $1
+		  AND changed_at < $2
+	`, from, settledBefore).Scan(&to); err != nil {
+		log.Error("failed to read change log", zap.Error(err))
+		return 0, ErrDB
+	}
+
+	if to > from {
+		var res sql.Result
+		res, err = tx.ExecContext(ctx, `
+			INSERT INTO wishlist_alerts (user_id, variant_id, kind, old_price, new_price)
+			SELECT w.user_id, w.variant_id, 'PRICE_DROP', w.alert_price, v.price
+			FROM wishlist_items w
+			JOIN variants v ON v.id = w.variant_id
+			WHERE w.variant_id IN (`+changedVariants+`)
+			  AND v.price < w.alert_price
+			UNION ALL
+			SELECT w.user_id, w.variant_id, 'BACK_IN_STOCK', NULL, v.price
+			FROM wishlist_items w
+			JOIN variants v ON v.id = w.variant_id
+			WHERE w.variant_id IN (`+changedVariants+`)
+			  AND NOT w.in_stock
+			  AND v.stock > 0
+		`, from, to)
+		if err != nil {
+			log.Error("failed to record wishlist alerts", zap.Error(err))
+			return 0, ErrDB
+		}
+		n, _ = res.RowsAffected()
+
+		// A price rise does not raise the baseline, so only a drop below
+		// the lowest price announced alerts again.
+		if _, err = tx.ExecContext(ctx, `
+			UPDATE wishlist_items w
+			SET alert_price = LEAST(w.alert_price, v.price),
+			    in_stock = v.stock > 0
+			FROM variants v
+			WHERE v.id = w.variant_id
+			  AND w.variant_id IN (`+changedVariants+`)
+		`, from, to); err != nil {
+			log.Error("failed to update wishlist baselines", zap.Error(err))
+			return 0, ErrDB
+		}
+
+		if _, err = tx.ExecContext(ctx, `
+			UPDATE wishlist_alert_cursor SET last_change_id = $1
+		`, to); err != nil {
+			log.Error("failed to move alert cursor", zap.Error(err))
+			return 0, ErrDB
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		log.Error("failed to commit wishlist alerts", zap.Error(err))
+		return 0, ErrDB
+	}
+
+	return n, nil
+}
+
+func (r *repository) ListUnnotified(ctx context.Context, limit int32) ([]*Alert, error) {
+	return r.listAlerts(ctx, "ListUnnotified", `
+		WHERE a.notified_at IS NULL
+		ORDER BY a.id
+		LIMIT $1
+	`, limit)
+}
+
+func (r *repository) MarkNotified(ctx context.Context, ids []int64, now time.Time) error {
+	_, err := r.db.ExecContext(ctx, `
+		UPDATE wishlist_alerts
+		SET notified_at = $2
+		WHERE id = ANY($1)
+	`, pq.Array(ids), now)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to mark wishlist alerts notified", zap.Error(err))
+		return ErrDB
+	}
+	return nil
+}
+
+func (r *repository) ListAlerts(ctx context.Context, userID int32, unreadOnly bool, limit, offset int32) ([]*Alert, error) {
+	return r.listAlerts(ctx, "ListAlerts", `
+		WHERE a.user_id = $1
+		  AND (NOT $2 OR a.read_at IS NULL)
+		ORDER BY a.id DESC
+		LIMIT $3 OFFSET $4
+	`, userID, unreadOnly, limit, offset)
+}
+
+func (r *repository) CountAlerts(ctx context.Context, userID int32, unreadOnly bool) (int64, error) {
+	var n int64
+	err := r.db.QueryRowContext(ctx, `
+		SELECT COUNT(1)
+		FROM wishlist_alerts
+		WHERE user_id = $1
+		  AND (NOT $2 OR read_at IS NULL)
+	`, userID, unreadOnly).Scan(&n)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to count wishlist alerts", zap.Error(err))
+		return 0, ErrDB
+	}
+	return n, nil
+}
+
+func (r *repository) MarkAlertsRead(ctx context.Context, userID int32, now time.Time) (int64, error) {
+	res, err := r.db.ExecContext(ctx, `
+		UPDATE wishlist_alerts
+		SET read_at = $2
+		WHERE user_id = $1
+		  AND read_at IS NULL
+	`, userID, now)
+	if err != nil {
+		logger.FromCtx(ctx).Error("failed to mark wishlist alerts read", zap.Error(err))
+		return 0, ErrDB
+	}
+
+	n, _ := res.RowsAffected()
+	return n, nil
+}
+
+func (r *repository) listAlerts(ctx context.Context, method, where string, args ...any) ([]*Alert, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "repository"),
+		zap.String("method", method),
+	)
+
+	rows, err := r.db.QueryContext(ctx, `
+		SELECT a.id, a.user_id, a.variant_id, p.id, p.name, v.name,
+		       a.kind, a.old_price, a.new_price, a.created_at, a.read_at
+		FROM wishlist_alerts a
+		JOIN variants v ON v.id = a.variant_id
+		JOIN products p ON p.id = v.product_id
+	`+where, args...)
+	if err != nil {
+		log.Error("failed to query wishlist alerts", zap.Error(err))
+		return nil, ErrDB
+	}
+	defer rows.Close()
+
+	list := []*Alert{}
+	for rows.Next() {
+		var a Alert
+		if err := rows.Scan(
+			&a.ID, &a.UserID, &a.VariantID, &a.ProductID, &a.ProductName, &a.VariantName,
+			&a.Kind, &a.OldPrice, &a.NewPrice, &a.CreatedAt, &a.ReadAt,
+		); err != nil {
+			log.Error("failed to scan wishlist alert", zap.Error(err))
+			return nil, ErrDB
+		}
+		list = append(list, &a)
+	}
+
+	if err := rows.Err(); err != nil {
+		log.Error("wishlist alert iteration failed", zap.Error(err))
+		return nil, ErrDB
+	}
+
+	return list, nil
+}
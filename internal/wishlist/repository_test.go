@@ -0,0 +1,140 @@
+package wishlist
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepository_Add(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+	now := time.Now()
+
+	t.Run("Success", func(t *testing.T) {
+		mock.ExpectExec(`INSERT INTO wishlist_items .* SELECT \$1, v.id, v.price, v.stock > 0 FROM variants v .* AND p.status = 'active' ON CONFLICT \(user_id, variant_id\) DO NOTHING`).
+			WithArgs(int32(7), variantID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectQuery(`SELECT .* FROM wishlist_items w JOIN variants v .* WHERE w.user_id = \$1 AND w.variant_id = \$2`).
+			WithArgs(int32(7), variantID).
+			WillReturnRows(sqlmock.NewRows([]string{
+				"id", "user_id", "variant_id", "product_id", "product_name",
+				"variant_name", "imageurl", "price", "in_stock", "created_at",
+			}).AddRow(1, 7, variantID, "p-1", "Beras", "5kg", nil, 65000.0, false, now))
+
+		it, err := repo.Add(ctx, 7, variantID)
+		assert.NoError(t, err)
+		assert.Equal(t, "Beras", it.ProductName)
+		assert.Equal(t, 65000.0, it.Price)
+		assert.False(t, it.InStock)
+	})
+
+	t.Run("InactiveOrMissingVariant", func(t *testing.T) {
+		mock.ExpectExec(`INSERT INTO wishlist_items`).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+		mock.ExpectQuery(`SELECT .* FROM wishlist_items w`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		_, err := repo.Add(ctx, 7, variantID)
+		assert.ErrorIs(t, err, ErrVariantNotFound)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_DetectAlerts(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	ctx := context.Background()
+	settled := time.Now()
+
+	t.Run("RecordsAlertsAndMovesCursor", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT last_change_id FROM wishlist_alert_cursor FOR UPDATE`).
+			WillReturnRows(sqlmock.NewRows([]string{"last_change_id"}).AddRow(10))
+		mock.ExpectQuery(`SELECT COALESCE\(MAX\(id\), \$1\) FROM product_changes WHERE id > \$1 AND changed_at < \$2`).
+			WithArgs(int64(10), settled).
+			WillReturnRows(sqlmock.NewRows([]string{"max"}).AddRow(15))
+		mock.ExpectExec(`INSERT INTO wishlist_alerts .* 'PRICE_DROP'.* v.price < w.alert_price UNION ALL .* 'BACK_IN_STOCK'.* NOT w.in_stock AND v.stock > 0`).
+			WithArgs(int64(10), int64(15)).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectExec(`UPDATE wishlist_items w SET alert_price = LEAST\(w.alert_price, v.price\), in_stock = v.stock > 0`).
+			WithArgs(int64(10), int64(15)).
+			WillReturnResult(sqlmock.NewResult(0, 3))
+		mock.ExpectExec(`UPDATE wishlist_alert_cursor SET last_change_id = \$1`).
+			WithArgs(int64(15)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectCommit()
+
+		n, err := repo.DetectAlerts(ctx, settled)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), n)
+	})
+
+	t.Run("NothingNew", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT last_change_id FROM wishlist_alert_cursor`).
+			WillReturnRows(sqlmock.NewRows([]string{"last_change_id"}).AddRow(15))
+		mock.ExpectQuery(`FROM product_changes`).
+			WillReturnRows(sqlmock.NewRows([]string{"max"}).AddRow(15))
+		mock.ExpectCommit()
+
+		n, err := repo.DetectAlerts(ctx, settled)
+		assert.NoError(t, err)
+		assert.Zero(t, n)
+	})
+
+	t.Run("InsertErrorRollsBack", func(t *testing.T) {
+		mock.ExpectBegin()
+		mock.ExpectQuery(`SELECT last_change_id FROM wishlist_alert_cursor`).
+			WillReturnRows(sqlmock.NewRows([]string{"last_change_id"}).AddRow(15))
+		mock.ExpectQuery(`FROM product_changes`).
+			WillReturnRows(sqlmock.NewRows([]string{"max"}).AddRow(20))
+		mock.ExpectExec(`INSERT INTO wishlist_alerts`).WillReturnError(errors.New("boom"))
+		mock.ExpectRollback()
+
+		_, err := repo.DetectAlerts(ctx, settled)
+		assert.ErrorIs(t, err, ErrDB)
+	})
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRepository_ListAlerts(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	repo := NewRepository(db)
+	now := time.Now()
+	old := 70000.0
+
+	mock.ExpectQuery(`SELECT a.id, .* FROM wishlist_alerts a JOIN variants v .* WHERE a.user_id = \$1 AND \(NOT \$2 OR a.read_at IS NULL\) ORDER BY a.id DESC LIMIT \$3 OFFSET \$4`).
+		WithArgs(int32(7), true, int32(20), int32(0)).
+		WillReturnRows(sqlmock.NewRows([]string{
+			"id", "user_id", "variant_id", "product_id", "product_name", "variant_name",
+			"kind", "old_price", "new_price", "created_at", "read_at",
+		}).
+			AddRow(2, 7, variantID, "p-1", "Beras", "5kg", "PRICE_DROP", old, 65000.0, now, nil).
+			AddRow(1, 7, variantID, "p-1", "Beras", "5kg", "BACK_IN_STOCK", nil, 70000.0, now, nil))
+
+	alerts, err := repo.ListAlerts(context.Background(), 7, true, 20, 0)
+	assert.NoError(t, err)
+	assert.Len(t, alerts, 2)
+	assert.Equal(t, AlertKindPriceDrop, alerts[0].Kind)
+	assert.Equal(t, &old, alerts[0].OldPrice)
+	assert.Nil(t, alerts[1].OldPrice)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
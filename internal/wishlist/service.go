@@ -0,0 +1,231 @@
+package wishlist
+
+import (
+	"context"
+	"slices"
+	"time"
+	"warimas-be/internal/changelog"
+	"warimas-be/internal/consent"
+	"warimas-be/internal/logger"
+	"warimas-be/internal/preference"
+	"warimas-be/internal/utils"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type Service interface {
+	AddItem(ctx context.Context, variantID string) (*Item, error)
+	RemoveItem(ctx context.Context, variantID string) (bool, error)
+	GetMyWishlist(ctx context.Context, limit, page int32) ([]*Item, int64, error)
+	GetMyAlerts(ctx context.Context, unreadOnly bool, limit, page int32) ([]*Alert, int64, error)
+	MarkAlertsRead(ctx context.Context) (int64, error)
+
+	// CheckAlerts records alerts for the variant changes logged since the
+	// last run and delivers any not yet notified. It returns how many new
+	// alerts were recorded.
+	CheckAlerts(ctx context.Context) (int64, error)
+}
+
+// ConsentChecker is the suppression check for alert emails; price drops
+// are promotional, so they follow marketing email consent.
+type ConsentChecker interface {
+	FilterSendable(ctx context.Context, channel consent.Channel, userIDs []int32) ([]int32, error)
+}
+
+// PreferenceGateway says how customers want product alerts sent.
+type PreferenceGateway interface {
+	DeliveriesFor(ctx context.Context, event preference.Event, userIDs []int32) map[int32]*preference.Delivery
+}
+
+type service struct {
+	repo     Repository
+	consent  ConsentChecker
+	prefs    PreferenceGateway
+	notifier Notifier
+	now      func() time.Time
+}
+
+func NewService(repo Repository, consent ConsentChecker, prefs PreferenceGateway, notifier Notifier) Service {
+	return &service{repo: repo, consent: consent, prefs: prefs, notifier: notifier, now: time.Now}
+}
+
+func (s *service) AddItem(ctx context.Context, variantID string) (*Item, error) {
+	userID, err := currentUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uuid.Parse(variantID); err != nil {
+		return nil, ErrInvalidVariantID
+	}
+	return s.repo.Add(ctx, userID, variantID)
+}
+
+func (s *service) RemoveItem(ctx context.Context, variantID string) (bool, error) {
+	userID, err := currentUser(ctx)
+	if err != nil {
+		return false, err
+	}
+	if _, err := uuid.Parse(variantID); err != nil {
+		return false, ErrInvalidVariantID
+	}
+	return s.repo.Remove(ctx, userID, variantID)
+}
+
+func (s *service) GetMyWishlist(ctx context.Context, limit, page int32) ([]*Item, int64, error) {
+	userID, err := currentUser(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	limit, offset := pageBounds(limit, page)
+	items, err := s.repo.ListItems(ctx, userID, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err := s.repo.CountItems(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return items, total, nil
+}
+
+func (s *service) GetMyAlerts(ctx context.Context, unreadOnly bool, limit, page int32) ([]*Alert, int64, error) {
+	userID, err := currentUser(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	limit, offset := pageBounds(limit, page)
+	alerts, err := s.repo.ListAlerts(ctx, userID, unreadOnly, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	total, err := s.repo.CountAlerts(ctx, userID, unreadOnly)
+	if err != nil {
+		return nil, 0, err
+	}
+	return alerts, total, nil
+}
+
+func (s *service) MarkAlertsRead(ctx context.Context) (int64, error) {
+	userID, err := currentUser(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return s.repo.MarkAlertsRead(ctx, userID, s.now())
+}
+
+func (s *service) CheckAlerts(ctx context.Context) (int64, error) {
+	log := logger.FromCtx(ctx).With(
+		zap.String("layer", "service"),
+		zap.String("method", "CheckAlerts"),
+	)
+
+	now := s.now()
+	found, err := s.repo.DetectAlerts(ctx, now.Add(-changelog.SettleDelay))
+	if err != nil {
+		return 0, err
+	}
+	if found > 0 {
+		log.Info("new wishlist alerts", zap.Int64("count", found))
+	}
+
+	for {
+		pending, err := s.repo.ListUnnotified(ctx, notifyBatchSize)
+		if err != nil {
+			return found, err
+		}
+		if len(pending) == 0 {
+			return found, nil
+		}
+
+		if err := s.addDeliveries(ctx, pending); err != nil {
+			return found, err
+		}
+
+		// Muted alerts stay listed in the app and count as notified.
+		send := make([]*Alert, 0, len(pending))
+		for _, a := range pending {
+			if !a.Delivery.Muted() {
+				send = append(send, a)
+			}
+		}
+		if len(send) > 0 {
+			if err := s.notifier.NotifyAlerts(ctx, send); err != nil {
+				log.Error("failed to send wishlist alerts", zap.Error(err))
+				return found, err
+			}
+		}
+
+		ids := make([]int64, 0, len(pending))
+		for _, a := range pending {
+			ids = append(ids, a.ID)
+		}
+		if err := s.repo.MarkNotified(ctx, ids, now); err != nil {
+			return found, err
+		}
+
+		if len(pending) < notifyBatchSize {
+			return found, nil
+		}
+	}
+}
+
+// addDeliveries sets Delivery on the alerts from the customers' product
+// alert preferences, leaving out email for those who do not accept
+// marketing email.
+func (s *service) addDeliveries(ctx context.Context, alerts []*Alert) error {
+	userIDs := make([]int32, 0, len(alerts))
+	seen := make(map[int32]bool, len(alerts))
+	for _, a := range alerts {
+		if !seen[a.UserID] {
+			seen[a.UserID] = true
+			userIDs = append(userIDs, a.UserID)
+		}
+	}
+
+	sendable, err := s.consent.FilterSendable(ctx, consent.ChannelEmail, userIDs)
+	if err != nil {
+		return err
+	}
+
+	allowed := make(map[int32]bool, len(sendable))
+	for _, id := range sendable {
+		allowed[id] = true
+	}
+
+	deliveries := s.prefs.DeliveriesFor(ctx, preference.EventProductAlerts, userIDs)
+	for id, d := range deliveries {
+		if !allowed[id] {
+			d.Channels = slices.DeleteFunc(d.Channels, func(c preference.Channel) bool {
+				return c == preference.ChannelEmail
+			})
+		}
+	}
+	for _, a := range alerts {
+		a.Delivery = deliveries[a.UserID]
+	}
+	return nil
+}
+
+func currentUser(ctx context.Context) (int32, error) {
+	userID, ok := utils.GetUserIDFromContext(ctx)
+	if !ok {
+		return 0, ErrUnauthenticated
+	}
+	return int32(userID), nil
+}
+
+func pageBounds(limit, page int32) (int32, int32) {
+	if limit <= 0 {
+		limit = defaultLimit
+	}
+	if limit > maxLimit {
+		limit = maxLimit
+	}
+	if page <= 0 {
+		page = 1
+	}
+	return limit, (page - 1) * limit
+}
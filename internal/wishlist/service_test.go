@@ -0,0 +1,248 @@
+package wishlist
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+	"warimas-be/internal/changelog"
+	"warimas-be/internal/consent"
+	"warimas-be/internal/preference"
+	"warimas-be/internal/utils"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// --- Mocks ---
+
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) Add(ctx context.Context, userID int32, variantID string) (*Item, error) {
+	args := m.Called(ctx, userID, variantID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*Item), args.Error(1)
+}
+
+func (m *MockRepository) Remove(ctx context.Context, userID int32, variantID string) (bool, error) {
+	args := m.Called(ctx, userID, variantID)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *MockRepository) ListItems(ctx context.Context, userID int32, limit, offset int32) ([]*Item, error) {
+	args := m.Called(ctx, userID, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Item), args.Error(1)
+}
+
+func (m *MockRepository) CountItems(ctx context.Context, userID int32) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) DetectAlerts(ctx context.Context, settledBefore time.Time) (int64, error) {
+	args := m.Called(ctx, settledBefore)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) ListUnnotified(ctx context.Context, limit int32) ([]*Alert, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Alert), args.Error(1)
+}
+
+func (m *MockRepository) MarkNotified(ctx context.Context, ids []int64, now time.Time) error {
+	args := m.Called(ctx, ids, now)
+	return args.Error(0)
+}
+
+func (m *MockRepository) ListAlerts(ctx context.Context, userID int32, unreadOnly bool, limit, offset int32) ([]*Alert, error) {
+	args := m.Called(ctx, userID, unreadOnly, limit, offset)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*Alert), args.Error(1)
+}
+
+func (m *MockRepository) CountAlerts(ctx context.Context, userID int32, unreadOnly bool) (int64, error) {
+	args := m.Called(ctx, userID, unreadOnly)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *MockRepository) MarkAlertsRead(ctx context.Context, userID int32, now time.Time) (int64, error) {
+	args := m.Called(ctx, userID, now)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+type MockConsent struct {
+	mock.Mock
+}
+
+func (m *MockConsent) FilterSendable(ctx context.Context, channel consent.Channel, userIDs []int32) ([]int32, error) {
+	args := m.Called(ctx, channel, userIDs)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]int32), args.Error(1)
+}
+
+type MockPreferences struct {
+	mock.Mock
+}
+
+func (m *MockPreferences) DeliveriesFor(ctx context.Context, event preference.Event, userIDs []int32) map[int32]*preference.Delivery {
+	return m.Called(ctx, event, userIDs).Get(0).(map[int32]*preference.Delivery)
+}
+
+type MockNotifier struct {
+	mock.Mock
+}
+
+func (m *MockNotifier) NotifyAlerts(ctx context.Context, alerts []*Alert) error {
+	args := m.Called(ctx, alerts)
+	return args.Error(0)
+}
+
+// --- Tests ---
+
+func newTestService(repo Repository, consent ConsentChecker, prefs PreferenceGateway, notifier Notifier, now time.Time) *service {
+	return &service{
+		repo:     repo,
+		consent:  consent,
+		prefs:    prefs,
+		notifier: notifier,
+		now:      func() time.Time { return now },
+	}
+}
+
+const variantID = "6f1c2a8e-3b0d-4c55-9a7e-2d1f0b8c4e11"
+
+func TestService_AddItem(t *testing.T) {
+	ctx := utils.SetUserContext(context.Background(), 7, "test@example.com", "USER")
+
+	t.Run("Success", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := newTestService(mockRepo, nil, nil, nil, time.Now())
+
+		mockRepo.On("Add", ctx, int32(7), variantID).Return(&Item{ID: 1, VariantID: variantID}, nil)
+
+		it, err := svc.AddItem(ctx, variantID)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), it.ID)
+		mockRepo.AssertExpectations(t)
+	})
+
+	t.Run("Unauthenticated", func(t *testing.T) {
+		svc := newTestService(new(MockRepository), nil, nil, nil, time.Now())
+
+		_, err := svc.AddItem(context.Background(), variantID)
+		assert.ErrorIs(t, err, ErrUnauthenticated)
+	})
+
+	t.Run("InvalidVariantID", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := newTestService(mockRepo, nil, nil, nil, time.Now())
+
+		_, err := svc.AddItem(ctx, "not-a-uuid")
+		assert.ErrorIs(t, err, ErrInvalidVariantID)
+		mockRepo.AssertNotCalled(t, "Add", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestService_GetMyAlerts(t *testing.T) {
+	ctx := utils.SetUserContext(context.Background(), 7, "test@example.com", "USER")
+	mockRepo := new(MockRepository)
+	svc := newTestService(mockRepo, nil, nil, nil, time.Now())
+
+	mockRepo.On("ListAlerts", ctx, int32(7), true, maxLimit, maxLimit).Return([]*Alert{{ID: 3}}, nil)
+	mockRepo.On("CountAlerts", ctx, int32(7), true).Return(int64(101), nil)
+
+	alerts, total, err := svc.GetMyAlerts(ctx, true, 500, 2)
+	assert.NoError(t, err)
+	assert.Len(t, alerts, 1)
+	assert.Equal(t, int64(101), total)
+	mockRepo.AssertExpectations(t)
+}
+
+func TestService_CheckAlerts(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	settled := now.Add(-changelog.SettleDelay)
+
+	emailAndPush := func() *preference.Delivery {
+		return &preference.Delivery{Locale: "id", Channels: []preference.Channel{preference.ChannelEmail, preference.ChannelPush}}
+	}
+
+	t.Run("DetectsAndNotifiesWithEmailConsent", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockConsent := new(MockConsent)
+		prefs := new(MockPreferences)
+		notifier := new(MockNotifier)
+		svc := newTestService(mockRepo, mockConsent, prefs, notifier, now)
+
+		pending := []*Alert{
+			{ID: 4, UserID: 1, Kind: AlertKindPriceDrop},
+			{ID: 5, UserID: 2, Kind: AlertKindBackInStock},
+			{ID: 6, UserID: 1, Kind: AlertKindBackInStock},
+			{ID: 7, UserID: 3, Kind: AlertKindPriceDrop},
+		}
+		mockRepo.On("DetectAlerts", ctx, settled).Return(int64(4), nil)
+		mockRepo.On("ListUnnotified", ctx, int32(notifyBatchSize)).Return(pending, nil)
+		mockConsent.On("FilterSendable", ctx, consent.ChannelEmail, []int32{1, 2, 3}).Return([]int32{2, 3}, nil)
+		prefs.On("DeliveriesFor", ctx, preference.EventProductAlerts, []int32{1, 2, 3}).Return(map[int32]*preference.Delivery{
+			1: emailAndPush(),
+			2: emailAndPush(),
+			3: {Locale: "en"}, // muted
+		})
+		notifier.On("NotifyAlerts", ctx, pending[:3]).Return(nil)
+		mockRepo.On("MarkNotified", ctx, []int64{4, 5, 6, 7}, now).Return(nil)
+
+		n, err := svc.CheckAlerts(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(4), n)
+		assert.Equal(t, []preference.Channel{preference.ChannelPush}, pending[0].Delivery.Channels)
+		assert.Equal(t, []preference.Channel{preference.ChannelEmail, preference.ChannelPush}, pending[1].Delivery.Channels)
+		assert.Equal(t, []preference.Channel{preference.ChannelPush}, pending[2].Delivery.Channels)
+		mockRepo.AssertExpectations(t)
+		mockConsent.AssertExpectations(t)
+		notifier.AssertExpectations(t)
+	})
+
+	t.Run("NotifyFailureLeavesAlertsPending", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		mockConsent := new(MockConsent)
+		prefs := new(MockPreferences)
+		notifier := new(MockNotifier)
+		svc := newTestService(mockRepo, mockConsent, prefs, notifier, now)
+
+		mockRepo.On("DetectAlerts", ctx, settled).Return(int64(0), nil)
+		mockRepo.On("ListUnnotified", ctx, int32(notifyBatchSize)).Return([]*Alert{{ID: 1, UserID: 1}}, nil)
+		mockConsent.On("FilterSendable", ctx, consent.ChannelEmail, []int32{1}).Return([]int32{}, nil)
+		prefs.On("DeliveriesFor", ctx, preference.EventProductAlerts, []int32{1}).
+			Return(map[int32]*preference.Delivery{1: emailAndPush()})
+		notifier.On("NotifyAlerts", ctx, mock.Anything).Return(errors.New("push down"))
+
+		_, err := svc.CheckAlerts(ctx)
+		assert.Error(t, err)
+		mockRepo.AssertNotCalled(t, "MarkNotified", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("DetectError", func(t *testing.T) {
+		mockRepo := new(MockRepository)
+		svc := newTestService(mockRepo, nil, nil, nil, now)
+
+		mockRepo.On("DetectAlerts", ctx, settled).Return(int64(0), ErrDB)
+
+		_, err := svc.CheckAlerts(ctx)
+		assert.ErrorIs(t, err, ErrDB)
+		mockRepo.AssertNotCalled(t, "ListUnnotified", mock.Anything, mock.Anything)
+	})
+}
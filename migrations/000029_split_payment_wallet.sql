@@ -0,0 +1,55 @@
+-- +migrate Up
+
+CREATE TABLE wallets (
+    id BIGSERIAL PRIMARY KEY,
+    user_id INT NOT NULL UNIQUE REFERENCES users(id) ON DELETE CASCADE,
+    balance BIGINT NOT NULL DEFAULT 0 CHECK (balance >= 0),
+    currency VARCHAR(3) NOT NULL DEFAULT 'IDR',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER trg_wallets_updated_at
+BEFORE UPDATE ON wallets
+FOR EACH ROW
+EXECUTE FUNCTION update_timestamp();
+
+CREATE TABLE wallet_ledger (
+    id BIGSERIAL PRIMARY KEY,
+    wallet_id BIGINT NOT NULL REFERENCES wallets(id) ON DELETE CASCADE,
+    entry_type VARCHAR(10) NOT NULL CHECK (entry_type IN ('DEBIT', 'CREDIT')),
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    balance_after BIGINT NOT NULL,
+    reference_type VARCHAR(30) NOT NULL,
+    reference_id VARCHAR(150) NOT NULL,
+    note TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- One ledger movement per business reference
+CREATE UNIQUE INDEX ux_wallet_ledger_reference
+ON wallet_ledger (reference_type, reference_id, entry_type);
+
+CREATE INDEX idx_wallet_ledger_wallet_id
+ON wallet_ledger (wallet_id, created_at DESC);
+
+-- Portion of the total paid from the wallet; the rest goes to the gateway
+ALTER TABLE checkout_sessions
+ADD COLUMN wallet_amount BIGINT NOT NULL DEFAULT 0 CHECK (wallet_amount >= 0);
+
+ALTER TABLE orders
+ADD COLUMN wallet_amount BIGINT NOT NULL DEFAULT 0 CHECK (wallet_amount >= 0);
+
+CREATE INDEX IF NOT EXISTS idx_payments_order_id
+ON payments (order_id);
+
+-- +migrate Down
+
+DROP INDEX IF EXISTS idx_payments_order_id;
+
+ALTER TABLE orders DROP COLUMN IF EXISTS wallet_amount;
+ALTER TABLE checkout_sessions DROP COLUMN IF EXISTS wallet_amount;
+
+DROP TABLE IF EXISTS wallet_ledger;
+DROP TRIGGER IF EXISTS trg_wallets_updated_at ON wallets;
+DROP TABLE IF EXISTS wallets;
@@ -0,0 +1,45 @@
+-- +migrate Up
+
+CREATE TABLE refunds (
+    id BIGSERIAL PRIMARY KEY,
+    order_id INT NOT NULL REFERENCES orders(id),
+    user_id INT REFERENCES users(id),
+
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    currency VARCHAR(3) NOT NULL DEFAULT 'IDR',
+
+    -- WALLET: credited instantly, GATEWAY: returned by the provider asynchronously
+    method VARCHAR(20) NOT NULL CHECK (method IN ('WALLET', 'GATEWAY')),
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING'
+        CHECK (status IN ('PENDING', 'PROCESSING', 'COMPLETED', 'FAILED')),
+
+    reason TEXT,
+
+    -- Accounting links
+    wallet_ledger_id BIGINT REFERENCES wallet_ledger(id),
+    payment_reference VARCHAR(150),
+    provider_refund_id VARCHAR(150),
+    failure_reason TEXT,
+
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMPTZ
+);
+
+-- One refund per funding source of an order
+CREATE UNIQUE INDEX ux_refunds_order_method
+ON refunds (order_id, method);
+
+CREATE INDEX idx_refunds_pending_gateway
+ON refunds (created_at)
+WHERE method = 'GATEWAY' AND status = 'PENDING';
+
+CREATE TRIGGER trg_refunds_updated_at
+BEFORE UPDATE ON refunds
+FOR EACH ROW
+EXECUTE FUNCTION update_timestamp();
+
+-- +migrate Down
+
+DROP TRIGGER IF EXISTS trg_refunds_updated_at ON refunds;
+DROP TABLE IF EXISTS refunds;
@@ -0,0 +1,68 @@
+-- +migrate Up
+
+CREATE TABLE voucher_campaigns (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(150) NOT NULL,
+    description TEXT,
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (ends_at IS NULL OR ends_at > starts_at)
+);
+
+CREATE TRIGGER trg_voucher_campaigns_updated_at
+BEFORE UPDATE ON voucher_campaigns
+FOR EACH ROW
+EXECUTE FUNCTION update_timestamp();
+
+CREATE TABLE vouchers (
+    id BIGSERIAL PRIMARY KEY,
+    campaign_id BIGINT NOT NULL REFERENCES voucher_campaigns(id) ON DELETE CASCADE,
+    code VARCHAR(50) NOT NULL UNIQUE,
+    discount_type VARCHAR(10) NOT NULL CHECK (discount_type IN ('PERCENT', 'FIXED')),
+    discount_value BIGINT NOT NULL CHECK (discount_value > 0),
+    max_discount BIGINT CHECK (max_discount IS NULL OR max_discount > 0),
+    min_subtotal BIGINT NOT NULL DEFAULT 0 CHECK (min_subtotal >= 0),
+    usage_limit INT CHECK (usage_limit IS NULL OR usage_limit > 0),
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_vouchers_campaign_id
+ON vouchers (campaign_id);
+
+CREATE TRIGGER trg_vouchers_updated_at
+BEFORE UPDATE ON vouchers
+FOR EACH ROW
+EXECUTE FUNCTION update_timestamp();
+
+CREATE TABLE voucher_redemptions (
+    id BIGSERIAL PRIMARY KEY,
+    voucher_id BIGINT NOT NULL REFERENCES vouchers(id) ON DELETE RESTRICT,
+    campaign_id BIGINT NOT NULL REFERENCES voucher_campaigns(id) ON DELETE RESTRICT,
+    order_id INT NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    user_id INT REFERENCES users(id) ON DELETE SET NULL,
+    discount_amount BIGINT NOT NULL CHECK (discount_amount >= 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- One voucher per order
+CREATE UNIQUE INDEX ux_voucher_redemptions_order_id
+ON voucher_redemptions (order_id);
+
+-- Promotions report scans by campaign over a date range
+CREATE INDEX idx_voucher_redemptions_campaign_created
+ON voucher_redemptions (campaign_id, created_at);
+
+CREATE INDEX idx_voucher_redemptions_voucher_id
+ON voucher_redemptions (voucher_id);
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS voucher_redemptions;
+DROP TRIGGER IF EXISTS trg_vouchers_updated_at ON vouchers;
+DROP TABLE IF EXISTS vouchers;
+DROP TRIGGER IF EXISTS trg_voucher_campaigns_updated_at ON voucher_campaigns;
+DROP TABLE IF EXISTS voucher_campaigns;
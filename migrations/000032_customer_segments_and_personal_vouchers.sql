@@ -0,0 +1,34 @@
+-- +migrate Up
+
+-- Materialized segment membership, rebuilt by the scheduled segment refresh
+CREATE TABLE customer_segment_members (
+    segment VARCHAR(30) NOT NULL CHECK (segment IN ('FIRST_PURCHASE', 'DORMANT_90D', 'HIGH_LTV')),
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    evaluated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (segment, user_id)
+);
+
+-- Personal vouchers are bound to one user
+ALTER TABLE vouchers
+ADD COLUMN owner_user_id INT REFERENCES users(id) ON DELETE CASCADE,
+ADD COLUMN expires_at TIMESTAMPTZ;
+
+-- One personal voucher per user per campaign, so re-issuing is idempotent
+CREATE UNIQUE INDEX ux_vouchers_campaign_owner
+ON vouchers (campaign_id, owner_user_id)
+WHERE owner_user_id IS NOT NULL;
+
+ALTER TABLE checkout_sessions
+ADD COLUMN voucher_id BIGINT REFERENCES vouchers(id) ON DELETE SET NULL;
+
+-- +migrate Down
+
+ALTER TABLE checkout_sessions DROP COLUMN IF EXISTS voucher_id;
+
+DROP INDEX IF EXISTS ux_vouchers_campaign_owner;
+
+ALTER TABLE vouchers
+DROP COLUMN IF EXISTS expires_at,
+DROP COLUMN IF EXISTS owner_user_id;
+
+DROP TABLE IF EXISTS customer_segment_members;
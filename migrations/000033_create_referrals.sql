@@ -0,0 +1,36 @@
+-- +migrate Up
+
+CREATE TABLE referral_codes (
+    user_id INT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    code VARCHAR(20) NOT NULL UNIQUE,
+    -- Device the owner used when the code was issued, for same-device checks
+    device_id VARCHAR(128),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TABLE referrals (
+    id BIGSERIAL PRIMARY KEY,
+    referrer_user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    referee_user_id INT NOT NULL UNIQUE REFERENCES users(id) ON DELETE CASCADE,
+    code VARCHAR(20) NOT NULL,
+    signup_device_id VARCHAR(128),
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING'
+        CHECK (status IN ('PENDING', 'REWARDED', 'REJECTED')),
+    rejection_reason VARCHAR(50),
+    first_order_id INT REFERENCES orders(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    rewarded_at TIMESTAMPTZ,
+    CHECK (referrer_user_id <> referee_user_id)
+);
+
+CREATE INDEX idx_referrals_referrer
+ON referrals (referrer_user_id);
+
+CREATE INDEX idx_referrals_pending
+ON referrals (created_at)
+WHERE status = 'PENDING';
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS referrals;
+DROP TABLE IF EXISTS referral_codes;
@@ -0,0 +1,73 @@
+-- +migrate Up
+
+CREATE TABLE loyalty_rules (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    -- Earn points_per_unit for every unit_amount of eligible spend
+    points_per_unit INT NOT NULL CHECK (points_per_unit > 0),
+    unit_amount BIGINT NOT NULL CHECK (unit_amount > 0),
+    min_order_amount BIGINT NOT NULL DEFAULT 0 CHECK (min_order_amount >= 0),
+    starts_at TIMESTAMPTZ,
+    ends_at TIMESTAMPTZ,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER trg_loyalty_rules_updated_at
+BEFORE UPDATE ON loyalty_rules
+FOR EACH ROW
+EXECUTE FUNCTION update_timestamp();
+
+-- Base earn rate: 1 point per Rp100
+INSERT INTO loyalty_rules (name, points_per_unit, unit_amount)
+VALUES ('Base earn', 1, 100);
+
+CREATE TABLE loyalty_accounts (
+    user_id INT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    balance BIGINT NOT NULL DEFAULT 0 CHECK (balance >= 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER trg_loyalty_accounts_updated_at
+BEFORE UPDATE ON loyalty_accounts
+FOR EACH ROW
+EXECUTE FUNCTION update_timestamp();
+
+CREATE TABLE loyalty_ledger (
+    id BIGSERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    entry_type VARCHAR(10) NOT NULL CHECK (entry_type IN ('EARN', 'BURN', 'EXPIRE')),
+    points BIGINT NOT NULL CHECK (points > 0),
+    -- Unspent points of an EARN lot; burns and expiry consume lots FIFO
+    remaining BIGINT NOT NULL DEFAULT 0 CHECK (remaining >= 0),
+    balance_after BIGINT NOT NULL,
+    reference_type VARCHAR(30) NOT NULL,
+    reference_id VARCHAR(150) NOT NULL,
+    expires_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX ux_loyalty_ledger_reference
+ON loyalty_ledger (reference_type, reference_id, entry_type);
+
+CREATE INDEX idx_loyalty_ledger_user
+ON loyalty_ledger (user_id, created_at DESC);
+
+CREATE INDEX idx_loyalty_ledger_open_lots
+ON loyalty_ledger (expires_at)
+WHERE entry_type = 'EARN' AND remaining > 0;
+
+ALTER TABLE checkout_sessions
+ADD COLUMN points_redeemed BIGINT NOT NULL DEFAULT 0 CHECK (points_redeemed >= 0);
+
+-- +migrate Down
+
+ALTER TABLE checkout_sessions DROP COLUMN IF EXISTS points_redeemed;
+
+DROP TABLE IF EXISTS loyalty_ledger;
+DROP TRIGGER IF EXISTS trg_loyalty_accounts_updated_at ON loyalty_accounts;
+DROP TABLE IF EXISTS loyalty_accounts;
+DROP TRIGGER IF EXISTS trg_loyalty_rules_updated_at ON loyalty_rules;
+DROP TABLE IF EXISTS loyalty_rules;
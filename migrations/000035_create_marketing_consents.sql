@@ -0,0 +1,42 @@
+-- +migrate Up
+
+-- Current marketing opt-in per user and channel. No row means the user
+-- never opted in and must not receive marketing on that channel.
+CREATE TABLE marketing_consents (
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    channel VARCHAR(20) NOT NULL CHECK (channel IN ('EMAIL', 'WHATSAPP')),
+    status VARCHAR(20) NOT NULL CHECK (status IN ('SUBSCRIBED', 'UNSUBSCRIBED')),
+    source VARCHAR(30) NOT NULL,
+    subscribed_at TIMESTAMPTZ,
+    unsubscribed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, channel)
+);
+
+CREATE TRIGGER trg_marketing_consents_updated_at
+BEFORE UPDATE ON marketing_consents
+FOR EACH ROW
+EXECUTE FUNCTION update_timestamp();
+
+-- Append-only history of every consent change, kept as evidence of when
+-- and how consent was given or withdrawn.
+CREATE TABLE marketing_consent_events (
+    id BIGSERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    channel VARCHAR(20) NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    source VARCHAR(30) NOT NULL,
+    ip_address VARCHAR(64),
+    user_agent TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_marketing_consent_events_user
+ON marketing_consent_events (user_id, created_at DESC);
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS marketing_consent_events;
+DROP TRIGGER IF EXISTS trg_marketing_consents_updated_at ON marketing_consents;
+DROP TABLE IF EXISTS marketing_consents;
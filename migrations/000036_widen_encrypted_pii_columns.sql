@@ -0,0 +1,20 @@
+-- +migrate Up
+
+-- Encrypted values (see internal/pii) are far longer than the plaintext
+-- they replace.
+ALTER TABLE addresses
+ALTER COLUMN phone TYPE TEXT,
+ALTER COLUMN receiver_name TYPE TEXT;
+
+ALTER TABLE profiles
+ALTER COLUMN phone TYPE TEXT;
+
+-- +migrate Down
+
+-- Only safe once every value has been decrypted back to plaintext.
+ALTER TABLE profiles
+ALTER COLUMN phone TYPE VARCHAR(50);
+
+ALTER TABLE addresses
+ALTER COLUMN receiver_name TYPE VARCHAR(150),
+ALTER COLUMN phone TYPE VARCHAR(30);
@@ -0,0 +1,23 @@
+-- +migrate Up
+
+-- Set when the retention job strips an old order of its customer link
+ALTER TABLE orders
+ADD COLUMN anonymized_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS idx_orders_created_at_not_anonymized
+ON orders (created_at)
+WHERE anonymized_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_payment_webhooks_received_at
+ON payment_webhooks (received_at);
+
+CREATE INDEX IF NOT EXISTS idx_carts_updated_at
+ON carts (updated_at);
+
+-- +migrate Down
+
+DROP INDEX IF EXISTS idx_carts_updated_at;
+DROP INDEX IF EXISTS idx_payment_webhooks_received_at;
+DROP INDEX IF EXISTS idx_orders_created_at_not_anonymized;
+
+ALTER TABLE orders DROP COLUMN IF EXISTS anonymized_at;
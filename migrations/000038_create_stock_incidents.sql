@@ -0,0 +1,24 @@
+-- +migrate Up
+
+-- Checkouts that passed stock validation but lost the stock to a
+-- concurrent order at order creation time
+CREATE TABLE stock_incidents (
+    id BIGSERIAL PRIMARY KEY,
+    variant_id UUID NOT NULL REFERENCES variants(id) ON DELETE CASCADE,
+    checkout_session_id UUID,
+    requested INT NOT NULL,
+    available INT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_stock_incidents_created_at
+ON stock_incidents (created_at DESC);
+
+CREATE INDEX IF NOT EXISTS idx_payment_webhooks_unprocessed
+ON payment_webhooks (received_at)
+WHERE processed_at IS NULL;
+
+-- +migrate Down
+
+DROP INDEX IF EXISTS idx_payment_webhooks_unprocessed;
+DROP TABLE IF EXISTS stock_incidents;
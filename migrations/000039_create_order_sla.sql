@@ -0,0 +1,67 @@
+-- +migrate Up
+
+-- Every status an order enters, so time-in-status can be measured
+-- whichever code path changed it
+CREATE TABLE order_status_history (
+    id BIGSERIAL PRIMARY KEY,
+    order_id INT NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    from_status order_status,
+    to_status order_status NOT NULL,
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_order_status_history_order
+ON order_status_history (order_id, to_status, changed_at DESC);
+
+CREATE OR REPLACE FUNCTION record_order_status_change()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        INSERT INTO order_status_history (order_id, from_status, to_status)
+        VALUES (NEW.id, NULL, NEW.status);
+    ELSIF NEW.status IS DISTINCT FROM OLD.status THEN
+        INSERT INTO order_status_history (order_id, from_status, to_status)
+        VALUES (NEW.id, OLD.status, NEW.status);
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_record_order_status_change
+AFTER INSERT OR UPDATE OF status ON orders
+FOR EACH ROW
+EXECUTE FUNCTION record_order_status_change();
+
+-- Existing orders start their clock at their last update
+INSERT INTO order_status_history (order_id, from_status, to_status, changed_at)
+SELECT id, NULL, status, updated_at
+FROM orders;
+
+-- One row per order per SLA rule, kept after the order moves on so the
+-- breach stays on record
+CREATE TABLE order_sla_breaches (
+    id BIGSERIAL PRIMARY KEY,
+    order_id INT NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    rule TEXT NOT NULL,
+    entered_at TIMESTAMPTZ NOT NULL,
+    deadline TIMESTAMPTZ NOT NULL,
+    detected_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    resolved_at TIMESTAMPTZ,
+    notified_at TIMESTAMPTZ,
+    UNIQUE (order_id, rule)
+);
+
+CREATE INDEX idx_order_sla_breaches_open
+ON order_sla_breaches (detected_at DESC)
+WHERE resolved_at IS NULL;
+
+CREATE INDEX idx_order_sla_breaches_unnotified
+ON order_sla_breaches (id)
+WHERE notified_at IS NULL;
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS order_sla_breaches;
+DROP TRIGGER IF EXISTS trg_record_order_status_change ON orders;
+DROP FUNCTION IF EXISTS record_order_status_change;
+DROP TABLE IF EXISTS order_status_history;
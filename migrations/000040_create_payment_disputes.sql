@@ -0,0 +1,62 @@
+-- +migrate Up
+
+-- Dispute sub-state layered over the fulfilment status; NULL when the
+-- order was never disputed
+ALTER TABLE orders
+ADD COLUMN dispute_status VARCHAR(10)
+    CHECK (dispute_status IN ('DISPUTED', 'WON', 'LOST'));
+
+CREATE TABLE payment_disputes (
+    id BIGSERIAL PRIMARY KEY,
+    payment_id INT NOT NULL REFERENCES payments(id),
+    order_id INT NOT NULL REFERENCES orders(id),
+    provider VARCHAR(50) NOT NULL,
+    provider_dispute_id VARCHAR(150) NOT NULL,
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    currency VARCHAR(3) NOT NULL DEFAULT 'IDR',
+    reason TEXT,
+
+    status VARCHAR(10) NOT NULL DEFAULT 'OPEN'
+        CHECK (status IN ('OPEN', 'WON', 'LOST')),
+    resolution_note TEXT,
+    resolved_by INT REFERENCES users(id),
+    -- Points taken back from the customer when the dispute was lost
+    points_clawed_back BIGINT NOT NULL DEFAULT 0,
+
+    opened_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    resolved_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    UNIQUE (provider, provider_dispute_id)
+);
+
+CREATE INDEX idx_payment_disputes_order
+ON payment_disputes (order_id);
+
+CREATE INDEX idx_payment_disputes_open
+ON payment_disputes (opened_at)
+WHERE status = 'OPEN';
+
+CREATE TRIGGER trg_payment_disputes_updated_at
+BEFORE UPDATE ON payment_disputes
+FOR EACH ROW
+EXECUTE FUNCTION update_timestamp();
+
+ALTER TABLE loyalty_ledger
+DROP CONSTRAINT loyalty_ledger_entry_type_check,
+ADD CONSTRAINT loyalty_ledger_entry_type_check
+    CHECK (entry_type IN ('EARN', 'BURN', 'EXPIRE', 'CLAWBACK'));
+
+-- +migrate Down
+
+DELETE FROM loyalty_ledger WHERE entry_type = 'CLAWBACK';
+
+ALTER TABLE loyalty_ledger
+DROP CONSTRAINT loyalty_ledger_entry_type_check,
+ADD CONSTRAINT loyalty_ledger_entry_type_check
+    CHECK (entry_type IN ('EARN', 'BURN', 'EXPIRE'));
+
+DROP TRIGGER IF EXISTS trg_payment_disputes_updated_at ON payment_disputes;
+DROP TABLE IF EXISTS payment_disputes;
+ALTER TABLE orders DROP COLUMN IF EXISTS dispute_status;
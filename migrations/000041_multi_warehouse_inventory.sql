@@ -0,0 +1,186 @@
+-- +migrate Up
+
+CREATE TABLE warehouses (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    code VARCHAR(30) NOT NULL UNIQUE,
+    name VARCHAR(150) NOT NULL,
+    -- Province served first; compared with addresses.province at checkout
+    region VARCHAR(100) NOT NULL,
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Exactly one warehouse takes stock written straight to variants.stock
+CREATE UNIQUE INDEX ux_warehouses_default
+ON warehouses (is_default)
+WHERE is_default;
+
+CREATE TRIGGER trg_warehouses_updated_at
+BEFORE UPDATE ON warehouses
+FOR EACH ROW
+EXECUTE FUNCTION update_timestamp();
+
+INSERT INTO warehouses (code, name, region, is_default)
+VALUES ('MAIN', 'Main warehouse', 'DKI Jakarta', TRUE);
+
+CREATE TABLE variant_stocks (
+    warehouse_id UUID NOT NULL REFERENCES warehouses(id) ON DELETE CASCADE,
+    variant_id UUID NOT NULL REFERENCES variants(id) ON DELETE CASCADE,
+    quantity INT NOT NULL DEFAULT 0 CHECK (quantity >= 0),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (warehouse_id, variant_id)
+);
+
+CREATE INDEX idx_variant_stocks_variant
+ON variant_stocks (variant_id);
+
+-- Existing stock starts in the default warehouse
+INSERT INTO variant_stocks (warehouse_id, variant_id, quantity)
+SELECT w.id, v.id, GREATEST(v.stock, 0)
+FROM variants v
+CROSS JOIN warehouses w
+WHERE w.is_default;
+
+-- variants.stock is kept as the sellable total across active warehouses
+-- so listing and cart reads need no change
+CREATE OR REPLACE FUNCTION sync_variant_stock_total()
+RETURNS TRIGGER AS $$
+DECLARE
+    vid UUID;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        vid := OLD.variant_id;
+    ELSE
+        vid := NEW.variant_id;
+    END IF;
+
+    UPDATE variants
+    SET stock = (
+        SELECT COALESCE(SUM(s.quantity), 0)
+        FROM variant_stocks s
+        JOIN warehouses w ON w.id = s.warehouse_id
+        WHERE s.variant_id = vid
+          AND w.is_active
+    )
+    WHERE id = vid;
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_variant_stocks_sync_total
+AFTER INSERT OR UPDATE OR DELETE ON variant_stocks
+FOR EACH ROW
+EXECUTE FUNCTION sync_variant_stock_total();
+
+CREATE OR REPLACE FUNCTION sync_warehouse_stock_totals()
+RETURNS TRIGGER AS $$
+BEGIN
+    UPDATE variants v
+    SET stock = (
+        SELECT COALESCE(SUM(s.quantity), 0)
+        FROM variant_stocks s
+        JOIN warehouses w ON w.id = s.warehouse_id
+        WHERE s.variant_id = v.id
+          AND w.is_active
+    )
+    WHERE v.id IN (
+        SELECT variant_id FROM variant_stocks WHERE warehouse_id = NEW.id
+    );
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_warehouses_sync_totals
+AFTER UPDATE OF is_active ON warehouses
+FOR EACH ROW
+WHEN (OLD.is_active IS DISTINCT FROM NEW.is_active)
+EXECUTE FUNCTION sync_warehouse_stock_totals();
+
+-- A direct write to variants.stock (product admin) sets the default
+-- warehouse so the total matches; other warehouses are left alone, so
+-- the total cannot drop below what they hold. Writes coming from the
+-- sync triggers above are skipped.
+CREATE OR REPLACE FUNCTION route_variant_stock_to_default()
+RETURNS TRIGGER AS $$
+DECLARE
+    default_id UUID;
+    elsewhere INT;
+BEGIN
+    IF pg_trigger_depth() > 1 THEN
+        RETURN NULL;
+    END IF;
+    IF TG_OP = 'UPDATE' AND NEW.stock IS NOT DISTINCT FROM OLD.stock THEN
+        RETURN NULL;
+    END IF;
+
+    SELECT id INTO default_id FROM warehouses WHERE is_default;
+    IF default_id IS NULL THEN
+        RETURN NULL;
+    END IF;
+
+    SELECT COALESCE(SUM(s.quantity), 0) INTO elsewhere
+    FROM variant_stocks s
+    JOIN warehouses w ON w.id = s.warehouse_id
+    WHERE s.variant_id = NEW.id
+      AND s.warehouse_id <> default_id
+      AND w.is_active;
+
+    INSERT INTO variant_stocks (warehouse_id, variant_id, quantity)
+    VALUES (default_id, NEW.id, GREATEST(NEW.stock - elsewhere, 0))
+    ON CONFLICT (warehouse_id, variant_id)
+    DO UPDATE SET quantity = EXCLUDED.quantity, updated_at = NOW();
+
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_variants_route_stock
+AFTER INSERT OR UPDATE OF stock ON variants
+FOR EACH ROW
+EXECUTE FUNCTION route_variant_stock_to_default();
+
+-- Stock leaves the source on creation and reaches the destination on
+-- receipt; while IN_TRANSIT it is not sellable anywhere
+CREATE TABLE stock_transfers (
+    id BIGSERIAL PRIMARY KEY,
+    variant_id UUID NOT NULL REFERENCES variants(id),
+    from_warehouse_id UUID NOT NULL REFERENCES warehouses(id),
+    to_warehouse_id UUID NOT NULL REFERENCES warehouses(id),
+    quantity INT NOT NULL CHECK (quantity > 0),
+    status VARCHAR(15) NOT NULL DEFAULT 'IN_TRANSIT'
+        CHECK (status IN ('IN_TRANSIT', 'RECEIVED', 'CANCELLED')),
+    note TEXT,
+    created_by INT REFERENCES users(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    received_at TIMESTAMPTZ,
+    cancelled_at TIMESTAMPTZ,
+    CHECK (from_warehouse_id <> to_warehouse_id)
+);
+
+CREATE INDEX idx_stock_transfers_in_transit
+ON stock_transfers (to_warehouse_id, variant_id)
+WHERE status = 'IN_TRANSIT';
+
+-- Warehouse each order line was allocated from
+ALTER TABLE order_items
+ADD COLUMN warehouse_id UUID REFERENCES warehouses(id);
+
+-- +migrate Down
+
+ALTER TABLE order_items DROP COLUMN IF EXISTS warehouse_id;
+DROP TABLE IF EXISTS stock_transfers;
+
+DROP TRIGGER IF EXISTS trg_variants_route_stock ON variants;
+DROP FUNCTION IF EXISTS route_variant_stock_to_default;
+DROP TRIGGER IF EXISTS trg_warehouses_sync_totals ON warehouses;
+DROP FUNCTION IF EXISTS sync_warehouse_stock_totals;
+DROP TRIGGER IF EXISTS trg_variant_stocks_sync_total ON variant_stocks;
+DROP FUNCTION IF EXISTS sync_variant_stock_total;
+
+DROP TABLE IF EXISTS variant_stocks;
+DROP TRIGGER IF EXISTS trg_warehouses_updated_at ON warehouses;
+DROP TABLE IF EXISTS warehouses;
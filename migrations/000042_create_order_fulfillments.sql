@@ -0,0 +1,28 @@
+-- +migrate Up
+
+-- One row per order that entered the pick/pack queue. An order must be
+-- packed before it can move to SHIPPED.
+CREATE TABLE order_fulfillments (
+    order_id INT PRIMARY KEY REFERENCES orders(id) ON DELETE CASCADE,
+    picker_id INT REFERENCES users(id),
+    assigned_by INT REFERENCES users(id),
+    assigned_at TIMESTAMPTZ,
+    packed_by INT REFERENCES users(id),
+    packed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_order_fulfillments_picker
+ON order_fulfillments (picker_id)
+WHERE packed_at IS NULL;
+
+CREATE TRIGGER trg_order_fulfillments_updated_at
+BEFORE UPDATE ON order_fulfillments
+FOR EACH ROW
+EXECUTE FUNCTION update_timestamp();
+
+-- +migrate Down
+
+DROP TRIGGER IF EXISTS trg_order_fulfillments_updated_at ON order_fulfillments;
+DROP TABLE IF EXISTS order_fulfillments;
@@ -0,0 +1,23 @@
+-- +migrate Up
+
+-- Packed weight and box size per variant; zero means not measured yet
+ALTER TABLE variants
+ADD COLUMN weight_grams INT NOT NULL DEFAULT 0 CHECK (weight_grams >= 0),
+ADD COLUMN length_cm INT NOT NULL DEFAULT 0 CHECK (length_cm >= 0),
+ADD COLUMN width_cm INT NOT NULL DEFAULT 0 CHECK (width_cm >= 0),
+ADD COLUMN height_cm INT NOT NULL DEFAULT 0 CHECK (height_cm >= 0);
+
+-- Greater of actual and volumetric weight of everything in the session,
+-- worked out when the session is created
+ALTER TABLE checkout_sessions
+ADD COLUMN chargeable_weight_grams INT NOT NULL DEFAULT 0;
+
+-- +migrate Down
+
+ALTER TABLE checkout_sessions DROP COLUMN IF EXISTS chargeable_weight_grams;
+
+ALTER TABLE variants
+DROP COLUMN IF EXISTS height_cm,
+DROP COLUMN IF EXISTS width_cm,
+DROP COLUMN IF EXISTS length_cm,
+DROP COLUMN IF EXISTS weight_grams;
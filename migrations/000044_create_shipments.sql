@@ -0,0 +1,70 @@
+-- +migrate Up
+
+-- Courier consignment of a shipped order, keyed by the air waybill (AWB)
+CREATE TABLE shipments (
+    id BIGSERIAL PRIMARY KEY,
+    order_id INT NOT NULL UNIQUE REFERENCES orders(id) ON DELETE CASCADE,
+    courier VARCHAR(30) NOT NULL,
+    awb VARCHAR(60) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'CREATED'
+        CHECK (status IN (
+            'CREATED', 'PICKED_UP', 'IN_TRANSIT', 'OUT_FOR_DELIVERY',
+            'DELIVERED', 'FAILED', 'RETURNED'
+        )),
+    created_by INT REFERENCES users(id),
+    shipped_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_event_at TIMESTAMPTZ,
+    delivered_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (courier, awb)
+);
+
+CREATE TRIGGER trg_shipments_updated_at
+BEFORE UPDATE ON shipments
+FOR EACH ROW
+EXECUTE FUNCTION update_timestamp();
+
+CREATE TABLE shipment_tracking_events (
+    id BIGSERIAL PRIMARY KEY,
+    shipment_id BIGINT NOT NULL REFERENCES shipments(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL,
+    description TEXT,
+    location VARCHAR(150),
+    occurred_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_shipment_tracking_events_shipment
+ON shipment_tracking_events (shipment_id, occurred_at);
+
+-- Raw courier callbacks. Failed ones are retried with backoff until
+-- max attempts, then parked in the dead-letter state (dead_at set).
+CREATE TABLE courier_webhooks (
+    id BIGSERIAL PRIMARY KEY,
+    courier VARCHAR(30) NOT NULL,
+    event_id VARCHAR(200) NOT NULL,
+    awb VARCHAR(60),
+    status VARCHAR(20),
+    signature_valid BOOLEAN NOT NULL DEFAULT FALSE,
+    payload JSONB NOT NULL,
+    received_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    processed_at TIMESTAMPTZ,
+    process_error TEXT,
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at TIMESTAMPTZ,
+    dead_at TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX ux_courier_webhooks_event
+ON courier_webhooks (courier, event_id);
+
+CREATE INDEX idx_courier_webhooks_retry
+ON courier_webhooks (next_attempt_at)
+WHERE processed_at IS NULL AND dead_at IS NULL;
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS courier_webhooks;
+DROP TABLE IF EXISTS shipment_tracking_events;
+DROP TRIGGER IF EXISTS trg_shipments_updated_at ON shipments;
+DROP TABLE IF EXISTS shipments;
@@ -0,0 +1,9 @@
+-- +migrate Up
+
+-- Daily courier manifest looks up orders by when they entered SHIPPED
+CREATE INDEX idx_order_status_history_status_time
+ON order_status_history (to_status, changed_at);
+
+-- +migrate Down
+
+DROP INDEX IF EXISTS idx_order_status_history_status_time;
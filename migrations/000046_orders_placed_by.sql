@@ -0,0 +1,9 @@
+-- +migrate Up
+
+-- Admin who created the order for the customer (manual/phone orders)
+ALTER TABLE orders
+ADD COLUMN placed_by INT REFERENCES users(id);
+
+-- +migrate Down
+
+ALTER TABLE orders DROP COLUMN IF EXISTS placed_by;
@@ -0,0 +1,29 @@
+-- +migrate Up
+
+-- A user's newer checkout session supersedes their older open ones
+ALTER TABLE checkout_sessions
+  DROP CONSTRAINT IF EXISTS chk_checkout_sessions_status;
+
+ALTER TABLE checkout_sessions
+  ADD CONSTRAINT chk_checkout_sessions_status
+  CHECK (status IN ('PENDING', 'PAID', 'EXPIRED', 'CANCELLED', 'SUPERSEDED'))
+  NOT VALID;
+
+-- Latest open session per user (myActiveCheckoutSession)
+CREATE INDEX idx_checkout_sessions_user_open
+  ON checkout_sessions (user_id, created_at DESC)
+  WHERE status = 'PENDING' AND confirmed_at IS NULL;
+
+-- +migrate Down
+
+DROP INDEX IF EXISTS idx_checkout_sessions_user_open;
+
+UPDATE checkout_sessions SET status = 'CANCELLED' WHERE status = 'SUPERSEDED';
+
+ALTER TABLE checkout_sessions
+  DROP CONSTRAINT IF EXISTS chk_checkout_sessions_status;
+
+ALTER TABLE checkout_sessions
+  ADD CONSTRAINT chk_checkout_sessions_status
+  CHECK (status IN ('PENDING', 'PAID', 'EXPIRED', 'CANCELLED'))
+  NOT VALID;
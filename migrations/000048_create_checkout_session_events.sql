@@ -0,0 +1,25 @@
+-- +migrate Up
+
+-- Each change made to a checkout session after creation, so support can
+-- see why a total moved before the order was placed
+CREATE TABLE checkout_session_events (
+    id BIGSERIAL PRIMARY KEY,
+    session_id UUID NOT NULL REFERENCES checkout_sessions(id) ON DELETE CASCADE,
+    event_type VARCHAR(30) NOT NULL,
+    -- Who made the change: a user, or the guest owning the session
+    actor_user_id INT REFERENCES users(id) ON DELETE SET NULL,
+    actor_guest_id UUID,
+    actor_role VARCHAR(20),
+    from_value TEXT,
+    to_value TEXT,
+    total_before INT NOT NULL,
+    total_after INT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_checkout_session_events_session
+ON checkout_session_events (session_id, created_at);
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS checkout_session_events;
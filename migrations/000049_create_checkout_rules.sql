@@ -0,0 +1,32 @@
+-- +migrate Up
+
+-- Order minimum and free-shipping threshold applied at checkout. The row
+-- with no region is the default; a region row (matched to
+-- addresses.province) overrides it.
+CREATE TABLE checkout_rules (
+    id SERIAL PRIMARY KEY,
+    region VARCHAR(100),
+    min_order_amount INT NOT NULL DEFAULT 0 CHECK (min_order_amount >= 0),
+    -- Subtotal from which shipping is free; NULL means never
+    free_shipping_min INT CHECK (free_shipping_min >= 0),
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    updated_by INT REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX ux_checkout_rules_region
+ON checkout_rules (LOWER(COALESCE(region, '')));
+
+CREATE TRIGGER trg_checkout_rules_updated_at
+BEFORE UPDATE ON checkout_rules
+FOR EACH ROW
+EXECUTE FUNCTION update_timestamp();
+
+INSERT INTO checkout_rules (region, min_order_amount, free_shipping_min)
+VALUES (NULL, 0, NULL);
+
+-- +migrate Down
+
+DROP TRIGGER IF EXISTS trg_checkout_rules_updated_at ON checkout_rules;
+DROP TABLE IF EXISTS checkout_rules;
@@ -0,0 +1,26 @@
+-- +migrate Up
+
+-- Each composite supersedes the single-column index it starts with.
+
+-- Customer order history: WHERE user_id = $1 ORDER BY created_at DESC
+CREATE INDEX IF NOT EXISTS idx_orders_user_id_created_at
+ON orders (user_id, created_at DESC);
+
+DROP INDEX IF EXISTS idx_orders_user_id;
+
+-- Batched item hydration: WHERE order_id = ANY($1) ORDER BY order_id, id.
+CREATE INDEX IF NOT EXISTS idx_order_items_order_id_id
+ON order_items (order_id, id);
+
+DROP INDEX IF EXISTS idx_order_items_order_id;
+
+-- +migrate Down
+
+CREATE INDEX IF NOT EXISTS idx_orders_user_id
+ON orders (user_id);
+
+CREATE INDEX IF NOT EXISTS idx_order_items_order_id
+ON order_items (order_id);
+
+DROP INDEX IF EXISTS idx_order_items_order_id_id;
+DROP INDEX IF EXISTS idx_orders_user_id_created_at;
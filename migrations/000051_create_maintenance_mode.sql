@@ -0,0 +1,25 @@
+-- +migrate Up
+
+-- Single-row maintenance switch. While enabled the API rejects mutations
+-- (and reads unless allow_reads) for everyone but admins, and payment
+-- webhooks are stored without being applied.
+CREATE TABLE maintenance_mode (
+    id BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    allow_reads BOOLEAN NOT NULL DEFAULT TRUE,
+    message TEXT,
+    updated_by INT REFERENCES users(id) ON DELETE SET NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER trg_maintenance_mode_updated_at
+BEFORE UPDATE ON maintenance_mode
+FOR EACH ROW
+EXECUTE FUNCTION update_timestamp();
+
+INSERT INTO maintenance_mode (id) VALUES (TRUE);
+
+-- +migrate Down
+
+DROP TRIGGER IF EXISTS trg_maintenance_mode_updated_at ON maintenance_mode;
+DROP TABLE IF EXISTS maintenance_mode;
@@ -0,0 +1,25 @@
+-- +migrate Up
+
+-- Single-row runtime logger override shared by every instance. An empty
+-- level means the environment default; the override lapses at expires_at.
+CREATE TABLE log_settings (
+    id BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    level TEXT NOT NULL DEFAULT '' CHECK (level IN ('', 'debug', 'info', 'warn', 'error')),
+    debug_modules TEXT[] NOT NULL DEFAULT '{}',
+    sampling BOOLEAN,
+    expires_at TIMESTAMPTZ,
+    updated_by INT REFERENCES users(id) ON DELETE SET NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE TRIGGER trg_log_settings_updated_at
+BEFORE UPDATE ON log_settings
+FOR EACH ROW
+EXECUTE FUNCTION update_timestamp();
+
+INSERT INTO log_settings (id) VALUES (TRUE);
+
+-- +migrate Down
+
+DROP TRIGGER IF EXISTS trg_log_settings_updated_at ON log_settings;
+DROP TABLE IF EXISTS log_settings;
@@ -0,0 +1,35 @@
+-- +migrate Up
+
+-- Daily GraphQL root-field counts per user. Fields with a @quota are
+-- counted as they run and capped; the rest are flushed in batches.
+CREATE TABLE operation_usage (
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    operation VARCHAR(100) NOT NULL,
+    count INT NOT NULL DEFAULT 0,
+    PRIMARY KEY (user_id, day, operation)
+);
+
+CREATE INDEX idx_operation_usage_day ON operation_usage (day);
+
+-- At most one flag per user, day and operation, for admins to review.
+CREATE TABLE usage_flags (
+    id BIGSERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    day DATE NOT NULL,
+    operation VARCHAR(100) NOT NULL,
+    reason VARCHAR(20) NOT NULL
+        CHECK (reason IN ('DAILY_VOLUME', 'SPIKE', 'QUOTA_EXCEEDED')),
+    count INT NOT NULL,
+    -- Average daily count over the previous week
+    baseline NUMERIC(12, 2) NOT NULL DEFAULT 0,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (user_id, day, operation)
+);
+
+CREATE INDEX idx_usage_flags_created_at ON usage_flags (created_at DESC);
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS usage_flags;
+DROP TABLE IF EXISTS operation_usage;
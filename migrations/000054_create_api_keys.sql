@@ -0,0 +1,21 @@
+-- +migrate Up
+
+-- Keys for internal services calling the API without a user. Only a hash
+-- of the key is stored; the prefix identifies the key without revealing it.
+CREATE TABLE api_keys (
+    id BIGSERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    prefix VARCHAR(16) NOT NULL UNIQUE,
+    key_hash VARCHAR(64) NOT NULL,
+    scopes TEXT[] NOT NULL DEFAULT '{}',
+    created_by INT REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    last_used_at TIMESTAMPTZ,
+    expires_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ,
+    revoked_by INT REFERENCES users(id) ON DELETE SET NULL
+);
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS api_keys;
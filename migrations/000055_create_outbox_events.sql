@@ -0,0 +1,127 @@
+-- +migrate Up
+
+-- Domain events waiting to be published to the broker. Triggers write them
+-- in the same transaction as the change, whichever code path made it, so
+-- no committed change is missed and no rolled-back one is published.
+CREATE TABLE outbox_events (
+    id BIGSERIAL PRIMARY KEY,
+    aggregate_type VARCHAR(30) NOT NULL,
+    aggregate_id TEXT NOT NULL,
+    event_type VARCHAR(60) NOT NULL,
+    payload JSONB NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    published_at TIMESTAMPTZ,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT
+);
+
+CREATE INDEX idx_outbox_events_pending
+ON outbox_events (id)
+WHERE published_at IS NULL;
+
+CREATE INDEX idx_outbox_events_published
+ON outbox_events (published_at)
+WHERE published_at IS NOT NULL;
+
+CREATE OR REPLACE FUNCTION outbox_order_event()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'UPDATE' AND NEW.status IS NOT DISTINCT FROM OLD.status THEN
+        RETURN NEW;
+    END IF;
+
+    INSERT INTO outbox_events (aggregate_type, aggregate_id, event_type, payload)
+    VALUES (
+        'order',
+        NEW.external_id,
+        CASE WHEN TG_OP = 'INSERT' THEN 'order.created' ELSE 'order.status_changed' END,
+        jsonb_build_object(
+            'orderId', NEW.id,
+            'externalId', NEW.external_id,
+            'userId', NEW.user_id,
+            'status', NEW.status,
+            'previousStatus', CASE WHEN TG_OP = 'UPDATE' THEN OLD.status END,
+            'totalAmount', NEW.total_amount,
+            'walletAmount', NEW.wallet_amount,
+            'currency', NEW.currency
+        )
+    );
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_outbox_order_event
+AFTER INSERT OR UPDATE OF status ON orders
+FOR EACH ROW
+EXECUTE FUNCTION outbox_order_event();
+
+CREATE OR REPLACE FUNCTION outbox_payment_event()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'UPDATE' AND NEW.status IS NOT DISTINCT FROM OLD.status THEN
+        RETURN NEW;
+    END IF;
+
+    INSERT INTO outbox_events (aggregate_type, aggregate_id, event_type, payload)
+    VALUES (
+        'payment',
+        NEW.external_reference,
+        CASE WHEN TG_OP = 'INSERT' THEN 'payment.created' ELSE 'payment.status_changed' END,
+        jsonb_build_object(
+            'paymentId', NEW.id,
+            'orderId', NEW.order_id,
+            'externalReference', NEW.external_reference,
+            'provider', NEW.provider,
+            'amount', NEW.amount,
+            'currency', NEW.currency,
+            'status', NEW.status,
+            'previousStatus', CASE WHEN TG_OP = 'UPDATE' THEN OLD.status END,
+            'paidAt', NEW.paid_at
+        )
+    );
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_outbox_payment_event
+AFTER INSERT OR UPDATE OF status ON payments
+FOR EACH ROW
+EXECUTE FUNCTION outbox_payment_event();
+
+CREATE OR REPLACE FUNCTION outbox_stock_event()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'UPDATE' AND NEW.quantity IS NOT DISTINCT FROM OLD.quantity THEN
+        RETURN NEW;
+    END IF;
+
+    INSERT INTO outbox_events (aggregate_type, aggregate_id, event_type, payload)
+    VALUES (
+        'inventory',
+        NEW.variant_id::text,
+        'inventory.stock_changed',
+        jsonb_build_object(
+            'variantId', NEW.variant_id,
+            'warehouseId', NEW.warehouse_id,
+            'quantity', NEW.quantity,
+            'previousQuantity', CASE WHEN TG_OP = 'UPDATE' THEN OLD.quantity ELSE 0 END
+        )
+    );
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_outbox_stock_event
+AFTER INSERT OR UPDATE OF quantity ON variant_stocks
+FOR EACH ROW
+EXECUTE FUNCTION outbox_stock_event();
+
+-- +migrate Down
+
+DROP TRIGGER IF EXISTS trg_outbox_stock_event ON variant_stocks;
+DROP FUNCTION IF EXISTS outbox_stock_event;
+DROP TRIGGER IF EXISTS trg_outbox_payment_event ON payments;
+DROP FUNCTION IF EXISTS outbox_payment_event;
+DROP TRIGGER IF EXISTS trg_outbox_order_event ON orders;
+DROP FUNCTION IF EXISTS outbox_order_event;
+DROP TABLE IF EXISTS outbox_events;
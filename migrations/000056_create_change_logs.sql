@@ -0,0 +1,149 @@
+-- +migrate Up
+
+-- products and variants have updated_at but nothing kept it current
+CREATE TRIGGER trg_products_updated_at
+BEFORE UPDATE ON products
+FOR EACH ROW
+EXECUTE FUNCTION update_timestamp();
+
+CREATE TRIGGER trg_variants_updated_at
+BEFORE UPDATE ON variants
+FOR EACH ROW
+EXECUTE FUNCTION update_timestamp();
+
+-- For sync jobs that pull by updated_at
+CREATE INDEX IF NOT EXISTS idx_orders_updated_at ON orders (updated_at);
+CREATE INDEX IF NOT EXISTS idx_products_updated_at ON products (updated_at);
+CREATE INDEX IF NOT EXISTS idx_variants_updated_at ON variants (updated_at);
+
+-- One row per changed order, product or variant, so a sync job can pull
+-- everything after the last id it saw, deletes included. Updates list the
+-- columns they changed; an update that changes nothing but updated_at is
+-- not logged. No foreign keys, so the rows outlive a deleted entity.
+CREATE TABLE order_changes (
+    id BIGSERIAL PRIMARY KEY,
+    order_id INT NOT NULL,
+    operation VARCHAR(6) NOT NULL,
+    changed_columns TEXT[] NOT NULL DEFAULT '{}',
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- variant_id is NULL for a change to the product row itself
+CREATE TABLE product_changes (
+    id BIGSERIAL PRIMARY KEY,
+    product_id UUID NOT NULL,
+    variant_id UUID,
+    operation VARCHAR(6) NOT NULL,
+    changed_columns TEXT[] NOT NULL DEFAULT '{}',
+    changed_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE OR REPLACE FUNCTION row_changed_columns(old_row JSONB, new_row JSONB)
+RETURNS TEXT[] AS $$
+    SELECT COALESCE(array_agg(n.key ORDER BY n.key), '{}')
+    FROM jsonb_each(new_row) n
+    WHERE n.key <> 'updated_at'
+      AND n.value IS DISTINCT FROM old_row -> n.key
+$$ LANGUAGE sql IMMUTABLE;
+
+CREATE OR REPLACE FUNCTION record_order_change()
+RETURNS TRIGGER AS $$
+DECLARE
+    cols TEXT[] := '{}';
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        INSERT INTO order_changes (order_id, operation) VALUES (OLD.id, TG_OP);
+        RETURN OLD;
+    END IF;
+
+    IF TG_OP = 'UPDATE' THEN
+        cols := row_changed_columns(to_jsonb(OLD), to_jsonb(NEW));
+        IF cardinality(cols) = 0 THEN
+            RETURN NEW;
+        END IF;
+    END IF;
+
+    INSERT INTO order_changes (order_id, operation, changed_columns)
+    VALUES (NEW.id, TG_OP, cols);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_record_order_change
+AFTER INSERT OR UPDATE OR DELETE ON orders
+FOR EACH ROW
+EXECUTE FUNCTION record_order_change();
+
+CREATE OR REPLACE FUNCTION record_product_change()
+RETURNS TRIGGER AS $$
+DECLARE
+    cols TEXT[] := '{}';
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        INSERT INTO product_changes (product_id, operation) VALUES (OLD.id, TG_OP);
+        RETURN OLD;
+    END IF;
+
+    IF TG_OP = 'UPDATE' THEN
+        cols := row_changed_columns(to_jsonb(OLD), to_jsonb(NEW));
+        IF cardinality(cols) = 0 THEN
+            RETURN NEW;
+        END IF;
+    END IF;
+
+    INSERT INTO product_changes (product_id, operation, changed_columns)
+    VALUES (NEW.id, TG_OP, cols);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_record_product_change
+AFTER INSERT OR UPDATE OR DELETE ON products
+FOR EACH ROW
+EXECUTE FUNCTION record_product_change();
+
+CREATE OR REPLACE FUNCTION record_variant_change()
+RETURNS TRIGGER AS $$
+DECLARE
+    cols TEXT[] := '{}';
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        INSERT INTO product_changes (product_id, variant_id, operation)
+        VALUES (OLD.product_id, OLD.id, TG_OP);
+        RETURN OLD;
+    END IF;
+
+    IF TG_OP = 'UPDATE' THEN
+        cols := row_changed_columns(to_jsonb(OLD), to_jsonb(NEW));
+        IF cardinality(cols) = 0 THEN
+            RETURN NEW;
+        END IF;
+    END IF;
+
+    INSERT INTO product_changes (product_id, variant_id, operation, changed_columns)
+    VALUES (NEW.product_id, NEW.id, TG_OP, cols);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_record_variant_change
+AFTER INSERT OR UPDATE OR DELETE ON variants
+FOR EACH ROW
+EXECUTE FUNCTION record_variant_change();
+
+-- +migrate Down
+
+DROP TRIGGER IF EXISTS trg_record_variant_change ON variants;
+DROP FUNCTION IF EXISTS record_variant_change;
+DROP TRIGGER IF EXISTS trg_record_product_change ON products;
+DROP FUNCTION IF EXISTS record_product_change;
+DROP TRIGGER IF EXISTS trg_record_order_change ON orders;
+DROP FUNCTION IF EXISTS record_order_change;
+DROP FUNCTION IF EXISTS row_changed_columns;
+DROP TABLE IF EXISTS product_changes;
+DROP TABLE IF EXISTS order_changes;
+DROP INDEX IF EXISTS idx_variants_updated_at;
+DROP INDEX IF EXISTS idx_products_updated_at;
+DROP INDEX IF EXISTS idx_orders_updated_at;
+DROP TRIGGER IF EXISTS trg_variants_updated_at ON variants;
+DROP TRIGGER IF EXISTS trg_products_updated_at ON products;
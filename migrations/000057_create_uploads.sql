@@ -0,0 +1,24 @@
+-- +migrate Up
+
+-- Files uploaded through GraphQL. The file itself is in storage under
+-- storage_key; the row records what it is for and who sent it.
+CREATE TABLE uploads (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    purpose VARCHAR(30) NOT NULL,
+    storage_key TEXT NOT NULL UNIQUE,
+    content_type VARCHAR(100) NOT NULL,
+    size_bytes BIGINT NOT NULL,
+    original_name TEXT NOT NULL DEFAULT '',
+    uploaded_by INT REFERENCES users(id) ON DELETE SET NULL,
+    order_id INT REFERENCES orders(id) ON DELETE CASCADE,
+    product_id UUID REFERENCES products(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_uploads_order
+ON uploads (order_id, created_at)
+WHERE order_id IS NOT NULL;
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS uploads;
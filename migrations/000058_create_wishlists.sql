@@ -0,0 +1,54 @@
+-- +migrate Up
+
+-- Variants a customer wants to hear about. alert_price is the price the
+-- next drop is measured against: the price when added, lowered to each
+-- price already announced. in_stock is the stock state last seen.
+CREATE TABLE wishlist_items (
+    id BIGSERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    variant_id UUID NOT NULL REFERENCES variants(id) ON DELETE CASCADE,
+    alert_price NUMERIC(12,2) NOT NULL,
+    in_stock BOOLEAN NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (user_id, variant_id)
+);
+
+CREATE INDEX idx_wishlist_items_variant
+ON wishlist_items (variant_id);
+
+-- In-app alerts; notified_at is set once push/email delivery succeeded.
+-- old_price is NULL for BACK_IN_STOCK.
+CREATE TABLE wishlist_alerts (
+    id BIGSERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    variant_id UUID NOT NULL REFERENCES variants(id) ON DELETE CASCADE,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('PRICE_DROP', 'BACK_IN_STOCK')),
+    old_price NUMERIC(12,2),
+    new_price NUMERIC(12,2) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    notified_at TIMESTAMPTZ,
+    read_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_wishlist_alerts_user
+ON wishlist_alerts (user_id, id DESC);
+
+CREATE INDEX idx_wishlist_alerts_unnotified
+ON wishlist_alerts (id)
+WHERE notified_at IS NULL;
+
+-- Single-row cursor: the last product_changes id the alert job has read.
+-- It starts at the current end of the log, so history is not replayed.
+CREATE TABLE wishlist_alert_cursor (
+    id BOOLEAN PRIMARY KEY DEFAULT TRUE CHECK (id),
+    last_change_id BIGINT NOT NULL DEFAULT 0
+);
+
+INSERT INTO wishlist_alert_cursor (id, last_change_id)
+SELECT TRUE, COALESCE(MAX(id), 0) FROM product_changes;
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS wishlist_alert_cursor;
+DROP TABLE IF EXISTS wishlist_alerts;
+DROP TABLE IF EXISTS wishlist_items;
@@ -0,0 +1,61 @@
+-- +migrate Up
+
+-- A seller's public storefront. slug is what storefront URLs use; it
+-- defaults to the seller id prefix plus the slugged name, the same shape
+-- product slugs take, and the seller may change it.
+CREATE TABLE stores (
+    seller_id UUID PRIMARY KEY REFERENCES sellers(id) ON DELETE CASCADE,
+    slug TEXT NOT NULL,
+    name VARCHAR(150) NOT NULL,
+    logo_url TEXT,
+    description TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_stores_slug_unique
+ON stores (slug);
+
+CREATE TRIGGER trg_stores_updated_at
+BEFORE UPDATE ON stores
+FOR EACH ROW
+EXECUTE FUNCTION update_timestamp();
+
+CREATE OR REPLACE FUNCTION default_store_slug(seller_id UUID, name TEXT)
+RETURNS TEXT AS $$
+    SELECT LEFT(
+        split_part(seller_id::text, '-', 1) || '-' ||
+        COALESCE(NULLIF(TRIM(BOTH '-' FROM LOWER(
+            REGEXP_REPLACE(name, '[^a-zA-Z0-9]+', '-', 'g')
+        )), ''), 'store'),
+        150
+    );
+$$ LANGUAGE sql IMMUTABLE;
+
+INSERT INTO stores (seller_id, slug, name)
+SELECT id, default_store_slug(id, name), name
+FROM sellers
+WHERE deleted_at IS NULL;
+
+-- Every new seller gets a store right away.
+CREATE OR REPLACE FUNCTION create_seller_store()
+RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO stores (seller_id, slug, name)
+    VALUES (NEW.id, default_store_slug(NEW.id, NEW.name), NEW.name)
+    ON CONFLICT DO NOTHING;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_sellers_create_store
+AFTER INSERT ON sellers
+FOR EACH ROW
+EXECUTE FUNCTION create_seller_store();
+
+-- +migrate Down
+
+DROP TRIGGER IF EXISTS trg_sellers_create_store ON sellers;
+DROP FUNCTION IF EXISTS create_seller_store();
+DROP TABLE IF EXISTS stores;
+DROP FUNCTION IF EXISTS default_store_slug(UUID, TEXT);
@@ -0,0 +1,34 @@
+-- +migrate Up
+
+-- Weekly opening hours, in Asia/Jakarta time. weekday is ISO: 1 is
+-- Monday, 7 is Sunday. A day without a row is closed.
+CREATE TABLE store_operating_hours (
+    seller_id UUID NOT NULL REFERENCES stores(seller_id) ON DELETE CASCADE,
+    weekday SMALLINT NOT NULL CHECK (weekday BETWEEN 1 AND 7),
+    opens_at TIME NOT NULL,
+    closes_at TIME NOT NULL CHECK (closes_at > opens_at),
+    PRIMARY KEY (seller_id, weekday)
+);
+
+-- Vacation periods. While one is running the seller's products can not be
+-- checked out; HIDDEN also takes them out of the catalogue. Nothing has to
+-- be switched back afterwards: a period simply stops matching once ends_at
+-- has passed.
+CREATE TABLE store_vacations (
+    id BIGSERIAL PRIMARY KEY,
+    seller_id UUID NOT NULL REFERENCES stores(seller_id) ON DELETE CASCADE,
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+    mode VARCHAR(20) NOT NULL CHECK (mode IN ('HIDDEN', 'UNAVAILABLE')),
+    message TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX idx_store_vacations_seller
+ON store_vacations (seller_id, ends_at);
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS store_vacations;
+DROP TABLE IF EXISTS store_operating_hours;
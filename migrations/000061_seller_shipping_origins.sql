@@ -0,0 +1,48 @@
+-- +migrate Up
+
+-- Where a seller ships from. Shipping rates and packing slips use the
+-- product's origin, else the seller's default one; a seller without any
+-- origin ships from the platform origin in Jakarta, as before.
+CREATE TABLE seller_origins (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    seller_id UUID NOT NULL REFERENCES stores(seller_id) ON DELETE CASCADE,
+    label VARCHAR(100) NOT NULL,
+    contact_name VARCHAR(100) NOT NULL,
+    phone VARCHAR(30) NOT NULL,
+    address_line1 TEXT NOT NULL,
+    address_line2 TEXT,
+    city VARCHAR(100) NOT NULL,
+    province VARCHAR(100) NOT NULL,
+    postal_code VARCHAR(20) NOT NULL,
+    is_default BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_seller_origins_seller
+ON seller_origins (seller_id);
+
+CREATE UNIQUE INDEX uniq_default_origin_per_seller
+ON seller_origins (seller_id)
+WHERE is_default;
+
+CREATE TRIGGER trg_seller_origins_updated_at
+BEFORE UPDATE ON seller_origins
+FOR EACH ROW
+EXECUTE FUNCTION update_timestamp();
+
+-- Set for products kept somewhere other than the seller's default origin.
+ALTER TABLE products
+ADD COLUMN origin_id UUID REFERENCES seller_origins(id) ON DELETE SET NULL;
+
+-- A checkout's chargeable weight split by origin; each parcel is charged
+-- on its own. Empty for sessions opened before this, which ship from the
+-- platform origin.
+ALTER TABLE checkout_sessions
+ADD COLUMN shipping_parcels JSONB NOT NULL DEFAULT '[]';
+
+-- +migrate Down
+
+ALTER TABLE checkout_sessions DROP COLUMN IF EXISTS shipping_parcels;
+ALTER TABLE products DROP COLUMN IF EXISTS origin_id;
+DROP TABLE IF EXISTS seller_origins;
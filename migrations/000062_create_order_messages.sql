@@ -0,0 +1,39 @@
+-- +migrate Up
+
+-- A message thread per order between its customer and the staff (admins
+-- and sellers). sender_id is kept only for the audit trail; the side is
+-- what the thread shows.
+CREATE TABLE order_messages (
+    id BIGSERIAL PRIMARY KEY,
+    order_id INT NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    sender_id INT REFERENCES users(id) ON DELETE SET NULL,
+    sender_role VARCHAR(10) NOT NULL CHECK (sender_role IN ('CUSTOMER', 'STAFF')),
+    body TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_order_messages_order
+ON order_messages (order_id, id);
+
+-- Uploads sent with a message. An upload belongs to one message at most.
+CREATE TABLE order_message_attachments (
+    message_id BIGINT NOT NULL REFERENCES order_messages(id) ON DELETE CASCADE,
+    upload_id UUID NOT NULL UNIQUE REFERENCES uploads(id) ON DELETE CASCADE,
+    PRIMARY KEY (message_id, upload_id)
+);
+
+-- How far each side has read. Staff share one marker, like a support
+-- inbox: once anyone on staff has read a message it is read for all.
+CREATE TABLE order_message_reads (
+    order_id INT NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    reader_role VARCHAR(10) NOT NULL CHECK (reader_role IN ('CUSTOMER', 'STAFF')),
+    last_read_id BIGINT NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (order_id, reader_role)
+);
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS order_message_reads;
+DROP TABLE IF EXISTS order_message_attachments;
+DROP TABLE IF EXISTS order_messages;
@@ -0,0 +1,10 @@
+-- +migrate Up
+
+-- What the provider reported it captured, next to what was requested in
+-- amount. NULL for payments settled before this column existed and for
+-- wallet and offline payments, which have no capture.
+ALTER TABLE payments ADD COLUMN captured_amount BIGINT;
+
+-- +migrate Down
+
+ALTER TABLE payments DROP COLUMN IF EXISTS captured_amount;
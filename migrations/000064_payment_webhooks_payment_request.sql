@@ -0,0 +1,10 @@
+-- +migrate Up
+
+-- Refund webhooks carry the refund's reference rather than the order's, so
+-- a payment's history is also looked up by its payment request id.
+CREATE INDEX IF NOT EXISTS idx_payment_webhooks_payment_request_id
+ON payment_webhooks ((payload->'data'->>'payment_request_id'));
+
+-- +migrate Down
+
+DROP INDEX IF EXISTS idx_payment_webhooks_payment_request_id;
@@ -0,0 +1,10 @@
+-- +migrate Up
+
+-- The payment expiry job looks for pending payments past their expiry
+CREATE INDEX IF NOT EXISTS idx_payments_pending_expire_at
+ON payments (expire_at)
+WHERE status = 'PENDING';
+
+-- +migrate Down
+
+DROP INDEX IF EXISTS idx_payments_pending_expire_at;
@@ -0,0 +1,30 @@
+-- +migrate Up
+
+-- Manual stock corrections. Ones over the approval threshold wait as
+-- PENDING until a second admin approves or rejects them. Applied ones are
+-- also booked in stock_movement.
+CREATE TABLE stock_adjustments (
+    id BIGSERIAL PRIMARY KEY,
+    warehouse_id UUID NOT NULL REFERENCES warehouses(id),
+    variant_id UUID NOT NULL REFERENCES variants(id),
+    delta INT NOT NULL CHECK (delta <> 0),
+    reason TEXT NOT NULL,
+    status VARCHAR(10) NOT NULL DEFAULT 'PENDING'
+        CHECK (status IN ('PENDING', 'APPLIED', 'REJECTED')),
+    requested_by INT NOT NULL REFERENCES users(id),
+    reviewed_by INT REFERENCES users(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    reviewed_at TIMESTAMPTZ,
+    CHECK (reviewed_by IS NULL OR reviewed_by <> requested_by)
+);
+
+CREATE INDEX idx_stock_adjustments_pending
+ON stock_adjustments (created_at)
+WHERE status = 'PENDING';
+
+CREATE INDEX idx_stock_adjustments_variant
+ON stock_adjustments (variant_id, created_at);
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS stock_adjustments;
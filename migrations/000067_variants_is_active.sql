@@ -0,0 +1,16 @@
+-- +migrate Up
+
+-- Variants follow their product: taking a product out of 'active'
+-- deactivates all of its variants, and restoring it brings them back.
+ALTER TABLE variants
+ADD COLUMN IF NOT EXISTS is_active BOOLEAN NOT NULL DEFAULT TRUE;
+
+UPDATE variants v
+SET is_active = FALSE
+FROM products p
+WHERE p.id = v.product_id
+  AND p.status <> 'active';
+
+-- +migrate Down
+
+ALTER TABLE variants DROP COLUMN IF EXISTS is_active;
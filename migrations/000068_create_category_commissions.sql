@@ -0,0 +1,38 @@
+-- +migrate Up
+
+-- Marketplace commission per category, versioned by effective_from. A row
+-- with no category is the default for categories without their own. Rows
+-- are never edited: a new rate is a new row, so an order keeps pointing
+-- at the rate it was placed under.
+CREATE TABLE category_commissions (
+    id BIGSERIAL PRIMARY KEY,
+    category_id UUID REFERENCES category(id),
+    -- Share of the line subtotal in basis points (1250 = 12.5%)
+    rate_bps INT NOT NULL CHECK (rate_bps BETWEEN 0 AND 10000),
+    -- Flat fee per order line, in rupiah
+    fixed_fee BIGINT NOT NULL DEFAULT 0 CHECK (fixed_fee >= 0),
+    effective_from TIMESTAMPTZ NOT NULL,
+    created_by INT REFERENCES users(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX ux_category_commissions_category_from
+ON category_commissions (category_id, effective_from)
+WHERE category_id IS NOT NULL;
+
+CREATE UNIQUE INDEX ux_category_commissions_default_from
+ON category_commissions (effective_from)
+WHERE category_id IS NULL;
+
+-- Commission each order line was charged, fixed when the order is placed
+ALTER TABLE order_items
+ADD COLUMN commission_rate_id BIGINT REFERENCES category_commissions(id),
+ADD COLUMN commission_amount BIGINT NOT NULL DEFAULT 0;
+
+-- +migrate Down
+
+ALTER TABLE order_items
+DROP COLUMN IF EXISTS commission_amount,
+DROP COLUMN IF EXISTS commission_rate_id;
+
+DROP TABLE IF EXISTS category_commissions;
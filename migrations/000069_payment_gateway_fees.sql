@@ -0,0 +1,11 @@
+-- +migrate Up
+
+-- What the gateway kept of a captured payment, from the capture webhook or
+-- a settlement report. NULL until either reports it, and for wallet and
+-- offline payments, which carry no gateway fee.
+ALTER TABLE payments
+ADD COLUMN fee_amount BIGINT CHECK (fee_amount >= 0);
+
+-- +migrate Down
+
+ALTER TABLE payments DROP COLUMN IF EXISTS fee_amount;
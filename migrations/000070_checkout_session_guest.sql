@@ -0,0 +1,16 @@
+-- +migrate Up
+
+-- Guest checkout sessions belong to the guest token's ID instead of a user;
+-- user_id stays NULL for them.
+ALTER TABLE checkout_sessions
+ADD COLUMN guest_id UUID;
+
+CREATE INDEX idx_checkout_sessions_guest_id
+  ON checkout_sessions (guest_id)
+  WHERE guest_id IS NOT NULL;
+
+-- +migrate Down
+
+DROP INDEX IF EXISTS idx_checkout_sessions_guest_id;
+
+ALTER TABLE checkout_sessions DROP COLUMN IF EXISTS guest_id;
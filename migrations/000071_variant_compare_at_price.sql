@@ -0,0 +1,16 @@
+-- +migrate Up
+
+-- The price a variant is shown struck through against; its price is the
+-- sale price. NULL when the variant is not on promotion.
+ALTER TABLE variants
+ADD COLUMN compare_at_price NUMERIC(12,2);
+
+ALTER TABLE variants
+ADD CONSTRAINT chk_variants_compare_at_price
+CHECK (compare_at_price IS NULL OR compare_at_price >= price);
+
+-- +migrate Down
+
+ALTER TABLE variants DROP CONSTRAINT IF EXISTS chk_variants_compare_at_price;
+
+ALTER TABLE variants DROP COLUMN IF EXISTS compare_at_price;
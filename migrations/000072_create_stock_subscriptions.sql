@@ -0,0 +1,18 @@
+-- +migrate Up
+
+-- Customers waiting for a sold-out variant. The notification job deletes a
+-- row once its customer was told the variant is back.
+CREATE TABLE stock_subscriptions (
+    id BIGSERIAL PRIMARY KEY,
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    variant_id UUID NOT NULL REFERENCES variants(id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (user_id, variant_id)
+);
+
+CREATE INDEX idx_stock_subscriptions_variant
+ON stock_subscriptions (variant_id);
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS stock_subscriptions;
@@ -0,0 +1,30 @@
+-- +migrate Up
+
+-- Prices sellers set ahead of time. The price_changes job applies a
+-- PENDING change once effective_at passes, except while the variant is
+-- on sale (has a compare-at price); the change then waits for the sale to
+-- end.
+CREATE TABLE scheduled_price_changes (
+    id BIGSERIAL PRIMARY KEY,
+    variant_id UUID NOT NULL REFERENCES variants(id) ON DELETE CASCADE,
+    price NUMERIC(12,2) NOT NULL CHECK (price > 0),
+    effective_at TIMESTAMPTZ NOT NULL,
+    status VARCHAR(10) NOT NULL DEFAULT 'PENDING'
+        CHECK (status IN ('PENDING', 'APPLIED', 'CANCELLED')),
+    created_by INT NOT NULL REFERENCES users(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    applied_at TIMESTAMPTZ
+);
+
+-- A variant has at most one pending change.
+CREATE UNIQUE INDEX uq_scheduled_price_changes_pending
+ON scheduled_price_changes (variant_id)
+WHERE status = 'PENDING';
+
+CREATE INDEX idx_scheduled_price_changes_due
+ON scheduled_price_changes (effective_at)
+WHERE status = 'PENDING';
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS scheduled_price_changes;
@@ -0,0 +1,36 @@
+-- +migrate Up
+
+-- Files built in the background for a seller to download. The exports
+-- job claims PENDING rows; a RUNNING row whose job died is claimed again
+-- once it goes stale. kind says what the file holds.
+CREATE TABLE exports (
+    id BIGSERIAL PRIMARY KEY,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('CATALOG')),
+    status VARCHAR(10) NOT NULL DEFAULT 'PENDING'
+        CHECK (status IN ('PENDING', 'RUNNING', 'DONE', 'FAILED')),
+    seller_id UUID NOT NULL REFERENCES sellers(id),
+    requested_by INT NOT NULL REFERENCES users(id),
+    attempts INT NOT NULL DEFAULT 0,
+    storage_key TEXT,
+    row_count INT,
+    error TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    started_at TIMESTAMPTZ,
+    finished_at TIMESTAMPTZ
+);
+
+-- A seller has at most one unfinished export of each kind.
+CREATE UNIQUE INDEX uq_exports_unfinished
+ON exports (seller_id, kind)
+WHERE status IN ('PENDING', 'RUNNING');
+
+CREATE INDEX idx_exports_seller
+ON exports (seller_id, created_at DESC);
+
+CREATE INDEX idx_exports_queue
+ON exports (created_at)
+WHERE status IN ('PENDING', 'RUNNING');
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS exports;
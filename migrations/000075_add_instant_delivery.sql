@@ -0,0 +1,37 @@
+-- +migrate Up
+
+-- Where the customer pinned the address on a map, as "lat,lng". It is
+-- personal data like the rest of the address and is stored encrypted.
+ALTER TABLE addresses
+ADD COLUMN location TEXT;
+
+-- Where a seller origin sits. Only origins with both set can send
+-- instant deliveries.
+ALTER TABLE seller_origins
+ADD COLUMN latitude DOUBLE PRECISION,
+ADD COLUMN longitude DOUBLE PRECISION,
+ADD CONSTRAINT seller_origins_location_check CHECK (
+    (latitude IS NULL) = (longitude IS NULL)
+    AND latitude BETWEEN -90 AND 90
+    AND longitude BETWEEN -180 AND 180
+);
+
+-- INSTANT is a same-day courier ride, offered only when the address is
+-- within reach of every origin in the checkout.
+ALTER TABLE checkout_sessions
+ADD COLUMN shipping_method VARCHAR(20) NOT NULL DEFAULT 'STANDARD'
+    CHECK (shipping_method IN ('STANDARD', 'INSTANT'));
+
+ALTER TABLE orders
+ADD COLUMN shipping_method VARCHAR(20) NOT NULL DEFAULT 'STANDARD'
+    CHECK (shipping_method IN ('STANDARD', 'INSTANT'));
+
+-- +migrate Down
+
+ALTER TABLE orders DROP COLUMN IF EXISTS shipping_method;
+ALTER TABLE checkout_sessions DROP COLUMN IF EXISTS shipping_method;
+ALTER TABLE seller_origins
+DROP CONSTRAINT IF EXISTS seller_origins_location_check,
+DROP COLUMN IF EXISTS latitude,
+DROP COLUMN IF EXISTS longitude;
+ALTER TABLE addresses DROP COLUMN IF EXISTS location;
@@ -0,0 +1,72 @@
+-- +migrate Up
+
+-- A pickup order waits at its pickup location between ACCEPTED and
+-- COMPLETED instead of shipping.
+ALTER TYPE order_status ADD VALUE IF NOT EXISTS 'READY_FOR_PICKUP' AFTER 'SHIPPED';
+
+-- Counters where customers collect their orders. Opening hours are local
+-- (Asia/Jakarta) time and are cut into slots of slot_minutes.
+CREATE TABLE pickup_locations (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    address TEXT NOT NULL,
+    city VARCHAR(100) NOT NULL,
+    opens_at TIME NOT NULL,
+    closes_at TIME NOT NULL,
+    slot_minutes INT NOT NULL DEFAULT 60
+        CHECK (slot_minutes BETWEEN 15 AND 240),
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT pickup_locations_hours_check CHECK (opens_at < closes_at)
+);
+
+-- SELF_PICKUP skips the courier; the session keeps the chosen location
+-- and slot until it is confirmed.
+ALTER TABLE checkout_sessions
+DROP CONSTRAINT IF EXISTS checkout_sessions_shipping_method_check,
+ADD CONSTRAINT checkout_sessions_shipping_method_check
+    CHECK (shipping_method IN ('STANDARD', 'INSTANT', 'SELF_PICKUP')),
+ADD COLUMN pickup_location_id INT REFERENCES pickup_locations(id),
+ADD COLUMN pickup_slot_start TIMESTAMPTZ;
+
+ALTER TABLE orders
+DROP CONSTRAINT IF EXISTS orders_shipping_method_check,
+ADD CONSTRAINT orders_shipping_method_check
+    CHECK (shipping_method IN ('STANDARD', 'INSTANT', 'SELF_PICKUP'));
+
+-- Where and when a pickup order is collected. The code is shown to the
+-- customer and checked at the counter before the order is handed over.
+CREATE TABLE order_pickups (
+    order_id INT PRIMARY KEY REFERENCES orders(id) ON DELETE CASCADE,
+    location_id INT NOT NULL REFERENCES pickup_locations(id),
+    slot_start TIMESTAMPTZ NOT NULL,
+    slot_end TIMESTAMPTZ NOT NULL,
+    code CHAR(6) NOT NULL,
+    picked_up_at TIMESTAMPTZ,
+    handed_over_by INT REFERENCES users(id) ON DELETE SET NULL
+);
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS order_pickups;
+
+UPDATE orders SET shipping_method = 'STANDARD' WHERE shipping_method = 'SELF_PICKUP';
+ALTER TABLE orders
+DROP CONSTRAINT IF EXISTS orders_shipping_method_check,
+ADD CONSTRAINT orders_shipping_method_check
+    CHECK (shipping_method IN ('STANDARD', 'INSTANT'));
+
+UPDATE checkout_sessions SET shipping_method = 'STANDARD' WHERE shipping_method = 'SELF_PICKUP';
+ALTER TABLE checkout_sessions
+DROP COLUMN IF EXISTS pickup_slot_start,
+DROP COLUMN IF EXISTS pickup_location_id,
+DROP CONSTRAINT IF EXISTS checkout_sessions_shipping_method_check,
+ADD CONSTRAINT checkout_sessions_shipping_method_check
+    CHECK (shipping_method IN ('STANDARD', 'INSTANT'));
+
+DROP TABLE IF EXISTS pickup_locations;
+
+-- Postgres cannot drop an enum value; orders waiting for pickup go back
+-- to ACCEPTED and READY_FOR_PICKUP stays unused.
+UPDATE orders SET status = 'ACCEPTED' WHERE status = 'READY_FOR_PICKUP';
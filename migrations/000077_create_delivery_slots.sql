@@ -0,0 +1,46 @@
+-- +migrate Up
+
+-- Same-day delivery windows per region (a province, matched like the
+-- checkout rules). Each window takes at most capacity orders a day. Times
+-- are local (Asia/Jakarta).
+CREATE TABLE delivery_slots (
+    id SERIAL PRIMARY KEY,
+    region VARCHAR(100) NOT NULL,
+    starts_at TIME NOT NULL,
+    ends_at TIME NOT NULL,
+    capacity INT NOT NULL CHECK (capacity >= 0),
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT delivery_slots_window_check CHECK (starts_at < ends_at)
+);
+
+CREATE UNIQUE INDEX idx_delivery_slots_region_start
+ON delivery_slots (LOWER(region), starts_at);
+
+ALTER TABLE checkout_sessions
+ADD COLUMN delivery_slot_id INT REFERENCES delivery_slots(id),
+ADD COLUMN delivery_date DATE;
+
+-- One row per order holding a slot. A booking whose order was cancelled
+-- or failed, e.g. because its payment expired, no longer counts against
+-- the slot's capacity.
+CREATE TABLE delivery_slot_bookings (
+    order_id INT PRIMARY KEY REFERENCES orders(id) ON DELETE CASCADE,
+    slot_id INT NOT NULL REFERENCES delivery_slots(id),
+    delivery_date DATE NOT NULL,
+    window_start TIMESTAMPTZ NOT NULL,
+    window_end TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_delivery_slot_bookings_slot_date
+ON delivery_slot_bookings (slot_id, delivery_date);
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS delivery_slot_bookings;
+ALTER TABLE checkout_sessions
+DROP COLUMN IF EXISTS delivery_date,
+DROP COLUMN IF EXISTS delivery_slot_id;
+DROP TABLE IF EXISTS delivery_slots;
@@ -0,0 +1,18 @@
+-- +migrate Up
+
+-- Optional shipping insurance. The session keeps the customer's choice;
+-- the fee follows from its shipping method and subtotal, and the order
+-- keeps what was charged.
+ALTER TABLE checkout_sessions
+ADD COLUMN insured BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE orders
+ADD COLUMN insurance_fee INT NOT NULL DEFAULT 0 CHECK (insurance_fee >= 0);
+
+-- +migrate Down
+
+ALTER TABLE orders
+DROP COLUMN IF EXISTS insurance_fee;
+
+ALTER TABLE checkout_sessions
+DROP COLUMN IF EXISTS insured;
@@ -0,0 +1,28 @@
+-- +migrate Up
+
+-- Published versions of the terms and conditions and the refund policy.
+-- A version is never edited; publishing again adds the next one.
+CREATE TABLE policies (
+    id SERIAL PRIMARY KEY,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('TERMS', 'REFUND')),
+    version INT NOT NULL CHECK (version > 0),
+    title VARCHAR(200) NOT NULL,
+    body TEXT NOT NULL,
+    published_by INT REFERENCES users(id) ON DELETE SET NULL,
+    published_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    UNIQUE (kind, version)
+);
+
+-- The policy versions the customer accepted when placing each order,
+-- kept as evidence for disputes.
+CREATE TABLE order_policy_acceptances (
+    order_id INT NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    policy_id INT NOT NULL REFERENCES policies(id),
+    accepted_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (order_id, policy_id)
+);
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS order_policy_acceptances;
+DROP TABLE IF EXISTS policies;
@@ -0,0 +1,47 @@
+-- +migrate Up
+
+-- Receipt emails waiting to be sent. Like the outbox events, a row is
+-- written by a trigger in the same transaction that marks the order paid,
+-- so a committed payment always gets its receipt and a rolled-back one
+-- never does. The order id key keeps it to one receipt per order; an admin
+-- resend clears sent_at to send it again.
+CREATE TABLE order_receipts (
+    order_id INT PRIMARY KEY REFERENCES orders(id) ON DELETE CASCADE,
+    queued_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    sent_at TIMESTAMPTZ,
+    sent_to TEXT,
+    attempts INT NOT NULL DEFAULT 0,
+    last_error TEXT,
+    resent_by INT REFERENCES users(id) ON DELETE SET NULL,
+    resent_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_order_receipts_pending
+ON order_receipts (queued_at)
+WHERE sent_at IS NULL;
+
+-- Guest orders have no email on record and get no receipt.
+CREATE OR REPLACE FUNCTION queue_order_receipt()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.status = 'PAID'
+       AND OLD.status IS DISTINCT FROM NEW.status
+       AND NEW.user_id IS NOT NULL THEN
+        INSERT INTO order_receipts (order_id)
+        VALUES (NEW.id)
+        ON CONFLICT (order_id) DO NOTHING;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_queue_order_receipt
+AFTER UPDATE OF status ON orders
+FOR EACH ROW
+EXECUTE FUNCTION queue_order_receipt();
+
+-- +migrate Down
+
+DROP TRIGGER IF EXISTS trg_queue_order_receipt ON orders;
+DROP FUNCTION IF EXISTS queue_order_receipt;
+DROP TABLE IF EXISTS order_receipts;
@@ -0,0 +1,31 @@
+-- +migrate Up
+
+-- A/B experiments. Shoppers are split between the variants by a hash of
+-- the experiment key and their user or guest id, so assignments are not
+-- stored; an experiment's variants never change once it is created. Only
+-- orders record the variants they were placed under, for conversion
+-- analysis.
+CREATE TABLE experiments (
+    key VARCHAR(64) PRIMARY KEY,
+    description TEXT,
+    -- [{"name": "control", "weight": 50}, ...]
+    variants JSONB NOT NULL,
+    started_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    ended_at TIMESTAMPTZ,
+    created_by INT REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE TABLE order_experiments (
+    order_id INT NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    experiment_key VARCHAR(64) NOT NULL REFERENCES experiments(key) ON DELETE CASCADE,
+    variant VARCHAR(64) NOT NULL,
+    PRIMARY KEY (order_id, experiment_key)
+);
+
+CREATE INDEX idx_order_experiments_key
+ON order_experiments (experiment_key, variant);
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS order_experiments;
+DROP TABLE IF EXISTS experiments;
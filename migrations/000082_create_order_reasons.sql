@@ -0,0 +1,84 @@
+-- +migrate Up
+
+-- Managed list of why orders are cancelled or returned. Customer reasons
+-- are offered to shoppers; internal ones only to admins and the system.
+-- Reasons are deactivated rather than deleted, so past orders keep them.
+CREATE TABLE order_reasons (
+    id SERIAL PRIMARY KEY,
+    code VARCHAR(50) NOT NULL UNIQUE,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('CANCELLATION', 'RETURN')),
+    audience VARCHAR(20) NOT NULL CHECK (audience IN ('CUSTOMER', 'INTERNAL')),
+    label VARCHAR(200) NOT NULL,
+    position INT NOT NULL DEFAULT 0,
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO order_reasons (code, kind, audience, label, position) VALUES
+    ('CHANGED_MIND', 'CANCELLATION', 'CUSTOMER', 'Changed my mind', 10),
+    ('ORDERED_BY_MISTAKE', 'CANCELLATION', 'CUSTOMER', 'Ordered by mistake', 20),
+    ('FOUND_CHEAPER', 'CANCELLATION', 'CUSTOMER', 'Found a better price elsewhere', 30),
+    ('DELIVERY_TOO_SLOW', 'CANCELLATION', 'CUSTOMER', 'Delivery takes too long', 40),
+    ('OUT_OF_STOCK', 'CANCELLATION', 'INTERNAL', 'Item out of stock', 110),
+    ('SUSPECTED_FRAUD', 'CANCELLATION', 'INTERNAL', 'Suspected fraud', 120),
+    ('UNDELIVERABLE_ADDRESS', 'CANCELLATION', 'INTERNAL', 'Address cannot be delivered to', 130),
+    ('PAYMENT_EXPIRED', 'CANCELLATION', 'INTERNAL', 'Payment not completed in time', 900),
+    ('PAYMENT_FAILED', 'CANCELLATION', 'INTERNAL', 'Payment failed', 910),
+    ('DAMAGED', 'RETURN', 'CUSTOMER', 'Item arrived damaged', 10),
+    ('WRONG_ITEM', 'RETURN', 'CUSTOMER', 'Received the wrong item', 20),
+    ('NOT_AS_DESCRIBED', 'RETURN', 'CUSTOMER', 'Item not as described', 30),
+    ('MISSING_ITEMS', 'RETURN', 'CUSTOMER', 'Items missing from the package', 40),
+    ('NO_LONGER_NEEDED', 'RETURN', 'CUSTOMER', 'No longer needed', 50),
+    ('GOODWILL', 'RETURN', 'INTERNAL', 'Goodwill refund', 110);
+
+-- Why and when a cancelled or failed order stopped.
+ALTER TABLE orders
+ADD COLUMN cancel_reason_id INT REFERENCES order_reasons(id),
+ADD COLUMN cancel_note TEXT,
+ADD COLUMN cancelled_at TIMESTAMPTZ;
+
+CREATE INDEX idx_orders_cancelled_at
+ON orders (cancelled_at, cancel_reason_id)
+WHERE cancelled_at IS NOT NULL;
+
+-- Refunds keep their free-text reason as a note next to the managed one.
+ALTER TABLE refunds
+ADD COLUMN reason_id INT REFERENCES order_reasons(id);
+
+-- Orders the system cancels or fails get a reason too, so reports cover
+-- every order that did not go through. A reason set with the status
+-- change wins.
+CREATE OR REPLACE FUNCTION stamp_order_cancellation()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF OLD.status IS DISTINCT FROM NEW.status
+       AND NEW.status IN ('CANCELLED', 'FAILED') THEN
+        NEW.cancelled_at := NOW();
+        IF NEW.cancel_reason_id IS NULL THEN
+            IF NEW.status = 'FAILED' THEN
+                SELECT id INTO NEW.cancel_reason_id FROM order_reasons WHERE code = 'PAYMENT_FAILED';
+            ELSIF OLD.status = 'PENDING_PAYMENT' THEN
+                SELECT id INTO NEW.cancel_reason_id FROM order_reasons WHERE code = 'PAYMENT_EXPIRED';
+            END IF;
+        END IF;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER trg_stamp_order_cancellation
+BEFORE UPDATE OF status ON orders
+FOR EACH ROW
+EXECUTE FUNCTION stamp_order_cancellation();
+
+-- +migrate Down
+
+DROP TRIGGER IF EXISTS trg_stamp_order_cancellation ON orders;
+DROP FUNCTION IF EXISTS stamp_order_cancellation;
+DROP INDEX IF EXISTS idx_orders_cancelled_at;
+ALTER TABLE refunds DROP COLUMN IF EXISTS reason_id;
+ALTER TABLE orders
+DROP COLUMN IF EXISTS cancelled_at,
+DROP COLUMN IF EXISTS cancel_note,
+DROP COLUMN IF EXISTS cancel_reason_id;
+DROP TABLE IF EXISTS order_reasons;
@@ -0,0 +1,24 @@
+-- +migrate Up
+
+-- Results of the synthetic checkout canary, which buys the dedicated test
+-- product as the canary user with a simulated payment. Scheduled runs
+-- have no triggered_by.
+CREATE TABLE synthetic_checkout_runs (
+    id BIGSERIAL PRIMARY KEY,
+    passed BOOLEAN NOT NULL,
+    failed_step VARCHAR(32),
+    error TEXT,
+    order_external_id VARCHAR(64),
+    -- [{"name": "create_session", "duration_ms": 12, "error": ""}, ...]
+    steps JSONB NOT NULL,
+    started_at TIMESTAMPTZ NOT NULL,
+    duration_ms INT NOT NULL,
+    triggered_by INT REFERENCES users(id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_synthetic_checkout_runs_started_at
+ON synthetic_checkout_runs (started_at DESC);
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS synthetic_checkout_runs;
@@ -0,0 +1,34 @@
+-- +migrate Up
+
+-- Discounts and extra charges admins put on an order still waiting for
+-- payment. Each one is requested by one admin and applied when a second
+-- admin approves it; an order has at most one waiting at a time.
+CREATE TABLE order_adjustments (
+    id BIGSERIAL PRIMARY KEY,
+    order_id INT NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    kind VARCHAR(20) NOT NULL CHECK (kind IN ('DISCOUNT', 'CHARGE')),
+    amount BIGINT NOT NULL CHECK (amount > 0),
+    reason TEXT NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING'
+        CHECK (status IN ('PENDING', 'APPLIED', 'REJECTED')),
+    requested_by INT NOT NULL REFERENCES users(id),
+    reviewed_by INT REFERENCES users(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    reviewed_at TIMESTAMPTZ
+);
+
+CREATE INDEX idx_order_adjustments_order ON order_adjustments (order_id);
+
+CREATE UNIQUE INDEX ux_order_adjustments_pending
+ON order_adjustments (order_id)
+WHERE status = 'PENDING';
+
+-- Net of the applied adjustments, already included in total_amount:
+-- charges add to it and discounts take from it.
+ALTER TABLE orders
+ADD COLUMN adjustment_amount BIGINT NOT NULL DEFAULT 0;
+
+-- +migrate Down
+
+ALTER TABLE orders DROP COLUMN IF EXISTS adjustment_amount;
+DROP TABLE IF EXISTS order_adjustments;
@@ -0,0 +1,33 @@
+-- +migrate Up
+
+-- A verified phone is a second login identifier, so it is found through
+-- phone_lookup, a keyed digest of the normalized number: the phone column
+-- itself is encrypted differently on every write.
+ALTER TABLE profiles
+ADD COLUMN phone_lookup VARCHAR(64),
+ADD COLUMN phone_verified_at TIMESTAMPTZ;
+
+CREATE UNIQUE INDEX ux_profiles_verified_phone
+ON profiles (phone_lookup)
+WHERE phone_verified_at IS NOT NULL;
+
+-- The one-time code a user was last sent, at most one per user. Only a
+-- hash of the code is kept.
+CREATE TABLE phone_verifications (
+    user_id INT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    phone TEXT NOT NULL,
+    phone_lookup VARCHAR(64) NOT NULL,
+    code_hash TEXT NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    expires_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS phone_verifications;
+DROP INDEX IF EXISTS ux_profiles_verified_phone;
+
+ALTER TABLE profiles
+DROP COLUMN IF EXISTS phone_verified_at,
+DROP COLUMN IF EXISTS phone_lookup;
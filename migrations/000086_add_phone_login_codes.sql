@@ -0,0 +1,28 @@
+-- +migrate Up
+
+-- Codes now also log users in without a password. A user can hold one
+-- code per purpose, and sends counts the codes sent for that purpose
+-- since window_started_at, capping how many go out an hour. Rows get
+-- their own id so key rotation rewrites one row at a time.
+ALTER TABLE phone_verifications
+ADD COLUMN purpose VARCHAR(10) NOT NULL DEFAULT 'VERIFY'
+    CHECK (purpose IN ('VERIFY', 'LOGIN')),
+ADD COLUMN sends INT NOT NULL DEFAULT 1,
+ADD COLUMN window_started_at TIMESTAMPTZ NOT NULL DEFAULT NOW();
+
+ALTER TABLE phone_verifications
+DROP CONSTRAINT phone_verifications_pkey,
+ADD COLUMN id BIGSERIAL PRIMARY KEY,
+ADD CONSTRAINT ux_phone_verifications_user_purpose UNIQUE (user_id, purpose);
+
+-- +migrate Down
+
+DELETE FROM phone_verifications WHERE purpose = 'LOGIN';
+
+ALTER TABLE phone_verifications
+DROP CONSTRAINT ux_phone_verifications_user_purpose,
+DROP COLUMN id,
+DROP COLUMN window_started_at,
+DROP COLUMN sends,
+DROP COLUMN purpose,
+ADD PRIMARY KEY (user_id);
@@ -0,0 +1,29 @@
+-- +migrate Up
+
+-- The name shown publicly instead of the full name.
+ALTER TABLE profiles
+ADD COLUMN display_name VARCHAR(30);
+
+-- Words display names may not contain. Terms are stored lowercased with
+-- leetspeak undone and only letters kept, the form names are compared in.
+-- Adding a term does not touch names already saved.
+CREATE TABLE display_name_blocklist (
+    term VARCHAR(50) PRIMARY KEY,
+    created_by INT REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Names that would pass for the shop itself
+INSERT INTO display_name_blocklist (term) VALUES
+    ('admin'),
+    ('moderator'),
+    ('warimas'),
+    ('official'),
+    ('customerservice');
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS display_name_blocklist;
+
+ALTER TABLE profiles
+DROP COLUMN IF EXISTS display_name;
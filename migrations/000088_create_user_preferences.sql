@@ -0,0 +1,23 @@
+-- +migrate Up
+
+CREATE TABLE user_preferences (
+    user_id INT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    locale VARCHAR(10) NOT NULL DEFAULT 'id',
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- Only settings a user changed are stored; the rest use the defaults in
+-- code. Marketing by email and WhatsApp lives in marketing_consents.
+CREATE TABLE notification_preferences (
+    user_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    event VARCHAR(30) NOT NULL,
+    channel VARCHAR(20) NOT NULL,
+    enabled BOOLEAN NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, event, channel)
+);
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS notification_preferences;
+DROP TABLE IF EXISTS user_preferences;
@@ -0,0 +1,73 @@
+-- +migrate Up
+
+ALTER TABLE users
+    ADD COLUMN banned_at TIMESTAMPTZ,
+    ADD COLUMN ban_reason TEXT;
+
+-- Keyword rules flag content for review; unlike the display name
+-- blocklist they never reject an edit. Terms are stored folded.
+CREATE TABLE moderation_keywords (
+    term VARCHAR(50) PRIMARY KEY,
+    created_by INT REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO moderation_keywords (term) VALUES
+    ('judi'), ('togel'), ('penipuan'), ('scam'), ('narkoba');
+
+-- content_id is the seller ID for store content and the user ID for
+-- profile content. body is the content as it was queued.
+CREATE TABLE moderation_items (
+    id BIGSERIAL PRIMARY KEY,
+    content_type VARCHAR(30) NOT NULL,
+    content_id VARCHAR(64) NOT NULL,
+    author_id INT REFERENCES users(id) ON DELETE SET NULL,
+    body TEXT NOT NULL,
+    matched_keywords TEXT[] NOT NULL DEFAULT '{}',
+    report_count INT NOT NULL DEFAULT 0,
+    status VARCHAR(20) NOT NULL DEFAULT 'PENDING',
+    resolved_by INT REFERENCES users(id) ON DELETE SET NULL,
+    resolved_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- A piece of content has at most one pending item; new flags and reports
+-- land on it.
+CREATE UNIQUE INDEX idx_moderation_items_pending
+ON moderation_items (content_type, content_id)
+WHERE status = 'PENDING';
+
+CREATE INDEX idx_moderation_items_status
+ON moderation_items (status, report_count DESC, created_at DESC);
+
+CREATE TABLE moderation_reports (
+    item_id BIGINT NOT NULL REFERENCES moderation_items(id) ON DELETE CASCADE,
+    reporter_id INT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    reason TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (item_id, reporter_id)
+);
+
+CREATE TABLE moderation_audit (
+    id BIGSERIAL PRIMARY KEY,
+    item_id BIGINT NOT NULL REFERENCES moderation_items(id) ON DELETE CASCADE,
+    action VARCHAR(20) NOT NULL,
+    actor_id INT REFERENCES users(id) ON DELETE SET NULL,
+    target_user_id INT REFERENCES users(id) ON DELETE SET NULL,
+    note TEXT,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_moderation_audit_item ON moderation_audit (item_id);
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS moderation_audit;
+DROP TABLE IF EXISTS moderation_reports;
+DROP TABLE IF EXISTS moderation_items;
+DROP TABLE IF EXISTS moderation_keywords;
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS ban_reason,
+    DROP COLUMN IF EXISTS banned_at;
@@ -0,0 +1,12 @@
+-- +migrate Up
+
+-- Set while reports hide a product from shoppers pending review, and for
+-- good once a moderator removes it.
+ALTER TABLE products ADD COLUMN moderation_hidden_at TIMESTAMPTZ;
+
+ALTER TABLE moderation_items ADD COLUMN hidden_at TIMESTAMPTZ;
+
+-- +migrate Down
+
+ALTER TABLE moderation_items DROP COLUMN IF EXISTS hidden_at;
+ALTER TABLE products DROP COLUMN IF EXISTS moderation_hidden_at;
@@ -0,0 +1,9 @@
+-- +migrate Up
+
+-- Set when a seller deletes a product. The row stays for the orders that
+-- reference it but is gone from every product read.
+ALTER TABLE products ADD COLUMN deleted_at TIMESTAMPTZ;
+
+-- +migrate Down
+
+ALTER TABLE products DROP COLUMN IF EXISTS deleted_at;
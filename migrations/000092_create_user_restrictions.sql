@@ -0,0 +1,16 @@
+-- +migrate Up
+
+-- An admin's restriction on an account, at most one per user. Setting a
+-- new one replaces it; it stops applying once expires_at has passed.
+CREATE TABLE user_restrictions (
+    user_id INT PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    level VARCHAR(20) NOT NULL CHECK (level IN ('WARN', 'BLOCK_CHECKOUT', 'SUSPEND')),
+    reason TEXT NOT NULL,
+    expires_at TIMESTAMPTZ,
+    created_by INT REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+-- +migrate Down
+
+DROP TABLE IF EXISTS user_restrictions;
@@ -0,0 +1,17 @@
+-- +migrate Up
+
+-- What the seller earns on a line (its subtotal less the commission) is
+-- AVAILABLE for payout, HELD while a dispute on the order's payment is
+-- open and REVERSED once the dispute is lost.
+ALTER TABLE order_items
+ADD COLUMN payout_status VARCHAR(10) NOT NULL DEFAULT 'AVAILABLE'
+    CHECK (payout_status IN ('AVAILABLE', 'HELD', 'REVERSED'));
+
+-- Seller earnings the dispute put on hold when it was opened
+ALTER TABLE payment_disputes
+ADD COLUMN payout_held BIGINT NOT NULL DEFAULT 0;
+
+-- +migrate Down
+
+ALTER TABLE payment_disputes DROP COLUMN IF EXISTS payout_held;
+ALTER TABLE order_items DROP COLUMN IF EXISTS payout_status;